@@ -2,7 +2,11 @@
 // Licensed under the MIT License.
 
 #pragma once
+#include <cstddef>
+#include <cstdint>
 #include <stdexcept>
+#include <string>
+#include <vector>
 
 // We want the evolution test assertions to evaluate regardless of the CMake
 // Build mode, so these tests use a very simple custom assert macro.
@@ -18,3 +22,7 @@
 #define EVO_ASSERT_EQUALISH(a, b) EVO_ASSERT(std::abs(a - b) < 0.0001)
 
 static std::string HelloWorld = "Hello, World!";
+static std::vector<std::byte> HelloWorldBytes(reinterpret_cast<std::byte const*>(HelloWorld.data()),
+                                              reinterpret_cast<std::byte const*>(HelloWorld.data()) + HelloWorld.size());
+static std::vector<uint8_t> HelloWorldOctets(HelloWorld.begin(), HelloWorld.end());
+static std::string UuidString = "123e4567-e89b-12d3-a456-426614174000";
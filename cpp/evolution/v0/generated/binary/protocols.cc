@@ -763,6 +763,30 @@ void ProtocolWithChangesWriter::WriteStringToAliasedIntImpl(std::string const& v
   yardl::binary::WriteString(stream_, value);
 }
 
+void ProtocolWithChangesWriter::WriteStringToBytesImpl(std::string const& value) {
+  yardl::binary::WriteString(stream_, value);
+}
+
+void ProtocolWithChangesWriter::WriteBytesToStringImpl(std::vector<std::byte> const& value) {
+  yardl::binary::WriteBytes(stream_, value);
+}
+
+void ProtocolWithChangesWriter::WriteVectorToBytesImpl(std::vector<uint8_t> const& value) {
+  yardl::binary::WriteVector<uint8_t, yardl::binary::WriteInteger>(stream_, value);
+}
+
+void ProtocolWithChangesWriter::WriteBytesToVectorImpl(std::vector<std::byte> const& value) {
+  yardl::binary::WriteBytes(stream_, value);
+}
+
+void ProtocolWithChangesWriter::WriteStringToUuidImpl(std::string const& value) {
+  yardl::binary::WriteString(stream_, value);
+}
+
+void ProtocolWithChangesWriter::WriteUuidToStringImpl(yardl::Uuid const& value) {
+  yardl::binary::WriteUuid(stream_, value);
+}
+
 void ProtocolWithChangesWriter::WriteEnumToAliasedEnumImpl(evo_test::GrowingEnum const& value) {
   yardl::binary::WriteEnum<evo_test::GrowingEnum>(stream_, value);
 }
@@ -1231,6 +1255,30 @@ void ProtocolWithChangesReader::ReadStringToAliasedIntImpl(std::string& value) {
   yardl::binary::ReadString(stream_, value);
 }
 
+void ProtocolWithChangesReader::ReadStringToBytesImpl(std::string& value) {
+  yardl::binary::ReadString(stream_, value);
+}
+
+void ProtocolWithChangesReader::ReadBytesToStringImpl(std::vector<std::byte>& value) {
+  yardl::binary::ReadBytes(stream_, value);
+}
+
+void ProtocolWithChangesReader::ReadVectorToBytesImpl(std::vector<uint8_t>& value) {
+  yardl::binary::ReadVector<uint8_t, yardl::binary::ReadInteger>(stream_, value);
+}
+
+void ProtocolWithChangesReader::ReadBytesToVectorImpl(std::vector<std::byte>& value) {
+  yardl::binary::ReadBytes(stream_, value);
+}
+
+void ProtocolWithChangesReader::ReadStringToUuidImpl(std::string& value) {
+  yardl::binary::ReadString(stream_, value);
+}
+
+void ProtocolWithChangesReader::ReadUuidToStringImpl(yardl::Uuid& value) {
+  yardl::binary::ReadUuid(stream_, value);
+}
+
 void ProtocolWithChangesReader::ReadEnumToAliasedEnumImpl(evo_test::GrowingEnum& value) {
   yardl::binary::ReadEnum<evo_test::GrowingEnum>(stream_, value);
 }
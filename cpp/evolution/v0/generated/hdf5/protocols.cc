@@ -637,6 +637,30 @@ void ProtocolWithChangesWriter::WriteStringToAliasedIntImpl(std::string const& v
   yardl::hdf5::WriteScalarDataset<yardl::hdf5::InnerVlenString, std::string>(group_, "stringToAliasedInt", yardl::hdf5::InnerVlenStringDdl(), value);
 }
 
+void ProtocolWithChangesWriter::WriteStringToBytesImpl(std::string const& value) {
+  yardl::hdf5::WriteScalarDataset<yardl::hdf5::InnerVlenString, std::string>(group_, "stringToBytes", yardl::hdf5::InnerVlenStringDdl(), value);
+}
+
+void ProtocolWithChangesWriter::WriteBytesToStringImpl(std::vector<std::byte> const& value) {
+  yardl::hdf5::WriteScalarDataset<yardl::hdf5::InnerVlen<std::byte, std::byte>, std::vector<std::byte>>(group_, "bytesToString", yardl::hdf5::InnerVlenBytesDdl(), value);
+}
+
+void ProtocolWithChangesWriter::WriteVectorToBytesImpl(std::vector<uint8_t> const& value) {
+  yardl::hdf5::WriteScalarDataset<yardl::hdf5::InnerVlen<uint8_t, uint8_t>, std::vector<uint8_t>>(group_, "vectorToBytes", yardl::hdf5::InnerVlenDdl(H5::PredType::NATIVE_UINT8), value);
+}
+
+void ProtocolWithChangesWriter::WriteBytesToVectorImpl(std::vector<std::byte> const& value) {
+  yardl::hdf5::WriteScalarDataset<yardl::hdf5::InnerVlen<std::byte, std::byte>, std::vector<std::byte>>(group_, "bytesToVector", yardl::hdf5::InnerVlenBytesDdl(), value);
+}
+
+void ProtocolWithChangesWriter::WriteStringToUuidImpl(std::string const& value) {
+  yardl::hdf5::WriteScalarDataset<yardl::hdf5::InnerVlenString, std::string>(group_, "stringToUuid", yardl::hdf5::InnerVlenStringDdl(), value);
+}
+
+void ProtocolWithChangesWriter::WriteUuidToStringImpl(yardl::Uuid const& value) {
+  yardl::hdf5::WriteScalarDataset<yardl::Uuid, yardl::Uuid>(group_, "uuidToString", yardl::hdf5::UuidTypeDdl(), value);
+}
+
 void ProtocolWithChangesWriter::WriteEnumToAliasedEnumImpl(evo_test::GrowingEnum const& value) {
   yardl::hdf5::WriteScalarDataset<evo_test::GrowingEnum, evo_test::GrowingEnum>(group_, "enumToAliasedEnum", evo_test::hdf5::GetGrowingEnumHdf5Ddl(), value);
 }
@@ -1170,6 +1194,30 @@ void ProtocolWithChangesReader::ReadStringToAliasedIntImpl(std::string& value) {
   yardl::hdf5::ReadScalarDataset<yardl::hdf5::InnerVlenString, std::string>(group_, "stringToAliasedInt", yardl::hdf5::InnerVlenStringDdl(), value);
 }
 
+void ProtocolWithChangesReader::ReadStringToBytesImpl(std::string& value) {
+  yardl::hdf5::ReadScalarDataset<yardl::hdf5::InnerVlenString, std::string>(group_, "stringToBytes", yardl::hdf5::InnerVlenStringDdl(), value);
+}
+
+void ProtocolWithChangesReader::ReadBytesToStringImpl(std::vector<std::byte>& value) {
+  yardl::hdf5::ReadScalarDataset<yardl::hdf5::InnerVlen<std::byte, std::byte>, std::vector<std::byte>>(group_, "bytesToString", yardl::hdf5::InnerVlenBytesDdl(), value);
+}
+
+void ProtocolWithChangesReader::ReadVectorToBytesImpl(std::vector<uint8_t>& value) {
+  yardl::hdf5::ReadScalarDataset<yardl::hdf5::InnerVlen<uint8_t, uint8_t>, std::vector<uint8_t>>(group_, "vectorToBytes", yardl::hdf5::InnerVlenDdl(H5::PredType::NATIVE_UINT8), value);
+}
+
+void ProtocolWithChangesReader::ReadBytesToVectorImpl(std::vector<std::byte>& value) {
+  yardl::hdf5::ReadScalarDataset<yardl::hdf5::InnerVlen<std::byte, std::byte>, std::vector<std::byte>>(group_, "bytesToVector", yardl::hdf5::InnerVlenBytesDdl(), value);
+}
+
+void ProtocolWithChangesReader::ReadStringToUuidImpl(std::string& value) {
+  yardl::hdf5::ReadScalarDataset<yardl::hdf5::InnerVlenString, std::string>(group_, "stringToUuid", yardl::hdf5::InnerVlenStringDdl(), value);
+}
+
+void ProtocolWithChangesReader::ReadUuidToStringImpl(yardl::Uuid& value) {
+  yardl::hdf5::ReadScalarDataset<yardl::Uuid, yardl::Uuid>(group_, "uuidToString", yardl::hdf5::UuidTypeDdl(), value);
+}
+
 void ProtocolWithChangesReader::ReadEnumToAliasedEnumImpl(evo_test::GrowingEnum& value) {
   yardl::hdf5::ReadScalarDataset<evo_test::GrowingEnum, evo_test::GrowingEnum>(group_, "enumToAliasedEnum", evo_test::hdf5::GetGrowingEnumHdf5Ddl(), value);
 }
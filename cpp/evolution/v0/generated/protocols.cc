@@ -45,74 +45,80 @@ void ProtocolWithChangesWriterBaseInvalidState(uint8_t attempted, [[maybe_unused
   case 29: expected_method = "WriteAliasedLongToString()"; break;
   case 30: expected_method = "WriteStringToAliasedString()"; break;
   case 31: expected_method = "WriteStringToAliasedInt()"; break;
-  case 32: expected_method = "WriteEnumToAliasedEnum()"; break;
-  case 33: expected_method = "WriteOptionalIntToUnion()"; break;
-  case 34: expected_method = "WriteOptionalRecordToUnion()"; break;
-  case 35: expected_method = "WriteRecordWithChanges()"; break;
-  case 36: expected_method = "WriteAliasedRecordWithChanges()"; break;
-  case 37: expected_method = "WriteRecordToRenamedRecord()"; break;
-  case 38: expected_method = "WriteRecordToAliasedRecord()"; break;
-  case 39: expected_method = "WriteRecordToAliasedAlias()"; break;
-  case 40: expected_method = "WriteStreamIntToStringToFloat() or EndStreamIntToStringToFloat()"; break;
-  case 41: expected_method = "WriteVectorIntToStringToFloat()"; break;
-  case 42: expected_method = "WriteIntFloatUnionReordered()"; break;
-  case 43: expected_method = "WriteVectorUnionReordered()"; break;
-  case 44: expected_method = "WriteStreamUnionReordered() or EndStreamUnionReordered()"; break;
-  case 45: expected_method = "WriteStreamOfAliasTypeChange() or EndStreamOfAliasTypeChange()"; break;
-  case 46: expected_method = "WriteRlink()"; break;
-  case 47: expected_method = "WriteRlinkRX()"; break;
-  case 48: expected_method = "WriteRlinkRY()"; break;
-  case 49: expected_method = "WriteRlinkRZ()"; break;
-  case 50: expected_method = "WriteRaRLink()"; break;
-  case 51: expected_method = "WriteRaRX()"; break;
-  case 52: expected_method = "WriteRaRY()"; break;
-  case 53: expected_method = "WriteRaRZ()"; break;
-  case 54: expected_method = "WriteRbRLink()"; break;
-  case 55: expected_method = "WriteRbRX()"; break;
-  case 56: expected_method = "WriteRbRY()"; break;
-  case 57: expected_method = "WriteRbRZ()"; break;
-  case 58: expected_method = "WriteRcRLink()"; break;
-  case 59: expected_method = "WriteRcRX()"; break;
-  case 60: expected_method = "WriteRcRY()"; break;
-  case 61: expected_method = "WriteRcRZ()"; break;
-  case 62: expected_method = "WriteRlinkRNew()"; break;
-  case 63: expected_method = "WriteRaRNew()"; break;
-  case 64: expected_method = "WriteRbRNew()"; break;
-  case 65: expected_method = "WriteRcRNew()"; break;
-  case 66: expected_method = "WriteRlinkRUnion()"; break;
-  case 67: expected_method = "WriteRaRUnion()"; break;
-  case 68: expected_method = "WriteRbRUnion()"; break;
-  case 69: expected_method = "WriteRcRUnion()"; break;
-  case 70: expected_method = "WriteOptionalRecordWithChanges()"; break;
-  case 71: expected_method = "WriteAliasedOptionalRecordWithChanges()"; break;
-  case 72: expected_method = "WriteUnionRecordWithChanges()"; break;
-  case 73: expected_method = "WriteUnionWithSameTypeset()"; break;
-  case 74: expected_method = "WriteUnionWithTypesAdded()"; break;
-  case 75: expected_method = "WriteUnionWithTypesRemoved()"; break;
-  case 76: expected_method = "WriteRecordToOptional()"; break;
-  case 77: expected_method = "WriteRecordToAliasedOptional()"; break;
-  case 78: expected_method = "WriteRecordToUnion()"; break;
-  case 79: expected_method = "WriteRecordToAliasedUnion()"; break;
-  case 80: expected_method = "WriteUnionToAliasedUnion()"; break;
-  case 81: expected_method = "WriteUnionToAliasedUnionWithChanges()"; break;
-  case 82: expected_method = "WriteOptionalToAliasedOptional()"; break;
-  case 83: expected_method = "WriteOptionalToAliasedOptionalWithChanges()"; break;
-  case 84: expected_method = "WriteGenericRecord()"; break;
-  case 85: expected_method = "WriteGenericRecordToOpenAlias()"; break;
-  case 86: expected_method = "WriteGenericRecordToClosedAlias()"; break;
-  case 87: expected_method = "WriteGenericRecordToHalfClosedAlias()"; break;
-  case 88: expected_method = "WriteAliasedGenericRecordToAlias()"; break;
-  case 89: expected_method = "WriteGenericRecordToReversed()"; break;
-  case 90: expected_method = "WriteClosedGenericRecordToUnion()"; break;
-  case 91: expected_method = "WriteGenericRecordToAliasedUnion()"; break;
-  case 92: expected_method = "WriteGenericUnionToReversed()"; break;
-  case 93: expected_method = "WriteGenericUnionOfChangedRecord()"; break;
-  case 94: expected_method = "WriteGenericParentRecord()"; break;
-  case 95: expected_method = "WriteGenericNestedRecords()"; break;
-  case 96: expected_method = "WriteGenericRecordStream() or EndGenericRecordStream()"; break;
-  case 97: expected_method = "WriteGenericParentRecordStream() or EndGenericParentRecordStream()"; break;
-  case 98: expected_method = "WriteVectorRecordWithChanges()"; break;
-  case 99: expected_method = "WriteStreamedRecordWithChanges() or EndStreamedRecordWithChanges()"; break;
+  case 32: expected_method = "WriteStringToBytes()"; break;
+  case 33: expected_method = "WriteBytesToString()"; break;
+  case 34: expected_method = "WriteVectorToBytes()"; break;
+  case 35: expected_method = "WriteBytesToVector()"; break;
+  case 36: expected_method = "WriteStringToUuid()"; break;
+  case 37: expected_method = "WriteUuidToString()"; break;
+  case 38: expected_method = "WriteEnumToAliasedEnum()"; break;
+  case 39: expected_method = "WriteOptionalIntToUnion()"; break;
+  case 40: expected_method = "WriteOptionalRecordToUnion()"; break;
+  case 41: expected_method = "WriteRecordWithChanges()"; break;
+  case 42: expected_method = "WriteAliasedRecordWithChanges()"; break;
+  case 43: expected_method = "WriteRecordToRenamedRecord()"; break;
+  case 44: expected_method = "WriteRecordToAliasedRecord()"; break;
+  case 45: expected_method = "WriteRecordToAliasedAlias()"; break;
+  case 46: expected_method = "WriteStreamIntToStringToFloat() or EndStreamIntToStringToFloat()"; break;
+  case 47: expected_method = "WriteVectorIntToStringToFloat()"; break;
+  case 48: expected_method = "WriteIntFloatUnionReordered()"; break;
+  case 49: expected_method = "WriteVectorUnionReordered()"; break;
+  case 50: expected_method = "WriteStreamUnionReordered() or EndStreamUnionReordered()"; break;
+  case 51: expected_method = "WriteStreamOfAliasTypeChange() or EndStreamOfAliasTypeChange()"; break;
+  case 52: expected_method = "WriteRlink()"; break;
+  case 53: expected_method = "WriteRlinkRX()"; break;
+  case 54: expected_method = "WriteRlinkRY()"; break;
+  case 55: expected_method = "WriteRlinkRZ()"; break;
+  case 56: expected_method = "WriteRaRLink()"; break;
+  case 57: expected_method = "WriteRaRX()"; break;
+  case 58: expected_method = "WriteRaRY()"; break;
+  case 59: expected_method = "WriteRaRZ()"; break;
+  case 60: expected_method = "WriteRbRLink()"; break;
+  case 61: expected_method = "WriteRbRX()"; break;
+  case 62: expected_method = "WriteRbRY()"; break;
+  case 63: expected_method = "WriteRbRZ()"; break;
+  case 64: expected_method = "WriteRcRLink()"; break;
+  case 65: expected_method = "WriteRcRX()"; break;
+  case 66: expected_method = "WriteRcRY()"; break;
+  case 67: expected_method = "WriteRcRZ()"; break;
+  case 68: expected_method = "WriteRlinkRNew()"; break;
+  case 69: expected_method = "WriteRaRNew()"; break;
+  case 70: expected_method = "WriteRbRNew()"; break;
+  case 71: expected_method = "WriteRcRNew()"; break;
+  case 72: expected_method = "WriteRlinkRUnion()"; break;
+  case 73: expected_method = "WriteRaRUnion()"; break;
+  case 74: expected_method = "WriteRbRUnion()"; break;
+  case 75: expected_method = "WriteRcRUnion()"; break;
+  case 76: expected_method = "WriteOptionalRecordWithChanges()"; break;
+  case 77: expected_method = "WriteAliasedOptionalRecordWithChanges()"; break;
+  case 78: expected_method = "WriteUnionRecordWithChanges()"; break;
+  case 79: expected_method = "WriteUnionWithSameTypeset()"; break;
+  case 80: expected_method = "WriteUnionWithTypesAdded()"; break;
+  case 81: expected_method = "WriteUnionWithTypesRemoved()"; break;
+  case 82: expected_method = "WriteRecordToOptional()"; break;
+  case 83: expected_method = "WriteRecordToAliasedOptional()"; break;
+  case 84: expected_method = "WriteRecordToUnion()"; break;
+  case 85: expected_method = "WriteRecordToAliasedUnion()"; break;
+  case 86: expected_method = "WriteUnionToAliasedUnion()"; break;
+  case 87: expected_method = "WriteUnionToAliasedUnionWithChanges()"; break;
+  case 88: expected_method = "WriteOptionalToAliasedOptional()"; break;
+  case 89: expected_method = "WriteOptionalToAliasedOptionalWithChanges()"; break;
+  case 90: expected_method = "WriteGenericRecord()"; break;
+  case 91: expected_method = "WriteGenericRecordToOpenAlias()"; break;
+  case 92: expected_method = "WriteGenericRecordToClosedAlias()"; break;
+  case 93: expected_method = "WriteGenericRecordToHalfClosedAlias()"; break;
+  case 94: expected_method = "WriteAliasedGenericRecordToAlias()"; break;
+  case 95: expected_method = "WriteGenericRecordToReversed()"; break;
+  case 96: expected_method = "WriteClosedGenericRecordToUnion()"; break;
+  case 97: expected_method = "WriteGenericRecordToAliasedUnion()"; break;
+  case 98: expected_method = "WriteGenericUnionToReversed()"; break;
+  case 99: expected_method = "WriteGenericUnionOfChangedRecord()"; break;
+  case 100: expected_method = "WriteGenericParentRecord()"; break;
+  case 101: expected_method = "WriteGenericNestedRecords()"; break;
+  case 102: expected_method = "WriteGenericRecordStream() or EndGenericRecordStream()"; break;
+  case 103: expected_method = "WriteGenericParentRecordStream() or EndGenericParentRecordStream()"; break;
+  case 104: expected_method = "WriteVectorRecordWithChanges()"; break;
+  case 105: expected_method = "WriteStreamedRecordWithChanges() or EndStreamedRecordWithChanges()"; break;
   }
   std::string attempted_method;
   switch (attempted) {
@@ -148,75 +154,81 @@ void ProtocolWithChangesWriterBaseInvalidState(uint8_t attempted, [[maybe_unused
   case 29: attempted_method = "WriteAliasedLongToString()"; break;
   case 30: attempted_method = "WriteStringToAliasedString()"; break;
   case 31: attempted_method = "WriteStringToAliasedInt()"; break;
-  case 32: attempted_method = "WriteEnumToAliasedEnum()"; break;
-  case 33: attempted_method = "WriteOptionalIntToUnion()"; break;
-  case 34: attempted_method = "WriteOptionalRecordToUnion()"; break;
-  case 35: attempted_method = "WriteRecordWithChanges()"; break;
-  case 36: attempted_method = "WriteAliasedRecordWithChanges()"; break;
-  case 37: attempted_method = "WriteRecordToRenamedRecord()"; break;
-  case 38: attempted_method = "WriteRecordToAliasedRecord()"; break;
-  case 39: attempted_method = "WriteRecordToAliasedAlias()"; break;
-  case 40: attempted_method = end ? "EndStreamIntToStringToFloat()" : "WriteStreamIntToStringToFloat()"; break;
-  case 41: attempted_method = "WriteVectorIntToStringToFloat()"; break;
-  case 42: attempted_method = "WriteIntFloatUnionReordered()"; break;
-  case 43: attempted_method = "WriteVectorUnionReordered()"; break;
-  case 44: attempted_method = end ? "EndStreamUnionReordered()" : "WriteStreamUnionReordered()"; break;
-  case 45: attempted_method = end ? "EndStreamOfAliasTypeChange()" : "WriteStreamOfAliasTypeChange()"; break;
-  case 46: attempted_method = "WriteRlink()"; break;
-  case 47: attempted_method = "WriteRlinkRX()"; break;
-  case 48: attempted_method = "WriteRlinkRY()"; break;
-  case 49: attempted_method = "WriteRlinkRZ()"; break;
-  case 50: attempted_method = "WriteRaRLink()"; break;
-  case 51: attempted_method = "WriteRaRX()"; break;
-  case 52: attempted_method = "WriteRaRY()"; break;
-  case 53: attempted_method = "WriteRaRZ()"; break;
-  case 54: attempted_method = "WriteRbRLink()"; break;
-  case 55: attempted_method = "WriteRbRX()"; break;
-  case 56: attempted_method = "WriteRbRY()"; break;
-  case 57: attempted_method = "WriteRbRZ()"; break;
-  case 58: attempted_method = "WriteRcRLink()"; break;
-  case 59: attempted_method = "WriteRcRX()"; break;
-  case 60: attempted_method = "WriteRcRY()"; break;
-  case 61: attempted_method = "WriteRcRZ()"; break;
-  case 62: attempted_method = "WriteRlinkRNew()"; break;
-  case 63: attempted_method = "WriteRaRNew()"; break;
-  case 64: attempted_method = "WriteRbRNew()"; break;
-  case 65: attempted_method = "WriteRcRNew()"; break;
-  case 66: attempted_method = "WriteRlinkRUnion()"; break;
-  case 67: attempted_method = "WriteRaRUnion()"; break;
-  case 68: attempted_method = "WriteRbRUnion()"; break;
-  case 69: attempted_method = "WriteRcRUnion()"; break;
-  case 70: attempted_method = "WriteOptionalRecordWithChanges()"; break;
-  case 71: attempted_method = "WriteAliasedOptionalRecordWithChanges()"; break;
-  case 72: attempted_method = "WriteUnionRecordWithChanges()"; break;
-  case 73: attempted_method = "WriteUnionWithSameTypeset()"; break;
-  case 74: attempted_method = "WriteUnionWithTypesAdded()"; break;
-  case 75: attempted_method = "WriteUnionWithTypesRemoved()"; break;
-  case 76: attempted_method = "WriteRecordToOptional()"; break;
-  case 77: attempted_method = "WriteRecordToAliasedOptional()"; break;
-  case 78: attempted_method = "WriteRecordToUnion()"; break;
-  case 79: attempted_method = "WriteRecordToAliasedUnion()"; break;
-  case 80: attempted_method = "WriteUnionToAliasedUnion()"; break;
-  case 81: attempted_method = "WriteUnionToAliasedUnionWithChanges()"; break;
-  case 82: attempted_method = "WriteOptionalToAliasedOptional()"; break;
-  case 83: attempted_method = "WriteOptionalToAliasedOptionalWithChanges()"; break;
-  case 84: attempted_method = "WriteGenericRecord()"; break;
-  case 85: attempted_method = "WriteGenericRecordToOpenAlias()"; break;
-  case 86: attempted_method = "WriteGenericRecordToClosedAlias()"; break;
-  case 87: attempted_method = "WriteGenericRecordToHalfClosedAlias()"; break;
-  case 88: attempted_method = "WriteAliasedGenericRecordToAlias()"; break;
-  case 89: attempted_method = "WriteGenericRecordToReversed()"; break;
-  case 90: attempted_method = "WriteClosedGenericRecordToUnion()"; break;
-  case 91: attempted_method = "WriteGenericRecordToAliasedUnion()"; break;
-  case 92: attempted_method = "WriteGenericUnionToReversed()"; break;
-  case 93: attempted_method = "WriteGenericUnionOfChangedRecord()"; break;
-  case 94: attempted_method = "WriteGenericParentRecord()"; break;
-  case 95: attempted_method = "WriteGenericNestedRecords()"; break;
-  case 96: attempted_method = end ? "EndGenericRecordStream()" : "WriteGenericRecordStream()"; break;
-  case 97: attempted_method = end ? "EndGenericParentRecordStream()" : "WriteGenericParentRecordStream()"; break;
-  case 98: attempted_method = "WriteVectorRecordWithChanges()"; break;
-  case 99: attempted_method = end ? "EndStreamedRecordWithChanges()" : "WriteStreamedRecordWithChanges()"; break;
-  case 100: attempted_method = "Close()"; break;
+  case 32: attempted_method = "WriteStringToBytes()"; break;
+  case 33: attempted_method = "WriteBytesToString()"; break;
+  case 34: attempted_method = "WriteVectorToBytes()"; break;
+  case 35: attempted_method = "WriteBytesToVector()"; break;
+  case 36: attempted_method = "WriteStringToUuid()"; break;
+  case 37: attempted_method = "WriteUuidToString()"; break;
+  case 38: attempted_method = "WriteEnumToAliasedEnum()"; break;
+  case 39: attempted_method = "WriteOptionalIntToUnion()"; break;
+  case 40: attempted_method = "WriteOptionalRecordToUnion()"; break;
+  case 41: attempted_method = "WriteRecordWithChanges()"; break;
+  case 42: attempted_method = "WriteAliasedRecordWithChanges()"; break;
+  case 43: attempted_method = "WriteRecordToRenamedRecord()"; break;
+  case 44: attempted_method = "WriteRecordToAliasedRecord()"; break;
+  case 45: attempted_method = "WriteRecordToAliasedAlias()"; break;
+  case 46: attempted_method = end ? "EndStreamIntToStringToFloat()" : "WriteStreamIntToStringToFloat()"; break;
+  case 47: attempted_method = "WriteVectorIntToStringToFloat()"; break;
+  case 48: attempted_method = "WriteIntFloatUnionReordered()"; break;
+  case 49: attempted_method = "WriteVectorUnionReordered()"; break;
+  case 50: attempted_method = end ? "EndStreamUnionReordered()" : "WriteStreamUnionReordered()"; break;
+  case 51: attempted_method = end ? "EndStreamOfAliasTypeChange()" : "WriteStreamOfAliasTypeChange()"; break;
+  case 52: attempted_method = "WriteRlink()"; break;
+  case 53: attempted_method = "WriteRlinkRX()"; break;
+  case 54: attempted_method = "WriteRlinkRY()"; break;
+  case 55: attempted_method = "WriteRlinkRZ()"; break;
+  case 56: attempted_method = "WriteRaRLink()"; break;
+  case 57: attempted_method = "WriteRaRX()"; break;
+  case 58: attempted_method = "WriteRaRY()"; break;
+  case 59: attempted_method = "WriteRaRZ()"; break;
+  case 60: attempted_method = "WriteRbRLink()"; break;
+  case 61: attempted_method = "WriteRbRX()"; break;
+  case 62: attempted_method = "WriteRbRY()"; break;
+  case 63: attempted_method = "WriteRbRZ()"; break;
+  case 64: attempted_method = "WriteRcRLink()"; break;
+  case 65: attempted_method = "WriteRcRX()"; break;
+  case 66: attempted_method = "WriteRcRY()"; break;
+  case 67: attempted_method = "WriteRcRZ()"; break;
+  case 68: attempted_method = "WriteRlinkRNew()"; break;
+  case 69: attempted_method = "WriteRaRNew()"; break;
+  case 70: attempted_method = "WriteRbRNew()"; break;
+  case 71: attempted_method = "WriteRcRNew()"; break;
+  case 72: attempted_method = "WriteRlinkRUnion()"; break;
+  case 73: attempted_method = "WriteRaRUnion()"; break;
+  case 74: attempted_method = "WriteRbRUnion()"; break;
+  case 75: attempted_method = "WriteRcRUnion()"; break;
+  case 76: attempted_method = "WriteOptionalRecordWithChanges()"; break;
+  case 77: attempted_method = "WriteAliasedOptionalRecordWithChanges()"; break;
+  case 78: attempted_method = "WriteUnionRecordWithChanges()"; break;
+  case 79: attempted_method = "WriteUnionWithSameTypeset()"; break;
+  case 80: attempted_method = "WriteUnionWithTypesAdded()"; break;
+  case 81: attempted_method = "WriteUnionWithTypesRemoved()"; break;
+  case 82: attempted_method = "WriteRecordToOptional()"; break;
+  case 83: attempted_method = "WriteRecordToAliasedOptional()"; break;
+  case 84: attempted_method = "WriteRecordToUnion()"; break;
+  case 85: attempted_method = "WriteRecordToAliasedUnion()"; break;
+  case 86: attempted_method = "WriteUnionToAliasedUnion()"; break;
+  case 87: attempted_method = "WriteUnionToAliasedUnionWithChanges()"; break;
+  case 88: attempted_method = "WriteOptionalToAliasedOptional()"; break;
+  case 89: attempted_method = "WriteOptionalToAliasedOptionalWithChanges()"; break;
+  case 90: attempted_method = "WriteGenericRecord()"; break;
+  case 91: attempted_method = "WriteGenericRecordToOpenAlias()"; break;
+  case 92: attempted_method = "WriteGenericRecordToClosedAlias()"; break;
+  case 93: attempted_method = "WriteGenericRecordToHalfClosedAlias()"; break;
+  case 94: attempted_method = "WriteAliasedGenericRecordToAlias()"; break;
+  case 95: attempted_method = "WriteGenericRecordToReversed()"; break;
+  case 96: attempted_method = "WriteClosedGenericRecordToUnion()"; break;
+  case 97: attempted_method = "WriteGenericRecordToAliasedUnion()"; break;
+  case 98: attempted_method = "WriteGenericUnionToReversed()"; break;
+  case 99: attempted_method = "WriteGenericUnionOfChangedRecord()"; break;
+  case 100: attempted_method = "WriteGenericParentRecord()"; break;
+  case 101: attempted_method = "WriteGenericNestedRecords()"; break;
+  case 102: attempted_method = end ? "EndGenericRecordStream()" : "WriteGenericRecordStream()"; break;
+  case 103: attempted_method = end ? "EndGenericParentRecordStream()" : "WriteGenericParentRecordStream()"; break;
+  case 104: attempted_method = "WriteVectorRecordWithChanges()"; break;
+  case 105: attempted_method = end ? "EndStreamedRecordWithChanges()" : "WriteStreamedRecordWithChanges()"; break;
+  case 106: attempted_method = "Close()"; break;
   }
   throw std::runtime_error("Expected call to " + expected_method + " but received call to " + attempted_method + " instead.");
 }
@@ -256,75 +268,81 @@ void ProtocolWithChangesReaderBaseInvalidState(uint8_t attempted, uint8_t curren
     case 29: return "ReadAliasedLongToString()";
     case 30: return "ReadStringToAliasedString()";
     case 31: return "ReadStringToAliasedInt()";
-    case 32: return "ReadEnumToAliasedEnum()";
-    case 33: return "ReadOptionalIntToUnion()";
-    case 34: return "ReadOptionalRecordToUnion()";
-    case 35: return "ReadRecordWithChanges()";
-    case 36: return "ReadAliasedRecordWithChanges()";
-    case 37: return "ReadRecordToRenamedRecord()";
-    case 38: return "ReadRecordToAliasedRecord()";
-    case 39: return "ReadRecordToAliasedAlias()";
-    case 40: return "ReadStreamIntToStringToFloat()";
-    case 41: return "ReadVectorIntToStringToFloat()";
-    case 42: return "ReadIntFloatUnionReordered()";
-    case 43: return "ReadVectorUnionReordered()";
-    case 44: return "ReadStreamUnionReordered()";
-    case 45: return "ReadStreamOfAliasTypeChange()";
-    case 46: return "ReadRlink()";
-    case 47: return "ReadRlinkRX()";
-    case 48: return "ReadRlinkRY()";
-    case 49: return "ReadRlinkRZ()";
-    case 50: return "ReadRaRLink()";
-    case 51: return "ReadRaRX()";
-    case 52: return "ReadRaRY()";
-    case 53: return "ReadRaRZ()";
-    case 54: return "ReadRbRLink()";
-    case 55: return "ReadRbRX()";
-    case 56: return "ReadRbRY()";
-    case 57: return "ReadRbRZ()";
-    case 58: return "ReadRcRLink()";
-    case 59: return "ReadRcRX()";
-    case 60: return "ReadRcRY()";
-    case 61: return "ReadRcRZ()";
-    case 62: return "ReadRlinkRNew()";
-    case 63: return "ReadRaRNew()";
-    case 64: return "ReadRbRNew()";
-    case 65: return "ReadRcRNew()";
-    case 66: return "ReadRlinkRUnion()";
-    case 67: return "ReadRaRUnion()";
-    case 68: return "ReadRbRUnion()";
-    case 69: return "ReadRcRUnion()";
-    case 70: return "ReadOptionalRecordWithChanges()";
-    case 71: return "ReadAliasedOptionalRecordWithChanges()";
-    case 72: return "ReadUnionRecordWithChanges()";
-    case 73: return "ReadUnionWithSameTypeset()";
-    case 74: return "ReadUnionWithTypesAdded()";
-    case 75: return "ReadUnionWithTypesRemoved()";
-    case 76: return "ReadRecordToOptional()";
-    case 77: return "ReadRecordToAliasedOptional()";
-    case 78: return "ReadRecordToUnion()";
-    case 79: return "ReadRecordToAliasedUnion()";
-    case 80: return "ReadUnionToAliasedUnion()";
-    case 81: return "ReadUnionToAliasedUnionWithChanges()";
-    case 82: return "ReadOptionalToAliasedOptional()";
-    case 83: return "ReadOptionalToAliasedOptionalWithChanges()";
-    case 84: return "ReadGenericRecord()";
-    case 85: return "ReadGenericRecordToOpenAlias()";
-    case 86: return "ReadGenericRecordToClosedAlias()";
-    case 87: return "ReadGenericRecordToHalfClosedAlias()";
-    case 88: return "ReadAliasedGenericRecordToAlias()";
-    case 89: return "ReadGenericRecordToReversed()";
-    case 90: return "ReadClosedGenericRecordToUnion()";
-    case 91: return "ReadGenericRecordToAliasedUnion()";
-    case 92: return "ReadGenericUnionToReversed()";
-    case 93: return "ReadGenericUnionOfChangedRecord()";
-    case 94: return "ReadGenericParentRecord()";
-    case 95: return "ReadGenericNestedRecords()";
-    case 96: return "ReadGenericRecordStream()";
-    case 97: return "ReadGenericParentRecordStream()";
-    case 98: return "ReadVectorRecordWithChanges()";
-    case 99: return "ReadStreamedRecordWithChanges()";
-    case 100: return "Close()";
+    case 32: return "ReadStringToBytes()";
+    case 33: return "ReadBytesToString()";
+    case 34: return "ReadVectorToBytes()";
+    case 35: return "ReadBytesToVector()";
+    case 36: return "ReadStringToUuid()";
+    case 37: return "ReadUuidToString()";
+    case 38: return "ReadEnumToAliasedEnum()";
+    case 39: return "ReadOptionalIntToUnion()";
+    case 40: return "ReadOptionalRecordToUnion()";
+    case 41: return "ReadRecordWithChanges()";
+    case 42: return "ReadAliasedRecordWithChanges()";
+    case 43: return "ReadRecordToRenamedRecord()";
+    case 44: return "ReadRecordToAliasedRecord()";
+    case 45: return "ReadRecordToAliasedAlias()";
+    case 46: return "ReadStreamIntToStringToFloat()";
+    case 47: return "ReadVectorIntToStringToFloat()";
+    case 48: return "ReadIntFloatUnionReordered()";
+    case 49: return "ReadVectorUnionReordered()";
+    case 50: return "ReadStreamUnionReordered()";
+    case 51: return "ReadStreamOfAliasTypeChange()";
+    case 52: return "ReadRlink()";
+    case 53: return "ReadRlinkRX()";
+    case 54: return "ReadRlinkRY()";
+    case 55: return "ReadRlinkRZ()";
+    case 56: return "ReadRaRLink()";
+    case 57: return "ReadRaRX()";
+    case 58: return "ReadRaRY()";
+    case 59: return "ReadRaRZ()";
+    case 60: return "ReadRbRLink()";
+    case 61: return "ReadRbRX()";
+    case 62: return "ReadRbRY()";
+    case 63: return "ReadRbRZ()";
+    case 64: return "ReadRcRLink()";
+    case 65: return "ReadRcRX()";
+    case 66: return "ReadRcRY()";
+    case 67: return "ReadRcRZ()";
+    case 68: return "ReadRlinkRNew()";
+    case 69: return "ReadRaRNew()";
+    case 70: return "ReadRbRNew()";
+    case 71: return "ReadRcRNew()";
+    case 72: return "ReadRlinkRUnion()";
+    case 73: return "ReadRaRUnion()";
+    case 74: return "ReadRbRUnion()";
+    case 75: return "ReadRcRUnion()";
+    case 76: return "ReadOptionalRecordWithChanges()";
+    case 77: return "ReadAliasedOptionalRecordWithChanges()";
+    case 78: return "ReadUnionRecordWithChanges()";
+    case 79: return "ReadUnionWithSameTypeset()";
+    case 80: return "ReadUnionWithTypesAdded()";
+    case 81: return "ReadUnionWithTypesRemoved()";
+    case 82: return "ReadRecordToOptional()";
+    case 83: return "ReadRecordToAliasedOptional()";
+    case 84: return "ReadRecordToUnion()";
+    case 85: return "ReadRecordToAliasedUnion()";
+    case 86: return "ReadUnionToAliasedUnion()";
+    case 87: return "ReadUnionToAliasedUnionWithChanges()";
+    case 88: return "ReadOptionalToAliasedOptional()";
+    case 89: return "ReadOptionalToAliasedOptionalWithChanges()";
+    case 90: return "ReadGenericRecord()";
+    case 91: return "ReadGenericRecordToOpenAlias()";
+    case 92: return "ReadGenericRecordToClosedAlias()";
+    case 93: return "ReadGenericRecordToHalfClosedAlias()";
+    case 94: return "ReadAliasedGenericRecordToAlias()";
+    case 95: return "ReadGenericRecordToReversed()";
+    case 96: return "ReadClosedGenericRecordToUnion()";
+    case 97: return "ReadGenericRecordToAliasedUnion()";
+    case 98: return "ReadGenericUnionToReversed()";
+    case 99: return "ReadGenericUnionOfChangedRecord()";
+    case 100: return "ReadGenericParentRecord()";
+    case 101: return "ReadGenericNestedRecords()";
+    case 102: return "ReadGenericRecordStream()";
+    case 103: return "ReadGenericParentRecordStream()";
+    case 104: return "ReadVectorRecordWithChanges()";
+    case 105: return "ReadStreamedRecordWithChanges()";
+    case 106: return "Close()";
     default: return "<unknown>";
     }
   };
@@ -333,7 +351,7 @@ void ProtocolWithChangesReaderBaseInvalidState(uint8_t attempted, uint8_t curren
 
 } // namespace 
 
-std::string ProtocolWithChangesWriterBase::schema_ = R"({"protocol":{"name":"ProtocolWithChanges","sequence":[{"name":"int8ToInt","type":"int8"},{"name":"int8ToLong","type":"int8"},{"name":"int8ToUint","type":"int8"},{"name":"int8ToUlong","type":"int8"},{"name":"int8ToFloat","type":"int8"},{"name":"int8ToDouble","type":"int8"},{"name":"intToUint","type":"int32"},{"name":"intToLong","type":"int32"},{"name":"intToFloat","type":"int32"},{"name":"intToDouble","type":"int32"},{"name":"uintToUlong","type":"uint32"},{"name":"uintToFloat","type":"uint32"},{"name":"uintToDouble","type":"uint32"},{"name":"floatToDouble","type":"float32"},{"name":"complexFloatToComplexDouble","type":"complexfloat32"},{"name":"intToString","type":"int32"},{"name":"uintToString","type":"uint32"},{"name":"longToString","type":"int64"},{"name":"ulongToString","type":"uint64"},{"name":"floatToString","type":"float32"},{"name":"doubleToString","type":"float64"},{"name":"intToOptional","type":"int32"},{"name":"floatToOptional","type":"float32"},{"name":"stringToOptional","type":"string"},{"name":"intToUnion","type":"int32"},{"name":"floatToUnion","type":"float32"},{"name":"stringToUnion","type":"string"},{"name":"optionalIntToFloat","type":[null,"int32"]},{"name":"optionalFloatToString","type":[null,"float32"]},{"name":"aliasedLongToString","type":"EvoTest.AliasedLongToString"},{"name":"stringToAliasedString","type":"string"},{"name":"stringToAliasedInt","type":"string"},{"name":"enumToAliasedEnum","type":"EvoTest.GrowingEnum"},{"name":"optionalIntToUnion","type":[null,"int32"]},{"name":"optionalRecordToUnion","type":[null,"EvoTest.RecordWithChanges"]},{"name":"recordWithChanges","type":"EvoTest.RecordWithChanges"},{"name":"aliasedRecordWithChanges","type":"EvoTest.AliasedRecordWithChanges"},{"name":"recordToRenamedRecord","type":"EvoTest.RenamedRecord"},{"name":"recordToAliasedRecord","type":"EvoTest.RecordWithChanges"},{"name":"recordToAliasedAlias","type":"EvoTest.RecordWithChanges"},{"name":"streamIntToStringToFloat","type":{"stream":{"items":"int32"}}},{"name":"vectorIntToStringToFloat","type":{"vector":{"items":"int32"}}},{"name":"intFloatUnionReordered","type":[{"tag":"int32","type":"int32"},{"tag":"float32","type":"float32"}]},{"name":"vectorUnionReordered","type":{"vector":{"items":[{"tag":"int32","type":"int32"},{"tag":"float32","type":"float32"}]}}},{"name":"streamUnionReordered","type":{"stream":{"items":[{"tag":"int32","type":"int32"},{"tag":"string","type":"string"}]}}},{"name":"streamOfAliasTypeChange","type":{"stream":{"items":"EvoTest.StreamItem"}}},{"name":"rlink","type":"EvoTest.RLink"},{"name":"rlinkRX","type":"EvoTest.RLink"},{"name":"rlinkRY","type":"EvoTest.RLink"},{"name":"rlinkRZ","type":"EvoTest.RLink"},{"name":"raRLink","type":"EvoTest.RA"},{"name":"raRX","type":"EvoTest.RA"},{"name":"raRY","type":"EvoTest.RA"},{"name":"raRZ","type":"EvoTest.RA"},{"name":"rbRLink","type":"EvoTest.RB"},{"name":"rbRX","type":"EvoTest.RB"},{"name":"rbRY","type":"EvoTest.RB"},{"name":"rbRZ","type":"EvoTest.RB"},{"name":"rcRLink","type":"EvoTest.RC"},{"name":"rcRX","type":"EvoTest.RC"},{"name":"rcRY","type":"EvoTest.RC"},{"name":"rcRZ","type":"EvoTest.RC"},{"name":"rlinkRNew","type":"EvoTest.RLink"},{"name":"raRNew","type":"EvoTest.RA"},{"name":"rbRNew","type":"EvoTest.RB"},{"name":"rcRNew","type":"EvoTest.RC"},{"name":"rlinkRUnion","type":"EvoTest.RLink"},{"name":"raRUnion","type":"EvoTest.RA"},{"name":"rbRUnion","type":"EvoTest.RB"},{"name":"rcRUnion","type":"EvoTest.RC"},{"name":"optionalRecordWithChanges","type":[null,"EvoTest.RecordWithChanges"]},{"name":"aliasedOptionalRecordWithChanges","type":[null,"EvoTest.AliasedRecordWithChanges"]},{"name":"unionRecordWithChanges","type":[{"tag":"RecordWithChanges","type":"EvoTest.RecordWithChanges"},{"tag":"int32","type":"int32"}]},{"name":"unionWithSameTypeset","type":[{"tag":"RecordWithChanges","type":"EvoTest.RecordWithChanges"},{"tag":"int32","type":"int32"},{"tag":"float32","type":"float32"},{"tag":"string","type":"string"}]},{"name":"unionWithTypesAdded","type":[{"tag":"RecordWithChanges","type":"EvoTest.RecordWithChanges"},{"tag":"float32","type":"float32"}]},{"name":"unionWithTypesRemoved","type":[{"tag":"RecordWithChanges","type":"EvoTest.RecordWithChanges"},{"tag":"int32","type":"int32"},{"tag":"float32","type":"float32"},{"tag":"string","type":"string"}]},{"name":"recordToOptional","type":"EvoTest.RecordWithChanges"},{"name":"recordToAliasedOptional","type":"EvoTest.RecordWithChanges"},{"name":"recordToUnion","type":"EvoTest.RecordWithChanges"},{"name":"recordToAliasedUnion","type":"EvoTest.RecordWithChanges"},{"name":"unionToAliasedUnion","type":[{"tag":"RecordWithChanges","type":"EvoTest.RecordWithChanges"},{"tag":"int32","type":"int32"}]},{"name":"unionToAliasedUnionWithChanges","type":[{"tag":"RecordWithChanges","type":"EvoTest.RecordWithChanges"},{"tag":"int32","type":"int32"}]},{"name":"optionalToAliasedOptional","type":[null,"EvoTest.RecordWithChanges"]},{"name":"optionalToAliasedOptionalWithChanges","type":[null,"int32"]},{"name":"genericRecord","type":{"name":"EvoTest.GenericRecord","typeArguments":["int32","string"]}},{"name":"genericRecordToOpenAlias","type":{"name":"EvoTest.GenericRecord","typeArguments":["int32","string"]}},{"name":"genericRecordToClosedAlias","type":{"name":"EvoTest.GenericRecord","typeArguments":["int32","string"]}},{"name":"genericRecordToHalfClosedAlias","type":{"name":"EvoTest.GenericRecord","typeArguments":["int32","string"]}},{"name":"aliasedGenericRecordToAlias","type":{"name":"EvoTest.AliasedHalfClosedGenericRecord","typeArguments":["int32"]}},{"name":"genericRecordToReversed","type":{"name":"EvoTest.GenericRecord","typeArguments":["int32","string"]}},{"name":"closedGenericRecordToUnion","type":"EvoTest.AliasedClosedGenericRecord"},{"name":"genericRecordToAliasedUnion","type":{"name":"EvoTest.GenericRecord","typeArguments":["int32","string"]}},{"name":"genericUnionToReversed","type":"EvoTest.AliasedClosedGenericUnion"},{"name":"genericUnionOfChangedRecord","type":"EvoTest.AliasedClosedGenericUnion"},{"name":"genericParentRecord","type":{"name":"EvoTest.GenericParentRecord","typeArguments":["int32"]}},{"name":"genericNestedRecords","type":{"name":"EvoTest.GenericRecord","typeArguments":[{"name":"EvoTest.UnchangedGeneric","typeArguments":["int32"]},{"name":"EvoTest.ChangedGeneric","typeArguments":["string","int32"]}]}},{"name":"genericRecordStream","type":{"stream":{"items":{"name":"EvoTest.GenericRecord","typeArguments":["int32","string"]}}}},{"name":"genericParentRecordStream","type":{"stream":{"items":{"name":"EvoTest.GenericParentRecord","typeArguments":["int32"]}}}},{"name":"vectorRecordWithChanges","type":{"vector":{"items":"EvoTest.RecordWithChanges"}}},{"name":"streamedRecordWithChanges","type":{"stream":{"items":"EvoTest.RecordWithChanges"}}}]},"types":[{"name":"AliasedClosedGenericRecord","type":{"name":"EvoTest.AliasedHalfClosedGenericRecord","typeArguments":["int32"]}},{"name":"AliasedClosedGenericUnion","type":{"name":"EvoTest.AliasedHalfClosedGenericUnion","typeArguments":[{"name":"EvoTest.GenericRecord","typeArguments":["int32","string"]}]}},{"name":"AliasedHalfClosedGenericRecord","typeParameters":["T"],"type":{"name":"EvoTest.GenericRecord","typeArguments":["T","string"]}},{"name":"AliasedHalfClosedGenericUnion","typeParameters":["T"],"type":{"name":"EvoTest.GenericUnion","typeArguments":["T","float32"]}},{"name":"AliasedLongToString","type":"int64"},{"name":"AliasedRecordWithChanges","type":"EvoTest.RecordWithChanges"},{"name":"ChangedGeneric","typeParameters":["Y","Z"],"fields":[{"name":"y","type":"Y"},{"name":"z","type":{"name":"EvoTest.UnchangedGeneric","typeArguments":["Z"]}}]},{"name":"GenericParentRecord","typeParameters":["T"],"fields":[{"name":"record","type":{"name":"EvoTest.GenericRecord","typeArguments":["T","string"]}},{"name":"recordOfUnion","type":{"name":"EvoTest.GenericRecord","typeArguments":[{"name":"EvoTest.GenericUnion","typeArguments":["T","float32"]},"string"]}},{"name":"unionOfRecord","type":{"name":"EvoTest.GenericUnion","typeArguments":[{"name":"EvoTest.GenericRecord","typeArguments":["int32","string"]},"float32"]}}]},{"name":"GenericRecord","typeParameters":["T1","T2"],"fields":[{"name":"removed","type":[null,"bool"]},{"name":"field1","type":"T1"},{"name":"field2","type":"T2"}]},{"name":"GenericUnion","typeParameters":["T1","T2"],"type":[{"tag":"T1","type":"T1"},{"tag":"T2","type":"T2"}]},{"name":"GrowingEnum","base":"uint16","values":[{"symbol":"a","value":0},{"symbol":"b","value":1},{"symbol":"c","value":2}]},{"name":"RA","type":"EvoTest.RB"},{"name":"RB","type":"EvoTest.RC"},{"name":"RC","fields":[{"name":"subject","type":"string"}]},{"name":"RLink","type":"EvoTest.RA"},{"name":"RecordWithChanges","fields":[{"name":"deprecatedFloat","type":"float32"},{"name":"intToLong","type":"int32"},{"name":"deprecatedVector","type":{"vector":{"items":"int32"}}},{"name":"floatToDouble","type":"float32"},{"name":"deprecatedArray","type":{"array":{"items":"uint8","dimensions":[{"length":7}]}}},{"name":"optionalLongToString","type":[null,"int64"]},{"name":"deprecatedMap","type":{"map":{"keys":"string","values":{"vector":{"items":"int32"}}}}},{"name":"unchangedRecord","type":"EvoTest.UnchangedRecord"}]},{"name":"RenamedRecord","fields":[{"name":"i","type":"int32"},{"name":"s","type":"string"}]},{"name":"StreamItem","type":"EvoTest.RecordWithChanges"},{"name":"UnchangedGeneric","typeParameters":["T2"],"fields":[{"name":"field","type":"T2"}]},{"name":"UnchangedRecord","fields":[{"name":"name","type":"string"},{"name":"age","type":"int32"},{"name":"meta","type":{"map":{"keys":"string","values":"float64"}}}]}]})";
+std::string ProtocolWithChangesWriterBase::schema_ = R"({"protocol":{"name":"ProtocolWithChanges","sequence":[{"name":"int8ToInt","type":"int8"},{"name":"int8ToLong","type":"int8"},{"name":"int8ToUint","type":"int8"},{"name":"int8ToUlong","type":"int8"},{"name":"int8ToFloat","type":"int8"},{"name":"int8ToDouble","type":"int8"},{"name":"intToUint","type":"int32"},{"name":"intToLong","type":"int32"},{"name":"intToFloat","type":"int32"},{"name":"intToDouble","type":"int32"},{"name":"uintToUlong","type":"uint32"},{"name":"uintToFloat","type":"uint32"},{"name":"uintToDouble","type":"uint32"},{"name":"floatToDouble","type":"float32"},{"name":"complexFloatToComplexDouble","type":"complexfloat32"},{"name":"intToString","type":"int32"},{"name":"uintToString","type":"uint32"},{"name":"longToString","type":"int64"},{"name":"ulongToString","type":"uint64"},{"name":"floatToString","type":"float32"},{"name":"doubleToString","type":"float64"},{"name":"intToOptional","type":"int32"},{"name":"floatToOptional","type":"float32"},{"name":"stringToOptional","type":"string"},{"name":"intToUnion","type":"int32"},{"name":"floatToUnion","type":"float32"},{"name":"stringToUnion","type":"string"},{"name":"optionalIntToFloat","type":[null,"int32"]},{"name":"optionalFloatToString","type":[null,"float32"]},{"name":"aliasedLongToString","type":"EvoTest.AliasedLongToString"},{"name":"stringToAliasedString","type":"string"},{"name":"stringToAliasedInt","type":"string"},{"name":"stringToBytes","type":"string"},{"name":"bytesToString","type":"bytes"},{"name":"vectorToBytes","type":{"vector":{"items":"uint8"}}},{"name":"bytesToVector","type":"bytes"},{"name":"stringToUuid","type":"string"},{"name":"uuidToString","type":"uuid"},{"name":"enumToAliasedEnum","type":"EvoTest.GrowingEnum"},{"name":"optionalIntToUnion","type":[null,"int32"]},{"name":"optionalRecordToUnion","type":[null,"EvoTest.RecordWithChanges"]},{"name":"recordWithChanges","type":"EvoTest.RecordWithChanges"},{"name":"aliasedRecordWithChanges","type":"EvoTest.AliasedRecordWithChanges"},{"name":"recordToRenamedRecord","type":"EvoTest.RenamedRecord"},{"name":"recordToAliasedRecord","type":"EvoTest.RecordWithChanges"},{"name":"recordToAliasedAlias","type":"EvoTest.RecordWithChanges"},{"name":"streamIntToStringToFloat","type":{"stream":{"items":"int32"}}},{"name":"vectorIntToStringToFloat","type":{"vector":{"items":"int32"}}},{"name":"intFloatUnionReordered","type":[{"tag":"int32","type":"int32"},{"tag":"float32","type":"float32"}]},{"name":"vectorUnionReordered","type":{"vector":{"items":[{"tag":"int32","type":"int32"},{"tag":"float32","type":"float32"}]}}},{"name":"streamUnionReordered","type":{"stream":{"items":[{"tag":"int32","type":"int32"},{"tag":"string","type":"string"}]}}},{"name":"streamOfAliasTypeChange","type":{"stream":{"items":"EvoTest.StreamItem"}}},{"name":"rlink","type":"EvoTest.RLink"},{"name":"rlinkRX","type":"EvoTest.RLink"},{"name":"rlinkRY","type":"EvoTest.RLink"},{"name":"rlinkRZ","type":"EvoTest.RLink"},{"name":"raRLink","type":"EvoTest.RA"},{"name":"raRX","type":"EvoTest.RA"},{"name":"raRY","type":"EvoTest.RA"},{"name":"raRZ","type":"EvoTest.RA"},{"name":"rbRLink","type":"EvoTest.RB"},{"name":"rbRX","type":"EvoTest.RB"},{"name":"rbRY","type":"EvoTest.RB"},{"name":"rbRZ","type":"EvoTest.RB"},{"name":"rcRLink","type":"EvoTest.RC"},{"name":"rcRX","type":"EvoTest.RC"},{"name":"rcRY","type":"EvoTest.RC"},{"name":"rcRZ","type":"EvoTest.RC"},{"name":"rlinkRNew","type":"EvoTest.RLink"},{"name":"raRNew","type":"EvoTest.RA"},{"name":"rbRNew","type":"EvoTest.RB"},{"name":"rcRNew","type":"EvoTest.RC"},{"name":"rlinkRUnion","type":"EvoTest.RLink"},{"name":"raRUnion","type":"EvoTest.RA"},{"name":"rbRUnion","type":"EvoTest.RB"},{"name":"rcRUnion","type":"EvoTest.RC"},{"name":"optionalRecordWithChanges","type":[null,"EvoTest.RecordWithChanges"]},{"name":"aliasedOptionalRecordWithChanges","type":[null,"EvoTest.AliasedRecordWithChanges"]},{"name":"unionRecordWithChanges","type":[{"tag":"RecordWithChanges","type":"EvoTest.RecordWithChanges"},{"tag":"int32","type":"int32"}]},{"name":"unionWithSameTypeset","type":[{"tag":"RecordWithChanges","type":"EvoTest.RecordWithChanges"},{"tag":"int32","type":"int32"},{"tag":"float32","type":"float32"},{"tag":"string","type":"string"}]},{"name":"unionWithTypesAdded","type":[{"tag":"RecordWithChanges","type":"EvoTest.RecordWithChanges"},{"tag":"float32","type":"float32"}]},{"name":"unionWithTypesRemoved","type":[{"tag":"RecordWithChanges","type":"EvoTest.RecordWithChanges"},{"tag":"int32","type":"int32"},{"tag":"float32","type":"float32"},{"tag":"string","type":"string"}]},{"name":"recordToOptional","type":"EvoTest.RecordWithChanges"},{"name":"recordToAliasedOptional","type":"EvoTest.RecordWithChanges"},{"name":"recordToUnion","type":"EvoTest.RecordWithChanges"},{"name":"recordToAliasedUnion","type":"EvoTest.RecordWithChanges"},{"name":"unionToAliasedUnion","type":[{"tag":"RecordWithChanges","type":"EvoTest.RecordWithChanges"},{"tag":"int32","type":"int32"}]},{"name":"unionToAliasedUnionWithChanges","type":[{"tag":"RecordWithChanges","type":"EvoTest.RecordWithChanges"},{"tag":"int32","type":"int32"}]},{"name":"optionalToAliasedOptional","type":[null,"EvoTest.RecordWithChanges"]},{"name":"optionalToAliasedOptionalWithChanges","type":[null,"int32"]},{"name":"genericRecord","type":{"name":"EvoTest.GenericRecord","typeArguments":["int32","string"]}},{"name":"genericRecordToOpenAlias","type":{"name":"EvoTest.GenericRecord","typeArguments":["int32","string"]}},{"name":"genericRecordToClosedAlias","type":{"name":"EvoTest.GenericRecord","typeArguments":["int32","string"]}},{"name":"genericRecordToHalfClosedAlias","type":{"name":"EvoTest.GenericRecord","typeArguments":["int32","string"]}},{"name":"aliasedGenericRecordToAlias","type":{"name":"EvoTest.AliasedHalfClosedGenericRecord","typeArguments":["int32"]}},{"name":"genericRecordToReversed","type":{"name":"EvoTest.GenericRecord","typeArguments":["int32","string"]}},{"name":"closedGenericRecordToUnion","type":"EvoTest.AliasedClosedGenericRecord"},{"name":"genericRecordToAliasedUnion","type":{"name":"EvoTest.GenericRecord","typeArguments":["int32","string"]}},{"name":"genericUnionToReversed","type":"EvoTest.AliasedClosedGenericUnion"},{"name":"genericUnionOfChangedRecord","type":"EvoTest.AliasedClosedGenericUnion"},{"name":"genericParentRecord","type":{"name":"EvoTest.GenericParentRecord","typeArguments":["int32"]}},{"name":"genericNestedRecords","type":{"name":"EvoTest.GenericRecord","typeArguments":[{"name":"EvoTest.UnchangedGeneric","typeArguments":["int32"]},{"name":"EvoTest.ChangedGeneric","typeArguments":["string","int32"]}]}},{"name":"genericRecordStream","type":{"stream":{"items":{"name":"EvoTest.GenericRecord","typeArguments":["int32","string"]}}}},{"name":"genericParentRecordStream","type":{"stream":{"items":{"name":"EvoTest.GenericParentRecord","typeArguments":["int32"]}}}},{"name":"vectorRecordWithChanges","type":{"vector":{"items":"EvoTest.RecordWithChanges"}}},{"name":"streamedRecordWithChanges","type":{"stream":{"items":"EvoTest.RecordWithChanges"}}}]},"types":[{"name":"AliasedClosedGenericRecord","type":{"name":"EvoTest.AliasedHalfClosedGenericRecord","typeArguments":["int32"]}},{"name":"AliasedClosedGenericUnion","type":{"name":"EvoTest.AliasedHalfClosedGenericUnion","typeArguments":[{"name":"EvoTest.GenericRecord","typeArguments":["int32","string"]}]}},{"name":"AliasedHalfClosedGenericRecord","typeParameters":["T"],"type":{"name":"EvoTest.GenericRecord","typeArguments":["T","string"]}},{"name":"AliasedHalfClosedGenericUnion","typeParameters":["T"],"type":{"name":"EvoTest.GenericUnion","typeArguments":["T","float32"]}},{"name":"AliasedLongToString","type":"int64"},{"name":"AliasedRecordWithChanges","type":"EvoTest.RecordWithChanges"},{"name":"ChangedGeneric","typeParameters":["Y","Z"],"fields":[{"name":"y","type":"Y"},{"name":"z","type":{"name":"EvoTest.UnchangedGeneric","typeArguments":["Z"]}}]},{"name":"GenericParentRecord","typeParameters":["T"],"fields":[{"name":"record","type":{"name":"EvoTest.GenericRecord","typeArguments":["T","string"]}},{"name":"recordOfUnion","type":{"name":"EvoTest.GenericRecord","typeArguments":[{"name":"EvoTest.GenericUnion","typeArguments":["T","float32"]},"string"]}},{"name":"unionOfRecord","type":{"name":"EvoTest.GenericUnion","typeArguments":[{"name":"EvoTest.GenericRecord","typeArguments":["int32","string"]},"float32"]}}]},{"name":"GenericRecord","typeParameters":["T1","T2"],"fields":[{"name":"removed","type":[null,"bool"]},{"name":"field1","type":"T1"},{"name":"field2","type":"T2"}]},{"name":"GenericUnion","typeParameters":["T1","T2"],"type":[{"tag":"T1","type":"T1"},{"tag":"T2","type":"T2"}]},{"name":"GrowingEnum","base":"uint16","values":[{"symbol":"a","value":0},{"symbol":"b","value":1},{"symbol":"c","value":2}]},{"name":"RA","type":"EvoTest.RB"},{"name":"RB","type":"EvoTest.RC"},{"name":"RC","fields":[{"name":"subject","type":"string"}]},{"name":"RLink","type":"EvoTest.RA"},{"name":"RecordWithChanges","fields":[{"name":"deprecatedFloat","type":"float32"},{"name":"intToLong","type":"int32"},{"name":"deprecatedVector","type":{"vector":{"items":"int32"}}},{"name":"floatToDouble","type":"float32"},{"name":"deprecatedArray","type":{"array":{"items":"uint8","dimensions":[{"length":7}]}}},{"name":"optionalLongToString","type":[null,"int64"]},{"name":"deprecatedMap","type":{"map":{"keys":"string","values":{"vector":{"items":"int32"}}}}},{"name":"unchangedRecord","type":"EvoTest.UnchangedRecord"}]},{"name":"RenamedRecord","fields":[{"name":"i","type":"int32"},{"name":"s","type":"string"}]},{"name":"StreamItem","type":"EvoTest.RecordWithChanges"},{"name":"UnchangedGeneric","typeParameters":["T2"],"fields":[{"name":"field","type":"T2"}]},{"name":"UnchangedRecord","fields":[{"name":"name","type":"string"},{"name":"age","type":"int32"},{"name":"meta","type":{"map":{"keys":"string","values":"float64"}}}]}]})";
 
 std::vector<std::string> ProtocolWithChangesWriterBase::previous_schemas_ = {
 };
@@ -633,101 +651,155 @@ void ProtocolWithChangesWriterBase::WriteStringToAliasedInt(std::string const& v
   state_ = 32;
 }
 
-void ProtocolWithChangesWriterBase::WriteEnumToAliasedEnum(evo_test::GrowingEnum const& value) {
+void ProtocolWithChangesWriterBase::WriteStringToBytes(std::string const& value) {
   if (unlikely(state_ != 32)) {
     ProtocolWithChangesWriterBaseInvalidState(32, false, state_);
   }
 
-  WriteEnumToAliasedEnumImpl(value);
+  WriteStringToBytesImpl(value);
   state_ = 33;
 }
 
-void ProtocolWithChangesWriterBase::WriteOptionalIntToUnion(std::optional<int32_t> const& value) {
+void ProtocolWithChangesWriterBase::WriteBytesToString(std::vector<std::byte> const& value) {
   if (unlikely(state_ != 33)) {
     ProtocolWithChangesWriterBaseInvalidState(33, false, state_);
   }
 
-  WriteOptionalIntToUnionImpl(value);
+  WriteBytesToStringImpl(value);
   state_ = 34;
 }
 
-void ProtocolWithChangesWriterBase::WriteOptionalRecordToUnion(std::optional<evo_test::RecordWithChanges> const& value) {
+void ProtocolWithChangesWriterBase::WriteVectorToBytes(std::vector<uint8_t> const& value) {
   if (unlikely(state_ != 34)) {
     ProtocolWithChangesWriterBaseInvalidState(34, false, state_);
   }
 
-  WriteOptionalRecordToUnionImpl(value);
+  WriteVectorToBytesImpl(value);
   state_ = 35;
 }
 
-void ProtocolWithChangesWriterBase::WriteRecordWithChanges(evo_test::RecordWithChanges const& value) {
+void ProtocolWithChangesWriterBase::WriteBytesToVector(std::vector<std::byte> const& value) {
   if (unlikely(state_ != 35)) {
     ProtocolWithChangesWriterBaseInvalidState(35, false, state_);
   }
 
-  WriteRecordWithChangesImpl(value);
+  WriteBytesToVectorImpl(value);
   state_ = 36;
 }
 
-void ProtocolWithChangesWriterBase::WriteAliasedRecordWithChanges(evo_test::AliasedRecordWithChanges const& value) {
+void ProtocolWithChangesWriterBase::WriteStringToUuid(std::string const& value) {
   if (unlikely(state_ != 36)) {
     ProtocolWithChangesWriterBaseInvalidState(36, false, state_);
   }
 
-  WriteAliasedRecordWithChangesImpl(value);
+  WriteStringToUuidImpl(value);
   state_ = 37;
 }
 
-void ProtocolWithChangesWriterBase::WriteRecordToRenamedRecord(evo_test::RenamedRecord const& value) {
+void ProtocolWithChangesWriterBase::WriteUuidToString(yardl::Uuid const& value) {
   if (unlikely(state_ != 37)) {
     ProtocolWithChangesWriterBaseInvalidState(37, false, state_);
   }
 
-  WriteRecordToRenamedRecordImpl(value);
+  WriteUuidToStringImpl(value);
   state_ = 38;
 }
 
-void ProtocolWithChangesWriterBase::WriteRecordToAliasedRecord(evo_test::RecordWithChanges const& value) {
+void ProtocolWithChangesWriterBase::WriteEnumToAliasedEnum(evo_test::GrowingEnum const& value) {
   if (unlikely(state_ != 38)) {
     ProtocolWithChangesWriterBaseInvalidState(38, false, state_);
   }
 
-  WriteRecordToAliasedRecordImpl(value);
+  WriteEnumToAliasedEnumImpl(value);
   state_ = 39;
 }
 
-void ProtocolWithChangesWriterBase::WriteRecordToAliasedAlias(evo_test::RecordWithChanges const& value) {
+void ProtocolWithChangesWriterBase::WriteOptionalIntToUnion(std::optional<int32_t> const& value) {
   if (unlikely(state_ != 39)) {
     ProtocolWithChangesWriterBaseInvalidState(39, false, state_);
   }
 
-  WriteRecordToAliasedAliasImpl(value);
+  WriteOptionalIntToUnionImpl(value);
   state_ = 40;
 }
 
-void ProtocolWithChangesWriterBase::WriteStreamIntToStringToFloat(int32_t const& value) {
+void ProtocolWithChangesWriterBase::WriteOptionalRecordToUnion(std::optional<evo_test::RecordWithChanges> const& value) {
   if (unlikely(state_ != 40)) {
     ProtocolWithChangesWriterBaseInvalidState(40, false, state_);
   }
 
+  WriteOptionalRecordToUnionImpl(value);
+  state_ = 41;
+}
+
+void ProtocolWithChangesWriterBase::WriteRecordWithChanges(evo_test::RecordWithChanges const& value) {
+  if (unlikely(state_ != 41)) {
+    ProtocolWithChangesWriterBaseInvalidState(41, false, state_);
+  }
+
+  WriteRecordWithChangesImpl(value);
+  state_ = 42;
+}
+
+void ProtocolWithChangesWriterBase::WriteAliasedRecordWithChanges(evo_test::AliasedRecordWithChanges const& value) {
+  if (unlikely(state_ != 42)) {
+    ProtocolWithChangesWriterBaseInvalidState(42, false, state_);
+  }
+
+  WriteAliasedRecordWithChangesImpl(value);
+  state_ = 43;
+}
+
+void ProtocolWithChangesWriterBase::WriteRecordToRenamedRecord(evo_test::RenamedRecord const& value) {
+  if (unlikely(state_ != 43)) {
+    ProtocolWithChangesWriterBaseInvalidState(43, false, state_);
+  }
+
+  WriteRecordToRenamedRecordImpl(value);
+  state_ = 44;
+}
+
+void ProtocolWithChangesWriterBase::WriteRecordToAliasedRecord(evo_test::RecordWithChanges const& value) {
+  if (unlikely(state_ != 44)) {
+    ProtocolWithChangesWriterBaseInvalidState(44, false, state_);
+  }
+
+  WriteRecordToAliasedRecordImpl(value);
+  state_ = 45;
+}
+
+void ProtocolWithChangesWriterBase::WriteRecordToAliasedAlias(evo_test::RecordWithChanges const& value) {
+  if (unlikely(state_ != 45)) {
+    ProtocolWithChangesWriterBaseInvalidState(45, false, state_);
+  }
+
+  WriteRecordToAliasedAliasImpl(value);
+  state_ = 46;
+}
+
+void ProtocolWithChangesWriterBase::WriteStreamIntToStringToFloat(int32_t const& value) {
+  if (unlikely(state_ != 46)) {
+    ProtocolWithChangesWriterBaseInvalidState(46, false, state_);
+  }
+
   WriteStreamIntToStringToFloatImpl(value);
 }
 
 void ProtocolWithChangesWriterBase::WriteStreamIntToStringToFloat(std::vector<int32_t> const& values) {
-  if (unlikely(state_ != 40)) {
-    ProtocolWithChangesWriterBaseInvalidState(40, false, state_);
+  if (unlikely(state_ != 46)) {
+    ProtocolWithChangesWriterBaseInvalidState(46, false, state_);
   }
 
   WriteStreamIntToStringToFloatImpl(values);
 }
 
 void ProtocolWithChangesWriterBase::EndStreamIntToStringToFloat() {
-  if (unlikely(state_ != 40)) {
-    ProtocolWithChangesWriterBaseInvalidState(40, true, state_);
+  if (unlikely(state_ != 46)) {
+    ProtocolWithChangesWriterBaseInvalidState(46, true, state_);
   }
 
   EndStreamIntToStringToFloatImpl();
-  state_ = 41;
+  state_ = 47;
 }
 
 // fallback implementation
@@ -738,55 +810,55 @@ void ProtocolWithChangesWriterBase::WriteStreamIntToStringToFloatImpl(std::vecto
 }
 
 void ProtocolWithChangesWriterBase::WriteVectorIntToStringToFloat(std::vector<int32_t> const& value) {
-  if (unlikely(state_ != 41)) {
-    ProtocolWithChangesWriterBaseInvalidState(41, false, state_);
+  if (unlikely(state_ != 47)) {
+    ProtocolWithChangesWriterBaseInvalidState(47, false, state_);
   }
 
   WriteVectorIntToStringToFloatImpl(value);
-  state_ = 42;
+  state_ = 48;
 }
 
 void ProtocolWithChangesWriterBase::WriteIntFloatUnionReordered(std::variant<int32_t, float> const& value) {
-  if (unlikely(state_ != 42)) {
-    ProtocolWithChangesWriterBaseInvalidState(42, false, state_);
+  if (unlikely(state_ != 48)) {
+    ProtocolWithChangesWriterBaseInvalidState(48, false, state_);
   }
 
   WriteIntFloatUnionReorderedImpl(value);
-  state_ = 43;
+  state_ = 49;
 }
 
 void ProtocolWithChangesWriterBase::WriteVectorUnionReordered(std::vector<std::variant<int32_t, float>> const& value) {
-  if (unlikely(state_ != 43)) {
-    ProtocolWithChangesWriterBaseInvalidState(43, false, state_);
+  if (unlikely(state_ != 49)) {
+    ProtocolWithChangesWriterBaseInvalidState(49, false, state_);
   }
 
   WriteVectorUnionReorderedImpl(value);
-  state_ = 44;
+  state_ = 50;
 }
 
 void ProtocolWithChangesWriterBase::WriteStreamUnionReordered(std::variant<int32_t, std::string> const& value) {
-  if (unlikely(state_ != 44)) {
-    ProtocolWithChangesWriterBaseInvalidState(44, false, state_);
+  if (unlikely(state_ != 50)) {
+    ProtocolWithChangesWriterBaseInvalidState(50, false, state_);
   }
 
   WriteStreamUnionReorderedImpl(value);
 }
 
 void ProtocolWithChangesWriterBase::WriteStreamUnionReordered(std::vector<std::variant<int32_t, std::string>> const& values) {
-  if (unlikely(state_ != 44)) {
-    ProtocolWithChangesWriterBaseInvalidState(44, false, state_);
+  if (unlikely(state_ != 50)) {
+    ProtocolWithChangesWriterBaseInvalidState(50, false, state_);
   }
 
   WriteStreamUnionReorderedImpl(values);
 }
 
 void ProtocolWithChangesWriterBase::EndStreamUnionReordered() {
-  if (unlikely(state_ != 44)) {
-    ProtocolWithChangesWriterBaseInvalidState(44, true, state_);
+  if (unlikely(state_ != 50)) {
+    ProtocolWithChangesWriterBaseInvalidState(50, true, state_);
   }
 
   EndStreamUnionReorderedImpl();
-  state_ = 45;
+  state_ = 51;
 }
 
 // fallback implementation
@@ -797,28 +869,28 @@ void ProtocolWithChangesWriterBase::WriteStreamUnionReorderedImpl(std::vector<st
 }
 
 void ProtocolWithChangesWriterBase::WriteStreamOfAliasTypeChange(evo_test::StreamItem const& value) {
-  if (unlikely(state_ != 45)) {
-    ProtocolWithChangesWriterBaseInvalidState(45, false, state_);
+  if (unlikely(state_ != 51)) {
+    ProtocolWithChangesWriterBaseInvalidState(51, false, state_);
   }
 
   WriteStreamOfAliasTypeChangeImpl(value);
 }
 
 void ProtocolWithChangesWriterBase::WriteStreamOfAliasTypeChange(std::vector<evo_test::StreamItem> const& values) {
-  if (unlikely(state_ != 45)) {
-    ProtocolWithChangesWriterBaseInvalidState(45, false, state_);
+  if (unlikely(state_ != 51)) {
+    ProtocolWithChangesWriterBaseInvalidState(51, false, state_);
   }
 
   WriteStreamOfAliasTypeChangeImpl(values);
 }
 
 void ProtocolWithChangesWriterBase::EndStreamOfAliasTypeChange() {
-  if (unlikely(state_ != 45)) {
-    ProtocolWithChangesWriterBaseInvalidState(45, true, state_);
+  if (unlikely(state_ != 51)) {
+    ProtocolWithChangesWriterBaseInvalidState(51, true, state_);
   }
 
   EndStreamOfAliasTypeChangeImpl();
-  state_ = 46;
+  state_ = 52;
 }
 
 // fallback implementation
@@ -829,478 +901,478 @@ void ProtocolWithChangesWriterBase::WriteStreamOfAliasTypeChangeImpl(std::vector
 }
 
 void ProtocolWithChangesWriterBase::WriteRlink(evo_test::RLink const& value) {
-  if (unlikely(state_ != 46)) {
-    ProtocolWithChangesWriterBaseInvalidState(46, false, state_);
+  if (unlikely(state_ != 52)) {
+    ProtocolWithChangesWriterBaseInvalidState(52, false, state_);
   }
 
   WriteRlinkImpl(value);
-  state_ = 47;
+  state_ = 53;
 }
 
 void ProtocolWithChangesWriterBase::WriteRlinkRX(evo_test::RLink const& value) {
-  if (unlikely(state_ != 47)) {
-    ProtocolWithChangesWriterBaseInvalidState(47, false, state_);
+  if (unlikely(state_ != 53)) {
+    ProtocolWithChangesWriterBaseInvalidState(53, false, state_);
   }
 
   WriteRlinkRXImpl(value);
-  state_ = 48;
+  state_ = 54;
 }
 
 void ProtocolWithChangesWriterBase::WriteRlinkRY(evo_test::RLink const& value) {
-  if (unlikely(state_ != 48)) {
-    ProtocolWithChangesWriterBaseInvalidState(48, false, state_);
+  if (unlikely(state_ != 54)) {
+    ProtocolWithChangesWriterBaseInvalidState(54, false, state_);
   }
 
   WriteRlinkRYImpl(value);
-  state_ = 49;
+  state_ = 55;
 }
 
 void ProtocolWithChangesWriterBase::WriteRlinkRZ(evo_test::RLink const& value) {
-  if (unlikely(state_ != 49)) {
-    ProtocolWithChangesWriterBaseInvalidState(49, false, state_);
+  if (unlikely(state_ != 55)) {
+    ProtocolWithChangesWriterBaseInvalidState(55, false, state_);
   }
 
   WriteRlinkRZImpl(value);
-  state_ = 50;
+  state_ = 56;
 }
 
 void ProtocolWithChangesWriterBase::WriteRaRLink(evo_test::RA const& value) {
-  if (unlikely(state_ != 50)) {
-    ProtocolWithChangesWriterBaseInvalidState(50, false, state_);
+  if (unlikely(state_ != 56)) {
+    ProtocolWithChangesWriterBaseInvalidState(56, false, state_);
   }
 
   WriteRaRLinkImpl(value);
-  state_ = 51;
+  state_ = 57;
 }
 
 void ProtocolWithChangesWriterBase::WriteRaRX(evo_test::RA const& value) {
-  if (unlikely(state_ != 51)) {
-    ProtocolWithChangesWriterBaseInvalidState(51, false, state_);
+  if (unlikely(state_ != 57)) {
+    ProtocolWithChangesWriterBaseInvalidState(57, false, state_);
   }
 
   WriteRaRXImpl(value);
-  state_ = 52;
+  state_ = 58;
 }
 
 void ProtocolWithChangesWriterBase::WriteRaRY(evo_test::RA const& value) {
-  if (unlikely(state_ != 52)) {
-    ProtocolWithChangesWriterBaseInvalidState(52, false, state_);
+  if (unlikely(state_ != 58)) {
+    ProtocolWithChangesWriterBaseInvalidState(58, false, state_);
   }
 
   WriteRaRYImpl(value);
-  state_ = 53;
+  state_ = 59;
 }
 
 void ProtocolWithChangesWriterBase::WriteRaRZ(evo_test::RA const& value) {
-  if (unlikely(state_ != 53)) {
-    ProtocolWithChangesWriterBaseInvalidState(53, false, state_);
+  if (unlikely(state_ != 59)) {
+    ProtocolWithChangesWriterBaseInvalidState(59, false, state_);
   }
 
   WriteRaRZImpl(value);
-  state_ = 54;
+  state_ = 60;
 }
 
 void ProtocolWithChangesWriterBase::WriteRbRLink(evo_test::RB const& value) {
-  if (unlikely(state_ != 54)) {
-    ProtocolWithChangesWriterBaseInvalidState(54, false, state_);
+  if (unlikely(state_ != 60)) {
+    ProtocolWithChangesWriterBaseInvalidState(60, false, state_);
   }
 
   WriteRbRLinkImpl(value);
-  state_ = 55;
+  state_ = 61;
 }
 
 void ProtocolWithChangesWriterBase::WriteRbRX(evo_test::RB const& value) {
-  if (unlikely(state_ != 55)) {
-    ProtocolWithChangesWriterBaseInvalidState(55, false, state_);
+  if (unlikely(state_ != 61)) {
+    ProtocolWithChangesWriterBaseInvalidState(61, false, state_);
   }
 
   WriteRbRXImpl(value);
-  state_ = 56;
+  state_ = 62;
 }
 
 void ProtocolWithChangesWriterBase::WriteRbRY(evo_test::RB const& value) {
-  if (unlikely(state_ != 56)) {
-    ProtocolWithChangesWriterBaseInvalidState(56, false, state_);
+  if (unlikely(state_ != 62)) {
+    ProtocolWithChangesWriterBaseInvalidState(62, false, state_);
   }
 
   WriteRbRYImpl(value);
-  state_ = 57;
+  state_ = 63;
 }
 
 void ProtocolWithChangesWriterBase::WriteRbRZ(evo_test::RB const& value) {
-  if (unlikely(state_ != 57)) {
-    ProtocolWithChangesWriterBaseInvalidState(57, false, state_);
+  if (unlikely(state_ != 63)) {
+    ProtocolWithChangesWriterBaseInvalidState(63, false, state_);
   }
 
   WriteRbRZImpl(value);
-  state_ = 58;
+  state_ = 64;
 }
 
 void ProtocolWithChangesWriterBase::WriteRcRLink(evo_test::RC const& value) {
-  if (unlikely(state_ != 58)) {
-    ProtocolWithChangesWriterBaseInvalidState(58, false, state_);
+  if (unlikely(state_ != 64)) {
+    ProtocolWithChangesWriterBaseInvalidState(64, false, state_);
   }
 
   WriteRcRLinkImpl(value);
-  state_ = 59;
+  state_ = 65;
 }
 
 void ProtocolWithChangesWriterBase::WriteRcRX(evo_test::RC const& value) {
-  if (unlikely(state_ != 59)) {
-    ProtocolWithChangesWriterBaseInvalidState(59, false, state_);
+  if (unlikely(state_ != 65)) {
+    ProtocolWithChangesWriterBaseInvalidState(65, false, state_);
   }
 
   WriteRcRXImpl(value);
-  state_ = 60;
+  state_ = 66;
 }
 
 void ProtocolWithChangesWriterBase::WriteRcRY(evo_test::RC const& value) {
-  if (unlikely(state_ != 60)) {
-    ProtocolWithChangesWriterBaseInvalidState(60, false, state_);
+  if (unlikely(state_ != 66)) {
+    ProtocolWithChangesWriterBaseInvalidState(66, false, state_);
   }
 
   WriteRcRYImpl(value);
-  state_ = 61;
+  state_ = 67;
 }
 
 void ProtocolWithChangesWriterBase::WriteRcRZ(evo_test::RC const& value) {
-  if (unlikely(state_ != 61)) {
-    ProtocolWithChangesWriterBaseInvalidState(61, false, state_);
+  if (unlikely(state_ != 67)) {
+    ProtocolWithChangesWriterBaseInvalidState(67, false, state_);
   }
 
   WriteRcRZImpl(value);
-  state_ = 62;
+  state_ = 68;
 }
 
 void ProtocolWithChangesWriterBase::WriteRlinkRNew(evo_test::RLink const& value) {
-  if (unlikely(state_ != 62)) {
-    ProtocolWithChangesWriterBaseInvalidState(62, false, state_);
+  if (unlikely(state_ != 68)) {
+    ProtocolWithChangesWriterBaseInvalidState(68, false, state_);
   }
 
   WriteRlinkRNewImpl(value);
-  state_ = 63;
+  state_ = 69;
 }
 
 void ProtocolWithChangesWriterBase::WriteRaRNew(evo_test::RA const& value) {
-  if (unlikely(state_ != 63)) {
-    ProtocolWithChangesWriterBaseInvalidState(63, false, state_);
+  if (unlikely(state_ != 69)) {
+    ProtocolWithChangesWriterBaseInvalidState(69, false, state_);
   }
 
   WriteRaRNewImpl(value);
-  state_ = 64;
+  state_ = 70;
 }
 
 void ProtocolWithChangesWriterBase::WriteRbRNew(evo_test::RB const& value) {
-  if (unlikely(state_ != 64)) {
-    ProtocolWithChangesWriterBaseInvalidState(64, false, state_);
+  if (unlikely(state_ != 70)) {
+    ProtocolWithChangesWriterBaseInvalidState(70, false, state_);
   }
 
   WriteRbRNewImpl(value);
-  state_ = 65;
+  state_ = 71;
 }
 
 void ProtocolWithChangesWriterBase::WriteRcRNew(evo_test::RC const& value) {
-  if (unlikely(state_ != 65)) {
-    ProtocolWithChangesWriterBaseInvalidState(65, false, state_);
+  if (unlikely(state_ != 71)) {
+    ProtocolWithChangesWriterBaseInvalidState(71, false, state_);
   }
 
   WriteRcRNewImpl(value);
-  state_ = 66;
+  state_ = 72;
 }
 
 void ProtocolWithChangesWriterBase::WriteRlinkRUnion(evo_test::RLink const& value) {
-  if (unlikely(state_ != 66)) {
-    ProtocolWithChangesWriterBaseInvalidState(66, false, state_);
+  if (unlikely(state_ != 72)) {
+    ProtocolWithChangesWriterBaseInvalidState(72, false, state_);
   }
 
   WriteRlinkRUnionImpl(value);
-  state_ = 67;
+  state_ = 73;
 }
 
 void ProtocolWithChangesWriterBase::WriteRaRUnion(evo_test::RA const& value) {
-  if (unlikely(state_ != 67)) {
-    ProtocolWithChangesWriterBaseInvalidState(67, false, state_);
+  if (unlikely(state_ != 73)) {
+    ProtocolWithChangesWriterBaseInvalidState(73, false, state_);
   }
 
   WriteRaRUnionImpl(value);
-  state_ = 68;
+  state_ = 74;
 }
 
 void ProtocolWithChangesWriterBase::WriteRbRUnion(evo_test::RB const& value) {
-  if (unlikely(state_ != 68)) {
-    ProtocolWithChangesWriterBaseInvalidState(68, false, state_);
+  if (unlikely(state_ != 74)) {
+    ProtocolWithChangesWriterBaseInvalidState(74, false, state_);
   }
 
   WriteRbRUnionImpl(value);
-  state_ = 69;
+  state_ = 75;
 }
 
 void ProtocolWithChangesWriterBase::WriteRcRUnion(evo_test::RC const& value) {
-  if (unlikely(state_ != 69)) {
-    ProtocolWithChangesWriterBaseInvalidState(69, false, state_);
+  if (unlikely(state_ != 75)) {
+    ProtocolWithChangesWriterBaseInvalidState(75, false, state_);
   }
 
   WriteRcRUnionImpl(value);
-  state_ = 70;
+  state_ = 76;
 }
 
 void ProtocolWithChangesWriterBase::WriteOptionalRecordWithChanges(std::optional<evo_test::RecordWithChanges> const& value) {
-  if (unlikely(state_ != 70)) {
-    ProtocolWithChangesWriterBaseInvalidState(70, false, state_);
+  if (unlikely(state_ != 76)) {
+    ProtocolWithChangesWriterBaseInvalidState(76, false, state_);
   }
 
   WriteOptionalRecordWithChangesImpl(value);
-  state_ = 71;
+  state_ = 77;
 }
 
 void ProtocolWithChangesWriterBase::WriteAliasedOptionalRecordWithChanges(std::optional<evo_test::AliasedRecordWithChanges> const& value) {
-  if (unlikely(state_ != 71)) {
-    ProtocolWithChangesWriterBaseInvalidState(71, false, state_);
+  if (unlikely(state_ != 77)) {
+    ProtocolWithChangesWriterBaseInvalidState(77, false, state_);
   }
 
   WriteAliasedOptionalRecordWithChangesImpl(value);
-  state_ = 72;
+  state_ = 78;
 }
 
 void ProtocolWithChangesWriterBase::WriteUnionRecordWithChanges(std::variant<evo_test::RecordWithChanges, int32_t> const& value) {
-  if (unlikely(state_ != 72)) {
-    ProtocolWithChangesWriterBaseInvalidState(72, false, state_);
+  if (unlikely(state_ != 78)) {
+    ProtocolWithChangesWriterBaseInvalidState(78, false, state_);
   }
 
   WriteUnionRecordWithChangesImpl(value);
-  state_ = 73;
+  state_ = 79;
 }
 
 void ProtocolWithChangesWriterBase::WriteUnionWithSameTypeset(std::variant<evo_test::RecordWithChanges, int32_t, float, std::string> const& value) {
-  if (unlikely(state_ != 73)) {
-    ProtocolWithChangesWriterBaseInvalidState(73, false, state_);
+  if (unlikely(state_ != 79)) {
+    ProtocolWithChangesWriterBaseInvalidState(79, false, state_);
   }
 
   WriteUnionWithSameTypesetImpl(value);
-  state_ = 74;
+  state_ = 80;
 }
 
 void ProtocolWithChangesWriterBase::WriteUnionWithTypesAdded(std::variant<evo_test::RecordWithChanges, float> const& value) {
-  if (unlikely(state_ != 74)) {
-    ProtocolWithChangesWriterBaseInvalidState(74, false, state_);
+  if (unlikely(state_ != 80)) {
+    ProtocolWithChangesWriterBaseInvalidState(80, false, state_);
   }
 
   WriteUnionWithTypesAddedImpl(value);
-  state_ = 75;
+  state_ = 81;
 }
 
 void ProtocolWithChangesWriterBase::WriteUnionWithTypesRemoved(std::variant<evo_test::RecordWithChanges, int32_t, float, std::string> const& value) {
-  if (unlikely(state_ != 75)) {
-    ProtocolWithChangesWriterBaseInvalidState(75, false, state_);
+  if (unlikely(state_ != 81)) {
+    ProtocolWithChangesWriterBaseInvalidState(81, false, state_);
   }
 
   WriteUnionWithTypesRemovedImpl(value);
-  state_ = 76;
+  state_ = 82;
 }
 
 void ProtocolWithChangesWriterBase::WriteRecordToOptional(evo_test::RecordWithChanges const& value) {
-  if (unlikely(state_ != 76)) {
-    ProtocolWithChangesWriterBaseInvalidState(76, false, state_);
+  if (unlikely(state_ != 82)) {
+    ProtocolWithChangesWriterBaseInvalidState(82, false, state_);
   }
 
   WriteRecordToOptionalImpl(value);
-  state_ = 77;
+  state_ = 83;
 }
 
 void ProtocolWithChangesWriterBase::WriteRecordToAliasedOptional(evo_test::RecordWithChanges const& value) {
-  if (unlikely(state_ != 77)) {
-    ProtocolWithChangesWriterBaseInvalidState(77, false, state_);
+  if (unlikely(state_ != 83)) {
+    ProtocolWithChangesWriterBaseInvalidState(83, false, state_);
   }
 
   WriteRecordToAliasedOptionalImpl(value);
-  state_ = 78;
+  state_ = 84;
 }
 
 void ProtocolWithChangesWriterBase::WriteRecordToUnion(evo_test::RecordWithChanges const& value) {
-  if (unlikely(state_ != 78)) {
-    ProtocolWithChangesWriterBaseInvalidState(78, false, state_);
+  if (unlikely(state_ != 84)) {
+    ProtocolWithChangesWriterBaseInvalidState(84, false, state_);
   }
 
   WriteRecordToUnionImpl(value);
-  state_ = 79;
+  state_ = 85;
 }
 
 void ProtocolWithChangesWriterBase::WriteRecordToAliasedUnion(evo_test::RecordWithChanges const& value) {
-  if (unlikely(state_ != 79)) {
-    ProtocolWithChangesWriterBaseInvalidState(79, false, state_);
+  if (unlikely(state_ != 85)) {
+    ProtocolWithChangesWriterBaseInvalidState(85, false, state_);
   }
 
   WriteRecordToAliasedUnionImpl(value);
-  state_ = 80;
+  state_ = 86;
 }
 
 void ProtocolWithChangesWriterBase::WriteUnionToAliasedUnion(std::variant<evo_test::RecordWithChanges, int32_t> const& value) {
-  if (unlikely(state_ != 80)) {
-    ProtocolWithChangesWriterBaseInvalidState(80, false, state_);
+  if (unlikely(state_ != 86)) {
+    ProtocolWithChangesWriterBaseInvalidState(86, false, state_);
   }
 
   WriteUnionToAliasedUnionImpl(value);
-  state_ = 81;
+  state_ = 87;
 }
 
 void ProtocolWithChangesWriterBase::WriteUnionToAliasedUnionWithChanges(std::variant<evo_test::RecordWithChanges, int32_t> const& value) {
-  if (unlikely(state_ != 81)) {
-    ProtocolWithChangesWriterBaseInvalidState(81, false, state_);
+  if (unlikely(state_ != 87)) {
+    ProtocolWithChangesWriterBaseInvalidState(87, false, state_);
   }
 
   WriteUnionToAliasedUnionWithChangesImpl(value);
-  state_ = 82;
+  state_ = 88;
 }
 
 void ProtocolWithChangesWriterBase::WriteOptionalToAliasedOptional(std::optional<evo_test::RecordWithChanges> const& value) {
-  if (unlikely(state_ != 82)) {
-    ProtocolWithChangesWriterBaseInvalidState(82, false, state_);
+  if (unlikely(state_ != 88)) {
+    ProtocolWithChangesWriterBaseInvalidState(88, false, state_);
   }
 
   WriteOptionalToAliasedOptionalImpl(value);
-  state_ = 83;
+  state_ = 89;
 }
 
 void ProtocolWithChangesWriterBase::WriteOptionalToAliasedOptionalWithChanges(std::optional<int32_t> const& value) {
-  if (unlikely(state_ != 83)) {
-    ProtocolWithChangesWriterBaseInvalidState(83, false, state_);
+  if (unlikely(state_ != 89)) {
+    ProtocolWithChangesWriterBaseInvalidState(89, false, state_);
   }
 
   WriteOptionalToAliasedOptionalWithChangesImpl(value);
-  state_ = 84;
+  state_ = 90;
 }
 
 void ProtocolWithChangesWriterBase::WriteGenericRecord(evo_test::GenericRecord<int32_t, std::string> const& value) {
-  if (unlikely(state_ != 84)) {
-    ProtocolWithChangesWriterBaseInvalidState(84, false, state_);
+  if (unlikely(state_ != 90)) {
+    ProtocolWithChangesWriterBaseInvalidState(90, false, state_);
   }
 
   WriteGenericRecordImpl(value);
-  state_ = 85;
+  state_ = 91;
 }
 
 void ProtocolWithChangesWriterBase::WriteGenericRecordToOpenAlias(evo_test::GenericRecord<int32_t, std::string> const& value) {
-  if (unlikely(state_ != 85)) {
-    ProtocolWithChangesWriterBaseInvalidState(85, false, state_);
+  if (unlikely(state_ != 91)) {
+    ProtocolWithChangesWriterBaseInvalidState(91, false, state_);
   }
 
   WriteGenericRecordToOpenAliasImpl(value);
-  state_ = 86;
+  state_ = 92;
 }
 
 void ProtocolWithChangesWriterBase::WriteGenericRecordToClosedAlias(evo_test::GenericRecord<int32_t, std::string> const& value) {
-  if (unlikely(state_ != 86)) {
-    ProtocolWithChangesWriterBaseInvalidState(86, false, state_);
+  if (unlikely(state_ != 92)) {
+    ProtocolWithChangesWriterBaseInvalidState(92, false, state_);
   }
 
   WriteGenericRecordToClosedAliasImpl(value);
-  state_ = 87;
+  state_ = 93;
 }
 
 void ProtocolWithChangesWriterBase::WriteGenericRecordToHalfClosedAlias(evo_test::GenericRecord<int32_t, std::string> const& value) {
-  if (unlikely(state_ != 87)) {
-    ProtocolWithChangesWriterBaseInvalidState(87, false, state_);
+  if (unlikely(state_ != 93)) {
+    ProtocolWithChangesWriterBaseInvalidState(93, false, state_);
   }
 
   WriteGenericRecordToHalfClosedAliasImpl(value);
-  state_ = 88;
+  state_ = 94;
 }
 
 void ProtocolWithChangesWriterBase::WriteAliasedGenericRecordToAlias(evo_test::AliasedHalfClosedGenericRecord<int32_t> const& value) {
-  if (unlikely(state_ != 88)) {
-    ProtocolWithChangesWriterBaseInvalidState(88, false, state_);
+  if (unlikely(state_ != 94)) {
+    ProtocolWithChangesWriterBaseInvalidState(94, false, state_);
   }
 
   WriteAliasedGenericRecordToAliasImpl(value);
-  state_ = 89;
+  state_ = 95;
 }
 
 void ProtocolWithChangesWriterBase::WriteGenericRecordToReversed(evo_test::GenericRecord<int32_t, std::string> const& value) {
-  if (unlikely(state_ != 89)) {
-    ProtocolWithChangesWriterBaseInvalidState(89, false, state_);
+  if (unlikely(state_ != 95)) {
+    ProtocolWithChangesWriterBaseInvalidState(95, false, state_);
   }
 
   WriteGenericRecordToReversedImpl(value);
-  state_ = 90;
+  state_ = 96;
 }
 
 void ProtocolWithChangesWriterBase::WriteClosedGenericRecordToUnion(evo_test::AliasedClosedGenericRecord const& value) {
-  if (unlikely(state_ != 90)) {
-    ProtocolWithChangesWriterBaseInvalidState(90, false, state_);
+  if (unlikely(state_ != 96)) {
+    ProtocolWithChangesWriterBaseInvalidState(96, false, state_);
   }
 
   WriteClosedGenericRecordToUnionImpl(value);
-  state_ = 91;
+  state_ = 97;
 }
 
 void ProtocolWithChangesWriterBase::WriteGenericRecordToAliasedUnion(evo_test::GenericRecord<int32_t, std::string> const& value) {
-  if (unlikely(state_ != 91)) {
-    ProtocolWithChangesWriterBaseInvalidState(91, false, state_);
+  if (unlikely(state_ != 97)) {
+    ProtocolWithChangesWriterBaseInvalidState(97, false, state_);
   }
 
   WriteGenericRecordToAliasedUnionImpl(value);
-  state_ = 92;
+  state_ = 98;
 }
 
 void ProtocolWithChangesWriterBase::WriteGenericUnionToReversed(evo_test::AliasedClosedGenericUnion const& value) {
-  if (unlikely(state_ != 92)) {
-    ProtocolWithChangesWriterBaseInvalidState(92, false, state_);
+  if (unlikely(state_ != 98)) {
+    ProtocolWithChangesWriterBaseInvalidState(98, false, state_);
   }
 
   WriteGenericUnionToReversedImpl(value);
-  state_ = 93;
+  state_ = 99;
 }
 
 void ProtocolWithChangesWriterBase::WriteGenericUnionOfChangedRecord(evo_test::AliasedClosedGenericUnion const& value) {
-  if (unlikely(state_ != 93)) {
-    ProtocolWithChangesWriterBaseInvalidState(93, false, state_);
+  if (unlikely(state_ != 99)) {
+    ProtocolWithChangesWriterBaseInvalidState(99, false, state_);
   }
 
   WriteGenericUnionOfChangedRecordImpl(value);
-  state_ = 94;
+  state_ = 100;
 }
 
 void ProtocolWithChangesWriterBase::WriteGenericParentRecord(evo_test::GenericParentRecord<int32_t> const& value) {
-  if (unlikely(state_ != 94)) {
-    ProtocolWithChangesWriterBaseInvalidState(94, false, state_);
+  if (unlikely(state_ != 100)) {
+    ProtocolWithChangesWriterBaseInvalidState(100, false, state_);
   }
 
   WriteGenericParentRecordImpl(value);
-  state_ = 95;
+  state_ = 101;
 }
 
 void ProtocolWithChangesWriterBase::WriteGenericNestedRecords(evo_test::GenericRecord<evo_test::UnchangedGeneric<int32_t>, evo_test::ChangedGeneric<std::string, int32_t>> const& value) {
-  if (unlikely(state_ != 95)) {
-    ProtocolWithChangesWriterBaseInvalidState(95, false, state_);
+  if (unlikely(state_ != 101)) {
+    ProtocolWithChangesWriterBaseInvalidState(101, false, state_);
   }
 
   WriteGenericNestedRecordsImpl(value);
-  state_ = 96;
+  state_ = 102;
 }
 
 void ProtocolWithChangesWriterBase::WriteGenericRecordStream(evo_test::GenericRecord<int32_t, std::string> const& value) {
-  if (unlikely(state_ != 96)) {
-    ProtocolWithChangesWriterBaseInvalidState(96, false, state_);
+  if (unlikely(state_ != 102)) {
+    ProtocolWithChangesWriterBaseInvalidState(102, false, state_);
   }
 
   WriteGenericRecordStreamImpl(value);
 }
 
 void ProtocolWithChangesWriterBase::WriteGenericRecordStream(std::vector<evo_test::GenericRecord<int32_t, std::string>> const& values) {
-  if (unlikely(state_ != 96)) {
-    ProtocolWithChangesWriterBaseInvalidState(96, false, state_);
+  if (unlikely(state_ != 102)) {
+    ProtocolWithChangesWriterBaseInvalidState(102, false, state_);
   }
 
   WriteGenericRecordStreamImpl(values);
 }
 
 void ProtocolWithChangesWriterBase::EndGenericRecordStream() {
-  if (unlikely(state_ != 96)) {
-    ProtocolWithChangesWriterBaseInvalidState(96, true, state_);
+  if (unlikely(state_ != 102)) {
+    ProtocolWithChangesWriterBaseInvalidState(102, true, state_);
   }
 
   EndGenericRecordStreamImpl();
-  state_ = 97;
+  state_ = 103;
 }
 
 // fallback implementation
@@ -1311,28 +1383,28 @@ void ProtocolWithChangesWriterBase::WriteGenericRecordStreamImpl(std::vector<evo
 }
 
 void ProtocolWithChangesWriterBase::WriteGenericParentRecordStream(evo_test::GenericParentRecord<int32_t> const& value) {
-  if (unlikely(state_ != 97)) {
-    ProtocolWithChangesWriterBaseInvalidState(97, false, state_);
+  if (unlikely(state_ != 103)) {
+    ProtocolWithChangesWriterBaseInvalidState(103, false, state_);
   }
 
   WriteGenericParentRecordStreamImpl(value);
 }
 
 void ProtocolWithChangesWriterBase::WriteGenericParentRecordStream(std::vector<evo_test::GenericParentRecord<int32_t>> const& values) {
-  if (unlikely(state_ != 97)) {
-    ProtocolWithChangesWriterBaseInvalidState(97, false, state_);
+  if (unlikely(state_ != 103)) {
+    ProtocolWithChangesWriterBaseInvalidState(103, false, state_);
   }
 
   WriteGenericParentRecordStreamImpl(values);
 }
 
 void ProtocolWithChangesWriterBase::EndGenericParentRecordStream() {
-  if (unlikely(state_ != 97)) {
-    ProtocolWithChangesWriterBaseInvalidState(97, true, state_);
+  if (unlikely(state_ != 103)) {
+    ProtocolWithChangesWriterBaseInvalidState(103, true, state_);
   }
 
   EndGenericParentRecordStreamImpl();
-  state_ = 98;
+  state_ = 104;
 }
 
 // fallback implementation
@@ -1343,37 +1415,37 @@ void ProtocolWithChangesWriterBase::WriteGenericParentRecordStreamImpl(std::vect
 }
 
 void ProtocolWithChangesWriterBase::WriteVectorRecordWithChanges(std::vector<evo_test::RecordWithChanges> const& value) {
-  if (unlikely(state_ != 98)) {
-    ProtocolWithChangesWriterBaseInvalidState(98, false, state_);
+  if (unlikely(state_ != 104)) {
+    ProtocolWithChangesWriterBaseInvalidState(104, false, state_);
   }
 
   WriteVectorRecordWithChangesImpl(value);
-  state_ = 99;
+  state_ = 105;
 }
 
 void ProtocolWithChangesWriterBase::WriteStreamedRecordWithChanges(evo_test::RecordWithChanges const& value) {
-  if (unlikely(state_ != 99)) {
-    ProtocolWithChangesWriterBaseInvalidState(99, false, state_);
+  if (unlikely(state_ != 105)) {
+    ProtocolWithChangesWriterBaseInvalidState(105, false, state_);
   }
 
   WriteStreamedRecordWithChangesImpl(value);
 }
 
 void ProtocolWithChangesWriterBase::WriteStreamedRecordWithChanges(std::vector<evo_test::RecordWithChanges> const& values) {
-  if (unlikely(state_ != 99)) {
-    ProtocolWithChangesWriterBaseInvalidState(99, false, state_);
+  if (unlikely(state_ != 105)) {
+    ProtocolWithChangesWriterBaseInvalidState(105, false, state_);
   }
 
   WriteStreamedRecordWithChangesImpl(values);
 }
 
 void ProtocolWithChangesWriterBase::EndStreamedRecordWithChanges() {
-  if (unlikely(state_ != 99)) {
-    ProtocolWithChangesWriterBaseInvalidState(99, true, state_);
+  if (unlikely(state_ != 105)) {
+    ProtocolWithChangesWriterBaseInvalidState(105, true, state_);
   }
 
   EndStreamedRecordWithChangesImpl();
-  state_ = 100;
+  state_ = 106;
 }
 
 // fallback implementation
@@ -1384,8 +1456,8 @@ void ProtocolWithChangesWriterBase::WriteStreamedRecordWithChangesImpl(std::vect
 }
 
 void ProtocolWithChangesWriterBase::Close() {
-  if (unlikely(state_ != 100)) {
-    ProtocolWithChangesWriterBaseInvalidState(100, false, state_);
+  if (unlikely(state_ != 106)) {
+    ProtocolWithChangesWriterBaseInvalidState(106, false, state_);
   }
 
   CloseImpl();
@@ -1689,90 +1761,144 @@ void ProtocolWithChangesReaderBase::ReadStringToAliasedInt(std::string& value) {
   state_ = 64;
 }
 
-void ProtocolWithChangesReaderBase::ReadEnumToAliasedEnum(evo_test::GrowingEnum& value) {
+void ProtocolWithChangesReaderBase::ReadStringToBytes(std::string& value) {
   if (unlikely(state_ != 64)) {
     ProtocolWithChangesReaderBaseInvalidState(64, state_);
   }
 
-  ReadEnumToAliasedEnumImpl(value);
+  ReadStringToBytesImpl(value);
   state_ = 66;
 }
 
-void ProtocolWithChangesReaderBase::ReadOptionalIntToUnion(std::optional<int32_t>& value) {
+void ProtocolWithChangesReaderBase::ReadBytesToString(std::vector<std::byte>& value) {
   if (unlikely(state_ != 66)) {
     ProtocolWithChangesReaderBaseInvalidState(66, state_);
   }
 
-  ReadOptionalIntToUnionImpl(value);
+  ReadBytesToStringImpl(value);
   state_ = 68;
 }
 
-void ProtocolWithChangesReaderBase::ReadOptionalRecordToUnion(std::optional<evo_test::RecordWithChanges>& value) {
+void ProtocolWithChangesReaderBase::ReadVectorToBytes(std::vector<uint8_t>& value) {
   if (unlikely(state_ != 68)) {
     ProtocolWithChangesReaderBaseInvalidState(68, state_);
   }
 
-  ReadOptionalRecordToUnionImpl(value);
+  ReadVectorToBytesImpl(value);
   state_ = 70;
 }
 
-void ProtocolWithChangesReaderBase::ReadRecordWithChanges(evo_test::RecordWithChanges& value) {
+void ProtocolWithChangesReaderBase::ReadBytesToVector(std::vector<std::byte>& value) {
   if (unlikely(state_ != 70)) {
     ProtocolWithChangesReaderBaseInvalidState(70, state_);
   }
 
-  ReadRecordWithChangesImpl(value);
+  ReadBytesToVectorImpl(value);
   state_ = 72;
 }
 
-void ProtocolWithChangesReaderBase::ReadAliasedRecordWithChanges(evo_test::AliasedRecordWithChanges& value) {
+void ProtocolWithChangesReaderBase::ReadStringToUuid(std::string& value) {
   if (unlikely(state_ != 72)) {
     ProtocolWithChangesReaderBaseInvalidState(72, state_);
   }
 
-  ReadAliasedRecordWithChangesImpl(value);
+  ReadStringToUuidImpl(value);
   state_ = 74;
 }
 
-void ProtocolWithChangesReaderBase::ReadRecordToRenamedRecord(evo_test::RenamedRecord& value) {
+void ProtocolWithChangesReaderBase::ReadUuidToString(yardl::Uuid& value) {
   if (unlikely(state_ != 74)) {
     ProtocolWithChangesReaderBaseInvalidState(74, state_);
   }
 
-  ReadRecordToRenamedRecordImpl(value);
+  ReadUuidToStringImpl(value);
   state_ = 76;
 }
 
-void ProtocolWithChangesReaderBase::ReadRecordToAliasedRecord(evo_test::RecordWithChanges& value) {
+void ProtocolWithChangesReaderBase::ReadEnumToAliasedEnum(evo_test::GrowingEnum& value) {
   if (unlikely(state_ != 76)) {
     ProtocolWithChangesReaderBaseInvalidState(76, state_);
   }
 
-  ReadRecordToAliasedRecordImpl(value);
+  ReadEnumToAliasedEnumImpl(value);
   state_ = 78;
 }
 
-void ProtocolWithChangesReaderBase::ReadRecordToAliasedAlias(evo_test::RecordWithChanges& value) {
+void ProtocolWithChangesReaderBase::ReadOptionalIntToUnion(std::optional<int32_t>& value) {
   if (unlikely(state_ != 78)) {
     ProtocolWithChangesReaderBaseInvalidState(78, state_);
   }
 
-  ReadRecordToAliasedAliasImpl(value);
+  ReadOptionalIntToUnionImpl(value);
   state_ = 80;
 }
 
-bool ProtocolWithChangesReaderBase::ReadStreamIntToStringToFloat(int32_t& value) {
+void ProtocolWithChangesReaderBase::ReadOptionalRecordToUnion(std::optional<evo_test::RecordWithChanges>& value) {
   if (unlikely(state_ != 80)) {
-    if (state_ == 81) {
-      state_ = 82;
+    ProtocolWithChangesReaderBaseInvalidState(80, state_);
+  }
+
+  ReadOptionalRecordToUnionImpl(value);
+  state_ = 82;
+}
+
+void ProtocolWithChangesReaderBase::ReadRecordWithChanges(evo_test::RecordWithChanges& value) {
+  if (unlikely(state_ != 82)) {
+    ProtocolWithChangesReaderBaseInvalidState(82, state_);
+  }
+
+  ReadRecordWithChangesImpl(value);
+  state_ = 84;
+}
+
+void ProtocolWithChangesReaderBase::ReadAliasedRecordWithChanges(evo_test::AliasedRecordWithChanges& value) {
+  if (unlikely(state_ != 84)) {
+    ProtocolWithChangesReaderBaseInvalidState(84, state_);
+  }
+
+  ReadAliasedRecordWithChangesImpl(value);
+  state_ = 86;
+}
+
+void ProtocolWithChangesReaderBase::ReadRecordToRenamedRecord(evo_test::RenamedRecord& value) {
+  if (unlikely(state_ != 86)) {
+    ProtocolWithChangesReaderBaseInvalidState(86, state_);
+  }
+
+  ReadRecordToRenamedRecordImpl(value);
+  state_ = 88;
+}
+
+void ProtocolWithChangesReaderBase::ReadRecordToAliasedRecord(evo_test::RecordWithChanges& value) {
+  if (unlikely(state_ != 88)) {
+    ProtocolWithChangesReaderBaseInvalidState(88, state_);
+  }
+
+  ReadRecordToAliasedRecordImpl(value);
+  state_ = 90;
+}
+
+void ProtocolWithChangesReaderBase::ReadRecordToAliasedAlias(evo_test::RecordWithChanges& value) {
+  if (unlikely(state_ != 90)) {
+    ProtocolWithChangesReaderBaseInvalidState(90, state_);
+  }
+
+  ReadRecordToAliasedAliasImpl(value);
+  state_ = 92;
+}
+
+bool ProtocolWithChangesReaderBase::ReadStreamIntToStringToFloat(int32_t& value) {
+  if (unlikely(state_ != 92)) {
+    if (state_ == 93) {
+      state_ = 94;
       return false;
     }
-    ProtocolWithChangesReaderBaseInvalidState(80, state_);
+    ProtocolWithChangesReaderBaseInvalidState(92, state_);
   }
 
   bool result = ReadStreamIntToStringToFloatImpl(value);
   if (!result) {
-    state_ = 82;
+    state_ = 94;
   }
   return result;
 }
@@ -1781,17 +1907,17 @@ bool ProtocolWithChangesReaderBase::ReadStreamIntToStringToFloat(std::vector<int
   if (values.capacity() == 0) {
     throw std::runtime_error("vector must have a nonzero capacity.");
   }
-  if (unlikely(state_ != 80)) {
-    if (state_ == 81) {
-      state_ = 82;
+  if (unlikely(state_ != 92)) {
+    if (state_ == 93) {
+      state_ = 94;
       values.clear();
       return false;
     }
-    ProtocolWithChangesReaderBaseInvalidState(80, state_);
+    ProtocolWithChangesReaderBaseInvalidState(92, state_);
   }
 
   if (!ReadStreamIntToStringToFloatImpl(values)) {
-    state_ = 81;
+    state_ = 93;
     return values.size() > 0;
   }
   return true;
@@ -1816,48 +1942,48 @@ bool ProtocolWithChangesReaderBase::ReadStreamIntToStringToFloatImpl(std::vector
 }
 
 void ProtocolWithChangesReaderBase::ReadVectorIntToStringToFloat(std::vector<int32_t>& value) {
-  if (unlikely(state_ != 82)) {
-    if (state_ == 81) {
-      state_ = 82;
+  if (unlikely(state_ != 94)) {
+    if (state_ == 93) {
+      state_ = 94;
     } else {
-      ProtocolWithChangesReaderBaseInvalidState(82, state_);
+      ProtocolWithChangesReaderBaseInvalidState(94, state_);
     }
   }
 
   ReadVectorIntToStringToFloatImpl(value);
-  state_ = 84;
+  state_ = 96;
 }
 
 void ProtocolWithChangesReaderBase::ReadIntFloatUnionReordered(std::variant<int32_t, float>& value) {
-  if (unlikely(state_ != 84)) {
-    ProtocolWithChangesReaderBaseInvalidState(84, state_);
+  if (unlikely(state_ != 96)) {
+    ProtocolWithChangesReaderBaseInvalidState(96, state_);
   }
 
   ReadIntFloatUnionReorderedImpl(value);
-  state_ = 86;
+  state_ = 98;
 }
 
 void ProtocolWithChangesReaderBase::ReadVectorUnionReordered(std::vector<std::variant<int32_t, float>>& value) {
-  if (unlikely(state_ != 86)) {
-    ProtocolWithChangesReaderBaseInvalidState(86, state_);
+  if (unlikely(state_ != 98)) {
+    ProtocolWithChangesReaderBaseInvalidState(98, state_);
   }
 
   ReadVectorUnionReorderedImpl(value);
-  state_ = 88;
+  state_ = 100;
 }
 
 bool ProtocolWithChangesReaderBase::ReadStreamUnionReordered(std::variant<int32_t, std::string>& value) {
-  if (unlikely(state_ != 88)) {
-    if (state_ == 89) {
-      state_ = 90;
+  if (unlikely(state_ != 100)) {
+    if (state_ == 101) {
+      state_ = 102;
       return false;
     }
-    ProtocolWithChangesReaderBaseInvalidState(88, state_);
+    ProtocolWithChangesReaderBaseInvalidState(100, state_);
   }
 
   bool result = ReadStreamUnionReorderedImpl(value);
   if (!result) {
-    state_ = 90;
+    state_ = 102;
   }
   return result;
 }
@@ -1866,17 +1992,17 @@ bool ProtocolWithChangesReaderBase::ReadStreamUnionReordered(std::vector<std::va
   if (values.capacity() == 0) {
     throw std::runtime_error("vector must have a nonzero capacity.");
   }
-  if (unlikely(state_ != 88)) {
-    if (state_ == 89) {
-      state_ = 90;
+  if (unlikely(state_ != 100)) {
+    if (state_ == 101) {
+      state_ = 102;
       values.clear();
       return false;
     }
-    ProtocolWithChangesReaderBaseInvalidState(88, state_);
+    ProtocolWithChangesReaderBaseInvalidState(100, state_);
   }
 
   if (!ReadStreamUnionReorderedImpl(values)) {
-    state_ = 89;
+    state_ = 101;
     return values.size() > 0;
   }
   return true;
@@ -1901,21 +2027,21 @@ bool ProtocolWithChangesReaderBase::ReadStreamUnionReorderedImpl(std::vector<std
 }
 
 bool ProtocolWithChangesReaderBase::ReadStreamOfAliasTypeChange(evo_test::StreamItem& value) {
-  if (unlikely(state_ != 90)) {
-    if (state_ == 91) {
-      state_ = 92;
+  if (unlikely(state_ != 102)) {
+    if (state_ == 103) {
+      state_ = 104;
       return false;
     }
-    if (state_ == 89) {
-      state_ = 90;
+    if (state_ == 101) {
+      state_ = 102;
     } else {
-      ProtocolWithChangesReaderBaseInvalidState(90, state_);
+      ProtocolWithChangesReaderBaseInvalidState(102, state_);
     }
   }
 
   bool result = ReadStreamOfAliasTypeChangeImpl(value);
   if (!result) {
-    state_ = 92;
+    state_ = 104;
   }
   return result;
 }
@@ -1924,21 +2050,21 @@ bool ProtocolWithChangesReaderBase::ReadStreamOfAliasTypeChange(std::vector<evo_
   if (values.capacity() == 0) {
     throw std::runtime_error("vector must have a nonzero capacity.");
   }
-  if (unlikely(state_ != 90)) {
-    if (state_ == 91) {
-      state_ = 92;
+  if (unlikely(state_ != 102)) {
+    if (state_ == 103) {
+      state_ = 104;
       values.clear();
       return false;
     }
-    if (state_ == 89) {
-      state_ = 90;
+    if (state_ == 101) {
+      state_ = 102;
     } else {
-      ProtocolWithChangesReaderBaseInvalidState(90, state_);
+      ProtocolWithChangesReaderBaseInvalidState(102, state_);
     }
   }
 
   if (!ReadStreamOfAliasTypeChangeImpl(values)) {
-    state_ = 91;
+    state_ = 103;
     return values.size() > 0;
   }
   return true;
@@ -1953,481 +2079,481 @@ bool ProtocolWithChangesReaderBase::ReadStreamOfAliasTypeChangeImpl(std::vector<
     }
     if (!ReadStreamOfAliasTypeChangeImpl(values[i])) {
       values.resize(i);
-      return false;
-    }
-    i++;
-    if (i == values.capacity()) {
-      return true;
-    }
-  }
-}
-
-void ProtocolWithChangesReaderBase::ReadRlink(evo_test::RLink& value) {
-  if (unlikely(state_ != 92)) {
-    if (state_ == 91) {
-      state_ = 92;
-    } else {
-      ProtocolWithChangesReaderBaseInvalidState(92, state_);
-    }
-  }
-
-  ReadRlinkImpl(value);
-  state_ = 94;
-}
-
-void ProtocolWithChangesReaderBase::ReadRlinkRX(evo_test::RLink& value) {
-  if (unlikely(state_ != 94)) {
-    ProtocolWithChangesReaderBaseInvalidState(94, state_);
-  }
-
-  ReadRlinkRXImpl(value);
-  state_ = 96;
-}
-
-void ProtocolWithChangesReaderBase::ReadRlinkRY(evo_test::RLink& value) {
-  if (unlikely(state_ != 96)) {
-    ProtocolWithChangesReaderBaseInvalidState(96, state_);
-  }
-
-  ReadRlinkRYImpl(value);
-  state_ = 98;
-}
-
-void ProtocolWithChangesReaderBase::ReadRlinkRZ(evo_test::RLink& value) {
-  if (unlikely(state_ != 98)) {
-    ProtocolWithChangesReaderBaseInvalidState(98, state_);
-  }
-
-  ReadRlinkRZImpl(value);
-  state_ = 100;
-}
-
-void ProtocolWithChangesReaderBase::ReadRaRLink(evo_test::RA& value) {
-  if (unlikely(state_ != 100)) {
-    ProtocolWithChangesReaderBaseInvalidState(100, state_);
-  }
-
-  ReadRaRLinkImpl(value);
-  state_ = 102;
-}
-
-void ProtocolWithChangesReaderBase::ReadRaRX(evo_test::RA& value) {
-  if (unlikely(state_ != 102)) {
-    ProtocolWithChangesReaderBaseInvalidState(102, state_);
+      return false;
+    }
+    i++;
+    if (i == values.capacity()) {
+      return true;
+    }
   }
-
-  ReadRaRXImpl(value);
-  state_ = 104;
 }
 
-void ProtocolWithChangesReaderBase::ReadRaRY(evo_test::RA& value) {
+void ProtocolWithChangesReaderBase::ReadRlink(evo_test::RLink& value) {
   if (unlikely(state_ != 104)) {
-    ProtocolWithChangesReaderBaseInvalidState(104, state_);
+    if (state_ == 103) {
+      state_ = 104;
+    } else {
+      ProtocolWithChangesReaderBaseInvalidState(104, state_);
+    }
   }
 
-  ReadRaRYImpl(value);
+  ReadRlinkImpl(value);
   state_ = 106;
 }
 
-void ProtocolWithChangesReaderBase::ReadRaRZ(evo_test::RA& value) {
+void ProtocolWithChangesReaderBase::ReadRlinkRX(evo_test::RLink& value) {
   if (unlikely(state_ != 106)) {
     ProtocolWithChangesReaderBaseInvalidState(106, state_);
   }
 
-  ReadRaRZImpl(value);
+  ReadRlinkRXImpl(value);
   state_ = 108;
 }
 
-void ProtocolWithChangesReaderBase::ReadRbRLink(evo_test::RB& value) {
+void ProtocolWithChangesReaderBase::ReadRlinkRY(evo_test::RLink& value) {
   if (unlikely(state_ != 108)) {
     ProtocolWithChangesReaderBaseInvalidState(108, state_);
   }
 
-  ReadRbRLinkImpl(value);
+  ReadRlinkRYImpl(value);
   state_ = 110;
 }
 
-void ProtocolWithChangesReaderBase::ReadRbRX(evo_test::RB& value) {
+void ProtocolWithChangesReaderBase::ReadRlinkRZ(evo_test::RLink& value) {
   if (unlikely(state_ != 110)) {
     ProtocolWithChangesReaderBaseInvalidState(110, state_);
   }
 
-  ReadRbRXImpl(value);
+  ReadRlinkRZImpl(value);
   state_ = 112;
 }
 
-void ProtocolWithChangesReaderBase::ReadRbRY(evo_test::RB& value) {
+void ProtocolWithChangesReaderBase::ReadRaRLink(evo_test::RA& value) {
   if (unlikely(state_ != 112)) {
     ProtocolWithChangesReaderBaseInvalidState(112, state_);
   }
 
-  ReadRbRYImpl(value);
+  ReadRaRLinkImpl(value);
   state_ = 114;
 }
 
-void ProtocolWithChangesReaderBase::ReadRbRZ(evo_test::RB& value) {
+void ProtocolWithChangesReaderBase::ReadRaRX(evo_test::RA& value) {
   if (unlikely(state_ != 114)) {
     ProtocolWithChangesReaderBaseInvalidState(114, state_);
   }
 
-  ReadRbRZImpl(value);
+  ReadRaRXImpl(value);
   state_ = 116;
 }
 
-void ProtocolWithChangesReaderBase::ReadRcRLink(evo_test::RC& value) {
+void ProtocolWithChangesReaderBase::ReadRaRY(evo_test::RA& value) {
   if (unlikely(state_ != 116)) {
     ProtocolWithChangesReaderBaseInvalidState(116, state_);
   }
 
-  ReadRcRLinkImpl(value);
+  ReadRaRYImpl(value);
   state_ = 118;
 }
 
-void ProtocolWithChangesReaderBase::ReadRcRX(evo_test::RC& value) {
+void ProtocolWithChangesReaderBase::ReadRaRZ(evo_test::RA& value) {
   if (unlikely(state_ != 118)) {
     ProtocolWithChangesReaderBaseInvalidState(118, state_);
   }
 
-  ReadRcRXImpl(value);
+  ReadRaRZImpl(value);
   state_ = 120;
 }
 
-void ProtocolWithChangesReaderBase::ReadRcRY(evo_test::RC& value) {
+void ProtocolWithChangesReaderBase::ReadRbRLink(evo_test::RB& value) {
   if (unlikely(state_ != 120)) {
     ProtocolWithChangesReaderBaseInvalidState(120, state_);
   }
 
-  ReadRcRYImpl(value);
+  ReadRbRLinkImpl(value);
   state_ = 122;
 }
 
-void ProtocolWithChangesReaderBase::ReadRcRZ(evo_test::RC& value) {
+void ProtocolWithChangesReaderBase::ReadRbRX(evo_test::RB& value) {
   if (unlikely(state_ != 122)) {
     ProtocolWithChangesReaderBaseInvalidState(122, state_);
   }
 
-  ReadRcRZImpl(value);
+  ReadRbRXImpl(value);
   state_ = 124;
 }
 
-void ProtocolWithChangesReaderBase::ReadRlinkRNew(evo_test::RLink& value) {
+void ProtocolWithChangesReaderBase::ReadRbRY(evo_test::RB& value) {
   if (unlikely(state_ != 124)) {
     ProtocolWithChangesReaderBaseInvalidState(124, state_);
   }
 
-  ReadRlinkRNewImpl(value);
+  ReadRbRYImpl(value);
   state_ = 126;
 }
 
-void ProtocolWithChangesReaderBase::ReadRaRNew(evo_test::RA& value) {
+void ProtocolWithChangesReaderBase::ReadRbRZ(evo_test::RB& value) {
   if (unlikely(state_ != 126)) {
     ProtocolWithChangesReaderBaseInvalidState(126, state_);
   }
 
-  ReadRaRNewImpl(value);
+  ReadRbRZImpl(value);
   state_ = 128;
 }
 
-void ProtocolWithChangesReaderBase::ReadRbRNew(evo_test::RB& value) {
+void ProtocolWithChangesReaderBase::ReadRcRLink(evo_test::RC& value) {
   if (unlikely(state_ != 128)) {
     ProtocolWithChangesReaderBaseInvalidState(128, state_);
   }
 
-  ReadRbRNewImpl(value);
+  ReadRcRLinkImpl(value);
   state_ = 130;
 }
 
-void ProtocolWithChangesReaderBase::ReadRcRNew(evo_test::RC& value) {
+void ProtocolWithChangesReaderBase::ReadRcRX(evo_test::RC& value) {
   if (unlikely(state_ != 130)) {
     ProtocolWithChangesReaderBaseInvalidState(130, state_);
   }
 
-  ReadRcRNewImpl(value);
+  ReadRcRXImpl(value);
   state_ = 132;
 }
 
-void ProtocolWithChangesReaderBase::ReadRlinkRUnion(evo_test::RLink& value) {
+void ProtocolWithChangesReaderBase::ReadRcRY(evo_test::RC& value) {
   if (unlikely(state_ != 132)) {
     ProtocolWithChangesReaderBaseInvalidState(132, state_);
   }
 
-  ReadRlinkRUnionImpl(value);
+  ReadRcRYImpl(value);
   state_ = 134;
 }
 
-void ProtocolWithChangesReaderBase::ReadRaRUnion(evo_test::RA& value) {
+void ProtocolWithChangesReaderBase::ReadRcRZ(evo_test::RC& value) {
   if (unlikely(state_ != 134)) {
     ProtocolWithChangesReaderBaseInvalidState(134, state_);
   }
 
-  ReadRaRUnionImpl(value);
+  ReadRcRZImpl(value);
   state_ = 136;
 }
 
-void ProtocolWithChangesReaderBase::ReadRbRUnion(evo_test::RB& value) {
+void ProtocolWithChangesReaderBase::ReadRlinkRNew(evo_test::RLink& value) {
   if (unlikely(state_ != 136)) {
     ProtocolWithChangesReaderBaseInvalidState(136, state_);
   }
 
-  ReadRbRUnionImpl(value);
+  ReadRlinkRNewImpl(value);
   state_ = 138;
 }
 
-void ProtocolWithChangesReaderBase::ReadRcRUnion(evo_test::RC& value) {
+void ProtocolWithChangesReaderBase::ReadRaRNew(evo_test::RA& value) {
   if (unlikely(state_ != 138)) {
     ProtocolWithChangesReaderBaseInvalidState(138, state_);
   }
 
-  ReadRcRUnionImpl(value);
+  ReadRaRNewImpl(value);
   state_ = 140;
 }
 
-void ProtocolWithChangesReaderBase::ReadOptionalRecordWithChanges(std::optional<evo_test::RecordWithChanges>& value) {
+void ProtocolWithChangesReaderBase::ReadRbRNew(evo_test::RB& value) {
   if (unlikely(state_ != 140)) {
     ProtocolWithChangesReaderBaseInvalidState(140, state_);
   }
 
-  ReadOptionalRecordWithChangesImpl(value);
+  ReadRbRNewImpl(value);
   state_ = 142;
 }
 
-void ProtocolWithChangesReaderBase::ReadAliasedOptionalRecordWithChanges(std::optional<evo_test::AliasedRecordWithChanges>& value) {
+void ProtocolWithChangesReaderBase::ReadRcRNew(evo_test::RC& value) {
   if (unlikely(state_ != 142)) {
     ProtocolWithChangesReaderBaseInvalidState(142, state_);
   }
 
-  ReadAliasedOptionalRecordWithChangesImpl(value);
+  ReadRcRNewImpl(value);
   state_ = 144;
 }
 
-void ProtocolWithChangesReaderBase::ReadUnionRecordWithChanges(std::variant<evo_test::RecordWithChanges, int32_t>& value) {
+void ProtocolWithChangesReaderBase::ReadRlinkRUnion(evo_test::RLink& value) {
   if (unlikely(state_ != 144)) {
     ProtocolWithChangesReaderBaseInvalidState(144, state_);
   }
 
-  ReadUnionRecordWithChangesImpl(value);
+  ReadRlinkRUnionImpl(value);
   state_ = 146;
 }
 
-void ProtocolWithChangesReaderBase::ReadUnionWithSameTypeset(std::variant<evo_test::RecordWithChanges, int32_t, float, std::string>& value) {
+void ProtocolWithChangesReaderBase::ReadRaRUnion(evo_test::RA& value) {
   if (unlikely(state_ != 146)) {
     ProtocolWithChangesReaderBaseInvalidState(146, state_);
   }
 
-  ReadUnionWithSameTypesetImpl(value);
+  ReadRaRUnionImpl(value);
   state_ = 148;
 }
 
-void ProtocolWithChangesReaderBase::ReadUnionWithTypesAdded(std::variant<evo_test::RecordWithChanges, float>& value) {
+void ProtocolWithChangesReaderBase::ReadRbRUnion(evo_test::RB& value) {
   if (unlikely(state_ != 148)) {
     ProtocolWithChangesReaderBaseInvalidState(148, state_);
   }
 
-  ReadUnionWithTypesAddedImpl(value);
+  ReadRbRUnionImpl(value);
   state_ = 150;
 }
 
-void ProtocolWithChangesReaderBase::ReadUnionWithTypesRemoved(std::variant<evo_test::RecordWithChanges, int32_t, float, std::string>& value) {
+void ProtocolWithChangesReaderBase::ReadRcRUnion(evo_test::RC& value) {
   if (unlikely(state_ != 150)) {
     ProtocolWithChangesReaderBaseInvalidState(150, state_);
   }
 
-  ReadUnionWithTypesRemovedImpl(value);
+  ReadRcRUnionImpl(value);
   state_ = 152;
 }
 
-void ProtocolWithChangesReaderBase::ReadRecordToOptional(evo_test::RecordWithChanges& value) {
+void ProtocolWithChangesReaderBase::ReadOptionalRecordWithChanges(std::optional<evo_test::RecordWithChanges>& value) {
   if (unlikely(state_ != 152)) {
     ProtocolWithChangesReaderBaseInvalidState(152, state_);
   }
 
-  ReadRecordToOptionalImpl(value);
+  ReadOptionalRecordWithChangesImpl(value);
   state_ = 154;
 }
 
-void ProtocolWithChangesReaderBase::ReadRecordToAliasedOptional(evo_test::RecordWithChanges& value) {
+void ProtocolWithChangesReaderBase::ReadAliasedOptionalRecordWithChanges(std::optional<evo_test::AliasedRecordWithChanges>& value) {
   if (unlikely(state_ != 154)) {
     ProtocolWithChangesReaderBaseInvalidState(154, state_);
   }
 
-  ReadRecordToAliasedOptionalImpl(value);
+  ReadAliasedOptionalRecordWithChangesImpl(value);
   state_ = 156;
 }
 
-void ProtocolWithChangesReaderBase::ReadRecordToUnion(evo_test::RecordWithChanges& value) {
+void ProtocolWithChangesReaderBase::ReadUnionRecordWithChanges(std::variant<evo_test::RecordWithChanges, int32_t>& value) {
   if (unlikely(state_ != 156)) {
     ProtocolWithChangesReaderBaseInvalidState(156, state_);
   }
 
-  ReadRecordToUnionImpl(value);
+  ReadUnionRecordWithChangesImpl(value);
   state_ = 158;
 }
 
-void ProtocolWithChangesReaderBase::ReadRecordToAliasedUnion(evo_test::RecordWithChanges& value) {
+void ProtocolWithChangesReaderBase::ReadUnionWithSameTypeset(std::variant<evo_test::RecordWithChanges, int32_t, float, std::string>& value) {
   if (unlikely(state_ != 158)) {
     ProtocolWithChangesReaderBaseInvalidState(158, state_);
   }
 
-  ReadRecordToAliasedUnionImpl(value);
+  ReadUnionWithSameTypesetImpl(value);
   state_ = 160;
 }
 
-void ProtocolWithChangesReaderBase::ReadUnionToAliasedUnion(std::variant<evo_test::RecordWithChanges, int32_t>& value) {
+void ProtocolWithChangesReaderBase::ReadUnionWithTypesAdded(std::variant<evo_test::RecordWithChanges, float>& value) {
   if (unlikely(state_ != 160)) {
     ProtocolWithChangesReaderBaseInvalidState(160, state_);
   }
 
-  ReadUnionToAliasedUnionImpl(value);
+  ReadUnionWithTypesAddedImpl(value);
   state_ = 162;
 }
 
-void ProtocolWithChangesReaderBase::ReadUnionToAliasedUnionWithChanges(std::variant<evo_test::RecordWithChanges, int32_t>& value) {
+void ProtocolWithChangesReaderBase::ReadUnionWithTypesRemoved(std::variant<evo_test::RecordWithChanges, int32_t, float, std::string>& value) {
   if (unlikely(state_ != 162)) {
     ProtocolWithChangesReaderBaseInvalidState(162, state_);
   }
 
-  ReadUnionToAliasedUnionWithChangesImpl(value);
+  ReadUnionWithTypesRemovedImpl(value);
   state_ = 164;
 }
 
-void ProtocolWithChangesReaderBase::ReadOptionalToAliasedOptional(std::optional<evo_test::RecordWithChanges>& value) {
+void ProtocolWithChangesReaderBase::ReadRecordToOptional(evo_test::RecordWithChanges& value) {
   if (unlikely(state_ != 164)) {
     ProtocolWithChangesReaderBaseInvalidState(164, state_);
   }
 
-  ReadOptionalToAliasedOptionalImpl(value);
+  ReadRecordToOptionalImpl(value);
   state_ = 166;
 }
 
-void ProtocolWithChangesReaderBase::ReadOptionalToAliasedOptionalWithChanges(std::optional<int32_t>& value) {
+void ProtocolWithChangesReaderBase::ReadRecordToAliasedOptional(evo_test::RecordWithChanges& value) {
   if (unlikely(state_ != 166)) {
     ProtocolWithChangesReaderBaseInvalidState(166, state_);
   }
 
-  ReadOptionalToAliasedOptionalWithChangesImpl(value);
+  ReadRecordToAliasedOptionalImpl(value);
   state_ = 168;
 }
 
-void ProtocolWithChangesReaderBase::ReadGenericRecord(evo_test::GenericRecord<int32_t, std::string>& value) {
+void ProtocolWithChangesReaderBase::ReadRecordToUnion(evo_test::RecordWithChanges& value) {
   if (unlikely(state_ != 168)) {
     ProtocolWithChangesReaderBaseInvalidState(168, state_);
   }
 
-  ReadGenericRecordImpl(value);
+  ReadRecordToUnionImpl(value);
   state_ = 170;
 }
 
-void ProtocolWithChangesReaderBase::ReadGenericRecordToOpenAlias(evo_test::GenericRecord<int32_t, std::string>& value) {
+void ProtocolWithChangesReaderBase::ReadRecordToAliasedUnion(evo_test::RecordWithChanges& value) {
   if (unlikely(state_ != 170)) {
     ProtocolWithChangesReaderBaseInvalidState(170, state_);
   }
 
-  ReadGenericRecordToOpenAliasImpl(value);
+  ReadRecordToAliasedUnionImpl(value);
   state_ = 172;
 }
 
-void ProtocolWithChangesReaderBase::ReadGenericRecordToClosedAlias(evo_test::GenericRecord<int32_t, std::string>& value) {
+void ProtocolWithChangesReaderBase::ReadUnionToAliasedUnion(std::variant<evo_test::RecordWithChanges, int32_t>& value) {
   if (unlikely(state_ != 172)) {
     ProtocolWithChangesReaderBaseInvalidState(172, state_);
   }
 
-  ReadGenericRecordToClosedAliasImpl(value);
+  ReadUnionToAliasedUnionImpl(value);
   state_ = 174;
 }
 
-void ProtocolWithChangesReaderBase::ReadGenericRecordToHalfClosedAlias(evo_test::GenericRecord<int32_t, std::string>& value) {
+void ProtocolWithChangesReaderBase::ReadUnionToAliasedUnionWithChanges(std::variant<evo_test::RecordWithChanges, int32_t>& value) {
   if (unlikely(state_ != 174)) {
     ProtocolWithChangesReaderBaseInvalidState(174, state_);
   }
 
-  ReadGenericRecordToHalfClosedAliasImpl(value);
+  ReadUnionToAliasedUnionWithChangesImpl(value);
   state_ = 176;
 }
 
-void ProtocolWithChangesReaderBase::ReadAliasedGenericRecordToAlias(evo_test::AliasedHalfClosedGenericRecord<int32_t>& value) {
+void ProtocolWithChangesReaderBase::ReadOptionalToAliasedOptional(std::optional<evo_test::RecordWithChanges>& value) {
   if (unlikely(state_ != 176)) {
     ProtocolWithChangesReaderBaseInvalidState(176, state_);
   }
 
-  ReadAliasedGenericRecordToAliasImpl(value);
+  ReadOptionalToAliasedOptionalImpl(value);
   state_ = 178;
 }
 
-void ProtocolWithChangesReaderBase::ReadGenericRecordToReversed(evo_test::GenericRecord<int32_t, std::string>& value) {
+void ProtocolWithChangesReaderBase::ReadOptionalToAliasedOptionalWithChanges(std::optional<int32_t>& value) {
   if (unlikely(state_ != 178)) {
     ProtocolWithChangesReaderBaseInvalidState(178, state_);
   }
 
-  ReadGenericRecordToReversedImpl(value);
+  ReadOptionalToAliasedOptionalWithChangesImpl(value);
   state_ = 180;
 }
 
-void ProtocolWithChangesReaderBase::ReadClosedGenericRecordToUnion(evo_test::AliasedClosedGenericRecord& value) {
+void ProtocolWithChangesReaderBase::ReadGenericRecord(evo_test::GenericRecord<int32_t, std::string>& value) {
   if (unlikely(state_ != 180)) {
     ProtocolWithChangesReaderBaseInvalidState(180, state_);
   }
 
-  ReadClosedGenericRecordToUnionImpl(value);
+  ReadGenericRecordImpl(value);
   state_ = 182;
 }
 
-void ProtocolWithChangesReaderBase::ReadGenericRecordToAliasedUnion(evo_test::GenericRecord<int32_t, std::string>& value) {
+void ProtocolWithChangesReaderBase::ReadGenericRecordToOpenAlias(evo_test::GenericRecord<int32_t, std::string>& value) {
   if (unlikely(state_ != 182)) {
     ProtocolWithChangesReaderBaseInvalidState(182, state_);
   }
 
-  ReadGenericRecordToAliasedUnionImpl(value);
+  ReadGenericRecordToOpenAliasImpl(value);
   state_ = 184;
 }
 
-void ProtocolWithChangesReaderBase::ReadGenericUnionToReversed(evo_test::AliasedClosedGenericUnion& value) {
+void ProtocolWithChangesReaderBase::ReadGenericRecordToClosedAlias(evo_test::GenericRecord<int32_t, std::string>& value) {
   if (unlikely(state_ != 184)) {
     ProtocolWithChangesReaderBaseInvalidState(184, state_);
   }
 
-  ReadGenericUnionToReversedImpl(value);
+  ReadGenericRecordToClosedAliasImpl(value);
   state_ = 186;
 }
 
-void ProtocolWithChangesReaderBase::ReadGenericUnionOfChangedRecord(evo_test::AliasedClosedGenericUnion& value) {
+void ProtocolWithChangesReaderBase::ReadGenericRecordToHalfClosedAlias(evo_test::GenericRecord<int32_t, std::string>& value) {
   if (unlikely(state_ != 186)) {
     ProtocolWithChangesReaderBaseInvalidState(186, state_);
   }
 
-  ReadGenericUnionOfChangedRecordImpl(value);
+  ReadGenericRecordToHalfClosedAliasImpl(value);
   state_ = 188;
 }
 
-void ProtocolWithChangesReaderBase::ReadGenericParentRecord(evo_test::GenericParentRecord<int32_t>& value) {
+void ProtocolWithChangesReaderBase::ReadAliasedGenericRecordToAlias(evo_test::AliasedHalfClosedGenericRecord<int32_t>& value) {
   if (unlikely(state_ != 188)) {
     ProtocolWithChangesReaderBaseInvalidState(188, state_);
   }
 
-  ReadGenericParentRecordImpl(value);
+  ReadAliasedGenericRecordToAliasImpl(value);
   state_ = 190;
 }
 
-void ProtocolWithChangesReaderBase::ReadGenericNestedRecords(evo_test::GenericRecord<evo_test::UnchangedGeneric<int32_t>, evo_test::ChangedGeneric<std::string, int32_t>>& value) {
+void ProtocolWithChangesReaderBase::ReadGenericRecordToReversed(evo_test::GenericRecord<int32_t, std::string>& value) {
   if (unlikely(state_ != 190)) {
     ProtocolWithChangesReaderBaseInvalidState(190, state_);
   }
 
-  ReadGenericNestedRecordsImpl(value);
+  ReadGenericRecordToReversedImpl(value);
   state_ = 192;
 }
 
-bool ProtocolWithChangesReaderBase::ReadGenericRecordStream(evo_test::GenericRecord<int32_t, std::string>& value) {
+void ProtocolWithChangesReaderBase::ReadClosedGenericRecordToUnion(evo_test::AliasedClosedGenericRecord& value) {
   if (unlikely(state_ != 192)) {
-    if (state_ == 193) {
-      state_ = 194;
+    ProtocolWithChangesReaderBaseInvalidState(192, state_);
+  }
+
+  ReadClosedGenericRecordToUnionImpl(value);
+  state_ = 194;
+}
+
+void ProtocolWithChangesReaderBase::ReadGenericRecordToAliasedUnion(evo_test::GenericRecord<int32_t, std::string>& value) {
+  if (unlikely(state_ != 194)) {
+    ProtocolWithChangesReaderBaseInvalidState(194, state_);
+  }
+
+  ReadGenericRecordToAliasedUnionImpl(value);
+  state_ = 196;
+}
+
+void ProtocolWithChangesReaderBase::ReadGenericUnionToReversed(evo_test::AliasedClosedGenericUnion& value) {
+  if (unlikely(state_ != 196)) {
+    ProtocolWithChangesReaderBaseInvalidState(196, state_);
+  }
+
+  ReadGenericUnionToReversedImpl(value);
+  state_ = 198;
+}
+
+void ProtocolWithChangesReaderBase::ReadGenericUnionOfChangedRecord(evo_test::AliasedClosedGenericUnion& value) {
+  if (unlikely(state_ != 198)) {
+    ProtocolWithChangesReaderBaseInvalidState(198, state_);
+  }
+
+  ReadGenericUnionOfChangedRecordImpl(value);
+  state_ = 200;
+}
+
+void ProtocolWithChangesReaderBase::ReadGenericParentRecord(evo_test::GenericParentRecord<int32_t>& value) {
+  if (unlikely(state_ != 200)) {
+    ProtocolWithChangesReaderBaseInvalidState(200, state_);
+  }
+
+  ReadGenericParentRecordImpl(value);
+  state_ = 202;
+}
+
+void ProtocolWithChangesReaderBase::ReadGenericNestedRecords(evo_test::GenericRecord<evo_test::UnchangedGeneric<int32_t>, evo_test::ChangedGeneric<std::string, int32_t>>& value) {
+  if (unlikely(state_ != 202)) {
+    ProtocolWithChangesReaderBaseInvalidState(202, state_);
+  }
+
+  ReadGenericNestedRecordsImpl(value);
+  state_ = 204;
+}
+
+bool ProtocolWithChangesReaderBase::ReadGenericRecordStream(evo_test::GenericRecord<int32_t, std::string>& value) {
+  if (unlikely(state_ != 204)) {
+    if (state_ == 205) {
+      state_ = 206;
       return false;
     }
-    ProtocolWithChangesReaderBaseInvalidState(192, state_);
+    ProtocolWithChangesReaderBaseInvalidState(204, state_);
   }
 
   bool result = ReadGenericRecordStreamImpl(value);
   if (!result) {
-    state_ = 194;
+    state_ = 206;
   }
   return result;
 }
@@ -2436,17 +2562,17 @@ bool ProtocolWithChangesReaderBase::ReadGenericRecordStream(std::vector<evo_test
   if (values.capacity() == 0) {
     throw std::runtime_error("vector must have a nonzero capacity.");
   }
-  if (unlikely(state_ != 192)) {
-    if (state_ == 193) {
-      state_ = 194;
+  if (unlikely(state_ != 204)) {
+    if (state_ == 205) {
+      state_ = 206;
       values.clear();
       return false;
     }
-    ProtocolWithChangesReaderBaseInvalidState(192, state_);
+    ProtocolWithChangesReaderBaseInvalidState(204, state_);
   }
 
   if (!ReadGenericRecordStreamImpl(values)) {
-    state_ = 193;
+    state_ = 205;
     return values.size() > 0;
   }
   return true;
@@ -2471,21 +2597,21 @@ bool ProtocolWithChangesReaderBase::ReadGenericRecordStreamImpl(std::vector<evo_
 }
 
 bool ProtocolWithChangesReaderBase::ReadGenericParentRecordStream(evo_test::GenericParentRecord<int32_t>& value) {
-  if (unlikely(state_ != 194)) {
-    if (state_ == 195) {
-      state_ = 196;
+  if (unlikely(state_ != 206)) {
+    if (state_ == 207) {
+      state_ = 208;
       return false;
     }
-    if (state_ == 193) {
-      state_ = 194;
+    if (state_ == 205) {
+      state_ = 206;
     } else {
-      ProtocolWithChangesReaderBaseInvalidState(194, state_);
+      ProtocolWithChangesReaderBaseInvalidState(206, state_);
     }
   }
 
   bool result = ReadGenericParentRecordStreamImpl(value);
   if (!result) {
-    state_ = 196;
+    state_ = 208;
   }
   return result;
 }
@@ -2494,21 +2620,21 @@ bool ProtocolWithChangesReaderBase::ReadGenericParentRecordStream(std::vector<ev
   if (values.capacity() == 0) {
     throw std::runtime_error("vector must have a nonzero capacity.");
   }
-  if (unlikely(state_ != 194)) {
-    if (state_ == 195) {
-      state_ = 196;
+  if (unlikely(state_ != 206)) {
+    if (state_ == 207) {
+      state_ = 208;
       values.clear();
       return false;
     }
-    if (state_ == 193) {
-      state_ = 194;
+    if (state_ == 205) {
+      state_ = 206;
     } else {
-      ProtocolWithChangesReaderBaseInvalidState(194, state_);
+      ProtocolWithChangesReaderBaseInvalidState(206, state_);
     }
   }
 
   if (!ReadGenericParentRecordStreamImpl(values)) {
-    state_ = 195;
+    state_ = 207;
     return values.size() > 0;
   }
   return true;
@@ -2533,30 +2659,30 @@ bool ProtocolWithChangesReaderBase::ReadGenericParentRecordStreamImpl(std::vecto
 }
 
 void ProtocolWithChangesReaderBase::ReadVectorRecordWithChanges(std::vector<evo_test::RecordWithChanges>& value) {
-  if (unlikely(state_ != 196)) {
-    if (state_ == 195) {
-      state_ = 196;
+  if (unlikely(state_ != 208)) {
+    if (state_ == 207) {
+      state_ = 208;
     } else {
-      ProtocolWithChangesReaderBaseInvalidState(196, state_);
+      ProtocolWithChangesReaderBaseInvalidState(208, state_);
     }
   }
 
   ReadVectorRecordWithChangesImpl(value);
-  state_ = 198;
+  state_ = 210;
 }
 
 bool ProtocolWithChangesReaderBase::ReadStreamedRecordWithChanges(evo_test::RecordWithChanges& value) {
-  if (unlikely(state_ != 198)) {
-    if (state_ == 199) {
-      state_ = 200;
+  if (unlikely(state_ != 210)) {
+    if (state_ == 211) {
+      state_ = 212;
       return false;
     }
-    ProtocolWithChangesReaderBaseInvalidState(198, state_);
+    ProtocolWithChangesReaderBaseInvalidState(210, state_);
   }
 
   bool result = ReadStreamedRecordWithChangesImpl(value);
   if (!result) {
-    state_ = 200;
+    state_ = 212;
   }
   return result;
 }
@@ -2565,17 +2691,17 @@ bool ProtocolWithChangesReaderBase::ReadStreamedRecordWithChanges(std::vector<ev
   if (values.capacity() == 0) {
     throw std::runtime_error("vector must have a nonzero capacity.");
   }
-  if (unlikely(state_ != 198)) {
-    if (state_ == 199) {
-      state_ = 200;
+  if (unlikely(state_ != 210)) {
+    if (state_ == 211) {
+      state_ = 212;
       values.clear();
       return false;
     }
-    ProtocolWithChangesReaderBaseInvalidState(198, state_);
+    ProtocolWithChangesReaderBaseInvalidState(210, state_);
   }
 
   if (!ReadStreamedRecordWithChangesImpl(values)) {
-    state_ = 199;
+    state_ = 211;
     return values.size() > 0;
   }
   return true;
@@ -2600,11 +2726,11 @@ bool ProtocolWithChangesReaderBase::ReadStreamedRecordWithChangesImpl(std::vecto
 }
 
 void ProtocolWithChangesReaderBase::Close() {
-  if (!skip_completed_check_ && unlikely(state_ != 200)) {
-    if (state_ == 199) {
-      state_ = 200;
+  if (!skip_completed_check_ && unlikely(state_ != 212)) {
+    if (state_ == 211) {
+      state_ = 212;
     } else {
-      ProtocolWithChangesReaderBaseInvalidState(200, state_);
+      ProtocolWithChangesReaderBaseInvalidState(212, state_);
     }
   }
 
@@ -2771,6 +2897,36 @@ void ProtocolWithChangesReaderBase::CopyTo(ProtocolWithChangesWriterBase& writer
     ReadStringToAliasedInt(value);
     writer.WriteStringToAliasedInt(value);
   }
+  {
+    std::string value;
+    ReadStringToBytes(value);
+    writer.WriteStringToBytes(value);
+  }
+  {
+    std::vector<std::byte> value;
+    ReadBytesToString(value);
+    writer.WriteBytesToString(value);
+  }
+  {
+    std::vector<uint8_t> value;
+    ReadVectorToBytes(value);
+    writer.WriteVectorToBytes(value);
+  }
+  {
+    std::vector<std::byte> value;
+    ReadBytesToVector(value);
+    writer.WriteBytesToVector(value);
+  }
+  {
+    std::string value;
+    ReadStringToUuid(value);
+    writer.WriteStringToUuid(value);
+  }
+  {
+    yardl::Uuid value;
+    ReadUuidToString(value);
+    writer.WriteUuidToString(value);
+  }
   {
     evo_test::GrowingEnum value;
     ReadEnumToAliasedEnum(value);
@@ -47,6 +47,14 @@ int main(void) {
   w.WriteStringToAliasedString(HelloWorld);
   w.WriteStringToAliasedInt(std::to_string(INT_MIN));
 
+  w.WriteStringToBytes(HelloWorld);
+  w.WriteBytesToString(HelloWorldBytes);
+  w.WriteVectorToBytes(HelloWorldOctets);
+  w.WriteBytesToVector(HelloWorldBytes);
+
+  w.WriteStringToUuid(UuidString);
+  w.WriteUuidToString(yardl::Uuid::Parse(UuidString));
+
   w.WriteEnumToAliasedEnum(GrowingEnum::kC);
 
   UnchangedRecord unchanged;
@@ -1850,6 +1850,90 @@ void ProtocolWithChangesWriter::WriteStringToAliasedIntImpl(evo_test::AliasedInt
   }
 }
 
+void ProtocolWithChangesWriter::WriteStringToBytesImpl(std::vector<std::byte> const& value) {
+  switch (version_) {
+  case Version::v0: {
+    std::string string_to_bytes = {};
+    string_to_bytes = std::string(reinterpret_cast<char const*>(value.data()), value.size());
+    yardl::binary::WriteString(stream_, string_to_bytes);
+    break;
+  }
+  default:
+    yardl::binary::WriteBytes(stream_, value);
+    break;
+  }
+}
+
+void ProtocolWithChangesWriter::WriteBytesToStringImpl(std::string const& value) {
+  switch (version_) {
+  case Version::v0: {
+    std::vector<std::byte> bytes_to_string = {};
+    bytes_to_string = std::vector<std::byte>(reinterpret_cast<std::byte const*>(value.data()), reinterpret_cast<std::byte const*>(value.data()) + value.size());
+    yardl::binary::WriteBytes(stream_, bytes_to_string);
+    break;
+  }
+  default:
+    yardl::binary::WriteString(stream_, value);
+    break;
+  }
+}
+
+void ProtocolWithChangesWriter::WriteVectorToBytesImpl(std::vector<std::byte> const& value) {
+  switch (version_) {
+  case Version::v0: {
+    std::vector<uint8_t> vector_to_bytes = {};
+    vector_to_bytes = std::vector<uint8_t>(reinterpret_cast<uint8_t const*>(value.data()), reinterpret_cast<uint8_t const*>(value.data()) + value.size());
+    yardl::binary::WriteVector<uint8_t, yardl::binary::WriteInteger>(stream_, vector_to_bytes);
+    break;
+  }
+  default:
+    yardl::binary::WriteBytes(stream_, value);
+    break;
+  }
+}
+
+void ProtocolWithChangesWriter::WriteBytesToVectorImpl(std::vector<uint8_t> const& value) {
+  switch (version_) {
+  case Version::v0: {
+    std::vector<std::byte> bytes_to_vector = {};
+    bytes_to_vector = std::vector<std::byte>(reinterpret_cast<std::byte const*>(value.data()), reinterpret_cast<std::byte const*>(value.data()) + value.size());
+    yardl::binary::WriteBytes(stream_, bytes_to_vector);
+    break;
+  }
+  default:
+    yardl::binary::WriteVector<uint8_t, yardl::binary::WriteInteger>(stream_, value);
+    break;
+  }
+}
+
+void ProtocolWithChangesWriter::WriteStringToUuidImpl(yardl::Uuid const& value) {
+  switch (version_) {
+  case Version::v0: {
+    std::string string_to_uuid = {};
+    string_to_uuid = value.ToString();
+    yardl::binary::WriteString(stream_, string_to_uuid);
+    break;
+  }
+  default:
+    yardl::binary::WriteUuid(stream_, value);
+    break;
+  }
+}
+
+void ProtocolWithChangesWriter::WriteUuidToStringImpl(std::string const& value) {
+  switch (version_) {
+  case Version::v0: {
+    yardl::Uuid uuid_to_string = {};
+    uuid_to_string = yardl::Uuid::Parse(value);
+    yardl::binary::WriteUuid(stream_, uuid_to_string);
+    break;
+  }
+  default:
+    yardl::binary::WriteString(stream_, value);
+    break;
+  }
+}
+
 void ProtocolWithChangesWriter::WriteEnumToAliasedEnumImpl(evo_test::AliasedEnum const& value) {
   switch (version_) {
   case Version::v0: {
@@ -3631,6 +3715,90 @@ void ProtocolWithChangesReader::ReadStringToAliasedIntImpl(evo_test::AliasedInt&
   }
 }
 
+void ProtocolWithChangesReader::ReadStringToBytesImpl(std::vector<std::byte>& value) {
+  switch (version_) {
+  case Version::v0: {
+    std::string string_to_bytes = {};
+    yardl::binary::ReadString(stream_, string_to_bytes);
+    value = std::vector<std::byte>(reinterpret_cast<std::byte const*>(string_to_bytes.data()), reinterpret_cast<std::byte const*>(string_to_bytes.data()) + string_to_bytes.size());
+    break;
+  }
+  default:
+    yardl::binary::ReadBytes(stream_, value);
+    break;
+  }
+}
+
+void ProtocolWithChangesReader::ReadBytesToStringImpl(std::string& value) {
+  switch (version_) {
+  case Version::v0: {
+    std::vector<std::byte> bytes_to_string = {};
+    yardl::binary::ReadBytes(stream_, bytes_to_string);
+    value = std::string(reinterpret_cast<char const*>(bytes_to_string.data()), bytes_to_string.size());
+    break;
+  }
+  default:
+    yardl::binary::ReadString(stream_, value);
+    break;
+  }
+}
+
+void ProtocolWithChangesReader::ReadVectorToBytesImpl(std::vector<std::byte>& value) {
+  switch (version_) {
+  case Version::v0: {
+    std::vector<uint8_t> vector_to_bytes = {};
+    yardl::binary::ReadVector<uint8_t, yardl::binary::ReadInteger>(stream_, vector_to_bytes);
+    value = std::vector<std::byte>(reinterpret_cast<std::byte const*>(vector_to_bytes.data()), reinterpret_cast<std::byte const*>(vector_to_bytes.data()) + vector_to_bytes.size());
+    break;
+  }
+  default:
+    yardl::binary::ReadBytes(stream_, value);
+    break;
+  }
+}
+
+void ProtocolWithChangesReader::ReadBytesToVectorImpl(std::vector<uint8_t>& value) {
+  switch (version_) {
+  case Version::v0: {
+    std::vector<std::byte> bytes_to_vector = {};
+    yardl::binary::ReadBytes(stream_, bytes_to_vector);
+    value = std::vector<uint8_t>(reinterpret_cast<uint8_t const*>(bytes_to_vector.data()), reinterpret_cast<uint8_t const*>(bytes_to_vector.data()) + bytes_to_vector.size());
+    break;
+  }
+  default:
+    yardl::binary::ReadVector<uint8_t, yardl::binary::ReadInteger>(stream_, value);
+    break;
+  }
+}
+
+void ProtocolWithChangesReader::ReadStringToUuidImpl(yardl::Uuid& value) {
+  switch (version_) {
+  case Version::v0: {
+    std::string string_to_uuid = {};
+    yardl::binary::ReadString(stream_, string_to_uuid);
+    value = yardl::Uuid::Parse(string_to_uuid);
+    break;
+  }
+  default:
+    yardl::binary::ReadUuid(stream_, value);
+    break;
+  }
+}
+
+void ProtocolWithChangesReader::ReadUuidToStringImpl(std::string& value) {
+  switch (version_) {
+  case Version::v0: {
+    yardl::Uuid uuid_to_string = {};
+    yardl::binary::ReadUuid(stream_, uuid_to_string);
+    value = uuid_to_string.ToString();
+    break;
+  }
+  default:
+    yardl::binary::ReadString(stream_, value);
+    break;
+  }
+}
+
 void ProtocolWithChangesReader::ReadEnumToAliasedEnumImpl(evo_test::AliasedEnum& value) {
   switch (version_) {
   case Version::v0: {
@@ -610,6 +610,30 @@ void ProtocolWithChangesWriter::WriteStringToAliasedIntImpl(evo_test::AliasedInt
   yardl::hdf5::WriteScalarDataset<int32_t, evo_test::AliasedInt>(group_, "stringToAliasedInt", H5::PredType::NATIVE_INT32, value);
 }
 
+void ProtocolWithChangesWriter::WriteStringToBytesImpl(std::vector<std::byte> const& value) {
+  yardl::hdf5::WriteScalarDataset<yardl::hdf5::InnerVlen<std::byte, std::byte>, std::vector<std::byte>>(group_, "stringToBytes", yardl::hdf5::InnerVlenBytesDdl(), value);
+}
+
+void ProtocolWithChangesWriter::WriteBytesToStringImpl(std::string const& value) {
+  yardl::hdf5::WriteScalarDataset<yardl::hdf5::InnerVlenString, std::string>(group_, "bytesToString", yardl::hdf5::InnerVlenStringDdl(), value);
+}
+
+void ProtocolWithChangesWriter::WriteVectorToBytesImpl(std::vector<std::byte> const& value) {
+  yardl::hdf5::WriteScalarDataset<yardl::hdf5::InnerVlen<std::byte, std::byte>, std::vector<std::byte>>(group_, "vectorToBytes", yardl::hdf5::InnerVlenBytesDdl(), value);
+}
+
+void ProtocolWithChangesWriter::WriteBytesToVectorImpl(std::vector<uint8_t> const& value) {
+  yardl::hdf5::WriteScalarDataset<yardl::hdf5::InnerVlen<uint8_t, uint8_t>, std::vector<uint8_t>>(group_, "bytesToVector", yardl::hdf5::InnerVlenDdl(H5::PredType::NATIVE_UINT8), value);
+}
+
+void ProtocolWithChangesWriter::WriteStringToUuidImpl(yardl::Uuid const& value) {
+  yardl::hdf5::WriteScalarDataset<yardl::Uuid, yardl::Uuid>(group_, "stringToUuid", yardl::hdf5::UuidTypeDdl(), value);
+}
+
+void ProtocolWithChangesWriter::WriteUuidToStringImpl(std::string const& value) {
+  yardl::hdf5::WriteScalarDataset<yardl::hdf5::InnerVlenString, std::string>(group_, "uuidToString", yardl::hdf5::InnerVlenStringDdl(), value);
+}
+
 void ProtocolWithChangesWriter::WriteEnumToAliasedEnumImpl(evo_test::AliasedEnum const& value) {
   yardl::hdf5::WriteScalarDataset<evo_test::GrowingEnum, evo_test::AliasedEnum>(group_, "enumToAliasedEnum", evo_test::hdf5::GetGrowingEnumHdf5Ddl(), value);
 }
@@ -1235,6 +1259,30 @@ void ProtocolWithChangesReader::ReadStringToAliasedIntImpl(evo_test::AliasedInt&
   yardl::hdf5::ReadScalarDataset<int32_t, evo_test::AliasedInt>(group_, "stringToAliasedInt", H5::PredType::NATIVE_INT32, value);
 }
 
+void ProtocolWithChangesReader::ReadStringToBytesImpl(std::vector<std::byte>& value) {
+  yardl::hdf5::ReadScalarDataset<yardl::hdf5::InnerVlen<std::byte, std::byte>, std::vector<std::byte>>(group_, "stringToBytes", yardl::hdf5::InnerVlenBytesDdl(), value);
+}
+
+void ProtocolWithChangesReader::ReadBytesToStringImpl(std::string& value) {
+  yardl::hdf5::ReadScalarDataset<yardl::hdf5::InnerVlenString, std::string>(group_, "bytesToString", yardl::hdf5::InnerVlenStringDdl(), value);
+}
+
+void ProtocolWithChangesReader::ReadVectorToBytesImpl(std::vector<std::byte>& value) {
+  yardl::hdf5::ReadScalarDataset<yardl::hdf5::InnerVlen<std::byte, std::byte>, std::vector<std::byte>>(group_, "vectorToBytes", yardl::hdf5::InnerVlenBytesDdl(), value);
+}
+
+void ProtocolWithChangesReader::ReadBytesToVectorImpl(std::vector<uint8_t>& value) {
+  yardl::hdf5::ReadScalarDataset<yardl::hdf5::InnerVlen<uint8_t, uint8_t>, std::vector<uint8_t>>(group_, "bytesToVector", yardl::hdf5::InnerVlenDdl(H5::PredType::NATIVE_UINT8), value);
+}
+
+void ProtocolWithChangesReader::ReadStringToUuidImpl(yardl::Uuid& value) {
+  yardl::hdf5::ReadScalarDataset<yardl::Uuid, yardl::Uuid>(group_, "stringToUuid", yardl::hdf5::UuidTypeDdl(), value);
+}
+
+void ProtocolWithChangesReader::ReadUuidToStringImpl(std::string& value) {
+  yardl::hdf5::ReadScalarDataset<yardl::hdf5::InnerVlenString, std::string>(group_, "uuidToString", yardl::hdf5::InnerVlenStringDdl(), value);
+}
+
 void ProtocolWithChangesReader::ReadEnumToAliasedEnumImpl(evo_test::AliasedEnum& value) {
   yardl::hdf5::ReadScalarDataset<evo_test::GrowingEnum, evo_test::AliasedEnum>(group_, "enumToAliasedEnum", evo_test::hdf5::GetGrowingEnumHdf5Ddl(), value);
 }
@@ -81,6 +81,18 @@ class ProtocolWithChangesWriter : public evo_test::ProtocolWithChangesWriterBase
 
   void WriteStringToAliasedIntImpl(evo_test::AliasedInt const& value) override;
 
+  void WriteStringToBytesImpl(std::vector<std::byte> const& value) override;
+
+  void WriteBytesToStringImpl(std::string const& value) override;
+
+  void WriteVectorToBytesImpl(std::vector<std::byte> const& value) override;
+
+  void WriteBytesToVectorImpl(std::vector<uint8_t> const& value) override;
+
+  void WriteStringToUuidImpl(yardl::Uuid const& value) override;
+
+  void WriteUuidToStringImpl(std::string const& value) override;
+
   void WriteEnumToAliasedEnumImpl(evo_test::AliasedEnum const& value) override;
 
   void WriteOptionalIntToUnionImpl(std::variant<std::monostate, int32_t, std::string> const& value) override;
@@ -341,6 +353,18 @@ class ProtocolWithChangesReader : public evo_test::ProtocolWithChangesReaderBase
 
   void ReadStringToAliasedIntImpl(evo_test::AliasedInt& value) override;
 
+  void ReadStringToBytesImpl(std::vector<std::byte>& value) override;
+
+  void ReadBytesToStringImpl(std::string& value) override;
+
+  void ReadVectorToBytesImpl(std::vector<std::byte>& value) override;
+
+  void ReadBytesToVectorImpl(std::vector<uint8_t>& value) override;
+
+  void ReadStringToUuidImpl(yardl::Uuid& value) override;
+
+  void ReadUuidToStringImpl(std::string& value) override;
+
   void ReadEnumToAliasedEnumImpl(evo_test::AliasedEnum& value) override;
 
   void ReadOptionalIntToUnionImpl(std::variant<std::monostate, int32_t, std::string>& value) override;
@@ -862,6 +862,30 @@ void ProtocolWithChangesWriter::WriteStringToAliasedIntImpl(evo_test::AliasedInt
   ordered_json json_value = value;
   yardl::ndjson::WriteProtocolValue(stream_, "stringToAliasedInt", json_value);}
 
+void ProtocolWithChangesWriter::WriteStringToBytesImpl(std::vector<std::byte> const& value) {
+  ordered_json json_value = value;
+  yardl::ndjson::WriteProtocolValue(stream_, "stringToBytes", json_value);}
+
+void ProtocolWithChangesWriter::WriteBytesToStringImpl(std::string const& value) {
+  ordered_json json_value = value;
+  yardl::ndjson::WriteProtocolValue(stream_, "bytesToString", json_value);}
+
+void ProtocolWithChangesWriter::WriteVectorToBytesImpl(std::vector<std::byte> const& value) {
+  ordered_json json_value = value;
+  yardl::ndjson::WriteProtocolValue(stream_, "vectorToBytes", json_value);}
+
+void ProtocolWithChangesWriter::WriteBytesToVectorImpl(std::vector<uint8_t> const& value) {
+  ordered_json json_value = value;
+  yardl::ndjson::WriteProtocolValue(stream_, "bytesToVector", json_value);}
+
+void ProtocolWithChangesWriter::WriteStringToUuidImpl(yardl::Uuid const& value) {
+  ordered_json json_value = value;
+  yardl::ndjson::WriteProtocolValue(stream_, "stringToUuid", json_value);}
+
+void ProtocolWithChangesWriter::WriteUuidToStringImpl(std::string const& value) {
+  ordered_json json_value = value;
+  yardl::ndjson::WriteProtocolValue(stream_, "uuidToString", json_value);}
+
 void ProtocolWithChangesWriter::WriteEnumToAliasedEnumImpl(evo_test::AliasedEnum const& value) {
   ordered_json json_value = value;
   yardl::ndjson::WriteProtocolValue(stream_, "enumToAliasedEnum", json_value);}
@@ -1290,6 +1314,30 @@ void ProtocolWithChangesReader::ReadStringToAliasedIntImpl(evo_test::AliasedInt&
   yardl::ndjson::ReadProtocolValue(stream_, line_, "stringToAliasedInt", true, unused_step_, value);
 }
 
+void ProtocolWithChangesReader::ReadStringToBytesImpl(std::vector<std::byte>& value) {
+  yardl::ndjson::ReadProtocolValue(stream_, line_, "stringToBytes", true, unused_step_, value);
+}
+
+void ProtocolWithChangesReader::ReadBytesToStringImpl(std::string& value) {
+  yardl::ndjson::ReadProtocolValue(stream_, line_, "bytesToString", true, unused_step_, value);
+}
+
+void ProtocolWithChangesReader::ReadVectorToBytesImpl(std::vector<std::byte>& value) {
+  yardl::ndjson::ReadProtocolValue(stream_, line_, "vectorToBytes", true, unused_step_, value);
+}
+
+void ProtocolWithChangesReader::ReadBytesToVectorImpl(std::vector<uint8_t>& value) {
+  yardl::ndjson::ReadProtocolValue(stream_, line_, "bytesToVector", true, unused_step_, value);
+}
+
+void ProtocolWithChangesReader::ReadStringToUuidImpl(yardl::Uuid& value) {
+  yardl::ndjson::ReadProtocolValue(stream_, line_, "stringToUuid", true, unused_step_, value);
+}
+
+void ProtocolWithChangesReader::ReadUuidToStringImpl(std::string& value) {
+  yardl::ndjson::ReadProtocolValue(stream_, line_, "uuidToString", true, unused_step_, value);
+}
+
 void ProtocolWithChangesReader::ReadEnumToAliasedEnumImpl(evo_test::AliasedEnum& value) {
   yardl::ndjson::ReadProtocolValue(stream_, line_, "enumToAliasedEnum", true, unused_step_, value);
 }
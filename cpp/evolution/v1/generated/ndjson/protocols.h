@@ -58,6 +58,12 @@ class ProtocolWithChangesWriter : public evo_test::ProtocolWithChangesWriterBase
   void WriteAliasedLongToStringImpl(evo_test::AliasedLongToString const& value) override;
   void WriteStringToAliasedStringImpl(evo_test::AliasedString const& value) override;
   void WriteStringToAliasedIntImpl(evo_test::AliasedInt const& value) override;
+  void WriteStringToBytesImpl(std::vector<std::byte> const& value) override;
+  void WriteBytesToStringImpl(std::string const& value) override;
+  void WriteVectorToBytesImpl(std::vector<std::byte> const& value) override;
+  void WriteBytesToVectorImpl(std::vector<uint8_t> const& value) override;
+  void WriteStringToUuidImpl(yardl::Uuid const& value) override;
+  void WriteUuidToStringImpl(std::string const& value) override;
   void WriteEnumToAliasedEnumImpl(evo_test::AliasedEnum const& value) override;
   void WriteOptionalIntToUnionImpl(std::variant<std::monostate, int32_t, std::string> const& value) override;
   void WriteOptionalRecordToUnionImpl(std::variant<std::monostate, evo_test::RecordWithChanges, std::string> const& value) override;
@@ -189,6 +195,12 @@ class ProtocolWithChangesReader : public evo_test::ProtocolWithChangesReaderBase
   void ReadAliasedLongToStringImpl(evo_test::AliasedLongToString& value) override;
   void ReadStringToAliasedStringImpl(evo_test::AliasedString& value) override;
   void ReadStringToAliasedIntImpl(evo_test::AliasedInt& value) override;
+  void ReadStringToBytesImpl(std::vector<std::byte>& value) override;
+  void ReadBytesToStringImpl(std::string& value) override;
+  void ReadVectorToBytesImpl(std::vector<std::byte>& value) override;
+  void ReadBytesToVectorImpl(std::vector<uint8_t>& value) override;
+  void ReadStringToUuidImpl(yardl::Uuid& value) override;
+  void ReadUuidToStringImpl(std::string& value) override;
   void ReadEnumToAliasedEnumImpl(evo_test::AliasedEnum& value) override;
   void ReadOptionalIntToUnionImpl(std::variant<std::monostate, int32_t, std::string>& value) override;
   void ReadOptionalRecordToUnionImpl(std::variant<std::monostate, evo_test::RecordWithChanges, std::string>& value) override;
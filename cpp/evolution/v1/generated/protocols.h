@@ -108,35 +108,53 @@ class ProtocolWithChangesWriterBase {
   void WriteStringToAliasedInt(evo_test::AliasedInt const& value);
 
   // Ordinal 32.
-  void WriteEnumToAliasedEnum(evo_test::AliasedEnum const& value);
+  void WriteStringToBytes(std::vector<std::byte> const& value);
 
   // Ordinal 33.
-  void WriteOptionalIntToUnion(std::variant<std::monostate, int32_t, std::string> const& value);
+  void WriteBytesToString(std::string const& value);
 
   // Ordinal 34.
-  void WriteOptionalRecordToUnion(std::variant<std::monostate, evo_test::RecordWithChanges, std::string> const& value);
+  void WriteVectorToBytes(std::vector<std::byte> const& value);
 
   // Ordinal 35.
-  void WriteRecordWithChanges(evo_test::RecordWithChanges const& value);
+  void WriteBytesToVector(std::vector<uint8_t> const& value);
 
   // Ordinal 36.
-  void WriteAliasedRecordWithChanges(evo_test::AliasedRecordWithChanges const& value);
+  void WriteStringToUuid(yardl::Uuid const& value);
 
   // Ordinal 37.
-  void WriteRecordToRenamedRecord(evo_test::RenamedRecord const& value);
+  void WriteUuidToString(std::string const& value);
 
   // Ordinal 38.
-  void WriteRecordToAliasedRecord(evo_test::AliasedRecordWithChanges const& value);
+  void WriteEnumToAliasedEnum(evo_test::AliasedEnum const& value);
 
   // Ordinal 39.
-  void WriteRecordToAliasedAlias(evo_test::AliasOfAliasedRecordWithChanges const& value);
+  void WriteOptionalIntToUnion(std::variant<std::monostate, int32_t, std::string> const& value);
 
   // Ordinal 40.
+  void WriteOptionalRecordToUnion(std::variant<std::monostate, evo_test::RecordWithChanges, std::string> const& value);
+
+  // Ordinal 41.
+  void WriteRecordWithChanges(evo_test::RecordWithChanges const& value);
+
+  // Ordinal 42.
+  void WriteAliasedRecordWithChanges(evo_test::AliasedRecordWithChanges const& value);
+
+  // Ordinal 43.
+  void WriteRecordToRenamedRecord(evo_test::RenamedRecord const& value);
+
+  // Ordinal 44.
+  void WriteRecordToAliasedRecord(evo_test::AliasedRecordWithChanges const& value);
+
+  // Ordinal 45.
+  void WriteRecordToAliasedAlias(evo_test::AliasOfAliasedRecordWithChanges const& value);
+
+  // Ordinal 46.
   // Stream and Vector type changes
   // Call this method for each element of the `streamIntToStringToFloat` stream, then call `EndStreamIntToStringToFloat() when done.`
   void WriteStreamIntToStringToFloat(std::string const& value);
 
-  // Ordinal 40.
+  // Ordinal 46.
   // Stream and Vector type changes
   // Call this method to write many values to the `streamIntToStringToFloat` stream, then call `EndStreamIntToStringToFloat()` when done.
   void WriteStreamIntToStringToFloat(std::vector<std::string> const& values);
@@ -144,257 +162,257 @@ class ProtocolWithChangesWriterBase {
   // Marks the end of the `streamIntToStringToFloat` stream.
   void EndStreamIntToStringToFloat();
 
-  // Ordinal 41.
+  // Ordinal 47.
   void WriteVectorIntToStringToFloat(std::vector<std::string> const& value);
 
-  // Ordinal 42.
+  // Ordinal 48.
   void WriteIntFloatUnionReordered(std::variant<float, int32_t> const& value);
 
-  // Ordinal 43.
+  // Ordinal 49.
   void WriteVectorUnionReordered(std::vector<std::variant<float, int32_t>> const& value);
 
-  // Ordinal 44.
+  // Ordinal 50.
   // Call this method for each element of the `streamUnionReordered` stream, then call `EndStreamUnionReordered() when done.`
   void WriteStreamUnionReordered(std::variant<std::string, int32_t> const& value);
 
-  // Ordinal 44.
+  // Ordinal 50.
   // Call this method to write many values to the `streamUnionReordered` stream, then call `EndStreamUnionReordered()` when done.
   void WriteStreamUnionReordered(std::vector<std::variant<std::string, int32_t>> const& values);
 
   // Marks the end of the `streamUnionReordered` stream.
   void EndStreamUnionReordered();
 
-  // Ordinal 45.
+  // Ordinal 51.
   // Call this method for each element of the `intToUnionStream` stream, then call `EndIntToUnionStream() when done.`
   void WriteIntToUnionStream(int32_t const& value);
 
-  // Ordinal 45.
+  // Ordinal 51.
   // Call this method to write many values to the `intToUnionStream` stream, then call `EndIntToUnionStream()` when done.
   void WriteIntToUnionStream(std::vector<int32_t> const& values);
 
   // Marks the end of the `intToUnionStream` stream.
   void EndIntToUnionStream();
 
-  // Ordinal 46.
+  // Ordinal 52.
   // Call this method for each element of the `unionStreamTypeChange` stream, then call `EndUnionStreamTypeChange() when done.`
   void WriteUnionStreamTypeChange(std::variant<int32_t, bool> const& value);
 
-  // Ordinal 46.
+  // Ordinal 52.
   // Call this method to write many values to the `unionStreamTypeChange` stream, then call `EndUnionStreamTypeChange()` when done.
   void WriteUnionStreamTypeChange(std::vector<std::variant<int32_t, bool>> const& values);
 
   // Marks the end of the `unionStreamTypeChange` stream.
   void EndUnionStreamTypeChange();
 
-  // Ordinal 47.
+  // Ordinal 53.
   // Call this method for each element of the `streamOfAliasTypeChange` stream, then call `EndStreamOfAliasTypeChange() when done.`
   void WriteStreamOfAliasTypeChange(evo_test::StreamItem const& value);
 
-  // Ordinal 47.
+  // Ordinal 53.
   // Call this method to write many values to the `streamOfAliasTypeChange` stream, then call `EndStreamOfAliasTypeChange()` when done.
   void WriteStreamOfAliasTypeChange(std::vector<evo_test::StreamItem> const& values);
 
   // Marks the end of the `streamOfAliasTypeChange` stream.
   void EndStreamOfAliasTypeChange();
 
-  // Ordinal 48.
+  // Ordinal 54.
   // Comprehensive NamedType changes
   void WriteRlink(evo_test::RLink const& value);
 
-  // Ordinal 49.
+  // Ordinal 55.
   void WriteRlinkRX(evo_test::RX const& value);
 
-  // Ordinal 50.
+  // Ordinal 56.
   void WriteRlinkRY(evo_test::RY const& value);
 
-  // Ordinal 51.
+  // Ordinal 57.
   void WriteRlinkRZ(evo_test::RZ const& value);
 
-  // Ordinal 52.
+  // Ordinal 58.
   void WriteRaRLink(evo_test::RLink const& value);
 
-  // Ordinal 53.
+  // Ordinal 59.
   void WriteRaRX(evo_test::RX const& value);
 
-  // Ordinal 54.
+  // Ordinal 60.
   void WriteRaRY(evo_test::RY const& value);
 
-  // Ordinal 55.
+  // Ordinal 61.
   void WriteRaRZ(evo_test::RZ const& value);
 
-  // Ordinal 56.
+  // Ordinal 62.
   void WriteRbRLink(evo_test::RLink const& value);
 
-  // Ordinal 57.
+  // Ordinal 63.
   void WriteRbRX(evo_test::RX const& value);
 
-  // Ordinal 58.
+  // Ordinal 64.
   void WriteRbRY(evo_test::RY const& value);
 
-  // Ordinal 59.
+  // Ordinal 65.
   void WriteRbRZ(evo_test::RZ const& value);
 
-  // Ordinal 60.
+  // Ordinal 66.
   void WriteRcRLink(evo_test::RLink const& value);
 
-  // Ordinal 61.
+  // Ordinal 67.
   void WriteRcRX(evo_test::RX const& value);
 
-  // Ordinal 62.
+  // Ordinal 68.
   void WriteRcRY(evo_test::RY const& value);
 
-  // Ordinal 63.
+  // Ordinal 69.
   void WriteRcRZ(evo_test::RZ const& value);
 
-  // Ordinal 64.
+  // Ordinal 70.
   void WriteRlinkRNew(evo_test::RNew const& value);
 
-  // Ordinal 65.
+  // Ordinal 71.
   void WriteRaRNew(evo_test::RNew const& value);
 
-  // Ordinal 66.
+  // Ordinal 72.
   void WriteRbRNew(evo_test::RNew const& value);
 
-  // Ordinal 67.
+  // Ordinal 73.
   void WriteRcRNew(evo_test::RNew const& value);
 
-  // Ordinal 68.
+  // Ordinal 74.
   void WriteRlinkRUnion(evo_test::RUnion const& value);
 
-  // Ordinal 69.
+  // Ordinal 75.
   void WriteRaRUnion(evo_test::RUnion const& value);
 
-  // Ordinal 70.
+  // Ordinal 76.
   void WriteRbRUnion(evo_test::RUnion const& value);
 
-  // Ordinal 71.
+  // Ordinal 77.
   void WriteRcRUnion(evo_test::RUnion const& value);
 
-  // Ordinal 72.
+  // Ordinal 78.
   void WriteOptionalRecordWithChanges(std::optional<evo_test::RecordWithChanges> const& value);
 
-  // Ordinal 73.
+  // Ordinal 79.
   void WriteAliasedOptionalRecordWithChanges(std::optional<evo_test::AliasedRecordWithChanges> const& value);
 
-  // Ordinal 74.
+  // Ordinal 80.
   void WriteUnionRecordWithChanges(std::variant<evo_test::RecordWithChanges, int32_t> const& value);
 
-  // Ordinal 75.
+  // Ordinal 81.
   void WriteUnionWithSameTypeset(std::variant<float, evo_test::RecordWithChanges, std::string, int32_t> const& value);
 
-  // Ordinal 76.
+  // Ordinal 82.
   void WriteUnionWithTypesAdded(std::variant<evo_test::RecordWithChanges, int32_t, float, std::string> const& value);
 
-  // Ordinal 77.
+  // Ordinal 83.
   void WriteUnionWithTypesRemoved(std::variant<evo_test::RecordWithChanges, std::string> const& value);
 
-  // Ordinal 78.
+  // Ordinal 84.
   void WriteRecordToOptional(std::optional<evo_test::RecordWithChanges> const& value);
 
-  // Ordinal 79.
+  // Ordinal 85.
   void WriteRecordToAliasedOptional(evo_test::AliasedOptionalRecord const& value);
 
-  // Ordinal 80.
+  // Ordinal 86.
   void WriteRecordToUnion(std::variant<evo_test::RecordWithChanges, std::string> const& value);
 
-  // Ordinal 81.
+  // Ordinal 87.
   void WriteRecordToAliasedUnion(evo_test::AliasedRecordOrString const& value);
 
-  // Ordinal 82.
+  // Ordinal 88.
   void WriteUnionToAliasedUnion(evo_test::AliasedRecordOrInt const& value);
 
-  // Ordinal 83.
+  // Ordinal 89.
   void WriteUnionToAliasedUnionWithChanges(evo_test::AliasedRecordOrString const& value);
 
-  // Ordinal 84.
+  // Ordinal 90.
   void WriteOptionalToAliasedOptional(evo_test::AliasedOptionalRecord const& value);
 
-  // Ordinal 85.
+  // Ordinal 91.
   void WriteOptionalToAliasedOptionalWithChanges(evo_test::AliasedOptionalString const& value);
 
-  // Ordinal 86.
+  // Ordinal 92.
   void WriteGenericRecord(evo_test::GenericRecord<int32_t, std::string> const& value);
 
-  // Ordinal 87.
+  // Ordinal 93.
   void WriteGenericRecordToOpenAlias(evo_test::AliasedOpenGenericRecord<int32_t, std::string> const& value);
 
-  // Ordinal 88.
+  // Ordinal 94.
   void WriteGenericRecordToClosedAlias(evo_test::AliasedClosedGenericRecord const& value);
 
-  // Ordinal 89.
+  // Ordinal 95.
   void WriteGenericRecordToHalfClosedAlias(evo_test::AliasedHalfClosedGenericRecord<int32_t> const& value);
 
-  // Ordinal 90.
+  // Ordinal 96.
   void WriteAliasedGenericRecordToAlias(evo_test::AliasedOpenGenericRecord<int32_t, std::string> const& value);
 
-  // Ordinal 91.
+  // Ordinal 97.
   void WriteGenericRecordToReversed(evo_test::GenericRecordReversed<std::string, int32_t> const& value);
 
-  // Ordinal 92.
+  // Ordinal 98.
   void WriteClosedGenericRecordToUnion(std::variant<evo_test::GenericRecord<int32_t, std::string>, std::string> const& value);
 
-  // Ordinal 93.
+  // Ordinal 99.
   void WriteGenericRecordToAliasedUnion(evo_test::AliasedGenericRecordOrString const& value);
 
-  // Ordinal 94.
+  // Ordinal 100.
   void WriteGenericUnionToReversed(evo_test::GenericUnionReversed<float, evo_test::GenericRecord<int32_t, std::string>> const& value);
 
-  // Ordinal 95.
+  // Ordinal 101.
   void WriteGenericUnionOfChangedRecord(evo_test::GenericUnion<evo_test::GenericRecord<int32_t, std::string>, float> const& value);
 
-  // Ordinal 96.
+  // Ordinal 102.
   void WriteGenericParentRecord(evo_test::GenericParentRecord<int32_t> const& value);
 
-  // Ordinal 97.
+  // Ordinal 103.
   void WriteGenericNestedRecords(evo_test::GenericRecord<evo_test::Unchanged, evo_test::Changed> const& value);
 
-  // Ordinal 98.
+  // Ordinal 104.
   // Call this method for each element of the `genericRecordStream` stream, then call `EndGenericRecordStream() when done.`
   void WriteGenericRecordStream(evo_test::AliasedClosedGenericRecord const& value);
 
-  // Ordinal 98.
+  // Ordinal 104.
   // Call this method to write many values to the `genericRecordStream` stream, then call `EndGenericRecordStream()` when done.
   void WriteGenericRecordStream(std::vector<evo_test::AliasedClosedGenericRecord> const& values);
 
   // Marks the end of the `genericRecordStream` stream.
   void EndGenericRecordStream();
 
-  // Ordinal 99.
+  // Ordinal 105.
   // Call this method for each element of the `genericParentRecordStream` stream, then call `EndGenericParentRecordStream() when done.`
   void WriteGenericParentRecordStream(evo_test::GenericParentRecord<int32_t> const& value);
 
-  // Ordinal 99.
+  // Ordinal 105.
   // Call this method to write many values to the `genericParentRecordStream` stream, then call `EndGenericParentRecordStream()` when done.
   void WriteGenericParentRecordStream(std::vector<evo_test::GenericParentRecord<int32_t>> const& values);
 
   // Marks the end of the `genericParentRecordStream` stream.
   void EndGenericParentRecordStream();
 
-  // Ordinal 100.
+  // Ordinal 106.
   void WriteVectorRecordWithChanges(std::vector<evo_test::RecordWithChanges> const& value);
 
-  // Ordinal 101.
+  // Ordinal 107.
   // Call this method for each element of the `streamedRecordWithChanges` stream, then call `EndStreamedRecordWithChanges() when done.`
   void WriteStreamedRecordWithChanges(evo_test::RecordWithChanges const& value);
 
-  // Ordinal 101.
+  // Ordinal 107.
   // Call this method to write many values to the `streamedRecordWithChanges` stream, then call `EndStreamedRecordWithChanges()` when done.
   void WriteStreamedRecordWithChanges(std::vector<evo_test::RecordWithChanges> const& values);
 
   // Marks the end of the `streamedRecordWithChanges` stream.
   void EndStreamedRecordWithChanges();
 
-  // Ordinal 102.
+  // Ordinal 108.
   void WriteAddedOptional(std::optional<evo_test::RecordWithChanges> const& value);
 
-  // Ordinal 103.
+  // Ordinal 109.
   void WriteAddedMap(std::unordered_map<std::string, std::string> const& value);
 
-  // Ordinal 104.
+  // Ordinal 110.
   // Call this method for each element of the `addedRecordStream` stream, then call `EndAddedRecordStream() when done.`
   void WriteAddedRecordStream(evo_test::RecordWithChanges const& value);
 
-  // Ordinal 104.
+  // Ordinal 110.
   // Call this method to write many values to the `addedRecordStream` stream, then call `EndAddedRecordStream()` when done.
   void WriteAddedRecordStream(std::vector<evo_test::RecordWithChanges> const& values);
 
@@ -442,6 +460,12 @@ class ProtocolWithChangesWriterBase {
   virtual void WriteAliasedLongToStringImpl(evo_test::AliasedLongToString const& value) = 0;
   virtual void WriteStringToAliasedStringImpl(evo_test::AliasedString const& value) = 0;
   virtual void WriteStringToAliasedIntImpl(evo_test::AliasedInt const& value) = 0;
+  virtual void WriteStringToBytesImpl(std::vector<std::byte> const& value) = 0;
+  virtual void WriteBytesToStringImpl(std::string const& value) = 0;
+  virtual void WriteVectorToBytesImpl(std::vector<std::byte> const& value) = 0;
+  virtual void WriteBytesToVectorImpl(std::vector<uint8_t> const& value) = 0;
+  virtual void WriteStringToUuidImpl(yardl::Uuid const& value) = 0;
+  virtual void WriteUuidToStringImpl(std::string const& value) = 0;
   virtual void WriteEnumToAliasedEnumImpl(evo_test::AliasedEnum const& value) = 0;
   virtual void WriteOptionalIntToUnionImpl(std::variant<std::monostate, int32_t, std::string> const& value) = 0;
   virtual void WriteOptionalRecordToUnionImpl(std::variant<std::monostate, evo_test::RecordWithChanges, std::string> const& value) = 0;
@@ -649,252 +673,270 @@ class ProtocolWithChangesReaderBase {
   void ReadStringToAliasedInt(evo_test::AliasedInt& value);
 
   // Ordinal 32.
-  void ReadEnumToAliasedEnum(evo_test::AliasedEnum& value);
+  void ReadStringToBytes(std::vector<std::byte>& value);
 
   // Ordinal 33.
-  void ReadOptionalIntToUnion(std::variant<std::monostate, int32_t, std::string>& value);
+  void ReadBytesToString(std::string& value);
 
   // Ordinal 34.
-  void ReadOptionalRecordToUnion(std::variant<std::monostate, evo_test::RecordWithChanges, std::string>& value);
+  void ReadVectorToBytes(std::vector<std::byte>& value);
 
   // Ordinal 35.
-  void ReadRecordWithChanges(evo_test::RecordWithChanges& value);
+  void ReadBytesToVector(std::vector<uint8_t>& value);
 
   // Ordinal 36.
-  void ReadAliasedRecordWithChanges(evo_test::AliasedRecordWithChanges& value);
+  void ReadStringToUuid(yardl::Uuid& value);
 
   // Ordinal 37.
-  void ReadRecordToRenamedRecord(evo_test::RenamedRecord& value);
+  void ReadUuidToString(std::string& value);
 
   // Ordinal 38.
-  void ReadRecordToAliasedRecord(evo_test::AliasedRecordWithChanges& value);
+  void ReadEnumToAliasedEnum(evo_test::AliasedEnum& value);
 
   // Ordinal 39.
-  void ReadRecordToAliasedAlias(evo_test::AliasOfAliasedRecordWithChanges& value);
+  void ReadOptionalIntToUnion(std::variant<std::monostate, int32_t, std::string>& value);
 
   // Ordinal 40.
+  void ReadOptionalRecordToUnion(std::variant<std::monostate, evo_test::RecordWithChanges, std::string>& value);
+
+  // Ordinal 41.
+  void ReadRecordWithChanges(evo_test::RecordWithChanges& value);
+
+  // Ordinal 42.
+  void ReadAliasedRecordWithChanges(evo_test::AliasedRecordWithChanges& value);
+
+  // Ordinal 43.
+  void ReadRecordToRenamedRecord(evo_test::RenamedRecord& value);
+
+  // Ordinal 44.
+  void ReadRecordToAliasedRecord(evo_test::AliasedRecordWithChanges& value);
+
+  // Ordinal 45.
+  void ReadRecordToAliasedAlias(evo_test::AliasOfAliasedRecordWithChanges& value);
+
+  // Ordinal 46.
   // Stream and Vector type changes
   [[nodiscard]] bool ReadStreamIntToStringToFloat(std::string& value);
 
-  // Ordinal 40.
+  // Ordinal 46.
   // Stream and Vector type changes
   [[nodiscard]] bool ReadStreamIntToStringToFloat(std::vector<std::string>& values);
 
-  // Ordinal 41.
+  // Ordinal 47.
   void ReadVectorIntToStringToFloat(std::vector<std::string>& value);
 
-  // Ordinal 42.
+  // Ordinal 48.
   void ReadIntFloatUnionReordered(std::variant<float, int32_t>& value);
 
-  // Ordinal 43.
+  // Ordinal 49.
   void ReadVectorUnionReordered(std::vector<std::variant<float, int32_t>>& value);
 
-  // Ordinal 44.
+  // Ordinal 50.
   [[nodiscard]] bool ReadStreamUnionReordered(std::variant<std::string, int32_t>& value);
 
-  // Ordinal 44.
+  // Ordinal 50.
   [[nodiscard]] bool ReadStreamUnionReordered(std::vector<std::variant<std::string, int32_t>>& values);
 
-  // Ordinal 45.
+  // Ordinal 51.
   [[nodiscard]] bool ReadIntToUnionStream(int32_t& value);
 
-  // Ordinal 45.
+  // Ordinal 51.
   [[nodiscard]] bool ReadIntToUnionStream(std::vector<int32_t>& values);
 
-  // Ordinal 46.
+  // Ordinal 52.
   [[nodiscard]] bool ReadUnionStreamTypeChange(std::variant<int32_t, bool>& value);
 
-  // Ordinal 46.
+  // Ordinal 52.
   [[nodiscard]] bool ReadUnionStreamTypeChange(std::vector<std::variant<int32_t, bool>>& values);
 
-  // Ordinal 47.
+  // Ordinal 53.
   [[nodiscard]] bool ReadStreamOfAliasTypeChange(evo_test::StreamItem& value);
 
-  // Ordinal 47.
+  // Ordinal 53.
   [[nodiscard]] bool ReadStreamOfAliasTypeChange(std::vector<evo_test::StreamItem>& values);
 
-  // Ordinal 48.
+  // Ordinal 54.
   // Comprehensive NamedType changes
   void ReadRlink(evo_test::RLink& value);
 
-  // Ordinal 49.
+  // Ordinal 55.
   void ReadRlinkRX(evo_test::RX& value);
 
-  // Ordinal 50.
+  // Ordinal 56.
   void ReadRlinkRY(evo_test::RY& value);
 
-  // Ordinal 51.
+  // Ordinal 57.
   void ReadRlinkRZ(evo_test::RZ& value);
 
-  // Ordinal 52.
+  // Ordinal 58.
   void ReadRaRLink(evo_test::RLink& value);
 
-  // Ordinal 53.
+  // Ordinal 59.
   void ReadRaRX(evo_test::RX& value);
 
-  // Ordinal 54.
+  // Ordinal 60.
   void ReadRaRY(evo_test::RY& value);
 
-  // Ordinal 55.
+  // Ordinal 61.
   void ReadRaRZ(evo_test::RZ& value);
 
-  // Ordinal 56.
+  // Ordinal 62.
   void ReadRbRLink(evo_test::RLink& value);
 
-  // Ordinal 57.
+  // Ordinal 63.
   void ReadRbRX(evo_test::RX& value);
 
-  // Ordinal 58.
+  // Ordinal 64.
   void ReadRbRY(evo_test::RY& value);
 
-  // Ordinal 59.
+  // Ordinal 65.
   void ReadRbRZ(evo_test::RZ& value);
 
-  // Ordinal 60.
+  // Ordinal 66.
   void ReadRcRLink(evo_test::RLink& value);
 
-  // Ordinal 61.
+  // Ordinal 67.
   void ReadRcRX(evo_test::RX& value);
 
-  // Ordinal 62.
+  // Ordinal 68.
   void ReadRcRY(evo_test::RY& value);
 
-  // Ordinal 63.
+  // Ordinal 69.
   void ReadRcRZ(evo_test::RZ& value);
 
-  // Ordinal 64.
+  // Ordinal 70.
   void ReadRlinkRNew(evo_test::RNew& value);
 
-  // Ordinal 65.
+  // Ordinal 71.
   void ReadRaRNew(evo_test::RNew& value);
 
-  // Ordinal 66.
+  // Ordinal 72.
   void ReadRbRNew(evo_test::RNew& value);
 
-  // Ordinal 67.
+  // Ordinal 73.
   void ReadRcRNew(evo_test::RNew& value);
 
-  // Ordinal 68.
+  // Ordinal 74.
   void ReadRlinkRUnion(evo_test::RUnion& value);
 
-  // Ordinal 69.
+  // Ordinal 75.
   void ReadRaRUnion(evo_test::RUnion& value);
 
-  // Ordinal 70.
+  // Ordinal 76.
   void ReadRbRUnion(evo_test::RUnion& value);
 
-  // Ordinal 71.
+  // Ordinal 77.
   void ReadRcRUnion(evo_test::RUnion& value);
 
-  // Ordinal 72.
+  // Ordinal 78.
   void ReadOptionalRecordWithChanges(std::optional<evo_test::RecordWithChanges>& value);
 
-  // Ordinal 73.
+  // Ordinal 79.
   void ReadAliasedOptionalRecordWithChanges(std::optional<evo_test::AliasedRecordWithChanges>& value);
 
-  // Ordinal 74.
+  // Ordinal 80.
   void ReadUnionRecordWithChanges(std::variant<evo_test::RecordWithChanges, int32_t>& value);
 
-  // Ordinal 75.
+  // Ordinal 81.
   void ReadUnionWithSameTypeset(std::variant<float, evo_test::RecordWithChanges, std::string, int32_t>& value);
 
-  // Ordinal 76.
+  // Ordinal 82.
   void ReadUnionWithTypesAdded(std::variant<evo_test::RecordWithChanges, int32_t, float, std::string>& value);
 
-  // Ordinal 77.
+  // Ordinal 83.
   void ReadUnionWithTypesRemoved(std::variant<evo_test::RecordWithChanges, std::string>& value);
 
-  // Ordinal 78.
+  // Ordinal 84.
   void ReadRecordToOptional(std::optional<evo_test::RecordWithChanges>& value);
 
-  // Ordinal 79.
+  // Ordinal 85.
   void ReadRecordToAliasedOptional(evo_test::AliasedOptionalRecord& value);
 
-  // Ordinal 80.
+  // Ordinal 86.
   void ReadRecordToUnion(std::variant<evo_test::RecordWithChanges, std::string>& value);
 
-  // Ordinal 81.
+  // Ordinal 87.
   void ReadRecordToAliasedUnion(evo_test::AliasedRecordOrString& value);
 
-  // Ordinal 82.
+  // Ordinal 88.
   void ReadUnionToAliasedUnion(evo_test::AliasedRecordOrInt& value);
 
-  // Ordinal 83.
+  // Ordinal 89.
   void ReadUnionToAliasedUnionWithChanges(evo_test::AliasedRecordOrString& value);
 
-  // Ordinal 84.
+  // Ordinal 90.
   void ReadOptionalToAliasedOptional(evo_test::AliasedOptionalRecord& value);
 
-  // Ordinal 85.
+  // Ordinal 91.
   void ReadOptionalToAliasedOptionalWithChanges(evo_test::AliasedOptionalString& value);
 
-  // Ordinal 86.
+  // Ordinal 92.
   void ReadGenericRecord(evo_test::GenericRecord<int32_t, std::string>& value);
 
-  // Ordinal 87.
+  // Ordinal 93.
   void ReadGenericRecordToOpenAlias(evo_test::AliasedOpenGenericRecord<int32_t, std::string>& value);
 
-  // Ordinal 88.
+  // Ordinal 94.
   void ReadGenericRecordToClosedAlias(evo_test::AliasedClosedGenericRecord& value);
 
-  // Ordinal 89.
+  // Ordinal 95.
   void ReadGenericRecordToHalfClosedAlias(evo_test::AliasedHalfClosedGenericRecord<int32_t>& value);
 
-  // Ordinal 90.
+  // Ordinal 96.
   void ReadAliasedGenericRecordToAlias(evo_test::AliasedOpenGenericRecord<int32_t, std::string>& value);
 
-  // Ordinal 91.
+  // Ordinal 97.
   void ReadGenericRecordToReversed(evo_test::GenericRecordReversed<std::string, int32_t>& value);
 
-  // Ordinal 92.
+  // Ordinal 98.
   void ReadClosedGenericRecordToUnion(std::variant<evo_test::GenericRecord<int32_t, std::string>, std::string>& value);
 
-  // Ordinal 93.
+  // Ordinal 99.
   void ReadGenericRecordToAliasedUnion(evo_test::AliasedGenericRecordOrString& value);
 
-  // Ordinal 94.
+  // Ordinal 100.
   void ReadGenericUnionToReversed(evo_test::GenericUnionReversed<float, evo_test::GenericRecord<int32_t, std::string>>& value);
 
-  // Ordinal 95.
+  // Ordinal 101.
   void ReadGenericUnionOfChangedRecord(evo_test::GenericUnion<evo_test::GenericRecord<int32_t, std::string>, float>& value);
 
-  // Ordinal 96.
+  // Ordinal 102.
   void ReadGenericParentRecord(evo_test::GenericParentRecord<int32_t>& value);
 
-  // Ordinal 97.
+  // Ordinal 103.
   void ReadGenericNestedRecords(evo_test::GenericRecord<evo_test::Unchanged, evo_test::Changed>& value);
 
-  // Ordinal 98.
+  // Ordinal 104.
   [[nodiscard]] bool ReadGenericRecordStream(evo_test::AliasedClosedGenericRecord& value);
 
-  // Ordinal 98.
+  // Ordinal 104.
   [[nodiscard]] bool ReadGenericRecordStream(std::vector<evo_test::AliasedClosedGenericRecord>& values);
 
-  // Ordinal 99.
+  // Ordinal 105.
   [[nodiscard]] bool ReadGenericParentRecordStream(evo_test::GenericParentRecord<int32_t>& value);
 
-  // Ordinal 99.
+  // Ordinal 105.
   [[nodiscard]] bool ReadGenericParentRecordStream(std::vector<evo_test::GenericParentRecord<int32_t>>& values);
 
-  // Ordinal 100.
+  // Ordinal 106.
   void ReadVectorRecordWithChanges(std::vector<evo_test::RecordWithChanges>& value);
 
-  // Ordinal 101.
+  // Ordinal 107.
   [[nodiscard]] bool ReadStreamedRecordWithChanges(evo_test::RecordWithChanges& value);
 
-  // Ordinal 101.
+  // Ordinal 107.
   [[nodiscard]] bool ReadStreamedRecordWithChanges(std::vector<evo_test::RecordWithChanges>& values);
 
-  // Ordinal 102.
+  // Ordinal 108.
   void ReadAddedOptional(std::optional<evo_test::RecordWithChanges>& value);
 
-  // Ordinal 103.
+  // Ordinal 109.
   void ReadAddedMap(std::unordered_map<std::string, std::string>& value);
 
-  // Ordinal 104.
+  // Ordinal 110.
   [[nodiscard]] bool ReadAddedRecordStream(evo_test::RecordWithChanges& value);
 
-  // Ordinal 104.
+  // Ordinal 110.
   [[nodiscard]] bool ReadAddedRecordStream(std::vector<evo_test::RecordWithChanges>& values);
 
   // Optionaly close this writer before destructing. Validates that all steps were completely read.
@@ -937,6 +979,12 @@ class ProtocolWithChangesReaderBase {
   virtual void ReadAliasedLongToStringImpl(evo_test::AliasedLongToString& value) = 0;
   virtual void ReadStringToAliasedStringImpl(evo_test::AliasedString& value) = 0;
   virtual void ReadStringToAliasedIntImpl(evo_test::AliasedInt& value) = 0;
+  virtual void ReadStringToBytesImpl(std::vector<std::byte>& value) = 0;
+  virtual void ReadBytesToStringImpl(std::string& value) = 0;
+  virtual void ReadVectorToBytesImpl(std::vector<std::byte>& value) = 0;
+  virtual void ReadBytesToVectorImpl(std::vector<uint8_t>& value) = 0;
+  virtual void ReadStringToUuidImpl(yardl::Uuid& value) = 0;
+  virtual void ReadUuidToStringImpl(std::string& value) = 0;
   virtual void ReadEnumToAliasedEnumImpl(evo_test::AliasedEnum& value) = 0;
   virtual void ReadOptionalIntToUnionImpl(std::variant<std::monostate, int32_t, std::string>& value) = 0;
   virtual void ReadOptionalRecordToUnionImpl(std::variant<std::monostate, evo_test::RecordWithChanges, std::string>& value) = 0;
@@ -145,6 +145,23 @@ int main(void) {
   r.ReadStringToAliasedInt(int32);
   EVO_ASSERT(int32 == INT_MIN);
 
+  std::vector<std::byte> bytes;
+  std::vector<uint8_t> octets;
+  r.ReadStringToBytes(bytes);
+  EVO_ASSERT(bytes == HelloWorldBytes);
+  r.ReadBytesToString(str);
+  EVO_ASSERT(str == HelloWorld);
+  r.ReadVectorToBytes(bytes);
+  EVO_ASSERT(bytes == HelloWorldBytes);
+  r.ReadBytesToVector(octets);
+  EVO_ASSERT(octets == HelloWorldOctets);
+
+  yardl::Uuid uuid;
+  r.ReadStringToUuid(uuid);
+  EVO_ASSERT(uuid == yardl::Uuid::Parse(UuidString));
+  r.ReadUuidToString(str);
+  EVO_ASSERT(str == UuidString);
+
   AliasedEnum e;
   r.ReadEnumToAliasedEnum(e);
   EVO_ASSERT(e == GrowingEnum::kC);
@@ -47,6 +47,14 @@ int main(void) {
   w.WriteStringToAliasedString(HelloWorld);
   w.WriteStringToAliasedInt(INT_MIN);
 
+  w.WriteStringToBytes(HelloWorldBytes);
+  w.WriteBytesToString(HelloWorld);
+  w.WriteVectorToBytes(HelloWorldBytes);
+  w.WriteBytesToVector(HelloWorldOctets);
+
+  w.WriteStringToUuid(yardl::Uuid::Parse(UuidString));
+  w.WriteUuidToString(UuidString);
+
   w.WriteEnumToAliasedEnum(GrowingEnum::kC);
 
   UnchangedRecord unchanged;
@@ -1555,6 +1555,90 @@ void ProtocolWithChangesWriter::WriteStringToAliasedIntImpl(std::string const& v
   }
 }
 
+void ProtocolWithChangesWriter::WriteStringToBytesImpl(std::string const& value) {
+  switch (version_) {
+  case Version::v1: {
+    std::vector<std::byte> string_to_bytes = {};
+    string_to_bytes = std::vector<std::byte>(reinterpret_cast<std::byte const*>(value.data()), reinterpret_cast<std::byte const*>(value.data()) + value.size());
+    yardl::binary::WriteBytes(stream_, string_to_bytes);
+    break;
+  }
+  default:
+    yardl::binary::WriteString(stream_, value);
+    break;
+  }
+}
+
+void ProtocolWithChangesWriter::WriteBytesToStringImpl(std::vector<std::byte> const& value) {
+  switch (version_) {
+  case Version::v1: {
+    std::string bytes_to_string = {};
+    bytes_to_string = std::string(reinterpret_cast<char const*>(value.data()), value.size());
+    yardl::binary::WriteString(stream_, bytes_to_string);
+    break;
+  }
+  default:
+    yardl::binary::WriteBytes(stream_, value);
+    break;
+  }
+}
+
+void ProtocolWithChangesWriter::WriteVectorToBytesImpl(std::vector<uint8_t> const& value) {
+  switch (version_) {
+  case Version::v1: {
+    std::vector<std::byte> vector_to_bytes = {};
+    vector_to_bytes = std::vector<std::byte>(reinterpret_cast<std::byte const*>(value.data()), reinterpret_cast<std::byte const*>(value.data()) + value.size());
+    yardl::binary::WriteBytes(stream_, vector_to_bytes);
+    break;
+  }
+  default:
+    yardl::binary::WriteVector<uint8_t, yardl::binary::WriteInteger>(stream_, value);
+    break;
+  }
+}
+
+void ProtocolWithChangesWriter::WriteBytesToVectorImpl(std::vector<std::byte> const& value) {
+  switch (version_) {
+  case Version::v1: {
+    std::vector<uint8_t> bytes_to_vector = {};
+    bytes_to_vector = std::vector<uint8_t>(reinterpret_cast<uint8_t const*>(value.data()), reinterpret_cast<uint8_t const*>(value.data()) + value.size());
+    yardl::binary::WriteVector<uint8_t, yardl::binary::WriteInteger>(stream_, bytes_to_vector);
+    break;
+  }
+  default:
+    yardl::binary::WriteBytes(stream_, value);
+    break;
+  }
+}
+
+void ProtocolWithChangesWriter::WriteStringToUuidImpl(std::string const& value) {
+  switch (version_) {
+  case Version::v1: {
+    yardl::Uuid string_to_uuid = {};
+    string_to_uuid = yardl::Uuid::Parse(value);
+    yardl::binary::WriteUuid(stream_, string_to_uuid);
+    break;
+  }
+  default:
+    yardl::binary::WriteString(stream_, value);
+    break;
+  }
+}
+
+void ProtocolWithChangesWriter::WriteUuidToStringImpl(yardl::Uuid const& value) {
+  switch (version_) {
+  case Version::v1: {
+    std::string uuid_to_string = {};
+    uuid_to_string = value.ToString();
+    yardl::binary::WriteString(stream_, uuid_to_string);
+    break;
+  }
+  default:
+    yardl::binary::WriteUuid(stream_, value);
+    break;
+  }
+}
+
 void ProtocolWithChangesWriter::WriteEnumToAliasedEnumImpl(evo_test::GrowingEnum const& value) {
   switch (version_) {
   case Version::v0: {
@@ -3747,6 +3831,90 @@ void ProtocolWithChangesReader::ReadStringToAliasedIntImpl(std::string& value) {
   }
 }
 
+void ProtocolWithChangesReader::ReadStringToBytesImpl(std::string& value) {
+  switch (version_) {
+  case Version::v1: {
+    std::vector<std::byte> string_to_bytes = {};
+    yardl::binary::ReadBytes(stream_, string_to_bytes);
+    value = std::string(reinterpret_cast<char const*>(string_to_bytes.data()), string_to_bytes.size());
+    break;
+  }
+  default:
+    yardl::binary::ReadString(stream_, value);
+    break;
+  }
+}
+
+void ProtocolWithChangesReader::ReadBytesToStringImpl(std::vector<std::byte>& value) {
+  switch (version_) {
+  case Version::v1: {
+    std::string bytes_to_string = {};
+    yardl::binary::ReadString(stream_, bytes_to_string);
+    value = std::vector<std::byte>(reinterpret_cast<std::byte const*>(bytes_to_string.data()), reinterpret_cast<std::byte const*>(bytes_to_string.data()) + bytes_to_string.size());
+    break;
+  }
+  default:
+    yardl::binary::ReadBytes(stream_, value);
+    break;
+  }
+}
+
+void ProtocolWithChangesReader::ReadVectorToBytesImpl(std::vector<uint8_t>& value) {
+  switch (version_) {
+  case Version::v1: {
+    std::vector<std::byte> vector_to_bytes = {};
+    yardl::binary::ReadBytes(stream_, vector_to_bytes);
+    value = std::vector<uint8_t>(reinterpret_cast<uint8_t const*>(vector_to_bytes.data()), reinterpret_cast<uint8_t const*>(vector_to_bytes.data()) + vector_to_bytes.size());
+    break;
+  }
+  default:
+    yardl::binary::ReadVector<uint8_t, yardl::binary::ReadInteger>(stream_, value);
+    break;
+  }
+}
+
+void ProtocolWithChangesReader::ReadBytesToVectorImpl(std::vector<std::byte>& value) {
+  switch (version_) {
+  case Version::v1: {
+    std::vector<uint8_t> bytes_to_vector = {};
+    yardl::binary::ReadVector<uint8_t, yardl::binary::ReadInteger>(stream_, bytes_to_vector);
+    value = std::vector<std::byte>(reinterpret_cast<std::byte const*>(bytes_to_vector.data()), reinterpret_cast<std::byte const*>(bytes_to_vector.data()) + bytes_to_vector.size());
+    break;
+  }
+  default:
+    yardl::binary::ReadBytes(stream_, value);
+    break;
+  }
+}
+
+void ProtocolWithChangesReader::ReadStringToUuidImpl(std::string& value) {
+  switch (version_) {
+  case Version::v1: {
+    yardl::Uuid string_to_uuid = {};
+    yardl::binary::ReadUuid(stream_, string_to_uuid);
+    value = string_to_uuid.ToString();
+    break;
+  }
+  default:
+    yardl::binary::ReadString(stream_, value);
+    break;
+  }
+}
+
+void ProtocolWithChangesReader::ReadUuidToStringImpl(yardl::Uuid& value) {
+  switch (version_) {
+  case Version::v1: {
+    std::string uuid_to_string = {};
+    yardl::binary::ReadString(stream_, uuid_to_string);
+    value = yardl::Uuid::Parse(uuid_to_string);
+    break;
+  }
+  default:
+    yardl::binary::ReadUuid(stream_, value);
+    break;
+  }
+}
+
 void ProtocolWithChangesReader::ReadEnumToAliasedEnumImpl(evo_test::GrowingEnum& value) {
   switch (version_) {
   case Version::v0: {
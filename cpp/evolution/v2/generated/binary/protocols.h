@@ -56,6 +56,12 @@ class ProtocolWithChangesWriter : public evo_test::ProtocolWithChangesWriterBase
   void WriteAliasedLongToStringImpl(evo_test::AliasedLongToString const& value) override;
   void WriteStringToAliasedStringImpl(std::string const& value) override;
   void WriteStringToAliasedIntImpl(std::string const& value) override;
+  void WriteStringToBytesImpl(std::string const& value) override;
+  void WriteBytesToStringImpl(std::vector<std::byte> const& value) override;
+  void WriteVectorToBytesImpl(std::vector<uint8_t> const& value) override;
+  void WriteBytesToVectorImpl(std::vector<std::byte> const& value) override;
+  void WriteStringToUuidImpl(std::string const& value) override;
+  void WriteUuidToStringImpl(yardl::Uuid const& value) override;
   void WriteEnumToAliasedEnumImpl(evo_test::GrowingEnum const& value) override;
   void WriteOptionalIntToUnionImpl(std::optional<int32_t> const& value) override;
   void WriteOptionalRecordToUnionImpl(std::optional<evo_test::RecordWithChanges> const& value) override;
@@ -203,6 +209,12 @@ class ProtocolWithChangesReader : public evo_test::ProtocolWithChangesReaderBase
   void ReadAliasedLongToStringImpl(evo_test::AliasedLongToString& value) override;
   void ReadStringToAliasedStringImpl(std::string& value) override;
   void ReadStringToAliasedIntImpl(std::string& value) override;
+  void ReadStringToBytesImpl(std::string& value) override;
+  void ReadBytesToStringImpl(std::vector<std::byte>& value) override;
+  void ReadVectorToBytesImpl(std::vector<uint8_t>& value) override;
+  void ReadBytesToVectorImpl(std::vector<std::byte>& value) override;
+  void ReadStringToUuidImpl(std::string& value) override;
+  void ReadUuidToStringImpl(yardl::Uuid& value) override;
   void ReadEnumToAliasedEnumImpl(evo_test::GrowingEnum& value) override;
   void ReadOptionalIntToUnionImpl(std::optional<int32_t>& value) override;
   void ReadOptionalRecordToUnionImpl(std::optional<evo_test::RecordWithChanges>& value) override;
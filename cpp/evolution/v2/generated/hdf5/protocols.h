@@ -81,6 +81,18 @@ class ProtocolWithChangesWriter : public evo_test::ProtocolWithChangesWriterBase
 
   void WriteStringToAliasedIntImpl(std::string const& value) override;
 
+  void WriteStringToBytesImpl(std::string const& value) override;
+
+  void WriteBytesToStringImpl(std::vector<std::byte> const& value) override;
+
+  void WriteVectorToBytesImpl(std::vector<uint8_t> const& value) override;
+
+  void WriteBytesToVectorImpl(std::vector<std::byte> const& value) override;
+
+  void WriteStringToUuidImpl(std::string const& value) override;
+
+  void WriteUuidToStringImpl(yardl::Uuid const& value) override;
+
   void WriteEnumToAliasedEnumImpl(evo_test::GrowingEnum const& value) override;
 
   void WriteOptionalIntToUnionImpl(std::optional<int32_t> const& value) override;
@@ -350,6 +362,18 @@ class ProtocolWithChangesReader : public evo_test::ProtocolWithChangesReaderBase
 
   void ReadStringToAliasedIntImpl(std::string& value) override;
 
+  void ReadStringToBytesImpl(std::string& value) override;
+
+  void ReadBytesToStringImpl(std::vector<std::byte>& value) override;
+
+  void ReadVectorToBytesImpl(std::vector<uint8_t>& value) override;
+
+  void ReadBytesToVectorImpl(std::vector<std::byte>& value) override;
+
+  void ReadStringToUuidImpl(std::string& value) override;
+
+  void ReadUuidToStringImpl(yardl::Uuid& value) override;
+
   void ReadEnumToAliasedEnumImpl(evo_test::GrowingEnum& value) override;
 
   void ReadOptionalIntToUnionImpl(std::optional<int32_t>& value) override;
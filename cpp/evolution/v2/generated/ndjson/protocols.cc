@@ -713,6 +713,30 @@ void ProtocolWithChangesWriter::WriteStringToAliasedIntImpl(std::string const& v
   ordered_json json_value = value;
   yardl::ndjson::WriteProtocolValue(stream_, "stringToAliasedInt", json_value);}
 
+void ProtocolWithChangesWriter::WriteStringToBytesImpl(std::string const& value) {
+  ordered_json json_value = value;
+  yardl::ndjson::WriteProtocolValue(stream_, "stringToBytes", json_value);}
+
+void ProtocolWithChangesWriter::WriteBytesToStringImpl(std::vector<std::byte> const& value) {
+  ordered_json json_value = value;
+  yardl::ndjson::WriteProtocolValue(stream_, "bytesToString", json_value);}
+
+void ProtocolWithChangesWriter::WriteVectorToBytesImpl(std::vector<uint8_t> const& value) {
+  ordered_json json_value = value;
+  yardl::ndjson::WriteProtocolValue(stream_, "vectorToBytes", json_value);}
+
+void ProtocolWithChangesWriter::WriteBytesToVectorImpl(std::vector<std::byte> const& value) {
+  ordered_json json_value = value;
+  yardl::ndjson::WriteProtocolValue(stream_, "bytesToVector", json_value);}
+
+void ProtocolWithChangesWriter::WriteStringToUuidImpl(std::string const& value) {
+  ordered_json json_value = value;
+  yardl::ndjson::WriteProtocolValue(stream_, "stringToUuid", json_value);}
+
+void ProtocolWithChangesWriter::WriteUuidToStringImpl(yardl::Uuid const& value) {
+  ordered_json json_value = value;
+  yardl::ndjson::WriteProtocolValue(stream_, "uuidToString", json_value);}
+
 void ProtocolWithChangesWriter::WriteEnumToAliasedEnumImpl(evo_test::GrowingEnum const& value) {
   ordered_json json_value = value;
   yardl::ndjson::WriteProtocolValue(stream_, "enumToAliasedEnum", json_value);}
@@ -1153,6 +1177,30 @@ void ProtocolWithChangesReader::ReadStringToAliasedIntImpl(std::string& value) {
   yardl::ndjson::ReadProtocolValue(stream_, line_, "stringToAliasedInt", true, unused_step_, value);
 }
 
+void ProtocolWithChangesReader::ReadStringToBytesImpl(std::string& value) {
+  yardl::ndjson::ReadProtocolValue(stream_, line_, "stringToBytes", true, unused_step_, value);
+}
+
+void ProtocolWithChangesReader::ReadBytesToStringImpl(std::vector<std::byte>& value) {
+  yardl::ndjson::ReadProtocolValue(stream_, line_, "bytesToString", true, unused_step_, value);
+}
+
+void ProtocolWithChangesReader::ReadVectorToBytesImpl(std::vector<uint8_t>& value) {
+  yardl::ndjson::ReadProtocolValue(stream_, line_, "vectorToBytes", true, unused_step_, value);
+}
+
+void ProtocolWithChangesReader::ReadBytesToVectorImpl(std::vector<std::byte>& value) {
+  yardl::ndjson::ReadProtocolValue(stream_, line_, "bytesToVector", true, unused_step_, value);
+}
+
+void ProtocolWithChangesReader::ReadStringToUuidImpl(std::string& value) {
+  yardl::ndjson::ReadProtocolValue(stream_, line_, "stringToUuid", true, unused_step_, value);
+}
+
+void ProtocolWithChangesReader::ReadUuidToStringImpl(yardl::Uuid& value) {
+  yardl::ndjson::ReadProtocolValue(stream_, line_, "uuidToString", true, unused_step_, value);
+}
+
 void ProtocolWithChangesReader::ReadEnumToAliasedEnumImpl(evo_test::GrowingEnum& value) {
   yardl::ndjson::ReadProtocolValue(stream_, line_, "enumToAliasedEnum", true, unused_step_, value);
 }
@@ -109,35 +109,53 @@ class ProtocolWithChangesWriterBase {
   void WriteStringToAliasedInt(std::string const& value);
 
   // Ordinal 32.
-  void WriteEnumToAliasedEnum(evo_test::GrowingEnum const& value);
+  void WriteStringToBytes(std::string const& value);
 
   // Ordinal 33.
-  void WriteOptionalIntToUnion(std::optional<int32_t> const& value);
+  void WriteBytesToString(std::vector<std::byte> const& value);
 
   // Ordinal 34.
-  void WriteOptionalRecordToUnion(std::optional<evo_test::RecordWithChanges> const& value);
+  void WriteVectorToBytes(std::vector<uint8_t> const& value);
 
   // Ordinal 35.
-  void WriteRecordWithChanges(evo_test::RecordWithChanges const& value);
+  void WriteBytesToVector(std::vector<std::byte> const& value);
 
   // Ordinal 36.
-  void WriteAliasedRecordWithChanges(evo_test::AliasedRecordWithChanges const& value);
+  void WriteStringToUuid(std::string const& value);
 
   // Ordinal 37.
-  void WriteRecordToRenamedRecord(evo_test::RenamedRecord const& value);
+  void WriteUuidToString(yardl::Uuid const& value);
 
   // Ordinal 38.
-  void WriteRecordToAliasedRecord(evo_test::RecordWithChanges const& value);
+  void WriteEnumToAliasedEnum(evo_test::GrowingEnum const& value);
 
   // Ordinal 39.
-  void WriteRecordToAliasedAlias(evo_test::RecordWithChanges const& value);
+  void WriteOptionalIntToUnion(std::optional<int32_t> const& value);
 
   // Ordinal 40.
+  void WriteOptionalRecordToUnion(std::optional<evo_test::RecordWithChanges> const& value);
+
+  // Ordinal 41.
+  void WriteRecordWithChanges(evo_test::RecordWithChanges const& value);
+
+  // Ordinal 42.
+  void WriteAliasedRecordWithChanges(evo_test::AliasedRecordWithChanges const& value);
+
+  // Ordinal 43.
+  void WriteRecordToRenamedRecord(evo_test::RenamedRecord const& value);
+
+  // Ordinal 44.
+  void WriteRecordToAliasedRecord(evo_test::RecordWithChanges const& value);
+
+  // Ordinal 45.
+  void WriteRecordToAliasedAlias(evo_test::RecordWithChanges const& value);
+
+  // Ordinal 46.
   // Stream and Vector type changes
   // Call this method for each element of the `streamIntToStringToFloat` stream, then call `EndStreamIntToStringToFloat() when done.`
   void WriteStreamIntToStringToFloat(float const& value);
 
-  // Ordinal 40.
+  // Ordinal 46.
   // Stream and Vector type changes
   // Call this method to write many values to the `streamIntToStringToFloat` stream, then call `EndStreamIntToStringToFloat()` when done.
   void WriteStreamIntToStringToFloat(std::vector<float> const& values);
@@ -145,274 +163,274 @@ class ProtocolWithChangesWriterBase {
   // Marks the end of the `streamIntToStringToFloat` stream.
   void EndStreamIntToStringToFloat();
 
-  // Ordinal 41.
+  // Ordinal 47.
   void WriteVectorIntToStringToFloat(std::vector<float> const& value);
 
-  // Ordinal 42.
+  // Ordinal 48.
   void WriteIntFloatUnionReordered(std::variant<int32_t, float> const& value);
 
-  // Ordinal 43.
+  // Ordinal 49.
   void WriteVectorUnionReordered(std::vector<std::variant<int32_t, float>> const& value);
 
-  // Ordinal 44.
+  // Ordinal 50.
   // Call this method for each element of the `streamUnionReordered` stream, then call `EndStreamUnionReordered() when done.`
   void WriteStreamUnionReordered(std::variant<int32_t, std::string> const& value);
 
-  // Ordinal 44.
+  // Ordinal 50.
   // Call this method to write many values to the `streamUnionReordered` stream, then call `EndStreamUnionReordered()` when done.
   void WriteStreamUnionReordered(std::vector<std::variant<int32_t, std::string>> const& values);
 
   // Marks the end of the `streamUnionReordered` stream.
   void EndStreamUnionReordered();
 
-  // Ordinal 45.
+  // Ordinal 51.
   // Call this method for each element of the `intToUnionStream` stream, then call `EndIntToUnionStream() when done.`
   void WriteIntToUnionStream(std::variant<std::string, int32_t> const& value);
 
-  // Ordinal 45.
+  // Ordinal 51.
   // Call this method to write many values to the `intToUnionStream` stream, then call `EndIntToUnionStream()` when done.
   void WriteIntToUnionStream(std::vector<std::variant<std::string, int32_t>> const& values);
 
   // Marks the end of the `intToUnionStream` stream.
   void EndIntToUnionStream();
 
-  // Ordinal 46.
+  // Ordinal 52.
   // Call this method for each element of the `unionStreamTypeChange` stream, then call `EndUnionStreamTypeChange() when done.`
   void WriteUnionStreamTypeChange(std::variant<int32_t, float> const& value);
 
-  // Ordinal 46.
+  // Ordinal 52.
   // Call this method to write many values to the `unionStreamTypeChange` stream, then call `EndUnionStreamTypeChange()` when done.
   void WriteUnionStreamTypeChange(std::vector<std::variant<int32_t, float>> const& values);
 
   // Marks the end of the `unionStreamTypeChange` stream.
   void EndUnionStreamTypeChange();
 
-  // Ordinal 47.
+  // Ordinal 53.
   // Call this method for each element of the `streamOfAliasTypeChange` stream, then call `EndStreamOfAliasTypeChange() when done.`
   void WriteStreamOfAliasTypeChange(evo_test::StreamItem const& value);
 
-  // Ordinal 47.
+  // Ordinal 53.
   // Call this method to write many values to the `streamOfAliasTypeChange` stream, then call `EndStreamOfAliasTypeChange()` when done.
   void WriteStreamOfAliasTypeChange(std::vector<evo_test::StreamItem> const& values);
 
   // Marks the end of the `streamOfAliasTypeChange` stream.
   void EndStreamOfAliasTypeChange();
 
-  // Ordinal 48.
+  // Ordinal 54.
   // Comprehensive NamedType changes
   void WriteRlink(evo_test::RLink const& value);
 
-  // Ordinal 49.
+  // Ordinal 55.
   void WriteRlinkRX(evo_test::RLink const& value);
 
-  // Ordinal 50.
+  // Ordinal 56.
   void WriteRlinkRY(evo_test::RLink const& value);
 
-  // Ordinal 51.
+  // Ordinal 57.
   void WriteRlinkRZ(evo_test::RLink const& value);
 
-  // Ordinal 52.
+  // Ordinal 58.
   void WriteRaRLink(evo_test::RA const& value);
 
-  // Ordinal 53.
+  // Ordinal 59.
   void WriteRaRX(evo_test::RA const& value);
 
-  // Ordinal 54.
+  // Ordinal 60.
   void WriteRaRY(evo_test::RA const& value);
 
-  // Ordinal 55.
+  // Ordinal 61.
   void WriteRaRZ(evo_test::RA const& value);
 
-  // Ordinal 56.
+  // Ordinal 62.
   void WriteRbRLink(evo_test::RB const& value);
 
-  // Ordinal 57.
+  // Ordinal 63.
   void WriteRbRX(evo_test::RB const& value);
 
-  // Ordinal 58.
+  // Ordinal 64.
   void WriteRbRY(evo_test::RB const& value);
 
-  // Ordinal 59.
+  // Ordinal 65.
   void WriteRbRZ(evo_test::RB const& value);
 
-  // Ordinal 60.
+  // Ordinal 66.
   void WriteRcRLink(evo_test::RC const& value);
 
-  // Ordinal 61.
+  // Ordinal 67.
   void WriteRcRX(evo_test::RC const& value);
 
-  // Ordinal 62.
+  // Ordinal 68.
   void WriteRcRY(evo_test::RC const& value);
 
-  // Ordinal 63.
+  // Ordinal 69.
   void WriteRcRZ(evo_test::RC const& value);
 
-  // Ordinal 64.
+  // Ordinal 70.
   void WriteRlinkRNew(evo_test::RLink const& value);
 
-  // Ordinal 65.
+  // Ordinal 71.
   void WriteRaRNew(evo_test::RA const& value);
 
-  // Ordinal 66.
+  // Ordinal 72.
   void WriteRbRNew(evo_test::RB const& value);
 
-  // Ordinal 67.
+  // Ordinal 73.
   void WriteRcRNew(evo_test::RC const& value);
 
-  // Ordinal 68.
+  // Ordinal 74.
   void WriteRlinkRUnion(evo_test::RLink const& value);
 
-  // Ordinal 69.
+  // Ordinal 75.
   void WriteRaRUnion(evo_test::RA const& value);
 
-  // Ordinal 70.
+  // Ordinal 76.
   void WriteRbRUnion(evo_test::RB const& value);
 
-  // Ordinal 71.
+  // Ordinal 77.
   void WriteRcRUnion(evo_test::RC const& value);
 
-  // Ordinal 72.
+  // Ordinal 78.
   void WriteOptionalRecordWithChanges(std::optional<evo_test::RecordWithChanges> const& value);
 
-  // Ordinal 73.
+  // Ordinal 79.
   void WriteAliasedOptionalRecordWithChanges(std::optional<evo_test::AliasedRecordWithChanges> const& value);
 
-  // Ordinal 74.
+  // Ordinal 80.
   void WriteUnionRecordWithChanges(std::variant<evo_test::RecordWithChanges, int32_t> const& value);
 
-  // Ordinal 75.
+  // Ordinal 81.
   void WriteUnionWithSameTypeset(std::variant<evo_test::RecordWithChanges, int32_t, float, std::string> const& value);
 
-  // Ordinal 76.
+  // Ordinal 82.
   void WriteUnionWithTypesAdded(std::variant<evo_test::RecordWithChanges, float> const& value);
 
-  // Ordinal 77.
+  // Ordinal 83.
   void WriteUnionWithTypesRemoved(std::variant<evo_test::RecordWithChanges, int32_t, float, std::string> const& value);
 
-  // Ordinal 78.
+  // Ordinal 84.
   void WriteRecordToOptional(evo_test::RecordWithChanges const& value);
 
-  // Ordinal 79.
+  // Ordinal 85.
   void WriteRecordToAliasedOptional(evo_test::RecordWithChanges const& value);
 
-  // Ordinal 80.
+  // Ordinal 86.
   void WriteRecordToUnion(evo_test::RecordWithChanges const& value);
 
-  // Ordinal 81.
+  // Ordinal 87.
   void WriteRecordToAliasedUnion(evo_test::RecordWithChanges const& value);
 
-  // Ordinal 82.
+  // Ordinal 88.
   void WriteUnionToAliasedUnion(std::variant<evo_test::RecordWithChanges, int32_t> const& value);
 
-  // Ordinal 83.
+  // Ordinal 89.
   void WriteUnionToAliasedUnionWithChanges(std::variant<evo_test::RecordWithChanges, int32_t> const& value);
 
-  // Ordinal 84.
+  // Ordinal 90.
   void WriteOptionalToAliasedOptional(std::optional<evo_test::RecordWithChanges> const& value);
 
-  // Ordinal 85.
+  // Ordinal 91.
   void WriteOptionalToAliasedOptionalWithChanges(std::optional<int32_t> const& value);
 
-  // Ordinal 86.
+  // Ordinal 92.
   void WriteGenericRecord(evo_test::GenericRecord<int32_t, std::string> const& value);
 
-  // Ordinal 87.
+  // Ordinal 93.
   void WriteGenericRecordToOpenAlias(evo_test::GenericRecord<int32_t, std::string> const& value);
 
-  // Ordinal 88.
+  // Ordinal 94.
   void WriteGenericRecordToClosedAlias(evo_test::GenericRecord<int32_t, std::string> const& value);
 
-  // Ordinal 89.
+  // Ordinal 95.
   void WriteGenericRecordToHalfClosedAlias(evo_test::GenericRecord<int32_t, std::string> const& value);
 
-  // Ordinal 90.
+  // Ordinal 96.
   void WriteAliasedGenericRecordToAlias(evo_test::AliasedHalfClosedGenericRecord<int32_t> const& value);
 
-  // Ordinal 91.
+  // Ordinal 97.
   void WriteGenericRecordToReversed(evo_test::GenericRecord<int32_t, std::string> const& value);
 
-  // Ordinal 92.
+  // Ordinal 98.
   void WriteClosedGenericRecordToUnion(evo_test::AliasedClosedGenericRecord const& value);
 
-  // Ordinal 93.
+  // Ordinal 99.
   void WriteGenericRecordToAliasedUnion(evo_test::GenericRecord<int32_t, std::string> const& value);
 
-  // Ordinal 94.
+  // Ordinal 100.
   void WriteGenericUnionToReversed(evo_test::GenericUnion<evo_test::GenericRecord<int32_t, std::string>, float> const& value);
 
-  // Ordinal 95.
+  // Ordinal 101.
   void WriteGenericUnionOfChangedRecord(evo_test::GenericUnion<evo_test::GenericRecord<int32_t, std::string>, float> const& value);
 
-  // Ordinal 96.
+  // Ordinal 102.
   void WriteGenericParentRecord(evo_test::GenericParentRecord<int32_t> const& value);
 
-  // Ordinal 97.
+  // Ordinal 103.
   void WriteGenericNestedRecords(evo_test::GenericRecord<evo_test::UnchangedGeneric<int32_t>, evo_test::ChangedGeneric<std::string, int32_t>> const& value);
 
-  // Ordinal 98.
+  // Ordinal 104.
   // Call this method for each element of the `genericRecordStream` stream, then call `EndGenericRecordStream() when done.`
   void WriteGenericRecordStream(evo_test::GenericRecord<int32_t, std::string> const& value);
 
-  // Ordinal 98.
+  // Ordinal 104.
   // Call this method to write many values to the `genericRecordStream` stream, then call `EndGenericRecordStream()` when done.
   void WriteGenericRecordStream(std::vector<evo_test::GenericRecord<int32_t, std::string>> const& values);
 
   // Marks the end of the `genericRecordStream` stream.
   void EndGenericRecordStream();
 
-  // Ordinal 99.
+  // Ordinal 105.
   // Call this method for each element of the `genericParentRecordStream` stream, then call `EndGenericParentRecordStream() when done.`
   void WriteGenericParentRecordStream(evo_test::GenericParentRecord<int32_t> const& value);
 
-  // Ordinal 99.
+  // Ordinal 105.
   // Call this method to write many values to the `genericParentRecordStream` stream, then call `EndGenericParentRecordStream()` when done.
   void WriteGenericParentRecordStream(std::vector<evo_test::GenericParentRecord<int32_t>> const& values);
 
   // Marks the end of the `genericParentRecordStream` stream.
   void EndGenericParentRecordStream();
 
-  // Ordinal 100.
+  // Ordinal 106.
   void WriteVectorRecordWithChanges(std::vector<evo_test::RecordWithChanges> const& value);
 
-  // Ordinal 101.
+  // Ordinal 107.
   // Call this method for each element of the `streamedRecordWithChanges` stream, then call `EndStreamedRecordWithChanges() when done.`
   void WriteStreamedRecordWithChanges(evo_test::RecordWithChanges const& value);
 
-  // Ordinal 101.
+  // Ordinal 107.
   // Call this method to write many values to the `streamedRecordWithChanges` stream, then call `EndStreamedRecordWithChanges()` when done.
   void WriteStreamedRecordWithChanges(std::vector<evo_test::RecordWithChanges> const& values);
 
   // Marks the end of the `streamedRecordWithChanges` stream.
   void EndStreamedRecordWithChanges();
 
-  // Ordinal 102.
+  // Ordinal 108.
   void WriteAddedStringVector(std::vector<evo_test::AliasedString> const& value);
 
-  // Ordinal 103.
+  // Ordinal 109.
   void WriteAddedOptional(std::optional<evo_test::RecordWithChanges> const& value);
 
-  // Ordinal 104.
+  // Ordinal 110.
   void WriteAddedMap(std::unordered_map<std::string, std::string> const& value);
 
-  // Ordinal 105.
+  // Ordinal 111.
   void WriteAddedUnion(std::variant<std::monostate, evo_test::RecordWithChanges, std::string> const& value);
 
-  // Ordinal 106.
+  // Ordinal 112.
   // Call this method for each element of the `addedRecordStream` stream, then call `EndAddedRecordStream() when done.`
   void WriteAddedRecordStream(evo_test::RecordWithChanges const& value);
 
-  // Ordinal 106.
+  // Ordinal 112.
   // Call this method to write many values to the `addedRecordStream` stream, then call `EndAddedRecordStream()` when done.
   void WriteAddedRecordStream(std::vector<evo_test::RecordWithChanges> const& values);
 
   // Marks the end of the `addedRecordStream` stream.
   void EndAddedRecordStream();
 
-  // Ordinal 107.
+  // Ordinal 113.
   // Call this method for each element of the `addedUnionStream` stream, then call `EndAddedUnionStream() when done.`
   void WriteAddedUnionStream(std::variant<evo_test::RecordWithChanges, evo_test::RenamedRecord> const& value);
 
-  // Ordinal 107.
+  // Ordinal 113.
   // Call this method to write many values to the `addedUnionStream` stream, then call `EndAddedUnionStream()` when done.
   void WriteAddedUnionStream(std::vector<std::variant<evo_test::RecordWithChanges, evo_test::RenamedRecord>> const& values);
 
@@ -460,6 +478,12 @@ class ProtocolWithChangesWriterBase {
   virtual void WriteAliasedLongToStringImpl(evo_test::AliasedLongToString const& value) = 0;
   virtual void WriteStringToAliasedStringImpl(std::string const& value) = 0;
   virtual void WriteStringToAliasedIntImpl(std::string const& value) = 0;
+  virtual void WriteStringToBytesImpl(std::string const& value) = 0;
+  virtual void WriteBytesToStringImpl(std::vector<std::byte> const& value) = 0;
+  virtual void WriteVectorToBytesImpl(std::vector<uint8_t> const& value) = 0;
+  virtual void WriteBytesToVectorImpl(std::vector<std::byte> const& value) = 0;
+  virtual void WriteStringToUuidImpl(std::string const& value) = 0;
+  virtual void WriteUuidToStringImpl(yardl::Uuid const& value) = 0;
   virtual void WriteEnumToAliasedEnumImpl(evo_test::GrowingEnum const& value) = 0;
   virtual void WriteOptionalIntToUnionImpl(std::optional<int32_t> const& value) = 0;
   virtual void WriteOptionalRecordToUnionImpl(std::optional<evo_test::RecordWithChanges> const& value) = 0;
@@ -672,264 +696,282 @@ class ProtocolWithChangesReaderBase {
   void ReadStringToAliasedInt(std::string& value);
 
   // Ordinal 32.
-  void ReadEnumToAliasedEnum(evo_test::GrowingEnum& value);
+  void ReadStringToBytes(std::string& value);
 
   // Ordinal 33.
-  void ReadOptionalIntToUnion(std::optional<int32_t>& value);
+  void ReadBytesToString(std::vector<std::byte>& value);
 
   // Ordinal 34.
-  void ReadOptionalRecordToUnion(std::optional<evo_test::RecordWithChanges>& value);
+  void ReadVectorToBytes(std::vector<uint8_t>& value);
 
   // Ordinal 35.
-  void ReadRecordWithChanges(evo_test::RecordWithChanges& value);
+  void ReadBytesToVector(std::vector<std::byte>& value);
 
   // Ordinal 36.
-  void ReadAliasedRecordWithChanges(evo_test::AliasedRecordWithChanges& value);
+  void ReadStringToUuid(std::string& value);
 
   // Ordinal 37.
-  void ReadRecordToRenamedRecord(evo_test::RenamedRecord& value);
+  void ReadUuidToString(yardl::Uuid& value);
 
   // Ordinal 38.
-  void ReadRecordToAliasedRecord(evo_test::RecordWithChanges& value);
+  void ReadEnumToAliasedEnum(evo_test::GrowingEnum& value);
 
   // Ordinal 39.
-  void ReadRecordToAliasedAlias(evo_test::RecordWithChanges& value);
+  void ReadOptionalIntToUnion(std::optional<int32_t>& value);
 
   // Ordinal 40.
+  void ReadOptionalRecordToUnion(std::optional<evo_test::RecordWithChanges>& value);
+
+  // Ordinal 41.
+  void ReadRecordWithChanges(evo_test::RecordWithChanges& value);
+
+  // Ordinal 42.
+  void ReadAliasedRecordWithChanges(evo_test::AliasedRecordWithChanges& value);
+
+  // Ordinal 43.
+  void ReadRecordToRenamedRecord(evo_test::RenamedRecord& value);
+
+  // Ordinal 44.
+  void ReadRecordToAliasedRecord(evo_test::RecordWithChanges& value);
+
+  // Ordinal 45.
+  void ReadRecordToAliasedAlias(evo_test::RecordWithChanges& value);
+
+  // Ordinal 46.
   // Stream and Vector type changes
   [[nodiscard]] bool ReadStreamIntToStringToFloat(float& value);
 
-  // Ordinal 40.
+  // Ordinal 46.
   // Stream and Vector type changes
   [[nodiscard]] bool ReadStreamIntToStringToFloat(std::vector<float>& values);
 
-  // Ordinal 41.
+  // Ordinal 47.
   void ReadVectorIntToStringToFloat(std::vector<float>& value);
 
-  // Ordinal 42.
+  // Ordinal 48.
   void ReadIntFloatUnionReordered(std::variant<int32_t, float>& value);
 
-  // Ordinal 43.
+  // Ordinal 49.
   void ReadVectorUnionReordered(std::vector<std::variant<int32_t, float>>& value);
 
-  // Ordinal 44.
+  // Ordinal 50.
   [[nodiscard]] bool ReadStreamUnionReordered(std::variant<int32_t, std::string>& value);
 
-  // Ordinal 44.
+  // Ordinal 50.
   [[nodiscard]] bool ReadStreamUnionReordered(std::vector<std::variant<int32_t, std::string>>& values);
 
-  // Ordinal 45.
+  // Ordinal 51.
   [[nodiscard]] bool ReadIntToUnionStream(std::variant<std::string, int32_t>& value);
 
-  // Ordinal 45.
+  // Ordinal 51.
   [[nodiscard]] bool ReadIntToUnionStream(std::vector<std::variant<std::string, int32_t>>& values);
 
-  // Ordinal 46.
+  // Ordinal 52.
   [[nodiscard]] bool ReadUnionStreamTypeChange(std::variant<int32_t, float>& value);
 
-  // Ordinal 46.
+  // Ordinal 52.
   [[nodiscard]] bool ReadUnionStreamTypeChange(std::vector<std::variant<int32_t, float>>& values);
 
-  // Ordinal 47.
+  // Ordinal 53.
   [[nodiscard]] bool ReadStreamOfAliasTypeChange(evo_test::StreamItem& value);
 
-  // Ordinal 47.
+  // Ordinal 53.
   [[nodiscard]] bool ReadStreamOfAliasTypeChange(std::vector<evo_test::StreamItem>& values);
 
-  // Ordinal 48.
+  // Ordinal 54.
   // Comprehensive NamedType changes
   void ReadRlink(evo_test::RLink& value);
 
-  // Ordinal 49.
+  // Ordinal 55.
   void ReadRlinkRX(evo_test::RLink& value);
 
-  // Ordinal 50.
+  // Ordinal 56.
   void ReadRlinkRY(evo_test::RLink& value);
 
-  // Ordinal 51.
+  // Ordinal 57.
   void ReadRlinkRZ(evo_test::RLink& value);
 
-  // Ordinal 52.
+  // Ordinal 58.
   void ReadRaRLink(evo_test::RA& value);
 
-  // Ordinal 53.
+  // Ordinal 59.
   void ReadRaRX(evo_test::RA& value);
 
-  // Ordinal 54.
+  // Ordinal 60.
   void ReadRaRY(evo_test::RA& value);
 
-  // Ordinal 55.
+  // Ordinal 61.
   void ReadRaRZ(evo_test::RA& value);
 
-  // Ordinal 56.
+  // Ordinal 62.
   void ReadRbRLink(evo_test::RB& value);
 
-  // Ordinal 57.
+  // Ordinal 63.
   void ReadRbRX(evo_test::RB& value);
 
-  // Ordinal 58.
+  // Ordinal 64.
   void ReadRbRY(evo_test::RB& value);
 
-  // Ordinal 59.
+  // Ordinal 65.
   void ReadRbRZ(evo_test::RB& value);
 
-  // Ordinal 60.
+  // Ordinal 66.
   void ReadRcRLink(evo_test::RC& value);
 
-  // Ordinal 61.
+  // Ordinal 67.
   void ReadRcRX(evo_test::RC& value);
 
-  // Ordinal 62.
+  // Ordinal 68.
   void ReadRcRY(evo_test::RC& value);
 
-  // Ordinal 63.
+  // Ordinal 69.
   void ReadRcRZ(evo_test::RC& value);
 
-  // Ordinal 64.
+  // Ordinal 70.
   void ReadRlinkRNew(evo_test::RLink& value);
 
-  // Ordinal 65.
+  // Ordinal 71.
   void ReadRaRNew(evo_test::RA& value);
 
-  // Ordinal 66.
+  // Ordinal 72.
   void ReadRbRNew(evo_test::RB& value);
 
-  // Ordinal 67.
+  // Ordinal 73.
   void ReadRcRNew(evo_test::RC& value);
 
-  // Ordinal 68.
+  // Ordinal 74.
   void ReadRlinkRUnion(evo_test::RLink& value);
 
-  // Ordinal 69.
+  // Ordinal 75.
   void ReadRaRUnion(evo_test::RA& value);
 
-  // Ordinal 70.
+  // Ordinal 76.
   void ReadRbRUnion(evo_test::RB& value);
 
-  // Ordinal 71.
+  // Ordinal 77.
   void ReadRcRUnion(evo_test::RC& value);
 
-  // Ordinal 72.
+  // Ordinal 78.
   void ReadOptionalRecordWithChanges(std::optional<evo_test::RecordWithChanges>& value);
 
-  // Ordinal 73.
+  // Ordinal 79.
   void ReadAliasedOptionalRecordWithChanges(std::optional<evo_test::AliasedRecordWithChanges>& value);
 
-  // Ordinal 74.
+  // Ordinal 80.
   void ReadUnionRecordWithChanges(std::variant<evo_test::RecordWithChanges, int32_t>& value);
 
-  // Ordinal 75.
+  // Ordinal 81.
   void ReadUnionWithSameTypeset(std::variant<evo_test::RecordWithChanges, int32_t, float, std::string>& value);
 
-  // Ordinal 76.
+  // Ordinal 82.
   void ReadUnionWithTypesAdded(std::variant<evo_test::RecordWithChanges, float>& value);
 
-  // Ordinal 77.
+  // Ordinal 83.
   void ReadUnionWithTypesRemoved(std::variant<evo_test::RecordWithChanges, int32_t, float, std::string>& value);
 
-  // Ordinal 78.
+  // Ordinal 84.
   void ReadRecordToOptional(evo_test::RecordWithChanges& value);
 
-  // Ordinal 79.
+  // Ordinal 85.
   void ReadRecordToAliasedOptional(evo_test::RecordWithChanges& value);
 
-  // Ordinal 80.
+  // Ordinal 86.
   void ReadRecordToUnion(evo_test::RecordWithChanges& value);
 
-  // Ordinal 81.
+  // Ordinal 87.
   void ReadRecordToAliasedUnion(evo_test::RecordWithChanges& value);
 
-  // Ordinal 82.
+  // Ordinal 88.
   void ReadUnionToAliasedUnion(std::variant<evo_test::RecordWithChanges, int32_t>& value);
 
-  // Ordinal 83.
+  // Ordinal 89.
   void ReadUnionToAliasedUnionWithChanges(std::variant<evo_test::RecordWithChanges, int32_t>& value);
 
-  // Ordinal 84.
+  // Ordinal 90.
   void ReadOptionalToAliasedOptional(std::optional<evo_test::RecordWithChanges>& value);
 
-  // Ordinal 85.
+  // Ordinal 91.
   void ReadOptionalToAliasedOptionalWithChanges(std::optional<int32_t>& value);
 
-  // Ordinal 86.
+  // Ordinal 92.
   void ReadGenericRecord(evo_test::GenericRecord<int32_t, std::string>& value);
 
-  // Ordinal 87.
+  // Ordinal 93.
   void ReadGenericRecordToOpenAlias(evo_test::GenericRecord<int32_t, std::string>& value);
 
-  // Ordinal 88.
+  // Ordinal 94.
   void ReadGenericRecordToClosedAlias(evo_test::GenericRecord<int32_t, std::string>& value);
 
-  // Ordinal 89.
+  // Ordinal 95.
   void ReadGenericRecordToHalfClosedAlias(evo_test::GenericRecord<int32_t, std::string>& value);
 
-  // Ordinal 90.
+  // Ordinal 96.
   void ReadAliasedGenericRecordToAlias(evo_test::AliasedHalfClosedGenericRecord<int32_t>& value);
 
-  // Ordinal 91.
+  // Ordinal 97.
   void ReadGenericRecordToReversed(evo_test::GenericRecord<int32_t, std::string>& value);
 
-  // Ordinal 92.
+  // Ordinal 98.
   void ReadClosedGenericRecordToUnion(evo_test::AliasedClosedGenericRecord& value);
 
-  // Ordinal 93.
+  // Ordinal 99.
   void ReadGenericRecordToAliasedUnion(evo_test::GenericRecord<int32_t, std::string>& value);
 
-  // Ordinal 94.
+  // Ordinal 100.
   void ReadGenericUnionToReversed(evo_test::GenericUnion<evo_test::GenericRecord<int32_t, std::string>, float>& value);
 
-  // Ordinal 95.
+  // Ordinal 101.
   void ReadGenericUnionOfChangedRecord(evo_test::GenericUnion<evo_test::GenericRecord<int32_t, std::string>, float>& value);
 
-  // Ordinal 96.
+  // Ordinal 102.
   void ReadGenericParentRecord(evo_test::GenericParentRecord<int32_t>& value);
 
-  // Ordinal 97.
+  // Ordinal 103.
   void ReadGenericNestedRecords(evo_test::GenericRecord<evo_test::UnchangedGeneric<int32_t>, evo_test::ChangedGeneric<std::string, int32_t>>& value);
 
-  // Ordinal 98.
+  // Ordinal 104.
   [[nodiscard]] bool ReadGenericRecordStream(evo_test::GenericRecord<int32_t, std::string>& value);
 
-  // Ordinal 98.
+  // Ordinal 104.
   [[nodiscard]] bool ReadGenericRecordStream(std::vector<evo_test::GenericRecord<int32_t, std::string>>& values);
 
-  // Ordinal 99.
+  // Ordinal 105.
   [[nodiscard]] bool ReadGenericParentRecordStream(evo_test::GenericParentRecord<int32_t>& value);
 
-  // Ordinal 99.
+  // Ordinal 105.
   [[nodiscard]] bool ReadGenericParentRecordStream(std::vector<evo_test::GenericParentRecord<int32_t>>& values);
 
-  // Ordinal 100.
+  // Ordinal 106.
   void ReadVectorRecordWithChanges(std::vector<evo_test::RecordWithChanges>& value);
 
-  // Ordinal 101.
+  // Ordinal 107.
   [[nodiscard]] bool ReadStreamedRecordWithChanges(evo_test::RecordWithChanges& value);
 
-  // Ordinal 101.
+  // Ordinal 107.
   [[nodiscard]] bool ReadStreamedRecordWithChanges(std::vector<evo_test::RecordWithChanges>& values);
 
-  // Ordinal 102.
+  // Ordinal 108.
   void ReadAddedStringVector(std::vector<evo_test::AliasedString>& value);
 
-  // Ordinal 103.
+  // Ordinal 109.
   void ReadAddedOptional(std::optional<evo_test::RecordWithChanges>& value);
 
-  // Ordinal 104.
+  // Ordinal 110.
   void ReadAddedMap(std::unordered_map<std::string, std::string>& value);
 
-  // Ordinal 105.
+  // Ordinal 111.
   void ReadAddedUnion(std::variant<std::monostate, evo_test::RecordWithChanges, std::string>& value);
 
-  // Ordinal 106.
+  // Ordinal 112.
   [[nodiscard]] bool ReadAddedRecordStream(evo_test::RecordWithChanges& value);
 
-  // Ordinal 106.
+  // Ordinal 112.
   [[nodiscard]] bool ReadAddedRecordStream(std::vector<evo_test::RecordWithChanges>& values);
 
-  // Ordinal 107.
+  // Ordinal 113.
   [[nodiscard]] bool ReadAddedUnionStream(std::variant<evo_test::RecordWithChanges, evo_test::RenamedRecord>& value);
 
-  // Ordinal 107.
+  // Ordinal 113.
   [[nodiscard]] bool ReadAddedUnionStream(std::vector<std::variant<evo_test::RecordWithChanges, evo_test::RenamedRecord>>& values);
 
   // Optionaly close this writer before destructing. Validates that all steps were completely read.
@@ -972,6 +1014,12 @@ class ProtocolWithChangesReaderBase {
   virtual void ReadAliasedLongToStringImpl(evo_test::AliasedLongToString& value) = 0;
   virtual void ReadStringToAliasedStringImpl(std::string& value) = 0;
   virtual void ReadStringToAliasedIntImpl(std::string& value) = 0;
+  virtual void ReadStringToBytesImpl(std::string& value) = 0;
+  virtual void ReadBytesToStringImpl(std::vector<std::byte>& value) = 0;
+  virtual void ReadVectorToBytesImpl(std::vector<uint8_t>& value) = 0;
+  virtual void ReadBytesToVectorImpl(std::vector<std::byte>& value) = 0;
+  virtual void ReadStringToUuidImpl(std::string& value) = 0;
+  virtual void ReadUuidToStringImpl(yardl::Uuid& value) = 0;
   virtual void ReadEnumToAliasedEnumImpl(evo_test::GrowingEnum& value) = 0;
   virtual void ReadOptionalIntToUnionImpl(std::optional<int32_t>& value) = 0;
   virtual void ReadOptionalRecordToUnionImpl(std::optional<evo_test::RecordWithChanges>& value) = 0;
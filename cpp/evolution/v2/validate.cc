@@ -128,6 +128,23 @@ int main(void) {
   r.ReadStringToAliasedInt(str);
   EVO_ASSERT(str == std::to_string(INT_MIN));
 
+  std::vector<std::byte> bytes;
+  std::vector<uint8_t> octets;
+  r.ReadStringToBytes(str);
+  EVO_ASSERT(str == HelloWorld);
+  r.ReadBytesToString(bytes);
+  EVO_ASSERT(bytes == HelloWorldBytes);
+  r.ReadVectorToBytes(octets);
+  EVO_ASSERT(octets == HelloWorldOctets);
+  r.ReadBytesToVector(bytes);
+  EVO_ASSERT(bytes == HelloWorldBytes);
+
+  yardl::Uuid uuid;
+  r.ReadStringToUuid(str);
+  EVO_ASSERT(str == UuidString);
+  r.ReadUuidToString(uuid);
+  EVO_ASSERT(uuid == yardl::Uuid::Parse(UuidString));
+
   GrowingEnum e;
   r.ReadEnumToAliasedEnum(e);
   EVO_ASSERT(e == GrowingEnum::kC);
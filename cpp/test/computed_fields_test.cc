@@ -2,6 +2,8 @@
 // Licensed under the MIT License.
 
 #include <filesystem>
+#include <limits>
+#include <stdexcept>
 #include <type_traits>
 #include <vector>
 
@@ -189,6 +191,85 @@ TEST(ComputedFieldsTest, Arithmetic) {
   ASSERT_EQ(r.Arithmetic11(), -(5.3));
 }
 
+TEST(ComputedFieldsTest, ComparisonAndLogicalOperators) {
+  RecordWithComputedFields r;
+  ASSERT_FALSE(r.Comparison1());
+  ASSERT_TRUE(r.Comparison2());
+  ASSERT_FALSE(r.Comparison3());
+  ASSERT_FALSE(r.Comparison4());
+  ASSERT_FALSE(r.Logical1());
+  ASSERT_TRUE(r.Logical2());
+
+  r.int_field = 42;
+  r.vector_field = {1, 2, 3, 4};
+  r.string_field = "hello";
+  r.float32_field = 42.5f;
+  ASSERT_TRUE(r.Comparison1());
+  ASSERT_FALSE(r.Comparison2());
+  ASSERT_TRUE(r.Comparison3());
+  ASSERT_TRUE(r.Comparison4());
+  ASSERT_TRUE(r.Logical1());
+  ASSERT_FALSE(r.Logical2());
+}
+
+TEST(ComputedFieldsTest, Conditional) {
+  RecordWithComputedFields r;
+  ASSERT_EQ(r.Conditional1(), -1);
+  ASSERT_EQ(r.Conditional2(), "zero");
+
+  r.vector_field = {7, 8};
+  r.int_field = 1;
+  ASSERT_EQ(r.Conditional1(), 7);
+  ASSERT_EQ(r.Conditional2(), "positive");
+
+  r.int_field = -1;
+  ASSERT_EQ(r.Conditional2(), "negative");
+}
+
+TEST(ComputedFieldsTest, Functions) {
+  RecordWithComputedFields r;
+  r.int_field = -5;
+  r.int8_field = 3;
+  ASSERT_EQ(r.CallMin(), -5);
+  ASSERT_EQ(r.CallAbs(), 5);
+
+  r.float32_field = 1.5f;
+  ASSERT_EQ(r.CallMax(), 2.0f);
+  static_assert(std::is_same_v<decltype(r.CallMax()), float>);
+
+  r.uint32_field = 16;
+  ASSERT_EQ(r.CallSqrt(), 4.0);
+  static_assert(std::is_same_v<decltype(r.CallSqrt()), double>);
+
+  r.float64_field = 2.5;
+  ASSERT_EQ(r.CallFloor(), 2.0);
+  ASSERT_EQ(r.CallCeil(), 3.0);
+
+  ASSERT_EQ(r.CallSum(), 0);
+  r.vector_field = {1, 2, 3};
+  ASSERT_EQ(r.CallSum(), 6);
+  r.vector_field = {std::numeric_limits<int32_t>::max(), 1};
+  ASSERT_THROW(r.CallSum(), std::overflow_error);
+  r.vector_field = {std::numeric_limits<int32_t>::min(), -1};
+  ASSERT_THROW(r.CallSum(), std::overflow_error);
+  r.vector_field = {std::numeric_limits<int32_t>::max(), 1, -1};
+  ASSERT_THROW(r.CallSum(), std::overflow_error);
+
+  r.string_field = "hello";
+  ASSERT_EQ(r.CallLength(), 5);
+  // The length is in UTF-8 bytes
+  r.string_field = "h\u00e9llo";
+  ASSERT_EQ(r.CallLength(), 6);
+
+  ASSERT_FALSE(r.CallContains());
+  r.map_field["hello"] = "world";
+  ASSERT_TRUE(r.CallContains());
+
+  ASSERT_FALSE(r.CallHasValue());
+  r.optional_named_array = {{1, 2}, {3, 4}};
+  ASSERT_TRUE(r.CallHasValue());
+}
+
 TEST(ComputedFieldsTest, Casting) {
   RecordWithComputedFields r;
   r.int_field = 42;
@@ -211,4 +292,19 @@ TEST(ComputedFieldsTest, Casting) {
   static_assert(std::is_same_v<decltype(r.CastFloatToComplex()), std::complex<float>>);
 }
 
+TEST(ComputedFieldsTest, TemporalArithmetic) {
+  RecordWithDurations r;
+  r.acquisition_start = yardl::DateTime(std::chrono::seconds(1000));
+  r.acquisition_end = yardl::DateTime(std::chrono::seconds(1090));
+  r.repetition_time = std::chrono::seconds(60);
+
+  ASSERT_EQ(r.Elapsed(), std::chrono::seconds(90));
+  static_assert(std::is_same_v<decltype(r.Elapsed()), yardl::Duration>);
+  ASSERT_EQ(r.NextAcquisitionStart(), yardl::DateTime(std::chrono::seconds(1060)));
+  ASSERT_TRUE(r.ExceedsRepetitionTime());
+
+  r.repetition_time = std::chrono::minutes(2);
+  ASSERT_FALSE(r.ExceedsRepetitionTime());
+}
+
 }  // namespace
@@ -2,6 +2,8 @@
 // Licensed under the MIT License.
 
 #include <filesystem>
+#include <string>
+#include <tuple>
 #include <type_traits>
 #include <vector>
 
@@ -84,4 +86,153 @@ TEST(DefinitionsTests, EnumsHaveExpectedUnderlyingType) {
   static_assert(std::is_same_v<std::underlying_type_t<Int64Enum>, int64_t>);
 }
 
+TEST(DefinitionsTests, FieldDefaults) {
+  RecordWithDefaults r;
+  EXPECT_EQ(r.int_field, 42);
+  EXPECT_EQ(r.int8_field, -7);
+  EXPECT_EQ(r.uint64_field, 0x8000000000000000ULL);
+  EXPECT_EQ(r.float32_field, 1.5f);
+  EXPECT_EQ(r.float64_field, 8.0);
+  EXPECT_EQ(r.complexfloat64_field, std::complex<double>(3, 0));
+  EXPECT_TRUE(r.bool_field);
+  EXPECT_EQ(r.string_field, "hello");
+  EXPECT_EQ(r.enum_field, Fruits::kPear);
+  EXPECT_EQ(r.no_default_field, 0);
+}
+
+TEST(DefinitionsTests, Constants) {
+  static_assert(std::is_same_v<decltype(kMaxChannels), int32_t const>);
+  static_assert(std::is_same_v<decltype(kSamplesPerChannel), uint32_t const>);
+  static_assert(std::is_same_v<decltype(kDefaultGain), float const>);
+  static_assert(kMaxChannels == 4);
+  static_assert(kSamplesPerChannel == 8);
+  static_assert(!kIsStrict);
+
+  RecordWithConstants r;
+  static_assert(std::tuple_size_v<decltype(r.channels)> == kMaxChannels);
+  EXPECT_EQ(r.samples.size(), kMaxChannels * kSamplesPerChannel);
+  EXPECT_EQ(r.gain, 1.5f);
+  EXPECT_EQ(r.ChannelCount(), 4);
+  EXPECT_EQ(r.SampleCount(), 32);
+  EXPECT_EQ(r.ScaledGain(), 2.25f);
+  EXPECT_FALSE(r.Strict());
+}
+
+TEST(DefinitionsTests, ExtendedRecordsIncludeInheritedFields) {
+  RecordWithBase r;
+  EXPECT_EQ(r.version, 0u);
+  EXPECT_EQ(r.name, "header");
+  r.version = 1;
+  r.data = {1, 2, 3};
+  EXPECT_TRUE(r.IsFirstVersion());
+  EXPECT_EQ(r.DataSize(), 3);
+
+  RecordWithBases r2;
+  static_assert(std::is_same_v<decltype(r2.label), std::string>);
+  EXPECT_EQ(r2.name, "header");
+  EXPECT_FALSE(r2.IsFirstVersion());
+}
+
+TEST(DefinitionsTests, Units) {
+  RecordWithUnits r;
+  static_assert(std::is_same_v<decltype(r.length), float>);
+  static_assert(std::is_same_v<decltype(r.width), Millimeters>);
+  r.length = 2;
+  r.width = 3;
+  r.durations = {0.5, 1.5};
+  EXPECT_EQ(r.Perimeter(), 10.0f);
+  EXPECT_EQ(r.Area(), 6.0f);
+  EXPECT_EQ(r.TotalDuration(), 2.0);
+}
+
+TEST(DefinitionsTests, FieldConstraints) {
+  RecordWithConstraints r;
+  r.size = 1;
+  r.gain = 2.5f;
+  r.name = "abc_1";
+  r.samples = {1};
+  EXPECT_NO_THROW(r.Validate());
+
+  auto expect_invalid = [](RecordWithConstraints r, auto change) {
+    change(r);
+    EXPECT_THROW(r.Validate(), std::runtime_error);
+  };
+
+  expect_invalid(r, [](auto& r) { r.size = 0; });
+  expect_invalid(r, [](auto& r) { r.size = 4097; });
+  expect_invalid(r, [](auto& r) { r.gain = -0.5f; });
+  expect_invalid(r, [](auto& r) { r.name = "Abc"; });
+  expect_invalid(r, [](auto& r) { r.name = "abc!"; });
+  expect_invalid(r, [](auto& r) { r.limit = 11; });
+  expect_invalid(r, [](auto& r) { r.samples = {}; });
+  expect_invalid(r, [](auto& r) { r.samples = {1, 2, 3, 4, 5}; });
+
+  RecordWithConstrainedRecords outer;
+  outer.single = r;
+  outer.vector = {r, r};
+  EXPECT_NO_THROW(outer.Validate());
+  outer.vector[1].size = 0;
+  EXPECT_THROW(outer.Validate(), std::runtime_error);
+  outer.vector.clear();
+  outer.optional = r;
+  outer.optional->name = "";
+  EXPECT_THROW(outer.Validate(), std::runtime_error);
+}
+
+// The same cases are tested in every language, since each uses its own regular
+// expression library.
+TEST(DefinitionsTests, FieldPatterns) {
+  RecordWithPatterns valid;
+  valid.alternation = "a";
+  valid.word = "w";
+  valid.code = "12";
+  valid.escaped = "(.)+";
+  valid.capitalized = "A";
+  EXPECT_NO_THROW(valid.Validate());
+
+  std::vector<std::tuple<std::string RecordWithPatterns::*, std::string, bool>> cases = {
+      {&RecordWithPatterns::alternation, "ab", true},
+      {&RecordWithPatterns::alternation, "abc", false},
+      {&RecordWithPatterns::alternation, "", false},
+      {&RecordWithPatterns::alternation, "a\n", false},
+      {&RecordWithPatterns::word, "abc_1", true},
+      {&RecordWithPatterns::word, "\u00e9", false},
+      {&RecordWithPatterns::word, "a b", false},
+      {&RecordWithPatterns::code, "123-45", true},
+      {&RecordWithPatterns::code, "1234", false},
+      {&RecordWithPatterns::code, "12-4", false},
+      {&RecordWithPatterns::code, "\u0661\u0662", false},
+      {&RecordWithPatterns::escaped, "(.)-", true},
+      {&RecordWithPatterns::escaped, "(.)*", true},
+      {&RecordWithPatterns::escaped, "(x)-", false},
+      {&RecordWithPatterns::capitalized, "Abc", true},
+      {&RecordWithPatterns::capitalized, "abc", false},
+      {&RecordWithPatterns::capitalized, "ABC", false},
+  };
+
+  for (auto const& [field, value, matches] : cases) {
+    RecordWithPatterns r = valid;
+    r.*field = value;
+    if (matches) {
+      EXPECT_NO_THROW(r.Validate()) << value;
+    } else {
+      EXPECT_THROW(r.Validate(), std::runtime_error) << value;
+    }
+  }
+}
+
+TEST(DefinitionsTests, UuidParse) {
+  auto id = yardl::Uuid::Parse("123E4567-e89b-12d3-a456-426614174000");
+  EXPECT_EQ(id.ToString(), "123e4567-e89b-12d3-a456-426614174000");
+  EXPECT_EQ(id.Bytes()[0], 0x12);
+  EXPECT_EQ(id.Bytes()[15], 0x00);
+
+  EXPECT_THROW(yardl::Uuid::Parse(""), std::invalid_argument);
+  EXPECT_THROW(yardl::Uuid::Parse("123e4567e89b12d3a456426614174000"), std::invalid_argument);
+  EXPECT_THROW(yardl::Uuid::Parse("123e4567-e89b-12d3-a456-42661417400g"), std::invalid_argument);
+  // An extra dash where a hex digit is expected must not be skipped
+  EXPECT_THROW(yardl::Uuid::Parse("123e4567-e89b-12d3-a456-42-661417400"), std::invalid_argument);
+  EXPECT_THROW(yardl::Uuid::Parse("123e4567-e89b-12d3--456-426614174000"), std::invalid_argument);
+}
+
 }  // namespace
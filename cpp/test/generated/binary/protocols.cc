@@ -253,6 +253,92 @@ struct IsTriviallySerializable<test_model::RecordWithStrings> {
     offsetof(__T__, a) < offsetof(__T__, b);
 };
 
+template <>
+struct IsTriviallySerializable<test_model::RecordWithBytes> {
+  using __T__ = test_model::RecordWithBytes;
+  static constexpr bool value = 
+    std::is_standard_layout_v<__T__> &&
+    IsTriviallySerializable<decltype(__T__::data)>::value &&
+    IsTriviallySerializable<decltype(__T__::optional_data)>::value &&
+    IsTriviallySerializable<decltype(__T__::chunks)>::value &&
+    (sizeof(__T__) == (sizeof(__T__::data) + sizeof(__T__::optional_data) + sizeof(__T__::chunks))) &&
+    offsetof(__T__, data) < offsetof(__T__, optional_data) && offsetof(__T__, optional_data) < offsetof(__T__, chunks);
+};
+
+template <>
+struct IsTriviallySerializable<test_model::RecordWithUuids> {
+  using __T__ = test_model::RecordWithUuids;
+  static constexpr bool value = 
+    std::is_standard_layout_v<__T__> &&
+    IsTriviallySerializable<decltype(__T__::id)>::value &&
+    IsTriviallySerializable<decltype(__T__::optional_id)>::value &&
+    IsTriviallySerializable<decltype(__T__::related)>::value &&
+    IsTriviallySerializable<decltype(__T__::names)>::value &&
+    (sizeof(__T__) == (sizeof(__T__::id) + sizeof(__T__::optional_id) + sizeof(__T__::related) + sizeof(__T__::names))) &&
+    offsetof(__T__, id) < offsetof(__T__, optional_id) && offsetof(__T__, optional_id) < offsetof(__T__, related) && offsetof(__T__, related) < offsetof(__T__, names);
+};
+
+template <>
+struct IsTriviallySerializable<test_model::RecordWithDurations> {
+  using __T__ = test_model::RecordWithDurations;
+  static constexpr bool value = 
+    std::is_standard_layout_v<__T__> &&
+    IsTriviallySerializable<decltype(__T__::acquisition_start)>::value &&
+    IsTriviallySerializable<decltype(__T__::acquisition_end)>::value &&
+    IsTriviallySerializable<decltype(__T__::repetition_time)>::value &&
+    IsTriviallySerializable<decltype(__T__::timeout)>::value &&
+    IsTriviallySerializable<decltype(__T__::intervals)>::value &&
+    (sizeof(__T__) == (sizeof(__T__::acquisition_start) + sizeof(__T__::acquisition_end) + sizeof(__T__::repetition_time) + sizeof(__T__::timeout) + sizeof(__T__::intervals))) &&
+    offsetof(__T__, acquisition_start) < offsetof(__T__, acquisition_end) && offsetof(__T__, acquisition_end) < offsetof(__T__, repetition_time) && offsetof(__T__, repetition_time) < offsetof(__T__, timeout) && offsetof(__T__, timeout) < offsetof(__T__, intervals);
+};
+
+template <>
+struct IsTriviallySerializable<test_model::TreeNode> {
+  using __T__ = test_model::TreeNode;
+  static constexpr bool value = 
+    std::is_standard_layout_v<__T__> &&
+    IsTriviallySerializable<decltype(__T__::label)>::value &&
+    IsTriviallySerializable<decltype(__T__::children)>::value &&
+    (sizeof(__T__) == (sizeof(__T__::label) + sizeof(__T__::children))) &&
+    offsetof(__T__, label) < offsetof(__T__, children);
+};
+
+template <>
+struct IsTriviallySerializable<test_model::LinkedListNode> {
+  using __T__ = test_model::LinkedListNode;
+  static constexpr bool value = 
+    std::is_standard_layout_v<__T__> &&
+    IsTriviallySerializable<decltype(__T__::value)>::value &&
+    IsTriviallySerializable<decltype(__T__::next)>::value &&
+    (sizeof(__T__) == (sizeof(__T__::value) + sizeof(__T__::next))) &&
+    offsetof(__T__, value) < offsetof(__T__, next);
+};
+
+template <>
+struct IsTriviallySerializable<test_model::Expression> {
+  using __T__ = test_model::Expression;
+  static constexpr bool value = 
+    std::is_standard_layout_v<__T__> &&
+    IsTriviallySerializable<decltype(__T__::name)>::value &&
+    IsTriviallySerializable<decltype(__T__::operand)>::value &&
+    IsTriviallySerializable<decltype(__T__::arguments)>::value &&
+    (sizeof(__T__) == (sizeof(__T__::name) + sizeof(__T__::operand) + sizeof(__T__::arguments))) &&
+    offsetof(__T__, name) < offsetof(__T__, operand) && offsetof(__T__, operand) < offsetof(__T__, arguments);
+};
+
+template <>
+struct IsTriviallySerializable<test_model::RecordWithHalfPrecision> {
+  using __T__ = test_model::RecordWithHalfPrecision;
+  static constexpr bool value = 
+    std::is_standard_layout_v<__T__> &&
+    IsTriviallySerializable<decltype(__T__::half)>::value &&
+    IsTriviallySerializable<decltype(__T__::brain)>::value &&
+    IsTriviallySerializable<decltype(__T__::half_vector)>::value &&
+    IsTriviallySerializable<decltype(__T__::brain_array)>::value &&
+    (sizeof(__T__) == (sizeof(__T__::half) + sizeof(__T__::brain) + sizeof(__T__::half_vector) + sizeof(__T__::brain_array))) &&
+    offsetof(__T__, half) < offsetof(__T__, brain) && offsetof(__T__, brain) < offsetof(__T__, half_vector) && offsetof(__T__, half_vector) < offsetof(__T__, brain_array);
+};
+
 template <>
 struct IsTriviallySerializable<test_model::RecordWithOptionalVector> {
   using __T__ = test_model::RecordWithOptionalVector;
@@ -602,6 +688,65 @@ struct IsTriviallySerializable<test_model::RecordWithComputedFields> {
     offsetof(__T__, array_field) < offsetof(__T__, array_field_map_dimensions) && offsetof(__T__, array_field_map_dimensions) < offsetof(__T__, dynamic_array_field) && offsetof(__T__, dynamic_array_field) < offsetof(__T__, fixed_array_field) && offsetof(__T__, fixed_array_field) < offsetof(__T__, int_field) && offsetof(__T__, int_field) < offsetof(__T__, int8_field) && offsetof(__T__, int8_field) < offsetof(__T__, uint8_field) && offsetof(__T__, uint8_field) < offsetof(__T__, int16_field) && offsetof(__T__, int16_field) < offsetof(__T__, uint16_field) && offsetof(__T__, uint16_field) < offsetof(__T__, uint32_field) && offsetof(__T__, uint32_field) < offsetof(__T__, int64_field) && offsetof(__T__, int64_field) < offsetof(__T__, uint64_field) && offsetof(__T__, uint64_field) < offsetof(__T__, size_field) && offsetof(__T__, size_field) < offsetof(__T__, float32_field) && offsetof(__T__, float32_field) < offsetof(__T__, float64_field) && offsetof(__T__, float64_field) < offsetof(__T__, complexfloat32_field) && offsetof(__T__, complexfloat32_field) < offsetof(__T__, complexfloat64_field) && offsetof(__T__, complexfloat64_field) < offsetof(__T__, string_field) && offsetof(__T__, string_field) < offsetof(__T__, tuple_field) && offsetof(__T__, tuple_field) < offsetof(__T__, vector_field) && offsetof(__T__, vector_field) < offsetof(__T__, vector_of_vectors_field) && offsetof(__T__, vector_of_vectors_field) < offsetof(__T__, fixed_vector_field) && offsetof(__T__, fixed_vector_field) < offsetof(__T__, fixed_vector_of_vectors_field) && offsetof(__T__, fixed_vector_of_vectors_field) < offsetof(__T__, optional_named_array) && offsetof(__T__, optional_named_array) < offsetof(__T__, int_float_union) && offsetof(__T__, int_float_union) < offsetof(__T__, nullable_int_float_union) && offsetof(__T__, nullable_int_float_union) < offsetof(__T__, union_with_nested_generic_union) && offsetof(__T__, union_with_nested_generic_union) < offsetof(__T__, map_field);
 };
 
+template <>
+struct IsTriviallySerializable<test_model::RecordWithDefaults> {
+  using __T__ = test_model::RecordWithDefaults;
+  static constexpr bool value = 
+    std::is_standard_layout_v<__T__> &&
+    IsTriviallySerializable<decltype(__T__::int_field)>::value &&
+    IsTriviallySerializable<decltype(__T__::int8_field)>::value &&
+    IsTriviallySerializable<decltype(__T__::uint64_field)>::value &&
+    IsTriviallySerializable<decltype(__T__::float32_field)>::value &&
+    IsTriviallySerializable<decltype(__T__::float64_field)>::value &&
+    IsTriviallySerializable<decltype(__T__::complexfloat64_field)>::value &&
+    IsTriviallySerializable<decltype(__T__::bool_field)>::value &&
+    IsTriviallySerializable<decltype(__T__::string_field)>::value &&
+    IsTriviallySerializable<decltype(__T__::enum_field)>::value &&
+    IsTriviallySerializable<decltype(__T__::no_default_field)>::value &&
+    (sizeof(__T__) == (sizeof(__T__::int_field) + sizeof(__T__::int8_field) + sizeof(__T__::uint64_field) + sizeof(__T__::float32_field) + sizeof(__T__::float64_field) + sizeof(__T__::complexfloat64_field) + sizeof(__T__::bool_field) + sizeof(__T__::string_field) + sizeof(__T__::enum_field) + sizeof(__T__::no_default_field))) &&
+    offsetof(__T__, int_field) < offsetof(__T__, int8_field) && offsetof(__T__, int8_field) < offsetof(__T__, uint64_field) && offsetof(__T__, uint64_field) < offsetof(__T__, float32_field) && offsetof(__T__, float32_field) < offsetof(__T__, float64_field) && offsetof(__T__, float64_field) < offsetof(__T__, complexfloat64_field) && offsetof(__T__, complexfloat64_field) < offsetof(__T__, bool_field) && offsetof(__T__, bool_field) < offsetof(__T__, string_field) && offsetof(__T__, string_field) < offsetof(__T__, enum_field) && offsetof(__T__, enum_field) < offsetof(__T__, no_default_field);
+};
+
+template <>
+struct IsTriviallySerializable<test_model::RecordWithConstraints> {
+  using __T__ = test_model::RecordWithConstraints;
+  static constexpr bool value = 
+    std::is_standard_layout_v<__T__> &&
+    IsTriviallySerializable<decltype(__T__::size)>::value &&
+    IsTriviallySerializable<decltype(__T__::gain)>::value &&
+    IsTriviallySerializable<decltype(__T__::name)>::value &&
+    IsTriviallySerializable<decltype(__T__::limit)>::value &&
+    IsTriviallySerializable<decltype(__T__::samples)>::value &&
+    (sizeof(__T__) == (sizeof(__T__::size) + sizeof(__T__::gain) + sizeof(__T__::name) + sizeof(__T__::limit) + sizeof(__T__::samples))) &&
+    offsetof(__T__, size) < offsetof(__T__, gain) && offsetof(__T__, gain) < offsetof(__T__, name) && offsetof(__T__, name) < offsetof(__T__, limit) && offsetof(__T__, limit) < offsetof(__T__, samples);
+};
+
+template <>
+struct IsTriviallySerializable<test_model::RecordWithPatterns> {
+  using __T__ = test_model::RecordWithPatterns;
+  static constexpr bool value = 
+    std::is_standard_layout_v<__T__> &&
+    IsTriviallySerializable<decltype(__T__::alternation)>::value &&
+    IsTriviallySerializable<decltype(__T__::word)>::value &&
+    IsTriviallySerializable<decltype(__T__::code)>::value &&
+    IsTriviallySerializable<decltype(__T__::escaped)>::value &&
+    IsTriviallySerializable<decltype(__T__::capitalized)>::value &&
+    (sizeof(__T__) == (sizeof(__T__::alternation) + sizeof(__T__::word) + sizeof(__T__::code) + sizeof(__T__::escaped) + sizeof(__T__::capitalized))) &&
+    offsetof(__T__, alternation) < offsetof(__T__, word) && offsetof(__T__, word) < offsetof(__T__, code) && offsetof(__T__, code) < offsetof(__T__, escaped) && offsetof(__T__, escaped) < offsetof(__T__, capitalized);
+};
+
+template <>
+struct IsTriviallySerializable<test_model::RecordWithConstrainedRecords> {
+  using __T__ = test_model::RecordWithConstrainedRecords;
+  static constexpr bool value = 
+    std::is_standard_layout_v<__T__> &&
+    IsTriviallySerializable<decltype(__T__::single)>::value &&
+    IsTriviallySerializable<decltype(__T__::optional)>::value &&
+    IsTriviallySerializable<decltype(__T__::vector)>::value &&
+    (sizeof(__T__) == (sizeof(__T__::single) + sizeof(__T__::optional) + sizeof(__T__::vector))) &&
+    offsetof(__T__, single) < offsetof(__T__, optional) && offsetof(__T__, optional) < offsetof(__T__, vector);
+};
+
 template <>
 struct IsTriviallySerializable<test_model::RecordNotUsedInProtocol> {
   using __T__ = test_model::RecordNotUsedInProtocol;
@@ -634,6 +779,87 @@ struct IsTriviallySerializable<test_model::RecordWithOptionalDate> {
     (sizeof(__T__) == (sizeof(__T__::date_field)));
 };
 
+template <>
+struct IsTriviallySerializable<test_model::RecordWithConstants> {
+  using __T__ = test_model::RecordWithConstants;
+  static constexpr bool value = 
+    std::is_standard_layout_v<__T__> &&
+    IsTriviallySerializable<decltype(__T__::channels)>::value &&
+    IsTriviallySerializable<decltype(__T__::samples)>::value &&
+    IsTriviallySerializable<decltype(__T__::gain)>::value &&
+    (sizeof(__T__) == (sizeof(__T__::channels) + sizeof(__T__::samples) + sizeof(__T__::gain))) &&
+    offsetof(__T__, channels) < offsetof(__T__, samples) && offsetof(__T__, samples) < offsetof(__T__, gain);
+};
+
+template <>
+struct IsTriviallySerializable<test_model::RecordHeader> {
+  using __T__ = test_model::RecordHeader;
+  static constexpr bool value = 
+    std::is_standard_layout_v<__T__> &&
+    IsTriviallySerializable<decltype(__T__::version)>::value &&
+    IsTriviallySerializable<decltype(__T__::name)>::value &&
+    (sizeof(__T__) == (sizeof(__T__::version) + sizeof(__T__::name))) &&
+    offsetof(__T__, version) < offsetof(__T__, name);
+};
+
+template <typename T>
+struct IsTriviallySerializable<test_model::LabeledHeader<T>> {
+  using __T__ = test_model::LabeledHeader<T>;
+  static constexpr bool value = 
+    std::is_standard_layout_v<__T__> &&
+    IsTriviallySerializable<decltype(__T__::label)>::value &&
+    (sizeof(__T__) == (sizeof(__T__::label)));
+};
+
+template <>
+struct IsTriviallySerializable<test_model::RecordWithBase> {
+  using __T__ = test_model::RecordWithBase;
+  static constexpr bool value = 
+    std::is_standard_layout_v<__T__> &&
+    IsTriviallySerializable<decltype(__T__::version)>::value &&
+    IsTriviallySerializable<decltype(__T__::name)>::value &&
+    IsTriviallySerializable<decltype(__T__::data)>::value &&
+    (sizeof(__T__) == (sizeof(__T__::version) + sizeof(__T__::name) + sizeof(__T__::data))) &&
+    offsetof(__T__, version) < offsetof(__T__, name) && offsetof(__T__, name) < offsetof(__T__, data);
+};
+
+template <>
+struct IsTriviallySerializable<test_model::RecordWithBases> {
+  using __T__ = test_model::RecordWithBases;
+  static constexpr bool value = 
+    std::is_standard_layout_v<__T__> &&
+    IsTriviallySerializable<decltype(__T__::version)>::value &&
+    IsTriviallySerializable<decltype(__T__::name)>::value &&
+    IsTriviallySerializable<decltype(__T__::label)>::value &&
+    IsTriviallySerializable<decltype(__T__::value)>::value &&
+    (sizeof(__T__) == (sizeof(__T__::version) + sizeof(__T__::name) + sizeof(__T__::label) + sizeof(__T__::value))) &&
+    offsetof(__T__, version) < offsetof(__T__, name) && offsetof(__T__, name) < offsetof(__T__, label) && offsetof(__T__, label) < offsetof(__T__, value);
+};
+
+template <>
+struct IsTriviallySerializable<test_model::RecordWithAnnotations> {
+  using __T__ = test_model::RecordWithAnnotations;
+  static constexpr bool value = 
+    std::is_standard_layout_v<__T__> &&
+    IsTriviallySerializable<decltype(__T__::thickness)>::value &&
+    IsTriviallySerializable<decltype(__T__::spacing)>::value &&
+    IsTriviallySerializable<decltype(__T__::label)>::value &&
+    (sizeof(__T__) == (sizeof(__T__::thickness) + sizeof(__T__::spacing) + sizeof(__T__::label))) &&
+    offsetof(__T__, thickness) < offsetof(__T__, spacing) && offsetof(__T__, spacing) < offsetof(__T__, label);
+};
+
+template <>
+struct IsTriviallySerializable<test_model::RecordWithUnits> {
+  using __T__ = test_model::RecordWithUnits;
+  static constexpr bool value = 
+    std::is_standard_layout_v<__T__> &&
+    IsTriviallySerializable<decltype(__T__::length)>::value &&
+    IsTriviallySerializable<decltype(__T__::width)>::value &&
+    IsTriviallySerializable<decltype(__T__::durations)>::value &&
+    (sizeof(__T__) == (sizeof(__T__::length) + sizeof(__T__::width) + sizeof(__T__::durations))) &&
+    offsetof(__T__, length) < offsetof(__T__, width) && offsetof(__T__, width) < offsetof(__T__, durations);
+};
+
 #ifndef _MSC_VER
 #pragma GCC diagnostic pop // #pragma GCC diagnostic ignored "-Winvalid-offsetof" 
 #endif
@@ -1062,6 +1288,13 @@ template<typename T, yardl::binary::Reader<T> ReadT>
 
 namespace test_model::binary {
 namespace {
+[[maybe_unused]] void WriteTreeNode(yardl::binary::CodedOutputStream& stream, test_model::TreeNode const& value);
+[[maybe_unused]] void ReadTreeNode(yardl::binary::CodedInputStream& stream, test_model::TreeNode& value);
+[[maybe_unused]] void WriteLinkedListNode(yardl::binary::CodedOutputStream& stream, test_model::LinkedListNode const& value);
+[[maybe_unused]] void ReadLinkedListNode(yardl::binary::CodedInputStream& stream, test_model::LinkedListNode& value);
+[[maybe_unused]] void WriteExpression(yardl::binary::CodedOutputStream& stream, test_model::Expression const& value);
+[[maybe_unused]] void ReadExpression(yardl::binary::CodedInputStream& stream, test_model::Expression& value);
+
 [[maybe_unused]] void WriteSmallBenchmarkRecord(yardl::binary::CodedOutputStream& stream, test_model::SmallBenchmarkRecord const& value) {
   if constexpr (yardl::binary::IsTriviallySerializable<test_model::SmallBenchmarkRecord>::value) {
     yardl::binary::WriteTriviallySerializable(stream, value);
@@ -1430,6 +1663,164 @@ namespace {
   yardl::binary::ReadString(stream, value.b);
 }
 
+[[maybe_unused]] void WriteRecordWithBytes(yardl::binary::CodedOutputStream& stream, test_model::RecordWithBytes const& value) {
+  if constexpr (yardl::binary::IsTriviallySerializable<test_model::RecordWithBytes>::value) {
+    yardl::binary::WriteTriviallySerializable(stream, value);
+    return;
+  }
+
+  yardl::binary::WriteBytes(stream, value.data);
+  yardl::binary::WriteOptional<std::vector<std::byte>, yardl::binary::WriteBytes>(stream, value.optional_data);
+  yardl::binary::WriteVector<std::vector<std::byte>, yardl::binary::WriteBytes>(stream, value.chunks);
+}
+
+[[maybe_unused]] void ReadRecordWithBytes(yardl::binary::CodedInputStream& stream, test_model::RecordWithBytes& value) {
+  if constexpr (yardl::binary::IsTriviallySerializable<test_model::RecordWithBytes>::value) {
+    yardl::binary::ReadTriviallySerializable(stream, value);
+    return;
+  }
+
+  yardl::binary::ReadBytes(stream, value.data);
+  yardl::binary::ReadOptional<std::vector<std::byte>, yardl::binary::ReadBytes>(stream, value.optional_data);
+  yardl::binary::ReadVector<std::vector<std::byte>, yardl::binary::ReadBytes>(stream, value.chunks);
+}
+
+[[maybe_unused]] void WriteRecordWithUuids(yardl::binary::CodedOutputStream& stream, test_model::RecordWithUuids const& value) {
+  if constexpr (yardl::binary::IsTriviallySerializable<test_model::RecordWithUuids>::value) {
+    yardl::binary::WriteTriviallySerializable(stream, value);
+    return;
+  }
+
+  yardl::binary::WriteUuid(stream, value.id);
+  yardl::binary::WriteOptional<yardl::Uuid, yardl::binary::WriteUuid>(stream, value.optional_id);
+  yardl::binary::WriteVector<yardl::Uuid, yardl::binary::WriteUuid>(stream, value.related);
+  yardl::binary::WriteMap<yardl::Uuid, std::string, yardl::binary::WriteUuid, yardl::binary::WriteString>(stream, value.names);
+}
+
+[[maybe_unused]] void ReadRecordWithUuids(yardl::binary::CodedInputStream& stream, test_model::RecordWithUuids& value) {
+  if constexpr (yardl::binary::IsTriviallySerializable<test_model::RecordWithUuids>::value) {
+    yardl::binary::ReadTriviallySerializable(stream, value);
+    return;
+  }
+
+  yardl::binary::ReadUuid(stream, value.id);
+  yardl::binary::ReadOptional<yardl::Uuid, yardl::binary::ReadUuid>(stream, value.optional_id);
+  yardl::binary::ReadVector<yardl::Uuid, yardl::binary::ReadUuid>(stream, value.related);
+  yardl::binary::ReadMap<yardl::Uuid, std::string, yardl::binary::ReadUuid, yardl::binary::ReadString>(stream, value.names);
+}
+
+[[maybe_unused]] void WriteRecordWithDurations(yardl::binary::CodedOutputStream& stream, test_model::RecordWithDurations const& value) {
+  if constexpr (yardl::binary::IsTriviallySerializable<test_model::RecordWithDurations>::value) {
+    yardl::binary::WriteTriviallySerializable(stream, value);
+    return;
+  }
+
+  yardl::binary::WriteDateTime(stream, value.acquisition_start);
+  yardl::binary::WriteDateTime(stream, value.acquisition_end);
+  yardl::binary::WriteDuration(stream, value.repetition_time);
+  yardl::binary::WriteOptional<yardl::Duration, yardl::binary::WriteDuration>(stream, value.timeout);
+  yardl::binary::WriteVector<yardl::Duration, yardl::binary::WriteDuration>(stream, value.intervals);
+}
+
+[[maybe_unused]] void ReadRecordWithDurations(yardl::binary::CodedInputStream& stream, test_model::RecordWithDurations& value) {
+  if constexpr (yardl::binary::IsTriviallySerializable<test_model::RecordWithDurations>::value) {
+    yardl::binary::ReadTriviallySerializable(stream, value);
+    return;
+  }
+
+  yardl::binary::ReadDateTime(stream, value.acquisition_start);
+  yardl::binary::ReadDateTime(stream, value.acquisition_end);
+  yardl::binary::ReadDuration(stream, value.repetition_time);
+  yardl::binary::ReadOptional<yardl::Duration, yardl::binary::ReadDuration>(stream, value.timeout);
+  yardl::binary::ReadVector<yardl::Duration, yardl::binary::ReadDuration>(stream, value.intervals);
+}
+
+[[maybe_unused]] void WriteTreeNode(yardl::binary::CodedOutputStream& stream, test_model::TreeNode const& value) {
+  if constexpr (yardl::binary::IsTriviallySerializable<test_model::TreeNode>::value) {
+    yardl::binary::WriteTriviallySerializable(stream, value);
+    return;
+  }
+
+  yardl::binary::WriteString(stream, value.label);
+  yardl::binary::WriteVector<test_model::TreeNode, test_model::binary::WriteTreeNode>(stream, value.children);
+}
+
+[[maybe_unused]] void ReadTreeNode(yardl::binary::CodedInputStream& stream, test_model::TreeNode& value) {
+  if constexpr (yardl::binary::IsTriviallySerializable<test_model::TreeNode>::value) {
+    yardl::binary::ReadTriviallySerializable(stream, value);
+    return;
+  }
+
+  yardl::binary::ReadString(stream, value.label);
+  yardl::binary::ReadVector<test_model::TreeNode, test_model::binary::ReadTreeNode>(stream, value.children);
+}
+
+[[maybe_unused]] void WriteLinkedListNode(yardl::binary::CodedOutputStream& stream, test_model::LinkedListNode const& value) {
+  if constexpr (yardl::binary::IsTriviallySerializable<test_model::LinkedListNode>::value) {
+    yardl::binary::WriteTriviallySerializable(stream, value);
+    return;
+  }
+
+  yardl::binary::WriteInteger(stream, value.value);
+  yardl::binary::WriteOptional<yardl::Indirect<test_model::LinkedListNode>, yardl::binary::WriteIndirect<test_model::LinkedListNode, test_model::binary::WriteLinkedListNode>>(stream, value.next);
+}
+
+[[maybe_unused]] void ReadLinkedListNode(yardl::binary::CodedInputStream& stream, test_model::LinkedListNode& value) {
+  if constexpr (yardl::binary::IsTriviallySerializable<test_model::LinkedListNode>::value) {
+    yardl::binary::ReadTriviallySerializable(stream, value);
+    return;
+  }
+
+  yardl::binary::ReadInteger(stream, value.value);
+  yardl::binary::ReadOptional<yardl::Indirect<test_model::LinkedListNode>, yardl::binary::ReadIndirect<test_model::LinkedListNode, test_model::binary::ReadLinkedListNode>>(stream, value.next);
+}
+
+[[maybe_unused]] void WriteExpression(yardl::binary::CodedOutputStream& stream, test_model::Expression const& value) {
+  if constexpr (yardl::binary::IsTriviallySerializable<test_model::Expression>::value) {
+    yardl::binary::WriteTriviallySerializable(stream, value);
+    return;
+  }
+
+  yardl::binary::WriteString(stream, value.name);
+  WriteUnion<int32_t, yardl::binary::WriteInteger, yardl::Indirect<test_model::Expression>, yardl::binary::WriteIndirect<test_model::Expression, test_model::binary::WriteExpression>>(stream, value.operand);
+  yardl::binary::WriteMap<std::string, yardl::Indirect<test_model::Expression>, yardl::binary::WriteString, yardl::binary::WriteIndirect<test_model::Expression, test_model::binary::WriteExpression>>(stream, value.arguments);
+}
+
+[[maybe_unused]] void ReadExpression(yardl::binary::CodedInputStream& stream, test_model::Expression& value) {
+  if constexpr (yardl::binary::IsTriviallySerializable<test_model::Expression>::value) {
+    yardl::binary::ReadTriviallySerializable(stream, value);
+    return;
+  }
+
+  yardl::binary::ReadString(stream, value.name);
+  ReadUnion<int32_t, yardl::binary::ReadInteger, yardl::Indirect<test_model::Expression>, yardl::binary::ReadIndirect<test_model::Expression, test_model::binary::ReadExpression>>(stream, value.operand);
+  yardl::binary::ReadMap<std::string, yardl::Indirect<test_model::Expression>, yardl::binary::ReadString, yardl::binary::ReadIndirect<test_model::Expression, test_model::binary::ReadExpression>>(stream, value.arguments);
+}
+
+[[maybe_unused]] void WriteRecordWithHalfPrecision(yardl::binary::CodedOutputStream& stream, test_model::RecordWithHalfPrecision const& value) {
+  if constexpr (yardl::binary::IsTriviallySerializable<test_model::RecordWithHalfPrecision>::value) {
+    yardl::binary::WriteTriviallySerializable(stream, value);
+    return;
+  }
+
+  yardl::binary::WriteFloatingPoint(stream, value.half);
+  yardl::binary::WriteFloatingPoint(stream, value.brain);
+  yardl::binary::WriteVector<yardl::Float16, yardl::binary::WriteFloatingPoint>(stream, value.half_vector);
+  yardl::binary::WriteDynamicNDArray<yardl::BFloat16, yardl::binary::WriteFloatingPoint>(stream, value.brain_array);
+}
+
+[[maybe_unused]] void ReadRecordWithHalfPrecision(yardl::binary::CodedInputStream& stream, test_model::RecordWithHalfPrecision& value) {
+  if constexpr (yardl::binary::IsTriviallySerializable<test_model::RecordWithHalfPrecision>::value) {
+    yardl::binary::ReadTriviallySerializable(stream, value);
+    return;
+  }
+
+  yardl::binary::ReadFloatingPoint(stream, value.half);
+  yardl::binary::ReadFloatingPoint(stream, value.brain);
+  yardl::binary::ReadVector<yardl::Float16, yardl::binary::ReadFloatingPoint>(stream, value.half_vector);
+  yardl::binary::ReadDynamicNDArray<yardl::BFloat16, yardl::binary::ReadFloatingPoint>(stream, value.brain_array);
+}
+
 [[maybe_unused]] void WriteRecordWithOptionalVector(yardl::binary::CodedOutputStream& stream, test_model::RecordWithOptionalVector const& value) {
   if constexpr (yardl::binary::IsTriviallySerializable<test_model::RecordWithOptionalVector>::value) {
     yardl::binary::WriteTriviallySerializable(stream, value);
@@ -2786,6 +3177,116 @@ template<typename A, yardl::binary::Reader<A> ReadA, typename B, yardl::binary::
   yardl::binary::ReadMap<std::string, std::string, yardl::binary::ReadString, yardl::binary::ReadString>(stream, value.map_field);
 }
 
+[[maybe_unused]] void WriteRecordWithDefaults(yardl::binary::CodedOutputStream& stream, test_model::RecordWithDefaults const& value) {
+  if constexpr (yardl::binary::IsTriviallySerializable<test_model::RecordWithDefaults>::value) {
+    yardl::binary::WriteTriviallySerializable(stream, value);
+    return;
+  }
+
+  yardl::binary::WriteInteger(stream, value.int_field);
+  yardl::binary::WriteInteger(stream, value.int8_field);
+  yardl::binary::WriteInteger(stream, value.uint64_field);
+  yardl::binary::WriteFloatingPoint(stream, value.float32_field);
+  yardl::binary::WriteFloatingPoint(stream, value.float64_field);
+  yardl::binary::WriteFloatingPoint(stream, value.complexfloat64_field);
+  yardl::binary::WriteInteger(stream, value.bool_field);
+  yardl::binary::WriteString(stream, value.string_field);
+  test_model::binary::WriteFruits(stream, value.enum_field);
+  yardl::binary::WriteInteger(stream, value.no_default_field);
+}
+
+[[maybe_unused]] void ReadRecordWithDefaults(yardl::binary::CodedInputStream& stream, test_model::RecordWithDefaults& value) {
+  if constexpr (yardl::binary::IsTriviallySerializable<test_model::RecordWithDefaults>::value) {
+    yardl::binary::ReadTriviallySerializable(stream, value);
+    return;
+  }
+
+  yardl::binary::ReadInteger(stream, value.int_field);
+  yardl::binary::ReadInteger(stream, value.int8_field);
+  yardl::binary::ReadInteger(stream, value.uint64_field);
+  yardl::binary::ReadFloatingPoint(stream, value.float32_field);
+  yardl::binary::ReadFloatingPoint(stream, value.float64_field);
+  yardl::binary::ReadFloatingPoint(stream, value.complexfloat64_field);
+  yardl::binary::ReadInteger(stream, value.bool_field);
+  yardl::binary::ReadString(stream, value.string_field);
+  test_model::binary::ReadFruits(stream, value.enum_field);
+  yardl::binary::ReadInteger(stream, value.no_default_field);
+}
+
+[[maybe_unused]] void WriteRecordWithConstraints(yardl::binary::CodedOutputStream& stream, test_model::RecordWithConstraints const& value) {
+  if constexpr (yardl::binary::IsTriviallySerializable<test_model::RecordWithConstraints>::value) {
+    yardl::binary::WriteTriviallySerializable(stream, value);
+    return;
+  }
+
+  yardl::binary::WriteInteger(stream, value.size);
+  yardl::binary::WriteFloatingPoint(stream, value.gain);
+  yardl::binary::WriteString(stream, value.name);
+  yardl::binary::WriteOptional<int32_t, yardl::binary::WriteInteger>(stream, value.limit);
+  yardl::binary::WriteVector<int32_t, yardl::binary::WriteInteger>(stream, value.samples);
+}
+
+[[maybe_unused]] void ReadRecordWithConstraints(yardl::binary::CodedInputStream& stream, test_model::RecordWithConstraints& value) {
+  if constexpr (yardl::binary::IsTriviallySerializable<test_model::RecordWithConstraints>::value) {
+    yardl::binary::ReadTriviallySerializable(stream, value);
+    return;
+  }
+
+  yardl::binary::ReadInteger(stream, value.size);
+  yardl::binary::ReadFloatingPoint(stream, value.gain);
+  yardl::binary::ReadString(stream, value.name);
+  yardl::binary::ReadOptional<int32_t, yardl::binary::ReadInteger>(stream, value.limit);
+  yardl::binary::ReadVector<int32_t, yardl::binary::ReadInteger>(stream, value.samples);
+}
+
+[[maybe_unused]] void WriteRecordWithPatterns(yardl::binary::CodedOutputStream& stream, test_model::RecordWithPatterns const& value) {
+  if constexpr (yardl::binary::IsTriviallySerializable<test_model::RecordWithPatterns>::value) {
+    yardl::binary::WriteTriviallySerializable(stream, value);
+    return;
+  }
+
+  yardl::binary::WriteString(stream, value.alternation);
+  yardl::binary::WriteString(stream, value.word);
+  yardl::binary::WriteString(stream, value.code);
+  yardl::binary::WriteString(stream, value.escaped);
+  yardl::binary::WriteString(stream, value.capitalized);
+}
+
+[[maybe_unused]] void ReadRecordWithPatterns(yardl::binary::CodedInputStream& stream, test_model::RecordWithPatterns& value) {
+  if constexpr (yardl::binary::IsTriviallySerializable<test_model::RecordWithPatterns>::value) {
+    yardl::binary::ReadTriviallySerializable(stream, value);
+    return;
+  }
+
+  yardl::binary::ReadString(stream, value.alternation);
+  yardl::binary::ReadString(stream, value.word);
+  yardl::binary::ReadString(stream, value.code);
+  yardl::binary::ReadString(stream, value.escaped);
+  yardl::binary::ReadString(stream, value.capitalized);
+}
+
+[[maybe_unused]] void WriteRecordWithConstrainedRecords(yardl::binary::CodedOutputStream& stream, test_model::RecordWithConstrainedRecords const& value) {
+  if constexpr (yardl::binary::IsTriviallySerializable<test_model::RecordWithConstrainedRecords>::value) {
+    yardl::binary::WriteTriviallySerializable(stream, value);
+    return;
+  }
+
+  test_model::binary::WriteRecordWithConstraints(stream, value.single);
+  yardl::binary::WriteOptional<test_model::RecordWithConstraints, test_model::binary::WriteRecordWithConstraints>(stream, value.optional);
+  yardl::binary::WriteVector<test_model::RecordWithConstraints, test_model::binary::WriteRecordWithConstraints>(stream, value.vector);
+}
+
+[[maybe_unused]] void ReadRecordWithConstrainedRecords(yardl::binary::CodedInputStream& stream, test_model::RecordWithConstrainedRecords& value) {
+  if constexpr (yardl::binary::IsTriviallySerializable<test_model::RecordWithConstrainedRecords>::value) {
+    yardl::binary::ReadTriviallySerializable(stream, value);
+    return;
+  }
+
+  test_model::binary::ReadRecordWithConstraints(stream, value.single);
+  yardl::binary::ReadOptional<test_model::RecordWithConstraints, test_model::binary::ReadRecordWithConstraints>(stream, value.optional);
+  yardl::binary::ReadVector<test_model::RecordWithConstraints, test_model::binary::ReadRecordWithConstraints>(stream, value.vector);
+}
+
 template<typename T, yardl::binary::Writer<T> WriteT>
 [[maybe_unused]] void WriteGenericUnionWithRepeatedTypeParameters(yardl::binary::CodedOutputStream& stream, test_model::GenericUnionWithRepeatedTypeParameters<T> const& value) {
   if constexpr (yardl::binary::IsTriviallySerializable<test_model::GenericUnionWithRepeatedTypeParameters<T>>::value) {
@@ -2924,9 +3425,179 @@ template<typename U, yardl::binary::Reader<U> ReadU, typename V, yardl::binary::
   yardl::binary::ReadOptional<yardl::Date, yardl::binary::ReadDate>(stream, value.date_field);
 }
 
-} // namespace
-
-void BenchmarkFloat256x256Writer::WriteFloat256x256Impl(yardl::FixedNDArray<float, 256, 256> const& value) {
+[[maybe_unused]] void WriteRecordWithConstants(yardl::binary::CodedOutputStream& stream, test_model::RecordWithConstants const& value) {
+  if constexpr (yardl::binary::IsTriviallySerializable<test_model::RecordWithConstants>::value) {
+    yardl::binary::WriteTriviallySerializable(stream, value);
+    return;
+  }
+
+  yardl::binary::WriteArray<int32_t, yardl::binary::WriteInteger, 4>(stream, value.channels);
+  yardl::binary::WriteFixedNDArray<float, yardl::binary::WriteFloatingPoint, 4, 8>(stream, value.samples);
+  yardl::binary::WriteFloatingPoint(stream, value.gain);
+}
+
+[[maybe_unused]] void ReadRecordWithConstants(yardl::binary::CodedInputStream& stream, test_model::RecordWithConstants& value) {
+  if constexpr (yardl::binary::IsTriviallySerializable<test_model::RecordWithConstants>::value) {
+    yardl::binary::ReadTriviallySerializable(stream, value);
+    return;
+  }
+
+  yardl::binary::ReadArray<int32_t, yardl::binary::ReadInteger, 4>(stream, value.channels);
+  yardl::binary::ReadFixedNDArray<float, yardl::binary::ReadFloatingPoint, 4, 8>(stream, value.samples);
+  yardl::binary::ReadFloatingPoint(stream, value.gain);
+}
+
+[[maybe_unused]] void WriteRecordHeader(yardl::binary::CodedOutputStream& stream, test_model::RecordHeader const& value) {
+  if constexpr (yardl::binary::IsTriviallySerializable<test_model::RecordHeader>::value) {
+    yardl::binary::WriteTriviallySerializable(stream, value);
+    return;
+  }
+
+  yardl::binary::WriteInteger(stream, value.version);
+  yardl::binary::WriteString(stream, value.name);
+}
+
+[[maybe_unused]] void ReadRecordHeader(yardl::binary::CodedInputStream& stream, test_model::RecordHeader& value) {
+  if constexpr (yardl::binary::IsTriviallySerializable<test_model::RecordHeader>::value) {
+    yardl::binary::ReadTriviallySerializable(stream, value);
+    return;
+  }
+
+  yardl::binary::ReadInteger(stream, value.version);
+  yardl::binary::ReadString(stream, value.name);
+}
+
+template<typename T, yardl::binary::Writer<T> WriteT>
+[[maybe_unused]] void WriteLabeledHeader(yardl::binary::CodedOutputStream& stream, test_model::LabeledHeader<T> const& value) {
+  if constexpr (yardl::binary::IsTriviallySerializable<test_model::LabeledHeader<T>>::value) {
+    yardl::binary::WriteTriviallySerializable(stream, value);
+    return;
+  }
+
+  WriteT(stream, value.label);
+}
+
+template<typename T, yardl::binary::Reader<T> ReadT>
+[[maybe_unused]] void ReadLabeledHeader(yardl::binary::CodedInputStream& stream, test_model::LabeledHeader<T>& value) {
+  if constexpr (yardl::binary::IsTriviallySerializable<test_model::LabeledHeader<T>>::value) {
+    yardl::binary::ReadTriviallySerializable(stream, value);
+    return;
+  }
+
+  ReadT(stream, value.label);
+}
+
+[[maybe_unused]] void WriteRecordWithBase(yardl::binary::CodedOutputStream& stream, test_model::RecordWithBase const& value) {
+  if constexpr (yardl::binary::IsTriviallySerializable<test_model::RecordWithBase>::value) {
+    yardl::binary::WriteTriviallySerializable(stream, value);
+    return;
+  }
+
+  yardl::binary::WriteInteger(stream, value.version);
+  yardl::binary::WriteString(stream, value.name);
+  yardl::binary::WriteVector<float, yardl::binary::WriteFloatingPoint>(stream, value.data);
+}
+
+[[maybe_unused]] void ReadRecordWithBase(yardl::binary::CodedInputStream& stream, test_model::RecordWithBase& value) {
+  if constexpr (yardl::binary::IsTriviallySerializable<test_model::RecordWithBase>::value) {
+    yardl::binary::ReadTriviallySerializable(stream, value);
+    return;
+  }
+
+  yardl::binary::ReadInteger(stream, value.version);
+  yardl::binary::ReadString(stream, value.name);
+  yardl::binary::ReadVector<float, yardl::binary::ReadFloatingPoint>(stream, value.data);
+}
+
+[[maybe_unused]] void WriteRecordWithBases(yardl::binary::CodedOutputStream& stream, test_model::RecordWithBases const& value) {
+  if constexpr (yardl::binary::IsTriviallySerializable<test_model::RecordWithBases>::value) {
+    yardl::binary::WriteTriviallySerializable(stream, value);
+    return;
+  }
+
+  yardl::binary::WriteInteger(stream, value.version);
+  yardl::binary::WriteString(stream, value.name);
+  yardl::binary::WriteString(stream, value.label);
+  yardl::binary::WriteInteger(stream, value.value);
+}
+
+[[maybe_unused]] void ReadRecordWithBases(yardl::binary::CodedInputStream& stream, test_model::RecordWithBases& value) {
+  if constexpr (yardl::binary::IsTriviallySerializable<test_model::RecordWithBases>::value) {
+    yardl::binary::ReadTriviallySerializable(stream, value);
+    return;
+  }
+
+  yardl::binary::ReadInteger(stream, value.version);
+  yardl::binary::ReadString(stream, value.name);
+  yardl::binary::ReadString(stream, value.label);
+  yardl::binary::ReadInteger(stream, value.value);
+}
+
+[[maybe_unused]] void WriteRecordWithAnnotations(yardl::binary::CodedOutputStream& stream, test_model::RecordWithAnnotations const& value) {
+  if constexpr (yardl::binary::IsTriviallySerializable<test_model::RecordWithAnnotations>::value) {
+    yardl::binary::WriteTriviallySerializable(stream, value);
+    return;
+  }
+
+  yardl::binary::WriteFloatingPoint(stream, value.thickness);
+  yardl::binary::WriteFloatingPoint(stream, value.spacing);
+  yardl::binary::WriteString(stream, value.label);
+}
+
+[[maybe_unused]] void ReadRecordWithAnnotations(yardl::binary::CodedInputStream& stream, test_model::RecordWithAnnotations& value) {
+  if constexpr (yardl::binary::IsTriviallySerializable<test_model::RecordWithAnnotations>::value) {
+    yardl::binary::ReadTriviallySerializable(stream, value);
+    return;
+  }
+
+  yardl::binary::ReadFloatingPoint(stream, value.thickness);
+  yardl::binary::ReadFloatingPoint(stream, value.spacing);
+  yardl::binary::ReadString(stream, value.label);
+}
+
+[[maybe_unused]] void WriteMillimeters(yardl::binary::CodedOutputStream& stream, test_model::Millimeters const& value) {
+  if constexpr (yardl::binary::IsTriviallySerializable<test_model::Millimeters>::value) {
+    yardl::binary::WriteTriviallySerializable(stream, value);
+    return;
+  }
+
+  yardl::binary::WriteFloatingPoint(stream, value);
+}
+
+[[maybe_unused]] void ReadMillimeters(yardl::binary::CodedInputStream& stream, test_model::Millimeters& value) {
+  if constexpr (yardl::binary::IsTriviallySerializable<test_model::Millimeters>::value) {
+    yardl::binary::ReadTriviallySerializable(stream, value);
+    return;
+  }
+
+  yardl::binary::ReadFloatingPoint(stream, value);
+}
+
+[[maybe_unused]] void WriteRecordWithUnits(yardl::binary::CodedOutputStream& stream, test_model::RecordWithUnits const& value) {
+  if constexpr (yardl::binary::IsTriviallySerializable<test_model::RecordWithUnits>::value) {
+    yardl::binary::WriteTriviallySerializable(stream, value);
+    return;
+  }
+
+  yardl::binary::WriteFloatingPoint(stream, value.length);
+  test_model::binary::WriteMillimeters(stream, value.width);
+  yardl::binary::WriteVector<double, yardl::binary::WriteFloatingPoint>(stream, value.durations);
+}
+
+[[maybe_unused]] void ReadRecordWithUnits(yardl::binary::CodedInputStream& stream, test_model::RecordWithUnits& value) {
+  if constexpr (yardl::binary::IsTriviallySerializable<test_model::RecordWithUnits>::value) {
+    yardl::binary::ReadTriviallySerializable(stream, value);
+    return;
+  }
+
+  yardl::binary::ReadFloatingPoint(stream, value.length);
+  test_model::binary::ReadMillimeters(stream, value.width);
+  yardl::binary::ReadVector<double, yardl::binary::ReadFloatingPoint>(stream, value.durations);
+}
+
+} // namespace
+
+void BenchmarkFloat256x256Writer::WriteFloat256x256Impl(yardl::FixedNDArray<float, 256, 256> const& value) {
   yardl::binary::WriteBlock<yardl::FixedNDArray<float, 256, 256>, yardl::binary::WriteFixedNDArray<float, yardl::binary::WriteFloatingPoint, 256, 256>>(stream_, value);
 }
 
@@ -3334,6 +4005,341 @@ void StringsReader::CloseImpl() {
   }
 }
 
+void ProtocolWithBytesWriter::WriteSingleBytesImpl(std::vector<std::byte> const& value) {
+  yardl::binary::WriteBytes(stream_, value);
+}
+
+void ProtocolWithBytesWriter::WriteRecWithBytesImpl(test_model::RecordWithBytes const& value) {
+  test_model::binary::WriteRecordWithBytes(stream_, value);
+}
+
+void ProtocolWithBytesWriter::Flush() {
+  stream_.Flush();
+}
+
+void ProtocolWithBytesWriter::CloseImpl() {
+  stream_.Flush();
+}
+
+void ProtocolWithBytesReader::ReadSingleBytesImpl(std::vector<std::byte>& value) {
+  yardl::binary::ReadBytes(stream_, value);
+}
+
+void ProtocolWithBytesReader::ReadRecWithBytesImpl(test_model::RecordWithBytes& value) {
+  test_model::binary::ReadRecordWithBytes(stream_, value);
+}
+
+void ProtocolWithBytesReader::CloseImpl() {
+  if (!skip_completed_check_) {
+    stream_.VerifyFinished();
+  }
+}
+
+void ProtocolWithUuidsWriter::WriteSingleUuidImpl(yardl::Uuid const& value) {
+  yardl::binary::WriteUuid(stream_, value);
+}
+
+void ProtocolWithUuidsWriter::WriteRecWithUuidsImpl(test_model::RecordWithUuids const& value) {
+  test_model::binary::WriteRecordWithUuids(stream_, value);
+}
+
+void ProtocolWithUuidsWriter::Flush() {
+  stream_.Flush();
+}
+
+void ProtocolWithUuidsWriter::CloseImpl() {
+  stream_.Flush();
+}
+
+void ProtocolWithUuidsReader::ReadSingleUuidImpl(yardl::Uuid& value) {
+  yardl::binary::ReadUuid(stream_, value);
+}
+
+void ProtocolWithUuidsReader::ReadRecWithUuidsImpl(test_model::RecordWithUuids& value) {
+  test_model::binary::ReadRecordWithUuids(stream_, value);
+}
+
+void ProtocolWithUuidsReader::CloseImpl() {
+  if (!skip_completed_check_) {
+    stream_.VerifyFinished();
+  }
+}
+
+void ProtocolWithDurationsWriter::WriteSingleDurationImpl(yardl::Duration const& value) {
+  yardl::binary::WriteDuration(stream_, value);
+}
+
+void ProtocolWithDurationsWriter::WriteRecWithDurationsImpl(test_model::RecordWithDurations const& value) {
+  test_model::binary::WriteRecordWithDurations(stream_, value);
+}
+
+void ProtocolWithDurationsWriter::Flush() {
+  stream_.Flush();
+}
+
+void ProtocolWithDurationsWriter::CloseImpl() {
+  stream_.Flush();
+}
+
+void ProtocolWithDurationsReader::ReadSingleDurationImpl(yardl::Duration& value) {
+  yardl::binary::ReadDuration(stream_, value);
+}
+
+void ProtocolWithDurationsReader::ReadRecWithDurationsImpl(test_model::RecordWithDurations& value) {
+  test_model::binary::ReadRecordWithDurations(stream_, value);
+}
+
+void ProtocolWithDurationsReader::CloseImpl() {
+  if (!skip_completed_check_) {
+    stream_.VerifyFinished();
+  }
+}
+
+void ProtocolWithRecursiveRecordsWriter::WriteTreeImpl(test_model::TreeNode const& value) {
+  test_model::binary::WriteTreeNode(stream_, value);
+}
+
+void ProtocolWithRecursiveRecordsWriter::WriteListImpl(test_model::LinkedListNode const& value) {
+  test_model::binary::WriteLinkedListNode(stream_, value);
+}
+
+void ProtocolWithRecursiveRecordsWriter::WriteExpressionsImpl(test_model::Expression const& value) {
+  yardl::binary::WriteBlock<test_model::Expression, test_model::binary::WriteExpression>(stream_, value);
+}
+
+void ProtocolWithRecursiveRecordsWriter::WriteExpressionsImpl(std::vector<test_model::Expression> const& values) {
+  if (!values.empty()) {
+    yardl::binary::WriteVector<test_model::Expression, test_model::binary::WriteExpression>(stream_, values);
+  }
+}
+
+void ProtocolWithRecursiveRecordsWriter::EndExpressionsImpl() {
+  yardl::binary::WriteInteger(stream_, 0U);
+}
+
+void ProtocolWithRecursiveRecordsWriter::Flush() {
+  stream_.Flush();
+}
+
+void ProtocolWithRecursiveRecordsWriter::CloseImpl() {
+  stream_.Flush();
+}
+
+void ProtocolWithRecursiveRecordsReader::ReadTreeImpl(test_model::TreeNode& value) {
+  test_model::binary::ReadTreeNode(stream_, value);
+}
+
+void ProtocolWithRecursiveRecordsReader::ReadListImpl(test_model::LinkedListNode& value) {
+  test_model::binary::ReadLinkedListNode(stream_, value);
+}
+
+bool ProtocolWithRecursiveRecordsReader::ReadExpressionsImpl(test_model::Expression& value) {
+  bool read_block_successful = false;
+  read_block_successful = yardl::binary::ReadBlock<test_model::Expression, test_model::binary::ReadExpression>(stream_, current_block_remaining_, value);
+  return read_block_successful;
+}
+
+bool ProtocolWithRecursiveRecordsReader::ReadExpressionsImpl(std::vector<test_model::Expression>& values) {
+  yardl::binary::ReadBlocksIntoVector<test_model::Expression, test_model::binary::ReadExpression>(stream_, current_block_remaining_, values);
+  return current_block_remaining_ != 0;
+}
+
+void ProtocolWithRecursiveRecordsReader::CloseImpl() {
+  if (!skip_completed_check_) {
+    stream_.VerifyFinished();
+  }
+}
+
+void CalibrationWriter::WriteGainImpl(float const& value) {
+  yardl::binary::WriteFloatingPoint(stream_, value);
+}
+
+void CalibrationWriter::WriteSamplesImpl(double const& value) {
+  yardl::binary::WriteBlock<double, yardl::binary::WriteFloatingPoint>(stream_, value);
+}
+
+void CalibrationWriter::WriteSamplesImpl(std::vector<double> const& values) {
+  if (!values.empty()) {
+    yardl::binary::WriteVector<double, yardl::binary::WriteFloatingPoint>(stream_, values);
+  }
+}
+
+void CalibrationWriter::EndSamplesImpl() {
+  yardl::binary::WriteInteger(stream_, 0U);
+}
+
+void CalibrationWriter::Flush() {
+  stream_.Flush();
+}
+
+void CalibrationWriter::CloseImpl() {
+  stream_.Flush();
+}
+
+void CalibrationReader::ReadGainImpl(float& value) {
+  yardl::binary::ReadFloatingPoint(stream_, value);
+}
+
+bool CalibrationReader::ReadSamplesImpl(double& value) {
+  bool read_block_successful = false;
+  read_block_successful = yardl::binary::ReadBlock<double, yardl::binary::ReadFloatingPoint>(stream_, current_block_remaining_, value);
+  return read_block_successful;
+}
+
+bool CalibrationReader::ReadSamplesImpl(std::vector<double>& values) {
+  yardl::binary::ReadBlocksIntoVector<double, yardl::binary::ReadFloatingPoint>(stream_, current_block_remaining_, values);
+  return current_block_remaining_ != 0;
+}
+
+void CalibrationReader::CloseImpl() {
+  if (!skip_completed_check_) {
+    stream_.VerifyFinished();
+  }
+}
+
+void ProtocolWithSubProtocolsWriter::WriteHeaderImpl(std::string const& value) {
+  yardl::binary::WriteString(stream_, value);
+}
+
+void ProtocolWithSubProtocolsWriter::WriteCalibrationGainImpl(float const& value) {
+  yardl::binary::WriteFloatingPoint(stream_, value);
+}
+
+void ProtocolWithSubProtocolsWriter::WriteCalibrationSamplesImpl(double const& value) {
+  yardl::binary::WriteBlock<double, yardl::binary::WriteFloatingPoint>(stream_, value);
+}
+
+void ProtocolWithSubProtocolsWriter::WriteCalibrationSamplesImpl(std::vector<double> const& values) {
+  if (!values.empty()) {
+    yardl::binary::WriteVector<double, yardl::binary::WriteFloatingPoint>(stream_, values);
+  }
+}
+
+void ProtocolWithSubProtocolsWriter::EndCalibrationSamplesImpl() {
+  yardl::binary::WriteInteger(stream_, 0U);
+}
+
+void ProtocolWithSubProtocolsWriter::WriteDataImpl(int32_t const& value) {
+  yardl::binary::WriteBlock<int32_t, yardl::binary::WriteInteger>(stream_, value);
+}
+
+void ProtocolWithSubProtocolsWriter::WriteDataImpl(std::vector<int32_t> const& values) {
+  if (!values.empty()) {
+    yardl::binary::WriteVector<int32_t, yardl::binary::WriteInteger>(stream_, values);
+  }
+}
+
+void ProtocolWithSubProtocolsWriter::EndDataImpl() {
+  yardl::binary::WriteInteger(stream_, 0U);
+}
+
+void ProtocolWithSubProtocolsWriter::WriteRecalibrationGainImpl(float const& value) {
+  yardl::binary::WriteFloatingPoint(stream_, value);
+}
+
+void ProtocolWithSubProtocolsWriter::WriteRecalibrationSamplesImpl(double const& value) {
+  yardl::binary::WriteBlock<double, yardl::binary::WriteFloatingPoint>(stream_, value);
+}
+
+void ProtocolWithSubProtocolsWriter::WriteRecalibrationSamplesImpl(std::vector<double> const& values) {
+  if (!values.empty()) {
+    yardl::binary::WriteVector<double, yardl::binary::WriteFloatingPoint>(stream_, values);
+  }
+}
+
+void ProtocolWithSubProtocolsWriter::EndRecalibrationSamplesImpl() {
+  yardl::binary::WriteInteger(stream_, 0U);
+}
+
+void ProtocolWithSubProtocolsWriter::Flush() {
+  stream_.Flush();
+}
+
+void ProtocolWithSubProtocolsWriter::CloseImpl() {
+  stream_.Flush();
+}
+
+void ProtocolWithSubProtocolsReader::ReadHeaderImpl(std::string& value) {
+  yardl::binary::ReadString(stream_, value);
+}
+
+void ProtocolWithSubProtocolsReader::ReadCalibrationGainImpl(float& value) {
+  yardl::binary::ReadFloatingPoint(stream_, value);
+}
+
+bool ProtocolWithSubProtocolsReader::ReadCalibrationSamplesImpl(double& value) {
+  bool read_block_successful = false;
+  read_block_successful = yardl::binary::ReadBlock<double, yardl::binary::ReadFloatingPoint>(stream_, current_block_remaining_, value);
+  return read_block_successful;
+}
+
+bool ProtocolWithSubProtocolsReader::ReadCalibrationSamplesImpl(std::vector<double>& values) {
+  yardl::binary::ReadBlocksIntoVector<double, yardl::binary::ReadFloatingPoint>(stream_, current_block_remaining_, values);
+  return current_block_remaining_ != 0;
+}
+
+bool ProtocolWithSubProtocolsReader::ReadDataImpl(int32_t& value) {
+  bool read_block_successful = false;
+  read_block_successful = yardl::binary::ReadBlock<int32_t, yardl::binary::ReadInteger>(stream_, current_block_remaining_, value);
+  return read_block_successful;
+}
+
+bool ProtocolWithSubProtocolsReader::ReadDataImpl(std::vector<int32_t>& values) {
+  yardl::binary::ReadBlocksIntoVector<int32_t, yardl::binary::ReadInteger>(stream_, current_block_remaining_, values);
+  return current_block_remaining_ != 0;
+}
+
+void ProtocolWithSubProtocolsReader::ReadRecalibrationGainImpl(float& value) {
+  yardl::binary::ReadFloatingPoint(stream_, value);
+}
+
+bool ProtocolWithSubProtocolsReader::ReadRecalibrationSamplesImpl(double& value) {
+  bool read_block_successful = false;
+  read_block_successful = yardl::binary::ReadBlock<double, yardl::binary::ReadFloatingPoint>(stream_, current_block_remaining_, value);
+  return read_block_successful;
+}
+
+bool ProtocolWithSubProtocolsReader::ReadRecalibrationSamplesImpl(std::vector<double>& values) {
+  yardl::binary::ReadBlocksIntoVector<double, yardl::binary::ReadFloatingPoint>(stream_, current_block_remaining_, values);
+  return current_block_remaining_ != 0;
+}
+
+void ProtocolWithSubProtocolsReader::CloseImpl() {
+  if (!skip_completed_check_) {
+    stream_.VerifyFinished();
+  }
+}
+
+void ProtocolWithHalfPrecisionWriter::WriteHalvesImpl(std::vector<yardl::Float16> const& value) {
+  yardl::binary::WriteVector<yardl::Float16, yardl::binary::WriteFloatingPoint>(stream_, value);
+}
+
+void ProtocolWithHalfPrecisionWriter::WriteRecWithHalvesImpl(test_model::RecordWithHalfPrecision const& value) {
+  test_model::binary::WriteRecordWithHalfPrecision(stream_, value);
+}
+
+void ProtocolWithHalfPrecisionWriter::Flush() {
+  stream_.Flush();
+}
+
+void ProtocolWithHalfPrecisionWriter::CloseImpl() {
+  stream_.Flush();
+}
+
+void ProtocolWithHalfPrecisionReader::ReadHalvesImpl(std::vector<yardl::Float16>& value) {
+  yardl::binary::ReadVector<yardl::Float16, yardl::binary::ReadFloatingPoint>(stream_, value);
+}
+
+void ProtocolWithHalfPrecisionReader::ReadRecWithHalvesImpl(test_model::RecordWithHalfPrecision& value) {
+  test_model::binary::ReadRecordWithHalfPrecision(stream_, value);
+}
+
+void ProtocolWithHalfPrecisionReader::CloseImpl() {
+  if (!skip_completed_check_) {
+    stream_.VerifyFinished();
+  }
+}
+
 void OptionalVectorsWriter::WriteRecordWithOptionalVectorImpl(test_model::RecordWithOptionalVector const& value) {
   test_model::binary::WriteRecordWithOptionalVector(stream_, value);
 }
@@ -4445,6 +5451,116 @@ void AdvancedGenericsReader::CloseImpl() {
   }
 }
 
+void FloatImageStreamWriter::WriteHeaderImpl(test_model::GenericRecord<int32_t, float> const& value) {
+  test_model::binary::WriteGenericRecord<int32_t, yardl::binary::WriteInteger, float, yardl::binary::WriteFloatingPoint>(stream_, value);
+}
+
+void FloatImageStreamWriter::WriteImagesImpl(test_model::Image<float> const& value) {
+  yardl::binary::WriteBlock<test_model::Image<float>, test_model::binary::WriteImage<float, yardl::binary::WriteFloatingPoint>>(stream_, value);
+}
+
+void FloatImageStreamWriter::WriteImagesImpl(std::vector<test_model::Image<float>> const& values) {
+  if (!values.empty()) {
+    yardl::binary::WriteVector<test_model::Image<float>, test_model::binary::WriteImage<float, yardl::binary::WriteFloatingPoint>>(stream_, values);
+  }
+}
+
+void FloatImageStreamWriter::EndImagesImpl() {
+  yardl::binary::WriteInteger(stream_, 0U);
+}
+
+void FloatImageStreamWriter::WriteBackgroundImpl(std::variant<float, std::string> const& value) {
+  WriteUnion<float, yardl::binary::WriteFloatingPoint, std::string, yardl::binary::WriteString>(stream_, value);
+}
+
+void FloatImageStreamWriter::Flush() {
+  stream_.Flush();
+}
+
+void FloatImageStreamWriter::CloseImpl() {
+  stream_.Flush();
+}
+
+void FloatImageStreamReader::ReadHeaderImpl(test_model::GenericRecord<int32_t, float>& value) {
+  test_model::binary::ReadGenericRecord<int32_t, yardl::binary::ReadInteger, float, yardl::binary::ReadFloatingPoint>(stream_, value);
+}
+
+bool FloatImageStreamReader::ReadImagesImpl(test_model::Image<float>& value) {
+  bool read_block_successful = false;
+  read_block_successful = yardl::binary::ReadBlock<test_model::Image<float>, test_model::binary::ReadImage<float, yardl::binary::ReadFloatingPoint>>(stream_, current_block_remaining_, value);
+  return read_block_successful;
+}
+
+bool FloatImageStreamReader::ReadImagesImpl(std::vector<test_model::Image<float>>& values) {
+  yardl::binary::ReadBlocksIntoVector<test_model::Image<float>, test_model::binary::ReadImage<float, yardl::binary::ReadFloatingPoint>>(stream_, current_block_remaining_, values);
+  return current_block_remaining_ != 0;
+}
+
+void FloatImageStreamReader::ReadBackgroundImpl(std::variant<float, std::string>& value) {
+  ReadUnion<float, yardl::binary::ReadFloatingPoint, std::string, yardl::binary::ReadString>(stream_, value);
+}
+
+void FloatImageStreamReader::CloseImpl() {
+  if (!skip_completed_check_) {
+    stream_.VerifyFinished();
+  }
+}
+
+void ComplexImageStreamWriter::WriteHeaderImpl(test_model::GenericRecord<int32_t, std::complex<float>> const& value) {
+  test_model::binary::WriteGenericRecord<int32_t, yardl::binary::WriteInteger, std::complex<float>, yardl::binary::WriteFloatingPoint>(stream_, value);
+}
+
+void ComplexImageStreamWriter::WriteImagesImpl(test_model::Image<std::complex<float>> const& value) {
+  yardl::binary::WriteBlock<test_model::Image<std::complex<float>>, test_model::binary::WriteImage<std::complex<float>, yardl::binary::WriteFloatingPoint>>(stream_, value);
+}
+
+void ComplexImageStreamWriter::WriteImagesImpl(std::vector<test_model::Image<std::complex<float>>> const& values) {
+  if (!values.empty()) {
+    yardl::binary::WriteVector<test_model::Image<std::complex<float>>, test_model::binary::WriteImage<std::complex<float>, yardl::binary::WriteFloatingPoint>>(stream_, values);
+  }
+}
+
+void ComplexImageStreamWriter::EndImagesImpl() {
+  yardl::binary::WriteInteger(stream_, 0U);
+}
+
+void ComplexImageStreamWriter::WriteBackgroundImpl(std::variant<std::complex<float>, std::string> const& value) {
+  WriteUnion<std::complex<float>, yardl::binary::WriteFloatingPoint, std::string, yardl::binary::WriteString>(stream_, value);
+}
+
+void ComplexImageStreamWriter::Flush() {
+  stream_.Flush();
+}
+
+void ComplexImageStreamWriter::CloseImpl() {
+  stream_.Flush();
+}
+
+void ComplexImageStreamReader::ReadHeaderImpl(test_model::GenericRecord<int32_t, std::complex<float>>& value) {
+  test_model::binary::ReadGenericRecord<int32_t, yardl::binary::ReadInteger, std::complex<float>, yardl::binary::ReadFloatingPoint>(stream_, value);
+}
+
+bool ComplexImageStreamReader::ReadImagesImpl(test_model::Image<std::complex<float>>& value) {
+  bool read_block_successful = false;
+  read_block_successful = yardl::binary::ReadBlock<test_model::Image<std::complex<float>>, test_model::binary::ReadImage<std::complex<float>, yardl::binary::ReadFloatingPoint>>(stream_, current_block_remaining_, value);
+  return read_block_successful;
+}
+
+bool ComplexImageStreamReader::ReadImagesImpl(std::vector<test_model::Image<std::complex<float>>>& values) {
+  yardl::binary::ReadBlocksIntoVector<test_model::Image<std::complex<float>>, test_model::binary::ReadImage<std::complex<float>, yardl::binary::ReadFloatingPoint>>(stream_, current_block_remaining_, values);
+  return current_block_remaining_ != 0;
+}
+
+void ComplexImageStreamReader::ReadBackgroundImpl(std::variant<std::complex<float>, std::string>& value) {
+  ReadUnion<std::complex<float>, yardl::binary::ReadFloatingPoint, std::string, yardl::binary::ReadString>(stream_, value);
+}
+
+void ComplexImageStreamReader::CloseImpl() {
+  if (!skip_completed_check_) {
+    stream_.VerifyFinished();
+  }
+}
+
 void AliasesWriter::WriteAliasedStringImpl(test_model::AliasedString const& value) {
   test_model::binary::WriteAliasedString(stream_, value);
 }
@@ -4650,6 +5766,53 @@ void ProtocolWithComputedFieldsReader::CloseImpl() {
   }
 }
 
+void ProtocolWithConstraintsWriter::WriteRecordImpl(test_model::RecordWithConstraints const& value) {
+  test_model::binary::WriteRecordWithConstraints(stream_, value);
+}
+
+void ProtocolWithConstraintsWriter::WriteRecordsImpl(test_model::RecordWithConstrainedRecords const& value) {
+  yardl::binary::WriteBlock<test_model::RecordWithConstrainedRecords, test_model::binary::WriteRecordWithConstrainedRecords>(stream_, value);
+}
+
+void ProtocolWithConstraintsWriter::WriteRecordsImpl(std::vector<test_model::RecordWithConstrainedRecords> const& values) {
+  if (!values.empty()) {
+    yardl::binary::WriteVector<test_model::RecordWithConstrainedRecords, test_model::binary::WriteRecordWithConstrainedRecords>(stream_, values);
+  }
+}
+
+void ProtocolWithConstraintsWriter::EndRecordsImpl() {
+  yardl::binary::WriteInteger(stream_, 0U);
+}
+
+void ProtocolWithConstraintsWriter::Flush() {
+  stream_.Flush();
+}
+
+void ProtocolWithConstraintsWriter::CloseImpl() {
+  stream_.Flush();
+}
+
+void ProtocolWithConstraintsReader::ReadRecordImpl(test_model::RecordWithConstraints& value) {
+  test_model::binary::ReadRecordWithConstraints(stream_, value);
+}
+
+bool ProtocolWithConstraintsReader::ReadRecordsImpl(test_model::RecordWithConstrainedRecords& value) {
+  bool read_block_successful = false;
+  read_block_successful = yardl::binary::ReadBlock<test_model::RecordWithConstrainedRecords, test_model::binary::ReadRecordWithConstrainedRecords>(stream_, current_block_remaining_, value);
+  return read_block_successful;
+}
+
+bool ProtocolWithConstraintsReader::ReadRecordsImpl(std::vector<test_model::RecordWithConstrainedRecords>& values) {
+  yardl::binary::ReadBlocksIntoVector<test_model::RecordWithConstrainedRecords, test_model::binary::ReadRecordWithConstrainedRecords>(stream_, current_block_remaining_, values);
+  return current_block_remaining_ != 0;
+}
+
+void ProtocolWithConstraintsReader::CloseImpl() {
+  if (!skip_completed_check_) {
+    stream_.VerifyFinished();
+  }
+}
+
 void ProtocolWithKeywordStepsWriter::WriteIntImpl(test_model::RecordWithKeywordFields const& value) {
   yardl::binary::WriteBlock<test_model::RecordWithKeywordFields, test_model::binary::WriteRecordWithKeywordFields>(stream_, value);
 }
@@ -460,6 +460,308 @@ class StringsReader : public test_model::StringsReaderBase, yardl::binary::Binar
   Version version_;
 };
 
+// Binary writer for the ProtocolWithBytes protocol.
+class ProtocolWithBytesWriter : public test_model::ProtocolWithBytesWriterBase, yardl::binary::BinaryWriter {
+  public:
+  ProtocolWithBytesWriter(std::ostream& stream, Version version = Version::Current)
+      : yardl::binary::BinaryWriter(stream, test_model::ProtocolWithBytesWriterBase::SchemaFromVersion(version)), version_(version) {}
+
+  ProtocolWithBytesWriter(std::string file_name, Version version = Version::Current)
+      : yardl::binary::BinaryWriter(file_name, test_model::ProtocolWithBytesWriterBase::SchemaFromVersion(version)), version_(version) {}
+
+  void Flush() override;
+
+  protected:
+  void WriteSingleBytesImpl(std::vector<std::byte> const& value) override;
+  void WriteRecWithBytesImpl(test_model::RecordWithBytes const& value) override;
+  void CloseImpl() override;
+
+  Version version_;
+};
+
+// Binary reader for the ProtocolWithBytes protocol.
+class ProtocolWithBytesReader : public test_model::ProtocolWithBytesReaderBase, yardl::binary::BinaryReader {
+  public:
+  ProtocolWithBytesReader(std::istream& stream, bool skip_completed_check=false)
+      : test_model::ProtocolWithBytesReaderBase(skip_completed_check), yardl::binary::BinaryReader(stream), version_(test_model::ProtocolWithBytesReaderBase::VersionFromSchema(schema_read_)) {}
+
+  ProtocolWithBytesReader(std::string file_name, bool skip_completed_check=false)
+      : test_model::ProtocolWithBytesReaderBase(skip_completed_check), yardl::binary::BinaryReader(file_name), version_(test_model::ProtocolWithBytesReaderBase::VersionFromSchema(schema_read_)) {}
+
+  Version GetVersion() { return version_; }
+
+  protected:
+  void ReadSingleBytesImpl(std::vector<std::byte>& value) override;
+  void ReadRecWithBytesImpl(test_model::RecordWithBytes& value) override;
+  void CloseImpl() override;
+
+  Version version_;
+};
+
+// Binary writer for the ProtocolWithUuids protocol.
+class ProtocolWithUuidsWriter : public test_model::ProtocolWithUuidsWriterBase, yardl::binary::BinaryWriter {
+  public:
+  ProtocolWithUuidsWriter(std::ostream& stream, Version version = Version::Current)
+      : yardl::binary::BinaryWriter(stream, test_model::ProtocolWithUuidsWriterBase::SchemaFromVersion(version)), version_(version) {}
+
+  ProtocolWithUuidsWriter(std::string file_name, Version version = Version::Current)
+      : yardl::binary::BinaryWriter(file_name, test_model::ProtocolWithUuidsWriterBase::SchemaFromVersion(version)), version_(version) {}
+
+  void Flush() override;
+
+  protected:
+  void WriteSingleUuidImpl(yardl::Uuid const& value) override;
+  void WriteRecWithUuidsImpl(test_model::RecordWithUuids const& value) override;
+  void CloseImpl() override;
+
+  Version version_;
+};
+
+// Binary reader for the ProtocolWithUuids protocol.
+class ProtocolWithUuidsReader : public test_model::ProtocolWithUuidsReaderBase, yardl::binary::BinaryReader {
+  public:
+  ProtocolWithUuidsReader(std::istream& stream, bool skip_completed_check=false)
+      : test_model::ProtocolWithUuidsReaderBase(skip_completed_check), yardl::binary::BinaryReader(stream), version_(test_model::ProtocolWithUuidsReaderBase::VersionFromSchema(schema_read_)) {}
+
+  ProtocolWithUuidsReader(std::string file_name, bool skip_completed_check=false)
+      : test_model::ProtocolWithUuidsReaderBase(skip_completed_check), yardl::binary::BinaryReader(file_name), version_(test_model::ProtocolWithUuidsReaderBase::VersionFromSchema(schema_read_)) {}
+
+  Version GetVersion() { return version_; }
+
+  protected:
+  void ReadSingleUuidImpl(yardl::Uuid& value) override;
+  void ReadRecWithUuidsImpl(test_model::RecordWithUuids& value) override;
+  void CloseImpl() override;
+
+  Version version_;
+};
+
+// Binary writer for the ProtocolWithDurations protocol.
+class ProtocolWithDurationsWriter : public test_model::ProtocolWithDurationsWriterBase, yardl::binary::BinaryWriter {
+  public:
+  ProtocolWithDurationsWriter(std::ostream& stream, Version version = Version::Current)
+      : yardl::binary::BinaryWriter(stream, test_model::ProtocolWithDurationsWriterBase::SchemaFromVersion(version)), version_(version) {}
+
+  ProtocolWithDurationsWriter(std::string file_name, Version version = Version::Current)
+      : yardl::binary::BinaryWriter(file_name, test_model::ProtocolWithDurationsWriterBase::SchemaFromVersion(version)), version_(version) {}
+
+  void Flush() override;
+
+  protected:
+  void WriteSingleDurationImpl(yardl::Duration const& value) override;
+  void WriteRecWithDurationsImpl(test_model::RecordWithDurations const& value) override;
+  void CloseImpl() override;
+
+  Version version_;
+};
+
+// Binary reader for the ProtocolWithDurations protocol.
+class ProtocolWithDurationsReader : public test_model::ProtocolWithDurationsReaderBase, yardl::binary::BinaryReader {
+  public:
+  ProtocolWithDurationsReader(std::istream& stream, bool skip_completed_check=false)
+      : test_model::ProtocolWithDurationsReaderBase(skip_completed_check), yardl::binary::BinaryReader(stream), version_(test_model::ProtocolWithDurationsReaderBase::VersionFromSchema(schema_read_)) {}
+
+  ProtocolWithDurationsReader(std::string file_name, bool skip_completed_check=false)
+      : test_model::ProtocolWithDurationsReaderBase(skip_completed_check), yardl::binary::BinaryReader(file_name), version_(test_model::ProtocolWithDurationsReaderBase::VersionFromSchema(schema_read_)) {}
+
+  Version GetVersion() { return version_; }
+
+  protected:
+  void ReadSingleDurationImpl(yardl::Duration& value) override;
+  void ReadRecWithDurationsImpl(test_model::RecordWithDurations& value) override;
+  void CloseImpl() override;
+
+  Version version_;
+};
+
+// Binary writer for the ProtocolWithRecursiveRecords protocol.
+class ProtocolWithRecursiveRecordsWriter : public test_model::ProtocolWithRecursiveRecordsWriterBase, yardl::binary::BinaryWriter {
+  public:
+  ProtocolWithRecursiveRecordsWriter(std::ostream& stream, Version version = Version::Current)
+      : yardl::binary::BinaryWriter(stream, test_model::ProtocolWithRecursiveRecordsWriterBase::SchemaFromVersion(version)), version_(version) {}
+
+  ProtocolWithRecursiveRecordsWriter(std::string file_name, Version version = Version::Current)
+      : yardl::binary::BinaryWriter(file_name, test_model::ProtocolWithRecursiveRecordsWriterBase::SchemaFromVersion(version)), version_(version) {}
+
+  void Flush() override;
+
+  protected:
+  void WriteTreeImpl(test_model::TreeNode const& value) override;
+  void WriteListImpl(test_model::LinkedListNode const& value) override;
+  void WriteExpressionsImpl(test_model::Expression const& value) override;
+  void WriteExpressionsImpl(std::vector<test_model::Expression> const& values) override;
+  void EndExpressionsImpl() override;
+  void CloseImpl() override;
+
+  Version version_;
+};
+
+// Binary reader for the ProtocolWithRecursiveRecords protocol.
+class ProtocolWithRecursiveRecordsReader : public test_model::ProtocolWithRecursiveRecordsReaderBase, yardl::binary::BinaryReader {
+  public:
+  ProtocolWithRecursiveRecordsReader(std::istream& stream, bool skip_completed_check=false)
+      : test_model::ProtocolWithRecursiveRecordsReaderBase(skip_completed_check), yardl::binary::BinaryReader(stream), version_(test_model::ProtocolWithRecursiveRecordsReaderBase::VersionFromSchema(schema_read_)) {}
+
+  ProtocolWithRecursiveRecordsReader(std::string file_name, bool skip_completed_check=false)
+      : test_model::ProtocolWithRecursiveRecordsReaderBase(skip_completed_check), yardl::binary::BinaryReader(file_name), version_(test_model::ProtocolWithRecursiveRecordsReaderBase::VersionFromSchema(schema_read_)) {}
+
+  Version GetVersion() { return version_; }
+
+  protected:
+  void ReadTreeImpl(test_model::TreeNode& value) override;
+  void ReadListImpl(test_model::LinkedListNode& value) override;
+  bool ReadExpressionsImpl(test_model::Expression& value) override;
+  bool ReadExpressionsImpl(std::vector<test_model::Expression>& values) override;
+  void CloseImpl() override;
+
+  Version version_;
+
+  private:
+  size_t current_block_remaining_ = 0;
+};
+
+// Binary writer for the Calibration protocol.
+// A calibration phase shared by protocols
+class CalibrationWriter : public test_model::CalibrationWriterBase, yardl::binary::BinaryWriter {
+  public:
+  CalibrationWriter(std::ostream& stream, Version version = Version::Current)
+      : yardl::binary::BinaryWriter(stream, test_model::CalibrationWriterBase::SchemaFromVersion(version)), version_(version) {}
+
+  CalibrationWriter(std::string file_name, Version version = Version::Current)
+      : yardl::binary::BinaryWriter(file_name, test_model::CalibrationWriterBase::SchemaFromVersion(version)), version_(version) {}
+
+  void Flush() override;
+
+  protected:
+  void WriteGainImpl(float const& value) override;
+  void WriteSamplesImpl(double const& value) override;
+  void WriteSamplesImpl(std::vector<double> const& values) override;
+  void EndSamplesImpl() override;
+  void CloseImpl() override;
+
+  Version version_;
+};
+
+// Binary reader for the Calibration protocol.
+// A calibration phase shared by protocols
+class CalibrationReader : public test_model::CalibrationReaderBase, yardl::binary::BinaryReader {
+  public:
+  CalibrationReader(std::istream& stream, bool skip_completed_check=false)
+      : test_model::CalibrationReaderBase(skip_completed_check), yardl::binary::BinaryReader(stream), version_(test_model::CalibrationReaderBase::VersionFromSchema(schema_read_)) {}
+
+  CalibrationReader(std::string file_name, bool skip_completed_check=false)
+      : test_model::CalibrationReaderBase(skip_completed_check), yardl::binary::BinaryReader(file_name), version_(test_model::CalibrationReaderBase::VersionFromSchema(schema_read_)) {}
+
+  Version GetVersion() { return version_; }
+
+  protected:
+  void ReadGainImpl(float& value) override;
+  bool ReadSamplesImpl(double& value) override;
+  bool ReadSamplesImpl(std::vector<double>& values) override;
+  void CloseImpl() override;
+
+  Version version_;
+
+  private:
+  size_t current_block_remaining_ = 0;
+};
+
+// Binary writer for the ProtocolWithSubProtocols protocol.
+class ProtocolWithSubProtocolsWriter : public test_model::ProtocolWithSubProtocolsWriterBase, yardl::binary::BinaryWriter {
+  public:
+  ProtocolWithSubProtocolsWriter(std::ostream& stream, Version version = Version::Current)
+      : yardl::binary::BinaryWriter(stream, test_model::ProtocolWithSubProtocolsWriterBase::SchemaFromVersion(version)), version_(version) {}
+
+  ProtocolWithSubProtocolsWriter(std::string file_name, Version version = Version::Current)
+      : yardl::binary::BinaryWriter(file_name, test_model::ProtocolWithSubProtocolsWriterBase::SchemaFromVersion(version)), version_(version) {}
+
+  void Flush() override;
+
+  protected:
+  void WriteHeaderImpl(std::string const& value) override;
+  void WriteCalibrationGainImpl(float const& value) override;
+  void WriteCalibrationSamplesImpl(double const& value) override;
+  void WriteCalibrationSamplesImpl(std::vector<double> const& values) override;
+  void EndCalibrationSamplesImpl() override;
+  void WriteDataImpl(int32_t const& value) override;
+  void WriteDataImpl(std::vector<int32_t> const& values) override;
+  void EndDataImpl() override;
+  void WriteRecalibrationGainImpl(float const& value) override;
+  void WriteRecalibrationSamplesImpl(double const& value) override;
+  void WriteRecalibrationSamplesImpl(std::vector<double> const& values) override;
+  void EndRecalibrationSamplesImpl() override;
+  void CloseImpl() override;
+
+  Version version_;
+};
+
+// Binary reader for the ProtocolWithSubProtocols protocol.
+class ProtocolWithSubProtocolsReader : public test_model::ProtocolWithSubProtocolsReaderBase, yardl::binary::BinaryReader {
+  public:
+  ProtocolWithSubProtocolsReader(std::istream& stream, bool skip_completed_check=false)
+      : test_model::ProtocolWithSubProtocolsReaderBase(skip_completed_check), yardl::binary::BinaryReader(stream), version_(test_model::ProtocolWithSubProtocolsReaderBase::VersionFromSchema(schema_read_)) {}
+
+  ProtocolWithSubProtocolsReader(std::string file_name, bool skip_completed_check=false)
+      : test_model::ProtocolWithSubProtocolsReaderBase(skip_completed_check), yardl::binary::BinaryReader(file_name), version_(test_model::ProtocolWithSubProtocolsReaderBase::VersionFromSchema(schema_read_)) {}
+
+  Version GetVersion() { return version_; }
+
+  protected:
+  void ReadHeaderImpl(std::string& value) override;
+  void ReadCalibrationGainImpl(float& value) override;
+  bool ReadCalibrationSamplesImpl(double& value) override;
+  bool ReadCalibrationSamplesImpl(std::vector<double>& values) override;
+  bool ReadDataImpl(int32_t& value) override;
+  bool ReadDataImpl(std::vector<int32_t>& values) override;
+  void ReadRecalibrationGainImpl(float& value) override;
+  bool ReadRecalibrationSamplesImpl(double& value) override;
+  bool ReadRecalibrationSamplesImpl(std::vector<double>& values) override;
+  void CloseImpl() override;
+
+  Version version_;
+
+  private:
+  size_t current_block_remaining_ = 0;
+};
+
+// Binary writer for the ProtocolWithHalfPrecision protocol.
+class ProtocolWithHalfPrecisionWriter : public test_model::ProtocolWithHalfPrecisionWriterBase, yardl::binary::BinaryWriter {
+  public:
+  ProtocolWithHalfPrecisionWriter(std::ostream& stream, Version version = Version::Current)
+      : yardl::binary::BinaryWriter(stream, test_model::ProtocolWithHalfPrecisionWriterBase::SchemaFromVersion(version)), version_(version) {}
+
+  ProtocolWithHalfPrecisionWriter(std::string file_name, Version version = Version::Current)
+      : yardl::binary::BinaryWriter(file_name, test_model::ProtocolWithHalfPrecisionWriterBase::SchemaFromVersion(version)), version_(version) {}
+
+  void Flush() override;
+
+  protected:
+  void WriteHalvesImpl(std::vector<yardl::Float16> const& value) override;
+  void WriteRecWithHalvesImpl(test_model::RecordWithHalfPrecision const& value) override;
+  void CloseImpl() override;
+
+  Version version_;
+};
+
+// Binary reader for the ProtocolWithHalfPrecision protocol.
+class ProtocolWithHalfPrecisionReader : public test_model::ProtocolWithHalfPrecisionReaderBase, yardl::binary::BinaryReader {
+  public:
+  ProtocolWithHalfPrecisionReader(std::istream& stream, bool skip_completed_check=false)
+      : test_model::ProtocolWithHalfPrecisionReaderBase(skip_completed_check), yardl::binary::BinaryReader(stream), version_(test_model::ProtocolWithHalfPrecisionReaderBase::VersionFromSchema(schema_read_)) {}
+
+  ProtocolWithHalfPrecisionReader(std::string file_name, bool skip_completed_check=false)
+      : test_model::ProtocolWithHalfPrecisionReaderBase(skip_completed_check), yardl::binary::BinaryReader(file_name), version_(test_model::ProtocolWithHalfPrecisionReaderBase::VersionFromSchema(schema_read_)) {}
+
+  Version GetVersion() { return version_; }
+
+  protected:
+  void ReadHalvesImpl(std::vector<yardl::Float16>& value) override;
+  void ReadRecWithHalvesImpl(test_model::RecordWithHalfPrecision& value) override;
+  void CloseImpl() override;
+
+  Version version_;
+};
+
 // Binary writer for the OptionalVectors protocol.
 class OptionalVectorsWriter : public test_model::OptionalVectorsWriterBase, yardl::binary::BinaryWriter {
   public:
@@ -1319,6 +1621,102 @@ class AdvancedGenericsReader : public test_model::AdvancedGenericsReaderBase, ya
   Version version_;
 };
 
+// Binary writer for the FloatImageStream protocol.
+// A protocol that is instantiated for each pixel type
+class FloatImageStreamWriter : public test_model::FloatImageStreamWriterBase, yardl::binary::BinaryWriter {
+  public:
+  FloatImageStreamWriter(std::ostream& stream, Version version = Version::Current)
+      : yardl::binary::BinaryWriter(stream, test_model::FloatImageStreamWriterBase::SchemaFromVersion(version)), version_(version) {}
+
+  FloatImageStreamWriter(std::string file_name, Version version = Version::Current)
+      : yardl::binary::BinaryWriter(file_name, test_model::FloatImageStreamWriterBase::SchemaFromVersion(version)), version_(version) {}
+
+  void Flush() override;
+
+  protected:
+  void WriteHeaderImpl(test_model::GenericRecord<int32_t, float> const& value) override;
+  void WriteImagesImpl(test_model::Image<float> const& value) override;
+  void WriteImagesImpl(std::vector<test_model::Image<float>> const& values) override;
+  void EndImagesImpl() override;
+  void WriteBackgroundImpl(std::variant<float, std::string> const& value) override;
+  void CloseImpl() override;
+
+  Version version_;
+};
+
+// Binary reader for the FloatImageStream protocol.
+// A protocol that is instantiated for each pixel type
+class FloatImageStreamReader : public test_model::FloatImageStreamReaderBase, yardl::binary::BinaryReader {
+  public:
+  FloatImageStreamReader(std::istream& stream, bool skip_completed_check=false)
+      : test_model::FloatImageStreamReaderBase(skip_completed_check), yardl::binary::BinaryReader(stream), version_(test_model::FloatImageStreamReaderBase::VersionFromSchema(schema_read_)) {}
+
+  FloatImageStreamReader(std::string file_name, bool skip_completed_check=false)
+      : test_model::FloatImageStreamReaderBase(skip_completed_check), yardl::binary::BinaryReader(file_name), version_(test_model::FloatImageStreamReaderBase::VersionFromSchema(schema_read_)) {}
+
+  Version GetVersion() { return version_; }
+
+  protected:
+  void ReadHeaderImpl(test_model::GenericRecord<int32_t, float>& value) override;
+  bool ReadImagesImpl(test_model::Image<float>& value) override;
+  bool ReadImagesImpl(std::vector<test_model::Image<float>>& values) override;
+  void ReadBackgroundImpl(std::variant<float, std::string>& value) override;
+  void CloseImpl() override;
+
+  Version version_;
+
+  private:
+  size_t current_block_remaining_ = 0;
+};
+
+// Binary writer for the ComplexImageStream protocol.
+// A protocol that is instantiated for each pixel type
+class ComplexImageStreamWriter : public test_model::ComplexImageStreamWriterBase, yardl::binary::BinaryWriter {
+  public:
+  ComplexImageStreamWriter(std::ostream& stream, Version version = Version::Current)
+      : yardl::binary::BinaryWriter(stream, test_model::ComplexImageStreamWriterBase::SchemaFromVersion(version)), version_(version) {}
+
+  ComplexImageStreamWriter(std::string file_name, Version version = Version::Current)
+      : yardl::binary::BinaryWriter(file_name, test_model::ComplexImageStreamWriterBase::SchemaFromVersion(version)), version_(version) {}
+
+  void Flush() override;
+
+  protected:
+  void WriteHeaderImpl(test_model::GenericRecord<int32_t, std::complex<float>> const& value) override;
+  void WriteImagesImpl(test_model::Image<std::complex<float>> const& value) override;
+  void WriteImagesImpl(std::vector<test_model::Image<std::complex<float>>> const& values) override;
+  void EndImagesImpl() override;
+  void WriteBackgroundImpl(std::variant<std::complex<float>, std::string> const& value) override;
+  void CloseImpl() override;
+
+  Version version_;
+};
+
+// Binary reader for the ComplexImageStream protocol.
+// A protocol that is instantiated for each pixel type
+class ComplexImageStreamReader : public test_model::ComplexImageStreamReaderBase, yardl::binary::BinaryReader {
+  public:
+  ComplexImageStreamReader(std::istream& stream, bool skip_completed_check=false)
+      : test_model::ComplexImageStreamReaderBase(skip_completed_check), yardl::binary::BinaryReader(stream), version_(test_model::ComplexImageStreamReaderBase::VersionFromSchema(schema_read_)) {}
+
+  ComplexImageStreamReader(std::string file_name, bool skip_completed_check=false)
+      : test_model::ComplexImageStreamReaderBase(skip_completed_check), yardl::binary::BinaryReader(file_name), version_(test_model::ComplexImageStreamReaderBase::VersionFromSchema(schema_read_)) {}
+
+  Version GetVersion() { return version_; }
+
+  protected:
+  void ReadHeaderImpl(test_model::GenericRecord<int32_t, std::complex<float>>& value) override;
+  bool ReadImagesImpl(test_model::Image<std::complex<float>>& value) override;
+  bool ReadImagesImpl(std::vector<test_model::Image<std::complex<float>>>& values) override;
+  void ReadBackgroundImpl(std::variant<std::complex<float>, std::string>& value) override;
+  void CloseImpl() override;
+
+  Version version_;
+
+  private:
+  size_t current_block_remaining_ = 0;
+};
+
 // Binary writer for the Aliases protocol.
 class AliasesWriter : public test_model::AliasesWriterBase, yardl::binary::BinaryWriter {
   public:
@@ -1464,6 +1862,50 @@ class ProtocolWithComputedFieldsReader : public test_model::ProtocolWithComputed
   Version version_;
 };
 
+// Binary writer for the ProtocolWithConstraints protocol.
+class ProtocolWithConstraintsWriter : public test_model::ProtocolWithConstraintsWriterBase, yardl::binary::BinaryWriter {
+  public:
+  ProtocolWithConstraintsWriter(std::ostream& stream, Version version = Version::Current)
+      : yardl::binary::BinaryWriter(stream, test_model::ProtocolWithConstraintsWriterBase::SchemaFromVersion(version)), version_(version) {}
+
+  ProtocolWithConstraintsWriter(std::string file_name, Version version = Version::Current)
+      : yardl::binary::BinaryWriter(file_name, test_model::ProtocolWithConstraintsWriterBase::SchemaFromVersion(version)), version_(version) {}
+
+  void Flush() override;
+
+  protected:
+  void WriteRecordImpl(test_model::RecordWithConstraints const& value) override;
+  void WriteRecordsImpl(test_model::RecordWithConstrainedRecords const& value) override;
+  void WriteRecordsImpl(std::vector<test_model::RecordWithConstrainedRecords> const& values) override;
+  void EndRecordsImpl() override;
+  void CloseImpl() override;
+
+  Version version_;
+};
+
+// Binary reader for the ProtocolWithConstraints protocol.
+class ProtocolWithConstraintsReader : public test_model::ProtocolWithConstraintsReaderBase, yardl::binary::BinaryReader {
+  public:
+  ProtocolWithConstraintsReader(std::istream& stream, bool skip_completed_check=false)
+      : test_model::ProtocolWithConstraintsReaderBase(skip_completed_check), yardl::binary::BinaryReader(stream), version_(test_model::ProtocolWithConstraintsReaderBase::VersionFromSchema(schema_read_)) {}
+
+  ProtocolWithConstraintsReader(std::string file_name, bool skip_completed_check=false)
+      : test_model::ProtocolWithConstraintsReaderBase(skip_completed_check), yardl::binary::BinaryReader(file_name), version_(test_model::ProtocolWithConstraintsReaderBase::VersionFromSchema(schema_read_)) {}
+
+  Version GetVersion() { return version_; }
+
+  protected:
+  void ReadRecordImpl(test_model::RecordWithConstraints& value) override;
+  bool ReadRecordsImpl(test_model::RecordWithConstrainedRecords& value) override;
+  bool ReadRecordsImpl(std::vector<test_model::RecordWithConstrainedRecords>& values) override;
+  void CloseImpl() override;
+
+  Version version_;
+
+  private:
+  size_t current_block_remaining_ = 0;
+};
+
 // Binary writer for the ProtocolWithKeywordSteps protocol.
 class ProtocolWithKeywordStepsWriter : public test_model::ProtocolWithKeywordStepsWriterBase, yardl::binary::BinaryWriter {
   public:
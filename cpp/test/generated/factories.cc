@@ -315,6 +315,202 @@ std::unique_ptr<test_model::StringsReaderBase> CreateReader<test_model::StringsR
   }
 }
 
+template<>
+std::unique_ptr<test_model::ProtocolWithBytesWriterBase> CreateWriter<test_model::ProtocolWithBytesWriterBase>(Format format, std::string const& filename) {
+  switch (format) {
+  case Format::kHdf5:
+    return std::make_unique<test_model::hdf5::ProtocolWithBytesWriter>(filename);
+  case Format::kBinary:
+    return std::make_unique<test_model::binary::ProtocolWithBytesWriter>(filename);
+  case Format::kNDJson:
+    return std::make_unique<test_model::ndjson::ProtocolWithBytesWriter>(filename);
+  default:
+    throw std::runtime_error("Unknown format");
+  }
+}
+
+template<>
+std::unique_ptr<test_model::ProtocolWithBytesReaderBase> CreateReader<test_model::ProtocolWithBytesReaderBase>(Format format, std::string const& filename) {
+  switch (format) {
+  case Format::kHdf5:
+    return std::make_unique<test_model::hdf5::ProtocolWithBytesReader>(filename);
+  case Format::kBinary:
+    return std::make_unique<test_model::binary::ProtocolWithBytesReader>(filename);
+  case Format::kNDJson:
+    return std::make_unique<test_model::ndjson::ProtocolWithBytesReader>(filename);
+  default:
+    throw std::runtime_error("Unknown format");
+  }
+}
+
+template<>
+std::unique_ptr<test_model::ProtocolWithUuidsWriterBase> CreateWriter<test_model::ProtocolWithUuidsWriterBase>(Format format, std::string const& filename) {
+  switch (format) {
+  case Format::kHdf5:
+    return std::make_unique<test_model::hdf5::ProtocolWithUuidsWriter>(filename);
+  case Format::kBinary:
+    return std::make_unique<test_model::binary::ProtocolWithUuidsWriter>(filename);
+  case Format::kNDJson:
+    return std::make_unique<test_model::ndjson::ProtocolWithUuidsWriter>(filename);
+  default:
+    throw std::runtime_error("Unknown format");
+  }
+}
+
+template<>
+std::unique_ptr<test_model::ProtocolWithUuidsReaderBase> CreateReader<test_model::ProtocolWithUuidsReaderBase>(Format format, std::string const& filename) {
+  switch (format) {
+  case Format::kHdf5:
+    return std::make_unique<test_model::hdf5::ProtocolWithUuidsReader>(filename);
+  case Format::kBinary:
+    return std::make_unique<test_model::binary::ProtocolWithUuidsReader>(filename);
+  case Format::kNDJson:
+    return std::make_unique<test_model::ndjson::ProtocolWithUuidsReader>(filename);
+  default:
+    throw std::runtime_error("Unknown format");
+  }
+}
+
+template<>
+std::unique_ptr<test_model::ProtocolWithDurationsWriterBase> CreateWriter<test_model::ProtocolWithDurationsWriterBase>(Format format, std::string const& filename) {
+  switch (format) {
+  case Format::kHdf5:
+    return std::make_unique<test_model::hdf5::ProtocolWithDurationsWriter>(filename);
+  case Format::kBinary:
+    return std::make_unique<test_model::binary::ProtocolWithDurationsWriter>(filename);
+  case Format::kNDJson:
+    return std::make_unique<test_model::ndjson::ProtocolWithDurationsWriter>(filename);
+  default:
+    throw std::runtime_error("Unknown format");
+  }
+}
+
+template<>
+std::unique_ptr<test_model::ProtocolWithDurationsReaderBase> CreateReader<test_model::ProtocolWithDurationsReaderBase>(Format format, std::string const& filename) {
+  switch (format) {
+  case Format::kHdf5:
+    return std::make_unique<test_model::hdf5::ProtocolWithDurationsReader>(filename);
+  case Format::kBinary:
+    return std::make_unique<test_model::binary::ProtocolWithDurationsReader>(filename);
+  case Format::kNDJson:
+    return std::make_unique<test_model::ndjson::ProtocolWithDurationsReader>(filename);
+  default:
+    throw std::runtime_error("Unknown format");
+  }
+}
+
+template<>
+std::unique_ptr<test_model::ProtocolWithRecursiveRecordsWriterBase> CreateWriter<test_model::ProtocolWithRecursiveRecordsWriterBase>(Format format, std::string const& filename) {
+  switch (format) {
+  case Format::kHdf5:
+    throw std::runtime_error("Recursive records are not supported in HDF5");
+  case Format::kBinary:
+    return std::make_unique<test_model::binary::ProtocolWithRecursiveRecordsWriter>(filename);
+  case Format::kNDJson:
+    return std::make_unique<test_model::ndjson::ProtocolWithRecursiveRecordsWriter>(filename);
+  default:
+    throw std::runtime_error("Unknown format");
+  }
+}
+
+template<>
+std::unique_ptr<test_model::ProtocolWithRecursiveRecordsReaderBase> CreateReader<test_model::ProtocolWithRecursiveRecordsReaderBase>(Format format, std::string const& filename) {
+  switch (format) {
+  case Format::kHdf5:
+    throw std::runtime_error("Recursive records are not supported in HDF5");
+  case Format::kBinary:
+    return std::make_unique<test_model::binary::ProtocolWithRecursiveRecordsReader>(filename);
+  case Format::kNDJson:
+    return std::make_unique<test_model::ndjson::ProtocolWithRecursiveRecordsReader>(filename);
+  default:
+    throw std::runtime_error("Unknown format");
+  }
+}
+
+template<>
+std::unique_ptr<test_model::CalibrationWriterBase> CreateWriter<test_model::CalibrationWriterBase>(Format format, std::string const& filename) {
+  switch (format) {
+  case Format::kHdf5:
+    return std::make_unique<test_model::hdf5::CalibrationWriter>(filename);
+  case Format::kBinary:
+    return std::make_unique<test_model::binary::CalibrationWriter>(filename);
+  case Format::kNDJson:
+    return std::make_unique<test_model::ndjson::CalibrationWriter>(filename);
+  default:
+    throw std::runtime_error("Unknown format");
+  }
+}
+
+template<>
+std::unique_ptr<test_model::CalibrationReaderBase> CreateReader<test_model::CalibrationReaderBase>(Format format, std::string const& filename) {
+  switch (format) {
+  case Format::kHdf5:
+    return std::make_unique<test_model::hdf5::CalibrationReader>(filename);
+  case Format::kBinary:
+    return std::make_unique<test_model::binary::CalibrationReader>(filename);
+  case Format::kNDJson:
+    return std::make_unique<test_model::ndjson::CalibrationReader>(filename);
+  default:
+    throw std::runtime_error("Unknown format");
+  }
+}
+
+template<>
+std::unique_ptr<test_model::ProtocolWithSubProtocolsWriterBase> CreateWriter<test_model::ProtocolWithSubProtocolsWriterBase>(Format format, std::string const& filename) {
+  switch (format) {
+  case Format::kHdf5:
+    return std::make_unique<test_model::hdf5::ProtocolWithSubProtocolsWriter>(filename);
+  case Format::kBinary:
+    return std::make_unique<test_model::binary::ProtocolWithSubProtocolsWriter>(filename);
+  case Format::kNDJson:
+    return std::make_unique<test_model::ndjson::ProtocolWithSubProtocolsWriter>(filename);
+  default:
+    throw std::runtime_error("Unknown format");
+  }
+}
+
+template<>
+std::unique_ptr<test_model::ProtocolWithSubProtocolsReaderBase> CreateReader<test_model::ProtocolWithSubProtocolsReaderBase>(Format format, std::string const& filename) {
+  switch (format) {
+  case Format::kHdf5:
+    return std::make_unique<test_model::hdf5::ProtocolWithSubProtocolsReader>(filename);
+  case Format::kBinary:
+    return std::make_unique<test_model::binary::ProtocolWithSubProtocolsReader>(filename);
+  case Format::kNDJson:
+    return std::make_unique<test_model::ndjson::ProtocolWithSubProtocolsReader>(filename);
+  default:
+    throw std::runtime_error("Unknown format");
+  }
+}
+
+template<>
+std::unique_ptr<test_model::ProtocolWithHalfPrecisionWriterBase> CreateWriter<test_model::ProtocolWithHalfPrecisionWriterBase>(Format format, std::string const& filename) {
+  switch (format) {
+  case Format::kHdf5:
+    return std::make_unique<test_model::hdf5::ProtocolWithHalfPrecisionWriter>(filename);
+  case Format::kBinary:
+    return std::make_unique<test_model::binary::ProtocolWithHalfPrecisionWriter>(filename);
+  case Format::kNDJson:
+    return std::make_unique<test_model::ndjson::ProtocolWithHalfPrecisionWriter>(filename);
+  default:
+    throw std::runtime_error("Unknown format");
+  }
+}
+
+template<>
+std::unique_ptr<test_model::ProtocolWithHalfPrecisionReaderBase> CreateReader<test_model::ProtocolWithHalfPrecisionReaderBase>(Format format, std::string const& filename) {
+  switch (format) {
+  case Format::kHdf5:
+    return std::make_unique<test_model::hdf5::ProtocolWithHalfPrecisionReader>(filename);
+  case Format::kBinary:
+    return std::make_unique<test_model::binary::ProtocolWithHalfPrecisionReader>(filename);
+  case Format::kNDJson:
+    return std::make_unique<test_model::ndjson::ProtocolWithHalfPrecisionReader>(filename);
+  default:
+    throw std::runtime_error("Unknown format");
+  }
+}
+
 template<>
 std::unique_ptr<test_model::OptionalVectorsWriterBase> CreateWriter<test_model::OptionalVectorsWriterBase>(Format format, std::string const& filename) {
   switch (format) {
@@ -847,6 +1043,62 @@ std::unique_ptr<test_model::AdvancedGenericsReaderBase> CreateReader<test_model:
   }
 }
 
+template<>
+std::unique_ptr<test_model::FloatImageStreamWriterBase> CreateWriter<test_model::FloatImageStreamWriterBase>(Format format, std::string const& filename) {
+  switch (format) {
+  case Format::kHdf5:
+    return std::make_unique<test_model::hdf5::FloatImageStreamWriter>(filename);
+  case Format::kBinary:
+    return std::make_unique<test_model::binary::FloatImageStreamWriter>(filename);
+  case Format::kNDJson:
+    return std::make_unique<test_model::ndjson::FloatImageStreamWriter>(filename);
+  default:
+    throw std::runtime_error("Unknown format");
+  }
+}
+
+template<>
+std::unique_ptr<test_model::FloatImageStreamReaderBase> CreateReader<test_model::FloatImageStreamReaderBase>(Format format, std::string const& filename) {
+  switch (format) {
+  case Format::kHdf5:
+    return std::make_unique<test_model::hdf5::FloatImageStreamReader>(filename);
+  case Format::kBinary:
+    return std::make_unique<test_model::binary::FloatImageStreamReader>(filename);
+  case Format::kNDJson:
+    return std::make_unique<test_model::ndjson::FloatImageStreamReader>(filename);
+  default:
+    throw std::runtime_error("Unknown format");
+  }
+}
+
+template<>
+std::unique_ptr<test_model::ComplexImageStreamWriterBase> CreateWriter<test_model::ComplexImageStreamWriterBase>(Format format, std::string const& filename) {
+  switch (format) {
+  case Format::kHdf5:
+    return std::make_unique<test_model::hdf5::ComplexImageStreamWriter>(filename);
+  case Format::kBinary:
+    return std::make_unique<test_model::binary::ComplexImageStreamWriter>(filename);
+  case Format::kNDJson:
+    return std::make_unique<test_model::ndjson::ComplexImageStreamWriter>(filename);
+  default:
+    throw std::runtime_error("Unknown format");
+  }
+}
+
+template<>
+std::unique_ptr<test_model::ComplexImageStreamReaderBase> CreateReader<test_model::ComplexImageStreamReaderBase>(Format format, std::string const& filename) {
+  switch (format) {
+  case Format::kHdf5:
+    return std::make_unique<test_model::hdf5::ComplexImageStreamReader>(filename);
+  case Format::kBinary:
+    return std::make_unique<test_model::binary::ComplexImageStreamReader>(filename);
+  case Format::kNDJson:
+    return std::make_unique<test_model::ndjson::ComplexImageStreamReader>(filename);
+  default:
+    throw std::runtime_error("Unknown format");
+  }
+}
+
 template<>
 std::unique_ptr<test_model::AliasesWriterBase> CreateWriter<test_model::AliasesWriterBase>(Format format, std::string const& filename) {
   switch (format) {
@@ -931,6 +1183,34 @@ std::unique_ptr<test_model::ProtocolWithComputedFieldsReaderBase> CreateReader<t
   }
 }
 
+template<>
+std::unique_ptr<test_model::ProtocolWithConstraintsWriterBase> CreateWriter<test_model::ProtocolWithConstraintsWriterBase>(Format format, std::string const& filename) {
+  switch (format) {
+  case Format::kHdf5:
+    return std::make_unique<test_model::hdf5::ProtocolWithConstraintsWriter>(filename);
+  case Format::kBinary:
+    return std::make_unique<test_model::binary::ProtocolWithConstraintsWriter>(filename);
+  case Format::kNDJson:
+    return std::make_unique<test_model::ndjson::ProtocolWithConstraintsWriter>(filename);
+  default:
+    throw std::runtime_error("Unknown format");
+  }
+}
+
+template<>
+std::unique_ptr<test_model::ProtocolWithConstraintsReaderBase> CreateReader<test_model::ProtocolWithConstraintsReaderBase>(Format format, std::string const& filename) {
+  switch (format) {
+  case Format::kHdf5:
+    return std::make_unique<test_model::hdf5::ProtocolWithConstraintsReader>(filename);
+  case Format::kBinary:
+    return std::make_unique<test_model::binary::ProtocolWithConstraintsReader>(filename);
+  case Format::kNDJson:
+    return std::make_unique<test_model::ndjson::ProtocolWithConstraintsReader>(filename);
+  default:
+    throw std::runtime_error("Unknown format");
+  }
+}
+
 template<>
 std::unique_ptr<test_model::ProtocolWithKeywordStepsWriterBase> CreateWriter<test_model::ProtocolWithKeywordStepsWriterBase>(Format format, std::string const& filename) {
   switch (format) {
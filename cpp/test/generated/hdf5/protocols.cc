@@ -533,6 +533,15 @@ namespace {
   return t;
 }
 
+[[maybe_unused]] H5::EnumType GetEnumWithAnnotationsHdf5Ddl() {
+  H5::EnumType t(H5::PredType::NATIVE_INT32);
+  int32_t i = 0;
+  t.insert("a", &i);
+  i = 1;
+  t.insert("b", &i);
+  return t;
+}
+
 struct _Inner_SimpleEncodingCounters {
   _Inner_SimpleEncodingCounters() {} 
   _Inner_SimpleEncodingCounters(test_model::SimpleEncodingCounters const& o) 
@@ -737,6 +746,94 @@ struct _Inner_RecordWithStrings {
   yardl::hdf5::InnerVlenString b;
 };
 
+struct _Inner_RecordWithBytes {
+  _Inner_RecordWithBytes() {} 
+  _Inner_RecordWithBytes(test_model::RecordWithBytes const& o) 
+      : data(o.data),
+      optional_data(o.optional_data),
+      chunks(o.chunks) {
+  }
+
+  void ToOuter (test_model::RecordWithBytes& o) const {
+    yardl::hdf5::ToOuter(data, o.data);
+    yardl::hdf5::ToOuter(optional_data, o.optional_data);
+    yardl::hdf5::ToOuter(chunks, o.chunks);
+  }
+
+  yardl::hdf5::InnerVlen<std::byte, std::byte> data;
+  yardl::hdf5::InnerOptional<yardl::hdf5::InnerVlen<std::byte, std::byte>, std::vector<std::byte>> optional_data;
+  yardl::hdf5::InnerVlen<yardl::hdf5::InnerVlen<std::byte, std::byte>, std::vector<std::byte>> chunks;
+};
+
+struct _Inner_RecordWithUuids {
+  _Inner_RecordWithUuids() {} 
+  _Inner_RecordWithUuids(test_model::RecordWithUuids const& o) 
+      : id(o.id),
+      optional_id(o.optional_id),
+      related(o.related),
+      names(o.names) {
+  }
+
+  void ToOuter (test_model::RecordWithUuids& o) const {
+    yardl::hdf5::ToOuter(id, o.id);
+    yardl::hdf5::ToOuter(optional_id, o.optional_id);
+    yardl::hdf5::ToOuter(related, o.related);
+    yardl::hdf5::ToOuter(names, o.names);
+  }
+
+  yardl::Uuid id;
+  yardl::hdf5::InnerOptional<yardl::Uuid, yardl::Uuid> optional_id;
+  yardl::hdf5::InnerVlen<yardl::Uuid, yardl::Uuid> related;
+  yardl::hdf5::InnerMap<yardl::Uuid, yardl::Uuid, yardl::hdf5::InnerVlenString, std::string> names;
+};
+
+struct _Inner_RecordWithDurations {
+  _Inner_RecordWithDurations() {} 
+  _Inner_RecordWithDurations(test_model::RecordWithDurations const& o) 
+      : acquisition_start(o.acquisition_start),
+      acquisition_end(o.acquisition_end),
+      repetition_time(o.repetition_time),
+      timeout(o.timeout),
+      intervals(o.intervals) {
+  }
+
+  void ToOuter (test_model::RecordWithDurations& o) const {
+    yardl::hdf5::ToOuter(acquisition_start, o.acquisition_start);
+    yardl::hdf5::ToOuter(acquisition_end, o.acquisition_end);
+    yardl::hdf5::ToOuter(repetition_time, o.repetition_time);
+    yardl::hdf5::ToOuter(timeout, o.timeout);
+    yardl::hdf5::ToOuter(intervals, o.intervals);
+  }
+
+  yardl::DateTime acquisition_start;
+  yardl::DateTime acquisition_end;
+  yardl::Duration repetition_time;
+  yardl::hdf5::InnerOptional<yardl::Duration, yardl::Duration> timeout;
+  yardl::hdf5::InnerVlen<yardl::Duration, yardl::Duration> intervals;
+};
+
+struct _Inner_RecordWithHalfPrecision {
+  _Inner_RecordWithHalfPrecision() {} 
+  _Inner_RecordWithHalfPrecision(test_model::RecordWithHalfPrecision const& o) 
+      : half(o.half),
+      brain(o.brain),
+      half_vector(o.half_vector),
+      brain_array(o.brain_array) {
+  }
+
+  void ToOuter (test_model::RecordWithHalfPrecision& o) const {
+    yardl::hdf5::ToOuter(half, o.half);
+    yardl::hdf5::ToOuter(brain, o.brain);
+    yardl::hdf5::ToOuter(half_vector, o.half_vector);
+    yardl::hdf5::ToOuter(brain_array, o.brain_array);
+  }
+
+  yardl::Float16 half;
+  yardl::BFloat16 brain;
+  yardl::hdf5::InnerVlen<yardl::Float16, yardl::Float16> half_vector;
+  yardl::hdf5::InnerDynamicNdArray<yardl::BFloat16, yardl::BFloat16> brain_array;
+};
+
 struct _Inner_RecordWithOptionalVector {
   _Inner_RecordWithOptionalVector() {} 
   _Inner_RecordWithOptionalVector(test_model::RecordWithOptionalVector const& o) 
@@ -1289,6 +1386,115 @@ struct _Inner_RecordWithComputedFields {
   yardl::hdf5::InnerMap<yardl::hdf5::InnerVlenString, std::string, yardl::hdf5::InnerVlenString, std::string> map_field;
 };
 
+struct _Inner_RecordWithDefaults {
+  _Inner_RecordWithDefaults() {} 
+  _Inner_RecordWithDefaults(test_model::RecordWithDefaults const& o) 
+      : int_field(o.int_field),
+      int8_field(o.int8_field),
+      uint64_field(o.uint64_field),
+      float32_field(o.float32_field),
+      float64_field(o.float64_field),
+      complexfloat64_field(o.complexfloat64_field),
+      bool_field(o.bool_field),
+      string_field(o.string_field),
+      enum_field(o.enum_field),
+      no_default_field(o.no_default_field) {
+  }
+
+  void ToOuter (test_model::RecordWithDefaults& o) const {
+    yardl::hdf5::ToOuter(int_field, o.int_field);
+    yardl::hdf5::ToOuter(int8_field, o.int8_field);
+    yardl::hdf5::ToOuter(uint64_field, o.uint64_field);
+    yardl::hdf5::ToOuter(float32_field, o.float32_field);
+    yardl::hdf5::ToOuter(float64_field, o.float64_field);
+    yardl::hdf5::ToOuter(complexfloat64_field, o.complexfloat64_field);
+    yardl::hdf5::ToOuter(bool_field, o.bool_field);
+    yardl::hdf5::ToOuter(string_field, o.string_field);
+    yardl::hdf5::ToOuter(enum_field, o.enum_field);
+    yardl::hdf5::ToOuter(no_default_field, o.no_default_field);
+  }
+
+  int32_t int_field;
+  int8_t int8_field;
+  uint64_t uint64_field;
+  float float32_field;
+  double float64_field;
+  std::complex<double> complexfloat64_field;
+  bool bool_field;
+  yardl::hdf5::InnerVlenString string_field;
+  basic_types::Fruits enum_field;
+  int32_t no_default_field;
+};
+
+struct _Inner_RecordWithConstraints {
+  _Inner_RecordWithConstraints() {} 
+  _Inner_RecordWithConstraints(test_model::RecordWithConstraints const& o) 
+      : size(o.size),
+      gain(o.gain),
+      name(o.name),
+      limit(o.limit),
+      samples(o.samples) {
+  }
+
+  void ToOuter (test_model::RecordWithConstraints& o) const {
+    yardl::hdf5::ToOuter(size, o.size);
+    yardl::hdf5::ToOuter(gain, o.gain);
+    yardl::hdf5::ToOuter(name, o.name);
+    yardl::hdf5::ToOuter(limit, o.limit);
+    yardl::hdf5::ToOuter(samples, o.samples);
+  }
+
+  uint32_t size;
+  float gain;
+  yardl::hdf5::InnerVlenString name;
+  yardl::hdf5::InnerOptional<int32_t, int32_t> limit;
+  yardl::hdf5::InnerVlen<int32_t, int32_t> samples;
+};
+
+struct _Inner_RecordWithPatterns {
+  _Inner_RecordWithPatterns() {} 
+  _Inner_RecordWithPatterns(test_model::RecordWithPatterns const& o) 
+      : alternation(o.alternation),
+      word(o.word),
+      code(o.code),
+      escaped(o.escaped),
+      capitalized(o.capitalized) {
+  }
+
+  void ToOuter (test_model::RecordWithPatterns& o) const {
+    yardl::hdf5::ToOuter(alternation, o.alternation);
+    yardl::hdf5::ToOuter(word, o.word);
+    yardl::hdf5::ToOuter(code, o.code);
+    yardl::hdf5::ToOuter(escaped, o.escaped);
+    yardl::hdf5::ToOuter(capitalized, o.capitalized);
+  }
+
+  yardl::hdf5::InnerVlenString alternation;
+  yardl::hdf5::InnerVlenString word;
+  yardl::hdf5::InnerVlenString code;
+  yardl::hdf5::InnerVlenString escaped;
+  yardl::hdf5::InnerVlenString capitalized;
+};
+
+struct _Inner_RecordWithConstrainedRecords {
+  _Inner_RecordWithConstrainedRecords() {} 
+  _Inner_RecordWithConstrainedRecords(test_model::RecordWithConstrainedRecords const& o) 
+      : single(o.single),
+      optional(o.optional),
+      vector(o.vector) {
+  }
+
+  void ToOuter (test_model::RecordWithConstrainedRecords& o) const {
+    yardl::hdf5::ToOuter(single, o.single);
+    yardl::hdf5::ToOuter(optional, o.optional);
+    yardl::hdf5::ToOuter(vector, o.vector);
+  }
+
+  test_model::hdf5::_Inner_RecordWithConstraints single;
+  yardl::hdf5::InnerOptional<test_model::hdf5::_Inner_RecordWithConstraints, test_model::RecordWithConstraints> optional;
+  yardl::hdf5::InnerVlen<test_model::hdf5::_Inner_RecordWithConstraints, test_model::RecordWithConstraints> vector;
+};
+
 struct _Inner_RecordNotUsedInProtocol {
   _Inner_RecordNotUsedInProtocol() {} 
   _Inner_RecordNotUsedInProtocol(test_model::RecordNotUsedInProtocol const& o) 
@@ -1337,6 +1543,115 @@ struct _Inner_RecordWithOptionalDate {
   yardl::hdf5::InnerOptional<yardl::Date, yardl::Date> date_field;
 };
 
+struct _Inner_RecordHeader {
+  _Inner_RecordHeader() {} 
+  _Inner_RecordHeader(test_model::RecordHeader const& o) 
+      : version(o.version),
+      name(o.name) {
+  }
+
+  void ToOuter (test_model::RecordHeader& o) const {
+    yardl::hdf5::ToOuter(version, o.version);
+    yardl::hdf5::ToOuter(name, o.name);
+  }
+
+  uint32_t version;
+  yardl::hdf5::InnerVlenString name;
+};
+
+template <typename _T_Inner, typename T>
+struct _Inner_LabeledHeader {
+  _Inner_LabeledHeader() {} 
+  _Inner_LabeledHeader(test_model::LabeledHeader<T> const& o) 
+      : label(o.label) {
+  }
+
+  void ToOuter (test_model::LabeledHeader<T>& o) const {
+    yardl::hdf5::ToOuter(label, o.label);
+  }
+
+  _T_Inner label;
+};
+
+struct _Inner_RecordWithBase {
+  _Inner_RecordWithBase() {} 
+  _Inner_RecordWithBase(test_model::RecordWithBase const& o) 
+      : version(o.version),
+      name(o.name),
+      data(o.data) {
+  }
+
+  void ToOuter (test_model::RecordWithBase& o) const {
+    yardl::hdf5::ToOuter(version, o.version);
+    yardl::hdf5::ToOuter(name, o.name);
+    yardl::hdf5::ToOuter(data, o.data);
+  }
+
+  uint32_t version;
+  yardl::hdf5::InnerVlenString name;
+  yardl::hdf5::InnerVlen<float, float> data;
+};
+
+struct _Inner_RecordWithBases {
+  _Inner_RecordWithBases() {} 
+  _Inner_RecordWithBases(test_model::RecordWithBases const& o) 
+      : version(o.version),
+      name(o.name),
+      label(o.label),
+      value(o.value) {
+  }
+
+  void ToOuter (test_model::RecordWithBases& o) const {
+    yardl::hdf5::ToOuter(version, o.version);
+    yardl::hdf5::ToOuter(name, o.name);
+    yardl::hdf5::ToOuter(label, o.label);
+    yardl::hdf5::ToOuter(value, o.value);
+  }
+
+  uint32_t version;
+  yardl::hdf5::InnerVlenString name;
+  yardl::hdf5::InnerVlenString label;
+  int32_t value;
+};
+
+struct _Inner_RecordWithAnnotations {
+  _Inner_RecordWithAnnotations() {} 
+  _Inner_RecordWithAnnotations(test_model::RecordWithAnnotations const& o) 
+      : thickness(o.thickness),
+      spacing(o.spacing),
+      label(o.label) {
+  }
+
+  void ToOuter (test_model::RecordWithAnnotations& o) const {
+    yardl::hdf5::ToOuter(thickness, o.thickness);
+    yardl::hdf5::ToOuter(spacing, o.spacing);
+    yardl::hdf5::ToOuter(label, o.label);
+  }
+
+  float thickness;
+  float spacing;
+  yardl::hdf5::InnerVlenString label;
+};
+
+struct _Inner_RecordWithUnits {
+  _Inner_RecordWithUnits() {} 
+  _Inner_RecordWithUnits(test_model::RecordWithUnits const& o) 
+      : length(o.length),
+      width(o.width),
+      durations(o.durations) {
+  }
+
+  void ToOuter (test_model::RecordWithUnits& o) const {
+    yardl::hdf5::ToOuter(length, o.length);
+    yardl::hdf5::ToOuter(width, o.width);
+    yardl::hdf5::ToOuter(durations, o.durations);
+  }
+
+  float length;
+  float width;
+  yardl::hdf5::InnerVlen<double, double> durations;
+};
+
 [[maybe_unused]] H5::CompType GetSmallBenchmarkRecordHdf5Ddl() {
   using RecordType = test_model::SmallBenchmarkRecord;
   H5::CompType t(sizeof(RecordType));
@@ -1493,6 +1808,46 @@ struct _Inner_RecordWithOptionalDate {
   return t;
 }
 
+[[maybe_unused]] H5::CompType GetRecordWithBytesHdf5Ddl() {
+  using RecordType = test_model::hdf5::_Inner_RecordWithBytes;
+  H5::CompType t(sizeof(RecordType));
+  t.insertMember("data", HOFFSET(RecordType, data), yardl::hdf5::InnerVlenBytesDdl());
+  t.insertMember("optionalData", HOFFSET(RecordType, optional_data), yardl::hdf5::OptionalTypeDdl<yardl::hdf5::InnerVlen<std::byte, std::byte>, std::vector<std::byte>>(yardl::hdf5::InnerVlenBytesDdl()));
+  t.insertMember("chunks", HOFFSET(RecordType, chunks), yardl::hdf5::InnerVlenDdl(yardl::hdf5::InnerVlenBytesDdl()));
+  return t;
+}
+
+[[maybe_unused]] H5::CompType GetRecordWithUuidsHdf5Ddl() {
+  using RecordType = test_model::hdf5::_Inner_RecordWithUuids;
+  H5::CompType t(sizeof(RecordType));
+  t.insertMember("id", HOFFSET(RecordType, id), yardl::hdf5::UuidTypeDdl());
+  t.insertMember("optionalId", HOFFSET(RecordType, optional_id), yardl::hdf5::OptionalTypeDdl<yardl::Uuid, yardl::Uuid>(yardl::hdf5::UuidTypeDdl()));
+  t.insertMember("related", HOFFSET(RecordType, related), yardl::hdf5::InnerVlenDdl(yardl::hdf5::UuidTypeDdl()));
+  t.insertMember("names", HOFFSET(RecordType, names), yardl::hdf5::InnerMapDdl<yardl::Uuid, yardl::hdf5::InnerVlenString>(yardl::hdf5::UuidTypeDdl(), yardl::hdf5::InnerVlenStringDdl()));
+  return t;
+}
+
+[[maybe_unused]] H5::CompType GetRecordWithDurationsHdf5Ddl() {
+  using RecordType = test_model::hdf5::_Inner_RecordWithDurations;
+  H5::CompType t(sizeof(RecordType));
+  t.insertMember("acquisitionStart", HOFFSET(RecordType, acquisition_start), yardl::hdf5::DateTimeTypeDdl());
+  t.insertMember("acquisitionEnd", HOFFSET(RecordType, acquisition_end), yardl::hdf5::DateTimeTypeDdl());
+  t.insertMember("repetitionTime", HOFFSET(RecordType, repetition_time), yardl::hdf5::DurationTypeDdl());
+  t.insertMember("timeout", HOFFSET(RecordType, timeout), yardl::hdf5::OptionalTypeDdl<yardl::Duration, yardl::Duration>(yardl::hdf5::DurationTypeDdl()));
+  t.insertMember("intervals", HOFFSET(RecordType, intervals), yardl::hdf5::InnerVlenDdl(yardl::hdf5::DurationTypeDdl()));
+  return t;
+}
+
+[[maybe_unused]] H5::CompType GetRecordWithHalfPrecisionHdf5Ddl() {
+  using RecordType = test_model::hdf5::_Inner_RecordWithHalfPrecision;
+  H5::CompType t(sizeof(RecordType));
+  t.insertMember("half", HOFFSET(RecordType, half), yardl::hdf5::Float16TypeDdl());
+  t.insertMember("brain", HOFFSET(RecordType, brain), yardl::hdf5::BFloat16TypeDdl());
+  t.insertMember("halfVector", HOFFSET(RecordType, half_vector), yardl::hdf5::InnerVlenDdl(yardl::hdf5::Float16TypeDdl()));
+  t.insertMember("brainArray", HOFFSET(RecordType, brain_array), yardl::hdf5::DynamicNDArrayDdl<yardl::BFloat16, yardl::BFloat16>(yardl::hdf5::BFloat16TypeDdl()));
+  return t;
+}
+
 [[maybe_unused]] H5::CompType GetRecordWithOptionalVectorHdf5Ddl() {
   using RecordType = test_model::hdf5::_Inner_RecordWithOptionalVector;
   H5::CompType t(sizeof(RecordType));
@@ -1776,6 +2131,53 @@ template <typename _A_Inner, typename A, typename _B_Inner, typename B>
   return t;
 }
 
+[[maybe_unused]] H5::CompType GetRecordWithDefaultsHdf5Ddl() {
+  using RecordType = test_model::hdf5::_Inner_RecordWithDefaults;
+  H5::CompType t(sizeof(RecordType));
+  t.insertMember("intField", HOFFSET(RecordType, int_field), H5::PredType::NATIVE_INT32);
+  t.insertMember("int8Field", HOFFSET(RecordType, int8_field), H5::PredType::NATIVE_INT8);
+  t.insertMember("uint64Field", HOFFSET(RecordType, uint64_field), H5::PredType::NATIVE_UINT64);
+  t.insertMember("float32Field", HOFFSET(RecordType, float32_field), H5::PredType::NATIVE_FLOAT);
+  t.insertMember("float64Field", HOFFSET(RecordType, float64_field), H5::PredType::NATIVE_DOUBLE);
+  t.insertMember("complexfloat64Field", HOFFSET(RecordType, complexfloat64_field), yardl::hdf5::ComplexTypeDdl<double>());
+  t.insertMember("boolField", HOFFSET(RecordType, bool_field), H5::PredType::NATIVE_HBOOL);
+  t.insertMember("stringField", HOFFSET(RecordType, string_field), yardl::hdf5::InnerVlenStringDdl());
+  t.insertMember("enumField", HOFFSET(RecordType, enum_field), basic_types::hdf5::GetFruitsHdf5Ddl());
+  t.insertMember("noDefaultField", HOFFSET(RecordType, no_default_field), H5::PredType::NATIVE_INT32);
+  return t;
+}
+
+[[maybe_unused]] H5::CompType GetRecordWithConstraintsHdf5Ddl() {
+  using RecordType = test_model::hdf5::_Inner_RecordWithConstraints;
+  H5::CompType t(sizeof(RecordType));
+  t.insertMember("size", HOFFSET(RecordType, size), H5::PredType::NATIVE_UINT32);
+  t.insertMember("gain", HOFFSET(RecordType, gain), H5::PredType::NATIVE_FLOAT);
+  t.insertMember("name", HOFFSET(RecordType, name), yardl::hdf5::InnerVlenStringDdl());
+  t.insertMember("limit", HOFFSET(RecordType, limit), yardl::hdf5::OptionalTypeDdl<int32_t, int32_t>(H5::PredType::NATIVE_INT32));
+  t.insertMember("samples", HOFFSET(RecordType, samples), yardl::hdf5::InnerVlenDdl(H5::PredType::NATIVE_INT32));
+  return t;
+}
+
+[[maybe_unused]] H5::CompType GetRecordWithPatternsHdf5Ddl() {
+  using RecordType = test_model::hdf5::_Inner_RecordWithPatterns;
+  H5::CompType t(sizeof(RecordType));
+  t.insertMember("alternation", HOFFSET(RecordType, alternation), yardl::hdf5::InnerVlenStringDdl());
+  t.insertMember("word", HOFFSET(RecordType, word), yardl::hdf5::InnerVlenStringDdl());
+  t.insertMember("code", HOFFSET(RecordType, code), yardl::hdf5::InnerVlenStringDdl());
+  t.insertMember("escaped", HOFFSET(RecordType, escaped), yardl::hdf5::InnerVlenStringDdl());
+  t.insertMember("capitalized", HOFFSET(RecordType, capitalized), yardl::hdf5::InnerVlenStringDdl());
+  return t;
+}
+
+[[maybe_unused]] H5::CompType GetRecordWithConstrainedRecordsHdf5Ddl() {
+  using RecordType = test_model::hdf5::_Inner_RecordWithConstrainedRecords;
+  H5::CompType t(sizeof(RecordType));
+  t.insertMember("single", HOFFSET(RecordType, single), test_model::hdf5::GetRecordWithConstraintsHdf5Ddl());
+  t.insertMember("optional", HOFFSET(RecordType, optional), yardl::hdf5::OptionalTypeDdl<test_model::hdf5::_Inner_RecordWithConstraints, test_model::RecordWithConstraints>(test_model::hdf5::GetRecordWithConstraintsHdf5Ddl()));
+  t.insertMember("vector", HOFFSET(RecordType, vector), yardl::hdf5::InnerVlenDdl(test_model::hdf5::GetRecordWithConstraintsHdf5Ddl()));
+  return t;
+}
+
 [[maybe_unused]] H5::CompType GetRecordNotUsedInProtocolHdf5Ddl() {
   using RecordType = test_model::hdf5::_Inner_RecordNotUsedInProtocol;
   H5::CompType t(sizeof(RecordType));
@@ -1800,6 +2202,68 @@ template <typename _A_Inner, typename A, typename _B_Inner, typename B>
   return t;
 }
 
+[[maybe_unused]] H5::CompType GetRecordWithConstantsHdf5Ddl() {
+  using RecordType = test_model::RecordWithConstants;
+  H5::CompType t(sizeof(RecordType));
+  t.insertMember("channels", HOFFSET(RecordType, channels), yardl::hdf5::FixedVectorDdl(H5::PredType::NATIVE_INT32, 4));
+  t.insertMember("samples", HOFFSET(RecordType, samples), yardl::hdf5::FixedNDArrayDdl(H5::PredType::NATIVE_FLOAT, {4, 8}));
+  t.insertMember("gain", HOFFSET(RecordType, gain), H5::PredType::NATIVE_FLOAT);
+  return t;
+}
+
+[[maybe_unused]] H5::CompType GetRecordHeaderHdf5Ddl() {
+  using RecordType = test_model::hdf5::_Inner_RecordHeader;
+  H5::CompType t(sizeof(RecordType));
+  t.insertMember("version", HOFFSET(RecordType, version), H5::PredType::NATIVE_UINT32);
+  t.insertMember("name", HOFFSET(RecordType, name), yardl::hdf5::InnerVlenStringDdl());
+  return t;
+}
+
+template <typename _T_Inner, typename T>
+[[maybe_unused]] H5::CompType GetLabeledHeaderHdf5Ddl(H5::DataType const& T_type) {
+  using RecordType = test_model::hdf5::_Inner_LabeledHeader<_T_Inner, T>;
+  H5::CompType t(sizeof(RecordType));
+  t.insertMember("label", HOFFSET(RecordType, label), T_type);
+  return t;
+}
+
+[[maybe_unused]] H5::CompType GetRecordWithBaseHdf5Ddl() {
+  using RecordType = test_model::hdf5::_Inner_RecordWithBase;
+  H5::CompType t(sizeof(RecordType));
+  t.insertMember("version", HOFFSET(RecordType, version), H5::PredType::NATIVE_UINT32);
+  t.insertMember("name", HOFFSET(RecordType, name), yardl::hdf5::InnerVlenStringDdl());
+  t.insertMember("data", HOFFSET(RecordType, data), yardl::hdf5::InnerVlenDdl(H5::PredType::NATIVE_FLOAT));
+  return t;
+}
+
+[[maybe_unused]] H5::CompType GetRecordWithBasesHdf5Ddl() {
+  using RecordType = test_model::hdf5::_Inner_RecordWithBases;
+  H5::CompType t(sizeof(RecordType));
+  t.insertMember("version", HOFFSET(RecordType, version), H5::PredType::NATIVE_UINT32);
+  t.insertMember("name", HOFFSET(RecordType, name), yardl::hdf5::InnerVlenStringDdl());
+  t.insertMember("label", HOFFSET(RecordType, label), yardl::hdf5::InnerVlenStringDdl());
+  t.insertMember("value", HOFFSET(RecordType, value), H5::PredType::NATIVE_INT32);
+  return t;
+}
+
+[[maybe_unused]] H5::CompType GetRecordWithAnnotationsHdf5Ddl() {
+  using RecordType = test_model::hdf5::_Inner_RecordWithAnnotations;
+  H5::CompType t(sizeof(RecordType));
+  t.insertMember("thickness", HOFFSET(RecordType, thickness), H5::PredType::NATIVE_FLOAT);
+  t.insertMember("spacing", HOFFSET(RecordType, spacing), H5::PredType::NATIVE_FLOAT);
+  t.insertMember("label", HOFFSET(RecordType, label), yardl::hdf5::InnerVlenStringDdl());
+  return t;
+}
+
+[[maybe_unused]] H5::CompType GetRecordWithUnitsHdf5Ddl() {
+  using RecordType = test_model::hdf5::_Inner_RecordWithUnits;
+  H5::CompType t(sizeof(RecordType));
+  t.insertMember("length", HOFFSET(RecordType, length), H5::PredType::NATIVE_FLOAT);
+  t.insertMember("width", HOFFSET(RecordType, width), H5::PredType::NATIVE_FLOAT);
+  t.insertMember("durations", HOFFSET(RecordType, durations), yardl::hdf5::InnerVlenDdl(H5::PredType::NATIVE_DOUBLE));
+  return t;
+}
+
 } // namespace 
 
 BenchmarkFloat256x256Writer::BenchmarkFloat256x256Writer(std::string path)
@@ -2304,6 +2768,350 @@ void StringsReader::ReadRecWithStringImpl(test_model::RecordWithStrings& value)
   yardl::hdf5::ReadScalarDataset<test_model::hdf5::_Inner_RecordWithStrings, test_model::RecordWithStrings>(group_, "recWithString", test_model::hdf5::GetRecordWithStringsHdf5Ddl(), value);
 }
 
+ProtocolWithBytesWriter::ProtocolWithBytesWriter(std::string path)
+    : yardl::hdf5::Hdf5Writer::Hdf5Writer(path, "ProtocolWithBytes", schema_) {
+}
+
+void ProtocolWithBytesWriter::WriteSingleBytesImpl(std::vector<std::byte> const& value) {
+  yardl::hdf5::WriteScalarDataset<yardl::hdf5::InnerVlen<std::byte, std::byte>, std::vector<std::byte>>(group_, "singleBytes", yardl::hdf5::InnerVlenBytesDdl(), value);
+}
+
+void ProtocolWithBytesWriter::WriteRecWithBytesImpl(test_model::RecordWithBytes const& value) {
+  yardl::hdf5::WriteScalarDataset<test_model::hdf5::_Inner_RecordWithBytes, test_model::RecordWithBytes>(group_, "recWithBytes", test_model::hdf5::GetRecordWithBytesHdf5Ddl(), value);
+}
+
+ProtocolWithBytesReader::ProtocolWithBytesReader(std::string path, bool skip_completed_check)
+    : test_model::ProtocolWithBytesReaderBase(skip_completed_check), yardl::hdf5::Hdf5Reader::Hdf5Reader(path, "ProtocolWithBytes", schema_) {
+}
+
+void ProtocolWithBytesReader::ReadSingleBytesImpl(std::vector<std::byte>& value) {
+  yardl::hdf5::ReadScalarDataset<yardl::hdf5::InnerVlen<std::byte, std::byte>, std::vector<std::byte>>(group_, "singleBytes", yardl::hdf5::InnerVlenBytesDdl(), value);
+}
+
+void ProtocolWithBytesReader::ReadRecWithBytesImpl(test_model::RecordWithBytes& value) {
+  yardl::hdf5::ReadScalarDataset<test_model::hdf5::_Inner_RecordWithBytes, test_model::RecordWithBytes>(group_, "recWithBytes", test_model::hdf5::GetRecordWithBytesHdf5Ddl(), value);
+}
+
+ProtocolWithUuidsWriter::ProtocolWithUuidsWriter(std::string path)
+    : yardl::hdf5::Hdf5Writer::Hdf5Writer(path, "ProtocolWithUuids", schema_) {
+}
+
+void ProtocolWithUuidsWriter::WriteSingleUuidImpl(yardl::Uuid const& value) {
+  yardl::hdf5::WriteScalarDataset<yardl::Uuid, yardl::Uuid>(group_, "singleUuid", yardl::hdf5::UuidTypeDdl(), value);
+}
+
+void ProtocolWithUuidsWriter::WriteRecWithUuidsImpl(test_model::RecordWithUuids const& value) {
+  yardl::hdf5::WriteScalarDataset<test_model::hdf5::_Inner_RecordWithUuids, test_model::RecordWithUuids>(group_, "recWithUuids", test_model::hdf5::GetRecordWithUuidsHdf5Ddl(), value);
+}
+
+ProtocolWithUuidsReader::ProtocolWithUuidsReader(std::string path, bool skip_completed_check)
+    : test_model::ProtocolWithUuidsReaderBase(skip_completed_check), yardl::hdf5::Hdf5Reader::Hdf5Reader(path, "ProtocolWithUuids", schema_) {
+}
+
+void ProtocolWithUuidsReader::ReadSingleUuidImpl(yardl::Uuid& value) {
+  yardl::hdf5::ReadScalarDataset<yardl::Uuid, yardl::Uuid>(group_, "singleUuid", yardl::hdf5::UuidTypeDdl(), value);
+}
+
+void ProtocolWithUuidsReader::ReadRecWithUuidsImpl(test_model::RecordWithUuids& value) {
+  yardl::hdf5::ReadScalarDataset<test_model::hdf5::_Inner_RecordWithUuids, test_model::RecordWithUuids>(group_, "recWithUuids", test_model::hdf5::GetRecordWithUuidsHdf5Ddl(), value);
+}
+
+ProtocolWithDurationsWriter::ProtocolWithDurationsWriter(std::string path)
+    : yardl::hdf5::Hdf5Writer::Hdf5Writer(path, "ProtocolWithDurations", schema_) {
+}
+
+void ProtocolWithDurationsWriter::WriteSingleDurationImpl(yardl::Duration const& value) {
+  yardl::hdf5::WriteScalarDataset<yardl::Duration, yardl::Duration>(group_, "singleDuration", yardl::hdf5::DurationTypeDdl(), value);
+}
+
+void ProtocolWithDurationsWriter::WriteRecWithDurationsImpl(test_model::RecordWithDurations const& value) {
+  yardl::hdf5::WriteScalarDataset<test_model::hdf5::_Inner_RecordWithDurations, test_model::RecordWithDurations>(group_, "recWithDurations", test_model::hdf5::GetRecordWithDurationsHdf5Ddl(), value);
+}
+
+ProtocolWithDurationsReader::ProtocolWithDurationsReader(std::string path, bool skip_completed_check)
+    : test_model::ProtocolWithDurationsReaderBase(skip_completed_check), yardl::hdf5::Hdf5Reader::Hdf5Reader(path, "ProtocolWithDurations", schema_) {
+}
+
+void ProtocolWithDurationsReader::ReadSingleDurationImpl(yardl::Duration& value) {
+  yardl::hdf5::ReadScalarDataset<yardl::Duration, yardl::Duration>(group_, "singleDuration", yardl::hdf5::DurationTypeDdl(), value);
+}
+
+void ProtocolWithDurationsReader::ReadRecWithDurationsImpl(test_model::RecordWithDurations& value) {
+  yardl::hdf5::ReadScalarDataset<test_model::hdf5::_Inner_RecordWithDurations, test_model::RecordWithDurations>(group_, "recWithDurations", test_model::hdf5::GetRecordWithDurationsHdf5Ddl(), value);
+}
+
+CalibrationWriter::CalibrationWriter(std::string path)
+    : yardl::hdf5::Hdf5Writer::Hdf5Writer(path, "Calibration", schema_) {
+}
+
+void CalibrationWriter::WriteGainImpl(float const& value) {
+  yardl::hdf5::WriteScalarDataset<float, float>(group_, "gain", H5::PredType::NATIVE_FLOAT, value);
+}
+
+void CalibrationWriter::WriteSamplesImpl(double const& value) {
+  if (!samples_dataset_state_) {
+    samples_dataset_state_ = std::make_unique<yardl::hdf5::DatasetWriter>(group_, "samples", H5::PredType::NATIVE_DOUBLE, 0);
+  }
+
+  samples_dataset_state_->Append<double, double>(value);
+}
+
+void CalibrationWriter::WriteSamplesImpl(std::vector<double> const& values) {
+  if (!samples_dataset_state_) {
+    samples_dataset_state_ = std::make_unique<yardl::hdf5::DatasetWriter>(group_, "samples", H5::PredType::NATIVE_DOUBLE, 0);
+  }
+
+  samples_dataset_state_->AppendBatch<double, double>(values);
+}
+
+void CalibrationWriter::EndSamplesImpl() {
+  if (!samples_dataset_state_) {
+    samples_dataset_state_ = std::make_unique<yardl::hdf5::DatasetWriter>(group_, "samples", H5::PredType::NATIVE_DOUBLE, 0);
+  }
+
+  samples_dataset_state_.reset();
+}
+
+CalibrationReader::CalibrationReader(std::string path, bool skip_completed_check)
+    : test_model::CalibrationReaderBase(skip_completed_check), yardl::hdf5::Hdf5Reader::Hdf5Reader(path, "Calibration", schema_) {
+}
+
+void CalibrationReader::ReadGainImpl(float& value) {
+  yardl::hdf5::ReadScalarDataset<float, float>(group_, "gain", H5::PredType::NATIVE_FLOAT, value);
+}
+
+bool CalibrationReader::ReadSamplesImpl(double& value) {
+  if (!samples_dataset_state_) {
+    samples_dataset_state_ = std::make_unique<yardl::hdf5::DatasetReader>(group_, "samples", H5::PredType::NATIVE_DOUBLE, 0);
+  }
+
+  bool has_value = samples_dataset_state_->Read<double, double>(value);
+  if (!has_value) {
+    samples_dataset_state_.reset();
+  }
+
+  return has_value;
+}
+
+bool CalibrationReader::ReadSamplesImpl(std::vector<double>& values) {
+  if (!samples_dataset_state_) {
+    samples_dataset_state_ = std::make_unique<yardl::hdf5::DatasetReader>(group_, "samples", H5::PredType::NATIVE_DOUBLE);
+  }
+
+  bool has_more = samples_dataset_state_->ReadBatch<double, double>(values);
+  if (!has_more) {
+    samples_dataset_state_.reset();
+  }
+
+  return has_more;
+}
+
+ProtocolWithSubProtocolsWriter::ProtocolWithSubProtocolsWriter(std::string path)
+    : yardl::hdf5::Hdf5Writer::Hdf5Writer(path, "ProtocolWithSubProtocols", schema_) {
+}
+
+void ProtocolWithSubProtocolsWriter::WriteHeaderImpl(std::string const& value) {
+  yardl::hdf5::WriteScalarDataset<yardl::hdf5::InnerVlenString, std::string>(group_, "header", yardl::hdf5::InnerVlenStringDdl(), value);
+}
+
+void ProtocolWithSubProtocolsWriter::WriteCalibrationGainImpl(float const& value) {
+  yardl::hdf5::WriteScalarDataset<float, float>(group_, "calibrationGain", H5::PredType::NATIVE_FLOAT, value);
+}
+
+void ProtocolWithSubProtocolsWriter::WriteCalibrationSamplesImpl(double const& value) {
+  if (!calibrationSamples_dataset_state_) {
+    calibrationSamples_dataset_state_ = std::make_unique<yardl::hdf5::DatasetWriter>(group_, "calibrationSamples", H5::PredType::NATIVE_DOUBLE, 0);
+  }
+
+  calibrationSamples_dataset_state_->Append<double, double>(value);
+}
+
+void ProtocolWithSubProtocolsWriter::WriteCalibrationSamplesImpl(std::vector<double> const& values) {
+  if (!calibrationSamples_dataset_state_) {
+    calibrationSamples_dataset_state_ = std::make_unique<yardl::hdf5::DatasetWriter>(group_, "calibrationSamples", H5::PredType::NATIVE_DOUBLE, 0);
+  }
+
+  calibrationSamples_dataset_state_->AppendBatch<double, double>(values);
+}
+
+void ProtocolWithSubProtocolsWriter::EndCalibrationSamplesImpl() {
+  if (!calibrationSamples_dataset_state_) {
+    calibrationSamples_dataset_state_ = std::make_unique<yardl::hdf5::DatasetWriter>(group_, "calibrationSamples", H5::PredType::NATIVE_DOUBLE, 0);
+  }
+
+  calibrationSamples_dataset_state_.reset();
+}
+
+void ProtocolWithSubProtocolsWriter::WriteDataImpl(int32_t const& value) {
+  if (!data_dataset_state_) {
+    data_dataset_state_ = std::make_unique<yardl::hdf5::DatasetWriter>(group_, "data", H5::PredType::NATIVE_INT32, 0);
+  }
+
+  data_dataset_state_->Append<int32_t, int32_t>(value);
+}
+
+void ProtocolWithSubProtocolsWriter::WriteDataImpl(std::vector<int32_t> const& values) {
+  if (!data_dataset_state_) {
+    data_dataset_state_ = std::make_unique<yardl::hdf5::DatasetWriter>(group_, "data", H5::PredType::NATIVE_INT32, 0);
+  }
+
+  data_dataset_state_->AppendBatch<int32_t, int32_t>(values);
+}
+
+void ProtocolWithSubProtocolsWriter::EndDataImpl() {
+  if (!data_dataset_state_) {
+    data_dataset_state_ = std::make_unique<yardl::hdf5::DatasetWriter>(group_, "data", H5::PredType::NATIVE_INT32, 0);
+  }
+
+  data_dataset_state_.reset();
+}
+
+void ProtocolWithSubProtocolsWriter::WriteRecalibrationGainImpl(float const& value) {
+  yardl::hdf5::WriteScalarDataset<float, float>(group_, "recalibrationGain", H5::PredType::NATIVE_FLOAT, value);
+}
+
+void ProtocolWithSubProtocolsWriter::WriteRecalibrationSamplesImpl(double const& value) {
+  if (!recalibrationSamples_dataset_state_) {
+    recalibrationSamples_dataset_state_ = std::make_unique<yardl::hdf5::DatasetWriter>(group_, "recalibrationSamples", H5::PredType::NATIVE_DOUBLE, 0);
+  }
+
+  recalibrationSamples_dataset_state_->Append<double, double>(value);
+}
+
+void ProtocolWithSubProtocolsWriter::WriteRecalibrationSamplesImpl(std::vector<double> const& values) {
+  if (!recalibrationSamples_dataset_state_) {
+    recalibrationSamples_dataset_state_ = std::make_unique<yardl::hdf5::DatasetWriter>(group_, "recalibrationSamples", H5::PredType::NATIVE_DOUBLE, 0);
+  }
+
+  recalibrationSamples_dataset_state_->AppendBatch<double, double>(values);
+}
+
+void ProtocolWithSubProtocolsWriter::EndRecalibrationSamplesImpl() {
+  if (!recalibrationSamples_dataset_state_) {
+    recalibrationSamples_dataset_state_ = std::make_unique<yardl::hdf5::DatasetWriter>(group_, "recalibrationSamples", H5::PredType::NATIVE_DOUBLE, 0);
+  }
+
+  recalibrationSamples_dataset_state_.reset();
+}
+
+ProtocolWithSubProtocolsReader::ProtocolWithSubProtocolsReader(std::string path, bool skip_completed_check)
+    : test_model::ProtocolWithSubProtocolsReaderBase(skip_completed_check), yardl::hdf5::Hdf5Reader::Hdf5Reader(path, "ProtocolWithSubProtocols", schema_) {
+}
+
+void ProtocolWithSubProtocolsReader::ReadHeaderImpl(std::string& value) {
+  yardl::hdf5::ReadScalarDataset<yardl::hdf5::InnerVlenString, std::string>(group_, "header", yardl::hdf5::InnerVlenStringDdl(), value);
+}
+
+void ProtocolWithSubProtocolsReader::ReadCalibrationGainImpl(float& value) {
+  yardl::hdf5::ReadScalarDataset<float, float>(group_, "calibrationGain", H5::PredType::NATIVE_FLOAT, value);
+}
+
+bool ProtocolWithSubProtocolsReader::ReadCalibrationSamplesImpl(double& value) {
+  if (!calibrationSamples_dataset_state_) {
+    calibrationSamples_dataset_state_ = std::make_unique<yardl::hdf5::DatasetReader>(group_, "calibrationSamples", H5::PredType::NATIVE_DOUBLE, 0);
+  }
+
+  bool has_value = calibrationSamples_dataset_state_->Read<double, double>(value);
+  if (!has_value) {
+    calibrationSamples_dataset_state_.reset();
+  }
+
+  return has_value;
+}
+
+bool ProtocolWithSubProtocolsReader::ReadCalibrationSamplesImpl(std::vector<double>& values) {
+  if (!calibrationSamples_dataset_state_) {
+    calibrationSamples_dataset_state_ = std::make_unique<yardl::hdf5::DatasetReader>(group_, "calibrationSamples", H5::PredType::NATIVE_DOUBLE);
+  }
+
+  bool has_more = calibrationSamples_dataset_state_->ReadBatch<double, double>(values);
+  if (!has_more) {
+    calibrationSamples_dataset_state_.reset();
+  }
+
+  return has_more;
+}
+
+bool ProtocolWithSubProtocolsReader::ReadDataImpl(int32_t& value) {
+  if (!data_dataset_state_) {
+    data_dataset_state_ = std::make_unique<yardl::hdf5::DatasetReader>(group_, "data", H5::PredType::NATIVE_INT32, 0);
+  }
+
+  bool has_value = data_dataset_state_->Read<int32_t, int32_t>(value);
+  if (!has_value) {
+    data_dataset_state_.reset();
+  }
+
+  return has_value;
+}
+
+bool ProtocolWithSubProtocolsReader::ReadDataImpl(std::vector<int32_t>& values) {
+  if (!data_dataset_state_) {
+    data_dataset_state_ = std::make_unique<yardl::hdf5::DatasetReader>(group_, "data", H5::PredType::NATIVE_INT32);
+  }
+
+  bool has_more = data_dataset_state_->ReadBatch<int32_t, int32_t>(values);
+  if (!has_more) {
+    data_dataset_state_.reset();
+  }
+
+  return has_more;
+}
+
+void ProtocolWithSubProtocolsReader::ReadRecalibrationGainImpl(float& value) {
+  yardl::hdf5::ReadScalarDataset<float, float>(group_, "recalibrationGain", H5::PredType::NATIVE_FLOAT, value);
+}
+
+bool ProtocolWithSubProtocolsReader::ReadRecalibrationSamplesImpl(double& value) {
+  if (!recalibrationSamples_dataset_state_) {
+    recalibrationSamples_dataset_state_ = std::make_unique<yardl::hdf5::DatasetReader>(group_, "recalibrationSamples", H5::PredType::NATIVE_DOUBLE, 0);
+  }
+
+  bool has_value = recalibrationSamples_dataset_state_->Read<double, double>(value);
+  if (!has_value) {
+    recalibrationSamples_dataset_state_.reset();
+  }
+
+  return has_value;
+}
+
+bool ProtocolWithSubProtocolsReader::ReadRecalibrationSamplesImpl(std::vector<double>& values) {
+  if (!recalibrationSamples_dataset_state_) {
+    recalibrationSamples_dataset_state_ = std::make_unique<yardl::hdf5::DatasetReader>(group_, "recalibrationSamples", H5::PredType::NATIVE_DOUBLE);
+  }
+
+  bool has_more = recalibrationSamples_dataset_state_->ReadBatch<double, double>(values);
+  if (!has_more) {
+    recalibrationSamples_dataset_state_.reset();
+  }
+
+  return has_more;
+}
+
+ProtocolWithHalfPrecisionWriter::ProtocolWithHalfPrecisionWriter(std::string path)
+    : yardl::hdf5::Hdf5Writer::Hdf5Writer(path, "ProtocolWithHalfPrecision", schema_) {
+}
+
+void ProtocolWithHalfPrecisionWriter::WriteHalvesImpl(std::vector<yardl::Float16> const& value) {
+  yardl::hdf5::WriteScalarDataset<yardl::hdf5::InnerVlen<yardl::Float16, yardl::Float16>, std::vector<yardl::Float16>>(group_, "halves", yardl::hdf5::InnerVlenDdl(yardl::hdf5::Float16TypeDdl()), value);
+}
+
+void ProtocolWithHalfPrecisionWriter::WriteRecWithHalvesImpl(test_model::RecordWithHalfPrecision const& value) {
+  yardl::hdf5::WriteScalarDataset<test_model::hdf5::_Inner_RecordWithHalfPrecision, test_model::RecordWithHalfPrecision>(group_, "recWithHalves", test_model::hdf5::GetRecordWithHalfPrecisionHdf5Ddl(), value);
+}
+
+ProtocolWithHalfPrecisionReader::ProtocolWithHalfPrecisionReader(std::string path, bool skip_completed_check)
+    : test_model::ProtocolWithHalfPrecisionReaderBase(skip_completed_check), yardl::hdf5::Hdf5Reader::Hdf5Reader(path, "ProtocolWithHalfPrecision", schema_) {
+}
+
+void ProtocolWithHalfPrecisionReader::ReadHalvesImpl(std::vector<yardl::Float16>& value) {
+  yardl::hdf5::ReadScalarDataset<yardl::hdf5::InnerVlen<yardl::Float16, yardl::Float16>, std::vector<yardl::Float16>>(group_, "halves", yardl::hdf5::InnerVlenDdl(yardl::hdf5::Float16TypeDdl()), value);
+}
+
+void ProtocolWithHalfPrecisionReader::ReadRecWithHalvesImpl(test_model::RecordWithHalfPrecision& value) {
+  yardl::hdf5::ReadScalarDataset<test_model::hdf5::_Inner_RecordWithHalfPrecision, test_model::RecordWithHalfPrecision>(group_, "recWithHalves", test_model::hdf5::GetRecordWithHalfPrecisionHdf5Ddl(), value);
+}
+
 OptionalVectorsWriter::OptionalVectorsWriter(std::string path)
     : yardl::hdf5::Hdf5Writer::Hdf5Writer(path, "OptionalVectors", schema_) {
 }
@@ -3686,6 +4494,154 @@ void AdvancedGenericsReader::ReadTupleOfVectorsImpl(test_model::MyTuple<std::vec
   yardl::hdf5::ReadScalarDataset<tuples::hdf5::_Inner_Tuple<yardl::hdf5::InnerVlen<int32_t, int32_t>, std::vector<int32_t>, yardl::hdf5::InnerVlen<float, float>, std::vector<float>>, test_model::MyTuple<std::vector<int32_t>, std::vector<float>>>(group_, "tupleOfVectors", tuples::hdf5::GetTupleHdf5Ddl<yardl::hdf5::InnerVlen<int32_t, int32_t>, std::vector<int32_t>, yardl::hdf5::InnerVlen<float, float>, std::vector<float>>(yardl::hdf5::InnerVlenDdl(H5::PredType::NATIVE_INT32), yardl::hdf5::InnerVlenDdl(H5::PredType::NATIVE_FLOAT)), value);
 }
 
+FloatImageStreamWriter::FloatImageStreamWriter(std::string path)
+    : yardl::hdf5::Hdf5Writer::Hdf5Writer(path, "FloatImageStream", schema_) {
+}
+
+void FloatImageStreamWriter::WriteHeaderImpl(test_model::GenericRecord<int32_t, float> const& value) {
+  yardl::hdf5::WriteScalarDataset<test_model::hdf5::_Inner_GenericRecord<int32_t, int32_t, float, float>, test_model::GenericRecord<int32_t, float>>(group_, "header", test_model::hdf5::GetGenericRecordHdf5Ddl<int32_t, int32_t, float, float>(H5::PredType::NATIVE_INT32, H5::PredType::NATIVE_FLOAT), value);
+}
+
+void FloatImageStreamWriter::WriteImagesImpl(test_model::Image<float> const& value) {
+  if (!images_dataset_state_) {
+    images_dataset_state_ = std::make_unique<yardl::hdf5::DatasetWriter>(group_, "images", yardl::hdf5::NDArrayDdl<float, float, 2>(H5::PredType::NATIVE_FLOAT), std::max(sizeof(yardl::hdf5::InnerNdArray<float, float, 2>), sizeof(yardl::NDArray<float, 2>)));
+  }
+
+  images_dataset_state_->Append<yardl::hdf5::InnerNdArray<float, float, 2>, test_model::Image<float>>(value);
+}
+
+void FloatImageStreamWriter::WriteImagesImpl(std::vector<test_model::Image<float>> const& values) {
+  if (!images_dataset_state_) {
+    images_dataset_state_ = std::make_unique<yardl::hdf5::DatasetWriter>(group_, "images", yardl::hdf5::NDArrayDdl<float, float, 2>(H5::PredType::NATIVE_FLOAT), std::max(sizeof(yardl::hdf5::InnerNdArray<float, float, 2>), sizeof(yardl::NDArray<float, 2>)));
+  }
+
+  images_dataset_state_->AppendBatch<yardl::hdf5::InnerNdArray<float, float, 2>, test_model::Image<float>>(values);
+}
+
+void FloatImageStreamWriter::EndImagesImpl() {
+  if (!images_dataset_state_) {
+    images_dataset_state_ = std::make_unique<yardl::hdf5::DatasetWriter>(group_, "images", yardl::hdf5::NDArrayDdl<float, float, 2>(H5::PredType::NATIVE_FLOAT), std::max(sizeof(yardl::hdf5::InnerNdArray<float, float, 2>), sizeof(yardl::NDArray<float, 2>)));
+  }
+
+  images_dataset_state_.reset();
+}
+
+void FloatImageStreamWriter::WriteBackgroundImpl(std::variant<float, std::string> const& value) {
+  yardl::hdf5::WriteScalarDataset<::InnerUnion2<float, float, yardl::hdf5::InnerVlenString, std::string>, std::variant<float, std::string>>(group_, "background", ::InnerUnion2Ddl<float, float, yardl::hdf5::InnerVlenString, std::string>(false, H5::PredType::NATIVE_FLOAT, "float32", yardl::hdf5::InnerVlenStringDdl(), "string"), value);
+}
+
+FloatImageStreamReader::FloatImageStreamReader(std::string path, bool skip_completed_check)
+    : test_model::FloatImageStreamReaderBase(skip_completed_check), yardl::hdf5::Hdf5Reader::Hdf5Reader(path, "FloatImageStream", schema_) {
+}
+
+void FloatImageStreamReader::ReadHeaderImpl(test_model::GenericRecord<int32_t, float>& value) {
+  yardl::hdf5::ReadScalarDataset<test_model::hdf5::_Inner_GenericRecord<int32_t, int32_t, float, float>, test_model::GenericRecord<int32_t, float>>(group_, "header", test_model::hdf5::GetGenericRecordHdf5Ddl<int32_t, int32_t, float, float>(H5::PredType::NATIVE_INT32, H5::PredType::NATIVE_FLOAT), value);
+}
+
+bool FloatImageStreamReader::ReadImagesImpl(test_model::Image<float>& value) {
+  if (!images_dataset_state_) {
+    images_dataset_state_ = std::make_unique<yardl::hdf5::DatasetReader>(group_, "images", yardl::hdf5::NDArrayDdl<float, float, 2>(H5::PredType::NATIVE_FLOAT), std::max(sizeof(yardl::hdf5::InnerNdArray<float, float, 2>), sizeof(yardl::NDArray<float, 2>)));
+  }
+
+  bool has_value = images_dataset_state_->Read<yardl::hdf5::InnerNdArray<float, float, 2>, test_model::Image<float>>(value);
+  if (!has_value) {
+    images_dataset_state_.reset();
+  }
+
+  return has_value;
+}
+
+bool FloatImageStreamReader::ReadImagesImpl(std::vector<test_model::Image<float>>& values) {
+  if (!images_dataset_state_) {
+    images_dataset_state_ = std::make_unique<yardl::hdf5::DatasetReader>(group_, "images", yardl::hdf5::NDArrayDdl<float, float, 2>(H5::PredType::NATIVE_FLOAT));
+  }
+
+  bool has_more = images_dataset_state_->ReadBatch<yardl::hdf5::InnerNdArray<float, float, 2>, test_model::Image<float>>(values);
+  if (!has_more) {
+    images_dataset_state_.reset();
+  }
+
+  return has_more;
+}
+
+void FloatImageStreamReader::ReadBackgroundImpl(std::variant<float, std::string>& value) {
+  yardl::hdf5::ReadScalarDataset<::InnerUnion2<float, float, yardl::hdf5::InnerVlenString, std::string>, std::variant<float, std::string>>(group_, "background", ::InnerUnion2Ddl<float, float, yardl::hdf5::InnerVlenString, std::string>(false, H5::PredType::NATIVE_FLOAT, "float32", yardl::hdf5::InnerVlenStringDdl(), "string"), value);
+}
+
+ComplexImageStreamWriter::ComplexImageStreamWriter(std::string path)
+    : yardl::hdf5::Hdf5Writer::Hdf5Writer(path, "ComplexImageStream", schema_) {
+}
+
+void ComplexImageStreamWriter::WriteHeaderImpl(test_model::GenericRecord<int32_t, std::complex<float>> const& value) {
+  yardl::hdf5::WriteScalarDataset<test_model::hdf5::_Inner_GenericRecord<int32_t, int32_t, std::complex<float>, std::complex<float>>, test_model::GenericRecord<int32_t, std::complex<float>>>(group_, "header", test_model::hdf5::GetGenericRecordHdf5Ddl<int32_t, int32_t, std::complex<float>, std::complex<float>>(H5::PredType::NATIVE_INT32, yardl::hdf5::ComplexTypeDdl<float>()), value);
+}
+
+void ComplexImageStreamWriter::WriteImagesImpl(test_model::Image<std::complex<float>> const& value) {
+  if (!images_dataset_state_) {
+    images_dataset_state_ = std::make_unique<yardl::hdf5::DatasetWriter>(group_, "images", yardl::hdf5::NDArrayDdl<std::complex<float>, std::complex<float>, 2>(yardl::hdf5::ComplexTypeDdl<float>()), std::max(sizeof(yardl::hdf5::InnerNdArray<std::complex<float>, std::complex<float>, 2>), sizeof(yardl::NDArray<std::complex<float>, 2>)));
+  }
+
+  images_dataset_state_->Append<yardl::hdf5::InnerNdArray<std::complex<float>, std::complex<float>, 2>, test_model::Image<std::complex<float>>>(value);
+}
+
+void ComplexImageStreamWriter::WriteImagesImpl(std::vector<test_model::Image<std::complex<float>>> const& values) {
+  if (!images_dataset_state_) {
+    images_dataset_state_ = std::make_unique<yardl::hdf5::DatasetWriter>(group_, "images", yardl::hdf5::NDArrayDdl<std::complex<float>, std::complex<float>, 2>(yardl::hdf5::ComplexTypeDdl<float>()), std::max(sizeof(yardl::hdf5::InnerNdArray<std::complex<float>, std::complex<float>, 2>), sizeof(yardl::NDArray<std::complex<float>, 2>)));
+  }
+
+  images_dataset_state_->AppendBatch<yardl::hdf5::InnerNdArray<std::complex<float>, std::complex<float>, 2>, test_model::Image<std::complex<float>>>(values);
+}
+
+void ComplexImageStreamWriter::EndImagesImpl() {
+  if (!images_dataset_state_) {
+    images_dataset_state_ = std::make_unique<yardl::hdf5::DatasetWriter>(group_, "images", yardl::hdf5::NDArrayDdl<std::complex<float>, std::complex<float>, 2>(yardl::hdf5::ComplexTypeDdl<float>()), std::max(sizeof(yardl::hdf5::InnerNdArray<std::complex<float>, std::complex<float>, 2>), sizeof(yardl::NDArray<std::complex<float>, 2>)));
+  }
+
+  images_dataset_state_.reset();
+}
+
+void ComplexImageStreamWriter::WriteBackgroundImpl(std::variant<std::complex<float>, std::string> const& value) {
+  yardl::hdf5::WriteScalarDataset<::InnerUnion2<std::complex<float>, std::complex<float>, yardl::hdf5::InnerVlenString, std::string>, std::variant<std::complex<float>, std::string>>(group_, "background", ::InnerUnion2Ddl<std::complex<float>, std::complex<float>, yardl::hdf5::InnerVlenString, std::string>(false, yardl::hdf5::ComplexTypeDdl<float>(), "complexfloat32", yardl::hdf5::InnerVlenStringDdl(), "string"), value);
+}
+
+ComplexImageStreamReader::ComplexImageStreamReader(std::string path, bool skip_completed_check)
+    : test_model::ComplexImageStreamReaderBase(skip_completed_check), yardl::hdf5::Hdf5Reader::Hdf5Reader(path, "ComplexImageStream", schema_) {
+}
+
+void ComplexImageStreamReader::ReadHeaderImpl(test_model::GenericRecord<int32_t, std::complex<float>>& value) {
+  yardl::hdf5::ReadScalarDataset<test_model::hdf5::_Inner_GenericRecord<int32_t, int32_t, std::complex<float>, std::complex<float>>, test_model::GenericRecord<int32_t, std::complex<float>>>(group_, "header", test_model::hdf5::GetGenericRecordHdf5Ddl<int32_t, int32_t, std::complex<float>, std::complex<float>>(H5::PredType::NATIVE_INT32, yardl::hdf5::ComplexTypeDdl<float>()), value);
+}
+
+bool ComplexImageStreamReader::ReadImagesImpl(test_model::Image<std::complex<float>>& value) {
+  if (!images_dataset_state_) {
+    images_dataset_state_ = std::make_unique<yardl::hdf5::DatasetReader>(group_, "images", yardl::hdf5::NDArrayDdl<std::complex<float>, std::complex<float>, 2>(yardl::hdf5::ComplexTypeDdl<float>()), std::max(sizeof(yardl::hdf5::InnerNdArray<std::complex<float>, std::complex<float>, 2>), sizeof(yardl::NDArray<std::complex<float>, 2>)));
+  }
+
+  bool has_value = images_dataset_state_->Read<yardl::hdf5::InnerNdArray<std::complex<float>, std::complex<float>, 2>, test_model::Image<std::complex<float>>>(value);
+  if (!has_value) {
+    images_dataset_state_.reset();
+  }
+
+  return has_value;
+}
+
+bool ComplexImageStreamReader::ReadImagesImpl(std::vector<test_model::Image<std::complex<float>>>& values) {
+  if (!images_dataset_state_) {
+    images_dataset_state_ = std::make_unique<yardl::hdf5::DatasetReader>(group_, "images", yardl::hdf5::NDArrayDdl<std::complex<float>, std::complex<float>, 2>(yardl::hdf5::ComplexTypeDdl<float>()));
+  }
+
+  bool has_more = images_dataset_state_->ReadBatch<yardl::hdf5::InnerNdArray<std::complex<float>, std::complex<float>, 2>, test_model::Image<std::complex<float>>>(values);
+  if (!has_more) {
+    images_dataset_state_.reset();
+  }
+
+  return has_more;
+}
+
+void ComplexImageStreamReader::ReadBackgroundImpl(std::variant<std::complex<float>, std::string>& value) {
+  yardl::hdf5::ReadScalarDataset<::InnerUnion2<std::complex<float>, std::complex<float>, yardl::hdf5::InnerVlenString, std::string>, std::variant<std::complex<float>, std::string>>(group_, "background", ::InnerUnion2Ddl<std::complex<float>, std::complex<float>, yardl::hdf5::InnerVlenString, std::string>(false, yardl::hdf5::ComplexTypeDdl<float>(), "complexfloat32", yardl::hdf5::InnerVlenStringDdl(), "string"), value);
+}
+
 AliasesWriter::AliasesWriter(std::string path)
     : yardl::hdf5::Hdf5Writer::Hdf5Writer(path, "Aliases", schema_) {
 }
@@ -3980,6 +4936,72 @@ void ProtocolWithComputedFieldsReader::ReadRecordWithComputedFieldsImpl(test_mod
   yardl::hdf5::ReadScalarDataset<test_model::hdf5::_Inner_RecordWithComputedFields, test_model::RecordWithComputedFields>(group_, "recordWithComputedFields", test_model::hdf5::GetRecordWithComputedFieldsHdf5Ddl(), value);
 }
 
+ProtocolWithConstraintsWriter::ProtocolWithConstraintsWriter(std::string path)
+    : yardl::hdf5::Hdf5Writer::Hdf5Writer(path, "ProtocolWithConstraints", schema_) {
+}
+
+void ProtocolWithConstraintsWriter::WriteRecordImpl(test_model::RecordWithConstraints const& value) {
+  yardl::hdf5::WriteScalarDataset<test_model::hdf5::_Inner_RecordWithConstraints, test_model::RecordWithConstraints>(group_, "record", test_model::hdf5::GetRecordWithConstraintsHdf5Ddl(), value);
+}
+
+void ProtocolWithConstraintsWriter::WriteRecordsImpl(test_model::RecordWithConstrainedRecords const& value) {
+  if (!records_dataset_state_) {
+    records_dataset_state_ = std::make_unique<yardl::hdf5::DatasetWriter>(group_, "records", test_model::hdf5::GetRecordWithConstrainedRecordsHdf5Ddl(), std::max(sizeof(test_model::hdf5::_Inner_RecordWithConstrainedRecords), sizeof(test_model::RecordWithConstrainedRecords)));
+  }
+
+  records_dataset_state_->Append<test_model::hdf5::_Inner_RecordWithConstrainedRecords, test_model::RecordWithConstrainedRecords>(value);
+}
+
+void ProtocolWithConstraintsWriter::WriteRecordsImpl(std::vector<test_model::RecordWithConstrainedRecords> const& values) {
+  if (!records_dataset_state_) {
+    records_dataset_state_ = std::make_unique<yardl::hdf5::DatasetWriter>(group_, "records", test_model::hdf5::GetRecordWithConstrainedRecordsHdf5Ddl(), std::max(sizeof(test_model::hdf5::_Inner_RecordWithConstrainedRecords), sizeof(test_model::RecordWithConstrainedRecords)));
+  }
+
+  records_dataset_state_->AppendBatch<test_model::hdf5::_Inner_RecordWithConstrainedRecords, test_model::RecordWithConstrainedRecords>(values);
+}
+
+void ProtocolWithConstraintsWriter::EndRecordsImpl() {
+  if (!records_dataset_state_) {
+    records_dataset_state_ = std::make_unique<yardl::hdf5::DatasetWriter>(group_, "records", test_model::hdf5::GetRecordWithConstrainedRecordsHdf5Ddl(), std::max(sizeof(test_model::hdf5::_Inner_RecordWithConstrainedRecords), sizeof(test_model::RecordWithConstrainedRecords)));
+  }
+
+  records_dataset_state_.reset();
+}
+
+ProtocolWithConstraintsReader::ProtocolWithConstraintsReader(std::string path, bool skip_completed_check)
+    : test_model::ProtocolWithConstraintsReaderBase(skip_completed_check), yardl::hdf5::Hdf5Reader::Hdf5Reader(path, "ProtocolWithConstraints", schema_) {
+}
+
+void ProtocolWithConstraintsReader::ReadRecordImpl(test_model::RecordWithConstraints& value) {
+  yardl::hdf5::ReadScalarDataset<test_model::hdf5::_Inner_RecordWithConstraints, test_model::RecordWithConstraints>(group_, "record", test_model::hdf5::GetRecordWithConstraintsHdf5Ddl(), value);
+}
+
+bool ProtocolWithConstraintsReader::ReadRecordsImpl(test_model::RecordWithConstrainedRecords& value) {
+  if (!records_dataset_state_) {
+    records_dataset_state_ = std::make_unique<yardl::hdf5::DatasetReader>(group_, "records", test_model::hdf5::GetRecordWithConstrainedRecordsHdf5Ddl(), std::max(sizeof(test_model::hdf5::_Inner_RecordWithConstrainedRecords), sizeof(test_model::RecordWithConstrainedRecords)));
+  }
+
+  bool has_value = records_dataset_state_->Read<test_model::hdf5::_Inner_RecordWithConstrainedRecords, test_model::RecordWithConstrainedRecords>(value);
+  if (!has_value) {
+    records_dataset_state_.reset();
+  }
+
+  return has_value;
+}
+
+bool ProtocolWithConstraintsReader::ReadRecordsImpl(std::vector<test_model::RecordWithConstrainedRecords>& values) {
+  if (!records_dataset_state_) {
+    records_dataset_state_ = std::make_unique<yardl::hdf5::DatasetReader>(group_, "records", test_model::hdf5::GetRecordWithConstrainedRecordsHdf5Ddl());
+  }
+
+  bool has_more = records_dataset_state_->ReadBatch<test_model::hdf5::_Inner_RecordWithConstrainedRecords, test_model::RecordWithConstrainedRecords>(values);
+  if (!has_more) {
+    records_dataset_state_.reset();
+  }
+
+  return has_more;
+}
+
 ProtocolWithKeywordStepsWriter::ProtocolWithKeywordStepsWriter(std::string path)
     : yardl::hdf5::Hdf5Writer::Hdf5Writer(path, "ProtocolWithKeywordSteps", schema_) {
 }
@@ -321,6 +321,206 @@ class StringsReader : public test_model::StringsReaderBase, public yardl::hdf5::
   private:
 };
 
+// HDF5 writer for the ProtocolWithBytes protocol.
+class ProtocolWithBytesWriter : public test_model::ProtocolWithBytesWriterBase, public yardl::hdf5::Hdf5Writer {
+  public:
+  ProtocolWithBytesWriter(std::string path);
+
+  protected:
+  void WriteSingleBytesImpl(std::vector<std::byte> const& value) override;
+
+  void WriteRecWithBytesImpl(test_model::RecordWithBytes const& value) override;
+
+  private:
+};
+
+// HDF5 reader for the ProtocolWithBytes protocol.
+class ProtocolWithBytesReader : public test_model::ProtocolWithBytesReaderBase, public yardl::hdf5::Hdf5Reader {
+  public:
+  ProtocolWithBytesReader(std::string path, bool skip_completed_check=false);
+
+  void ReadSingleBytesImpl(std::vector<std::byte>& value) override;
+
+  void ReadRecWithBytesImpl(test_model::RecordWithBytes& value) override;
+
+  private:
+};
+
+// HDF5 writer for the ProtocolWithUuids protocol.
+class ProtocolWithUuidsWriter : public test_model::ProtocolWithUuidsWriterBase, public yardl::hdf5::Hdf5Writer {
+  public:
+  ProtocolWithUuidsWriter(std::string path);
+
+  protected:
+  void WriteSingleUuidImpl(yardl::Uuid const& value) override;
+
+  void WriteRecWithUuidsImpl(test_model::RecordWithUuids const& value) override;
+
+  private:
+};
+
+// HDF5 reader for the ProtocolWithUuids protocol.
+class ProtocolWithUuidsReader : public test_model::ProtocolWithUuidsReaderBase, public yardl::hdf5::Hdf5Reader {
+  public:
+  ProtocolWithUuidsReader(std::string path, bool skip_completed_check=false);
+
+  void ReadSingleUuidImpl(yardl::Uuid& value) override;
+
+  void ReadRecWithUuidsImpl(test_model::RecordWithUuids& value) override;
+
+  private:
+};
+
+// HDF5 writer for the ProtocolWithDurations protocol.
+class ProtocolWithDurationsWriter : public test_model::ProtocolWithDurationsWriterBase, public yardl::hdf5::Hdf5Writer {
+  public:
+  ProtocolWithDurationsWriter(std::string path);
+
+  protected:
+  void WriteSingleDurationImpl(yardl::Duration const& value) override;
+
+  void WriteRecWithDurationsImpl(test_model::RecordWithDurations const& value) override;
+
+  private:
+};
+
+// HDF5 reader for the ProtocolWithDurations protocol.
+class ProtocolWithDurationsReader : public test_model::ProtocolWithDurationsReaderBase, public yardl::hdf5::Hdf5Reader {
+  public:
+  ProtocolWithDurationsReader(std::string path, bool skip_completed_check=false);
+
+  void ReadSingleDurationImpl(yardl::Duration& value) override;
+
+  void ReadRecWithDurationsImpl(test_model::RecordWithDurations& value) override;
+
+  private:
+};
+
+// HDF5 writer for the Calibration protocol.
+// A calibration phase shared by protocols
+class CalibrationWriter : public test_model::CalibrationWriterBase, public yardl::hdf5::Hdf5Writer {
+  public:
+  CalibrationWriter(std::string path);
+
+  protected:
+  void WriteGainImpl(float const& value) override;
+
+  void WriteSamplesImpl(double const& value) override;
+
+  void WriteSamplesImpl(std::vector<double> const& values) override;
+
+  void EndSamplesImpl() override;
+
+  private:
+  std::unique_ptr<yardl::hdf5::DatasetWriter> samples_dataset_state_;
+};
+
+// HDF5 reader for the Calibration protocol.
+// A calibration phase shared by protocols
+class CalibrationReader : public test_model::CalibrationReaderBase, public yardl::hdf5::Hdf5Reader {
+  public:
+  CalibrationReader(std::string path, bool skip_completed_check=false);
+
+  void ReadGainImpl(float& value) override;
+
+  bool ReadSamplesImpl(double& value) override;
+
+  bool ReadSamplesImpl(std::vector<double>& values) override;
+
+  private:
+  std::unique_ptr<yardl::hdf5::DatasetReader> samples_dataset_state_;
+};
+
+// HDF5 writer for the ProtocolWithSubProtocols protocol.
+class ProtocolWithSubProtocolsWriter : public test_model::ProtocolWithSubProtocolsWriterBase, public yardl::hdf5::Hdf5Writer {
+  public:
+  ProtocolWithSubProtocolsWriter(std::string path);
+
+  protected:
+  void WriteHeaderImpl(std::string const& value) override;
+
+  void WriteCalibrationGainImpl(float const& value) override;
+
+  void WriteCalibrationSamplesImpl(double const& value) override;
+
+  void WriteCalibrationSamplesImpl(std::vector<double> const& values) override;
+
+  void EndCalibrationSamplesImpl() override;
+
+  void WriteDataImpl(int32_t const& value) override;
+
+  void WriteDataImpl(std::vector<int32_t> const& values) override;
+
+  void EndDataImpl() override;
+
+  void WriteRecalibrationGainImpl(float const& value) override;
+
+  void WriteRecalibrationSamplesImpl(double const& value) override;
+
+  void WriteRecalibrationSamplesImpl(std::vector<double> const& values) override;
+
+  void EndRecalibrationSamplesImpl() override;
+
+  private:
+  std::unique_ptr<yardl::hdf5::DatasetWriter> calibrationSamples_dataset_state_;
+  std::unique_ptr<yardl::hdf5::DatasetWriter> data_dataset_state_;
+  std::unique_ptr<yardl::hdf5::DatasetWriter> recalibrationSamples_dataset_state_;
+};
+
+// HDF5 reader for the ProtocolWithSubProtocols protocol.
+class ProtocolWithSubProtocolsReader : public test_model::ProtocolWithSubProtocolsReaderBase, public yardl::hdf5::Hdf5Reader {
+  public:
+  ProtocolWithSubProtocolsReader(std::string path, bool skip_completed_check=false);
+
+  void ReadHeaderImpl(std::string& value) override;
+
+  void ReadCalibrationGainImpl(float& value) override;
+
+  bool ReadCalibrationSamplesImpl(double& value) override;
+
+  bool ReadCalibrationSamplesImpl(std::vector<double>& values) override;
+
+  bool ReadDataImpl(int32_t& value) override;
+
+  bool ReadDataImpl(std::vector<int32_t>& values) override;
+
+  void ReadRecalibrationGainImpl(float& value) override;
+
+  bool ReadRecalibrationSamplesImpl(double& value) override;
+
+  bool ReadRecalibrationSamplesImpl(std::vector<double>& values) override;
+
+  private:
+  std::unique_ptr<yardl::hdf5::DatasetReader> calibrationSamples_dataset_state_;
+  std::unique_ptr<yardl::hdf5::DatasetReader> data_dataset_state_;
+  std::unique_ptr<yardl::hdf5::DatasetReader> recalibrationSamples_dataset_state_;
+};
+
+// HDF5 writer for the ProtocolWithHalfPrecision protocol.
+class ProtocolWithHalfPrecisionWriter : public test_model::ProtocolWithHalfPrecisionWriterBase, public yardl::hdf5::Hdf5Writer {
+  public:
+  ProtocolWithHalfPrecisionWriter(std::string path);
+
+  protected:
+  void WriteHalvesImpl(std::vector<yardl::Float16> const& value) override;
+
+  void WriteRecWithHalvesImpl(test_model::RecordWithHalfPrecision const& value) override;
+
+  private:
+};
+
+// HDF5 reader for the ProtocolWithHalfPrecision protocol.
+class ProtocolWithHalfPrecisionReader : public test_model::ProtocolWithHalfPrecisionReaderBase, public yardl::hdf5::Hdf5Reader {
+  public:
+  ProtocolWithHalfPrecisionReader(std::string path, bool skip_completed_check=false);
+
+  void ReadHalvesImpl(std::vector<yardl::Float16>& value) override;
+
+  void ReadRecWithHalvesImpl(test_model::RecordWithHalfPrecision& value) override;
+
+  private:
+};
+
 // HDF5 writer for the OptionalVectors protocol.
 class OptionalVectorsWriter : public test_model::OptionalVectorsWriterBase, public yardl::hdf5::Hdf5Writer {
   public:
@@ -1050,6 +1250,84 @@ class AdvancedGenericsReader : public test_model::AdvancedGenericsReaderBase, pu
   private:
 };
 
+// HDF5 writer for the FloatImageStream protocol.
+// A protocol that is instantiated for each pixel type
+class FloatImageStreamWriter : public test_model::FloatImageStreamWriterBase, public yardl::hdf5::Hdf5Writer {
+  public:
+  FloatImageStreamWriter(std::string path);
+
+  protected:
+  void WriteHeaderImpl(test_model::GenericRecord<int32_t, float> const& value) override;
+
+  void WriteImagesImpl(test_model::Image<float> const& value) override;
+
+  void WriteImagesImpl(std::vector<test_model::Image<float>> const& values) override;
+
+  void EndImagesImpl() override;
+
+  void WriteBackgroundImpl(std::variant<float, std::string> const& value) override;
+
+  private:
+  std::unique_ptr<yardl::hdf5::DatasetWriter> images_dataset_state_;
+};
+
+// HDF5 reader for the FloatImageStream protocol.
+// A protocol that is instantiated for each pixel type
+class FloatImageStreamReader : public test_model::FloatImageStreamReaderBase, public yardl::hdf5::Hdf5Reader {
+  public:
+  FloatImageStreamReader(std::string path, bool skip_completed_check=false);
+
+  void ReadHeaderImpl(test_model::GenericRecord<int32_t, float>& value) override;
+
+  bool ReadImagesImpl(test_model::Image<float>& value) override;
+
+  bool ReadImagesImpl(std::vector<test_model::Image<float>>& values) override;
+
+  void ReadBackgroundImpl(std::variant<float, std::string>& value) override;
+
+  private:
+  std::unique_ptr<yardl::hdf5::DatasetReader> images_dataset_state_;
+};
+
+// HDF5 writer for the ComplexImageStream protocol.
+// A protocol that is instantiated for each pixel type
+class ComplexImageStreamWriter : public test_model::ComplexImageStreamWriterBase, public yardl::hdf5::Hdf5Writer {
+  public:
+  ComplexImageStreamWriter(std::string path);
+
+  protected:
+  void WriteHeaderImpl(test_model::GenericRecord<int32_t, std::complex<float>> const& value) override;
+
+  void WriteImagesImpl(test_model::Image<std::complex<float>> const& value) override;
+
+  void WriteImagesImpl(std::vector<test_model::Image<std::complex<float>>> const& values) override;
+
+  void EndImagesImpl() override;
+
+  void WriteBackgroundImpl(std::variant<std::complex<float>, std::string> const& value) override;
+
+  private:
+  std::unique_ptr<yardl::hdf5::DatasetWriter> images_dataset_state_;
+};
+
+// HDF5 reader for the ComplexImageStream protocol.
+// A protocol that is instantiated for each pixel type
+class ComplexImageStreamReader : public test_model::ComplexImageStreamReaderBase, public yardl::hdf5::Hdf5Reader {
+  public:
+  ComplexImageStreamReader(std::string path, bool skip_completed_check=false);
+
+  void ReadHeaderImpl(test_model::GenericRecord<int32_t, std::complex<float>>& value) override;
+
+  bool ReadImagesImpl(test_model::Image<std::complex<float>>& value) override;
+
+  bool ReadImagesImpl(std::vector<test_model::Image<std::complex<float>>>& values) override;
+
+  void ReadBackgroundImpl(std::variant<std::complex<float>, std::string>& value) override;
+
+  private:
+  std::unique_ptr<yardl::hdf5::DatasetReader> images_dataset_state_;
+};
+
 // HDF5 writer for the Aliases protocol.
 class AliasesWriter : public test_model::AliasesWriterBase, public yardl::hdf5::Hdf5Writer {
   public:
@@ -1175,6 +1453,39 @@ class ProtocolWithComputedFieldsReader : public test_model::ProtocolWithComputed
   private:
 };
 
+// HDF5 writer for the ProtocolWithConstraints protocol.
+class ProtocolWithConstraintsWriter : public test_model::ProtocolWithConstraintsWriterBase, public yardl::hdf5::Hdf5Writer {
+  public:
+  ProtocolWithConstraintsWriter(std::string path);
+
+  protected:
+  void WriteRecordImpl(test_model::RecordWithConstraints const& value) override;
+
+  void WriteRecordsImpl(test_model::RecordWithConstrainedRecords const& value) override;
+
+  void WriteRecordsImpl(std::vector<test_model::RecordWithConstrainedRecords> const& values) override;
+
+  void EndRecordsImpl() override;
+
+  private:
+  std::unique_ptr<yardl::hdf5::DatasetWriter> records_dataset_state_;
+};
+
+// HDF5 reader for the ProtocolWithConstraints protocol.
+class ProtocolWithConstraintsReader : public test_model::ProtocolWithConstraintsReaderBase, public yardl::hdf5::Hdf5Reader {
+  public:
+  ProtocolWithConstraintsReader(std::string path, bool skip_completed_check=false);
+
+  void ReadRecordImpl(test_model::RecordWithConstraints& value) override;
+
+  bool ReadRecordsImpl(test_model::RecordWithConstrainedRecords& value) override;
+
+  bool ReadRecordsImpl(std::vector<test_model::RecordWithConstrainedRecords>& values) override;
+
+  private:
+  std::unique_ptr<yardl::hdf5::DatasetReader> records_dataset_state_;
+};
+
 // HDF5 writer for the ProtocolWithKeywordSteps protocol.
 class ProtocolWithKeywordStepsWriter : public test_model::ProtocolWithKeywordStepsWriterBase, public yardl::hdf5::Hdf5Writer {
   public:
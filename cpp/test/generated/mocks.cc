@@ -994,6 +994,828 @@ class TestStringsWriterBase : public StringsWriterBase {
   bool close_called_ = false;
 };
 
+class MockProtocolWithBytesWriter : public ProtocolWithBytesWriterBase {
+  public:
+  void WriteSingleBytesImpl (std::vector<std::byte> const& value) override {
+    if (WriteSingleBytesImpl_expected_values_.empty()) {
+      throw std::runtime_error("Unexpected call to WriteSingleBytesImpl");
+    }
+    if (WriteSingleBytesImpl_expected_values_.front() != value) {
+      throw std::runtime_error("Unexpected argument value for call to WriteSingleBytesImpl");
+    }
+    WriteSingleBytesImpl_expected_values_.pop();
+  }
+
+  std::queue<std::vector<std::byte>> WriteSingleBytesImpl_expected_values_;
+
+  void ExpectWriteSingleBytesImpl (std::vector<std::byte> const& value) {
+    WriteSingleBytesImpl_expected_values_.push(value);
+  }
+
+  void WriteRecWithBytesImpl (test_model::RecordWithBytes const& value) override {
+    if (WriteRecWithBytesImpl_expected_values_.empty()) {
+      throw std::runtime_error("Unexpected call to WriteRecWithBytesImpl");
+    }
+    if (WriteRecWithBytesImpl_expected_values_.front() != value) {
+      throw std::runtime_error("Unexpected argument value for call to WriteRecWithBytesImpl");
+    }
+    WriteRecWithBytesImpl_expected_values_.pop();
+  }
+
+  std::queue<test_model::RecordWithBytes> WriteRecWithBytesImpl_expected_values_;
+
+  void ExpectWriteRecWithBytesImpl (test_model::RecordWithBytes const& value) {
+    WriteRecWithBytesImpl_expected_values_.push(value);
+  }
+
+  void Verify() {
+    if (!WriteSingleBytesImpl_expected_values_.empty()) {
+      throw std::runtime_error("Expected call to WriteSingleBytesImpl was not received");
+    }
+    if (!WriteRecWithBytesImpl_expected_values_.empty()) {
+      throw std::runtime_error("Expected call to WriteRecWithBytesImpl was not received");
+    }
+  }
+};
+
+class TestProtocolWithBytesWriterBase : public ProtocolWithBytesWriterBase {
+  public:
+  TestProtocolWithBytesWriterBase(std::unique_ptr<test_model::ProtocolWithBytesWriterBase> writer, std::function<std::unique_ptr<ProtocolWithBytesReaderBase>()> create_reader) : writer_(std::move(writer)), create_reader_(create_reader) {
+  }
+
+  ~TestProtocolWithBytesWriterBase() {
+    if (!close_called_ && !std::uncaught_exceptions()) {
+      ADD_FAILURE() << "Close() needs to be called on 'TestProtocolWithBytesWriterBase' to verify mocks";
+    }
+  }
+
+  protected:
+  void WriteSingleBytesImpl(std::vector<std::byte> const& value) override {
+    writer_->WriteSingleBytes(value);
+    mock_writer_.ExpectWriteSingleBytesImpl(value);
+  }
+
+  void WriteRecWithBytesImpl(test_model::RecordWithBytes const& value) override {
+    writer_->WriteRecWithBytes(value);
+    mock_writer_.ExpectWriteRecWithBytesImpl(value);
+  }
+
+  void CloseImpl() override {
+    close_called_ = true;
+    writer_->Close();
+    std::unique_ptr<ProtocolWithBytesReaderBase> reader = create_reader_();
+    reader->CopyTo(mock_writer_);
+    mock_writer_.Verify();
+  }
+
+  private:
+  std::unique_ptr<test_model::ProtocolWithBytesWriterBase> writer_;
+  std::function<std::unique_ptr<test_model::ProtocolWithBytesReaderBase>()> create_reader_;
+  MockProtocolWithBytesWriter mock_writer_;
+  bool close_called_ = false;
+};
+
+class MockProtocolWithUuidsWriter : public ProtocolWithUuidsWriterBase {
+  public:
+  void WriteSingleUuidImpl (yardl::Uuid const& value) override {
+    if (WriteSingleUuidImpl_expected_values_.empty()) {
+      throw std::runtime_error("Unexpected call to WriteSingleUuidImpl");
+    }
+    if (WriteSingleUuidImpl_expected_values_.front() != value) {
+      throw std::runtime_error("Unexpected argument value for call to WriteSingleUuidImpl");
+    }
+    WriteSingleUuidImpl_expected_values_.pop();
+  }
+
+  std::queue<yardl::Uuid> WriteSingleUuidImpl_expected_values_;
+
+  void ExpectWriteSingleUuidImpl (yardl::Uuid const& value) {
+    WriteSingleUuidImpl_expected_values_.push(value);
+  }
+
+  void WriteRecWithUuidsImpl (test_model::RecordWithUuids const& value) override {
+    if (WriteRecWithUuidsImpl_expected_values_.empty()) {
+      throw std::runtime_error("Unexpected call to WriteRecWithUuidsImpl");
+    }
+    if (WriteRecWithUuidsImpl_expected_values_.front() != value) {
+      throw std::runtime_error("Unexpected argument value for call to WriteRecWithUuidsImpl");
+    }
+    WriteRecWithUuidsImpl_expected_values_.pop();
+  }
+
+  std::queue<test_model::RecordWithUuids> WriteRecWithUuidsImpl_expected_values_;
+
+  void ExpectWriteRecWithUuidsImpl (test_model::RecordWithUuids const& value) {
+    WriteRecWithUuidsImpl_expected_values_.push(value);
+  }
+
+  void Verify() {
+    if (!WriteSingleUuidImpl_expected_values_.empty()) {
+      throw std::runtime_error("Expected call to WriteSingleUuidImpl was not received");
+    }
+    if (!WriteRecWithUuidsImpl_expected_values_.empty()) {
+      throw std::runtime_error("Expected call to WriteRecWithUuidsImpl was not received");
+    }
+  }
+};
+
+class TestProtocolWithUuidsWriterBase : public ProtocolWithUuidsWriterBase {
+  public:
+  TestProtocolWithUuidsWriterBase(std::unique_ptr<test_model::ProtocolWithUuidsWriterBase> writer, std::function<std::unique_ptr<ProtocolWithUuidsReaderBase>()> create_reader) : writer_(std::move(writer)), create_reader_(create_reader) {
+  }
+
+  ~TestProtocolWithUuidsWriterBase() {
+    if (!close_called_ && !std::uncaught_exceptions()) {
+      ADD_FAILURE() << "Close() needs to be called on 'TestProtocolWithUuidsWriterBase' to verify mocks";
+    }
+  }
+
+  protected:
+  void WriteSingleUuidImpl(yardl::Uuid const& value) override {
+    writer_->WriteSingleUuid(value);
+    mock_writer_.ExpectWriteSingleUuidImpl(value);
+  }
+
+  void WriteRecWithUuidsImpl(test_model::RecordWithUuids const& value) override {
+    writer_->WriteRecWithUuids(value);
+    mock_writer_.ExpectWriteRecWithUuidsImpl(value);
+  }
+
+  void CloseImpl() override {
+    close_called_ = true;
+    writer_->Close();
+    std::unique_ptr<ProtocolWithUuidsReaderBase> reader = create_reader_();
+    reader->CopyTo(mock_writer_);
+    mock_writer_.Verify();
+  }
+
+  private:
+  std::unique_ptr<test_model::ProtocolWithUuidsWriterBase> writer_;
+  std::function<std::unique_ptr<test_model::ProtocolWithUuidsReaderBase>()> create_reader_;
+  MockProtocolWithUuidsWriter mock_writer_;
+  bool close_called_ = false;
+};
+
+class MockProtocolWithDurationsWriter : public ProtocolWithDurationsWriterBase {
+  public:
+  void WriteSingleDurationImpl (yardl::Duration const& value) override {
+    if (WriteSingleDurationImpl_expected_values_.empty()) {
+      throw std::runtime_error("Unexpected call to WriteSingleDurationImpl");
+    }
+    if (WriteSingleDurationImpl_expected_values_.front() != value) {
+      throw std::runtime_error("Unexpected argument value for call to WriteSingleDurationImpl");
+    }
+    WriteSingleDurationImpl_expected_values_.pop();
+  }
+
+  std::queue<yardl::Duration> WriteSingleDurationImpl_expected_values_;
+
+  void ExpectWriteSingleDurationImpl (yardl::Duration const& value) {
+    WriteSingleDurationImpl_expected_values_.push(value);
+  }
+
+  void WriteRecWithDurationsImpl (test_model::RecordWithDurations const& value) override {
+    if (WriteRecWithDurationsImpl_expected_values_.empty()) {
+      throw std::runtime_error("Unexpected call to WriteRecWithDurationsImpl");
+    }
+    if (WriteRecWithDurationsImpl_expected_values_.front() != value) {
+      throw std::runtime_error("Unexpected argument value for call to WriteRecWithDurationsImpl");
+    }
+    WriteRecWithDurationsImpl_expected_values_.pop();
+  }
+
+  std::queue<test_model::RecordWithDurations> WriteRecWithDurationsImpl_expected_values_;
+
+  void ExpectWriteRecWithDurationsImpl (test_model::RecordWithDurations const& value) {
+    WriteRecWithDurationsImpl_expected_values_.push(value);
+  }
+
+  void Verify() {
+    if (!WriteSingleDurationImpl_expected_values_.empty()) {
+      throw std::runtime_error("Expected call to WriteSingleDurationImpl was not received");
+    }
+    if (!WriteRecWithDurationsImpl_expected_values_.empty()) {
+      throw std::runtime_error("Expected call to WriteRecWithDurationsImpl was not received");
+    }
+  }
+};
+
+class TestProtocolWithDurationsWriterBase : public ProtocolWithDurationsWriterBase {
+  public:
+  TestProtocolWithDurationsWriterBase(std::unique_ptr<test_model::ProtocolWithDurationsWriterBase> writer, std::function<std::unique_ptr<ProtocolWithDurationsReaderBase>()> create_reader) : writer_(std::move(writer)), create_reader_(create_reader) {
+  }
+
+  ~TestProtocolWithDurationsWriterBase() {
+    if (!close_called_ && !std::uncaught_exceptions()) {
+      ADD_FAILURE() << "Close() needs to be called on 'TestProtocolWithDurationsWriterBase' to verify mocks";
+    }
+  }
+
+  protected:
+  void WriteSingleDurationImpl(yardl::Duration const& value) override {
+    writer_->WriteSingleDuration(value);
+    mock_writer_.ExpectWriteSingleDurationImpl(value);
+  }
+
+  void WriteRecWithDurationsImpl(test_model::RecordWithDurations const& value) override {
+    writer_->WriteRecWithDurations(value);
+    mock_writer_.ExpectWriteRecWithDurationsImpl(value);
+  }
+
+  void CloseImpl() override {
+    close_called_ = true;
+    writer_->Close();
+    std::unique_ptr<ProtocolWithDurationsReaderBase> reader = create_reader_();
+    reader->CopyTo(mock_writer_);
+    mock_writer_.Verify();
+  }
+
+  private:
+  std::unique_ptr<test_model::ProtocolWithDurationsWriterBase> writer_;
+  std::function<std::unique_ptr<test_model::ProtocolWithDurationsReaderBase>()> create_reader_;
+  MockProtocolWithDurationsWriter mock_writer_;
+  bool close_called_ = false;
+};
+
+class MockProtocolWithRecursiveRecordsWriter : public ProtocolWithRecursiveRecordsWriterBase {
+  public:
+  void WriteTreeImpl (test_model::TreeNode const& value) override {
+    if (WriteTreeImpl_expected_values_.empty()) {
+      throw std::runtime_error("Unexpected call to WriteTreeImpl");
+    }
+    if (WriteTreeImpl_expected_values_.front() != value) {
+      throw std::runtime_error("Unexpected argument value for call to WriteTreeImpl");
+    }
+    WriteTreeImpl_expected_values_.pop();
+  }
+
+  std::queue<test_model::TreeNode> WriteTreeImpl_expected_values_;
+
+  void ExpectWriteTreeImpl (test_model::TreeNode const& value) {
+    WriteTreeImpl_expected_values_.push(value);
+  }
+
+  void WriteListImpl (test_model::LinkedListNode const& value) override {
+    if (WriteListImpl_expected_values_.empty()) {
+      throw std::runtime_error("Unexpected call to WriteListImpl");
+    }
+    if (WriteListImpl_expected_values_.front() != value) {
+      throw std::runtime_error("Unexpected argument value for call to WriteListImpl");
+    }
+    WriteListImpl_expected_values_.pop();
+  }
+
+  std::queue<test_model::LinkedListNode> WriteListImpl_expected_values_;
+
+  void ExpectWriteListImpl (test_model::LinkedListNode const& value) {
+    WriteListImpl_expected_values_.push(value);
+  }
+
+  void WriteExpressionsImpl (test_model::Expression const& value) override {
+    if (WriteExpressionsImpl_expected_values_.empty()) {
+      throw std::runtime_error("Unexpected call to WriteExpressionsImpl");
+    }
+    if (WriteExpressionsImpl_expected_values_.front() != value) {
+      throw std::runtime_error("Unexpected argument value for call to WriteExpressionsImpl");
+    }
+    WriteExpressionsImpl_expected_values_.pop();
+  }
+
+  std::queue<test_model::Expression> WriteExpressionsImpl_expected_values_;
+
+  void ExpectWriteExpressionsImpl (test_model::Expression const& value) {
+    WriteExpressionsImpl_expected_values_.push(value);
+  }
+
+  void EndExpressionsImpl () override {
+    if (--EndExpressionsImpl_expected_call_count_ < 0) {
+      throw std::runtime_error("Unexpected call to EndExpressionsImpl");
+    }
+  }
+
+  int EndExpressionsImpl_expected_call_count_ = 0;
+
+  void ExpectEndExpressionsImpl () {
+    EndExpressionsImpl_expected_call_count_++;
+  }
+
+  void Verify() {
+    if (!WriteTreeImpl_expected_values_.empty()) {
+      throw std::runtime_error("Expected call to WriteTreeImpl was not received");
+    }
+    if (!WriteListImpl_expected_values_.empty()) {
+      throw std::runtime_error("Expected call to WriteListImpl was not received");
+    }
+    if (!WriteExpressionsImpl_expected_values_.empty()) {
+      throw std::runtime_error("Expected call to WriteExpressionsImpl was not received");
+    }
+    if (EndExpressionsImpl_expected_call_count_ > 0) {
+      throw std::runtime_error("Expected call to EndExpressionsImpl was not received");
+    }
+  }
+};
+
+class TestProtocolWithRecursiveRecordsWriterBase : public ProtocolWithRecursiveRecordsWriterBase {
+  public:
+  TestProtocolWithRecursiveRecordsWriterBase(std::unique_ptr<test_model::ProtocolWithRecursiveRecordsWriterBase> writer, std::function<std::unique_ptr<ProtocolWithRecursiveRecordsReaderBase>()> create_reader) : writer_(std::move(writer)), create_reader_(create_reader) {
+  }
+
+  ~TestProtocolWithRecursiveRecordsWriterBase() {
+    if (!close_called_ && !std::uncaught_exceptions()) {
+      ADD_FAILURE() << "Close() needs to be called on 'TestProtocolWithRecursiveRecordsWriterBase' to verify mocks";
+    }
+  }
+
+  protected:
+  void WriteTreeImpl(test_model::TreeNode const& value) override {
+    writer_->WriteTree(value);
+    mock_writer_.ExpectWriteTreeImpl(value);
+  }
+
+  void WriteListImpl(test_model::LinkedListNode const& value) override {
+    writer_->WriteList(value);
+    mock_writer_.ExpectWriteListImpl(value);
+  }
+
+  void WriteExpressionsImpl(test_model::Expression const& value) override {
+    writer_->WriteExpressions(value);
+    mock_writer_.ExpectWriteExpressionsImpl(value);
+  }
+
+  void WriteExpressionsImpl(std::vector<test_model::Expression> const& values) override {
+    writer_->WriteExpressions(values);
+    for (auto const& v : values) {
+      mock_writer_.ExpectWriteExpressionsImpl(v);
+    }
+  }
+
+  void EndExpressionsImpl() override {
+    writer_->EndExpressions();
+    mock_writer_.ExpectEndExpressionsImpl();
+  }
+
+  void CloseImpl() override {
+    close_called_ = true;
+    writer_->Close();
+    std::unique_ptr<ProtocolWithRecursiveRecordsReaderBase> reader = create_reader_();
+    reader->CopyTo(mock_writer_, 4);
+    mock_writer_.Verify();
+  }
+
+  private:
+  std::unique_ptr<test_model::ProtocolWithRecursiveRecordsWriterBase> writer_;
+  std::function<std::unique_ptr<test_model::ProtocolWithRecursiveRecordsReaderBase>()> create_reader_;
+  MockProtocolWithRecursiveRecordsWriter mock_writer_;
+  bool close_called_ = false;
+};
+
+class MockCalibrationWriter : public CalibrationWriterBase {
+  public:
+  void WriteGainImpl (float const& value) override {
+    if (WriteGainImpl_expected_values_.empty()) {
+      throw std::runtime_error("Unexpected call to WriteGainImpl");
+    }
+    if (WriteGainImpl_expected_values_.front() != value) {
+      throw std::runtime_error("Unexpected argument value for call to WriteGainImpl");
+    }
+    WriteGainImpl_expected_values_.pop();
+  }
+
+  std::queue<float> WriteGainImpl_expected_values_;
+
+  void ExpectWriteGainImpl (float const& value) {
+    WriteGainImpl_expected_values_.push(value);
+  }
+
+  void WriteSamplesImpl (double const& value) override {
+    if (WriteSamplesImpl_expected_values_.empty()) {
+      throw std::runtime_error("Unexpected call to WriteSamplesImpl");
+    }
+    if (WriteSamplesImpl_expected_values_.front() != value) {
+      throw std::runtime_error("Unexpected argument value for call to WriteSamplesImpl");
+    }
+    WriteSamplesImpl_expected_values_.pop();
+  }
+
+  std::queue<double> WriteSamplesImpl_expected_values_;
+
+  void ExpectWriteSamplesImpl (double const& value) {
+    WriteSamplesImpl_expected_values_.push(value);
+  }
+
+  void EndSamplesImpl () override {
+    if (--EndSamplesImpl_expected_call_count_ < 0) {
+      throw std::runtime_error("Unexpected call to EndSamplesImpl");
+    }
+  }
+
+  int EndSamplesImpl_expected_call_count_ = 0;
+
+  void ExpectEndSamplesImpl () {
+    EndSamplesImpl_expected_call_count_++;
+  }
+
+  void Verify() {
+    if (!WriteGainImpl_expected_values_.empty()) {
+      throw std::runtime_error("Expected call to WriteGainImpl was not received");
+    }
+    if (!WriteSamplesImpl_expected_values_.empty()) {
+      throw std::runtime_error("Expected call to WriteSamplesImpl was not received");
+    }
+    if (EndSamplesImpl_expected_call_count_ > 0) {
+      throw std::runtime_error("Expected call to EndSamplesImpl was not received");
+    }
+  }
+};
+
+class TestCalibrationWriterBase : public CalibrationWriterBase {
+  public:
+  TestCalibrationWriterBase(std::unique_ptr<test_model::CalibrationWriterBase> writer, std::function<std::unique_ptr<CalibrationReaderBase>()> create_reader) : writer_(std::move(writer)), create_reader_(create_reader) {
+  }
+
+  ~TestCalibrationWriterBase() {
+    if (!close_called_ && !std::uncaught_exceptions()) {
+      ADD_FAILURE() << "Close() needs to be called on 'TestCalibrationWriterBase' to verify mocks";
+    }
+  }
+
+  protected:
+  void WriteGainImpl(float const& value) override {
+    writer_->WriteGain(value);
+    mock_writer_.ExpectWriteGainImpl(value);
+  }
+
+  void WriteSamplesImpl(double const& value) override {
+    writer_->WriteSamples(value);
+    mock_writer_.ExpectWriteSamplesImpl(value);
+  }
+
+  void WriteSamplesImpl(std::vector<double> const& values) override {
+    writer_->WriteSamples(values);
+    for (auto const& v : values) {
+      mock_writer_.ExpectWriteSamplesImpl(v);
+    }
+  }
+
+  void EndSamplesImpl() override {
+    writer_->EndSamples();
+    mock_writer_.ExpectEndSamplesImpl();
+  }
+
+  void CloseImpl() override {
+    close_called_ = true;
+    writer_->Close();
+    std::unique_ptr<CalibrationReaderBase> reader = create_reader_();
+    reader->CopyTo(mock_writer_, 1);
+    mock_writer_.Verify();
+  }
+
+  private:
+  std::unique_ptr<test_model::CalibrationWriterBase> writer_;
+  std::function<std::unique_ptr<test_model::CalibrationReaderBase>()> create_reader_;
+  MockCalibrationWriter mock_writer_;
+  bool close_called_ = false;
+};
+
+class MockProtocolWithSubProtocolsWriter : public ProtocolWithSubProtocolsWriterBase {
+  public:
+  void WriteHeaderImpl (std::string const& value) override {
+    if (WriteHeaderImpl_expected_values_.empty()) {
+      throw std::runtime_error("Unexpected call to WriteHeaderImpl");
+    }
+    if (WriteHeaderImpl_expected_values_.front() != value) {
+      throw std::runtime_error("Unexpected argument value for call to WriteHeaderImpl");
+    }
+    WriteHeaderImpl_expected_values_.pop();
+  }
+
+  std::queue<std::string> WriteHeaderImpl_expected_values_;
+
+  void ExpectWriteHeaderImpl (std::string const& value) {
+    WriteHeaderImpl_expected_values_.push(value);
+  }
+
+  void WriteCalibrationGainImpl (float const& value) override {
+    if (WriteCalibrationGainImpl_expected_values_.empty()) {
+      throw std::runtime_error("Unexpected call to WriteCalibrationGainImpl");
+    }
+    if (WriteCalibrationGainImpl_expected_values_.front() != value) {
+      throw std::runtime_error("Unexpected argument value for call to WriteCalibrationGainImpl");
+    }
+    WriteCalibrationGainImpl_expected_values_.pop();
+  }
+
+  std::queue<float> WriteCalibrationGainImpl_expected_values_;
+
+  void ExpectWriteCalibrationGainImpl (float const& value) {
+    WriteCalibrationGainImpl_expected_values_.push(value);
+  }
+
+  void WriteCalibrationSamplesImpl (double const& value) override {
+    if (WriteCalibrationSamplesImpl_expected_values_.empty()) {
+      throw std::runtime_error("Unexpected call to WriteCalibrationSamplesImpl");
+    }
+    if (WriteCalibrationSamplesImpl_expected_values_.front() != value) {
+      throw std::runtime_error("Unexpected argument value for call to WriteCalibrationSamplesImpl");
+    }
+    WriteCalibrationSamplesImpl_expected_values_.pop();
+  }
+
+  std::queue<double> WriteCalibrationSamplesImpl_expected_values_;
+
+  void ExpectWriteCalibrationSamplesImpl (double const& value) {
+    WriteCalibrationSamplesImpl_expected_values_.push(value);
+  }
+
+  void EndCalibrationSamplesImpl () override {
+    if (--EndCalibrationSamplesImpl_expected_call_count_ < 0) {
+      throw std::runtime_error("Unexpected call to EndCalibrationSamplesImpl");
+    }
+  }
+
+  int EndCalibrationSamplesImpl_expected_call_count_ = 0;
+
+  void ExpectEndCalibrationSamplesImpl () {
+    EndCalibrationSamplesImpl_expected_call_count_++;
+  }
+
+  void WriteDataImpl (int32_t const& value) override {
+    if (WriteDataImpl_expected_values_.empty()) {
+      throw std::runtime_error("Unexpected call to WriteDataImpl");
+    }
+    if (WriteDataImpl_expected_values_.front() != value) {
+      throw std::runtime_error("Unexpected argument value for call to WriteDataImpl");
+    }
+    WriteDataImpl_expected_values_.pop();
+  }
+
+  std::queue<int32_t> WriteDataImpl_expected_values_;
+
+  void ExpectWriteDataImpl (int32_t const& value) {
+    WriteDataImpl_expected_values_.push(value);
+  }
+
+  void EndDataImpl () override {
+    if (--EndDataImpl_expected_call_count_ < 0) {
+      throw std::runtime_error("Unexpected call to EndDataImpl");
+    }
+  }
+
+  int EndDataImpl_expected_call_count_ = 0;
+
+  void ExpectEndDataImpl () {
+    EndDataImpl_expected_call_count_++;
+  }
+
+  void WriteRecalibrationGainImpl (float const& value) override {
+    if (WriteRecalibrationGainImpl_expected_values_.empty()) {
+      throw std::runtime_error("Unexpected call to WriteRecalibrationGainImpl");
+    }
+    if (WriteRecalibrationGainImpl_expected_values_.front() != value) {
+      throw std::runtime_error("Unexpected argument value for call to WriteRecalibrationGainImpl");
+    }
+    WriteRecalibrationGainImpl_expected_values_.pop();
+  }
+
+  std::queue<float> WriteRecalibrationGainImpl_expected_values_;
+
+  void ExpectWriteRecalibrationGainImpl (float const& value) {
+    WriteRecalibrationGainImpl_expected_values_.push(value);
+  }
+
+  void WriteRecalibrationSamplesImpl (double const& value) override {
+    if (WriteRecalibrationSamplesImpl_expected_values_.empty()) {
+      throw std::runtime_error("Unexpected call to WriteRecalibrationSamplesImpl");
+    }
+    if (WriteRecalibrationSamplesImpl_expected_values_.front() != value) {
+      throw std::runtime_error("Unexpected argument value for call to WriteRecalibrationSamplesImpl");
+    }
+    WriteRecalibrationSamplesImpl_expected_values_.pop();
+  }
+
+  std::queue<double> WriteRecalibrationSamplesImpl_expected_values_;
+
+  void ExpectWriteRecalibrationSamplesImpl (double const& value) {
+    WriteRecalibrationSamplesImpl_expected_values_.push(value);
+  }
+
+  void EndRecalibrationSamplesImpl () override {
+    if (--EndRecalibrationSamplesImpl_expected_call_count_ < 0) {
+      throw std::runtime_error("Unexpected call to EndRecalibrationSamplesImpl");
+    }
+  }
+
+  int EndRecalibrationSamplesImpl_expected_call_count_ = 0;
+
+  void ExpectEndRecalibrationSamplesImpl () {
+    EndRecalibrationSamplesImpl_expected_call_count_++;
+  }
+
+  void Verify() {
+    if (!WriteHeaderImpl_expected_values_.empty()) {
+      throw std::runtime_error("Expected call to WriteHeaderImpl was not received");
+    }
+    if (!WriteCalibrationGainImpl_expected_values_.empty()) {
+      throw std::runtime_error("Expected call to WriteCalibrationGainImpl was not received");
+    }
+    if (!WriteCalibrationSamplesImpl_expected_values_.empty()) {
+      throw std::runtime_error("Expected call to WriteCalibrationSamplesImpl was not received");
+    }
+    if (EndCalibrationSamplesImpl_expected_call_count_ > 0) {
+      throw std::runtime_error("Expected call to EndCalibrationSamplesImpl was not received");
+    }
+    if (!WriteDataImpl_expected_values_.empty()) {
+      throw std::runtime_error("Expected call to WriteDataImpl was not received");
+    }
+    if (EndDataImpl_expected_call_count_ > 0) {
+      throw std::runtime_error("Expected call to EndDataImpl was not received");
+    }
+    if (!WriteRecalibrationGainImpl_expected_values_.empty()) {
+      throw std::runtime_error("Expected call to WriteRecalibrationGainImpl was not received");
+    }
+    if (!WriteRecalibrationSamplesImpl_expected_values_.empty()) {
+      throw std::runtime_error("Expected call to WriteRecalibrationSamplesImpl was not received");
+    }
+    if (EndRecalibrationSamplesImpl_expected_call_count_ > 0) {
+      throw std::runtime_error("Expected call to EndRecalibrationSamplesImpl was not received");
+    }
+  }
+};
+
+class TestProtocolWithSubProtocolsWriterBase : public ProtocolWithSubProtocolsWriterBase {
+  public:
+  TestProtocolWithSubProtocolsWriterBase(std::unique_ptr<test_model::ProtocolWithSubProtocolsWriterBase> writer, std::function<std::unique_ptr<ProtocolWithSubProtocolsReaderBase>()> create_reader) : writer_(std::move(writer)), create_reader_(create_reader) {
+  }
+
+  ~TestProtocolWithSubProtocolsWriterBase() {
+    if (!close_called_ && !std::uncaught_exceptions()) {
+      ADD_FAILURE() << "Close() needs to be called on 'TestProtocolWithSubProtocolsWriterBase' to verify mocks";
+    }
+  }
+
+  protected:
+  void WriteHeaderImpl(std::string const& value) override {
+    writer_->WriteHeader(value);
+    mock_writer_.ExpectWriteHeaderImpl(value);
+  }
+
+  void WriteCalibrationGainImpl(float const& value) override {
+    writer_->WriteCalibrationGain(value);
+    mock_writer_.ExpectWriteCalibrationGainImpl(value);
+  }
+
+  void WriteCalibrationSamplesImpl(double const& value) override {
+    writer_->WriteCalibrationSamples(value);
+    mock_writer_.ExpectWriteCalibrationSamplesImpl(value);
+  }
+
+  void WriteCalibrationSamplesImpl(std::vector<double> const& values) override {
+    writer_->WriteCalibrationSamples(values);
+    for (auto const& v : values) {
+      mock_writer_.ExpectWriteCalibrationSamplesImpl(v);
+    }
+  }
+
+  void EndCalibrationSamplesImpl() override {
+    writer_->EndCalibrationSamples();
+    mock_writer_.ExpectEndCalibrationSamplesImpl();
+  }
+
+  void WriteDataImpl(int32_t const& value) override {
+    writer_->WriteData(value);
+    mock_writer_.ExpectWriteDataImpl(value);
+  }
+
+  void WriteDataImpl(std::vector<int32_t> const& values) override {
+    writer_->WriteData(values);
+    for (auto const& v : values) {
+      mock_writer_.ExpectWriteDataImpl(v);
+    }
+  }
+
+  void EndDataImpl() override {
+    writer_->EndData();
+    mock_writer_.ExpectEndDataImpl();
+  }
+
+  void WriteRecalibrationGainImpl(float const& value) override {
+    writer_->WriteRecalibrationGain(value);
+    mock_writer_.ExpectWriteRecalibrationGainImpl(value);
+  }
+
+  void WriteRecalibrationSamplesImpl(double const& value) override {
+    writer_->WriteRecalibrationSamples(value);
+    mock_writer_.ExpectWriteRecalibrationSamplesImpl(value);
+  }
+
+  void WriteRecalibrationSamplesImpl(std::vector<double> const& values) override {
+    writer_->WriteRecalibrationSamples(values);
+    for (auto const& v : values) {
+      mock_writer_.ExpectWriteRecalibrationSamplesImpl(v);
+    }
+  }
+
+  void EndRecalibrationSamplesImpl() override {
+    writer_->EndRecalibrationSamples();
+    mock_writer_.ExpectEndRecalibrationSamplesImpl();
+  }
+
+  void CloseImpl() override {
+    close_called_ = true;
+    writer_->Close();
+    std::unique_ptr<ProtocolWithSubProtocolsReaderBase> reader = create_reader_();
+    reader->CopyTo(mock_writer_, 4, 1, 1);
+    mock_writer_.Verify();
+  }
+
+  private:
+  std::unique_ptr<test_model::ProtocolWithSubProtocolsWriterBase> writer_;
+  std::function<std::unique_ptr<test_model::ProtocolWithSubProtocolsReaderBase>()> create_reader_;
+  MockProtocolWithSubProtocolsWriter mock_writer_;
+  bool close_called_ = false;
+};
+
+class MockProtocolWithHalfPrecisionWriter : public ProtocolWithHalfPrecisionWriterBase {
+  public:
+  void WriteHalvesImpl (std::vector<yardl::Float16> const& value) override {
+    if (WriteHalvesImpl_expected_values_.empty()) {
+      throw std::runtime_error("Unexpected call to WriteHalvesImpl");
+    }
+    if (WriteHalvesImpl_expected_values_.front() != value) {
+      throw std::runtime_error("Unexpected argument value for call to WriteHalvesImpl");
+    }
+    WriteHalvesImpl_expected_values_.pop();
+  }
+
+  std::queue<std::vector<yardl::Float16>> WriteHalvesImpl_expected_values_;
+
+  void ExpectWriteHalvesImpl (std::vector<yardl::Float16> const& value) {
+    WriteHalvesImpl_expected_values_.push(value);
+  }
+
+  void WriteRecWithHalvesImpl (test_model::RecordWithHalfPrecision const& value) override {
+    if (WriteRecWithHalvesImpl_expected_values_.empty()) {
+      throw std::runtime_error("Unexpected call to WriteRecWithHalvesImpl");
+    }
+    if (WriteRecWithHalvesImpl_expected_values_.front() != value) {
+      throw std::runtime_error("Unexpected argument value for call to WriteRecWithHalvesImpl");
+    }
+    WriteRecWithHalvesImpl_expected_values_.pop();
+  }
+
+  std::queue<test_model::RecordWithHalfPrecision> WriteRecWithHalvesImpl_expected_values_;
+
+  void ExpectWriteRecWithHalvesImpl (test_model::RecordWithHalfPrecision const& value) {
+    WriteRecWithHalvesImpl_expected_values_.push(value);
+  }
+
+  void Verify() {
+    if (!WriteHalvesImpl_expected_values_.empty()) {
+      throw std::runtime_error("Expected call to WriteHalvesImpl was not received");
+    }
+    if (!WriteRecWithHalvesImpl_expected_values_.empty()) {
+      throw std::runtime_error("Expected call to WriteRecWithHalvesImpl was not received");
+    }
+  }
+};
+
+class TestProtocolWithHalfPrecisionWriterBase : public ProtocolWithHalfPrecisionWriterBase {
+  public:
+  TestProtocolWithHalfPrecisionWriterBase(std::unique_ptr<test_model::ProtocolWithHalfPrecisionWriterBase> writer, std::function<std::unique_ptr<ProtocolWithHalfPrecisionReaderBase>()> create_reader) : writer_(std::move(writer)), create_reader_(create_reader) {
+  }
+
+  ~TestProtocolWithHalfPrecisionWriterBase() {
+    if (!close_called_ && !std::uncaught_exceptions()) {
+      ADD_FAILURE() << "Close() needs to be called on 'TestProtocolWithHalfPrecisionWriterBase' to verify mocks";
+    }
+  }
+
+  protected:
+  void WriteHalvesImpl(std::vector<yardl::Float16> const& value) override {
+    writer_->WriteHalves(value);
+    mock_writer_.ExpectWriteHalvesImpl(value);
+  }
+
+  void WriteRecWithHalvesImpl(test_model::RecordWithHalfPrecision const& value) override {
+    writer_->WriteRecWithHalves(value);
+    mock_writer_.ExpectWriteRecWithHalvesImpl(value);
+  }
+
+  void CloseImpl() override {
+    close_called_ = true;
+    writer_->Close();
+    std::unique_ptr<ProtocolWithHalfPrecisionReaderBase> reader = create_reader_();
+    reader->CopyTo(mock_writer_);
+    mock_writer_.Verify();
+  }
+
+  private:
+  std::unique_ptr<test_model::ProtocolWithHalfPrecisionWriterBase> writer_;
+  std::function<std::unique_ptr<test_model::ProtocolWithHalfPrecisionReaderBase>()> create_reader_;
+  MockProtocolWithHalfPrecisionWriter mock_writer_;
+  bool close_called_ = false;
+};
+
 class MockOptionalVectorsWriter : public OptionalVectorsWriterBase {
   public:
   void WriteRecordWithOptionalVectorImpl (test_model::RecordWithOptionalVector const& value) override {
@@ -3659,188 +4481,452 @@ class TestSimpleGenericsWriterBase : public SimpleGenericsWriterBase {
     mock_writer_.ExpectWriteIntStringTupleImpl(value);
   }
 
-  void WriteStreamOfTypeVariantsImpl(std::variant<image::FloatImage, test_model::Image<double>> const& value) override {
-    writer_->WriteStreamOfTypeVariants(value);
-    mock_writer_.ExpectWriteStreamOfTypeVariantsImpl(value);
+  void WriteStreamOfTypeVariantsImpl(std::variant<image::FloatImage, test_model::Image<double>> const& value) override {
+    writer_->WriteStreamOfTypeVariants(value);
+    mock_writer_.ExpectWriteStreamOfTypeVariantsImpl(value);
+  }
+
+  void WriteStreamOfTypeVariantsImpl(std::vector<std::variant<image::FloatImage, test_model::Image<double>>> const& values) override {
+    writer_->WriteStreamOfTypeVariants(values);
+    for (auto const& v : values) {
+      mock_writer_.ExpectWriteStreamOfTypeVariantsImpl(v);
+    }
+  }
+
+  void EndStreamOfTypeVariantsImpl() override {
+    writer_->EndStreamOfTypeVariants();
+    mock_writer_.ExpectEndStreamOfTypeVariantsImpl();
+  }
+
+  void CloseImpl() override {
+    close_called_ = true;
+    writer_->Close();
+    std::unique_ptr<SimpleGenericsReaderBase> reader = create_reader_();
+    reader->CopyTo(mock_writer_, 10);
+    mock_writer_.Verify();
+  }
+
+  private:
+  std::unique_ptr<test_model::SimpleGenericsWriterBase> writer_;
+  std::function<std::unique_ptr<test_model::SimpleGenericsReaderBase>()> create_reader_;
+  MockSimpleGenericsWriter mock_writer_;
+  bool close_called_ = false;
+};
+
+class MockAdvancedGenericsWriter : public AdvancedGenericsWriterBase {
+  public:
+  void WriteFloatImageImageImpl (test_model::Image<test_model::Image<float>> const& value) override {
+    if (WriteFloatImageImageImpl_expected_values_.empty()) {
+      throw std::runtime_error("Unexpected call to WriteFloatImageImageImpl");
+    }
+    if (WriteFloatImageImageImpl_expected_values_.front() != value) {
+      throw std::runtime_error("Unexpected argument value for call to WriteFloatImageImageImpl");
+    }
+    WriteFloatImageImageImpl_expected_values_.pop();
+  }
+
+  std::queue<test_model::Image<test_model::Image<float>>> WriteFloatImageImageImpl_expected_values_;
+
+  void ExpectWriteFloatImageImageImpl (test_model::Image<test_model::Image<float>> const& value) {
+    WriteFloatImageImageImpl_expected_values_.push(value);
+  }
+
+  void WriteGenericRecord1Impl (test_model::GenericRecord<int32_t, std::string> const& value) override {
+    if (WriteGenericRecord1Impl_expected_values_.empty()) {
+      throw std::runtime_error("Unexpected call to WriteGenericRecord1Impl");
+    }
+    if (WriteGenericRecord1Impl_expected_values_.front() != value) {
+      throw std::runtime_error("Unexpected argument value for call to WriteGenericRecord1Impl");
+    }
+    WriteGenericRecord1Impl_expected_values_.pop();
+  }
+
+  std::queue<test_model::GenericRecord<int32_t, std::string>> WriteGenericRecord1Impl_expected_values_;
+
+  void ExpectWriteGenericRecord1Impl (test_model::GenericRecord<int32_t, std::string> const& value) {
+    WriteGenericRecord1Impl_expected_values_.push(value);
+  }
+
+  void WriteTupleOfOptionalsImpl (test_model::MyTuple<std::optional<int32_t>, std::optional<std::string>> const& value) override {
+    if (WriteTupleOfOptionalsImpl_expected_values_.empty()) {
+      throw std::runtime_error("Unexpected call to WriteTupleOfOptionalsImpl");
+    }
+    if (WriteTupleOfOptionalsImpl_expected_values_.front() != value) {
+      throw std::runtime_error("Unexpected argument value for call to WriteTupleOfOptionalsImpl");
+    }
+    WriteTupleOfOptionalsImpl_expected_values_.pop();
+  }
+
+  std::queue<test_model::MyTuple<std::optional<int32_t>, std::optional<std::string>>> WriteTupleOfOptionalsImpl_expected_values_;
+
+  void ExpectWriteTupleOfOptionalsImpl (test_model::MyTuple<std::optional<int32_t>, std::optional<std::string>> const& value) {
+    WriteTupleOfOptionalsImpl_expected_values_.push(value);
+  }
+
+  void WriteTupleOfOptionalsAlternateSyntaxImpl (test_model::MyTuple<std::optional<int32_t>, std::optional<std::string>> const& value) override {
+    if (WriteTupleOfOptionalsAlternateSyntaxImpl_expected_values_.empty()) {
+      throw std::runtime_error("Unexpected call to WriteTupleOfOptionalsAlternateSyntaxImpl");
+    }
+    if (WriteTupleOfOptionalsAlternateSyntaxImpl_expected_values_.front() != value) {
+      throw std::runtime_error("Unexpected argument value for call to WriteTupleOfOptionalsAlternateSyntaxImpl");
+    }
+    WriteTupleOfOptionalsAlternateSyntaxImpl_expected_values_.pop();
+  }
+
+  std::queue<test_model::MyTuple<std::optional<int32_t>, std::optional<std::string>>> WriteTupleOfOptionalsAlternateSyntaxImpl_expected_values_;
+
+  void ExpectWriteTupleOfOptionalsAlternateSyntaxImpl (test_model::MyTuple<std::optional<int32_t>, std::optional<std::string>> const& value) {
+    WriteTupleOfOptionalsAlternateSyntaxImpl_expected_values_.push(value);
+  }
+
+  void WriteTupleOfVectorsImpl (test_model::MyTuple<std::vector<int32_t>, std::vector<float>> const& value) override {
+    if (WriteTupleOfVectorsImpl_expected_values_.empty()) {
+      throw std::runtime_error("Unexpected call to WriteTupleOfVectorsImpl");
+    }
+    if (WriteTupleOfVectorsImpl_expected_values_.front() != value) {
+      throw std::runtime_error("Unexpected argument value for call to WriteTupleOfVectorsImpl");
+    }
+    WriteTupleOfVectorsImpl_expected_values_.pop();
+  }
+
+  std::queue<test_model::MyTuple<std::vector<int32_t>, std::vector<float>>> WriteTupleOfVectorsImpl_expected_values_;
+
+  void ExpectWriteTupleOfVectorsImpl (test_model::MyTuple<std::vector<int32_t>, std::vector<float>> const& value) {
+    WriteTupleOfVectorsImpl_expected_values_.push(value);
+  }
+
+  void Verify() {
+    if (!WriteFloatImageImageImpl_expected_values_.empty()) {
+      throw std::runtime_error("Expected call to WriteFloatImageImageImpl was not received");
+    }
+    if (!WriteGenericRecord1Impl_expected_values_.empty()) {
+      throw std::runtime_error("Expected call to WriteGenericRecord1Impl was not received");
+    }
+    if (!WriteTupleOfOptionalsImpl_expected_values_.empty()) {
+      throw std::runtime_error("Expected call to WriteTupleOfOptionalsImpl was not received");
+    }
+    if (!WriteTupleOfOptionalsAlternateSyntaxImpl_expected_values_.empty()) {
+      throw std::runtime_error("Expected call to WriteTupleOfOptionalsAlternateSyntaxImpl was not received");
+    }
+    if (!WriteTupleOfVectorsImpl_expected_values_.empty()) {
+      throw std::runtime_error("Expected call to WriteTupleOfVectorsImpl was not received");
+    }
+  }
+};
+
+class TestAdvancedGenericsWriterBase : public AdvancedGenericsWriterBase {
+  public:
+  TestAdvancedGenericsWriterBase(std::unique_ptr<test_model::AdvancedGenericsWriterBase> writer, std::function<std::unique_ptr<AdvancedGenericsReaderBase>()> create_reader) : writer_(std::move(writer)), create_reader_(create_reader) {
+  }
+
+  ~TestAdvancedGenericsWriterBase() {
+    if (!close_called_ && !std::uncaught_exceptions()) {
+      ADD_FAILURE() << "Close() needs to be called on 'TestAdvancedGenericsWriterBase' to verify mocks";
+    }
+  }
+
+  protected:
+  void WriteFloatImageImageImpl(test_model::Image<test_model::Image<float>> const& value) override {
+    writer_->WriteFloatImageImage(value);
+    mock_writer_.ExpectWriteFloatImageImageImpl(value);
+  }
+
+  void WriteGenericRecord1Impl(test_model::GenericRecord<int32_t, std::string> const& value) override {
+    writer_->WriteGenericRecord1(value);
+    mock_writer_.ExpectWriteGenericRecord1Impl(value);
+  }
+
+  void WriteTupleOfOptionalsImpl(test_model::MyTuple<std::optional<int32_t>, std::optional<std::string>> const& value) override {
+    writer_->WriteTupleOfOptionals(value);
+    mock_writer_.ExpectWriteTupleOfOptionalsImpl(value);
+  }
+
+  void WriteTupleOfOptionalsAlternateSyntaxImpl(test_model::MyTuple<std::optional<int32_t>, std::optional<std::string>> const& value) override {
+    writer_->WriteTupleOfOptionalsAlternateSyntax(value);
+    mock_writer_.ExpectWriteTupleOfOptionalsAlternateSyntaxImpl(value);
+  }
+
+  void WriteTupleOfVectorsImpl(test_model::MyTuple<std::vector<int32_t>, std::vector<float>> const& value) override {
+    writer_->WriteTupleOfVectors(value);
+    mock_writer_.ExpectWriteTupleOfVectorsImpl(value);
+  }
+
+  void CloseImpl() override {
+    close_called_ = true;
+    writer_->Close();
+    std::unique_ptr<AdvancedGenericsReaderBase> reader = create_reader_();
+    reader->CopyTo(mock_writer_);
+    mock_writer_.Verify();
+  }
+
+  private:
+  std::unique_ptr<test_model::AdvancedGenericsWriterBase> writer_;
+  std::function<std::unique_ptr<test_model::AdvancedGenericsReaderBase>()> create_reader_;
+  MockAdvancedGenericsWriter mock_writer_;
+  bool close_called_ = false;
+};
+
+class MockFloatImageStreamWriter : public FloatImageStreamWriterBase {
+  public:
+  void WriteHeaderImpl (test_model::GenericRecord<int32_t, float> const& value) override {
+    if (WriteHeaderImpl_expected_values_.empty()) {
+      throw std::runtime_error("Unexpected call to WriteHeaderImpl");
+    }
+    if (WriteHeaderImpl_expected_values_.front() != value) {
+      throw std::runtime_error("Unexpected argument value for call to WriteHeaderImpl");
+    }
+    WriteHeaderImpl_expected_values_.pop();
+  }
+
+  std::queue<test_model::GenericRecord<int32_t, float>> WriteHeaderImpl_expected_values_;
+
+  void ExpectWriteHeaderImpl (test_model::GenericRecord<int32_t, float> const& value) {
+    WriteHeaderImpl_expected_values_.push(value);
+  }
+
+  void WriteImagesImpl (test_model::Image<float> const& value) override {
+    if (WriteImagesImpl_expected_values_.empty()) {
+      throw std::runtime_error("Unexpected call to WriteImagesImpl");
+    }
+    if (WriteImagesImpl_expected_values_.front() != value) {
+      throw std::runtime_error("Unexpected argument value for call to WriteImagesImpl");
+    }
+    WriteImagesImpl_expected_values_.pop();
+  }
+
+  std::queue<test_model::Image<float>> WriteImagesImpl_expected_values_;
+
+  void ExpectWriteImagesImpl (test_model::Image<float> const& value) {
+    WriteImagesImpl_expected_values_.push(value);
+  }
+
+  void EndImagesImpl () override {
+    if (--EndImagesImpl_expected_call_count_ < 0) {
+      throw std::runtime_error("Unexpected call to EndImagesImpl");
+    }
+  }
+
+  int EndImagesImpl_expected_call_count_ = 0;
+
+  void ExpectEndImagesImpl () {
+    EndImagesImpl_expected_call_count_++;
+  }
+
+  void WriteBackgroundImpl (std::variant<float, std::string> const& value) override {
+    if (WriteBackgroundImpl_expected_values_.empty()) {
+      throw std::runtime_error("Unexpected call to WriteBackgroundImpl");
+    }
+    if (WriteBackgroundImpl_expected_values_.front() != value) {
+      throw std::runtime_error("Unexpected argument value for call to WriteBackgroundImpl");
+    }
+    WriteBackgroundImpl_expected_values_.pop();
+  }
+
+  std::queue<std::variant<float, std::string>> WriteBackgroundImpl_expected_values_;
+
+  void ExpectWriteBackgroundImpl (std::variant<float, std::string> const& value) {
+    WriteBackgroundImpl_expected_values_.push(value);
+  }
+
+  void Verify() {
+    if (!WriteHeaderImpl_expected_values_.empty()) {
+      throw std::runtime_error("Expected call to WriteHeaderImpl was not received");
+    }
+    if (!WriteImagesImpl_expected_values_.empty()) {
+      throw std::runtime_error("Expected call to WriteImagesImpl was not received");
+    }
+    if (EndImagesImpl_expected_call_count_ > 0) {
+      throw std::runtime_error("Expected call to EndImagesImpl was not received");
+    }
+    if (!WriteBackgroundImpl_expected_values_.empty()) {
+      throw std::runtime_error("Expected call to WriteBackgroundImpl was not received");
+    }
+  }
+};
+
+class TestFloatImageStreamWriterBase : public FloatImageStreamWriterBase {
+  public:
+  TestFloatImageStreamWriterBase(std::unique_ptr<test_model::FloatImageStreamWriterBase> writer, std::function<std::unique_ptr<FloatImageStreamReaderBase>()> create_reader) : writer_(std::move(writer)), create_reader_(create_reader) {
+  }
+
+  ~TestFloatImageStreamWriterBase() {
+    if (!close_called_ && !std::uncaught_exceptions()) {
+      ADD_FAILURE() << "Close() needs to be called on 'TestFloatImageStreamWriterBase' to verify mocks";
+    }
+  }
+
+  protected:
+  void WriteHeaderImpl(test_model::GenericRecord<int32_t, float> const& value) override {
+    writer_->WriteHeader(value);
+    mock_writer_.ExpectWriteHeaderImpl(value);
+  }
+
+  void WriteImagesImpl(test_model::Image<float> const& value) override {
+    writer_->WriteImages(value);
+    mock_writer_.ExpectWriteImagesImpl(value);
   }
 
-  void WriteStreamOfTypeVariantsImpl(std::vector<std::variant<image::FloatImage, test_model::Image<double>>> const& values) override {
-    writer_->WriteStreamOfTypeVariants(values);
+  void WriteImagesImpl(std::vector<test_model::Image<float>> const& values) override {
+    writer_->WriteImages(values);
     for (auto const& v : values) {
-      mock_writer_.ExpectWriteStreamOfTypeVariantsImpl(v);
+      mock_writer_.ExpectWriteImagesImpl(v);
     }
   }
 
-  void EndStreamOfTypeVariantsImpl() override {
-    writer_->EndStreamOfTypeVariants();
-    mock_writer_.ExpectEndStreamOfTypeVariantsImpl();
+  void EndImagesImpl() override {
+    writer_->EndImages();
+    mock_writer_.ExpectEndImagesImpl();
+  }
+
+  void WriteBackgroundImpl(std::variant<float, std::string> const& value) override {
+    writer_->WriteBackground(value);
+    mock_writer_.ExpectWriteBackgroundImpl(value);
   }
 
   void CloseImpl() override {
     close_called_ = true;
     writer_->Close();
-    std::unique_ptr<SimpleGenericsReaderBase> reader = create_reader_();
-    reader->CopyTo(mock_writer_, 10);
+    std::unique_ptr<FloatImageStreamReaderBase> reader = create_reader_();
+    reader->CopyTo(mock_writer_, 1);
     mock_writer_.Verify();
   }
 
   private:
-  std::unique_ptr<test_model::SimpleGenericsWriterBase> writer_;
-  std::function<std::unique_ptr<test_model::SimpleGenericsReaderBase>()> create_reader_;
-  MockSimpleGenericsWriter mock_writer_;
+  std::unique_ptr<test_model::FloatImageStreamWriterBase> writer_;
+  std::function<std::unique_ptr<test_model::FloatImageStreamReaderBase>()> create_reader_;
+  MockFloatImageStreamWriter mock_writer_;
   bool close_called_ = false;
 };
 
-class MockAdvancedGenericsWriter : public AdvancedGenericsWriterBase {
+class MockComplexImageStreamWriter : public ComplexImageStreamWriterBase {
   public:
-  void WriteFloatImageImageImpl (test_model::Image<test_model::Image<float>> const& value) override {
-    if (WriteFloatImageImageImpl_expected_values_.empty()) {
-      throw std::runtime_error("Unexpected call to WriteFloatImageImageImpl");
-    }
-    if (WriteFloatImageImageImpl_expected_values_.front() != value) {
-      throw std::runtime_error("Unexpected argument value for call to WriteFloatImageImageImpl");
-    }
-    WriteFloatImageImageImpl_expected_values_.pop();
-  }
-
-  std::queue<test_model::Image<test_model::Image<float>>> WriteFloatImageImageImpl_expected_values_;
-
-  void ExpectWriteFloatImageImageImpl (test_model::Image<test_model::Image<float>> const& value) {
-    WriteFloatImageImageImpl_expected_values_.push(value);
-  }
-
-  void WriteGenericRecord1Impl (test_model::GenericRecord<int32_t, std::string> const& value) override {
-    if (WriteGenericRecord1Impl_expected_values_.empty()) {
-      throw std::runtime_error("Unexpected call to WriteGenericRecord1Impl");
+  void WriteHeaderImpl (test_model::GenericRecord<int32_t, std::complex<float>> const& value) override {
+    if (WriteHeaderImpl_expected_values_.empty()) {
+      throw std::runtime_error("Unexpected call to WriteHeaderImpl");
     }
-    if (WriteGenericRecord1Impl_expected_values_.front() != value) {
-      throw std::runtime_error("Unexpected argument value for call to WriteGenericRecord1Impl");
+    if (WriteHeaderImpl_expected_values_.front() != value) {
+      throw std::runtime_error("Unexpected argument value for call to WriteHeaderImpl");
     }
-    WriteGenericRecord1Impl_expected_values_.pop();
+    WriteHeaderImpl_expected_values_.pop();
   }
 
-  std::queue<test_model::GenericRecord<int32_t, std::string>> WriteGenericRecord1Impl_expected_values_;
+  std::queue<test_model::GenericRecord<int32_t, std::complex<float>>> WriteHeaderImpl_expected_values_;
 
-  void ExpectWriteGenericRecord1Impl (test_model::GenericRecord<int32_t, std::string> const& value) {
-    WriteGenericRecord1Impl_expected_values_.push(value);
+  void ExpectWriteHeaderImpl (test_model::GenericRecord<int32_t, std::complex<float>> const& value) {
+    WriteHeaderImpl_expected_values_.push(value);
   }
 
-  void WriteTupleOfOptionalsImpl (test_model::MyTuple<std::optional<int32_t>, std::optional<std::string>> const& value) override {
-    if (WriteTupleOfOptionalsImpl_expected_values_.empty()) {
-      throw std::runtime_error("Unexpected call to WriteTupleOfOptionalsImpl");
+  void WriteImagesImpl (test_model::Image<std::complex<float>> const& value) override {
+    if (WriteImagesImpl_expected_values_.empty()) {
+      throw std::runtime_error("Unexpected call to WriteImagesImpl");
     }
-    if (WriteTupleOfOptionalsImpl_expected_values_.front() != value) {
-      throw std::runtime_error("Unexpected argument value for call to WriteTupleOfOptionalsImpl");
+    if (WriteImagesImpl_expected_values_.front() != value) {
+      throw std::runtime_error("Unexpected argument value for call to WriteImagesImpl");
     }
-    WriteTupleOfOptionalsImpl_expected_values_.pop();
+    WriteImagesImpl_expected_values_.pop();
   }
 
-  std::queue<test_model::MyTuple<std::optional<int32_t>, std::optional<std::string>>> WriteTupleOfOptionalsImpl_expected_values_;
+  std::queue<test_model::Image<std::complex<float>>> WriteImagesImpl_expected_values_;
 
-  void ExpectWriteTupleOfOptionalsImpl (test_model::MyTuple<std::optional<int32_t>, std::optional<std::string>> const& value) {
-    WriteTupleOfOptionalsImpl_expected_values_.push(value);
+  void ExpectWriteImagesImpl (test_model::Image<std::complex<float>> const& value) {
+    WriteImagesImpl_expected_values_.push(value);
   }
 
-  void WriteTupleOfOptionalsAlternateSyntaxImpl (test_model::MyTuple<std::optional<int32_t>, std::optional<std::string>> const& value) override {
-    if (WriteTupleOfOptionalsAlternateSyntaxImpl_expected_values_.empty()) {
-      throw std::runtime_error("Unexpected call to WriteTupleOfOptionalsAlternateSyntaxImpl");
-    }
-    if (WriteTupleOfOptionalsAlternateSyntaxImpl_expected_values_.front() != value) {
-      throw std::runtime_error("Unexpected argument value for call to WriteTupleOfOptionalsAlternateSyntaxImpl");
+  void EndImagesImpl () override {
+    if (--EndImagesImpl_expected_call_count_ < 0) {
+      throw std::runtime_error("Unexpected call to EndImagesImpl");
     }
-    WriteTupleOfOptionalsAlternateSyntaxImpl_expected_values_.pop();
   }
 
-  std::queue<test_model::MyTuple<std::optional<int32_t>, std::optional<std::string>>> WriteTupleOfOptionalsAlternateSyntaxImpl_expected_values_;
+  int EndImagesImpl_expected_call_count_ = 0;
 
-  void ExpectWriteTupleOfOptionalsAlternateSyntaxImpl (test_model::MyTuple<std::optional<int32_t>, std::optional<std::string>> const& value) {
-    WriteTupleOfOptionalsAlternateSyntaxImpl_expected_values_.push(value);
+  void ExpectEndImagesImpl () {
+    EndImagesImpl_expected_call_count_++;
   }
 
-  void WriteTupleOfVectorsImpl (test_model::MyTuple<std::vector<int32_t>, std::vector<float>> const& value) override {
-    if (WriteTupleOfVectorsImpl_expected_values_.empty()) {
-      throw std::runtime_error("Unexpected call to WriteTupleOfVectorsImpl");
+  void WriteBackgroundImpl (std::variant<std::complex<float>, std::string> const& value) override {
+    if (WriteBackgroundImpl_expected_values_.empty()) {
+      throw std::runtime_error("Unexpected call to WriteBackgroundImpl");
     }
-    if (WriteTupleOfVectorsImpl_expected_values_.front() != value) {
-      throw std::runtime_error("Unexpected argument value for call to WriteTupleOfVectorsImpl");
+    if (WriteBackgroundImpl_expected_values_.front() != value) {
+      throw std::runtime_error("Unexpected argument value for call to WriteBackgroundImpl");
     }
-    WriteTupleOfVectorsImpl_expected_values_.pop();
+    WriteBackgroundImpl_expected_values_.pop();
   }
 
-  std::queue<test_model::MyTuple<std::vector<int32_t>, std::vector<float>>> WriteTupleOfVectorsImpl_expected_values_;
+  std::queue<std::variant<std::complex<float>, std::string>> WriteBackgroundImpl_expected_values_;
 
-  void ExpectWriteTupleOfVectorsImpl (test_model::MyTuple<std::vector<int32_t>, std::vector<float>> const& value) {
-    WriteTupleOfVectorsImpl_expected_values_.push(value);
+  void ExpectWriteBackgroundImpl (std::variant<std::complex<float>, std::string> const& value) {
+    WriteBackgroundImpl_expected_values_.push(value);
   }
 
   void Verify() {
-    if (!WriteFloatImageImageImpl_expected_values_.empty()) {
-      throw std::runtime_error("Expected call to WriteFloatImageImageImpl was not received");
+    if (!WriteHeaderImpl_expected_values_.empty()) {
+      throw std::runtime_error("Expected call to WriteHeaderImpl was not received");
     }
-    if (!WriteGenericRecord1Impl_expected_values_.empty()) {
-      throw std::runtime_error("Expected call to WriteGenericRecord1Impl was not received");
-    }
-    if (!WriteTupleOfOptionalsImpl_expected_values_.empty()) {
-      throw std::runtime_error("Expected call to WriteTupleOfOptionalsImpl was not received");
+    if (!WriteImagesImpl_expected_values_.empty()) {
+      throw std::runtime_error("Expected call to WriteImagesImpl was not received");
     }
-    if (!WriteTupleOfOptionalsAlternateSyntaxImpl_expected_values_.empty()) {
-      throw std::runtime_error("Expected call to WriteTupleOfOptionalsAlternateSyntaxImpl was not received");
+    if (EndImagesImpl_expected_call_count_ > 0) {
+      throw std::runtime_error("Expected call to EndImagesImpl was not received");
     }
-    if (!WriteTupleOfVectorsImpl_expected_values_.empty()) {
-      throw std::runtime_error("Expected call to WriteTupleOfVectorsImpl was not received");
+    if (!WriteBackgroundImpl_expected_values_.empty()) {
+      throw std::runtime_error("Expected call to WriteBackgroundImpl was not received");
     }
   }
 };
 
-class TestAdvancedGenericsWriterBase : public AdvancedGenericsWriterBase {
+class TestComplexImageStreamWriterBase : public ComplexImageStreamWriterBase {
   public:
-  TestAdvancedGenericsWriterBase(std::unique_ptr<test_model::AdvancedGenericsWriterBase> writer, std::function<std::unique_ptr<AdvancedGenericsReaderBase>()> create_reader) : writer_(std::move(writer)), create_reader_(create_reader) {
+  TestComplexImageStreamWriterBase(std::unique_ptr<test_model::ComplexImageStreamWriterBase> writer, std::function<std::unique_ptr<ComplexImageStreamReaderBase>()> create_reader) : writer_(std::move(writer)), create_reader_(create_reader) {
   }
 
-  ~TestAdvancedGenericsWriterBase() {
+  ~TestComplexImageStreamWriterBase() {
     if (!close_called_ && !std::uncaught_exceptions()) {
-      ADD_FAILURE() << "Close() needs to be called on 'TestAdvancedGenericsWriterBase' to verify mocks";
+      ADD_FAILURE() << "Close() needs to be called on 'TestComplexImageStreamWriterBase' to verify mocks";
     }
   }
 
   protected:
-  void WriteFloatImageImageImpl(test_model::Image<test_model::Image<float>> const& value) override {
-    writer_->WriteFloatImageImage(value);
-    mock_writer_.ExpectWriteFloatImageImageImpl(value);
+  void WriteHeaderImpl(test_model::GenericRecord<int32_t, std::complex<float>> const& value) override {
+    writer_->WriteHeader(value);
+    mock_writer_.ExpectWriteHeaderImpl(value);
   }
 
-  void WriteGenericRecord1Impl(test_model::GenericRecord<int32_t, std::string> const& value) override {
-    writer_->WriteGenericRecord1(value);
-    mock_writer_.ExpectWriteGenericRecord1Impl(value);
+  void WriteImagesImpl(test_model::Image<std::complex<float>> const& value) override {
+    writer_->WriteImages(value);
+    mock_writer_.ExpectWriteImagesImpl(value);
   }
 
-  void WriteTupleOfOptionalsImpl(test_model::MyTuple<std::optional<int32_t>, std::optional<std::string>> const& value) override {
-    writer_->WriteTupleOfOptionals(value);
-    mock_writer_.ExpectWriteTupleOfOptionalsImpl(value);
+  void WriteImagesImpl(std::vector<test_model::Image<std::complex<float>>> const& values) override {
+    writer_->WriteImages(values);
+    for (auto const& v : values) {
+      mock_writer_.ExpectWriteImagesImpl(v);
+    }
   }
 
-  void WriteTupleOfOptionalsAlternateSyntaxImpl(test_model::MyTuple<std::optional<int32_t>, std::optional<std::string>> const& value) override {
-    writer_->WriteTupleOfOptionalsAlternateSyntax(value);
-    mock_writer_.ExpectWriteTupleOfOptionalsAlternateSyntaxImpl(value);
+  void EndImagesImpl() override {
+    writer_->EndImages();
+    mock_writer_.ExpectEndImagesImpl();
   }
 
-  void WriteTupleOfVectorsImpl(test_model::MyTuple<std::vector<int32_t>, std::vector<float>> const& value) override {
-    writer_->WriteTupleOfVectors(value);
-    mock_writer_.ExpectWriteTupleOfVectorsImpl(value);
+  void WriteBackgroundImpl(std::variant<std::complex<float>, std::string> const& value) override {
+    writer_->WriteBackground(value);
+    mock_writer_.ExpectWriteBackgroundImpl(value);
   }
 
   void CloseImpl() override {
     close_called_ = true;
     writer_->Close();
-    std::unique_ptr<AdvancedGenericsReaderBase> reader = create_reader_();
-    reader->CopyTo(mock_writer_);
+    std::unique_ptr<ComplexImageStreamReaderBase> reader = create_reader_();
+    reader->CopyTo(mock_writer_, 1);
     mock_writer_.Verify();
   }
 
   private:
-  std::unique_ptr<test_model::AdvancedGenericsWriterBase> writer_;
-  std::function<std::unique_ptr<test_model::AdvancedGenericsReaderBase>()> create_reader_;
-  MockAdvancedGenericsWriter mock_writer_;
+  std::unique_ptr<test_model::ComplexImageStreamWriterBase> writer_;
+  std::function<std::unique_ptr<test_model::ComplexImageStreamReaderBase>()> create_reader_;
+  MockComplexImageStreamWriter mock_writer_;
   bool close_called_ = false;
 };
 
@@ -4360,6 +5446,114 @@ class TestProtocolWithComputedFieldsWriterBase : public ProtocolWithComputedFiel
   bool close_called_ = false;
 };
 
+class MockProtocolWithConstraintsWriter : public ProtocolWithConstraintsWriterBase {
+  public:
+  void WriteRecordImpl (test_model::RecordWithConstraints const& value) override {
+    if (WriteRecordImpl_expected_values_.empty()) {
+      throw std::runtime_error("Unexpected call to WriteRecordImpl");
+    }
+    if (WriteRecordImpl_expected_values_.front() != value) {
+      throw std::runtime_error("Unexpected argument value for call to WriteRecordImpl");
+    }
+    WriteRecordImpl_expected_values_.pop();
+  }
+
+  std::queue<test_model::RecordWithConstraints> WriteRecordImpl_expected_values_;
+
+  void ExpectWriteRecordImpl (test_model::RecordWithConstraints const& value) {
+    WriteRecordImpl_expected_values_.push(value);
+  }
+
+  void WriteRecordsImpl (test_model::RecordWithConstrainedRecords const& value) override {
+    if (WriteRecordsImpl_expected_values_.empty()) {
+      throw std::runtime_error("Unexpected call to WriteRecordsImpl");
+    }
+    if (WriteRecordsImpl_expected_values_.front() != value) {
+      throw std::runtime_error("Unexpected argument value for call to WriteRecordsImpl");
+    }
+    WriteRecordsImpl_expected_values_.pop();
+  }
+
+  std::queue<test_model::RecordWithConstrainedRecords> WriteRecordsImpl_expected_values_;
+
+  void ExpectWriteRecordsImpl (test_model::RecordWithConstrainedRecords const& value) {
+    WriteRecordsImpl_expected_values_.push(value);
+  }
+
+  void EndRecordsImpl () override {
+    if (--EndRecordsImpl_expected_call_count_ < 0) {
+      throw std::runtime_error("Unexpected call to EndRecordsImpl");
+    }
+  }
+
+  int EndRecordsImpl_expected_call_count_ = 0;
+
+  void ExpectEndRecordsImpl () {
+    EndRecordsImpl_expected_call_count_++;
+  }
+
+  void Verify() {
+    if (!WriteRecordImpl_expected_values_.empty()) {
+      throw std::runtime_error("Expected call to WriteRecordImpl was not received");
+    }
+    if (!WriteRecordsImpl_expected_values_.empty()) {
+      throw std::runtime_error("Expected call to WriteRecordsImpl was not received");
+    }
+    if (EndRecordsImpl_expected_call_count_ > 0) {
+      throw std::runtime_error("Expected call to EndRecordsImpl was not received");
+    }
+  }
+};
+
+class TestProtocolWithConstraintsWriterBase : public ProtocolWithConstraintsWriterBase {
+  public:
+  TestProtocolWithConstraintsWriterBase(std::unique_ptr<test_model::ProtocolWithConstraintsWriterBase> writer, std::function<std::unique_ptr<ProtocolWithConstraintsReaderBase>()> create_reader) : writer_(std::move(writer)), create_reader_(create_reader) {
+  }
+
+  ~TestProtocolWithConstraintsWriterBase() {
+    if (!close_called_ && !std::uncaught_exceptions()) {
+      ADD_FAILURE() << "Close() needs to be called on 'TestProtocolWithConstraintsWriterBase' to verify mocks";
+    }
+  }
+
+  protected:
+  void WriteRecordImpl(test_model::RecordWithConstraints const& value) override {
+    writer_->WriteRecord(value);
+    mock_writer_.ExpectWriteRecordImpl(value);
+  }
+
+  void WriteRecordsImpl(test_model::RecordWithConstrainedRecords const& value) override {
+    writer_->WriteRecords(value);
+    mock_writer_.ExpectWriteRecordsImpl(value);
+  }
+
+  void WriteRecordsImpl(std::vector<test_model::RecordWithConstrainedRecords> const& values) override {
+    writer_->WriteRecords(values);
+    for (auto const& v : values) {
+      mock_writer_.ExpectWriteRecordsImpl(v);
+    }
+  }
+
+  void EndRecordsImpl() override {
+    writer_->EndRecords();
+    mock_writer_.ExpectEndRecordsImpl();
+  }
+
+  void CloseImpl() override {
+    close_called_ = true;
+    writer_->Close();
+    std::unique_ptr<ProtocolWithConstraintsReaderBase> reader = create_reader_();
+    reader->CopyTo(mock_writer_, 1);
+    mock_writer_.Verify();
+  }
+
+  private:
+  std::unique_ptr<test_model::ProtocolWithConstraintsWriterBase> writer_;
+  std::function<std::unique_ptr<test_model::ProtocolWithConstraintsReaderBase>()> create_reader_;
+  MockProtocolWithConstraintsWriter mock_writer_;
+  bool close_called_ = false;
+};
+
 class MockProtocolWithKeywordStepsWriter : public ProtocolWithKeywordStepsWriterBase {
   public:
   void WriteIntImpl (test_model::RecordWithKeywordFields const& value) override {
@@ -4616,6 +5810,62 @@ std::unique_ptr<test_model::StringsWriterBase> CreateValidatingWriter<test_model
   );
 }
 
+template<>
+std::unique_ptr<test_model::ProtocolWithBytesWriterBase> CreateValidatingWriter<test_model::ProtocolWithBytesWriterBase>(Format format, std::string const& filename) {
+  return std::make_unique<test_model::TestProtocolWithBytesWriterBase>(
+    CreateWriter<test_model::ProtocolWithBytesWriterBase>(format, filename),
+    [format, filename](){ return CreateReader<test_model::ProtocolWithBytesReaderBase>(format, filename);}
+  );
+}
+
+template<>
+std::unique_ptr<test_model::ProtocolWithUuidsWriterBase> CreateValidatingWriter<test_model::ProtocolWithUuidsWriterBase>(Format format, std::string const& filename) {
+  return std::make_unique<test_model::TestProtocolWithUuidsWriterBase>(
+    CreateWriter<test_model::ProtocolWithUuidsWriterBase>(format, filename),
+    [format, filename](){ return CreateReader<test_model::ProtocolWithUuidsReaderBase>(format, filename);}
+  );
+}
+
+template<>
+std::unique_ptr<test_model::ProtocolWithDurationsWriterBase> CreateValidatingWriter<test_model::ProtocolWithDurationsWriterBase>(Format format, std::string const& filename) {
+  return std::make_unique<test_model::TestProtocolWithDurationsWriterBase>(
+    CreateWriter<test_model::ProtocolWithDurationsWriterBase>(format, filename),
+    [format, filename](){ return CreateReader<test_model::ProtocolWithDurationsReaderBase>(format, filename);}
+  );
+}
+
+template<>
+std::unique_ptr<test_model::ProtocolWithRecursiveRecordsWriterBase> CreateValidatingWriter<test_model::ProtocolWithRecursiveRecordsWriterBase>(Format format, std::string const& filename) {
+  return std::make_unique<test_model::TestProtocolWithRecursiveRecordsWriterBase>(
+    CreateWriter<test_model::ProtocolWithRecursiveRecordsWriterBase>(format, filename),
+    [format, filename](){ return CreateReader<test_model::ProtocolWithRecursiveRecordsReaderBase>(format, filename);}
+  );
+}
+
+template<>
+std::unique_ptr<test_model::CalibrationWriterBase> CreateValidatingWriter<test_model::CalibrationWriterBase>(Format format, std::string const& filename) {
+  return std::make_unique<test_model::TestCalibrationWriterBase>(
+    CreateWriter<test_model::CalibrationWriterBase>(format, filename),
+    [format, filename](){ return CreateReader<test_model::CalibrationReaderBase>(format, filename);}
+  );
+}
+
+template<>
+std::unique_ptr<test_model::ProtocolWithSubProtocolsWriterBase> CreateValidatingWriter<test_model::ProtocolWithSubProtocolsWriterBase>(Format format, std::string const& filename) {
+  return std::make_unique<test_model::TestProtocolWithSubProtocolsWriterBase>(
+    CreateWriter<test_model::ProtocolWithSubProtocolsWriterBase>(format, filename),
+    [format, filename](){ return CreateReader<test_model::ProtocolWithSubProtocolsReaderBase>(format, filename);}
+  );
+}
+
+template<>
+std::unique_ptr<test_model::ProtocolWithHalfPrecisionWriterBase> CreateValidatingWriter<test_model::ProtocolWithHalfPrecisionWriterBase>(Format format, std::string const& filename) {
+  return std::make_unique<test_model::TestProtocolWithHalfPrecisionWriterBase>(
+    CreateWriter<test_model::ProtocolWithHalfPrecisionWriterBase>(format, filename),
+    [format, filename](){ return CreateReader<test_model::ProtocolWithHalfPrecisionReaderBase>(format, filename);}
+  );
+}
+
 template<>
 std::unique_ptr<test_model::OptionalVectorsWriterBase> CreateValidatingWriter<test_model::OptionalVectorsWriterBase>(Format format, std::string const& filename) {
   return std::make_unique<test_model::TestOptionalVectorsWriterBase>(
@@ -4768,6 +6018,22 @@ std::unique_ptr<test_model::AdvancedGenericsWriterBase> CreateValidatingWriter<t
   );
 }
 
+template<>
+std::unique_ptr<test_model::FloatImageStreamWriterBase> CreateValidatingWriter<test_model::FloatImageStreamWriterBase>(Format format, std::string const& filename) {
+  return std::make_unique<test_model::TestFloatImageStreamWriterBase>(
+    CreateWriter<test_model::FloatImageStreamWriterBase>(format, filename),
+    [format, filename](){ return CreateReader<test_model::FloatImageStreamReaderBase>(format, filename);}
+  );
+}
+
+template<>
+std::unique_ptr<test_model::ComplexImageStreamWriterBase> CreateValidatingWriter<test_model::ComplexImageStreamWriterBase>(Format format, std::string const& filename) {
+  return std::make_unique<test_model::TestComplexImageStreamWriterBase>(
+    CreateWriter<test_model::ComplexImageStreamWriterBase>(format, filename),
+    [format, filename](){ return CreateReader<test_model::ComplexImageStreamReaderBase>(format, filename);}
+  );
+}
+
 template<>
 std::unique_ptr<test_model::AliasesWriterBase> CreateValidatingWriter<test_model::AliasesWriterBase>(Format format, std::string const& filename) {
   return std::make_unique<test_model::TestAliasesWriterBase>(
@@ -4792,6 +6058,14 @@ std::unique_ptr<test_model::ProtocolWithComputedFieldsWriterBase> CreateValidati
   );
 }
 
+template<>
+std::unique_ptr<test_model::ProtocolWithConstraintsWriterBase> CreateValidatingWriter<test_model::ProtocolWithConstraintsWriterBase>(Format format, std::string const& filename) {
+  return std::make_unique<test_model::TestProtocolWithConstraintsWriterBase>(
+    CreateWriter<test_model::ProtocolWithConstraintsWriterBase>(format, filename),
+    [format, filename](){ return CreateReader<test_model::ProtocolWithConstraintsReaderBase>(format, filename);}
+  );
+}
+
 template<>
 std::unique_ptr<test_model::ProtocolWithKeywordStepsWriterBase> CreateValidatingWriter<test_model::ProtocolWithKeywordStepsWriterBase>(Format format, std::string const& filename) {
   return std::make_unique<test_model::TestProtocolWithKeywordStepsWriterBase>(
@@ -88,6 +88,27 @@ void from_json(ordered_json const& j, test_model::RecordWithVlens& value);
 void to_json(ordered_json& j, test_model::RecordWithStrings const& value);
 void from_json(ordered_json const& j, test_model::RecordWithStrings& value);
 
+void to_json(ordered_json& j, test_model::RecordWithBytes const& value);
+void from_json(ordered_json const& j, test_model::RecordWithBytes& value);
+
+void to_json(ordered_json& j, test_model::RecordWithUuids const& value);
+void from_json(ordered_json const& j, test_model::RecordWithUuids& value);
+
+void to_json(ordered_json& j, test_model::RecordWithDurations const& value);
+void from_json(ordered_json const& j, test_model::RecordWithDurations& value);
+
+void to_json(ordered_json& j, test_model::TreeNode const& value);
+void from_json(ordered_json const& j, test_model::TreeNode& value);
+
+void to_json(ordered_json& j, test_model::LinkedListNode const& value);
+void from_json(ordered_json const& j, test_model::LinkedListNode& value);
+
+void to_json(ordered_json& j, test_model::Expression const& value);
+void from_json(ordered_json const& j, test_model::Expression& value);
+
+void to_json(ordered_json& j, test_model::RecordWithHalfPrecision const& value);
+void from_json(ordered_json const& j, test_model::RecordWithHalfPrecision& value);
+
 void to_json(ordered_json& j, test_model::RecordWithOptionalVector const& value);
 void from_json(ordered_json const& j, test_model::RecordWithOptionalVector& value);
 
@@ -203,6 +224,18 @@ void from_json(ordered_json const& j, test_model::RecordContainingVectorsOfAlias
 void to_json(ordered_json& j, test_model::RecordWithComputedFields const& value);
 void from_json(ordered_json const& j, test_model::RecordWithComputedFields& value);
 
+void to_json(ordered_json& j, test_model::RecordWithDefaults const& value);
+void from_json(ordered_json const& j, test_model::RecordWithDefaults& value);
+
+void to_json(ordered_json& j, test_model::RecordWithConstraints const& value);
+void from_json(ordered_json const& j, test_model::RecordWithConstraints& value);
+
+void to_json(ordered_json& j, test_model::RecordWithPatterns const& value);
+void from_json(ordered_json const& j, test_model::RecordWithPatterns& value);
+
+void to_json(ordered_json& j, test_model::RecordWithConstrainedRecords const& value);
+void from_json(ordered_json const& j, test_model::RecordWithConstrainedRecords& value);
+
 void to_json(ordered_json& j, test_model::RecordNotUsedInProtocol const& value);
 void from_json(ordered_json const& j, test_model::RecordNotUsedInProtocol& value);
 
@@ -215,6 +248,32 @@ void from_json(ordered_json const& j, test_model::RecordWithKeywordFields& value
 void to_json(ordered_json& j, test_model::RecordWithOptionalDate const& value);
 void from_json(ordered_json const& j, test_model::RecordWithOptionalDate& value);
 
+void to_json(ordered_json& j, test_model::RecordWithConstants const& value);
+void from_json(ordered_json const& j, test_model::RecordWithConstants& value);
+
+void to_json(ordered_json& j, test_model::RecordHeader const& value);
+void from_json(ordered_json const& j, test_model::RecordHeader& value);
+
+template <typename T>
+void to_json(ordered_json& j, test_model::LabeledHeader<T> const& value);
+template <typename T>
+void from_json(ordered_json const& j, test_model::LabeledHeader<T>& value);
+
+void to_json(ordered_json& j, test_model::RecordWithBase const& value);
+void from_json(ordered_json const& j, test_model::RecordWithBase& value);
+
+void to_json(ordered_json& j, test_model::RecordWithBases const& value);
+void from_json(ordered_json const& j, test_model::RecordWithBases& value);
+
+void to_json(ordered_json& j, test_model::RecordWithAnnotations const& value);
+void from_json(ordered_json const& j, test_model::RecordWithAnnotations& value);
+
+void to_json(ordered_json& j, test_model::EnumWithAnnotations const& value);
+void from_json(ordered_json const& j, test_model::EnumWithAnnotations& value);
+
+void to_json(ordered_json& j, test_model::RecordWithUnits const& value);
+void from_json(ordered_json const& j, test_model::RecordWithUnits& value);
+
 } // namespace test_model
 
 NLOHMANN_JSON_NAMESPACE_BEGIN
@@ -378,6 +437,25 @@ struct adl_serializer<std::variant<basic_types::RecordWithString, int32_t>> {
   }
 };
 
+template <>
+struct adl_serializer<std::variant<int32_t, yardl::Indirect<test_model::Expression>>> {
+  static void to_json(ordered_json& j, std::variant<int32_t, yardl::Indirect<test_model::Expression>> const& value) {
+    std::visit([&j](auto const& v) {j = v;}, value);
+  }
+
+  static void from_json(ordered_json const& j, std::variant<int32_t, yardl::Indirect<test_model::Expression>>& value) {
+    if ((j.is_number())) {
+      value = j.get<int32_t>();
+      return;
+    }
+    if ((j.is_object())) {
+      value = j.get<yardl::Indirect<test_model::Expression>>();
+      return;
+    }
+    throw std::runtime_error("Invalid union value");
+  }
+};
+
 template <>
 struct adl_serializer<std::variant<std::unordered_map<std::string, int32_t>, int32_t>> {
   static void to_json(ordered_json& j, std::variant<std::unordered_map<std::string, int32_t>, int32_t> const& value) {
@@ -886,6 +964,44 @@ struct adl_serializer<std::variant<yardl::NDArray<float, 2>, yardl::NDArray<doub
   }
 };
 
+template <>
+struct adl_serializer<std::variant<float, std::string>> {
+  static void to_json(ordered_json& j, std::variant<float, std::string> const& value) {
+    std::visit([&j](auto const& v) {j = v;}, value);
+  }
+
+  static void from_json(ordered_json const& j, std::variant<float, std::string>& value) {
+    if ((j.is_number())) {
+      value = j.get<float>();
+      return;
+    }
+    if ((j.is_string())) {
+      value = j.get<std::string>();
+      return;
+    }
+    throw std::runtime_error("Invalid union value");
+  }
+};
+
+template <>
+struct adl_serializer<std::variant<std::complex<float>, std::string>> {
+  static void to_json(ordered_json& j, std::variant<std::complex<float>, std::string> const& value) {
+    std::visit([&j](auto const& v) {j = v;}, value);
+  }
+
+  static void from_json(ordered_json const& j, std::variant<std::complex<float>, std::string>& value) {
+    if ((j.is_array())) {
+      value = j.get<std::complex<float>>();
+      return;
+    }
+    if ((j.is_string())) {
+      value = j.get<std::string>();
+      return;
+    }
+    throw std::runtime_error("Invalid union value");
+  }
+};
+
 template <>
 struct adl_serializer<std::variant<std::string, basic_types::Fruits>> {
   static void to_json(ordered_json& j, std::variant<std::string, basic_types::Fruits> const& value) {
@@ -1749,6 +1865,193 @@ void from_json(ordered_json const& j, test_model::RecordWithStrings& value) {
   }
 }
 
+void to_json(ordered_json& j, test_model::RecordWithBytes const& value) {
+  j = ordered_json::object();
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.data)) {
+    j.push_back({"data", value.data});
+  }
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.optional_data)) {
+    j.push_back({"optionalData", value.optional_data});
+  }
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.chunks)) {
+    j.push_back({"chunks", value.chunks});
+  }
+}
+
+void from_json(ordered_json const& j, test_model::RecordWithBytes& value) {
+  if (auto it = j.find("data"); it != j.end()) {
+    it->get_to(value.data);
+  }
+  if (auto it = j.find("optionalData"); it != j.end()) {
+    it->get_to(value.optional_data);
+  }
+  if (auto it = j.find("chunks"); it != j.end()) {
+    it->get_to(value.chunks);
+  }
+}
+
+void to_json(ordered_json& j, test_model::RecordWithUuids const& value) {
+  j = ordered_json::object();
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.id)) {
+    j.push_back({"id", value.id});
+  }
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.optional_id)) {
+    j.push_back({"optionalId", value.optional_id});
+  }
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.related)) {
+    j.push_back({"related", value.related});
+  }
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.names)) {
+    j.push_back({"names", value.names});
+  }
+}
+
+void from_json(ordered_json const& j, test_model::RecordWithUuids& value) {
+  if (auto it = j.find("id"); it != j.end()) {
+    it->get_to(value.id);
+  }
+  if (auto it = j.find("optionalId"); it != j.end()) {
+    it->get_to(value.optional_id);
+  }
+  if (auto it = j.find("related"); it != j.end()) {
+    it->get_to(value.related);
+  }
+  if (auto it = j.find("names"); it != j.end()) {
+    it->get_to(value.names);
+  }
+}
+
+void to_json(ordered_json& j, test_model::RecordWithDurations const& value) {
+  j = ordered_json::object();
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.acquisition_start)) {
+    j.push_back({"acquisitionStart", value.acquisition_start});
+  }
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.acquisition_end)) {
+    j.push_back({"acquisitionEnd", value.acquisition_end});
+  }
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.repetition_time)) {
+    j.push_back({"repetitionTime", value.repetition_time});
+  }
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.timeout)) {
+    j.push_back({"timeout", value.timeout});
+  }
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.intervals)) {
+    j.push_back({"intervals", value.intervals});
+  }
+}
+
+void from_json(ordered_json const& j, test_model::RecordWithDurations& value) {
+  if (auto it = j.find("acquisitionStart"); it != j.end()) {
+    it->get_to(value.acquisition_start);
+  }
+  if (auto it = j.find("acquisitionEnd"); it != j.end()) {
+    it->get_to(value.acquisition_end);
+  }
+  if (auto it = j.find("repetitionTime"); it != j.end()) {
+    it->get_to(value.repetition_time);
+  }
+  if (auto it = j.find("timeout"); it != j.end()) {
+    it->get_to(value.timeout);
+  }
+  if (auto it = j.find("intervals"); it != j.end()) {
+    it->get_to(value.intervals);
+  }
+}
+
+void to_json(ordered_json& j, test_model::TreeNode const& value) {
+  j = ordered_json::object();
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.label)) {
+    j.push_back({"label", value.label});
+  }
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.children)) {
+    j.push_back({"children", value.children});
+  }
+}
+
+void from_json(ordered_json const& j, test_model::TreeNode& value) {
+  if (auto it = j.find("label"); it != j.end()) {
+    it->get_to(value.label);
+  }
+  if (auto it = j.find("children"); it != j.end()) {
+    it->get_to(value.children);
+  }
+}
+
+void to_json(ordered_json& j, test_model::LinkedListNode const& value) {
+  j = ordered_json::object();
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.value)) {
+    j.push_back({"value", value.value});
+  }
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.next)) {
+    j.push_back({"next", value.next});
+  }
+}
+
+void from_json(ordered_json const& j, test_model::LinkedListNode& value) {
+  if (auto it = j.find("value"); it != j.end()) {
+    it->get_to(value.value);
+  }
+  if (auto it = j.find("next"); it != j.end()) {
+    it->get_to(value.next);
+  }
+}
+
+void to_json(ordered_json& j, test_model::Expression const& value) {
+  j = ordered_json::object();
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.name)) {
+    j.push_back({"name", value.name});
+  }
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.operand)) {
+    j.push_back({"operand", value.operand});
+  }
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.arguments)) {
+    j.push_back({"arguments", value.arguments});
+  }
+}
+
+void from_json(ordered_json const& j, test_model::Expression& value) {
+  if (auto it = j.find("name"); it != j.end()) {
+    it->get_to(value.name);
+  }
+  if (auto it = j.find("operand"); it != j.end()) {
+    it->get_to(value.operand);
+  }
+  if (auto it = j.find("arguments"); it != j.end()) {
+    it->get_to(value.arguments);
+  }
+}
+
+void to_json(ordered_json& j, test_model::RecordWithHalfPrecision const& value) {
+  j = ordered_json::object();
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.half)) {
+    j.push_back({"half", value.half});
+  }
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.brain)) {
+    j.push_back({"brain", value.brain});
+  }
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.half_vector)) {
+    j.push_back({"halfVector", value.half_vector});
+  }
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.brain_array)) {
+    j.push_back({"brainArray", value.brain_array});
+  }
+}
+
+void from_json(ordered_json const& j, test_model::RecordWithHalfPrecision& value) {
+  if (auto it = j.find("half"); it != j.end()) {
+    it->get_to(value.half);
+  }
+  if (auto it = j.find("brain"); it != j.end()) {
+    it->get_to(value.brain);
+  }
+  if (auto it = j.find("halfVector"); it != j.end()) {
+    it->get_to(value.half_vector);
+  }
+  if (auto it = j.find("brainArray"); it != j.end()) {
+    it->get_to(value.brain_array);
+  }
+}
+
 void to_json(ordered_json& j, test_model::RecordWithOptionalVector const& value) {
   j = ordered_json::object();
   if (yardl::ndjson::ShouldSerializeFieldValue(value.optional_vector)) {
@@ -2692,6 +2995,172 @@ void from_json(ordered_json const& j, test_model::RecordWithComputedFields& valu
   }
 }
 
+void to_json(ordered_json& j, test_model::RecordWithDefaults const& value) {
+  j = ordered_json::object();
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.int_field)) {
+    j.push_back({"intField", value.int_field});
+  }
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.int8_field)) {
+    j.push_back({"int8Field", value.int8_field});
+  }
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.uint64_field)) {
+    j.push_back({"uint64Field", value.uint64_field});
+  }
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.float32_field)) {
+    j.push_back({"float32Field", value.float32_field});
+  }
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.float64_field)) {
+    j.push_back({"float64Field", value.float64_field});
+  }
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.complexfloat64_field)) {
+    j.push_back({"complexfloat64Field", value.complexfloat64_field});
+  }
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.bool_field)) {
+    j.push_back({"boolField", value.bool_field});
+  }
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.string_field)) {
+    j.push_back({"stringField", value.string_field});
+  }
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.enum_field)) {
+    j.push_back({"enumField", value.enum_field});
+  }
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.no_default_field)) {
+    j.push_back({"noDefaultField", value.no_default_field});
+  }
+}
+
+void from_json(ordered_json const& j, test_model::RecordWithDefaults& value) {
+  if (auto it = j.find("intField"); it != j.end()) {
+    it->get_to(value.int_field);
+  }
+  if (auto it = j.find("int8Field"); it != j.end()) {
+    it->get_to(value.int8_field);
+  }
+  if (auto it = j.find("uint64Field"); it != j.end()) {
+    it->get_to(value.uint64_field);
+  }
+  if (auto it = j.find("float32Field"); it != j.end()) {
+    it->get_to(value.float32_field);
+  }
+  if (auto it = j.find("float64Field"); it != j.end()) {
+    it->get_to(value.float64_field);
+  }
+  if (auto it = j.find("complexfloat64Field"); it != j.end()) {
+    it->get_to(value.complexfloat64_field);
+  }
+  if (auto it = j.find("boolField"); it != j.end()) {
+    it->get_to(value.bool_field);
+  }
+  if (auto it = j.find("stringField"); it != j.end()) {
+    it->get_to(value.string_field);
+  }
+  if (auto it = j.find("enumField"); it != j.end()) {
+    it->get_to(value.enum_field);
+  }
+  if (auto it = j.find("noDefaultField"); it != j.end()) {
+    it->get_to(value.no_default_field);
+  }
+}
+
+void to_json(ordered_json& j, test_model::RecordWithConstraints const& value) {
+  j = ordered_json::object();
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.size)) {
+    j.push_back({"size", value.size});
+  }
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.gain)) {
+    j.push_back({"gain", value.gain});
+  }
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.name)) {
+    j.push_back({"name", value.name});
+  }
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.limit)) {
+    j.push_back({"limit", value.limit});
+  }
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.samples)) {
+    j.push_back({"samples", value.samples});
+  }
+}
+
+void from_json(ordered_json const& j, test_model::RecordWithConstraints& value) {
+  if (auto it = j.find("size"); it != j.end()) {
+    it->get_to(value.size);
+  }
+  if (auto it = j.find("gain"); it != j.end()) {
+    it->get_to(value.gain);
+  }
+  if (auto it = j.find("name"); it != j.end()) {
+    it->get_to(value.name);
+  }
+  if (auto it = j.find("limit"); it != j.end()) {
+    it->get_to(value.limit);
+  }
+  if (auto it = j.find("samples"); it != j.end()) {
+    it->get_to(value.samples);
+  }
+}
+
+void to_json(ordered_json& j, test_model::RecordWithPatterns const& value) {
+  j = ordered_json::object();
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.alternation)) {
+    j.push_back({"alternation", value.alternation});
+  }
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.word)) {
+    j.push_back({"word", value.word});
+  }
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.code)) {
+    j.push_back({"code", value.code});
+  }
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.escaped)) {
+    j.push_back({"escaped", value.escaped});
+  }
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.capitalized)) {
+    j.push_back({"capitalized", value.capitalized});
+  }
+}
+
+void from_json(ordered_json const& j, test_model::RecordWithPatterns& value) {
+  if (auto it = j.find("alternation"); it != j.end()) {
+    it->get_to(value.alternation);
+  }
+  if (auto it = j.find("word"); it != j.end()) {
+    it->get_to(value.word);
+  }
+  if (auto it = j.find("code"); it != j.end()) {
+    it->get_to(value.code);
+  }
+  if (auto it = j.find("escaped"); it != j.end()) {
+    it->get_to(value.escaped);
+  }
+  if (auto it = j.find("capitalized"); it != j.end()) {
+    it->get_to(value.capitalized);
+  }
+}
+
+void to_json(ordered_json& j, test_model::RecordWithConstrainedRecords const& value) {
+  j = ordered_json::object();
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.single)) {
+    j.push_back({"single", value.single});
+  }
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.optional)) {
+    j.push_back({"optional", value.optional});
+  }
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.vector)) {
+    j.push_back({"vector", value.vector});
+  }
+}
+
+void from_json(ordered_json const& j, test_model::RecordWithConstrainedRecords& value) {
+  if (auto it = j.find("single"); it != j.end()) {
+    it->get_to(value.single);
+  }
+  if (auto it = j.find("optional"); it != j.end()) {
+    it->get_to(value.optional);
+  }
+  if (auto it = j.find("vector"); it != j.end()) {
+    it->get_to(value.vector);
+  }
+}
+
 void to_json(ordered_json& j, test_model::RecordNotUsedInProtocol const& value) {
   j = ordered_json::object();
   if (yardl::ndjson::ShouldSerializeFieldValue(value.u1)) {
@@ -2784,6 +3253,206 @@ void from_json(ordered_json const& j, test_model::RecordWithOptionalDate& value)
   }
 }
 
+void to_json(ordered_json& j, test_model::RecordWithConstants const& value) {
+  j = ordered_json::object();
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.channels)) {
+    j.push_back({"channels", value.channels});
+  }
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.samples)) {
+    j.push_back({"samples", value.samples});
+  }
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.gain)) {
+    j.push_back({"gain", value.gain});
+  }
+}
+
+void from_json(ordered_json const& j, test_model::RecordWithConstants& value) {
+  if (auto it = j.find("channels"); it != j.end()) {
+    it->get_to(value.channels);
+  }
+  if (auto it = j.find("samples"); it != j.end()) {
+    it->get_to(value.samples);
+  }
+  if (auto it = j.find("gain"); it != j.end()) {
+    it->get_to(value.gain);
+  }
+}
+
+void to_json(ordered_json& j, test_model::RecordHeader const& value) {
+  j = ordered_json::object();
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.version)) {
+    j.push_back({"version", value.version});
+  }
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.name)) {
+    j.push_back({"name", value.name});
+  }
+}
+
+void from_json(ordered_json const& j, test_model::RecordHeader& value) {
+  if (auto it = j.find("version"); it != j.end()) {
+    it->get_to(value.version);
+  }
+  if (auto it = j.find("name"); it != j.end()) {
+    it->get_to(value.name);
+  }
+}
+
+template <typename T>
+void to_json(ordered_json& j, test_model::LabeledHeader<T> const& value) {
+  j = ordered_json::object();
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.label)) {
+    j.push_back({"label", value.label});
+  }
+}
+
+template <typename T>
+void from_json(ordered_json const& j, test_model::LabeledHeader<T>& value) {
+  if (auto it = j.find("label"); it != j.end()) {
+    it->get_to(value.label);
+  }
+}
+
+void to_json(ordered_json& j, test_model::RecordWithBase const& value) {
+  j = ordered_json::object();
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.version)) {
+    j.push_back({"version", value.version});
+  }
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.name)) {
+    j.push_back({"name", value.name});
+  }
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.data)) {
+    j.push_back({"data", value.data});
+  }
+}
+
+void from_json(ordered_json const& j, test_model::RecordWithBase& value) {
+  if (auto it = j.find("version"); it != j.end()) {
+    it->get_to(value.version);
+  }
+  if (auto it = j.find("name"); it != j.end()) {
+    it->get_to(value.name);
+  }
+  if (auto it = j.find("data"); it != j.end()) {
+    it->get_to(value.data);
+  }
+}
+
+void to_json(ordered_json& j, test_model::RecordWithBases const& value) {
+  j = ordered_json::object();
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.version)) {
+    j.push_back({"version", value.version});
+  }
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.name)) {
+    j.push_back({"name", value.name});
+  }
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.label)) {
+    j.push_back({"label", value.label});
+  }
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.value)) {
+    j.push_back({"value", value.value});
+  }
+}
+
+void from_json(ordered_json const& j, test_model::RecordWithBases& value) {
+  if (auto it = j.find("version"); it != j.end()) {
+    it->get_to(value.version);
+  }
+  if (auto it = j.find("name"); it != j.end()) {
+    it->get_to(value.name);
+  }
+  if (auto it = j.find("label"); it != j.end()) {
+    it->get_to(value.label);
+  }
+  if (auto it = j.find("value"); it != j.end()) {
+    it->get_to(value.value);
+  }
+}
+
+void to_json(ordered_json& j, test_model::RecordWithAnnotations const& value) {
+  j = ordered_json::object();
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.thickness)) {
+    j.push_back({"thickness", value.thickness});
+  }
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.spacing)) {
+    j.push_back({"spacing", value.spacing});
+  }
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.label)) {
+    j.push_back({"label", value.label});
+  }
+}
+
+void from_json(ordered_json const& j, test_model::RecordWithAnnotations& value) {
+  if (auto it = j.find("thickness"); it != j.end()) {
+    it->get_to(value.thickness);
+  }
+  if (auto it = j.find("spacing"); it != j.end()) {
+    it->get_to(value.spacing);
+  }
+  if (auto it = j.find("label"); it != j.end()) {
+    it->get_to(value.label);
+  }
+}
+
+namespace {
+std::unordered_map<std::string, test_model::EnumWithAnnotations> const __EnumWithAnnotations_values = {
+  {"a", test_model::EnumWithAnnotations::kA},
+  {"b", test_model::EnumWithAnnotations::kB},
+};
+} //namespace
+
+void to_json(ordered_json& j, test_model::EnumWithAnnotations const& value) {
+  switch (value) {
+    case test_model::EnumWithAnnotations::kA:
+      j = "a";
+      break;
+    case test_model::EnumWithAnnotations::kB:
+      j = "b";
+      break;
+    default:
+      using underlying_type = typename std::underlying_type<test_model::EnumWithAnnotations>::type;
+      j = static_cast<underlying_type>(value);
+      break;
+  }
+}
+
+void from_json(ordered_json const& j, test_model::EnumWithAnnotations& value) {
+  if (j.is_string()) {
+    auto symbol = j.get<std::string>();
+    if (auto res = __EnumWithAnnotations_values.find(symbol); res != __EnumWithAnnotations_values.end()) {
+      value = res->second;
+      return;
+    }
+    throw std::runtime_error("Invalid enum value '" + symbol + "' for enum test_model::EnumWithAnnotations");
+  }
+  using underlying_type = typename std::underlying_type<test_model::EnumWithAnnotations>::type;
+  value = static_cast<test_model::EnumWithAnnotations>(j.get<underlying_type>());
+}
+
+void to_json(ordered_json& j, test_model::RecordWithUnits const& value) {
+  j = ordered_json::object();
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.length)) {
+    j.push_back({"length", value.length});
+  }
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.width)) {
+    j.push_back({"width", value.width});
+  }
+  if (yardl::ndjson::ShouldSerializeFieldValue(value.durations)) {
+    j.push_back({"durations", value.durations});
+  }
+}
+
+void from_json(ordered_json const& j, test_model::RecordWithUnits& value) {
+  if (auto it = j.find("length"); it != j.end()) {
+    it->get_to(value.length);
+  }
+  if (auto it = j.find("width"); it != j.end()) {
+    it->get_to(value.width);
+  }
+  if (auto it = j.find("durations"); it != j.end()) {
+    it->get_to(value.durations);
+  }
+}
+
 } // namespace test_model
 
 namespace test_model::ndjson {
@@ -3093,6 +3762,256 @@ void StringsReader::CloseImpl() {
   }
 }
 
+void ProtocolWithBytesWriter::WriteSingleBytesImpl(std::vector<std::byte> const& value) {
+  ordered_json json_value = value;
+  yardl::ndjson::WriteProtocolValue(stream_, "singleBytes", json_value);}
+
+void ProtocolWithBytesWriter::WriteRecWithBytesImpl(test_model::RecordWithBytes const& value) {
+  ordered_json json_value = value;
+  yardl::ndjson::WriteProtocolValue(stream_, "recWithBytes", json_value);}
+
+void ProtocolWithBytesWriter::Flush() {
+  stream_.flush();
+}
+
+void ProtocolWithBytesWriter::CloseImpl() {
+  stream_.flush();
+}
+
+void ProtocolWithBytesReader::ReadSingleBytesImpl(std::vector<std::byte>& value) {
+  yardl::ndjson::ReadProtocolValue(stream_, line_, "singleBytes", true, unused_step_, value);
+}
+
+void ProtocolWithBytesReader::ReadRecWithBytesImpl(test_model::RecordWithBytes& value) {
+  yardl::ndjson::ReadProtocolValue(stream_, line_, "recWithBytes", true, unused_step_, value);
+}
+
+void ProtocolWithBytesReader::CloseImpl() {
+  if (!skip_completed_check_) {
+    VerifyFinished();
+  }
+}
+
+void ProtocolWithUuidsWriter::WriteSingleUuidImpl(yardl::Uuid const& value) {
+  ordered_json json_value = value;
+  yardl::ndjson::WriteProtocolValue(stream_, "singleUuid", json_value);}
+
+void ProtocolWithUuidsWriter::WriteRecWithUuidsImpl(test_model::RecordWithUuids const& value) {
+  ordered_json json_value = value;
+  yardl::ndjson::WriteProtocolValue(stream_, "recWithUuids", json_value);}
+
+void ProtocolWithUuidsWriter::Flush() {
+  stream_.flush();
+}
+
+void ProtocolWithUuidsWriter::CloseImpl() {
+  stream_.flush();
+}
+
+void ProtocolWithUuidsReader::ReadSingleUuidImpl(yardl::Uuid& value) {
+  yardl::ndjson::ReadProtocolValue(stream_, line_, "singleUuid", true, unused_step_, value);
+}
+
+void ProtocolWithUuidsReader::ReadRecWithUuidsImpl(test_model::RecordWithUuids& value) {
+  yardl::ndjson::ReadProtocolValue(stream_, line_, "recWithUuids", true, unused_step_, value);
+}
+
+void ProtocolWithUuidsReader::CloseImpl() {
+  if (!skip_completed_check_) {
+    VerifyFinished();
+  }
+}
+
+void ProtocolWithDurationsWriter::WriteSingleDurationImpl(yardl::Duration const& value) {
+  ordered_json json_value = value;
+  yardl::ndjson::WriteProtocolValue(stream_, "singleDuration", json_value);}
+
+void ProtocolWithDurationsWriter::WriteRecWithDurationsImpl(test_model::RecordWithDurations const& value) {
+  ordered_json json_value = value;
+  yardl::ndjson::WriteProtocolValue(stream_, "recWithDurations", json_value);}
+
+void ProtocolWithDurationsWriter::Flush() {
+  stream_.flush();
+}
+
+void ProtocolWithDurationsWriter::CloseImpl() {
+  stream_.flush();
+}
+
+void ProtocolWithDurationsReader::ReadSingleDurationImpl(yardl::Duration& value) {
+  yardl::ndjson::ReadProtocolValue(stream_, line_, "singleDuration", true, unused_step_, value);
+}
+
+void ProtocolWithDurationsReader::ReadRecWithDurationsImpl(test_model::RecordWithDurations& value) {
+  yardl::ndjson::ReadProtocolValue(stream_, line_, "recWithDurations", true, unused_step_, value);
+}
+
+void ProtocolWithDurationsReader::CloseImpl() {
+  if (!skip_completed_check_) {
+    VerifyFinished();
+  }
+}
+
+void ProtocolWithRecursiveRecordsWriter::WriteTreeImpl(test_model::TreeNode const& value) {
+  ordered_json json_value = value;
+  yardl::ndjson::WriteProtocolValue(stream_, "tree", json_value);}
+
+void ProtocolWithRecursiveRecordsWriter::WriteListImpl(test_model::LinkedListNode const& value) {
+  ordered_json json_value = value;
+  yardl::ndjson::WriteProtocolValue(stream_, "list", json_value);}
+
+void ProtocolWithRecursiveRecordsWriter::WriteExpressionsImpl(test_model::Expression const& value) {
+  ordered_json json_value = value;
+  yardl::ndjson::WriteProtocolValue(stream_, "expressions", json_value);}
+
+void ProtocolWithRecursiveRecordsWriter::Flush() {
+  stream_.flush();
+}
+
+void ProtocolWithRecursiveRecordsWriter::CloseImpl() {
+  stream_.flush();
+}
+
+void ProtocolWithRecursiveRecordsReader::ReadTreeImpl(test_model::TreeNode& value) {
+  yardl::ndjson::ReadProtocolValue(stream_, line_, "tree", true, unused_step_, value);
+}
+
+void ProtocolWithRecursiveRecordsReader::ReadListImpl(test_model::LinkedListNode& value) {
+  yardl::ndjson::ReadProtocolValue(stream_, line_, "list", true, unused_step_, value);
+}
+
+bool ProtocolWithRecursiveRecordsReader::ReadExpressionsImpl(test_model::Expression& value) {
+  return yardl::ndjson::ReadProtocolValue(stream_, line_, "expressions", false, unused_step_, value);
+}
+
+void ProtocolWithRecursiveRecordsReader::CloseImpl() {
+  if (!skip_completed_check_) {
+    VerifyFinished();
+  }
+}
+
+void CalibrationWriter::WriteGainImpl(float const& value) {
+  ordered_json json_value = value;
+  yardl::ndjson::WriteProtocolValue(stream_, "gain", json_value);}
+
+void CalibrationWriter::WriteSamplesImpl(double const& value) {
+  ordered_json json_value = value;
+  yardl::ndjson::WriteProtocolValue(stream_, "samples", json_value);}
+
+void CalibrationWriter::Flush() {
+  stream_.flush();
+}
+
+void CalibrationWriter::CloseImpl() {
+  stream_.flush();
+}
+
+void CalibrationReader::ReadGainImpl(float& value) {
+  yardl::ndjson::ReadProtocolValue(stream_, line_, "gain", true, unused_step_, value);
+}
+
+bool CalibrationReader::ReadSamplesImpl(double& value) {
+  return yardl::ndjson::ReadProtocolValue(stream_, line_, "samples", false, unused_step_, value);
+}
+
+void CalibrationReader::CloseImpl() {
+  if (!skip_completed_check_) {
+    VerifyFinished();
+  }
+}
+
+void ProtocolWithSubProtocolsWriter::WriteHeaderImpl(std::string const& value) {
+  ordered_json json_value = value;
+  yardl::ndjson::WriteProtocolValue(stream_, "header", json_value);}
+
+void ProtocolWithSubProtocolsWriter::WriteCalibrationGainImpl(float const& value) {
+  ordered_json json_value = value;
+  yardl::ndjson::WriteProtocolValue(stream_, "calibrationGain", json_value);}
+
+void ProtocolWithSubProtocolsWriter::WriteCalibrationSamplesImpl(double const& value) {
+  ordered_json json_value = value;
+  yardl::ndjson::WriteProtocolValue(stream_, "calibrationSamples", json_value);}
+
+void ProtocolWithSubProtocolsWriter::WriteDataImpl(int32_t const& value) {
+  ordered_json json_value = value;
+  yardl::ndjson::WriteProtocolValue(stream_, "data", json_value);}
+
+void ProtocolWithSubProtocolsWriter::WriteRecalibrationGainImpl(float const& value) {
+  ordered_json json_value = value;
+  yardl::ndjson::WriteProtocolValue(stream_, "recalibrationGain", json_value);}
+
+void ProtocolWithSubProtocolsWriter::WriteRecalibrationSamplesImpl(double const& value) {
+  ordered_json json_value = value;
+  yardl::ndjson::WriteProtocolValue(stream_, "recalibrationSamples", json_value);}
+
+void ProtocolWithSubProtocolsWriter::Flush() {
+  stream_.flush();
+}
+
+void ProtocolWithSubProtocolsWriter::CloseImpl() {
+  stream_.flush();
+}
+
+void ProtocolWithSubProtocolsReader::ReadHeaderImpl(std::string& value) {
+  yardl::ndjson::ReadProtocolValue(stream_, line_, "header", true, unused_step_, value);
+}
+
+void ProtocolWithSubProtocolsReader::ReadCalibrationGainImpl(float& value) {
+  yardl::ndjson::ReadProtocolValue(stream_, line_, "calibrationGain", true, unused_step_, value);
+}
+
+bool ProtocolWithSubProtocolsReader::ReadCalibrationSamplesImpl(double& value) {
+  return yardl::ndjson::ReadProtocolValue(stream_, line_, "calibrationSamples", false, unused_step_, value);
+}
+
+bool ProtocolWithSubProtocolsReader::ReadDataImpl(int32_t& value) {
+  return yardl::ndjson::ReadProtocolValue(stream_, line_, "data", false, unused_step_, value);
+}
+
+void ProtocolWithSubProtocolsReader::ReadRecalibrationGainImpl(float& value) {
+  yardl::ndjson::ReadProtocolValue(stream_, line_, "recalibrationGain", true, unused_step_, value);
+}
+
+bool ProtocolWithSubProtocolsReader::ReadRecalibrationSamplesImpl(double& value) {
+  return yardl::ndjson::ReadProtocolValue(stream_, line_, "recalibrationSamples", false, unused_step_, value);
+}
+
+void ProtocolWithSubProtocolsReader::CloseImpl() {
+  if (!skip_completed_check_) {
+    VerifyFinished();
+  }
+}
+
+void ProtocolWithHalfPrecisionWriter::WriteHalvesImpl(std::vector<yardl::Float16> const& value) {
+  ordered_json json_value = value;
+  yardl::ndjson::WriteProtocolValue(stream_, "halves", json_value);}
+
+void ProtocolWithHalfPrecisionWriter::WriteRecWithHalvesImpl(test_model::RecordWithHalfPrecision const& value) {
+  ordered_json json_value = value;
+  yardl::ndjson::WriteProtocolValue(stream_, "recWithHalves", json_value);}
+
+void ProtocolWithHalfPrecisionWriter::Flush() {
+  stream_.flush();
+}
+
+void ProtocolWithHalfPrecisionWriter::CloseImpl() {
+  stream_.flush();
+}
+
+void ProtocolWithHalfPrecisionReader::ReadHalvesImpl(std::vector<yardl::Float16>& value) {
+  yardl::ndjson::ReadProtocolValue(stream_, line_, "halves", true, unused_step_, value);
+}
+
+void ProtocolWithHalfPrecisionReader::ReadRecWithHalvesImpl(test_model::RecordWithHalfPrecision& value) {
+  yardl::ndjson::ReadProtocolValue(stream_, line_, "recWithHalves", true, unused_step_, value);
+}
+
+void ProtocolWithHalfPrecisionReader::CloseImpl() {
+  if (!skip_completed_check_) {
+    VerifyFinished();
+  }
+}
+
 void OptionalVectorsWriter::WriteRecordWithOptionalVectorImpl(test_model::RecordWithOptionalVector const& value) {
   ordered_json json_value = value;
   yardl::ndjson::WriteProtocolValue(stream_, "recordWithOptionalVector", json_value);}
@@ -3983,6 +4902,82 @@ void AdvancedGenericsReader::CloseImpl() {
   }
 }
 
+void FloatImageStreamWriter::WriteHeaderImpl(test_model::GenericRecord<int32_t, float> const& value) {
+  ordered_json json_value = value;
+  yardl::ndjson::WriteProtocolValue(stream_, "header", json_value);}
+
+void FloatImageStreamWriter::WriteImagesImpl(test_model::Image<float> const& value) {
+  ordered_json json_value = value;
+  yardl::ndjson::WriteProtocolValue(stream_, "images", json_value);}
+
+void FloatImageStreamWriter::WriteBackgroundImpl(std::variant<float, std::string> const& value) {
+  ordered_json json_value = value;
+  yardl::ndjson::WriteProtocolValue(stream_, "background", json_value);}
+
+void FloatImageStreamWriter::Flush() {
+  stream_.flush();
+}
+
+void FloatImageStreamWriter::CloseImpl() {
+  stream_.flush();
+}
+
+void FloatImageStreamReader::ReadHeaderImpl(test_model::GenericRecord<int32_t, float>& value) {
+  yardl::ndjson::ReadProtocolValue(stream_, line_, "header", true, unused_step_, value);
+}
+
+bool FloatImageStreamReader::ReadImagesImpl(test_model::Image<float>& value) {
+  return yardl::ndjson::ReadProtocolValue(stream_, line_, "images", false, unused_step_, value);
+}
+
+void FloatImageStreamReader::ReadBackgroundImpl(std::variant<float, std::string>& value) {
+  yardl::ndjson::ReadProtocolValue(stream_, line_, "background", true, unused_step_, value);
+}
+
+void FloatImageStreamReader::CloseImpl() {
+  if (!skip_completed_check_) {
+    VerifyFinished();
+  }
+}
+
+void ComplexImageStreamWriter::WriteHeaderImpl(test_model::GenericRecord<int32_t, std::complex<float>> const& value) {
+  ordered_json json_value = value;
+  yardl::ndjson::WriteProtocolValue(stream_, "header", json_value);}
+
+void ComplexImageStreamWriter::WriteImagesImpl(test_model::Image<std::complex<float>> const& value) {
+  ordered_json json_value = value;
+  yardl::ndjson::WriteProtocolValue(stream_, "images", json_value);}
+
+void ComplexImageStreamWriter::WriteBackgroundImpl(std::variant<std::complex<float>, std::string> const& value) {
+  ordered_json json_value = value;
+  yardl::ndjson::WriteProtocolValue(stream_, "background", json_value);}
+
+void ComplexImageStreamWriter::Flush() {
+  stream_.flush();
+}
+
+void ComplexImageStreamWriter::CloseImpl() {
+  stream_.flush();
+}
+
+void ComplexImageStreamReader::ReadHeaderImpl(test_model::GenericRecord<int32_t, std::complex<float>>& value) {
+  yardl::ndjson::ReadProtocolValue(stream_, line_, "header", true, unused_step_, value);
+}
+
+bool ComplexImageStreamReader::ReadImagesImpl(test_model::Image<std::complex<float>>& value) {
+  return yardl::ndjson::ReadProtocolValue(stream_, line_, "images", false, unused_step_, value);
+}
+
+void ComplexImageStreamReader::ReadBackgroundImpl(std::variant<std::complex<float>, std::string>& value) {
+  yardl::ndjson::ReadProtocolValue(stream_, line_, "background", true, unused_step_, value);
+}
+
+void ComplexImageStreamReader::CloseImpl() {
+  if (!skip_completed_check_) {
+    VerifyFinished();
+  }
+}
+
 void AliasesWriter::WriteAliasedStringImpl(test_model::AliasedString const& value) {
   ordered_json json_value = value;
   yardl::ndjson::WriteProtocolValue(stream_, "aliasedString", json_value);}
@@ -4137,6 +5132,36 @@ void ProtocolWithComputedFieldsReader::CloseImpl() {
   }
 }
 
+void ProtocolWithConstraintsWriter::WriteRecordImpl(test_model::RecordWithConstraints const& value) {
+  ordered_json json_value = value;
+  yardl::ndjson::WriteProtocolValue(stream_, "record", json_value);}
+
+void ProtocolWithConstraintsWriter::WriteRecordsImpl(test_model::RecordWithConstrainedRecords const& value) {
+  ordered_json json_value = value;
+  yardl::ndjson::WriteProtocolValue(stream_, "records", json_value);}
+
+void ProtocolWithConstraintsWriter::Flush() {
+  stream_.flush();
+}
+
+void ProtocolWithConstraintsWriter::CloseImpl() {
+  stream_.flush();
+}
+
+void ProtocolWithConstraintsReader::ReadRecordImpl(test_model::RecordWithConstraints& value) {
+  yardl::ndjson::ReadProtocolValue(stream_, line_, "record", true, unused_step_, value);
+}
+
+bool ProtocolWithConstraintsReader::ReadRecordsImpl(test_model::RecordWithConstrainedRecords& value) {
+  return yardl::ndjson::ReadProtocolValue(stream_, line_, "records", false, unused_step_, value);
+}
+
+void ProtocolWithConstraintsReader::CloseImpl() {
+  if (!skip_completed_check_) {
+    VerifyFinished();
+  }
+}
+
 void ProtocolWithKeywordStepsWriter::WriteIntImpl(test_model::RecordWithKeywordFields const& value) {
   ordered_json json_value = value;
   yardl::ndjson::WriteProtocolValue(stream_, "int", json_value);}
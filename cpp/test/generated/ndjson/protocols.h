@@ -408,6 +408,275 @@ class StringsReader : public test_model::StringsReaderBase, yardl::ndjson::NDJso
   void CloseImpl() override;
 };
 
+// NDJSON writer for the ProtocolWithBytes protocol.
+class ProtocolWithBytesWriter : public test_model::ProtocolWithBytesWriterBase, yardl::ndjson::NDJsonWriter {
+  public:
+  ProtocolWithBytesWriter(std::ostream& stream)
+      : yardl::ndjson::NDJsonWriter(stream, schema_) {
+  }
+
+  ProtocolWithBytesWriter(std::string file_name)
+      : yardl::ndjson::NDJsonWriter(file_name, schema_) {
+  }
+
+  void Flush() override;
+
+  protected:
+  void WriteSingleBytesImpl(std::vector<std::byte> const& value) override;
+  void WriteRecWithBytesImpl(test_model::RecordWithBytes const& value) override;
+  void CloseImpl() override;
+};
+
+// NDJSON reader for the ProtocolWithBytes protocol.
+class ProtocolWithBytesReader : public test_model::ProtocolWithBytesReaderBase, yardl::ndjson::NDJsonReader {
+  public:
+  ProtocolWithBytesReader(std::istream& stream, bool skip_completed_check=false)
+      : test_model::ProtocolWithBytesReaderBase(skip_completed_check), yardl::ndjson::NDJsonReader(stream, schema_) {
+  }
+
+  ProtocolWithBytesReader(std::string file_name, bool skip_completed_check=false)
+      : test_model::ProtocolWithBytesReaderBase(skip_completed_check), yardl::ndjson::NDJsonReader(file_name, schema_) {
+  }
+
+  protected:
+  void ReadSingleBytesImpl(std::vector<std::byte>& value) override;
+  void ReadRecWithBytesImpl(test_model::RecordWithBytes& value) override;
+  void CloseImpl() override;
+};
+
+// NDJSON writer for the ProtocolWithUuids protocol.
+class ProtocolWithUuidsWriter : public test_model::ProtocolWithUuidsWriterBase, yardl::ndjson::NDJsonWriter {
+  public:
+  ProtocolWithUuidsWriter(std::ostream& stream)
+      : yardl::ndjson::NDJsonWriter(stream, schema_) {
+  }
+
+  ProtocolWithUuidsWriter(std::string file_name)
+      : yardl::ndjson::NDJsonWriter(file_name, schema_) {
+  }
+
+  void Flush() override;
+
+  protected:
+  void WriteSingleUuidImpl(yardl::Uuid const& value) override;
+  void WriteRecWithUuidsImpl(test_model::RecordWithUuids const& value) override;
+  void CloseImpl() override;
+};
+
+// NDJSON reader for the ProtocolWithUuids protocol.
+class ProtocolWithUuidsReader : public test_model::ProtocolWithUuidsReaderBase, yardl::ndjson::NDJsonReader {
+  public:
+  ProtocolWithUuidsReader(std::istream& stream, bool skip_completed_check=false)
+      : test_model::ProtocolWithUuidsReaderBase(skip_completed_check), yardl::ndjson::NDJsonReader(stream, schema_) {
+  }
+
+  ProtocolWithUuidsReader(std::string file_name, bool skip_completed_check=false)
+      : test_model::ProtocolWithUuidsReaderBase(skip_completed_check), yardl::ndjson::NDJsonReader(file_name, schema_) {
+  }
+
+  protected:
+  void ReadSingleUuidImpl(yardl::Uuid& value) override;
+  void ReadRecWithUuidsImpl(test_model::RecordWithUuids& value) override;
+  void CloseImpl() override;
+};
+
+// NDJSON writer for the ProtocolWithDurations protocol.
+class ProtocolWithDurationsWriter : public test_model::ProtocolWithDurationsWriterBase, yardl::ndjson::NDJsonWriter {
+  public:
+  ProtocolWithDurationsWriter(std::ostream& stream)
+      : yardl::ndjson::NDJsonWriter(stream, schema_) {
+  }
+
+  ProtocolWithDurationsWriter(std::string file_name)
+      : yardl::ndjson::NDJsonWriter(file_name, schema_) {
+  }
+
+  void Flush() override;
+
+  protected:
+  void WriteSingleDurationImpl(yardl::Duration const& value) override;
+  void WriteRecWithDurationsImpl(test_model::RecordWithDurations const& value) override;
+  void CloseImpl() override;
+};
+
+// NDJSON reader for the ProtocolWithDurations protocol.
+class ProtocolWithDurationsReader : public test_model::ProtocolWithDurationsReaderBase, yardl::ndjson::NDJsonReader {
+  public:
+  ProtocolWithDurationsReader(std::istream& stream, bool skip_completed_check=false)
+      : test_model::ProtocolWithDurationsReaderBase(skip_completed_check), yardl::ndjson::NDJsonReader(stream, schema_) {
+  }
+
+  ProtocolWithDurationsReader(std::string file_name, bool skip_completed_check=false)
+      : test_model::ProtocolWithDurationsReaderBase(skip_completed_check), yardl::ndjson::NDJsonReader(file_name, schema_) {
+  }
+
+  protected:
+  void ReadSingleDurationImpl(yardl::Duration& value) override;
+  void ReadRecWithDurationsImpl(test_model::RecordWithDurations& value) override;
+  void CloseImpl() override;
+};
+
+// NDJSON writer for the ProtocolWithRecursiveRecords protocol.
+class ProtocolWithRecursiveRecordsWriter : public test_model::ProtocolWithRecursiveRecordsWriterBase, yardl::ndjson::NDJsonWriter {
+  public:
+  ProtocolWithRecursiveRecordsWriter(std::ostream& stream)
+      : yardl::ndjson::NDJsonWriter(stream, schema_) {
+  }
+
+  ProtocolWithRecursiveRecordsWriter(std::string file_name)
+      : yardl::ndjson::NDJsonWriter(file_name, schema_) {
+  }
+
+  void Flush() override;
+
+  protected:
+  void WriteTreeImpl(test_model::TreeNode const& value) override;
+  void WriteListImpl(test_model::LinkedListNode const& value) override;
+  void WriteExpressionsImpl(test_model::Expression const& value) override;
+  void EndExpressionsImpl() override {}
+  void CloseImpl() override;
+};
+
+// NDJSON reader for the ProtocolWithRecursiveRecords protocol.
+class ProtocolWithRecursiveRecordsReader : public test_model::ProtocolWithRecursiveRecordsReaderBase, yardl::ndjson::NDJsonReader {
+  public:
+  ProtocolWithRecursiveRecordsReader(std::istream& stream, bool skip_completed_check=false)
+      : test_model::ProtocolWithRecursiveRecordsReaderBase(skip_completed_check), yardl::ndjson::NDJsonReader(stream, schema_) {
+  }
+
+  ProtocolWithRecursiveRecordsReader(std::string file_name, bool skip_completed_check=false)
+      : test_model::ProtocolWithRecursiveRecordsReaderBase(skip_completed_check), yardl::ndjson::NDJsonReader(file_name, schema_) {
+  }
+
+  protected:
+  void ReadTreeImpl(test_model::TreeNode& value) override;
+  void ReadListImpl(test_model::LinkedListNode& value) override;
+  bool ReadExpressionsImpl(test_model::Expression& value) override;
+  void CloseImpl() override;
+};
+
+// NDJSON writer for the Calibration protocol.
+// A calibration phase shared by protocols
+class CalibrationWriter : public test_model::CalibrationWriterBase, yardl::ndjson::NDJsonWriter {
+  public:
+  CalibrationWriter(std::ostream& stream)
+      : yardl::ndjson::NDJsonWriter(stream, schema_) {
+  }
+
+  CalibrationWriter(std::string file_name)
+      : yardl::ndjson::NDJsonWriter(file_name, schema_) {
+  }
+
+  void Flush() override;
+
+  protected:
+  void WriteGainImpl(float const& value) override;
+  void WriteSamplesImpl(double const& value) override;
+  void EndSamplesImpl() override {}
+  void CloseImpl() override;
+};
+
+// NDJSON reader for the Calibration protocol.
+// A calibration phase shared by protocols
+class CalibrationReader : public test_model::CalibrationReaderBase, yardl::ndjson::NDJsonReader {
+  public:
+  CalibrationReader(std::istream& stream, bool skip_completed_check=false)
+      : test_model::CalibrationReaderBase(skip_completed_check), yardl::ndjson::NDJsonReader(stream, schema_) {
+  }
+
+  CalibrationReader(std::string file_name, bool skip_completed_check=false)
+      : test_model::CalibrationReaderBase(skip_completed_check), yardl::ndjson::NDJsonReader(file_name, schema_) {
+  }
+
+  protected:
+  void ReadGainImpl(float& value) override;
+  bool ReadSamplesImpl(double& value) override;
+  void CloseImpl() override;
+};
+
+// NDJSON writer for the ProtocolWithSubProtocols protocol.
+class ProtocolWithSubProtocolsWriter : public test_model::ProtocolWithSubProtocolsWriterBase, yardl::ndjson::NDJsonWriter {
+  public:
+  ProtocolWithSubProtocolsWriter(std::ostream& stream)
+      : yardl::ndjson::NDJsonWriter(stream, schema_) {
+  }
+
+  ProtocolWithSubProtocolsWriter(std::string file_name)
+      : yardl::ndjson::NDJsonWriter(file_name, schema_) {
+  }
+
+  void Flush() override;
+
+  protected:
+  void WriteHeaderImpl(std::string const& value) override;
+  void WriteCalibrationGainImpl(float const& value) override;
+  void WriteCalibrationSamplesImpl(double const& value) override;
+  void EndCalibrationSamplesImpl() override {}
+  void WriteDataImpl(int32_t const& value) override;
+  void EndDataImpl() override {}
+  void WriteRecalibrationGainImpl(float const& value) override;
+  void WriteRecalibrationSamplesImpl(double const& value) override;
+  void EndRecalibrationSamplesImpl() override {}
+  void CloseImpl() override;
+};
+
+// NDJSON reader for the ProtocolWithSubProtocols protocol.
+class ProtocolWithSubProtocolsReader : public test_model::ProtocolWithSubProtocolsReaderBase, yardl::ndjson::NDJsonReader {
+  public:
+  ProtocolWithSubProtocolsReader(std::istream& stream, bool skip_completed_check=false)
+      : test_model::ProtocolWithSubProtocolsReaderBase(skip_completed_check), yardl::ndjson::NDJsonReader(stream, schema_) {
+  }
+
+  ProtocolWithSubProtocolsReader(std::string file_name, bool skip_completed_check=false)
+      : test_model::ProtocolWithSubProtocolsReaderBase(skip_completed_check), yardl::ndjson::NDJsonReader(file_name, schema_) {
+  }
+
+  protected:
+  void ReadHeaderImpl(std::string& value) override;
+  void ReadCalibrationGainImpl(float& value) override;
+  bool ReadCalibrationSamplesImpl(double& value) override;
+  bool ReadDataImpl(int32_t& value) override;
+  void ReadRecalibrationGainImpl(float& value) override;
+  bool ReadRecalibrationSamplesImpl(double& value) override;
+  void CloseImpl() override;
+};
+
+// NDJSON writer for the ProtocolWithHalfPrecision protocol.
+class ProtocolWithHalfPrecisionWriter : public test_model::ProtocolWithHalfPrecisionWriterBase, yardl::ndjson::NDJsonWriter {
+  public:
+  ProtocolWithHalfPrecisionWriter(std::ostream& stream)
+      : yardl::ndjson::NDJsonWriter(stream, schema_) {
+  }
+
+  ProtocolWithHalfPrecisionWriter(std::string file_name)
+      : yardl::ndjson::NDJsonWriter(file_name, schema_) {
+  }
+
+  void Flush() override;
+
+  protected:
+  void WriteHalvesImpl(std::vector<yardl::Float16> const& value) override;
+  void WriteRecWithHalvesImpl(test_model::RecordWithHalfPrecision const& value) override;
+  void CloseImpl() override;
+};
+
+// NDJSON reader for the ProtocolWithHalfPrecision protocol.
+class ProtocolWithHalfPrecisionReader : public test_model::ProtocolWithHalfPrecisionReaderBase, yardl::ndjson::NDJsonReader {
+  public:
+  ProtocolWithHalfPrecisionReader(std::istream& stream, bool skip_completed_check=false)
+      : test_model::ProtocolWithHalfPrecisionReaderBase(skip_completed_check), yardl::ndjson::NDJsonReader(stream, schema_) {
+  }
+
+  ProtocolWithHalfPrecisionReader(std::string file_name, bool skip_completed_check=false)
+      : test_model::ProtocolWithHalfPrecisionReaderBase(skip_completed_check), yardl::ndjson::NDJsonReader(file_name, schema_) {
+  }
+
+  protected:
+  void ReadHalvesImpl(std::vector<yardl::Float16>& value) override;
+  void ReadRecWithHalvesImpl(test_model::RecordWithHalfPrecision& value) override;
+  void CloseImpl() override;
+};
+
 // NDJSON writer for the OptionalVectors protocol.
 class OptionalVectorsWriter : public test_model::OptionalVectorsWriterBase, yardl::ndjson::NDJsonWriter {
   public:
@@ -1185,6 +1454,88 @@ class AdvancedGenericsReader : public test_model::AdvancedGenericsReaderBase, ya
   void CloseImpl() override;
 };
 
+// NDJSON writer for the FloatImageStream protocol.
+// A protocol that is instantiated for each pixel type
+class FloatImageStreamWriter : public test_model::FloatImageStreamWriterBase, yardl::ndjson::NDJsonWriter {
+  public:
+  FloatImageStreamWriter(std::ostream& stream)
+      : yardl::ndjson::NDJsonWriter(stream, schema_) {
+  }
+
+  FloatImageStreamWriter(std::string file_name)
+      : yardl::ndjson::NDJsonWriter(file_name, schema_) {
+  }
+
+  void Flush() override;
+
+  protected:
+  void WriteHeaderImpl(test_model::GenericRecord<int32_t, float> const& value) override;
+  void WriteImagesImpl(test_model::Image<float> const& value) override;
+  void EndImagesImpl() override {}
+  void WriteBackgroundImpl(std::variant<float, std::string> const& value) override;
+  void CloseImpl() override;
+};
+
+// NDJSON reader for the FloatImageStream protocol.
+// A protocol that is instantiated for each pixel type
+class FloatImageStreamReader : public test_model::FloatImageStreamReaderBase, yardl::ndjson::NDJsonReader {
+  public:
+  FloatImageStreamReader(std::istream& stream, bool skip_completed_check=false)
+      : test_model::FloatImageStreamReaderBase(skip_completed_check), yardl::ndjson::NDJsonReader(stream, schema_) {
+  }
+
+  FloatImageStreamReader(std::string file_name, bool skip_completed_check=false)
+      : test_model::FloatImageStreamReaderBase(skip_completed_check), yardl::ndjson::NDJsonReader(file_name, schema_) {
+  }
+
+  protected:
+  void ReadHeaderImpl(test_model::GenericRecord<int32_t, float>& value) override;
+  bool ReadImagesImpl(test_model::Image<float>& value) override;
+  void ReadBackgroundImpl(std::variant<float, std::string>& value) override;
+  void CloseImpl() override;
+};
+
+// NDJSON writer for the ComplexImageStream protocol.
+// A protocol that is instantiated for each pixel type
+class ComplexImageStreamWriter : public test_model::ComplexImageStreamWriterBase, yardl::ndjson::NDJsonWriter {
+  public:
+  ComplexImageStreamWriter(std::ostream& stream)
+      : yardl::ndjson::NDJsonWriter(stream, schema_) {
+  }
+
+  ComplexImageStreamWriter(std::string file_name)
+      : yardl::ndjson::NDJsonWriter(file_name, schema_) {
+  }
+
+  void Flush() override;
+
+  protected:
+  void WriteHeaderImpl(test_model::GenericRecord<int32_t, std::complex<float>> const& value) override;
+  void WriteImagesImpl(test_model::Image<std::complex<float>> const& value) override;
+  void EndImagesImpl() override {}
+  void WriteBackgroundImpl(std::variant<std::complex<float>, std::string> const& value) override;
+  void CloseImpl() override;
+};
+
+// NDJSON reader for the ComplexImageStream protocol.
+// A protocol that is instantiated for each pixel type
+class ComplexImageStreamReader : public test_model::ComplexImageStreamReaderBase, yardl::ndjson::NDJsonReader {
+  public:
+  ComplexImageStreamReader(std::istream& stream, bool skip_completed_check=false)
+      : test_model::ComplexImageStreamReaderBase(skip_completed_check), yardl::ndjson::NDJsonReader(stream, schema_) {
+  }
+
+  ComplexImageStreamReader(std::string file_name, bool skip_completed_check=false)
+      : test_model::ComplexImageStreamReaderBase(skip_completed_check), yardl::ndjson::NDJsonReader(file_name, schema_) {
+  }
+
+  protected:
+  void ReadHeaderImpl(test_model::GenericRecord<int32_t, std::complex<float>>& value) override;
+  bool ReadImagesImpl(test_model::Image<std::complex<float>>& value) override;
+  void ReadBackgroundImpl(std::variant<std::complex<float>, std::string>& value) override;
+  void CloseImpl() override;
+};
+
 // NDJSON writer for the Aliases protocol.
 class AliasesWriter : public test_model::AliasesWriterBase, yardl::ndjson::NDJsonWriter {
   public:
@@ -1312,6 +1663,43 @@ class ProtocolWithComputedFieldsReader : public test_model::ProtocolWithComputed
   void CloseImpl() override;
 };
 
+// NDJSON writer for the ProtocolWithConstraints protocol.
+class ProtocolWithConstraintsWriter : public test_model::ProtocolWithConstraintsWriterBase, yardl::ndjson::NDJsonWriter {
+  public:
+  ProtocolWithConstraintsWriter(std::ostream& stream)
+      : yardl::ndjson::NDJsonWriter(stream, schema_) {
+  }
+
+  ProtocolWithConstraintsWriter(std::string file_name)
+      : yardl::ndjson::NDJsonWriter(file_name, schema_) {
+  }
+
+  void Flush() override;
+
+  protected:
+  void WriteRecordImpl(test_model::RecordWithConstraints const& value) override;
+  void WriteRecordsImpl(test_model::RecordWithConstrainedRecords const& value) override;
+  void EndRecordsImpl() override {}
+  void CloseImpl() override;
+};
+
+// NDJSON reader for the ProtocolWithConstraints protocol.
+class ProtocolWithConstraintsReader : public test_model::ProtocolWithConstraintsReaderBase, yardl::ndjson::NDJsonReader {
+  public:
+  ProtocolWithConstraintsReader(std::istream& stream, bool skip_completed_check=false)
+      : test_model::ProtocolWithConstraintsReaderBase(skip_completed_check), yardl::ndjson::NDJsonReader(stream, schema_) {
+  }
+
+  ProtocolWithConstraintsReader(std::string file_name, bool skip_completed_check=false)
+      : test_model::ProtocolWithConstraintsReaderBase(skip_completed_check), yardl::ndjson::NDJsonReader(file_name, schema_) {
+  }
+
+  protected:
+  void ReadRecordImpl(test_model::RecordWithConstraints& value) override;
+  bool ReadRecordsImpl(test_model::RecordWithConstrainedRecords& value) override;
+  void CloseImpl() override;
+};
+
 // NDJSON writer for the ProtocolWithKeywordSteps protocol.
 class ProtocolWithKeywordStepsWriter : public test_model::ProtocolWithKeywordStepsWriterBase, yardl::ndjson::NDJsonWriter {
   public:
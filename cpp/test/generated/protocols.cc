@@ -1694,24 +1694,27 @@ void StringsReaderBase::CopyTo(StringsWriterBase& writer) {
 }
 
 namespace {
-void OptionalVectorsWriterBaseInvalidState(uint8_t attempted, [[maybe_unused]] bool end, uint8_t current) {
+void ProtocolWithBytesWriterBaseInvalidState(uint8_t attempted, [[maybe_unused]] bool end, uint8_t current) {
   std::string expected_method;
   switch (current) {
-  case 0: expected_method = "WriteRecordWithOptionalVector()"; break;
+  case 0: expected_method = "WriteSingleBytes()"; break;
+  case 1: expected_method = "WriteRecWithBytes()"; break;
   }
   std::string attempted_method;
   switch (attempted) {
-  case 0: attempted_method = "WriteRecordWithOptionalVector()"; break;
-  case 1: attempted_method = "Close()"; break;
+  case 0: attempted_method = "WriteSingleBytes()"; break;
+  case 1: attempted_method = "WriteRecWithBytes()"; break;
+  case 2: attempted_method = "Close()"; break;
   }
   throw std::runtime_error("Expected call to " + expected_method + " but received call to " + attempted_method + " instead.");
 }
 
-void OptionalVectorsReaderBaseInvalidState(uint8_t attempted, uint8_t current) {
+void ProtocolWithBytesReaderBaseInvalidState(uint8_t attempted, uint8_t current) {
   auto f = [](uint8_t i) -> std::string {
     switch (i/2) {
-    case 0: return "ReadRecordWithOptionalVector()";
-    case 1: return "Close()";
+    case 0: return "ReadSingleBytes()";
+    case 1: return "ReadRecWithBytes()";
+    case 2: return "Close()";
     default: return "<unknown>";
     }
   };
@@ -1720,97 +1723,114 @@ void OptionalVectorsReaderBaseInvalidState(uint8_t attempted, uint8_t current) {
 
 } // namespace 
 
-std::string OptionalVectorsWriterBase::schema_ = R"({"protocol":{"name":"OptionalVectors","sequence":[{"name":"recordWithOptionalVector","type":"TestModel.RecordWithOptionalVector"}]},"types":[{"name":"RecordWithOptionalVector","fields":[{"name":"optionalVector","type":[null,{"vector":{"items":"int32"}}]}]}]})";
+std::string ProtocolWithBytesWriterBase::schema_ = R"({"protocol":{"name":"ProtocolWithBytes","sequence":[{"name":"singleBytes","type":"bytes"},{"name":"recWithBytes","type":"TestModel.RecordWithBytes"}]},"types":[{"name":"RecordWithBytes","fields":[{"name":"data","type":"bytes"},{"name":"optionalData","type":[null,"bytes"]},{"name":"chunks","type":{"vector":{"items":"bytes"}}}]}]})";
 
-std::vector<std::string> OptionalVectorsWriterBase::previous_schemas_ = {
+std::vector<std::string> ProtocolWithBytesWriterBase::previous_schemas_ = {
 };
 
-std::string OptionalVectorsWriterBase::SchemaFromVersion(Version version) {
+std::string ProtocolWithBytesWriterBase::SchemaFromVersion(Version version) {
   switch (version) {
-  case Version::Current: return OptionalVectorsWriterBase::schema_; break;
-  default: throw std::runtime_error("The version does not correspond to any schema supported by protocol OptionalVectors.");
+  case Version::Current: return ProtocolWithBytesWriterBase::schema_; break;
+  default: throw std::runtime_error("The version does not correspond to any schema supported by protocol ProtocolWithBytes.");
   }
 
 }
-void OptionalVectorsWriterBase::WriteRecordWithOptionalVector(test_model::RecordWithOptionalVector const& value) {
+void ProtocolWithBytesWriterBase::WriteSingleBytes(std::vector<std::byte> const& value) {
   if (unlikely(state_ != 0)) {
-    OptionalVectorsWriterBaseInvalidState(0, false, state_);
+    ProtocolWithBytesWriterBaseInvalidState(0, false, state_);
   }
 
-  WriteRecordWithOptionalVectorImpl(value);
+  WriteSingleBytesImpl(value);
   state_ = 1;
 }
 
-void OptionalVectorsWriterBase::Close() {
+void ProtocolWithBytesWriterBase::WriteRecWithBytes(test_model::RecordWithBytes const& value) {
   if (unlikely(state_ != 1)) {
-    OptionalVectorsWriterBaseInvalidState(1, false, state_);
+    ProtocolWithBytesWriterBaseInvalidState(1, false, state_);
+  }
+
+  WriteRecWithBytesImpl(value);
+  state_ = 2;
+}
+
+void ProtocolWithBytesWriterBase::Close() {
+  if (unlikely(state_ != 2)) {
+    ProtocolWithBytesWriterBaseInvalidState(2, false, state_);
   }
 
   CloseImpl();
 }
 
-std::string OptionalVectorsReaderBase::schema_ = OptionalVectorsWriterBase::schema_;
+std::string ProtocolWithBytesReaderBase::schema_ = ProtocolWithBytesWriterBase::schema_;
 
-std::vector<std::string> OptionalVectorsReaderBase::previous_schemas_ = OptionalVectorsWriterBase::previous_schemas_;
+std::vector<std::string> ProtocolWithBytesReaderBase::previous_schemas_ = ProtocolWithBytesWriterBase::previous_schemas_;
 
-Version OptionalVectorsReaderBase::VersionFromSchema(std::string const& schema) {
-  if (schema == OptionalVectorsWriterBase::schema_) {
+Version ProtocolWithBytesReaderBase::VersionFromSchema(std::string const& schema) {
+  if (schema == ProtocolWithBytesWriterBase::schema_) {
     return Version::Current;
   }
-  throw std::runtime_error("The schema does not match any version supported by protocol OptionalVectors.");
+  throw std::runtime_error("The schema does not match any version supported by protocol ProtocolWithBytes.");
 }
-void OptionalVectorsReaderBase::ReadRecordWithOptionalVector(test_model::RecordWithOptionalVector& value) {
+void ProtocolWithBytesReaderBase::ReadSingleBytes(std::vector<std::byte>& value) {
   if (unlikely(state_ != 0)) {
-    OptionalVectorsReaderBaseInvalidState(0, state_);
+    ProtocolWithBytesReaderBaseInvalidState(0, state_);
   }
 
-  ReadRecordWithOptionalVectorImpl(value);
+  ReadSingleBytesImpl(value);
   state_ = 2;
 }
 
-void OptionalVectorsReaderBase::Close() {
-  if (!skip_completed_check_ && unlikely(state_ != 2)) {
-    OptionalVectorsReaderBaseInvalidState(2, state_);
+void ProtocolWithBytesReaderBase::ReadRecWithBytes(test_model::RecordWithBytes& value) {
+  if (unlikely(state_ != 2)) {
+    ProtocolWithBytesReaderBaseInvalidState(2, state_);
+  }
+
+  ReadRecWithBytesImpl(value);
+  state_ = 4;
+}
+
+void ProtocolWithBytesReaderBase::Close() {
+  if (!skip_completed_check_ && unlikely(state_ != 4)) {
+    ProtocolWithBytesReaderBaseInvalidState(4, state_);
   }
 
   CloseImpl();
 }
-void OptionalVectorsReaderBase::CopyTo(OptionalVectorsWriterBase& writer) {
+void ProtocolWithBytesReaderBase::CopyTo(ProtocolWithBytesWriterBase& writer) {
   {
-    test_model::RecordWithOptionalVector value;
-    ReadRecordWithOptionalVector(value);
-    writer.WriteRecordWithOptionalVector(value);
+    std::vector<std::byte> value;
+    ReadSingleBytes(value);
+    writer.WriteSingleBytes(value);
+  }
+  {
+    test_model::RecordWithBytes value;
+    ReadRecWithBytes(value);
+    writer.WriteRecWithBytes(value);
   }
 }
 
 namespace {
-void FixedVectorsWriterBaseInvalidState(uint8_t attempted, [[maybe_unused]] bool end, uint8_t current) {
+void ProtocolWithUuidsWriterBaseInvalidState(uint8_t attempted, [[maybe_unused]] bool end, uint8_t current) {
   std::string expected_method;
   switch (current) {
-  case 0: expected_method = "WriteFixedIntVector()"; break;
-  case 1: expected_method = "WriteFixedSimpleRecordVector()"; break;
-  case 2: expected_method = "WriteFixedRecordWithVlensVector()"; break;
-  case 3: expected_method = "WriteRecordWithFixedVectors()"; break;
+  case 0: expected_method = "WriteSingleUuid()"; break;
+  case 1: expected_method = "WriteRecWithUuids()"; break;
   }
   std::string attempted_method;
   switch (attempted) {
-  case 0: attempted_method = "WriteFixedIntVector()"; break;
-  case 1: attempted_method = "WriteFixedSimpleRecordVector()"; break;
-  case 2: attempted_method = "WriteFixedRecordWithVlensVector()"; break;
-  case 3: attempted_method = "WriteRecordWithFixedVectors()"; break;
-  case 4: attempted_method = "Close()"; break;
+  case 0: attempted_method = "WriteSingleUuid()"; break;
+  case 1: attempted_method = "WriteRecWithUuids()"; break;
+  case 2: attempted_method = "Close()"; break;
   }
   throw std::runtime_error("Expected call to " + expected_method + " but received call to " + attempted_method + " instead.");
 }
 
-void FixedVectorsReaderBaseInvalidState(uint8_t attempted, uint8_t current) {
+void ProtocolWithUuidsReaderBaseInvalidState(uint8_t attempted, uint8_t current) {
   auto f = [](uint8_t i) -> std::string {
     switch (i/2) {
-    case 0: return "ReadFixedIntVector()";
-    case 1: return "ReadFixedSimpleRecordVector()";
-    case 2: return "ReadFixedRecordWithVlensVector()";
-    case 3: return "ReadRecordWithFixedVectors()";
-    case 4: return "Close()";
+    case 0: return "ReadSingleUuid()";
+    case 1: return "ReadRecWithUuids()";
+    case 2: return "Close()";
     default: return "<unknown>";
     }
   };
@@ -1819,166 +1839,233 @@ void FixedVectorsReaderBaseInvalidState(uint8_t attempted, uint8_t current) {
 
 } // namespace 
 
-std::string FixedVectorsWriterBase::schema_ = R"({"protocol":{"name":"FixedVectors","sequence":[{"name":"fixedIntVector","type":{"vector":{"items":"int32","length":5}}},{"name":"fixedSimpleRecordVector","type":{"vector":{"items":"TestModel.SimpleRecord","length":3}}},{"name":"fixedRecordWithVlensVector","type":{"vector":{"items":"TestModel.RecordWithVlens","length":2}}},{"name":"recordWithFixedVectors","type":"TestModel.RecordWithFixedVectors"}]},"types":[{"name":"RecordWithFixedVectors","fields":[{"name":"fixedIntVector","type":{"vector":{"items":"int32","length":5}}},{"name":"fixedSimpleRecordVector","type":{"vector":{"items":"TestModel.SimpleRecord","length":3}}},{"name":"fixedRecordWithVlensVector","type":{"vector":{"items":"TestModel.RecordWithVlens","length":2}}}]},{"name":"RecordWithVlens","fields":[{"name":"a","type":{"vector":{"items":"TestModel.SimpleRecord"}}},{"name":"b","type":"int32"},{"name":"c","type":"int32"}]},{"name":"SimpleRecord","fields":[{"name":"x","type":"int32"},{"name":"y","type":"int32"},{"name":"z","type":"int32"}]}]})";
+std::string ProtocolWithUuidsWriterBase::schema_ = R"({"protocol":{"name":"ProtocolWithUuids","sequence":[{"name":"singleUuid","type":"uuid"},{"name":"recWithUuids","type":"TestModel.RecordWithUuids"}]},"types":[{"name":"RecordWithUuids","fields":[{"name":"id","type":"uuid"},{"name":"optionalId","type":[null,"uuid"]},{"name":"related","type":{"vector":{"items":"uuid"}}},{"name":"names","type":{"map":{"keys":"uuid","values":"string"}}}]}]})";
 
-std::vector<std::string> FixedVectorsWriterBase::previous_schemas_ = {
+std::vector<std::string> ProtocolWithUuidsWriterBase::previous_schemas_ = {
 };
 
-std::string FixedVectorsWriterBase::SchemaFromVersion(Version version) {
+std::string ProtocolWithUuidsWriterBase::SchemaFromVersion(Version version) {
   switch (version) {
-  case Version::Current: return FixedVectorsWriterBase::schema_; break;
-  default: throw std::runtime_error("The version does not correspond to any schema supported by protocol FixedVectors.");
+  case Version::Current: return ProtocolWithUuidsWriterBase::schema_; break;
+  default: throw std::runtime_error("The version does not correspond to any schema supported by protocol ProtocolWithUuids.");
   }
 
 }
-void FixedVectorsWriterBase::WriteFixedIntVector(std::array<int32_t, 5> const& value) {
+void ProtocolWithUuidsWriterBase::WriteSingleUuid(yardl::Uuid const& value) {
   if (unlikely(state_ != 0)) {
-    FixedVectorsWriterBaseInvalidState(0, false, state_);
+    ProtocolWithUuidsWriterBaseInvalidState(0, false, state_);
   }
 
-  WriteFixedIntVectorImpl(value);
+  WriteSingleUuidImpl(value);
   state_ = 1;
 }
 
-void FixedVectorsWriterBase::WriteFixedSimpleRecordVector(std::array<test_model::SimpleRecord, 3> const& value) {
+void ProtocolWithUuidsWriterBase::WriteRecWithUuids(test_model::RecordWithUuids const& value) {
   if (unlikely(state_ != 1)) {
-    FixedVectorsWriterBaseInvalidState(1, false, state_);
+    ProtocolWithUuidsWriterBaseInvalidState(1, false, state_);
   }
 
-  WriteFixedSimpleRecordVectorImpl(value);
+  WriteRecWithUuidsImpl(value);
   state_ = 2;
 }
 
-void FixedVectorsWriterBase::WriteFixedRecordWithVlensVector(std::array<test_model::RecordWithVlens, 2> const& value) {
+void ProtocolWithUuidsWriterBase::Close() {
   if (unlikely(state_ != 2)) {
-    FixedVectorsWriterBaseInvalidState(2, false, state_);
+    ProtocolWithUuidsWriterBaseInvalidState(2, false, state_);
   }
 
-  WriteFixedRecordWithVlensVectorImpl(value);
-  state_ = 3;
+  CloseImpl();
 }
 
-void FixedVectorsWriterBase::WriteRecordWithFixedVectors(test_model::RecordWithFixedVectors const& value) {
-  if (unlikely(state_ != 3)) {
-    FixedVectorsWriterBaseInvalidState(3, false, state_);
+std::string ProtocolWithUuidsReaderBase::schema_ = ProtocolWithUuidsWriterBase::schema_;
+
+std::vector<std::string> ProtocolWithUuidsReaderBase::previous_schemas_ = ProtocolWithUuidsWriterBase::previous_schemas_;
+
+Version ProtocolWithUuidsReaderBase::VersionFromSchema(std::string const& schema) {
+  if (schema == ProtocolWithUuidsWriterBase::schema_) {
+    return Version::Current;
+  }
+  throw std::runtime_error("The schema does not match any version supported by protocol ProtocolWithUuids.");
+}
+void ProtocolWithUuidsReaderBase::ReadSingleUuid(yardl::Uuid& value) {
+  if (unlikely(state_ != 0)) {
+    ProtocolWithUuidsReaderBaseInvalidState(0, state_);
   }
 
-  WriteRecordWithFixedVectorsImpl(value);
+  ReadSingleUuidImpl(value);
+  state_ = 2;
+}
+
+void ProtocolWithUuidsReaderBase::ReadRecWithUuids(test_model::RecordWithUuids& value) {
+  if (unlikely(state_ != 2)) {
+    ProtocolWithUuidsReaderBaseInvalidState(2, state_);
+  }
+
+  ReadRecWithUuidsImpl(value);
   state_ = 4;
 }
 
-void FixedVectorsWriterBase::Close() {
-  if (unlikely(state_ != 4)) {
-    FixedVectorsWriterBaseInvalidState(4, false, state_);
+void ProtocolWithUuidsReaderBase::Close() {
+  if (!skip_completed_check_ && unlikely(state_ != 4)) {
+    ProtocolWithUuidsReaderBaseInvalidState(4, state_);
   }
 
   CloseImpl();
 }
+void ProtocolWithUuidsReaderBase::CopyTo(ProtocolWithUuidsWriterBase& writer) {
+  {
+    yardl::Uuid value;
+    ReadSingleUuid(value);
+    writer.WriteSingleUuid(value);
+  }
+  {
+    test_model::RecordWithUuids value;
+    ReadRecWithUuids(value);
+    writer.WriteRecWithUuids(value);
+  }
+}
 
-std::string FixedVectorsReaderBase::schema_ = FixedVectorsWriterBase::schema_;
+namespace {
+void ProtocolWithDurationsWriterBaseInvalidState(uint8_t attempted, [[maybe_unused]] bool end, uint8_t current) {
+  std::string expected_method;
+  switch (current) {
+  case 0: expected_method = "WriteSingleDuration()"; break;
+  case 1: expected_method = "WriteRecWithDurations()"; break;
+  }
+  std::string attempted_method;
+  switch (attempted) {
+  case 0: attempted_method = "WriteSingleDuration()"; break;
+  case 1: attempted_method = "WriteRecWithDurations()"; break;
+  case 2: attempted_method = "Close()"; break;
+  }
+  throw std::runtime_error("Expected call to " + expected_method + " but received call to " + attempted_method + " instead.");
+}
 
-std::vector<std::string> FixedVectorsReaderBase::previous_schemas_ = FixedVectorsWriterBase::previous_schemas_;
+void ProtocolWithDurationsReaderBaseInvalidState(uint8_t attempted, uint8_t current) {
+  auto f = [](uint8_t i) -> std::string {
+    switch (i/2) {
+    case 0: return "ReadSingleDuration()";
+    case 1: return "ReadRecWithDurations()";
+    case 2: return "Close()";
+    default: return "<unknown>";
+    }
+  };
+  throw std::runtime_error("Expected call to " + f(current) + " but received call to " + f(attempted) + " instead.");
+}
 
-Version FixedVectorsReaderBase::VersionFromSchema(std::string const& schema) {
-  if (schema == FixedVectorsWriterBase::schema_) {
-    return Version::Current;
+} // namespace 
+
+std::string ProtocolWithDurationsWriterBase::schema_ = R"({"protocol":{"name":"ProtocolWithDurations","sequence":[{"name":"singleDuration","type":"duration"},{"name":"recWithDurations","type":"TestModel.RecordWithDurations"}]},"types":[{"name":"RecordWithDurations","fields":[{"name":"acquisitionStart","type":"datetime"},{"name":"acquisitionEnd","type":"datetime"},{"name":"repetitionTime","type":"duration"},{"name":"timeout","type":[null,"duration"]},{"name":"intervals","type":{"vector":{"items":"duration"}}}]}]})";
+
+std::vector<std::string> ProtocolWithDurationsWriterBase::previous_schemas_ = {
+};
+
+std::string ProtocolWithDurationsWriterBase::SchemaFromVersion(Version version) {
+  switch (version) {
+  case Version::Current: return ProtocolWithDurationsWriterBase::schema_; break;
+  default: throw std::runtime_error("The version does not correspond to any schema supported by protocol ProtocolWithDurations.");
   }
-  throw std::runtime_error("The schema does not match any version supported by protocol FixedVectors.");
+
 }
-void FixedVectorsReaderBase::ReadFixedIntVector(std::array<int32_t, 5>& value) {
+void ProtocolWithDurationsWriterBase::WriteSingleDuration(yardl::Duration const& value) {
   if (unlikely(state_ != 0)) {
-    FixedVectorsReaderBaseInvalidState(0, state_);
+    ProtocolWithDurationsWriterBaseInvalidState(0, false, state_);
   }
 
-  ReadFixedIntVectorImpl(value);
+  WriteSingleDurationImpl(value);
+  state_ = 1;
+}
+
+void ProtocolWithDurationsWriterBase::WriteRecWithDurations(test_model::RecordWithDurations const& value) {
+  if (unlikely(state_ != 1)) {
+    ProtocolWithDurationsWriterBaseInvalidState(1, false, state_);
+  }
+
+  WriteRecWithDurationsImpl(value);
   state_ = 2;
 }
 
-void FixedVectorsReaderBase::ReadFixedSimpleRecordVector(std::array<test_model::SimpleRecord, 3>& value) {
+void ProtocolWithDurationsWriterBase::Close() {
   if (unlikely(state_ != 2)) {
-    FixedVectorsReaderBaseInvalidState(2, state_);
+    ProtocolWithDurationsWriterBaseInvalidState(2, false, state_);
   }
 
-  ReadFixedSimpleRecordVectorImpl(value);
-  state_ = 4;
+  CloseImpl();
 }
 
-void FixedVectorsReaderBase::ReadFixedRecordWithVlensVector(std::array<test_model::RecordWithVlens, 2>& value) {
-  if (unlikely(state_ != 4)) {
-    FixedVectorsReaderBaseInvalidState(4, state_);
+std::string ProtocolWithDurationsReaderBase::schema_ = ProtocolWithDurationsWriterBase::schema_;
+
+std::vector<std::string> ProtocolWithDurationsReaderBase::previous_schemas_ = ProtocolWithDurationsWriterBase::previous_schemas_;
+
+Version ProtocolWithDurationsReaderBase::VersionFromSchema(std::string const& schema) {
+  if (schema == ProtocolWithDurationsWriterBase::schema_) {
+    return Version::Current;
+  }
+  throw std::runtime_error("The schema does not match any version supported by protocol ProtocolWithDurations.");
+}
+void ProtocolWithDurationsReaderBase::ReadSingleDuration(yardl::Duration& value) {
+  if (unlikely(state_ != 0)) {
+    ProtocolWithDurationsReaderBaseInvalidState(0, state_);
   }
 
-  ReadFixedRecordWithVlensVectorImpl(value);
-  state_ = 6;
+  ReadSingleDurationImpl(value);
+  state_ = 2;
 }
 
-void FixedVectorsReaderBase::ReadRecordWithFixedVectors(test_model::RecordWithFixedVectors& value) {
-  if (unlikely(state_ != 6)) {
-    FixedVectorsReaderBaseInvalidState(6, state_);
+void ProtocolWithDurationsReaderBase::ReadRecWithDurations(test_model::RecordWithDurations& value) {
+  if (unlikely(state_ != 2)) {
+    ProtocolWithDurationsReaderBaseInvalidState(2, state_);
   }
 
-  ReadRecordWithFixedVectorsImpl(value);
-  state_ = 8;
+  ReadRecWithDurationsImpl(value);
+  state_ = 4;
 }
 
-void FixedVectorsReaderBase::Close() {
-  if (!skip_completed_check_ && unlikely(state_ != 8)) {
-    FixedVectorsReaderBaseInvalidState(8, state_);
+void ProtocolWithDurationsReaderBase::Close() {
+  if (!skip_completed_check_ && unlikely(state_ != 4)) {
+    ProtocolWithDurationsReaderBaseInvalidState(4, state_);
   }
 
   CloseImpl();
 }
-void FixedVectorsReaderBase::CopyTo(FixedVectorsWriterBase& writer) {
-  {
-    std::array<int32_t, 5> value;
-    ReadFixedIntVector(value);
-    writer.WriteFixedIntVector(value);
-  }
-  {
-    std::array<test_model::SimpleRecord, 3> value;
-    ReadFixedSimpleRecordVector(value);
-    writer.WriteFixedSimpleRecordVector(value);
-  }
+void ProtocolWithDurationsReaderBase::CopyTo(ProtocolWithDurationsWriterBase& writer) {
   {
-    std::array<test_model::RecordWithVlens, 2> value;
-    ReadFixedRecordWithVlensVector(value);
-    writer.WriteFixedRecordWithVlensVector(value);
+    yardl::Duration value;
+    ReadSingleDuration(value);
+    writer.WriteSingleDuration(value);
   }
   {
-    test_model::RecordWithFixedVectors value;
-    ReadRecordWithFixedVectors(value);
-    writer.WriteRecordWithFixedVectors(value);
+    test_model::RecordWithDurations value;
+    ReadRecWithDurations(value);
+    writer.WriteRecWithDurations(value);
   }
 }
 
 namespace {
-void StreamsWriterBaseInvalidState(uint8_t attempted, [[maybe_unused]] bool end, uint8_t current) {
+void ProtocolWithRecursiveRecordsWriterBaseInvalidState(uint8_t attempted, [[maybe_unused]] bool end, uint8_t current) {
   std::string expected_method;
   switch (current) {
-  case 0: expected_method = "WriteIntData() or EndIntData()"; break;
-  case 1: expected_method = "WriteOptionalIntData() or EndOptionalIntData()"; break;
-  case 2: expected_method = "WriteRecordWithOptionalVectorData() or EndRecordWithOptionalVectorData()"; break;
-  case 3: expected_method = "WriteFixedVector() or EndFixedVector()"; break;
+  case 0: expected_method = "WriteTree()"; break;
+  case 1: expected_method = "WriteList()"; break;
+  case 2: expected_method = "WriteExpressions() or EndExpressions()"; break;
   }
   std::string attempted_method;
   switch (attempted) {
-  case 0: attempted_method = end ? "EndIntData()" : "WriteIntData()"; break;
-  case 1: attempted_method = end ? "EndOptionalIntData()" : "WriteOptionalIntData()"; break;
-  case 2: attempted_method = end ? "EndRecordWithOptionalVectorData()" : "WriteRecordWithOptionalVectorData()"; break;
-  case 3: attempted_method = end ? "EndFixedVector()" : "WriteFixedVector()"; break;
-  case 4: attempted_method = "Close()"; break;
+  case 0: attempted_method = "WriteTree()"; break;
+  case 1: attempted_method = "WriteList()"; break;
+  case 2: attempted_method = end ? "EndExpressions()" : "WriteExpressions()"; break;
+  case 3: attempted_method = "Close()"; break;
   }
   throw std::runtime_error("Expected call to " + expected_method + " but received call to " + attempted_method + " instead.");
 }
 
-void StreamsReaderBaseInvalidState(uint8_t attempted, uint8_t current) {
+void ProtocolWithRecursiveRecordsReaderBaseInvalidState(uint8_t attempted, uint8_t current) {
   auto f = [](uint8_t i) -> std::string {
     switch (i/2) {
-    case 0: return "ReadIntData()";
-    case 1: return "ReadOptionalIntData()";
-    case 2: return "ReadRecordWithOptionalVectorData()";
-    case 3: return "ReadFixedVector()";
-    case 4: return "Close()";
+    case 0: return "ReadTree()";
+    case 1: return "ReadList()";
+    case 2: return "ReadExpressions()";
+    case 3: return "Close()";
     default: return "<unknown>";
     }
   };
@@ -1987,239 +2074,323 @@ void StreamsReaderBaseInvalidState(uint8_t attempted, uint8_t current) {
 
 } // namespace 
 
-std::string StreamsWriterBase::schema_ = R"({"protocol":{"name":"Streams","sequence":[{"name":"intData","type":{"stream":{"items":"int32"}}},{"name":"optionalIntData","type":{"stream":{"items":[null,"int32"]}}},{"name":"recordWithOptionalVectorData","type":{"stream":{"items":"TestModel.RecordWithOptionalVector"}}},{"name":"fixedVector","type":{"stream":{"items":{"vector":{"items":"int32","length":3}}}}}]},"types":[{"name":"RecordWithOptionalVector","fields":[{"name":"optionalVector","type":[null,{"vector":{"items":"int32"}}]}]}]})";
+std::string ProtocolWithRecursiveRecordsWriterBase::schema_ = R"({"protocol":{"name":"ProtocolWithRecursiveRecords","sequence":[{"name":"tree","type":"TestModel.TreeNode"},{"name":"list","type":"TestModel.LinkedListNode"},{"name":"expressions","type":{"stream":{"items":"TestModel.Expression"}}}]},"types":[{"name":"Expression","fields":[{"name":"name","type":"string"},{"name":"operand","type":[{"tag":"int32","type":"int32"},{"tag":"Expression","type":"TestModel.Expression"}]},{"name":"arguments","type":{"map":{"keys":"string","values":"TestModel.Expression"}}}]},{"name":"LinkedListNode","fields":[{"name":"value","type":"int32"},{"name":"next","type":[null,"TestModel.LinkedListNode"]}]},{"name":"TreeNode","fields":[{"name":"label","type":"string"},{"name":"children","type":{"vector":{"items":"TestModel.TreeNode"}}}]}]})";
 
-std::vector<std::string> StreamsWriterBase::previous_schemas_ = {
+std::vector<std::string> ProtocolWithRecursiveRecordsWriterBase::previous_schemas_ = {
 };
 
-std::string StreamsWriterBase::SchemaFromVersion(Version version) {
+std::string ProtocolWithRecursiveRecordsWriterBase::SchemaFromVersion(Version version) {
   switch (version) {
-  case Version::Current: return StreamsWriterBase::schema_; break;
-  default: throw std::runtime_error("The version does not correspond to any schema supported by protocol Streams.");
+  case Version::Current: return ProtocolWithRecursiveRecordsWriterBase::schema_; break;
+  default: throw std::runtime_error("The version does not correspond to any schema supported by protocol ProtocolWithRecursiveRecords.");
   }
 
 }
-void StreamsWriterBase::WriteIntData(int32_t const& value) {
+void ProtocolWithRecursiveRecordsWriterBase::WriteTree(test_model::TreeNode const& value) {
   if (unlikely(state_ != 0)) {
-    StreamsWriterBaseInvalidState(0, false, state_);
+    ProtocolWithRecursiveRecordsWriterBaseInvalidState(0, false, state_);
   }
 
-  WriteIntDataImpl(value);
+  WriteTreeImpl(value);
+  state_ = 1;
 }
 
-void StreamsWriterBase::WriteIntData(std::vector<int32_t> const& values) {
-  if (unlikely(state_ != 0)) {
-    StreamsWriterBaseInvalidState(0, false, state_);
+void ProtocolWithRecursiveRecordsWriterBase::WriteList(test_model::LinkedListNode const& value) {
+  if (unlikely(state_ != 1)) {
+    ProtocolWithRecursiveRecordsWriterBaseInvalidState(1, false, state_);
   }
 
-  WriteIntDataImpl(values);
+  WriteListImpl(value);
+  state_ = 2;
 }
 
-void StreamsWriterBase::EndIntData() {
-  if (unlikely(state_ != 0)) {
-    StreamsWriterBaseInvalidState(0, true, state_);
+void ProtocolWithRecursiveRecordsWriterBase::WriteExpressions(test_model::Expression const& value) {
+  if (unlikely(state_ != 2)) {
+    ProtocolWithRecursiveRecordsWriterBaseInvalidState(2, false, state_);
   }
 
-  EndIntDataImpl();
-  state_ = 1;
+  WriteExpressionsImpl(value);
 }
 
-// fallback implementation
-void StreamsWriterBase::WriteIntDataImpl(std::vector<int32_t> const& values) {
-  for (auto const& v : values) {
-    WriteIntDataImpl(v);
+void ProtocolWithRecursiveRecordsWriterBase::WriteExpressions(std::vector<test_model::Expression> const& values) {
+  if (unlikely(state_ != 2)) {
+    ProtocolWithRecursiveRecordsWriterBaseInvalidState(2, false, state_);
   }
+
+  WriteExpressionsImpl(values);
 }
 
-void StreamsWriterBase::WriteOptionalIntData(std::optional<int32_t> const& value) {
-  if (unlikely(state_ != 1)) {
-    StreamsWriterBaseInvalidState(1, false, state_);
+void ProtocolWithRecursiveRecordsWriterBase::EndExpressions() {
+  if (unlikely(state_ != 2)) {
+    ProtocolWithRecursiveRecordsWriterBaseInvalidState(2, true, state_);
   }
 
-  WriteOptionalIntDataImpl(value);
+  EndExpressionsImpl();
+  state_ = 3;
 }
 
-void StreamsWriterBase::WriteOptionalIntData(std::vector<std::optional<int32_t>> const& values) {
-  if (unlikely(state_ != 1)) {
-    StreamsWriterBaseInvalidState(1, false, state_);
+// fallback implementation
+void ProtocolWithRecursiveRecordsWriterBase::WriteExpressionsImpl(std::vector<test_model::Expression> const& values) {
+  for (auto const& v : values) {
+    WriteExpressionsImpl(v);
   }
-
-  WriteOptionalIntDataImpl(values);
 }
 
-void StreamsWriterBase::EndOptionalIntData() {
-  if (unlikely(state_ != 1)) {
-    StreamsWriterBaseInvalidState(1, true, state_);
+void ProtocolWithRecursiveRecordsWriterBase::Close() {
+  if (unlikely(state_ != 3)) {
+    ProtocolWithRecursiveRecordsWriterBaseInvalidState(3, false, state_);
   }
 
-  EndOptionalIntDataImpl();
-  state_ = 2;
+  CloseImpl();
 }
 
-// fallback implementation
-void StreamsWriterBase::WriteOptionalIntDataImpl(std::vector<std::optional<int32_t>> const& values) {
-  for (auto const& v : values) {
-    WriteOptionalIntDataImpl(v);
+std::string ProtocolWithRecursiveRecordsReaderBase::schema_ = ProtocolWithRecursiveRecordsWriterBase::schema_;
+
+std::vector<std::string> ProtocolWithRecursiveRecordsReaderBase::previous_schemas_ = ProtocolWithRecursiveRecordsWriterBase::previous_schemas_;
+
+Version ProtocolWithRecursiveRecordsReaderBase::VersionFromSchema(std::string const& schema) {
+  if (schema == ProtocolWithRecursiveRecordsWriterBase::schema_) {
+    return Version::Current;
   }
+  throw std::runtime_error("The schema does not match any version supported by protocol ProtocolWithRecursiveRecords.");
 }
+void ProtocolWithRecursiveRecordsReaderBase::ReadTree(test_model::TreeNode& value) {
+  if (unlikely(state_ != 0)) {
+    ProtocolWithRecursiveRecordsReaderBaseInvalidState(0, state_);
+  }
 
-void StreamsWriterBase::WriteRecordWithOptionalVectorData(test_model::RecordWithOptionalVector const& value) {
+  ReadTreeImpl(value);
+  state_ = 2;
+}
+
+void ProtocolWithRecursiveRecordsReaderBase::ReadList(test_model::LinkedListNode& value) {
   if (unlikely(state_ != 2)) {
-    StreamsWriterBaseInvalidState(2, false, state_);
+    ProtocolWithRecursiveRecordsReaderBaseInvalidState(2, state_);
   }
 
-  WriteRecordWithOptionalVectorDataImpl(value);
+  ReadListImpl(value);
+  state_ = 4;
 }
 
-void StreamsWriterBase::WriteRecordWithOptionalVectorData(std::vector<test_model::RecordWithOptionalVector> const& values) {
-  if (unlikely(state_ != 2)) {
-    StreamsWriterBaseInvalidState(2, false, state_);
+bool ProtocolWithRecursiveRecordsReaderBase::ReadExpressions(test_model::Expression& value) {
+  if (unlikely(state_ != 4)) {
+    if (state_ == 5) {
+      state_ = 6;
+      return false;
+    }
+    ProtocolWithRecursiveRecordsReaderBaseInvalidState(4, state_);
   }
 
-  WriteRecordWithOptionalVectorDataImpl(values);
+  bool result = ReadExpressionsImpl(value);
+  if (!result) {
+    state_ = 6;
+  }
+  return result;
 }
 
-void StreamsWriterBase::EndRecordWithOptionalVectorData() {
-  if (unlikely(state_ != 2)) {
-    StreamsWriterBaseInvalidState(2, true, state_);
+bool ProtocolWithRecursiveRecordsReaderBase::ReadExpressions(std::vector<test_model::Expression>& values) {
+  if (values.capacity() == 0) {
+    throw std::runtime_error("vector must have a nonzero capacity.");
+  }
+  if (unlikely(state_ != 4)) {
+    if (state_ == 5) {
+      state_ = 6;
+      values.clear();
+      return false;
+    }
+    ProtocolWithRecursiveRecordsReaderBaseInvalidState(4, state_);
   }
 
-  EndRecordWithOptionalVectorDataImpl();
-  state_ = 3;
+  if (!ReadExpressionsImpl(values)) {
+    state_ = 5;
+    return values.size() > 0;
+  }
+  return true;
 }
 
 // fallback implementation
-void StreamsWriterBase::WriteRecordWithOptionalVectorDataImpl(std::vector<test_model::RecordWithOptionalVector> const& values) {
-  for (auto const& v : values) {
-    WriteRecordWithOptionalVectorDataImpl(v);
+bool ProtocolWithRecursiveRecordsReaderBase::ReadExpressionsImpl(std::vector<test_model::Expression>& values) {
+  size_t i = 0;
+  while (true) {
+    if (i == values.size()) {
+      values.resize(i + 1);
+    }
+    if (!ReadExpressionsImpl(values[i])) {
+      values.resize(i);
+      return false;
+    }
+    i++;
+    if (i == values.capacity()) {
+      return true;
+    }
   }
 }
 
-void StreamsWriterBase::WriteFixedVector(std::array<int32_t, 3> const& value) {
-  if (unlikely(state_ != 3)) {
-    StreamsWriterBaseInvalidState(3, false, state_);
+void ProtocolWithRecursiveRecordsReaderBase::Close() {
+  if (!skip_completed_check_ && unlikely(state_ != 6)) {
+    if (state_ == 5) {
+      state_ = 6;
+    } else {
+      ProtocolWithRecursiveRecordsReaderBaseInvalidState(6, state_);
+    }
   }
 
-  WriteFixedVectorImpl(value);
+  CloseImpl();
+}
+void ProtocolWithRecursiveRecordsReaderBase::CopyTo(ProtocolWithRecursiveRecordsWriterBase& writer, size_t expressions_buffer_size) {
+  {
+    test_model::TreeNode value;
+    ReadTree(value);
+    writer.WriteTree(value);
+  }
+  {
+    test_model::LinkedListNode value;
+    ReadList(value);
+    writer.WriteList(value);
+  }
+  if (expressions_buffer_size > 1) {
+    std::vector<test_model::Expression> values;
+    values.reserve(expressions_buffer_size);
+    while(ReadExpressions(values)) {
+      writer.WriteExpressions(values);
+    }
+    writer.EndExpressions();
+  } else {
+    test_model::Expression value;
+    while(ReadExpressions(value)) {
+      writer.WriteExpressions(value);
+    }
+    writer.EndExpressions();
+  }
 }
 
-void StreamsWriterBase::WriteFixedVector(std::vector<std::array<int32_t, 3>> const& values) {
-  if (unlikely(state_ != 3)) {
-    StreamsWriterBaseInvalidState(3, false, state_);
+namespace {
+void CalibrationWriterBaseInvalidState(uint8_t attempted, [[maybe_unused]] bool end, uint8_t current) {
+  std::string expected_method;
+  switch (current) {
+  case 0: expected_method = "WriteGain()"; break;
+  case 1: expected_method = "WriteSamples() or EndSamples()"; break;
+  }
+  std::string attempted_method;
+  switch (attempted) {
+  case 0: attempted_method = "WriteGain()"; break;
+  case 1: attempted_method = end ? "EndSamples()" : "WriteSamples()"; break;
+  case 2: attempted_method = "Close()"; break;
   }
+  throw std::runtime_error("Expected call to " + expected_method + " but received call to " + attempted_method + " instead.");
+}
 
-  WriteFixedVectorImpl(values);
+void CalibrationReaderBaseInvalidState(uint8_t attempted, uint8_t current) {
+  auto f = [](uint8_t i) -> std::string {
+    switch (i/2) {
+    case 0: return "ReadGain()";
+    case 1: return "ReadSamples()";
+    case 2: return "Close()";
+    default: return "<unknown>";
+    }
+  };
+  throw std::runtime_error("Expected call to " + f(current) + " but received call to " + f(attempted) + " instead.");
 }
 
-void StreamsWriterBase::EndFixedVector() {
-  if (unlikely(state_ != 3)) {
-    StreamsWriterBaseInvalidState(3, true, state_);
+} // namespace 
+
+std::string CalibrationWriterBase::schema_ = R"({"protocol":{"name":"Calibration","sequence":[{"name":"gain","type":"float32"},{"name":"samples","type":{"stream":{"items":"float64"}}}]},"types":null})";
+
+std::vector<std::string> CalibrationWriterBase::previous_schemas_ = {
+};
+
+std::string CalibrationWriterBase::SchemaFromVersion(Version version) {
+  switch (version) {
+  case Version::Current: return CalibrationWriterBase::schema_; break;
+  default: throw std::runtime_error("The version does not correspond to any schema supported by protocol Calibration.");
   }
 
-  EndFixedVectorImpl();
-  state_ = 4;
 }
-
-// fallback implementation
-void StreamsWriterBase::WriteFixedVectorImpl(std::vector<std::array<int32_t, 3>> const& values) {
-  for (auto const& v : values) {
-    WriteFixedVectorImpl(v);
+void CalibrationWriterBase::WriteGain(float const& value) {
+  if (unlikely(state_ != 0)) {
+    CalibrationWriterBaseInvalidState(0, false, state_);
   }
+
+  WriteGainImpl(value);
+  state_ = 1;
 }
 
-void StreamsWriterBase::Close() {
-  if (unlikely(state_ != 4)) {
-    StreamsWriterBaseInvalidState(4, false, state_);
+void CalibrationWriterBase::WriteSamples(double const& value) {
+  if (unlikely(state_ != 1)) {
+    CalibrationWriterBaseInvalidState(1, false, state_);
   }
 
-  CloseImpl();
+  WriteSamplesImpl(value);
 }
 
-std::string StreamsReaderBase::schema_ = StreamsWriterBase::schema_;
+void CalibrationWriterBase::WriteSamples(std::vector<double> const& values) {
+  if (unlikely(state_ != 1)) {
+    CalibrationWriterBaseInvalidState(1, false, state_);
+  }
 
-std::vector<std::string> StreamsReaderBase::previous_schemas_ = StreamsWriterBase::previous_schemas_;
+  WriteSamplesImpl(values);
+}
 
-Version StreamsReaderBase::VersionFromSchema(std::string const& schema) {
-  if (schema == StreamsWriterBase::schema_) {
-    return Version::Current;
+void CalibrationWriterBase::EndSamples() {
+  if (unlikely(state_ != 1)) {
+    CalibrationWriterBaseInvalidState(1, true, state_);
   }
-  throw std::runtime_error("The schema does not match any version supported by protocol Streams.");
+
+  EndSamplesImpl();
+  state_ = 2;
 }
-bool StreamsReaderBase::ReadIntData(int32_t& value) {
-  if (unlikely(state_ != 0)) {
-    if (state_ == 1) {
-      state_ = 2;
-      return false;
-    }
-    StreamsReaderBaseInvalidState(0, state_);
-  }
 
-  bool result = ReadIntDataImpl(value);
-  if (!result) {
-    state_ = 2;
+// fallback implementation
+void CalibrationWriterBase::WriteSamplesImpl(std::vector<double> const& values) {
+  for (auto const& v : values) {
+    WriteSamplesImpl(v);
   }
-  return result;
 }
 
-bool StreamsReaderBase::ReadIntData(std::vector<int32_t>& values) {
-  if (values.capacity() == 0) {
-    throw std::runtime_error("vector must have a nonzero capacity.");
-  }
-  if (unlikely(state_ != 0)) {
-    if (state_ == 1) {
-      state_ = 2;
-      values.clear();
-      return false;
-    }
-    StreamsReaderBaseInvalidState(0, state_);
+void CalibrationWriterBase::Close() {
+  if (unlikely(state_ != 2)) {
+    CalibrationWriterBaseInvalidState(2, false, state_);
   }
 
-  if (!ReadIntDataImpl(values)) {
-    state_ = 1;
-    return values.size() > 0;
-  }
-  return true;
+  CloseImpl();
 }
 
-// fallback implementation
-bool StreamsReaderBase::ReadIntDataImpl(std::vector<int32_t>& values) {
-  size_t i = 0;
-  while (true) {
-    if (i == values.size()) {
-      values.resize(i + 1);
-    }
-    if (!ReadIntDataImpl(values[i])) {
-      values.resize(i);
-      return false;
-    }
-    i++;
-    if (i == values.capacity()) {
-      return true;
-    }
+std::string CalibrationReaderBase::schema_ = CalibrationWriterBase::schema_;
+
+std::vector<std::string> CalibrationReaderBase::previous_schemas_ = CalibrationWriterBase::previous_schemas_;
+
+Version CalibrationReaderBase::VersionFromSchema(std::string const& schema) {
+  if (schema == CalibrationWriterBase::schema_) {
+    return Version::Current;
   }
+  throw std::runtime_error("The schema does not match any version supported by protocol Calibration.");
 }
+void CalibrationReaderBase::ReadGain(float& value) {
+  if (unlikely(state_ != 0)) {
+    CalibrationReaderBaseInvalidState(0, state_);
+  }
 
-bool StreamsReaderBase::ReadOptionalIntData(std::optional<int32_t>& value) {
+  ReadGainImpl(value);
+  state_ = 2;
+}
+
+bool CalibrationReaderBase::ReadSamples(double& value) {
   if (unlikely(state_ != 2)) {
     if (state_ == 3) {
       state_ = 4;
       return false;
     }
-    if (state_ == 1) {
-      state_ = 2;
-    } else {
-      StreamsReaderBaseInvalidState(2, state_);
-    }
+    CalibrationReaderBaseInvalidState(2, state_);
   }
 
-  bool result = ReadOptionalIntDataImpl(value);
+  bool result = ReadSamplesImpl(value);
   if (!result) {
     state_ = 4;
   }
   return result;
 }
 
-bool StreamsReaderBase::ReadOptionalIntData(std::vector<std::optional<int32_t>>& values) {
+bool CalibrationReaderBase::ReadSamples(std::vector<double>& values) {
   if (values.capacity() == 0) {
     throw std::runtime_error("vector must have a nonzero capacity.");
   }
@@ -2229,14 +2400,10 @@ bool StreamsReaderBase::ReadOptionalIntData(std::vector<std::optional<int32_t>>&
       values.clear();
       return false;
     }
-    if (state_ == 1) {
-      state_ = 2;
-    } else {
-      StreamsReaderBaseInvalidState(2, state_);
-    }
+    CalibrationReaderBaseInvalidState(2, state_);
   }
 
-  if (!ReadOptionalIntDataImpl(values)) {
+  if (!ReadSamplesImpl(values)) {
     state_ = 3;
     return values.size() > 0;
   }
@@ -2244,13 +2411,13 @@ bool StreamsReaderBase::ReadOptionalIntData(std::vector<std::optional<int32_t>>&
 }
 
 // fallback implementation
-bool StreamsReaderBase::ReadOptionalIntDataImpl(std::vector<std::optional<int32_t>>& values) {
+bool CalibrationReaderBase::ReadSamplesImpl(std::vector<double>& values) {
   size_t i = 0;
   while (true) {
     if (i == values.size()) {
       values.resize(i + 1);
     }
-    if (!ReadOptionalIntDataImpl(values[i])) {
+    if (!ReadSamplesImpl(values[i])) {
       values.resize(i);
       return false;
     }
@@ -2261,58 +2428,296 @@ bool StreamsReaderBase::ReadOptionalIntDataImpl(std::vector<std::optional<int32_
   }
 }
 
-bool StreamsReaderBase::ReadRecordWithOptionalVectorData(test_model::RecordWithOptionalVector& value) {
-  if (unlikely(state_ != 4)) {
-    if (state_ == 5) {
-      state_ = 6;
-      return false;
-    }
+void CalibrationReaderBase::Close() {
+  if (!skip_completed_check_ && unlikely(state_ != 4)) {
     if (state_ == 3) {
       state_ = 4;
     } else {
-      StreamsReaderBaseInvalidState(4, state_);
+      CalibrationReaderBaseInvalidState(4, state_);
     }
   }
 
-  bool result = ReadRecordWithOptionalVectorDataImpl(value);
-  if (!result) {
-    state_ = 6;
-  }
-  return result;
+  CloseImpl();
 }
-
-bool StreamsReaderBase::ReadRecordWithOptionalVectorData(std::vector<test_model::RecordWithOptionalVector>& values) {
-  if (values.capacity() == 0) {
-    throw std::runtime_error("vector must have a nonzero capacity.");
+void CalibrationReaderBase::CopyTo(CalibrationWriterBase& writer, size_t samples_buffer_size) {
+  {
+    float value;
+    ReadGain(value);
+    writer.WriteGain(value);
   }
-  if (unlikely(state_ != 4)) {
-    if (state_ == 5) {
-      state_ = 6;
-      values.clear();
-      return false;
+  if (samples_buffer_size > 1) {
+    std::vector<double> values;
+    values.reserve(samples_buffer_size);
+    while(ReadSamples(values)) {
+      writer.WriteSamples(values);
     }
-    if (state_ == 3) {
-      state_ = 4;
-    } else {
-      StreamsReaderBaseInvalidState(4, state_);
+    writer.EndSamples();
+  } else {
+    double value;
+    while(ReadSamples(value)) {
+      writer.WriteSamples(value);
     }
+    writer.EndSamples();
   }
+}
 
-  if (!ReadRecordWithOptionalVectorDataImpl(values)) {
-    state_ = 5;
-    return values.size() > 0;
+namespace {
+void ProtocolWithSubProtocolsWriterBaseInvalidState(uint8_t attempted, [[maybe_unused]] bool end, uint8_t current) {
+  std::string expected_method;
+  switch (current) {
+  case 0: expected_method = "WriteHeader()"; break;
+  case 1: expected_method = "WriteCalibrationGain()"; break;
+  case 2: expected_method = "WriteCalibrationSamples() or EndCalibrationSamples()"; break;
+  case 3: expected_method = "WriteData() or EndData()"; break;
+  case 4: expected_method = "WriteRecalibrationGain()"; break;
+  case 5: expected_method = "WriteRecalibrationSamples() or EndRecalibrationSamples()"; break;
   }
-  return true;
+  std::string attempted_method;
+  switch (attempted) {
+  case 0: attempted_method = "WriteHeader()"; break;
+  case 1: attempted_method = "WriteCalibrationGain()"; break;
+  case 2: attempted_method = end ? "EndCalibrationSamples()" : "WriteCalibrationSamples()"; break;
+  case 3: attempted_method = end ? "EndData()" : "WriteData()"; break;
+  case 4: attempted_method = "WriteRecalibrationGain()"; break;
+  case 5: attempted_method = end ? "EndRecalibrationSamples()" : "WriteRecalibrationSamples()"; break;
+  case 6: attempted_method = "Close()"; break;
+  }
+  throw std::runtime_error("Expected call to " + expected_method + " but received call to " + attempted_method + " instead.");
 }
 
-// fallback implementation
-bool StreamsReaderBase::ReadRecordWithOptionalVectorDataImpl(std::vector<test_model::RecordWithOptionalVector>& values) {
-  size_t i = 0;
-  while (true) {
-    if (i == values.size()) {
+void ProtocolWithSubProtocolsReaderBaseInvalidState(uint8_t attempted, uint8_t current) {
+  auto f = [](uint8_t i) -> std::string {
+    switch (i/2) {
+    case 0: return "ReadHeader()";
+    case 1: return "ReadCalibrationGain()";
+    case 2: return "ReadCalibrationSamples()";
+    case 3: return "ReadData()";
+    case 4: return "ReadRecalibrationGain()";
+    case 5: return "ReadRecalibrationSamples()";
+    case 6: return "Close()";
+    default: return "<unknown>";
+    }
+  };
+  throw std::runtime_error("Expected call to " + f(current) + " but received call to " + f(attempted) + " instead.");
+}
+
+} // namespace 
+
+std::string ProtocolWithSubProtocolsWriterBase::schema_ = R"({"protocol":{"name":"ProtocolWithSubProtocols","sequence":[{"name":"header","type":"string"},{"name":"calibrationGain","type":"float32"},{"name":"calibrationSamples","type":{"stream":{"items":"float64"}}},{"name":"data","type":{"stream":{"items":"int32"}}},{"name":"recalibrationGain","type":"float32"},{"name":"recalibrationSamples","type":{"stream":{"items":"float64"}}}]},"types":null})";
+
+std::vector<std::string> ProtocolWithSubProtocolsWriterBase::previous_schemas_ = {
+};
+
+std::string ProtocolWithSubProtocolsWriterBase::SchemaFromVersion(Version version) {
+  switch (version) {
+  case Version::Current: return ProtocolWithSubProtocolsWriterBase::schema_; break;
+  default: throw std::runtime_error("The version does not correspond to any schema supported by protocol ProtocolWithSubProtocols.");
+  }
+
+}
+void ProtocolWithSubProtocolsWriterBase::WriteHeader(std::string const& value) {
+  if (unlikely(state_ != 0)) {
+    ProtocolWithSubProtocolsWriterBaseInvalidState(0, false, state_);
+  }
+
+  WriteHeaderImpl(value);
+  state_ = 1;
+}
+
+void ProtocolWithSubProtocolsWriterBase::WriteCalibrationGain(float const& value) {
+  if (unlikely(state_ != 1)) {
+    ProtocolWithSubProtocolsWriterBaseInvalidState(1, false, state_);
+  }
+
+  WriteCalibrationGainImpl(value);
+  state_ = 2;
+}
+
+void ProtocolWithSubProtocolsWriterBase::WriteCalibrationSamples(double const& value) {
+  if (unlikely(state_ != 2)) {
+    ProtocolWithSubProtocolsWriterBaseInvalidState(2, false, state_);
+  }
+
+  WriteCalibrationSamplesImpl(value);
+}
+
+void ProtocolWithSubProtocolsWriterBase::WriteCalibrationSamples(std::vector<double> const& values) {
+  if (unlikely(state_ != 2)) {
+    ProtocolWithSubProtocolsWriterBaseInvalidState(2, false, state_);
+  }
+
+  WriteCalibrationSamplesImpl(values);
+}
+
+void ProtocolWithSubProtocolsWriterBase::EndCalibrationSamples() {
+  if (unlikely(state_ != 2)) {
+    ProtocolWithSubProtocolsWriterBaseInvalidState(2, true, state_);
+  }
+
+  EndCalibrationSamplesImpl();
+  state_ = 3;
+}
+
+// fallback implementation
+void ProtocolWithSubProtocolsWriterBase::WriteCalibrationSamplesImpl(std::vector<double> const& values) {
+  for (auto const& v : values) {
+    WriteCalibrationSamplesImpl(v);
+  }
+}
+
+void ProtocolWithSubProtocolsWriterBase::WriteData(int32_t const& value) {
+  if (unlikely(state_ != 3)) {
+    ProtocolWithSubProtocolsWriterBaseInvalidState(3, false, state_);
+  }
+
+  WriteDataImpl(value);
+}
+
+void ProtocolWithSubProtocolsWriterBase::WriteData(std::vector<int32_t> const& values) {
+  if (unlikely(state_ != 3)) {
+    ProtocolWithSubProtocolsWriterBaseInvalidState(3, false, state_);
+  }
+
+  WriteDataImpl(values);
+}
+
+void ProtocolWithSubProtocolsWriterBase::EndData() {
+  if (unlikely(state_ != 3)) {
+    ProtocolWithSubProtocolsWriterBaseInvalidState(3, true, state_);
+  }
+
+  EndDataImpl();
+  state_ = 4;
+}
+
+// fallback implementation
+void ProtocolWithSubProtocolsWriterBase::WriteDataImpl(std::vector<int32_t> const& values) {
+  for (auto const& v : values) {
+    WriteDataImpl(v);
+  }
+}
+
+void ProtocolWithSubProtocolsWriterBase::WriteRecalibrationGain(float const& value) {
+  if (unlikely(state_ != 4)) {
+    ProtocolWithSubProtocolsWriterBaseInvalidState(4, false, state_);
+  }
+
+  WriteRecalibrationGainImpl(value);
+  state_ = 5;
+}
+
+void ProtocolWithSubProtocolsWriterBase::WriteRecalibrationSamples(double const& value) {
+  if (unlikely(state_ != 5)) {
+    ProtocolWithSubProtocolsWriterBaseInvalidState(5, false, state_);
+  }
+
+  WriteRecalibrationSamplesImpl(value);
+}
+
+void ProtocolWithSubProtocolsWriterBase::WriteRecalibrationSamples(std::vector<double> const& values) {
+  if (unlikely(state_ != 5)) {
+    ProtocolWithSubProtocolsWriterBaseInvalidState(5, false, state_);
+  }
+
+  WriteRecalibrationSamplesImpl(values);
+}
+
+void ProtocolWithSubProtocolsWriterBase::EndRecalibrationSamples() {
+  if (unlikely(state_ != 5)) {
+    ProtocolWithSubProtocolsWriterBaseInvalidState(5, true, state_);
+  }
+
+  EndRecalibrationSamplesImpl();
+  state_ = 6;
+}
+
+// fallback implementation
+void ProtocolWithSubProtocolsWriterBase::WriteRecalibrationSamplesImpl(std::vector<double> const& values) {
+  for (auto const& v : values) {
+    WriteRecalibrationSamplesImpl(v);
+  }
+}
+
+void ProtocolWithSubProtocolsWriterBase::Close() {
+  if (unlikely(state_ != 6)) {
+    ProtocolWithSubProtocolsWriterBaseInvalidState(6, false, state_);
+  }
+
+  CloseImpl();
+}
+
+std::string ProtocolWithSubProtocolsReaderBase::schema_ = ProtocolWithSubProtocolsWriterBase::schema_;
+
+std::vector<std::string> ProtocolWithSubProtocolsReaderBase::previous_schemas_ = ProtocolWithSubProtocolsWriterBase::previous_schemas_;
+
+Version ProtocolWithSubProtocolsReaderBase::VersionFromSchema(std::string const& schema) {
+  if (schema == ProtocolWithSubProtocolsWriterBase::schema_) {
+    return Version::Current;
+  }
+  throw std::runtime_error("The schema does not match any version supported by protocol ProtocolWithSubProtocols.");
+}
+void ProtocolWithSubProtocolsReaderBase::ReadHeader(std::string& value) {
+  if (unlikely(state_ != 0)) {
+    ProtocolWithSubProtocolsReaderBaseInvalidState(0, state_);
+  }
+
+  ReadHeaderImpl(value);
+  state_ = 2;
+}
+
+void ProtocolWithSubProtocolsReaderBase::ReadCalibrationGain(float& value) {
+  if (unlikely(state_ != 2)) {
+    ProtocolWithSubProtocolsReaderBaseInvalidState(2, state_);
+  }
+
+  ReadCalibrationGainImpl(value);
+  state_ = 4;
+}
+
+bool ProtocolWithSubProtocolsReaderBase::ReadCalibrationSamples(double& value) {
+  if (unlikely(state_ != 4)) {
+    if (state_ == 5) {
+      state_ = 6;
+      return false;
+    }
+    ProtocolWithSubProtocolsReaderBaseInvalidState(4, state_);
+  }
+
+  bool result = ReadCalibrationSamplesImpl(value);
+  if (!result) {
+    state_ = 6;
+  }
+  return result;
+}
+
+bool ProtocolWithSubProtocolsReaderBase::ReadCalibrationSamples(std::vector<double>& values) {
+  if (values.capacity() == 0) {
+    throw std::runtime_error("vector must have a nonzero capacity.");
+  }
+  if (unlikely(state_ != 4)) {
+    if (state_ == 5) {
+      state_ = 6;
+      values.clear();
+      return false;
+    }
+    ProtocolWithSubProtocolsReaderBaseInvalidState(4, state_);
+  }
+
+  if (!ReadCalibrationSamplesImpl(values)) {
+    state_ = 5;
+    return values.size() > 0;
+  }
+  return true;
+}
+
+// fallback implementation
+bool ProtocolWithSubProtocolsReaderBase::ReadCalibrationSamplesImpl(std::vector<double>& values) {
+  size_t i = 0;
+  while (true) {
+    if (i == values.size()) {
       values.resize(i + 1);
     }
-    if (!ReadRecordWithOptionalVectorDataImpl(values[i])) {
+    if (!ReadCalibrationSamplesImpl(values[i])) {
       values.resize(i);
       return false;
     }
@@ -2323,7 +2728,7 @@ bool StreamsReaderBase::ReadRecordWithOptionalVectorDataImpl(std::vector<test_mo
   }
 }
 
-bool StreamsReaderBase::ReadFixedVector(std::array<int32_t, 3>& value) {
+bool ProtocolWithSubProtocolsReaderBase::ReadData(int32_t& value) {
   if (unlikely(state_ != 6)) {
     if (state_ == 7) {
       state_ = 8;
@@ -2332,18 +2737,18 @@ bool StreamsReaderBase::ReadFixedVector(std::array<int32_t, 3>& value) {
     if (state_ == 5) {
       state_ = 6;
     } else {
-      StreamsReaderBaseInvalidState(6, state_);
+      ProtocolWithSubProtocolsReaderBaseInvalidState(6, state_);
     }
   }
 
-  bool result = ReadFixedVectorImpl(value);
+  bool result = ReadDataImpl(value);
   if (!result) {
     state_ = 8;
   }
   return result;
 }
 
-bool StreamsReaderBase::ReadFixedVector(std::vector<std::array<int32_t, 3>>& values) {
+bool ProtocolWithSubProtocolsReaderBase::ReadData(std::vector<int32_t>& values) {
   if (values.capacity() == 0) {
     throw std::runtime_error("vector must have a nonzero capacity.");
   }
@@ -2356,11 +2761,11 @@ bool StreamsReaderBase::ReadFixedVector(std::vector<std::array<int32_t, 3>>& val
     if (state_ == 5) {
       state_ = 6;
     } else {
-      StreamsReaderBaseInvalidState(6, state_);
+      ProtocolWithSubProtocolsReaderBaseInvalidState(6, state_);
     }
   }
 
-  if (!ReadFixedVectorImpl(values)) {
+  if (!ReadDataImpl(values)) {
     state_ = 7;
     return values.size() > 0;
   }
@@ -2368,13 +2773,13 @@ bool StreamsReaderBase::ReadFixedVector(std::vector<std::array<int32_t, 3>>& val
 }
 
 // fallback implementation
-bool StreamsReaderBase::ReadFixedVectorImpl(std::vector<std::array<int32_t, 3>>& values) {
+bool ProtocolWithSubProtocolsReaderBase::ReadDataImpl(std::vector<int32_t>& values) {
   size_t i = 0;
   while (true) {
     if (i == values.size()) {
       values.resize(i + 1);
     }
-    if (!ReadFixedVectorImpl(values[i])) {
+    if (!ReadDataImpl(values[i])) {
       values.resize(i);
       return false;
     }
@@ -2385,107 +2790,166 @@ bool StreamsReaderBase::ReadFixedVectorImpl(std::vector<std::array<int32_t, 3>>&
   }
 }
 
-void StreamsReaderBase::Close() {
-  if (!skip_completed_check_ && unlikely(state_ != 8)) {
+void ProtocolWithSubProtocolsReaderBase::ReadRecalibrationGain(float& value) {
+  if (unlikely(state_ != 8)) {
     if (state_ == 7) {
       state_ = 8;
     } else {
-      StreamsReaderBaseInvalidState(8, state_);
+      ProtocolWithSubProtocolsReaderBaseInvalidState(8, state_);
     }
   }
 
-  CloseImpl();
+  ReadRecalibrationGainImpl(value);
+  state_ = 10;
 }
-void StreamsReaderBase::CopyTo(StreamsWriterBase& writer, size_t int_data_buffer_size, size_t optional_int_data_buffer_size, size_t record_with_optional_vector_data_buffer_size, size_t fixed_vector_buffer_size) {
-  if (int_data_buffer_size > 1) {
-    std::vector<int32_t> values;
-    values.reserve(int_data_buffer_size);
-    while(ReadIntData(values)) {
-      writer.WriteIntData(values);
+
+bool ProtocolWithSubProtocolsReaderBase::ReadRecalibrationSamples(double& value) {
+  if (unlikely(state_ != 10)) {
+    if (state_ == 11) {
+      state_ = 12;
+      return false;
     }
-    writer.EndIntData();
-  } else {
-    int32_t value;
-    while(ReadIntData(value)) {
-      writer.WriteIntData(value);
+    ProtocolWithSubProtocolsReaderBaseInvalidState(10, state_);
+  }
+
+  bool result = ReadRecalibrationSamplesImpl(value);
+  if (!result) {
+    state_ = 12;
+  }
+  return result;
+}
+
+bool ProtocolWithSubProtocolsReaderBase::ReadRecalibrationSamples(std::vector<double>& values) {
+  if (values.capacity() == 0) {
+    throw std::runtime_error("vector must have a nonzero capacity.");
+  }
+  if (unlikely(state_ != 10)) {
+    if (state_ == 11) {
+      state_ = 12;
+      values.clear();
+      return false;
     }
-    writer.EndIntData();
+    ProtocolWithSubProtocolsReaderBaseInvalidState(10, state_);
   }
-  if (optional_int_data_buffer_size > 1) {
-    std::vector<std::optional<int32_t>> values;
-    values.reserve(optional_int_data_buffer_size);
-    while(ReadOptionalIntData(values)) {
-      writer.WriteOptionalIntData(values);
+
+  if (!ReadRecalibrationSamplesImpl(values)) {
+    state_ = 11;
+    return values.size() > 0;
+  }
+  return true;
+}
+
+// fallback implementation
+bool ProtocolWithSubProtocolsReaderBase::ReadRecalibrationSamplesImpl(std::vector<double>& values) {
+  size_t i = 0;
+  while (true) {
+    if (i == values.size()) {
+      values.resize(i + 1);
     }
-    writer.EndOptionalIntData();
-  } else {
-    std::optional<int32_t> value;
-    while(ReadOptionalIntData(value)) {
-      writer.WriteOptionalIntData(value);
+    if (!ReadRecalibrationSamplesImpl(values[i])) {
+      values.resize(i);
+      return false;
+    }
+    i++;
+    if (i == values.capacity()) {
+      return true;
     }
-    writer.EndOptionalIntData();
   }
-  if (record_with_optional_vector_data_buffer_size > 1) {
-    std::vector<test_model::RecordWithOptionalVector> values;
-    values.reserve(record_with_optional_vector_data_buffer_size);
-    while(ReadRecordWithOptionalVectorData(values)) {
-      writer.WriteRecordWithOptionalVectorData(values);
+}
+
+void ProtocolWithSubProtocolsReaderBase::Close() {
+  if (!skip_completed_check_ && unlikely(state_ != 12)) {
+    if (state_ == 11) {
+      state_ = 12;
+    } else {
+      ProtocolWithSubProtocolsReaderBaseInvalidState(12, state_);
     }
-    writer.EndRecordWithOptionalVectorData();
+  }
+
+  CloseImpl();
+}
+void ProtocolWithSubProtocolsReaderBase::CopyTo(ProtocolWithSubProtocolsWriterBase& writer, size_t calibration_samples_buffer_size, size_t data_buffer_size, size_t recalibration_samples_buffer_size) {
+  {
+    std::string value;
+    ReadHeader(value);
+    writer.WriteHeader(value);
+  }
+  {
+    float value;
+    ReadCalibrationGain(value);
+    writer.WriteCalibrationGain(value);
+  }
+  if (calibration_samples_buffer_size > 1) {
+    std::vector<double> values;
+    values.reserve(calibration_samples_buffer_size);
+    while(ReadCalibrationSamples(values)) {
+      writer.WriteCalibrationSamples(values);
+    }
+    writer.EndCalibrationSamples();
   } else {
-    test_model::RecordWithOptionalVector value;
-    while(ReadRecordWithOptionalVectorData(value)) {
-      writer.WriteRecordWithOptionalVectorData(value);
+    double value;
+    while(ReadCalibrationSamples(value)) {
+      writer.WriteCalibrationSamples(value);
     }
-    writer.EndRecordWithOptionalVectorData();
+    writer.EndCalibrationSamples();
   }
-  if (fixed_vector_buffer_size > 1) {
-    std::vector<std::array<int32_t, 3>> values;
-    values.reserve(fixed_vector_buffer_size);
-    while(ReadFixedVector(values)) {
-      writer.WriteFixedVector(values);
+  if (data_buffer_size > 1) {
+    std::vector<int32_t> values;
+    values.reserve(data_buffer_size);
+    while(ReadData(values)) {
+      writer.WriteData(values);
     }
-    writer.EndFixedVector();
+    writer.EndData();
   } else {
-    std::array<int32_t, 3> value;
-    while(ReadFixedVector(value)) {
-      writer.WriteFixedVector(value);
+    int32_t value;
+    while(ReadData(value)) {
+      writer.WriteData(value);
     }
-    writer.EndFixedVector();
+    writer.EndData();
+  }
+  {
+    float value;
+    ReadRecalibrationGain(value);
+    writer.WriteRecalibrationGain(value);
+  }
+  if (recalibration_samples_buffer_size > 1) {
+    std::vector<double> values;
+    values.reserve(recalibration_samples_buffer_size);
+    while(ReadRecalibrationSamples(values)) {
+      writer.WriteRecalibrationSamples(values);
+    }
+    writer.EndRecalibrationSamples();
+  } else {
+    double value;
+    while(ReadRecalibrationSamples(value)) {
+      writer.WriteRecalibrationSamples(value);
+    }
+    writer.EndRecalibrationSamples();
   }
 }
 
 namespace {
-void FixedArraysWriterBaseInvalidState(uint8_t attempted, [[maybe_unused]] bool end, uint8_t current) {
+void ProtocolWithHalfPrecisionWriterBaseInvalidState(uint8_t attempted, [[maybe_unused]] bool end, uint8_t current) {
   std::string expected_method;
   switch (current) {
-  case 0: expected_method = "WriteInts()"; break;
-  case 1: expected_method = "WriteFixedSimpleRecordArray()"; break;
-  case 2: expected_method = "WriteFixedRecordWithVlensArray()"; break;
-  case 3: expected_method = "WriteRecordWithFixedArrays()"; break;
-  case 4: expected_method = "WriteNamedArray()"; break;
+  case 0: expected_method = "WriteHalves()"; break;
+  case 1: expected_method = "WriteRecWithHalves()"; break;
   }
   std::string attempted_method;
   switch (attempted) {
-  case 0: attempted_method = "WriteInts()"; break;
-  case 1: attempted_method = "WriteFixedSimpleRecordArray()"; break;
-  case 2: attempted_method = "WriteFixedRecordWithVlensArray()"; break;
-  case 3: attempted_method = "WriteRecordWithFixedArrays()"; break;
-  case 4: attempted_method = "WriteNamedArray()"; break;
-  case 5: attempted_method = "Close()"; break;
+  case 0: attempted_method = "WriteHalves()"; break;
+  case 1: attempted_method = "WriteRecWithHalves()"; break;
+  case 2: attempted_method = "Close()"; break;
   }
   throw std::runtime_error("Expected call to " + expected_method + " but received call to " + attempted_method + " instead.");
 }
 
-void FixedArraysReaderBaseInvalidState(uint8_t attempted, uint8_t current) {
+void ProtocolWithHalfPrecisionReaderBaseInvalidState(uint8_t attempted, uint8_t current) {
   auto f = [](uint8_t i) -> std::string {
     switch (i/2) {
-    case 0: return "ReadInts()";
-    case 1: return "ReadFixedSimpleRecordArray()";
-    case 2: return "ReadFixedRecordWithVlensArray()";
-    case 3: return "ReadRecordWithFixedArrays()";
-    case 4: return "ReadNamedArray()";
-    case 5: return "Close()";
+    case 0: return "ReadHalves()";
+    case 1: return "ReadRecWithHalves()";
+    case 2: return "Close()";
     default: return "<unknown>";
     }
   };
@@ -2494,204 +2958,111 @@ void FixedArraysReaderBaseInvalidState(uint8_t attempted, uint8_t current) {
 
 } // namespace 
 
-std::string FixedArraysWriterBase::schema_ = R"({"protocol":{"name":"FixedArrays","sequence":[{"name":"ints","type":{"array":{"items":"int32","dimensions":[{"length":2},{"length":3}]}}},{"name":"fixedSimpleRecordArray","type":{"array":{"items":"TestModel.SimpleRecord","dimensions":[{"length":3},{"length":2}]}}},{"name":"fixedRecordWithVlensArray","type":{"array":{"items":"TestModel.RecordWithVlens","dimensions":[{"length":2},{"length":2}]}}},{"name":"recordWithFixedArrays","type":"TestModel.RecordWithFixedArrays"},{"name":"namedArray","type":"TestModel.NamedFixedNDArray"}]},"types":[{"name":"NamedFixedNDArray","type":{"array":{"items":"int32","dimensions":[{"name":"dimA","length":2},{"name":"dimB","length":4}]}}},{"name":"RecordWithFixedArrays","fields":[{"name":"ints","type":{"array":{"items":"int32","dimensions":[{"length":2},{"length":3}]}}},{"name":"fixedSimpleRecordArray","type":{"array":{"items":"TestModel.SimpleRecord","dimensions":[{"length":3},{"length":2}]}}},{"name":"fixedRecordWithVlensArray","type":{"array":{"items":"TestModel.RecordWithVlens","dimensions":[{"length":2},{"length":2}]}}}]},{"name":"RecordWithVlens","fields":[{"name":"a","type":{"vector":{"items":"TestModel.SimpleRecord"}}},{"name":"b","type":"int32"},{"name":"c","type":"int32"}]},{"name":"SimpleRecord","fields":[{"name":"x","type":"int32"},{"name":"y","type":"int32"},{"name":"z","type":"int32"}]}]})";
+std::string ProtocolWithHalfPrecisionWriterBase::schema_ = R"({"protocol":{"name":"ProtocolWithHalfPrecision","sequence":[{"name":"halves","type":{"vector":{"items":"float16"}}},{"name":"recWithHalves","type":"TestModel.RecordWithHalfPrecision"}]},"types":[{"name":"RecordWithHalfPrecision","fields":[{"name":"half","type":"float16"},{"name":"brain","type":"bfloat16"},{"name":"halfVector","type":{"vector":{"items":"float16"}}},{"name":"brainArray","type":{"array":{"items":"bfloat16"}}}]}]})";
 
-std::vector<std::string> FixedArraysWriterBase::previous_schemas_ = {
+std::vector<std::string> ProtocolWithHalfPrecisionWriterBase::previous_schemas_ = {
 };
 
-std::string FixedArraysWriterBase::SchemaFromVersion(Version version) {
+std::string ProtocolWithHalfPrecisionWriterBase::SchemaFromVersion(Version version) {
   switch (version) {
-  case Version::Current: return FixedArraysWriterBase::schema_; break;
-  default: throw std::runtime_error("The version does not correspond to any schema supported by protocol FixedArrays.");
+  case Version::Current: return ProtocolWithHalfPrecisionWriterBase::schema_; break;
+  default: throw std::runtime_error("The version does not correspond to any schema supported by protocol ProtocolWithHalfPrecision.");
   }
 
 }
-void FixedArraysWriterBase::WriteInts(yardl::FixedNDArray<int32_t, 2, 3> const& value) {
+void ProtocolWithHalfPrecisionWriterBase::WriteHalves(std::vector<yardl::Float16> const& value) {
   if (unlikely(state_ != 0)) {
-    FixedArraysWriterBaseInvalidState(0, false, state_);
+    ProtocolWithHalfPrecisionWriterBaseInvalidState(0, false, state_);
   }
 
-  WriteIntsImpl(value);
+  WriteHalvesImpl(value);
   state_ = 1;
 }
 
-void FixedArraysWriterBase::WriteFixedSimpleRecordArray(yardl::FixedNDArray<test_model::SimpleRecord, 3, 2> const& value) {
+void ProtocolWithHalfPrecisionWriterBase::WriteRecWithHalves(test_model::RecordWithHalfPrecision const& value) {
   if (unlikely(state_ != 1)) {
-    FixedArraysWriterBaseInvalidState(1, false, state_);
+    ProtocolWithHalfPrecisionWriterBaseInvalidState(1, false, state_);
   }
 
-  WriteFixedSimpleRecordArrayImpl(value);
+  WriteRecWithHalvesImpl(value);
   state_ = 2;
 }
 
-void FixedArraysWriterBase::WriteFixedRecordWithVlensArray(yardl::FixedNDArray<test_model::RecordWithVlens, 2, 2> const& value) {
+void ProtocolWithHalfPrecisionWriterBase::Close() {
   if (unlikely(state_ != 2)) {
-    FixedArraysWriterBaseInvalidState(2, false, state_);
-  }
-
-  WriteFixedRecordWithVlensArrayImpl(value);
-  state_ = 3;
-}
-
-void FixedArraysWriterBase::WriteRecordWithFixedArrays(test_model::RecordWithFixedArrays const& value) {
-  if (unlikely(state_ != 3)) {
-    FixedArraysWriterBaseInvalidState(3, false, state_);
-  }
-
-  WriteRecordWithFixedArraysImpl(value);
-  state_ = 4;
-}
-
-void FixedArraysWriterBase::WriteNamedArray(test_model::NamedFixedNDArray const& value) {
-  if (unlikely(state_ != 4)) {
-    FixedArraysWriterBaseInvalidState(4, false, state_);
-  }
-
-  WriteNamedArrayImpl(value);
-  state_ = 5;
-}
-
-void FixedArraysWriterBase::Close() {
-  if (unlikely(state_ != 5)) {
-    FixedArraysWriterBaseInvalidState(5, false, state_);
+    ProtocolWithHalfPrecisionWriterBaseInvalidState(2, false, state_);
   }
 
   CloseImpl();
 }
 
-std::string FixedArraysReaderBase::schema_ = FixedArraysWriterBase::schema_;
+std::string ProtocolWithHalfPrecisionReaderBase::schema_ = ProtocolWithHalfPrecisionWriterBase::schema_;
 
-std::vector<std::string> FixedArraysReaderBase::previous_schemas_ = FixedArraysWriterBase::previous_schemas_;
+std::vector<std::string> ProtocolWithHalfPrecisionReaderBase::previous_schemas_ = ProtocolWithHalfPrecisionWriterBase::previous_schemas_;
 
-Version FixedArraysReaderBase::VersionFromSchema(std::string const& schema) {
-  if (schema == FixedArraysWriterBase::schema_) {
+Version ProtocolWithHalfPrecisionReaderBase::VersionFromSchema(std::string const& schema) {
+  if (schema == ProtocolWithHalfPrecisionWriterBase::schema_) {
     return Version::Current;
   }
-  throw std::runtime_error("The schema does not match any version supported by protocol FixedArrays.");
+  throw std::runtime_error("The schema does not match any version supported by protocol ProtocolWithHalfPrecision.");
 }
-void FixedArraysReaderBase::ReadInts(yardl::FixedNDArray<int32_t, 2, 3>& value) {
+void ProtocolWithHalfPrecisionReaderBase::ReadHalves(std::vector<yardl::Float16>& value) {
   if (unlikely(state_ != 0)) {
-    FixedArraysReaderBaseInvalidState(0, state_);
+    ProtocolWithHalfPrecisionReaderBaseInvalidState(0, state_);
   }
 
-  ReadIntsImpl(value);
+  ReadHalvesImpl(value);
   state_ = 2;
 }
 
-void FixedArraysReaderBase::ReadFixedSimpleRecordArray(yardl::FixedNDArray<test_model::SimpleRecord, 3, 2>& value) {
+void ProtocolWithHalfPrecisionReaderBase::ReadRecWithHalves(test_model::RecordWithHalfPrecision& value) {
   if (unlikely(state_ != 2)) {
-    FixedArraysReaderBaseInvalidState(2, state_);
+    ProtocolWithHalfPrecisionReaderBaseInvalidState(2, state_);
   }
 
-  ReadFixedSimpleRecordArrayImpl(value);
+  ReadRecWithHalvesImpl(value);
   state_ = 4;
 }
 
-void FixedArraysReaderBase::ReadFixedRecordWithVlensArray(yardl::FixedNDArray<test_model::RecordWithVlens, 2, 2>& value) {
-  if (unlikely(state_ != 4)) {
-    FixedArraysReaderBaseInvalidState(4, state_);
-  }
-
-  ReadFixedRecordWithVlensArrayImpl(value);
-  state_ = 6;
-}
-
-void FixedArraysReaderBase::ReadRecordWithFixedArrays(test_model::RecordWithFixedArrays& value) {
-  if (unlikely(state_ != 6)) {
-    FixedArraysReaderBaseInvalidState(6, state_);
-  }
-
-  ReadRecordWithFixedArraysImpl(value);
-  state_ = 8;
-}
-
-void FixedArraysReaderBase::ReadNamedArray(test_model::NamedFixedNDArray& value) {
-  if (unlikely(state_ != 8)) {
-    FixedArraysReaderBaseInvalidState(8, state_);
-  }
-
-  ReadNamedArrayImpl(value);
-  state_ = 10;
-}
-
-void FixedArraysReaderBase::Close() {
-  if (!skip_completed_check_ && unlikely(state_ != 10)) {
-    FixedArraysReaderBaseInvalidState(10, state_);
+void ProtocolWithHalfPrecisionReaderBase::Close() {
+  if (!skip_completed_check_ && unlikely(state_ != 4)) {
+    ProtocolWithHalfPrecisionReaderBaseInvalidState(4, state_);
   }
 
   CloseImpl();
 }
-void FixedArraysReaderBase::CopyTo(FixedArraysWriterBase& writer) {
-  {
-    yardl::FixedNDArray<int32_t, 2, 3> value;
-    ReadInts(value);
-    writer.WriteInts(value);
-  }
-  {
-    yardl::FixedNDArray<test_model::SimpleRecord, 3, 2> value;
-    ReadFixedSimpleRecordArray(value);
-    writer.WriteFixedSimpleRecordArray(value);
-  }
-  {
-    yardl::FixedNDArray<test_model::RecordWithVlens, 2, 2> value;
-    ReadFixedRecordWithVlensArray(value);
-    writer.WriteFixedRecordWithVlensArray(value);
-  }
+void ProtocolWithHalfPrecisionReaderBase::CopyTo(ProtocolWithHalfPrecisionWriterBase& writer) {
   {
-    test_model::RecordWithFixedArrays value;
-    ReadRecordWithFixedArrays(value);
-    writer.WriteRecordWithFixedArrays(value);
+    std::vector<yardl::Float16> value;
+    ReadHalves(value);
+    writer.WriteHalves(value);
   }
   {
-    test_model::NamedFixedNDArray value;
-    ReadNamedArray(value);
-    writer.WriteNamedArray(value);
+    test_model::RecordWithHalfPrecision value;
+    ReadRecWithHalves(value);
+    writer.WriteRecWithHalves(value);
   }
 }
 
 namespace {
-void SubarraysWriterBaseInvalidState(uint8_t attempted, [[maybe_unused]] bool end, uint8_t current) {
+void OptionalVectorsWriterBaseInvalidState(uint8_t attempted, [[maybe_unused]] bool end, uint8_t current) {
   std::string expected_method;
   switch (current) {
-  case 0: expected_method = "WriteDynamicWithFixedIntSubarray()"; break;
-  case 1: expected_method = "WriteDynamicWithFixedFloatSubarray()"; break;
-  case 2: expected_method = "WriteKnownDimCountWithFixedIntSubarray()"; break;
-  case 3: expected_method = "WriteKnownDimCountWithFixedFloatSubarray()"; break;
-  case 4: expected_method = "WriteFixedWithFixedIntSubarray()"; break;
-  case 5: expected_method = "WriteFixedWithFixedFloatSubarray()"; break;
-  case 6: expected_method = "WriteNestedSubarray()"; break;
-  case 7: expected_method = "WriteDynamicWithFixedVectorSubarray()"; break;
-  case 8: expected_method = "WriteGenericSubarray()"; break;
+  case 0: expected_method = "WriteRecordWithOptionalVector()"; break;
   }
   std::string attempted_method;
   switch (attempted) {
-  case 0: attempted_method = "WriteDynamicWithFixedIntSubarray()"; break;
-  case 1: attempted_method = "WriteDynamicWithFixedFloatSubarray()"; break;
-  case 2: attempted_method = "WriteKnownDimCountWithFixedIntSubarray()"; break;
-  case 3: attempted_method = "WriteKnownDimCountWithFixedFloatSubarray()"; break;
-  case 4: attempted_method = "WriteFixedWithFixedIntSubarray()"; break;
-  case 5: attempted_method = "WriteFixedWithFixedFloatSubarray()"; break;
-  case 6: attempted_method = "WriteNestedSubarray()"; break;
-  case 7: attempted_method = "WriteDynamicWithFixedVectorSubarray()"; break;
-  case 8: attempted_method = "WriteGenericSubarray()"; break;
-  case 9: attempted_method = "Close()"; break;
+  case 0: attempted_method = "WriteRecordWithOptionalVector()"; break;
+  case 1: attempted_method = "Close()"; break;
   }
   throw std::runtime_error("Expected call to " + expected_method + " but received call to " + attempted_method + " instead.");
 }
 
-void SubarraysReaderBaseInvalidState(uint8_t attempted, uint8_t current) {
+void OptionalVectorsReaderBaseInvalidState(uint8_t attempted, uint8_t current) {
   auto f = [](uint8_t i) -> std::string {
     switch (i/2) {
-    case 0: return "ReadDynamicWithFixedIntSubarray()";
-    case 1: return "ReadDynamicWithFixedFloatSubarray()";
-    case 2: return "ReadKnownDimCountWithFixedIntSubarray()";
-    case 3: return "ReadKnownDimCountWithFixedFloatSubarray()";
-    case 4: return "ReadFixedWithFixedIntSubarray()";
-    case 5: return "ReadFixedWithFixedFloatSubarray()";
-    case 6: return "ReadNestedSubarray()";
-    case 7: return "ReadDynamicWithFixedVectorSubarray()";
-    case 8: return "ReadGenericSubarray()";
-    case 9: return "Close()";
+    case 0: return "ReadRecordWithOptionalVector()";
+    case 1: return "Close()";
     default: return "<unknown>";
     }
   };
@@ -2700,275 +3071,265 @@ void SubarraysReaderBaseInvalidState(uint8_t attempted, uint8_t current) {
 
 } // namespace 
 
-std::string SubarraysWriterBase::schema_ = R"({"protocol":{"name":"Subarrays","sequence":[{"name":"dynamicWithFixedIntSubarray","type":{"array":{"items":{"array":{"items":"int32","dimensions":[{"length":3}]}}}}},{"name":"dynamicWithFixedFloatSubarray","type":{"array":{"items":{"array":{"items":"float32","dimensions":[{"length":3}]}}}}},{"name":"knownDimCountWithFixedIntSubarray","type":{"array":{"items":{"array":{"items":"int32","dimensions":[{"length":3}]}},"dimensions":1}}},{"name":"knownDimCountWithFixedFloatSubarray","type":{"array":{"items":{"array":{"items":"float32","dimensions":[{"length":3}]}},"dimensions":1}}},{"name":"fixedWithFixedIntSubarray","type":{"array":{"items":{"array":{"items":"int32","dimensions":[{"length":3}]}},"dimensions":[{"length":2}]}}},{"name":"fixedWithFixedFloatSubarray","type":{"array":{"items":{"array":{"items":"float32","dimensions":[{"length":3}]}},"dimensions":[{"length":2}]}}},{"name":"nestedSubarray","type":{"array":{"items":{"array":{"items":{"array":{"items":"int32","dimensions":[{"length":3}]}},"dimensions":[{"length":2}]}}}}},{"name":"dynamicWithFixedVectorSubarray","type":{"array":{"items":{"vector":{"items":"int32","length":3}}}}},{"name":"genericSubarray","type":{"name":"TestModel.Image","typeArguments":[{"array":{"items":"int32","dimensions":[{"length":3}]}}]}}]},"types":[{"name":"Image","typeParameters":["T"],"type":{"array":{"items":"T","dimensions":[{"name":"x"},{"name":"y"}]}}},{"name":"Image","typeParameters":["T"],"type":{"name":"Image.Image","typeArguments":["T"]}}]})";
+std::string OptionalVectorsWriterBase::schema_ = R"({"protocol":{"name":"OptionalVectors","sequence":[{"name":"recordWithOptionalVector","type":"TestModel.RecordWithOptionalVector"}]},"types":[{"name":"RecordWithOptionalVector","fields":[{"name":"optionalVector","type":[null,{"vector":{"items":"int32"}}]}]}]})";
 
-std::vector<std::string> SubarraysWriterBase::previous_schemas_ = {
+std::vector<std::string> OptionalVectorsWriterBase::previous_schemas_ = {
 };
 
-std::string SubarraysWriterBase::SchemaFromVersion(Version version) {
+std::string OptionalVectorsWriterBase::SchemaFromVersion(Version version) {
   switch (version) {
-  case Version::Current: return SubarraysWriterBase::schema_; break;
-  default: throw std::runtime_error("The version does not correspond to any schema supported by protocol Subarrays.");
+  case Version::Current: return OptionalVectorsWriterBase::schema_; break;
+  default: throw std::runtime_error("The version does not correspond to any schema supported by protocol OptionalVectors.");
   }
 
 }
-void SubarraysWriterBase::WriteDynamicWithFixedIntSubarray(yardl::DynamicNDArray<yardl::FixedNDArray<int32_t, 3>> const& value) {
+void OptionalVectorsWriterBase::WriteRecordWithOptionalVector(test_model::RecordWithOptionalVector const& value) {
   if (unlikely(state_ != 0)) {
-    SubarraysWriterBaseInvalidState(0, false, state_);
+    OptionalVectorsWriterBaseInvalidState(0, false, state_);
   }
 
-  WriteDynamicWithFixedIntSubarrayImpl(value);
+  WriteRecordWithOptionalVectorImpl(value);
   state_ = 1;
 }
 
-void SubarraysWriterBase::WriteDynamicWithFixedFloatSubarray(yardl::DynamicNDArray<yardl::FixedNDArray<float, 3>> const& value) {
+void OptionalVectorsWriterBase::Close() {
   if (unlikely(state_ != 1)) {
-    SubarraysWriterBaseInvalidState(1, false, state_);
+    OptionalVectorsWriterBaseInvalidState(1, false, state_);
   }
 
-  WriteDynamicWithFixedFloatSubarrayImpl(value);
-  state_ = 2;
+  CloseImpl();
 }
 
-void SubarraysWriterBase::WriteKnownDimCountWithFixedIntSubarray(yardl::NDArray<yardl::FixedNDArray<int32_t, 3>, 1> const& value) {
-  if (unlikely(state_ != 2)) {
-    SubarraysWriterBaseInvalidState(2, false, state_);
-  }
+std::string OptionalVectorsReaderBase::schema_ = OptionalVectorsWriterBase::schema_;
 
-  WriteKnownDimCountWithFixedIntSubarrayImpl(value);
-  state_ = 3;
-}
+std::vector<std::string> OptionalVectorsReaderBase::previous_schemas_ = OptionalVectorsWriterBase::previous_schemas_;
 
-void SubarraysWriterBase::WriteKnownDimCountWithFixedFloatSubarray(yardl::NDArray<yardl::FixedNDArray<float, 3>, 1> const& value) {
-  if (unlikely(state_ != 3)) {
-    SubarraysWriterBaseInvalidState(3, false, state_);
+Version OptionalVectorsReaderBase::VersionFromSchema(std::string const& schema) {
+  if (schema == OptionalVectorsWriterBase::schema_) {
+    return Version::Current;
   }
-
-  WriteKnownDimCountWithFixedFloatSubarrayImpl(value);
-  state_ = 4;
+  throw std::runtime_error("The schema does not match any version supported by protocol OptionalVectors.");
 }
-
-void SubarraysWriterBase::WriteFixedWithFixedIntSubarray(yardl::FixedNDArray<yardl::FixedNDArray<int32_t, 3>, 2> const& value) {
-  if (unlikely(state_ != 4)) {
-    SubarraysWriterBaseInvalidState(4, false, state_);
+void OptionalVectorsReaderBase::ReadRecordWithOptionalVector(test_model::RecordWithOptionalVector& value) {
+  if (unlikely(state_ != 0)) {
+    OptionalVectorsReaderBaseInvalidState(0, state_);
   }
 
-  WriteFixedWithFixedIntSubarrayImpl(value);
-  state_ = 5;
+  ReadRecordWithOptionalVectorImpl(value);
+  state_ = 2;
 }
 
-void SubarraysWriterBase::WriteFixedWithFixedFloatSubarray(yardl::FixedNDArray<yardl::FixedNDArray<float, 3>, 2> const& value) {
-  if (unlikely(state_ != 5)) {
-    SubarraysWriterBaseInvalidState(5, false, state_);
+void OptionalVectorsReaderBase::Close() {
+  if (!skip_completed_check_ && unlikely(state_ != 2)) {
+    OptionalVectorsReaderBaseInvalidState(2, state_);
   }
 
-  WriteFixedWithFixedFloatSubarrayImpl(value);
-  state_ = 6;
+  CloseImpl();
 }
-
-void SubarraysWriterBase::WriteNestedSubarray(yardl::DynamicNDArray<yardl::FixedNDArray<yardl::FixedNDArray<int32_t, 3>, 2>> const& value) {
-  if (unlikely(state_ != 6)) {
-    SubarraysWriterBaseInvalidState(6, false, state_);
-  }
-
-  WriteNestedSubarrayImpl(value);
-  state_ = 7;
-}
-
-void SubarraysWriterBase::WriteDynamicWithFixedVectorSubarray(yardl::DynamicNDArray<std::array<int32_t, 3>> const& value) {
-  if (unlikely(state_ != 7)) {
-    SubarraysWriterBaseInvalidState(7, false, state_);
+void OptionalVectorsReaderBase::CopyTo(OptionalVectorsWriterBase& writer) {
+  {
+    test_model::RecordWithOptionalVector value;
+    ReadRecordWithOptionalVector(value);
+    writer.WriteRecordWithOptionalVector(value);
   }
-
-  WriteDynamicWithFixedVectorSubarrayImpl(value);
-  state_ = 8;
 }
 
-void SubarraysWriterBase::WriteGenericSubarray(test_model::Image<yardl::FixedNDArray<int32_t, 3>> const& value) {
-  if (unlikely(state_ != 8)) {
-    SubarraysWriterBaseInvalidState(8, false, state_);
+namespace {
+void FixedVectorsWriterBaseInvalidState(uint8_t attempted, [[maybe_unused]] bool end, uint8_t current) {
+  std::string expected_method;
+  switch (current) {
+  case 0: expected_method = "WriteFixedIntVector()"; break;
+  case 1: expected_method = "WriteFixedSimpleRecordVector()"; break;
+  case 2: expected_method = "WriteFixedRecordWithVlensVector()"; break;
+  case 3: expected_method = "WriteRecordWithFixedVectors()"; break;
   }
-
-  WriteGenericSubarrayImpl(value);
-  state_ = 9;
-}
-
-void SubarraysWriterBase::Close() {
-  if (unlikely(state_ != 9)) {
-    SubarraysWriterBaseInvalidState(9, false, state_);
+  std::string attempted_method;
+  switch (attempted) {
+  case 0: attempted_method = "WriteFixedIntVector()"; break;
+  case 1: attempted_method = "WriteFixedSimpleRecordVector()"; break;
+  case 2: attempted_method = "WriteFixedRecordWithVlensVector()"; break;
+  case 3: attempted_method = "WriteRecordWithFixedVectors()"; break;
+  case 4: attempted_method = "Close()"; break;
   }
+  throw std::runtime_error("Expected call to " + expected_method + " but received call to " + attempted_method + " instead.");
+}
 
-  CloseImpl();
+void FixedVectorsReaderBaseInvalidState(uint8_t attempted, uint8_t current) {
+  auto f = [](uint8_t i) -> std::string {
+    switch (i/2) {
+    case 0: return "ReadFixedIntVector()";
+    case 1: return "ReadFixedSimpleRecordVector()";
+    case 2: return "ReadFixedRecordWithVlensVector()";
+    case 3: return "ReadRecordWithFixedVectors()";
+    case 4: return "Close()";
+    default: return "<unknown>";
+    }
+  };
+  throw std::runtime_error("Expected call to " + f(current) + " but received call to " + f(attempted) + " instead.");
 }
 
-std::string SubarraysReaderBase::schema_ = SubarraysWriterBase::schema_;
+} // namespace 
 
-std::vector<std::string> SubarraysReaderBase::previous_schemas_ = SubarraysWriterBase::previous_schemas_;
+std::string FixedVectorsWriterBase::schema_ = R"({"protocol":{"name":"FixedVectors","sequence":[{"name":"fixedIntVector","type":{"vector":{"items":"int32","length":5}}},{"name":"fixedSimpleRecordVector","type":{"vector":{"items":"TestModel.SimpleRecord","length":3}}},{"name":"fixedRecordWithVlensVector","type":{"vector":{"items":"TestModel.RecordWithVlens","length":2}}},{"name":"recordWithFixedVectors","type":"TestModel.RecordWithFixedVectors"}]},"types":[{"name":"RecordWithFixedVectors","fields":[{"name":"fixedIntVector","type":{"vector":{"items":"int32","length":5}}},{"name":"fixedSimpleRecordVector","type":{"vector":{"items":"TestModel.SimpleRecord","length":3}}},{"name":"fixedRecordWithVlensVector","type":{"vector":{"items":"TestModel.RecordWithVlens","length":2}}}]},{"name":"RecordWithVlens","fields":[{"name":"a","type":{"vector":{"items":"TestModel.SimpleRecord"}}},{"name":"b","type":"int32"},{"name":"c","type":"int32"}]},{"name":"SimpleRecord","fields":[{"name":"x","type":"int32"},{"name":"y","type":"int32"},{"name":"z","type":"int32"}]}]})";
 
-Version SubarraysReaderBase::VersionFromSchema(std::string const& schema) {
-  if (schema == SubarraysWriterBase::schema_) {
-    return Version::Current;
+std::vector<std::string> FixedVectorsWriterBase::previous_schemas_ = {
+};
+
+std::string FixedVectorsWriterBase::SchemaFromVersion(Version version) {
+  switch (version) {
+  case Version::Current: return FixedVectorsWriterBase::schema_; break;
+  default: throw std::runtime_error("The version does not correspond to any schema supported by protocol FixedVectors.");
   }
-  throw std::runtime_error("The schema does not match any version supported by protocol Subarrays.");
+
 }
-void SubarraysReaderBase::ReadDynamicWithFixedIntSubarray(yardl::DynamicNDArray<yardl::FixedNDArray<int32_t, 3>>& value) {
+void FixedVectorsWriterBase::WriteFixedIntVector(std::array<int32_t, 5> const& value) {
   if (unlikely(state_ != 0)) {
-    SubarraysReaderBaseInvalidState(0, state_);
+    FixedVectorsWriterBaseInvalidState(0, false, state_);
   }
 
-  ReadDynamicWithFixedIntSubarrayImpl(value);
-  state_ = 2;
+  WriteFixedIntVectorImpl(value);
+  state_ = 1;
 }
 
-void SubarraysReaderBase::ReadDynamicWithFixedFloatSubarray(yardl::DynamicNDArray<yardl::FixedNDArray<float, 3>>& value) {
-  if (unlikely(state_ != 2)) {
-    SubarraysReaderBaseInvalidState(2, state_);
+void FixedVectorsWriterBase::WriteFixedSimpleRecordVector(std::array<test_model::SimpleRecord, 3> const& value) {
+  if (unlikely(state_ != 1)) {
+    FixedVectorsWriterBaseInvalidState(1, false, state_);
   }
 
-  ReadDynamicWithFixedFloatSubarrayImpl(value);
-  state_ = 4;
+  WriteFixedSimpleRecordVectorImpl(value);
+  state_ = 2;
 }
 
-void SubarraysReaderBase::ReadKnownDimCountWithFixedIntSubarray(yardl::NDArray<yardl::FixedNDArray<int32_t, 3>, 1>& value) {
-  if (unlikely(state_ != 4)) {
-    SubarraysReaderBaseInvalidState(4, state_);
+void FixedVectorsWriterBase::WriteFixedRecordWithVlensVector(std::array<test_model::RecordWithVlens, 2> const& value) {
+  if (unlikely(state_ != 2)) {
+    FixedVectorsWriterBaseInvalidState(2, false, state_);
   }
 
-  ReadKnownDimCountWithFixedIntSubarrayImpl(value);
-  state_ = 6;
+  WriteFixedRecordWithVlensVectorImpl(value);
+  state_ = 3;
 }
 
-void SubarraysReaderBase::ReadKnownDimCountWithFixedFloatSubarray(yardl::NDArray<yardl::FixedNDArray<float, 3>, 1>& value) {
-  if (unlikely(state_ != 6)) {
-    SubarraysReaderBaseInvalidState(6, state_);
+void FixedVectorsWriterBase::WriteRecordWithFixedVectors(test_model::RecordWithFixedVectors const& value) {
+  if (unlikely(state_ != 3)) {
+    FixedVectorsWriterBaseInvalidState(3, false, state_);
   }
 
-  ReadKnownDimCountWithFixedFloatSubarrayImpl(value);
-  state_ = 8;
+  WriteRecordWithFixedVectorsImpl(value);
+  state_ = 4;
 }
 
-void SubarraysReaderBase::ReadFixedWithFixedIntSubarray(yardl::FixedNDArray<yardl::FixedNDArray<int32_t, 3>, 2>& value) {
-  if (unlikely(state_ != 8)) {
-    SubarraysReaderBaseInvalidState(8, state_);
+void FixedVectorsWriterBase::Close() {
+  if (unlikely(state_ != 4)) {
+    FixedVectorsWriterBaseInvalidState(4, false, state_);
   }
 
-  ReadFixedWithFixedIntSubarrayImpl(value);
-  state_ = 10;
+  CloseImpl();
 }
 
-void SubarraysReaderBase::ReadFixedWithFixedFloatSubarray(yardl::FixedNDArray<yardl::FixedNDArray<float, 3>, 2>& value) {
-  if (unlikely(state_ != 10)) {
-    SubarraysReaderBaseInvalidState(10, state_);
+std::string FixedVectorsReaderBase::schema_ = FixedVectorsWriterBase::schema_;
+
+std::vector<std::string> FixedVectorsReaderBase::previous_schemas_ = FixedVectorsWriterBase::previous_schemas_;
+
+Version FixedVectorsReaderBase::VersionFromSchema(std::string const& schema) {
+  if (schema == FixedVectorsWriterBase::schema_) {
+    return Version::Current;
+  }
+  throw std::runtime_error("The schema does not match any version supported by protocol FixedVectors.");
+}
+void FixedVectorsReaderBase::ReadFixedIntVector(std::array<int32_t, 5>& value) {
+  if (unlikely(state_ != 0)) {
+    FixedVectorsReaderBaseInvalidState(0, state_);
   }
 
-  ReadFixedWithFixedFloatSubarrayImpl(value);
-  state_ = 12;
+  ReadFixedIntVectorImpl(value);
+  state_ = 2;
 }
 
-void SubarraysReaderBase::ReadNestedSubarray(yardl::DynamicNDArray<yardl::FixedNDArray<yardl::FixedNDArray<int32_t, 3>, 2>>& value) {
-  if (unlikely(state_ != 12)) {
-    SubarraysReaderBaseInvalidState(12, state_);
+void FixedVectorsReaderBase::ReadFixedSimpleRecordVector(std::array<test_model::SimpleRecord, 3>& value) {
+  if (unlikely(state_ != 2)) {
+    FixedVectorsReaderBaseInvalidState(2, state_);
   }
 
-  ReadNestedSubarrayImpl(value);
-  state_ = 14;
+  ReadFixedSimpleRecordVectorImpl(value);
+  state_ = 4;
 }
 
-void SubarraysReaderBase::ReadDynamicWithFixedVectorSubarray(yardl::DynamicNDArray<std::array<int32_t, 3>>& value) {
-  if (unlikely(state_ != 14)) {
-    SubarraysReaderBaseInvalidState(14, state_);
+void FixedVectorsReaderBase::ReadFixedRecordWithVlensVector(std::array<test_model::RecordWithVlens, 2>& value) {
+  if (unlikely(state_ != 4)) {
+    FixedVectorsReaderBaseInvalidState(4, state_);
   }
 
-  ReadDynamicWithFixedVectorSubarrayImpl(value);
-  state_ = 16;
+  ReadFixedRecordWithVlensVectorImpl(value);
+  state_ = 6;
 }
 
-void SubarraysReaderBase::ReadGenericSubarray(test_model::Image<yardl::FixedNDArray<int32_t, 3>>& value) {
-  if (unlikely(state_ != 16)) {
-    SubarraysReaderBaseInvalidState(16, state_);
+void FixedVectorsReaderBase::ReadRecordWithFixedVectors(test_model::RecordWithFixedVectors& value) {
+  if (unlikely(state_ != 6)) {
+    FixedVectorsReaderBaseInvalidState(6, state_);
   }
 
-  ReadGenericSubarrayImpl(value);
-  state_ = 18;
+  ReadRecordWithFixedVectorsImpl(value);
+  state_ = 8;
 }
 
-void SubarraysReaderBase::Close() {
-  if (!skip_completed_check_ && unlikely(state_ != 18)) {
-    SubarraysReaderBaseInvalidState(18, state_);
+void FixedVectorsReaderBase::Close() {
+  if (!skip_completed_check_ && unlikely(state_ != 8)) {
+    FixedVectorsReaderBaseInvalidState(8, state_);
   }
 
   CloseImpl();
 }
-void SubarraysReaderBase::CopyTo(SubarraysWriterBase& writer) {
-  {
-    yardl::DynamicNDArray<yardl::FixedNDArray<int32_t, 3>> value;
-    ReadDynamicWithFixedIntSubarray(value);
-    writer.WriteDynamicWithFixedIntSubarray(value);
-  }
-  {
-    yardl::DynamicNDArray<yardl::FixedNDArray<float, 3>> value;
-    ReadDynamicWithFixedFloatSubarray(value);
-    writer.WriteDynamicWithFixedFloatSubarray(value);
-  }
-  {
-    yardl::NDArray<yardl::FixedNDArray<int32_t, 3>, 1> value;
-    ReadKnownDimCountWithFixedIntSubarray(value);
-    writer.WriteKnownDimCountWithFixedIntSubarray(value);
-  }
-  {
-    yardl::NDArray<yardl::FixedNDArray<float, 3>, 1> value;
-    ReadKnownDimCountWithFixedFloatSubarray(value);
-    writer.WriteKnownDimCountWithFixedFloatSubarray(value);
-  }
-  {
-    yardl::FixedNDArray<yardl::FixedNDArray<int32_t, 3>, 2> value;
-    ReadFixedWithFixedIntSubarray(value);
-    writer.WriteFixedWithFixedIntSubarray(value);
-  }
+void FixedVectorsReaderBase::CopyTo(FixedVectorsWriterBase& writer) {
   {
-    yardl::FixedNDArray<yardl::FixedNDArray<float, 3>, 2> value;
-    ReadFixedWithFixedFloatSubarray(value);
-    writer.WriteFixedWithFixedFloatSubarray(value);
+    std::array<int32_t, 5> value;
+    ReadFixedIntVector(value);
+    writer.WriteFixedIntVector(value);
   }
   {
-    yardl::DynamicNDArray<yardl::FixedNDArray<yardl::FixedNDArray<int32_t, 3>, 2>> value;
-    ReadNestedSubarray(value);
-    writer.WriteNestedSubarray(value);
+    std::array<test_model::SimpleRecord, 3> value;
+    ReadFixedSimpleRecordVector(value);
+    writer.WriteFixedSimpleRecordVector(value);
   }
   {
-    yardl::DynamicNDArray<std::array<int32_t, 3>> value;
-    ReadDynamicWithFixedVectorSubarray(value);
-    writer.WriteDynamicWithFixedVectorSubarray(value);
+    std::array<test_model::RecordWithVlens, 2> value;
+    ReadFixedRecordWithVlensVector(value);
+    writer.WriteFixedRecordWithVlensVector(value);
   }
   {
-    test_model::Image<yardl::FixedNDArray<int32_t, 3>> value;
-    ReadGenericSubarray(value);
-    writer.WriteGenericSubarray(value);
+    test_model::RecordWithFixedVectors value;
+    ReadRecordWithFixedVectors(value);
+    writer.WriteRecordWithFixedVectors(value);
   }
 }
 
 namespace {
-void SubarraysInRecordsWriterBaseInvalidState(uint8_t attempted, [[maybe_unused]] bool end, uint8_t current) {
+void StreamsWriterBaseInvalidState(uint8_t attempted, [[maybe_unused]] bool end, uint8_t current) {
   std::string expected_method;
   switch (current) {
-  case 0: expected_method = "WriteWithFixedSubarrays()"; break;
-  case 1: expected_method = "WriteWithVlenSubarrays()"; break;
+  case 0: expected_method = "WriteIntData() or EndIntData()"; break;
+  case 1: expected_method = "WriteOptionalIntData() or EndOptionalIntData()"; break;
+  case 2: expected_method = "WriteRecordWithOptionalVectorData() or EndRecordWithOptionalVectorData()"; break;
+  case 3: expected_method = "WriteFixedVector() or EndFixedVector()"; break;
   }
   std::string attempted_method;
   switch (attempted) {
-  case 0: attempted_method = "WriteWithFixedSubarrays()"; break;
-  case 1: attempted_method = "WriteWithVlenSubarrays()"; break;
-  case 2: attempted_method = "Close()"; break;
+  case 0: attempted_method = end ? "EndIntData()" : "WriteIntData()"; break;
+  case 1: attempted_method = end ? "EndOptionalIntData()" : "WriteOptionalIntData()"; break;
+  case 2: attempted_method = end ? "EndRecordWithOptionalVectorData()" : "WriteRecordWithOptionalVectorData()"; break;
+  case 3: attempted_method = end ? "EndFixedVector()" : "WriteFixedVector()"; break;
+  case 4: attempted_method = "Close()"; break;
   }
   throw std::runtime_error("Expected call to " + expected_method + " but received call to " + attempted_method + " instead.");
 }
 
-void SubarraysInRecordsReaderBaseInvalidState(uint8_t attempted, uint8_t current) {
+void StreamsReaderBaseInvalidState(uint8_t attempted, uint8_t current) {
   auto f = [](uint8_t i) -> std::string {
     switch (i/2) {
-    case 0: return "ReadWithFixedSubarrays()";
-    case 1: return "ReadWithVlenSubarrays()";
-    case 2: return "Close()";
+    case 0: return "ReadIntData()";
+    case 1: return "ReadOptionalIntData()";
+    case 2: return "ReadRecordWithOptionalVectorData()";
+    case 3: return "ReadFixedVector()";
+    case 4: return "Close()";
     default: return "<unknown>";
     }
   };
@@ -2977,243 +3338,1233 @@ void SubarraysInRecordsReaderBaseInvalidState(uint8_t attempted, uint8_t current
 
 } // namespace 
 
-std::string SubarraysInRecordsWriterBase::schema_ = R"({"protocol":{"name":"SubarraysInRecords","sequence":[{"name":"withFixedSubarrays","type":{"array":{"items":"TestModel.RecordWithFixedCollections"}}},{"name":"withVlenSubarrays","type":{"array":{"items":"TestModel.RecordWithVlenCollections"}}}]},"types":[{"name":"RecordWithFixedCollections","fields":[{"name":"fixedVector","type":{"vector":{"items":"int32","length":3}}},{"name":"fixedArray","type":{"array":{"items":"int32","dimensions":[{"length":2},{"length":3}]}}}]},{"name":"RecordWithVlenCollections","fields":[{"name":"vector","type":{"vector":{"items":"int32"}}},{"name":"array","type":{"array":{"items":"int32","dimensions":2}}}]}]})";
+std::string StreamsWriterBase::schema_ = R"({"protocol":{"name":"Streams","sequence":[{"name":"intData","type":{"stream":{"items":"int32"}}},{"name":"optionalIntData","type":{"stream":{"items":[null,"int32"]}}},{"name":"recordWithOptionalVectorData","type":{"stream":{"items":"TestModel.RecordWithOptionalVector"}}},{"name":"fixedVector","type":{"stream":{"items":{"vector":{"items":"int32","length":3}}}}}]},"types":[{"name":"RecordWithOptionalVector","fields":[{"name":"optionalVector","type":[null,{"vector":{"items":"int32"}}]}]}]})";
 
-std::vector<std::string> SubarraysInRecordsWriterBase::previous_schemas_ = {
+std::vector<std::string> StreamsWriterBase::previous_schemas_ = {
 };
 
-std::string SubarraysInRecordsWriterBase::SchemaFromVersion(Version version) {
+std::string StreamsWriterBase::SchemaFromVersion(Version version) {
   switch (version) {
-  case Version::Current: return SubarraysInRecordsWriterBase::schema_; break;
-  default: throw std::runtime_error("The version does not correspond to any schema supported by protocol SubarraysInRecords.");
+  case Version::Current: return StreamsWriterBase::schema_; break;
+  default: throw std::runtime_error("The version does not correspond to any schema supported by protocol Streams.");
   }
 
 }
-void SubarraysInRecordsWriterBase::WriteWithFixedSubarrays(yardl::DynamicNDArray<test_model::RecordWithFixedCollections> const& value) {
+void StreamsWriterBase::WriteIntData(int32_t const& value) {
   if (unlikely(state_ != 0)) {
-    SubarraysInRecordsWriterBaseInvalidState(0, false, state_);
+    StreamsWriterBaseInvalidState(0, false, state_);
   }
 
-  WriteWithFixedSubarraysImpl(value);
-  state_ = 1;
+  WriteIntDataImpl(value);
 }
 
-void SubarraysInRecordsWriterBase::WriteWithVlenSubarrays(yardl::DynamicNDArray<test_model::RecordWithVlenCollections> const& value) {
-  if (unlikely(state_ != 1)) {
-    SubarraysInRecordsWriterBaseInvalidState(1, false, state_);
+void StreamsWriterBase::WriteIntData(std::vector<int32_t> const& values) {
+  if (unlikely(state_ != 0)) {
+    StreamsWriterBaseInvalidState(0, false, state_);
   }
 
-  WriteWithVlenSubarraysImpl(value);
-  state_ = 2;
+  WriteIntDataImpl(values);
 }
 
-void SubarraysInRecordsWriterBase::Close() {
-  if (unlikely(state_ != 2)) {
-    SubarraysInRecordsWriterBaseInvalidState(2, false, state_);
+void StreamsWriterBase::EndIntData() {
+  if (unlikely(state_ != 0)) {
+    StreamsWriterBaseInvalidState(0, true, state_);
   }
 
-  CloseImpl();
+  EndIntDataImpl();
+  state_ = 1;
 }
 
-std::string SubarraysInRecordsReaderBase::schema_ = SubarraysInRecordsWriterBase::schema_;
-
-std::vector<std::string> SubarraysInRecordsReaderBase::previous_schemas_ = SubarraysInRecordsWriterBase::previous_schemas_;
-
-Version SubarraysInRecordsReaderBase::VersionFromSchema(std::string const& schema) {
-  if (schema == SubarraysInRecordsWriterBase::schema_) {
-    return Version::Current;
+// fallback implementation
+void StreamsWriterBase::WriteIntDataImpl(std::vector<int32_t> const& values) {
+  for (auto const& v : values) {
+    WriteIntDataImpl(v);
   }
-  throw std::runtime_error("The schema does not match any version supported by protocol SubarraysInRecords.");
 }
-void SubarraysInRecordsReaderBase::ReadWithFixedSubarrays(yardl::DynamicNDArray<test_model::RecordWithFixedCollections>& value) {
-  if (unlikely(state_ != 0)) {
-    SubarraysInRecordsReaderBaseInvalidState(0, state_);
+
+void StreamsWriterBase::WriteOptionalIntData(std::optional<int32_t> const& value) {
+  if (unlikely(state_ != 1)) {
+    StreamsWriterBaseInvalidState(1, false, state_);
   }
 
-  ReadWithFixedSubarraysImpl(value);
-  state_ = 2;
+  WriteOptionalIntDataImpl(value);
 }
 
-void SubarraysInRecordsReaderBase::ReadWithVlenSubarrays(yardl::DynamicNDArray<test_model::RecordWithVlenCollections>& value) {
-  if (unlikely(state_ != 2)) {
-    SubarraysInRecordsReaderBaseInvalidState(2, state_);
+void StreamsWriterBase::WriteOptionalIntData(std::vector<std::optional<int32_t>> const& values) {
+  if (unlikely(state_ != 1)) {
+    StreamsWriterBaseInvalidState(1, false, state_);
   }
 
-  ReadWithVlenSubarraysImpl(value);
-  state_ = 4;
+  WriteOptionalIntDataImpl(values);
 }
 
-void SubarraysInRecordsReaderBase::Close() {
-  if (!skip_completed_check_ && unlikely(state_ != 4)) {
-    SubarraysInRecordsReaderBaseInvalidState(4, state_);
+void StreamsWriterBase::EndOptionalIntData() {
+  if (unlikely(state_ != 1)) {
+    StreamsWriterBaseInvalidState(1, true, state_);
   }
 
-  CloseImpl();
+  EndOptionalIntDataImpl();
+  state_ = 2;
 }
-void SubarraysInRecordsReaderBase::CopyTo(SubarraysInRecordsWriterBase& writer) {
-  {
-    yardl::DynamicNDArray<test_model::RecordWithFixedCollections> value;
-    ReadWithFixedSubarrays(value);
-    writer.WriteWithFixedSubarrays(value);
-  }
-  {
-    yardl::DynamicNDArray<test_model::RecordWithVlenCollections> value;
-    ReadWithVlenSubarrays(value);
-    writer.WriteWithVlenSubarrays(value);
+
+// fallback implementation
+void StreamsWriterBase::WriteOptionalIntDataImpl(std::vector<std::optional<int32_t>> const& values) {
+  for (auto const& v : values) {
+    WriteOptionalIntDataImpl(v);
   }
 }
 
-namespace {
-void NDArraysWriterBaseInvalidState(uint8_t attempted, [[maybe_unused]] bool end, uint8_t current) {
-  std::string expected_method;
-  switch (current) {
-  case 0: expected_method = "WriteInts()"; break;
-  case 1: expected_method = "WriteSimpleRecordArray()"; break;
-  case 2: expected_method = "WriteRecordWithVlensArray()"; break;
-  case 3: expected_method = "WriteRecordWithNDArrays()"; break;
-  case 4: expected_method = "WriteNamedArray()"; break;
-  }
-  std::string attempted_method;
-  switch (attempted) {
-  case 0: attempted_method = "WriteInts()"; break;
-  case 1: attempted_method = "WriteSimpleRecordArray()"; break;
-  case 2: attempted_method = "WriteRecordWithVlensArray()"; break;
-  case 3: attempted_method = "WriteRecordWithNDArrays()"; break;
-  case 4: attempted_method = "WriteNamedArray()"; break;
-  case 5: attempted_method = "Close()"; break;
+void StreamsWriterBase::WriteRecordWithOptionalVectorData(test_model::RecordWithOptionalVector const& value) {
+  if (unlikely(state_ != 2)) {
+    StreamsWriterBaseInvalidState(2, false, state_);
   }
-  throw std::runtime_error("Expected call to " + expected_method + " but received call to " + attempted_method + " instead.");
-}
 
-void NDArraysReaderBaseInvalidState(uint8_t attempted, uint8_t current) {
-  auto f = [](uint8_t i) -> std::string {
-    switch (i/2) {
-    case 0: return "ReadInts()";
-    case 1: return "ReadSimpleRecordArray()";
-    case 2: return "ReadRecordWithVlensArray()";
-    case 3: return "ReadRecordWithNDArrays()";
-    case 4: return "ReadNamedArray()";
-    case 5: return "Close()";
-    default: return "<unknown>";
-    }
-  };
-  throw std::runtime_error("Expected call to " + f(current) + " but received call to " + f(attempted) + " instead.");
+  WriteRecordWithOptionalVectorDataImpl(value);
 }
 
-} // namespace 
-
-std::string NDArraysWriterBase::schema_ = R"({"protocol":{"name":"NDArrays","sequence":[{"name":"ints","type":{"array":{"items":"int32","dimensions":2}}},{"name":"simpleRecordArray","type":{"array":{"items":"TestModel.SimpleRecord","dimensions":2}}},{"name":"recordWithVlensArray","type":{"array":{"items":"TestModel.RecordWithVlens","dimensions":2}}},{"name":"recordWithNDArrays","type":"TestModel.RecordWithNDArrays"},{"name":"namedArray","type":"TestModel.NamedNDArray"}]},"types":[{"name":"NamedNDArray","type":{"array":{"items":"int32","dimensions":[{"name":"dimA"},{"name":"dimB"}]}}},{"name":"RecordWithNDArrays","fields":[{"name":"ints","type":{"array":{"items":"int32","dimensions":2}}},{"name":"fixedSimpleRecordArray","type":{"array":{"items":"TestModel.SimpleRecord","dimensions":2}}},{"name":"fixedRecordWithVlensArray","type":{"array":{"items":"TestModel.RecordWithVlens","dimensions":2}}}]},{"name":"RecordWithVlens","fields":[{"name":"a","type":{"vector":{"items":"TestModel.SimpleRecord"}}},{"name":"b","type":"int32"},{"name":"c","type":"int32"}]},{"name":"SimpleRecord","fields":[{"name":"x","type":"int32"},{"name":"y","type":"int32"},{"name":"z","type":"int32"}]}]})";
+void StreamsWriterBase::WriteRecordWithOptionalVectorData(std::vector<test_model::RecordWithOptionalVector> const& values) {
+  if (unlikely(state_ != 2)) {
+    StreamsWriterBaseInvalidState(2, false, state_);
+  }
 
-std::vector<std::string> NDArraysWriterBase::previous_schemas_ = {
-};
+  WriteRecordWithOptionalVectorDataImpl(values);
+}
 
-std::string NDArraysWriterBase::SchemaFromVersion(Version version) {
-  switch (version) {
-  case Version::Current: return NDArraysWriterBase::schema_; break;
-  default: throw std::runtime_error("The version does not correspond to any schema supported by protocol NDArrays.");
+void StreamsWriterBase::EndRecordWithOptionalVectorData() {
+  if (unlikely(state_ != 2)) {
+    StreamsWriterBaseInvalidState(2, true, state_);
   }
 
+  EndRecordWithOptionalVectorDataImpl();
+  state_ = 3;
 }
-void NDArraysWriterBase::WriteInts(yardl::NDArray<int32_t, 2> const& value) {
-  if (unlikely(state_ != 0)) {
-    NDArraysWriterBaseInvalidState(0, false, state_);
-  }
 
-  WriteIntsImpl(value);
-  state_ = 1;
+// fallback implementation
+void StreamsWriterBase::WriteRecordWithOptionalVectorDataImpl(std::vector<test_model::RecordWithOptionalVector> const& values) {
+  for (auto const& v : values) {
+    WriteRecordWithOptionalVectorDataImpl(v);
+  }
 }
 
-void NDArraysWriterBase::WriteSimpleRecordArray(yardl::NDArray<test_model::SimpleRecord, 2> const& value) {
-  if (unlikely(state_ != 1)) {
-    NDArraysWriterBaseInvalidState(1, false, state_);
+void StreamsWriterBase::WriteFixedVector(std::array<int32_t, 3> const& value) {
+  if (unlikely(state_ != 3)) {
+    StreamsWriterBaseInvalidState(3, false, state_);
   }
 
-  WriteSimpleRecordArrayImpl(value);
-  state_ = 2;
+  WriteFixedVectorImpl(value);
 }
 
-void NDArraysWriterBase::WriteRecordWithVlensArray(yardl::NDArray<test_model::RecordWithVlens, 2> const& value) {
-  if (unlikely(state_ != 2)) {
-    NDArraysWriterBaseInvalidState(2, false, state_);
+void StreamsWriterBase::WriteFixedVector(std::vector<std::array<int32_t, 3>> const& values) {
+  if (unlikely(state_ != 3)) {
+    StreamsWriterBaseInvalidState(3, false, state_);
   }
 
-  WriteRecordWithVlensArrayImpl(value);
-  state_ = 3;
+  WriteFixedVectorImpl(values);
 }
 
-void NDArraysWriterBase::WriteRecordWithNDArrays(test_model::RecordWithNDArrays const& value) {
+void StreamsWriterBase::EndFixedVector() {
   if (unlikely(state_ != 3)) {
-    NDArraysWriterBaseInvalidState(3, false, state_);
+    StreamsWriterBaseInvalidState(3, true, state_);
   }
 
-  WriteRecordWithNDArraysImpl(value);
+  EndFixedVectorImpl();
   state_ = 4;
 }
 
-void NDArraysWriterBase::WriteNamedArray(test_model::NamedNDArray const& value) {
-  if (unlikely(state_ != 4)) {
-    NDArraysWriterBaseInvalidState(4, false, state_);
+// fallback implementation
+void StreamsWriterBase::WriteFixedVectorImpl(std::vector<std::array<int32_t, 3>> const& values) {
+  for (auto const& v : values) {
+    WriteFixedVectorImpl(v);
   }
-
-  WriteNamedArrayImpl(value);
-  state_ = 5;
 }
 
-void NDArraysWriterBase::Close() {
-  if (unlikely(state_ != 5)) {
-    NDArraysWriterBaseInvalidState(5, false, state_);
+void StreamsWriterBase::Close() {
+  if (unlikely(state_ != 4)) {
+    StreamsWriterBaseInvalidState(4, false, state_);
   }
 
   CloseImpl();
 }
 
-std::string NDArraysReaderBase::schema_ = NDArraysWriterBase::schema_;
+std::string StreamsReaderBase::schema_ = StreamsWriterBase::schema_;
 
-std::vector<std::string> NDArraysReaderBase::previous_schemas_ = NDArraysWriterBase::previous_schemas_;
+std::vector<std::string> StreamsReaderBase::previous_schemas_ = StreamsWriterBase::previous_schemas_;
 
-Version NDArraysReaderBase::VersionFromSchema(std::string const& schema) {
-  if (schema == NDArraysWriterBase::schema_) {
+Version StreamsReaderBase::VersionFromSchema(std::string const& schema) {
+  if (schema == StreamsWriterBase::schema_) {
     return Version::Current;
   }
-  throw std::runtime_error("The schema does not match any version supported by protocol NDArrays.");
+  throw std::runtime_error("The schema does not match any version supported by protocol Streams.");
 }
-void NDArraysReaderBase::ReadInts(yardl::NDArray<int32_t, 2>& value) {
+bool StreamsReaderBase::ReadIntData(int32_t& value) {
   if (unlikely(state_ != 0)) {
-    NDArraysReaderBaseInvalidState(0, state_);
+    if (state_ == 1) {
+      state_ = 2;
+      return false;
+    }
+    StreamsReaderBaseInvalidState(0, state_);
   }
 
-  ReadIntsImpl(value);
-  state_ = 2;
-}
-
-void NDArraysReaderBase::ReadSimpleRecordArray(yardl::NDArray<test_model::SimpleRecord, 2>& value) {
-  if (unlikely(state_ != 2)) {
-    NDArraysReaderBaseInvalidState(2, state_);
+  bool result = ReadIntDataImpl(value);
+  if (!result) {
+    state_ = 2;
   }
-
-  ReadSimpleRecordArrayImpl(value);
-  state_ = 4;
+  return result;
 }
 
-void NDArraysReaderBase::ReadRecordWithVlensArray(yardl::NDArray<test_model::RecordWithVlens, 2>& value) {
-  if (unlikely(state_ != 4)) {
-    NDArraysReaderBaseInvalidState(4, state_);
+bool StreamsReaderBase::ReadIntData(std::vector<int32_t>& values) {
+  if (values.capacity() == 0) {
+    throw std::runtime_error("vector must have a nonzero capacity.");
   }
-
-  ReadRecordWithVlensArrayImpl(value);
-  state_ = 6;
-}
-
-void NDArraysReaderBase::ReadRecordWithNDArrays(test_model::RecordWithNDArrays& value) {
-  if (unlikely(state_ != 6)) {
-    NDArraysReaderBaseInvalidState(6, state_);
+  if (unlikely(state_ != 0)) {
+    if (state_ == 1) {
+      state_ = 2;
+      values.clear();
+      return false;
+    }
+    StreamsReaderBaseInvalidState(0, state_);
   }
 
-  ReadRecordWithNDArraysImpl(value);
-  state_ = 8;
+  if (!ReadIntDataImpl(values)) {
+    state_ = 1;
+    return values.size() > 0;
+  }
+  return true;
 }
 
-void NDArraysReaderBase::ReadNamedArray(test_model::NamedNDArray& value) {
+// fallback implementation
+bool StreamsReaderBase::ReadIntDataImpl(std::vector<int32_t>& values) {
+  size_t i = 0;
+  while (true) {
+    if (i == values.size()) {
+      values.resize(i + 1);
+    }
+    if (!ReadIntDataImpl(values[i])) {
+      values.resize(i);
+      return false;
+    }
+    i++;
+    if (i == values.capacity()) {
+      return true;
+    }
+  }
+}
+
+bool StreamsReaderBase::ReadOptionalIntData(std::optional<int32_t>& value) {
+  if (unlikely(state_ != 2)) {
+    if (state_ == 3) {
+      state_ = 4;
+      return false;
+    }
+    if (state_ == 1) {
+      state_ = 2;
+    } else {
+      StreamsReaderBaseInvalidState(2, state_);
+    }
+  }
+
+  bool result = ReadOptionalIntDataImpl(value);
+  if (!result) {
+    state_ = 4;
+  }
+  return result;
+}
+
+bool StreamsReaderBase::ReadOptionalIntData(std::vector<std::optional<int32_t>>& values) {
+  if (values.capacity() == 0) {
+    throw std::runtime_error("vector must have a nonzero capacity.");
+  }
+  if (unlikely(state_ != 2)) {
+    if (state_ == 3) {
+      state_ = 4;
+      values.clear();
+      return false;
+    }
+    if (state_ == 1) {
+      state_ = 2;
+    } else {
+      StreamsReaderBaseInvalidState(2, state_);
+    }
+  }
+
+  if (!ReadOptionalIntDataImpl(values)) {
+    state_ = 3;
+    return values.size() > 0;
+  }
+  return true;
+}
+
+// fallback implementation
+bool StreamsReaderBase::ReadOptionalIntDataImpl(std::vector<std::optional<int32_t>>& values) {
+  size_t i = 0;
+  while (true) {
+    if (i == values.size()) {
+      values.resize(i + 1);
+    }
+    if (!ReadOptionalIntDataImpl(values[i])) {
+      values.resize(i);
+      return false;
+    }
+    i++;
+    if (i == values.capacity()) {
+      return true;
+    }
+  }
+}
+
+bool StreamsReaderBase::ReadRecordWithOptionalVectorData(test_model::RecordWithOptionalVector& value) {
+  if (unlikely(state_ != 4)) {
+    if (state_ == 5) {
+      state_ = 6;
+      return false;
+    }
+    if (state_ == 3) {
+      state_ = 4;
+    } else {
+      StreamsReaderBaseInvalidState(4, state_);
+    }
+  }
+
+  bool result = ReadRecordWithOptionalVectorDataImpl(value);
+  if (!result) {
+    state_ = 6;
+  }
+  return result;
+}
+
+bool StreamsReaderBase::ReadRecordWithOptionalVectorData(std::vector<test_model::RecordWithOptionalVector>& values) {
+  if (values.capacity() == 0) {
+    throw std::runtime_error("vector must have a nonzero capacity.");
+  }
+  if (unlikely(state_ != 4)) {
+    if (state_ == 5) {
+      state_ = 6;
+      values.clear();
+      return false;
+    }
+    if (state_ == 3) {
+      state_ = 4;
+    } else {
+      StreamsReaderBaseInvalidState(4, state_);
+    }
+  }
+
+  if (!ReadRecordWithOptionalVectorDataImpl(values)) {
+    state_ = 5;
+    return values.size() > 0;
+  }
+  return true;
+}
+
+// fallback implementation
+bool StreamsReaderBase::ReadRecordWithOptionalVectorDataImpl(std::vector<test_model::RecordWithOptionalVector>& values) {
+  size_t i = 0;
+  while (true) {
+    if (i == values.size()) {
+      values.resize(i + 1);
+    }
+    if (!ReadRecordWithOptionalVectorDataImpl(values[i])) {
+      values.resize(i);
+      return false;
+    }
+    i++;
+    if (i == values.capacity()) {
+      return true;
+    }
+  }
+}
+
+bool StreamsReaderBase::ReadFixedVector(std::array<int32_t, 3>& value) {
+  if (unlikely(state_ != 6)) {
+    if (state_ == 7) {
+      state_ = 8;
+      return false;
+    }
+    if (state_ == 5) {
+      state_ = 6;
+    } else {
+      StreamsReaderBaseInvalidState(6, state_);
+    }
+  }
+
+  bool result = ReadFixedVectorImpl(value);
+  if (!result) {
+    state_ = 8;
+  }
+  return result;
+}
+
+bool StreamsReaderBase::ReadFixedVector(std::vector<std::array<int32_t, 3>>& values) {
+  if (values.capacity() == 0) {
+    throw std::runtime_error("vector must have a nonzero capacity.");
+  }
+  if (unlikely(state_ != 6)) {
+    if (state_ == 7) {
+      state_ = 8;
+      values.clear();
+      return false;
+    }
+    if (state_ == 5) {
+      state_ = 6;
+    } else {
+      StreamsReaderBaseInvalidState(6, state_);
+    }
+  }
+
+  if (!ReadFixedVectorImpl(values)) {
+    state_ = 7;
+    return values.size() > 0;
+  }
+  return true;
+}
+
+// fallback implementation
+bool StreamsReaderBase::ReadFixedVectorImpl(std::vector<std::array<int32_t, 3>>& values) {
+  size_t i = 0;
+  while (true) {
+    if (i == values.size()) {
+      values.resize(i + 1);
+    }
+    if (!ReadFixedVectorImpl(values[i])) {
+      values.resize(i);
+      return false;
+    }
+    i++;
+    if (i == values.capacity()) {
+      return true;
+    }
+  }
+}
+
+void StreamsReaderBase::Close() {
+  if (!skip_completed_check_ && unlikely(state_ != 8)) {
+    if (state_ == 7) {
+      state_ = 8;
+    } else {
+      StreamsReaderBaseInvalidState(8, state_);
+    }
+  }
+
+  CloseImpl();
+}
+void StreamsReaderBase::CopyTo(StreamsWriterBase& writer, size_t int_data_buffer_size, size_t optional_int_data_buffer_size, size_t record_with_optional_vector_data_buffer_size, size_t fixed_vector_buffer_size) {
+  if (int_data_buffer_size > 1) {
+    std::vector<int32_t> values;
+    values.reserve(int_data_buffer_size);
+    while(ReadIntData(values)) {
+      writer.WriteIntData(values);
+    }
+    writer.EndIntData();
+  } else {
+    int32_t value;
+    while(ReadIntData(value)) {
+      writer.WriteIntData(value);
+    }
+    writer.EndIntData();
+  }
+  if (optional_int_data_buffer_size > 1) {
+    std::vector<std::optional<int32_t>> values;
+    values.reserve(optional_int_data_buffer_size);
+    while(ReadOptionalIntData(values)) {
+      writer.WriteOptionalIntData(values);
+    }
+    writer.EndOptionalIntData();
+  } else {
+    std::optional<int32_t> value;
+    while(ReadOptionalIntData(value)) {
+      writer.WriteOptionalIntData(value);
+    }
+    writer.EndOptionalIntData();
+  }
+  if (record_with_optional_vector_data_buffer_size > 1) {
+    std::vector<test_model::RecordWithOptionalVector> values;
+    values.reserve(record_with_optional_vector_data_buffer_size);
+    while(ReadRecordWithOptionalVectorData(values)) {
+      writer.WriteRecordWithOptionalVectorData(values);
+    }
+    writer.EndRecordWithOptionalVectorData();
+  } else {
+    test_model::RecordWithOptionalVector value;
+    while(ReadRecordWithOptionalVectorData(value)) {
+      writer.WriteRecordWithOptionalVectorData(value);
+    }
+    writer.EndRecordWithOptionalVectorData();
+  }
+  if (fixed_vector_buffer_size > 1) {
+    std::vector<std::array<int32_t, 3>> values;
+    values.reserve(fixed_vector_buffer_size);
+    while(ReadFixedVector(values)) {
+      writer.WriteFixedVector(values);
+    }
+    writer.EndFixedVector();
+  } else {
+    std::array<int32_t, 3> value;
+    while(ReadFixedVector(value)) {
+      writer.WriteFixedVector(value);
+    }
+    writer.EndFixedVector();
+  }
+}
+
+namespace {
+void FixedArraysWriterBaseInvalidState(uint8_t attempted, [[maybe_unused]] bool end, uint8_t current) {
+  std::string expected_method;
+  switch (current) {
+  case 0: expected_method = "WriteInts()"; break;
+  case 1: expected_method = "WriteFixedSimpleRecordArray()"; break;
+  case 2: expected_method = "WriteFixedRecordWithVlensArray()"; break;
+  case 3: expected_method = "WriteRecordWithFixedArrays()"; break;
+  case 4: expected_method = "WriteNamedArray()"; break;
+  }
+  std::string attempted_method;
+  switch (attempted) {
+  case 0: attempted_method = "WriteInts()"; break;
+  case 1: attempted_method = "WriteFixedSimpleRecordArray()"; break;
+  case 2: attempted_method = "WriteFixedRecordWithVlensArray()"; break;
+  case 3: attempted_method = "WriteRecordWithFixedArrays()"; break;
+  case 4: attempted_method = "WriteNamedArray()"; break;
+  case 5: attempted_method = "Close()"; break;
+  }
+  throw std::runtime_error("Expected call to " + expected_method + " but received call to " + attempted_method + " instead.");
+}
+
+void FixedArraysReaderBaseInvalidState(uint8_t attempted, uint8_t current) {
+  auto f = [](uint8_t i) -> std::string {
+    switch (i/2) {
+    case 0: return "ReadInts()";
+    case 1: return "ReadFixedSimpleRecordArray()";
+    case 2: return "ReadFixedRecordWithVlensArray()";
+    case 3: return "ReadRecordWithFixedArrays()";
+    case 4: return "ReadNamedArray()";
+    case 5: return "Close()";
+    default: return "<unknown>";
+    }
+  };
+  throw std::runtime_error("Expected call to " + f(current) + " but received call to " + f(attempted) + " instead.");
+}
+
+} // namespace 
+
+std::string FixedArraysWriterBase::schema_ = R"({"protocol":{"name":"FixedArrays","sequence":[{"name":"ints","type":{"array":{"items":"int32","dimensions":[{"length":2},{"length":3}]}}},{"name":"fixedSimpleRecordArray","type":{"array":{"items":"TestModel.SimpleRecord","dimensions":[{"length":3},{"length":2}]}}},{"name":"fixedRecordWithVlensArray","type":{"array":{"items":"TestModel.RecordWithVlens","dimensions":[{"length":2},{"length":2}]}}},{"name":"recordWithFixedArrays","type":"TestModel.RecordWithFixedArrays"},{"name":"namedArray","type":"TestModel.NamedFixedNDArray"}]},"types":[{"name":"NamedFixedNDArray","type":{"array":{"items":"int32","dimensions":[{"name":"dimA","length":2},{"name":"dimB","length":4}]}}},{"name":"RecordWithFixedArrays","fields":[{"name":"ints","type":{"array":{"items":"int32","dimensions":[{"length":2},{"length":3}]}}},{"name":"fixedSimpleRecordArray","type":{"array":{"items":"TestModel.SimpleRecord","dimensions":[{"length":3},{"length":2}]}}},{"name":"fixedRecordWithVlensArray","type":{"array":{"items":"TestModel.RecordWithVlens","dimensions":[{"length":2},{"length":2}]}}}]},{"name":"RecordWithVlens","fields":[{"name":"a","type":{"vector":{"items":"TestModel.SimpleRecord"}}},{"name":"b","type":"int32"},{"name":"c","type":"int32"}]},{"name":"SimpleRecord","fields":[{"name":"x","type":"int32"},{"name":"y","type":"int32"},{"name":"z","type":"int32"}]}]})";
+
+std::vector<std::string> FixedArraysWriterBase::previous_schemas_ = {
+};
+
+std::string FixedArraysWriterBase::SchemaFromVersion(Version version) {
+  switch (version) {
+  case Version::Current: return FixedArraysWriterBase::schema_; break;
+  default: throw std::runtime_error("The version does not correspond to any schema supported by protocol FixedArrays.");
+  }
+
+}
+void FixedArraysWriterBase::WriteInts(yardl::FixedNDArray<int32_t, 2, 3> const& value) {
+  if (unlikely(state_ != 0)) {
+    FixedArraysWriterBaseInvalidState(0, false, state_);
+  }
+
+  WriteIntsImpl(value);
+  state_ = 1;
+}
+
+void FixedArraysWriterBase::WriteFixedSimpleRecordArray(yardl::FixedNDArray<test_model::SimpleRecord, 3, 2> const& value) {
+  if (unlikely(state_ != 1)) {
+    FixedArraysWriterBaseInvalidState(1, false, state_);
+  }
+
+  WriteFixedSimpleRecordArrayImpl(value);
+  state_ = 2;
+}
+
+void FixedArraysWriterBase::WriteFixedRecordWithVlensArray(yardl::FixedNDArray<test_model::RecordWithVlens, 2, 2> const& value) {
+  if (unlikely(state_ != 2)) {
+    FixedArraysWriterBaseInvalidState(2, false, state_);
+  }
+
+  WriteFixedRecordWithVlensArrayImpl(value);
+  state_ = 3;
+}
+
+void FixedArraysWriterBase::WriteRecordWithFixedArrays(test_model::RecordWithFixedArrays const& value) {
+  if (unlikely(state_ != 3)) {
+    FixedArraysWriterBaseInvalidState(3, false, state_);
+  }
+
+  WriteRecordWithFixedArraysImpl(value);
+  state_ = 4;
+}
+
+void FixedArraysWriterBase::WriteNamedArray(test_model::NamedFixedNDArray const& value) {
+  if (unlikely(state_ != 4)) {
+    FixedArraysWriterBaseInvalidState(4, false, state_);
+  }
+
+  WriteNamedArrayImpl(value);
+  state_ = 5;
+}
+
+void FixedArraysWriterBase::Close() {
+  if (unlikely(state_ != 5)) {
+    FixedArraysWriterBaseInvalidState(5, false, state_);
+  }
+
+  CloseImpl();
+}
+
+std::string FixedArraysReaderBase::schema_ = FixedArraysWriterBase::schema_;
+
+std::vector<std::string> FixedArraysReaderBase::previous_schemas_ = FixedArraysWriterBase::previous_schemas_;
+
+Version FixedArraysReaderBase::VersionFromSchema(std::string const& schema) {
+  if (schema == FixedArraysWriterBase::schema_) {
+    return Version::Current;
+  }
+  throw std::runtime_error("The schema does not match any version supported by protocol FixedArrays.");
+}
+void FixedArraysReaderBase::ReadInts(yardl::FixedNDArray<int32_t, 2, 3>& value) {
+  if (unlikely(state_ != 0)) {
+    FixedArraysReaderBaseInvalidState(0, state_);
+  }
+
+  ReadIntsImpl(value);
+  state_ = 2;
+}
+
+void FixedArraysReaderBase::ReadFixedSimpleRecordArray(yardl::FixedNDArray<test_model::SimpleRecord, 3, 2>& value) {
+  if (unlikely(state_ != 2)) {
+    FixedArraysReaderBaseInvalidState(2, state_);
+  }
+
+  ReadFixedSimpleRecordArrayImpl(value);
+  state_ = 4;
+}
+
+void FixedArraysReaderBase::ReadFixedRecordWithVlensArray(yardl::FixedNDArray<test_model::RecordWithVlens, 2, 2>& value) {
+  if (unlikely(state_ != 4)) {
+    FixedArraysReaderBaseInvalidState(4, state_);
+  }
+
+  ReadFixedRecordWithVlensArrayImpl(value);
+  state_ = 6;
+}
+
+void FixedArraysReaderBase::ReadRecordWithFixedArrays(test_model::RecordWithFixedArrays& value) {
+  if (unlikely(state_ != 6)) {
+    FixedArraysReaderBaseInvalidState(6, state_);
+  }
+
+  ReadRecordWithFixedArraysImpl(value);
+  state_ = 8;
+}
+
+void FixedArraysReaderBase::ReadNamedArray(test_model::NamedFixedNDArray& value) {
+  if (unlikely(state_ != 8)) {
+    FixedArraysReaderBaseInvalidState(8, state_);
+  }
+
+  ReadNamedArrayImpl(value);
+  state_ = 10;
+}
+
+void FixedArraysReaderBase::Close() {
+  if (!skip_completed_check_ && unlikely(state_ != 10)) {
+    FixedArraysReaderBaseInvalidState(10, state_);
+  }
+
+  CloseImpl();
+}
+void FixedArraysReaderBase::CopyTo(FixedArraysWriterBase& writer) {
+  {
+    yardl::FixedNDArray<int32_t, 2, 3> value;
+    ReadInts(value);
+    writer.WriteInts(value);
+  }
+  {
+    yardl::FixedNDArray<test_model::SimpleRecord, 3, 2> value;
+    ReadFixedSimpleRecordArray(value);
+    writer.WriteFixedSimpleRecordArray(value);
+  }
+  {
+    yardl::FixedNDArray<test_model::RecordWithVlens, 2, 2> value;
+    ReadFixedRecordWithVlensArray(value);
+    writer.WriteFixedRecordWithVlensArray(value);
+  }
+  {
+    test_model::RecordWithFixedArrays value;
+    ReadRecordWithFixedArrays(value);
+    writer.WriteRecordWithFixedArrays(value);
+  }
+  {
+    test_model::NamedFixedNDArray value;
+    ReadNamedArray(value);
+    writer.WriteNamedArray(value);
+  }
+}
+
+namespace {
+void SubarraysWriterBaseInvalidState(uint8_t attempted, [[maybe_unused]] bool end, uint8_t current) {
+  std::string expected_method;
+  switch (current) {
+  case 0: expected_method = "WriteDynamicWithFixedIntSubarray()"; break;
+  case 1: expected_method = "WriteDynamicWithFixedFloatSubarray()"; break;
+  case 2: expected_method = "WriteKnownDimCountWithFixedIntSubarray()"; break;
+  case 3: expected_method = "WriteKnownDimCountWithFixedFloatSubarray()"; break;
+  case 4: expected_method = "WriteFixedWithFixedIntSubarray()"; break;
+  case 5: expected_method = "WriteFixedWithFixedFloatSubarray()"; break;
+  case 6: expected_method = "WriteNestedSubarray()"; break;
+  case 7: expected_method = "WriteDynamicWithFixedVectorSubarray()"; break;
+  case 8: expected_method = "WriteGenericSubarray()"; break;
+  }
+  std::string attempted_method;
+  switch (attempted) {
+  case 0: attempted_method = "WriteDynamicWithFixedIntSubarray()"; break;
+  case 1: attempted_method = "WriteDynamicWithFixedFloatSubarray()"; break;
+  case 2: attempted_method = "WriteKnownDimCountWithFixedIntSubarray()"; break;
+  case 3: attempted_method = "WriteKnownDimCountWithFixedFloatSubarray()"; break;
+  case 4: attempted_method = "WriteFixedWithFixedIntSubarray()"; break;
+  case 5: attempted_method = "WriteFixedWithFixedFloatSubarray()"; break;
+  case 6: attempted_method = "WriteNestedSubarray()"; break;
+  case 7: attempted_method = "WriteDynamicWithFixedVectorSubarray()"; break;
+  case 8: attempted_method = "WriteGenericSubarray()"; break;
+  case 9: attempted_method = "Close()"; break;
+  }
+  throw std::runtime_error("Expected call to " + expected_method + " but received call to " + attempted_method + " instead.");
+}
+
+void SubarraysReaderBaseInvalidState(uint8_t attempted, uint8_t current) {
+  auto f = [](uint8_t i) -> std::string {
+    switch (i/2) {
+    case 0: return "ReadDynamicWithFixedIntSubarray()";
+    case 1: return "ReadDynamicWithFixedFloatSubarray()";
+    case 2: return "ReadKnownDimCountWithFixedIntSubarray()";
+    case 3: return "ReadKnownDimCountWithFixedFloatSubarray()";
+    case 4: return "ReadFixedWithFixedIntSubarray()";
+    case 5: return "ReadFixedWithFixedFloatSubarray()";
+    case 6: return "ReadNestedSubarray()";
+    case 7: return "ReadDynamicWithFixedVectorSubarray()";
+    case 8: return "ReadGenericSubarray()";
+    case 9: return "Close()";
+    default: return "<unknown>";
+    }
+  };
+  throw std::runtime_error("Expected call to " + f(current) + " but received call to " + f(attempted) + " instead.");
+}
+
+} // namespace 
+
+std::string SubarraysWriterBase::schema_ = R"({"protocol":{"name":"Subarrays","sequence":[{"name":"dynamicWithFixedIntSubarray","type":{"array":{"items":{"array":{"items":"int32","dimensions":[{"length":3}]}}}}},{"name":"dynamicWithFixedFloatSubarray","type":{"array":{"items":{"array":{"items":"float32","dimensions":[{"length":3}]}}}}},{"name":"knownDimCountWithFixedIntSubarray","type":{"array":{"items":{"array":{"items":"int32","dimensions":[{"length":3}]}},"dimensions":1}}},{"name":"knownDimCountWithFixedFloatSubarray","type":{"array":{"items":{"array":{"items":"float32","dimensions":[{"length":3}]}},"dimensions":1}}},{"name":"fixedWithFixedIntSubarray","type":{"array":{"items":{"array":{"items":"int32","dimensions":[{"length":3}]}},"dimensions":[{"length":2}]}}},{"name":"fixedWithFixedFloatSubarray","type":{"array":{"items":{"array":{"items":"float32","dimensions":[{"length":3}]}},"dimensions":[{"length":2}]}}},{"name":"nestedSubarray","type":{"array":{"items":{"array":{"items":{"array":{"items":"int32","dimensions":[{"length":3}]}},"dimensions":[{"length":2}]}}}}},{"name":"dynamicWithFixedVectorSubarray","type":{"array":{"items":{"vector":{"items":"int32","length":3}}}}},{"name":"genericSubarray","type":{"name":"TestModel.Image","typeArguments":[{"array":{"items":"int32","dimensions":[{"length":3}]}}]}}]},"types":[{"name":"Image","typeParameters":["T"],"type":{"array":{"items":"T","dimensions":[{"name":"x"},{"name":"y"}]}}},{"name":"Image","typeParameters":["T"],"type":{"name":"Image.Image","typeArguments":["T"]}}]})";
+
+std::vector<std::string> SubarraysWriterBase::previous_schemas_ = {
+};
+
+std::string SubarraysWriterBase::SchemaFromVersion(Version version) {
+  switch (version) {
+  case Version::Current: return SubarraysWriterBase::schema_; break;
+  default: throw std::runtime_error("The version does not correspond to any schema supported by protocol Subarrays.");
+  }
+
+}
+void SubarraysWriterBase::WriteDynamicWithFixedIntSubarray(yardl::DynamicNDArray<yardl::FixedNDArray<int32_t, 3>> const& value) {
+  if (unlikely(state_ != 0)) {
+    SubarraysWriterBaseInvalidState(0, false, state_);
+  }
+
+  WriteDynamicWithFixedIntSubarrayImpl(value);
+  state_ = 1;
+}
+
+void SubarraysWriterBase::WriteDynamicWithFixedFloatSubarray(yardl::DynamicNDArray<yardl::FixedNDArray<float, 3>> const& value) {
+  if (unlikely(state_ != 1)) {
+    SubarraysWriterBaseInvalidState(1, false, state_);
+  }
+
+  WriteDynamicWithFixedFloatSubarrayImpl(value);
+  state_ = 2;
+}
+
+void SubarraysWriterBase::WriteKnownDimCountWithFixedIntSubarray(yardl::NDArray<yardl::FixedNDArray<int32_t, 3>, 1> const& value) {
+  if (unlikely(state_ != 2)) {
+    SubarraysWriterBaseInvalidState(2, false, state_);
+  }
+
+  WriteKnownDimCountWithFixedIntSubarrayImpl(value);
+  state_ = 3;
+}
+
+void SubarraysWriterBase::WriteKnownDimCountWithFixedFloatSubarray(yardl::NDArray<yardl::FixedNDArray<float, 3>, 1> const& value) {
+  if (unlikely(state_ != 3)) {
+    SubarraysWriterBaseInvalidState(3, false, state_);
+  }
+
+  WriteKnownDimCountWithFixedFloatSubarrayImpl(value);
+  state_ = 4;
+}
+
+void SubarraysWriterBase::WriteFixedWithFixedIntSubarray(yardl::FixedNDArray<yardl::FixedNDArray<int32_t, 3>, 2> const& value) {
+  if (unlikely(state_ != 4)) {
+    SubarraysWriterBaseInvalidState(4, false, state_);
+  }
+
+  WriteFixedWithFixedIntSubarrayImpl(value);
+  state_ = 5;
+}
+
+void SubarraysWriterBase::WriteFixedWithFixedFloatSubarray(yardl::FixedNDArray<yardl::FixedNDArray<float, 3>, 2> const& value) {
+  if (unlikely(state_ != 5)) {
+    SubarraysWriterBaseInvalidState(5, false, state_);
+  }
+
+  WriteFixedWithFixedFloatSubarrayImpl(value);
+  state_ = 6;
+}
+
+void SubarraysWriterBase::WriteNestedSubarray(yardl::DynamicNDArray<yardl::FixedNDArray<yardl::FixedNDArray<int32_t, 3>, 2>> const& value) {
+  if (unlikely(state_ != 6)) {
+    SubarraysWriterBaseInvalidState(6, false, state_);
+  }
+
+  WriteNestedSubarrayImpl(value);
+  state_ = 7;
+}
+
+void SubarraysWriterBase::WriteDynamicWithFixedVectorSubarray(yardl::DynamicNDArray<std::array<int32_t, 3>> const& value) {
+  if (unlikely(state_ != 7)) {
+    SubarraysWriterBaseInvalidState(7, false, state_);
+  }
+
+  WriteDynamicWithFixedVectorSubarrayImpl(value);
+  state_ = 8;
+}
+
+void SubarraysWriterBase::WriteGenericSubarray(test_model::Image<yardl::FixedNDArray<int32_t, 3>> const& value) {
+  if (unlikely(state_ != 8)) {
+    SubarraysWriterBaseInvalidState(8, false, state_);
+  }
+
+  WriteGenericSubarrayImpl(value);
+  state_ = 9;
+}
+
+void SubarraysWriterBase::Close() {
+  if (unlikely(state_ != 9)) {
+    SubarraysWriterBaseInvalidState(9, false, state_);
+  }
+
+  CloseImpl();
+}
+
+std::string SubarraysReaderBase::schema_ = SubarraysWriterBase::schema_;
+
+std::vector<std::string> SubarraysReaderBase::previous_schemas_ = SubarraysWriterBase::previous_schemas_;
+
+Version SubarraysReaderBase::VersionFromSchema(std::string const& schema) {
+  if (schema == SubarraysWriterBase::schema_) {
+    return Version::Current;
+  }
+  throw std::runtime_error("The schema does not match any version supported by protocol Subarrays.");
+}
+void SubarraysReaderBase::ReadDynamicWithFixedIntSubarray(yardl::DynamicNDArray<yardl::FixedNDArray<int32_t, 3>>& value) {
+  if (unlikely(state_ != 0)) {
+    SubarraysReaderBaseInvalidState(0, state_);
+  }
+
+  ReadDynamicWithFixedIntSubarrayImpl(value);
+  state_ = 2;
+}
+
+void SubarraysReaderBase::ReadDynamicWithFixedFloatSubarray(yardl::DynamicNDArray<yardl::FixedNDArray<float, 3>>& value) {
+  if (unlikely(state_ != 2)) {
+    SubarraysReaderBaseInvalidState(2, state_);
+  }
+
+  ReadDynamicWithFixedFloatSubarrayImpl(value);
+  state_ = 4;
+}
+
+void SubarraysReaderBase::ReadKnownDimCountWithFixedIntSubarray(yardl::NDArray<yardl::FixedNDArray<int32_t, 3>, 1>& value) {
+  if (unlikely(state_ != 4)) {
+    SubarraysReaderBaseInvalidState(4, state_);
+  }
+
+  ReadKnownDimCountWithFixedIntSubarrayImpl(value);
+  state_ = 6;
+}
+
+void SubarraysReaderBase::ReadKnownDimCountWithFixedFloatSubarray(yardl::NDArray<yardl::FixedNDArray<float, 3>, 1>& value) {
+  if (unlikely(state_ != 6)) {
+    SubarraysReaderBaseInvalidState(6, state_);
+  }
+
+  ReadKnownDimCountWithFixedFloatSubarrayImpl(value);
+  state_ = 8;
+}
+
+void SubarraysReaderBase::ReadFixedWithFixedIntSubarray(yardl::FixedNDArray<yardl::FixedNDArray<int32_t, 3>, 2>& value) {
+  if (unlikely(state_ != 8)) {
+    SubarraysReaderBaseInvalidState(8, state_);
+  }
+
+  ReadFixedWithFixedIntSubarrayImpl(value);
+  state_ = 10;
+}
+
+void SubarraysReaderBase::ReadFixedWithFixedFloatSubarray(yardl::FixedNDArray<yardl::FixedNDArray<float, 3>, 2>& value) {
+  if (unlikely(state_ != 10)) {
+    SubarraysReaderBaseInvalidState(10, state_);
+  }
+
+  ReadFixedWithFixedFloatSubarrayImpl(value);
+  state_ = 12;
+}
+
+void SubarraysReaderBase::ReadNestedSubarray(yardl::DynamicNDArray<yardl::FixedNDArray<yardl::FixedNDArray<int32_t, 3>, 2>>& value) {
+  if (unlikely(state_ != 12)) {
+    SubarraysReaderBaseInvalidState(12, state_);
+  }
+
+  ReadNestedSubarrayImpl(value);
+  state_ = 14;
+}
+
+void SubarraysReaderBase::ReadDynamicWithFixedVectorSubarray(yardl::DynamicNDArray<std::array<int32_t, 3>>& value) {
+  if (unlikely(state_ != 14)) {
+    SubarraysReaderBaseInvalidState(14, state_);
+  }
+
+  ReadDynamicWithFixedVectorSubarrayImpl(value);
+  state_ = 16;
+}
+
+void SubarraysReaderBase::ReadGenericSubarray(test_model::Image<yardl::FixedNDArray<int32_t, 3>>& value) {
+  if (unlikely(state_ != 16)) {
+    SubarraysReaderBaseInvalidState(16, state_);
+  }
+
+  ReadGenericSubarrayImpl(value);
+  state_ = 18;
+}
+
+void SubarraysReaderBase::Close() {
+  if (!skip_completed_check_ && unlikely(state_ != 18)) {
+    SubarraysReaderBaseInvalidState(18, state_);
+  }
+
+  CloseImpl();
+}
+void SubarraysReaderBase::CopyTo(SubarraysWriterBase& writer) {
+  {
+    yardl::DynamicNDArray<yardl::FixedNDArray<int32_t, 3>> value;
+    ReadDynamicWithFixedIntSubarray(value);
+    writer.WriteDynamicWithFixedIntSubarray(value);
+  }
+  {
+    yardl::DynamicNDArray<yardl::FixedNDArray<float, 3>> value;
+    ReadDynamicWithFixedFloatSubarray(value);
+    writer.WriteDynamicWithFixedFloatSubarray(value);
+  }
+  {
+    yardl::NDArray<yardl::FixedNDArray<int32_t, 3>, 1> value;
+    ReadKnownDimCountWithFixedIntSubarray(value);
+    writer.WriteKnownDimCountWithFixedIntSubarray(value);
+  }
+  {
+    yardl::NDArray<yardl::FixedNDArray<float, 3>, 1> value;
+    ReadKnownDimCountWithFixedFloatSubarray(value);
+    writer.WriteKnownDimCountWithFixedFloatSubarray(value);
+  }
+  {
+    yardl::FixedNDArray<yardl::FixedNDArray<int32_t, 3>, 2> value;
+    ReadFixedWithFixedIntSubarray(value);
+    writer.WriteFixedWithFixedIntSubarray(value);
+  }
+  {
+    yardl::FixedNDArray<yardl::FixedNDArray<float, 3>, 2> value;
+    ReadFixedWithFixedFloatSubarray(value);
+    writer.WriteFixedWithFixedFloatSubarray(value);
+  }
+  {
+    yardl::DynamicNDArray<yardl::FixedNDArray<yardl::FixedNDArray<int32_t, 3>, 2>> value;
+    ReadNestedSubarray(value);
+    writer.WriteNestedSubarray(value);
+  }
+  {
+    yardl::DynamicNDArray<std::array<int32_t, 3>> value;
+    ReadDynamicWithFixedVectorSubarray(value);
+    writer.WriteDynamicWithFixedVectorSubarray(value);
+  }
+  {
+    test_model::Image<yardl::FixedNDArray<int32_t, 3>> value;
+    ReadGenericSubarray(value);
+    writer.WriteGenericSubarray(value);
+  }
+}
+
+namespace {
+void SubarraysInRecordsWriterBaseInvalidState(uint8_t attempted, [[maybe_unused]] bool end, uint8_t current) {
+  std::string expected_method;
+  switch (current) {
+  case 0: expected_method = "WriteWithFixedSubarrays()"; break;
+  case 1: expected_method = "WriteWithVlenSubarrays()"; break;
+  }
+  std::string attempted_method;
+  switch (attempted) {
+  case 0: attempted_method = "WriteWithFixedSubarrays()"; break;
+  case 1: attempted_method = "WriteWithVlenSubarrays()"; break;
+  case 2: attempted_method = "Close()"; break;
+  }
+  throw std::runtime_error("Expected call to " + expected_method + " but received call to " + attempted_method + " instead.");
+}
+
+void SubarraysInRecordsReaderBaseInvalidState(uint8_t attempted, uint8_t current) {
+  auto f = [](uint8_t i) -> std::string {
+    switch (i/2) {
+    case 0: return "ReadWithFixedSubarrays()";
+    case 1: return "ReadWithVlenSubarrays()";
+    case 2: return "Close()";
+    default: return "<unknown>";
+    }
+  };
+  throw std::runtime_error("Expected call to " + f(current) + " but received call to " + f(attempted) + " instead.");
+}
+
+} // namespace 
+
+std::string SubarraysInRecordsWriterBase::schema_ = R"({"protocol":{"name":"SubarraysInRecords","sequence":[{"name":"withFixedSubarrays","type":{"array":{"items":"TestModel.RecordWithFixedCollections"}}},{"name":"withVlenSubarrays","type":{"array":{"items":"TestModel.RecordWithVlenCollections"}}}]},"types":[{"name":"RecordWithFixedCollections","fields":[{"name":"fixedVector","type":{"vector":{"items":"int32","length":3}}},{"name":"fixedArray","type":{"array":{"items":"int32","dimensions":[{"length":2},{"length":3}]}}}]},{"name":"RecordWithVlenCollections","fields":[{"name":"vector","type":{"vector":{"items":"int32"}}},{"name":"array","type":{"array":{"items":"int32","dimensions":2}}}]}]})";
+
+std::vector<std::string> SubarraysInRecordsWriterBase::previous_schemas_ = {
+};
+
+std::string SubarraysInRecordsWriterBase::SchemaFromVersion(Version version) {
+  switch (version) {
+  case Version::Current: return SubarraysInRecordsWriterBase::schema_; break;
+  default: throw std::runtime_error("The version does not correspond to any schema supported by protocol SubarraysInRecords.");
+  }
+
+}
+void SubarraysInRecordsWriterBase::WriteWithFixedSubarrays(yardl::DynamicNDArray<test_model::RecordWithFixedCollections> const& value) {
+  if (unlikely(state_ != 0)) {
+    SubarraysInRecordsWriterBaseInvalidState(0, false, state_);
+  }
+
+  WriteWithFixedSubarraysImpl(value);
+  state_ = 1;
+}
+
+void SubarraysInRecordsWriterBase::WriteWithVlenSubarrays(yardl::DynamicNDArray<test_model::RecordWithVlenCollections> const& value) {
+  if (unlikely(state_ != 1)) {
+    SubarraysInRecordsWriterBaseInvalidState(1, false, state_);
+  }
+
+  WriteWithVlenSubarraysImpl(value);
+  state_ = 2;
+}
+
+void SubarraysInRecordsWriterBase::Close() {
+  if (unlikely(state_ != 2)) {
+    SubarraysInRecordsWriterBaseInvalidState(2, false, state_);
+  }
+
+  CloseImpl();
+}
+
+std::string SubarraysInRecordsReaderBase::schema_ = SubarraysInRecordsWriterBase::schema_;
+
+std::vector<std::string> SubarraysInRecordsReaderBase::previous_schemas_ = SubarraysInRecordsWriterBase::previous_schemas_;
+
+Version SubarraysInRecordsReaderBase::VersionFromSchema(std::string const& schema) {
+  if (schema == SubarraysInRecordsWriterBase::schema_) {
+    return Version::Current;
+  }
+  throw std::runtime_error("The schema does not match any version supported by protocol SubarraysInRecords.");
+}
+void SubarraysInRecordsReaderBase::ReadWithFixedSubarrays(yardl::DynamicNDArray<test_model::RecordWithFixedCollections>& value) {
+  if (unlikely(state_ != 0)) {
+    SubarraysInRecordsReaderBaseInvalidState(0, state_);
+  }
+
+  ReadWithFixedSubarraysImpl(value);
+  state_ = 2;
+}
+
+void SubarraysInRecordsReaderBase::ReadWithVlenSubarrays(yardl::DynamicNDArray<test_model::RecordWithVlenCollections>& value) {
+  if (unlikely(state_ != 2)) {
+    SubarraysInRecordsReaderBaseInvalidState(2, state_);
+  }
+
+  ReadWithVlenSubarraysImpl(value);
+  state_ = 4;
+}
+
+void SubarraysInRecordsReaderBase::Close() {
+  if (!skip_completed_check_ && unlikely(state_ != 4)) {
+    SubarraysInRecordsReaderBaseInvalidState(4, state_);
+  }
+
+  CloseImpl();
+}
+void SubarraysInRecordsReaderBase::CopyTo(SubarraysInRecordsWriterBase& writer) {
+  {
+    yardl::DynamicNDArray<test_model::RecordWithFixedCollections> value;
+    ReadWithFixedSubarrays(value);
+    writer.WriteWithFixedSubarrays(value);
+  }
+  {
+    yardl::DynamicNDArray<test_model::RecordWithVlenCollections> value;
+    ReadWithVlenSubarrays(value);
+    writer.WriteWithVlenSubarrays(value);
+  }
+}
+
+namespace {
+void NDArraysWriterBaseInvalidState(uint8_t attempted, [[maybe_unused]] bool end, uint8_t current) {
+  std::string expected_method;
+  switch (current) {
+  case 0: expected_method = "WriteInts()"; break;
+  case 1: expected_method = "WriteSimpleRecordArray()"; break;
+  case 2: expected_method = "WriteRecordWithVlensArray()"; break;
+  case 3: expected_method = "WriteRecordWithNDArrays()"; break;
+  case 4: expected_method = "WriteNamedArray()"; break;
+  }
+  std::string attempted_method;
+  switch (attempted) {
+  case 0: attempted_method = "WriteInts()"; break;
+  case 1: attempted_method = "WriteSimpleRecordArray()"; break;
+  case 2: attempted_method = "WriteRecordWithVlensArray()"; break;
+  case 3: attempted_method = "WriteRecordWithNDArrays()"; break;
+  case 4: attempted_method = "WriteNamedArray()"; break;
+  case 5: attempted_method = "Close()"; break;
+  }
+  throw std::runtime_error("Expected call to " + expected_method + " but received call to " + attempted_method + " instead.");
+}
+
+void NDArraysReaderBaseInvalidState(uint8_t attempted, uint8_t current) {
+  auto f = [](uint8_t i) -> std::string {
+    switch (i/2) {
+    case 0: return "ReadInts()";
+    case 1: return "ReadSimpleRecordArray()";
+    case 2: return "ReadRecordWithVlensArray()";
+    case 3: return "ReadRecordWithNDArrays()";
+    case 4: return "ReadNamedArray()";
+    case 5: return "Close()";
+    default: return "<unknown>";
+    }
+  };
+  throw std::runtime_error("Expected call to " + f(current) + " but received call to " + f(attempted) + " instead.");
+}
+
+} // namespace 
+
+std::string NDArraysWriterBase::schema_ = R"({"protocol":{"name":"NDArrays","sequence":[{"name":"ints","type":{"array":{"items":"int32","dimensions":2}}},{"name":"simpleRecordArray","type":{"array":{"items":"TestModel.SimpleRecord","dimensions":2}}},{"name":"recordWithVlensArray","type":{"array":{"items":"TestModel.RecordWithVlens","dimensions":2}}},{"name":"recordWithNDArrays","type":"TestModel.RecordWithNDArrays"},{"name":"namedArray","type":"TestModel.NamedNDArray"}]},"types":[{"name":"NamedNDArray","type":{"array":{"items":"int32","dimensions":[{"name":"dimA"},{"name":"dimB"}]}}},{"name":"RecordWithNDArrays","fields":[{"name":"ints","type":{"array":{"items":"int32","dimensions":2}}},{"name":"fixedSimpleRecordArray","type":{"array":{"items":"TestModel.SimpleRecord","dimensions":2}}},{"name":"fixedRecordWithVlensArray","type":{"array":{"items":"TestModel.RecordWithVlens","dimensions":2}}}]},{"name":"RecordWithVlens","fields":[{"name":"a","type":{"vector":{"items":"TestModel.SimpleRecord"}}},{"name":"b","type":"int32"},{"name":"c","type":"int32"}]},{"name":"SimpleRecord","fields":[{"name":"x","type":"int32"},{"name":"y","type":"int32"},{"name":"z","type":"int32"}]}]})";
+
+std::vector<std::string> NDArraysWriterBase::previous_schemas_ = {
+};
+
+std::string NDArraysWriterBase::SchemaFromVersion(Version version) {
+  switch (version) {
+  case Version::Current: return NDArraysWriterBase::schema_; break;
+  default: throw std::runtime_error("The version does not correspond to any schema supported by protocol NDArrays.");
+  }
+
+}
+void NDArraysWriterBase::WriteInts(yardl::NDArray<int32_t, 2> const& value) {
+  if (unlikely(state_ != 0)) {
+    NDArraysWriterBaseInvalidState(0, false, state_);
+  }
+
+  WriteIntsImpl(value);
+  state_ = 1;
+}
+
+void NDArraysWriterBase::WriteSimpleRecordArray(yardl::NDArray<test_model::SimpleRecord, 2> const& value) {
+  if (unlikely(state_ != 1)) {
+    NDArraysWriterBaseInvalidState(1, false, state_);
+  }
+
+  WriteSimpleRecordArrayImpl(value);
+  state_ = 2;
+}
+
+void NDArraysWriterBase::WriteRecordWithVlensArray(yardl::NDArray<test_model::RecordWithVlens, 2> const& value) {
+  if (unlikely(state_ != 2)) {
+    NDArraysWriterBaseInvalidState(2, false, state_);
+  }
+
+  WriteRecordWithVlensArrayImpl(value);
+  state_ = 3;
+}
+
+void NDArraysWriterBase::WriteRecordWithNDArrays(test_model::RecordWithNDArrays const& value) {
+  if (unlikely(state_ != 3)) {
+    NDArraysWriterBaseInvalidState(3, false, state_);
+  }
+
+  WriteRecordWithNDArraysImpl(value);
+  state_ = 4;
+}
+
+void NDArraysWriterBase::WriteNamedArray(test_model::NamedNDArray const& value) {
+  if (unlikely(state_ != 4)) {
+    NDArraysWriterBaseInvalidState(4, false, state_);
+  }
+
+  WriteNamedArrayImpl(value);
+  state_ = 5;
+}
+
+void NDArraysWriterBase::Close() {
+  if (unlikely(state_ != 5)) {
+    NDArraysWriterBaseInvalidState(5, false, state_);
+  }
+
+  CloseImpl();
+}
+
+std::string NDArraysReaderBase::schema_ = NDArraysWriterBase::schema_;
+
+std::vector<std::string> NDArraysReaderBase::previous_schemas_ = NDArraysWriterBase::previous_schemas_;
+
+Version NDArraysReaderBase::VersionFromSchema(std::string const& schema) {
+  if (schema == NDArraysWriterBase::schema_) {
+    return Version::Current;
+  }
+  throw std::runtime_error("The schema does not match any version supported by protocol NDArrays.");
+}
+void NDArraysReaderBase::ReadInts(yardl::NDArray<int32_t, 2>& value) {
+  if (unlikely(state_ != 0)) {
+    NDArraysReaderBaseInvalidState(0, state_);
+  }
+
+  ReadIntsImpl(value);
+  state_ = 2;
+}
+
+void NDArraysReaderBase::ReadSimpleRecordArray(yardl::NDArray<test_model::SimpleRecord, 2>& value) {
+  if (unlikely(state_ != 2)) {
+    NDArraysReaderBaseInvalidState(2, state_);
+  }
+
+  ReadSimpleRecordArrayImpl(value);
+  state_ = 4;
+}
+
+void NDArraysReaderBase::ReadRecordWithVlensArray(yardl::NDArray<test_model::RecordWithVlens, 2>& value) {
+  if (unlikely(state_ != 4)) {
+    NDArraysReaderBaseInvalidState(4, state_);
+  }
+
+  ReadRecordWithVlensArrayImpl(value);
+  state_ = 6;
+}
+
+void NDArraysReaderBase::ReadRecordWithNDArrays(test_model::RecordWithNDArrays& value) {
+  if (unlikely(state_ != 6)) {
+    NDArraysReaderBaseInvalidState(6, state_);
+  }
+
+  ReadRecordWithNDArraysImpl(value);
+  state_ = 8;
+}
+
+void NDArraysReaderBase::ReadNamedArray(test_model::NamedNDArray& value) {
   if (unlikely(state_ != 8)) {
     NDArraysReaderBaseInvalidState(8, state_);
   }
@@ -4851,117 +6202,402 @@ void EnumsWriterBase::WriteRec(test_model::RecordWithEnums const& value) {
   if (unlikely(state_ != 3)) {
     EnumsWriterBaseInvalidState(3, false, state_);
   }
-
-  WriteRecImpl(value);
-  state_ = 4;
+
+  WriteRecImpl(value);
+  state_ = 4;
+}
+
+void EnumsWriterBase::Close() {
+  if (unlikely(state_ != 4)) {
+    EnumsWriterBaseInvalidState(4, false, state_);
+  }
+
+  CloseImpl();
+}
+
+std::string EnumsReaderBase::schema_ = EnumsWriterBase::schema_;
+
+std::vector<std::string> EnumsReaderBase::previous_schemas_ = EnumsWriterBase::previous_schemas_;
+
+Version EnumsReaderBase::VersionFromSchema(std::string const& schema) {
+  if (schema == EnumsWriterBase::schema_) {
+    return Version::Current;
+  }
+  throw std::runtime_error("The schema does not match any version supported by protocol Enums.");
+}
+void EnumsReaderBase::ReadSingle(test_model::Fruits& value) {
+  if (unlikely(state_ != 0)) {
+    EnumsReaderBaseInvalidState(0, state_);
+  }
+
+  ReadSingleImpl(value);
+  state_ = 2;
+}
+
+void EnumsReaderBase::ReadVec(std::vector<test_model::Fruits>& value) {
+  if (unlikely(state_ != 2)) {
+    EnumsReaderBaseInvalidState(2, state_);
+  }
+
+  ReadVecImpl(value);
+  state_ = 4;
+}
+
+void EnumsReaderBase::ReadSize(test_model::SizeBasedEnum& value) {
+  if (unlikely(state_ != 4)) {
+    EnumsReaderBaseInvalidState(4, state_);
+  }
+
+  ReadSizeImpl(value);
+  state_ = 6;
+}
+
+void EnumsReaderBase::ReadRec(test_model::RecordWithEnums& value) {
+  if (unlikely(state_ != 6)) {
+    EnumsReaderBaseInvalidState(6, state_);
+  }
+
+  ReadRecImpl(value);
+  state_ = 8;
+}
+
+void EnumsReaderBase::Close() {
+  if (!skip_completed_check_ && unlikely(state_ != 8)) {
+    EnumsReaderBaseInvalidState(8, state_);
+  }
+
+  CloseImpl();
+}
+void EnumsReaderBase::CopyTo(EnumsWriterBase& writer) {
+  {
+    test_model::Fruits value;
+    ReadSingle(value);
+    writer.WriteSingle(value);
+  }
+  {
+    std::vector<test_model::Fruits> value;
+    ReadVec(value);
+    writer.WriteVec(value);
+  }
+  {
+    test_model::SizeBasedEnum value;
+    ReadSize(value);
+    writer.WriteSize(value);
+  }
+  {
+    test_model::RecordWithEnums value;
+    ReadRec(value);
+    writer.WriteRec(value);
+  }
+}
+
+namespace {
+void FlagsWriterBaseInvalidState(uint8_t attempted, [[maybe_unused]] bool end, uint8_t current) {
+  std::string expected_method;
+  switch (current) {
+  case 0: expected_method = "WriteDays() or EndDays()"; break;
+  case 1: expected_method = "WriteFormats() or EndFormats()"; break;
+  }
+  std::string attempted_method;
+  switch (attempted) {
+  case 0: attempted_method = end ? "EndDays()" : "WriteDays()"; break;
+  case 1: attempted_method = end ? "EndFormats()" : "WriteFormats()"; break;
+  case 2: attempted_method = "Close()"; break;
+  }
+  throw std::runtime_error("Expected call to " + expected_method + " but received call to " + attempted_method + " instead.");
+}
+
+void FlagsReaderBaseInvalidState(uint8_t attempted, uint8_t current) {
+  auto f = [](uint8_t i) -> std::string {
+    switch (i/2) {
+    case 0: return "ReadDays()";
+    case 1: return "ReadFormats()";
+    case 2: return "Close()";
+    default: return "<unknown>";
+    }
+  };
+  throw std::runtime_error("Expected call to " + f(current) + " but received call to " + f(attempted) + " instead.");
+}
+
+} // namespace 
+
+std::string FlagsWriterBase::schema_ = R"({"protocol":{"name":"Flags","sequence":[{"name":"days","type":{"stream":{"items":"TestModel.DaysOfWeek"}}},{"name":"formats","type":{"stream":{"items":"TestModel.TextFormat"}}}]},"types":[{"name":"DaysOfWeek","values":[{"symbol":"monday","value":1},{"symbol":"tuesday","value":2},{"symbol":"wednesday","value":4},{"symbol":"thursday","value":8},{"symbol":"friday","value":16},{"symbol":"saturday","value":32},{"symbol":"sunday","value":64}]},{"name":"TextFormat","base":"uint64","values":[{"symbol":"regular","value":0},{"symbol":"bold","value":1},{"symbol":"italic","value":2},{"symbol":"underline","value":4},{"symbol":"strikethrough","value":8}]},{"name":"DaysOfWeek","type":"BasicTypes.DaysOfWeek"},{"name":"TextFormat","type":"BasicTypes.TextFormat"}]})";
+
+std::vector<std::string> FlagsWriterBase::previous_schemas_ = {
+};
+
+std::string FlagsWriterBase::SchemaFromVersion(Version version) {
+  switch (version) {
+  case Version::Current: return FlagsWriterBase::schema_; break;
+  default: throw std::runtime_error("The version does not correspond to any schema supported by protocol Flags.");
+  }
+
+}
+void FlagsWriterBase::WriteDays(test_model::DaysOfWeek const& value) {
+  if (unlikely(state_ != 0)) {
+    FlagsWriterBaseInvalidState(0, false, state_);
+  }
+
+  WriteDaysImpl(value);
+}
+
+void FlagsWriterBase::WriteDays(std::vector<test_model::DaysOfWeek> const& values) {
+  if (unlikely(state_ != 0)) {
+    FlagsWriterBaseInvalidState(0, false, state_);
+  }
+
+  WriteDaysImpl(values);
+}
+
+void FlagsWriterBase::EndDays() {
+  if (unlikely(state_ != 0)) {
+    FlagsWriterBaseInvalidState(0, true, state_);
+  }
+
+  EndDaysImpl();
+  state_ = 1;
+}
+
+// fallback implementation
+void FlagsWriterBase::WriteDaysImpl(std::vector<test_model::DaysOfWeek> const& values) {
+  for (auto const& v : values) {
+    WriteDaysImpl(v);
+  }
+}
+
+void FlagsWriterBase::WriteFormats(test_model::TextFormat const& value) {
+  if (unlikely(state_ != 1)) {
+    FlagsWriterBaseInvalidState(1, false, state_);
+  }
+
+  WriteFormatsImpl(value);
+}
+
+void FlagsWriterBase::WriteFormats(std::vector<test_model::TextFormat> const& values) {
+  if (unlikely(state_ != 1)) {
+    FlagsWriterBaseInvalidState(1, false, state_);
+  }
+
+  WriteFormatsImpl(values);
+}
+
+void FlagsWriterBase::EndFormats() {
+  if (unlikely(state_ != 1)) {
+    FlagsWriterBaseInvalidState(1, true, state_);
+  }
+
+  EndFormatsImpl();
+  state_ = 2;
+}
+
+// fallback implementation
+void FlagsWriterBase::WriteFormatsImpl(std::vector<test_model::TextFormat> const& values) {
+  for (auto const& v : values) {
+    WriteFormatsImpl(v);
+  }
 }
 
-void EnumsWriterBase::Close() {
-  if (unlikely(state_ != 4)) {
-    EnumsWriterBaseInvalidState(4, false, state_);
+void FlagsWriterBase::Close() {
+  if (unlikely(state_ != 2)) {
+    FlagsWriterBaseInvalidState(2, false, state_);
   }
 
   CloseImpl();
 }
 
-std::string EnumsReaderBase::schema_ = EnumsWriterBase::schema_;
+std::string FlagsReaderBase::schema_ = FlagsWriterBase::schema_;
 
-std::vector<std::string> EnumsReaderBase::previous_schemas_ = EnumsWriterBase::previous_schemas_;
+std::vector<std::string> FlagsReaderBase::previous_schemas_ = FlagsWriterBase::previous_schemas_;
 
-Version EnumsReaderBase::VersionFromSchema(std::string const& schema) {
-  if (schema == EnumsWriterBase::schema_) {
+Version FlagsReaderBase::VersionFromSchema(std::string const& schema) {
+  if (schema == FlagsWriterBase::schema_) {
     return Version::Current;
   }
-  throw std::runtime_error("The schema does not match any version supported by protocol Enums.");
+  throw std::runtime_error("The schema does not match any version supported by protocol Flags.");
 }
-void EnumsReaderBase::ReadSingle(test_model::Fruits& value) {
+bool FlagsReaderBase::ReadDays(test_model::DaysOfWeek& value) {
   if (unlikely(state_ != 0)) {
-    EnumsReaderBaseInvalidState(0, state_);
+    if (state_ == 1) {
+      state_ = 2;
+      return false;
+    }
+    FlagsReaderBaseInvalidState(0, state_);
   }
 
-  ReadSingleImpl(value);
-  state_ = 2;
+  bool result = ReadDaysImpl(value);
+  if (!result) {
+    state_ = 2;
+  }
+  return result;
 }
 
-void EnumsReaderBase::ReadVec(std::vector<test_model::Fruits>& value) {
-  if (unlikely(state_ != 2)) {
-    EnumsReaderBaseInvalidState(2, state_);
+bool FlagsReaderBase::ReadDays(std::vector<test_model::DaysOfWeek>& values) {
+  if (values.capacity() == 0) {
+    throw std::runtime_error("vector must have a nonzero capacity.");
+  }
+  if (unlikely(state_ != 0)) {
+    if (state_ == 1) {
+      state_ = 2;
+      values.clear();
+      return false;
+    }
+    FlagsReaderBaseInvalidState(0, state_);
   }
 
-  ReadVecImpl(value);
-  state_ = 4;
+  if (!ReadDaysImpl(values)) {
+    state_ = 1;
+    return values.size() > 0;
+  }
+  return true;
 }
 
-void EnumsReaderBase::ReadSize(test_model::SizeBasedEnum& value) {
-  if (unlikely(state_ != 4)) {
-    EnumsReaderBaseInvalidState(4, state_);
+// fallback implementation
+bool FlagsReaderBase::ReadDaysImpl(std::vector<test_model::DaysOfWeek>& values) {
+  size_t i = 0;
+  while (true) {
+    if (i == values.size()) {
+      values.resize(i + 1);
+    }
+    if (!ReadDaysImpl(values[i])) {
+      values.resize(i);
+      return false;
+    }
+    i++;
+    if (i == values.capacity()) {
+      return true;
+    }
   }
-
-  ReadSizeImpl(value);
-  state_ = 6;
 }
 
-void EnumsReaderBase::ReadRec(test_model::RecordWithEnums& value) {
-  if (unlikely(state_ != 6)) {
-    EnumsReaderBaseInvalidState(6, state_);
+bool FlagsReaderBase::ReadFormats(test_model::TextFormat& value) {
+  if (unlikely(state_ != 2)) {
+    if (state_ == 3) {
+      state_ = 4;
+      return false;
+    }
+    if (state_ == 1) {
+      state_ = 2;
+    } else {
+      FlagsReaderBaseInvalidState(2, state_);
+    }
   }
 
-  ReadRecImpl(value);
-  state_ = 8;
+  bool result = ReadFormatsImpl(value);
+  if (!result) {
+    state_ = 4;
+  }
+  return result;
 }
 
-void EnumsReaderBase::Close() {
-  if (!skip_completed_check_ && unlikely(state_ != 8)) {
-    EnumsReaderBaseInvalidState(8, state_);
+bool FlagsReaderBase::ReadFormats(std::vector<test_model::TextFormat>& values) {
+  if (values.capacity() == 0) {
+    throw std::runtime_error("vector must have a nonzero capacity.");
+  }
+  if (unlikely(state_ != 2)) {
+    if (state_ == 3) {
+      state_ = 4;
+      values.clear();
+      return false;
+    }
+    if (state_ == 1) {
+      state_ = 2;
+    } else {
+      FlagsReaderBaseInvalidState(2, state_);
+    }
   }
 
-  CloseImpl();
+  if (!ReadFormatsImpl(values)) {
+    state_ = 3;
+    return values.size() > 0;
+  }
+  return true;
 }
-void EnumsReaderBase::CopyTo(EnumsWriterBase& writer) {
-  {
-    test_model::Fruits value;
-    ReadSingle(value);
-    writer.WriteSingle(value);
+
+// fallback implementation
+bool FlagsReaderBase::ReadFormatsImpl(std::vector<test_model::TextFormat>& values) {
+  size_t i = 0;
+  while (true) {
+    if (i == values.size()) {
+      values.resize(i + 1);
+    }
+    if (!ReadFormatsImpl(values[i])) {
+      values.resize(i);
+      return false;
+    }
+    i++;
+    if (i == values.capacity()) {
+      return true;
+    }
   }
-  {
-    std::vector<test_model::Fruits> value;
-    ReadVec(value);
-    writer.WriteVec(value);
+}
+
+void FlagsReaderBase::Close() {
+  if (!skip_completed_check_ && unlikely(state_ != 4)) {
+    if (state_ == 3) {
+      state_ = 4;
+    } else {
+      FlagsReaderBaseInvalidState(4, state_);
+    }
   }
-  {
-    test_model::SizeBasedEnum value;
-    ReadSize(value);
-    writer.WriteSize(value);
+
+  CloseImpl();
+}
+void FlagsReaderBase::CopyTo(FlagsWriterBase& writer, size_t days_buffer_size, size_t formats_buffer_size) {
+  if (days_buffer_size > 1) {
+    std::vector<test_model::DaysOfWeek> values;
+    values.reserve(days_buffer_size);
+    while(ReadDays(values)) {
+      writer.WriteDays(values);
+    }
+    writer.EndDays();
+  } else {
+    test_model::DaysOfWeek value;
+    while(ReadDays(value)) {
+      writer.WriteDays(value);
+    }
+    writer.EndDays();
   }
-  {
-    test_model::RecordWithEnums value;
-    ReadRec(value);
-    writer.WriteRec(value);
+  if (formats_buffer_size > 1) {
+    std::vector<test_model::TextFormat> values;
+    values.reserve(formats_buffer_size);
+    while(ReadFormats(values)) {
+      writer.WriteFormats(values);
+    }
+    writer.EndFormats();
+  } else {
+    test_model::TextFormat value;
+    while(ReadFormats(value)) {
+      writer.WriteFormats(value);
+    }
+    writer.EndFormats();
   }
 }
 
 namespace {
-void FlagsWriterBaseInvalidState(uint8_t attempted, [[maybe_unused]] bool end, uint8_t current) {
+void StateTestWriterBaseInvalidState(uint8_t attempted, [[maybe_unused]] bool end, uint8_t current) {
   std::string expected_method;
   switch (current) {
-  case 0: expected_method = "WriteDays() or EndDays()"; break;
-  case 1: expected_method = "WriteFormats() or EndFormats()"; break;
+  case 0: expected_method = "WriteAnInt()"; break;
+  case 1: expected_method = "WriteAStream() or EndAStream()"; break;
+  case 2: expected_method = "WriteAnotherInt()"; break;
   }
   std::string attempted_method;
   switch (attempted) {
-  case 0: attempted_method = end ? "EndDays()" : "WriteDays()"; break;
-  case 1: attempted_method = end ? "EndFormats()" : "WriteFormats()"; break;
-  case 2: attempted_method = "Close()"; break;
+  case 0: attempted_method = "WriteAnInt()"; break;
+  case 1: attempted_method = end ? "EndAStream()" : "WriteAStream()"; break;
+  case 2: attempted_method = "WriteAnotherInt()"; break;
+  case 3: attempted_method = "Close()"; break;
   }
   throw std::runtime_error("Expected call to " + expected_method + " but received call to " + attempted_method + " instead.");
 }
 
-void FlagsReaderBaseInvalidState(uint8_t attempted, uint8_t current) {
+void StateTestReaderBaseInvalidState(uint8_t attempted, uint8_t current) {
   auto f = [](uint8_t i) -> std::string {
     switch (i/2) {
-    case 0: return "ReadDays()";
-    case 1: return "ReadFormats()";
-    case 2: return "Close()";
+    case 0: return "ReadAnInt()";
+    case 1: return "ReadAStream()";
+    case 2: return "ReadAnotherInt()";
+    case 3: return "Close()";
     default: return "<unknown>";
     }
   };
@@ -4970,175 +6606,112 @@ void FlagsReaderBaseInvalidState(uint8_t attempted, uint8_t current) {
 
 } // namespace 
 
-std::string FlagsWriterBase::schema_ = R"({"protocol":{"name":"Flags","sequence":[{"name":"days","type":{"stream":{"items":"TestModel.DaysOfWeek"}}},{"name":"formats","type":{"stream":{"items":"TestModel.TextFormat"}}}]},"types":[{"name":"DaysOfWeek","values":[{"symbol":"monday","value":1},{"symbol":"tuesday","value":2},{"symbol":"wednesday","value":4},{"symbol":"thursday","value":8},{"symbol":"friday","value":16},{"symbol":"saturday","value":32},{"symbol":"sunday","value":64}]},{"name":"TextFormat","base":"uint64","values":[{"symbol":"regular","value":0},{"symbol":"bold","value":1},{"symbol":"italic","value":2},{"symbol":"underline","value":4},{"symbol":"strikethrough","value":8}]},{"name":"DaysOfWeek","type":"BasicTypes.DaysOfWeek"},{"name":"TextFormat","type":"BasicTypes.TextFormat"}]})";
+std::string StateTestWriterBase::schema_ = R"({"protocol":{"name":"StateTest","sequence":[{"name":"anInt","type":"int32"},{"name":"aStream","type":{"stream":{"items":"int32"}}},{"name":"anotherInt","type":"int32"}]},"types":null})";
 
-std::vector<std::string> FlagsWriterBase::previous_schemas_ = {
+std::vector<std::string> StateTestWriterBase::previous_schemas_ = {
 };
 
-std::string FlagsWriterBase::SchemaFromVersion(Version version) {
+std::string StateTestWriterBase::SchemaFromVersion(Version version) {
   switch (version) {
-  case Version::Current: return FlagsWriterBase::schema_; break;
-  default: throw std::runtime_error("The version does not correspond to any schema supported by protocol Flags.");
-  }
-
-}
-void FlagsWriterBase::WriteDays(test_model::DaysOfWeek const& value) {
-  if (unlikely(state_ != 0)) {
-    FlagsWriterBaseInvalidState(0, false, state_);
-  }
-
-  WriteDaysImpl(value);
-}
-
-void FlagsWriterBase::WriteDays(std::vector<test_model::DaysOfWeek> const& values) {
-  if (unlikely(state_ != 0)) {
-    FlagsWriterBaseInvalidState(0, false, state_);
+  case Version::Current: return StateTestWriterBase::schema_; break;
+  default: throw std::runtime_error("The version does not correspond to any schema supported by protocol StateTest.");
   }
 
-  WriteDaysImpl(values);
 }
-
-void FlagsWriterBase::EndDays() {
+void StateTestWriterBase::WriteAnInt(int32_t const& value) {
   if (unlikely(state_ != 0)) {
-    FlagsWriterBaseInvalidState(0, true, state_);
+    StateTestWriterBaseInvalidState(0, false, state_);
   }
 
-  EndDaysImpl();
+  WriteAnIntImpl(value);
   state_ = 1;
 }
 
-// fallback implementation
-void FlagsWriterBase::WriteDaysImpl(std::vector<test_model::DaysOfWeek> const& values) {
-  for (auto const& v : values) {
-    WriteDaysImpl(v);
-  }
-}
-
-void FlagsWriterBase::WriteFormats(test_model::TextFormat const& value) {
+void StateTestWriterBase::WriteAStream(int32_t const& value) {
   if (unlikely(state_ != 1)) {
-    FlagsWriterBaseInvalidState(1, false, state_);
+    StateTestWriterBaseInvalidState(1, false, state_);
   }
 
-  WriteFormatsImpl(value);
+  WriteAStreamImpl(value);
 }
 
-void FlagsWriterBase::WriteFormats(std::vector<test_model::TextFormat> const& values) {
+void StateTestWriterBase::WriteAStream(std::vector<int32_t> const& values) {
   if (unlikely(state_ != 1)) {
-    FlagsWriterBaseInvalidState(1, false, state_);
+    StateTestWriterBaseInvalidState(1, false, state_);
   }
 
-  WriteFormatsImpl(values);
+  WriteAStreamImpl(values);
 }
 
-void FlagsWriterBase::EndFormats() {
+void StateTestWriterBase::EndAStream() {
   if (unlikely(state_ != 1)) {
-    FlagsWriterBaseInvalidState(1, true, state_);
+    StateTestWriterBaseInvalidState(1, true, state_);
   }
 
-  EndFormatsImpl();
+  EndAStreamImpl();
   state_ = 2;
 }
 
 // fallback implementation
-void FlagsWriterBase::WriteFormatsImpl(std::vector<test_model::TextFormat> const& values) {
+void StateTestWriterBase::WriteAStreamImpl(std::vector<int32_t> const& values) {
   for (auto const& v : values) {
-    WriteFormatsImpl(v);
+    WriteAStreamImpl(v);
   }
 }
 
-void FlagsWriterBase::Close() {
+void StateTestWriterBase::WriteAnotherInt(int32_t const& value) {
   if (unlikely(state_ != 2)) {
-    FlagsWriterBaseInvalidState(2, false, state_);
+    StateTestWriterBaseInvalidState(2, false, state_);
+  }
+
+  WriteAnotherIntImpl(value);
+  state_ = 3;
+}
+
+void StateTestWriterBase::Close() {
+  if (unlikely(state_ != 3)) {
+    StateTestWriterBaseInvalidState(3, false, state_);
   }
 
   CloseImpl();
 }
 
-std::string FlagsReaderBase::schema_ = FlagsWriterBase::schema_;
+std::string StateTestReaderBase::schema_ = StateTestWriterBase::schema_;
 
-std::vector<std::string> FlagsReaderBase::previous_schemas_ = FlagsWriterBase::previous_schemas_;
+std::vector<std::string> StateTestReaderBase::previous_schemas_ = StateTestWriterBase::previous_schemas_;
 
-Version FlagsReaderBase::VersionFromSchema(std::string const& schema) {
-  if (schema == FlagsWriterBase::schema_) {
+Version StateTestReaderBase::VersionFromSchema(std::string const& schema) {
+  if (schema == StateTestWriterBase::schema_) {
     return Version::Current;
   }
-  throw std::runtime_error("The schema does not match any version supported by protocol Flags.");
-}
-bool FlagsReaderBase::ReadDays(test_model::DaysOfWeek& value) {
-  if (unlikely(state_ != 0)) {
-    if (state_ == 1) {
-      state_ = 2;
-      return false;
-    }
-    FlagsReaderBaseInvalidState(0, state_);
-  }
-
-  bool result = ReadDaysImpl(value);
-  if (!result) {
-    state_ = 2;
-  }
-  return result;
+  throw std::runtime_error("The schema does not match any version supported by protocol StateTest.");
 }
-
-bool FlagsReaderBase::ReadDays(std::vector<test_model::DaysOfWeek>& values) {
-  if (values.capacity() == 0) {
-    throw std::runtime_error("vector must have a nonzero capacity.");
-  }
+void StateTestReaderBase::ReadAnInt(int32_t& value) {
   if (unlikely(state_ != 0)) {
-    if (state_ == 1) {
-      state_ = 2;
-      values.clear();
-      return false;
-    }
-    FlagsReaderBaseInvalidState(0, state_);
-  }
-
-  if (!ReadDaysImpl(values)) {
-    state_ = 1;
-    return values.size() > 0;
+    StateTestReaderBaseInvalidState(0, state_);
   }
-  return true;
-}
 
-// fallback implementation
-bool FlagsReaderBase::ReadDaysImpl(std::vector<test_model::DaysOfWeek>& values) {
-  size_t i = 0;
-  while (true) {
-    if (i == values.size()) {
-      values.resize(i + 1);
-    }
-    if (!ReadDaysImpl(values[i])) {
-      values.resize(i);
-      return false;
-    }
-    i++;
-    if (i == values.capacity()) {
-      return true;
-    }
-  }
+  ReadAnIntImpl(value);
+  state_ = 2;
 }
 
-bool FlagsReaderBase::ReadFormats(test_model::TextFormat& value) {
+bool StateTestReaderBase::ReadAStream(int32_t& value) {
   if (unlikely(state_ != 2)) {
     if (state_ == 3) {
       state_ = 4;
       return false;
     }
-    if (state_ == 1) {
-      state_ = 2;
-    } else {
-      FlagsReaderBaseInvalidState(2, state_);
-    }
+    StateTestReaderBaseInvalidState(2, state_);
   }
 
-  bool result = ReadFormatsImpl(value);
+  bool result = ReadAStreamImpl(value);
   if (!result) {
     state_ = 4;
   }
   return result;
 }
 
-bool FlagsReaderBase::ReadFormats(std::vector<test_model::TextFormat>& values) {
+bool StateTestReaderBase::ReadAStream(std::vector<int32_t>& values) {
   if (values.capacity() == 0) {
     throw std::runtime_error("vector must have a nonzero capacity.");
   }
@@ -5148,14 +6721,10 @@ bool FlagsReaderBase::ReadFormats(std::vector<test_model::TextFormat>& values) {
       values.clear();
       return false;
     }
-    if (state_ == 1) {
-      state_ = 2;
-    } else {
-      FlagsReaderBaseInvalidState(2, state_);
-    }
+    StateTestReaderBaseInvalidState(2, state_);
   }
 
-  if (!ReadFormatsImpl(values)) {
+  if (!ReadAStreamImpl(values)) {
     state_ = 3;
     return values.size() > 0;
   }
@@ -5163,13 +6732,13 @@ bool FlagsReaderBase::ReadFormats(std::vector<test_model::TextFormat>& values) {
 }
 
 // fallback implementation
-bool FlagsReaderBase::ReadFormatsImpl(std::vector<test_model::TextFormat>& values) {
+bool StateTestReaderBase::ReadAStreamImpl(std::vector<int32_t>& values) {
   size_t i = 0;
   while (true) {
     if (i == values.size()) {
       values.resize(i + 1);
     }
-    if (!ReadFormatsImpl(values[i])) {
+    if (!ReadAStreamImpl(values[i])) {
       values.resize(i);
       return false;
     }
@@ -5180,214 +6749,354 @@ bool FlagsReaderBase::ReadFormatsImpl(std::vector<test_model::TextFormat>& value
   }
 }
 
-void FlagsReaderBase::Close() {
-  if (!skip_completed_check_ && unlikely(state_ != 4)) {
+void StateTestReaderBase::ReadAnotherInt(int32_t& value) {
+  if (unlikely(state_ != 4)) {
     if (state_ == 3) {
       state_ = 4;
     } else {
-      FlagsReaderBaseInvalidState(4, state_);
+      StateTestReaderBaseInvalidState(4, state_);
     }
   }
 
+  ReadAnotherIntImpl(value);
+  state_ = 6;
+}
+
+void StateTestReaderBase::Close() {
+  if (!skip_completed_check_ && unlikely(state_ != 6)) {
+    StateTestReaderBaseInvalidState(6, state_);
+  }
+
   CloseImpl();
 }
-void FlagsReaderBase::CopyTo(FlagsWriterBase& writer, size_t days_buffer_size, size_t formats_buffer_size) {
-  if (days_buffer_size > 1) {
-    std::vector<test_model::DaysOfWeek> values;
-    values.reserve(days_buffer_size);
-    while(ReadDays(values)) {
-      writer.WriteDays(values);
-    }
-    writer.EndDays();
-  } else {
-    test_model::DaysOfWeek value;
-    while(ReadDays(value)) {
-      writer.WriteDays(value);
-    }
-    writer.EndDays();
+void StateTestReaderBase::CopyTo(StateTestWriterBase& writer, size_t a_stream_buffer_size) {
+  {
+    int32_t value;
+    ReadAnInt(value);
+    writer.WriteAnInt(value);
   }
-  if (formats_buffer_size > 1) {
-    std::vector<test_model::TextFormat> values;
-    values.reserve(formats_buffer_size);
-    while(ReadFormats(values)) {
-      writer.WriteFormats(values);
+  if (a_stream_buffer_size > 1) {
+    std::vector<int32_t> values;
+    values.reserve(a_stream_buffer_size);
+    while(ReadAStream(values)) {
+      writer.WriteAStream(values);
     }
-    writer.EndFormats();
+    writer.EndAStream();
   } else {
-    test_model::TextFormat value;
-    while(ReadFormats(value)) {
-      writer.WriteFormats(value);
+    int32_t value;
+    while(ReadAStream(value)) {
+      writer.WriteAStream(value);
     }
-    writer.EndFormats();
+    writer.EndAStream();
+  }
+  {
+    int32_t value;
+    ReadAnotherInt(value);
+    writer.WriteAnotherInt(value);
   }
 }
 
 namespace {
-void StateTestWriterBaseInvalidState(uint8_t attempted, [[maybe_unused]] bool end, uint8_t current) {
+void SimpleGenericsWriterBaseInvalidState(uint8_t attempted, [[maybe_unused]] bool end, uint8_t current) {
   std::string expected_method;
   switch (current) {
-  case 0: expected_method = "WriteAnInt()"; break;
-  case 1: expected_method = "WriteAStream() or EndAStream()"; break;
-  case 2: expected_method = "WriteAnotherInt()"; break;
+  case 0: expected_method = "WriteFloatImage()"; break;
+  case 1: expected_method = "WriteIntImage()"; break;
+  case 2: expected_method = "WriteIntImageAlternateSyntax()"; break;
+  case 3: expected_method = "WriteStringImage()"; break;
+  case 4: expected_method = "WriteIntFloatTuple()"; break;
+  case 5: expected_method = "WriteFloatFloatTuple()"; break;
+  case 6: expected_method = "WriteIntFloatTupleAlternateSyntax()"; break;
+  case 7: expected_method = "WriteIntStringTuple()"; break;
+  case 8: expected_method = "WriteStreamOfTypeVariants() or EndStreamOfTypeVariants()"; break;
   }
   std::string attempted_method;
   switch (attempted) {
-  case 0: attempted_method = "WriteAnInt()"; break;
-  case 1: attempted_method = end ? "EndAStream()" : "WriteAStream()"; break;
-  case 2: attempted_method = "WriteAnotherInt()"; break;
-  case 3: attempted_method = "Close()"; break;
+  case 0: attempted_method = "WriteFloatImage()"; break;
+  case 1: attempted_method = "WriteIntImage()"; break;
+  case 2: attempted_method = "WriteIntImageAlternateSyntax()"; break;
+  case 3: attempted_method = "WriteStringImage()"; break;
+  case 4: attempted_method = "WriteIntFloatTuple()"; break;
+  case 5: attempted_method = "WriteFloatFloatTuple()"; break;
+  case 6: attempted_method = "WriteIntFloatTupleAlternateSyntax()"; break;
+  case 7: attempted_method = "WriteIntStringTuple()"; break;
+  case 8: attempted_method = end ? "EndStreamOfTypeVariants()" : "WriteStreamOfTypeVariants()"; break;
+  case 9: attempted_method = "Close()"; break;
+  }
+  throw std::runtime_error("Expected call to " + expected_method + " but received call to " + attempted_method + " instead.");
+}
+
+void SimpleGenericsReaderBaseInvalidState(uint8_t attempted, uint8_t current) {
+  auto f = [](uint8_t i) -> std::string {
+    switch (i/2) {
+    case 0: return "ReadFloatImage()";
+    case 1: return "ReadIntImage()";
+    case 2: return "ReadIntImageAlternateSyntax()";
+    case 3: return "ReadStringImage()";
+    case 4: return "ReadIntFloatTuple()";
+    case 5: return "ReadFloatFloatTuple()";
+    case 6: return "ReadIntFloatTupleAlternateSyntax()";
+    case 7: return "ReadIntStringTuple()";
+    case 8: return "ReadStreamOfTypeVariants()";
+    case 9: return "Close()";
+    default: return "<unknown>";
+    }
+  };
+  throw std::runtime_error("Expected call to " + f(current) + " but received call to " + f(attempted) + " instead.");
+}
+
+} // namespace 
+
+std::string SimpleGenericsWriterBase::schema_ = R"({"protocol":{"name":"SimpleGenerics","sequence":[{"name":"floatImage","type":"Image.FloatImage"},{"name":"intImage","type":"Image.IntImage"},{"name":"intImageAlternateSyntax","type":{"name":"TestModel.Image","typeArguments":["int32"]}},{"name":"stringImage","type":{"name":"TestModel.Image","typeArguments":["string"]}},{"name":"intFloatTuple","type":{"name":"Tuples.Tuple","typeArguments":["int32","float32"]}},{"name":"floatFloatTuple","type":{"name":"Tuples.Tuple","typeArguments":["float32","float32"]}},{"name":"intFloatTupleAlternateSyntax","type":{"name":"Tuples.Tuple","typeArguments":["int32","float32"]}},{"name":"intStringTuple","type":{"name":"Tuples.Tuple","typeArguments":["int32","string"]}},{"name":"streamOfTypeVariants","type":{"stream":{"items":[{"tag":"imageFloat","explicitTag":true,"type":"Image.FloatImage"},{"tag":"imageDouble","explicitTag":true,"type":{"name":"TestModel.Image","typeArguments":["float64"]}}]}}}]},"types":[{"name":"FloatImage","type":{"name":"Image.Image","typeArguments":["float32"]}},{"name":"Image","typeParameters":["T"],"type":{"array":{"items":"T","dimensions":[{"name":"x"},{"name":"y"}]}}},{"name":"IntImage","type":{"name":"Image.Image","typeArguments":["int32"]}},{"name":"Image","typeParameters":["T"],"type":{"name":"Image.Image","typeArguments":["T"]}},{"name":"Tuple","typeParameters":["T1","T2"],"fields":[{"name":"v1","type":"T1"},{"name":"v2","type":"T2"}]}]})";
+
+std::vector<std::string> SimpleGenericsWriterBase::previous_schemas_ = {
+};
+
+std::string SimpleGenericsWriterBase::SchemaFromVersion(Version version) {
+  switch (version) {
+  case Version::Current: return SimpleGenericsWriterBase::schema_; break;
+  default: throw std::runtime_error("The version does not correspond to any schema supported by protocol SimpleGenerics.");
+  }
+
+}
+void SimpleGenericsWriterBase::WriteFloatImage(image::FloatImage const& value) {
+  if (unlikely(state_ != 0)) {
+    SimpleGenericsWriterBaseInvalidState(0, false, state_);
+  }
+
+  WriteFloatImageImpl(value);
+  state_ = 1;
+}
+
+void SimpleGenericsWriterBase::WriteIntImage(image::IntImage const& value) {
+  if (unlikely(state_ != 1)) {
+    SimpleGenericsWriterBaseInvalidState(1, false, state_);
   }
-  throw std::runtime_error("Expected call to " + expected_method + " but received call to " + attempted_method + " instead.");
-}
 
-void StateTestReaderBaseInvalidState(uint8_t attempted, uint8_t current) {
-  auto f = [](uint8_t i) -> std::string {
-    switch (i/2) {
-    case 0: return "ReadAnInt()";
-    case 1: return "ReadAStream()";
-    case 2: return "ReadAnotherInt()";
-    case 3: return "Close()";
-    default: return "<unknown>";
-    }
-  };
-  throw std::runtime_error("Expected call to " + f(current) + " but received call to " + f(attempted) + " instead.");
+  WriteIntImageImpl(value);
+  state_ = 2;
 }
 
-} // namespace 
+void SimpleGenericsWriterBase::WriteIntImageAlternateSyntax(test_model::Image<int32_t> const& value) {
+  if (unlikely(state_ != 2)) {
+    SimpleGenericsWriterBaseInvalidState(2, false, state_);
+  }
 
-std::string StateTestWriterBase::schema_ = R"({"protocol":{"name":"StateTest","sequence":[{"name":"anInt","type":"int32"},{"name":"aStream","type":{"stream":{"items":"int32"}}},{"name":"anotherInt","type":"int32"}]},"types":null})";
+  WriteIntImageAlternateSyntaxImpl(value);
+  state_ = 3;
+}
 
-std::vector<std::string> StateTestWriterBase::previous_schemas_ = {
-};
+void SimpleGenericsWriterBase::WriteStringImage(test_model::Image<std::string> const& value) {
+  if (unlikely(state_ != 3)) {
+    SimpleGenericsWriterBaseInvalidState(3, false, state_);
+  }
 
-std::string StateTestWriterBase::SchemaFromVersion(Version version) {
-  switch (version) {
-  case Version::Current: return StateTestWriterBase::schema_; break;
-  default: throw std::runtime_error("The version does not correspond to any schema supported by protocol StateTest.");
+  WriteStringImageImpl(value);
+  state_ = 4;
+}
+
+void SimpleGenericsWriterBase::WriteIntFloatTuple(tuples::Tuple<int32_t, float> const& value) {
+  if (unlikely(state_ != 4)) {
+    SimpleGenericsWriterBaseInvalidState(4, false, state_);
   }
 
+  WriteIntFloatTupleImpl(value);
+  state_ = 5;
 }
-void StateTestWriterBase::WriteAnInt(int32_t const& value) {
-  if (unlikely(state_ != 0)) {
-    StateTestWriterBaseInvalidState(0, false, state_);
+
+void SimpleGenericsWriterBase::WriteFloatFloatTuple(tuples::Tuple<float, float> const& value) {
+  if (unlikely(state_ != 5)) {
+    SimpleGenericsWriterBaseInvalidState(5, false, state_);
   }
 
-  WriteAnIntImpl(value);
-  state_ = 1;
+  WriteFloatFloatTupleImpl(value);
+  state_ = 6;
 }
 
-void StateTestWriterBase::WriteAStream(int32_t const& value) {
-  if (unlikely(state_ != 1)) {
-    StateTestWriterBaseInvalidState(1, false, state_);
+void SimpleGenericsWriterBase::WriteIntFloatTupleAlternateSyntax(tuples::Tuple<int32_t, float> const& value) {
+  if (unlikely(state_ != 6)) {
+    SimpleGenericsWriterBaseInvalidState(6, false, state_);
   }
 
-  WriteAStreamImpl(value);
+  WriteIntFloatTupleAlternateSyntaxImpl(value);
+  state_ = 7;
 }
 
-void StateTestWriterBase::WriteAStream(std::vector<int32_t> const& values) {
-  if (unlikely(state_ != 1)) {
-    StateTestWriterBaseInvalidState(1, false, state_);
+void SimpleGenericsWriterBase::WriteIntStringTuple(tuples::Tuple<int32_t, std::string> const& value) {
+  if (unlikely(state_ != 7)) {
+    SimpleGenericsWriterBaseInvalidState(7, false, state_);
   }
 
-  WriteAStreamImpl(values);
+  WriteIntStringTupleImpl(value);
+  state_ = 8;
 }
 
-void StateTestWriterBase::EndAStream() {
-  if (unlikely(state_ != 1)) {
-    StateTestWriterBaseInvalidState(1, true, state_);
+void SimpleGenericsWriterBase::WriteStreamOfTypeVariants(std::variant<image::FloatImage, test_model::Image<double>> const& value) {
+  if (unlikely(state_ != 8)) {
+    SimpleGenericsWriterBaseInvalidState(8, false, state_);
   }
 
-  EndAStreamImpl();
-  state_ = 2;
+  WriteStreamOfTypeVariantsImpl(value);
 }
 
-// fallback implementation
-void StateTestWriterBase::WriteAStreamImpl(std::vector<int32_t> const& values) {
-  for (auto const& v : values) {
-    WriteAStreamImpl(v);
+void SimpleGenericsWriterBase::WriteStreamOfTypeVariants(std::vector<std::variant<image::FloatImage, test_model::Image<double>>> const& values) {
+  if (unlikely(state_ != 8)) {
+    SimpleGenericsWriterBaseInvalidState(8, false, state_);
   }
+
+  WriteStreamOfTypeVariantsImpl(values);
 }
 
-void StateTestWriterBase::WriteAnotherInt(int32_t const& value) {
-  if (unlikely(state_ != 2)) {
-    StateTestWriterBaseInvalidState(2, false, state_);
+void SimpleGenericsWriterBase::EndStreamOfTypeVariants() {
+  if (unlikely(state_ != 8)) {
+    SimpleGenericsWriterBaseInvalidState(8, true, state_);
   }
 
-  WriteAnotherIntImpl(value);
-  state_ = 3;
+  EndStreamOfTypeVariantsImpl();
+  state_ = 9;
 }
 
-void StateTestWriterBase::Close() {
-  if (unlikely(state_ != 3)) {
-    StateTestWriterBaseInvalidState(3, false, state_);
+// fallback implementation
+void SimpleGenericsWriterBase::WriteStreamOfTypeVariantsImpl(std::vector<std::variant<image::FloatImage, test_model::Image<double>>> const& values) {
+  for (auto const& v : values) {
+    WriteStreamOfTypeVariantsImpl(v);
+  }
+}
+
+void SimpleGenericsWriterBase::Close() {
+  if (unlikely(state_ != 9)) {
+    SimpleGenericsWriterBaseInvalidState(9, false, state_);
   }
 
   CloseImpl();
 }
 
-std::string StateTestReaderBase::schema_ = StateTestWriterBase::schema_;
+std::string SimpleGenericsReaderBase::schema_ = SimpleGenericsWriterBase::schema_;
 
-std::vector<std::string> StateTestReaderBase::previous_schemas_ = StateTestWriterBase::previous_schemas_;
+std::vector<std::string> SimpleGenericsReaderBase::previous_schemas_ = SimpleGenericsWriterBase::previous_schemas_;
 
-Version StateTestReaderBase::VersionFromSchema(std::string const& schema) {
-  if (schema == StateTestWriterBase::schema_) {
+Version SimpleGenericsReaderBase::VersionFromSchema(std::string const& schema) {
+  if (schema == SimpleGenericsWriterBase::schema_) {
     return Version::Current;
   }
-  throw std::runtime_error("The schema does not match any version supported by protocol StateTest.");
+  throw std::runtime_error("The schema does not match any version supported by protocol SimpleGenerics.");
 }
-void StateTestReaderBase::ReadAnInt(int32_t& value) {
+void SimpleGenericsReaderBase::ReadFloatImage(image::FloatImage& value) {
   if (unlikely(state_ != 0)) {
-    StateTestReaderBaseInvalidState(0, state_);
+    SimpleGenericsReaderBaseInvalidState(0, state_);
   }
 
-  ReadAnIntImpl(value);
+  ReadFloatImageImpl(value);
   state_ = 2;
 }
 
-bool StateTestReaderBase::ReadAStream(int32_t& value) {
+void SimpleGenericsReaderBase::ReadIntImage(image::IntImage& value) {
   if (unlikely(state_ != 2)) {
-    if (state_ == 3) {
-      state_ = 4;
+    SimpleGenericsReaderBaseInvalidState(2, state_);
+  }
+
+  ReadIntImageImpl(value);
+  state_ = 4;
+}
+
+void SimpleGenericsReaderBase::ReadIntImageAlternateSyntax(test_model::Image<int32_t>& value) {
+  if (unlikely(state_ != 4)) {
+    SimpleGenericsReaderBaseInvalidState(4, state_);
+  }
+
+  ReadIntImageAlternateSyntaxImpl(value);
+  state_ = 6;
+}
+
+void SimpleGenericsReaderBase::ReadStringImage(test_model::Image<std::string>& value) {
+  if (unlikely(state_ != 6)) {
+    SimpleGenericsReaderBaseInvalidState(6, state_);
+  }
+
+  ReadStringImageImpl(value);
+  state_ = 8;
+}
+
+void SimpleGenericsReaderBase::ReadIntFloatTuple(tuples::Tuple<int32_t, float>& value) {
+  if (unlikely(state_ != 8)) {
+    SimpleGenericsReaderBaseInvalidState(8, state_);
+  }
+
+  ReadIntFloatTupleImpl(value);
+  state_ = 10;
+}
+
+void SimpleGenericsReaderBase::ReadFloatFloatTuple(tuples::Tuple<float, float>& value) {
+  if (unlikely(state_ != 10)) {
+    SimpleGenericsReaderBaseInvalidState(10, state_);
+  }
+
+  ReadFloatFloatTupleImpl(value);
+  state_ = 12;
+}
+
+void SimpleGenericsReaderBase::ReadIntFloatTupleAlternateSyntax(tuples::Tuple<int32_t, float>& value) {
+  if (unlikely(state_ != 12)) {
+    SimpleGenericsReaderBaseInvalidState(12, state_);
+  }
+
+  ReadIntFloatTupleAlternateSyntaxImpl(value);
+  state_ = 14;
+}
+
+void SimpleGenericsReaderBase::ReadIntStringTuple(tuples::Tuple<int32_t, std::string>& value) {
+  if (unlikely(state_ != 14)) {
+    SimpleGenericsReaderBaseInvalidState(14, state_);
+  }
+
+  ReadIntStringTupleImpl(value);
+  state_ = 16;
+}
+
+bool SimpleGenericsReaderBase::ReadStreamOfTypeVariants(std::variant<image::FloatImage, test_model::Image<double>>& value) {
+  if (unlikely(state_ != 16)) {
+    if (state_ == 17) {
+      state_ = 18;
       return false;
     }
-    StateTestReaderBaseInvalidState(2, state_);
+    SimpleGenericsReaderBaseInvalidState(16, state_);
   }
 
-  bool result = ReadAStreamImpl(value);
+  bool result = ReadStreamOfTypeVariantsImpl(value);
   if (!result) {
-    state_ = 4;
+    state_ = 18;
   }
   return result;
 }
 
-bool StateTestReaderBase::ReadAStream(std::vector<int32_t>& values) {
+bool SimpleGenericsReaderBase::ReadStreamOfTypeVariants(std::vector<std::variant<image::FloatImage, test_model::Image<double>>>& values) {
   if (values.capacity() == 0) {
     throw std::runtime_error("vector must have a nonzero capacity.");
   }
-  if (unlikely(state_ != 2)) {
-    if (state_ == 3) {
-      state_ = 4;
+  if (unlikely(state_ != 16)) {
+    if (state_ == 17) {
+      state_ = 18;
       values.clear();
       return false;
     }
-    StateTestReaderBaseInvalidState(2, state_);
+    SimpleGenericsReaderBaseInvalidState(16, state_);
   }
 
-  if (!ReadAStreamImpl(values)) {
-    state_ = 3;
+  if (!ReadStreamOfTypeVariantsImpl(values)) {
+    state_ = 17;
     return values.size() > 0;
   }
   return true;
 }
 
 // fallback implementation
-bool StateTestReaderBase::ReadAStreamImpl(std::vector<int32_t>& values) {
+bool SimpleGenericsReaderBase::ReadStreamOfTypeVariantsImpl(std::vector<std::variant<image::FloatImage, test_model::Image<double>>>& values) {
   size_t i = 0;
   while (true) {
     if (i == values.size()) {
       values.resize(i + 1);
     }
-    if (!ReadAStreamImpl(values[i])) {
+    if (!ReadStreamOfTypeVariantsImpl(values[i])) {
       values.resize(i);
       return false;
     }
@@ -5398,96 +7107,105 @@ bool StateTestReaderBase::ReadAStreamImpl(std::vector<int32_t>& values) {
   }
 }
 
-void StateTestReaderBase::ReadAnotherInt(int32_t& value) {
-  if (unlikely(state_ != 4)) {
-    if (state_ == 3) {
-      state_ = 4;
+void SimpleGenericsReaderBase::Close() {
+  if (!skip_completed_check_ && unlikely(state_ != 18)) {
+    if (state_ == 17) {
+      state_ = 18;
     } else {
-      StateTestReaderBaseInvalidState(4, state_);
+      SimpleGenericsReaderBaseInvalidState(18, state_);
     }
   }
-
-  ReadAnotherIntImpl(value);
-  state_ = 6;
-}
-
-void StateTestReaderBase::Close() {
-  if (!skip_completed_check_ && unlikely(state_ != 6)) {
-    StateTestReaderBaseInvalidState(6, state_);
+
+  CloseImpl();
+}
+void SimpleGenericsReaderBase::CopyTo(SimpleGenericsWriterBase& writer, size_t stream_of_type_variants_buffer_size) {
+  {
+    image::FloatImage value;
+    ReadFloatImage(value);
+    writer.WriteFloatImage(value);
+  }
+  {
+    image::IntImage value;
+    ReadIntImage(value);
+    writer.WriteIntImage(value);
+  }
+  {
+    test_model::Image<int32_t> value;
+    ReadIntImageAlternateSyntax(value);
+    writer.WriteIntImageAlternateSyntax(value);
+  }
+  {
+    test_model::Image<std::string> value;
+    ReadStringImage(value);
+    writer.WriteStringImage(value);
+  }
+  {
+    tuples::Tuple<int32_t, float> value;
+    ReadIntFloatTuple(value);
+    writer.WriteIntFloatTuple(value);
   }
-
-  CloseImpl();
-}
-void StateTestReaderBase::CopyTo(StateTestWriterBase& writer, size_t a_stream_buffer_size) {
   {
-    int32_t value;
-    ReadAnInt(value);
-    writer.WriteAnInt(value);
+    tuples::Tuple<float, float> value;
+    ReadFloatFloatTuple(value);
+    writer.WriteFloatFloatTuple(value);
   }
-  if (a_stream_buffer_size > 1) {
-    std::vector<int32_t> values;
-    values.reserve(a_stream_buffer_size);
-    while(ReadAStream(values)) {
-      writer.WriteAStream(values);
+  {
+    tuples::Tuple<int32_t, float> value;
+    ReadIntFloatTupleAlternateSyntax(value);
+    writer.WriteIntFloatTupleAlternateSyntax(value);
+  }
+  {
+    tuples::Tuple<int32_t, std::string> value;
+    ReadIntStringTuple(value);
+    writer.WriteIntStringTuple(value);
+  }
+  if (stream_of_type_variants_buffer_size > 1) {
+    std::vector<std::variant<image::FloatImage, test_model::Image<double>>> values;
+    values.reserve(stream_of_type_variants_buffer_size);
+    while(ReadStreamOfTypeVariants(values)) {
+      writer.WriteStreamOfTypeVariants(values);
     }
-    writer.EndAStream();
+    writer.EndStreamOfTypeVariants();
   } else {
-    int32_t value;
-    while(ReadAStream(value)) {
-      writer.WriteAStream(value);
+    std::variant<image::FloatImage, test_model::Image<double>> value;
+    while(ReadStreamOfTypeVariants(value)) {
+      writer.WriteStreamOfTypeVariants(value);
     }
-    writer.EndAStream();
-  }
-  {
-    int32_t value;
-    ReadAnotherInt(value);
-    writer.WriteAnotherInt(value);
+    writer.EndStreamOfTypeVariants();
   }
 }
 
 namespace {
-void SimpleGenericsWriterBaseInvalidState(uint8_t attempted, [[maybe_unused]] bool end, uint8_t current) {
+void AdvancedGenericsWriterBaseInvalidState(uint8_t attempted, [[maybe_unused]] bool end, uint8_t current) {
   std::string expected_method;
   switch (current) {
-  case 0: expected_method = "WriteFloatImage()"; break;
-  case 1: expected_method = "WriteIntImage()"; break;
-  case 2: expected_method = "WriteIntImageAlternateSyntax()"; break;
-  case 3: expected_method = "WriteStringImage()"; break;
-  case 4: expected_method = "WriteIntFloatTuple()"; break;
-  case 5: expected_method = "WriteFloatFloatTuple()"; break;
-  case 6: expected_method = "WriteIntFloatTupleAlternateSyntax()"; break;
-  case 7: expected_method = "WriteIntStringTuple()"; break;
-  case 8: expected_method = "WriteStreamOfTypeVariants() or EndStreamOfTypeVariants()"; break;
+  case 0: expected_method = "WriteFloatImageImage()"; break;
+  case 1: expected_method = "WriteGenericRecord1()"; break;
+  case 2: expected_method = "WriteTupleOfOptionals()"; break;
+  case 3: expected_method = "WriteTupleOfOptionalsAlternateSyntax()"; break;
+  case 4: expected_method = "WriteTupleOfVectors()"; break;
   }
   std::string attempted_method;
   switch (attempted) {
-  case 0: attempted_method = "WriteFloatImage()"; break;
-  case 1: attempted_method = "WriteIntImage()"; break;
-  case 2: attempted_method = "WriteIntImageAlternateSyntax()"; break;
-  case 3: attempted_method = "WriteStringImage()"; break;
-  case 4: attempted_method = "WriteIntFloatTuple()"; break;
-  case 5: attempted_method = "WriteFloatFloatTuple()"; break;
-  case 6: attempted_method = "WriteIntFloatTupleAlternateSyntax()"; break;
-  case 7: attempted_method = "WriteIntStringTuple()"; break;
-  case 8: attempted_method = end ? "EndStreamOfTypeVariants()" : "WriteStreamOfTypeVariants()"; break;
-  case 9: attempted_method = "Close()"; break;
+  case 0: attempted_method = "WriteFloatImageImage()"; break;
+  case 1: attempted_method = "WriteGenericRecord1()"; break;
+  case 2: attempted_method = "WriteTupleOfOptionals()"; break;
+  case 3: attempted_method = "WriteTupleOfOptionalsAlternateSyntax()"; break;
+  case 4: attempted_method = "WriteTupleOfVectors()"; break;
+  case 5: attempted_method = "Close()"; break;
   }
   throw std::runtime_error("Expected call to " + expected_method + " but received call to " + attempted_method + " instead.");
 }
 
-void SimpleGenericsReaderBaseInvalidState(uint8_t attempted, uint8_t current) {
+void AdvancedGenericsReaderBaseInvalidState(uint8_t attempted, uint8_t current) {
   auto f = [](uint8_t i) -> std::string {
     switch (i/2) {
-    case 0: return "ReadFloatImage()";
-    case 1: return "ReadIntImage()";
-    case 2: return "ReadIntImageAlternateSyntax()";
-    case 3: return "ReadStringImage()";
-    case 4: return "ReadIntFloatTuple()";
-    case 5: return "ReadFloatFloatTuple()";
-    case 6: return "ReadIntFloatTupleAlternateSyntax()";
-    case 7: return "ReadIntStringTuple()";
-    case 8: return "ReadStreamOfTypeVariants()";
-    case 9: return "Close()";
+    case 0: return "ReadFloatImageImage()";
+    case 1: return "ReadGenericRecord1()";
+    case 2: return "ReadTupleOfOptionals()";
+    case 3: return "ReadTupleOfOptionalsAlternateSyntax()";
+    case 4: return "ReadTupleOfVectors()";
+    case 5: return "Close()";
     default: return "<unknown>";
     }
   };
@@ -5496,256 +7214,327 @@ void SimpleGenericsReaderBaseInvalidState(uint8_t attempted, uint8_t current) {
 
 } // namespace 
 
-std::string SimpleGenericsWriterBase::schema_ = R"({"protocol":{"name":"SimpleGenerics","sequence":[{"name":"floatImage","type":"Image.FloatImage"},{"name":"intImage","type":"Image.IntImage"},{"name":"intImageAlternateSyntax","type":{"name":"TestModel.Image","typeArguments":["int32"]}},{"name":"stringImage","type":{"name":"TestModel.Image","typeArguments":["string"]}},{"name":"intFloatTuple","type":{"name":"Tuples.Tuple","typeArguments":["int32","float32"]}},{"name":"floatFloatTuple","type":{"name":"Tuples.Tuple","typeArguments":["float32","float32"]}},{"name":"intFloatTupleAlternateSyntax","type":{"name":"Tuples.Tuple","typeArguments":["int32","float32"]}},{"name":"intStringTuple","type":{"name":"Tuples.Tuple","typeArguments":["int32","string"]}},{"name":"streamOfTypeVariants","type":{"stream":{"items":[{"tag":"imageFloat","explicitTag":true,"type":"Image.FloatImage"},{"tag":"imageDouble","explicitTag":true,"type":{"name":"TestModel.Image","typeArguments":["float64"]}}]}}}]},"types":[{"name":"FloatImage","type":{"name":"Image.Image","typeArguments":["float32"]}},{"name":"Image","typeParameters":["T"],"type":{"array":{"items":"T","dimensions":[{"name":"x"},{"name":"y"}]}}},{"name":"IntImage","type":{"name":"Image.Image","typeArguments":["int32"]}},{"name":"Image","typeParameters":["T"],"type":{"name":"Image.Image","typeArguments":["T"]}},{"name":"Tuple","typeParameters":["T1","T2"],"fields":[{"name":"v1","type":"T1"},{"name":"v2","type":"T2"}]}]})";
+std::string AdvancedGenericsWriterBase::schema_ = R"({"protocol":{"name":"AdvancedGenerics","sequence":[{"name":"floatImageImage","type":{"name":"TestModel.Image","typeArguments":[{"name":"TestModel.Image","typeArguments":["float32"]}]}},{"name":"genericRecord1","type":{"name":"TestModel.GenericRecord","typeArguments":["int32","string"]}},{"name":"tupleOfOptionals","type":{"name":"TestModel.MyTuple","typeArguments":[[null,"int32"],[null,"string"]]}},{"name":"tupleOfOptionalsAlternateSyntax","type":{"name":"TestModel.MyTuple","typeArguments":[[null,"int32"],[null,"string"]]}},{"name":"tupleOfVectors","type":{"name":"TestModel.MyTuple","typeArguments":[{"vector":{"items":"int32"}},{"vector":{"items":"float32"}}]}}]},"types":[{"name":"MyTuple","typeParameters":["T1","T2"],"type":{"name":"Tuples.Tuple","typeArguments":["T1","T2"]}},{"name":"Image","typeParameters":["T"],"type":{"array":{"items":"T","dimensions":[{"name":"x"},{"name":"y"}]}}},{"name":"GenericRecord","typeParameters":["T1","T2"],"fields":[{"name":"scalar1","type":"T1"},{"name":"scalar2","type":"T2"},{"name":"vector1","type":{"vector":{"items":"T1"}}},{"name":"image2","type":{"name":"TestModel.Image","typeArguments":["T2"]}}]},{"name":"Image","typeParameters":["T"],"type":{"name":"Image.Image","typeArguments":["T"]}},{"name":"MyTuple","typeParameters":["T1","T2"],"type":{"name":"BasicTypes.MyTuple","typeArguments":["T1","T2"]}},{"name":"Tuple","typeParameters":["T1","T2"],"fields":[{"name":"v1","type":"T1"},{"name":"v2","type":"T2"}]}]})";
 
-std::vector<std::string> SimpleGenericsWriterBase::previous_schemas_ = {
+std::vector<std::string> AdvancedGenericsWriterBase::previous_schemas_ = {
 };
 
-std::string SimpleGenericsWriterBase::SchemaFromVersion(Version version) {
+std::string AdvancedGenericsWriterBase::SchemaFromVersion(Version version) {
   switch (version) {
-  case Version::Current: return SimpleGenericsWriterBase::schema_; break;
-  default: throw std::runtime_error("The version does not correspond to any schema supported by protocol SimpleGenerics.");
+  case Version::Current: return AdvancedGenericsWriterBase::schema_; break;
+  default: throw std::runtime_error("The version does not correspond to any schema supported by protocol AdvancedGenerics.");
   }
 
 }
-void SimpleGenericsWriterBase::WriteFloatImage(image::FloatImage const& value) {
+void AdvancedGenericsWriterBase::WriteFloatImageImage(test_model::Image<test_model::Image<float>> const& value) {
   if (unlikely(state_ != 0)) {
-    SimpleGenericsWriterBaseInvalidState(0, false, state_);
+    AdvancedGenericsWriterBaseInvalidState(0, false, state_);
   }
 
-  WriteFloatImageImpl(value);
+  WriteFloatImageImageImpl(value);
   state_ = 1;
 }
 
-void SimpleGenericsWriterBase::WriteIntImage(image::IntImage const& value) {
+void AdvancedGenericsWriterBase::WriteGenericRecord1(test_model::GenericRecord<int32_t, std::string> const& value) {
   if (unlikely(state_ != 1)) {
-    SimpleGenericsWriterBaseInvalidState(1, false, state_);
+    AdvancedGenericsWriterBaseInvalidState(1, false, state_);
   }
 
-  WriteIntImageImpl(value);
+  WriteGenericRecord1Impl(value);
   state_ = 2;
 }
 
-void SimpleGenericsWriterBase::WriteIntImageAlternateSyntax(test_model::Image<int32_t> const& value) {
+void AdvancedGenericsWriterBase::WriteTupleOfOptionals(test_model::MyTuple<std::optional<int32_t>, std::optional<std::string>> const& value) {
   if (unlikely(state_ != 2)) {
-    SimpleGenericsWriterBaseInvalidState(2, false, state_);
+    AdvancedGenericsWriterBaseInvalidState(2, false, state_);
   }
 
-  WriteIntImageAlternateSyntaxImpl(value);
+  WriteTupleOfOptionalsImpl(value);
   state_ = 3;
 }
 
-void SimpleGenericsWriterBase::WriteStringImage(test_model::Image<std::string> const& value) {
+void AdvancedGenericsWriterBase::WriteTupleOfOptionalsAlternateSyntax(test_model::MyTuple<std::optional<int32_t>, std::optional<std::string>> const& value) {
   if (unlikely(state_ != 3)) {
-    SimpleGenericsWriterBaseInvalidState(3, false, state_);
+    AdvancedGenericsWriterBaseInvalidState(3, false, state_);
   }
 
-  WriteStringImageImpl(value);
+  WriteTupleOfOptionalsAlternateSyntaxImpl(value);
   state_ = 4;
 }
 
-void SimpleGenericsWriterBase::WriteIntFloatTuple(tuples::Tuple<int32_t, float> const& value) {
+void AdvancedGenericsWriterBase::WriteTupleOfVectors(test_model::MyTuple<std::vector<int32_t>, std::vector<float>> const& value) {
   if (unlikely(state_ != 4)) {
-    SimpleGenericsWriterBaseInvalidState(4, false, state_);
+    AdvancedGenericsWriterBaseInvalidState(4, false, state_);
   }
 
-  WriteIntFloatTupleImpl(value);
+  WriteTupleOfVectorsImpl(value);
   state_ = 5;
 }
 
-void SimpleGenericsWriterBase::WriteFloatFloatTuple(tuples::Tuple<float, float> const& value) {
+void AdvancedGenericsWriterBase::Close() {
   if (unlikely(state_ != 5)) {
-    SimpleGenericsWriterBaseInvalidState(5, false, state_);
+    AdvancedGenericsWriterBaseInvalidState(5, false, state_);
   }
 
-  WriteFloatFloatTupleImpl(value);
-  state_ = 6;
+  CloseImpl();
 }
 
-void SimpleGenericsWriterBase::WriteIntFloatTupleAlternateSyntax(tuples::Tuple<int32_t, float> const& value) {
-  if (unlikely(state_ != 6)) {
-    SimpleGenericsWriterBaseInvalidState(6, false, state_);
+std::string AdvancedGenericsReaderBase::schema_ = AdvancedGenericsWriterBase::schema_;
+
+std::vector<std::string> AdvancedGenericsReaderBase::previous_schemas_ = AdvancedGenericsWriterBase::previous_schemas_;
+
+Version AdvancedGenericsReaderBase::VersionFromSchema(std::string const& schema) {
+  if (schema == AdvancedGenericsWriterBase::schema_) {
+    return Version::Current;
+  }
+  throw std::runtime_error("The schema does not match any version supported by protocol AdvancedGenerics.");
+}
+void AdvancedGenericsReaderBase::ReadFloatImageImage(test_model::Image<test_model::Image<float>>& value) {
+  if (unlikely(state_ != 0)) {
+    AdvancedGenericsReaderBaseInvalidState(0, state_);
   }
 
-  WriteIntFloatTupleAlternateSyntaxImpl(value);
-  state_ = 7;
+  ReadFloatImageImageImpl(value);
+  state_ = 2;
 }
 
-void SimpleGenericsWriterBase::WriteIntStringTuple(tuples::Tuple<int32_t, std::string> const& value) {
-  if (unlikely(state_ != 7)) {
-    SimpleGenericsWriterBaseInvalidState(7, false, state_);
+void AdvancedGenericsReaderBase::ReadGenericRecord1(test_model::GenericRecord<int32_t, std::string>& value) {
+  if (unlikely(state_ != 2)) {
+    AdvancedGenericsReaderBaseInvalidState(2, state_);
   }
 
-  WriteIntStringTupleImpl(value);
-  state_ = 8;
+  ReadGenericRecord1Impl(value);
+  state_ = 4;
 }
 
-void SimpleGenericsWriterBase::WriteStreamOfTypeVariants(std::variant<image::FloatImage, test_model::Image<double>> const& value) {
-  if (unlikely(state_ != 8)) {
-    SimpleGenericsWriterBaseInvalidState(8, false, state_);
+void AdvancedGenericsReaderBase::ReadTupleOfOptionals(test_model::MyTuple<std::optional<int32_t>, std::optional<std::string>>& value) {
+  if (unlikely(state_ != 4)) {
+    AdvancedGenericsReaderBaseInvalidState(4, state_);
   }
 
-  WriteStreamOfTypeVariantsImpl(value);
+  ReadTupleOfOptionalsImpl(value);
+  state_ = 6;
 }
 
-void SimpleGenericsWriterBase::WriteStreamOfTypeVariants(std::vector<std::variant<image::FloatImage, test_model::Image<double>>> const& values) {
-  if (unlikely(state_ != 8)) {
-    SimpleGenericsWriterBaseInvalidState(8, false, state_);
+void AdvancedGenericsReaderBase::ReadTupleOfOptionalsAlternateSyntax(test_model::MyTuple<std::optional<int32_t>, std::optional<std::string>>& value) {
+  if (unlikely(state_ != 6)) {
+    AdvancedGenericsReaderBaseInvalidState(6, state_);
   }
 
-  WriteStreamOfTypeVariantsImpl(values);
+  ReadTupleOfOptionalsAlternateSyntaxImpl(value);
+  state_ = 8;
 }
 
-void SimpleGenericsWriterBase::EndStreamOfTypeVariants() {
+void AdvancedGenericsReaderBase::ReadTupleOfVectors(test_model::MyTuple<std::vector<int32_t>, std::vector<float>>& value) {
   if (unlikely(state_ != 8)) {
-    SimpleGenericsWriterBaseInvalidState(8, true, state_);
+    AdvancedGenericsReaderBaseInvalidState(8, state_);
   }
 
-  EndStreamOfTypeVariantsImpl();
-  state_ = 9;
+  ReadTupleOfVectorsImpl(value);
+  state_ = 10;
 }
 
-// fallback implementation
-void SimpleGenericsWriterBase::WriteStreamOfTypeVariantsImpl(std::vector<std::variant<image::FloatImage, test_model::Image<double>>> const& values) {
-  for (auto const& v : values) {
-    WriteStreamOfTypeVariantsImpl(v);
+void AdvancedGenericsReaderBase::Close() {
+  if (!skip_completed_check_ && unlikely(state_ != 10)) {
+    AdvancedGenericsReaderBaseInvalidState(10, state_);
+  }
+
+  CloseImpl();
+}
+void AdvancedGenericsReaderBase::CopyTo(AdvancedGenericsWriterBase& writer) {
+  {
+    test_model::Image<test_model::Image<float>> value;
+    ReadFloatImageImage(value);
+    writer.WriteFloatImageImage(value);
+  }
+  {
+    test_model::GenericRecord<int32_t, std::string> value;
+    ReadGenericRecord1(value);
+    writer.WriteGenericRecord1(value);
+  }
+  {
+    test_model::MyTuple<std::optional<int32_t>, std::optional<std::string>> value;
+    ReadTupleOfOptionals(value);
+    writer.WriteTupleOfOptionals(value);
+  }
+  {
+    test_model::MyTuple<std::optional<int32_t>, std::optional<std::string>> value;
+    ReadTupleOfOptionalsAlternateSyntax(value);
+    writer.WriteTupleOfOptionalsAlternateSyntax(value);
+  }
+  {
+    test_model::MyTuple<std::vector<int32_t>, std::vector<float>> value;
+    ReadTupleOfVectors(value);
+    writer.WriteTupleOfVectors(value);
+  }
+}
+
+namespace {
+void FloatImageStreamWriterBaseInvalidState(uint8_t attempted, [[maybe_unused]] bool end, uint8_t current) {
+  std::string expected_method;
+  switch (current) {
+  case 0: expected_method = "WriteHeader()"; break;
+  case 1: expected_method = "WriteImages() or EndImages()"; break;
+  case 2: expected_method = "WriteBackground()"; break;
+  }
+  std::string attempted_method;
+  switch (attempted) {
+  case 0: attempted_method = "WriteHeader()"; break;
+  case 1: attempted_method = end ? "EndImages()" : "WriteImages()"; break;
+  case 2: attempted_method = "WriteBackground()"; break;
+  case 3: attempted_method = "Close()"; break;
   }
+  throw std::runtime_error("Expected call to " + expected_method + " but received call to " + attempted_method + " instead.");
 }
 
-void SimpleGenericsWriterBase::Close() {
-  if (unlikely(state_ != 9)) {
-    SimpleGenericsWriterBaseInvalidState(9, false, state_);
-  }
-
-  CloseImpl();
+void FloatImageStreamReaderBaseInvalidState(uint8_t attempted, uint8_t current) {
+  auto f = [](uint8_t i) -> std::string {
+    switch (i/2) {
+    case 0: return "ReadHeader()";
+    case 1: return "ReadImages()";
+    case 2: return "ReadBackground()";
+    case 3: return "Close()";
+    default: return "<unknown>";
+    }
+  };
+  throw std::runtime_error("Expected call to " + f(current) + " but received call to " + f(attempted) + " instead.");
 }
 
-std::string SimpleGenericsReaderBase::schema_ = SimpleGenericsWriterBase::schema_;
+} // namespace 
 
-std::vector<std::string> SimpleGenericsReaderBase::previous_schemas_ = SimpleGenericsWriterBase::previous_schemas_;
+std::string FloatImageStreamWriterBase::schema_ = R"({"protocol":{"name":"FloatImageStream","sequence":[{"name":"header","type":{"name":"TestModel.GenericRecord","typeArguments":["int32","float32"]}},{"name":"images","type":{"stream":{"items":{"name":"TestModel.Image","typeArguments":["float32"]}}}},{"name":"background","type":[{"tag":"float32","type":"float32"},{"tag":"string","type":"string"}]}]},"types":[{"name":"Image","typeParameters":["T"],"type":{"array":{"items":"T","dimensions":[{"name":"x"},{"name":"y"}]}}},{"name":"GenericRecord","typeParameters":["T1","T2"],"fields":[{"name":"scalar1","type":"T1"},{"name":"scalar2","type":"T2"},{"name":"vector1","type":{"vector":{"items":"T1"}}},{"name":"image2","type":{"name":"TestModel.Image","typeArguments":["T2"]}}]},{"name":"Image","typeParameters":["T"],"type":{"name":"Image.Image","typeArguments":["T"]}}]})";
 
-Version SimpleGenericsReaderBase::VersionFromSchema(std::string const& schema) {
-  if (schema == SimpleGenericsWriterBase::schema_) {
-    return Version::Current;
+std::vector<std::string> FloatImageStreamWriterBase::previous_schemas_ = {
+};
+
+std::string FloatImageStreamWriterBase::SchemaFromVersion(Version version) {
+  switch (version) {
+  case Version::Current: return FloatImageStreamWriterBase::schema_; break;
+  default: throw std::runtime_error("The version does not correspond to any schema supported by protocol FloatImageStream.");
   }
-  throw std::runtime_error("The schema does not match any version supported by protocol SimpleGenerics.");
+
 }
-void SimpleGenericsReaderBase::ReadFloatImage(image::FloatImage& value) {
+void FloatImageStreamWriterBase::WriteHeader(test_model::GenericRecord<int32_t, float> const& value) {
   if (unlikely(state_ != 0)) {
-    SimpleGenericsReaderBaseInvalidState(0, state_);
+    FloatImageStreamWriterBaseInvalidState(0, false, state_);
   }
 
-  ReadFloatImageImpl(value);
-  state_ = 2;
+  WriteHeaderImpl(value);
+  state_ = 1;
 }
 
-void SimpleGenericsReaderBase::ReadIntImage(image::IntImage& value) {
-  if (unlikely(state_ != 2)) {
-    SimpleGenericsReaderBaseInvalidState(2, state_);
+void FloatImageStreamWriterBase::WriteImages(test_model::Image<float> const& value) {
+  if (unlikely(state_ != 1)) {
+    FloatImageStreamWriterBaseInvalidState(1, false, state_);
   }
 
-  ReadIntImageImpl(value);
-  state_ = 4;
+  WriteImagesImpl(value);
 }
 
-void SimpleGenericsReaderBase::ReadIntImageAlternateSyntax(test_model::Image<int32_t>& value) {
-  if (unlikely(state_ != 4)) {
-    SimpleGenericsReaderBaseInvalidState(4, state_);
+void FloatImageStreamWriterBase::WriteImages(std::vector<test_model::Image<float>> const& values) {
+  if (unlikely(state_ != 1)) {
+    FloatImageStreamWriterBaseInvalidState(1, false, state_);
   }
 
-  ReadIntImageAlternateSyntaxImpl(value);
-  state_ = 6;
+  WriteImagesImpl(values);
 }
 
-void SimpleGenericsReaderBase::ReadStringImage(test_model::Image<std::string>& value) {
-  if (unlikely(state_ != 6)) {
-    SimpleGenericsReaderBaseInvalidState(6, state_);
+void FloatImageStreamWriterBase::EndImages() {
+  if (unlikely(state_ != 1)) {
+    FloatImageStreamWriterBaseInvalidState(1, true, state_);
   }
 
-  ReadStringImageImpl(value);
-  state_ = 8;
+  EndImagesImpl();
+  state_ = 2;
 }
 
-void SimpleGenericsReaderBase::ReadIntFloatTuple(tuples::Tuple<int32_t, float>& value) {
-  if (unlikely(state_ != 8)) {
-    SimpleGenericsReaderBaseInvalidState(8, state_);
+// fallback implementation
+void FloatImageStreamWriterBase::WriteImagesImpl(std::vector<test_model::Image<float>> const& values) {
+  for (auto const& v : values) {
+    WriteImagesImpl(v);
   }
-
-  ReadIntFloatTupleImpl(value);
-  state_ = 10;
 }
 
-void SimpleGenericsReaderBase::ReadFloatFloatTuple(tuples::Tuple<float, float>& value) {
-  if (unlikely(state_ != 10)) {
-    SimpleGenericsReaderBaseInvalidState(10, state_);
+void FloatImageStreamWriterBase::WriteBackground(std::variant<float, std::string> const& value) {
+  if (unlikely(state_ != 2)) {
+    FloatImageStreamWriterBaseInvalidState(2, false, state_);
   }
 
-  ReadFloatFloatTupleImpl(value);
-  state_ = 12;
+  WriteBackgroundImpl(value);
+  state_ = 3;
 }
 
-void SimpleGenericsReaderBase::ReadIntFloatTupleAlternateSyntax(tuples::Tuple<int32_t, float>& value) {
-  if (unlikely(state_ != 12)) {
-    SimpleGenericsReaderBaseInvalidState(12, state_);
+void FloatImageStreamWriterBase::Close() {
+  if (unlikely(state_ != 3)) {
+    FloatImageStreamWriterBaseInvalidState(3, false, state_);
   }
 
-  ReadIntFloatTupleAlternateSyntaxImpl(value);
-  state_ = 14;
+  CloseImpl();
 }
 
-void SimpleGenericsReaderBase::ReadIntStringTuple(tuples::Tuple<int32_t, std::string>& value) {
-  if (unlikely(state_ != 14)) {
-    SimpleGenericsReaderBaseInvalidState(14, state_);
+std::string FloatImageStreamReaderBase::schema_ = FloatImageStreamWriterBase::schema_;
+
+std::vector<std::string> FloatImageStreamReaderBase::previous_schemas_ = FloatImageStreamWriterBase::previous_schemas_;
+
+Version FloatImageStreamReaderBase::VersionFromSchema(std::string const& schema) {
+  if (schema == FloatImageStreamWriterBase::schema_) {
+    return Version::Current;
+  }
+  throw std::runtime_error("The schema does not match any version supported by protocol FloatImageStream.");
+}
+void FloatImageStreamReaderBase::ReadHeader(test_model::GenericRecord<int32_t, float>& value) {
+  if (unlikely(state_ != 0)) {
+    FloatImageStreamReaderBaseInvalidState(0, state_);
   }
 
-  ReadIntStringTupleImpl(value);
-  state_ = 16;
+  ReadHeaderImpl(value);
+  state_ = 2;
 }
 
-bool SimpleGenericsReaderBase::ReadStreamOfTypeVariants(std::variant<image::FloatImage, test_model::Image<double>>& value) {
-  if (unlikely(state_ != 16)) {
-    if (state_ == 17) {
-      state_ = 18;
+bool FloatImageStreamReaderBase::ReadImages(test_model::Image<float>& value) {
+  if (unlikely(state_ != 2)) {
+    if (state_ == 3) {
+      state_ = 4;
       return false;
     }
-    SimpleGenericsReaderBaseInvalidState(16, state_);
+    FloatImageStreamReaderBaseInvalidState(2, state_);
   }
 
-  bool result = ReadStreamOfTypeVariantsImpl(value);
+  bool result = ReadImagesImpl(value);
   if (!result) {
-    state_ = 18;
+    state_ = 4;
   }
   return result;
 }
 
-bool SimpleGenericsReaderBase::ReadStreamOfTypeVariants(std::vector<std::variant<image::FloatImage, test_model::Image<double>>>& values) {
+bool FloatImageStreamReaderBase::ReadImages(std::vector<test_model::Image<float>>& values) {
   if (values.capacity() == 0) {
     throw std::runtime_error("vector must have a nonzero capacity.");
   }
-  if (unlikely(state_ != 16)) {
-    if (state_ == 17) {
-      state_ = 18;
+  if (unlikely(state_ != 2)) {
+    if (state_ == 3) {
+      state_ = 4;
       values.clear();
       return false;
     }
-    SimpleGenericsReaderBaseInvalidState(16, state_);
+    FloatImageStreamReaderBaseInvalidState(2, state_);
   }
 
-  if (!ReadStreamOfTypeVariantsImpl(values)) {
-    state_ = 17;
+  if (!ReadImagesImpl(values)) {
+    state_ = 3;
     return values.size() > 0;
   }
   return true;
 }
 
 // fallback implementation
-bool SimpleGenericsReaderBase::ReadStreamOfTypeVariantsImpl(std::vector<std::variant<image::FloatImage, test_model::Image<double>>>& values) {
+bool FloatImageStreamReaderBase::ReadImagesImpl(std::vector<test_model::Image<float>>& values) {
   size_t i = 0;
   while (true) {
     if (i == values.size()) {
       values.resize(i + 1);
     }
-    if (!ReadStreamOfTypeVariantsImpl(values[i])) {
+    if (!ReadImagesImpl(values[i])) {
       values.resize(i);
       return false;
     }
@@ -5756,105 +7545,78 @@ bool SimpleGenericsReaderBase::ReadStreamOfTypeVariantsImpl(std::vector<std::var
   }
 }
 
-void SimpleGenericsReaderBase::Close() {
-  if (!skip_completed_check_ && unlikely(state_ != 18)) {
-    if (state_ == 17) {
-      state_ = 18;
+void FloatImageStreamReaderBase::ReadBackground(std::variant<float, std::string>& value) {
+  if (unlikely(state_ != 4)) {
+    if (state_ == 3) {
+      state_ = 4;
     } else {
-      SimpleGenericsReaderBaseInvalidState(18, state_);
+      FloatImageStreamReaderBaseInvalidState(4, state_);
     }
   }
 
-  CloseImpl();
+  ReadBackgroundImpl(value);
+  state_ = 6;
 }
-void SimpleGenericsReaderBase::CopyTo(SimpleGenericsWriterBase& writer, size_t stream_of_type_variants_buffer_size) {
-  {
-    image::FloatImage value;
-    ReadFloatImage(value);
-    writer.WriteFloatImage(value);
-  }
-  {
-    image::IntImage value;
-    ReadIntImage(value);
-    writer.WriteIntImage(value);
-  }
-  {
-    test_model::Image<int32_t> value;
-    ReadIntImageAlternateSyntax(value);
-    writer.WriteIntImageAlternateSyntax(value);
-  }
-  {
-    test_model::Image<std::string> value;
-    ReadStringImage(value);
-    writer.WriteStringImage(value);
-  }
-  {
-    tuples::Tuple<int32_t, float> value;
-    ReadIntFloatTuple(value);
-    writer.WriteIntFloatTuple(value);
-  }
-  {
-    tuples::Tuple<float, float> value;
-    ReadFloatFloatTuple(value);
-    writer.WriteFloatFloatTuple(value);
-  }
-  {
-    tuples::Tuple<int32_t, float> value;
-    ReadIntFloatTupleAlternateSyntax(value);
-    writer.WriteIntFloatTupleAlternateSyntax(value);
+
+void FloatImageStreamReaderBase::Close() {
+  if (!skip_completed_check_ && unlikely(state_ != 6)) {
+    FloatImageStreamReaderBaseInvalidState(6, state_);
   }
+
+  CloseImpl();
+}
+void FloatImageStreamReaderBase::CopyTo(FloatImageStreamWriterBase& writer, size_t images_buffer_size) {
   {
-    tuples::Tuple<int32_t, std::string> value;
-    ReadIntStringTuple(value);
-    writer.WriteIntStringTuple(value);
+    test_model::GenericRecord<int32_t, float> value;
+    ReadHeader(value);
+    writer.WriteHeader(value);
   }
-  if (stream_of_type_variants_buffer_size > 1) {
-    std::vector<std::variant<image::FloatImage, test_model::Image<double>>> values;
-    values.reserve(stream_of_type_variants_buffer_size);
-    while(ReadStreamOfTypeVariants(values)) {
-      writer.WriteStreamOfTypeVariants(values);
+  if (images_buffer_size > 1) {
+    std::vector<test_model::Image<float>> values;
+    values.reserve(images_buffer_size);
+    while(ReadImages(values)) {
+      writer.WriteImages(values);
     }
-    writer.EndStreamOfTypeVariants();
+    writer.EndImages();
   } else {
-    std::variant<image::FloatImage, test_model::Image<double>> value;
-    while(ReadStreamOfTypeVariants(value)) {
-      writer.WriteStreamOfTypeVariants(value);
+    test_model::Image<float> value;
+    while(ReadImages(value)) {
+      writer.WriteImages(value);
     }
-    writer.EndStreamOfTypeVariants();
+    writer.EndImages();
+  }
+  {
+    std::variant<float, std::string> value;
+    ReadBackground(value);
+    writer.WriteBackground(value);
   }
 }
 
 namespace {
-void AdvancedGenericsWriterBaseInvalidState(uint8_t attempted, [[maybe_unused]] bool end, uint8_t current) {
+void ComplexImageStreamWriterBaseInvalidState(uint8_t attempted, [[maybe_unused]] bool end, uint8_t current) {
   std::string expected_method;
   switch (current) {
-  case 0: expected_method = "WriteFloatImageImage()"; break;
-  case 1: expected_method = "WriteGenericRecord1()"; break;
-  case 2: expected_method = "WriteTupleOfOptionals()"; break;
-  case 3: expected_method = "WriteTupleOfOptionalsAlternateSyntax()"; break;
-  case 4: expected_method = "WriteTupleOfVectors()"; break;
+  case 0: expected_method = "WriteHeader()"; break;
+  case 1: expected_method = "WriteImages() or EndImages()"; break;
+  case 2: expected_method = "WriteBackground()"; break;
   }
   std::string attempted_method;
   switch (attempted) {
-  case 0: attempted_method = "WriteFloatImageImage()"; break;
-  case 1: attempted_method = "WriteGenericRecord1()"; break;
-  case 2: attempted_method = "WriteTupleOfOptionals()"; break;
-  case 3: attempted_method = "WriteTupleOfOptionalsAlternateSyntax()"; break;
-  case 4: attempted_method = "WriteTupleOfVectors()"; break;
-  case 5: attempted_method = "Close()"; break;
+  case 0: attempted_method = "WriteHeader()"; break;
+  case 1: attempted_method = end ? "EndImages()" : "WriteImages()"; break;
+  case 2: attempted_method = "WriteBackground()"; break;
+  case 3: attempted_method = "Close()"; break;
   }
   throw std::runtime_error("Expected call to " + expected_method + " but received call to " + attempted_method + " instead.");
 }
 
-void AdvancedGenericsReaderBaseInvalidState(uint8_t attempted, uint8_t current) {
+void ComplexImageStreamReaderBaseInvalidState(uint8_t attempted, uint8_t current) {
   auto f = [](uint8_t i) -> std::string {
     switch (i/2) {
-    case 0: return "ReadFloatImageImage()";
-    case 1: return "ReadGenericRecord1()";
-    case 2: return "ReadTupleOfOptionals()";
-    case 3: return "ReadTupleOfOptionalsAlternateSyntax()";
-    case 4: return "ReadTupleOfVectors()";
-    case 5: return "Close()";
+    case 0: return "ReadHeader()";
+    case 1: return "ReadImages()";
+    case 2: return "ReadBackground()";
+    case 3: return "Close()";
     default: return "<unknown>";
     }
   };
@@ -5863,158 +7625,193 @@ void AdvancedGenericsReaderBaseInvalidState(uint8_t attempted, uint8_t current)
 
 } // namespace 
 
-std::string AdvancedGenericsWriterBase::schema_ = R"({"protocol":{"name":"AdvancedGenerics","sequence":[{"name":"floatImageImage","type":{"name":"TestModel.Image","typeArguments":[{"name":"TestModel.Image","typeArguments":["float32"]}]}},{"name":"genericRecord1","type":{"name":"TestModel.GenericRecord","typeArguments":["int32","string"]}},{"name":"tupleOfOptionals","type":{"name":"TestModel.MyTuple","typeArguments":[[null,"int32"],[null,"string"]]}},{"name":"tupleOfOptionalsAlternateSyntax","type":{"name":"TestModel.MyTuple","typeArguments":[[null,"int32"],[null,"string"]]}},{"name":"tupleOfVectors","type":{"name":"TestModel.MyTuple","typeArguments":[{"vector":{"items":"int32"}},{"vector":{"items":"float32"}}]}}]},"types":[{"name":"MyTuple","typeParameters":["T1","T2"],"type":{"name":"Tuples.Tuple","typeArguments":["T1","T2"]}},{"name":"Image","typeParameters":["T"],"type":{"array":{"items":"T","dimensions":[{"name":"x"},{"name":"y"}]}}},{"name":"GenericRecord","typeParameters":["T1","T2"],"fields":[{"name":"scalar1","type":"T1"},{"name":"scalar2","type":"T2"},{"name":"vector1","type":{"vector":{"items":"T1"}}},{"name":"image2","type":{"name":"TestModel.Image","typeArguments":["T2"]}}]},{"name":"Image","typeParameters":["T"],"type":{"name":"Image.Image","typeArguments":["T"]}},{"name":"MyTuple","typeParameters":["T1","T2"],"type":{"name":"BasicTypes.MyTuple","typeArguments":["T1","T2"]}},{"name":"Tuple","typeParameters":["T1","T2"],"fields":[{"name":"v1","type":"T1"},{"name":"v2","type":"T2"}]}]})";
+std::string ComplexImageStreamWriterBase::schema_ = R"({"protocol":{"name":"ComplexImageStream","sequence":[{"name":"header","type":{"name":"TestModel.GenericRecord","typeArguments":["int32","complexfloat32"]}},{"name":"images","type":{"stream":{"items":{"name":"TestModel.Image","typeArguments":["complexfloat32"]}}}},{"name":"background","type":[{"tag":"complexfloat32","type":"complexfloat32"},{"tag":"string","type":"string"}]}]},"types":[{"name":"Image","typeParameters":["T"],"type":{"array":{"items":"T","dimensions":[{"name":"x"},{"name":"y"}]}}},{"name":"GenericRecord","typeParameters":["T1","T2"],"fields":[{"name":"scalar1","type":"T1"},{"name":"scalar2","type":"T2"},{"name":"vector1","type":{"vector":{"items":"T1"}}},{"name":"image2","type":{"name":"TestModel.Image","typeArguments":["T2"]}}]},{"name":"Image","typeParameters":["T"],"type":{"name":"Image.Image","typeArguments":["T"]}}]})";
 
-std::vector<std::string> AdvancedGenericsWriterBase::previous_schemas_ = {
+std::vector<std::string> ComplexImageStreamWriterBase::previous_schemas_ = {
 };
 
-std::string AdvancedGenericsWriterBase::SchemaFromVersion(Version version) {
+std::string ComplexImageStreamWriterBase::SchemaFromVersion(Version version) {
   switch (version) {
-  case Version::Current: return AdvancedGenericsWriterBase::schema_; break;
-  default: throw std::runtime_error("The version does not correspond to any schema supported by protocol AdvancedGenerics.");
+  case Version::Current: return ComplexImageStreamWriterBase::schema_; break;
+  default: throw std::runtime_error("The version does not correspond to any schema supported by protocol ComplexImageStream.");
   }
 
 }
-void AdvancedGenericsWriterBase::WriteFloatImageImage(test_model::Image<test_model::Image<float>> const& value) {
+void ComplexImageStreamWriterBase::WriteHeader(test_model::GenericRecord<int32_t, std::complex<float>> const& value) {
   if (unlikely(state_ != 0)) {
-    AdvancedGenericsWriterBaseInvalidState(0, false, state_);
+    ComplexImageStreamWriterBaseInvalidState(0, false, state_);
   }
 
-  WriteFloatImageImageImpl(value);
+  WriteHeaderImpl(value);
   state_ = 1;
 }
 
-void AdvancedGenericsWriterBase::WriteGenericRecord1(test_model::GenericRecord<int32_t, std::string> const& value) {
+void ComplexImageStreamWriterBase::WriteImages(test_model::Image<std::complex<float>> const& value) {
   if (unlikely(state_ != 1)) {
-    AdvancedGenericsWriterBaseInvalidState(1, false, state_);
+    ComplexImageStreamWriterBaseInvalidState(1, false, state_);
   }
 
-  WriteGenericRecord1Impl(value);
-  state_ = 2;
+  WriteImagesImpl(value);
 }
 
-void AdvancedGenericsWriterBase::WriteTupleOfOptionals(test_model::MyTuple<std::optional<int32_t>, std::optional<std::string>> const& value) {
-  if (unlikely(state_ != 2)) {
-    AdvancedGenericsWriterBaseInvalidState(2, false, state_);
+void ComplexImageStreamWriterBase::WriteImages(std::vector<test_model::Image<std::complex<float>>> const& values) {
+  if (unlikely(state_ != 1)) {
+    ComplexImageStreamWriterBaseInvalidState(1, false, state_);
   }
 
-  WriteTupleOfOptionalsImpl(value);
-  state_ = 3;
+  WriteImagesImpl(values);
 }
 
-void AdvancedGenericsWriterBase::WriteTupleOfOptionalsAlternateSyntax(test_model::MyTuple<std::optional<int32_t>, std::optional<std::string>> const& value) {
-  if (unlikely(state_ != 3)) {
-    AdvancedGenericsWriterBaseInvalidState(3, false, state_);
+void ComplexImageStreamWriterBase::EndImages() {
+  if (unlikely(state_ != 1)) {
+    ComplexImageStreamWriterBaseInvalidState(1, true, state_);
   }
 
-  WriteTupleOfOptionalsAlternateSyntaxImpl(value);
-  state_ = 4;
+  EndImagesImpl();
+  state_ = 2;
 }
 
-void AdvancedGenericsWriterBase::WriteTupleOfVectors(test_model::MyTuple<std::vector<int32_t>, std::vector<float>> const& value) {
-  if (unlikely(state_ != 4)) {
-    AdvancedGenericsWriterBaseInvalidState(4, false, state_);
+// fallback implementation
+void ComplexImageStreamWriterBase::WriteImagesImpl(std::vector<test_model::Image<std::complex<float>>> const& values) {
+  for (auto const& v : values) {
+    WriteImagesImpl(v);
   }
+}
 
-  WriteTupleOfVectorsImpl(value);
-  state_ = 5;
+void ComplexImageStreamWriterBase::WriteBackground(std::variant<std::complex<float>, std::string> const& value) {
+  if (unlikely(state_ != 2)) {
+    ComplexImageStreamWriterBaseInvalidState(2, false, state_);
+  }
+
+  WriteBackgroundImpl(value);
+  state_ = 3;
 }
 
-void AdvancedGenericsWriterBase::Close() {
-  if (unlikely(state_ != 5)) {
-    AdvancedGenericsWriterBaseInvalidState(5, false, state_);
+void ComplexImageStreamWriterBase::Close() {
+  if (unlikely(state_ != 3)) {
+    ComplexImageStreamWriterBaseInvalidState(3, false, state_);
   }
 
   CloseImpl();
 }
 
-std::string AdvancedGenericsReaderBase::schema_ = AdvancedGenericsWriterBase::schema_;
+std::string ComplexImageStreamReaderBase::schema_ = ComplexImageStreamWriterBase::schema_;
 
-std::vector<std::string> AdvancedGenericsReaderBase::previous_schemas_ = AdvancedGenericsWriterBase::previous_schemas_;
+std::vector<std::string> ComplexImageStreamReaderBase::previous_schemas_ = ComplexImageStreamWriterBase::previous_schemas_;
 
-Version AdvancedGenericsReaderBase::VersionFromSchema(std::string const& schema) {
-  if (schema == AdvancedGenericsWriterBase::schema_) {
+Version ComplexImageStreamReaderBase::VersionFromSchema(std::string const& schema) {
+  if (schema == ComplexImageStreamWriterBase::schema_) {
     return Version::Current;
   }
-  throw std::runtime_error("The schema does not match any version supported by protocol AdvancedGenerics.");
+  throw std::runtime_error("The schema does not match any version supported by protocol ComplexImageStream.");
 }
-void AdvancedGenericsReaderBase::ReadFloatImageImage(test_model::Image<test_model::Image<float>>& value) {
+void ComplexImageStreamReaderBase::ReadHeader(test_model::GenericRecord<int32_t, std::complex<float>>& value) {
   if (unlikely(state_ != 0)) {
-    AdvancedGenericsReaderBaseInvalidState(0, state_);
+    ComplexImageStreamReaderBaseInvalidState(0, state_);
   }
 
-  ReadFloatImageImageImpl(value);
+  ReadHeaderImpl(value);
   state_ = 2;
 }
 
-void AdvancedGenericsReaderBase::ReadGenericRecord1(test_model::GenericRecord<int32_t, std::string>& value) {
+bool ComplexImageStreamReaderBase::ReadImages(test_model::Image<std::complex<float>>& value) {
   if (unlikely(state_ != 2)) {
-    AdvancedGenericsReaderBaseInvalidState(2, state_);
+    if (state_ == 3) {
+      state_ = 4;
+      return false;
+    }
+    ComplexImageStreamReaderBaseInvalidState(2, state_);
   }
 
-  ReadGenericRecord1Impl(value);
-  state_ = 4;
+  bool result = ReadImagesImpl(value);
+  if (!result) {
+    state_ = 4;
+  }
+  return result;
 }
 
-void AdvancedGenericsReaderBase::ReadTupleOfOptionals(test_model::MyTuple<std::optional<int32_t>, std::optional<std::string>>& value) {
-  if (unlikely(state_ != 4)) {
-    AdvancedGenericsReaderBaseInvalidState(4, state_);
+bool ComplexImageStreamReaderBase::ReadImages(std::vector<test_model::Image<std::complex<float>>>& values) {
+  if (values.capacity() == 0) {
+    throw std::runtime_error("vector must have a nonzero capacity.");
+  }
+  if (unlikely(state_ != 2)) {
+    if (state_ == 3) {
+      state_ = 4;
+      values.clear();
+      return false;
+    }
+    ComplexImageStreamReaderBaseInvalidState(2, state_);
   }
 
-  ReadTupleOfOptionalsImpl(value);
-  state_ = 6;
+  if (!ReadImagesImpl(values)) {
+    state_ = 3;
+    return values.size() > 0;
+  }
+  return true;
 }
 
-void AdvancedGenericsReaderBase::ReadTupleOfOptionalsAlternateSyntax(test_model::MyTuple<std::optional<int32_t>, std::optional<std::string>>& value) {
-  if (unlikely(state_ != 6)) {
-    AdvancedGenericsReaderBaseInvalidState(6, state_);
+// fallback implementation
+bool ComplexImageStreamReaderBase::ReadImagesImpl(std::vector<test_model::Image<std::complex<float>>>& values) {
+  size_t i = 0;
+  while (true) {
+    if (i == values.size()) {
+      values.resize(i + 1);
+    }
+    if (!ReadImagesImpl(values[i])) {
+      values.resize(i);
+      return false;
+    }
+    i++;
+    if (i == values.capacity()) {
+      return true;
+    }
   }
-
-  ReadTupleOfOptionalsAlternateSyntaxImpl(value);
-  state_ = 8;
 }
 
-void AdvancedGenericsReaderBase::ReadTupleOfVectors(test_model::MyTuple<std::vector<int32_t>, std::vector<float>>& value) {
-  if (unlikely(state_ != 8)) {
-    AdvancedGenericsReaderBaseInvalidState(8, state_);
+void ComplexImageStreamReaderBase::ReadBackground(std::variant<std::complex<float>, std::string>& value) {
+  if (unlikely(state_ != 4)) {
+    if (state_ == 3) {
+      state_ = 4;
+    } else {
+      ComplexImageStreamReaderBaseInvalidState(4, state_);
+    }
   }
 
-  ReadTupleOfVectorsImpl(value);
-  state_ = 10;
+  ReadBackgroundImpl(value);
+  state_ = 6;
 }
 
-void AdvancedGenericsReaderBase::Close() {
-  if (!skip_completed_check_ && unlikely(state_ != 10)) {
-    AdvancedGenericsReaderBaseInvalidState(10, state_);
+void ComplexImageStreamReaderBase::Close() {
+  if (!skip_completed_check_ && unlikely(state_ != 6)) {
+    ComplexImageStreamReaderBaseInvalidState(6, state_);
   }
 
   CloseImpl();
 }
-void AdvancedGenericsReaderBase::CopyTo(AdvancedGenericsWriterBase& writer) {
-  {
-    test_model::Image<test_model::Image<float>> value;
-    ReadFloatImageImage(value);
-    writer.WriteFloatImageImage(value);
-  }
-  {
-    test_model::GenericRecord<int32_t, std::string> value;
-    ReadGenericRecord1(value);
-    writer.WriteGenericRecord1(value);
-  }
+void ComplexImageStreamReaderBase::CopyTo(ComplexImageStreamWriterBase& writer, size_t images_buffer_size) {
   {
-    test_model::MyTuple<std::optional<int32_t>, std::optional<std::string>> value;
-    ReadTupleOfOptionals(value);
-    writer.WriteTupleOfOptionals(value);
+    test_model::GenericRecord<int32_t, std::complex<float>> value;
+    ReadHeader(value);
+    writer.WriteHeader(value);
   }
-  {
-    test_model::MyTuple<std::optional<int32_t>, std::optional<std::string>> value;
-    ReadTupleOfOptionalsAlternateSyntax(value);
-    writer.WriteTupleOfOptionalsAlternateSyntax(value);
+  if (images_buffer_size > 1) {
+    std::vector<test_model::Image<std::complex<float>>> values;
+    values.reserve(images_buffer_size);
+    while(ReadImages(values)) {
+      writer.WriteImages(values);
+    }
+    writer.EndImages();
+  } else {
+    test_model::Image<std::complex<float>> value;
+    while(ReadImages(value)) {
+      writer.WriteImages(value);
+    }
+    writer.EndImages();
   }
   {
-    test_model::MyTuple<std::vector<int32_t>, std::vector<float>> value;
-    ReadTupleOfVectors(value);
-    writer.WriteTupleOfVectors(value);
+    std::variant<std::complex<float>, std::string> value;
+    ReadBackground(value);
+    writer.WriteBackground(value);
   }
 }
 
@@ -6821,6 +8618,217 @@ void ProtocolWithComputedFieldsReaderBase::CopyTo(ProtocolWithComputedFieldsWrit
   }
 }
 
+namespace {
+void ProtocolWithConstraintsWriterBaseInvalidState(uint8_t attempted, [[maybe_unused]] bool end, uint8_t current) {
+  std::string expected_method;
+  switch (current) {
+  case 0: expected_method = "WriteRecord()"; break;
+  case 1: expected_method = "WriteRecords() or EndRecords()"; break;
+  }
+  std::string attempted_method;
+  switch (attempted) {
+  case 0: attempted_method = "WriteRecord()"; break;
+  case 1: attempted_method = end ? "EndRecords()" : "WriteRecords()"; break;
+  case 2: attempted_method = "Close()"; break;
+  }
+  throw std::runtime_error("Expected call to " + expected_method + " but received call to " + attempted_method + " instead.");
+}
+
+void ProtocolWithConstraintsReaderBaseInvalidState(uint8_t attempted, uint8_t current) {
+  auto f = [](uint8_t i) -> std::string {
+    switch (i/2) {
+    case 0: return "ReadRecord()";
+    case 1: return "ReadRecords()";
+    case 2: return "Close()";
+    default: return "<unknown>";
+    }
+  };
+  throw std::runtime_error("Expected call to " + f(current) + " but received call to " + f(attempted) + " instead.");
+}
+
+} // namespace 
+
+std::string ProtocolWithConstraintsWriterBase::schema_ = R"({"protocol":{"name":"ProtocolWithConstraints","sequence":[{"name":"record","type":"TestModel.RecordWithConstraints"},{"name":"records","type":{"stream":{"items":"TestModel.RecordWithConstrainedRecords"}}}]},"types":[{"name":"RecordWithConstrainedRecords","fields":[{"name":"single","type":"TestModel.RecordWithConstraints"},{"name":"optional","type":[null,"TestModel.RecordWithConstraints"]},{"name":"vector","type":{"vector":{"items":"TestModel.RecordWithConstraints"}}}]},{"name":"RecordWithConstraints","fields":[{"name":"size","type":"uint32"},{"name":"gain","type":"float32"},{"name":"name","type":"string"},{"name":"limit","type":[null,"int32"]},{"name":"samples","type":{"vector":{"items":"int32"}}}]}]})";
+
+std::vector<std::string> ProtocolWithConstraintsWriterBase::previous_schemas_ = {
+};
+
+std::string ProtocolWithConstraintsWriterBase::SchemaFromVersion(Version version) {
+  switch (version) {
+  case Version::Current: return ProtocolWithConstraintsWriterBase::schema_; break;
+  default: throw std::runtime_error("The version does not correspond to any schema supported by protocol ProtocolWithConstraints.");
+  }
+
+}
+void ProtocolWithConstraintsWriterBase::WriteRecord(test_model::RecordWithConstraints const& value) {
+  if (unlikely(state_ != 0)) {
+    ProtocolWithConstraintsWriterBaseInvalidState(0, false, state_);
+  }
+
+  if (validate_constraints_) {
+    value.Validate();
+  }
+
+  WriteRecordImpl(value);
+  state_ = 1;
+}
+
+void ProtocolWithConstraintsWriterBase::WriteRecords(test_model::RecordWithConstrainedRecords const& value) {
+  if (unlikely(state_ != 1)) {
+    ProtocolWithConstraintsWriterBaseInvalidState(1, false, state_);
+  }
+
+  if (validate_constraints_) {
+    value.Validate();
+  }
+
+  WriteRecordsImpl(value);
+}
+
+void ProtocolWithConstraintsWriterBase::WriteRecords(std::vector<test_model::RecordWithConstrainedRecords> const& values) {
+  if (unlikely(state_ != 1)) {
+    ProtocolWithConstraintsWriterBaseInvalidState(1, false, state_);
+  }
+
+  if (validate_constraints_) {
+    for (auto const& value : values) {
+      value.Validate();
+    }
+  }
+
+  WriteRecordsImpl(values);
+}
+
+void ProtocolWithConstraintsWriterBase::EndRecords() {
+  if (unlikely(state_ != 1)) {
+    ProtocolWithConstraintsWriterBaseInvalidState(1, true, state_);
+  }
+
+  EndRecordsImpl();
+  state_ = 2;
+}
+
+// fallback implementation
+void ProtocolWithConstraintsWriterBase::WriteRecordsImpl(std::vector<test_model::RecordWithConstrainedRecords> const& values) {
+  for (auto const& v : values) {
+    WriteRecordsImpl(v);
+  }
+}
+
+void ProtocolWithConstraintsWriterBase::Close() {
+  if (unlikely(state_ != 2)) {
+    ProtocolWithConstraintsWriterBaseInvalidState(2, false, state_);
+  }
+
+  CloseImpl();
+}
+
+std::string ProtocolWithConstraintsReaderBase::schema_ = ProtocolWithConstraintsWriterBase::schema_;
+
+std::vector<std::string> ProtocolWithConstraintsReaderBase::previous_schemas_ = ProtocolWithConstraintsWriterBase::previous_schemas_;
+
+Version ProtocolWithConstraintsReaderBase::VersionFromSchema(std::string const& schema) {
+  if (schema == ProtocolWithConstraintsWriterBase::schema_) {
+    return Version::Current;
+  }
+  throw std::runtime_error("The schema does not match any version supported by protocol ProtocolWithConstraints.");
+}
+void ProtocolWithConstraintsReaderBase::ReadRecord(test_model::RecordWithConstraints& value) {
+  if (unlikely(state_ != 0)) {
+    ProtocolWithConstraintsReaderBaseInvalidState(0, state_);
+  }
+
+  ReadRecordImpl(value);
+  state_ = 2;
+}
+
+bool ProtocolWithConstraintsReaderBase::ReadRecords(test_model::RecordWithConstrainedRecords& value) {
+  if (unlikely(state_ != 2)) {
+    if (state_ == 3) {
+      state_ = 4;
+      return false;
+    }
+    ProtocolWithConstraintsReaderBaseInvalidState(2, state_);
+  }
+
+  bool result = ReadRecordsImpl(value);
+  if (!result) {
+    state_ = 4;
+  }
+  return result;
+}
+
+bool ProtocolWithConstraintsReaderBase::ReadRecords(std::vector<test_model::RecordWithConstrainedRecords>& values) {
+  if (values.capacity() == 0) {
+    throw std::runtime_error("vector must have a nonzero capacity.");
+  }
+  if (unlikely(state_ != 2)) {
+    if (state_ == 3) {
+      state_ = 4;
+      values.clear();
+      return false;
+    }
+    ProtocolWithConstraintsReaderBaseInvalidState(2, state_);
+  }
+
+  if (!ReadRecordsImpl(values)) {
+    state_ = 3;
+    return values.size() > 0;
+  }
+  return true;
+}
+
+// fallback implementation
+bool ProtocolWithConstraintsReaderBase::ReadRecordsImpl(std::vector<test_model::RecordWithConstrainedRecords>& values) {
+  size_t i = 0;
+  while (true) {
+    if (i == values.size()) {
+      values.resize(i + 1);
+    }
+    if (!ReadRecordsImpl(values[i])) {
+      values.resize(i);
+      return false;
+    }
+    i++;
+    if (i == values.capacity()) {
+      return true;
+    }
+  }
+}
+
+void ProtocolWithConstraintsReaderBase::Close() {
+  if (!skip_completed_check_ && unlikely(state_ != 4)) {
+    if (state_ == 3) {
+      state_ = 4;
+    } else {
+      ProtocolWithConstraintsReaderBaseInvalidState(4, state_);
+    }
+  }
+
+  CloseImpl();
+}
+void ProtocolWithConstraintsReaderBase::CopyTo(ProtocolWithConstraintsWriterBase& writer, size_t records_buffer_size) {
+  {
+    test_model::RecordWithConstraints value;
+    ReadRecord(value);
+    writer.WriteRecord(value);
+  }
+  if (records_buffer_size > 1) {
+    std::vector<test_model::RecordWithConstrainedRecords> values;
+    values.reserve(records_buffer_size);
+    while(ReadRecords(values)) {
+      writer.WriteRecords(values);
+    }
+    writer.EndRecords();
+  } else {
+    test_model::RecordWithConstrainedRecords value;
+    while(ReadRecords(value)) {
+      writer.WriteRecords(value);
+    }
+    writer.EndRecords();
+  }
+}
+
 namespace {
 void ProtocolWithKeywordStepsWriterBaseInvalidState(uint8_t attempted, [[maybe_unused]] bool end, uint8_t current) {
   std::string expected_method;
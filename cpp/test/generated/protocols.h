@@ -815,6 +815,670 @@ class StringsReaderBase {
   uint8_t state_ = 0;
 };
 
+// Abstract writer for the ProtocolWithBytes protocol.
+class ProtocolWithBytesWriterBase {
+  public:
+  // Ordinal 0.
+  void WriteSingleBytes(std::vector<std::byte> const& value);
+
+  // Ordinal 1.
+  void WriteRecWithBytes(test_model::RecordWithBytes const& value);
+
+  // Optionaly close this writer before destructing. Validates that all steps were completed.
+  void Close();
+
+  virtual ~ProtocolWithBytesWriterBase() = default;
+
+  // Flushes all buffered data.
+  virtual void Flush() {}
+
+  protected:
+  virtual void WriteSingleBytesImpl(std::vector<std::byte> const& value) = 0;
+  virtual void WriteRecWithBytesImpl(test_model::RecordWithBytes const& value) = 0;
+  virtual void CloseImpl() {}
+
+  static std::string schema_;
+
+  static std::vector<std::string> previous_schemas_;
+
+  static std::string SchemaFromVersion(Version version);
+
+  private:
+  uint8_t state_ = 0;
+
+  friend class ProtocolWithBytesReaderBase;
+};
+
+// Abstract reader for the ProtocolWithBytes protocol.
+class ProtocolWithBytesReaderBase {
+  public:
+  ProtocolWithBytesReaderBase(bool skip_completed_check = false): skip_completed_check_(skip_completed_check) {}
+
+  // Ordinal 0.
+  void ReadSingleBytes(std::vector<std::byte>& value);
+
+  // Ordinal 1.
+  void ReadRecWithBytes(test_model::RecordWithBytes& value);
+
+  // Optionaly close this writer before destructing. Validates that all steps were completely read.
+  void Close();
+
+  void CopyTo(ProtocolWithBytesWriterBase& writer);
+
+  virtual ~ProtocolWithBytesReaderBase() = default;
+
+  protected:
+  virtual void ReadSingleBytesImpl(std::vector<std::byte>& value) = 0;
+  virtual void ReadRecWithBytesImpl(test_model::RecordWithBytes& value) = 0;
+  virtual void CloseImpl() {}
+  static std::string schema_;
+
+  static std::vector<std::string> previous_schemas_;
+
+  static Version VersionFromSchema(const std::string& schema);
+
+  bool skip_completed_check_;
+
+  private:
+  uint8_t state_ = 0;
+};
+
+// Abstract writer for the ProtocolWithUuids protocol.
+class ProtocolWithUuidsWriterBase {
+  public:
+  // Ordinal 0.
+  void WriteSingleUuid(yardl::Uuid const& value);
+
+  // Ordinal 1.
+  void WriteRecWithUuids(test_model::RecordWithUuids const& value);
+
+  // Optionaly close this writer before destructing. Validates that all steps were completed.
+  void Close();
+
+  virtual ~ProtocolWithUuidsWriterBase() = default;
+
+  // Flushes all buffered data.
+  virtual void Flush() {}
+
+  protected:
+  virtual void WriteSingleUuidImpl(yardl::Uuid const& value) = 0;
+  virtual void WriteRecWithUuidsImpl(test_model::RecordWithUuids const& value) = 0;
+  virtual void CloseImpl() {}
+
+  static std::string schema_;
+
+  static std::vector<std::string> previous_schemas_;
+
+  static std::string SchemaFromVersion(Version version);
+
+  private:
+  uint8_t state_ = 0;
+
+  friend class ProtocolWithUuidsReaderBase;
+};
+
+// Abstract reader for the ProtocolWithUuids protocol.
+class ProtocolWithUuidsReaderBase {
+  public:
+  ProtocolWithUuidsReaderBase(bool skip_completed_check = false): skip_completed_check_(skip_completed_check) {}
+
+  // Ordinal 0.
+  void ReadSingleUuid(yardl::Uuid& value);
+
+  // Ordinal 1.
+  void ReadRecWithUuids(test_model::RecordWithUuids& value);
+
+  // Optionaly close this writer before destructing. Validates that all steps were completely read.
+  void Close();
+
+  void CopyTo(ProtocolWithUuidsWriterBase& writer);
+
+  virtual ~ProtocolWithUuidsReaderBase() = default;
+
+  protected:
+  virtual void ReadSingleUuidImpl(yardl::Uuid& value) = 0;
+  virtual void ReadRecWithUuidsImpl(test_model::RecordWithUuids& value) = 0;
+  virtual void CloseImpl() {}
+  static std::string schema_;
+
+  static std::vector<std::string> previous_schemas_;
+
+  static Version VersionFromSchema(const std::string& schema);
+
+  bool skip_completed_check_;
+
+  private:
+  uint8_t state_ = 0;
+};
+
+// Abstract writer for the ProtocolWithDurations protocol.
+class ProtocolWithDurationsWriterBase {
+  public:
+  // Ordinal 0.
+  void WriteSingleDuration(yardl::Duration const& value);
+
+  // Ordinal 1.
+  void WriteRecWithDurations(test_model::RecordWithDurations const& value);
+
+  // Optionaly close this writer before destructing. Validates that all steps were completed.
+  void Close();
+
+  virtual ~ProtocolWithDurationsWriterBase() = default;
+
+  // Flushes all buffered data.
+  virtual void Flush() {}
+
+  protected:
+  virtual void WriteSingleDurationImpl(yardl::Duration const& value) = 0;
+  virtual void WriteRecWithDurationsImpl(test_model::RecordWithDurations const& value) = 0;
+  virtual void CloseImpl() {}
+
+  static std::string schema_;
+
+  static std::vector<std::string> previous_schemas_;
+
+  static std::string SchemaFromVersion(Version version);
+
+  private:
+  uint8_t state_ = 0;
+
+  friend class ProtocolWithDurationsReaderBase;
+};
+
+// Abstract reader for the ProtocolWithDurations protocol.
+class ProtocolWithDurationsReaderBase {
+  public:
+  ProtocolWithDurationsReaderBase(bool skip_completed_check = false): skip_completed_check_(skip_completed_check) {}
+
+  // Ordinal 0.
+  void ReadSingleDuration(yardl::Duration& value);
+
+  // Ordinal 1.
+  void ReadRecWithDurations(test_model::RecordWithDurations& value);
+
+  // Optionaly close this writer before destructing. Validates that all steps were completely read.
+  void Close();
+
+  void CopyTo(ProtocolWithDurationsWriterBase& writer);
+
+  virtual ~ProtocolWithDurationsReaderBase() = default;
+
+  protected:
+  virtual void ReadSingleDurationImpl(yardl::Duration& value) = 0;
+  virtual void ReadRecWithDurationsImpl(test_model::RecordWithDurations& value) = 0;
+  virtual void CloseImpl() {}
+  static std::string schema_;
+
+  static std::vector<std::string> previous_schemas_;
+
+  static Version VersionFromSchema(const std::string& schema);
+
+  bool skip_completed_check_;
+
+  private:
+  uint8_t state_ = 0;
+};
+
+// Abstract writer for the ProtocolWithRecursiveRecords protocol.
+class ProtocolWithRecursiveRecordsWriterBase {
+  public:
+  // Ordinal 0.
+  void WriteTree(test_model::TreeNode const& value);
+
+  // Ordinal 1.
+  void WriteList(test_model::LinkedListNode const& value);
+
+  // Ordinal 2.
+  // Call this method for each element of the `expressions` stream, then call `EndExpressions() when done.`
+  void WriteExpressions(test_model::Expression const& value);
+
+  // Ordinal 2.
+  // Call this method to write many values to the `expressions` stream, then call `EndExpressions()` when done.
+  void WriteExpressions(std::vector<test_model::Expression> const& values);
+
+  // Marks the end of the `expressions` stream.
+  void EndExpressions();
+
+  // Optionaly close this writer before destructing. Validates that all steps were completed.
+  void Close();
+
+  virtual ~ProtocolWithRecursiveRecordsWriterBase() = default;
+
+  // Flushes all buffered data.
+  virtual void Flush() {}
+
+  protected:
+  virtual void WriteTreeImpl(test_model::TreeNode const& value) = 0;
+  virtual void WriteListImpl(test_model::LinkedListNode const& value) = 0;
+  virtual void WriteExpressionsImpl(test_model::Expression const& value) = 0;
+  virtual void WriteExpressionsImpl(std::vector<test_model::Expression> const& value);
+  virtual void EndExpressionsImpl() = 0;
+  virtual void CloseImpl() {}
+
+  static std::string schema_;
+
+  static std::vector<std::string> previous_schemas_;
+
+  static std::string SchemaFromVersion(Version version);
+
+  private:
+  uint8_t state_ = 0;
+
+  friend class ProtocolWithRecursiveRecordsReaderBase;
+};
+
+// Abstract reader for the ProtocolWithRecursiveRecords protocol.
+class ProtocolWithRecursiveRecordsReaderBase {
+  public:
+  ProtocolWithRecursiveRecordsReaderBase(bool skip_completed_check = false): skip_completed_check_(skip_completed_check) {}
+
+  // Ordinal 0.
+  void ReadTree(test_model::TreeNode& value);
+
+  // Ordinal 1.
+  void ReadList(test_model::LinkedListNode& value);
+
+  // Ordinal 2.
+  [[nodiscard]] bool ReadExpressions(test_model::Expression& value);
+
+  // Ordinal 2.
+  [[nodiscard]] bool ReadExpressions(std::vector<test_model::Expression>& values);
+
+  // Optionaly close this writer before destructing. Validates that all steps were completely read.
+  void Close();
+
+  void CopyTo(ProtocolWithRecursiveRecordsWriterBase& writer, size_t expressions_buffer_size = 1);
+
+  virtual ~ProtocolWithRecursiveRecordsReaderBase() = default;
+
+  protected:
+  virtual void ReadTreeImpl(test_model::TreeNode& value) = 0;
+  virtual void ReadListImpl(test_model::LinkedListNode& value) = 0;
+  virtual bool ReadExpressionsImpl(test_model::Expression& value) = 0;
+  virtual bool ReadExpressionsImpl(std::vector<test_model::Expression>& values);
+  virtual void CloseImpl() {}
+  static std::string schema_;
+
+  static std::vector<std::string> previous_schemas_;
+
+  static Version VersionFromSchema(const std::string& schema);
+
+  bool skip_completed_check_;
+
+  private:
+  uint8_t state_ = 0;
+};
+
+// Abstract writer for the Calibration protocol.
+// A calibration phase shared by protocols
+class CalibrationWriterBase {
+  public:
+  // Ordinal 0.
+  void WriteGain(float const& value);
+
+  // Ordinal 1.
+  // Call this method for each element of the `samples` stream, then call `EndSamples() when done.`
+  void WriteSamples(double const& value);
+
+  // Ordinal 1.
+  // Call this method to write many values to the `samples` stream, then call `EndSamples()` when done.
+  void WriteSamples(std::vector<double> const& values);
+
+  // Marks the end of the `samples` stream.
+  void EndSamples();
+
+  // Optionaly close this writer before destructing. Validates that all steps were completed.
+  void Close();
+
+  virtual ~CalibrationWriterBase() = default;
+
+  // Flushes all buffered data.
+  virtual void Flush() {}
+
+  protected:
+  virtual void WriteGainImpl(float const& value) = 0;
+  virtual void WriteSamplesImpl(double const& value) = 0;
+  virtual void WriteSamplesImpl(std::vector<double> const& value);
+  virtual void EndSamplesImpl() = 0;
+  virtual void CloseImpl() {}
+
+  static std::string schema_;
+
+  static std::vector<std::string> previous_schemas_;
+
+  static std::string SchemaFromVersion(Version version);
+
+  private:
+  uint8_t state_ = 0;
+
+  friend class CalibrationReaderBase;
+};
+
+// Abstract reader for the Calibration protocol.
+// A calibration phase shared by protocols
+class CalibrationReaderBase {
+  public:
+  CalibrationReaderBase(bool skip_completed_check = false): skip_completed_check_(skip_completed_check) {}
+
+  // Ordinal 0.
+  void ReadGain(float& value);
+
+  // Ordinal 1.
+  [[nodiscard]] bool ReadSamples(double& value);
+
+  // Ordinal 1.
+  [[nodiscard]] bool ReadSamples(std::vector<double>& values);
+
+  // Optionaly close this writer before destructing. Validates that all steps were completely read.
+  void Close();
+
+  void CopyTo(CalibrationWriterBase& writer, size_t samples_buffer_size = 1);
+
+  virtual ~CalibrationReaderBase() = default;
+
+  protected:
+  virtual void ReadGainImpl(float& value) = 0;
+  virtual bool ReadSamplesImpl(double& value) = 0;
+  virtual bool ReadSamplesImpl(std::vector<double>& values);
+  virtual void CloseImpl() {}
+  static std::string schema_;
+
+  static std::vector<std::string> previous_schemas_;
+
+  static Version VersionFromSchema(const std::string& schema);
+
+  bool skip_completed_check_;
+
+  private:
+  uint8_t state_ = 0;
+};
+
+// Abstract writer for the ProtocolWithSubProtocols protocol.
+class ProtocolWithSubProtocolsWriterBase {
+  public:
+  // Ordinal 0.
+  void WriteHeader(std::string const& value);
+
+  // Ordinal 1.
+  void WriteCalibrationGain(float const& value);
+
+  // Ordinal 2.
+  // Call this method for each element of the `calibrationSamples` stream, then call `EndCalibrationSamples() when done.`
+  void WriteCalibrationSamples(double const& value);
+
+  // Ordinal 2.
+  // Call this method to write many values to the `calibrationSamples` stream, then call `EndCalibrationSamples()` when done.
+  void WriteCalibrationSamples(std::vector<double> const& values);
+
+  // Marks the end of the `calibrationSamples` stream.
+  void EndCalibrationSamples();
+
+  // Ordinal 3.
+  // Call this method for each element of the `data` stream, then call `EndData() when done.`
+  void WriteData(int32_t const& value);
+
+  // Ordinal 3.
+  // Call this method to write many values to the `data` stream, then call `EndData()` when done.
+  void WriteData(std::vector<int32_t> const& values);
+
+  // Marks the end of the `data` stream.
+  void EndData();
+
+  // Ordinal 4.
+  void WriteRecalibrationGain(float const& value);
+
+  // Ordinal 5.
+  // Call this method for each element of the `recalibrationSamples` stream, then call `EndRecalibrationSamples() when done.`
+  void WriteRecalibrationSamples(double const& value);
+
+  // Ordinal 5.
+  // Call this method to write many values to the `recalibrationSamples` stream, then call `EndRecalibrationSamples()` when done.
+  void WriteRecalibrationSamples(std::vector<double> const& values);
+
+  // Marks the end of the `recalibrationSamples` stream.
+  void EndRecalibrationSamples();
+
+  // Optionaly close this writer before destructing. Validates that all steps were completed.
+  void Close();
+
+  virtual ~ProtocolWithSubProtocolsWriterBase() = default;
+
+  // Flushes all buffered data.
+  virtual void Flush() {}
+
+  // Returns a writer for the `calibration` steps, which are written through this writer.
+  // Calibration before the data
+  CalibrationWriterBase& Calibration() { return calibration_sub_writer_; }
+
+  // Returns a writer for the `recalibration` steps, which are written through this writer.
+  CalibrationWriterBase& Recalibration() { return recalibration_sub_writer_; }
+
+  protected:
+  virtual void WriteHeaderImpl(std::string const& value) = 0;
+  virtual void WriteCalibrationGainImpl(float const& value) = 0;
+  virtual void WriteCalibrationSamplesImpl(double const& value) = 0;
+  virtual void WriteCalibrationSamplesImpl(std::vector<double> const& value);
+  virtual void EndCalibrationSamplesImpl() = 0;
+  virtual void WriteDataImpl(int32_t const& value) = 0;
+  virtual void WriteDataImpl(std::vector<int32_t> const& value);
+  virtual void EndDataImpl() = 0;
+  virtual void WriteRecalibrationGainImpl(float const& value) = 0;
+  virtual void WriteRecalibrationSamplesImpl(double const& value) = 0;
+  virtual void WriteRecalibrationSamplesImpl(std::vector<double> const& value);
+  virtual void EndRecalibrationSamplesImpl() = 0;
+  virtual void CloseImpl() {}
+
+  static std::string schema_;
+
+  static std::vector<std::string> previous_schemas_;
+
+  static std::string SchemaFromVersion(Version version);
+
+  private:
+  uint8_t state_ = 0;
+
+  class CalibrationSubWriter : public CalibrationWriterBase {
+    public:
+    CalibrationSubWriter(ProtocolWithSubProtocolsWriterBase& outer) : outer_(outer) {}
+
+    protected:
+    void WriteGainImpl(float const& value) override { outer_.WriteCalibrationGain(value); }
+    void WriteSamplesImpl(double const& value) override { outer_.WriteCalibrationSamples(value); }
+    void WriteSamplesImpl(std::vector<double> const& values) override { outer_.WriteCalibrationSamples(values); }
+    void EndSamplesImpl() override { outer_.EndCalibrationSamples(); }
+
+    private:
+    ProtocolWithSubProtocolsWriterBase& outer_;
+  };
+
+  CalibrationSubWriter calibration_sub_writer_{*this};
+
+  class RecalibrationSubWriter : public CalibrationWriterBase {
+    public:
+    RecalibrationSubWriter(ProtocolWithSubProtocolsWriterBase& outer) : outer_(outer) {}
+
+    protected:
+    void WriteGainImpl(float const& value) override { outer_.WriteRecalibrationGain(value); }
+    void WriteSamplesImpl(double const& value) override { outer_.WriteRecalibrationSamples(value); }
+    void WriteSamplesImpl(std::vector<double> const& values) override { outer_.WriteRecalibrationSamples(values); }
+    void EndSamplesImpl() override { outer_.EndRecalibrationSamples(); }
+
+    private:
+    ProtocolWithSubProtocolsWriterBase& outer_;
+  };
+
+  RecalibrationSubWriter recalibration_sub_writer_{*this};
+
+  friend class ProtocolWithSubProtocolsReaderBase;
+};
+
+// Abstract reader for the ProtocolWithSubProtocols protocol.
+class ProtocolWithSubProtocolsReaderBase {
+  public:
+  ProtocolWithSubProtocolsReaderBase(bool skip_completed_check = false): skip_completed_check_(skip_completed_check) {}
+
+  // Ordinal 0.
+  void ReadHeader(std::string& value);
+
+  // Ordinal 1.
+  void ReadCalibrationGain(float& value);
+
+  // Ordinal 2.
+  [[nodiscard]] bool ReadCalibrationSamples(double& value);
+
+  // Ordinal 2.
+  [[nodiscard]] bool ReadCalibrationSamples(std::vector<double>& values);
+
+  // Ordinal 3.
+  [[nodiscard]] bool ReadData(int32_t& value);
+
+  // Ordinal 3.
+  [[nodiscard]] bool ReadData(std::vector<int32_t>& values);
+
+  // Ordinal 4.
+  void ReadRecalibrationGain(float& value);
+
+  // Ordinal 5.
+  [[nodiscard]] bool ReadRecalibrationSamples(double& value);
+
+  // Ordinal 5.
+  [[nodiscard]] bool ReadRecalibrationSamples(std::vector<double>& values);
+
+  // Optionaly close this writer before destructing. Validates that all steps were completely read.
+  void Close();
+
+  void CopyTo(ProtocolWithSubProtocolsWriterBase& writer, size_t calibration_samples_buffer_size = 1, size_t data_buffer_size = 1, size_t recalibration_samples_buffer_size = 1);
+
+  virtual ~ProtocolWithSubProtocolsReaderBase() = default;
+
+  // Returns a reader for the `calibration` steps, which are read through this reader.
+  // Calibration before the data
+  CalibrationReaderBase& Calibration() { return calibration_sub_reader_; }
+
+  // Returns a reader for the `recalibration` steps, which are read through this reader.
+  CalibrationReaderBase& Recalibration() { return recalibration_sub_reader_; }
+
+  protected:
+  virtual void ReadHeaderImpl(std::string& value) = 0;
+  virtual void ReadCalibrationGainImpl(float& value) = 0;
+  virtual bool ReadCalibrationSamplesImpl(double& value) = 0;
+  virtual bool ReadCalibrationSamplesImpl(std::vector<double>& values);
+  virtual bool ReadDataImpl(int32_t& value) = 0;
+  virtual bool ReadDataImpl(std::vector<int32_t>& values);
+  virtual void ReadRecalibrationGainImpl(float& value) = 0;
+  virtual bool ReadRecalibrationSamplesImpl(double& value) = 0;
+  virtual bool ReadRecalibrationSamplesImpl(std::vector<double>& values);
+  virtual void CloseImpl() {}
+  static std::string schema_;
+
+  static std::vector<std::string> previous_schemas_;
+
+  static Version VersionFromSchema(const std::string& schema);
+
+  bool skip_completed_check_;
+
+  private:
+  uint8_t state_ = 0;
+
+  class CalibrationSubReader : public CalibrationReaderBase {
+    public:
+    CalibrationSubReader(ProtocolWithSubProtocolsReaderBase& outer) : outer_(outer) {}
+
+    protected:
+    void ReadGainImpl(float& value) override { outer_.ReadCalibrationGain(value); }
+    bool ReadSamplesImpl(double& value) override { return outer_.ReadCalibrationSamples(value); }
+    bool ReadSamplesImpl(std::vector<double>& values) override { return outer_.ReadCalibrationSamples(values); }
+
+    private:
+    ProtocolWithSubProtocolsReaderBase& outer_;
+  };
+
+  CalibrationSubReader calibration_sub_reader_{*this};
+
+  class RecalibrationSubReader : public CalibrationReaderBase {
+    public:
+    RecalibrationSubReader(ProtocolWithSubProtocolsReaderBase& outer) : outer_(outer) {}
+
+    protected:
+    void ReadGainImpl(float& value) override { outer_.ReadRecalibrationGain(value); }
+    bool ReadSamplesImpl(double& value) override { return outer_.ReadRecalibrationSamples(value); }
+    bool ReadSamplesImpl(std::vector<double>& values) override { return outer_.ReadRecalibrationSamples(values); }
+
+    private:
+    ProtocolWithSubProtocolsReaderBase& outer_;
+  };
+
+  RecalibrationSubReader recalibration_sub_reader_{*this};
+};
+
+// Abstract writer for the ProtocolWithHalfPrecision protocol.
+class ProtocolWithHalfPrecisionWriterBase {
+  public:
+  // Ordinal 0.
+  void WriteHalves(std::vector<yardl::Float16> const& value);
+
+  // Ordinal 1.
+  void WriteRecWithHalves(test_model::RecordWithHalfPrecision const& value);
+
+  // Optionaly close this writer before destructing. Validates that all steps were completed.
+  void Close();
+
+  virtual ~ProtocolWithHalfPrecisionWriterBase() = default;
+
+  // Flushes all buffered data.
+  virtual void Flush() {}
+
+  protected:
+  virtual void WriteHalvesImpl(std::vector<yardl::Float16> const& value) = 0;
+  virtual void WriteRecWithHalvesImpl(test_model::RecordWithHalfPrecision const& value) = 0;
+  virtual void CloseImpl() {}
+
+  static std::string schema_;
+
+  static std::vector<std::string> previous_schemas_;
+
+  static std::string SchemaFromVersion(Version version);
+
+  private:
+  uint8_t state_ = 0;
+
+  friend class ProtocolWithHalfPrecisionReaderBase;
+};
+
+// Abstract reader for the ProtocolWithHalfPrecision protocol.
+class ProtocolWithHalfPrecisionReaderBase {
+  public:
+  ProtocolWithHalfPrecisionReaderBase(bool skip_completed_check = false): skip_completed_check_(skip_completed_check) {}
+
+  // Ordinal 0.
+  void ReadHalves(std::vector<yardl::Float16>& value);
+
+  // Ordinal 1.
+  void ReadRecWithHalves(test_model::RecordWithHalfPrecision& value);
+
+  // Optionaly close this writer before destructing. Validates that all steps were completely read.
+  void Close();
+
+  void CopyTo(ProtocolWithHalfPrecisionWriterBase& writer);
+
+  virtual ~ProtocolWithHalfPrecisionReaderBase() = default;
+
+  protected:
+  virtual void ReadHalvesImpl(std::vector<yardl::Float16>& value) = 0;
+  virtual void ReadRecWithHalvesImpl(test_model::RecordWithHalfPrecision& value) = 0;
+  virtual void CloseImpl() {}
+  static std::string schema_;
+
+  static std::vector<std::string> previous_schemas_;
+
+  static Version VersionFromSchema(const std::string& schema);
+
+  bool skip_completed_check_;
+
+  private:
+  uint8_t state_ = 0;
+};
+
 // Abstract writer for the OptionalVectors protocol.
 class OptionalVectorsWriterBase {
   public:
@@ -2609,6 +3273,190 @@ class AdvancedGenericsReaderBase {
   uint8_t state_ = 0;
 };
 
+// Abstract writer for the FloatImageStream protocol.
+// A protocol that is instantiated for each pixel type
+class FloatImageStreamWriterBase {
+  public:
+  // Ordinal 0.
+  void WriteHeader(test_model::GenericRecord<int32_t, float> const& value);
+
+  // Ordinal 1.
+  // Call this method for each element of the `images` stream, then call `EndImages() when done.`
+  void WriteImages(test_model::Image<float> const& value);
+
+  // Ordinal 1.
+  // Call this method to write many values to the `images` stream, then call `EndImages()` when done.
+  void WriteImages(std::vector<test_model::Image<float>> const& values);
+
+  // Marks the end of the `images` stream.
+  void EndImages();
+
+  // Ordinal 2.
+  void WriteBackground(std::variant<float, std::string> const& value);
+
+  // Optionaly close this writer before destructing. Validates that all steps were completed.
+  void Close();
+
+  virtual ~FloatImageStreamWriterBase() = default;
+
+  // Flushes all buffered data.
+  virtual void Flush() {}
+
+  protected:
+  virtual void WriteHeaderImpl(test_model::GenericRecord<int32_t, float> const& value) = 0;
+  virtual void WriteImagesImpl(test_model::Image<float> const& value) = 0;
+  virtual void WriteImagesImpl(std::vector<test_model::Image<float>> const& value);
+  virtual void EndImagesImpl() = 0;
+  virtual void WriteBackgroundImpl(std::variant<float, std::string> const& value) = 0;
+  virtual void CloseImpl() {}
+
+  static std::string schema_;
+
+  static std::vector<std::string> previous_schemas_;
+
+  static std::string SchemaFromVersion(Version version);
+
+  private:
+  uint8_t state_ = 0;
+
+  friend class FloatImageStreamReaderBase;
+};
+
+// Abstract reader for the FloatImageStream protocol.
+// A protocol that is instantiated for each pixel type
+class FloatImageStreamReaderBase {
+  public:
+  FloatImageStreamReaderBase(bool skip_completed_check = false): skip_completed_check_(skip_completed_check) {}
+
+  // Ordinal 0.
+  void ReadHeader(test_model::GenericRecord<int32_t, float>& value);
+
+  // Ordinal 1.
+  [[nodiscard]] bool ReadImages(test_model::Image<float>& value);
+
+  // Ordinal 1.
+  [[nodiscard]] bool ReadImages(std::vector<test_model::Image<float>>& values);
+
+  // Ordinal 2.
+  void ReadBackground(std::variant<float, std::string>& value);
+
+  // Optionaly close this writer before destructing. Validates that all steps were completely read.
+  void Close();
+
+  void CopyTo(FloatImageStreamWriterBase& writer, size_t images_buffer_size = 1);
+
+  virtual ~FloatImageStreamReaderBase() = default;
+
+  protected:
+  virtual void ReadHeaderImpl(test_model::GenericRecord<int32_t, float>& value) = 0;
+  virtual bool ReadImagesImpl(test_model::Image<float>& value) = 0;
+  virtual bool ReadImagesImpl(std::vector<test_model::Image<float>>& values);
+  virtual void ReadBackgroundImpl(std::variant<float, std::string>& value) = 0;
+  virtual void CloseImpl() {}
+  static std::string schema_;
+
+  static std::vector<std::string> previous_schemas_;
+
+  static Version VersionFromSchema(const std::string& schema);
+
+  bool skip_completed_check_;
+
+  private:
+  uint8_t state_ = 0;
+};
+
+// Abstract writer for the ComplexImageStream protocol.
+// A protocol that is instantiated for each pixel type
+class ComplexImageStreamWriterBase {
+  public:
+  // Ordinal 0.
+  void WriteHeader(test_model::GenericRecord<int32_t, std::complex<float>> const& value);
+
+  // Ordinal 1.
+  // Call this method for each element of the `images` stream, then call `EndImages() when done.`
+  void WriteImages(test_model::Image<std::complex<float>> const& value);
+
+  // Ordinal 1.
+  // Call this method to write many values to the `images` stream, then call `EndImages()` when done.
+  void WriteImages(std::vector<test_model::Image<std::complex<float>>> const& values);
+
+  // Marks the end of the `images` stream.
+  void EndImages();
+
+  // Ordinal 2.
+  void WriteBackground(std::variant<std::complex<float>, std::string> const& value);
+
+  // Optionaly close this writer before destructing. Validates that all steps were completed.
+  void Close();
+
+  virtual ~ComplexImageStreamWriterBase() = default;
+
+  // Flushes all buffered data.
+  virtual void Flush() {}
+
+  protected:
+  virtual void WriteHeaderImpl(test_model::GenericRecord<int32_t, std::complex<float>> const& value) = 0;
+  virtual void WriteImagesImpl(test_model::Image<std::complex<float>> const& value) = 0;
+  virtual void WriteImagesImpl(std::vector<test_model::Image<std::complex<float>>> const& value);
+  virtual void EndImagesImpl() = 0;
+  virtual void WriteBackgroundImpl(std::variant<std::complex<float>, std::string> const& value) = 0;
+  virtual void CloseImpl() {}
+
+  static std::string schema_;
+
+  static std::vector<std::string> previous_schemas_;
+
+  static std::string SchemaFromVersion(Version version);
+
+  private:
+  uint8_t state_ = 0;
+
+  friend class ComplexImageStreamReaderBase;
+};
+
+// Abstract reader for the ComplexImageStream protocol.
+// A protocol that is instantiated for each pixel type
+class ComplexImageStreamReaderBase {
+  public:
+  ComplexImageStreamReaderBase(bool skip_completed_check = false): skip_completed_check_(skip_completed_check) {}
+
+  // Ordinal 0.
+  void ReadHeader(test_model::GenericRecord<int32_t, std::complex<float>>& value);
+
+  // Ordinal 1.
+  [[nodiscard]] bool ReadImages(test_model::Image<std::complex<float>>& value);
+
+  // Ordinal 1.
+  [[nodiscard]] bool ReadImages(std::vector<test_model::Image<std::complex<float>>>& values);
+
+  // Ordinal 2.
+  void ReadBackground(std::variant<std::complex<float>, std::string>& value);
+
+  // Optionaly close this writer before destructing. Validates that all steps were completely read.
+  void Close();
+
+  void CopyTo(ComplexImageStreamWriterBase& writer, size_t images_buffer_size = 1);
+
+  virtual ~ComplexImageStreamReaderBase() = default;
+
+  protected:
+  virtual void ReadHeaderImpl(test_model::GenericRecord<int32_t, std::complex<float>>& value) = 0;
+  virtual bool ReadImagesImpl(test_model::Image<std::complex<float>>& value) = 0;
+  virtual bool ReadImagesImpl(std::vector<test_model::Image<std::complex<float>>>& values);
+  virtual void ReadBackgroundImpl(std::variant<std::complex<float>, std::string>& value) = 0;
+  virtual void CloseImpl() {}
+  static std::string schema_;
+
+  static std::vector<std::string> previous_schemas_;
+
+  static Version VersionFromSchema(const std::string& schema);
+
+  bool skip_completed_check_;
+
+  private:
+  uint8_t state_ = 0;
+};
+
 // Abstract writer for the Aliases protocol.
 class AliasesWriterBase {
   public:
@@ -2919,6 +3767,93 @@ class ProtocolWithComputedFieldsReaderBase {
   uint8_t state_ = 0;
 };
 
+// Abstract writer for the ProtocolWithConstraints protocol.
+class ProtocolWithConstraintsWriterBase {
+  public:
+  // Ordinal 0.
+  void WriteRecord(test_model::RecordWithConstraints const& value);
+
+  // Ordinal 1.
+  // Call this method for each element of the `records` stream, then call `EndRecords() when done.`
+  void WriteRecords(test_model::RecordWithConstrainedRecords const& value);
+
+  // Ordinal 1.
+  // Call this method to write many values to the `records` stream, then call `EndRecords()` when done.
+  void WriteRecords(std::vector<test_model::RecordWithConstrainedRecords> const& values);
+
+  // Marks the end of the `records` stream.
+  void EndRecords();
+
+  // Optionaly close this writer before destructing. Validates that all steps were completed.
+  void Close();
+
+  virtual ~ProtocolWithConstraintsWriterBase() = default;
+
+  // Flushes all buffered data.
+  virtual void Flush() {}
+
+  // Enables checking that values satisfy the constraints of their fields before they are written. Disabled by default.
+  void SetValidateConstraints(bool validate) { validate_constraints_ = validate; }
+
+  protected:
+  virtual void WriteRecordImpl(test_model::RecordWithConstraints const& value) = 0;
+  virtual void WriteRecordsImpl(test_model::RecordWithConstrainedRecords const& value) = 0;
+  virtual void WriteRecordsImpl(std::vector<test_model::RecordWithConstrainedRecords> const& value);
+  virtual void EndRecordsImpl() = 0;
+  virtual void CloseImpl() {}
+
+  static std::string schema_;
+
+  static std::vector<std::string> previous_schemas_;
+
+  static std::string SchemaFromVersion(Version version);
+
+  private:
+  uint8_t state_ = 0;
+
+  bool validate_constraints_ = false;
+
+  friend class ProtocolWithConstraintsReaderBase;
+};
+
+// Abstract reader for the ProtocolWithConstraints protocol.
+class ProtocolWithConstraintsReaderBase {
+  public:
+  ProtocolWithConstraintsReaderBase(bool skip_completed_check = false): skip_completed_check_(skip_completed_check) {}
+
+  // Ordinal 0.
+  void ReadRecord(test_model::RecordWithConstraints& value);
+
+  // Ordinal 1.
+  [[nodiscard]] bool ReadRecords(test_model::RecordWithConstrainedRecords& value);
+
+  // Ordinal 1.
+  [[nodiscard]] bool ReadRecords(std::vector<test_model::RecordWithConstrainedRecords>& values);
+
+  // Optionaly close this writer before destructing. Validates that all steps were completely read.
+  void Close();
+
+  void CopyTo(ProtocolWithConstraintsWriterBase& writer, size_t records_buffer_size = 1);
+
+  virtual ~ProtocolWithConstraintsReaderBase() = default;
+
+  protected:
+  virtual void ReadRecordImpl(test_model::RecordWithConstraints& value) = 0;
+  virtual bool ReadRecordsImpl(test_model::RecordWithConstrainedRecords& value) = 0;
+  virtual bool ReadRecordsImpl(std::vector<test_model::RecordWithConstrainedRecords>& values);
+  virtual void CloseImpl() {}
+  static std::string schema_;
+
+  static std::vector<std::string> previous_schemas_;
+
+  static Version VersionFromSchema(const std::string& schema);
+
+  bool skip_completed_check_;
+
+  private:
+  uint8_t state_ = 0;
+};
+
 // Abstract writer for the ProtocolWithKeywordSteps protocol.
 class ProtocolWithKeywordStepsWriterBase {
   public:
@@ -1,6 +1,7 @@
 // This file was generated by the "yardl" tool. DO NOT EDIT.
 
 #include <iostream>
+#include <memory>
 
 #include "../format.h"
 #include "binary/protocols.h"
@@ -8,398 +9,1529 @@
 #include "ndjson/protocols.h"
 
 namespace yardl::testing {
-void TranslateStream(std::string const& protocol_name, yardl::testing::Format input_format, std::istream& input, yardl::testing::Format output_format, std::ostream& output) {
-  switch (input_format) {
-  case yardl::testing::Format::kBinary:
-    break;
-  case yardl::testing::Format::kNDJson:
-    break;
-  default:
-    throw std::runtime_error("Unsupported input format");
-  }
-
+// HDF5 files cannot be streamed, so they are read from and written to the given paths instead.
+void TranslateStream(std::string const& protocol_name, yardl::testing::Format input_format, std::istream& input, std::string const& hdf5_input_path, yardl::testing::Format output_format, std::ostream& output, std::string const& hdf5_output_path) {
   if (protocol_name == "BenchmarkFloat256x256") {
-    auto reader = input_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::BenchmarkFloat256x256ReaderBase>(new test_model::binary::BenchmarkFloat256x256Reader(input))
-      : std::unique_ptr<test_model::BenchmarkFloat256x256ReaderBase>(new test_model::ndjson::BenchmarkFloat256x256Reader(input));
+    std::unique_ptr<test_model::BenchmarkFloat256x256ReaderBase> reader;
+    switch (input_format) {
+    case yardl::testing::Format::kBinary:
+      reader = std::make_unique<test_model::binary::BenchmarkFloat256x256Reader>(input);
+      break;
+    case yardl::testing::Format::kHdf5:
+      reader = std::make_unique<test_model::hdf5::BenchmarkFloat256x256Reader>(hdf5_input_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      reader = std::make_unique<test_model::ndjson::BenchmarkFloat256x256Reader>(input);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
+
+    std::unique_ptr<test_model::BenchmarkFloat256x256WriterBase> writer;
+    switch (output_format) {
+    case yardl::testing::Format::kBinary:
+      writer = std::make_unique<test_model::binary::BenchmarkFloat256x256Writer>(output);
+      break;
+    case yardl::testing::Format::kHdf5:
+      writer = std::make_unique<test_model::hdf5::BenchmarkFloat256x256Writer>(hdf5_output_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      writer = std::make_unique<test_model::ndjson::BenchmarkFloat256x256Writer>(output);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
 
-    auto writer = output_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::BenchmarkFloat256x256WriterBase>(new test_model::binary::BenchmarkFloat256x256Writer(output))
-      : std::unique_ptr<test_model::BenchmarkFloat256x256WriterBase>(new test_model::ndjson::BenchmarkFloat256x256Writer(output));
     reader->CopyTo(*writer);
     return;
   }
   if (protocol_name == "BenchmarkInt256x256") {
-    auto reader = input_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::BenchmarkInt256x256ReaderBase>(new test_model::binary::BenchmarkInt256x256Reader(input))
-      : std::unique_ptr<test_model::BenchmarkInt256x256ReaderBase>(new test_model::ndjson::BenchmarkInt256x256Reader(input));
+    std::unique_ptr<test_model::BenchmarkInt256x256ReaderBase> reader;
+    switch (input_format) {
+    case yardl::testing::Format::kBinary:
+      reader = std::make_unique<test_model::binary::BenchmarkInt256x256Reader>(input);
+      break;
+    case yardl::testing::Format::kHdf5:
+      reader = std::make_unique<test_model::hdf5::BenchmarkInt256x256Reader>(hdf5_input_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      reader = std::make_unique<test_model::ndjson::BenchmarkInt256x256Reader>(input);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
+
+    std::unique_ptr<test_model::BenchmarkInt256x256WriterBase> writer;
+    switch (output_format) {
+    case yardl::testing::Format::kBinary:
+      writer = std::make_unique<test_model::binary::BenchmarkInt256x256Writer>(output);
+      break;
+    case yardl::testing::Format::kHdf5:
+      writer = std::make_unique<test_model::hdf5::BenchmarkInt256x256Writer>(hdf5_output_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      writer = std::make_unique<test_model::ndjson::BenchmarkInt256x256Writer>(output);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
 
-    auto writer = output_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::BenchmarkInt256x256WriterBase>(new test_model::binary::BenchmarkInt256x256Writer(output))
-      : std::unique_ptr<test_model::BenchmarkInt256x256WriterBase>(new test_model::ndjson::BenchmarkInt256x256Writer(output));
     reader->CopyTo(*writer);
     return;
   }
   if (protocol_name == "BenchmarkFloatVlen") {
-    auto reader = input_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::BenchmarkFloatVlenReaderBase>(new test_model::binary::BenchmarkFloatVlenReader(input))
-      : std::unique_ptr<test_model::BenchmarkFloatVlenReaderBase>(new test_model::ndjson::BenchmarkFloatVlenReader(input));
+    std::unique_ptr<test_model::BenchmarkFloatVlenReaderBase> reader;
+    switch (input_format) {
+    case yardl::testing::Format::kBinary:
+      reader = std::make_unique<test_model::binary::BenchmarkFloatVlenReader>(input);
+      break;
+    case yardl::testing::Format::kHdf5:
+      reader = std::make_unique<test_model::hdf5::BenchmarkFloatVlenReader>(hdf5_input_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      reader = std::make_unique<test_model::ndjson::BenchmarkFloatVlenReader>(input);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
+
+    std::unique_ptr<test_model::BenchmarkFloatVlenWriterBase> writer;
+    switch (output_format) {
+    case yardl::testing::Format::kBinary:
+      writer = std::make_unique<test_model::binary::BenchmarkFloatVlenWriter>(output);
+      break;
+    case yardl::testing::Format::kHdf5:
+      writer = std::make_unique<test_model::hdf5::BenchmarkFloatVlenWriter>(hdf5_output_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      writer = std::make_unique<test_model::ndjson::BenchmarkFloatVlenWriter>(output);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
 
-    auto writer = output_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::BenchmarkFloatVlenWriterBase>(new test_model::binary::BenchmarkFloatVlenWriter(output))
-      : std::unique_ptr<test_model::BenchmarkFloatVlenWriterBase>(new test_model::ndjson::BenchmarkFloatVlenWriter(output));
     reader->CopyTo(*writer);
     return;
   }
   if (protocol_name == "BenchmarkSmallRecord") {
-    auto reader = input_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::BenchmarkSmallRecordReaderBase>(new test_model::binary::BenchmarkSmallRecordReader(input))
-      : std::unique_ptr<test_model::BenchmarkSmallRecordReaderBase>(new test_model::ndjson::BenchmarkSmallRecordReader(input));
+    std::unique_ptr<test_model::BenchmarkSmallRecordReaderBase> reader;
+    switch (input_format) {
+    case yardl::testing::Format::kBinary:
+      reader = std::make_unique<test_model::binary::BenchmarkSmallRecordReader>(input);
+      break;
+    case yardl::testing::Format::kHdf5:
+      reader = std::make_unique<test_model::hdf5::BenchmarkSmallRecordReader>(hdf5_input_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      reader = std::make_unique<test_model::ndjson::BenchmarkSmallRecordReader>(input);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
+
+    std::unique_ptr<test_model::BenchmarkSmallRecordWriterBase> writer;
+    switch (output_format) {
+    case yardl::testing::Format::kBinary:
+      writer = std::make_unique<test_model::binary::BenchmarkSmallRecordWriter>(output);
+      break;
+    case yardl::testing::Format::kHdf5:
+      writer = std::make_unique<test_model::hdf5::BenchmarkSmallRecordWriter>(hdf5_output_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      writer = std::make_unique<test_model::ndjson::BenchmarkSmallRecordWriter>(output);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
 
-    auto writer = output_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::BenchmarkSmallRecordWriterBase>(new test_model::binary::BenchmarkSmallRecordWriter(output))
-      : std::unique_ptr<test_model::BenchmarkSmallRecordWriterBase>(new test_model::ndjson::BenchmarkSmallRecordWriter(output));
     reader->CopyTo(*writer);
     return;
   }
   if (protocol_name == "BenchmarkSmallRecordWithOptionals") {
-    auto reader = input_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::BenchmarkSmallRecordWithOptionalsReaderBase>(new test_model::binary::BenchmarkSmallRecordWithOptionalsReader(input))
-      : std::unique_ptr<test_model::BenchmarkSmallRecordWithOptionalsReaderBase>(new test_model::ndjson::BenchmarkSmallRecordWithOptionalsReader(input));
+    std::unique_ptr<test_model::BenchmarkSmallRecordWithOptionalsReaderBase> reader;
+    switch (input_format) {
+    case yardl::testing::Format::kBinary:
+      reader = std::make_unique<test_model::binary::BenchmarkSmallRecordWithOptionalsReader>(input);
+      break;
+    case yardl::testing::Format::kHdf5:
+      reader = std::make_unique<test_model::hdf5::BenchmarkSmallRecordWithOptionalsReader>(hdf5_input_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      reader = std::make_unique<test_model::ndjson::BenchmarkSmallRecordWithOptionalsReader>(input);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
+
+    std::unique_ptr<test_model::BenchmarkSmallRecordWithOptionalsWriterBase> writer;
+    switch (output_format) {
+    case yardl::testing::Format::kBinary:
+      writer = std::make_unique<test_model::binary::BenchmarkSmallRecordWithOptionalsWriter>(output);
+      break;
+    case yardl::testing::Format::kHdf5:
+      writer = std::make_unique<test_model::hdf5::BenchmarkSmallRecordWithOptionalsWriter>(hdf5_output_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      writer = std::make_unique<test_model::ndjson::BenchmarkSmallRecordWithOptionalsWriter>(output);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
 
-    auto writer = output_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::BenchmarkSmallRecordWithOptionalsWriterBase>(new test_model::binary::BenchmarkSmallRecordWithOptionalsWriter(output))
-      : std::unique_ptr<test_model::BenchmarkSmallRecordWithOptionalsWriterBase>(new test_model::ndjson::BenchmarkSmallRecordWithOptionalsWriter(output));
     reader->CopyTo(*writer);
     return;
   }
   if (protocol_name == "BenchmarkSimpleMrd") {
-    auto reader = input_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::BenchmarkSimpleMrdReaderBase>(new test_model::binary::BenchmarkSimpleMrdReader(input))
-      : std::unique_ptr<test_model::BenchmarkSimpleMrdReaderBase>(new test_model::ndjson::BenchmarkSimpleMrdReader(input));
+    std::unique_ptr<test_model::BenchmarkSimpleMrdReaderBase> reader;
+    switch (input_format) {
+    case yardl::testing::Format::kBinary:
+      reader = std::make_unique<test_model::binary::BenchmarkSimpleMrdReader>(input);
+      break;
+    case yardl::testing::Format::kHdf5:
+      reader = std::make_unique<test_model::hdf5::BenchmarkSimpleMrdReader>(hdf5_input_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      reader = std::make_unique<test_model::ndjson::BenchmarkSimpleMrdReader>(input);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
+
+    std::unique_ptr<test_model::BenchmarkSimpleMrdWriterBase> writer;
+    switch (output_format) {
+    case yardl::testing::Format::kBinary:
+      writer = std::make_unique<test_model::binary::BenchmarkSimpleMrdWriter>(output);
+      break;
+    case yardl::testing::Format::kHdf5:
+      writer = std::make_unique<test_model::hdf5::BenchmarkSimpleMrdWriter>(hdf5_output_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      writer = std::make_unique<test_model::ndjson::BenchmarkSimpleMrdWriter>(output);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
 
-    auto writer = output_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::BenchmarkSimpleMrdWriterBase>(new test_model::binary::BenchmarkSimpleMrdWriter(output))
-      : std::unique_ptr<test_model::BenchmarkSimpleMrdWriterBase>(new test_model::ndjson::BenchmarkSimpleMrdWriter(output));
     reader->CopyTo(*writer);
     return;
   }
   if (protocol_name == "Scalars") {
-    auto reader = input_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::ScalarsReaderBase>(new test_model::binary::ScalarsReader(input))
-      : std::unique_ptr<test_model::ScalarsReaderBase>(new test_model::ndjson::ScalarsReader(input));
+    std::unique_ptr<test_model::ScalarsReaderBase> reader;
+    switch (input_format) {
+    case yardl::testing::Format::kBinary:
+      reader = std::make_unique<test_model::binary::ScalarsReader>(input);
+      break;
+    case yardl::testing::Format::kHdf5:
+      reader = std::make_unique<test_model::hdf5::ScalarsReader>(hdf5_input_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      reader = std::make_unique<test_model::ndjson::ScalarsReader>(input);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
+
+    std::unique_ptr<test_model::ScalarsWriterBase> writer;
+    switch (output_format) {
+    case yardl::testing::Format::kBinary:
+      writer = std::make_unique<test_model::binary::ScalarsWriter>(output);
+      break;
+    case yardl::testing::Format::kHdf5:
+      writer = std::make_unique<test_model::hdf5::ScalarsWriter>(hdf5_output_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      writer = std::make_unique<test_model::ndjson::ScalarsWriter>(output);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
 
-    auto writer = output_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::ScalarsWriterBase>(new test_model::binary::ScalarsWriter(output))
-      : std::unique_ptr<test_model::ScalarsWriterBase>(new test_model::ndjson::ScalarsWriter(output));
     reader->CopyTo(*writer);
     return;
   }
   if (protocol_name == "ScalarOptionals") {
-    auto reader = input_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::ScalarOptionalsReaderBase>(new test_model::binary::ScalarOptionalsReader(input))
-      : std::unique_ptr<test_model::ScalarOptionalsReaderBase>(new test_model::ndjson::ScalarOptionalsReader(input));
+    std::unique_ptr<test_model::ScalarOptionalsReaderBase> reader;
+    switch (input_format) {
+    case yardl::testing::Format::kBinary:
+      reader = std::make_unique<test_model::binary::ScalarOptionalsReader>(input);
+      break;
+    case yardl::testing::Format::kHdf5:
+      reader = std::make_unique<test_model::hdf5::ScalarOptionalsReader>(hdf5_input_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      reader = std::make_unique<test_model::ndjson::ScalarOptionalsReader>(input);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
+
+    std::unique_ptr<test_model::ScalarOptionalsWriterBase> writer;
+    switch (output_format) {
+    case yardl::testing::Format::kBinary:
+      writer = std::make_unique<test_model::binary::ScalarOptionalsWriter>(output);
+      break;
+    case yardl::testing::Format::kHdf5:
+      writer = std::make_unique<test_model::hdf5::ScalarOptionalsWriter>(hdf5_output_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      writer = std::make_unique<test_model::ndjson::ScalarOptionalsWriter>(output);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
 
-    auto writer = output_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::ScalarOptionalsWriterBase>(new test_model::binary::ScalarOptionalsWriter(output))
-      : std::unique_ptr<test_model::ScalarOptionalsWriterBase>(new test_model::ndjson::ScalarOptionalsWriter(output));
     reader->CopyTo(*writer);
     return;
   }
   if (protocol_name == "NestedRecords") {
-    auto reader = input_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::NestedRecordsReaderBase>(new test_model::binary::NestedRecordsReader(input))
-      : std::unique_ptr<test_model::NestedRecordsReaderBase>(new test_model::ndjson::NestedRecordsReader(input));
+    std::unique_ptr<test_model::NestedRecordsReaderBase> reader;
+    switch (input_format) {
+    case yardl::testing::Format::kBinary:
+      reader = std::make_unique<test_model::binary::NestedRecordsReader>(input);
+      break;
+    case yardl::testing::Format::kHdf5:
+      reader = std::make_unique<test_model::hdf5::NestedRecordsReader>(hdf5_input_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      reader = std::make_unique<test_model::ndjson::NestedRecordsReader>(input);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
+
+    std::unique_ptr<test_model::NestedRecordsWriterBase> writer;
+    switch (output_format) {
+    case yardl::testing::Format::kBinary:
+      writer = std::make_unique<test_model::binary::NestedRecordsWriter>(output);
+      break;
+    case yardl::testing::Format::kHdf5:
+      writer = std::make_unique<test_model::hdf5::NestedRecordsWriter>(hdf5_output_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      writer = std::make_unique<test_model::ndjson::NestedRecordsWriter>(output);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
 
-    auto writer = output_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::NestedRecordsWriterBase>(new test_model::binary::NestedRecordsWriter(output))
-      : std::unique_ptr<test_model::NestedRecordsWriterBase>(new test_model::ndjson::NestedRecordsWriter(output));
     reader->CopyTo(*writer);
     return;
   }
   if (protocol_name == "Vlens") {
-    auto reader = input_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::VlensReaderBase>(new test_model::binary::VlensReader(input))
-      : std::unique_ptr<test_model::VlensReaderBase>(new test_model::ndjson::VlensReader(input));
+    std::unique_ptr<test_model::VlensReaderBase> reader;
+    switch (input_format) {
+    case yardl::testing::Format::kBinary:
+      reader = std::make_unique<test_model::binary::VlensReader>(input);
+      break;
+    case yardl::testing::Format::kHdf5:
+      reader = std::make_unique<test_model::hdf5::VlensReader>(hdf5_input_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      reader = std::make_unique<test_model::ndjson::VlensReader>(input);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
+
+    std::unique_ptr<test_model::VlensWriterBase> writer;
+    switch (output_format) {
+    case yardl::testing::Format::kBinary:
+      writer = std::make_unique<test_model::binary::VlensWriter>(output);
+      break;
+    case yardl::testing::Format::kHdf5:
+      writer = std::make_unique<test_model::hdf5::VlensWriter>(hdf5_output_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      writer = std::make_unique<test_model::ndjson::VlensWriter>(output);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
 
-    auto writer = output_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::VlensWriterBase>(new test_model::binary::VlensWriter(output))
-      : std::unique_ptr<test_model::VlensWriterBase>(new test_model::ndjson::VlensWriter(output));
     reader->CopyTo(*writer);
     return;
   }
   if (protocol_name == "Strings") {
-    auto reader = input_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::StringsReaderBase>(new test_model::binary::StringsReader(input))
-      : std::unique_ptr<test_model::StringsReaderBase>(new test_model::ndjson::StringsReader(input));
+    std::unique_ptr<test_model::StringsReaderBase> reader;
+    switch (input_format) {
+    case yardl::testing::Format::kBinary:
+      reader = std::make_unique<test_model::binary::StringsReader>(input);
+      break;
+    case yardl::testing::Format::kHdf5:
+      reader = std::make_unique<test_model::hdf5::StringsReader>(hdf5_input_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      reader = std::make_unique<test_model::ndjson::StringsReader>(input);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
+
+    std::unique_ptr<test_model::StringsWriterBase> writer;
+    switch (output_format) {
+    case yardl::testing::Format::kBinary:
+      writer = std::make_unique<test_model::binary::StringsWriter>(output);
+      break;
+    case yardl::testing::Format::kHdf5:
+      writer = std::make_unique<test_model::hdf5::StringsWriter>(hdf5_output_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      writer = std::make_unique<test_model::ndjson::StringsWriter>(output);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
+
+    reader->CopyTo(*writer);
+    return;
+  }
+  if (protocol_name == "ProtocolWithBytes") {
+    std::unique_ptr<test_model::ProtocolWithBytesReaderBase> reader;
+    switch (input_format) {
+    case yardl::testing::Format::kBinary:
+      reader = std::make_unique<test_model::binary::ProtocolWithBytesReader>(input);
+      break;
+    case yardl::testing::Format::kHdf5:
+      reader = std::make_unique<test_model::hdf5::ProtocolWithBytesReader>(hdf5_input_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      reader = std::make_unique<test_model::ndjson::ProtocolWithBytesReader>(input);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
+
+    std::unique_ptr<test_model::ProtocolWithBytesWriterBase> writer;
+    switch (output_format) {
+    case yardl::testing::Format::kBinary:
+      writer = std::make_unique<test_model::binary::ProtocolWithBytesWriter>(output);
+      break;
+    case yardl::testing::Format::kHdf5:
+      writer = std::make_unique<test_model::hdf5::ProtocolWithBytesWriter>(hdf5_output_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      writer = std::make_unique<test_model::ndjson::ProtocolWithBytesWriter>(output);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
+
+    reader->CopyTo(*writer);
+    return;
+  }
+  if (protocol_name == "ProtocolWithUuids") {
+    std::unique_ptr<test_model::ProtocolWithUuidsReaderBase> reader;
+    switch (input_format) {
+    case yardl::testing::Format::kBinary:
+      reader = std::make_unique<test_model::binary::ProtocolWithUuidsReader>(input);
+      break;
+    case yardl::testing::Format::kHdf5:
+      reader = std::make_unique<test_model::hdf5::ProtocolWithUuidsReader>(hdf5_input_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      reader = std::make_unique<test_model::ndjson::ProtocolWithUuidsReader>(input);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
+
+    std::unique_ptr<test_model::ProtocolWithUuidsWriterBase> writer;
+    switch (output_format) {
+    case yardl::testing::Format::kBinary:
+      writer = std::make_unique<test_model::binary::ProtocolWithUuidsWriter>(output);
+      break;
+    case yardl::testing::Format::kHdf5:
+      writer = std::make_unique<test_model::hdf5::ProtocolWithUuidsWriter>(hdf5_output_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      writer = std::make_unique<test_model::ndjson::ProtocolWithUuidsWriter>(output);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
+
+    reader->CopyTo(*writer);
+    return;
+  }
+  if (protocol_name == "ProtocolWithDurations") {
+    std::unique_ptr<test_model::ProtocolWithDurationsReaderBase> reader;
+    switch (input_format) {
+    case yardl::testing::Format::kBinary:
+      reader = std::make_unique<test_model::binary::ProtocolWithDurationsReader>(input);
+      break;
+    case yardl::testing::Format::kHdf5:
+      reader = std::make_unique<test_model::hdf5::ProtocolWithDurationsReader>(hdf5_input_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      reader = std::make_unique<test_model::ndjson::ProtocolWithDurationsReader>(input);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
+
+    std::unique_ptr<test_model::ProtocolWithDurationsWriterBase> writer;
+    switch (output_format) {
+    case yardl::testing::Format::kBinary:
+      writer = std::make_unique<test_model::binary::ProtocolWithDurationsWriter>(output);
+      break;
+    case yardl::testing::Format::kHdf5:
+      writer = std::make_unique<test_model::hdf5::ProtocolWithDurationsWriter>(hdf5_output_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      writer = std::make_unique<test_model::ndjson::ProtocolWithDurationsWriter>(output);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
+
+    reader->CopyTo(*writer);
+    return;
+  }
+  if (protocol_name == "ProtocolWithRecursiveRecords") {
+    std::unique_ptr<test_model::ProtocolWithRecursiveRecordsReaderBase> reader;
+    switch (input_format) {
+    case yardl::testing::Format::kBinary:
+      reader = std::make_unique<test_model::binary::ProtocolWithRecursiveRecordsReader>(input);
+      break;
+    case yardl::testing::Format::kNDJson:
+      reader = std::make_unique<test_model::ndjson::ProtocolWithRecursiveRecordsReader>(input);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
+
+    std::unique_ptr<test_model::ProtocolWithRecursiveRecordsWriterBase> writer;
+    switch (output_format) {
+    case yardl::testing::Format::kBinary:
+      writer = std::make_unique<test_model::binary::ProtocolWithRecursiveRecordsWriter>(output);
+      break;
+    case yardl::testing::Format::kNDJson:
+      writer = std::make_unique<test_model::ndjson::ProtocolWithRecursiveRecordsWriter>(output);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
+
+    reader->CopyTo(*writer);
+    return;
+  }
+  if (protocol_name == "Calibration") {
+    std::unique_ptr<test_model::CalibrationReaderBase> reader;
+    switch (input_format) {
+    case yardl::testing::Format::kBinary:
+      reader = std::make_unique<test_model::binary::CalibrationReader>(input);
+      break;
+    case yardl::testing::Format::kHdf5:
+      reader = std::make_unique<test_model::hdf5::CalibrationReader>(hdf5_input_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      reader = std::make_unique<test_model::ndjson::CalibrationReader>(input);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
+
+    std::unique_ptr<test_model::CalibrationWriterBase> writer;
+    switch (output_format) {
+    case yardl::testing::Format::kBinary:
+      writer = std::make_unique<test_model::binary::CalibrationWriter>(output);
+      break;
+    case yardl::testing::Format::kHdf5:
+      writer = std::make_unique<test_model::hdf5::CalibrationWriter>(hdf5_output_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      writer = std::make_unique<test_model::ndjson::CalibrationWriter>(output);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
+
+    reader->CopyTo(*writer);
+    return;
+  }
+  if (protocol_name == "ProtocolWithSubProtocols") {
+    std::unique_ptr<test_model::ProtocolWithSubProtocolsReaderBase> reader;
+    switch (input_format) {
+    case yardl::testing::Format::kBinary:
+      reader = std::make_unique<test_model::binary::ProtocolWithSubProtocolsReader>(input);
+      break;
+    case yardl::testing::Format::kHdf5:
+      reader = std::make_unique<test_model::hdf5::ProtocolWithSubProtocolsReader>(hdf5_input_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      reader = std::make_unique<test_model::ndjson::ProtocolWithSubProtocolsReader>(input);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
+
+    std::unique_ptr<test_model::ProtocolWithSubProtocolsWriterBase> writer;
+    switch (output_format) {
+    case yardl::testing::Format::kBinary:
+      writer = std::make_unique<test_model::binary::ProtocolWithSubProtocolsWriter>(output);
+      break;
+    case yardl::testing::Format::kHdf5:
+      writer = std::make_unique<test_model::hdf5::ProtocolWithSubProtocolsWriter>(hdf5_output_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      writer = std::make_unique<test_model::ndjson::ProtocolWithSubProtocolsWriter>(output);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
+
+    reader->CopyTo(*writer);
+    return;
+  }
+  if (protocol_name == "ProtocolWithHalfPrecision") {
+    std::unique_ptr<test_model::ProtocolWithHalfPrecisionReaderBase> reader;
+    switch (input_format) {
+    case yardl::testing::Format::kBinary:
+      reader = std::make_unique<test_model::binary::ProtocolWithHalfPrecisionReader>(input);
+      break;
+    case yardl::testing::Format::kHdf5:
+      reader = std::make_unique<test_model::hdf5::ProtocolWithHalfPrecisionReader>(hdf5_input_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      reader = std::make_unique<test_model::ndjson::ProtocolWithHalfPrecisionReader>(input);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
+
+    std::unique_ptr<test_model::ProtocolWithHalfPrecisionWriterBase> writer;
+    switch (output_format) {
+    case yardl::testing::Format::kBinary:
+      writer = std::make_unique<test_model::binary::ProtocolWithHalfPrecisionWriter>(output);
+      break;
+    case yardl::testing::Format::kHdf5:
+      writer = std::make_unique<test_model::hdf5::ProtocolWithHalfPrecisionWriter>(hdf5_output_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      writer = std::make_unique<test_model::ndjson::ProtocolWithHalfPrecisionWriter>(output);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
 
-    auto writer = output_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::StringsWriterBase>(new test_model::binary::StringsWriter(output))
-      : std::unique_ptr<test_model::StringsWriterBase>(new test_model::ndjson::StringsWriter(output));
     reader->CopyTo(*writer);
     return;
   }
   if (protocol_name == "OptionalVectors") {
-    auto reader = input_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::OptionalVectorsReaderBase>(new test_model::binary::OptionalVectorsReader(input))
-      : std::unique_ptr<test_model::OptionalVectorsReaderBase>(new test_model::ndjson::OptionalVectorsReader(input));
+    std::unique_ptr<test_model::OptionalVectorsReaderBase> reader;
+    switch (input_format) {
+    case yardl::testing::Format::kBinary:
+      reader = std::make_unique<test_model::binary::OptionalVectorsReader>(input);
+      break;
+    case yardl::testing::Format::kHdf5:
+      reader = std::make_unique<test_model::hdf5::OptionalVectorsReader>(hdf5_input_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      reader = std::make_unique<test_model::ndjson::OptionalVectorsReader>(input);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
+
+    std::unique_ptr<test_model::OptionalVectorsWriterBase> writer;
+    switch (output_format) {
+    case yardl::testing::Format::kBinary:
+      writer = std::make_unique<test_model::binary::OptionalVectorsWriter>(output);
+      break;
+    case yardl::testing::Format::kHdf5:
+      writer = std::make_unique<test_model::hdf5::OptionalVectorsWriter>(hdf5_output_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      writer = std::make_unique<test_model::ndjson::OptionalVectorsWriter>(output);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
 
-    auto writer = output_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::OptionalVectorsWriterBase>(new test_model::binary::OptionalVectorsWriter(output))
-      : std::unique_ptr<test_model::OptionalVectorsWriterBase>(new test_model::ndjson::OptionalVectorsWriter(output));
     reader->CopyTo(*writer);
     return;
   }
   if (protocol_name == "FixedVectors") {
-    auto reader = input_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::FixedVectorsReaderBase>(new test_model::binary::FixedVectorsReader(input))
-      : std::unique_ptr<test_model::FixedVectorsReaderBase>(new test_model::ndjson::FixedVectorsReader(input));
+    std::unique_ptr<test_model::FixedVectorsReaderBase> reader;
+    switch (input_format) {
+    case yardl::testing::Format::kBinary:
+      reader = std::make_unique<test_model::binary::FixedVectorsReader>(input);
+      break;
+    case yardl::testing::Format::kHdf5:
+      reader = std::make_unique<test_model::hdf5::FixedVectorsReader>(hdf5_input_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      reader = std::make_unique<test_model::ndjson::FixedVectorsReader>(input);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
+
+    std::unique_ptr<test_model::FixedVectorsWriterBase> writer;
+    switch (output_format) {
+    case yardl::testing::Format::kBinary:
+      writer = std::make_unique<test_model::binary::FixedVectorsWriter>(output);
+      break;
+    case yardl::testing::Format::kHdf5:
+      writer = std::make_unique<test_model::hdf5::FixedVectorsWriter>(hdf5_output_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      writer = std::make_unique<test_model::ndjson::FixedVectorsWriter>(output);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
 
-    auto writer = output_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::FixedVectorsWriterBase>(new test_model::binary::FixedVectorsWriter(output))
-      : std::unique_ptr<test_model::FixedVectorsWriterBase>(new test_model::ndjson::FixedVectorsWriter(output));
     reader->CopyTo(*writer);
     return;
   }
   if (protocol_name == "Streams") {
-    auto reader = input_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::StreamsReaderBase>(new test_model::binary::StreamsReader(input))
-      : std::unique_ptr<test_model::StreamsReaderBase>(new test_model::ndjson::StreamsReader(input));
+    std::unique_ptr<test_model::StreamsReaderBase> reader;
+    switch (input_format) {
+    case yardl::testing::Format::kBinary:
+      reader = std::make_unique<test_model::binary::StreamsReader>(input);
+      break;
+    case yardl::testing::Format::kHdf5:
+      reader = std::make_unique<test_model::hdf5::StreamsReader>(hdf5_input_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      reader = std::make_unique<test_model::ndjson::StreamsReader>(input);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
+
+    std::unique_ptr<test_model::StreamsWriterBase> writer;
+    switch (output_format) {
+    case yardl::testing::Format::kBinary:
+      writer = std::make_unique<test_model::binary::StreamsWriter>(output);
+      break;
+    case yardl::testing::Format::kHdf5:
+      writer = std::make_unique<test_model::hdf5::StreamsWriter>(hdf5_output_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      writer = std::make_unique<test_model::ndjson::StreamsWriter>(output);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
 
-    auto writer = output_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::StreamsWriterBase>(new test_model::binary::StreamsWriter(output))
-      : std::unique_ptr<test_model::StreamsWriterBase>(new test_model::ndjson::StreamsWriter(output));
     reader->CopyTo(*writer);
     return;
   }
   if (protocol_name == "FixedArrays") {
-    auto reader = input_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::FixedArraysReaderBase>(new test_model::binary::FixedArraysReader(input))
-      : std::unique_ptr<test_model::FixedArraysReaderBase>(new test_model::ndjson::FixedArraysReader(input));
+    std::unique_ptr<test_model::FixedArraysReaderBase> reader;
+    switch (input_format) {
+    case yardl::testing::Format::kBinary:
+      reader = std::make_unique<test_model::binary::FixedArraysReader>(input);
+      break;
+    case yardl::testing::Format::kHdf5:
+      reader = std::make_unique<test_model::hdf5::FixedArraysReader>(hdf5_input_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      reader = std::make_unique<test_model::ndjson::FixedArraysReader>(input);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
+
+    std::unique_ptr<test_model::FixedArraysWriterBase> writer;
+    switch (output_format) {
+    case yardl::testing::Format::kBinary:
+      writer = std::make_unique<test_model::binary::FixedArraysWriter>(output);
+      break;
+    case yardl::testing::Format::kHdf5:
+      writer = std::make_unique<test_model::hdf5::FixedArraysWriter>(hdf5_output_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      writer = std::make_unique<test_model::ndjson::FixedArraysWriter>(output);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
 
-    auto writer = output_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::FixedArraysWriterBase>(new test_model::binary::FixedArraysWriter(output))
-      : std::unique_ptr<test_model::FixedArraysWriterBase>(new test_model::ndjson::FixedArraysWriter(output));
     reader->CopyTo(*writer);
     return;
   }
   if (protocol_name == "Subarrays") {
-    auto reader = input_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::SubarraysReaderBase>(new test_model::binary::SubarraysReader(input))
-      : std::unique_ptr<test_model::SubarraysReaderBase>(new test_model::ndjson::SubarraysReader(input));
+    std::unique_ptr<test_model::SubarraysReaderBase> reader;
+    switch (input_format) {
+    case yardl::testing::Format::kBinary:
+      reader = std::make_unique<test_model::binary::SubarraysReader>(input);
+      break;
+    case yardl::testing::Format::kHdf5:
+      reader = std::make_unique<test_model::hdf5::SubarraysReader>(hdf5_input_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      reader = std::make_unique<test_model::ndjson::SubarraysReader>(input);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
+
+    std::unique_ptr<test_model::SubarraysWriterBase> writer;
+    switch (output_format) {
+    case yardl::testing::Format::kBinary:
+      writer = std::make_unique<test_model::binary::SubarraysWriter>(output);
+      break;
+    case yardl::testing::Format::kHdf5:
+      writer = std::make_unique<test_model::hdf5::SubarraysWriter>(hdf5_output_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      writer = std::make_unique<test_model::ndjson::SubarraysWriter>(output);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
 
-    auto writer = output_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::SubarraysWriterBase>(new test_model::binary::SubarraysWriter(output))
-      : std::unique_ptr<test_model::SubarraysWriterBase>(new test_model::ndjson::SubarraysWriter(output));
     reader->CopyTo(*writer);
     return;
   }
   if (protocol_name == "SubarraysInRecords") {
-    auto reader = input_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::SubarraysInRecordsReaderBase>(new test_model::binary::SubarraysInRecordsReader(input))
-      : std::unique_ptr<test_model::SubarraysInRecordsReaderBase>(new test_model::ndjson::SubarraysInRecordsReader(input));
+    std::unique_ptr<test_model::SubarraysInRecordsReaderBase> reader;
+    switch (input_format) {
+    case yardl::testing::Format::kBinary:
+      reader = std::make_unique<test_model::binary::SubarraysInRecordsReader>(input);
+      break;
+    case yardl::testing::Format::kHdf5:
+      reader = std::make_unique<test_model::hdf5::SubarraysInRecordsReader>(hdf5_input_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      reader = std::make_unique<test_model::ndjson::SubarraysInRecordsReader>(input);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
+
+    std::unique_ptr<test_model::SubarraysInRecordsWriterBase> writer;
+    switch (output_format) {
+    case yardl::testing::Format::kBinary:
+      writer = std::make_unique<test_model::binary::SubarraysInRecordsWriter>(output);
+      break;
+    case yardl::testing::Format::kHdf5:
+      writer = std::make_unique<test_model::hdf5::SubarraysInRecordsWriter>(hdf5_output_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      writer = std::make_unique<test_model::ndjson::SubarraysInRecordsWriter>(output);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
 
-    auto writer = output_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::SubarraysInRecordsWriterBase>(new test_model::binary::SubarraysInRecordsWriter(output))
-      : std::unique_ptr<test_model::SubarraysInRecordsWriterBase>(new test_model::ndjson::SubarraysInRecordsWriter(output));
     reader->CopyTo(*writer);
     return;
   }
   if (protocol_name == "NDArrays") {
-    auto reader = input_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::NDArraysReaderBase>(new test_model::binary::NDArraysReader(input))
-      : std::unique_ptr<test_model::NDArraysReaderBase>(new test_model::ndjson::NDArraysReader(input));
+    std::unique_ptr<test_model::NDArraysReaderBase> reader;
+    switch (input_format) {
+    case yardl::testing::Format::kBinary:
+      reader = std::make_unique<test_model::binary::NDArraysReader>(input);
+      break;
+    case yardl::testing::Format::kHdf5:
+      reader = std::make_unique<test_model::hdf5::NDArraysReader>(hdf5_input_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      reader = std::make_unique<test_model::ndjson::NDArraysReader>(input);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
+
+    std::unique_ptr<test_model::NDArraysWriterBase> writer;
+    switch (output_format) {
+    case yardl::testing::Format::kBinary:
+      writer = std::make_unique<test_model::binary::NDArraysWriter>(output);
+      break;
+    case yardl::testing::Format::kHdf5:
+      writer = std::make_unique<test_model::hdf5::NDArraysWriter>(hdf5_output_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      writer = std::make_unique<test_model::ndjson::NDArraysWriter>(output);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
 
-    auto writer = output_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::NDArraysWriterBase>(new test_model::binary::NDArraysWriter(output))
-      : std::unique_ptr<test_model::NDArraysWriterBase>(new test_model::ndjson::NDArraysWriter(output));
     reader->CopyTo(*writer);
     return;
   }
   if (protocol_name == "NDArraysSingleDimension") {
-    auto reader = input_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::NDArraysSingleDimensionReaderBase>(new test_model::binary::NDArraysSingleDimensionReader(input))
-      : std::unique_ptr<test_model::NDArraysSingleDimensionReaderBase>(new test_model::ndjson::NDArraysSingleDimensionReader(input));
+    std::unique_ptr<test_model::NDArraysSingleDimensionReaderBase> reader;
+    switch (input_format) {
+    case yardl::testing::Format::kBinary:
+      reader = std::make_unique<test_model::binary::NDArraysSingleDimensionReader>(input);
+      break;
+    case yardl::testing::Format::kHdf5:
+      reader = std::make_unique<test_model::hdf5::NDArraysSingleDimensionReader>(hdf5_input_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      reader = std::make_unique<test_model::ndjson::NDArraysSingleDimensionReader>(input);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
+
+    std::unique_ptr<test_model::NDArraysSingleDimensionWriterBase> writer;
+    switch (output_format) {
+    case yardl::testing::Format::kBinary:
+      writer = std::make_unique<test_model::binary::NDArraysSingleDimensionWriter>(output);
+      break;
+    case yardl::testing::Format::kHdf5:
+      writer = std::make_unique<test_model::hdf5::NDArraysSingleDimensionWriter>(hdf5_output_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      writer = std::make_unique<test_model::ndjson::NDArraysSingleDimensionWriter>(output);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
 
-    auto writer = output_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::NDArraysSingleDimensionWriterBase>(new test_model::binary::NDArraysSingleDimensionWriter(output))
-      : std::unique_ptr<test_model::NDArraysSingleDimensionWriterBase>(new test_model::ndjson::NDArraysSingleDimensionWriter(output));
     reader->CopyTo(*writer);
     return;
   }
   if (protocol_name == "DynamicNDArrays") {
-    auto reader = input_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::DynamicNDArraysReaderBase>(new test_model::binary::DynamicNDArraysReader(input))
-      : std::unique_ptr<test_model::DynamicNDArraysReaderBase>(new test_model::ndjson::DynamicNDArraysReader(input));
+    std::unique_ptr<test_model::DynamicNDArraysReaderBase> reader;
+    switch (input_format) {
+    case yardl::testing::Format::kBinary:
+      reader = std::make_unique<test_model::binary::DynamicNDArraysReader>(input);
+      break;
+    case yardl::testing::Format::kHdf5:
+      reader = std::make_unique<test_model::hdf5::DynamicNDArraysReader>(hdf5_input_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      reader = std::make_unique<test_model::ndjson::DynamicNDArraysReader>(input);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
+
+    std::unique_ptr<test_model::DynamicNDArraysWriterBase> writer;
+    switch (output_format) {
+    case yardl::testing::Format::kBinary:
+      writer = std::make_unique<test_model::binary::DynamicNDArraysWriter>(output);
+      break;
+    case yardl::testing::Format::kHdf5:
+      writer = std::make_unique<test_model::hdf5::DynamicNDArraysWriter>(hdf5_output_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      writer = std::make_unique<test_model::ndjson::DynamicNDArraysWriter>(output);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
 
-    auto writer = output_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::DynamicNDArraysWriterBase>(new test_model::binary::DynamicNDArraysWriter(output))
-      : std::unique_ptr<test_model::DynamicNDArraysWriterBase>(new test_model::ndjson::DynamicNDArraysWriter(output));
     reader->CopyTo(*writer);
     return;
   }
   if (protocol_name == "MultiDArrays") {
-    auto reader = input_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::MultiDArraysReaderBase>(new test_model::binary::MultiDArraysReader(input))
-      : std::unique_ptr<test_model::MultiDArraysReaderBase>(new test_model::ndjson::MultiDArraysReader(input));
+    std::unique_ptr<test_model::MultiDArraysReaderBase> reader;
+    switch (input_format) {
+    case yardl::testing::Format::kBinary:
+      reader = std::make_unique<test_model::binary::MultiDArraysReader>(input);
+      break;
+    case yardl::testing::Format::kHdf5:
+      reader = std::make_unique<test_model::hdf5::MultiDArraysReader>(hdf5_input_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      reader = std::make_unique<test_model::ndjson::MultiDArraysReader>(input);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
+
+    std::unique_ptr<test_model::MultiDArraysWriterBase> writer;
+    switch (output_format) {
+    case yardl::testing::Format::kBinary:
+      writer = std::make_unique<test_model::binary::MultiDArraysWriter>(output);
+      break;
+    case yardl::testing::Format::kHdf5:
+      writer = std::make_unique<test_model::hdf5::MultiDArraysWriter>(hdf5_output_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      writer = std::make_unique<test_model::ndjson::MultiDArraysWriter>(output);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
 
-    auto writer = output_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::MultiDArraysWriterBase>(new test_model::binary::MultiDArraysWriter(output))
-      : std::unique_ptr<test_model::MultiDArraysWriterBase>(new test_model::ndjson::MultiDArraysWriter(output));
     reader->CopyTo(*writer);
     return;
   }
   if (protocol_name == "ComplexArrays") {
-    auto reader = input_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::ComplexArraysReaderBase>(new test_model::binary::ComplexArraysReader(input))
-      : std::unique_ptr<test_model::ComplexArraysReaderBase>(new test_model::ndjson::ComplexArraysReader(input));
+    std::unique_ptr<test_model::ComplexArraysReaderBase> reader;
+    switch (input_format) {
+    case yardl::testing::Format::kBinary:
+      reader = std::make_unique<test_model::binary::ComplexArraysReader>(input);
+      break;
+    case yardl::testing::Format::kHdf5:
+      reader = std::make_unique<test_model::hdf5::ComplexArraysReader>(hdf5_input_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      reader = std::make_unique<test_model::ndjson::ComplexArraysReader>(input);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
+
+    std::unique_ptr<test_model::ComplexArraysWriterBase> writer;
+    switch (output_format) {
+    case yardl::testing::Format::kBinary:
+      writer = std::make_unique<test_model::binary::ComplexArraysWriter>(output);
+      break;
+    case yardl::testing::Format::kHdf5:
+      writer = std::make_unique<test_model::hdf5::ComplexArraysWriter>(hdf5_output_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      writer = std::make_unique<test_model::ndjson::ComplexArraysWriter>(output);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
 
-    auto writer = output_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::ComplexArraysWriterBase>(new test_model::binary::ComplexArraysWriter(output))
-      : std::unique_ptr<test_model::ComplexArraysWriterBase>(new test_model::ndjson::ComplexArraysWriter(output));
     reader->CopyTo(*writer);
     return;
   }
   if (protocol_name == "Maps") {
-    auto reader = input_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::MapsReaderBase>(new test_model::binary::MapsReader(input))
-      : std::unique_ptr<test_model::MapsReaderBase>(new test_model::ndjson::MapsReader(input));
+    std::unique_ptr<test_model::MapsReaderBase> reader;
+    switch (input_format) {
+    case yardl::testing::Format::kBinary:
+      reader = std::make_unique<test_model::binary::MapsReader>(input);
+      break;
+    case yardl::testing::Format::kHdf5:
+      reader = std::make_unique<test_model::hdf5::MapsReader>(hdf5_input_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      reader = std::make_unique<test_model::ndjson::MapsReader>(input);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
+
+    std::unique_ptr<test_model::MapsWriterBase> writer;
+    switch (output_format) {
+    case yardl::testing::Format::kBinary:
+      writer = std::make_unique<test_model::binary::MapsWriter>(output);
+      break;
+    case yardl::testing::Format::kHdf5:
+      writer = std::make_unique<test_model::hdf5::MapsWriter>(hdf5_output_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      writer = std::make_unique<test_model::ndjson::MapsWriter>(output);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
 
-    auto writer = output_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::MapsWriterBase>(new test_model::binary::MapsWriter(output))
-      : std::unique_ptr<test_model::MapsWriterBase>(new test_model::ndjson::MapsWriter(output));
     reader->CopyTo(*writer);
     return;
   }
   if (protocol_name == "Unions") {
-    auto reader = input_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::UnionsReaderBase>(new test_model::binary::UnionsReader(input))
-      : std::unique_ptr<test_model::UnionsReaderBase>(new test_model::ndjson::UnionsReader(input));
+    std::unique_ptr<test_model::UnionsReaderBase> reader;
+    switch (input_format) {
+    case yardl::testing::Format::kBinary:
+      reader = std::make_unique<test_model::binary::UnionsReader>(input);
+      break;
+    case yardl::testing::Format::kHdf5:
+      reader = std::make_unique<test_model::hdf5::UnionsReader>(hdf5_input_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      reader = std::make_unique<test_model::ndjson::UnionsReader>(input);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
+
+    std::unique_ptr<test_model::UnionsWriterBase> writer;
+    switch (output_format) {
+    case yardl::testing::Format::kBinary:
+      writer = std::make_unique<test_model::binary::UnionsWriter>(output);
+      break;
+    case yardl::testing::Format::kHdf5:
+      writer = std::make_unique<test_model::hdf5::UnionsWriter>(hdf5_output_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      writer = std::make_unique<test_model::ndjson::UnionsWriter>(output);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
 
-    auto writer = output_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::UnionsWriterBase>(new test_model::binary::UnionsWriter(output))
-      : std::unique_ptr<test_model::UnionsWriterBase>(new test_model::ndjson::UnionsWriter(output));
     reader->CopyTo(*writer);
     return;
   }
   if (protocol_name == "StreamsOfUnions") {
-    auto reader = input_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::StreamsOfUnionsReaderBase>(new test_model::binary::StreamsOfUnionsReader(input))
-      : std::unique_ptr<test_model::StreamsOfUnionsReaderBase>(new test_model::ndjson::StreamsOfUnionsReader(input));
+    std::unique_ptr<test_model::StreamsOfUnionsReaderBase> reader;
+    switch (input_format) {
+    case yardl::testing::Format::kBinary:
+      reader = std::make_unique<test_model::binary::StreamsOfUnionsReader>(input);
+      break;
+    case yardl::testing::Format::kHdf5:
+      reader = std::make_unique<test_model::hdf5::StreamsOfUnionsReader>(hdf5_input_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      reader = std::make_unique<test_model::ndjson::StreamsOfUnionsReader>(input);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
+
+    std::unique_ptr<test_model::StreamsOfUnionsWriterBase> writer;
+    switch (output_format) {
+    case yardl::testing::Format::kBinary:
+      writer = std::make_unique<test_model::binary::StreamsOfUnionsWriter>(output);
+      break;
+    case yardl::testing::Format::kHdf5:
+      writer = std::make_unique<test_model::hdf5::StreamsOfUnionsWriter>(hdf5_output_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      writer = std::make_unique<test_model::ndjson::StreamsOfUnionsWriter>(output);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
 
-    auto writer = output_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::StreamsOfUnionsWriterBase>(new test_model::binary::StreamsOfUnionsWriter(output))
-      : std::unique_ptr<test_model::StreamsOfUnionsWriterBase>(new test_model::ndjson::StreamsOfUnionsWriter(output));
     reader->CopyTo(*writer);
     return;
   }
   if (protocol_name == "Enums") {
-    auto reader = input_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::EnumsReaderBase>(new test_model::binary::EnumsReader(input))
-      : std::unique_ptr<test_model::EnumsReaderBase>(new test_model::ndjson::EnumsReader(input));
+    std::unique_ptr<test_model::EnumsReaderBase> reader;
+    switch (input_format) {
+    case yardl::testing::Format::kBinary:
+      reader = std::make_unique<test_model::binary::EnumsReader>(input);
+      break;
+    case yardl::testing::Format::kHdf5:
+      reader = std::make_unique<test_model::hdf5::EnumsReader>(hdf5_input_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      reader = std::make_unique<test_model::ndjson::EnumsReader>(input);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
+
+    std::unique_ptr<test_model::EnumsWriterBase> writer;
+    switch (output_format) {
+    case yardl::testing::Format::kBinary:
+      writer = std::make_unique<test_model::binary::EnumsWriter>(output);
+      break;
+    case yardl::testing::Format::kHdf5:
+      writer = std::make_unique<test_model::hdf5::EnumsWriter>(hdf5_output_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      writer = std::make_unique<test_model::ndjson::EnumsWriter>(output);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
 
-    auto writer = output_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::EnumsWriterBase>(new test_model::binary::EnumsWriter(output))
-      : std::unique_ptr<test_model::EnumsWriterBase>(new test_model::ndjson::EnumsWriter(output));
     reader->CopyTo(*writer);
     return;
   }
   if (protocol_name == "Flags") {
-    auto reader = input_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::FlagsReaderBase>(new test_model::binary::FlagsReader(input))
-      : std::unique_ptr<test_model::FlagsReaderBase>(new test_model::ndjson::FlagsReader(input));
+    std::unique_ptr<test_model::FlagsReaderBase> reader;
+    switch (input_format) {
+    case yardl::testing::Format::kBinary:
+      reader = std::make_unique<test_model::binary::FlagsReader>(input);
+      break;
+    case yardl::testing::Format::kHdf5:
+      reader = std::make_unique<test_model::hdf5::FlagsReader>(hdf5_input_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      reader = std::make_unique<test_model::ndjson::FlagsReader>(input);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
+
+    std::unique_ptr<test_model::FlagsWriterBase> writer;
+    switch (output_format) {
+    case yardl::testing::Format::kBinary:
+      writer = std::make_unique<test_model::binary::FlagsWriter>(output);
+      break;
+    case yardl::testing::Format::kHdf5:
+      writer = std::make_unique<test_model::hdf5::FlagsWriter>(hdf5_output_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      writer = std::make_unique<test_model::ndjson::FlagsWriter>(output);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
 
-    auto writer = output_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::FlagsWriterBase>(new test_model::binary::FlagsWriter(output))
-      : std::unique_ptr<test_model::FlagsWriterBase>(new test_model::ndjson::FlagsWriter(output));
     reader->CopyTo(*writer);
     return;
   }
   if (protocol_name == "StateTest") {
-    auto reader = input_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::StateTestReaderBase>(new test_model::binary::StateTestReader(input))
-      : std::unique_ptr<test_model::StateTestReaderBase>(new test_model::ndjson::StateTestReader(input));
+    std::unique_ptr<test_model::StateTestReaderBase> reader;
+    switch (input_format) {
+    case yardl::testing::Format::kBinary:
+      reader = std::make_unique<test_model::binary::StateTestReader>(input);
+      break;
+    case yardl::testing::Format::kHdf5:
+      reader = std::make_unique<test_model::hdf5::StateTestReader>(hdf5_input_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      reader = std::make_unique<test_model::ndjson::StateTestReader>(input);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
+
+    std::unique_ptr<test_model::StateTestWriterBase> writer;
+    switch (output_format) {
+    case yardl::testing::Format::kBinary:
+      writer = std::make_unique<test_model::binary::StateTestWriter>(output);
+      break;
+    case yardl::testing::Format::kHdf5:
+      writer = std::make_unique<test_model::hdf5::StateTestWriter>(hdf5_output_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      writer = std::make_unique<test_model::ndjson::StateTestWriter>(output);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
 
-    auto writer = output_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::StateTestWriterBase>(new test_model::binary::StateTestWriter(output))
-      : std::unique_ptr<test_model::StateTestWriterBase>(new test_model::ndjson::StateTestWriter(output));
     reader->CopyTo(*writer);
     return;
   }
   if (protocol_name == "SimpleGenerics") {
-    auto reader = input_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::SimpleGenericsReaderBase>(new test_model::binary::SimpleGenericsReader(input))
-      : std::unique_ptr<test_model::SimpleGenericsReaderBase>(new test_model::ndjson::SimpleGenericsReader(input));
+    std::unique_ptr<test_model::SimpleGenericsReaderBase> reader;
+    switch (input_format) {
+    case yardl::testing::Format::kBinary:
+      reader = std::make_unique<test_model::binary::SimpleGenericsReader>(input);
+      break;
+    case yardl::testing::Format::kHdf5:
+      reader = std::make_unique<test_model::hdf5::SimpleGenericsReader>(hdf5_input_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      reader = std::make_unique<test_model::ndjson::SimpleGenericsReader>(input);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
+
+    std::unique_ptr<test_model::SimpleGenericsWriterBase> writer;
+    switch (output_format) {
+    case yardl::testing::Format::kBinary:
+      writer = std::make_unique<test_model::binary::SimpleGenericsWriter>(output);
+      break;
+    case yardl::testing::Format::kHdf5:
+      writer = std::make_unique<test_model::hdf5::SimpleGenericsWriter>(hdf5_output_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      writer = std::make_unique<test_model::ndjson::SimpleGenericsWriter>(output);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
 
-    auto writer = output_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::SimpleGenericsWriterBase>(new test_model::binary::SimpleGenericsWriter(output))
-      : std::unique_ptr<test_model::SimpleGenericsWriterBase>(new test_model::ndjson::SimpleGenericsWriter(output));
     reader->CopyTo(*writer);
     return;
   }
   if (protocol_name == "AdvancedGenerics") {
-    auto reader = input_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::AdvancedGenericsReaderBase>(new test_model::binary::AdvancedGenericsReader(input))
-      : std::unique_ptr<test_model::AdvancedGenericsReaderBase>(new test_model::ndjson::AdvancedGenericsReader(input));
+    std::unique_ptr<test_model::AdvancedGenericsReaderBase> reader;
+    switch (input_format) {
+    case yardl::testing::Format::kBinary:
+      reader = std::make_unique<test_model::binary::AdvancedGenericsReader>(input);
+      break;
+    case yardl::testing::Format::kHdf5:
+      reader = std::make_unique<test_model::hdf5::AdvancedGenericsReader>(hdf5_input_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      reader = std::make_unique<test_model::ndjson::AdvancedGenericsReader>(input);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
+
+    std::unique_ptr<test_model::AdvancedGenericsWriterBase> writer;
+    switch (output_format) {
+    case yardl::testing::Format::kBinary:
+      writer = std::make_unique<test_model::binary::AdvancedGenericsWriter>(output);
+      break;
+    case yardl::testing::Format::kHdf5:
+      writer = std::make_unique<test_model::hdf5::AdvancedGenericsWriter>(hdf5_output_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      writer = std::make_unique<test_model::ndjson::AdvancedGenericsWriter>(output);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
+
+    reader->CopyTo(*writer);
+    return;
+  }
+  if (protocol_name == "FloatImageStream") {
+    std::unique_ptr<test_model::FloatImageStreamReaderBase> reader;
+    switch (input_format) {
+    case yardl::testing::Format::kBinary:
+      reader = std::make_unique<test_model::binary::FloatImageStreamReader>(input);
+      break;
+    case yardl::testing::Format::kHdf5:
+      reader = std::make_unique<test_model::hdf5::FloatImageStreamReader>(hdf5_input_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      reader = std::make_unique<test_model::ndjson::FloatImageStreamReader>(input);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
+
+    std::unique_ptr<test_model::FloatImageStreamWriterBase> writer;
+    switch (output_format) {
+    case yardl::testing::Format::kBinary:
+      writer = std::make_unique<test_model::binary::FloatImageStreamWriter>(output);
+      break;
+    case yardl::testing::Format::kHdf5:
+      writer = std::make_unique<test_model::hdf5::FloatImageStreamWriter>(hdf5_output_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      writer = std::make_unique<test_model::ndjson::FloatImageStreamWriter>(output);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
+
+    reader->CopyTo(*writer);
+    return;
+  }
+  if (protocol_name == "ComplexImageStream") {
+    std::unique_ptr<test_model::ComplexImageStreamReaderBase> reader;
+    switch (input_format) {
+    case yardl::testing::Format::kBinary:
+      reader = std::make_unique<test_model::binary::ComplexImageStreamReader>(input);
+      break;
+    case yardl::testing::Format::kHdf5:
+      reader = std::make_unique<test_model::hdf5::ComplexImageStreamReader>(hdf5_input_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      reader = std::make_unique<test_model::ndjson::ComplexImageStreamReader>(input);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
+
+    std::unique_ptr<test_model::ComplexImageStreamWriterBase> writer;
+    switch (output_format) {
+    case yardl::testing::Format::kBinary:
+      writer = std::make_unique<test_model::binary::ComplexImageStreamWriter>(output);
+      break;
+    case yardl::testing::Format::kHdf5:
+      writer = std::make_unique<test_model::hdf5::ComplexImageStreamWriter>(hdf5_output_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      writer = std::make_unique<test_model::ndjson::ComplexImageStreamWriter>(output);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
 
-    auto writer = output_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::AdvancedGenericsWriterBase>(new test_model::binary::AdvancedGenericsWriter(output))
-      : std::unique_ptr<test_model::AdvancedGenericsWriterBase>(new test_model::ndjson::AdvancedGenericsWriter(output));
     reader->CopyTo(*writer);
     return;
   }
   if (protocol_name == "Aliases") {
-    auto reader = input_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::AliasesReaderBase>(new test_model::binary::AliasesReader(input))
-      : std::unique_ptr<test_model::AliasesReaderBase>(new test_model::ndjson::AliasesReader(input));
+    std::unique_ptr<test_model::AliasesReaderBase> reader;
+    switch (input_format) {
+    case yardl::testing::Format::kBinary:
+      reader = std::make_unique<test_model::binary::AliasesReader>(input);
+      break;
+    case yardl::testing::Format::kHdf5:
+      reader = std::make_unique<test_model::hdf5::AliasesReader>(hdf5_input_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      reader = std::make_unique<test_model::ndjson::AliasesReader>(input);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
+
+    std::unique_ptr<test_model::AliasesWriterBase> writer;
+    switch (output_format) {
+    case yardl::testing::Format::kBinary:
+      writer = std::make_unique<test_model::binary::AliasesWriter>(output);
+      break;
+    case yardl::testing::Format::kHdf5:
+      writer = std::make_unique<test_model::hdf5::AliasesWriter>(hdf5_output_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      writer = std::make_unique<test_model::ndjson::AliasesWriter>(output);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
 
-    auto writer = output_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::AliasesWriterBase>(new test_model::binary::AliasesWriter(output))
-      : std::unique_ptr<test_model::AliasesWriterBase>(new test_model::ndjson::AliasesWriter(output));
     reader->CopyTo(*writer);
     return;
   }
   if (protocol_name == "StreamsOfAliasedUnions") {
-    auto reader = input_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::StreamsOfAliasedUnionsReaderBase>(new test_model::binary::StreamsOfAliasedUnionsReader(input))
-      : std::unique_ptr<test_model::StreamsOfAliasedUnionsReaderBase>(new test_model::ndjson::StreamsOfAliasedUnionsReader(input));
+    std::unique_ptr<test_model::StreamsOfAliasedUnionsReaderBase> reader;
+    switch (input_format) {
+    case yardl::testing::Format::kBinary:
+      reader = std::make_unique<test_model::binary::StreamsOfAliasedUnionsReader>(input);
+      break;
+    case yardl::testing::Format::kHdf5:
+      reader = std::make_unique<test_model::hdf5::StreamsOfAliasedUnionsReader>(hdf5_input_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      reader = std::make_unique<test_model::ndjson::StreamsOfAliasedUnionsReader>(input);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
+
+    std::unique_ptr<test_model::StreamsOfAliasedUnionsWriterBase> writer;
+    switch (output_format) {
+    case yardl::testing::Format::kBinary:
+      writer = std::make_unique<test_model::binary::StreamsOfAliasedUnionsWriter>(output);
+      break;
+    case yardl::testing::Format::kHdf5:
+      writer = std::make_unique<test_model::hdf5::StreamsOfAliasedUnionsWriter>(hdf5_output_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      writer = std::make_unique<test_model::ndjson::StreamsOfAliasedUnionsWriter>(output);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
 
-    auto writer = output_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::StreamsOfAliasedUnionsWriterBase>(new test_model::binary::StreamsOfAliasedUnionsWriter(output))
-      : std::unique_ptr<test_model::StreamsOfAliasedUnionsWriterBase>(new test_model::ndjson::StreamsOfAliasedUnionsWriter(output));
     reader->CopyTo(*writer);
     return;
   }
   if (protocol_name == "ProtocolWithComputedFields") {
-    auto reader = input_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::ProtocolWithComputedFieldsReaderBase>(new test_model::binary::ProtocolWithComputedFieldsReader(input))
-      : std::unique_ptr<test_model::ProtocolWithComputedFieldsReaderBase>(new test_model::ndjson::ProtocolWithComputedFieldsReader(input));
+    std::unique_ptr<test_model::ProtocolWithComputedFieldsReaderBase> reader;
+    switch (input_format) {
+    case yardl::testing::Format::kBinary:
+      reader = std::make_unique<test_model::binary::ProtocolWithComputedFieldsReader>(input);
+      break;
+    case yardl::testing::Format::kHdf5:
+      reader = std::make_unique<test_model::hdf5::ProtocolWithComputedFieldsReader>(hdf5_input_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      reader = std::make_unique<test_model::ndjson::ProtocolWithComputedFieldsReader>(input);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
+
+    std::unique_ptr<test_model::ProtocolWithComputedFieldsWriterBase> writer;
+    switch (output_format) {
+    case yardl::testing::Format::kBinary:
+      writer = std::make_unique<test_model::binary::ProtocolWithComputedFieldsWriter>(output);
+      break;
+    case yardl::testing::Format::kHdf5:
+      writer = std::make_unique<test_model::hdf5::ProtocolWithComputedFieldsWriter>(hdf5_output_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      writer = std::make_unique<test_model::ndjson::ProtocolWithComputedFieldsWriter>(output);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
+
+    reader->CopyTo(*writer);
+    return;
+  }
+  if (protocol_name == "ProtocolWithConstraints") {
+    std::unique_ptr<test_model::ProtocolWithConstraintsReaderBase> reader;
+    switch (input_format) {
+    case yardl::testing::Format::kBinary:
+      reader = std::make_unique<test_model::binary::ProtocolWithConstraintsReader>(input);
+      break;
+    case yardl::testing::Format::kHdf5:
+      reader = std::make_unique<test_model::hdf5::ProtocolWithConstraintsReader>(hdf5_input_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      reader = std::make_unique<test_model::ndjson::ProtocolWithConstraintsReader>(input);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
+
+    std::unique_ptr<test_model::ProtocolWithConstraintsWriterBase> writer;
+    switch (output_format) {
+    case yardl::testing::Format::kBinary:
+      writer = std::make_unique<test_model::binary::ProtocolWithConstraintsWriter>(output);
+      break;
+    case yardl::testing::Format::kHdf5:
+      writer = std::make_unique<test_model::hdf5::ProtocolWithConstraintsWriter>(hdf5_output_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      writer = std::make_unique<test_model::ndjson::ProtocolWithConstraintsWriter>(output);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
 
-    auto writer = output_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::ProtocolWithComputedFieldsWriterBase>(new test_model::binary::ProtocolWithComputedFieldsWriter(output))
-      : std::unique_ptr<test_model::ProtocolWithComputedFieldsWriterBase>(new test_model::ndjson::ProtocolWithComputedFieldsWriter(output));
     reader->CopyTo(*writer);
     return;
   }
   if (protocol_name == "ProtocolWithKeywordSteps") {
-    auto reader = input_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::ProtocolWithKeywordStepsReaderBase>(new test_model::binary::ProtocolWithKeywordStepsReader(input))
-      : std::unique_ptr<test_model::ProtocolWithKeywordStepsReaderBase>(new test_model::ndjson::ProtocolWithKeywordStepsReader(input));
+    std::unique_ptr<test_model::ProtocolWithKeywordStepsReaderBase> reader;
+    switch (input_format) {
+    case yardl::testing::Format::kBinary:
+      reader = std::make_unique<test_model::binary::ProtocolWithKeywordStepsReader>(input);
+      break;
+    case yardl::testing::Format::kHdf5:
+      reader = std::make_unique<test_model::hdf5::ProtocolWithKeywordStepsReader>(hdf5_input_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      reader = std::make_unique<test_model::ndjson::ProtocolWithKeywordStepsReader>(input);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
+
+    std::unique_ptr<test_model::ProtocolWithKeywordStepsWriterBase> writer;
+    switch (output_format) {
+    case yardl::testing::Format::kBinary:
+      writer = std::make_unique<test_model::binary::ProtocolWithKeywordStepsWriter>(output);
+      break;
+    case yardl::testing::Format::kHdf5:
+      writer = std::make_unique<test_model::hdf5::ProtocolWithKeywordStepsWriter>(hdf5_output_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      writer = std::make_unique<test_model::ndjson::ProtocolWithKeywordStepsWriter>(output);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
 
-    auto writer = output_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::ProtocolWithKeywordStepsWriterBase>(new test_model::binary::ProtocolWithKeywordStepsWriter(output))
-      : std::unique_ptr<test_model::ProtocolWithKeywordStepsWriterBase>(new test_model::ndjson::ProtocolWithKeywordStepsWriter(output));
     reader->CopyTo(*writer);
     return;
   }
   if (protocol_name == "ProtocolWithOptionalDate") {
-    auto reader = input_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::ProtocolWithOptionalDateReaderBase>(new test_model::binary::ProtocolWithOptionalDateReader(input))
-      : std::unique_ptr<test_model::ProtocolWithOptionalDateReaderBase>(new test_model::ndjson::ProtocolWithOptionalDateReader(input));
+    std::unique_ptr<test_model::ProtocolWithOptionalDateReaderBase> reader;
+    switch (input_format) {
+    case yardl::testing::Format::kBinary:
+      reader = std::make_unique<test_model::binary::ProtocolWithOptionalDateReader>(input);
+      break;
+    case yardl::testing::Format::kHdf5:
+      reader = std::make_unique<test_model::hdf5::ProtocolWithOptionalDateReader>(hdf5_input_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      reader = std::make_unique<test_model::ndjson::ProtocolWithOptionalDateReader>(input);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
+
+    std::unique_ptr<test_model::ProtocolWithOptionalDateWriterBase> writer;
+    switch (output_format) {
+    case yardl::testing::Format::kBinary:
+      writer = std::make_unique<test_model::binary::ProtocolWithOptionalDateWriter>(output);
+      break;
+    case yardl::testing::Format::kHdf5:
+      writer = std::make_unique<test_model::hdf5::ProtocolWithOptionalDateWriter>(hdf5_output_path);
+      break;
+    case yardl::testing::Format::kNDJson:
+      writer = std::make_unique<test_model::ndjson::ProtocolWithOptionalDateWriter>(output);
+      break;
+    default:
+      throw std::runtime_error("Unsupported format");
+    }
 
-    auto writer = output_format == yardl::testing::Format::kBinary
-      ? std::unique_ptr<test_model::ProtocolWithOptionalDateWriterBase>(new test_model::binary::ProtocolWithOptionalDateWriter(output))
-      : std::unique_ptr<test_model::ProtocolWithOptionalDateWriterBase>(new test_model::ndjson::ProtocolWithOptionalDateWriter(output));
     reader->CopyTo(*writer);
     return;
   }
@@ -1,9 +1,13 @@
 // This file was generated by the "yardl" tool. DO NOT EDIT.
 
 #pragma once
+#include <algorithm>
 #include <array>
+#include <cmath>
 #include <complex>
+#include <numeric>
 #include <optional>
+#include <regex>
 #include <unordered_map>
 #include <variant>
 #include <vector>
@@ -139,6 +143,16 @@ using IntImage = image::Image<int32_t>;
 } // namespace image
 
 namespace test_model {
+// The maximum number of channels
+constexpr int32_t kMaxChannels = 4;
+constexpr uint32_t kSamplesPerChannel = 8;
+constexpr float kDefaultGain = 1.5f;
+constexpr bool kIsStrict = false;
+
+struct TreeNode;
+struct LinkedListNode;
+struct Expression;
+
 struct SmallBenchmarkRecord {
   double a{};
   float b{};
@@ -423,6 +437,134 @@ struct RecordWithStrings {
   }
 };
 
+struct RecordWithBytes {
+  std::vector<std::byte> data{};
+  std::optional<std::vector<std::byte>> optional_data{};
+  std::vector<std::vector<std::byte>> chunks{};
+
+  bool operator==(const RecordWithBytes& other) const {
+    return data == other.data &&
+      optional_data == other.optional_data &&
+      chunks == other.chunks;
+  }
+
+  bool operator!=(const RecordWithBytes& other) const {
+    return !(*this == other);
+  }
+};
+
+struct RecordWithUuids {
+  yardl::Uuid id{};
+  std::optional<yardl::Uuid> optional_id{};
+  std::vector<yardl::Uuid> related{};
+  std::unordered_map<yardl::Uuid, std::string> names{};
+
+  bool operator==(const RecordWithUuids& other) const {
+    return id == other.id &&
+      optional_id == other.optional_id &&
+      related == other.related &&
+      names == other.names;
+  }
+
+  bool operator!=(const RecordWithUuids& other) const {
+    return !(*this == other);
+  }
+};
+
+struct RecordWithDurations {
+  yardl::DateTime acquisition_start{};
+  yardl::DateTime acquisition_end{};
+  yardl::Duration repetition_time{};
+  std::optional<yardl::Duration> timeout{};
+  std::vector<yardl::Duration> intervals{};
+
+  yardl::Duration Elapsed() const {
+    return acquisition_end - acquisition_start;
+  }
+
+  yardl::DateTime NextAcquisitionStart() const {
+    return acquisition_start + repetition_time;
+  }
+
+  bool ExceedsRepetitionTime() const {
+    return Elapsed() > repetition_time;
+  }
+
+  bool operator==(const RecordWithDurations& other) const {
+    return acquisition_start == other.acquisition_start &&
+      acquisition_end == other.acquisition_end &&
+      repetition_time == other.repetition_time &&
+      timeout == other.timeout &&
+      intervals == other.intervals;
+  }
+
+  bool operator!=(const RecordWithDurations& other) const {
+    return !(*this == other);
+  }
+};
+
+struct TreeNode {
+  std::string label{};
+  std::vector<test_model::TreeNode> children{};
+
+  bool operator==(const TreeNode& other) const {
+    return label == other.label &&
+      children == other.children;
+  }
+
+  bool operator!=(const TreeNode& other) const {
+    return !(*this == other);
+  }
+};
+
+struct LinkedListNode {
+  int32_t value{};
+  std::optional<yardl::Indirect<test_model::LinkedListNode>> next{};
+
+  bool operator==(const LinkedListNode& other) const {
+    return value == other.value &&
+      next == other.next;
+  }
+
+  bool operator!=(const LinkedListNode& other) const {
+    return !(*this == other);
+  }
+};
+
+struct Expression {
+  std::string name{};
+  std::variant<int32_t, yardl::Indirect<test_model::Expression>> operand{};
+  std::unordered_map<std::string, yardl::Indirect<test_model::Expression>> arguments{};
+
+  bool operator==(const Expression& other) const {
+    return name == other.name &&
+      operand == other.operand &&
+      arguments == other.arguments;
+  }
+
+  bool operator!=(const Expression& other) const {
+    return !(*this == other);
+  }
+};
+
+struct RecordWithHalfPrecision {
+  yardl::Float16 half{};
+  yardl::BFloat16 brain{};
+  std::vector<yardl::Float16> half_vector{};
+  yardl::DynamicNDArray<yardl::BFloat16> brain_array{};
+
+  bool operator==(const RecordWithHalfPrecision& other) const {
+    return half == other.half &&
+      brain == other.brain &&
+      half_vector == other.half_vector &&
+      brain_array == other.brain_array;
+  }
+
+  bool operator!=(const RecordWithHalfPrecision& other) const {
+    return !(*this == other);
+  }
+};
+
 struct RecordWithOptionalVector {
   std::optional<std::vector<int32_t>> optional_vector{};
 
@@ -1383,6 +1525,78 @@ struct RecordWithComputedFields {
     return -(4.3 + static_cast<double>(1));
   }
 
+  bool Comparison1() const {
+    return int_field > 10;
+  }
+
+  bool Comparison2() const {
+    return VectorSize() <= 3ULL;
+  }
+
+  bool Comparison3() const {
+    return string_field == "hello";
+  }
+
+  bool Comparison4() const {
+    return float32_field != static_cast<float>(int_field);
+  }
+
+  bool Logical1() const {
+    return int_field > 0 && VectorSize() > 0ULL;
+  }
+
+  bool Logical2() const {
+    return !(int_field > 0) || string_field != "hello";
+  }
+
+  int32_t Conditional1() const {
+    return (VectorSize() > 0ULL ? vector_field.at(0) : -1);
+  }
+
+  std::string Conditional2() const {
+    return (int_field > 0 ? "positive" : (int_field == 0 ? "zero" : "negative"));
+  }
+
+  int32_t CallMin() const {
+    return std::min<int32_t>(int_field, static_cast<int32_t>(int8_field));
+  }
+
+  float CallMax() const {
+    return std::max<float>(float32_field, static_cast<float>(2));
+  }
+
+  int32_t CallAbs() const {
+    return std::abs(int_field);
+  }
+
+  double CallSqrt() const {
+    return std::sqrt(static_cast<double>(uint32_field));
+  }
+
+  double CallFloor() const {
+    return std::floor(float64_field);
+  }
+
+  double CallCeil() const {
+    return std::ceil(float64_field);
+  }
+
+  int32_t CallSum() const {
+    return yardl::Sum<int32_t>(vector_field);
+  }
+
+  yardl::Size CallLength() const {
+    return string_field.size();
+  }
+
+  bool CallContains() const {
+    return (map_field.count("hello") != 0);
+  }
+
+  bool CallHasValue() const {
+    return optional_named_array.has_value();
+  }
+
   float CastIntToFloat() const {
     return static_cast<float>(int_field);
   }
@@ -1443,6 +1657,156 @@ struct RecordWithComputedFields {
   }
 };
 
+struct RecordWithDefaults {
+  int32_t int_field{42};
+  int8_t int8_field{-7};
+  uint64_t uint64_field{9223372036854775808ULL};
+  float float32_field{1.5f};
+  double float64_field{static_cast<double>(2 * 4)};
+  std::complex<double> complexfloat64_field{static_cast<std::complex<double>>(static_cast<double>(3))};
+  bool bool_field{true};
+  std::string string_field{"hello"};
+  test_model::Fruits enum_field{test_model::Fruits::kPear};
+  int32_t no_default_field{};
+
+  bool operator==(const RecordWithDefaults& other) const {
+    return int_field == other.int_field &&
+      int8_field == other.int8_field &&
+      uint64_field == other.uint64_field &&
+      float32_field == other.float32_field &&
+      float64_field == other.float64_field &&
+      complexfloat64_field == other.complexfloat64_field &&
+      bool_field == other.bool_field &&
+      string_field == other.string_field &&
+      enum_field == other.enum_field &&
+      no_default_field == other.no_default_field;
+  }
+
+  bool operator!=(const RecordWithDefaults& other) const {
+    return !(*this == other);
+  }
+};
+
+struct RecordWithConstraints {
+  uint32_t size{};
+  float gain{};
+  std::string name{};
+  std::optional<int32_t> limit{};
+  std::vector<int32_t> samples{};
+
+  // Throws std::runtime_error if a field does not satisfy its constraints.
+  void Validate() const {
+    if (size < 1) {
+      throw std::runtime_error("RecordWithConstraints.size must be at least 1");
+    }
+    if (size > 4096) {
+      throw std::runtime_error("RecordWithConstraints.size must be at most 4096");
+    }
+    if (gain < 0.0f) {
+      throw std::runtime_error("RecordWithConstraints.gain must be at least 0.0");
+    }
+    if (gain > 2.5f) {
+      throw std::runtime_error("RecordWithConstraints.gain must be at most 2.5");
+    }
+    static std::regex const kNamePattern("[a-z][a-z0-9_]*");
+    if (!std::regex_match(name, kNamePattern)) {
+      throw std::runtime_error("RecordWithConstraints.name must match the pattern '[a-z][a-z0-9_]*'");
+    }
+    if (limit.has_value() && limit.value() > 10) {
+      throw std::runtime_error("RecordWithConstraints.limit must be at most 10");
+    }
+    if (samples.size() < 1) {
+      throw std::runtime_error("RecordWithConstraints.samples must have a length of at least 1");
+    }
+    if (samples.size() > 4) {
+      throw std::runtime_error("RecordWithConstraints.samples must have a length of at most 4");
+    }
+  }
+
+  bool operator==(const RecordWithConstraints& other) const {
+    return size == other.size &&
+      gain == other.gain &&
+      name == other.name &&
+      limit == other.limit &&
+      samples == other.samples;
+  }
+
+  bool operator!=(const RecordWithConstraints& other) const {
+    return !(*this == other);
+  }
+};
+
+struct RecordWithPatterns {
+  std::string alternation{};
+  std::string word{};
+  std::string code{};
+  std::string escaped{};
+  std::string capitalized{};
+
+  // Throws std::runtime_error if a field does not satisfy its constraints.
+  void Validate() const {
+    static std::regex const kAlternationPattern("a|ab");
+    if (!std::regex_match(alternation, kAlternationPattern)) {
+      throw std::runtime_error("RecordWithPatterns.alternation must match the pattern 'a|ab'");
+    }
+    static std::regex const kWordPattern("\\w+");
+    if (!std::regex_match(word, kWordPattern)) {
+      throw std::runtime_error("RecordWithPatterns.word must match the pattern '\\w+'");
+    }
+    static std::regex const kCodePattern("\\d{2,3}(?:\\-\\d{2})?");
+    if (!std::regex_match(code, kCodePattern)) {
+      throw std::runtime_error("RecordWithPatterns.code must match the pattern '\\d{2,3}(?:\\-\\d{2})?'");
+    }
+    static std::regex const kEscapedPattern("\\(\\.\\)[\\-+*]");
+    if (!std::regex_match(escaped, kEscapedPattern)) {
+      throw std::runtime_error("RecordWithPatterns.escaped must match the pattern '\\(\\.\\)[\\-+*]'");
+    }
+    static std::regex const kCapitalizedPattern("[A-Z][a-z]*");
+    if (!std::regex_match(capitalized, kCapitalizedPattern)) {
+      throw std::runtime_error("RecordWithPatterns.capitalized must match the pattern '[A-Z][a-z]*'");
+    }
+  }
+
+  bool operator==(const RecordWithPatterns& other) const {
+    return alternation == other.alternation &&
+      word == other.word &&
+      code == other.code &&
+      escaped == other.escaped &&
+      capitalized == other.capitalized;
+  }
+
+  bool operator!=(const RecordWithPatterns& other) const {
+    return !(*this == other);
+  }
+};
+
+struct RecordWithConstrainedRecords {
+  test_model::RecordWithConstraints single{};
+  std::optional<test_model::RecordWithConstraints> optional{};
+  std::vector<test_model::RecordWithConstraints> vector{};
+
+  // Throws std::runtime_error if a field does not satisfy its constraints.
+  void Validate() const {
+    single.Validate();
+    if (optional.has_value()) {
+      optional.value().Validate();
+    }
+    for (auto const& item0 : vector) {
+      item0.Validate();
+    }
+  }
+
+  bool operator==(const RecordWithConstrainedRecords& other) const {
+    return single == other.single &&
+      optional == other.optional &&
+      vector == other.vector;
+  }
+
+  bool operator!=(const RecordWithConstrainedRecords& other) const {
+    return !(*this == other);
+  }
+};
+
 template <typename T>
 using GenericUnionWithRepeatedTypeParameters = std::variant<T, std::vector<T>, yardl::DynamicNDArray<T>>;
 
@@ -1526,5 +1890,171 @@ struct RecordWithOptionalDate {
   }
 };
 
+struct RecordWithConstants {
+  std::array<int32_t, 4> channels{};
+  yardl::FixedNDArray<float, 4, 8> samples{};
+  float gain{test_model::kDefaultGain};
+
+  int32_t ChannelCount() const {
+    return test_model::kMaxChannels;
+  }
+
+  int64_t SampleCount() const {
+    return static_cast<int64_t>(test_model::kMaxChannels) * static_cast<int64_t>(test_model::kSamplesPerChannel);
+  }
+
+  float ScaledGain() const {
+    return gain * test_model::kDefaultGain;
+  }
+
+  bool Strict() const {
+    return test_model::kIsStrict;
+  }
+
+  bool operator==(const RecordWithConstants& other) const {
+    return channels == other.channels &&
+      samples == other.samples &&
+      gain == other.gain;
+  }
+
+  bool operator!=(const RecordWithConstants& other) const {
+    return !(*this == other);
+  }
+};
+
+// A common header
+struct RecordHeader {
+  uint32_t version{};
+  std::string name{"header"};
+
+  bool IsFirstVersion() const {
+    return version == 1;
+  }
+
+  bool operator==(const RecordHeader& other) const {
+    return version == other.version &&
+      name == other.name;
+  }
+
+  bool operator!=(const RecordHeader& other) const {
+    return !(*this == other);
+  }
+};
+
+template <typename T>
+struct LabeledHeader {
+  T label{};
+
+  bool operator==(const LabeledHeader& other) const {
+    return label == other.label;
+  }
+
+  bool operator!=(const LabeledHeader& other) const {
+    return !(*this == other);
+  }
+};
+
+struct RecordWithBase {
+  uint32_t version{};
+  std::string name{"header"};
+  std::vector<float> data{};
+
+  bool IsFirstVersion() const {
+    return version == 1;
+  }
+
+  yardl::Size DataSize() const {
+    return data.size();
+  }
+
+  bool operator==(const RecordWithBase& other) const {
+    return version == other.version &&
+      name == other.name &&
+      data == other.data;
+  }
+
+  bool operator!=(const RecordWithBase& other) const {
+    return !(*this == other);
+  }
+};
+
+struct RecordWithBases {
+  uint32_t version{};
+  std::string name{"header"};
+  std::string label{};
+  int32_t value{};
+
+  bool IsFirstVersion() const {
+    return version == 1;
+  }
+
+  bool operator==(const RecordWithBases& other) const {
+    return version == other.version &&
+      name == other.name &&
+      label == other.label &&
+      value == other.value;
+  }
+
+  bool operator!=(const RecordWithBases& other) const {
+    return !(*this == other);
+  }
+};
+
+// A slice with user-defined annotations
+// Annotations: dicomModule="Image Plane", version=2
+struct RecordWithAnnotations {
+  // Annotations: dicomTag="(0018,0050)", units="mm"
+  float thickness{};
+  // Annotations: deprecated=true, scale=1.0, units="mm"
+  float spacing{1.5f};
+  std::string label{};
+
+  bool operator==(const RecordWithAnnotations& other) const {
+    return thickness == other.thickness &&
+      spacing == other.spacing &&
+      label == other.label;
+  }
+
+  bool operator!=(const RecordWithAnnotations& other) const {
+    return !(*this == other);
+  }
+};
+
+// Annotations: deprecated=true
+enum class EnumWithAnnotations {
+  kA = 0,
+  kB = 1,
+};
+
+using Millimeters = float;
+
+struct RecordWithUnits {
+  float length{};
+  test_model::Millimeters width{};
+  std::vector<double> durations{};
+
+  float Perimeter() const {
+    return static_cast<float>(2) * (length + width);
+  }
+
+  float Area() const {
+    return length * width;
+  }
+
+  double TotalDuration() const {
+    return yardl::Sum<double>(durations);
+  }
+
+  bool operator==(const RecordWithUnits& other) const {
+    return length == other.length &&
+      width == other.width &&
+      durations == other.durations;
+  }
+
+  bool operator!=(const RecordWithUnits& other) const {
+    return !(*this == other);
+  }
+};
+
 } // namespace test_model
 
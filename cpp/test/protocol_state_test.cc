@@ -147,4 +147,130 @@ TEST(ReaderStateTest, PrematureClose) {
   ASSERT_ANY_THROW(r.Close());
 }
 
+class TestProtocolWithConstraintsWriter : public ProtocolWithConstraintsWriterBase {
+  void WriteRecordImpl([[maybe_unused]] RecordWithConstraints const& value) override {}
+  void WriteRecordsImpl([[maybe_unused]] RecordWithConstrainedRecords const& value) override {}
+  void EndRecordsImpl() override {}
+};
+
+TEST(WriterStateTest, ConstraintsNotValidatedByDefault) {
+  TestProtocolWithConstraintsWriter w;
+  w.WriteRecord(RecordWithConstraints{});
+  w.EndRecords();
+  w.Close();
+}
+
+TEST(WriterStateTest, ConstraintsValidatedWhenEnabled) {
+  TestProtocolWithConstraintsWriter w;
+  w.SetValidateConstraints(true);
+  ASSERT_THROW(w.WriteRecord(RecordWithConstraints{}), std::runtime_error);
+
+  RecordWithConstraints valid;
+  valid.size = 1;
+  valid.name = "a";
+  valid.samples = {1};
+  w.WriteRecord(valid);
+
+  RecordWithConstrainedRecords records;
+  records.single = valid;
+  records.vector = {valid, RecordWithConstraints{}};
+  ASSERT_THROW(w.WriteRecords(records), std::runtime_error);
+  ASSERT_THROW(w.WriteRecords(std::vector<RecordWithConstrainedRecords>{records}), std::runtime_error);
+
+  records.vector.pop_back();
+  w.WriteRecords(records);
+  w.EndRecords();
+  w.Close();
+}
+
+class TestSubProtocolsWriter : public ProtocolWithSubProtocolsWriterBase {
+  void WriteHeaderImpl([[maybe_unused]] std::string const& value) override {}
+  void WriteCalibrationGainImpl([[maybe_unused]] float const& value) override {}
+  void WriteCalibrationSamplesImpl([[maybe_unused]] double const& value) override {}
+  void EndCalibrationSamplesImpl() override {}
+  void WriteDataImpl([[maybe_unused]] int32_t const& value) override {}
+  void EndDataImpl() override {}
+  void WriteRecalibrationGainImpl([[maybe_unused]] float const& value) override {}
+  void WriteRecalibrationSamplesImpl([[maybe_unused]] double const& value) override {}
+  void EndRecalibrationSamplesImpl() override {}
+};
+
+TEST(WriterStateTest, SubProtocolWriter) {
+  TestSubProtocolsWriter w;
+  w.WriteHeader("header");
+
+  w.Calibration().WriteGain(1);
+  w.Calibration().WriteSamples(1);
+  w.Calibration().EndSamples();
+  w.Calibration().Close();
+
+  w.EndData();
+
+  w.Recalibration().WriteGain(1);
+  w.WriteRecalibrationSamples(1);
+  w.EndRecalibrationSamples();
+
+  w.Close();
+}
+
+TEST(WriterStateTest, SubProtocolWriterOutOfSequence) {
+  TestSubProtocolsWriter w;
+  ASSERT_ANY_THROW(w.Calibration().WriteGain(1));
+}
+
+TEST(WriterStateTest, SubProtocolWriterMissingStep) {
+  TestSubProtocolsWriter w;
+  w.WriteHeader("header");
+  ASSERT_ANY_THROW(w.Calibration().EndSamples());
+}
+
+class TestSubProtocolsReader : public ProtocolWithSubProtocolsReaderBase {
+  void ReadHeaderImpl([[maybe_unused]] std::string& value) override {}
+  void ReadCalibrationGainImpl(float& value) override { value = 1.5f; }
+  bool ReadCalibrationSamplesImpl(double& value) override {
+    value = 2.0;
+    return samples_count_++ < 2;
+  }
+  bool ReadDataImpl([[maybe_unused]] int32_t& value) override { return false; }
+  void ReadRecalibrationGainImpl([[maybe_unused]] float& value) override {}
+  bool ReadRecalibrationSamplesImpl([[maybe_unused]] double& value) override { return false; }
+
+ private:
+  int samples_count_ = 0;
+};
+
+TEST(ReaderStateTest, SubProtocolReader) {
+  TestSubProtocolsReader r;
+  std::string header;
+  r.ReadHeader(header);
+
+  CalibrationReaderBase& calibration = r.Calibration();
+  float gain;
+  calibration.ReadGain(gain);
+  ASSERT_EQ(gain, 1.5f);
+
+  double sample;
+  int count = 0;
+  while (calibration.ReadSamples(sample)) {
+    ASSERT_EQ(sample, 2.0);
+    count++;
+  }
+  ASSERT_EQ(count, 2);
+  calibration.Close();
+
+  int32_t data;
+  ASSERT_FALSE(r.ReadData(data));
+
+  r.Recalibration().ReadGain(gain);
+  ASSERT_FALSE(r.ReadRecalibrationSamples(sample));
+
+  r.Close();
+}
+
+TEST(ReaderStateTest, SubProtocolReaderOutOfSequence) {
+  TestSubProtocolsReader r;
+  float gain;
+  ASSERT_ANY_THROW(r.Calibration().ReadGain(gain));
+}
+
 }  // namespace
@@ -1,6 +1,9 @@
 // Copyright (c) Microsoft Corporation.
 // Licensed under the MIT License.
 
+#include <cmath>
+#include <limits>
+
 #include <gtest/gtest.h>
 
 #include "generated/protocols.h"
@@ -141,6 +144,117 @@ TEST_P(RoundTripTests, Strings) {
   tw->Close();
 }
 
+TEST_P(RoundTripTests, Bytes) {
+  auto tw = CreateValidatingWriter<ProtocolWithBytesWriterBase>();
+
+  std::vector<std::byte> bytes = {std::byte{0x00}, std::byte{0x7F}, std::byte{0xFF}};
+  tw->WriteSingleBytes(bytes);
+
+  RecordWithBytes rec;
+  rec.data = {std::byte{0xDE}, std::byte{0xAD}};
+  rec.optional_data = std::vector<std::byte>{};
+  rec.chunks = {bytes, {}, {std::byte{0x01}}};
+  tw->WriteRecWithBytes(rec);
+
+  tw->Close();
+}
+
+TEST_P(RoundTripTests, Uuids) {
+  auto tw = CreateValidatingWriter<ProtocolWithUuidsWriterBase>();
+
+  auto id = yardl::Uuid::Parse("123e4567-e89b-12d3-a456-426614174000");
+  tw->WriteSingleUuid(id);
+
+  RecordWithUuids rec;
+  rec.id = id;
+  rec.optional_id = yardl::Uuid();
+  rec.related = {id, yardl::Uuid::Parse("FFFFFFFF-0000-4000-8000-00000000000A")};
+  rec.names = {{id, "first"}, {yardl::Uuid(), "nil"}};
+  tw->WriteRecWithUuids(rec);
+
+  tw->Close();
+}
+
+TEST_P(RoundTripTests, Durations) {
+  auto tw = CreateValidatingWriter<ProtocolWithDurationsWriterBase>();
+
+  tw->WriteSingleDuration(yardl::Duration(-1500000000));
+
+  RecordWithDurations rec;
+  rec.acquisition_start = yardl::DateTime(std::chrono::seconds(1700000000));
+  rec.acquisition_end = rec.acquisition_start + std::chrono::milliseconds(2500);
+  rec.repetition_time = std::chrono::milliseconds(3);
+  rec.timeout = std::chrono::hours(-1);
+  rec.intervals = {yardl::Duration(1), yardl::Duration(), std::chrono::seconds(-30)};
+  tw->WriteRecWithDurations(rec);
+
+  tw->Close();
+}
+
+TEST_P(RoundTripTests, RecursiveRecords) {
+  if (format_ == Format::kHdf5) {
+    GTEST_SKIP() << "Recursive records are not supported in HDF5";
+  }
+
+  auto tw = CreateValidatingWriter<ProtocolWithRecursiveRecordsWriterBase>();
+
+  TreeNode leaf{"leaf", {}};
+  TreeNode tree{"root", {TreeNode{"a", {leaf, leaf}}, TreeNode{"b", {}}}};
+  tw->WriteTree(tree);
+
+  LinkedListNode list{1, LinkedListNode{2, LinkedListNode{3, std::nullopt}}};
+  tw->WriteList(list);
+
+  Expression constant{"const", 42, {}};
+  Expression negate{"negate", constant, {}};
+  Expression call{"call", negate, {{"x", constant}, {"y", negate}}};
+  tw->WriteExpressions({constant, negate, call});
+  tw->EndExpressions();
+
+  tw->Close();
+}
+
+TEST_P(RoundTripTests, SubProtocols) {
+  auto tw = CreateValidatingWriter<ProtocolWithSubProtocolsWriterBase>();
+
+  tw->WriteHeader("header");
+
+  CalibrationWriterBase& calibration = tw->Calibration();
+  calibration.WriteGain(1.5f);
+  calibration.WriteSamples({1.0, 2.0});
+  calibration.WriteSamples(3.0);
+  calibration.EndSamples();
+  calibration.Close();
+
+  tw->WriteData({1, 2, 3});
+  tw->EndData();
+
+  tw->WriteRecalibrationGain(2.5f);
+  tw->EndRecalibrationSamples();
+
+  tw->Close();
+}
+
+TEST_P(RoundTripTests, HalfPrecision) {
+  auto tw = CreateValidatingWriter<ProtocolWithHalfPrecisionWriterBase>();
+
+  std::vector<float> halves = {1.5f, -0.25f, 65504.0f};
+  if (format_ != Format::kNDJson) {
+    // JSON has no representation of infinity
+    halves.push_back(std::numeric_limits<float>::infinity());
+  }
+  tw->WriteHalves(halves);
+
+  RecordWithHalfPrecision rec;
+  rec.half = 6.103515625e-05f;
+  rec.brain = -std::ldexp(1.0f, 100);
+  rec.half_vector = {0.0f, -2.0f};
+  rec.brain_array = {{1.0f, 2.5f, 1024.0f}, {-0.5f, 0.0f, 7.0f}};
+  tw->WriteRecWithHalves(rec);
+
+  tw->Close();
+}
+
 TEST_P(RoundTripTests, OptionalVectors_NoValue) {
   auto tw = CreateValidatingWriter<OptionalVectorsWriterBase>();
 
@@ -564,6 +678,24 @@ TEST_P(RoundTripTests, AdvancedGenerics) {
   tw->Close();
 }
 
+TEST_P(RoundTripTests, GenericProtocols) {
+  auto fw = CreateValidatingWriter<FloatImageStreamWriterBase>();
+  fw->WriteHeader({1, 2.5f, {3, 4}, {{1.5f, 2.5f}, {3.5f, 4.5f}}});
+  fw->WriteImages({{1, 2, 3}, {4, 5, 6}});
+  fw->WriteImages({{7, 8}, {9, 10}});
+  fw->EndImages();
+  fw->WriteBackground(0.5f);
+  fw->Close();
+
+  using cf = std::complex<float>;
+  auto cw = CreateValidatingWriter<ComplexImageStreamWriterBase>();
+  cw->WriteHeader({1, cf(2, 3), {3, 4}, {{cf(1, 2), cf(3, 4)}}});
+  cw->WriteImages({{cf(1, -1), cf(2, -2)}, {cf(3, -3), cf(4, -4)}});
+  cw->EndImages();
+  cw->WriteBackground("transparent");
+  cw->Close();
+}
+
 TEST_P(RoundTripTests, Aliases) {
   auto tw = CreateValidatingWriter<AliasesWriterBase>();
 
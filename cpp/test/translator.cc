@@ -2,9 +2,13 @@
 // Licensed under the MIT License.
 
 #include <algorithm>
+#include <filesystem>
+#include <fstream>
 #include <iostream>
+#include <random>
 #include <sstream>
 
+#include <H5Cpp.h>
 #include <nlohmann/json.hpp>
 
 #include "format.h"
@@ -17,7 +21,7 @@ using ordered_json = nlohmann::ordered_json;
 using yardl::testing::Format;
 
 namespace yardl::testing {
-void TranslateStream(std::string const& protocol_name, Format input_format, std::istream& input, Format output_format, std::ostream& output);
+void TranslateStream(std::string const& protocol_name, Format input_format, std::istream& input, std::string const& hdf5_input_path, Format output_format, std::ostream& output, std::string const& hdf5_output_path);
 }
 
 namespace {
@@ -26,17 +30,27 @@ Format parseFormat(std::string arg) {
 
   if (arg == "BINARY") {
     return Format::kBinary;
+  } else if (arg == "HDF5") {
+    return Format::kHdf5;
   } else if (arg == "NDJSON") {
     return Format::kNDJson;
   }
 
-  std::cerr << "Invalid format '" << arg << "'. Expected 'binary' or 'ndjson'" << std::endl;
+  std::cerr << "Invalid format '" << arg << "'. Expected 'binary', 'hdf5' or 'ndjson'" << std::endl;
   exit(1);
 }
 
-std::string GetProtocolName(std::istream& input, Format format) {
+std::string GetProtocolName(std::istream& input, std::string const& hdf5_path, Format format) {
   ordered_json parsed_schema;
   switch (format) {
+    case Format::kHdf5: {
+      // The file has a single group, named after the protocol
+      H5::H5File file(hdf5_path, H5F_ACC_RDONLY);
+      if (file.getNumObjs() != 1) {
+        throw std::runtime_error("Expected the HDF5 file to contain a single protocol group");
+      }
+      return file.getObjnameByIdx(0);
+    }
     case Format::kBinary: {
       yardl::binary::CodedInputStream input_stream(input);
       std::string schema = yardl::binary::ReadHeader(input_stream);
@@ -53,11 +67,32 @@ std::string GetProtocolName(std::istream& input, Format format) {
   return parsed_schema["protocol"]["name"].get<std::string>();
 }
 
+// HDF5 files are read from and written to a temporary directory,
+// so that they can be piped through stdin and stdout like the other formats.
+class TemporaryDirectory {
+ public:
+  TemporaryDirectory()
+      : path_(std::filesystem::temp_directory_path() /
+              ("yardl_translator_" + std::to_string(std::random_device{}()))) {
+    std::filesystem::create_directories(path_);
+  }
+
+  ~TemporaryDirectory() {
+    std::error_code ec;
+    std::filesystem::remove_all(path_, ec);
+  }
+
+  std::filesystem::path const& Path() const { return path_; }
+
+ private:
+  std::filesystem::path path_;
+};
+
 }  // namespace
 
 int main(int argc, char* argv[]) {
   if (argc != 3) {  // Check if argument count is correct
-    std::cerr << "Incorrect number of arguments. Usage: translator <binary | ndjson> <binary | ndjson>" << std::endl;
+    std::cerr << "Incorrect number of arguments. Usage: translator <binary | hdf5 | ndjson> <binary | hdf5 | ndjson>" << std::endl;
     return 1;
   }
 
@@ -71,12 +106,25 @@ int main(int argc, char* argv[]) {
     return 1;
   }
 
-  std::string protocol_name = GetProtocolName(buffered_input, inputFormat);
+  TemporaryDirectory temp_dir;
+  std::string hdf5_input_path = (temp_dir.Path() / "input.h5").string();
+  std::string hdf5_output_path = (temp_dir.Path() / "output.h5").string();
+  if (inputFormat == Format::kHdf5) {
+    std::ofstream hdf5_input(hdf5_input_path, std::ios::binary);
+    hdf5_input << buffered_input.rdbuf();
+  }
+
+  std::string protocol_name = GetProtocolName(buffered_input, hdf5_input_path, inputFormat);
 
   buffered_input.clear();  // clear possible failbit from last read where we may have reached EOF
   buffered_input.seekg(0);
 
-  yardl::testing::TranslateStream(protocol_name, inputFormat, buffered_input, outputFormat, std::cout);
+  yardl::testing::TranslateStream(protocol_name, inputFormat, buffered_input, hdf5_input_path, outputFormat, std::cout, hdf5_output_path);
+
+  if (outputFormat == Format::kHdf5) {
+    std::ifstream hdf5_output(hdf5_output_path, std::ios::binary);
+    std::cout << hdf5_output.rdbuf();
+  }
 
   return 0;
 }
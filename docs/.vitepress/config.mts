@@ -105,6 +105,7 @@ export default defineConfig({
           items: [
             { text: "Binary Encoding Format", link: "/reference/binary" },
             { text: "NDJSON Encoding Format", link: "/reference/ndjson" },
+            { text: "Protocol Buffers Export", link: "/reference/protobuf" },
             {
               text: "Protocol Schema JSON",
               link: "/reference/protocol-schema",
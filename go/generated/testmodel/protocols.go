@@ -0,0 +1,8168 @@
+// Code generated by the "yardl" tool. DO NOT EDIT.
+
+package testmodel
+
+import (
+	"github.com/microsoft/yardl/go/generated/basictypes"
+	"github.com/microsoft/yardl/go/generated/image"
+	"github.com/microsoft/yardl/go/generated/tuples"
+	"github.com/microsoft/yardl/go/generated/yardl"
+)
+
+// BenchmarkFloat256x256Schema is the schema written to the header of binary BenchmarkFloat256x256 streams.
+const BenchmarkFloat256x256Schema = `{"protocol":{"name":"BenchmarkFloat256x256","sequence":[{"name":"float256x256","type":{"stream":{"items":{"array":{"items":"float32","dimensions":[{"length":256},{"length":256}]}}}}}]},"types":null}`
+
+// benchmarkFloat256x256StepName returns the name of the step at the given state and whether it is a stream, or "" after the last step.
+func benchmarkFloat256x256StepName(state int) (name string, isStream bool) {
+	switch state {
+	case 0:
+		return "Float256x256", true
+	default:
+		return "", false
+	}
+}
+
+type BenchmarkFloat256x256Writer interface {
+	WriteFloat256x256(values ...[256][256]float32) error
+	EndFloat256x256() error
+	Close() error
+}
+
+// BenchmarkFloat256x256WriterImpl is implemented by concrete BenchmarkFloat256x256 writers.
+// Its methods are called by BenchmarkFloat256x256WriterBase after it has validated the order of calls.
+type BenchmarkFloat256x256WriterImpl interface {
+	WriteFloat256x256Impl(values [][256][256]float32) error
+	EndFloat256x256Impl() error
+	CloseImpl() error
+}
+
+// BenchmarkFloat256x256WriterBase implements BenchmarkFloat256x256Writer, ensuring that the protocol steps are written in order.
+type BenchmarkFloat256x256WriterBase struct {
+	impl  BenchmarkFloat256x256WriterImpl
+	state int
+}
+
+func NewBenchmarkFloat256x256WriterBase(impl BenchmarkFloat256x256WriterImpl) *BenchmarkFloat256x256WriterBase {
+	return &BenchmarkFloat256x256WriterBase{impl: impl}
+}
+
+func (w *BenchmarkFloat256x256WriterBase) WriteFloat256x256(values ...[256][256]float32) error {
+	if w.state != 0 {
+		return w.invalidState("WriteFloat256x256()")
+	}
+	return w.impl.WriteFloat256x256Impl(values)
+}
+
+func (w *BenchmarkFloat256x256WriterBase) EndFloat256x256() error {
+	if w.state != 0 {
+		return w.invalidState("EndFloat256x256()")
+	}
+	if err := w.impl.EndFloat256x256Impl(); err != nil {
+		return err
+	}
+	w.state = 1
+	return nil
+}
+
+func (w *BenchmarkFloat256x256WriterBase) Close() error {
+	if w.state != 1 {
+		return w.invalidState("Close()")
+	}
+	return w.impl.CloseImpl()
+}
+
+func (w *BenchmarkFloat256x256WriterBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, isStream := benchmarkFloat256x256StepName(w.state); name != "" {
+		expected = "Write" + name + "()"
+		if isStream {
+			expected += " or End" + name + "()"
+		}
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+type BenchmarkFloat256x256Reader interface {
+	// Returns false when the stream has no more items.
+	ReadFloat256x256() ([256][256]float32, bool, error)
+	Close() error
+}
+
+// BenchmarkFloat256x256ReaderImpl is implemented by concrete BenchmarkFloat256x256 readers.
+// Its methods are called by BenchmarkFloat256x256ReaderBase after it has validated the order of calls.
+type BenchmarkFloat256x256ReaderImpl interface {
+	ReadFloat256x256Impl() ([256][256]float32, bool, error)
+	CloseImpl() error
+}
+
+// BenchmarkFloat256x256ReaderBase implements BenchmarkFloat256x256Reader, ensuring that the protocol steps are read in order.
+type BenchmarkFloat256x256ReaderBase struct {
+	impl  BenchmarkFloat256x256ReaderImpl
+	state int
+}
+
+func NewBenchmarkFloat256x256ReaderBase(impl BenchmarkFloat256x256ReaderImpl) *BenchmarkFloat256x256ReaderBase {
+	return &BenchmarkFloat256x256ReaderBase{impl: impl}
+}
+
+func (r *BenchmarkFloat256x256ReaderBase) ReadFloat256x256() (value [256][256]float32, ok bool, err error) {
+	if r.state != 0 {
+		return value, false, r.invalidState("ReadFloat256x256()")
+	}
+	if value, ok, err = r.impl.ReadFloat256x256Impl(); err != nil || ok {
+		return
+	}
+	r.state = 1
+	return
+}
+
+func (r *BenchmarkFloat256x256ReaderBase) Close() error {
+	if r.state != 1 {
+		return r.invalidState("Close()")
+	}
+	return r.impl.CloseImpl()
+}
+
+func (r *BenchmarkFloat256x256ReaderBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, _ := benchmarkFloat256x256StepName(r.state); name != "" {
+		expected = "Read" + name + "()"
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+// BenchmarkInt256x256Schema is the schema written to the header of binary BenchmarkInt256x256 streams.
+const BenchmarkInt256x256Schema = `{"protocol":{"name":"BenchmarkInt256x256","sequence":[{"name":"int256x256","type":{"stream":{"items":{"array":{"items":"int32","dimensions":[{"length":256},{"length":256}]}}}}}]},"types":null}`
+
+// benchmarkInt256x256StepName returns the name of the step at the given state and whether it is a stream, or "" after the last step.
+func benchmarkInt256x256StepName(state int) (name string, isStream bool) {
+	switch state {
+	case 0:
+		return "Int256x256", true
+	default:
+		return "", false
+	}
+}
+
+type BenchmarkInt256x256Writer interface {
+	WriteInt256x256(values ...[256][256]int32) error
+	EndInt256x256() error
+	Close() error
+}
+
+// BenchmarkInt256x256WriterImpl is implemented by concrete BenchmarkInt256x256 writers.
+// Its methods are called by BenchmarkInt256x256WriterBase after it has validated the order of calls.
+type BenchmarkInt256x256WriterImpl interface {
+	WriteInt256x256Impl(values [][256][256]int32) error
+	EndInt256x256Impl() error
+	CloseImpl() error
+}
+
+// BenchmarkInt256x256WriterBase implements BenchmarkInt256x256Writer, ensuring that the protocol steps are written in order.
+type BenchmarkInt256x256WriterBase struct {
+	impl  BenchmarkInt256x256WriterImpl
+	state int
+}
+
+func NewBenchmarkInt256x256WriterBase(impl BenchmarkInt256x256WriterImpl) *BenchmarkInt256x256WriterBase {
+	return &BenchmarkInt256x256WriterBase{impl: impl}
+}
+
+func (w *BenchmarkInt256x256WriterBase) WriteInt256x256(values ...[256][256]int32) error {
+	if w.state != 0 {
+		return w.invalidState("WriteInt256x256()")
+	}
+	return w.impl.WriteInt256x256Impl(values)
+}
+
+func (w *BenchmarkInt256x256WriterBase) EndInt256x256() error {
+	if w.state != 0 {
+		return w.invalidState("EndInt256x256()")
+	}
+	if err := w.impl.EndInt256x256Impl(); err != nil {
+		return err
+	}
+	w.state = 1
+	return nil
+}
+
+func (w *BenchmarkInt256x256WriterBase) Close() error {
+	if w.state != 1 {
+		return w.invalidState("Close()")
+	}
+	return w.impl.CloseImpl()
+}
+
+func (w *BenchmarkInt256x256WriterBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, isStream := benchmarkInt256x256StepName(w.state); name != "" {
+		expected = "Write" + name + "()"
+		if isStream {
+			expected += " or End" + name + "()"
+		}
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+type BenchmarkInt256x256Reader interface {
+	// Returns false when the stream has no more items.
+	ReadInt256x256() ([256][256]int32, bool, error)
+	Close() error
+}
+
+// BenchmarkInt256x256ReaderImpl is implemented by concrete BenchmarkInt256x256 readers.
+// Its methods are called by BenchmarkInt256x256ReaderBase after it has validated the order of calls.
+type BenchmarkInt256x256ReaderImpl interface {
+	ReadInt256x256Impl() ([256][256]int32, bool, error)
+	CloseImpl() error
+}
+
+// BenchmarkInt256x256ReaderBase implements BenchmarkInt256x256Reader, ensuring that the protocol steps are read in order.
+type BenchmarkInt256x256ReaderBase struct {
+	impl  BenchmarkInt256x256ReaderImpl
+	state int
+}
+
+func NewBenchmarkInt256x256ReaderBase(impl BenchmarkInt256x256ReaderImpl) *BenchmarkInt256x256ReaderBase {
+	return &BenchmarkInt256x256ReaderBase{impl: impl}
+}
+
+func (r *BenchmarkInt256x256ReaderBase) ReadInt256x256() (value [256][256]int32, ok bool, err error) {
+	if r.state != 0 {
+		return value, false, r.invalidState("ReadInt256x256()")
+	}
+	if value, ok, err = r.impl.ReadInt256x256Impl(); err != nil || ok {
+		return
+	}
+	r.state = 1
+	return
+}
+
+func (r *BenchmarkInt256x256ReaderBase) Close() error {
+	if r.state != 1 {
+		return r.invalidState("Close()")
+	}
+	return r.impl.CloseImpl()
+}
+
+func (r *BenchmarkInt256x256ReaderBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, _ := benchmarkInt256x256StepName(r.state); name != "" {
+		expected = "Read" + name + "()"
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+// BenchmarkFloatVlenSchema is the schema written to the header of binary BenchmarkFloatVlen streams.
+const BenchmarkFloatVlenSchema = `{"protocol":{"name":"BenchmarkFloatVlen","sequence":[{"name":"floatArray","type":{"stream":{"items":{"array":{"items":"float32","dimensions":2}}}}}]},"types":null}`
+
+// benchmarkFloatVlenStepName returns the name of the step at the given state and whether it is a stream, or "" after the last step.
+func benchmarkFloatVlenStepName(state int) (name string, isStream bool) {
+	switch state {
+	case 0:
+		return "FloatArray", true
+	default:
+		return "", false
+	}
+}
+
+type BenchmarkFloatVlenWriter interface {
+	WriteFloatArray(values ...yardl.NDArray[float32]) error
+	EndFloatArray() error
+	Close() error
+}
+
+// BenchmarkFloatVlenWriterImpl is implemented by concrete BenchmarkFloatVlen writers.
+// Its methods are called by BenchmarkFloatVlenWriterBase after it has validated the order of calls.
+type BenchmarkFloatVlenWriterImpl interface {
+	WriteFloatArrayImpl(values []yardl.NDArray[float32]) error
+	EndFloatArrayImpl() error
+	CloseImpl() error
+}
+
+// BenchmarkFloatVlenWriterBase implements BenchmarkFloatVlenWriter, ensuring that the protocol steps are written in order.
+type BenchmarkFloatVlenWriterBase struct {
+	impl  BenchmarkFloatVlenWriterImpl
+	state int
+}
+
+func NewBenchmarkFloatVlenWriterBase(impl BenchmarkFloatVlenWriterImpl) *BenchmarkFloatVlenWriterBase {
+	return &BenchmarkFloatVlenWriterBase{impl: impl}
+}
+
+func (w *BenchmarkFloatVlenWriterBase) WriteFloatArray(values ...yardl.NDArray[float32]) error {
+	if w.state != 0 {
+		return w.invalidState("WriteFloatArray()")
+	}
+	return w.impl.WriteFloatArrayImpl(values)
+}
+
+func (w *BenchmarkFloatVlenWriterBase) EndFloatArray() error {
+	if w.state != 0 {
+		return w.invalidState("EndFloatArray()")
+	}
+	if err := w.impl.EndFloatArrayImpl(); err != nil {
+		return err
+	}
+	w.state = 1
+	return nil
+}
+
+func (w *BenchmarkFloatVlenWriterBase) Close() error {
+	if w.state != 1 {
+		return w.invalidState("Close()")
+	}
+	return w.impl.CloseImpl()
+}
+
+func (w *BenchmarkFloatVlenWriterBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, isStream := benchmarkFloatVlenStepName(w.state); name != "" {
+		expected = "Write" + name + "()"
+		if isStream {
+			expected += " or End" + name + "()"
+		}
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+type BenchmarkFloatVlenReader interface {
+	// Returns false when the stream has no more items.
+	ReadFloatArray() (yardl.NDArray[float32], bool, error)
+	Close() error
+}
+
+// BenchmarkFloatVlenReaderImpl is implemented by concrete BenchmarkFloatVlen readers.
+// Its methods are called by BenchmarkFloatVlenReaderBase after it has validated the order of calls.
+type BenchmarkFloatVlenReaderImpl interface {
+	ReadFloatArrayImpl() (yardl.NDArray[float32], bool, error)
+	CloseImpl() error
+}
+
+// BenchmarkFloatVlenReaderBase implements BenchmarkFloatVlenReader, ensuring that the protocol steps are read in order.
+type BenchmarkFloatVlenReaderBase struct {
+	impl  BenchmarkFloatVlenReaderImpl
+	state int
+}
+
+func NewBenchmarkFloatVlenReaderBase(impl BenchmarkFloatVlenReaderImpl) *BenchmarkFloatVlenReaderBase {
+	return &BenchmarkFloatVlenReaderBase{impl: impl}
+}
+
+func (r *BenchmarkFloatVlenReaderBase) ReadFloatArray() (value yardl.NDArray[float32], ok bool, err error) {
+	if r.state != 0 {
+		return value, false, r.invalidState("ReadFloatArray()")
+	}
+	if value, ok, err = r.impl.ReadFloatArrayImpl(); err != nil || ok {
+		return
+	}
+	r.state = 1
+	return
+}
+
+func (r *BenchmarkFloatVlenReaderBase) Close() error {
+	if r.state != 1 {
+		return r.invalidState("Close()")
+	}
+	return r.impl.CloseImpl()
+}
+
+func (r *BenchmarkFloatVlenReaderBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, _ := benchmarkFloatVlenStepName(r.state); name != "" {
+		expected = "Read" + name + "()"
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+// BenchmarkSmallRecordSchema is the schema written to the header of binary BenchmarkSmallRecord streams.
+const BenchmarkSmallRecordSchema = `{"protocol":{"name":"BenchmarkSmallRecord","sequence":[{"name":"smallRecord","type":{"stream":{"items":"TestModel.SmallBenchmarkRecord"}}}]},"types":[{"name":"SmallBenchmarkRecord","fields":[{"name":"a","type":"float64"},{"name":"b","type":"float32"},{"name":"c","type":"float32"}]}]}`
+
+// benchmarkSmallRecordStepName returns the name of the step at the given state and whether it is a stream, or "" after the last step.
+func benchmarkSmallRecordStepName(state int) (name string, isStream bool) {
+	switch state {
+	case 0:
+		return "SmallRecord", true
+	default:
+		return "", false
+	}
+}
+
+type BenchmarkSmallRecordWriter interface {
+	WriteSmallRecord(values ...SmallBenchmarkRecord) error
+	EndSmallRecord() error
+	Close() error
+}
+
+// BenchmarkSmallRecordWriterImpl is implemented by concrete BenchmarkSmallRecord writers.
+// Its methods are called by BenchmarkSmallRecordWriterBase after it has validated the order of calls.
+type BenchmarkSmallRecordWriterImpl interface {
+	WriteSmallRecordImpl(values []SmallBenchmarkRecord) error
+	EndSmallRecordImpl() error
+	CloseImpl() error
+}
+
+// BenchmarkSmallRecordWriterBase implements BenchmarkSmallRecordWriter, ensuring that the protocol steps are written in order.
+type BenchmarkSmallRecordWriterBase struct {
+	impl  BenchmarkSmallRecordWriterImpl
+	state int
+}
+
+func NewBenchmarkSmallRecordWriterBase(impl BenchmarkSmallRecordWriterImpl) *BenchmarkSmallRecordWriterBase {
+	return &BenchmarkSmallRecordWriterBase{impl: impl}
+}
+
+func (w *BenchmarkSmallRecordWriterBase) WriteSmallRecord(values ...SmallBenchmarkRecord) error {
+	if w.state != 0 {
+		return w.invalidState("WriteSmallRecord()")
+	}
+	return w.impl.WriteSmallRecordImpl(values)
+}
+
+func (w *BenchmarkSmallRecordWriterBase) EndSmallRecord() error {
+	if w.state != 0 {
+		return w.invalidState("EndSmallRecord()")
+	}
+	if err := w.impl.EndSmallRecordImpl(); err != nil {
+		return err
+	}
+	w.state = 1
+	return nil
+}
+
+func (w *BenchmarkSmallRecordWriterBase) Close() error {
+	if w.state != 1 {
+		return w.invalidState("Close()")
+	}
+	return w.impl.CloseImpl()
+}
+
+func (w *BenchmarkSmallRecordWriterBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, isStream := benchmarkSmallRecordStepName(w.state); name != "" {
+		expected = "Write" + name + "()"
+		if isStream {
+			expected += " or End" + name + "()"
+		}
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+type BenchmarkSmallRecordReader interface {
+	// Returns false when the stream has no more items.
+	ReadSmallRecord() (SmallBenchmarkRecord, bool, error)
+	Close() error
+}
+
+// BenchmarkSmallRecordReaderImpl is implemented by concrete BenchmarkSmallRecord readers.
+// Its methods are called by BenchmarkSmallRecordReaderBase after it has validated the order of calls.
+type BenchmarkSmallRecordReaderImpl interface {
+	ReadSmallRecordImpl() (SmallBenchmarkRecord, bool, error)
+	CloseImpl() error
+}
+
+// BenchmarkSmallRecordReaderBase implements BenchmarkSmallRecordReader, ensuring that the protocol steps are read in order.
+type BenchmarkSmallRecordReaderBase struct {
+	impl  BenchmarkSmallRecordReaderImpl
+	state int
+}
+
+func NewBenchmarkSmallRecordReaderBase(impl BenchmarkSmallRecordReaderImpl) *BenchmarkSmallRecordReaderBase {
+	return &BenchmarkSmallRecordReaderBase{impl: impl}
+}
+
+func (r *BenchmarkSmallRecordReaderBase) ReadSmallRecord() (value SmallBenchmarkRecord, ok bool, err error) {
+	if r.state != 0 {
+		return value, false, r.invalidState("ReadSmallRecord()")
+	}
+	if value, ok, err = r.impl.ReadSmallRecordImpl(); err != nil || ok {
+		return
+	}
+	r.state = 1
+	return
+}
+
+func (r *BenchmarkSmallRecordReaderBase) Close() error {
+	if r.state != 1 {
+		return r.invalidState("Close()")
+	}
+	return r.impl.CloseImpl()
+}
+
+func (r *BenchmarkSmallRecordReaderBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, _ := benchmarkSmallRecordStepName(r.state); name != "" {
+		expected = "Read" + name + "()"
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+// BenchmarkSmallRecordWithOptionalsSchema is the schema written to the header of binary BenchmarkSmallRecordWithOptionals streams.
+const BenchmarkSmallRecordWithOptionalsSchema = `{"protocol":{"name":"BenchmarkSmallRecordWithOptionals","sequence":[{"name":"smallRecord","type":{"stream":{"items":"TestModel.SimpleEncodingCounters"}}}]},"types":[{"name":"SimpleEncodingCounters","fields":[{"name":"e1","type":[null,"uint32"]},{"name":"e2","type":[null,"uint32"]},{"name":"slice","type":[null,"uint32"]},{"name":"repetition","type":[null,"uint32"]}]}]}`
+
+// benchmarkSmallRecordWithOptionalsStepName returns the name of the step at the given state and whether it is a stream, or "" after the last step.
+func benchmarkSmallRecordWithOptionalsStepName(state int) (name string, isStream bool) {
+	switch state {
+	case 0:
+		return "SmallRecord", true
+	default:
+		return "", false
+	}
+}
+
+type BenchmarkSmallRecordWithOptionalsWriter interface {
+	WriteSmallRecord(values ...SimpleEncodingCounters) error
+	EndSmallRecord() error
+	Close() error
+}
+
+// BenchmarkSmallRecordWithOptionalsWriterImpl is implemented by concrete BenchmarkSmallRecordWithOptionals writers.
+// Its methods are called by BenchmarkSmallRecordWithOptionalsWriterBase after it has validated the order of calls.
+type BenchmarkSmallRecordWithOptionalsWriterImpl interface {
+	WriteSmallRecordImpl(values []SimpleEncodingCounters) error
+	EndSmallRecordImpl() error
+	CloseImpl() error
+}
+
+// BenchmarkSmallRecordWithOptionalsWriterBase implements BenchmarkSmallRecordWithOptionalsWriter, ensuring that the protocol steps are written in order.
+type BenchmarkSmallRecordWithOptionalsWriterBase struct {
+	impl  BenchmarkSmallRecordWithOptionalsWriterImpl
+	state int
+}
+
+func NewBenchmarkSmallRecordWithOptionalsWriterBase(impl BenchmarkSmallRecordWithOptionalsWriterImpl) *BenchmarkSmallRecordWithOptionalsWriterBase {
+	return &BenchmarkSmallRecordWithOptionalsWriterBase{impl: impl}
+}
+
+func (w *BenchmarkSmallRecordWithOptionalsWriterBase) WriteSmallRecord(values ...SimpleEncodingCounters) error {
+	if w.state != 0 {
+		return w.invalidState("WriteSmallRecord()")
+	}
+	return w.impl.WriteSmallRecordImpl(values)
+}
+
+func (w *BenchmarkSmallRecordWithOptionalsWriterBase) EndSmallRecord() error {
+	if w.state != 0 {
+		return w.invalidState("EndSmallRecord()")
+	}
+	if err := w.impl.EndSmallRecordImpl(); err != nil {
+		return err
+	}
+	w.state = 1
+	return nil
+}
+
+func (w *BenchmarkSmallRecordWithOptionalsWriterBase) Close() error {
+	if w.state != 1 {
+		return w.invalidState("Close()")
+	}
+	return w.impl.CloseImpl()
+}
+
+func (w *BenchmarkSmallRecordWithOptionalsWriterBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, isStream := benchmarkSmallRecordWithOptionalsStepName(w.state); name != "" {
+		expected = "Write" + name + "()"
+		if isStream {
+			expected += " or End" + name + "()"
+		}
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+type BenchmarkSmallRecordWithOptionalsReader interface {
+	// Returns false when the stream has no more items.
+	ReadSmallRecord() (SimpleEncodingCounters, bool, error)
+	Close() error
+}
+
+// BenchmarkSmallRecordWithOptionalsReaderImpl is implemented by concrete BenchmarkSmallRecordWithOptionals readers.
+// Its methods are called by BenchmarkSmallRecordWithOptionalsReaderBase after it has validated the order of calls.
+type BenchmarkSmallRecordWithOptionalsReaderImpl interface {
+	ReadSmallRecordImpl() (SimpleEncodingCounters, bool, error)
+	CloseImpl() error
+}
+
+// BenchmarkSmallRecordWithOptionalsReaderBase implements BenchmarkSmallRecordWithOptionalsReader, ensuring that the protocol steps are read in order.
+type BenchmarkSmallRecordWithOptionalsReaderBase struct {
+	impl  BenchmarkSmallRecordWithOptionalsReaderImpl
+	state int
+}
+
+func NewBenchmarkSmallRecordWithOptionalsReaderBase(impl BenchmarkSmallRecordWithOptionalsReaderImpl) *BenchmarkSmallRecordWithOptionalsReaderBase {
+	return &BenchmarkSmallRecordWithOptionalsReaderBase{impl: impl}
+}
+
+func (r *BenchmarkSmallRecordWithOptionalsReaderBase) ReadSmallRecord() (value SimpleEncodingCounters, ok bool, err error) {
+	if r.state != 0 {
+		return value, false, r.invalidState("ReadSmallRecord()")
+	}
+	if value, ok, err = r.impl.ReadSmallRecordImpl(); err != nil || ok {
+		return
+	}
+	r.state = 1
+	return
+}
+
+func (r *BenchmarkSmallRecordWithOptionalsReaderBase) Close() error {
+	if r.state != 1 {
+		return r.invalidState("Close()")
+	}
+	return r.impl.CloseImpl()
+}
+
+func (r *BenchmarkSmallRecordWithOptionalsReaderBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, _ := benchmarkSmallRecordWithOptionalsStepName(r.state); name != "" {
+		expected = "Read" + name + "()"
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+// BenchmarkSimpleMrdSchema is the schema written to the header of binary BenchmarkSimpleMrd streams.
+const BenchmarkSimpleMrdSchema = `{"protocol":{"name":"BenchmarkSimpleMrd","sequence":[{"name":"data","type":{"stream":{"items":[{"tag":"acquisition","explicitTag":true,"type":"TestModel.SimpleAcquisition"},{"tag":"image","explicitTag":true,"type":{"name":"Image.Image","typeArguments":["float32"]}}]}}}]},"types":[{"name":"Image","typeParameters":["T"],"type":{"array":{"items":"T","dimensions":[{"name":"x"},{"name":"y"}]}}},{"name":"SimpleAcquisition","fields":[{"name":"flags","type":"uint64"},{"name":"idx","type":"TestModel.SimpleEncodingCounters"},{"name":"data","type":{"array":{"items":"complexfloat32","dimensions":2}}},{"name":"trajectory","type":{"array":{"items":"float32","dimensions":2}}}]},{"name":"SimpleEncodingCounters","fields":[{"name":"e1","type":[null,"uint32"]},{"name":"e2","type":[null,"uint32"]},{"name":"slice","type":[null,"uint32"]},{"name":"repetition","type":[null,"uint32"]}]}]}`
+
+// benchmarkSimpleMrdStepName returns the name of the step at the given state and whether it is a stream, or "" after the last step.
+func benchmarkSimpleMrdStepName(state int) (name string, isStream bool) {
+	switch state {
+	case 0:
+		return "Data", true
+	default:
+		return "", false
+	}
+}
+
+type BenchmarkSimpleMrdWriter interface {
+	WriteData(values ...AcquisitionOrImage) error
+	EndData() error
+	Close() error
+}
+
+// BenchmarkSimpleMrdWriterImpl is implemented by concrete BenchmarkSimpleMrd writers.
+// Its methods are called by BenchmarkSimpleMrdWriterBase after it has validated the order of calls.
+type BenchmarkSimpleMrdWriterImpl interface {
+	WriteDataImpl(values []AcquisitionOrImage) error
+	EndDataImpl() error
+	CloseImpl() error
+}
+
+// BenchmarkSimpleMrdWriterBase implements BenchmarkSimpleMrdWriter, ensuring that the protocol steps are written in order.
+type BenchmarkSimpleMrdWriterBase struct {
+	impl  BenchmarkSimpleMrdWriterImpl
+	state int
+}
+
+func NewBenchmarkSimpleMrdWriterBase(impl BenchmarkSimpleMrdWriterImpl) *BenchmarkSimpleMrdWriterBase {
+	return &BenchmarkSimpleMrdWriterBase{impl: impl}
+}
+
+func (w *BenchmarkSimpleMrdWriterBase) WriteData(values ...AcquisitionOrImage) error {
+	if w.state != 0 {
+		return w.invalidState("WriteData()")
+	}
+	return w.impl.WriteDataImpl(values)
+}
+
+func (w *BenchmarkSimpleMrdWriterBase) EndData() error {
+	if w.state != 0 {
+		return w.invalidState("EndData()")
+	}
+	if err := w.impl.EndDataImpl(); err != nil {
+		return err
+	}
+	w.state = 1
+	return nil
+}
+
+func (w *BenchmarkSimpleMrdWriterBase) Close() error {
+	if w.state != 1 {
+		return w.invalidState("Close()")
+	}
+	return w.impl.CloseImpl()
+}
+
+func (w *BenchmarkSimpleMrdWriterBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, isStream := benchmarkSimpleMrdStepName(w.state); name != "" {
+		expected = "Write" + name + "()"
+		if isStream {
+			expected += " or End" + name + "()"
+		}
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+type BenchmarkSimpleMrdReader interface {
+	// Returns false when the stream has no more items.
+	ReadData() (AcquisitionOrImage, bool, error)
+	Close() error
+}
+
+// BenchmarkSimpleMrdReaderImpl is implemented by concrete BenchmarkSimpleMrd readers.
+// Its methods are called by BenchmarkSimpleMrdReaderBase after it has validated the order of calls.
+type BenchmarkSimpleMrdReaderImpl interface {
+	ReadDataImpl() (AcquisitionOrImage, bool, error)
+	CloseImpl() error
+}
+
+// BenchmarkSimpleMrdReaderBase implements BenchmarkSimpleMrdReader, ensuring that the protocol steps are read in order.
+type BenchmarkSimpleMrdReaderBase struct {
+	impl  BenchmarkSimpleMrdReaderImpl
+	state int
+}
+
+func NewBenchmarkSimpleMrdReaderBase(impl BenchmarkSimpleMrdReaderImpl) *BenchmarkSimpleMrdReaderBase {
+	return &BenchmarkSimpleMrdReaderBase{impl: impl}
+}
+
+func (r *BenchmarkSimpleMrdReaderBase) ReadData() (value AcquisitionOrImage, ok bool, err error) {
+	if r.state != 0 {
+		return value, false, r.invalidState("ReadData()")
+	}
+	if value, ok, err = r.impl.ReadDataImpl(); err != nil || ok {
+		return
+	}
+	r.state = 1
+	return
+}
+
+func (r *BenchmarkSimpleMrdReaderBase) Close() error {
+	if r.state != 1 {
+		return r.invalidState("Close()")
+	}
+	return r.impl.CloseImpl()
+}
+
+func (r *BenchmarkSimpleMrdReaderBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, _ := benchmarkSimpleMrdStepName(r.state); name != "" {
+		expected = "Read" + name + "()"
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+// ScalarsSchema is the schema written to the header of binary Scalars streams.
+const ScalarsSchema = `{"protocol":{"name":"Scalars","sequence":[{"name":"int32","type":"int32"},{"name":"record","type":"TestModel.RecordWithPrimitives"}]},"types":[{"name":"RecordWithPrimitives","fields":[{"name":"boolField","type":"bool"},{"name":"int8Field","type":"int8"},{"name":"uint8Field","type":"uint8"},{"name":"int16Field","type":"int16"},{"name":"uint16Field","type":"uint16"},{"name":"int32Field","type":"int32"},{"name":"uint32Field","type":"uint32"},{"name":"int64Field","type":"int64"},{"name":"uint64Field","type":"uint64"},{"name":"sizeField","type":"size"},{"name":"float32Field","type":"float32"},{"name":"float64Field","type":"float64"},{"name":"complexfloat32Field","type":"complexfloat32"},{"name":"complexfloat64Field","type":"complexfloat64"},{"name":"dateField","type":"date"},{"name":"timeField","type":"time"},{"name":"datetimeField","type":"datetime"}]}]}`
+
+// scalarsStepName returns the name of the step at the given state and whether it is a stream, or "" after the last step.
+func scalarsStepName(state int) (name string, isStream bool) {
+	switch state {
+	case 0:
+		return "Int32", false
+	case 1:
+		return "Record", false
+	default:
+		return "", false
+	}
+}
+
+type ScalarsWriter interface {
+	WriteInt32(value int32) error
+	WriteRecord(value RecordWithPrimitives) error
+	Close() error
+}
+
+// ScalarsWriterImpl is implemented by concrete Scalars writers.
+// Its methods are called by ScalarsWriterBase after it has validated the order of calls.
+type ScalarsWriterImpl interface {
+	WriteInt32Impl(value int32) error
+	WriteRecordImpl(value RecordWithPrimitives) error
+	CloseImpl() error
+}
+
+// ScalarsWriterBase implements ScalarsWriter, ensuring that the protocol steps are written in order.
+type ScalarsWriterBase struct {
+	impl  ScalarsWriterImpl
+	state int
+}
+
+func NewScalarsWriterBase(impl ScalarsWriterImpl) *ScalarsWriterBase {
+	return &ScalarsWriterBase{impl: impl}
+}
+
+func (w *ScalarsWriterBase) WriteInt32(value int32) error {
+	if w.state != 0 {
+		return w.invalidState("WriteInt32()")
+	}
+	if err := w.impl.WriteInt32Impl(value); err != nil {
+		return err
+	}
+	w.state = 1
+	return nil
+}
+
+func (w *ScalarsWriterBase) WriteRecord(value RecordWithPrimitives) error {
+	if w.state != 1 {
+		return w.invalidState("WriteRecord()")
+	}
+	if err := w.impl.WriteRecordImpl(value); err != nil {
+		return err
+	}
+	w.state = 2
+	return nil
+}
+
+func (w *ScalarsWriterBase) Close() error {
+	if w.state != 2 {
+		return w.invalidState("Close()")
+	}
+	return w.impl.CloseImpl()
+}
+
+func (w *ScalarsWriterBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, isStream := scalarsStepName(w.state); name != "" {
+		expected = "Write" + name + "()"
+		if isStream {
+			expected += " or End" + name + "()"
+		}
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+type ScalarsReader interface {
+	ReadInt32() (int32, error)
+	ReadRecord() (RecordWithPrimitives, error)
+	Close() error
+}
+
+// ScalarsReaderImpl is implemented by concrete Scalars readers.
+// Its methods are called by ScalarsReaderBase after it has validated the order of calls.
+type ScalarsReaderImpl interface {
+	ReadInt32Impl() (int32, error)
+	ReadRecordImpl() (RecordWithPrimitives, error)
+	CloseImpl() error
+}
+
+// ScalarsReaderBase implements ScalarsReader, ensuring that the protocol steps are read in order.
+type ScalarsReaderBase struct {
+	impl  ScalarsReaderImpl
+	state int
+}
+
+func NewScalarsReaderBase(impl ScalarsReaderImpl) *ScalarsReaderBase {
+	return &ScalarsReaderBase{impl: impl}
+}
+
+func (r *ScalarsReaderBase) ReadInt32() (value int32, err error) {
+	if r.state != 0 {
+		return value, r.invalidState("ReadInt32()")
+	}
+	if value, err = r.impl.ReadInt32Impl(); err != nil {
+		return
+	}
+	r.state = 1
+	return
+}
+
+func (r *ScalarsReaderBase) ReadRecord() (value RecordWithPrimitives, err error) {
+	if r.state != 1 {
+		return value, r.invalidState("ReadRecord()")
+	}
+	if value, err = r.impl.ReadRecordImpl(); err != nil {
+		return
+	}
+	r.state = 2
+	return
+}
+
+func (r *ScalarsReaderBase) Close() error {
+	if r.state != 2 {
+		return r.invalidState("Close()")
+	}
+	return r.impl.CloseImpl()
+}
+
+func (r *ScalarsReaderBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, _ := scalarsStepName(r.state); name != "" {
+		expected = "Read" + name + "()"
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+// ScalarOptionalsSchema is the schema written to the header of binary ScalarOptionals streams.
+const ScalarOptionalsSchema = `{"protocol":{"name":"ScalarOptionals","sequence":[{"name":"optionalInt","type":[null,"int32"]},{"name":"optionalRecord","type":[null,"TestModel.SimpleRecord"]},{"name":"recordWithOptionalFields","type":"TestModel.RecordWithOptionalFields"},{"name":"optionalRecordWithOptionalFields","type":[null,"TestModel.RecordWithOptionalFields"]}]},"types":[{"name":"RecordWithOptionalFields","fields":[{"name":"optionalInt","type":[null,"int32"]},{"name":"optionalIntAlternateSyntax","type":[null,"int32"]},{"name":"optionalTime","type":[null,"time"]}]},{"name":"SimpleRecord","fields":[{"name":"x","type":"int32"},{"name":"y","type":"int32"},{"name":"z","type":"int32"}]}]}`
+
+// scalarOptionalsStepName returns the name of the step at the given state and whether it is a stream, or "" after the last step.
+func scalarOptionalsStepName(state int) (name string, isStream bool) {
+	switch state {
+	case 0:
+		return "OptionalInt", false
+	case 1:
+		return "OptionalRecord", false
+	case 2:
+		return "RecordWithOptionalFields", false
+	case 3:
+		return "OptionalRecordWithOptionalFields", false
+	default:
+		return "", false
+	}
+}
+
+type ScalarOptionalsWriter interface {
+	WriteOptionalInt(value *int32) error
+	WriteOptionalRecord(value *SimpleRecord) error
+	WriteRecordWithOptionalFields(value RecordWithOptionalFields) error
+	WriteOptionalRecordWithOptionalFields(value *RecordWithOptionalFields) error
+	Close() error
+}
+
+// ScalarOptionalsWriterImpl is implemented by concrete ScalarOptionals writers.
+// Its methods are called by ScalarOptionalsWriterBase after it has validated the order of calls.
+type ScalarOptionalsWriterImpl interface {
+	WriteOptionalIntImpl(value *int32) error
+	WriteOptionalRecordImpl(value *SimpleRecord) error
+	WriteRecordWithOptionalFieldsImpl(value RecordWithOptionalFields) error
+	WriteOptionalRecordWithOptionalFieldsImpl(value *RecordWithOptionalFields) error
+	CloseImpl() error
+}
+
+// ScalarOptionalsWriterBase implements ScalarOptionalsWriter, ensuring that the protocol steps are written in order.
+type ScalarOptionalsWriterBase struct {
+	impl  ScalarOptionalsWriterImpl
+	state int
+}
+
+func NewScalarOptionalsWriterBase(impl ScalarOptionalsWriterImpl) *ScalarOptionalsWriterBase {
+	return &ScalarOptionalsWriterBase{impl: impl}
+}
+
+func (w *ScalarOptionalsWriterBase) WriteOptionalInt(value *int32) error {
+	if w.state != 0 {
+		return w.invalidState("WriteOptionalInt()")
+	}
+	if err := w.impl.WriteOptionalIntImpl(value); err != nil {
+		return err
+	}
+	w.state = 1
+	return nil
+}
+
+func (w *ScalarOptionalsWriterBase) WriteOptionalRecord(value *SimpleRecord) error {
+	if w.state != 1 {
+		return w.invalidState("WriteOptionalRecord()")
+	}
+	if err := w.impl.WriteOptionalRecordImpl(value); err != nil {
+		return err
+	}
+	w.state = 2
+	return nil
+}
+
+func (w *ScalarOptionalsWriterBase) WriteRecordWithOptionalFields(value RecordWithOptionalFields) error {
+	if w.state != 2 {
+		return w.invalidState("WriteRecordWithOptionalFields()")
+	}
+	if err := w.impl.WriteRecordWithOptionalFieldsImpl(value); err != nil {
+		return err
+	}
+	w.state = 3
+	return nil
+}
+
+func (w *ScalarOptionalsWriterBase) WriteOptionalRecordWithOptionalFields(value *RecordWithOptionalFields) error {
+	if w.state != 3 {
+		return w.invalidState("WriteOptionalRecordWithOptionalFields()")
+	}
+	if err := w.impl.WriteOptionalRecordWithOptionalFieldsImpl(value); err != nil {
+		return err
+	}
+	w.state = 4
+	return nil
+}
+
+func (w *ScalarOptionalsWriterBase) Close() error {
+	if w.state != 4 {
+		return w.invalidState("Close()")
+	}
+	return w.impl.CloseImpl()
+}
+
+func (w *ScalarOptionalsWriterBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, isStream := scalarOptionalsStepName(w.state); name != "" {
+		expected = "Write" + name + "()"
+		if isStream {
+			expected += " or End" + name + "()"
+		}
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+type ScalarOptionalsReader interface {
+	ReadOptionalInt() (*int32, error)
+	ReadOptionalRecord() (*SimpleRecord, error)
+	ReadRecordWithOptionalFields() (RecordWithOptionalFields, error)
+	ReadOptionalRecordWithOptionalFields() (*RecordWithOptionalFields, error)
+	Close() error
+}
+
+// ScalarOptionalsReaderImpl is implemented by concrete ScalarOptionals readers.
+// Its methods are called by ScalarOptionalsReaderBase after it has validated the order of calls.
+type ScalarOptionalsReaderImpl interface {
+	ReadOptionalIntImpl() (*int32, error)
+	ReadOptionalRecordImpl() (*SimpleRecord, error)
+	ReadRecordWithOptionalFieldsImpl() (RecordWithOptionalFields, error)
+	ReadOptionalRecordWithOptionalFieldsImpl() (*RecordWithOptionalFields, error)
+	CloseImpl() error
+}
+
+// ScalarOptionalsReaderBase implements ScalarOptionalsReader, ensuring that the protocol steps are read in order.
+type ScalarOptionalsReaderBase struct {
+	impl  ScalarOptionalsReaderImpl
+	state int
+}
+
+func NewScalarOptionalsReaderBase(impl ScalarOptionalsReaderImpl) *ScalarOptionalsReaderBase {
+	return &ScalarOptionalsReaderBase{impl: impl}
+}
+
+func (r *ScalarOptionalsReaderBase) ReadOptionalInt() (value *int32, err error) {
+	if r.state != 0 {
+		return value, r.invalidState("ReadOptionalInt()")
+	}
+	if value, err = r.impl.ReadOptionalIntImpl(); err != nil {
+		return
+	}
+	r.state = 1
+	return
+}
+
+func (r *ScalarOptionalsReaderBase) ReadOptionalRecord() (value *SimpleRecord, err error) {
+	if r.state != 1 {
+		return value, r.invalidState("ReadOptionalRecord()")
+	}
+	if value, err = r.impl.ReadOptionalRecordImpl(); err != nil {
+		return
+	}
+	r.state = 2
+	return
+}
+
+func (r *ScalarOptionalsReaderBase) ReadRecordWithOptionalFields() (value RecordWithOptionalFields, err error) {
+	if r.state != 2 {
+		return value, r.invalidState("ReadRecordWithOptionalFields()")
+	}
+	if value, err = r.impl.ReadRecordWithOptionalFieldsImpl(); err != nil {
+		return
+	}
+	r.state = 3
+	return
+}
+
+func (r *ScalarOptionalsReaderBase) ReadOptionalRecordWithOptionalFields() (value *RecordWithOptionalFields, err error) {
+	if r.state != 3 {
+		return value, r.invalidState("ReadOptionalRecordWithOptionalFields()")
+	}
+	if value, err = r.impl.ReadOptionalRecordWithOptionalFieldsImpl(); err != nil {
+		return
+	}
+	r.state = 4
+	return
+}
+
+func (r *ScalarOptionalsReaderBase) Close() error {
+	if r.state != 4 {
+		return r.invalidState("Close()")
+	}
+	return r.impl.CloseImpl()
+}
+
+func (r *ScalarOptionalsReaderBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, _ := scalarOptionalsStepName(r.state); name != "" {
+		expected = "Read" + name + "()"
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+// NestedRecordsSchema is the schema written to the header of binary NestedRecords streams.
+const NestedRecordsSchema = `{"protocol":{"name":"NestedRecords","sequence":[{"name":"tupleWithRecords","type":"TestModel.TupleWithRecords"}]},"types":[{"name":"SimpleRecord","fields":[{"name":"x","type":"int32"},{"name":"y","type":"int32"},{"name":"z","type":"int32"}]},{"name":"TupleWithRecords","fields":[{"name":"a","type":"TestModel.SimpleRecord"},{"name":"b","type":"TestModel.SimpleRecord"}]}]}`
+
+// nestedRecordsStepName returns the name of the step at the given state and whether it is a stream, or "" after the last step.
+func nestedRecordsStepName(state int) (name string, isStream bool) {
+	switch state {
+	case 0:
+		return "TupleWithRecords", false
+	default:
+		return "", false
+	}
+}
+
+type NestedRecordsWriter interface {
+	WriteTupleWithRecords(value TupleWithRecords) error
+	Close() error
+}
+
+// NestedRecordsWriterImpl is implemented by concrete NestedRecords writers.
+// Its methods are called by NestedRecordsWriterBase after it has validated the order of calls.
+type NestedRecordsWriterImpl interface {
+	WriteTupleWithRecordsImpl(value TupleWithRecords) error
+	CloseImpl() error
+}
+
+// NestedRecordsWriterBase implements NestedRecordsWriter, ensuring that the protocol steps are written in order.
+type NestedRecordsWriterBase struct {
+	impl  NestedRecordsWriterImpl
+	state int
+}
+
+func NewNestedRecordsWriterBase(impl NestedRecordsWriterImpl) *NestedRecordsWriterBase {
+	return &NestedRecordsWriterBase{impl: impl}
+}
+
+func (w *NestedRecordsWriterBase) WriteTupleWithRecords(value TupleWithRecords) error {
+	if w.state != 0 {
+		return w.invalidState("WriteTupleWithRecords()")
+	}
+	if err := w.impl.WriteTupleWithRecordsImpl(value); err != nil {
+		return err
+	}
+	w.state = 1
+	return nil
+}
+
+func (w *NestedRecordsWriterBase) Close() error {
+	if w.state != 1 {
+		return w.invalidState("Close()")
+	}
+	return w.impl.CloseImpl()
+}
+
+func (w *NestedRecordsWriterBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, isStream := nestedRecordsStepName(w.state); name != "" {
+		expected = "Write" + name + "()"
+		if isStream {
+			expected += " or End" + name + "()"
+		}
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+type NestedRecordsReader interface {
+	ReadTupleWithRecords() (TupleWithRecords, error)
+	Close() error
+}
+
+// NestedRecordsReaderImpl is implemented by concrete NestedRecords readers.
+// Its methods are called by NestedRecordsReaderBase after it has validated the order of calls.
+type NestedRecordsReaderImpl interface {
+	ReadTupleWithRecordsImpl() (TupleWithRecords, error)
+	CloseImpl() error
+}
+
+// NestedRecordsReaderBase implements NestedRecordsReader, ensuring that the protocol steps are read in order.
+type NestedRecordsReaderBase struct {
+	impl  NestedRecordsReaderImpl
+	state int
+}
+
+func NewNestedRecordsReaderBase(impl NestedRecordsReaderImpl) *NestedRecordsReaderBase {
+	return &NestedRecordsReaderBase{impl: impl}
+}
+
+func (r *NestedRecordsReaderBase) ReadTupleWithRecords() (value TupleWithRecords, err error) {
+	if r.state != 0 {
+		return value, r.invalidState("ReadTupleWithRecords()")
+	}
+	if value, err = r.impl.ReadTupleWithRecordsImpl(); err != nil {
+		return
+	}
+	r.state = 1
+	return
+}
+
+func (r *NestedRecordsReaderBase) Close() error {
+	if r.state != 1 {
+		return r.invalidState("Close()")
+	}
+	return r.impl.CloseImpl()
+}
+
+func (r *NestedRecordsReaderBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, _ := nestedRecordsStepName(r.state); name != "" {
+		expected = "Read" + name + "()"
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+// VlensSchema is the schema written to the header of binary Vlens streams.
+const VlensSchema = `{"protocol":{"name":"Vlens","sequence":[{"name":"intVector","type":{"vector":{"items":"int32"}}},{"name":"complexVector","type":{"vector":{"items":"complexfloat32"}}},{"name":"recordWithVlens","type":"TestModel.RecordWithVlens"},{"name":"vlenOfRecordWithVlens","type":{"vector":{"items":"TestModel.RecordWithVlens"}}}]},"types":[{"name":"RecordWithVlens","fields":[{"name":"a","type":{"vector":{"items":"TestModel.SimpleRecord"}}},{"name":"b","type":"int32"},{"name":"c","type":"int32"}]},{"name":"SimpleRecord","fields":[{"name":"x","type":"int32"},{"name":"y","type":"int32"},{"name":"z","type":"int32"}]}]}`
+
+// vlensStepName returns the name of the step at the given state and whether it is a stream, or "" after the last step.
+func vlensStepName(state int) (name string, isStream bool) {
+	switch state {
+	case 0:
+		return "IntVector", false
+	case 1:
+		return "ComplexVector", false
+	case 2:
+		return "RecordWithVlens", false
+	case 3:
+		return "VlenOfRecordWithVlens", false
+	default:
+		return "", false
+	}
+}
+
+type VlensWriter interface {
+	WriteIntVector(value []int32) error
+	WriteComplexVector(value []complex64) error
+	WriteRecordWithVlens(value RecordWithVlens) error
+	WriteVlenOfRecordWithVlens(value []RecordWithVlens) error
+	Close() error
+}
+
+// VlensWriterImpl is implemented by concrete Vlens writers.
+// Its methods are called by VlensWriterBase after it has validated the order of calls.
+type VlensWriterImpl interface {
+	WriteIntVectorImpl(value []int32) error
+	WriteComplexVectorImpl(value []complex64) error
+	WriteRecordWithVlensImpl(value RecordWithVlens) error
+	WriteVlenOfRecordWithVlensImpl(value []RecordWithVlens) error
+	CloseImpl() error
+}
+
+// VlensWriterBase implements VlensWriter, ensuring that the protocol steps are written in order.
+type VlensWriterBase struct {
+	impl  VlensWriterImpl
+	state int
+}
+
+func NewVlensWriterBase(impl VlensWriterImpl) *VlensWriterBase {
+	return &VlensWriterBase{impl: impl}
+}
+
+func (w *VlensWriterBase) WriteIntVector(value []int32) error {
+	if w.state != 0 {
+		return w.invalidState("WriteIntVector()")
+	}
+	if err := w.impl.WriteIntVectorImpl(value); err != nil {
+		return err
+	}
+	w.state = 1
+	return nil
+}
+
+func (w *VlensWriterBase) WriteComplexVector(value []complex64) error {
+	if w.state != 1 {
+		return w.invalidState("WriteComplexVector()")
+	}
+	if err := w.impl.WriteComplexVectorImpl(value); err != nil {
+		return err
+	}
+	w.state = 2
+	return nil
+}
+
+func (w *VlensWriterBase) WriteRecordWithVlens(value RecordWithVlens) error {
+	if w.state != 2 {
+		return w.invalidState("WriteRecordWithVlens()")
+	}
+	if err := w.impl.WriteRecordWithVlensImpl(value); err != nil {
+		return err
+	}
+	w.state = 3
+	return nil
+}
+
+func (w *VlensWriterBase) WriteVlenOfRecordWithVlens(value []RecordWithVlens) error {
+	if w.state != 3 {
+		return w.invalidState("WriteVlenOfRecordWithVlens()")
+	}
+	if err := w.impl.WriteVlenOfRecordWithVlensImpl(value); err != nil {
+		return err
+	}
+	w.state = 4
+	return nil
+}
+
+func (w *VlensWriterBase) Close() error {
+	if w.state != 4 {
+		return w.invalidState("Close()")
+	}
+	return w.impl.CloseImpl()
+}
+
+func (w *VlensWriterBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, isStream := vlensStepName(w.state); name != "" {
+		expected = "Write" + name + "()"
+		if isStream {
+			expected += " or End" + name + "()"
+		}
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+type VlensReader interface {
+	ReadIntVector() ([]int32, error)
+	ReadComplexVector() ([]complex64, error)
+	ReadRecordWithVlens() (RecordWithVlens, error)
+	ReadVlenOfRecordWithVlens() ([]RecordWithVlens, error)
+	Close() error
+}
+
+// VlensReaderImpl is implemented by concrete Vlens readers.
+// Its methods are called by VlensReaderBase after it has validated the order of calls.
+type VlensReaderImpl interface {
+	ReadIntVectorImpl() ([]int32, error)
+	ReadComplexVectorImpl() ([]complex64, error)
+	ReadRecordWithVlensImpl() (RecordWithVlens, error)
+	ReadVlenOfRecordWithVlensImpl() ([]RecordWithVlens, error)
+	CloseImpl() error
+}
+
+// VlensReaderBase implements VlensReader, ensuring that the protocol steps are read in order.
+type VlensReaderBase struct {
+	impl  VlensReaderImpl
+	state int
+}
+
+func NewVlensReaderBase(impl VlensReaderImpl) *VlensReaderBase {
+	return &VlensReaderBase{impl: impl}
+}
+
+func (r *VlensReaderBase) ReadIntVector() (value []int32, err error) {
+	if r.state != 0 {
+		return value, r.invalidState("ReadIntVector()")
+	}
+	if value, err = r.impl.ReadIntVectorImpl(); err != nil {
+		return
+	}
+	r.state = 1
+	return
+}
+
+func (r *VlensReaderBase) ReadComplexVector() (value []complex64, err error) {
+	if r.state != 1 {
+		return value, r.invalidState("ReadComplexVector()")
+	}
+	if value, err = r.impl.ReadComplexVectorImpl(); err != nil {
+		return
+	}
+	r.state = 2
+	return
+}
+
+func (r *VlensReaderBase) ReadRecordWithVlens() (value RecordWithVlens, err error) {
+	if r.state != 2 {
+		return value, r.invalidState("ReadRecordWithVlens()")
+	}
+	if value, err = r.impl.ReadRecordWithVlensImpl(); err != nil {
+		return
+	}
+	r.state = 3
+	return
+}
+
+func (r *VlensReaderBase) ReadVlenOfRecordWithVlens() (value []RecordWithVlens, err error) {
+	if r.state != 3 {
+		return value, r.invalidState("ReadVlenOfRecordWithVlens()")
+	}
+	if value, err = r.impl.ReadVlenOfRecordWithVlensImpl(); err != nil {
+		return
+	}
+	r.state = 4
+	return
+}
+
+func (r *VlensReaderBase) Close() error {
+	if r.state != 4 {
+		return r.invalidState("Close()")
+	}
+	return r.impl.CloseImpl()
+}
+
+func (r *VlensReaderBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, _ := vlensStepName(r.state); name != "" {
+		expected = "Read" + name + "()"
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+// StringsSchema is the schema written to the header of binary Strings streams.
+const StringsSchema = `{"protocol":{"name":"Strings","sequence":[{"name":"singleString","type":"string"},{"name":"recWithString","type":"TestModel.RecordWithStrings"}]},"types":[{"name":"RecordWithStrings","fields":[{"name":"a","type":"string"},{"name":"b","type":"string"}]}]}`
+
+// stringsStepName returns the name of the step at the given state and whether it is a stream, or "" after the last step.
+func stringsStepName(state int) (name string, isStream bool) {
+	switch state {
+	case 0:
+		return "SingleString", false
+	case 1:
+		return "RecWithString", false
+	default:
+		return "", false
+	}
+}
+
+type StringsWriter interface {
+	WriteSingleString(value string) error
+	WriteRecWithString(value RecordWithStrings) error
+	Close() error
+}
+
+// StringsWriterImpl is implemented by concrete Strings writers.
+// Its methods are called by StringsWriterBase after it has validated the order of calls.
+type StringsWriterImpl interface {
+	WriteSingleStringImpl(value string) error
+	WriteRecWithStringImpl(value RecordWithStrings) error
+	CloseImpl() error
+}
+
+// StringsWriterBase implements StringsWriter, ensuring that the protocol steps are written in order.
+type StringsWriterBase struct {
+	impl  StringsWriterImpl
+	state int
+}
+
+func NewStringsWriterBase(impl StringsWriterImpl) *StringsWriterBase {
+	return &StringsWriterBase{impl: impl}
+}
+
+func (w *StringsWriterBase) WriteSingleString(value string) error {
+	if w.state != 0 {
+		return w.invalidState("WriteSingleString()")
+	}
+	if err := w.impl.WriteSingleStringImpl(value); err != nil {
+		return err
+	}
+	w.state = 1
+	return nil
+}
+
+func (w *StringsWriterBase) WriteRecWithString(value RecordWithStrings) error {
+	if w.state != 1 {
+		return w.invalidState("WriteRecWithString()")
+	}
+	if err := w.impl.WriteRecWithStringImpl(value); err != nil {
+		return err
+	}
+	w.state = 2
+	return nil
+}
+
+func (w *StringsWriterBase) Close() error {
+	if w.state != 2 {
+		return w.invalidState("Close()")
+	}
+	return w.impl.CloseImpl()
+}
+
+func (w *StringsWriterBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, isStream := stringsStepName(w.state); name != "" {
+		expected = "Write" + name + "()"
+		if isStream {
+			expected += " or End" + name + "()"
+		}
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+type StringsReader interface {
+	ReadSingleString() (string, error)
+	ReadRecWithString() (RecordWithStrings, error)
+	Close() error
+}
+
+// StringsReaderImpl is implemented by concrete Strings readers.
+// Its methods are called by StringsReaderBase after it has validated the order of calls.
+type StringsReaderImpl interface {
+	ReadSingleStringImpl() (string, error)
+	ReadRecWithStringImpl() (RecordWithStrings, error)
+	CloseImpl() error
+}
+
+// StringsReaderBase implements StringsReader, ensuring that the protocol steps are read in order.
+type StringsReaderBase struct {
+	impl  StringsReaderImpl
+	state int
+}
+
+func NewStringsReaderBase(impl StringsReaderImpl) *StringsReaderBase {
+	return &StringsReaderBase{impl: impl}
+}
+
+func (r *StringsReaderBase) ReadSingleString() (value string, err error) {
+	if r.state != 0 {
+		return value, r.invalidState("ReadSingleString()")
+	}
+	if value, err = r.impl.ReadSingleStringImpl(); err != nil {
+		return
+	}
+	r.state = 1
+	return
+}
+
+func (r *StringsReaderBase) ReadRecWithString() (value RecordWithStrings, err error) {
+	if r.state != 1 {
+		return value, r.invalidState("ReadRecWithString()")
+	}
+	if value, err = r.impl.ReadRecWithStringImpl(); err != nil {
+		return
+	}
+	r.state = 2
+	return
+}
+
+func (r *StringsReaderBase) Close() error {
+	if r.state != 2 {
+		return r.invalidState("Close()")
+	}
+	return r.impl.CloseImpl()
+}
+
+func (r *StringsReaderBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, _ := stringsStepName(r.state); name != "" {
+		expected = "Read" + name + "()"
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+// ProtocolWithBytesSchema is the schema written to the header of binary ProtocolWithBytes streams.
+const ProtocolWithBytesSchema = `{"protocol":{"name":"ProtocolWithBytes","sequence":[{"name":"singleBytes","type":"bytes"},{"name":"recWithBytes","type":"TestModel.RecordWithBytes"}]},"types":[{"name":"RecordWithBytes","fields":[{"name":"data","type":"bytes"},{"name":"optionalData","type":[null,"bytes"]},{"name":"chunks","type":{"vector":{"items":"bytes"}}}]}]}`
+
+// protocolWithBytesStepName returns the name of the step at the given state and whether it is a stream, or "" after the last step.
+func protocolWithBytesStepName(state int) (name string, isStream bool) {
+	switch state {
+	case 0:
+		return "SingleBytes", false
+	case 1:
+		return "RecWithBytes", false
+	default:
+		return "", false
+	}
+}
+
+type ProtocolWithBytesWriter interface {
+	WriteSingleBytes(value []byte) error
+	WriteRecWithBytes(value RecordWithBytes) error
+	Close() error
+}
+
+// ProtocolWithBytesWriterImpl is implemented by concrete ProtocolWithBytes writers.
+// Its methods are called by ProtocolWithBytesWriterBase after it has validated the order of calls.
+type ProtocolWithBytesWriterImpl interface {
+	WriteSingleBytesImpl(value []byte) error
+	WriteRecWithBytesImpl(value RecordWithBytes) error
+	CloseImpl() error
+}
+
+// ProtocolWithBytesWriterBase implements ProtocolWithBytesWriter, ensuring that the protocol steps are written in order.
+type ProtocolWithBytesWriterBase struct {
+	impl  ProtocolWithBytesWriterImpl
+	state int
+}
+
+func NewProtocolWithBytesWriterBase(impl ProtocolWithBytesWriterImpl) *ProtocolWithBytesWriterBase {
+	return &ProtocolWithBytesWriterBase{impl: impl}
+}
+
+func (w *ProtocolWithBytesWriterBase) WriteSingleBytes(value []byte) error {
+	if w.state != 0 {
+		return w.invalidState("WriteSingleBytes()")
+	}
+	if err := w.impl.WriteSingleBytesImpl(value); err != nil {
+		return err
+	}
+	w.state = 1
+	return nil
+}
+
+func (w *ProtocolWithBytesWriterBase) WriteRecWithBytes(value RecordWithBytes) error {
+	if w.state != 1 {
+		return w.invalidState("WriteRecWithBytes()")
+	}
+	if err := w.impl.WriteRecWithBytesImpl(value); err != nil {
+		return err
+	}
+	w.state = 2
+	return nil
+}
+
+func (w *ProtocolWithBytesWriterBase) Close() error {
+	if w.state != 2 {
+		return w.invalidState("Close()")
+	}
+	return w.impl.CloseImpl()
+}
+
+func (w *ProtocolWithBytesWriterBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, isStream := protocolWithBytesStepName(w.state); name != "" {
+		expected = "Write" + name + "()"
+		if isStream {
+			expected += " or End" + name + "()"
+		}
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+type ProtocolWithBytesReader interface {
+	ReadSingleBytes() ([]byte, error)
+	ReadRecWithBytes() (RecordWithBytes, error)
+	Close() error
+}
+
+// ProtocolWithBytesReaderImpl is implemented by concrete ProtocolWithBytes readers.
+// Its methods are called by ProtocolWithBytesReaderBase after it has validated the order of calls.
+type ProtocolWithBytesReaderImpl interface {
+	ReadSingleBytesImpl() ([]byte, error)
+	ReadRecWithBytesImpl() (RecordWithBytes, error)
+	CloseImpl() error
+}
+
+// ProtocolWithBytesReaderBase implements ProtocolWithBytesReader, ensuring that the protocol steps are read in order.
+type ProtocolWithBytesReaderBase struct {
+	impl  ProtocolWithBytesReaderImpl
+	state int
+}
+
+func NewProtocolWithBytesReaderBase(impl ProtocolWithBytesReaderImpl) *ProtocolWithBytesReaderBase {
+	return &ProtocolWithBytesReaderBase{impl: impl}
+}
+
+func (r *ProtocolWithBytesReaderBase) ReadSingleBytes() (value []byte, err error) {
+	if r.state != 0 {
+		return value, r.invalidState("ReadSingleBytes()")
+	}
+	if value, err = r.impl.ReadSingleBytesImpl(); err != nil {
+		return
+	}
+	r.state = 1
+	return
+}
+
+func (r *ProtocolWithBytesReaderBase) ReadRecWithBytes() (value RecordWithBytes, err error) {
+	if r.state != 1 {
+		return value, r.invalidState("ReadRecWithBytes()")
+	}
+	if value, err = r.impl.ReadRecWithBytesImpl(); err != nil {
+		return
+	}
+	r.state = 2
+	return
+}
+
+func (r *ProtocolWithBytesReaderBase) Close() error {
+	if r.state != 2 {
+		return r.invalidState("Close()")
+	}
+	return r.impl.CloseImpl()
+}
+
+func (r *ProtocolWithBytesReaderBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, _ := protocolWithBytesStepName(r.state); name != "" {
+		expected = "Read" + name + "()"
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+// ProtocolWithUuidsSchema is the schema written to the header of binary ProtocolWithUuids streams.
+const ProtocolWithUuidsSchema = `{"protocol":{"name":"ProtocolWithUuids","sequence":[{"name":"singleUuid","type":"uuid"},{"name":"recWithUuids","type":"TestModel.RecordWithUuids"}]},"types":[{"name":"RecordWithUuids","fields":[{"name":"id","type":"uuid"},{"name":"optionalId","type":[null,"uuid"]},{"name":"related","type":{"vector":{"items":"uuid"}}},{"name":"names","type":{"map":{"keys":"uuid","values":"string"}}}]}]}`
+
+// protocolWithUuidsStepName returns the name of the step at the given state and whether it is a stream, or "" after the last step.
+func protocolWithUuidsStepName(state int) (name string, isStream bool) {
+	switch state {
+	case 0:
+		return "SingleUuid", false
+	case 1:
+		return "RecWithUuids", false
+	default:
+		return "", false
+	}
+}
+
+type ProtocolWithUuidsWriter interface {
+	WriteSingleUuid(value yardl.Uuid) error
+	WriteRecWithUuids(value RecordWithUuids) error
+	Close() error
+}
+
+// ProtocolWithUuidsWriterImpl is implemented by concrete ProtocolWithUuids writers.
+// Its methods are called by ProtocolWithUuidsWriterBase after it has validated the order of calls.
+type ProtocolWithUuidsWriterImpl interface {
+	WriteSingleUuidImpl(value yardl.Uuid) error
+	WriteRecWithUuidsImpl(value RecordWithUuids) error
+	CloseImpl() error
+}
+
+// ProtocolWithUuidsWriterBase implements ProtocolWithUuidsWriter, ensuring that the protocol steps are written in order.
+type ProtocolWithUuidsWriterBase struct {
+	impl  ProtocolWithUuidsWriterImpl
+	state int
+}
+
+func NewProtocolWithUuidsWriterBase(impl ProtocolWithUuidsWriterImpl) *ProtocolWithUuidsWriterBase {
+	return &ProtocolWithUuidsWriterBase{impl: impl}
+}
+
+func (w *ProtocolWithUuidsWriterBase) WriteSingleUuid(value yardl.Uuid) error {
+	if w.state != 0 {
+		return w.invalidState("WriteSingleUuid()")
+	}
+	if err := w.impl.WriteSingleUuidImpl(value); err != nil {
+		return err
+	}
+	w.state = 1
+	return nil
+}
+
+func (w *ProtocolWithUuidsWriterBase) WriteRecWithUuids(value RecordWithUuids) error {
+	if w.state != 1 {
+		return w.invalidState("WriteRecWithUuids()")
+	}
+	if err := w.impl.WriteRecWithUuidsImpl(value); err != nil {
+		return err
+	}
+	w.state = 2
+	return nil
+}
+
+func (w *ProtocolWithUuidsWriterBase) Close() error {
+	if w.state != 2 {
+		return w.invalidState("Close()")
+	}
+	return w.impl.CloseImpl()
+}
+
+func (w *ProtocolWithUuidsWriterBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, isStream := protocolWithUuidsStepName(w.state); name != "" {
+		expected = "Write" + name + "()"
+		if isStream {
+			expected += " or End" + name + "()"
+		}
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+type ProtocolWithUuidsReader interface {
+	ReadSingleUuid() (yardl.Uuid, error)
+	ReadRecWithUuids() (RecordWithUuids, error)
+	Close() error
+}
+
+// ProtocolWithUuidsReaderImpl is implemented by concrete ProtocolWithUuids readers.
+// Its methods are called by ProtocolWithUuidsReaderBase after it has validated the order of calls.
+type ProtocolWithUuidsReaderImpl interface {
+	ReadSingleUuidImpl() (yardl.Uuid, error)
+	ReadRecWithUuidsImpl() (RecordWithUuids, error)
+	CloseImpl() error
+}
+
+// ProtocolWithUuidsReaderBase implements ProtocolWithUuidsReader, ensuring that the protocol steps are read in order.
+type ProtocolWithUuidsReaderBase struct {
+	impl  ProtocolWithUuidsReaderImpl
+	state int
+}
+
+func NewProtocolWithUuidsReaderBase(impl ProtocolWithUuidsReaderImpl) *ProtocolWithUuidsReaderBase {
+	return &ProtocolWithUuidsReaderBase{impl: impl}
+}
+
+func (r *ProtocolWithUuidsReaderBase) ReadSingleUuid() (value yardl.Uuid, err error) {
+	if r.state != 0 {
+		return value, r.invalidState("ReadSingleUuid()")
+	}
+	if value, err = r.impl.ReadSingleUuidImpl(); err != nil {
+		return
+	}
+	r.state = 1
+	return
+}
+
+func (r *ProtocolWithUuidsReaderBase) ReadRecWithUuids() (value RecordWithUuids, err error) {
+	if r.state != 1 {
+		return value, r.invalidState("ReadRecWithUuids()")
+	}
+	if value, err = r.impl.ReadRecWithUuidsImpl(); err != nil {
+		return
+	}
+	r.state = 2
+	return
+}
+
+func (r *ProtocolWithUuidsReaderBase) Close() error {
+	if r.state != 2 {
+		return r.invalidState("Close()")
+	}
+	return r.impl.CloseImpl()
+}
+
+func (r *ProtocolWithUuidsReaderBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, _ := protocolWithUuidsStepName(r.state); name != "" {
+		expected = "Read" + name + "()"
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+// ProtocolWithDurationsSchema is the schema written to the header of binary ProtocolWithDurations streams.
+const ProtocolWithDurationsSchema = `{"protocol":{"name":"ProtocolWithDurations","sequence":[{"name":"singleDuration","type":"duration"},{"name":"recWithDurations","type":"TestModel.RecordWithDurations"}]},"types":[{"name":"RecordWithDurations","fields":[{"name":"acquisitionStart","type":"datetime"},{"name":"acquisitionEnd","type":"datetime"},{"name":"repetitionTime","type":"duration"},{"name":"timeout","type":[null,"duration"]},{"name":"intervals","type":{"vector":{"items":"duration"}}}]}]}`
+
+// protocolWithDurationsStepName returns the name of the step at the given state and whether it is a stream, or "" after the last step.
+func protocolWithDurationsStepName(state int) (name string, isStream bool) {
+	switch state {
+	case 0:
+		return "SingleDuration", false
+	case 1:
+		return "RecWithDurations", false
+	default:
+		return "", false
+	}
+}
+
+type ProtocolWithDurationsWriter interface {
+	WriteSingleDuration(value yardl.Duration) error
+	WriteRecWithDurations(value RecordWithDurations) error
+	Close() error
+}
+
+// ProtocolWithDurationsWriterImpl is implemented by concrete ProtocolWithDurations writers.
+// Its methods are called by ProtocolWithDurationsWriterBase after it has validated the order of calls.
+type ProtocolWithDurationsWriterImpl interface {
+	WriteSingleDurationImpl(value yardl.Duration) error
+	WriteRecWithDurationsImpl(value RecordWithDurations) error
+	CloseImpl() error
+}
+
+// ProtocolWithDurationsWriterBase implements ProtocolWithDurationsWriter, ensuring that the protocol steps are written in order.
+type ProtocolWithDurationsWriterBase struct {
+	impl  ProtocolWithDurationsWriterImpl
+	state int
+}
+
+func NewProtocolWithDurationsWriterBase(impl ProtocolWithDurationsWriterImpl) *ProtocolWithDurationsWriterBase {
+	return &ProtocolWithDurationsWriterBase{impl: impl}
+}
+
+func (w *ProtocolWithDurationsWriterBase) WriteSingleDuration(value yardl.Duration) error {
+	if w.state != 0 {
+		return w.invalidState("WriteSingleDuration()")
+	}
+	if err := w.impl.WriteSingleDurationImpl(value); err != nil {
+		return err
+	}
+	w.state = 1
+	return nil
+}
+
+func (w *ProtocolWithDurationsWriterBase) WriteRecWithDurations(value RecordWithDurations) error {
+	if w.state != 1 {
+		return w.invalidState("WriteRecWithDurations()")
+	}
+	if err := w.impl.WriteRecWithDurationsImpl(value); err != nil {
+		return err
+	}
+	w.state = 2
+	return nil
+}
+
+func (w *ProtocolWithDurationsWriterBase) Close() error {
+	if w.state != 2 {
+		return w.invalidState("Close()")
+	}
+	return w.impl.CloseImpl()
+}
+
+func (w *ProtocolWithDurationsWriterBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, isStream := protocolWithDurationsStepName(w.state); name != "" {
+		expected = "Write" + name + "()"
+		if isStream {
+			expected += " or End" + name + "()"
+		}
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+type ProtocolWithDurationsReader interface {
+	ReadSingleDuration() (yardl.Duration, error)
+	ReadRecWithDurations() (RecordWithDurations, error)
+	Close() error
+}
+
+// ProtocolWithDurationsReaderImpl is implemented by concrete ProtocolWithDurations readers.
+// Its methods are called by ProtocolWithDurationsReaderBase after it has validated the order of calls.
+type ProtocolWithDurationsReaderImpl interface {
+	ReadSingleDurationImpl() (yardl.Duration, error)
+	ReadRecWithDurationsImpl() (RecordWithDurations, error)
+	CloseImpl() error
+}
+
+// ProtocolWithDurationsReaderBase implements ProtocolWithDurationsReader, ensuring that the protocol steps are read in order.
+type ProtocolWithDurationsReaderBase struct {
+	impl  ProtocolWithDurationsReaderImpl
+	state int
+}
+
+func NewProtocolWithDurationsReaderBase(impl ProtocolWithDurationsReaderImpl) *ProtocolWithDurationsReaderBase {
+	return &ProtocolWithDurationsReaderBase{impl: impl}
+}
+
+func (r *ProtocolWithDurationsReaderBase) ReadSingleDuration() (value yardl.Duration, err error) {
+	if r.state != 0 {
+		return value, r.invalidState("ReadSingleDuration()")
+	}
+	if value, err = r.impl.ReadSingleDurationImpl(); err != nil {
+		return
+	}
+	r.state = 1
+	return
+}
+
+func (r *ProtocolWithDurationsReaderBase) ReadRecWithDurations() (value RecordWithDurations, err error) {
+	if r.state != 1 {
+		return value, r.invalidState("ReadRecWithDurations()")
+	}
+	if value, err = r.impl.ReadRecWithDurationsImpl(); err != nil {
+		return
+	}
+	r.state = 2
+	return
+}
+
+func (r *ProtocolWithDurationsReaderBase) Close() error {
+	if r.state != 2 {
+		return r.invalidState("Close()")
+	}
+	return r.impl.CloseImpl()
+}
+
+func (r *ProtocolWithDurationsReaderBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, _ := protocolWithDurationsStepName(r.state); name != "" {
+		expected = "Read" + name + "()"
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+// ProtocolWithRecursiveRecordsSchema is the schema written to the header of binary ProtocolWithRecursiveRecords streams.
+const ProtocolWithRecursiveRecordsSchema = `{"protocol":{"name":"ProtocolWithRecursiveRecords","sequence":[{"name":"tree","type":"TestModel.TreeNode"},{"name":"list","type":"TestModel.LinkedListNode"},{"name":"expressions","type":{"stream":{"items":"TestModel.Expression"}}}]},"types":[{"name":"Expression","fields":[{"name":"name","type":"string"},{"name":"operand","type":[{"tag":"int32","type":"int32"},{"tag":"Expression","type":"TestModel.Expression"}]},{"name":"arguments","type":{"map":{"keys":"string","values":"TestModel.Expression"}}}]},{"name":"LinkedListNode","fields":[{"name":"value","type":"int32"},{"name":"next","type":[null,"TestModel.LinkedListNode"]}]},{"name":"TreeNode","fields":[{"name":"label","type":"string"},{"name":"children","type":{"vector":{"items":"TestModel.TreeNode"}}}]}]}`
+
+// protocolWithRecursiveRecordsStepName returns the name of the step at the given state and whether it is a stream, or "" after the last step.
+func protocolWithRecursiveRecordsStepName(state int) (name string, isStream bool) {
+	switch state {
+	case 0:
+		return "Tree", false
+	case 1:
+		return "List", false
+	case 2:
+		return "Expressions", true
+	default:
+		return "", false
+	}
+}
+
+type ProtocolWithRecursiveRecordsWriter interface {
+	WriteTree(value TreeNode) error
+	WriteList(value LinkedListNode) error
+	WriteExpressions(values ...Expression) error
+	EndExpressions() error
+	Close() error
+}
+
+// ProtocolWithRecursiveRecordsWriterImpl is implemented by concrete ProtocolWithRecursiveRecords writers.
+// Its methods are called by ProtocolWithRecursiveRecordsWriterBase after it has validated the order of calls.
+type ProtocolWithRecursiveRecordsWriterImpl interface {
+	WriteTreeImpl(value TreeNode) error
+	WriteListImpl(value LinkedListNode) error
+	WriteExpressionsImpl(values []Expression) error
+	EndExpressionsImpl() error
+	CloseImpl() error
+}
+
+// ProtocolWithRecursiveRecordsWriterBase implements ProtocolWithRecursiveRecordsWriter, ensuring that the protocol steps are written in order.
+type ProtocolWithRecursiveRecordsWriterBase struct {
+	impl  ProtocolWithRecursiveRecordsWriterImpl
+	state int
+}
+
+func NewProtocolWithRecursiveRecordsWriterBase(impl ProtocolWithRecursiveRecordsWriterImpl) *ProtocolWithRecursiveRecordsWriterBase {
+	return &ProtocolWithRecursiveRecordsWriterBase{impl: impl}
+}
+
+func (w *ProtocolWithRecursiveRecordsWriterBase) WriteTree(value TreeNode) error {
+	if w.state != 0 {
+		return w.invalidState("WriteTree()")
+	}
+	if err := w.impl.WriteTreeImpl(value); err != nil {
+		return err
+	}
+	w.state = 1
+	return nil
+}
+
+func (w *ProtocolWithRecursiveRecordsWriterBase) WriteList(value LinkedListNode) error {
+	if w.state != 1 {
+		return w.invalidState("WriteList()")
+	}
+	if err := w.impl.WriteListImpl(value); err != nil {
+		return err
+	}
+	w.state = 2
+	return nil
+}
+
+func (w *ProtocolWithRecursiveRecordsWriterBase) WriteExpressions(values ...Expression) error {
+	if w.state != 2 {
+		return w.invalidState("WriteExpressions()")
+	}
+	return w.impl.WriteExpressionsImpl(values)
+}
+
+func (w *ProtocolWithRecursiveRecordsWriterBase) EndExpressions() error {
+	if w.state != 2 {
+		return w.invalidState("EndExpressions()")
+	}
+	if err := w.impl.EndExpressionsImpl(); err != nil {
+		return err
+	}
+	w.state = 3
+	return nil
+}
+
+func (w *ProtocolWithRecursiveRecordsWriterBase) Close() error {
+	if w.state != 3 {
+		return w.invalidState("Close()")
+	}
+	return w.impl.CloseImpl()
+}
+
+func (w *ProtocolWithRecursiveRecordsWriterBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, isStream := protocolWithRecursiveRecordsStepName(w.state); name != "" {
+		expected = "Write" + name + "()"
+		if isStream {
+			expected += " or End" + name + "()"
+		}
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+type ProtocolWithRecursiveRecordsReader interface {
+	ReadTree() (TreeNode, error)
+	ReadList() (LinkedListNode, error)
+	// Returns false when the stream has no more items.
+	ReadExpressions() (Expression, bool, error)
+	Close() error
+}
+
+// ProtocolWithRecursiveRecordsReaderImpl is implemented by concrete ProtocolWithRecursiveRecords readers.
+// Its methods are called by ProtocolWithRecursiveRecordsReaderBase after it has validated the order of calls.
+type ProtocolWithRecursiveRecordsReaderImpl interface {
+	ReadTreeImpl() (TreeNode, error)
+	ReadListImpl() (LinkedListNode, error)
+	ReadExpressionsImpl() (Expression, bool, error)
+	CloseImpl() error
+}
+
+// ProtocolWithRecursiveRecordsReaderBase implements ProtocolWithRecursiveRecordsReader, ensuring that the protocol steps are read in order.
+type ProtocolWithRecursiveRecordsReaderBase struct {
+	impl  ProtocolWithRecursiveRecordsReaderImpl
+	state int
+}
+
+func NewProtocolWithRecursiveRecordsReaderBase(impl ProtocolWithRecursiveRecordsReaderImpl) *ProtocolWithRecursiveRecordsReaderBase {
+	return &ProtocolWithRecursiveRecordsReaderBase{impl: impl}
+}
+
+func (r *ProtocolWithRecursiveRecordsReaderBase) ReadTree() (value TreeNode, err error) {
+	if r.state != 0 {
+		return value, r.invalidState("ReadTree()")
+	}
+	if value, err = r.impl.ReadTreeImpl(); err != nil {
+		return
+	}
+	r.state = 1
+	return
+}
+
+func (r *ProtocolWithRecursiveRecordsReaderBase) ReadList() (value LinkedListNode, err error) {
+	if r.state != 1 {
+		return value, r.invalidState("ReadList()")
+	}
+	if value, err = r.impl.ReadListImpl(); err != nil {
+		return
+	}
+	r.state = 2
+	return
+}
+
+func (r *ProtocolWithRecursiveRecordsReaderBase) ReadExpressions() (value Expression, ok bool, err error) {
+	if r.state != 2 {
+		return value, false, r.invalidState("ReadExpressions()")
+	}
+	if value, ok, err = r.impl.ReadExpressionsImpl(); err != nil || ok {
+		return
+	}
+	r.state = 3
+	return
+}
+
+func (r *ProtocolWithRecursiveRecordsReaderBase) Close() error {
+	if r.state != 3 {
+		return r.invalidState("Close()")
+	}
+	return r.impl.CloseImpl()
+}
+
+func (r *ProtocolWithRecursiveRecordsReaderBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, _ := protocolWithRecursiveRecordsStepName(r.state); name != "" {
+		expected = "Read" + name + "()"
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+// CalibrationSchema is the schema written to the header of binary Calibration streams.
+const CalibrationSchema = `{"protocol":{"name":"Calibration","sequence":[{"name":"gain","type":"float32"},{"name":"samples","type":{"stream":{"items":"float64"}}}]},"types":null}`
+
+// calibrationStepName returns the name of the step at the given state and whether it is a stream, or "" after the last step.
+func calibrationStepName(state int) (name string, isStream bool) {
+	switch state {
+	case 0:
+		return "Gain", false
+	case 1:
+		return "Samples", true
+	default:
+		return "", false
+	}
+}
+
+// A calibration phase shared by protocols
+type CalibrationWriter interface {
+	WriteGain(value float32) error
+	WriteSamples(values ...float64) error
+	EndSamples() error
+	Close() error
+}
+
+// CalibrationWriterImpl is implemented by concrete Calibration writers.
+// Its methods are called by CalibrationWriterBase after it has validated the order of calls.
+type CalibrationWriterImpl interface {
+	WriteGainImpl(value float32) error
+	WriteSamplesImpl(values []float64) error
+	EndSamplesImpl() error
+	CloseImpl() error
+}
+
+// CalibrationWriterBase implements CalibrationWriter, ensuring that the protocol steps are written in order.
+type CalibrationWriterBase struct {
+	impl  CalibrationWriterImpl
+	state int
+}
+
+func NewCalibrationWriterBase(impl CalibrationWriterImpl) *CalibrationWriterBase {
+	return &CalibrationWriterBase{impl: impl}
+}
+
+func (w *CalibrationWriterBase) WriteGain(value float32) error {
+	if w.state != 0 {
+		return w.invalidState("WriteGain()")
+	}
+	if err := w.impl.WriteGainImpl(value); err != nil {
+		return err
+	}
+	w.state = 1
+	return nil
+}
+
+func (w *CalibrationWriterBase) WriteSamples(values ...float64) error {
+	if w.state != 1 {
+		return w.invalidState("WriteSamples()")
+	}
+	return w.impl.WriteSamplesImpl(values)
+}
+
+func (w *CalibrationWriterBase) EndSamples() error {
+	if w.state != 1 {
+		return w.invalidState("EndSamples()")
+	}
+	if err := w.impl.EndSamplesImpl(); err != nil {
+		return err
+	}
+	w.state = 2
+	return nil
+}
+
+func (w *CalibrationWriterBase) Close() error {
+	if w.state != 2 {
+		return w.invalidState("Close()")
+	}
+	return w.impl.CloseImpl()
+}
+
+func (w *CalibrationWriterBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, isStream := calibrationStepName(w.state); name != "" {
+		expected = "Write" + name + "()"
+		if isStream {
+			expected += " or End" + name + "()"
+		}
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+// A calibration phase shared by protocols
+type CalibrationReader interface {
+	ReadGain() (float32, error)
+	// Returns false when the stream has no more items.
+	ReadSamples() (float64, bool, error)
+	Close() error
+}
+
+// CalibrationReaderImpl is implemented by concrete Calibration readers.
+// Its methods are called by CalibrationReaderBase after it has validated the order of calls.
+type CalibrationReaderImpl interface {
+	ReadGainImpl() (float32, error)
+	ReadSamplesImpl() (float64, bool, error)
+	CloseImpl() error
+}
+
+// CalibrationReaderBase implements CalibrationReader, ensuring that the protocol steps are read in order.
+type CalibrationReaderBase struct {
+	impl  CalibrationReaderImpl
+	state int
+}
+
+func NewCalibrationReaderBase(impl CalibrationReaderImpl) *CalibrationReaderBase {
+	return &CalibrationReaderBase{impl: impl}
+}
+
+func (r *CalibrationReaderBase) ReadGain() (value float32, err error) {
+	if r.state != 0 {
+		return value, r.invalidState("ReadGain()")
+	}
+	if value, err = r.impl.ReadGainImpl(); err != nil {
+		return
+	}
+	r.state = 1
+	return
+}
+
+func (r *CalibrationReaderBase) ReadSamples() (value float64, ok bool, err error) {
+	if r.state != 1 {
+		return value, false, r.invalidState("ReadSamples()")
+	}
+	if value, ok, err = r.impl.ReadSamplesImpl(); err != nil || ok {
+		return
+	}
+	r.state = 2
+	return
+}
+
+func (r *CalibrationReaderBase) Close() error {
+	if r.state != 2 {
+		return r.invalidState("Close()")
+	}
+	return r.impl.CloseImpl()
+}
+
+func (r *CalibrationReaderBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, _ := calibrationStepName(r.state); name != "" {
+		expected = "Read" + name + "()"
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+// ProtocolWithSubProtocolsSchema is the schema written to the header of binary ProtocolWithSubProtocols streams.
+const ProtocolWithSubProtocolsSchema = `{"protocol":{"name":"ProtocolWithSubProtocols","sequence":[{"name":"header","type":"string"},{"name":"calibrationGain","type":"float32"},{"name":"calibrationSamples","type":{"stream":{"items":"float64"}}},{"name":"data","type":{"stream":{"items":"int32"}}},{"name":"recalibrationGain","type":"float32"},{"name":"recalibrationSamples","type":{"stream":{"items":"float64"}}}]},"types":null}`
+
+// protocolWithSubProtocolsStepName returns the name of the step at the given state and whether it is a stream, or "" after the last step.
+func protocolWithSubProtocolsStepName(state int) (name string, isStream bool) {
+	switch state {
+	case 0:
+		return "Header", false
+	case 1:
+		return "CalibrationGain", false
+	case 2:
+		return "CalibrationSamples", true
+	case 3:
+		return "Data", true
+	case 4:
+		return "RecalibrationGain", false
+	case 5:
+		return "RecalibrationSamples", true
+	default:
+		return "", false
+	}
+}
+
+type ProtocolWithSubProtocolsWriter interface {
+	WriteHeader(value string) error
+	WriteCalibrationGain(value float32) error
+	WriteCalibrationSamples(values ...float64) error
+	EndCalibrationSamples() error
+	WriteData(values ...int32) error
+	EndData() error
+	WriteRecalibrationGain(value float32) error
+	WriteRecalibrationSamples(values ...float64) error
+	EndRecalibrationSamples() error
+	// Calibration before the data
+	Calibration() CalibrationWriter
+	Recalibration() CalibrationWriter
+	Close() error
+}
+
+// ProtocolWithSubProtocolsWriterImpl is implemented by concrete ProtocolWithSubProtocols writers.
+// Its methods are called by ProtocolWithSubProtocolsWriterBase after it has validated the order of calls.
+type ProtocolWithSubProtocolsWriterImpl interface {
+	WriteHeaderImpl(value string) error
+	WriteCalibrationGainImpl(value float32) error
+	WriteCalibrationSamplesImpl(values []float64) error
+	EndCalibrationSamplesImpl() error
+	WriteDataImpl(values []int32) error
+	EndDataImpl() error
+	WriteRecalibrationGainImpl(value float32) error
+	WriteRecalibrationSamplesImpl(values []float64) error
+	EndRecalibrationSamplesImpl() error
+	CloseImpl() error
+}
+
+// ProtocolWithSubProtocolsWriterBase implements ProtocolWithSubProtocolsWriter, ensuring that the protocol steps are written in order.
+type ProtocolWithSubProtocolsWriterBase struct {
+	impl                   ProtocolWithSubProtocolsWriterImpl
+	state                  int
+	calibrationSubWriter   *CalibrationWriterBase
+	recalibrationSubWriter *CalibrationWriterBase
+}
+
+func NewProtocolWithSubProtocolsWriterBase(impl ProtocolWithSubProtocolsWriterImpl) *ProtocolWithSubProtocolsWriterBase {
+	return &ProtocolWithSubProtocolsWriterBase{impl: impl}
+}
+
+func (w *ProtocolWithSubProtocolsWriterBase) WriteHeader(value string) error {
+	if w.state != 0 {
+		return w.invalidState("WriteHeader()")
+	}
+	if err := w.impl.WriteHeaderImpl(value); err != nil {
+		return err
+	}
+	w.state = 1
+	return nil
+}
+
+func (w *ProtocolWithSubProtocolsWriterBase) WriteCalibrationGain(value float32) error {
+	if w.state != 1 {
+		return w.invalidState("WriteCalibrationGain()")
+	}
+	if err := w.impl.WriteCalibrationGainImpl(value); err != nil {
+		return err
+	}
+	w.state = 2
+	return nil
+}
+
+func (w *ProtocolWithSubProtocolsWriterBase) WriteCalibrationSamples(values ...float64) error {
+	if w.state != 2 {
+		return w.invalidState("WriteCalibrationSamples()")
+	}
+	return w.impl.WriteCalibrationSamplesImpl(values)
+}
+
+func (w *ProtocolWithSubProtocolsWriterBase) EndCalibrationSamples() error {
+	if w.state != 2 {
+		return w.invalidState("EndCalibrationSamples()")
+	}
+	if err := w.impl.EndCalibrationSamplesImpl(); err != nil {
+		return err
+	}
+	w.state = 3
+	return nil
+}
+
+func (w *ProtocolWithSubProtocolsWriterBase) WriteData(values ...int32) error {
+	if w.state != 3 {
+		return w.invalidState("WriteData()")
+	}
+	return w.impl.WriteDataImpl(values)
+}
+
+func (w *ProtocolWithSubProtocolsWriterBase) EndData() error {
+	if w.state != 3 {
+		return w.invalidState("EndData()")
+	}
+	if err := w.impl.EndDataImpl(); err != nil {
+		return err
+	}
+	w.state = 4
+	return nil
+}
+
+func (w *ProtocolWithSubProtocolsWriterBase) WriteRecalibrationGain(value float32) error {
+	if w.state != 4 {
+		return w.invalidState("WriteRecalibrationGain()")
+	}
+	if err := w.impl.WriteRecalibrationGainImpl(value); err != nil {
+		return err
+	}
+	w.state = 5
+	return nil
+}
+
+func (w *ProtocolWithSubProtocolsWriterBase) WriteRecalibrationSamples(values ...float64) error {
+	if w.state != 5 {
+		return w.invalidState("WriteRecalibrationSamples()")
+	}
+	return w.impl.WriteRecalibrationSamplesImpl(values)
+}
+
+func (w *ProtocolWithSubProtocolsWriterBase) EndRecalibrationSamples() error {
+	if w.state != 5 {
+		return w.invalidState("EndRecalibrationSamples()")
+	}
+	if err := w.impl.EndRecalibrationSamplesImpl(); err != nil {
+		return err
+	}
+	w.state = 6
+	return nil
+}
+
+func (w *ProtocolWithSubProtocolsWriterBase) Close() error {
+	if w.state != 6 {
+		return w.invalidState("Close()")
+	}
+	return w.impl.CloseImpl()
+}
+
+// Calibration returns a writer for the calibration steps, which are written through w.
+func (w *ProtocolWithSubProtocolsWriterBase) Calibration() CalibrationWriter {
+	if w.calibrationSubWriter == nil {
+		w.calibrationSubWriter = NewCalibrationWriterBase(&protocolWithSubProtocolsCalibrationSubWriter{outer: w})
+	}
+	return w.calibrationSubWriter
+}
+
+type protocolWithSubProtocolsCalibrationSubWriter struct {
+	outer *ProtocolWithSubProtocolsWriterBase
+}
+
+func (impl *protocolWithSubProtocolsCalibrationSubWriter) WriteGainImpl(value float32) error {
+	return impl.outer.WriteCalibrationGain(value)
+}
+
+func (impl *protocolWithSubProtocolsCalibrationSubWriter) WriteSamplesImpl(values []float64) error {
+	return impl.outer.WriteCalibrationSamples(values...)
+}
+
+func (impl *protocolWithSubProtocolsCalibrationSubWriter) EndSamplesImpl() error {
+	return impl.outer.EndCalibrationSamples()
+}
+
+func (impl *protocolWithSubProtocolsCalibrationSubWriter) CloseImpl() error {
+	return nil
+}
+
+// Recalibration returns a writer for the recalibration steps, which are written through w.
+func (w *ProtocolWithSubProtocolsWriterBase) Recalibration() CalibrationWriter {
+	if w.recalibrationSubWriter == nil {
+		w.recalibrationSubWriter = NewCalibrationWriterBase(&protocolWithSubProtocolsRecalibrationSubWriter{outer: w})
+	}
+	return w.recalibrationSubWriter
+}
+
+type protocolWithSubProtocolsRecalibrationSubWriter struct {
+	outer *ProtocolWithSubProtocolsWriterBase
+}
+
+func (impl *protocolWithSubProtocolsRecalibrationSubWriter) WriteGainImpl(value float32) error {
+	return impl.outer.WriteRecalibrationGain(value)
+}
+
+func (impl *protocolWithSubProtocolsRecalibrationSubWriter) WriteSamplesImpl(values []float64) error {
+	return impl.outer.WriteRecalibrationSamples(values...)
+}
+
+func (impl *protocolWithSubProtocolsRecalibrationSubWriter) EndSamplesImpl() error {
+	return impl.outer.EndRecalibrationSamples()
+}
+
+func (impl *protocolWithSubProtocolsRecalibrationSubWriter) CloseImpl() error {
+	return nil
+}
+
+func (w *ProtocolWithSubProtocolsWriterBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, isStream := protocolWithSubProtocolsStepName(w.state); name != "" {
+		expected = "Write" + name + "()"
+		if isStream {
+			expected += " or End" + name + "()"
+		}
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+type ProtocolWithSubProtocolsReader interface {
+	ReadHeader() (string, error)
+	ReadCalibrationGain() (float32, error)
+	// Returns false when the stream has no more items.
+	ReadCalibrationSamples() (float64, bool, error)
+	// Returns false when the stream has no more items.
+	ReadData() (int32, bool, error)
+	ReadRecalibrationGain() (float32, error)
+	// Returns false when the stream has no more items.
+	ReadRecalibrationSamples() (float64, bool, error)
+	// Calibration before the data
+	Calibration() CalibrationReader
+	Recalibration() CalibrationReader
+	Close() error
+}
+
+// ProtocolWithSubProtocolsReaderImpl is implemented by concrete ProtocolWithSubProtocols readers.
+// Its methods are called by ProtocolWithSubProtocolsReaderBase after it has validated the order of calls.
+type ProtocolWithSubProtocolsReaderImpl interface {
+	ReadHeaderImpl() (string, error)
+	ReadCalibrationGainImpl() (float32, error)
+	ReadCalibrationSamplesImpl() (float64, bool, error)
+	ReadDataImpl() (int32, bool, error)
+	ReadRecalibrationGainImpl() (float32, error)
+	ReadRecalibrationSamplesImpl() (float64, bool, error)
+	CloseImpl() error
+}
+
+// ProtocolWithSubProtocolsReaderBase implements ProtocolWithSubProtocolsReader, ensuring that the protocol steps are read in order.
+type ProtocolWithSubProtocolsReaderBase struct {
+	impl                   ProtocolWithSubProtocolsReaderImpl
+	state                  int
+	calibrationSubReader   *CalibrationReaderBase
+	recalibrationSubReader *CalibrationReaderBase
+}
+
+func NewProtocolWithSubProtocolsReaderBase(impl ProtocolWithSubProtocolsReaderImpl) *ProtocolWithSubProtocolsReaderBase {
+	return &ProtocolWithSubProtocolsReaderBase{impl: impl}
+}
+
+func (r *ProtocolWithSubProtocolsReaderBase) ReadHeader() (value string, err error) {
+	if r.state != 0 {
+		return value, r.invalidState("ReadHeader()")
+	}
+	if value, err = r.impl.ReadHeaderImpl(); err != nil {
+		return
+	}
+	r.state = 1
+	return
+}
+
+func (r *ProtocolWithSubProtocolsReaderBase) ReadCalibrationGain() (value float32, err error) {
+	if r.state != 1 {
+		return value, r.invalidState("ReadCalibrationGain()")
+	}
+	if value, err = r.impl.ReadCalibrationGainImpl(); err != nil {
+		return
+	}
+	r.state = 2
+	return
+}
+
+func (r *ProtocolWithSubProtocolsReaderBase) ReadCalibrationSamples() (value float64, ok bool, err error) {
+	if r.state != 2 {
+		return value, false, r.invalidState("ReadCalibrationSamples()")
+	}
+	if value, ok, err = r.impl.ReadCalibrationSamplesImpl(); err != nil || ok {
+		return
+	}
+	r.state = 3
+	return
+}
+
+func (r *ProtocolWithSubProtocolsReaderBase) ReadData() (value int32, ok bool, err error) {
+	if r.state != 3 {
+		return value, false, r.invalidState("ReadData()")
+	}
+	if value, ok, err = r.impl.ReadDataImpl(); err != nil || ok {
+		return
+	}
+	r.state = 4
+	return
+}
+
+func (r *ProtocolWithSubProtocolsReaderBase) ReadRecalibrationGain() (value float32, err error) {
+	if r.state != 4 {
+		return value, r.invalidState("ReadRecalibrationGain()")
+	}
+	if value, err = r.impl.ReadRecalibrationGainImpl(); err != nil {
+		return
+	}
+	r.state = 5
+	return
+}
+
+func (r *ProtocolWithSubProtocolsReaderBase) ReadRecalibrationSamples() (value float64, ok bool, err error) {
+	if r.state != 5 {
+		return value, false, r.invalidState("ReadRecalibrationSamples()")
+	}
+	if value, ok, err = r.impl.ReadRecalibrationSamplesImpl(); err != nil || ok {
+		return
+	}
+	r.state = 6
+	return
+}
+
+func (r *ProtocolWithSubProtocolsReaderBase) Close() error {
+	if r.state != 6 {
+		return r.invalidState("Close()")
+	}
+	return r.impl.CloseImpl()
+}
+
+// Calibration returns a reader for the calibration steps, which are read through r.
+func (r *ProtocolWithSubProtocolsReaderBase) Calibration() CalibrationReader {
+	if r.calibrationSubReader == nil {
+		r.calibrationSubReader = NewCalibrationReaderBase(&protocolWithSubProtocolsCalibrationSubReader{outer: r})
+	}
+	return r.calibrationSubReader
+}
+
+type protocolWithSubProtocolsCalibrationSubReader struct {
+	outer *ProtocolWithSubProtocolsReaderBase
+}
+
+func (impl *protocolWithSubProtocolsCalibrationSubReader) ReadGainImpl() (float32, error) {
+	return impl.outer.ReadCalibrationGain()
+}
+
+func (impl *protocolWithSubProtocolsCalibrationSubReader) ReadSamplesImpl() (float64, bool, error) {
+	return impl.outer.ReadCalibrationSamples()
+}
+
+func (impl *protocolWithSubProtocolsCalibrationSubReader) CloseImpl() error {
+	return nil
+}
+
+// Recalibration returns a reader for the recalibration steps, which are read through r.
+func (r *ProtocolWithSubProtocolsReaderBase) Recalibration() CalibrationReader {
+	if r.recalibrationSubReader == nil {
+		r.recalibrationSubReader = NewCalibrationReaderBase(&protocolWithSubProtocolsRecalibrationSubReader{outer: r})
+	}
+	return r.recalibrationSubReader
+}
+
+type protocolWithSubProtocolsRecalibrationSubReader struct {
+	outer *ProtocolWithSubProtocolsReaderBase
+}
+
+func (impl *protocolWithSubProtocolsRecalibrationSubReader) ReadGainImpl() (float32, error) {
+	return impl.outer.ReadRecalibrationGain()
+}
+
+func (impl *protocolWithSubProtocolsRecalibrationSubReader) ReadSamplesImpl() (float64, bool, error) {
+	return impl.outer.ReadRecalibrationSamples()
+}
+
+func (impl *protocolWithSubProtocolsRecalibrationSubReader) CloseImpl() error {
+	return nil
+}
+
+func (r *ProtocolWithSubProtocolsReaderBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, _ := protocolWithSubProtocolsStepName(r.state); name != "" {
+		expected = "Read" + name + "()"
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+// ProtocolWithHalfPrecisionSchema is the schema written to the header of binary ProtocolWithHalfPrecision streams.
+const ProtocolWithHalfPrecisionSchema = `{"protocol":{"name":"ProtocolWithHalfPrecision","sequence":[{"name":"halves","type":{"vector":{"items":"float16"}}},{"name":"recWithHalves","type":"TestModel.RecordWithHalfPrecision"}]},"types":[{"name":"RecordWithHalfPrecision","fields":[{"name":"half","type":"float16"},{"name":"brain","type":"bfloat16"},{"name":"halfVector","type":{"vector":{"items":"float16"}}},{"name":"brainArray","type":{"array":{"items":"bfloat16"}}}]}]}`
+
+// protocolWithHalfPrecisionStepName returns the name of the step at the given state and whether it is a stream, or "" after the last step.
+func protocolWithHalfPrecisionStepName(state int) (name string, isStream bool) {
+	switch state {
+	case 0:
+		return "Halves", false
+	case 1:
+		return "RecWithHalves", false
+	default:
+		return "", false
+	}
+}
+
+type ProtocolWithHalfPrecisionWriter interface {
+	WriteHalves(value []float32) error
+	WriteRecWithHalves(value RecordWithHalfPrecision) error
+	Close() error
+}
+
+// ProtocolWithHalfPrecisionWriterImpl is implemented by concrete ProtocolWithHalfPrecision writers.
+// Its methods are called by ProtocolWithHalfPrecisionWriterBase after it has validated the order of calls.
+type ProtocolWithHalfPrecisionWriterImpl interface {
+	WriteHalvesImpl(value []float32) error
+	WriteRecWithHalvesImpl(value RecordWithHalfPrecision) error
+	CloseImpl() error
+}
+
+// ProtocolWithHalfPrecisionWriterBase implements ProtocolWithHalfPrecisionWriter, ensuring that the protocol steps are written in order.
+type ProtocolWithHalfPrecisionWriterBase struct {
+	impl  ProtocolWithHalfPrecisionWriterImpl
+	state int
+}
+
+func NewProtocolWithHalfPrecisionWriterBase(impl ProtocolWithHalfPrecisionWriterImpl) *ProtocolWithHalfPrecisionWriterBase {
+	return &ProtocolWithHalfPrecisionWriterBase{impl: impl}
+}
+
+func (w *ProtocolWithHalfPrecisionWriterBase) WriteHalves(value []float32) error {
+	if w.state != 0 {
+		return w.invalidState("WriteHalves()")
+	}
+	if err := w.impl.WriteHalvesImpl(value); err != nil {
+		return err
+	}
+	w.state = 1
+	return nil
+}
+
+func (w *ProtocolWithHalfPrecisionWriterBase) WriteRecWithHalves(value RecordWithHalfPrecision) error {
+	if w.state != 1 {
+		return w.invalidState("WriteRecWithHalves()")
+	}
+	if err := w.impl.WriteRecWithHalvesImpl(value); err != nil {
+		return err
+	}
+	w.state = 2
+	return nil
+}
+
+func (w *ProtocolWithHalfPrecisionWriterBase) Close() error {
+	if w.state != 2 {
+		return w.invalidState("Close()")
+	}
+	return w.impl.CloseImpl()
+}
+
+func (w *ProtocolWithHalfPrecisionWriterBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, isStream := protocolWithHalfPrecisionStepName(w.state); name != "" {
+		expected = "Write" + name + "()"
+		if isStream {
+			expected += " or End" + name + "()"
+		}
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+type ProtocolWithHalfPrecisionReader interface {
+	ReadHalves() ([]float32, error)
+	ReadRecWithHalves() (RecordWithHalfPrecision, error)
+	Close() error
+}
+
+// ProtocolWithHalfPrecisionReaderImpl is implemented by concrete ProtocolWithHalfPrecision readers.
+// Its methods are called by ProtocolWithHalfPrecisionReaderBase after it has validated the order of calls.
+type ProtocolWithHalfPrecisionReaderImpl interface {
+	ReadHalvesImpl() ([]float32, error)
+	ReadRecWithHalvesImpl() (RecordWithHalfPrecision, error)
+	CloseImpl() error
+}
+
+// ProtocolWithHalfPrecisionReaderBase implements ProtocolWithHalfPrecisionReader, ensuring that the protocol steps are read in order.
+type ProtocolWithHalfPrecisionReaderBase struct {
+	impl  ProtocolWithHalfPrecisionReaderImpl
+	state int
+}
+
+func NewProtocolWithHalfPrecisionReaderBase(impl ProtocolWithHalfPrecisionReaderImpl) *ProtocolWithHalfPrecisionReaderBase {
+	return &ProtocolWithHalfPrecisionReaderBase{impl: impl}
+}
+
+func (r *ProtocolWithHalfPrecisionReaderBase) ReadHalves() (value []float32, err error) {
+	if r.state != 0 {
+		return value, r.invalidState("ReadHalves()")
+	}
+	if value, err = r.impl.ReadHalvesImpl(); err != nil {
+		return
+	}
+	r.state = 1
+	return
+}
+
+func (r *ProtocolWithHalfPrecisionReaderBase) ReadRecWithHalves() (value RecordWithHalfPrecision, err error) {
+	if r.state != 1 {
+		return value, r.invalidState("ReadRecWithHalves()")
+	}
+	if value, err = r.impl.ReadRecWithHalvesImpl(); err != nil {
+		return
+	}
+	r.state = 2
+	return
+}
+
+func (r *ProtocolWithHalfPrecisionReaderBase) Close() error {
+	if r.state != 2 {
+		return r.invalidState("Close()")
+	}
+	return r.impl.CloseImpl()
+}
+
+func (r *ProtocolWithHalfPrecisionReaderBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, _ := protocolWithHalfPrecisionStepName(r.state); name != "" {
+		expected = "Read" + name + "()"
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+// OptionalVectorsSchema is the schema written to the header of binary OptionalVectors streams.
+const OptionalVectorsSchema = `{"protocol":{"name":"OptionalVectors","sequence":[{"name":"recordWithOptionalVector","type":"TestModel.RecordWithOptionalVector"}]},"types":[{"name":"RecordWithOptionalVector","fields":[{"name":"optionalVector","type":[null,{"vector":{"items":"int32"}}]}]}]}`
+
+// optionalVectorsStepName returns the name of the step at the given state and whether it is a stream, or "" after the last step.
+func optionalVectorsStepName(state int) (name string, isStream bool) {
+	switch state {
+	case 0:
+		return "RecordWithOptionalVector", false
+	default:
+		return "", false
+	}
+}
+
+type OptionalVectorsWriter interface {
+	WriteRecordWithOptionalVector(value RecordWithOptionalVector) error
+	Close() error
+}
+
+// OptionalVectorsWriterImpl is implemented by concrete OptionalVectors writers.
+// Its methods are called by OptionalVectorsWriterBase after it has validated the order of calls.
+type OptionalVectorsWriterImpl interface {
+	WriteRecordWithOptionalVectorImpl(value RecordWithOptionalVector) error
+	CloseImpl() error
+}
+
+// OptionalVectorsWriterBase implements OptionalVectorsWriter, ensuring that the protocol steps are written in order.
+type OptionalVectorsWriterBase struct {
+	impl  OptionalVectorsWriterImpl
+	state int
+}
+
+func NewOptionalVectorsWriterBase(impl OptionalVectorsWriterImpl) *OptionalVectorsWriterBase {
+	return &OptionalVectorsWriterBase{impl: impl}
+}
+
+func (w *OptionalVectorsWriterBase) WriteRecordWithOptionalVector(value RecordWithOptionalVector) error {
+	if w.state != 0 {
+		return w.invalidState("WriteRecordWithOptionalVector()")
+	}
+	if err := w.impl.WriteRecordWithOptionalVectorImpl(value); err != nil {
+		return err
+	}
+	w.state = 1
+	return nil
+}
+
+func (w *OptionalVectorsWriterBase) Close() error {
+	if w.state != 1 {
+		return w.invalidState("Close()")
+	}
+	return w.impl.CloseImpl()
+}
+
+func (w *OptionalVectorsWriterBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, isStream := optionalVectorsStepName(w.state); name != "" {
+		expected = "Write" + name + "()"
+		if isStream {
+			expected += " or End" + name + "()"
+		}
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+type OptionalVectorsReader interface {
+	ReadRecordWithOptionalVector() (RecordWithOptionalVector, error)
+	Close() error
+}
+
+// OptionalVectorsReaderImpl is implemented by concrete OptionalVectors readers.
+// Its methods are called by OptionalVectorsReaderBase after it has validated the order of calls.
+type OptionalVectorsReaderImpl interface {
+	ReadRecordWithOptionalVectorImpl() (RecordWithOptionalVector, error)
+	CloseImpl() error
+}
+
+// OptionalVectorsReaderBase implements OptionalVectorsReader, ensuring that the protocol steps are read in order.
+type OptionalVectorsReaderBase struct {
+	impl  OptionalVectorsReaderImpl
+	state int
+}
+
+func NewOptionalVectorsReaderBase(impl OptionalVectorsReaderImpl) *OptionalVectorsReaderBase {
+	return &OptionalVectorsReaderBase{impl: impl}
+}
+
+func (r *OptionalVectorsReaderBase) ReadRecordWithOptionalVector() (value RecordWithOptionalVector, err error) {
+	if r.state != 0 {
+		return value, r.invalidState("ReadRecordWithOptionalVector()")
+	}
+	if value, err = r.impl.ReadRecordWithOptionalVectorImpl(); err != nil {
+		return
+	}
+	r.state = 1
+	return
+}
+
+func (r *OptionalVectorsReaderBase) Close() error {
+	if r.state != 1 {
+		return r.invalidState("Close()")
+	}
+	return r.impl.CloseImpl()
+}
+
+func (r *OptionalVectorsReaderBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, _ := optionalVectorsStepName(r.state); name != "" {
+		expected = "Read" + name + "()"
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+// FixedVectorsSchema is the schema written to the header of binary FixedVectors streams.
+const FixedVectorsSchema = `{"protocol":{"name":"FixedVectors","sequence":[{"name":"fixedIntVector","type":{"vector":{"items":"int32","length":5}}},{"name":"fixedSimpleRecordVector","type":{"vector":{"items":"TestModel.SimpleRecord","length":3}}},{"name":"fixedRecordWithVlensVector","type":{"vector":{"items":"TestModel.RecordWithVlens","length":2}}},{"name":"recordWithFixedVectors","type":"TestModel.RecordWithFixedVectors"}]},"types":[{"name":"RecordWithFixedVectors","fields":[{"name":"fixedIntVector","type":{"vector":{"items":"int32","length":5}}},{"name":"fixedSimpleRecordVector","type":{"vector":{"items":"TestModel.SimpleRecord","length":3}}},{"name":"fixedRecordWithVlensVector","type":{"vector":{"items":"TestModel.RecordWithVlens","length":2}}}]},{"name":"RecordWithVlens","fields":[{"name":"a","type":{"vector":{"items":"TestModel.SimpleRecord"}}},{"name":"b","type":"int32"},{"name":"c","type":"int32"}]},{"name":"SimpleRecord","fields":[{"name":"x","type":"int32"},{"name":"y","type":"int32"},{"name":"z","type":"int32"}]}]}`
+
+// fixedVectorsStepName returns the name of the step at the given state and whether it is a stream, or "" after the last step.
+func fixedVectorsStepName(state int) (name string, isStream bool) {
+	switch state {
+	case 0:
+		return "FixedIntVector", false
+	case 1:
+		return "FixedSimpleRecordVector", false
+	case 2:
+		return "FixedRecordWithVlensVector", false
+	case 3:
+		return "RecordWithFixedVectors", false
+	default:
+		return "", false
+	}
+}
+
+type FixedVectorsWriter interface {
+	WriteFixedIntVector(value [5]int32) error
+	WriteFixedSimpleRecordVector(value [3]SimpleRecord) error
+	WriteFixedRecordWithVlensVector(value [2]RecordWithVlens) error
+	WriteRecordWithFixedVectors(value RecordWithFixedVectors) error
+	Close() error
+}
+
+// FixedVectorsWriterImpl is implemented by concrete FixedVectors writers.
+// Its methods are called by FixedVectorsWriterBase after it has validated the order of calls.
+type FixedVectorsWriterImpl interface {
+	WriteFixedIntVectorImpl(value [5]int32) error
+	WriteFixedSimpleRecordVectorImpl(value [3]SimpleRecord) error
+	WriteFixedRecordWithVlensVectorImpl(value [2]RecordWithVlens) error
+	WriteRecordWithFixedVectorsImpl(value RecordWithFixedVectors) error
+	CloseImpl() error
+}
+
+// FixedVectorsWriterBase implements FixedVectorsWriter, ensuring that the protocol steps are written in order.
+type FixedVectorsWriterBase struct {
+	impl  FixedVectorsWriterImpl
+	state int
+}
+
+func NewFixedVectorsWriterBase(impl FixedVectorsWriterImpl) *FixedVectorsWriterBase {
+	return &FixedVectorsWriterBase{impl: impl}
+}
+
+func (w *FixedVectorsWriterBase) WriteFixedIntVector(value [5]int32) error {
+	if w.state != 0 {
+		return w.invalidState("WriteFixedIntVector()")
+	}
+	if err := w.impl.WriteFixedIntVectorImpl(value); err != nil {
+		return err
+	}
+	w.state = 1
+	return nil
+}
+
+func (w *FixedVectorsWriterBase) WriteFixedSimpleRecordVector(value [3]SimpleRecord) error {
+	if w.state != 1 {
+		return w.invalidState("WriteFixedSimpleRecordVector()")
+	}
+	if err := w.impl.WriteFixedSimpleRecordVectorImpl(value); err != nil {
+		return err
+	}
+	w.state = 2
+	return nil
+}
+
+func (w *FixedVectorsWriterBase) WriteFixedRecordWithVlensVector(value [2]RecordWithVlens) error {
+	if w.state != 2 {
+		return w.invalidState("WriteFixedRecordWithVlensVector()")
+	}
+	if err := w.impl.WriteFixedRecordWithVlensVectorImpl(value); err != nil {
+		return err
+	}
+	w.state = 3
+	return nil
+}
+
+func (w *FixedVectorsWriterBase) WriteRecordWithFixedVectors(value RecordWithFixedVectors) error {
+	if w.state != 3 {
+		return w.invalidState("WriteRecordWithFixedVectors()")
+	}
+	if err := w.impl.WriteRecordWithFixedVectorsImpl(value); err != nil {
+		return err
+	}
+	w.state = 4
+	return nil
+}
+
+func (w *FixedVectorsWriterBase) Close() error {
+	if w.state != 4 {
+		return w.invalidState("Close()")
+	}
+	return w.impl.CloseImpl()
+}
+
+func (w *FixedVectorsWriterBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, isStream := fixedVectorsStepName(w.state); name != "" {
+		expected = "Write" + name + "()"
+		if isStream {
+			expected += " or End" + name + "()"
+		}
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+type FixedVectorsReader interface {
+	ReadFixedIntVector() ([5]int32, error)
+	ReadFixedSimpleRecordVector() ([3]SimpleRecord, error)
+	ReadFixedRecordWithVlensVector() ([2]RecordWithVlens, error)
+	ReadRecordWithFixedVectors() (RecordWithFixedVectors, error)
+	Close() error
+}
+
+// FixedVectorsReaderImpl is implemented by concrete FixedVectors readers.
+// Its methods are called by FixedVectorsReaderBase after it has validated the order of calls.
+type FixedVectorsReaderImpl interface {
+	ReadFixedIntVectorImpl() ([5]int32, error)
+	ReadFixedSimpleRecordVectorImpl() ([3]SimpleRecord, error)
+	ReadFixedRecordWithVlensVectorImpl() ([2]RecordWithVlens, error)
+	ReadRecordWithFixedVectorsImpl() (RecordWithFixedVectors, error)
+	CloseImpl() error
+}
+
+// FixedVectorsReaderBase implements FixedVectorsReader, ensuring that the protocol steps are read in order.
+type FixedVectorsReaderBase struct {
+	impl  FixedVectorsReaderImpl
+	state int
+}
+
+func NewFixedVectorsReaderBase(impl FixedVectorsReaderImpl) *FixedVectorsReaderBase {
+	return &FixedVectorsReaderBase{impl: impl}
+}
+
+func (r *FixedVectorsReaderBase) ReadFixedIntVector() (value [5]int32, err error) {
+	if r.state != 0 {
+		return value, r.invalidState("ReadFixedIntVector()")
+	}
+	if value, err = r.impl.ReadFixedIntVectorImpl(); err != nil {
+		return
+	}
+	r.state = 1
+	return
+}
+
+func (r *FixedVectorsReaderBase) ReadFixedSimpleRecordVector() (value [3]SimpleRecord, err error) {
+	if r.state != 1 {
+		return value, r.invalidState("ReadFixedSimpleRecordVector()")
+	}
+	if value, err = r.impl.ReadFixedSimpleRecordVectorImpl(); err != nil {
+		return
+	}
+	r.state = 2
+	return
+}
+
+func (r *FixedVectorsReaderBase) ReadFixedRecordWithVlensVector() (value [2]RecordWithVlens, err error) {
+	if r.state != 2 {
+		return value, r.invalidState("ReadFixedRecordWithVlensVector()")
+	}
+	if value, err = r.impl.ReadFixedRecordWithVlensVectorImpl(); err != nil {
+		return
+	}
+	r.state = 3
+	return
+}
+
+func (r *FixedVectorsReaderBase) ReadRecordWithFixedVectors() (value RecordWithFixedVectors, err error) {
+	if r.state != 3 {
+		return value, r.invalidState("ReadRecordWithFixedVectors()")
+	}
+	if value, err = r.impl.ReadRecordWithFixedVectorsImpl(); err != nil {
+		return
+	}
+	r.state = 4
+	return
+}
+
+func (r *FixedVectorsReaderBase) Close() error {
+	if r.state != 4 {
+		return r.invalidState("Close()")
+	}
+	return r.impl.CloseImpl()
+}
+
+func (r *FixedVectorsReaderBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, _ := fixedVectorsStepName(r.state); name != "" {
+		expected = "Read" + name + "()"
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+// StreamsSchema is the schema written to the header of binary Streams streams.
+const StreamsSchema = `{"protocol":{"name":"Streams","sequence":[{"name":"intData","type":{"stream":{"items":"int32"}}},{"name":"optionalIntData","type":{"stream":{"items":[null,"int32"]}}},{"name":"recordWithOptionalVectorData","type":{"stream":{"items":"TestModel.RecordWithOptionalVector"}}},{"name":"fixedVector","type":{"stream":{"items":{"vector":{"items":"int32","length":3}}}}}]},"types":[{"name":"RecordWithOptionalVector","fields":[{"name":"optionalVector","type":[null,{"vector":{"items":"int32"}}]}]}]}`
+
+// streamsStepName returns the name of the step at the given state and whether it is a stream, or "" after the last step.
+func streamsStepName(state int) (name string, isStream bool) {
+	switch state {
+	case 0:
+		return "IntData", true
+	case 1:
+		return "OptionalIntData", true
+	case 2:
+		return "RecordWithOptionalVectorData", true
+	case 3:
+		return "FixedVector", true
+	default:
+		return "", false
+	}
+}
+
+type StreamsWriter interface {
+	WriteIntData(values ...int32) error
+	EndIntData() error
+	WriteOptionalIntData(values ...*int32) error
+	EndOptionalIntData() error
+	WriteRecordWithOptionalVectorData(values ...RecordWithOptionalVector) error
+	EndRecordWithOptionalVectorData() error
+	WriteFixedVector(values ...[3]int32) error
+	EndFixedVector() error
+	Close() error
+}
+
+// StreamsWriterImpl is implemented by concrete Streams writers.
+// Its methods are called by StreamsWriterBase after it has validated the order of calls.
+type StreamsWriterImpl interface {
+	WriteIntDataImpl(values []int32) error
+	EndIntDataImpl() error
+	WriteOptionalIntDataImpl(values []*int32) error
+	EndOptionalIntDataImpl() error
+	WriteRecordWithOptionalVectorDataImpl(values []RecordWithOptionalVector) error
+	EndRecordWithOptionalVectorDataImpl() error
+	WriteFixedVectorImpl(values [][3]int32) error
+	EndFixedVectorImpl() error
+	CloseImpl() error
+}
+
+// StreamsWriterBase implements StreamsWriter, ensuring that the protocol steps are written in order.
+type StreamsWriterBase struct {
+	impl  StreamsWriterImpl
+	state int
+}
+
+func NewStreamsWriterBase(impl StreamsWriterImpl) *StreamsWriterBase {
+	return &StreamsWriterBase{impl: impl}
+}
+
+func (w *StreamsWriterBase) WriteIntData(values ...int32) error {
+	if w.state != 0 {
+		return w.invalidState("WriteIntData()")
+	}
+	return w.impl.WriteIntDataImpl(values)
+}
+
+func (w *StreamsWriterBase) EndIntData() error {
+	if w.state != 0 {
+		return w.invalidState("EndIntData()")
+	}
+	if err := w.impl.EndIntDataImpl(); err != nil {
+		return err
+	}
+	w.state = 1
+	return nil
+}
+
+func (w *StreamsWriterBase) WriteOptionalIntData(values ...*int32) error {
+	if w.state != 1 {
+		return w.invalidState("WriteOptionalIntData()")
+	}
+	return w.impl.WriteOptionalIntDataImpl(values)
+}
+
+func (w *StreamsWriterBase) EndOptionalIntData() error {
+	if w.state != 1 {
+		return w.invalidState("EndOptionalIntData()")
+	}
+	if err := w.impl.EndOptionalIntDataImpl(); err != nil {
+		return err
+	}
+	w.state = 2
+	return nil
+}
+
+func (w *StreamsWriterBase) WriteRecordWithOptionalVectorData(values ...RecordWithOptionalVector) error {
+	if w.state != 2 {
+		return w.invalidState("WriteRecordWithOptionalVectorData()")
+	}
+	return w.impl.WriteRecordWithOptionalVectorDataImpl(values)
+}
+
+func (w *StreamsWriterBase) EndRecordWithOptionalVectorData() error {
+	if w.state != 2 {
+		return w.invalidState("EndRecordWithOptionalVectorData()")
+	}
+	if err := w.impl.EndRecordWithOptionalVectorDataImpl(); err != nil {
+		return err
+	}
+	w.state = 3
+	return nil
+}
+
+func (w *StreamsWriterBase) WriteFixedVector(values ...[3]int32) error {
+	if w.state != 3 {
+		return w.invalidState("WriteFixedVector()")
+	}
+	return w.impl.WriteFixedVectorImpl(values)
+}
+
+func (w *StreamsWriterBase) EndFixedVector() error {
+	if w.state != 3 {
+		return w.invalidState("EndFixedVector()")
+	}
+	if err := w.impl.EndFixedVectorImpl(); err != nil {
+		return err
+	}
+	w.state = 4
+	return nil
+}
+
+func (w *StreamsWriterBase) Close() error {
+	if w.state != 4 {
+		return w.invalidState("Close()")
+	}
+	return w.impl.CloseImpl()
+}
+
+func (w *StreamsWriterBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, isStream := streamsStepName(w.state); name != "" {
+		expected = "Write" + name + "()"
+		if isStream {
+			expected += " or End" + name + "()"
+		}
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+type StreamsReader interface {
+	// Returns false when the stream has no more items.
+	ReadIntData() (int32, bool, error)
+	// Returns false when the stream has no more items.
+	ReadOptionalIntData() (*int32, bool, error)
+	// Returns false when the stream has no more items.
+	ReadRecordWithOptionalVectorData() (RecordWithOptionalVector, bool, error)
+	// Returns false when the stream has no more items.
+	ReadFixedVector() ([3]int32, bool, error)
+	Close() error
+}
+
+// StreamsReaderImpl is implemented by concrete Streams readers.
+// Its methods are called by StreamsReaderBase after it has validated the order of calls.
+type StreamsReaderImpl interface {
+	ReadIntDataImpl() (int32, bool, error)
+	ReadOptionalIntDataImpl() (*int32, bool, error)
+	ReadRecordWithOptionalVectorDataImpl() (RecordWithOptionalVector, bool, error)
+	ReadFixedVectorImpl() ([3]int32, bool, error)
+	CloseImpl() error
+}
+
+// StreamsReaderBase implements StreamsReader, ensuring that the protocol steps are read in order.
+type StreamsReaderBase struct {
+	impl  StreamsReaderImpl
+	state int
+}
+
+func NewStreamsReaderBase(impl StreamsReaderImpl) *StreamsReaderBase {
+	return &StreamsReaderBase{impl: impl}
+}
+
+func (r *StreamsReaderBase) ReadIntData() (value int32, ok bool, err error) {
+	if r.state != 0 {
+		return value, false, r.invalidState("ReadIntData()")
+	}
+	if value, ok, err = r.impl.ReadIntDataImpl(); err != nil || ok {
+		return
+	}
+	r.state = 1
+	return
+}
+
+func (r *StreamsReaderBase) ReadOptionalIntData() (value *int32, ok bool, err error) {
+	if r.state != 1 {
+		return value, false, r.invalidState("ReadOptionalIntData()")
+	}
+	if value, ok, err = r.impl.ReadOptionalIntDataImpl(); err != nil || ok {
+		return
+	}
+	r.state = 2
+	return
+}
+
+func (r *StreamsReaderBase) ReadRecordWithOptionalVectorData() (value RecordWithOptionalVector, ok bool, err error) {
+	if r.state != 2 {
+		return value, false, r.invalidState("ReadRecordWithOptionalVectorData()")
+	}
+	if value, ok, err = r.impl.ReadRecordWithOptionalVectorDataImpl(); err != nil || ok {
+		return
+	}
+	r.state = 3
+	return
+}
+
+func (r *StreamsReaderBase) ReadFixedVector() (value [3]int32, ok bool, err error) {
+	if r.state != 3 {
+		return value, false, r.invalidState("ReadFixedVector()")
+	}
+	if value, ok, err = r.impl.ReadFixedVectorImpl(); err != nil || ok {
+		return
+	}
+	r.state = 4
+	return
+}
+
+func (r *StreamsReaderBase) Close() error {
+	if r.state != 4 {
+		return r.invalidState("Close()")
+	}
+	return r.impl.CloseImpl()
+}
+
+func (r *StreamsReaderBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, _ := streamsStepName(r.state); name != "" {
+		expected = "Read" + name + "()"
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+// FixedArraysSchema is the schema written to the header of binary FixedArrays streams.
+const FixedArraysSchema = `{"protocol":{"name":"FixedArrays","sequence":[{"name":"ints","type":{"array":{"items":"int32","dimensions":[{"length":2},{"length":3}]}}},{"name":"fixedSimpleRecordArray","type":{"array":{"items":"TestModel.SimpleRecord","dimensions":[{"length":3},{"length":2}]}}},{"name":"fixedRecordWithVlensArray","type":{"array":{"items":"TestModel.RecordWithVlens","dimensions":[{"length":2},{"length":2}]}}},{"name":"recordWithFixedArrays","type":"TestModel.RecordWithFixedArrays"},{"name":"namedArray","type":"TestModel.NamedFixedNDArray"}]},"types":[{"name":"NamedFixedNDArray","type":{"array":{"items":"int32","dimensions":[{"name":"dimA","length":2},{"name":"dimB","length":4}]}}},{"name":"RecordWithFixedArrays","fields":[{"name":"ints","type":{"array":{"items":"int32","dimensions":[{"length":2},{"length":3}]}}},{"name":"fixedSimpleRecordArray","type":{"array":{"items":"TestModel.SimpleRecord","dimensions":[{"length":3},{"length":2}]}}},{"name":"fixedRecordWithVlensArray","type":{"array":{"items":"TestModel.RecordWithVlens","dimensions":[{"length":2},{"length":2}]}}}]},{"name":"RecordWithVlens","fields":[{"name":"a","type":{"vector":{"items":"TestModel.SimpleRecord"}}},{"name":"b","type":"int32"},{"name":"c","type":"int32"}]},{"name":"SimpleRecord","fields":[{"name":"x","type":"int32"},{"name":"y","type":"int32"},{"name":"z","type":"int32"}]}]}`
+
+// fixedArraysStepName returns the name of the step at the given state and whether it is a stream, or "" after the last step.
+func fixedArraysStepName(state int) (name string, isStream bool) {
+	switch state {
+	case 0:
+		return "Ints", false
+	case 1:
+		return "FixedSimpleRecordArray", false
+	case 2:
+		return "FixedRecordWithVlensArray", false
+	case 3:
+		return "RecordWithFixedArrays", false
+	case 4:
+		return "NamedArray", false
+	default:
+		return "", false
+	}
+}
+
+type FixedArraysWriter interface {
+	WriteInts(value [2][3]int32) error
+	WriteFixedSimpleRecordArray(value [3][2]SimpleRecord) error
+	WriteFixedRecordWithVlensArray(value [2][2]RecordWithVlens) error
+	WriteRecordWithFixedArrays(value RecordWithFixedArrays) error
+	WriteNamedArray(value NamedFixedNDArray) error
+	Close() error
+}
+
+// FixedArraysWriterImpl is implemented by concrete FixedArrays writers.
+// Its methods are called by FixedArraysWriterBase after it has validated the order of calls.
+type FixedArraysWriterImpl interface {
+	WriteIntsImpl(value [2][3]int32) error
+	WriteFixedSimpleRecordArrayImpl(value [3][2]SimpleRecord) error
+	WriteFixedRecordWithVlensArrayImpl(value [2][2]RecordWithVlens) error
+	WriteRecordWithFixedArraysImpl(value RecordWithFixedArrays) error
+	WriteNamedArrayImpl(value NamedFixedNDArray) error
+	CloseImpl() error
+}
+
+// FixedArraysWriterBase implements FixedArraysWriter, ensuring that the protocol steps are written in order.
+type FixedArraysWriterBase struct {
+	impl  FixedArraysWriterImpl
+	state int
+}
+
+func NewFixedArraysWriterBase(impl FixedArraysWriterImpl) *FixedArraysWriterBase {
+	return &FixedArraysWriterBase{impl: impl}
+}
+
+func (w *FixedArraysWriterBase) WriteInts(value [2][3]int32) error {
+	if w.state != 0 {
+		return w.invalidState("WriteInts()")
+	}
+	if err := w.impl.WriteIntsImpl(value); err != nil {
+		return err
+	}
+	w.state = 1
+	return nil
+}
+
+func (w *FixedArraysWriterBase) WriteFixedSimpleRecordArray(value [3][2]SimpleRecord) error {
+	if w.state != 1 {
+		return w.invalidState("WriteFixedSimpleRecordArray()")
+	}
+	if err := w.impl.WriteFixedSimpleRecordArrayImpl(value); err != nil {
+		return err
+	}
+	w.state = 2
+	return nil
+}
+
+func (w *FixedArraysWriterBase) WriteFixedRecordWithVlensArray(value [2][2]RecordWithVlens) error {
+	if w.state != 2 {
+		return w.invalidState("WriteFixedRecordWithVlensArray()")
+	}
+	if err := w.impl.WriteFixedRecordWithVlensArrayImpl(value); err != nil {
+		return err
+	}
+	w.state = 3
+	return nil
+}
+
+func (w *FixedArraysWriterBase) WriteRecordWithFixedArrays(value RecordWithFixedArrays) error {
+	if w.state != 3 {
+		return w.invalidState("WriteRecordWithFixedArrays()")
+	}
+	if err := w.impl.WriteRecordWithFixedArraysImpl(value); err != nil {
+		return err
+	}
+	w.state = 4
+	return nil
+}
+
+func (w *FixedArraysWriterBase) WriteNamedArray(value NamedFixedNDArray) error {
+	if w.state != 4 {
+		return w.invalidState("WriteNamedArray()")
+	}
+	if err := w.impl.WriteNamedArrayImpl(value); err != nil {
+		return err
+	}
+	w.state = 5
+	return nil
+}
+
+func (w *FixedArraysWriterBase) Close() error {
+	if w.state != 5 {
+		return w.invalidState("Close()")
+	}
+	return w.impl.CloseImpl()
+}
+
+func (w *FixedArraysWriterBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, isStream := fixedArraysStepName(w.state); name != "" {
+		expected = "Write" + name + "()"
+		if isStream {
+			expected += " or End" + name + "()"
+		}
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+type FixedArraysReader interface {
+	ReadInts() ([2][3]int32, error)
+	ReadFixedSimpleRecordArray() ([3][2]SimpleRecord, error)
+	ReadFixedRecordWithVlensArray() ([2][2]RecordWithVlens, error)
+	ReadRecordWithFixedArrays() (RecordWithFixedArrays, error)
+	ReadNamedArray() (NamedFixedNDArray, error)
+	Close() error
+}
+
+// FixedArraysReaderImpl is implemented by concrete FixedArrays readers.
+// Its methods are called by FixedArraysReaderBase after it has validated the order of calls.
+type FixedArraysReaderImpl interface {
+	ReadIntsImpl() ([2][3]int32, error)
+	ReadFixedSimpleRecordArrayImpl() ([3][2]SimpleRecord, error)
+	ReadFixedRecordWithVlensArrayImpl() ([2][2]RecordWithVlens, error)
+	ReadRecordWithFixedArraysImpl() (RecordWithFixedArrays, error)
+	ReadNamedArrayImpl() (NamedFixedNDArray, error)
+	CloseImpl() error
+}
+
+// FixedArraysReaderBase implements FixedArraysReader, ensuring that the protocol steps are read in order.
+type FixedArraysReaderBase struct {
+	impl  FixedArraysReaderImpl
+	state int
+}
+
+func NewFixedArraysReaderBase(impl FixedArraysReaderImpl) *FixedArraysReaderBase {
+	return &FixedArraysReaderBase{impl: impl}
+}
+
+func (r *FixedArraysReaderBase) ReadInts() (value [2][3]int32, err error) {
+	if r.state != 0 {
+		return value, r.invalidState("ReadInts()")
+	}
+	if value, err = r.impl.ReadIntsImpl(); err != nil {
+		return
+	}
+	r.state = 1
+	return
+}
+
+func (r *FixedArraysReaderBase) ReadFixedSimpleRecordArray() (value [3][2]SimpleRecord, err error) {
+	if r.state != 1 {
+		return value, r.invalidState("ReadFixedSimpleRecordArray()")
+	}
+	if value, err = r.impl.ReadFixedSimpleRecordArrayImpl(); err != nil {
+		return
+	}
+	r.state = 2
+	return
+}
+
+func (r *FixedArraysReaderBase) ReadFixedRecordWithVlensArray() (value [2][2]RecordWithVlens, err error) {
+	if r.state != 2 {
+		return value, r.invalidState("ReadFixedRecordWithVlensArray()")
+	}
+	if value, err = r.impl.ReadFixedRecordWithVlensArrayImpl(); err != nil {
+		return
+	}
+	r.state = 3
+	return
+}
+
+func (r *FixedArraysReaderBase) ReadRecordWithFixedArrays() (value RecordWithFixedArrays, err error) {
+	if r.state != 3 {
+		return value, r.invalidState("ReadRecordWithFixedArrays()")
+	}
+	if value, err = r.impl.ReadRecordWithFixedArraysImpl(); err != nil {
+		return
+	}
+	r.state = 4
+	return
+}
+
+func (r *FixedArraysReaderBase) ReadNamedArray() (value NamedFixedNDArray, err error) {
+	if r.state != 4 {
+		return value, r.invalidState("ReadNamedArray()")
+	}
+	if value, err = r.impl.ReadNamedArrayImpl(); err != nil {
+		return
+	}
+	r.state = 5
+	return
+}
+
+func (r *FixedArraysReaderBase) Close() error {
+	if r.state != 5 {
+		return r.invalidState("Close()")
+	}
+	return r.impl.CloseImpl()
+}
+
+func (r *FixedArraysReaderBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, _ := fixedArraysStepName(r.state); name != "" {
+		expected = "Read" + name + "()"
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+// SubarraysSchema is the schema written to the header of binary Subarrays streams.
+const SubarraysSchema = `{"protocol":{"name":"Subarrays","sequence":[{"name":"dynamicWithFixedIntSubarray","type":{"array":{"items":{"array":{"items":"int32","dimensions":[{"length":3}]}}}}},{"name":"dynamicWithFixedFloatSubarray","type":{"array":{"items":{"array":{"items":"float32","dimensions":[{"length":3}]}}}}},{"name":"knownDimCountWithFixedIntSubarray","type":{"array":{"items":{"array":{"items":"int32","dimensions":[{"length":3}]}},"dimensions":1}}},{"name":"knownDimCountWithFixedFloatSubarray","type":{"array":{"items":{"array":{"items":"float32","dimensions":[{"length":3}]}},"dimensions":1}}},{"name":"fixedWithFixedIntSubarray","type":{"array":{"items":{"array":{"items":"int32","dimensions":[{"length":3}]}},"dimensions":[{"length":2}]}}},{"name":"fixedWithFixedFloatSubarray","type":{"array":{"items":{"array":{"items":"float32","dimensions":[{"length":3}]}},"dimensions":[{"length":2}]}}},{"name":"nestedSubarray","type":{"array":{"items":{"array":{"items":{"array":{"items":"int32","dimensions":[{"length":3}]}},"dimensions":[{"length":2}]}}}}},{"name":"dynamicWithFixedVectorSubarray","type":{"array":{"items":{"vector":{"items":"int32","length":3}}}}},{"name":"genericSubarray","type":{"name":"TestModel.Image","typeArguments":[{"array":{"items":"int32","dimensions":[{"length":3}]}}]}}]},"types":[{"name":"Image","typeParameters":["T"],"type":{"array":{"items":"T","dimensions":[{"name":"x"},{"name":"y"}]}}},{"name":"Image","typeParameters":["T"],"type":{"name":"Image.Image","typeArguments":["T"]}}]}`
+
+// subarraysStepName returns the name of the step at the given state and whether it is a stream, or "" after the last step.
+func subarraysStepName(state int) (name string, isStream bool) {
+	switch state {
+	case 0:
+		return "DynamicWithFixedIntSubarray", false
+	case 1:
+		return "DynamicWithFixedFloatSubarray", false
+	case 2:
+		return "KnownDimCountWithFixedIntSubarray", false
+	case 3:
+		return "KnownDimCountWithFixedFloatSubarray", false
+	case 4:
+		return "FixedWithFixedIntSubarray", false
+	case 5:
+		return "FixedWithFixedFloatSubarray", false
+	case 6:
+		return "NestedSubarray", false
+	case 7:
+		return "DynamicWithFixedVectorSubarray", false
+	case 8:
+		return "GenericSubarray", false
+	default:
+		return "", false
+	}
+}
+
+type SubarraysWriter interface {
+	WriteDynamicWithFixedIntSubarray(value yardl.NDArray[[3]int32]) error
+	WriteDynamicWithFixedFloatSubarray(value yardl.NDArray[[3]float32]) error
+	WriteKnownDimCountWithFixedIntSubarray(value yardl.NDArray[[3]int32]) error
+	WriteKnownDimCountWithFixedFloatSubarray(value yardl.NDArray[[3]float32]) error
+	WriteFixedWithFixedIntSubarray(value [2][3]int32) error
+	WriteFixedWithFixedFloatSubarray(value [2][3]float32) error
+	WriteNestedSubarray(value yardl.NDArray[[2][3]int32]) error
+	WriteDynamicWithFixedVectorSubarray(value yardl.NDArray[[3]int32]) error
+	WriteGenericSubarray(value Image[[3]int32]) error
+	Close() error
+}
+
+// SubarraysWriterImpl is implemented by concrete Subarrays writers.
+// Its methods are called by SubarraysWriterBase after it has validated the order of calls.
+type SubarraysWriterImpl interface {
+	WriteDynamicWithFixedIntSubarrayImpl(value yardl.NDArray[[3]int32]) error
+	WriteDynamicWithFixedFloatSubarrayImpl(value yardl.NDArray[[3]float32]) error
+	WriteKnownDimCountWithFixedIntSubarrayImpl(value yardl.NDArray[[3]int32]) error
+	WriteKnownDimCountWithFixedFloatSubarrayImpl(value yardl.NDArray[[3]float32]) error
+	WriteFixedWithFixedIntSubarrayImpl(value [2][3]int32) error
+	WriteFixedWithFixedFloatSubarrayImpl(value [2][3]float32) error
+	WriteNestedSubarrayImpl(value yardl.NDArray[[2][3]int32]) error
+	WriteDynamicWithFixedVectorSubarrayImpl(value yardl.NDArray[[3]int32]) error
+	WriteGenericSubarrayImpl(value Image[[3]int32]) error
+	CloseImpl() error
+}
+
+// SubarraysWriterBase implements SubarraysWriter, ensuring that the protocol steps are written in order.
+type SubarraysWriterBase struct {
+	impl  SubarraysWriterImpl
+	state int
+}
+
+func NewSubarraysWriterBase(impl SubarraysWriterImpl) *SubarraysWriterBase {
+	return &SubarraysWriterBase{impl: impl}
+}
+
+func (w *SubarraysWriterBase) WriteDynamicWithFixedIntSubarray(value yardl.NDArray[[3]int32]) error {
+	if w.state != 0 {
+		return w.invalidState("WriteDynamicWithFixedIntSubarray()")
+	}
+	if err := w.impl.WriteDynamicWithFixedIntSubarrayImpl(value); err != nil {
+		return err
+	}
+	w.state = 1
+	return nil
+}
+
+func (w *SubarraysWriterBase) WriteDynamicWithFixedFloatSubarray(value yardl.NDArray[[3]float32]) error {
+	if w.state != 1 {
+		return w.invalidState("WriteDynamicWithFixedFloatSubarray()")
+	}
+	if err := w.impl.WriteDynamicWithFixedFloatSubarrayImpl(value); err != nil {
+		return err
+	}
+	w.state = 2
+	return nil
+}
+
+func (w *SubarraysWriterBase) WriteKnownDimCountWithFixedIntSubarray(value yardl.NDArray[[3]int32]) error {
+	if w.state != 2 {
+		return w.invalidState("WriteKnownDimCountWithFixedIntSubarray()")
+	}
+	if err := w.impl.WriteKnownDimCountWithFixedIntSubarrayImpl(value); err != nil {
+		return err
+	}
+	w.state = 3
+	return nil
+}
+
+func (w *SubarraysWriterBase) WriteKnownDimCountWithFixedFloatSubarray(value yardl.NDArray[[3]float32]) error {
+	if w.state != 3 {
+		return w.invalidState("WriteKnownDimCountWithFixedFloatSubarray()")
+	}
+	if err := w.impl.WriteKnownDimCountWithFixedFloatSubarrayImpl(value); err != nil {
+		return err
+	}
+	w.state = 4
+	return nil
+}
+
+func (w *SubarraysWriterBase) WriteFixedWithFixedIntSubarray(value [2][3]int32) error {
+	if w.state != 4 {
+		return w.invalidState("WriteFixedWithFixedIntSubarray()")
+	}
+	if err := w.impl.WriteFixedWithFixedIntSubarrayImpl(value); err != nil {
+		return err
+	}
+	w.state = 5
+	return nil
+}
+
+func (w *SubarraysWriterBase) WriteFixedWithFixedFloatSubarray(value [2][3]float32) error {
+	if w.state != 5 {
+		return w.invalidState("WriteFixedWithFixedFloatSubarray()")
+	}
+	if err := w.impl.WriteFixedWithFixedFloatSubarrayImpl(value); err != nil {
+		return err
+	}
+	w.state = 6
+	return nil
+}
+
+func (w *SubarraysWriterBase) WriteNestedSubarray(value yardl.NDArray[[2][3]int32]) error {
+	if w.state != 6 {
+		return w.invalidState("WriteNestedSubarray()")
+	}
+	if err := w.impl.WriteNestedSubarrayImpl(value); err != nil {
+		return err
+	}
+	w.state = 7
+	return nil
+}
+
+func (w *SubarraysWriterBase) WriteDynamicWithFixedVectorSubarray(value yardl.NDArray[[3]int32]) error {
+	if w.state != 7 {
+		return w.invalidState("WriteDynamicWithFixedVectorSubarray()")
+	}
+	if err := w.impl.WriteDynamicWithFixedVectorSubarrayImpl(value); err != nil {
+		return err
+	}
+	w.state = 8
+	return nil
+}
+
+func (w *SubarraysWriterBase) WriteGenericSubarray(value Image[[3]int32]) error {
+	if w.state != 8 {
+		return w.invalidState("WriteGenericSubarray()")
+	}
+	if err := w.impl.WriteGenericSubarrayImpl(value); err != nil {
+		return err
+	}
+	w.state = 9
+	return nil
+}
+
+func (w *SubarraysWriterBase) Close() error {
+	if w.state != 9 {
+		return w.invalidState("Close()")
+	}
+	return w.impl.CloseImpl()
+}
+
+func (w *SubarraysWriterBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, isStream := subarraysStepName(w.state); name != "" {
+		expected = "Write" + name + "()"
+		if isStream {
+			expected += " or End" + name + "()"
+		}
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+type SubarraysReader interface {
+	ReadDynamicWithFixedIntSubarray() (yardl.NDArray[[3]int32], error)
+	ReadDynamicWithFixedFloatSubarray() (yardl.NDArray[[3]float32], error)
+	ReadKnownDimCountWithFixedIntSubarray() (yardl.NDArray[[3]int32], error)
+	ReadKnownDimCountWithFixedFloatSubarray() (yardl.NDArray[[3]float32], error)
+	ReadFixedWithFixedIntSubarray() ([2][3]int32, error)
+	ReadFixedWithFixedFloatSubarray() ([2][3]float32, error)
+	ReadNestedSubarray() (yardl.NDArray[[2][3]int32], error)
+	ReadDynamicWithFixedVectorSubarray() (yardl.NDArray[[3]int32], error)
+	ReadGenericSubarray() (Image[[3]int32], error)
+	Close() error
+}
+
+// SubarraysReaderImpl is implemented by concrete Subarrays readers.
+// Its methods are called by SubarraysReaderBase after it has validated the order of calls.
+type SubarraysReaderImpl interface {
+	ReadDynamicWithFixedIntSubarrayImpl() (yardl.NDArray[[3]int32], error)
+	ReadDynamicWithFixedFloatSubarrayImpl() (yardl.NDArray[[3]float32], error)
+	ReadKnownDimCountWithFixedIntSubarrayImpl() (yardl.NDArray[[3]int32], error)
+	ReadKnownDimCountWithFixedFloatSubarrayImpl() (yardl.NDArray[[3]float32], error)
+	ReadFixedWithFixedIntSubarrayImpl() ([2][3]int32, error)
+	ReadFixedWithFixedFloatSubarrayImpl() ([2][3]float32, error)
+	ReadNestedSubarrayImpl() (yardl.NDArray[[2][3]int32], error)
+	ReadDynamicWithFixedVectorSubarrayImpl() (yardl.NDArray[[3]int32], error)
+	ReadGenericSubarrayImpl() (Image[[3]int32], error)
+	CloseImpl() error
+}
+
+// SubarraysReaderBase implements SubarraysReader, ensuring that the protocol steps are read in order.
+type SubarraysReaderBase struct {
+	impl  SubarraysReaderImpl
+	state int
+}
+
+func NewSubarraysReaderBase(impl SubarraysReaderImpl) *SubarraysReaderBase {
+	return &SubarraysReaderBase{impl: impl}
+}
+
+func (r *SubarraysReaderBase) ReadDynamicWithFixedIntSubarray() (value yardl.NDArray[[3]int32], err error) {
+	if r.state != 0 {
+		return value, r.invalidState("ReadDynamicWithFixedIntSubarray()")
+	}
+	if value, err = r.impl.ReadDynamicWithFixedIntSubarrayImpl(); err != nil {
+		return
+	}
+	r.state = 1
+	return
+}
+
+func (r *SubarraysReaderBase) ReadDynamicWithFixedFloatSubarray() (value yardl.NDArray[[3]float32], err error) {
+	if r.state != 1 {
+		return value, r.invalidState("ReadDynamicWithFixedFloatSubarray()")
+	}
+	if value, err = r.impl.ReadDynamicWithFixedFloatSubarrayImpl(); err != nil {
+		return
+	}
+	r.state = 2
+	return
+}
+
+func (r *SubarraysReaderBase) ReadKnownDimCountWithFixedIntSubarray() (value yardl.NDArray[[3]int32], err error) {
+	if r.state != 2 {
+		return value, r.invalidState("ReadKnownDimCountWithFixedIntSubarray()")
+	}
+	if value, err = r.impl.ReadKnownDimCountWithFixedIntSubarrayImpl(); err != nil {
+		return
+	}
+	r.state = 3
+	return
+}
+
+func (r *SubarraysReaderBase) ReadKnownDimCountWithFixedFloatSubarray() (value yardl.NDArray[[3]float32], err error) {
+	if r.state != 3 {
+		return value, r.invalidState("ReadKnownDimCountWithFixedFloatSubarray()")
+	}
+	if value, err = r.impl.ReadKnownDimCountWithFixedFloatSubarrayImpl(); err != nil {
+		return
+	}
+	r.state = 4
+	return
+}
+
+func (r *SubarraysReaderBase) ReadFixedWithFixedIntSubarray() (value [2][3]int32, err error) {
+	if r.state != 4 {
+		return value, r.invalidState("ReadFixedWithFixedIntSubarray()")
+	}
+	if value, err = r.impl.ReadFixedWithFixedIntSubarrayImpl(); err != nil {
+		return
+	}
+	r.state = 5
+	return
+}
+
+func (r *SubarraysReaderBase) ReadFixedWithFixedFloatSubarray() (value [2][3]float32, err error) {
+	if r.state != 5 {
+		return value, r.invalidState("ReadFixedWithFixedFloatSubarray()")
+	}
+	if value, err = r.impl.ReadFixedWithFixedFloatSubarrayImpl(); err != nil {
+		return
+	}
+	r.state = 6
+	return
+}
+
+func (r *SubarraysReaderBase) ReadNestedSubarray() (value yardl.NDArray[[2][3]int32], err error) {
+	if r.state != 6 {
+		return value, r.invalidState("ReadNestedSubarray()")
+	}
+	if value, err = r.impl.ReadNestedSubarrayImpl(); err != nil {
+		return
+	}
+	r.state = 7
+	return
+}
+
+func (r *SubarraysReaderBase) ReadDynamicWithFixedVectorSubarray() (value yardl.NDArray[[3]int32], err error) {
+	if r.state != 7 {
+		return value, r.invalidState("ReadDynamicWithFixedVectorSubarray()")
+	}
+	if value, err = r.impl.ReadDynamicWithFixedVectorSubarrayImpl(); err != nil {
+		return
+	}
+	r.state = 8
+	return
+}
+
+func (r *SubarraysReaderBase) ReadGenericSubarray() (value Image[[3]int32], err error) {
+	if r.state != 8 {
+		return value, r.invalidState("ReadGenericSubarray()")
+	}
+	if value, err = r.impl.ReadGenericSubarrayImpl(); err != nil {
+		return
+	}
+	r.state = 9
+	return
+}
+
+func (r *SubarraysReaderBase) Close() error {
+	if r.state != 9 {
+		return r.invalidState("Close()")
+	}
+	return r.impl.CloseImpl()
+}
+
+func (r *SubarraysReaderBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, _ := subarraysStepName(r.state); name != "" {
+		expected = "Read" + name + "()"
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+// SubarraysInRecordsSchema is the schema written to the header of binary SubarraysInRecords streams.
+const SubarraysInRecordsSchema = `{"protocol":{"name":"SubarraysInRecords","sequence":[{"name":"withFixedSubarrays","type":{"array":{"items":"TestModel.RecordWithFixedCollections"}}},{"name":"withVlenSubarrays","type":{"array":{"items":"TestModel.RecordWithVlenCollections"}}}]},"types":[{"name":"RecordWithFixedCollections","fields":[{"name":"fixedVector","type":{"vector":{"items":"int32","length":3}}},{"name":"fixedArray","type":{"array":{"items":"int32","dimensions":[{"length":2},{"length":3}]}}}]},{"name":"RecordWithVlenCollections","fields":[{"name":"vector","type":{"vector":{"items":"int32"}}},{"name":"array","type":{"array":{"items":"int32","dimensions":2}}}]}]}`
+
+// subarraysInRecordsStepName returns the name of the step at the given state and whether it is a stream, or "" after the last step.
+func subarraysInRecordsStepName(state int) (name string, isStream bool) {
+	switch state {
+	case 0:
+		return "WithFixedSubarrays", false
+	case 1:
+		return "WithVlenSubarrays", false
+	default:
+		return "", false
+	}
+}
+
+type SubarraysInRecordsWriter interface {
+	WriteWithFixedSubarrays(value yardl.NDArray[RecordWithFixedCollections]) error
+	WriteWithVlenSubarrays(value yardl.NDArray[RecordWithVlenCollections]) error
+	Close() error
+}
+
+// SubarraysInRecordsWriterImpl is implemented by concrete SubarraysInRecords writers.
+// Its methods are called by SubarraysInRecordsWriterBase after it has validated the order of calls.
+type SubarraysInRecordsWriterImpl interface {
+	WriteWithFixedSubarraysImpl(value yardl.NDArray[RecordWithFixedCollections]) error
+	WriteWithVlenSubarraysImpl(value yardl.NDArray[RecordWithVlenCollections]) error
+	CloseImpl() error
+}
+
+// SubarraysInRecordsWriterBase implements SubarraysInRecordsWriter, ensuring that the protocol steps are written in order.
+type SubarraysInRecordsWriterBase struct {
+	impl  SubarraysInRecordsWriterImpl
+	state int
+}
+
+func NewSubarraysInRecordsWriterBase(impl SubarraysInRecordsWriterImpl) *SubarraysInRecordsWriterBase {
+	return &SubarraysInRecordsWriterBase{impl: impl}
+}
+
+func (w *SubarraysInRecordsWriterBase) WriteWithFixedSubarrays(value yardl.NDArray[RecordWithFixedCollections]) error {
+	if w.state != 0 {
+		return w.invalidState("WriteWithFixedSubarrays()")
+	}
+	if err := w.impl.WriteWithFixedSubarraysImpl(value); err != nil {
+		return err
+	}
+	w.state = 1
+	return nil
+}
+
+func (w *SubarraysInRecordsWriterBase) WriteWithVlenSubarrays(value yardl.NDArray[RecordWithVlenCollections]) error {
+	if w.state != 1 {
+		return w.invalidState("WriteWithVlenSubarrays()")
+	}
+	if err := w.impl.WriteWithVlenSubarraysImpl(value); err != nil {
+		return err
+	}
+	w.state = 2
+	return nil
+}
+
+func (w *SubarraysInRecordsWriterBase) Close() error {
+	if w.state != 2 {
+		return w.invalidState("Close()")
+	}
+	return w.impl.CloseImpl()
+}
+
+func (w *SubarraysInRecordsWriterBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, isStream := subarraysInRecordsStepName(w.state); name != "" {
+		expected = "Write" + name + "()"
+		if isStream {
+			expected += " or End" + name + "()"
+		}
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+type SubarraysInRecordsReader interface {
+	ReadWithFixedSubarrays() (yardl.NDArray[RecordWithFixedCollections], error)
+	ReadWithVlenSubarrays() (yardl.NDArray[RecordWithVlenCollections], error)
+	Close() error
+}
+
+// SubarraysInRecordsReaderImpl is implemented by concrete SubarraysInRecords readers.
+// Its methods are called by SubarraysInRecordsReaderBase after it has validated the order of calls.
+type SubarraysInRecordsReaderImpl interface {
+	ReadWithFixedSubarraysImpl() (yardl.NDArray[RecordWithFixedCollections], error)
+	ReadWithVlenSubarraysImpl() (yardl.NDArray[RecordWithVlenCollections], error)
+	CloseImpl() error
+}
+
+// SubarraysInRecordsReaderBase implements SubarraysInRecordsReader, ensuring that the protocol steps are read in order.
+type SubarraysInRecordsReaderBase struct {
+	impl  SubarraysInRecordsReaderImpl
+	state int
+}
+
+func NewSubarraysInRecordsReaderBase(impl SubarraysInRecordsReaderImpl) *SubarraysInRecordsReaderBase {
+	return &SubarraysInRecordsReaderBase{impl: impl}
+}
+
+func (r *SubarraysInRecordsReaderBase) ReadWithFixedSubarrays() (value yardl.NDArray[RecordWithFixedCollections], err error) {
+	if r.state != 0 {
+		return value, r.invalidState("ReadWithFixedSubarrays()")
+	}
+	if value, err = r.impl.ReadWithFixedSubarraysImpl(); err != nil {
+		return
+	}
+	r.state = 1
+	return
+}
+
+func (r *SubarraysInRecordsReaderBase) ReadWithVlenSubarrays() (value yardl.NDArray[RecordWithVlenCollections], err error) {
+	if r.state != 1 {
+		return value, r.invalidState("ReadWithVlenSubarrays()")
+	}
+	if value, err = r.impl.ReadWithVlenSubarraysImpl(); err != nil {
+		return
+	}
+	r.state = 2
+	return
+}
+
+func (r *SubarraysInRecordsReaderBase) Close() error {
+	if r.state != 2 {
+		return r.invalidState("Close()")
+	}
+	return r.impl.CloseImpl()
+}
+
+func (r *SubarraysInRecordsReaderBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, _ := subarraysInRecordsStepName(r.state); name != "" {
+		expected = "Read" + name + "()"
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+// NDArraysSchema is the schema written to the header of binary NDArrays streams.
+const NDArraysSchema = `{"protocol":{"name":"NDArrays","sequence":[{"name":"ints","type":{"array":{"items":"int32","dimensions":2}}},{"name":"simpleRecordArray","type":{"array":{"items":"TestModel.SimpleRecord","dimensions":2}}},{"name":"recordWithVlensArray","type":{"array":{"items":"TestModel.RecordWithVlens","dimensions":2}}},{"name":"recordWithNDArrays","type":"TestModel.RecordWithNDArrays"},{"name":"namedArray","type":"TestModel.NamedNDArray"}]},"types":[{"name":"NamedNDArray","type":{"array":{"items":"int32","dimensions":[{"name":"dimA"},{"name":"dimB"}]}}},{"name":"RecordWithNDArrays","fields":[{"name":"ints","type":{"array":{"items":"int32","dimensions":2}}},{"name":"fixedSimpleRecordArray","type":{"array":{"items":"TestModel.SimpleRecord","dimensions":2}}},{"name":"fixedRecordWithVlensArray","type":{"array":{"items":"TestModel.RecordWithVlens","dimensions":2}}}]},{"name":"RecordWithVlens","fields":[{"name":"a","type":{"vector":{"items":"TestModel.SimpleRecord"}}},{"name":"b","type":"int32"},{"name":"c","type":"int32"}]},{"name":"SimpleRecord","fields":[{"name":"x","type":"int32"},{"name":"y","type":"int32"},{"name":"z","type":"int32"}]}]}`
+
+// nDArraysStepName returns the name of the step at the given state and whether it is a stream, or "" after the last step.
+func nDArraysStepName(state int) (name string, isStream bool) {
+	switch state {
+	case 0:
+		return "Ints", false
+	case 1:
+		return "SimpleRecordArray", false
+	case 2:
+		return "RecordWithVlensArray", false
+	case 3:
+		return "RecordWithNDArrays", false
+	case 4:
+		return "NamedArray", false
+	default:
+		return "", false
+	}
+}
+
+type NDArraysWriter interface {
+	WriteInts(value yardl.NDArray[int32]) error
+	WriteSimpleRecordArray(value yardl.NDArray[SimpleRecord]) error
+	WriteRecordWithVlensArray(value yardl.NDArray[RecordWithVlens]) error
+	WriteRecordWithNDArrays(value RecordWithNDArrays) error
+	WriteNamedArray(value NamedNDArray) error
+	Close() error
+}
+
+// NDArraysWriterImpl is implemented by concrete NDArrays writers.
+// Its methods are called by NDArraysWriterBase after it has validated the order of calls.
+type NDArraysWriterImpl interface {
+	WriteIntsImpl(value yardl.NDArray[int32]) error
+	WriteSimpleRecordArrayImpl(value yardl.NDArray[SimpleRecord]) error
+	WriteRecordWithVlensArrayImpl(value yardl.NDArray[RecordWithVlens]) error
+	WriteRecordWithNDArraysImpl(value RecordWithNDArrays) error
+	WriteNamedArrayImpl(value NamedNDArray) error
+	CloseImpl() error
+}
+
+// NDArraysWriterBase implements NDArraysWriter, ensuring that the protocol steps are written in order.
+type NDArraysWriterBase struct {
+	impl  NDArraysWriterImpl
+	state int
+}
+
+func NewNDArraysWriterBase(impl NDArraysWriterImpl) *NDArraysWriterBase {
+	return &NDArraysWriterBase{impl: impl}
+}
+
+func (w *NDArraysWriterBase) WriteInts(value yardl.NDArray[int32]) error {
+	if w.state != 0 {
+		return w.invalidState("WriteInts()")
+	}
+	if err := w.impl.WriteIntsImpl(value); err != nil {
+		return err
+	}
+	w.state = 1
+	return nil
+}
+
+func (w *NDArraysWriterBase) WriteSimpleRecordArray(value yardl.NDArray[SimpleRecord]) error {
+	if w.state != 1 {
+		return w.invalidState("WriteSimpleRecordArray()")
+	}
+	if err := w.impl.WriteSimpleRecordArrayImpl(value); err != nil {
+		return err
+	}
+	w.state = 2
+	return nil
+}
+
+func (w *NDArraysWriterBase) WriteRecordWithVlensArray(value yardl.NDArray[RecordWithVlens]) error {
+	if w.state != 2 {
+		return w.invalidState("WriteRecordWithVlensArray()")
+	}
+	if err := w.impl.WriteRecordWithVlensArrayImpl(value); err != nil {
+		return err
+	}
+	w.state = 3
+	return nil
+}
+
+func (w *NDArraysWriterBase) WriteRecordWithNDArrays(value RecordWithNDArrays) error {
+	if w.state != 3 {
+		return w.invalidState("WriteRecordWithNDArrays()")
+	}
+	if err := w.impl.WriteRecordWithNDArraysImpl(value); err != nil {
+		return err
+	}
+	w.state = 4
+	return nil
+}
+
+func (w *NDArraysWriterBase) WriteNamedArray(value NamedNDArray) error {
+	if w.state != 4 {
+		return w.invalidState("WriteNamedArray()")
+	}
+	if err := w.impl.WriteNamedArrayImpl(value); err != nil {
+		return err
+	}
+	w.state = 5
+	return nil
+}
+
+func (w *NDArraysWriterBase) Close() error {
+	if w.state != 5 {
+		return w.invalidState("Close()")
+	}
+	return w.impl.CloseImpl()
+}
+
+func (w *NDArraysWriterBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, isStream := nDArraysStepName(w.state); name != "" {
+		expected = "Write" + name + "()"
+		if isStream {
+			expected += " or End" + name + "()"
+		}
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+type NDArraysReader interface {
+	ReadInts() (yardl.NDArray[int32], error)
+	ReadSimpleRecordArray() (yardl.NDArray[SimpleRecord], error)
+	ReadRecordWithVlensArray() (yardl.NDArray[RecordWithVlens], error)
+	ReadRecordWithNDArrays() (RecordWithNDArrays, error)
+	ReadNamedArray() (NamedNDArray, error)
+	Close() error
+}
+
+// NDArraysReaderImpl is implemented by concrete NDArrays readers.
+// Its methods are called by NDArraysReaderBase after it has validated the order of calls.
+type NDArraysReaderImpl interface {
+	ReadIntsImpl() (yardl.NDArray[int32], error)
+	ReadSimpleRecordArrayImpl() (yardl.NDArray[SimpleRecord], error)
+	ReadRecordWithVlensArrayImpl() (yardl.NDArray[RecordWithVlens], error)
+	ReadRecordWithNDArraysImpl() (RecordWithNDArrays, error)
+	ReadNamedArrayImpl() (NamedNDArray, error)
+	CloseImpl() error
+}
+
+// NDArraysReaderBase implements NDArraysReader, ensuring that the protocol steps are read in order.
+type NDArraysReaderBase struct {
+	impl  NDArraysReaderImpl
+	state int
+}
+
+func NewNDArraysReaderBase(impl NDArraysReaderImpl) *NDArraysReaderBase {
+	return &NDArraysReaderBase{impl: impl}
+}
+
+func (r *NDArraysReaderBase) ReadInts() (value yardl.NDArray[int32], err error) {
+	if r.state != 0 {
+		return value, r.invalidState("ReadInts()")
+	}
+	if value, err = r.impl.ReadIntsImpl(); err != nil {
+		return
+	}
+	r.state = 1
+	return
+}
+
+func (r *NDArraysReaderBase) ReadSimpleRecordArray() (value yardl.NDArray[SimpleRecord], err error) {
+	if r.state != 1 {
+		return value, r.invalidState("ReadSimpleRecordArray()")
+	}
+	if value, err = r.impl.ReadSimpleRecordArrayImpl(); err != nil {
+		return
+	}
+	r.state = 2
+	return
+}
+
+func (r *NDArraysReaderBase) ReadRecordWithVlensArray() (value yardl.NDArray[RecordWithVlens], err error) {
+	if r.state != 2 {
+		return value, r.invalidState("ReadRecordWithVlensArray()")
+	}
+	if value, err = r.impl.ReadRecordWithVlensArrayImpl(); err != nil {
+		return
+	}
+	r.state = 3
+	return
+}
+
+func (r *NDArraysReaderBase) ReadRecordWithNDArrays() (value RecordWithNDArrays, err error) {
+	if r.state != 3 {
+		return value, r.invalidState("ReadRecordWithNDArrays()")
+	}
+	if value, err = r.impl.ReadRecordWithNDArraysImpl(); err != nil {
+		return
+	}
+	r.state = 4
+	return
+}
+
+func (r *NDArraysReaderBase) ReadNamedArray() (value NamedNDArray, err error) {
+	if r.state != 4 {
+		return value, r.invalidState("ReadNamedArray()")
+	}
+	if value, err = r.impl.ReadNamedArrayImpl(); err != nil {
+		return
+	}
+	r.state = 5
+	return
+}
+
+func (r *NDArraysReaderBase) Close() error {
+	if r.state != 5 {
+		return r.invalidState("Close()")
+	}
+	return r.impl.CloseImpl()
+}
+
+func (r *NDArraysReaderBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, _ := nDArraysStepName(r.state); name != "" {
+		expected = "Read" + name + "()"
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+// NDArraysSingleDimensionSchema is the schema written to the header of binary NDArraysSingleDimension streams.
+const NDArraysSingleDimensionSchema = `{"protocol":{"name":"NDArraysSingleDimension","sequence":[{"name":"ints","type":{"array":{"items":"int32","dimensions":1}}},{"name":"simpleRecordArray","type":{"array":{"items":"TestModel.SimpleRecord","dimensions":1}}},{"name":"recordWithVlensArray","type":{"array":{"items":"TestModel.RecordWithVlens","dimensions":1}}},{"name":"recordWithNDArrays","type":"TestModel.RecordWithNDArraysSingleDimension"}]},"types":[{"name":"RecordWithNDArraysSingleDimension","fields":[{"name":"ints","type":{"array":{"items":"int32","dimensions":1}}},{"name":"fixedSimpleRecordArray","type":{"array":{"items":"TestModel.SimpleRecord","dimensions":1}}},{"name":"fixedRecordWithVlensArray","type":{"array":{"items":"TestModel.RecordWithVlens","dimensions":1}}}]},{"name":"RecordWithVlens","fields":[{"name":"a","type":{"vector":{"items":"TestModel.SimpleRecord"}}},{"name":"b","type":"int32"},{"name":"c","type":"int32"}]},{"name":"SimpleRecord","fields":[{"name":"x","type":"int32"},{"name":"y","type":"int32"},{"name":"z","type":"int32"}]}]}`
+
+// nDArraysSingleDimensionStepName returns the name of the step at the given state and whether it is a stream, or "" after the last step.
+func nDArraysSingleDimensionStepName(state int) (name string, isStream bool) {
+	switch state {
+	case 0:
+		return "Ints", false
+	case 1:
+		return "SimpleRecordArray", false
+	case 2:
+		return "RecordWithVlensArray", false
+	case 3:
+		return "RecordWithNDArrays", false
+	default:
+		return "", false
+	}
+}
+
+type NDArraysSingleDimensionWriter interface {
+	WriteInts(value yardl.NDArray[int32]) error
+	WriteSimpleRecordArray(value yardl.NDArray[SimpleRecord]) error
+	WriteRecordWithVlensArray(value yardl.NDArray[RecordWithVlens]) error
+	WriteRecordWithNDArrays(value RecordWithNDArraysSingleDimension) error
+	Close() error
+}
+
+// NDArraysSingleDimensionWriterImpl is implemented by concrete NDArraysSingleDimension writers.
+// Its methods are called by NDArraysSingleDimensionWriterBase after it has validated the order of calls.
+type NDArraysSingleDimensionWriterImpl interface {
+	WriteIntsImpl(value yardl.NDArray[int32]) error
+	WriteSimpleRecordArrayImpl(value yardl.NDArray[SimpleRecord]) error
+	WriteRecordWithVlensArrayImpl(value yardl.NDArray[RecordWithVlens]) error
+	WriteRecordWithNDArraysImpl(value RecordWithNDArraysSingleDimension) error
+	CloseImpl() error
+}
+
+// NDArraysSingleDimensionWriterBase implements NDArraysSingleDimensionWriter, ensuring that the protocol steps are written in order.
+type NDArraysSingleDimensionWriterBase struct {
+	impl  NDArraysSingleDimensionWriterImpl
+	state int
+}
+
+func NewNDArraysSingleDimensionWriterBase(impl NDArraysSingleDimensionWriterImpl) *NDArraysSingleDimensionWriterBase {
+	return &NDArraysSingleDimensionWriterBase{impl: impl}
+}
+
+func (w *NDArraysSingleDimensionWriterBase) WriteInts(value yardl.NDArray[int32]) error {
+	if w.state != 0 {
+		return w.invalidState("WriteInts()")
+	}
+	if err := w.impl.WriteIntsImpl(value); err != nil {
+		return err
+	}
+	w.state = 1
+	return nil
+}
+
+func (w *NDArraysSingleDimensionWriterBase) WriteSimpleRecordArray(value yardl.NDArray[SimpleRecord]) error {
+	if w.state != 1 {
+		return w.invalidState("WriteSimpleRecordArray()")
+	}
+	if err := w.impl.WriteSimpleRecordArrayImpl(value); err != nil {
+		return err
+	}
+	w.state = 2
+	return nil
+}
+
+func (w *NDArraysSingleDimensionWriterBase) WriteRecordWithVlensArray(value yardl.NDArray[RecordWithVlens]) error {
+	if w.state != 2 {
+		return w.invalidState("WriteRecordWithVlensArray()")
+	}
+	if err := w.impl.WriteRecordWithVlensArrayImpl(value); err != nil {
+		return err
+	}
+	w.state = 3
+	return nil
+}
+
+func (w *NDArraysSingleDimensionWriterBase) WriteRecordWithNDArrays(value RecordWithNDArraysSingleDimension) error {
+	if w.state != 3 {
+		return w.invalidState("WriteRecordWithNDArrays()")
+	}
+	if err := w.impl.WriteRecordWithNDArraysImpl(value); err != nil {
+		return err
+	}
+	w.state = 4
+	return nil
+}
+
+func (w *NDArraysSingleDimensionWriterBase) Close() error {
+	if w.state != 4 {
+		return w.invalidState("Close()")
+	}
+	return w.impl.CloseImpl()
+}
+
+func (w *NDArraysSingleDimensionWriterBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, isStream := nDArraysSingleDimensionStepName(w.state); name != "" {
+		expected = "Write" + name + "()"
+		if isStream {
+			expected += " or End" + name + "()"
+		}
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+type NDArraysSingleDimensionReader interface {
+	ReadInts() (yardl.NDArray[int32], error)
+	ReadSimpleRecordArray() (yardl.NDArray[SimpleRecord], error)
+	ReadRecordWithVlensArray() (yardl.NDArray[RecordWithVlens], error)
+	ReadRecordWithNDArrays() (RecordWithNDArraysSingleDimension, error)
+	Close() error
+}
+
+// NDArraysSingleDimensionReaderImpl is implemented by concrete NDArraysSingleDimension readers.
+// Its methods are called by NDArraysSingleDimensionReaderBase after it has validated the order of calls.
+type NDArraysSingleDimensionReaderImpl interface {
+	ReadIntsImpl() (yardl.NDArray[int32], error)
+	ReadSimpleRecordArrayImpl() (yardl.NDArray[SimpleRecord], error)
+	ReadRecordWithVlensArrayImpl() (yardl.NDArray[RecordWithVlens], error)
+	ReadRecordWithNDArraysImpl() (RecordWithNDArraysSingleDimension, error)
+	CloseImpl() error
+}
+
+// NDArraysSingleDimensionReaderBase implements NDArraysSingleDimensionReader, ensuring that the protocol steps are read in order.
+type NDArraysSingleDimensionReaderBase struct {
+	impl  NDArraysSingleDimensionReaderImpl
+	state int
+}
+
+func NewNDArraysSingleDimensionReaderBase(impl NDArraysSingleDimensionReaderImpl) *NDArraysSingleDimensionReaderBase {
+	return &NDArraysSingleDimensionReaderBase{impl: impl}
+}
+
+func (r *NDArraysSingleDimensionReaderBase) ReadInts() (value yardl.NDArray[int32], err error) {
+	if r.state != 0 {
+		return value, r.invalidState("ReadInts()")
+	}
+	if value, err = r.impl.ReadIntsImpl(); err != nil {
+		return
+	}
+	r.state = 1
+	return
+}
+
+func (r *NDArraysSingleDimensionReaderBase) ReadSimpleRecordArray() (value yardl.NDArray[SimpleRecord], err error) {
+	if r.state != 1 {
+		return value, r.invalidState("ReadSimpleRecordArray()")
+	}
+	if value, err = r.impl.ReadSimpleRecordArrayImpl(); err != nil {
+		return
+	}
+	r.state = 2
+	return
+}
+
+func (r *NDArraysSingleDimensionReaderBase) ReadRecordWithVlensArray() (value yardl.NDArray[RecordWithVlens], err error) {
+	if r.state != 2 {
+		return value, r.invalidState("ReadRecordWithVlensArray()")
+	}
+	if value, err = r.impl.ReadRecordWithVlensArrayImpl(); err != nil {
+		return
+	}
+	r.state = 3
+	return
+}
+
+func (r *NDArraysSingleDimensionReaderBase) ReadRecordWithNDArrays() (value RecordWithNDArraysSingleDimension, err error) {
+	if r.state != 3 {
+		return value, r.invalidState("ReadRecordWithNDArrays()")
+	}
+	if value, err = r.impl.ReadRecordWithNDArraysImpl(); err != nil {
+		return
+	}
+	r.state = 4
+	return
+}
+
+func (r *NDArraysSingleDimensionReaderBase) Close() error {
+	if r.state != 4 {
+		return r.invalidState("Close()")
+	}
+	return r.impl.CloseImpl()
+}
+
+func (r *NDArraysSingleDimensionReaderBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, _ := nDArraysSingleDimensionStepName(r.state); name != "" {
+		expected = "Read" + name + "()"
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+// DynamicNDArraysSchema is the schema written to the header of binary DynamicNDArrays streams.
+const DynamicNDArraysSchema = `{"protocol":{"name":"DynamicNDArrays","sequence":[{"name":"ints","type":{"array":{"items":"int32"}}},{"name":"simpleRecordArray","type":{"array":{"items":"TestModel.SimpleRecord"}}},{"name":"recordWithVlensArray","type":{"array":{"items":"TestModel.RecordWithVlens"}}},{"name":"recordWithDynamicNDArrays","type":"TestModel.RecordWithDynamicNDArrays"}]},"types":[{"name":"IntArray","type":{"array":{"items":"int32"}}},{"name":"RecordWithDynamicNDArrays","fields":[{"name":"ints","type":"TestModel.IntArray"},{"name":"simpleRecordArray","type":{"array":{"items":"TestModel.SimpleRecord"}}},{"name":"recordWithVlensArray","type":{"array":{"items":"TestModel.RecordWithVlens"}}}]},{"name":"RecordWithVlens","fields":[{"name":"a","type":{"vector":{"items":"TestModel.SimpleRecord"}}},{"name":"b","type":"int32"},{"name":"c","type":"int32"}]},{"name":"SimpleRecord","fields":[{"name":"x","type":"int32"},{"name":"y","type":"int32"},{"name":"z","type":"int32"}]}]}`
+
+// dynamicNDArraysStepName returns the name of the step at the given state and whether it is a stream, or "" after the last step.
+func dynamicNDArraysStepName(state int) (name string, isStream bool) {
+	switch state {
+	case 0:
+		return "Ints", false
+	case 1:
+		return "SimpleRecordArray", false
+	case 2:
+		return "RecordWithVlensArray", false
+	case 3:
+		return "RecordWithDynamicNDArrays", false
+	default:
+		return "", false
+	}
+}
+
+type DynamicNDArraysWriter interface {
+	WriteInts(value yardl.NDArray[int32]) error
+	WriteSimpleRecordArray(value yardl.NDArray[SimpleRecord]) error
+	WriteRecordWithVlensArray(value yardl.NDArray[RecordWithVlens]) error
+	WriteRecordWithDynamicNDArrays(value RecordWithDynamicNDArrays) error
+	Close() error
+}
+
+// DynamicNDArraysWriterImpl is implemented by concrete DynamicNDArrays writers.
+// Its methods are called by DynamicNDArraysWriterBase after it has validated the order of calls.
+type DynamicNDArraysWriterImpl interface {
+	WriteIntsImpl(value yardl.NDArray[int32]) error
+	WriteSimpleRecordArrayImpl(value yardl.NDArray[SimpleRecord]) error
+	WriteRecordWithVlensArrayImpl(value yardl.NDArray[RecordWithVlens]) error
+	WriteRecordWithDynamicNDArraysImpl(value RecordWithDynamicNDArrays) error
+	CloseImpl() error
+}
+
+// DynamicNDArraysWriterBase implements DynamicNDArraysWriter, ensuring that the protocol steps are written in order.
+type DynamicNDArraysWriterBase struct {
+	impl  DynamicNDArraysWriterImpl
+	state int
+}
+
+func NewDynamicNDArraysWriterBase(impl DynamicNDArraysWriterImpl) *DynamicNDArraysWriterBase {
+	return &DynamicNDArraysWriterBase{impl: impl}
+}
+
+func (w *DynamicNDArraysWriterBase) WriteInts(value yardl.NDArray[int32]) error {
+	if w.state != 0 {
+		return w.invalidState("WriteInts()")
+	}
+	if err := w.impl.WriteIntsImpl(value); err != nil {
+		return err
+	}
+	w.state = 1
+	return nil
+}
+
+func (w *DynamicNDArraysWriterBase) WriteSimpleRecordArray(value yardl.NDArray[SimpleRecord]) error {
+	if w.state != 1 {
+		return w.invalidState("WriteSimpleRecordArray()")
+	}
+	if err := w.impl.WriteSimpleRecordArrayImpl(value); err != nil {
+		return err
+	}
+	w.state = 2
+	return nil
+}
+
+func (w *DynamicNDArraysWriterBase) WriteRecordWithVlensArray(value yardl.NDArray[RecordWithVlens]) error {
+	if w.state != 2 {
+		return w.invalidState("WriteRecordWithVlensArray()")
+	}
+	if err := w.impl.WriteRecordWithVlensArrayImpl(value); err != nil {
+		return err
+	}
+	w.state = 3
+	return nil
+}
+
+func (w *DynamicNDArraysWriterBase) WriteRecordWithDynamicNDArrays(value RecordWithDynamicNDArrays) error {
+	if w.state != 3 {
+		return w.invalidState("WriteRecordWithDynamicNDArrays()")
+	}
+	if err := w.impl.WriteRecordWithDynamicNDArraysImpl(value); err != nil {
+		return err
+	}
+	w.state = 4
+	return nil
+}
+
+func (w *DynamicNDArraysWriterBase) Close() error {
+	if w.state != 4 {
+		return w.invalidState("Close()")
+	}
+	return w.impl.CloseImpl()
+}
+
+func (w *DynamicNDArraysWriterBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, isStream := dynamicNDArraysStepName(w.state); name != "" {
+		expected = "Write" + name + "()"
+		if isStream {
+			expected += " or End" + name + "()"
+		}
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+type DynamicNDArraysReader interface {
+	ReadInts() (yardl.NDArray[int32], error)
+	ReadSimpleRecordArray() (yardl.NDArray[SimpleRecord], error)
+	ReadRecordWithVlensArray() (yardl.NDArray[RecordWithVlens], error)
+	ReadRecordWithDynamicNDArrays() (RecordWithDynamicNDArrays, error)
+	Close() error
+}
+
+// DynamicNDArraysReaderImpl is implemented by concrete DynamicNDArrays readers.
+// Its methods are called by DynamicNDArraysReaderBase after it has validated the order of calls.
+type DynamicNDArraysReaderImpl interface {
+	ReadIntsImpl() (yardl.NDArray[int32], error)
+	ReadSimpleRecordArrayImpl() (yardl.NDArray[SimpleRecord], error)
+	ReadRecordWithVlensArrayImpl() (yardl.NDArray[RecordWithVlens], error)
+	ReadRecordWithDynamicNDArraysImpl() (RecordWithDynamicNDArrays, error)
+	CloseImpl() error
+}
+
+// DynamicNDArraysReaderBase implements DynamicNDArraysReader, ensuring that the protocol steps are read in order.
+type DynamicNDArraysReaderBase struct {
+	impl  DynamicNDArraysReaderImpl
+	state int
+}
+
+func NewDynamicNDArraysReaderBase(impl DynamicNDArraysReaderImpl) *DynamicNDArraysReaderBase {
+	return &DynamicNDArraysReaderBase{impl: impl}
+}
+
+func (r *DynamicNDArraysReaderBase) ReadInts() (value yardl.NDArray[int32], err error) {
+	if r.state != 0 {
+		return value, r.invalidState("ReadInts()")
+	}
+	if value, err = r.impl.ReadIntsImpl(); err != nil {
+		return
+	}
+	r.state = 1
+	return
+}
+
+func (r *DynamicNDArraysReaderBase) ReadSimpleRecordArray() (value yardl.NDArray[SimpleRecord], err error) {
+	if r.state != 1 {
+		return value, r.invalidState("ReadSimpleRecordArray()")
+	}
+	if value, err = r.impl.ReadSimpleRecordArrayImpl(); err != nil {
+		return
+	}
+	r.state = 2
+	return
+}
+
+func (r *DynamicNDArraysReaderBase) ReadRecordWithVlensArray() (value yardl.NDArray[RecordWithVlens], err error) {
+	if r.state != 2 {
+		return value, r.invalidState("ReadRecordWithVlensArray()")
+	}
+	if value, err = r.impl.ReadRecordWithVlensArrayImpl(); err != nil {
+		return
+	}
+	r.state = 3
+	return
+}
+
+func (r *DynamicNDArraysReaderBase) ReadRecordWithDynamicNDArrays() (value RecordWithDynamicNDArrays, err error) {
+	if r.state != 3 {
+		return value, r.invalidState("ReadRecordWithDynamicNDArrays()")
+	}
+	if value, err = r.impl.ReadRecordWithDynamicNDArraysImpl(); err != nil {
+		return
+	}
+	r.state = 4
+	return
+}
+
+func (r *DynamicNDArraysReaderBase) Close() error {
+	if r.state != 4 {
+		return r.invalidState("Close()")
+	}
+	return r.impl.CloseImpl()
+}
+
+func (r *DynamicNDArraysReaderBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, _ := dynamicNDArraysStepName(r.state); name != "" {
+		expected = "Read" + name + "()"
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+// MultiDArraysSchema is the schema written to the header of binary MultiDArrays streams.
+const MultiDArraysSchema = `{"protocol":{"name":"MultiDArrays","sequence":[{"name":"images","type":{"stream":{"items":{"array":{"items":"float32","dimensions":[{"name":"ch"},{"name":"z"},{"name":"y"},{"name":"x"}]}}}}},{"name":"frames","type":{"stream":{"items":{"array":{"items":"float32","dimensions":[{"name":"ch","length":1},{"name":"z","length":1},{"name":"y","length":64},{"name":"x","length":32}]}}}}}]},"types":null}`
+
+// multiDArraysStepName returns the name of the step at the given state and whether it is a stream, or "" after the last step.
+func multiDArraysStepName(state int) (name string, isStream bool) {
+	switch state {
+	case 0:
+		return "Images", true
+	case 1:
+		return "Frames", true
+	default:
+		return "", false
+	}
+}
+
+type MultiDArraysWriter interface {
+	WriteImages(values ...yardl.NDArray[float32]) error
+	EndImages() error
+	WriteFrames(values ...[1][1][64][32]float32) error
+	EndFrames() error
+	Close() error
+}
+
+// MultiDArraysWriterImpl is implemented by concrete MultiDArrays writers.
+// Its methods are called by MultiDArraysWriterBase after it has validated the order of calls.
+type MultiDArraysWriterImpl interface {
+	WriteImagesImpl(values []yardl.NDArray[float32]) error
+	EndImagesImpl() error
+	WriteFramesImpl(values [][1][1][64][32]float32) error
+	EndFramesImpl() error
+	CloseImpl() error
+}
+
+// MultiDArraysWriterBase implements MultiDArraysWriter, ensuring that the protocol steps are written in order.
+type MultiDArraysWriterBase struct {
+	impl  MultiDArraysWriterImpl
+	state int
+}
+
+func NewMultiDArraysWriterBase(impl MultiDArraysWriterImpl) *MultiDArraysWriterBase {
+	return &MultiDArraysWriterBase{impl: impl}
+}
+
+func (w *MultiDArraysWriterBase) WriteImages(values ...yardl.NDArray[float32]) error {
+	if w.state != 0 {
+		return w.invalidState("WriteImages()")
+	}
+	return w.impl.WriteImagesImpl(values)
+}
+
+func (w *MultiDArraysWriterBase) EndImages() error {
+	if w.state != 0 {
+		return w.invalidState("EndImages()")
+	}
+	if err := w.impl.EndImagesImpl(); err != nil {
+		return err
+	}
+	w.state = 1
+	return nil
+}
+
+func (w *MultiDArraysWriterBase) WriteFrames(values ...[1][1][64][32]float32) error {
+	if w.state != 1 {
+		return w.invalidState("WriteFrames()")
+	}
+	return w.impl.WriteFramesImpl(values)
+}
+
+func (w *MultiDArraysWriterBase) EndFrames() error {
+	if w.state != 1 {
+		return w.invalidState("EndFrames()")
+	}
+	if err := w.impl.EndFramesImpl(); err != nil {
+		return err
+	}
+	w.state = 2
+	return nil
+}
+
+func (w *MultiDArraysWriterBase) Close() error {
+	if w.state != 2 {
+		return w.invalidState("Close()")
+	}
+	return w.impl.CloseImpl()
+}
+
+func (w *MultiDArraysWriterBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, isStream := multiDArraysStepName(w.state); name != "" {
+		expected = "Write" + name + "()"
+		if isStream {
+			expected += " or End" + name + "()"
+		}
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+type MultiDArraysReader interface {
+	// Returns false when the stream has no more items.
+	ReadImages() (yardl.NDArray[float32], bool, error)
+	// Returns false when the stream has no more items.
+	ReadFrames() ([1][1][64][32]float32, bool, error)
+	Close() error
+}
+
+// MultiDArraysReaderImpl is implemented by concrete MultiDArrays readers.
+// Its methods are called by MultiDArraysReaderBase after it has validated the order of calls.
+type MultiDArraysReaderImpl interface {
+	ReadImagesImpl() (yardl.NDArray[float32], bool, error)
+	ReadFramesImpl() ([1][1][64][32]float32, bool, error)
+	CloseImpl() error
+}
+
+// MultiDArraysReaderBase implements MultiDArraysReader, ensuring that the protocol steps are read in order.
+type MultiDArraysReaderBase struct {
+	impl  MultiDArraysReaderImpl
+	state int
+}
+
+func NewMultiDArraysReaderBase(impl MultiDArraysReaderImpl) *MultiDArraysReaderBase {
+	return &MultiDArraysReaderBase{impl: impl}
+}
+
+func (r *MultiDArraysReaderBase) ReadImages() (value yardl.NDArray[float32], ok bool, err error) {
+	if r.state != 0 {
+		return value, false, r.invalidState("ReadImages()")
+	}
+	if value, ok, err = r.impl.ReadImagesImpl(); err != nil || ok {
+		return
+	}
+	r.state = 1
+	return
+}
+
+func (r *MultiDArraysReaderBase) ReadFrames() (value [1][1][64][32]float32, ok bool, err error) {
+	if r.state != 1 {
+		return value, false, r.invalidState("ReadFrames()")
+	}
+	if value, ok, err = r.impl.ReadFramesImpl(); err != nil || ok {
+		return
+	}
+	r.state = 2
+	return
+}
+
+func (r *MultiDArraysReaderBase) Close() error {
+	if r.state != 2 {
+		return r.invalidState("Close()")
+	}
+	return r.impl.CloseImpl()
+}
+
+func (r *MultiDArraysReaderBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, _ := multiDArraysStepName(r.state); name != "" {
+		expected = "Read" + name + "()"
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+// ComplexArraysSchema is the schema written to the header of binary ComplexArrays streams.
+const ComplexArraysSchema = `{"protocol":{"name":"ComplexArrays","sequence":[{"name":"floats","type":{"array":{"items":"complexfloat32"}}},{"name":"doubles","type":{"array":{"items":"complexfloat64","dimensions":2}}}]},"types":null}`
+
+// complexArraysStepName returns the name of the step at the given state and whether it is a stream, or "" after the last step.
+func complexArraysStepName(state int) (name string, isStream bool) {
+	switch state {
+	case 0:
+		return "Floats", false
+	case 1:
+		return "Doubles", false
+	default:
+		return "", false
+	}
+}
+
+type ComplexArraysWriter interface {
+	WriteFloats(value yardl.NDArray[complex64]) error
+	WriteDoubles(value yardl.NDArray[complex128]) error
+	Close() error
+}
+
+// ComplexArraysWriterImpl is implemented by concrete ComplexArrays writers.
+// Its methods are called by ComplexArraysWriterBase after it has validated the order of calls.
+type ComplexArraysWriterImpl interface {
+	WriteFloatsImpl(value yardl.NDArray[complex64]) error
+	WriteDoublesImpl(value yardl.NDArray[complex128]) error
+	CloseImpl() error
+}
+
+// ComplexArraysWriterBase implements ComplexArraysWriter, ensuring that the protocol steps are written in order.
+type ComplexArraysWriterBase struct {
+	impl  ComplexArraysWriterImpl
+	state int
+}
+
+func NewComplexArraysWriterBase(impl ComplexArraysWriterImpl) *ComplexArraysWriterBase {
+	return &ComplexArraysWriterBase{impl: impl}
+}
+
+func (w *ComplexArraysWriterBase) WriteFloats(value yardl.NDArray[complex64]) error {
+	if w.state != 0 {
+		return w.invalidState("WriteFloats()")
+	}
+	if err := w.impl.WriteFloatsImpl(value); err != nil {
+		return err
+	}
+	w.state = 1
+	return nil
+}
+
+func (w *ComplexArraysWriterBase) WriteDoubles(value yardl.NDArray[complex128]) error {
+	if w.state != 1 {
+		return w.invalidState("WriteDoubles()")
+	}
+	if err := w.impl.WriteDoublesImpl(value); err != nil {
+		return err
+	}
+	w.state = 2
+	return nil
+}
+
+func (w *ComplexArraysWriterBase) Close() error {
+	if w.state != 2 {
+		return w.invalidState("Close()")
+	}
+	return w.impl.CloseImpl()
+}
+
+func (w *ComplexArraysWriterBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, isStream := complexArraysStepName(w.state); name != "" {
+		expected = "Write" + name + "()"
+		if isStream {
+			expected += " or End" + name + "()"
+		}
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+type ComplexArraysReader interface {
+	ReadFloats() (yardl.NDArray[complex64], error)
+	ReadDoubles() (yardl.NDArray[complex128], error)
+	Close() error
+}
+
+// ComplexArraysReaderImpl is implemented by concrete ComplexArrays readers.
+// Its methods are called by ComplexArraysReaderBase after it has validated the order of calls.
+type ComplexArraysReaderImpl interface {
+	ReadFloatsImpl() (yardl.NDArray[complex64], error)
+	ReadDoublesImpl() (yardl.NDArray[complex128], error)
+	CloseImpl() error
+}
+
+// ComplexArraysReaderBase implements ComplexArraysReader, ensuring that the protocol steps are read in order.
+type ComplexArraysReaderBase struct {
+	impl  ComplexArraysReaderImpl
+	state int
+}
+
+func NewComplexArraysReaderBase(impl ComplexArraysReaderImpl) *ComplexArraysReaderBase {
+	return &ComplexArraysReaderBase{impl: impl}
+}
+
+func (r *ComplexArraysReaderBase) ReadFloats() (value yardl.NDArray[complex64], err error) {
+	if r.state != 0 {
+		return value, r.invalidState("ReadFloats()")
+	}
+	if value, err = r.impl.ReadFloatsImpl(); err != nil {
+		return
+	}
+	r.state = 1
+	return
+}
+
+func (r *ComplexArraysReaderBase) ReadDoubles() (value yardl.NDArray[complex128], err error) {
+	if r.state != 1 {
+		return value, r.invalidState("ReadDoubles()")
+	}
+	if value, err = r.impl.ReadDoublesImpl(); err != nil {
+		return
+	}
+	r.state = 2
+	return
+}
+
+func (r *ComplexArraysReaderBase) Close() error {
+	if r.state != 2 {
+		return r.invalidState("Close()")
+	}
+	return r.impl.CloseImpl()
+}
+
+func (r *ComplexArraysReaderBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, _ := complexArraysStepName(r.state); name != "" {
+		expected = "Read" + name + "()"
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+// MapsSchema is the schema written to the header of binary Maps streams.
+const MapsSchema = `{"protocol":{"name":"Maps","sequence":[{"name":"stringToInt","type":{"map":{"keys":"string","values":"int32"}}},{"name":"intToString","type":{"map":{"keys":"int32","values":"string"}}},{"name":"stringToUnion","type":{"map":{"keys":"string","values":[{"tag":"string","type":"string"},{"tag":"int32","type":"int32"}]}}},{"name":"aliasedGeneric","type":{"name":"BasicTypes.AliasedMap","typeArguments":["string","int32"]}},{"name":"records","type":{"vector":{"items":"TestModel.RecordWithMaps"}}}]},"types":[{"name":"AliasedMap","typeParameters":["K","V"],"type":{"map":{"keys":"K","values":"V"}}},{"name":"RecordWithMaps","fields":[{"name":"set1","type":{"map":{"keys":"uint32","values":"uint32"}}},{"name":"set2","type":{"map":{"keys":"int32","values":"bool"}}},{"name":"set3","type":{"map":{"keys":"string","values":[{"tag":"string","type":"string"},{"tag":"int32","type":"int32"}]}}}]}]}`
+
+// mapsStepName returns the name of the step at the given state and whether it is a stream, or "" after the last step.
+func mapsStepName(state int) (name string, isStream bool) {
+	switch state {
+	case 0:
+		return "StringToInt", false
+	case 1:
+		return "IntToString", false
+	case 2:
+		return "StringToUnion", false
+	case 3:
+		return "AliasedGeneric", false
+	case 4:
+		return "Records", false
+	default:
+		return "", false
+	}
+}
+
+type MapsWriter interface {
+	WriteStringToInt(value map[string]int32) error
+	WriteIntToString(value map[int32]string) error
+	WriteStringToUnion(value map[string]StringOrInt32) error
+	WriteAliasedGeneric(value basictypes.AliasedMap[string, int32]) error
+	WriteRecords(value []RecordWithMaps) error
+	Close() error
+}
+
+// MapsWriterImpl is implemented by concrete Maps writers.
+// Its methods are called by MapsWriterBase after it has validated the order of calls.
+type MapsWriterImpl interface {
+	WriteStringToIntImpl(value map[string]int32) error
+	WriteIntToStringImpl(value map[int32]string) error
+	WriteStringToUnionImpl(value map[string]StringOrInt32) error
+	WriteAliasedGenericImpl(value basictypes.AliasedMap[string, int32]) error
+	WriteRecordsImpl(value []RecordWithMaps) error
+	CloseImpl() error
+}
+
+// MapsWriterBase implements MapsWriter, ensuring that the protocol steps are written in order.
+type MapsWriterBase struct {
+	impl  MapsWriterImpl
+	state int
+}
+
+func NewMapsWriterBase(impl MapsWriterImpl) *MapsWriterBase {
+	return &MapsWriterBase{impl: impl}
+}
+
+func (w *MapsWriterBase) WriteStringToInt(value map[string]int32) error {
+	if w.state != 0 {
+		return w.invalidState("WriteStringToInt()")
+	}
+	if err := w.impl.WriteStringToIntImpl(value); err != nil {
+		return err
+	}
+	w.state = 1
+	return nil
+}
+
+func (w *MapsWriterBase) WriteIntToString(value map[int32]string) error {
+	if w.state != 1 {
+		return w.invalidState("WriteIntToString()")
+	}
+	if err := w.impl.WriteIntToStringImpl(value); err != nil {
+		return err
+	}
+	w.state = 2
+	return nil
+}
+
+func (w *MapsWriterBase) WriteStringToUnion(value map[string]StringOrInt32) error {
+	if w.state != 2 {
+		return w.invalidState("WriteStringToUnion()")
+	}
+	if err := w.impl.WriteStringToUnionImpl(value); err != nil {
+		return err
+	}
+	w.state = 3
+	return nil
+}
+
+func (w *MapsWriterBase) WriteAliasedGeneric(value basictypes.AliasedMap[string, int32]) error {
+	if w.state != 3 {
+		return w.invalidState("WriteAliasedGeneric()")
+	}
+	if err := w.impl.WriteAliasedGenericImpl(value); err != nil {
+		return err
+	}
+	w.state = 4
+	return nil
+}
+
+func (w *MapsWriterBase) WriteRecords(value []RecordWithMaps) error {
+	if w.state != 4 {
+		return w.invalidState("WriteRecords()")
+	}
+	if err := w.impl.WriteRecordsImpl(value); err != nil {
+		return err
+	}
+	w.state = 5
+	return nil
+}
+
+func (w *MapsWriterBase) Close() error {
+	if w.state != 5 {
+		return w.invalidState("Close()")
+	}
+	return w.impl.CloseImpl()
+}
+
+func (w *MapsWriterBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, isStream := mapsStepName(w.state); name != "" {
+		expected = "Write" + name + "()"
+		if isStream {
+			expected += " or End" + name + "()"
+		}
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+type MapsReader interface {
+	ReadStringToInt() (map[string]int32, error)
+	ReadIntToString() (map[int32]string, error)
+	ReadStringToUnion() (map[string]StringOrInt32, error)
+	ReadAliasedGeneric() (basictypes.AliasedMap[string, int32], error)
+	ReadRecords() ([]RecordWithMaps, error)
+	Close() error
+}
+
+// MapsReaderImpl is implemented by concrete Maps readers.
+// Its methods are called by MapsReaderBase after it has validated the order of calls.
+type MapsReaderImpl interface {
+	ReadStringToIntImpl() (map[string]int32, error)
+	ReadIntToStringImpl() (map[int32]string, error)
+	ReadStringToUnionImpl() (map[string]StringOrInt32, error)
+	ReadAliasedGenericImpl() (basictypes.AliasedMap[string, int32], error)
+	ReadRecordsImpl() ([]RecordWithMaps, error)
+	CloseImpl() error
+}
+
+// MapsReaderBase implements MapsReader, ensuring that the protocol steps are read in order.
+type MapsReaderBase struct {
+	impl  MapsReaderImpl
+	state int
+}
+
+func NewMapsReaderBase(impl MapsReaderImpl) *MapsReaderBase {
+	return &MapsReaderBase{impl: impl}
+}
+
+func (r *MapsReaderBase) ReadStringToInt() (value map[string]int32, err error) {
+	if r.state != 0 {
+		return value, r.invalidState("ReadStringToInt()")
+	}
+	if value, err = r.impl.ReadStringToIntImpl(); err != nil {
+		return
+	}
+	r.state = 1
+	return
+}
+
+func (r *MapsReaderBase) ReadIntToString() (value map[int32]string, err error) {
+	if r.state != 1 {
+		return value, r.invalidState("ReadIntToString()")
+	}
+	if value, err = r.impl.ReadIntToStringImpl(); err != nil {
+		return
+	}
+	r.state = 2
+	return
+}
+
+func (r *MapsReaderBase) ReadStringToUnion() (value map[string]StringOrInt32, err error) {
+	if r.state != 2 {
+		return value, r.invalidState("ReadStringToUnion()")
+	}
+	if value, err = r.impl.ReadStringToUnionImpl(); err != nil {
+		return
+	}
+	r.state = 3
+	return
+}
+
+func (r *MapsReaderBase) ReadAliasedGeneric() (value basictypes.AliasedMap[string, int32], err error) {
+	if r.state != 3 {
+		return value, r.invalidState("ReadAliasedGeneric()")
+	}
+	if value, err = r.impl.ReadAliasedGenericImpl(); err != nil {
+		return
+	}
+	r.state = 4
+	return
+}
+
+func (r *MapsReaderBase) ReadRecords() (value []RecordWithMaps, err error) {
+	if r.state != 4 {
+		return value, r.invalidState("ReadRecords()")
+	}
+	if value, err = r.impl.ReadRecordsImpl(); err != nil {
+		return
+	}
+	r.state = 5
+	return
+}
+
+func (r *MapsReaderBase) Close() error {
+	if r.state != 5 {
+		return r.invalidState("Close()")
+	}
+	return r.impl.CloseImpl()
+}
+
+func (r *MapsReaderBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, _ := mapsStepName(r.state); name != "" {
+		expected = "Read" + name + "()"
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+// UnionsSchema is the schema written to the header of binary Unions streams.
+const UnionsSchema = `{"protocol":{"name":"Unions","sequence":[{"name":"intOrSimpleRecord","type":[{"tag":"int32","type":"int32"},{"tag":"SimpleRecord","type":"TestModel.SimpleRecord"}]},{"name":"intOrRecordWithVlens","type":[{"tag":"int32","type":"int32"},{"tag":"RecordWithVlens","type":"TestModel.RecordWithVlens"}]},{"name":"monosotateOrIntOrSimpleRecord","type":[null,{"tag":"int32","type":"int32"},{"tag":"SimpleRecord","type":"TestModel.SimpleRecord"}]},{"name":"vectorOfUnions","type":{"vector":{"items":[{"tag":"string","type":"string"},{"tag":"int32","type":"int32"}]}}},{"name":"recordWithUnions","type":"BasicTypes.RecordWithUnions"}]},"types":[{"name":"DaysOfWeek","values":[{"symbol":"monday","value":1},{"symbol":"tuesday","value":2},{"symbol":"wednesday","value":4},{"symbol":"thursday","value":8},{"symbol":"friday","value":16},{"symbol":"saturday","value":32},{"symbol":"sunday","value":64}]},{"name":"Fruits","values":[{"symbol":"apple","value":1},{"symbol":"banana","value":2},{"symbol":"pear","value":3}]},{"name":"GenericNullableUnion2","typeParameters":["T1","T2"],"type":[null,{"tag":"T1","type":"T1"},{"tag":"T2","type":"T2"}]},{"name":"RecordWithString","fields":[{"name":"i","type":"string"}]},{"name":"RecordWithUnions","fields":[{"name":"nullOrIntOrString","type":[null,{"tag":"int32","type":"int32"},{"tag":"string","type":"string"}]},{"name":"dateOrDatetime","type":[{"tag":"time","type":"time"},{"tag":"datetime","type":"datetime"}]},{"name":"nullOrFruitsOrDaysOfWeek","type":{"name":"BasicTypes.GenericNullableUnion2","typeArguments":["BasicTypes.Fruits","BasicTypes.DaysOfWeek"]}},{"name":"recordOrInt","type":[{"tag":"RecordWithString","type":"BasicTypes.RecordWithString"},{"tag":"int32","type":"int32"}]}]},{"name":"RecordWithVlens","fields":[{"name":"a","type":{"vector":{"items":"TestModel.SimpleRecord"}}},{"name":"b","type":"int32"},{"name":"c","type":"int32"}]},{"name":"SimpleRecord","fields":[{"name":"x","type":"int32"},{"name":"y","type":"int32"},{"name":"z","type":"int32"}]}]}`
+
+// unionsStepName returns the name of the step at the given state and whether it is a stream, or "" after the last step.
+func unionsStepName(state int) (name string, isStream bool) {
+	switch state {
+	case 0:
+		return "IntOrSimpleRecord", false
+	case 1:
+		return "IntOrRecordWithVlens", false
+	case 2:
+		return "MonosotateOrIntOrSimpleRecord", false
+	case 3:
+		return "VectorOfUnions", false
+	case 4:
+		return "RecordWithUnions", false
+	default:
+		return "", false
+	}
+}
+
+type UnionsWriter interface {
+	WriteIntOrSimpleRecord(value Int32OrSimpleRecord) error
+	WriteIntOrRecordWithVlens(value Int32OrRecordWithVlens) error
+	WriteMonosotateOrIntOrSimpleRecord(value Int32OrSimpleRecord) error
+	WriteVectorOfUnions(value []StringOrInt32) error
+	WriteRecordWithUnions(value basictypes.RecordWithUnions) error
+	Close() error
+}
+
+// UnionsWriterImpl is implemented by concrete Unions writers.
+// Its methods are called by UnionsWriterBase after it has validated the order of calls.
+type UnionsWriterImpl interface {
+	WriteIntOrSimpleRecordImpl(value Int32OrSimpleRecord) error
+	WriteIntOrRecordWithVlensImpl(value Int32OrRecordWithVlens) error
+	WriteMonosotateOrIntOrSimpleRecordImpl(value Int32OrSimpleRecord) error
+	WriteVectorOfUnionsImpl(value []StringOrInt32) error
+	WriteRecordWithUnionsImpl(value basictypes.RecordWithUnions) error
+	CloseImpl() error
+}
+
+// UnionsWriterBase implements UnionsWriter, ensuring that the protocol steps are written in order.
+type UnionsWriterBase struct {
+	impl  UnionsWriterImpl
+	state int
+}
+
+func NewUnionsWriterBase(impl UnionsWriterImpl) *UnionsWriterBase {
+	return &UnionsWriterBase{impl: impl}
+}
+
+func (w *UnionsWriterBase) WriteIntOrSimpleRecord(value Int32OrSimpleRecord) error {
+	if w.state != 0 {
+		return w.invalidState("WriteIntOrSimpleRecord()")
+	}
+	if err := w.impl.WriteIntOrSimpleRecordImpl(value); err != nil {
+		return err
+	}
+	w.state = 1
+	return nil
+}
+
+func (w *UnionsWriterBase) WriteIntOrRecordWithVlens(value Int32OrRecordWithVlens) error {
+	if w.state != 1 {
+		return w.invalidState("WriteIntOrRecordWithVlens()")
+	}
+	if err := w.impl.WriteIntOrRecordWithVlensImpl(value); err != nil {
+		return err
+	}
+	w.state = 2
+	return nil
+}
+
+func (w *UnionsWriterBase) WriteMonosotateOrIntOrSimpleRecord(value Int32OrSimpleRecord) error {
+	if w.state != 2 {
+		return w.invalidState("WriteMonosotateOrIntOrSimpleRecord()")
+	}
+	if err := w.impl.WriteMonosotateOrIntOrSimpleRecordImpl(value); err != nil {
+		return err
+	}
+	w.state = 3
+	return nil
+}
+
+func (w *UnionsWriterBase) WriteVectorOfUnions(value []StringOrInt32) error {
+	if w.state != 3 {
+		return w.invalidState("WriteVectorOfUnions()")
+	}
+	if err := w.impl.WriteVectorOfUnionsImpl(value); err != nil {
+		return err
+	}
+	w.state = 4
+	return nil
+}
+
+func (w *UnionsWriterBase) WriteRecordWithUnions(value basictypes.RecordWithUnions) error {
+	if w.state != 4 {
+		return w.invalidState("WriteRecordWithUnions()")
+	}
+	if err := w.impl.WriteRecordWithUnionsImpl(value); err != nil {
+		return err
+	}
+	w.state = 5
+	return nil
+}
+
+func (w *UnionsWriterBase) Close() error {
+	if w.state != 5 {
+		return w.invalidState("Close()")
+	}
+	return w.impl.CloseImpl()
+}
+
+func (w *UnionsWriterBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, isStream := unionsStepName(w.state); name != "" {
+		expected = "Write" + name + "()"
+		if isStream {
+			expected += " or End" + name + "()"
+		}
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+type UnionsReader interface {
+	ReadIntOrSimpleRecord() (Int32OrSimpleRecord, error)
+	ReadIntOrRecordWithVlens() (Int32OrRecordWithVlens, error)
+	ReadMonosotateOrIntOrSimpleRecord() (Int32OrSimpleRecord, error)
+	ReadVectorOfUnions() ([]StringOrInt32, error)
+	ReadRecordWithUnions() (basictypes.RecordWithUnions, error)
+	Close() error
+}
+
+// UnionsReaderImpl is implemented by concrete Unions readers.
+// Its methods are called by UnionsReaderBase after it has validated the order of calls.
+type UnionsReaderImpl interface {
+	ReadIntOrSimpleRecordImpl() (Int32OrSimpleRecord, error)
+	ReadIntOrRecordWithVlensImpl() (Int32OrRecordWithVlens, error)
+	ReadMonosotateOrIntOrSimpleRecordImpl() (Int32OrSimpleRecord, error)
+	ReadVectorOfUnionsImpl() ([]StringOrInt32, error)
+	ReadRecordWithUnionsImpl() (basictypes.RecordWithUnions, error)
+	CloseImpl() error
+}
+
+// UnionsReaderBase implements UnionsReader, ensuring that the protocol steps are read in order.
+type UnionsReaderBase struct {
+	impl  UnionsReaderImpl
+	state int
+}
+
+func NewUnionsReaderBase(impl UnionsReaderImpl) *UnionsReaderBase {
+	return &UnionsReaderBase{impl: impl}
+}
+
+func (r *UnionsReaderBase) ReadIntOrSimpleRecord() (value Int32OrSimpleRecord, err error) {
+	if r.state != 0 {
+		return value, r.invalidState("ReadIntOrSimpleRecord()")
+	}
+	if value, err = r.impl.ReadIntOrSimpleRecordImpl(); err != nil {
+		return
+	}
+	r.state = 1
+	return
+}
+
+func (r *UnionsReaderBase) ReadIntOrRecordWithVlens() (value Int32OrRecordWithVlens, err error) {
+	if r.state != 1 {
+		return value, r.invalidState("ReadIntOrRecordWithVlens()")
+	}
+	if value, err = r.impl.ReadIntOrRecordWithVlensImpl(); err != nil {
+		return
+	}
+	r.state = 2
+	return
+}
+
+func (r *UnionsReaderBase) ReadMonosotateOrIntOrSimpleRecord() (value Int32OrSimpleRecord, err error) {
+	if r.state != 2 {
+		return value, r.invalidState("ReadMonosotateOrIntOrSimpleRecord()")
+	}
+	if value, err = r.impl.ReadMonosotateOrIntOrSimpleRecordImpl(); err != nil {
+		return
+	}
+	r.state = 3
+	return
+}
+
+func (r *UnionsReaderBase) ReadVectorOfUnions() (value []StringOrInt32, err error) {
+	if r.state != 3 {
+		return value, r.invalidState("ReadVectorOfUnions()")
+	}
+	if value, err = r.impl.ReadVectorOfUnionsImpl(); err != nil {
+		return
+	}
+	r.state = 4
+	return
+}
+
+func (r *UnionsReaderBase) ReadRecordWithUnions() (value basictypes.RecordWithUnions, err error) {
+	if r.state != 4 {
+		return value, r.invalidState("ReadRecordWithUnions()")
+	}
+	if value, err = r.impl.ReadRecordWithUnionsImpl(); err != nil {
+		return
+	}
+	r.state = 5
+	return
+}
+
+func (r *UnionsReaderBase) Close() error {
+	if r.state != 5 {
+		return r.invalidState("Close()")
+	}
+	return r.impl.CloseImpl()
+}
+
+func (r *UnionsReaderBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, _ := unionsStepName(r.state); name != "" {
+		expected = "Read" + name + "()"
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+// StreamsOfUnionsSchema is the schema written to the header of binary StreamsOfUnions streams.
+const StreamsOfUnionsSchema = `{"protocol":{"name":"StreamsOfUnions","sequence":[{"name":"intOrSimpleRecord","type":{"stream":{"items":[{"tag":"int32","type":"int32"},{"tag":"SimpleRecord","type":"TestModel.SimpleRecord"}]}}},{"name":"nullableIntOrSimpleRecord","type":{"stream":{"items":[null,{"tag":"int32","type":"int32"},{"tag":"SimpleRecord","type":"TestModel.SimpleRecord"}]}}},{"name":"manyCases","type":{"stream":{"items":[{"tag":"int32","type":"int32"},{"tag":"float32","type":"float32"},{"tag":"string","type":"string"},{"tag":"SimpleRecord","type":"TestModel.SimpleRecord"},{"tag":"NamedFixedNDArray","type":"TestModel.NamedFixedNDArray"}]}}}]},"types":[{"name":"NamedFixedNDArray","type":{"array":{"items":"int32","dimensions":[{"name":"dimA","length":2},{"name":"dimB","length":4}]}}},{"name":"SimpleRecord","fields":[{"name":"x","type":"int32"},{"name":"y","type":"int32"},{"name":"z","type":"int32"}]}]}`
+
+// streamsOfUnionsStepName returns the name of the step at the given state and whether it is a stream, or "" after the last step.
+func streamsOfUnionsStepName(state int) (name string, isStream bool) {
+	switch state {
+	case 0:
+		return "IntOrSimpleRecord", true
+	case 1:
+		return "NullableIntOrSimpleRecord", true
+	case 2:
+		return "ManyCases", true
+	default:
+		return "", false
+	}
+}
+
+type StreamsOfUnionsWriter interface {
+	WriteIntOrSimpleRecord(values ...Int32OrSimpleRecord) error
+	EndIntOrSimpleRecord() error
+	WriteNullableIntOrSimpleRecord(values ...Int32OrSimpleRecord) error
+	EndNullableIntOrSimpleRecord() error
+	WriteManyCases(values ...Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray) error
+	EndManyCases() error
+	Close() error
+}
+
+// StreamsOfUnionsWriterImpl is implemented by concrete StreamsOfUnions writers.
+// Its methods are called by StreamsOfUnionsWriterBase after it has validated the order of calls.
+type StreamsOfUnionsWriterImpl interface {
+	WriteIntOrSimpleRecordImpl(values []Int32OrSimpleRecord) error
+	EndIntOrSimpleRecordImpl() error
+	WriteNullableIntOrSimpleRecordImpl(values []Int32OrSimpleRecord) error
+	EndNullableIntOrSimpleRecordImpl() error
+	WriteManyCasesImpl(values []Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray) error
+	EndManyCasesImpl() error
+	CloseImpl() error
+}
+
+// StreamsOfUnionsWriterBase implements StreamsOfUnionsWriter, ensuring that the protocol steps are written in order.
+type StreamsOfUnionsWriterBase struct {
+	impl  StreamsOfUnionsWriterImpl
+	state int
+}
+
+func NewStreamsOfUnionsWriterBase(impl StreamsOfUnionsWriterImpl) *StreamsOfUnionsWriterBase {
+	return &StreamsOfUnionsWriterBase{impl: impl}
+}
+
+func (w *StreamsOfUnionsWriterBase) WriteIntOrSimpleRecord(values ...Int32OrSimpleRecord) error {
+	if w.state != 0 {
+		return w.invalidState("WriteIntOrSimpleRecord()")
+	}
+	return w.impl.WriteIntOrSimpleRecordImpl(values)
+}
+
+func (w *StreamsOfUnionsWriterBase) EndIntOrSimpleRecord() error {
+	if w.state != 0 {
+		return w.invalidState("EndIntOrSimpleRecord()")
+	}
+	if err := w.impl.EndIntOrSimpleRecordImpl(); err != nil {
+		return err
+	}
+	w.state = 1
+	return nil
+}
+
+func (w *StreamsOfUnionsWriterBase) WriteNullableIntOrSimpleRecord(values ...Int32OrSimpleRecord) error {
+	if w.state != 1 {
+		return w.invalidState("WriteNullableIntOrSimpleRecord()")
+	}
+	return w.impl.WriteNullableIntOrSimpleRecordImpl(values)
+}
+
+func (w *StreamsOfUnionsWriterBase) EndNullableIntOrSimpleRecord() error {
+	if w.state != 1 {
+		return w.invalidState("EndNullableIntOrSimpleRecord()")
+	}
+	if err := w.impl.EndNullableIntOrSimpleRecordImpl(); err != nil {
+		return err
+	}
+	w.state = 2
+	return nil
+}
+
+func (w *StreamsOfUnionsWriterBase) WriteManyCases(values ...Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray) error {
+	if w.state != 2 {
+		return w.invalidState("WriteManyCases()")
+	}
+	return w.impl.WriteManyCasesImpl(values)
+}
+
+func (w *StreamsOfUnionsWriterBase) EndManyCases() error {
+	if w.state != 2 {
+		return w.invalidState("EndManyCases()")
+	}
+	if err := w.impl.EndManyCasesImpl(); err != nil {
+		return err
+	}
+	w.state = 3
+	return nil
+}
+
+func (w *StreamsOfUnionsWriterBase) Close() error {
+	if w.state != 3 {
+		return w.invalidState("Close()")
+	}
+	return w.impl.CloseImpl()
+}
+
+func (w *StreamsOfUnionsWriterBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, isStream := streamsOfUnionsStepName(w.state); name != "" {
+		expected = "Write" + name + "()"
+		if isStream {
+			expected += " or End" + name + "()"
+		}
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+type StreamsOfUnionsReader interface {
+	// Returns false when the stream has no more items.
+	ReadIntOrSimpleRecord() (Int32OrSimpleRecord, bool, error)
+	// Returns false when the stream has no more items.
+	ReadNullableIntOrSimpleRecord() (Int32OrSimpleRecord, bool, error)
+	// Returns false when the stream has no more items.
+	ReadManyCases() (Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray, bool, error)
+	Close() error
+}
+
+// StreamsOfUnionsReaderImpl is implemented by concrete StreamsOfUnions readers.
+// Its methods are called by StreamsOfUnionsReaderBase after it has validated the order of calls.
+type StreamsOfUnionsReaderImpl interface {
+	ReadIntOrSimpleRecordImpl() (Int32OrSimpleRecord, bool, error)
+	ReadNullableIntOrSimpleRecordImpl() (Int32OrSimpleRecord, bool, error)
+	ReadManyCasesImpl() (Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray, bool, error)
+	CloseImpl() error
+}
+
+// StreamsOfUnionsReaderBase implements StreamsOfUnionsReader, ensuring that the protocol steps are read in order.
+type StreamsOfUnionsReaderBase struct {
+	impl  StreamsOfUnionsReaderImpl
+	state int
+}
+
+func NewStreamsOfUnionsReaderBase(impl StreamsOfUnionsReaderImpl) *StreamsOfUnionsReaderBase {
+	return &StreamsOfUnionsReaderBase{impl: impl}
+}
+
+func (r *StreamsOfUnionsReaderBase) ReadIntOrSimpleRecord() (value Int32OrSimpleRecord, ok bool, err error) {
+	if r.state != 0 {
+		return value, false, r.invalidState("ReadIntOrSimpleRecord()")
+	}
+	if value, ok, err = r.impl.ReadIntOrSimpleRecordImpl(); err != nil || ok {
+		return
+	}
+	r.state = 1
+	return
+}
+
+func (r *StreamsOfUnionsReaderBase) ReadNullableIntOrSimpleRecord() (value Int32OrSimpleRecord, ok bool, err error) {
+	if r.state != 1 {
+		return value, false, r.invalidState("ReadNullableIntOrSimpleRecord()")
+	}
+	if value, ok, err = r.impl.ReadNullableIntOrSimpleRecordImpl(); err != nil || ok {
+		return
+	}
+	r.state = 2
+	return
+}
+
+func (r *StreamsOfUnionsReaderBase) ReadManyCases() (value Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray, ok bool, err error) {
+	if r.state != 2 {
+		return value, false, r.invalidState("ReadManyCases()")
+	}
+	if value, ok, err = r.impl.ReadManyCasesImpl(); err != nil || ok {
+		return
+	}
+	r.state = 3
+	return
+}
+
+func (r *StreamsOfUnionsReaderBase) Close() error {
+	if r.state != 3 {
+		return r.invalidState("Close()")
+	}
+	return r.impl.CloseImpl()
+}
+
+func (r *StreamsOfUnionsReaderBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, _ := streamsOfUnionsStepName(r.state); name != "" {
+		expected = "Read" + name + "()"
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+// EnumsSchema is the schema written to the header of binary Enums streams.
+const EnumsSchema = `{"protocol":{"name":"Enums","sequence":[{"name":"single","type":"TestModel.Fruits"},{"name":"vec","type":{"vector":{"items":"TestModel.Fruits"}}},{"name":"size","type":"TestModel.SizeBasedEnum"},{"name":"rec","type":"TestModel.RecordWithEnums"}]},"types":[{"name":"DaysOfWeek","values":[{"symbol":"monday","value":1},{"symbol":"tuesday","value":2},{"symbol":"wednesday","value":4},{"symbol":"thursday","value":8},{"symbol":"friday","value":16},{"symbol":"saturday","value":32},{"symbol":"sunday","value":64}]},{"name":"Fruits","values":[{"symbol":"apple","value":1},{"symbol":"banana","value":2},{"symbol":"pear","value":3}]},{"name":"TextFormat","base":"uint64","values":[{"symbol":"regular","value":0},{"symbol":"bold","value":1},{"symbol":"italic","value":2},{"symbol":"underline","value":4},{"symbol":"strikethrough","value":8}]},{"name":"DaysOfWeek","type":"BasicTypes.DaysOfWeek"},{"name":"Fruits","type":"BasicTypes.Fruits"},{"name":"RecordWithEnums","fields":[{"name":"enum","type":"TestModel.Fruits"},{"name":"flags","type":"TestModel.DaysOfWeek"},{"name":"flags2","type":"TestModel.TextFormat"},{"name":"rec","type":"TestModel.RecordWithNoDefaultEnum"}]},{"name":"RecordWithNoDefaultEnum","fields":[{"name":"enum","type":"TestModel.Fruits"}]},{"name":"SizeBasedEnum","base":"size","values":[{"symbol":"a","value":0},{"symbol":"b","value":1},{"symbol":"c","value":2}]},{"name":"TextFormat","type":"BasicTypes.TextFormat"}]}`
+
+// enumsStepName returns the name of the step at the given state and whether it is a stream, or "" after the last step.
+func enumsStepName(state int) (name string, isStream bool) {
+	switch state {
+	case 0:
+		return "Single", false
+	case 1:
+		return "Vec", false
+	case 2:
+		return "Size", false
+	case 3:
+		return "Rec", false
+	default:
+		return "", false
+	}
+}
+
+type EnumsWriter interface {
+	WriteSingle(value Fruits) error
+	WriteVec(value []Fruits) error
+	WriteSize(value SizeBasedEnum) error
+	WriteRec(value RecordWithEnums) error
+	Close() error
+}
+
+// EnumsWriterImpl is implemented by concrete Enums writers.
+// Its methods are called by EnumsWriterBase after it has validated the order of calls.
+type EnumsWriterImpl interface {
+	WriteSingleImpl(value Fruits) error
+	WriteVecImpl(value []Fruits) error
+	WriteSizeImpl(value SizeBasedEnum) error
+	WriteRecImpl(value RecordWithEnums) error
+	CloseImpl() error
+}
+
+// EnumsWriterBase implements EnumsWriter, ensuring that the protocol steps are written in order.
+type EnumsWriterBase struct {
+	impl  EnumsWriterImpl
+	state int
+}
+
+func NewEnumsWriterBase(impl EnumsWriterImpl) *EnumsWriterBase {
+	return &EnumsWriterBase{impl: impl}
+}
+
+func (w *EnumsWriterBase) WriteSingle(value Fruits) error {
+	if w.state != 0 {
+		return w.invalidState("WriteSingle()")
+	}
+	if err := w.impl.WriteSingleImpl(value); err != nil {
+		return err
+	}
+	w.state = 1
+	return nil
+}
+
+func (w *EnumsWriterBase) WriteVec(value []Fruits) error {
+	if w.state != 1 {
+		return w.invalidState("WriteVec()")
+	}
+	if err := w.impl.WriteVecImpl(value); err != nil {
+		return err
+	}
+	w.state = 2
+	return nil
+}
+
+func (w *EnumsWriterBase) WriteSize(value SizeBasedEnum) error {
+	if w.state != 2 {
+		return w.invalidState("WriteSize()")
+	}
+	if err := w.impl.WriteSizeImpl(value); err != nil {
+		return err
+	}
+	w.state = 3
+	return nil
+}
+
+func (w *EnumsWriterBase) WriteRec(value RecordWithEnums) error {
+	if w.state != 3 {
+		return w.invalidState("WriteRec()")
+	}
+	if err := w.impl.WriteRecImpl(value); err != nil {
+		return err
+	}
+	w.state = 4
+	return nil
+}
+
+func (w *EnumsWriterBase) Close() error {
+	if w.state != 4 {
+		return w.invalidState("Close()")
+	}
+	return w.impl.CloseImpl()
+}
+
+func (w *EnumsWriterBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, isStream := enumsStepName(w.state); name != "" {
+		expected = "Write" + name + "()"
+		if isStream {
+			expected += " or End" + name + "()"
+		}
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+type EnumsReader interface {
+	ReadSingle() (Fruits, error)
+	ReadVec() ([]Fruits, error)
+	ReadSize() (SizeBasedEnum, error)
+	ReadRec() (RecordWithEnums, error)
+	Close() error
+}
+
+// EnumsReaderImpl is implemented by concrete Enums readers.
+// Its methods are called by EnumsReaderBase after it has validated the order of calls.
+type EnumsReaderImpl interface {
+	ReadSingleImpl() (Fruits, error)
+	ReadVecImpl() ([]Fruits, error)
+	ReadSizeImpl() (SizeBasedEnum, error)
+	ReadRecImpl() (RecordWithEnums, error)
+	CloseImpl() error
+}
+
+// EnumsReaderBase implements EnumsReader, ensuring that the protocol steps are read in order.
+type EnumsReaderBase struct {
+	impl  EnumsReaderImpl
+	state int
+}
+
+func NewEnumsReaderBase(impl EnumsReaderImpl) *EnumsReaderBase {
+	return &EnumsReaderBase{impl: impl}
+}
+
+func (r *EnumsReaderBase) ReadSingle() (value Fruits, err error) {
+	if r.state != 0 {
+		return value, r.invalidState("ReadSingle()")
+	}
+	if value, err = r.impl.ReadSingleImpl(); err != nil {
+		return
+	}
+	r.state = 1
+	return
+}
+
+func (r *EnumsReaderBase) ReadVec() (value []Fruits, err error) {
+	if r.state != 1 {
+		return value, r.invalidState("ReadVec()")
+	}
+	if value, err = r.impl.ReadVecImpl(); err != nil {
+		return
+	}
+	r.state = 2
+	return
+}
+
+func (r *EnumsReaderBase) ReadSize() (value SizeBasedEnum, err error) {
+	if r.state != 2 {
+		return value, r.invalidState("ReadSize()")
+	}
+	if value, err = r.impl.ReadSizeImpl(); err != nil {
+		return
+	}
+	r.state = 3
+	return
+}
+
+func (r *EnumsReaderBase) ReadRec() (value RecordWithEnums, err error) {
+	if r.state != 3 {
+		return value, r.invalidState("ReadRec()")
+	}
+	if value, err = r.impl.ReadRecImpl(); err != nil {
+		return
+	}
+	r.state = 4
+	return
+}
+
+func (r *EnumsReaderBase) Close() error {
+	if r.state != 4 {
+		return r.invalidState("Close()")
+	}
+	return r.impl.CloseImpl()
+}
+
+func (r *EnumsReaderBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, _ := enumsStepName(r.state); name != "" {
+		expected = "Read" + name + "()"
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+// FlagsSchema is the schema written to the header of binary Flags streams.
+const FlagsSchema = `{"protocol":{"name":"Flags","sequence":[{"name":"days","type":{"stream":{"items":"TestModel.DaysOfWeek"}}},{"name":"formats","type":{"stream":{"items":"TestModel.TextFormat"}}}]},"types":[{"name":"DaysOfWeek","values":[{"symbol":"monday","value":1},{"symbol":"tuesday","value":2},{"symbol":"wednesday","value":4},{"symbol":"thursday","value":8},{"symbol":"friday","value":16},{"symbol":"saturday","value":32},{"symbol":"sunday","value":64}]},{"name":"TextFormat","base":"uint64","values":[{"symbol":"regular","value":0},{"symbol":"bold","value":1},{"symbol":"italic","value":2},{"symbol":"underline","value":4},{"symbol":"strikethrough","value":8}]},{"name":"DaysOfWeek","type":"BasicTypes.DaysOfWeek"},{"name":"TextFormat","type":"BasicTypes.TextFormat"}]}`
+
+// flagsStepName returns the name of the step at the given state and whether it is a stream, or "" after the last step.
+func flagsStepName(state int) (name string, isStream bool) {
+	switch state {
+	case 0:
+		return "Days", true
+	case 1:
+		return "Formats", true
+	default:
+		return "", false
+	}
+}
+
+type FlagsWriter interface {
+	WriteDays(values ...DaysOfWeek) error
+	EndDays() error
+	WriteFormats(values ...TextFormat) error
+	EndFormats() error
+	Close() error
+}
+
+// FlagsWriterImpl is implemented by concrete Flags writers.
+// Its methods are called by FlagsWriterBase after it has validated the order of calls.
+type FlagsWriterImpl interface {
+	WriteDaysImpl(values []DaysOfWeek) error
+	EndDaysImpl() error
+	WriteFormatsImpl(values []TextFormat) error
+	EndFormatsImpl() error
+	CloseImpl() error
+}
+
+// FlagsWriterBase implements FlagsWriter, ensuring that the protocol steps are written in order.
+type FlagsWriterBase struct {
+	impl  FlagsWriterImpl
+	state int
+}
+
+func NewFlagsWriterBase(impl FlagsWriterImpl) *FlagsWriterBase {
+	return &FlagsWriterBase{impl: impl}
+}
+
+func (w *FlagsWriterBase) WriteDays(values ...DaysOfWeek) error {
+	if w.state != 0 {
+		return w.invalidState("WriteDays()")
+	}
+	return w.impl.WriteDaysImpl(values)
+}
+
+func (w *FlagsWriterBase) EndDays() error {
+	if w.state != 0 {
+		return w.invalidState("EndDays()")
+	}
+	if err := w.impl.EndDaysImpl(); err != nil {
+		return err
+	}
+	w.state = 1
+	return nil
+}
+
+func (w *FlagsWriterBase) WriteFormats(values ...TextFormat) error {
+	if w.state != 1 {
+		return w.invalidState("WriteFormats()")
+	}
+	return w.impl.WriteFormatsImpl(values)
+}
+
+func (w *FlagsWriterBase) EndFormats() error {
+	if w.state != 1 {
+		return w.invalidState("EndFormats()")
+	}
+	if err := w.impl.EndFormatsImpl(); err != nil {
+		return err
+	}
+	w.state = 2
+	return nil
+}
+
+func (w *FlagsWriterBase) Close() error {
+	if w.state != 2 {
+		return w.invalidState("Close()")
+	}
+	return w.impl.CloseImpl()
+}
+
+func (w *FlagsWriterBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, isStream := flagsStepName(w.state); name != "" {
+		expected = "Write" + name + "()"
+		if isStream {
+			expected += " or End" + name + "()"
+		}
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+type FlagsReader interface {
+	// Returns false when the stream has no more items.
+	ReadDays() (DaysOfWeek, bool, error)
+	// Returns false when the stream has no more items.
+	ReadFormats() (TextFormat, bool, error)
+	Close() error
+}
+
+// FlagsReaderImpl is implemented by concrete Flags readers.
+// Its methods are called by FlagsReaderBase after it has validated the order of calls.
+type FlagsReaderImpl interface {
+	ReadDaysImpl() (DaysOfWeek, bool, error)
+	ReadFormatsImpl() (TextFormat, bool, error)
+	CloseImpl() error
+}
+
+// FlagsReaderBase implements FlagsReader, ensuring that the protocol steps are read in order.
+type FlagsReaderBase struct {
+	impl  FlagsReaderImpl
+	state int
+}
+
+func NewFlagsReaderBase(impl FlagsReaderImpl) *FlagsReaderBase {
+	return &FlagsReaderBase{impl: impl}
+}
+
+func (r *FlagsReaderBase) ReadDays() (value DaysOfWeek, ok bool, err error) {
+	if r.state != 0 {
+		return value, false, r.invalidState("ReadDays()")
+	}
+	if value, ok, err = r.impl.ReadDaysImpl(); err != nil || ok {
+		return
+	}
+	r.state = 1
+	return
+}
+
+func (r *FlagsReaderBase) ReadFormats() (value TextFormat, ok bool, err error) {
+	if r.state != 1 {
+		return value, false, r.invalidState("ReadFormats()")
+	}
+	if value, ok, err = r.impl.ReadFormatsImpl(); err != nil || ok {
+		return
+	}
+	r.state = 2
+	return
+}
+
+func (r *FlagsReaderBase) Close() error {
+	if r.state != 2 {
+		return r.invalidState("Close()")
+	}
+	return r.impl.CloseImpl()
+}
+
+func (r *FlagsReaderBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, _ := flagsStepName(r.state); name != "" {
+		expected = "Read" + name + "()"
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+// StateTestSchema is the schema written to the header of binary StateTest streams.
+const StateTestSchema = `{"protocol":{"name":"StateTest","sequence":[{"name":"anInt","type":"int32"},{"name":"aStream","type":{"stream":{"items":"int32"}}},{"name":"anotherInt","type":"int32"}]},"types":null}`
+
+// stateTestStepName returns the name of the step at the given state and whether it is a stream, or "" after the last step.
+func stateTestStepName(state int) (name string, isStream bool) {
+	switch state {
+	case 0:
+		return "AnInt", false
+	case 1:
+		return "AStream", true
+	case 2:
+		return "AnotherInt", false
+	default:
+		return "", false
+	}
+}
+
+type StateTestWriter interface {
+	WriteAnInt(value int32) error
+	WriteAStream(values ...int32) error
+	EndAStream() error
+	WriteAnotherInt(value int32) error
+	Close() error
+}
+
+// StateTestWriterImpl is implemented by concrete StateTest writers.
+// Its methods are called by StateTestWriterBase after it has validated the order of calls.
+type StateTestWriterImpl interface {
+	WriteAnIntImpl(value int32) error
+	WriteAStreamImpl(values []int32) error
+	EndAStreamImpl() error
+	WriteAnotherIntImpl(value int32) error
+	CloseImpl() error
+}
+
+// StateTestWriterBase implements StateTestWriter, ensuring that the protocol steps are written in order.
+type StateTestWriterBase struct {
+	impl  StateTestWriterImpl
+	state int
+}
+
+func NewStateTestWriterBase(impl StateTestWriterImpl) *StateTestWriterBase {
+	return &StateTestWriterBase{impl: impl}
+}
+
+func (w *StateTestWriterBase) WriteAnInt(value int32) error {
+	if w.state != 0 {
+		return w.invalidState("WriteAnInt()")
+	}
+	if err := w.impl.WriteAnIntImpl(value); err != nil {
+		return err
+	}
+	w.state = 1
+	return nil
+}
+
+func (w *StateTestWriterBase) WriteAStream(values ...int32) error {
+	if w.state != 1 {
+		return w.invalidState("WriteAStream()")
+	}
+	return w.impl.WriteAStreamImpl(values)
+}
+
+func (w *StateTestWriterBase) EndAStream() error {
+	if w.state != 1 {
+		return w.invalidState("EndAStream()")
+	}
+	if err := w.impl.EndAStreamImpl(); err != nil {
+		return err
+	}
+	w.state = 2
+	return nil
+}
+
+func (w *StateTestWriterBase) WriteAnotherInt(value int32) error {
+	if w.state != 2 {
+		return w.invalidState("WriteAnotherInt()")
+	}
+	if err := w.impl.WriteAnotherIntImpl(value); err != nil {
+		return err
+	}
+	w.state = 3
+	return nil
+}
+
+func (w *StateTestWriterBase) Close() error {
+	if w.state != 3 {
+		return w.invalidState("Close()")
+	}
+	return w.impl.CloseImpl()
+}
+
+func (w *StateTestWriterBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, isStream := stateTestStepName(w.state); name != "" {
+		expected = "Write" + name + "()"
+		if isStream {
+			expected += " or End" + name + "()"
+		}
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+type StateTestReader interface {
+	ReadAnInt() (int32, error)
+	// Returns false when the stream has no more items.
+	ReadAStream() (int32, bool, error)
+	ReadAnotherInt() (int32, error)
+	Close() error
+}
+
+// StateTestReaderImpl is implemented by concrete StateTest readers.
+// Its methods are called by StateTestReaderBase after it has validated the order of calls.
+type StateTestReaderImpl interface {
+	ReadAnIntImpl() (int32, error)
+	ReadAStreamImpl() (int32, bool, error)
+	ReadAnotherIntImpl() (int32, error)
+	CloseImpl() error
+}
+
+// StateTestReaderBase implements StateTestReader, ensuring that the protocol steps are read in order.
+type StateTestReaderBase struct {
+	impl  StateTestReaderImpl
+	state int
+}
+
+func NewStateTestReaderBase(impl StateTestReaderImpl) *StateTestReaderBase {
+	return &StateTestReaderBase{impl: impl}
+}
+
+func (r *StateTestReaderBase) ReadAnInt() (value int32, err error) {
+	if r.state != 0 {
+		return value, r.invalidState("ReadAnInt()")
+	}
+	if value, err = r.impl.ReadAnIntImpl(); err != nil {
+		return
+	}
+	r.state = 1
+	return
+}
+
+func (r *StateTestReaderBase) ReadAStream() (value int32, ok bool, err error) {
+	if r.state != 1 {
+		return value, false, r.invalidState("ReadAStream()")
+	}
+	if value, ok, err = r.impl.ReadAStreamImpl(); err != nil || ok {
+		return
+	}
+	r.state = 2
+	return
+}
+
+func (r *StateTestReaderBase) ReadAnotherInt() (value int32, err error) {
+	if r.state != 2 {
+		return value, r.invalidState("ReadAnotherInt()")
+	}
+	if value, err = r.impl.ReadAnotherIntImpl(); err != nil {
+		return
+	}
+	r.state = 3
+	return
+}
+
+func (r *StateTestReaderBase) Close() error {
+	if r.state != 3 {
+		return r.invalidState("Close()")
+	}
+	return r.impl.CloseImpl()
+}
+
+func (r *StateTestReaderBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, _ := stateTestStepName(r.state); name != "" {
+		expected = "Read" + name + "()"
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+// SimpleGenericsSchema is the schema written to the header of binary SimpleGenerics streams.
+const SimpleGenericsSchema = `{"protocol":{"name":"SimpleGenerics","sequence":[{"name":"floatImage","type":"Image.FloatImage"},{"name":"intImage","type":"Image.IntImage"},{"name":"intImageAlternateSyntax","type":{"name":"TestModel.Image","typeArguments":["int32"]}},{"name":"stringImage","type":{"name":"TestModel.Image","typeArguments":["string"]}},{"name":"intFloatTuple","type":{"name":"Tuples.Tuple","typeArguments":["int32","float32"]}},{"name":"floatFloatTuple","type":{"name":"Tuples.Tuple","typeArguments":["float32","float32"]}},{"name":"intFloatTupleAlternateSyntax","type":{"name":"Tuples.Tuple","typeArguments":["int32","float32"]}},{"name":"intStringTuple","type":{"name":"Tuples.Tuple","typeArguments":["int32","string"]}},{"name":"streamOfTypeVariants","type":{"stream":{"items":[{"tag":"imageFloat","explicitTag":true,"type":"Image.FloatImage"},{"tag":"imageDouble","explicitTag":true,"type":{"name":"TestModel.Image","typeArguments":["float64"]}}]}}}]},"types":[{"name":"FloatImage","type":{"name":"Image.Image","typeArguments":["float32"]}},{"name":"Image","typeParameters":["T"],"type":{"array":{"items":"T","dimensions":[{"name":"x"},{"name":"y"}]}}},{"name":"IntImage","type":{"name":"Image.Image","typeArguments":["int32"]}},{"name":"Image","typeParameters":["T"],"type":{"name":"Image.Image","typeArguments":["T"]}},{"name":"Tuple","typeParameters":["T1","T2"],"fields":[{"name":"v1","type":"T1"},{"name":"v2","type":"T2"}]}]}`
+
+// simpleGenericsStepName returns the name of the step at the given state and whether it is a stream, or "" after the last step.
+func simpleGenericsStepName(state int) (name string, isStream bool) {
+	switch state {
+	case 0:
+		return "FloatImage", false
+	case 1:
+		return "IntImage", false
+	case 2:
+		return "IntImageAlternateSyntax", false
+	case 3:
+		return "StringImage", false
+	case 4:
+		return "IntFloatTuple", false
+	case 5:
+		return "FloatFloatTuple", false
+	case 6:
+		return "IntFloatTupleAlternateSyntax", false
+	case 7:
+		return "IntStringTuple", false
+	case 8:
+		return "StreamOfTypeVariants", true
+	default:
+		return "", false
+	}
+}
+
+type SimpleGenericsWriter interface {
+	WriteFloatImage(value image.FloatImage) error
+	WriteIntImage(value image.IntImage) error
+	WriteIntImageAlternateSyntax(value Image[int32]) error
+	WriteStringImage(value Image[string]) error
+	WriteIntFloatTuple(value tuples.Tuple[int32, float32]) error
+	WriteFloatFloatTuple(value tuples.Tuple[float32, float32]) error
+	WriteIntFloatTupleAlternateSyntax(value tuples.Tuple[int32, float32]) error
+	WriteIntStringTuple(value tuples.Tuple[int32, string]) error
+	WriteStreamOfTypeVariants(values ...ImageFloatOrImageDouble) error
+	EndStreamOfTypeVariants() error
+	Close() error
+}
+
+// SimpleGenericsWriterImpl is implemented by concrete SimpleGenerics writers.
+// Its methods are called by SimpleGenericsWriterBase after it has validated the order of calls.
+type SimpleGenericsWriterImpl interface {
+	WriteFloatImageImpl(value image.FloatImage) error
+	WriteIntImageImpl(value image.IntImage) error
+	WriteIntImageAlternateSyntaxImpl(value Image[int32]) error
+	WriteStringImageImpl(value Image[string]) error
+	WriteIntFloatTupleImpl(value tuples.Tuple[int32, float32]) error
+	WriteFloatFloatTupleImpl(value tuples.Tuple[float32, float32]) error
+	WriteIntFloatTupleAlternateSyntaxImpl(value tuples.Tuple[int32, float32]) error
+	WriteIntStringTupleImpl(value tuples.Tuple[int32, string]) error
+	WriteStreamOfTypeVariantsImpl(values []ImageFloatOrImageDouble) error
+	EndStreamOfTypeVariantsImpl() error
+	CloseImpl() error
+}
+
+// SimpleGenericsWriterBase implements SimpleGenericsWriter, ensuring that the protocol steps are written in order.
+type SimpleGenericsWriterBase struct {
+	impl  SimpleGenericsWriterImpl
+	state int
+}
+
+func NewSimpleGenericsWriterBase(impl SimpleGenericsWriterImpl) *SimpleGenericsWriterBase {
+	return &SimpleGenericsWriterBase{impl: impl}
+}
+
+func (w *SimpleGenericsWriterBase) WriteFloatImage(value image.FloatImage) error {
+	if w.state != 0 {
+		return w.invalidState("WriteFloatImage()")
+	}
+	if err := w.impl.WriteFloatImageImpl(value); err != nil {
+		return err
+	}
+	w.state = 1
+	return nil
+}
+
+func (w *SimpleGenericsWriterBase) WriteIntImage(value image.IntImage) error {
+	if w.state != 1 {
+		return w.invalidState("WriteIntImage()")
+	}
+	if err := w.impl.WriteIntImageImpl(value); err != nil {
+		return err
+	}
+	w.state = 2
+	return nil
+}
+
+func (w *SimpleGenericsWriterBase) WriteIntImageAlternateSyntax(value Image[int32]) error {
+	if w.state != 2 {
+		return w.invalidState("WriteIntImageAlternateSyntax()")
+	}
+	if err := w.impl.WriteIntImageAlternateSyntaxImpl(value); err != nil {
+		return err
+	}
+	w.state = 3
+	return nil
+}
+
+func (w *SimpleGenericsWriterBase) WriteStringImage(value Image[string]) error {
+	if w.state != 3 {
+		return w.invalidState("WriteStringImage()")
+	}
+	if err := w.impl.WriteStringImageImpl(value); err != nil {
+		return err
+	}
+	w.state = 4
+	return nil
+}
+
+func (w *SimpleGenericsWriterBase) WriteIntFloatTuple(value tuples.Tuple[int32, float32]) error {
+	if w.state != 4 {
+		return w.invalidState("WriteIntFloatTuple()")
+	}
+	if err := w.impl.WriteIntFloatTupleImpl(value); err != nil {
+		return err
+	}
+	w.state = 5
+	return nil
+}
+
+func (w *SimpleGenericsWriterBase) WriteFloatFloatTuple(value tuples.Tuple[float32, float32]) error {
+	if w.state != 5 {
+		return w.invalidState("WriteFloatFloatTuple()")
+	}
+	if err := w.impl.WriteFloatFloatTupleImpl(value); err != nil {
+		return err
+	}
+	w.state = 6
+	return nil
+}
+
+func (w *SimpleGenericsWriterBase) WriteIntFloatTupleAlternateSyntax(value tuples.Tuple[int32, float32]) error {
+	if w.state != 6 {
+		return w.invalidState("WriteIntFloatTupleAlternateSyntax()")
+	}
+	if err := w.impl.WriteIntFloatTupleAlternateSyntaxImpl(value); err != nil {
+		return err
+	}
+	w.state = 7
+	return nil
+}
+
+func (w *SimpleGenericsWriterBase) WriteIntStringTuple(value tuples.Tuple[int32, string]) error {
+	if w.state != 7 {
+		return w.invalidState("WriteIntStringTuple()")
+	}
+	if err := w.impl.WriteIntStringTupleImpl(value); err != nil {
+		return err
+	}
+	w.state = 8
+	return nil
+}
+
+func (w *SimpleGenericsWriterBase) WriteStreamOfTypeVariants(values ...ImageFloatOrImageDouble) error {
+	if w.state != 8 {
+		return w.invalidState("WriteStreamOfTypeVariants()")
+	}
+	return w.impl.WriteStreamOfTypeVariantsImpl(values)
+}
+
+func (w *SimpleGenericsWriterBase) EndStreamOfTypeVariants() error {
+	if w.state != 8 {
+		return w.invalidState("EndStreamOfTypeVariants()")
+	}
+	if err := w.impl.EndStreamOfTypeVariantsImpl(); err != nil {
+		return err
+	}
+	w.state = 9
+	return nil
+}
+
+func (w *SimpleGenericsWriterBase) Close() error {
+	if w.state != 9 {
+		return w.invalidState("Close()")
+	}
+	return w.impl.CloseImpl()
+}
+
+func (w *SimpleGenericsWriterBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, isStream := simpleGenericsStepName(w.state); name != "" {
+		expected = "Write" + name + "()"
+		if isStream {
+			expected += " or End" + name + "()"
+		}
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+type SimpleGenericsReader interface {
+	ReadFloatImage() (image.FloatImage, error)
+	ReadIntImage() (image.IntImage, error)
+	ReadIntImageAlternateSyntax() (Image[int32], error)
+	ReadStringImage() (Image[string], error)
+	ReadIntFloatTuple() (tuples.Tuple[int32, float32], error)
+	ReadFloatFloatTuple() (tuples.Tuple[float32, float32], error)
+	ReadIntFloatTupleAlternateSyntax() (tuples.Tuple[int32, float32], error)
+	ReadIntStringTuple() (tuples.Tuple[int32, string], error)
+	// Returns false when the stream has no more items.
+	ReadStreamOfTypeVariants() (ImageFloatOrImageDouble, bool, error)
+	Close() error
+}
+
+// SimpleGenericsReaderImpl is implemented by concrete SimpleGenerics readers.
+// Its methods are called by SimpleGenericsReaderBase after it has validated the order of calls.
+type SimpleGenericsReaderImpl interface {
+	ReadFloatImageImpl() (image.FloatImage, error)
+	ReadIntImageImpl() (image.IntImage, error)
+	ReadIntImageAlternateSyntaxImpl() (Image[int32], error)
+	ReadStringImageImpl() (Image[string], error)
+	ReadIntFloatTupleImpl() (tuples.Tuple[int32, float32], error)
+	ReadFloatFloatTupleImpl() (tuples.Tuple[float32, float32], error)
+	ReadIntFloatTupleAlternateSyntaxImpl() (tuples.Tuple[int32, float32], error)
+	ReadIntStringTupleImpl() (tuples.Tuple[int32, string], error)
+	ReadStreamOfTypeVariantsImpl() (ImageFloatOrImageDouble, bool, error)
+	CloseImpl() error
+}
+
+// SimpleGenericsReaderBase implements SimpleGenericsReader, ensuring that the protocol steps are read in order.
+type SimpleGenericsReaderBase struct {
+	impl  SimpleGenericsReaderImpl
+	state int
+}
+
+func NewSimpleGenericsReaderBase(impl SimpleGenericsReaderImpl) *SimpleGenericsReaderBase {
+	return &SimpleGenericsReaderBase{impl: impl}
+}
+
+func (r *SimpleGenericsReaderBase) ReadFloatImage() (value image.FloatImage, err error) {
+	if r.state != 0 {
+		return value, r.invalidState("ReadFloatImage()")
+	}
+	if value, err = r.impl.ReadFloatImageImpl(); err != nil {
+		return
+	}
+	r.state = 1
+	return
+}
+
+func (r *SimpleGenericsReaderBase) ReadIntImage() (value image.IntImage, err error) {
+	if r.state != 1 {
+		return value, r.invalidState("ReadIntImage()")
+	}
+	if value, err = r.impl.ReadIntImageImpl(); err != nil {
+		return
+	}
+	r.state = 2
+	return
+}
+
+func (r *SimpleGenericsReaderBase) ReadIntImageAlternateSyntax() (value Image[int32], err error) {
+	if r.state != 2 {
+		return value, r.invalidState("ReadIntImageAlternateSyntax()")
+	}
+	if value, err = r.impl.ReadIntImageAlternateSyntaxImpl(); err != nil {
+		return
+	}
+	r.state = 3
+	return
+}
+
+func (r *SimpleGenericsReaderBase) ReadStringImage() (value Image[string], err error) {
+	if r.state != 3 {
+		return value, r.invalidState("ReadStringImage()")
+	}
+	if value, err = r.impl.ReadStringImageImpl(); err != nil {
+		return
+	}
+	r.state = 4
+	return
+}
+
+func (r *SimpleGenericsReaderBase) ReadIntFloatTuple() (value tuples.Tuple[int32, float32], err error) {
+	if r.state != 4 {
+		return value, r.invalidState("ReadIntFloatTuple()")
+	}
+	if value, err = r.impl.ReadIntFloatTupleImpl(); err != nil {
+		return
+	}
+	r.state = 5
+	return
+}
+
+func (r *SimpleGenericsReaderBase) ReadFloatFloatTuple() (value tuples.Tuple[float32, float32], err error) {
+	if r.state != 5 {
+		return value, r.invalidState("ReadFloatFloatTuple()")
+	}
+	if value, err = r.impl.ReadFloatFloatTupleImpl(); err != nil {
+		return
+	}
+	r.state = 6
+	return
+}
+
+func (r *SimpleGenericsReaderBase) ReadIntFloatTupleAlternateSyntax() (value tuples.Tuple[int32, float32], err error) {
+	if r.state != 6 {
+		return value, r.invalidState("ReadIntFloatTupleAlternateSyntax()")
+	}
+	if value, err = r.impl.ReadIntFloatTupleAlternateSyntaxImpl(); err != nil {
+		return
+	}
+	r.state = 7
+	return
+}
+
+func (r *SimpleGenericsReaderBase) ReadIntStringTuple() (value tuples.Tuple[int32, string], err error) {
+	if r.state != 7 {
+		return value, r.invalidState("ReadIntStringTuple()")
+	}
+	if value, err = r.impl.ReadIntStringTupleImpl(); err != nil {
+		return
+	}
+	r.state = 8
+	return
+}
+
+func (r *SimpleGenericsReaderBase) ReadStreamOfTypeVariants() (value ImageFloatOrImageDouble, ok bool, err error) {
+	if r.state != 8 {
+		return value, false, r.invalidState("ReadStreamOfTypeVariants()")
+	}
+	if value, ok, err = r.impl.ReadStreamOfTypeVariantsImpl(); err != nil || ok {
+		return
+	}
+	r.state = 9
+	return
+}
+
+func (r *SimpleGenericsReaderBase) Close() error {
+	if r.state != 9 {
+		return r.invalidState("Close()")
+	}
+	return r.impl.CloseImpl()
+}
+
+func (r *SimpleGenericsReaderBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, _ := simpleGenericsStepName(r.state); name != "" {
+		expected = "Read" + name + "()"
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+// AdvancedGenericsSchema is the schema written to the header of binary AdvancedGenerics streams.
+const AdvancedGenericsSchema = `{"protocol":{"name":"AdvancedGenerics","sequence":[{"name":"floatImageImage","type":{"name":"TestModel.Image","typeArguments":[{"name":"TestModel.Image","typeArguments":["float32"]}]}},{"name":"genericRecord1","type":{"name":"TestModel.GenericRecord","typeArguments":["int32","string"]}},{"name":"tupleOfOptionals","type":{"name":"TestModel.MyTuple","typeArguments":[[null,"int32"],[null,"string"]]}},{"name":"tupleOfOptionalsAlternateSyntax","type":{"name":"TestModel.MyTuple","typeArguments":[[null,"int32"],[null,"string"]]}},{"name":"tupleOfVectors","type":{"name":"TestModel.MyTuple","typeArguments":[{"vector":{"items":"int32"}},{"vector":{"items":"float32"}}]}}]},"types":[{"name":"MyTuple","typeParameters":["T1","T2"],"type":{"name":"Tuples.Tuple","typeArguments":["T1","T2"]}},{"name":"Image","typeParameters":["T"],"type":{"array":{"items":"T","dimensions":[{"name":"x"},{"name":"y"}]}}},{"name":"GenericRecord","typeParameters":["T1","T2"],"fields":[{"name":"scalar1","type":"T1"},{"name":"scalar2","type":"T2"},{"name":"vector1","type":{"vector":{"items":"T1"}}},{"name":"image2","type":{"name":"TestModel.Image","typeArguments":["T2"]}}]},{"name":"Image","typeParameters":["T"],"type":{"name":"Image.Image","typeArguments":["T"]}},{"name":"MyTuple","typeParameters":["T1","T2"],"type":{"name":"BasicTypes.MyTuple","typeArguments":["T1","T2"]}},{"name":"Tuple","typeParameters":["T1","T2"],"fields":[{"name":"v1","type":"T1"},{"name":"v2","type":"T2"}]}]}`
+
+// advancedGenericsStepName returns the name of the step at the given state and whether it is a stream, or "" after the last step.
+func advancedGenericsStepName(state int) (name string, isStream bool) {
+	switch state {
+	case 0:
+		return "FloatImageImage", false
+	case 1:
+		return "GenericRecord1", false
+	case 2:
+		return "TupleOfOptionals", false
+	case 3:
+		return "TupleOfOptionalsAlternateSyntax", false
+	case 4:
+		return "TupleOfVectors", false
+	default:
+		return "", false
+	}
+}
+
+type AdvancedGenericsWriter interface {
+	WriteFloatImageImage(value Image[Image[float32]]) error
+	WriteGenericRecord1(value GenericRecord[int32, string]) error
+	WriteTupleOfOptionals(value MyTuple[*int32, *string]) error
+	WriteTupleOfOptionalsAlternateSyntax(value MyTuple[*int32, *string]) error
+	WriteTupleOfVectors(value MyTuple[[]int32, []float32]) error
+	Close() error
+}
+
+// AdvancedGenericsWriterImpl is implemented by concrete AdvancedGenerics writers.
+// Its methods are called by AdvancedGenericsWriterBase after it has validated the order of calls.
+type AdvancedGenericsWriterImpl interface {
+	WriteFloatImageImageImpl(value Image[Image[float32]]) error
+	WriteGenericRecord1Impl(value GenericRecord[int32, string]) error
+	WriteTupleOfOptionalsImpl(value MyTuple[*int32, *string]) error
+	WriteTupleOfOptionalsAlternateSyntaxImpl(value MyTuple[*int32, *string]) error
+	WriteTupleOfVectorsImpl(value MyTuple[[]int32, []float32]) error
+	CloseImpl() error
+}
+
+// AdvancedGenericsWriterBase implements AdvancedGenericsWriter, ensuring that the protocol steps are written in order.
+type AdvancedGenericsWriterBase struct {
+	impl  AdvancedGenericsWriterImpl
+	state int
+}
+
+func NewAdvancedGenericsWriterBase(impl AdvancedGenericsWriterImpl) *AdvancedGenericsWriterBase {
+	return &AdvancedGenericsWriterBase{impl: impl}
+}
+
+func (w *AdvancedGenericsWriterBase) WriteFloatImageImage(value Image[Image[float32]]) error {
+	if w.state != 0 {
+		return w.invalidState("WriteFloatImageImage()")
+	}
+	if err := w.impl.WriteFloatImageImageImpl(value); err != nil {
+		return err
+	}
+	w.state = 1
+	return nil
+}
+
+func (w *AdvancedGenericsWriterBase) WriteGenericRecord1(value GenericRecord[int32, string]) error {
+	if w.state != 1 {
+		return w.invalidState("WriteGenericRecord1()")
+	}
+	if err := w.impl.WriteGenericRecord1Impl(value); err != nil {
+		return err
+	}
+	w.state = 2
+	return nil
+}
+
+func (w *AdvancedGenericsWriterBase) WriteTupleOfOptionals(value MyTuple[*int32, *string]) error {
+	if w.state != 2 {
+		return w.invalidState("WriteTupleOfOptionals()")
+	}
+	if err := w.impl.WriteTupleOfOptionalsImpl(value); err != nil {
+		return err
+	}
+	w.state = 3
+	return nil
+}
+
+func (w *AdvancedGenericsWriterBase) WriteTupleOfOptionalsAlternateSyntax(value MyTuple[*int32, *string]) error {
+	if w.state != 3 {
+		return w.invalidState("WriteTupleOfOptionalsAlternateSyntax()")
+	}
+	if err := w.impl.WriteTupleOfOptionalsAlternateSyntaxImpl(value); err != nil {
+		return err
+	}
+	w.state = 4
+	return nil
+}
+
+func (w *AdvancedGenericsWriterBase) WriteTupleOfVectors(value MyTuple[[]int32, []float32]) error {
+	if w.state != 4 {
+		return w.invalidState("WriteTupleOfVectors()")
+	}
+	if err := w.impl.WriteTupleOfVectorsImpl(value); err != nil {
+		return err
+	}
+	w.state = 5
+	return nil
+}
+
+func (w *AdvancedGenericsWriterBase) Close() error {
+	if w.state != 5 {
+		return w.invalidState("Close()")
+	}
+	return w.impl.CloseImpl()
+}
+
+func (w *AdvancedGenericsWriterBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, isStream := advancedGenericsStepName(w.state); name != "" {
+		expected = "Write" + name + "()"
+		if isStream {
+			expected += " or End" + name + "()"
+		}
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+type AdvancedGenericsReader interface {
+	ReadFloatImageImage() (Image[Image[float32]], error)
+	ReadGenericRecord1() (GenericRecord[int32, string], error)
+	ReadTupleOfOptionals() (MyTuple[*int32, *string], error)
+	ReadTupleOfOptionalsAlternateSyntax() (MyTuple[*int32, *string], error)
+	ReadTupleOfVectors() (MyTuple[[]int32, []float32], error)
+	Close() error
+}
+
+// AdvancedGenericsReaderImpl is implemented by concrete AdvancedGenerics readers.
+// Its methods are called by AdvancedGenericsReaderBase after it has validated the order of calls.
+type AdvancedGenericsReaderImpl interface {
+	ReadFloatImageImageImpl() (Image[Image[float32]], error)
+	ReadGenericRecord1Impl() (GenericRecord[int32, string], error)
+	ReadTupleOfOptionalsImpl() (MyTuple[*int32, *string], error)
+	ReadTupleOfOptionalsAlternateSyntaxImpl() (MyTuple[*int32, *string], error)
+	ReadTupleOfVectorsImpl() (MyTuple[[]int32, []float32], error)
+	CloseImpl() error
+}
+
+// AdvancedGenericsReaderBase implements AdvancedGenericsReader, ensuring that the protocol steps are read in order.
+type AdvancedGenericsReaderBase struct {
+	impl  AdvancedGenericsReaderImpl
+	state int
+}
+
+func NewAdvancedGenericsReaderBase(impl AdvancedGenericsReaderImpl) *AdvancedGenericsReaderBase {
+	return &AdvancedGenericsReaderBase{impl: impl}
+}
+
+func (r *AdvancedGenericsReaderBase) ReadFloatImageImage() (value Image[Image[float32]], err error) {
+	if r.state != 0 {
+		return value, r.invalidState("ReadFloatImageImage()")
+	}
+	if value, err = r.impl.ReadFloatImageImageImpl(); err != nil {
+		return
+	}
+	r.state = 1
+	return
+}
+
+func (r *AdvancedGenericsReaderBase) ReadGenericRecord1() (value GenericRecord[int32, string], err error) {
+	if r.state != 1 {
+		return value, r.invalidState("ReadGenericRecord1()")
+	}
+	if value, err = r.impl.ReadGenericRecord1Impl(); err != nil {
+		return
+	}
+	r.state = 2
+	return
+}
+
+func (r *AdvancedGenericsReaderBase) ReadTupleOfOptionals() (value MyTuple[*int32, *string], err error) {
+	if r.state != 2 {
+		return value, r.invalidState("ReadTupleOfOptionals()")
+	}
+	if value, err = r.impl.ReadTupleOfOptionalsImpl(); err != nil {
+		return
+	}
+	r.state = 3
+	return
+}
+
+func (r *AdvancedGenericsReaderBase) ReadTupleOfOptionalsAlternateSyntax() (value MyTuple[*int32, *string], err error) {
+	if r.state != 3 {
+		return value, r.invalidState("ReadTupleOfOptionalsAlternateSyntax()")
+	}
+	if value, err = r.impl.ReadTupleOfOptionalsAlternateSyntaxImpl(); err != nil {
+		return
+	}
+	r.state = 4
+	return
+}
+
+func (r *AdvancedGenericsReaderBase) ReadTupleOfVectors() (value MyTuple[[]int32, []float32], err error) {
+	if r.state != 4 {
+		return value, r.invalidState("ReadTupleOfVectors()")
+	}
+	if value, err = r.impl.ReadTupleOfVectorsImpl(); err != nil {
+		return
+	}
+	r.state = 5
+	return
+}
+
+func (r *AdvancedGenericsReaderBase) Close() error {
+	if r.state != 5 {
+		return r.invalidState("Close()")
+	}
+	return r.impl.CloseImpl()
+}
+
+func (r *AdvancedGenericsReaderBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, _ := advancedGenericsStepName(r.state); name != "" {
+		expected = "Read" + name + "()"
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+// FloatImageStreamSchema is the schema written to the header of binary FloatImageStream streams.
+const FloatImageStreamSchema = `{"protocol":{"name":"FloatImageStream","sequence":[{"name":"header","type":{"name":"TestModel.GenericRecord","typeArguments":["int32","float32"]}},{"name":"images","type":{"stream":{"items":{"name":"TestModel.Image","typeArguments":["float32"]}}}},{"name":"background","type":[{"tag":"float32","type":"float32"},{"tag":"string","type":"string"}]}]},"types":[{"name":"Image","typeParameters":["T"],"type":{"array":{"items":"T","dimensions":[{"name":"x"},{"name":"y"}]}}},{"name":"GenericRecord","typeParameters":["T1","T2"],"fields":[{"name":"scalar1","type":"T1"},{"name":"scalar2","type":"T2"},{"name":"vector1","type":{"vector":{"items":"T1"}}},{"name":"image2","type":{"name":"TestModel.Image","typeArguments":["T2"]}}]},{"name":"Image","typeParameters":["T"],"type":{"name":"Image.Image","typeArguments":["T"]}}]}`
+
+// floatImageStreamStepName returns the name of the step at the given state and whether it is a stream, or "" after the last step.
+func floatImageStreamStepName(state int) (name string, isStream bool) {
+	switch state {
+	case 0:
+		return "Header", false
+	case 1:
+		return "Images", true
+	case 2:
+		return "Background", false
+	default:
+		return "", false
+	}
+}
+
+// A protocol that is instantiated for each pixel type
+type FloatImageStreamWriter interface {
+	WriteHeader(value GenericRecord[int32, float32]) error
+	WriteImages(values ...Image[float32]) error
+	EndImages() error
+	WriteBackground(value Float32OrString) error
+	Close() error
+}
+
+// FloatImageStreamWriterImpl is implemented by concrete FloatImageStream writers.
+// Its methods are called by FloatImageStreamWriterBase after it has validated the order of calls.
+type FloatImageStreamWriterImpl interface {
+	WriteHeaderImpl(value GenericRecord[int32, float32]) error
+	WriteImagesImpl(values []Image[float32]) error
+	EndImagesImpl() error
+	WriteBackgroundImpl(value Float32OrString) error
+	CloseImpl() error
+}
+
+// FloatImageStreamWriterBase implements FloatImageStreamWriter, ensuring that the protocol steps are written in order.
+type FloatImageStreamWriterBase struct {
+	impl  FloatImageStreamWriterImpl
+	state int
+}
+
+func NewFloatImageStreamWriterBase(impl FloatImageStreamWriterImpl) *FloatImageStreamWriterBase {
+	return &FloatImageStreamWriterBase{impl: impl}
+}
+
+func (w *FloatImageStreamWriterBase) WriteHeader(value GenericRecord[int32, float32]) error {
+	if w.state != 0 {
+		return w.invalidState("WriteHeader()")
+	}
+	if err := w.impl.WriteHeaderImpl(value); err != nil {
+		return err
+	}
+	w.state = 1
+	return nil
+}
+
+func (w *FloatImageStreamWriterBase) WriteImages(values ...Image[float32]) error {
+	if w.state != 1 {
+		return w.invalidState("WriteImages()")
+	}
+	return w.impl.WriteImagesImpl(values)
+}
+
+func (w *FloatImageStreamWriterBase) EndImages() error {
+	if w.state != 1 {
+		return w.invalidState("EndImages()")
+	}
+	if err := w.impl.EndImagesImpl(); err != nil {
+		return err
+	}
+	w.state = 2
+	return nil
+}
+
+func (w *FloatImageStreamWriterBase) WriteBackground(value Float32OrString) error {
+	if w.state != 2 {
+		return w.invalidState("WriteBackground()")
+	}
+	if err := w.impl.WriteBackgroundImpl(value); err != nil {
+		return err
+	}
+	w.state = 3
+	return nil
+}
+
+func (w *FloatImageStreamWriterBase) Close() error {
+	if w.state != 3 {
+		return w.invalidState("Close()")
+	}
+	return w.impl.CloseImpl()
+}
+
+func (w *FloatImageStreamWriterBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, isStream := floatImageStreamStepName(w.state); name != "" {
+		expected = "Write" + name + "()"
+		if isStream {
+			expected += " or End" + name + "()"
+		}
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+// A protocol that is instantiated for each pixel type
+type FloatImageStreamReader interface {
+	ReadHeader() (GenericRecord[int32, float32], error)
+	// Returns false when the stream has no more items.
+	ReadImages() (Image[float32], bool, error)
+	ReadBackground() (Float32OrString, error)
+	Close() error
+}
+
+// FloatImageStreamReaderImpl is implemented by concrete FloatImageStream readers.
+// Its methods are called by FloatImageStreamReaderBase after it has validated the order of calls.
+type FloatImageStreamReaderImpl interface {
+	ReadHeaderImpl() (GenericRecord[int32, float32], error)
+	ReadImagesImpl() (Image[float32], bool, error)
+	ReadBackgroundImpl() (Float32OrString, error)
+	CloseImpl() error
+}
+
+// FloatImageStreamReaderBase implements FloatImageStreamReader, ensuring that the protocol steps are read in order.
+type FloatImageStreamReaderBase struct {
+	impl  FloatImageStreamReaderImpl
+	state int
+}
+
+func NewFloatImageStreamReaderBase(impl FloatImageStreamReaderImpl) *FloatImageStreamReaderBase {
+	return &FloatImageStreamReaderBase{impl: impl}
+}
+
+func (r *FloatImageStreamReaderBase) ReadHeader() (value GenericRecord[int32, float32], err error) {
+	if r.state != 0 {
+		return value, r.invalidState("ReadHeader()")
+	}
+	if value, err = r.impl.ReadHeaderImpl(); err != nil {
+		return
+	}
+	r.state = 1
+	return
+}
+
+func (r *FloatImageStreamReaderBase) ReadImages() (value Image[float32], ok bool, err error) {
+	if r.state != 1 {
+		return value, false, r.invalidState("ReadImages()")
+	}
+	if value, ok, err = r.impl.ReadImagesImpl(); err != nil || ok {
+		return
+	}
+	r.state = 2
+	return
+}
+
+func (r *FloatImageStreamReaderBase) ReadBackground() (value Float32OrString, err error) {
+	if r.state != 2 {
+		return value, r.invalidState("ReadBackground()")
+	}
+	if value, err = r.impl.ReadBackgroundImpl(); err != nil {
+		return
+	}
+	r.state = 3
+	return
+}
+
+func (r *FloatImageStreamReaderBase) Close() error {
+	if r.state != 3 {
+		return r.invalidState("Close()")
+	}
+	return r.impl.CloseImpl()
+}
+
+func (r *FloatImageStreamReaderBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, _ := floatImageStreamStepName(r.state); name != "" {
+		expected = "Read" + name + "()"
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+// ComplexImageStreamSchema is the schema written to the header of binary ComplexImageStream streams.
+const ComplexImageStreamSchema = `{"protocol":{"name":"ComplexImageStream","sequence":[{"name":"header","type":{"name":"TestModel.GenericRecord","typeArguments":["int32","complexfloat32"]}},{"name":"images","type":{"stream":{"items":{"name":"TestModel.Image","typeArguments":["complexfloat32"]}}}},{"name":"background","type":[{"tag":"complexfloat32","type":"complexfloat32"},{"tag":"string","type":"string"}]}]},"types":[{"name":"Image","typeParameters":["T"],"type":{"array":{"items":"T","dimensions":[{"name":"x"},{"name":"y"}]}}},{"name":"GenericRecord","typeParameters":["T1","T2"],"fields":[{"name":"scalar1","type":"T1"},{"name":"scalar2","type":"T2"},{"name":"vector1","type":{"vector":{"items":"T1"}}},{"name":"image2","type":{"name":"TestModel.Image","typeArguments":["T2"]}}]},{"name":"Image","typeParameters":["T"],"type":{"name":"Image.Image","typeArguments":["T"]}}]}`
+
+// complexImageStreamStepName returns the name of the step at the given state and whether it is a stream, or "" after the last step.
+func complexImageStreamStepName(state int) (name string, isStream bool) {
+	switch state {
+	case 0:
+		return "Header", false
+	case 1:
+		return "Images", true
+	case 2:
+		return "Background", false
+	default:
+		return "", false
+	}
+}
+
+// A protocol that is instantiated for each pixel type
+type ComplexImageStreamWriter interface {
+	WriteHeader(value GenericRecord[int32, complex64]) error
+	WriteImages(values ...Image[complex64]) error
+	EndImages() error
+	WriteBackground(value Complexfloat32OrString) error
+	Close() error
+}
+
+// ComplexImageStreamWriterImpl is implemented by concrete ComplexImageStream writers.
+// Its methods are called by ComplexImageStreamWriterBase after it has validated the order of calls.
+type ComplexImageStreamWriterImpl interface {
+	WriteHeaderImpl(value GenericRecord[int32, complex64]) error
+	WriteImagesImpl(values []Image[complex64]) error
+	EndImagesImpl() error
+	WriteBackgroundImpl(value Complexfloat32OrString) error
+	CloseImpl() error
+}
+
+// ComplexImageStreamWriterBase implements ComplexImageStreamWriter, ensuring that the protocol steps are written in order.
+type ComplexImageStreamWriterBase struct {
+	impl  ComplexImageStreamWriterImpl
+	state int
+}
+
+func NewComplexImageStreamWriterBase(impl ComplexImageStreamWriterImpl) *ComplexImageStreamWriterBase {
+	return &ComplexImageStreamWriterBase{impl: impl}
+}
+
+func (w *ComplexImageStreamWriterBase) WriteHeader(value GenericRecord[int32, complex64]) error {
+	if w.state != 0 {
+		return w.invalidState("WriteHeader()")
+	}
+	if err := w.impl.WriteHeaderImpl(value); err != nil {
+		return err
+	}
+	w.state = 1
+	return nil
+}
+
+func (w *ComplexImageStreamWriterBase) WriteImages(values ...Image[complex64]) error {
+	if w.state != 1 {
+		return w.invalidState("WriteImages()")
+	}
+	return w.impl.WriteImagesImpl(values)
+}
+
+func (w *ComplexImageStreamWriterBase) EndImages() error {
+	if w.state != 1 {
+		return w.invalidState("EndImages()")
+	}
+	if err := w.impl.EndImagesImpl(); err != nil {
+		return err
+	}
+	w.state = 2
+	return nil
+}
+
+func (w *ComplexImageStreamWriterBase) WriteBackground(value Complexfloat32OrString) error {
+	if w.state != 2 {
+		return w.invalidState("WriteBackground()")
+	}
+	if err := w.impl.WriteBackgroundImpl(value); err != nil {
+		return err
+	}
+	w.state = 3
+	return nil
+}
+
+func (w *ComplexImageStreamWriterBase) Close() error {
+	if w.state != 3 {
+		return w.invalidState("Close()")
+	}
+	return w.impl.CloseImpl()
+}
+
+func (w *ComplexImageStreamWriterBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, isStream := complexImageStreamStepName(w.state); name != "" {
+		expected = "Write" + name + "()"
+		if isStream {
+			expected += " or End" + name + "()"
+		}
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+// A protocol that is instantiated for each pixel type
+type ComplexImageStreamReader interface {
+	ReadHeader() (GenericRecord[int32, complex64], error)
+	// Returns false when the stream has no more items.
+	ReadImages() (Image[complex64], bool, error)
+	ReadBackground() (Complexfloat32OrString, error)
+	Close() error
+}
+
+// ComplexImageStreamReaderImpl is implemented by concrete ComplexImageStream readers.
+// Its methods are called by ComplexImageStreamReaderBase after it has validated the order of calls.
+type ComplexImageStreamReaderImpl interface {
+	ReadHeaderImpl() (GenericRecord[int32, complex64], error)
+	ReadImagesImpl() (Image[complex64], bool, error)
+	ReadBackgroundImpl() (Complexfloat32OrString, error)
+	CloseImpl() error
+}
+
+// ComplexImageStreamReaderBase implements ComplexImageStreamReader, ensuring that the protocol steps are read in order.
+type ComplexImageStreamReaderBase struct {
+	impl  ComplexImageStreamReaderImpl
+	state int
+}
+
+func NewComplexImageStreamReaderBase(impl ComplexImageStreamReaderImpl) *ComplexImageStreamReaderBase {
+	return &ComplexImageStreamReaderBase{impl: impl}
+}
+
+func (r *ComplexImageStreamReaderBase) ReadHeader() (value GenericRecord[int32, complex64], err error) {
+	if r.state != 0 {
+		return value, r.invalidState("ReadHeader()")
+	}
+	if value, err = r.impl.ReadHeaderImpl(); err != nil {
+		return
+	}
+	r.state = 1
+	return
+}
+
+func (r *ComplexImageStreamReaderBase) ReadImages() (value Image[complex64], ok bool, err error) {
+	if r.state != 1 {
+		return value, false, r.invalidState("ReadImages()")
+	}
+	if value, ok, err = r.impl.ReadImagesImpl(); err != nil || ok {
+		return
+	}
+	r.state = 2
+	return
+}
+
+func (r *ComplexImageStreamReaderBase) ReadBackground() (value Complexfloat32OrString, err error) {
+	if r.state != 2 {
+		return value, r.invalidState("ReadBackground()")
+	}
+	if value, err = r.impl.ReadBackgroundImpl(); err != nil {
+		return
+	}
+	r.state = 3
+	return
+}
+
+func (r *ComplexImageStreamReaderBase) Close() error {
+	if r.state != 3 {
+		return r.invalidState("Close()")
+	}
+	return r.impl.CloseImpl()
+}
+
+func (r *ComplexImageStreamReaderBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, _ := complexImageStreamStepName(r.state); name != "" {
+		expected = "Read" + name + "()"
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+// AliasesSchema is the schema written to the header of binary Aliases streams.
+const AliasesSchema = `{"protocol":{"name":"Aliases","sequence":[{"name":"aliasedString","type":"TestModel.AliasedString"},{"name":"aliasedEnum","type":"TestModel.AliasedEnum"},{"name":"aliasedOpenGeneric","type":{"name":"TestModel.AliasedOpenGeneric","typeArguments":["TestModel.AliasedString","TestModel.AliasedEnum"]}},{"name":"aliasedClosedGeneric","type":"TestModel.AliasedClosedGeneric"},{"name":"aliasedOptional","type":"TestModel.AliasedOptional"},{"name":"aliasedGenericOptional","type":{"name":"TestModel.AliasedGenericOptional","typeArguments":["float32"]}},{"name":"aliasedGenericUnion2","type":{"name":"TestModel.AliasedGenericUnion2","typeArguments":["TestModel.AliasedString","TestModel.AliasedEnum"]}},{"name":"aliasedGenericVector","type":{"name":"TestModel.AliasedGenericVector","typeArguments":["float32"]}},{"name":"aliasedGenericFixedVector","type":{"name":"TestModel.AliasedGenericFixedVector","typeArguments":["float32"]}},{"name":"streamOfAliasedGenericUnion2","type":{"stream":{"items":{"name":"TestModel.AliasedGenericUnion2","typeArguments":["TestModel.AliasedString","TestModel.AliasedEnum"]}}}},{"name":"vectors","type":{"vector":{"items":"TestModel.RecordContainingVectorsOfAliases"}}}]},"types":[{"name":"AliasedMap","typeParameters":["K","V"],"type":{"map":{"keys":"K","values":"V"}}},{"name":"Fruits","values":[{"symbol":"apple","value":1},{"symbol":"banana","value":2},{"symbol":"pear","value":3}]},{"name":"GenericUnion2","typeParameters":["T1","T2"],"type":[{"tag":"T1","type":"T1"},{"tag":"T2","type":"T2"}]},{"name":"GenericVector","typeParameters":["T"],"type":{"vector":{"items":"T"}}},{"name":"MyTuple","typeParameters":["T1","T2"],"type":{"name":"Tuples.Tuple","typeArguments":["T1","T2"]}},{"name":"Image","typeParameters":["T"],"type":{"array":{"items":"T","dimensions":[{"name":"x"},{"name":"y"}]}}},{"name":"AliasedClosedGeneric","type":{"name":"TestModel.AliasedTuple","typeArguments":["TestModel.AliasedString","TestModel.AliasedEnum"]}},{"name":"AliasedEnum","type":"TestModel.Fruits"},{"name":"AliasedGenericFixedVector","typeParameters":["T"],"type":{"vector":{"items":"T","length":3}}},{"name":"AliasedGenericOptional","typeParameters":["T"],"type":[null,"T"]},{"name":"AliasedGenericUnion2","typeParameters":["T1","T2"],"type":{"name":"BasicTypes.GenericUnion2","typeArguments":["T1","T2"]}},{"name":"AliasedGenericVector","typeParameters":["T"],"type":{"name":"BasicTypes.GenericVector","typeArguments":["T"]}},{"name":"AliasedMap","typeParameters":["K","V"],"type":{"name":"BasicTypes.AliasedMap","typeArguments":["K","V"]}},{"name":"AliasedOpenGeneric","typeParameters":["T1","T2"],"type":{"name":"TestModel.AliasedTuple","typeArguments":["T1","T2"]}},{"name":"AliasedOptional","type":[null,"int32"]},{"name":"AliasedString","type":"string"},{"name":"AliasedTuple","typeParameters":["T1","T2"],"type":{"name":"TestModel.MyTuple","typeArguments":["T1","T2"]}},{"name":"Fruits","type":"BasicTypes.Fruits"},{"name":"Image","typeParameters":["T"],"type":{"name":"Image.Image","typeArguments":["T"]}},{"name":"MyTuple","typeParameters":["T1","T2"],"type":{"name":"BasicTypes.MyTuple","typeArguments":["T1","T2"]}},{"name":"RecordContainingVectorsOfAliases","fields":[{"name":"strings","type":{"vector":{"items":"TestModel.AliasedString"}}},{"name":"maps","type":{"vector":{"items":{"name":"TestModel.AliasedMap","typeArguments":["string","int32"]}}}},{"name":"arrays","type":{"vector":{"items":{"name":"TestModel.Image","typeArguments":["float32"]}}}},{"name":"tuples","type":{"vector":{"items":{"name":"TestModel.MyTuple","typeArguments":["int32","TestModel.SimpleRecord"]}}}}]},{"name":"SimpleRecord","fields":[{"name":"x","type":"int32"},{"name":"y","type":"int32"},{"name":"z","type":"int32"}]},{"name":"Tuple","typeParameters":["T1","T2"],"fields":[{"name":"v1","type":"T1"},{"name":"v2","type":"T2"}]}]}`
+
+// aliasesStepName returns the name of the step at the given state and whether it is a stream, or "" after the last step.
+func aliasesStepName(state int) (name string, isStream bool) {
+	switch state {
+	case 0:
+		return "AliasedString", false
+	case 1:
+		return "AliasedEnum", false
+	case 2:
+		return "AliasedOpenGeneric", false
+	case 3:
+		return "AliasedClosedGeneric", false
+	case 4:
+		return "AliasedOptional", false
+	case 5:
+		return "AliasedGenericOptional", false
+	case 6:
+		return "AliasedGenericUnion2", false
+	case 7:
+		return "AliasedGenericVector", false
+	case 8:
+		return "AliasedGenericFixedVector", false
+	case 9:
+		return "StreamOfAliasedGenericUnion2", true
+	case 10:
+		return "Vectors", false
+	default:
+		return "", false
+	}
+}
+
+type AliasesWriter interface {
+	WriteAliasedString(value AliasedString) error
+	WriteAliasedEnum(value AliasedEnum) error
+	WriteAliasedOpenGeneric(value AliasedOpenGeneric[AliasedString, AliasedEnum]) error
+	WriteAliasedClosedGeneric(value AliasedClosedGeneric) error
+	WriteAliasedOptional(value AliasedOptional) error
+	WriteAliasedGenericOptional(value AliasedGenericOptional[float32]) error
+	WriteAliasedGenericUnion2(value AliasedGenericUnion2[AliasedString, AliasedEnum]) error
+	WriteAliasedGenericVector(value AliasedGenericVector[float32]) error
+	WriteAliasedGenericFixedVector(value AliasedGenericFixedVector[float32]) error
+	WriteStreamOfAliasedGenericUnion2(values ...AliasedGenericUnion2[AliasedString, AliasedEnum]) error
+	EndStreamOfAliasedGenericUnion2() error
+	WriteVectors(value []RecordContainingVectorsOfAliases) error
+	Close() error
+}
+
+// AliasesWriterImpl is implemented by concrete Aliases writers.
+// Its methods are called by AliasesWriterBase after it has validated the order of calls.
+type AliasesWriterImpl interface {
+	WriteAliasedStringImpl(value AliasedString) error
+	WriteAliasedEnumImpl(value AliasedEnum) error
+	WriteAliasedOpenGenericImpl(value AliasedOpenGeneric[AliasedString, AliasedEnum]) error
+	WriteAliasedClosedGenericImpl(value AliasedClosedGeneric) error
+	WriteAliasedOptionalImpl(value AliasedOptional) error
+	WriteAliasedGenericOptionalImpl(value AliasedGenericOptional[float32]) error
+	WriteAliasedGenericUnion2Impl(value AliasedGenericUnion2[AliasedString, AliasedEnum]) error
+	WriteAliasedGenericVectorImpl(value AliasedGenericVector[float32]) error
+	WriteAliasedGenericFixedVectorImpl(value AliasedGenericFixedVector[float32]) error
+	WriteStreamOfAliasedGenericUnion2Impl(values []AliasedGenericUnion2[AliasedString, AliasedEnum]) error
+	EndStreamOfAliasedGenericUnion2Impl() error
+	WriteVectorsImpl(value []RecordContainingVectorsOfAliases) error
+	CloseImpl() error
+}
+
+// AliasesWriterBase implements AliasesWriter, ensuring that the protocol steps are written in order.
+type AliasesWriterBase struct {
+	impl  AliasesWriterImpl
+	state int
+}
+
+func NewAliasesWriterBase(impl AliasesWriterImpl) *AliasesWriterBase {
+	return &AliasesWriterBase{impl: impl}
+}
+
+func (w *AliasesWriterBase) WriteAliasedString(value AliasedString) error {
+	if w.state != 0 {
+		return w.invalidState("WriteAliasedString()")
+	}
+	if err := w.impl.WriteAliasedStringImpl(value); err != nil {
+		return err
+	}
+	w.state = 1
+	return nil
+}
+
+func (w *AliasesWriterBase) WriteAliasedEnum(value AliasedEnum) error {
+	if w.state != 1 {
+		return w.invalidState("WriteAliasedEnum()")
+	}
+	if err := w.impl.WriteAliasedEnumImpl(value); err != nil {
+		return err
+	}
+	w.state = 2
+	return nil
+}
+
+func (w *AliasesWriterBase) WriteAliasedOpenGeneric(value AliasedOpenGeneric[AliasedString, AliasedEnum]) error {
+	if w.state != 2 {
+		return w.invalidState("WriteAliasedOpenGeneric()")
+	}
+	if err := w.impl.WriteAliasedOpenGenericImpl(value); err != nil {
+		return err
+	}
+	w.state = 3
+	return nil
+}
+
+func (w *AliasesWriterBase) WriteAliasedClosedGeneric(value AliasedClosedGeneric) error {
+	if w.state != 3 {
+		return w.invalidState("WriteAliasedClosedGeneric()")
+	}
+	if err := w.impl.WriteAliasedClosedGenericImpl(value); err != nil {
+		return err
+	}
+	w.state = 4
+	return nil
+}
+
+func (w *AliasesWriterBase) WriteAliasedOptional(value AliasedOptional) error {
+	if w.state != 4 {
+		return w.invalidState("WriteAliasedOptional()")
+	}
+	if err := w.impl.WriteAliasedOptionalImpl(value); err != nil {
+		return err
+	}
+	w.state = 5
+	return nil
+}
+
+func (w *AliasesWriterBase) WriteAliasedGenericOptional(value AliasedGenericOptional[float32]) error {
+	if w.state != 5 {
+		return w.invalidState("WriteAliasedGenericOptional()")
+	}
+	if err := w.impl.WriteAliasedGenericOptionalImpl(value); err != nil {
+		return err
+	}
+	w.state = 6
+	return nil
+}
+
+func (w *AliasesWriterBase) WriteAliasedGenericUnion2(value AliasedGenericUnion2[AliasedString, AliasedEnum]) error {
+	if w.state != 6 {
+		return w.invalidState("WriteAliasedGenericUnion2()")
+	}
+	if err := w.impl.WriteAliasedGenericUnion2Impl(value); err != nil {
+		return err
+	}
+	w.state = 7
+	return nil
+}
+
+func (w *AliasesWriterBase) WriteAliasedGenericVector(value AliasedGenericVector[float32]) error {
+	if w.state != 7 {
+		return w.invalidState("WriteAliasedGenericVector()")
+	}
+	if err := w.impl.WriteAliasedGenericVectorImpl(value); err != nil {
+		return err
+	}
+	w.state = 8
+	return nil
+}
+
+func (w *AliasesWriterBase) WriteAliasedGenericFixedVector(value AliasedGenericFixedVector[float32]) error {
+	if w.state != 8 {
+		return w.invalidState("WriteAliasedGenericFixedVector()")
+	}
+	if err := w.impl.WriteAliasedGenericFixedVectorImpl(value); err != nil {
+		return err
+	}
+	w.state = 9
+	return nil
+}
+
+func (w *AliasesWriterBase) WriteStreamOfAliasedGenericUnion2(values ...AliasedGenericUnion2[AliasedString, AliasedEnum]) error {
+	if w.state != 9 {
+		return w.invalidState("WriteStreamOfAliasedGenericUnion2()")
+	}
+	return w.impl.WriteStreamOfAliasedGenericUnion2Impl(values)
+}
+
+func (w *AliasesWriterBase) EndStreamOfAliasedGenericUnion2() error {
+	if w.state != 9 {
+		return w.invalidState("EndStreamOfAliasedGenericUnion2()")
+	}
+	if err := w.impl.EndStreamOfAliasedGenericUnion2Impl(); err != nil {
+		return err
+	}
+	w.state = 10
+	return nil
+}
+
+func (w *AliasesWriterBase) WriteVectors(value []RecordContainingVectorsOfAliases) error {
+	if w.state != 10 {
+		return w.invalidState("WriteVectors()")
+	}
+	if err := w.impl.WriteVectorsImpl(value); err != nil {
+		return err
+	}
+	w.state = 11
+	return nil
+}
+
+func (w *AliasesWriterBase) Close() error {
+	if w.state != 11 {
+		return w.invalidState("Close()")
+	}
+	return w.impl.CloseImpl()
+}
+
+func (w *AliasesWriterBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, isStream := aliasesStepName(w.state); name != "" {
+		expected = "Write" + name + "()"
+		if isStream {
+			expected += " or End" + name + "()"
+		}
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+type AliasesReader interface {
+	ReadAliasedString() (AliasedString, error)
+	ReadAliasedEnum() (AliasedEnum, error)
+	ReadAliasedOpenGeneric() (AliasedOpenGeneric[AliasedString, AliasedEnum], error)
+	ReadAliasedClosedGeneric() (AliasedClosedGeneric, error)
+	ReadAliasedOptional() (AliasedOptional, error)
+	ReadAliasedGenericOptional() (AliasedGenericOptional[float32], error)
+	ReadAliasedGenericUnion2() (AliasedGenericUnion2[AliasedString, AliasedEnum], error)
+	ReadAliasedGenericVector() (AliasedGenericVector[float32], error)
+	ReadAliasedGenericFixedVector() (AliasedGenericFixedVector[float32], error)
+	// Returns false when the stream has no more items.
+	ReadStreamOfAliasedGenericUnion2() (AliasedGenericUnion2[AliasedString, AliasedEnum], bool, error)
+	ReadVectors() ([]RecordContainingVectorsOfAliases, error)
+	Close() error
+}
+
+// AliasesReaderImpl is implemented by concrete Aliases readers.
+// Its methods are called by AliasesReaderBase after it has validated the order of calls.
+type AliasesReaderImpl interface {
+	ReadAliasedStringImpl() (AliasedString, error)
+	ReadAliasedEnumImpl() (AliasedEnum, error)
+	ReadAliasedOpenGenericImpl() (AliasedOpenGeneric[AliasedString, AliasedEnum], error)
+	ReadAliasedClosedGenericImpl() (AliasedClosedGeneric, error)
+	ReadAliasedOptionalImpl() (AliasedOptional, error)
+	ReadAliasedGenericOptionalImpl() (AliasedGenericOptional[float32], error)
+	ReadAliasedGenericUnion2Impl() (AliasedGenericUnion2[AliasedString, AliasedEnum], error)
+	ReadAliasedGenericVectorImpl() (AliasedGenericVector[float32], error)
+	ReadAliasedGenericFixedVectorImpl() (AliasedGenericFixedVector[float32], error)
+	ReadStreamOfAliasedGenericUnion2Impl() (AliasedGenericUnion2[AliasedString, AliasedEnum], bool, error)
+	ReadVectorsImpl() ([]RecordContainingVectorsOfAliases, error)
+	CloseImpl() error
+}
+
+// AliasesReaderBase implements AliasesReader, ensuring that the protocol steps are read in order.
+type AliasesReaderBase struct {
+	impl  AliasesReaderImpl
+	state int
+}
+
+func NewAliasesReaderBase(impl AliasesReaderImpl) *AliasesReaderBase {
+	return &AliasesReaderBase{impl: impl}
+}
+
+func (r *AliasesReaderBase) ReadAliasedString() (value AliasedString, err error) {
+	if r.state != 0 {
+		return value, r.invalidState("ReadAliasedString()")
+	}
+	if value, err = r.impl.ReadAliasedStringImpl(); err != nil {
+		return
+	}
+	r.state = 1
+	return
+}
+
+func (r *AliasesReaderBase) ReadAliasedEnum() (value AliasedEnum, err error) {
+	if r.state != 1 {
+		return value, r.invalidState("ReadAliasedEnum()")
+	}
+	if value, err = r.impl.ReadAliasedEnumImpl(); err != nil {
+		return
+	}
+	r.state = 2
+	return
+}
+
+func (r *AliasesReaderBase) ReadAliasedOpenGeneric() (value AliasedOpenGeneric[AliasedString, AliasedEnum], err error) {
+	if r.state != 2 {
+		return value, r.invalidState("ReadAliasedOpenGeneric()")
+	}
+	if value, err = r.impl.ReadAliasedOpenGenericImpl(); err != nil {
+		return
+	}
+	r.state = 3
+	return
+}
+
+func (r *AliasesReaderBase) ReadAliasedClosedGeneric() (value AliasedClosedGeneric, err error) {
+	if r.state != 3 {
+		return value, r.invalidState("ReadAliasedClosedGeneric()")
+	}
+	if value, err = r.impl.ReadAliasedClosedGenericImpl(); err != nil {
+		return
+	}
+	r.state = 4
+	return
+}
+
+func (r *AliasesReaderBase) ReadAliasedOptional() (value AliasedOptional, err error) {
+	if r.state != 4 {
+		return value, r.invalidState("ReadAliasedOptional()")
+	}
+	if value, err = r.impl.ReadAliasedOptionalImpl(); err != nil {
+		return
+	}
+	r.state = 5
+	return
+}
+
+func (r *AliasesReaderBase) ReadAliasedGenericOptional() (value AliasedGenericOptional[float32], err error) {
+	if r.state != 5 {
+		return value, r.invalidState("ReadAliasedGenericOptional()")
+	}
+	if value, err = r.impl.ReadAliasedGenericOptionalImpl(); err != nil {
+		return
+	}
+	r.state = 6
+	return
+}
+
+func (r *AliasesReaderBase) ReadAliasedGenericUnion2() (value AliasedGenericUnion2[AliasedString, AliasedEnum], err error) {
+	if r.state != 6 {
+		return value, r.invalidState("ReadAliasedGenericUnion2()")
+	}
+	if value, err = r.impl.ReadAliasedGenericUnion2Impl(); err != nil {
+		return
+	}
+	r.state = 7
+	return
+}
+
+func (r *AliasesReaderBase) ReadAliasedGenericVector() (value AliasedGenericVector[float32], err error) {
+	if r.state != 7 {
+		return value, r.invalidState("ReadAliasedGenericVector()")
+	}
+	if value, err = r.impl.ReadAliasedGenericVectorImpl(); err != nil {
+		return
+	}
+	r.state = 8
+	return
+}
+
+func (r *AliasesReaderBase) ReadAliasedGenericFixedVector() (value AliasedGenericFixedVector[float32], err error) {
+	if r.state != 8 {
+		return value, r.invalidState("ReadAliasedGenericFixedVector()")
+	}
+	if value, err = r.impl.ReadAliasedGenericFixedVectorImpl(); err != nil {
+		return
+	}
+	r.state = 9
+	return
+}
+
+func (r *AliasesReaderBase) ReadStreamOfAliasedGenericUnion2() (value AliasedGenericUnion2[AliasedString, AliasedEnum], ok bool, err error) {
+	if r.state != 9 {
+		return value, false, r.invalidState("ReadStreamOfAliasedGenericUnion2()")
+	}
+	if value, ok, err = r.impl.ReadStreamOfAliasedGenericUnion2Impl(); err != nil || ok {
+		return
+	}
+	r.state = 10
+	return
+}
+
+func (r *AliasesReaderBase) ReadVectors() (value []RecordContainingVectorsOfAliases, err error) {
+	if r.state != 10 {
+		return value, r.invalidState("ReadVectors()")
+	}
+	if value, err = r.impl.ReadVectorsImpl(); err != nil {
+		return
+	}
+	r.state = 11
+	return
+}
+
+func (r *AliasesReaderBase) Close() error {
+	if r.state != 11 {
+		return r.invalidState("Close()")
+	}
+	return r.impl.CloseImpl()
+}
+
+func (r *AliasesReaderBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, _ := aliasesStepName(r.state); name != "" {
+		expected = "Read" + name + "()"
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+// StreamsOfAliasedUnionsSchema is the schema written to the header of binary StreamsOfAliasedUnions streams.
+const StreamsOfAliasedUnionsSchema = `{"protocol":{"name":"StreamsOfAliasedUnions","sequence":[{"name":"intOrSimpleRecord","type":{"stream":{"items":"TestModel.AliasedIntOrSimpleRecord"}}},{"name":"nullableIntOrSimpleRecord","type":{"stream":{"items":"TestModel.AliasedNullableIntSimpleRecord"}}}]},"types":[{"name":"AliasedIntOrSimpleRecord","type":[{"tag":"int32","type":"int32"},{"tag":"SimpleRecord","type":"TestModel.SimpleRecord"}]},{"name":"AliasedNullableIntSimpleRecord","type":[null,{"tag":"int32","type":"int32"},{"tag":"SimpleRecord","type":"TestModel.SimpleRecord"}]},{"name":"SimpleRecord","fields":[{"name":"x","type":"int32"},{"name":"y","type":"int32"},{"name":"z","type":"int32"}]}]}`
+
+// streamsOfAliasedUnionsStepName returns the name of the step at the given state and whether it is a stream, or "" after the last step.
+func streamsOfAliasedUnionsStepName(state int) (name string, isStream bool) {
+	switch state {
+	case 0:
+		return "IntOrSimpleRecord", true
+	case 1:
+		return "NullableIntOrSimpleRecord", true
+	default:
+		return "", false
+	}
+}
+
+type StreamsOfAliasedUnionsWriter interface {
+	WriteIntOrSimpleRecord(values ...AliasedIntOrSimpleRecord) error
+	EndIntOrSimpleRecord() error
+	WriteNullableIntOrSimpleRecord(values ...AliasedNullableIntSimpleRecord) error
+	EndNullableIntOrSimpleRecord() error
+	Close() error
+}
+
+// StreamsOfAliasedUnionsWriterImpl is implemented by concrete StreamsOfAliasedUnions writers.
+// Its methods are called by StreamsOfAliasedUnionsWriterBase after it has validated the order of calls.
+type StreamsOfAliasedUnionsWriterImpl interface {
+	WriteIntOrSimpleRecordImpl(values []AliasedIntOrSimpleRecord) error
+	EndIntOrSimpleRecordImpl() error
+	WriteNullableIntOrSimpleRecordImpl(values []AliasedNullableIntSimpleRecord) error
+	EndNullableIntOrSimpleRecordImpl() error
+	CloseImpl() error
+}
+
+// StreamsOfAliasedUnionsWriterBase implements StreamsOfAliasedUnionsWriter, ensuring that the protocol steps are written in order.
+type StreamsOfAliasedUnionsWriterBase struct {
+	impl  StreamsOfAliasedUnionsWriterImpl
+	state int
+}
+
+func NewStreamsOfAliasedUnionsWriterBase(impl StreamsOfAliasedUnionsWriterImpl) *StreamsOfAliasedUnionsWriterBase {
+	return &StreamsOfAliasedUnionsWriterBase{impl: impl}
+}
+
+func (w *StreamsOfAliasedUnionsWriterBase) WriteIntOrSimpleRecord(values ...AliasedIntOrSimpleRecord) error {
+	if w.state != 0 {
+		return w.invalidState("WriteIntOrSimpleRecord()")
+	}
+	return w.impl.WriteIntOrSimpleRecordImpl(values)
+}
+
+func (w *StreamsOfAliasedUnionsWriterBase) EndIntOrSimpleRecord() error {
+	if w.state != 0 {
+		return w.invalidState("EndIntOrSimpleRecord()")
+	}
+	if err := w.impl.EndIntOrSimpleRecordImpl(); err != nil {
+		return err
+	}
+	w.state = 1
+	return nil
+}
+
+func (w *StreamsOfAliasedUnionsWriterBase) WriteNullableIntOrSimpleRecord(values ...AliasedNullableIntSimpleRecord) error {
+	if w.state != 1 {
+		return w.invalidState("WriteNullableIntOrSimpleRecord()")
+	}
+	return w.impl.WriteNullableIntOrSimpleRecordImpl(values)
+}
+
+func (w *StreamsOfAliasedUnionsWriterBase) EndNullableIntOrSimpleRecord() error {
+	if w.state != 1 {
+		return w.invalidState("EndNullableIntOrSimpleRecord()")
+	}
+	if err := w.impl.EndNullableIntOrSimpleRecordImpl(); err != nil {
+		return err
+	}
+	w.state = 2
+	return nil
+}
+
+func (w *StreamsOfAliasedUnionsWriterBase) Close() error {
+	if w.state != 2 {
+		return w.invalidState("Close()")
+	}
+	return w.impl.CloseImpl()
+}
+
+func (w *StreamsOfAliasedUnionsWriterBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, isStream := streamsOfAliasedUnionsStepName(w.state); name != "" {
+		expected = "Write" + name + "()"
+		if isStream {
+			expected += " or End" + name + "()"
+		}
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+type StreamsOfAliasedUnionsReader interface {
+	// Returns false when the stream has no more items.
+	ReadIntOrSimpleRecord() (AliasedIntOrSimpleRecord, bool, error)
+	// Returns false when the stream has no more items.
+	ReadNullableIntOrSimpleRecord() (AliasedNullableIntSimpleRecord, bool, error)
+	Close() error
+}
+
+// StreamsOfAliasedUnionsReaderImpl is implemented by concrete StreamsOfAliasedUnions readers.
+// Its methods are called by StreamsOfAliasedUnionsReaderBase after it has validated the order of calls.
+type StreamsOfAliasedUnionsReaderImpl interface {
+	ReadIntOrSimpleRecordImpl() (AliasedIntOrSimpleRecord, bool, error)
+	ReadNullableIntOrSimpleRecordImpl() (AliasedNullableIntSimpleRecord, bool, error)
+	CloseImpl() error
+}
+
+// StreamsOfAliasedUnionsReaderBase implements StreamsOfAliasedUnionsReader, ensuring that the protocol steps are read in order.
+type StreamsOfAliasedUnionsReaderBase struct {
+	impl  StreamsOfAliasedUnionsReaderImpl
+	state int
+}
+
+func NewStreamsOfAliasedUnionsReaderBase(impl StreamsOfAliasedUnionsReaderImpl) *StreamsOfAliasedUnionsReaderBase {
+	return &StreamsOfAliasedUnionsReaderBase{impl: impl}
+}
+
+func (r *StreamsOfAliasedUnionsReaderBase) ReadIntOrSimpleRecord() (value AliasedIntOrSimpleRecord, ok bool, err error) {
+	if r.state != 0 {
+		return value, false, r.invalidState("ReadIntOrSimpleRecord()")
+	}
+	if value, ok, err = r.impl.ReadIntOrSimpleRecordImpl(); err != nil || ok {
+		return
+	}
+	r.state = 1
+	return
+}
+
+func (r *StreamsOfAliasedUnionsReaderBase) ReadNullableIntOrSimpleRecord() (value AliasedNullableIntSimpleRecord, ok bool, err error) {
+	if r.state != 1 {
+		return value, false, r.invalidState("ReadNullableIntOrSimpleRecord()")
+	}
+	if value, ok, err = r.impl.ReadNullableIntOrSimpleRecordImpl(); err != nil || ok {
+		return
+	}
+	r.state = 2
+	return
+}
+
+func (r *StreamsOfAliasedUnionsReaderBase) Close() error {
+	if r.state != 2 {
+		return r.invalidState("Close()")
+	}
+	return r.impl.CloseImpl()
+}
+
+func (r *StreamsOfAliasedUnionsReaderBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, _ := streamsOfAliasedUnionsStepName(r.state); name != "" {
+		expected = "Read" + name + "()"
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+// ProtocolWithComputedFieldsSchema is the schema written to the header of binary ProtocolWithComputedFields streams.
+const ProtocolWithComputedFieldsSchema = `{"protocol":{"name":"ProtocolWithComputedFields","sequence":[{"name":"recordWithComputedFields","type":"TestModel.RecordWithComputedFields"}]},"types":[{"name":"GenericRecordWithComputedFields","typeParameters":["T0","T1"],"fields":[{"name":"f1","type":[{"tag":"T0","type":"T0"},{"tag":"T1","type":"T1"}]}]},{"name":"MyTuple","typeParameters":["T1","T2"],"type":{"name":"Tuples.Tuple","typeArguments":["T1","T2"]}},{"name":"MyTuple","typeParameters":["T1","T2"],"type":{"name":"BasicTypes.MyTuple","typeArguments":["T1","T2"]}},{"name":"NamedNDArray","type":{"array":{"items":"int32","dimensions":[{"name":"dimA"},{"name":"dimB"}]}}},{"name":"RecordWithComputedFields","fields":[{"name":"arrayField","type":{"array":{"items":"int32","dimensions":[{"name":"x"},{"name":"y"}]}}},{"name":"arrayFieldMapDimensions","type":{"array":{"items":"int32","dimensions":[{"name":"x"},{"name":"y"}]}}},{"name":"dynamicArrayField","type":{"array":{"items":"int32"}}},{"name":"fixedArrayField","type":{"array":{"items":"int32","dimensions":[{"name":"x","length":3},{"name":"y","length":4}]}}},{"name":"intField","type":"int32"},{"name":"int8Field","type":"int8"},{"name":"uint8Field","type":"uint8"},{"name":"int16Field","type":"int16"},{"name":"uint16Field","type":"uint16"},{"name":"uint32Field","type":"uint32"},{"name":"int64Field","type":"int64"},{"name":"uint64Field","type":"uint64"},{"name":"sizeField","type":"size"},{"name":"float32Field","type":"float32"},{"name":"float64Field","type":"float64"},{"name":"complexfloat32Field","type":"complexfloat32"},{"name":"complexfloat64Field","type":"complexfloat64"},{"name":"stringField","type":"string"},{"name":"tupleField","type":{"name":"TestModel.MyTuple","typeArguments":["int32","int32"]}},{"name":"vectorField","type":{"vector":{"items":"int32"}}},{"name":"vectorOfVectorsField","type":{"vector":{"items":{"vector":{"items":"int32"}}}}},{"name":"fixedVectorField","type":{"vector":{"items":"int32","length":3}}},{"name":"fixedVectorOfVectorsField","type":{"vector":{"items":{"vector":{"items":"int32","length":3}},"length":2}}},{"name":"optionalNamedArray","type":[null,"TestModel.NamedNDArray"]},{"name":"intFloatUnion","type":[{"tag":"int32","type":"int32"},{"tag":"float32","type":"float32"}]},{"name":"nullableIntFloatUnion","type":[null,{"tag":"int32","type":"int32"},{"tag":"float32","type":"float32"}]},{"name":"unionWithNestedGenericUnion","type":[{"tag":"int","explicitTag":true,"type":"int32"},{"tag":"genericRecordWithComputedFields","explicitTag":true,"type":{"name":"BasicTypes.GenericRecordWithComputedFields","typeArguments":["string","float32"]}}]},{"name":"mapField","type":{"map":{"keys":"string","values":"string"}}}]},{"name":"Tuple","typeParameters":["T1","T2"],"fields":[{"name":"v1","type":"T1"},{"name":"v2","type":"T2"}]}]}`
+
+// protocolWithComputedFieldsStepName returns the name of the step at the given state and whether it is a stream, or "" after the last step.
+func protocolWithComputedFieldsStepName(state int) (name string, isStream bool) {
+	switch state {
+	case 0:
+		return "RecordWithComputedFields", false
+	default:
+		return "", false
+	}
+}
+
+type ProtocolWithComputedFieldsWriter interface {
+	WriteRecordWithComputedFields(value RecordWithComputedFields) error
+	Close() error
+}
+
+// ProtocolWithComputedFieldsWriterImpl is implemented by concrete ProtocolWithComputedFields writers.
+// Its methods are called by ProtocolWithComputedFieldsWriterBase after it has validated the order of calls.
+type ProtocolWithComputedFieldsWriterImpl interface {
+	WriteRecordWithComputedFieldsImpl(value RecordWithComputedFields) error
+	CloseImpl() error
+}
+
+// ProtocolWithComputedFieldsWriterBase implements ProtocolWithComputedFieldsWriter, ensuring that the protocol steps are written in order.
+type ProtocolWithComputedFieldsWriterBase struct {
+	impl  ProtocolWithComputedFieldsWriterImpl
+	state int
+}
+
+func NewProtocolWithComputedFieldsWriterBase(impl ProtocolWithComputedFieldsWriterImpl) *ProtocolWithComputedFieldsWriterBase {
+	return &ProtocolWithComputedFieldsWriterBase{impl: impl}
+}
+
+func (w *ProtocolWithComputedFieldsWriterBase) WriteRecordWithComputedFields(value RecordWithComputedFields) error {
+	if w.state != 0 {
+		return w.invalidState("WriteRecordWithComputedFields()")
+	}
+	if err := w.impl.WriteRecordWithComputedFieldsImpl(value); err != nil {
+		return err
+	}
+	w.state = 1
+	return nil
+}
+
+func (w *ProtocolWithComputedFieldsWriterBase) Close() error {
+	if w.state != 1 {
+		return w.invalidState("Close()")
+	}
+	return w.impl.CloseImpl()
+}
+
+func (w *ProtocolWithComputedFieldsWriterBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, isStream := protocolWithComputedFieldsStepName(w.state); name != "" {
+		expected = "Write" + name + "()"
+		if isStream {
+			expected += " or End" + name + "()"
+		}
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+type ProtocolWithComputedFieldsReader interface {
+	ReadRecordWithComputedFields() (RecordWithComputedFields, error)
+	Close() error
+}
+
+// ProtocolWithComputedFieldsReaderImpl is implemented by concrete ProtocolWithComputedFields readers.
+// Its methods are called by ProtocolWithComputedFieldsReaderBase after it has validated the order of calls.
+type ProtocolWithComputedFieldsReaderImpl interface {
+	ReadRecordWithComputedFieldsImpl() (RecordWithComputedFields, error)
+	CloseImpl() error
+}
+
+// ProtocolWithComputedFieldsReaderBase implements ProtocolWithComputedFieldsReader, ensuring that the protocol steps are read in order.
+type ProtocolWithComputedFieldsReaderBase struct {
+	impl  ProtocolWithComputedFieldsReaderImpl
+	state int
+}
+
+func NewProtocolWithComputedFieldsReaderBase(impl ProtocolWithComputedFieldsReaderImpl) *ProtocolWithComputedFieldsReaderBase {
+	return &ProtocolWithComputedFieldsReaderBase{impl: impl}
+}
+
+func (r *ProtocolWithComputedFieldsReaderBase) ReadRecordWithComputedFields() (value RecordWithComputedFields, err error) {
+	if r.state != 0 {
+		return value, r.invalidState("ReadRecordWithComputedFields()")
+	}
+	if value, err = r.impl.ReadRecordWithComputedFieldsImpl(); err != nil {
+		return
+	}
+	r.state = 1
+	return
+}
+
+func (r *ProtocolWithComputedFieldsReaderBase) Close() error {
+	if r.state != 1 {
+		return r.invalidState("Close()")
+	}
+	return r.impl.CloseImpl()
+}
+
+func (r *ProtocolWithComputedFieldsReaderBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, _ := protocolWithComputedFieldsStepName(r.state); name != "" {
+		expected = "Read" + name + "()"
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+// ProtocolWithConstraintsSchema is the schema written to the header of binary ProtocolWithConstraints streams.
+const ProtocolWithConstraintsSchema = `{"protocol":{"name":"ProtocolWithConstraints","sequence":[{"name":"record","type":"TestModel.RecordWithConstraints"},{"name":"records","type":{"stream":{"items":"TestModel.RecordWithConstrainedRecords"}}}]},"types":[{"name":"RecordWithConstrainedRecords","fields":[{"name":"single","type":"TestModel.RecordWithConstraints"},{"name":"optional","type":[null,"TestModel.RecordWithConstraints"]},{"name":"vector","type":{"vector":{"items":"TestModel.RecordWithConstraints"}}}]},{"name":"RecordWithConstraints","fields":[{"name":"size","type":"uint32"},{"name":"gain","type":"float32"},{"name":"name","type":"string"},{"name":"limit","type":[null,"int32"]},{"name":"samples","type":{"vector":{"items":"int32"}}}]}]}`
+
+// protocolWithConstraintsStepName returns the name of the step at the given state and whether it is a stream, or "" after the last step.
+func protocolWithConstraintsStepName(state int) (name string, isStream bool) {
+	switch state {
+	case 0:
+		return "Record", false
+	case 1:
+		return "Records", true
+	default:
+		return "", false
+	}
+}
+
+type ProtocolWithConstraintsWriter interface {
+	WriteRecord(value RecordWithConstraints) error
+	WriteRecords(values ...RecordWithConstrainedRecords) error
+	EndRecords() error
+	Close() error
+}
+
+// ProtocolWithConstraintsWriterImpl is implemented by concrete ProtocolWithConstraints writers.
+// Its methods are called by ProtocolWithConstraintsWriterBase after it has validated the order of calls.
+type ProtocolWithConstraintsWriterImpl interface {
+	WriteRecordImpl(value RecordWithConstraints) error
+	WriteRecordsImpl(values []RecordWithConstrainedRecords) error
+	EndRecordsImpl() error
+	CloseImpl() error
+}
+
+// ProtocolWithConstraintsWriterBase implements ProtocolWithConstraintsWriter, ensuring that the protocol steps are written in order.
+type ProtocolWithConstraintsWriterBase struct {
+	impl  ProtocolWithConstraintsWriterImpl
+	state int
+}
+
+func NewProtocolWithConstraintsWriterBase(impl ProtocolWithConstraintsWriterImpl) *ProtocolWithConstraintsWriterBase {
+	return &ProtocolWithConstraintsWriterBase{impl: impl}
+}
+
+func (w *ProtocolWithConstraintsWriterBase) WriteRecord(value RecordWithConstraints) error {
+	if w.state != 0 {
+		return w.invalidState("WriteRecord()")
+	}
+	if err := w.impl.WriteRecordImpl(value); err != nil {
+		return err
+	}
+	w.state = 1
+	return nil
+}
+
+func (w *ProtocolWithConstraintsWriterBase) WriteRecords(values ...RecordWithConstrainedRecords) error {
+	if w.state != 1 {
+		return w.invalidState("WriteRecords()")
+	}
+	return w.impl.WriteRecordsImpl(values)
+}
+
+func (w *ProtocolWithConstraintsWriterBase) EndRecords() error {
+	if w.state != 1 {
+		return w.invalidState("EndRecords()")
+	}
+	if err := w.impl.EndRecordsImpl(); err != nil {
+		return err
+	}
+	w.state = 2
+	return nil
+}
+
+func (w *ProtocolWithConstraintsWriterBase) Close() error {
+	if w.state != 2 {
+		return w.invalidState("Close()")
+	}
+	return w.impl.CloseImpl()
+}
+
+func (w *ProtocolWithConstraintsWriterBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, isStream := protocolWithConstraintsStepName(w.state); name != "" {
+		expected = "Write" + name + "()"
+		if isStream {
+			expected += " or End" + name + "()"
+		}
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+type ProtocolWithConstraintsReader interface {
+	ReadRecord() (RecordWithConstraints, error)
+	// Returns false when the stream has no more items.
+	ReadRecords() (RecordWithConstrainedRecords, bool, error)
+	Close() error
+}
+
+// ProtocolWithConstraintsReaderImpl is implemented by concrete ProtocolWithConstraints readers.
+// Its methods are called by ProtocolWithConstraintsReaderBase after it has validated the order of calls.
+type ProtocolWithConstraintsReaderImpl interface {
+	ReadRecordImpl() (RecordWithConstraints, error)
+	ReadRecordsImpl() (RecordWithConstrainedRecords, bool, error)
+	CloseImpl() error
+}
+
+// ProtocolWithConstraintsReaderBase implements ProtocolWithConstraintsReader, ensuring that the protocol steps are read in order.
+type ProtocolWithConstraintsReaderBase struct {
+	impl  ProtocolWithConstraintsReaderImpl
+	state int
+}
+
+func NewProtocolWithConstraintsReaderBase(impl ProtocolWithConstraintsReaderImpl) *ProtocolWithConstraintsReaderBase {
+	return &ProtocolWithConstraintsReaderBase{impl: impl}
+}
+
+func (r *ProtocolWithConstraintsReaderBase) ReadRecord() (value RecordWithConstraints, err error) {
+	if r.state != 0 {
+		return value, r.invalidState("ReadRecord()")
+	}
+	if value, err = r.impl.ReadRecordImpl(); err != nil {
+		return
+	}
+	r.state = 1
+	return
+}
+
+func (r *ProtocolWithConstraintsReaderBase) ReadRecords() (value RecordWithConstrainedRecords, ok bool, err error) {
+	if r.state != 1 {
+		return value, false, r.invalidState("ReadRecords()")
+	}
+	if value, ok, err = r.impl.ReadRecordsImpl(); err != nil || ok {
+		return
+	}
+	r.state = 2
+	return
+}
+
+func (r *ProtocolWithConstraintsReaderBase) Close() error {
+	if r.state != 2 {
+		return r.invalidState("Close()")
+	}
+	return r.impl.CloseImpl()
+}
+
+func (r *ProtocolWithConstraintsReaderBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, _ := protocolWithConstraintsStepName(r.state); name != "" {
+		expected = "Read" + name + "()"
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+// ProtocolWithKeywordStepsSchema is the schema written to the header of binary ProtocolWithKeywordSteps streams.
+const ProtocolWithKeywordStepsSchema = `{"protocol":{"name":"ProtocolWithKeywordSteps","sequence":[{"name":"int","type":{"stream":{"items":"TestModel.RecordWithKeywordFields"}}},{"name":"float","type":"TestModel.EnumWithKeywordSymbols"}]},"types":[{"name":"ArrayWithKeywordDimensionNames","type":{"array":{"items":"int32","dimensions":[{"name":"while"},{"name":"do"}]}}},{"name":"EnumWithKeywordSymbols","values":[{"symbol":"try","value":2},{"symbol":"catch","value":1}]},{"name":"RecordWithKeywordFields","fields":[{"name":"int","type":"string"},{"name":"sizeof","type":"TestModel.ArrayWithKeywordDimensionNames"},{"name":"if","type":"TestModel.EnumWithKeywordSymbols"}]}]}`
+
+// protocolWithKeywordStepsStepName returns the name of the step at the given state and whether it is a stream, or "" after the last step.
+func protocolWithKeywordStepsStepName(state int) (name string, isStream bool) {
+	switch state {
+	case 0:
+		return "Int", true
+	case 1:
+		return "Float", false
+	default:
+		return "", false
+	}
+}
+
+type ProtocolWithKeywordStepsWriter interface {
+	WriteInt(values ...RecordWithKeywordFields) error
+	EndInt() error
+	WriteFloat(value EnumWithKeywordSymbols) error
+	Close() error
+}
+
+// ProtocolWithKeywordStepsWriterImpl is implemented by concrete ProtocolWithKeywordSteps writers.
+// Its methods are called by ProtocolWithKeywordStepsWriterBase after it has validated the order of calls.
+type ProtocolWithKeywordStepsWriterImpl interface {
+	WriteIntImpl(values []RecordWithKeywordFields) error
+	EndIntImpl() error
+	WriteFloatImpl(value EnumWithKeywordSymbols) error
+	CloseImpl() error
+}
+
+// ProtocolWithKeywordStepsWriterBase implements ProtocolWithKeywordStepsWriter, ensuring that the protocol steps are written in order.
+type ProtocolWithKeywordStepsWriterBase struct {
+	impl  ProtocolWithKeywordStepsWriterImpl
+	state int
+}
+
+func NewProtocolWithKeywordStepsWriterBase(impl ProtocolWithKeywordStepsWriterImpl) *ProtocolWithKeywordStepsWriterBase {
+	return &ProtocolWithKeywordStepsWriterBase{impl: impl}
+}
+
+func (w *ProtocolWithKeywordStepsWriterBase) WriteInt(values ...RecordWithKeywordFields) error {
+	if w.state != 0 {
+		return w.invalidState("WriteInt()")
+	}
+	return w.impl.WriteIntImpl(values)
+}
+
+func (w *ProtocolWithKeywordStepsWriterBase) EndInt() error {
+	if w.state != 0 {
+		return w.invalidState("EndInt()")
+	}
+	if err := w.impl.EndIntImpl(); err != nil {
+		return err
+	}
+	w.state = 1
+	return nil
+}
+
+func (w *ProtocolWithKeywordStepsWriterBase) WriteFloat(value EnumWithKeywordSymbols) error {
+	if w.state != 1 {
+		return w.invalidState("WriteFloat()")
+	}
+	if err := w.impl.WriteFloatImpl(value); err != nil {
+		return err
+	}
+	w.state = 2
+	return nil
+}
+
+func (w *ProtocolWithKeywordStepsWriterBase) Close() error {
+	if w.state != 2 {
+		return w.invalidState("Close()")
+	}
+	return w.impl.CloseImpl()
+}
+
+func (w *ProtocolWithKeywordStepsWriterBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, isStream := protocolWithKeywordStepsStepName(w.state); name != "" {
+		expected = "Write" + name + "()"
+		if isStream {
+			expected += " or End" + name + "()"
+		}
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+type ProtocolWithKeywordStepsReader interface {
+	// Returns false when the stream has no more items.
+	ReadInt() (RecordWithKeywordFields, bool, error)
+	ReadFloat() (EnumWithKeywordSymbols, error)
+	Close() error
+}
+
+// ProtocolWithKeywordStepsReaderImpl is implemented by concrete ProtocolWithKeywordSteps readers.
+// Its methods are called by ProtocolWithKeywordStepsReaderBase after it has validated the order of calls.
+type ProtocolWithKeywordStepsReaderImpl interface {
+	ReadIntImpl() (RecordWithKeywordFields, bool, error)
+	ReadFloatImpl() (EnumWithKeywordSymbols, error)
+	CloseImpl() error
+}
+
+// ProtocolWithKeywordStepsReaderBase implements ProtocolWithKeywordStepsReader, ensuring that the protocol steps are read in order.
+type ProtocolWithKeywordStepsReaderBase struct {
+	impl  ProtocolWithKeywordStepsReaderImpl
+	state int
+}
+
+func NewProtocolWithKeywordStepsReaderBase(impl ProtocolWithKeywordStepsReaderImpl) *ProtocolWithKeywordStepsReaderBase {
+	return &ProtocolWithKeywordStepsReaderBase{impl: impl}
+}
+
+func (r *ProtocolWithKeywordStepsReaderBase) ReadInt() (value RecordWithKeywordFields, ok bool, err error) {
+	if r.state != 0 {
+		return value, false, r.invalidState("ReadInt()")
+	}
+	if value, ok, err = r.impl.ReadIntImpl(); err != nil || ok {
+		return
+	}
+	r.state = 1
+	return
+}
+
+func (r *ProtocolWithKeywordStepsReaderBase) ReadFloat() (value EnumWithKeywordSymbols, err error) {
+	if r.state != 1 {
+		return value, r.invalidState("ReadFloat()")
+	}
+	if value, err = r.impl.ReadFloatImpl(); err != nil {
+		return
+	}
+	r.state = 2
+	return
+}
+
+func (r *ProtocolWithKeywordStepsReaderBase) Close() error {
+	if r.state != 2 {
+		return r.invalidState("Close()")
+	}
+	return r.impl.CloseImpl()
+}
+
+func (r *ProtocolWithKeywordStepsReaderBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, _ := protocolWithKeywordStepsStepName(r.state); name != "" {
+		expected = "Read" + name + "()"
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+// ProtocolWithOptionalDateSchema is the schema written to the header of binary ProtocolWithOptionalDate streams.
+const ProtocolWithOptionalDateSchema = `{"protocol":{"name":"ProtocolWithOptionalDate","sequence":[{"name":"record","type":[null,"TestModel.RecordWithOptionalDate"]}]},"types":[{"name":"RecordWithOptionalDate","fields":[{"name":"dateField","type":[null,"date"]}]}]}`
+
+// protocolWithOptionalDateStepName returns the name of the step at the given state and whether it is a stream, or "" after the last step.
+func protocolWithOptionalDateStepName(state int) (name string, isStream bool) {
+	switch state {
+	case 0:
+		return "Record", false
+	default:
+		return "", false
+	}
+}
+
+type ProtocolWithOptionalDateWriter interface {
+	WriteRecord(value *RecordWithOptionalDate) error
+	Close() error
+}
+
+// ProtocolWithOptionalDateWriterImpl is implemented by concrete ProtocolWithOptionalDate writers.
+// Its methods are called by ProtocolWithOptionalDateWriterBase after it has validated the order of calls.
+type ProtocolWithOptionalDateWriterImpl interface {
+	WriteRecordImpl(value *RecordWithOptionalDate) error
+	CloseImpl() error
+}
+
+// ProtocolWithOptionalDateWriterBase implements ProtocolWithOptionalDateWriter, ensuring that the protocol steps are written in order.
+type ProtocolWithOptionalDateWriterBase struct {
+	impl  ProtocolWithOptionalDateWriterImpl
+	state int
+}
+
+func NewProtocolWithOptionalDateWriterBase(impl ProtocolWithOptionalDateWriterImpl) *ProtocolWithOptionalDateWriterBase {
+	return &ProtocolWithOptionalDateWriterBase{impl: impl}
+}
+
+func (w *ProtocolWithOptionalDateWriterBase) WriteRecord(value *RecordWithOptionalDate) error {
+	if w.state != 0 {
+		return w.invalidState("WriteRecord()")
+	}
+	if err := w.impl.WriteRecordImpl(value); err != nil {
+		return err
+	}
+	w.state = 1
+	return nil
+}
+
+func (w *ProtocolWithOptionalDateWriterBase) Close() error {
+	if w.state != 1 {
+		return w.invalidState("Close()")
+	}
+	return w.impl.CloseImpl()
+}
+
+func (w *ProtocolWithOptionalDateWriterBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, isStream := protocolWithOptionalDateStepName(w.state); name != "" {
+		expected = "Write" + name + "()"
+		if isStream {
+			expected += " or End" + name + "()"
+		}
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
+
+type ProtocolWithOptionalDateReader interface {
+	ReadRecord() (*RecordWithOptionalDate, error)
+	Close() error
+}
+
+// ProtocolWithOptionalDateReaderImpl is implemented by concrete ProtocolWithOptionalDate readers.
+// Its methods are called by ProtocolWithOptionalDateReaderBase after it has validated the order of calls.
+type ProtocolWithOptionalDateReaderImpl interface {
+	ReadRecordImpl() (*RecordWithOptionalDate, error)
+	CloseImpl() error
+}
+
+// ProtocolWithOptionalDateReaderBase implements ProtocolWithOptionalDateReader, ensuring that the protocol steps are read in order.
+type ProtocolWithOptionalDateReaderBase struct {
+	impl  ProtocolWithOptionalDateReaderImpl
+	state int
+}
+
+func NewProtocolWithOptionalDateReaderBase(impl ProtocolWithOptionalDateReaderImpl) *ProtocolWithOptionalDateReaderBase {
+	return &ProtocolWithOptionalDateReaderBase{impl: impl}
+}
+
+func (r *ProtocolWithOptionalDateReaderBase) ReadRecord() (value *RecordWithOptionalDate, err error) {
+	if r.state != 0 {
+		return value, r.invalidState("ReadRecord()")
+	}
+	if value, err = r.impl.ReadRecordImpl(); err != nil {
+		return
+	}
+	r.state = 1
+	return
+}
+
+func (r *ProtocolWithOptionalDateReaderBase) Close() error {
+	if r.state != 1 {
+		return r.invalidState("Close()")
+	}
+	return r.impl.CloseImpl()
+}
+
+func (r *ProtocolWithOptionalDateReaderBase) invalidState(attempted string) error {
+	expected := "Close()"
+	if name, _ := protocolWithOptionalDateStepName(r.state); name != "" {
+		expected = "Read" + name + "()"
+	}
+	return &yardl.ProtocolError{Expected: expected, Received: attempted}
+}
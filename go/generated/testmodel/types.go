@@ -0,0 +1,1089 @@
+// Code generated by the "yardl" tool. DO NOT EDIT.
+
+package testmodel
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/microsoft/yardl/go/generated/basictypes"
+	"github.com/microsoft/yardl/go/generated/image"
+	"github.com/microsoft/yardl/go/generated/yardl"
+)
+
+type SmallBenchmarkRecord struct {
+	A float64
+	B float32
+	C float32
+}
+
+type SimpleEncodingCounters struct {
+	E1         *uint32
+	E2         *uint32
+	Slice      *uint32
+	Repetition *uint32
+}
+
+type SimpleAcquisition struct {
+	Flags      uint64
+	Idx        SimpleEncodingCounters
+	Data       yardl.NDArray[complex64]
+	Trajectory yardl.NDArray[float32]
+}
+
+type SimpleRecord struct {
+	X int32
+	Y int32
+	Z int32
+}
+
+type RecordWithPrimitives struct {
+	BoolField           bool
+	Int8Field           int8
+	Uint8Field          uint8
+	Int16Field          int16
+	Uint16Field         uint16
+	Int32Field          int32
+	Uint32Field         uint32
+	Int64Field          int64
+	Uint64Field         uint64
+	SizeField           uint64
+	Float32Field        float32
+	Float64Field        float64
+	Complexfloat32Field complex64
+	Complexfloat64Field complex128
+	DateField           yardl.Date
+	TimeField           yardl.Time
+	DatetimeField       yardl.DateTime
+}
+
+type RecordWithPrimitiveAliases struct {
+	ByteField          uint8
+	IntField           int32
+	UintField          uint32
+	LongField          int64
+	UlongField         uint64
+	FloatField         float32
+	DoubleField        float64
+	ComplexfloatField  complex64
+	ComplexdoubleField complex128
+}
+
+type TupleWithRecords struct {
+	A SimpleRecord
+	B SimpleRecord
+}
+
+type RecordWithVectors struct {
+	DefaultVector            []int32
+	DefaultVectorFixedLength [3]int32
+	VectorOfVectors          [][2]int32
+}
+
+type RecordWithVectorOfTimes struct {
+	Times []yardl.Time
+}
+
+type RecordWithArrays struct {
+	DefaultArray                       yardl.NDArray[int32]
+	DefaultArrayWithEmptyDimension     yardl.NDArray[int32]
+	Rank1Array                         yardl.NDArray[int32]
+	Rank2Array                         yardl.NDArray[int32]
+	Rank2ArrayWithNamedDimensions      yardl.NDArray[int32]
+	Rank2FixedArray                    [3][4]int32
+	Rank2FixedArrayWithNamedDimensions [3][4]int32
+	DynamicArray                       yardl.NDArray[int32]
+	ArrayOfVectors                     [5][4]int32
+}
+
+type RecordWithArraysSimpleSyntax struct {
+	DefaultArray                       yardl.NDArray[int32]
+	DefaultArrayWithEmptyDimension     yardl.NDArray[int32]
+	Rank1Array                         yardl.NDArray[int32]
+	Rank2Array                         yardl.NDArray[int32]
+	Rank2ArrayWithNamedDimensions      yardl.NDArray[int32]
+	Rank2FixedArray                    [3][4]int32
+	Rank2FixedArrayWithNamedDimensions [3][4]int32
+	DynamicArray                       yardl.NDArray[int32]
+	ArrayOfVectors                     [5][4]int32
+}
+
+type RecordWithOptionalFields struct {
+	OptionalInt                *int32
+	OptionalIntAlternateSyntax *int32
+	OptionalTime               *yardl.Time
+}
+
+type RecordWithVlens struct {
+	A []SimpleRecord
+	B int32
+	C int32
+}
+
+type RecordWithStrings struct {
+	A string
+	B string
+}
+
+type RecordWithBytes struct {
+	Data         []byte
+	OptionalData *[]byte
+	Chunks       [][]byte
+}
+
+type RecordWithUuids struct {
+	Id         yardl.Uuid
+	OptionalId *yardl.Uuid
+	Related    []yardl.Uuid
+	Names      map[yardl.Uuid]string
+}
+
+type RecordWithDurations struct {
+	AcquisitionStart yardl.DateTime
+	AcquisitionEnd   yardl.DateTime
+	RepetitionTime   yardl.Duration
+	Timeout          *yardl.Duration
+	Intervals        []yardl.Duration
+}
+
+type TreeNode struct {
+	Label    string
+	Children []TreeNode
+}
+
+type LinkedListNode struct {
+	Value int32
+	Next  *LinkedListNode
+}
+
+type Int32OrExpression interface {
+	isInt32OrExpression()
+}
+
+type Int32OrExpressionInt32 struct {
+	Value int32
+}
+
+func (Int32OrExpressionInt32) isInt32OrExpression() {}
+
+type Int32OrExpressionExpression struct {
+	Value Expression
+}
+
+func (Int32OrExpressionExpression) isInt32OrExpression() {}
+
+type Expression struct {
+	Name      string
+	Operand   Int32OrExpression
+	Arguments map[string]Expression
+}
+
+type RecordWithHalfPrecision struct {
+	Half       float32
+	Brain      float32
+	HalfVector []float32
+	BrainArray yardl.NDArray[float32]
+}
+
+type RecordWithOptionalVector struct {
+	OptionalVector *[]int32
+}
+
+type RecordWithFixedVectors struct {
+	FixedIntVector             [5]int32
+	FixedSimpleRecordVector    [3]SimpleRecord
+	FixedRecordWithVlensVector [2]RecordWithVlens
+}
+
+type RecordWithFixedArrays struct {
+	Ints                      [2][3]int32
+	FixedSimpleRecordArray    [3][2]SimpleRecord
+	FixedRecordWithVlensArray [2][2]RecordWithVlens
+}
+
+type IntArray = yardl.NDArray[int32]
+
+type IntRank2Array = yardl.NDArray[int32]
+
+type IntFixedArray = [2][3]int32
+
+type SimpleRecordFixedArray = [3][2]SimpleRecord
+
+type RecordWithVlensFixedArray = [2][2]RecordWithVlens
+
+type RecordWithNamedFixedArrays struct {
+	Ints                      IntFixedArray
+	FixedSimpleRecordArray    SimpleRecordFixedArray
+	FixedRecordWithVlensArray RecordWithVlensFixedArray
+}
+
+type RecordWithNDArrays struct {
+	Ints                      yardl.NDArray[int32]
+	FixedSimpleRecordArray    yardl.NDArray[SimpleRecord]
+	FixedRecordWithVlensArray yardl.NDArray[RecordWithVlens]
+}
+
+type RecordWithNDArraysSingleDimension struct {
+	Ints                      yardl.NDArray[int32]
+	FixedSimpleRecordArray    yardl.NDArray[SimpleRecord]
+	FixedRecordWithVlensArray yardl.NDArray[RecordWithVlens]
+}
+
+type RecordWithDynamicNDArrays struct {
+	Ints                 IntArray
+	SimpleRecordArray    yardl.NDArray[SimpleRecord]
+	RecordWithVlensArray yardl.NDArray[RecordWithVlens]
+}
+
+type NamedFixedNDArray = [2][4]int32
+
+type RecordWithFixedCollections struct {
+	FixedVector [3]int32
+	FixedArray  [2][3]int32
+}
+
+type RecordWithVlenCollections struct {
+	Vector []int32
+	Array  yardl.NDArray[int32]
+}
+
+type MapOrScalar interface {
+	isMapOrScalar()
+}
+
+type MapOrScalarMap struct {
+	Value map[string]int32
+}
+
+func (MapOrScalarMap) isMapOrScalar() {}
+
+type MapOrScalarScalar struct {
+	Value int32
+}
+
+func (MapOrScalarScalar) isMapOrScalar() {}
+
+type VectorOrScalar interface {
+	isVectorOrScalar()
+}
+
+type VectorOrScalarVector struct {
+	Value []int32
+}
+
+func (VectorOrScalarVector) isVectorOrScalar() {}
+
+type VectorOrScalarScalar struct {
+	Value int32
+}
+
+func (VectorOrScalarScalar) isVectorOrScalar() {}
+
+type ArrayOrScalar interface {
+	isArrayOrScalar()
+}
+
+type ArrayOrScalarArray struct {
+	Value yardl.NDArray[int32]
+}
+
+func (ArrayOrScalarArray) isArrayOrScalar() {}
+
+type ArrayOrScalarScalar struct {
+	Value int32
+}
+
+func (ArrayOrScalarScalar) isArrayOrScalar() {}
+
+type RecordWithUnionsOfContainers struct {
+	MapOrScalar    MapOrScalar
+	VectorOrScalar VectorOrScalar
+	ArrayOrScalar  ArrayOrScalar
+}
+
+type NamedNDArray = yardl.NDArray[int32]
+
+type StringOrInt32 interface {
+	isStringOrInt32()
+}
+
+type StringOrInt32String struct {
+	Value string
+}
+
+func (StringOrInt32String) isStringOrInt32() {}
+
+type StringOrInt32Int32 struct {
+	Value int32
+}
+
+func (StringOrInt32Int32) isStringOrInt32() {}
+
+type RecordWithMaps struct {
+	Set1 map[uint32]uint32
+	Set2 map[int32]bool
+	Set3 map[string]StringOrInt32
+}
+
+type Fruits = basictypes.Fruits
+
+type UInt64Enum uint64
+
+const (
+	UInt64EnumA UInt64Enum = 9223372036854775808
+)
+
+func (e UInt64Enum) String() string {
+	switch e {
+	case UInt64EnumA:
+		return "a"
+	}
+	return fmt.Sprintf("UInt64Enum(%d)", e)
+}
+
+type Int64Enum int64
+
+const (
+	Int64EnumB Int64Enum = -4611686018427387904
+)
+
+func (e Int64Enum) String() string {
+	switch e {
+	case Int64EnumB:
+		return "b"
+	}
+	return fmt.Sprintf("Int64Enum(%d)", e)
+}
+
+type SizeBasedEnum uint64
+
+const (
+	SizeBasedEnumA SizeBasedEnum = 0
+	SizeBasedEnumB SizeBasedEnum = 1
+	SizeBasedEnumC SizeBasedEnum = 2
+)
+
+func (e SizeBasedEnum) String() string {
+	switch e {
+	case SizeBasedEnumA:
+		return "a"
+	case SizeBasedEnumB:
+		return "b"
+	case SizeBasedEnumC:
+		return "c"
+	}
+	return fmt.Sprintf("SizeBasedEnum(%d)", e)
+}
+
+type DaysOfWeek = basictypes.DaysOfWeek
+
+type TextFormat = basictypes.TextFormat
+
+type RecordWithNoDefaultEnum struct {
+	Enum Fruits
+}
+
+type RecordWithEnums struct {
+	Enum   Fruits
+	Flags  DaysOfWeek
+	Flags2 TextFormat
+	Rec    RecordWithNoDefaultEnum
+}
+
+type Image[T any] = image.Image[T]
+
+type GenericRecord[T1 any, T2 any] struct {
+	Scalar1 T1
+	Scalar2 T2
+	Vector1 []T1
+	Image2  Image[T2]
+}
+
+type MyTuple[T1 any, T2 any] = basictypes.MyTuple[T1, T2]
+
+type AliasedTuple[T1 any, T2 any] = MyTuple[T1, T2]
+
+type RecordWithAliasedGenerics struct {
+	MyStrings      MyTuple[string, string]
+	AliasedStrings AliasedTuple[string, string]
+}
+
+type AliasedString = string
+
+type AliasedEnum = Fruits
+
+type AliasedSimpleRecord = SimpleRecord
+
+type AliasedOpenGeneric[T1 any, T2 any] = AliasedTuple[T1, T2]
+
+type AliasedClosedGeneric = AliasedTuple[AliasedString, AliasedEnum]
+
+type AliasedOptional = *int32
+
+type AliasedGenericOptional[T any] = *T
+
+type AliasedMultiGenericOptional[T any, U any] interface {
+	isAliasedMultiGenericOptional()
+}
+
+type AliasedMultiGenericOptionalT[T any] struct {
+	Value T
+}
+
+func (AliasedMultiGenericOptionalT[T]) isAliasedMultiGenericOptional() {}
+
+type AliasedMultiGenericOptionalU[U any] struct {
+	Value U
+}
+
+func (AliasedMultiGenericOptionalU[U]) isAliasedMultiGenericOptional() {}
+
+type AliasedGenericUnion2[T1 any, T2 any] = basictypes.GenericUnion2[T1, T2]
+
+type AliasedGenericVector[T any] = basictypes.GenericVector[T]
+
+type AliasedGenericFixedVector[T any] = [3]T
+
+type AliasedGenericRank2Array[T any] = yardl.NDArray[T]
+
+type AliasedGenericFixedArray[T any] = [16][8]T
+
+type AliasedGenericDynamicArray[T any] = yardl.NDArray[T]
+
+type AliasedMap[K comparable, V any] = basictypes.AliasedMap[K, V]
+
+type VectorOfGenericRecords[T1 any, T2 any] = []GenericRecord[T1, T2]
+
+type AliasedVectorOfGenericRecords[T1 any, T2 any] = AliasedGenericVector[GenericRecord[T1, T2]]
+
+type RecordWithGenericVectorOfRecords[T any, U any] struct {
+	V []VectorOfGenericRecords[T, U]
+}
+
+type RecordWithOptionalGenericField[T any] struct {
+	V *T
+}
+
+type RecordWithAliasedOptionalGenericField[T any] struct {
+	V AliasedGenericOptional[T]
+}
+
+type UOrV[U any, V any] interface {
+	isUOrV()
+}
+
+type UOrVU[U any] struct {
+	Value U
+}
+
+func (UOrVU[U]) isUOrV() {}
+
+type UOrVV[V any] struct {
+	Value V
+}
+
+func (UOrVV[V]) isUOrV() {}
+
+type RecordWithOptionalGenericUnionField[U any, V any] struct {
+	V UOrV[U, V]
+}
+
+type RecordWithAliasedOptionalGenericUnionField[U any, V any] struct {
+	V AliasedMultiGenericOptional[U, V]
+}
+
+type RecordWithGenericVectors[T any] struct {
+	V  []T
+	Av AliasedGenericVector[T]
+}
+
+type RecordWithGenericFixedVectors[T any] struct {
+	Fv  [3]T
+	Afv AliasedGenericFixedVector[T]
+}
+
+type RecordWithGenericArrays[T any] struct {
+	Nd               yardl.NDArray[T]
+	FixedNd          [16][8]T
+	DynamicNd        yardl.NDArray[T]
+	AliasedNd        AliasedGenericRank2Array[T]
+	AliasedFixedNd   AliasedGenericFixedArray[T]
+	AliasedDynamicNd AliasedGenericDynamicArray[T]
+}
+
+type RecordWithGenericMaps[T comparable, U any] struct {
+	M  map[T]U
+	Am basictypes.AliasedMap[T, U]
+}
+
+type RecordContainingGenericRecords[A comparable, B any] struct {
+	G1  RecordWithOptionalGenericField[A]
+	G1a RecordWithAliasedOptionalGenericField[A]
+	G2  RecordWithOptionalGenericUnionField[A, B]
+	G2a RecordWithAliasedOptionalGenericUnionField[A, B]
+	G3  MyTuple[A, B]
+	G3a AliasedTuple[A, B]
+	G4  RecordWithGenericVectors[B]
+	G5  RecordWithGenericFixedVectors[B]
+	G6  RecordWithGenericArrays[B]
+	G7  RecordWithGenericMaps[A, B]
+}
+
+type RecordContainingNestedGenericRecords struct {
+	F1     RecordWithOptionalGenericField[string]
+	F1a    RecordWithAliasedOptionalGenericField[string]
+	F2     RecordWithOptionalGenericUnionField[string, int32]
+	F2a    RecordWithAliasedOptionalGenericUnionField[string, int32]
+	Nested RecordContainingGenericRecords[string, int32]
+}
+
+type RecordContainingVectorsOfAliases struct {
+	Strings []AliasedString
+	Maps    []AliasedMap[string, int32]
+	Arrays  []Image[float32]
+	Tuples  []MyTuple[int32, SimpleRecord]
+}
+
+type AliasedIntOrSimpleRecord interface {
+	isAliasedIntOrSimpleRecord()
+}
+
+type AliasedIntOrSimpleRecordInt32 struct {
+	Value int32
+}
+
+func (AliasedIntOrSimpleRecordInt32) isAliasedIntOrSimpleRecord() {}
+
+type AliasedIntOrSimpleRecordSimpleRecord struct {
+	Value SimpleRecord
+}
+
+func (AliasedIntOrSimpleRecordSimpleRecord) isAliasedIntOrSimpleRecord() {}
+
+type AliasedIntOrAliasedSimpleRecord interface {
+	isAliasedIntOrAliasedSimpleRecord()
+}
+
+type AliasedIntOrAliasedSimpleRecordInt32 struct {
+	Value int32
+}
+
+func (AliasedIntOrAliasedSimpleRecordInt32) isAliasedIntOrAliasedSimpleRecord() {}
+
+type AliasedIntOrAliasedSimpleRecordAliasedSimpleRecord struct {
+	Value AliasedSimpleRecord
+}
+
+func (AliasedIntOrAliasedSimpleRecordAliasedSimpleRecord) isAliasedIntOrAliasedSimpleRecord() {}
+
+type AliasedNullableIntSimpleRecord interface {
+	isAliasedNullableIntSimpleRecord()
+}
+
+type AliasedNullableIntSimpleRecordInt32 struct {
+	Value int32
+}
+
+func (AliasedNullableIntSimpleRecordInt32) isAliasedNullableIntSimpleRecord() {}
+
+type AliasedNullableIntSimpleRecordSimpleRecord struct {
+	Value SimpleRecord
+}
+
+func (AliasedNullableIntSimpleRecordSimpleRecord) isAliasedNullableIntSimpleRecord() {}
+
+type RecordWithIntVectors = RecordWithGenericVectors[int32]
+
+type RecordWithFloatArrays = RecordWithGenericArrays[float32]
+
+type UnionOfContainerRecords interface {
+	isUnionOfContainerRecords()
+}
+
+type UnionOfContainerRecordsRecordWithIntVectors struct {
+	Value RecordWithIntVectors
+}
+
+func (UnionOfContainerRecordsRecordWithIntVectors) isUnionOfContainerRecords() {}
+
+type UnionOfContainerRecordsRecordWithFloatArrays struct {
+	Value RecordWithFloatArrays
+}
+
+func (UnionOfContainerRecordsRecordWithFloatArrays) isUnionOfContainerRecords() {}
+
+type Int32OrFloat32 interface {
+	isInt32OrFloat32()
+}
+
+type Int32OrFloat32Int32 struct {
+	Value int32
+}
+
+func (Int32OrFloat32Int32) isInt32OrFloat32() {}
+
+type Int32OrFloat32Float32 struct {
+	Value float32
+}
+
+func (Int32OrFloat32Float32) isInt32OrFloat32() {}
+
+type IntOrGenericRecordWithComputedFields interface {
+	isIntOrGenericRecordWithComputedFields()
+}
+
+type IntOrGenericRecordWithComputedFieldsInt struct {
+	Value int32
+}
+
+func (IntOrGenericRecordWithComputedFieldsInt) isIntOrGenericRecordWithComputedFields() {}
+
+type IntOrGenericRecordWithComputedFieldsGenericRecordWithComputedFields struct {
+	Value basictypes.GenericRecordWithComputedFields[string, float32]
+}
+
+func (IntOrGenericRecordWithComputedFieldsGenericRecordWithComputedFields) isIntOrGenericRecordWithComputedFields() {
+}
+
+type RecordWithComputedFields struct {
+	ArrayField                  yardl.NDArray[int32]
+	ArrayFieldMapDimensions     yardl.NDArray[int32]
+	DynamicArrayField           yardl.NDArray[int32]
+	FixedArrayField             [3][4]int32
+	IntField                    int32
+	Int8Field                   int8
+	Uint8Field                  uint8
+	Int16Field                  int16
+	Uint16Field                 uint16
+	Uint32Field                 uint32
+	Int64Field                  int64
+	Uint64Field                 uint64
+	SizeField                   uint64
+	Float32Field                float32
+	Float64Field                float64
+	Complexfloat32Field         complex64
+	Complexfloat64Field         complex128
+	StringField                 string
+	TupleField                  MyTuple[int32, int32]
+	VectorField                 []int32
+	VectorOfVectorsField        [][]int32
+	FixedVectorField            [3]int32
+	FixedVectorOfVectorsField   [2][3]int32
+	OptionalNamedArray          *NamedNDArray
+	IntFloatUnion               Int32OrFloat32
+	NullableIntFloatUnion       Int32OrFloat32
+	UnionWithNestedGenericUnion IntOrGenericRecordWithComputedFields
+	MapField                    map[string]string
+}
+
+type RecordWithDefaults struct {
+	IntField            int32
+	Int8Field           int8
+	Uint64Field         uint64
+	Float32Field        float32
+	Float64Field        float64
+	Complexfloat64Field complex128
+	BoolField           bool
+	StringField         string
+	EnumField           Fruits
+	NoDefaultField      int32
+}
+
+type RecordWithConstraints struct {
+	Size    uint32
+	Gain    float32
+	Name    string
+	Limit   *int32
+	Samples []int32
+}
+
+var recordWithConstraintsNamePattern = regexp.MustCompile("^(?:[a-z][a-z0-9_]*)$")
+
+// Validate returns an error if a field does not satisfy its constraints.
+func (r *RecordWithConstraints) Validate() error {
+	if r.Size < 1 {
+		return errors.New("RecordWithConstraints.size must be at least 1")
+	}
+	if r.Size > 4096 {
+		return errors.New("RecordWithConstraints.size must be at most 4096")
+	}
+	if r.Gain < 0.0 {
+		return errors.New("RecordWithConstraints.gain must be at least 0.0")
+	}
+	if r.Gain > 2.5 {
+		return errors.New("RecordWithConstraints.gain must be at most 2.5")
+	}
+	if !recordWithConstraintsNamePattern.MatchString(r.Name) {
+		return errors.New("RecordWithConstraints.name must match the pattern '[a-z][a-z0-9_]*'")
+	}
+	if r.Limit != nil && *r.Limit > 10 {
+		return errors.New("RecordWithConstraints.limit must be at most 10")
+	}
+	if len(r.Samples) < 1 {
+		return errors.New("RecordWithConstraints.samples must have a length of at least 1")
+	}
+	if len(r.Samples) > 4 {
+		return errors.New("RecordWithConstraints.samples must have a length of at most 4")
+	}
+	return nil
+}
+
+type RecordWithPatterns struct {
+	Alternation string
+	Word        string
+	Code        string
+	Escaped     string
+	Capitalized string
+}
+
+var recordWithPatternsAlternationPattern = regexp.MustCompile("^(?:a|ab)$")
+
+var recordWithPatternsWordPattern = regexp.MustCompile("^(?:\\w+)$")
+
+var recordWithPatternsCodePattern = regexp.MustCompile("^(?:\\d{2,3}(?:\\-\\d{2})?)$")
+
+var recordWithPatternsEscapedPattern = regexp.MustCompile("^(?:\\(\\.\\)[\\-+*])$")
+
+var recordWithPatternsCapitalizedPattern = regexp.MustCompile("^(?:[A-Z][a-z]*)$")
+
+// Validate returns an error if a field does not satisfy its constraints.
+func (r *RecordWithPatterns) Validate() error {
+	if !recordWithPatternsAlternationPattern.MatchString(r.Alternation) {
+		return errors.New("RecordWithPatterns.alternation must match the pattern 'a|ab'")
+	}
+	if !recordWithPatternsWordPattern.MatchString(r.Word) {
+		return errors.New("RecordWithPatterns.word must match the pattern '\\w+'")
+	}
+	if !recordWithPatternsCodePattern.MatchString(r.Code) {
+		return errors.New("RecordWithPatterns.code must match the pattern '\\d{2,3}(?:\\-\\d{2})?'")
+	}
+	if !recordWithPatternsEscapedPattern.MatchString(r.Escaped) {
+		return errors.New("RecordWithPatterns.escaped must match the pattern '\\(\\.\\)[\\-+*]'")
+	}
+	if !recordWithPatternsCapitalizedPattern.MatchString(r.Capitalized) {
+		return errors.New("RecordWithPatterns.capitalized must match the pattern '[A-Z][a-z]*'")
+	}
+	return nil
+}
+
+type RecordWithConstrainedRecords struct {
+	Single   RecordWithConstraints
+	Optional *RecordWithConstraints
+	Vector   []RecordWithConstraints
+}
+
+// Validate returns an error if a field does not satisfy its constraints.
+func (r *RecordWithConstrainedRecords) Validate() error {
+	if err := r.Single.Validate(); err != nil {
+		return err
+	}
+	if r.Optional != nil {
+		if err := r.Optional.Validate(); err != nil {
+			return err
+		}
+	}
+	for i0 := range r.Vector {
+		if err := r.Vector[i0].Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type GenericUnionWithRepeatedTypeParameters[T any] interface {
+	isGenericUnionWithRepeatedTypeParameters()
+}
+
+type GenericUnionWithRepeatedTypeParametersT[T any] struct {
+	Value T
+}
+
+func (GenericUnionWithRepeatedTypeParametersT[T]) isGenericUnionWithRepeatedTypeParameters() {}
+
+type GenericUnionWithRepeatedTypeParametersTv[T any] struct {
+	Value []T
+}
+
+func (GenericUnionWithRepeatedTypeParametersTv[T]) isGenericUnionWithRepeatedTypeParameters() {}
+
+type GenericUnionWithRepeatedTypeParametersTa[T any] struct {
+	Value yardl.NDArray[T]
+}
+
+func (GenericUnionWithRepeatedTypeParametersTa[T]) isGenericUnionWithRepeatedTypeParameters() {}
+
+type GenericUnion3[T any, U any, V any] interface {
+	isGenericUnion3()
+}
+
+type GenericUnion3T[T any] struct {
+	Value T
+}
+
+func (GenericUnion3T[T]) isGenericUnion3() {}
+
+type GenericUnion3U[U any] struct {
+	Value U
+}
+
+func (GenericUnion3U[U]) isGenericUnion3() {}
+
+type GenericUnion3V[V any] struct {
+	Value V
+}
+
+func (GenericUnion3V[V]) isGenericUnion3() {}
+
+type GenericUnion3Alternate[U any, V any, W any] interface {
+	isGenericUnion3Alternate()
+}
+
+type GenericUnion3AlternateU[U any] struct {
+	Value U
+}
+
+func (GenericUnion3AlternateU[U]) isGenericUnion3Alternate() {}
+
+type GenericUnion3AlternateV[V any] struct {
+	Value V
+}
+
+func (GenericUnion3AlternateV[V]) isGenericUnion3Alternate() {}
+
+type GenericUnion3AlternateW[W any] struct {
+	Value W
+}
+
+func (GenericUnion3AlternateW[W]) isGenericUnion3Alternate() {}
+
+type RecordNotUsedInProtocol struct {
+	U1 GenericUnion3[int32, float32, string]
+	U2 GenericUnion3Alternate[int32, float32, string]
+}
+
+type ArrayWithKeywordDimensionNames = yardl.NDArray[int32]
+
+type EnumWithKeywordSymbols int32
+
+const (
+	EnumWithKeywordSymbolsTry   EnumWithKeywordSymbols = 2
+	EnumWithKeywordSymbolsCatch EnumWithKeywordSymbols = 1
+)
+
+func (e EnumWithKeywordSymbols) String() string {
+	switch e {
+	case EnumWithKeywordSymbolsTry:
+		return "try"
+	case EnumWithKeywordSymbolsCatch:
+		return "catch"
+	}
+	return fmt.Sprintf("EnumWithKeywordSymbols(%d)", e)
+}
+
+// This comment ends with a Python docstring character"
+type RecordWithKeywordFields struct {
+	Int    string
+	Sizeof ArrayWithKeywordDimensionNames
+	If     EnumWithKeywordSymbols
+}
+
+type RecordWithOptionalDate struct {
+	DateField *yardl.Date
+}
+
+type RecordWithConstants struct {
+	Channels [4]int32
+	Samples  [4][8]float32
+	Gain     float32
+}
+
+// A common header
+type RecordHeader struct {
+	Version uint32
+	Name    string
+}
+
+type LabeledHeader[T any] struct {
+	Label T
+}
+
+type RecordWithBase struct {
+	Version uint32
+	Name    string
+	Data    []float32
+}
+
+type RecordWithBases struct {
+	Version uint32
+	Name    string
+	Label   string
+	Value   int32
+}
+
+// A slice with user-defined annotations
+type RecordWithAnnotations struct {
+	Thickness float32
+	Spacing   float32
+	Label     string
+}
+
+type EnumWithAnnotations int32
+
+const (
+	EnumWithAnnotationsA EnumWithAnnotations = 0
+	EnumWithAnnotationsB EnumWithAnnotations = 1
+)
+
+func (e EnumWithAnnotations) String() string {
+	switch e {
+	case EnumWithAnnotationsA:
+		return "a"
+	case EnumWithAnnotationsB:
+		return "b"
+	}
+	return fmt.Sprintf("EnumWithAnnotations(%d)", e)
+}
+
+type Millimeters = float32
+
+type RecordWithUnits struct {
+	Length    float32
+	Width     Millimeters
+	Durations []float64
+}
+
+type AcquisitionOrImage interface {
+	isAcquisitionOrImage()
+}
+
+type AcquisitionOrImageAcquisition struct {
+	Value SimpleAcquisition
+}
+
+func (AcquisitionOrImageAcquisition) isAcquisitionOrImage() {}
+
+type AcquisitionOrImageImage struct {
+	Value image.Image[float32]
+}
+
+func (AcquisitionOrImageImage) isAcquisitionOrImage() {}
+
+type Int32OrSimpleRecord interface {
+	isInt32OrSimpleRecord()
+}
+
+type Int32OrSimpleRecordInt32 struct {
+	Value int32
+}
+
+func (Int32OrSimpleRecordInt32) isInt32OrSimpleRecord() {}
+
+type Int32OrSimpleRecordSimpleRecord struct {
+	Value SimpleRecord
+}
+
+func (Int32OrSimpleRecordSimpleRecord) isInt32OrSimpleRecord() {}
+
+type Int32OrRecordWithVlens interface {
+	isInt32OrRecordWithVlens()
+}
+
+type Int32OrRecordWithVlensInt32 struct {
+	Value int32
+}
+
+func (Int32OrRecordWithVlensInt32) isInt32OrRecordWithVlens() {}
+
+type Int32OrRecordWithVlensRecordWithVlens struct {
+	Value RecordWithVlens
+}
+
+func (Int32OrRecordWithVlensRecordWithVlens) isInt32OrRecordWithVlens() {}
+
+type Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray interface {
+	isInt32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray()
+}
+
+type Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArrayInt32 struct {
+	Value int32
+}
+
+func (Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArrayInt32) isInt32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray() {
+}
+
+type Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArrayFloat32 struct {
+	Value float32
+}
+
+func (Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArrayFloat32) isInt32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray() {
+}
+
+type Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArrayString struct {
+	Value string
+}
+
+func (Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArrayString) isInt32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray() {
+}
+
+type Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArraySimpleRecord struct {
+	Value SimpleRecord
+}
+
+func (Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArraySimpleRecord) isInt32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray() {
+}
+
+type Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArrayNamedFixedNDArray struct {
+	Value NamedFixedNDArray
+}
+
+func (Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArrayNamedFixedNDArray) isInt32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray() {
+}
+
+type ImageFloatOrImageDouble interface {
+	isImageFloatOrImageDouble()
+}
+
+type ImageFloatOrImageDoubleImageFloat struct {
+	Value image.FloatImage
+}
+
+func (ImageFloatOrImageDoubleImageFloat) isImageFloatOrImageDouble() {}
+
+type ImageFloatOrImageDoubleImageDouble struct {
+	Value Image[float64]
+}
+
+func (ImageFloatOrImageDoubleImageDouble) isImageFloatOrImageDouble() {}
+
+type Float32OrString interface {
+	isFloat32OrString()
+}
+
+type Float32OrStringFloat32 struct {
+	Value float32
+}
+
+func (Float32OrStringFloat32) isFloat32OrString() {}
+
+type Float32OrStringString struct {
+	Value string
+}
+
+func (Float32OrStringString) isFloat32OrString() {}
+
+type Complexfloat32OrString interface {
+	isComplexfloat32OrString()
+}
+
+type Complexfloat32OrStringComplexfloat32 struct {
+	Value complex64
+}
+
+func (Complexfloat32OrStringComplexfloat32) isComplexfloat32OrString() {}
+
+type Complexfloat32OrStringString struct {
+	Value string
+}
+
+func (Complexfloat32OrStringString) isComplexfloat32OrString() {}
@@ -0,0 +1,3 @@
+module github.com/microsoft/yardl/go
+
+go 1.24
@@ -0,0 +1,99 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/microsoft/yardl/go/generated/testmodel"
+)
+
+func TestFieldConstraints(t *testing.T) {
+	valid := testmodel.RecordWithConstraints{Size: 1, Gain: 2.5, Name: "abc_1", Samples: []int32{1}}
+	if err := valid.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	limit := int32(11)
+	changes := []func(r *testmodel.RecordWithConstraints){
+		func(r *testmodel.RecordWithConstraints) { r.Size = 0 },
+		func(r *testmodel.RecordWithConstraints) { r.Size = 4097 },
+		func(r *testmodel.RecordWithConstraints) { r.Gain = -0.5 },
+		func(r *testmodel.RecordWithConstraints) { r.Name = "Abc" },
+		func(r *testmodel.RecordWithConstraints) { r.Name = "abc!" },
+		func(r *testmodel.RecordWithConstraints) { r.Limit = &limit },
+		func(r *testmodel.RecordWithConstraints) { r.Samples = []int32{} },
+		func(r *testmodel.RecordWithConstraints) { r.Samples = []int32{1, 2, 3, 4, 5} },
+	}
+	for i, change := range changes {
+		r := valid
+		change(&r)
+		if r.Validate() == nil {
+			t.Errorf("change %d: expected a validation error", i)
+		}
+	}
+
+	outer := testmodel.RecordWithConstrainedRecords{Single: valid, Vector: []testmodel.RecordWithConstraints{valid, valid}}
+	if err := outer.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	outer.Vector[1].Size = 0
+	if outer.Validate() == nil {
+		t.Error("expected a validation error for a vector item")
+	}
+	invalid := valid
+	invalid.Name = ""
+	outer.Vector = nil
+	outer.Optional = &invalid
+	if outer.Validate() == nil {
+		t.Error("expected a validation error for the optional record")
+	}
+}
+
+// The same cases are tested in every language, since each uses its own regular
+// expression library.
+func TestFieldPatterns(t *testing.T) {
+	valid := testmodel.RecordWithPatterns{Alternation: "a", Word: "w", Code: "12", Escaped: "(.)+", Capitalized: "A"}
+	if err := valid.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		field   func(r *testmodel.RecordWithPatterns) *string
+		value   string
+		matches bool
+	}{
+		{alternation, "ab", true},
+		{alternation, "abc", false},
+		{alternation, "", false},
+		{alternation, "a\n", false},
+		{word, "abc_1", true},
+		{word, "é", false},
+		{word, "a b", false},
+		{code, "123-45", true},
+		{code, "1234", false},
+		{code, "12-4", false},
+		{code, "١٢", false},
+		{escaped, "(.)-", true},
+		{escaped, "(.)*", true},
+		{escaped, "(x)-", false},
+		{capitalized, "Abc", true},
+		{capitalized, "abc", false},
+		{capitalized, "ABC", false},
+	}
+
+	for _, c := range cases {
+		r := valid
+		*c.field(&r) = c.value
+		if err := r.Validate(); (err == nil) != c.matches {
+			t.Errorf("%q: expected a match to be %v but validation returned %v", c.value, c.matches, err)
+		}
+	}
+}
+
+func alternation(r *testmodel.RecordWithPatterns) *string { return &r.Alternation }
+func word(r *testmodel.RecordWithPatterns) *string        { return &r.Word }
+func code(r *testmodel.RecordWithPatterns) *string        { return &r.Code }
+func escaped(r *testmodel.RecordWithPatterns) *string     { return &r.Escaped }
+func capitalized(r *testmodel.RecordWithPatterns) *string { return &r.Capitalized }
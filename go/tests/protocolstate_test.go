@@ -0,0 +1,59 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+package tests
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/microsoft/yardl/go/generated/testmodel"
+	"github.com/microsoft/yardl/go/generated/testmodel/binary"
+	"github.com/microsoft/yardl/go/generated/yardl"
+)
+
+func expectProtocolError(t *testing.T, err error, expected, received string) {
+	t.Helper()
+	var protocolErr *yardl.ProtocolError
+	if !errors.As(err, &protocolErr) {
+		t.Fatalf("expected a protocol error, got %v", err)
+	}
+	if protocolErr.Expected != expected || protocolErr.Received != received {
+		t.Fatalf("expected call to %q but received call to %q, got %v", expected, received, err)
+	}
+}
+
+func TestWriterProtocolState(t *testing.T) {
+	var buf bytes.Buffer
+	w := binary.NewStreamsWriter(&buf)
+	expectProtocolError(t, w.EndOptionalIntData(), "WriteIntData() or EndIntData()", "EndOptionalIntData()")
+	expectProtocolError(t, w.Close(), "WriteIntData() or EndIntData()", "Close()")
+	check(t, w.EndIntData())
+	expectProtocolError(t, w.WriteIntData(1), "WriteOptionalIntData() or EndOptionalIntData()", "WriteIntData()")
+
+	s := binary.NewScalarsWriter(&buf)
+	expectProtocolError(t, s.WriteRecord(testmodel.RecordWithPrimitives{}), "WriteInt32()", "WriteRecord()")
+	check(t, s.WriteInt32(1))
+	check(t, s.WriteRecord(testmodel.RecordWithPrimitives{}))
+	expectProtocolError(t, s.WriteInt32(2), "Close()", "WriteInt32()")
+}
+
+func TestReaderProtocolState(t *testing.T) {
+	var buf bytes.Buffer
+	w := binary.NewScalarsWriter(&buf)
+	check(t, w.WriteInt32(1))
+	check(t, w.WriteRecord(testmodel.RecordWithPrimitives{}))
+	check(t, w.Close())
+
+	r, err := binary.NewScalarsReader(&buf)
+	check(t, err)
+	_, err = r.ReadRecord()
+	expectProtocolError(t, err, "ReadInt32()", "ReadRecord()")
+	expectProtocolError(t, r.Close(), "ReadInt32()", "Close()")
+	expectValue(t, int32(1))(r.ReadInt32())
+	expectValue(t, testmodel.RecordWithPrimitives{})(r.ReadRecord())
+	_, err = r.ReadInt32()
+	expectProtocolError(t, err, "Close()", "ReadInt32()")
+	check(t, r.Close())
+}
@@ -0,0 +1,534 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+package tests
+
+import (
+	"bytes"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/microsoft/yardl/go/generated/basictypes"
+	"github.com/microsoft/yardl/go/generated/image"
+	"github.com/microsoft/yardl/go/generated/testmodel"
+	"github.com/microsoft/yardl/go/generated/testmodel/binary"
+	"github.com/microsoft/yardl/go/generated/tuples"
+	"github.com/microsoft/yardl/go/generated/yardl"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestScalars(t *testing.T) {
+	rec := testmodel.RecordWithPrimitives{
+		BoolField:           true,
+		Int8Field:           -88,
+		Uint8Field:          88,
+		Int16Field:          -1616,
+		Uint16Field:         1616,
+		Int32Field:          -3232,
+		Uint32Field:         3232,
+		Int64Field:          -64646464,
+		Uint64Field:         64646464,
+		SizeField:           64646464,
+		Float32Field:        32.0,
+		Float64Field:        64.64,
+		Complexfloat32Field: complex(32.0, 64.0),
+		Complexfloat64Field: complex(64.64, 32.32),
+		DateField:           yardl.DateFromTime(time.Date(2024, 4, 2, 0, 0, 0, 0, time.UTC)),
+		TimeField:           yardl.NewTime(12, 34, 56, 0),
+		DatetimeField:       yardl.DateTimeFromTime(time.Date(2024, 4, 2, 12, 34, 56, 111222333, time.UTC)),
+	}
+
+	var buf bytes.Buffer
+	w := binary.NewScalarsWriter(&buf)
+	check(t, w.WriteInt32(42))
+	check(t, w.WriteRecord(rec))
+	check(t, w.Close())
+
+	verifyRoundTrip(t, buf.Bytes(), binary.NewScalarsReader, func(t *testing.T, r *binary.ScalarsReader) {
+		expectValue(t, int32(42))(r.ReadInt32())
+		expectValue(t, rec)(r.ReadRecord())
+	})
+}
+
+func TestScalarOptionals(t *testing.T) {
+	t.Run("NoValue", func(t *testing.T) {
+		var buf bytes.Buffer
+		w := binary.NewScalarOptionalsWriter(&buf)
+		check(t, w.WriteOptionalInt(nil))
+		check(t, w.WriteOptionalRecord(nil))
+		check(t, w.WriteRecordWithOptionalFields(testmodel.RecordWithOptionalFields{}))
+		check(t, w.WriteOptionalRecordWithOptionalFields(nil))
+		check(t, w.Close())
+
+		verifyRoundTrip(t, buf.Bytes(), binary.NewScalarOptionalsReader, func(t *testing.T, r *binary.ScalarOptionalsReader) {
+			expectValue[*int32](t, nil)(r.ReadOptionalInt())
+			expectValue[*testmodel.SimpleRecord](t, nil)(r.ReadOptionalRecord())
+			expectValue(t, testmodel.RecordWithOptionalFields{})(r.ReadRecordWithOptionalFields())
+			expectValue[*testmodel.RecordWithOptionalFields](t, nil)(r.ReadOptionalRecordWithOptionalFields())
+		})
+	})
+
+	t.Run("WithValue", func(t *testing.T) {
+		rec := testmodel.RecordWithOptionalFields{
+			OptionalInt:                ptr(int32(44)),
+			OptionalIntAlternateSyntax: ptr(int32(45)),
+			OptionalTime:               ptr(yardl.NewTime(12, 34, 56, 0)),
+		}
+
+		var buf bytes.Buffer
+		w := binary.NewScalarOptionalsWriter(&buf)
+		check(t, w.WriteOptionalInt(ptr(int32(55))))
+		check(t, w.WriteOptionalRecord(&testmodel.SimpleRecord{X: 8, Y: 9, Z: 10}))
+		check(t, w.WriteRecordWithOptionalFields(rec))
+		check(t, w.WriteOptionalRecordWithOptionalFields(&rec))
+		check(t, w.Close())
+
+		verifyRoundTrip(t, buf.Bytes(), binary.NewScalarOptionalsReader, func(t *testing.T, r *binary.ScalarOptionalsReader) {
+			expectValue(t, ptr(int32(55)))(r.ReadOptionalInt())
+			expectValue(t, &testmodel.SimpleRecord{X: 8, Y: 9, Z: 10})(r.ReadOptionalRecord())
+			expectValue(t, rec)(r.ReadRecordWithOptionalFields())
+			expectValue(t, &rec)(r.ReadOptionalRecordWithOptionalFields())
+		})
+	})
+}
+
+func TestVariableLengthVectors(t *testing.T) {
+	rec := testmodel.RecordWithVlens{
+		A: []testmodel.SimpleRecord{{X: 1, Y: 2, Z: 3}, {X: 4, Y: 5, Z: 6}},
+		B: 4,
+		C: 2,
+	}
+
+	var buf bytes.Buffer
+	w := binary.NewVlensWriter(&buf)
+	check(t, w.WriteIntVector([]int32{1, 2, 3}))
+	check(t, w.WriteComplexVector([]complex64{complex(1, 2), complex(3, 4)}))
+	check(t, w.WriteRecordWithVlens(rec))
+	check(t, w.WriteVlenOfRecordWithVlens([]testmodel.RecordWithVlens{rec, rec}))
+	check(t, w.Close())
+
+	verifyRoundTrip(t, buf.Bytes(), binary.NewVlensReader, func(t *testing.T, r *binary.VlensReader) {
+		expectValue(t, []int32{1, 2, 3})(r.ReadIntVector())
+		expectValue(t, []complex64{complex(1, 2), complex(3, 4)})(r.ReadComplexVector())
+		expectValue(t, rec)(r.ReadRecordWithVlens())
+		expectValue(t, []testmodel.RecordWithVlens{rec, rec})(r.ReadVlenOfRecordWithVlens())
+	})
+}
+
+func TestStrings(t *testing.T) {
+	rec := testmodel.RecordWithStrings{A: "Montréal", B: "臺北市"}
+
+	var buf bytes.Buffer
+	w := binary.NewStringsWriter(&buf)
+	check(t, w.WriteSingleString("hello"))
+	check(t, w.WriteRecWithString(rec))
+	check(t, w.Close())
+
+	verifyRoundTrip(t, buf.Bytes(), binary.NewStringsReader, func(t *testing.T, r *binary.StringsReader) {
+		expectValue(t, "hello")(r.ReadSingleString())
+		expectValue(t, rec)(r.ReadRecWithString())
+	})
+}
+
+func TestBytes(t *testing.T) {
+	rec := testmodel.RecordWithBytes{
+		Data:         []byte{0x00, 0xff, 0x10},
+		OptionalData: ptr([]byte("abc")),
+		Chunks:       [][]byte{{0x01}, {0x02, 0x03}},
+	}
+
+	var buf bytes.Buffer
+	w := binary.NewProtocolWithBytesWriter(&buf)
+	check(t, w.WriteSingleBytes([]byte{0xde, 0xad, 0xbe, 0xef}))
+	check(t, w.WriteRecWithBytes(rec))
+	check(t, w.Close())
+
+	verifyRoundTrip(t, buf.Bytes(), binary.NewProtocolWithBytesReader, func(t *testing.T, r *binary.ProtocolWithBytesReader) {
+		expectValue(t, []byte{0xde, 0xad, 0xbe, 0xef})(r.ReadSingleBytes())
+		expectValue(t, rec)(r.ReadRecWithBytes())
+	})
+}
+
+func TestUuids(t *testing.T) {
+	id, err := yardl.ParseUuid("123e4567-e89b-12d3-a456-426614174000")
+	check(t, err)
+	other, err := yardl.ParseUuid("00000000-0000-0000-0000-0000000000ff")
+	check(t, err)
+
+	rec := testmodel.RecordWithUuids{
+		Id:         id,
+		OptionalId: &other,
+		Related:    []yardl.Uuid{other, id},
+		Names:      map[yardl.Uuid]string{id: "first", other: "second"},
+	}
+
+	var buf bytes.Buffer
+	w := binary.NewProtocolWithUuidsWriter(&buf)
+	check(t, w.WriteSingleUuid(id))
+	check(t, w.WriteRecWithUuids(rec))
+	check(t, w.Close())
+
+	verifyRoundTrip(t, buf.Bytes(), binary.NewProtocolWithUuidsReader, func(t *testing.T, r *binary.ProtocolWithUuidsReader) {
+		expectValue(t, id)(r.ReadSingleUuid())
+		expectValue(t, rec)(r.ReadRecWithUuids())
+	})
+}
+
+func TestDurations(t *testing.T) {
+	start := yardl.DateTimeFromTime(time.Unix(1700000000, 0))
+	rec := testmodel.RecordWithDurations{
+		AcquisitionStart: start,
+		AcquisitionEnd:   start + yardl.DateTime(2500*time.Millisecond),
+		RepetitionTime:   3 * time.Millisecond,
+		Timeout:          ptr(-time.Hour),
+		Intervals:        []yardl.Duration{1, 0, -30 * time.Second},
+	}
+
+	var buf bytes.Buffer
+	w := binary.NewProtocolWithDurationsWriter(&buf)
+	check(t, w.WriteSingleDuration(-1500*time.Millisecond))
+	check(t, w.WriteRecWithDurations(rec))
+	check(t, w.Close())
+
+	verifyRoundTrip(t, buf.Bytes(), binary.NewProtocolWithDurationsReader, func(t *testing.T, r *binary.ProtocolWithDurationsReader) {
+		expectValue(t, -1500*time.Millisecond)(r.ReadSingleDuration())
+		expectValue(t, rec)(r.ReadRecWithDurations())
+	})
+}
+
+func TestRecursiveRecords(t *testing.T) {
+	// Empty vectors and maps are read back as empty, not nil
+	leaf := testmodel.TreeNode{Label: "leaf", Children: []testmodel.TreeNode{}}
+	tree := testmodel.TreeNode{
+		Label: "root",
+		Children: []testmodel.TreeNode{
+			leaf,
+			{Label: "branch", Children: []testmodel.TreeNode{leaf}},
+		},
+	}
+	list := testmodel.LinkedListNode{Value: 1, Next: &testmodel.LinkedListNode{Value: 2}}
+	expression := testmodel.Expression{
+		Name: "add",
+		Operand: testmodel.Int32OrExpressionExpression{Value: testmodel.Expression{
+			Name:      "neg",
+			Operand:   testmodel.Int32OrExpressionInt32{Value: 3},
+			Arguments: map[string]testmodel.Expression{},
+		}},
+		Arguments: map[string]testmodel.Expression{
+			"x": {Name: "x", Operand: testmodel.Int32OrExpressionInt32{Value: 4}, Arguments: map[string]testmodel.Expression{}},
+		},
+	}
+
+	var buf bytes.Buffer
+	w := binary.NewProtocolWithRecursiveRecordsWriter(&buf)
+	check(t, w.WriteTree(tree))
+	check(t, w.WriteList(list))
+	check(t, w.WriteExpressions(expression))
+	check(t, w.EndExpressions())
+	check(t, w.Close())
+
+	verifyRoundTrip(t, buf.Bytes(), binary.NewProtocolWithRecursiveRecordsReader, func(t *testing.T, r *binary.ProtocolWithRecursiveRecordsReader) {
+		expectValue(t, tree)(r.ReadTree())
+		expectValue(t, list)(r.ReadList())
+		expectStream(t, r.ReadExpressions, expression)
+	})
+}
+
+func TestSubProtocols(t *testing.T) {
+	var buf bytes.Buffer
+	w := binary.NewProtocolWithSubProtocolsWriter(&buf)
+	check(t, w.WriteHeader("header"))
+	calibration := w.Calibration()
+	check(t, calibration.WriteGain(1.5))
+	check(t, calibration.WriteSamples(1.0, 2.0))
+	check(t, calibration.WriteSamples(3.0))
+	check(t, calibration.EndSamples())
+	check(t, calibration.Close())
+	check(t, w.WriteData(1, 2, 3))
+	check(t, w.EndData())
+	check(t, w.WriteRecalibrationGain(2.5))
+	check(t, w.EndRecalibrationSamples())
+	check(t, w.Close())
+
+	verifyRoundTrip(t, buf.Bytes(), binary.NewProtocolWithSubProtocolsReader, func(t *testing.T, r *binary.ProtocolWithSubProtocolsReader) {
+		expectValue(t, "header")(r.ReadHeader())
+		calibration := r.Calibration()
+		expectValue(t, float32(1.5))(calibration.ReadGain())
+		expectStream(t, calibration.ReadSamples, 1.0, 2.0, 3.0)
+		check(t, calibration.Close())
+		expectStream(t, r.ReadData, 1, 2, 3)
+		expectValue(t, float32(2.5))(r.ReadRecalibrationGain())
+		expectStream(t, r.ReadRecalibrationSamples)
+	})
+}
+
+func TestHalfPrecision(t *testing.T) {
+	halves := []float32{1.5, -0.25, 65504.0, float32(math.Inf(1))}
+	rec := testmodel.RecordWithHalfPrecision{
+		Half:       6.103515625e-05,
+		Brain:      float32(-math.Ldexp(1, 100)),
+		HalfVector: []float32{0.0, -2.0},
+		BrainArray: yardl.NDArray[float32]{Shape: []int{2, 3}, Data: []float32{1.0, 2.5, 1024.0, -0.5, 0.0, 7.0}},
+	}
+
+	var buf bytes.Buffer
+	w := binary.NewProtocolWithHalfPrecisionWriter(&buf)
+	check(t, w.WriteHalves(halves))
+	check(t, w.WriteRecWithHalves(rec))
+	check(t, w.Close())
+
+	// JSON has no representation of infinity, so this does not go through NDJSON
+	data := buf.Bytes()
+	for name, input := range map[string][]byte{"Go": data, "C++": invokeTranslator(t, data, "binary", "binary")} {
+		t.Run(name, func(t *testing.T) {
+			r, err := binary.NewProtocolWithHalfPrecisionReader(bytes.NewReader(input))
+			check(t, err)
+			expectValue(t, halves)(r.ReadHalves())
+			expectValue(t, rec)(r.ReadRecWithHalves())
+			check(t, r.Close())
+		})
+	}
+}
+
+func TestFixedVectors(t *testing.T) {
+	vlens := testmodel.RecordWithVlens{A: []testmodel.SimpleRecord{{X: 1, Y: 2, Z: 3}}, B: 5, C: 6}
+	rec := testmodel.RecordWithFixedVectors{
+		FixedIntVector:             [5]int32{1, 2, 3, 4, 5},
+		FixedSimpleRecordVector:    [3]testmodel.SimpleRecord{{X: 1, Y: 2, Z: 3}, {X: 4, Y: 5, Z: 6}, {X: 7, Y: 8, Z: 9}},
+		FixedRecordWithVlensVector: [2]testmodel.RecordWithVlens{vlens, vlens},
+	}
+
+	var buf bytes.Buffer
+	w := binary.NewFixedVectorsWriter(&buf)
+	check(t, w.WriteFixedIntVector(rec.FixedIntVector))
+	check(t, w.WriteFixedSimpleRecordVector(rec.FixedSimpleRecordVector))
+	check(t, w.WriteFixedRecordWithVlensVector(rec.FixedRecordWithVlensVector))
+	check(t, w.WriteRecordWithFixedVectors(rec))
+	check(t, w.Close())
+
+	verifyRoundTrip(t, buf.Bytes(), binary.NewFixedVectorsReader, func(t *testing.T, r *binary.FixedVectorsReader) {
+		expectValue(t, rec.FixedIntVector)(r.ReadFixedIntVector())
+		expectValue(t, rec.FixedSimpleRecordVector)(r.ReadFixedSimpleRecordVector())
+		expectValue(t, rec.FixedRecordWithVlensVector)(r.ReadFixedRecordWithVlensVector())
+		expectValue(t, rec)(r.ReadRecordWithFixedVectors())
+	})
+}
+
+func TestStreams(t *testing.T) {
+	optionalVector := testmodel.RecordWithOptionalVector{OptionalVector: ptr([]int32{1, 2, 3})}
+
+	var buf bytes.Buffer
+	w := binary.NewStreamsWriter(&buf)
+	check(t, w.WriteIntData(1, 2))
+	check(t, w.WriteIntData(3, 4, 5))
+	check(t, w.EndIntData())
+	check(t, w.WriteOptionalIntData(ptr(int32(1)), nil, ptr(int32(3))))
+	check(t, w.EndOptionalIntData())
+	check(t, w.WriteRecordWithOptionalVectorData(testmodel.RecordWithOptionalVector{}, optionalVector))
+	check(t, w.EndRecordWithOptionalVectorData())
+	check(t, w.EndFixedVector())
+	check(t, w.Close())
+
+	verifyRoundTrip(t, buf.Bytes(), binary.NewStreamsReader, func(t *testing.T, r *binary.StreamsReader) {
+		expectStream(t, r.ReadIntData, 1, 2, 3, 4, 5)
+		expectStream(t, r.ReadOptionalIntData, ptr(int32(1)), nil, ptr(int32(3)))
+		expectStream(t, r.ReadRecordWithOptionalVectorData, testmodel.RecordWithOptionalVector{}, optionalVector)
+		expectStream(t, r.ReadFixedVector)
+	})
+}
+
+func TestDynamicNDArrays(t *testing.T) {
+	ints := yardl.NDArray[int32]{Shape: []int{2, 3}, Data: []int32{1, 2, 3, 4, 5, 6}}
+	records := yardl.NDArray[testmodel.SimpleRecord]{Shape: []int{1, 2}, Data: []testmodel.SimpleRecord{{X: 1, Y: 2, Z: 3}, {X: 4, Y: 5, Z: 6}}}
+	vlens := yardl.NDArray[testmodel.RecordWithVlens]{Shape: []int{1, 1}, Data: []testmodel.RecordWithVlens{{A: []testmodel.SimpleRecord{{X: 1, Y: 2, Z: 3}}, B: 4, C: 5}}}
+	rec := testmodel.RecordWithDynamicNDArrays{Ints: ints, SimpleRecordArray: records, RecordWithVlensArray: vlens}
+
+	var buf bytes.Buffer
+	w := binary.NewDynamicNDArraysWriter(&buf)
+	check(t, w.WriteInts(ints))
+	check(t, w.WriteSimpleRecordArray(records))
+	check(t, w.WriteRecordWithVlensArray(vlens))
+	check(t, w.WriteRecordWithDynamicNDArrays(rec))
+	check(t, w.Close())
+
+	verifyRoundTrip(t, buf.Bytes(), binary.NewDynamicNDArraysReader, func(t *testing.T, r *binary.DynamicNDArraysReader) {
+		expectValue(t, ints)(r.ReadInts())
+		expectValue(t, records)(r.ReadSimpleRecordArray())
+		expectValue(t, vlens)(r.ReadRecordWithVlensArray())
+		expectValue(t, rec)(r.ReadRecordWithDynamicNDArrays())
+	})
+}
+
+func TestMaps(t *testing.T) {
+	stringToInt := map[string]int32{"a": 1, "b": 2, "c": 3}
+	intToString := map[int32]string{1: "a", 2: "b", 3: "c"}
+	stringToUnion := map[string]testmodel.StringOrInt32{
+		"a": testmodel.StringOrInt32Int32{Value: 1},
+		"b": testmodel.StringOrInt32String{Value: "2"},
+	}
+	records := []testmodel.RecordWithMaps{{
+		Set1: map[uint32]uint32{1: 1, 2: 2},
+		Set2: map[int32]bool{-1: true, 3: false},
+		Set3: map[string]testmodel.StringOrInt32{"x": testmodel.StringOrInt32String{Value: "y"}},
+	}}
+
+	var buf bytes.Buffer
+	w := binary.NewMapsWriter(&buf)
+	check(t, w.WriteStringToInt(stringToInt))
+	check(t, w.WriteIntToString(intToString))
+	check(t, w.WriteStringToUnion(stringToUnion))
+	check(t, w.WriteAliasedGeneric(basictypes.AliasedMap[string, int32](stringToInt)))
+	check(t, w.WriteRecords(records))
+	check(t, w.Close())
+
+	verifyRoundTrip(t, buf.Bytes(), binary.NewMapsReader, func(t *testing.T, r *binary.MapsReader) {
+		expectValue(t, stringToInt)(r.ReadStringToInt())
+		expectValue(t, intToString)(r.ReadIntToString())
+		expectValue(t, stringToUnion)(r.ReadStringToUnion())
+		expectValue(t, basictypes.AliasedMap[string, int32](stringToInt))(r.ReadAliasedGeneric())
+		expectValue(t, records)(r.ReadRecords())
+	})
+}
+
+func TestUnions(t *testing.T) {
+	recordWithUnions := basictypes.RecordWithUnions{
+		NullOrIntOrString:        basictypes.Int32OrStringString{Value: "hello"},
+		DateOrDatetime:           basictypes.TimeOrDatetimeDatetime{Value: yardl.DateTimeFromTime(time.Date(2024, 4, 2, 12, 34, 56, 0, time.UTC))},
+		NullOrFruitsOrDaysOfWeek: basictypes.GenericNullableUnion2T2[basictypes.DaysOfWeek]{Value: basictypes.DaysOfWeekMonday | basictypes.DaysOfWeekFriday},
+		RecordOrInt:              basictypes.RecordWithStringOrInt32RecordWithString{Value: basictypes.RecordWithString{I: "i"}},
+	}
+	vectorOfUnions := []testmodel.StringOrInt32{testmodel.StringOrInt32String{Value: "hello"}, testmodel.StringOrInt32Int32{Value: 3}}
+
+	var buf bytes.Buffer
+	w := binary.NewUnionsWriter(&buf)
+	check(t, w.WriteIntOrSimpleRecord(testmodel.Int32OrSimpleRecordInt32{Value: 1}))
+	check(t, w.WriteIntOrRecordWithVlens(testmodel.Int32OrRecordWithVlensRecordWithVlens{Value: testmodel.RecordWithVlens{A: []testmodel.SimpleRecord{}, B: 1, C: 2}}))
+	check(t, w.WriteMonosotateOrIntOrSimpleRecord(nil))
+	check(t, w.WriteVectorOfUnions(vectorOfUnions))
+	check(t, w.WriteRecordWithUnions(recordWithUnions))
+	check(t, w.Close())
+
+	verifyRoundTrip(t, buf.Bytes(), binary.NewUnionsReader, func(t *testing.T, r *binary.UnionsReader) {
+		expectValue[testmodel.Int32OrSimpleRecord](t, testmodel.Int32OrSimpleRecordInt32{Value: 1})(r.ReadIntOrSimpleRecord())
+		expectValue[testmodel.Int32OrRecordWithVlens](t, testmodel.Int32OrRecordWithVlensRecordWithVlens{Value: testmodel.RecordWithVlens{A: []testmodel.SimpleRecord{}, B: 1, C: 2}})(r.ReadIntOrRecordWithVlens())
+		expectValue[testmodel.Int32OrSimpleRecord](t, nil)(r.ReadMonosotateOrIntOrSimpleRecord())
+		expectValue(t, vectorOfUnions)(r.ReadVectorOfUnions())
+		expectValue(t, recordWithUnions)(r.ReadRecordWithUnions())
+	})
+}
+
+func TestStreamsOfUnions(t *testing.T) {
+	intOrSimpleRecord := []testmodel.Int32OrSimpleRecord{
+		testmodel.Int32OrSimpleRecordInt32{Value: 1},
+		testmodel.Int32OrSimpleRecordSimpleRecord{Value: testmodel.SimpleRecord{X: 1, Y: 2, Z: 3}},
+	}
+	nullable := []testmodel.Int32OrSimpleRecord{nil, testmodel.Int32OrSimpleRecordInt32{Value: 2}, nil}
+
+	var buf bytes.Buffer
+	w := binary.NewStreamsOfUnionsWriter(&buf)
+	check(t, w.WriteIntOrSimpleRecord(intOrSimpleRecord...))
+	check(t, w.EndIntOrSimpleRecord())
+	check(t, w.WriteNullableIntOrSimpleRecord(nullable...))
+	check(t, w.EndNullableIntOrSimpleRecord())
+	check(t, w.EndManyCases())
+	check(t, w.Close())
+
+	verifyRoundTrip(t, buf.Bytes(), binary.NewStreamsOfUnionsReader, func(t *testing.T, r *binary.StreamsOfUnionsReader) {
+		expectStream(t, r.ReadIntOrSimpleRecord, intOrSimpleRecord...)
+		expectStream(t, r.ReadNullableIntOrSimpleRecord, nullable...)
+		expectStream(t, r.ReadManyCases)
+	})
+}
+
+func TestEnums(t *testing.T) {
+	rec := testmodel.RecordWithEnums{
+		Enum:   basictypes.FruitsBanana,
+		Flags:  basictypes.DaysOfWeekMonday | basictypes.DaysOfWeekSunday,
+		Flags2: basictypes.TextFormatBold,
+		Rec:    testmodel.RecordWithNoDefaultEnum{Enum: basictypes.FruitsPear},
+	}
+
+	var buf bytes.Buffer
+	w := binary.NewEnumsWriter(&buf)
+	check(t, w.WriteSingle(basictypes.FruitsApple))
+	check(t, w.WriteVec([]testmodel.Fruits{basictypes.FruitsApple, basictypes.FruitsBanana, 233}))
+	check(t, w.WriteSize(testmodel.SizeBasedEnumC))
+	check(t, w.WriteRec(rec))
+	check(t, w.Close())
+
+	verifyRoundTrip(t, buf.Bytes(), binary.NewEnumsReader, func(t *testing.T, r *binary.EnumsReader) {
+		expectValue(t, basictypes.FruitsApple)(r.ReadSingle())
+		expectValue(t, []testmodel.Fruits{basictypes.FruitsApple, basictypes.FruitsBanana, 233})(r.ReadVec())
+		expectValue(t, testmodel.SizeBasedEnumC)(r.ReadSize())
+		expectValue(t, rec)(r.ReadRec())
+	})
+}
+
+func TestFlags(t *testing.T) {
+	days := []testmodel.DaysOfWeek{
+		basictypes.DaysOfWeekSunday,
+		basictypes.DaysOfWeekMonday | basictypes.DaysOfWeekWednesday | basictypes.DaysOfWeekFriday,
+		0,
+		282839,
+		234532,
+	}
+	formats := []testmodel.TextFormat{
+		basictypes.TextFormatBold,
+		basictypes.TextFormatBold | basictypes.TextFormatItalic,
+		basictypes.TextFormatRegular,
+		232932,
+	}
+
+	var buf bytes.Buffer
+	w := binary.NewFlagsWriter(&buf)
+	check(t, w.WriteDays(days...))
+	check(t, w.EndDays())
+	check(t, w.WriteFormats(formats...))
+	check(t, w.EndFormats())
+	check(t, w.Close())
+
+	verifyRoundTrip(t, buf.Bytes(), binary.NewFlagsReader, func(t *testing.T, r *binary.FlagsReader) {
+		expectStream(t, r.ReadDays, days...)
+		expectStream(t, r.ReadFormats, formats...)
+	})
+}
+
+func TestSimpleGenerics(t *testing.T) {
+	floatImage := image.FloatImage{Shape: []int{2, 2}, Data: []float32{1, 2, 3, 4}}
+	intImage := image.IntImage{Shape: []int{1, 2}, Data: []int32{1, 2}}
+	stringImage := testmodel.Image[string]{Shape: []int{1, 2}, Data: []string{"a", "b"}}
+	variants := []testmodel.ImageFloatOrImageDouble{
+		testmodel.ImageFloatOrImageDoubleImageFloat{Value: floatImage},
+		testmodel.ImageFloatOrImageDoubleImageDouble{Value: testmodel.Image[float64]{Shape: []int{1, 1}, Data: []float64{5}}},
+	}
+
+	var buf bytes.Buffer
+	w := binary.NewSimpleGenericsWriter(&buf)
+	check(t, w.WriteFloatImage(floatImage))
+	check(t, w.WriteIntImage(intImage))
+	check(t, w.WriteIntImageAlternateSyntax(intImage))
+	check(t, w.WriteStringImage(stringImage))
+	check(t, w.WriteIntFloatTuple(tuples.Tuple[int32, float32]{V1: 1, V2: 2}))
+	check(t, w.WriteFloatFloatTuple(tuples.Tuple[float32, float32]{V1: 1, V2: 2}))
+	check(t, w.WriteIntFloatTupleAlternateSyntax(tuples.Tuple[int32, float32]{V1: 1, V2: 2}))
+	check(t, w.WriteIntStringTuple(tuples.Tuple[int32, string]{V1: 1, V2: "2"}))
+	check(t, w.WriteStreamOfTypeVariants(variants...))
+	check(t, w.EndStreamOfTypeVariants())
+	check(t, w.Close())
+
+	verifyRoundTrip(t, buf.Bytes(), binary.NewSimpleGenericsReader, func(t *testing.T, r *binary.SimpleGenericsReader) {
+		expectValue(t, floatImage)(r.ReadFloatImage())
+		expectValue(t, intImage)(r.ReadIntImage())
+		expectValue(t, intImage)(r.ReadIntImageAlternateSyntax())
+		expectValue(t, stringImage)(r.ReadStringImage())
+		expectValue(t, tuples.Tuple[int32, float32]{V1: 1, V2: 2})(r.ReadIntFloatTuple())
+		expectValue(t, tuples.Tuple[float32, float32]{V1: 1, V2: 2})(r.ReadFloatFloatTuple())
+		expectValue(t, tuples.Tuple[int32, float32]{V1: 1, V2: 2})(r.ReadIntFloatTupleAlternateSyntax())
+		expectValue(t, tuples.Tuple[int32, string]{V1: 1, V2: "2"})(r.ReadIntStringTuple())
+		expectStream(t, r.ReadStreamOfTypeVariants, variants...)
+	})
+}
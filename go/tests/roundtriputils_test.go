@@ -0,0 +1,108 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+package tests
+
+import (
+	"bytes"
+	"io"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+var translatorPath = filepath.Join("..", "..", "cpp", "build", "translator")
+
+// invokeTranslator runs the C++ translator, which reads the input in one format
+// and writes it in another.
+func invokeTranslator(t *testing.T, input []byte, inputFormat, outputFormat string) []byte {
+	t.Helper()
+	cmd := exec.Command(translatorPath, inputFormat, outputFormat)
+	cmd.Stdin = bytes.NewReader(input)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	output, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("translator failed (run `just build-translator` to build it): %v\n%s", err, stderr.String())
+	}
+	return output
+}
+
+type reader interface {
+	Close() error
+}
+
+// verifyRoundTrip calls read with the binary data written by Go, and with the data
+// after the C++ translator has read it and written it again, both directly
+// and through NDJSON.
+func verifyRoundTrip[R reader](t *testing.T, data []byte, newReader func(io.Reader) (R, error), read func(t *testing.T, r R)) {
+	t.Helper()
+	inputs := []struct {
+		name string
+		data []byte
+	}{
+		{"Go", data},
+		{"C++", invokeTranslator(t, data, "binary", "binary")},
+		{"C++ NDJSON", invokeTranslator(t, invokeTranslator(t, data, "binary", "ndjson"), "ndjson", "binary")},
+	}
+
+	for _, input := range inputs {
+		t.Run(input.name, func(t *testing.T) {
+			r, err := newReader(bytes.NewReader(input.data))
+			if err != nil {
+				t.Fatal(err)
+			}
+			read(t, r)
+			if err := r.Close(); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+// expectValue returns a function that takes the results of reading a protocol
+// step and compares the value to the expected one.
+func expectValue[T any](t *testing.T, expected T) func(T, error) {
+	t.Helper()
+	return func(actual T, err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(expected, actual) {
+			t.Errorf("expected %#v but read %#v", expected, actual)
+		}
+	}
+}
+
+// expectStream reads a protocol stream to its end and compares the items to
+// the expected ones.
+func expectStream[T any](t *testing.T, read func() (T, bool, error), expected ...T) {
+	t.Helper()
+	var actual []T
+	for {
+		item, ok, err := read()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !ok {
+			break
+		}
+		actual = append(actual, item)
+	}
+	if len(expected) == 0 && len(actual) == 0 {
+		return
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected stream %#v but read %#v", expected, actual)
+	}
+}
+
+// check fails the test if writing a protocol step failed.
+func check(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
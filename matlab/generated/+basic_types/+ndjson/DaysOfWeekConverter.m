@@ -0,0 +1,11 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef DaysOfWeekConverter < yardl.ndjson.FlagsConverter
+  methods
+    function self = DaysOfWeekConverter()
+      symbols = ["monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"];
+      values = [basic_types.DaysOfWeek.MONDAY, basic_types.DaysOfWeek.TUESDAY, basic_types.DaysOfWeek.WEDNESDAY, basic_types.DaysOfWeek.THURSDAY, basic_types.DaysOfWeek.FRIDAY, basic_types.DaysOfWeek.SATURDAY, basic_types.DaysOfWeek.SUNDAY];
+      self@yardl.ndjson.FlagsConverter('basic_types.DaysOfWeek', @basic_types.DaysOfWeek, yardl.ndjson.Int32Converter, symbols, values);
+    end
+  end
+end
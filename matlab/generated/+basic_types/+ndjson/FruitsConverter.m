@@ -0,0 +1,11 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef FruitsConverter < yardl.ndjson.EnumConverter
+  methods
+    function self = FruitsConverter()
+      symbols = ["apple", "banana", "pear"];
+      values = [basic_types.Fruits.APPLE, basic_types.Fruits.BANANA, basic_types.Fruits.PEAR];
+      self@yardl.ndjson.EnumConverter('basic_types.Fruits', @basic_types.Fruits, yardl.ndjson.Int32Converter, symbols, values);
+    end
+  end
+end
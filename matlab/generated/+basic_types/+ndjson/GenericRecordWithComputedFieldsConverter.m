@@ -0,0 +1,23 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef GenericRecordWithComputedFieldsConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = GenericRecordWithComputedFieldsConverter(t0_converter, t1_converter)
+      field_converters{1} = yardl.ndjson.UnionConverter('basic_types.T0OrT1', {t0_converter, t1_converter}, {@basic_types.T0OrT1.T0, @basic_types.T0OrT1.T1}, ["T0", "T1"], {["object"], ["object"]}, false);
+      self@yardl.ndjson.RecordConverter('basic_types.GenericRecordWithComputedFields', ["f1"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) basic_types.GenericRecordWithComputedFields
+      end
+      json = self.to_json_(value.f1);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = basic_types.GenericRecordWithComputedFields(f1=fields{1});
+    end
+  end
+end
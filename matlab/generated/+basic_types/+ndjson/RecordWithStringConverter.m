@@ -0,0 +1,23 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithStringConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = RecordWithStringConverter()
+      field_converters{1} = yardl.ndjson.StringConverter;
+      self@yardl.ndjson.RecordConverter('basic_types.RecordWithString', ["i"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) basic_types.RecordWithString
+      end
+      json = self.to_json_(value.i);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = basic_types.RecordWithString(i=fields{1});
+    end
+  end
+end
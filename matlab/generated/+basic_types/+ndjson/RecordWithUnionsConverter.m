@@ -0,0 +1,26 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithUnionsConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = RecordWithUnionsConverter()
+      field_converters{1} = yardl.ndjson.UnionConverter('basic_types.Int32OrString', {yardl.ndjson.NoneConverter, yardl.ndjson.Int32Converter, yardl.ndjson.StringConverter}, {yardl.None, @basic_types.Int32OrString.Int32, @basic_types.Int32OrString.String}, ["", "int32", "string"], {["null"], ["number"], ["string"]}, true);
+      field_converters{2} = yardl.ndjson.UnionConverter('basic_types.TimeOrDatetime', {yardl.ndjson.TimeConverter, yardl.ndjson.DatetimeConverter}, {@basic_types.TimeOrDatetime.Time, @basic_types.TimeOrDatetime.Datetime}, ["time", "datetime"], {["number"], ["number"]}, false);
+      field_converters{3} = yardl.ndjson.UnionConverter('basic_types.GenericNullableUnion2', {yardl.ndjson.NoneConverter, basic_types.ndjson.FruitsConverter(), basic_types.ndjson.DaysOfWeekConverter()}, {yardl.None, @basic_types.GenericNullableUnion2.T1, @basic_types.GenericNullableUnion2.T2}, ["", "T1", "T2"], {["null"], ["number", "string"], ["array"]}, true);
+      field_converters{4} = yardl.ndjson.UnionConverter('basic_types.RecordWithStringOrInt32', {basic_types.ndjson.RecordWithStringConverter(), yardl.ndjson.Int32Converter}, {@basic_types.RecordWithStringOrInt32.RecordWithString, @basic_types.RecordWithStringOrInt32.Int32}, ["RecordWithString", "int32"], {["object"], ["number"]}, true);
+      self@yardl.ndjson.RecordConverter('basic_types.RecordWithUnions', ["nullOrIntOrString", "dateOrDatetime", "nullOrFruitsOrDaysOfWeek", "recordOrInt"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) basic_types.RecordWithUnions
+      end
+      json = self.to_json_(value.null_or_int_or_string, value.date_or_datetime, value.null_or_fruits_or_days_of_week, value.record_or_int);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = basic_types.RecordWithUnions(null_or_int_or_string=fields{1}, date_or_datetime=fields{2}, null_or_fruits_or_days_of_week=fields{3}, record_or_int=fields{4});
+    end
+  end
+end
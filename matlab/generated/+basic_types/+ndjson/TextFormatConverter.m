@@ -0,0 +1,11 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef TextFormatConverter < yardl.ndjson.FlagsConverter
+  methods
+    function self = TextFormatConverter()
+      symbols = ["regular", "bold", "italic", "underline", "strikethrough"];
+      values = [basic_types.TextFormat.REGULAR, basic_types.TextFormat.BOLD, basic_types.TextFormat.ITALIC, basic_types.TextFormat.UNDERLINE, basic_types.TextFormat.STRIKETHROUGH];
+      self@yardl.ndjson.FlagsConverter('basic_types.TextFormat', @basic_types.TextFormat, yardl.ndjson.Uint64Converter, symbols, values);
+    end
+  end
+end
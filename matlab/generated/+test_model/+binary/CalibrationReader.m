@@ -0,0 +1,37 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef CalibrationReader < yardl.binary.BinaryProtocolReader & test_model.CalibrationReaderBase
+  % Binary reader for the Calibration protocol
+  % A calibration phase shared by protocols
+  properties (Access=protected)
+    gain_serializer
+    samples_serializer
+  end
+
+  methods
+    function self = CalibrationReader(filename, options)
+      arguments
+        filename (1,1) string
+        options.skip_completed_check (1,1) logical = false
+      end
+      self@test_model.CalibrationReaderBase(skip_completed_check=options.skip_completed_check);
+      self@yardl.binary.BinaryProtocolReader(filename, test_model.CalibrationReaderBase.schema);
+      self.gain_serializer = yardl.binary.Float32Serializer;
+      self.samples_serializer = yardl.binary.StreamSerializer(yardl.binary.Float64Serializer);
+    end
+  end
+
+  methods (Access=protected)
+    function value = read_gain_(self)
+      value = self.gain_serializer.read(self.stream_);
+    end
+
+    function more = has_samples_(self)
+      more = self.samples_serializer.hasnext(self.stream_);
+    end
+
+    function value = read_samples_(self)
+      value = self.samples_serializer.read(self.stream_);
+    end
+  end
+end
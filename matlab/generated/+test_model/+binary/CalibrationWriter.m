@@ -0,0 +1,29 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef CalibrationWriter < yardl.binary.BinaryProtocolWriter & test_model.CalibrationWriterBase
+  % Binary writer for the Calibration protocol
+  % A calibration phase shared by protocols
+  properties (Access=protected)
+    gain_serializer
+    samples_serializer
+  end
+
+  methods
+    function self = CalibrationWriter(filename)
+      self@test_model.CalibrationWriterBase();
+      self@yardl.binary.BinaryProtocolWriter(filename, test_model.CalibrationWriterBase.schema);
+      self.gain_serializer = yardl.binary.Float32Serializer;
+      self.samples_serializer = yardl.binary.StreamSerializer(yardl.binary.Float64Serializer);
+    end
+  end
+
+  methods (Access=protected)
+    function write_gain_(self, value)
+      self.gain_serializer.write(self.stream_, value);
+    end
+
+    function write_samples_(self, value)
+      self.samples_serializer.write(self.stream_, value);
+    end
+  end
+end
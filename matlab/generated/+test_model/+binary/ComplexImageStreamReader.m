@@ -0,0 +1,43 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef ComplexImageStreamReader < yardl.binary.BinaryProtocolReader & test_model.ComplexImageStreamReaderBase
+  % Binary reader for the ComplexImageStream protocol
+  % A protocol that is instantiated for each pixel type
+  properties (Access=protected)
+    header_serializer
+    images_serializer
+    background_serializer
+  end
+
+  methods
+    function self = ComplexImageStreamReader(filename, options)
+      arguments
+        filename (1,1) string
+        options.skip_completed_check (1,1) logical = false
+      end
+      self@test_model.ComplexImageStreamReaderBase(skip_completed_check=options.skip_completed_check);
+      self@yardl.binary.BinaryProtocolReader(filename, test_model.ComplexImageStreamReaderBase.schema);
+      self.header_serializer = test_model.binary.GenericRecordSerializer(yardl.binary.Int32Serializer, yardl.binary.Complexfloat32Serializer);
+      self.images_serializer = yardl.binary.StreamSerializer(yardl.binary.NDArraySerializer(yardl.binary.Complexfloat32Serializer, 2));
+      self.background_serializer = yardl.binary.UnionSerializer('test_model.Complexfloat32OrString', {yardl.binary.Complexfloat32Serializer, yardl.binary.StringSerializer}, {@test_model.Complexfloat32OrString.Complexfloat32, @test_model.Complexfloat32OrString.String});
+    end
+  end
+
+  methods (Access=protected)
+    function value = read_header_(self)
+      value = self.header_serializer.read(self.stream_);
+    end
+
+    function more = has_images_(self)
+      more = self.images_serializer.hasnext(self.stream_);
+    end
+
+    function value = read_images_(self)
+      value = self.images_serializer.read(self.stream_);
+    end
+
+    function value = read_background_(self)
+      value = self.background_serializer.read(self.stream_);
+    end
+  end
+end
@@ -0,0 +1,26 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef ExpressionSerializer < yardl.binary.RecordSerializer
+  methods
+    function self = ExpressionSerializer()
+      field_serializers{1} = yardl.binary.StringSerializer;
+      field_serializers{2} = yardl.binary.UnionSerializer('test_model.Int32OrExpression', {yardl.binary.Int32Serializer, yardl.binary.RecursiveSerializer('test_model.Expression', @() test_model.binary.ExpressionSerializer())}, {@test_model.Int32OrExpression.Int32, @test_model.Int32OrExpression.Expression});
+      field_serializers{3} = yardl.binary.MapSerializer(yardl.binary.StringSerializer, yardl.binary.RecursiveSerializer('test_model.Expression', @() test_model.binary.ExpressionSerializer()));
+      self@yardl.binary.RecordSerializer('test_model.Expression', field_serializers);
+    end
+
+    function write(self, outstream, value)
+      arguments
+        self
+        outstream (1,1) yardl.binary.CodedOutputStream
+        value (1,1) test_model.Expression
+      end
+      self.write_(outstream, value.name, value.operand, value.arguments);
+    end
+
+    function value = read(self, instream)
+      fields = self.read_(instream);
+      value = test_model.Expression(name=fields{1}, operand=fields{2}, arguments=fields{3});
+    end
+  end
+end
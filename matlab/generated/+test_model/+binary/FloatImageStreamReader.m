@@ -0,0 +1,43 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef FloatImageStreamReader < yardl.binary.BinaryProtocolReader & test_model.FloatImageStreamReaderBase
+  % Binary reader for the FloatImageStream protocol
+  % A protocol that is instantiated for each pixel type
+  properties (Access=protected)
+    header_serializer
+    images_serializer
+    background_serializer
+  end
+
+  methods
+    function self = FloatImageStreamReader(filename, options)
+      arguments
+        filename (1,1) string
+        options.skip_completed_check (1,1) logical = false
+      end
+      self@test_model.FloatImageStreamReaderBase(skip_completed_check=options.skip_completed_check);
+      self@yardl.binary.BinaryProtocolReader(filename, test_model.FloatImageStreamReaderBase.schema);
+      self.header_serializer = test_model.binary.GenericRecordSerializer(yardl.binary.Int32Serializer, yardl.binary.Float32Serializer);
+      self.images_serializer = yardl.binary.StreamSerializer(yardl.binary.NDArraySerializer(yardl.binary.Float32Serializer, 2));
+      self.background_serializer = yardl.binary.UnionSerializer('test_model.Float32OrString', {yardl.binary.Float32Serializer, yardl.binary.StringSerializer}, {@test_model.Float32OrString.Float32, @test_model.Float32OrString.String});
+    end
+  end
+
+  methods (Access=protected)
+    function value = read_header_(self)
+      value = self.header_serializer.read(self.stream_);
+    end
+
+    function more = has_images_(self)
+      more = self.images_serializer.hasnext(self.stream_);
+    end
+
+    function value = read_images_(self)
+      value = self.images_serializer.read(self.stream_);
+    end
+
+    function value = read_background_(self)
+      value = self.background_serializer.read(self.stream_);
+    end
+  end
+end
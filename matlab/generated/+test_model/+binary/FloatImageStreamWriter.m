@@ -0,0 +1,35 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef FloatImageStreamWriter < yardl.binary.BinaryProtocolWriter & test_model.FloatImageStreamWriterBase
+  % Binary writer for the FloatImageStream protocol
+  % A protocol that is instantiated for each pixel type
+  properties (Access=protected)
+    header_serializer
+    images_serializer
+    background_serializer
+  end
+
+  methods
+    function self = FloatImageStreamWriter(filename)
+      self@test_model.FloatImageStreamWriterBase();
+      self@yardl.binary.BinaryProtocolWriter(filename, test_model.FloatImageStreamWriterBase.schema);
+      self.header_serializer = test_model.binary.GenericRecordSerializer(yardl.binary.Int32Serializer, yardl.binary.Float32Serializer);
+      self.images_serializer = yardl.binary.StreamSerializer(yardl.binary.NDArraySerializer(yardl.binary.Float32Serializer, 2));
+      self.background_serializer = yardl.binary.UnionSerializer('test_model.Float32OrString', {yardl.binary.Float32Serializer, yardl.binary.StringSerializer}, {@test_model.Float32OrString.Float32, @test_model.Float32OrString.String});
+    end
+  end
+
+  methods (Access=protected)
+    function write_header_(self, value)
+      self.header_serializer.write(self.stream_, value);
+    end
+
+    function write_images_(self, value)
+      self.images_serializer.write(self.stream_, value);
+    end
+
+    function write_background_(self, value)
+      self.background_serializer.write(self.stream_, value);
+    end
+  end
+end
@@ -0,0 +1,24 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef LabeledHeaderSerializer < yardl.binary.RecordSerializer
+  methods
+    function self = LabeledHeaderSerializer(t_serializer)
+      field_serializers{1} = t_serializer;
+      self@yardl.binary.RecordSerializer('test_model.LabeledHeader', field_serializers);
+    end
+
+    function write(self, outstream, value)
+      arguments
+        self
+        outstream (1,1) yardl.binary.CodedOutputStream
+        value (1,1) test_model.LabeledHeader
+      end
+      self.write_(outstream, value.label);
+    end
+
+    function value = read(self, instream)
+      fields = self.read_(instream);
+      value = test_model.LabeledHeader(label=fields{1});
+    end
+  end
+end
@@ -0,0 +1,25 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef LinkedListNodeSerializer < yardl.binary.RecordSerializer
+  methods
+    function self = LinkedListNodeSerializer()
+      field_serializers{1} = yardl.binary.Int32Serializer;
+      field_serializers{2} = yardl.binary.OptionalSerializer(yardl.binary.RecursiveSerializer('test_model.LinkedListNode', @() test_model.binary.LinkedListNodeSerializer()));
+      self@yardl.binary.RecordSerializer('test_model.LinkedListNode', field_serializers);
+    end
+
+    function write(self, outstream, value)
+      arguments
+        self
+        outstream (1,1) yardl.binary.CodedOutputStream
+        value (1,1) test_model.LinkedListNode
+      end
+      self.write_(outstream, value.value, value.next);
+    end
+
+    function value = read(self, instream)
+      fields = self.read_(instream);
+      value = test_model.LinkedListNode(value=fields{1}, next=fields{2});
+    end
+  end
+end
@@ -0,0 +1,32 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef ProtocolWithBytesReader < yardl.binary.BinaryProtocolReader & test_model.ProtocolWithBytesReaderBase
+  % Binary reader for the ProtocolWithBytes protocol
+  properties (Access=protected)
+    single_bytes_serializer
+    rec_with_bytes_serializer
+  end
+
+  methods
+    function self = ProtocolWithBytesReader(filename, options)
+      arguments
+        filename (1,1) string
+        options.skip_completed_check (1,1) logical = false
+      end
+      self@test_model.ProtocolWithBytesReaderBase(skip_completed_check=options.skip_completed_check);
+      self@yardl.binary.BinaryProtocolReader(filename, test_model.ProtocolWithBytesReaderBase.schema);
+      self.single_bytes_serializer = yardl.binary.BytesSerializer;
+      self.rec_with_bytes_serializer = test_model.binary.RecordWithBytesSerializer();
+    end
+  end
+
+  methods (Access=protected)
+    function value = read_single_bytes_(self)
+      value = self.single_bytes_serializer.read(self.stream_);
+    end
+
+    function value = read_rec_with_bytes_(self)
+      value = self.rec_with_bytes_serializer.read(self.stream_);
+    end
+  end
+end
@@ -0,0 +1,28 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef ProtocolWithBytesWriter < yardl.binary.BinaryProtocolWriter & test_model.ProtocolWithBytesWriterBase
+  % Binary writer for the ProtocolWithBytes protocol
+  properties (Access=protected)
+    single_bytes_serializer
+    rec_with_bytes_serializer
+  end
+
+  methods
+    function self = ProtocolWithBytesWriter(filename)
+      self@test_model.ProtocolWithBytesWriterBase();
+      self@yardl.binary.BinaryProtocolWriter(filename, test_model.ProtocolWithBytesWriterBase.schema);
+      self.single_bytes_serializer = yardl.binary.BytesSerializer;
+      self.rec_with_bytes_serializer = test_model.binary.RecordWithBytesSerializer();
+    end
+  end
+
+  methods (Access=protected)
+    function write_single_bytes_(self, value)
+      self.single_bytes_serializer.write(self.stream_, value);
+    end
+
+    function write_rec_with_bytes_(self, value)
+      self.rec_with_bytes_serializer.write(self.stream_, value);
+    end
+  end
+end
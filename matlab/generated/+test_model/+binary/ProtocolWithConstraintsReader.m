@@ -0,0 +1,36 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef ProtocolWithConstraintsReader < yardl.binary.BinaryProtocolReader & test_model.ProtocolWithConstraintsReaderBase
+  % Binary reader for the ProtocolWithConstraints protocol
+  properties (Access=protected)
+    record_serializer
+    records_serializer
+  end
+
+  methods
+    function self = ProtocolWithConstraintsReader(filename, options)
+      arguments
+        filename (1,1) string
+        options.skip_completed_check (1,1) logical = false
+      end
+      self@test_model.ProtocolWithConstraintsReaderBase(skip_completed_check=options.skip_completed_check);
+      self@yardl.binary.BinaryProtocolReader(filename, test_model.ProtocolWithConstraintsReaderBase.schema);
+      self.record_serializer = test_model.binary.RecordWithConstraintsSerializer();
+      self.records_serializer = yardl.binary.StreamSerializer(test_model.binary.RecordWithConstrainedRecordsSerializer());
+    end
+  end
+
+  methods (Access=protected)
+    function value = read_record_(self)
+      value = self.record_serializer.read(self.stream_);
+    end
+
+    function more = has_records_(self)
+      more = self.records_serializer.hasnext(self.stream_);
+    end
+
+    function value = read_records_(self)
+      value = self.records_serializer.read(self.stream_);
+    end
+  end
+end
@@ -0,0 +1,28 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef ProtocolWithConstraintsWriter < yardl.binary.BinaryProtocolWriter & test_model.ProtocolWithConstraintsWriterBase
+  % Binary writer for the ProtocolWithConstraints protocol
+  properties (Access=protected)
+    record_serializer
+    records_serializer
+  end
+
+  methods
+    function self = ProtocolWithConstraintsWriter(filename)
+      self@test_model.ProtocolWithConstraintsWriterBase();
+      self@yardl.binary.BinaryProtocolWriter(filename, test_model.ProtocolWithConstraintsWriterBase.schema);
+      self.record_serializer = test_model.binary.RecordWithConstraintsSerializer();
+      self.records_serializer = yardl.binary.StreamSerializer(test_model.binary.RecordWithConstrainedRecordsSerializer());
+    end
+  end
+
+  methods (Access=protected)
+    function write_record_(self, value)
+      self.record_serializer.write(self.stream_, value);
+    end
+
+    function write_records_(self, value)
+      self.records_serializer.write(self.stream_, value);
+    end
+  end
+end
@@ -0,0 +1,32 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef ProtocolWithDurationsReader < yardl.binary.BinaryProtocolReader & test_model.ProtocolWithDurationsReaderBase
+  % Binary reader for the ProtocolWithDurations protocol
+  properties (Access=protected)
+    single_duration_serializer
+    rec_with_durations_serializer
+  end
+
+  methods
+    function self = ProtocolWithDurationsReader(filename, options)
+      arguments
+        filename (1,1) string
+        options.skip_completed_check (1,1) logical = false
+      end
+      self@test_model.ProtocolWithDurationsReaderBase(skip_completed_check=options.skip_completed_check);
+      self@yardl.binary.BinaryProtocolReader(filename, test_model.ProtocolWithDurationsReaderBase.schema);
+      self.single_duration_serializer = yardl.binary.DurationSerializer;
+      self.rec_with_durations_serializer = test_model.binary.RecordWithDurationsSerializer();
+    end
+  end
+
+  methods (Access=protected)
+    function value = read_single_duration_(self)
+      value = self.single_duration_serializer.read(self.stream_);
+    end
+
+    function value = read_rec_with_durations_(self)
+      value = self.rec_with_durations_serializer.read(self.stream_);
+    end
+  end
+end
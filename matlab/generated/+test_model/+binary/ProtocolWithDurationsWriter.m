@@ -0,0 +1,28 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef ProtocolWithDurationsWriter < yardl.binary.BinaryProtocolWriter & test_model.ProtocolWithDurationsWriterBase
+  % Binary writer for the ProtocolWithDurations protocol
+  properties (Access=protected)
+    single_duration_serializer
+    rec_with_durations_serializer
+  end
+
+  methods
+    function self = ProtocolWithDurationsWriter(filename)
+      self@test_model.ProtocolWithDurationsWriterBase();
+      self@yardl.binary.BinaryProtocolWriter(filename, test_model.ProtocolWithDurationsWriterBase.schema);
+      self.single_duration_serializer = yardl.binary.DurationSerializer;
+      self.rec_with_durations_serializer = test_model.binary.RecordWithDurationsSerializer();
+    end
+  end
+
+  methods (Access=protected)
+    function write_single_duration_(self, value)
+      self.single_duration_serializer.write(self.stream_, value);
+    end
+
+    function write_rec_with_durations_(self, value)
+      self.rec_with_durations_serializer.write(self.stream_, value);
+    end
+  end
+end
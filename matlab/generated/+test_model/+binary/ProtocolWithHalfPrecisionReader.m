@@ -0,0 +1,32 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef ProtocolWithHalfPrecisionReader < yardl.binary.BinaryProtocolReader & test_model.ProtocolWithHalfPrecisionReaderBase
+  % Binary reader for the ProtocolWithHalfPrecision protocol
+  properties (Access=protected)
+    halves_serializer
+    rec_with_halves_serializer
+  end
+
+  methods
+    function self = ProtocolWithHalfPrecisionReader(filename, options)
+      arguments
+        filename (1,1) string
+        options.skip_completed_check (1,1) logical = false
+      end
+      self@test_model.ProtocolWithHalfPrecisionReaderBase(skip_completed_check=options.skip_completed_check);
+      self@yardl.binary.BinaryProtocolReader(filename, test_model.ProtocolWithHalfPrecisionReaderBase.schema);
+      self.halves_serializer = yardl.binary.VectorSerializer(yardl.binary.Float16Serializer);
+      self.rec_with_halves_serializer = test_model.binary.RecordWithHalfPrecisionSerializer();
+    end
+  end
+
+  methods (Access=protected)
+    function value = read_halves_(self)
+      value = self.halves_serializer.read(self.stream_);
+    end
+
+    function value = read_rec_with_halves_(self)
+      value = self.rec_with_halves_serializer.read(self.stream_);
+    end
+  end
+end
@@ -0,0 +1,28 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef ProtocolWithHalfPrecisionWriter < yardl.binary.BinaryProtocolWriter & test_model.ProtocolWithHalfPrecisionWriterBase
+  % Binary writer for the ProtocolWithHalfPrecision protocol
+  properties (Access=protected)
+    halves_serializer
+    rec_with_halves_serializer
+  end
+
+  methods
+    function self = ProtocolWithHalfPrecisionWriter(filename)
+      self@test_model.ProtocolWithHalfPrecisionWriterBase();
+      self@yardl.binary.BinaryProtocolWriter(filename, test_model.ProtocolWithHalfPrecisionWriterBase.schema);
+      self.halves_serializer = yardl.binary.VectorSerializer(yardl.binary.Float16Serializer);
+      self.rec_with_halves_serializer = test_model.binary.RecordWithHalfPrecisionSerializer();
+    end
+  end
+
+  methods (Access=protected)
+    function write_halves_(self, value)
+      self.halves_serializer.write(self.stream_, value);
+    end
+
+    function write_rec_with_halves_(self, value)
+      self.rec_with_halves_serializer.write(self.stream_, value);
+    end
+  end
+end
@@ -0,0 +1,42 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef ProtocolWithRecursiveRecordsReader < yardl.binary.BinaryProtocolReader & test_model.ProtocolWithRecursiveRecordsReaderBase
+  % Binary reader for the ProtocolWithRecursiveRecords protocol
+  properties (Access=protected)
+    tree_serializer
+    list_serializer
+    expressions_serializer
+  end
+
+  methods
+    function self = ProtocolWithRecursiveRecordsReader(filename, options)
+      arguments
+        filename (1,1) string
+        options.skip_completed_check (1,1) logical = false
+      end
+      self@test_model.ProtocolWithRecursiveRecordsReaderBase(skip_completed_check=options.skip_completed_check);
+      self@yardl.binary.BinaryProtocolReader(filename, test_model.ProtocolWithRecursiveRecordsReaderBase.schema);
+      self.tree_serializer = test_model.binary.TreeNodeSerializer();
+      self.list_serializer = test_model.binary.LinkedListNodeSerializer();
+      self.expressions_serializer = yardl.binary.StreamSerializer(test_model.binary.ExpressionSerializer());
+    end
+  end
+
+  methods (Access=protected)
+    function value = read_tree_(self)
+      value = self.tree_serializer.read(self.stream_);
+    end
+
+    function value = read_list_(self)
+      value = self.list_serializer.read(self.stream_);
+    end
+
+    function more = has_expressions_(self)
+      more = self.expressions_serializer.hasnext(self.stream_);
+    end
+
+    function value = read_expressions_(self)
+      value = self.expressions_serializer.read(self.stream_);
+    end
+  end
+end
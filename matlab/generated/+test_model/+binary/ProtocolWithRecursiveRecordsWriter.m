@@ -0,0 +1,34 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef ProtocolWithRecursiveRecordsWriter < yardl.binary.BinaryProtocolWriter & test_model.ProtocolWithRecursiveRecordsWriterBase
+  % Binary writer for the ProtocolWithRecursiveRecords protocol
+  properties (Access=protected)
+    tree_serializer
+    list_serializer
+    expressions_serializer
+  end
+
+  methods
+    function self = ProtocolWithRecursiveRecordsWriter(filename)
+      self@test_model.ProtocolWithRecursiveRecordsWriterBase();
+      self@yardl.binary.BinaryProtocolWriter(filename, test_model.ProtocolWithRecursiveRecordsWriterBase.schema);
+      self.tree_serializer = test_model.binary.TreeNodeSerializer();
+      self.list_serializer = test_model.binary.LinkedListNodeSerializer();
+      self.expressions_serializer = yardl.binary.StreamSerializer(test_model.binary.ExpressionSerializer());
+    end
+  end
+
+  methods (Access=protected)
+    function write_tree_(self, value)
+      self.tree_serializer.write(self.stream_, value);
+    end
+
+    function write_list_(self, value)
+      self.list_serializer.write(self.stream_, value);
+    end
+
+    function write_expressions_(self, value)
+      self.expressions_serializer.write(self.stream_, value);
+    end
+  end
+end
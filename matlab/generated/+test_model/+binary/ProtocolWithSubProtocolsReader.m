@@ -0,0 +1,68 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef ProtocolWithSubProtocolsReader < yardl.binary.BinaryProtocolReader & test_model.ProtocolWithSubProtocolsReaderBase
+  % Binary reader for the ProtocolWithSubProtocols protocol
+  properties (Access=protected)
+    header_serializer
+    calibration_gain_serializer
+    calibration_samples_serializer
+    data_serializer
+    recalibration_gain_serializer
+    recalibration_samples_serializer
+  end
+
+  methods
+    function self = ProtocolWithSubProtocolsReader(filename, options)
+      arguments
+        filename (1,1) string
+        options.skip_completed_check (1,1) logical = false
+      end
+      self@test_model.ProtocolWithSubProtocolsReaderBase(skip_completed_check=options.skip_completed_check);
+      self@yardl.binary.BinaryProtocolReader(filename, test_model.ProtocolWithSubProtocolsReaderBase.schema);
+      self.header_serializer = yardl.binary.StringSerializer;
+      self.calibration_gain_serializer = yardl.binary.Float32Serializer;
+      self.calibration_samples_serializer = yardl.binary.StreamSerializer(yardl.binary.Float64Serializer);
+      self.data_serializer = yardl.binary.StreamSerializer(yardl.binary.Int32Serializer);
+      self.recalibration_gain_serializer = yardl.binary.Float32Serializer;
+      self.recalibration_samples_serializer = yardl.binary.StreamSerializer(yardl.binary.Float64Serializer);
+    end
+  end
+
+  methods (Access=protected)
+    function value = read_header_(self)
+      value = self.header_serializer.read(self.stream_);
+    end
+
+    function value = read_calibration_gain_(self)
+      value = self.calibration_gain_serializer.read(self.stream_);
+    end
+
+    function more = has_calibration_samples_(self)
+      more = self.calibration_samples_serializer.hasnext(self.stream_);
+    end
+
+    function value = read_calibration_samples_(self)
+      value = self.calibration_samples_serializer.read(self.stream_);
+    end
+
+    function more = has_data_(self)
+      more = self.data_serializer.hasnext(self.stream_);
+    end
+
+    function value = read_data_(self)
+      value = self.data_serializer.read(self.stream_);
+    end
+
+    function value = read_recalibration_gain_(self)
+      value = self.recalibration_gain_serializer.read(self.stream_);
+    end
+
+    function more = has_recalibration_samples_(self)
+      more = self.recalibration_samples_serializer.hasnext(self.stream_);
+    end
+
+    function value = read_recalibration_samples_(self)
+      value = self.recalibration_samples_serializer.read(self.stream_);
+    end
+  end
+end
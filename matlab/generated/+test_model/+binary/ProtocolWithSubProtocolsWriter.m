@@ -0,0 +1,52 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef ProtocolWithSubProtocolsWriter < yardl.binary.BinaryProtocolWriter & test_model.ProtocolWithSubProtocolsWriterBase
+  % Binary writer for the ProtocolWithSubProtocols protocol
+  properties (Access=protected)
+    header_serializer
+    calibration_gain_serializer
+    calibration_samples_serializer
+    data_serializer
+    recalibration_gain_serializer
+    recalibration_samples_serializer
+  end
+
+  methods
+    function self = ProtocolWithSubProtocolsWriter(filename)
+      self@test_model.ProtocolWithSubProtocolsWriterBase();
+      self@yardl.binary.BinaryProtocolWriter(filename, test_model.ProtocolWithSubProtocolsWriterBase.schema);
+      self.header_serializer = yardl.binary.StringSerializer;
+      self.calibration_gain_serializer = yardl.binary.Float32Serializer;
+      self.calibration_samples_serializer = yardl.binary.StreamSerializer(yardl.binary.Float64Serializer);
+      self.data_serializer = yardl.binary.StreamSerializer(yardl.binary.Int32Serializer);
+      self.recalibration_gain_serializer = yardl.binary.Float32Serializer;
+      self.recalibration_samples_serializer = yardl.binary.StreamSerializer(yardl.binary.Float64Serializer);
+    end
+  end
+
+  methods (Access=protected)
+    function write_header_(self, value)
+      self.header_serializer.write(self.stream_, value);
+    end
+
+    function write_calibration_gain_(self, value)
+      self.calibration_gain_serializer.write(self.stream_, value);
+    end
+
+    function write_calibration_samples_(self, value)
+      self.calibration_samples_serializer.write(self.stream_, value);
+    end
+
+    function write_data_(self, value)
+      self.data_serializer.write(self.stream_, value);
+    end
+
+    function write_recalibration_gain_(self, value)
+      self.recalibration_gain_serializer.write(self.stream_, value);
+    end
+
+    function write_recalibration_samples_(self, value)
+      self.recalibration_samples_serializer.write(self.stream_, value);
+    end
+  end
+end
@@ -0,0 +1,32 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef ProtocolWithUuidsReader < yardl.binary.BinaryProtocolReader & test_model.ProtocolWithUuidsReaderBase
+  % Binary reader for the ProtocolWithUuids protocol
+  properties (Access=protected)
+    single_uuid_serializer
+    rec_with_uuids_serializer
+  end
+
+  methods
+    function self = ProtocolWithUuidsReader(filename, options)
+      arguments
+        filename (1,1) string
+        options.skip_completed_check (1,1) logical = false
+      end
+      self@test_model.ProtocolWithUuidsReaderBase(skip_completed_check=options.skip_completed_check);
+      self@yardl.binary.BinaryProtocolReader(filename, test_model.ProtocolWithUuidsReaderBase.schema);
+      self.single_uuid_serializer = yardl.binary.UuidSerializer;
+      self.rec_with_uuids_serializer = test_model.binary.RecordWithUuidsSerializer();
+    end
+  end
+
+  methods (Access=protected)
+    function value = read_single_uuid_(self)
+      value = self.single_uuid_serializer.read(self.stream_);
+    end
+
+    function value = read_rec_with_uuids_(self)
+      value = self.rec_with_uuids_serializer.read(self.stream_);
+    end
+  end
+end
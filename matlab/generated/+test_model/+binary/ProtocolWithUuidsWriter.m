@@ -0,0 +1,28 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef ProtocolWithUuidsWriter < yardl.binary.BinaryProtocolWriter & test_model.ProtocolWithUuidsWriterBase
+  % Binary writer for the ProtocolWithUuids protocol
+  properties (Access=protected)
+    single_uuid_serializer
+    rec_with_uuids_serializer
+  end
+
+  methods
+    function self = ProtocolWithUuidsWriter(filename)
+      self@test_model.ProtocolWithUuidsWriterBase();
+      self@yardl.binary.BinaryProtocolWriter(filename, test_model.ProtocolWithUuidsWriterBase.schema);
+      self.single_uuid_serializer = yardl.binary.UuidSerializer;
+      self.rec_with_uuids_serializer = test_model.binary.RecordWithUuidsSerializer();
+    end
+  end
+
+  methods (Access=protected)
+    function write_single_uuid_(self, value)
+      self.single_uuid_serializer.write(self.stream_, value);
+    end
+
+    function write_rec_with_uuids_(self, value)
+      self.rec_with_uuids_serializer.write(self.stream_, value);
+    end
+  end
+end
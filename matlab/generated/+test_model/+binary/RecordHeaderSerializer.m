@@ -0,0 +1,25 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordHeaderSerializer < yardl.binary.RecordSerializer
+  methods
+    function self = RecordHeaderSerializer()
+      field_serializers{1} = yardl.binary.Uint32Serializer;
+      field_serializers{2} = yardl.binary.StringSerializer;
+      self@yardl.binary.RecordSerializer('test_model.RecordHeader', field_serializers);
+    end
+
+    function write(self, outstream, value)
+      arguments
+        self
+        outstream (1,1) yardl.binary.CodedOutputStream
+        value (1,1) test_model.RecordHeader
+      end
+      self.write_(outstream, value.version, value.name);
+    end
+
+    function value = read(self, instream)
+      fields = self.read_(instream);
+      value = test_model.RecordHeader(version=fields{1}, name=fields{2});
+    end
+  end
+end
@@ -0,0 +1,26 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithAnnotationsSerializer < yardl.binary.RecordSerializer
+  methods
+    function self = RecordWithAnnotationsSerializer()
+      field_serializers{1} = yardl.binary.Float32Serializer;
+      field_serializers{2} = yardl.binary.Float32Serializer;
+      field_serializers{3} = yardl.binary.StringSerializer;
+      self@yardl.binary.RecordSerializer('test_model.RecordWithAnnotations', field_serializers);
+    end
+
+    function write(self, outstream, value)
+      arguments
+        self
+        outstream (1,1) yardl.binary.CodedOutputStream
+        value (1,1) test_model.RecordWithAnnotations
+      end
+      self.write_(outstream, value.thickness, value.spacing, value.label);
+    end
+
+    function value = read(self, instream)
+      fields = self.read_(instream);
+      value = test_model.RecordWithAnnotations(thickness=fields{1}, spacing=fields{2}, label=fields{3});
+    end
+  end
+end
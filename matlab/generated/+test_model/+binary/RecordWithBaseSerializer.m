@@ -0,0 +1,26 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithBaseSerializer < yardl.binary.RecordSerializer
+  methods
+    function self = RecordWithBaseSerializer()
+      field_serializers{1} = yardl.binary.Uint32Serializer;
+      field_serializers{2} = yardl.binary.StringSerializer;
+      field_serializers{3} = yardl.binary.VectorSerializer(yardl.binary.Float32Serializer);
+      self@yardl.binary.RecordSerializer('test_model.RecordWithBase', field_serializers);
+    end
+
+    function write(self, outstream, value)
+      arguments
+        self
+        outstream (1,1) yardl.binary.CodedOutputStream
+        value (1,1) test_model.RecordWithBase
+      end
+      self.write_(outstream, value.version, value.name, value.data);
+    end
+
+    function value = read(self, instream)
+      fields = self.read_(instream);
+      value = test_model.RecordWithBase(version=fields{1}, name=fields{2}, data=fields{3});
+    end
+  end
+end
@@ -0,0 +1,27 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithBasesSerializer < yardl.binary.RecordSerializer
+  methods
+    function self = RecordWithBasesSerializer()
+      field_serializers{1} = yardl.binary.Uint32Serializer;
+      field_serializers{2} = yardl.binary.StringSerializer;
+      field_serializers{3} = yardl.binary.StringSerializer;
+      field_serializers{4} = yardl.binary.Int32Serializer;
+      self@yardl.binary.RecordSerializer('test_model.RecordWithBases', field_serializers);
+    end
+
+    function write(self, outstream, value)
+      arguments
+        self
+        outstream (1,1) yardl.binary.CodedOutputStream
+        value (1,1) test_model.RecordWithBases
+      end
+      self.write_(outstream, value.version, value.name, value.label, value.value);
+    end
+
+    function value = read(self, instream)
+      fields = self.read_(instream);
+      value = test_model.RecordWithBases(version=fields{1}, name=fields{2}, label=fields{3}, value=fields{4});
+    end
+  end
+end
@@ -0,0 +1,26 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithBytesSerializer < yardl.binary.RecordSerializer
+  methods
+    function self = RecordWithBytesSerializer()
+      field_serializers{1} = yardl.binary.BytesSerializer;
+      field_serializers{2} = yardl.binary.OptionalSerializer(yardl.binary.BytesSerializer);
+      field_serializers{3} = yardl.binary.VectorSerializer(yardl.binary.BytesSerializer);
+      self@yardl.binary.RecordSerializer('test_model.RecordWithBytes', field_serializers);
+    end
+
+    function write(self, outstream, value)
+      arguments
+        self
+        outstream (1,1) yardl.binary.CodedOutputStream
+        value (1,1) test_model.RecordWithBytes
+      end
+      self.write_(outstream, value.data, value.optional_data, value.chunks);
+    end
+
+    function value = read(self, instream)
+      fields = self.read_(instream);
+      value = test_model.RecordWithBytes(data=fields{1}, optional_data=fields{2}, chunks=fields{3});
+    end
+  end
+end
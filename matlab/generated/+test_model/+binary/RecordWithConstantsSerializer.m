@@ -0,0 +1,26 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithConstantsSerializer < yardl.binary.RecordSerializer
+  methods
+    function self = RecordWithConstantsSerializer()
+      field_serializers{1} = yardl.binary.FixedVectorSerializer(yardl.binary.Int32Serializer, 4);
+      field_serializers{2} = yardl.binary.FixedNDArraySerializer(yardl.binary.Float32Serializer, [8, 4]);
+      field_serializers{3} = yardl.binary.Float32Serializer;
+      self@yardl.binary.RecordSerializer('test_model.RecordWithConstants', field_serializers);
+    end
+
+    function write(self, outstream, value)
+      arguments
+        self
+        outstream (1,1) yardl.binary.CodedOutputStream
+        value (1,1) test_model.RecordWithConstants
+      end
+      self.write_(outstream, value.channels, value.samples, value.gain);
+    end
+
+    function value = read(self, instream)
+      fields = self.read_(instream);
+      value = test_model.RecordWithConstants(channels=fields{1}, samples=fields{2}, gain=fields{3});
+    end
+  end
+end
@@ -0,0 +1,26 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithConstrainedRecordsSerializer < yardl.binary.RecordSerializer
+  methods
+    function self = RecordWithConstrainedRecordsSerializer()
+      field_serializers{1} = test_model.binary.RecordWithConstraintsSerializer();
+      field_serializers{2} = yardl.binary.OptionalSerializer(test_model.binary.RecordWithConstraintsSerializer());
+      field_serializers{3} = yardl.binary.VectorSerializer(test_model.binary.RecordWithConstraintsSerializer());
+      self@yardl.binary.RecordSerializer('test_model.RecordWithConstrainedRecords', field_serializers);
+    end
+
+    function write(self, outstream, value)
+      arguments
+        self
+        outstream (1,1) yardl.binary.CodedOutputStream
+        value (1,1) test_model.RecordWithConstrainedRecords
+      end
+      self.write_(outstream, value.single, value.optional, value.vector);
+    end
+
+    function value = read(self, instream)
+      fields = self.read_(instream);
+      value = test_model.RecordWithConstrainedRecords(single=fields{1}, optional=fields{2}, vector=fields{3});
+    end
+  end
+end
@@ -0,0 +1,28 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithConstraintsSerializer < yardl.binary.RecordSerializer
+  methods
+    function self = RecordWithConstraintsSerializer()
+      field_serializers{1} = yardl.binary.Uint32Serializer;
+      field_serializers{2} = yardl.binary.Float32Serializer;
+      field_serializers{3} = yardl.binary.StringSerializer;
+      field_serializers{4} = yardl.binary.OptionalSerializer(yardl.binary.Int32Serializer);
+      field_serializers{5} = yardl.binary.VectorSerializer(yardl.binary.Int32Serializer);
+      self@yardl.binary.RecordSerializer('test_model.RecordWithConstraints', field_serializers);
+    end
+
+    function write(self, outstream, value)
+      arguments
+        self
+        outstream (1,1) yardl.binary.CodedOutputStream
+        value (1,1) test_model.RecordWithConstraints
+      end
+      self.write_(outstream, value.size, value.gain, value.name, value.limit, value.samples);
+    end
+
+    function value = read(self, instream)
+      fields = self.read_(instream);
+      value = test_model.RecordWithConstraints(size=fields{1}, gain=fields{2}, name=fields{3}, limit=fields{4}, samples=fields{5});
+    end
+  end
+end
@@ -0,0 +1,33 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithDefaultsSerializer < yardl.binary.RecordSerializer
+  methods
+    function self = RecordWithDefaultsSerializer()
+      field_serializers{1} = yardl.binary.Int32Serializer;
+      field_serializers{2} = yardl.binary.Int8Serializer;
+      field_serializers{3} = yardl.binary.Uint64Serializer;
+      field_serializers{4} = yardl.binary.Float32Serializer;
+      field_serializers{5} = yardl.binary.Float64Serializer;
+      field_serializers{6} = yardl.binary.Complexfloat64Serializer;
+      field_serializers{7} = yardl.binary.BoolSerializer;
+      field_serializers{8} = yardl.binary.StringSerializer;
+      field_serializers{9} = yardl.binary.EnumSerializer('basic_types.Fruits', @basic_types.Fruits, yardl.binary.Int32Serializer);
+      field_serializers{10} = yardl.binary.Int32Serializer;
+      self@yardl.binary.RecordSerializer('test_model.RecordWithDefaults', field_serializers);
+    end
+
+    function write(self, outstream, value)
+      arguments
+        self
+        outstream (1,1) yardl.binary.CodedOutputStream
+        value (1,1) test_model.RecordWithDefaults
+      end
+      self.write_(outstream, value.int_field, value.int8_field, value.uint64_field, value.float32_field, value.float64_field, value.complexfloat64_field, value.bool_field, value.string_field, value.enum_field, value.no_default_field);
+    end
+
+    function value = read(self, instream)
+      fields = self.read_(instream);
+      value = test_model.RecordWithDefaults(int_field=fields{1}, int8_field=fields{2}, uint64_field=fields{3}, float32_field=fields{4}, float64_field=fields{5}, complexfloat64_field=fields{6}, bool_field=fields{7}, string_field=fields{8}, enum_field=fields{9}, no_default_field=fields{10});
+    end
+  end
+end
@@ -0,0 +1,28 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithDurationsSerializer < yardl.binary.RecordSerializer
+  methods
+    function self = RecordWithDurationsSerializer()
+      field_serializers{1} = yardl.binary.DatetimeSerializer;
+      field_serializers{2} = yardl.binary.DatetimeSerializer;
+      field_serializers{3} = yardl.binary.DurationSerializer;
+      field_serializers{4} = yardl.binary.OptionalSerializer(yardl.binary.DurationSerializer);
+      field_serializers{5} = yardl.binary.VectorSerializer(yardl.binary.DurationSerializer);
+      self@yardl.binary.RecordSerializer('test_model.RecordWithDurations', field_serializers);
+    end
+
+    function write(self, outstream, value)
+      arguments
+        self
+        outstream (1,1) yardl.binary.CodedOutputStream
+        value (1,1) test_model.RecordWithDurations
+      end
+      self.write_(outstream, value.acquisition_start, value.acquisition_end, value.repetition_time, value.timeout, value.intervals);
+    end
+
+    function value = read(self, instream)
+      fields = self.read_(instream);
+      value = test_model.RecordWithDurations(acquisition_start=fields{1}, acquisition_end=fields{2}, repetition_time=fields{3}, timeout=fields{4}, intervals=fields{5});
+    end
+  end
+end
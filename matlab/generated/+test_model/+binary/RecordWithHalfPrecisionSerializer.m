@@ -0,0 +1,27 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithHalfPrecisionSerializer < yardl.binary.RecordSerializer
+  methods
+    function self = RecordWithHalfPrecisionSerializer()
+      field_serializers{1} = yardl.binary.Float16Serializer;
+      field_serializers{2} = yardl.binary.Bfloat16Serializer;
+      field_serializers{3} = yardl.binary.VectorSerializer(yardl.binary.Float16Serializer);
+      field_serializers{4} = yardl.binary.DynamicNDArraySerializer(yardl.binary.Bfloat16Serializer);
+      self@yardl.binary.RecordSerializer('test_model.RecordWithHalfPrecision', field_serializers);
+    end
+
+    function write(self, outstream, value)
+      arguments
+        self
+        outstream (1,1) yardl.binary.CodedOutputStream
+        value (1,1) test_model.RecordWithHalfPrecision
+      end
+      self.write_(outstream, value.half, value.brain, value.half_vector, value.brain_array);
+    end
+
+    function value = read(self, instream)
+      fields = self.read_(instream);
+      value = test_model.RecordWithHalfPrecision(half=fields{1}, brain=fields{2}, half_vector=fields{3}, brain_array=fields{4});
+    end
+  end
+end
@@ -0,0 +1,28 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithPatternsSerializer < yardl.binary.RecordSerializer
+  methods
+    function self = RecordWithPatternsSerializer()
+      field_serializers{1} = yardl.binary.StringSerializer;
+      field_serializers{2} = yardl.binary.StringSerializer;
+      field_serializers{3} = yardl.binary.StringSerializer;
+      field_serializers{4} = yardl.binary.StringSerializer;
+      field_serializers{5} = yardl.binary.StringSerializer;
+      self@yardl.binary.RecordSerializer('test_model.RecordWithPatterns', field_serializers);
+    end
+
+    function write(self, outstream, value)
+      arguments
+        self
+        outstream (1,1) yardl.binary.CodedOutputStream
+        value (1,1) test_model.RecordWithPatterns
+      end
+      self.write_(outstream, value.alternation, value.word, value.code, value.escaped, value.capitalized);
+    end
+
+    function value = read(self, instream)
+      fields = self.read_(instream);
+      value = test_model.RecordWithPatterns(alternation=fields{1}, word=fields{2}, code=fields{3}, escaped=fields{4}, capitalized=fields{5});
+    end
+  end
+end
@@ -0,0 +1,26 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithUnitsSerializer < yardl.binary.RecordSerializer
+  methods
+    function self = RecordWithUnitsSerializer()
+      field_serializers{1} = yardl.binary.Float32Serializer;
+      field_serializers{2} = yardl.binary.Float32Serializer;
+      field_serializers{3} = yardl.binary.VectorSerializer(yardl.binary.Float64Serializer);
+      self@yardl.binary.RecordSerializer('test_model.RecordWithUnits', field_serializers);
+    end
+
+    function write(self, outstream, value)
+      arguments
+        self
+        outstream (1,1) yardl.binary.CodedOutputStream
+        value (1,1) test_model.RecordWithUnits
+      end
+      self.write_(outstream, value.length, value.width, value.durations);
+    end
+
+    function value = read(self, instream)
+      fields = self.read_(instream);
+      value = test_model.RecordWithUnits(length=fields{1}, width=fields{2}, durations=fields{3});
+    end
+  end
+end
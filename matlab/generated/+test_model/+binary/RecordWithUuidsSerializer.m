@@ -0,0 +1,27 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithUuidsSerializer < yardl.binary.RecordSerializer
+  methods
+    function self = RecordWithUuidsSerializer()
+      field_serializers{1} = yardl.binary.UuidSerializer;
+      field_serializers{2} = yardl.binary.OptionalSerializer(yardl.binary.UuidSerializer);
+      field_serializers{3} = yardl.binary.VectorSerializer(yardl.binary.UuidSerializer);
+      field_serializers{4} = yardl.binary.MapSerializer(yardl.binary.UuidSerializer, yardl.binary.StringSerializer);
+      self@yardl.binary.RecordSerializer('test_model.RecordWithUuids', field_serializers);
+    end
+
+    function write(self, outstream, value)
+      arguments
+        self
+        outstream (1,1) yardl.binary.CodedOutputStream
+        value (1,1) test_model.RecordWithUuids
+      end
+      self.write_(outstream, value.id, value.optional_id, value.related, value.names);
+    end
+
+    function value = read(self, instream)
+      fields = self.read_(instream);
+      value = test_model.RecordWithUuids(id=fields{1}, optional_id=fields{2}, related=fields{3}, names=fields{4});
+    end
+  end
+end
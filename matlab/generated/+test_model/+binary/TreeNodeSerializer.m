@@ -0,0 +1,25 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef TreeNodeSerializer < yardl.binary.RecordSerializer
+  methods
+    function self = TreeNodeSerializer()
+      field_serializers{1} = yardl.binary.StringSerializer;
+      field_serializers{2} = yardl.binary.VectorSerializer(yardl.binary.RecursiveSerializer('test_model.TreeNode', @() test_model.binary.TreeNodeSerializer()));
+      self@yardl.binary.RecordSerializer('test_model.TreeNode', field_serializers);
+    end
+
+    function write(self, outstream, value)
+      arguments
+        self
+        outstream (1,1) yardl.binary.CodedOutputStream
+        value (1,1) test_model.TreeNode
+      end
+      self.write_(outstream, value.label, value.children);
+    end
+
+    function value = read(self, instream)
+      fields = self.read_(instream);
+      value = test_model.TreeNode(label=fields{1}, children=fields{2});
+    end
+  end
+end
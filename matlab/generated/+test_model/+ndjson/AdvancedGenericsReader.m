@@ -0,0 +1,55 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef AdvancedGenericsReader < yardl.ndjson.NDJsonProtocolReader & test_model.AdvancedGenericsReaderBase
+  % NDJSON reader for the AdvancedGenerics protocol
+  properties (Access=protected)
+    float_image_image_converter
+    generic_record_1_converter
+    tuple_of_optionals_converter
+    tuple_of_optionals_alternate_syntax_converter
+    tuple_of_vectors_converter
+  end
+
+  methods
+    function self = AdvancedGenericsReader(filename, options)
+      arguments
+        filename (1,1) string
+        options.skip_completed_check (1,1) logical = false
+      end
+      self@test_model.AdvancedGenericsReaderBase(skip_completed_check=options.skip_completed_check);
+      self@yardl.ndjson.NDJsonProtocolReader(filename, test_model.AdvancedGenericsReaderBase.schema);
+      self.float_image_image_converter = yardl.ndjson.NDArrayConverter(yardl.ndjson.NDArrayConverter(yardl.ndjson.Float32Converter, 2), 2);
+      self.generic_record_1_converter = test_model.ndjson.GenericRecordConverter(yardl.ndjson.Int32Converter, yardl.ndjson.StringConverter);
+      self.tuple_of_optionals_converter = tuples.ndjson.TupleConverter(yardl.ndjson.OptionalConverter(yardl.ndjson.Int32Converter), yardl.ndjson.OptionalConverter(yardl.ndjson.StringConverter));
+      self.tuple_of_optionals_alternate_syntax_converter = tuples.ndjson.TupleConverter(yardl.ndjson.OptionalConverter(yardl.ndjson.Int32Converter), yardl.ndjson.OptionalConverter(yardl.ndjson.StringConverter));
+      self.tuple_of_vectors_converter = tuples.ndjson.TupleConverter(yardl.ndjson.VectorConverter(yardl.ndjson.Int32Converter), yardl.ndjson.VectorConverter(yardl.ndjson.Float32Converter));
+    end
+  end
+
+  methods (Access=protected)
+    function value = read_float_image_image_(self)
+      json = self.read_json_line_("floatImageImage");
+      value = self.float_image_image_converter.from_json(json);
+    end
+
+    function value = read_generic_record_1_(self)
+      json = self.read_json_line_("genericRecord1");
+      value = self.generic_record_1_converter.from_json(json);
+    end
+
+    function value = read_tuple_of_optionals_(self)
+      json = self.read_json_line_("tupleOfOptionals");
+      value = self.tuple_of_optionals_converter.from_json(json);
+    end
+
+    function value = read_tuple_of_optionals_alternate_syntax_(self)
+      json = self.read_json_line_("tupleOfOptionalsAlternateSyntax");
+      value = self.tuple_of_optionals_alternate_syntax_converter.from_json(json);
+    end
+
+    function value = read_tuple_of_vectors_(self)
+      json = self.read_json_line_("tupleOfVectors");
+      value = self.tuple_of_vectors_converter.from_json(json);
+    end
+  end
+end
@@ -0,0 +1,46 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef AdvancedGenericsWriter < yardl.ndjson.NDJsonProtocolWriter & test_model.AdvancedGenericsWriterBase
+  % NDJSON writer for the AdvancedGenerics protocol
+  properties (Access=protected)
+    float_image_image_converter
+    generic_record_1_converter
+    tuple_of_optionals_converter
+    tuple_of_optionals_alternate_syntax_converter
+    tuple_of_vectors_converter
+  end
+
+  methods
+    function self = AdvancedGenericsWriter(filename)
+      self@test_model.AdvancedGenericsWriterBase();
+      self@yardl.ndjson.NDJsonProtocolWriter(filename, test_model.AdvancedGenericsWriterBase.schema);
+      self.float_image_image_converter = yardl.ndjson.NDArrayConverter(yardl.ndjson.NDArrayConverter(yardl.ndjson.Float32Converter, 2), 2);
+      self.generic_record_1_converter = test_model.ndjson.GenericRecordConverter(yardl.ndjson.Int32Converter, yardl.ndjson.StringConverter);
+      self.tuple_of_optionals_converter = tuples.ndjson.TupleConverter(yardl.ndjson.OptionalConverter(yardl.ndjson.Int32Converter), yardl.ndjson.OptionalConverter(yardl.ndjson.StringConverter));
+      self.tuple_of_optionals_alternate_syntax_converter = tuples.ndjson.TupleConverter(yardl.ndjson.OptionalConverter(yardl.ndjson.Int32Converter), yardl.ndjson.OptionalConverter(yardl.ndjson.StringConverter));
+      self.tuple_of_vectors_converter = tuples.ndjson.TupleConverter(yardl.ndjson.VectorConverter(yardl.ndjson.Int32Converter), yardl.ndjson.VectorConverter(yardl.ndjson.Float32Converter));
+    end
+  end
+
+  methods (Access=protected)
+    function write_float_image_image_(self, value)
+      self.write_json_line_("floatImageImage", self.float_image_image_converter.to_json(value));
+    end
+
+    function write_generic_record_1_(self, value)
+      self.write_json_line_("genericRecord1", self.generic_record_1_converter.to_json(value));
+    end
+
+    function write_tuple_of_optionals_(self, value)
+      self.write_json_line_("tupleOfOptionals", self.tuple_of_optionals_converter.to_json(value));
+    end
+
+    function write_tuple_of_optionals_alternate_syntax_(self, value)
+      self.write_json_line_("tupleOfOptionalsAlternateSyntax", self.tuple_of_optionals_alternate_syntax_converter.to_json(value));
+    end
+
+    function write_tuple_of_vectors_(self, value)
+      self.write_json_line_("tupleOfVectors", self.tuple_of_vectors_converter.to_json(value));
+    end
+  end
+end
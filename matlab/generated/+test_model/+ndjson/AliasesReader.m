@@ -0,0 +1,101 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef AliasesReader < yardl.ndjson.NDJsonProtocolReader & test_model.AliasesReaderBase
+  % NDJSON reader for the Aliases protocol
+  properties (Access=protected)
+    aliased_string_converter
+    aliased_enum_converter
+    aliased_open_generic_converter
+    aliased_closed_generic_converter
+    aliased_optional_converter
+    aliased_generic_optional_converter
+    aliased_generic_union_2_converter
+    aliased_generic_vector_converter
+    aliased_generic_fixed_vector_converter
+    stream_of_aliased_generic_union_2_converter
+    vectors_converter
+  end
+
+  methods
+    function self = AliasesReader(filename, options)
+      arguments
+        filename (1,1) string
+        options.skip_completed_check (1,1) logical = false
+      end
+      self@test_model.AliasesReaderBase(skip_completed_check=options.skip_completed_check);
+      self@yardl.ndjson.NDJsonProtocolReader(filename, test_model.AliasesReaderBase.schema);
+      self.aliased_string_converter = yardl.ndjson.StringConverter;
+      self.aliased_enum_converter = basic_types.ndjson.FruitsConverter();
+      self.aliased_open_generic_converter = tuples.ndjson.TupleConverter(yardl.ndjson.StringConverter, basic_types.ndjson.FruitsConverter());
+      self.aliased_closed_generic_converter = tuples.ndjson.TupleConverter(yardl.ndjson.StringConverter, basic_types.ndjson.FruitsConverter());
+      self.aliased_optional_converter = yardl.ndjson.OptionalConverter(yardl.ndjson.Int32Converter);
+      self.aliased_generic_optional_converter = yardl.ndjson.OptionalConverter(yardl.ndjson.Float32Converter);
+      self.aliased_generic_union_2_converter = yardl.ndjson.UnionConverter('basic_types.GenericUnion2', {yardl.ndjson.StringConverter, basic_types.ndjson.FruitsConverter()}, {@basic_types.GenericUnion2.T1, @basic_types.GenericUnion2.T2}, ["T1", "T2"], {["string"], ["number", "string"]}, false);
+      self.aliased_generic_vector_converter = yardl.ndjson.VectorConverter(yardl.ndjson.Float32Converter);
+      self.aliased_generic_fixed_vector_converter = yardl.ndjson.FixedVectorConverter(yardl.ndjson.Float32Converter, 3);
+      self.stream_of_aliased_generic_union_2_converter = yardl.ndjson.UnionConverter('basic_types.GenericUnion2', {yardl.ndjson.StringConverter, basic_types.ndjson.FruitsConverter()}, {@basic_types.GenericUnion2.T1, @basic_types.GenericUnion2.T2}, ["T1", "T2"], {["string"], ["number", "string"]}, false);
+      self.vectors_converter = yardl.ndjson.VectorConverter(test_model.ndjson.RecordContainingVectorsOfAliasesConverter());
+    end
+  end
+
+  methods (Access=protected)
+    function value = read_aliased_string_(self)
+      json = self.read_json_line_("aliasedString");
+      value = self.aliased_string_converter.from_json(json);
+    end
+
+    function value = read_aliased_enum_(self)
+      json = self.read_json_line_("aliasedEnum");
+      value = self.aliased_enum_converter.from_json(json);
+    end
+
+    function value = read_aliased_open_generic_(self)
+      json = self.read_json_line_("aliasedOpenGeneric");
+      value = self.aliased_open_generic_converter.from_json(json);
+    end
+
+    function value = read_aliased_closed_generic_(self)
+      json = self.read_json_line_("aliasedClosedGeneric");
+      value = self.aliased_closed_generic_converter.from_json(json);
+    end
+
+    function value = read_aliased_optional_(self)
+      json = self.read_json_line_("aliasedOptional");
+      value = self.aliased_optional_converter.from_json(json);
+    end
+
+    function value = read_aliased_generic_optional_(self)
+      json = self.read_json_line_("aliasedGenericOptional");
+      value = self.aliased_generic_optional_converter.from_json(json);
+    end
+
+    function value = read_aliased_generic_union_2_(self)
+      json = self.read_json_line_("aliasedGenericUnion2");
+      value = self.aliased_generic_union_2_converter.from_json(json);
+    end
+
+    function value = read_aliased_generic_vector_(self)
+      json = self.read_json_line_("aliasedGenericVector");
+      value = self.aliased_generic_vector_converter.from_json(json);
+    end
+
+    function value = read_aliased_generic_fixed_vector_(self)
+      json = self.read_json_line_("aliasedGenericFixedVector");
+      value = self.aliased_generic_fixed_vector_converter.from_json(json);
+    end
+
+    function more = has_stream_of_aliased_generic_union_2_(self)
+      more = self.has_json_line_("streamOfAliasedGenericUnion2");
+    end
+
+    function value = read_stream_of_aliased_generic_union_2_(self)
+      json = self.read_json_line_("streamOfAliasedGenericUnion2");
+      value = self.stream_of_aliased_generic_union_2_converter.from_json(json);
+    end
+
+    function value = read_vectors_(self)
+      json = self.read_json_line_("vectors");
+      value = self.vectors_converter.from_json(json);
+    end
+  end
+end
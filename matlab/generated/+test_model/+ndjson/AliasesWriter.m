@@ -0,0 +1,82 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef AliasesWriter < yardl.ndjson.NDJsonProtocolWriter & test_model.AliasesWriterBase
+  % NDJSON writer for the Aliases protocol
+  properties (Access=protected)
+    aliased_string_converter
+    aliased_enum_converter
+    aliased_open_generic_converter
+    aliased_closed_generic_converter
+    aliased_optional_converter
+    aliased_generic_optional_converter
+    aliased_generic_union_2_converter
+    aliased_generic_vector_converter
+    aliased_generic_fixed_vector_converter
+    stream_of_aliased_generic_union_2_converter
+    vectors_converter
+  end
+
+  methods
+    function self = AliasesWriter(filename)
+      self@test_model.AliasesWriterBase();
+      self@yardl.ndjson.NDJsonProtocolWriter(filename, test_model.AliasesWriterBase.schema);
+      self.aliased_string_converter = yardl.ndjson.StringConverter;
+      self.aliased_enum_converter = basic_types.ndjson.FruitsConverter();
+      self.aliased_open_generic_converter = tuples.ndjson.TupleConverter(yardl.ndjson.StringConverter, basic_types.ndjson.FruitsConverter());
+      self.aliased_closed_generic_converter = tuples.ndjson.TupleConverter(yardl.ndjson.StringConverter, basic_types.ndjson.FruitsConverter());
+      self.aliased_optional_converter = yardl.ndjson.OptionalConverter(yardl.ndjson.Int32Converter);
+      self.aliased_generic_optional_converter = yardl.ndjson.OptionalConverter(yardl.ndjson.Float32Converter);
+      self.aliased_generic_union_2_converter = yardl.ndjson.UnionConverter('basic_types.GenericUnion2', {yardl.ndjson.StringConverter, basic_types.ndjson.FruitsConverter()}, {@basic_types.GenericUnion2.T1, @basic_types.GenericUnion2.T2}, ["T1", "T2"], {["string"], ["number", "string"]}, false);
+      self.aliased_generic_vector_converter = yardl.ndjson.VectorConverter(yardl.ndjson.Float32Converter);
+      self.aliased_generic_fixed_vector_converter = yardl.ndjson.FixedVectorConverter(yardl.ndjson.Float32Converter, 3);
+      self.stream_of_aliased_generic_union_2_converter = yardl.ndjson.UnionConverter('basic_types.GenericUnion2', {yardl.ndjson.StringConverter, basic_types.ndjson.FruitsConverter()}, {@basic_types.GenericUnion2.T1, @basic_types.GenericUnion2.T2}, ["T1", "T2"], {["string"], ["number", "string"]}, false);
+      self.vectors_converter = yardl.ndjson.VectorConverter(test_model.ndjson.RecordContainingVectorsOfAliasesConverter());
+    end
+  end
+
+  methods (Access=protected)
+    function write_aliased_string_(self, value)
+      self.write_json_line_("aliasedString", self.aliased_string_converter.to_json(value));
+    end
+
+    function write_aliased_enum_(self, value)
+      self.write_json_line_("aliasedEnum", self.aliased_enum_converter.to_json(value));
+    end
+
+    function write_aliased_open_generic_(self, value)
+      self.write_json_line_("aliasedOpenGeneric", self.aliased_open_generic_converter.to_json(value));
+    end
+
+    function write_aliased_closed_generic_(self, value)
+      self.write_json_line_("aliasedClosedGeneric", self.aliased_closed_generic_converter.to_json(value));
+    end
+
+    function write_aliased_optional_(self, value)
+      self.write_json_line_("aliasedOptional", self.aliased_optional_converter.to_json(value));
+    end
+
+    function write_aliased_generic_optional_(self, value)
+      self.write_json_line_("aliasedGenericOptional", self.aliased_generic_optional_converter.to_json(value));
+    end
+
+    function write_aliased_generic_union_2_(self, value)
+      self.write_json_line_("aliasedGenericUnion2", self.aliased_generic_union_2_converter.to_json(value));
+    end
+
+    function write_aliased_generic_vector_(self, value)
+      self.write_json_line_("aliasedGenericVector", self.aliased_generic_vector_converter.to_json(value));
+    end
+
+    function write_aliased_generic_fixed_vector_(self, value)
+      self.write_json_line_("aliasedGenericFixedVector", self.aliased_generic_fixed_vector_converter.to_json(value));
+    end
+
+    function write_stream_of_aliased_generic_union_2_(self, value)
+      self.write_json_stream_("streamOfAliasedGenericUnion2", self.stream_of_aliased_generic_union_2_converter, value);
+    end
+
+    function write_vectors_(self, value)
+      self.write_json_line_("vectors", self.vectors_converter.to_json(value));
+    end
+  end
+end
@@ -0,0 +1,31 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef BenchmarkFloat256x256Reader < yardl.ndjson.NDJsonProtocolReader & test_model.BenchmarkFloat256x256ReaderBase
+  % NDJSON reader for the BenchmarkFloat256x256 protocol
+  properties (Access=protected)
+    float256x256_converter
+  end
+
+  methods
+    function self = BenchmarkFloat256x256Reader(filename, options)
+      arguments
+        filename (1,1) string
+        options.skip_completed_check (1,1) logical = false
+      end
+      self@test_model.BenchmarkFloat256x256ReaderBase(skip_completed_check=options.skip_completed_check);
+      self@yardl.ndjson.NDJsonProtocolReader(filename, test_model.BenchmarkFloat256x256ReaderBase.schema);
+      self.float256x256_converter = yardl.ndjson.FixedNDArrayConverter(yardl.ndjson.Float32Converter, [256, 256]);
+    end
+  end
+
+  methods (Access=protected)
+    function more = has_float256x256_(self)
+      more = self.has_json_line_("float256x256");
+    end
+
+    function value = read_float256x256_(self)
+      json = self.read_json_line_("float256x256");
+      value = self.float256x256_converter.from_json(json);
+    end
+  end
+end
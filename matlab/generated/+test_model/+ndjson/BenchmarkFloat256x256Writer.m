@@ -0,0 +1,22 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef BenchmarkFloat256x256Writer < yardl.ndjson.NDJsonProtocolWriter & test_model.BenchmarkFloat256x256WriterBase
+  % NDJSON writer for the BenchmarkFloat256x256 protocol
+  properties (Access=protected)
+    float256x256_converter
+  end
+
+  methods
+    function self = BenchmarkFloat256x256Writer(filename)
+      self@test_model.BenchmarkFloat256x256WriterBase();
+      self@yardl.ndjson.NDJsonProtocolWriter(filename, test_model.BenchmarkFloat256x256WriterBase.schema);
+      self.float256x256_converter = yardl.ndjson.FixedNDArrayConverter(yardl.ndjson.Float32Converter, [256, 256]);
+    end
+  end
+
+  methods (Access=protected)
+    function write_float256x256_(self, value)
+      self.write_json_stream_("float256x256", self.float256x256_converter, value);
+    end
+  end
+end
@@ -0,0 +1,31 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef BenchmarkFloatVlenReader < yardl.ndjson.NDJsonProtocolReader & test_model.BenchmarkFloatVlenReaderBase
+  % NDJSON reader for the BenchmarkFloatVlen protocol
+  properties (Access=protected)
+    float_array_converter
+  end
+
+  methods
+    function self = BenchmarkFloatVlenReader(filename, options)
+      arguments
+        filename (1,1) string
+        options.skip_completed_check (1,1) logical = false
+      end
+      self@test_model.BenchmarkFloatVlenReaderBase(skip_completed_check=options.skip_completed_check);
+      self@yardl.ndjson.NDJsonProtocolReader(filename, test_model.BenchmarkFloatVlenReaderBase.schema);
+      self.float_array_converter = yardl.ndjson.NDArrayConverter(yardl.ndjson.Float32Converter, 2);
+    end
+  end
+
+  methods (Access=protected)
+    function more = has_float_array_(self)
+      more = self.has_json_line_("floatArray");
+    end
+
+    function value = read_float_array_(self)
+      json = self.read_json_line_("floatArray");
+      value = self.float_array_converter.from_json(json);
+    end
+  end
+end
@@ -0,0 +1,22 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef BenchmarkFloatVlenWriter < yardl.ndjson.NDJsonProtocolWriter & test_model.BenchmarkFloatVlenWriterBase
+  % NDJSON writer for the BenchmarkFloatVlen protocol
+  properties (Access=protected)
+    float_array_converter
+  end
+
+  methods
+    function self = BenchmarkFloatVlenWriter(filename)
+      self@test_model.BenchmarkFloatVlenWriterBase();
+      self@yardl.ndjson.NDJsonProtocolWriter(filename, test_model.BenchmarkFloatVlenWriterBase.schema);
+      self.float_array_converter = yardl.ndjson.NDArrayConverter(yardl.ndjson.Float32Converter, 2);
+    end
+  end
+
+  methods (Access=protected)
+    function write_float_array_(self, value)
+      self.write_json_stream_("floatArray", self.float_array_converter, value);
+    end
+  end
+end
@@ -0,0 +1,22 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef BenchmarkInt256x256Writer < yardl.ndjson.NDJsonProtocolWriter & test_model.BenchmarkInt256x256WriterBase
+  % NDJSON writer for the BenchmarkInt256x256 protocol
+  properties (Access=protected)
+    int256x256_converter
+  end
+
+  methods
+    function self = BenchmarkInt256x256Writer(filename)
+      self@test_model.BenchmarkInt256x256WriterBase();
+      self@yardl.ndjson.NDJsonProtocolWriter(filename, test_model.BenchmarkInt256x256WriterBase.schema);
+      self.int256x256_converter = yardl.ndjson.FixedNDArrayConverter(yardl.ndjson.Int32Converter, [256, 256]);
+    end
+  end
+
+  methods (Access=protected)
+    function write_int256x256_(self, value)
+      self.write_json_stream_("int256x256", self.int256x256_converter, value);
+    end
+  end
+end
@@ -0,0 +1,31 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef BenchmarkSimpleMrdReader < yardl.ndjson.NDJsonProtocolReader & test_model.BenchmarkSimpleMrdReaderBase
+  % NDJSON reader for the BenchmarkSimpleMrd protocol
+  properties (Access=protected)
+    data_converter
+  end
+
+  methods
+    function self = BenchmarkSimpleMrdReader(filename, options)
+      arguments
+        filename (1,1) string
+        options.skip_completed_check (1,1) logical = false
+      end
+      self@test_model.BenchmarkSimpleMrdReaderBase(skip_completed_check=options.skip_completed_check);
+      self@yardl.ndjson.NDJsonProtocolReader(filename, test_model.BenchmarkSimpleMrdReaderBase.schema);
+      self.data_converter = yardl.ndjson.UnionConverter('test_model.AcquisitionOrImage', {test_model.ndjson.SimpleAcquisitionConverter(), yardl.ndjson.NDArrayConverter(yardl.ndjson.Float32Converter, 2)}, {@test_model.AcquisitionOrImage.Acquisition, @test_model.AcquisitionOrImage.Image}, ["acquisition", "image"], {["object"], ["object"]}, false);
+    end
+  end
+
+  methods (Access=protected)
+    function more = has_data_(self)
+      more = self.has_json_line_("data");
+    end
+
+    function value = read_data_(self)
+      json = self.read_json_line_("data");
+      value = self.data_converter.from_json(json);
+    end
+  end
+end
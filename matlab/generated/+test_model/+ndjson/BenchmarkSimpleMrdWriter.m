@@ -0,0 +1,22 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef BenchmarkSimpleMrdWriter < yardl.ndjson.NDJsonProtocolWriter & test_model.BenchmarkSimpleMrdWriterBase
+  % NDJSON writer for the BenchmarkSimpleMrd protocol
+  properties (Access=protected)
+    data_converter
+  end
+
+  methods
+    function self = BenchmarkSimpleMrdWriter(filename)
+      self@test_model.BenchmarkSimpleMrdWriterBase();
+      self@yardl.ndjson.NDJsonProtocolWriter(filename, test_model.BenchmarkSimpleMrdWriterBase.schema);
+      self.data_converter = yardl.ndjson.UnionConverter('test_model.AcquisitionOrImage', {test_model.ndjson.SimpleAcquisitionConverter(), yardl.ndjson.NDArrayConverter(yardl.ndjson.Float32Converter, 2)}, {@test_model.AcquisitionOrImage.Acquisition, @test_model.AcquisitionOrImage.Image}, ["acquisition", "image"], {["object"], ["object"]}, false);
+    end
+  end
+
+  methods (Access=protected)
+    function write_data_(self, value)
+      self.write_json_stream_("data", self.data_converter, value);
+    end
+  end
+end
@@ -0,0 +1,31 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef BenchmarkSmallRecordWithOptionalsReader < yardl.ndjson.NDJsonProtocolReader & test_model.BenchmarkSmallRecordWithOptionalsReaderBase
+  % NDJSON reader for the BenchmarkSmallRecordWithOptionals protocol
+  properties (Access=protected)
+    small_record_converter
+  end
+
+  methods
+    function self = BenchmarkSmallRecordWithOptionalsReader(filename, options)
+      arguments
+        filename (1,1) string
+        options.skip_completed_check (1,1) logical = false
+      end
+      self@test_model.BenchmarkSmallRecordWithOptionalsReaderBase(skip_completed_check=options.skip_completed_check);
+      self@yardl.ndjson.NDJsonProtocolReader(filename, test_model.BenchmarkSmallRecordWithOptionalsReaderBase.schema);
+      self.small_record_converter = test_model.ndjson.SimpleEncodingCountersConverter();
+    end
+  end
+
+  methods (Access=protected)
+    function more = has_small_record_(self)
+      more = self.has_json_line_("smallRecord");
+    end
+
+    function value = read_small_record_(self)
+      json = self.read_json_line_("smallRecord");
+      value = self.small_record_converter.from_json(json);
+    end
+  end
+end
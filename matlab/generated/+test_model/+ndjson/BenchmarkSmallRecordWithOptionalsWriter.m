@@ -0,0 +1,22 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef BenchmarkSmallRecordWithOptionalsWriter < yardl.ndjson.NDJsonProtocolWriter & test_model.BenchmarkSmallRecordWithOptionalsWriterBase
+  % NDJSON writer for the BenchmarkSmallRecordWithOptionals protocol
+  properties (Access=protected)
+    small_record_converter
+  end
+
+  methods
+    function self = BenchmarkSmallRecordWithOptionalsWriter(filename)
+      self@test_model.BenchmarkSmallRecordWithOptionalsWriterBase();
+      self@yardl.ndjson.NDJsonProtocolWriter(filename, test_model.BenchmarkSmallRecordWithOptionalsWriterBase.schema);
+      self.small_record_converter = test_model.ndjson.SimpleEncodingCountersConverter();
+    end
+  end
+
+  methods (Access=protected)
+    function write_small_record_(self, value)
+      self.write_json_stream_("smallRecord", self.small_record_converter, value);
+    end
+  end
+end
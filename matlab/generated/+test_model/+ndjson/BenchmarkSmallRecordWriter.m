@@ -0,0 +1,22 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef BenchmarkSmallRecordWriter < yardl.ndjson.NDJsonProtocolWriter & test_model.BenchmarkSmallRecordWriterBase
+  % NDJSON writer for the BenchmarkSmallRecord protocol
+  properties (Access=protected)
+    small_record_converter
+  end
+
+  methods
+    function self = BenchmarkSmallRecordWriter(filename)
+      self@test_model.BenchmarkSmallRecordWriterBase();
+      self@yardl.ndjson.NDJsonProtocolWriter(filename, test_model.BenchmarkSmallRecordWriterBase.schema);
+      self.small_record_converter = test_model.ndjson.SmallBenchmarkRecordConverter();
+    end
+  end
+
+  methods (Access=protected)
+    function write_small_record_(self, value)
+      self.write_json_stream_("smallRecord", self.small_record_converter, value);
+    end
+  end
+end
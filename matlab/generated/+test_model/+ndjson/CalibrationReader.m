@@ -0,0 +1,39 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef CalibrationReader < yardl.ndjson.NDJsonProtocolReader & test_model.CalibrationReaderBase
+  % NDJSON reader for the Calibration protocol
+  % A calibration phase shared by protocols
+  properties (Access=protected)
+    gain_converter
+    samples_converter
+  end
+
+  methods
+    function self = CalibrationReader(filename, options)
+      arguments
+        filename (1,1) string
+        options.skip_completed_check (1,1) logical = false
+      end
+      self@test_model.CalibrationReaderBase(skip_completed_check=options.skip_completed_check);
+      self@yardl.ndjson.NDJsonProtocolReader(filename, test_model.CalibrationReaderBase.schema);
+      self.gain_converter = yardl.ndjson.Float32Converter;
+      self.samples_converter = yardl.ndjson.Float64Converter;
+    end
+  end
+
+  methods (Access=protected)
+    function value = read_gain_(self)
+      json = self.read_json_line_("gain");
+      value = self.gain_converter.from_json(json);
+    end
+
+    function more = has_samples_(self)
+      more = self.has_json_line_("samples");
+    end
+
+    function value = read_samples_(self)
+      json = self.read_json_line_("samples");
+      value = self.samples_converter.from_json(json);
+    end
+  end
+end
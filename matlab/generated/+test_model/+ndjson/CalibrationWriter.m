@@ -0,0 +1,29 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef CalibrationWriter < yardl.ndjson.NDJsonProtocolWriter & test_model.CalibrationWriterBase
+  % NDJSON writer for the Calibration protocol
+  % A calibration phase shared by protocols
+  properties (Access=protected)
+    gain_converter
+    samples_converter
+  end
+
+  methods
+    function self = CalibrationWriter(filename)
+      self@test_model.CalibrationWriterBase();
+      self@yardl.ndjson.NDJsonProtocolWriter(filename, test_model.CalibrationWriterBase.schema);
+      self.gain_converter = yardl.ndjson.Float32Converter;
+      self.samples_converter = yardl.ndjson.Float64Converter;
+    end
+  end
+
+  methods (Access=protected)
+    function write_gain_(self, value)
+      self.write_json_line_("gain", self.gain_converter.to_json(value));
+    end
+
+    function write_samples_(self, value)
+      self.write_json_stream_("samples", self.samples_converter, value);
+    end
+  end
+end
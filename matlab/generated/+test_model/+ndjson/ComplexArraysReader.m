@@ -0,0 +1,34 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef ComplexArraysReader < yardl.ndjson.NDJsonProtocolReader & test_model.ComplexArraysReaderBase
+  % NDJSON reader for the ComplexArrays protocol
+  properties (Access=protected)
+    floats_converter
+    doubles_converter
+  end
+
+  methods
+    function self = ComplexArraysReader(filename, options)
+      arguments
+        filename (1,1) string
+        options.skip_completed_check (1,1) logical = false
+      end
+      self@test_model.ComplexArraysReaderBase(skip_completed_check=options.skip_completed_check);
+      self@yardl.ndjson.NDJsonProtocolReader(filename, test_model.ComplexArraysReaderBase.schema);
+      self.floats_converter = yardl.ndjson.DynamicNDArrayConverter(yardl.ndjson.Complexfloat32Converter);
+      self.doubles_converter = yardl.ndjson.NDArrayConverter(yardl.ndjson.Complexfloat64Converter, 2);
+    end
+  end
+
+  methods (Access=protected)
+    function value = read_floats_(self)
+      json = self.read_json_line_("floats");
+      value = self.floats_converter.from_json(json);
+    end
+
+    function value = read_doubles_(self)
+      json = self.read_json_line_("doubles");
+      value = self.doubles_converter.from_json(json);
+    end
+  end
+end
@@ -0,0 +1,28 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef ComplexArraysWriter < yardl.ndjson.NDJsonProtocolWriter & test_model.ComplexArraysWriterBase
+  % NDJSON writer for the ComplexArrays protocol
+  properties (Access=protected)
+    floats_converter
+    doubles_converter
+  end
+
+  methods
+    function self = ComplexArraysWriter(filename)
+      self@test_model.ComplexArraysWriterBase();
+      self@yardl.ndjson.NDJsonProtocolWriter(filename, test_model.ComplexArraysWriterBase.schema);
+      self.floats_converter = yardl.ndjson.DynamicNDArrayConverter(yardl.ndjson.Complexfloat32Converter);
+      self.doubles_converter = yardl.ndjson.NDArrayConverter(yardl.ndjson.Complexfloat64Converter, 2);
+    end
+  end
+
+  methods (Access=protected)
+    function write_floats_(self, value)
+      self.write_json_line_("floats", self.floats_converter.to_json(value));
+    end
+
+    function write_doubles_(self, value)
+      self.write_json_line_("doubles", self.doubles_converter.to_json(value));
+    end
+  end
+end
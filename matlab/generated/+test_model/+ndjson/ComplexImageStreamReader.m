@@ -0,0 +1,46 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef ComplexImageStreamReader < yardl.ndjson.NDJsonProtocolReader & test_model.ComplexImageStreamReaderBase
+  % NDJSON reader for the ComplexImageStream protocol
+  % A protocol that is instantiated for each pixel type
+  properties (Access=protected)
+    header_converter
+    images_converter
+    background_converter
+  end
+
+  methods
+    function self = ComplexImageStreamReader(filename, options)
+      arguments
+        filename (1,1) string
+        options.skip_completed_check (1,1) logical = false
+      end
+      self@test_model.ComplexImageStreamReaderBase(skip_completed_check=options.skip_completed_check);
+      self@yardl.ndjson.NDJsonProtocolReader(filename, test_model.ComplexImageStreamReaderBase.schema);
+      self.header_converter = test_model.ndjson.GenericRecordConverter(yardl.ndjson.Int32Converter, yardl.ndjson.Complexfloat32Converter);
+      self.images_converter = yardl.ndjson.NDArrayConverter(yardl.ndjson.Complexfloat32Converter, 2);
+      self.background_converter = yardl.ndjson.UnionConverter('test_model.Complexfloat32OrString', {yardl.ndjson.Complexfloat32Converter, yardl.ndjson.StringConverter}, {@test_model.Complexfloat32OrString.Complexfloat32, @test_model.Complexfloat32OrString.String}, ["complexfloat32", "string"], {["array"], ["string"]}, true);
+    end
+  end
+
+  methods (Access=protected)
+    function value = read_header_(self)
+      json = self.read_json_line_("header");
+      value = self.header_converter.from_json(json);
+    end
+
+    function more = has_images_(self)
+      more = self.has_json_line_("images");
+    end
+
+    function value = read_images_(self)
+      json = self.read_json_line_("images");
+      value = self.images_converter.from_json(json);
+    end
+
+    function value = read_background_(self)
+      json = self.read_json_line_("background");
+      value = self.background_converter.from_json(json);
+    end
+  end
+end
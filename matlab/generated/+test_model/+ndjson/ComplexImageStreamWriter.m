@@ -0,0 +1,35 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef ComplexImageStreamWriter < yardl.ndjson.NDJsonProtocolWriter & test_model.ComplexImageStreamWriterBase
+  % NDJSON writer for the ComplexImageStream protocol
+  % A protocol that is instantiated for each pixel type
+  properties (Access=protected)
+    header_converter
+    images_converter
+    background_converter
+  end
+
+  methods
+    function self = ComplexImageStreamWriter(filename)
+      self@test_model.ComplexImageStreamWriterBase();
+      self@yardl.ndjson.NDJsonProtocolWriter(filename, test_model.ComplexImageStreamWriterBase.schema);
+      self.header_converter = test_model.ndjson.GenericRecordConverter(yardl.ndjson.Int32Converter, yardl.ndjson.Complexfloat32Converter);
+      self.images_converter = yardl.ndjson.NDArrayConverter(yardl.ndjson.Complexfloat32Converter, 2);
+      self.background_converter = yardl.ndjson.UnionConverter('test_model.Complexfloat32OrString', {yardl.ndjson.Complexfloat32Converter, yardl.ndjson.StringConverter}, {@test_model.Complexfloat32OrString.Complexfloat32, @test_model.Complexfloat32OrString.String}, ["complexfloat32", "string"], {["array"], ["string"]}, true);
+    end
+  end
+
+  methods (Access=protected)
+    function write_header_(self, value)
+      self.write_json_line_("header", self.header_converter.to_json(value));
+    end
+
+    function write_images_(self, value)
+      self.write_json_stream_("images", self.images_converter, value);
+    end
+
+    function write_background_(self, value)
+      self.write_json_line_("background", self.background_converter.to_json(value));
+    end
+  end
+end
@@ -0,0 +1,48 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef DynamicNDArraysReader < yardl.ndjson.NDJsonProtocolReader & test_model.DynamicNDArraysReaderBase
+  % NDJSON reader for the DynamicNDArrays protocol
+  properties (Access=protected)
+    ints_converter
+    simple_record_array_converter
+    record_with_vlens_array_converter
+    record_with_dynamic_nd_arrays_converter
+  end
+
+  methods
+    function self = DynamicNDArraysReader(filename, options)
+      arguments
+        filename (1,1) string
+        options.skip_completed_check (1,1) logical = false
+      end
+      self@test_model.DynamicNDArraysReaderBase(skip_completed_check=options.skip_completed_check);
+      self@yardl.ndjson.NDJsonProtocolReader(filename, test_model.DynamicNDArraysReaderBase.schema);
+      self.ints_converter = yardl.ndjson.DynamicNDArrayConverter(yardl.ndjson.Int32Converter);
+      self.simple_record_array_converter = yardl.ndjson.DynamicNDArrayConverter(test_model.ndjson.SimpleRecordConverter());
+      self.record_with_vlens_array_converter = yardl.ndjson.DynamicNDArrayConverter(test_model.ndjson.RecordWithVlensConverter());
+      self.record_with_dynamic_nd_arrays_converter = test_model.ndjson.RecordWithDynamicNDArraysConverter();
+    end
+  end
+
+  methods (Access=protected)
+    function value = read_ints_(self)
+      json = self.read_json_line_("ints");
+      value = self.ints_converter.from_json(json);
+    end
+
+    function value = read_simple_record_array_(self)
+      json = self.read_json_line_("simpleRecordArray");
+      value = self.simple_record_array_converter.from_json(json);
+    end
+
+    function value = read_record_with_vlens_array_(self)
+      json = self.read_json_line_("recordWithVlensArray");
+      value = self.record_with_vlens_array_converter.from_json(json);
+    end
+
+    function value = read_record_with_dynamic_nd_arrays_(self)
+      json = self.read_json_line_("recordWithDynamicNDArrays");
+      value = self.record_with_dynamic_nd_arrays_converter.from_json(json);
+    end
+  end
+end
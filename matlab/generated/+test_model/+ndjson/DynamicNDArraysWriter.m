@@ -0,0 +1,40 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef DynamicNDArraysWriter < yardl.ndjson.NDJsonProtocolWriter & test_model.DynamicNDArraysWriterBase
+  % NDJSON writer for the DynamicNDArrays protocol
+  properties (Access=protected)
+    ints_converter
+    simple_record_array_converter
+    record_with_vlens_array_converter
+    record_with_dynamic_nd_arrays_converter
+  end
+
+  methods
+    function self = DynamicNDArraysWriter(filename)
+      self@test_model.DynamicNDArraysWriterBase();
+      self@yardl.ndjson.NDJsonProtocolWriter(filename, test_model.DynamicNDArraysWriterBase.schema);
+      self.ints_converter = yardl.ndjson.DynamicNDArrayConverter(yardl.ndjson.Int32Converter);
+      self.simple_record_array_converter = yardl.ndjson.DynamicNDArrayConverter(test_model.ndjson.SimpleRecordConverter());
+      self.record_with_vlens_array_converter = yardl.ndjson.DynamicNDArrayConverter(test_model.ndjson.RecordWithVlensConverter());
+      self.record_with_dynamic_nd_arrays_converter = test_model.ndjson.RecordWithDynamicNDArraysConverter();
+    end
+  end
+
+  methods (Access=protected)
+    function write_ints_(self, value)
+      self.write_json_line_("ints", self.ints_converter.to_json(value));
+    end
+
+    function write_simple_record_array_(self, value)
+      self.write_json_line_("simpleRecordArray", self.simple_record_array_converter.to_json(value));
+    end
+
+    function write_record_with_vlens_array_(self, value)
+      self.write_json_line_("recordWithVlensArray", self.record_with_vlens_array_converter.to_json(value));
+    end
+
+    function write_record_with_dynamic_nd_arrays_(self, value)
+      self.write_json_line_("recordWithDynamicNDArrays", self.record_with_dynamic_nd_arrays_converter.to_json(value));
+    end
+  end
+end
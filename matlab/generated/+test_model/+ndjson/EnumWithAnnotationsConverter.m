@@ -0,0 +1,11 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef EnumWithAnnotationsConverter < yardl.ndjson.EnumConverter
+  methods
+    function self = EnumWithAnnotationsConverter()
+      symbols = ["a", "b"];
+      values = [test_model.EnumWithAnnotations.A, test_model.EnumWithAnnotations.B];
+      self@yardl.ndjson.EnumConverter('test_model.EnumWithAnnotations', @test_model.EnumWithAnnotations, yardl.ndjson.Int32Converter, symbols, values);
+    end
+  end
+end
@@ -0,0 +1,11 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef EnumWithKeywordSymbolsConverter < yardl.ndjson.EnumConverter
+  methods
+    function self = EnumWithKeywordSymbolsConverter()
+      symbols = ["try", "catch"];
+      values = [test_model.EnumWithKeywordSymbols.TRY, test_model.EnumWithKeywordSymbols.CATCH];
+      self@yardl.ndjson.EnumConverter('test_model.EnumWithKeywordSymbols', @test_model.EnumWithKeywordSymbols, yardl.ndjson.Int32Converter, symbols, values);
+    end
+  end
+end
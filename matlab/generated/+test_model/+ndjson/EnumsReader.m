@@ -0,0 +1,48 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef EnumsReader < yardl.ndjson.NDJsonProtocolReader & test_model.EnumsReaderBase
+  % NDJSON reader for the Enums protocol
+  properties (Access=protected)
+    single_converter
+    vec_converter
+    size_converter
+    rec_converter
+  end
+
+  methods
+    function self = EnumsReader(filename, options)
+      arguments
+        filename (1,1) string
+        options.skip_completed_check (1,1) logical = false
+      end
+      self@test_model.EnumsReaderBase(skip_completed_check=options.skip_completed_check);
+      self@yardl.ndjson.NDJsonProtocolReader(filename, test_model.EnumsReaderBase.schema);
+      self.single_converter = basic_types.ndjson.FruitsConverter();
+      self.vec_converter = yardl.ndjson.VectorConverter(basic_types.ndjson.FruitsConverter());
+      self.size_converter = test_model.ndjson.SizeBasedEnumConverter();
+      self.rec_converter = test_model.ndjson.RecordWithEnumsConverter();
+    end
+  end
+
+  methods (Access=protected)
+    function value = read_single_(self)
+      json = self.read_json_line_("single");
+      value = self.single_converter.from_json(json);
+    end
+
+    function value = read_vec_(self)
+      json = self.read_json_line_("vec");
+      value = self.vec_converter.from_json(json);
+    end
+
+    function value = read_size_(self)
+      json = self.read_json_line_("size");
+      value = self.size_converter.from_json(json);
+    end
+
+    function value = read_rec_(self)
+      json = self.read_json_line_("rec");
+      value = self.rec_converter.from_json(json);
+    end
+  end
+end
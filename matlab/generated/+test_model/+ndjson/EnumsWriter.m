@@ -0,0 +1,40 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef EnumsWriter < yardl.ndjson.NDJsonProtocolWriter & test_model.EnumsWriterBase
+  % NDJSON writer for the Enums protocol
+  properties (Access=protected)
+    single_converter
+    vec_converter
+    size_converter
+    rec_converter
+  end
+
+  methods
+    function self = EnumsWriter(filename)
+      self@test_model.EnumsWriterBase();
+      self@yardl.ndjson.NDJsonProtocolWriter(filename, test_model.EnumsWriterBase.schema);
+      self.single_converter = basic_types.ndjson.FruitsConverter();
+      self.vec_converter = yardl.ndjson.VectorConverter(basic_types.ndjson.FruitsConverter());
+      self.size_converter = test_model.ndjson.SizeBasedEnumConverter();
+      self.rec_converter = test_model.ndjson.RecordWithEnumsConverter();
+    end
+  end
+
+  methods (Access=protected)
+    function write_single_(self, value)
+      self.write_json_line_("single", self.single_converter.to_json(value));
+    end
+
+    function write_vec_(self, value)
+      self.write_json_line_("vec", self.vec_converter.to_json(value));
+    end
+
+    function write_size_(self, value)
+      self.write_json_line_("size", self.size_converter.to_json(value));
+    end
+
+    function write_rec_(self, value)
+      self.write_json_line_("rec", self.rec_converter.to_json(value));
+    end
+  end
+end
@@ -0,0 +1,25 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef ExpressionConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = ExpressionConverter()
+      field_converters{1} = yardl.ndjson.StringConverter;
+      field_converters{2} = yardl.ndjson.UnionConverter('test_model.Int32OrExpression', {yardl.ndjson.Int32Converter, yardl.ndjson.RecursiveConverter('test_model.Expression', @() test_model.ndjson.ExpressionConverter())}, {@test_model.Int32OrExpression.Int32, @test_model.Int32OrExpression.Expression}, ["int32", "Expression"], {["number"], ["object"]}, true);
+      field_converters{3} = yardl.ndjson.MapConverter(yardl.ndjson.StringConverter, yardl.ndjson.RecursiveConverter('test_model.Expression', @() test_model.ndjson.ExpressionConverter()));
+      self@yardl.ndjson.RecordConverter('test_model.Expression', ["name", "operand", "arguments"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.Expression
+      end
+      json = self.to_json_(value.name, value.operand, value.arguments);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.Expression(name=fields{1}, operand=fields{2}, arguments=fields{3});
+    end
+  end
+end
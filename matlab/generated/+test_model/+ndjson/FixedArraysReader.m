@@ -0,0 +1,55 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef FixedArraysReader < yardl.ndjson.NDJsonProtocolReader & test_model.FixedArraysReaderBase
+  % NDJSON reader for the FixedArrays protocol
+  properties (Access=protected)
+    ints_converter
+    fixed_simple_record_array_converter
+    fixed_record_with_vlens_array_converter
+    record_with_fixed_arrays_converter
+    named_array_converter
+  end
+
+  methods
+    function self = FixedArraysReader(filename, options)
+      arguments
+        filename (1,1) string
+        options.skip_completed_check (1,1) logical = false
+      end
+      self@test_model.FixedArraysReaderBase(skip_completed_check=options.skip_completed_check);
+      self@yardl.ndjson.NDJsonProtocolReader(filename, test_model.FixedArraysReaderBase.schema);
+      self.ints_converter = yardl.ndjson.FixedNDArrayConverter(yardl.ndjson.Int32Converter, [3, 2]);
+      self.fixed_simple_record_array_converter = yardl.ndjson.FixedNDArrayConverter(test_model.ndjson.SimpleRecordConverter(), [2, 3]);
+      self.fixed_record_with_vlens_array_converter = yardl.ndjson.FixedNDArrayConverter(test_model.ndjson.RecordWithVlensConverter(), [2, 2]);
+      self.record_with_fixed_arrays_converter = test_model.ndjson.RecordWithFixedArraysConverter();
+      self.named_array_converter = yardl.ndjson.FixedNDArrayConverter(yardl.ndjson.Int32Converter, [4, 2]);
+    end
+  end
+
+  methods (Access=protected)
+    function value = read_ints_(self)
+      json = self.read_json_line_("ints");
+      value = self.ints_converter.from_json(json);
+    end
+
+    function value = read_fixed_simple_record_array_(self)
+      json = self.read_json_line_("fixedSimpleRecordArray");
+      value = self.fixed_simple_record_array_converter.from_json(json);
+    end
+
+    function value = read_fixed_record_with_vlens_array_(self)
+      json = self.read_json_line_("fixedRecordWithVlensArray");
+      value = self.fixed_record_with_vlens_array_converter.from_json(json);
+    end
+
+    function value = read_record_with_fixed_arrays_(self)
+      json = self.read_json_line_("recordWithFixedArrays");
+      value = self.record_with_fixed_arrays_converter.from_json(json);
+    end
+
+    function value = read_named_array_(self)
+      json = self.read_json_line_("namedArray");
+      value = self.named_array_converter.from_json(json);
+    end
+  end
+end
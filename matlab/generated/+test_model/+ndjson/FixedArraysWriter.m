@@ -0,0 +1,46 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef FixedArraysWriter < yardl.ndjson.NDJsonProtocolWriter & test_model.FixedArraysWriterBase
+  % NDJSON writer for the FixedArrays protocol
+  properties (Access=protected)
+    ints_converter
+    fixed_simple_record_array_converter
+    fixed_record_with_vlens_array_converter
+    record_with_fixed_arrays_converter
+    named_array_converter
+  end
+
+  methods
+    function self = FixedArraysWriter(filename)
+      self@test_model.FixedArraysWriterBase();
+      self@yardl.ndjson.NDJsonProtocolWriter(filename, test_model.FixedArraysWriterBase.schema);
+      self.ints_converter = yardl.ndjson.FixedNDArrayConverter(yardl.ndjson.Int32Converter, [3, 2]);
+      self.fixed_simple_record_array_converter = yardl.ndjson.FixedNDArrayConverter(test_model.ndjson.SimpleRecordConverter(), [2, 3]);
+      self.fixed_record_with_vlens_array_converter = yardl.ndjson.FixedNDArrayConverter(test_model.ndjson.RecordWithVlensConverter(), [2, 2]);
+      self.record_with_fixed_arrays_converter = test_model.ndjson.RecordWithFixedArraysConverter();
+      self.named_array_converter = yardl.ndjson.FixedNDArrayConverter(yardl.ndjson.Int32Converter, [4, 2]);
+    end
+  end
+
+  methods (Access=protected)
+    function write_ints_(self, value)
+      self.write_json_line_("ints", self.ints_converter.to_json(value));
+    end
+
+    function write_fixed_simple_record_array_(self, value)
+      self.write_json_line_("fixedSimpleRecordArray", self.fixed_simple_record_array_converter.to_json(value));
+    end
+
+    function write_fixed_record_with_vlens_array_(self, value)
+      self.write_json_line_("fixedRecordWithVlensArray", self.fixed_record_with_vlens_array_converter.to_json(value));
+    end
+
+    function write_record_with_fixed_arrays_(self, value)
+      self.write_json_line_("recordWithFixedArrays", self.record_with_fixed_arrays_converter.to_json(value));
+    end
+
+    function write_named_array_(self, value)
+      self.write_json_line_("namedArray", self.named_array_converter.to_json(value));
+    end
+  end
+end
@@ -0,0 +1,48 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef FixedVectorsReader < yardl.ndjson.NDJsonProtocolReader & test_model.FixedVectorsReaderBase
+  % NDJSON reader for the FixedVectors protocol
+  properties (Access=protected)
+    fixed_int_vector_converter
+    fixed_simple_record_vector_converter
+    fixed_record_with_vlens_vector_converter
+    record_with_fixed_vectors_converter
+  end
+
+  methods
+    function self = FixedVectorsReader(filename, options)
+      arguments
+        filename (1,1) string
+        options.skip_completed_check (1,1) logical = false
+      end
+      self@test_model.FixedVectorsReaderBase(skip_completed_check=options.skip_completed_check);
+      self@yardl.ndjson.NDJsonProtocolReader(filename, test_model.FixedVectorsReaderBase.schema);
+      self.fixed_int_vector_converter = yardl.ndjson.FixedVectorConverter(yardl.ndjson.Int32Converter, 5);
+      self.fixed_simple_record_vector_converter = yardl.ndjson.FixedVectorConverter(test_model.ndjson.SimpleRecordConverter(), 3);
+      self.fixed_record_with_vlens_vector_converter = yardl.ndjson.FixedVectorConverter(test_model.ndjson.RecordWithVlensConverter(), 2);
+      self.record_with_fixed_vectors_converter = test_model.ndjson.RecordWithFixedVectorsConverter();
+    end
+  end
+
+  methods (Access=protected)
+    function value = read_fixed_int_vector_(self)
+      json = self.read_json_line_("fixedIntVector");
+      value = self.fixed_int_vector_converter.from_json(json);
+    end
+
+    function value = read_fixed_simple_record_vector_(self)
+      json = self.read_json_line_("fixedSimpleRecordVector");
+      value = self.fixed_simple_record_vector_converter.from_json(json);
+    end
+
+    function value = read_fixed_record_with_vlens_vector_(self)
+      json = self.read_json_line_("fixedRecordWithVlensVector");
+      value = self.fixed_record_with_vlens_vector_converter.from_json(json);
+    end
+
+    function value = read_record_with_fixed_vectors_(self)
+      json = self.read_json_line_("recordWithFixedVectors");
+      value = self.record_with_fixed_vectors_converter.from_json(json);
+    end
+  end
+end
@@ -0,0 +1,40 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef FixedVectorsWriter < yardl.ndjson.NDJsonProtocolWriter & test_model.FixedVectorsWriterBase
+  % NDJSON writer for the FixedVectors protocol
+  properties (Access=protected)
+    fixed_int_vector_converter
+    fixed_simple_record_vector_converter
+    fixed_record_with_vlens_vector_converter
+    record_with_fixed_vectors_converter
+  end
+
+  methods
+    function self = FixedVectorsWriter(filename)
+      self@test_model.FixedVectorsWriterBase();
+      self@yardl.ndjson.NDJsonProtocolWriter(filename, test_model.FixedVectorsWriterBase.schema);
+      self.fixed_int_vector_converter = yardl.ndjson.FixedVectorConverter(yardl.ndjson.Int32Converter, 5);
+      self.fixed_simple_record_vector_converter = yardl.ndjson.FixedVectorConverter(test_model.ndjson.SimpleRecordConverter(), 3);
+      self.fixed_record_with_vlens_vector_converter = yardl.ndjson.FixedVectorConverter(test_model.ndjson.RecordWithVlensConverter(), 2);
+      self.record_with_fixed_vectors_converter = test_model.ndjson.RecordWithFixedVectorsConverter();
+    end
+  end
+
+  methods (Access=protected)
+    function write_fixed_int_vector_(self, value)
+      self.write_json_line_("fixedIntVector", self.fixed_int_vector_converter.to_json(value));
+    end
+
+    function write_fixed_simple_record_vector_(self, value)
+      self.write_json_line_("fixedSimpleRecordVector", self.fixed_simple_record_vector_converter.to_json(value));
+    end
+
+    function write_fixed_record_with_vlens_vector_(self, value)
+      self.write_json_line_("fixedRecordWithVlensVector", self.fixed_record_with_vlens_vector_converter.to_json(value));
+    end
+
+    function write_record_with_fixed_vectors_(self, value)
+      self.write_json_line_("recordWithFixedVectors", self.record_with_fixed_vectors_converter.to_json(value));
+    end
+  end
+end
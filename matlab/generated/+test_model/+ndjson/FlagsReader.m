@@ -0,0 +1,42 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef FlagsReader < yardl.ndjson.NDJsonProtocolReader & test_model.FlagsReaderBase
+  % NDJSON reader for the Flags protocol
+  properties (Access=protected)
+    days_converter
+    formats_converter
+  end
+
+  methods
+    function self = FlagsReader(filename, options)
+      arguments
+        filename (1,1) string
+        options.skip_completed_check (1,1) logical = false
+      end
+      self@test_model.FlagsReaderBase(skip_completed_check=options.skip_completed_check);
+      self@yardl.ndjson.NDJsonProtocolReader(filename, test_model.FlagsReaderBase.schema);
+      self.days_converter = basic_types.ndjson.DaysOfWeekConverter();
+      self.formats_converter = basic_types.ndjson.TextFormatConverter();
+    end
+  end
+
+  methods (Access=protected)
+    function more = has_days_(self)
+      more = self.has_json_line_("days");
+    end
+
+    function value = read_days_(self)
+      json = self.read_json_line_("days");
+      value = self.days_converter.from_json(json);
+    end
+
+    function more = has_formats_(self)
+      more = self.has_json_line_("formats");
+    end
+
+    function value = read_formats_(self)
+      json = self.read_json_line_("formats");
+      value = self.formats_converter.from_json(json);
+    end
+  end
+end
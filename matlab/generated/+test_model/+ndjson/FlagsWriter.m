@@ -0,0 +1,28 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef FlagsWriter < yardl.ndjson.NDJsonProtocolWriter & test_model.FlagsWriterBase
+  % NDJSON writer for the Flags protocol
+  properties (Access=protected)
+    days_converter
+    formats_converter
+  end
+
+  methods
+    function self = FlagsWriter(filename)
+      self@test_model.FlagsWriterBase();
+      self@yardl.ndjson.NDJsonProtocolWriter(filename, test_model.FlagsWriterBase.schema);
+      self.days_converter = basic_types.ndjson.DaysOfWeekConverter();
+      self.formats_converter = basic_types.ndjson.TextFormatConverter();
+    end
+  end
+
+  methods (Access=protected)
+    function write_days_(self, value)
+      self.write_json_stream_("days", self.days_converter, value);
+    end
+
+    function write_formats_(self, value)
+      self.write_json_stream_("formats", self.formats_converter, value);
+    end
+  end
+end
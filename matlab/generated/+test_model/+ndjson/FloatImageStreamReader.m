@@ -0,0 +1,46 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef FloatImageStreamReader < yardl.ndjson.NDJsonProtocolReader & test_model.FloatImageStreamReaderBase
+  % NDJSON reader for the FloatImageStream protocol
+  % A protocol that is instantiated for each pixel type
+  properties (Access=protected)
+    header_converter
+    images_converter
+    background_converter
+  end
+
+  methods
+    function self = FloatImageStreamReader(filename, options)
+      arguments
+        filename (1,1) string
+        options.skip_completed_check (1,1) logical = false
+      end
+      self@test_model.FloatImageStreamReaderBase(skip_completed_check=options.skip_completed_check);
+      self@yardl.ndjson.NDJsonProtocolReader(filename, test_model.FloatImageStreamReaderBase.schema);
+      self.header_converter = test_model.ndjson.GenericRecordConverter(yardl.ndjson.Int32Converter, yardl.ndjson.Float32Converter);
+      self.images_converter = yardl.ndjson.NDArrayConverter(yardl.ndjson.Float32Converter, 2);
+      self.background_converter = yardl.ndjson.UnionConverter('test_model.Float32OrString', {yardl.ndjson.Float32Converter, yardl.ndjson.StringConverter}, {@test_model.Float32OrString.Float32, @test_model.Float32OrString.String}, ["float32", "string"], {["number"], ["string"]}, true);
+    end
+  end
+
+  methods (Access=protected)
+    function value = read_header_(self)
+      json = self.read_json_line_("header");
+      value = self.header_converter.from_json(json);
+    end
+
+    function more = has_images_(self)
+      more = self.has_json_line_("images");
+    end
+
+    function value = read_images_(self)
+      json = self.read_json_line_("images");
+      value = self.images_converter.from_json(json);
+    end
+
+    function value = read_background_(self)
+      json = self.read_json_line_("background");
+      value = self.background_converter.from_json(json);
+    end
+  end
+end
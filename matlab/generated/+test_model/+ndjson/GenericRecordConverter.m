@@ -0,0 +1,26 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef GenericRecordConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = GenericRecordConverter(t1_converter, t2_converter)
+      field_converters{1} = t1_converter;
+      field_converters{2} = t2_converter;
+      field_converters{3} = yardl.ndjson.VectorConverter(t1_converter);
+      field_converters{4} = yardl.ndjson.NDArrayConverter(t2_converter, 2);
+      self@yardl.ndjson.RecordConverter('test_model.GenericRecord', ["scalar1", "scalar2", "vector1", "image2"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.GenericRecord
+      end
+      json = self.to_json_(value.scalar_1, value.scalar_2, value.vector_1, value.image_2);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.GenericRecord(scalar_1=fields{1}, scalar_2=fields{2}, vector_1=fields{3}, image_2=fields{4});
+    end
+  end
+end
@@ -0,0 +1,11 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef Int64EnumConverter < yardl.ndjson.EnumConverter
+  methods
+    function self = Int64EnumConverter()
+      symbols = ["b"];
+      values = [test_model.Int64Enum.B];
+      self@yardl.ndjson.EnumConverter('test_model.Int64Enum', @test_model.Int64Enum, yardl.ndjson.Int64Converter, symbols, values);
+    end
+  end
+end
@@ -0,0 +1,23 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef LabeledHeaderConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = LabeledHeaderConverter(t_converter)
+      field_converters{1} = t_converter;
+      self@yardl.ndjson.RecordConverter('test_model.LabeledHeader', ["label"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.LabeledHeader
+      end
+      json = self.to_json_(value.label);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.LabeledHeader(label=fields{1});
+    end
+  end
+end
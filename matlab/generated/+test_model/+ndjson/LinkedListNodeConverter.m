@@ -0,0 +1,24 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef LinkedListNodeConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = LinkedListNodeConverter()
+      field_converters{1} = yardl.ndjson.Int32Converter;
+      field_converters{2} = yardl.ndjson.OptionalConverter(yardl.ndjson.RecursiveConverter('test_model.LinkedListNode', @() test_model.ndjson.LinkedListNodeConverter()));
+      self@yardl.ndjson.RecordConverter('test_model.LinkedListNode', ["value", "next"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.LinkedListNode
+      end
+      json = self.to_json_(value.value, value.next);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.LinkedListNode(value=fields{1}, next=fields{2});
+    end
+  end
+end
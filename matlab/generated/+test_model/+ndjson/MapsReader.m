@@ -0,0 +1,55 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef MapsReader < yardl.ndjson.NDJsonProtocolReader & test_model.MapsReaderBase
+  % NDJSON reader for the Maps protocol
+  properties (Access=protected)
+    string_to_int_converter
+    int_to_string_converter
+    string_to_union_converter
+    aliased_generic_converter
+    records_converter
+  end
+
+  methods
+    function self = MapsReader(filename, options)
+      arguments
+        filename (1,1) string
+        options.skip_completed_check (1,1) logical = false
+      end
+      self@test_model.MapsReaderBase(skip_completed_check=options.skip_completed_check);
+      self@yardl.ndjson.NDJsonProtocolReader(filename, test_model.MapsReaderBase.schema);
+      self.string_to_int_converter = yardl.ndjson.MapConverter(yardl.ndjson.StringConverter, yardl.ndjson.Int32Converter);
+      self.int_to_string_converter = yardl.ndjson.MapConverter(yardl.ndjson.Int32Converter, yardl.ndjson.StringConverter);
+      self.string_to_union_converter = yardl.ndjson.MapConverter(yardl.ndjson.StringConverter, yardl.ndjson.UnionConverter('test_model.StringOrInt32', {yardl.ndjson.StringConverter, yardl.ndjson.Int32Converter}, {@test_model.StringOrInt32.String, @test_model.StringOrInt32.Int32}, ["string", "int32"], {["string"], ["number"]}, true));
+      self.aliased_generic_converter = yardl.ndjson.MapConverter(yardl.ndjson.StringConverter, yardl.ndjson.Int32Converter);
+      self.records_converter = yardl.ndjson.VectorConverter(test_model.ndjson.RecordWithMapsConverter());
+    end
+  end
+
+  methods (Access=protected)
+    function value = read_string_to_int_(self)
+      json = self.read_json_line_("stringToInt");
+      value = self.string_to_int_converter.from_json(json);
+    end
+
+    function value = read_int_to_string_(self)
+      json = self.read_json_line_("intToString");
+      value = self.int_to_string_converter.from_json(json);
+    end
+
+    function value = read_string_to_union_(self)
+      json = self.read_json_line_("stringToUnion");
+      value = self.string_to_union_converter.from_json(json);
+    end
+
+    function value = read_aliased_generic_(self)
+      json = self.read_json_line_("aliasedGeneric");
+      value = self.aliased_generic_converter.from_json(json);
+    end
+
+    function value = read_records_(self)
+      json = self.read_json_line_("records");
+      value = self.records_converter.from_json(json);
+    end
+  end
+end
@@ -0,0 +1,46 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef MapsWriter < yardl.ndjson.NDJsonProtocolWriter & test_model.MapsWriterBase
+  % NDJSON writer for the Maps protocol
+  properties (Access=protected)
+    string_to_int_converter
+    int_to_string_converter
+    string_to_union_converter
+    aliased_generic_converter
+    records_converter
+  end
+
+  methods
+    function self = MapsWriter(filename)
+      self@test_model.MapsWriterBase();
+      self@yardl.ndjson.NDJsonProtocolWriter(filename, test_model.MapsWriterBase.schema);
+      self.string_to_int_converter = yardl.ndjson.MapConverter(yardl.ndjson.StringConverter, yardl.ndjson.Int32Converter);
+      self.int_to_string_converter = yardl.ndjson.MapConverter(yardl.ndjson.Int32Converter, yardl.ndjson.StringConverter);
+      self.string_to_union_converter = yardl.ndjson.MapConverter(yardl.ndjson.StringConverter, yardl.ndjson.UnionConverter('test_model.StringOrInt32', {yardl.ndjson.StringConverter, yardl.ndjson.Int32Converter}, {@test_model.StringOrInt32.String, @test_model.StringOrInt32.Int32}, ["string", "int32"], {["string"], ["number"]}, true));
+      self.aliased_generic_converter = yardl.ndjson.MapConverter(yardl.ndjson.StringConverter, yardl.ndjson.Int32Converter);
+      self.records_converter = yardl.ndjson.VectorConverter(test_model.ndjson.RecordWithMapsConverter());
+    end
+  end
+
+  methods (Access=protected)
+    function write_string_to_int_(self, value)
+      self.write_json_line_("stringToInt", self.string_to_int_converter.to_json(value));
+    end
+
+    function write_int_to_string_(self, value)
+      self.write_json_line_("intToString", self.int_to_string_converter.to_json(value));
+    end
+
+    function write_string_to_union_(self, value)
+      self.write_json_line_("stringToUnion", self.string_to_union_converter.to_json(value));
+    end
+
+    function write_aliased_generic_(self, value)
+      self.write_json_line_("aliasedGeneric", self.aliased_generic_converter.to_json(value));
+    end
+
+    function write_records_(self, value)
+      self.write_json_line_("records", self.records_converter.to_json(value));
+    end
+  end
+end
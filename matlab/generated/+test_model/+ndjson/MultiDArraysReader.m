@@ -0,0 +1,42 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef MultiDArraysReader < yardl.ndjson.NDJsonProtocolReader & test_model.MultiDArraysReaderBase
+  % NDJSON reader for the MultiDArrays protocol
+  properties (Access=protected)
+    images_converter
+    frames_converter
+  end
+
+  methods
+    function self = MultiDArraysReader(filename, options)
+      arguments
+        filename (1,1) string
+        options.skip_completed_check (1,1) logical = false
+      end
+      self@test_model.MultiDArraysReaderBase(skip_completed_check=options.skip_completed_check);
+      self@yardl.ndjson.NDJsonProtocolReader(filename, test_model.MultiDArraysReaderBase.schema);
+      self.images_converter = yardl.ndjson.NDArrayConverter(yardl.ndjson.Float32Converter, 4);
+      self.frames_converter = yardl.ndjson.FixedNDArrayConverter(yardl.ndjson.Float32Converter, [32, 64, 1, 1]);
+    end
+  end
+
+  methods (Access=protected)
+    function more = has_images_(self)
+      more = self.has_json_line_("images");
+    end
+
+    function value = read_images_(self)
+      json = self.read_json_line_("images");
+      value = self.images_converter.from_json(json);
+    end
+
+    function more = has_frames_(self)
+      more = self.has_json_line_("frames");
+    end
+
+    function value = read_frames_(self)
+      json = self.read_json_line_("frames");
+      value = self.frames_converter.from_json(json);
+    end
+  end
+end
@@ -0,0 +1,28 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef MultiDArraysWriter < yardl.ndjson.NDJsonProtocolWriter & test_model.MultiDArraysWriterBase
+  % NDJSON writer for the MultiDArrays protocol
+  properties (Access=protected)
+    images_converter
+    frames_converter
+  end
+
+  methods
+    function self = MultiDArraysWriter(filename)
+      self@test_model.MultiDArraysWriterBase();
+      self@yardl.ndjson.NDJsonProtocolWriter(filename, test_model.MultiDArraysWriterBase.schema);
+      self.images_converter = yardl.ndjson.NDArrayConverter(yardl.ndjson.Float32Converter, 4);
+      self.frames_converter = yardl.ndjson.FixedNDArrayConverter(yardl.ndjson.Float32Converter, [32, 64, 1, 1]);
+    end
+  end
+
+  methods (Access=protected)
+    function write_images_(self, value)
+      self.write_json_stream_("images", self.images_converter, value);
+    end
+
+    function write_frames_(self, value)
+      self.write_json_stream_("frames", self.frames_converter, value);
+    end
+  end
+end
@@ -0,0 +1,55 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef NDArraysReader < yardl.ndjson.NDJsonProtocolReader & test_model.NDArraysReaderBase
+  % NDJSON reader for the NDArrays protocol
+  properties (Access=protected)
+    ints_converter
+    simple_record_array_converter
+    record_with_vlens_array_converter
+    record_with_nd_arrays_converter
+    named_array_converter
+  end
+
+  methods
+    function self = NDArraysReader(filename, options)
+      arguments
+        filename (1,1) string
+        options.skip_completed_check (1,1) logical = false
+      end
+      self@test_model.NDArraysReaderBase(skip_completed_check=options.skip_completed_check);
+      self@yardl.ndjson.NDJsonProtocolReader(filename, test_model.NDArraysReaderBase.schema);
+      self.ints_converter = yardl.ndjson.NDArrayConverter(yardl.ndjson.Int32Converter, 2);
+      self.simple_record_array_converter = yardl.ndjson.NDArrayConverter(test_model.ndjson.SimpleRecordConverter(), 2);
+      self.record_with_vlens_array_converter = yardl.ndjson.NDArrayConverter(test_model.ndjson.RecordWithVlensConverter(), 2);
+      self.record_with_nd_arrays_converter = test_model.ndjson.RecordWithNDArraysConverter();
+      self.named_array_converter = yardl.ndjson.NDArrayConverter(yardl.ndjson.Int32Converter, 2);
+    end
+  end
+
+  methods (Access=protected)
+    function value = read_ints_(self)
+      json = self.read_json_line_("ints");
+      value = self.ints_converter.from_json(json);
+    end
+
+    function value = read_simple_record_array_(self)
+      json = self.read_json_line_("simpleRecordArray");
+      value = self.simple_record_array_converter.from_json(json);
+    end
+
+    function value = read_record_with_vlens_array_(self)
+      json = self.read_json_line_("recordWithVlensArray");
+      value = self.record_with_vlens_array_converter.from_json(json);
+    end
+
+    function value = read_record_with_nd_arrays_(self)
+      json = self.read_json_line_("recordWithNDArrays");
+      value = self.record_with_nd_arrays_converter.from_json(json);
+    end
+
+    function value = read_named_array_(self)
+      json = self.read_json_line_("namedArray");
+      value = self.named_array_converter.from_json(json);
+    end
+  end
+end
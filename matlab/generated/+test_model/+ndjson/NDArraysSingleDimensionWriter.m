@@ -0,0 +1,40 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef NDArraysSingleDimensionWriter < yardl.ndjson.NDJsonProtocolWriter & test_model.NDArraysSingleDimensionWriterBase
+  % NDJSON writer for the NDArraysSingleDimension protocol
+  properties (Access=protected)
+    ints_converter
+    simple_record_array_converter
+    record_with_vlens_array_converter
+    record_with_nd_arrays_converter
+  end
+
+  methods
+    function self = NDArraysSingleDimensionWriter(filename)
+      self@test_model.NDArraysSingleDimensionWriterBase();
+      self@yardl.ndjson.NDJsonProtocolWriter(filename, test_model.NDArraysSingleDimensionWriterBase.schema);
+      self.ints_converter = yardl.ndjson.NDArrayConverter(yardl.ndjson.Int32Converter, 1);
+      self.simple_record_array_converter = yardl.ndjson.NDArrayConverter(test_model.ndjson.SimpleRecordConverter(), 1);
+      self.record_with_vlens_array_converter = yardl.ndjson.NDArrayConverter(test_model.ndjson.RecordWithVlensConverter(), 1);
+      self.record_with_nd_arrays_converter = test_model.ndjson.RecordWithNDArraysSingleDimensionConverter();
+    end
+  end
+
+  methods (Access=protected)
+    function write_ints_(self, value)
+      self.write_json_line_("ints", self.ints_converter.to_json(value));
+    end
+
+    function write_simple_record_array_(self, value)
+      self.write_json_line_("simpleRecordArray", self.simple_record_array_converter.to_json(value));
+    end
+
+    function write_record_with_vlens_array_(self, value)
+      self.write_json_line_("recordWithVlensArray", self.record_with_vlens_array_converter.to_json(value));
+    end
+
+    function write_record_with_nd_arrays_(self, value)
+      self.write_json_line_("recordWithNDArrays", self.record_with_nd_arrays_converter.to_json(value));
+    end
+  end
+end
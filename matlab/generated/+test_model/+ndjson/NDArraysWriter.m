@@ -0,0 +1,46 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef NDArraysWriter < yardl.ndjson.NDJsonProtocolWriter & test_model.NDArraysWriterBase
+  % NDJSON writer for the NDArrays protocol
+  properties (Access=protected)
+    ints_converter
+    simple_record_array_converter
+    record_with_vlens_array_converter
+    record_with_nd_arrays_converter
+    named_array_converter
+  end
+
+  methods
+    function self = NDArraysWriter(filename)
+      self@test_model.NDArraysWriterBase();
+      self@yardl.ndjson.NDJsonProtocolWriter(filename, test_model.NDArraysWriterBase.schema);
+      self.ints_converter = yardl.ndjson.NDArrayConverter(yardl.ndjson.Int32Converter, 2);
+      self.simple_record_array_converter = yardl.ndjson.NDArrayConverter(test_model.ndjson.SimpleRecordConverter(), 2);
+      self.record_with_vlens_array_converter = yardl.ndjson.NDArrayConverter(test_model.ndjson.RecordWithVlensConverter(), 2);
+      self.record_with_nd_arrays_converter = test_model.ndjson.RecordWithNDArraysConverter();
+      self.named_array_converter = yardl.ndjson.NDArrayConverter(yardl.ndjson.Int32Converter, 2);
+    end
+  end
+
+  methods (Access=protected)
+    function write_ints_(self, value)
+      self.write_json_line_("ints", self.ints_converter.to_json(value));
+    end
+
+    function write_simple_record_array_(self, value)
+      self.write_json_line_("simpleRecordArray", self.simple_record_array_converter.to_json(value));
+    end
+
+    function write_record_with_vlens_array_(self, value)
+      self.write_json_line_("recordWithVlensArray", self.record_with_vlens_array_converter.to_json(value));
+    end
+
+    function write_record_with_nd_arrays_(self, value)
+      self.write_json_line_("recordWithNDArrays", self.record_with_nd_arrays_converter.to_json(value));
+    end
+
+    function write_named_array_(self, value)
+      self.write_json_line_("namedArray", self.named_array_converter.to_json(value));
+    end
+  end
+end
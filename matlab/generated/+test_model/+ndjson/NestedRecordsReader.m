@@ -0,0 +1,27 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef NestedRecordsReader < yardl.ndjson.NDJsonProtocolReader & test_model.NestedRecordsReaderBase
+  % NDJSON reader for the NestedRecords protocol
+  properties (Access=protected)
+    tuple_with_records_converter
+  end
+
+  methods
+    function self = NestedRecordsReader(filename, options)
+      arguments
+        filename (1,1) string
+        options.skip_completed_check (1,1) logical = false
+      end
+      self@test_model.NestedRecordsReaderBase(skip_completed_check=options.skip_completed_check);
+      self@yardl.ndjson.NDJsonProtocolReader(filename, test_model.NestedRecordsReaderBase.schema);
+      self.tuple_with_records_converter = test_model.ndjson.TupleWithRecordsConverter();
+    end
+  end
+
+  methods (Access=protected)
+    function value = read_tuple_with_records_(self)
+      json = self.read_json_line_("tupleWithRecords");
+      value = self.tuple_with_records_converter.from_json(json);
+    end
+  end
+end
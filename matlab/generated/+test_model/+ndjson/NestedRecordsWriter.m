@@ -0,0 +1,22 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef NestedRecordsWriter < yardl.ndjson.NDJsonProtocolWriter & test_model.NestedRecordsWriterBase
+  % NDJSON writer for the NestedRecords protocol
+  properties (Access=protected)
+    tuple_with_records_converter
+  end
+
+  methods
+    function self = NestedRecordsWriter(filename)
+      self@test_model.NestedRecordsWriterBase();
+      self@yardl.ndjson.NDJsonProtocolWriter(filename, test_model.NestedRecordsWriterBase.schema);
+      self.tuple_with_records_converter = test_model.ndjson.TupleWithRecordsConverter();
+    end
+  end
+
+  methods (Access=protected)
+    function write_tuple_with_records_(self, value)
+      self.write_json_line_("tupleWithRecords", self.tuple_with_records_converter.to_json(value));
+    end
+  end
+end
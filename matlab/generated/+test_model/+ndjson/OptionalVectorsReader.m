@@ -0,0 +1,27 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef OptionalVectorsReader < yardl.ndjson.NDJsonProtocolReader & test_model.OptionalVectorsReaderBase
+  % NDJSON reader for the OptionalVectors protocol
+  properties (Access=protected)
+    record_with_optional_vector_converter
+  end
+
+  methods
+    function self = OptionalVectorsReader(filename, options)
+      arguments
+        filename (1,1) string
+        options.skip_completed_check (1,1) logical = false
+      end
+      self@test_model.OptionalVectorsReaderBase(skip_completed_check=options.skip_completed_check);
+      self@yardl.ndjson.NDJsonProtocolReader(filename, test_model.OptionalVectorsReaderBase.schema);
+      self.record_with_optional_vector_converter = test_model.ndjson.RecordWithOptionalVectorConverter();
+    end
+  end
+
+  methods (Access=protected)
+    function value = read_record_with_optional_vector_(self)
+      json = self.read_json_line_("recordWithOptionalVector");
+      value = self.record_with_optional_vector_converter.from_json(json);
+    end
+  end
+end
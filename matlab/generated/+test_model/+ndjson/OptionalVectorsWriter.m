@@ -0,0 +1,22 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef OptionalVectorsWriter < yardl.ndjson.NDJsonProtocolWriter & test_model.OptionalVectorsWriterBase
+  % NDJSON writer for the OptionalVectors protocol
+  properties (Access=protected)
+    record_with_optional_vector_converter
+  end
+
+  methods
+    function self = OptionalVectorsWriter(filename)
+      self@test_model.OptionalVectorsWriterBase();
+      self@yardl.ndjson.NDJsonProtocolWriter(filename, test_model.OptionalVectorsWriterBase.schema);
+      self.record_with_optional_vector_converter = test_model.ndjson.RecordWithOptionalVectorConverter();
+    end
+  end
+
+  methods (Access=protected)
+    function write_record_with_optional_vector_(self, value)
+      self.write_json_line_("recordWithOptionalVector", self.record_with_optional_vector_converter.to_json(value));
+    end
+  end
+end
@@ -0,0 +1,34 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef ProtocolWithBytesReader < yardl.ndjson.NDJsonProtocolReader & test_model.ProtocolWithBytesReaderBase
+  % NDJSON reader for the ProtocolWithBytes protocol
+  properties (Access=protected)
+    single_bytes_converter
+    rec_with_bytes_converter
+  end
+
+  methods
+    function self = ProtocolWithBytesReader(filename, options)
+      arguments
+        filename (1,1) string
+        options.skip_completed_check (1,1) logical = false
+      end
+      self@test_model.ProtocolWithBytesReaderBase(skip_completed_check=options.skip_completed_check);
+      self@yardl.ndjson.NDJsonProtocolReader(filename, test_model.ProtocolWithBytesReaderBase.schema);
+      self.single_bytes_converter = yardl.ndjson.BytesConverter;
+      self.rec_with_bytes_converter = test_model.ndjson.RecordWithBytesConverter();
+    end
+  end
+
+  methods (Access=protected)
+    function value = read_single_bytes_(self)
+      json = self.read_json_line_("singleBytes");
+      value = self.single_bytes_converter.from_json(json);
+    end
+
+    function value = read_rec_with_bytes_(self)
+      json = self.read_json_line_("recWithBytes");
+      value = self.rec_with_bytes_converter.from_json(json);
+    end
+  end
+end
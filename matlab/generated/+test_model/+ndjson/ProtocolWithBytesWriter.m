@@ -0,0 +1,28 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef ProtocolWithBytesWriter < yardl.ndjson.NDJsonProtocolWriter & test_model.ProtocolWithBytesWriterBase
+  % NDJSON writer for the ProtocolWithBytes protocol
+  properties (Access=protected)
+    single_bytes_converter
+    rec_with_bytes_converter
+  end
+
+  methods
+    function self = ProtocolWithBytesWriter(filename)
+      self@test_model.ProtocolWithBytesWriterBase();
+      self@yardl.ndjson.NDJsonProtocolWriter(filename, test_model.ProtocolWithBytesWriterBase.schema);
+      self.single_bytes_converter = yardl.ndjson.BytesConverter;
+      self.rec_with_bytes_converter = test_model.ndjson.RecordWithBytesConverter();
+    end
+  end
+
+  methods (Access=protected)
+    function write_single_bytes_(self, value)
+      self.write_json_line_("singleBytes", self.single_bytes_converter.to_json(value));
+    end
+
+    function write_rec_with_bytes_(self, value)
+      self.write_json_line_("recWithBytes", self.rec_with_bytes_converter.to_json(value));
+    end
+  end
+end
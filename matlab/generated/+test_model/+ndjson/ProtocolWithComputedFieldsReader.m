@@ -0,0 +1,27 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef ProtocolWithComputedFieldsReader < yardl.ndjson.NDJsonProtocolReader & test_model.ProtocolWithComputedFieldsReaderBase
+  % NDJSON reader for the ProtocolWithComputedFields protocol
+  properties (Access=protected)
+    record_with_computed_fields_converter
+  end
+
+  methods
+    function self = ProtocolWithComputedFieldsReader(filename, options)
+      arguments
+        filename (1,1) string
+        options.skip_completed_check (1,1) logical = false
+      end
+      self@test_model.ProtocolWithComputedFieldsReaderBase(skip_completed_check=options.skip_completed_check);
+      self@yardl.ndjson.NDJsonProtocolReader(filename, test_model.ProtocolWithComputedFieldsReaderBase.schema);
+      self.record_with_computed_fields_converter = test_model.ndjson.RecordWithComputedFieldsConverter();
+    end
+  end
+
+  methods (Access=protected)
+    function value = read_record_with_computed_fields_(self)
+      json = self.read_json_line_("recordWithComputedFields");
+      value = self.record_with_computed_fields_converter.from_json(json);
+    end
+  end
+end
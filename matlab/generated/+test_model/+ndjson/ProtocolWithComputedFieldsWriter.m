@@ -0,0 +1,22 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef ProtocolWithComputedFieldsWriter < yardl.ndjson.NDJsonProtocolWriter & test_model.ProtocolWithComputedFieldsWriterBase
+  % NDJSON writer for the ProtocolWithComputedFields protocol
+  properties (Access=protected)
+    record_with_computed_fields_converter
+  end
+
+  methods
+    function self = ProtocolWithComputedFieldsWriter(filename)
+      self@test_model.ProtocolWithComputedFieldsWriterBase();
+      self@yardl.ndjson.NDJsonProtocolWriter(filename, test_model.ProtocolWithComputedFieldsWriterBase.schema);
+      self.record_with_computed_fields_converter = test_model.ndjson.RecordWithComputedFieldsConverter();
+    end
+  end
+
+  methods (Access=protected)
+    function write_record_with_computed_fields_(self, value)
+      self.write_json_line_("recordWithComputedFields", self.record_with_computed_fields_converter.to_json(value));
+    end
+  end
+end
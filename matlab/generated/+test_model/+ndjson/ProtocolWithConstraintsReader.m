@@ -0,0 +1,38 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef ProtocolWithConstraintsReader < yardl.ndjson.NDJsonProtocolReader & test_model.ProtocolWithConstraintsReaderBase
+  % NDJSON reader for the ProtocolWithConstraints protocol
+  properties (Access=protected)
+    record_converter
+    records_converter
+  end
+
+  methods
+    function self = ProtocolWithConstraintsReader(filename, options)
+      arguments
+        filename (1,1) string
+        options.skip_completed_check (1,1) logical = false
+      end
+      self@test_model.ProtocolWithConstraintsReaderBase(skip_completed_check=options.skip_completed_check);
+      self@yardl.ndjson.NDJsonProtocolReader(filename, test_model.ProtocolWithConstraintsReaderBase.schema);
+      self.record_converter = test_model.ndjson.RecordWithConstraintsConverter();
+      self.records_converter = test_model.ndjson.RecordWithConstrainedRecordsConverter();
+    end
+  end
+
+  methods (Access=protected)
+    function value = read_record_(self)
+      json = self.read_json_line_("record");
+      value = self.record_converter.from_json(json);
+    end
+
+    function more = has_records_(self)
+      more = self.has_json_line_("records");
+    end
+
+    function value = read_records_(self)
+      json = self.read_json_line_("records");
+      value = self.records_converter.from_json(json);
+    end
+  end
+end
@@ -0,0 +1,28 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef ProtocolWithConstraintsWriter < yardl.ndjson.NDJsonProtocolWriter & test_model.ProtocolWithConstraintsWriterBase
+  % NDJSON writer for the ProtocolWithConstraints protocol
+  properties (Access=protected)
+    record_converter
+    records_converter
+  end
+
+  methods
+    function self = ProtocolWithConstraintsWriter(filename)
+      self@test_model.ProtocolWithConstraintsWriterBase();
+      self@yardl.ndjson.NDJsonProtocolWriter(filename, test_model.ProtocolWithConstraintsWriterBase.schema);
+      self.record_converter = test_model.ndjson.RecordWithConstraintsConverter();
+      self.records_converter = test_model.ndjson.RecordWithConstrainedRecordsConverter();
+    end
+  end
+
+  methods (Access=protected)
+    function write_record_(self, value)
+      self.write_json_line_("record", self.record_converter.to_json(value));
+    end
+
+    function write_records_(self, value)
+      self.write_json_stream_("records", self.records_converter, value);
+    end
+  end
+end
@@ -0,0 +1,34 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef ProtocolWithDurationsReader < yardl.ndjson.NDJsonProtocolReader & test_model.ProtocolWithDurationsReaderBase
+  % NDJSON reader for the ProtocolWithDurations protocol
+  properties (Access=protected)
+    single_duration_converter
+    rec_with_durations_converter
+  end
+
+  methods
+    function self = ProtocolWithDurationsReader(filename, options)
+      arguments
+        filename (1,1) string
+        options.skip_completed_check (1,1) logical = false
+      end
+      self@test_model.ProtocolWithDurationsReaderBase(skip_completed_check=options.skip_completed_check);
+      self@yardl.ndjson.NDJsonProtocolReader(filename, test_model.ProtocolWithDurationsReaderBase.schema);
+      self.single_duration_converter = yardl.ndjson.DurationConverter;
+      self.rec_with_durations_converter = test_model.ndjson.RecordWithDurationsConverter();
+    end
+  end
+
+  methods (Access=protected)
+    function value = read_single_duration_(self)
+      json = self.read_json_line_("singleDuration");
+      value = self.single_duration_converter.from_json(json);
+    end
+
+    function value = read_rec_with_durations_(self)
+      json = self.read_json_line_("recWithDurations");
+      value = self.rec_with_durations_converter.from_json(json);
+    end
+  end
+end
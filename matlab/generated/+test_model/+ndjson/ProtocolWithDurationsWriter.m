@@ -0,0 +1,28 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef ProtocolWithDurationsWriter < yardl.ndjson.NDJsonProtocolWriter & test_model.ProtocolWithDurationsWriterBase
+  % NDJSON writer for the ProtocolWithDurations protocol
+  properties (Access=protected)
+    single_duration_converter
+    rec_with_durations_converter
+  end
+
+  methods
+    function self = ProtocolWithDurationsWriter(filename)
+      self@test_model.ProtocolWithDurationsWriterBase();
+      self@yardl.ndjson.NDJsonProtocolWriter(filename, test_model.ProtocolWithDurationsWriterBase.schema);
+      self.single_duration_converter = yardl.ndjson.DurationConverter;
+      self.rec_with_durations_converter = test_model.ndjson.RecordWithDurationsConverter();
+    end
+  end
+
+  methods (Access=protected)
+    function write_single_duration_(self, value)
+      self.write_json_line_("singleDuration", self.single_duration_converter.to_json(value));
+    end
+
+    function write_rec_with_durations_(self, value)
+      self.write_json_line_("recWithDurations", self.rec_with_durations_converter.to_json(value));
+    end
+  end
+end
@@ -0,0 +1,34 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef ProtocolWithHalfPrecisionReader < yardl.ndjson.NDJsonProtocolReader & test_model.ProtocolWithHalfPrecisionReaderBase
+  % NDJSON reader for the ProtocolWithHalfPrecision protocol
+  properties (Access=protected)
+    halves_converter
+    rec_with_halves_converter
+  end
+
+  methods
+    function self = ProtocolWithHalfPrecisionReader(filename, options)
+      arguments
+        filename (1,1) string
+        options.skip_completed_check (1,1) logical = false
+      end
+      self@test_model.ProtocolWithHalfPrecisionReaderBase(skip_completed_check=options.skip_completed_check);
+      self@yardl.ndjson.NDJsonProtocolReader(filename, test_model.ProtocolWithHalfPrecisionReaderBase.schema);
+      self.halves_converter = yardl.ndjson.VectorConverter(yardl.ndjson.Float16Converter);
+      self.rec_with_halves_converter = test_model.ndjson.RecordWithHalfPrecisionConverter();
+    end
+  end
+
+  methods (Access=protected)
+    function value = read_halves_(self)
+      json = self.read_json_line_("halves");
+      value = self.halves_converter.from_json(json);
+    end
+
+    function value = read_rec_with_halves_(self)
+      json = self.read_json_line_("recWithHalves");
+      value = self.rec_with_halves_converter.from_json(json);
+    end
+  end
+end
@@ -0,0 +1,28 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef ProtocolWithHalfPrecisionWriter < yardl.ndjson.NDJsonProtocolWriter & test_model.ProtocolWithHalfPrecisionWriterBase
+  % NDJSON writer for the ProtocolWithHalfPrecision protocol
+  properties (Access=protected)
+    halves_converter
+    rec_with_halves_converter
+  end
+
+  methods
+    function self = ProtocolWithHalfPrecisionWriter(filename)
+      self@test_model.ProtocolWithHalfPrecisionWriterBase();
+      self@yardl.ndjson.NDJsonProtocolWriter(filename, test_model.ProtocolWithHalfPrecisionWriterBase.schema);
+      self.halves_converter = yardl.ndjson.VectorConverter(yardl.ndjson.Float16Converter);
+      self.rec_with_halves_converter = test_model.ndjson.RecordWithHalfPrecisionConverter();
+    end
+  end
+
+  methods (Access=protected)
+    function write_halves_(self, value)
+      self.write_json_line_("halves", self.halves_converter.to_json(value));
+    end
+
+    function write_rec_with_halves_(self, value)
+      self.write_json_line_("recWithHalves", self.rec_with_halves_converter.to_json(value));
+    end
+  end
+end
@@ -0,0 +1,38 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef ProtocolWithKeywordStepsReader < yardl.ndjson.NDJsonProtocolReader & test_model.ProtocolWithKeywordStepsReaderBase
+  % NDJSON reader for the ProtocolWithKeywordSteps protocol
+  properties (Access=protected)
+    int_converter
+    float_converter
+  end
+
+  methods
+    function self = ProtocolWithKeywordStepsReader(filename, options)
+      arguments
+        filename (1,1) string
+        options.skip_completed_check (1,1) logical = false
+      end
+      self@test_model.ProtocolWithKeywordStepsReaderBase(skip_completed_check=options.skip_completed_check);
+      self@yardl.ndjson.NDJsonProtocolReader(filename, test_model.ProtocolWithKeywordStepsReaderBase.schema);
+      self.int_converter = test_model.ndjson.RecordWithKeywordFieldsConverter();
+      self.float_converter = test_model.ndjson.EnumWithKeywordSymbolsConverter();
+    end
+  end
+
+  methods (Access=protected)
+    function more = has_int_(self)
+      more = self.has_json_line_("int");
+    end
+
+    function value = read_int_(self)
+      json = self.read_json_line_("int");
+      value = self.int_converter.from_json(json);
+    end
+
+    function value = read_float_(self)
+      json = self.read_json_line_("float");
+      value = self.float_converter.from_json(json);
+    end
+  end
+end
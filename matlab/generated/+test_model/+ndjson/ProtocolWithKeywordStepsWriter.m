@@ -0,0 +1,28 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef ProtocolWithKeywordStepsWriter < yardl.ndjson.NDJsonProtocolWriter & test_model.ProtocolWithKeywordStepsWriterBase
+  % NDJSON writer for the ProtocolWithKeywordSteps protocol
+  properties (Access=protected)
+    int_converter
+    float_converter
+  end
+
+  methods
+    function self = ProtocolWithKeywordStepsWriter(filename)
+      self@test_model.ProtocolWithKeywordStepsWriterBase();
+      self@yardl.ndjson.NDJsonProtocolWriter(filename, test_model.ProtocolWithKeywordStepsWriterBase.schema);
+      self.int_converter = test_model.ndjson.RecordWithKeywordFieldsConverter();
+      self.float_converter = test_model.ndjson.EnumWithKeywordSymbolsConverter();
+    end
+  end
+
+  methods (Access=protected)
+    function write_int_(self, value)
+      self.write_json_stream_("int", self.int_converter, value);
+    end
+
+    function write_float_(self, value)
+      self.write_json_line_("float", self.float_converter.to_json(value));
+    end
+  end
+end
@@ -0,0 +1,27 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef ProtocolWithOptionalDateReader < yardl.ndjson.NDJsonProtocolReader & test_model.ProtocolWithOptionalDateReaderBase
+  % NDJSON reader for the ProtocolWithOptionalDate protocol
+  properties (Access=protected)
+    record_converter
+  end
+
+  methods
+    function self = ProtocolWithOptionalDateReader(filename, options)
+      arguments
+        filename (1,1) string
+        options.skip_completed_check (1,1) logical = false
+      end
+      self@test_model.ProtocolWithOptionalDateReaderBase(skip_completed_check=options.skip_completed_check);
+      self@yardl.ndjson.NDJsonProtocolReader(filename, test_model.ProtocolWithOptionalDateReaderBase.schema);
+      self.record_converter = yardl.ndjson.OptionalConverter(test_model.ndjson.RecordWithOptionalDateConverter());
+    end
+  end
+
+  methods (Access=protected)
+    function value = read_record_(self)
+      json = self.read_json_line_("record");
+      value = self.record_converter.from_json(json);
+    end
+  end
+end
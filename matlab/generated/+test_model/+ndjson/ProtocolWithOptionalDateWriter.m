@@ -0,0 +1,22 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef ProtocolWithOptionalDateWriter < yardl.ndjson.NDJsonProtocolWriter & test_model.ProtocolWithOptionalDateWriterBase
+  % NDJSON writer for the ProtocolWithOptionalDate protocol
+  properties (Access=protected)
+    record_converter
+  end
+
+  methods
+    function self = ProtocolWithOptionalDateWriter(filename)
+      self@test_model.ProtocolWithOptionalDateWriterBase();
+      self@yardl.ndjson.NDJsonProtocolWriter(filename, test_model.ProtocolWithOptionalDateWriterBase.schema);
+      self.record_converter = yardl.ndjson.OptionalConverter(test_model.ndjson.RecordWithOptionalDateConverter());
+    end
+  end
+
+  methods (Access=protected)
+    function write_record_(self, value)
+      self.write_json_line_("record", self.record_converter.to_json(value));
+    end
+  end
+end
@@ -0,0 +1,45 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef ProtocolWithRecursiveRecordsReader < yardl.ndjson.NDJsonProtocolReader & test_model.ProtocolWithRecursiveRecordsReaderBase
+  % NDJSON reader for the ProtocolWithRecursiveRecords protocol
+  properties (Access=protected)
+    tree_converter
+    list_converter
+    expressions_converter
+  end
+
+  methods
+    function self = ProtocolWithRecursiveRecordsReader(filename, options)
+      arguments
+        filename (1,1) string
+        options.skip_completed_check (1,1) logical = false
+      end
+      self@test_model.ProtocolWithRecursiveRecordsReaderBase(skip_completed_check=options.skip_completed_check);
+      self@yardl.ndjson.NDJsonProtocolReader(filename, test_model.ProtocolWithRecursiveRecordsReaderBase.schema);
+      self.tree_converter = test_model.ndjson.TreeNodeConverter();
+      self.list_converter = test_model.ndjson.LinkedListNodeConverter();
+      self.expressions_converter = test_model.ndjson.ExpressionConverter();
+    end
+  end
+
+  methods (Access=protected)
+    function value = read_tree_(self)
+      json = self.read_json_line_("tree");
+      value = self.tree_converter.from_json(json);
+    end
+
+    function value = read_list_(self)
+      json = self.read_json_line_("list");
+      value = self.list_converter.from_json(json);
+    end
+
+    function more = has_expressions_(self)
+      more = self.has_json_line_("expressions");
+    end
+
+    function value = read_expressions_(self)
+      json = self.read_json_line_("expressions");
+      value = self.expressions_converter.from_json(json);
+    end
+  end
+end
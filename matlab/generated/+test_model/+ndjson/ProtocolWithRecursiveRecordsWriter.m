@@ -0,0 +1,34 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef ProtocolWithRecursiveRecordsWriter < yardl.ndjson.NDJsonProtocolWriter & test_model.ProtocolWithRecursiveRecordsWriterBase
+  % NDJSON writer for the ProtocolWithRecursiveRecords protocol
+  properties (Access=protected)
+    tree_converter
+    list_converter
+    expressions_converter
+  end
+
+  methods
+    function self = ProtocolWithRecursiveRecordsWriter(filename)
+      self@test_model.ProtocolWithRecursiveRecordsWriterBase();
+      self@yardl.ndjson.NDJsonProtocolWriter(filename, test_model.ProtocolWithRecursiveRecordsWriterBase.schema);
+      self.tree_converter = test_model.ndjson.TreeNodeConverter();
+      self.list_converter = test_model.ndjson.LinkedListNodeConverter();
+      self.expressions_converter = test_model.ndjson.ExpressionConverter();
+    end
+  end
+
+  methods (Access=protected)
+    function write_tree_(self, value)
+      self.write_json_line_("tree", self.tree_converter.to_json(value));
+    end
+
+    function write_list_(self, value)
+      self.write_json_line_("list", self.list_converter.to_json(value));
+    end
+
+    function write_expressions_(self, value)
+      self.write_json_stream_("expressions", self.expressions_converter, value);
+    end
+  end
+end
@@ -0,0 +1,74 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef ProtocolWithSubProtocolsReader < yardl.ndjson.NDJsonProtocolReader & test_model.ProtocolWithSubProtocolsReaderBase
+  % NDJSON reader for the ProtocolWithSubProtocols protocol
+  properties (Access=protected)
+    header_converter
+    calibration_gain_converter
+    calibration_samples_converter
+    data_converter
+    recalibration_gain_converter
+    recalibration_samples_converter
+  end
+
+  methods
+    function self = ProtocolWithSubProtocolsReader(filename, options)
+      arguments
+        filename (1,1) string
+        options.skip_completed_check (1,1) logical = false
+      end
+      self@test_model.ProtocolWithSubProtocolsReaderBase(skip_completed_check=options.skip_completed_check);
+      self@yardl.ndjson.NDJsonProtocolReader(filename, test_model.ProtocolWithSubProtocolsReaderBase.schema);
+      self.header_converter = yardl.ndjson.StringConverter;
+      self.calibration_gain_converter = yardl.ndjson.Float32Converter;
+      self.calibration_samples_converter = yardl.ndjson.Float64Converter;
+      self.data_converter = yardl.ndjson.Int32Converter;
+      self.recalibration_gain_converter = yardl.ndjson.Float32Converter;
+      self.recalibration_samples_converter = yardl.ndjson.Float64Converter;
+    end
+  end
+
+  methods (Access=protected)
+    function value = read_header_(self)
+      json = self.read_json_line_("header");
+      value = self.header_converter.from_json(json);
+    end
+
+    function value = read_calibration_gain_(self)
+      json = self.read_json_line_("calibrationGain");
+      value = self.calibration_gain_converter.from_json(json);
+    end
+
+    function more = has_calibration_samples_(self)
+      more = self.has_json_line_("calibrationSamples");
+    end
+
+    function value = read_calibration_samples_(self)
+      json = self.read_json_line_("calibrationSamples");
+      value = self.calibration_samples_converter.from_json(json);
+    end
+
+    function more = has_data_(self)
+      more = self.has_json_line_("data");
+    end
+
+    function value = read_data_(self)
+      json = self.read_json_line_("data");
+      value = self.data_converter.from_json(json);
+    end
+
+    function value = read_recalibration_gain_(self)
+      json = self.read_json_line_("recalibrationGain");
+      value = self.recalibration_gain_converter.from_json(json);
+    end
+
+    function more = has_recalibration_samples_(self)
+      more = self.has_json_line_("recalibrationSamples");
+    end
+
+    function value = read_recalibration_samples_(self)
+      json = self.read_json_line_("recalibrationSamples");
+      value = self.recalibration_samples_converter.from_json(json);
+    end
+  end
+end
@@ -0,0 +1,52 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef ProtocolWithSubProtocolsWriter < yardl.ndjson.NDJsonProtocolWriter & test_model.ProtocolWithSubProtocolsWriterBase
+  % NDJSON writer for the ProtocolWithSubProtocols protocol
+  properties (Access=protected)
+    header_converter
+    calibration_gain_converter
+    calibration_samples_converter
+    data_converter
+    recalibration_gain_converter
+    recalibration_samples_converter
+  end
+
+  methods
+    function self = ProtocolWithSubProtocolsWriter(filename)
+      self@test_model.ProtocolWithSubProtocolsWriterBase();
+      self@yardl.ndjson.NDJsonProtocolWriter(filename, test_model.ProtocolWithSubProtocolsWriterBase.schema);
+      self.header_converter = yardl.ndjson.StringConverter;
+      self.calibration_gain_converter = yardl.ndjson.Float32Converter;
+      self.calibration_samples_converter = yardl.ndjson.Float64Converter;
+      self.data_converter = yardl.ndjson.Int32Converter;
+      self.recalibration_gain_converter = yardl.ndjson.Float32Converter;
+      self.recalibration_samples_converter = yardl.ndjson.Float64Converter;
+    end
+  end
+
+  methods (Access=protected)
+    function write_header_(self, value)
+      self.write_json_line_("header", self.header_converter.to_json(value));
+    end
+
+    function write_calibration_gain_(self, value)
+      self.write_json_line_("calibrationGain", self.calibration_gain_converter.to_json(value));
+    end
+
+    function write_calibration_samples_(self, value)
+      self.write_json_stream_("calibrationSamples", self.calibration_samples_converter, value);
+    end
+
+    function write_data_(self, value)
+      self.write_json_stream_("data", self.data_converter, value);
+    end
+
+    function write_recalibration_gain_(self, value)
+      self.write_json_line_("recalibrationGain", self.recalibration_gain_converter.to_json(value));
+    end
+
+    function write_recalibration_samples_(self, value)
+      self.write_json_stream_("recalibrationSamples", self.recalibration_samples_converter, value);
+    end
+  end
+end
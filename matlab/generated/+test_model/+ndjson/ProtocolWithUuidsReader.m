@@ -0,0 +1,34 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef ProtocolWithUuidsReader < yardl.ndjson.NDJsonProtocolReader & test_model.ProtocolWithUuidsReaderBase
+  % NDJSON reader for the ProtocolWithUuids protocol
+  properties (Access=protected)
+    single_uuid_converter
+    rec_with_uuids_converter
+  end
+
+  methods
+    function self = ProtocolWithUuidsReader(filename, options)
+      arguments
+        filename (1,1) string
+        options.skip_completed_check (1,1) logical = false
+      end
+      self@test_model.ProtocolWithUuidsReaderBase(skip_completed_check=options.skip_completed_check);
+      self@yardl.ndjson.NDJsonProtocolReader(filename, test_model.ProtocolWithUuidsReaderBase.schema);
+      self.single_uuid_converter = yardl.ndjson.UuidConverter;
+      self.rec_with_uuids_converter = test_model.ndjson.RecordWithUuidsConverter();
+    end
+  end
+
+  methods (Access=protected)
+    function value = read_single_uuid_(self)
+      json = self.read_json_line_("singleUuid");
+      value = self.single_uuid_converter.from_json(json);
+    end
+
+    function value = read_rec_with_uuids_(self)
+      json = self.read_json_line_("recWithUuids");
+      value = self.rec_with_uuids_converter.from_json(json);
+    end
+  end
+end
@@ -0,0 +1,28 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef ProtocolWithUuidsWriter < yardl.ndjson.NDJsonProtocolWriter & test_model.ProtocolWithUuidsWriterBase
+  % NDJSON writer for the ProtocolWithUuids protocol
+  properties (Access=protected)
+    single_uuid_converter
+    rec_with_uuids_converter
+  end
+
+  methods
+    function self = ProtocolWithUuidsWriter(filename)
+      self@test_model.ProtocolWithUuidsWriterBase();
+      self@yardl.ndjson.NDJsonProtocolWriter(filename, test_model.ProtocolWithUuidsWriterBase.schema);
+      self.single_uuid_converter = yardl.ndjson.UuidConverter;
+      self.rec_with_uuids_converter = test_model.ndjson.RecordWithUuidsConverter();
+    end
+  end
+
+  methods (Access=protected)
+    function write_single_uuid_(self, value)
+      self.write_json_line_("singleUuid", self.single_uuid_converter.to_json(value));
+    end
+
+    function write_rec_with_uuids_(self, value)
+      self.write_json_line_("recWithUuids", self.rec_with_uuids_converter.to_json(value));
+    end
+  end
+end
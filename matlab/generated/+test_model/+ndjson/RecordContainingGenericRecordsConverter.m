@@ -0,0 +1,32 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordContainingGenericRecordsConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = RecordContainingGenericRecordsConverter(a_converter, b_converter)
+      field_converters{1} = test_model.ndjson.RecordWithOptionalGenericFieldConverter(a_converter);
+      field_converters{2} = test_model.ndjson.RecordWithAliasedOptionalGenericFieldConverter(a_converter);
+      field_converters{3} = test_model.ndjson.RecordWithOptionalGenericUnionFieldConverter(a_converter, b_converter);
+      field_converters{4} = test_model.ndjson.RecordWithAliasedOptionalGenericUnionFieldConverter(a_converter, b_converter);
+      field_converters{5} = tuples.ndjson.TupleConverter(a_converter, b_converter);
+      field_converters{6} = tuples.ndjson.TupleConverter(a_converter, b_converter);
+      field_converters{7} = test_model.ndjson.RecordWithGenericVectorsConverter(b_converter);
+      field_converters{8} = test_model.ndjson.RecordWithGenericFixedVectorsConverter(b_converter);
+      field_converters{9} = test_model.ndjson.RecordWithGenericArraysConverter(b_converter);
+      field_converters{10} = test_model.ndjson.RecordWithGenericMapsConverter(a_converter, b_converter);
+      self@yardl.ndjson.RecordConverter('test_model.RecordContainingGenericRecords', ["g1", "g1a", "g2", "g2a", "g3", "g3a", "g4", "g5", "g6", "g7"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.RecordContainingGenericRecords
+      end
+      json = self.to_json_(value.g1, value.g1a, value.g2, value.g2a, value.g3, value.g3a, value.g4, value.g5, value.g6, value.g7);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.RecordContainingGenericRecords(g1=fields{1}, g1a=fields{2}, g2=fields{3}, g2a=fields{4}, g3=fields{5}, g3a=fields{6}, g4=fields{7}, g5=fields{8}, g6=fields{9}, g7=fields{10});
+    end
+  end
+end
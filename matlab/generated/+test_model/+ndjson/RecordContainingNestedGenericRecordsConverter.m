@@ -0,0 +1,27 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordContainingNestedGenericRecordsConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = RecordContainingNestedGenericRecordsConverter()
+      field_converters{1} = test_model.ndjson.RecordWithOptionalGenericFieldConverter(yardl.ndjson.StringConverter);
+      field_converters{2} = test_model.ndjson.RecordWithAliasedOptionalGenericFieldConverter(yardl.ndjson.StringConverter);
+      field_converters{3} = test_model.ndjson.RecordWithOptionalGenericUnionFieldConverter(yardl.ndjson.StringConverter, yardl.ndjson.Int32Converter);
+      field_converters{4} = test_model.ndjson.RecordWithAliasedOptionalGenericUnionFieldConverter(yardl.ndjson.StringConverter, yardl.ndjson.Int32Converter);
+      field_converters{5} = test_model.ndjson.RecordContainingGenericRecordsConverter(yardl.ndjson.StringConverter, yardl.ndjson.Int32Converter);
+      self@yardl.ndjson.RecordConverter('test_model.RecordContainingNestedGenericRecords', ["f1", "f1a", "f2", "f2a", "nested"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.RecordContainingNestedGenericRecords
+      end
+      json = self.to_json_(value.f1, value.f1a, value.f2, value.f2a, value.nested);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.RecordContainingNestedGenericRecords(f1=fields{1}, f1a=fields{2}, f2=fields{3}, f2a=fields{4}, nested=fields{5});
+    end
+  end
+end
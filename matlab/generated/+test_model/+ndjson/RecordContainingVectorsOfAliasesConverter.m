@@ -0,0 +1,26 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordContainingVectorsOfAliasesConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = RecordContainingVectorsOfAliasesConverter()
+      field_converters{1} = yardl.ndjson.VectorConverter(yardl.ndjson.StringConverter);
+      field_converters{2} = yardl.ndjson.VectorConverter(yardl.ndjson.MapConverter(yardl.ndjson.StringConverter, yardl.ndjson.Int32Converter));
+      field_converters{3} = yardl.ndjson.VectorConverter(yardl.ndjson.NDArrayConverter(yardl.ndjson.Float32Converter, 2));
+      field_converters{4} = yardl.ndjson.VectorConverter(tuples.ndjson.TupleConverter(yardl.ndjson.Int32Converter, test_model.ndjson.SimpleRecordConverter()));
+      self@yardl.ndjson.RecordConverter('test_model.RecordContainingVectorsOfAliases', ["strings", "maps", "arrays", "tuples"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.RecordContainingVectorsOfAliases
+      end
+      json = self.to_json_(value.strings, value.maps, value.arrays, value.tuples);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.RecordContainingVectorsOfAliases(strings=fields{1}, maps=fields{2}, arrays=fields{3}, tuples=fields{4});
+    end
+  end
+end
@@ -0,0 +1,24 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordHeaderConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = RecordHeaderConverter()
+      field_converters{1} = yardl.ndjson.Uint32Converter;
+      field_converters{2} = yardl.ndjson.StringConverter;
+      self@yardl.ndjson.RecordConverter('test_model.RecordHeader', ["version", "name"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.RecordHeader
+      end
+      json = self.to_json_(value.version, value.name);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.RecordHeader(version=fields{1}, name=fields{2});
+    end
+  end
+end
@@ -0,0 +1,24 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordNotUsedInProtocolConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = RecordNotUsedInProtocolConverter()
+      field_converters{1} = yardl.ndjson.UnionConverter('test_model.GenericUnion3', {yardl.ndjson.Int32Converter, yardl.ndjson.Float32Converter, yardl.ndjson.StringConverter}, {@test_model.GenericUnion3.T, @test_model.GenericUnion3.U, @test_model.GenericUnion3.V}, ["T", "U", "V"], {["number"], ["number"], ["string"]}, false);
+      field_converters{2} = yardl.ndjson.UnionConverter('test_model.GenericUnion3Alternate', {yardl.ndjson.Int32Converter, yardl.ndjson.Float32Converter, yardl.ndjson.StringConverter}, {@test_model.GenericUnion3Alternate.U, @test_model.GenericUnion3Alternate.V, @test_model.GenericUnion3Alternate.W}, ["U", "V", "W"], {["number"], ["number"], ["string"]}, false);
+      self@yardl.ndjson.RecordConverter('test_model.RecordNotUsedInProtocol', ["u1", "u2"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.RecordNotUsedInProtocol
+      end
+      json = self.to_json_(value.u1, value.u2);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.RecordNotUsedInProtocol(u1=fields{1}, u2=fields{2});
+    end
+  end
+end
@@ -0,0 +1,24 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithAliasedGenericsConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = RecordWithAliasedGenericsConverter()
+      field_converters{1} = tuples.ndjson.TupleConverter(yardl.ndjson.StringConverter, yardl.ndjson.StringConverter);
+      field_converters{2} = tuples.ndjson.TupleConverter(yardl.ndjson.StringConverter, yardl.ndjson.StringConverter);
+      self@yardl.ndjson.RecordConverter('test_model.RecordWithAliasedGenerics', ["myStrings", "aliasedStrings"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.RecordWithAliasedGenerics
+      end
+      json = self.to_json_(value.my_strings, value.aliased_strings);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.RecordWithAliasedGenerics(my_strings=fields{1}, aliased_strings=fields{2});
+    end
+  end
+end
@@ -0,0 +1,23 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithAliasedOptionalGenericFieldConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = RecordWithAliasedOptionalGenericFieldConverter(t_converter)
+      field_converters{1} = yardl.ndjson.OptionalConverter(t_converter);
+      self@yardl.ndjson.RecordConverter('test_model.RecordWithAliasedOptionalGenericField', ["v"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.RecordWithAliasedOptionalGenericField
+      end
+      json = self.to_json_(value.v);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.RecordWithAliasedOptionalGenericField(v=fields{1});
+    end
+  end
+end
@@ -0,0 +1,23 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithAliasedOptionalGenericUnionFieldConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = RecordWithAliasedOptionalGenericUnionFieldConverter(u_converter, v_converter)
+      field_converters{1} = yardl.ndjson.UnionConverter('test_model.AliasedMultiGenericOptional', {yardl.ndjson.NoneConverter, u_converter, v_converter}, {yardl.None, @test_model.AliasedMultiGenericOptional.T, @test_model.AliasedMultiGenericOptional.U}, ["", "T", "U"], {["null"], ["object"], ["object"]}, false);
+      self@yardl.ndjson.RecordConverter('test_model.RecordWithAliasedOptionalGenericUnionField', ["v"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.RecordWithAliasedOptionalGenericUnionField
+      end
+      json = self.to_json_(value.v);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.RecordWithAliasedOptionalGenericUnionField(v=fields{1});
+    end
+  end
+end
@@ -0,0 +1,25 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithAnnotationsConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = RecordWithAnnotationsConverter()
+      field_converters{1} = yardl.ndjson.Float32Converter;
+      field_converters{2} = yardl.ndjson.Float32Converter;
+      field_converters{3} = yardl.ndjson.StringConverter;
+      self@yardl.ndjson.RecordConverter('test_model.RecordWithAnnotations', ["thickness", "spacing", "label"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.RecordWithAnnotations
+      end
+      json = self.to_json_(value.thickness, value.spacing, value.label);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.RecordWithAnnotations(thickness=fields{1}, spacing=fields{2}, label=fields{3});
+    end
+  end
+end
@@ -0,0 +1,31 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithArraysSimpleSyntaxConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = RecordWithArraysSimpleSyntaxConverter()
+      field_converters{1} = yardl.ndjson.DynamicNDArrayConverter(yardl.ndjson.Int32Converter);
+      field_converters{2} = yardl.ndjson.DynamicNDArrayConverter(yardl.ndjson.Int32Converter);
+      field_converters{3} = yardl.ndjson.NDArrayConverter(yardl.ndjson.Int32Converter, 1);
+      field_converters{4} = yardl.ndjson.NDArrayConverter(yardl.ndjson.Int32Converter, 2);
+      field_converters{5} = yardl.ndjson.NDArrayConverter(yardl.ndjson.Int32Converter, 2);
+      field_converters{6} = yardl.ndjson.FixedNDArrayConverter(yardl.ndjson.Int32Converter, [4, 3]);
+      field_converters{7} = yardl.ndjson.FixedNDArrayConverter(yardl.ndjson.Int32Converter, [4, 3]);
+      field_converters{8} = yardl.ndjson.DynamicNDArrayConverter(yardl.ndjson.Int32Converter);
+      field_converters{9} = yardl.ndjson.FixedNDArrayConverter(yardl.ndjson.FixedVectorConverter(yardl.ndjson.Int32Converter, 4), [5]);
+      self@yardl.ndjson.RecordConverter('test_model.RecordWithArraysSimpleSyntax', ["defaultArray", "defaultArrayWithEmptyDimension", "rank1Array", "rank2Array", "rank2ArrayWithNamedDimensions", "rank2FixedArray", "rank2FixedArrayWithNamedDimensions", "dynamicArray", "arrayOfVectors"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.RecordWithArraysSimpleSyntax
+      end
+      json = self.to_json_(value.default_array, value.default_array_with_empty_dimension, value.rank_1_array, value.rank_2_array, value.rank_2_array_with_named_dimensions, value.rank_2_fixed_array, value.rank_2_fixed_array_with_named_dimensions, value.dynamic_array, value.array_of_vectors);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.RecordWithArraysSimpleSyntax(default_array=fields{1}, default_array_with_empty_dimension=fields{2}, rank_1_array=fields{3}, rank_2_array=fields{4}, rank_2_array_with_named_dimensions=fields{5}, rank_2_fixed_array=fields{6}, rank_2_fixed_array_with_named_dimensions=fields{7}, dynamic_array=fields{8}, array_of_vectors=fields{9});
+    end
+  end
+end
@@ -0,0 +1,25 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithBaseConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = RecordWithBaseConverter()
+      field_converters{1} = yardl.ndjson.Uint32Converter;
+      field_converters{2} = yardl.ndjson.StringConverter;
+      field_converters{3} = yardl.ndjson.VectorConverter(yardl.ndjson.Float32Converter);
+      self@yardl.ndjson.RecordConverter('test_model.RecordWithBase', ["version", "name", "data"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.RecordWithBase
+      end
+      json = self.to_json_(value.version, value.name, value.data);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.RecordWithBase(version=fields{1}, name=fields{2}, data=fields{3});
+    end
+  end
+end
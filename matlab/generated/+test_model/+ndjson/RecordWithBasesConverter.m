@@ -0,0 +1,26 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithBasesConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = RecordWithBasesConverter()
+      field_converters{1} = yardl.ndjson.Uint32Converter;
+      field_converters{2} = yardl.ndjson.StringConverter;
+      field_converters{3} = yardl.ndjson.StringConverter;
+      field_converters{4} = yardl.ndjson.Int32Converter;
+      self@yardl.ndjson.RecordConverter('test_model.RecordWithBases', ["version", "name", "label", "value"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.RecordWithBases
+      end
+      json = self.to_json_(value.version, value.name, value.label, value.value);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.RecordWithBases(version=fields{1}, name=fields{2}, label=fields{3}, value=fields{4});
+    end
+  end
+end
@@ -0,0 +1,25 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithBytesConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = RecordWithBytesConverter()
+      field_converters{1} = yardl.ndjson.BytesConverter;
+      field_converters{2} = yardl.ndjson.OptionalConverter(yardl.ndjson.BytesConverter);
+      field_converters{3} = yardl.ndjson.VectorConverter(yardl.ndjson.BytesConverter);
+      self@yardl.ndjson.RecordConverter('test_model.RecordWithBytes', ["data", "optionalData", "chunks"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.RecordWithBytes
+      end
+      json = self.to_json_(value.data, value.optional_data, value.chunks);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.RecordWithBytes(data=fields{1}, optional_data=fields{2}, chunks=fields{3});
+    end
+  end
+end
@@ -0,0 +1,50 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithComputedFieldsConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = RecordWithComputedFieldsConverter()
+      field_converters{1} = yardl.ndjson.NDArrayConverter(yardl.ndjson.Int32Converter, 2);
+      field_converters{2} = yardl.ndjson.NDArrayConverter(yardl.ndjson.Int32Converter, 2);
+      field_converters{3} = yardl.ndjson.DynamicNDArrayConverter(yardl.ndjson.Int32Converter);
+      field_converters{4} = yardl.ndjson.FixedNDArrayConverter(yardl.ndjson.Int32Converter, [4, 3]);
+      field_converters{5} = yardl.ndjson.Int32Converter;
+      field_converters{6} = yardl.ndjson.Int8Converter;
+      field_converters{7} = yardl.ndjson.Uint8Converter;
+      field_converters{8} = yardl.ndjson.Int16Converter;
+      field_converters{9} = yardl.ndjson.Uint16Converter;
+      field_converters{10} = yardl.ndjson.Uint32Converter;
+      field_converters{11} = yardl.ndjson.Int64Converter;
+      field_converters{12} = yardl.ndjson.Uint64Converter;
+      field_converters{13} = yardl.ndjson.SizeConverter;
+      field_converters{14} = yardl.ndjson.Float32Converter;
+      field_converters{15} = yardl.ndjson.Float64Converter;
+      field_converters{16} = yardl.ndjson.Complexfloat32Converter;
+      field_converters{17} = yardl.ndjson.Complexfloat64Converter;
+      field_converters{18} = yardl.ndjson.StringConverter;
+      field_converters{19} = tuples.ndjson.TupleConverter(yardl.ndjson.Int32Converter, yardl.ndjson.Int32Converter);
+      field_converters{20} = yardl.ndjson.VectorConverter(yardl.ndjson.Int32Converter);
+      field_converters{21} = yardl.ndjson.VectorConverter(yardl.ndjson.VectorConverter(yardl.ndjson.Int32Converter));
+      field_converters{22} = yardl.ndjson.FixedVectorConverter(yardl.ndjson.Int32Converter, 3);
+      field_converters{23} = yardl.ndjson.FixedVectorConverter(yardl.ndjson.FixedVectorConverter(yardl.ndjson.Int32Converter, 3), 2);
+      field_converters{24} = yardl.ndjson.OptionalConverter(yardl.ndjson.NDArrayConverter(yardl.ndjson.Int32Converter, 2));
+      field_converters{25} = yardl.ndjson.UnionConverter('test_model.Int32OrFloat32', {yardl.ndjson.Int32Converter, yardl.ndjson.Float32Converter}, {@test_model.Int32OrFloat32.Int32, @test_model.Int32OrFloat32.Float32}, ["int32", "float32"], {["number"], ["number"]}, false);
+      field_converters{26} = yardl.ndjson.UnionConverter('test_model.Int32OrFloat32', {yardl.ndjson.NoneConverter, yardl.ndjson.Int32Converter, yardl.ndjson.Float32Converter}, {yardl.None, @test_model.Int32OrFloat32.Int32, @test_model.Int32OrFloat32.Float32}, ["", "int32", "float32"], {["null"], ["number"], ["number"]}, false);
+      field_converters{27} = yardl.ndjson.UnionConverter('test_model.IntOrGenericRecordWithComputedFields', {yardl.ndjson.Int32Converter, basic_types.ndjson.GenericRecordWithComputedFieldsConverter(yardl.ndjson.StringConverter, yardl.ndjson.Float32Converter)}, {@test_model.IntOrGenericRecordWithComputedFields.Int, @test_model.IntOrGenericRecordWithComputedFields.GenericRecordWithComputedFields}, ["int", "genericRecordWithComputedFields"], {["number"], ["object"]}, true);
+      field_converters{28} = yardl.ndjson.MapConverter(yardl.ndjson.StringConverter, yardl.ndjson.StringConverter);
+      self@yardl.ndjson.RecordConverter('test_model.RecordWithComputedFields', ["arrayField", "arrayFieldMapDimensions", "dynamicArrayField", "fixedArrayField", "intField", "int8Field", "uint8Field", "int16Field", "uint16Field", "uint32Field", "int64Field", "uint64Field", "sizeField", "float32Field", "float64Field", "complexfloat32Field", "complexfloat64Field", "stringField", "tupleField", "vectorField", "vectorOfVectorsField", "fixedVectorField", "fixedVectorOfVectorsField", "optionalNamedArray", "intFloatUnion", "nullableIntFloatUnion", "unionWithNestedGenericUnion", "mapField"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.RecordWithComputedFields
+      end
+      json = self.to_json_(value.array_field, value.array_field_map_dimensions, value.dynamic_array_field, value.fixed_array_field, value.int_field, value.int8_field, value.uint8_field, value.int16_field, value.uint16_field, value.uint32_field, value.int64_field, value.uint64_field, value.size_field, value.float32_field, value.float64_field, value.complexfloat32_field, value.complexfloat64_field, value.string_field, value.tuple_field, value.vector_field, value.vector_of_vectors_field, value.fixed_vector_field, value.fixed_vector_of_vectors_field, value.optional_named_array, value.int_float_union, value.nullable_int_float_union, value.union_with_nested_generic_union, value.map_field);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.RecordWithComputedFields(array_field=fields{1}, array_field_map_dimensions=fields{2}, dynamic_array_field=fields{3}, fixed_array_field=fields{4}, int_field=fields{5}, int8_field=fields{6}, uint8_field=fields{7}, int16_field=fields{8}, uint16_field=fields{9}, uint32_field=fields{10}, int64_field=fields{11}, uint64_field=fields{12}, size_field=fields{13}, float32_field=fields{14}, float64_field=fields{15}, complexfloat32_field=fields{16}, complexfloat64_field=fields{17}, string_field=fields{18}, tuple_field=fields{19}, vector_field=fields{20}, vector_of_vectors_field=fields{21}, fixed_vector_field=fields{22}, fixed_vector_of_vectors_field=fields{23}, optional_named_array=fields{24}, int_float_union=fields{25}, nullable_int_float_union=fields{26}, union_with_nested_generic_union=fields{27}, map_field=fields{28});
+    end
+  end
+end
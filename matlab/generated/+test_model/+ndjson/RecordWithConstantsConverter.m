@@ -0,0 +1,25 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithConstantsConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = RecordWithConstantsConverter()
+      field_converters{1} = yardl.ndjson.FixedVectorConverter(yardl.ndjson.Int32Converter, 4);
+      field_converters{2} = yardl.ndjson.FixedNDArrayConverter(yardl.ndjson.Float32Converter, [8, 4]);
+      field_converters{3} = yardl.ndjson.Float32Converter;
+      self@yardl.ndjson.RecordConverter('test_model.RecordWithConstants', ["channels", "samples", "gain"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.RecordWithConstants
+      end
+      json = self.to_json_(value.channels, value.samples, value.gain);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.RecordWithConstants(channels=fields{1}, samples=fields{2}, gain=fields{3});
+    end
+  end
+end
@@ -0,0 +1,25 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithConstrainedRecordsConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = RecordWithConstrainedRecordsConverter()
+      field_converters{1} = test_model.ndjson.RecordWithConstraintsConverter();
+      field_converters{2} = yardl.ndjson.OptionalConverter(test_model.ndjson.RecordWithConstraintsConverter());
+      field_converters{3} = yardl.ndjson.VectorConverter(test_model.ndjson.RecordWithConstraintsConverter());
+      self@yardl.ndjson.RecordConverter('test_model.RecordWithConstrainedRecords', ["single", "optional", "vector"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.RecordWithConstrainedRecords
+      end
+      json = self.to_json_(value.single, value.optional, value.vector);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.RecordWithConstrainedRecords(single=fields{1}, optional=fields{2}, vector=fields{3});
+    end
+  end
+end
@@ -0,0 +1,27 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithConstraintsConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = RecordWithConstraintsConverter()
+      field_converters{1} = yardl.ndjson.Uint32Converter;
+      field_converters{2} = yardl.ndjson.Float32Converter;
+      field_converters{3} = yardl.ndjson.StringConverter;
+      field_converters{4} = yardl.ndjson.OptionalConverter(yardl.ndjson.Int32Converter);
+      field_converters{5} = yardl.ndjson.VectorConverter(yardl.ndjson.Int32Converter);
+      self@yardl.ndjson.RecordConverter('test_model.RecordWithConstraints', ["size", "gain", "name", "limit", "samples"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.RecordWithConstraints
+      end
+      json = self.to_json_(value.size, value.gain, value.name, value.limit, value.samples);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.RecordWithConstraints(size=fields{1}, gain=fields{2}, name=fields{3}, limit=fields{4}, samples=fields{5});
+    end
+  end
+end
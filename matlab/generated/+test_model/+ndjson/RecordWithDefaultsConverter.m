@@ -0,0 +1,32 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithDefaultsConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = RecordWithDefaultsConverter()
+      field_converters{1} = yardl.ndjson.Int32Converter;
+      field_converters{2} = yardl.ndjson.Int8Converter;
+      field_converters{3} = yardl.ndjson.Uint64Converter;
+      field_converters{4} = yardl.ndjson.Float32Converter;
+      field_converters{5} = yardl.ndjson.Float64Converter;
+      field_converters{6} = yardl.ndjson.Complexfloat64Converter;
+      field_converters{7} = yardl.ndjson.BoolConverter;
+      field_converters{8} = yardl.ndjson.StringConverter;
+      field_converters{9} = basic_types.ndjson.FruitsConverter();
+      field_converters{10} = yardl.ndjson.Int32Converter;
+      self@yardl.ndjson.RecordConverter('test_model.RecordWithDefaults', ["intField", "int8Field", "uint64Field", "float32Field", "float64Field", "complexfloat64Field", "boolField", "stringField", "enumField", "noDefaultField"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.RecordWithDefaults
+      end
+      json = self.to_json_(value.int_field, value.int8_field, value.uint64_field, value.float32_field, value.float64_field, value.complexfloat64_field, value.bool_field, value.string_field, value.enum_field, value.no_default_field);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.RecordWithDefaults(int_field=fields{1}, int8_field=fields{2}, uint64_field=fields{3}, float32_field=fields{4}, float64_field=fields{5}, complexfloat64_field=fields{6}, bool_field=fields{7}, string_field=fields{8}, enum_field=fields{9}, no_default_field=fields{10});
+    end
+  end
+end
@@ -0,0 +1,27 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithDurationsConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = RecordWithDurationsConverter()
+      field_converters{1} = yardl.ndjson.DatetimeConverter;
+      field_converters{2} = yardl.ndjson.DatetimeConverter;
+      field_converters{3} = yardl.ndjson.DurationConverter;
+      field_converters{4} = yardl.ndjson.OptionalConverter(yardl.ndjson.DurationConverter);
+      field_converters{5} = yardl.ndjson.VectorConverter(yardl.ndjson.DurationConverter);
+      self@yardl.ndjson.RecordConverter('test_model.RecordWithDurations', ["acquisitionStart", "acquisitionEnd", "repetitionTime", "timeout", "intervals"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.RecordWithDurations
+      end
+      json = self.to_json_(value.acquisition_start, value.acquisition_end, value.repetition_time, value.timeout, value.intervals);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.RecordWithDurations(acquisition_start=fields{1}, acquisition_end=fields{2}, repetition_time=fields{3}, timeout=fields{4}, intervals=fields{5});
+    end
+  end
+end
@@ -0,0 +1,25 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithDynamicNDArraysConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = RecordWithDynamicNDArraysConverter()
+      field_converters{1} = yardl.ndjson.DynamicNDArrayConverter(yardl.ndjson.Int32Converter);
+      field_converters{2} = yardl.ndjson.DynamicNDArrayConverter(test_model.ndjson.SimpleRecordConverter());
+      field_converters{3} = yardl.ndjson.DynamicNDArrayConverter(test_model.ndjson.RecordWithVlensConverter());
+      self@yardl.ndjson.RecordConverter('test_model.RecordWithDynamicNDArrays', ["ints", "simpleRecordArray", "recordWithVlensArray"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.RecordWithDynamicNDArrays
+      end
+      json = self.to_json_(value.ints, value.simple_record_array, value.record_with_vlens_array);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.RecordWithDynamicNDArrays(ints=fields{1}, simple_record_array=fields{2}, record_with_vlens_array=fields{3});
+    end
+  end
+end
@@ -0,0 +1,26 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithEnumsConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = RecordWithEnumsConverter()
+      field_converters{1} = basic_types.ndjson.FruitsConverter();
+      field_converters{2} = basic_types.ndjson.DaysOfWeekConverter();
+      field_converters{3} = basic_types.ndjson.TextFormatConverter();
+      field_converters{4} = test_model.ndjson.RecordWithNoDefaultEnumConverter();
+      self@yardl.ndjson.RecordConverter('test_model.RecordWithEnums', ["enum", "flags", "flags2", "rec"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.RecordWithEnums
+      end
+      json = self.to_json_(value.enum, value.flags, value.flags_2, value.rec);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.RecordWithEnums(enum=fields{1}, flags=fields{2}, flags_2=fields{3}, rec=fields{4});
+    end
+  end
+end
@@ -0,0 +1,24 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithFixedCollectionsConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = RecordWithFixedCollectionsConverter()
+      field_converters{1} = yardl.ndjson.FixedVectorConverter(yardl.ndjson.Int32Converter, 3);
+      field_converters{2} = yardl.ndjson.FixedNDArrayConverter(yardl.ndjson.Int32Converter, [3, 2]);
+      self@yardl.ndjson.RecordConverter('test_model.RecordWithFixedCollections', ["fixedVector", "fixedArray"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.RecordWithFixedCollections
+      end
+      json = self.to_json_(value.fixed_vector, value.fixed_array);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.RecordWithFixedCollections(fixed_vector=fields{1}, fixed_array=fields{2});
+    end
+  end
+end
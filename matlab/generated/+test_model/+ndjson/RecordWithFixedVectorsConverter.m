@@ -0,0 +1,25 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithFixedVectorsConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = RecordWithFixedVectorsConverter()
+      field_converters{1} = yardl.ndjson.FixedVectorConverter(yardl.ndjson.Int32Converter, 5);
+      field_converters{2} = yardl.ndjson.FixedVectorConverter(test_model.ndjson.SimpleRecordConverter(), 3);
+      field_converters{3} = yardl.ndjson.FixedVectorConverter(test_model.ndjson.RecordWithVlensConverter(), 2);
+      self@yardl.ndjson.RecordConverter('test_model.RecordWithFixedVectors', ["fixedIntVector", "fixedSimpleRecordVector", "fixedRecordWithVlensVector"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.RecordWithFixedVectors
+      end
+      json = self.to_json_(value.fixed_int_vector, value.fixed_simple_record_vector, value.fixed_record_with_vlens_vector);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.RecordWithFixedVectors(fixed_int_vector=fields{1}, fixed_simple_record_vector=fields{2}, fixed_record_with_vlens_vector=fields{3});
+    end
+  end
+end
@@ -0,0 +1,28 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithGenericArraysConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = RecordWithGenericArraysConverter(t_converter)
+      field_converters{1} = yardl.ndjson.NDArrayConverter(t_converter, 2);
+      field_converters{2} = yardl.ndjson.FixedNDArrayConverter(t_converter, [8, 16]);
+      field_converters{3} = yardl.ndjson.DynamicNDArrayConverter(t_converter);
+      field_converters{4} = yardl.ndjson.NDArrayConverter(t_converter, 2);
+      field_converters{5} = yardl.ndjson.FixedNDArrayConverter(t_converter, [8, 16]);
+      field_converters{6} = yardl.ndjson.DynamicNDArrayConverter(t_converter);
+      self@yardl.ndjson.RecordConverter('test_model.RecordWithGenericArrays', ["nd", "fixedNd", "dynamicNd", "aliasedNd", "aliasedFixedNd", "aliasedDynamicNd"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.RecordWithGenericArrays
+      end
+      json = self.to_json_(value.nd, value.fixed_nd, value.dynamic_nd, value.aliased_nd, value.aliased_fixed_nd, value.aliased_dynamic_nd);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.RecordWithGenericArrays(nd=fields{1}, fixed_nd=fields{2}, dynamic_nd=fields{3}, aliased_nd=fields{4}, aliased_fixed_nd=fields{5}, aliased_dynamic_nd=fields{6});
+    end
+  end
+end
@@ -0,0 +1,24 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithGenericFixedVectorsConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = RecordWithGenericFixedVectorsConverter(t_converter)
+      field_converters{1} = yardl.ndjson.FixedVectorConverter(t_converter, 3);
+      field_converters{2} = yardl.ndjson.FixedVectorConverter(t_converter, 3);
+      self@yardl.ndjson.RecordConverter('test_model.RecordWithGenericFixedVectors', ["fv", "afv"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.RecordWithGenericFixedVectors
+      end
+      json = self.to_json_(value.fv, value.afv);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.RecordWithGenericFixedVectors(fv=fields{1}, afv=fields{2});
+    end
+  end
+end
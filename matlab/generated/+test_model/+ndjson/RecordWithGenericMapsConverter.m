@@ -0,0 +1,24 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithGenericMapsConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = RecordWithGenericMapsConverter(t_converter, u_converter)
+      field_converters{1} = yardl.ndjson.MapConverter(t_converter, u_converter);
+      field_converters{2} = yardl.ndjson.MapConverter(t_converter, u_converter);
+      self@yardl.ndjson.RecordConverter('test_model.RecordWithGenericMaps', ["m", "am"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.RecordWithGenericMaps
+      end
+      json = self.to_json_(value.m, value.am);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.RecordWithGenericMaps(m=fields{1}, am=fields{2});
+    end
+  end
+end
@@ -0,0 +1,23 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithGenericVectorOfRecordsConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = RecordWithGenericVectorOfRecordsConverter(t_converter, u_converter)
+      field_converters{1} = yardl.ndjson.VectorConverter(yardl.ndjson.VectorConverter(test_model.ndjson.GenericRecordConverter(t_converter, u_converter)));
+      self@yardl.ndjson.RecordConverter('test_model.RecordWithGenericVectorOfRecords', ["v"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.RecordWithGenericVectorOfRecords
+      end
+      json = self.to_json_(value.v);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.RecordWithGenericVectorOfRecords(v=fields{1});
+    end
+  end
+end
@@ -0,0 +1,24 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithGenericVectorsConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = RecordWithGenericVectorsConverter(t_converter)
+      field_converters{1} = yardl.ndjson.VectorConverter(t_converter);
+      field_converters{2} = yardl.ndjson.VectorConverter(t_converter);
+      self@yardl.ndjson.RecordConverter('test_model.RecordWithGenericVectors', ["v", "av"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.RecordWithGenericVectors
+      end
+      json = self.to_json_(value.v, value.av);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.RecordWithGenericVectors(v=fields{1}, av=fields{2});
+    end
+  end
+end
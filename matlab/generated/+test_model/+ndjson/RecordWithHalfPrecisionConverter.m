@@ -0,0 +1,26 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithHalfPrecisionConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = RecordWithHalfPrecisionConverter()
+      field_converters{1} = yardl.ndjson.Float16Converter;
+      field_converters{2} = yardl.ndjson.Bfloat16Converter;
+      field_converters{3} = yardl.ndjson.VectorConverter(yardl.ndjson.Float16Converter);
+      field_converters{4} = yardl.ndjson.DynamicNDArrayConverter(yardl.ndjson.Bfloat16Converter);
+      self@yardl.ndjson.RecordConverter('test_model.RecordWithHalfPrecision', ["half", "brain", "halfVector", "brainArray"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.RecordWithHalfPrecision
+      end
+      json = self.to_json_(value.half, value.brain, value.half_vector, value.brain_array);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.RecordWithHalfPrecision(half=fields{1}, brain=fields{2}, half_vector=fields{3}, brain_array=fields{4});
+    end
+  end
+end
@@ -0,0 +1,25 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithKeywordFieldsConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = RecordWithKeywordFieldsConverter()
+      field_converters{1} = yardl.ndjson.StringConverter;
+      field_converters{2} = yardl.ndjson.NDArrayConverter(yardl.ndjson.Int32Converter, 2);
+      field_converters{3} = test_model.ndjson.EnumWithKeywordSymbolsConverter();
+      self@yardl.ndjson.RecordConverter('test_model.RecordWithKeywordFields', ["int", "sizeof", "if"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.RecordWithKeywordFields
+      end
+      json = self.to_json_(value.int, value.sizeof, value.if_);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.RecordWithKeywordFields(int=fields{1}, sizeof=fields{2}, if_=fields{3});
+    end
+  end
+end
@@ -0,0 +1,25 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithMapsConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = RecordWithMapsConverter()
+      field_converters{1} = yardl.ndjson.MapConverter(yardl.ndjson.Uint32Converter, yardl.ndjson.Uint32Converter);
+      field_converters{2} = yardl.ndjson.MapConverter(yardl.ndjson.Int32Converter, yardl.ndjson.BoolConverter);
+      field_converters{3} = yardl.ndjson.MapConverter(yardl.ndjson.StringConverter, yardl.ndjson.UnionConverter('test_model.StringOrInt32', {yardl.ndjson.StringConverter, yardl.ndjson.Int32Converter}, {@test_model.StringOrInt32.String, @test_model.StringOrInt32.Int32}, ["string", "int32"], {["string"], ["number"]}, true));
+      self@yardl.ndjson.RecordConverter('test_model.RecordWithMaps', ["set1", "set2", "set3"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.RecordWithMaps
+      end
+      json = self.to_json_(value.set_1, value.set_2, value.set_3);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.RecordWithMaps(set_1=fields{1}, set_2=fields{2}, set_3=fields{3});
+    end
+  end
+end
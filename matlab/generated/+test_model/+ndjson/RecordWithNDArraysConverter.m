@@ -0,0 +1,25 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithNDArraysConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = RecordWithNDArraysConverter()
+      field_converters{1} = yardl.ndjson.NDArrayConverter(yardl.ndjson.Int32Converter, 2);
+      field_converters{2} = yardl.ndjson.NDArrayConverter(test_model.ndjson.SimpleRecordConverter(), 2);
+      field_converters{3} = yardl.ndjson.NDArrayConverter(test_model.ndjson.RecordWithVlensConverter(), 2);
+      self@yardl.ndjson.RecordConverter('test_model.RecordWithNDArrays', ["ints", "fixedSimpleRecordArray", "fixedRecordWithVlensArray"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.RecordWithNDArrays
+      end
+      json = self.to_json_(value.ints, value.fixed_simple_record_array, value.fixed_record_with_vlens_array);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.RecordWithNDArrays(ints=fields{1}, fixed_simple_record_array=fields{2}, fixed_record_with_vlens_array=fields{3});
+    end
+  end
+end
@@ -0,0 +1,25 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithNDArraysSingleDimensionConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = RecordWithNDArraysSingleDimensionConverter()
+      field_converters{1} = yardl.ndjson.NDArrayConverter(yardl.ndjson.Int32Converter, 1);
+      field_converters{2} = yardl.ndjson.NDArrayConverter(test_model.ndjson.SimpleRecordConverter(), 1);
+      field_converters{3} = yardl.ndjson.NDArrayConverter(test_model.ndjson.RecordWithVlensConverter(), 1);
+      self@yardl.ndjson.RecordConverter('test_model.RecordWithNDArraysSingleDimension', ["ints", "fixedSimpleRecordArray", "fixedRecordWithVlensArray"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.RecordWithNDArraysSingleDimension
+      end
+      json = self.to_json_(value.ints, value.fixed_simple_record_array, value.fixed_record_with_vlens_array);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.RecordWithNDArraysSingleDimension(ints=fields{1}, fixed_simple_record_array=fields{2}, fixed_record_with_vlens_array=fields{3});
+    end
+  end
+end
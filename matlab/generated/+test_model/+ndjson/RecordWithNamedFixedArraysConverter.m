@@ -0,0 +1,25 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithNamedFixedArraysConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = RecordWithNamedFixedArraysConverter()
+      field_converters{1} = yardl.ndjson.FixedNDArrayConverter(yardl.ndjson.Int32Converter, [3, 2]);
+      field_converters{2} = yardl.ndjson.FixedNDArrayConverter(test_model.ndjson.SimpleRecordConverter(), [2, 3]);
+      field_converters{3} = yardl.ndjson.FixedNDArrayConverter(test_model.ndjson.RecordWithVlensConverter(), [2, 2]);
+      self@yardl.ndjson.RecordConverter('test_model.RecordWithNamedFixedArrays', ["ints", "fixedSimpleRecordArray", "fixedRecordWithVlensArray"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.RecordWithNamedFixedArrays
+      end
+      json = self.to_json_(value.ints, value.fixed_simple_record_array, value.fixed_record_with_vlens_array);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.RecordWithNamedFixedArrays(ints=fields{1}, fixed_simple_record_array=fields{2}, fixed_record_with_vlens_array=fields{3});
+    end
+  end
+end
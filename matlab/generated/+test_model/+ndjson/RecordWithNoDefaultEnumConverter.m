@@ -0,0 +1,23 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithNoDefaultEnumConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = RecordWithNoDefaultEnumConverter()
+      field_converters{1} = basic_types.ndjson.FruitsConverter();
+      self@yardl.ndjson.RecordConverter('test_model.RecordWithNoDefaultEnum', ["enum"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.RecordWithNoDefaultEnum
+      end
+      json = self.to_json_(value.enum);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.RecordWithNoDefaultEnum(enum=fields{1});
+    end
+  end
+end
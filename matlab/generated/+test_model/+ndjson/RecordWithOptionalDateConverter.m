@@ -0,0 +1,23 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithOptionalDateConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = RecordWithOptionalDateConverter()
+      field_converters{1} = yardl.ndjson.OptionalConverter(yardl.ndjson.DateConverter);
+      self@yardl.ndjson.RecordConverter('test_model.RecordWithOptionalDate', ["dateField"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.RecordWithOptionalDate
+      end
+      json = self.to_json_(value.date_field);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.RecordWithOptionalDate(date_field=fields{1});
+    end
+  end
+end
@@ -0,0 +1,25 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithOptionalFieldsConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = RecordWithOptionalFieldsConverter()
+      field_converters{1} = yardl.ndjson.OptionalConverter(yardl.ndjson.Int32Converter);
+      field_converters{2} = yardl.ndjson.OptionalConverter(yardl.ndjson.Int32Converter);
+      field_converters{3} = yardl.ndjson.OptionalConverter(yardl.ndjson.TimeConverter);
+      self@yardl.ndjson.RecordConverter('test_model.RecordWithOptionalFields', ["optionalInt", "optionalIntAlternateSyntax", "optionalTime"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.RecordWithOptionalFields
+      end
+      json = self.to_json_(value.optional_int, value.optional_int_alternate_syntax, value.optional_time);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.RecordWithOptionalFields(optional_int=fields{1}, optional_int_alternate_syntax=fields{2}, optional_time=fields{3});
+    end
+  end
+end
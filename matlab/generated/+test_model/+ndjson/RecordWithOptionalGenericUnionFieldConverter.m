@@ -0,0 +1,23 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithOptionalGenericUnionFieldConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = RecordWithOptionalGenericUnionFieldConverter(u_converter, v_converter)
+      field_converters{1} = yardl.ndjson.UnionConverter('test_model.UOrV', {yardl.ndjson.NoneConverter, u_converter, v_converter}, {yardl.None, @test_model.UOrV.U, @test_model.UOrV.V}, ["", "U", "V"], {["null"], ["object"], ["object"]}, false);
+      self@yardl.ndjson.RecordConverter('test_model.RecordWithOptionalGenericUnionField', ["v"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.RecordWithOptionalGenericUnionField
+      end
+      json = self.to_json_(value.v);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.RecordWithOptionalGenericUnionField(v=fields{1});
+    end
+  end
+end
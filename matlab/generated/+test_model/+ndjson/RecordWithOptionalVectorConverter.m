@@ -0,0 +1,23 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithOptionalVectorConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = RecordWithOptionalVectorConverter()
+      field_converters{1} = yardl.ndjson.OptionalConverter(yardl.ndjson.VectorConverter(yardl.ndjson.Int32Converter));
+      self@yardl.ndjson.RecordConverter('test_model.RecordWithOptionalVector', ["optionalVector"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.RecordWithOptionalVector
+      end
+      json = self.to_json_(value.optional_vector);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.RecordWithOptionalVector(optional_vector=fields{1});
+    end
+  end
+end
@@ -0,0 +1,27 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithPatternsConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = RecordWithPatternsConverter()
+      field_converters{1} = yardl.ndjson.StringConverter;
+      field_converters{2} = yardl.ndjson.StringConverter;
+      field_converters{3} = yardl.ndjson.StringConverter;
+      field_converters{4} = yardl.ndjson.StringConverter;
+      field_converters{5} = yardl.ndjson.StringConverter;
+      self@yardl.ndjson.RecordConverter('test_model.RecordWithPatterns', ["alternation", "word", "code", "escaped", "capitalized"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.RecordWithPatterns
+      end
+      json = self.to_json_(value.alternation, value.word, value.code, value.escaped, value.capitalized);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.RecordWithPatterns(alternation=fields{1}, word=fields{2}, code=fields{3}, escaped=fields{4}, capitalized=fields{5});
+    end
+  end
+end
@@ -0,0 +1,31 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithPrimitiveAliasesConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = RecordWithPrimitiveAliasesConverter()
+      field_converters{1} = yardl.ndjson.Uint8Converter;
+      field_converters{2} = yardl.ndjson.Int32Converter;
+      field_converters{3} = yardl.ndjson.Uint32Converter;
+      field_converters{4} = yardl.ndjson.Int64Converter;
+      field_converters{5} = yardl.ndjson.Uint64Converter;
+      field_converters{6} = yardl.ndjson.Float32Converter;
+      field_converters{7} = yardl.ndjson.Float64Converter;
+      field_converters{8} = yardl.ndjson.Complexfloat32Converter;
+      field_converters{9} = yardl.ndjson.Complexfloat64Converter;
+      self@yardl.ndjson.RecordConverter('test_model.RecordWithPrimitiveAliases', ["byteField", "intField", "uintField", "longField", "ulongField", "floatField", "doubleField", "complexfloatField", "complexdoubleField"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.RecordWithPrimitiveAliases
+      end
+      json = self.to_json_(value.byte_field, value.int_field, value.uint_field, value.long_field, value.ulong_field, value.float_field, value.double_field, value.complexfloat_field, value.complexdouble_field);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.RecordWithPrimitiveAliases(byte_field=fields{1}, int_field=fields{2}, uint_field=fields{3}, long_field=fields{4}, ulong_field=fields{5}, float_field=fields{6}, double_field=fields{7}, complexfloat_field=fields{8}, complexdouble_field=fields{9});
+    end
+  end
+end
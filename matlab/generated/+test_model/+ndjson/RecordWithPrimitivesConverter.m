@@ -0,0 +1,39 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithPrimitivesConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = RecordWithPrimitivesConverter()
+      field_converters{1} = yardl.ndjson.BoolConverter;
+      field_converters{2} = yardl.ndjson.Int8Converter;
+      field_converters{3} = yardl.ndjson.Uint8Converter;
+      field_converters{4} = yardl.ndjson.Int16Converter;
+      field_converters{5} = yardl.ndjson.Uint16Converter;
+      field_converters{6} = yardl.ndjson.Int32Converter;
+      field_converters{7} = yardl.ndjson.Uint32Converter;
+      field_converters{8} = yardl.ndjson.Int64Converter;
+      field_converters{9} = yardl.ndjson.Uint64Converter;
+      field_converters{10} = yardl.ndjson.SizeConverter;
+      field_converters{11} = yardl.ndjson.Float32Converter;
+      field_converters{12} = yardl.ndjson.Float64Converter;
+      field_converters{13} = yardl.ndjson.Complexfloat32Converter;
+      field_converters{14} = yardl.ndjson.Complexfloat64Converter;
+      field_converters{15} = yardl.ndjson.DateConverter;
+      field_converters{16} = yardl.ndjson.TimeConverter;
+      field_converters{17} = yardl.ndjson.DatetimeConverter;
+      self@yardl.ndjson.RecordConverter('test_model.RecordWithPrimitives', ["boolField", "int8Field", "uint8Field", "int16Field", "uint16Field", "int32Field", "uint32Field", "int64Field", "uint64Field", "sizeField", "float32Field", "float64Field", "complexfloat32Field", "complexfloat64Field", "dateField", "timeField", "datetimeField"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.RecordWithPrimitives
+      end
+      json = self.to_json_(value.bool_field, value.int8_field, value.uint8_field, value.int16_field, value.uint16_field, value.int32_field, value.uint32_field, value.int64_field, value.uint64_field, value.size_field, value.float32_field, value.float64_field, value.complexfloat32_field, value.complexfloat64_field, value.date_field, value.time_field, value.datetime_field);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.RecordWithPrimitives(bool_field=fields{1}, int8_field=fields{2}, uint8_field=fields{3}, int16_field=fields{4}, uint16_field=fields{5}, int32_field=fields{6}, uint32_field=fields{7}, int64_field=fields{8}, uint64_field=fields{9}, size_field=fields{10}, float32_field=fields{11}, float64_field=fields{12}, complexfloat32_field=fields{13}, complexfloat64_field=fields{14}, date_field=fields{15}, time_field=fields{16}, datetime_field=fields{17});
+    end
+  end
+end
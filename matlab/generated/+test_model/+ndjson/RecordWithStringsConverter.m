@@ -0,0 +1,24 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithStringsConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = RecordWithStringsConverter()
+      field_converters{1} = yardl.ndjson.StringConverter;
+      field_converters{2} = yardl.ndjson.StringConverter;
+      self@yardl.ndjson.RecordConverter('test_model.RecordWithStrings', ["a", "b"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.RecordWithStrings
+      end
+      json = self.to_json_(value.a, value.b);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.RecordWithStrings(a=fields{1}, b=fields{2});
+    end
+  end
+end
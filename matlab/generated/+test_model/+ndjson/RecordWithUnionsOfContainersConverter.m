@@ -0,0 +1,25 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithUnionsOfContainersConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = RecordWithUnionsOfContainersConverter()
+      field_converters{1} = yardl.ndjson.UnionConverter('test_model.MapOrScalar', {yardl.ndjson.MapConverter(yardl.ndjson.StringConverter, yardl.ndjson.Int32Converter), yardl.ndjson.Int32Converter}, {@test_model.MapOrScalar.Map, @test_model.MapOrScalar.Scalar}, ["map", "scalar"], {["object"], ["number"]}, true);
+      field_converters{2} = yardl.ndjson.UnionConverter('test_model.VectorOrScalar', {yardl.ndjson.VectorConverter(yardl.ndjson.Int32Converter), yardl.ndjson.Int32Converter}, {@test_model.VectorOrScalar.Vector, @test_model.VectorOrScalar.Scalar}, ["vector", "scalar"], {["array"], ["number"]}, true);
+      field_converters{3} = yardl.ndjson.UnionConverter('test_model.ArrayOrScalar', {yardl.ndjson.DynamicNDArrayConverter(yardl.ndjson.Int32Converter), yardl.ndjson.Int32Converter}, {@test_model.ArrayOrScalar.Array, @test_model.ArrayOrScalar.Scalar}, ["array", "scalar"], {["object"], ["number"]}, true);
+      self@yardl.ndjson.RecordConverter('test_model.RecordWithUnionsOfContainers', ["mapOrScalar", "vectorOrScalar", "arrayOrScalar"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.RecordWithUnionsOfContainers
+      end
+      json = self.to_json_(value.map_or_scalar, value.vector_or_scalar, value.array_or_scalar);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.RecordWithUnionsOfContainers(map_or_scalar=fields{1}, vector_or_scalar=fields{2}, array_or_scalar=fields{3});
+    end
+  end
+end
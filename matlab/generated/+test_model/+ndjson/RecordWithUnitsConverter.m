@@ -0,0 +1,25 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithUnitsConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = RecordWithUnitsConverter()
+      field_converters{1} = yardl.ndjson.Float32Converter;
+      field_converters{2} = yardl.ndjson.Float32Converter;
+      field_converters{3} = yardl.ndjson.VectorConverter(yardl.ndjson.Float64Converter);
+      self@yardl.ndjson.RecordConverter('test_model.RecordWithUnits', ["length", "width", "durations"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.RecordWithUnits
+      end
+      json = self.to_json_(value.length, value.width, value.durations);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.RecordWithUnits(length=fields{1}, width=fields{2}, durations=fields{3});
+    end
+  end
+end
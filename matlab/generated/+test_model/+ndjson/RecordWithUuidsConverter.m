@@ -0,0 +1,26 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithUuidsConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = RecordWithUuidsConverter()
+      field_converters{1} = yardl.ndjson.UuidConverter;
+      field_converters{2} = yardl.ndjson.OptionalConverter(yardl.ndjson.UuidConverter);
+      field_converters{3} = yardl.ndjson.VectorConverter(yardl.ndjson.UuidConverter);
+      field_converters{4} = yardl.ndjson.MapConverter(yardl.ndjson.UuidConverter, yardl.ndjson.StringConverter);
+      self@yardl.ndjson.RecordConverter('test_model.RecordWithUuids', ["id", "optionalId", "related", "names"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.RecordWithUuids
+      end
+      json = self.to_json_(value.id, value.optional_id, value.related, value.names);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.RecordWithUuids(id=fields{1}, optional_id=fields{2}, related=fields{3}, names=fields{4});
+    end
+  end
+end
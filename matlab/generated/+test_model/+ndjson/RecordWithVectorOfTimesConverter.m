@@ -0,0 +1,23 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithVectorOfTimesConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = RecordWithVectorOfTimesConverter()
+      field_converters{1} = yardl.ndjson.VectorConverter(yardl.ndjson.TimeConverter);
+      self@yardl.ndjson.RecordConverter('test_model.RecordWithVectorOfTimes', ["times"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.RecordWithVectorOfTimes
+      end
+      json = self.to_json_(value.times);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.RecordWithVectorOfTimes(times=fields{1});
+    end
+  end
+end
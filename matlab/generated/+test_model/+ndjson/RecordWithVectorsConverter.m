@@ -0,0 +1,25 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithVectorsConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = RecordWithVectorsConverter()
+      field_converters{1} = yardl.ndjson.VectorConverter(yardl.ndjson.Int32Converter);
+      field_converters{2} = yardl.ndjson.FixedVectorConverter(yardl.ndjson.Int32Converter, 3);
+      field_converters{3} = yardl.ndjson.VectorConverter(yardl.ndjson.FixedVectorConverter(yardl.ndjson.Int32Converter, 2));
+      self@yardl.ndjson.RecordConverter('test_model.RecordWithVectors', ["defaultVector", "defaultVectorFixedLength", "vectorOfVectors"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.RecordWithVectors
+      end
+      json = self.to_json_(value.default_vector, value.default_vector_fixed_length, value.vector_of_vectors);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.RecordWithVectors(default_vector=fields{1}, default_vector_fixed_length=fields{2}, vector_of_vectors=fields{3});
+    end
+  end
+end
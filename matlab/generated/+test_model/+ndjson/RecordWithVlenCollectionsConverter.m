@@ -0,0 +1,24 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithVlenCollectionsConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = RecordWithVlenCollectionsConverter()
+      field_converters{1} = yardl.ndjson.VectorConverter(yardl.ndjson.Int32Converter);
+      field_converters{2} = yardl.ndjson.NDArrayConverter(yardl.ndjson.Int32Converter, 2);
+      self@yardl.ndjson.RecordConverter('test_model.RecordWithVlenCollections', ["vector", "array"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.RecordWithVlenCollections
+      end
+      json = self.to_json_(value.vector, value.array);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.RecordWithVlenCollections(vector=fields{1}, array=fields{2});
+    end
+  end
+end
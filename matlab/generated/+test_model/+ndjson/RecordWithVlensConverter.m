@@ -0,0 +1,25 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithVlensConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = RecordWithVlensConverter()
+      field_converters{1} = yardl.ndjson.VectorConverter(test_model.ndjson.SimpleRecordConverter());
+      field_converters{2} = yardl.ndjson.Int32Converter;
+      field_converters{3} = yardl.ndjson.Int32Converter;
+      self@yardl.ndjson.RecordConverter('test_model.RecordWithVlens', ["a", "b", "c"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.RecordWithVlens
+      end
+      json = self.to_json_(value.a, value.b, value.c);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.RecordWithVlens(a=fields{1}, b=fields{2}, c=fields{3});
+    end
+  end
+end
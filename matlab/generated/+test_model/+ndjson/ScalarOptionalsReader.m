@@ -0,0 +1,48 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef ScalarOptionalsReader < yardl.ndjson.NDJsonProtocolReader & test_model.ScalarOptionalsReaderBase
+  % NDJSON reader for the ScalarOptionals protocol
+  properties (Access=protected)
+    optional_int_converter
+    optional_record_converter
+    record_with_optional_fields_converter
+    optional_record_with_optional_fields_converter
+  end
+
+  methods
+    function self = ScalarOptionalsReader(filename, options)
+      arguments
+        filename (1,1) string
+        options.skip_completed_check (1,1) logical = false
+      end
+      self@test_model.ScalarOptionalsReaderBase(skip_completed_check=options.skip_completed_check);
+      self@yardl.ndjson.NDJsonProtocolReader(filename, test_model.ScalarOptionalsReaderBase.schema);
+      self.optional_int_converter = yardl.ndjson.OptionalConverter(yardl.ndjson.Int32Converter);
+      self.optional_record_converter = yardl.ndjson.OptionalConverter(test_model.ndjson.SimpleRecordConverter());
+      self.record_with_optional_fields_converter = test_model.ndjson.RecordWithOptionalFieldsConverter();
+      self.optional_record_with_optional_fields_converter = yardl.ndjson.OptionalConverter(test_model.ndjson.RecordWithOptionalFieldsConverter());
+    end
+  end
+
+  methods (Access=protected)
+    function value = read_optional_int_(self)
+      json = self.read_json_line_("optionalInt");
+      value = self.optional_int_converter.from_json(json);
+    end
+
+    function value = read_optional_record_(self)
+      json = self.read_json_line_("optionalRecord");
+      value = self.optional_record_converter.from_json(json);
+    end
+
+    function value = read_record_with_optional_fields_(self)
+      json = self.read_json_line_("recordWithOptionalFields");
+      value = self.record_with_optional_fields_converter.from_json(json);
+    end
+
+    function value = read_optional_record_with_optional_fields_(self)
+      json = self.read_json_line_("optionalRecordWithOptionalFields");
+      value = self.optional_record_with_optional_fields_converter.from_json(json);
+    end
+  end
+end
@@ -0,0 +1,40 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef ScalarOptionalsWriter < yardl.ndjson.NDJsonProtocolWriter & test_model.ScalarOptionalsWriterBase
+  % NDJSON writer for the ScalarOptionals protocol
+  properties (Access=protected)
+    optional_int_converter
+    optional_record_converter
+    record_with_optional_fields_converter
+    optional_record_with_optional_fields_converter
+  end
+
+  methods
+    function self = ScalarOptionalsWriter(filename)
+      self@test_model.ScalarOptionalsWriterBase();
+      self@yardl.ndjson.NDJsonProtocolWriter(filename, test_model.ScalarOptionalsWriterBase.schema);
+      self.optional_int_converter = yardl.ndjson.OptionalConverter(yardl.ndjson.Int32Converter);
+      self.optional_record_converter = yardl.ndjson.OptionalConverter(test_model.ndjson.SimpleRecordConverter());
+      self.record_with_optional_fields_converter = test_model.ndjson.RecordWithOptionalFieldsConverter();
+      self.optional_record_with_optional_fields_converter = yardl.ndjson.OptionalConverter(test_model.ndjson.RecordWithOptionalFieldsConverter());
+    end
+  end
+
+  methods (Access=protected)
+    function write_optional_int_(self, value)
+      self.write_json_line_("optionalInt", self.optional_int_converter.to_json(value));
+    end
+
+    function write_optional_record_(self, value)
+      self.write_json_line_("optionalRecord", self.optional_record_converter.to_json(value));
+    end
+
+    function write_record_with_optional_fields_(self, value)
+      self.write_json_line_("recordWithOptionalFields", self.record_with_optional_fields_converter.to_json(value));
+    end
+
+    function write_optional_record_with_optional_fields_(self, value)
+      self.write_json_line_("optionalRecordWithOptionalFields", self.optional_record_with_optional_fields_converter.to_json(value));
+    end
+  end
+end
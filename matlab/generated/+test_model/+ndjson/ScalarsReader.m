@@ -0,0 +1,34 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef ScalarsReader < yardl.ndjson.NDJsonProtocolReader & test_model.ScalarsReaderBase
+  % NDJSON reader for the Scalars protocol
+  properties (Access=protected)
+    int32_converter
+    record_converter
+  end
+
+  methods
+    function self = ScalarsReader(filename, options)
+      arguments
+        filename (1,1) string
+        options.skip_completed_check (1,1) logical = false
+      end
+      self@test_model.ScalarsReaderBase(skip_completed_check=options.skip_completed_check);
+      self@yardl.ndjson.NDJsonProtocolReader(filename, test_model.ScalarsReaderBase.schema);
+      self.int32_converter = yardl.ndjson.Int32Converter;
+      self.record_converter = test_model.ndjson.RecordWithPrimitivesConverter();
+    end
+  end
+
+  methods (Access=protected)
+    function value = read_int32_(self)
+      json = self.read_json_line_("int32");
+      value = self.int32_converter.from_json(json);
+    end
+
+    function value = read_record_(self)
+      json = self.read_json_line_("record");
+      value = self.record_converter.from_json(json);
+    end
+  end
+end
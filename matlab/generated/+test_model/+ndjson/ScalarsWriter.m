@@ -0,0 +1,28 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef ScalarsWriter < yardl.ndjson.NDJsonProtocolWriter & test_model.ScalarsWriterBase
+  % NDJSON writer for the Scalars protocol
+  properties (Access=protected)
+    int32_converter
+    record_converter
+  end
+
+  methods
+    function self = ScalarsWriter(filename)
+      self@test_model.ScalarsWriterBase();
+      self@yardl.ndjson.NDJsonProtocolWriter(filename, test_model.ScalarsWriterBase.schema);
+      self.int32_converter = yardl.ndjson.Int32Converter;
+      self.record_converter = test_model.ndjson.RecordWithPrimitivesConverter();
+    end
+  end
+
+  methods (Access=protected)
+    function write_int32_(self, value)
+      self.write_json_line_("int32", self.int32_converter.to_json(value));
+    end
+
+    function write_record_(self, value)
+      self.write_json_line_("record", self.record_converter.to_json(value));
+    end
+  end
+end
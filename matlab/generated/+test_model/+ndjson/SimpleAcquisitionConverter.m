@@ -0,0 +1,26 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef SimpleAcquisitionConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = SimpleAcquisitionConverter()
+      field_converters{1} = yardl.ndjson.Uint64Converter;
+      field_converters{2} = test_model.ndjson.SimpleEncodingCountersConverter();
+      field_converters{3} = yardl.ndjson.NDArrayConverter(yardl.ndjson.Complexfloat32Converter, 2);
+      field_converters{4} = yardl.ndjson.NDArrayConverter(yardl.ndjson.Float32Converter, 2);
+      self@yardl.ndjson.RecordConverter('test_model.SimpleAcquisition', ["flags", "idx", "data", "trajectory"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.SimpleAcquisition
+      end
+      json = self.to_json_(value.flags, value.idx, value.data, value.trajectory);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.SimpleAcquisition(flags=fields{1}, idx=fields{2}, data=fields{3}, trajectory=fields{4});
+    end
+  end
+end
@@ -0,0 +1,26 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef SimpleEncodingCountersConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = SimpleEncodingCountersConverter()
+      field_converters{1} = yardl.ndjson.OptionalConverter(yardl.ndjson.Uint32Converter);
+      field_converters{2} = yardl.ndjson.OptionalConverter(yardl.ndjson.Uint32Converter);
+      field_converters{3} = yardl.ndjson.OptionalConverter(yardl.ndjson.Uint32Converter);
+      field_converters{4} = yardl.ndjson.OptionalConverter(yardl.ndjson.Uint32Converter);
+      self@yardl.ndjson.RecordConverter('test_model.SimpleEncodingCounters', ["e1", "e2", "slice", "repetition"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.SimpleEncodingCounters
+      end
+      json = self.to_json_(value.e1, value.e2, value.slice, value.repetition);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.SimpleEncodingCounters(e1=fields{1}, e2=fields{2}, slice=fields{3}, repetition=fields{4});
+    end
+  end
+end
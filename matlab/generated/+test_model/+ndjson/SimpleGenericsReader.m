@@ -0,0 +1,87 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef SimpleGenericsReader < yardl.ndjson.NDJsonProtocolReader & test_model.SimpleGenericsReaderBase
+  % NDJSON reader for the SimpleGenerics protocol
+  properties (Access=protected)
+    float_image_converter
+    int_image_converter
+    int_image_alternate_syntax_converter
+    string_image_converter
+    int_float_tuple_converter
+    float_float_tuple_converter
+    int_float_tuple_alternate_syntax_converter
+    int_string_tuple_converter
+    stream_of_type_variants_converter
+  end
+
+  methods
+    function self = SimpleGenericsReader(filename, options)
+      arguments
+        filename (1,1) string
+        options.skip_completed_check (1,1) logical = false
+      end
+      self@test_model.SimpleGenericsReaderBase(skip_completed_check=options.skip_completed_check);
+      self@yardl.ndjson.NDJsonProtocolReader(filename, test_model.SimpleGenericsReaderBase.schema);
+      self.float_image_converter = yardl.ndjson.NDArrayConverter(yardl.ndjson.Float32Converter, 2);
+      self.int_image_converter = yardl.ndjson.NDArrayConverter(yardl.ndjson.Int32Converter, 2);
+      self.int_image_alternate_syntax_converter = yardl.ndjson.NDArrayConverter(yardl.ndjson.Int32Converter, 2);
+      self.string_image_converter = yardl.ndjson.NDArrayConverter(yardl.ndjson.StringConverter, 2);
+      self.int_float_tuple_converter = tuples.ndjson.TupleConverter(yardl.ndjson.Int32Converter, yardl.ndjson.Float32Converter);
+      self.float_float_tuple_converter = tuples.ndjson.TupleConverter(yardl.ndjson.Float32Converter, yardl.ndjson.Float32Converter);
+      self.int_float_tuple_alternate_syntax_converter = tuples.ndjson.TupleConverter(yardl.ndjson.Int32Converter, yardl.ndjson.Float32Converter);
+      self.int_string_tuple_converter = tuples.ndjson.TupleConverter(yardl.ndjson.Int32Converter, yardl.ndjson.StringConverter);
+      self.stream_of_type_variants_converter = yardl.ndjson.UnionConverter('test_model.ImageFloatOrImageDouble', {yardl.ndjson.NDArrayConverter(yardl.ndjson.Float32Converter, 2), yardl.ndjson.NDArrayConverter(yardl.ndjson.Float64Converter, 2)}, {@test_model.ImageFloatOrImageDouble.ImageFloat, @test_model.ImageFloatOrImageDouble.ImageDouble}, ["imageFloat", "imageDouble"], {["object"], ["object"]}, false);
+    end
+  end
+
+  methods (Access=protected)
+    function value = read_float_image_(self)
+      json = self.read_json_line_("floatImage");
+      value = self.float_image_converter.from_json(json);
+    end
+
+    function value = read_int_image_(self)
+      json = self.read_json_line_("intImage");
+      value = self.int_image_converter.from_json(json);
+    end
+
+    function value = read_int_image_alternate_syntax_(self)
+      json = self.read_json_line_("intImageAlternateSyntax");
+      value = self.int_image_alternate_syntax_converter.from_json(json);
+    end
+
+    function value = read_string_image_(self)
+      json = self.read_json_line_("stringImage");
+      value = self.string_image_converter.from_json(json);
+    end
+
+    function value = read_int_float_tuple_(self)
+      json = self.read_json_line_("intFloatTuple");
+      value = self.int_float_tuple_converter.from_json(json);
+    end
+
+    function value = read_float_float_tuple_(self)
+      json = self.read_json_line_("floatFloatTuple");
+      value = self.float_float_tuple_converter.from_json(json);
+    end
+
+    function value = read_int_float_tuple_alternate_syntax_(self)
+      json = self.read_json_line_("intFloatTupleAlternateSyntax");
+      value = self.int_float_tuple_alternate_syntax_converter.from_json(json);
+    end
+
+    function value = read_int_string_tuple_(self)
+      json = self.read_json_line_("intStringTuple");
+      value = self.int_string_tuple_converter.from_json(json);
+    end
+
+    function more = has_stream_of_type_variants_(self)
+      more = self.has_json_line_("streamOfTypeVariants");
+    end
+
+    function value = read_stream_of_type_variants_(self)
+      json = self.read_json_line_("streamOfTypeVariants");
+      value = self.stream_of_type_variants_converter.from_json(json);
+    end
+  end
+end
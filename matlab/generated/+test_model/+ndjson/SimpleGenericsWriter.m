@@ -0,0 +1,70 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef SimpleGenericsWriter < yardl.ndjson.NDJsonProtocolWriter & test_model.SimpleGenericsWriterBase
+  % NDJSON writer for the SimpleGenerics protocol
+  properties (Access=protected)
+    float_image_converter
+    int_image_converter
+    int_image_alternate_syntax_converter
+    string_image_converter
+    int_float_tuple_converter
+    float_float_tuple_converter
+    int_float_tuple_alternate_syntax_converter
+    int_string_tuple_converter
+    stream_of_type_variants_converter
+  end
+
+  methods
+    function self = SimpleGenericsWriter(filename)
+      self@test_model.SimpleGenericsWriterBase();
+      self@yardl.ndjson.NDJsonProtocolWriter(filename, test_model.SimpleGenericsWriterBase.schema);
+      self.float_image_converter = yardl.ndjson.NDArrayConverter(yardl.ndjson.Float32Converter, 2);
+      self.int_image_converter = yardl.ndjson.NDArrayConverter(yardl.ndjson.Int32Converter, 2);
+      self.int_image_alternate_syntax_converter = yardl.ndjson.NDArrayConverter(yardl.ndjson.Int32Converter, 2);
+      self.string_image_converter = yardl.ndjson.NDArrayConverter(yardl.ndjson.StringConverter, 2);
+      self.int_float_tuple_converter = tuples.ndjson.TupleConverter(yardl.ndjson.Int32Converter, yardl.ndjson.Float32Converter);
+      self.float_float_tuple_converter = tuples.ndjson.TupleConverter(yardl.ndjson.Float32Converter, yardl.ndjson.Float32Converter);
+      self.int_float_tuple_alternate_syntax_converter = tuples.ndjson.TupleConverter(yardl.ndjson.Int32Converter, yardl.ndjson.Float32Converter);
+      self.int_string_tuple_converter = tuples.ndjson.TupleConverter(yardl.ndjson.Int32Converter, yardl.ndjson.StringConverter);
+      self.stream_of_type_variants_converter = yardl.ndjson.UnionConverter('test_model.ImageFloatOrImageDouble', {yardl.ndjson.NDArrayConverter(yardl.ndjson.Float32Converter, 2), yardl.ndjson.NDArrayConverter(yardl.ndjson.Float64Converter, 2)}, {@test_model.ImageFloatOrImageDouble.ImageFloat, @test_model.ImageFloatOrImageDouble.ImageDouble}, ["imageFloat", "imageDouble"], {["object"], ["object"]}, false);
+    end
+  end
+
+  methods (Access=protected)
+    function write_float_image_(self, value)
+      self.write_json_line_("floatImage", self.float_image_converter.to_json(value));
+    end
+
+    function write_int_image_(self, value)
+      self.write_json_line_("intImage", self.int_image_converter.to_json(value));
+    end
+
+    function write_int_image_alternate_syntax_(self, value)
+      self.write_json_line_("intImageAlternateSyntax", self.int_image_alternate_syntax_converter.to_json(value));
+    end
+
+    function write_string_image_(self, value)
+      self.write_json_line_("stringImage", self.string_image_converter.to_json(value));
+    end
+
+    function write_int_float_tuple_(self, value)
+      self.write_json_line_("intFloatTuple", self.int_float_tuple_converter.to_json(value));
+    end
+
+    function write_float_float_tuple_(self, value)
+      self.write_json_line_("floatFloatTuple", self.float_float_tuple_converter.to_json(value));
+    end
+
+    function write_int_float_tuple_alternate_syntax_(self, value)
+      self.write_json_line_("intFloatTupleAlternateSyntax", self.int_float_tuple_alternate_syntax_converter.to_json(value));
+    end
+
+    function write_int_string_tuple_(self, value)
+      self.write_json_line_("intStringTuple", self.int_string_tuple_converter.to_json(value));
+    end
+
+    function write_stream_of_type_variants_(self, value)
+      self.write_json_stream_("streamOfTypeVariants", self.stream_of_type_variants_converter, value);
+    end
+  end
+end
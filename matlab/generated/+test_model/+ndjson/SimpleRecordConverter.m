@@ -0,0 +1,25 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef SimpleRecordConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = SimpleRecordConverter()
+      field_converters{1} = yardl.ndjson.Int32Converter;
+      field_converters{2} = yardl.ndjson.Int32Converter;
+      field_converters{3} = yardl.ndjson.Int32Converter;
+      self@yardl.ndjson.RecordConverter('test_model.SimpleRecord', ["x", "y", "z"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.SimpleRecord
+      end
+      json = self.to_json_(value.x, value.y, value.z);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.SimpleRecord(x=fields{1}, y=fields{2}, z=fields{3});
+    end
+  end
+end
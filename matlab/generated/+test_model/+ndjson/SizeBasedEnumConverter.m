@@ -0,0 +1,11 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef SizeBasedEnumConverter < yardl.ndjson.EnumConverter
+  methods
+    function self = SizeBasedEnumConverter()
+      symbols = ["a", "b", "c"];
+      values = [test_model.SizeBasedEnum.A, test_model.SizeBasedEnum.B, test_model.SizeBasedEnum.C];
+      self@yardl.ndjson.EnumConverter('test_model.SizeBasedEnum', @test_model.SizeBasedEnum, yardl.ndjson.SizeConverter, symbols, values);
+    end
+  end
+end
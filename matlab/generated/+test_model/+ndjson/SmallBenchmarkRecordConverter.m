@@ -0,0 +1,25 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef SmallBenchmarkRecordConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = SmallBenchmarkRecordConverter()
+      field_converters{1} = yardl.ndjson.Float64Converter;
+      field_converters{2} = yardl.ndjson.Float32Converter;
+      field_converters{3} = yardl.ndjson.Float32Converter;
+      self@yardl.ndjson.RecordConverter('test_model.SmallBenchmarkRecord', ["a", "b", "c"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.SmallBenchmarkRecord
+      end
+      json = self.to_json_(value.a, value.b, value.c);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.SmallBenchmarkRecord(a=fields{1}, b=fields{2}, c=fields{3});
+    end
+  end
+end
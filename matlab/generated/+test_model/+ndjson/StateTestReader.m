@@ -0,0 +1,45 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef StateTestReader < yardl.ndjson.NDJsonProtocolReader & test_model.StateTestReaderBase
+  % NDJSON reader for the StateTest protocol
+  properties (Access=protected)
+    an_int_converter
+    a_stream_converter
+    another_int_converter
+  end
+
+  methods
+    function self = StateTestReader(filename, options)
+      arguments
+        filename (1,1) string
+        options.skip_completed_check (1,1) logical = false
+      end
+      self@test_model.StateTestReaderBase(skip_completed_check=options.skip_completed_check);
+      self@yardl.ndjson.NDJsonProtocolReader(filename, test_model.StateTestReaderBase.schema);
+      self.an_int_converter = yardl.ndjson.Int32Converter;
+      self.a_stream_converter = yardl.ndjson.Int32Converter;
+      self.another_int_converter = yardl.ndjson.Int32Converter;
+    end
+  end
+
+  methods (Access=protected)
+    function value = read_an_int_(self)
+      json = self.read_json_line_("anInt");
+      value = self.an_int_converter.from_json(json);
+    end
+
+    function more = has_a_stream_(self)
+      more = self.has_json_line_("aStream");
+    end
+
+    function value = read_a_stream_(self)
+      json = self.read_json_line_("aStream");
+      value = self.a_stream_converter.from_json(json);
+    end
+
+    function value = read_another_int_(self)
+      json = self.read_json_line_("anotherInt");
+      value = self.another_int_converter.from_json(json);
+    end
+  end
+end
@@ -0,0 +1,34 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef StateTestWriter < yardl.ndjson.NDJsonProtocolWriter & test_model.StateTestWriterBase
+  % NDJSON writer for the StateTest protocol
+  properties (Access=protected)
+    an_int_converter
+    a_stream_converter
+    another_int_converter
+  end
+
+  methods
+    function self = StateTestWriter(filename)
+      self@test_model.StateTestWriterBase();
+      self@yardl.ndjson.NDJsonProtocolWriter(filename, test_model.StateTestWriterBase.schema);
+      self.an_int_converter = yardl.ndjson.Int32Converter;
+      self.a_stream_converter = yardl.ndjson.Int32Converter;
+      self.another_int_converter = yardl.ndjson.Int32Converter;
+    end
+  end
+
+  methods (Access=protected)
+    function write_an_int_(self, value)
+      self.write_json_line_("anInt", self.an_int_converter.to_json(value));
+    end
+
+    function write_a_stream_(self, value)
+      self.write_json_stream_("aStream", self.a_stream_converter, value);
+    end
+
+    function write_another_int_(self, value)
+      self.write_json_line_("anotherInt", self.another_int_converter.to_json(value));
+    end
+  end
+end
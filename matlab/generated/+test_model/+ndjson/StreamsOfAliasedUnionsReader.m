@@ -0,0 +1,42 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef StreamsOfAliasedUnionsReader < yardl.ndjson.NDJsonProtocolReader & test_model.StreamsOfAliasedUnionsReaderBase
+  % NDJSON reader for the StreamsOfAliasedUnions protocol
+  properties (Access=protected)
+    int_or_simple_record_converter
+    nullable_int_or_simple_record_converter
+  end
+
+  methods
+    function self = StreamsOfAliasedUnionsReader(filename, options)
+      arguments
+        filename (1,1) string
+        options.skip_completed_check (1,1) logical = false
+      end
+      self@test_model.StreamsOfAliasedUnionsReaderBase(skip_completed_check=options.skip_completed_check);
+      self@yardl.ndjson.NDJsonProtocolReader(filename, test_model.StreamsOfAliasedUnionsReaderBase.schema);
+      self.int_or_simple_record_converter = yardl.ndjson.UnionConverter('test_model.AliasedIntOrSimpleRecord', {yardl.ndjson.Int32Converter, test_model.ndjson.SimpleRecordConverter()}, {@test_model.AliasedIntOrSimpleRecord.Int32, @test_model.AliasedIntOrSimpleRecord.SimpleRecord}, ["int32", "SimpleRecord"], {["number"], ["object"]}, true);
+      self.nullable_int_or_simple_record_converter = yardl.ndjson.UnionConverter('test_model.AliasedNullableIntSimpleRecord', {yardl.ndjson.NoneConverter, yardl.ndjson.Int32Converter, test_model.ndjson.SimpleRecordConverter()}, {yardl.None, @test_model.AliasedNullableIntSimpleRecord.Int32, @test_model.AliasedNullableIntSimpleRecord.SimpleRecord}, ["", "int32", "SimpleRecord"], {["null"], ["number"], ["object"]}, true);
+    end
+  end
+
+  methods (Access=protected)
+    function more = has_int_or_simple_record_(self)
+      more = self.has_json_line_("intOrSimpleRecord");
+    end
+
+    function value = read_int_or_simple_record_(self)
+      json = self.read_json_line_("intOrSimpleRecord");
+      value = self.int_or_simple_record_converter.from_json(json);
+    end
+
+    function more = has_nullable_int_or_simple_record_(self)
+      more = self.has_json_line_("nullableIntOrSimpleRecord");
+    end
+
+    function value = read_nullable_int_or_simple_record_(self)
+      json = self.read_json_line_("nullableIntOrSimpleRecord");
+      value = self.nullable_int_or_simple_record_converter.from_json(json);
+    end
+  end
+end
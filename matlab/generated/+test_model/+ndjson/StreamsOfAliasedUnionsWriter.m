@@ -0,0 +1,28 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef StreamsOfAliasedUnionsWriter < yardl.ndjson.NDJsonProtocolWriter & test_model.StreamsOfAliasedUnionsWriterBase
+  % NDJSON writer for the StreamsOfAliasedUnions protocol
+  properties (Access=protected)
+    int_or_simple_record_converter
+    nullable_int_or_simple_record_converter
+  end
+
+  methods
+    function self = StreamsOfAliasedUnionsWriter(filename)
+      self@test_model.StreamsOfAliasedUnionsWriterBase();
+      self@yardl.ndjson.NDJsonProtocolWriter(filename, test_model.StreamsOfAliasedUnionsWriterBase.schema);
+      self.int_or_simple_record_converter = yardl.ndjson.UnionConverter('test_model.AliasedIntOrSimpleRecord', {yardl.ndjson.Int32Converter, test_model.ndjson.SimpleRecordConverter()}, {@test_model.AliasedIntOrSimpleRecord.Int32, @test_model.AliasedIntOrSimpleRecord.SimpleRecord}, ["int32", "SimpleRecord"], {["number"], ["object"]}, true);
+      self.nullable_int_or_simple_record_converter = yardl.ndjson.UnionConverter('test_model.AliasedNullableIntSimpleRecord', {yardl.ndjson.NoneConverter, yardl.ndjson.Int32Converter, test_model.ndjson.SimpleRecordConverter()}, {yardl.None, @test_model.AliasedNullableIntSimpleRecord.Int32, @test_model.AliasedNullableIntSimpleRecord.SimpleRecord}, ["", "int32", "SimpleRecord"], {["null"], ["number"], ["object"]}, true);
+    end
+  end
+
+  methods (Access=protected)
+    function write_int_or_simple_record_(self, value)
+      self.write_json_stream_("intOrSimpleRecord", self.int_or_simple_record_converter, value);
+    end
+
+    function write_nullable_int_or_simple_record_(self, value)
+      self.write_json_stream_("nullableIntOrSimpleRecord", self.nullable_int_or_simple_record_converter, value);
+    end
+  end
+end
@@ -0,0 +1,53 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef StreamsOfUnionsReader < yardl.ndjson.NDJsonProtocolReader & test_model.StreamsOfUnionsReaderBase
+  % NDJSON reader for the StreamsOfUnions protocol
+  properties (Access=protected)
+    int_or_simple_record_converter
+    nullable_int_or_simple_record_converter
+    many_cases_converter
+  end
+
+  methods
+    function self = StreamsOfUnionsReader(filename, options)
+      arguments
+        filename (1,1) string
+        options.skip_completed_check (1,1) logical = false
+      end
+      self@test_model.StreamsOfUnionsReaderBase(skip_completed_check=options.skip_completed_check);
+      self@yardl.ndjson.NDJsonProtocolReader(filename, test_model.StreamsOfUnionsReaderBase.schema);
+      self.int_or_simple_record_converter = yardl.ndjson.UnionConverter('test_model.Int32OrSimpleRecord', {yardl.ndjson.Int32Converter, test_model.ndjson.SimpleRecordConverter()}, {@test_model.Int32OrSimpleRecord.Int32, @test_model.Int32OrSimpleRecord.SimpleRecord}, ["int32", "SimpleRecord"], {["number"], ["object"]}, true);
+      self.nullable_int_or_simple_record_converter = yardl.ndjson.UnionConverter('test_model.Int32OrSimpleRecord', {yardl.ndjson.NoneConverter, yardl.ndjson.Int32Converter, test_model.ndjson.SimpleRecordConverter()}, {yardl.None, @test_model.Int32OrSimpleRecord.Int32, @test_model.Int32OrSimpleRecord.SimpleRecord}, ["", "int32", "SimpleRecord"], {["null"], ["number"], ["object"]}, true);
+      self.many_cases_converter = yardl.ndjson.UnionConverter('test_model.Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray', {yardl.ndjson.Int32Converter, yardl.ndjson.Float32Converter, yardl.ndjson.StringConverter, test_model.ndjson.SimpleRecordConverter(), yardl.ndjson.FixedNDArrayConverter(yardl.ndjson.Int32Converter, [4, 2])}, {@test_model.Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray.Int32, @test_model.Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray.Float32, @test_model.Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray.String, @test_model.Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray.SimpleRecord, @test_model.Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray.NamedFixedNDArray}, ["int32", "float32", "string", "SimpleRecord", "NamedFixedNDArray"], {["number"], ["number"], ["string"], ["object"], ["array"]}, false);
+    end
+  end
+
+  methods (Access=protected)
+    function more = has_int_or_simple_record_(self)
+      more = self.has_json_line_("intOrSimpleRecord");
+    end
+
+    function value = read_int_or_simple_record_(self)
+      json = self.read_json_line_("intOrSimpleRecord");
+      value = self.int_or_simple_record_converter.from_json(json);
+    end
+
+    function more = has_nullable_int_or_simple_record_(self)
+      more = self.has_json_line_("nullableIntOrSimpleRecord");
+    end
+
+    function value = read_nullable_int_or_simple_record_(self)
+      json = self.read_json_line_("nullableIntOrSimpleRecord");
+      value = self.nullable_int_or_simple_record_converter.from_json(json);
+    end
+
+    function more = has_many_cases_(self)
+      more = self.has_json_line_("manyCases");
+    end
+
+    function value = read_many_cases_(self)
+      json = self.read_json_line_("manyCases");
+      value = self.many_cases_converter.from_json(json);
+    end
+  end
+end
@@ -0,0 +1,34 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef StreamsOfUnionsWriter < yardl.ndjson.NDJsonProtocolWriter & test_model.StreamsOfUnionsWriterBase
+  % NDJSON writer for the StreamsOfUnions protocol
+  properties (Access=protected)
+    int_or_simple_record_converter
+    nullable_int_or_simple_record_converter
+    many_cases_converter
+  end
+
+  methods
+    function self = StreamsOfUnionsWriter(filename)
+      self@test_model.StreamsOfUnionsWriterBase();
+      self@yardl.ndjson.NDJsonProtocolWriter(filename, test_model.StreamsOfUnionsWriterBase.schema);
+      self.int_or_simple_record_converter = yardl.ndjson.UnionConverter('test_model.Int32OrSimpleRecord', {yardl.ndjson.Int32Converter, test_model.ndjson.SimpleRecordConverter()}, {@test_model.Int32OrSimpleRecord.Int32, @test_model.Int32OrSimpleRecord.SimpleRecord}, ["int32", "SimpleRecord"], {["number"], ["object"]}, true);
+      self.nullable_int_or_simple_record_converter = yardl.ndjson.UnionConverter('test_model.Int32OrSimpleRecord', {yardl.ndjson.NoneConverter, yardl.ndjson.Int32Converter, test_model.ndjson.SimpleRecordConverter()}, {yardl.None, @test_model.Int32OrSimpleRecord.Int32, @test_model.Int32OrSimpleRecord.SimpleRecord}, ["", "int32", "SimpleRecord"], {["null"], ["number"], ["object"]}, true);
+      self.many_cases_converter = yardl.ndjson.UnionConverter('test_model.Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray', {yardl.ndjson.Int32Converter, yardl.ndjson.Float32Converter, yardl.ndjson.StringConverter, test_model.ndjson.SimpleRecordConverter(), yardl.ndjson.FixedNDArrayConverter(yardl.ndjson.Int32Converter, [4, 2])}, {@test_model.Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray.Int32, @test_model.Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray.Float32, @test_model.Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray.String, @test_model.Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray.SimpleRecord, @test_model.Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray.NamedFixedNDArray}, ["int32", "float32", "string", "SimpleRecord", "NamedFixedNDArray"], {["number"], ["number"], ["string"], ["object"], ["array"]}, false);
+    end
+  end
+
+  methods (Access=protected)
+    function write_int_or_simple_record_(self, value)
+      self.write_json_stream_("intOrSimpleRecord", self.int_or_simple_record_converter, value);
+    end
+
+    function write_nullable_int_or_simple_record_(self, value)
+      self.write_json_stream_("nullableIntOrSimpleRecord", self.nullable_int_or_simple_record_converter, value);
+    end
+
+    function write_many_cases_(self, value)
+      self.write_json_stream_("manyCases", self.many_cases_converter, value);
+    end
+  end
+end
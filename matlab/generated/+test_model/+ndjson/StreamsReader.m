@@ -0,0 +1,64 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef StreamsReader < yardl.ndjson.NDJsonProtocolReader & test_model.StreamsReaderBase
+  % NDJSON reader for the Streams protocol
+  properties (Access=protected)
+    int_data_converter
+    optional_int_data_converter
+    record_with_optional_vector_data_converter
+    fixed_vector_converter
+  end
+
+  methods
+    function self = StreamsReader(filename, options)
+      arguments
+        filename (1,1) string
+        options.skip_completed_check (1,1) logical = false
+      end
+      self@test_model.StreamsReaderBase(skip_completed_check=options.skip_completed_check);
+      self@yardl.ndjson.NDJsonProtocolReader(filename, test_model.StreamsReaderBase.schema);
+      self.int_data_converter = yardl.ndjson.Int32Converter;
+      self.optional_int_data_converter = yardl.ndjson.OptionalConverter(yardl.ndjson.Int32Converter);
+      self.record_with_optional_vector_data_converter = test_model.ndjson.RecordWithOptionalVectorConverter();
+      self.fixed_vector_converter = yardl.ndjson.FixedVectorConverter(yardl.ndjson.Int32Converter, 3);
+    end
+  end
+
+  methods (Access=protected)
+    function more = has_int_data_(self)
+      more = self.has_json_line_("intData");
+    end
+
+    function value = read_int_data_(self)
+      json = self.read_json_line_("intData");
+      value = self.int_data_converter.from_json(json);
+    end
+
+    function more = has_optional_int_data_(self)
+      more = self.has_json_line_("optionalIntData");
+    end
+
+    function value = read_optional_int_data_(self)
+      json = self.read_json_line_("optionalIntData");
+      value = self.optional_int_data_converter.from_json(json);
+    end
+
+    function more = has_record_with_optional_vector_data_(self)
+      more = self.has_json_line_("recordWithOptionalVectorData");
+    end
+
+    function value = read_record_with_optional_vector_data_(self)
+      json = self.read_json_line_("recordWithOptionalVectorData");
+      value = self.record_with_optional_vector_data_converter.from_json(json);
+    end
+
+    function more = has_fixed_vector_(self)
+      more = self.has_json_line_("fixedVector");
+    end
+
+    function value = read_fixed_vector_(self)
+      json = self.read_json_line_("fixedVector");
+      value = self.fixed_vector_converter.from_json(json);
+    end
+  end
+end
@@ -0,0 +1,40 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef StreamsWriter < yardl.ndjson.NDJsonProtocolWriter & test_model.StreamsWriterBase
+  % NDJSON writer for the Streams protocol
+  properties (Access=protected)
+    int_data_converter
+    optional_int_data_converter
+    record_with_optional_vector_data_converter
+    fixed_vector_converter
+  end
+
+  methods
+    function self = StreamsWriter(filename)
+      self@test_model.StreamsWriterBase();
+      self@yardl.ndjson.NDJsonProtocolWriter(filename, test_model.StreamsWriterBase.schema);
+      self.int_data_converter = yardl.ndjson.Int32Converter;
+      self.optional_int_data_converter = yardl.ndjson.OptionalConverter(yardl.ndjson.Int32Converter);
+      self.record_with_optional_vector_data_converter = test_model.ndjson.RecordWithOptionalVectorConverter();
+      self.fixed_vector_converter = yardl.ndjson.FixedVectorConverter(yardl.ndjson.Int32Converter, 3);
+    end
+  end
+
+  methods (Access=protected)
+    function write_int_data_(self, value)
+      self.write_json_stream_("intData", self.int_data_converter, value);
+    end
+
+    function write_optional_int_data_(self, value)
+      self.write_json_stream_("optionalIntData", self.optional_int_data_converter, value);
+    end
+
+    function write_record_with_optional_vector_data_(self, value)
+      self.write_json_stream_("recordWithOptionalVectorData", self.record_with_optional_vector_data_converter, value);
+    end
+
+    function write_fixed_vector_(self, value)
+      self.write_json_stream_("fixedVector", self.fixed_vector_converter, value);
+    end
+  end
+end
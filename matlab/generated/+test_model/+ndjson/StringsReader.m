@@ -0,0 +1,34 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef StringsReader < yardl.ndjson.NDJsonProtocolReader & test_model.StringsReaderBase
+  % NDJSON reader for the Strings protocol
+  properties (Access=protected)
+    single_string_converter
+    rec_with_string_converter
+  end
+
+  methods
+    function self = StringsReader(filename, options)
+      arguments
+        filename (1,1) string
+        options.skip_completed_check (1,1) logical = false
+      end
+      self@test_model.StringsReaderBase(skip_completed_check=options.skip_completed_check);
+      self@yardl.ndjson.NDJsonProtocolReader(filename, test_model.StringsReaderBase.schema);
+      self.single_string_converter = yardl.ndjson.StringConverter;
+      self.rec_with_string_converter = test_model.ndjson.RecordWithStringsConverter();
+    end
+  end
+
+  methods (Access=protected)
+    function value = read_single_string_(self)
+      json = self.read_json_line_("singleString");
+      value = self.single_string_converter.from_json(json);
+    end
+
+    function value = read_rec_with_string_(self)
+      json = self.read_json_line_("recWithString");
+      value = self.rec_with_string_converter.from_json(json);
+    end
+  end
+end
@@ -0,0 +1,28 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef StringsWriter < yardl.ndjson.NDJsonProtocolWriter & test_model.StringsWriterBase
+  % NDJSON writer for the Strings protocol
+  properties (Access=protected)
+    single_string_converter
+    rec_with_string_converter
+  end
+
+  methods
+    function self = StringsWriter(filename)
+      self@test_model.StringsWriterBase();
+      self@yardl.ndjson.NDJsonProtocolWriter(filename, test_model.StringsWriterBase.schema);
+      self.single_string_converter = yardl.ndjson.StringConverter;
+      self.rec_with_string_converter = test_model.ndjson.RecordWithStringsConverter();
+    end
+  end
+
+  methods (Access=protected)
+    function write_single_string_(self, value)
+      self.write_json_line_("singleString", self.single_string_converter.to_json(value));
+    end
+
+    function write_rec_with_string_(self, value)
+      self.write_json_line_("recWithString", self.rec_with_string_converter.to_json(value));
+    end
+  end
+end
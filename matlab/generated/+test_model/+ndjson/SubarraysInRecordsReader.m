@@ -0,0 +1,34 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef SubarraysInRecordsReader < yardl.ndjson.NDJsonProtocolReader & test_model.SubarraysInRecordsReaderBase
+  % NDJSON reader for the SubarraysInRecords protocol
+  properties (Access=protected)
+    with_fixed_subarrays_converter
+    with_vlen_subarrays_converter
+  end
+
+  methods
+    function self = SubarraysInRecordsReader(filename, options)
+      arguments
+        filename (1,1) string
+        options.skip_completed_check (1,1) logical = false
+      end
+      self@test_model.SubarraysInRecordsReaderBase(skip_completed_check=options.skip_completed_check);
+      self@yardl.ndjson.NDJsonProtocolReader(filename, test_model.SubarraysInRecordsReaderBase.schema);
+      self.with_fixed_subarrays_converter = yardl.ndjson.DynamicNDArrayConverter(test_model.ndjson.RecordWithFixedCollectionsConverter());
+      self.with_vlen_subarrays_converter = yardl.ndjson.DynamicNDArrayConverter(test_model.ndjson.RecordWithVlenCollectionsConverter());
+    end
+  end
+
+  methods (Access=protected)
+    function value = read_with_fixed_subarrays_(self)
+      json = self.read_json_line_("withFixedSubarrays");
+      value = self.with_fixed_subarrays_converter.from_json(json);
+    end
+
+    function value = read_with_vlen_subarrays_(self)
+      json = self.read_json_line_("withVlenSubarrays");
+      value = self.with_vlen_subarrays_converter.from_json(json);
+    end
+  end
+end
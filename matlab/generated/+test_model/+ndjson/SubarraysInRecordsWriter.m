@@ -0,0 +1,28 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef SubarraysInRecordsWriter < yardl.ndjson.NDJsonProtocolWriter & test_model.SubarraysInRecordsWriterBase
+  % NDJSON writer for the SubarraysInRecords protocol
+  properties (Access=protected)
+    with_fixed_subarrays_converter
+    with_vlen_subarrays_converter
+  end
+
+  methods
+    function self = SubarraysInRecordsWriter(filename)
+      self@test_model.SubarraysInRecordsWriterBase();
+      self@yardl.ndjson.NDJsonProtocolWriter(filename, test_model.SubarraysInRecordsWriterBase.schema);
+      self.with_fixed_subarrays_converter = yardl.ndjson.DynamicNDArrayConverter(test_model.ndjson.RecordWithFixedCollectionsConverter());
+      self.with_vlen_subarrays_converter = yardl.ndjson.DynamicNDArrayConverter(test_model.ndjson.RecordWithVlenCollectionsConverter());
+    end
+  end
+
+  methods (Access=protected)
+    function write_with_fixed_subarrays_(self, value)
+      self.write_json_line_("withFixedSubarrays", self.with_fixed_subarrays_converter.to_json(value));
+    end
+
+    function write_with_vlen_subarrays_(self, value)
+      self.write_json_line_("withVlenSubarrays", self.with_vlen_subarrays_converter.to_json(value));
+    end
+  end
+end
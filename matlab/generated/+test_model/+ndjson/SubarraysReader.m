@@ -0,0 +1,83 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef SubarraysReader < yardl.ndjson.NDJsonProtocolReader & test_model.SubarraysReaderBase
+  % NDJSON reader for the Subarrays protocol
+  properties (Access=protected)
+    dynamic_with_fixed_int_subarray_converter
+    dynamic_with_fixed_float_subarray_converter
+    known_dim_count_with_fixed_int_subarray_converter
+    known_dim_count_with_fixed_float_subarray_converter
+    fixed_with_fixed_int_subarray_converter
+    fixed_with_fixed_float_subarray_converter
+    nested_subarray_converter
+    dynamic_with_fixed_vector_subarray_converter
+    generic_subarray_converter
+  end
+
+  methods
+    function self = SubarraysReader(filename, options)
+      arguments
+        filename (1,1) string
+        options.skip_completed_check (1,1) logical = false
+      end
+      self@test_model.SubarraysReaderBase(skip_completed_check=options.skip_completed_check);
+      self@yardl.ndjson.NDJsonProtocolReader(filename, test_model.SubarraysReaderBase.schema);
+      self.dynamic_with_fixed_int_subarray_converter = yardl.ndjson.DynamicNDArrayConverter(yardl.ndjson.FixedNDArrayConverter(yardl.ndjson.Int32Converter, [3]));
+      self.dynamic_with_fixed_float_subarray_converter = yardl.ndjson.DynamicNDArrayConverter(yardl.ndjson.FixedNDArrayConverter(yardl.ndjson.Float32Converter, [3]));
+      self.known_dim_count_with_fixed_int_subarray_converter = yardl.ndjson.NDArrayConverter(yardl.ndjson.FixedNDArrayConverter(yardl.ndjson.Int32Converter, [3]), 1);
+      self.known_dim_count_with_fixed_float_subarray_converter = yardl.ndjson.NDArrayConverter(yardl.ndjson.FixedNDArrayConverter(yardl.ndjson.Float32Converter, [3]), 1);
+      self.fixed_with_fixed_int_subarray_converter = yardl.ndjson.FixedNDArrayConverter(yardl.ndjson.FixedNDArrayConverter(yardl.ndjson.Int32Converter, [3]), [2]);
+      self.fixed_with_fixed_float_subarray_converter = yardl.ndjson.FixedNDArrayConverter(yardl.ndjson.FixedNDArrayConverter(yardl.ndjson.Float32Converter, [3]), [2]);
+      self.nested_subarray_converter = yardl.ndjson.DynamicNDArrayConverter(yardl.ndjson.FixedNDArrayConverter(yardl.ndjson.FixedNDArrayConverter(yardl.ndjson.Int32Converter, [3]), [2]));
+      self.dynamic_with_fixed_vector_subarray_converter = yardl.ndjson.DynamicNDArrayConverter(yardl.ndjson.FixedVectorConverter(yardl.ndjson.Int32Converter, 3));
+      self.generic_subarray_converter = yardl.ndjson.NDArrayConverter(yardl.ndjson.FixedNDArrayConverter(yardl.ndjson.Int32Converter, [3]), 2);
+    end
+  end
+
+  methods (Access=protected)
+    function value = read_dynamic_with_fixed_int_subarray_(self)
+      json = self.read_json_line_("dynamicWithFixedIntSubarray");
+      value = self.dynamic_with_fixed_int_subarray_converter.from_json(json);
+    end
+
+    function value = read_dynamic_with_fixed_float_subarray_(self)
+      json = self.read_json_line_("dynamicWithFixedFloatSubarray");
+      value = self.dynamic_with_fixed_float_subarray_converter.from_json(json);
+    end
+
+    function value = read_known_dim_count_with_fixed_int_subarray_(self)
+      json = self.read_json_line_("knownDimCountWithFixedIntSubarray");
+      value = self.known_dim_count_with_fixed_int_subarray_converter.from_json(json);
+    end
+
+    function value = read_known_dim_count_with_fixed_float_subarray_(self)
+      json = self.read_json_line_("knownDimCountWithFixedFloatSubarray");
+      value = self.known_dim_count_with_fixed_float_subarray_converter.from_json(json);
+    end
+
+    function value = read_fixed_with_fixed_int_subarray_(self)
+      json = self.read_json_line_("fixedWithFixedIntSubarray");
+      value = self.fixed_with_fixed_int_subarray_converter.from_json(json);
+    end
+
+    function value = read_fixed_with_fixed_float_subarray_(self)
+      json = self.read_json_line_("fixedWithFixedFloatSubarray");
+      value = self.fixed_with_fixed_float_subarray_converter.from_json(json);
+    end
+
+    function value = read_nested_subarray_(self)
+      json = self.read_json_line_("nestedSubarray");
+      value = self.nested_subarray_converter.from_json(json);
+    end
+
+    function value = read_dynamic_with_fixed_vector_subarray_(self)
+      json = self.read_json_line_("dynamicWithFixedVectorSubarray");
+      value = self.dynamic_with_fixed_vector_subarray_converter.from_json(json);
+    end
+
+    function value = read_generic_subarray_(self)
+      json = self.read_json_line_("genericSubarray");
+      value = self.generic_subarray_converter.from_json(json);
+    end
+  end
+end
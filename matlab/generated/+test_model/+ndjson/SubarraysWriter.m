@@ -0,0 +1,70 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef SubarraysWriter < yardl.ndjson.NDJsonProtocolWriter & test_model.SubarraysWriterBase
+  % NDJSON writer for the Subarrays protocol
+  properties (Access=protected)
+    dynamic_with_fixed_int_subarray_converter
+    dynamic_with_fixed_float_subarray_converter
+    known_dim_count_with_fixed_int_subarray_converter
+    known_dim_count_with_fixed_float_subarray_converter
+    fixed_with_fixed_int_subarray_converter
+    fixed_with_fixed_float_subarray_converter
+    nested_subarray_converter
+    dynamic_with_fixed_vector_subarray_converter
+    generic_subarray_converter
+  end
+
+  methods
+    function self = SubarraysWriter(filename)
+      self@test_model.SubarraysWriterBase();
+      self@yardl.ndjson.NDJsonProtocolWriter(filename, test_model.SubarraysWriterBase.schema);
+      self.dynamic_with_fixed_int_subarray_converter = yardl.ndjson.DynamicNDArrayConverter(yardl.ndjson.FixedNDArrayConverter(yardl.ndjson.Int32Converter, [3]));
+      self.dynamic_with_fixed_float_subarray_converter = yardl.ndjson.DynamicNDArrayConverter(yardl.ndjson.FixedNDArrayConverter(yardl.ndjson.Float32Converter, [3]));
+      self.known_dim_count_with_fixed_int_subarray_converter = yardl.ndjson.NDArrayConverter(yardl.ndjson.FixedNDArrayConverter(yardl.ndjson.Int32Converter, [3]), 1);
+      self.known_dim_count_with_fixed_float_subarray_converter = yardl.ndjson.NDArrayConverter(yardl.ndjson.FixedNDArrayConverter(yardl.ndjson.Float32Converter, [3]), 1);
+      self.fixed_with_fixed_int_subarray_converter = yardl.ndjson.FixedNDArrayConverter(yardl.ndjson.FixedNDArrayConverter(yardl.ndjson.Int32Converter, [3]), [2]);
+      self.fixed_with_fixed_float_subarray_converter = yardl.ndjson.FixedNDArrayConverter(yardl.ndjson.FixedNDArrayConverter(yardl.ndjson.Float32Converter, [3]), [2]);
+      self.nested_subarray_converter = yardl.ndjson.DynamicNDArrayConverter(yardl.ndjson.FixedNDArrayConverter(yardl.ndjson.FixedNDArrayConverter(yardl.ndjson.Int32Converter, [3]), [2]));
+      self.dynamic_with_fixed_vector_subarray_converter = yardl.ndjson.DynamicNDArrayConverter(yardl.ndjson.FixedVectorConverter(yardl.ndjson.Int32Converter, 3));
+      self.generic_subarray_converter = yardl.ndjson.NDArrayConverter(yardl.ndjson.FixedNDArrayConverter(yardl.ndjson.Int32Converter, [3]), 2);
+    end
+  end
+
+  methods (Access=protected)
+    function write_dynamic_with_fixed_int_subarray_(self, value)
+      self.write_json_line_("dynamicWithFixedIntSubarray", self.dynamic_with_fixed_int_subarray_converter.to_json(value));
+    end
+
+    function write_dynamic_with_fixed_float_subarray_(self, value)
+      self.write_json_line_("dynamicWithFixedFloatSubarray", self.dynamic_with_fixed_float_subarray_converter.to_json(value));
+    end
+
+    function write_known_dim_count_with_fixed_int_subarray_(self, value)
+      self.write_json_line_("knownDimCountWithFixedIntSubarray", self.known_dim_count_with_fixed_int_subarray_converter.to_json(value));
+    end
+
+    function write_known_dim_count_with_fixed_float_subarray_(self, value)
+      self.write_json_line_("knownDimCountWithFixedFloatSubarray", self.known_dim_count_with_fixed_float_subarray_converter.to_json(value));
+    end
+
+    function write_fixed_with_fixed_int_subarray_(self, value)
+      self.write_json_line_("fixedWithFixedIntSubarray", self.fixed_with_fixed_int_subarray_converter.to_json(value));
+    end
+
+    function write_fixed_with_fixed_float_subarray_(self, value)
+      self.write_json_line_("fixedWithFixedFloatSubarray", self.fixed_with_fixed_float_subarray_converter.to_json(value));
+    end
+
+    function write_nested_subarray_(self, value)
+      self.write_json_line_("nestedSubarray", self.nested_subarray_converter.to_json(value));
+    end
+
+    function write_dynamic_with_fixed_vector_subarray_(self, value)
+      self.write_json_line_("dynamicWithFixedVectorSubarray", self.dynamic_with_fixed_vector_subarray_converter.to_json(value));
+    end
+
+    function write_generic_subarray_(self, value)
+      self.write_json_line_("genericSubarray", self.generic_subarray_converter.to_json(value));
+    end
+  end
+end
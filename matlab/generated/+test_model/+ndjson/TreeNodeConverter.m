@@ -0,0 +1,24 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef TreeNodeConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = TreeNodeConverter()
+      field_converters{1} = yardl.ndjson.StringConverter;
+      field_converters{2} = yardl.ndjson.VectorConverter(yardl.ndjson.RecursiveConverter('test_model.TreeNode', @() test_model.ndjson.TreeNodeConverter()));
+      self@yardl.ndjson.RecordConverter('test_model.TreeNode', ["label", "children"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.TreeNode
+      end
+      json = self.to_json_(value.label, value.children);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.TreeNode(label=fields{1}, children=fields{2});
+    end
+  end
+end
@@ -0,0 +1,24 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef TupleWithRecordsConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = TupleWithRecordsConverter()
+      field_converters{1} = test_model.ndjson.SimpleRecordConverter();
+      field_converters{2} = test_model.ndjson.SimpleRecordConverter();
+      self@yardl.ndjson.RecordConverter('test_model.TupleWithRecords', ["a", "b"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) test_model.TupleWithRecords
+      end
+      json = self.to_json_(value.a, value.b);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = test_model.TupleWithRecords(a=fields{1}, b=fields{2});
+    end
+  end
+end
@@ -0,0 +1,11 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef UInt64EnumConverter < yardl.ndjson.EnumConverter
+  methods
+    function self = UInt64EnumConverter()
+      symbols = ["a"];
+      values = [test_model.UInt64Enum.A];
+      self@yardl.ndjson.EnumConverter('test_model.UInt64Enum', @test_model.UInt64Enum, yardl.ndjson.Uint64Converter, symbols, values);
+    end
+  end
+end
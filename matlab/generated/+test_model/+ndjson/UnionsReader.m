@@ -0,0 +1,55 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef UnionsReader < yardl.ndjson.NDJsonProtocolReader & test_model.UnionsReaderBase
+  % NDJSON reader for the Unions protocol
+  properties (Access=protected)
+    int_or_simple_record_converter
+    int_or_record_with_vlens_converter
+    monosotate_or_int_or_simple_record_converter
+    vector_of_unions_converter
+    record_with_unions_converter
+  end
+
+  methods
+    function self = UnionsReader(filename, options)
+      arguments
+        filename (1,1) string
+        options.skip_completed_check (1,1) logical = false
+      end
+      self@test_model.UnionsReaderBase(skip_completed_check=options.skip_completed_check);
+      self@yardl.ndjson.NDJsonProtocolReader(filename, test_model.UnionsReaderBase.schema);
+      self.int_or_simple_record_converter = yardl.ndjson.UnionConverter('test_model.Int32OrSimpleRecord', {yardl.ndjson.Int32Converter, test_model.ndjson.SimpleRecordConverter()}, {@test_model.Int32OrSimpleRecord.Int32, @test_model.Int32OrSimpleRecord.SimpleRecord}, ["int32", "SimpleRecord"], {["number"], ["object"]}, true);
+      self.int_or_record_with_vlens_converter = yardl.ndjson.UnionConverter('test_model.Int32OrRecordWithVlens', {yardl.ndjson.Int32Converter, test_model.ndjson.RecordWithVlensConverter()}, {@test_model.Int32OrRecordWithVlens.Int32, @test_model.Int32OrRecordWithVlens.RecordWithVlens}, ["int32", "RecordWithVlens"], {["number"], ["object"]}, true);
+      self.monosotate_or_int_or_simple_record_converter = yardl.ndjson.UnionConverter('test_model.Int32OrSimpleRecord', {yardl.ndjson.NoneConverter, yardl.ndjson.Int32Converter, test_model.ndjson.SimpleRecordConverter()}, {yardl.None, @test_model.Int32OrSimpleRecord.Int32, @test_model.Int32OrSimpleRecord.SimpleRecord}, ["", "int32", "SimpleRecord"], {["null"], ["number"], ["object"]}, true);
+      self.vector_of_unions_converter = yardl.ndjson.VectorConverter(yardl.ndjson.UnionConverter('test_model.StringOrInt32', {yardl.ndjson.StringConverter, yardl.ndjson.Int32Converter}, {@test_model.StringOrInt32.String, @test_model.StringOrInt32.Int32}, ["string", "int32"], {["string"], ["number"]}, true));
+      self.record_with_unions_converter = basic_types.ndjson.RecordWithUnionsConverter();
+    end
+  end
+
+  methods (Access=protected)
+    function value = read_int_or_simple_record_(self)
+      json = self.read_json_line_("intOrSimpleRecord");
+      value = self.int_or_simple_record_converter.from_json(json);
+    end
+
+    function value = read_int_or_record_with_vlens_(self)
+      json = self.read_json_line_("intOrRecordWithVlens");
+      value = self.int_or_record_with_vlens_converter.from_json(json);
+    end
+
+    function value = read_monosotate_or_int_or_simple_record_(self)
+      json = self.read_json_line_("monosotateOrIntOrSimpleRecord");
+      value = self.monosotate_or_int_or_simple_record_converter.from_json(json);
+    end
+
+    function value = read_vector_of_unions_(self)
+      json = self.read_json_line_("vectorOfUnions");
+      value = self.vector_of_unions_converter.from_json(json);
+    end
+
+    function value = read_record_with_unions_(self)
+      json = self.read_json_line_("recordWithUnions");
+      value = self.record_with_unions_converter.from_json(json);
+    end
+  end
+end
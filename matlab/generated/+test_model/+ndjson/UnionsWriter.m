@@ -0,0 +1,46 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef UnionsWriter < yardl.ndjson.NDJsonProtocolWriter & test_model.UnionsWriterBase
+  % NDJSON writer for the Unions protocol
+  properties (Access=protected)
+    int_or_simple_record_converter
+    int_or_record_with_vlens_converter
+    monosotate_or_int_or_simple_record_converter
+    vector_of_unions_converter
+    record_with_unions_converter
+  end
+
+  methods
+    function self = UnionsWriter(filename)
+      self@test_model.UnionsWriterBase();
+      self@yardl.ndjson.NDJsonProtocolWriter(filename, test_model.UnionsWriterBase.schema);
+      self.int_or_simple_record_converter = yardl.ndjson.UnionConverter('test_model.Int32OrSimpleRecord', {yardl.ndjson.Int32Converter, test_model.ndjson.SimpleRecordConverter()}, {@test_model.Int32OrSimpleRecord.Int32, @test_model.Int32OrSimpleRecord.SimpleRecord}, ["int32", "SimpleRecord"], {["number"], ["object"]}, true);
+      self.int_or_record_with_vlens_converter = yardl.ndjson.UnionConverter('test_model.Int32OrRecordWithVlens', {yardl.ndjson.Int32Converter, test_model.ndjson.RecordWithVlensConverter()}, {@test_model.Int32OrRecordWithVlens.Int32, @test_model.Int32OrRecordWithVlens.RecordWithVlens}, ["int32", "RecordWithVlens"], {["number"], ["object"]}, true);
+      self.monosotate_or_int_or_simple_record_converter = yardl.ndjson.UnionConverter('test_model.Int32OrSimpleRecord', {yardl.ndjson.NoneConverter, yardl.ndjson.Int32Converter, test_model.ndjson.SimpleRecordConverter()}, {yardl.None, @test_model.Int32OrSimpleRecord.Int32, @test_model.Int32OrSimpleRecord.SimpleRecord}, ["", "int32", "SimpleRecord"], {["null"], ["number"], ["object"]}, true);
+      self.vector_of_unions_converter = yardl.ndjson.VectorConverter(yardl.ndjson.UnionConverter('test_model.StringOrInt32', {yardl.ndjson.StringConverter, yardl.ndjson.Int32Converter}, {@test_model.StringOrInt32.String, @test_model.StringOrInt32.Int32}, ["string", "int32"], {["string"], ["number"]}, true));
+      self.record_with_unions_converter = basic_types.ndjson.RecordWithUnionsConverter();
+    end
+  end
+
+  methods (Access=protected)
+    function write_int_or_simple_record_(self, value)
+      self.write_json_line_("intOrSimpleRecord", self.int_or_simple_record_converter.to_json(value));
+    end
+
+    function write_int_or_record_with_vlens_(self, value)
+      self.write_json_line_("intOrRecordWithVlens", self.int_or_record_with_vlens_converter.to_json(value));
+    end
+
+    function write_monosotate_or_int_or_simple_record_(self, value)
+      self.write_json_line_("monosotateOrIntOrSimpleRecord", self.monosotate_or_int_or_simple_record_converter.to_json(value));
+    end
+
+    function write_vector_of_unions_(self, value)
+      self.write_json_line_("vectorOfUnions", self.vector_of_unions_converter.to_json(value));
+    end
+
+    function write_record_with_unions_(self, value)
+      self.write_json_line_("recordWithUnions", self.record_with_unions_converter.to_json(value));
+    end
+  end
+end
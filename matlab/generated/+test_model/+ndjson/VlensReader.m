@@ -0,0 +1,48 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef VlensReader < yardl.ndjson.NDJsonProtocolReader & test_model.VlensReaderBase
+  % NDJSON reader for the Vlens protocol
+  properties (Access=protected)
+    int_vector_converter
+    complex_vector_converter
+    record_with_vlens_converter
+    vlen_of_record_with_vlens_converter
+  end
+
+  methods
+    function self = VlensReader(filename, options)
+      arguments
+        filename (1,1) string
+        options.skip_completed_check (1,1) logical = false
+      end
+      self@test_model.VlensReaderBase(skip_completed_check=options.skip_completed_check);
+      self@yardl.ndjson.NDJsonProtocolReader(filename, test_model.VlensReaderBase.schema);
+      self.int_vector_converter = yardl.ndjson.VectorConverter(yardl.ndjson.Int32Converter);
+      self.complex_vector_converter = yardl.ndjson.VectorConverter(yardl.ndjson.Complexfloat32Converter);
+      self.record_with_vlens_converter = test_model.ndjson.RecordWithVlensConverter();
+      self.vlen_of_record_with_vlens_converter = yardl.ndjson.VectorConverter(test_model.ndjson.RecordWithVlensConverter());
+    end
+  end
+
+  methods (Access=protected)
+    function value = read_int_vector_(self)
+      json = self.read_json_line_("intVector");
+      value = self.int_vector_converter.from_json(json);
+    end
+
+    function value = read_complex_vector_(self)
+      json = self.read_json_line_("complexVector");
+      value = self.complex_vector_converter.from_json(json);
+    end
+
+    function value = read_record_with_vlens_(self)
+      json = self.read_json_line_("recordWithVlens");
+      value = self.record_with_vlens_converter.from_json(json);
+    end
+
+    function value = read_vlen_of_record_with_vlens_(self)
+      json = self.read_json_line_("vlenOfRecordWithVlens");
+      value = self.vlen_of_record_with_vlens_converter.from_json(json);
+    end
+  end
+end
@@ -0,0 +1,40 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef VlensWriter < yardl.ndjson.NDJsonProtocolWriter & test_model.VlensWriterBase
+  % NDJSON writer for the Vlens protocol
+  properties (Access=protected)
+    int_vector_converter
+    complex_vector_converter
+    record_with_vlens_converter
+    vlen_of_record_with_vlens_converter
+  end
+
+  methods
+    function self = VlensWriter(filename)
+      self@test_model.VlensWriterBase();
+      self@yardl.ndjson.NDJsonProtocolWriter(filename, test_model.VlensWriterBase.schema);
+      self.int_vector_converter = yardl.ndjson.VectorConverter(yardl.ndjson.Int32Converter);
+      self.complex_vector_converter = yardl.ndjson.VectorConverter(yardl.ndjson.Complexfloat32Converter);
+      self.record_with_vlens_converter = test_model.ndjson.RecordWithVlensConverter();
+      self.vlen_of_record_with_vlens_converter = yardl.ndjson.VectorConverter(test_model.ndjson.RecordWithVlensConverter());
+    end
+  end
+
+  methods (Access=protected)
+    function write_int_vector_(self, value)
+      self.write_json_line_("intVector", self.int_vector_converter.to_json(value));
+    end
+
+    function write_complex_vector_(self, value)
+      self.write_json_line_("complexVector", self.complex_vector_converter.to_json(value));
+    end
+
+    function write_record_with_vlens_(self, value)
+      self.write_json_line_("recordWithVlens", self.record_with_vlens_converter.to_json(value));
+    end
+
+    function write_vlen_of_record_with_vlens_(self, value)
+      self.write_json_line_("vlenOfRecordWithVlens", self.vlen_of_record_with_vlens_converter.to_json(value));
+    end
+  end
+end
@@ -0,0 +1,63 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef MockCalibrationWriter < matlab.mixin.Copyable & test_model.CalibrationWriterBase
+  properties
+    testCase_
+    expected_gain
+    expected_samples
+  end
+
+  methods
+    function self = MockCalibrationWriter(testCase)
+      self.testCase_ = testCase;
+      self.expected_gain = yardl.None;
+      self.expected_samples = {};
+    end
+
+    function expect_write_gain_(self, value)
+      self.expected_gain = yardl.Optional(value);
+    end
+
+    function expect_write_samples_(self, value)
+      if iscell(value)
+        for n = 1:numel(value)
+          self.expected_samples{end+1} = value{n};
+        end
+        return;
+      end
+      shape = size(value);
+      lastDim = ndims(value);
+      count = shape(lastDim);
+      index = repelem({':'}, lastDim-1);
+      for n = 1:count
+        self.expected_samples{end+1} = value(index{:}, n);
+      end
+    end
+
+    function verify(self)
+      self.testCase_.verifyEqual(self.expected_gain, yardl.None, "Expected call to write_gain_ was not received");
+      self.testCase_.verifyTrue(isempty(self.expected_samples), "Expected call to write_samples_ was not received");
+    end
+  end
+
+  methods (Access=protected)
+    function write_gain_(self, value)
+      self.testCase_.verifyTrue(self.expected_gain.has_value(), "Unexpected call to write_gain_");
+      self.testCase_.verifyEqual(value, self.expected_gain.value, "Unexpected argument value for call to write_gain_");
+      self.expected_gain = yardl.None;
+    end
+
+    function write_samples_(self, value)
+      assert(iscell(value));
+      assert(isscalar(value));
+      self.testCase_.verifyFalse(isempty(self.expected_samples), "Unexpected call to write_samples_");
+      self.testCase_.verifyEqual(value{1}, self.expected_samples{1}, "Unexpected argument value for call to write_samples_");
+      self.expected_samples = self.expected_samples(2:end);
+    end
+
+    function close_(self)
+    end
+    function end_stream_(self)
+    end
+  end
+end
@@ -0,0 +1,76 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef MockComplexImageStreamWriter < matlab.mixin.Copyable & test_model.ComplexImageStreamWriterBase
+  properties
+    testCase_
+    expected_header
+    expected_images
+    expected_background
+  end
+
+  methods
+    function self = MockComplexImageStreamWriter(testCase)
+      self.testCase_ = testCase;
+      self.expected_header = yardl.None;
+      self.expected_images = {};
+      self.expected_background = yardl.None;
+    end
+
+    function expect_write_header_(self, value)
+      self.expected_header = yardl.Optional(value);
+    end
+
+    function expect_write_images_(self, value)
+      if iscell(value)
+        for n = 1:numel(value)
+          self.expected_images{end+1} = value{n};
+        end
+        return;
+      end
+      shape = size(value);
+      lastDim = ndims(value);
+      count = shape(lastDim);
+      index = repelem({':'}, lastDim-1);
+      for n = 1:count
+        self.expected_images{end+1} = value(index{:}, n);
+      end
+    end
+
+    function expect_write_background_(self, value)
+      self.expected_background = yardl.Optional(value);
+    end
+
+    function verify(self)
+      self.testCase_.verifyEqual(self.expected_header, yardl.None, "Expected call to write_header_ was not received");
+      self.testCase_.verifyTrue(isempty(self.expected_images), "Expected call to write_images_ was not received");
+      self.testCase_.verifyEqual(self.expected_background, yardl.None, "Expected call to write_background_ was not received");
+    end
+  end
+
+  methods (Access=protected)
+    function write_header_(self, value)
+      self.testCase_.verifyTrue(self.expected_header.has_value(), "Unexpected call to write_header_");
+      self.testCase_.verifyEqual(value, self.expected_header.value, "Unexpected argument value for call to write_header_");
+      self.expected_header = yardl.None;
+    end
+
+    function write_images_(self, value)
+      assert(iscell(value));
+      assert(isscalar(value));
+      self.testCase_.verifyFalse(isempty(self.expected_images), "Unexpected call to write_images_");
+      self.testCase_.verifyEqual(value{1}, self.expected_images{1}, "Unexpected argument value for call to write_images_");
+      self.expected_images = self.expected_images(2:end);
+    end
+
+    function write_background_(self, value)
+      self.testCase_.verifyTrue(self.expected_background.has_value(), "Unexpected call to write_background_");
+      self.testCase_.verifyEqual(value, self.expected_background.value, "Unexpected argument value for call to write_background_");
+      self.expected_background = yardl.None;
+    end
+
+    function close_(self)
+    end
+    function end_stream_(self)
+    end
+  end
+end
@@ -0,0 +1,49 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef MockProtocolWithBytesWriter < matlab.mixin.Copyable & test_model.ProtocolWithBytesWriterBase
+  properties
+    testCase_
+    expected_single_bytes
+    expected_rec_with_bytes
+  end
+
+  methods
+    function self = MockProtocolWithBytesWriter(testCase)
+      self.testCase_ = testCase;
+      self.expected_single_bytes = yardl.None;
+      self.expected_rec_with_bytes = yardl.None;
+    end
+
+    function expect_write_single_bytes_(self, value)
+      self.expected_single_bytes = yardl.Optional(value);
+    end
+
+    function expect_write_rec_with_bytes_(self, value)
+      self.expected_rec_with_bytes = yardl.Optional(value);
+    end
+
+    function verify(self)
+      self.testCase_.verifyEqual(self.expected_single_bytes, yardl.None, "Expected call to write_single_bytes_ was not received");
+      self.testCase_.verifyEqual(self.expected_rec_with_bytes, yardl.None, "Expected call to write_rec_with_bytes_ was not received");
+    end
+  end
+
+  methods (Access=protected)
+    function write_single_bytes_(self, value)
+      self.testCase_.verifyTrue(self.expected_single_bytes.has_value(), "Unexpected call to write_single_bytes_");
+      self.testCase_.verifyEqual(value, self.expected_single_bytes.value, "Unexpected argument value for call to write_single_bytes_");
+      self.expected_single_bytes = yardl.None;
+    end
+
+    function write_rec_with_bytes_(self, value)
+      self.testCase_.verifyTrue(self.expected_rec_with_bytes.has_value(), "Unexpected call to write_rec_with_bytes_");
+      self.testCase_.verifyEqual(value, self.expected_rec_with_bytes.value, "Unexpected argument value for call to write_rec_with_bytes_");
+      self.expected_rec_with_bytes = yardl.None;
+    end
+
+    function close_(self)
+    end
+    function end_stream_(self)
+    end
+  end
+end
@@ -0,0 +1,63 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef MockProtocolWithConstraintsWriter < matlab.mixin.Copyable & test_model.ProtocolWithConstraintsWriterBase
+  properties
+    testCase_
+    expected_record
+    expected_records
+  end
+
+  methods
+    function self = MockProtocolWithConstraintsWriter(testCase)
+      self.testCase_ = testCase;
+      self.expected_record = yardl.None;
+      self.expected_records = {};
+    end
+
+    function expect_write_record_(self, value)
+      self.expected_record = yardl.Optional(value);
+    end
+
+    function expect_write_records_(self, value)
+      if iscell(value)
+        for n = 1:numel(value)
+          self.expected_records{end+1} = value{n};
+        end
+        return;
+      end
+      shape = size(value);
+      lastDim = ndims(value);
+      count = shape(lastDim);
+      index = repelem({':'}, lastDim-1);
+      for n = 1:count
+        self.expected_records{end+1} = value(index{:}, n);
+      end
+    end
+
+    function verify(self)
+      self.testCase_.verifyEqual(self.expected_record, yardl.None, "Expected call to write_record_ was not received");
+      self.testCase_.verifyTrue(isempty(self.expected_records), "Expected call to write_records_ was not received");
+    end
+  end
+
+  methods (Access=protected)
+    function write_record_(self, value)
+      self.testCase_.verifyTrue(self.expected_record.has_value(), "Unexpected call to write_record_");
+      self.testCase_.verifyEqual(value, self.expected_record.value, "Unexpected argument value for call to write_record_");
+      self.expected_record = yardl.None;
+    end
+
+    function write_records_(self, value)
+      assert(iscell(value));
+      assert(isscalar(value));
+      self.testCase_.verifyFalse(isempty(self.expected_records), "Unexpected call to write_records_");
+      self.testCase_.verifyEqual(value{1}, self.expected_records{1}, "Unexpected argument value for call to write_records_");
+      self.expected_records = self.expected_records(2:end);
+    end
+
+    function close_(self)
+    end
+    function end_stream_(self)
+    end
+  end
+end
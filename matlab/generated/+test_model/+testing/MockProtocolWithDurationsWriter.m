@@ -0,0 +1,49 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef MockProtocolWithDurationsWriter < matlab.mixin.Copyable & test_model.ProtocolWithDurationsWriterBase
+  properties
+    testCase_
+    expected_single_duration
+    expected_rec_with_durations
+  end
+
+  methods
+    function self = MockProtocolWithDurationsWriter(testCase)
+      self.testCase_ = testCase;
+      self.expected_single_duration = yardl.None;
+      self.expected_rec_with_durations = yardl.None;
+    end
+
+    function expect_write_single_duration_(self, value)
+      self.expected_single_duration = yardl.Optional(value);
+    end
+
+    function expect_write_rec_with_durations_(self, value)
+      self.expected_rec_with_durations = yardl.Optional(value);
+    end
+
+    function verify(self)
+      self.testCase_.verifyEqual(self.expected_single_duration, yardl.None, "Expected call to write_single_duration_ was not received");
+      self.testCase_.verifyEqual(self.expected_rec_with_durations, yardl.None, "Expected call to write_rec_with_durations_ was not received");
+    end
+  end
+
+  methods (Access=protected)
+    function write_single_duration_(self, value)
+      self.testCase_.verifyTrue(self.expected_single_duration.has_value(), "Unexpected call to write_single_duration_");
+      self.testCase_.verifyEqual(value, self.expected_single_duration.value, "Unexpected argument value for call to write_single_duration_");
+      self.expected_single_duration = yardl.None;
+    end
+
+    function write_rec_with_durations_(self, value)
+      self.testCase_.verifyTrue(self.expected_rec_with_durations.has_value(), "Unexpected call to write_rec_with_durations_");
+      self.testCase_.verifyEqual(value, self.expected_rec_with_durations.value, "Unexpected argument value for call to write_rec_with_durations_");
+      self.expected_rec_with_durations = yardl.None;
+    end
+
+    function close_(self)
+    end
+    function end_stream_(self)
+    end
+  end
+end
@@ -0,0 +1,49 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef MockProtocolWithHalfPrecisionWriter < matlab.mixin.Copyable & test_model.ProtocolWithHalfPrecisionWriterBase
+  properties
+    testCase_
+    expected_halves
+    expected_rec_with_halves
+  end
+
+  methods
+    function self = MockProtocolWithHalfPrecisionWriter(testCase)
+      self.testCase_ = testCase;
+      self.expected_halves = yardl.None;
+      self.expected_rec_with_halves = yardl.None;
+    end
+
+    function expect_write_halves_(self, value)
+      self.expected_halves = yardl.Optional(value);
+    end
+
+    function expect_write_rec_with_halves_(self, value)
+      self.expected_rec_with_halves = yardl.Optional(value);
+    end
+
+    function verify(self)
+      self.testCase_.verifyEqual(self.expected_halves, yardl.None, "Expected call to write_halves_ was not received");
+      self.testCase_.verifyEqual(self.expected_rec_with_halves, yardl.None, "Expected call to write_rec_with_halves_ was not received");
+    end
+  end
+
+  methods (Access=protected)
+    function write_halves_(self, value)
+      self.testCase_.verifyTrue(self.expected_halves.has_value(), "Unexpected call to write_halves_");
+      self.testCase_.verifyEqual(value, self.expected_halves.value, "Unexpected argument value for call to write_halves_");
+      self.expected_halves = yardl.None;
+    end
+
+    function write_rec_with_halves_(self, value)
+      self.testCase_.verifyTrue(self.expected_rec_with_halves.has_value(), "Unexpected call to write_rec_with_halves_");
+      self.testCase_.verifyEqual(value, self.expected_rec_with_halves.value, "Unexpected argument value for call to write_rec_with_halves_");
+      self.expected_rec_with_halves = yardl.None;
+    end
+
+    function close_(self)
+    end
+    function end_stream_(self)
+    end
+  end
+end
@@ -0,0 +1,76 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef MockProtocolWithRecursiveRecordsWriter < matlab.mixin.Copyable & test_model.ProtocolWithRecursiveRecordsWriterBase
+  properties
+    testCase_
+    expected_tree
+    expected_list
+    expected_expressions
+  end
+
+  methods
+    function self = MockProtocolWithRecursiveRecordsWriter(testCase)
+      self.testCase_ = testCase;
+      self.expected_tree = yardl.None;
+      self.expected_list = yardl.None;
+      self.expected_expressions = {};
+    end
+
+    function expect_write_tree_(self, value)
+      self.expected_tree = yardl.Optional(value);
+    end
+
+    function expect_write_list_(self, value)
+      self.expected_list = yardl.Optional(value);
+    end
+
+    function expect_write_expressions_(self, value)
+      if iscell(value)
+        for n = 1:numel(value)
+          self.expected_expressions{end+1} = value{n};
+        end
+        return;
+      end
+      shape = size(value);
+      lastDim = ndims(value);
+      count = shape(lastDim);
+      index = repelem({':'}, lastDim-1);
+      for n = 1:count
+        self.expected_expressions{end+1} = value(index{:}, n);
+      end
+    end
+
+    function verify(self)
+      self.testCase_.verifyEqual(self.expected_tree, yardl.None, "Expected call to write_tree_ was not received");
+      self.testCase_.verifyEqual(self.expected_list, yardl.None, "Expected call to write_list_ was not received");
+      self.testCase_.verifyTrue(isempty(self.expected_expressions), "Expected call to write_expressions_ was not received");
+    end
+  end
+
+  methods (Access=protected)
+    function write_tree_(self, value)
+      self.testCase_.verifyTrue(self.expected_tree.has_value(), "Unexpected call to write_tree_");
+      self.testCase_.verifyEqual(value, self.expected_tree.value, "Unexpected argument value for call to write_tree_");
+      self.expected_tree = yardl.None;
+    end
+
+    function write_list_(self, value)
+      self.testCase_.verifyTrue(self.expected_list.has_value(), "Unexpected call to write_list_");
+      self.testCase_.verifyEqual(value, self.expected_list.value, "Unexpected argument value for call to write_list_");
+      self.expected_list = yardl.None;
+    end
+
+    function write_expressions_(self, value)
+      assert(iscell(value));
+      assert(isscalar(value));
+      self.testCase_.verifyFalse(isempty(self.expected_expressions), "Unexpected call to write_expressions_");
+      self.testCase_.verifyEqual(value{1}, self.expected_expressions{1}, "Unexpected argument value for call to write_expressions_");
+      self.expected_expressions = self.expected_expressions(2:end);
+    end
+
+    function close_(self)
+    end
+    function end_stream_(self)
+    end
+  end
+end
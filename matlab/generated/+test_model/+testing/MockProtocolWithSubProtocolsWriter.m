@@ -0,0 +1,143 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef MockProtocolWithSubProtocolsWriter < matlab.mixin.Copyable & test_model.ProtocolWithSubProtocolsWriterBase
+  properties
+    testCase_
+    expected_header
+    expected_calibration_gain
+    expected_calibration_samples
+    expected_data
+    expected_recalibration_gain
+    expected_recalibration_samples
+  end
+
+  methods
+    function self = MockProtocolWithSubProtocolsWriter(testCase)
+      self.testCase_ = testCase;
+      self.expected_header = yardl.None;
+      self.expected_calibration_gain = yardl.None;
+      self.expected_calibration_samples = {};
+      self.expected_data = {};
+      self.expected_recalibration_gain = yardl.None;
+      self.expected_recalibration_samples = {};
+    end
+
+    function expect_write_header_(self, value)
+      self.expected_header = yardl.Optional(value);
+    end
+
+    function expect_write_calibration_gain_(self, value)
+      self.expected_calibration_gain = yardl.Optional(value);
+    end
+
+    function expect_write_calibration_samples_(self, value)
+      if iscell(value)
+        for n = 1:numel(value)
+          self.expected_calibration_samples{end+1} = value{n};
+        end
+        return;
+      end
+      shape = size(value);
+      lastDim = ndims(value);
+      count = shape(lastDim);
+      index = repelem({':'}, lastDim-1);
+      for n = 1:count
+        self.expected_calibration_samples{end+1} = value(index{:}, n);
+      end
+    end
+
+    function expect_write_data_(self, value)
+      if iscell(value)
+        for n = 1:numel(value)
+          self.expected_data{end+1} = value{n};
+        end
+        return;
+      end
+      shape = size(value);
+      lastDim = ndims(value);
+      count = shape(lastDim);
+      index = repelem({':'}, lastDim-1);
+      for n = 1:count
+        self.expected_data{end+1} = value(index{:}, n);
+      end
+    end
+
+    function expect_write_recalibration_gain_(self, value)
+      self.expected_recalibration_gain = yardl.Optional(value);
+    end
+
+    function expect_write_recalibration_samples_(self, value)
+      if iscell(value)
+        for n = 1:numel(value)
+          self.expected_recalibration_samples{end+1} = value{n};
+        end
+        return;
+      end
+      shape = size(value);
+      lastDim = ndims(value);
+      count = shape(lastDim);
+      index = repelem({':'}, lastDim-1);
+      for n = 1:count
+        self.expected_recalibration_samples{end+1} = value(index{:}, n);
+      end
+    end
+
+    function verify(self)
+      self.testCase_.verifyEqual(self.expected_header, yardl.None, "Expected call to write_header_ was not received");
+      self.testCase_.verifyEqual(self.expected_calibration_gain, yardl.None, "Expected call to write_calibration_gain_ was not received");
+      self.testCase_.verifyTrue(isempty(self.expected_calibration_samples), "Expected call to write_calibration_samples_ was not received");
+      self.testCase_.verifyTrue(isempty(self.expected_data), "Expected call to write_data_ was not received");
+      self.testCase_.verifyEqual(self.expected_recalibration_gain, yardl.None, "Expected call to write_recalibration_gain_ was not received");
+      self.testCase_.verifyTrue(isempty(self.expected_recalibration_samples), "Expected call to write_recalibration_samples_ was not received");
+    end
+  end
+
+  methods (Access=protected)
+    function write_header_(self, value)
+      self.testCase_.verifyTrue(self.expected_header.has_value(), "Unexpected call to write_header_");
+      self.testCase_.verifyEqual(value, self.expected_header.value, "Unexpected argument value for call to write_header_");
+      self.expected_header = yardl.None;
+    end
+
+    function write_calibration_gain_(self, value)
+      self.testCase_.verifyTrue(self.expected_calibration_gain.has_value(), "Unexpected call to write_calibration_gain_");
+      self.testCase_.verifyEqual(value, self.expected_calibration_gain.value, "Unexpected argument value for call to write_calibration_gain_");
+      self.expected_calibration_gain = yardl.None;
+    end
+
+    function write_calibration_samples_(self, value)
+      assert(iscell(value));
+      assert(isscalar(value));
+      self.testCase_.verifyFalse(isempty(self.expected_calibration_samples), "Unexpected call to write_calibration_samples_");
+      self.testCase_.verifyEqual(value{1}, self.expected_calibration_samples{1}, "Unexpected argument value for call to write_calibration_samples_");
+      self.expected_calibration_samples = self.expected_calibration_samples(2:end);
+    end
+
+    function write_data_(self, value)
+      assert(iscell(value));
+      assert(isscalar(value));
+      self.testCase_.verifyFalse(isempty(self.expected_data), "Unexpected call to write_data_");
+      self.testCase_.verifyEqual(value{1}, self.expected_data{1}, "Unexpected argument value for call to write_data_");
+      self.expected_data = self.expected_data(2:end);
+    end
+
+    function write_recalibration_gain_(self, value)
+      self.testCase_.verifyTrue(self.expected_recalibration_gain.has_value(), "Unexpected call to write_recalibration_gain_");
+      self.testCase_.verifyEqual(value, self.expected_recalibration_gain.value, "Unexpected argument value for call to write_recalibration_gain_");
+      self.expected_recalibration_gain = yardl.None;
+    end
+
+    function write_recalibration_samples_(self, value)
+      assert(iscell(value));
+      assert(isscalar(value));
+      self.testCase_.verifyFalse(isempty(self.expected_recalibration_samples), "Unexpected call to write_recalibration_samples_");
+      self.testCase_.verifyEqual(value{1}, self.expected_recalibration_samples{1}, "Unexpected argument value for call to write_recalibration_samples_");
+      self.expected_recalibration_samples = self.expected_recalibration_samples(2:end);
+    end
+
+    function close_(self)
+    end
+    function end_stream_(self)
+    end
+  end
+end
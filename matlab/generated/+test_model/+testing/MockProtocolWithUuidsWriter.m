@@ -0,0 +1,49 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef MockProtocolWithUuidsWriter < matlab.mixin.Copyable & test_model.ProtocolWithUuidsWriterBase
+  properties
+    testCase_
+    expected_single_uuid
+    expected_rec_with_uuids
+  end
+
+  methods
+    function self = MockProtocolWithUuidsWriter(testCase)
+      self.testCase_ = testCase;
+      self.expected_single_uuid = yardl.None;
+      self.expected_rec_with_uuids = yardl.None;
+    end
+
+    function expect_write_single_uuid_(self, value)
+      self.expected_single_uuid = yardl.Optional(value);
+    end
+
+    function expect_write_rec_with_uuids_(self, value)
+      self.expected_rec_with_uuids = yardl.Optional(value);
+    end
+
+    function verify(self)
+      self.testCase_.verifyEqual(self.expected_single_uuid, yardl.None, "Expected call to write_single_uuid_ was not received");
+      self.testCase_.verifyEqual(self.expected_rec_with_uuids, yardl.None, "Expected call to write_rec_with_uuids_ was not received");
+    end
+  end
+
+  methods (Access=protected)
+    function write_single_uuid_(self, value)
+      self.testCase_.verifyTrue(self.expected_single_uuid.has_value(), "Unexpected call to write_single_uuid_");
+      self.testCase_.verifyEqual(value, self.expected_single_uuid.value, "Unexpected argument value for call to write_single_uuid_");
+      self.expected_single_uuid = yardl.None;
+    end
+
+    function write_rec_with_uuids_(self, value)
+      self.testCase_.verifyTrue(self.expected_rec_with_uuids.has_value(), "Unexpected call to write_rec_with_uuids_");
+      self.testCase_.verifyEqual(value, self.expected_rec_with_uuids.value, "Unexpected argument value for call to write_rec_with_uuids_");
+      self.expected_rec_with_uuids = yardl.None;
+    end
+
+    function close_(self)
+    end
+    function end_stream_(self)
+    end
+  end
+end
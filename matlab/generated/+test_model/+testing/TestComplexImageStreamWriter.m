@@ -0,0 +1,76 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef TestComplexImageStreamWriter < test_model.ComplexImageStreamWriterBase
+  properties (Access = private)
+    writer_
+    create_reader_
+    mock_writer_
+    close_called_
+    filename_
+    format_
+  end
+
+  methods
+    function self = TestComplexImageStreamWriter(testCase, format, create_writer, create_reader)
+      self.filename_ = tempname();
+      self.format_ = format;
+      self.writer_ = create_writer(self.filename_);
+      self.create_reader_ = create_reader;
+      self.mock_writer_ = test_model.testing.MockComplexImageStreamWriter(testCase);
+      self.close_called_ = false;
+    end
+
+    function delete(self)
+      delete(self.filename_);
+      if ~self.close_called_
+        % ADD_FAILURE() << ...;
+        throw(yardl.RuntimeError("Close() must be called on 'TestComplexImageStreamWriter' to verify mocks"));
+      end
+    end
+    function end_images(self)
+      end_images@test_model.ComplexImageStreamWriterBase(self);
+      self.writer_.end_images();
+    end
+
+  end
+
+  methods (Access=protected)
+    function write_header_(self, value)
+      self.writer_.write_header(value);
+      self.mock_writer_.expect_write_header_(value);
+    end
+
+    function write_images_(self, value)
+      self.writer_.write_images(value);
+      self.mock_writer_.expect_write_images_(value);
+    end
+
+    function write_background_(self, value)
+      self.writer_.write_background(value);
+      self.mock_writer_.expect_write_background_(value);
+    end
+
+    function close_(self)
+      self.close_called_ = true;
+      self.writer_.close();
+      mock_copy = copy(self.mock_writer_);
+
+      reader = self.create_reader_(self.filename_);
+      reader.copy_to(self.mock_writer_);
+      reader.close();
+      self.mock_writer_.verify();
+      self.mock_writer_.close();
+
+      translated = invoke_translator(self.filename_, self.format_, self.format_);
+      reader = self.create_reader_(translated);
+      reader.copy_to(mock_copy);
+      reader.close();
+      mock_copy.verify();
+      mock_copy.close();
+      delete(translated);
+    end
+
+    function end_stream_(self)
+    end
+  end
+end
@@ -0,0 +1,66 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef TestProtocolWithBytesWriter < test_model.ProtocolWithBytesWriterBase
+  properties (Access = private)
+    writer_
+    create_reader_
+    mock_writer_
+    close_called_
+    filename_
+    format_
+  end
+
+  methods
+    function self = TestProtocolWithBytesWriter(testCase, format, create_writer, create_reader)
+      self.filename_ = tempname();
+      self.format_ = format;
+      self.writer_ = create_writer(self.filename_);
+      self.create_reader_ = create_reader;
+      self.mock_writer_ = test_model.testing.MockProtocolWithBytesWriter(testCase);
+      self.close_called_ = false;
+    end
+
+    function delete(self)
+      delete(self.filename_);
+      if ~self.close_called_
+        % ADD_FAILURE() << ...;
+        throw(yardl.RuntimeError("Close() must be called on 'TestProtocolWithBytesWriter' to verify mocks"));
+      end
+    end
+  end
+
+  methods (Access=protected)
+    function write_single_bytes_(self, value)
+      self.writer_.write_single_bytes(value);
+      self.mock_writer_.expect_write_single_bytes_(value);
+    end
+
+    function write_rec_with_bytes_(self, value)
+      self.writer_.write_rec_with_bytes(value);
+      self.mock_writer_.expect_write_rec_with_bytes_(value);
+    end
+
+    function close_(self)
+      self.close_called_ = true;
+      self.writer_.close();
+      mock_copy = copy(self.mock_writer_);
+
+      reader = self.create_reader_(self.filename_);
+      reader.copy_to(self.mock_writer_);
+      reader.close();
+      self.mock_writer_.verify();
+      self.mock_writer_.close();
+
+      translated = invoke_translator(self.filename_, self.format_, self.format_);
+      reader = self.create_reader_(translated);
+      reader.copy_to(mock_copy);
+      reader.close();
+      mock_copy.verify();
+      mock_copy.close();
+      delete(translated);
+    end
+
+    function end_stream_(self)
+    end
+  end
+end
@@ -0,0 +1,101 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef TestProtocolWithSubProtocolsWriter < test_model.ProtocolWithSubProtocolsWriterBase
+  properties (Access = private)
+    writer_
+    create_reader_
+    mock_writer_
+    close_called_
+    filename_
+    format_
+  end
+
+  methods
+    function self = TestProtocolWithSubProtocolsWriter(testCase, format, create_writer, create_reader)
+      self.filename_ = tempname();
+      self.format_ = format;
+      self.writer_ = create_writer(self.filename_);
+      self.create_reader_ = create_reader;
+      self.mock_writer_ = test_model.testing.MockProtocolWithSubProtocolsWriter(testCase);
+      self.close_called_ = false;
+    end
+
+    function delete(self)
+      delete(self.filename_);
+      if ~self.close_called_
+        % ADD_FAILURE() << ...;
+        throw(yardl.RuntimeError("Close() must be called on 'TestProtocolWithSubProtocolsWriter' to verify mocks"));
+      end
+    end
+    function end_calibration_samples(self)
+      end_calibration_samples@test_model.ProtocolWithSubProtocolsWriterBase(self);
+      self.writer_.end_calibration_samples();
+    end
+
+    function end_data(self)
+      end_data@test_model.ProtocolWithSubProtocolsWriterBase(self);
+      self.writer_.end_data();
+    end
+
+    function end_recalibration_samples(self)
+      end_recalibration_samples@test_model.ProtocolWithSubProtocolsWriterBase(self);
+      self.writer_.end_recalibration_samples();
+    end
+
+  end
+
+  methods (Access=protected)
+    function write_header_(self, value)
+      self.writer_.write_header(value);
+      self.mock_writer_.expect_write_header_(value);
+    end
+
+    function write_calibration_gain_(self, value)
+      self.writer_.write_calibration_gain(value);
+      self.mock_writer_.expect_write_calibration_gain_(value);
+    end
+
+    function write_calibration_samples_(self, value)
+      self.writer_.write_calibration_samples(value);
+      self.mock_writer_.expect_write_calibration_samples_(value);
+    end
+
+    function write_data_(self, value)
+      self.writer_.write_data(value);
+      self.mock_writer_.expect_write_data_(value);
+    end
+
+    function write_recalibration_gain_(self, value)
+      self.writer_.write_recalibration_gain(value);
+      self.mock_writer_.expect_write_recalibration_gain_(value);
+    end
+
+    function write_recalibration_samples_(self, value)
+      self.writer_.write_recalibration_samples(value);
+      self.mock_writer_.expect_write_recalibration_samples_(value);
+    end
+
+    function close_(self)
+      self.close_called_ = true;
+      self.writer_.close();
+      mock_copy = copy(self.mock_writer_);
+
+      reader = self.create_reader_(self.filename_);
+      reader.copy_to(self.mock_writer_);
+      reader.close();
+      self.mock_writer_.verify();
+      self.mock_writer_.close();
+
+      translated = invoke_translator(self.filename_, self.format_, self.format_);
+      reader = self.create_reader_(translated);
+      reader.copy_to(mock_copy);
+      reader.close();
+      mock_copy.verify();
+      mock_copy.close();
+      delete(translated);
+    end
+
+    function end_stream_(self)
+    end
+  end
+end
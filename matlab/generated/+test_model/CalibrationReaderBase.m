@@ -0,0 +1,98 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+% A calibration phase shared by protocols
+classdef CalibrationReaderBase < handle
+  properties (Access=protected)
+    state_
+    skip_completed_check_
+  end
+
+  methods
+    function self = CalibrationReaderBase(options)
+      arguments
+        options.skip_completed_check (1,1) logical = false
+      end
+      self.state_ = 0;
+      self.skip_completed_check_ = options.skip_completed_check;
+    end
+
+    function close(self)
+      self.close_();
+      if ~self.skip_completed_check_ && self.state_ ~= 2
+        expected_method = self.state_to_method_name_(self.state_);
+        throw(yardl.ProtocolError("Protocol reader closed before all data was consumed. Expected call to '%s'.", expected_method));
+      end
+    end
+
+    % Ordinal 0
+    function value = read_gain(self)
+      if self.state_ ~= 0
+        self.raise_unexpected_state_(0);
+      end
+
+      value = self.read_gain_();
+      self.state_ = 1;
+    end
+
+    % Ordinal 1
+    function more = has_samples(self)
+      if self.state_ ~= 1
+        self.raise_unexpected_state_(1);
+      end
+
+      more = self.has_samples_();
+      if ~more
+        self.state_ = 2;
+      end
+    end
+
+    function value = read_samples(self)
+      if self.state_ ~= 1
+        self.raise_unexpected_state_(1);
+      end
+
+      value = self.read_samples_();
+    end
+
+    function copy_to(self, writer)
+      writer.write_gain(self.read_gain());
+      while self.has_samples()
+        item = self.read_samples();
+        writer.write_samples({item});
+      end
+      writer.end_samples();
+    end
+  end
+
+  methods (Static)
+    function res = schema()
+      res = test_model.CalibrationWriterBase.schema;
+    end
+  end
+
+  methods (Abstract, Access=protected)
+    read_gain_(self)
+    has_samples_(self)
+    read_samples_(self)
+
+    close_(self)
+  end
+
+  methods (Access=private)
+    function raise_unexpected_state_(self, actual)
+      actual_method = self.state_to_method_name_(actual);
+      expected_method = self.state_to_method_name_(self.state_);
+      throw(yardl.ProtocolError("Expected call to '%s' but received call to '%s'.", expected_method, actual_method));
+    end
+
+    function name = state_to_method_name_(self, state)
+      if state == 0
+        name = "read_gain";
+      elseif state == 1
+        name = "read_samples";
+      else
+        name = "<unknown>";
+      end
+    end
+  end
+end
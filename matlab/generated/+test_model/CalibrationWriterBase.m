@@ -0,0 +1,83 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+% Abstract writer for protocol Calibration
+% A calibration phase shared by protocols
+classdef (Abstract) CalibrationWriterBase < handle
+  properties (Access=protected)
+    state_
+  end
+
+  methods
+    function self = CalibrationWriterBase()
+      self.state_ = 0;
+    end
+
+    function close(self)
+      self.close_();
+      if self.state_ ~= 2
+        expected_method = self.state_to_method_name_(self.state_);
+        throw(yardl.ProtocolError("Protocol writer closed before all steps were called. Expected call to '%s'.", expected_method));
+      end
+    end
+
+    % Ordinal 0
+    function write_gain(self, value)
+      if self.state_ ~= 0
+        self.raise_unexpected_state_(0);
+      end
+
+      self.write_gain_(value);
+      self.state_ = 1;
+    end
+
+    % Ordinal 1
+    function write_samples(self, value)
+      if self.state_ ~= 1
+        self.raise_unexpected_state_(1);
+      end
+
+      self.write_samples_(value);
+    end
+
+    function end_samples(self)
+      if self.state_ ~= 1
+        self.raise_unexpected_state_(1);
+      end
+
+      self.end_stream_();
+      self.state_ = 2;
+    end
+  end
+
+  methods (Static)
+    function res = schema()
+      res = string('{"protocol":{"name":"Calibration","sequence":[{"name":"gain","type":"float32"},{"name":"samples","type":{"stream":{"items":"float64"}}}]},"types":null}');
+    end
+  end
+
+  methods (Abstract, Access=protected)
+    write_gain_(self, value)
+    write_samples_(self, value)
+
+    end_stream_(self)
+    close_(self)
+  end
+
+  methods (Access=private)
+    function raise_unexpected_state_(self, actual)
+      expected_method = self.state_to_method_name_(self.state_);
+      actual_method = self.state_to_method_name_(actual);
+      throw(yardl.ProtocolError("Expected call to '%s' but received call to '%s'", expected_method, actual_method));
+    end
+
+    function name = state_to_method_name_(self, state)
+      if state == 0
+        name = "write_gain";
+      elseif state == 1
+        name = "write_samples or end_samples";
+      else
+        name = '<unknown>';
+      end
+    end
+  end
+end
@@ -0,0 +1,11 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef Constants
+  properties (Constant)
+    % The maximum number of channels
+    MAX_CHANNELS = int32(4)
+    SAMPLES_PER_CHANNEL = uint32(8)
+    DEFAULT_GAIN = single(1.5)
+    IS_STRICT = logical(false)
+  end
+end
@@ -0,0 +1,26 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef EnumWithAnnotations < uint64
+  % Annotations: deprecated=true
+  methods (Static)
+    function v = A
+      v = test_model.EnumWithAnnotations(0);
+    end
+    function v = B
+      v = test_model.EnumWithAnnotations(1);
+    end
+
+    function z = zeros(varargin)
+      elem = test_model.EnumWithAnnotations(0);
+      if nargin == 0
+        z = elem;
+        return;
+      end
+      sz = [varargin{:}];
+      if isscalar(sz)
+        sz = [sz, sz];
+      end
+      z = reshape(repelem(elem, prod(sz)), sz);
+    end
+  end
+end
@@ -0,0 +1,53 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef Expression < handle
+  properties
+    name
+    operand
+    arguments
+  end
+
+  methods
+    function self = Expression(kwargs)
+      arguments
+        kwargs.name = "";
+        kwargs.operand = test_model.Int32OrExpression.Int32(int32(0));
+        kwargs.arguments = yardl.Map;
+      end
+      self.name = kwargs.name;
+      self.operand = kwargs.operand;
+      self.arguments = kwargs.arguments;
+    end
+
+    function res = eq(self, other)
+      res = ...
+        isa(other, "test_model.Expression") && ...
+        isequal({self.name}, {other.name}) && ...
+        isequal({self.operand}, {other.operand}) && ...
+        isequal({self.arguments}, {other.arguments});
+    end
+
+    function res = ne(self, other)
+      res = ~self.eq(other);
+    end
+
+    function res = isequal(self, other)
+      res = all(eq(self, other));
+    end
+  end
+
+  methods (Static)
+    function z = zeros(varargin)
+      elem = test_model.Expression();
+      if nargin == 0
+        z = elem;
+        return;
+      end
+      sz = [varargin{:}];
+      if isscalar(sz)
+        sz = [sz, sz];
+      end
+      z = reshape(repelem(elem, prod(sz)), sz);
+    end
+  end
+end
@@ -0,0 +1,49 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef Float32OrString < yardl.Union
+  methods (Static)
+    function res = Float32(value)
+      res = test_model.Float32OrString(1, value);
+    end
+
+    function res = String(value)
+      res = test_model.Float32OrString(2, value);
+    end
+
+    function z = zeros(varargin)
+      elem = test_model.Float32OrString(0, yardl.None);
+      if nargin == 0
+        z = elem;
+        return;
+      end
+      sz = [varargin{:}];
+      if isscalar(sz)
+        sz = [sz, sz];
+      end
+      z = reshape(repelem(elem, prod(sz)), sz);
+    end
+  end
+
+  methods
+    function res = isFloat32(self)
+      res = self.index == 1;
+    end
+
+    function res = isString(self)
+      res = self.index == 2;
+    end
+
+    function eq = eq(self, other)
+      eq = isa(other, "test_model.Float32OrString") && all([self.index_] == [other.index_], 'all') && all([self.value] == [other.value], 'all');
+    end
+
+    function ne = ne(self, other)
+      ne = ~self.eq(other);
+    end
+
+    function t = tag(self)
+      tags_ = ["Float32", "String"];
+      t = tags_(self.index_);
+    end
+  end
+end
@@ -0,0 +1,112 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+% A protocol that is instantiated for each pixel type
+classdef FloatImageStreamReaderBase < handle
+  properties (Access=protected)
+    state_
+    skip_completed_check_
+  end
+
+  methods
+    function self = FloatImageStreamReaderBase(options)
+      arguments
+        options.skip_completed_check (1,1) logical = false
+      end
+      self.state_ = 0;
+      self.skip_completed_check_ = options.skip_completed_check;
+    end
+
+    function close(self)
+      self.close_();
+      if ~self.skip_completed_check_ && self.state_ ~= 3
+        expected_method = self.state_to_method_name_(self.state_);
+        throw(yardl.ProtocolError("Protocol reader closed before all data was consumed. Expected call to '%s'.", expected_method));
+      end
+    end
+
+    % Ordinal 0
+    function value = read_header(self)
+      if self.state_ ~= 0
+        self.raise_unexpected_state_(0);
+      end
+
+      value = self.read_header_();
+      self.state_ = 1;
+    end
+
+    % Ordinal 1
+    function more = has_images(self)
+      if self.state_ ~= 1
+        self.raise_unexpected_state_(1);
+      end
+
+      more = self.has_images_();
+      if ~more
+        self.state_ = 2;
+      end
+    end
+
+    function value = read_images(self)
+      if self.state_ ~= 1
+        self.raise_unexpected_state_(1);
+      end
+
+      value = self.read_images_();
+    end
+
+    % Ordinal 2
+    function value = read_background(self)
+      if self.state_ ~= 2
+        self.raise_unexpected_state_(2);
+      end
+
+      value = self.read_background_();
+      self.state_ = 3;
+    end
+
+    function copy_to(self, writer)
+      writer.write_header(self.read_header());
+      while self.has_images()
+        item = self.read_images();
+        writer.write_images({item});
+      end
+      writer.end_images();
+      writer.write_background(self.read_background());
+    end
+  end
+
+  methods (Static)
+    function res = schema()
+      res = test_model.FloatImageStreamWriterBase.schema;
+    end
+  end
+
+  methods (Abstract, Access=protected)
+    read_header_(self)
+    has_images_(self)
+    read_images_(self)
+    read_background_(self)
+
+    close_(self)
+  end
+
+  methods (Access=private)
+    function raise_unexpected_state_(self, actual)
+      actual_method = self.state_to_method_name_(actual);
+      expected_method = self.state_to_method_name_(self.state_);
+      throw(yardl.ProtocolError("Expected call to '%s' but received call to '%s'.", expected_method, actual_method));
+    end
+
+    function name = state_to_method_name_(self, state)
+      if state == 0
+        name = "read_header";
+      elseif state == 1
+        name = "read_images";
+      elseif state == 2
+        name = "read_background";
+      else
+        name = "<unknown>";
+      end
+    end
+  end
+end
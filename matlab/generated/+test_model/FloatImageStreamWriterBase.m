@@ -0,0 +1,96 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+% Abstract writer for protocol FloatImageStream
+% A protocol that is instantiated for each pixel type
+classdef (Abstract) FloatImageStreamWriterBase < handle
+  properties (Access=protected)
+    state_
+  end
+
+  methods
+    function self = FloatImageStreamWriterBase()
+      self.state_ = 0;
+    end
+
+    function close(self)
+      self.close_();
+      if self.state_ ~= 3
+        expected_method = self.state_to_method_name_(self.state_);
+        throw(yardl.ProtocolError("Protocol writer closed before all steps were called. Expected call to '%s'.", expected_method));
+      end
+    end
+
+    % Ordinal 0
+    function write_header(self, value)
+      if self.state_ ~= 0
+        self.raise_unexpected_state_(0);
+      end
+
+      self.write_header_(value);
+      self.state_ = 1;
+    end
+
+    % Ordinal 1
+    function write_images(self, value)
+      if self.state_ ~= 1
+        self.raise_unexpected_state_(1);
+      end
+
+      self.write_images_(value);
+    end
+
+    function end_images(self)
+      if self.state_ ~= 1
+        self.raise_unexpected_state_(1);
+      end
+
+      self.end_stream_();
+      self.state_ = 2;
+    end
+
+    % Ordinal 2
+    function write_background(self, value)
+      if self.state_ ~= 2
+        self.raise_unexpected_state_(2);
+      end
+
+      self.write_background_(value);
+      self.state_ = 3;
+    end
+  end
+
+  methods (Static)
+    function res = schema()
+      res = string('{"protocol":{"name":"FloatImageStream","sequence":[{"name":"header","type":{"name":"TestModel.GenericRecord","typeArguments":["int32","float32"]}},{"name":"images","type":{"stream":{"items":{"name":"TestModel.Image","typeArguments":["float32"]}}}},{"name":"background","type":[{"tag":"float32","type":"float32"},{"tag":"string","type":"string"}]}]},"types":[{"name":"Image","typeParameters":["T"],"type":{"array":{"items":"T","dimensions":[{"name":"x"},{"name":"y"}]}}},{"name":"GenericRecord","typeParameters":["T1","T2"],"fields":[{"name":"scalar1","type":"T1"},{"name":"scalar2","type":"T2"},{"name":"vector1","type":{"vector":{"items":"T1"}}},{"name":"image2","type":{"name":"TestModel.Image","typeArguments":["T2"]}}]},{"name":"Image","typeParameters":["T"],"type":{"name":"Image.Image","typeArguments":["T"]}}]}');
+    end
+  end
+
+  methods (Abstract, Access=protected)
+    write_header_(self, value)
+    write_images_(self, value)
+    write_background_(self, value)
+
+    end_stream_(self)
+    close_(self)
+  end
+
+  methods (Access=private)
+    function raise_unexpected_state_(self, actual)
+      expected_method = self.state_to_method_name_(self.state_);
+      actual_method = self.state_to_method_name_(actual);
+      throw(yardl.ProtocolError("Expected call to '%s' but received call to '%s'", expected_method, actual_method));
+    end
+
+    function name = state_to_method_name_(self, state)
+      if state == 0
+        name = "write_header";
+      elseif state == 1
+        name = "write_images or end_images";
+      elseif state == 2
+        name = "write_background";
+      else
+        name = '<unknown>';
+      end
+    end
+  end
+end
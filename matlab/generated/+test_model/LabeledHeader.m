@@ -0,0 +1,48 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef LabeledHeader < handle
+  properties
+    label
+  end
+
+  methods
+    function self = LabeledHeader(kwargs)
+      arguments
+        kwargs.label;
+      end
+      if ~isfield(kwargs, "label")
+        throw(yardl.TypeError("Missing required keyword argument 'label'"))
+      end
+      self.label = kwargs.label;
+    end
+
+    function res = eq(self, other)
+      res = ...
+        isa(other, "test_model.LabeledHeader") && ...
+        isequal({self.label}, {other.label});
+    end
+
+    function res = ne(self, other)
+      res = ~self.eq(other);
+    end
+
+    function res = isequal(self, other)
+      res = all(eq(self, other));
+    end
+  end
+
+  methods (Static)
+    function z = zeros(varargin)
+      elem = test_model.LabeledHeader(label=yardl.None);
+      if nargin == 0
+        z = elem;
+        return;
+      end
+      sz = [varargin{:}];
+      if isscalar(sz)
+        sz = [sz, sz];
+      end
+      z = reshape(repelem(elem, prod(sz)), sz);
+    end
+  end
+end
@@ -0,0 +1,49 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef LinkedListNode < handle
+  properties
+    value
+    next
+  end
+
+  methods
+    function self = LinkedListNode(kwargs)
+      arguments
+        kwargs.value = int32(0);
+        kwargs.next = yardl.None;
+      end
+      self.value = kwargs.value;
+      self.next = kwargs.next;
+    end
+
+    function res = eq(self, other)
+      res = ...
+        isa(other, "test_model.LinkedListNode") && ...
+        isequal({self.value}, {other.value}) && ...
+        isequal({self.next}, {other.next});
+    end
+
+    function res = ne(self, other)
+      res = ~self.eq(other);
+    end
+
+    function res = isequal(self, other)
+      res = all(eq(self, other));
+    end
+  end
+
+  methods (Static)
+    function z = zeros(varargin)
+      elem = test_model.LinkedListNode();
+      if nargin == 0
+        z = elem;
+        return;
+      end
+      sz = [varargin{:}];
+      if isscalar(sz)
+        sz = [sz, sz];
+      end
+      z = reshape(repelem(elem, prod(sz)), sz);
+    end
+  end
+end
@@ -0,0 +1,82 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+% Abstract writer for protocol ProtocolWithConstraints
+classdef (Abstract) ProtocolWithConstraintsWriterBase < handle
+  properties (Access=protected)
+    state_
+  end
+
+  methods
+    function self = ProtocolWithConstraintsWriterBase()
+      self.state_ = 0;
+    end
+
+    function close(self)
+      self.close_();
+      if self.state_ ~= 2
+        expected_method = self.state_to_method_name_(self.state_);
+        throw(yardl.ProtocolError("Protocol writer closed before all steps were called. Expected call to '%s'.", expected_method));
+      end
+    end
+
+    % Ordinal 0
+    function write_record(self, value)
+      if self.state_ ~= 0
+        self.raise_unexpected_state_(0);
+      end
+
+      self.write_record_(value);
+      self.state_ = 1;
+    end
+
+    % Ordinal 1
+    function write_records(self, value)
+      if self.state_ ~= 1
+        self.raise_unexpected_state_(1);
+      end
+
+      self.write_records_(value);
+    end
+
+    function end_records(self)
+      if self.state_ ~= 1
+        self.raise_unexpected_state_(1);
+      end
+
+      self.end_stream_();
+      self.state_ = 2;
+    end
+  end
+
+  methods (Static)
+    function res = schema()
+      res = string('{"protocol":{"name":"ProtocolWithConstraints","sequence":[{"name":"record","type":"TestModel.RecordWithConstraints"},{"name":"records","type":{"stream":{"items":"TestModel.RecordWithConstrainedRecords"}}}]},"types":[{"name":"RecordWithConstrainedRecords","fields":[{"name":"single","type":"TestModel.RecordWithConstraints"},{"name":"optional","type":[null,"TestModel.RecordWithConstraints"]},{"name":"vector","type":{"vector":{"items":"TestModel.RecordWithConstraints"}}}]},{"name":"RecordWithConstraints","fields":[{"name":"size","type":"uint32"},{"name":"gain","type":"float32"},{"name":"name","type":"string"},{"name":"limit","type":[null,"int32"]},{"name":"samples","type":{"vector":{"items":"int32"}}}]}]}');
+    end
+  end
+
+  methods (Abstract, Access=protected)
+    write_record_(self, value)
+    write_records_(self, value)
+
+    end_stream_(self)
+    close_(self)
+  end
+
+  methods (Access=private)
+    function raise_unexpected_state_(self, actual)
+      expected_method = self.state_to_method_name_(self.state_);
+      actual_method = self.state_to_method_name_(actual);
+      throw(yardl.ProtocolError("Expected call to '%s' but received call to '%s'", expected_method, actual_method));
+    end
+
+    function name = state_to_method_name_(self, state)
+      if state == 0
+        name = "write_record";
+      elseif state == 1
+        name = "write_records or end_records";
+      else
+        name = '<unknown>';
+      end
+    end
+  end
+end
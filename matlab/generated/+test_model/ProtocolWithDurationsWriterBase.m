@@ -0,0 +1,74 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+% Abstract writer for protocol ProtocolWithDurations
+classdef (Abstract) ProtocolWithDurationsWriterBase < handle
+  properties (Access=protected)
+    state_
+  end
+
+  methods
+    function self = ProtocolWithDurationsWriterBase()
+      self.state_ = 0;
+    end
+
+    function close(self)
+      self.close_();
+      if self.state_ ~= 2
+        expected_method = self.state_to_method_name_(self.state_);
+        throw(yardl.ProtocolError("Protocol writer closed before all steps were called. Expected call to '%s'.", expected_method));
+      end
+    end
+
+    % Ordinal 0
+    function write_single_duration(self, value)
+      if self.state_ ~= 0
+        self.raise_unexpected_state_(0);
+      end
+
+      self.write_single_duration_(value);
+      self.state_ = 1;
+    end
+
+    % Ordinal 1
+    function write_rec_with_durations(self, value)
+      if self.state_ ~= 1
+        self.raise_unexpected_state_(1);
+      end
+
+      self.write_rec_with_durations_(value);
+      self.state_ = 2;
+    end
+  end
+
+  methods (Static)
+    function res = schema()
+      res = string('{"protocol":{"name":"ProtocolWithDurations","sequence":[{"name":"singleDuration","type":"duration"},{"name":"recWithDurations","type":"TestModel.RecordWithDurations"}]},"types":[{"name":"RecordWithDurations","fields":[{"name":"acquisitionStart","type":"datetime"},{"name":"acquisitionEnd","type":"datetime"},{"name":"repetitionTime","type":"duration"},{"name":"timeout","type":[null,"duration"]},{"name":"intervals","type":{"vector":{"items":"duration"}}}]}]}');
+    end
+  end
+
+  methods (Abstract, Access=protected)
+    write_single_duration_(self, value)
+    write_rec_with_durations_(self, value)
+
+    end_stream_(self)
+    close_(self)
+  end
+
+  methods (Access=private)
+    function raise_unexpected_state_(self, actual)
+      expected_method = self.state_to_method_name_(self.state_);
+      actual_method = self.state_to_method_name_(actual);
+      throw(yardl.ProtocolError("Expected call to '%s' but received call to '%s'", expected_method, actual_method));
+    end
+
+    function name = state_to_method_name_(self, state)
+      if state == 0
+        name = "write_single_duration";
+      elseif state == 1
+        name = "write_rec_with_durations";
+      else
+        name = '<unknown>';
+      end
+    end
+  end
+end
@@ -0,0 +1,82 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef ProtocolWithHalfPrecisionReaderBase < handle
+  properties (Access=protected)
+    state_
+    skip_completed_check_
+  end
+
+  methods
+    function self = ProtocolWithHalfPrecisionReaderBase(options)
+      arguments
+        options.skip_completed_check (1,1) logical = false
+      end
+      self.state_ = 0;
+      self.skip_completed_check_ = options.skip_completed_check;
+    end
+
+    function close(self)
+      self.close_();
+      if ~self.skip_completed_check_ && self.state_ ~= 2
+        expected_method = self.state_to_method_name_(self.state_);
+        throw(yardl.ProtocolError("Protocol reader closed before all data was consumed. Expected call to '%s'.", expected_method));
+      end
+    end
+
+    % Ordinal 0
+    function value = read_halves(self)
+      if self.state_ ~= 0
+        self.raise_unexpected_state_(0);
+      end
+
+      value = self.read_halves_();
+      self.state_ = 1;
+    end
+
+    % Ordinal 1
+    function value = read_rec_with_halves(self)
+      if self.state_ ~= 1
+        self.raise_unexpected_state_(1);
+      end
+
+      value = self.read_rec_with_halves_();
+      self.state_ = 2;
+    end
+
+    function copy_to(self, writer)
+      writer.write_halves(self.read_halves());
+      writer.write_rec_with_halves(self.read_rec_with_halves());
+    end
+  end
+
+  methods (Static)
+    function res = schema()
+      res = test_model.ProtocolWithHalfPrecisionWriterBase.schema;
+    end
+  end
+
+  methods (Abstract, Access=protected)
+    read_halves_(self)
+    read_rec_with_halves_(self)
+
+    close_(self)
+  end
+
+  methods (Access=private)
+    function raise_unexpected_state_(self, actual)
+      actual_method = self.state_to_method_name_(actual);
+      expected_method = self.state_to_method_name_(self.state_);
+      throw(yardl.ProtocolError("Expected call to '%s' but received call to '%s'.", expected_method, actual_method));
+    end
+
+    function name = state_to_method_name_(self, state)
+      if state == 0
+        name = "read_halves";
+      elseif state == 1
+        name = "read_rec_with_halves";
+      else
+        name = "<unknown>";
+      end
+    end
+  end
+end
@@ -0,0 +1,74 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+% Abstract writer for protocol ProtocolWithHalfPrecision
+classdef (Abstract) ProtocolWithHalfPrecisionWriterBase < handle
+  properties (Access=protected)
+    state_
+  end
+
+  methods
+    function self = ProtocolWithHalfPrecisionWriterBase()
+      self.state_ = 0;
+    end
+
+    function close(self)
+      self.close_();
+      if self.state_ ~= 2
+        expected_method = self.state_to_method_name_(self.state_);
+        throw(yardl.ProtocolError("Protocol writer closed before all steps were called. Expected call to '%s'.", expected_method));
+      end
+    end
+
+    % Ordinal 0
+    function write_halves(self, value)
+      if self.state_ ~= 0
+        self.raise_unexpected_state_(0);
+      end
+
+      self.write_halves_(value);
+      self.state_ = 1;
+    end
+
+    % Ordinal 1
+    function write_rec_with_halves(self, value)
+      if self.state_ ~= 1
+        self.raise_unexpected_state_(1);
+      end
+
+      self.write_rec_with_halves_(value);
+      self.state_ = 2;
+    end
+  end
+
+  methods (Static)
+    function res = schema()
+      res = string('{"protocol":{"name":"ProtocolWithHalfPrecision","sequence":[{"name":"halves","type":{"vector":{"items":"float16"}}},{"name":"recWithHalves","type":"TestModel.RecordWithHalfPrecision"}]},"types":[{"name":"RecordWithHalfPrecision","fields":[{"name":"half","type":"float16"},{"name":"brain","type":"bfloat16"},{"name":"halfVector","type":{"vector":{"items":"float16"}}},{"name":"brainArray","type":{"array":{"items":"bfloat16"}}}]}]}');
+    end
+  end
+
+  methods (Abstract, Access=protected)
+    write_halves_(self, value)
+    write_rec_with_halves_(self, value)
+
+    end_stream_(self)
+    close_(self)
+  end
+
+  methods (Access=private)
+    function raise_unexpected_state_(self, actual)
+      expected_method = self.state_to_method_name_(self.state_);
+      actual_method = self.state_to_method_name_(actual);
+      throw(yardl.ProtocolError("Expected call to '%s' but received call to '%s'", expected_method, actual_method));
+    end
+
+    function name = state_to_method_name_(self, state)
+      if state == 0
+        name = "write_halves";
+      elseif state == 1
+        name = "write_rec_with_halves";
+      else
+        name = '<unknown>';
+      end
+    end
+  end
+end
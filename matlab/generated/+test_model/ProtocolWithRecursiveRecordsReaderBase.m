@@ -0,0 +1,111 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef ProtocolWithRecursiveRecordsReaderBase < handle
+  properties (Access=protected)
+    state_
+    skip_completed_check_
+  end
+
+  methods
+    function self = ProtocolWithRecursiveRecordsReaderBase(options)
+      arguments
+        options.skip_completed_check (1,1) logical = false
+      end
+      self.state_ = 0;
+      self.skip_completed_check_ = options.skip_completed_check;
+    end
+
+    function close(self)
+      self.close_();
+      if ~self.skip_completed_check_ && self.state_ ~= 3
+        expected_method = self.state_to_method_name_(self.state_);
+        throw(yardl.ProtocolError("Protocol reader closed before all data was consumed. Expected call to '%s'.", expected_method));
+      end
+    end
+
+    % Ordinal 0
+    function value = read_tree(self)
+      if self.state_ ~= 0
+        self.raise_unexpected_state_(0);
+      end
+
+      value = self.read_tree_();
+      self.state_ = 1;
+    end
+
+    % Ordinal 1
+    function value = read_list(self)
+      if self.state_ ~= 1
+        self.raise_unexpected_state_(1);
+      end
+
+      value = self.read_list_();
+      self.state_ = 2;
+    end
+
+    % Ordinal 2
+    function more = has_expressions(self)
+      if self.state_ ~= 2
+        self.raise_unexpected_state_(2);
+      end
+
+      more = self.has_expressions_();
+      if ~more
+        self.state_ = 3;
+      end
+    end
+
+    function value = read_expressions(self)
+      if self.state_ ~= 2
+        self.raise_unexpected_state_(2);
+      end
+
+      value = self.read_expressions_();
+    end
+
+    function copy_to(self, writer)
+      writer.write_tree(self.read_tree());
+      writer.write_list(self.read_list());
+      while self.has_expressions()
+        item = self.read_expressions();
+        writer.write_expressions({item});
+      end
+      writer.end_expressions();
+    end
+  end
+
+  methods (Static)
+    function res = schema()
+      res = test_model.ProtocolWithRecursiveRecordsWriterBase.schema;
+    end
+  end
+
+  methods (Abstract, Access=protected)
+    read_tree_(self)
+    read_list_(self)
+    has_expressions_(self)
+    read_expressions_(self)
+
+    close_(self)
+  end
+
+  methods (Access=private)
+    function raise_unexpected_state_(self, actual)
+      actual_method = self.state_to_method_name_(actual);
+      expected_method = self.state_to_method_name_(self.state_);
+      throw(yardl.ProtocolError("Expected call to '%s' but received call to '%s'.", expected_method, actual_method));
+    end
+
+    function name = state_to_method_name_(self, state)
+      if state == 0
+        name = "read_tree";
+      elseif state == 1
+        name = "read_list";
+      elseif state == 2
+        name = "read_expressions";
+      else
+        name = "<unknown>";
+      end
+    end
+  end
+end
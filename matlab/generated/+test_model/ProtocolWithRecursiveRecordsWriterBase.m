@@ -0,0 +1,95 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+% Abstract writer for protocol ProtocolWithRecursiveRecords
+classdef (Abstract) ProtocolWithRecursiveRecordsWriterBase < handle
+  properties (Access=protected)
+    state_
+  end
+
+  methods
+    function self = ProtocolWithRecursiveRecordsWriterBase()
+      self.state_ = 0;
+    end
+
+    function close(self)
+      self.close_();
+      if self.state_ ~= 3
+        expected_method = self.state_to_method_name_(self.state_);
+        throw(yardl.ProtocolError("Protocol writer closed before all steps were called. Expected call to '%s'.", expected_method));
+      end
+    end
+
+    % Ordinal 0
+    function write_tree(self, value)
+      if self.state_ ~= 0
+        self.raise_unexpected_state_(0);
+      end
+
+      self.write_tree_(value);
+      self.state_ = 1;
+    end
+
+    % Ordinal 1
+    function write_list(self, value)
+      if self.state_ ~= 1
+        self.raise_unexpected_state_(1);
+      end
+
+      self.write_list_(value);
+      self.state_ = 2;
+    end
+
+    % Ordinal 2
+    function write_expressions(self, value)
+      if self.state_ ~= 2
+        self.raise_unexpected_state_(2);
+      end
+
+      self.write_expressions_(value);
+    end
+
+    function end_expressions(self)
+      if self.state_ ~= 2
+        self.raise_unexpected_state_(2);
+      end
+
+      self.end_stream_();
+      self.state_ = 3;
+    end
+  end
+
+  methods (Static)
+    function res = schema()
+      res = string('{"protocol":{"name":"ProtocolWithRecursiveRecords","sequence":[{"name":"tree","type":"TestModel.TreeNode"},{"name":"list","type":"TestModel.LinkedListNode"},{"name":"expressions","type":{"stream":{"items":"TestModel.Expression"}}}]},"types":[{"name":"Expression","fields":[{"name":"name","type":"string"},{"name":"operand","type":[{"tag":"int32","type":"int32"},{"tag":"Expression","type":"TestModel.Expression"}]},{"name":"arguments","type":{"map":{"keys":"string","values":"TestModel.Expression"}}}]},{"name":"LinkedListNode","fields":[{"name":"value","type":"int32"},{"name":"next","type":[null,"TestModel.LinkedListNode"]}]},{"name":"TreeNode","fields":[{"name":"label","type":"string"},{"name":"children","type":{"vector":{"items":"TestModel.TreeNode"}}}]}]}');
+    end
+  end
+
+  methods (Abstract, Access=protected)
+    write_tree_(self, value)
+    write_list_(self, value)
+    write_expressions_(self, value)
+
+    end_stream_(self)
+    close_(self)
+  end
+
+  methods (Access=private)
+    function raise_unexpected_state_(self, actual)
+      expected_method = self.state_to_method_name_(self.state_);
+      actual_method = self.state_to_method_name_(actual);
+      throw(yardl.ProtocolError("Expected call to '%s' but received call to '%s'", expected_method, actual_method));
+    end
+
+    function name = state_to_method_name_(self, state)
+      if state == 0
+        name = "write_tree";
+      elseif state == 1
+        name = "write_list";
+      elseif state == 2
+        name = "write_expressions or end_expressions";
+      else
+        name = '<unknown>';
+      end
+    end
+  end
+end
@@ -0,0 +1,31 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+% Reads the `calibration` steps of protocol ProtocolWithSubProtocols
+classdef ProtocolWithSubProtocolsCalibrationSubReader < test_model.CalibrationReaderBase
+  properties (Access=private)
+    outer_
+  end
+
+  methods
+    function self = ProtocolWithSubProtocolsCalibrationSubReader(outer)
+      self.outer_ = outer;
+    end
+  end
+
+  methods (Access=protected)
+    function value = read_gain_(self)
+      value = self.outer_.read_calibration_gain();
+    end
+
+    function more = has_samples_(self)
+      more = self.outer_.has_calibration_samples();
+    end
+
+    function value = read_samples_(self)
+      value = self.outer_.read_calibration_samples();
+    end
+
+    function close_(self)
+    end
+  end
+end
@@ -0,0 +1,33 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+% Writes the `calibration` steps of protocol ProtocolWithSubProtocols
+classdef ProtocolWithSubProtocolsCalibrationSubWriter < test_model.CalibrationWriterBase
+  properties (Access=private)
+    outer_
+  end
+
+  methods
+    function self = ProtocolWithSubProtocolsCalibrationSubWriter(outer)
+      self.outer_ = outer;
+    end
+  end
+
+  methods (Access=protected)
+    function write_gain_(self, value)
+      self.outer_.write_calibration_gain(value);
+    end
+
+    function write_samples_(self, value)
+      self.outer_.write_calibration_samples(value);
+    end
+
+    function end_stream_(self)
+      if self.state_ == 1
+        self.outer_.end_calibration_samples();
+      end
+    end
+
+    function close_(self)
+    end
+  end
+end
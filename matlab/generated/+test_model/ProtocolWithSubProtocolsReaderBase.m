@@ -0,0 +1,198 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef ProtocolWithSubProtocolsReaderBase < handle
+  properties (Access=protected)
+    state_
+    skip_completed_check_
+    calibration_reader_
+    recalibration_reader_
+  end
+
+  methods
+    function self = ProtocolWithSubProtocolsReaderBase(options)
+      arguments
+        options.skip_completed_check (1,1) logical = false
+      end
+      self.state_ = 0;
+      self.skip_completed_check_ = options.skip_completed_check;
+      self.calibration_reader_ = test_model.ProtocolWithSubProtocolsCalibrationSubReader(self);
+      self.recalibration_reader_ = test_model.ProtocolWithSubProtocolsRecalibrationSubReader(self);
+    end
+
+    % Reader for the `calibration` steps, which are read through this reader
+    % Calibration before the data
+    function reader = calibration(self)
+      reader = self.calibration_reader_;
+    end
+
+    % Reader for the `recalibration` steps, which are read through this reader
+    function reader = recalibration(self)
+      reader = self.recalibration_reader_;
+    end
+
+    function close(self)
+      self.close_();
+      if ~self.skip_completed_check_ && self.state_ ~= 6
+        expected_method = self.state_to_method_name_(self.state_);
+        throw(yardl.ProtocolError("Protocol reader closed before all data was consumed. Expected call to '%s'.", expected_method));
+      end
+    end
+
+    % Ordinal 0
+    function value = read_header(self)
+      if self.state_ ~= 0
+        self.raise_unexpected_state_(0);
+      end
+
+      value = self.read_header_();
+      self.state_ = 1;
+    end
+
+    % Ordinal 1
+    function value = read_calibration_gain(self)
+      if self.state_ ~= 1
+        self.raise_unexpected_state_(1);
+      end
+
+      value = self.read_calibration_gain_();
+      self.state_ = 2;
+    end
+
+    % Ordinal 2
+    function more = has_calibration_samples(self)
+      if self.state_ ~= 2
+        self.raise_unexpected_state_(2);
+      end
+
+      more = self.has_calibration_samples_();
+      if ~more
+        self.state_ = 3;
+      end
+    end
+
+    function value = read_calibration_samples(self)
+      if self.state_ ~= 2
+        self.raise_unexpected_state_(2);
+      end
+
+      value = self.read_calibration_samples_();
+    end
+
+    % Ordinal 3
+    function more = has_data(self)
+      if self.state_ ~= 3
+        self.raise_unexpected_state_(3);
+      end
+
+      more = self.has_data_();
+      if ~more
+        self.state_ = 4;
+      end
+    end
+
+    function value = read_data(self)
+      if self.state_ ~= 3
+        self.raise_unexpected_state_(3);
+      end
+
+      value = self.read_data_();
+    end
+
+    % Ordinal 4
+    function value = read_recalibration_gain(self)
+      if self.state_ ~= 4
+        self.raise_unexpected_state_(4);
+      end
+
+      value = self.read_recalibration_gain_();
+      self.state_ = 5;
+    end
+
+    % Ordinal 5
+    function more = has_recalibration_samples(self)
+      if self.state_ ~= 5
+        self.raise_unexpected_state_(5);
+      end
+
+      more = self.has_recalibration_samples_();
+      if ~more
+        self.state_ = 6;
+      end
+    end
+
+    function value = read_recalibration_samples(self)
+      if self.state_ ~= 5
+        self.raise_unexpected_state_(5);
+      end
+
+      value = self.read_recalibration_samples_();
+    end
+
+    function copy_to(self, writer)
+      writer.write_header(self.read_header());
+      writer.write_calibration_gain(self.read_calibration_gain());
+      while self.has_calibration_samples()
+        item = self.read_calibration_samples();
+        writer.write_calibration_samples({item});
+      end
+      writer.end_calibration_samples();
+      while self.has_data()
+        item = self.read_data();
+        writer.write_data({item});
+      end
+      writer.end_data();
+      writer.write_recalibration_gain(self.read_recalibration_gain());
+      while self.has_recalibration_samples()
+        item = self.read_recalibration_samples();
+        writer.write_recalibration_samples({item});
+      end
+      writer.end_recalibration_samples();
+    end
+  end
+
+  methods (Static)
+    function res = schema()
+      res = test_model.ProtocolWithSubProtocolsWriterBase.schema;
+    end
+  end
+
+  methods (Abstract, Access=protected)
+    read_header_(self)
+    read_calibration_gain_(self)
+    has_calibration_samples_(self)
+    read_calibration_samples_(self)
+    has_data_(self)
+    read_data_(self)
+    read_recalibration_gain_(self)
+    has_recalibration_samples_(self)
+    read_recalibration_samples_(self)
+
+    close_(self)
+  end
+
+  methods (Access=private)
+    function raise_unexpected_state_(self, actual)
+      actual_method = self.state_to_method_name_(actual);
+      expected_method = self.state_to_method_name_(self.state_);
+      throw(yardl.ProtocolError("Expected call to '%s' but received call to '%s'.", expected_method, actual_method));
+    end
+
+    function name = state_to_method_name_(self, state)
+      if state == 0
+        name = "read_header";
+      elseif state == 1
+        name = "read_calibration_gain";
+      elseif state == 2
+        name = "read_calibration_samples";
+      elseif state == 3
+        name = "read_data";
+      elseif state == 4
+        name = "read_recalibration_gain";
+      elseif state == 5
+        name = "read_recalibration_samples";
+      else
+        name = "<unknown>";
+      end
+    end
+  end
+end
@@ -0,0 +1,31 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+% Reads the `recalibration` steps of protocol ProtocolWithSubProtocols
+classdef ProtocolWithSubProtocolsRecalibrationSubReader < test_model.CalibrationReaderBase
+  properties (Access=private)
+    outer_
+  end
+
+  methods
+    function self = ProtocolWithSubProtocolsRecalibrationSubReader(outer)
+      self.outer_ = outer;
+    end
+  end
+
+  methods (Access=protected)
+    function value = read_gain_(self)
+      value = self.outer_.read_recalibration_gain();
+    end
+
+    function more = has_samples_(self)
+      more = self.outer_.has_recalibration_samples();
+    end
+
+    function value = read_samples_(self)
+      value = self.outer_.read_recalibration_samples();
+    end
+
+    function close_(self)
+    end
+  end
+end
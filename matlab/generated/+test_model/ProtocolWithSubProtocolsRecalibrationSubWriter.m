@@ -0,0 +1,33 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+% Writes the `recalibration` steps of protocol ProtocolWithSubProtocols
+classdef ProtocolWithSubProtocolsRecalibrationSubWriter < test_model.CalibrationWriterBase
+  properties (Access=private)
+    outer_
+  end
+
+  methods
+    function self = ProtocolWithSubProtocolsRecalibrationSubWriter(outer)
+      self.outer_ = outer;
+    end
+  end
+
+  methods (Access=protected)
+    function write_gain_(self, value)
+      self.outer_.write_recalibration_gain(value);
+    end
+
+    function write_samples_(self, value)
+      self.outer_.write_recalibration_samples(value);
+    end
+
+    function end_stream_(self)
+      if self.state_ == 1
+        self.outer_.end_recalibration_samples();
+      end
+    end
+
+    function close_(self)
+    end
+  end
+end
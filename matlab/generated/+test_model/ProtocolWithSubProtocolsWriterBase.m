@@ -0,0 +1,165 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+% Abstract writer for protocol ProtocolWithSubProtocols
+classdef (Abstract) ProtocolWithSubProtocolsWriterBase < handle
+  properties (Access=protected)
+    state_
+    calibration_writer_
+    recalibration_writer_
+  end
+
+  methods
+    function self = ProtocolWithSubProtocolsWriterBase()
+      self.state_ = 0;
+      self.calibration_writer_ = test_model.ProtocolWithSubProtocolsCalibrationSubWriter(self);
+      self.recalibration_writer_ = test_model.ProtocolWithSubProtocolsRecalibrationSubWriter(self);
+    end
+
+    % Writer for the `calibration` steps, which are written through this writer
+    % Calibration before the data
+    function writer = calibration(self)
+      writer = self.calibration_writer_;
+    end
+
+    % Writer for the `recalibration` steps, which are written through this writer
+    function writer = recalibration(self)
+      writer = self.recalibration_writer_;
+    end
+
+    function close(self)
+      self.close_();
+      if self.state_ ~= 6
+        expected_method = self.state_to_method_name_(self.state_);
+        throw(yardl.ProtocolError("Protocol writer closed before all steps were called. Expected call to '%s'.", expected_method));
+      end
+    end
+
+    % Ordinal 0
+    function write_header(self, value)
+      if self.state_ ~= 0
+        self.raise_unexpected_state_(0);
+      end
+
+      self.write_header_(value);
+      self.state_ = 1;
+    end
+
+    % Ordinal 1
+    function write_calibration_gain(self, value)
+      if self.state_ ~= 1
+        self.raise_unexpected_state_(1);
+      end
+
+      self.write_calibration_gain_(value);
+      self.state_ = 2;
+    end
+
+    % Ordinal 2
+    function write_calibration_samples(self, value)
+      if self.state_ ~= 2
+        self.raise_unexpected_state_(2);
+      end
+
+      self.write_calibration_samples_(value);
+    end
+
+    function end_calibration_samples(self)
+      if self.state_ ~= 2
+        self.raise_unexpected_state_(2);
+      end
+
+      self.end_stream_();
+      self.state_ = 3;
+    end
+
+    % Ordinal 3
+    function write_data(self, value)
+      if self.state_ ~= 3
+        self.raise_unexpected_state_(3);
+      end
+
+      self.write_data_(value);
+    end
+
+    function end_data(self)
+      if self.state_ ~= 3
+        self.raise_unexpected_state_(3);
+      end
+
+      self.end_stream_();
+      self.state_ = 4;
+    end
+
+    % Ordinal 4
+    function write_recalibration_gain(self, value)
+      if self.state_ ~= 4
+        self.raise_unexpected_state_(4);
+      end
+
+      self.write_recalibration_gain_(value);
+      self.state_ = 5;
+    end
+
+    % Ordinal 5
+    function write_recalibration_samples(self, value)
+      if self.state_ ~= 5
+        self.raise_unexpected_state_(5);
+      end
+
+      self.write_recalibration_samples_(value);
+    end
+
+    function end_recalibration_samples(self)
+      if self.state_ ~= 5
+        self.raise_unexpected_state_(5);
+      end
+
+      self.end_stream_();
+      self.state_ = 6;
+    end
+  end
+
+  methods (Static)
+    function res = schema()
+      res = string('{"protocol":{"name":"ProtocolWithSubProtocols","sequence":[{"name":"header","type":"string"},{"name":"calibrationGain","type":"float32"},{"name":"calibrationSamples","type":{"stream":{"items":"float64"}}},{"name":"data","type":{"stream":{"items":"int32"}}},{"name":"recalibrationGain","type":"float32"},{"name":"recalibrationSamples","type":{"stream":{"items":"float64"}}}]},"types":null}');
+    end
+  end
+
+  methods (Abstract, Access=protected)
+    write_header_(self, value)
+    write_calibration_gain_(self, value)
+    write_calibration_samples_(self, value)
+    write_data_(self, value)
+    write_recalibration_gain_(self, value)
+    write_recalibration_samples_(self, value)
+
+    end_stream_(self)
+    close_(self)
+  end
+
+  methods (Access=private)
+    function raise_unexpected_state_(self, actual)
+      expected_method = self.state_to_method_name_(self.state_);
+      actual_method = self.state_to_method_name_(actual);
+      throw(yardl.ProtocolError("Expected call to '%s' but received call to '%s'", expected_method, actual_method));
+    end
+
+    function name = state_to_method_name_(self, state)
+      if state == 0
+        name = "write_header";
+      elseif state == 1
+        name = "write_calibration_gain";
+      elseif state == 2
+        name = "write_calibration_samples or end_calibration_samples";
+      elseif state == 3
+        name = "write_data or end_data";
+      elseif state == 4
+        name = "write_recalibration_gain";
+      elseif state == 5
+        name = "write_recalibration_samples or end_recalibration_samples";
+      else
+        name = '<unknown>';
+      end
+    end
+  end
+end
@@ -0,0 +1,56 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordHeader < handle
+  % A common header
+  properties
+    version
+    name
+  end
+
+  methods
+    function self = RecordHeader(kwargs)
+      arguments
+        kwargs.version = uint32(0);
+        kwargs.name = "header";
+      end
+      self.version = kwargs.version;
+      self.name = kwargs.name;
+    end
+
+    function res = is_first_version(self)
+      res = self.version == 1;
+      return
+    end
+
+
+    function res = eq(self, other)
+      res = ...
+        isa(other, "test_model.RecordHeader") && ...
+        isequal({self.version}, {other.version}) && ...
+        isequal({self.name}, {other.name});
+    end
+
+    function res = ne(self, other)
+      res = ~self.eq(other);
+    end
+
+    function res = isequal(self, other)
+      res = all(eq(self, other));
+    end
+  end
+
+  methods (Static)
+    function z = zeros(varargin)
+      elem = test_model.RecordHeader();
+      if nargin == 0
+        z = elem;
+        return;
+      end
+      sz = [varargin{:}];
+      if isscalar(sz)
+        sz = [sz, sz];
+      end
+      z = reshape(repelem(elem, prod(sz)), sz);
+    end
+  end
+end
@@ -0,0 +1,57 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithAnnotations < handle
+  % A slice with user-defined annotations
+  % Annotations: dicomModule="Image Plane", version=2
+  properties
+    % Annotations: dicomTag="(0018,0050)", units="mm"
+    thickness
+    % Annotations: deprecated=true, scale=1.0, units="mm"
+    spacing
+    label
+  end
+
+  methods
+    function self = RecordWithAnnotations(kwargs)
+      arguments
+        kwargs.thickness = single(0);
+        kwargs.spacing = single(1.5);
+        kwargs.label = "";
+      end
+      self.thickness = kwargs.thickness;
+      self.spacing = kwargs.spacing;
+      self.label = kwargs.label;
+    end
+
+    function res = eq(self, other)
+      res = ...
+        isa(other, "test_model.RecordWithAnnotations") && ...
+        isequal({self.thickness}, {other.thickness}) && ...
+        isequal({self.spacing}, {other.spacing}) && ...
+        isequal({self.label}, {other.label});
+    end
+
+    function res = ne(self, other)
+      res = ~self.eq(other);
+    end
+
+    function res = isequal(self, other)
+      res = all(eq(self, other));
+    end
+  end
+
+  methods (Static)
+    function z = zeros(varargin)
+      elem = test_model.RecordWithAnnotations();
+      if nargin == 0
+        z = elem;
+        return;
+      end
+      sz = [varargin{:}];
+      if isscalar(sz)
+        sz = [sz, sz];
+      end
+      z = reshape(repelem(elem, prod(sz)), sz);
+    end
+  end
+end
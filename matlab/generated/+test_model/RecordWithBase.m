@@ -0,0 +1,56 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithBase < test_model.RecordHeader
+  properties
+    data
+  end
+
+  methods
+    function self = RecordWithBase(kwargs)
+      arguments
+        kwargs.version = uint32(0);
+        kwargs.name = "header";
+        kwargs.data = single.empty();
+      end
+      self@test_model.RecordHeader(version=kwargs.version, name=kwargs.name);
+      self.data = kwargs.data;
+    end
+
+    function res = data_size(self)
+      res = length(self.data);
+      return
+    end
+
+
+    function res = eq(self, other)
+      res = ...
+        isa(other, "test_model.RecordWithBase") && ...
+        isequal({self.version}, {other.version}) && ...
+        isequal({self.name}, {other.name}) && ...
+        isequal({self.data}, {other.data});
+    end
+
+    function res = ne(self, other)
+      res = ~self.eq(other);
+    end
+
+    function res = isequal(self, other)
+      res = all(eq(self, other));
+    end
+  end
+
+  methods (Static)
+    function z = zeros(varargin)
+      elem = test_model.RecordWithBase();
+      if nargin == 0
+        z = elem;
+        return;
+      end
+      sz = [varargin{:}];
+      if isscalar(sz)
+        sz = [sz, sz];
+      end
+      z = reshape(repelem(elem, prod(sz)), sz);
+    end
+  end
+end
@@ -0,0 +1,53 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithBases < test_model.RecordHeader & test_model.LabeledHeader
+  properties
+    value
+  end
+
+  methods
+    function self = RecordWithBases(kwargs)
+      arguments
+        kwargs.version = uint32(0);
+        kwargs.name = "header";
+        kwargs.label = "";
+        kwargs.value = int32(0);
+      end
+      self@test_model.RecordHeader(version=kwargs.version, name=kwargs.name);
+      self@test_model.LabeledHeader(label=kwargs.label);
+      self.value = kwargs.value;
+    end
+
+    function res = eq(self, other)
+      res = ...
+        isa(other, "test_model.RecordWithBases") && ...
+        isequal({self.version}, {other.version}) && ...
+        isequal({self.name}, {other.name}) && ...
+        isequal({self.label}, {other.label}) && ...
+        isequal({self.value}, {other.value});
+    end
+
+    function res = ne(self, other)
+      res = ~self.eq(other);
+    end
+
+    function res = isequal(self, other)
+      res = all(eq(self, other));
+    end
+  end
+
+  methods (Static)
+    function z = zeros(varargin)
+      elem = test_model.RecordWithBases();
+      if nargin == 0
+        z = elem;
+        return;
+      end
+      sz = [varargin{:}];
+      if isscalar(sz)
+        sz = [sz, sz];
+      end
+      z = reshape(repelem(elem, prod(sz)), sz);
+    end
+  end
+end
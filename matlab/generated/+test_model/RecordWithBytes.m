@@ -0,0 +1,53 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithBytes < handle
+  properties
+    data
+    optional_data
+    chunks
+  end
+
+  methods
+    function self = RecordWithBytes(kwargs)
+      arguments
+        kwargs.data = uint8.empty();
+        kwargs.optional_data = yardl.None;
+        kwargs.chunks = uint8.empty();
+      end
+      self.data = kwargs.data;
+      self.optional_data = kwargs.optional_data;
+      self.chunks = kwargs.chunks;
+    end
+
+    function res = eq(self, other)
+      res = ...
+        isa(other, "test_model.RecordWithBytes") && ...
+        isequal({self.data}, {other.data}) && ...
+        isequal({self.optional_data}, {other.optional_data}) && ...
+        isequal({self.chunks}, {other.chunks});
+    end
+
+    function res = ne(self, other)
+      res = ~self.eq(other);
+    end
+
+    function res = isequal(self, other)
+      res = all(eq(self, other));
+    end
+  end
+
+  methods (Static)
+    function z = zeros(varargin)
+      elem = test_model.RecordWithBytes();
+      if nargin == 0
+        z = elem;
+        return;
+      end
+      sz = [varargin{:}];
+      if isscalar(sz)
+        sz = [sz, sz];
+      end
+      z = reshape(repelem(elem, prod(sz)), sz);
+    end
+  end
+end
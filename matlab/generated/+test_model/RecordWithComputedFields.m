@@ -493,6 +493,96 @@ classdef RecordWithComputedFields < handle
       return
     end
 
+    function res = comparison_1(self)
+      res = self.int_field > 10;
+      return
+    end
+
+    function res = comparison_2(self)
+      res = self.vector_size() <= 3;
+      return
+    end
+
+    function res = comparison_3(self)
+      res = self.string_field == "hello";
+      return
+    end
+
+    function res = comparison_4(self)
+      res = self.float32_field ~= single(self.int_field);
+      return
+    end
+
+    function res = logical_1(self)
+      res = self.int_field > 0 && self.vector_size() > 0;
+      return
+    end
+
+    function res = logical_2(self)
+      res = ~(self.int_field > 0) || self.string_field ~= "hello";
+      return
+    end
+
+    function res = conditional_1(self)
+      res = yardl.conditional(self.vector_size() > 0, @() self.vector_field(1+0), @() -1);
+      return
+    end
+
+    function res = conditional_2(self)
+      res = yardl.conditional(self.int_field > 0, @() "positive", @() yardl.conditional(self.int_field == 0, @() "zero", @() "negative"));
+      return
+    end
+
+    function res = call_min(self)
+      res = min(self.int_field, int32(self.int8_field));
+      return
+    end
+
+    function res = call_max(self)
+      res = max(self.float32_field, single(2));
+      return
+    end
+
+    function res = call_abs(self)
+      res = abs(self.int_field);
+      return
+    end
+
+    function res = call_sqrt(self)
+      res = sqrt(double(self.uint32_field));
+      return
+    end
+
+    function res = call_floor(self)
+      res = floor(self.float64_field);
+      return
+    end
+
+    function res = call_ceil(self)
+      res = ceil(self.float64_field);
+      return
+    end
+
+    function res = call_sum(self)
+      res = yardl.checked_sum(self.vector_field);
+      return
+    end
+
+    function res = call_length(self)
+      res = numel(unicode2native(self.string_field, "UTF-8"));
+      return
+    end
+
+    function res = call_contains(self)
+      res = self.map_field.isKey("hello");
+      return
+    end
+
+    function res = call_has_value(self)
+      res = (self.optional_named_array ~= yardl.None);
+      return
+    end
+
     function res = cast_int_to_float(self)
       res = single(self.int_field);
       return
@@ -0,0 +1,74 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithConstants < handle
+  properties
+    channels
+    samples
+    gain
+  end
+
+  methods
+    function self = RecordWithConstants(kwargs)
+      arguments
+        kwargs.channels = repelem(int32(0), 4);
+        kwargs.samples = repelem(single(0), 8, 4);
+        kwargs.gain = single(test_model.Constants.DEFAULT_GAIN);
+      end
+      self.channels = kwargs.channels;
+      self.samples = kwargs.samples;
+      self.gain = kwargs.gain;
+    end
+
+    function res = channel_count(self)
+      res = test_model.Constants.MAX_CHANNELS;
+      return
+    end
+
+    function res = sample_count(self)
+      res = int64(test_model.Constants.MAX_CHANNELS) .* int64(test_model.Constants.SAMPLES_PER_CHANNEL);
+      return
+    end
+
+    function res = scaled_gain(self)
+      res = self.gain .* test_model.Constants.DEFAULT_GAIN;
+      return
+    end
+
+    function res = strict(self)
+      res = test_model.Constants.IS_STRICT;
+      return
+    end
+
+
+    function res = eq(self, other)
+      res = ...
+        isa(other, "test_model.RecordWithConstants") && ...
+        isequal({self.channels}, {other.channels}) && ...
+        isequal({self.samples}, {other.samples}) && ...
+        isequal({self.gain}, {other.gain});
+    end
+
+    function res = ne(self, other)
+      res = ~self.eq(other);
+    end
+
+    function res = isequal(self, other)
+      res = all(eq(self, other));
+    end
+  end
+
+  methods (Static)
+    function z = zeros(varargin)
+      elem = test_model.RecordWithConstants();
+      if nargin == 0
+        z = elem;
+        return;
+      end
+      sz = [varargin{:}];
+      if isscalar(sz)
+        sz = [sz, sz];
+      end
+      z = reshape(repelem(elem, prod(sz)), sz);
+    end
+  end
+end
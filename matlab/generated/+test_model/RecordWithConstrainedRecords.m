@@ -0,0 +1,68 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithConstrainedRecords < handle
+  properties
+    single
+    optional
+    vector
+  end
+
+  methods
+    function self = RecordWithConstrainedRecords(kwargs)
+      arguments
+        kwargs.single = test_model.RecordWithConstraints();
+        kwargs.optional = yardl.None;
+        kwargs.vector = test_model.RecordWithConstraints.empty();
+      end
+      self.single = kwargs.single;
+      self.optional = kwargs.optional;
+      self.vector = kwargs.vector;
+    end
+
+    function validate(self)
+      % Throws a yardl.ValueError if a field does not satisfy its constraints.
+      self.single.validate();
+      if self.optional ~= yardl.None
+        self.optional.validate();
+      end
+      items0 = self.vector;
+      if ~iscell(items0)
+        items0 = num2cell(items0);
+      end
+      for i0 = 1:numel(items0)
+        items0{i0}.validate();
+      end
+    end
+
+    function res = eq(self, other)
+      res = ...
+        isa(other, "test_model.RecordWithConstrainedRecords") && ...
+        isequal({self.single}, {other.single}) && ...
+        isequal({self.optional}, {other.optional}) && ...
+        isequal({self.vector}, {other.vector});
+    end
+
+    function res = ne(self, other)
+      res = ~self.eq(other);
+    end
+
+    function res = isequal(self, other)
+      res = all(eq(self, other));
+    end
+  end
+
+  methods (Static)
+    function z = zeros(varargin)
+      elem = test_model.RecordWithConstrainedRecords();
+      if nargin == 0
+        z = elem;
+        return;
+      end
+      sz = [varargin{:}];
+      if isscalar(sz)
+        sz = [sz, sz];
+      end
+      z = reshape(repelem(elem, prod(sz)), sz);
+    end
+  end
+end
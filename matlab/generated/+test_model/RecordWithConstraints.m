@@ -0,0 +1,89 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithConstraints < handle
+  properties
+    size
+    gain
+    name
+    limit
+    samples
+  end
+
+  methods
+    function self = RecordWithConstraints(kwargs)
+      arguments
+        kwargs.size = uint32(0);
+        kwargs.gain = single(0);
+        kwargs.name = "";
+        kwargs.limit = yardl.None;
+        kwargs.samples = int32.empty();
+      end
+      self.size = kwargs.size;
+      self.gain = kwargs.gain;
+      self.name = kwargs.name;
+      self.limit = kwargs.limit;
+      self.samples = kwargs.samples;
+    end
+
+    function validate(self)
+      % Throws a yardl.ValueError if a field does not satisfy its constraints.
+      if self.size < 1
+        throw(yardl.ValueError("RecordWithConstraints.size must be at least 1"));
+      end
+      if self.size > 4096
+        throw(yardl.ValueError("RecordWithConstraints.size must be at most 4096"));
+      end
+      if self.gain < 0.0
+        throw(yardl.ValueError("RecordWithConstraints.gain must be at least 0.0"));
+      end
+      if self.gain > 2.5
+        throw(yardl.ValueError("RecordWithConstraints.gain must be at most 2.5"));
+      end
+      if isempty(regexp(self.name, "^(?:[a-z][a-z0-9_]*)$", "once"))
+        throw(yardl.ValueError("RecordWithConstraints.name must match the pattern '%s'", "[a-z][a-z0-9_]*"));
+      end
+      if self.limit ~= yardl.None && self.limit > 10
+        throw(yardl.ValueError("RecordWithConstraints.limit must be at most 10"));
+      end
+      if length(self.samples) < 1
+        throw(yardl.ValueError("RecordWithConstraints.samples must have a length of at least 1"));
+      end
+      if length(self.samples) > 4
+        throw(yardl.ValueError("RecordWithConstraints.samples must have a length of at most 4"));
+      end
+    end
+
+    function res = eq(self, other)
+      res = ...
+        isa(other, "test_model.RecordWithConstraints") && ...
+        isequal({self.size}, {other.size}) && ...
+        isequal({self.gain}, {other.gain}) && ...
+        isequal({self.name}, {other.name}) && ...
+        isequal({self.limit}, {other.limit}) && ...
+        isequal({self.samples}, {other.samples});
+    end
+
+    function res = ne(self, other)
+      res = ~self.eq(other);
+    end
+
+    function res = isequal(self, other)
+      res = all(eq(self, other));
+    end
+  end
+
+  methods (Static)
+    function z = zeros(varargin)
+      elem = test_model.RecordWithConstraints();
+      if nargin == 0
+        z = elem;
+        return;
+      end
+      sz = [varargin{:}];
+      if isscalar(sz)
+        sz = [sz, sz];
+      end
+      z = reshape(repelem(elem, prod(sz)), sz);
+    end
+  end
+end
@@ -0,0 +1,81 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithDefaults < handle
+  properties
+    int_field
+    int8_field
+    uint64_field
+    float32_field
+    float64_field
+    complexfloat64_field
+    bool_field
+    string_field
+    enum_field
+    no_default_field
+  end
+
+  methods
+    function self = RecordWithDefaults(kwargs)
+      arguments
+        kwargs.int_field = int32(42);
+        kwargs.int8_field = int8(-7);
+        kwargs.uint64_field = uint64(9223372036854775808);
+        kwargs.float32_field = single(1.5);
+        kwargs.float64_field = double(2 .* 4);
+        kwargs.complexfloat64_field = complex(double(double(3)));
+        kwargs.bool_field = true;
+        kwargs.string_field = "hello";
+        kwargs.enum_field = test_model.Fruits.PEAR;
+        kwargs.no_default_field = int32(0);
+      end
+      self.int_field = kwargs.int_field;
+      self.int8_field = kwargs.int8_field;
+      self.uint64_field = kwargs.uint64_field;
+      self.float32_field = kwargs.float32_field;
+      self.float64_field = kwargs.float64_field;
+      self.complexfloat64_field = kwargs.complexfloat64_field;
+      self.bool_field = kwargs.bool_field;
+      self.string_field = kwargs.string_field;
+      self.enum_field = kwargs.enum_field;
+      self.no_default_field = kwargs.no_default_field;
+    end
+
+    function res = eq(self, other)
+      res = ...
+        isa(other, "test_model.RecordWithDefaults") && ...
+        isequal({self.int_field}, {other.int_field}) && ...
+        isequal({self.int8_field}, {other.int8_field}) && ...
+        isequal({self.uint64_field}, {other.uint64_field}) && ...
+        isequal({self.float32_field}, {other.float32_field}) && ...
+        isequal({self.float64_field}, {other.float64_field}) && ...
+        isequal({self.complexfloat64_field}, {other.complexfloat64_field}) && ...
+        isequal({self.bool_field}, {other.bool_field}) && ...
+        isequal({self.string_field}, {other.string_field}) && ...
+        isequal({self.enum_field}, {other.enum_field}) && ...
+        isequal({self.no_default_field}, {other.no_default_field});
+    end
+
+    function res = ne(self, other)
+      res = ~self.eq(other);
+    end
+
+    function res = isequal(self, other)
+      res = all(eq(self, other));
+    end
+  end
+
+  methods (Static)
+    function z = zeros(varargin)
+      elem = test_model.RecordWithDefaults();
+      if nargin == 0
+        z = elem;
+        return;
+      end
+      sz = [varargin{:}];
+      if isscalar(sz)
+        sz = [sz, sz];
+      end
+      z = reshape(repelem(elem, prod(sz)), sz);
+    end
+  end
+end
@@ -0,0 +1,77 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithDurations < handle
+  properties
+    acquisition_start
+    acquisition_end
+    repetition_time
+    timeout
+    intervals
+  end
+
+  methods
+    function self = RecordWithDurations(kwargs)
+      arguments
+        kwargs.acquisition_start = yardl.DateTime();
+        kwargs.acquisition_end = yardl.DateTime();
+        kwargs.repetition_time = seconds(0);
+        kwargs.timeout = yardl.None;
+        kwargs.intervals = duration.empty();
+      end
+      self.acquisition_start = kwargs.acquisition_start;
+      self.acquisition_end = kwargs.acquisition_end;
+      self.repetition_time = kwargs.repetition_time;
+      self.timeout = kwargs.timeout;
+      self.intervals = kwargs.intervals;
+    end
+
+    function res = elapsed(self)
+      res = self.acquisition_end - self.acquisition_start;
+      return
+    end
+
+    function res = next_acquisition_start(self)
+      res = self.acquisition_start + self.repetition_time;
+      return
+    end
+
+    function res = exceeds_repetition_time(self)
+      res = self.elapsed() > self.repetition_time;
+      return
+    end
+
+
+    function res = eq(self, other)
+      res = ...
+        isa(other, "test_model.RecordWithDurations") && ...
+        isequal({self.acquisition_start}, {other.acquisition_start}) && ...
+        isequal({self.acquisition_end}, {other.acquisition_end}) && ...
+        isequal({self.repetition_time}, {other.repetition_time}) && ...
+        isequal({self.timeout}, {other.timeout}) && ...
+        isequal({self.intervals}, {other.intervals});
+    end
+
+    function res = ne(self, other)
+      res = ~self.eq(other);
+    end
+
+    function res = isequal(self, other)
+      res = all(eq(self, other));
+    end
+  end
+
+  methods (Static)
+    function z = zeros(varargin)
+      elem = test_model.RecordWithDurations();
+      if nargin == 0
+        z = elem;
+        return;
+      end
+      sz = [varargin{:}];
+      if isscalar(sz)
+        sz = [sz, sz];
+      end
+      z = reshape(repelem(elem, prod(sz)), sz);
+    end
+  end
+end
@@ -0,0 +1,57 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithHalfPrecision < handle
+  properties
+    half
+    brain
+    half_vector
+    brain_array
+  end
+
+  methods
+    function self = RecordWithHalfPrecision(kwargs)
+      arguments
+        kwargs.half = single(0);
+        kwargs.brain = single(0);
+        kwargs.half_vector = single.empty();
+        kwargs.brain_array = single.empty();
+      end
+      self.half = kwargs.half;
+      self.brain = kwargs.brain;
+      self.half_vector = kwargs.half_vector;
+      self.brain_array = kwargs.brain_array;
+    end
+
+    function res = eq(self, other)
+      res = ...
+        isa(other, "test_model.RecordWithHalfPrecision") && ...
+        isequal({self.half}, {other.half}) && ...
+        isequal({self.brain}, {other.brain}) && ...
+        isequal({self.half_vector}, {other.half_vector}) && ...
+        isequal({self.brain_array}, {other.brain_array});
+    end
+
+    function res = ne(self, other)
+      res = ~self.eq(other);
+    end
+
+    function res = isequal(self, other)
+      res = all(eq(self, other));
+    end
+  end
+
+  methods (Static)
+    function z = zeros(varargin)
+      elem = test_model.RecordWithHalfPrecision();
+      if nargin == 0
+        z = elem;
+        return;
+      end
+      sz = [varargin{:}];
+      if isscalar(sz)
+        sz = [sz, sz];
+      end
+      z = reshape(repelem(elem, prod(sz)), sz);
+    end
+  end
+end
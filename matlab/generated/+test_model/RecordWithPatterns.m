@@ -0,0 +1,80 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithPatterns < handle
+  properties
+    alternation
+    word
+    code
+    escaped
+    capitalized
+  end
+
+  methods
+    function self = RecordWithPatterns(kwargs)
+      arguments
+        kwargs.alternation = "";
+        kwargs.word = "";
+        kwargs.code = "";
+        kwargs.escaped = "";
+        kwargs.capitalized = "";
+      end
+      self.alternation = kwargs.alternation;
+      self.word = kwargs.word;
+      self.code = kwargs.code;
+      self.escaped = kwargs.escaped;
+      self.capitalized = kwargs.capitalized;
+    end
+
+    function validate(self)
+      % Throws a yardl.ValueError if a field does not satisfy its constraints.
+      if isempty(regexp(self.alternation, "^(?:a|ab)$", "once"))
+        throw(yardl.ValueError("RecordWithPatterns.alternation must match the pattern '%s'", "a|ab"));
+      end
+      if isempty(regexp(self.word, "^(?:\w+)$", "once"))
+        throw(yardl.ValueError("RecordWithPatterns.word must match the pattern '%s'", "\w+"));
+      end
+      if isempty(regexp(self.code, "^(?:\d{2,3}(?:\-\d{2})?)$", "once"))
+        throw(yardl.ValueError("RecordWithPatterns.code must match the pattern '%s'", "\d{2,3}(?:\-\d{2})?"));
+      end
+      if isempty(regexp(self.escaped, "^(?:\(\.\)[\-+*])$", "once"))
+        throw(yardl.ValueError("RecordWithPatterns.escaped must match the pattern '%s'", "\(\.\)[\-+*]"));
+      end
+      if isempty(regexp(self.capitalized, "^(?:[A-Z][a-z]*)$", "once"))
+        throw(yardl.ValueError("RecordWithPatterns.capitalized must match the pattern '%s'", "[A-Z][a-z]*"));
+      end
+    end
+
+    function res = eq(self, other)
+      res = ...
+        isa(other, "test_model.RecordWithPatterns") && ...
+        isequal({self.alternation}, {other.alternation}) && ...
+        isequal({self.word}, {other.word}) && ...
+        isequal({self.code}, {other.code}) && ...
+        isequal({self.escaped}, {other.escaped}) && ...
+        isequal({self.capitalized}, {other.capitalized});
+    end
+
+    function res = ne(self, other)
+      res = ~self.eq(other);
+    end
+
+    function res = isequal(self, other)
+      res = all(eq(self, other));
+    end
+  end
+
+  methods (Static)
+    function z = zeros(varargin)
+      elem = test_model.RecordWithPatterns();
+      if nargin == 0
+        z = elem;
+        return;
+      end
+      sz = [varargin{:}];
+      if isscalar(sz)
+        sz = [sz, sz];
+      end
+      z = reshape(repelem(elem, prod(sz)), sz);
+    end
+  end
+end
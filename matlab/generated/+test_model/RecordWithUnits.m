@@ -0,0 +1,69 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithUnits < handle
+  properties
+    length
+    width
+    durations
+  end
+
+  methods
+    function self = RecordWithUnits(kwargs)
+      arguments
+        kwargs.length = single(0);
+        kwargs.width = single(0);
+        kwargs.durations = double.empty();
+      end
+      self.length = kwargs.length;
+      self.width = kwargs.width;
+      self.durations = kwargs.durations;
+    end
+
+    function res = perimeter(self)
+      res = single(2) .* (self.length + self.width);
+      return
+    end
+
+    function res = area(self)
+      res = self.length .* self.width;
+      return
+    end
+
+    function res = total_duration(self)
+      res = sum(self.durations, "native");
+      return
+    end
+
+
+    function res = eq(self, other)
+      res = ...
+        isa(other, "test_model.RecordWithUnits") && ...
+        isequal({self.length}, {other.length}) && ...
+        isequal({self.width}, {other.width}) && ...
+        isequal({self.durations}, {other.durations});
+    end
+
+    function res = ne(self, other)
+      res = ~self.eq(other);
+    end
+
+    function res = isequal(self, other)
+      res = all(eq(self, other));
+    end
+  end
+
+  methods (Static)
+    function z = zeros(varargin)
+      elem = test_model.RecordWithUnits();
+      if nargin == 0
+        z = elem;
+        return;
+      end
+      sz = [varargin{:}];
+      if isscalar(sz)
+        sz = [sz, sz];
+      end
+      z = reshape(repelem(elem, prod(sz)), sz);
+    end
+  end
+end
@@ -0,0 +1,57 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef RecordWithUuids < handle
+  properties
+    id
+    optional_id
+    related
+    names
+  end
+
+  methods
+    function self = RecordWithUuids(kwargs)
+      arguments
+        kwargs.id = "00000000-0000-0000-0000-000000000000";
+        kwargs.optional_id = yardl.None;
+        kwargs.related = string.empty();
+        kwargs.names = yardl.Map;
+      end
+      self.id = kwargs.id;
+      self.optional_id = kwargs.optional_id;
+      self.related = kwargs.related;
+      self.names = kwargs.names;
+    end
+
+    function res = eq(self, other)
+      res = ...
+        isa(other, "test_model.RecordWithUuids") && ...
+        isequal({self.id}, {other.id}) && ...
+        isequal({self.optional_id}, {other.optional_id}) && ...
+        isequal({self.related}, {other.related}) && ...
+        isequal({self.names}, {other.names});
+    end
+
+    function res = ne(self, other)
+      res = ~self.eq(other);
+    end
+
+    function res = isequal(self, other)
+      res = all(eq(self, other));
+    end
+  end
+
+  methods (Static)
+    function z = zeros(varargin)
+      elem = test_model.RecordWithUuids();
+      if nargin == 0
+        z = elem;
+        return;
+      end
+      sz = [varargin{:}];
+      if isscalar(sz)
+        sz = [sz, sz];
+      end
+      z = reshape(repelem(elem, prod(sz)), sz);
+    end
+  end
+end
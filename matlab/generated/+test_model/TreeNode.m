@@ -0,0 +1,49 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef TreeNode < handle
+  properties
+    label
+    children
+  end
+
+  methods
+    function self = TreeNode(kwargs)
+      arguments
+        kwargs.label = "";
+        kwargs.children = test_model.TreeNode.empty();
+      end
+      self.label = kwargs.label;
+      self.children = kwargs.children;
+    end
+
+    function res = eq(self, other)
+      res = ...
+        isa(other, "test_model.TreeNode") && ...
+        isequal({self.label}, {other.label}) && ...
+        isequal({self.children}, {other.children});
+    end
+
+    function res = ne(self, other)
+      res = ~self.eq(other);
+    end
+
+    function res = isequal(self, other)
+      res = all(eq(self, other));
+    end
+  end
+
+  methods (Static)
+    function z = zeros(varargin)
+      elem = test_model.TreeNode();
+      if nargin == 0
+        z = elem;
+        return;
+      end
+      sz = [varargin{:}];
+      if isscalar(sz)
+        sz = [sz, sz];
+      end
+      z = reshape(repelem(elem, prod(sz)), sz);
+    end
+  end
+end
@@ -0,0 +1,24 @@
+% This file was generated by the "yardl" tool. DO NOT EDIT.
+
+classdef TupleConverter < yardl.ndjson.RecordConverter
+  methods
+    function self = TupleConverter(t1_converter, t2_converter)
+      field_converters{1} = t1_converter;
+      field_converters{2} = t2_converter;
+      self@yardl.ndjson.RecordConverter('tuples.Tuple', ["v1", "v2"], field_converters);
+    end
+
+    function json = to_json(self, value)
+      arguments
+        self
+        value (1,1) tuples.Tuple
+      end
+      json = self.to_json_(value.v1, value.v2);
+    end
+
+    function value = from_json(self, json)
+      fields = self.from_json_(json);
+      value = tuples.Tuple(v1=fields{1}, v2=fields{2});
+    end
+  end
+end
@@ -191,6 +191,82 @@ classdef ComputedFieldsTest < matlab.unittest.TestCase
             testCase.verifyEqual(r.arithmetic_10(), 1e10 + 9e9);
         end
 
+        function testComparisonAndLogicalOperators(testCase)
+            r = test_model.RecordWithComputedFields();
+            testCase.verifyFalse(r.comparison_1());
+            testCase.verifyTrue(r.comparison_2());
+            testCase.verifyFalse(r.comparison_3());
+            testCase.verifyFalse(r.comparison_4());
+            testCase.verifyFalse(r.logical_1());
+            testCase.verifyTrue(r.logical_2());
+
+            r.int_field = int32(42);
+            r.vector_field = int32([1, 2, 3, 4]);
+            r.string_field = "hello";
+            r.float32_field = single(42.5);
+            testCase.verifyTrue(r.comparison_1());
+            testCase.verifyFalse(r.comparison_2());
+            testCase.verifyTrue(r.comparison_3());
+            testCase.verifyTrue(r.comparison_4());
+            testCase.verifyTrue(r.logical_1());
+            testCase.verifyFalse(r.logical_2());
+        end
+
+        function testConditional(testCase)
+            r = test_model.RecordWithComputedFields();
+            testCase.verifyEqual(r.conditional_1(), -1);
+            testCase.verifyEqual(r.conditional_2(), "zero");
+
+            r.vector_field = int32([7, 8]);
+            r.int_field = int32(1);
+            testCase.verifyEqual(r.conditional_1(), int32(7));
+            testCase.verifyEqual(r.conditional_2(), "positive");
+
+            r.int_field = int32(-1);
+            testCase.verifyEqual(r.conditional_2(), "negative");
+        end
+
+        function testFunctions(testCase)
+            r = test_model.RecordWithComputedFields();
+            r.int_field = int32(-5);
+            r.int8_field = int8(3);
+            testCase.verifyEqual(r.call_min(), int32(-5));
+            testCase.verifyEqual(r.call_abs(), int32(5));
+
+            r.float32_field = single(1.5);
+            testCase.verifyEqual(r.call_max(), single(2));
+
+            r.uint32_field = uint32(16);
+            testCase.verifyEqual(r.call_sqrt(), 4.0);
+
+            r.float64_field = 2.5;
+            testCase.verifyEqual(r.call_floor(), 2.0);
+            testCase.verifyEqual(r.call_ceil(), 3.0);
+
+            r.vector_field = int32([1, 2, 3]);
+            testCase.verifyEqual(r.call_sum(), int32(6));
+            r.vector_field = [intmax("int32"), int32(1)];
+            testCase.verifyError(@() r.call_sum(), "yardl:RuntimeError");
+            r.vector_field = [intmin("int32"), int32(-1)];
+            testCase.verifyError(@() r.call_sum(), "yardl:RuntimeError");
+            r.vector_field = [intmax("int32"), int32(1), int32(-1)];
+            testCase.verifyError(@() r.call_sum(), "yardl:RuntimeError");
+
+            r.string_field = "hello";
+            testCase.verifyEqual(r.call_length(), 5);
+            % The length is in UTF-8 bytes
+            r.string_field = "h" + char(233) + "llo";
+            testCase.verifyEqual(r.call_length(), 6);
+
+            testCase.verifyFalse(r.call_contains());
+            r.map_field = yardl.Map("hello", "world");
+            testCase.verifyTrue(r.call_contains());
+
+            testCase.verifyFalse(r.call_has_value());
+            r.optional_named_array = int32([[1; 2], [3; 4]]);
+            testCase.verifyTrue(r.call_has_value());
+        end
+
         function testCasting(testCase)
             r = test_model.RecordWithComputedFields();
             r.int_field = int32(42);
@@ -210,5 +286,19 @@ classdef ComputedFieldsTest < matlab.unittest.TestCase
             testCase.verifyEqual(r.cast_float_to_complex(), complex(single(66.6), 0));
         end
 
+        function testTemporalArithmetic(testCase)
+            r = test_model.RecordWithDurations();
+            r.acquisition_start = yardl.DateTime(int64(1000e9));
+            r.acquisition_end = yardl.DateTime(int64(1090e9));
+            r.repetition_time = seconds(60);
+
+            testCase.verifyEqual(r.elapsed(), seconds(90));
+            testCase.verifyEqual(r.next_acquisition_start(), yardl.DateTime(int64(1060e9)));
+            testCase.verifyTrue(r.exceeds_repetition_time());
+
+            r.repetition_time = minutes(2);
+            testCase.verifyFalse(r.exceeds_repetition_time());
+        end
+
     end
 end
@@ -90,6 +90,129 @@ classdef GeneratedTypesTest < matlab.unittest.TestCase
             testCase.verifyEqual(rm.am, rm.m);
         end
 
+        function testRecordWithFieldDefaults(testCase)
+            r = test_model.RecordWithDefaults();
+
+            testCase.verifyEqual(r.int_field, int32(42));
+            testCase.verifyEqual(r.int8_field, int8(-7));
+            testCase.verifyEqual(r.uint64_field, uint64(9223372036854775808));
+            testCase.verifyEqual(r.float32_field, single(1.5));
+            testCase.verifyEqual(r.float64_field, 8);
+            testCase.verifyEqual(r.complexfloat64_field, complex(3));
+            testCase.verifyEqual(r.bool_field, true);
+            testCase.verifyEqual(r.string_field, "hello");
+            testCase.verifyEqual(r.enum_field, test_model.Fruits.PEAR);
+            testCase.verifyEqual(r.no_default_field, int32(0));
+
+            r = test_model.RecordWithDefaults(int_field=int32(1));
+            testCase.verifyEqual(r.int_field, int32(1));
+        end
+
+        function testConstants(testCase)
+            testCase.verifyEqual(test_model.Constants.MAX_CHANNELS, int32(4));
+            testCase.verifyEqual(test_model.Constants.SAMPLES_PER_CHANNEL, uint32(8));
+            testCase.verifyEqual(test_model.Constants.DEFAULT_GAIN, single(1.5));
+            testCase.verifyEqual(test_model.Constants.IS_STRICT, false);
+
+            r = test_model.RecordWithConstants();
+            testCase.verifyEqual(size(r.channels), [1, 4]);
+            testCase.verifyEqual(size(r.samples), [8, 4]);
+            testCase.verifyEqual(r.gain, single(1.5));
+            testCase.verifyEqual(r.channel_count(), int32(4));
+            testCase.verifyEqual(r.sample_count(), int64(32));
+            testCase.verifyEqual(r.scaled_gain(), single(2.25));
+            testCase.verifyEqual(r.strict(), false);
+        end
+
+        function testExtendedRecords(testCase)
+            r = test_model.RecordWithBase(version=uint32(1), data=single([1, 2, 3]));
+            testCase.verifyTrue(isa(r, "test_model.RecordHeader"));
+            testCase.verifyEqual(r.name, "header");
+            testCase.verifyTrue(r.is_first_version());
+            testCase.verifyEqual(r.data_size(), 3);
+            testCase.verifyNotEqual(r, test_model.RecordHeader(version=uint32(1)));
+
+            r2 = test_model.RecordWithBases(label="abc", value=int32(2));
+            testCase.verifyTrue(isa(r2, "test_model.RecordHeader"));
+            testCase.verifyTrue(isa(r2, "test_model.LabeledHeader"));
+            testCase.verifyEqual(r2.label, "abc");
+            testCase.verifyFalse(r2.is_first_version());
+            testCase.verifyEqual(r2, test_model.RecordWithBases(label="abc", value=int32(2)));
+        end
+
+        function testUnits(testCase)
+            r = test_model.RecordWithUnits(length=single(2), width=single(3), durations=[0.5, 1.5]);
+            testCase.verifyEqual(r.perimeter(), single(10));
+            testCase.verifyEqual(r.area(), single(6));
+            testCase.verifyEqual(r.total_duration(), 2.0);
+        end
+
+        function testRecordWithFieldConstraints(testCase)
+            valid = @() test_model.RecordWithConstraints(size=uint32(1), gain=single(2.5), name="abc_1", samples=int32([1]));
+            valid().validate();
+
+            invalid = {
+                "size", uint32(0);
+                "size", uint32(4097);
+                "gain", single(-0.5);
+                "name", "Abc";
+                "name", "abc!";
+                "limit", int32(11);
+                "samples", int32.empty();
+                "samples", int32([1, 2, 3, 4, 5])
+            };
+            for i = 1:size(invalid, 1)
+                r = valid();
+                r.(invalid{i, 1}) = invalid{i, 2};
+                testCase.verifyError(@() r.validate(), 'yardl:ValueError');
+            end
+
+            outer = test_model.RecordWithConstrainedRecords(single=valid(), vector=[valid(), valid()]);
+            outer.validate();
+            outer.vector(2).size = uint32(0);
+            testCase.verifyError(@() outer.validate(), 'yardl:ValueError');
+            outer.vector = test_model.RecordWithConstraints.empty();
+            outer.optional = valid();
+            outer.optional.name = "";
+            testCase.verifyError(@() outer.validate(), 'yardl:ValueError');
+        end
+
+        function testRecordWithFieldPatterns(testCase)
+            % The same cases are tested in every language, since each uses
+            % its own regular expression library.
+            valid = @() test_model.RecordWithPatterns(alternation="a", word="w", code="12", escaped="(.)+", capitalized="A");
+            valid().validate();
+
+            cases = {
+                "alternation", "ab", true;
+                "alternation", "abc", false;
+                "alternation", "", false;
+                "alternation", "a" + newline, false;
+                "word", "abc_1", true;
+                "word", string(char(233)), false;
+                "word", "a b", false;
+                "code", "123-45", true;
+                "code", "1234", false;
+                "code", "12-4", false;
+                "code", string(char([1633, 1634])), false;
+                "escaped", "(.)-", true;
+                "escaped", "(.)*", true;
+                "escaped", "(x)-", false;
+                "capitalized", "Abc", true;
+                "capitalized", "abc", false;
+                "capitalized", "ABC", false
+            };
+            for i = 1:size(cases, 1)
+                r = valid();
+                r.(cases{i, 1}) = cases{i, 2};
+                if cases{i, 3}
+                    r.validate();
+                else
+                    testCase.verifyError(@() r.validate(), 'yardl:ValueError');
+                end
+            end
+        end
+
         function testDefaultRecordWithGenericRequiredArguments(testCase)
             testCase.verifyError(@() test_model.RecordWithGenericArrays(), 'yardl:TypeError');
             testCase.verifyError(@() test_model.RecordWithGenericVectors(), 'yardl:TypeError');
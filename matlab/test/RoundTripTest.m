@@ -3,8 +3,7 @@
 
 classdef RoundTripTest < matlab.unittest.TestCase
     properties (TestParameter)
-        % format = {"binary", "ndjson"};
-        format = {"binary"};
+        format = {"binary", "ndjson"};
     end
 
     methods (Test)
@@ -35,6 +34,25 @@ classdef RoundTripTest < matlab.unittest.TestCase
             w.close();
         end
 
+        function testIntegerLimits(testCase, format)
+            rec = test_model.RecordWithPrimitives();
+            rec.int64_field = intmax('int64');
+            rec.uint64_field = intmax('uint64');
+            rec.size_field = intmax('uint64');
+            w = create_validating_writer(testCase, format, 'Scalars');
+            w.write_int32(intmax('int32'));
+            w.write_record(rec);
+            w.close();
+
+            rec.int64_field = intmin('int64');
+            rec.uint64_field = uint64(flintmax) + 1;
+            rec.size_field = uint64(0);
+            w = create_validating_writer(testCase, format, 'Scalars');
+            w.write_int32(intmin('int32'));
+            w.write_record(rec);
+            w.close();
+        end
+
         function testScalarOptionals(testCase, format)
             w = create_validating_writer(testCase, format, 'ScalarOptionals');
             w.write_optional_int(yardl.None);
@@ -87,6 +105,88 @@ classdef RoundTripTest < matlab.unittest.TestCase
             w.close();
         end
 
+        function testBytes(testCase, format)
+            w = create_validating_writer(testCase, format, 'ProtocolWithBytes');
+            w.write_single_bytes(uint8([0, 127, 255]));
+            w.write_rec_with_bytes(test_model.RecordWithBytes(data=uint8([222, 173]), optional_data=uint8.empty(), chunks={uint8([0, 127, 255]), uint8.empty(), uint8(1)}));
+            w.close();
+        end
+
+        function testUuids(testCase, format)
+            w = create_validating_writer(testCase, format, 'ProtocolWithUuids');
+            id = "123e4567-e89b-12d3-a456-426614174000";
+            w.write_single_uuid(id);
+            names = yardl.Map([id, "00000000-0000-0000-0000-000000000000"], ["first", "nil"]);
+            w.write_rec_with_uuids(test_model.RecordWithUuids(id=id, optional_id="00000000-0000-0000-0000-000000000000", related=[id, "ffffffff-0000-4000-8000-00000000000a"], names=names));
+            w.close();
+        end
+
+        function testDurations(testCase, format)
+            w = create_validating_writer(testCase, format, 'ProtocolWithDurations');
+            w.write_single_duration(milliseconds(-1500));
+            start = yardl.DateTime(int64(1700000000e9));
+            w.write_rec_with_durations(test_model.RecordWithDurations(...
+                acquisition_start=start, ...
+                acquisition_end=start + milliseconds(2500), ...
+                repetition_time=milliseconds(3), ...
+                timeout=hours(-1), ...
+                intervals=[milliseconds(1e-6), seconds(0), seconds(-30)]));
+            w.close();
+        end
+
+        function testRecursiveRecords(testCase, format)
+            w = create_validating_writer(testCase, format, 'ProtocolWithRecursiveRecords');
+            leaf = test_model.TreeNode(label="leaf");
+            w.write_tree(test_model.TreeNode(label="root", children=[...
+                test_model.TreeNode(label="a", children=[leaf, leaf]), ...
+                test_model.TreeNode(label="b")]));
+            w.write_list(test_model.LinkedListNode(value=int32(1), ...
+                next=test_model.LinkedListNode(value=int32(2), ...
+                    next=test_model.LinkedListNode(value=int32(3)))));
+            constant = test_model.Expression(name="const", ...
+                operand=test_model.Int32OrExpression.Int32(int32(42)));
+            negate = test_model.Expression(name="negate", ...
+                operand=test_model.Int32OrExpression.Expression(constant));
+            args = yardl.Map();
+            args.insert("x", constant);
+            args.insert("y", negate);
+            call = test_model.Expression(name="call", ...
+                operand=test_model.Int32OrExpression.Expression(negate), ...
+                arguments=args);
+            w.write_expressions([constant, negate, call]);
+            w.end_expressions();
+            w.close();
+        end
+
+        function testSubProtocols(testCase, format)
+            w = create_validating_writer(testCase, format, 'ProtocolWithSubProtocols');
+            w.write_header("header");
+            calibration = w.calibration();
+            calibration.write_gain(single(1.5));
+            calibration.write_samples([1.0, 2.0]);
+            calibration.write_samples(3.0);
+            calibration.end_samples();
+            calibration.close();
+            w.write_data(int32([1, 2, 3]));
+            w.end_data();
+            w.write_recalibration_gain(single(2.5));
+            w.end_recalibration_samples();
+            w.close();
+        end
+
+        function testHalfPrecision(testCase, format)
+            w = create_validating_writer(testCase, format, 'ProtocolWithHalfPrecision');
+            halves = single([1.5, -0.25, 65504]);
+            if format ~= "ndjson"
+                % JSON has no representation of infinity
+                halves(end+1) = Inf;
+            end
+            w.write_halves(halves);
+            brain_array = transpose(single([[1, 2.5, 1024]; [-0.5, 0, 7]]));
+            w.write_rec_with_halves(test_model.RecordWithHalfPrecision(half=single(0.5), brain=single(-2.5), half_vector=single([0, -2]), brain_array=brain_array));
+            w.close();
+        end
+
         function testOptionalVectors(testCase, format)
             w = create_validating_writer(testCase, format, 'OptionalVectors');
             w.write_record_with_optional_vector(test_model.RecordWithOptionalVector());
@@ -319,6 +419,16 @@ classdef RoundTripTest < matlab.unittest.TestCase
             w.write_records([r1, r2]);
             w.close();
 
+            % Keys that are not valid MATLAB identifiers
+            d = yardl.Map(["if", "a b", "1st", "x.y", "_"], int32([1, 2, 3, 4, 5]));
+            w = create_validating_writer(testCase, format, 'Maps');
+            w.write_string_to_int(d);
+            w.write_int_to_string(yardl.Map());
+            w.write_string_to_union(yardl.Map(["end", "a-b"], [test_model.StringOrInt32.Int32(1), test_model.StringOrInt32.String("2")]));
+            w.write_aliased_generic(d);
+            w.write_records([test_model.RecordWithMaps(set_1=yardl.Map(), set_2=yardl.Map())]);
+            w.close();
+
             % Now again for "empty" maps
             w = create_validating_writer(testCase, format, 'Maps');
             w.write_string_to_int(yardl.Map());
@@ -543,6 +653,32 @@ classdef RoundTripTest < matlab.unittest.TestCase
             w.close();
         end
 
+        function testGenericProtocols(testCase, format)
+            w = create_validating_writer(testCase, format, 'FloatImageStream');
+            w.write_header(test_model.GenericRecord(...
+                scalar_1=int32(1), ...
+                scalar_2=single(2.5), ...
+                vector_1=int32([3, 4]), ...
+                image_2=single([[1.5, 2.5]; [3.5, 4.5]]) ...
+            ));
+            w.write_images({single([[1, 2, 3]; [4, 5, 6]]), single([[7, 8]; [9, 10]])});
+            w.end_images();
+            w.write_background(test_model.Float32OrString.Float32(single(0.5)));
+            w.close();
+
+            w = create_validating_writer(testCase, format, 'ComplexImageStream');
+            w.write_header(test_model.GenericRecord(...
+                scalar_1=int32(1), ...
+                scalar_2=single(2 + 3j), ...
+                vector_1=int32([3, 4]), ...
+                image_2=single([1 + 2j, 3 + 4j]) ...
+            ));
+            w.write_images({single([[1 - 1j, 2 - 2j]; [3 - 3j, 4 - 4j]])});
+            w.end_images();
+            w.write_background(test_model.Complexfloat32OrString.String("transparent"));
+            w.close();
+        end
+
         function testAliases(testCase, format)
             w = create_validating_writer(testCase, format, 'Aliases');
             w.write_aliased_string("hello");
@@ -575,6 +711,17 @@ classdef RoundTripTest < matlab.unittest.TestCase
 
             w.close();
         end
+
+        function testKeywordSteps(testCase, format)
+            w = create_validating_writer(testCase, format, 'ProtocolWithKeywordSteps');
+            w.write_int([...
+                test_model.RecordWithKeywordFields(int="a", sizeof=int32([1, 2; 3, 4]), if_=test_model.EnumWithKeywordSymbols.TRY), ...
+                test_model.RecordWithKeywordFields(int="b", sizeof=int32([5, 6; 7, 8]), if_=test_model.EnumWithKeywordSymbols.CATCH) ...
+            ]);
+            w.end_int();
+            w.write_float(test_model.EnumWithKeywordSymbols.CATCH);
+            w.close();
+        end
     end
 end
 
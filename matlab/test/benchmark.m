@@ -9,8 +9,8 @@ function benchmark(varargin)
     scenario = parse_scenario(varargin{1});
     format = parse_format(varargin{2});
 
-    % Matlab support currently implemented for binary format only
-    if format ~= "binary"
+    % Matlab support currently implemented for binary and NDJSON formats only
+    if format == "hdf5"
         return
     end
 
@@ -46,15 +46,22 @@ from .types import (
     AliasedVectorOfGenericRecords,
     ArrayOrScalar,
     ArrayWithKeywordDimensionNames,
+    Complexfloat32OrString,
+    DEFAULT_GAIN,
     DaysOfWeek,
+    EnumWithAnnotations,
     EnumWithKeywordSymbols,
+    Expression,
+    Float32OrString,
     Fruits,
     GenericRecord,
     GenericUnion3,
     GenericUnion3Alternate,
     GenericUnionWithRepeatedTypeParameters,
+    IS_STRICT,
     Image,
     ImageFloatOrImageDouble,
+    Int32OrExpression,
     Int32OrFloat32,
     Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray,
     Int32OrRecordWithVlens,
@@ -64,20 +71,34 @@ from .types import (
     IntFixedArray,
     IntOrGenericRecordWithComputedFields,
     IntRank2Array,
+    LabeledHeader,
+    LinkedListNode,
+    MAX_CHANNELS,
     MapOrScalar,
+    Millimeters,
     MyTuple,
     NamedFixedNDArray,
     NamedNDArray,
     RecordContainingGenericRecords,
     RecordContainingNestedGenericRecords,
     RecordContainingVectorsOfAliases,
+    RecordHeader,
     RecordNotUsedInProtocol,
     RecordWithAliasedGenerics,
     RecordWithAliasedOptionalGenericField,
     RecordWithAliasedOptionalGenericUnionField,
+    RecordWithAnnotations,
     RecordWithArrays,
     RecordWithArraysSimpleSyntax,
+    RecordWithBase,
+    RecordWithBases,
+    RecordWithBytes,
     RecordWithComputedFields,
+    RecordWithConstants,
+    RecordWithConstrainedRecords,
+    RecordWithConstraints,
+    RecordWithDefaults,
+    RecordWithDurations,
     RecordWithDynamicNDArrays,
     RecordWithEnums,
     RecordWithFixedArrays,
@@ -89,6 +110,7 @@ from .types import (
     RecordWithGenericMaps,
     RecordWithGenericVectorOfRecords,
     RecordWithGenericVectors,
+    RecordWithHalfPrecision,
     RecordWithIntVectors,
     RecordWithKeywordFields,
     RecordWithMaps,
@@ -101,15 +123,19 @@ from .types import (
     RecordWithOptionalGenericField,
     RecordWithOptionalGenericUnionField,
     RecordWithOptionalVector,
+    RecordWithPatterns,
     RecordWithPrimitiveAliases,
     RecordWithPrimitives,
     RecordWithStrings,
     RecordWithUnionsOfContainers,
+    RecordWithUnits,
+    RecordWithUuids,
     RecordWithVectorOfTimes,
     RecordWithVectors,
     RecordWithVlenCollections,
     RecordWithVlens,
     RecordWithVlensFixedArray,
+    SAMPLES_PER_CHANNEL,
     SimpleAcquisition,
     SimpleEncodingCounters,
     SimpleRecord,
@@ -118,6 +144,7 @@ from .types import (
     SmallBenchmarkRecord,
     StringOrInt32,
     TextFormat,
+    TreeNode,
     TupleWithRecords,
     UInt64Enum,
     UOrV,
@@ -143,8 +170,12 @@ from .protocols import (
     BenchmarkSmallRecordWithOptionalsReaderBase,
     BenchmarkSmallRecordWithOptionalsWriterBase,
     BenchmarkSmallRecordWriterBase,
+    CalibrationReaderBase,
+    CalibrationWriterBase,
     ComplexArraysReaderBase,
     ComplexArraysWriterBase,
+    ComplexImageStreamReaderBase,
+    ComplexImageStreamWriterBase,
     DynamicNDArraysReaderBase,
     DynamicNDArraysWriterBase,
     EnumsReaderBase,
@@ -155,6 +186,8 @@ from .protocols import (
     FixedVectorsWriterBase,
     FlagsReaderBase,
     FlagsWriterBase,
+    FloatImageStreamReaderBase,
+    FloatImageStreamWriterBase,
     MapsReaderBase,
     MapsWriterBase,
     MultiDArraysReaderBase,
@@ -167,12 +200,26 @@ from .protocols import (
     NestedRecordsWriterBase,
     OptionalVectorsReaderBase,
     OptionalVectorsWriterBase,
+    ProtocolWithBytesReaderBase,
+    ProtocolWithBytesWriterBase,
     ProtocolWithComputedFieldsReaderBase,
     ProtocolWithComputedFieldsWriterBase,
+    ProtocolWithConstraintsReaderBase,
+    ProtocolWithConstraintsWriterBase,
+    ProtocolWithDurationsReaderBase,
+    ProtocolWithDurationsWriterBase,
+    ProtocolWithHalfPrecisionReaderBase,
+    ProtocolWithHalfPrecisionWriterBase,
     ProtocolWithKeywordStepsReaderBase,
     ProtocolWithKeywordStepsWriterBase,
     ProtocolWithOptionalDateReaderBase,
     ProtocolWithOptionalDateWriterBase,
+    ProtocolWithRecursiveRecordsReaderBase,
+    ProtocolWithRecursiveRecordsWriterBase,
+    ProtocolWithSubProtocolsReaderBase,
+    ProtocolWithSubProtocolsWriterBase,
+    ProtocolWithUuidsReaderBase,
+    ProtocolWithUuidsWriterBase,
     ScalarOptionalsReaderBase,
     ScalarOptionalsWriterBase,
     ScalarsReaderBase,
@@ -215,8 +262,12 @@ from .binary import (
     BinaryBenchmarkSmallRecordWithOptionalsReader,
     BinaryBenchmarkSmallRecordWithOptionalsWriter,
     BinaryBenchmarkSmallRecordWriter,
+    BinaryCalibrationReader,
+    BinaryCalibrationWriter,
     BinaryComplexArraysReader,
     BinaryComplexArraysWriter,
+    BinaryComplexImageStreamReader,
+    BinaryComplexImageStreamWriter,
     BinaryDynamicNDArraysReader,
     BinaryDynamicNDArraysWriter,
     BinaryEnumsReader,
@@ -227,6 +278,8 @@ from .binary import (
     BinaryFixedVectorsWriter,
     BinaryFlagsReader,
     BinaryFlagsWriter,
+    BinaryFloatImageStreamReader,
+    BinaryFloatImageStreamWriter,
     BinaryMapsReader,
     BinaryMapsWriter,
     BinaryMultiDArraysReader,
@@ -239,12 +292,26 @@ from .binary import (
     BinaryNestedRecordsWriter,
     BinaryOptionalVectorsReader,
     BinaryOptionalVectorsWriter,
+    BinaryProtocolWithBytesReader,
+    BinaryProtocolWithBytesWriter,
     BinaryProtocolWithComputedFieldsReader,
     BinaryProtocolWithComputedFieldsWriter,
+    BinaryProtocolWithConstraintsReader,
+    BinaryProtocolWithConstraintsWriter,
+    BinaryProtocolWithDurationsReader,
+    BinaryProtocolWithDurationsWriter,
+    BinaryProtocolWithHalfPrecisionReader,
+    BinaryProtocolWithHalfPrecisionWriter,
     BinaryProtocolWithKeywordStepsReader,
     BinaryProtocolWithKeywordStepsWriter,
     BinaryProtocolWithOptionalDateReader,
     BinaryProtocolWithOptionalDateWriter,
+    BinaryProtocolWithRecursiveRecordsReader,
+    BinaryProtocolWithRecursiveRecordsWriter,
+    BinaryProtocolWithSubProtocolsReader,
+    BinaryProtocolWithSubProtocolsWriter,
+    BinaryProtocolWithUuidsReader,
+    BinaryProtocolWithUuidsWriter,
     BinaryScalarOptionalsReader,
     BinaryScalarOptionalsWriter,
     BinaryScalarsReader,
@@ -287,8 +354,12 @@ from .ndjson import (
     NDJsonBenchmarkSmallRecordWithOptionalsReader,
     NDJsonBenchmarkSmallRecordWithOptionalsWriter,
     NDJsonBenchmarkSmallRecordWriter,
+    NDJsonCalibrationReader,
+    NDJsonCalibrationWriter,
     NDJsonComplexArraysReader,
     NDJsonComplexArraysWriter,
+    NDJsonComplexImageStreamReader,
+    NDJsonComplexImageStreamWriter,
     NDJsonDynamicNDArraysReader,
     NDJsonDynamicNDArraysWriter,
     NDJsonEnumsReader,
@@ -299,6 +370,8 @@ from .ndjson import (
     NDJsonFixedVectorsWriter,
     NDJsonFlagsReader,
     NDJsonFlagsWriter,
+    NDJsonFloatImageStreamReader,
+    NDJsonFloatImageStreamWriter,
     NDJsonMapsReader,
     NDJsonMapsWriter,
     NDJsonMultiDArraysReader,
@@ -311,12 +384,26 @@ from .ndjson import (
     NDJsonNestedRecordsWriter,
     NDJsonOptionalVectorsReader,
     NDJsonOptionalVectorsWriter,
+    NDJsonProtocolWithBytesReader,
+    NDJsonProtocolWithBytesWriter,
     NDJsonProtocolWithComputedFieldsReader,
     NDJsonProtocolWithComputedFieldsWriter,
+    NDJsonProtocolWithConstraintsReader,
+    NDJsonProtocolWithConstraintsWriter,
+    NDJsonProtocolWithDurationsReader,
+    NDJsonProtocolWithDurationsWriter,
+    NDJsonProtocolWithHalfPrecisionReader,
+    NDJsonProtocolWithHalfPrecisionWriter,
     NDJsonProtocolWithKeywordStepsReader,
     NDJsonProtocolWithKeywordStepsWriter,
     NDJsonProtocolWithOptionalDateReader,
     NDJsonProtocolWithOptionalDateWriter,
+    NDJsonProtocolWithRecursiveRecordsReader,
+    NDJsonProtocolWithRecursiveRecordsWriter,
+    NDJsonProtocolWithSubProtocolsReader,
+    NDJsonProtocolWithSubProtocolsWriter,
+    NDJsonProtocolWithUuidsReader,
+    NDJsonProtocolWithUuidsWriter,
     NDJsonScalarOptionalsReader,
     NDJsonScalarOptionalsWriter,
     NDJsonScalarsReader,
@@ -342,3 +429,93 @@ from .ndjson import (
     NDJsonVlensReader,
     NDJsonVlensWriter,
 )
+from .hdf5 import (
+    Hdf5AdvancedGenericsReader,
+    Hdf5AdvancedGenericsWriter,
+    Hdf5AliasesReader,
+    Hdf5AliasesWriter,
+    Hdf5BenchmarkFloat256x256Reader,
+    Hdf5BenchmarkFloat256x256Writer,
+    Hdf5BenchmarkFloatVlenReader,
+    Hdf5BenchmarkFloatVlenWriter,
+    Hdf5BenchmarkInt256x256Reader,
+    Hdf5BenchmarkInt256x256Writer,
+    Hdf5BenchmarkSimpleMrdReader,
+    Hdf5BenchmarkSimpleMrdWriter,
+    Hdf5BenchmarkSmallRecordReader,
+    Hdf5BenchmarkSmallRecordWithOptionalsReader,
+    Hdf5BenchmarkSmallRecordWithOptionalsWriter,
+    Hdf5BenchmarkSmallRecordWriter,
+    Hdf5CalibrationReader,
+    Hdf5CalibrationWriter,
+    Hdf5ComplexArraysReader,
+    Hdf5ComplexArraysWriter,
+    Hdf5ComplexImageStreamReader,
+    Hdf5ComplexImageStreamWriter,
+    Hdf5DynamicNDArraysReader,
+    Hdf5DynamicNDArraysWriter,
+    Hdf5EnumsReader,
+    Hdf5EnumsWriter,
+    Hdf5FixedArraysReader,
+    Hdf5FixedArraysWriter,
+    Hdf5FixedVectorsReader,
+    Hdf5FixedVectorsWriter,
+    Hdf5FlagsReader,
+    Hdf5FlagsWriter,
+    Hdf5FloatImageStreamReader,
+    Hdf5FloatImageStreamWriter,
+    Hdf5MapsReader,
+    Hdf5MapsWriter,
+    Hdf5MultiDArraysReader,
+    Hdf5MultiDArraysWriter,
+    Hdf5NDArraysReader,
+    Hdf5NDArraysSingleDimensionReader,
+    Hdf5NDArraysSingleDimensionWriter,
+    Hdf5NDArraysWriter,
+    Hdf5NestedRecordsReader,
+    Hdf5NestedRecordsWriter,
+    Hdf5OptionalVectorsReader,
+    Hdf5OptionalVectorsWriter,
+    Hdf5ProtocolWithBytesReader,
+    Hdf5ProtocolWithBytesWriter,
+    Hdf5ProtocolWithComputedFieldsReader,
+    Hdf5ProtocolWithComputedFieldsWriter,
+    Hdf5ProtocolWithConstraintsReader,
+    Hdf5ProtocolWithConstraintsWriter,
+    Hdf5ProtocolWithDurationsReader,
+    Hdf5ProtocolWithDurationsWriter,
+    Hdf5ProtocolWithHalfPrecisionReader,
+    Hdf5ProtocolWithHalfPrecisionWriter,
+    Hdf5ProtocolWithKeywordStepsReader,
+    Hdf5ProtocolWithKeywordStepsWriter,
+    Hdf5ProtocolWithOptionalDateReader,
+    Hdf5ProtocolWithOptionalDateWriter,
+    Hdf5ProtocolWithSubProtocolsReader,
+    Hdf5ProtocolWithSubProtocolsWriter,
+    Hdf5ProtocolWithUuidsReader,
+    Hdf5ProtocolWithUuidsWriter,
+    Hdf5ScalarOptionalsReader,
+    Hdf5ScalarOptionalsWriter,
+    Hdf5ScalarsReader,
+    Hdf5ScalarsWriter,
+    Hdf5SimpleGenericsReader,
+    Hdf5SimpleGenericsWriter,
+    Hdf5StateTestReader,
+    Hdf5StateTestWriter,
+    Hdf5StreamsOfAliasedUnionsReader,
+    Hdf5StreamsOfAliasedUnionsWriter,
+    Hdf5StreamsOfUnionsReader,
+    Hdf5StreamsOfUnionsWriter,
+    Hdf5StreamsReader,
+    Hdf5StreamsWriter,
+    Hdf5StringsReader,
+    Hdf5StringsWriter,
+    Hdf5SubarraysInRecordsReader,
+    Hdf5SubarraysInRecordsWriter,
+    Hdf5SubarraysReader,
+    Hdf5SubarraysWriter,
+    Hdf5UnionsReader,
+    Hdf5UnionsWriter,
+    Hdf5VlensReader,
+    Hdf5VlensWriter,
+)
@@ -24,3 +24,4 @@ from .types import (
 )
 from . import binary
 from . import ndjson
+from . import hdf5
@@ -320,6 +320,245 @@ class BinaryStringsReader(_binary.BinaryProtocolReader, StringsReaderBase):
     def _read_rec_with_string(self) -> RecordWithStrings:
         return RecordWithStringsSerializer().read(self._stream)
 
+class BinaryProtocolWithBytesWriter(_binary.BinaryProtocolWriter, ProtocolWithBytesWriterBase):
+    """Binary writer for the ProtocolWithBytes protocol."""
+
+
+    def __init__(self, stream: typing.Union[typing.BinaryIO, str]) -> None:
+        ProtocolWithBytesWriterBase.__init__(self)
+        _binary.BinaryProtocolWriter.__init__(self, stream, ProtocolWithBytesWriterBase.schema)
+
+    def _write_single_bytes(self, value: bytes) -> None:
+        _binary.bytes_serializer.write(self._stream, value)
+
+    def _write_rec_with_bytes(self, value: RecordWithBytes) -> None:
+        RecordWithBytesSerializer().write(self._stream, value)
+
+
+class BinaryProtocolWithBytesReader(_binary.BinaryProtocolReader, ProtocolWithBytesReaderBase):
+    """Binary writer for the ProtocolWithBytes protocol."""
+
+
+    def __init__(self, stream: typing.Union[io.BufferedReader, io.BytesIO, typing.BinaryIO, str], skip_completed_check: bool = False) -> None:
+        ProtocolWithBytesReaderBase.__init__(self, skip_completed_check)
+        _binary.BinaryProtocolReader.__init__(self, stream, ProtocolWithBytesReaderBase.schema)
+
+    def _read_single_bytes(self) -> bytes:
+        return _binary.bytes_serializer.read(self._stream)
+
+    def _read_rec_with_bytes(self) -> RecordWithBytes:
+        return RecordWithBytesSerializer().read(self._stream)
+
+class BinaryProtocolWithUuidsWriter(_binary.BinaryProtocolWriter, ProtocolWithUuidsWriterBase):
+    """Binary writer for the ProtocolWithUuids protocol."""
+
+
+    def __init__(self, stream: typing.Union[typing.BinaryIO, str]) -> None:
+        ProtocolWithUuidsWriterBase.__init__(self)
+        _binary.BinaryProtocolWriter.__init__(self, stream, ProtocolWithUuidsWriterBase.schema)
+
+    def _write_single_uuid(self, value: uuid.UUID) -> None:
+        _binary.uuid_serializer.write(self._stream, value)
+
+    def _write_rec_with_uuids(self, value: RecordWithUuids) -> None:
+        RecordWithUuidsSerializer().write(self._stream, value)
+
+
+class BinaryProtocolWithUuidsReader(_binary.BinaryProtocolReader, ProtocolWithUuidsReaderBase):
+    """Binary writer for the ProtocolWithUuids protocol."""
+
+
+    def __init__(self, stream: typing.Union[io.BufferedReader, io.BytesIO, typing.BinaryIO, str], skip_completed_check: bool = False) -> None:
+        ProtocolWithUuidsReaderBase.__init__(self, skip_completed_check)
+        _binary.BinaryProtocolReader.__init__(self, stream, ProtocolWithUuidsReaderBase.schema)
+
+    def _read_single_uuid(self) -> uuid.UUID:
+        return _binary.uuid_serializer.read(self._stream)
+
+    def _read_rec_with_uuids(self) -> RecordWithUuids:
+        return RecordWithUuidsSerializer().read(self._stream)
+
+class BinaryProtocolWithDurationsWriter(_binary.BinaryProtocolWriter, ProtocolWithDurationsWriterBase):
+    """Binary writer for the ProtocolWithDurations protocol."""
+
+
+    def __init__(self, stream: typing.Union[typing.BinaryIO, str]) -> None:
+        ProtocolWithDurationsWriterBase.__init__(self)
+        _binary.BinaryProtocolWriter.__init__(self, stream, ProtocolWithDurationsWriterBase.schema)
+
+    def _write_single_duration(self, value: np.timedelta64) -> None:
+        _binary.duration_serializer.write(self._stream, value)
+
+    def _write_rec_with_durations(self, value: RecordWithDurations) -> None:
+        RecordWithDurationsSerializer().write(self._stream, value)
+
+
+class BinaryProtocolWithDurationsReader(_binary.BinaryProtocolReader, ProtocolWithDurationsReaderBase):
+    """Binary writer for the ProtocolWithDurations protocol."""
+
+
+    def __init__(self, stream: typing.Union[io.BufferedReader, io.BytesIO, typing.BinaryIO, str], skip_completed_check: bool = False) -> None:
+        ProtocolWithDurationsReaderBase.__init__(self, skip_completed_check)
+        _binary.BinaryProtocolReader.__init__(self, stream, ProtocolWithDurationsReaderBase.schema)
+
+    def _read_single_duration(self) -> np.timedelta64:
+        return _binary.duration_serializer.read(self._stream)
+
+    def _read_rec_with_durations(self) -> RecordWithDurations:
+        return RecordWithDurationsSerializer().read(self._stream)
+
+class BinaryProtocolWithRecursiveRecordsWriter(_binary.BinaryProtocolWriter, ProtocolWithRecursiveRecordsWriterBase):
+    """Binary writer for the ProtocolWithRecursiveRecords protocol."""
+
+
+    def __init__(self, stream: typing.Union[typing.BinaryIO, str]) -> None:
+        ProtocolWithRecursiveRecordsWriterBase.__init__(self)
+        _binary.BinaryProtocolWriter.__init__(self, stream, ProtocolWithRecursiveRecordsWriterBase.schema)
+
+    def _write_tree(self, value: TreeNode) -> None:
+        TreeNodeSerializer().write(self._stream, value)
+
+    def _write_list(self, value: LinkedListNode) -> None:
+        LinkedListNodeSerializer().write(self._stream, value)
+
+    def _write_expressions(self, value: collections.abc.Iterable[Expression]) -> None:
+        _binary.StreamSerializer(ExpressionSerializer()).write(self._stream, value)
+
+
+class BinaryProtocolWithRecursiveRecordsReader(_binary.BinaryProtocolReader, ProtocolWithRecursiveRecordsReaderBase):
+    """Binary writer for the ProtocolWithRecursiveRecords protocol."""
+
+
+    def __init__(self, stream: typing.Union[io.BufferedReader, io.BytesIO, typing.BinaryIO, str], skip_completed_check: bool = False) -> None:
+        ProtocolWithRecursiveRecordsReaderBase.__init__(self, skip_completed_check)
+        _binary.BinaryProtocolReader.__init__(self, stream, ProtocolWithRecursiveRecordsReaderBase.schema)
+
+    def _read_tree(self) -> TreeNode:
+        return TreeNodeSerializer().read(self._stream)
+
+    def _read_list(self) -> LinkedListNode:
+        return LinkedListNodeSerializer().read(self._stream)
+
+    def _read_expressions(self) -> collections.abc.Iterable[Expression]:
+        return _binary.StreamSerializer(ExpressionSerializer()).read(self._stream)
+
+class BinaryCalibrationWriter(_binary.BinaryProtocolWriter, CalibrationWriterBase):
+    """Binary writer for the Calibration protocol.
+
+    A calibration phase shared by protocols
+    """
+
+
+    def __init__(self, stream: typing.Union[typing.BinaryIO, str]) -> None:
+        CalibrationWriterBase.__init__(self)
+        _binary.BinaryProtocolWriter.__init__(self, stream, CalibrationWriterBase.schema)
+
+    def _write_gain(self, value: yardl.Float32) -> None:
+        _binary.float32_serializer.write(self._stream, value)
+
+    def _write_samples(self, value: collections.abc.Iterable[yardl.Float64]) -> None:
+        _binary.StreamSerializer(_binary.float64_serializer).write(self._stream, value)
+
+
+class BinaryCalibrationReader(_binary.BinaryProtocolReader, CalibrationReaderBase):
+    """Binary writer for the Calibration protocol.
+
+    A calibration phase shared by protocols
+    """
+
+
+    def __init__(self, stream: typing.Union[io.BufferedReader, io.BytesIO, typing.BinaryIO, str], skip_completed_check: bool = False) -> None:
+        CalibrationReaderBase.__init__(self, skip_completed_check)
+        _binary.BinaryProtocolReader.__init__(self, stream, CalibrationReaderBase.schema)
+
+    def _read_gain(self) -> yardl.Float32:
+        return _binary.float32_serializer.read(self._stream)
+
+    def _read_samples(self) -> collections.abc.Iterable[yardl.Float64]:
+        return _binary.StreamSerializer(_binary.float64_serializer).read(self._stream)
+
+class BinaryProtocolWithSubProtocolsWriter(_binary.BinaryProtocolWriter, ProtocolWithSubProtocolsWriterBase):
+    """Binary writer for the ProtocolWithSubProtocols protocol."""
+
+
+    def __init__(self, stream: typing.Union[typing.BinaryIO, str]) -> None:
+        ProtocolWithSubProtocolsWriterBase.__init__(self)
+        _binary.BinaryProtocolWriter.__init__(self, stream, ProtocolWithSubProtocolsWriterBase.schema)
+
+    def _write_header(self, value: str) -> None:
+        _binary.string_serializer.write(self._stream, value)
+
+    def _write_calibration_gain(self, value: yardl.Float32) -> None:
+        _binary.float32_serializer.write(self._stream, value)
+
+    def _write_calibration_samples(self, value: collections.abc.Iterable[yardl.Float64]) -> None:
+        _binary.StreamSerializer(_binary.float64_serializer).write(self._stream, value)
+
+    def _write_data(self, value: collections.abc.Iterable[yardl.Int32]) -> None:
+        _binary.StreamSerializer(_binary.int32_serializer).write(self._stream, value)
+
+    def _write_recalibration_gain(self, value: yardl.Float32) -> None:
+        _binary.float32_serializer.write(self._stream, value)
+
+    def _write_recalibration_samples(self, value: collections.abc.Iterable[yardl.Float64]) -> None:
+        _binary.StreamSerializer(_binary.float64_serializer).write(self._stream, value)
+
+
+class BinaryProtocolWithSubProtocolsReader(_binary.BinaryProtocolReader, ProtocolWithSubProtocolsReaderBase):
+    """Binary writer for the ProtocolWithSubProtocols protocol."""
+
+
+    def __init__(self, stream: typing.Union[io.BufferedReader, io.BytesIO, typing.BinaryIO, str], skip_completed_check: bool = False) -> None:
+        ProtocolWithSubProtocolsReaderBase.__init__(self, skip_completed_check)
+        _binary.BinaryProtocolReader.__init__(self, stream, ProtocolWithSubProtocolsReaderBase.schema)
+
+    def _read_header(self) -> str:
+        return _binary.string_serializer.read(self._stream)
+
+    def _read_calibration_gain(self) -> yardl.Float32:
+        return _binary.float32_serializer.read(self._stream)
+
+    def _read_calibration_samples(self) -> collections.abc.Iterable[yardl.Float64]:
+        return _binary.StreamSerializer(_binary.float64_serializer).read(self._stream)
+
+    def _read_data(self) -> collections.abc.Iterable[yardl.Int32]:
+        return _binary.StreamSerializer(_binary.int32_serializer).read(self._stream)
+
+    def _read_recalibration_gain(self) -> yardl.Float32:
+        return _binary.float32_serializer.read(self._stream)
+
+    def _read_recalibration_samples(self) -> collections.abc.Iterable[yardl.Float64]:
+        return _binary.StreamSerializer(_binary.float64_serializer).read(self._stream)
+
+class BinaryProtocolWithHalfPrecisionWriter(_binary.BinaryProtocolWriter, ProtocolWithHalfPrecisionWriterBase):
+    """Binary writer for the ProtocolWithHalfPrecision protocol."""
+
+
+    def __init__(self, stream: typing.Union[typing.BinaryIO, str]) -> None:
+        ProtocolWithHalfPrecisionWriterBase.__init__(self)
+        _binary.BinaryProtocolWriter.__init__(self, stream, ProtocolWithHalfPrecisionWriterBase.schema)
+
+    def _write_halves(self, value: list[yardl.Float16]) -> None:
+        _binary.VectorSerializer(_binary.float16_serializer).write(self._stream, value)
+
+    def _write_rec_with_halves(self, value: RecordWithHalfPrecision) -> None:
+        RecordWithHalfPrecisionSerializer().write(self._stream, value)
+
+
+class BinaryProtocolWithHalfPrecisionReader(_binary.BinaryProtocolReader, ProtocolWithHalfPrecisionReaderBase):
+    """Binary writer for the ProtocolWithHalfPrecision protocol."""
+
+
+    def __init__(self, stream: typing.Union[io.BufferedReader, io.BytesIO, typing.BinaryIO, str], skip_completed_check: bool = False) -> None:
+        ProtocolWithHalfPrecisionReaderBase.__init__(self, skip_completed_check)
+        _binary.BinaryProtocolReader.__init__(self, stream, ProtocolWithHalfPrecisionReaderBase.schema)
+
+    def _read_halves(self) -> list[yardl.Float16]:
+        return _binary.VectorSerializer(_binary.float16_serializer).read(self._stream)
+
+    def _read_rec_with_halves(self) -> RecordWithHalfPrecision:
+        return RecordWithHalfPrecisionSerializer().read(self._stream)
+
 class BinaryOptionalVectorsWriter(_binary.BinaryProtocolWriter, OptionalVectorsWriterBase):
     """Binary writer for the OptionalVectors protocol."""
 
@@ -1111,6 +1350,88 @@ class BinaryAdvancedGenericsReader(_binary.BinaryProtocolReader, AdvancedGeneric
     def _read_tuple_of_vectors(self) -> MyTuple[list[yardl.Int32], list[yardl.Float32]]:
         return tuples.binary.TupleSerializer(_binary.VectorSerializer(_binary.int32_serializer), _binary.VectorSerializer(_binary.float32_serializer)).read(self._stream)
 
+class BinaryFloatImageStreamWriter(_binary.BinaryProtocolWriter, FloatImageStreamWriterBase):
+    """Binary writer for the FloatImageStream protocol.
+
+    A protocol that is instantiated for each pixel type
+    """
+
+
+    def __init__(self, stream: typing.Union[typing.BinaryIO, str]) -> None:
+        FloatImageStreamWriterBase.__init__(self)
+        _binary.BinaryProtocolWriter.__init__(self, stream, FloatImageStreamWriterBase.schema)
+
+    def _write_header(self, value: GenericRecord[yardl.Int32, yardl.Float32, np.float32]) -> None:
+        GenericRecordSerializer(_binary.int32_serializer, _binary.float32_serializer).write(self._stream, value)
+
+    def _write_images(self, value: collections.abc.Iterable[Image[np.float32]]) -> None:
+        _binary.StreamSerializer(_binary.NDArraySerializer(_binary.float32_serializer, 2)).write(self._stream, value)
+
+    def _write_background(self, value: Float32OrString) -> None:
+        _binary.UnionSerializer(Float32OrString, [(Float32OrString.Float32, _binary.float32_serializer), (Float32OrString.String, _binary.string_serializer)]).write(self._stream, value)
+
+
+class BinaryFloatImageStreamReader(_binary.BinaryProtocolReader, FloatImageStreamReaderBase):
+    """Binary writer for the FloatImageStream protocol.
+
+    A protocol that is instantiated for each pixel type
+    """
+
+
+    def __init__(self, stream: typing.Union[io.BufferedReader, io.BytesIO, typing.BinaryIO, str], skip_completed_check: bool = False) -> None:
+        FloatImageStreamReaderBase.__init__(self, skip_completed_check)
+        _binary.BinaryProtocolReader.__init__(self, stream, FloatImageStreamReaderBase.schema)
+
+    def _read_header(self) -> GenericRecord[yardl.Int32, yardl.Float32, np.float32]:
+        return GenericRecordSerializer(_binary.int32_serializer, _binary.float32_serializer).read(self._stream)
+
+    def _read_images(self) -> collections.abc.Iterable[Image[np.float32]]:
+        return _binary.StreamSerializer(_binary.NDArraySerializer(_binary.float32_serializer, 2)).read(self._stream)
+
+    def _read_background(self) -> Float32OrString:
+        return _binary.UnionSerializer(Float32OrString, [(Float32OrString.Float32, _binary.float32_serializer), (Float32OrString.String, _binary.string_serializer)]).read(self._stream)
+
+class BinaryComplexImageStreamWriter(_binary.BinaryProtocolWriter, ComplexImageStreamWriterBase):
+    """Binary writer for the ComplexImageStream protocol.
+
+    A protocol that is instantiated for each pixel type
+    """
+
+
+    def __init__(self, stream: typing.Union[typing.BinaryIO, str]) -> None:
+        ComplexImageStreamWriterBase.__init__(self)
+        _binary.BinaryProtocolWriter.__init__(self, stream, ComplexImageStreamWriterBase.schema)
+
+    def _write_header(self, value: GenericRecord[yardl.Int32, yardl.ComplexFloat, np.complex64]) -> None:
+        GenericRecordSerializer(_binary.int32_serializer, _binary.complexfloat32_serializer).write(self._stream, value)
+
+    def _write_images(self, value: collections.abc.Iterable[Image[np.complex64]]) -> None:
+        _binary.StreamSerializer(_binary.NDArraySerializer(_binary.complexfloat32_serializer, 2)).write(self._stream, value)
+
+    def _write_background(self, value: Complexfloat32OrString) -> None:
+        _binary.UnionSerializer(Complexfloat32OrString, [(Complexfloat32OrString.Complexfloat32, _binary.complexfloat32_serializer), (Complexfloat32OrString.String, _binary.string_serializer)]).write(self._stream, value)
+
+
+class BinaryComplexImageStreamReader(_binary.BinaryProtocolReader, ComplexImageStreamReaderBase):
+    """Binary writer for the ComplexImageStream protocol.
+
+    A protocol that is instantiated for each pixel type
+    """
+
+
+    def __init__(self, stream: typing.Union[io.BufferedReader, io.BytesIO, typing.BinaryIO, str], skip_completed_check: bool = False) -> None:
+        ComplexImageStreamReaderBase.__init__(self, skip_completed_check)
+        _binary.BinaryProtocolReader.__init__(self, stream, ComplexImageStreamReaderBase.schema)
+
+    def _read_header(self) -> GenericRecord[yardl.Int32, yardl.ComplexFloat, np.complex64]:
+        return GenericRecordSerializer(_binary.int32_serializer, _binary.complexfloat32_serializer).read(self._stream)
+
+    def _read_images(self) -> collections.abc.Iterable[Image[np.complex64]]:
+        return _binary.StreamSerializer(_binary.NDArraySerializer(_binary.complexfloat32_serializer, 2)).read(self._stream)
+
+    def _read_background(self) -> Complexfloat32OrString:
+        return _binary.UnionSerializer(Complexfloat32OrString, [(Complexfloat32OrString.Complexfloat32, _binary.complexfloat32_serializer), (Complexfloat32OrString.String, _binary.string_serializer)]).read(self._stream)
+
 class BinaryAliasesWriter(_binary.BinaryProtocolWriter, AliasesWriterBase):
     """Binary writer for the Aliases protocol."""
 
@@ -1246,6 +1567,35 @@ class BinaryProtocolWithComputedFieldsReader(_binary.BinaryProtocolReader, Proto
     def _read_record_with_computed_fields(self) -> RecordWithComputedFields:
         return RecordWithComputedFieldsSerializer().read(self._stream)
 
+class BinaryProtocolWithConstraintsWriter(_binary.BinaryProtocolWriter, ProtocolWithConstraintsWriterBase):
+    """Binary writer for the ProtocolWithConstraints protocol."""
+
+
+    def __init__(self, stream: typing.Union[typing.BinaryIO, str]) -> None:
+        ProtocolWithConstraintsWriterBase.__init__(self)
+        _binary.BinaryProtocolWriter.__init__(self, stream, ProtocolWithConstraintsWriterBase.schema)
+
+    def _write_record(self, value: RecordWithConstraints) -> None:
+        RecordWithConstraintsSerializer().write(self._stream, value)
+
+    def _write_records(self, value: collections.abc.Iterable[RecordWithConstrainedRecords]) -> None:
+        _binary.StreamSerializer(RecordWithConstrainedRecordsSerializer()).write(self._stream, value)
+
+
+class BinaryProtocolWithConstraintsReader(_binary.BinaryProtocolReader, ProtocolWithConstraintsReaderBase):
+    """Binary writer for the ProtocolWithConstraints protocol."""
+
+
+    def __init__(self, stream: typing.Union[io.BufferedReader, io.BytesIO, typing.BinaryIO, str], skip_completed_check: bool = False) -> None:
+        ProtocolWithConstraintsReaderBase.__init__(self, skip_completed_check)
+        _binary.BinaryProtocolReader.__init__(self, stream, ProtocolWithConstraintsReaderBase.schema)
+
+    def _read_record(self) -> RecordWithConstraints:
+        return RecordWithConstraintsSerializer().read(self._stream)
+
+    def _read_records(self) -> collections.abc.Iterable[RecordWithConstrainedRecords]:
+        return _binary.StreamSerializer(RecordWithConstrainedRecordsSerializer()).read(self._stream)
+
 class BinaryProtocolWithKeywordStepsWriter(_binary.BinaryProtocolWriter, ProtocolWithKeywordStepsWriterBase):
     """Binary writer for the ProtocolWithKeywordSteps protocol."""
 
@@ -1550,6 +1900,132 @@ class RecordWithStringsSerializer(_binary.RecordSerializer[RecordWithStrings]):
         return RecordWithStrings(a=field_values[0], b=field_values[1])
 
 
+class RecordWithBytesSerializer(_binary.RecordSerializer[RecordWithBytes]):
+    def __init__(self) -> None:
+        super().__init__([("data", _binary.bytes_serializer), ("optional_data", _binary.OptionalSerializer(_binary.bytes_serializer)), ("chunks", _binary.VectorSerializer(_binary.bytes_serializer))])
+
+    def write(self, stream: _binary.CodedOutputStream, value: RecordWithBytes) -> None:
+        if isinstance(value, np.void):
+            self.write_numpy(stream, value)
+            return
+        self._write(stream, value.data, value.optional_data, value.chunks)
+
+    def write_numpy(self, stream: _binary.CodedOutputStream, value: np.void) -> None:
+        self._write(stream, value['data'], value['optional_data'], value['chunks'])
+
+    def read(self, stream: _binary.CodedInputStream) -> RecordWithBytes:
+        field_values = self._read(stream)
+        return RecordWithBytes(data=field_values[0], optional_data=field_values[1], chunks=field_values[2])
+
+
+class RecordWithUuidsSerializer(_binary.RecordSerializer[RecordWithUuids]):
+    def __init__(self) -> None:
+        super().__init__([("id", _binary.uuid_serializer), ("optional_id", _binary.OptionalSerializer(_binary.uuid_serializer)), ("related", _binary.VectorSerializer(_binary.uuid_serializer)), ("names", _binary.MapSerializer(_binary.uuid_serializer, _binary.string_serializer))])
+
+    def write(self, stream: _binary.CodedOutputStream, value: RecordWithUuids) -> None:
+        if isinstance(value, np.void):
+            self.write_numpy(stream, value)
+            return
+        self._write(stream, value.id, value.optional_id, value.related, value.names)
+
+    def write_numpy(self, stream: _binary.CodedOutputStream, value: np.void) -> None:
+        self._write(stream, value['id'], value['optional_id'], value['related'], value['names'])
+
+    def read(self, stream: _binary.CodedInputStream) -> RecordWithUuids:
+        field_values = self._read(stream)
+        return RecordWithUuids(id=field_values[0], optional_id=field_values[1], related=field_values[2], names=field_values[3])
+
+
+class RecordWithDurationsSerializer(_binary.RecordSerializer[RecordWithDurations]):
+    def __init__(self) -> None:
+        super().__init__([("acquisition_start", _binary.datetime_serializer), ("acquisition_end", _binary.datetime_serializer), ("repetition_time", _binary.duration_serializer), ("timeout", _binary.OptionalSerializer(_binary.duration_serializer)), ("intervals", _binary.VectorSerializer(_binary.duration_serializer))])
+
+    def write(self, stream: _binary.CodedOutputStream, value: RecordWithDurations) -> None:
+        if isinstance(value, np.void):
+            self.write_numpy(stream, value)
+            return
+        self._write(stream, value.acquisition_start, value.acquisition_end, value.repetition_time, value.timeout, value.intervals)
+
+    def write_numpy(self, stream: _binary.CodedOutputStream, value: np.void) -> None:
+        self._write(stream, value['acquisition_start'], value['acquisition_end'], value['repetition_time'], value['timeout'], value['intervals'])
+
+    def read(self, stream: _binary.CodedInputStream) -> RecordWithDurations:
+        field_values = self._read(stream)
+        return RecordWithDurations(acquisition_start=field_values[0], acquisition_end=field_values[1], repetition_time=field_values[2], timeout=field_values[3], intervals=field_values[4])
+
+
+class TreeNodeSerializer(_binary.RecordSerializer[TreeNode]):
+    def __init__(self) -> None:
+        super().__init__([("label", _binary.string_serializer), ("children", _binary.VectorSerializer(_binary.RecursiveSerializer(lambda: TreeNodeSerializer())))])
+
+    def write(self, stream: _binary.CodedOutputStream, value: TreeNode) -> None:
+        if isinstance(value, np.void):
+            self.write_numpy(stream, value)
+            return
+        self._write(stream, value.label, value.children)
+
+    def write_numpy(self, stream: _binary.CodedOutputStream, value: np.void) -> None:
+        self._write(stream, value['label'], value['children'])
+
+    def read(self, stream: _binary.CodedInputStream) -> TreeNode:
+        field_values = self._read(stream)
+        return TreeNode(label=field_values[0], children=field_values[1])
+
+
+class LinkedListNodeSerializer(_binary.RecordSerializer[LinkedListNode]):
+    def __init__(self) -> None:
+        super().__init__([("value", _binary.int32_serializer), ("next", _binary.OptionalSerializer(_binary.RecursiveSerializer(lambda: LinkedListNodeSerializer())))])
+
+    def write(self, stream: _binary.CodedOutputStream, value: LinkedListNode) -> None:
+        if isinstance(value, np.void):
+            self.write_numpy(stream, value)
+            return
+        self._write(stream, value.value, value.next)
+
+    def write_numpy(self, stream: _binary.CodedOutputStream, value: np.void) -> None:
+        self._write(stream, value['value'], value['next'])
+
+    def read(self, stream: _binary.CodedInputStream) -> LinkedListNode:
+        field_values = self._read(stream)
+        return LinkedListNode(value=field_values[0], next=field_values[1])
+
+
+class ExpressionSerializer(_binary.RecordSerializer[Expression]):
+    def __init__(self) -> None:
+        super().__init__([("name", _binary.string_serializer), ("operand", _binary.UnionSerializer(Int32OrExpression, [(Int32OrExpression.Int32, _binary.int32_serializer), (Int32OrExpression.Expression, _binary.RecursiveSerializer(lambda: ExpressionSerializer()))])), ("arguments", _binary.MapSerializer(_binary.string_serializer, _binary.RecursiveSerializer(lambda: ExpressionSerializer())))])
+
+    def write(self, stream: _binary.CodedOutputStream, value: Expression) -> None:
+        if isinstance(value, np.void):
+            self.write_numpy(stream, value)
+            return
+        self._write(stream, value.name, value.operand, value.arguments)
+
+    def write_numpy(self, stream: _binary.CodedOutputStream, value: np.void) -> None:
+        self._write(stream, value['name'], value['operand'], value['arguments'])
+
+    def read(self, stream: _binary.CodedInputStream) -> Expression:
+        field_values = self._read(stream)
+        return Expression(name=field_values[0], operand=field_values[1], arguments=field_values[2])
+
+
+class RecordWithHalfPrecisionSerializer(_binary.RecordSerializer[RecordWithHalfPrecision]):
+    def __init__(self) -> None:
+        super().__init__([("half", _binary.float16_serializer), ("brain", _binary.bfloat16_serializer), ("half_vector", _binary.VectorSerializer(_binary.float16_serializer)), ("brain_array", _binary.DynamicNDArraySerializer(_binary.bfloat16_serializer))])
+
+    def write(self, stream: _binary.CodedOutputStream, value: RecordWithHalfPrecision) -> None:
+        if isinstance(value, np.void):
+            self.write_numpy(stream, value)
+            return
+        self._write(stream, value.half, value.brain, value.half_vector, value.brain_array)
+
+    def write_numpy(self, stream: _binary.CodedOutputStream, value: np.void) -> None:
+        self._write(stream, value['half'], value['brain'], value['half_vector'], value['brain_array'])
+
+    def read(self, stream: _binary.CodedInputStream) -> RecordWithHalfPrecision:
+        field_values = self._read(stream)
+        return RecordWithHalfPrecision(half=field_values[0], brain=field_values[1], half_vector=field_values[2], brain_array=field_values[3])
+
+
 class RecordWithOptionalVectorSerializer(_binary.RecordSerializer[RecordWithOptionalVector]):
     def __init__(self) -> None:
         super().__init__([("optional_vector", _binary.OptionalSerializer(_binary.VectorSerializer(_binary.int32_serializer)))])
@@ -2054,6 +2530,78 @@ class RecordWithComputedFieldsSerializer(_binary.RecordSerializer[RecordWithComp
         return RecordWithComputedFields(array_field=field_values[0], array_field_map_dimensions=field_values[1], dynamic_array_field=field_values[2], fixed_array_field=field_values[3], int_field=field_values[4], int8_field=field_values[5], uint8_field=field_values[6], int16_field=field_values[7], uint16_field=field_values[8], uint32_field=field_values[9], int64_field=field_values[10], uint64_field=field_values[11], size_field=field_values[12], float32_field=field_values[13], float64_field=field_values[14], complexfloat32_field=field_values[15], complexfloat64_field=field_values[16], string_field=field_values[17], tuple_field=field_values[18], vector_field=field_values[19], vector_of_vectors_field=field_values[20], fixed_vector_field=field_values[21], fixed_vector_of_vectors_field=field_values[22], optional_named_array=field_values[23], int_float_union=field_values[24], nullable_int_float_union=field_values[25], union_with_nested_generic_union=field_values[26], map_field=field_values[27])
 
 
+class RecordWithDefaultsSerializer(_binary.RecordSerializer[RecordWithDefaults]):
+    def __init__(self) -> None:
+        super().__init__([("int_field", _binary.int32_serializer), ("int8_field", _binary.int8_serializer), ("uint64_field", _binary.uint64_serializer), ("float32_field", _binary.float32_serializer), ("float64_field", _binary.float64_serializer), ("complexfloat64_field", _binary.complexfloat64_serializer), ("bool_field", _binary.bool_serializer), ("string_field", _binary.string_serializer), ("enum_field", _binary.EnumSerializer(_binary.int32_serializer, basic_types.Fruits)), ("no_default_field", _binary.int32_serializer)])
+
+    def write(self, stream: _binary.CodedOutputStream, value: RecordWithDefaults) -> None:
+        if isinstance(value, np.void):
+            self.write_numpy(stream, value)
+            return
+        self._write(stream, value.int_field, value.int8_field, value.uint64_field, value.float32_field, value.float64_field, value.complexfloat64_field, value.bool_field, value.string_field, value.enum_field, value.no_default_field)
+
+    def write_numpy(self, stream: _binary.CodedOutputStream, value: np.void) -> None:
+        self._write(stream, value['int_field'], value['int8_field'], value['uint64_field'], value['float32_field'], value['float64_field'], value['complexfloat64_field'], value['bool_field'], value['string_field'], value['enum_field'], value['no_default_field'])
+
+    def read(self, stream: _binary.CodedInputStream) -> RecordWithDefaults:
+        field_values = self._read(stream)
+        return RecordWithDefaults(int_field=field_values[0], int8_field=field_values[1], uint64_field=field_values[2], float32_field=field_values[3], float64_field=field_values[4], complexfloat64_field=field_values[5], bool_field=field_values[6], string_field=field_values[7], enum_field=field_values[8], no_default_field=field_values[9])
+
+
+class RecordWithConstraintsSerializer(_binary.RecordSerializer[RecordWithConstraints]):
+    def __init__(self) -> None:
+        super().__init__([("size", _binary.uint32_serializer), ("gain", _binary.float32_serializer), ("name", _binary.string_serializer), ("limit", _binary.OptionalSerializer(_binary.int32_serializer)), ("samples", _binary.VectorSerializer(_binary.int32_serializer))])
+
+    def write(self, stream: _binary.CodedOutputStream, value: RecordWithConstraints) -> None:
+        if isinstance(value, np.void):
+            self.write_numpy(stream, value)
+            return
+        self._write(stream, value.size, value.gain, value.name, value.limit, value.samples)
+
+    def write_numpy(self, stream: _binary.CodedOutputStream, value: np.void) -> None:
+        self._write(stream, value['size'], value['gain'], value['name'], value['limit'], value['samples'])
+
+    def read(self, stream: _binary.CodedInputStream) -> RecordWithConstraints:
+        field_values = self._read(stream)
+        return RecordWithConstraints(size=field_values[0], gain=field_values[1], name=field_values[2], limit=field_values[3], samples=field_values[4])
+
+
+class RecordWithPatternsSerializer(_binary.RecordSerializer[RecordWithPatterns]):
+    def __init__(self) -> None:
+        super().__init__([("alternation", _binary.string_serializer), ("word", _binary.string_serializer), ("code", _binary.string_serializer), ("escaped", _binary.string_serializer), ("capitalized", _binary.string_serializer)])
+
+    def write(self, stream: _binary.CodedOutputStream, value: RecordWithPatterns) -> None:
+        if isinstance(value, np.void):
+            self.write_numpy(stream, value)
+            return
+        self._write(stream, value.alternation, value.word, value.code, value.escaped, value.capitalized)
+
+    def write_numpy(self, stream: _binary.CodedOutputStream, value: np.void) -> None:
+        self._write(stream, value['alternation'], value['word'], value['code'], value['escaped'], value['capitalized'])
+
+    def read(self, stream: _binary.CodedInputStream) -> RecordWithPatterns:
+        field_values = self._read(stream)
+        return RecordWithPatterns(alternation=field_values[0], word=field_values[1], code=field_values[2], escaped=field_values[3], capitalized=field_values[4])
+
+
+class RecordWithConstrainedRecordsSerializer(_binary.RecordSerializer[RecordWithConstrainedRecords]):
+    def __init__(self) -> None:
+        super().__init__([("single", RecordWithConstraintsSerializer()), ("optional", _binary.OptionalSerializer(RecordWithConstraintsSerializer())), ("vector", _binary.VectorSerializer(RecordWithConstraintsSerializer()))])
+
+    def write(self, stream: _binary.CodedOutputStream, value: RecordWithConstrainedRecords) -> None:
+        if isinstance(value, np.void):
+            self.write_numpy(stream, value)
+            return
+        self._write(stream, value.single, value.optional, value.vector)
+
+    def write_numpy(self, stream: _binary.CodedOutputStream, value: np.void) -> None:
+        self._write(stream, value['single'], value['optional'], value['vector'])
+
+    def read(self, stream: _binary.CodedInputStream) -> RecordWithConstrainedRecords:
+        field_values = self._read(stream)
+        return RecordWithConstrainedRecords(single=field_values[0], optional=field_values[1], vector=field_values[2])
+
+
 class RecordNotUsedInProtocolSerializer(_binary.RecordSerializer[RecordNotUsedInProtocol]):
     def __init__(self) -> None:
         super().__init__([("u1", _binary.UnionSerializer(GenericUnion3, [(GenericUnion3.T, _binary.int32_serializer), (GenericUnion3.U, _binary.float32_serializer), (GenericUnion3.V, _binary.string_serializer)])), ("u2", _binary.UnionSerializer(GenericUnion3Alternate, [(GenericUnion3Alternate.U, _binary.int32_serializer), (GenericUnion3Alternate.V, _binary.float32_serializer), (GenericUnion3Alternate.W, _binary.string_serializer)]))])
@@ -2108,3 +2656,129 @@ class RecordWithOptionalDateSerializer(_binary.RecordSerializer[RecordWithOption
         return RecordWithOptionalDate(date_field=field_values[0])
 
 
+class RecordWithConstantsSerializer(_binary.RecordSerializer[RecordWithConstants]):
+    def __init__(self) -> None:
+        super().__init__([("channels", _binary.FixedVectorSerializer(_binary.int32_serializer, 4)), ("samples", _binary.FixedNDArraySerializer(_binary.float32_serializer, (4, 8,))), ("gain", _binary.float32_serializer)])
+
+    def write(self, stream: _binary.CodedOutputStream, value: RecordWithConstants) -> None:
+        if isinstance(value, np.void):
+            self.write_numpy(stream, value)
+            return
+        self._write(stream, value.channels, value.samples, value.gain)
+
+    def write_numpy(self, stream: _binary.CodedOutputStream, value: np.void) -> None:
+        self._write(stream, value['channels'], value['samples'], value['gain'])
+
+    def read(self, stream: _binary.CodedInputStream) -> RecordWithConstants:
+        field_values = self._read(stream)
+        return RecordWithConstants(channels=field_values[0], samples=field_values[1], gain=field_values[2])
+
+
+class RecordHeaderSerializer(_binary.RecordSerializer[RecordHeader]):
+    def __init__(self) -> None:
+        super().__init__([("version", _binary.uint32_serializer), ("name", _binary.string_serializer)])
+
+    def write(self, stream: _binary.CodedOutputStream, value: RecordHeader) -> None:
+        if isinstance(value, np.void):
+            self.write_numpy(stream, value)
+            return
+        self._write(stream, value.version, value.name)
+
+    def write_numpy(self, stream: _binary.CodedOutputStream, value: np.void) -> None:
+        self._write(stream, value['version'], value['name'])
+
+    def read(self, stream: _binary.CodedInputStream) -> RecordHeader:
+        field_values = self._read(stream)
+        return RecordHeader(version=field_values[0], name=field_values[1])
+
+
+class LabeledHeaderSerializer(typing.Generic[T, T_NP], _binary.RecordSerializer[LabeledHeader[T]]):
+    def __init__(self, t_serializer: _binary.TypeSerializer[T, T_NP]) -> None:
+        super().__init__([("label", t_serializer)])
+
+    def write(self, stream: _binary.CodedOutputStream, value: LabeledHeader[T]) -> None:
+        if isinstance(value, np.void):
+            self.write_numpy(stream, value)
+            return
+        self._write(stream, value.label)
+
+    def write_numpy(self, stream: _binary.CodedOutputStream, value: np.void) -> None:
+        self._write(stream, value['label'])
+
+    def read(self, stream: _binary.CodedInputStream) -> LabeledHeader[T]:
+        field_values = self._read(stream)
+        return LabeledHeader[T](label=field_values[0])
+
+
+class RecordWithBaseSerializer(_binary.RecordSerializer[RecordWithBase]):
+    def __init__(self) -> None:
+        super().__init__([("version", _binary.uint32_serializer), ("name", _binary.string_serializer), ("data", _binary.VectorSerializer(_binary.float32_serializer))])
+
+    def write(self, stream: _binary.CodedOutputStream, value: RecordWithBase) -> None:
+        if isinstance(value, np.void):
+            self.write_numpy(stream, value)
+            return
+        self._write(stream, value.version, value.name, value.data)
+
+    def write_numpy(self, stream: _binary.CodedOutputStream, value: np.void) -> None:
+        self._write(stream, value['version'], value['name'], value['data'])
+
+    def read(self, stream: _binary.CodedInputStream) -> RecordWithBase:
+        field_values = self._read(stream)
+        return RecordWithBase(version=field_values[0], name=field_values[1], data=field_values[2])
+
+
+class RecordWithBasesSerializer(_binary.RecordSerializer[RecordWithBases]):
+    def __init__(self) -> None:
+        super().__init__([("version", _binary.uint32_serializer), ("name", _binary.string_serializer), ("label", _binary.string_serializer), ("value", _binary.int32_serializer)])
+
+    def write(self, stream: _binary.CodedOutputStream, value: RecordWithBases) -> None:
+        if isinstance(value, np.void):
+            self.write_numpy(stream, value)
+            return
+        self._write(stream, value.version, value.name, value.label, value.value)
+
+    def write_numpy(self, stream: _binary.CodedOutputStream, value: np.void) -> None:
+        self._write(stream, value['version'], value['name'], value['label'], value['value'])
+
+    def read(self, stream: _binary.CodedInputStream) -> RecordWithBases:
+        field_values = self._read(stream)
+        return RecordWithBases(version=field_values[0], name=field_values[1], label=field_values[2], value=field_values[3])
+
+
+class RecordWithAnnotationsSerializer(_binary.RecordSerializer[RecordWithAnnotations]):
+    def __init__(self) -> None:
+        super().__init__([("thickness", _binary.float32_serializer), ("spacing", _binary.float32_serializer), ("label", _binary.string_serializer)])
+
+    def write(self, stream: _binary.CodedOutputStream, value: RecordWithAnnotations) -> None:
+        if isinstance(value, np.void):
+            self.write_numpy(stream, value)
+            return
+        self._write(stream, value.thickness, value.spacing, value.label)
+
+    def write_numpy(self, stream: _binary.CodedOutputStream, value: np.void) -> None:
+        self._write(stream, value['thickness'], value['spacing'], value['label'])
+
+    def read(self, stream: _binary.CodedInputStream) -> RecordWithAnnotations:
+        field_values = self._read(stream)
+        return RecordWithAnnotations(thickness=field_values[0], spacing=field_values[1], label=field_values[2])
+
+
+class RecordWithUnitsSerializer(_binary.RecordSerializer[RecordWithUnits]):
+    def __init__(self) -> None:
+        super().__init__([("length", _binary.float32_serializer), ("width", _binary.float32_serializer), ("durations", _binary.VectorSerializer(_binary.float64_serializer))])
+
+    def write(self, stream: _binary.CodedOutputStream, value: RecordWithUnits) -> None:
+        if isinstance(value, np.void):
+            self.write_numpy(stream, value)
+            return
+        self._write(stream, value.length, value.width, value.durations)
+
+    def write_numpy(self, stream: _binary.CodedOutputStream, value: np.void) -> None:
+        self._write(stream, value['length'], value['width'], value['durations'])
+
+    def read(self, stream: _binary.CodedInputStream) -> RecordWithUnits:
+        field_values = self._read(stream)
+        return RecordWithUnits(length=field_values[0], width=field_values[1], durations=field_values[2])
+
+
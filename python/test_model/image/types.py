@@ -9,6 +9,7 @@ import datetime
 import enum
 import types
 import typing
+import uuid
 
 import numpy as np
 import numpy.typing as npt
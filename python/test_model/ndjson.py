@@ -913,6 +913,376 @@ class RecordWithStringsConverter(_ndjson.JsonConverter[RecordWithStrings, np.voi
         ) # type:ignore 
 
 
+class RecordWithBytesConverter(_ndjson.JsonConverter[RecordWithBytes, np.void]):
+    def __init__(self) -> None:
+        self._data_converter = _ndjson.bytes_converter
+        self._optional_data_converter = _ndjson.OptionalConverter(_ndjson.bytes_converter)
+        self._chunks_converter = _ndjson.VectorConverter(_ndjson.bytes_converter)
+        super().__init__(np.dtype([
+            ("data", self._data_converter.overall_dtype()),
+            ("optional_data", self._optional_data_converter.overall_dtype()),
+            ("chunks", self._chunks_converter.overall_dtype()),
+        ]))
+
+    def to_json(self, value: RecordWithBytes) -> object:
+        if not isinstance(value, RecordWithBytes): # pyright: ignore [reportUnnecessaryIsInstance]
+            raise TypeError("Expected 'RecordWithBytes' instance")
+        json_object = {}
+
+        json_object["data"] = self._data_converter.to_json(value.data)
+        if value.optional_data is not None:
+            json_object["optionalData"] = self._optional_data_converter.to_json(value.optional_data)
+        json_object["chunks"] = self._chunks_converter.to_json(value.chunks)
+        return json_object
+
+    def numpy_to_json(self, value: np.void) -> object:
+        if not isinstance(value, np.void): # pyright: ignore [reportUnnecessaryIsInstance]
+            raise TypeError("Expected 'np.void' instance")
+        json_object = {}
+
+        json_object["data"] = self._data_converter.numpy_to_json(value["data"])
+        if (field_val := value["optional_data"]) is not None:
+            json_object["optionalData"] = self._optional_data_converter.numpy_to_json(field_val)
+        json_object["chunks"] = self._chunks_converter.numpy_to_json(value["chunks"])
+        return json_object
+
+    def from_json(self, json_object: object) -> RecordWithBytes:
+        if not isinstance(json_object, dict):
+            raise TypeError("Expected 'dict' instance")
+        return RecordWithBytes(
+            data=self._data_converter.from_json(json_object["data"],),
+            optional_data=self._optional_data_converter.from_json(json_object.get("optionalData")),
+            chunks=self._chunks_converter.from_json(json_object["chunks"],),
+        )
+
+    def from_json_to_numpy(self, json_object: object) -> np.void:
+        if not isinstance(json_object, dict):
+            raise TypeError("Expected 'dict' instance")
+        return (
+            self._data_converter.from_json_to_numpy(json_object["data"]),
+            self._optional_data_converter.from_json_to_numpy(json_object.get("optionalData")),
+            self._chunks_converter.from_json_to_numpy(json_object["chunks"]),
+        ) # type:ignore 
+
+
+class RecordWithUuidsConverter(_ndjson.JsonConverter[RecordWithUuids, np.void]):
+    def __init__(self) -> None:
+        self._id_converter = _ndjson.uuid_converter
+        self._optional_id_converter = _ndjson.OptionalConverter(_ndjson.uuid_converter)
+        self._related_converter = _ndjson.VectorConverter(_ndjson.uuid_converter)
+        self._names_converter = _ndjson.MapConverter(_ndjson.uuid_converter, _ndjson.string_converter)
+        super().__init__(np.dtype([
+            ("id", self._id_converter.overall_dtype()),
+            ("optional_id", self._optional_id_converter.overall_dtype()),
+            ("related", self._related_converter.overall_dtype()),
+            ("names", self._names_converter.overall_dtype()),
+        ]))
+
+    def to_json(self, value: RecordWithUuids) -> object:
+        if not isinstance(value, RecordWithUuids): # pyright: ignore [reportUnnecessaryIsInstance]
+            raise TypeError("Expected 'RecordWithUuids' instance")
+        json_object = {}
+
+        json_object["id"] = self._id_converter.to_json(value.id)
+        if value.optional_id is not None:
+            json_object["optionalId"] = self._optional_id_converter.to_json(value.optional_id)
+        json_object["related"] = self._related_converter.to_json(value.related)
+        json_object["names"] = self._names_converter.to_json(value.names)
+        return json_object
+
+    def numpy_to_json(self, value: np.void) -> object:
+        if not isinstance(value, np.void): # pyright: ignore [reportUnnecessaryIsInstance]
+            raise TypeError("Expected 'np.void' instance")
+        json_object = {}
+
+        json_object["id"] = self._id_converter.numpy_to_json(value["id"])
+        if (field_val := value["optional_id"]) is not None:
+            json_object["optionalId"] = self._optional_id_converter.numpy_to_json(field_val)
+        json_object["related"] = self._related_converter.numpy_to_json(value["related"])
+        json_object["names"] = self._names_converter.numpy_to_json(value["names"])
+        return json_object
+
+    def from_json(self, json_object: object) -> RecordWithUuids:
+        if not isinstance(json_object, dict):
+            raise TypeError("Expected 'dict' instance")
+        return RecordWithUuids(
+            id=self._id_converter.from_json(json_object["id"],),
+            optional_id=self._optional_id_converter.from_json(json_object.get("optionalId")),
+            related=self._related_converter.from_json(json_object["related"],),
+            names=self._names_converter.from_json(json_object["names"],),
+        )
+
+    def from_json_to_numpy(self, json_object: object) -> np.void:
+        if not isinstance(json_object, dict):
+            raise TypeError("Expected 'dict' instance")
+        return (
+            self._id_converter.from_json_to_numpy(json_object["id"]),
+            self._optional_id_converter.from_json_to_numpy(json_object.get("optionalId")),
+            self._related_converter.from_json_to_numpy(json_object["related"]),
+            self._names_converter.from_json_to_numpy(json_object["names"]),
+        ) # type:ignore 
+
+
+class RecordWithDurationsConverter(_ndjson.JsonConverter[RecordWithDurations, np.void]):
+    def __init__(self) -> None:
+        self._acquisition_start_converter = _ndjson.datetime_converter
+        self._acquisition_end_converter = _ndjson.datetime_converter
+        self._repetition_time_converter = _ndjson.duration_converter
+        self._timeout_converter = _ndjson.OptionalConverter(_ndjson.duration_converter)
+        self._intervals_converter = _ndjson.VectorConverter(_ndjson.duration_converter)
+        super().__init__(np.dtype([
+            ("acquisition_start", self._acquisition_start_converter.overall_dtype()),
+            ("acquisition_end", self._acquisition_end_converter.overall_dtype()),
+            ("repetition_time", self._repetition_time_converter.overall_dtype()),
+            ("timeout", self._timeout_converter.overall_dtype()),
+            ("intervals", self._intervals_converter.overall_dtype()),
+        ]))
+
+    def to_json(self, value: RecordWithDurations) -> object:
+        if not isinstance(value, RecordWithDurations): # pyright: ignore [reportUnnecessaryIsInstance]
+            raise TypeError("Expected 'RecordWithDurations' instance")
+        json_object = {}
+
+        json_object["acquisitionStart"] = self._acquisition_start_converter.to_json(value.acquisition_start)
+        json_object["acquisitionEnd"] = self._acquisition_end_converter.to_json(value.acquisition_end)
+        json_object["repetitionTime"] = self._repetition_time_converter.to_json(value.repetition_time)
+        if value.timeout is not None:
+            json_object["timeout"] = self._timeout_converter.to_json(value.timeout)
+        json_object["intervals"] = self._intervals_converter.to_json(value.intervals)
+        return json_object
+
+    def numpy_to_json(self, value: np.void) -> object:
+        if not isinstance(value, np.void): # pyright: ignore [reportUnnecessaryIsInstance]
+            raise TypeError("Expected 'np.void' instance")
+        json_object = {}
+
+        json_object["acquisitionStart"] = self._acquisition_start_converter.numpy_to_json(value["acquisition_start"])
+        json_object["acquisitionEnd"] = self._acquisition_end_converter.numpy_to_json(value["acquisition_end"])
+        json_object["repetitionTime"] = self._repetition_time_converter.numpy_to_json(value["repetition_time"])
+        if (field_val := value["timeout"]) is not None:
+            json_object["timeout"] = self._timeout_converter.numpy_to_json(field_val)
+        json_object["intervals"] = self._intervals_converter.numpy_to_json(value["intervals"])
+        return json_object
+
+    def from_json(self, json_object: object) -> RecordWithDurations:
+        if not isinstance(json_object, dict):
+            raise TypeError("Expected 'dict' instance")
+        return RecordWithDurations(
+            acquisition_start=self._acquisition_start_converter.from_json(json_object["acquisitionStart"],),
+            acquisition_end=self._acquisition_end_converter.from_json(json_object["acquisitionEnd"],),
+            repetition_time=self._repetition_time_converter.from_json(json_object["repetitionTime"],),
+            timeout=self._timeout_converter.from_json(json_object.get("timeout")),
+            intervals=self._intervals_converter.from_json(json_object["intervals"],),
+        )
+
+    def from_json_to_numpy(self, json_object: object) -> np.void:
+        if not isinstance(json_object, dict):
+            raise TypeError("Expected 'dict' instance")
+        return (
+            self._acquisition_start_converter.from_json_to_numpy(json_object["acquisitionStart"]),
+            self._acquisition_end_converter.from_json_to_numpy(json_object["acquisitionEnd"]),
+            self._repetition_time_converter.from_json_to_numpy(json_object["repetitionTime"]),
+            self._timeout_converter.from_json_to_numpy(json_object.get("timeout")),
+            self._intervals_converter.from_json_to_numpy(json_object["intervals"]),
+        ) # type:ignore 
+
+
+class TreeNodeConverter(_ndjson.JsonConverter[TreeNode, np.void]):
+    def __init__(self) -> None:
+        self._label_converter = _ndjson.string_converter
+        self._children_converter = _ndjson.VectorConverter(_ndjson.RecursiveConverter(lambda: TreeNodeConverter()))
+        super().__init__(np.dtype([
+            ("label", self._label_converter.overall_dtype()),
+            ("children", self._children_converter.overall_dtype()),
+        ]))
+
+    def to_json(self, value: TreeNode) -> object:
+        if not isinstance(value, TreeNode): # pyright: ignore [reportUnnecessaryIsInstance]
+            raise TypeError("Expected 'TreeNode' instance")
+        json_object = {}
+
+        json_object["label"] = self._label_converter.to_json(value.label)
+        json_object["children"] = self._children_converter.to_json(value.children)
+        return json_object
+
+    def numpy_to_json(self, value: np.void) -> object:
+        if not isinstance(value, np.void): # pyright: ignore [reportUnnecessaryIsInstance]
+            raise TypeError("Expected 'np.void' instance")
+        json_object = {}
+
+        json_object["label"] = self._label_converter.numpy_to_json(value["label"])
+        json_object["children"] = self._children_converter.numpy_to_json(value["children"])
+        return json_object
+
+    def from_json(self, json_object: object) -> TreeNode:
+        if not isinstance(json_object, dict):
+            raise TypeError("Expected 'dict' instance")
+        return TreeNode(
+            label=self._label_converter.from_json(json_object["label"],),
+            children=self._children_converter.from_json(json_object["children"],),
+        )
+
+    def from_json_to_numpy(self, json_object: object) -> np.void:
+        if not isinstance(json_object, dict):
+            raise TypeError("Expected 'dict' instance")
+        return (
+            self._label_converter.from_json_to_numpy(json_object["label"]),
+            self._children_converter.from_json_to_numpy(json_object["children"]),
+        ) # type:ignore 
+
+
+class LinkedListNodeConverter(_ndjson.JsonConverter[LinkedListNode, np.void]):
+    def __init__(self) -> None:
+        self._value_converter = _ndjson.int32_converter
+        self._next_converter = _ndjson.OptionalConverter(_ndjson.RecursiveConverter(lambda: LinkedListNodeConverter()))
+        super().__init__(np.dtype([
+            ("value", self._value_converter.overall_dtype()),
+            ("next", self._next_converter.overall_dtype()),
+        ]))
+
+    def to_json(self, value: LinkedListNode) -> object:
+        if not isinstance(value, LinkedListNode): # pyright: ignore [reportUnnecessaryIsInstance]
+            raise TypeError("Expected 'LinkedListNode' instance")
+        json_object = {}
+
+        json_object["value"] = self._value_converter.to_json(value.value)
+        if value.next is not None:
+            json_object["next"] = self._next_converter.to_json(value.next)
+        return json_object
+
+    def numpy_to_json(self, value: np.void) -> object:
+        if not isinstance(value, np.void): # pyright: ignore [reportUnnecessaryIsInstance]
+            raise TypeError("Expected 'np.void' instance")
+        json_object = {}
+
+        json_object["value"] = self._value_converter.numpy_to_json(value["value"])
+        if (field_val := value["next"]) is not None:
+            json_object["next"] = self._next_converter.numpy_to_json(field_val)
+        return json_object
+
+    def from_json(self, json_object: object) -> LinkedListNode:
+        if not isinstance(json_object, dict):
+            raise TypeError("Expected 'dict' instance")
+        return LinkedListNode(
+            value=self._value_converter.from_json(json_object["value"],),
+            next=self._next_converter.from_json(json_object.get("next")),
+        )
+
+    def from_json_to_numpy(self, json_object: object) -> np.void:
+        if not isinstance(json_object, dict):
+            raise TypeError("Expected 'dict' instance")
+        return (
+            self._value_converter.from_json_to_numpy(json_object["value"]),
+            self._next_converter.from_json_to_numpy(json_object.get("next")),
+        ) # type:ignore 
+
+
+class ExpressionConverter(_ndjson.JsonConverter[Expression, np.void]):
+    def __init__(self) -> None:
+        self._name_converter = _ndjson.string_converter
+        self._operand_converter = _ndjson.UnionConverter(Int32OrExpression, [(Int32OrExpression.Int32, _ndjson.int32_converter, [int, float]), (Int32OrExpression.Expression, _ndjson.RecursiveConverter(lambda: ExpressionConverter()), [dict])], True)
+        self._arguments_converter = _ndjson.MapConverter(_ndjson.string_converter, _ndjson.RecursiveConverter(lambda: ExpressionConverter()))
+        super().__init__(np.dtype([
+            ("name", self._name_converter.overall_dtype()),
+            ("operand", self._operand_converter.overall_dtype()),
+            ("arguments", self._arguments_converter.overall_dtype()),
+        ]))
+
+    def to_json(self, value: Expression) -> object:
+        if not isinstance(value, Expression): # pyright: ignore [reportUnnecessaryIsInstance]
+            raise TypeError("Expected 'Expression' instance")
+        json_object = {}
+
+        json_object["name"] = self._name_converter.to_json(value.name)
+        json_object["operand"] = self._operand_converter.to_json(value.operand)
+        json_object["arguments"] = self._arguments_converter.to_json(value.arguments)
+        return json_object
+
+    def numpy_to_json(self, value: np.void) -> object:
+        if not isinstance(value, np.void): # pyright: ignore [reportUnnecessaryIsInstance]
+            raise TypeError("Expected 'np.void' instance")
+        json_object = {}
+
+        json_object["name"] = self._name_converter.numpy_to_json(value["name"])
+        json_object["operand"] = self._operand_converter.numpy_to_json(value["operand"])
+        json_object["arguments"] = self._arguments_converter.numpy_to_json(value["arguments"])
+        return json_object
+
+    def from_json(self, json_object: object) -> Expression:
+        if not isinstance(json_object, dict):
+            raise TypeError("Expected 'dict' instance")
+        return Expression(
+            name=self._name_converter.from_json(json_object["name"],),
+            operand=self._operand_converter.from_json(json_object["operand"],),
+            arguments=self._arguments_converter.from_json(json_object["arguments"],),
+        )
+
+    def from_json_to_numpy(self, json_object: object) -> np.void:
+        if not isinstance(json_object, dict):
+            raise TypeError("Expected 'dict' instance")
+        return (
+            self._name_converter.from_json_to_numpy(json_object["name"]),
+            self._operand_converter.from_json_to_numpy(json_object["operand"]),
+            self._arguments_converter.from_json_to_numpy(json_object["arguments"]),
+        ) # type:ignore 
+
+
+class RecordWithHalfPrecisionConverter(_ndjson.JsonConverter[RecordWithHalfPrecision, np.void]):
+    def __init__(self) -> None:
+        self._half_converter = _ndjson.float16_converter
+        self._brain_converter = _ndjson.bfloat16_converter
+        self._half_vector_converter = _ndjson.VectorConverter(_ndjson.float16_converter)
+        self._brain_array_converter = _ndjson.DynamicNDArrayConverter(_ndjson.bfloat16_converter)
+        super().__init__(np.dtype([
+            ("half", self._half_converter.overall_dtype()),
+            ("brain", self._brain_converter.overall_dtype()),
+            ("half_vector", self._half_vector_converter.overall_dtype()),
+            ("brain_array", self._brain_array_converter.overall_dtype()),
+        ]))
+
+    def to_json(self, value: RecordWithHalfPrecision) -> object:
+        if not isinstance(value, RecordWithHalfPrecision): # pyright: ignore [reportUnnecessaryIsInstance]
+            raise TypeError("Expected 'RecordWithHalfPrecision' instance")
+        json_object = {}
+
+        json_object["half"] = self._half_converter.to_json(value.half)
+        json_object["brain"] = self._brain_converter.to_json(value.brain)
+        json_object["halfVector"] = self._half_vector_converter.to_json(value.half_vector)
+        json_object["brainArray"] = self._brain_array_converter.to_json(value.brain_array)
+        return json_object
+
+    def numpy_to_json(self, value: np.void) -> object:
+        if not isinstance(value, np.void): # pyright: ignore [reportUnnecessaryIsInstance]
+            raise TypeError("Expected 'np.void' instance")
+        json_object = {}
+
+        json_object["half"] = self._half_converter.numpy_to_json(value["half"])
+        json_object["brain"] = self._brain_converter.numpy_to_json(value["brain"])
+        json_object["halfVector"] = self._half_vector_converter.numpy_to_json(value["half_vector"])
+        json_object["brainArray"] = self._brain_array_converter.numpy_to_json(value["brain_array"])
+        return json_object
+
+    def from_json(self, json_object: object) -> RecordWithHalfPrecision:
+        if not isinstance(json_object, dict):
+            raise TypeError("Expected 'dict' instance")
+        return RecordWithHalfPrecision(
+            half=self._half_converter.from_json(json_object["half"],),
+            brain=self._brain_converter.from_json(json_object["brain"],),
+            half_vector=self._half_vector_converter.from_json(json_object["halfVector"],),
+            brain_array=self._brain_array_converter.from_json(json_object["brainArray"],),
+        )
+
+    def from_json_to_numpy(self, json_object: object) -> np.void:
+        if not isinstance(json_object, dict):
+            raise TypeError("Expected 'dict' instance")
+        return (
+            self._half_converter.from_json_to_numpy(json_object["half"]),
+            self._brain_converter.from_json_to_numpy(json_object["brain"]),
+            self._half_vector_converter.from_json_to_numpy(json_object["halfVector"]),
+            self._brain_array_converter.from_json_to_numpy(json_object["brainArray"]),
+        ) # type:ignore 
+
+
 class RecordWithOptionalVectorConverter(_ndjson.JsonConverter[RecordWithOptionalVector, np.void]):
     def __init__(self) -> None:
         self._optional_vector_converter = _ndjson.OptionalConverter(_ndjson.VectorConverter(_ndjson.int32_converter))
@@ -2470,22 +2840,46 @@ class RecordWithComputedFieldsConverter(_ndjson.JsonConverter[RecordWithComputed
         ) # type:ignore 
 
 
-class RecordNotUsedInProtocolConverter(_ndjson.JsonConverter[RecordNotUsedInProtocol, np.void]):
+class RecordWithDefaultsConverter(_ndjson.JsonConverter[RecordWithDefaults, np.void]):
     def __init__(self) -> None:
-        self._u1_converter = _ndjson.UnionConverter(GenericUnion3, [(GenericUnion3.T, _ndjson.int32_converter, [int, float]), (GenericUnion3.U, _ndjson.float32_converter, [int, float]), (GenericUnion3.V, _ndjson.string_converter, [str])], False)
-        self._u2_converter = _ndjson.UnionConverter(GenericUnion3Alternate, [(GenericUnion3Alternate.U, _ndjson.int32_converter, [int, float]), (GenericUnion3Alternate.V, _ndjson.float32_converter, [int, float]), (GenericUnion3Alternate.W, _ndjson.string_converter, [str])], False)
+        self._int_field_converter = _ndjson.int32_converter
+        self._int8_field_converter = _ndjson.int8_converter
+        self._uint64_field_converter = _ndjson.uint64_converter
+        self._float32_field_converter = _ndjson.float32_converter
+        self._float64_field_converter = _ndjson.float64_converter
+        self._complexfloat64_field_converter = _ndjson.complexfloat64_converter
+        self._bool_field_converter = _ndjson.bool_converter
+        self._string_field_converter = _ndjson.string_converter
+        self._enum_field_converter = _ndjson.EnumConverter(basic_types.Fruits, np.int32, basic_types.ndjson.fruits_name_to_value_map, basic_types.ndjson.fruits_value_to_name_map)
+        self._no_default_field_converter = _ndjson.int32_converter
         super().__init__(np.dtype([
-            ("u1", self._u1_converter.overall_dtype()),
-            ("u2", self._u2_converter.overall_dtype()),
+            ("int_field", self._int_field_converter.overall_dtype()),
+            ("int8_field", self._int8_field_converter.overall_dtype()),
+            ("uint64_field", self._uint64_field_converter.overall_dtype()),
+            ("float32_field", self._float32_field_converter.overall_dtype()),
+            ("float64_field", self._float64_field_converter.overall_dtype()),
+            ("complexfloat64_field", self._complexfloat64_field_converter.overall_dtype()),
+            ("bool_field", self._bool_field_converter.overall_dtype()),
+            ("string_field", self._string_field_converter.overall_dtype()),
+            ("enum_field", self._enum_field_converter.overall_dtype()),
+            ("no_default_field", self._no_default_field_converter.overall_dtype()),
         ]))
 
-    def to_json(self, value: RecordNotUsedInProtocol) -> object:
-        if not isinstance(value, RecordNotUsedInProtocol): # pyright: ignore [reportUnnecessaryIsInstance]
-            raise TypeError("Expected 'RecordNotUsedInProtocol' instance")
+    def to_json(self, value: RecordWithDefaults) -> object:
+        if not isinstance(value, RecordWithDefaults): # pyright: ignore [reportUnnecessaryIsInstance]
+            raise TypeError("Expected 'RecordWithDefaults' instance")
         json_object = {}
 
-        json_object["u1"] = self._u1_converter.to_json(value.u1)
-        json_object["u2"] = self._u2_converter.to_json(value.u2)
+        json_object["intField"] = self._int_field_converter.to_json(value.int_field)
+        json_object["int8Field"] = self._int8_field_converter.to_json(value.int8_field)
+        json_object["uint64Field"] = self._uint64_field_converter.to_json(value.uint64_field)
+        json_object["float32Field"] = self._float32_field_converter.to_json(value.float32_field)
+        json_object["float64Field"] = self._float64_field_converter.to_json(value.float64_field)
+        json_object["complexfloat64Field"] = self._complexfloat64_field_converter.to_json(value.complexfloat64_field)
+        json_object["boolField"] = self._bool_field_converter.to_json(value.bool_field)
+        json_object["stringField"] = self._string_field_converter.to_json(value.string_field)
+        json_object["enumField"] = self._enum_field_converter.to_json(value.enum_field)
+        json_object["noDefaultField"] = self._no_default_field_converter.to_json(value.no_default_field)
         return json_object
 
     def numpy_to_json(self, value: np.void) -> object:
@@ -2493,30 +2887,276 @@ class RecordNotUsedInProtocolConverter(_ndjson.JsonConverter[RecordNotUsedInProt
             raise TypeError("Expected 'np.void' instance")
         json_object = {}
 
-        json_object["u1"] = self._u1_converter.numpy_to_json(value["u1"])
-        json_object["u2"] = self._u2_converter.numpy_to_json(value["u2"])
+        json_object["intField"] = self._int_field_converter.numpy_to_json(value["int_field"])
+        json_object["int8Field"] = self._int8_field_converter.numpy_to_json(value["int8_field"])
+        json_object["uint64Field"] = self._uint64_field_converter.numpy_to_json(value["uint64_field"])
+        json_object["float32Field"] = self._float32_field_converter.numpy_to_json(value["float32_field"])
+        json_object["float64Field"] = self._float64_field_converter.numpy_to_json(value["float64_field"])
+        json_object["complexfloat64Field"] = self._complexfloat64_field_converter.numpy_to_json(value["complexfloat64_field"])
+        json_object["boolField"] = self._bool_field_converter.numpy_to_json(value["bool_field"])
+        json_object["stringField"] = self._string_field_converter.numpy_to_json(value["string_field"])
+        json_object["enumField"] = self._enum_field_converter.numpy_to_json(value["enum_field"])
+        json_object["noDefaultField"] = self._no_default_field_converter.numpy_to_json(value["no_default_field"])
         return json_object
 
-    def from_json(self, json_object: object) -> RecordNotUsedInProtocol:
+    def from_json(self, json_object: object) -> RecordWithDefaults:
         if not isinstance(json_object, dict):
             raise TypeError("Expected 'dict' instance")
-        return RecordNotUsedInProtocol(
-            u1=self._u1_converter.from_json(json_object["u1"],),
-            u2=self._u2_converter.from_json(json_object["u2"],),
+        return RecordWithDefaults(
+            int_field=self._int_field_converter.from_json(json_object["intField"],),
+            int8_field=self._int8_field_converter.from_json(json_object["int8Field"],),
+            uint64_field=self._uint64_field_converter.from_json(json_object["uint64Field"],),
+            float32_field=self._float32_field_converter.from_json(json_object["float32Field"],),
+            float64_field=self._float64_field_converter.from_json(json_object["float64Field"],),
+            complexfloat64_field=self._complexfloat64_field_converter.from_json(json_object["complexfloat64Field"],),
+            bool_field=self._bool_field_converter.from_json(json_object["boolField"],),
+            string_field=self._string_field_converter.from_json(json_object["stringField"],),
+            enum_field=self._enum_field_converter.from_json(json_object["enumField"],),
+            no_default_field=self._no_default_field_converter.from_json(json_object["noDefaultField"],),
         )
 
     def from_json_to_numpy(self, json_object: object) -> np.void:
         if not isinstance(json_object, dict):
             raise TypeError("Expected 'dict' instance")
         return (
-            self._u1_converter.from_json_to_numpy(json_object["u1"]),
-            self._u2_converter.from_json_to_numpy(json_object["u2"]),
+            self._int_field_converter.from_json_to_numpy(json_object["intField"]),
+            self._int8_field_converter.from_json_to_numpy(json_object["int8Field"]),
+            self._uint64_field_converter.from_json_to_numpy(json_object["uint64Field"]),
+            self._float32_field_converter.from_json_to_numpy(json_object["float32Field"]),
+            self._float64_field_converter.from_json_to_numpy(json_object["float64Field"]),
+            self._complexfloat64_field_converter.from_json_to_numpy(json_object["complexfloat64Field"]),
+            self._bool_field_converter.from_json_to_numpy(json_object["boolField"]),
+            self._string_field_converter.from_json_to_numpy(json_object["stringField"]),
+            self._enum_field_converter.from_json_to_numpy(json_object["enumField"]),
+            self._no_default_field_converter.from_json_to_numpy(json_object["noDefaultField"]),
         ) # type:ignore 
 
 
-enum_with_keyword_symbols_name_to_value_map = {
-    "try": EnumWithKeywordSymbols.TRY,
-    "catch": EnumWithKeywordSymbols.CATCH,
+class RecordWithConstraintsConverter(_ndjson.JsonConverter[RecordWithConstraints, np.void]):
+    def __init__(self) -> None:
+        self._size_converter = _ndjson.uint32_converter
+        self._gain_converter = _ndjson.float32_converter
+        self._name_converter = _ndjson.string_converter
+        self._limit_converter = _ndjson.OptionalConverter(_ndjson.int32_converter)
+        self._samples_converter = _ndjson.VectorConverter(_ndjson.int32_converter)
+        super().__init__(np.dtype([
+            ("size", self._size_converter.overall_dtype()),
+            ("gain", self._gain_converter.overall_dtype()),
+            ("name", self._name_converter.overall_dtype()),
+            ("limit", self._limit_converter.overall_dtype()),
+            ("samples", self._samples_converter.overall_dtype()),
+        ]))
+
+    def to_json(self, value: RecordWithConstraints) -> object:
+        if not isinstance(value, RecordWithConstraints): # pyright: ignore [reportUnnecessaryIsInstance]
+            raise TypeError("Expected 'RecordWithConstraints' instance")
+        json_object = {}
+
+        json_object["size"] = self._size_converter.to_json(value.size)
+        json_object["gain"] = self._gain_converter.to_json(value.gain)
+        json_object["name"] = self._name_converter.to_json(value.name)
+        if value.limit is not None:
+            json_object["limit"] = self._limit_converter.to_json(value.limit)
+        json_object["samples"] = self._samples_converter.to_json(value.samples)
+        return json_object
+
+    def numpy_to_json(self, value: np.void) -> object:
+        if not isinstance(value, np.void): # pyright: ignore [reportUnnecessaryIsInstance]
+            raise TypeError("Expected 'np.void' instance")
+        json_object = {}
+
+        json_object["size"] = self._size_converter.numpy_to_json(value["size"])
+        json_object["gain"] = self._gain_converter.numpy_to_json(value["gain"])
+        json_object["name"] = self._name_converter.numpy_to_json(value["name"])
+        if (field_val := value["limit"]) is not None:
+            json_object["limit"] = self._limit_converter.numpy_to_json(field_val)
+        json_object["samples"] = self._samples_converter.numpy_to_json(value["samples"])
+        return json_object
+
+    def from_json(self, json_object: object) -> RecordWithConstraints:
+        if not isinstance(json_object, dict):
+            raise TypeError("Expected 'dict' instance")
+        return RecordWithConstraints(
+            size=self._size_converter.from_json(json_object["size"],),
+            gain=self._gain_converter.from_json(json_object["gain"],),
+            name=self._name_converter.from_json(json_object["name"],),
+            limit=self._limit_converter.from_json(json_object.get("limit")),
+            samples=self._samples_converter.from_json(json_object["samples"],),
+        )
+
+    def from_json_to_numpy(self, json_object: object) -> np.void:
+        if not isinstance(json_object, dict):
+            raise TypeError("Expected 'dict' instance")
+        return (
+            self._size_converter.from_json_to_numpy(json_object["size"]),
+            self._gain_converter.from_json_to_numpy(json_object["gain"]),
+            self._name_converter.from_json_to_numpy(json_object["name"]),
+            self._limit_converter.from_json_to_numpy(json_object.get("limit")),
+            self._samples_converter.from_json_to_numpy(json_object["samples"]),
+        ) # type:ignore 
+
+
+class RecordWithPatternsConverter(_ndjson.JsonConverter[RecordWithPatterns, np.void]):
+    def __init__(self) -> None:
+        self._alternation_converter = _ndjson.string_converter
+        self._word_converter = _ndjson.string_converter
+        self._code_converter = _ndjson.string_converter
+        self._escaped_converter = _ndjson.string_converter
+        self._capitalized_converter = _ndjson.string_converter
+        super().__init__(np.dtype([
+            ("alternation", self._alternation_converter.overall_dtype()),
+            ("word", self._word_converter.overall_dtype()),
+            ("code", self._code_converter.overall_dtype()),
+            ("escaped", self._escaped_converter.overall_dtype()),
+            ("capitalized", self._capitalized_converter.overall_dtype()),
+        ]))
+
+    def to_json(self, value: RecordWithPatterns) -> object:
+        if not isinstance(value, RecordWithPatterns): # pyright: ignore [reportUnnecessaryIsInstance]
+            raise TypeError("Expected 'RecordWithPatterns' instance")
+        json_object = {}
+
+        json_object["alternation"] = self._alternation_converter.to_json(value.alternation)
+        json_object["word"] = self._word_converter.to_json(value.word)
+        json_object["code"] = self._code_converter.to_json(value.code)
+        json_object["escaped"] = self._escaped_converter.to_json(value.escaped)
+        json_object["capitalized"] = self._capitalized_converter.to_json(value.capitalized)
+        return json_object
+
+    def numpy_to_json(self, value: np.void) -> object:
+        if not isinstance(value, np.void): # pyright: ignore [reportUnnecessaryIsInstance]
+            raise TypeError("Expected 'np.void' instance")
+        json_object = {}
+
+        json_object["alternation"] = self._alternation_converter.numpy_to_json(value["alternation"])
+        json_object["word"] = self._word_converter.numpy_to_json(value["word"])
+        json_object["code"] = self._code_converter.numpy_to_json(value["code"])
+        json_object["escaped"] = self._escaped_converter.numpy_to_json(value["escaped"])
+        json_object["capitalized"] = self._capitalized_converter.numpy_to_json(value["capitalized"])
+        return json_object
+
+    def from_json(self, json_object: object) -> RecordWithPatterns:
+        if not isinstance(json_object, dict):
+            raise TypeError("Expected 'dict' instance")
+        return RecordWithPatterns(
+            alternation=self._alternation_converter.from_json(json_object["alternation"],),
+            word=self._word_converter.from_json(json_object["word"],),
+            code=self._code_converter.from_json(json_object["code"],),
+            escaped=self._escaped_converter.from_json(json_object["escaped"],),
+            capitalized=self._capitalized_converter.from_json(json_object["capitalized"],),
+        )
+
+    def from_json_to_numpy(self, json_object: object) -> np.void:
+        if not isinstance(json_object, dict):
+            raise TypeError("Expected 'dict' instance")
+        return (
+            self._alternation_converter.from_json_to_numpy(json_object["alternation"]),
+            self._word_converter.from_json_to_numpy(json_object["word"]),
+            self._code_converter.from_json_to_numpy(json_object["code"]),
+            self._escaped_converter.from_json_to_numpy(json_object["escaped"]),
+            self._capitalized_converter.from_json_to_numpy(json_object["capitalized"]),
+        ) # type:ignore 
+
+
+class RecordWithConstrainedRecordsConverter(_ndjson.JsonConverter[RecordWithConstrainedRecords, np.void]):
+    def __init__(self) -> None:
+        self._single_converter = RecordWithConstraintsConverter()
+        self._optional_converter = _ndjson.OptionalConverter(RecordWithConstraintsConverter())
+        self._vector_converter = _ndjson.VectorConverter(RecordWithConstraintsConverter())
+        super().__init__(np.dtype([
+            ("single", self._single_converter.overall_dtype()),
+            ("optional", self._optional_converter.overall_dtype()),
+            ("vector", self._vector_converter.overall_dtype()),
+        ]))
+
+    def to_json(self, value: RecordWithConstrainedRecords) -> object:
+        if not isinstance(value, RecordWithConstrainedRecords): # pyright: ignore [reportUnnecessaryIsInstance]
+            raise TypeError("Expected 'RecordWithConstrainedRecords' instance")
+        json_object = {}
+
+        json_object["single"] = self._single_converter.to_json(value.single)
+        if value.optional is not None:
+            json_object["optional"] = self._optional_converter.to_json(value.optional)
+        json_object["vector"] = self._vector_converter.to_json(value.vector)
+        return json_object
+
+    def numpy_to_json(self, value: np.void) -> object:
+        if not isinstance(value, np.void): # pyright: ignore [reportUnnecessaryIsInstance]
+            raise TypeError("Expected 'np.void' instance")
+        json_object = {}
+
+        json_object["single"] = self._single_converter.numpy_to_json(value["single"])
+        if (field_val := value["optional"]) is not None:
+            json_object["optional"] = self._optional_converter.numpy_to_json(field_val)
+        json_object["vector"] = self._vector_converter.numpy_to_json(value["vector"])
+        return json_object
+
+    def from_json(self, json_object: object) -> RecordWithConstrainedRecords:
+        if not isinstance(json_object, dict):
+            raise TypeError("Expected 'dict' instance")
+        return RecordWithConstrainedRecords(
+            single=self._single_converter.from_json(json_object["single"],),
+            optional=self._optional_converter.from_json(json_object.get("optional")),
+            vector=self._vector_converter.from_json(json_object["vector"],),
+        )
+
+    def from_json_to_numpy(self, json_object: object) -> np.void:
+        if not isinstance(json_object, dict):
+            raise TypeError("Expected 'dict' instance")
+        return (
+            self._single_converter.from_json_to_numpy(json_object["single"]),
+            self._optional_converter.from_json_to_numpy(json_object.get("optional")),
+            self._vector_converter.from_json_to_numpy(json_object["vector"]),
+        ) # type:ignore 
+
+
+class RecordNotUsedInProtocolConverter(_ndjson.JsonConverter[RecordNotUsedInProtocol, np.void]):
+    def __init__(self) -> None:
+        self._u1_converter = _ndjson.UnionConverter(GenericUnion3, [(GenericUnion3.T, _ndjson.int32_converter, [int, float]), (GenericUnion3.U, _ndjson.float32_converter, [int, float]), (GenericUnion3.V, _ndjson.string_converter, [str])], False)
+        self._u2_converter = _ndjson.UnionConverter(GenericUnion3Alternate, [(GenericUnion3Alternate.U, _ndjson.int32_converter, [int, float]), (GenericUnion3Alternate.V, _ndjson.float32_converter, [int, float]), (GenericUnion3Alternate.W, _ndjson.string_converter, [str])], False)
+        super().__init__(np.dtype([
+            ("u1", self._u1_converter.overall_dtype()),
+            ("u2", self._u2_converter.overall_dtype()),
+        ]))
+
+    def to_json(self, value: RecordNotUsedInProtocol) -> object:
+        if not isinstance(value, RecordNotUsedInProtocol): # pyright: ignore [reportUnnecessaryIsInstance]
+            raise TypeError("Expected 'RecordNotUsedInProtocol' instance")
+        json_object = {}
+
+        json_object["u1"] = self._u1_converter.to_json(value.u1)
+        json_object["u2"] = self._u2_converter.to_json(value.u2)
+        return json_object
+
+    def numpy_to_json(self, value: np.void) -> object:
+        if not isinstance(value, np.void): # pyright: ignore [reportUnnecessaryIsInstance]
+            raise TypeError("Expected 'np.void' instance")
+        json_object = {}
+
+        json_object["u1"] = self._u1_converter.numpy_to_json(value["u1"])
+        json_object["u2"] = self._u2_converter.numpy_to_json(value["u2"])
+        return json_object
+
+    def from_json(self, json_object: object) -> RecordNotUsedInProtocol:
+        if not isinstance(json_object, dict):
+            raise TypeError("Expected 'dict' instance")
+        return RecordNotUsedInProtocol(
+            u1=self._u1_converter.from_json(json_object["u1"],),
+            u2=self._u2_converter.from_json(json_object["u2"],),
+        )
+
+    def from_json_to_numpy(self, json_object: object) -> np.void:
+        if not isinstance(json_object, dict):
+            raise TypeError("Expected 'dict' instance")
+        return (
+            self._u1_converter.from_json_to_numpy(json_object["u1"]),
+            self._u2_converter.from_json_to_numpy(json_object["u2"]),
+        ) # type:ignore 
+
+
+enum_with_keyword_symbols_name_to_value_map = {
+    "try": EnumWithKeywordSymbols.TRY,
+    "catch": EnumWithKeywordSymbols.CATCH,
 }
 enum_with_keyword_symbols_value_to_name_map = {v: n for n, v in enum_with_keyword_symbols_name_to_value_map.items()}
 
@@ -2536,9 +3176,340 @@ class RecordWithKeywordFieldsConverter(_ndjson.JsonConverter[RecordWithKeywordFi
             raise TypeError("Expected 'RecordWithKeywordFields' instance")
         json_object = {}
 
-        json_object["int"] = self._int__converter.to_json(value.int_)
-        json_object["sizeof"] = self._sizeof_converter.to_json(value.sizeof)
-        json_object["if"] = self._if__converter.to_json(value.if_)
+        json_object["int"] = self._int__converter.to_json(value.int_)
+        json_object["sizeof"] = self._sizeof_converter.to_json(value.sizeof)
+        json_object["if"] = self._if__converter.to_json(value.if_)
+        return json_object
+
+    def numpy_to_json(self, value: np.void) -> object:
+        if not isinstance(value, np.void): # pyright: ignore [reportUnnecessaryIsInstance]
+            raise TypeError("Expected 'np.void' instance")
+        json_object = {}
+
+        json_object["int"] = self._int__converter.numpy_to_json(value["int_"])
+        json_object["sizeof"] = self._sizeof_converter.numpy_to_json(value["sizeof"])
+        json_object["if"] = self._if__converter.numpy_to_json(value["if_"])
+        return json_object
+
+    def from_json(self, json_object: object) -> RecordWithKeywordFields:
+        if not isinstance(json_object, dict):
+            raise TypeError("Expected 'dict' instance")
+        return RecordWithKeywordFields(
+            int_=self._int__converter.from_json(json_object["int"],),
+            sizeof=self._sizeof_converter.from_json(json_object["sizeof"],),
+            if_=self._if__converter.from_json(json_object["if"],),
+        )
+
+    def from_json_to_numpy(self, json_object: object) -> np.void:
+        if not isinstance(json_object, dict):
+            raise TypeError("Expected 'dict' instance")
+        return (
+            self._int__converter.from_json_to_numpy(json_object["int"]),
+            self._sizeof_converter.from_json_to_numpy(json_object["sizeof"]),
+            self._if__converter.from_json_to_numpy(json_object["if"]),
+        ) # type:ignore 
+
+
+class RecordWithOptionalDateConverter(_ndjson.JsonConverter[RecordWithOptionalDate, np.void]):
+    def __init__(self) -> None:
+        self._date_field_converter = _ndjson.OptionalConverter(_ndjson.date_converter)
+        super().__init__(np.dtype([
+            ("date_field", self._date_field_converter.overall_dtype()),
+        ]))
+
+    def to_json(self, value: RecordWithOptionalDate) -> object:
+        if not isinstance(value, RecordWithOptionalDate): # pyright: ignore [reportUnnecessaryIsInstance]
+            raise TypeError("Expected 'RecordWithOptionalDate' instance")
+        json_object = {}
+
+        if value.date_field is not None:
+            json_object["dateField"] = self._date_field_converter.to_json(value.date_field)
+        return json_object
+
+    def numpy_to_json(self, value: np.void) -> object:
+        if not isinstance(value, np.void): # pyright: ignore [reportUnnecessaryIsInstance]
+            raise TypeError("Expected 'np.void' instance")
+        json_object = {}
+
+        if (field_val := value["date_field"]) is not None:
+            json_object["dateField"] = self._date_field_converter.numpy_to_json(field_val)
+        return json_object
+
+    def from_json(self, json_object: object) -> RecordWithOptionalDate:
+        if not isinstance(json_object, dict):
+            raise TypeError("Expected 'dict' instance")
+        return RecordWithOptionalDate(
+            date_field=self._date_field_converter.from_json(json_object.get("dateField")),
+        )
+
+    def from_json_to_numpy(self, json_object: object) -> np.void:
+        if not isinstance(json_object, dict):
+            raise TypeError("Expected 'dict' instance")
+        return (
+            self._date_field_converter.from_json_to_numpy(json_object.get("dateField")),
+        ) # type:ignore 
+
+
+class RecordWithConstantsConverter(_ndjson.JsonConverter[RecordWithConstants, np.void]):
+    def __init__(self) -> None:
+        self._channels_converter = _ndjson.FixedVectorConverter(_ndjson.int32_converter, 4)
+        self._samples_converter = _ndjson.FixedNDArrayConverter(_ndjson.float32_converter, (4, 8,))
+        self._gain_converter = _ndjson.float32_converter
+        super().__init__(np.dtype([
+            ("channels", self._channels_converter.overall_dtype()),
+            ("samples", self._samples_converter.overall_dtype()),
+            ("gain", self._gain_converter.overall_dtype()),
+        ]))
+
+    def to_json(self, value: RecordWithConstants) -> object:
+        if not isinstance(value, RecordWithConstants): # pyright: ignore [reportUnnecessaryIsInstance]
+            raise TypeError("Expected 'RecordWithConstants' instance")
+        json_object = {}
+
+        json_object["channels"] = self._channels_converter.to_json(value.channels)
+        json_object["samples"] = self._samples_converter.to_json(value.samples)
+        json_object["gain"] = self._gain_converter.to_json(value.gain)
+        return json_object
+
+    def numpy_to_json(self, value: np.void) -> object:
+        if not isinstance(value, np.void): # pyright: ignore [reportUnnecessaryIsInstance]
+            raise TypeError("Expected 'np.void' instance")
+        json_object = {}
+
+        json_object["channels"] = self._channels_converter.numpy_to_json(value["channels"])
+        json_object["samples"] = self._samples_converter.numpy_to_json(value["samples"])
+        json_object["gain"] = self._gain_converter.numpy_to_json(value["gain"])
+        return json_object
+
+    def from_json(self, json_object: object) -> RecordWithConstants:
+        if not isinstance(json_object, dict):
+            raise TypeError("Expected 'dict' instance")
+        return RecordWithConstants(
+            channels=self._channels_converter.from_json(json_object["channels"],),
+            samples=self._samples_converter.from_json(json_object["samples"],),
+            gain=self._gain_converter.from_json(json_object["gain"],),
+        )
+
+    def from_json_to_numpy(self, json_object: object) -> np.void:
+        if not isinstance(json_object, dict):
+            raise TypeError("Expected 'dict' instance")
+        return (
+            self._channels_converter.from_json_to_numpy(json_object["channels"]),
+            self._samples_converter.from_json_to_numpy(json_object["samples"]),
+            self._gain_converter.from_json_to_numpy(json_object["gain"]),
+        ) # type:ignore 
+
+
+class RecordHeaderConverter(_ndjson.JsonConverter[RecordHeader, np.void]):
+    def __init__(self) -> None:
+        self._version_converter = _ndjson.uint32_converter
+        self._name_converter = _ndjson.string_converter
+        super().__init__(np.dtype([
+            ("version", self._version_converter.overall_dtype()),
+            ("name", self._name_converter.overall_dtype()),
+        ]))
+
+    def to_json(self, value: RecordHeader) -> object:
+        if not isinstance(value, RecordHeader): # pyright: ignore [reportUnnecessaryIsInstance]
+            raise TypeError("Expected 'RecordHeader' instance")
+        json_object = {}
+
+        json_object["version"] = self._version_converter.to_json(value.version)
+        json_object["name"] = self._name_converter.to_json(value.name)
+        return json_object
+
+    def numpy_to_json(self, value: np.void) -> object:
+        if not isinstance(value, np.void): # pyright: ignore [reportUnnecessaryIsInstance]
+            raise TypeError("Expected 'np.void' instance")
+        json_object = {}
+
+        json_object["version"] = self._version_converter.numpy_to_json(value["version"])
+        json_object["name"] = self._name_converter.numpy_to_json(value["name"])
+        return json_object
+
+    def from_json(self, json_object: object) -> RecordHeader:
+        if not isinstance(json_object, dict):
+            raise TypeError("Expected 'dict' instance")
+        return RecordHeader(
+            version=self._version_converter.from_json(json_object["version"],),
+            name=self._name_converter.from_json(json_object["name"],),
+        )
+
+    def from_json_to_numpy(self, json_object: object) -> np.void:
+        if not isinstance(json_object, dict):
+            raise TypeError("Expected 'dict' instance")
+        return (
+            self._version_converter.from_json_to_numpy(json_object["version"]),
+            self._name_converter.from_json_to_numpy(json_object["name"]),
+        ) # type:ignore 
+
+
+class LabeledHeaderConverter(typing.Generic[T, T_NP], _ndjson.JsonConverter[LabeledHeader[T], np.void]):
+    def __init__(self, t_converter: _ndjson.JsonConverter[T, T_NP]) -> None:
+        self._label_converter = t_converter
+        self._label_supports_none = self._label_converter.supports_none()
+        super().__init__(np.dtype([
+            ("label", self._label_converter.overall_dtype()),
+        ]))
+
+    def to_json(self, value: LabeledHeader[T]) -> object:
+        if not isinstance(value, LabeledHeader): # pyright: ignore [reportUnnecessaryIsInstance]
+            raise TypeError("Expected 'LabeledHeader[T]' instance")
+        json_object = {}
+
+        if not self._label_supports_none or value.label is not None:
+            json_object["label"] = self._label_converter.to_json(value.label)
+        return json_object
+
+    def numpy_to_json(self, value: np.void) -> object:
+        if not isinstance(value, np.void): # pyright: ignore [reportUnnecessaryIsInstance]
+            raise TypeError("Expected 'np.void' instance")
+        json_object = {}
+
+        if not self._label_supports_none or value["label"] is not None:
+            json_object["label"] = self._label_converter.numpy_to_json(value["label"])
+        return json_object
+
+    def from_json(self, json_object: object) -> LabeledHeader[T]:
+        if not isinstance(json_object, dict):
+            raise TypeError("Expected 'dict' instance")
+        return LabeledHeader[T](
+            label=self._label_converter.from_json(json_object.get("label") if self._label_supports_none else json_object["label"]),
+        )
+
+    def from_json_to_numpy(self, json_object: object) -> np.void:
+        if not isinstance(json_object, dict):
+            raise TypeError("Expected 'dict' instance")
+        return (
+            self._label_converter.from_json_to_numpy(json_object.get("label") if self._label_supports_none else json_object["label"]),
+        ) # type:ignore 
+
+
+class RecordWithBaseConverter(_ndjson.JsonConverter[RecordWithBase, np.void]):
+    def __init__(self) -> None:
+        self._version_converter = _ndjson.uint32_converter
+        self._name_converter = _ndjson.string_converter
+        self._data_converter = _ndjson.VectorConverter(_ndjson.float32_converter)
+        super().__init__(np.dtype([
+            ("version", self._version_converter.overall_dtype()),
+            ("name", self._name_converter.overall_dtype()),
+            ("data", self._data_converter.overall_dtype()),
+        ]))
+
+    def to_json(self, value: RecordWithBase) -> object:
+        if not isinstance(value, RecordWithBase): # pyright: ignore [reportUnnecessaryIsInstance]
+            raise TypeError("Expected 'RecordWithBase' instance")
+        json_object = {}
+
+        json_object["version"] = self._version_converter.to_json(value.version)
+        json_object["name"] = self._name_converter.to_json(value.name)
+        json_object["data"] = self._data_converter.to_json(value.data)
+        return json_object
+
+    def numpy_to_json(self, value: np.void) -> object:
+        if not isinstance(value, np.void): # pyright: ignore [reportUnnecessaryIsInstance]
+            raise TypeError("Expected 'np.void' instance")
+        json_object = {}
+
+        json_object["version"] = self._version_converter.numpy_to_json(value["version"])
+        json_object["name"] = self._name_converter.numpy_to_json(value["name"])
+        json_object["data"] = self._data_converter.numpy_to_json(value["data"])
+        return json_object
+
+    def from_json(self, json_object: object) -> RecordWithBase:
+        if not isinstance(json_object, dict):
+            raise TypeError("Expected 'dict' instance")
+        return RecordWithBase(
+            version=self._version_converter.from_json(json_object["version"],),
+            name=self._name_converter.from_json(json_object["name"],),
+            data=self._data_converter.from_json(json_object["data"],),
+        )
+
+    def from_json_to_numpy(self, json_object: object) -> np.void:
+        if not isinstance(json_object, dict):
+            raise TypeError("Expected 'dict' instance")
+        return (
+            self._version_converter.from_json_to_numpy(json_object["version"]),
+            self._name_converter.from_json_to_numpy(json_object["name"]),
+            self._data_converter.from_json_to_numpy(json_object["data"]),
+        ) # type:ignore 
+
+
+class RecordWithBasesConverter(_ndjson.JsonConverter[RecordWithBases, np.void]):
+    def __init__(self) -> None:
+        self._version_converter = _ndjson.uint32_converter
+        self._name_converter = _ndjson.string_converter
+        self._label_converter = _ndjson.string_converter
+        self._value_converter = _ndjson.int32_converter
+        super().__init__(np.dtype([
+            ("version", self._version_converter.overall_dtype()),
+            ("name", self._name_converter.overall_dtype()),
+            ("label", self._label_converter.overall_dtype()),
+            ("value", self._value_converter.overall_dtype()),
+        ]))
+
+    def to_json(self, value: RecordWithBases) -> object:
+        if not isinstance(value, RecordWithBases): # pyright: ignore [reportUnnecessaryIsInstance]
+            raise TypeError("Expected 'RecordWithBases' instance")
+        json_object = {}
+
+        json_object["version"] = self._version_converter.to_json(value.version)
+        json_object["name"] = self._name_converter.to_json(value.name)
+        json_object["label"] = self._label_converter.to_json(value.label)
+        json_object["value"] = self._value_converter.to_json(value.value)
+        return json_object
+
+    def numpy_to_json(self, value: np.void) -> object:
+        if not isinstance(value, np.void): # pyright: ignore [reportUnnecessaryIsInstance]
+            raise TypeError("Expected 'np.void' instance")
+        json_object = {}
+
+        json_object["version"] = self._version_converter.numpy_to_json(value["version"])
+        json_object["name"] = self._name_converter.numpy_to_json(value["name"])
+        json_object["label"] = self._label_converter.numpy_to_json(value["label"])
+        json_object["value"] = self._value_converter.numpy_to_json(value["value"])
+        return json_object
+
+    def from_json(self, json_object: object) -> RecordWithBases:
+        if not isinstance(json_object, dict):
+            raise TypeError("Expected 'dict' instance")
+        return RecordWithBases(
+            version=self._version_converter.from_json(json_object["version"],),
+            name=self._name_converter.from_json(json_object["name"],),
+            label=self._label_converter.from_json(json_object["label"],),
+            value=self._value_converter.from_json(json_object["value"],),
+        )
+
+    def from_json_to_numpy(self, json_object: object) -> np.void:
+        if not isinstance(json_object, dict):
+            raise TypeError("Expected 'dict' instance")
+        return (
+            self._version_converter.from_json_to_numpy(json_object["version"]),
+            self._name_converter.from_json_to_numpy(json_object["name"]),
+            self._label_converter.from_json_to_numpy(json_object["label"]),
+            self._value_converter.from_json_to_numpy(json_object["value"]),
+        ) # type:ignore 
+
+
+class RecordWithAnnotationsConverter(_ndjson.JsonConverter[RecordWithAnnotations, np.void]):
+    def __init__(self) -> None:
+        self._thickness_converter = _ndjson.float32_converter
+        self._spacing_converter = _ndjson.float32_converter
+        self._label_converter = _ndjson.string_converter
+        super().__init__(np.dtype([
+            ("thickness", self._thickness_converter.overall_dtype()),
+            ("spacing", self._spacing_converter.overall_dtype()),
+            ("label", self._label_converter.overall_dtype()),
+        ]))
+
+    def to_json(self, value: RecordWithAnnotations) -> object:
+        if not isinstance(value, RecordWithAnnotations): # pyright: ignore [reportUnnecessaryIsInstance]
+            raise TypeError("Expected 'RecordWithAnnotations' instance")
+        json_object = {}
+
+        json_object["thickness"] = self._thickness_converter.to_json(value.thickness)
+        json_object["spacing"] = self._spacing_converter.to_json(value.spacing)
+        json_object["label"] = self._label_converter.to_json(value.label)
         return json_object
 
     def numpy_to_json(self, value: np.void) -> object:
@@ -2546,44 +3517,55 @@ class RecordWithKeywordFieldsConverter(_ndjson.JsonConverter[RecordWithKeywordFi
             raise TypeError("Expected 'np.void' instance")
         json_object = {}
 
-        json_object["int"] = self._int__converter.numpy_to_json(value["int_"])
-        json_object["sizeof"] = self._sizeof_converter.numpy_to_json(value["sizeof"])
-        json_object["if"] = self._if__converter.numpy_to_json(value["if_"])
+        json_object["thickness"] = self._thickness_converter.numpy_to_json(value["thickness"])
+        json_object["spacing"] = self._spacing_converter.numpy_to_json(value["spacing"])
+        json_object["label"] = self._label_converter.numpy_to_json(value["label"])
         return json_object
 
-    def from_json(self, json_object: object) -> RecordWithKeywordFields:
+    def from_json(self, json_object: object) -> RecordWithAnnotations:
         if not isinstance(json_object, dict):
             raise TypeError("Expected 'dict' instance")
-        return RecordWithKeywordFields(
-            int_=self._int__converter.from_json(json_object["int"],),
-            sizeof=self._sizeof_converter.from_json(json_object["sizeof"],),
-            if_=self._if__converter.from_json(json_object["if"],),
+        return RecordWithAnnotations(
+            thickness=self._thickness_converter.from_json(json_object["thickness"],),
+            spacing=self._spacing_converter.from_json(json_object["spacing"],),
+            label=self._label_converter.from_json(json_object["label"],),
         )
 
     def from_json_to_numpy(self, json_object: object) -> np.void:
         if not isinstance(json_object, dict):
             raise TypeError("Expected 'dict' instance")
         return (
-            self._int__converter.from_json_to_numpy(json_object["int"]),
-            self._sizeof_converter.from_json_to_numpy(json_object["sizeof"]),
-            self._if__converter.from_json_to_numpy(json_object["if"]),
+            self._thickness_converter.from_json_to_numpy(json_object["thickness"]),
+            self._spacing_converter.from_json_to_numpy(json_object["spacing"]),
+            self._label_converter.from_json_to_numpy(json_object["label"]),
         ) # type:ignore 
 
 
-class RecordWithOptionalDateConverter(_ndjson.JsonConverter[RecordWithOptionalDate, np.void]):
+enum_with_annotations_name_to_value_map = {
+    "a": EnumWithAnnotations.A,
+    "b": EnumWithAnnotations.B,
+}
+enum_with_annotations_value_to_name_map = {v: n for n, v in enum_with_annotations_name_to_value_map.items()}
+
+class RecordWithUnitsConverter(_ndjson.JsonConverter[RecordWithUnits, np.void]):
     def __init__(self) -> None:
-        self._date_field_converter = _ndjson.OptionalConverter(_ndjson.date_converter)
+        self._length_converter = _ndjson.float32_converter
+        self._width_converter = _ndjson.float32_converter
+        self._durations_converter = _ndjson.VectorConverter(_ndjson.float64_converter)
         super().__init__(np.dtype([
-            ("date_field", self._date_field_converter.overall_dtype()),
+            ("length", self._length_converter.overall_dtype()),
+            ("width", self._width_converter.overall_dtype()),
+            ("durations", self._durations_converter.overall_dtype()),
         ]))
 
-    def to_json(self, value: RecordWithOptionalDate) -> object:
-        if not isinstance(value, RecordWithOptionalDate): # pyright: ignore [reportUnnecessaryIsInstance]
-            raise TypeError("Expected 'RecordWithOptionalDate' instance")
+    def to_json(self, value: RecordWithUnits) -> object:
+        if not isinstance(value, RecordWithUnits): # pyright: ignore [reportUnnecessaryIsInstance]
+            raise TypeError("Expected 'RecordWithUnits' instance")
         json_object = {}
 
-        if value.date_field is not None:
-            json_object["dateField"] = self._date_field_converter.to_json(value.date_field)
+        json_object["length"] = self._length_converter.to_json(value.length)
+        json_object["width"] = self._width_converter.to_json(value.width)
+        json_object["durations"] = self._durations_converter.to_json(value.durations)
         return json_object
 
     def numpy_to_json(self, value: np.void) -> object:
@@ -2591,22 +3573,27 @@ class RecordWithOptionalDateConverter(_ndjson.JsonConverter[RecordWithOptionalDa
             raise TypeError("Expected 'np.void' instance")
         json_object = {}
 
-        if (field_val := value["date_field"]) is not None:
-            json_object["dateField"] = self._date_field_converter.numpy_to_json(field_val)
+        json_object["length"] = self._length_converter.numpy_to_json(value["length"])
+        json_object["width"] = self._width_converter.numpy_to_json(value["width"])
+        json_object["durations"] = self._durations_converter.numpy_to_json(value["durations"])
         return json_object
 
-    def from_json(self, json_object: object) -> RecordWithOptionalDate:
+    def from_json(self, json_object: object) -> RecordWithUnits:
         if not isinstance(json_object, dict):
             raise TypeError("Expected 'dict' instance")
-        return RecordWithOptionalDate(
-            date_field=self._date_field_converter.from_json(json_object.get("dateField")),
+        return RecordWithUnits(
+            length=self._length_converter.from_json(json_object["length"],),
+            width=self._width_converter.from_json(json_object["width"],),
+            durations=self._durations_converter.from_json(json_object["durations"],),
         )
 
     def from_json_to_numpy(self, json_object: object) -> np.void:
         if not isinstance(json_object, dict):
             raise TypeError("Expected 'dict' instance")
         return (
-            self._date_field_converter.from_json_to_numpy(json_object.get("dateField")),
+            self._length_converter.from_json_to_numpy(json_object["length"]),
+            self._width_converter.from_json_to_numpy(json_object["width"]),
+            self._durations_converter.from_json_to_numpy(json_object["durations"]),
         ) # type:ignore 
 
 
@@ -2993,6 +3980,326 @@ class NDJsonStringsReader(_ndjson.NDJsonProtocolReader, StringsReaderBase):
         converter = RecordWithStringsConverter()
         return converter.from_json(json_object)
 
+class NDJsonProtocolWithBytesWriter(_ndjson.NDJsonProtocolWriter, ProtocolWithBytesWriterBase):
+    """NDJson writer for the ProtocolWithBytes protocol."""
+
+
+    def __init__(self, stream: typing.Union[typing.TextIO, str]) -> None:
+        ProtocolWithBytesWriterBase.__init__(self)
+        _ndjson.NDJsonProtocolWriter.__init__(self, stream, ProtocolWithBytesWriterBase.schema)
+
+    def _write_single_bytes(self, value: bytes) -> None:
+        converter = _ndjson.bytes_converter
+        json_value = converter.to_json(value)
+        self._write_json_line({"singleBytes": json_value})
+
+    def _write_rec_with_bytes(self, value: RecordWithBytes) -> None:
+        converter = RecordWithBytesConverter()
+        json_value = converter.to_json(value)
+        self._write_json_line({"recWithBytes": json_value})
+
+
+class NDJsonProtocolWithBytesReader(_ndjson.NDJsonProtocolReader, ProtocolWithBytesReaderBase):
+    """NDJson writer for the ProtocolWithBytes protocol."""
+
+
+    def __init__(self, stream: typing.Union[io.BufferedReader, typing.TextIO, str], skip_completed_check: bool = False) -> None:
+        ProtocolWithBytesReaderBase.__init__(self, skip_completed_check)
+        _ndjson.NDJsonProtocolReader.__init__(self, stream, ProtocolWithBytesReaderBase.schema)
+
+    def _read_single_bytes(self) -> bytes:
+        json_object = self._read_json_line("singleBytes", True)
+        converter = _ndjson.bytes_converter
+        return converter.from_json(json_object)
+
+    def _read_rec_with_bytes(self) -> RecordWithBytes:
+        json_object = self._read_json_line("recWithBytes", True)
+        converter = RecordWithBytesConverter()
+        return converter.from_json(json_object)
+
+class NDJsonProtocolWithUuidsWriter(_ndjson.NDJsonProtocolWriter, ProtocolWithUuidsWriterBase):
+    """NDJson writer for the ProtocolWithUuids protocol."""
+
+
+    def __init__(self, stream: typing.Union[typing.TextIO, str]) -> None:
+        ProtocolWithUuidsWriterBase.__init__(self)
+        _ndjson.NDJsonProtocolWriter.__init__(self, stream, ProtocolWithUuidsWriterBase.schema)
+
+    def _write_single_uuid(self, value: uuid.UUID) -> None:
+        converter = _ndjson.uuid_converter
+        json_value = converter.to_json(value)
+        self._write_json_line({"singleUuid": json_value})
+
+    def _write_rec_with_uuids(self, value: RecordWithUuids) -> None:
+        converter = RecordWithUuidsConverter()
+        json_value = converter.to_json(value)
+        self._write_json_line({"recWithUuids": json_value})
+
+
+class NDJsonProtocolWithUuidsReader(_ndjson.NDJsonProtocolReader, ProtocolWithUuidsReaderBase):
+    """NDJson writer for the ProtocolWithUuids protocol."""
+
+
+    def __init__(self, stream: typing.Union[io.BufferedReader, typing.TextIO, str], skip_completed_check: bool = False) -> None:
+        ProtocolWithUuidsReaderBase.__init__(self, skip_completed_check)
+        _ndjson.NDJsonProtocolReader.__init__(self, stream, ProtocolWithUuidsReaderBase.schema)
+
+    def _read_single_uuid(self) -> uuid.UUID:
+        json_object = self._read_json_line("singleUuid", True)
+        converter = _ndjson.uuid_converter
+        return converter.from_json(json_object)
+
+    def _read_rec_with_uuids(self) -> RecordWithUuids:
+        json_object = self._read_json_line("recWithUuids", True)
+        converter = RecordWithUuidsConverter()
+        return converter.from_json(json_object)
+
+class NDJsonProtocolWithDurationsWriter(_ndjson.NDJsonProtocolWriter, ProtocolWithDurationsWriterBase):
+    """NDJson writer for the ProtocolWithDurations protocol."""
+
+
+    def __init__(self, stream: typing.Union[typing.TextIO, str]) -> None:
+        ProtocolWithDurationsWriterBase.__init__(self)
+        _ndjson.NDJsonProtocolWriter.__init__(self, stream, ProtocolWithDurationsWriterBase.schema)
+
+    def _write_single_duration(self, value: np.timedelta64) -> None:
+        converter = _ndjson.duration_converter
+        json_value = converter.to_json(value)
+        self._write_json_line({"singleDuration": json_value})
+
+    def _write_rec_with_durations(self, value: RecordWithDurations) -> None:
+        converter = RecordWithDurationsConverter()
+        json_value = converter.to_json(value)
+        self._write_json_line({"recWithDurations": json_value})
+
+
+class NDJsonProtocolWithDurationsReader(_ndjson.NDJsonProtocolReader, ProtocolWithDurationsReaderBase):
+    """NDJson writer for the ProtocolWithDurations protocol."""
+
+
+    def __init__(self, stream: typing.Union[io.BufferedReader, typing.TextIO, str], skip_completed_check: bool = False) -> None:
+        ProtocolWithDurationsReaderBase.__init__(self, skip_completed_check)
+        _ndjson.NDJsonProtocolReader.__init__(self, stream, ProtocolWithDurationsReaderBase.schema)
+
+    def _read_single_duration(self) -> np.timedelta64:
+        json_object = self._read_json_line("singleDuration", True)
+        converter = _ndjson.duration_converter
+        return converter.from_json(json_object)
+
+    def _read_rec_with_durations(self) -> RecordWithDurations:
+        json_object = self._read_json_line("recWithDurations", True)
+        converter = RecordWithDurationsConverter()
+        return converter.from_json(json_object)
+
+class NDJsonProtocolWithRecursiveRecordsWriter(_ndjson.NDJsonProtocolWriter, ProtocolWithRecursiveRecordsWriterBase):
+    """NDJson writer for the ProtocolWithRecursiveRecords protocol."""
+
+
+    def __init__(self, stream: typing.Union[typing.TextIO, str]) -> None:
+        ProtocolWithRecursiveRecordsWriterBase.__init__(self)
+        _ndjson.NDJsonProtocolWriter.__init__(self, stream, ProtocolWithRecursiveRecordsWriterBase.schema)
+
+    def _write_tree(self, value: TreeNode) -> None:
+        converter = TreeNodeConverter()
+        json_value = converter.to_json(value)
+        self._write_json_line({"tree": json_value})
+
+    def _write_list(self, value: LinkedListNode) -> None:
+        converter = LinkedListNodeConverter()
+        json_value = converter.to_json(value)
+        self._write_json_line({"list": json_value})
+
+    def _write_expressions(self, value: collections.abc.Iterable[Expression]) -> None:
+        converter = ExpressionConverter()
+        for item in value:
+            json_item = converter.to_json(item)
+            self._write_json_line({"expressions": json_item})
+
+
+class NDJsonProtocolWithRecursiveRecordsReader(_ndjson.NDJsonProtocolReader, ProtocolWithRecursiveRecordsReaderBase):
+    """NDJson writer for the ProtocolWithRecursiveRecords protocol."""
+
+
+    def __init__(self, stream: typing.Union[io.BufferedReader, typing.TextIO, str], skip_completed_check: bool = False) -> None:
+        ProtocolWithRecursiveRecordsReaderBase.__init__(self, skip_completed_check)
+        _ndjson.NDJsonProtocolReader.__init__(self, stream, ProtocolWithRecursiveRecordsReaderBase.schema)
+
+    def _read_tree(self) -> TreeNode:
+        json_object = self._read_json_line("tree", True)
+        converter = TreeNodeConverter()
+        return converter.from_json(json_object)
+
+    def _read_list(self) -> LinkedListNode:
+        json_object = self._read_json_line("list", True)
+        converter = LinkedListNodeConverter()
+        return converter.from_json(json_object)
+
+    def _read_expressions(self) -> collections.abc.Iterable[Expression]:
+        converter = ExpressionConverter()
+        while (json_object := self._read_json_line("expressions", False)) is not _ndjson.MISSING_SENTINEL:
+            yield converter.from_json(json_object)
+
+class NDJsonCalibrationWriter(_ndjson.NDJsonProtocolWriter, CalibrationWriterBase):
+    """NDJson writer for the Calibration protocol.
+
+    A calibration phase shared by protocols
+    """
+
+
+    def __init__(self, stream: typing.Union[typing.TextIO, str]) -> None:
+        CalibrationWriterBase.__init__(self)
+        _ndjson.NDJsonProtocolWriter.__init__(self, stream, CalibrationWriterBase.schema)
+
+    def _write_gain(self, value: yardl.Float32) -> None:
+        converter = _ndjson.float32_converter
+        json_value = converter.to_json(value)
+        self._write_json_line({"gain": json_value})
+
+    def _write_samples(self, value: collections.abc.Iterable[yardl.Float64]) -> None:
+        converter = _ndjson.float64_converter
+        for item in value:
+            json_item = converter.to_json(item)
+            self._write_json_line({"samples": json_item})
+
+
+class NDJsonCalibrationReader(_ndjson.NDJsonProtocolReader, CalibrationReaderBase):
+    """NDJson writer for the Calibration protocol.
+
+    A calibration phase shared by protocols
+    """
+
+
+    def __init__(self, stream: typing.Union[io.BufferedReader, typing.TextIO, str], skip_completed_check: bool = False) -> None:
+        CalibrationReaderBase.__init__(self, skip_completed_check)
+        _ndjson.NDJsonProtocolReader.__init__(self, stream, CalibrationReaderBase.schema)
+
+    def _read_gain(self) -> yardl.Float32:
+        json_object = self._read_json_line("gain", True)
+        converter = _ndjson.float32_converter
+        return converter.from_json(json_object)
+
+    def _read_samples(self) -> collections.abc.Iterable[yardl.Float64]:
+        converter = _ndjson.float64_converter
+        while (json_object := self._read_json_line("samples", False)) is not _ndjson.MISSING_SENTINEL:
+            yield converter.from_json(json_object)
+
+class NDJsonProtocolWithSubProtocolsWriter(_ndjson.NDJsonProtocolWriter, ProtocolWithSubProtocolsWriterBase):
+    """NDJson writer for the ProtocolWithSubProtocols protocol."""
+
+
+    def __init__(self, stream: typing.Union[typing.TextIO, str]) -> None:
+        ProtocolWithSubProtocolsWriterBase.__init__(self)
+        _ndjson.NDJsonProtocolWriter.__init__(self, stream, ProtocolWithSubProtocolsWriterBase.schema)
+
+    def _write_header(self, value: str) -> None:
+        converter = _ndjson.string_converter
+        json_value = converter.to_json(value)
+        self._write_json_line({"header": json_value})
+
+    def _write_calibration_gain(self, value: yardl.Float32) -> None:
+        converter = _ndjson.float32_converter
+        json_value = converter.to_json(value)
+        self._write_json_line({"calibrationGain": json_value})
+
+    def _write_calibration_samples(self, value: collections.abc.Iterable[yardl.Float64]) -> None:
+        converter = _ndjson.float64_converter
+        for item in value:
+            json_item = converter.to_json(item)
+            self._write_json_line({"calibrationSamples": json_item})
+
+    def _write_data(self, value: collections.abc.Iterable[yardl.Int32]) -> None:
+        converter = _ndjson.int32_converter
+        for item in value:
+            json_item = converter.to_json(item)
+            self._write_json_line({"data": json_item})
+
+    def _write_recalibration_gain(self, value: yardl.Float32) -> None:
+        converter = _ndjson.float32_converter
+        json_value = converter.to_json(value)
+        self._write_json_line({"recalibrationGain": json_value})
+
+    def _write_recalibration_samples(self, value: collections.abc.Iterable[yardl.Float64]) -> None:
+        converter = _ndjson.float64_converter
+        for item in value:
+            json_item = converter.to_json(item)
+            self._write_json_line({"recalibrationSamples": json_item})
+
+
+class NDJsonProtocolWithSubProtocolsReader(_ndjson.NDJsonProtocolReader, ProtocolWithSubProtocolsReaderBase):
+    """NDJson writer for the ProtocolWithSubProtocols protocol."""
+
+
+    def __init__(self, stream: typing.Union[io.BufferedReader, typing.TextIO, str], skip_completed_check: bool = False) -> None:
+        ProtocolWithSubProtocolsReaderBase.__init__(self, skip_completed_check)
+        _ndjson.NDJsonProtocolReader.__init__(self, stream, ProtocolWithSubProtocolsReaderBase.schema)
+
+    def _read_header(self) -> str:
+        json_object = self._read_json_line("header", True)
+        converter = _ndjson.string_converter
+        return converter.from_json(json_object)
+
+    def _read_calibration_gain(self) -> yardl.Float32:
+        json_object = self._read_json_line("calibrationGain", True)
+        converter = _ndjson.float32_converter
+        return converter.from_json(json_object)
+
+    def _read_calibration_samples(self) -> collections.abc.Iterable[yardl.Float64]:
+        converter = _ndjson.float64_converter
+        while (json_object := self._read_json_line("calibrationSamples", False)) is not _ndjson.MISSING_SENTINEL:
+            yield converter.from_json(json_object)
+
+    def _read_data(self) -> collections.abc.Iterable[yardl.Int32]:
+        converter = _ndjson.int32_converter
+        while (json_object := self._read_json_line("data", False)) is not _ndjson.MISSING_SENTINEL:
+            yield converter.from_json(json_object)
+
+    def _read_recalibration_gain(self) -> yardl.Float32:
+        json_object = self._read_json_line("recalibrationGain", True)
+        converter = _ndjson.float32_converter
+        return converter.from_json(json_object)
+
+    def _read_recalibration_samples(self) -> collections.abc.Iterable[yardl.Float64]:
+        converter = _ndjson.float64_converter
+        while (json_object := self._read_json_line("recalibrationSamples", False)) is not _ndjson.MISSING_SENTINEL:
+            yield converter.from_json(json_object)
+
+class NDJsonProtocolWithHalfPrecisionWriter(_ndjson.NDJsonProtocolWriter, ProtocolWithHalfPrecisionWriterBase):
+    """NDJson writer for the ProtocolWithHalfPrecision protocol."""
+
+
+    def __init__(self, stream: typing.Union[typing.TextIO, str]) -> None:
+        ProtocolWithHalfPrecisionWriterBase.__init__(self)
+        _ndjson.NDJsonProtocolWriter.__init__(self, stream, ProtocolWithHalfPrecisionWriterBase.schema)
+
+    def _write_halves(self, value: list[yardl.Float16]) -> None:
+        converter = _ndjson.VectorConverter(_ndjson.float16_converter)
+        json_value = converter.to_json(value)
+        self._write_json_line({"halves": json_value})
+
+    def _write_rec_with_halves(self, value: RecordWithHalfPrecision) -> None:
+        converter = RecordWithHalfPrecisionConverter()
+        json_value = converter.to_json(value)
+        self._write_json_line({"recWithHalves": json_value})
+
+
+class NDJsonProtocolWithHalfPrecisionReader(_ndjson.NDJsonProtocolReader, ProtocolWithHalfPrecisionReaderBase):
+    """NDJson writer for the ProtocolWithHalfPrecision protocol."""
+
+
+    def __init__(self, stream: typing.Union[io.BufferedReader, typing.TextIO, str], skip_completed_check: bool = False) -> None:
+        ProtocolWithHalfPrecisionReaderBase.__init__(self, skip_completed_check)
+        _ndjson.NDJsonProtocolReader.__init__(self, stream, ProtocolWithHalfPrecisionReaderBase.schema)
+
+    def _read_halves(self) -> list[yardl.Float16]:
+        json_object = self._read_json_line("halves", True)
+        converter = _ndjson.VectorConverter(_ndjson.float16_converter)
+        return converter.from_json(json_object)
+
+    def _read_rec_with_halves(self) -> RecordWithHalfPrecision:
+        json_object = self._read_json_line("recWithHalves", True)
+        converter = RecordWithHalfPrecisionConverter()
+        return converter.from_json(json_object)
+
 class NDJsonOptionalVectorsWriter(_ndjson.NDJsonProtocolWriter, OptionalVectorsWriterBase):
     """NDJson writer for the OptionalVectors protocol."""
 
@@ -4109,6 +5416,114 @@ class NDJsonAdvancedGenericsReader(_ndjson.NDJsonProtocolReader, AdvancedGeneric
         converter = tuples.ndjson.TupleConverter(_ndjson.VectorConverter(_ndjson.int32_converter), _ndjson.VectorConverter(_ndjson.float32_converter))
         return converter.from_json(json_object)
 
+class NDJsonFloatImageStreamWriter(_ndjson.NDJsonProtocolWriter, FloatImageStreamWriterBase):
+    """NDJson writer for the FloatImageStream protocol.
+
+    A protocol that is instantiated for each pixel type
+    """
+
+
+    def __init__(self, stream: typing.Union[typing.TextIO, str]) -> None:
+        FloatImageStreamWriterBase.__init__(self)
+        _ndjson.NDJsonProtocolWriter.__init__(self, stream, FloatImageStreamWriterBase.schema)
+
+    def _write_header(self, value: GenericRecord[yardl.Int32, yardl.Float32, np.float32]) -> None:
+        converter = GenericRecordConverter(_ndjson.int32_converter, _ndjson.float32_converter)
+        json_value = converter.to_json(value)
+        self._write_json_line({"header": json_value})
+
+    def _write_images(self, value: collections.abc.Iterable[Image[np.float32]]) -> None:
+        converter = _ndjson.NDArrayConverter(_ndjson.float32_converter, 2)
+        for item in value:
+            json_item = converter.to_json(item)
+            self._write_json_line({"images": json_item})
+
+    def _write_background(self, value: Float32OrString) -> None:
+        converter = _ndjson.UnionConverter(Float32OrString, [(Float32OrString.Float32, _ndjson.float32_converter, [int, float]), (Float32OrString.String, _ndjson.string_converter, [str])], True)
+        json_value = converter.to_json(value)
+        self._write_json_line({"background": json_value})
+
+
+class NDJsonFloatImageStreamReader(_ndjson.NDJsonProtocolReader, FloatImageStreamReaderBase):
+    """NDJson writer for the FloatImageStream protocol.
+
+    A protocol that is instantiated for each pixel type
+    """
+
+
+    def __init__(self, stream: typing.Union[io.BufferedReader, typing.TextIO, str], skip_completed_check: bool = False) -> None:
+        FloatImageStreamReaderBase.__init__(self, skip_completed_check)
+        _ndjson.NDJsonProtocolReader.__init__(self, stream, FloatImageStreamReaderBase.schema)
+
+    def _read_header(self) -> GenericRecord[yardl.Int32, yardl.Float32, np.float32]:
+        json_object = self._read_json_line("header", True)
+        converter = GenericRecordConverter(_ndjson.int32_converter, _ndjson.float32_converter)
+        return converter.from_json(json_object)
+
+    def _read_images(self) -> collections.abc.Iterable[Image[np.float32]]:
+        converter = _ndjson.NDArrayConverter(_ndjson.float32_converter, 2)
+        while (json_object := self._read_json_line("images", False)) is not _ndjson.MISSING_SENTINEL:
+            yield converter.from_json(json_object)
+
+    def _read_background(self) -> Float32OrString:
+        json_object = self._read_json_line("background", True)
+        converter = _ndjson.UnionConverter(Float32OrString, [(Float32OrString.Float32, _ndjson.float32_converter, [int, float]), (Float32OrString.String, _ndjson.string_converter, [str])], True)
+        return converter.from_json(json_object)
+
+class NDJsonComplexImageStreamWriter(_ndjson.NDJsonProtocolWriter, ComplexImageStreamWriterBase):
+    """NDJson writer for the ComplexImageStream protocol.
+
+    A protocol that is instantiated for each pixel type
+    """
+
+
+    def __init__(self, stream: typing.Union[typing.TextIO, str]) -> None:
+        ComplexImageStreamWriterBase.__init__(self)
+        _ndjson.NDJsonProtocolWriter.__init__(self, stream, ComplexImageStreamWriterBase.schema)
+
+    def _write_header(self, value: GenericRecord[yardl.Int32, yardl.ComplexFloat, np.complex64]) -> None:
+        converter = GenericRecordConverter(_ndjson.int32_converter, _ndjson.complexfloat32_converter)
+        json_value = converter.to_json(value)
+        self._write_json_line({"header": json_value})
+
+    def _write_images(self, value: collections.abc.Iterable[Image[np.complex64]]) -> None:
+        converter = _ndjson.NDArrayConverter(_ndjson.complexfloat32_converter, 2)
+        for item in value:
+            json_item = converter.to_json(item)
+            self._write_json_line({"images": json_item})
+
+    def _write_background(self, value: Complexfloat32OrString) -> None:
+        converter = _ndjson.UnionConverter(Complexfloat32OrString, [(Complexfloat32OrString.Complexfloat32, _ndjson.complexfloat32_converter, [list]), (Complexfloat32OrString.String, _ndjson.string_converter, [str])], True)
+        json_value = converter.to_json(value)
+        self._write_json_line({"background": json_value})
+
+
+class NDJsonComplexImageStreamReader(_ndjson.NDJsonProtocolReader, ComplexImageStreamReaderBase):
+    """NDJson writer for the ComplexImageStream protocol.
+
+    A protocol that is instantiated for each pixel type
+    """
+
+
+    def __init__(self, stream: typing.Union[io.BufferedReader, typing.TextIO, str], skip_completed_check: bool = False) -> None:
+        ComplexImageStreamReaderBase.__init__(self, skip_completed_check)
+        _ndjson.NDJsonProtocolReader.__init__(self, stream, ComplexImageStreamReaderBase.schema)
+
+    def _read_header(self) -> GenericRecord[yardl.Int32, yardl.ComplexFloat, np.complex64]:
+        json_object = self._read_json_line("header", True)
+        converter = GenericRecordConverter(_ndjson.int32_converter, _ndjson.complexfloat32_converter)
+        return converter.from_json(json_object)
+
+    def _read_images(self) -> collections.abc.Iterable[Image[np.complex64]]:
+        converter = _ndjson.NDArrayConverter(_ndjson.complexfloat32_converter, 2)
+        while (json_object := self._read_json_line("images", False)) is not _ndjson.MISSING_SENTINEL:
+            yield converter.from_json(json_object)
+
+    def _read_background(self) -> Complexfloat32OrString:
+        json_object = self._read_json_line("background", True)
+        converter = _ndjson.UnionConverter(Complexfloat32OrString, [(Complexfloat32OrString.Complexfloat32, _ndjson.complexfloat32_converter, [list]), (Complexfloat32OrString.String, _ndjson.string_converter, [str])], True)
+        return converter.from_json(json_object)
+
 class NDJsonAliasesWriter(_ndjson.NDJsonProtocolWriter, AliasesWriterBase):
     """NDJson writer for the Aliases protocol."""
 
@@ -4303,6 +5718,44 @@ class NDJsonProtocolWithComputedFieldsReader(_ndjson.NDJsonProtocolReader, Proto
         converter = RecordWithComputedFieldsConverter()
         return converter.from_json(json_object)
 
+class NDJsonProtocolWithConstraintsWriter(_ndjson.NDJsonProtocolWriter, ProtocolWithConstraintsWriterBase):
+    """NDJson writer for the ProtocolWithConstraints protocol."""
+
+
+    def __init__(self, stream: typing.Union[typing.TextIO, str]) -> None:
+        ProtocolWithConstraintsWriterBase.__init__(self)
+        _ndjson.NDJsonProtocolWriter.__init__(self, stream, ProtocolWithConstraintsWriterBase.schema)
+
+    def _write_record(self, value: RecordWithConstraints) -> None:
+        converter = RecordWithConstraintsConverter()
+        json_value = converter.to_json(value)
+        self._write_json_line({"record": json_value})
+
+    def _write_records(self, value: collections.abc.Iterable[RecordWithConstrainedRecords]) -> None:
+        converter = RecordWithConstrainedRecordsConverter()
+        for item in value:
+            json_item = converter.to_json(item)
+            self._write_json_line({"records": json_item})
+
+
+class NDJsonProtocolWithConstraintsReader(_ndjson.NDJsonProtocolReader, ProtocolWithConstraintsReaderBase):
+    """NDJson writer for the ProtocolWithConstraints protocol."""
+
+
+    def __init__(self, stream: typing.Union[io.BufferedReader, typing.TextIO, str], skip_completed_check: bool = False) -> None:
+        ProtocolWithConstraintsReaderBase.__init__(self, skip_completed_check)
+        _ndjson.NDJsonProtocolReader.__init__(self, stream, ProtocolWithConstraintsReaderBase.schema)
+
+    def _read_record(self) -> RecordWithConstraints:
+        json_object = self._read_json_line("record", True)
+        converter = RecordWithConstraintsConverter()
+        return converter.from_json(json_object)
+
+    def _read_records(self) -> collections.abc.Iterable[RecordWithConstrainedRecords]:
+        converter = RecordWithConstrainedRecordsConverter()
+        while (json_object := self._read_json_line("records", False)) is not _ndjson.MISSING_SENTINEL:
+            yield converter.from_json(json_object)
+
 class NDJsonProtocolWithKeywordStepsWriter(_ndjson.NDJsonProtocolWriter, ProtocolWithKeywordStepsWriterBase):
     """NDJson writer for the ProtocolWithKeywordSteps protocol."""
 
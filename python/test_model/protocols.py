@@ -6,6 +6,7 @@ import abc
 import collections.abc
 import datetime
 import typing
+import uuid
 
 import numpy as np
 import numpy.typing as npt
@@ -1703,18 +1704,18 @@ class StringsReaderBase(abc.ABC):
             return 'read_rec_with_string'
         return "<unknown>"
 
-class OptionalVectorsWriterBase(abc.ABC):
-    """Abstract writer for the OptionalVectors protocol."""
+class ProtocolWithBytesWriterBase(abc.ABC):
+    """Abstract writer for the ProtocolWithBytes protocol."""
 
 
     def __init__(self) -> None:
         self._state = 0
 
-    schema = r"""{"protocol":{"name":"OptionalVectors","sequence":[{"name":"recordWithOptionalVector","type":"TestModel.RecordWithOptionalVector"}]},"types":[{"name":"RecordWithOptionalVector","fields":[{"name":"optionalVector","type":[null,{"vector":{"items":"int32"}}]}]}]}"""
+    schema = r"""{"protocol":{"name":"ProtocolWithBytes","sequence":[{"name":"singleBytes","type":"bytes"},{"name":"recWithBytes","type":"TestModel.RecordWithBytes"}]},"types":[{"name":"RecordWithBytes","fields":[{"name":"data","type":"bytes"},{"name":"optionalData","type":[null,"bytes"]},{"name":"chunks","type":{"vector":{"items":"bytes"}}}]}]}"""
 
     def close(self) -> None:
         self._close()
-        if self._state != 2:
+        if self._state != 4:
             expected_method = self._state_to_method_name((self._state + 1) & ~1)
             raise ProtocolError(f"Protocol writer closed before all steps were called. Expected to call to '{expected_method}'.")
 
@@ -1728,17 +1729,30 @@ class OptionalVectorsWriterBase(abc.ABC):
             if exc is None:
                 raise e
 
-    def write_record_with_optional_vector(self, value: RecordWithOptionalVector) -> None:
+    def write_single_bytes(self, value: bytes) -> None:
         """Ordinal 0"""
 
         if self._state != 0:
             self._raise_unexpected_state(0)
 
-        self._write_record_with_optional_vector(value)
+        self._write_single_bytes(value)
         self._state = 2
 
+    def write_rec_with_bytes(self, value: RecordWithBytes) -> None:
+        """Ordinal 1"""
+
+        if self._state != 2:
+            self._raise_unexpected_state(2)
+
+        self._write_rec_with_bytes(value)
+        self._state = 4
+
     @abc.abstractmethod
-    def _write_record_with_optional_vector(self, value: RecordWithOptionalVector) -> None:
+    def _write_single_bytes(self, value: bytes) -> None:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _write_rec_with_bytes(self, value: RecordWithBytes) -> None:
         raise NotImplementedError()
 
     @abc.abstractmethod
@@ -1756,11 +1770,13 @@ class OptionalVectorsWriterBase(abc.ABC):
 
     def _state_to_method_name(self, state: int) -> str:
         if state == 0:
-            return 'write_record_with_optional_vector'
+            return 'write_single_bytes'
+        if state == 2:
+            return 'write_rec_with_bytes'
         return "<unknown>"
 
-class OptionalVectorsReaderBase(abc.ABC):
-    """Abstract reader for the OptionalVectors protocol."""
+class ProtocolWithBytesReaderBase(abc.ABC):
+    """Abstract reader for the ProtocolWithBytes protocol."""
 
 
     def __init__(self, skip_completed_check: bool = False) -> None:
@@ -1769,7 +1785,7 @@ class OptionalVectorsReaderBase(abc.ABC):
 
     def close(self) -> None:
         self._close()
-        if not self._skip_completed_check and self._state != 2:
+        if not self._skip_completed_check and self._state != 4:
             if self._state % 2 == 1:
                 previous_method = self._state_to_method_name(self._state - 1)
                 raise ProtocolError(f"Protocol reader closed before all data was consumed. The iterable returned by '{previous_method}' was not fully consumed.")
@@ -1778,7 +1794,7 @@ class OptionalVectorsReaderBase(abc.ABC):
                 raise ProtocolError(f"Protocol reader closed before all data was consumed. Expected call to '{expected_method}'.")
             	
 
-    schema = OptionalVectorsWriterBase.schema
+    schema = ProtocolWithBytesWriterBase.schema
 
     def __enter__(self):
         return self
@@ -1794,21 +1810,36 @@ class OptionalVectorsReaderBase(abc.ABC):
     def _close(self) -> None:
         raise NotImplementedError()
 
-    def read_record_with_optional_vector(self) -> RecordWithOptionalVector:
+    def read_single_bytes(self) -> bytes:
         """Ordinal 0"""
 
         if self._state != 0:
             self._raise_unexpected_state(0)
 
-        value = self._read_record_with_optional_vector()
+        value = self._read_single_bytes()
         self._state = 2
         return value
 
-    def copy_to(self, writer: OptionalVectorsWriterBase) -> None:
-        writer.write_record_with_optional_vector(self.read_record_with_optional_vector())
+    def read_rec_with_bytes(self) -> RecordWithBytes:
+        """Ordinal 1"""
+
+        if self._state != 2:
+            self._raise_unexpected_state(2)
+
+        value = self._read_rec_with_bytes()
+        self._state = 4
+        return value
+
+    def copy_to(self, writer: ProtocolWithBytesWriterBase) -> None:
+        writer.write_single_bytes(self.read_single_bytes())
+        writer.write_rec_with_bytes(self.read_rec_with_bytes())
 
     @abc.abstractmethod
-    def _read_record_with_optional_vector(self) -> RecordWithOptionalVector:
+    def _read_single_bytes(self) -> bytes:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _read_rec_with_bytes(self) -> RecordWithBytes:
         raise NotImplementedError()
 
     T = typing.TypeVar('T')
@@ -1827,21 +1858,23 @@ class OptionalVectorsReaderBase(abc.ABC):
         	
     def _state_to_method_name(self, state: int) -> str:
         if state == 0:
-            return 'read_record_with_optional_vector'
+            return 'read_single_bytes'
+        if state == 2:
+            return 'read_rec_with_bytes'
         return "<unknown>"
 
-class FixedVectorsWriterBase(abc.ABC):
-    """Abstract writer for the FixedVectors protocol."""
+class ProtocolWithUuidsWriterBase(abc.ABC):
+    """Abstract writer for the ProtocolWithUuids protocol."""
 
 
     def __init__(self) -> None:
         self._state = 0
 
-    schema = r"""{"protocol":{"name":"FixedVectors","sequence":[{"name":"fixedIntVector","type":{"vector":{"items":"int32","length":5}}},{"name":"fixedSimpleRecordVector","type":{"vector":{"items":"TestModel.SimpleRecord","length":3}}},{"name":"fixedRecordWithVlensVector","type":{"vector":{"items":"TestModel.RecordWithVlens","length":2}}},{"name":"recordWithFixedVectors","type":"TestModel.RecordWithFixedVectors"}]},"types":[{"name":"RecordWithFixedVectors","fields":[{"name":"fixedIntVector","type":{"vector":{"items":"int32","length":5}}},{"name":"fixedSimpleRecordVector","type":{"vector":{"items":"TestModel.SimpleRecord","length":3}}},{"name":"fixedRecordWithVlensVector","type":{"vector":{"items":"TestModel.RecordWithVlens","length":2}}}]},{"name":"RecordWithVlens","fields":[{"name":"a","type":{"vector":{"items":"TestModel.SimpleRecord"}}},{"name":"b","type":"int32"},{"name":"c","type":"int32"}]},{"name":"SimpleRecord","fields":[{"name":"x","type":"int32"},{"name":"y","type":"int32"},{"name":"z","type":"int32"}]}]}"""
+    schema = r"""{"protocol":{"name":"ProtocolWithUuids","sequence":[{"name":"singleUuid","type":"uuid"},{"name":"recWithUuids","type":"TestModel.RecordWithUuids"}]},"types":[{"name":"RecordWithUuids","fields":[{"name":"id","type":"uuid"},{"name":"optionalId","type":[null,"uuid"]},{"name":"related","type":{"vector":{"items":"uuid"}}},{"name":"names","type":{"map":{"keys":"uuid","values":"string"}}}]}]}"""
 
     def close(self) -> None:
         self._close()
-        if self._state != 8:
+        if self._state != 4:
             expected_method = self._state_to_method_name((self._state + 1) & ~1)
             raise ProtocolError(f"Protocol writer closed before all steps were called. Expected to call to '{expected_method}'.")
 
@@ -1855,56 +1888,30 @@ class FixedVectorsWriterBase(abc.ABC):
             if exc is None:
                 raise e
 
-    def write_fixed_int_vector(self, value: list[yardl.Int32]) -> None:
+    def write_single_uuid(self, value: uuid.UUID) -> None:
         """Ordinal 0"""
 
         if self._state != 0:
             self._raise_unexpected_state(0)
 
-        self._write_fixed_int_vector(value)
+        self._write_single_uuid(value)
         self._state = 2
 
-    def write_fixed_simple_record_vector(self, value: list[SimpleRecord]) -> None:
+    def write_rec_with_uuids(self, value: RecordWithUuids) -> None:
         """Ordinal 1"""
 
         if self._state != 2:
             self._raise_unexpected_state(2)
 
-        self._write_fixed_simple_record_vector(value)
+        self._write_rec_with_uuids(value)
         self._state = 4
 
-    def write_fixed_record_with_vlens_vector(self, value: list[RecordWithVlens]) -> None:
-        """Ordinal 2"""
-
-        if self._state != 4:
-            self._raise_unexpected_state(4)
-
-        self._write_fixed_record_with_vlens_vector(value)
-        self._state = 6
-
-    def write_record_with_fixed_vectors(self, value: RecordWithFixedVectors) -> None:
-        """Ordinal 3"""
-
-        if self._state != 6:
-            self._raise_unexpected_state(6)
-
-        self._write_record_with_fixed_vectors(value)
-        self._state = 8
-
-    @abc.abstractmethod
-    def _write_fixed_int_vector(self, value: list[yardl.Int32]) -> None:
-        raise NotImplementedError()
-
-    @abc.abstractmethod
-    def _write_fixed_simple_record_vector(self, value: list[SimpleRecord]) -> None:
-        raise NotImplementedError()
-
     @abc.abstractmethod
-    def _write_fixed_record_with_vlens_vector(self, value: list[RecordWithVlens]) -> None:
+    def _write_single_uuid(self, value: uuid.UUID) -> None:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _write_record_with_fixed_vectors(self, value: RecordWithFixedVectors) -> None:
+    def _write_rec_with_uuids(self, value: RecordWithUuids) -> None:
         raise NotImplementedError()
 
     @abc.abstractmethod
@@ -1922,17 +1929,13 @@ class FixedVectorsWriterBase(abc.ABC):
 
     def _state_to_method_name(self, state: int) -> str:
         if state == 0:
-            return 'write_fixed_int_vector'
+            return 'write_single_uuid'
         if state == 2:
-            return 'write_fixed_simple_record_vector'
-        if state == 4:
-            return 'write_fixed_record_with_vlens_vector'
-        if state == 6:
-            return 'write_record_with_fixed_vectors'
+            return 'write_rec_with_uuids'
         return "<unknown>"
 
-class FixedVectorsReaderBase(abc.ABC):
-    """Abstract reader for the FixedVectors protocol."""
+class ProtocolWithUuidsReaderBase(abc.ABC):
+    """Abstract reader for the ProtocolWithUuids protocol."""
 
 
     def __init__(self, skip_completed_check: bool = False) -> None:
@@ -1941,7 +1944,7 @@ class FixedVectorsReaderBase(abc.ABC):
 
     def close(self) -> None:
         self._close()
-        if not self._skip_completed_check and self._state != 8:
+        if not self._skip_completed_check and self._state != 4:
             if self._state % 2 == 1:
                 previous_method = self._state_to_method_name(self._state - 1)
                 raise ProtocolError(f"Protocol reader closed before all data was consumed. The iterable returned by '{previous_method}' was not fully consumed.")
@@ -1950,7 +1953,7 @@ class FixedVectorsReaderBase(abc.ABC):
                 raise ProtocolError(f"Protocol reader closed before all data was consumed. Expected call to '{expected_method}'.")
             	
 
-    schema = FixedVectorsWriterBase.schema
+    schema = ProtocolWithUuidsWriterBase.schema
 
     def __enter__(self):
         return self
@@ -1966,66 +1969,36 @@ class FixedVectorsReaderBase(abc.ABC):
     def _close(self) -> None:
         raise NotImplementedError()
 
-    def read_fixed_int_vector(self) -> list[yardl.Int32]:
+    def read_single_uuid(self) -> uuid.UUID:
         """Ordinal 0"""
 
         if self._state != 0:
             self._raise_unexpected_state(0)
 
-        value = self._read_fixed_int_vector()
+        value = self._read_single_uuid()
         self._state = 2
         return value
 
-    def read_fixed_simple_record_vector(self) -> list[SimpleRecord]:
+    def read_rec_with_uuids(self) -> RecordWithUuids:
         """Ordinal 1"""
 
         if self._state != 2:
             self._raise_unexpected_state(2)
 
-        value = self._read_fixed_simple_record_vector()
+        value = self._read_rec_with_uuids()
         self._state = 4
         return value
 
-    def read_fixed_record_with_vlens_vector(self) -> list[RecordWithVlens]:
-        """Ordinal 2"""
-
-        if self._state != 4:
-            self._raise_unexpected_state(4)
-
-        value = self._read_fixed_record_with_vlens_vector()
-        self._state = 6
-        return value
-
-    def read_record_with_fixed_vectors(self) -> RecordWithFixedVectors:
-        """Ordinal 3"""
-
-        if self._state != 6:
-            self._raise_unexpected_state(6)
-
-        value = self._read_record_with_fixed_vectors()
-        self._state = 8
-        return value
-
-    def copy_to(self, writer: FixedVectorsWriterBase) -> None:
-        writer.write_fixed_int_vector(self.read_fixed_int_vector())
-        writer.write_fixed_simple_record_vector(self.read_fixed_simple_record_vector())
-        writer.write_fixed_record_with_vlens_vector(self.read_fixed_record_with_vlens_vector())
-        writer.write_record_with_fixed_vectors(self.read_record_with_fixed_vectors())
-
-    @abc.abstractmethod
-    def _read_fixed_int_vector(self) -> list[yardl.Int32]:
-        raise NotImplementedError()
-
-    @abc.abstractmethod
-    def _read_fixed_simple_record_vector(self) -> list[SimpleRecord]:
-        raise NotImplementedError()
+    def copy_to(self, writer: ProtocolWithUuidsWriterBase) -> None:
+        writer.write_single_uuid(self.read_single_uuid())
+        writer.write_rec_with_uuids(self.read_rec_with_uuids())
 
     @abc.abstractmethod
-    def _read_fixed_record_with_vlens_vector(self) -> list[RecordWithVlens]:
+    def _read_single_uuid(self) -> uuid.UUID:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _read_record_with_fixed_vectors(self) -> RecordWithFixedVectors:
+    def _read_rec_with_uuids(self) -> RecordWithUuids:
         raise NotImplementedError()
 
     T = typing.TypeVar('T')
@@ -2044,33 +2017,23 @@ class FixedVectorsReaderBase(abc.ABC):
         	
     def _state_to_method_name(self, state: int) -> str:
         if state == 0:
-            return 'read_fixed_int_vector'
+            return 'read_single_uuid'
         if state == 2:
-            return 'read_fixed_simple_record_vector'
-        if state == 4:
-            return 'read_fixed_record_with_vlens_vector'
-        if state == 6:
-            return 'read_record_with_fixed_vectors'
+            return 'read_rec_with_uuids'
         return "<unknown>"
 
-class StreamsWriterBase(abc.ABC):
-    """Abstract writer for the Streams protocol."""
+class ProtocolWithDurationsWriterBase(abc.ABC):
+    """Abstract writer for the ProtocolWithDurations protocol."""
 
 
     def __init__(self) -> None:
         self._state = 0
 
-    schema = r"""{"protocol":{"name":"Streams","sequence":[{"name":"intData","type":{"stream":{"items":"int32"}}},{"name":"optionalIntData","type":{"stream":{"items":[null,"int32"]}}},{"name":"recordWithOptionalVectorData","type":{"stream":{"items":"TestModel.RecordWithOptionalVector"}}},{"name":"fixedVector","type":{"stream":{"items":{"vector":{"items":"int32","length":3}}}}}]},"types":[{"name":"RecordWithOptionalVector","fields":[{"name":"optionalVector","type":[null,{"vector":{"items":"int32"}}]}]}]}"""
+    schema = r"""{"protocol":{"name":"ProtocolWithDurations","sequence":[{"name":"singleDuration","type":"duration"},{"name":"recWithDurations","type":"TestModel.RecordWithDurations"}]},"types":[{"name":"RecordWithDurations","fields":[{"name":"acquisitionStart","type":"datetime"},{"name":"acquisitionEnd","type":"datetime"},{"name":"repetitionTime","type":"duration"},{"name":"timeout","type":[null,"duration"]},{"name":"intervals","type":{"vector":{"items":"duration"}}}]}]}"""
 
     def close(self) -> None:
-        if self._state == 7:
-            try:
-                self._end_stream()
-                return
-            finally:
-                self._close()
         self._close()
-        if self._state != 8:
+        if self._state != 4:
             expected_method = self._state_to_method_name((self._state + 1) & ~1)
             raise ProtocolError(f"Protocol writer closed before all steps were called. Expected to call to '{expected_method}'.")
 
@@ -2084,65 +2047,30 @@ class StreamsWriterBase(abc.ABC):
             if exc is None:
                 raise e
 
-    def write_int_data(self, value: collections.abc.Iterable[yardl.Int32]) -> None:
+    def write_single_duration(self, value: np.timedelta64) -> None:
         """Ordinal 0"""
 
-        if self._state & ~1 != 0:
+        if self._state != 0:
             self._raise_unexpected_state(0)
 
-        self._write_int_data(value)
-        self._state = 1
+        self._write_single_duration(value)
+        self._state = 2
 
-    def write_optional_int_data(self, value: collections.abc.Iterable[typing.Optional[yardl.Int32]]) -> None:
+    def write_rec_with_durations(self, value: RecordWithDurations) -> None:
         """Ordinal 1"""
 
-        if self._state == 1:
-            self._end_stream()
-            self._state = 2
-        elif self._state & ~1 != 2:
+        if self._state != 2:
             self._raise_unexpected_state(2)
 
-        self._write_optional_int_data(value)
-        self._state = 3
-
-    def write_record_with_optional_vector_data(self, value: collections.abc.Iterable[RecordWithOptionalVector]) -> None:
-        """Ordinal 2"""
-
-        if self._state == 3:
-            self._end_stream()
-            self._state = 4
-        elif self._state & ~1 != 4:
-            self._raise_unexpected_state(4)
-
-        self._write_record_with_optional_vector_data(value)
-        self._state = 5
-
-    def write_fixed_vector(self, value: collections.abc.Iterable[list[yardl.Int32]]) -> None:
-        """Ordinal 3"""
-
-        if self._state == 5:
-            self._end_stream()
-            self._state = 6
-        elif self._state & ~1 != 6:
-            self._raise_unexpected_state(6)
-
-        self._write_fixed_vector(value)
-        self._state = 7
-
-    @abc.abstractmethod
-    def _write_int_data(self, value: collections.abc.Iterable[yardl.Int32]) -> None:
-        raise NotImplementedError()
-
-    @abc.abstractmethod
-    def _write_optional_int_data(self, value: collections.abc.Iterable[typing.Optional[yardl.Int32]]) -> None:
-        raise NotImplementedError()
+        self._write_rec_with_durations(value)
+        self._state = 4
 
     @abc.abstractmethod
-    def _write_record_with_optional_vector_data(self, value: collections.abc.Iterable[RecordWithOptionalVector]) -> None:
+    def _write_single_duration(self, value: np.timedelta64) -> None:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _write_fixed_vector(self, value: collections.abc.Iterable[list[yardl.Int32]]) -> None:
+    def _write_rec_with_durations(self, value: RecordWithDurations) -> None:
         raise NotImplementedError()
 
     @abc.abstractmethod
@@ -2160,17 +2088,13 @@ class StreamsWriterBase(abc.ABC):
 
     def _state_to_method_name(self, state: int) -> str:
         if state == 0:
-            return 'write_int_data'
+            return 'write_single_duration'
         if state == 2:
-            return 'write_optional_int_data'
-        if state == 4:
-            return 'write_record_with_optional_vector_data'
-        if state == 6:
-            return 'write_fixed_vector'
+            return 'write_rec_with_durations'
         return "<unknown>"
 
-class StreamsReaderBase(abc.ABC):
-    """Abstract reader for the Streams protocol."""
+class ProtocolWithDurationsReaderBase(abc.ABC):
+    """Abstract reader for the ProtocolWithDurations protocol."""
 
 
     def __init__(self, skip_completed_check: bool = False) -> None:
@@ -2179,7 +2103,7 @@ class StreamsReaderBase(abc.ABC):
 
     def close(self) -> None:
         self._close()
-        if not self._skip_completed_check and self._state != 8:
+        if not self._skip_completed_check and self._state != 4:
             if self._state % 2 == 1:
                 previous_method = self._state_to_method_name(self._state - 1)
                 raise ProtocolError(f"Protocol reader closed before all data was consumed. The iterable returned by '{previous_method}' was not fully consumed.")
@@ -2188,7 +2112,7 @@ class StreamsReaderBase(abc.ABC):
                 raise ProtocolError(f"Protocol reader closed before all data was consumed. Expected call to '{expected_method}'.")
             	
 
-    schema = StreamsWriterBase.schema
+    schema = ProtocolWithDurationsWriterBase.schema
 
     def __enter__(self):
         return self
@@ -2204,66 +2128,36 @@ class StreamsReaderBase(abc.ABC):
     def _close(self) -> None:
         raise NotImplementedError()
 
-    def read_int_data(self) -> collections.abc.Iterable[yardl.Int32]:
+    def read_single_duration(self) -> np.timedelta64:
         """Ordinal 0"""
 
         if self._state != 0:
             self._raise_unexpected_state(0)
 
-        value = self._read_int_data()
-        self._state = 1
-        return self._wrap_iterable(value, 2)
+        value = self._read_single_duration()
+        self._state = 2
+        return value
 
-    def read_optional_int_data(self) -> collections.abc.Iterable[typing.Optional[yardl.Int32]]:
+    def read_rec_with_durations(self) -> RecordWithDurations:
         """Ordinal 1"""
 
         if self._state != 2:
             self._raise_unexpected_state(2)
 
-        value = self._read_optional_int_data()
-        self._state = 3
-        return self._wrap_iterable(value, 4)
-
-    def read_record_with_optional_vector_data(self) -> collections.abc.Iterable[RecordWithOptionalVector]:
-        """Ordinal 2"""
-
-        if self._state != 4:
-            self._raise_unexpected_state(4)
-
-        value = self._read_record_with_optional_vector_data()
-        self._state = 5
-        return self._wrap_iterable(value, 6)
-
-    def read_fixed_vector(self) -> collections.abc.Iterable[list[yardl.Int32]]:
-        """Ordinal 3"""
-
-        if self._state != 6:
-            self._raise_unexpected_state(6)
-
-        value = self._read_fixed_vector()
-        self._state = 7
-        return self._wrap_iterable(value, 8)
-
-    def copy_to(self, writer: StreamsWriterBase) -> None:
-        writer.write_int_data(self.read_int_data())
-        writer.write_optional_int_data(self.read_optional_int_data())
-        writer.write_record_with_optional_vector_data(self.read_record_with_optional_vector_data())
-        writer.write_fixed_vector(self.read_fixed_vector())
-
-    @abc.abstractmethod
-    def _read_int_data(self) -> collections.abc.Iterable[yardl.Int32]:
-        raise NotImplementedError()
+        value = self._read_rec_with_durations()
+        self._state = 4
+        return value
 
-    @abc.abstractmethod
-    def _read_optional_int_data(self) -> collections.abc.Iterable[typing.Optional[yardl.Int32]]:
-        raise NotImplementedError()
+    def copy_to(self, writer: ProtocolWithDurationsWriterBase) -> None:
+        writer.write_single_duration(self.read_single_duration())
+        writer.write_rec_with_durations(self.read_rec_with_durations())
 
     @abc.abstractmethod
-    def _read_record_with_optional_vector_data(self) -> collections.abc.Iterable[RecordWithOptionalVector]:
+    def _read_single_duration(self) -> np.timedelta64:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _read_fixed_vector(self) -> collections.abc.Iterable[list[yardl.Int32]]:
+    def _read_rec_with_durations(self) -> RecordWithDurations:
         raise NotImplementedError()
 
     T = typing.TypeVar('T')
@@ -2282,27 +2176,29 @@ class StreamsReaderBase(abc.ABC):
         	
     def _state_to_method_name(self, state: int) -> str:
         if state == 0:
-            return 'read_int_data'
+            return 'read_single_duration'
         if state == 2:
-            return 'read_optional_int_data'
-        if state == 4:
-            return 'read_record_with_optional_vector_data'
-        if state == 6:
-            return 'read_fixed_vector'
+            return 'read_rec_with_durations'
         return "<unknown>"
 
-class FixedArraysWriterBase(abc.ABC):
-    """Abstract writer for the FixedArrays protocol."""
+class ProtocolWithRecursiveRecordsWriterBase(abc.ABC):
+    """Abstract writer for the ProtocolWithRecursiveRecords protocol."""
 
 
     def __init__(self) -> None:
         self._state = 0
 
-    schema = r"""{"protocol":{"name":"FixedArrays","sequence":[{"name":"ints","type":{"array":{"items":"int32","dimensions":[{"length":2},{"length":3}]}}},{"name":"fixedSimpleRecordArray","type":{"array":{"items":"TestModel.SimpleRecord","dimensions":[{"length":3},{"length":2}]}}},{"name":"fixedRecordWithVlensArray","type":{"array":{"items":"TestModel.RecordWithVlens","dimensions":[{"length":2},{"length":2}]}}},{"name":"recordWithFixedArrays","type":"TestModel.RecordWithFixedArrays"},{"name":"namedArray","type":"TestModel.NamedFixedNDArray"}]},"types":[{"name":"NamedFixedNDArray","type":{"array":{"items":"int32","dimensions":[{"name":"dimA","length":2},{"name":"dimB","length":4}]}}},{"name":"RecordWithFixedArrays","fields":[{"name":"ints","type":{"array":{"items":"int32","dimensions":[{"length":2},{"length":3}]}}},{"name":"fixedSimpleRecordArray","type":{"array":{"items":"TestModel.SimpleRecord","dimensions":[{"length":3},{"length":2}]}}},{"name":"fixedRecordWithVlensArray","type":{"array":{"items":"TestModel.RecordWithVlens","dimensions":[{"length":2},{"length":2}]}}}]},{"name":"RecordWithVlens","fields":[{"name":"a","type":{"vector":{"items":"TestModel.SimpleRecord"}}},{"name":"b","type":"int32"},{"name":"c","type":"int32"}]},{"name":"SimpleRecord","fields":[{"name":"x","type":"int32"},{"name":"y","type":"int32"},{"name":"z","type":"int32"}]}]}"""
+    schema = r"""{"protocol":{"name":"ProtocolWithRecursiveRecords","sequence":[{"name":"tree","type":"TestModel.TreeNode"},{"name":"list","type":"TestModel.LinkedListNode"},{"name":"expressions","type":{"stream":{"items":"TestModel.Expression"}}}]},"types":[{"name":"Expression","fields":[{"name":"name","type":"string"},{"name":"operand","type":[{"tag":"int32","type":"int32"},{"tag":"Expression","type":"TestModel.Expression"}]},{"name":"arguments","type":{"map":{"keys":"string","values":"TestModel.Expression"}}}]},{"name":"LinkedListNode","fields":[{"name":"value","type":"int32"},{"name":"next","type":[null,"TestModel.LinkedListNode"]}]},{"name":"TreeNode","fields":[{"name":"label","type":"string"},{"name":"children","type":{"vector":{"items":"TestModel.TreeNode"}}}]}]}"""
 
     def close(self) -> None:
+        if self._state == 5:
+            try:
+                self._end_stream()
+                return
+            finally:
+                self._close()
         self._close()
-        if self._state != 10:
+        if self._state != 6:
             expected_method = self._state_to_method_name((self._state + 1) & ~1)
             raise ProtocolError(f"Protocol writer closed before all steps were called. Expected to call to '{expected_method}'.")
 
@@ -2316,69 +2212,43 @@ class FixedArraysWriterBase(abc.ABC):
             if exc is None:
                 raise e
 
-    def write_ints(self, value: npt.NDArray[np.int32]) -> None:
+    def write_tree(self, value: TreeNode) -> None:
         """Ordinal 0"""
 
         if self._state != 0:
             self._raise_unexpected_state(0)
 
-        self._write_ints(value)
+        self._write_tree(value)
         self._state = 2
 
-    def write_fixed_simple_record_array(self, value: npt.NDArray[np.void]) -> None:
+    def write_list(self, value: LinkedListNode) -> None:
         """Ordinal 1"""
 
         if self._state != 2:
             self._raise_unexpected_state(2)
 
-        self._write_fixed_simple_record_array(value)
+        self._write_list(value)
         self._state = 4
 
-    def write_fixed_record_with_vlens_array(self, value: npt.NDArray[np.void]) -> None:
+    def write_expressions(self, value: collections.abc.Iterable[Expression]) -> None:
         """Ordinal 2"""
 
-        if self._state != 4:
+        if self._state & ~1 != 4:
             self._raise_unexpected_state(4)
 
-        self._write_fixed_record_with_vlens_array(value)
-        self._state = 6
-
-    def write_record_with_fixed_arrays(self, value: RecordWithFixedArrays) -> None:
-        """Ordinal 3"""
-
-        if self._state != 6:
-            self._raise_unexpected_state(6)
-
-        self._write_record_with_fixed_arrays(value)
-        self._state = 8
-
-    def write_named_array(self, value: NamedFixedNDArray) -> None:
-        """Ordinal 4"""
-
-        if self._state != 8:
-            self._raise_unexpected_state(8)
-
-        self._write_named_array(value)
-        self._state = 10
-
-    @abc.abstractmethod
-    def _write_ints(self, value: npt.NDArray[np.int32]) -> None:
-        raise NotImplementedError()
-
-    @abc.abstractmethod
-    def _write_fixed_simple_record_array(self, value: npt.NDArray[np.void]) -> None:
-        raise NotImplementedError()
+        self._write_expressions(value)
+        self._state = 5
 
     @abc.abstractmethod
-    def _write_fixed_record_with_vlens_array(self, value: npt.NDArray[np.void]) -> None:
+    def _write_tree(self, value: TreeNode) -> None:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _write_record_with_fixed_arrays(self, value: RecordWithFixedArrays) -> None:
+    def _write_list(self, value: LinkedListNode) -> None:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _write_named_array(self, value: NamedFixedNDArray) -> None:
+    def _write_expressions(self, value: collections.abc.Iterable[Expression]) -> None:
         raise NotImplementedError()
 
     @abc.abstractmethod
@@ -2396,19 +2266,15 @@ class FixedArraysWriterBase(abc.ABC):
 
     def _state_to_method_name(self, state: int) -> str:
         if state == 0:
-            return 'write_ints'
+            return 'write_tree'
         if state == 2:
-            return 'write_fixed_simple_record_array'
+            return 'write_list'
         if state == 4:
-            return 'write_fixed_record_with_vlens_array'
-        if state == 6:
-            return 'write_record_with_fixed_arrays'
-        if state == 8:
-            return 'write_named_array'
+            return 'write_expressions'
         return "<unknown>"
 
-class FixedArraysReaderBase(abc.ABC):
-    """Abstract reader for the FixedArrays protocol."""
+class ProtocolWithRecursiveRecordsReaderBase(abc.ABC):
+    """Abstract reader for the ProtocolWithRecursiveRecords protocol."""
 
 
     def __init__(self, skip_completed_check: bool = False) -> None:
@@ -2417,7 +2283,7 @@ class FixedArraysReaderBase(abc.ABC):
 
     def close(self) -> None:
         self._close()
-        if not self._skip_completed_check and self._state != 10:
+        if not self._skip_completed_check and self._state != 6:
             if self._state % 2 == 1:
                 previous_method = self._state_to_method_name(self._state - 1)
                 raise ProtocolError(f"Protocol reader closed before all data was consumed. The iterable returned by '{previous_method}' was not fully consumed.")
@@ -2426,7 +2292,7 @@ class FixedArraysReaderBase(abc.ABC):
                 raise ProtocolError(f"Protocol reader closed before all data was consumed. Expected call to '{expected_method}'.")
             	
 
-    schema = FixedArraysWriterBase.schema
+    schema = ProtocolWithRecursiveRecordsWriterBase.schema
 
     def __enter__(self):
         return self
@@ -2442,81 +2308,51 @@ class FixedArraysReaderBase(abc.ABC):
     def _close(self) -> None:
         raise NotImplementedError()
 
-    def read_ints(self) -> npt.NDArray[np.int32]:
+    def read_tree(self) -> TreeNode:
         """Ordinal 0"""
 
         if self._state != 0:
             self._raise_unexpected_state(0)
 
-        value = self._read_ints()
+        value = self._read_tree()
         self._state = 2
         return value
 
-    def read_fixed_simple_record_array(self) -> npt.NDArray[np.void]:
+    def read_list(self) -> LinkedListNode:
         """Ordinal 1"""
 
         if self._state != 2:
             self._raise_unexpected_state(2)
 
-        value = self._read_fixed_simple_record_array()
+        value = self._read_list()
         self._state = 4
         return value
 
-    def read_fixed_record_with_vlens_array(self) -> npt.NDArray[np.void]:
+    def read_expressions(self) -> collections.abc.Iterable[Expression]:
         """Ordinal 2"""
 
         if self._state != 4:
             self._raise_unexpected_state(4)
 
-        value = self._read_fixed_record_with_vlens_array()
-        self._state = 6
-        return value
-
-    def read_record_with_fixed_arrays(self) -> RecordWithFixedArrays:
-        """Ordinal 3"""
-
-        if self._state != 6:
-            self._raise_unexpected_state(6)
-
-        value = self._read_record_with_fixed_arrays()
-        self._state = 8
-        return value
-
-    def read_named_array(self) -> NamedFixedNDArray:
-        """Ordinal 4"""
-
-        if self._state != 8:
-            self._raise_unexpected_state(8)
-
-        value = self._read_named_array()
-        self._state = 10
-        return value
-
-    def copy_to(self, writer: FixedArraysWriterBase) -> None:
-        writer.write_ints(self.read_ints())
-        writer.write_fixed_simple_record_array(self.read_fixed_simple_record_array())
-        writer.write_fixed_record_with_vlens_array(self.read_fixed_record_with_vlens_array())
-        writer.write_record_with_fixed_arrays(self.read_record_with_fixed_arrays())
-        writer.write_named_array(self.read_named_array())
-
-    @abc.abstractmethod
-    def _read_ints(self) -> npt.NDArray[np.int32]:
-        raise NotImplementedError()
+        value = self._read_expressions()
+        self._state = 5
+        return self._wrap_iterable(value, 6)
 
-    @abc.abstractmethod
-    def _read_fixed_simple_record_array(self) -> npt.NDArray[np.void]:
-        raise NotImplementedError()
+    def copy_to(self, writer: ProtocolWithRecursiveRecordsWriterBase) -> None:
+        writer.write_tree(self.read_tree())
+        writer.write_list(self.read_list())
+        writer.write_expressions(self.read_expressions())
 
     @abc.abstractmethod
-    def _read_fixed_record_with_vlens_array(self) -> npt.NDArray[np.void]:
+    def _read_tree(self) -> TreeNode:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _read_record_with_fixed_arrays(self) -> RecordWithFixedArrays:
+    def _read_list(self) -> LinkedListNode:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _read_named_array(self) -> NamedFixedNDArray:
+    def _read_expressions(self) -> collections.abc.Iterable[Expression]:
         raise NotImplementedError()
 
     T = typing.TypeVar('T')
@@ -2535,29 +2371,34 @@ class FixedArraysReaderBase(abc.ABC):
         	
     def _state_to_method_name(self, state: int) -> str:
         if state == 0:
-            return 'read_ints'
+            return 'read_tree'
         if state == 2:
-            return 'read_fixed_simple_record_array'
+            return 'read_list'
         if state == 4:
-            return 'read_fixed_record_with_vlens_array'
-        if state == 6:
-            return 'read_record_with_fixed_arrays'
-        if state == 8:
-            return 'read_named_array'
+            return 'read_expressions'
         return "<unknown>"
 
-class SubarraysWriterBase(abc.ABC):
-    """Abstract writer for the Subarrays protocol."""
+class CalibrationWriterBase(abc.ABC):
+    """Abstract writer for the Calibration protocol.
+
+    A calibration phase shared by protocols
+    """
 
 
     def __init__(self) -> None:
         self._state = 0
 
-    schema = r"""{"protocol":{"name":"Subarrays","sequence":[{"name":"dynamicWithFixedIntSubarray","type":{"array":{"items":{"array":{"items":"int32","dimensions":[{"length":3}]}}}}},{"name":"dynamicWithFixedFloatSubarray","type":{"array":{"items":{"array":{"items":"float32","dimensions":[{"length":3}]}}}}},{"name":"knownDimCountWithFixedIntSubarray","type":{"array":{"items":{"array":{"items":"int32","dimensions":[{"length":3}]}},"dimensions":1}}},{"name":"knownDimCountWithFixedFloatSubarray","type":{"array":{"items":{"array":{"items":"float32","dimensions":[{"length":3}]}},"dimensions":1}}},{"name":"fixedWithFixedIntSubarray","type":{"array":{"items":{"array":{"items":"int32","dimensions":[{"length":3}]}},"dimensions":[{"length":2}]}}},{"name":"fixedWithFixedFloatSubarray","type":{"array":{"items":{"array":{"items":"float32","dimensions":[{"length":3}]}},"dimensions":[{"length":2}]}}},{"name":"nestedSubarray","type":{"array":{"items":{"array":{"items":{"array":{"items":"int32","dimensions":[{"length":3}]}},"dimensions":[{"length":2}]}}}}},{"name":"dynamicWithFixedVectorSubarray","type":{"array":{"items":{"vector":{"items":"int32","length":3}}}}},{"name":"genericSubarray","type":{"name":"TestModel.Image","typeArguments":[{"array":{"items":"int32","dimensions":[{"length":3}]}}]}}]},"types":[{"name":"Image","typeParameters":["T"],"type":{"array":{"items":"T","dimensions":[{"name":"x"},{"name":"y"}]}}},{"name":"Image","typeParameters":["T"],"type":{"name":"Image.Image","typeArguments":["T"]}}]}"""
+    schema = r"""{"protocol":{"name":"Calibration","sequence":[{"name":"gain","type":"float32"},{"name":"samples","type":{"stream":{"items":"float64"}}}]},"types":null}"""
 
     def close(self) -> None:
+        if self._state == 3:
+            try:
+                self._end_stream()
+                return
+            finally:
+                self._close()
         self._close()
-        if self._state != 18:
+        if self._state != 4:
             expected_method = self._state_to_method_name((self._state + 1) & ~1)
             raise ProtocolError(f"Protocol writer closed before all steps were called. Expected to call to '{expected_method}'.")
 
@@ -2571,121 +2412,30 @@ class SubarraysWriterBase(abc.ABC):
             if exc is None:
                 raise e
 
-    def write_dynamic_with_fixed_int_subarray(self, value: npt.NDArray[np.int32]) -> None:
+    def write_gain(self, value: yardl.Float32) -> None:
         """Ordinal 0"""
 
         if self._state != 0:
             self._raise_unexpected_state(0)
 
-        self._write_dynamic_with_fixed_int_subarray(value)
+        self._write_gain(value)
         self._state = 2
 
-    def write_dynamic_with_fixed_float_subarray(self, value: npt.NDArray[np.float32]) -> None:
+    def write_samples(self, value: collections.abc.Iterable[yardl.Float64]) -> None:
         """Ordinal 1"""
 
-        if self._state != 2:
+        if self._state & ~1 != 2:
             self._raise_unexpected_state(2)
 
-        self._write_dynamic_with_fixed_float_subarray(value)
-        self._state = 4
-
-    def write_known_dim_count_with_fixed_int_subarray(self, value: npt.NDArray[np.int32]) -> None:
-        """Ordinal 2"""
-
-        if self._state != 4:
-            self._raise_unexpected_state(4)
-
-        self._write_known_dim_count_with_fixed_int_subarray(value)
-        self._state = 6
-
-    def write_known_dim_count_with_fixed_float_subarray(self, value: npt.NDArray[np.float32]) -> None:
-        """Ordinal 3"""
-
-        if self._state != 6:
-            self._raise_unexpected_state(6)
-
-        self._write_known_dim_count_with_fixed_float_subarray(value)
-        self._state = 8
-
-    def write_fixed_with_fixed_int_subarray(self, value: npt.NDArray[np.int32]) -> None:
-        """Ordinal 4"""
-
-        if self._state != 8:
-            self._raise_unexpected_state(8)
-
-        self._write_fixed_with_fixed_int_subarray(value)
-        self._state = 10
-
-    def write_fixed_with_fixed_float_subarray(self, value: npt.NDArray[np.float32]) -> None:
-        """Ordinal 5"""
-
-        if self._state != 10:
-            self._raise_unexpected_state(10)
-
-        self._write_fixed_with_fixed_float_subarray(value)
-        self._state = 12
-
-    def write_nested_subarray(self, value: npt.NDArray[np.int32]) -> None:
-        """Ordinal 6"""
-
-        if self._state != 12:
-            self._raise_unexpected_state(12)
-
-        self._write_nested_subarray(value)
-        self._state = 14
-
-    def write_dynamic_with_fixed_vector_subarray(self, value: npt.NDArray[np.int32]) -> None:
-        """Ordinal 7"""
-
-        if self._state != 14:
-            self._raise_unexpected_state(14)
-
-        self._write_dynamic_with_fixed_vector_subarray(value)
-        self._state = 16
-
-    def write_generic_subarray(self, value: Image[np.int32]) -> None:
-        """Ordinal 8"""
-
-        if self._state != 16:
-            self._raise_unexpected_state(16)
-
-        self._write_generic_subarray(value)
-        self._state = 18
-
-    @abc.abstractmethod
-    def _write_dynamic_with_fixed_int_subarray(self, value: npt.NDArray[np.int32]) -> None:
-        raise NotImplementedError()
-
-    @abc.abstractmethod
-    def _write_dynamic_with_fixed_float_subarray(self, value: npt.NDArray[np.float32]) -> None:
-        raise NotImplementedError()
-
-    @abc.abstractmethod
-    def _write_known_dim_count_with_fixed_int_subarray(self, value: npt.NDArray[np.int32]) -> None:
-        raise NotImplementedError()
-
-    @abc.abstractmethod
-    def _write_known_dim_count_with_fixed_float_subarray(self, value: npt.NDArray[np.float32]) -> None:
-        raise NotImplementedError()
-
-    @abc.abstractmethod
-    def _write_fixed_with_fixed_int_subarray(self, value: npt.NDArray[np.int32]) -> None:
-        raise NotImplementedError()
-
-    @abc.abstractmethod
-    def _write_fixed_with_fixed_float_subarray(self, value: npt.NDArray[np.float32]) -> None:
-        raise NotImplementedError()
-
-    @abc.abstractmethod
-    def _write_nested_subarray(self, value: npt.NDArray[np.int32]) -> None:
-        raise NotImplementedError()
+        self._write_samples(value)
+        self._state = 3
 
     @abc.abstractmethod
-    def _write_dynamic_with_fixed_vector_subarray(self, value: npt.NDArray[np.int32]) -> None:
+    def _write_gain(self, value: yardl.Float32) -> None:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _write_generic_subarray(self, value: Image[np.int32]) -> None:
+    def _write_samples(self, value: collections.abc.Iterable[yardl.Float64]) -> None:
         raise NotImplementedError()
 
     @abc.abstractmethod
@@ -2703,27 +2453,16 @@ class SubarraysWriterBase(abc.ABC):
 
     def _state_to_method_name(self, state: int) -> str:
         if state == 0:
-            return 'write_dynamic_with_fixed_int_subarray'
+            return 'write_gain'
         if state == 2:
-            return 'write_dynamic_with_fixed_float_subarray'
-        if state == 4:
-            return 'write_known_dim_count_with_fixed_int_subarray'
-        if state == 6:
-            return 'write_known_dim_count_with_fixed_float_subarray'
-        if state == 8:
-            return 'write_fixed_with_fixed_int_subarray'
-        if state == 10:
-            return 'write_fixed_with_fixed_float_subarray'
-        if state == 12:
-            return 'write_nested_subarray'
-        if state == 14:
-            return 'write_dynamic_with_fixed_vector_subarray'
-        if state == 16:
-            return 'write_generic_subarray'
+            return 'write_samples'
         return "<unknown>"
 
-class SubarraysReaderBase(abc.ABC):
-    """Abstract reader for the Subarrays protocol."""
+class CalibrationReaderBase(abc.ABC):
+    """Abstract reader for the Calibration protocol.
+
+    A calibration phase shared by protocols
+    """
 
 
     def __init__(self, skip_completed_check: bool = False) -> None:
@@ -2732,7 +2471,7 @@ class SubarraysReaderBase(abc.ABC):
 
     def close(self) -> None:
         self._close()
-        if not self._skip_completed_check and self._state != 18:
+        if not self._skip_completed_check and self._state != 4:
             if self._state % 2 == 1:
                 previous_method = self._state_to_method_name(self._state - 1)
                 raise ProtocolError(f"Protocol reader closed before all data was consumed. The iterable returned by '{previous_method}' was not fully consumed.")
@@ -2741,7 +2480,7 @@ class SubarraysReaderBase(abc.ABC):
                 raise ProtocolError(f"Protocol reader closed before all data was consumed. Expected call to '{expected_method}'.")
             	
 
-    schema = SubarraysWriterBase.schema
+    schema = CalibrationWriterBase.schema
 
     def __enter__(self):
         return self
@@ -2757,141 +2496,36 @@ class SubarraysReaderBase(abc.ABC):
     def _close(self) -> None:
         raise NotImplementedError()
 
-    def read_dynamic_with_fixed_int_subarray(self) -> npt.NDArray[np.int32]:
+    def read_gain(self) -> yardl.Float32:
         """Ordinal 0"""
 
         if self._state != 0:
             self._raise_unexpected_state(0)
 
-        value = self._read_dynamic_with_fixed_int_subarray()
+        value = self._read_gain()
         self._state = 2
         return value
 
-    def read_dynamic_with_fixed_float_subarray(self) -> npt.NDArray[np.float32]:
+    def read_samples(self) -> collections.abc.Iterable[yardl.Float64]:
         """Ordinal 1"""
 
         if self._state != 2:
             self._raise_unexpected_state(2)
 
-        value = self._read_dynamic_with_fixed_float_subarray()
-        self._state = 4
-        return value
-
-    def read_known_dim_count_with_fixed_int_subarray(self) -> npt.NDArray[np.int32]:
-        """Ordinal 2"""
-
-        if self._state != 4:
-            self._raise_unexpected_state(4)
-
-        value = self._read_known_dim_count_with_fixed_int_subarray()
-        self._state = 6
-        return value
-
-    def read_known_dim_count_with_fixed_float_subarray(self) -> npt.NDArray[np.float32]:
-        """Ordinal 3"""
-
-        if self._state != 6:
-            self._raise_unexpected_state(6)
-
-        value = self._read_known_dim_count_with_fixed_float_subarray()
-        self._state = 8
-        return value
-
-    def read_fixed_with_fixed_int_subarray(self) -> npt.NDArray[np.int32]:
-        """Ordinal 4"""
-
-        if self._state != 8:
-            self._raise_unexpected_state(8)
-
-        value = self._read_fixed_with_fixed_int_subarray()
-        self._state = 10
-        return value
-
-    def read_fixed_with_fixed_float_subarray(self) -> npt.NDArray[np.float32]:
-        """Ordinal 5"""
-
-        if self._state != 10:
-            self._raise_unexpected_state(10)
-
-        value = self._read_fixed_with_fixed_float_subarray()
-        self._state = 12
-        return value
-
-    def read_nested_subarray(self) -> npt.NDArray[np.int32]:
-        """Ordinal 6"""
-
-        if self._state != 12:
-            self._raise_unexpected_state(12)
-
-        value = self._read_nested_subarray()
-        self._state = 14
-        return value
-
-    def read_dynamic_with_fixed_vector_subarray(self) -> npt.NDArray[np.int32]:
-        """Ordinal 7"""
-
-        if self._state != 14:
-            self._raise_unexpected_state(14)
-
-        value = self._read_dynamic_with_fixed_vector_subarray()
-        self._state = 16
-        return value
-
-    def read_generic_subarray(self) -> Image[np.int32]:
-        """Ordinal 8"""
-
-        if self._state != 16:
-            self._raise_unexpected_state(16)
-
-        value = self._read_generic_subarray()
-        self._state = 18
-        return value
-
-    def copy_to(self, writer: SubarraysWriterBase) -> None:
-        writer.write_dynamic_with_fixed_int_subarray(self.read_dynamic_with_fixed_int_subarray())
-        writer.write_dynamic_with_fixed_float_subarray(self.read_dynamic_with_fixed_float_subarray())
-        writer.write_known_dim_count_with_fixed_int_subarray(self.read_known_dim_count_with_fixed_int_subarray())
-        writer.write_known_dim_count_with_fixed_float_subarray(self.read_known_dim_count_with_fixed_float_subarray())
-        writer.write_fixed_with_fixed_int_subarray(self.read_fixed_with_fixed_int_subarray())
-        writer.write_fixed_with_fixed_float_subarray(self.read_fixed_with_fixed_float_subarray())
-        writer.write_nested_subarray(self.read_nested_subarray())
-        writer.write_dynamic_with_fixed_vector_subarray(self.read_dynamic_with_fixed_vector_subarray())
-        writer.write_generic_subarray(self.read_generic_subarray())
-
-    @abc.abstractmethod
-    def _read_dynamic_with_fixed_int_subarray(self) -> npt.NDArray[np.int32]:
-        raise NotImplementedError()
-
-    @abc.abstractmethod
-    def _read_dynamic_with_fixed_float_subarray(self) -> npt.NDArray[np.float32]:
-        raise NotImplementedError()
-
-    @abc.abstractmethod
-    def _read_known_dim_count_with_fixed_int_subarray(self) -> npt.NDArray[np.int32]:
-        raise NotImplementedError()
-
-    @abc.abstractmethod
-    def _read_known_dim_count_with_fixed_float_subarray(self) -> npt.NDArray[np.float32]:
-        raise NotImplementedError()
-
-    @abc.abstractmethod
-    def _read_fixed_with_fixed_int_subarray(self) -> npt.NDArray[np.int32]:
-        raise NotImplementedError()
-
-    @abc.abstractmethod
-    def _read_fixed_with_fixed_float_subarray(self) -> npt.NDArray[np.float32]:
-        raise NotImplementedError()
+        value = self._read_samples()
+        self._state = 3
+        return self._wrap_iterable(value, 4)
 
-    @abc.abstractmethod
-    def _read_nested_subarray(self) -> npt.NDArray[np.int32]:
-        raise NotImplementedError()
+    def copy_to(self, writer: CalibrationWriterBase) -> None:
+        writer.write_gain(self.read_gain())
+        writer.write_samples(self.read_samples())
 
     @abc.abstractmethod
-    def _read_dynamic_with_fixed_vector_subarray(self) -> npt.NDArray[np.int32]:
+    def _read_gain(self) -> yardl.Float32:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _read_generic_subarray(self) -> Image[np.int32]:
+    def _read_samples(self) -> collections.abc.Iterable[yardl.Float64]:
         raise NotImplementedError()
 
     T = typing.TypeVar('T')
@@ -2910,37 +2544,46 @@ class SubarraysReaderBase(abc.ABC):
         	
     def _state_to_method_name(self, state: int) -> str:
         if state == 0:
-            return 'read_dynamic_with_fixed_int_subarray'
+            return 'read_gain'
         if state == 2:
-            return 'read_dynamic_with_fixed_float_subarray'
-        if state == 4:
-            return 'read_known_dim_count_with_fixed_int_subarray'
-        if state == 6:
-            return 'read_known_dim_count_with_fixed_float_subarray'
-        if state == 8:
-            return 'read_fixed_with_fixed_int_subarray'
-        if state == 10:
-            return 'read_fixed_with_fixed_float_subarray'
-        if state == 12:
-            return 'read_nested_subarray'
-        if state == 14:
-            return 'read_dynamic_with_fixed_vector_subarray'
-        if state == 16:
-            return 'read_generic_subarray'
+            return 'read_samples'
         return "<unknown>"
 
-class SubarraysInRecordsWriterBase(abc.ABC):
-    """Abstract writer for the SubarraysInRecords protocol."""
+class ProtocolWithSubProtocolsWriterBase(abc.ABC):
+    """Abstract writer for the ProtocolWithSubProtocols protocol."""
 
 
     def __init__(self) -> None:
         self._state = 0
+        self._calibration_writer = _ProtocolWithSubProtocolsCalibrationWriter(self)
+        self._recalibration_writer = _ProtocolWithSubProtocolsRecalibrationWriter(self)
 
-    schema = r"""{"protocol":{"name":"SubarraysInRecords","sequence":[{"name":"withFixedSubarrays","type":{"array":{"items":"TestModel.RecordWithFixedCollections"}}},{"name":"withVlenSubarrays","type":{"array":{"items":"TestModel.RecordWithVlenCollections"}}}]},"types":[{"name":"RecordWithFixedCollections","fields":[{"name":"fixedVector","type":{"vector":{"items":"int32","length":3}}},{"name":"fixedArray","type":{"array":{"items":"int32","dimensions":[{"length":2},{"length":3}]}}}]},{"name":"RecordWithVlenCollections","fields":[{"name":"vector","type":{"vector":{"items":"int32"}}},{"name":"array","type":{"array":{"items":"int32","dimensions":2}}}]}]}"""
+    @property
+    def calibration(self) -> CalibrationWriterBase:
+        """Writer for the `calibration` steps, which are written through this writer.
+
+        Calibration before the data
+        """
+
+        return self._calibration_writer
+
+    @property
+    def recalibration(self) -> CalibrationWriterBase:
+        """Writer for the `recalibration` steps, which are written through this writer."""
+
+        return self._recalibration_writer
+
+    schema = r"""{"protocol":{"name":"ProtocolWithSubProtocols","sequence":[{"name":"header","type":"string"},{"name":"calibrationGain","type":"float32"},{"name":"calibrationSamples","type":{"stream":{"items":"float64"}}},{"name":"data","type":{"stream":{"items":"int32"}}},{"name":"recalibrationGain","type":"float32"},{"name":"recalibrationSamples","type":{"stream":{"items":"float64"}}}]},"types":null}"""
 
     def close(self) -> None:
+        if self._state == 11:
+            try:
+                self._end_stream()
+                return
+            finally:
+                self._close()
         self._close()
-        if self._state != 4:
+        if self._state != 12:
             expected_method = self._state_to_method_name((self._state + 1) & ~1)
             raise ProtocolError(f"Protocol writer closed before all steps were called. Expected to call to '{expected_method}'.")
 
@@ -2954,30 +2597,88 @@ class SubarraysInRecordsWriterBase(abc.ABC):
             if exc is None:
                 raise e
 
-    def write_with_fixed_subarrays(self, value: npt.NDArray[np.void]) -> None:
+    def write_header(self, value: str) -> None:
         """Ordinal 0"""
 
         if self._state != 0:
             self._raise_unexpected_state(0)
 
-        self._write_with_fixed_subarrays(value)
+        self._write_header(value)
         self._state = 2
 
-    def write_with_vlen_subarrays(self, value: npt.NDArray[np.void]) -> None:
+    def write_calibration_gain(self, value: yardl.Float32) -> None:
         """Ordinal 1"""
 
         if self._state != 2:
             self._raise_unexpected_state(2)
 
-        self._write_with_vlen_subarrays(value)
+        self._write_calibration_gain(value)
         self._state = 4
 
+    def write_calibration_samples(self, value: collections.abc.Iterable[yardl.Float64]) -> None:
+        """Ordinal 2"""
+
+        if self._state & ~1 != 4:
+            self._raise_unexpected_state(4)
+
+        self._write_calibration_samples(value)
+        self._state = 5
+
+    def write_data(self, value: collections.abc.Iterable[yardl.Int32]) -> None:
+        """Ordinal 3"""
+
+        if self._state == 5:
+            self._end_stream()
+            self._state = 6
+        elif self._state & ~1 != 6:
+            self._raise_unexpected_state(6)
+
+        self._write_data(value)
+        self._state = 7
+
+    def write_recalibration_gain(self, value: yardl.Float32) -> None:
+        """Ordinal 4"""
+
+        if self._state == 7:
+            self._end_stream()
+            self._state = 8
+        elif self._state != 8:
+            self._raise_unexpected_state(8)
+
+        self._write_recalibration_gain(value)
+        self._state = 10
+
+    def write_recalibration_samples(self, value: collections.abc.Iterable[yardl.Float64]) -> None:
+        """Ordinal 5"""
+
+        if self._state & ~1 != 10:
+            self._raise_unexpected_state(10)
+
+        self._write_recalibration_samples(value)
+        self._state = 11
+
     @abc.abstractmethod
-    def _write_with_fixed_subarrays(self, value: npt.NDArray[np.void]) -> None:
+    def _write_header(self, value: str) -> None:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _write_with_vlen_subarrays(self, value: npt.NDArray[np.void]) -> None:
+    def _write_calibration_gain(self, value: yardl.Float32) -> None:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _write_calibration_samples(self, value: collections.abc.Iterable[yardl.Float64]) -> None:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _write_data(self, value: collections.abc.Iterable[yardl.Int32]) -> None:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _write_recalibration_gain(self, value: yardl.Float32) -> None:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _write_recalibration_samples(self, value: collections.abc.Iterable[yardl.Float64]) -> None:
         raise NotImplementedError()
 
     @abc.abstractmethod
@@ -2995,22 +2696,47 @@ class SubarraysInRecordsWriterBase(abc.ABC):
 
     def _state_to_method_name(self, state: int) -> str:
         if state == 0:
-            return 'write_with_fixed_subarrays'
+            return 'write_header'
         if state == 2:
-            return 'write_with_vlen_subarrays'
+            return 'write_calibration_gain'
+        if state == 4:
+            return 'write_calibration_samples'
+        if state == 6:
+            return 'write_data'
+        if state == 8:
+            return 'write_recalibration_gain'
+        if state == 10:
+            return 'write_recalibration_samples'
         return "<unknown>"
 
-class SubarraysInRecordsReaderBase(abc.ABC):
-    """Abstract reader for the SubarraysInRecords protocol."""
+class ProtocolWithSubProtocolsReaderBase(abc.ABC):
+    """Abstract reader for the ProtocolWithSubProtocols protocol."""
 
 
     def __init__(self, skip_completed_check: bool = False) -> None:
         self._skip_completed_check = skip_completed_check
         self._state = 0
+        self._calibration_reader = _ProtocolWithSubProtocolsCalibrationReader(self)
+        self._recalibration_reader = _ProtocolWithSubProtocolsRecalibrationReader(self)
+
+    @property
+    def calibration(self) -> CalibrationReaderBase:
+        """Reader for the `calibration` steps, which are read through this reader.
+
+        Calibration before the data
+        """
+
+        return self._calibration_reader
+
+    @property
+    def recalibration(self) -> CalibrationReaderBase:
+        """Reader for the `recalibration` steps, which are read through this reader."""
+
+        return self._recalibration_reader
 
     def close(self) -> None:
         self._close()
-        if not self._skip_completed_check and self._state != 4:
+        if not self._skip_completed_check and self._state != 12:
             if self._state % 2 == 1:
                 previous_method = self._state_to_method_name(self._state - 1)
                 raise ProtocolError(f"Protocol reader closed before all data was consumed. The iterable returned by '{previous_method}' was not fully consumed.")
@@ -3019,7 +2745,7 @@ class SubarraysInRecordsReaderBase(abc.ABC):
                 raise ProtocolError(f"Protocol reader closed before all data was consumed. Expected call to '{expected_method}'.")
             	
 
-    schema = SubarraysInRecordsWriterBase.schema
+    schema = ProtocolWithSubProtocolsWriterBase.schema
 
     def __enter__(self):
         return self
@@ -3035,42 +2761,102 @@ class SubarraysInRecordsReaderBase(abc.ABC):
     def _close(self) -> None:
         raise NotImplementedError()
 
-    def read_with_fixed_subarrays(self) -> npt.NDArray[np.void]:
+    def read_header(self) -> str:
         """Ordinal 0"""
 
         if self._state != 0:
             self._raise_unexpected_state(0)
 
-        value = self._read_with_fixed_subarrays()
+        value = self._read_header()
         self._state = 2
         return value
 
-    def read_with_vlen_subarrays(self) -> npt.NDArray[np.void]:
+    def read_calibration_gain(self) -> yardl.Float32:
         """Ordinal 1"""
 
         if self._state != 2:
             self._raise_unexpected_state(2)
 
-        value = self._read_with_vlen_subarrays()
+        value = self._read_calibration_gain()
         self._state = 4
         return value
 
-    def copy_to(self, writer: SubarraysInRecordsWriterBase) -> None:
-        writer.write_with_fixed_subarrays(self.read_with_fixed_subarrays())
-        writer.write_with_vlen_subarrays(self.read_with_vlen_subarrays())
+    def read_calibration_samples(self) -> collections.abc.Iterable[yardl.Float64]:
+        """Ordinal 2"""
+
+        if self._state != 4:
+            self._raise_unexpected_state(4)
+
+        value = self._read_calibration_samples()
+        self._state = 5
+        return self._wrap_iterable(value, 6)
+
+    def read_data(self) -> collections.abc.Iterable[yardl.Int32]:
+        """Ordinal 3"""
+
+        if self._state != 6:
+            self._raise_unexpected_state(6)
+
+        value = self._read_data()
+        self._state = 7
+        return self._wrap_iterable(value, 8)
+
+    def read_recalibration_gain(self) -> yardl.Float32:
+        """Ordinal 4"""
+
+        if self._state != 8:
+            self._raise_unexpected_state(8)
+
+        value = self._read_recalibration_gain()
+        self._state = 10
+        return value
+
+    def read_recalibration_samples(self) -> collections.abc.Iterable[yardl.Float64]:
+        """Ordinal 5"""
+
+        if self._state != 10:
+            self._raise_unexpected_state(10)
+
+        value = self._read_recalibration_samples()
+        self._state = 11
+        return self._wrap_iterable(value, 12)
+
+    def copy_to(self, writer: ProtocolWithSubProtocolsWriterBase) -> None:
+        writer.write_header(self.read_header())
+        writer.write_calibration_gain(self.read_calibration_gain())
+        writer.write_calibration_samples(self.read_calibration_samples())
+        writer.write_data(self.read_data())
+        writer.write_recalibration_gain(self.read_recalibration_gain())
+        writer.write_recalibration_samples(self.read_recalibration_samples())
 
     @abc.abstractmethod
-    def _read_with_fixed_subarrays(self) -> npt.NDArray[np.void]:
+    def _read_header(self) -> str:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _read_with_vlen_subarrays(self) -> npt.NDArray[np.void]:
+    def _read_calibration_gain(self) -> yardl.Float32:
         raise NotImplementedError()
 
-    T = typing.TypeVar('T')
-    def _wrap_iterable(self, iterable: collections.abc.Iterable[T], final_state: int) -> collections.abc.Iterable[T]:
-        yield from iterable
-        self._state = final_state
+    @abc.abstractmethod
+    def _read_calibration_samples(self) -> collections.abc.Iterable[yardl.Float64]:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _read_data(self) -> collections.abc.Iterable[yardl.Int32]:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _read_recalibration_gain(self) -> yardl.Float32:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _read_recalibration_samples(self) -> collections.abc.Iterable[yardl.Float64]:
+        raise NotImplementedError()
+
+    T = typing.TypeVar('T')
+    def _wrap_iterable(self, iterable: collections.abc.Iterable[T], final_state: int) -> collections.abc.Iterable[T]:
+        yield from iterable
+        self._state = final_state
 
     def _raise_unexpected_state(self, actual: int) -> None:
         actual_method = self._state_to_method_name(actual)
@@ -3083,23 +2869,101 @@ class SubarraysInRecordsReaderBase(abc.ABC):
         	
     def _state_to_method_name(self, state: int) -> str:
         if state == 0:
-            return 'read_with_fixed_subarrays'
+            return 'read_header'
         if state == 2:
-            return 'read_with_vlen_subarrays'
+            return 'read_calibration_gain'
+        if state == 4:
+            return 'read_calibration_samples'
+        if state == 6:
+            return 'read_data'
+        if state == 8:
+            return 'read_recalibration_gain'
+        if state == 10:
+            return 'read_recalibration_samples'
         return "<unknown>"
 
-class NDArraysWriterBase(abc.ABC):
-    """Abstract writer for the NDArrays protocol."""
+class _ProtocolWithSubProtocolsCalibrationWriter(CalibrationWriterBase):
+    """Writes the `calibration` steps of the ProtocolWithSubProtocols protocol."""
+
+    def __init__(self, outer: ProtocolWithSubProtocolsWriterBase) -> None:
+        super().__init__()
+        self._outer = outer
+
+    def _write_gain(self, value: yardl.Float32) -> None:
+        self._outer.write_calibration_gain(value)
+
+    def _write_samples(self, value: collections.abc.Iterable[yardl.Float64]) -> None:
+        self._outer.write_calibration_samples(value)
+
+    def _close(self) -> None:
+        pass
+
+    def _end_stream(self) -> None:
+        pass
+
+class _ProtocolWithSubProtocolsCalibrationReader(CalibrationReaderBase):
+    """Reads the `calibration` steps of the ProtocolWithSubProtocols protocol."""
+
+    def __init__(self, outer: ProtocolWithSubProtocolsReaderBase) -> None:
+        super().__init__()
+        self._outer = outer
+
+    def _read_gain(self) -> yardl.Float32:
+        return self._outer.read_calibration_gain()
+
+    def _read_samples(self) -> collections.abc.Iterable[yardl.Float64]:
+        return self._outer.read_calibration_samples()
+
+    def _close(self) -> None:
+        pass
+
+class _ProtocolWithSubProtocolsRecalibrationWriter(CalibrationWriterBase):
+    """Writes the `recalibration` steps of the ProtocolWithSubProtocols protocol."""
+
+    def __init__(self, outer: ProtocolWithSubProtocolsWriterBase) -> None:
+        super().__init__()
+        self._outer = outer
+
+    def _write_gain(self, value: yardl.Float32) -> None:
+        self._outer.write_recalibration_gain(value)
+
+    def _write_samples(self, value: collections.abc.Iterable[yardl.Float64]) -> None:
+        self._outer.write_recalibration_samples(value)
+
+    def _close(self) -> None:
+        pass
+
+    def _end_stream(self) -> None:
+        pass
+
+class _ProtocolWithSubProtocolsRecalibrationReader(CalibrationReaderBase):
+    """Reads the `recalibration` steps of the ProtocolWithSubProtocols protocol."""
+
+    def __init__(self, outer: ProtocolWithSubProtocolsReaderBase) -> None:
+        super().__init__()
+        self._outer = outer
+
+    def _read_gain(self) -> yardl.Float32:
+        return self._outer.read_recalibration_gain()
+
+    def _read_samples(self) -> collections.abc.Iterable[yardl.Float64]:
+        return self._outer.read_recalibration_samples()
+
+    def _close(self) -> None:
+        pass
+
+class ProtocolWithHalfPrecisionWriterBase(abc.ABC):
+    """Abstract writer for the ProtocolWithHalfPrecision protocol."""
 
 
     def __init__(self) -> None:
         self._state = 0
 
-    schema = r"""{"protocol":{"name":"NDArrays","sequence":[{"name":"ints","type":{"array":{"items":"int32","dimensions":2}}},{"name":"simpleRecordArray","type":{"array":{"items":"TestModel.SimpleRecord","dimensions":2}}},{"name":"recordWithVlensArray","type":{"array":{"items":"TestModel.RecordWithVlens","dimensions":2}}},{"name":"recordWithNDArrays","type":"TestModel.RecordWithNDArrays"},{"name":"namedArray","type":"TestModel.NamedNDArray"}]},"types":[{"name":"NamedNDArray","type":{"array":{"items":"int32","dimensions":[{"name":"dimA"},{"name":"dimB"}]}}},{"name":"RecordWithNDArrays","fields":[{"name":"ints","type":{"array":{"items":"int32","dimensions":2}}},{"name":"fixedSimpleRecordArray","type":{"array":{"items":"TestModel.SimpleRecord","dimensions":2}}},{"name":"fixedRecordWithVlensArray","type":{"array":{"items":"TestModel.RecordWithVlens","dimensions":2}}}]},{"name":"RecordWithVlens","fields":[{"name":"a","type":{"vector":{"items":"TestModel.SimpleRecord"}}},{"name":"b","type":"int32"},{"name":"c","type":"int32"}]},{"name":"SimpleRecord","fields":[{"name":"x","type":"int32"},{"name":"y","type":"int32"},{"name":"z","type":"int32"}]}]}"""
+    schema = r"""{"protocol":{"name":"ProtocolWithHalfPrecision","sequence":[{"name":"halves","type":{"vector":{"items":"float16"}}},{"name":"recWithHalves","type":"TestModel.RecordWithHalfPrecision"}]},"types":[{"name":"RecordWithHalfPrecision","fields":[{"name":"half","type":"float16"},{"name":"brain","type":"bfloat16"},{"name":"halfVector","type":{"vector":{"items":"float16"}}},{"name":"brainArray","type":{"array":{"items":"bfloat16"}}}]}]}"""
 
     def close(self) -> None:
         self._close()
-        if self._state != 10:
+        if self._state != 4:
             expected_method = self._state_to_method_name((self._state + 1) & ~1)
             raise ProtocolError(f"Protocol writer closed before all steps were called. Expected to call to '{expected_method}'.")
 
@@ -3113,69 +2977,30 @@ class NDArraysWriterBase(abc.ABC):
             if exc is None:
                 raise e
 
-    def write_ints(self, value: npt.NDArray[np.int32]) -> None:
+    def write_halves(self, value: list[yardl.Float16]) -> None:
         """Ordinal 0"""
 
         if self._state != 0:
             self._raise_unexpected_state(0)
 
-        self._write_ints(value)
+        self._write_halves(value)
         self._state = 2
 
-    def write_simple_record_array(self, value: npt.NDArray[np.void]) -> None:
+    def write_rec_with_halves(self, value: RecordWithHalfPrecision) -> None:
         """Ordinal 1"""
 
         if self._state != 2:
             self._raise_unexpected_state(2)
 
-        self._write_simple_record_array(value)
+        self._write_rec_with_halves(value)
         self._state = 4
 
-    def write_record_with_vlens_array(self, value: npt.NDArray[np.void]) -> None:
-        """Ordinal 2"""
-
-        if self._state != 4:
-            self._raise_unexpected_state(4)
-
-        self._write_record_with_vlens_array(value)
-        self._state = 6
-
-    def write_record_with_nd_arrays(self, value: RecordWithNDArrays) -> None:
-        """Ordinal 3"""
-
-        if self._state != 6:
-            self._raise_unexpected_state(6)
-
-        self._write_record_with_nd_arrays(value)
-        self._state = 8
-
-    def write_named_array(self, value: NamedNDArray) -> None:
-        """Ordinal 4"""
-
-        if self._state != 8:
-            self._raise_unexpected_state(8)
-
-        self._write_named_array(value)
-        self._state = 10
-
-    @abc.abstractmethod
-    def _write_ints(self, value: npt.NDArray[np.int32]) -> None:
-        raise NotImplementedError()
-
-    @abc.abstractmethod
-    def _write_simple_record_array(self, value: npt.NDArray[np.void]) -> None:
-        raise NotImplementedError()
-
-    @abc.abstractmethod
-    def _write_record_with_vlens_array(self, value: npt.NDArray[np.void]) -> None:
-        raise NotImplementedError()
-
     @abc.abstractmethod
-    def _write_record_with_nd_arrays(self, value: RecordWithNDArrays) -> None:
+    def _write_halves(self, value: list[yardl.Float16]) -> None:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _write_named_array(self, value: NamedNDArray) -> None:
+    def _write_rec_with_halves(self, value: RecordWithHalfPrecision) -> None:
         raise NotImplementedError()
 
     @abc.abstractmethod
@@ -3193,19 +3018,13 @@ class NDArraysWriterBase(abc.ABC):
 
     def _state_to_method_name(self, state: int) -> str:
         if state == 0:
-            return 'write_ints'
+            return 'write_halves'
         if state == 2:
-            return 'write_simple_record_array'
-        if state == 4:
-            return 'write_record_with_vlens_array'
-        if state == 6:
-            return 'write_record_with_nd_arrays'
-        if state == 8:
-            return 'write_named_array'
+            return 'write_rec_with_halves'
         return "<unknown>"
 
-class NDArraysReaderBase(abc.ABC):
-    """Abstract reader for the NDArrays protocol."""
+class ProtocolWithHalfPrecisionReaderBase(abc.ABC):
+    """Abstract reader for the ProtocolWithHalfPrecision protocol."""
 
 
     def __init__(self, skip_completed_check: bool = False) -> None:
@@ -3214,7 +3033,7 @@ class NDArraysReaderBase(abc.ABC):
 
     def close(self) -> None:
         self._close()
-        if not self._skip_completed_check and self._state != 10:
+        if not self._skip_completed_check and self._state != 4:
             if self._state % 2 == 1:
                 previous_method = self._state_to_method_name(self._state - 1)
                 raise ProtocolError(f"Protocol reader closed before all data was consumed. The iterable returned by '{previous_method}' was not fully consumed.")
@@ -3223,7 +3042,7 @@ class NDArraysReaderBase(abc.ABC):
                 raise ProtocolError(f"Protocol reader closed before all data was consumed. Expected call to '{expected_method}'.")
             	
 
-    schema = NDArraysWriterBase.schema
+    schema = ProtocolWithHalfPrecisionWriterBase.schema
 
     def __enter__(self):
         return self
@@ -3239,81 +3058,36 @@ class NDArraysReaderBase(abc.ABC):
     def _close(self) -> None:
         raise NotImplementedError()
 
-    def read_ints(self) -> npt.NDArray[np.int32]:
+    def read_halves(self) -> list[yardl.Float16]:
         """Ordinal 0"""
 
         if self._state != 0:
             self._raise_unexpected_state(0)
 
-        value = self._read_ints()
+        value = self._read_halves()
         self._state = 2
         return value
 
-    def read_simple_record_array(self) -> npt.NDArray[np.void]:
+    def read_rec_with_halves(self) -> RecordWithHalfPrecision:
         """Ordinal 1"""
 
         if self._state != 2:
             self._raise_unexpected_state(2)
 
-        value = self._read_simple_record_array()
+        value = self._read_rec_with_halves()
         self._state = 4
         return value
 
-    def read_record_with_vlens_array(self) -> npt.NDArray[np.void]:
-        """Ordinal 2"""
-
-        if self._state != 4:
-            self._raise_unexpected_state(4)
-
-        value = self._read_record_with_vlens_array()
-        self._state = 6
-        return value
-
-    def read_record_with_nd_arrays(self) -> RecordWithNDArrays:
-        """Ordinal 3"""
-
-        if self._state != 6:
-            self._raise_unexpected_state(6)
-
-        value = self._read_record_with_nd_arrays()
-        self._state = 8
-        return value
-
-    def read_named_array(self) -> NamedNDArray:
-        """Ordinal 4"""
-
-        if self._state != 8:
-            self._raise_unexpected_state(8)
-
-        value = self._read_named_array()
-        self._state = 10
-        return value
-
-    def copy_to(self, writer: NDArraysWriterBase) -> None:
-        writer.write_ints(self.read_ints())
-        writer.write_simple_record_array(self.read_simple_record_array())
-        writer.write_record_with_vlens_array(self.read_record_with_vlens_array())
-        writer.write_record_with_nd_arrays(self.read_record_with_nd_arrays())
-        writer.write_named_array(self.read_named_array())
-
-    @abc.abstractmethod
-    def _read_ints(self) -> npt.NDArray[np.int32]:
-        raise NotImplementedError()
-
-    @abc.abstractmethod
-    def _read_simple_record_array(self) -> npt.NDArray[np.void]:
-        raise NotImplementedError()
-
-    @abc.abstractmethod
-    def _read_record_with_vlens_array(self) -> npt.NDArray[np.void]:
-        raise NotImplementedError()
+    def copy_to(self, writer: ProtocolWithHalfPrecisionWriterBase) -> None:
+        writer.write_halves(self.read_halves())
+        writer.write_rec_with_halves(self.read_rec_with_halves())
 
     @abc.abstractmethod
-    def _read_record_with_nd_arrays(self) -> RecordWithNDArrays:
+    def _read_halves(self) -> list[yardl.Float16]:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _read_named_array(self) -> NamedNDArray:
+    def _read_rec_with_halves(self) -> RecordWithHalfPrecision:
         raise NotImplementedError()
 
     T = typing.TypeVar('T')
@@ -3332,29 +3106,23 @@ class NDArraysReaderBase(abc.ABC):
         	
     def _state_to_method_name(self, state: int) -> str:
         if state == 0:
-            return 'read_ints'
+            return 'read_halves'
         if state == 2:
-            return 'read_simple_record_array'
-        if state == 4:
-            return 'read_record_with_vlens_array'
-        if state == 6:
-            return 'read_record_with_nd_arrays'
-        if state == 8:
-            return 'read_named_array'
+            return 'read_rec_with_halves'
         return "<unknown>"
 
-class NDArraysSingleDimensionWriterBase(abc.ABC):
-    """Abstract writer for the NDArraysSingleDimension protocol."""
+class OptionalVectorsWriterBase(abc.ABC):
+    """Abstract writer for the OptionalVectors protocol."""
 
 
     def __init__(self) -> None:
         self._state = 0
 
-    schema = r"""{"protocol":{"name":"NDArraysSingleDimension","sequence":[{"name":"ints","type":{"array":{"items":"int32","dimensions":1}}},{"name":"simpleRecordArray","type":{"array":{"items":"TestModel.SimpleRecord","dimensions":1}}},{"name":"recordWithVlensArray","type":{"array":{"items":"TestModel.RecordWithVlens","dimensions":1}}},{"name":"recordWithNDArrays","type":"TestModel.RecordWithNDArraysSingleDimension"}]},"types":[{"name":"RecordWithNDArraysSingleDimension","fields":[{"name":"ints","type":{"array":{"items":"int32","dimensions":1}}},{"name":"fixedSimpleRecordArray","type":{"array":{"items":"TestModel.SimpleRecord","dimensions":1}}},{"name":"fixedRecordWithVlensArray","type":{"array":{"items":"TestModel.RecordWithVlens","dimensions":1}}}]},{"name":"RecordWithVlens","fields":[{"name":"a","type":{"vector":{"items":"TestModel.SimpleRecord"}}},{"name":"b","type":"int32"},{"name":"c","type":"int32"}]},{"name":"SimpleRecord","fields":[{"name":"x","type":"int32"},{"name":"y","type":"int32"},{"name":"z","type":"int32"}]}]}"""
+    schema = r"""{"protocol":{"name":"OptionalVectors","sequence":[{"name":"recordWithOptionalVector","type":"TestModel.RecordWithOptionalVector"}]},"types":[{"name":"RecordWithOptionalVector","fields":[{"name":"optionalVector","type":[null,{"vector":{"items":"int32"}}]}]}]}"""
 
     def close(self) -> None:
         self._close()
-        if self._state != 8:
+        if self._state != 2:
             expected_method = self._state_to_method_name((self._state + 1) & ~1)
             raise ProtocolError(f"Protocol writer closed before all steps were called. Expected to call to '{expected_method}'.")
 
@@ -3368,56 +3136,17 @@ class NDArraysSingleDimensionWriterBase(abc.ABC):
             if exc is None:
                 raise e
 
-    def write_ints(self, value: npt.NDArray[np.int32]) -> None:
+    def write_record_with_optional_vector(self, value: RecordWithOptionalVector) -> None:
         """Ordinal 0"""
 
         if self._state != 0:
             self._raise_unexpected_state(0)
 
-        self._write_ints(value)
+        self._write_record_with_optional_vector(value)
         self._state = 2
 
-    def write_simple_record_array(self, value: npt.NDArray[np.void]) -> None:
-        """Ordinal 1"""
-
-        if self._state != 2:
-            self._raise_unexpected_state(2)
-
-        self._write_simple_record_array(value)
-        self._state = 4
-
-    def write_record_with_vlens_array(self, value: npt.NDArray[np.void]) -> None:
-        """Ordinal 2"""
-
-        if self._state != 4:
-            self._raise_unexpected_state(4)
-
-        self._write_record_with_vlens_array(value)
-        self._state = 6
-
-    def write_record_with_nd_arrays(self, value: RecordWithNDArraysSingleDimension) -> None:
-        """Ordinal 3"""
-
-        if self._state != 6:
-            self._raise_unexpected_state(6)
-
-        self._write_record_with_nd_arrays(value)
-        self._state = 8
-
-    @abc.abstractmethod
-    def _write_ints(self, value: npt.NDArray[np.int32]) -> None:
-        raise NotImplementedError()
-
-    @abc.abstractmethod
-    def _write_simple_record_array(self, value: npt.NDArray[np.void]) -> None:
-        raise NotImplementedError()
-
-    @abc.abstractmethod
-    def _write_record_with_vlens_array(self, value: npt.NDArray[np.void]) -> None:
-        raise NotImplementedError()
-
     @abc.abstractmethod
-    def _write_record_with_nd_arrays(self, value: RecordWithNDArraysSingleDimension) -> None:
+    def _write_record_with_optional_vector(self, value: RecordWithOptionalVector) -> None:
         raise NotImplementedError()
 
     @abc.abstractmethod
@@ -3435,17 +3164,11 @@ class NDArraysSingleDimensionWriterBase(abc.ABC):
 
     def _state_to_method_name(self, state: int) -> str:
         if state == 0:
-            return 'write_ints'
-        if state == 2:
-            return 'write_simple_record_array'
-        if state == 4:
-            return 'write_record_with_vlens_array'
-        if state == 6:
-            return 'write_record_with_nd_arrays'
+            return 'write_record_with_optional_vector'
         return "<unknown>"
 
-class NDArraysSingleDimensionReaderBase(abc.ABC):
-    """Abstract reader for the NDArraysSingleDimension protocol."""
+class OptionalVectorsReaderBase(abc.ABC):
+    """Abstract reader for the OptionalVectors protocol."""
 
 
     def __init__(self, skip_completed_check: bool = False) -> None:
@@ -3454,7 +3177,7 @@ class NDArraysSingleDimensionReaderBase(abc.ABC):
 
     def close(self) -> None:
         self._close()
-        if not self._skip_completed_check and self._state != 8:
+        if not self._skip_completed_check and self._state != 2:
             if self._state % 2 == 1:
                 previous_method = self._state_to_method_name(self._state - 1)
                 raise ProtocolError(f"Protocol reader closed before all data was consumed. The iterable returned by '{previous_method}' was not fully consumed.")
@@ -3463,7 +3186,7 @@ class NDArraysSingleDimensionReaderBase(abc.ABC):
                 raise ProtocolError(f"Protocol reader closed before all data was consumed. Expected call to '{expected_method}'.")
             	
 
-    schema = NDArraysSingleDimensionWriterBase.schema
+    schema = OptionalVectorsWriterBase.schema
 
     def __enter__(self):
         return self
@@ -3479,66 +3202,21 @@ class NDArraysSingleDimensionReaderBase(abc.ABC):
     def _close(self) -> None:
         raise NotImplementedError()
 
-    def read_ints(self) -> npt.NDArray[np.int32]:
+    def read_record_with_optional_vector(self) -> RecordWithOptionalVector:
         """Ordinal 0"""
 
         if self._state != 0:
             self._raise_unexpected_state(0)
 
-        value = self._read_ints()
+        value = self._read_record_with_optional_vector()
         self._state = 2
         return value
 
-    def read_simple_record_array(self) -> npt.NDArray[np.void]:
-        """Ordinal 1"""
-
-        if self._state != 2:
-            self._raise_unexpected_state(2)
-
-        value = self._read_simple_record_array()
-        self._state = 4
-        return value
-
-    def read_record_with_vlens_array(self) -> npt.NDArray[np.void]:
-        """Ordinal 2"""
-
-        if self._state != 4:
-            self._raise_unexpected_state(4)
-
-        value = self._read_record_with_vlens_array()
-        self._state = 6
-        return value
-
-    def read_record_with_nd_arrays(self) -> RecordWithNDArraysSingleDimension:
-        """Ordinal 3"""
-
-        if self._state != 6:
-            self._raise_unexpected_state(6)
-
-        value = self._read_record_with_nd_arrays()
-        self._state = 8
-        return value
-
-    def copy_to(self, writer: NDArraysSingleDimensionWriterBase) -> None:
-        writer.write_ints(self.read_ints())
-        writer.write_simple_record_array(self.read_simple_record_array())
-        writer.write_record_with_vlens_array(self.read_record_with_vlens_array())
-        writer.write_record_with_nd_arrays(self.read_record_with_nd_arrays())
-
-    @abc.abstractmethod
-    def _read_ints(self) -> npt.NDArray[np.int32]:
-        raise NotImplementedError()
-
-    @abc.abstractmethod
-    def _read_simple_record_array(self) -> npt.NDArray[np.void]:
-        raise NotImplementedError()
-
-    @abc.abstractmethod
-    def _read_record_with_vlens_array(self) -> npt.NDArray[np.void]:
-        raise NotImplementedError()
+    def copy_to(self, writer: OptionalVectorsWriterBase) -> None:
+        writer.write_record_with_optional_vector(self.read_record_with_optional_vector())
 
     @abc.abstractmethod
-    def _read_record_with_nd_arrays(self) -> RecordWithNDArraysSingleDimension:
+    def _read_record_with_optional_vector(self) -> RecordWithOptionalVector:
         raise NotImplementedError()
 
     T = typing.TypeVar('T')
@@ -3557,23 +3235,17 @@ class NDArraysSingleDimensionReaderBase(abc.ABC):
         	
     def _state_to_method_name(self, state: int) -> str:
         if state == 0:
-            return 'read_ints'
-        if state == 2:
-            return 'read_simple_record_array'
-        if state == 4:
-            return 'read_record_with_vlens_array'
-        if state == 6:
-            return 'read_record_with_nd_arrays'
+            return 'read_record_with_optional_vector'
         return "<unknown>"
 
-class DynamicNDArraysWriterBase(abc.ABC):
-    """Abstract writer for the DynamicNDArrays protocol."""
+class FixedVectorsWriterBase(abc.ABC):
+    """Abstract writer for the FixedVectors protocol."""
 
 
     def __init__(self) -> None:
         self._state = 0
 
-    schema = r"""{"protocol":{"name":"DynamicNDArrays","sequence":[{"name":"ints","type":{"array":{"items":"int32"}}},{"name":"simpleRecordArray","type":{"array":{"items":"TestModel.SimpleRecord"}}},{"name":"recordWithVlensArray","type":{"array":{"items":"TestModel.RecordWithVlens"}}},{"name":"recordWithDynamicNDArrays","type":"TestModel.RecordWithDynamicNDArrays"}]},"types":[{"name":"IntArray","type":{"array":{"items":"int32"}}},{"name":"RecordWithDynamicNDArrays","fields":[{"name":"ints","type":"TestModel.IntArray"},{"name":"simpleRecordArray","type":{"array":{"items":"TestModel.SimpleRecord"}}},{"name":"recordWithVlensArray","type":{"array":{"items":"TestModel.RecordWithVlens"}}}]},{"name":"RecordWithVlens","fields":[{"name":"a","type":{"vector":{"items":"TestModel.SimpleRecord"}}},{"name":"b","type":"int32"},{"name":"c","type":"int32"}]},{"name":"SimpleRecord","fields":[{"name":"x","type":"int32"},{"name":"y","type":"int32"},{"name":"z","type":"int32"}]}]}"""
+    schema = r"""{"protocol":{"name":"FixedVectors","sequence":[{"name":"fixedIntVector","type":{"vector":{"items":"int32","length":5}}},{"name":"fixedSimpleRecordVector","type":{"vector":{"items":"TestModel.SimpleRecord","length":3}}},{"name":"fixedRecordWithVlensVector","type":{"vector":{"items":"TestModel.RecordWithVlens","length":2}}},{"name":"recordWithFixedVectors","type":"TestModel.RecordWithFixedVectors"}]},"types":[{"name":"RecordWithFixedVectors","fields":[{"name":"fixedIntVector","type":{"vector":{"items":"int32","length":5}}},{"name":"fixedSimpleRecordVector","type":{"vector":{"items":"TestModel.SimpleRecord","length":3}}},{"name":"fixedRecordWithVlensVector","type":{"vector":{"items":"TestModel.RecordWithVlens","length":2}}}]},{"name":"RecordWithVlens","fields":[{"name":"a","type":{"vector":{"items":"TestModel.SimpleRecord"}}},{"name":"b","type":"int32"},{"name":"c","type":"int32"}]},{"name":"SimpleRecord","fields":[{"name":"x","type":"int32"},{"name":"y","type":"int32"},{"name":"z","type":"int32"}]}]}"""
 
     def close(self) -> None:
         self._close()
@@ -3591,56 +3263,56 @@ class DynamicNDArraysWriterBase(abc.ABC):
             if exc is None:
                 raise e
 
-    def write_ints(self, value: npt.NDArray[np.int32]) -> None:
+    def write_fixed_int_vector(self, value: list[yardl.Int32]) -> None:
         """Ordinal 0"""
 
         if self._state != 0:
             self._raise_unexpected_state(0)
 
-        self._write_ints(value)
+        self._write_fixed_int_vector(value)
         self._state = 2
 
-    def write_simple_record_array(self, value: npt.NDArray[np.void]) -> None:
+    def write_fixed_simple_record_vector(self, value: list[SimpleRecord]) -> None:
         """Ordinal 1"""
 
         if self._state != 2:
             self._raise_unexpected_state(2)
 
-        self._write_simple_record_array(value)
+        self._write_fixed_simple_record_vector(value)
         self._state = 4
 
-    def write_record_with_vlens_array(self, value: npt.NDArray[np.void]) -> None:
+    def write_fixed_record_with_vlens_vector(self, value: list[RecordWithVlens]) -> None:
         """Ordinal 2"""
 
         if self._state != 4:
             self._raise_unexpected_state(4)
 
-        self._write_record_with_vlens_array(value)
+        self._write_fixed_record_with_vlens_vector(value)
         self._state = 6
 
-    def write_record_with_dynamic_nd_arrays(self, value: RecordWithDynamicNDArrays) -> None:
+    def write_record_with_fixed_vectors(self, value: RecordWithFixedVectors) -> None:
         """Ordinal 3"""
 
         if self._state != 6:
             self._raise_unexpected_state(6)
 
-        self._write_record_with_dynamic_nd_arrays(value)
+        self._write_record_with_fixed_vectors(value)
         self._state = 8
 
     @abc.abstractmethod
-    def _write_ints(self, value: npt.NDArray[np.int32]) -> None:
+    def _write_fixed_int_vector(self, value: list[yardl.Int32]) -> None:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _write_simple_record_array(self, value: npt.NDArray[np.void]) -> None:
+    def _write_fixed_simple_record_vector(self, value: list[SimpleRecord]) -> None:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _write_record_with_vlens_array(self, value: npt.NDArray[np.void]) -> None:
+    def _write_fixed_record_with_vlens_vector(self, value: list[RecordWithVlens]) -> None:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _write_record_with_dynamic_nd_arrays(self, value: RecordWithDynamicNDArrays) -> None:
+    def _write_record_with_fixed_vectors(self, value: RecordWithFixedVectors) -> None:
         raise NotImplementedError()
 
     @abc.abstractmethod
@@ -3658,17 +3330,17 @@ class DynamicNDArraysWriterBase(abc.ABC):
 
     def _state_to_method_name(self, state: int) -> str:
         if state == 0:
-            return 'write_ints'
+            return 'write_fixed_int_vector'
         if state == 2:
-            return 'write_simple_record_array'
+            return 'write_fixed_simple_record_vector'
         if state == 4:
-            return 'write_record_with_vlens_array'
+            return 'write_fixed_record_with_vlens_vector'
         if state == 6:
-            return 'write_record_with_dynamic_nd_arrays'
+            return 'write_record_with_fixed_vectors'
         return "<unknown>"
 
-class DynamicNDArraysReaderBase(abc.ABC):
-    """Abstract reader for the DynamicNDArrays protocol."""
+class FixedVectorsReaderBase(abc.ABC):
+    """Abstract reader for the FixedVectors protocol."""
 
 
     def __init__(self, skip_completed_check: bool = False) -> None:
@@ -3686,7 +3358,7 @@ class DynamicNDArraysReaderBase(abc.ABC):
                 raise ProtocolError(f"Protocol reader closed before all data was consumed. Expected call to '{expected_method}'.")
             	
 
-    schema = DynamicNDArraysWriterBase.schema
+    schema = FixedVectorsWriterBase.schema
 
     def __enter__(self):
         return self
@@ -3702,66 +3374,66 @@ class DynamicNDArraysReaderBase(abc.ABC):
     def _close(self) -> None:
         raise NotImplementedError()
 
-    def read_ints(self) -> npt.NDArray[np.int32]:
+    def read_fixed_int_vector(self) -> list[yardl.Int32]:
         """Ordinal 0"""
 
         if self._state != 0:
             self._raise_unexpected_state(0)
 
-        value = self._read_ints()
+        value = self._read_fixed_int_vector()
         self._state = 2
         return value
 
-    def read_simple_record_array(self) -> npt.NDArray[np.void]:
+    def read_fixed_simple_record_vector(self) -> list[SimpleRecord]:
         """Ordinal 1"""
 
         if self._state != 2:
             self._raise_unexpected_state(2)
 
-        value = self._read_simple_record_array()
+        value = self._read_fixed_simple_record_vector()
         self._state = 4
         return value
 
-    def read_record_with_vlens_array(self) -> npt.NDArray[np.void]:
+    def read_fixed_record_with_vlens_vector(self) -> list[RecordWithVlens]:
         """Ordinal 2"""
 
         if self._state != 4:
             self._raise_unexpected_state(4)
 
-        value = self._read_record_with_vlens_array()
+        value = self._read_fixed_record_with_vlens_vector()
         self._state = 6
         return value
 
-    def read_record_with_dynamic_nd_arrays(self) -> RecordWithDynamicNDArrays:
+    def read_record_with_fixed_vectors(self) -> RecordWithFixedVectors:
         """Ordinal 3"""
 
         if self._state != 6:
             self._raise_unexpected_state(6)
 
-        value = self._read_record_with_dynamic_nd_arrays()
+        value = self._read_record_with_fixed_vectors()
         self._state = 8
         return value
 
-    def copy_to(self, writer: DynamicNDArraysWriterBase) -> None:
-        writer.write_ints(self.read_ints())
-        writer.write_simple_record_array(self.read_simple_record_array())
-        writer.write_record_with_vlens_array(self.read_record_with_vlens_array())
-        writer.write_record_with_dynamic_nd_arrays(self.read_record_with_dynamic_nd_arrays())
+    def copy_to(self, writer: FixedVectorsWriterBase) -> None:
+        writer.write_fixed_int_vector(self.read_fixed_int_vector())
+        writer.write_fixed_simple_record_vector(self.read_fixed_simple_record_vector())
+        writer.write_fixed_record_with_vlens_vector(self.read_fixed_record_with_vlens_vector())
+        writer.write_record_with_fixed_vectors(self.read_record_with_fixed_vectors())
 
     @abc.abstractmethod
-    def _read_ints(self) -> npt.NDArray[np.int32]:
+    def _read_fixed_int_vector(self) -> list[yardl.Int32]:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _read_simple_record_array(self) -> npt.NDArray[np.void]:
+    def _read_fixed_simple_record_vector(self) -> list[SimpleRecord]:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _read_record_with_vlens_array(self) -> npt.NDArray[np.void]:
+    def _read_fixed_record_with_vlens_vector(self) -> list[RecordWithVlens]:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _read_record_with_dynamic_nd_arrays(self) -> RecordWithDynamicNDArrays:
+    def _read_record_with_fixed_vectors(self) -> RecordWithFixedVectors:
         raise NotImplementedError()
 
     T = typing.TypeVar('T')
@@ -3780,33 +3452,33 @@ class DynamicNDArraysReaderBase(abc.ABC):
         	
     def _state_to_method_name(self, state: int) -> str:
         if state == 0:
-            return 'read_ints'
+            return 'read_fixed_int_vector'
         if state == 2:
-            return 'read_simple_record_array'
+            return 'read_fixed_simple_record_vector'
         if state == 4:
-            return 'read_record_with_vlens_array'
+            return 'read_fixed_record_with_vlens_vector'
         if state == 6:
-            return 'read_record_with_dynamic_nd_arrays'
+            return 'read_record_with_fixed_vectors'
         return "<unknown>"
 
-class MultiDArraysWriterBase(abc.ABC):
-    """Abstract writer for the MultiDArrays protocol."""
+class StreamsWriterBase(abc.ABC):
+    """Abstract writer for the Streams protocol."""
 
 
     def __init__(self) -> None:
         self._state = 0
 
-    schema = r"""{"protocol":{"name":"MultiDArrays","sequence":[{"name":"images","type":{"stream":{"items":{"array":{"items":"float32","dimensions":[{"name":"ch"},{"name":"z"},{"name":"y"},{"name":"x"}]}}}}},{"name":"frames","type":{"stream":{"items":{"array":{"items":"float32","dimensions":[{"name":"ch","length":1},{"name":"z","length":1},{"name":"y","length":64},{"name":"x","length":32}]}}}}}]},"types":null}"""
+    schema = r"""{"protocol":{"name":"Streams","sequence":[{"name":"intData","type":{"stream":{"items":"int32"}}},{"name":"optionalIntData","type":{"stream":{"items":[null,"int32"]}}},{"name":"recordWithOptionalVectorData","type":{"stream":{"items":"TestModel.RecordWithOptionalVector"}}},{"name":"fixedVector","type":{"stream":{"items":{"vector":{"items":"int32","length":3}}}}}]},"types":[{"name":"RecordWithOptionalVector","fields":[{"name":"optionalVector","type":[null,{"vector":{"items":"int32"}}]}]}]}"""
 
     def close(self) -> None:
-        if self._state == 3:
+        if self._state == 7:
             try:
                 self._end_stream()
                 return
             finally:
                 self._close()
         self._close()
-        if self._state != 4:
+        if self._state != 8:
             expected_method = self._state_to_method_name((self._state + 1) & ~1)
             raise ProtocolError(f"Protocol writer closed before all steps were called. Expected to call to '{expected_method}'.")
 
@@ -3820,16 +3492,16 @@ class MultiDArraysWriterBase(abc.ABC):
             if exc is None:
                 raise e
 
-    def write_images(self, value: collections.abc.Iterable[npt.NDArray[np.float32]]) -> None:
+    def write_int_data(self, value: collections.abc.Iterable[yardl.Int32]) -> None:
         """Ordinal 0"""
 
         if self._state & ~1 != 0:
             self._raise_unexpected_state(0)
 
-        self._write_images(value)
+        self._write_int_data(value)
         self._state = 1
 
-    def write_frames(self, value: collections.abc.Iterable[npt.NDArray[np.float32]]) -> None:
+    def write_optional_int_data(self, value: collections.abc.Iterable[typing.Optional[yardl.Int32]]) -> None:
         """Ordinal 1"""
 
         if self._state == 1:
@@ -3838,15 +3510,47 @@ class MultiDArraysWriterBase(abc.ABC):
         elif self._state & ~1 != 2:
             self._raise_unexpected_state(2)
 
-        self._write_frames(value)
+        self._write_optional_int_data(value)
         self._state = 3
 
+    def write_record_with_optional_vector_data(self, value: collections.abc.Iterable[RecordWithOptionalVector]) -> None:
+        """Ordinal 2"""
+
+        if self._state == 3:
+            self._end_stream()
+            self._state = 4
+        elif self._state & ~1 != 4:
+            self._raise_unexpected_state(4)
+
+        self._write_record_with_optional_vector_data(value)
+        self._state = 5
+
+    def write_fixed_vector(self, value: collections.abc.Iterable[list[yardl.Int32]]) -> None:
+        """Ordinal 3"""
+
+        if self._state == 5:
+            self._end_stream()
+            self._state = 6
+        elif self._state & ~1 != 6:
+            self._raise_unexpected_state(6)
+
+        self._write_fixed_vector(value)
+        self._state = 7
+
     @abc.abstractmethod
-    def _write_images(self, value: collections.abc.Iterable[npt.NDArray[np.float32]]) -> None:
+    def _write_int_data(self, value: collections.abc.Iterable[yardl.Int32]) -> None:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _write_frames(self, value: collections.abc.Iterable[npt.NDArray[np.float32]]) -> None:
+    def _write_optional_int_data(self, value: collections.abc.Iterable[typing.Optional[yardl.Int32]]) -> None:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _write_record_with_optional_vector_data(self, value: collections.abc.Iterable[RecordWithOptionalVector]) -> None:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _write_fixed_vector(self, value: collections.abc.Iterable[list[yardl.Int32]]) -> None:
         raise NotImplementedError()
 
     @abc.abstractmethod
@@ -3864,13 +3568,17 @@ class MultiDArraysWriterBase(abc.ABC):
 
     def _state_to_method_name(self, state: int) -> str:
         if state == 0:
-            return 'write_images'
+            return 'write_int_data'
         if state == 2:
-            return 'write_frames'
+            return 'write_optional_int_data'
+        if state == 4:
+            return 'write_record_with_optional_vector_data'
+        if state == 6:
+            return 'write_fixed_vector'
         return "<unknown>"
 
-class MultiDArraysReaderBase(abc.ABC):
-    """Abstract reader for the MultiDArrays protocol."""
+class StreamsReaderBase(abc.ABC):
+    """Abstract reader for the Streams protocol."""
 
 
     def __init__(self, skip_completed_check: bool = False) -> None:
@@ -3879,7 +3587,7 @@ class MultiDArraysReaderBase(abc.ABC):
 
     def close(self) -> None:
         self._close()
-        if not self._skip_completed_check and self._state != 4:
+        if not self._skip_completed_check and self._state != 8:
             if self._state % 2 == 1:
                 previous_method = self._state_to_method_name(self._state - 1)
                 raise ProtocolError(f"Protocol reader closed before all data was consumed. The iterable returned by '{previous_method}' was not fully consumed.")
@@ -3888,7 +3596,7 @@ class MultiDArraysReaderBase(abc.ABC):
                 raise ProtocolError(f"Protocol reader closed before all data was consumed. Expected call to '{expected_method}'.")
             	
 
-    schema = MultiDArraysWriterBase.schema
+    schema = StreamsWriterBase.schema
 
     def __enter__(self):
         return self
@@ -3904,40 +3612,70 @@ class MultiDArraysReaderBase(abc.ABC):
     def _close(self) -> None:
         raise NotImplementedError()
 
-    def read_images(self) -> collections.abc.Iterable[npt.NDArray[np.float32]]:
+    def read_int_data(self) -> collections.abc.Iterable[yardl.Int32]:
         """Ordinal 0"""
 
         if self._state != 0:
             self._raise_unexpected_state(0)
 
-        value = self._read_images()
+        value = self._read_int_data()
         self._state = 1
         return self._wrap_iterable(value, 2)
 
-    def read_frames(self) -> collections.abc.Iterable[npt.NDArray[np.float32]]:
+    def read_optional_int_data(self) -> collections.abc.Iterable[typing.Optional[yardl.Int32]]:
         """Ordinal 1"""
 
         if self._state != 2:
             self._raise_unexpected_state(2)
 
-        value = self._read_frames()
+        value = self._read_optional_int_data()
         self._state = 3
         return self._wrap_iterable(value, 4)
 
-    def copy_to(self, writer: MultiDArraysWriterBase) -> None:
-        writer.write_images(self.read_images())
-        writer.write_frames(self.read_frames())
+    def read_record_with_optional_vector_data(self) -> collections.abc.Iterable[RecordWithOptionalVector]:
+        """Ordinal 2"""
+
+        if self._state != 4:
+            self._raise_unexpected_state(4)
+
+        value = self._read_record_with_optional_vector_data()
+        self._state = 5
+        return self._wrap_iterable(value, 6)
+
+    def read_fixed_vector(self) -> collections.abc.Iterable[list[yardl.Int32]]:
+        """Ordinal 3"""
+
+        if self._state != 6:
+            self._raise_unexpected_state(6)
+
+        value = self._read_fixed_vector()
+        self._state = 7
+        return self._wrap_iterable(value, 8)
+
+    def copy_to(self, writer: StreamsWriterBase) -> None:
+        writer.write_int_data(self.read_int_data())
+        writer.write_optional_int_data(self.read_optional_int_data())
+        writer.write_record_with_optional_vector_data(self.read_record_with_optional_vector_data())
+        writer.write_fixed_vector(self.read_fixed_vector())
 
     @abc.abstractmethod
-    def _read_images(self) -> collections.abc.Iterable[npt.NDArray[np.float32]]:
+    def _read_int_data(self) -> collections.abc.Iterable[yardl.Int32]:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _read_frames(self) -> collections.abc.Iterable[npt.NDArray[np.float32]]:
+    def _read_optional_int_data(self) -> collections.abc.Iterable[typing.Optional[yardl.Int32]]:
         raise NotImplementedError()
 
-    T = typing.TypeVar('T')
-    def _wrap_iterable(self, iterable: collections.abc.Iterable[T], final_state: int) -> collections.abc.Iterable[T]:
+    @abc.abstractmethod
+    def _read_record_with_optional_vector_data(self) -> collections.abc.Iterable[RecordWithOptionalVector]:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _read_fixed_vector(self) -> collections.abc.Iterable[list[yardl.Int32]]:
+        raise NotImplementedError()
+
+    T = typing.TypeVar('T')
+    def _wrap_iterable(self, iterable: collections.abc.Iterable[T], final_state: int) -> collections.abc.Iterable[T]:
         yield from iterable
         self._state = final_state
 
@@ -3952,23 +3690,27 @@ class MultiDArraysReaderBase(abc.ABC):
         	
     def _state_to_method_name(self, state: int) -> str:
         if state == 0:
-            return 'read_images'
+            return 'read_int_data'
         if state == 2:
-            return 'read_frames'
+            return 'read_optional_int_data'
+        if state == 4:
+            return 'read_record_with_optional_vector_data'
+        if state == 6:
+            return 'read_fixed_vector'
         return "<unknown>"
 
-class ComplexArraysWriterBase(abc.ABC):
-    """Abstract writer for the ComplexArrays protocol."""
+class FixedArraysWriterBase(abc.ABC):
+    """Abstract writer for the FixedArrays protocol."""
 
 
     def __init__(self) -> None:
         self._state = 0
 
-    schema = r"""{"protocol":{"name":"ComplexArrays","sequence":[{"name":"floats","type":{"array":{"items":"complexfloat32"}}},{"name":"doubles","type":{"array":{"items":"complexfloat64","dimensions":2}}}]},"types":null}"""
+    schema = r"""{"protocol":{"name":"FixedArrays","sequence":[{"name":"ints","type":{"array":{"items":"int32","dimensions":[{"length":2},{"length":3}]}}},{"name":"fixedSimpleRecordArray","type":{"array":{"items":"TestModel.SimpleRecord","dimensions":[{"length":3},{"length":2}]}}},{"name":"fixedRecordWithVlensArray","type":{"array":{"items":"TestModel.RecordWithVlens","dimensions":[{"length":2},{"length":2}]}}},{"name":"recordWithFixedArrays","type":"TestModel.RecordWithFixedArrays"},{"name":"namedArray","type":"TestModel.NamedFixedNDArray"}]},"types":[{"name":"NamedFixedNDArray","type":{"array":{"items":"int32","dimensions":[{"name":"dimA","length":2},{"name":"dimB","length":4}]}}},{"name":"RecordWithFixedArrays","fields":[{"name":"ints","type":{"array":{"items":"int32","dimensions":[{"length":2},{"length":3}]}}},{"name":"fixedSimpleRecordArray","type":{"array":{"items":"TestModel.SimpleRecord","dimensions":[{"length":3},{"length":2}]}}},{"name":"fixedRecordWithVlensArray","type":{"array":{"items":"TestModel.RecordWithVlens","dimensions":[{"length":2},{"length":2}]}}}]},{"name":"RecordWithVlens","fields":[{"name":"a","type":{"vector":{"items":"TestModel.SimpleRecord"}}},{"name":"b","type":"int32"},{"name":"c","type":"int32"}]},{"name":"SimpleRecord","fields":[{"name":"x","type":"int32"},{"name":"y","type":"int32"},{"name":"z","type":"int32"}]}]}"""
 
     def close(self) -> None:
         self._close()
-        if self._state != 4:
+        if self._state != 10:
             expected_method = self._state_to_method_name((self._state + 1) & ~1)
             raise ProtocolError(f"Protocol writer closed before all steps were called. Expected to call to '{expected_method}'.")
 
@@ -3982,30 +3724,69 @@ class ComplexArraysWriterBase(abc.ABC):
             if exc is None:
                 raise e
 
-    def write_floats(self, value: npt.NDArray[np.complex64]) -> None:
+    def write_ints(self, value: npt.NDArray[np.int32]) -> None:
         """Ordinal 0"""
 
         if self._state != 0:
             self._raise_unexpected_state(0)
 
-        self._write_floats(value)
+        self._write_ints(value)
         self._state = 2
 
-    def write_doubles(self, value: npt.NDArray[np.complex128]) -> None:
+    def write_fixed_simple_record_array(self, value: npt.NDArray[np.void]) -> None:
         """Ordinal 1"""
 
         if self._state != 2:
             self._raise_unexpected_state(2)
 
-        self._write_doubles(value)
+        self._write_fixed_simple_record_array(value)
         self._state = 4
 
+    def write_fixed_record_with_vlens_array(self, value: npt.NDArray[np.void]) -> None:
+        """Ordinal 2"""
+
+        if self._state != 4:
+            self._raise_unexpected_state(4)
+
+        self._write_fixed_record_with_vlens_array(value)
+        self._state = 6
+
+    def write_record_with_fixed_arrays(self, value: RecordWithFixedArrays) -> None:
+        """Ordinal 3"""
+
+        if self._state != 6:
+            self._raise_unexpected_state(6)
+
+        self._write_record_with_fixed_arrays(value)
+        self._state = 8
+
+    def write_named_array(self, value: NamedFixedNDArray) -> None:
+        """Ordinal 4"""
+
+        if self._state != 8:
+            self._raise_unexpected_state(8)
+
+        self._write_named_array(value)
+        self._state = 10
+
     @abc.abstractmethod
-    def _write_floats(self, value: npt.NDArray[np.complex64]) -> None:
+    def _write_ints(self, value: npt.NDArray[np.int32]) -> None:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _write_doubles(self, value: npt.NDArray[np.complex128]) -> None:
+    def _write_fixed_simple_record_array(self, value: npt.NDArray[np.void]) -> None:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _write_fixed_record_with_vlens_array(self, value: npt.NDArray[np.void]) -> None:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _write_record_with_fixed_arrays(self, value: RecordWithFixedArrays) -> None:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _write_named_array(self, value: NamedFixedNDArray) -> None:
         raise NotImplementedError()
 
     @abc.abstractmethod
@@ -4023,13 +3804,19 @@ class ComplexArraysWriterBase(abc.ABC):
 
     def _state_to_method_name(self, state: int) -> str:
         if state == 0:
-            return 'write_floats'
+            return 'write_ints'
         if state == 2:
-            return 'write_doubles'
+            return 'write_fixed_simple_record_array'
+        if state == 4:
+            return 'write_fixed_record_with_vlens_array'
+        if state == 6:
+            return 'write_record_with_fixed_arrays'
+        if state == 8:
+            return 'write_named_array'
         return "<unknown>"
 
-class ComplexArraysReaderBase(abc.ABC):
-    """Abstract reader for the ComplexArrays protocol."""
+class FixedArraysReaderBase(abc.ABC):
+    """Abstract reader for the FixedArrays protocol."""
 
 
     def __init__(self, skip_completed_check: bool = False) -> None:
@@ -4038,7 +3825,7 @@ class ComplexArraysReaderBase(abc.ABC):
 
     def close(self) -> None:
         self._close()
-        if not self._skip_completed_check and self._state != 4:
+        if not self._skip_completed_check and self._state != 10:
             if self._state % 2 == 1:
                 previous_method = self._state_to_method_name(self._state - 1)
                 raise ProtocolError(f"Protocol reader closed before all data was consumed. The iterable returned by '{previous_method}' was not fully consumed.")
@@ -4047,7 +3834,7 @@ class ComplexArraysReaderBase(abc.ABC):
                 raise ProtocolError(f"Protocol reader closed before all data was consumed. Expected call to '{expected_method}'.")
             	
 
-    schema = ComplexArraysWriterBase.schema
+    schema = FixedArraysWriterBase.schema
 
     def __enter__(self):
         return self
@@ -4063,36 +3850,81 @@ class ComplexArraysReaderBase(abc.ABC):
     def _close(self) -> None:
         raise NotImplementedError()
 
-    def read_floats(self) -> npt.NDArray[np.complex64]:
+    def read_ints(self) -> npt.NDArray[np.int32]:
         """Ordinal 0"""
 
         if self._state != 0:
             self._raise_unexpected_state(0)
 
-        value = self._read_floats()
+        value = self._read_ints()
         self._state = 2
         return value
 
-    def read_doubles(self) -> npt.NDArray[np.complex128]:
+    def read_fixed_simple_record_array(self) -> npt.NDArray[np.void]:
         """Ordinal 1"""
 
         if self._state != 2:
             self._raise_unexpected_state(2)
 
-        value = self._read_doubles()
+        value = self._read_fixed_simple_record_array()
         self._state = 4
         return value
 
-    def copy_to(self, writer: ComplexArraysWriterBase) -> None:
-        writer.write_floats(self.read_floats())
-        writer.write_doubles(self.read_doubles())
+    def read_fixed_record_with_vlens_array(self) -> npt.NDArray[np.void]:
+        """Ordinal 2"""
+
+        if self._state != 4:
+            self._raise_unexpected_state(4)
+
+        value = self._read_fixed_record_with_vlens_array()
+        self._state = 6
+        return value
+
+    def read_record_with_fixed_arrays(self) -> RecordWithFixedArrays:
+        """Ordinal 3"""
+
+        if self._state != 6:
+            self._raise_unexpected_state(6)
+
+        value = self._read_record_with_fixed_arrays()
+        self._state = 8
+        return value
+
+    def read_named_array(self) -> NamedFixedNDArray:
+        """Ordinal 4"""
+
+        if self._state != 8:
+            self._raise_unexpected_state(8)
+
+        value = self._read_named_array()
+        self._state = 10
+        return value
+
+    def copy_to(self, writer: FixedArraysWriterBase) -> None:
+        writer.write_ints(self.read_ints())
+        writer.write_fixed_simple_record_array(self.read_fixed_simple_record_array())
+        writer.write_fixed_record_with_vlens_array(self.read_fixed_record_with_vlens_array())
+        writer.write_record_with_fixed_arrays(self.read_record_with_fixed_arrays())
+        writer.write_named_array(self.read_named_array())
 
     @abc.abstractmethod
-    def _read_floats(self) -> npt.NDArray[np.complex64]:
+    def _read_ints(self) -> npt.NDArray[np.int32]:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _read_doubles(self) -> npt.NDArray[np.complex128]:
+    def _read_fixed_simple_record_array(self) -> npt.NDArray[np.void]:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _read_fixed_record_with_vlens_array(self) -> npt.NDArray[np.void]:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _read_record_with_fixed_arrays(self) -> RecordWithFixedArrays:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _read_named_array(self) -> NamedFixedNDArray:
         raise NotImplementedError()
 
     T = typing.TypeVar('T')
@@ -4111,23 +3943,29 @@ class ComplexArraysReaderBase(abc.ABC):
         	
     def _state_to_method_name(self, state: int) -> str:
         if state == 0:
-            return 'read_floats'
+            return 'read_ints'
         if state == 2:
-            return 'read_doubles'
+            return 'read_fixed_simple_record_array'
+        if state == 4:
+            return 'read_fixed_record_with_vlens_array'
+        if state == 6:
+            return 'read_record_with_fixed_arrays'
+        if state == 8:
+            return 'read_named_array'
         return "<unknown>"
 
-class MapsWriterBase(abc.ABC):
-    """Abstract writer for the Maps protocol."""
+class SubarraysWriterBase(abc.ABC):
+    """Abstract writer for the Subarrays protocol."""
 
 
     def __init__(self) -> None:
         self._state = 0
 
-    schema = r"""{"protocol":{"name":"Maps","sequence":[{"name":"stringToInt","type":{"map":{"keys":"string","values":"int32"}}},{"name":"intToString","type":{"map":{"keys":"int32","values":"string"}}},{"name":"stringToUnion","type":{"map":{"keys":"string","values":[{"tag":"string","type":"string"},{"tag":"int32","type":"int32"}]}}},{"name":"aliasedGeneric","type":{"name":"BasicTypes.AliasedMap","typeArguments":["string","int32"]}},{"name":"records","type":{"vector":{"items":"TestModel.RecordWithMaps"}}}]},"types":[{"name":"AliasedMap","typeParameters":["K","V"],"type":{"map":{"keys":"K","values":"V"}}},{"name":"RecordWithMaps","fields":[{"name":"set1","type":{"map":{"keys":"uint32","values":"uint32"}}},{"name":"set2","type":{"map":{"keys":"int32","values":"bool"}}},{"name":"set3","type":{"map":{"keys":"string","values":[{"tag":"string","type":"string"},{"tag":"int32","type":"int32"}]}}}]}]}"""
+    schema = r"""{"protocol":{"name":"Subarrays","sequence":[{"name":"dynamicWithFixedIntSubarray","type":{"array":{"items":{"array":{"items":"int32","dimensions":[{"length":3}]}}}}},{"name":"dynamicWithFixedFloatSubarray","type":{"array":{"items":{"array":{"items":"float32","dimensions":[{"length":3}]}}}}},{"name":"knownDimCountWithFixedIntSubarray","type":{"array":{"items":{"array":{"items":"int32","dimensions":[{"length":3}]}},"dimensions":1}}},{"name":"knownDimCountWithFixedFloatSubarray","type":{"array":{"items":{"array":{"items":"float32","dimensions":[{"length":3}]}},"dimensions":1}}},{"name":"fixedWithFixedIntSubarray","type":{"array":{"items":{"array":{"items":"int32","dimensions":[{"length":3}]}},"dimensions":[{"length":2}]}}},{"name":"fixedWithFixedFloatSubarray","type":{"array":{"items":{"array":{"items":"float32","dimensions":[{"length":3}]}},"dimensions":[{"length":2}]}}},{"name":"nestedSubarray","type":{"array":{"items":{"array":{"items":{"array":{"items":"int32","dimensions":[{"length":3}]}},"dimensions":[{"length":2}]}}}}},{"name":"dynamicWithFixedVectorSubarray","type":{"array":{"items":{"vector":{"items":"int32","length":3}}}}},{"name":"genericSubarray","type":{"name":"TestModel.Image","typeArguments":[{"array":{"items":"int32","dimensions":[{"length":3}]}}]}}]},"types":[{"name":"Image","typeParameters":["T"],"type":{"array":{"items":"T","dimensions":[{"name":"x"},{"name":"y"}]}}},{"name":"Image","typeParameters":["T"],"type":{"name":"Image.Image","typeArguments":["T"]}}]}"""
 
     def close(self) -> None:
         self._close()
-        if self._state != 10:
+        if self._state != 18:
             expected_method = self._state_to_method_name((self._state + 1) & ~1)
             raise ProtocolError(f"Protocol writer closed before all steps were called. Expected to call to '{expected_method}'.")
 
@@ -4141,99 +3979,159 @@ class MapsWriterBase(abc.ABC):
             if exc is None:
                 raise e
 
-    def write_string_to_int(self, value: dict[str, yardl.Int32]) -> None:
+    def write_dynamic_with_fixed_int_subarray(self, value: npt.NDArray[np.int32]) -> None:
         """Ordinal 0"""
 
         if self._state != 0:
             self._raise_unexpected_state(0)
 
-        self._write_string_to_int(value)
+        self._write_dynamic_with_fixed_int_subarray(value)
         self._state = 2
 
-    def write_int_to_string(self, value: dict[yardl.Int32, str]) -> None:
+    def write_dynamic_with_fixed_float_subarray(self, value: npt.NDArray[np.float32]) -> None:
         """Ordinal 1"""
 
         if self._state != 2:
             self._raise_unexpected_state(2)
 
-        self._write_int_to_string(value)
+        self._write_dynamic_with_fixed_float_subarray(value)
         self._state = 4
 
-    def write_string_to_union(self, value: dict[str, StringOrInt32]) -> None:
+    def write_known_dim_count_with_fixed_int_subarray(self, value: npt.NDArray[np.int32]) -> None:
         """Ordinal 2"""
 
         if self._state != 4:
             self._raise_unexpected_state(4)
 
-        self._write_string_to_union(value)
+        self._write_known_dim_count_with_fixed_int_subarray(value)
         self._state = 6
 
-    def write_aliased_generic(self, value: basic_types.AliasedMap[str, yardl.Int32]) -> None:
+    def write_known_dim_count_with_fixed_float_subarray(self, value: npt.NDArray[np.float32]) -> None:
         """Ordinal 3"""
 
         if self._state != 6:
             self._raise_unexpected_state(6)
 
-        self._write_aliased_generic(value)
+        self._write_known_dim_count_with_fixed_float_subarray(value)
         self._state = 8
 
-    def write_records(self, value: list[RecordWithMaps]) -> None:
+    def write_fixed_with_fixed_int_subarray(self, value: npt.NDArray[np.int32]) -> None:
         """Ordinal 4"""
 
         if self._state != 8:
             self._raise_unexpected_state(8)
 
-        self._write_records(value)
+        self._write_fixed_with_fixed_int_subarray(value)
         self._state = 10
 
+    def write_fixed_with_fixed_float_subarray(self, value: npt.NDArray[np.float32]) -> None:
+        """Ordinal 5"""
+
+        if self._state != 10:
+            self._raise_unexpected_state(10)
+
+        self._write_fixed_with_fixed_float_subarray(value)
+        self._state = 12
+
+    def write_nested_subarray(self, value: npt.NDArray[np.int32]) -> None:
+        """Ordinal 6"""
+
+        if self._state != 12:
+            self._raise_unexpected_state(12)
+
+        self._write_nested_subarray(value)
+        self._state = 14
+
+    def write_dynamic_with_fixed_vector_subarray(self, value: npt.NDArray[np.int32]) -> None:
+        """Ordinal 7"""
+
+        if self._state != 14:
+            self._raise_unexpected_state(14)
+
+        self._write_dynamic_with_fixed_vector_subarray(value)
+        self._state = 16
+
+    def write_generic_subarray(self, value: Image[np.int32]) -> None:
+        """Ordinal 8"""
+
+        if self._state != 16:
+            self._raise_unexpected_state(16)
+
+        self._write_generic_subarray(value)
+        self._state = 18
+
     @abc.abstractmethod
-    def _write_string_to_int(self, value: dict[str, yardl.Int32]) -> None:
+    def _write_dynamic_with_fixed_int_subarray(self, value: npt.NDArray[np.int32]) -> None:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _write_int_to_string(self, value: dict[yardl.Int32, str]) -> None:
+    def _write_dynamic_with_fixed_float_subarray(self, value: npt.NDArray[np.float32]) -> None:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _write_string_to_union(self, value: dict[str, StringOrInt32]) -> None:
+    def _write_known_dim_count_with_fixed_int_subarray(self, value: npt.NDArray[np.int32]) -> None:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _write_aliased_generic(self, value: basic_types.AliasedMap[str, yardl.Int32]) -> None:
+    def _write_known_dim_count_with_fixed_float_subarray(self, value: npt.NDArray[np.float32]) -> None:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _write_records(self, value: list[RecordWithMaps]) -> None:
+    def _write_fixed_with_fixed_int_subarray(self, value: npt.NDArray[np.int32]) -> None:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _close(self) -> None:
-        pass
+    def _write_fixed_with_fixed_float_subarray(self, value: npt.NDArray[np.float32]) -> None:
+        raise NotImplementedError()
 
     @abc.abstractmethod
-    def _end_stream(self) -> None:
-        pass
-
-    def _raise_unexpected_state(self, actual: int) -> None:
-        expected_method = self._state_to_method_name(self._state)
-        actual_method = self._state_to_method_name(actual)
-        raise ProtocolError(f"Expected to call to '{expected_method}' but received call to '{actual_method}'.")
+    def _write_nested_subarray(self, value: npt.NDArray[np.int32]) -> None:
+        raise NotImplementedError()
 
-    def _state_to_method_name(self, state: int) -> str:
+    @abc.abstractmethod
+    def _write_dynamic_with_fixed_vector_subarray(self, value: npt.NDArray[np.int32]) -> None:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _write_generic_subarray(self, value: Image[np.int32]) -> None:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _close(self) -> None:
+        pass
+
+    @abc.abstractmethod
+    def _end_stream(self) -> None:
+        pass
+
+    def _raise_unexpected_state(self, actual: int) -> None:
+        expected_method = self._state_to_method_name(self._state)
+        actual_method = self._state_to_method_name(actual)
+        raise ProtocolError(f"Expected to call to '{expected_method}' but received call to '{actual_method}'.")
+
+    def _state_to_method_name(self, state: int) -> str:
         if state == 0:
-            return 'write_string_to_int'
+            return 'write_dynamic_with_fixed_int_subarray'
         if state == 2:
-            return 'write_int_to_string'
+            return 'write_dynamic_with_fixed_float_subarray'
         if state == 4:
-            return 'write_string_to_union'
+            return 'write_known_dim_count_with_fixed_int_subarray'
         if state == 6:
-            return 'write_aliased_generic'
+            return 'write_known_dim_count_with_fixed_float_subarray'
         if state == 8:
-            return 'write_records'
+            return 'write_fixed_with_fixed_int_subarray'
+        if state == 10:
+            return 'write_fixed_with_fixed_float_subarray'
+        if state == 12:
+            return 'write_nested_subarray'
+        if state == 14:
+            return 'write_dynamic_with_fixed_vector_subarray'
+        if state == 16:
+            return 'write_generic_subarray'
         return "<unknown>"
 
-class MapsReaderBase(abc.ABC):
-    """Abstract reader for the Maps protocol."""
+class SubarraysReaderBase(abc.ABC):
+    """Abstract reader for the Subarrays protocol."""
 
 
     def __init__(self, skip_completed_check: bool = False) -> None:
@@ -4242,7 +4140,7 @@ class MapsReaderBase(abc.ABC):
 
     def close(self) -> None:
         self._close()
-        if not self._skip_completed_check and self._state != 10:
+        if not self._skip_completed_check and self._state != 18:
             if self._state % 2 == 1:
                 previous_method = self._state_to_method_name(self._state - 1)
                 raise ProtocolError(f"Protocol reader closed before all data was consumed. The iterable returned by '{previous_method}' was not fully consumed.")
@@ -4251,7 +4149,7 @@ class MapsReaderBase(abc.ABC):
                 raise ProtocolError(f"Protocol reader closed before all data was consumed. Expected call to '{expected_method}'.")
             	
 
-    schema = MapsWriterBase.schema
+    schema = SubarraysWriterBase.schema
 
     def __enter__(self):
         return self
@@ -4267,81 +4165,141 @@ class MapsReaderBase(abc.ABC):
     def _close(self) -> None:
         raise NotImplementedError()
 
-    def read_string_to_int(self) -> dict[str, yardl.Int32]:
+    def read_dynamic_with_fixed_int_subarray(self) -> npt.NDArray[np.int32]:
         """Ordinal 0"""
 
         if self._state != 0:
             self._raise_unexpected_state(0)
 
-        value = self._read_string_to_int()
+        value = self._read_dynamic_with_fixed_int_subarray()
         self._state = 2
         return value
 
-    def read_int_to_string(self) -> dict[yardl.Int32, str]:
+    def read_dynamic_with_fixed_float_subarray(self) -> npt.NDArray[np.float32]:
         """Ordinal 1"""
 
         if self._state != 2:
             self._raise_unexpected_state(2)
 
-        value = self._read_int_to_string()
+        value = self._read_dynamic_with_fixed_float_subarray()
         self._state = 4
         return value
 
-    def read_string_to_union(self) -> dict[str, StringOrInt32]:
+    def read_known_dim_count_with_fixed_int_subarray(self) -> npt.NDArray[np.int32]:
         """Ordinal 2"""
 
         if self._state != 4:
             self._raise_unexpected_state(4)
 
-        value = self._read_string_to_union()
+        value = self._read_known_dim_count_with_fixed_int_subarray()
         self._state = 6
         return value
 
-    def read_aliased_generic(self) -> basic_types.AliasedMap[str, yardl.Int32]:
+    def read_known_dim_count_with_fixed_float_subarray(self) -> npt.NDArray[np.float32]:
         """Ordinal 3"""
 
         if self._state != 6:
             self._raise_unexpected_state(6)
 
-        value = self._read_aliased_generic()
+        value = self._read_known_dim_count_with_fixed_float_subarray()
         self._state = 8
         return value
 
-    def read_records(self) -> list[RecordWithMaps]:
+    def read_fixed_with_fixed_int_subarray(self) -> npt.NDArray[np.int32]:
         """Ordinal 4"""
 
         if self._state != 8:
             self._raise_unexpected_state(8)
 
-        value = self._read_records()
+        value = self._read_fixed_with_fixed_int_subarray()
         self._state = 10
         return value
 
-    def copy_to(self, writer: MapsWriterBase) -> None:
-        writer.write_string_to_int(self.read_string_to_int())
-        writer.write_int_to_string(self.read_int_to_string())
-        writer.write_string_to_union(self.read_string_to_union())
-        writer.write_aliased_generic(self.read_aliased_generic())
-        writer.write_records(self.read_records())
+    def read_fixed_with_fixed_float_subarray(self) -> npt.NDArray[np.float32]:
+        """Ordinal 5"""
+
+        if self._state != 10:
+            self._raise_unexpected_state(10)
+
+        value = self._read_fixed_with_fixed_float_subarray()
+        self._state = 12
+        return value
+
+    def read_nested_subarray(self) -> npt.NDArray[np.int32]:
+        """Ordinal 6"""
+
+        if self._state != 12:
+            self._raise_unexpected_state(12)
+
+        value = self._read_nested_subarray()
+        self._state = 14
+        return value
+
+    def read_dynamic_with_fixed_vector_subarray(self) -> npt.NDArray[np.int32]:
+        """Ordinal 7"""
+
+        if self._state != 14:
+            self._raise_unexpected_state(14)
+
+        value = self._read_dynamic_with_fixed_vector_subarray()
+        self._state = 16
+        return value
+
+    def read_generic_subarray(self) -> Image[np.int32]:
+        """Ordinal 8"""
+
+        if self._state != 16:
+            self._raise_unexpected_state(16)
+
+        value = self._read_generic_subarray()
+        self._state = 18
+        return value
+
+    def copy_to(self, writer: SubarraysWriterBase) -> None:
+        writer.write_dynamic_with_fixed_int_subarray(self.read_dynamic_with_fixed_int_subarray())
+        writer.write_dynamic_with_fixed_float_subarray(self.read_dynamic_with_fixed_float_subarray())
+        writer.write_known_dim_count_with_fixed_int_subarray(self.read_known_dim_count_with_fixed_int_subarray())
+        writer.write_known_dim_count_with_fixed_float_subarray(self.read_known_dim_count_with_fixed_float_subarray())
+        writer.write_fixed_with_fixed_int_subarray(self.read_fixed_with_fixed_int_subarray())
+        writer.write_fixed_with_fixed_float_subarray(self.read_fixed_with_fixed_float_subarray())
+        writer.write_nested_subarray(self.read_nested_subarray())
+        writer.write_dynamic_with_fixed_vector_subarray(self.read_dynamic_with_fixed_vector_subarray())
+        writer.write_generic_subarray(self.read_generic_subarray())
 
     @abc.abstractmethod
-    def _read_string_to_int(self) -> dict[str, yardl.Int32]:
+    def _read_dynamic_with_fixed_int_subarray(self) -> npt.NDArray[np.int32]:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _read_int_to_string(self) -> dict[yardl.Int32, str]:
+    def _read_dynamic_with_fixed_float_subarray(self) -> npt.NDArray[np.float32]:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _read_string_to_union(self) -> dict[str, StringOrInt32]:
+    def _read_known_dim_count_with_fixed_int_subarray(self) -> npt.NDArray[np.int32]:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _read_aliased_generic(self) -> basic_types.AliasedMap[str, yardl.Int32]:
+    def _read_known_dim_count_with_fixed_float_subarray(self) -> npt.NDArray[np.float32]:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _read_records(self) -> list[RecordWithMaps]:
+    def _read_fixed_with_fixed_int_subarray(self) -> npt.NDArray[np.int32]:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _read_fixed_with_fixed_float_subarray(self) -> npt.NDArray[np.float32]:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _read_nested_subarray(self) -> npt.NDArray[np.int32]:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _read_dynamic_with_fixed_vector_subarray(self) -> npt.NDArray[np.int32]:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _read_generic_subarray(self) -> Image[np.int32]:
         raise NotImplementedError()
 
     T = typing.TypeVar('T')
@@ -4360,29 +4318,37 @@ class MapsReaderBase(abc.ABC):
         	
     def _state_to_method_name(self, state: int) -> str:
         if state == 0:
-            return 'read_string_to_int'
+            return 'read_dynamic_with_fixed_int_subarray'
         if state == 2:
-            return 'read_int_to_string'
+            return 'read_dynamic_with_fixed_float_subarray'
         if state == 4:
-            return 'read_string_to_union'
+            return 'read_known_dim_count_with_fixed_int_subarray'
         if state == 6:
-            return 'read_aliased_generic'
+            return 'read_known_dim_count_with_fixed_float_subarray'
         if state == 8:
-            return 'read_records'
+            return 'read_fixed_with_fixed_int_subarray'
+        if state == 10:
+            return 'read_fixed_with_fixed_float_subarray'
+        if state == 12:
+            return 'read_nested_subarray'
+        if state == 14:
+            return 'read_dynamic_with_fixed_vector_subarray'
+        if state == 16:
+            return 'read_generic_subarray'
         return "<unknown>"
 
-class UnionsWriterBase(abc.ABC):
-    """Abstract writer for the Unions protocol."""
+class SubarraysInRecordsWriterBase(abc.ABC):
+    """Abstract writer for the SubarraysInRecords protocol."""
 
 
     def __init__(self) -> None:
         self._state = 0
 
-    schema = r"""{"protocol":{"name":"Unions","sequence":[{"name":"intOrSimpleRecord","type":[{"tag":"int32","type":"int32"},{"tag":"SimpleRecord","type":"TestModel.SimpleRecord"}]},{"name":"intOrRecordWithVlens","type":[{"tag":"int32","type":"int32"},{"tag":"RecordWithVlens","type":"TestModel.RecordWithVlens"}]},{"name":"monosotateOrIntOrSimpleRecord","type":[null,{"tag":"int32","type":"int32"},{"tag":"SimpleRecord","type":"TestModel.SimpleRecord"}]},{"name":"vectorOfUnions","type":{"vector":{"items":[{"tag":"string","type":"string"},{"tag":"int32","type":"int32"}]}}},{"name":"recordWithUnions","type":"BasicTypes.RecordWithUnions"}]},"types":[{"name":"DaysOfWeek","values":[{"symbol":"monday","value":1},{"symbol":"tuesday","value":2},{"symbol":"wednesday","value":4},{"symbol":"thursday","value":8},{"symbol":"friday","value":16},{"symbol":"saturday","value":32},{"symbol":"sunday","value":64}]},{"name":"Fruits","values":[{"symbol":"apple","value":1},{"symbol":"banana","value":2},{"symbol":"pear","value":3}]},{"name":"GenericNullableUnion2","typeParameters":["T1","T2"],"type":[null,{"tag":"T1","type":"T1"},{"tag":"T2","type":"T2"}]},{"name":"RecordWithString","fields":[{"name":"i","type":"string"}]},{"name":"RecordWithUnions","fields":[{"name":"nullOrIntOrString","type":[null,{"tag":"int32","type":"int32"},{"tag":"string","type":"string"}]},{"name":"dateOrDatetime","type":[{"tag":"time","type":"time"},{"tag":"datetime","type":"datetime"}]},{"name":"nullOrFruitsOrDaysOfWeek","type":{"name":"BasicTypes.GenericNullableUnion2","typeArguments":["BasicTypes.Fruits","BasicTypes.DaysOfWeek"]}},{"name":"recordOrInt","type":[{"tag":"RecordWithString","type":"BasicTypes.RecordWithString"},{"tag":"int32","type":"int32"}]}]},{"name":"RecordWithVlens","fields":[{"name":"a","type":{"vector":{"items":"TestModel.SimpleRecord"}}},{"name":"b","type":"int32"},{"name":"c","type":"int32"}]},{"name":"SimpleRecord","fields":[{"name":"x","type":"int32"},{"name":"y","type":"int32"},{"name":"z","type":"int32"}]}]}"""
+    schema = r"""{"protocol":{"name":"SubarraysInRecords","sequence":[{"name":"withFixedSubarrays","type":{"array":{"items":"TestModel.RecordWithFixedCollections"}}},{"name":"withVlenSubarrays","type":{"array":{"items":"TestModel.RecordWithVlenCollections"}}}]},"types":[{"name":"RecordWithFixedCollections","fields":[{"name":"fixedVector","type":{"vector":{"items":"int32","length":3}}},{"name":"fixedArray","type":{"array":{"items":"int32","dimensions":[{"length":2},{"length":3}]}}}]},{"name":"RecordWithVlenCollections","fields":[{"name":"vector","type":{"vector":{"items":"int32"}}},{"name":"array","type":{"array":{"items":"int32","dimensions":2}}}]}]}"""
 
     def close(self) -> None:
         self._close()
-        if self._state != 10:
+        if self._state != 4:
             expected_method = self._state_to_method_name((self._state + 1) & ~1)
             raise ProtocolError(f"Protocol writer closed before all steps were called. Expected to call to '{expected_method}'.")
 
@@ -4396,69 +4362,30 @@ class UnionsWriterBase(abc.ABC):
             if exc is None:
                 raise e
 
-    def write_int_or_simple_record(self, value: Int32OrSimpleRecord) -> None:
+    def write_with_fixed_subarrays(self, value: npt.NDArray[np.void]) -> None:
         """Ordinal 0"""
 
         if self._state != 0:
             self._raise_unexpected_state(0)
 
-        self._write_int_or_simple_record(value)
+        self._write_with_fixed_subarrays(value)
         self._state = 2
 
-    def write_int_or_record_with_vlens(self, value: Int32OrRecordWithVlens) -> None:
+    def write_with_vlen_subarrays(self, value: npt.NDArray[np.void]) -> None:
         """Ordinal 1"""
 
         if self._state != 2:
             self._raise_unexpected_state(2)
 
-        self._write_int_or_record_with_vlens(value)
+        self._write_with_vlen_subarrays(value)
         self._state = 4
 
-    def write_monosotate_or_int_or_simple_record(self, value: typing.Optional[Int32OrSimpleRecord]) -> None:
-        """Ordinal 2"""
-
-        if self._state != 4:
-            self._raise_unexpected_state(4)
-
-        self._write_monosotate_or_int_or_simple_record(value)
-        self._state = 6
-
-    def write_vector_of_unions(self, value: list[StringOrInt32]) -> None:
-        """Ordinal 3"""
-
-        if self._state != 6:
-            self._raise_unexpected_state(6)
-
-        self._write_vector_of_unions(value)
-        self._state = 8
-
-    def write_record_with_unions(self, value: basic_types.RecordWithUnions) -> None:
-        """Ordinal 4"""
-
-        if self._state != 8:
-            self._raise_unexpected_state(8)
-
-        self._write_record_with_unions(value)
-        self._state = 10
-
-    @abc.abstractmethod
-    def _write_int_or_simple_record(self, value: Int32OrSimpleRecord) -> None:
-        raise NotImplementedError()
-
-    @abc.abstractmethod
-    def _write_int_or_record_with_vlens(self, value: Int32OrRecordWithVlens) -> None:
-        raise NotImplementedError()
-
-    @abc.abstractmethod
-    def _write_monosotate_or_int_or_simple_record(self, value: typing.Optional[Int32OrSimpleRecord]) -> None:
-        raise NotImplementedError()
-
     @abc.abstractmethod
-    def _write_vector_of_unions(self, value: list[StringOrInt32]) -> None:
+    def _write_with_fixed_subarrays(self, value: npt.NDArray[np.void]) -> None:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _write_record_with_unions(self, value: basic_types.RecordWithUnions) -> None:
+    def _write_with_vlen_subarrays(self, value: npt.NDArray[np.void]) -> None:
         raise NotImplementedError()
 
     @abc.abstractmethod
@@ -4476,19 +4403,13 @@ class UnionsWriterBase(abc.ABC):
 
     def _state_to_method_name(self, state: int) -> str:
         if state == 0:
-            return 'write_int_or_simple_record'
+            return 'write_with_fixed_subarrays'
         if state == 2:
-            return 'write_int_or_record_with_vlens'
-        if state == 4:
-            return 'write_monosotate_or_int_or_simple_record'
-        if state == 6:
-            return 'write_vector_of_unions'
-        if state == 8:
-            return 'write_record_with_unions'
+            return 'write_with_vlen_subarrays'
         return "<unknown>"
 
-class UnionsReaderBase(abc.ABC):
-    """Abstract reader for the Unions protocol."""
+class SubarraysInRecordsReaderBase(abc.ABC):
+    """Abstract reader for the SubarraysInRecords protocol."""
 
 
     def __init__(self, skip_completed_check: bool = False) -> None:
@@ -4497,7 +4418,7 @@ class UnionsReaderBase(abc.ABC):
 
     def close(self) -> None:
         self._close()
-        if not self._skip_completed_check and self._state != 10:
+        if not self._skip_completed_check and self._state != 4:
             if self._state % 2 == 1:
                 previous_method = self._state_to_method_name(self._state - 1)
                 raise ProtocolError(f"Protocol reader closed before all data was consumed. The iterable returned by '{previous_method}' was not fully consumed.")
@@ -4506,7 +4427,7 @@ class UnionsReaderBase(abc.ABC):
                 raise ProtocolError(f"Protocol reader closed before all data was consumed. Expected call to '{expected_method}'.")
             	
 
-    schema = UnionsWriterBase.schema
+    schema = SubarraysInRecordsWriterBase.schema
 
     def __enter__(self):
         return self
@@ -4522,81 +4443,36 @@ class UnionsReaderBase(abc.ABC):
     def _close(self) -> None:
         raise NotImplementedError()
 
-    def read_int_or_simple_record(self) -> Int32OrSimpleRecord:
+    def read_with_fixed_subarrays(self) -> npt.NDArray[np.void]:
         """Ordinal 0"""
 
         if self._state != 0:
             self._raise_unexpected_state(0)
 
-        value = self._read_int_or_simple_record()
+        value = self._read_with_fixed_subarrays()
         self._state = 2
         return value
 
-    def read_int_or_record_with_vlens(self) -> Int32OrRecordWithVlens:
+    def read_with_vlen_subarrays(self) -> npt.NDArray[np.void]:
         """Ordinal 1"""
 
         if self._state != 2:
             self._raise_unexpected_state(2)
 
-        value = self._read_int_or_record_with_vlens()
+        value = self._read_with_vlen_subarrays()
         self._state = 4
         return value
 
-    def read_monosotate_or_int_or_simple_record(self) -> typing.Optional[Int32OrSimpleRecord]:
-        """Ordinal 2"""
-
-        if self._state != 4:
-            self._raise_unexpected_state(4)
-
-        value = self._read_monosotate_or_int_or_simple_record()
-        self._state = 6
-        return value
-
-    def read_vector_of_unions(self) -> list[StringOrInt32]:
-        """Ordinal 3"""
-
-        if self._state != 6:
-            self._raise_unexpected_state(6)
-
-        value = self._read_vector_of_unions()
-        self._state = 8
-        return value
-
-    def read_record_with_unions(self) -> basic_types.RecordWithUnions:
-        """Ordinal 4"""
-
-        if self._state != 8:
-            self._raise_unexpected_state(8)
-
-        value = self._read_record_with_unions()
-        self._state = 10
-        return value
-
-    def copy_to(self, writer: UnionsWriterBase) -> None:
-        writer.write_int_or_simple_record(self.read_int_or_simple_record())
-        writer.write_int_or_record_with_vlens(self.read_int_or_record_with_vlens())
-        writer.write_monosotate_or_int_or_simple_record(self.read_monosotate_or_int_or_simple_record())
-        writer.write_vector_of_unions(self.read_vector_of_unions())
-        writer.write_record_with_unions(self.read_record_with_unions())
-
-    @abc.abstractmethod
-    def _read_int_or_simple_record(self) -> Int32OrSimpleRecord:
-        raise NotImplementedError()
-
-    @abc.abstractmethod
-    def _read_int_or_record_with_vlens(self) -> Int32OrRecordWithVlens:
-        raise NotImplementedError()
-
-    @abc.abstractmethod
-    def _read_monosotate_or_int_or_simple_record(self) -> typing.Optional[Int32OrSimpleRecord]:
-        raise NotImplementedError()
+    def copy_to(self, writer: SubarraysInRecordsWriterBase) -> None:
+        writer.write_with_fixed_subarrays(self.read_with_fixed_subarrays())
+        writer.write_with_vlen_subarrays(self.read_with_vlen_subarrays())
 
     @abc.abstractmethod
-    def _read_vector_of_unions(self) -> list[StringOrInt32]:
+    def _read_with_fixed_subarrays(self) -> npt.NDArray[np.void]:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _read_record_with_unions(self) -> basic_types.RecordWithUnions:
+    def _read_with_vlen_subarrays(self) -> npt.NDArray[np.void]:
         raise NotImplementedError()
 
     T = typing.TypeVar('T')
@@ -4615,35 +4491,23 @@ class UnionsReaderBase(abc.ABC):
         	
     def _state_to_method_name(self, state: int) -> str:
         if state == 0:
-            return 'read_int_or_simple_record'
+            return 'read_with_fixed_subarrays'
         if state == 2:
-            return 'read_int_or_record_with_vlens'
-        if state == 4:
-            return 'read_monosotate_or_int_or_simple_record'
-        if state == 6:
-            return 'read_vector_of_unions'
-        if state == 8:
-            return 'read_record_with_unions'
+            return 'read_with_vlen_subarrays'
         return "<unknown>"
 
-class StreamsOfUnionsWriterBase(abc.ABC):
-    """Abstract writer for the StreamsOfUnions protocol."""
+class NDArraysWriterBase(abc.ABC):
+    """Abstract writer for the NDArrays protocol."""
 
 
     def __init__(self) -> None:
         self._state = 0
 
-    schema = r"""{"protocol":{"name":"StreamsOfUnions","sequence":[{"name":"intOrSimpleRecord","type":{"stream":{"items":[{"tag":"int32","type":"int32"},{"tag":"SimpleRecord","type":"TestModel.SimpleRecord"}]}}},{"name":"nullableIntOrSimpleRecord","type":{"stream":{"items":[null,{"tag":"int32","type":"int32"},{"tag":"SimpleRecord","type":"TestModel.SimpleRecord"}]}}},{"name":"manyCases","type":{"stream":{"items":[{"tag":"int32","type":"int32"},{"tag":"float32","type":"float32"},{"tag":"string","type":"string"},{"tag":"SimpleRecord","type":"TestModel.SimpleRecord"},{"tag":"NamedFixedNDArray","type":"TestModel.NamedFixedNDArray"}]}}}]},"types":[{"name":"NamedFixedNDArray","type":{"array":{"items":"int32","dimensions":[{"name":"dimA","length":2},{"name":"dimB","length":4}]}}},{"name":"SimpleRecord","fields":[{"name":"x","type":"int32"},{"name":"y","type":"int32"},{"name":"z","type":"int32"}]}]}"""
+    schema = r"""{"protocol":{"name":"NDArrays","sequence":[{"name":"ints","type":{"array":{"items":"int32","dimensions":2}}},{"name":"simpleRecordArray","type":{"array":{"items":"TestModel.SimpleRecord","dimensions":2}}},{"name":"recordWithVlensArray","type":{"array":{"items":"TestModel.RecordWithVlens","dimensions":2}}},{"name":"recordWithNDArrays","type":"TestModel.RecordWithNDArrays"},{"name":"namedArray","type":"TestModel.NamedNDArray"}]},"types":[{"name":"NamedNDArray","type":{"array":{"items":"int32","dimensions":[{"name":"dimA"},{"name":"dimB"}]}}},{"name":"RecordWithNDArrays","fields":[{"name":"ints","type":{"array":{"items":"int32","dimensions":2}}},{"name":"fixedSimpleRecordArray","type":{"array":{"items":"TestModel.SimpleRecord","dimensions":2}}},{"name":"fixedRecordWithVlensArray","type":{"array":{"items":"TestModel.RecordWithVlens","dimensions":2}}}]},{"name":"RecordWithVlens","fields":[{"name":"a","type":{"vector":{"items":"TestModel.SimpleRecord"}}},{"name":"b","type":"int32"},{"name":"c","type":"int32"}]},{"name":"SimpleRecord","fields":[{"name":"x","type":"int32"},{"name":"y","type":"int32"},{"name":"z","type":"int32"}]}]}"""
 
     def close(self) -> None:
-        if self._state == 5:
-            try:
-                self._end_stream()
-                return
-            finally:
-                self._close()
         self._close()
-        if self._state != 6:
+        if self._state != 10:
             expected_method = self._state_to_method_name((self._state + 1) & ~1)
             raise ProtocolError(f"Protocol writer closed before all steps were called. Expected to call to '{expected_method}'.")
 
@@ -4657,49 +4521,69 @@ class StreamsOfUnionsWriterBase(abc.ABC):
             if exc is None:
                 raise e
 
-    def write_int_or_simple_record(self, value: collections.abc.Iterable[Int32OrSimpleRecord]) -> None:
+    def write_ints(self, value: npt.NDArray[np.int32]) -> None:
         """Ordinal 0"""
 
-        if self._state & ~1 != 0:
+        if self._state != 0:
             self._raise_unexpected_state(0)
 
-        self._write_int_or_simple_record(value)
-        self._state = 1
+        self._write_ints(value)
+        self._state = 2
 
-    def write_nullable_int_or_simple_record(self, value: collections.abc.Iterable[typing.Optional[Int32OrSimpleRecord]]) -> None:
+    def write_simple_record_array(self, value: npt.NDArray[np.void]) -> None:
         """Ordinal 1"""
 
-        if self._state == 1:
-            self._end_stream()
-            self._state = 2
-        elif self._state & ~1 != 2:
+        if self._state != 2:
             self._raise_unexpected_state(2)
 
-        self._write_nullable_int_or_simple_record(value)
-        self._state = 3
+        self._write_simple_record_array(value)
+        self._state = 4
 
-    def write_many_cases(self, value: collections.abc.Iterable[Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray]) -> None:
+    def write_record_with_vlens_array(self, value: npt.NDArray[np.void]) -> None:
         """Ordinal 2"""
 
-        if self._state == 3:
-            self._end_stream()
-            self._state = 4
-        elif self._state & ~1 != 4:
+        if self._state != 4:
             self._raise_unexpected_state(4)
 
-        self._write_many_cases(value)
-        self._state = 5
+        self._write_record_with_vlens_array(value)
+        self._state = 6
+
+    def write_record_with_nd_arrays(self, value: RecordWithNDArrays) -> None:
+        """Ordinal 3"""
+
+        if self._state != 6:
+            self._raise_unexpected_state(6)
+
+        self._write_record_with_nd_arrays(value)
+        self._state = 8
+
+    def write_named_array(self, value: NamedNDArray) -> None:
+        """Ordinal 4"""
+
+        if self._state != 8:
+            self._raise_unexpected_state(8)
+
+        self._write_named_array(value)
+        self._state = 10
 
     @abc.abstractmethod
-    def _write_int_or_simple_record(self, value: collections.abc.Iterable[Int32OrSimpleRecord]) -> None:
+    def _write_ints(self, value: npt.NDArray[np.int32]) -> None:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _write_nullable_int_or_simple_record(self, value: collections.abc.Iterable[typing.Optional[Int32OrSimpleRecord]]) -> None:
+    def _write_simple_record_array(self, value: npt.NDArray[np.void]) -> None:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _write_many_cases(self, value: collections.abc.Iterable[Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray]) -> None:
+    def _write_record_with_vlens_array(self, value: npt.NDArray[np.void]) -> None:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _write_record_with_nd_arrays(self, value: RecordWithNDArrays) -> None:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _write_named_array(self, value: NamedNDArray) -> None:
         raise NotImplementedError()
 
     @abc.abstractmethod
@@ -4717,15 +4601,19 @@ class StreamsOfUnionsWriterBase(abc.ABC):
 
     def _state_to_method_name(self, state: int) -> str:
         if state == 0:
-            return 'write_int_or_simple_record'
+            return 'write_ints'
         if state == 2:
-            return 'write_nullable_int_or_simple_record'
+            return 'write_simple_record_array'
         if state == 4:
-            return 'write_many_cases'
+            return 'write_record_with_vlens_array'
+        if state == 6:
+            return 'write_record_with_nd_arrays'
+        if state == 8:
+            return 'write_named_array'
         return "<unknown>"
 
-class StreamsOfUnionsReaderBase(abc.ABC):
-    """Abstract reader for the StreamsOfUnions protocol."""
+class NDArraysReaderBase(abc.ABC):
+    """Abstract reader for the NDArrays protocol."""
 
 
     def __init__(self, skip_completed_check: bool = False) -> None:
@@ -4734,7 +4622,7 @@ class StreamsOfUnionsReaderBase(abc.ABC):
 
     def close(self) -> None:
         self._close()
-        if not self._skip_completed_check and self._state != 6:
+        if not self._skip_completed_check and self._state != 10:
             if self._state % 2 == 1:
                 previous_method = self._state_to_method_name(self._state - 1)
                 raise ProtocolError(f"Protocol reader closed before all data was consumed. The iterable returned by '{previous_method}' was not fully consumed.")
@@ -4743,7 +4631,7 @@ class StreamsOfUnionsReaderBase(abc.ABC):
                 raise ProtocolError(f"Protocol reader closed before all data was consumed. Expected call to '{expected_method}'.")
             	
 
-    schema = StreamsOfUnionsWriterBase.schema
+    schema = NDArraysWriterBase.schema
 
     def __enter__(self):
         return self
@@ -4759,51 +4647,81 @@ class StreamsOfUnionsReaderBase(abc.ABC):
     def _close(self) -> None:
         raise NotImplementedError()
 
-    def read_int_or_simple_record(self) -> collections.abc.Iterable[Int32OrSimpleRecord]:
+    def read_ints(self) -> npt.NDArray[np.int32]:
         """Ordinal 0"""
 
         if self._state != 0:
             self._raise_unexpected_state(0)
 
-        value = self._read_int_or_simple_record()
-        self._state = 1
-        return self._wrap_iterable(value, 2)
+        value = self._read_ints()
+        self._state = 2
+        return value
 
-    def read_nullable_int_or_simple_record(self) -> collections.abc.Iterable[typing.Optional[Int32OrSimpleRecord]]:
+    def read_simple_record_array(self) -> npt.NDArray[np.void]:
         """Ordinal 1"""
 
         if self._state != 2:
             self._raise_unexpected_state(2)
 
-        value = self._read_nullable_int_or_simple_record()
-        self._state = 3
-        return self._wrap_iterable(value, 4)
+        value = self._read_simple_record_array()
+        self._state = 4
+        return value
 
-    def read_many_cases(self) -> collections.abc.Iterable[Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray]:
+    def read_record_with_vlens_array(self) -> npt.NDArray[np.void]:
         """Ordinal 2"""
 
         if self._state != 4:
             self._raise_unexpected_state(4)
 
-        value = self._read_many_cases()
-        self._state = 5
-        return self._wrap_iterable(value, 6)
+        value = self._read_record_with_vlens_array()
+        self._state = 6
+        return value
 
-    def copy_to(self, writer: StreamsOfUnionsWriterBase) -> None:
-        writer.write_int_or_simple_record(self.read_int_or_simple_record())
-        writer.write_nullable_int_or_simple_record(self.read_nullable_int_or_simple_record())
-        writer.write_many_cases(self.read_many_cases())
+    def read_record_with_nd_arrays(self) -> RecordWithNDArrays:
+        """Ordinal 3"""
+
+        if self._state != 6:
+            self._raise_unexpected_state(6)
+
+        value = self._read_record_with_nd_arrays()
+        self._state = 8
+        return value
+
+    def read_named_array(self) -> NamedNDArray:
+        """Ordinal 4"""
+
+        if self._state != 8:
+            self._raise_unexpected_state(8)
+
+        value = self._read_named_array()
+        self._state = 10
+        return value
+
+    def copy_to(self, writer: NDArraysWriterBase) -> None:
+        writer.write_ints(self.read_ints())
+        writer.write_simple_record_array(self.read_simple_record_array())
+        writer.write_record_with_vlens_array(self.read_record_with_vlens_array())
+        writer.write_record_with_nd_arrays(self.read_record_with_nd_arrays())
+        writer.write_named_array(self.read_named_array())
 
     @abc.abstractmethod
-    def _read_int_or_simple_record(self) -> collections.abc.Iterable[Int32OrSimpleRecord]:
+    def _read_ints(self) -> npt.NDArray[np.int32]:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _read_nullable_int_or_simple_record(self) -> collections.abc.Iterable[typing.Optional[Int32OrSimpleRecord]]:
+    def _read_simple_record_array(self) -> npt.NDArray[np.void]:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _read_many_cases(self) -> collections.abc.Iterable[Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray]:
+    def _read_record_with_vlens_array(self) -> npt.NDArray[np.void]:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _read_record_with_nd_arrays(self) -> RecordWithNDArrays:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _read_named_array(self) -> NamedNDArray:
         raise NotImplementedError()
 
     T = typing.TypeVar('T')
@@ -4822,21 +4740,25 @@ class StreamsOfUnionsReaderBase(abc.ABC):
         	
     def _state_to_method_name(self, state: int) -> str:
         if state == 0:
-            return 'read_int_or_simple_record'
+            return 'read_ints'
         if state == 2:
-            return 'read_nullable_int_or_simple_record'
+            return 'read_simple_record_array'
         if state == 4:
-            return 'read_many_cases'
+            return 'read_record_with_vlens_array'
+        if state == 6:
+            return 'read_record_with_nd_arrays'
+        if state == 8:
+            return 'read_named_array'
         return "<unknown>"
 
-class EnumsWriterBase(abc.ABC):
-    """Abstract writer for the Enums protocol."""
+class NDArraysSingleDimensionWriterBase(abc.ABC):
+    """Abstract writer for the NDArraysSingleDimension protocol."""
 
 
     def __init__(self) -> None:
         self._state = 0
 
-    schema = r"""{"protocol":{"name":"Enums","sequence":[{"name":"single","type":"TestModel.Fruits"},{"name":"vec","type":{"vector":{"items":"TestModel.Fruits"}}},{"name":"size","type":"TestModel.SizeBasedEnum"},{"name":"rec","type":"TestModel.RecordWithEnums"}]},"types":[{"name":"DaysOfWeek","values":[{"symbol":"monday","value":1},{"symbol":"tuesday","value":2},{"symbol":"wednesday","value":4},{"symbol":"thursday","value":8},{"symbol":"friday","value":16},{"symbol":"saturday","value":32},{"symbol":"sunday","value":64}]},{"name":"Fruits","values":[{"symbol":"apple","value":1},{"symbol":"banana","value":2},{"symbol":"pear","value":3}]},{"name":"TextFormat","base":"uint64","values":[{"symbol":"regular","value":0},{"symbol":"bold","value":1},{"symbol":"italic","value":2},{"symbol":"underline","value":4},{"symbol":"strikethrough","value":8}]},{"name":"DaysOfWeek","type":"BasicTypes.DaysOfWeek"},{"name":"Fruits","type":"BasicTypes.Fruits"},{"name":"RecordWithEnums","fields":[{"name":"enum","type":"TestModel.Fruits"},{"name":"flags","type":"TestModel.DaysOfWeek"},{"name":"flags2","type":"TestModel.TextFormat"},{"name":"rec","type":"TestModel.RecordWithNoDefaultEnum"}]},{"name":"RecordWithNoDefaultEnum","fields":[{"name":"enum","type":"TestModel.Fruits"}]},{"name":"SizeBasedEnum","base":"size","values":[{"symbol":"a","value":0},{"symbol":"b","value":1},{"symbol":"c","value":2}]},{"name":"TextFormat","type":"BasicTypes.TextFormat"}]}"""
+    schema = r"""{"protocol":{"name":"NDArraysSingleDimension","sequence":[{"name":"ints","type":{"array":{"items":"int32","dimensions":1}}},{"name":"simpleRecordArray","type":{"array":{"items":"TestModel.SimpleRecord","dimensions":1}}},{"name":"recordWithVlensArray","type":{"array":{"items":"TestModel.RecordWithVlens","dimensions":1}}},{"name":"recordWithNDArrays","type":"TestModel.RecordWithNDArraysSingleDimension"}]},"types":[{"name":"RecordWithNDArraysSingleDimension","fields":[{"name":"ints","type":{"array":{"items":"int32","dimensions":1}}},{"name":"fixedSimpleRecordArray","type":{"array":{"items":"TestModel.SimpleRecord","dimensions":1}}},{"name":"fixedRecordWithVlensArray","type":{"array":{"items":"TestModel.RecordWithVlens","dimensions":1}}}]},{"name":"RecordWithVlens","fields":[{"name":"a","type":{"vector":{"items":"TestModel.SimpleRecord"}}},{"name":"b","type":"int32"},{"name":"c","type":"int32"}]},{"name":"SimpleRecord","fields":[{"name":"x","type":"int32"},{"name":"y","type":"int32"},{"name":"z","type":"int32"}]}]}"""
 
     def close(self) -> None:
         self._close()
@@ -4854,56 +4776,56 @@ class EnumsWriterBase(abc.ABC):
             if exc is None:
                 raise e
 
-    def write_single(self, value: Fruits) -> None:
+    def write_ints(self, value: npt.NDArray[np.int32]) -> None:
         """Ordinal 0"""
 
         if self._state != 0:
             self._raise_unexpected_state(0)
 
-        self._write_single(value)
+        self._write_ints(value)
         self._state = 2
 
-    def write_vec(self, value: list[Fruits]) -> None:
+    def write_simple_record_array(self, value: npt.NDArray[np.void]) -> None:
         """Ordinal 1"""
 
         if self._state != 2:
             self._raise_unexpected_state(2)
 
-        self._write_vec(value)
+        self._write_simple_record_array(value)
         self._state = 4
 
-    def write_size(self, value: SizeBasedEnum) -> None:
+    def write_record_with_vlens_array(self, value: npt.NDArray[np.void]) -> None:
         """Ordinal 2"""
 
         if self._state != 4:
             self._raise_unexpected_state(4)
 
-        self._write_size(value)
+        self._write_record_with_vlens_array(value)
         self._state = 6
 
-    def write_rec(self, value: RecordWithEnums) -> None:
+    def write_record_with_nd_arrays(self, value: RecordWithNDArraysSingleDimension) -> None:
         """Ordinal 3"""
 
         if self._state != 6:
             self._raise_unexpected_state(6)
 
-        self._write_rec(value)
+        self._write_record_with_nd_arrays(value)
         self._state = 8
 
     @abc.abstractmethod
-    def _write_single(self, value: Fruits) -> None:
+    def _write_ints(self, value: npt.NDArray[np.int32]) -> None:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _write_vec(self, value: list[Fruits]) -> None:
+    def _write_simple_record_array(self, value: npt.NDArray[np.void]) -> None:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _write_size(self, value: SizeBasedEnum) -> None:
+    def _write_record_with_vlens_array(self, value: npt.NDArray[np.void]) -> None:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _write_rec(self, value: RecordWithEnums) -> None:
+    def _write_record_with_nd_arrays(self, value: RecordWithNDArraysSingleDimension) -> None:
         raise NotImplementedError()
 
     @abc.abstractmethod
@@ -4921,17 +4843,17 @@ class EnumsWriterBase(abc.ABC):
 
     def _state_to_method_name(self, state: int) -> str:
         if state == 0:
-            return 'write_single'
+            return 'write_ints'
         if state == 2:
-            return 'write_vec'
+            return 'write_simple_record_array'
         if state == 4:
-            return 'write_size'
+            return 'write_record_with_vlens_array'
         if state == 6:
-            return 'write_rec'
+            return 'write_record_with_nd_arrays'
         return "<unknown>"
 
-class EnumsReaderBase(abc.ABC):
-    """Abstract reader for the Enums protocol."""
+class NDArraysSingleDimensionReaderBase(abc.ABC):
+    """Abstract reader for the NDArraysSingleDimension protocol."""
 
 
     def __init__(self, skip_completed_check: bool = False) -> None:
@@ -4949,7 +4871,7 @@ class EnumsReaderBase(abc.ABC):
                 raise ProtocolError(f"Protocol reader closed before all data was consumed. Expected call to '{expected_method}'.")
             	
 
-    schema = EnumsWriterBase.schema
+    schema = NDArraysSingleDimensionWriterBase.schema
 
     def __enter__(self):
         return self
@@ -4965,66 +4887,66 @@ class EnumsReaderBase(abc.ABC):
     def _close(self) -> None:
         raise NotImplementedError()
 
-    def read_single(self) -> Fruits:
+    def read_ints(self) -> npt.NDArray[np.int32]:
         """Ordinal 0"""
 
         if self._state != 0:
             self._raise_unexpected_state(0)
 
-        value = self._read_single()
+        value = self._read_ints()
         self._state = 2
         return value
 
-    def read_vec(self) -> list[Fruits]:
+    def read_simple_record_array(self) -> npt.NDArray[np.void]:
         """Ordinal 1"""
 
         if self._state != 2:
             self._raise_unexpected_state(2)
 
-        value = self._read_vec()
+        value = self._read_simple_record_array()
         self._state = 4
         return value
 
-    def read_size(self) -> SizeBasedEnum:
+    def read_record_with_vlens_array(self) -> npt.NDArray[np.void]:
         """Ordinal 2"""
 
         if self._state != 4:
             self._raise_unexpected_state(4)
 
-        value = self._read_size()
+        value = self._read_record_with_vlens_array()
         self._state = 6
         return value
 
-    def read_rec(self) -> RecordWithEnums:
+    def read_record_with_nd_arrays(self) -> RecordWithNDArraysSingleDimension:
         """Ordinal 3"""
 
         if self._state != 6:
             self._raise_unexpected_state(6)
 
-        value = self._read_rec()
+        value = self._read_record_with_nd_arrays()
         self._state = 8
         return value
 
-    def copy_to(self, writer: EnumsWriterBase) -> None:
-        writer.write_single(self.read_single())
-        writer.write_vec(self.read_vec())
-        writer.write_size(self.read_size())
-        writer.write_rec(self.read_rec())
+    def copy_to(self, writer: NDArraysSingleDimensionWriterBase) -> None:
+        writer.write_ints(self.read_ints())
+        writer.write_simple_record_array(self.read_simple_record_array())
+        writer.write_record_with_vlens_array(self.read_record_with_vlens_array())
+        writer.write_record_with_nd_arrays(self.read_record_with_nd_arrays())
 
     @abc.abstractmethod
-    def _read_single(self) -> Fruits:
+    def _read_ints(self) -> npt.NDArray[np.int32]:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _read_vec(self) -> list[Fruits]:
+    def _read_simple_record_array(self) -> npt.NDArray[np.void]:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _read_size(self) -> SizeBasedEnum:
+    def _read_record_with_vlens_array(self) -> npt.NDArray[np.void]:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _read_rec(self) -> RecordWithEnums:
+    def _read_record_with_nd_arrays(self) -> RecordWithNDArraysSingleDimension:
         raise NotImplementedError()
 
     T = typing.TypeVar('T')
@@ -5043,33 +4965,27 @@ class EnumsReaderBase(abc.ABC):
         	
     def _state_to_method_name(self, state: int) -> str:
         if state == 0:
-            return 'read_single'
+            return 'read_ints'
         if state == 2:
-            return 'read_vec'
+            return 'read_simple_record_array'
         if state == 4:
-            return 'read_size'
+            return 'read_record_with_vlens_array'
         if state == 6:
-            return 'read_rec'
+            return 'read_record_with_nd_arrays'
         return "<unknown>"
 
-class FlagsWriterBase(abc.ABC):
-    """Abstract writer for the Flags protocol."""
+class DynamicNDArraysWriterBase(abc.ABC):
+    """Abstract writer for the DynamicNDArrays protocol."""
 
 
     def __init__(self) -> None:
         self._state = 0
 
-    schema = r"""{"protocol":{"name":"Flags","sequence":[{"name":"days","type":{"stream":{"items":"TestModel.DaysOfWeek"}}},{"name":"formats","type":{"stream":{"items":"TestModel.TextFormat"}}}]},"types":[{"name":"DaysOfWeek","values":[{"symbol":"monday","value":1},{"symbol":"tuesday","value":2},{"symbol":"wednesday","value":4},{"symbol":"thursday","value":8},{"symbol":"friday","value":16},{"symbol":"saturday","value":32},{"symbol":"sunday","value":64}]},{"name":"TextFormat","base":"uint64","values":[{"symbol":"regular","value":0},{"symbol":"bold","value":1},{"symbol":"italic","value":2},{"symbol":"underline","value":4},{"symbol":"strikethrough","value":8}]},{"name":"DaysOfWeek","type":"BasicTypes.DaysOfWeek"},{"name":"TextFormat","type":"BasicTypes.TextFormat"}]}"""
+    schema = r"""{"protocol":{"name":"DynamicNDArrays","sequence":[{"name":"ints","type":{"array":{"items":"int32"}}},{"name":"simpleRecordArray","type":{"array":{"items":"TestModel.SimpleRecord"}}},{"name":"recordWithVlensArray","type":{"array":{"items":"TestModel.RecordWithVlens"}}},{"name":"recordWithDynamicNDArrays","type":"TestModel.RecordWithDynamicNDArrays"}]},"types":[{"name":"IntArray","type":{"array":{"items":"int32"}}},{"name":"RecordWithDynamicNDArrays","fields":[{"name":"ints","type":"TestModel.IntArray"},{"name":"simpleRecordArray","type":{"array":{"items":"TestModel.SimpleRecord"}}},{"name":"recordWithVlensArray","type":{"array":{"items":"TestModel.RecordWithVlens"}}}]},{"name":"RecordWithVlens","fields":[{"name":"a","type":{"vector":{"items":"TestModel.SimpleRecord"}}},{"name":"b","type":"int32"},{"name":"c","type":"int32"}]},{"name":"SimpleRecord","fields":[{"name":"x","type":"int32"},{"name":"y","type":"int32"},{"name":"z","type":"int32"}]}]}"""
 
     def close(self) -> None:
-        if self._state == 3:
-            try:
-                self._end_stream()
-                return
-            finally:
-                self._close()
         self._close()
-        if self._state != 4:
+        if self._state != 8:
             expected_method = self._state_to_method_name((self._state + 1) & ~1)
             raise ProtocolError(f"Protocol writer closed before all steps were called. Expected to call to '{expected_method}'.")
 
@@ -5083,33 +4999,56 @@ class FlagsWriterBase(abc.ABC):
             if exc is None:
                 raise e
 
-    def write_days(self, value: collections.abc.Iterable[DaysOfWeek]) -> None:
+    def write_ints(self, value: npt.NDArray[np.int32]) -> None:
         """Ordinal 0"""
 
-        if self._state & ~1 != 0:
+        if self._state != 0:
             self._raise_unexpected_state(0)
 
-        self._write_days(value)
-        self._state = 1
+        self._write_ints(value)
+        self._state = 2
 
-    def write_formats(self, value: collections.abc.Iterable[TextFormat]) -> None:
+    def write_simple_record_array(self, value: npt.NDArray[np.void]) -> None:
         """Ordinal 1"""
 
-        if self._state == 1:
-            self._end_stream()
-            self._state = 2
-        elif self._state & ~1 != 2:
+        if self._state != 2:
             self._raise_unexpected_state(2)
 
-        self._write_formats(value)
-        self._state = 3
+        self._write_simple_record_array(value)
+        self._state = 4
+
+    def write_record_with_vlens_array(self, value: npt.NDArray[np.void]) -> None:
+        """Ordinal 2"""
+
+        if self._state != 4:
+            self._raise_unexpected_state(4)
+
+        self._write_record_with_vlens_array(value)
+        self._state = 6
+
+    def write_record_with_dynamic_nd_arrays(self, value: RecordWithDynamicNDArrays) -> None:
+        """Ordinal 3"""
+
+        if self._state != 6:
+            self._raise_unexpected_state(6)
+
+        self._write_record_with_dynamic_nd_arrays(value)
+        self._state = 8
 
     @abc.abstractmethod
-    def _write_days(self, value: collections.abc.Iterable[DaysOfWeek]) -> None:
+    def _write_ints(self, value: npt.NDArray[np.int32]) -> None:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _write_formats(self, value: collections.abc.Iterable[TextFormat]) -> None:
+    def _write_simple_record_array(self, value: npt.NDArray[np.void]) -> None:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _write_record_with_vlens_array(self, value: npt.NDArray[np.void]) -> None:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _write_record_with_dynamic_nd_arrays(self, value: RecordWithDynamicNDArrays) -> None:
         raise NotImplementedError()
 
     @abc.abstractmethod
@@ -5127,13 +5066,17 @@ class FlagsWriterBase(abc.ABC):
 
     def _state_to_method_name(self, state: int) -> str:
         if state == 0:
-            return 'write_days'
+            return 'write_ints'
         if state == 2:
-            return 'write_formats'
+            return 'write_simple_record_array'
+        if state == 4:
+            return 'write_record_with_vlens_array'
+        if state == 6:
+            return 'write_record_with_dynamic_nd_arrays'
         return "<unknown>"
 
-class FlagsReaderBase(abc.ABC):
-    """Abstract reader for the Flags protocol."""
+class DynamicNDArraysReaderBase(abc.ABC):
+    """Abstract reader for the DynamicNDArrays protocol."""
 
 
     def __init__(self, skip_completed_check: bool = False) -> None:
@@ -5142,7 +5085,7 @@ class FlagsReaderBase(abc.ABC):
 
     def close(self) -> None:
         self._close()
-        if not self._skip_completed_check and self._state != 4:
+        if not self._skip_completed_check and self._state != 8:
             if self._state % 2 == 1:
                 previous_method = self._state_to_method_name(self._state - 1)
                 raise ProtocolError(f"Protocol reader closed before all data was consumed. The iterable returned by '{previous_method}' was not fully consumed.")
@@ -5151,7 +5094,7 @@ class FlagsReaderBase(abc.ABC):
                 raise ProtocolError(f"Protocol reader closed before all data was consumed. Expected call to '{expected_method}'.")
             	
 
-    schema = FlagsWriterBase.schema
+    schema = DynamicNDArraysWriterBase.schema
 
     def __enter__(self):
         return self
@@ -5167,36 +5110,2070 @@ class FlagsReaderBase(abc.ABC):
     def _close(self) -> None:
         raise NotImplementedError()
 
-    def read_days(self) -> collections.abc.Iterable[DaysOfWeek]:
+    def read_ints(self) -> npt.NDArray[np.int32]:
         """Ordinal 0"""
 
         if self._state != 0:
             self._raise_unexpected_state(0)
 
-        value = self._read_days()
-        self._state = 1
-        return self._wrap_iterable(value, 2)
+        value = self._read_ints()
+        self._state = 2
+        return value
 
-    def read_formats(self) -> collections.abc.Iterable[TextFormat]:
+    def read_simple_record_array(self) -> npt.NDArray[np.void]:
         """Ordinal 1"""
 
         if self._state != 2:
             self._raise_unexpected_state(2)
 
-        value = self._read_formats()
-        self._state = 3
-        return self._wrap_iterable(value, 4)
+        value = self._read_simple_record_array()
+        self._state = 4
+        return value
 
-    def copy_to(self, writer: FlagsWriterBase) -> None:
-        writer.write_days(self.read_days())
-        writer.write_formats(self.read_formats())
+    def read_record_with_vlens_array(self) -> npt.NDArray[np.void]:
+        """Ordinal 2"""
+
+        if self._state != 4:
+            self._raise_unexpected_state(4)
+
+        value = self._read_record_with_vlens_array()
+        self._state = 6
+        return value
+
+    def read_record_with_dynamic_nd_arrays(self) -> RecordWithDynamicNDArrays:
+        """Ordinal 3"""
+
+        if self._state != 6:
+            self._raise_unexpected_state(6)
+
+        value = self._read_record_with_dynamic_nd_arrays()
+        self._state = 8
+        return value
+
+    def copy_to(self, writer: DynamicNDArraysWriterBase) -> None:
+        writer.write_ints(self.read_ints())
+        writer.write_simple_record_array(self.read_simple_record_array())
+        writer.write_record_with_vlens_array(self.read_record_with_vlens_array())
+        writer.write_record_with_dynamic_nd_arrays(self.read_record_with_dynamic_nd_arrays())
+
+    @abc.abstractmethod
+    def _read_ints(self) -> npt.NDArray[np.int32]:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _read_simple_record_array(self) -> npt.NDArray[np.void]:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _read_record_with_vlens_array(self) -> npt.NDArray[np.void]:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _read_record_with_dynamic_nd_arrays(self) -> RecordWithDynamicNDArrays:
+        raise NotImplementedError()
+
+    T = typing.TypeVar('T')
+    def _wrap_iterable(self, iterable: collections.abc.Iterable[T], final_state: int) -> collections.abc.Iterable[T]:
+        yield from iterable
+        self._state = final_state
+
+    def _raise_unexpected_state(self, actual: int) -> None:
+        actual_method = self._state_to_method_name(actual)
+        if self._state % 2 == 1:
+            previous_method = self._state_to_method_name(self._state - 1)
+            raise ProtocolError(f"Received call to '{actual_method}' but the iterable returned by '{previous_method}' was not fully consumed.")
+        else:
+            expected_method = self._state_to_method_name(self._state)
+            raise ProtocolError(f"Expected to call to '{expected_method}' but received call to '{actual_method}'.")
+        	
+    def _state_to_method_name(self, state: int) -> str:
+        if state == 0:
+            return 'read_ints'
+        if state == 2:
+            return 'read_simple_record_array'
+        if state == 4:
+            return 'read_record_with_vlens_array'
+        if state == 6:
+            return 'read_record_with_dynamic_nd_arrays'
+        return "<unknown>"
+
+class MultiDArraysWriterBase(abc.ABC):
+    """Abstract writer for the MultiDArrays protocol."""
+
+
+    def __init__(self) -> None:
+        self._state = 0
+
+    schema = r"""{"protocol":{"name":"MultiDArrays","sequence":[{"name":"images","type":{"stream":{"items":{"array":{"items":"float32","dimensions":[{"name":"ch"},{"name":"z"},{"name":"y"},{"name":"x"}]}}}}},{"name":"frames","type":{"stream":{"items":{"array":{"items":"float32","dimensions":[{"name":"ch","length":1},{"name":"z","length":1},{"name":"y","length":64},{"name":"x","length":32}]}}}}}]},"types":null}"""
+
+    def close(self) -> None:
+        if self._state == 3:
+            try:
+                self._end_stream()
+                return
+            finally:
+                self._close()
+        self._close()
+        if self._state != 4:
+            expected_method = self._state_to_method_name((self._state + 1) & ~1)
+            raise ProtocolError(f"Protocol writer closed before all steps were called. Expected to call to '{expected_method}'.")
+
+    def __enter__(self):
+        return self
+
+    def __exit__(self, exc_type: typing.Optional[type[BaseException]], exc: typing.Optional[BaseException], traceback: object) -> None:
+        try:
+            self.close()
+        except Exception as e:
+            if exc is None:
+                raise e
+
+    def write_images(self, value: collections.abc.Iterable[npt.NDArray[np.float32]]) -> None:
+        """Ordinal 0"""
+
+        if self._state & ~1 != 0:
+            self._raise_unexpected_state(0)
+
+        self._write_images(value)
+        self._state = 1
+
+    def write_frames(self, value: collections.abc.Iterable[npt.NDArray[np.float32]]) -> None:
+        """Ordinal 1"""
+
+        if self._state == 1:
+            self._end_stream()
+            self._state = 2
+        elif self._state & ~1 != 2:
+            self._raise_unexpected_state(2)
+
+        self._write_frames(value)
+        self._state = 3
+
+    @abc.abstractmethod
+    def _write_images(self, value: collections.abc.Iterable[npt.NDArray[np.float32]]) -> None:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _write_frames(self, value: collections.abc.Iterable[npt.NDArray[np.float32]]) -> None:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _close(self) -> None:
+        pass
+
+    @abc.abstractmethod
+    def _end_stream(self) -> None:
+        pass
+
+    def _raise_unexpected_state(self, actual: int) -> None:
+        expected_method = self._state_to_method_name(self._state)
+        actual_method = self._state_to_method_name(actual)
+        raise ProtocolError(f"Expected to call to '{expected_method}' but received call to '{actual_method}'.")
+
+    def _state_to_method_name(self, state: int) -> str:
+        if state == 0:
+            return 'write_images'
+        if state == 2:
+            return 'write_frames'
+        return "<unknown>"
+
+class MultiDArraysReaderBase(abc.ABC):
+    """Abstract reader for the MultiDArrays protocol."""
+
+
+    def __init__(self, skip_completed_check: bool = False) -> None:
+        self._skip_completed_check = skip_completed_check
+        self._state = 0
+
+    def close(self) -> None:
+        self._close()
+        if not self._skip_completed_check and self._state != 4:
+            if self._state % 2 == 1:
+                previous_method = self._state_to_method_name(self._state - 1)
+                raise ProtocolError(f"Protocol reader closed before all data was consumed. The iterable returned by '{previous_method}' was not fully consumed.")
+            else:
+                expected_method = self._state_to_method_name(self._state)
+                raise ProtocolError(f"Protocol reader closed before all data was consumed. Expected call to '{expected_method}'.")
+            	
+
+    schema = MultiDArraysWriterBase.schema
+
+    def __enter__(self):
+        return self
+
+    def __exit__(self, exc_type: typing.Optional[type[BaseException]], exc: typing.Optional[BaseException], traceback: object) -> None:
+        try:
+            self.close()
+        except Exception as e:
+            if exc is None:
+                raise e
+
+    @abc.abstractmethod
+    def _close(self) -> None:
+        raise NotImplementedError()
+
+    def read_images(self) -> collections.abc.Iterable[npt.NDArray[np.float32]]:
+        """Ordinal 0"""
+
+        if self._state != 0:
+            self._raise_unexpected_state(0)
+
+        value = self._read_images()
+        self._state = 1
+        return self._wrap_iterable(value, 2)
+
+    def read_frames(self) -> collections.abc.Iterable[npt.NDArray[np.float32]]:
+        """Ordinal 1"""
+
+        if self._state != 2:
+            self._raise_unexpected_state(2)
+
+        value = self._read_frames()
+        self._state = 3
+        return self._wrap_iterable(value, 4)
+
+    def copy_to(self, writer: MultiDArraysWriterBase) -> None:
+        writer.write_images(self.read_images())
+        writer.write_frames(self.read_frames())
+
+    @abc.abstractmethod
+    def _read_images(self) -> collections.abc.Iterable[npt.NDArray[np.float32]]:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _read_frames(self) -> collections.abc.Iterable[npt.NDArray[np.float32]]:
+        raise NotImplementedError()
+
+    T = typing.TypeVar('T')
+    def _wrap_iterable(self, iterable: collections.abc.Iterable[T], final_state: int) -> collections.abc.Iterable[T]:
+        yield from iterable
+        self._state = final_state
+
+    def _raise_unexpected_state(self, actual: int) -> None:
+        actual_method = self._state_to_method_name(actual)
+        if self._state % 2 == 1:
+            previous_method = self._state_to_method_name(self._state - 1)
+            raise ProtocolError(f"Received call to '{actual_method}' but the iterable returned by '{previous_method}' was not fully consumed.")
+        else:
+            expected_method = self._state_to_method_name(self._state)
+            raise ProtocolError(f"Expected to call to '{expected_method}' but received call to '{actual_method}'.")
+        	
+    def _state_to_method_name(self, state: int) -> str:
+        if state == 0:
+            return 'read_images'
+        if state == 2:
+            return 'read_frames'
+        return "<unknown>"
+
+class ComplexArraysWriterBase(abc.ABC):
+    """Abstract writer for the ComplexArrays protocol."""
+
+
+    def __init__(self) -> None:
+        self._state = 0
+
+    schema = r"""{"protocol":{"name":"ComplexArrays","sequence":[{"name":"floats","type":{"array":{"items":"complexfloat32"}}},{"name":"doubles","type":{"array":{"items":"complexfloat64","dimensions":2}}}]},"types":null}"""
+
+    def close(self) -> None:
+        self._close()
+        if self._state != 4:
+            expected_method = self._state_to_method_name((self._state + 1) & ~1)
+            raise ProtocolError(f"Protocol writer closed before all steps were called. Expected to call to '{expected_method}'.")
+
+    def __enter__(self):
+        return self
+
+    def __exit__(self, exc_type: typing.Optional[type[BaseException]], exc: typing.Optional[BaseException], traceback: object) -> None:
+        try:
+            self.close()
+        except Exception as e:
+            if exc is None:
+                raise e
+
+    def write_floats(self, value: npt.NDArray[np.complex64]) -> None:
+        """Ordinal 0"""
+
+        if self._state != 0:
+            self._raise_unexpected_state(0)
+
+        self._write_floats(value)
+        self._state = 2
+
+    def write_doubles(self, value: npt.NDArray[np.complex128]) -> None:
+        """Ordinal 1"""
+
+        if self._state != 2:
+            self._raise_unexpected_state(2)
+
+        self._write_doubles(value)
+        self._state = 4
+
+    @abc.abstractmethod
+    def _write_floats(self, value: npt.NDArray[np.complex64]) -> None:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _write_doubles(self, value: npt.NDArray[np.complex128]) -> None:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _close(self) -> None:
+        pass
+
+    @abc.abstractmethod
+    def _end_stream(self) -> None:
+        pass
+
+    def _raise_unexpected_state(self, actual: int) -> None:
+        expected_method = self._state_to_method_name(self._state)
+        actual_method = self._state_to_method_name(actual)
+        raise ProtocolError(f"Expected to call to '{expected_method}' but received call to '{actual_method}'.")
+
+    def _state_to_method_name(self, state: int) -> str:
+        if state == 0:
+            return 'write_floats'
+        if state == 2:
+            return 'write_doubles'
+        return "<unknown>"
+
+class ComplexArraysReaderBase(abc.ABC):
+    """Abstract reader for the ComplexArrays protocol."""
+
+
+    def __init__(self, skip_completed_check: bool = False) -> None:
+        self._skip_completed_check = skip_completed_check
+        self._state = 0
+
+    def close(self) -> None:
+        self._close()
+        if not self._skip_completed_check and self._state != 4:
+            if self._state % 2 == 1:
+                previous_method = self._state_to_method_name(self._state - 1)
+                raise ProtocolError(f"Protocol reader closed before all data was consumed. The iterable returned by '{previous_method}' was not fully consumed.")
+            else:
+                expected_method = self._state_to_method_name(self._state)
+                raise ProtocolError(f"Protocol reader closed before all data was consumed. Expected call to '{expected_method}'.")
+            	
+
+    schema = ComplexArraysWriterBase.schema
+
+    def __enter__(self):
+        return self
+
+    def __exit__(self, exc_type: typing.Optional[type[BaseException]], exc: typing.Optional[BaseException], traceback: object) -> None:
+        try:
+            self.close()
+        except Exception as e:
+            if exc is None:
+                raise e
+
+    @abc.abstractmethod
+    def _close(self) -> None:
+        raise NotImplementedError()
+
+    def read_floats(self) -> npt.NDArray[np.complex64]:
+        """Ordinal 0"""
+
+        if self._state != 0:
+            self._raise_unexpected_state(0)
+
+        value = self._read_floats()
+        self._state = 2
+        return value
+
+    def read_doubles(self) -> npt.NDArray[np.complex128]:
+        """Ordinal 1"""
+
+        if self._state != 2:
+            self._raise_unexpected_state(2)
+
+        value = self._read_doubles()
+        self._state = 4
+        return value
+
+    def copy_to(self, writer: ComplexArraysWriterBase) -> None:
+        writer.write_floats(self.read_floats())
+        writer.write_doubles(self.read_doubles())
+
+    @abc.abstractmethod
+    def _read_floats(self) -> npt.NDArray[np.complex64]:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _read_doubles(self) -> npt.NDArray[np.complex128]:
+        raise NotImplementedError()
+
+    T = typing.TypeVar('T')
+    def _wrap_iterable(self, iterable: collections.abc.Iterable[T], final_state: int) -> collections.abc.Iterable[T]:
+        yield from iterable
+        self._state = final_state
+
+    def _raise_unexpected_state(self, actual: int) -> None:
+        actual_method = self._state_to_method_name(actual)
+        if self._state % 2 == 1:
+            previous_method = self._state_to_method_name(self._state - 1)
+            raise ProtocolError(f"Received call to '{actual_method}' but the iterable returned by '{previous_method}' was not fully consumed.")
+        else:
+            expected_method = self._state_to_method_name(self._state)
+            raise ProtocolError(f"Expected to call to '{expected_method}' but received call to '{actual_method}'.")
+        	
+    def _state_to_method_name(self, state: int) -> str:
+        if state == 0:
+            return 'read_floats'
+        if state == 2:
+            return 'read_doubles'
+        return "<unknown>"
+
+class MapsWriterBase(abc.ABC):
+    """Abstract writer for the Maps protocol."""
+
+
+    def __init__(self) -> None:
+        self._state = 0
+
+    schema = r"""{"protocol":{"name":"Maps","sequence":[{"name":"stringToInt","type":{"map":{"keys":"string","values":"int32"}}},{"name":"intToString","type":{"map":{"keys":"int32","values":"string"}}},{"name":"stringToUnion","type":{"map":{"keys":"string","values":[{"tag":"string","type":"string"},{"tag":"int32","type":"int32"}]}}},{"name":"aliasedGeneric","type":{"name":"BasicTypes.AliasedMap","typeArguments":["string","int32"]}},{"name":"records","type":{"vector":{"items":"TestModel.RecordWithMaps"}}}]},"types":[{"name":"AliasedMap","typeParameters":["K","V"],"type":{"map":{"keys":"K","values":"V"}}},{"name":"RecordWithMaps","fields":[{"name":"set1","type":{"map":{"keys":"uint32","values":"uint32"}}},{"name":"set2","type":{"map":{"keys":"int32","values":"bool"}}},{"name":"set3","type":{"map":{"keys":"string","values":[{"tag":"string","type":"string"},{"tag":"int32","type":"int32"}]}}}]}]}"""
+
+    def close(self) -> None:
+        self._close()
+        if self._state != 10:
+            expected_method = self._state_to_method_name((self._state + 1) & ~1)
+            raise ProtocolError(f"Protocol writer closed before all steps were called. Expected to call to '{expected_method}'.")
+
+    def __enter__(self):
+        return self
+
+    def __exit__(self, exc_type: typing.Optional[type[BaseException]], exc: typing.Optional[BaseException], traceback: object) -> None:
+        try:
+            self.close()
+        except Exception as e:
+            if exc is None:
+                raise e
+
+    def write_string_to_int(self, value: dict[str, yardl.Int32]) -> None:
+        """Ordinal 0"""
+
+        if self._state != 0:
+            self._raise_unexpected_state(0)
+
+        self._write_string_to_int(value)
+        self._state = 2
+
+    def write_int_to_string(self, value: dict[yardl.Int32, str]) -> None:
+        """Ordinal 1"""
+
+        if self._state != 2:
+            self._raise_unexpected_state(2)
+
+        self._write_int_to_string(value)
+        self._state = 4
+
+    def write_string_to_union(self, value: dict[str, StringOrInt32]) -> None:
+        """Ordinal 2"""
+
+        if self._state != 4:
+            self._raise_unexpected_state(4)
+
+        self._write_string_to_union(value)
+        self._state = 6
+
+    def write_aliased_generic(self, value: basic_types.AliasedMap[str, yardl.Int32]) -> None:
+        """Ordinal 3"""
+
+        if self._state != 6:
+            self._raise_unexpected_state(6)
+
+        self._write_aliased_generic(value)
+        self._state = 8
+
+    def write_records(self, value: list[RecordWithMaps]) -> None:
+        """Ordinal 4"""
+
+        if self._state != 8:
+            self._raise_unexpected_state(8)
+
+        self._write_records(value)
+        self._state = 10
+
+    @abc.abstractmethod
+    def _write_string_to_int(self, value: dict[str, yardl.Int32]) -> None:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _write_int_to_string(self, value: dict[yardl.Int32, str]) -> None:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _write_string_to_union(self, value: dict[str, StringOrInt32]) -> None:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _write_aliased_generic(self, value: basic_types.AliasedMap[str, yardl.Int32]) -> None:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _write_records(self, value: list[RecordWithMaps]) -> None:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _close(self) -> None:
+        pass
+
+    @abc.abstractmethod
+    def _end_stream(self) -> None:
+        pass
+
+    def _raise_unexpected_state(self, actual: int) -> None:
+        expected_method = self._state_to_method_name(self._state)
+        actual_method = self._state_to_method_name(actual)
+        raise ProtocolError(f"Expected to call to '{expected_method}' but received call to '{actual_method}'.")
+
+    def _state_to_method_name(self, state: int) -> str:
+        if state == 0:
+            return 'write_string_to_int'
+        if state == 2:
+            return 'write_int_to_string'
+        if state == 4:
+            return 'write_string_to_union'
+        if state == 6:
+            return 'write_aliased_generic'
+        if state == 8:
+            return 'write_records'
+        return "<unknown>"
+
+class MapsReaderBase(abc.ABC):
+    """Abstract reader for the Maps protocol."""
+
+
+    def __init__(self, skip_completed_check: bool = False) -> None:
+        self._skip_completed_check = skip_completed_check
+        self._state = 0
+
+    def close(self) -> None:
+        self._close()
+        if not self._skip_completed_check and self._state != 10:
+            if self._state % 2 == 1:
+                previous_method = self._state_to_method_name(self._state - 1)
+                raise ProtocolError(f"Protocol reader closed before all data was consumed. The iterable returned by '{previous_method}' was not fully consumed.")
+            else:
+                expected_method = self._state_to_method_name(self._state)
+                raise ProtocolError(f"Protocol reader closed before all data was consumed. Expected call to '{expected_method}'.")
+            	
+
+    schema = MapsWriterBase.schema
+
+    def __enter__(self):
+        return self
+
+    def __exit__(self, exc_type: typing.Optional[type[BaseException]], exc: typing.Optional[BaseException], traceback: object) -> None:
+        try:
+            self.close()
+        except Exception as e:
+            if exc is None:
+                raise e
+
+    @abc.abstractmethod
+    def _close(self) -> None:
+        raise NotImplementedError()
+
+    def read_string_to_int(self) -> dict[str, yardl.Int32]:
+        """Ordinal 0"""
+
+        if self._state != 0:
+            self._raise_unexpected_state(0)
+
+        value = self._read_string_to_int()
+        self._state = 2
+        return value
+
+    def read_int_to_string(self) -> dict[yardl.Int32, str]:
+        """Ordinal 1"""
+
+        if self._state != 2:
+            self._raise_unexpected_state(2)
+
+        value = self._read_int_to_string()
+        self._state = 4
+        return value
+
+    def read_string_to_union(self) -> dict[str, StringOrInt32]:
+        """Ordinal 2"""
+
+        if self._state != 4:
+            self._raise_unexpected_state(4)
+
+        value = self._read_string_to_union()
+        self._state = 6
+        return value
+
+    def read_aliased_generic(self) -> basic_types.AliasedMap[str, yardl.Int32]:
+        """Ordinal 3"""
+
+        if self._state != 6:
+            self._raise_unexpected_state(6)
+
+        value = self._read_aliased_generic()
+        self._state = 8
+        return value
+
+    def read_records(self) -> list[RecordWithMaps]:
+        """Ordinal 4"""
+
+        if self._state != 8:
+            self._raise_unexpected_state(8)
+
+        value = self._read_records()
+        self._state = 10
+        return value
+
+    def copy_to(self, writer: MapsWriterBase) -> None:
+        writer.write_string_to_int(self.read_string_to_int())
+        writer.write_int_to_string(self.read_int_to_string())
+        writer.write_string_to_union(self.read_string_to_union())
+        writer.write_aliased_generic(self.read_aliased_generic())
+        writer.write_records(self.read_records())
+
+    @abc.abstractmethod
+    def _read_string_to_int(self) -> dict[str, yardl.Int32]:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _read_int_to_string(self) -> dict[yardl.Int32, str]:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _read_string_to_union(self) -> dict[str, StringOrInt32]:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _read_aliased_generic(self) -> basic_types.AliasedMap[str, yardl.Int32]:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _read_records(self) -> list[RecordWithMaps]:
+        raise NotImplementedError()
+
+    T = typing.TypeVar('T')
+    def _wrap_iterable(self, iterable: collections.abc.Iterable[T], final_state: int) -> collections.abc.Iterable[T]:
+        yield from iterable
+        self._state = final_state
+
+    def _raise_unexpected_state(self, actual: int) -> None:
+        actual_method = self._state_to_method_name(actual)
+        if self._state % 2 == 1:
+            previous_method = self._state_to_method_name(self._state - 1)
+            raise ProtocolError(f"Received call to '{actual_method}' but the iterable returned by '{previous_method}' was not fully consumed.")
+        else:
+            expected_method = self._state_to_method_name(self._state)
+            raise ProtocolError(f"Expected to call to '{expected_method}' but received call to '{actual_method}'.")
+        	
+    def _state_to_method_name(self, state: int) -> str:
+        if state == 0:
+            return 'read_string_to_int'
+        if state == 2:
+            return 'read_int_to_string'
+        if state == 4:
+            return 'read_string_to_union'
+        if state == 6:
+            return 'read_aliased_generic'
+        if state == 8:
+            return 'read_records'
+        return "<unknown>"
+
+class UnionsWriterBase(abc.ABC):
+    """Abstract writer for the Unions protocol."""
+
+
+    def __init__(self) -> None:
+        self._state = 0
+
+    schema = r"""{"protocol":{"name":"Unions","sequence":[{"name":"intOrSimpleRecord","type":[{"tag":"int32","type":"int32"},{"tag":"SimpleRecord","type":"TestModel.SimpleRecord"}]},{"name":"intOrRecordWithVlens","type":[{"tag":"int32","type":"int32"},{"tag":"RecordWithVlens","type":"TestModel.RecordWithVlens"}]},{"name":"monosotateOrIntOrSimpleRecord","type":[null,{"tag":"int32","type":"int32"},{"tag":"SimpleRecord","type":"TestModel.SimpleRecord"}]},{"name":"vectorOfUnions","type":{"vector":{"items":[{"tag":"string","type":"string"},{"tag":"int32","type":"int32"}]}}},{"name":"recordWithUnions","type":"BasicTypes.RecordWithUnions"}]},"types":[{"name":"DaysOfWeek","values":[{"symbol":"monday","value":1},{"symbol":"tuesday","value":2},{"symbol":"wednesday","value":4},{"symbol":"thursday","value":8},{"symbol":"friday","value":16},{"symbol":"saturday","value":32},{"symbol":"sunday","value":64}]},{"name":"Fruits","values":[{"symbol":"apple","value":1},{"symbol":"banana","value":2},{"symbol":"pear","value":3}]},{"name":"GenericNullableUnion2","typeParameters":["T1","T2"],"type":[null,{"tag":"T1","type":"T1"},{"tag":"T2","type":"T2"}]},{"name":"RecordWithString","fields":[{"name":"i","type":"string"}]},{"name":"RecordWithUnions","fields":[{"name":"nullOrIntOrString","type":[null,{"tag":"int32","type":"int32"},{"tag":"string","type":"string"}]},{"name":"dateOrDatetime","type":[{"tag":"time","type":"time"},{"tag":"datetime","type":"datetime"}]},{"name":"nullOrFruitsOrDaysOfWeek","type":{"name":"BasicTypes.GenericNullableUnion2","typeArguments":["BasicTypes.Fruits","BasicTypes.DaysOfWeek"]}},{"name":"recordOrInt","type":[{"tag":"RecordWithString","type":"BasicTypes.RecordWithString"},{"tag":"int32","type":"int32"}]}]},{"name":"RecordWithVlens","fields":[{"name":"a","type":{"vector":{"items":"TestModel.SimpleRecord"}}},{"name":"b","type":"int32"},{"name":"c","type":"int32"}]},{"name":"SimpleRecord","fields":[{"name":"x","type":"int32"},{"name":"y","type":"int32"},{"name":"z","type":"int32"}]}]}"""
+
+    def close(self) -> None:
+        self._close()
+        if self._state != 10:
+            expected_method = self._state_to_method_name((self._state + 1) & ~1)
+            raise ProtocolError(f"Protocol writer closed before all steps were called. Expected to call to '{expected_method}'.")
+
+    def __enter__(self):
+        return self
+
+    def __exit__(self, exc_type: typing.Optional[type[BaseException]], exc: typing.Optional[BaseException], traceback: object) -> None:
+        try:
+            self.close()
+        except Exception as e:
+            if exc is None:
+                raise e
+
+    def write_int_or_simple_record(self, value: Int32OrSimpleRecord) -> None:
+        """Ordinal 0"""
+
+        if self._state != 0:
+            self._raise_unexpected_state(0)
+
+        self._write_int_or_simple_record(value)
+        self._state = 2
+
+    def write_int_or_record_with_vlens(self, value: Int32OrRecordWithVlens) -> None:
+        """Ordinal 1"""
+
+        if self._state != 2:
+            self._raise_unexpected_state(2)
+
+        self._write_int_or_record_with_vlens(value)
+        self._state = 4
+
+    def write_monosotate_or_int_or_simple_record(self, value: typing.Optional[Int32OrSimpleRecord]) -> None:
+        """Ordinal 2"""
+
+        if self._state != 4:
+            self._raise_unexpected_state(4)
+
+        self._write_monosotate_or_int_or_simple_record(value)
+        self._state = 6
+
+    def write_vector_of_unions(self, value: list[StringOrInt32]) -> None:
+        """Ordinal 3"""
+
+        if self._state != 6:
+            self._raise_unexpected_state(6)
+
+        self._write_vector_of_unions(value)
+        self._state = 8
+
+    def write_record_with_unions(self, value: basic_types.RecordWithUnions) -> None:
+        """Ordinal 4"""
+
+        if self._state != 8:
+            self._raise_unexpected_state(8)
+
+        self._write_record_with_unions(value)
+        self._state = 10
+
+    @abc.abstractmethod
+    def _write_int_or_simple_record(self, value: Int32OrSimpleRecord) -> None:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _write_int_or_record_with_vlens(self, value: Int32OrRecordWithVlens) -> None:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _write_monosotate_or_int_or_simple_record(self, value: typing.Optional[Int32OrSimpleRecord]) -> None:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _write_vector_of_unions(self, value: list[StringOrInt32]) -> None:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _write_record_with_unions(self, value: basic_types.RecordWithUnions) -> None:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _close(self) -> None:
+        pass
+
+    @abc.abstractmethod
+    def _end_stream(self) -> None:
+        pass
+
+    def _raise_unexpected_state(self, actual: int) -> None:
+        expected_method = self._state_to_method_name(self._state)
+        actual_method = self._state_to_method_name(actual)
+        raise ProtocolError(f"Expected to call to '{expected_method}' but received call to '{actual_method}'.")
+
+    def _state_to_method_name(self, state: int) -> str:
+        if state == 0:
+            return 'write_int_or_simple_record'
+        if state == 2:
+            return 'write_int_or_record_with_vlens'
+        if state == 4:
+            return 'write_monosotate_or_int_or_simple_record'
+        if state == 6:
+            return 'write_vector_of_unions'
+        if state == 8:
+            return 'write_record_with_unions'
+        return "<unknown>"
+
+class UnionsReaderBase(abc.ABC):
+    """Abstract reader for the Unions protocol."""
+
+
+    def __init__(self, skip_completed_check: bool = False) -> None:
+        self._skip_completed_check = skip_completed_check
+        self._state = 0
+
+    def close(self) -> None:
+        self._close()
+        if not self._skip_completed_check and self._state != 10:
+            if self._state % 2 == 1:
+                previous_method = self._state_to_method_name(self._state - 1)
+                raise ProtocolError(f"Protocol reader closed before all data was consumed. The iterable returned by '{previous_method}' was not fully consumed.")
+            else:
+                expected_method = self._state_to_method_name(self._state)
+                raise ProtocolError(f"Protocol reader closed before all data was consumed. Expected call to '{expected_method}'.")
+            	
+
+    schema = UnionsWriterBase.schema
+
+    def __enter__(self):
+        return self
+
+    def __exit__(self, exc_type: typing.Optional[type[BaseException]], exc: typing.Optional[BaseException], traceback: object) -> None:
+        try:
+            self.close()
+        except Exception as e:
+            if exc is None:
+                raise e
+
+    @abc.abstractmethod
+    def _close(self) -> None:
+        raise NotImplementedError()
+
+    def read_int_or_simple_record(self) -> Int32OrSimpleRecord:
+        """Ordinal 0"""
+
+        if self._state != 0:
+            self._raise_unexpected_state(0)
+
+        value = self._read_int_or_simple_record()
+        self._state = 2
+        return value
+
+    def read_int_or_record_with_vlens(self) -> Int32OrRecordWithVlens:
+        """Ordinal 1"""
+
+        if self._state != 2:
+            self._raise_unexpected_state(2)
+
+        value = self._read_int_or_record_with_vlens()
+        self._state = 4
+        return value
+
+    def read_monosotate_or_int_or_simple_record(self) -> typing.Optional[Int32OrSimpleRecord]:
+        """Ordinal 2"""
+
+        if self._state != 4:
+            self._raise_unexpected_state(4)
+
+        value = self._read_monosotate_or_int_or_simple_record()
+        self._state = 6
+        return value
+
+    def read_vector_of_unions(self) -> list[StringOrInt32]:
+        """Ordinal 3"""
+
+        if self._state != 6:
+            self._raise_unexpected_state(6)
+
+        value = self._read_vector_of_unions()
+        self._state = 8
+        return value
+
+    def read_record_with_unions(self) -> basic_types.RecordWithUnions:
+        """Ordinal 4"""
+
+        if self._state != 8:
+            self._raise_unexpected_state(8)
+
+        value = self._read_record_with_unions()
+        self._state = 10
+        return value
+
+    def copy_to(self, writer: UnionsWriterBase) -> None:
+        writer.write_int_or_simple_record(self.read_int_or_simple_record())
+        writer.write_int_or_record_with_vlens(self.read_int_or_record_with_vlens())
+        writer.write_monosotate_or_int_or_simple_record(self.read_monosotate_or_int_or_simple_record())
+        writer.write_vector_of_unions(self.read_vector_of_unions())
+        writer.write_record_with_unions(self.read_record_with_unions())
+
+    @abc.abstractmethod
+    def _read_int_or_simple_record(self) -> Int32OrSimpleRecord:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _read_int_or_record_with_vlens(self) -> Int32OrRecordWithVlens:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _read_monosotate_or_int_or_simple_record(self) -> typing.Optional[Int32OrSimpleRecord]:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _read_vector_of_unions(self) -> list[StringOrInt32]:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _read_record_with_unions(self) -> basic_types.RecordWithUnions:
+        raise NotImplementedError()
+
+    T = typing.TypeVar('T')
+    def _wrap_iterable(self, iterable: collections.abc.Iterable[T], final_state: int) -> collections.abc.Iterable[T]:
+        yield from iterable
+        self._state = final_state
+
+    def _raise_unexpected_state(self, actual: int) -> None:
+        actual_method = self._state_to_method_name(actual)
+        if self._state % 2 == 1:
+            previous_method = self._state_to_method_name(self._state - 1)
+            raise ProtocolError(f"Received call to '{actual_method}' but the iterable returned by '{previous_method}' was not fully consumed.")
+        else:
+            expected_method = self._state_to_method_name(self._state)
+            raise ProtocolError(f"Expected to call to '{expected_method}' but received call to '{actual_method}'.")
+        	
+    def _state_to_method_name(self, state: int) -> str:
+        if state == 0:
+            return 'read_int_or_simple_record'
+        if state == 2:
+            return 'read_int_or_record_with_vlens'
+        if state == 4:
+            return 'read_monosotate_or_int_or_simple_record'
+        if state == 6:
+            return 'read_vector_of_unions'
+        if state == 8:
+            return 'read_record_with_unions'
+        return "<unknown>"
+
+class StreamsOfUnionsWriterBase(abc.ABC):
+    """Abstract writer for the StreamsOfUnions protocol."""
+
+
+    def __init__(self) -> None:
+        self._state = 0
+
+    schema = r"""{"protocol":{"name":"StreamsOfUnions","sequence":[{"name":"intOrSimpleRecord","type":{"stream":{"items":[{"tag":"int32","type":"int32"},{"tag":"SimpleRecord","type":"TestModel.SimpleRecord"}]}}},{"name":"nullableIntOrSimpleRecord","type":{"stream":{"items":[null,{"tag":"int32","type":"int32"},{"tag":"SimpleRecord","type":"TestModel.SimpleRecord"}]}}},{"name":"manyCases","type":{"stream":{"items":[{"tag":"int32","type":"int32"},{"tag":"float32","type":"float32"},{"tag":"string","type":"string"},{"tag":"SimpleRecord","type":"TestModel.SimpleRecord"},{"tag":"NamedFixedNDArray","type":"TestModel.NamedFixedNDArray"}]}}}]},"types":[{"name":"NamedFixedNDArray","type":{"array":{"items":"int32","dimensions":[{"name":"dimA","length":2},{"name":"dimB","length":4}]}}},{"name":"SimpleRecord","fields":[{"name":"x","type":"int32"},{"name":"y","type":"int32"},{"name":"z","type":"int32"}]}]}"""
+
+    def close(self) -> None:
+        if self._state == 5:
+            try:
+                self._end_stream()
+                return
+            finally:
+                self._close()
+        self._close()
+        if self._state != 6:
+            expected_method = self._state_to_method_name((self._state + 1) & ~1)
+            raise ProtocolError(f"Protocol writer closed before all steps were called. Expected to call to '{expected_method}'.")
+
+    def __enter__(self):
+        return self
+
+    def __exit__(self, exc_type: typing.Optional[type[BaseException]], exc: typing.Optional[BaseException], traceback: object) -> None:
+        try:
+            self.close()
+        except Exception as e:
+            if exc is None:
+                raise e
+
+    def write_int_or_simple_record(self, value: collections.abc.Iterable[Int32OrSimpleRecord]) -> None:
+        """Ordinal 0"""
+
+        if self._state & ~1 != 0:
+            self._raise_unexpected_state(0)
+
+        self._write_int_or_simple_record(value)
+        self._state = 1
+
+    def write_nullable_int_or_simple_record(self, value: collections.abc.Iterable[typing.Optional[Int32OrSimpleRecord]]) -> None:
+        """Ordinal 1"""
+
+        if self._state == 1:
+            self._end_stream()
+            self._state = 2
+        elif self._state & ~1 != 2:
+            self._raise_unexpected_state(2)
+
+        self._write_nullable_int_or_simple_record(value)
+        self._state = 3
+
+    def write_many_cases(self, value: collections.abc.Iterable[Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray]) -> None:
+        """Ordinal 2"""
+
+        if self._state == 3:
+            self._end_stream()
+            self._state = 4
+        elif self._state & ~1 != 4:
+            self._raise_unexpected_state(4)
+
+        self._write_many_cases(value)
+        self._state = 5
+
+    @abc.abstractmethod
+    def _write_int_or_simple_record(self, value: collections.abc.Iterable[Int32OrSimpleRecord]) -> None:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _write_nullable_int_or_simple_record(self, value: collections.abc.Iterable[typing.Optional[Int32OrSimpleRecord]]) -> None:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _write_many_cases(self, value: collections.abc.Iterable[Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray]) -> None:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _close(self) -> None:
+        pass
+
+    @abc.abstractmethod
+    def _end_stream(self) -> None:
+        pass
+
+    def _raise_unexpected_state(self, actual: int) -> None:
+        expected_method = self._state_to_method_name(self._state)
+        actual_method = self._state_to_method_name(actual)
+        raise ProtocolError(f"Expected to call to '{expected_method}' but received call to '{actual_method}'.")
+
+    def _state_to_method_name(self, state: int) -> str:
+        if state == 0:
+            return 'write_int_or_simple_record'
+        if state == 2:
+            return 'write_nullable_int_or_simple_record'
+        if state == 4:
+            return 'write_many_cases'
+        return "<unknown>"
+
+class StreamsOfUnionsReaderBase(abc.ABC):
+    """Abstract reader for the StreamsOfUnions protocol."""
+
+
+    def __init__(self, skip_completed_check: bool = False) -> None:
+        self._skip_completed_check = skip_completed_check
+        self._state = 0
+
+    def close(self) -> None:
+        self._close()
+        if not self._skip_completed_check and self._state != 6:
+            if self._state % 2 == 1:
+                previous_method = self._state_to_method_name(self._state - 1)
+                raise ProtocolError(f"Protocol reader closed before all data was consumed. The iterable returned by '{previous_method}' was not fully consumed.")
+            else:
+                expected_method = self._state_to_method_name(self._state)
+                raise ProtocolError(f"Protocol reader closed before all data was consumed. Expected call to '{expected_method}'.")
+            	
+
+    schema = StreamsOfUnionsWriterBase.schema
+
+    def __enter__(self):
+        return self
+
+    def __exit__(self, exc_type: typing.Optional[type[BaseException]], exc: typing.Optional[BaseException], traceback: object) -> None:
+        try:
+            self.close()
+        except Exception as e:
+            if exc is None:
+                raise e
+
+    @abc.abstractmethod
+    def _close(self) -> None:
+        raise NotImplementedError()
+
+    def read_int_or_simple_record(self) -> collections.abc.Iterable[Int32OrSimpleRecord]:
+        """Ordinal 0"""
+
+        if self._state != 0:
+            self._raise_unexpected_state(0)
+
+        value = self._read_int_or_simple_record()
+        self._state = 1
+        return self._wrap_iterable(value, 2)
+
+    def read_nullable_int_or_simple_record(self) -> collections.abc.Iterable[typing.Optional[Int32OrSimpleRecord]]:
+        """Ordinal 1"""
+
+        if self._state != 2:
+            self._raise_unexpected_state(2)
+
+        value = self._read_nullable_int_or_simple_record()
+        self._state = 3
+        return self._wrap_iterable(value, 4)
+
+    def read_many_cases(self) -> collections.abc.Iterable[Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray]:
+        """Ordinal 2"""
+
+        if self._state != 4:
+            self._raise_unexpected_state(4)
+
+        value = self._read_many_cases()
+        self._state = 5
+        return self._wrap_iterable(value, 6)
+
+    def copy_to(self, writer: StreamsOfUnionsWriterBase) -> None:
+        writer.write_int_or_simple_record(self.read_int_or_simple_record())
+        writer.write_nullable_int_or_simple_record(self.read_nullable_int_or_simple_record())
+        writer.write_many_cases(self.read_many_cases())
+
+    @abc.abstractmethod
+    def _read_int_or_simple_record(self) -> collections.abc.Iterable[Int32OrSimpleRecord]:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _read_nullable_int_or_simple_record(self) -> collections.abc.Iterable[typing.Optional[Int32OrSimpleRecord]]:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _read_many_cases(self) -> collections.abc.Iterable[Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray]:
+        raise NotImplementedError()
+
+    T = typing.TypeVar('T')
+    def _wrap_iterable(self, iterable: collections.abc.Iterable[T], final_state: int) -> collections.abc.Iterable[T]:
+        yield from iterable
+        self._state = final_state
+
+    def _raise_unexpected_state(self, actual: int) -> None:
+        actual_method = self._state_to_method_name(actual)
+        if self._state % 2 == 1:
+            previous_method = self._state_to_method_name(self._state - 1)
+            raise ProtocolError(f"Received call to '{actual_method}' but the iterable returned by '{previous_method}' was not fully consumed.")
+        else:
+            expected_method = self._state_to_method_name(self._state)
+            raise ProtocolError(f"Expected to call to '{expected_method}' but received call to '{actual_method}'.")
+        	
+    def _state_to_method_name(self, state: int) -> str:
+        if state == 0:
+            return 'read_int_or_simple_record'
+        if state == 2:
+            return 'read_nullable_int_or_simple_record'
+        if state == 4:
+            return 'read_many_cases'
+        return "<unknown>"
+
+class EnumsWriterBase(abc.ABC):
+    """Abstract writer for the Enums protocol."""
+
+
+    def __init__(self) -> None:
+        self._state = 0
+
+    schema = r"""{"protocol":{"name":"Enums","sequence":[{"name":"single","type":"TestModel.Fruits"},{"name":"vec","type":{"vector":{"items":"TestModel.Fruits"}}},{"name":"size","type":"TestModel.SizeBasedEnum"},{"name":"rec","type":"TestModel.RecordWithEnums"}]},"types":[{"name":"DaysOfWeek","values":[{"symbol":"monday","value":1},{"symbol":"tuesday","value":2},{"symbol":"wednesday","value":4},{"symbol":"thursday","value":8},{"symbol":"friday","value":16},{"symbol":"saturday","value":32},{"symbol":"sunday","value":64}]},{"name":"Fruits","values":[{"symbol":"apple","value":1},{"symbol":"banana","value":2},{"symbol":"pear","value":3}]},{"name":"TextFormat","base":"uint64","values":[{"symbol":"regular","value":0},{"symbol":"bold","value":1},{"symbol":"italic","value":2},{"symbol":"underline","value":4},{"symbol":"strikethrough","value":8}]},{"name":"DaysOfWeek","type":"BasicTypes.DaysOfWeek"},{"name":"Fruits","type":"BasicTypes.Fruits"},{"name":"RecordWithEnums","fields":[{"name":"enum","type":"TestModel.Fruits"},{"name":"flags","type":"TestModel.DaysOfWeek"},{"name":"flags2","type":"TestModel.TextFormat"},{"name":"rec","type":"TestModel.RecordWithNoDefaultEnum"}]},{"name":"RecordWithNoDefaultEnum","fields":[{"name":"enum","type":"TestModel.Fruits"}]},{"name":"SizeBasedEnum","base":"size","values":[{"symbol":"a","value":0},{"symbol":"b","value":1},{"symbol":"c","value":2}]},{"name":"TextFormat","type":"BasicTypes.TextFormat"}]}"""
+
+    def close(self) -> None:
+        self._close()
+        if self._state != 8:
+            expected_method = self._state_to_method_name((self._state + 1) & ~1)
+            raise ProtocolError(f"Protocol writer closed before all steps were called. Expected to call to '{expected_method}'.")
+
+    def __enter__(self):
+        return self
+
+    def __exit__(self, exc_type: typing.Optional[type[BaseException]], exc: typing.Optional[BaseException], traceback: object) -> None:
+        try:
+            self.close()
+        except Exception as e:
+            if exc is None:
+                raise e
+
+    def write_single(self, value: Fruits) -> None:
+        """Ordinal 0"""
+
+        if self._state != 0:
+            self._raise_unexpected_state(0)
+
+        self._write_single(value)
+        self._state = 2
+
+    def write_vec(self, value: list[Fruits]) -> None:
+        """Ordinal 1"""
+
+        if self._state != 2:
+            self._raise_unexpected_state(2)
+
+        self._write_vec(value)
+        self._state = 4
+
+    def write_size(self, value: SizeBasedEnum) -> None:
+        """Ordinal 2"""
+
+        if self._state != 4:
+            self._raise_unexpected_state(4)
+
+        self._write_size(value)
+        self._state = 6
+
+    def write_rec(self, value: RecordWithEnums) -> None:
+        """Ordinal 3"""
+
+        if self._state != 6:
+            self._raise_unexpected_state(6)
+
+        self._write_rec(value)
+        self._state = 8
+
+    @abc.abstractmethod
+    def _write_single(self, value: Fruits) -> None:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _write_vec(self, value: list[Fruits]) -> None:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _write_size(self, value: SizeBasedEnum) -> None:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _write_rec(self, value: RecordWithEnums) -> None:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _close(self) -> None:
+        pass
+
+    @abc.abstractmethod
+    def _end_stream(self) -> None:
+        pass
+
+    def _raise_unexpected_state(self, actual: int) -> None:
+        expected_method = self._state_to_method_name(self._state)
+        actual_method = self._state_to_method_name(actual)
+        raise ProtocolError(f"Expected to call to '{expected_method}' but received call to '{actual_method}'.")
+
+    def _state_to_method_name(self, state: int) -> str:
+        if state == 0:
+            return 'write_single'
+        if state == 2:
+            return 'write_vec'
+        if state == 4:
+            return 'write_size'
+        if state == 6:
+            return 'write_rec'
+        return "<unknown>"
+
+class EnumsReaderBase(abc.ABC):
+    """Abstract reader for the Enums protocol."""
+
+
+    def __init__(self, skip_completed_check: bool = False) -> None:
+        self._skip_completed_check = skip_completed_check
+        self._state = 0
+
+    def close(self) -> None:
+        self._close()
+        if not self._skip_completed_check and self._state != 8:
+            if self._state % 2 == 1:
+                previous_method = self._state_to_method_name(self._state - 1)
+                raise ProtocolError(f"Protocol reader closed before all data was consumed. The iterable returned by '{previous_method}' was not fully consumed.")
+            else:
+                expected_method = self._state_to_method_name(self._state)
+                raise ProtocolError(f"Protocol reader closed before all data was consumed. Expected call to '{expected_method}'.")
+            	
+
+    schema = EnumsWriterBase.schema
+
+    def __enter__(self):
+        return self
+
+    def __exit__(self, exc_type: typing.Optional[type[BaseException]], exc: typing.Optional[BaseException], traceback: object) -> None:
+        try:
+            self.close()
+        except Exception as e:
+            if exc is None:
+                raise e
+
+    @abc.abstractmethod
+    def _close(self) -> None:
+        raise NotImplementedError()
+
+    def read_single(self) -> Fruits:
+        """Ordinal 0"""
+
+        if self._state != 0:
+            self._raise_unexpected_state(0)
+
+        value = self._read_single()
+        self._state = 2
+        return value
+
+    def read_vec(self) -> list[Fruits]:
+        """Ordinal 1"""
+
+        if self._state != 2:
+            self._raise_unexpected_state(2)
+
+        value = self._read_vec()
+        self._state = 4
+        return value
+
+    def read_size(self) -> SizeBasedEnum:
+        """Ordinal 2"""
+
+        if self._state != 4:
+            self._raise_unexpected_state(4)
+
+        value = self._read_size()
+        self._state = 6
+        return value
+
+    def read_rec(self) -> RecordWithEnums:
+        """Ordinal 3"""
+
+        if self._state != 6:
+            self._raise_unexpected_state(6)
+
+        value = self._read_rec()
+        self._state = 8
+        return value
+
+    def copy_to(self, writer: EnumsWriterBase) -> None:
+        writer.write_single(self.read_single())
+        writer.write_vec(self.read_vec())
+        writer.write_size(self.read_size())
+        writer.write_rec(self.read_rec())
+
+    @abc.abstractmethod
+    def _read_single(self) -> Fruits:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _read_vec(self) -> list[Fruits]:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _read_size(self) -> SizeBasedEnum:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _read_rec(self) -> RecordWithEnums:
+        raise NotImplementedError()
+
+    T = typing.TypeVar('T')
+    def _wrap_iterable(self, iterable: collections.abc.Iterable[T], final_state: int) -> collections.abc.Iterable[T]:
+        yield from iterable
+        self._state = final_state
+
+    def _raise_unexpected_state(self, actual: int) -> None:
+        actual_method = self._state_to_method_name(actual)
+        if self._state % 2 == 1:
+            previous_method = self._state_to_method_name(self._state - 1)
+            raise ProtocolError(f"Received call to '{actual_method}' but the iterable returned by '{previous_method}' was not fully consumed.")
+        else:
+            expected_method = self._state_to_method_name(self._state)
+            raise ProtocolError(f"Expected to call to '{expected_method}' but received call to '{actual_method}'.")
+        	
+    def _state_to_method_name(self, state: int) -> str:
+        if state == 0:
+            return 'read_single'
+        if state == 2:
+            return 'read_vec'
+        if state == 4:
+            return 'read_size'
+        if state == 6:
+            return 'read_rec'
+        return "<unknown>"
+
+class FlagsWriterBase(abc.ABC):
+    """Abstract writer for the Flags protocol."""
+
+
+    def __init__(self) -> None:
+        self._state = 0
+
+    schema = r"""{"protocol":{"name":"Flags","sequence":[{"name":"days","type":{"stream":{"items":"TestModel.DaysOfWeek"}}},{"name":"formats","type":{"stream":{"items":"TestModel.TextFormat"}}}]},"types":[{"name":"DaysOfWeek","values":[{"symbol":"monday","value":1},{"symbol":"tuesday","value":2},{"symbol":"wednesday","value":4},{"symbol":"thursday","value":8},{"symbol":"friday","value":16},{"symbol":"saturday","value":32},{"symbol":"sunday","value":64}]},{"name":"TextFormat","base":"uint64","values":[{"symbol":"regular","value":0},{"symbol":"bold","value":1},{"symbol":"italic","value":2},{"symbol":"underline","value":4},{"symbol":"strikethrough","value":8}]},{"name":"DaysOfWeek","type":"BasicTypes.DaysOfWeek"},{"name":"TextFormat","type":"BasicTypes.TextFormat"}]}"""
+
+    def close(self) -> None:
+        if self._state == 3:
+            try:
+                self._end_stream()
+                return
+            finally:
+                self._close()
+        self._close()
+        if self._state != 4:
+            expected_method = self._state_to_method_name((self._state + 1) & ~1)
+            raise ProtocolError(f"Protocol writer closed before all steps were called. Expected to call to '{expected_method}'.")
+
+    def __enter__(self):
+        return self
+
+    def __exit__(self, exc_type: typing.Optional[type[BaseException]], exc: typing.Optional[BaseException], traceback: object) -> None:
+        try:
+            self.close()
+        except Exception as e:
+            if exc is None:
+                raise e
+
+    def write_days(self, value: collections.abc.Iterable[DaysOfWeek]) -> None:
+        """Ordinal 0"""
+
+        if self._state & ~1 != 0:
+            self._raise_unexpected_state(0)
+
+        self._write_days(value)
+        self._state = 1
+
+    def write_formats(self, value: collections.abc.Iterable[TextFormat]) -> None:
+        """Ordinal 1"""
+
+        if self._state == 1:
+            self._end_stream()
+            self._state = 2
+        elif self._state & ~1 != 2:
+            self._raise_unexpected_state(2)
+
+        self._write_formats(value)
+        self._state = 3
+
+    @abc.abstractmethod
+    def _write_days(self, value: collections.abc.Iterable[DaysOfWeek]) -> None:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _write_formats(self, value: collections.abc.Iterable[TextFormat]) -> None:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _close(self) -> None:
+        pass
+
+    @abc.abstractmethod
+    def _end_stream(self) -> None:
+        pass
+
+    def _raise_unexpected_state(self, actual: int) -> None:
+        expected_method = self._state_to_method_name(self._state)
+        actual_method = self._state_to_method_name(actual)
+        raise ProtocolError(f"Expected to call to '{expected_method}' but received call to '{actual_method}'.")
+
+    def _state_to_method_name(self, state: int) -> str:
+        if state == 0:
+            return 'write_days'
+        if state == 2:
+            return 'write_formats'
+        return "<unknown>"
+
+class FlagsReaderBase(abc.ABC):
+    """Abstract reader for the Flags protocol."""
+
+
+    def __init__(self, skip_completed_check: bool = False) -> None:
+        self._skip_completed_check = skip_completed_check
+        self._state = 0
+
+    def close(self) -> None:
+        self._close()
+        if not self._skip_completed_check and self._state != 4:
+            if self._state % 2 == 1:
+                previous_method = self._state_to_method_name(self._state - 1)
+                raise ProtocolError(f"Protocol reader closed before all data was consumed. The iterable returned by '{previous_method}' was not fully consumed.")
+            else:
+                expected_method = self._state_to_method_name(self._state)
+                raise ProtocolError(f"Protocol reader closed before all data was consumed. Expected call to '{expected_method}'.")
+            	
+
+    schema = FlagsWriterBase.schema
+
+    def __enter__(self):
+        return self
+
+    def __exit__(self, exc_type: typing.Optional[type[BaseException]], exc: typing.Optional[BaseException], traceback: object) -> None:
+        try:
+            self.close()
+        except Exception as e:
+            if exc is None:
+                raise e
+
+    @abc.abstractmethod
+    def _close(self) -> None:
+        raise NotImplementedError()
+
+    def read_days(self) -> collections.abc.Iterable[DaysOfWeek]:
+        """Ordinal 0"""
+
+        if self._state != 0:
+            self._raise_unexpected_state(0)
+
+        value = self._read_days()
+        self._state = 1
+        return self._wrap_iterable(value, 2)
+
+    def read_formats(self) -> collections.abc.Iterable[TextFormat]:
+        """Ordinal 1"""
+
+        if self._state != 2:
+            self._raise_unexpected_state(2)
+
+        value = self._read_formats()
+        self._state = 3
+        return self._wrap_iterable(value, 4)
+
+    def copy_to(self, writer: FlagsWriterBase) -> None:
+        writer.write_days(self.read_days())
+        writer.write_formats(self.read_formats())
+
+    @abc.abstractmethod
+    def _read_days(self) -> collections.abc.Iterable[DaysOfWeek]:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _read_formats(self) -> collections.abc.Iterable[TextFormat]:
+        raise NotImplementedError()
+
+    T = typing.TypeVar('T')
+    def _wrap_iterable(self, iterable: collections.abc.Iterable[T], final_state: int) -> collections.abc.Iterable[T]:
+        yield from iterable
+        self._state = final_state
+
+    def _raise_unexpected_state(self, actual: int) -> None:
+        actual_method = self._state_to_method_name(actual)
+        if self._state % 2 == 1:
+            previous_method = self._state_to_method_name(self._state - 1)
+            raise ProtocolError(f"Received call to '{actual_method}' but the iterable returned by '{previous_method}' was not fully consumed.")
+        else:
+            expected_method = self._state_to_method_name(self._state)
+            raise ProtocolError(f"Expected to call to '{expected_method}' but received call to '{actual_method}'.")
+        	
+    def _state_to_method_name(self, state: int) -> str:
+        if state == 0:
+            return 'read_days'
+        if state == 2:
+            return 'read_formats'
+        return "<unknown>"
+
+class StateTestWriterBase(abc.ABC):
+    """Abstract writer for the StateTest protocol."""
+
+
+    def __init__(self) -> None:
+        self._state = 0
+
+    schema = r"""{"protocol":{"name":"StateTest","sequence":[{"name":"anInt","type":"int32"},{"name":"aStream","type":{"stream":{"items":"int32"}}},{"name":"anotherInt","type":"int32"}]},"types":null}"""
+
+    def close(self) -> None:
+        self._close()
+        if self._state != 6:
+            expected_method = self._state_to_method_name((self._state + 1) & ~1)
+            raise ProtocolError(f"Protocol writer closed before all steps were called. Expected to call to '{expected_method}'.")
+
+    def __enter__(self):
+        return self
+
+    def __exit__(self, exc_type: typing.Optional[type[BaseException]], exc: typing.Optional[BaseException], traceback: object) -> None:
+        try:
+            self.close()
+        except Exception as e:
+            if exc is None:
+                raise e
+
+    def write_an_int(self, value: yardl.Int32) -> None:
+        """Ordinal 0"""
+
+        if self._state != 0:
+            self._raise_unexpected_state(0)
+
+        self._write_an_int(value)
+        self._state = 2
+
+    def write_a_stream(self, value: collections.abc.Iterable[yardl.Int32]) -> None:
+        """Ordinal 1"""
+
+        if self._state & ~1 != 2:
+            self._raise_unexpected_state(2)
+
+        self._write_a_stream(value)
+        self._state = 3
+
+    def write_another_int(self, value: yardl.Int32) -> None:
+        """Ordinal 2"""
+
+        if self._state == 3:
+            self._end_stream()
+            self._state = 4
+        elif self._state != 4:
+            self._raise_unexpected_state(4)
+
+        self._write_another_int(value)
+        self._state = 6
+
+    @abc.abstractmethod
+    def _write_an_int(self, value: yardl.Int32) -> None:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _write_a_stream(self, value: collections.abc.Iterable[yardl.Int32]) -> None:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _write_another_int(self, value: yardl.Int32) -> None:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _close(self) -> None:
+        pass
+
+    @abc.abstractmethod
+    def _end_stream(self) -> None:
+        pass
+
+    def _raise_unexpected_state(self, actual: int) -> None:
+        expected_method = self._state_to_method_name(self._state)
+        actual_method = self._state_to_method_name(actual)
+        raise ProtocolError(f"Expected to call to '{expected_method}' but received call to '{actual_method}'.")
+
+    def _state_to_method_name(self, state: int) -> str:
+        if state == 0:
+            return 'write_an_int'
+        if state == 2:
+            return 'write_a_stream'
+        if state == 4:
+            return 'write_another_int'
+        return "<unknown>"
+
+class StateTestReaderBase(abc.ABC):
+    """Abstract reader for the StateTest protocol."""
+
+
+    def __init__(self, skip_completed_check: bool = False) -> None:
+        self._skip_completed_check = skip_completed_check
+        self._state = 0
+
+    def close(self) -> None:
+        self._close()
+        if not self._skip_completed_check and self._state != 6:
+            if self._state % 2 == 1:
+                previous_method = self._state_to_method_name(self._state - 1)
+                raise ProtocolError(f"Protocol reader closed before all data was consumed. The iterable returned by '{previous_method}' was not fully consumed.")
+            else:
+                expected_method = self._state_to_method_name(self._state)
+                raise ProtocolError(f"Protocol reader closed before all data was consumed. Expected call to '{expected_method}'.")
+            	
+
+    schema = StateTestWriterBase.schema
+
+    def __enter__(self):
+        return self
+
+    def __exit__(self, exc_type: typing.Optional[type[BaseException]], exc: typing.Optional[BaseException], traceback: object) -> None:
+        try:
+            self.close()
+        except Exception as e:
+            if exc is None:
+                raise e
+
+    @abc.abstractmethod
+    def _close(self) -> None:
+        raise NotImplementedError()
+
+    def read_an_int(self) -> yardl.Int32:
+        """Ordinal 0"""
+
+        if self._state != 0:
+            self._raise_unexpected_state(0)
+
+        value = self._read_an_int()
+        self._state = 2
+        return value
+
+    def read_a_stream(self) -> collections.abc.Iterable[yardl.Int32]:
+        """Ordinal 1"""
+
+        if self._state != 2:
+            self._raise_unexpected_state(2)
+
+        value = self._read_a_stream()
+        self._state = 3
+        return self._wrap_iterable(value, 4)
+
+    def read_another_int(self) -> yardl.Int32:
+        """Ordinal 2"""
+
+        if self._state != 4:
+            self._raise_unexpected_state(4)
+
+        value = self._read_another_int()
+        self._state = 6
+        return value
+
+    def copy_to(self, writer: StateTestWriterBase) -> None:
+        writer.write_an_int(self.read_an_int())
+        writer.write_a_stream(self.read_a_stream())
+        writer.write_another_int(self.read_another_int())
+
+    @abc.abstractmethod
+    def _read_an_int(self) -> yardl.Int32:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _read_a_stream(self) -> collections.abc.Iterable[yardl.Int32]:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _read_another_int(self) -> yardl.Int32:
+        raise NotImplementedError()
+
+    T = typing.TypeVar('T')
+    def _wrap_iterable(self, iterable: collections.abc.Iterable[T], final_state: int) -> collections.abc.Iterable[T]:
+        yield from iterable
+        self._state = final_state
+
+    def _raise_unexpected_state(self, actual: int) -> None:
+        actual_method = self._state_to_method_name(actual)
+        if self._state % 2 == 1:
+            previous_method = self._state_to_method_name(self._state - 1)
+            raise ProtocolError(f"Received call to '{actual_method}' but the iterable returned by '{previous_method}' was not fully consumed.")
+        else:
+            expected_method = self._state_to_method_name(self._state)
+            raise ProtocolError(f"Expected to call to '{expected_method}' but received call to '{actual_method}'.")
+        	
+    def _state_to_method_name(self, state: int) -> str:
+        if state == 0:
+            return 'read_an_int'
+        if state == 2:
+            return 'read_a_stream'
+        if state == 4:
+            return 'read_another_int'
+        return "<unknown>"
+
+class SimpleGenericsWriterBase(abc.ABC):
+    """Abstract writer for the SimpleGenerics protocol."""
+
+
+    def __init__(self) -> None:
+        self._state = 0
+
+    schema = r"""{"protocol":{"name":"SimpleGenerics","sequence":[{"name":"floatImage","type":"Image.FloatImage"},{"name":"intImage","type":"Image.IntImage"},{"name":"intImageAlternateSyntax","type":{"name":"TestModel.Image","typeArguments":["int32"]}},{"name":"stringImage","type":{"name":"TestModel.Image","typeArguments":["string"]}},{"name":"intFloatTuple","type":{"name":"Tuples.Tuple","typeArguments":["int32","float32"]}},{"name":"floatFloatTuple","type":{"name":"Tuples.Tuple","typeArguments":["float32","float32"]}},{"name":"intFloatTupleAlternateSyntax","type":{"name":"Tuples.Tuple","typeArguments":["int32","float32"]}},{"name":"intStringTuple","type":{"name":"Tuples.Tuple","typeArguments":["int32","string"]}},{"name":"streamOfTypeVariants","type":{"stream":{"items":[{"tag":"imageFloat","explicitTag":true,"type":"Image.FloatImage"},{"tag":"imageDouble","explicitTag":true,"type":{"name":"TestModel.Image","typeArguments":["float64"]}}]}}}]},"types":[{"name":"FloatImage","type":{"name":"Image.Image","typeArguments":["float32"]}},{"name":"Image","typeParameters":["T"],"type":{"array":{"items":"T","dimensions":[{"name":"x"},{"name":"y"}]}}},{"name":"IntImage","type":{"name":"Image.Image","typeArguments":["int32"]}},{"name":"Image","typeParameters":["T"],"type":{"name":"Image.Image","typeArguments":["T"]}},{"name":"Tuple","typeParameters":["T1","T2"],"fields":[{"name":"v1","type":"T1"},{"name":"v2","type":"T2"}]}]}"""
+
+    def close(self) -> None:
+        if self._state == 17:
+            try:
+                self._end_stream()
+                return
+            finally:
+                self._close()
+        self._close()
+        if self._state != 18:
+            expected_method = self._state_to_method_name((self._state + 1) & ~1)
+            raise ProtocolError(f"Protocol writer closed before all steps were called. Expected to call to '{expected_method}'.")
+
+    def __enter__(self):
+        return self
+
+    def __exit__(self, exc_type: typing.Optional[type[BaseException]], exc: typing.Optional[BaseException], traceback: object) -> None:
+        try:
+            self.close()
+        except Exception as e:
+            if exc is None:
+                raise e
+
+    def write_float_image(self, value: image.FloatImage) -> None:
+        """Ordinal 0"""
+
+        if self._state != 0:
+            self._raise_unexpected_state(0)
+
+        self._write_float_image(value)
+        self._state = 2
+
+    def write_int_image(self, value: image.IntImage) -> None:
+        """Ordinal 1"""
+
+        if self._state != 2:
+            self._raise_unexpected_state(2)
+
+        self._write_int_image(value)
+        self._state = 4
+
+    def write_int_image_alternate_syntax(self, value: Image[np.int32]) -> None:
+        """Ordinal 2"""
+
+        if self._state != 4:
+            self._raise_unexpected_state(4)
+
+        self._write_int_image_alternate_syntax(value)
+        self._state = 6
+
+    def write_string_image(self, value: Image[np.object_]) -> None:
+        """Ordinal 3"""
+
+        if self._state != 6:
+            self._raise_unexpected_state(6)
+
+        self._write_string_image(value)
+        self._state = 8
+
+    def write_int_float_tuple(self, value: tuples.Tuple[yardl.Int32, yardl.Float32]) -> None:
+        """Ordinal 4"""
+
+        if self._state != 8:
+            self._raise_unexpected_state(8)
+
+        self._write_int_float_tuple(value)
+        self._state = 10
+
+    def write_float_float_tuple(self, value: tuples.Tuple[yardl.Float32, yardl.Float32]) -> None:
+        """Ordinal 5"""
+
+        if self._state != 10:
+            self._raise_unexpected_state(10)
+
+        self._write_float_float_tuple(value)
+        self._state = 12
+
+    def write_int_float_tuple_alternate_syntax(self, value: tuples.Tuple[yardl.Int32, yardl.Float32]) -> None:
+        """Ordinal 6"""
+
+        if self._state != 12:
+            self._raise_unexpected_state(12)
+
+        self._write_int_float_tuple_alternate_syntax(value)
+        self._state = 14
+
+    def write_int_string_tuple(self, value: tuples.Tuple[yardl.Int32, str]) -> None:
+        """Ordinal 7"""
+
+        if self._state != 14:
+            self._raise_unexpected_state(14)
+
+        self._write_int_string_tuple(value)
+        self._state = 16
+
+    def write_stream_of_type_variants(self, value: collections.abc.Iterable[ImageFloatOrImageDouble]) -> None:
+        """Ordinal 8"""
+
+        if self._state & ~1 != 16:
+            self._raise_unexpected_state(16)
+
+        self._write_stream_of_type_variants(value)
+        self._state = 17
+
+    @abc.abstractmethod
+    def _write_float_image(self, value: image.FloatImage) -> None:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _write_int_image(self, value: image.IntImage) -> None:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _write_int_image_alternate_syntax(self, value: Image[np.int32]) -> None:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _write_string_image(self, value: Image[np.object_]) -> None:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _write_int_float_tuple(self, value: tuples.Tuple[yardl.Int32, yardl.Float32]) -> None:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _write_float_float_tuple(self, value: tuples.Tuple[yardl.Float32, yardl.Float32]) -> None:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _write_int_float_tuple_alternate_syntax(self, value: tuples.Tuple[yardl.Int32, yardl.Float32]) -> None:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _write_int_string_tuple(self, value: tuples.Tuple[yardl.Int32, str]) -> None:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _write_stream_of_type_variants(self, value: collections.abc.Iterable[ImageFloatOrImageDouble]) -> None:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _close(self) -> None:
+        pass
+
+    @abc.abstractmethod
+    def _end_stream(self) -> None:
+        pass
+
+    def _raise_unexpected_state(self, actual: int) -> None:
+        expected_method = self._state_to_method_name(self._state)
+        actual_method = self._state_to_method_name(actual)
+        raise ProtocolError(f"Expected to call to '{expected_method}' but received call to '{actual_method}'.")
+
+    def _state_to_method_name(self, state: int) -> str:
+        if state == 0:
+            return 'write_float_image'
+        if state == 2:
+            return 'write_int_image'
+        if state == 4:
+            return 'write_int_image_alternate_syntax'
+        if state == 6:
+            return 'write_string_image'
+        if state == 8:
+            return 'write_int_float_tuple'
+        if state == 10:
+            return 'write_float_float_tuple'
+        if state == 12:
+            return 'write_int_float_tuple_alternate_syntax'
+        if state == 14:
+            return 'write_int_string_tuple'
+        if state == 16:
+            return 'write_stream_of_type_variants'
+        return "<unknown>"
+
+class SimpleGenericsReaderBase(abc.ABC):
+    """Abstract reader for the SimpleGenerics protocol."""
+
+
+    def __init__(self, skip_completed_check: bool = False) -> None:
+        self._skip_completed_check = skip_completed_check
+        self._state = 0
+
+    def close(self) -> None:
+        self._close()
+        if not self._skip_completed_check and self._state != 18:
+            if self._state % 2 == 1:
+                previous_method = self._state_to_method_name(self._state - 1)
+                raise ProtocolError(f"Protocol reader closed before all data was consumed. The iterable returned by '{previous_method}' was not fully consumed.")
+            else:
+                expected_method = self._state_to_method_name(self._state)
+                raise ProtocolError(f"Protocol reader closed before all data was consumed. Expected call to '{expected_method}'.")
+            	
+
+    schema = SimpleGenericsWriterBase.schema
+
+    def __enter__(self):
+        return self
+
+    def __exit__(self, exc_type: typing.Optional[type[BaseException]], exc: typing.Optional[BaseException], traceback: object) -> None:
+        try:
+            self.close()
+        except Exception as e:
+            if exc is None:
+                raise e
+
+    @abc.abstractmethod
+    def _close(self) -> None:
+        raise NotImplementedError()
+
+    def read_float_image(self) -> image.FloatImage:
+        """Ordinal 0"""
+
+        if self._state != 0:
+            self._raise_unexpected_state(0)
+
+        value = self._read_float_image()
+        self._state = 2
+        return value
+
+    def read_int_image(self) -> image.IntImage:
+        """Ordinal 1"""
+
+        if self._state != 2:
+            self._raise_unexpected_state(2)
+
+        value = self._read_int_image()
+        self._state = 4
+        return value
+
+    def read_int_image_alternate_syntax(self) -> Image[np.int32]:
+        """Ordinal 2"""
+
+        if self._state != 4:
+            self._raise_unexpected_state(4)
+
+        value = self._read_int_image_alternate_syntax()
+        self._state = 6
+        return value
+
+    def read_string_image(self) -> Image[np.object_]:
+        """Ordinal 3"""
+
+        if self._state != 6:
+            self._raise_unexpected_state(6)
+
+        value = self._read_string_image()
+        self._state = 8
+        return value
+
+    def read_int_float_tuple(self) -> tuples.Tuple[yardl.Int32, yardl.Float32]:
+        """Ordinal 4"""
+
+        if self._state != 8:
+            self._raise_unexpected_state(8)
+
+        value = self._read_int_float_tuple()
+        self._state = 10
+        return value
+
+    def read_float_float_tuple(self) -> tuples.Tuple[yardl.Float32, yardl.Float32]:
+        """Ordinal 5"""
+
+        if self._state != 10:
+            self._raise_unexpected_state(10)
+
+        value = self._read_float_float_tuple()
+        self._state = 12
+        return value
+
+    def read_int_float_tuple_alternate_syntax(self) -> tuples.Tuple[yardl.Int32, yardl.Float32]:
+        """Ordinal 6"""
+
+        if self._state != 12:
+            self._raise_unexpected_state(12)
+
+        value = self._read_int_float_tuple_alternate_syntax()
+        self._state = 14
+        return value
+
+    def read_int_string_tuple(self) -> tuples.Tuple[yardl.Int32, str]:
+        """Ordinal 7"""
+
+        if self._state != 14:
+            self._raise_unexpected_state(14)
+
+        value = self._read_int_string_tuple()
+        self._state = 16
+        return value
+
+    def read_stream_of_type_variants(self) -> collections.abc.Iterable[ImageFloatOrImageDouble]:
+        """Ordinal 8"""
+
+        if self._state != 16:
+            self._raise_unexpected_state(16)
+
+        value = self._read_stream_of_type_variants()
+        self._state = 17
+        return self._wrap_iterable(value, 18)
+
+    def copy_to(self, writer: SimpleGenericsWriterBase) -> None:
+        writer.write_float_image(self.read_float_image())
+        writer.write_int_image(self.read_int_image())
+        writer.write_int_image_alternate_syntax(self.read_int_image_alternate_syntax())
+        writer.write_string_image(self.read_string_image())
+        writer.write_int_float_tuple(self.read_int_float_tuple())
+        writer.write_float_float_tuple(self.read_float_float_tuple())
+        writer.write_int_float_tuple_alternate_syntax(self.read_int_float_tuple_alternate_syntax())
+        writer.write_int_string_tuple(self.read_int_string_tuple())
+        writer.write_stream_of_type_variants(self.read_stream_of_type_variants())
+
+    @abc.abstractmethod
+    def _read_float_image(self) -> image.FloatImage:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _read_int_image(self) -> image.IntImage:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _read_int_image_alternate_syntax(self) -> Image[np.int32]:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _read_string_image(self) -> Image[np.object_]:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _read_int_float_tuple(self) -> tuples.Tuple[yardl.Int32, yardl.Float32]:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _read_float_float_tuple(self) -> tuples.Tuple[yardl.Float32, yardl.Float32]:
+        raise NotImplementedError()
 
     @abc.abstractmethod
-    def _read_days(self) -> collections.abc.Iterable[DaysOfWeek]:
+    def _read_int_float_tuple_alternate_syntax(self) -> tuples.Tuple[yardl.Int32, yardl.Float32]:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _read_formats(self) -> collections.abc.Iterable[TextFormat]:
+    def _read_int_string_tuple(self) -> tuples.Tuple[yardl.Int32, str]:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _read_stream_of_type_variants(self) -> collections.abc.Iterable[ImageFloatOrImageDouble]:
         raise NotImplementedError()
 
     T = typing.TypeVar('T')
@@ -5215,23 +7192,37 @@ class FlagsReaderBase(abc.ABC):
         	
     def _state_to_method_name(self, state: int) -> str:
         if state == 0:
-            return 'read_days'
+            return 'read_float_image'
         if state == 2:
-            return 'read_formats'
+            return 'read_int_image'
+        if state == 4:
+            return 'read_int_image_alternate_syntax'
+        if state == 6:
+            return 'read_string_image'
+        if state == 8:
+            return 'read_int_float_tuple'
+        if state == 10:
+            return 'read_float_float_tuple'
+        if state == 12:
+            return 'read_int_float_tuple_alternate_syntax'
+        if state == 14:
+            return 'read_int_string_tuple'
+        if state == 16:
+            return 'read_stream_of_type_variants'
         return "<unknown>"
 
-class StateTestWriterBase(abc.ABC):
-    """Abstract writer for the StateTest protocol."""
+class AdvancedGenericsWriterBase(abc.ABC):
+    """Abstract writer for the AdvancedGenerics protocol."""
 
 
     def __init__(self) -> None:
         self._state = 0
 
-    schema = r"""{"protocol":{"name":"StateTest","sequence":[{"name":"anInt","type":"int32"},{"name":"aStream","type":{"stream":{"items":"int32"}}},{"name":"anotherInt","type":"int32"}]},"types":null}"""
+    schema = r"""{"protocol":{"name":"AdvancedGenerics","sequence":[{"name":"floatImageImage","type":{"name":"TestModel.Image","typeArguments":[{"name":"TestModel.Image","typeArguments":["float32"]}]}},{"name":"genericRecord1","type":{"name":"TestModel.GenericRecord","typeArguments":["int32","string"]}},{"name":"tupleOfOptionals","type":{"name":"TestModel.MyTuple","typeArguments":[[null,"int32"],[null,"string"]]}},{"name":"tupleOfOptionalsAlternateSyntax","type":{"name":"TestModel.MyTuple","typeArguments":[[null,"int32"],[null,"string"]]}},{"name":"tupleOfVectors","type":{"name":"TestModel.MyTuple","typeArguments":[{"vector":{"items":"int32"}},{"vector":{"items":"float32"}}]}}]},"types":[{"name":"MyTuple","typeParameters":["T1","T2"],"type":{"name":"Tuples.Tuple","typeArguments":["T1","T2"]}},{"name":"Image","typeParameters":["T"],"type":{"array":{"items":"T","dimensions":[{"name":"x"},{"name":"y"}]}}},{"name":"GenericRecord","typeParameters":["T1","T2"],"fields":[{"name":"scalar1","type":"T1"},{"name":"scalar2","type":"T2"},{"name":"vector1","type":{"vector":{"items":"T1"}}},{"name":"image2","type":{"name":"TestModel.Image","typeArguments":["T2"]}}]},{"name":"Image","typeParameters":["T"],"type":{"name":"Image.Image","typeArguments":["T"]}},{"name":"MyTuple","typeParameters":["T1","T2"],"type":{"name":"BasicTypes.MyTuple","typeArguments":["T1","T2"]}},{"name":"Tuple","typeParameters":["T1","T2"],"fields":[{"name":"v1","type":"T1"},{"name":"v2","type":"T2"}]}]}"""
 
     def close(self) -> None:
         self._close()
-        if self._state != 6:
+        if self._state != 10:
             expected_method = self._state_to_method_name((self._state + 1) & ~1)
             raise ProtocolError(f"Protocol writer closed before all steps were called. Expected to call to '{expected_method}'.")
 
@@ -5245,46 +7236,69 @@ class StateTestWriterBase(abc.ABC):
             if exc is None:
                 raise e
 
-    def write_an_int(self, value: yardl.Int32) -> None:
+    def write_float_image_image(self, value: Image[np.object_]) -> None:
         """Ordinal 0"""
 
         if self._state != 0:
             self._raise_unexpected_state(0)
 
-        self._write_an_int(value)
+        self._write_float_image_image(value)
         self._state = 2
 
-    def write_a_stream(self, value: collections.abc.Iterable[yardl.Int32]) -> None:
+    def write_generic_record_1(self, value: GenericRecord[yardl.Int32, str, np.object_]) -> None:
         """Ordinal 1"""
 
-        if self._state & ~1 != 2:
+        if self._state != 2:
             self._raise_unexpected_state(2)
 
-        self._write_a_stream(value)
-        self._state = 3
+        self._write_generic_record_1(value)
+        self._state = 4
 
-    def write_another_int(self, value: yardl.Int32) -> None:
+    def write_tuple_of_optionals(self, value: MyTuple[typing.Optional[yardl.Int32], typing.Optional[str]]) -> None:
         """Ordinal 2"""
 
-        if self._state == 3:
-            self._end_stream()
-            self._state = 4
-        elif self._state != 4:
+        if self._state != 4:
             self._raise_unexpected_state(4)
 
-        self._write_another_int(value)
+        self._write_tuple_of_optionals(value)
         self._state = 6
 
+    def write_tuple_of_optionals_alternate_syntax(self, value: MyTuple[typing.Optional[yardl.Int32], typing.Optional[str]]) -> None:
+        """Ordinal 3"""
+
+        if self._state != 6:
+            self._raise_unexpected_state(6)
+
+        self._write_tuple_of_optionals_alternate_syntax(value)
+        self._state = 8
+
+    def write_tuple_of_vectors(self, value: MyTuple[list[yardl.Int32], list[yardl.Float32]]) -> None:
+        """Ordinal 4"""
+
+        if self._state != 8:
+            self._raise_unexpected_state(8)
+
+        self._write_tuple_of_vectors(value)
+        self._state = 10
+
     @abc.abstractmethod
-    def _write_an_int(self, value: yardl.Int32) -> None:
+    def _write_float_image_image(self, value: Image[np.object_]) -> None:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _write_a_stream(self, value: collections.abc.Iterable[yardl.Int32]) -> None:
+    def _write_generic_record_1(self, value: GenericRecord[yardl.Int32, str, np.object_]) -> None:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _write_another_int(self, value: yardl.Int32) -> None:
+    def _write_tuple_of_optionals(self, value: MyTuple[typing.Optional[yardl.Int32], typing.Optional[str]]) -> None:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _write_tuple_of_optionals_alternate_syntax(self, value: MyTuple[typing.Optional[yardl.Int32], typing.Optional[str]]) -> None:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _write_tuple_of_vectors(self, value: MyTuple[list[yardl.Int32], list[yardl.Float32]]) -> None:
         raise NotImplementedError()
 
     @abc.abstractmethod
@@ -5302,15 +7316,19 @@ class StateTestWriterBase(abc.ABC):
 
     def _state_to_method_name(self, state: int) -> str:
         if state == 0:
-            return 'write_an_int'
+            return 'write_float_image_image'
         if state == 2:
-            return 'write_a_stream'
+            return 'write_generic_record_1'
         if state == 4:
-            return 'write_another_int'
+            return 'write_tuple_of_optionals'
+        if state == 6:
+            return 'write_tuple_of_optionals_alternate_syntax'
+        if state == 8:
+            return 'write_tuple_of_vectors'
         return "<unknown>"
 
-class StateTestReaderBase(abc.ABC):
-    """Abstract reader for the StateTest protocol."""
+class AdvancedGenericsReaderBase(abc.ABC):
+    """Abstract reader for the AdvancedGenerics protocol."""
 
 
     def __init__(self, skip_completed_check: bool = False) -> None:
@@ -5319,7 +7337,7 @@ class StateTestReaderBase(abc.ABC):
 
     def close(self) -> None:
         self._close()
-        if not self._skip_completed_check and self._state != 6:
+        if not self._skip_completed_check and self._state != 10:
             if self._state % 2 == 1:
                 previous_method = self._state_to_method_name(self._state - 1)
                 raise ProtocolError(f"Protocol reader closed before all data was consumed. The iterable returned by '{previous_method}' was not fully consumed.")
@@ -5328,7 +7346,7 @@ class StateTestReaderBase(abc.ABC):
                 raise ProtocolError(f"Protocol reader closed before all data was consumed. Expected call to '{expected_method}'.")
             	
 
-    schema = StateTestWriterBase.schema
+    schema = AdvancedGenericsWriterBase.schema
 
     def __enter__(self):
         return self
@@ -5344,51 +7362,81 @@ class StateTestReaderBase(abc.ABC):
     def _close(self) -> None:
         raise NotImplementedError()
 
-    def read_an_int(self) -> yardl.Int32:
+    def read_float_image_image(self) -> Image[np.object_]:
         """Ordinal 0"""
 
         if self._state != 0:
             self._raise_unexpected_state(0)
 
-        value = self._read_an_int()
+        value = self._read_float_image_image()
         self._state = 2
         return value
 
-    def read_a_stream(self) -> collections.abc.Iterable[yardl.Int32]:
+    def read_generic_record_1(self) -> GenericRecord[yardl.Int32, str, np.object_]:
         """Ordinal 1"""
 
         if self._state != 2:
             self._raise_unexpected_state(2)
 
-        value = self._read_a_stream()
-        self._state = 3
-        return self._wrap_iterable(value, 4)
+        value = self._read_generic_record_1()
+        self._state = 4
+        return value
 
-    def read_another_int(self) -> yardl.Int32:
+    def read_tuple_of_optionals(self) -> MyTuple[typing.Optional[yardl.Int32], typing.Optional[str]]:
         """Ordinal 2"""
 
         if self._state != 4:
             self._raise_unexpected_state(4)
 
-        value = self._read_another_int()
+        value = self._read_tuple_of_optionals()
         self._state = 6
         return value
 
-    def copy_to(self, writer: StateTestWriterBase) -> None:
-        writer.write_an_int(self.read_an_int())
-        writer.write_a_stream(self.read_a_stream())
-        writer.write_another_int(self.read_another_int())
+    def read_tuple_of_optionals_alternate_syntax(self) -> MyTuple[typing.Optional[yardl.Int32], typing.Optional[str]]:
+        """Ordinal 3"""
+
+        if self._state != 6:
+            self._raise_unexpected_state(6)
+
+        value = self._read_tuple_of_optionals_alternate_syntax()
+        self._state = 8
+        return value
+
+    def read_tuple_of_vectors(self) -> MyTuple[list[yardl.Int32], list[yardl.Float32]]:
+        """Ordinal 4"""
+
+        if self._state != 8:
+            self._raise_unexpected_state(8)
+
+        value = self._read_tuple_of_vectors()
+        self._state = 10
+        return value
+
+    def copy_to(self, writer: AdvancedGenericsWriterBase) -> None:
+        writer.write_float_image_image(self.read_float_image_image())
+        writer.write_generic_record_1(self.read_generic_record_1())
+        writer.write_tuple_of_optionals(self.read_tuple_of_optionals())
+        writer.write_tuple_of_optionals_alternate_syntax(self.read_tuple_of_optionals_alternate_syntax())
+        writer.write_tuple_of_vectors(self.read_tuple_of_vectors())
 
     @abc.abstractmethod
-    def _read_an_int(self) -> yardl.Int32:
+    def _read_float_image_image(self) -> Image[np.object_]:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _read_a_stream(self) -> collections.abc.Iterable[yardl.Int32]:
+    def _read_generic_record_1(self) -> GenericRecord[yardl.Int32, str, np.object_]:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _read_another_int(self) -> yardl.Int32:
+    def _read_tuple_of_optionals(self) -> MyTuple[typing.Optional[yardl.Int32], typing.Optional[str]]:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _read_tuple_of_optionals_alternate_syntax(self) -> MyTuple[typing.Optional[yardl.Int32], typing.Optional[str]]:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _read_tuple_of_vectors(self) -> MyTuple[list[yardl.Int32], list[yardl.Float32]]:
         raise NotImplementedError()
 
     T = typing.TypeVar('T')
@@ -5407,159 +7455,85 @@ class StateTestReaderBase(abc.ABC):
         	
     def _state_to_method_name(self, state: int) -> str:
         if state == 0:
-            return 'read_an_int'
+            return 'read_float_image_image'
         if state == 2:
-            return 'read_a_stream'
+            return 'read_generic_record_1'
         if state == 4:
-            return 'read_another_int'
-        return "<unknown>"
-
-class SimpleGenericsWriterBase(abc.ABC):
-    """Abstract writer for the SimpleGenerics protocol."""
-
-
-    def __init__(self) -> None:
-        self._state = 0
-
-    schema = r"""{"protocol":{"name":"SimpleGenerics","sequence":[{"name":"floatImage","type":"Image.FloatImage"},{"name":"intImage","type":"Image.IntImage"},{"name":"intImageAlternateSyntax","type":{"name":"TestModel.Image","typeArguments":["int32"]}},{"name":"stringImage","type":{"name":"TestModel.Image","typeArguments":["string"]}},{"name":"intFloatTuple","type":{"name":"Tuples.Tuple","typeArguments":["int32","float32"]}},{"name":"floatFloatTuple","type":{"name":"Tuples.Tuple","typeArguments":["float32","float32"]}},{"name":"intFloatTupleAlternateSyntax","type":{"name":"Tuples.Tuple","typeArguments":["int32","float32"]}},{"name":"intStringTuple","type":{"name":"Tuples.Tuple","typeArguments":["int32","string"]}},{"name":"streamOfTypeVariants","type":{"stream":{"items":[{"tag":"imageFloat","explicitTag":true,"type":"Image.FloatImage"},{"tag":"imageDouble","explicitTag":true,"type":{"name":"TestModel.Image","typeArguments":["float64"]}}]}}}]},"types":[{"name":"FloatImage","type":{"name":"Image.Image","typeArguments":["float32"]}},{"name":"Image","typeParameters":["T"],"type":{"array":{"items":"T","dimensions":[{"name":"x"},{"name":"y"}]}}},{"name":"IntImage","type":{"name":"Image.Image","typeArguments":["int32"]}},{"name":"Image","typeParameters":["T"],"type":{"name":"Image.Image","typeArguments":["T"]}},{"name":"Tuple","typeParameters":["T1","T2"],"fields":[{"name":"v1","type":"T1"},{"name":"v2","type":"T2"}]}]}"""
-
-    def close(self) -> None:
-        if self._state == 17:
-            try:
-                self._end_stream()
-                return
-            finally:
-                self._close()
-        self._close()
-        if self._state != 18:
-            expected_method = self._state_to_method_name((self._state + 1) & ~1)
-            raise ProtocolError(f"Protocol writer closed before all steps were called. Expected to call to '{expected_method}'.")
-
-    def __enter__(self):
-        return self
-
-    def __exit__(self, exc_type: typing.Optional[type[BaseException]], exc: typing.Optional[BaseException], traceback: object) -> None:
-        try:
-            self.close()
-        except Exception as e:
-            if exc is None:
-                raise e
-
-    def write_float_image(self, value: image.FloatImage) -> None:
-        """Ordinal 0"""
-
-        if self._state != 0:
-            self._raise_unexpected_state(0)
-
-        self._write_float_image(value)
-        self._state = 2
-
-    def write_int_image(self, value: image.IntImage) -> None:
-        """Ordinal 1"""
-
-        if self._state != 2:
-            self._raise_unexpected_state(2)
-
-        self._write_int_image(value)
-        self._state = 4
-
-    def write_int_image_alternate_syntax(self, value: Image[np.int32]) -> None:
-        """Ordinal 2"""
-
-        if self._state != 4:
-            self._raise_unexpected_state(4)
-
-        self._write_int_image_alternate_syntax(value)
-        self._state = 6
-
-    def write_string_image(self, value: Image[np.object_]) -> None:
-        """Ordinal 3"""
-
-        if self._state != 6:
-            self._raise_unexpected_state(6)
-
-        self._write_string_image(value)
-        self._state = 8
-
-    def write_int_float_tuple(self, value: tuples.Tuple[yardl.Int32, yardl.Float32]) -> None:
-        """Ordinal 4"""
-
-        if self._state != 8:
-            self._raise_unexpected_state(8)
-
-        self._write_int_float_tuple(value)
-        self._state = 10
-
-    def write_float_float_tuple(self, value: tuples.Tuple[yardl.Float32, yardl.Float32]) -> None:
-        """Ordinal 5"""
+            return 'read_tuple_of_optionals'
+        if state == 6:
+            return 'read_tuple_of_optionals_alternate_syntax'
+        if state == 8:
+            return 'read_tuple_of_vectors'
+        return "<unknown>"
 
-        if self._state != 10:
-            self._raise_unexpected_state(10)
+class FloatImageStreamWriterBase(abc.ABC):
+    """Abstract writer for the FloatImageStream protocol.
 
-        self._write_float_float_tuple(value)
-        self._state = 12
+    A protocol that is instantiated for each pixel type
+    """
 
-    def write_int_float_tuple_alternate_syntax(self, value: tuples.Tuple[yardl.Int32, yardl.Float32]) -> None:
-        """Ordinal 6"""
 
-        if self._state != 12:
-            self._raise_unexpected_state(12)
+    def __init__(self) -> None:
+        self._state = 0
 
-        self._write_int_float_tuple_alternate_syntax(value)
-        self._state = 14
+    schema = r"""{"protocol":{"name":"FloatImageStream","sequence":[{"name":"header","type":{"name":"TestModel.GenericRecord","typeArguments":["int32","float32"]}},{"name":"images","type":{"stream":{"items":{"name":"TestModel.Image","typeArguments":["float32"]}}}},{"name":"background","type":[{"tag":"float32","type":"float32"},{"tag":"string","type":"string"}]}]},"types":[{"name":"Image","typeParameters":["T"],"type":{"array":{"items":"T","dimensions":[{"name":"x"},{"name":"y"}]}}},{"name":"GenericRecord","typeParameters":["T1","T2"],"fields":[{"name":"scalar1","type":"T1"},{"name":"scalar2","type":"T2"},{"name":"vector1","type":{"vector":{"items":"T1"}}},{"name":"image2","type":{"name":"TestModel.Image","typeArguments":["T2"]}}]},{"name":"Image","typeParameters":["T"],"type":{"name":"Image.Image","typeArguments":["T"]}}]}"""
 
-    def write_int_string_tuple(self, value: tuples.Tuple[yardl.Int32, str]) -> None:
-        """Ordinal 7"""
+    def close(self) -> None:
+        self._close()
+        if self._state != 6:
+            expected_method = self._state_to_method_name((self._state + 1) & ~1)
+            raise ProtocolError(f"Protocol writer closed before all steps were called. Expected to call to '{expected_method}'.")
 
-        if self._state != 14:
-            self._raise_unexpected_state(14)
+    def __enter__(self):
+        return self
 
-        self._write_int_string_tuple(value)
-        self._state = 16
+    def __exit__(self, exc_type: typing.Optional[type[BaseException]], exc: typing.Optional[BaseException], traceback: object) -> None:
+        try:
+            self.close()
+        except Exception as e:
+            if exc is None:
+                raise e
 
-    def write_stream_of_type_variants(self, value: collections.abc.Iterable[ImageFloatOrImageDouble]) -> None:
-        """Ordinal 8"""
+    def write_header(self, value: GenericRecord[yardl.Int32, yardl.Float32, np.float32]) -> None:
+        """Ordinal 0"""
 
-        if self._state & ~1 != 16:
-            self._raise_unexpected_state(16)
+        if self._state != 0:
+            self._raise_unexpected_state(0)
 
-        self._write_stream_of_type_variants(value)
-        self._state = 17
+        self._write_header(value)
+        self._state = 2
 
-    @abc.abstractmethod
-    def _write_float_image(self, value: image.FloatImage) -> None:
-        raise NotImplementedError()
+    def write_images(self, value: collections.abc.Iterable[Image[np.float32]]) -> None:
+        """Ordinal 1"""
 
-    @abc.abstractmethod
-    def _write_int_image(self, value: image.IntImage) -> None:
-        raise NotImplementedError()
+        if self._state & ~1 != 2:
+            self._raise_unexpected_state(2)
 
-    @abc.abstractmethod
-    def _write_int_image_alternate_syntax(self, value: Image[np.int32]) -> None:
-        raise NotImplementedError()
+        self._write_images(value)
+        self._state = 3
 
-    @abc.abstractmethod
-    def _write_string_image(self, value: Image[np.object_]) -> None:
-        raise NotImplementedError()
+    def write_background(self, value: Float32OrString) -> None:
+        """Ordinal 2"""
 
-    @abc.abstractmethod
-    def _write_int_float_tuple(self, value: tuples.Tuple[yardl.Int32, yardl.Float32]) -> None:
-        raise NotImplementedError()
+        if self._state == 3:
+            self._end_stream()
+            self._state = 4
+        elif self._state != 4:
+            self._raise_unexpected_state(4)
 
-    @abc.abstractmethod
-    def _write_float_float_tuple(self, value: tuples.Tuple[yardl.Float32, yardl.Float32]) -> None:
-        raise NotImplementedError()
+        self._write_background(value)
+        self._state = 6
 
     @abc.abstractmethod
-    def _write_int_float_tuple_alternate_syntax(self, value: tuples.Tuple[yardl.Int32, yardl.Float32]) -> None:
+    def _write_header(self, value: GenericRecord[yardl.Int32, yardl.Float32, np.float32]) -> None:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _write_int_string_tuple(self, value: tuples.Tuple[yardl.Int32, str]) -> None:
+    def _write_images(self, value: collections.abc.Iterable[Image[np.float32]]) -> None:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _write_stream_of_type_variants(self, value: collections.abc.Iterable[ImageFloatOrImageDouble]) -> None:
+    def _write_background(self, value: Float32OrString) -> None:
         raise NotImplementedError()
 
     @abc.abstractmethod
@@ -5577,27 +7551,18 @@ class SimpleGenericsWriterBase(abc.ABC):
 
     def _state_to_method_name(self, state: int) -> str:
         if state == 0:
-            return 'write_float_image'
+            return 'write_header'
         if state == 2:
-            return 'write_int_image'
+            return 'write_images'
         if state == 4:
-            return 'write_int_image_alternate_syntax'
-        if state == 6:
-            return 'write_string_image'
-        if state == 8:
-            return 'write_int_float_tuple'
-        if state == 10:
-            return 'write_float_float_tuple'
-        if state == 12:
-            return 'write_int_float_tuple_alternate_syntax'
-        if state == 14:
-            return 'write_int_string_tuple'
-        if state == 16:
-            return 'write_stream_of_type_variants'
+            return 'write_background'
         return "<unknown>"
 
-class SimpleGenericsReaderBase(abc.ABC):
-    """Abstract reader for the SimpleGenerics protocol."""
+class FloatImageStreamReaderBase(abc.ABC):
+    """Abstract reader for the FloatImageStream protocol.
+
+    A protocol that is instantiated for each pixel type
+    """
 
 
     def __init__(self, skip_completed_check: bool = False) -> None:
@@ -5606,7 +7571,7 @@ class SimpleGenericsReaderBase(abc.ABC):
 
     def close(self) -> None:
         self._close()
-        if not self._skip_completed_check and self._state != 18:
+        if not self._skip_completed_check and self._state != 6:
             if self._state % 2 == 1:
                 previous_method = self._state_to_method_name(self._state - 1)
                 raise ProtocolError(f"Protocol reader closed before all data was consumed. The iterable returned by '{previous_method}' was not fully consumed.")
@@ -5615,7 +7580,7 @@ class SimpleGenericsReaderBase(abc.ABC):
                 raise ProtocolError(f"Protocol reader closed before all data was consumed. Expected call to '{expected_method}'.")
             	
 
-    schema = SimpleGenericsWriterBase.schema
+    schema = FloatImageStreamWriterBase.schema
 
     def __enter__(self):
         return self
@@ -5631,141 +7596,51 @@ class SimpleGenericsReaderBase(abc.ABC):
     def _close(self) -> None:
         raise NotImplementedError()
 
-    def read_float_image(self) -> image.FloatImage:
+    def read_header(self) -> GenericRecord[yardl.Int32, yardl.Float32, np.float32]:
         """Ordinal 0"""
 
         if self._state != 0:
             self._raise_unexpected_state(0)
 
-        value = self._read_float_image()
+        value = self._read_header()
         self._state = 2
         return value
 
-    def read_int_image(self) -> image.IntImage:
+    def read_images(self) -> collections.abc.Iterable[Image[np.float32]]:
         """Ordinal 1"""
 
         if self._state != 2:
             self._raise_unexpected_state(2)
 
-        value = self._read_int_image()
-        self._state = 4
-        return value
+        value = self._read_images()
+        self._state = 3
+        return self._wrap_iterable(value, 4)
 
-    def read_int_image_alternate_syntax(self) -> Image[np.int32]:
+    def read_background(self) -> Float32OrString:
         """Ordinal 2"""
 
         if self._state != 4:
             self._raise_unexpected_state(4)
 
-        value = self._read_int_image_alternate_syntax()
+        value = self._read_background()
         self._state = 6
         return value
 
-    def read_string_image(self) -> Image[np.object_]:
-        """Ordinal 3"""
-
-        if self._state != 6:
-            self._raise_unexpected_state(6)
-
-        value = self._read_string_image()
-        self._state = 8
-        return value
-
-    def read_int_float_tuple(self) -> tuples.Tuple[yardl.Int32, yardl.Float32]:
-        """Ordinal 4"""
-
-        if self._state != 8:
-            self._raise_unexpected_state(8)
-
-        value = self._read_int_float_tuple()
-        self._state = 10
-        return value
-
-    def read_float_float_tuple(self) -> tuples.Tuple[yardl.Float32, yardl.Float32]:
-        """Ordinal 5"""
-
-        if self._state != 10:
-            self._raise_unexpected_state(10)
-
-        value = self._read_float_float_tuple()
-        self._state = 12
-        return value
-
-    def read_int_float_tuple_alternate_syntax(self) -> tuples.Tuple[yardl.Int32, yardl.Float32]:
-        """Ordinal 6"""
-
-        if self._state != 12:
-            self._raise_unexpected_state(12)
-
-        value = self._read_int_float_tuple_alternate_syntax()
-        self._state = 14
-        return value
-
-    def read_int_string_tuple(self) -> tuples.Tuple[yardl.Int32, str]:
-        """Ordinal 7"""
-
-        if self._state != 14:
-            self._raise_unexpected_state(14)
-
-        value = self._read_int_string_tuple()
-        self._state = 16
-        return value
-
-    def read_stream_of_type_variants(self) -> collections.abc.Iterable[ImageFloatOrImageDouble]:
-        """Ordinal 8"""
-
-        if self._state != 16:
-            self._raise_unexpected_state(16)
-
-        value = self._read_stream_of_type_variants()
-        self._state = 17
-        return self._wrap_iterable(value, 18)
-
-    def copy_to(self, writer: SimpleGenericsWriterBase) -> None:
-        writer.write_float_image(self.read_float_image())
-        writer.write_int_image(self.read_int_image())
-        writer.write_int_image_alternate_syntax(self.read_int_image_alternate_syntax())
-        writer.write_string_image(self.read_string_image())
-        writer.write_int_float_tuple(self.read_int_float_tuple())
-        writer.write_float_float_tuple(self.read_float_float_tuple())
-        writer.write_int_float_tuple_alternate_syntax(self.read_int_float_tuple_alternate_syntax())
-        writer.write_int_string_tuple(self.read_int_string_tuple())
-        writer.write_stream_of_type_variants(self.read_stream_of_type_variants())
-
-    @abc.abstractmethod
-    def _read_float_image(self) -> image.FloatImage:
-        raise NotImplementedError()
-
-    @abc.abstractmethod
-    def _read_int_image(self) -> image.IntImage:
-        raise NotImplementedError()
-
-    @abc.abstractmethod
-    def _read_int_image_alternate_syntax(self) -> Image[np.int32]:
-        raise NotImplementedError()
-
-    @abc.abstractmethod
-    def _read_string_image(self) -> Image[np.object_]:
-        raise NotImplementedError()
-
-    @abc.abstractmethod
-    def _read_int_float_tuple(self) -> tuples.Tuple[yardl.Int32, yardl.Float32]:
-        raise NotImplementedError()
-
-    @abc.abstractmethod
-    def _read_float_float_tuple(self) -> tuples.Tuple[yardl.Float32, yardl.Float32]:
-        raise NotImplementedError()
+    def copy_to(self, writer: FloatImageStreamWriterBase) -> None:
+        writer.write_header(self.read_header())
+        writer.write_images(self.read_images())
+        writer.write_background(self.read_background())
 
     @abc.abstractmethod
-    def _read_int_float_tuple_alternate_syntax(self) -> tuples.Tuple[yardl.Int32, yardl.Float32]:
+    def _read_header(self) -> GenericRecord[yardl.Int32, yardl.Float32, np.float32]:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _read_int_string_tuple(self) -> tuples.Tuple[yardl.Int32, str]:
+    def _read_images(self) -> collections.abc.Iterable[Image[np.float32]]:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _read_stream_of_type_variants(self) -> collections.abc.Iterable[ImageFloatOrImageDouble]:
+    def _read_background(self) -> Float32OrString:
         raise NotImplementedError()
 
     T = typing.TypeVar('T')
@@ -5784,37 +7659,28 @@ class SimpleGenericsReaderBase(abc.ABC):
         	
     def _state_to_method_name(self, state: int) -> str:
         if state == 0:
-            return 'read_float_image'
+            return 'read_header'
         if state == 2:
-            return 'read_int_image'
+            return 'read_images'
         if state == 4:
-            return 'read_int_image_alternate_syntax'
-        if state == 6:
-            return 'read_string_image'
-        if state == 8:
-            return 'read_int_float_tuple'
-        if state == 10:
-            return 'read_float_float_tuple'
-        if state == 12:
-            return 'read_int_float_tuple_alternate_syntax'
-        if state == 14:
-            return 'read_int_string_tuple'
-        if state == 16:
-            return 'read_stream_of_type_variants'
+            return 'read_background'
         return "<unknown>"
 
-class AdvancedGenericsWriterBase(abc.ABC):
-    """Abstract writer for the AdvancedGenerics protocol."""
+class ComplexImageStreamWriterBase(abc.ABC):
+    """Abstract writer for the ComplexImageStream protocol.
+
+    A protocol that is instantiated for each pixel type
+    """
 
 
     def __init__(self) -> None:
         self._state = 0
 
-    schema = r"""{"protocol":{"name":"AdvancedGenerics","sequence":[{"name":"floatImageImage","type":{"name":"TestModel.Image","typeArguments":[{"name":"TestModel.Image","typeArguments":["float32"]}]}},{"name":"genericRecord1","type":{"name":"TestModel.GenericRecord","typeArguments":["int32","string"]}},{"name":"tupleOfOptionals","type":{"name":"TestModel.MyTuple","typeArguments":[[null,"int32"],[null,"string"]]}},{"name":"tupleOfOptionalsAlternateSyntax","type":{"name":"TestModel.MyTuple","typeArguments":[[null,"int32"],[null,"string"]]}},{"name":"tupleOfVectors","type":{"name":"TestModel.MyTuple","typeArguments":[{"vector":{"items":"int32"}},{"vector":{"items":"float32"}}]}}]},"types":[{"name":"MyTuple","typeParameters":["T1","T2"],"type":{"name":"Tuples.Tuple","typeArguments":["T1","T2"]}},{"name":"Image","typeParameters":["T"],"type":{"array":{"items":"T","dimensions":[{"name":"x"},{"name":"y"}]}}},{"name":"GenericRecord","typeParameters":["T1","T2"],"fields":[{"name":"scalar1","type":"T1"},{"name":"scalar2","type":"T2"},{"name":"vector1","type":{"vector":{"items":"T1"}}},{"name":"image2","type":{"name":"TestModel.Image","typeArguments":["T2"]}}]},{"name":"Image","typeParameters":["T"],"type":{"name":"Image.Image","typeArguments":["T"]}},{"name":"MyTuple","typeParameters":["T1","T2"],"type":{"name":"BasicTypes.MyTuple","typeArguments":["T1","T2"]}},{"name":"Tuple","typeParameters":["T1","T2"],"fields":[{"name":"v1","type":"T1"},{"name":"v2","type":"T2"}]}]}"""
+    schema = r"""{"protocol":{"name":"ComplexImageStream","sequence":[{"name":"header","type":{"name":"TestModel.GenericRecord","typeArguments":["int32","complexfloat32"]}},{"name":"images","type":{"stream":{"items":{"name":"TestModel.Image","typeArguments":["complexfloat32"]}}}},{"name":"background","type":[{"tag":"complexfloat32","type":"complexfloat32"},{"tag":"string","type":"string"}]}]},"types":[{"name":"Image","typeParameters":["T"],"type":{"array":{"items":"T","dimensions":[{"name":"x"},{"name":"y"}]}}},{"name":"GenericRecord","typeParameters":["T1","T2"],"fields":[{"name":"scalar1","type":"T1"},{"name":"scalar2","type":"T2"},{"name":"vector1","type":{"vector":{"items":"T1"}}},{"name":"image2","type":{"name":"TestModel.Image","typeArguments":["T2"]}}]},{"name":"Image","typeParameters":["T"],"type":{"name":"Image.Image","typeArguments":["T"]}}]}"""
 
     def close(self) -> None:
         self._close()
-        if self._state != 10:
+        if self._state != 6:
             expected_method = self._state_to_method_name((self._state + 1) & ~1)
             raise ProtocolError(f"Protocol writer closed before all steps were called. Expected to call to '{expected_method}'.")
 
@@ -5828,69 +7694,46 @@ class AdvancedGenericsWriterBase(abc.ABC):
             if exc is None:
                 raise e
 
-    def write_float_image_image(self, value: Image[np.object_]) -> None:
+    def write_header(self, value: GenericRecord[yardl.Int32, yardl.ComplexFloat, np.complex64]) -> None:
         """Ordinal 0"""
 
         if self._state != 0:
             self._raise_unexpected_state(0)
 
-        self._write_float_image_image(value)
-        self._state = 2
-
-    def write_generic_record_1(self, value: GenericRecord[yardl.Int32, str, np.object_]) -> None:
-        """Ordinal 1"""
-
-        if self._state != 2:
-            self._raise_unexpected_state(2)
-
-        self._write_generic_record_1(value)
-        self._state = 4
-
-    def write_tuple_of_optionals(self, value: MyTuple[typing.Optional[yardl.Int32], typing.Optional[str]]) -> None:
-        """Ordinal 2"""
-
-        if self._state != 4:
-            self._raise_unexpected_state(4)
-
-        self._write_tuple_of_optionals(value)
-        self._state = 6
-
-    def write_tuple_of_optionals_alternate_syntax(self, value: MyTuple[typing.Optional[yardl.Int32], typing.Optional[str]]) -> None:
-        """Ordinal 3"""
-
-        if self._state != 6:
-            self._raise_unexpected_state(6)
-
-        self._write_tuple_of_optionals_alternate_syntax(value)
-        self._state = 8
+        self._write_header(value)
+        self._state = 2
 
-    def write_tuple_of_vectors(self, value: MyTuple[list[yardl.Int32], list[yardl.Float32]]) -> None:
-        """Ordinal 4"""
+    def write_images(self, value: collections.abc.Iterable[Image[np.complex64]]) -> None:
+        """Ordinal 1"""
 
-        if self._state != 8:
-            self._raise_unexpected_state(8)
+        if self._state & ~1 != 2:
+            self._raise_unexpected_state(2)
 
-        self._write_tuple_of_vectors(value)
-        self._state = 10
+        self._write_images(value)
+        self._state = 3
 
-    @abc.abstractmethod
-    def _write_float_image_image(self, value: Image[np.object_]) -> None:
-        raise NotImplementedError()
+    def write_background(self, value: Complexfloat32OrString) -> None:
+        """Ordinal 2"""
 
-    @abc.abstractmethod
-    def _write_generic_record_1(self, value: GenericRecord[yardl.Int32, str, np.object_]) -> None:
-        raise NotImplementedError()
+        if self._state == 3:
+            self._end_stream()
+            self._state = 4
+        elif self._state != 4:
+            self._raise_unexpected_state(4)
+
+        self._write_background(value)
+        self._state = 6
 
     @abc.abstractmethod
-    def _write_tuple_of_optionals(self, value: MyTuple[typing.Optional[yardl.Int32], typing.Optional[str]]) -> None:
+    def _write_header(self, value: GenericRecord[yardl.Int32, yardl.ComplexFloat, np.complex64]) -> None:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _write_tuple_of_optionals_alternate_syntax(self, value: MyTuple[typing.Optional[yardl.Int32], typing.Optional[str]]) -> None:
+    def _write_images(self, value: collections.abc.Iterable[Image[np.complex64]]) -> None:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _write_tuple_of_vectors(self, value: MyTuple[list[yardl.Int32], list[yardl.Float32]]) -> None:
+    def _write_background(self, value: Complexfloat32OrString) -> None:
         raise NotImplementedError()
 
     @abc.abstractmethod
@@ -5908,19 +7751,18 @@ class AdvancedGenericsWriterBase(abc.ABC):
 
     def _state_to_method_name(self, state: int) -> str:
         if state == 0:
-            return 'write_float_image_image'
+            return 'write_header'
         if state == 2:
-            return 'write_generic_record_1'
+            return 'write_images'
         if state == 4:
-            return 'write_tuple_of_optionals'
-        if state == 6:
-            return 'write_tuple_of_optionals_alternate_syntax'
-        if state == 8:
-            return 'write_tuple_of_vectors'
+            return 'write_background'
         return "<unknown>"
 
-class AdvancedGenericsReaderBase(abc.ABC):
-    """Abstract reader for the AdvancedGenerics protocol."""
+class ComplexImageStreamReaderBase(abc.ABC):
+    """Abstract reader for the ComplexImageStream protocol.
+
+    A protocol that is instantiated for each pixel type
+    """
 
 
     def __init__(self, skip_completed_check: bool = False) -> None:
@@ -5929,7 +7771,7 @@ class AdvancedGenericsReaderBase(abc.ABC):
 
     def close(self) -> None:
         self._close()
-        if not self._skip_completed_check and self._state != 10:
+        if not self._skip_completed_check and self._state != 6:
             if self._state % 2 == 1:
                 previous_method = self._state_to_method_name(self._state - 1)
                 raise ProtocolError(f"Protocol reader closed before all data was consumed. The iterable returned by '{previous_method}' was not fully consumed.")
@@ -5938,7 +7780,7 @@ class AdvancedGenericsReaderBase(abc.ABC):
                 raise ProtocolError(f"Protocol reader closed before all data was consumed. Expected call to '{expected_method}'.")
             	
 
-    schema = AdvancedGenericsWriterBase.schema
+    schema = ComplexImageStreamWriterBase.schema
 
     def __enter__(self):
         return self
@@ -5954,81 +7796,51 @@ class AdvancedGenericsReaderBase(abc.ABC):
     def _close(self) -> None:
         raise NotImplementedError()
 
-    def read_float_image_image(self) -> Image[np.object_]:
+    def read_header(self) -> GenericRecord[yardl.Int32, yardl.ComplexFloat, np.complex64]:
         """Ordinal 0"""
 
         if self._state != 0:
             self._raise_unexpected_state(0)
 
-        value = self._read_float_image_image()
+        value = self._read_header()
         self._state = 2
         return value
 
-    def read_generic_record_1(self) -> GenericRecord[yardl.Int32, str, np.object_]:
+    def read_images(self) -> collections.abc.Iterable[Image[np.complex64]]:
         """Ordinal 1"""
 
         if self._state != 2:
             self._raise_unexpected_state(2)
 
-        value = self._read_generic_record_1()
-        self._state = 4
-        return value
+        value = self._read_images()
+        self._state = 3
+        return self._wrap_iterable(value, 4)
 
-    def read_tuple_of_optionals(self) -> MyTuple[typing.Optional[yardl.Int32], typing.Optional[str]]:
+    def read_background(self) -> Complexfloat32OrString:
         """Ordinal 2"""
 
         if self._state != 4:
             self._raise_unexpected_state(4)
 
-        value = self._read_tuple_of_optionals()
+        value = self._read_background()
         self._state = 6
         return value
 
-    def read_tuple_of_optionals_alternate_syntax(self) -> MyTuple[typing.Optional[yardl.Int32], typing.Optional[str]]:
-        """Ordinal 3"""
-
-        if self._state != 6:
-            self._raise_unexpected_state(6)
-
-        value = self._read_tuple_of_optionals_alternate_syntax()
-        self._state = 8
-        return value
-
-    def read_tuple_of_vectors(self) -> MyTuple[list[yardl.Int32], list[yardl.Float32]]:
-        """Ordinal 4"""
-
-        if self._state != 8:
-            self._raise_unexpected_state(8)
-
-        value = self._read_tuple_of_vectors()
-        self._state = 10
-        return value
-
-    def copy_to(self, writer: AdvancedGenericsWriterBase) -> None:
-        writer.write_float_image_image(self.read_float_image_image())
-        writer.write_generic_record_1(self.read_generic_record_1())
-        writer.write_tuple_of_optionals(self.read_tuple_of_optionals())
-        writer.write_tuple_of_optionals_alternate_syntax(self.read_tuple_of_optionals_alternate_syntax())
-        writer.write_tuple_of_vectors(self.read_tuple_of_vectors())
-
-    @abc.abstractmethod
-    def _read_float_image_image(self) -> Image[np.object_]:
-        raise NotImplementedError()
-
-    @abc.abstractmethod
-    def _read_generic_record_1(self) -> GenericRecord[yardl.Int32, str, np.object_]:
-        raise NotImplementedError()
+    def copy_to(self, writer: ComplexImageStreamWriterBase) -> None:
+        writer.write_header(self.read_header())
+        writer.write_images(self.read_images())
+        writer.write_background(self.read_background())
 
     @abc.abstractmethod
-    def _read_tuple_of_optionals(self) -> MyTuple[typing.Optional[yardl.Int32], typing.Optional[str]]:
+    def _read_header(self) -> GenericRecord[yardl.Int32, yardl.ComplexFloat, np.complex64]:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _read_tuple_of_optionals_alternate_syntax(self) -> MyTuple[typing.Optional[yardl.Int32], typing.Optional[str]]:
+    def _read_images(self) -> collections.abc.Iterable[Image[np.complex64]]:
         raise NotImplementedError()
 
     @abc.abstractmethod
-    def _read_tuple_of_vectors(self) -> MyTuple[list[yardl.Int32], list[yardl.Float32]]:
+    def _read_background(self) -> Complexfloat32OrString:
         raise NotImplementedError()
 
     T = typing.TypeVar('T')
@@ -6047,15 +7859,11 @@ class AdvancedGenericsReaderBase(abc.ABC):
         	
     def _state_to_method_name(self, state: int) -> str:
         if state == 0:
-            return 'read_float_image_image'
+            return 'read_header'
         if state == 2:
-            return 'read_generic_record_1'
+            return 'read_images'
         if state == 4:
-            return 'read_tuple_of_optionals'
-        if state == 6:
-            return 'read_tuple_of_optionals_alternate_syntax'
-        if state == 8:
-            return 'read_tuple_of_vectors'
+            return 'read_background'
         return "<unknown>"
 
 class AliasesWriterBase(abc.ABC):
@@ -6803,6 +8611,189 @@ class ProtocolWithComputedFieldsReaderBase(abc.ABC):
             return 'read_record_with_computed_fields'
         return "<unknown>"
 
+class ProtocolWithConstraintsWriterBase(abc.ABC):
+    """Abstract writer for the ProtocolWithConstraints protocol."""
+
+
+    def __init__(self) -> None:
+        self._state = 0
+        self._validate_constraints = False
+
+    def set_validate_constraints(self, validate: bool) -> None:
+        """Enables checking that values satisfy the constraints of their fields before they are written. Disabled by default.
+        The items of streams are checked as they are written.
+        """
+
+        self._validate_constraints = validate
+
+    schema = r"""{"protocol":{"name":"ProtocolWithConstraints","sequence":[{"name":"record","type":"TestModel.RecordWithConstraints"},{"name":"records","type":{"stream":{"items":"TestModel.RecordWithConstrainedRecords"}}}]},"types":[{"name":"RecordWithConstrainedRecords","fields":[{"name":"single","type":"TestModel.RecordWithConstraints"},{"name":"optional","type":[null,"TestModel.RecordWithConstraints"]},{"name":"vector","type":{"vector":{"items":"TestModel.RecordWithConstraints"}}}]},{"name":"RecordWithConstraints","fields":[{"name":"size","type":"uint32"},{"name":"gain","type":"float32"},{"name":"name","type":"string"},{"name":"limit","type":[null,"int32"]},{"name":"samples","type":{"vector":{"items":"int32"}}}]}]}"""
+
+    def close(self) -> None:
+        if self._state == 3:
+            try:
+                self._end_stream()
+                return
+            finally:
+                self._close()
+        self._close()
+        if self._state != 4:
+            expected_method = self._state_to_method_name((self._state + 1) & ~1)
+            raise ProtocolError(f"Protocol writer closed before all steps were called. Expected to call to '{expected_method}'.")
+
+    def __enter__(self):
+        return self
+
+    def __exit__(self, exc_type: typing.Optional[type[BaseException]], exc: typing.Optional[BaseException], traceback: object) -> None:
+        try:
+            self.close()
+        except Exception as e:
+            if exc is None:
+                raise e
+
+    def write_record(self, value: RecordWithConstraints) -> None:
+        """Ordinal 0"""
+
+        if self._state != 0:
+            self._raise_unexpected_state(0)
+
+        if self._validate_constraints:
+            value.validate()
+
+        self._write_record(value)
+        self._state = 2
+
+    def write_records(self, value: collections.abc.Iterable[RecordWithConstrainedRecords]) -> None:
+        """Ordinal 1"""
+
+        if self._state & ~1 != 2:
+            self._raise_unexpected_state(2)
+
+        if self._validate_constraints:
+            def validated(items: collections.abc.Iterable[RecordWithConstrainedRecords]) -> collections.abc.Iterable[RecordWithConstrainedRecords]:
+                for item in items:
+                    item.validate()
+                    yield item
+            value = validated(value)
+
+        self._write_records(value)
+        self._state = 3
+
+    @abc.abstractmethod
+    def _write_record(self, value: RecordWithConstraints) -> None:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _write_records(self, value: collections.abc.Iterable[RecordWithConstrainedRecords]) -> None:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _close(self) -> None:
+        pass
+
+    @abc.abstractmethod
+    def _end_stream(self) -> None:
+        pass
+
+    def _raise_unexpected_state(self, actual: int) -> None:
+        expected_method = self._state_to_method_name(self._state)
+        actual_method = self._state_to_method_name(actual)
+        raise ProtocolError(f"Expected to call to '{expected_method}' but received call to '{actual_method}'.")
+
+    def _state_to_method_name(self, state: int) -> str:
+        if state == 0:
+            return 'write_record'
+        if state == 2:
+            return 'write_records'
+        return "<unknown>"
+
+class ProtocolWithConstraintsReaderBase(abc.ABC):
+    """Abstract reader for the ProtocolWithConstraints protocol."""
+
+
+    def __init__(self, skip_completed_check: bool = False) -> None:
+        self._skip_completed_check = skip_completed_check
+        self._state = 0
+
+    def close(self) -> None:
+        self._close()
+        if not self._skip_completed_check and self._state != 4:
+            if self._state % 2 == 1:
+                previous_method = self._state_to_method_name(self._state - 1)
+                raise ProtocolError(f"Protocol reader closed before all data was consumed. The iterable returned by '{previous_method}' was not fully consumed.")
+            else:
+                expected_method = self._state_to_method_name(self._state)
+                raise ProtocolError(f"Protocol reader closed before all data was consumed. Expected call to '{expected_method}'.")
+            	
+
+    schema = ProtocolWithConstraintsWriterBase.schema
+
+    def __enter__(self):
+        return self
+
+    def __exit__(self, exc_type: typing.Optional[type[BaseException]], exc: typing.Optional[BaseException], traceback: object) -> None:
+        try:
+            self.close()
+        except Exception as e:
+            if exc is None:
+                raise e
+
+    @abc.abstractmethod
+    def _close(self) -> None:
+        raise NotImplementedError()
+
+    def read_record(self) -> RecordWithConstraints:
+        """Ordinal 0"""
+
+        if self._state != 0:
+            self._raise_unexpected_state(0)
+
+        value = self._read_record()
+        self._state = 2
+        return value
+
+    def read_records(self) -> collections.abc.Iterable[RecordWithConstrainedRecords]:
+        """Ordinal 1"""
+
+        if self._state != 2:
+            self._raise_unexpected_state(2)
+
+        value = self._read_records()
+        self._state = 3
+        return self._wrap_iterable(value, 4)
+
+    def copy_to(self, writer: ProtocolWithConstraintsWriterBase) -> None:
+        writer.write_record(self.read_record())
+        writer.write_records(self.read_records())
+
+    @abc.abstractmethod
+    def _read_record(self) -> RecordWithConstraints:
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def _read_records(self) -> collections.abc.Iterable[RecordWithConstrainedRecords]:
+        raise NotImplementedError()
+
+    T = typing.TypeVar('T')
+    def _wrap_iterable(self, iterable: collections.abc.Iterable[T], final_state: int) -> collections.abc.Iterable[T]:
+        yield from iterable
+        self._state = final_state
+
+    def _raise_unexpected_state(self, actual: int) -> None:
+        actual_method = self._state_to_method_name(actual)
+        if self._state % 2 == 1:
+            previous_method = self._state_to_method_name(self._state - 1)
+            raise ProtocolError(f"Received call to '{actual_method}' but the iterable returned by '{previous_method}' was not fully consumed.")
+        else:
+            expected_method = self._state_to_method_name(self._state)
+            raise ProtocolError(f"Expected to call to '{expected_method}' but received call to '{actual_method}'.")
+        	
+    def _state_to_method_name(self, state: int) -> str:
+        if state == 0:
+            return 'read_record'
+        if state == 2:
+            return 'read_records'
+        return "<unknown>"
+
 class ProtocolWithKeywordStepsWriterBase(abc.ABC):
     """Abstract writer for the ProtocolWithKeywordSteps protocol."""
 
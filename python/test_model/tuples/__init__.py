@@ -9,3 +9,4 @@ from .types import (
 )
 from . import binary
 from . import ndjson
+from . import hdf5
@@ -7,8 +7,10 @@
 
 import datetime
 import enum
+import re
 import types
 import typing
+import uuid
 
 import numpy as np
 import numpy.typing as npt
@@ -40,6 +42,13 @@ W = typing.TypeVar("W")
 W_NP = typing.TypeVar("W_NP", bound=np.generic)
 
 
+# The maximum number of channels
+MAX_CHANNELS: yardl.Int32 = 4
+SAMPLES_PER_CHANNEL: yardl.UInt32 = 8
+DEFAULT_GAIN: yardl.Float32 = 1.5
+IS_STRICT: bool = False
+
+
 class SmallBenchmarkRecord:
     a: yardl.Float64
     b: yardl.Float32
@@ -566,6 +575,239 @@ class RecordWithStrings:
         return f"RecordWithStrings(a={repr(self.a)}, b={repr(self.b)})"
 
 
+class RecordWithBytes:
+    data: bytes
+    optional_data: typing.Optional[bytes]
+    chunks: list[bytes]
+
+    def __init__(self, *,
+        data: bytes = b"",
+        optional_data: typing.Optional[bytes] = None,
+        chunks: typing.Optional[list[bytes]] = None,
+    ):
+        self.data = data
+        self.optional_data = optional_data
+        self.chunks = chunks if chunks is not None else []
+
+    def __eq__(self, other: object) -> bool:
+        return (
+            isinstance(other, RecordWithBytes)
+            and self.data == other.data
+            and self.optional_data == other.optional_data
+            and self.chunks == other.chunks
+        )
+
+    def __str__(self) -> str:
+        return f"RecordWithBytes(data={self.data}, optional_data={self.optional_data}, chunks={self.chunks})"
+
+    def __repr__(self) -> str:
+        return f"RecordWithBytes(data={repr(self.data)}, optional_data={repr(self.optional_data)}, chunks={repr(self.chunks)})"
+
+
+class RecordWithUuids:
+    id: uuid.UUID
+    optional_id: typing.Optional[uuid.UUID]
+    related: list[uuid.UUID]
+    names: dict[uuid.UUID, str]
+
+    def __init__(self, *,
+        id: uuid.UUID = uuid.UUID(int=0),
+        optional_id: typing.Optional[uuid.UUID] = None,
+        related: typing.Optional[list[uuid.UUID]] = None,
+        names: typing.Optional[dict[uuid.UUID, str]] = None,
+    ):
+        self.id = id
+        self.optional_id = optional_id
+        self.related = related if related is not None else []
+        self.names = names if names is not None else {}
+
+    def __eq__(self, other: object) -> bool:
+        return (
+            isinstance(other, RecordWithUuids)
+            and self.id == other.id
+            and self.optional_id == other.optional_id
+            and self.related == other.related
+            and self.names == other.names
+        )
+
+    def __str__(self) -> str:
+        return f"RecordWithUuids(id={self.id}, optional_id={self.optional_id}, related={self.related}, names={self.names})"
+
+    def __repr__(self) -> str:
+        return f"RecordWithUuids(id={repr(self.id)}, optional_id={repr(self.optional_id)}, related={repr(self.related)}, names={repr(self.names)})"
+
+
+class RecordWithDurations:
+    acquisition_start: yardl.DateTime
+    acquisition_end: yardl.DateTime
+    repetition_time: np.timedelta64
+    timeout: typing.Optional[np.timedelta64]
+    intervals: list[np.timedelta64]
+
+    def __init__(self, *,
+        acquisition_start: yardl.DateTime = yardl.DateTime(),
+        acquisition_end: yardl.DateTime = yardl.DateTime(),
+        repetition_time: np.timedelta64 = np.timedelta64(0, "ns"),
+        timeout: typing.Optional[np.timedelta64] = None,
+        intervals: typing.Optional[list[np.timedelta64]] = None,
+    ):
+        self.acquisition_start = acquisition_start
+        self.acquisition_end = acquisition_end
+        self.repetition_time = repetition_time
+        self.timeout = timeout
+        self.intervals = intervals if intervals is not None else []
+
+    def elapsed(self) -> np.timedelta64:
+        return self.acquisition_end - self.acquisition_start
+
+    def next_acquisition_start(self) -> yardl.DateTime:
+        return self.acquisition_start + self.repetition_time
+
+    def exceeds_repetition_time(self) -> bool:
+        return self.elapsed() > self.repetition_time
+
+    def __eq__(self, other: object) -> bool:
+        return (
+            isinstance(other, RecordWithDurations)
+            and self.acquisition_start == other.acquisition_start
+            and self.acquisition_end == other.acquisition_end
+            and self.repetition_time == other.repetition_time
+            and self.timeout == other.timeout
+            and self.intervals == other.intervals
+        )
+
+    def __str__(self) -> str:
+        return f"RecordWithDurations(acquisition_start={self.acquisition_start}, acquisition_end={self.acquisition_end}, repetition_time={self.repetition_time}, timeout={self.timeout}, intervals={self.intervals})"
+
+    def __repr__(self) -> str:
+        return f"RecordWithDurations(acquisition_start={repr(self.acquisition_start)}, acquisition_end={repr(self.acquisition_end)}, repetition_time={repr(self.repetition_time)}, timeout={repr(self.timeout)}, intervals={repr(self.intervals)})"
+
+
+class TreeNode:
+    label: str
+    children: list["TreeNode"]
+
+    def __init__(self, *,
+        label: str = "",
+        children: typing.Optional[list["TreeNode"]] = None,
+    ):
+        self.label = label
+        self.children = children if children is not None else []
+
+    def __eq__(self, other: object) -> bool:
+        return (
+            isinstance(other, TreeNode)
+            and self.label == other.label
+            and self.children == other.children
+        )
+
+    def __str__(self) -> str:
+        return f"TreeNode(label={self.label}, children={self.children})"
+
+    def __repr__(self) -> str:
+        return f"TreeNode(label={repr(self.label)}, children={repr(self.children)})"
+
+
+class LinkedListNode:
+    value: yardl.Int32
+    next: typing.Optional["LinkedListNode"]
+
+    def __init__(self, *,
+        value: yardl.Int32 = 0,
+        next: typing.Optional["LinkedListNode"] = None,
+    ):
+        self.value = value
+        self.next = next
+
+    def __eq__(self, other: object) -> bool:
+        return (
+            isinstance(other, LinkedListNode)
+            and self.value == other.value
+            and self.next == other.next
+        )
+
+    def __str__(self) -> str:
+        return f"LinkedListNode(value={self.value}, next={self.next})"
+
+    def __repr__(self) -> str:
+        return f"LinkedListNode(value={repr(self.value)}, next={repr(self.next)})"
+
+
+_T = typing.TypeVar('_T')
+
+class Int32OrExpression:
+    Int32: typing.ClassVar[type["Int32OrExpressionUnionCase[yardl.Int32]"]]
+    Expression: typing.ClassVar[type["Int32OrExpressionUnionCase[Expression]"]]
+
+class Int32OrExpressionUnionCase(Int32OrExpression, yardl.UnionCase[_T]):
+    pass
+
+Int32OrExpression.Int32 = type("Int32OrExpression.Int32", (Int32OrExpressionUnionCase,), {"index": 0, "tag": "int32"})
+Int32OrExpression.Expression = type("Int32OrExpression.Expression", (Int32OrExpressionUnionCase,), {"index": 1, "tag": "Expression"})
+del Int32OrExpressionUnionCase
+
+class Expression:
+    name: str
+    operand: Int32OrExpression
+    arguments: dict[str, "Expression"]
+
+    def __init__(self, *,
+        name: str = "",
+        operand: Int32OrExpression = Int32OrExpression.Int32(0),
+        arguments: typing.Optional[dict[str, "Expression"]] = None,
+    ):
+        self.name = name
+        self.operand = operand
+        self.arguments = arguments if arguments is not None else {}
+
+    def __eq__(self, other: object) -> bool:
+        return (
+            isinstance(other, Expression)
+            and self.name == other.name
+            and self.operand == other.operand
+            and self.arguments == other.arguments
+        )
+
+    def __str__(self) -> str:
+        return f"Expression(name={self.name}, operand={self.operand}, arguments={self.arguments})"
+
+    def __repr__(self) -> str:
+        return f"Expression(name={repr(self.name)}, operand={repr(self.operand)}, arguments={repr(self.arguments)})"
+
+
+class RecordWithHalfPrecision:
+    half: yardl.Float16
+    brain: yardl.BFloat16
+    half_vector: list[yardl.Float16]
+    brain_array: npt.NDArray[np.float32]
+
+    def __init__(self, *,
+        half: yardl.Float16 = 0.0,
+        brain: yardl.BFloat16 = 0.0,
+        half_vector: typing.Optional[list[yardl.Float16]] = None,
+        brain_array: typing.Optional[npt.NDArray[np.float32]] = None,
+    ):
+        self.half = half
+        self.brain = brain
+        self.half_vector = half_vector if half_vector is not None else []
+        self.brain_array = brain_array if brain_array is not None else np.zeros((), dtype=np.dtype(np.float32))
+
+    def __eq__(self, other: object) -> bool:
+        return (
+            isinstance(other, RecordWithHalfPrecision)
+            and self.half == other.half
+            and self.brain == other.brain
+            and self.half_vector == other.half_vector
+            and yardl.structural_equal(self.brain_array, other.brain_array)
+        )
+
+    def __str__(self) -> str:
+        return f"RecordWithHalfPrecision(half={self.half}, brain={self.brain}, half_vector={self.half_vector}, brain_array={self.brain_array})"
+
+    def __repr__(self) -> str:
+        return f"RecordWithHalfPrecision(half={repr(self.half)}, brain={repr(self.brain)}, half_vector={repr(self.half_vector)}, brain_array={repr(self.brain_array)})"
+
+
 class RecordWithOptionalVector:
     optional_vector: typing.Optional[list[yardl.Int32]]
 
@@ -823,8 +1065,6 @@ class RecordWithVlenCollections:
         return f"RecordWithVlenCollections(vector={repr(self.vector)}, array={repr(self.array)})"
 
 
-_T = typing.TypeVar('_T')
-
 class MapOrScalar:
     Map: typing.ClassVar[type["MapOrScalarUnionCase[dict[str, yardl.Int32]]"]]
     Scalar: typing.ClassVar[type["MapOrScalarUnionCase[yardl.Int32]"]]
@@ -1454,9 +1694,9 @@ class RecordContainingVectorsOfAliases:
         return (
             isinstance(other, RecordContainingVectorsOfAliases)
             and self.strings == other.strings
-            and len(self.maps) == len(other.maps) and all(a == b for a, b in zip(self.maps, other.maps))
+            and self.maps == other.maps
             and len(self.arrays) == len(other.arrays) and all(yardl.structural_equal(a, b) for a, b in zip(self.arrays, other.arrays))
-            and len(self.tuples) == len(other.tuples) and all(a == b for a, b in zip(self.tuples, other.tuples))
+            and self.tuples == other.tuples
         )
 
     def __str__(self) -> str:
@@ -1871,6 +2111,60 @@ class RecordWithComputedFields:
     def arithmetic_11(self) -> yardl.Float64:
         return -(4.3 + float(1))
 
+    def comparison_1(self) -> bool:
+        return self.int_field > 10
+
+    def comparison_2(self) -> bool:
+        return self.vector_size() <= 3
+
+    def comparison_3(self) -> bool:
+        return self.string_field == "hello"
+
+    def comparison_4(self) -> bool:
+        return self.float32_field != float(self.int_field)
+
+    def logical_1(self) -> bool:
+        return self.int_field > 0 and self.vector_size() > 0
+
+    def logical_2(self) -> bool:
+        return not (self.int_field > 0) or self.string_field != "hello"
+
+    def conditional_1(self) -> yardl.Int32:
+        return (self.vector_field[0] if self.vector_size() > 0 else -1)
+
+    def conditional_2(self) -> str:
+        return ("positive" if self.int_field > 0 else ("zero" if self.int_field == 0 else "negative"))
+
+    def call_min(self) -> yardl.Int32:
+        return min(self.int_field, int(self.int8_field))
+
+    def call_max(self) -> yardl.Float32:
+        return max(self.float32_field, float(2))
+
+    def call_abs(self) -> yardl.Int32:
+        return abs(self.int_field)
+
+    def call_sqrt(self) -> yardl.Float64:
+        return np.sqrt(float(self.uint32_field))
+
+    def call_floor(self) -> yardl.Float64:
+        return np.floor(self.float64_field)
+
+    def call_ceil(self) -> yardl.Float64:
+        return np.ceil(self.float64_field)
+
+    def call_sum(self) -> yardl.Int32:
+        return yardl.checked_sum(self.vector_field, np.int32)
+
+    def call_length(self) -> yardl.Size:
+        return len(self.string_field.encode("utf-8"))
+
+    def call_contains(self) -> bool:
+        return ("hello" in self.map_field)
+
+    def call_has_value(self) -> bool:
+        return (self.optional_named_array is not None)
+
     def cast_int_to_float(self) -> yardl.Float32:
         return float(self.int_field)
 
@@ -1929,6 +2223,209 @@ class RecordWithComputedFields:
         return f"RecordWithComputedFields(array_field={repr(self.array_field)}, array_field_map_dimensions={repr(self.array_field_map_dimensions)}, dynamic_array_field={repr(self.dynamic_array_field)}, fixed_array_field={repr(self.fixed_array_field)}, int_field={repr(self.int_field)}, int8_field={repr(self.int8_field)}, uint8_field={repr(self.uint8_field)}, int16_field={repr(self.int16_field)}, uint16_field={repr(self.uint16_field)}, uint32_field={repr(self.uint32_field)}, int64_field={repr(self.int64_field)}, uint64_field={repr(self.uint64_field)}, size_field={repr(self.size_field)}, float32_field={repr(self.float32_field)}, float64_field={repr(self.float64_field)}, complexfloat32_field={repr(self.complexfloat32_field)}, complexfloat64_field={repr(self.complexfloat64_field)}, string_field={repr(self.string_field)}, tuple_field={repr(self.tuple_field)}, vector_field={repr(self.vector_field)}, vector_of_vectors_field={repr(self.vector_of_vectors_field)}, fixed_vector_field={repr(self.fixed_vector_field)}, fixed_vector_of_vectors_field={repr(self.fixed_vector_of_vectors_field)}, optional_named_array={repr(self.optional_named_array)}, int_float_union={repr(self.int_float_union)}, nullable_int_float_union={repr(self.nullable_int_float_union)}, union_with_nested_generic_union={repr(self.union_with_nested_generic_union)}, map_field={repr(self.map_field)})"
 
 
+class RecordWithDefaults:
+    int_field: yardl.Int32
+    int8_field: yardl.Int8
+    uint64_field: yardl.UInt64
+    float32_field: yardl.Float32
+    float64_field: yardl.Float64
+    complexfloat64_field: yardl.ComplexDouble
+    bool_field: bool
+    string_field: str
+    enum_field: Fruits
+    no_default_field: yardl.Int32
+
+    def __init__(self, *,
+        int_field: yardl.Int32 = 42,
+        int8_field: yardl.Int8 = -7,
+        uint64_field: yardl.UInt64 = 9223372036854775808,
+        float32_field: yardl.Float32 = 1.5,
+        float64_field: yardl.Float64 = float(2 * 4),
+        complexfloat64_field: yardl.ComplexDouble = complex(float(3)),
+        bool_field: bool = True,
+        string_field: str = "hello",
+        enum_field: Fruits = Fruits.PEAR,
+        no_default_field: yardl.Int32 = 0,
+    ):
+        self.int_field = int_field
+        self.int8_field = int8_field
+        self.uint64_field = uint64_field
+        self.float32_field = float32_field
+        self.float64_field = float64_field
+        self.complexfloat64_field = complexfloat64_field
+        self.bool_field = bool_field
+        self.string_field = string_field
+        self.enum_field = enum_field
+        self.no_default_field = no_default_field
+
+    def __eq__(self, other: object) -> bool:
+        return (
+            isinstance(other, RecordWithDefaults)
+            and self.int_field == other.int_field
+            and self.int8_field == other.int8_field
+            and self.uint64_field == other.uint64_field
+            and self.float32_field == other.float32_field
+            and self.float64_field == other.float64_field
+            and self.complexfloat64_field == other.complexfloat64_field
+            and self.bool_field == other.bool_field
+            and self.string_field == other.string_field
+            and self.enum_field == other.enum_field
+            and self.no_default_field == other.no_default_field
+        )
+
+    def __str__(self) -> str:
+        return f"RecordWithDefaults(int_field={self.int_field}, int8_field={self.int8_field}, uint64_field={self.uint64_field}, float32_field={self.float32_field}, float64_field={self.float64_field}, complexfloat64_field={self.complexfloat64_field}, bool_field={self.bool_field}, string_field={self.string_field}, enum_field={self.enum_field}, no_default_field={self.no_default_field})"
+
+    def __repr__(self) -> str:
+        return f"RecordWithDefaults(int_field={repr(self.int_field)}, int8_field={repr(self.int8_field)}, uint64_field={repr(self.uint64_field)}, float32_field={repr(self.float32_field)}, float64_field={repr(self.float64_field)}, complexfloat64_field={repr(self.complexfloat64_field)}, bool_field={repr(self.bool_field)}, string_field={repr(self.string_field)}, enum_field={repr(self.enum_field)}, no_default_field={repr(self.no_default_field)})"
+
+
+class RecordWithConstraints:
+    size: yardl.UInt32
+    gain: yardl.Float32
+    name: str
+    limit: typing.Optional[yardl.Int32]
+    samples: list[yardl.Int32]
+
+    def __init__(self, *,
+        size: yardl.UInt32 = 0,
+        gain: yardl.Float32 = 0.0,
+        name: str = "",
+        limit: typing.Optional[yardl.Int32] = None,
+        samples: typing.Optional[list[yardl.Int32]] = None,
+    ):
+        self.size = size
+        self.gain = gain
+        self.name = name
+        self.limit = limit
+        self.samples = samples if samples is not None else []
+
+    def validate(self) -> None:
+        """Raises ValueError if a field does not satisfy its constraints."""
+
+        if self.size < 1:
+            raise ValueError("RecordWithConstraints.size must be at least 1")
+        if self.size > 4096:
+            raise ValueError("RecordWithConstraints.size must be at most 4096")
+        if self.gain < 0.0:
+            raise ValueError("RecordWithConstraints.gain must be at least 0.0")
+        if self.gain > 2.5:
+            raise ValueError("RecordWithConstraints.gain must be at most 2.5")
+        if re.fullmatch("[a-z][a-z0-9_]*", self.name, re.ASCII) is None:
+            raise ValueError("RecordWithConstraints.name must match the pattern '[a-z][a-z0-9_]*'")
+        if self.limit is not None and self.limit > 10:
+            raise ValueError("RecordWithConstraints.limit must be at most 10")
+        if len(self.samples) < 1:
+            raise ValueError("RecordWithConstraints.samples must have a length of at least 1")
+        if len(self.samples) > 4:
+            raise ValueError("RecordWithConstraints.samples must have a length of at most 4")
+
+    def __eq__(self, other: object) -> bool:
+        return (
+            isinstance(other, RecordWithConstraints)
+            and self.size == other.size
+            and self.gain == other.gain
+            and self.name == other.name
+            and self.limit == other.limit
+            and self.samples == other.samples
+        )
+
+    def __str__(self) -> str:
+        return f"RecordWithConstraints(size={self.size}, gain={self.gain}, name={self.name}, limit={self.limit}, samples={self.samples})"
+
+    def __repr__(self) -> str:
+        return f"RecordWithConstraints(size={repr(self.size)}, gain={repr(self.gain)}, name={repr(self.name)}, limit={repr(self.limit)}, samples={repr(self.samples)})"
+
+
+class RecordWithPatterns:
+    alternation: str
+    word: str
+    code: str
+    escaped: str
+    capitalized: str
+
+    def __init__(self, *,
+        alternation: str = "",
+        word: str = "",
+        code: str = "",
+        escaped: str = "",
+        capitalized: str = "",
+    ):
+        self.alternation = alternation
+        self.word = word
+        self.code = code
+        self.escaped = escaped
+        self.capitalized = capitalized
+
+    def validate(self) -> None:
+        """Raises ValueError if a field does not satisfy its constraints."""
+
+        if re.fullmatch("a|ab", self.alternation, re.ASCII) is None:
+            raise ValueError("RecordWithPatterns.alternation must match the pattern 'a|ab'")
+        if re.fullmatch("\\w+", self.word, re.ASCII) is None:
+            raise ValueError("RecordWithPatterns.word must match the pattern '\\w+'")
+        if re.fullmatch("\\d{2,3}(?:\\-\\d{2})?", self.code, re.ASCII) is None:
+            raise ValueError("RecordWithPatterns.code must match the pattern '\\d{2,3}(?:\\-\\d{2})?'")
+        if re.fullmatch("\\(\\.\\)[\\-+*]", self.escaped, re.ASCII) is None:
+            raise ValueError("RecordWithPatterns.escaped must match the pattern '\\(\\.\\)[\\-+*]'")
+        if re.fullmatch("[A-Z][a-z]*", self.capitalized, re.ASCII) is None:
+            raise ValueError("RecordWithPatterns.capitalized must match the pattern '[A-Z][a-z]*'")
+
+    def __eq__(self, other: object) -> bool:
+        return (
+            isinstance(other, RecordWithPatterns)
+            and self.alternation == other.alternation
+            and self.word == other.word
+            and self.code == other.code
+            and self.escaped == other.escaped
+            and self.capitalized == other.capitalized
+        )
+
+    def __str__(self) -> str:
+        return f"RecordWithPatterns(alternation={self.alternation}, word={self.word}, code={self.code}, escaped={self.escaped}, capitalized={self.capitalized})"
+
+    def __repr__(self) -> str:
+        return f"RecordWithPatterns(alternation={repr(self.alternation)}, word={repr(self.word)}, code={repr(self.code)}, escaped={repr(self.escaped)}, capitalized={repr(self.capitalized)})"
+
+
+class RecordWithConstrainedRecords:
+    single: RecordWithConstraints
+    optional: typing.Optional[RecordWithConstraints]
+    vector: list[RecordWithConstraints]
+
+    def __init__(self, *,
+        single: typing.Optional[RecordWithConstraints] = None,
+        optional: typing.Optional[RecordWithConstraints] = None,
+        vector: typing.Optional[list[RecordWithConstraints]] = None,
+    ):
+        self.single = single if single is not None else RecordWithConstraints()
+        self.optional = optional
+        self.vector = vector if vector is not None else []
+
+    def validate(self) -> None:
+        """Raises ValueError if a field does not satisfy its constraints."""
+
+        self.single.validate()
+        if self.optional is not None:
+            self.optional.validate()
+        for item0 in self.vector:
+            item0.validate()
+
+    def __eq__(self, other: object) -> bool:
+        return (
+            isinstance(other, RecordWithConstrainedRecords)
+            and self.single == other.single
+            and self.optional == other.optional
+            and self.vector == other.vector
+        )
+
+    def __str__(self) -> str:
+        return f"RecordWithConstrainedRecords(single={self.single}, optional={self.optional}, vector={self.vector})"
+
+    def __repr__(self) -> str:
+        return f"RecordWithConstrainedRecords(single={repr(self.single)}, optional={repr(self.optional)}, vector={repr(self.vector)})"
+
+
 class GenericUnionWithRepeatedTypeParameters(typing.Generic[T, T_NP]):
     T: typing.ClassVar[type["GenericUnionWithRepeatedTypeParametersUnionCase[T, T_NP, T]"]] # type: ignore
     Tv: typing.ClassVar[type["GenericUnionWithRepeatedTypeParametersUnionCase[T, T_NP, list[T]]"]] # type: ignore
@@ -2060,6 +2557,237 @@ class RecordWithOptionalDate:
         return f"RecordWithOptionalDate(date_field={repr(self.date_field)})"
 
 
+class RecordWithConstants:
+    channels: list[yardl.Int32]
+    samples: npt.NDArray[np.float32]
+    gain: yardl.Float32
+
+    def __init__(self, *,
+        channels: typing.Optional[list[yardl.Int32]] = None,
+        samples: typing.Optional[npt.NDArray[np.float32]] = None,
+        gain: yardl.Float32 = DEFAULT_GAIN,
+    ):
+        self.channels = channels if channels is not None else [0] * 4
+        self.samples = samples if samples is not None else np.zeros((4, 8,), dtype=np.dtype(np.float32))
+        self.gain = gain
+
+    def channel_count(self) -> yardl.Int32:
+        return MAX_CHANNELS
+
+    def sample_count(self) -> yardl.Int64:
+        return int(MAX_CHANNELS) * int(SAMPLES_PER_CHANNEL)
+
+    def scaled_gain(self) -> yardl.Float32:
+        return self.gain * DEFAULT_GAIN
+
+    def strict(self) -> bool:
+        return IS_STRICT
+
+    def __eq__(self, other: object) -> bool:
+        return (
+            isinstance(other, RecordWithConstants)
+            and self.channels == other.channels
+            and yardl.structural_equal(self.samples, other.samples)
+            and self.gain == other.gain
+        )
+
+    def __str__(self) -> str:
+        return f"RecordWithConstants(channels={self.channels}, samples={self.samples}, gain={self.gain})"
+
+    def __repr__(self) -> str:
+        return f"RecordWithConstants(channels={repr(self.channels)}, samples={repr(self.samples)}, gain={repr(self.gain)})"
+
+
+class RecordHeader:
+    """A common header"""
+
+    version: yardl.UInt32
+    name: str
+
+    def __init__(self, *,
+        version: yardl.UInt32 = 0,
+        name: str = "header",
+    ):
+        self.version = version
+        self.name = name
+
+    def is_first_version(self) -> bool:
+        return self.version == 1
+
+    def __eq__(self, other: object) -> bool:
+        return (
+            isinstance(other, RecordHeader)
+            and self.version == other.version
+            and self.name == other.name
+        )
+
+    def __str__(self) -> str:
+        return f"RecordHeader(version={self.version}, name={self.name})"
+
+    def __repr__(self) -> str:
+        return f"RecordHeader(version={repr(self.version)}, name={repr(self.name)})"
+
+
+class LabeledHeader(typing.Generic[T]):
+    label: T
+
+    def __init__(self, *,
+        label: T,
+    ):
+        self.label = label
+
+    def __eq__(self, other: object) -> bool:
+        return (
+            isinstance(other, LabeledHeader)
+            and yardl.structural_equal(self.label, other.label)
+        )
+
+    def __str__(self) -> str:
+        return f"LabeledHeader(label={self.label})"
+
+    def __repr__(self) -> str:
+        return f"LabeledHeader(label={repr(self.label)})"
+
+
+class RecordWithBase(RecordHeader):
+    data: list[yardl.Float32]
+
+    def __init__(self, *,
+        version: yardl.UInt32 = 0,
+        name: str = "header",
+        data: typing.Optional[list[yardl.Float32]] = None,
+    ):
+        self.version = version
+        self.name = name
+        self.data = data if data is not None else []
+
+    def data_size(self) -> yardl.Size:
+        return len(self.data)
+
+    def __eq__(self, other: object) -> bool:
+        return (
+            isinstance(other, RecordWithBase)
+            and self.version == other.version
+            and self.name == other.name
+            and self.data == other.data
+        )
+
+    def __str__(self) -> str:
+        return f"RecordWithBase(version={self.version}, name={self.name}, data={self.data})"
+
+    def __repr__(self) -> str:
+        return f"RecordWithBase(version={repr(self.version)}, name={repr(self.name)}, data={repr(self.data)})"
+
+
+class RecordWithBases(RecordHeader, LabeledHeader[str]):
+    value: yardl.Int32
+
+    def __init__(self, *,
+        version: yardl.UInt32 = 0,
+        name: str = "header",
+        label: str = "",
+        value: yardl.Int32 = 0,
+    ):
+        self.version = version
+        self.name = name
+        self.label = label
+        self.value = value
+
+    def __eq__(self, other: object) -> bool:
+        return (
+            isinstance(other, RecordWithBases)
+            and self.version == other.version
+            and self.name == other.name
+            and self.label == other.label
+            and self.value == other.value
+        )
+
+    def __str__(self) -> str:
+        return f"RecordWithBases(version={self.version}, name={self.name}, label={self.label}, value={self.value})"
+
+    def __repr__(self) -> str:
+        return f"RecordWithBases(version={repr(self.version)}, name={repr(self.name)}, label={repr(self.label)}, value={repr(self.value)})"
+
+
+class RecordWithAnnotations:
+    """A slice with user-defined annotations
+    Annotations: dicomModule="Image Plane", version=2
+    """
+
+    thickness: typing.Annotated[yardl.Float32, {"dicomTag": "(0018,0050)", "units": "mm"}]
+    spacing: typing.Annotated[yardl.Float32, {"deprecated": True, "scale": 1.0, "units": "mm"}]
+    label: str
+
+    def __init__(self, *,
+        thickness: yardl.Float32 = 0.0,
+        spacing: yardl.Float32 = 1.5,
+        label: str = "",
+    ):
+        self.thickness = thickness
+        self.spacing = spacing
+        self.label = label
+
+    def __eq__(self, other: object) -> bool:
+        return (
+            isinstance(other, RecordWithAnnotations)
+            and self.thickness == other.thickness
+            and self.spacing == other.spacing
+            and self.label == other.label
+        )
+
+    def __str__(self) -> str:
+        return f"RecordWithAnnotations(thickness={self.thickness}, spacing={self.spacing}, label={self.label})"
+
+    def __repr__(self) -> str:
+        return f"RecordWithAnnotations(thickness={repr(self.thickness)}, spacing={repr(self.spacing)}, label={repr(self.label)})"
+
+
+class EnumWithAnnotations(yardl.OutOfRangeEnum):
+    """Annotations: deprecated=true"""
+
+    A = 0
+    B = 1
+
+Millimeters = yardl.Float32
+
+class RecordWithUnits:
+    length: yardl.Float32
+    width: Millimeters
+    durations: list[yardl.Float64]
+
+    def __init__(self, *,
+        length: yardl.Float32 = 0.0,
+        width: Millimeters = 0.0,
+        durations: typing.Optional[list[yardl.Float64]] = None,
+    ):
+        self.length = length
+        self.width = width
+        self.durations = durations if durations is not None else []
+
+    def perimeter(self) -> yardl.Float32:
+        return float(2) * (self.length + self.width)
+
+    def area(self) -> yardl.Float32:
+        return self.length * self.width
+
+    def total_duration(self) -> yardl.Float64:
+        return sum(self.durations)
+
+    def __eq__(self, other: object) -> bool:
+        return (
+            isinstance(other, RecordWithUnits)
+            and self.length == other.length
+            and self.width == other.width
+            and self.durations == other.durations
+        )
+
+    def __str__(self) -> str:
+        return f"RecordWithUnits(length={self.length}, width={self.width}, durations={self.durations})"
+
+    def __repr__(self) -> str:
+        return f"RecordWithUnits(length={repr(self.length)}, width={repr(self.width)}, durations={repr(self.durations)})"
+
+
 class AcquisitionOrImage:
     Acquisition: typing.ClassVar[type["AcquisitionOrImageUnionCase[SimpleAcquisition]"]]
     Image: typing.ClassVar[type["AcquisitionOrImageUnionCase[image.Image[np.float32]]"]]
@@ -2121,6 +2849,28 @@ ImageFloatOrImageDouble.ImageFloat = type("ImageFloatOrImageDouble.ImageFloat",
 ImageFloatOrImageDouble.ImageDouble = type("ImageFloatOrImageDouble.ImageDouble", (ImageFloatOrImageDoubleUnionCase,), {"index": 1, "tag": "imageDouble"})
 del ImageFloatOrImageDoubleUnionCase
 
+class Float32OrString:
+    Float32: typing.ClassVar[type["Float32OrStringUnionCase[yardl.Float32]"]]
+    String: typing.ClassVar[type["Float32OrStringUnionCase[str]"]]
+
+class Float32OrStringUnionCase(Float32OrString, yardl.UnionCase[_T]):
+    pass
+
+Float32OrString.Float32 = type("Float32OrString.Float32", (Float32OrStringUnionCase,), {"index": 0, "tag": "float32"})
+Float32OrString.String = type("Float32OrString.String", (Float32OrStringUnionCase,), {"index": 1, "tag": "string"})
+del Float32OrStringUnionCase
+
+class Complexfloat32OrString:
+    Complexfloat32: typing.ClassVar[type["Complexfloat32OrStringUnionCase[yardl.ComplexFloat]"]]
+    String: typing.ClassVar[type["Complexfloat32OrStringUnionCase[str]"]]
+
+class Complexfloat32OrStringUnionCase(Complexfloat32OrString, yardl.UnionCase[_T]):
+    pass
+
+Complexfloat32OrString.Complexfloat32 = type("Complexfloat32OrString.Complexfloat32", (Complexfloat32OrStringUnionCase,), {"index": 0, "tag": "complexfloat32"})
+Complexfloat32OrString.String = type("Complexfloat32OrString.String", (Complexfloat32OrStringUnionCase,), {"index": 1, "tag": "string"})
+del Complexfloat32OrStringUnionCase
+
 def _mk_get_dtype():
     dtype_map: dict[typing.Union[type, types.GenericAlias, typing.Annotated[typing.Any, typing.Any]], typing.Union[np.dtype[typing.Any], typing.Callable[[tuple[type, ...]], np.dtype[typing.Any]]]] = {}
     get_dtype = _dtypes.make_get_dtype_func(dtype_map)
@@ -2160,6 +2910,16 @@ def _mk_get_dtype():
     dtype_map.setdefault(RecordWithOptionalFields, np.dtype([('optional_int', np.dtype([('has_value', np.dtype(np.bool_)), ('value', np.dtype(np.int32))], align=True)), ('optional_int_alternate_syntax', np.dtype([('has_value', np.dtype(np.bool_)), ('value', np.dtype(np.int32))], align=True)), ('optional_time', np.dtype([('has_value', np.dtype(np.bool_)), ('value', np.dtype(np.timedelta64))], align=True))], align=True))
     dtype_map.setdefault(RecordWithVlens, np.dtype([('a', np.dtype(np.object_)), ('b', np.dtype(np.int32)), ('c', np.dtype(np.int32))], align=True))
     dtype_map.setdefault(RecordWithStrings, np.dtype([('a', np.dtype(np.object_)), ('b', np.dtype(np.object_))], align=True))
+    dtype_map.setdefault(RecordWithBytes, np.dtype([('data', np.dtype(np.object_)), ('optional_data', np.dtype([('has_value', np.dtype(np.bool_)), ('value', np.dtype(np.object_))], align=True)), ('chunks', np.dtype(np.object_))], align=True))
+    dtype_map.setdefault(RecordWithUuids, np.dtype([('id', np.dtype(np.object_)), ('optional_id', np.dtype([('has_value', np.dtype(np.bool_)), ('value', np.dtype(np.object_))], align=True)), ('related', np.dtype(np.object_)), ('names', np.dtype(np.object_))], align=True))
+    dtype_map.setdefault(RecordWithDurations, np.dtype([('acquisition_start', np.dtype(np.datetime64)), ('acquisition_end', np.dtype(np.datetime64)), ('repetition_time', np.dtype(np.timedelta64)), ('timeout', np.dtype([('has_value', np.dtype(np.bool_)), ('value', np.dtype(np.timedelta64))], align=True)), ('intervals', np.dtype(np.object_))], align=True))
+    dtype_map.setdefault(TreeNode, np.dtype([('label', np.dtype(np.object_)), ('children', np.dtype(np.object_))], align=True))
+    dtype_map.setdefault(LinkedListNode, np.dtype([('value', np.dtype(np.int32)), ('next', np.dtype([('has_value', np.dtype(np.bool_)), ('value', np.dtype(np.object_))], align=True))], align=True))
+    dtype_map.setdefault(Expression, np.dtype([('name', np.dtype(np.object_)), ('operand', np.dtype(np.object_)), ('arguments', np.dtype(np.object_))], align=True))
+    dtype_map.setdefault(Int32OrExpression, np.dtype(np.object_))
+    dtype_map.setdefault(Int32OrExpression.Int32, np.dtype(np.int32))
+    dtype_map.setdefault(Int32OrExpression.Expression, np.dtype(np.object_))
+    dtype_map.setdefault(RecordWithHalfPrecision, np.dtype([('half', np.dtype(np.float16)), ('brain', np.dtype(np.float32)), ('half_vector', np.dtype(np.object_)), ('brain_array', np.dtype(np.object_))], align=True))
     dtype_map.setdefault(RecordWithOptionalVector, np.dtype([('optional_vector', np.dtype([('has_value', np.dtype(np.bool_)), ('value', np.dtype(np.object_))], align=True))], align=True))
     dtype_map.setdefault(RecordWithFixedVectors, np.dtype([('fixed_int_vector', np.dtype(np.int32), (5,)), ('fixed_simple_record_vector', get_dtype(SimpleRecord), (3,)), ('fixed_record_with_vlens_vector', get_dtype(RecordWithVlens), (2,))], align=True))
     dtype_map.setdefault(RecordWithFixedArrays, np.dtype([('ints', np.dtype(np.int32), (2, 3,)), ('fixed_simple_record_array', get_dtype(SimpleRecord), (3, 2,)), ('fixed_record_with_vlens_array', get_dtype(RecordWithVlens), (2, 2,))], align=True))
@@ -2234,6 +2994,10 @@ def _mk_get_dtype():
     dtype_map.setdefault(IntOrGenericRecordWithComputedFields, np.dtype(np.object_))
     dtype_map.setdefault(IntOrGenericRecordWithComputedFields.Int, np.dtype(np.int32))
     dtype_map.setdefault(IntOrGenericRecordWithComputedFields.GenericRecordWithComputedFields, get_dtype(types.GenericAlias(basic_types.GenericRecordWithComputedFields, (str, yardl.Float32,))))
+    dtype_map.setdefault(RecordWithDefaults, np.dtype([('int_field', np.dtype(np.int32)), ('int8_field', np.dtype(np.int8)), ('uint64_field', np.dtype(np.uint64)), ('float32_field', np.dtype(np.float32)), ('float64_field', np.dtype(np.float64)), ('complexfloat64_field', np.dtype(np.complex128)), ('bool_field', np.dtype(np.bool_)), ('string_field', np.dtype(np.object_)), ('enum_field', get_dtype(basic_types.Fruits)), ('no_default_field', np.dtype(np.int32))], align=True))
+    dtype_map.setdefault(RecordWithConstraints, np.dtype([('size', np.dtype(np.uint32)), ('gain', np.dtype(np.float32)), ('name', np.dtype(np.object_)), ('limit', np.dtype([('has_value', np.dtype(np.bool_)), ('value', np.dtype(np.int32))], align=True)), ('samples', np.dtype(np.object_))], align=True))
+    dtype_map.setdefault(RecordWithPatterns, np.dtype([('alternation', np.dtype(np.object_)), ('word', np.dtype(np.object_)), ('code', np.dtype(np.object_)), ('escaped', np.dtype(np.object_)), ('capitalized', np.dtype(np.object_))], align=True))
+    dtype_map.setdefault(RecordWithConstrainedRecords, np.dtype([('single', get_dtype(RecordWithConstraints)), ('optional', np.dtype([('has_value', np.dtype(np.bool_)), ('value', get_dtype(RecordWithConstraints))], align=True)), ('vector', np.dtype(np.object_))], align=True))
     dtype_map.setdefault(GenericUnionWithRepeatedTypeParameters, lambda type_args: np.dtype(np.object_))
     dtype_map.setdefault(GenericUnion3, lambda type_args: np.dtype(np.object_))
     dtype_map.setdefault(GenericUnion3Alternate, lambda type_args: np.dtype(np.object_))
@@ -2241,6 +3005,15 @@ def _mk_get_dtype():
     dtype_map.setdefault(EnumWithKeywordSymbols, np.dtype(np.int32))
     dtype_map.setdefault(RecordWithKeywordFields, np.dtype([('int_', np.dtype(np.object_)), ('sizeof', np.dtype(np.object_)), ('if_', get_dtype(EnumWithKeywordSymbols))], align=True))
     dtype_map.setdefault(RecordWithOptionalDate, np.dtype([('date_field', np.dtype([('has_value', np.dtype(np.bool_)), ('value', np.dtype(np.datetime64))], align=True))], align=True))
+    dtype_map.setdefault(RecordWithConstants, np.dtype([('channels', np.dtype(np.int32), (4,)), ('samples', np.dtype(np.float32), (4, 8,)), ('gain', np.dtype(np.float32))], align=True))
+    dtype_map.setdefault(RecordHeader, np.dtype([('version', np.dtype(np.uint32)), ('name', np.dtype(np.object_))], align=True))
+    dtype_map.setdefault(LabeledHeader, lambda type_args: np.dtype([('label', get_dtype(type_args[0]))], align=True))
+    dtype_map.setdefault(RecordWithBase, np.dtype([('version', np.dtype(np.uint32)), ('name', np.dtype(np.object_)), ('data', np.dtype(np.object_))], align=True))
+    dtype_map.setdefault(RecordWithBases, np.dtype([('version', np.dtype(np.uint32)), ('name', np.dtype(np.object_)), ('label', np.dtype(np.object_)), ('value', np.dtype(np.int32))], align=True))
+    dtype_map.setdefault(RecordWithAnnotations, np.dtype([('thickness', np.dtype(np.float32)), ('spacing', np.dtype(np.float32)), ('label', np.dtype(np.object_))], align=True))
+    dtype_map.setdefault(EnumWithAnnotations, np.dtype(np.int32))
+    dtype_map.setdefault(Millimeters, np.dtype(np.float32))
+    dtype_map.setdefault(RecordWithUnits, np.dtype([('length', np.dtype(np.float32)), ('width', np.dtype(np.float32)), ('durations', np.dtype(np.object_))], align=True))
     dtype_map.setdefault(AcquisitionOrImage, np.dtype(np.object_))
     dtype_map.setdefault(AcquisitionOrImage.Acquisition, get_dtype(SimpleAcquisition))
     dtype_map.setdefault(AcquisitionOrImage.Image, np.dtype(np.object_))
@@ -2259,6 +3032,12 @@ def _mk_get_dtype():
     dtype_map.setdefault(ImageFloatOrImageDouble, np.dtype(np.object_))
     dtype_map.setdefault(ImageFloatOrImageDouble.ImageFloat, np.dtype(np.object_))
     dtype_map.setdefault(ImageFloatOrImageDouble.ImageDouble, np.dtype(np.object_))
+    dtype_map.setdefault(Float32OrString, np.dtype(np.object_))
+    dtype_map.setdefault(Float32OrString.Float32, np.dtype(np.float32))
+    dtype_map.setdefault(Float32OrString.String, np.dtype(np.object_))
+    dtype_map.setdefault(Complexfloat32OrString, np.dtype(np.object_))
+    dtype_map.setdefault(Complexfloat32OrString.Complexfloat32, np.dtype(np.complex64))
+    dtype_map.setdefault(Complexfloat32OrString.String, np.dtype(np.object_))
 
     return get_dtype
 
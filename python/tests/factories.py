@@ -41,6 +41,23 @@ _type_map = {
             ),
             cast(type, getattr(tm, "NDJson" + derived.__name__.removeprefix("Binary"))),
         ),
+        # Protocols with recursive records have no HDF5 implementation
+        (
+            cast(
+                type,
+                getattr(
+                    tm,
+                    "Hdf5"
+                    + derived.__name__.removeprefix("Binary").removesuffix("Writer")
+                    + "Reader",
+                    None,
+                ),
+            ),
+            cast(
+                type,
+                getattr(tm, "Hdf5" + derived.__name__.removeprefix("Binary"), None),
+            ),
+        ),
     )
     for base, derived in {
         [base for base in inspect.getmro(derived) if base.__name__.endswith("Base")][
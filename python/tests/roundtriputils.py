@@ -1,9 +1,13 @@
+import functools
 import io
+import json
 import pathlib
 import subprocess
 import types
-from typing import Callable, TypeVar, Union, cast
+from typing import Any, Callable, TypeVar, Union, cast
 
+import h5py
+import jsonschema
 import test_model as tm
 from .factories import Format
 from .factories import get_reader_writer_types
@@ -32,6 +36,54 @@ def invoke_translator(
         return cpp_output
 
 
+def in_memory_stream_class(format: Format) -> type[Union[io.BytesIO, io.StringIO]]:
+    return io.StringIO if format == Format.NDJSON else io.BytesIO
+
+
+class _Hdf5Buffer:
+    """An in-memory HDF5 file to write to, with the same getvalue() as the
+    in-memory streams used for the other formats."""
+
+    def __init__(self) -> None:
+        self._stream = io.BytesIO()
+        self.file = h5py.File(self._stream, "w")
+
+    def getvalue(self) -> bytes:
+        self.file.close()
+        return self._stream.getvalue()
+
+
+def _create_writer_buffer(format: Format) -> Any:
+    if format == Format.HDF5:
+        return _Hdf5Buffer()
+    return in_memory_stream_class(format)()
+
+
+def _writer_output(buffer: Any) -> Any:
+    return buffer.file if isinstance(buffer, _Hdf5Buffer) else buffer
+
+
+_json_schema_dir = (
+    pathlib.Path(__file__).parent / "../../jsonschema/generated"
+).resolve()
+
+
+@functools.cache
+def _get_ndjson_validator(protocol_name: str) -> jsonschema.Draft202012Validator:
+    schema_path = _json_schema_dir / f"TestModel.{protocol_name}.schema.json"
+    schema = json.loads(schema_path.read_text())
+    jsonschema.Draft202012Validator.check_schema(schema)
+    return jsonschema.Draft202012Validator(schema)
+
+
+def validate_ndjson_against_schema(ndjson: str, protocol_name: str) -> None:
+    """Validates each line of an NDJSON stream against the JSON Schema exported
+    for the protocol."""
+    validator = _get_ndjson_validator(protocol_name)
+    for line in ndjson.splitlines():
+        validator.validate(json.loads(line))
+
+
 T = TypeVar("T")
 
 
@@ -39,7 +91,8 @@ def create_validating_writer_class(
     format: Format, base_class: type[T]
 ) -> Callable[[], T]:
     reader_class, writer_class = get_reader_writer_types(format, base_class)
-    in_memory_stream_class = io.BytesIO if format == Format.BINARY else io.StringIO
+    protocol_name = base_class.__name__.removesuffix("WriterBase")
+    stream_class = in_memory_stream_class(format)
 
     write_methods = [
         cast(types.FunctionType, attr)
@@ -121,7 +174,9 @@ def create_validating_writer_class(
 
             self = args[0]
             this_buffer = self._buffer.getvalue()
-            validating_instance = validating_class(in_memory_stream_class())  # type: ignore
+            validating_instance = validating_class(  # type: ignore
+                _writer_output(_create_writer_buffer(format))
+            )
             validating_instance._recorded_arguments = (  # type: ignore
                 self._recorded_arguments
             )
@@ -131,34 +186,39 @@ def create_validating_writer_class(
         def validate(this_buffer, validating_instance):
             # 1. Validate that we get the same data when we read the output back in
 
-            reader = reader_class(in_memory_stream_class(this_buffer))
+            reader = reader_class(stream_class(this_buffer))
             reader.copy_to(validating_instance)
 
+            if format == Format.NDJSON:
+                validate_ndjson_against_schema(this_buffer, protocol_name)
+
             # 2. Now run the output though the C++ translator, and read its output back in
             # and validate that we get the same data
             cpp_output = invoke_translator(this_buffer, format, format)
+            if format == Format.NDJSON:
+                validate_ndjson_against_schema(cpp_output, protocol_name)  # type: ignore
 
-            reader = reader_class(in_memory_stream_class(cpp_output))  # type: ignore
+            reader = reader_class(stream_class(cpp_output))  # type: ignore
             reader.copy_to(validating_instance)
 
             # 3. Now use the translator to convert the output to the other format,
             # and then read it back in and make sure we get the same data.
 
-            other_format = Format.NDJSON if format == Format.BINARY else Format.BINARY
+            other_format = Format.BINARY if format == Format.NDJSON else Format.NDJSON
 
             cpp_output_other_format = invoke_translator(
                 this_buffer, format, other_format
             )
+            if other_format == Format.NDJSON:
+                validate_ndjson_against_schema(
+                    cpp_output_other_format, protocol_name  # type: ignore
+                )
 
             other_format_reader_class = get_reader_writer_types(
                 other_format, base_class
             )[0]
-            in_memory_stream_class_other_format = (
-                io.BytesIO if other_format == Format.BINARY else io.StringIO
-            )
-
             reader = other_format_reader_class(
-                in_memory_stream_class_other_format(
+                in_memory_stream_class(other_format)(
                     cpp_output_other_format  # type: ignore
                 )
             )
@@ -169,9 +229,10 @@ def create_validating_writer_class(
         def init_wrapper(*args, **kwargs):
             recorded_args = {}
             args[0]._recorded_arguments = recorded_args
-            buf = in_memory_stream_class()
+            buf = _create_writer_buffer(format)
             args[0]._buffer = buf
-            return writer_class.__init__(args[0], buf, **kwargs)  # type: ignore
+            output = _writer_output(buf)
+            return writer_class.__init__(args[0], output, **kwargs)  # type: ignore
 
         attrs["__init__"] = init_wrapper
 
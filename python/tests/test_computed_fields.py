@@ -195,6 +195,86 @@ def test_arithmetic():
     assert r.arithmetic_10() == 1e10 + 9e9
 
 
+def test_comparison_and_logical_operators():
+    r = tm.RecordWithComputedFields()
+    assert not r.comparison_1()
+    assert r.comparison_2()
+    assert not r.comparison_3()
+    assert not r.comparison_4()
+    assert not r.logical_1()
+    assert r.logical_2()
+
+    r.int_field = 42
+    r.vector_field = [1, 2, 3, 4]
+    r.string_field = "hello"
+    r.float32_field = 42.5
+    assert r.comparison_1()
+    assert not r.comparison_2()
+    assert r.comparison_3()
+    assert r.comparison_4()
+    assert r.logical_1()
+    assert not r.logical_2()
+
+
+def test_conditional():
+    r = tm.RecordWithComputedFields()
+    assert r.conditional_1() == -1
+    assert r.conditional_2() == "zero"
+
+    r.vector_field = [7, 8]
+    r.int_field = 1
+    assert r.conditional_1() == 7
+    assert r.conditional_2() == "positive"
+
+    r.int_field = -1
+    assert r.conditional_2() == "negative"
+
+
+def test_functions():
+    r = tm.RecordWithComputedFields()
+    r.int_field = -5
+    r.int8_field = 3
+    assert r.call_min() == -5
+    assert r.call_abs() == 5
+
+    r.float32_field = 1.5
+    assert r.call_max() == 2.0
+
+    r.uint32_field = 16
+    assert r.call_sqrt() == 4.0
+
+    r.float64_field = 2.5
+    assert r.call_floor() == 2.0
+    assert r.call_ceil() == 3.0
+
+    assert r.call_sum() == 0
+    r.vector_field = [1, 2, 3]
+    assert r.call_sum() == 6
+    r.vector_field = [2**31 - 1, 1]
+    with pytest.raises(OverflowError):
+        r.call_sum()
+    r.vector_field = [-(2**31), -1]
+    with pytest.raises(OverflowError):
+        r.call_sum()
+    r.vector_field = [2**31 - 1, 1, -1]
+    with pytest.raises(OverflowError):
+        r.call_sum()
+
+    r.string_field = "hello"
+    assert r.call_length() == 5
+    # The length is in UTF-8 bytes
+    r.string_field = "h\u00e9llo"
+    assert r.call_length() == 6
+
+    assert not r.call_contains()
+    r.map_field = {"hello": "world"}
+    assert r.call_contains()
+
+    assert not r.call_has_value()
+    r.optional_named_array = np.array([[1, 2], [3, 4]], dtype=np.int32)
+    assert r.call_has_value()
+
+
 def test_casting():
     r = tm.RecordWithComputedFields()
     r.int_field = 42
@@ -215,3 +295,19 @@ def test_casting():
 
     assert r.cast_float_to_complex() == 66.6 + 0.0j
     assert isinstance(r.cast_float_to_complex(), complex)
+
+
+def test_temporal_arithmetic():
+    r = tm.RecordWithDurations(
+        acquisition_start=tm.DateTime(1_000_000_000_000),
+        acquisition_end=tm.DateTime(1_090_000_000_000),
+        repetition_time=np.timedelta64(60, "s"),
+    )
+
+    assert r.elapsed() == np.timedelta64(90, "s")
+    assert isinstance(r.elapsed(), np.timedelta64)
+    assert r.next_acquisition_start() == tm.DateTime(1_060_000_000_000)
+    assert r.exceeds_repetition_time()
+
+    r.repetition_time = np.timedelta64(2, "m")
+    assert not r.exceeds_repetition_time()
@@ -111,6 +111,146 @@ def test_defaulting():
     tm.MyTuple(v1=1, v2=2.0)
 
 
+def test_field_defaults():
+    r = tm.RecordWithDefaults()
+    assert r.int_field == 42
+    assert r.int8_field == -7
+    assert r.uint64_field == 0x8000000000000000
+    assert r.float32_field == 1.5
+    assert r.float64_field == 8.0
+    assert r.complexfloat64_field == complex(3)
+    assert r.bool_field == True
+    assert r.string_field == "hello"
+    assert r.enum_field == tm.Fruits.PEAR
+    assert r.no_default_field == 0
+
+    assert tm.RecordWithDefaults(int_field=1).int_field == 1
+
+
+def test_constants():
+    assert tm.MAX_CHANNELS == 4
+    assert tm.SAMPLES_PER_CHANNEL == 8
+    assert tm.DEFAULT_GAIN == 1.5
+    assert tm.IS_STRICT == False
+
+    r = tm.RecordWithConstants()
+    assert len(r.channels) == 4
+    assert r.samples.shape == (4, 8)
+    assert r.gain == 1.5
+    assert r.channel_count() == 4
+    assert r.sample_count() == 32
+    assert r.scaled_gain() == 2.25
+    assert r.strict() == False
+
+
+def test_extended_records():
+    r = tm.RecordWithBase(version=1, data=[1, 2, 3])
+    assert isinstance(r, tm.RecordHeader)
+    assert r.name == "header"
+    assert r.is_first_version()
+    assert r.data_size() == 3
+    assert r != tm.RecordHeader(version=1)
+
+    r2 = tm.RecordWithBases(label="abc", value=2)
+    assert isinstance(r2, tm.RecordHeader)
+    assert isinstance(r2, tm.LabeledHeader)
+    assert r2.label == "abc"
+    assert not r2.is_first_version()
+    assert r2 == tm.RecordWithBases(label="abc", value=2)
+
+
+def test_annotations():
+    hints = typing.get_type_hints(tm.RecordWithAnnotations, include_extras=True)
+    assert typing.get_args(hints["thickness"])[1] == {
+        "dicomTag": "(0018,0050)",
+        "units": "mm",
+    }
+    assert typing.get_args(hints["spacing"])[1]["deprecated"] == True
+    assert typing.get_origin(hints["label"]) is None
+    assert "dicomModule" in (tm.RecordWithAnnotations.__doc__ or "")
+
+    r = tm.RecordWithAnnotations(thickness=2.0)
+    assert r.spacing == 1.5
+
+
+def test_units():
+    r = tm.RecordWithUnits(length=2, width=3, durations=[0.5, 1.5])
+    assert r.perimeter() == 10
+    assert r.area() == 6
+    assert r.total_duration() == 2.0
+
+
+def test_field_constraints():
+    def valid() -> tm.RecordWithConstraints:
+        return tm.RecordWithConstraints(size=1, gain=2.5, name="abc_1", samples=[1])
+
+    valid().validate()
+
+    for field, value in [
+        ("size", 0),
+        ("size", 4097),
+        ("gain", -0.5),
+        ("name", "Abc"),
+        ("name", "abc!"),
+        ("limit", 11),
+        ("samples", []),
+        ("samples", [1, 2, 3, 4, 5]),
+    ]:
+        r = valid()
+        setattr(r, field, value)
+        with pytest.raises(ValueError, match=f"RecordWithConstraints.{field}"):
+            r.validate()
+
+    outer = tm.RecordWithConstrainedRecords(single=valid(), vector=[valid(), valid()])
+    outer.validate()
+    outer.vector[1].size = 0
+    with pytest.raises(ValueError):
+        outer.validate()
+    outer.vector = []
+    outer.optional = valid()
+    outer.optional.name = ""
+    with pytest.raises(ValueError):
+        outer.validate()
+
+
+def test_field_patterns():
+    # The same cases are tested in every language, since each uses its own
+    # regular expression library.
+    def valid() -> tm.RecordWithPatterns:
+        return tm.RecordWithPatterns(
+            alternation="a", word="w", code="12", escaped="(.)+", capitalized="A"
+        )
+
+    valid().validate()
+
+    for field, value, matches in [
+        ("alternation", "ab", True),
+        ("alternation", "abc", False),
+        ("alternation", "", False),
+        ("alternation", "a\n", False),
+        ("word", "abc_1", True),
+        ("word", "\u00e9", False),
+        ("word", "a b", False),
+        ("code", "123-45", True),
+        ("code", "1234", False),
+        ("code", "12-4", False),
+        ("code", "\u0661\u0662", False),
+        ("escaped", "(.)-", True),
+        ("escaped", "(.)*", True),
+        ("escaped", "(x)-", False),
+        ("capitalized", "Abc", True),
+        ("capitalized", "abc", False),
+        ("capitalized", "ABC", False),
+    ]:
+        r = valid()
+        setattr(r, field, value)
+        if matches:
+            r.validate()
+        else:
+            with pytest.raises(ValueError, match=f"RecordWithPatterns.{field}"):
+                r.validate()
+
+
 def test_get_dtype():
     assert tm.get_dtype(tm.Int32) == np.int32
     assert tm.get_dtype(bool) == np.bool_
@@ -0,0 +1,33 @@
+import json
+
+import jsonschema
+import pytest
+
+from .roundtriputils import validate_ndjson_against_schema
+
+# The round-trip tests validate the NDJSON they write against the JSON Schema
+# of each protocol. These make sure that the schemas also reject invalid lines.
+
+
+@pytest.mark.parametrize(
+    "protocol_name, line",
+    [
+        ("Unions", {"intOrSimpleRecord": "a"}),
+        ("Unions", {"intOrSimpleRecord": {"x": 1, "y": 2}}),
+        ("Unions", {"vectorOfUnions": [3, 1.5]}),
+        ("Flags", {"days": ["someday"]}),
+        ("Flags", {"days": ["monday", "monday"]}),
+        ("Flags", {"formats": -1}),
+        ("ScalarOptionals", {"optionalInt": "55"}),
+        ("ScalarOptionals", {"recordWithOptionalFields": {"unknown": 1}}),
+        ("ScalarOptionals", {"recordWithOptionalFields": {"optionalTime": "noon"}}),
+        ("Maps", {"stringToInt": {"a": "1"}}),
+        ("Maps", {"intToString": [[1, "a", "b"]]}),
+        ("Maps", {"intToString": {"1": "a"}}),
+        ("Scalars", {"int32": 2147483648}),
+        ("Scalars", {"unknownStep": 1}),
+    ],
+)
+def test_schema_rejects_invalid_lines(protocol_name: str, line: object):
+    with pytest.raises(jsonschema.ValidationError):
+        validate_ndjson_against_schema(json.dumps(line), protocol_name)
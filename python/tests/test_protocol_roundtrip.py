@@ -1,6 +1,7 @@
 import datetime
 import re
 from typing import TypeVar
+import uuid
 
 import numpy as np
 import numpy.typing as npt
@@ -13,7 +14,7 @@ from .roundtriputils import create_validating_writer_class, Format
 T = TypeVar("T")
 
 
-@pytest.fixture(scope="module", params=[Format.BINARY, Format.NDJSON])
+@pytest.fixture(scope="module", params=[Format.BINARY, Format.NDJSON, Format.HDF5])
 def format(request: pytest.FixtureRequest):
     return request.param
 
@@ -100,6 +101,126 @@ def test_strings(format: Format):
         w.write_rec_with_string(tm.RecordWithStrings(a="Montréal", b="臺北市"))
 
 
+def test_bytes(format: Format):
+    with create_validating_writer_class(format, tm.ProtocolWithBytesWriterBase)() as w:
+        w.write_single_bytes(b"\x00\x7f\xff")
+        w.write_rec_with_bytes(
+            tm.RecordWithBytes(
+                data=b"\xde\xad",
+                optional_data=b"",
+                chunks=[b"\x00\x7f\xff", b"", b"\x01"],
+            )
+        )
+
+
+def test_uuids(format: Format):
+    first = uuid.UUID("123e4567-e89b-12d3-a456-426614174000")
+    with create_validating_writer_class(format, tm.ProtocolWithUuidsWriterBase)() as w:
+        w.write_single_uuid(first)
+        w.write_rec_with_uuids(
+            tm.RecordWithUuids(
+                id=first,
+                optional_id=uuid.UUID(int=0),
+                related=[first, uuid.UUID("FFFFFFFF-0000-4000-8000-00000000000A")],
+                names={first: "first", uuid.UUID(int=0): "nil"},
+            )
+        )
+
+
+def test_durations(format: Format):
+    start = tm.DateTime(1_700_000_000_000_000_000)
+    with create_validating_writer_class(
+        format, tm.ProtocolWithDurationsWriterBase
+    )() as w:
+        w.write_single_duration(np.timedelta64(-1_500_000_000, "ns"))
+        w.write_rec_with_durations(
+            tm.RecordWithDurations(
+                acquisition_start=start,
+                acquisition_end=start + np.timedelta64(2500, "ms"),
+                repetition_time=np.timedelta64(3, "ms"),
+                timeout=np.timedelta64(-1, "h"),
+                intervals=[
+                    np.timedelta64(1, "ns"),
+                    np.timedelta64(0, "ns"),
+                    np.timedelta64(-30, "s"),
+                ],
+            )
+        )
+
+
+def test_recursive_records(format: Format):
+    if format == Format.HDF5:
+        pytest.skip("Recursive records are not supported in HDF5")
+
+    with create_validating_writer_class(
+        format, tm.ProtocolWithRecursiveRecordsWriterBase
+    )() as w:
+        leaf = tm.TreeNode(label="leaf")
+        w.write_tree(
+            tm.TreeNode(
+                label="root",
+                children=[
+                    tm.TreeNode(label="a", children=[leaf, leaf]),
+                    tm.TreeNode(label="b"),
+                ],
+            )
+        )
+        w.write_list(
+            tm.LinkedListNode(
+                value=1,
+                next=tm.LinkedListNode(value=2, next=tm.LinkedListNode(value=3)),
+            )
+        )
+        constant = tm.Expression(name="const", operand=tm.Int32OrExpression.Int32(42))
+        negate = tm.Expression(
+            name="negate", operand=tm.Int32OrExpression.Expression(constant)
+        )
+        call = tm.Expression(
+            name="call",
+            operand=tm.Int32OrExpression.Expression(negate),
+            arguments={"x": constant, "y": negate},
+        )
+        w.write_expressions([constant, negate, call])
+
+
+def test_sub_protocols(format: Format):
+    with create_validating_writer_class(
+        format, tm.ProtocolWithSubProtocolsWriterBase
+    )() as w:
+        w.write_header("header")
+
+        calibration = w.calibration
+        calibration.write_gain(1.5)
+        calibration.write_samples([1.0, 2.0])
+        calibration.write_samples([3.0])
+        calibration.close()
+
+        w.write_data([1, 2, 3])
+        w.write_recalibration_gain(2.5)
+        w.write_recalibration_samples([])
+
+
+def test_half_precision(format: Format):
+    with create_validating_writer_class(
+        format, tm.ProtocolWithHalfPrecisionWriterBase
+    )() as w:
+        halves = [1.5, -0.25, 65504.0]
+        if format != Format.NDJSON:
+            # JSON has no representation of infinity
+            halves.append(float("inf"))
+        w.write_halves(halves)
+        w.write_rec_with_halves(
+            tm.RecordWithHalfPrecision(
+                half=6.103515625e-05,
+                brain=-(2.0**100),
+                half_vector=[0.0, -2.0],
+                brain_array=np.array(
+                    [[1.0, 2.5, 1024.0], [-0.5, 0.0, 7.0]], dtype=np.float32
+                ),
+            )
+        )
+
+
 def test_optional_vectors(format: Format):
     c = create_validating_writer_class(format, tm.OptionalVectorsWriterBase)
     with c() as w:
@@ -773,6 +894,39 @@ def test_advanced_generics(format: Format):
         w.write_tuple_of_vectors(tm.MyTuple(v1=[1, 2, 3], v2=[4.0, 5.0, 6.0]))
 
 
+def test_generic_protocols(format: Format):
+    with create_validating_writer_class(format, tm.FloatImageStreamWriterBase)() as w:
+        w.write_header(
+            tm.GenericRecord(
+                scalar_1=1,
+                scalar_2=np.float32(2.5),
+                vector_1=[3, 4],
+                image_2=np.array([[1.5, 2.5], [3.5, 4.5]], dtype=np.float32),
+            )
+        )
+        w.write_images(
+            [
+                np.array([[1, 2, 3], [4, 5, 6]], dtype=np.float32),
+                np.array([[7, 8], [9, 10]], dtype=np.float32),
+            ]
+        )
+        w.write_background(tm.Float32OrString.Float32(0.5))
+
+    with create_validating_writer_class(format, tm.ComplexImageStreamWriterBase)() as w:
+        w.write_header(
+            tm.GenericRecord(
+                scalar_1=1,
+                scalar_2=np.complex64(2 + 3j),
+                vector_1=[3, 4],
+                image_2=np.array([[1 + 2j, 3 + 4j]], dtype=np.complex64),
+            )
+        )
+        w.write_images(
+            [np.array([[1 - 1j, 2 - 2j], [3 - 3j, 4 - 4j]], dtype=np.complex64)]
+        )
+        w.write_background(tm.Complexfloat32OrString.String("transparent"))
+
+
 def test_aliases(format: Format):
     with create_validating_writer_class(format, tm.AliasesWriterBase)() as w:
         w.write_aliased_string("hello")
@@ -118,3 +118,144 @@ def test_read_without_first_step():
         r.read_an_int()
         for _ in r.read_a_stream():
             pass
+
+
+class _TestProtocolWithConstraintsWriter(tm.ProtocolWithConstraintsWriterBase):
+    def _write_record(self, value: tm.RecordWithConstraints) -> None:
+        pass
+
+    def _write_records(
+        self, value: Iterable[tm.RecordWithConstrainedRecords]
+    ) -> None:
+        for _ in value:
+            pass
+
+    def _end_stream(self) -> None:
+        pass
+
+    def _close(self) -> None:
+        pass
+
+
+def test_constraints_not_validated_by_default():
+    with _TestProtocolWithConstraintsWriter() as w:
+        w.write_record(tm.RecordWithConstraints())
+        w.write_records([])
+
+
+def test_constraints_validated_when_enabled():
+    valid = tm.RecordWithConstraints(size=1, name="a", samples=[1])
+    with _TestProtocolWithConstraintsWriter() as w:
+        w.set_validate_constraints(True)
+        with pytest.raises(ValueError):
+            w.write_record(tm.RecordWithConstraints())
+        w.write_record(valid)
+
+        records = tm.RecordWithConstrainedRecords(
+            single=valid, vector=[valid, tm.RecordWithConstraints()]
+        )
+        with pytest.raises(ValueError):
+            w.write_records([records])
+
+        records.vector.pop()
+        w.write_records(r for r in [records])
+
+
+class _TestSubProtocolsWriter(tm.ProtocolWithSubProtocolsWriterBase):
+    def __init__(self) -> None:
+        super().__init__()
+        self.written: list[str] = []
+
+    def _write_header(self, value: str) -> None:
+        self.written.append("header")
+
+    def _write_calibration_gain(self, value: tm.Float32) -> None:
+        self.written.append("calibration_gain")
+
+    def _write_calibration_samples(self, value: Iterable[tm.Float64]) -> None:
+        self.written.extend("calibration_samples" for _ in value)
+
+    def _write_data(self, value: Iterable[tm.Int32]) -> None:
+        self.written.extend("data" for _ in value)
+
+    def _write_recalibration_gain(self, value: tm.Float32) -> None:
+        self.written.append("recalibration_gain")
+
+    def _write_recalibration_samples(self, value: Iterable[tm.Float64]) -> None:
+        self.written.extend("recalibration_samples" for _ in value)
+
+    def _end_stream(self) -> None:
+        pass
+
+    def _close(self) -> None:
+        pass
+
+
+def test_sub_protocol_write():
+    with _TestSubProtocolsWriter() as w:
+        w.write_header("header")
+        w.calibration.write_gain(1.0)
+        w.calibration.write_samples([1.0, 2.0])
+        w.calibration.close()
+        w.write_data([1])
+        w.recalibration.write_gain(2.0)
+        w.write_recalibration_samples([])
+
+    assert w.written == [
+        "header",
+        "calibration_gain",
+        "calibration_samples",
+        "calibration_samples",
+        "data",
+        "recalibration_gain",
+    ]
+
+
+def test_sub_protocol_write_out_of_sequence():
+    with pytest.raises(
+        tm.ProtocolError,
+        match="Expected to call to 'write_header' but received call to 'write_calibration_gain'.",
+    ):
+        _TestSubProtocolsWriter().calibration.write_gain(1.0)
+
+    with pytest.raises(
+        tm.ProtocolError,
+        match="Expected to call to 'write_gain' but received call to 'write_samples'.",
+    ):
+        w = _TestSubProtocolsWriter()
+        w.write_header("header")
+        w.calibration.write_samples([])
+
+
+class _TestSubProtocolsReader(tm.ProtocolWithSubProtocolsReaderBase):
+    def _read_header(self) -> str:
+        return "header"
+
+    def _read_calibration_gain(self) -> tm.Float32:
+        return 1.5
+
+    def _read_calibration_samples(self) -> Iterable[tm.Float64]:
+        yield from [1.0, 2.0]
+
+    def _read_data(self) -> Iterable[tm.Int32]:
+        yield from []
+
+    def _read_recalibration_gain(self) -> tm.Float32:
+        return 2.5
+
+    def _read_recalibration_samples(self) -> Iterable[tm.Float64]:
+        yield from []
+
+    def _close(self) -> None:
+        pass
+
+
+def test_sub_protocol_read():
+    with _TestSubProtocolsReader() as r:
+        assert r.read_header() == "header"
+        assert r.calibration.read_gain() == 1.5
+        assert list(r.calibration.read_samples()) == [1.0, 2.0]
+        r.calibration.close()
+        assert list(r.read_data()) == []
+        assert r.recalibration.read_gain() == 2.5
+        assert list(r.read_recalibration_samples()) == []
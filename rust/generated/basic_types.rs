@@ -0,0 +1,671 @@
+// Code generated by the "yardl" tool. DO NOT EDIT.
+
+#![allow(dead_code, unused_imports, unused_variables, non_camel_case_types)]
+
+use std::collections::HashMap;
+use std::io::{Read, Write};
+
+use super::yardl::{self, BinaryRead, BinaryReader, BinaryWrite, BinaryWriter};
+
+#[derive(Debug, Clone, Copy, PartialEq, Eq, Hash)]
+#[repr(i32)]
+pub enum Fruits {
+    Apple = 1,
+    Banana = 2,
+    Pear = 3,
+}
+
+#[derive(Debug, Clone, Copy, PartialEq, Eq, Hash, Default)]
+pub struct DaysOfWeek(pub i32);
+
+impl DaysOfWeek {
+    pub const MONDAY: DaysOfWeek = DaysOfWeek(1);
+    pub const TUESDAY: DaysOfWeek = DaysOfWeek(2);
+    pub const WEDNESDAY: DaysOfWeek = DaysOfWeek(4);
+    pub const THURSDAY: DaysOfWeek = DaysOfWeek(8);
+    pub const FRIDAY: DaysOfWeek = DaysOfWeek(16);
+    pub const SATURDAY: DaysOfWeek = DaysOfWeek(32);
+    pub const SUNDAY: DaysOfWeek = DaysOfWeek(64);
+
+    /// Returns true if all of the given flags are set.
+    pub fn has_flags(self, flags: Self) -> bool {
+        self.0 & flags.0 == flags.0
+    }
+}
+
+impl std::ops::BitOr for DaysOfWeek {
+    type Output = Self;
+
+    fn bitor(self, rhs: Self) -> Self {
+        DaysOfWeek(self.0 | rhs.0)
+    }
+}
+
+impl std::ops::BitOrAssign for DaysOfWeek {
+    fn bitor_assign(&mut self, rhs: Self) {
+        self.0 |= rhs.0;
+    }
+}
+
+impl std::ops::BitAnd for DaysOfWeek {
+    type Output = Self;
+
+    fn bitand(self, rhs: Self) -> Self {
+        DaysOfWeek(self.0 & rhs.0)
+    }
+}
+
+impl std::ops::BitAndAssign for DaysOfWeek {
+    fn bitand_assign(&mut self, rhs: Self) {
+        self.0 &= rhs.0;
+    }
+}
+
+impl std::ops::BitXor for DaysOfWeek {
+    type Output = Self;
+
+    fn bitxor(self, rhs: Self) -> Self {
+        DaysOfWeek(self.0 ^ rhs.0)
+    }
+}
+
+impl std::ops::BitXorAssign for DaysOfWeek {
+    fn bitxor_assign(&mut self, rhs: Self) {
+        self.0 ^= rhs.0;
+    }
+}
+
+#[derive(Debug, Clone, Copy, PartialEq, Eq, Hash, Default)]
+pub struct TextFormat(pub u64);
+
+impl TextFormat {
+    pub const REGULAR: TextFormat = TextFormat(0);
+    pub const BOLD: TextFormat = TextFormat(1);
+    pub const ITALIC: TextFormat = TextFormat(2);
+    pub const UNDERLINE: TextFormat = TextFormat(4);
+    pub const STRIKETHROUGH: TextFormat = TextFormat(8);
+
+    /// Returns true if all of the given flags are set.
+    pub fn has_flags(self, flags: Self) -> bool {
+        self.0 & flags.0 == flags.0
+    }
+}
+
+impl std::ops::BitOr for TextFormat {
+    type Output = Self;
+
+    fn bitor(self, rhs: Self) -> Self {
+        TextFormat(self.0 | rhs.0)
+    }
+}
+
+impl std::ops::BitOrAssign for TextFormat {
+    fn bitor_assign(&mut self, rhs: Self) {
+        self.0 |= rhs.0;
+    }
+}
+
+impl std::ops::BitAnd for TextFormat {
+    type Output = Self;
+
+    fn bitand(self, rhs: Self) -> Self {
+        TextFormat(self.0 & rhs.0)
+    }
+}
+
+impl std::ops::BitAndAssign for TextFormat {
+    fn bitand_assign(&mut self, rhs: Self) {
+        self.0 &= rhs.0;
+    }
+}
+
+impl std::ops::BitXor for TextFormat {
+    type Output = Self;
+
+    fn bitxor(self, rhs: Self) -> Self {
+        TextFormat(self.0 ^ rhs.0)
+    }
+}
+
+impl std::ops::BitXorAssign for TextFormat {
+    fn bitxor_assign(&mut self, rhs: Self) {
+        self.0 ^= rhs.0;
+    }
+}
+
+pub type AliasedMap<K, V> = Vec<(K, V)>;
+
+pub type MyTuple<T1, T2> = super::tuples::Tuple<T1, T2>;
+
+#[derive(Debug, Clone, PartialEq)]
+pub enum GenericUnion2<T1, T2> {
+    T1(T1),
+    T2(T2),
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub enum GenericNullableUnion2<T1, T2> {
+    T1(T1),
+    T2(T2),
+}
+
+pub type GenericVector<T> = Vec<T>;
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct RecordWithString {
+    pub i: String,
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub enum Int32OrString {
+    Int32(i32),
+    String(String),
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub enum TimeOrDatetime {
+    Time(yardl::Time),
+    Datetime(yardl::DateTime),
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub enum RecordWithStringOrInt32 {
+    RecordWithString(RecordWithString),
+    Int32(i32),
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct RecordWithUnions {
+    pub null_or_int_or_string: Option<Int32OrString>,
+    pub date_or_datetime: TimeOrDatetime,
+    pub null_or_fruits_or_days_of_week: Option<GenericNullableUnion2<Fruits, DaysOfWeek>>,
+    pub record_or_int: RecordWithStringOrInt32,
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub enum T0OrT1<T0, T1> {
+    T0(T0),
+    T1(T1),
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct GenericRecordWithComputedFields<T0, T1> {
+    pub f1: T0OrT1<T0, T1>,
+}
+
+/// The schema written to the header of binary UnusedProtocol streams.
+pub const UNUSED_PROTOCOL_SCHEMA: &str = r#"{"protocol":{"name":"UnusedProtocol","sequence":[{"name":"enum","type":"BasicTypes.Fruits"},{"name":"tuple","type":{"name":"BasicTypes.MyTuple","typeArguments":["int32","string"]}}]},"types":[{"name":"Fruits","values":[{"symbol":"apple","value":1},{"symbol":"banana","value":2},{"symbol":"pear","value":3}]},{"name":"MyTuple","typeParameters":["T1","T2"],"type":{"name":"Tuples.Tuple","typeArguments":["T1","T2"]}},{"name":"Tuple","typeParameters":["T1","T2"],"fields":[{"name":"v1","type":"T1"},{"name":"v2","type":"T2"}]}]}"#;
+
+pub trait UnusedProtocolWriter {
+    fn write_enum(&mut self, value: &Fruits) -> yardl::Result<()>;
+    fn write_tuple(&mut self, value: &MyTuple<i32, String>) -> yardl::Result<()>;
+    /// Verifies that all steps have been written and flushes the output.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+pub trait UnusedProtocolReader {
+    fn read_enum(&mut self) -> yardl::Result<Fruits>;
+    fn read_tuple(&mut self) -> yardl::Result<MyTuple<i32, String>>;
+    /// Verifies that all steps have been read.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+impl BinaryWrite for Fruits {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&(*self as i32), w)
+    }
+}
+
+impl BinaryRead for Fruits {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        let value = <i32 as BinaryRead>::read(r)?;
+        match value {
+            1 => Ok(Fruits::Apple),
+            2 => Ok(Fruits::Banana),
+            3 => Ok(Fruits::Pear),
+            _ => Err(yardl::Error::InvalidData(format!("invalid Fruits value {}", value))),
+        }
+    }
+}
+
+impl BinaryWrite for DaysOfWeek {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.0, w)
+    }
+}
+
+impl BinaryRead for DaysOfWeek {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(DaysOfWeek(BinaryRead::read(r)?))
+    }
+}
+
+impl BinaryWrite for TextFormat {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.0, w)
+    }
+}
+
+impl BinaryRead for TextFormat {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(TextFormat(BinaryRead::read(r)?))
+    }
+}
+
+impl<T1: BinaryWrite, T2: BinaryWrite> BinaryWrite for GenericUnion2<T1, T2> {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        match self {
+            GenericUnion2::T1(value) => {
+                w.write_uvarint(0)?;
+                BinaryWrite::write(value, w)
+            }
+            GenericUnion2::T2(value) => {
+                w.write_uvarint(1)?;
+                BinaryWrite::write(value, w)
+            }
+        }
+    }
+}
+
+impl<T1: BinaryRead, T2: BinaryRead> BinaryRead for GenericUnion2<T1, T2> {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        match r.read_uvarint()? {
+            0 => Ok(GenericUnion2::T1(BinaryRead::read(r)?)),
+            1 => Ok(GenericUnion2::T2(BinaryRead::read(r)?)),
+            index => Err(yardl::Error::InvalidData(format!("unexpected GenericUnion2 index {}", index))),
+        }
+    }
+}
+
+impl<T1: BinaryWrite, T2: BinaryWrite> BinaryWrite for GenericNullableUnion2<T1, T2> {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        match self {
+            GenericNullableUnion2::T1(value) => {
+                w.write_uvarint(1)?;
+                BinaryWrite::write(value, w)
+            }
+            GenericNullableUnion2::T2(value) => {
+                w.write_uvarint(2)?;
+                BinaryWrite::write(value, w)
+            }
+        }
+    }
+
+    fn write_some<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(self, w)
+    }
+}
+
+impl<T1: BinaryRead, T2: BinaryRead> BinaryRead for GenericNullableUnion2<T1, T2> {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        match r.read_uvarint()? {
+            1 => Ok(GenericNullableUnion2::T1(BinaryRead::read(r)?)),
+            2 => Ok(GenericNullableUnion2::T2(BinaryRead::read(r)?)),
+            index => Err(yardl::Error::InvalidData(format!("unexpected GenericNullableUnion2 index {}", index))),
+        }
+    }
+
+    fn read_optional<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Option<Self>> {
+        match r.read_uvarint()? {
+            0 => Ok(None),
+            1 => Ok(Some(GenericNullableUnion2::T1(BinaryRead::read(r)?))),
+            2 => Ok(Some(GenericNullableUnion2::T2(BinaryRead::read(r)?))),
+            index => Err(yardl::Error::InvalidData(format!("unexpected GenericNullableUnion2 index {}", index))),
+        }
+    }
+}
+
+impl BinaryWrite for RecordWithString {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.i, w)?;
+        Ok(())
+    }
+}
+
+impl BinaryRead for RecordWithString {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(RecordWithString {
+            i: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl BinaryWrite for Int32OrString {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        match self {
+            Int32OrString::Int32(value) => {
+                w.write_uvarint(0)?;
+                BinaryWrite::write(value, w)
+            }
+            Int32OrString::String(value) => {
+                w.write_uvarint(1)?;
+                BinaryWrite::write(value, w)
+            }
+        }
+    }
+
+    fn write_some<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        match self {
+            Int32OrString::Int32(value) => {
+                w.write_uvarint(1)?;
+                BinaryWrite::write(value, w)
+            }
+            Int32OrString::String(value) => {
+                w.write_uvarint(2)?;
+                BinaryWrite::write(value, w)
+            }
+        }
+    }
+}
+
+impl BinaryRead for Int32OrString {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        match r.read_uvarint()? {
+            0 => Ok(Int32OrString::Int32(BinaryRead::read(r)?)),
+            1 => Ok(Int32OrString::String(BinaryRead::read(r)?)),
+            index => Err(yardl::Error::InvalidData(format!("unexpected Int32OrString index {}", index))),
+        }
+    }
+
+    fn read_optional<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Option<Self>> {
+        match r.read_uvarint()? {
+            0 => Ok(None),
+            1 => Ok(Some(Int32OrString::Int32(BinaryRead::read(r)?))),
+            2 => Ok(Some(Int32OrString::String(BinaryRead::read(r)?))),
+            index => Err(yardl::Error::InvalidData(format!("unexpected Int32OrString index {}", index))),
+        }
+    }
+}
+
+impl BinaryWrite for TimeOrDatetime {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        match self {
+            TimeOrDatetime::Time(value) => {
+                w.write_uvarint(0)?;
+                BinaryWrite::write(value, w)
+            }
+            TimeOrDatetime::Datetime(value) => {
+                w.write_uvarint(1)?;
+                BinaryWrite::write(value, w)
+            }
+        }
+    }
+
+    fn write_some<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        match self {
+            TimeOrDatetime::Time(value) => {
+                w.write_uvarint(1)?;
+                BinaryWrite::write(value, w)
+            }
+            TimeOrDatetime::Datetime(value) => {
+                w.write_uvarint(2)?;
+                BinaryWrite::write(value, w)
+            }
+        }
+    }
+}
+
+impl BinaryRead for TimeOrDatetime {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        match r.read_uvarint()? {
+            0 => Ok(TimeOrDatetime::Time(BinaryRead::read(r)?)),
+            1 => Ok(TimeOrDatetime::Datetime(BinaryRead::read(r)?)),
+            index => Err(yardl::Error::InvalidData(format!("unexpected TimeOrDatetime index {}", index))),
+        }
+    }
+
+    fn read_optional<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Option<Self>> {
+        match r.read_uvarint()? {
+            0 => Ok(None),
+            1 => Ok(Some(TimeOrDatetime::Time(BinaryRead::read(r)?))),
+            2 => Ok(Some(TimeOrDatetime::Datetime(BinaryRead::read(r)?))),
+            index => Err(yardl::Error::InvalidData(format!("unexpected TimeOrDatetime index {}", index))),
+        }
+    }
+}
+
+impl BinaryWrite for RecordWithStringOrInt32 {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        match self {
+            RecordWithStringOrInt32::RecordWithString(value) => {
+                w.write_uvarint(0)?;
+                BinaryWrite::write(value, w)
+            }
+            RecordWithStringOrInt32::Int32(value) => {
+                w.write_uvarint(1)?;
+                BinaryWrite::write(value, w)
+            }
+        }
+    }
+
+    fn write_some<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        match self {
+            RecordWithStringOrInt32::RecordWithString(value) => {
+                w.write_uvarint(1)?;
+                BinaryWrite::write(value, w)
+            }
+            RecordWithStringOrInt32::Int32(value) => {
+                w.write_uvarint(2)?;
+                BinaryWrite::write(value, w)
+            }
+        }
+    }
+}
+
+impl BinaryRead for RecordWithStringOrInt32 {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        match r.read_uvarint()? {
+            0 => Ok(RecordWithStringOrInt32::RecordWithString(BinaryRead::read(r)?)),
+            1 => Ok(RecordWithStringOrInt32::Int32(BinaryRead::read(r)?)),
+            index => Err(yardl::Error::InvalidData(format!("unexpected RecordWithStringOrInt32 index {}", index))),
+        }
+    }
+
+    fn read_optional<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Option<Self>> {
+        match r.read_uvarint()? {
+            0 => Ok(None),
+            1 => Ok(Some(RecordWithStringOrInt32::RecordWithString(BinaryRead::read(r)?))),
+            2 => Ok(Some(RecordWithStringOrInt32::Int32(BinaryRead::read(r)?))),
+            index => Err(yardl::Error::InvalidData(format!("unexpected RecordWithStringOrInt32 index {}", index))),
+        }
+    }
+}
+
+impl BinaryWrite for RecordWithUnions {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.null_or_int_or_string, w)?;
+        BinaryWrite::write(&self.date_or_datetime, w)?;
+        BinaryWrite::write(&self.null_or_fruits_or_days_of_week, w)?;
+        BinaryWrite::write(&self.record_or_int, w)?;
+        Ok(())
+    }
+}
+
+impl BinaryRead for RecordWithUnions {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(RecordWithUnions {
+            null_or_int_or_string: BinaryRead::read(r)?,
+            date_or_datetime: BinaryRead::read(r)?,
+            null_or_fruits_or_days_of_week: BinaryRead::read(r)?,
+            record_or_int: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl<T0: BinaryWrite, T1: BinaryWrite> BinaryWrite for T0OrT1<T0, T1> {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        match self {
+            T0OrT1::T0(value) => {
+                w.write_uvarint(0)?;
+                BinaryWrite::write(value, w)
+            }
+            T0OrT1::T1(value) => {
+                w.write_uvarint(1)?;
+                BinaryWrite::write(value, w)
+            }
+        }
+    }
+
+    fn write_some<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        match self {
+            T0OrT1::T0(value) => {
+                w.write_uvarint(1)?;
+                BinaryWrite::write(value, w)
+            }
+            T0OrT1::T1(value) => {
+                w.write_uvarint(2)?;
+                BinaryWrite::write(value, w)
+            }
+        }
+    }
+}
+
+impl<T0: BinaryRead, T1: BinaryRead> BinaryRead for T0OrT1<T0, T1> {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        match r.read_uvarint()? {
+            0 => Ok(T0OrT1::T0(BinaryRead::read(r)?)),
+            1 => Ok(T0OrT1::T1(BinaryRead::read(r)?)),
+            index => Err(yardl::Error::InvalidData(format!("unexpected T0OrT1 index {}", index))),
+        }
+    }
+
+    fn read_optional<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Option<Self>> {
+        match r.read_uvarint()? {
+            0 => Ok(None),
+            1 => Ok(Some(T0OrT1::T0(BinaryRead::read(r)?))),
+            2 => Ok(Some(T0OrT1::T1(BinaryRead::read(r)?))),
+            index => Err(yardl::Error::InvalidData(format!("unexpected T0OrT1 index {}", index))),
+        }
+    }
+}
+
+impl<T0: BinaryWrite, T1: BinaryWrite> BinaryWrite for GenericRecordWithComputedFields<T0, T1> {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.f1, w)?;
+        Ok(())
+    }
+}
+
+impl<T0: BinaryRead, T1: BinaryRead> BinaryRead for GenericRecordWithComputedFields<T0, T1> {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(GenericRecordWithComputedFields {
+            f1: BinaryRead::read(r)?,
+        })
+    }
+}
+
+/// Writes the UnusedProtocol protocol in binary format.
+pub struct BinaryUnusedProtocolWriter<W: Write> {
+    w: BinaryWriter<W>,
+    state: usize,
+}
+
+impl<W: Write> BinaryUnusedProtocolWriter<W> {
+    /// Writes the protocol header to the given writer.
+    pub fn new(w: W) -> yardl::Result<Self> {
+        let mut w = BinaryWriter::new(w);
+        w.write_header(UNUSED_PROTOCOL_SCHEMA)?;
+        Ok(Self { w, state: 0 })
+    }
+
+    fn invalid_state(&self, attempted: usize, end: bool) -> yardl::Error {
+        let expected = match self.state {
+            0 => "write_enum()",
+            1 => "write_tuple()",
+            _ => "close()",
+        };
+        let received = match attempted {
+            0 => "write_enum()",
+            1 => "write_tuple()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: expected.to_string(),
+            received: received.to_string(),
+        }
+    }
+}
+
+impl<W: Write> UnusedProtocolWriter for BinaryUnusedProtocolWriter<W> {
+    fn write_enum(&mut self, value: &Fruits) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 1;
+        Ok(())
+    }
+
+    fn write_tuple(&mut self, value: &MyTuple<i32, String>) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 2;
+        Ok(())
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2, false));
+        }
+        self.w.flush()
+    }
+}
+
+/// Reads the UnusedProtocol protocol in binary format.
+pub struct BinaryUnusedProtocolReader<R: Read> {
+    r: BinaryReader<R>,
+    state: usize,
+}
+
+impl<R: Read> BinaryUnusedProtocolReader<R> {
+    /// Reads the protocol header and returns an error if the stream is not a binary UnusedProtocol stream.
+    pub fn new(r: R) -> yardl::Result<Self> {
+        let mut r = BinaryReader::new(r);
+        r.read_header(Some(UNUSED_PROTOCOL_SCHEMA))?;
+        Ok(Self {
+            r,
+            state: 0,
+        })
+    }
+
+    fn invalid_state(&self, attempted: usize) -> yardl::Error {
+        let method_name = |state: usize| match state {
+            0 => "read_enum()",
+            1 => "read_tuple()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: method_name(self.state).to_string(),
+            received: method_name(attempted).to_string(),
+        }
+    }
+}
+
+impl<R: Read> UnusedProtocolReader for BinaryUnusedProtocolReader<R> {
+    fn read_enum(&mut self) -> yardl::Result<Fruits> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 1;
+        Ok(value)
+    }
+
+    fn read_tuple(&mut self) -> yardl::Result<MyTuple<i32, String>> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 2;
+        Ok(value)
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2));
+        }
+        Ok(())
+    }
+}
@@ -0,0 +1,14 @@
+// Code generated by the "yardl" tool. DO NOT EDIT.
+
+#![allow(dead_code, unused_imports, unused_variables, non_camel_case_types)]
+
+use std::collections::HashMap;
+use std::io::{Read, Write};
+
+use super::yardl::{self, BinaryRead, BinaryReader, BinaryWrite, BinaryWriter};
+
+pub type Image<T> = yardl::NDArray<T, 2>;
+
+pub type FloatImage = Image<f32>;
+
+pub type IntImage = Image<i32>;
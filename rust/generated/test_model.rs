@@ -0,0 +1,10901 @@
+// Code generated by the "yardl" tool. DO NOT EDIT.
+
+#![allow(dead_code, unused_imports, unused_variables, non_camel_case_types)]
+
+use std::collections::HashMap;
+use std::io::{Read, Write};
+
+use super::yardl::{self, BinaryRead, BinaryReader, BinaryWrite, BinaryWriter};
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct SmallBenchmarkRecord {
+    pub a: f64,
+    pub b: f32,
+    pub c: f32,
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct SimpleEncodingCounters {
+    pub e1: Option<u32>,
+    pub e2: Option<u32>,
+    pub slice: Option<u32>,
+    pub repetition: Option<u32>,
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct SimpleAcquisition {
+    pub flags: u64,
+    pub idx: SimpleEncodingCounters,
+    pub data: yardl::NDArray<yardl::Complex<f32>, 2>,
+    pub trajectory: yardl::NDArray<f32, 2>,
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct SimpleRecord {
+    pub x: i32,
+    pub y: i32,
+    pub z: i32,
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct RecordWithPrimitives {
+    pub bool_field: bool,
+    pub int8_field: i8,
+    pub uint8_field: u8,
+    pub int16_field: i16,
+    pub uint16_field: u16,
+    pub int32_field: i32,
+    pub uint32_field: u32,
+    pub int64_field: i64,
+    pub uint64_field: u64,
+    pub size_field: u64,
+    pub float32_field: f32,
+    pub float64_field: f64,
+    pub complexfloat32_field: yardl::Complex<f32>,
+    pub complexfloat64_field: yardl::Complex<f64>,
+    pub date_field: yardl::Date,
+    pub time_field: yardl::Time,
+    pub datetime_field: yardl::DateTime,
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct RecordWithPrimitiveAliases {
+    pub byte_field: u8,
+    pub int_field: i32,
+    pub uint_field: u32,
+    pub long_field: i64,
+    pub ulong_field: u64,
+    pub float_field: f32,
+    pub double_field: f64,
+    pub complexfloat_field: yardl::Complex<f32>,
+    pub complexdouble_field: yardl::Complex<f64>,
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct TupleWithRecords {
+    pub a: SimpleRecord,
+    pub b: SimpleRecord,
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct RecordWithVectors {
+    pub default_vector: Vec<i32>,
+    pub default_vector_fixed_length: [i32; 3],
+    pub vector_of_vectors: Vec<[i32; 2]>,
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct RecordWithVectorOfTimes {
+    pub times: Vec<yardl::Time>,
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct RecordWithArrays {
+    pub default_array: yardl::DynamicNDArray<i32>,
+    pub default_array_with_empty_dimension: yardl::DynamicNDArray<i32>,
+    pub rank_1_array: yardl::NDArray<i32, 1>,
+    pub rank_2_array: yardl::NDArray<i32, 2>,
+    pub rank_2_array_with_named_dimensions: yardl::NDArray<i32, 2>,
+    pub rank_2_fixed_array: [[i32; 4]; 3],
+    pub rank_2_fixed_array_with_named_dimensions: [[i32; 4]; 3],
+    pub dynamic_array: yardl::DynamicNDArray<i32>,
+    pub array_of_vectors: [[i32; 4]; 5],
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct RecordWithArraysSimpleSyntax {
+    pub default_array: yardl::DynamicNDArray<i32>,
+    pub default_array_with_empty_dimension: yardl::DynamicNDArray<i32>,
+    pub rank_1_array: yardl::NDArray<i32, 1>,
+    pub rank_2_array: yardl::NDArray<i32, 2>,
+    pub rank_2_array_with_named_dimensions: yardl::NDArray<i32, 2>,
+    pub rank_2_fixed_array: [[i32; 4]; 3],
+    pub rank_2_fixed_array_with_named_dimensions: [[i32; 4]; 3],
+    pub dynamic_array: yardl::DynamicNDArray<i32>,
+    pub array_of_vectors: [[i32; 4]; 5],
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct RecordWithOptionalFields {
+    pub optional_int: Option<i32>,
+    pub optional_int_alternate_syntax: Option<i32>,
+    pub optional_time: Option<yardl::Time>,
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct RecordWithVlens {
+    pub a: Vec<SimpleRecord>,
+    pub b: i32,
+    pub c: i32,
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct RecordWithStrings {
+    pub a: String,
+    pub b: String,
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct RecordWithBytes {
+    pub data: Vec<u8>,
+    pub optional_data: Option<Vec<u8>>,
+    pub chunks: Vec<Vec<u8>>,
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct RecordWithUuids {
+    pub id: yardl::Uuid,
+    pub optional_id: Option<yardl::Uuid>,
+    pub related: Vec<yardl::Uuid>,
+    pub names: HashMap<yardl::Uuid, String>,
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct RecordWithDurations {
+    pub acquisition_start: yardl::DateTime,
+    pub acquisition_end: yardl::DateTime,
+    pub repetition_time: yardl::Duration,
+    pub timeout: Option<yardl::Duration>,
+    pub intervals: Vec<yardl::Duration>,
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct TreeNode {
+    pub label: String,
+    pub children: Vec<TreeNode>,
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct LinkedListNode {
+    pub value: i32,
+    pub next: Option<Box<LinkedListNode>>,
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub enum Int32OrExpression {
+    Int32(i32),
+    Expression(Box<Expression>),
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct Expression {
+    pub name: String,
+    pub operand: Int32OrExpression,
+    pub arguments: HashMap<String, Expression>,
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct RecordWithHalfPrecision {
+    pub half: yardl::Float16,
+    pub brain: yardl::BFloat16,
+    pub half_vector: Vec<yardl::Float16>,
+    pub brain_array: yardl::DynamicNDArray<yardl::BFloat16>,
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct RecordWithOptionalVector {
+    pub optional_vector: Option<Vec<i32>>,
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct RecordWithFixedVectors {
+    pub fixed_int_vector: [i32; 5],
+    pub fixed_simple_record_vector: [SimpleRecord; 3],
+    pub fixed_record_with_vlens_vector: [RecordWithVlens; 2],
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct RecordWithFixedArrays {
+    pub ints: [[i32; 3]; 2],
+    pub fixed_simple_record_array: [[SimpleRecord; 2]; 3],
+    pub fixed_record_with_vlens_array: [[RecordWithVlens; 2]; 2],
+}
+
+pub type IntArray = yardl::DynamicNDArray<i32>;
+
+pub type IntRank2Array = yardl::NDArray<i32, 2>;
+
+pub type IntFixedArray = [[i32; 3]; 2];
+
+pub type SimpleRecordFixedArray = [[SimpleRecord; 2]; 3];
+
+pub type RecordWithVlensFixedArray = [[RecordWithVlens; 2]; 2];
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct RecordWithNamedFixedArrays {
+    pub ints: IntFixedArray,
+    pub fixed_simple_record_array: SimpleRecordFixedArray,
+    pub fixed_record_with_vlens_array: RecordWithVlensFixedArray,
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct RecordWithNDArrays {
+    pub ints: yardl::NDArray<i32, 2>,
+    pub fixed_simple_record_array: yardl::NDArray<SimpleRecord, 2>,
+    pub fixed_record_with_vlens_array: yardl::NDArray<RecordWithVlens, 2>,
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct RecordWithNDArraysSingleDimension {
+    pub ints: yardl::NDArray<i32, 1>,
+    pub fixed_simple_record_array: yardl::NDArray<SimpleRecord, 1>,
+    pub fixed_record_with_vlens_array: yardl::NDArray<RecordWithVlens, 1>,
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct RecordWithDynamicNDArrays {
+    pub ints: IntArray,
+    pub simple_record_array: yardl::DynamicNDArray<SimpleRecord>,
+    pub record_with_vlens_array: yardl::DynamicNDArray<RecordWithVlens>,
+}
+
+pub type NamedFixedNDArray = [[i32; 4]; 2];
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct RecordWithFixedCollections {
+    pub fixed_vector: [i32; 3],
+    pub fixed_array: [[i32; 3]; 2],
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct RecordWithVlenCollections {
+    pub vector: Vec<i32>,
+    pub array: yardl::NDArray<i32, 2>,
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub enum MapOrScalar {
+    Map(HashMap<String, i32>),
+    Scalar(i32),
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub enum VectorOrScalar {
+    Vector(Vec<i32>),
+    Scalar(i32),
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub enum ArrayOrScalar {
+    Array(yardl::DynamicNDArray<i32>),
+    Scalar(i32),
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct RecordWithUnionsOfContainers {
+    pub map_or_scalar: MapOrScalar,
+    pub vector_or_scalar: VectorOrScalar,
+    pub array_or_scalar: ArrayOrScalar,
+}
+
+pub type NamedNDArray = yardl::NDArray<i32, 2>;
+
+#[derive(Debug, Clone, PartialEq)]
+pub enum StringOrInt32 {
+    String(String),
+    Int32(i32),
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct RecordWithMaps {
+    pub set_1: HashMap<u32, u32>,
+    pub set_2: HashMap<i32, bool>,
+    pub set_3: HashMap<String, StringOrInt32>,
+}
+
+pub type Fruits = super::basic_types::Fruits;
+
+#[derive(Debug, Clone, Copy, PartialEq, Eq, Hash)]
+#[repr(u64)]
+pub enum UInt64Enum {
+    A = 9223372036854775808,
+}
+
+#[derive(Debug, Clone, Copy, PartialEq, Eq, Hash)]
+#[repr(i64)]
+pub enum Int64Enum {
+    B = -4611686018427387904,
+}
+
+#[derive(Debug, Clone, Copy, PartialEq, Eq, Hash)]
+#[repr(u64)]
+pub enum SizeBasedEnum {
+    A = 0,
+    B = 1,
+    C = 2,
+}
+
+pub type DaysOfWeek = super::basic_types::DaysOfWeek;
+
+pub type TextFormat = super::basic_types::TextFormat;
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct RecordWithNoDefaultEnum {
+    pub enum_: Fruits,
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct RecordWithEnums {
+    pub enum_: Fruits,
+    pub flags: DaysOfWeek,
+    pub flags_2: TextFormat,
+    pub rec: RecordWithNoDefaultEnum,
+}
+
+pub type Image<T> = super::image::Image<T>;
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct GenericRecord<T1, T2> {
+    pub scalar_1: T1,
+    pub scalar_2: T2,
+    pub vector_1: Vec<T1>,
+    pub image_2: Image<T2>,
+}
+
+pub type MyTuple<T1, T2> = super::basic_types::MyTuple<T1, T2>;
+
+pub type AliasedTuple<T1, T2> = MyTuple<T1, T2>;
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct RecordWithAliasedGenerics {
+    pub my_strings: MyTuple<String, String>,
+    pub aliased_strings: AliasedTuple<String, String>,
+}
+
+pub type AliasedString = String;
+
+pub type AliasedEnum = Fruits;
+
+pub type AliasedSimpleRecord = SimpleRecord;
+
+pub type AliasedOpenGeneric<T1, T2> = AliasedTuple<T1, T2>;
+
+pub type AliasedClosedGeneric = AliasedTuple<AliasedString, AliasedEnum>;
+
+pub type AliasedOptional = Option<i32>;
+
+pub type AliasedGenericOptional<T> = Option<T>;
+
+#[derive(Debug, Clone, PartialEq)]
+pub enum AliasedMultiGenericOptional<T, U> {
+    T(T),
+    U(U),
+}
+
+pub type AliasedGenericUnion2<T1, T2> = super::basic_types::GenericUnion2<T1, T2>;
+
+pub type AliasedGenericVector<T> = super::basic_types::GenericVector<T>;
+
+pub type AliasedGenericFixedVector<T> = [T; 3];
+
+pub type AliasedGenericRank2Array<T> = yardl::NDArray<T, 2>;
+
+pub type AliasedGenericFixedArray<T> = [[T; 8]; 16];
+
+pub type AliasedGenericDynamicArray<T> = yardl::DynamicNDArray<T>;
+
+pub type AliasedMap<K, V> = super::basic_types::AliasedMap<K, V>;
+
+pub type VectorOfGenericRecords<T1, T2> = Vec<GenericRecord<T1, T2>>;
+
+pub type AliasedVectorOfGenericRecords<T1, T2> = AliasedGenericVector<GenericRecord<T1, T2>>;
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct RecordWithGenericVectorOfRecords<T, U> {
+    pub v: Vec<VectorOfGenericRecords<T, U>>,
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct RecordWithOptionalGenericField<T> {
+    pub v: Option<T>,
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct RecordWithAliasedOptionalGenericField<T> {
+    pub v: AliasedGenericOptional<T>,
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub enum UOrV<U, V> {
+    U(U),
+    V(V),
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct RecordWithOptionalGenericUnionField<U, V> {
+    pub v: Option<UOrV<U, V>>,
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct RecordWithAliasedOptionalGenericUnionField<U, V> {
+    pub v: Option<AliasedMultiGenericOptional<U, V>>,
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct RecordWithGenericVectors<T> {
+    pub v: Vec<T>,
+    pub av: AliasedGenericVector<T>,
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct RecordWithGenericFixedVectors<T> {
+    pub fv: [T; 3],
+    pub afv: AliasedGenericFixedVector<T>,
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct RecordWithGenericArrays<T> {
+    pub nd: yardl::NDArray<T, 2>,
+    pub fixed_nd: [[T; 8]; 16],
+    pub dynamic_nd: yardl::DynamicNDArray<T>,
+    pub aliased_nd: AliasedGenericRank2Array<T>,
+    pub aliased_fixed_nd: AliasedGenericFixedArray<T>,
+    pub aliased_dynamic_nd: AliasedGenericDynamicArray<T>,
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct RecordWithGenericMaps<T, U> {
+    pub m: Vec<(T, U)>,
+    pub am: super::basic_types::AliasedMap<T, U>,
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct RecordContainingGenericRecords<A, B> {
+    pub g1: RecordWithOptionalGenericField<A>,
+    pub g1a: RecordWithAliasedOptionalGenericField<A>,
+    pub g2: RecordWithOptionalGenericUnionField<A, B>,
+    pub g2a: RecordWithAliasedOptionalGenericUnionField<A, B>,
+    pub g3: MyTuple<A, B>,
+    pub g3a: AliasedTuple<A, B>,
+    pub g4: RecordWithGenericVectors<B>,
+    pub g5: RecordWithGenericFixedVectors<B>,
+    pub g6: RecordWithGenericArrays<B>,
+    pub g7: RecordWithGenericMaps<A, B>,
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct RecordContainingNestedGenericRecords {
+    pub f1: RecordWithOptionalGenericField<String>,
+    pub f1a: RecordWithAliasedOptionalGenericField<String>,
+    pub f2: RecordWithOptionalGenericUnionField<String, i32>,
+    pub f2a: RecordWithAliasedOptionalGenericUnionField<String, i32>,
+    pub nested: RecordContainingGenericRecords<String, i32>,
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct RecordContainingVectorsOfAliases {
+    pub strings: Vec<AliasedString>,
+    pub maps: Vec<AliasedMap<String, i32>>,
+    pub arrays: Vec<Image<f32>>,
+    pub tuples: Vec<MyTuple<i32, SimpleRecord>>,
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub enum AliasedIntOrSimpleRecord {
+    Int32(i32),
+    SimpleRecord(SimpleRecord),
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub enum AliasedIntOrAliasedSimpleRecord {
+    Int32(i32),
+    AliasedSimpleRecord(AliasedSimpleRecord),
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub enum AliasedNullableIntSimpleRecord {
+    Int32(i32),
+    SimpleRecord(SimpleRecord),
+}
+
+pub type RecordWithIntVectors = RecordWithGenericVectors<i32>;
+
+pub type RecordWithFloatArrays = RecordWithGenericArrays<f32>;
+
+#[derive(Debug, Clone, PartialEq)]
+pub enum UnionOfContainerRecords {
+    RecordWithIntVectors(RecordWithIntVectors),
+    RecordWithFloatArrays(RecordWithFloatArrays),
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub enum Int32OrFloat32 {
+    Int32(i32),
+    Float32(f32),
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub enum IntOrGenericRecordWithComputedFields {
+    Int(i32),
+    GenericRecordWithComputedFields(super::basic_types::GenericRecordWithComputedFields<String, f32>),
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct RecordWithComputedFields {
+    pub array_field: yardl::NDArray<i32, 2>,
+    pub array_field_map_dimensions: yardl::NDArray<i32, 2>,
+    pub dynamic_array_field: yardl::DynamicNDArray<i32>,
+    pub fixed_array_field: [[i32; 4]; 3],
+    pub int_field: i32,
+    pub int8_field: i8,
+    pub uint8_field: u8,
+    pub int16_field: i16,
+    pub uint16_field: u16,
+    pub uint32_field: u32,
+    pub int64_field: i64,
+    pub uint64_field: u64,
+    pub size_field: u64,
+    pub float32_field: f32,
+    pub float64_field: f64,
+    pub complexfloat32_field: yardl::Complex<f32>,
+    pub complexfloat64_field: yardl::Complex<f64>,
+    pub string_field: String,
+    pub tuple_field: MyTuple<i32, i32>,
+    pub vector_field: Vec<i32>,
+    pub vector_of_vectors_field: Vec<Vec<i32>>,
+    pub fixed_vector_field: [i32; 3],
+    pub fixed_vector_of_vectors_field: [[i32; 3]; 2],
+    pub optional_named_array: Option<NamedNDArray>,
+    pub int_float_union: Int32OrFloat32,
+    pub nullable_int_float_union: Option<Int32OrFloat32>,
+    pub union_with_nested_generic_union: IntOrGenericRecordWithComputedFields,
+    pub map_field: HashMap<String, String>,
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct RecordWithDefaults {
+    pub int_field: i32,
+    pub int8_field: i8,
+    pub uint64_field: u64,
+    pub float32_field: f32,
+    pub float64_field: f64,
+    pub complexfloat64_field: yardl::Complex<f64>,
+    pub bool_field: bool,
+    pub string_field: String,
+    pub enum_field: Fruits,
+    pub no_default_field: i32,
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct RecordWithConstraints {
+    pub size: u32,
+    pub gain: f32,
+    pub name: String,
+    pub limit: Option<i32>,
+    pub samples: Vec<i32>,
+}
+
+impl RecordWithConstraints {
+    /// Returns an error if a field does not satisfy its constraints.
+    /// Pattern constraints are not checked, since the standard library has no regular expressions.
+    pub fn validate(&self) -> yardl::Result<()> {
+        if self.size < 1 {
+            return Err(yardl::Error::ConstraintViolation("RecordWithConstraints.size must be at least 1".to_string()));
+        }
+        if self.size > 4096 {
+            return Err(yardl::Error::ConstraintViolation("RecordWithConstraints.size must be at most 4096".to_string()));
+        }
+        if self.gain < 0.0_f32 {
+            return Err(yardl::Error::ConstraintViolation("RecordWithConstraints.gain must be at least 0.0".to_string()));
+        }
+        if self.gain > 2.5_f32 {
+            return Err(yardl::Error::ConstraintViolation("RecordWithConstraints.gain must be at most 2.5".to_string()));
+        }
+        if let Some(value) = &self.limit {
+            if *value > 10 {
+                return Err(yardl::Error::ConstraintViolation("RecordWithConstraints.limit must be at most 10".to_string()));
+            }
+        }
+        if self.samples.len() < 1 {
+            return Err(yardl::Error::ConstraintViolation("RecordWithConstraints.samples must have a length of at least 1".to_string()));
+        }
+        if self.samples.len() > 4 {
+            return Err(yardl::Error::ConstraintViolation("RecordWithConstraints.samples must have a length of at most 4".to_string()));
+        }
+        Ok(())
+    }
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct RecordWithPatterns {
+    pub alternation: String,
+    pub word: String,
+    pub code: String,
+    pub escaped: String,
+    pub capitalized: String,
+}
+
+impl RecordWithPatterns {
+    /// Returns an error if a field does not satisfy its constraints.
+    /// Pattern constraints are not checked, since the standard library has no regular expressions.
+    pub fn validate(&self) -> yardl::Result<()> {
+        Ok(())
+    }
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct RecordWithConstrainedRecords {
+    pub single: RecordWithConstraints,
+    pub optional: Option<RecordWithConstraints>,
+    pub vector: Vec<RecordWithConstraints>,
+}
+
+impl RecordWithConstrainedRecords {
+    /// Returns an error if a field does not satisfy its constraints.
+    pub fn validate(&self) -> yardl::Result<()> {
+        self.single.validate()?;
+        if let Some(item0) = &self.optional {
+            item0.validate()?;
+        }
+        for item0 in &self.vector {
+            item0.validate()?;
+        }
+        Ok(())
+    }
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub enum GenericUnionWithRepeatedTypeParameters<T> {
+    T(T),
+    Tv(Vec<T>),
+    Ta(yardl::DynamicNDArray<T>),
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub enum GenericUnion3<T, U, V> {
+    T(T),
+    U(U),
+    V(V),
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub enum GenericUnion3Alternate<U, V, W_> {
+    U(U),
+    V(V),
+    W(W_),
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct RecordNotUsedInProtocol {
+    pub u1: GenericUnion3<i32, f32, String>,
+    pub u2: GenericUnion3Alternate<i32, f32, String>,
+}
+
+pub type ArrayWithKeywordDimensionNames = yardl::NDArray<i32, 2>;
+
+#[derive(Debug, Clone, Copy, PartialEq, Eq, Hash)]
+#[repr(i32)]
+pub enum EnumWithKeywordSymbols {
+    Try = 2,
+    Catch = 1,
+}
+
+/// This comment ends with a Python docstring character"
+#[derive(Debug, Clone, PartialEq)]
+pub struct RecordWithKeywordFields {
+    pub int: String,
+    pub sizeof: ArrayWithKeywordDimensionNames,
+    pub if_: EnumWithKeywordSymbols,
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct RecordWithOptionalDate {
+    pub date_field: Option<yardl::Date>,
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct RecordWithConstants {
+    pub channels: [i32; 4],
+    pub samples: [[f32; 8]; 4],
+    pub gain: f32,
+}
+
+/// A common header
+#[derive(Debug, Clone, PartialEq)]
+pub struct RecordHeader {
+    pub version: u32,
+    pub name: String,
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct LabeledHeader<T> {
+    pub label: T,
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct RecordWithBase {
+    pub version: u32,
+    pub name: String,
+    pub data: Vec<f32>,
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct RecordWithBases {
+    pub version: u32,
+    pub name: String,
+    pub label: String,
+    pub value: i32,
+}
+
+/// A slice with user-defined annotations
+#[derive(Debug, Clone, PartialEq)]
+pub struct RecordWithAnnotations {
+    pub thickness: f32,
+    pub spacing: f32,
+    pub label: String,
+}
+
+#[derive(Debug, Clone, Copy, PartialEq, Eq, Hash)]
+#[repr(i32)]
+pub enum EnumWithAnnotations {
+    A = 0,
+    B = 1,
+}
+
+pub type Millimeters = f32;
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct RecordWithUnits {
+    pub length: f32,
+    pub width: Millimeters,
+    pub durations: Vec<f64>,
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub enum AcquisitionOrImage {
+    Acquisition(SimpleAcquisition),
+    Image(super::image::Image<f32>),
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub enum Int32OrSimpleRecord {
+    Int32(i32),
+    SimpleRecord(SimpleRecord),
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub enum Int32OrRecordWithVlens {
+    Int32(i32),
+    RecordWithVlens(RecordWithVlens),
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub enum Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray {
+    Int32(i32),
+    Float32(f32),
+    String(String),
+    SimpleRecord(SimpleRecord),
+    NamedFixedNDArray(NamedFixedNDArray),
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub enum ImageFloatOrImageDouble {
+    ImageFloat(super::image::FloatImage),
+    ImageDouble(Image<f64>),
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub enum Float32OrString {
+    Float32(f32),
+    String(String),
+}
+
+#[derive(Debug, Clone, PartialEq)]
+pub enum Complexfloat32OrString {
+    Complexfloat32(yardl::Complex<f32>),
+    String(String),
+}
+
+/// The schema written to the header of binary BenchmarkFloat256x256 streams.
+pub const BENCHMARK_FLOAT256X256_SCHEMA: &str = r#"{"protocol":{"name":"BenchmarkFloat256x256","sequence":[{"name":"float256x256","type":{"stream":{"items":{"array":{"items":"float32","dimensions":[{"length":256},{"length":256}]}}}}}]},"types":null}"#;
+
+pub trait BenchmarkFloat256x256Writer {
+    fn write_float256x256(&mut self, values: &[[[f32; 256]; 256]]) -> yardl::Result<()>;
+    /// Marks the end of the float256x256 stream.
+    fn end_float256x256(&mut self) -> yardl::Result<()>;
+    /// Verifies that all steps have been written and flushes the output.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+pub trait BenchmarkFloat256x256Reader {
+    /// Returns `None` when the stream has no more items.
+    fn read_float256x256(&mut self) -> yardl::Result<Option<[[f32; 256]; 256]>>;
+    /// Verifies that all steps have been read.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+/// The schema written to the header of binary BenchmarkInt256x256 streams.
+pub const BENCHMARK_INT256X256_SCHEMA: &str = r#"{"protocol":{"name":"BenchmarkInt256x256","sequence":[{"name":"int256x256","type":{"stream":{"items":{"array":{"items":"int32","dimensions":[{"length":256},{"length":256}]}}}}}]},"types":null}"#;
+
+pub trait BenchmarkInt256x256Writer {
+    fn write_int256x256(&mut self, values: &[[[i32; 256]; 256]]) -> yardl::Result<()>;
+    /// Marks the end of the int256x256 stream.
+    fn end_int256x256(&mut self) -> yardl::Result<()>;
+    /// Verifies that all steps have been written and flushes the output.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+pub trait BenchmarkInt256x256Reader {
+    /// Returns `None` when the stream has no more items.
+    fn read_int256x256(&mut self) -> yardl::Result<Option<[[i32; 256]; 256]>>;
+    /// Verifies that all steps have been read.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+/// The schema written to the header of binary BenchmarkFloatVlen streams.
+pub const BENCHMARK_FLOAT_VLEN_SCHEMA: &str = r#"{"protocol":{"name":"BenchmarkFloatVlen","sequence":[{"name":"floatArray","type":{"stream":{"items":{"array":{"items":"float32","dimensions":2}}}}}]},"types":null}"#;
+
+pub trait BenchmarkFloatVlenWriter {
+    fn write_float_array(&mut self, values: &[yardl::NDArray<f32, 2>]) -> yardl::Result<()>;
+    /// Marks the end of the floatArray stream.
+    fn end_float_array(&mut self) -> yardl::Result<()>;
+    /// Verifies that all steps have been written and flushes the output.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+pub trait BenchmarkFloatVlenReader {
+    /// Returns `None` when the stream has no more items.
+    fn read_float_array(&mut self) -> yardl::Result<Option<yardl::NDArray<f32, 2>>>;
+    /// Verifies that all steps have been read.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+/// The schema written to the header of binary BenchmarkSmallRecord streams.
+pub const BENCHMARK_SMALL_RECORD_SCHEMA: &str = r#"{"protocol":{"name":"BenchmarkSmallRecord","sequence":[{"name":"smallRecord","type":{"stream":{"items":"TestModel.SmallBenchmarkRecord"}}}]},"types":[{"name":"SmallBenchmarkRecord","fields":[{"name":"a","type":"float64"},{"name":"b","type":"float32"},{"name":"c","type":"float32"}]}]}"#;
+
+pub trait BenchmarkSmallRecordWriter {
+    fn write_small_record(&mut self, values: &[SmallBenchmarkRecord]) -> yardl::Result<()>;
+    /// Marks the end of the smallRecord stream.
+    fn end_small_record(&mut self) -> yardl::Result<()>;
+    /// Verifies that all steps have been written and flushes the output.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+pub trait BenchmarkSmallRecordReader {
+    /// Returns `None` when the stream has no more items.
+    fn read_small_record(&mut self) -> yardl::Result<Option<SmallBenchmarkRecord>>;
+    /// Verifies that all steps have been read.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+/// The schema written to the header of binary BenchmarkSmallRecordWithOptionals streams.
+pub const BENCHMARK_SMALL_RECORD_WITH_OPTIONALS_SCHEMA: &str = r#"{"protocol":{"name":"BenchmarkSmallRecordWithOptionals","sequence":[{"name":"smallRecord","type":{"stream":{"items":"TestModel.SimpleEncodingCounters"}}}]},"types":[{"name":"SimpleEncodingCounters","fields":[{"name":"e1","type":[null,"uint32"]},{"name":"e2","type":[null,"uint32"]},{"name":"slice","type":[null,"uint32"]},{"name":"repetition","type":[null,"uint32"]}]}]}"#;
+
+pub trait BenchmarkSmallRecordWithOptionalsWriter {
+    fn write_small_record(&mut self, values: &[SimpleEncodingCounters]) -> yardl::Result<()>;
+    /// Marks the end of the smallRecord stream.
+    fn end_small_record(&mut self) -> yardl::Result<()>;
+    /// Verifies that all steps have been written and flushes the output.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+pub trait BenchmarkSmallRecordWithOptionalsReader {
+    /// Returns `None` when the stream has no more items.
+    fn read_small_record(&mut self) -> yardl::Result<Option<SimpleEncodingCounters>>;
+    /// Verifies that all steps have been read.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+/// The schema written to the header of binary BenchmarkSimpleMrd streams.
+pub const BENCHMARK_SIMPLE_MRD_SCHEMA: &str = r#"{"protocol":{"name":"BenchmarkSimpleMrd","sequence":[{"name":"data","type":{"stream":{"items":[{"tag":"acquisition","explicitTag":true,"type":"TestModel.SimpleAcquisition"},{"tag":"image","explicitTag":true,"type":{"name":"Image.Image","typeArguments":["float32"]}}]}}}]},"types":[{"name":"Image","typeParameters":["T"],"type":{"array":{"items":"T","dimensions":[{"name":"x"},{"name":"y"}]}}},{"name":"SimpleAcquisition","fields":[{"name":"flags","type":"uint64"},{"name":"idx","type":"TestModel.SimpleEncodingCounters"},{"name":"data","type":{"array":{"items":"complexfloat32","dimensions":2}}},{"name":"trajectory","type":{"array":{"items":"float32","dimensions":2}}}]},{"name":"SimpleEncodingCounters","fields":[{"name":"e1","type":[null,"uint32"]},{"name":"e2","type":[null,"uint32"]},{"name":"slice","type":[null,"uint32"]},{"name":"repetition","type":[null,"uint32"]}]}]}"#;
+
+pub trait BenchmarkSimpleMrdWriter {
+    fn write_data(&mut self, values: &[AcquisitionOrImage]) -> yardl::Result<()>;
+    /// Marks the end of the data stream.
+    fn end_data(&mut self) -> yardl::Result<()>;
+    /// Verifies that all steps have been written and flushes the output.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+pub trait BenchmarkSimpleMrdReader {
+    /// Returns `None` when the stream has no more items.
+    fn read_data(&mut self) -> yardl::Result<Option<AcquisitionOrImage>>;
+    /// Verifies that all steps have been read.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+/// The schema written to the header of binary Scalars streams.
+pub const SCALARS_SCHEMA: &str = r#"{"protocol":{"name":"Scalars","sequence":[{"name":"int32","type":"int32"},{"name":"record","type":"TestModel.RecordWithPrimitives"}]},"types":[{"name":"RecordWithPrimitives","fields":[{"name":"boolField","type":"bool"},{"name":"int8Field","type":"int8"},{"name":"uint8Field","type":"uint8"},{"name":"int16Field","type":"int16"},{"name":"uint16Field","type":"uint16"},{"name":"int32Field","type":"int32"},{"name":"uint32Field","type":"uint32"},{"name":"int64Field","type":"int64"},{"name":"uint64Field","type":"uint64"},{"name":"sizeField","type":"size"},{"name":"float32Field","type":"float32"},{"name":"float64Field","type":"float64"},{"name":"complexfloat32Field","type":"complexfloat32"},{"name":"complexfloat64Field","type":"complexfloat64"},{"name":"dateField","type":"date"},{"name":"timeField","type":"time"},{"name":"datetimeField","type":"datetime"}]}]}"#;
+
+pub trait ScalarsWriter {
+    fn write_int32(&mut self, value: &i32) -> yardl::Result<()>;
+    fn write_record(&mut self, value: &RecordWithPrimitives) -> yardl::Result<()>;
+    /// Verifies that all steps have been written and flushes the output.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+pub trait ScalarsReader {
+    fn read_int32(&mut self) -> yardl::Result<i32>;
+    fn read_record(&mut self) -> yardl::Result<RecordWithPrimitives>;
+    /// Verifies that all steps have been read.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+/// The schema written to the header of binary ScalarOptionals streams.
+pub const SCALAR_OPTIONALS_SCHEMA: &str = r#"{"protocol":{"name":"ScalarOptionals","sequence":[{"name":"optionalInt","type":[null,"int32"]},{"name":"optionalRecord","type":[null,"TestModel.SimpleRecord"]},{"name":"recordWithOptionalFields","type":"TestModel.RecordWithOptionalFields"},{"name":"optionalRecordWithOptionalFields","type":[null,"TestModel.RecordWithOptionalFields"]}]},"types":[{"name":"RecordWithOptionalFields","fields":[{"name":"optionalInt","type":[null,"int32"]},{"name":"optionalIntAlternateSyntax","type":[null,"int32"]},{"name":"optionalTime","type":[null,"time"]}]},{"name":"SimpleRecord","fields":[{"name":"x","type":"int32"},{"name":"y","type":"int32"},{"name":"z","type":"int32"}]}]}"#;
+
+pub trait ScalarOptionalsWriter {
+    fn write_optional_int(&mut self, value: &Option<i32>) -> yardl::Result<()>;
+    fn write_optional_record(&mut self, value: &Option<SimpleRecord>) -> yardl::Result<()>;
+    fn write_record_with_optional_fields(&mut self, value: &RecordWithOptionalFields) -> yardl::Result<()>;
+    fn write_optional_record_with_optional_fields(&mut self, value: &Option<RecordWithOptionalFields>) -> yardl::Result<()>;
+    /// Verifies that all steps have been written and flushes the output.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+pub trait ScalarOptionalsReader {
+    fn read_optional_int(&mut self) -> yardl::Result<Option<i32>>;
+    fn read_optional_record(&mut self) -> yardl::Result<Option<SimpleRecord>>;
+    fn read_record_with_optional_fields(&mut self) -> yardl::Result<RecordWithOptionalFields>;
+    fn read_optional_record_with_optional_fields(&mut self) -> yardl::Result<Option<RecordWithOptionalFields>>;
+    /// Verifies that all steps have been read.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+/// The schema written to the header of binary NestedRecords streams.
+pub const NESTED_RECORDS_SCHEMA: &str = r#"{"protocol":{"name":"NestedRecords","sequence":[{"name":"tupleWithRecords","type":"TestModel.TupleWithRecords"}]},"types":[{"name":"SimpleRecord","fields":[{"name":"x","type":"int32"},{"name":"y","type":"int32"},{"name":"z","type":"int32"}]},{"name":"TupleWithRecords","fields":[{"name":"a","type":"TestModel.SimpleRecord"},{"name":"b","type":"TestModel.SimpleRecord"}]}]}"#;
+
+pub trait NestedRecordsWriter {
+    fn write_tuple_with_records(&mut self, value: &TupleWithRecords) -> yardl::Result<()>;
+    /// Verifies that all steps have been written and flushes the output.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+pub trait NestedRecordsReader {
+    fn read_tuple_with_records(&mut self) -> yardl::Result<TupleWithRecords>;
+    /// Verifies that all steps have been read.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+/// The schema written to the header of binary Vlens streams.
+pub const VLENS_SCHEMA: &str = r#"{"protocol":{"name":"Vlens","sequence":[{"name":"intVector","type":{"vector":{"items":"int32"}}},{"name":"complexVector","type":{"vector":{"items":"complexfloat32"}}},{"name":"recordWithVlens","type":"TestModel.RecordWithVlens"},{"name":"vlenOfRecordWithVlens","type":{"vector":{"items":"TestModel.RecordWithVlens"}}}]},"types":[{"name":"RecordWithVlens","fields":[{"name":"a","type":{"vector":{"items":"TestModel.SimpleRecord"}}},{"name":"b","type":"int32"},{"name":"c","type":"int32"}]},{"name":"SimpleRecord","fields":[{"name":"x","type":"int32"},{"name":"y","type":"int32"},{"name":"z","type":"int32"}]}]}"#;
+
+pub trait VlensWriter {
+    fn write_int_vector(&mut self, value: &Vec<i32>) -> yardl::Result<()>;
+    fn write_complex_vector(&mut self, value: &Vec<yardl::Complex<f32>>) -> yardl::Result<()>;
+    fn write_record_with_vlens(&mut self, value: &RecordWithVlens) -> yardl::Result<()>;
+    fn write_vlen_of_record_with_vlens(&mut self, value: &Vec<RecordWithVlens>) -> yardl::Result<()>;
+    /// Verifies that all steps have been written and flushes the output.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+pub trait VlensReader {
+    fn read_int_vector(&mut self) -> yardl::Result<Vec<i32>>;
+    fn read_complex_vector(&mut self) -> yardl::Result<Vec<yardl::Complex<f32>>>;
+    fn read_record_with_vlens(&mut self) -> yardl::Result<RecordWithVlens>;
+    fn read_vlen_of_record_with_vlens(&mut self) -> yardl::Result<Vec<RecordWithVlens>>;
+    /// Verifies that all steps have been read.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+/// The schema written to the header of binary Strings streams.
+pub const STRINGS_SCHEMA: &str = r#"{"protocol":{"name":"Strings","sequence":[{"name":"singleString","type":"string"},{"name":"recWithString","type":"TestModel.RecordWithStrings"}]},"types":[{"name":"RecordWithStrings","fields":[{"name":"a","type":"string"},{"name":"b","type":"string"}]}]}"#;
+
+pub trait StringsWriter {
+    fn write_single_string(&mut self, value: &String) -> yardl::Result<()>;
+    fn write_rec_with_string(&mut self, value: &RecordWithStrings) -> yardl::Result<()>;
+    /// Verifies that all steps have been written and flushes the output.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+pub trait StringsReader {
+    fn read_single_string(&mut self) -> yardl::Result<String>;
+    fn read_rec_with_string(&mut self) -> yardl::Result<RecordWithStrings>;
+    /// Verifies that all steps have been read.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+/// The schema written to the header of binary ProtocolWithBytes streams.
+pub const PROTOCOL_WITH_BYTES_SCHEMA: &str = r#"{"protocol":{"name":"ProtocolWithBytes","sequence":[{"name":"singleBytes","type":"bytes"},{"name":"recWithBytes","type":"TestModel.RecordWithBytes"}]},"types":[{"name":"RecordWithBytes","fields":[{"name":"data","type":"bytes"},{"name":"optionalData","type":[null,"bytes"]},{"name":"chunks","type":{"vector":{"items":"bytes"}}}]}]}"#;
+
+pub trait ProtocolWithBytesWriter {
+    fn write_single_bytes(&mut self, value: &Vec<u8>) -> yardl::Result<()>;
+    fn write_rec_with_bytes(&mut self, value: &RecordWithBytes) -> yardl::Result<()>;
+    /// Verifies that all steps have been written and flushes the output.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+pub trait ProtocolWithBytesReader {
+    fn read_single_bytes(&mut self) -> yardl::Result<Vec<u8>>;
+    fn read_rec_with_bytes(&mut self) -> yardl::Result<RecordWithBytes>;
+    /// Verifies that all steps have been read.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+/// The schema written to the header of binary ProtocolWithUuids streams.
+pub const PROTOCOL_WITH_UUIDS_SCHEMA: &str = r#"{"protocol":{"name":"ProtocolWithUuids","sequence":[{"name":"singleUuid","type":"uuid"},{"name":"recWithUuids","type":"TestModel.RecordWithUuids"}]},"types":[{"name":"RecordWithUuids","fields":[{"name":"id","type":"uuid"},{"name":"optionalId","type":[null,"uuid"]},{"name":"related","type":{"vector":{"items":"uuid"}}},{"name":"names","type":{"map":{"keys":"uuid","values":"string"}}}]}]}"#;
+
+pub trait ProtocolWithUuidsWriter {
+    fn write_single_uuid(&mut self, value: &yardl::Uuid) -> yardl::Result<()>;
+    fn write_rec_with_uuids(&mut self, value: &RecordWithUuids) -> yardl::Result<()>;
+    /// Verifies that all steps have been written and flushes the output.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+pub trait ProtocolWithUuidsReader {
+    fn read_single_uuid(&mut self) -> yardl::Result<yardl::Uuid>;
+    fn read_rec_with_uuids(&mut self) -> yardl::Result<RecordWithUuids>;
+    /// Verifies that all steps have been read.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+/// The schema written to the header of binary ProtocolWithDurations streams.
+pub const PROTOCOL_WITH_DURATIONS_SCHEMA: &str = r#"{"protocol":{"name":"ProtocolWithDurations","sequence":[{"name":"singleDuration","type":"duration"},{"name":"recWithDurations","type":"TestModel.RecordWithDurations"}]},"types":[{"name":"RecordWithDurations","fields":[{"name":"acquisitionStart","type":"datetime"},{"name":"acquisitionEnd","type":"datetime"},{"name":"repetitionTime","type":"duration"},{"name":"timeout","type":[null,"duration"]},{"name":"intervals","type":{"vector":{"items":"duration"}}}]}]}"#;
+
+pub trait ProtocolWithDurationsWriter {
+    fn write_single_duration(&mut self, value: &yardl::Duration) -> yardl::Result<()>;
+    fn write_rec_with_durations(&mut self, value: &RecordWithDurations) -> yardl::Result<()>;
+    /// Verifies that all steps have been written and flushes the output.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+pub trait ProtocolWithDurationsReader {
+    fn read_single_duration(&mut self) -> yardl::Result<yardl::Duration>;
+    fn read_rec_with_durations(&mut self) -> yardl::Result<RecordWithDurations>;
+    /// Verifies that all steps have been read.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+/// The schema written to the header of binary ProtocolWithRecursiveRecords streams.
+pub const PROTOCOL_WITH_RECURSIVE_RECORDS_SCHEMA: &str = r#"{"protocol":{"name":"ProtocolWithRecursiveRecords","sequence":[{"name":"tree","type":"TestModel.TreeNode"},{"name":"list","type":"TestModel.LinkedListNode"},{"name":"expressions","type":{"stream":{"items":"TestModel.Expression"}}}]},"types":[{"name":"Expression","fields":[{"name":"name","type":"string"},{"name":"operand","type":[{"tag":"int32","type":"int32"},{"tag":"Expression","type":"TestModel.Expression"}]},{"name":"arguments","type":{"map":{"keys":"string","values":"TestModel.Expression"}}}]},{"name":"LinkedListNode","fields":[{"name":"value","type":"int32"},{"name":"next","type":[null,"TestModel.LinkedListNode"]}]},{"name":"TreeNode","fields":[{"name":"label","type":"string"},{"name":"children","type":{"vector":{"items":"TestModel.TreeNode"}}}]}]}"#;
+
+pub trait ProtocolWithRecursiveRecordsWriter {
+    fn write_tree(&mut self, value: &TreeNode) -> yardl::Result<()>;
+    fn write_list(&mut self, value: &LinkedListNode) -> yardl::Result<()>;
+    fn write_expressions(&mut self, values: &[Expression]) -> yardl::Result<()>;
+    /// Marks the end of the expressions stream.
+    fn end_expressions(&mut self) -> yardl::Result<()>;
+    /// Verifies that all steps have been written and flushes the output.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+pub trait ProtocolWithRecursiveRecordsReader {
+    fn read_tree(&mut self) -> yardl::Result<TreeNode>;
+    fn read_list(&mut self) -> yardl::Result<LinkedListNode>;
+    /// Returns `None` when the stream has no more items.
+    fn read_expressions(&mut self) -> yardl::Result<Option<Expression>>;
+    /// Verifies that all steps have been read.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+/// The schema written to the header of binary Calibration streams.
+pub const CALIBRATION_SCHEMA: &str = r#"{"protocol":{"name":"Calibration","sequence":[{"name":"gain","type":"float32"},{"name":"samples","type":{"stream":{"items":"float64"}}}]},"types":null}"#;
+
+/// A calibration phase shared by protocols
+pub trait CalibrationWriter {
+    fn write_gain(&mut self, value: &f32) -> yardl::Result<()>;
+    fn write_samples(&mut self, values: &[f64]) -> yardl::Result<()>;
+    /// Marks the end of the samples stream.
+    fn end_samples(&mut self) -> yardl::Result<()>;
+    /// Verifies that all steps have been written and flushes the output.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+/// A calibration phase shared by protocols
+pub trait CalibrationReader {
+    fn read_gain(&mut self) -> yardl::Result<f32>;
+    /// Returns `None` when the stream has no more items.
+    fn read_samples(&mut self) -> yardl::Result<Option<f64>>;
+    /// Verifies that all steps have been read.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+/// The schema written to the header of binary ProtocolWithSubProtocols streams.
+pub const PROTOCOL_WITH_SUB_PROTOCOLS_SCHEMA: &str = r#"{"protocol":{"name":"ProtocolWithSubProtocols","sequence":[{"name":"header","type":"string"},{"name":"calibrationGain","type":"float32"},{"name":"calibrationSamples","type":{"stream":{"items":"float64"}}},{"name":"data","type":{"stream":{"items":"int32"}}},{"name":"recalibrationGain","type":"float32"},{"name":"recalibrationSamples","type":{"stream":{"items":"float64"}}}]},"types":null}"#;
+
+pub trait ProtocolWithSubProtocolsWriter {
+    fn write_header(&mut self, value: &String) -> yardl::Result<()>;
+    fn write_calibration_gain(&mut self, value: &f32) -> yardl::Result<()>;
+    fn write_calibration_samples(&mut self, values: &[f64]) -> yardl::Result<()>;
+    /// Marks the end of the calibrationSamples stream.
+    fn end_calibration_samples(&mut self) -> yardl::Result<()>;
+    fn write_data(&mut self, values: &[i32]) -> yardl::Result<()>;
+    /// Marks the end of the data stream.
+    fn end_data(&mut self) -> yardl::Result<()>;
+    fn write_recalibration_gain(&mut self, value: &f32) -> yardl::Result<()>;
+    fn write_recalibration_samples(&mut self, values: &[f64]) -> yardl::Result<()>;
+    /// Marks the end of the recalibrationSamples stream.
+    fn end_recalibration_samples(&mut self) -> yardl::Result<()>;
+    /// Returns a writer for the calibration steps, which are written through this writer.
+    /// Calibration before the data
+    fn calibration(&mut self) -> ProtocolWithSubProtocolsCalibrationSubWriter<'_, Self> where Self: Sized {
+        ProtocolWithSubProtocolsCalibrationSubWriter { outer: self }
+    }
+    /// Returns a writer for the recalibration steps, which are written through this writer.
+    fn recalibration(&mut self) -> ProtocolWithSubProtocolsRecalibrationSubWriter<'_, Self> where Self: Sized {
+        ProtocolWithSubProtocolsRecalibrationSubWriter { outer: self }
+    }
+    /// Verifies that all steps have been written and flushes the output.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+pub trait ProtocolWithSubProtocolsReader {
+    fn read_header(&mut self) -> yardl::Result<String>;
+    fn read_calibration_gain(&mut self) -> yardl::Result<f32>;
+    /// Returns `None` when the stream has no more items.
+    fn read_calibration_samples(&mut self) -> yardl::Result<Option<f64>>;
+    /// Returns `None` when the stream has no more items.
+    fn read_data(&mut self) -> yardl::Result<Option<i32>>;
+    fn read_recalibration_gain(&mut self) -> yardl::Result<f32>;
+    /// Returns `None` when the stream has no more items.
+    fn read_recalibration_samples(&mut self) -> yardl::Result<Option<f64>>;
+    /// Returns a reader for the calibration steps, which are read through this reader.
+    /// Calibration before the data
+    fn calibration(&mut self) -> ProtocolWithSubProtocolsCalibrationSubReader<'_, Self> where Self: Sized {
+        ProtocolWithSubProtocolsCalibrationSubReader { outer: self }
+    }
+    /// Returns a reader for the recalibration steps, which are read through this reader.
+    fn recalibration(&mut self) -> ProtocolWithSubProtocolsRecalibrationSubReader<'_, Self> where Self: Sized {
+        ProtocolWithSubProtocolsRecalibrationSubReader { outer: self }
+    }
+    /// Verifies that all steps have been read.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+/// Writes the calibration steps of ProtocolWithSubProtocols through its writer.
+pub struct ProtocolWithSubProtocolsCalibrationSubWriter<'a, W: ProtocolWithSubProtocolsWriter> {
+    outer: &'a mut W,
+}
+
+impl<'a, W: ProtocolWithSubProtocolsWriter> CalibrationWriter for ProtocolWithSubProtocolsCalibrationSubWriter<'a, W> {
+    fn write_gain(&mut self, value: &f32) -> yardl::Result<()> {
+        self.outer.write_calibration_gain(value)
+    }
+    fn write_samples(&mut self, values: &[f64]) -> yardl::Result<()> {
+        self.outer.write_calibration_samples(values)
+    }
+    fn end_samples(&mut self) -> yardl::Result<()> {
+        self.outer.end_calibration_samples()
+    }
+    fn close(&mut self) -> yardl::Result<()> {
+        Ok(())
+    }
+}
+
+/// Reads the calibration steps of ProtocolWithSubProtocols through its reader.
+pub struct ProtocolWithSubProtocolsCalibrationSubReader<'a, R: ProtocolWithSubProtocolsReader> {
+    outer: &'a mut R,
+}
+
+impl<'a, R: ProtocolWithSubProtocolsReader> CalibrationReader for ProtocolWithSubProtocolsCalibrationSubReader<'a, R> {
+    fn read_gain(&mut self) -> yardl::Result<f32> {
+        self.outer.read_calibration_gain()
+    }
+    fn read_samples(&mut self) -> yardl::Result<Option<f64>> {
+        self.outer.read_calibration_samples()
+    }
+    fn close(&mut self) -> yardl::Result<()> {
+        Ok(())
+    }
+}
+
+/// Writes the recalibration steps of ProtocolWithSubProtocols through its writer.
+pub struct ProtocolWithSubProtocolsRecalibrationSubWriter<'a, W: ProtocolWithSubProtocolsWriter> {
+    outer: &'a mut W,
+}
+
+impl<'a, W: ProtocolWithSubProtocolsWriter> CalibrationWriter for ProtocolWithSubProtocolsRecalibrationSubWriter<'a, W> {
+    fn write_gain(&mut self, value: &f32) -> yardl::Result<()> {
+        self.outer.write_recalibration_gain(value)
+    }
+    fn write_samples(&mut self, values: &[f64]) -> yardl::Result<()> {
+        self.outer.write_recalibration_samples(values)
+    }
+    fn end_samples(&mut self) -> yardl::Result<()> {
+        self.outer.end_recalibration_samples()
+    }
+    fn close(&mut self) -> yardl::Result<()> {
+        Ok(())
+    }
+}
+
+/// Reads the recalibration steps of ProtocolWithSubProtocols through its reader.
+pub struct ProtocolWithSubProtocolsRecalibrationSubReader<'a, R: ProtocolWithSubProtocolsReader> {
+    outer: &'a mut R,
+}
+
+impl<'a, R: ProtocolWithSubProtocolsReader> CalibrationReader for ProtocolWithSubProtocolsRecalibrationSubReader<'a, R> {
+    fn read_gain(&mut self) -> yardl::Result<f32> {
+        self.outer.read_recalibration_gain()
+    }
+    fn read_samples(&mut self) -> yardl::Result<Option<f64>> {
+        self.outer.read_recalibration_samples()
+    }
+    fn close(&mut self) -> yardl::Result<()> {
+        Ok(())
+    }
+}
+
+/// The schema written to the header of binary ProtocolWithHalfPrecision streams.
+pub const PROTOCOL_WITH_HALF_PRECISION_SCHEMA: &str = r#"{"protocol":{"name":"ProtocolWithHalfPrecision","sequence":[{"name":"halves","type":{"vector":{"items":"float16"}}},{"name":"recWithHalves","type":"TestModel.RecordWithHalfPrecision"}]},"types":[{"name":"RecordWithHalfPrecision","fields":[{"name":"half","type":"float16"},{"name":"brain","type":"bfloat16"},{"name":"halfVector","type":{"vector":{"items":"float16"}}},{"name":"brainArray","type":{"array":{"items":"bfloat16"}}}]}]}"#;
+
+pub trait ProtocolWithHalfPrecisionWriter {
+    fn write_halves(&mut self, value: &Vec<yardl::Float16>) -> yardl::Result<()>;
+    fn write_rec_with_halves(&mut self, value: &RecordWithHalfPrecision) -> yardl::Result<()>;
+    /// Verifies that all steps have been written and flushes the output.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+pub trait ProtocolWithHalfPrecisionReader {
+    fn read_halves(&mut self) -> yardl::Result<Vec<yardl::Float16>>;
+    fn read_rec_with_halves(&mut self) -> yardl::Result<RecordWithHalfPrecision>;
+    /// Verifies that all steps have been read.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+/// The schema written to the header of binary OptionalVectors streams.
+pub const OPTIONAL_VECTORS_SCHEMA: &str = r#"{"protocol":{"name":"OptionalVectors","sequence":[{"name":"recordWithOptionalVector","type":"TestModel.RecordWithOptionalVector"}]},"types":[{"name":"RecordWithOptionalVector","fields":[{"name":"optionalVector","type":[null,{"vector":{"items":"int32"}}]}]}]}"#;
+
+pub trait OptionalVectorsWriter {
+    fn write_record_with_optional_vector(&mut self, value: &RecordWithOptionalVector) -> yardl::Result<()>;
+    /// Verifies that all steps have been written and flushes the output.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+pub trait OptionalVectorsReader {
+    fn read_record_with_optional_vector(&mut self) -> yardl::Result<RecordWithOptionalVector>;
+    /// Verifies that all steps have been read.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+/// The schema written to the header of binary FixedVectors streams.
+pub const FIXED_VECTORS_SCHEMA: &str = r#"{"protocol":{"name":"FixedVectors","sequence":[{"name":"fixedIntVector","type":{"vector":{"items":"int32","length":5}}},{"name":"fixedSimpleRecordVector","type":{"vector":{"items":"TestModel.SimpleRecord","length":3}}},{"name":"fixedRecordWithVlensVector","type":{"vector":{"items":"TestModel.RecordWithVlens","length":2}}},{"name":"recordWithFixedVectors","type":"TestModel.RecordWithFixedVectors"}]},"types":[{"name":"RecordWithFixedVectors","fields":[{"name":"fixedIntVector","type":{"vector":{"items":"int32","length":5}}},{"name":"fixedSimpleRecordVector","type":{"vector":{"items":"TestModel.SimpleRecord","length":3}}},{"name":"fixedRecordWithVlensVector","type":{"vector":{"items":"TestModel.RecordWithVlens","length":2}}}]},{"name":"RecordWithVlens","fields":[{"name":"a","type":{"vector":{"items":"TestModel.SimpleRecord"}}},{"name":"b","type":"int32"},{"name":"c","type":"int32"}]},{"name":"SimpleRecord","fields":[{"name":"x","type":"int32"},{"name":"y","type":"int32"},{"name":"z","type":"int32"}]}]}"#;
+
+pub trait FixedVectorsWriter {
+    fn write_fixed_int_vector(&mut self, value: &[i32; 5]) -> yardl::Result<()>;
+    fn write_fixed_simple_record_vector(&mut self, value: &[SimpleRecord; 3]) -> yardl::Result<()>;
+    fn write_fixed_record_with_vlens_vector(&mut self, value: &[RecordWithVlens; 2]) -> yardl::Result<()>;
+    fn write_record_with_fixed_vectors(&mut self, value: &RecordWithFixedVectors) -> yardl::Result<()>;
+    /// Verifies that all steps have been written and flushes the output.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+pub trait FixedVectorsReader {
+    fn read_fixed_int_vector(&mut self) -> yardl::Result<[i32; 5]>;
+    fn read_fixed_simple_record_vector(&mut self) -> yardl::Result<[SimpleRecord; 3]>;
+    fn read_fixed_record_with_vlens_vector(&mut self) -> yardl::Result<[RecordWithVlens; 2]>;
+    fn read_record_with_fixed_vectors(&mut self) -> yardl::Result<RecordWithFixedVectors>;
+    /// Verifies that all steps have been read.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+/// The schema written to the header of binary Streams streams.
+pub const STREAMS_SCHEMA: &str = r#"{"protocol":{"name":"Streams","sequence":[{"name":"intData","type":{"stream":{"items":"int32"}}},{"name":"optionalIntData","type":{"stream":{"items":[null,"int32"]}}},{"name":"recordWithOptionalVectorData","type":{"stream":{"items":"TestModel.RecordWithOptionalVector"}}},{"name":"fixedVector","type":{"stream":{"items":{"vector":{"items":"int32","length":3}}}}}]},"types":[{"name":"RecordWithOptionalVector","fields":[{"name":"optionalVector","type":[null,{"vector":{"items":"int32"}}]}]}]}"#;
+
+pub trait StreamsWriter {
+    fn write_int_data(&mut self, values: &[i32]) -> yardl::Result<()>;
+    /// Marks the end of the intData stream.
+    fn end_int_data(&mut self) -> yardl::Result<()>;
+    fn write_optional_int_data(&mut self, values: &[Option<i32>]) -> yardl::Result<()>;
+    /// Marks the end of the optionalIntData stream.
+    fn end_optional_int_data(&mut self) -> yardl::Result<()>;
+    fn write_record_with_optional_vector_data(&mut self, values: &[RecordWithOptionalVector]) -> yardl::Result<()>;
+    /// Marks the end of the recordWithOptionalVectorData stream.
+    fn end_record_with_optional_vector_data(&mut self) -> yardl::Result<()>;
+    fn write_fixed_vector(&mut self, values: &[[i32; 3]]) -> yardl::Result<()>;
+    /// Marks the end of the fixedVector stream.
+    fn end_fixed_vector(&mut self) -> yardl::Result<()>;
+    /// Verifies that all steps have been written and flushes the output.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+pub trait StreamsReader {
+    /// Returns `None` when the stream has no more items.
+    fn read_int_data(&mut self) -> yardl::Result<Option<i32>>;
+    /// Returns `None` when the stream has no more items.
+    fn read_optional_int_data(&mut self) -> yardl::Result<Option<Option<i32>>>;
+    /// Returns `None` when the stream has no more items.
+    fn read_record_with_optional_vector_data(&mut self) -> yardl::Result<Option<RecordWithOptionalVector>>;
+    /// Returns `None` when the stream has no more items.
+    fn read_fixed_vector(&mut self) -> yardl::Result<Option<[i32; 3]>>;
+    /// Verifies that all steps have been read.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+/// The schema written to the header of binary FixedArrays streams.
+pub const FIXED_ARRAYS_SCHEMA: &str = r#"{"protocol":{"name":"FixedArrays","sequence":[{"name":"ints","type":{"array":{"items":"int32","dimensions":[{"length":2},{"length":3}]}}},{"name":"fixedSimpleRecordArray","type":{"array":{"items":"TestModel.SimpleRecord","dimensions":[{"length":3},{"length":2}]}}},{"name":"fixedRecordWithVlensArray","type":{"array":{"items":"TestModel.RecordWithVlens","dimensions":[{"length":2},{"length":2}]}}},{"name":"recordWithFixedArrays","type":"TestModel.RecordWithFixedArrays"},{"name":"namedArray","type":"TestModel.NamedFixedNDArray"}]},"types":[{"name":"NamedFixedNDArray","type":{"array":{"items":"int32","dimensions":[{"name":"dimA","length":2},{"name":"dimB","length":4}]}}},{"name":"RecordWithFixedArrays","fields":[{"name":"ints","type":{"array":{"items":"int32","dimensions":[{"length":2},{"length":3}]}}},{"name":"fixedSimpleRecordArray","type":{"array":{"items":"TestModel.SimpleRecord","dimensions":[{"length":3},{"length":2}]}}},{"name":"fixedRecordWithVlensArray","type":{"array":{"items":"TestModel.RecordWithVlens","dimensions":[{"length":2},{"length":2}]}}}]},{"name":"RecordWithVlens","fields":[{"name":"a","type":{"vector":{"items":"TestModel.SimpleRecord"}}},{"name":"b","type":"int32"},{"name":"c","type":"int32"}]},{"name":"SimpleRecord","fields":[{"name":"x","type":"int32"},{"name":"y","type":"int32"},{"name":"z","type":"int32"}]}]}"#;
+
+pub trait FixedArraysWriter {
+    fn write_ints(&mut self, value: &[[i32; 3]; 2]) -> yardl::Result<()>;
+    fn write_fixed_simple_record_array(&mut self, value: &[[SimpleRecord; 2]; 3]) -> yardl::Result<()>;
+    fn write_fixed_record_with_vlens_array(&mut self, value: &[[RecordWithVlens; 2]; 2]) -> yardl::Result<()>;
+    fn write_record_with_fixed_arrays(&mut self, value: &RecordWithFixedArrays) -> yardl::Result<()>;
+    fn write_named_array(&mut self, value: &NamedFixedNDArray) -> yardl::Result<()>;
+    /// Verifies that all steps have been written and flushes the output.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+pub trait FixedArraysReader {
+    fn read_ints(&mut self) -> yardl::Result<[[i32; 3]; 2]>;
+    fn read_fixed_simple_record_array(&mut self) -> yardl::Result<[[SimpleRecord; 2]; 3]>;
+    fn read_fixed_record_with_vlens_array(&mut self) -> yardl::Result<[[RecordWithVlens; 2]; 2]>;
+    fn read_record_with_fixed_arrays(&mut self) -> yardl::Result<RecordWithFixedArrays>;
+    fn read_named_array(&mut self) -> yardl::Result<NamedFixedNDArray>;
+    /// Verifies that all steps have been read.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+/// The schema written to the header of binary Subarrays streams.
+pub const SUBARRAYS_SCHEMA: &str = r#"{"protocol":{"name":"Subarrays","sequence":[{"name":"dynamicWithFixedIntSubarray","type":{"array":{"items":{"array":{"items":"int32","dimensions":[{"length":3}]}}}}},{"name":"dynamicWithFixedFloatSubarray","type":{"array":{"items":{"array":{"items":"float32","dimensions":[{"length":3}]}}}}},{"name":"knownDimCountWithFixedIntSubarray","type":{"array":{"items":{"array":{"items":"int32","dimensions":[{"length":3}]}},"dimensions":1}}},{"name":"knownDimCountWithFixedFloatSubarray","type":{"array":{"items":{"array":{"items":"float32","dimensions":[{"length":3}]}},"dimensions":1}}},{"name":"fixedWithFixedIntSubarray","type":{"array":{"items":{"array":{"items":"int32","dimensions":[{"length":3}]}},"dimensions":[{"length":2}]}}},{"name":"fixedWithFixedFloatSubarray","type":{"array":{"items":{"array":{"items":"float32","dimensions":[{"length":3}]}},"dimensions":[{"length":2}]}}},{"name":"nestedSubarray","type":{"array":{"items":{"array":{"items":{"array":{"items":"int32","dimensions":[{"length":3}]}},"dimensions":[{"length":2}]}}}}},{"name":"dynamicWithFixedVectorSubarray","type":{"array":{"items":{"vector":{"items":"int32","length":3}}}}},{"name":"genericSubarray","type":{"name":"TestModel.Image","typeArguments":[{"array":{"items":"int32","dimensions":[{"length":3}]}}]}}]},"types":[{"name":"Image","typeParameters":["T"],"type":{"array":{"items":"T","dimensions":[{"name":"x"},{"name":"y"}]}}},{"name":"Image","typeParameters":["T"],"type":{"name":"Image.Image","typeArguments":["T"]}}]}"#;
+
+pub trait SubarraysWriter {
+    fn write_dynamic_with_fixed_int_subarray(&mut self, value: &yardl::DynamicNDArray<[i32; 3]>) -> yardl::Result<()>;
+    fn write_dynamic_with_fixed_float_subarray(&mut self, value: &yardl::DynamicNDArray<[f32; 3]>) -> yardl::Result<()>;
+    fn write_known_dim_count_with_fixed_int_subarray(&mut self, value: &yardl::NDArray<[i32; 3], 1>) -> yardl::Result<()>;
+    fn write_known_dim_count_with_fixed_float_subarray(&mut self, value: &yardl::NDArray<[f32; 3], 1>) -> yardl::Result<()>;
+    fn write_fixed_with_fixed_int_subarray(&mut self, value: &[[i32; 3]; 2]) -> yardl::Result<()>;
+    fn write_fixed_with_fixed_float_subarray(&mut self, value: &[[f32; 3]; 2]) -> yardl::Result<()>;
+    fn write_nested_subarray(&mut self, value: &yardl::DynamicNDArray<[[i32; 3]; 2]>) -> yardl::Result<()>;
+    fn write_dynamic_with_fixed_vector_subarray(&mut self, value: &yardl::DynamicNDArray<[i32; 3]>) -> yardl::Result<()>;
+    fn write_generic_subarray(&mut self, value: &Image<[i32; 3]>) -> yardl::Result<()>;
+    /// Verifies that all steps have been written and flushes the output.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+pub trait SubarraysReader {
+    fn read_dynamic_with_fixed_int_subarray(&mut self) -> yardl::Result<yardl::DynamicNDArray<[i32; 3]>>;
+    fn read_dynamic_with_fixed_float_subarray(&mut self) -> yardl::Result<yardl::DynamicNDArray<[f32; 3]>>;
+    fn read_known_dim_count_with_fixed_int_subarray(&mut self) -> yardl::Result<yardl::NDArray<[i32; 3], 1>>;
+    fn read_known_dim_count_with_fixed_float_subarray(&mut self) -> yardl::Result<yardl::NDArray<[f32; 3], 1>>;
+    fn read_fixed_with_fixed_int_subarray(&mut self) -> yardl::Result<[[i32; 3]; 2]>;
+    fn read_fixed_with_fixed_float_subarray(&mut self) -> yardl::Result<[[f32; 3]; 2]>;
+    fn read_nested_subarray(&mut self) -> yardl::Result<yardl::DynamicNDArray<[[i32; 3]; 2]>>;
+    fn read_dynamic_with_fixed_vector_subarray(&mut self) -> yardl::Result<yardl::DynamicNDArray<[i32; 3]>>;
+    fn read_generic_subarray(&mut self) -> yardl::Result<Image<[i32; 3]>>;
+    /// Verifies that all steps have been read.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+/// The schema written to the header of binary SubarraysInRecords streams.
+pub const SUBARRAYS_IN_RECORDS_SCHEMA: &str = r#"{"protocol":{"name":"SubarraysInRecords","sequence":[{"name":"withFixedSubarrays","type":{"array":{"items":"TestModel.RecordWithFixedCollections"}}},{"name":"withVlenSubarrays","type":{"array":{"items":"TestModel.RecordWithVlenCollections"}}}]},"types":[{"name":"RecordWithFixedCollections","fields":[{"name":"fixedVector","type":{"vector":{"items":"int32","length":3}}},{"name":"fixedArray","type":{"array":{"items":"int32","dimensions":[{"length":2},{"length":3}]}}}]},{"name":"RecordWithVlenCollections","fields":[{"name":"vector","type":{"vector":{"items":"int32"}}},{"name":"array","type":{"array":{"items":"int32","dimensions":2}}}]}]}"#;
+
+pub trait SubarraysInRecordsWriter {
+    fn write_with_fixed_subarrays(&mut self, value: &yardl::DynamicNDArray<RecordWithFixedCollections>) -> yardl::Result<()>;
+    fn write_with_vlen_subarrays(&mut self, value: &yardl::DynamicNDArray<RecordWithVlenCollections>) -> yardl::Result<()>;
+    /// Verifies that all steps have been written and flushes the output.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+pub trait SubarraysInRecordsReader {
+    fn read_with_fixed_subarrays(&mut self) -> yardl::Result<yardl::DynamicNDArray<RecordWithFixedCollections>>;
+    fn read_with_vlen_subarrays(&mut self) -> yardl::Result<yardl::DynamicNDArray<RecordWithVlenCollections>>;
+    /// Verifies that all steps have been read.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+/// The schema written to the header of binary NDArrays streams.
+pub const ND_ARRAYS_SCHEMA: &str = r#"{"protocol":{"name":"NDArrays","sequence":[{"name":"ints","type":{"array":{"items":"int32","dimensions":2}}},{"name":"simpleRecordArray","type":{"array":{"items":"TestModel.SimpleRecord","dimensions":2}}},{"name":"recordWithVlensArray","type":{"array":{"items":"TestModel.RecordWithVlens","dimensions":2}}},{"name":"recordWithNDArrays","type":"TestModel.RecordWithNDArrays"},{"name":"namedArray","type":"TestModel.NamedNDArray"}]},"types":[{"name":"NamedNDArray","type":{"array":{"items":"int32","dimensions":[{"name":"dimA"},{"name":"dimB"}]}}},{"name":"RecordWithNDArrays","fields":[{"name":"ints","type":{"array":{"items":"int32","dimensions":2}}},{"name":"fixedSimpleRecordArray","type":{"array":{"items":"TestModel.SimpleRecord","dimensions":2}}},{"name":"fixedRecordWithVlensArray","type":{"array":{"items":"TestModel.RecordWithVlens","dimensions":2}}}]},{"name":"RecordWithVlens","fields":[{"name":"a","type":{"vector":{"items":"TestModel.SimpleRecord"}}},{"name":"b","type":"int32"},{"name":"c","type":"int32"}]},{"name":"SimpleRecord","fields":[{"name":"x","type":"int32"},{"name":"y","type":"int32"},{"name":"z","type":"int32"}]}]}"#;
+
+pub trait NDArraysWriter {
+    fn write_ints(&mut self, value: &yardl::NDArray<i32, 2>) -> yardl::Result<()>;
+    fn write_simple_record_array(&mut self, value: &yardl::NDArray<SimpleRecord, 2>) -> yardl::Result<()>;
+    fn write_record_with_vlens_array(&mut self, value: &yardl::NDArray<RecordWithVlens, 2>) -> yardl::Result<()>;
+    fn write_record_with_nd_arrays(&mut self, value: &RecordWithNDArrays) -> yardl::Result<()>;
+    fn write_named_array(&mut self, value: &NamedNDArray) -> yardl::Result<()>;
+    /// Verifies that all steps have been written and flushes the output.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+pub trait NDArraysReader {
+    fn read_ints(&mut self) -> yardl::Result<yardl::NDArray<i32, 2>>;
+    fn read_simple_record_array(&mut self) -> yardl::Result<yardl::NDArray<SimpleRecord, 2>>;
+    fn read_record_with_vlens_array(&mut self) -> yardl::Result<yardl::NDArray<RecordWithVlens, 2>>;
+    fn read_record_with_nd_arrays(&mut self) -> yardl::Result<RecordWithNDArrays>;
+    fn read_named_array(&mut self) -> yardl::Result<NamedNDArray>;
+    /// Verifies that all steps have been read.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+/// The schema written to the header of binary NDArraysSingleDimension streams.
+pub const ND_ARRAYS_SINGLE_DIMENSION_SCHEMA: &str = r#"{"protocol":{"name":"NDArraysSingleDimension","sequence":[{"name":"ints","type":{"array":{"items":"int32","dimensions":1}}},{"name":"simpleRecordArray","type":{"array":{"items":"TestModel.SimpleRecord","dimensions":1}}},{"name":"recordWithVlensArray","type":{"array":{"items":"TestModel.RecordWithVlens","dimensions":1}}},{"name":"recordWithNDArrays","type":"TestModel.RecordWithNDArraysSingleDimension"}]},"types":[{"name":"RecordWithNDArraysSingleDimension","fields":[{"name":"ints","type":{"array":{"items":"int32","dimensions":1}}},{"name":"fixedSimpleRecordArray","type":{"array":{"items":"TestModel.SimpleRecord","dimensions":1}}},{"name":"fixedRecordWithVlensArray","type":{"array":{"items":"TestModel.RecordWithVlens","dimensions":1}}}]},{"name":"RecordWithVlens","fields":[{"name":"a","type":{"vector":{"items":"TestModel.SimpleRecord"}}},{"name":"b","type":"int32"},{"name":"c","type":"int32"}]},{"name":"SimpleRecord","fields":[{"name":"x","type":"int32"},{"name":"y","type":"int32"},{"name":"z","type":"int32"}]}]}"#;
+
+pub trait NDArraysSingleDimensionWriter {
+    fn write_ints(&mut self, value: &yardl::NDArray<i32, 1>) -> yardl::Result<()>;
+    fn write_simple_record_array(&mut self, value: &yardl::NDArray<SimpleRecord, 1>) -> yardl::Result<()>;
+    fn write_record_with_vlens_array(&mut self, value: &yardl::NDArray<RecordWithVlens, 1>) -> yardl::Result<()>;
+    fn write_record_with_nd_arrays(&mut self, value: &RecordWithNDArraysSingleDimension) -> yardl::Result<()>;
+    /// Verifies that all steps have been written and flushes the output.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+pub trait NDArraysSingleDimensionReader {
+    fn read_ints(&mut self) -> yardl::Result<yardl::NDArray<i32, 1>>;
+    fn read_simple_record_array(&mut self) -> yardl::Result<yardl::NDArray<SimpleRecord, 1>>;
+    fn read_record_with_vlens_array(&mut self) -> yardl::Result<yardl::NDArray<RecordWithVlens, 1>>;
+    fn read_record_with_nd_arrays(&mut self) -> yardl::Result<RecordWithNDArraysSingleDimension>;
+    /// Verifies that all steps have been read.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+/// The schema written to the header of binary DynamicNDArrays streams.
+pub const DYNAMIC_ND_ARRAYS_SCHEMA: &str = r#"{"protocol":{"name":"DynamicNDArrays","sequence":[{"name":"ints","type":{"array":{"items":"int32"}}},{"name":"simpleRecordArray","type":{"array":{"items":"TestModel.SimpleRecord"}}},{"name":"recordWithVlensArray","type":{"array":{"items":"TestModel.RecordWithVlens"}}},{"name":"recordWithDynamicNDArrays","type":"TestModel.RecordWithDynamicNDArrays"}]},"types":[{"name":"IntArray","type":{"array":{"items":"int32"}}},{"name":"RecordWithDynamicNDArrays","fields":[{"name":"ints","type":"TestModel.IntArray"},{"name":"simpleRecordArray","type":{"array":{"items":"TestModel.SimpleRecord"}}},{"name":"recordWithVlensArray","type":{"array":{"items":"TestModel.RecordWithVlens"}}}]},{"name":"RecordWithVlens","fields":[{"name":"a","type":{"vector":{"items":"TestModel.SimpleRecord"}}},{"name":"b","type":"int32"},{"name":"c","type":"int32"}]},{"name":"SimpleRecord","fields":[{"name":"x","type":"int32"},{"name":"y","type":"int32"},{"name":"z","type":"int32"}]}]}"#;
+
+pub trait DynamicNDArraysWriter {
+    fn write_ints(&mut self, value: &yardl::DynamicNDArray<i32>) -> yardl::Result<()>;
+    fn write_simple_record_array(&mut self, value: &yardl::DynamicNDArray<SimpleRecord>) -> yardl::Result<()>;
+    fn write_record_with_vlens_array(&mut self, value: &yardl::DynamicNDArray<RecordWithVlens>) -> yardl::Result<()>;
+    fn write_record_with_dynamic_nd_arrays(&mut self, value: &RecordWithDynamicNDArrays) -> yardl::Result<()>;
+    /// Verifies that all steps have been written and flushes the output.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+pub trait DynamicNDArraysReader {
+    fn read_ints(&mut self) -> yardl::Result<yardl::DynamicNDArray<i32>>;
+    fn read_simple_record_array(&mut self) -> yardl::Result<yardl::DynamicNDArray<SimpleRecord>>;
+    fn read_record_with_vlens_array(&mut self) -> yardl::Result<yardl::DynamicNDArray<RecordWithVlens>>;
+    fn read_record_with_dynamic_nd_arrays(&mut self) -> yardl::Result<RecordWithDynamicNDArrays>;
+    /// Verifies that all steps have been read.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+/// The schema written to the header of binary MultiDArrays streams.
+pub const MULTI_D_ARRAYS_SCHEMA: &str = r#"{"protocol":{"name":"MultiDArrays","sequence":[{"name":"images","type":{"stream":{"items":{"array":{"items":"float32","dimensions":[{"name":"ch"},{"name":"z"},{"name":"y"},{"name":"x"}]}}}}},{"name":"frames","type":{"stream":{"items":{"array":{"items":"float32","dimensions":[{"name":"ch","length":1},{"name":"z","length":1},{"name":"y","length":64},{"name":"x","length":32}]}}}}}]},"types":null}"#;
+
+pub trait MultiDArraysWriter {
+    fn write_images(&mut self, values: &[yardl::NDArray<f32, 4>]) -> yardl::Result<()>;
+    /// Marks the end of the images stream.
+    fn end_images(&mut self) -> yardl::Result<()>;
+    fn write_frames(&mut self, values: &[[[[[f32; 32]; 64]; 1]; 1]]) -> yardl::Result<()>;
+    /// Marks the end of the frames stream.
+    fn end_frames(&mut self) -> yardl::Result<()>;
+    /// Verifies that all steps have been written and flushes the output.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+pub trait MultiDArraysReader {
+    /// Returns `None` when the stream has no more items.
+    fn read_images(&mut self) -> yardl::Result<Option<yardl::NDArray<f32, 4>>>;
+    /// Returns `None` when the stream has no more items.
+    fn read_frames(&mut self) -> yardl::Result<Option<[[[[f32; 32]; 64]; 1]; 1]>>;
+    /// Verifies that all steps have been read.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+/// The schema written to the header of binary ComplexArrays streams.
+pub const COMPLEX_ARRAYS_SCHEMA: &str = r#"{"protocol":{"name":"ComplexArrays","sequence":[{"name":"floats","type":{"array":{"items":"complexfloat32"}}},{"name":"doubles","type":{"array":{"items":"complexfloat64","dimensions":2}}}]},"types":null}"#;
+
+pub trait ComplexArraysWriter {
+    fn write_floats(&mut self, value: &yardl::DynamicNDArray<yardl::Complex<f32>>) -> yardl::Result<()>;
+    fn write_doubles(&mut self, value: &yardl::NDArray<yardl::Complex<f64>, 2>) -> yardl::Result<()>;
+    /// Verifies that all steps have been written and flushes the output.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+pub trait ComplexArraysReader {
+    fn read_floats(&mut self) -> yardl::Result<yardl::DynamicNDArray<yardl::Complex<f32>>>;
+    fn read_doubles(&mut self) -> yardl::Result<yardl::NDArray<yardl::Complex<f64>, 2>>;
+    /// Verifies that all steps have been read.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+/// The schema written to the header of binary Maps streams.
+pub const MAPS_SCHEMA: &str = r#"{"protocol":{"name":"Maps","sequence":[{"name":"stringToInt","type":{"map":{"keys":"string","values":"int32"}}},{"name":"intToString","type":{"map":{"keys":"int32","values":"string"}}},{"name":"stringToUnion","type":{"map":{"keys":"string","values":[{"tag":"string","type":"string"},{"tag":"int32","type":"int32"}]}}},{"name":"aliasedGeneric","type":{"name":"BasicTypes.AliasedMap","typeArguments":["string","int32"]}},{"name":"records","type":{"vector":{"items":"TestModel.RecordWithMaps"}}}]},"types":[{"name":"AliasedMap","typeParameters":["K","V"],"type":{"map":{"keys":"K","values":"V"}}},{"name":"RecordWithMaps","fields":[{"name":"set1","type":{"map":{"keys":"uint32","values":"uint32"}}},{"name":"set2","type":{"map":{"keys":"int32","values":"bool"}}},{"name":"set3","type":{"map":{"keys":"string","values":[{"tag":"string","type":"string"},{"tag":"int32","type":"int32"}]}}}]}]}"#;
+
+pub trait MapsWriter {
+    fn write_string_to_int(&mut self, value: &HashMap<String, i32>) -> yardl::Result<()>;
+    fn write_int_to_string(&mut self, value: &HashMap<i32, String>) -> yardl::Result<()>;
+    fn write_string_to_union(&mut self, value: &HashMap<String, StringOrInt32>) -> yardl::Result<()>;
+    fn write_aliased_generic(&mut self, value: &super::basic_types::AliasedMap<String, i32>) -> yardl::Result<()>;
+    fn write_records(&mut self, value: &Vec<RecordWithMaps>) -> yardl::Result<()>;
+    /// Verifies that all steps have been written and flushes the output.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+pub trait MapsReader {
+    fn read_string_to_int(&mut self) -> yardl::Result<HashMap<String, i32>>;
+    fn read_int_to_string(&mut self) -> yardl::Result<HashMap<i32, String>>;
+    fn read_string_to_union(&mut self) -> yardl::Result<HashMap<String, StringOrInt32>>;
+    fn read_aliased_generic(&mut self) -> yardl::Result<super::basic_types::AliasedMap<String, i32>>;
+    fn read_records(&mut self) -> yardl::Result<Vec<RecordWithMaps>>;
+    /// Verifies that all steps have been read.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+/// The schema written to the header of binary Unions streams.
+pub const UNIONS_SCHEMA: &str = r#"{"protocol":{"name":"Unions","sequence":[{"name":"intOrSimpleRecord","type":[{"tag":"int32","type":"int32"},{"tag":"SimpleRecord","type":"TestModel.SimpleRecord"}]},{"name":"intOrRecordWithVlens","type":[{"tag":"int32","type":"int32"},{"tag":"RecordWithVlens","type":"TestModel.RecordWithVlens"}]},{"name":"monosotateOrIntOrSimpleRecord","type":[null,{"tag":"int32","type":"int32"},{"tag":"SimpleRecord","type":"TestModel.SimpleRecord"}]},{"name":"vectorOfUnions","type":{"vector":{"items":[{"tag":"string","type":"string"},{"tag":"int32","type":"int32"}]}}},{"name":"recordWithUnions","type":"BasicTypes.RecordWithUnions"}]},"types":[{"name":"DaysOfWeek","values":[{"symbol":"monday","value":1},{"symbol":"tuesday","value":2},{"symbol":"wednesday","value":4},{"symbol":"thursday","value":8},{"symbol":"friday","value":16},{"symbol":"saturday","value":32},{"symbol":"sunday","value":64}]},{"name":"Fruits","values":[{"symbol":"apple","value":1},{"symbol":"banana","value":2},{"symbol":"pear","value":3}]},{"name":"GenericNullableUnion2","typeParameters":["T1","T2"],"type":[null,{"tag":"T1","type":"T1"},{"tag":"T2","type":"T2"}]},{"name":"RecordWithString","fields":[{"name":"i","type":"string"}]},{"name":"RecordWithUnions","fields":[{"name":"nullOrIntOrString","type":[null,{"tag":"int32","type":"int32"},{"tag":"string","type":"string"}]},{"name":"dateOrDatetime","type":[{"tag":"time","type":"time"},{"tag":"datetime","type":"datetime"}]},{"name":"nullOrFruitsOrDaysOfWeek","type":{"name":"BasicTypes.GenericNullableUnion2","typeArguments":["BasicTypes.Fruits","BasicTypes.DaysOfWeek"]}},{"name":"recordOrInt","type":[{"tag":"RecordWithString","type":"BasicTypes.RecordWithString"},{"tag":"int32","type":"int32"}]}]},{"name":"RecordWithVlens","fields":[{"name":"a","type":{"vector":{"items":"TestModel.SimpleRecord"}}},{"name":"b","type":"int32"},{"name":"c","type":"int32"}]},{"name":"SimpleRecord","fields":[{"name":"x","type":"int32"},{"name":"y","type":"int32"},{"name":"z","type":"int32"}]}]}"#;
+
+pub trait UnionsWriter {
+    fn write_int_or_simple_record(&mut self, value: &Int32OrSimpleRecord) -> yardl::Result<()>;
+    fn write_int_or_record_with_vlens(&mut self, value: &Int32OrRecordWithVlens) -> yardl::Result<()>;
+    fn write_monosotate_or_int_or_simple_record(&mut self, value: &Option<Int32OrSimpleRecord>) -> yardl::Result<()>;
+    fn write_vector_of_unions(&mut self, value: &Vec<StringOrInt32>) -> yardl::Result<()>;
+    fn write_record_with_unions(&mut self, value: &super::basic_types::RecordWithUnions) -> yardl::Result<()>;
+    /// Verifies that all steps have been written and flushes the output.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+pub trait UnionsReader {
+    fn read_int_or_simple_record(&mut self) -> yardl::Result<Int32OrSimpleRecord>;
+    fn read_int_or_record_with_vlens(&mut self) -> yardl::Result<Int32OrRecordWithVlens>;
+    fn read_monosotate_or_int_or_simple_record(&mut self) -> yardl::Result<Option<Int32OrSimpleRecord>>;
+    fn read_vector_of_unions(&mut self) -> yardl::Result<Vec<StringOrInt32>>;
+    fn read_record_with_unions(&mut self) -> yardl::Result<super::basic_types::RecordWithUnions>;
+    /// Verifies that all steps have been read.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+/// The schema written to the header of binary StreamsOfUnions streams.
+pub const STREAMS_OF_UNIONS_SCHEMA: &str = r#"{"protocol":{"name":"StreamsOfUnions","sequence":[{"name":"intOrSimpleRecord","type":{"stream":{"items":[{"tag":"int32","type":"int32"},{"tag":"SimpleRecord","type":"TestModel.SimpleRecord"}]}}},{"name":"nullableIntOrSimpleRecord","type":{"stream":{"items":[null,{"tag":"int32","type":"int32"},{"tag":"SimpleRecord","type":"TestModel.SimpleRecord"}]}}},{"name":"manyCases","type":{"stream":{"items":[{"tag":"int32","type":"int32"},{"tag":"float32","type":"float32"},{"tag":"string","type":"string"},{"tag":"SimpleRecord","type":"TestModel.SimpleRecord"},{"tag":"NamedFixedNDArray","type":"TestModel.NamedFixedNDArray"}]}}}]},"types":[{"name":"NamedFixedNDArray","type":{"array":{"items":"int32","dimensions":[{"name":"dimA","length":2},{"name":"dimB","length":4}]}}},{"name":"SimpleRecord","fields":[{"name":"x","type":"int32"},{"name":"y","type":"int32"},{"name":"z","type":"int32"}]}]}"#;
+
+pub trait StreamsOfUnionsWriter {
+    fn write_int_or_simple_record(&mut self, values: &[Int32OrSimpleRecord]) -> yardl::Result<()>;
+    /// Marks the end of the intOrSimpleRecord stream.
+    fn end_int_or_simple_record(&mut self) -> yardl::Result<()>;
+    fn write_nullable_int_or_simple_record(&mut self, values: &[Option<Int32OrSimpleRecord>]) -> yardl::Result<()>;
+    /// Marks the end of the nullableIntOrSimpleRecord stream.
+    fn end_nullable_int_or_simple_record(&mut self) -> yardl::Result<()>;
+    fn write_many_cases(&mut self, values: &[Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray]) -> yardl::Result<()>;
+    /// Marks the end of the manyCases stream.
+    fn end_many_cases(&mut self) -> yardl::Result<()>;
+    /// Verifies that all steps have been written and flushes the output.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+pub trait StreamsOfUnionsReader {
+    /// Returns `None` when the stream has no more items.
+    fn read_int_or_simple_record(&mut self) -> yardl::Result<Option<Int32OrSimpleRecord>>;
+    /// Returns `None` when the stream has no more items.
+    fn read_nullable_int_or_simple_record(&mut self) -> yardl::Result<Option<Option<Int32OrSimpleRecord>>>;
+    /// Returns `None` when the stream has no more items.
+    fn read_many_cases(&mut self) -> yardl::Result<Option<Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray>>;
+    /// Verifies that all steps have been read.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+/// The schema written to the header of binary Enums streams.
+pub const ENUMS_SCHEMA: &str = r#"{"protocol":{"name":"Enums","sequence":[{"name":"single","type":"TestModel.Fruits"},{"name":"vec","type":{"vector":{"items":"TestModel.Fruits"}}},{"name":"size","type":"TestModel.SizeBasedEnum"},{"name":"rec","type":"TestModel.RecordWithEnums"}]},"types":[{"name":"DaysOfWeek","values":[{"symbol":"monday","value":1},{"symbol":"tuesday","value":2},{"symbol":"wednesday","value":4},{"symbol":"thursday","value":8},{"symbol":"friday","value":16},{"symbol":"saturday","value":32},{"symbol":"sunday","value":64}]},{"name":"Fruits","values":[{"symbol":"apple","value":1},{"symbol":"banana","value":2},{"symbol":"pear","value":3}]},{"name":"TextFormat","base":"uint64","values":[{"symbol":"regular","value":0},{"symbol":"bold","value":1},{"symbol":"italic","value":2},{"symbol":"underline","value":4},{"symbol":"strikethrough","value":8}]},{"name":"DaysOfWeek","type":"BasicTypes.DaysOfWeek"},{"name":"Fruits","type":"BasicTypes.Fruits"},{"name":"RecordWithEnums","fields":[{"name":"enum","type":"TestModel.Fruits"},{"name":"flags","type":"TestModel.DaysOfWeek"},{"name":"flags2","type":"TestModel.TextFormat"},{"name":"rec","type":"TestModel.RecordWithNoDefaultEnum"}]},{"name":"RecordWithNoDefaultEnum","fields":[{"name":"enum","type":"TestModel.Fruits"}]},{"name":"SizeBasedEnum","base":"size","values":[{"symbol":"a","value":0},{"symbol":"b","value":1},{"symbol":"c","value":2}]},{"name":"TextFormat","type":"BasicTypes.TextFormat"}]}"#;
+
+pub trait EnumsWriter {
+    fn write_single(&mut self, value: &Fruits) -> yardl::Result<()>;
+    fn write_vec(&mut self, value: &Vec<Fruits>) -> yardl::Result<()>;
+    fn write_size(&mut self, value: &SizeBasedEnum) -> yardl::Result<()>;
+    fn write_rec(&mut self, value: &RecordWithEnums) -> yardl::Result<()>;
+    /// Verifies that all steps have been written and flushes the output.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+pub trait EnumsReader {
+    fn read_single(&mut self) -> yardl::Result<Fruits>;
+    fn read_vec(&mut self) -> yardl::Result<Vec<Fruits>>;
+    fn read_size(&mut self) -> yardl::Result<SizeBasedEnum>;
+    fn read_rec(&mut self) -> yardl::Result<RecordWithEnums>;
+    /// Verifies that all steps have been read.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+/// The schema written to the header of binary Flags streams.
+pub const FLAGS_SCHEMA: &str = r#"{"protocol":{"name":"Flags","sequence":[{"name":"days","type":{"stream":{"items":"TestModel.DaysOfWeek"}}},{"name":"formats","type":{"stream":{"items":"TestModel.TextFormat"}}}]},"types":[{"name":"DaysOfWeek","values":[{"symbol":"monday","value":1},{"symbol":"tuesday","value":2},{"symbol":"wednesday","value":4},{"symbol":"thursday","value":8},{"symbol":"friday","value":16},{"symbol":"saturday","value":32},{"symbol":"sunday","value":64}]},{"name":"TextFormat","base":"uint64","values":[{"symbol":"regular","value":0},{"symbol":"bold","value":1},{"symbol":"italic","value":2},{"symbol":"underline","value":4},{"symbol":"strikethrough","value":8}]},{"name":"DaysOfWeek","type":"BasicTypes.DaysOfWeek"},{"name":"TextFormat","type":"BasicTypes.TextFormat"}]}"#;
+
+pub trait FlagsWriter {
+    fn write_days(&mut self, values: &[DaysOfWeek]) -> yardl::Result<()>;
+    /// Marks the end of the days stream.
+    fn end_days(&mut self) -> yardl::Result<()>;
+    fn write_formats(&mut self, values: &[TextFormat]) -> yardl::Result<()>;
+    /// Marks the end of the formats stream.
+    fn end_formats(&mut self) -> yardl::Result<()>;
+    /// Verifies that all steps have been written and flushes the output.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+pub trait FlagsReader {
+    /// Returns `None` when the stream has no more items.
+    fn read_days(&mut self) -> yardl::Result<Option<DaysOfWeek>>;
+    /// Returns `None` when the stream has no more items.
+    fn read_formats(&mut self) -> yardl::Result<Option<TextFormat>>;
+    /// Verifies that all steps have been read.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+/// The schema written to the header of binary StateTest streams.
+pub const STATE_TEST_SCHEMA: &str = r#"{"protocol":{"name":"StateTest","sequence":[{"name":"anInt","type":"int32"},{"name":"aStream","type":{"stream":{"items":"int32"}}},{"name":"anotherInt","type":"int32"}]},"types":null}"#;
+
+pub trait StateTestWriter {
+    fn write_an_int(&mut self, value: &i32) -> yardl::Result<()>;
+    fn write_a_stream(&mut self, values: &[i32]) -> yardl::Result<()>;
+    /// Marks the end of the aStream stream.
+    fn end_a_stream(&mut self) -> yardl::Result<()>;
+    fn write_another_int(&mut self, value: &i32) -> yardl::Result<()>;
+    /// Verifies that all steps have been written and flushes the output.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+pub trait StateTestReader {
+    fn read_an_int(&mut self) -> yardl::Result<i32>;
+    /// Returns `None` when the stream has no more items.
+    fn read_a_stream(&mut self) -> yardl::Result<Option<i32>>;
+    fn read_another_int(&mut self) -> yardl::Result<i32>;
+    /// Verifies that all steps have been read.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+/// The schema written to the header of binary SimpleGenerics streams.
+pub const SIMPLE_GENERICS_SCHEMA: &str = r#"{"protocol":{"name":"SimpleGenerics","sequence":[{"name":"floatImage","type":"Image.FloatImage"},{"name":"intImage","type":"Image.IntImage"},{"name":"intImageAlternateSyntax","type":{"name":"TestModel.Image","typeArguments":["int32"]}},{"name":"stringImage","type":{"name":"TestModel.Image","typeArguments":["string"]}},{"name":"intFloatTuple","type":{"name":"Tuples.Tuple","typeArguments":["int32","float32"]}},{"name":"floatFloatTuple","type":{"name":"Tuples.Tuple","typeArguments":["float32","float32"]}},{"name":"intFloatTupleAlternateSyntax","type":{"name":"Tuples.Tuple","typeArguments":["int32","float32"]}},{"name":"intStringTuple","type":{"name":"Tuples.Tuple","typeArguments":["int32","string"]}},{"name":"streamOfTypeVariants","type":{"stream":{"items":[{"tag":"imageFloat","explicitTag":true,"type":"Image.FloatImage"},{"tag":"imageDouble","explicitTag":true,"type":{"name":"TestModel.Image","typeArguments":["float64"]}}]}}}]},"types":[{"name":"FloatImage","type":{"name":"Image.Image","typeArguments":["float32"]}},{"name":"Image","typeParameters":["T"],"type":{"array":{"items":"T","dimensions":[{"name":"x"},{"name":"y"}]}}},{"name":"IntImage","type":{"name":"Image.Image","typeArguments":["int32"]}},{"name":"Image","typeParameters":["T"],"type":{"name":"Image.Image","typeArguments":["T"]}},{"name":"Tuple","typeParameters":["T1","T2"],"fields":[{"name":"v1","type":"T1"},{"name":"v2","type":"T2"}]}]}"#;
+
+pub trait SimpleGenericsWriter {
+    fn write_float_image(&mut self, value: &super::image::FloatImage) -> yardl::Result<()>;
+    fn write_int_image(&mut self, value: &super::image::IntImage) -> yardl::Result<()>;
+    fn write_int_image_alternate_syntax(&mut self, value: &Image<i32>) -> yardl::Result<()>;
+    fn write_string_image(&mut self, value: &Image<String>) -> yardl::Result<()>;
+    fn write_int_float_tuple(&mut self, value: &super::tuples::Tuple<i32, f32>) -> yardl::Result<()>;
+    fn write_float_float_tuple(&mut self, value: &super::tuples::Tuple<f32, f32>) -> yardl::Result<()>;
+    fn write_int_float_tuple_alternate_syntax(&mut self, value: &super::tuples::Tuple<i32, f32>) -> yardl::Result<()>;
+    fn write_int_string_tuple(&mut self, value: &super::tuples::Tuple<i32, String>) -> yardl::Result<()>;
+    fn write_stream_of_type_variants(&mut self, values: &[ImageFloatOrImageDouble]) -> yardl::Result<()>;
+    /// Marks the end of the streamOfTypeVariants stream.
+    fn end_stream_of_type_variants(&mut self) -> yardl::Result<()>;
+    /// Verifies that all steps have been written and flushes the output.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+pub trait SimpleGenericsReader {
+    fn read_float_image(&mut self) -> yardl::Result<super::image::FloatImage>;
+    fn read_int_image(&mut self) -> yardl::Result<super::image::IntImage>;
+    fn read_int_image_alternate_syntax(&mut self) -> yardl::Result<Image<i32>>;
+    fn read_string_image(&mut self) -> yardl::Result<Image<String>>;
+    fn read_int_float_tuple(&mut self) -> yardl::Result<super::tuples::Tuple<i32, f32>>;
+    fn read_float_float_tuple(&mut self) -> yardl::Result<super::tuples::Tuple<f32, f32>>;
+    fn read_int_float_tuple_alternate_syntax(&mut self) -> yardl::Result<super::tuples::Tuple<i32, f32>>;
+    fn read_int_string_tuple(&mut self) -> yardl::Result<super::tuples::Tuple<i32, String>>;
+    /// Returns `None` when the stream has no more items.
+    fn read_stream_of_type_variants(&mut self) -> yardl::Result<Option<ImageFloatOrImageDouble>>;
+    /// Verifies that all steps have been read.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+/// The schema written to the header of binary AdvancedGenerics streams.
+pub const ADVANCED_GENERICS_SCHEMA: &str = r#"{"protocol":{"name":"AdvancedGenerics","sequence":[{"name":"floatImageImage","type":{"name":"TestModel.Image","typeArguments":[{"name":"TestModel.Image","typeArguments":["float32"]}]}},{"name":"genericRecord1","type":{"name":"TestModel.GenericRecord","typeArguments":["int32","string"]}},{"name":"tupleOfOptionals","type":{"name":"TestModel.MyTuple","typeArguments":[[null,"int32"],[null,"string"]]}},{"name":"tupleOfOptionalsAlternateSyntax","type":{"name":"TestModel.MyTuple","typeArguments":[[null,"int32"],[null,"string"]]}},{"name":"tupleOfVectors","type":{"name":"TestModel.MyTuple","typeArguments":[{"vector":{"items":"int32"}},{"vector":{"items":"float32"}}]}}]},"types":[{"name":"MyTuple","typeParameters":["T1","T2"],"type":{"name":"Tuples.Tuple","typeArguments":["T1","T2"]}},{"name":"Image","typeParameters":["T"],"type":{"array":{"items":"T","dimensions":[{"name":"x"},{"name":"y"}]}}},{"name":"GenericRecord","typeParameters":["T1","T2"],"fields":[{"name":"scalar1","type":"T1"},{"name":"scalar2","type":"T2"},{"name":"vector1","type":{"vector":{"items":"T1"}}},{"name":"image2","type":{"name":"TestModel.Image","typeArguments":["T2"]}}]},{"name":"Image","typeParameters":["T"],"type":{"name":"Image.Image","typeArguments":["T"]}},{"name":"MyTuple","typeParameters":["T1","T2"],"type":{"name":"BasicTypes.MyTuple","typeArguments":["T1","T2"]}},{"name":"Tuple","typeParameters":["T1","T2"],"fields":[{"name":"v1","type":"T1"},{"name":"v2","type":"T2"}]}]}"#;
+
+pub trait AdvancedGenericsWriter {
+    fn write_float_image_image(&mut self, value: &Image<Image<f32>>) -> yardl::Result<()>;
+    fn write_generic_record_1(&mut self, value: &GenericRecord<i32, String>) -> yardl::Result<()>;
+    fn write_tuple_of_optionals(&mut self, value: &MyTuple<Option<i32>, Option<String>>) -> yardl::Result<()>;
+    fn write_tuple_of_optionals_alternate_syntax(&mut self, value: &MyTuple<Option<i32>, Option<String>>) -> yardl::Result<()>;
+    fn write_tuple_of_vectors(&mut self, value: &MyTuple<Vec<i32>, Vec<f32>>) -> yardl::Result<()>;
+    /// Verifies that all steps have been written and flushes the output.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+pub trait AdvancedGenericsReader {
+    fn read_float_image_image(&mut self) -> yardl::Result<Image<Image<f32>>>;
+    fn read_generic_record_1(&mut self) -> yardl::Result<GenericRecord<i32, String>>;
+    fn read_tuple_of_optionals(&mut self) -> yardl::Result<MyTuple<Option<i32>, Option<String>>>;
+    fn read_tuple_of_optionals_alternate_syntax(&mut self) -> yardl::Result<MyTuple<Option<i32>, Option<String>>>;
+    fn read_tuple_of_vectors(&mut self) -> yardl::Result<MyTuple<Vec<i32>, Vec<f32>>>;
+    /// Verifies that all steps have been read.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+/// The schema written to the header of binary FloatImageStream streams.
+pub const FLOAT_IMAGE_STREAM_SCHEMA: &str = r#"{"protocol":{"name":"FloatImageStream","sequence":[{"name":"header","type":{"name":"TestModel.GenericRecord","typeArguments":["int32","float32"]}},{"name":"images","type":{"stream":{"items":{"name":"TestModel.Image","typeArguments":["float32"]}}}},{"name":"background","type":[{"tag":"float32","type":"float32"},{"tag":"string","type":"string"}]}]},"types":[{"name":"Image","typeParameters":["T"],"type":{"array":{"items":"T","dimensions":[{"name":"x"},{"name":"y"}]}}},{"name":"GenericRecord","typeParameters":["T1","T2"],"fields":[{"name":"scalar1","type":"T1"},{"name":"scalar2","type":"T2"},{"name":"vector1","type":{"vector":{"items":"T1"}}},{"name":"image2","type":{"name":"TestModel.Image","typeArguments":["T2"]}}]},{"name":"Image","typeParameters":["T"],"type":{"name":"Image.Image","typeArguments":["T"]}}]}"#;
+
+/// A protocol that is instantiated for each pixel type
+pub trait FloatImageStreamWriter {
+    fn write_header(&mut self, value: &GenericRecord<i32, f32>) -> yardl::Result<()>;
+    fn write_images(&mut self, values: &[Image<f32>]) -> yardl::Result<()>;
+    /// Marks the end of the images stream.
+    fn end_images(&mut self) -> yardl::Result<()>;
+    fn write_background(&mut self, value: &Float32OrString) -> yardl::Result<()>;
+    /// Verifies that all steps have been written and flushes the output.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+/// A protocol that is instantiated for each pixel type
+pub trait FloatImageStreamReader {
+    fn read_header(&mut self) -> yardl::Result<GenericRecord<i32, f32>>;
+    /// Returns `None` when the stream has no more items.
+    fn read_images(&mut self) -> yardl::Result<Option<Image<f32>>>;
+    fn read_background(&mut self) -> yardl::Result<Float32OrString>;
+    /// Verifies that all steps have been read.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+/// The schema written to the header of binary ComplexImageStream streams.
+pub const COMPLEX_IMAGE_STREAM_SCHEMA: &str = r#"{"protocol":{"name":"ComplexImageStream","sequence":[{"name":"header","type":{"name":"TestModel.GenericRecord","typeArguments":["int32","complexfloat32"]}},{"name":"images","type":{"stream":{"items":{"name":"TestModel.Image","typeArguments":["complexfloat32"]}}}},{"name":"background","type":[{"tag":"complexfloat32","type":"complexfloat32"},{"tag":"string","type":"string"}]}]},"types":[{"name":"Image","typeParameters":["T"],"type":{"array":{"items":"T","dimensions":[{"name":"x"},{"name":"y"}]}}},{"name":"GenericRecord","typeParameters":["T1","T2"],"fields":[{"name":"scalar1","type":"T1"},{"name":"scalar2","type":"T2"},{"name":"vector1","type":{"vector":{"items":"T1"}}},{"name":"image2","type":{"name":"TestModel.Image","typeArguments":["T2"]}}]},{"name":"Image","typeParameters":["T"],"type":{"name":"Image.Image","typeArguments":["T"]}}]}"#;
+
+/// A protocol that is instantiated for each pixel type
+pub trait ComplexImageStreamWriter {
+    fn write_header(&mut self, value: &GenericRecord<i32, yardl::Complex<f32>>) -> yardl::Result<()>;
+    fn write_images(&mut self, values: &[Image<yardl::Complex<f32>>]) -> yardl::Result<()>;
+    /// Marks the end of the images stream.
+    fn end_images(&mut self) -> yardl::Result<()>;
+    fn write_background(&mut self, value: &Complexfloat32OrString) -> yardl::Result<()>;
+    /// Verifies that all steps have been written and flushes the output.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+/// A protocol that is instantiated for each pixel type
+pub trait ComplexImageStreamReader {
+    fn read_header(&mut self) -> yardl::Result<GenericRecord<i32, yardl::Complex<f32>>>;
+    /// Returns `None` when the stream has no more items.
+    fn read_images(&mut self) -> yardl::Result<Option<Image<yardl::Complex<f32>>>>;
+    fn read_background(&mut self) -> yardl::Result<Complexfloat32OrString>;
+    /// Verifies that all steps have been read.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+/// The schema written to the header of binary Aliases streams.
+pub const ALIASES_SCHEMA: &str = r#"{"protocol":{"name":"Aliases","sequence":[{"name":"aliasedString","type":"TestModel.AliasedString"},{"name":"aliasedEnum","type":"TestModel.AliasedEnum"},{"name":"aliasedOpenGeneric","type":{"name":"TestModel.AliasedOpenGeneric","typeArguments":["TestModel.AliasedString","TestModel.AliasedEnum"]}},{"name":"aliasedClosedGeneric","type":"TestModel.AliasedClosedGeneric"},{"name":"aliasedOptional","type":"TestModel.AliasedOptional"},{"name":"aliasedGenericOptional","type":{"name":"TestModel.AliasedGenericOptional","typeArguments":["float32"]}},{"name":"aliasedGenericUnion2","type":{"name":"TestModel.AliasedGenericUnion2","typeArguments":["TestModel.AliasedString","TestModel.AliasedEnum"]}},{"name":"aliasedGenericVector","type":{"name":"TestModel.AliasedGenericVector","typeArguments":["float32"]}},{"name":"aliasedGenericFixedVector","type":{"name":"TestModel.AliasedGenericFixedVector","typeArguments":["float32"]}},{"name":"streamOfAliasedGenericUnion2","type":{"stream":{"items":{"name":"TestModel.AliasedGenericUnion2","typeArguments":["TestModel.AliasedString","TestModel.AliasedEnum"]}}}},{"name":"vectors","type":{"vector":{"items":"TestModel.RecordContainingVectorsOfAliases"}}}]},"types":[{"name":"AliasedMap","typeParameters":["K","V"],"type":{"map":{"keys":"K","values":"V"}}},{"name":"Fruits","values":[{"symbol":"apple","value":1},{"symbol":"banana","value":2},{"symbol":"pear","value":3}]},{"name":"GenericUnion2","typeParameters":["T1","T2"],"type":[{"tag":"T1","type":"T1"},{"tag":"T2","type":"T2"}]},{"name":"GenericVector","typeParameters":["T"],"type":{"vector":{"items":"T"}}},{"name":"MyTuple","typeParameters":["T1","T2"],"type":{"name":"Tuples.Tuple","typeArguments":["T1","T2"]}},{"name":"Image","typeParameters":["T"],"type":{"array":{"items":"T","dimensions":[{"name":"x"},{"name":"y"}]}}},{"name":"AliasedClosedGeneric","type":{"name":"TestModel.AliasedTuple","typeArguments":["TestModel.AliasedString","TestModel.AliasedEnum"]}},{"name":"AliasedEnum","type":"TestModel.Fruits"},{"name":"AliasedGenericFixedVector","typeParameters":["T"],"type":{"vector":{"items":"T","length":3}}},{"name":"AliasedGenericOptional","typeParameters":["T"],"type":[null,"T"]},{"name":"AliasedGenericUnion2","typeParameters":["T1","T2"],"type":{"name":"BasicTypes.GenericUnion2","typeArguments":["T1","T2"]}},{"name":"AliasedGenericVector","typeParameters":["T"],"type":{"name":"BasicTypes.GenericVector","typeArguments":["T"]}},{"name":"AliasedMap","typeParameters":["K","V"],"type":{"name":"BasicTypes.AliasedMap","typeArguments":["K","V"]}},{"name":"AliasedOpenGeneric","typeParameters":["T1","T2"],"type":{"name":"TestModel.AliasedTuple","typeArguments":["T1","T2"]}},{"name":"AliasedOptional","type":[null,"int32"]},{"name":"AliasedString","type":"string"},{"name":"AliasedTuple","typeParameters":["T1","T2"],"type":{"name":"TestModel.MyTuple","typeArguments":["T1","T2"]}},{"name":"Fruits","type":"BasicTypes.Fruits"},{"name":"Image","typeParameters":["T"],"type":{"name":"Image.Image","typeArguments":["T"]}},{"name":"MyTuple","typeParameters":["T1","T2"],"type":{"name":"BasicTypes.MyTuple","typeArguments":["T1","T2"]}},{"name":"RecordContainingVectorsOfAliases","fields":[{"name":"strings","type":{"vector":{"items":"TestModel.AliasedString"}}},{"name":"maps","type":{"vector":{"items":{"name":"TestModel.AliasedMap","typeArguments":["string","int32"]}}}},{"name":"arrays","type":{"vector":{"items":{"name":"TestModel.Image","typeArguments":["float32"]}}}},{"name":"tuples","type":{"vector":{"items":{"name":"TestModel.MyTuple","typeArguments":["int32","TestModel.SimpleRecord"]}}}}]},{"name":"SimpleRecord","fields":[{"name":"x","type":"int32"},{"name":"y","type":"int32"},{"name":"z","type":"int32"}]},{"name":"Tuple","typeParameters":["T1","T2"],"fields":[{"name":"v1","type":"T1"},{"name":"v2","type":"T2"}]}]}"#;
+
+pub trait AliasesWriter {
+    fn write_aliased_string(&mut self, value: &AliasedString) -> yardl::Result<()>;
+    fn write_aliased_enum(&mut self, value: &AliasedEnum) -> yardl::Result<()>;
+    fn write_aliased_open_generic(&mut self, value: &AliasedOpenGeneric<AliasedString, AliasedEnum>) -> yardl::Result<()>;
+    fn write_aliased_closed_generic(&mut self, value: &AliasedClosedGeneric) -> yardl::Result<()>;
+    fn write_aliased_optional(&mut self, value: &AliasedOptional) -> yardl::Result<()>;
+    fn write_aliased_generic_optional(&mut self, value: &AliasedGenericOptional<f32>) -> yardl::Result<()>;
+    fn write_aliased_generic_union_2(&mut self, value: &AliasedGenericUnion2<AliasedString, AliasedEnum>) -> yardl::Result<()>;
+    fn write_aliased_generic_vector(&mut self, value: &AliasedGenericVector<f32>) -> yardl::Result<()>;
+    fn write_aliased_generic_fixed_vector(&mut self, value: &AliasedGenericFixedVector<f32>) -> yardl::Result<()>;
+    fn write_stream_of_aliased_generic_union_2(&mut self, values: &[AliasedGenericUnion2<AliasedString, AliasedEnum>]) -> yardl::Result<()>;
+    /// Marks the end of the streamOfAliasedGenericUnion2 stream.
+    fn end_stream_of_aliased_generic_union_2(&mut self) -> yardl::Result<()>;
+    fn write_vectors(&mut self, value: &Vec<RecordContainingVectorsOfAliases>) -> yardl::Result<()>;
+    /// Verifies that all steps have been written and flushes the output.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+pub trait AliasesReader {
+    fn read_aliased_string(&mut self) -> yardl::Result<AliasedString>;
+    fn read_aliased_enum(&mut self) -> yardl::Result<AliasedEnum>;
+    fn read_aliased_open_generic(&mut self) -> yardl::Result<AliasedOpenGeneric<AliasedString, AliasedEnum>>;
+    fn read_aliased_closed_generic(&mut self) -> yardl::Result<AliasedClosedGeneric>;
+    fn read_aliased_optional(&mut self) -> yardl::Result<AliasedOptional>;
+    fn read_aliased_generic_optional(&mut self) -> yardl::Result<AliasedGenericOptional<f32>>;
+    fn read_aliased_generic_union_2(&mut self) -> yardl::Result<AliasedGenericUnion2<AliasedString, AliasedEnum>>;
+    fn read_aliased_generic_vector(&mut self) -> yardl::Result<AliasedGenericVector<f32>>;
+    fn read_aliased_generic_fixed_vector(&mut self) -> yardl::Result<AliasedGenericFixedVector<f32>>;
+    /// Returns `None` when the stream has no more items.
+    fn read_stream_of_aliased_generic_union_2(&mut self) -> yardl::Result<Option<AliasedGenericUnion2<AliasedString, AliasedEnum>>>;
+    fn read_vectors(&mut self) -> yardl::Result<Vec<RecordContainingVectorsOfAliases>>;
+    /// Verifies that all steps have been read.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+/// The schema written to the header of binary StreamsOfAliasedUnions streams.
+pub const STREAMS_OF_ALIASED_UNIONS_SCHEMA: &str = r#"{"protocol":{"name":"StreamsOfAliasedUnions","sequence":[{"name":"intOrSimpleRecord","type":{"stream":{"items":"TestModel.AliasedIntOrSimpleRecord"}}},{"name":"nullableIntOrSimpleRecord","type":{"stream":{"items":"TestModel.AliasedNullableIntSimpleRecord"}}}]},"types":[{"name":"AliasedIntOrSimpleRecord","type":[{"tag":"int32","type":"int32"},{"tag":"SimpleRecord","type":"TestModel.SimpleRecord"}]},{"name":"AliasedNullableIntSimpleRecord","type":[null,{"tag":"int32","type":"int32"},{"tag":"SimpleRecord","type":"TestModel.SimpleRecord"}]},{"name":"SimpleRecord","fields":[{"name":"x","type":"int32"},{"name":"y","type":"int32"},{"name":"z","type":"int32"}]}]}"#;
+
+pub trait StreamsOfAliasedUnionsWriter {
+    fn write_int_or_simple_record(&mut self, values: &[AliasedIntOrSimpleRecord]) -> yardl::Result<()>;
+    /// Marks the end of the intOrSimpleRecord stream.
+    fn end_int_or_simple_record(&mut self) -> yardl::Result<()>;
+    fn write_nullable_int_or_simple_record(&mut self, values: &[Option<AliasedNullableIntSimpleRecord>]) -> yardl::Result<()>;
+    /// Marks the end of the nullableIntOrSimpleRecord stream.
+    fn end_nullable_int_or_simple_record(&mut self) -> yardl::Result<()>;
+    /// Verifies that all steps have been written and flushes the output.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+pub trait StreamsOfAliasedUnionsReader {
+    /// Returns `None` when the stream has no more items.
+    fn read_int_or_simple_record(&mut self) -> yardl::Result<Option<AliasedIntOrSimpleRecord>>;
+    /// Returns `None` when the stream has no more items.
+    fn read_nullable_int_or_simple_record(&mut self) -> yardl::Result<Option<Option<AliasedNullableIntSimpleRecord>>>;
+    /// Verifies that all steps have been read.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+/// The schema written to the header of binary ProtocolWithComputedFields streams.
+pub const PROTOCOL_WITH_COMPUTED_FIELDS_SCHEMA: &str = r#"{"protocol":{"name":"ProtocolWithComputedFields","sequence":[{"name":"recordWithComputedFields","type":"TestModel.RecordWithComputedFields"}]},"types":[{"name":"GenericRecordWithComputedFields","typeParameters":["T0","T1"],"fields":[{"name":"f1","type":[{"tag":"T0","type":"T0"},{"tag":"T1","type":"T1"}]}]},{"name":"MyTuple","typeParameters":["T1","T2"],"type":{"name":"Tuples.Tuple","typeArguments":["T1","T2"]}},{"name":"MyTuple","typeParameters":["T1","T2"],"type":{"name":"BasicTypes.MyTuple","typeArguments":["T1","T2"]}},{"name":"NamedNDArray","type":{"array":{"items":"int32","dimensions":[{"name":"dimA"},{"name":"dimB"}]}}},{"name":"RecordWithComputedFields","fields":[{"name":"arrayField","type":{"array":{"items":"int32","dimensions":[{"name":"x"},{"name":"y"}]}}},{"name":"arrayFieldMapDimensions","type":{"array":{"items":"int32","dimensions":[{"name":"x"},{"name":"y"}]}}},{"name":"dynamicArrayField","type":{"array":{"items":"int32"}}},{"name":"fixedArrayField","type":{"array":{"items":"int32","dimensions":[{"name":"x","length":3},{"name":"y","length":4}]}}},{"name":"intField","type":"int32"},{"name":"int8Field","type":"int8"},{"name":"uint8Field","type":"uint8"},{"name":"int16Field","type":"int16"},{"name":"uint16Field","type":"uint16"},{"name":"uint32Field","type":"uint32"},{"name":"int64Field","type":"int64"},{"name":"uint64Field","type":"uint64"},{"name":"sizeField","type":"size"},{"name":"float32Field","type":"float32"},{"name":"float64Field","type":"float64"},{"name":"complexfloat32Field","type":"complexfloat32"},{"name":"complexfloat64Field","type":"complexfloat64"},{"name":"stringField","type":"string"},{"name":"tupleField","type":{"name":"TestModel.MyTuple","typeArguments":["int32","int32"]}},{"name":"vectorField","type":{"vector":{"items":"int32"}}},{"name":"vectorOfVectorsField","type":{"vector":{"items":{"vector":{"items":"int32"}}}}},{"name":"fixedVectorField","type":{"vector":{"items":"int32","length":3}}},{"name":"fixedVectorOfVectorsField","type":{"vector":{"items":{"vector":{"items":"int32","length":3}},"length":2}}},{"name":"optionalNamedArray","type":[null,"TestModel.NamedNDArray"]},{"name":"intFloatUnion","type":[{"tag":"int32","type":"int32"},{"tag":"float32","type":"float32"}]},{"name":"nullableIntFloatUnion","type":[null,{"tag":"int32","type":"int32"},{"tag":"float32","type":"float32"}]},{"name":"unionWithNestedGenericUnion","type":[{"tag":"int","explicitTag":true,"type":"int32"},{"tag":"genericRecordWithComputedFields","explicitTag":true,"type":{"name":"BasicTypes.GenericRecordWithComputedFields","typeArguments":["string","float32"]}}]},{"name":"mapField","type":{"map":{"keys":"string","values":"string"}}}]},{"name":"Tuple","typeParameters":["T1","T2"],"fields":[{"name":"v1","type":"T1"},{"name":"v2","type":"T2"}]}]}"#;
+
+pub trait ProtocolWithComputedFieldsWriter {
+    fn write_record_with_computed_fields(&mut self, value: &RecordWithComputedFields) -> yardl::Result<()>;
+    /// Verifies that all steps have been written and flushes the output.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+pub trait ProtocolWithComputedFieldsReader {
+    fn read_record_with_computed_fields(&mut self) -> yardl::Result<RecordWithComputedFields>;
+    /// Verifies that all steps have been read.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+/// The schema written to the header of binary ProtocolWithConstraints streams.
+pub const PROTOCOL_WITH_CONSTRAINTS_SCHEMA: &str = r#"{"protocol":{"name":"ProtocolWithConstraints","sequence":[{"name":"record","type":"TestModel.RecordWithConstraints"},{"name":"records","type":{"stream":{"items":"TestModel.RecordWithConstrainedRecords"}}}]},"types":[{"name":"RecordWithConstrainedRecords","fields":[{"name":"single","type":"TestModel.RecordWithConstraints"},{"name":"optional","type":[null,"TestModel.RecordWithConstraints"]},{"name":"vector","type":{"vector":{"items":"TestModel.RecordWithConstraints"}}}]},{"name":"RecordWithConstraints","fields":[{"name":"size","type":"uint32"},{"name":"gain","type":"float32"},{"name":"name","type":"string"},{"name":"limit","type":[null,"int32"]},{"name":"samples","type":{"vector":{"items":"int32"}}}]}]}"#;
+
+pub trait ProtocolWithConstraintsWriter {
+    fn write_record(&mut self, value: &RecordWithConstraints) -> yardl::Result<()>;
+    fn write_records(&mut self, values: &[RecordWithConstrainedRecords]) -> yardl::Result<()>;
+    /// Marks the end of the records stream.
+    fn end_records(&mut self) -> yardl::Result<()>;
+    /// Verifies that all steps have been written and flushes the output.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+pub trait ProtocolWithConstraintsReader {
+    fn read_record(&mut self) -> yardl::Result<RecordWithConstraints>;
+    /// Returns `None` when the stream has no more items.
+    fn read_records(&mut self) -> yardl::Result<Option<RecordWithConstrainedRecords>>;
+    /// Verifies that all steps have been read.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+/// The schema written to the header of binary ProtocolWithKeywordSteps streams.
+pub const PROTOCOL_WITH_KEYWORD_STEPS_SCHEMA: &str = r#"{"protocol":{"name":"ProtocolWithKeywordSteps","sequence":[{"name":"int","type":{"stream":{"items":"TestModel.RecordWithKeywordFields"}}},{"name":"float","type":"TestModel.EnumWithKeywordSymbols"}]},"types":[{"name":"ArrayWithKeywordDimensionNames","type":{"array":{"items":"int32","dimensions":[{"name":"while"},{"name":"do"}]}}},{"name":"EnumWithKeywordSymbols","values":[{"symbol":"try","value":2},{"symbol":"catch","value":1}]},{"name":"RecordWithKeywordFields","fields":[{"name":"int","type":"string"},{"name":"sizeof","type":"TestModel.ArrayWithKeywordDimensionNames"},{"name":"if","type":"TestModel.EnumWithKeywordSymbols"}]}]}"#;
+
+pub trait ProtocolWithKeywordStepsWriter {
+    fn write_int(&mut self, values: &[RecordWithKeywordFields]) -> yardl::Result<()>;
+    /// Marks the end of the int stream.
+    fn end_int(&mut self) -> yardl::Result<()>;
+    fn write_float(&mut self, value: &EnumWithKeywordSymbols) -> yardl::Result<()>;
+    /// Verifies that all steps have been written and flushes the output.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+pub trait ProtocolWithKeywordStepsReader {
+    /// Returns `None` when the stream has no more items.
+    fn read_int(&mut self) -> yardl::Result<Option<RecordWithKeywordFields>>;
+    fn read_float(&mut self) -> yardl::Result<EnumWithKeywordSymbols>;
+    /// Verifies that all steps have been read.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+/// The schema written to the header of binary ProtocolWithOptionalDate streams.
+pub const PROTOCOL_WITH_OPTIONAL_DATE_SCHEMA: &str = r#"{"protocol":{"name":"ProtocolWithOptionalDate","sequence":[{"name":"record","type":[null,"TestModel.RecordWithOptionalDate"]}]},"types":[{"name":"RecordWithOptionalDate","fields":[{"name":"dateField","type":[null,"date"]}]}]}"#;
+
+pub trait ProtocolWithOptionalDateWriter {
+    fn write_record(&mut self, value: &Option<RecordWithOptionalDate>) -> yardl::Result<()>;
+    /// Verifies that all steps have been written and flushes the output.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+pub trait ProtocolWithOptionalDateReader {
+    fn read_record(&mut self) -> yardl::Result<Option<RecordWithOptionalDate>>;
+    /// Verifies that all steps have been read.
+    fn close(&mut self) -> yardl::Result<()>;
+}
+
+impl BinaryWrite for SmallBenchmarkRecord {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.a, w)?;
+        BinaryWrite::write(&self.b, w)?;
+        BinaryWrite::write(&self.c, w)?;
+        Ok(())
+    }
+}
+
+impl BinaryRead for SmallBenchmarkRecord {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(SmallBenchmarkRecord {
+            a: BinaryRead::read(r)?,
+            b: BinaryRead::read(r)?,
+            c: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl BinaryWrite for SimpleEncodingCounters {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.e1, w)?;
+        BinaryWrite::write(&self.e2, w)?;
+        BinaryWrite::write(&self.slice, w)?;
+        BinaryWrite::write(&self.repetition, w)?;
+        Ok(())
+    }
+}
+
+impl BinaryRead for SimpleEncodingCounters {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(SimpleEncodingCounters {
+            e1: BinaryRead::read(r)?,
+            e2: BinaryRead::read(r)?,
+            slice: BinaryRead::read(r)?,
+            repetition: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl BinaryWrite for SimpleAcquisition {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.flags, w)?;
+        BinaryWrite::write(&self.idx, w)?;
+        BinaryWrite::write(&self.data, w)?;
+        BinaryWrite::write(&self.trajectory, w)?;
+        Ok(())
+    }
+}
+
+impl BinaryRead for SimpleAcquisition {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(SimpleAcquisition {
+            flags: BinaryRead::read(r)?,
+            idx: BinaryRead::read(r)?,
+            data: BinaryRead::read(r)?,
+            trajectory: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl BinaryWrite for SimpleRecord {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.x, w)?;
+        BinaryWrite::write(&self.y, w)?;
+        BinaryWrite::write(&self.z, w)?;
+        Ok(())
+    }
+}
+
+impl BinaryRead for SimpleRecord {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(SimpleRecord {
+            x: BinaryRead::read(r)?,
+            y: BinaryRead::read(r)?,
+            z: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl BinaryWrite for RecordWithPrimitives {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.bool_field, w)?;
+        BinaryWrite::write(&self.int8_field, w)?;
+        BinaryWrite::write(&self.uint8_field, w)?;
+        BinaryWrite::write(&self.int16_field, w)?;
+        BinaryWrite::write(&self.uint16_field, w)?;
+        BinaryWrite::write(&self.int32_field, w)?;
+        BinaryWrite::write(&self.uint32_field, w)?;
+        BinaryWrite::write(&self.int64_field, w)?;
+        BinaryWrite::write(&self.uint64_field, w)?;
+        BinaryWrite::write(&self.size_field, w)?;
+        BinaryWrite::write(&self.float32_field, w)?;
+        BinaryWrite::write(&self.float64_field, w)?;
+        BinaryWrite::write(&self.complexfloat32_field, w)?;
+        BinaryWrite::write(&self.complexfloat64_field, w)?;
+        BinaryWrite::write(&self.date_field, w)?;
+        BinaryWrite::write(&self.time_field, w)?;
+        BinaryWrite::write(&self.datetime_field, w)?;
+        Ok(())
+    }
+}
+
+impl BinaryRead for RecordWithPrimitives {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(RecordWithPrimitives {
+            bool_field: BinaryRead::read(r)?,
+            int8_field: BinaryRead::read(r)?,
+            uint8_field: BinaryRead::read(r)?,
+            int16_field: BinaryRead::read(r)?,
+            uint16_field: BinaryRead::read(r)?,
+            int32_field: BinaryRead::read(r)?,
+            uint32_field: BinaryRead::read(r)?,
+            int64_field: BinaryRead::read(r)?,
+            uint64_field: BinaryRead::read(r)?,
+            size_field: BinaryRead::read(r)?,
+            float32_field: BinaryRead::read(r)?,
+            float64_field: BinaryRead::read(r)?,
+            complexfloat32_field: BinaryRead::read(r)?,
+            complexfloat64_field: BinaryRead::read(r)?,
+            date_field: BinaryRead::read(r)?,
+            time_field: BinaryRead::read(r)?,
+            datetime_field: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl BinaryWrite for RecordWithPrimitiveAliases {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.byte_field, w)?;
+        BinaryWrite::write(&self.int_field, w)?;
+        BinaryWrite::write(&self.uint_field, w)?;
+        BinaryWrite::write(&self.long_field, w)?;
+        BinaryWrite::write(&self.ulong_field, w)?;
+        BinaryWrite::write(&self.float_field, w)?;
+        BinaryWrite::write(&self.double_field, w)?;
+        BinaryWrite::write(&self.complexfloat_field, w)?;
+        BinaryWrite::write(&self.complexdouble_field, w)?;
+        Ok(())
+    }
+}
+
+impl BinaryRead for RecordWithPrimitiveAliases {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(RecordWithPrimitiveAliases {
+            byte_field: BinaryRead::read(r)?,
+            int_field: BinaryRead::read(r)?,
+            uint_field: BinaryRead::read(r)?,
+            long_field: BinaryRead::read(r)?,
+            ulong_field: BinaryRead::read(r)?,
+            float_field: BinaryRead::read(r)?,
+            double_field: BinaryRead::read(r)?,
+            complexfloat_field: BinaryRead::read(r)?,
+            complexdouble_field: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl BinaryWrite for TupleWithRecords {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.a, w)?;
+        BinaryWrite::write(&self.b, w)?;
+        Ok(())
+    }
+}
+
+impl BinaryRead for TupleWithRecords {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(TupleWithRecords {
+            a: BinaryRead::read(r)?,
+            b: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl BinaryWrite for RecordWithVectors {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.default_vector, w)?;
+        BinaryWrite::write(&self.default_vector_fixed_length, w)?;
+        BinaryWrite::write(&self.vector_of_vectors, w)?;
+        Ok(())
+    }
+}
+
+impl BinaryRead for RecordWithVectors {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(RecordWithVectors {
+            default_vector: BinaryRead::read(r)?,
+            default_vector_fixed_length: BinaryRead::read(r)?,
+            vector_of_vectors: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl BinaryWrite for RecordWithVectorOfTimes {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.times, w)?;
+        Ok(())
+    }
+}
+
+impl BinaryRead for RecordWithVectorOfTimes {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(RecordWithVectorOfTimes {
+            times: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl BinaryWrite for RecordWithArrays {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.default_array, w)?;
+        BinaryWrite::write(&self.default_array_with_empty_dimension, w)?;
+        BinaryWrite::write(&self.rank_1_array, w)?;
+        BinaryWrite::write(&self.rank_2_array, w)?;
+        BinaryWrite::write(&self.rank_2_array_with_named_dimensions, w)?;
+        BinaryWrite::write(&self.rank_2_fixed_array, w)?;
+        BinaryWrite::write(&self.rank_2_fixed_array_with_named_dimensions, w)?;
+        BinaryWrite::write(&self.dynamic_array, w)?;
+        BinaryWrite::write(&self.array_of_vectors, w)?;
+        Ok(())
+    }
+}
+
+impl BinaryRead for RecordWithArrays {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(RecordWithArrays {
+            default_array: BinaryRead::read(r)?,
+            default_array_with_empty_dimension: BinaryRead::read(r)?,
+            rank_1_array: BinaryRead::read(r)?,
+            rank_2_array: BinaryRead::read(r)?,
+            rank_2_array_with_named_dimensions: BinaryRead::read(r)?,
+            rank_2_fixed_array: BinaryRead::read(r)?,
+            rank_2_fixed_array_with_named_dimensions: BinaryRead::read(r)?,
+            dynamic_array: BinaryRead::read(r)?,
+            array_of_vectors: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl BinaryWrite for RecordWithArraysSimpleSyntax {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.default_array, w)?;
+        BinaryWrite::write(&self.default_array_with_empty_dimension, w)?;
+        BinaryWrite::write(&self.rank_1_array, w)?;
+        BinaryWrite::write(&self.rank_2_array, w)?;
+        BinaryWrite::write(&self.rank_2_array_with_named_dimensions, w)?;
+        BinaryWrite::write(&self.rank_2_fixed_array, w)?;
+        BinaryWrite::write(&self.rank_2_fixed_array_with_named_dimensions, w)?;
+        BinaryWrite::write(&self.dynamic_array, w)?;
+        BinaryWrite::write(&self.array_of_vectors, w)?;
+        Ok(())
+    }
+}
+
+impl BinaryRead for RecordWithArraysSimpleSyntax {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(RecordWithArraysSimpleSyntax {
+            default_array: BinaryRead::read(r)?,
+            default_array_with_empty_dimension: BinaryRead::read(r)?,
+            rank_1_array: BinaryRead::read(r)?,
+            rank_2_array: BinaryRead::read(r)?,
+            rank_2_array_with_named_dimensions: BinaryRead::read(r)?,
+            rank_2_fixed_array: BinaryRead::read(r)?,
+            rank_2_fixed_array_with_named_dimensions: BinaryRead::read(r)?,
+            dynamic_array: BinaryRead::read(r)?,
+            array_of_vectors: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl BinaryWrite for RecordWithOptionalFields {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.optional_int, w)?;
+        BinaryWrite::write(&self.optional_int_alternate_syntax, w)?;
+        BinaryWrite::write(&self.optional_time, w)?;
+        Ok(())
+    }
+}
+
+impl BinaryRead for RecordWithOptionalFields {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(RecordWithOptionalFields {
+            optional_int: BinaryRead::read(r)?,
+            optional_int_alternate_syntax: BinaryRead::read(r)?,
+            optional_time: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl BinaryWrite for RecordWithVlens {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.a, w)?;
+        BinaryWrite::write(&self.b, w)?;
+        BinaryWrite::write(&self.c, w)?;
+        Ok(())
+    }
+}
+
+impl BinaryRead for RecordWithVlens {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(RecordWithVlens {
+            a: BinaryRead::read(r)?,
+            b: BinaryRead::read(r)?,
+            c: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl BinaryWrite for RecordWithStrings {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.a, w)?;
+        BinaryWrite::write(&self.b, w)?;
+        Ok(())
+    }
+}
+
+impl BinaryRead for RecordWithStrings {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(RecordWithStrings {
+            a: BinaryRead::read(r)?,
+            b: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl BinaryWrite for RecordWithBytes {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.data, w)?;
+        BinaryWrite::write(&self.optional_data, w)?;
+        BinaryWrite::write(&self.chunks, w)?;
+        Ok(())
+    }
+}
+
+impl BinaryRead for RecordWithBytes {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(RecordWithBytes {
+            data: BinaryRead::read(r)?,
+            optional_data: BinaryRead::read(r)?,
+            chunks: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl BinaryWrite for RecordWithUuids {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.id, w)?;
+        BinaryWrite::write(&self.optional_id, w)?;
+        BinaryWrite::write(&self.related, w)?;
+        BinaryWrite::write(&self.names, w)?;
+        Ok(())
+    }
+}
+
+impl BinaryRead for RecordWithUuids {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(RecordWithUuids {
+            id: BinaryRead::read(r)?,
+            optional_id: BinaryRead::read(r)?,
+            related: BinaryRead::read(r)?,
+            names: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl BinaryWrite for RecordWithDurations {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.acquisition_start, w)?;
+        BinaryWrite::write(&self.acquisition_end, w)?;
+        BinaryWrite::write(&self.repetition_time, w)?;
+        BinaryWrite::write(&self.timeout, w)?;
+        BinaryWrite::write(&self.intervals, w)?;
+        Ok(())
+    }
+}
+
+impl BinaryRead for RecordWithDurations {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(RecordWithDurations {
+            acquisition_start: BinaryRead::read(r)?,
+            acquisition_end: BinaryRead::read(r)?,
+            repetition_time: BinaryRead::read(r)?,
+            timeout: BinaryRead::read(r)?,
+            intervals: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl BinaryWrite for TreeNode {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.label, w)?;
+        BinaryWrite::write(&self.children, w)?;
+        Ok(())
+    }
+}
+
+impl BinaryRead for TreeNode {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(TreeNode {
+            label: BinaryRead::read(r)?,
+            children: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl BinaryWrite for LinkedListNode {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.value, w)?;
+        BinaryWrite::write(&self.next, w)?;
+        Ok(())
+    }
+}
+
+impl BinaryRead for LinkedListNode {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(LinkedListNode {
+            value: BinaryRead::read(r)?,
+            next: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl BinaryWrite for Int32OrExpression {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        match self {
+            Int32OrExpression::Int32(value) => {
+                w.write_uvarint(0)?;
+                BinaryWrite::write(value, w)
+            }
+            Int32OrExpression::Expression(value) => {
+                w.write_uvarint(1)?;
+                BinaryWrite::write(value, w)
+            }
+        }
+    }
+
+    fn write_some<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        match self {
+            Int32OrExpression::Int32(value) => {
+                w.write_uvarint(1)?;
+                BinaryWrite::write(value, w)
+            }
+            Int32OrExpression::Expression(value) => {
+                w.write_uvarint(2)?;
+                BinaryWrite::write(value, w)
+            }
+        }
+    }
+}
+
+impl BinaryRead for Int32OrExpression {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        match r.read_uvarint()? {
+            0 => Ok(Int32OrExpression::Int32(BinaryRead::read(r)?)),
+            1 => Ok(Int32OrExpression::Expression(BinaryRead::read(r)?)),
+            index => Err(yardl::Error::InvalidData(format!("unexpected Int32OrExpression index {}", index))),
+        }
+    }
+
+    fn read_optional<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Option<Self>> {
+        match r.read_uvarint()? {
+            0 => Ok(None),
+            1 => Ok(Some(Int32OrExpression::Int32(BinaryRead::read(r)?))),
+            2 => Ok(Some(Int32OrExpression::Expression(BinaryRead::read(r)?))),
+            index => Err(yardl::Error::InvalidData(format!("unexpected Int32OrExpression index {}", index))),
+        }
+    }
+}
+
+impl BinaryWrite for Expression {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.name, w)?;
+        BinaryWrite::write(&self.operand, w)?;
+        BinaryWrite::write(&self.arguments, w)?;
+        Ok(())
+    }
+}
+
+impl BinaryRead for Expression {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(Expression {
+            name: BinaryRead::read(r)?,
+            operand: BinaryRead::read(r)?,
+            arguments: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl BinaryWrite for RecordWithHalfPrecision {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.half, w)?;
+        BinaryWrite::write(&self.brain, w)?;
+        BinaryWrite::write(&self.half_vector, w)?;
+        BinaryWrite::write(&self.brain_array, w)?;
+        Ok(())
+    }
+}
+
+impl BinaryRead for RecordWithHalfPrecision {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(RecordWithHalfPrecision {
+            half: BinaryRead::read(r)?,
+            brain: BinaryRead::read(r)?,
+            half_vector: BinaryRead::read(r)?,
+            brain_array: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl BinaryWrite for RecordWithOptionalVector {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.optional_vector, w)?;
+        Ok(())
+    }
+}
+
+impl BinaryRead for RecordWithOptionalVector {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(RecordWithOptionalVector {
+            optional_vector: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl BinaryWrite for RecordWithFixedVectors {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.fixed_int_vector, w)?;
+        BinaryWrite::write(&self.fixed_simple_record_vector, w)?;
+        BinaryWrite::write(&self.fixed_record_with_vlens_vector, w)?;
+        Ok(())
+    }
+}
+
+impl BinaryRead for RecordWithFixedVectors {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(RecordWithFixedVectors {
+            fixed_int_vector: BinaryRead::read(r)?,
+            fixed_simple_record_vector: BinaryRead::read(r)?,
+            fixed_record_with_vlens_vector: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl BinaryWrite for RecordWithFixedArrays {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.ints, w)?;
+        BinaryWrite::write(&self.fixed_simple_record_array, w)?;
+        BinaryWrite::write(&self.fixed_record_with_vlens_array, w)?;
+        Ok(())
+    }
+}
+
+impl BinaryRead for RecordWithFixedArrays {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(RecordWithFixedArrays {
+            ints: BinaryRead::read(r)?,
+            fixed_simple_record_array: BinaryRead::read(r)?,
+            fixed_record_with_vlens_array: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl BinaryWrite for RecordWithNamedFixedArrays {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.ints, w)?;
+        BinaryWrite::write(&self.fixed_simple_record_array, w)?;
+        BinaryWrite::write(&self.fixed_record_with_vlens_array, w)?;
+        Ok(())
+    }
+}
+
+impl BinaryRead for RecordWithNamedFixedArrays {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(RecordWithNamedFixedArrays {
+            ints: BinaryRead::read(r)?,
+            fixed_simple_record_array: BinaryRead::read(r)?,
+            fixed_record_with_vlens_array: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl BinaryWrite for RecordWithNDArrays {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.ints, w)?;
+        BinaryWrite::write(&self.fixed_simple_record_array, w)?;
+        BinaryWrite::write(&self.fixed_record_with_vlens_array, w)?;
+        Ok(())
+    }
+}
+
+impl BinaryRead for RecordWithNDArrays {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(RecordWithNDArrays {
+            ints: BinaryRead::read(r)?,
+            fixed_simple_record_array: BinaryRead::read(r)?,
+            fixed_record_with_vlens_array: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl BinaryWrite for RecordWithNDArraysSingleDimension {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.ints, w)?;
+        BinaryWrite::write(&self.fixed_simple_record_array, w)?;
+        BinaryWrite::write(&self.fixed_record_with_vlens_array, w)?;
+        Ok(())
+    }
+}
+
+impl BinaryRead for RecordWithNDArraysSingleDimension {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(RecordWithNDArraysSingleDimension {
+            ints: BinaryRead::read(r)?,
+            fixed_simple_record_array: BinaryRead::read(r)?,
+            fixed_record_with_vlens_array: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl BinaryWrite for RecordWithDynamicNDArrays {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.ints, w)?;
+        BinaryWrite::write(&self.simple_record_array, w)?;
+        BinaryWrite::write(&self.record_with_vlens_array, w)?;
+        Ok(())
+    }
+}
+
+impl BinaryRead for RecordWithDynamicNDArrays {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(RecordWithDynamicNDArrays {
+            ints: BinaryRead::read(r)?,
+            simple_record_array: BinaryRead::read(r)?,
+            record_with_vlens_array: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl BinaryWrite for RecordWithFixedCollections {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.fixed_vector, w)?;
+        BinaryWrite::write(&self.fixed_array, w)?;
+        Ok(())
+    }
+}
+
+impl BinaryRead for RecordWithFixedCollections {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(RecordWithFixedCollections {
+            fixed_vector: BinaryRead::read(r)?,
+            fixed_array: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl BinaryWrite for RecordWithVlenCollections {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.vector, w)?;
+        BinaryWrite::write(&self.array, w)?;
+        Ok(())
+    }
+}
+
+impl BinaryRead for RecordWithVlenCollections {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(RecordWithVlenCollections {
+            vector: BinaryRead::read(r)?,
+            array: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl BinaryWrite for MapOrScalar {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        match self {
+            MapOrScalar::Map(value) => {
+                w.write_uvarint(0)?;
+                BinaryWrite::write(value, w)
+            }
+            MapOrScalar::Scalar(value) => {
+                w.write_uvarint(1)?;
+                BinaryWrite::write(value, w)
+            }
+        }
+    }
+
+    fn write_some<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        match self {
+            MapOrScalar::Map(value) => {
+                w.write_uvarint(1)?;
+                BinaryWrite::write(value, w)
+            }
+            MapOrScalar::Scalar(value) => {
+                w.write_uvarint(2)?;
+                BinaryWrite::write(value, w)
+            }
+        }
+    }
+}
+
+impl BinaryRead for MapOrScalar {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        match r.read_uvarint()? {
+            0 => Ok(MapOrScalar::Map(BinaryRead::read(r)?)),
+            1 => Ok(MapOrScalar::Scalar(BinaryRead::read(r)?)),
+            index => Err(yardl::Error::InvalidData(format!("unexpected MapOrScalar index {}", index))),
+        }
+    }
+
+    fn read_optional<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Option<Self>> {
+        match r.read_uvarint()? {
+            0 => Ok(None),
+            1 => Ok(Some(MapOrScalar::Map(BinaryRead::read(r)?))),
+            2 => Ok(Some(MapOrScalar::Scalar(BinaryRead::read(r)?))),
+            index => Err(yardl::Error::InvalidData(format!("unexpected MapOrScalar index {}", index))),
+        }
+    }
+}
+
+impl BinaryWrite for VectorOrScalar {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        match self {
+            VectorOrScalar::Vector(value) => {
+                w.write_uvarint(0)?;
+                BinaryWrite::write(value, w)
+            }
+            VectorOrScalar::Scalar(value) => {
+                w.write_uvarint(1)?;
+                BinaryWrite::write(value, w)
+            }
+        }
+    }
+
+    fn write_some<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        match self {
+            VectorOrScalar::Vector(value) => {
+                w.write_uvarint(1)?;
+                BinaryWrite::write(value, w)
+            }
+            VectorOrScalar::Scalar(value) => {
+                w.write_uvarint(2)?;
+                BinaryWrite::write(value, w)
+            }
+        }
+    }
+}
+
+impl BinaryRead for VectorOrScalar {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        match r.read_uvarint()? {
+            0 => Ok(VectorOrScalar::Vector(BinaryRead::read(r)?)),
+            1 => Ok(VectorOrScalar::Scalar(BinaryRead::read(r)?)),
+            index => Err(yardl::Error::InvalidData(format!("unexpected VectorOrScalar index {}", index))),
+        }
+    }
+
+    fn read_optional<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Option<Self>> {
+        match r.read_uvarint()? {
+            0 => Ok(None),
+            1 => Ok(Some(VectorOrScalar::Vector(BinaryRead::read(r)?))),
+            2 => Ok(Some(VectorOrScalar::Scalar(BinaryRead::read(r)?))),
+            index => Err(yardl::Error::InvalidData(format!("unexpected VectorOrScalar index {}", index))),
+        }
+    }
+}
+
+impl BinaryWrite for ArrayOrScalar {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        match self {
+            ArrayOrScalar::Array(value) => {
+                w.write_uvarint(0)?;
+                BinaryWrite::write(value, w)
+            }
+            ArrayOrScalar::Scalar(value) => {
+                w.write_uvarint(1)?;
+                BinaryWrite::write(value, w)
+            }
+        }
+    }
+
+    fn write_some<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        match self {
+            ArrayOrScalar::Array(value) => {
+                w.write_uvarint(1)?;
+                BinaryWrite::write(value, w)
+            }
+            ArrayOrScalar::Scalar(value) => {
+                w.write_uvarint(2)?;
+                BinaryWrite::write(value, w)
+            }
+        }
+    }
+}
+
+impl BinaryRead for ArrayOrScalar {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        match r.read_uvarint()? {
+            0 => Ok(ArrayOrScalar::Array(BinaryRead::read(r)?)),
+            1 => Ok(ArrayOrScalar::Scalar(BinaryRead::read(r)?)),
+            index => Err(yardl::Error::InvalidData(format!("unexpected ArrayOrScalar index {}", index))),
+        }
+    }
+
+    fn read_optional<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Option<Self>> {
+        match r.read_uvarint()? {
+            0 => Ok(None),
+            1 => Ok(Some(ArrayOrScalar::Array(BinaryRead::read(r)?))),
+            2 => Ok(Some(ArrayOrScalar::Scalar(BinaryRead::read(r)?))),
+            index => Err(yardl::Error::InvalidData(format!("unexpected ArrayOrScalar index {}", index))),
+        }
+    }
+}
+
+impl BinaryWrite for RecordWithUnionsOfContainers {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.map_or_scalar, w)?;
+        BinaryWrite::write(&self.vector_or_scalar, w)?;
+        BinaryWrite::write(&self.array_or_scalar, w)?;
+        Ok(())
+    }
+}
+
+impl BinaryRead for RecordWithUnionsOfContainers {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(RecordWithUnionsOfContainers {
+            map_or_scalar: BinaryRead::read(r)?,
+            vector_or_scalar: BinaryRead::read(r)?,
+            array_or_scalar: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl BinaryWrite for StringOrInt32 {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        match self {
+            StringOrInt32::String(value) => {
+                w.write_uvarint(0)?;
+                BinaryWrite::write(value, w)
+            }
+            StringOrInt32::Int32(value) => {
+                w.write_uvarint(1)?;
+                BinaryWrite::write(value, w)
+            }
+        }
+    }
+
+    fn write_some<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        match self {
+            StringOrInt32::String(value) => {
+                w.write_uvarint(1)?;
+                BinaryWrite::write(value, w)
+            }
+            StringOrInt32::Int32(value) => {
+                w.write_uvarint(2)?;
+                BinaryWrite::write(value, w)
+            }
+        }
+    }
+}
+
+impl BinaryRead for StringOrInt32 {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        match r.read_uvarint()? {
+            0 => Ok(StringOrInt32::String(BinaryRead::read(r)?)),
+            1 => Ok(StringOrInt32::Int32(BinaryRead::read(r)?)),
+            index => Err(yardl::Error::InvalidData(format!("unexpected StringOrInt32 index {}", index))),
+        }
+    }
+
+    fn read_optional<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Option<Self>> {
+        match r.read_uvarint()? {
+            0 => Ok(None),
+            1 => Ok(Some(StringOrInt32::String(BinaryRead::read(r)?))),
+            2 => Ok(Some(StringOrInt32::Int32(BinaryRead::read(r)?))),
+            index => Err(yardl::Error::InvalidData(format!("unexpected StringOrInt32 index {}", index))),
+        }
+    }
+}
+
+impl BinaryWrite for RecordWithMaps {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.set_1, w)?;
+        BinaryWrite::write(&self.set_2, w)?;
+        BinaryWrite::write(&self.set_3, w)?;
+        Ok(())
+    }
+}
+
+impl BinaryRead for RecordWithMaps {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(RecordWithMaps {
+            set_1: BinaryRead::read(r)?,
+            set_2: BinaryRead::read(r)?,
+            set_3: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl BinaryWrite for UInt64Enum {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&(*self as u64), w)
+    }
+}
+
+impl BinaryRead for UInt64Enum {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        let value = <u64 as BinaryRead>::read(r)?;
+        match value {
+            9223372036854775808 => Ok(UInt64Enum::A),
+            _ => Err(yardl::Error::InvalidData(format!("invalid UInt64Enum value {}", value))),
+        }
+    }
+}
+
+impl BinaryWrite for Int64Enum {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&(*self as i64), w)
+    }
+}
+
+impl BinaryRead for Int64Enum {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        let value = <i64 as BinaryRead>::read(r)?;
+        match value {
+            -4611686018427387904 => Ok(Int64Enum::B),
+            _ => Err(yardl::Error::InvalidData(format!("invalid Int64Enum value {}", value))),
+        }
+    }
+}
+
+impl BinaryWrite for SizeBasedEnum {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&(*self as u64), w)
+    }
+}
+
+impl BinaryRead for SizeBasedEnum {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        let value = <u64 as BinaryRead>::read(r)?;
+        match value {
+            0 => Ok(SizeBasedEnum::A),
+            1 => Ok(SizeBasedEnum::B),
+            2 => Ok(SizeBasedEnum::C),
+            _ => Err(yardl::Error::InvalidData(format!("invalid SizeBasedEnum value {}", value))),
+        }
+    }
+}
+
+impl BinaryWrite for RecordWithNoDefaultEnum {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.enum_, w)?;
+        Ok(())
+    }
+}
+
+impl BinaryRead for RecordWithNoDefaultEnum {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(RecordWithNoDefaultEnum {
+            enum_: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl BinaryWrite for RecordWithEnums {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.enum_, w)?;
+        BinaryWrite::write(&self.flags, w)?;
+        BinaryWrite::write(&self.flags_2, w)?;
+        BinaryWrite::write(&self.rec, w)?;
+        Ok(())
+    }
+}
+
+impl BinaryRead for RecordWithEnums {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(RecordWithEnums {
+            enum_: BinaryRead::read(r)?,
+            flags: BinaryRead::read(r)?,
+            flags_2: BinaryRead::read(r)?,
+            rec: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl<T1: BinaryWrite, T2: BinaryWrite> BinaryWrite for GenericRecord<T1, T2> {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.scalar_1, w)?;
+        BinaryWrite::write(&self.scalar_2, w)?;
+        BinaryWrite::write(&self.vector_1, w)?;
+        BinaryWrite::write(&self.image_2, w)?;
+        Ok(())
+    }
+}
+
+impl<T1: BinaryRead, T2: BinaryRead> BinaryRead for GenericRecord<T1, T2> {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(GenericRecord {
+            scalar_1: BinaryRead::read(r)?,
+            scalar_2: BinaryRead::read(r)?,
+            vector_1: BinaryRead::read(r)?,
+            image_2: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl BinaryWrite for RecordWithAliasedGenerics {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.my_strings, w)?;
+        BinaryWrite::write(&self.aliased_strings, w)?;
+        Ok(())
+    }
+}
+
+impl BinaryRead for RecordWithAliasedGenerics {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(RecordWithAliasedGenerics {
+            my_strings: BinaryRead::read(r)?,
+            aliased_strings: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl<T: BinaryWrite, U: BinaryWrite> BinaryWrite for AliasedMultiGenericOptional<T, U> {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        match self {
+            AliasedMultiGenericOptional::T(value) => {
+                w.write_uvarint(1)?;
+                BinaryWrite::write(value, w)
+            }
+            AliasedMultiGenericOptional::U(value) => {
+                w.write_uvarint(2)?;
+                BinaryWrite::write(value, w)
+            }
+        }
+    }
+
+    fn write_some<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(self, w)
+    }
+}
+
+impl<T: BinaryRead, U: BinaryRead> BinaryRead for AliasedMultiGenericOptional<T, U> {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        match r.read_uvarint()? {
+            1 => Ok(AliasedMultiGenericOptional::T(BinaryRead::read(r)?)),
+            2 => Ok(AliasedMultiGenericOptional::U(BinaryRead::read(r)?)),
+            index => Err(yardl::Error::InvalidData(format!("unexpected AliasedMultiGenericOptional index {}", index))),
+        }
+    }
+
+    fn read_optional<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Option<Self>> {
+        match r.read_uvarint()? {
+            0 => Ok(None),
+            1 => Ok(Some(AliasedMultiGenericOptional::T(BinaryRead::read(r)?))),
+            2 => Ok(Some(AliasedMultiGenericOptional::U(BinaryRead::read(r)?))),
+            index => Err(yardl::Error::InvalidData(format!("unexpected AliasedMultiGenericOptional index {}", index))),
+        }
+    }
+}
+
+impl<T: BinaryWrite, U: BinaryWrite> BinaryWrite for RecordWithGenericVectorOfRecords<T, U> {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.v, w)?;
+        Ok(())
+    }
+}
+
+impl<T: BinaryRead, U: BinaryRead> BinaryRead for RecordWithGenericVectorOfRecords<T, U> {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(RecordWithGenericVectorOfRecords {
+            v: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl<T: BinaryWrite> BinaryWrite for RecordWithOptionalGenericField<T> {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.v, w)?;
+        Ok(())
+    }
+}
+
+impl<T: BinaryRead> BinaryRead for RecordWithOptionalGenericField<T> {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(RecordWithOptionalGenericField {
+            v: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl<T: BinaryWrite> BinaryWrite for RecordWithAliasedOptionalGenericField<T> {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.v, w)?;
+        Ok(())
+    }
+}
+
+impl<T: BinaryRead> BinaryRead for RecordWithAliasedOptionalGenericField<T> {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(RecordWithAliasedOptionalGenericField {
+            v: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl<U: BinaryWrite, V: BinaryWrite> BinaryWrite for UOrV<U, V> {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        match self {
+            UOrV::U(value) => {
+                w.write_uvarint(0)?;
+                BinaryWrite::write(value, w)
+            }
+            UOrV::V(value) => {
+                w.write_uvarint(1)?;
+                BinaryWrite::write(value, w)
+            }
+        }
+    }
+
+    fn write_some<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        match self {
+            UOrV::U(value) => {
+                w.write_uvarint(1)?;
+                BinaryWrite::write(value, w)
+            }
+            UOrV::V(value) => {
+                w.write_uvarint(2)?;
+                BinaryWrite::write(value, w)
+            }
+        }
+    }
+}
+
+impl<U: BinaryRead, V: BinaryRead> BinaryRead for UOrV<U, V> {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        match r.read_uvarint()? {
+            0 => Ok(UOrV::U(BinaryRead::read(r)?)),
+            1 => Ok(UOrV::V(BinaryRead::read(r)?)),
+            index => Err(yardl::Error::InvalidData(format!("unexpected UOrV index {}", index))),
+        }
+    }
+
+    fn read_optional<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Option<Self>> {
+        match r.read_uvarint()? {
+            0 => Ok(None),
+            1 => Ok(Some(UOrV::U(BinaryRead::read(r)?))),
+            2 => Ok(Some(UOrV::V(BinaryRead::read(r)?))),
+            index => Err(yardl::Error::InvalidData(format!("unexpected UOrV index {}", index))),
+        }
+    }
+}
+
+impl<U: BinaryWrite, V: BinaryWrite> BinaryWrite for RecordWithOptionalGenericUnionField<U, V> {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.v, w)?;
+        Ok(())
+    }
+}
+
+impl<U: BinaryRead, V: BinaryRead> BinaryRead for RecordWithOptionalGenericUnionField<U, V> {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(RecordWithOptionalGenericUnionField {
+            v: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl<U: BinaryWrite, V: BinaryWrite> BinaryWrite for RecordWithAliasedOptionalGenericUnionField<U, V> {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.v, w)?;
+        Ok(())
+    }
+}
+
+impl<U: BinaryRead, V: BinaryRead> BinaryRead for RecordWithAliasedOptionalGenericUnionField<U, V> {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(RecordWithAliasedOptionalGenericUnionField {
+            v: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl<T: BinaryWrite> BinaryWrite for RecordWithGenericVectors<T> {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.v, w)?;
+        BinaryWrite::write(&self.av, w)?;
+        Ok(())
+    }
+}
+
+impl<T: BinaryRead> BinaryRead for RecordWithGenericVectors<T> {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(RecordWithGenericVectors {
+            v: BinaryRead::read(r)?,
+            av: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl<T: BinaryWrite> BinaryWrite for RecordWithGenericFixedVectors<T> {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.fv, w)?;
+        BinaryWrite::write(&self.afv, w)?;
+        Ok(())
+    }
+}
+
+impl<T: BinaryRead> BinaryRead for RecordWithGenericFixedVectors<T> {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(RecordWithGenericFixedVectors {
+            fv: BinaryRead::read(r)?,
+            afv: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl<T: BinaryWrite> BinaryWrite for RecordWithGenericArrays<T> {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.nd, w)?;
+        BinaryWrite::write(&self.fixed_nd, w)?;
+        BinaryWrite::write(&self.dynamic_nd, w)?;
+        BinaryWrite::write(&self.aliased_nd, w)?;
+        BinaryWrite::write(&self.aliased_fixed_nd, w)?;
+        BinaryWrite::write(&self.aliased_dynamic_nd, w)?;
+        Ok(())
+    }
+}
+
+impl<T: BinaryRead> BinaryRead for RecordWithGenericArrays<T> {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(RecordWithGenericArrays {
+            nd: BinaryRead::read(r)?,
+            fixed_nd: BinaryRead::read(r)?,
+            dynamic_nd: BinaryRead::read(r)?,
+            aliased_nd: BinaryRead::read(r)?,
+            aliased_fixed_nd: BinaryRead::read(r)?,
+            aliased_dynamic_nd: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl<T: BinaryWrite, U: BinaryWrite> BinaryWrite for RecordWithGenericMaps<T, U> {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.m, w)?;
+        BinaryWrite::write(&self.am, w)?;
+        Ok(())
+    }
+}
+
+impl<T: BinaryRead, U: BinaryRead> BinaryRead for RecordWithGenericMaps<T, U> {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(RecordWithGenericMaps {
+            m: BinaryRead::read(r)?,
+            am: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl<A: BinaryWrite, B: BinaryWrite> BinaryWrite for RecordContainingGenericRecords<A, B> {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.g1, w)?;
+        BinaryWrite::write(&self.g1a, w)?;
+        BinaryWrite::write(&self.g2, w)?;
+        BinaryWrite::write(&self.g2a, w)?;
+        BinaryWrite::write(&self.g3, w)?;
+        BinaryWrite::write(&self.g3a, w)?;
+        BinaryWrite::write(&self.g4, w)?;
+        BinaryWrite::write(&self.g5, w)?;
+        BinaryWrite::write(&self.g6, w)?;
+        BinaryWrite::write(&self.g7, w)?;
+        Ok(())
+    }
+}
+
+impl<A: BinaryRead, B: BinaryRead> BinaryRead for RecordContainingGenericRecords<A, B> {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(RecordContainingGenericRecords {
+            g1: BinaryRead::read(r)?,
+            g1a: BinaryRead::read(r)?,
+            g2: BinaryRead::read(r)?,
+            g2a: BinaryRead::read(r)?,
+            g3: BinaryRead::read(r)?,
+            g3a: BinaryRead::read(r)?,
+            g4: BinaryRead::read(r)?,
+            g5: BinaryRead::read(r)?,
+            g6: BinaryRead::read(r)?,
+            g7: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl BinaryWrite for RecordContainingNestedGenericRecords {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.f1, w)?;
+        BinaryWrite::write(&self.f1a, w)?;
+        BinaryWrite::write(&self.f2, w)?;
+        BinaryWrite::write(&self.f2a, w)?;
+        BinaryWrite::write(&self.nested, w)?;
+        Ok(())
+    }
+}
+
+impl BinaryRead for RecordContainingNestedGenericRecords {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(RecordContainingNestedGenericRecords {
+            f1: BinaryRead::read(r)?,
+            f1a: BinaryRead::read(r)?,
+            f2: BinaryRead::read(r)?,
+            f2a: BinaryRead::read(r)?,
+            nested: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl BinaryWrite for RecordContainingVectorsOfAliases {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.strings, w)?;
+        BinaryWrite::write(&self.maps, w)?;
+        BinaryWrite::write(&self.arrays, w)?;
+        BinaryWrite::write(&self.tuples, w)?;
+        Ok(())
+    }
+}
+
+impl BinaryRead for RecordContainingVectorsOfAliases {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(RecordContainingVectorsOfAliases {
+            strings: BinaryRead::read(r)?,
+            maps: BinaryRead::read(r)?,
+            arrays: BinaryRead::read(r)?,
+            tuples: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl BinaryWrite for AliasedIntOrSimpleRecord {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        match self {
+            AliasedIntOrSimpleRecord::Int32(value) => {
+                w.write_uvarint(0)?;
+                BinaryWrite::write(value, w)
+            }
+            AliasedIntOrSimpleRecord::SimpleRecord(value) => {
+                w.write_uvarint(1)?;
+                BinaryWrite::write(value, w)
+            }
+        }
+    }
+}
+
+impl BinaryRead for AliasedIntOrSimpleRecord {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        match r.read_uvarint()? {
+            0 => Ok(AliasedIntOrSimpleRecord::Int32(BinaryRead::read(r)?)),
+            1 => Ok(AliasedIntOrSimpleRecord::SimpleRecord(BinaryRead::read(r)?)),
+            index => Err(yardl::Error::InvalidData(format!("unexpected AliasedIntOrSimpleRecord index {}", index))),
+        }
+    }
+}
+
+impl BinaryWrite for AliasedIntOrAliasedSimpleRecord {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        match self {
+            AliasedIntOrAliasedSimpleRecord::Int32(value) => {
+                w.write_uvarint(0)?;
+                BinaryWrite::write(value, w)
+            }
+            AliasedIntOrAliasedSimpleRecord::AliasedSimpleRecord(value) => {
+                w.write_uvarint(1)?;
+                BinaryWrite::write(value, w)
+            }
+        }
+    }
+}
+
+impl BinaryRead for AliasedIntOrAliasedSimpleRecord {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        match r.read_uvarint()? {
+            0 => Ok(AliasedIntOrAliasedSimpleRecord::Int32(BinaryRead::read(r)?)),
+            1 => Ok(AliasedIntOrAliasedSimpleRecord::AliasedSimpleRecord(BinaryRead::read(r)?)),
+            index => Err(yardl::Error::InvalidData(format!("unexpected AliasedIntOrAliasedSimpleRecord index {}", index))),
+        }
+    }
+}
+
+impl BinaryWrite for AliasedNullableIntSimpleRecord {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        match self {
+            AliasedNullableIntSimpleRecord::Int32(value) => {
+                w.write_uvarint(1)?;
+                BinaryWrite::write(value, w)
+            }
+            AliasedNullableIntSimpleRecord::SimpleRecord(value) => {
+                w.write_uvarint(2)?;
+                BinaryWrite::write(value, w)
+            }
+        }
+    }
+
+    fn write_some<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(self, w)
+    }
+}
+
+impl BinaryRead for AliasedNullableIntSimpleRecord {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        match r.read_uvarint()? {
+            1 => Ok(AliasedNullableIntSimpleRecord::Int32(BinaryRead::read(r)?)),
+            2 => Ok(AliasedNullableIntSimpleRecord::SimpleRecord(BinaryRead::read(r)?)),
+            index => Err(yardl::Error::InvalidData(format!("unexpected AliasedNullableIntSimpleRecord index {}", index))),
+        }
+    }
+
+    fn read_optional<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Option<Self>> {
+        match r.read_uvarint()? {
+            0 => Ok(None),
+            1 => Ok(Some(AliasedNullableIntSimpleRecord::Int32(BinaryRead::read(r)?))),
+            2 => Ok(Some(AliasedNullableIntSimpleRecord::SimpleRecord(BinaryRead::read(r)?))),
+            index => Err(yardl::Error::InvalidData(format!("unexpected AliasedNullableIntSimpleRecord index {}", index))),
+        }
+    }
+}
+
+impl BinaryWrite for UnionOfContainerRecords {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        match self {
+            UnionOfContainerRecords::RecordWithIntVectors(value) => {
+                w.write_uvarint(0)?;
+                BinaryWrite::write(value, w)
+            }
+            UnionOfContainerRecords::RecordWithFloatArrays(value) => {
+                w.write_uvarint(1)?;
+                BinaryWrite::write(value, w)
+            }
+        }
+    }
+}
+
+impl BinaryRead for UnionOfContainerRecords {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        match r.read_uvarint()? {
+            0 => Ok(UnionOfContainerRecords::RecordWithIntVectors(BinaryRead::read(r)?)),
+            1 => Ok(UnionOfContainerRecords::RecordWithFloatArrays(BinaryRead::read(r)?)),
+            index => Err(yardl::Error::InvalidData(format!("unexpected UnionOfContainerRecords index {}", index))),
+        }
+    }
+}
+
+impl BinaryWrite for Int32OrFloat32 {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        match self {
+            Int32OrFloat32::Int32(value) => {
+                w.write_uvarint(0)?;
+                BinaryWrite::write(value, w)
+            }
+            Int32OrFloat32::Float32(value) => {
+                w.write_uvarint(1)?;
+                BinaryWrite::write(value, w)
+            }
+        }
+    }
+
+    fn write_some<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        match self {
+            Int32OrFloat32::Int32(value) => {
+                w.write_uvarint(1)?;
+                BinaryWrite::write(value, w)
+            }
+            Int32OrFloat32::Float32(value) => {
+                w.write_uvarint(2)?;
+                BinaryWrite::write(value, w)
+            }
+        }
+    }
+}
+
+impl BinaryRead for Int32OrFloat32 {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        match r.read_uvarint()? {
+            0 => Ok(Int32OrFloat32::Int32(BinaryRead::read(r)?)),
+            1 => Ok(Int32OrFloat32::Float32(BinaryRead::read(r)?)),
+            index => Err(yardl::Error::InvalidData(format!("unexpected Int32OrFloat32 index {}", index))),
+        }
+    }
+
+    fn read_optional<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Option<Self>> {
+        match r.read_uvarint()? {
+            0 => Ok(None),
+            1 => Ok(Some(Int32OrFloat32::Int32(BinaryRead::read(r)?))),
+            2 => Ok(Some(Int32OrFloat32::Float32(BinaryRead::read(r)?))),
+            index => Err(yardl::Error::InvalidData(format!("unexpected Int32OrFloat32 index {}", index))),
+        }
+    }
+}
+
+impl BinaryWrite for IntOrGenericRecordWithComputedFields {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        match self {
+            IntOrGenericRecordWithComputedFields::Int(value) => {
+                w.write_uvarint(0)?;
+                BinaryWrite::write(value, w)
+            }
+            IntOrGenericRecordWithComputedFields::GenericRecordWithComputedFields(value) => {
+                w.write_uvarint(1)?;
+                BinaryWrite::write(value, w)
+            }
+        }
+    }
+
+    fn write_some<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        match self {
+            IntOrGenericRecordWithComputedFields::Int(value) => {
+                w.write_uvarint(1)?;
+                BinaryWrite::write(value, w)
+            }
+            IntOrGenericRecordWithComputedFields::GenericRecordWithComputedFields(value) => {
+                w.write_uvarint(2)?;
+                BinaryWrite::write(value, w)
+            }
+        }
+    }
+}
+
+impl BinaryRead for IntOrGenericRecordWithComputedFields {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        match r.read_uvarint()? {
+            0 => Ok(IntOrGenericRecordWithComputedFields::Int(BinaryRead::read(r)?)),
+            1 => Ok(IntOrGenericRecordWithComputedFields::GenericRecordWithComputedFields(BinaryRead::read(r)?)),
+            index => Err(yardl::Error::InvalidData(format!("unexpected IntOrGenericRecordWithComputedFields index {}", index))),
+        }
+    }
+
+    fn read_optional<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Option<Self>> {
+        match r.read_uvarint()? {
+            0 => Ok(None),
+            1 => Ok(Some(IntOrGenericRecordWithComputedFields::Int(BinaryRead::read(r)?))),
+            2 => Ok(Some(IntOrGenericRecordWithComputedFields::GenericRecordWithComputedFields(BinaryRead::read(r)?))),
+            index => Err(yardl::Error::InvalidData(format!("unexpected IntOrGenericRecordWithComputedFields index {}", index))),
+        }
+    }
+}
+
+impl BinaryWrite for RecordWithComputedFields {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.array_field, w)?;
+        BinaryWrite::write(&self.array_field_map_dimensions, w)?;
+        BinaryWrite::write(&self.dynamic_array_field, w)?;
+        BinaryWrite::write(&self.fixed_array_field, w)?;
+        BinaryWrite::write(&self.int_field, w)?;
+        BinaryWrite::write(&self.int8_field, w)?;
+        BinaryWrite::write(&self.uint8_field, w)?;
+        BinaryWrite::write(&self.int16_field, w)?;
+        BinaryWrite::write(&self.uint16_field, w)?;
+        BinaryWrite::write(&self.uint32_field, w)?;
+        BinaryWrite::write(&self.int64_field, w)?;
+        BinaryWrite::write(&self.uint64_field, w)?;
+        BinaryWrite::write(&self.size_field, w)?;
+        BinaryWrite::write(&self.float32_field, w)?;
+        BinaryWrite::write(&self.float64_field, w)?;
+        BinaryWrite::write(&self.complexfloat32_field, w)?;
+        BinaryWrite::write(&self.complexfloat64_field, w)?;
+        BinaryWrite::write(&self.string_field, w)?;
+        BinaryWrite::write(&self.tuple_field, w)?;
+        BinaryWrite::write(&self.vector_field, w)?;
+        BinaryWrite::write(&self.vector_of_vectors_field, w)?;
+        BinaryWrite::write(&self.fixed_vector_field, w)?;
+        BinaryWrite::write(&self.fixed_vector_of_vectors_field, w)?;
+        BinaryWrite::write(&self.optional_named_array, w)?;
+        BinaryWrite::write(&self.int_float_union, w)?;
+        BinaryWrite::write(&self.nullable_int_float_union, w)?;
+        BinaryWrite::write(&self.union_with_nested_generic_union, w)?;
+        BinaryWrite::write(&self.map_field, w)?;
+        Ok(())
+    }
+}
+
+impl BinaryRead for RecordWithComputedFields {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(RecordWithComputedFields {
+            array_field: BinaryRead::read(r)?,
+            array_field_map_dimensions: BinaryRead::read(r)?,
+            dynamic_array_field: BinaryRead::read(r)?,
+            fixed_array_field: BinaryRead::read(r)?,
+            int_field: BinaryRead::read(r)?,
+            int8_field: BinaryRead::read(r)?,
+            uint8_field: BinaryRead::read(r)?,
+            int16_field: BinaryRead::read(r)?,
+            uint16_field: BinaryRead::read(r)?,
+            uint32_field: BinaryRead::read(r)?,
+            int64_field: BinaryRead::read(r)?,
+            uint64_field: BinaryRead::read(r)?,
+            size_field: BinaryRead::read(r)?,
+            float32_field: BinaryRead::read(r)?,
+            float64_field: BinaryRead::read(r)?,
+            complexfloat32_field: BinaryRead::read(r)?,
+            complexfloat64_field: BinaryRead::read(r)?,
+            string_field: BinaryRead::read(r)?,
+            tuple_field: BinaryRead::read(r)?,
+            vector_field: BinaryRead::read(r)?,
+            vector_of_vectors_field: BinaryRead::read(r)?,
+            fixed_vector_field: BinaryRead::read(r)?,
+            fixed_vector_of_vectors_field: BinaryRead::read(r)?,
+            optional_named_array: BinaryRead::read(r)?,
+            int_float_union: BinaryRead::read(r)?,
+            nullable_int_float_union: BinaryRead::read(r)?,
+            union_with_nested_generic_union: BinaryRead::read(r)?,
+            map_field: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl BinaryWrite for RecordWithDefaults {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.int_field, w)?;
+        BinaryWrite::write(&self.int8_field, w)?;
+        BinaryWrite::write(&self.uint64_field, w)?;
+        BinaryWrite::write(&self.float32_field, w)?;
+        BinaryWrite::write(&self.float64_field, w)?;
+        BinaryWrite::write(&self.complexfloat64_field, w)?;
+        BinaryWrite::write(&self.bool_field, w)?;
+        BinaryWrite::write(&self.string_field, w)?;
+        BinaryWrite::write(&self.enum_field, w)?;
+        BinaryWrite::write(&self.no_default_field, w)?;
+        Ok(())
+    }
+}
+
+impl BinaryRead for RecordWithDefaults {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(RecordWithDefaults {
+            int_field: BinaryRead::read(r)?,
+            int8_field: BinaryRead::read(r)?,
+            uint64_field: BinaryRead::read(r)?,
+            float32_field: BinaryRead::read(r)?,
+            float64_field: BinaryRead::read(r)?,
+            complexfloat64_field: BinaryRead::read(r)?,
+            bool_field: BinaryRead::read(r)?,
+            string_field: BinaryRead::read(r)?,
+            enum_field: BinaryRead::read(r)?,
+            no_default_field: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl BinaryWrite for RecordWithConstraints {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.size, w)?;
+        BinaryWrite::write(&self.gain, w)?;
+        BinaryWrite::write(&self.name, w)?;
+        BinaryWrite::write(&self.limit, w)?;
+        BinaryWrite::write(&self.samples, w)?;
+        Ok(())
+    }
+}
+
+impl BinaryRead for RecordWithConstraints {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(RecordWithConstraints {
+            size: BinaryRead::read(r)?,
+            gain: BinaryRead::read(r)?,
+            name: BinaryRead::read(r)?,
+            limit: BinaryRead::read(r)?,
+            samples: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl BinaryWrite for RecordWithPatterns {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.alternation, w)?;
+        BinaryWrite::write(&self.word, w)?;
+        BinaryWrite::write(&self.code, w)?;
+        BinaryWrite::write(&self.escaped, w)?;
+        BinaryWrite::write(&self.capitalized, w)?;
+        Ok(())
+    }
+}
+
+impl BinaryRead for RecordWithPatterns {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(RecordWithPatterns {
+            alternation: BinaryRead::read(r)?,
+            word: BinaryRead::read(r)?,
+            code: BinaryRead::read(r)?,
+            escaped: BinaryRead::read(r)?,
+            capitalized: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl BinaryWrite for RecordWithConstrainedRecords {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.single, w)?;
+        BinaryWrite::write(&self.optional, w)?;
+        BinaryWrite::write(&self.vector, w)?;
+        Ok(())
+    }
+}
+
+impl BinaryRead for RecordWithConstrainedRecords {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(RecordWithConstrainedRecords {
+            single: BinaryRead::read(r)?,
+            optional: BinaryRead::read(r)?,
+            vector: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl<T: BinaryWrite> BinaryWrite for GenericUnionWithRepeatedTypeParameters<T> {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        match self {
+            GenericUnionWithRepeatedTypeParameters::T(value) => {
+                w.write_uvarint(0)?;
+                BinaryWrite::write(value, w)
+            }
+            GenericUnionWithRepeatedTypeParameters::Tv(value) => {
+                w.write_uvarint(1)?;
+                BinaryWrite::write(value, w)
+            }
+            GenericUnionWithRepeatedTypeParameters::Ta(value) => {
+                w.write_uvarint(2)?;
+                BinaryWrite::write(value, w)
+            }
+        }
+    }
+}
+
+impl<T: BinaryRead> BinaryRead for GenericUnionWithRepeatedTypeParameters<T> {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        match r.read_uvarint()? {
+            0 => Ok(GenericUnionWithRepeatedTypeParameters::T(BinaryRead::read(r)?)),
+            1 => Ok(GenericUnionWithRepeatedTypeParameters::Tv(BinaryRead::read(r)?)),
+            2 => Ok(GenericUnionWithRepeatedTypeParameters::Ta(BinaryRead::read(r)?)),
+            index => Err(yardl::Error::InvalidData(format!("unexpected GenericUnionWithRepeatedTypeParameters index {}", index))),
+        }
+    }
+}
+
+impl<T: BinaryWrite, U: BinaryWrite, V: BinaryWrite> BinaryWrite for GenericUnion3<T, U, V> {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        match self {
+            GenericUnion3::T(value) => {
+                w.write_uvarint(0)?;
+                BinaryWrite::write(value, w)
+            }
+            GenericUnion3::U(value) => {
+                w.write_uvarint(1)?;
+                BinaryWrite::write(value, w)
+            }
+            GenericUnion3::V(value) => {
+                w.write_uvarint(2)?;
+                BinaryWrite::write(value, w)
+            }
+        }
+    }
+}
+
+impl<T: BinaryRead, U: BinaryRead, V: BinaryRead> BinaryRead for GenericUnion3<T, U, V> {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        match r.read_uvarint()? {
+            0 => Ok(GenericUnion3::T(BinaryRead::read(r)?)),
+            1 => Ok(GenericUnion3::U(BinaryRead::read(r)?)),
+            2 => Ok(GenericUnion3::V(BinaryRead::read(r)?)),
+            index => Err(yardl::Error::InvalidData(format!("unexpected GenericUnion3 index {}", index))),
+        }
+    }
+}
+
+impl<U: BinaryWrite, V: BinaryWrite, W_: BinaryWrite> BinaryWrite for GenericUnion3Alternate<U, V, W_> {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        match self {
+            GenericUnion3Alternate::U(value) => {
+                w.write_uvarint(0)?;
+                BinaryWrite::write(value, w)
+            }
+            GenericUnion3Alternate::V(value) => {
+                w.write_uvarint(1)?;
+                BinaryWrite::write(value, w)
+            }
+            GenericUnion3Alternate::W(value) => {
+                w.write_uvarint(2)?;
+                BinaryWrite::write(value, w)
+            }
+        }
+    }
+}
+
+impl<U: BinaryRead, V: BinaryRead, W_: BinaryRead> BinaryRead for GenericUnion3Alternate<U, V, W_> {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        match r.read_uvarint()? {
+            0 => Ok(GenericUnion3Alternate::U(BinaryRead::read(r)?)),
+            1 => Ok(GenericUnion3Alternate::V(BinaryRead::read(r)?)),
+            2 => Ok(GenericUnion3Alternate::W(BinaryRead::read(r)?)),
+            index => Err(yardl::Error::InvalidData(format!("unexpected GenericUnion3Alternate index {}", index))),
+        }
+    }
+}
+
+impl BinaryWrite for RecordNotUsedInProtocol {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.u1, w)?;
+        BinaryWrite::write(&self.u2, w)?;
+        Ok(())
+    }
+}
+
+impl BinaryRead for RecordNotUsedInProtocol {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(RecordNotUsedInProtocol {
+            u1: BinaryRead::read(r)?,
+            u2: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl BinaryWrite for EnumWithKeywordSymbols {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&(*self as i32), w)
+    }
+}
+
+impl BinaryRead for EnumWithKeywordSymbols {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        let value = <i32 as BinaryRead>::read(r)?;
+        match value {
+            2 => Ok(EnumWithKeywordSymbols::Try),
+            1 => Ok(EnumWithKeywordSymbols::Catch),
+            _ => Err(yardl::Error::InvalidData(format!("invalid EnumWithKeywordSymbols value {}", value))),
+        }
+    }
+}
+
+impl BinaryWrite for RecordWithKeywordFields {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.int, w)?;
+        BinaryWrite::write(&self.sizeof, w)?;
+        BinaryWrite::write(&self.if_, w)?;
+        Ok(())
+    }
+}
+
+impl BinaryRead for RecordWithKeywordFields {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(RecordWithKeywordFields {
+            int: BinaryRead::read(r)?,
+            sizeof: BinaryRead::read(r)?,
+            if_: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl BinaryWrite for RecordWithOptionalDate {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.date_field, w)?;
+        Ok(())
+    }
+}
+
+impl BinaryRead for RecordWithOptionalDate {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(RecordWithOptionalDate {
+            date_field: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl BinaryWrite for RecordWithConstants {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.channels, w)?;
+        BinaryWrite::write(&self.samples, w)?;
+        BinaryWrite::write(&self.gain, w)?;
+        Ok(())
+    }
+}
+
+impl BinaryRead for RecordWithConstants {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(RecordWithConstants {
+            channels: BinaryRead::read(r)?,
+            samples: BinaryRead::read(r)?,
+            gain: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl BinaryWrite for RecordHeader {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.version, w)?;
+        BinaryWrite::write(&self.name, w)?;
+        Ok(())
+    }
+}
+
+impl BinaryRead for RecordHeader {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(RecordHeader {
+            version: BinaryRead::read(r)?,
+            name: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl<T: BinaryWrite> BinaryWrite for LabeledHeader<T> {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.label, w)?;
+        Ok(())
+    }
+}
+
+impl<T: BinaryRead> BinaryRead for LabeledHeader<T> {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(LabeledHeader {
+            label: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl BinaryWrite for RecordWithBase {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.version, w)?;
+        BinaryWrite::write(&self.name, w)?;
+        BinaryWrite::write(&self.data, w)?;
+        Ok(())
+    }
+}
+
+impl BinaryRead for RecordWithBase {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(RecordWithBase {
+            version: BinaryRead::read(r)?,
+            name: BinaryRead::read(r)?,
+            data: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl BinaryWrite for RecordWithBases {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.version, w)?;
+        BinaryWrite::write(&self.name, w)?;
+        BinaryWrite::write(&self.label, w)?;
+        BinaryWrite::write(&self.value, w)?;
+        Ok(())
+    }
+}
+
+impl BinaryRead for RecordWithBases {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(RecordWithBases {
+            version: BinaryRead::read(r)?,
+            name: BinaryRead::read(r)?,
+            label: BinaryRead::read(r)?,
+            value: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl BinaryWrite for RecordWithAnnotations {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.thickness, w)?;
+        BinaryWrite::write(&self.spacing, w)?;
+        BinaryWrite::write(&self.label, w)?;
+        Ok(())
+    }
+}
+
+impl BinaryRead for RecordWithAnnotations {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(RecordWithAnnotations {
+            thickness: BinaryRead::read(r)?,
+            spacing: BinaryRead::read(r)?,
+            label: BinaryRead::read(r)?,
+        })
+    }
+}
+
+impl BinaryWrite for EnumWithAnnotations {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&(*self as i32), w)
+    }
+}
+
+impl BinaryRead for EnumWithAnnotations {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        let value = <i32 as BinaryRead>::read(r)?;
+        match value {
+            0 => Ok(EnumWithAnnotations::A),
+            1 => Ok(EnumWithAnnotations::B),
+            _ => Err(yardl::Error::InvalidData(format!("invalid EnumWithAnnotations value {}", value))),
+        }
+    }
+}
+
+impl BinaryWrite for RecordWithUnits {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.length, w)?;
+        BinaryWrite::write(&self.width, w)?;
+        BinaryWrite::write(&self.durations, w)?;
+        Ok(())
+    }
+}
+
+impl BinaryRead for RecordWithUnits {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(RecordWithUnits {
+            length: BinaryRead::read(r)?,
+            width: BinaryRead::read(r)?,
+            durations: BinaryRead::read(r)?,
+        })
+    }
+}
+
+/// Writes the BenchmarkFloat256x256 protocol in binary format.
+pub struct BinaryBenchmarkFloat256x256Writer<W: Write> {
+    w: BinaryWriter<W>,
+    state: usize,
+}
+
+impl<W: Write> BinaryBenchmarkFloat256x256Writer<W> {
+    /// Writes the protocol header to the given writer.
+    pub fn new(w: W) -> yardl::Result<Self> {
+        let mut w = BinaryWriter::new(w);
+        w.write_header(BENCHMARK_FLOAT256X256_SCHEMA)?;
+        Ok(Self { w, state: 0 })
+    }
+
+    fn invalid_state(&self, attempted: usize, end: bool) -> yardl::Error {
+        let expected = match self.state {
+            0 => "write_float256x256() or end_float256x256()",
+            _ => "close()",
+        };
+        let received = match attempted {
+            0 if end => "end_float256x256()",
+            0 => "write_float256x256()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: expected.to_string(),
+            received: received.to_string(),
+        }
+    }
+}
+
+impl<W: Write> BenchmarkFloat256x256Writer for BinaryBenchmarkFloat256x256Writer<W> {
+    fn write_float256x256(&mut self, values: &[[[f32; 256]; 256]]) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, false));
+        }
+        self.w.write_block(values)
+    }
+
+    fn end_float256x256(&mut self) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, true));
+        }
+        self.w.write_end_of_stream()?;
+        self.state = 1;
+        Ok(())
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, false));
+        }
+        self.w.flush()
+    }
+}
+
+/// Reads the BenchmarkFloat256x256 protocol in binary format.
+pub struct BinaryBenchmarkFloat256x256Reader<R: Read> {
+    r: BinaryReader<R>,
+    state: usize,
+    float256x256_remaining: u64,
+}
+
+impl<R: Read> BinaryBenchmarkFloat256x256Reader<R> {
+    /// Reads the protocol header and returns an error if the stream is not a binary BenchmarkFloat256x256 stream.
+    pub fn new(r: R) -> yardl::Result<Self> {
+        let mut r = BinaryReader::new(r);
+        r.read_header(Some(BENCHMARK_FLOAT256X256_SCHEMA))?;
+        Ok(Self {
+            r,
+            state: 0,
+            float256x256_remaining: 0,
+        })
+    }
+
+    fn invalid_state(&self, attempted: usize) -> yardl::Error {
+        let method_name = |state: usize| match state {
+            0 => "read_float256x256()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: method_name(self.state).to_string(),
+            received: method_name(attempted).to_string(),
+        }
+    }
+}
+
+impl<R: Read> BenchmarkFloat256x256Reader for BinaryBenchmarkFloat256x256Reader<R> {
+    fn read_float256x256(&mut self) -> yardl::Result<Option<[[f32; 256]; 256]>> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0));
+        }
+        let value = self.r.read_stream_item(&mut self.float256x256_remaining)?;
+        if value.is_none() {
+            self.state = 1;
+        }
+        Ok(value)
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1));
+        }
+        Ok(())
+    }
+}
+
+/// Writes the BenchmarkInt256x256 protocol in binary format.
+pub struct BinaryBenchmarkInt256x256Writer<W: Write> {
+    w: BinaryWriter<W>,
+    state: usize,
+}
+
+impl<W: Write> BinaryBenchmarkInt256x256Writer<W> {
+    /// Writes the protocol header to the given writer.
+    pub fn new(w: W) -> yardl::Result<Self> {
+        let mut w = BinaryWriter::new(w);
+        w.write_header(BENCHMARK_INT256X256_SCHEMA)?;
+        Ok(Self { w, state: 0 })
+    }
+
+    fn invalid_state(&self, attempted: usize, end: bool) -> yardl::Error {
+        let expected = match self.state {
+            0 => "write_int256x256() or end_int256x256()",
+            _ => "close()",
+        };
+        let received = match attempted {
+            0 if end => "end_int256x256()",
+            0 => "write_int256x256()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: expected.to_string(),
+            received: received.to_string(),
+        }
+    }
+}
+
+impl<W: Write> BenchmarkInt256x256Writer for BinaryBenchmarkInt256x256Writer<W> {
+    fn write_int256x256(&mut self, values: &[[[i32; 256]; 256]]) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, false));
+        }
+        self.w.write_block(values)
+    }
+
+    fn end_int256x256(&mut self) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, true));
+        }
+        self.w.write_end_of_stream()?;
+        self.state = 1;
+        Ok(())
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, false));
+        }
+        self.w.flush()
+    }
+}
+
+/// Reads the BenchmarkInt256x256 protocol in binary format.
+pub struct BinaryBenchmarkInt256x256Reader<R: Read> {
+    r: BinaryReader<R>,
+    state: usize,
+    int256x256_remaining: u64,
+}
+
+impl<R: Read> BinaryBenchmarkInt256x256Reader<R> {
+    /// Reads the protocol header and returns an error if the stream is not a binary BenchmarkInt256x256 stream.
+    pub fn new(r: R) -> yardl::Result<Self> {
+        let mut r = BinaryReader::new(r);
+        r.read_header(Some(BENCHMARK_INT256X256_SCHEMA))?;
+        Ok(Self {
+            r,
+            state: 0,
+            int256x256_remaining: 0,
+        })
+    }
+
+    fn invalid_state(&self, attempted: usize) -> yardl::Error {
+        let method_name = |state: usize| match state {
+            0 => "read_int256x256()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: method_name(self.state).to_string(),
+            received: method_name(attempted).to_string(),
+        }
+    }
+}
+
+impl<R: Read> BenchmarkInt256x256Reader for BinaryBenchmarkInt256x256Reader<R> {
+    fn read_int256x256(&mut self) -> yardl::Result<Option<[[i32; 256]; 256]>> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0));
+        }
+        let value = self.r.read_stream_item(&mut self.int256x256_remaining)?;
+        if value.is_none() {
+            self.state = 1;
+        }
+        Ok(value)
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1));
+        }
+        Ok(())
+    }
+}
+
+/// Writes the BenchmarkFloatVlen protocol in binary format.
+pub struct BinaryBenchmarkFloatVlenWriter<W: Write> {
+    w: BinaryWriter<W>,
+    state: usize,
+}
+
+impl<W: Write> BinaryBenchmarkFloatVlenWriter<W> {
+    /// Writes the protocol header to the given writer.
+    pub fn new(w: W) -> yardl::Result<Self> {
+        let mut w = BinaryWriter::new(w);
+        w.write_header(BENCHMARK_FLOAT_VLEN_SCHEMA)?;
+        Ok(Self { w, state: 0 })
+    }
+
+    fn invalid_state(&self, attempted: usize, end: bool) -> yardl::Error {
+        let expected = match self.state {
+            0 => "write_float_array() or end_float_array()",
+            _ => "close()",
+        };
+        let received = match attempted {
+            0 if end => "end_float_array()",
+            0 => "write_float_array()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: expected.to_string(),
+            received: received.to_string(),
+        }
+    }
+}
+
+impl<W: Write> BenchmarkFloatVlenWriter for BinaryBenchmarkFloatVlenWriter<W> {
+    fn write_float_array(&mut self, values: &[yardl::NDArray<f32, 2>]) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, false));
+        }
+        self.w.write_block(values)
+    }
+
+    fn end_float_array(&mut self) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, true));
+        }
+        self.w.write_end_of_stream()?;
+        self.state = 1;
+        Ok(())
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, false));
+        }
+        self.w.flush()
+    }
+}
+
+/// Reads the BenchmarkFloatVlen protocol in binary format.
+pub struct BinaryBenchmarkFloatVlenReader<R: Read> {
+    r: BinaryReader<R>,
+    state: usize,
+    float_array_remaining: u64,
+}
+
+impl<R: Read> BinaryBenchmarkFloatVlenReader<R> {
+    /// Reads the protocol header and returns an error if the stream is not a binary BenchmarkFloatVlen stream.
+    pub fn new(r: R) -> yardl::Result<Self> {
+        let mut r = BinaryReader::new(r);
+        r.read_header(Some(BENCHMARK_FLOAT_VLEN_SCHEMA))?;
+        Ok(Self {
+            r,
+            state: 0,
+            float_array_remaining: 0,
+        })
+    }
+
+    fn invalid_state(&self, attempted: usize) -> yardl::Error {
+        let method_name = |state: usize| match state {
+            0 => "read_float_array()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: method_name(self.state).to_string(),
+            received: method_name(attempted).to_string(),
+        }
+    }
+}
+
+impl<R: Read> BenchmarkFloatVlenReader for BinaryBenchmarkFloatVlenReader<R> {
+    fn read_float_array(&mut self) -> yardl::Result<Option<yardl::NDArray<f32, 2>>> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0));
+        }
+        let value = self.r.read_stream_item(&mut self.float_array_remaining)?;
+        if value.is_none() {
+            self.state = 1;
+        }
+        Ok(value)
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1));
+        }
+        Ok(())
+    }
+}
+
+/// Writes the BenchmarkSmallRecord protocol in binary format.
+pub struct BinaryBenchmarkSmallRecordWriter<W: Write> {
+    w: BinaryWriter<W>,
+    state: usize,
+}
+
+impl<W: Write> BinaryBenchmarkSmallRecordWriter<W> {
+    /// Writes the protocol header to the given writer.
+    pub fn new(w: W) -> yardl::Result<Self> {
+        let mut w = BinaryWriter::new(w);
+        w.write_header(BENCHMARK_SMALL_RECORD_SCHEMA)?;
+        Ok(Self { w, state: 0 })
+    }
+
+    fn invalid_state(&self, attempted: usize, end: bool) -> yardl::Error {
+        let expected = match self.state {
+            0 => "write_small_record() or end_small_record()",
+            _ => "close()",
+        };
+        let received = match attempted {
+            0 if end => "end_small_record()",
+            0 => "write_small_record()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: expected.to_string(),
+            received: received.to_string(),
+        }
+    }
+}
+
+impl<W: Write> BenchmarkSmallRecordWriter for BinaryBenchmarkSmallRecordWriter<W> {
+    fn write_small_record(&mut self, values: &[SmallBenchmarkRecord]) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, false));
+        }
+        self.w.write_block(values)
+    }
+
+    fn end_small_record(&mut self) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, true));
+        }
+        self.w.write_end_of_stream()?;
+        self.state = 1;
+        Ok(())
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, false));
+        }
+        self.w.flush()
+    }
+}
+
+/// Reads the BenchmarkSmallRecord protocol in binary format.
+pub struct BinaryBenchmarkSmallRecordReader<R: Read> {
+    r: BinaryReader<R>,
+    state: usize,
+    small_record_remaining: u64,
+}
+
+impl<R: Read> BinaryBenchmarkSmallRecordReader<R> {
+    /// Reads the protocol header and returns an error if the stream is not a binary BenchmarkSmallRecord stream.
+    pub fn new(r: R) -> yardl::Result<Self> {
+        let mut r = BinaryReader::new(r);
+        r.read_header(Some(BENCHMARK_SMALL_RECORD_SCHEMA))?;
+        Ok(Self {
+            r,
+            state: 0,
+            small_record_remaining: 0,
+        })
+    }
+
+    fn invalid_state(&self, attempted: usize) -> yardl::Error {
+        let method_name = |state: usize| match state {
+            0 => "read_small_record()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: method_name(self.state).to_string(),
+            received: method_name(attempted).to_string(),
+        }
+    }
+}
+
+impl<R: Read> BenchmarkSmallRecordReader for BinaryBenchmarkSmallRecordReader<R> {
+    fn read_small_record(&mut self) -> yardl::Result<Option<SmallBenchmarkRecord>> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0));
+        }
+        let value = self.r.read_stream_item(&mut self.small_record_remaining)?;
+        if value.is_none() {
+            self.state = 1;
+        }
+        Ok(value)
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1));
+        }
+        Ok(())
+    }
+}
+
+/// Writes the BenchmarkSmallRecordWithOptionals protocol in binary format.
+pub struct BinaryBenchmarkSmallRecordWithOptionalsWriter<W: Write> {
+    w: BinaryWriter<W>,
+    state: usize,
+}
+
+impl<W: Write> BinaryBenchmarkSmallRecordWithOptionalsWriter<W> {
+    /// Writes the protocol header to the given writer.
+    pub fn new(w: W) -> yardl::Result<Self> {
+        let mut w = BinaryWriter::new(w);
+        w.write_header(BENCHMARK_SMALL_RECORD_WITH_OPTIONALS_SCHEMA)?;
+        Ok(Self { w, state: 0 })
+    }
+
+    fn invalid_state(&self, attempted: usize, end: bool) -> yardl::Error {
+        let expected = match self.state {
+            0 => "write_small_record() or end_small_record()",
+            _ => "close()",
+        };
+        let received = match attempted {
+            0 if end => "end_small_record()",
+            0 => "write_small_record()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: expected.to_string(),
+            received: received.to_string(),
+        }
+    }
+}
+
+impl<W: Write> BenchmarkSmallRecordWithOptionalsWriter for BinaryBenchmarkSmallRecordWithOptionalsWriter<W> {
+    fn write_small_record(&mut self, values: &[SimpleEncodingCounters]) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, false));
+        }
+        self.w.write_block(values)
+    }
+
+    fn end_small_record(&mut self) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, true));
+        }
+        self.w.write_end_of_stream()?;
+        self.state = 1;
+        Ok(())
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, false));
+        }
+        self.w.flush()
+    }
+}
+
+/// Reads the BenchmarkSmallRecordWithOptionals protocol in binary format.
+pub struct BinaryBenchmarkSmallRecordWithOptionalsReader<R: Read> {
+    r: BinaryReader<R>,
+    state: usize,
+    small_record_remaining: u64,
+}
+
+impl<R: Read> BinaryBenchmarkSmallRecordWithOptionalsReader<R> {
+    /// Reads the protocol header and returns an error if the stream is not a binary BenchmarkSmallRecordWithOptionals stream.
+    pub fn new(r: R) -> yardl::Result<Self> {
+        let mut r = BinaryReader::new(r);
+        r.read_header(Some(BENCHMARK_SMALL_RECORD_WITH_OPTIONALS_SCHEMA))?;
+        Ok(Self {
+            r,
+            state: 0,
+            small_record_remaining: 0,
+        })
+    }
+
+    fn invalid_state(&self, attempted: usize) -> yardl::Error {
+        let method_name = |state: usize| match state {
+            0 => "read_small_record()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: method_name(self.state).to_string(),
+            received: method_name(attempted).to_string(),
+        }
+    }
+}
+
+impl<R: Read> BenchmarkSmallRecordWithOptionalsReader for BinaryBenchmarkSmallRecordWithOptionalsReader<R> {
+    fn read_small_record(&mut self) -> yardl::Result<Option<SimpleEncodingCounters>> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0));
+        }
+        let value = self.r.read_stream_item(&mut self.small_record_remaining)?;
+        if value.is_none() {
+            self.state = 1;
+        }
+        Ok(value)
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1));
+        }
+        Ok(())
+    }
+}
+
+impl BinaryWrite for AcquisitionOrImage {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        match self {
+            AcquisitionOrImage::Acquisition(value) => {
+                w.write_uvarint(0)?;
+                BinaryWrite::write(value, w)
+            }
+            AcquisitionOrImage::Image(value) => {
+                w.write_uvarint(1)?;
+                BinaryWrite::write(value, w)
+            }
+        }
+    }
+
+    fn write_some<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        match self {
+            AcquisitionOrImage::Acquisition(value) => {
+                w.write_uvarint(1)?;
+                BinaryWrite::write(value, w)
+            }
+            AcquisitionOrImage::Image(value) => {
+                w.write_uvarint(2)?;
+                BinaryWrite::write(value, w)
+            }
+        }
+    }
+}
+
+impl BinaryRead for AcquisitionOrImage {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        match r.read_uvarint()? {
+            0 => Ok(AcquisitionOrImage::Acquisition(BinaryRead::read(r)?)),
+            1 => Ok(AcquisitionOrImage::Image(BinaryRead::read(r)?)),
+            index => Err(yardl::Error::InvalidData(format!("unexpected AcquisitionOrImage index {}", index))),
+        }
+    }
+
+    fn read_optional<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Option<Self>> {
+        match r.read_uvarint()? {
+            0 => Ok(None),
+            1 => Ok(Some(AcquisitionOrImage::Acquisition(BinaryRead::read(r)?))),
+            2 => Ok(Some(AcquisitionOrImage::Image(BinaryRead::read(r)?))),
+            index => Err(yardl::Error::InvalidData(format!("unexpected AcquisitionOrImage index {}", index))),
+        }
+    }
+}
+
+/// Writes the BenchmarkSimpleMrd protocol in binary format.
+pub struct BinaryBenchmarkSimpleMrdWriter<W: Write> {
+    w: BinaryWriter<W>,
+    state: usize,
+}
+
+impl<W: Write> BinaryBenchmarkSimpleMrdWriter<W> {
+    /// Writes the protocol header to the given writer.
+    pub fn new(w: W) -> yardl::Result<Self> {
+        let mut w = BinaryWriter::new(w);
+        w.write_header(BENCHMARK_SIMPLE_MRD_SCHEMA)?;
+        Ok(Self { w, state: 0 })
+    }
+
+    fn invalid_state(&self, attempted: usize, end: bool) -> yardl::Error {
+        let expected = match self.state {
+            0 => "write_data() or end_data()",
+            _ => "close()",
+        };
+        let received = match attempted {
+            0 if end => "end_data()",
+            0 => "write_data()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: expected.to_string(),
+            received: received.to_string(),
+        }
+    }
+}
+
+impl<W: Write> BenchmarkSimpleMrdWriter for BinaryBenchmarkSimpleMrdWriter<W> {
+    fn write_data(&mut self, values: &[AcquisitionOrImage]) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, false));
+        }
+        self.w.write_block(values)
+    }
+
+    fn end_data(&mut self) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, true));
+        }
+        self.w.write_end_of_stream()?;
+        self.state = 1;
+        Ok(())
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, false));
+        }
+        self.w.flush()
+    }
+}
+
+/// Reads the BenchmarkSimpleMrd protocol in binary format.
+pub struct BinaryBenchmarkSimpleMrdReader<R: Read> {
+    r: BinaryReader<R>,
+    state: usize,
+    data_remaining: u64,
+}
+
+impl<R: Read> BinaryBenchmarkSimpleMrdReader<R> {
+    /// Reads the protocol header and returns an error if the stream is not a binary BenchmarkSimpleMrd stream.
+    pub fn new(r: R) -> yardl::Result<Self> {
+        let mut r = BinaryReader::new(r);
+        r.read_header(Some(BENCHMARK_SIMPLE_MRD_SCHEMA))?;
+        Ok(Self {
+            r,
+            state: 0,
+            data_remaining: 0,
+        })
+    }
+
+    fn invalid_state(&self, attempted: usize) -> yardl::Error {
+        let method_name = |state: usize| match state {
+            0 => "read_data()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: method_name(self.state).to_string(),
+            received: method_name(attempted).to_string(),
+        }
+    }
+}
+
+impl<R: Read> BenchmarkSimpleMrdReader for BinaryBenchmarkSimpleMrdReader<R> {
+    fn read_data(&mut self) -> yardl::Result<Option<AcquisitionOrImage>> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0));
+        }
+        let value = self.r.read_stream_item(&mut self.data_remaining)?;
+        if value.is_none() {
+            self.state = 1;
+        }
+        Ok(value)
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1));
+        }
+        Ok(())
+    }
+}
+
+/// Writes the Scalars protocol in binary format.
+pub struct BinaryScalarsWriter<W: Write> {
+    w: BinaryWriter<W>,
+    state: usize,
+}
+
+impl<W: Write> BinaryScalarsWriter<W> {
+    /// Writes the protocol header to the given writer.
+    pub fn new(w: W) -> yardl::Result<Self> {
+        let mut w = BinaryWriter::new(w);
+        w.write_header(SCALARS_SCHEMA)?;
+        Ok(Self { w, state: 0 })
+    }
+
+    fn invalid_state(&self, attempted: usize, end: bool) -> yardl::Error {
+        let expected = match self.state {
+            0 => "write_int32()",
+            1 => "write_record()",
+            _ => "close()",
+        };
+        let received = match attempted {
+            0 => "write_int32()",
+            1 => "write_record()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: expected.to_string(),
+            received: received.to_string(),
+        }
+    }
+}
+
+impl<W: Write> ScalarsWriter for BinaryScalarsWriter<W> {
+    fn write_int32(&mut self, value: &i32) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 1;
+        Ok(())
+    }
+
+    fn write_record(&mut self, value: &RecordWithPrimitives) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 2;
+        Ok(())
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2, false));
+        }
+        self.w.flush()
+    }
+}
+
+/// Reads the Scalars protocol in binary format.
+pub struct BinaryScalarsReader<R: Read> {
+    r: BinaryReader<R>,
+    state: usize,
+}
+
+impl<R: Read> BinaryScalarsReader<R> {
+    /// Reads the protocol header and returns an error if the stream is not a binary Scalars stream.
+    pub fn new(r: R) -> yardl::Result<Self> {
+        let mut r = BinaryReader::new(r);
+        r.read_header(Some(SCALARS_SCHEMA))?;
+        Ok(Self {
+            r,
+            state: 0,
+        })
+    }
+
+    fn invalid_state(&self, attempted: usize) -> yardl::Error {
+        let method_name = |state: usize| match state {
+            0 => "read_int32()",
+            1 => "read_record()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: method_name(self.state).to_string(),
+            received: method_name(attempted).to_string(),
+        }
+    }
+}
+
+impl<R: Read> ScalarsReader for BinaryScalarsReader<R> {
+    fn read_int32(&mut self) -> yardl::Result<i32> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 1;
+        Ok(value)
+    }
+
+    fn read_record(&mut self) -> yardl::Result<RecordWithPrimitives> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 2;
+        Ok(value)
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2));
+        }
+        Ok(())
+    }
+}
+
+/// Writes the ScalarOptionals protocol in binary format.
+pub struct BinaryScalarOptionalsWriter<W: Write> {
+    w: BinaryWriter<W>,
+    state: usize,
+}
+
+impl<W: Write> BinaryScalarOptionalsWriter<W> {
+    /// Writes the protocol header to the given writer.
+    pub fn new(w: W) -> yardl::Result<Self> {
+        let mut w = BinaryWriter::new(w);
+        w.write_header(SCALAR_OPTIONALS_SCHEMA)?;
+        Ok(Self { w, state: 0 })
+    }
+
+    fn invalid_state(&self, attempted: usize, end: bool) -> yardl::Error {
+        let expected = match self.state {
+            0 => "write_optional_int()",
+            1 => "write_optional_record()",
+            2 => "write_record_with_optional_fields()",
+            3 => "write_optional_record_with_optional_fields()",
+            _ => "close()",
+        };
+        let received = match attempted {
+            0 => "write_optional_int()",
+            1 => "write_optional_record()",
+            2 => "write_record_with_optional_fields()",
+            3 => "write_optional_record_with_optional_fields()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: expected.to_string(),
+            received: received.to_string(),
+        }
+    }
+}
+
+impl<W: Write> ScalarOptionalsWriter for BinaryScalarOptionalsWriter<W> {
+    fn write_optional_int(&mut self, value: &Option<i32>) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 1;
+        Ok(())
+    }
+
+    fn write_optional_record(&mut self, value: &Option<SimpleRecord>) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 2;
+        Ok(())
+    }
+
+    fn write_record_with_optional_fields(&mut self, value: &RecordWithOptionalFields) -> yardl::Result<()> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 3;
+        Ok(())
+    }
+
+    fn write_optional_record_with_optional_fields(&mut self, value: &Option<RecordWithOptionalFields>) -> yardl::Result<()> {
+        if self.state != 3 {
+            return Err(self.invalid_state(3, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 4;
+        Ok(())
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 4 {
+            return Err(self.invalid_state(4, false));
+        }
+        self.w.flush()
+    }
+}
+
+/// Reads the ScalarOptionals protocol in binary format.
+pub struct BinaryScalarOptionalsReader<R: Read> {
+    r: BinaryReader<R>,
+    state: usize,
+}
+
+impl<R: Read> BinaryScalarOptionalsReader<R> {
+    /// Reads the protocol header and returns an error if the stream is not a binary ScalarOptionals stream.
+    pub fn new(r: R) -> yardl::Result<Self> {
+        let mut r = BinaryReader::new(r);
+        r.read_header(Some(SCALAR_OPTIONALS_SCHEMA))?;
+        Ok(Self {
+            r,
+            state: 0,
+        })
+    }
+
+    fn invalid_state(&self, attempted: usize) -> yardl::Error {
+        let method_name = |state: usize| match state {
+            0 => "read_optional_int()",
+            1 => "read_optional_record()",
+            2 => "read_record_with_optional_fields()",
+            3 => "read_optional_record_with_optional_fields()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: method_name(self.state).to_string(),
+            received: method_name(attempted).to_string(),
+        }
+    }
+}
+
+impl<R: Read> ScalarOptionalsReader for BinaryScalarOptionalsReader<R> {
+    fn read_optional_int(&mut self) -> yardl::Result<Option<i32>> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 1;
+        Ok(value)
+    }
+
+    fn read_optional_record(&mut self) -> yardl::Result<Option<SimpleRecord>> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 2;
+        Ok(value)
+    }
+
+    fn read_record_with_optional_fields(&mut self) -> yardl::Result<RecordWithOptionalFields> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 3;
+        Ok(value)
+    }
+
+    fn read_optional_record_with_optional_fields(&mut self) -> yardl::Result<Option<RecordWithOptionalFields>> {
+        if self.state != 3 {
+            return Err(self.invalid_state(3));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 4;
+        Ok(value)
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 4 {
+            return Err(self.invalid_state(4));
+        }
+        Ok(())
+    }
+}
+
+/// Writes the NestedRecords protocol in binary format.
+pub struct BinaryNestedRecordsWriter<W: Write> {
+    w: BinaryWriter<W>,
+    state: usize,
+}
+
+impl<W: Write> BinaryNestedRecordsWriter<W> {
+    /// Writes the protocol header to the given writer.
+    pub fn new(w: W) -> yardl::Result<Self> {
+        let mut w = BinaryWriter::new(w);
+        w.write_header(NESTED_RECORDS_SCHEMA)?;
+        Ok(Self { w, state: 0 })
+    }
+
+    fn invalid_state(&self, attempted: usize, end: bool) -> yardl::Error {
+        let expected = match self.state {
+            0 => "write_tuple_with_records()",
+            _ => "close()",
+        };
+        let received = match attempted {
+            0 => "write_tuple_with_records()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: expected.to_string(),
+            received: received.to_string(),
+        }
+    }
+}
+
+impl<W: Write> NestedRecordsWriter for BinaryNestedRecordsWriter<W> {
+    fn write_tuple_with_records(&mut self, value: &TupleWithRecords) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 1;
+        Ok(())
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, false));
+        }
+        self.w.flush()
+    }
+}
+
+/// Reads the NestedRecords protocol in binary format.
+pub struct BinaryNestedRecordsReader<R: Read> {
+    r: BinaryReader<R>,
+    state: usize,
+}
+
+impl<R: Read> BinaryNestedRecordsReader<R> {
+    /// Reads the protocol header and returns an error if the stream is not a binary NestedRecords stream.
+    pub fn new(r: R) -> yardl::Result<Self> {
+        let mut r = BinaryReader::new(r);
+        r.read_header(Some(NESTED_RECORDS_SCHEMA))?;
+        Ok(Self {
+            r,
+            state: 0,
+        })
+    }
+
+    fn invalid_state(&self, attempted: usize) -> yardl::Error {
+        let method_name = |state: usize| match state {
+            0 => "read_tuple_with_records()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: method_name(self.state).to_string(),
+            received: method_name(attempted).to_string(),
+        }
+    }
+}
+
+impl<R: Read> NestedRecordsReader for BinaryNestedRecordsReader<R> {
+    fn read_tuple_with_records(&mut self) -> yardl::Result<TupleWithRecords> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 1;
+        Ok(value)
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1));
+        }
+        Ok(())
+    }
+}
+
+/// Writes the Vlens protocol in binary format.
+pub struct BinaryVlensWriter<W: Write> {
+    w: BinaryWriter<W>,
+    state: usize,
+}
+
+impl<W: Write> BinaryVlensWriter<W> {
+    /// Writes the protocol header to the given writer.
+    pub fn new(w: W) -> yardl::Result<Self> {
+        let mut w = BinaryWriter::new(w);
+        w.write_header(VLENS_SCHEMA)?;
+        Ok(Self { w, state: 0 })
+    }
+
+    fn invalid_state(&self, attempted: usize, end: bool) -> yardl::Error {
+        let expected = match self.state {
+            0 => "write_int_vector()",
+            1 => "write_complex_vector()",
+            2 => "write_record_with_vlens()",
+            3 => "write_vlen_of_record_with_vlens()",
+            _ => "close()",
+        };
+        let received = match attempted {
+            0 => "write_int_vector()",
+            1 => "write_complex_vector()",
+            2 => "write_record_with_vlens()",
+            3 => "write_vlen_of_record_with_vlens()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: expected.to_string(),
+            received: received.to_string(),
+        }
+    }
+}
+
+impl<W: Write> VlensWriter for BinaryVlensWriter<W> {
+    fn write_int_vector(&mut self, value: &Vec<i32>) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 1;
+        Ok(())
+    }
+
+    fn write_complex_vector(&mut self, value: &Vec<yardl::Complex<f32>>) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 2;
+        Ok(())
+    }
+
+    fn write_record_with_vlens(&mut self, value: &RecordWithVlens) -> yardl::Result<()> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 3;
+        Ok(())
+    }
+
+    fn write_vlen_of_record_with_vlens(&mut self, value: &Vec<RecordWithVlens>) -> yardl::Result<()> {
+        if self.state != 3 {
+            return Err(self.invalid_state(3, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 4;
+        Ok(())
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 4 {
+            return Err(self.invalid_state(4, false));
+        }
+        self.w.flush()
+    }
+}
+
+/// Reads the Vlens protocol in binary format.
+pub struct BinaryVlensReader<R: Read> {
+    r: BinaryReader<R>,
+    state: usize,
+}
+
+impl<R: Read> BinaryVlensReader<R> {
+    /// Reads the protocol header and returns an error if the stream is not a binary Vlens stream.
+    pub fn new(r: R) -> yardl::Result<Self> {
+        let mut r = BinaryReader::new(r);
+        r.read_header(Some(VLENS_SCHEMA))?;
+        Ok(Self {
+            r,
+            state: 0,
+        })
+    }
+
+    fn invalid_state(&self, attempted: usize) -> yardl::Error {
+        let method_name = |state: usize| match state {
+            0 => "read_int_vector()",
+            1 => "read_complex_vector()",
+            2 => "read_record_with_vlens()",
+            3 => "read_vlen_of_record_with_vlens()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: method_name(self.state).to_string(),
+            received: method_name(attempted).to_string(),
+        }
+    }
+}
+
+impl<R: Read> VlensReader for BinaryVlensReader<R> {
+    fn read_int_vector(&mut self) -> yardl::Result<Vec<i32>> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 1;
+        Ok(value)
+    }
+
+    fn read_complex_vector(&mut self) -> yardl::Result<Vec<yardl::Complex<f32>>> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 2;
+        Ok(value)
+    }
+
+    fn read_record_with_vlens(&mut self) -> yardl::Result<RecordWithVlens> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 3;
+        Ok(value)
+    }
+
+    fn read_vlen_of_record_with_vlens(&mut self) -> yardl::Result<Vec<RecordWithVlens>> {
+        if self.state != 3 {
+            return Err(self.invalid_state(3));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 4;
+        Ok(value)
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 4 {
+            return Err(self.invalid_state(4));
+        }
+        Ok(())
+    }
+}
+
+/// Writes the Strings protocol in binary format.
+pub struct BinaryStringsWriter<W: Write> {
+    w: BinaryWriter<W>,
+    state: usize,
+}
+
+impl<W: Write> BinaryStringsWriter<W> {
+    /// Writes the protocol header to the given writer.
+    pub fn new(w: W) -> yardl::Result<Self> {
+        let mut w = BinaryWriter::new(w);
+        w.write_header(STRINGS_SCHEMA)?;
+        Ok(Self { w, state: 0 })
+    }
+
+    fn invalid_state(&self, attempted: usize, end: bool) -> yardl::Error {
+        let expected = match self.state {
+            0 => "write_single_string()",
+            1 => "write_rec_with_string()",
+            _ => "close()",
+        };
+        let received = match attempted {
+            0 => "write_single_string()",
+            1 => "write_rec_with_string()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: expected.to_string(),
+            received: received.to_string(),
+        }
+    }
+}
+
+impl<W: Write> StringsWriter for BinaryStringsWriter<W> {
+    fn write_single_string(&mut self, value: &String) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 1;
+        Ok(())
+    }
+
+    fn write_rec_with_string(&mut self, value: &RecordWithStrings) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 2;
+        Ok(())
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2, false));
+        }
+        self.w.flush()
+    }
+}
+
+/// Reads the Strings protocol in binary format.
+pub struct BinaryStringsReader<R: Read> {
+    r: BinaryReader<R>,
+    state: usize,
+}
+
+impl<R: Read> BinaryStringsReader<R> {
+    /// Reads the protocol header and returns an error if the stream is not a binary Strings stream.
+    pub fn new(r: R) -> yardl::Result<Self> {
+        let mut r = BinaryReader::new(r);
+        r.read_header(Some(STRINGS_SCHEMA))?;
+        Ok(Self {
+            r,
+            state: 0,
+        })
+    }
+
+    fn invalid_state(&self, attempted: usize) -> yardl::Error {
+        let method_name = |state: usize| match state {
+            0 => "read_single_string()",
+            1 => "read_rec_with_string()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: method_name(self.state).to_string(),
+            received: method_name(attempted).to_string(),
+        }
+    }
+}
+
+impl<R: Read> StringsReader for BinaryStringsReader<R> {
+    fn read_single_string(&mut self) -> yardl::Result<String> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 1;
+        Ok(value)
+    }
+
+    fn read_rec_with_string(&mut self) -> yardl::Result<RecordWithStrings> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 2;
+        Ok(value)
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2));
+        }
+        Ok(())
+    }
+}
+
+/// Writes the ProtocolWithBytes protocol in binary format.
+pub struct BinaryProtocolWithBytesWriter<W: Write> {
+    w: BinaryWriter<W>,
+    state: usize,
+}
+
+impl<W: Write> BinaryProtocolWithBytesWriter<W> {
+    /// Writes the protocol header to the given writer.
+    pub fn new(w: W) -> yardl::Result<Self> {
+        let mut w = BinaryWriter::new(w);
+        w.write_header(PROTOCOL_WITH_BYTES_SCHEMA)?;
+        Ok(Self { w, state: 0 })
+    }
+
+    fn invalid_state(&self, attempted: usize, end: bool) -> yardl::Error {
+        let expected = match self.state {
+            0 => "write_single_bytes()",
+            1 => "write_rec_with_bytes()",
+            _ => "close()",
+        };
+        let received = match attempted {
+            0 => "write_single_bytes()",
+            1 => "write_rec_with_bytes()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: expected.to_string(),
+            received: received.to_string(),
+        }
+    }
+}
+
+impl<W: Write> ProtocolWithBytesWriter for BinaryProtocolWithBytesWriter<W> {
+    fn write_single_bytes(&mut self, value: &Vec<u8>) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 1;
+        Ok(())
+    }
+
+    fn write_rec_with_bytes(&mut self, value: &RecordWithBytes) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 2;
+        Ok(())
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2, false));
+        }
+        self.w.flush()
+    }
+}
+
+/// Reads the ProtocolWithBytes protocol in binary format.
+pub struct BinaryProtocolWithBytesReader<R: Read> {
+    r: BinaryReader<R>,
+    state: usize,
+}
+
+impl<R: Read> BinaryProtocolWithBytesReader<R> {
+    /// Reads the protocol header and returns an error if the stream is not a binary ProtocolWithBytes stream.
+    pub fn new(r: R) -> yardl::Result<Self> {
+        let mut r = BinaryReader::new(r);
+        r.read_header(Some(PROTOCOL_WITH_BYTES_SCHEMA))?;
+        Ok(Self {
+            r,
+            state: 0,
+        })
+    }
+
+    fn invalid_state(&self, attempted: usize) -> yardl::Error {
+        let method_name = |state: usize| match state {
+            0 => "read_single_bytes()",
+            1 => "read_rec_with_bytes()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: method_name(self.state).to_string(),
+            received: method_name(attempted).to_string(),
+        }
+    }
+}
+
+impl<R: Read> ProtocolWithBytesReader for BinaryProtocolWithBytesReader<R> {
+    fn read_single_bytes(&mut self) -> yardl::Result<Vec<u8>> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 1;
+        Ok(value)
+    }
+
+    fn read_rec_with_bytes(&mut self) -> yardl::Result<RecordWithBytes> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 2;
+        Ok(value)
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2));
+        }
+        Ok(())
+    }
+}
+
+/// Writes the ProtocolWithUuids protocol in binary format.
+pub struct BinaryProtocolWithUuidsWriter<W: Write> {
+    w: BinaryWriter<W>,
+    state: usize,
+}
+
+impl<W: Write> BinaryProtocolWithUuidsWriter<W> {
+    /// Writes the protocol header to the given writer.
+    pub fn new(w: W) -> yardl::Result<Self> {
+        let mut w = BinaryWriter::new(w);
+        w.write_header(PROTOCOL_WITH_UUIDS_SCHEMA)?;
+        Ok(Self { w, state: 0 })
+    }
+
+    fn invalid_state(&self, attempted: usize, end: bool) -> yardl::Error {
+        let expected = match self.state {
+            0 => "write_single_uuid()",
+            1 => "write_rec_with_uuids()",
+            _ => "close()",
+        };
+        let received = match attempted {
+            0 => "write_single_uuid()",
+            1 => "write_rec_with_uuids()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: expected.to_string(),
+            received: received.to_string(),
+        }
+    }
+}
+
+impl<W: Write> ProtocolWithUuidsWriter for BinaryProtocolWithUuidsWriter<W> {
+    fn write_single_uuid(&mut self, value: &yardl::Uuid) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 1;
+        Ok(())
+    }
+
+    fn write_rec_with_uuids(&mut self, value: &RecordWithUuids) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 2;
+        Ok(())
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2, false));
+        }
+        self.w.flush()
+    }
+}
+
+/// Reads the ProtocolWithUuids protocol in binary format.
+pub struct BinaryProtocolWithUuidsReader<R: Read> {
+    r: BinaryReader<R>,
+    state: usize,
+}
+
+impl<R: Read> BinaryProtocolWithUuidsReader<R> {
+    /// Reads the protocol header and returns an error if the stream is not a binary ProtocolWithUuids stream.
+    pub fn new(r: R) -> yardl::Result<Self> {
+        let mut r = BinaryReader::new(r);
+        r.read_header(Some(PROTOCOL_WITH_UUIDS_SCHEMA))?;
+        Ok(Self {
+            r,
+            state: 0,
+        })
+    }
+
+    fn invalid_state(&self, attempted: usize) -> yardl::Error {
+        let method_name = |state: usize| match state {
+            0 => "read_single_uuid()",
+            1 => "read_rec_with_uuids()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: method_name(self.state).to_string(),
+            received: method_name(attempted).to_string(),
+        }
+    }
+}
+
+impl<R: Read> ProtocolWithUuidsReader for BinaryProtocolWithUuidsReader<R> {
+    fn read_single_uuid(&mut self) -> yardl::Result<yardl::Uuid> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 1;
+        Ok(value)
+    }
+
+    fn read_rec_with_uuids(&mut self) -> yardl::Result<RecordWithUuids> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 2;
+        Ok(value)
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2));
+        }
+        Ok(())
+    }
+}
+
+/// Writes the ProtocolWithDurations protocol in binary format.
+pub struct BinaryProtocolWithDurationsWriter<W: Write> {
+    w: BinaryWriter<W>,
+    state: usize,
+}
+
+impl<W: Write> BinaryProtocolWithDurationsWriter<W> {
+    /// Writes the protocol header to the given writer.
+    pub fn new(w: W) -> yardl::Result<Self> {
+        let mut w = BinaryWriter::new(w);
+        w.write_header(PROTOCOL_WITH_DURATIONS_SCHEMA)?;
+        Ok(Self { w, state: 0 })
+    }
+
+    fn invalid_state(&self, attempted: usize, end: bool) -> yardl::Error {
+        let expected = match self.state {
+            0 => "write_single_duration()",
+            1 => "write_rec_with_durations()",
+            _ => "close()",
+        };
+        let received = match attempted {
+            0 => "write_single_duration()",
+            1 => "write_rec_with_durations()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: expected.to_string(),
+            received: received.to_string(),
+        }
+    }
+}
+
+impl<W: Write> ProtocolWithDurationsWriter for BinaryProtocolWithDurationsWriter<W> {
+    fn write_single_duration(&mut self, value: &yardl::Duration) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 1;
+        Ok(())
+    }
+
+    fn write_rec_with_durations(&mut self, value: &RecordWithDurations) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 2;
+        Ok(())
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2, false));
+        }
+        self.w.flush()
+    }
+}
+
+/// Reads the ProtocolWithDurations protocol in binary format.
+pub struct BinaryProtocolWithDurationsReader<R: Read> {
+    r: BinaryReader<R>,
+    state: usize,
+}
+
+impl<R: Read> BinaryProtocolWithDurationsReader<R> {
+    /// Reads the protocol header and returns an error if the stream is not a binary ProtocolWithDurations stream.
+    pub fn new(r: R) -> yardl::Result<Self> {
+        let mut r = BinaryReader::new(r);
+        r.read_header(Some(PROTOCOL_WITH_DURATIONS_SCHEMA))?;
+        Ok(Self {
+            r,
+            state: 0,
+        })
+    }
+
+    fn invalid_state(&self, attempted: usize) -> yardl::Error {
+        let method_name = |state: usize| match state {
+            0 => "read_single_duration()",
+            1 => "read_rec_with_durations()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: method_name(self.state).to_string(),
+            received: method_name(attempted).to_string(),
+        }
+    }
+}
+
+impl<R: Read> ProtocolWithDurationsReader for BinaryProtocolWithDurationsReader<R> {
+    fn read_single_duration(&mut self) -> yardl::Result<yardl::Duration> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 1;
+        Ok(value)
+    }
+
+    fn read_rec_with_durations(&mut self) -> yardl::Result<RecordWithDurations> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 2;
+        Ok(value)
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2));
+        }
+        Ok(())
+    }
+}
+
+/// Writes the ProtocolWithRecursiveRecords protocol in binary format.
+pub struct BinaryProtocolWithRecursiveRecordsWriter<W: Write> {
+    w: BinaryWriter<W>,
+    state: usize,
+}
+
+impl<W: Write> BinaryProtocolWithRecursiveRecordsWriter<W> {
+    /// Writes the protocol header to the given writer.
+    pub fn new(w: W) -> yardl::Result<Self> {
+        let mut w = BinaryWriter::new(w);
+        w.write_header(PROTOCOL_WITH_RECURSIVE_RECORDS_SCHEMA)?;
+        Ok(Self { w, state: 0 })
+    }
+
+    fn invalid_state(&self, attempted: usize, end: bool) -> yardl::Error {
+        let expected = match self.state {
+            0 => "write_tree()",
+            1 => "write_list()",
+            2 => "write_expressions() or end_expressions()",
+            _ => "close()",
+        };
+        let received = match attempted {
+            0 => "write_tree()",
+            1 => "write_list()",
+            2 if end => "end_expressions()",
+            2 => "write_expressions()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: expected.to_string(),
+            received: received.to_string(),
+        }
+    }
+}
+
+impl<W: Write> ProtocolWithRecursiveRecordsWriter for BinaryProtocolWithRecursiveRecordsWriter<W> {
+    fn write_tree(&mut self, value: &TreeNode) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 1;
+        Ok(())
+    }
+
+    fn write_list(&mut self, value: &LinkedListNode) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 2;
+        Ok(())
+    }
+
+    fn write_expressions(&mut self, values: &[Expression]) -> yardl::Result<()> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2, false));
+        }
+        self.w.write_block(values)
+    }
+
+    fn end_expressions(&mut self) -> yardl::Result<()> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2, true));
+        }
+        self.w.write_end_of_stream()?;
+        self.state = 3;
+        Ok(())
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 3 {
+            return Err(self.invalid_state(3, false));
+        }
+        self.w.flush()
+    }
+}
+
+/// Reads the ProtocolWithRecursiveRecords protocol in binary format.
+pub struct BinaryProtocolWithRecursiveRecordsReader<R: Read> {
+    r: BinaryReader<R>,
+    state: usize,
+    expressions_remaining: u64,
+}
+
+impl<R: Read> BinaryProtocolWithRecursiveRecordsReader<R> {
+    /// Reads the protocol header and returns an error if the stream is not a binary ProtocolWithRecursiveRecords stream.
+    pub fn new(r: R) -> yardl::Result<Self> {
+        let mut r = BinaryReader::new(r);
+        r.read_header(Some(PROTOCOL_WITH_RECURSIVE_RECORDS_SCHEMA))?;
+        Ok(Self {
+            r,
+            state: 0,
+            expressions_remaining: 0,
+        })
+    }
+
+    fn invalid_state(&self, attempted: usize) -> yardl::Error {
+        let method_name = |state: usize| match state {
+            0 => "read_tree()",
+            1 => "read_list()",
+            2 => "read_expressions()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: method_name(self.state).to_string(),
+            received: method_name(attempted).to_string(),
+        }
+    }
+}
+
+impl<R: Read> ProtocolWithRecursiveRecordsReader for BinaryProtocolWithRecursiveRecordsReader<R> {
+    fn read_tree(&mut self) -> yardl::Result<TreeNode> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 1;
+        Ok(value)
+    }
+
+    fn read_list(&mut self) -> yardl::Result<LinkedListNode> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 2;
+        Ok(value)
+    }
+
+    fn read_expressions(&mut self) -> yardl::Result<Option<Expression>> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2));
+        }
+        let value = self.r.read_stream_item(&mut self.expressions_remaining)?;
+        if value.is_none() {
+            self.state = 3;
+        }
+        Ok(value)
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 3 {
+            return Err(self.invalid_state(3));
+        }
+        Ok(())
+    }
+}
+
+/// Writes the Calibration protocol in binary format.
+pub struct BinaryCalibrationWriter<W: Write> {
+    w: BinaryWriter<W>,
+    state: usize,
+}
+
+impl<W: Write> BinaryCalibrationWriter<W> {
+    /// Writes the protocol header to the given writer.
+    pub fn new(w: W) -> yardl::Result<Self> {
+        let mut w = BinaryWriter::new(w);
+        w.write_header(CALIBRATION_SCHEMA)?;
+        Ok(Self { w, state: 0 })
+    }
+
+    fn invalid_state(&self, attempted: usize, end: bool) -> yardl::Error {
+        let expected = match self.state {
+            0 => "write_gain()",
+            1 => "write_samples() or end_samples()",
+            _ => "close()",
+        };
+        let received = match attempted {
+            0 => "write_gain()",
+            1 if end => "end_samples()",
+            1 => "write_samples()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: expected.to_string(),
+            received: received.to_string(),
+        }
+    }
+}
+
+impl<W: Write> CalibrationWriter for BinaryCalibrationWriter<W> {
+    fn write_gain(&mut self, value: &f32) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 1;
+        Ok(())
+    }
+
+    fn write_samples(&mut self, values: &[f64]) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, false));
+        }
+        self.w.write_block(values)
+    }
+
+    fn end_samples(&mut self) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, true));
+        }
+        self.w.write_end_of_stream()?;
+        self.state = 2;
+        Ok(())
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2, false));
+        }
+        self.w.flush()
+    }
+}
+
+/// Reads the Calibration protocol in binary format.
+pub struct BinaryCalibrationReader<R: Read> {
+    r: BinaryReader<R>,
+    state: usize,
+    samples_remaining: u64,
+}
+
+impl<R: Read> BinaryCalibrationReader<R> {
+    /// Reads the protocol header and returns an error if the stream is not a binary Calibration stream.
+    pub fn new(r: R) -> yardl::Result<Self> {
+        let mut r = BinaryReader::new(r);
+        r.read_header(Some(CALIBRATION_SCHEMA))?;
+        Ok(Self {
+            r,
+            state: 0,
+            samples_remaining: 0,
+        })
+    }
+
+    fn invalid_state(&self, attempted: usize) -> yardl::Error {
+        let method_name = |state: usize| match state {
+            0 => "read_gain()",
+            1 => "read_samples()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: method_name(self.state).to_string(),
+            received: method_name(attempted).to_string(),
+        }
+    }
+}
+
+impl<R: Read> CalibrationReader for BinaryCalibrationReader<R> {
+    fn read_gain(&mut self) -> yardl::Result<f32> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 1;
+        Ok(value)
+    }
+
+    fn read_samples(&mut self) -> yardl::Result<Option<f64>> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1));
+        }
+        let value = self.r.read_stream_item(&mut self.samples_remaining)?;
+        if value.is_none() {
+            self.state = 2;
+        }
+        Ok(value)
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2));
+        }
+        Ok(())
+    }
+}
+
+/// Writes the ProtocolWithSubProtocols protocol in binary format.
+pub struct BinaryProtocolWithSubProtocolsWriter<W: Write> {
+    w: BinaryWriter<W>,
+    state: usize,
+}
+
+impl<W: Write> BinaryProtocolWithSubProtocolsWriter<W> {
+    /// Writes the protocol header to the given writer.
+    pub fn new(w: W) -> yardl::Result<Self> {
+        let mut w = BinaryWriter::new(w);
+        w.write_header(PROTOCOL_WITH_SUB_PROTOCOLS_SCHEMA)?;
+        Ok(Self { w, state: 0 })
+    }
+
+    fn invalid_state(&self, attempted: usize, end: bool) -> yardl::Error {
+        let expected = match self.state {
+            0 => "write_header()",
+            1 => "write_calibration_gain()",
+            2 => "write_calibration_samples() or end_calibration_samples()",
+            3 => "write_data() or end_data()",
+            4 => "write_recalibration_gain()",
+            5 => "write_recalibration_samples() or end_recalibration_samples()",
+            _ => "close()",
+        };
+        let received = match attempted {
+            0 => "write_header()",
+            1 => "write_calibration_gain()",
+            2 if end => "end_calibration_samples()",
+            2 => "write_calibration_samples()",
+            3 if end => "end_data()",
+            3 => "write_data()",
+            4 => "write_recalibration_gain()",
+            5 if end => "end_recalibration_samples()",
+            5 => "write_recalibration_samples()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: expected.to_string(),
+            received: received.to_string(),
+        }
+    }
+}
+
+impl<W: Write> ProtocolWithSubProtocolsWriter for BinaryProtocolWithSubProtocolsWriter<W> {
+    fn write_header(&mut self, value: &String) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 1;
+        Ok(())
+    }
+
+    fn write_calibration_gain(&mut self, value: &f32) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 2;
+        Ok(())
+    }
+
+    fn write_calibration_samples(&mut self, values: &[f64]) -> yardl::Result<()> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2, false));
+        }
+        self.w.write_block(values)
+    }
+
+    fn end_calibration_samples(&mut self) -> yardl::Result<()> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2, true));
+        }
+        self.w.write_end_of_stream()?;
+        self.state = 3;
+        Ok(())
+    }
+
+    fn write_data(&mut self, values: &[i32]) -> yardl::Result<()> {
+        if self.state != 3 {
+            return Err(self.invalid_state(3, false));
+        }
+        self.w.write_block(values)
+    }
+
+    fn end_data(&mut self) -> yardl::Result<()> {
+        if self.state != 3 {
+            return Err(self.invalid_state(3, true));
+        }
+        self.w.write_end_of_stream()?;
+        self.state = 4;
+        Ok(())
+    }
+
+    fn write_recalibration_gain(&mut self, value: &f32) -> yardl::Result<()> {
+        if self.state != 4 {
+            return Err(self.invalid_state(4, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 5;
+        Ok(())
+    }
+
+    fn write_recalibration_samples(&mut self, values: &[f64]) -> yardl::Result<()> {
+        if self.state != 5 {
+            return Err(self.invalid_state(5, false));
+        }
+        self.w.write_block(values)
+    }
+
+    fn end_recalibration_samples(&mut self) -> yardl::Result<()> {
+        if self.state != 5 {
+            return Err(self.invalid_state(5, true));
+        }
+        self.w.write_end_of_stream()?;
+        self.state = 6;
+        Ok(())
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 6 {
+            return Err(self.invalid_state(6, false));
+        }
+        self.w.flush()
+    }
+}
+
+/// Reads the ProtocolWithSubProtocols protocol in binary format.
+pub struct BinaryProtocolWithSubProtocolsReader<R: Read> {
+    r: BinaryReader<R>,
+    state: usize,
+    calibration_samples_remaining: u64,
+    data_remaining: u64,
+    recalibration_samples_remaining: u64,
+}
+
+impl<R: Read> BinaryProtocolWithSubProtocolsReader<R> {
+    /// Reads the protocol header and returns an error if the stream is not a binary ProtocolWithSubProtocols stream.
+    pub fn new(r: R) -> yardl::Result<Self> {
+        let mut r = BinaryReader::new(r);
+        r.read_header(Some(PROTOCOL_WITH_SUB_PROTOCOLS_SCHEMA))?;
+        Ok(Self {
+            r,
+            state: 0,
+            calibration_samples_remaining: 0,
+            data_remaining: 0,
+            recalibration_samples_remaining: 0,
+        })
+    }
+
+    fn invalid_state(&self, attempted: usize) -> yardl::Error {
+        let method_name = |state: usize| match state {
+            0 => "read_header()",
+            1 => "read_calibration_gain()",
+            2 => "read_calibration_samples()",
+            3 => "read_data()",
+            4 => "read_recalibration_gain()",
+            5 => "read_recalibration_samples()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: method_name(self.state).to_string(),
+            received: method_name(attempted).to_string(),
+        }
+    }
+}
+
+impl<R: Read> ProtocolWithSubProtocolsReader for BinaryProtocolWithSubProtocolsReader<R> {
+    fn read_header(&mut self) -> yardl::Result<String> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 1;
+        Ok(value)
+    }
+
+    fn read_calibration_gain(&mut self) -> yardl::Result<f32> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 2;
+        Ok(value)
+    }
+
+    fn read_calibration_samples(&mut self) -> yardl::Result<Option<f64>> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2));
+        }
+        let value = self.r.read_stream_item(&mut self.calibration_samples_remaining)?;
+        if value.is_none() {
+            self.state = 3;
+        }
+        Ok(value)
+    }
+
+    fn read_data(&mut self) -> yardl::Result<Option<i32>> {
+        if self.state != 3 {
+            return Err(self.invalid_state(3));
+        }
+        let value = self.r.read_stream_item(&mut self.data_remaining)?;
+        if value.is_none() {
+            self.state = 4;
+        }
+        Ok(value)
+    }
+
+    fn read_recalibration_gain(&mut self) -> yardl::Result<f32> {
+        if self.state != 4 {
+            return Err(self.invalid_state(4));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 5;
+        Ok(value)
+    }
+
+    fn read_recalibration_samples(&mut self) -> yardl::Result<Option<f64>> {
+        if self.state != 5 {
+            return Err(self.invalid_state(5));
+        }
+        let value = self.r.read_stream_item(&mut self.recalibration_samples_remaining)?;
+        if value.is_none() {
+            self.state = 6;
+        }
+        Ok(value)
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 6 {
+            return Err(self.invalid_state(6));
+        }
+        Ok(())
+    }
+}
+
+/// Writes the ProtocolWithHalfPrecision protocol in binary format.
+pub struct BinaryProtocolWithHalfPrecisionWriter<W: Write> {
+    w: BinaryWriter<W>,
+    state: usize,
+}
+
+impl<W: Write> BinaryProtocolWithHalfPrecisionWriter<W> {
+    /// Writes the protocol header to the given writer.
+    pub fn new(w: W) -> yardl::Result<Self> {
+        let mut w = BinaryWriter::new(w);
+        w.write_header(PROTOCOL_WITH_HALF_PRECISION_SCHEMA)?;
+        Ok(Self { w, state: 0 })
+    }
+
+    fn invalid_state(&self, attempted: usize, end: bool) -> yardl::Error {
+        let expected = match self.state {
+            0 => "write_halves()",
+            1 => "write_rec_with_halves()",
+            _ => "close()",
+        };
+        let received = match attempted {
+            0 => "write_halves()",
+            1 => "write_rec_with_halves()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: expected.to_string(),
+            received: received.to_string(),
+        }
+    }
+}
+
+impl<W: Write> ProtocolWithHalfPrecisionWriter for BinaryProtocolWithHalfPrecisionWriter<W> {
+    fn write_halves(&mut self, value: &Vec<yardl::Float16>) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 1;
+        Ok(())
+    }
+
+    fn write_rec_with_halves(&mut self, value: &RecordWithHalfPrecision) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 2;
+        Ok(())
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2, false));
+        }
+        self.w.flush()
+    }
+}
+
+/// Reads the ProtocolWithHalfPrecision protocol in binary format.
+pub struct BinaryProtocolWithHalfPrecisionReader<R: Read> {
+    r: BinaryReader<R>,
+    state: usize,
+}
+
+impl<R: Read> BinaryProtocolWithHalfPrecisionReader<R> {
+    /// Reads the protocol header and returns an error if the stream is not a binary ProtocolWithHalfPrecision stream.
+    pub fn new(r: R) -> yardl::Result<Self> {
+        let mut r = BinaryReader::new(r);
+        r.read_header(Some(PROTOCOL_WITH_HALF_PRECISION_SCHEMA))?;
+        Ok(Self {
+            r,
+            state: 0,
+        })
+    }
+
+    fn invalid_state(&self, attempted: usize) -> yardl::Error {
+        let method_name = |state: usize| match state {
+            0 => "read_halves()",
+            1 => "read_rec_with_halves()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: method_name(self.state).to_string(),
+            received: method_name(attempted).to_string(),
+        }
+    }
+}
+
+impl<R: Read> ProtocolWithHalfPrecisionReader for BinaryProtocolWithHalfPrecisionReader<R> {
+    fn read_halves(&mut self) -> yardl::Result<Vec<yardl::Float16>> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 1;
+        Ok(value)
+    }
+
+    fn read_rec_with_halves(&mut self) -> yardl::Result<RecordWithHalfPrecision> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 2;
+        Ok(value)
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2));
+        }
+        Ok(())
+    }
+}
+
+/// Writes the OptionalVectors protocol in binary format.
+pub struct BinaryOptionalVectorsWriter<W: Write> {
+    w: BinaryWriter<W>,
+    state: usize,
+}
+
+impl<W: Write> BinaryOptionalVectorsWriter<W> {
+    /// Writes the protocol header to the given writer.
+    pub fn new(w: W) -> yardl::Result<Self> {
+        let mut w = BinaryWriter::new(w);
+        w.write_header(OPTIONAL_VECTORS_SCHEMA)?;
+        Ok(Self { w, state: 0 })
+    }
+
+    fn invalid_state(&self, attempted: usize, end: bool) -> yardl::Error {
+        let expected = match self.state {
+            0 => "write_record_with_optional_vector()",
+            _ => "close()",
+        };
+        let received = match attempted {
+            0 => "write_record_with_optional_vector()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: expected.to_string(),
+            received: received.to_string(),
+        }
+    }
+}
+
+impl<W: Write> OptionalVectorsWriter for BinaryOptionalVectorsWriter<W> {
+    fn write_record_with_optional_vector(&mut self, value: &RecordWithOptionalVector) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 1;
+        Ok(())
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, false));
+        }
+        self.w.flush()
+    }
+}
+
+/// Reads the OptionalVectors protocol in binary format.
+pub struct BinaryOptionalVectorsReader<R: Read> {
+    r: BinaryReader<R>,
+    state: usize,
+}
+
+impl<R: Read> BinaryOptionalVectorsReader<R> {
+    /// Reads the protocol header and returns an error if the stream is not a binary OptionalVectors stream.
+    pub fn new(r: R) -> yardl::Result<Self> {
+        let mut r = BinaryReader::new(r);
+        r.read_header(Some(OPTIONAL_VECTORS_SCHEMA))?;
+        Ok(Self {
+            r,
+            state: 0,
+        })
+    }
+
+    fn invalid_state(&self, attempted: usize) -> yardl::Error {
+        let method_name = |state: usize| match state {
+            0 => "read_record_with_optional_vector()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: method_name(self.state).to_string(),
+            received: method_name(attempted).to_string(),
+        }
+    }
+}
+
+impl<R: Read> OptionalVectorsReader for BinaryOptionalVectorsReader<R> {
+    fn read_record_with_optional_vector(&mut self) -> yardl::Result<RecordWithOptionalVector> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 1;
+        Ok(value)
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1));
+        }
+        Ok(())
+    }
+}
+
+/// Writes the FixedVectors protocol in binary format.
+pub struct BinaryFixedVectorsWriter<W: Write> {
+    w: BinaryWriter<W>,
+    state: usize,
+}
+
+impl<W: Write> BinaryFixedVectorsWriter<W> {
+    /// Writes the protocol header to the given writer.
+    pub fn new(w: W) -> yardl::Result<Self> {
+        let mut w = BinaryWriter::new(w);
+        w.write_header(FIXED_VECTORS_SCHEMA)?;
+        Ok(Self { w, state: 0 })
+    }
+
+    fn invalid_state(&self, attempted: usize, end: bool) -> yardl::Error {
+        let expected = match self.state {
+            0 => "write_fixed_int_vector()",
+            1 => "write_fixed_simple_record_vector()",
+            2 => "write_fixed_record_with_vlens_vector()",
+            3 => "write_record_with_fixed_vectors()",
+            _ => "close()",
+        };
+        let received = match attempted {
+            0 => "write_fixed_int_vector()",
+            1 => "write_fixed_simple_record_vector()",
+            2 => "write_fixed_record_with_vlens_vector()",
+            3 => "write_record_with_fixed_vectors()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: expected.to_string(),
+            received: received.to_string(),
+        }
+    }
+}
+
+impl<W: Write> FixedVectorsWriter for BinaryFixedVectorsWriter<W> {
+    fn write_fixed_int_vector(&mut self, value: &[i32; 5]) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 1;
+        Ok(())
+    }
+
+    fn write_fixed_simple_record_vector(&mut self, value: &[SimpleRecord; 3]) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 2;
+        Ok(())
+    }
+
+    fn write_fixed_record_with_vlens_vector(&mut self, value: &[RecordWithVlens; 2]) -> yardl::Result<()> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 3;
+        Ok(())
+    }
+
+    fn write_record_with_fixed_vectors(&mut self, value: &RecordWithFixedVectors) -> yardl::Result<()> {
+        if self.state != 3 {
+            return Err(self.invalid_state(3, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 4;
+        Ok(())
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 4 {
+            return Err(self.invalid_state(4, false));
+        }
+        self.w.flush()
+    }
+}
+
+/// Reads the FixedVectors protocol in binary format.
+pub struct BinaryFixedVectorsReader<R: Read> {
+    r: BinaryReader<R>,
+    state: usize,
+}
+
+impl<R: Read> BinaryFixedVectorsReader<R> {
+    /// Reads the protocol header and returns an error if the stream is not a binary FixedVectors stream.
+    pub fn new(r: R) -> yardl::Result<Self> {
+        let mut r = BinaryReader::new(r);
+        r.read_header(Some(FIXED_VECTORS_SCHEMA))?;
+        Ok(Self {
+            r,
+            state: 0,
+        })
+    }
+
+    fn invalid_state(&self, attempted: usize) -> yardl::Error {
+        let method_name = |state: usize| match state {
+            0 => "read_fixed_int_vector()",
+            1 => "read_fixed_simple_record_vector()",
+            2 => "read_fixed_record_with_vlens_vector()",
+            3 => "read_record_with_fixed_vectors()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: method_name(self.state).to_string(),
+            received: method_name(attempted).to_string(),
+        }
+    }
+}
+
+impl<R: Read> FixedVectorsReader for BinaryFixedVectorsReader<R> {
+    fn read_fixed_int_vector(&mut self) -> yardl::Result<[i32; 5]> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 1;
+        Ok(value)
+    }
+
+    fn read_fixed_simple_record_vector(&mut self) -> yardl::Result<[SimpleRecord; 3]> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 2;
+        Ok(value)
+    }
+
+    fn read_fixed_record_with_vlens_vector(&mut self) -> yardl::Result<[RecordWithVlens; 2]> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 3;
+        Ok(value)
+    }
+
+    fn read_record_with_fixed_vectors(&mut self) -> yardl::Result<RecordWithFixedVectors> {
+        if self.state != 3 {
+            return Err(self.invalid_state(3));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 4;
+        Ok(value)
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 4 {
+            return Err(self.invalid_state(4));
+        }
+        Ok(())
+    }
+}
+
+/// Writes the Streams protocol in binary format.
+pub struct BinaryStreamsWriter<W: Write> {
+    w: BinaryWriter<W>,
+    state: usize,
+}
+
+impl<W: Write> BinaryStreamsWriter<W> {
+    /// Writes the protocol header to the given writer.
+    pub fn new(w: W) -> yardl::Result<Self> {
+        let mut w = BinaryWriter::new(w);
+        w.write_header(STREAMS_SCHEMA)?;
+        Ok(Self { w, state: 0 })
+    }
+
+    fn invalid_state(&self, attempted: usize, end: bool) -> yardl::Error {
+        let expected = match self.state {
+            0 => "write_int_data() or end_int_data()",
+            1 => "write_optional_int_data() or end_optional_int_data()",
+            2 => "write_record_with_optional_vector_data() or end_record_with_optional_vector_data()",
+            3 => "write_fixed_vector() or end_fixed_vector()",
+            _ => "close()",
+        };
+        let received = match attempted {
+            0 if end => "end_int_data()",
+            0 => "write_int_data()",
+            1 if end => "end_optional_int_data()",
+            1 => "write_optional_int_data()",
+            2 if end => "end_record_with_optional_vector_data()",
+            2 => "write_record_with_optional_vector_data()",
+            3 if end => "end_fixed_vector()",
+            3 => "write_fixed_vector()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: expected.to_string(),
+            received: received.to_string(),
+        }
+    }
+}
+
+impl<W: Write> StreamsWriter for BinaryStreamsWriter<W> {
+    fn write_int_data(&mut self, values: &[i32]) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, false));
+        }
+        self.w.write_block(values)
+    }
+
+    fn end_int_data(&mut self) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, true));
+        }
+        self.w.write_end_of_stream()?;
+        self.state = 1;
+        Ok(())
+    }
+
+    fn write_optional_int_data(&mut self, values: &[Option<i32>]) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, false));
+        }
+        self.w.write_block(values)
+    }
+
+    fn end_optional_int_data(&mut self) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, true));
+        }
+        self.w.write_end_of_stream()?;
+        self.state = 2;
+        Ok(())
+    }
+
+    fn write_record_with_optional_vector_data(&mut self, values: &[RecordWithOptionalVector]) -> yardl::Result<()> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2, false));
+        }
+        self.w.write_block(values)
+    }
+
+    fn end_record_with_optional_vector_data(&mut self) -> yardl::Result<()> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2, true));
+        }
+        self.w.write_end_of_stream()?;
+        self.state = 3;
+        Ok(())
+    }
+
+    fn write_fixed_vector(&mut self, values: &[[i32; 3]]) -> yardl::Result<()> {
+        if self.state != 3 {
+            return Err(self.invalid_state(3, false));
+        }
+        self.w.write_block(values)
+    }
+
+    fn end_fixed_vector(&mut self) -> yardl::Result<()> {
+        if self.state != 3 {
+            return Err(self.invalid_state(3, true));
+        }
+        self.w.write_end_of_stream()?;
+        self.state = 4;
+        Ok(())
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 4 {
+            return Err(self.invalid_state(4, false));
+        }
+        self.w.flush()
+    }
+}
+
+/// Reads the Streams protocol in binary format.
+pub struct BinaryStreamsReader<R: Read> {
+    r: BinaryReader<R>,
+    state: usize,
+    int_data_remaining: u64,
+    optional_int_data_remaining: u64,
+    record_with_optional_vector_data_remaining: u64,
+    fixed_vector_remaining: u64,
+}
+
+impl<R: Read> BinaryStreamsReader<R> {
+    /// Reads the protocol header and returns an error if the stream is not a binary Streams stream.
+    pub fn new(r: R) -> yardl::Result<Self> {
+        let mut r = BinaryReader::new(r);
+        r.read_header(Some(STREAMS_SCHEMA))?;
+        Ok(Self {
+            r,
+            state: 0,
+            int_data_remaining: 0,
+            optional_int_data_remaining: 0,
+            record_with_optional_vector_data_remaining: 0,
+            fixed_vector_remaining: 0,
+        })
+    }
+
+    fn invalid_state(&self, attempted: usize) -> yardl::Error {
+        let method_name = |state: usize| match state {
+            0 => "read_int_data()",
+            1 => "read_optional_int_data()",
+            2 => "read_record_with_optional_vector_data()",
+            3 => "read_fixed_vector()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: method_name(self.state).to_string(),
+            received: method_name(attempted).to_string(),
+        }
+    }
+}
+
+impl<R: Read> StreamsReader for BinaryStreamsReader<R> {
+    fn read_int_data(&mut self) -> yardl::Result<Option<i32>> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0));
+        }
+        let value = self.r.read_stream_item(&mut self.int_data_remaining)?;
+        if value.is_none() {
+            self.state = 1;
+        }
+        Ok(value)
+    }
+
+    fn read_optional_int_data(&mut self) -> yardl::Result<Option<Option<i32>>> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1));
+        }
+        let value = self.r.read_stream_item(&mut self.optional_int_data_remaining)?;
+        if value.is_none() {
+            self.state = 2;
+        }
+        Ok(value)
+    }
+
+    fn read_record_with_optional_vector_data(&mut self) -> yardl::Result<Option<RecordWithOptionalVector>> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2));
+        }
+        let value = self.r.read_stream_item(&mut self.record_with_optional_vector_data_remaining)?;
+        if value.is_none() {
+            self.state = 3;
+        }
+        Ok(value)
+    }
+
+    fn read_fixed_vector(&mut self) -> yardl::Result<Option<[i32; 3]>> {
+        if self.state != 3 {
+            return Err(self.invalid_state(3));
+        }
+        let value = self.r.read_stream_item(&mut self.fixed_vector_remaining)?;
+        if value.is_none() {
+            self.state = 4;
+        }
+        Ok(value)
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 4 {
+            return Err(self.invalid_state(4));
+        }
+        Ok(())
+    }
+}
+
+/// Writes the FixedArrays protocol in binary format.
+pub struct BinaryFixedArraysWriter<W: Write> {
+    w: BinaryWriter<W>,
+    state: usize,
+}
+
+impl<W: Write> BinaryFixedArraysWriter<W> {
+    /// Writes the protocol header to the given writer.
+    pub fn new(w: W) -> yardl::Result<Self> {
+        let mut w = BinaryWriter::new(w);
+        w.write_header(FIXED_ARRAYS_SCHEMA)?;
+        Ok(Self { w, state: 0 })
+    }
+
+    fn invalid_state(&self, attempted: usize, end: bool) -> yardl::Error {
+        let expected = match self.state {
+            0 => "write_ints()",
+            1 => "write_fixed_simple_record_array()",
+            2 => "write_fixed_record_with_vlens_array()",
+            3 => "write_record_with_fixed_arrays()",
+            4 => "write_named_array()",
+            _ => "close()",
+        };
+        let received = match attempted {
+            0 => "write_ints()",
+            1 => "write_fixed_simple_record_array()",
+            2 => "write_fixed_record_with_vlens_array()",
+            3 => "write_record_with_fixed_arrays()",
+            4 => "write_named_array()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: expected.to_string(),
+            received: received.to_string(),
+        }
+    }
+}
+
+impl<W: Write> FixedArraysWriter for BinaryFixedArraysWriter<W> {
+    fn write_ints(&mut self, value: &[[i32; 3]; 2]) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 1;
+        Ok(())
+    }
+
+    fn write_fixed_simple_record_array(&mut self, value: &[[SimpleRecord; 2]; 3]) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 2;
+        Ok(())
+    }
+
+    fn write_fixed_record_with_vlens_array(&mut self, value: &[[RecordWithVlens; 2]; 2]) -> yardl::Result<()> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 3;
+        Ok(())
+    }
+
+    fn write_record_with_fixed_arrays(&mut self, value: &RecordWithFixedArrays) -> yardl::Result<()> {
+        if self.state != 3 {
+            return Err(self.invalid_state(3, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 4;
+        Ok(())
+    }
+
+    fn write_named_array(&mut self, value: &NamedFixedNDArray) -> yardl::Result<()> {
+        if self.state != 4 {
+            return Err(self.invalid_state(4, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 5;
+        Ok(())
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 5 {
+            return Err(self.invalid_state(5, false));
+        }
+        self.w.flush()
+    }
+}
+
+/// Reads the FixedArrays protocol in binary format.
+pub struct BinaryFixedArraysReader<R: Read> {
+    r: BinaryReader<R>,
+    state: usize,
+}
+
+impl<R: Read> BinaryFixedArraysReader<R> {
+    /// Reads the protocol header and returns an error if the stream is not a binary FixedArrays stream.
+    pub fn new(r: R) -> yardl::Result<Self> {
+        let mut r = BinaryReader::new(r);
+        r.read_header(Some(FIXED_ARRAYS_SCHEMA))?;
+        Ok(Self {
+            r,
+            state: 0,
+        })
+    }
+
+    fn invalid_state(&self, attempted: usize) -> yardl::Error {
+        let method_name = |state: usize| match state {
+            0 => "read_ints()",
+            1 => "read_fixed_simple_record_array()",
+            2 => "read_fixed_record_with_vlens_array()",
+            3 => "read_record_with_fixed_arrays()",
+            4 => "read_named_array()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: method_name(self.state).to_string(),
+            received: method_name(attempted).to_string(),
+        }
+    }
+}
+
+impl<R: Read> FixedArraysReader for BinaryFixedArraysReader<R> {
+    fn read_ints(&mut self) -> yardl::Result<[[i32; 3]; 2]> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 1;
+        Ok(value)
+    }
+
+    fn read_fixed_simple_record_array(&mut self) -> yardl::Result<[[SimpleRecord; 2]; 3]> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 2;
+        Ok(value)
+    }
+
+    fn read_fixed_record_with_vlens_array(&mut self) -> yardl::Result<[[RecordWithVlens; 2]; 2]> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 3;
+        Ok(value)
+    }
+
+    fn read_record_with_fixed_arrays(&mut self) -> yardl::Result<RecordWithFixedArrays> {
+        if self.state != 3 {
+            return Err(self.invalid_state(3));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 4;
+        Ok(value)
+    }
+
+    fn read_named_array(&mut self) -> yardl::Result<NamedFixedNDArray> {
+        if self.state != 4 {
+            return Err(self.invalid_state(4));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 5;
+        Ok(value)
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 5 {
+            return Err(self.invalid_state(5));
+        }
+        Ok(())
+    }
+}
+
+/// Writes the Subarrays protocol in binary format.
+pub struct BinarySubarraysWriter<W: Write> {
+    w: BinaryWriter<W>,
+    state: usize,
+}
+
+impl<W: Write> BinarySubarraysWriter<W> {
+    /// Writes the protocol header to the given writer.
+    pub fn new(w: W) -> yardl::Result<Self> {
+        let mut w = BinaryWriter::new(w);
+        w.write_header(SUBARRAYS_SCHEMA)?;
+        Ok(Self { w, state: 0 })
+    }
+
+    fn invalid_state(&self, attempted: usize, end: bool) -> yardl::Error {
+        let expected = match self.state {
+            0 => "write_dynamic_with_fixed_int_subarray()",
+            1 => "write_dynamic_with_fixed_float_subarray()",
+            2 => "write_known_dim_count_with_fixed_int_subarray()",
+            3 => "write_known_dim_count_with_fixed_float_subarray()",
+            4 => "write_fixed_with_fixed_int_subarray()",
+            5 => "write_fixed_with_fixed_float_subarray()",
+            6 => "write_nested_subarray()",
+            7 => "write_dynamic_with_fixed_vector_subarray()",
+            8 => "write_generic_subarray()",
+            _ => "close()",
+        };
+        let received = match attempted {
+            0 => "write_dynamic_with_fixed_int_subarray()",
+            1 => "write_dynamic_with_fixed_float_subarray()",
+            2 => "write_known_dim_count_with_fixed_int_subarray()",
+            3 => "write_known_dim_count_with_fixed_float_subarray()",
+            4 => "write_fixed_with_fixed_int_subarray()",
+            5 => "write_fixed_with_fixed_float_subarray()",
+            6 => "write_nested_subarray()",
+            7 => "write_dynamic_with_fixed_vector_subarray()",
+            8 => "write_generic_subarray()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: expected.to_string(),
+            received: received.to_string(),
+        }
+    }
+}
+
+impl<W: Write> SubarraysWriter for BinarySubarraysWriter<W> {
+    fn write_dynamic_with_fixed_int_subarray(&mut self, value: &yardl::DynamicNDArray<[i32; 3]>) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 1;
+        Ok(())
+    }
+
+    fn write_dynamic_with_fixed_float_subarray(&mut self, value: &yardl::DynamicNDArray<[f32; 3]>) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 2;
+        Ok(())
+    }
+
+    fn write_known_dim_count_with_fixed_int_subarray(&mut self, value: &yardl::NDArray<[i32; 3], 1>) -> yardl::Result<()> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 3;
+        Ok(())
+    }
+
+    fn write_known_dim_count_with_fixed_float_subarray(&mut self, value: &yardl::NDArray<[f32; 3], 1>) -> yardl::Result<()> {
+        if self.state != 3 {
+            return Err(self.invalid_state(3, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 4;
+        Ok(())
+    }
+
+    fn write_fixed_with_fixed_int_subarray(&mut self, value: &[[i32; 3]; 2]) -> yardl::Result<()> {
+        if self.state != 4 {
+            return Err(self.invalid_state(4, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 5;
+        Ok(())
+    }
+
+    fn write_fixed_with_fixed_float_subarray(&mut self, value: &[[f32; 3]; 2]) -> yardl::Result<()> {
+        if self.state != 5 {
+            return Err(self.invalid_state(5, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 6;
+        Ok(())
+    }
+
+    fn write_nested_subarray(&mut self, value: &yardl::DynamicNDArray<[[i32; 3]; 2]>) -> yardl::Result<()> {
+        if self.state != 6 {
+            return Err(self.invalid_state(6, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 7;
+        Ok(())
+    }
+
+    fn write_dynamic_with_fixed_vector_subarray(&mut self, value: &yardl::DynamicNDArray<[i32; 3]>) -> yardl::Result<()> {
+        if self.state != 7 {
+            return Err(self.invalid_state(7, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 8;
+        Ok(())
+    }
+
+    fn write_generic_subarray(&mut self, value: &Image<[i32; 3]>) -> yardl::Result<()> {
+        if self.state != 8 {
+            return Err(self.invalid_state(8, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 9;
+        Ok(())
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 9 {
+            return Err(self.invalid_state(9, false));
+        }
+        self.w.flush()
+    }
+}
+
+/// Reads the Subarrays protocol in binary format.
+pub struct BinarySubarraysReader<R: Read> {
+    r: BinaryReader<R>,
+    state: usize,
+}
+
+impl<R: Read> BinarySubarraysReader<R> {
+    /// Reads the protocol header and returns an error if the stream is not a binary Subarrays stream.
+    pub fn new(r: R) -> yardl::Result<Self> {
+        let mut r = BinaryReader::new(r);
+        r.read_header(Some(SUBARRAYS_SCHEMA))?;
+        Ok(Self {
+            r,
+            state: 0,
+        })
+    }
+
+    fn invalid_state(&self, attempted: usize) -> yardl::Error {
+        let method_name = |state: usize| match state {
+            0 => "read_dynamic_with_fixed_int_subarray()",
+            1 => "read_dynamic_with_fixed_float_subarray()",
+            2 => "read_known_dim_count_with_fixed_int_subarray()",
+            3 => "read_known_dim_count_with_fixed_float_subarray()",
+            4 => "read_fixed_with_fixed_int_subarray()",
+            5 => "read_fixed_with_fixed_float_subarray()",
+            6 => "read_nested_subarray()",
+            7 => "read_dynamic_with_fixed_vector_subarray()",
+            8 => "read_generic_subarray()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: method_name(self.state).to_string(),
+            received: method_name(attempted).to_string(),
+        }
+    }
+}
+
+impl<R: Read> SubarraysReader for BinarySubarraysReader<R> {
+    fn read_dynamic_with_fixed_int_subarray(&mut self) -> yardl::Result<yardl::DynamicNDArray<[i32; 3]>> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 1;
+        Ok(value)
+    }
+
+    fn read_dynamic_with_fixed_float_subarray(&mut self) -> yardl::Result<yardl::DynamicNDArray<[f32; 3]>> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 2;
+        Ok(value)
+    }
+
+    fn read_known_dim_count_with_fixed_int_subarray(&mut self) -> yardl::Result<yardl::NDArray<[i32; 3], 1>> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 3;
+        Ok(value)
+    }
+
+    fn read_known_dim_count_with_fixed_float_subarray(&mut self) -> yardl::Result<yardl::NDArray<[f32; 3], 1>> {
+        if self.state != 3 {
+            return Err(self.invalid_state(3));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 4;
+        Ok(value)
+    }
+
+    fn read_fixed_with_fixed_int_subarray(&mut self) -> yardl::Result<[[i32; 3]; 2]> {
+        if self.state != 4 {
+            return Err(self.invalid_state(4));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 5;
+        Ok(value)
+    }
+
+    fn read_fixed_with_fixed_float_subarray(&mut self) -> yardl::Result<[[f32; 3]; 2]> {
+        if self.state != 5 {
+            return Err(self.invalid_state(5));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 6;
+        Ok(value)
+    }
+
+    fn read_nested_subarray(&mut self) -> yardl::Result<yardl::DynamicNDArray<[[i32; 3]; 2]>> {
+        if self.state != 6 {
+            return Err(self.invalid_state(6));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 7;
+        Ok(value)
+    }
+
+    fn read_dynamic_with_fixed_vector_subarray(&mut self) -> yardl::Result<yardl::DynamicNDArray<[i32; 3]>> {
+        if self.state != 7 {
+            return Err(self.invalid_state(7));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 8;
+        Ok(value)
+    }
+
+    fn read_generic_subarray(&mut self) -> yardl::Result<Image<[i32; 3]>> {
+        if self.state != 8 {
+            return Err(self.invalid_state(8));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 9;
+        Ok(value)
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 9 {
+            return Err(self.invalid_state(9));
+        }
+        Ok(())
+    }
+}
+
+/// Writes the SubarraysInRecords protocol in binary format.
+pub struct BinarySubarraysInRecordsWriter<W: Write> {
+    w: BinaryWriter<W>,
+    state: usize,
+}
+
+impl<W: Write> BinarySubarraysInRecordsWriter<W> {
+    /// Writes the protocol header to the given writer.
+    pub fn new(w: W) -> yardl::Result<Self> {
+        let mut w = BinaryWriter::new(w);
+        w.write_header(SUBARRAYS_IN_RECORDS_SCHEMA)?;
+        Ok(Self { w, state: 0 })
+    }
+
+    fn invalid_state(&self, attempted: usize, end: bool) -> yardl::Error {
+        let expected = match self.state {
+            0 => "write_with_fixed_subarrays()",
+            1 => "write_with_vlen_subarrays()",
+            _ => "close()",
+        };
+        let received = match attempted {
+            0 => "write_with_fixed_subarrays()",
+            1 => "write_with_vlen_subarrays()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: expected.to_string(),
+            received: received.to_string(),
+        }
+    }
+}
+
+impl<W: Write> SubarraysInRecordsWriter for BinarySubarraysInRecordsWriter<W> {
+    fn write_with_fixed_subarrays(&mut self, value: &yardl::DynamicNDArray<RecordWithFixedCollections>) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 1;
+        Ok(())
+    }
+
+    fn write_with_vlen_subarrays(&mut self, value: &yardl::DynamicNDArray<RecordWithVlenCollections>) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 2;
+        Ok(())
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2, false));
+        }
+        self.w.flush()
+    }
+}
+
+/// Reads the SubarraysInRecords protocol in binary format.
+pub struct BinarySubarraysInRecordsReader<R: Read> {
+    r: BinaryReader<R>,
+    state: usize,
+}
+
+impl<R: Read> BinarySubarraysInRecordsReader<R> {
+    /// Reads the protocol header and returns an error if the stream is not a binary SubarraysInRecords stream.
+    pub fn new(r: R) -> yardl::Result<Self> {
+        let mut r = BinaryReader::new(r);
+        r.read_header(Some(SUBARRAYS_IN_RECORDS_SCHEMA))?;
+        Ok(Self {
+            r,
+            state: 0,
+        })
+    }
+
+    fn invalid_state(&self, attempted: usize) -> yardl::Error {
+        let method_name = |state: usize| match state {
+            0 => "read_with_fixed_subarrays()",
+            1 => "read_with_vlen_subarrays()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: method_name(self.state).to_string(),
+            received: method_name(attempted).to_string(),
+        }
+    }
+}
+
+impl<R: Read> SubarraysInRecordsReader for BinarySubarraysInRecordsReader<R> {
+    fn read_with_fixed_subarrays(&mut self) -> yardl::Result<yardl::DynamicNDArray<RecordWithFixedCollections>> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 1;
+        Ok(value)
+    }
+
+    fn read_with_vlen_subarrays(&mut self) -> yardl::Result<yardl::DynamicNDArray<RecordWithVlenCollections>> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 2;
+        Ok(value)
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2));
+        }
+        Ok(())
+    }
+}
+
+/// Writes the NDArrays protocol in binary format.
+pub struct BinaryNDArraysWriter<W: Write> {
+    w: BinaryWriter<W>,
+    state: usize,
+}
+
+impl<W: Write> BinaryNDArraysWriter<W> {
+    /// Writes the protocol header to the given writer.
+    pub fn new(w: W) -> yardl::Result<Self> {
+        let mut w = BinaryWriter::new(w);
+        w.write_header(ND_ARRAYS_SCHEMA)?;
+        Ok(Self { w, state: 0 })
+    }
+
+    fn invalid_state(&self, attempted: usize, end: bool) -> yardl::Error {
+        let expected = match self.state {
+            0 => "write_ints()",
+            1 => "write_simple_record_array()",
+            2 => "write_record_with_vlens_array()",
+            3 => "write_record_with_nd_arrays()",
+            4 => "write_named_array()",
+            _ => "close()",
+        };
+        let received = match attempted {
+            0 => "write_ints()",
+            1 => "write_simple_record_array()",
+            2 => "write_record_with_vlens_array()",
+            3 => "write_record_with_nd_arrays()",
+            4 => "write_named_array()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: expected.to_string(),
+            received: received.to_string(),
+        }
+    }
+}
+
+impl<W: Write> NDArraysWriter for BinaryNDArraysWriter<W> {
+    fn write_ints(&mut self, value: &yardl::NDArray<i32, 2>) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 1;
+        Ok(())
+    }
+
+    fn write_simple_record_array(&mut self, value: &yardl::NDArray<SimpleRecord, 2>) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 2;
+        Ok(())
+    }
+
+    fn write_record_with_vlens_array(&mut self, value: &yardl::NDArray<RecordWithVlens, 2>) -> yardl::Result<()> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 3;
+        Ok(())
+    }
+
+    fn write_record_with_nd_arrays(&mut self, value: &RecordWithNDArrays) -> yardl::Result<()> {
+        if self.state != 3 {
+            return Err(self.invalid_state(3, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 4;
+        Ok(())
+    }
+
+    fn write_named_array(&mut self, value: &NamedNDArray) -> yardl::Result<()> {
+        if self.state != 4 {
+            return Err(self.invalid_state(4, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 5;
+        Ok(())
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 5 {
+            return Err(self.invalid_state(5, false));
+        }
+        self.w.flush()
+    }
+}
+
+/// Reads the NDArrays protocol in binary format.
+pub struct BinaryNDArraysReader<R: Read> {
+    r: BinaryReader<R>,
+    state: usize,
+}
+
+impl<R: Read> BinaryNDArraysReader<R> {
+    /// Reads the protocol header and returns an error if the stream is not a binary NDArrays stream.
+    pub fn new(r: R) -> yardl::Result<Self> {
+        let mut r = BinaryReader::new(r);
+        r.read_header(Some(ND_ARRAYS_SCHEMA))?;
+        Ok(Self {
+            r,
+            state: 0,
+        })
+    }
+
+    fn invalid_state(&self, attempted: usize) -> yardl::Error {
+        let method_name = |state: usize| match state {
+            0 => "read_ints()",
+            1 => "read_simple_record_array()",
+            2 => "read_record_with_vlens_array()",
+            3 => "read_record_with_nd_arrays()",
+            4 => "read_named_array()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: method_name(self.state).to_string(),
+            received: method_name(attempted).to_string(),
+        }
+    }
+}
+
+impl<R: Read> NDArraysReader for BinaryNDArraysReader<R> {
+    fn read_ints(&mut self) -> yardl::Result<yardl::NDArray<i32, 2>> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 1;
+        Ok(value)
+    }
+
+    fn read_simple_record_array(&mut self) -> yardl::Result<yardl::NDArray<SimpleRecord, 2>> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 2;
+        Ok(value)
+    }
+
+    fn read_record_with_vlens_array(&mut self) -> yardl::Result<yardl::NDArray<RecordWithVlens, 2>> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 3;
+        Ok(value)
+    }
+
+    fn read_record_with_nd_arrays(&mut self) -> yardl::Result<RecordWithNDArrays> {
+        if self.state != 3 {
+            return Err(self.invalid_state(3));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 4;
+        Ok(value)
+    }
+
+    fn read_named_array(&mut self) -> yardl::Result<NamedNDArray> {
+        if self.state != 4 {
+            return Err(self.invalid_state(4));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 5;
+        Ok(value)
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 5 {
+            return Err(self.invalid_state(5));
+        }
+        Ok(())
+    }
+}
+
+/// Writes the NDArraysSingleDimension protocol in binary format.
+pub struct BinaryNDArraysSingleDimensionWriter<W: Write> {
+    w: BinaryWriter<W>,
+    state: usize,
+}
+
+impl<W: Write> BinaryNDArraysSingleDimensionWriter<W> {
+    /// Writes the protocol header to the given writer.
+    pub fn new(w: W) -> yardl::Result<Self> {
+        let mut w = BinaryWriter::new(w);
+        w.write_header(ND_ARRAYS_SINGLE_DIMENSION_SCHEMA)?;
+        Ok(Self { w, state: 0 })
+    }
+
+    fn invalid_state(&self, attempted: usize, end: bool) -> yardl::Error {
+        let expected = match self.state {
+            0 => "write_ints()",
+            1 => "write_simple_record_array()",
+            2 => "write_record_with_vlens_array()",
+            3 => "write_record_with_nd_arrays()",
+            _ => "close()",
+        };
+        let received = match attempted {
+            0 => "write_ints()",
+            1 => "write_simple_record_array()",
+            2 => "write_record_with_vlens_array()",
+            3 => "write_record_with_nd_arrays()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: expected.to_string(),
+            received: received.to_string(),
+        }
+    }
+}
+
+impl<W: Write> NDArraysSingleDimensionWriter for BinaryNDArraysSingleDimensionWriter<W> {
+    fn write_ints(&mut self, value: &yardl::NDArray<i32, 1>) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 1;
+        Ok(())
+    }
+
+    fn write_simple_record_array(&mut self, value: &yardl::NDArray<SimpleRecord, 1>) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 2;
+        Ok(())
+    }
+
+    fn write_record_with_vlens_array(&mut self, value: &yardl::NDArray<RecordWithVlens, 1>) -> yardl::Result<()> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 3;
+        Ok(())
+    }
+
+    fn write_record_with_nd_arrays(&mut self, value: &RecordWithNDArraysSingleDimension) -> yardl::Result<()> {
+        if self.state != 3 {
+            return Err(self.invalid_state(3, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 4;
+        Ok(())
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 4 {
+            return Err(self.invalid_state(4, false));
+        }
+        self.w.flush()
+    }
+}
+
+/// Reads the NDArraysSingleDimension protocol in binary format.
+pub struct BinaryNDArraysSingleDimensionReader<R: Read> {
+    r: BinaryReader<R>,
+    state: usize,
+}
+
+impl<R: Read> BinaryNDArraysSingleDimensionReader<R> {
+    /// Reads the protocol header and returns an error if the stream is not a binary NDArraysSingleDimension stream.
+    pub fn new(r: R) -> yardl::Result<Self> {
+        let mut r = BinaryReader::new(r);
+        r.read_header(Some(ND_ARRAYS_SINGLE_DIMENSION_SCHEMA))?;
+        Ok(Self {
+            r,
+            state: 0,
+        })
+    }
+
+    fn invalid_state(&self, attempted: usize) -> yardl::Error {
+        let method_name = |state: usize| match state {
+            0 => "read_ints()",
+            1 => "read_simple_record_array()",
+            2 => "read_record_with_vlens_array()",
+            3 => "read_record_with_nd_arrays()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: method_name(self.state).to_string(),
+            received: method_name(attempted).to_string(),
+        }
+    }
+}
+
+impl<R: Read> NDArraysSingleDimensionReader for BinaryNDArraysSingleDimensionReader<R> {
+    fn read_ints(&mut self) -> yardl::Result<yardl::NDArray<i32, 1>> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 1;
+        Ok(value)
+    }
+
+    fn read_simple_record_array(&mut self) -> yardl::Result<yardl::NDArray<SimpleRecord, 1>> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 2;
+        Ok(value)
+    }
+
+    fn read_record_with_vlens_array(&mut self) -> yardl::Result<yardl::NDArray<RecordWithVlens, 1>> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 3;
+        Ok(value)
+    }
+
+    fn read_record_with_nd_arrays(&mut self) -> yardl::Result<RecordWithNDArraysSingleDimension> {
+        if self.state != 3 {
+            return Err(self.invalid_state(3));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 4;
+        Ok(value)
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 4 {
+            return Err(self.invalid_state(4));
+        }
+        Ok(())
+    }
+}
+
+/// Writes the DynamicNDArrays protocol in binary format.
+pub struct BinaryDynamicNDArraysWriter<W: Write> {
+    w: BinaryWriter<W>,
+    state: usize,
+}
+
+impl<W: Write> BinaryDynamicNDArraysWriter<W> {
+    /// Writes the protocol header to the given writer.
+    pub fn new(w: W) -> yardl::Result<Self> {
+        let mut w = BinaryWriter::new(w);
+        w.write_header(DYNAMIC_ND_ARRAYS_SCHEMA)?;
+        Ok(Self { w, state: 0 })
+    }
+
+    fn invalid_state(&self, attempted: usize, end: bool) -> yardl::Error {
+        let expected = match self.state {
+            0 => "write_ints()",
+            1 => "write_simple_record_array()",
+            2 => "write_record_with_vlens_array()",
+            3 => "write_record_with_dynamic_nd_arrays()",
+            _ => "close()",
+        };
+        let received = match attempted {
+            0 => "write_ints()",
+            1 => "write_simple_record_array()",
+            2 => "write_record_with_vlens_array()",
+            3 => "write_record_with_dynamic_nd_arrays()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: expected.to_string(),
+            received: received.to_string(),
+        }
+    }
+}
+
+impl<W: Write> DynamicNDArraysWriter for BinaryDynamicNDArraysWriter<W> {
+    fn write_ints(&mut self, value: &yardl::DynamicNDArray<i32>) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 1;
+        Ok(())
+    }
+
+    fn write_simple_record_array(&mut self, value: &yardl::DynamicNDArray<SimpleRecord>) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 2;
+        Ok(())
+    }
+
+    fn write_record_with_vlens_array(&mut self, value: &yardl::DynamicNDArray<RecordWithVlens>) -> yardl::Result<()> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 3;
+        Ok(())
+    }
+
+    fn write_record_with_dynamic_nd_arrays(&mut self, value: &RecordWithDynamicNDArrays) -> yardl::Result<()> {
+        if self.state != 3 {
+            return Err(self.invalid_state(3, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 4;
+        Ok(())
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 4 {
+            return Err(self.invalid_state(4, false));
+        }
+        self.w.flush()
+    }
+}
+
+/// Reads the DynamicNDArrays protocol in binary format.
+pub struct BinaryDynamicNDArraysReader<R: Read> {
+    r: BinaryReader<R>,
+    state: usize,
+}
+
+impl<R: Read> BinaryDynamicNDArraysReader<R> {
+    /// Reads the protocol header and returns an error if the stream is not a binary DynamicNDArrays stream.
+    pub fn new(r: R) -> yardl::Result<Self> {
+        let mut r = BinaryReader::new(r);
+        r.read_header(Some(DYNAMIC_ND_ARRAYS_SCHEMA))?;
+        Ok(Self {
+            r,
+            state: 0,
+        })
+    }
+
+    fn invalid_state(&self, attempted: usize) -> yardl::Error {
+        let method_name = |state: usize| match state {
+            0 => "read_ints()",
+            1 => "read_simple_record_array()",
+            2 => "read_record_with_vlens_array()",
+            3 => "read_record_with_dynamic_nd_arrays()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: method_name(self.state).to_string(),
+            received: method_name(attempted).to_string(),
+        }
+    }
+}
+
+impl<R: Read> DynamicNDArraysReader for BinaryDynamicNDArraysReader<R> {
+    fn read_ints(&mut self) -> yardl::Result<yardl::DynamicNDArray<i32>> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 1;
+        Ok(value)
+    }
+
+    fn read_simple_record_array(&mut self) -> yardl::Result<yardl::DynamicNDArray<SimpleRecord>> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 2;
+        Ok(value)
+    }
+
+    fn read_record_with_vlens_array(&mut self) -> yardl::Result<yardl::DynamicNDArray<RecordWithVlens>> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 3;
+        Ok(value)
+    }
+
+    fn read_record_with_dynamic_nd_arrays(&mut self) -> yardl::Result<RecordWithDynamicNDArrays> {
+        if self.state != 3 {
+            return Err(self.invalid_state(3));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 4;
+        Ok(value)
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 4 {
+            return Err(self.invalid_state(4));
+        }
+        Ok(())
+    }
+}
+
+/// Writes the MultiDArrays protocol in binary format.
+pub struct BinaryMultiDArraysWriter<W: Write> {
+    w: BinaryWriter<W>,
+    state: usize,
+}
+
+impl<W: Write> BinaryMultiDArraysWriter<W> {
+    /// Writes the protocol header to the given writer.
+    pub fn new(w: W) -> yardl::Result<Self> {
+        let mut w = BinaryWriter::new(w);
+        w.write_header(MULTI_D_ARRAYS_SCHEMA)?;
+        Ok(Self { w, state: 0 })
+    }
+
+    fn invalid_state(&self, attempted: usize, end: bool) -> yardl::Error {
+        let expected = match self.state {
+            0 => "write_images() or end_images()",
+            1 => "write_frames() or end_frames()",
+            _ => "close()",
+        };
+        let received = match attempted {
+            0 if end => "end_images()",
+            0 => "write_images()",
+            1 if end => "end_frames()",
+            1 => "write_frames()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: expected.to_string(),
+            received: received.to_string(),
+        }
+    }
+}
+
+impl<W: Write> MultiDArraysWriter for BinaryMultiDArraysWriter<W> {
+    fn write_images(&mut self, values: &[yardl::NDArray<f32, 4>]) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, false));
+        }
+        self.w.write_block(values)
+    }
+
+    fn end_images(&mut self) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, true));
+        }
+        self.w.write_end_of_stream()?;
+        self.state = 1;
+        Ok(())
+    }
+
+    fn write_frames(&mut self, values: &[[[[[f32; 32]; 64]; 1]; 1]]) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, false));
+        }
+        self.w.write_block(values)
+    }
+
+    fn end_frames(&mut self) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, true));
+        }
+        self.w.write_end_of_stream()?;
+        self.state = 2;
+        Ok(())
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2, false));
+        }
+        self.w.flush()
+    }
+}
+
+/// Reads the MultiDArrays protocol in binary format.
+pub struct BinaryMultiDArraysReader<R: Read> {
+    r: BinaryReader<R>,
+    state: usize,
+    images_remaining: u64,
+    frames_remaining: u64,
+}
+
+impl<R: Read> BinaryMultiDArraysReader<R> {
+    /// Reads the protocol header and returns an error if the stream is not a binary MultiDArrays stream.
+    pub fn new(r: R) -> yardl::Result<Self> {
+        let mut r = BinaryReader::new(r);
+        r.read_header(Some(MULTI_D_ARRAYS_SCHEMA))?;
+        Ok(Self {
+            r,
+            state: 0,
+            images_remaining: 0,
+            frames_remaining: 0,
+        })
+    }
+
+    fn invalid_state(&self, attempted: usize) -> yardl::Error {
+        let method_name = |state: usize| match state {
+            0 => "read_images()",
+            1 => "read_frames()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: method_name(self.state).to_string(),
+            received: method_name(attempted).to_string(),
+        }
+    }
+}
+
+impl<R: Read> MultiDArraysReader for BinaryMultiDArraysReader<R> {
+    fn read_images(&mut self) -> yardl::Result<Option<yardl::NDArray<f32, 4>>> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0));
+        }
+        let value = self.r.read_stream_item(&mut self.images_remaining)?;
+        if value.is_none() {
+            self.state = 1;
+        }
+        Ok(value)
+    }
+
+    fn read_frames(&mut self) -> yardl::Result<Option<[[[[f32; 32]; 64]; 1]; 1]>> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1));
+        }
+        let value = self.r.read_stream_item(&mut self.frames_remaining)?;
+        if value.is_none() {
+            self.state = 2;
+        }
+        Ok(value)
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2));
+        }
+        Ok(())
+    }
+}
+
+/// Writes the ComplexArrays protocol in binary format.
+pub struct BinaryComplexArraysWriter<W: Write> {
+    w: BinaryWriter<W>,
+    state: usize,
+}
+
+impl<W: Write> BinaryComplexArraysWriter<W> {
+    /// Writes the protocol header to the given writer.
+    pub fn new(w: W) -> yardl::Result<Self> {
+        let mut w = BinaryWriter::new(w);
+        w.write_header(COMPLEX_ARRAYS_SCHEMA)?;
+        Ok(Self { w, state: 0 })
+    }
+
+    fn invalid_state(&self, attempted: usize, end: bool) -> yardl::Error {
+        let expected = match self.state {
+            0 => "write_floats()",
+            1 => "write_doubles()",
+            _ => "close()",
+        };
+        let received = match attempted {
+            0 => "write_floats()",
+            1 => "write_doubles()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: expected.to_string(),
+            received: received.to_string(),
+        }
+    }
+}
+
+impl<W: Write> ComplexArraysWriter for BinaryComplexArraysWriter<W> {
+    fn write_floats(&mut self, value: &yardl::DynamicNDArray<yardl::Complex<f32>>) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 1;
+        Ok(())
+    }
+
+    fn write_doubles(&mut self, value: &yardl::NDArray<yardl::Complex<f64>, 2>) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 2;
+        Ok(())
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2, false));
+        }
+        self.w.flush()
+    }
+}
+
+/// Reads the ComplexArrays protocol in binary format.
+pub struct BinaryComplexArraysReader<R: Read> {
+    r: BinaryReader<R>,
+    state: usize,
+}
+
+impl<R: Read> BinaryComplexArraysReader<R> {
+    /// Reads the protocol header and returns an error if the stream is not a binary ComplexArrays stream.
+    pub fn new(r: R) -> yardl::Result<Self> {
+        let mut r = BinaryReader::new(r);
+        r.read_header(Some(COMPLEX_ARRAYS_SCHEMA))?;
+        Ok(Self {
+            r,
+            state: 0,
+        })
+    }
+
+    fn invalid_state(&self, attempted: usize) -> yardl::Error {
+        let method_name = |state: usize| match state {
+            0 => "read_floats()",
+            1 => "read_doubles()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: method_name(self.state).to_string(),
+            received: method_name(attempted).to_string(),
+        }
+    }
+}
+
+impl<R: Read> ComplexArraysReader for BinaryComplexArraysReader<R> {
+    fn read_floats(&mut self) -> yardl::Result<yardl::DynamicNDArray<yardl::Complex<f32>>> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 1;
+        Ok(value)
+    }
+
+    fn read_doubles(&mut self) -> yardl::Result<yardl::NDArray<yardl::Complex<f64>, 2>> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 2;
+        Ok(value)
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2));
+        }
+        Ok(())
+    }
+}
+
+/// Writes the Maps protocol in binary format.
+pub struct BinaryMapsWriter<W: Write> {
+    w: BinaryWriter<W>,
+    state: usize,
+}
+
+impl<W: Write> BinaryMapsWriter<W> {
+    /// Writes the protocol header to the given writer.
+    pub fn new(w: W) -> yardl::Result<Self> {
+        let mut w = BinaryWriter::new(w);
+        w.write_header(MAPS_SCHEMA)?;
+        Ok(Self { w, state: 0 })
+    }
+
+    fn invalid_state(&self, attempted: usize, end: bool) -> yardl::Error {
+        let expected = match self.state {
+            0 => "write_string_to_int()",
+            1 => "write_int_to_string()",
+            2 => "write_string_to_union()",
+            3 => "write_aliased_generic()",
+            4 => "write_records()",
+            _ => "close()",
+        };
+        let received = match attempted {
+            0 => "write_string_to_int()",
+            1 => "write_int_to_string()",
+            2 => "write_string_to_union()",
+            3 => "write_aliased_generic()",
+            4 => "write_records()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: expected.to_string(),
+            received: received.to_string(),
+        }
+    }
+}
+
+impl<W: Write> MapsWriter for BinaryMapsWriter<W> {
+    fn write_string_to_int(&mut self, value: &HashMap<String, i32>) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 1;
+        Ok(())
+    }
+
+    fn write_int_to_string(&mut self, value: &HashMap<i32, String>) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 2;
+        Ok(())
+    }
+
+    fn write_string_to_union(&mut self, value: &HashMap<String, StringOrInt32>) -> yardl::Result<()> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 3;
+        Ok(())
+    }
+
+    fn write_aliased_generic(&mut self, value: &super::basic_types::AliasedMap<String, i32>) -> yardl::Result<()> {
+        if self.state != 3 {
+            return Err(self.invalid_state(3, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 4;
+        Ok(())
+    }
+
+    fn write_records(&mut self, value: &Vec<RecordWithMaps>) -> yardl::Result<()> {
+        if self.state != 4 {
+            return Err(self.invalid_state(4, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 5;
+        Ok(())
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 5 {
+            return Err(self.invalid_state(5, false));
+        }
+        self.w.flush()
+    }
+}
+
+/// Reads the Maps protocol in binary format.
+pub struct BinaryMapsReader<R: Read> {
+    r: BinaryReader<R>,
+    state: usize,
+}
+
+impl<R: Read> BinaryMapsReader<R> {
+    /// Reads the protocol header and returns an error if the stream is not a binary Maps stream.
+    pub fn new(r: R) -> yardl::Result<Self> {
+        let mut r = BinaryReader::new(r);
+        r.read_header(Some(MAPS_SCHEMA))?;
+        Ok(Self {
+            r,
+            state: 0,
+        })
+    }
+
+    fn invalid_state(&self, attempted: usize) -> yardl::Error {
+        let method_name = |state: usize| match state {
+            0 => "read_string_to_int()",
+            1 => "read_int_to_string()",
+            2 => "read_string_to_union()",
+            3 => "read_aliased_generic()",
+            4 => "read_records()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: method_name(self.state).to_string(),
+            received: method_name(attempted).to_string(),
+        }
+    }
+}
+
+impl<R: Read> MapsReader for BinaryMapsReader<R> {
+    fn read_string_to_int(&mut self) -> yardl::Result<HashMap<String, i32>> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 1;
+        Ok(value)
+    }
+
+    fn read_int_to_string(&mut self) -> yardl::Result<HashMap<i32, String>> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 2;
+        Ok(value)
+    }
+
+    fn read_string_to_union(&mut self) -> yardl::Result<HashMap<String, StringOrInt32>> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 3;
+        Ok(value)
+    }
+
+    fn read_aliased_generic(&mut self) -> yardl::Result<super::basic_types::AliasedMap<String, i32>> {
+        if self.state != 3 {
+            return Err(self.invalid_state(3));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 4;
+        Ok(value)
+    }
+
+    fn read_records(&mut self) -> yardl::Result<Vec<RecordWithMaps>> {
+        if self.state != 4 {
+            return Err(self.invalid_state(4));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 5;
+        Ok(value)
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 5 {
+            return Err(self.invalid_state(5));
+        }
+        Ok(())
+    }
+}
+
+impl BinaryWrite for Int32OrSimpleRecord {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        match self {
+            Int32OrSimpleRecord::Int32(value) => {
+                w.write_uvarint(0)?;
+                BinaryWrite::write(value, w)
+            }
+            Int32OrSimpleRecord::SimpleRecord(value) => {
+                w.write_uvarint(1)?;
+                BinaryWrite::write(value, w)
+            }
+        }
+    }
+
+    fn write_some<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        match self {
+            Int32OrSimpleRecord::Int32(value) => {
+                w.write_uvarint(1)?;
+                BinaryWrite::write(value, w)
+            }
+            Int32OrSimpleRecord::SimpleRecord(value) => {
+                w.write_uvarint(2)?;
+                BinaryWrite::write(value, w)
+            }
+        }
+    }
+}
+
+impl BinaryRead for Int32OrSimpleRecord {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        match r.read_uvarint()? {
+            0 => Ok(Int32OrSimpleRecord::Int32(BinaryRead::read(r)?)),
+            1 => Ok(Int32OrSimpleRecord::SimpleRecord(BinaryRead::read(r)?)),
+            index => Err(yardl::Error::InvalidData(format!("unexpected Int32OrSimpleRecord index {}", index))),
+        }
+    }
+
+    fn read_optional<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Option<Self>> {
+        match r.read_uvarint()? {
+            0 => Ok(None),
+            1 => Ok(Some(Int32OrSimpleRecord::Int32(BinaryRead::read(r)?))),
+            2 => Ok(Some(Int32OrSimpleRecord::SimpleRecord(BinaryRead::read(r)?))),
+            index => Err(yardl::Error::InvalidData(format!("unexpected Int32OrSimpleRecord index {}", index))),
+        }
+    }
+}
+
+impl BinaryWrite for Int32OrRecordWithVlens {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        match self {
+            Int32OrRecordWithVlens::Int32(value) => {
+                w.write_uvarint(0)?;
+                BinaryWrite::write(value, w)
+            }
+            Int32OrRecordWithVlens::RecordWithVlens(value) => {
+                w.write_uvarint(1)?;
+                BinaryWrite::write(value, w)
+            }
+        }
+    }
+
+    fn write_some<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        match self {
+            Int32OrRecordWithVlens::Int32(value) => {
+                w.write_uvarint(1)?;
+                BinaryWrite::write(value, w)
+            }
+            Int32OrRecordWithVlens::RecordWithVlens(value) => {
+                w.write_uvarint(2)?;
+                BinaryWrite::write(value, w)
+            }
+        }
+    }
+}
+
+impl BinaryRead for Int32OrRecordWithVlens {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        match r.read_uvarint()? {
+            0 => Ok(Int32OrRecordWithVlens::Int32(BinaryRead::read(r)?)),
+            1 => Ok(Int32OrRecordWithVlens::RecordWithVlens(BinaryRead::read(r)?)),
+            index => Err(yardl::Error::InvalidData(format!("unexpected Int32OrRecordWithVlens index {}", index))),
+        }
+    }
+
+    fn read_optional<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Option<Self>> {
+        match r.read_uvarint()? {
+            0 => Ok(None),
+            1 => Ok(Some(Int32OrRecordWithVlens::Int32(BinaryRead::read(r)?))),
+            2 => Ok(Some(Int32OrRecordWithVlens::RecordWithVlens(BinaryRead::read(r)?))),
+            index => Err(yardl::Error::InvalidData(format!("unexpected Int32OrRecordWithVlens index {}", index))),
+        }
+    }
+}
+
+/// Writes the Unions protocol in binary format.
+pub struct BinaryUnionsWriter<W: Write> {
+    w: BinaryWriter<W>,
+    state: usize,
+}
+
+impl<W: Write> BinaryUnionsWriter<W> {
+    /// Writes the protocol header to the given writer.
+    pub fn new(w: W) -> yardl::Result<Self> {
+        let mut w = BinaryWriter::new(w);
+        w.write_header(UNIONS_SCHEMA)?;
+        Ok(Self { w, state: 0 })
+    }
+
+    fn invalid_state(&self, attempted: usize, end: bool) -> yardl::Error {
+        let expected = match self.state {
+            0 => "write_int_or_simple_record()",
+            1 => "write_int_or_record_with_vlens()",
+            2 => "write_monosotate_or_int_or_simple_record()",
+            3 => "write_vector_of_unions()",
+            4 => "write_record_with_unions()",
+            _ => "close()",
+        };
+        let received = match attempted {
+            0 => "write_int_or_simple_record()",
+            1 => "write_int_or_record_with_vlens()",
+            2 => "write_monosotate_or_int_or_simple_record()",
+            3 => "write_vector_of_unions()",
+            4 => "write_record_with_unions()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: expected.to_string(),
+            received: received.to_string(),
+        }
+    }
+}
+
+impl<W: Write> UnionsWriter for BinaryUnionsWriter<W> {
+    fn write_int_or_simple_record(&mut self, value: &Int32OrSimpleRecord) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 1;
+        Ok(())
+    }
+
+    fn write_int_or_record_with_vlens(&mut self, value: &Int32OrRecordWithVlens) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 2;
+        Ok(())
+    }
+
+    fn write_monosotate_or_int_or_simple_record(&mut self, value: &Option<Int32OrSimpleRecord>) -> yardl::Result<()> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 3;
+        Ok(())
+    }
+
+    fn write_vector_of_unions(&mut self, value: &Vec<StringOrInt32>) -> yardl::Result<()> {
+        if self.state != 3 {
+            return Err(self.invalid_state(3, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 4;
+        Ok(())
+    }
+
+    fn write_record_with_unions(&mut self, value: &super::basic_types::RecordWithUnions) -> yardl::Result<()> {
+        if self.state != 4 {
+            return Err(self.invalid_state(4, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 5;
+        Ok(())
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 5 {
+            return Err(self.invalid_state(5, false));
+        }
+        self.w.flush()
+    }
+}
+
+/// Reads the Unions protocol in binary format.
+pub struct BinaryUnionsReader<R: Read> {
+    r: BinaryReader<R>,
+    state: usize,
+}
+
+impl<R: Read> BinaryUnionsReader<R> {
+    /// Reads the protocol header and returns an error if the stream is not a binary Unions stream.
+    pub fn new(r: R) -> yardl::Result<Self> {
+        let mut r = BinaryReader::new(r);
+        r.read_header(Some(UNIONS_SCHEMA))?;
+        Ok(Self {
+            r,
+            state: 0,
+        })
+    }
+
+    fn invalid_state(&self, attempted: usize) -> yardl::Error {
+        let method_name = |state: usize| match state {
+            0 => "read_int_or_simple_record()",
+            1 => "read_int_or_record_with_vlens()",
+            2 => "read_monosotate_or_int_or_simple_record()",
+            3 => "read_vector_of_unions()",
+            4 => "read_record_with_unions()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: method_name(self.state).to_string(),
+            received: method_name(attempted).to_string(),
+        }
+    }
+}
+
+impl<R: Read> UnionsReader for BinaryUnionsReader<R> {
+    fn read_int_or_simple_record(&mut self) -> yardl::Result<Int32OrSimpleRecord> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 1;
+        Ok(value)
+    }
+
+    fn read_int_or_record_with_vlens(&mut self) -> yardl::Result<Int32OrRecordWithVlens> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 2;
+        Ok(value)
+    }
+
+    fn read_monosotate_or_int_or_simple_record(&mut self) -> yardl::Result<Option<Int32OrSimpleRecord>> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 3;
+        Ok(value)
+    }
+
+    fn read_vector_of_unions(&mut self) -> yardl::Result<Vec<StringOrInt32>> {
+        if self.state != 3 {
+            return Err(self.invalid_state(3));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 4;
+        Ok(value)
+    }
+
+    fn read_record_with_unions(&mut self) -> yardl::Result<super::basic_types::RecordWithUnions> {
+        if self.state != 4 {
+            return Err(self.invalid_state(4));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 5;
+        Ok(value)
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 5 {
+            return Err(self.invalid_state(5));
+        }
+        Ok(())
+    }
+}
+
+impl BinaryWrite for Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        match self {
+            Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray::Int32(value) => {
+                w.write_uvarint(0)?;
+                BinaryWrite::write(value, w)
+            }
+            Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray::Float32(value) => {
+                w.write_uvarint(1)?;
+                BinaryWrite::write(value, w)
+            }
+            Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray::String(value) => {
+                w.write_uvarint(2)?;
+                BinaryWrite::write(value, w)
+            }
+            Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray::SimpleRecord(value) => {
+                w.write_uvarint(3)?;
+                BinaryWrite::write(value, w)
+            }
+            Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray::NamedFixedNDArray(value) => {
+                w.write_uvarint(4)?;
+                BinaryWrite::write(value, w)
+            }
+        }
+    }
+
+    fn write_some<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        match self {
+            Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray::Int32(value) => {
+                w.write_uvarint(1)?;
+                BinaryWrite::write(value, w)
+            }
+            Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray::Float32(value) => {
+                w.write_uvarint(2)?;
+                BinaryWrite::write(value, w)
+            }
+            Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray::String(value) => {
+                w.write_uvarint(3)?;
+                BinaryWrite::write(value, w)
+            }
+            Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray::SimpleRecord(value) => {
+                w.write_uvarint(4)?;
+                BinaryWrite::write(value, w)
+            }
+            Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray::NamedFixedNDArray(value) => {
+                w.write_uvarint(5)?;
+                BinaryWrite::write(value, w)
+            }
+        }
+    }
+}
+
+impl BinaryRead for Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        match r.read_uvarint()? {
+            0 => Ok(Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray::Int32(BinaryRead::read(r)?)),
+            1 => Ok(Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray::Float32(BinaryRead::read(r)?)),
+            2 => Ok(Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray::String(BinaryRead::read(r)?)),
+            3 => Ok(Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray::SimpleRecord(BinaryRead::read(r)?)),
+            4 => Ok(Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray::NamedFixedNDArray(BinaryRead::read(r)?)),
+            index => Err(yardl::Error::InvalidData(format!("unexpected Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray index {}", index))),
+        }
+    }
+
+    fn read_optional<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Option<Self>> {
+        match r.read_uvarint()? {
+            0 => Ok(None),
+            1 => Ok(Some(Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray::Int32(BinaryRead::read(r)?))),
+            2 => Ok(Some(Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray::Float32(BinaryRead::read(r)?))),
+            3 => Ok(Some(Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray::String(BinaryRead::read(r)?))),
+            4 => Ok(Some(Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray::SimpleRecord(BinaryRead::read(r)?))),
+            5 => Ok(Some(Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray::NamedFixedNDArray(BinaryRead::read(r)?))),
+            index => Err(yardl::Error::InvalidData(format!("unexpected Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray index {}", index))),
+        }
+    }
+}
+
+/// Writes the StreamsOfUnions protocol in binary format.
+pub struct BinaryStreamsOfUnionsWriter<W: Write> {
+    w: BinaryWriter<W>,
+    state: usize,
+}
+
+impl<W: Write> BinaryStreamsOfUnionsWriter<W> {
+    /// Writes the protocol header to the given writer.
+    pub fn new(w: W) -> yardl::Result<Self> {
+        let mut w = BinaryWriter::new(w);
+        w.write_header(STREAMS_OF_UNIONS_SCHEMA)?;
+        Ok(Self { w, state: 0 })
+    }
+
+    fn invalid_state(&self, attempted: usize, end: bool) -> yardl::Error {
+        let expected = match self.state {
+            0 => "write_int_or_simple_record() or end_int_or_simple_record()",
+            1 => "write_nullable_int_or_simple_record() or end_nullable_int_or_simple_record()",
+            2 => "write_many_cases() or end_many_cases()",
+            _ => "close()",
+        };
+        let received = match attempted {
+            0 if end => "end_int_or_simple_record()",
+            0 => "write_int_or_simple_record()",
+            1 if end => "end_nullable_int_or_simple_record()",
+            1 => "write_nullable_int_or_simple_record()",
+            2 if end => "end_many_cases()",
+            2 => "write_many_cases()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: expected.to_string(),
+            received: received.to_string(),
+        }
+    }
+}
+
+impl<W: Write> StreamsOfUnionsWriter for BinaryStreamsOfUnionsWriter<W> {
+    fn write_int_or_simple_record(&mut self, values: &[Int32OrSimpleRecord]) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, false));
+        }
+        self.w.write_block(values)
+    }
+
+    fn end_int_or_simple_record(&mut self) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, true));
+        }
+        self.w.write_end_of_stream()?;
+        self.state = 1;
+        Ok(())
+    }
+
+    fn write_nullable_int_or_simple_record(&mut self, values: &[Option<Int32OrSimpleRecord>]) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, false));
+        }
+        self.w.write_block(values)
+    }
+
+    fn end_nullable_int_or_simple_record(&mut self) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, true));
+        }
+        self.w.write_end_of_stream()?;
+        self.state = 2;
+        Ok(())
+    }
+
+    fn write_many_cases(&mut self, values: &[Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray]) -> yardl::Result<()> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2, false));
+        }
+        self.w.write_block(values)
+    }
+
+    fn end_many_cases(&mut self) -> yardl::Result<()> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2, true));
+        }
+        self.w.write_end_of_stream()?;
+        self.state = 3;
+        Ok(())
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 3 {
+            return Err(self.invalid_state(3, false));
+        }
+        self.w.flush()
+    }
+}
+
+/// Reads the StreamsOfUnions protocol in binary format.
+pub struct BinaryStreamsOfUnionsReader<R: Read> {
+    r: BinaryReader<R>,
+    state: usize,
+    int_or_simple_record_remaining: u64,
+    nullable_int_or_simple_record_remaining: u64,
+    many_cases_remaining: u64,
+}
+
+impl<R: Read> BinaryStreamsOfUnionsReader<R> {
+    /// Reads the protocol header and returns an error if the stream is not a binary StreamsOfUnions stream.
+    pub fn new(r: R) -> yardl::Result<Self> {
+        let mut r = BinaryReader::new(r);
+        r.read_header(Some(STREAMS_OF_UNIONS_SCHEMA))?;
+        Ok(Self {
+            r,
+            state: 0,
+            int_or_simple_record_remaining: 0,
+            nullable_int_or_simple_record_remaining: 0,
+            many_cases_remaining: 0,
+        })
+    }
+
+    fn invalid_state(&self, attempted: usize) -> yardl::Error {
+        let method_name = |state: usize| match state {
+            0 => "read_int_or_simple_record()",
+            1 => "read_nullable_int_or_simple_record()",
+            2 => "read_many_cases()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: method_name(self.state).to_string(),
+            received: method_name(attempted).to_string(),
+        }
+    }
+}
+
+impl<R: Read> StreamsOfUnionsReader for BinaryStreamsOfUnionsReader<R> {
+    fn read_int_or_simple_record(&mut self) -> yardl::Result<Option<Int32OrSimpleRecord>> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0));
+        }
+        let value = self.r.read_stream_item(&mut self.int_or_simple_record_remaining)?;
+        if value.is_none() {
+            self.state = 1;
+        }
+        Ok(value)
+    }
+
+    fn read_nullable_int_or_simple_record(&mut self) -> yardl::Result<Option<Option<Int32OrSimpleRecord>>> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1));
+        }
+        let value = self.r.read_stream_item(&mut self.nullable_int_or_simple_record_remaining)?;
+        if value.is_none() {
+            self.state = 2;
+        }
+        Ok(value)
+    }
+
+    fn read_many_cases(&mut self) -> yardl::Result<Option<Int32OrFloat32OrStringOrSimpleRecordOrNamedFixedNDArray>> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2));
+        }
+        let value = self.r.read_stream_item(&mut self.many_cases_remaining)?;
+        if value.is_none() {
+            self.state = 3;
+        }
+        Ok(value)
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 3 {
+            return Err(self.invalid_state(3));
+        }
+        Ok(())
+    }
+}
+
+/// Writes the Enums protocol in binary format.
+pub struct BinaryEnumsWriter<W: Write> {
+    w: BinaryWriter<W>,
+    state: usize,
+}
+
+impl<W: Write> BinaryEnumsWriter<W> {
+    /// Writes the protocol header to the given writer.
+    pub fn new(w: W) -> yardl::Result<Self> {
+        let mut w = BinaryWriter::new(w);
+        w.write_header(ENUMS_SCHEMA)?;
+        Ok(Self { w, state: 0 })
+    }
+
+    fn invalid_state(&self, attempted: usize, end: bool) -> yardl::Error {
+        let expected = match self.state {
+            0 => "write_single()",
+            1 => "write_vec()",
+            2 => "write_size()",
+            3 => "write_rec()",
+            _ => "close()",
+        };
+        let received = match attempted {
+            0 => "write_single()",
+            1 => "write_vec()",
+            2 => "write_size()",
+            3 => "write_rec()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: expected.to_string(),
+            received: received.to_string(),
+        }
+    }
+}
+
+impl<W: Write> EnumsWriter for BinaryEnumsWriter<W> {
+    fn write_single(&mut self, value: &Fruits) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 1;
+        Ok(())
+    }
+
+    fn write_vec(&mut self, value: &Vec<Fruits>) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 2;
+        Ok(())
+    }
+
+    fn write_size(&mut self, value: &SizeBasedEnum) -> yardl::Result<()> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 3;
+        Ok(())
+    }
+
+    fn write_rec(&mut self, value: &RecordWithEnums) -> yardl::Result<()> {
+        if self.state != 3 {
+            return Err(self.invalid_state(3, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 4;
+        Ok(())
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 4 {
+            return Err(self.invalid_state(4, false));
+        }
+        self.w.flush()
+    }
+}
+
+/// Reads the Enums protocol in binary format.
+pub struct BinaryEnumsReader<R: Read> {
+    r: BinaryReader<R>,
+    state: usize,
+}
+
+impl<R: Read> BinaryEnumsReader<R> {
+    /// Reads the protocol header and returns an error if the stream is not a binary Enums stream.
+    pub fn new(r: R) -> yardl::Result<Self> {
+        let mut r = BinaryReader::new(r);
+        r.read_header(Some(ENUMS_SCHEMA))?;
+        Ok(Self {
+            r,
+            state: 0,
+        })
+    }
+
+    fn invalid_state(&self, attempted: usize) -> yardl::Error {
+        let method_name = |state: usize| match state {
+            0 => "read_single()",
+            1 => "read_vec()",
+            2 => "read_size()",
+            3 => "read_rec()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: method_name(self.state).to_string(),
+            received: method_name(attempted).to_string(),
+        }
+    }
+}
+
+impl<R: Read> EnumsReader for BinaryEnumsReader<R> {
+    fn read_single(&mut self) -> yardl::Result<Fruits> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 1;
+        Ok(value)
+    }
+
+    fn read_vec(&mut self) -> yardl::Result<Vec<Fruits>> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 2;
+        Ok(value)
+    }
+
+    fn read_size(&mut self) -> yardl::Result<SizeBasedEnum> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 3;
+        Ok(value)
+    }
+
+    fn read_rec(&mut self) -> yardl::Result<RecordWithEnums> {
+        if self.state != 3 {
+            return Err(self.invalid_state(3));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 4;
+        Ok(value)
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 4 {
+            return Err(self.invalid_state(4));
+        }
+        Ok(())
+    }
+}
+
+/// Writes the Flags protocol in binary format.
+pub struct BinaryFlagsWriter<W: Write> {
+    w: BinaryWriter<W>,
+    state: usize,
+}
+
+impl<W: Write> BinaryFlagsWriter<W> {
+    /// Writes the protocol header to the given writer.
+    pub fn new(w: W) -> yardl::Result<Self> {
+        let mut w = BinaryWriter::new(w);
+        w.write_header(FLAGS_SCHEMA)?;
+        Ok(Self { w, state: 0 })
+    }
+
+    fn invalid_state(&self, attempted: usize, end: bool) -> yardl::Error {
+        let expected = match self.state {
+            0 => "write_days() or end_days()",
+            1 => "write_formats() or end_formats()",
+            _ => "close()",
+        };
+        let received = match attempted {
+            0 if end => "end_days()",
+            0 => "write_days()",
+            1 if end => "end_formats()",
+            1 => "write_formats()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: expected.to_string(),
+            received: received.to_string(),
+        }
+    }
+}
+
+impl<W: Write> FlagsWriter for BinaryFlagsWriter<W> {
+    fn write_days(&mut self, values: &[DaysOfWeek]) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, false));
+        }
+        self.w.write_block(values)
+    }
+
+    fn end_days(&mut self) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, true));
+        }
+        self.w.write_end_of_stream()?;
+        self.state = 1;
+        Ok(())
+    }
+
+    fn write_formats(&mut self, values: &[TextFormat]) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, false));
+        }
+        self.w.write_block(values)
+    }
+
+    fn end_formats(&mut self) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, true));
+        }
+        self.w.write_end_of_stream()?;
+        self.state = 2;
+        Ok(())
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2, false));
+        }
+        self.w.flush()
+    }
+}
+
+/// Reads the Flags protocol in binary format.
+pub struct BinaryFlagsReader<R: Read> {
+    r: BinaryReader<R>,
+    state: usize,
+    days_remaining: u64,
+    formats_remaining: u64,
+}
+
+impl<R: Read> BinaryFlagsReader<R> {
+    /// Reads the protocol header and returns an error if the stream is not a binary Flags stream.
+    pub fn new(r: R) -> yardl::Result<Self> {
+        let mut r = BinaryReader::new(r);
+        r.read_header(Some(FLAGS_SCHEMA))?;
+        Ok(Self {
+            r,
+            state: 0,
+            days_remaining: 0,
+            formats_remaining: 0,
+        })
+    }
+
+    fn invalid_state(&self, attempted: usize) -> yardl::Error {
+        let method_name = |state: usize| match state {
+            0 => "read_days()",
+            1 => "read_formats()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: method_name(self.state).to_string(),
+            received: method_name(attempted).to_string(),
+        }
+    }
+}
+
+impl<R: Read> FlagsReader for BinaryFlagsReader<R> {
+    fn read_days(&mut self) -> yardl::Result<Option<DaysOfWeek>> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0));
+        }
+        let value = self.r.read_stream_item(&mut self.days_remaining)?;
+        if value.is_none() {
+            self.state = 1;
+        }
+        Ok(value)
+    }
+
+    fn read_formats(&mut self) -> yardl::Result<Option<TextFormat>> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1));
+        }
+        let value = self.r.read_stream_item(&mut self.formats_remaining)?;
+        if value.is_none() {
+            self.state = 2;
+        }
+        Ok(value)
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2));
+        }
+        Ok(())
+    }
+}
+
+/// Writes the StateTest protocol in binary format.
+pub struct BinaryStateTestWriter<W: Write> {
+    w: BinaryWriter<W>,
+    state: usize,
+}
+
+impl<W: Write> BinaryStateTestWriter<W> {
+    /// Writes the protocol header to the given writer.
+    pub fn new(w: W) -> yardl::Result<Self> {
+        let mut w = BinaryWriter::new(w);
+        w.write_header(STATE_TEST_SCHEMA)?;
+        Ok(Self { w, state: 0 })
+    }
+
+    fn invalid_state(&self, attempted: usize, end: bool) -> yardl::Error {
+        let expected = match self.state {
+            0 => "write_an_int()",
+            1 => "write_a_stream() or end_a_stream()",
+            2 => "write_another_int()",
+            _ => "close()",
+        };
+        let received = match attempted {
+            0 => "write_an_int()",
+            1 if end => "end_a_stream()",
+            1 => "write_a_stream()",
+            2 => "write_another_int()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: expected.to_string(),
+            received: received.to_string(),
+        }
+    }
+}
+
+impl<W: Write> StateTestWriter for BinaryStateTestWriter<W> {
+    fn write_an_int(&mut self, value: &i32) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 1;
+        Ok(())
+    }
+
+    fn write_a_stream(&mut self, values: &[i32]) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, false));
+        }
+        self.w.write_block(values)
+    }
+
+    fn end_a_stream(&mut self) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, true));
+        }
+        self.w.write_end_of_stream()?;
+        self.state = 2;
+        Ok(())
+    }
+
+    fn write_another_int(&mut self, value: &i32) -> yardl::Result<()> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 3;
+        Ok(())
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 3 {
+            return Err(self.invalid_state(3, false));
+        }
+        self.w.flush()
+    }
+}
+
+/// Reads the StateTest protocol in binary format.
+pub struct BinaryStateTestReader<R: Read> {
+    r: BinaryReader<R>,
+    state: usize,
+    a_stream_remaining: u64,
+}
+
+impl<R: Read> BinaryStateTestReader<R> {
+    /// Reads the protocol header and returns an error if the stream is not a binary StateTest stream.
+    pub fn new(r: R) -> yardl::Result<Self> {
+        let mut r = BinaryReader::new(r);
+        r.read_header(Some(STATE_TEST_SCHEMA))?;
+        Ok(Self {
+            r,
+            state: 0,
+            a_stream_remaining: 0,
+        })
+    }
+
+    fn invalid_state(&self, attempted: usize) -> yardl::Error {
+        let method_name = |state: usize| match state {
+            0 => "read_an_int()",
+            1 => "read_a_stream()",
+            2 => "read_another_int()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: method_name(self.state).to_string(),
+            received: method_name(attempted).to_string(),
+        }
+    }
+}
+
+impl<R: Read> StateTestReader for BinaryStateTestReader<R> {
+    fn read_an_int(&mut self) -> yardl::Result<i32> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 1;
+        Ok(value)
+    }
+
+    fn read_a_stream(&mut self) -> yardl::Result<Option<i32>> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1));
+        }
+        let value = self.r.read_stream_item(&mut self.a_stream_remaining)?;
+        if value.is_none() {
+            self.state = 2;
+        }
+        Ok(value)
+    }
+
+    fn read_another_int(&mut self) -> yardl::Result<i32> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 3;
+        Ok(value)
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 3 {
+            return Err(self.invalid_state(3));
+        }
+        Ok(())
+    }
+}
+
+impl BinaryWrite for ImageFloatOrImageDouble {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        match self {
+            ImageFloatOrImageDouble::ImageFloat(value) => {
+                w.write_uvarint(0)?;
+                BinaryWrite::write(value, w)
+            }
+            ImageFloatOrImageDouble::ImageDouble(value) => {
+                w.write_uvarint(1)?;
+                BinaryWrite::write(value, w)
+            }
+        }
+    }
+
+    fn write_some<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        match self {
+            ImageFloatOrImageDouble::ImageFloat(value) => {
+                w.write_uvarint(1)?;
+                BinaryWrite::write(value, w)
+            }
+            ImageFloatOrImageDouble::ImageDouble(value) => {
+                w.write_uvarint(2)?;
+                BinaryWrite::write(value, w)
+            }
+        }
+    }
+}
+
+impl BinaryRead for ImageFloatOrImageDouble {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        match r.read_uvarint()? {
+            0 => Ok(ImageFloatOrImageDouble::ImageFloat(BinaryRead::read(r)?)),
+            1 => Ok(ImageFloatOrImageDouble::ImageDouble(BinaryRead::read(r)?)),
+            index => Err(yardl::Error::InvalidData(format!("unexpected ImageFloatOrImageDouble index {}", index))),
+        }
+    }
+
+    fn read_optional<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Option<Self>> {
+        match r.read_uvarint()? {
+            0 => Ok(None),
+            1 => Ok(Some(ImageFloatOrImageDouble::ImageFloat(BinaryRead::read(r)?))),
+            2 => Ok(Some(ImageFloatOrImageDouble::ImageDouble(BinaryRead::read(r)?))),
+            index => Err(yardl::Error::InvalidData(format!("unexpected ImageFloatOrImageDouble index {}", index))),
+        }
+    }
+}
+
+/// Writes the SimpleGenerics protocol in binary format.
+pub struct BinarySimpleGenericsWriter<W: Write> {
+    w: BinaryWriter<W>,
+    state: usize,
+}
+
+impl<W: Write> BinarySimpleGenericsWriter<W> {
+    /// Writes the protocol header to the given writer.
+    pub fn new(w: W) -> yardl::Result<Self> {
+        let mut w = BinaryWriter::new(w);
+        w.write_header(SIMPLE_GENERICS_SCHEMA)?;
+        Ok(Self { w, state: 0 })
+    }
+
+    fn invalid_state(&self, attempted: usize, end: bool) -> yardl::Error {
+        let expected = match self.state {
+            0 => "write_float_image()",
+            1 => "write_int_image()",
+            2 => "write_int_image_alternate_syntax()",
+            3 => "write_string_image()",
+            4 => "write_int_float_tuple()",
+            5 => "write_float_float_tuple()",
+            6 => "write_int_float_tuple_alternate_syntax()",
+            7 => "write_int_string_tuple()",
+            8 => "write_stream_of_type_variants() or end_stream_of_type_variants()",
+            _ => "close()",
+        };
+        let received = match attempted {
+            0 => "write_float_image()",
+            1 => "write_int_image()",
+            2 => "write_int_image_alternate_syntax()",
+            3 => "write_string_image()",
+            4 => "write_int_float_tuple()",
+            5 => "write_float_float_tuple()",
+            6 => "write_int_float_tuple_alternate_syntax()",
+            7 => "write_int_string_tuple()",
+            8 if end => "end_stream_of_type_variants()",
+            8 => "write_stream_of_type_variants()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: expected.to_string(),
+            received: received.to_string(),
+        }
+    }
+}
+
+impl<W: Write> SimpleGenericsWriter for BinarySimpleGenericsWriter<W> {
+    fn write_float_image(&mut self, value: &super::image::FloatImage) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 1;
+        Ok(())
+    }
+
+    fn write_int_image(&mut self, value: &super::image::IntImage) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 2;
+        Ok(())
+    }
+
+    fn write_int_image_alternate_syntax(&mut self, value: &Image<i32>) -> yardl::Result<()> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 3;
+        Ok(())
+    }
+
+    fn write_string_image(&mut self, value: &Image<String>) -> yardl::Result<()> {
+        if self.state != 3 {
+            return Err(self.invalid_state(3, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 4;
+        Ok(())
+    }
+
+    fn write_int_float_tuple(&mut self, value: &super::tuples::Tuple<i32, f32>) -> yardl::Result<()> {
+        if self.state != 4 {
+            return Err(self.invalid_state(4, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 5;
+        Ok(())
+    }
+
+    fn write_float_float_tuple(&mut self, value: &super::tuples::Tuple<f32, f32>) -> yardl::Result<()> {
+        if self.state != 5 {
+            return Err(self.invalid_state(5, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 6;
+        Ok(())
+    }
+
+    fn write_int_float_tuple_alternate_syntax(&mut self, value: &super::tuples::Tuple<i32, f32>) -> yardl::Result<()> {
+        if self.state != 6 {
+            return Err(self.invalid_state(6, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 7;
+        Ok(())
+    }
+
+    fn write_int_string_tuple(&mut self, value: &super::tuples::Tuple<i32, String>) -> yardl::Result<()> {
+        if self.state != 7 {
+            return Err(self.invalid_state(7, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 8;
+        Ok(())
+    }
+
+    fn write_stream_of_type_variants(&mut self, values: &[ImageFloatOrImageDouble]) -> yardl::Result<()> {
+        if self.state != 8 {
+            return Err(self.invalid_state(8, false));
+        }
+        self.w.write_block(values)
+    }
+
+    fn end_stream_of_type_variants(&mut self) -> yardl::Result<()> {
+        if self.state != 8 {
+            return Err(self.invalid_state(8, true));
+        }
+        self.w.write_end_of_stream()?;
+        self.state = 9;
+        Ok(())
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 9 {
+            return Err(self.invalid_state(9, false));
+        }
+        self.w.flush()
+    }
+}
+
+/// Reads the SimpleGenerics protocol in binary format.
+pub struct BinarySimpleGenericsReader<R: Read> {
+    r: BinaryReader<R>,
+    state: usize,
+    stream_of_type_variants_remaining: u64,
+}
+
+impl<R: Read> BinarySimpleGenericsReader<R> {
+    /// Reads the protocol header and returns an error if the stream is not a binary SimpleGenerics stream.
+    pub fn new(r: R) -> yardl::Result<Self> {
+        let mut r = BinaryReader::new(r);
+        r.read_header(Some(SIMPLE_GENERICS_SCHEMA))?;
+        Ok(Self {
+            r,
+            state: 0,
+            stream_of_type_variants_remaining: 0,
+        })
+    }
+
+    fn invalid_state(&self, attempted: usize) -> yardl::Error {
+        let method_name = |state: usize| match state {
+            0 => "read_float_image()",
+            1 => "read_int_image()",
+            2 => "read_int_image_alternate_syntax()",
+            3 => "read_string_image()",
+            4 => "read_int_float_tuple()",
+            5 => "read_float_float_tuple()",
+            6 => "read_int_float_tuple_alternate_syntax()",
+            7 => "read_int_string_tuple()",
+            8 => "read_stream_of_type_variants()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: method_name(self.state).to_string(),
+            received: method_name(attempted).to_string(),
+        }
+    }
+}
+
+impl<R: Read> SimpleGenericsReader for BinarySimpleGenericsReader<R> {
+    fn read_float_image(&mut self) -> yardl::Result<super::image::FloatImage> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 1;
+        Ok(value)
+    }
+
+    fn read_int_image(&mut self) -> yardl::Result<super::image::IntImage> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 2;
+        Ok(value)
+    }
+
+    fn read_int_image_alternate_syntax(&mut self) -> yardl::Result<Image<i32>> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 3;
+        Ok(value)
+    }
+
+    fn read_string_image(&mut self) -> yardl::Result<Image<String>> {
+        if self.state != 3 {
+            return Err(self.invalid_state(3));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 4;
+        Ok(value)
+    }
+
+    fn read_int_float_tuple(&mut self) -> yardl::Result<super::tuples::Tuple<i32, f32>> {
+        if self.state != 4 {
+            return Err(self.invalid_state(4));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 5;
+        Ok(value)
+    }
+
+    fn read_float_float_tuple(&mut self) -> yardl::Result<super::tuples::Tuple<f32, f32>> {
+        if self.state != 5 {
+            return Err(self.invalid_state(5));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 6;
+        Ok(value)
+    }
+
+    fn read_int_float_tuple_alternate_syntax(&mut self) -> yardl::Result<super::tuples::Tuple<i32, f32>> {
+        if self.state != 6 {
+            return Err(self.invalid_state(6));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 7;
+        Ok(value)
+    }
+
+    fn read_int_string_tuple(&mut self) -> yardl::Result<super::tuples::Tuple<i32, String>> {
+        if self.state != 7 {
+            return Err(self.invalid_state(7));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 8;
+        Ok(value)
+    }
+
+    fn read_stream_of_type_variants(&mut self) -> yardl::Result<Option<ImageFloatOrImageDouble>> {
+        if self.state != 8 {
+            return Err(self.invalid_state(8));
+        }
+        let value = self.r.read_stream_item(&mut self.stream_of_type_variants_remaining)?;
+        if value.is_none() {
+            self.state = 9;
+        }
+        Ok(value)
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 9 {
+            return Err(self.invalid_state(9));
+        }
+        Ok(())
+    }
+}
+
+/// Writes the AdvancedGenerics protocol in binary format.
+pub struct BinaryAdvancedGenericsWriter<W: Write> {
+    w: BinaryWriter<W>,
+    state: usize,
+}
+
+impl<W: Write> BinaryAdvancedGenericsWriter<W> {
+    /// Writes the protocol header to the given writer.
+    pub fn new(w: W) -> yardl::Result<Self> {
+        let mut w = BinaryWriter::new(w);
+        w.write_header(ADVANCED_GENERICS_SCHEMA)?;
+        Ok(Self { w, state: 0 })
+    }
+
+    fn invalid_state(&self, attempted: usize, end: bool) -> yardl::Error {
+        let expected = match self.state {
+            0 => "write_float_image_image()",
+            1 => "write_generic_record_1()",
+            2 => "write_tuple_of_optionals()",
+            3 => "write_tuple_of_optionals_alternate_syntax()",
+            4 => "write_tuple_of_vectors()",
+            _ => "close()",
+        };
+        let received = match attempted {
+            0 => "write_float_image_image()",
+            1 => "write_generic_record_1()",
+            2 => "write_tuple_of_optionals()",
+            3 => "write_tuple_of_optionals_alternate_syntax()",
+            4 => "write_tuple_of_vectors()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: expected.to_string(),
+            received: received.to_string(),
+        }
+    }
+}
+
+impl<W: Write> AdvancedGenericsWriter for BinaryAdvancedGenericsWriter<W> {
+    fn write_float_image_image(&mut self, value: &Image<Image<f32>>) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 1;
+        Ok(())
+    }
+
+    fn write_generic_record_1(&mut self, value: &GenericRecord<i32, String>) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 2;
+        Ok(())
+    }
+
+    fn write_tuple_of_optionals(&mut self, value: &MyTuple<Option<i32>, Option<String>>) -> yardl::Result<()> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 3;
+        Ok(())
+    }
+
+    fn write_tuple_of_optionals_alternate_syntax(&mut self, value: &MyTuple<Option<i32>, Option<String>>) -> yardl::Result<()> {
+        if self.state != 3 {
+            return Err(self.invalid_state(3, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 4;
+        Ok(())
+    }
+
+    fn write_tuple_of_vectors(&mut self, value: &MyTuple<Vec<i32>, Vec<f32>>) -> yardl::Result<()> {
+        if self.state != 4 {
+            return Err(self.invalid_state(4, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 5;
+        Ok(())
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 5 {
+            return Err(self.invalid_state(5, false));
+        }
+        self.w.flush()
+    }
+}
+
+/// Reads the AdvancedGenerics protocol in binary format.
+pub struct BinaryAdvancedGenericsReader<R: Read> {
+    r: BinaryReader<R>,
+    state: usize,
+}
+
+impl<R: Read> BinaryAdvancedGenericsReader<R> {
+    /// Reads the protocol header and returns an error if the stream is not a binary AdvancedGenerics stream.
+    pub fn new(r: R) -> yardl::Result<Self> {
+        let mut r = BinaryReader::new(r);
+        r.read_header(Some(ADVANCED_GENERICS_SCHEMA))?;
+        Ok(Self {
+            r,
+            state: 0,
+        })
+    }
+
+    fn invalid_state(&self, attempted: usize) -> yardl::Error {
+        let method_name = |state: usize| match state {
+            0 => "read_float_image_image()",
+            1 => "read_generic_record_1()",
+            2 => "read_tuple_of_optionals()",
+            3 => "read_tuple_of_optionals_alternate_syntax()",
+            4 => "read_tuple_of_vectors()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: method_name(self.state).to_string(),
+            received: method_name(attempted).to_string(),
+        }
+    }
+}
+
+impl<R: Read> AdvancedGenericsReader for BinaryAdvancedGenericsReader<R> {
+    fn read_float_image_image(&mut self) -> yardl::Result<Image<Image<f32>>> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 1;
+        Ok(value)
+    }
+
+    fn read_generic_record_1(&mut self) -> yardl::Result<GenericRecord<i32, String>> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 2;
+        Ok(value)
+    }
+
+    fn read_tuple_of_optionals(&mut self) -> yardl::Result<MyTuple<Option<i32>, Option<String>>> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 3;
+        Ok(value)
+    }
+
+    fn read_tuple_of_optionals_alternate_syntax(&mut self) -> yardl::Result<MyTuple<Option<i32>, Option<String>>> {
+        if self.state != 3 {
+            return Err(self.invalid_state(3));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 4;
+        Ok(value)
+    }
+
+    fn read_tuple_of_vectors(&mut self) -> yardl::Result<MyTuple<Vec<i32>, Vec<f32>>> {
+        if self.state != 4 {
+            return Err(self.invalid_state(4));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 5;
+        Ok(value)
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 5 {
+            return Err(self.invalid_state(5));
+        }
+        Ok(())
+    }
+}
+
+impl BinaryWrite for Float32OrString {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        match self {
+            Float32OrString::Float32(value) => {
+                w.write_uvarint(0)?;
+                BinaryWrite::write(value, w)
+            }
+            Float32OrString::String(value) => {
+                w.write_uvarint(1)?;
+                BinaryWrite::write(value, w)
+            }
+        }
+    }
+
+    fn write_some<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        match self {
+            Float32OrString::Float32(value) => {
+                w.write_uvarint(1)?;
+                BinaryWrite::write(value, w)
+            }
+            Float32OrString::String(value) => {
+                w.write_uvarint(2)?;
+                BinaryWrite::write(value, w)
+            }
+        }
+    }
+}
+
+impl BinaryRead for Float32OrString {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        match r.read_uvarint()? {
+            0 => Ok(Float32OrString::Float32(BinaryRead::read(r)?)),
+            1 => Ok(Float32OrString::String(BinaryRead::read(r)?)),
+            index => Err(yardl::Error::InvalidData(format!("unexpected Float32OrString index {}", index))),
+        }
+    }
+
+    fn read_optional<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Option<Self>> {
+        match r.read_uvarint()? {
+            0 => Ok(None),
+            1 => Ok(Some(Float32OrString::Float32(BinaryRead::read(r)?))),
+            2 => Ok(Some(Float32OrString::String(BinaryRead::read(r)?))),
+            index => Err(yardl::Error::InvalidData(format!("unexpected Float32OrString index {}", index))),
+        }
+    }
+}
+
+/// Writes the FloatImageStream protocol in binary format.
+pub struct BinaryFloatImageStreamWriter<W: Write> {
+    w: BinaryWriter<W>,
+    state: usize,
+}
+
+impl<W: Write> BinaryFloatImageStreamWriter<W> {
+    /// Writes the protocol header to the given writer.
+    pub fn new(w: W) -> yardl::Result<Self> {
+        let mut w = BinaryWriter::new(w);
+        w.write_header(FLOAT_IMAGE_STREAM_SCHEMA)?;
+        Ok(Self { w, state: 0 })
+    }
+
+    fn invalid_state(&self, attempted: usize, end: bool) -> yardl::Error {
+        let expected = match self.state {
+            0 => "write_header()",
+            1 => "write_images() or end_images()",
+            2 => "write_background()",
+            _ => "close()",
+        };
+        let received = match attempted {
+            0 => "write_header()",
+            1 if end => "end_images()",
+            1 => "write_images()",
+            2 => "write_background()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: expected.to_string(),
+            received: received.to_string(),
+        }
+    }
+}
+
+impl<W: Write> FloatImageStreamWriter for BinaryFloatImageStreamWriter<W> {
+    fn write_header(&mut self, value: &GenericRecord<i32, f32>) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 1;
+        Ok(())
+    }
+
+    fn write_images(&mut self, values: &[Image<f32>]) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, false));
+        }
+        self.w.write_block(values)
+    }
+
+    fn end_images(&mut self) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, true));
+        }
+        self.w.write_end_of_stream()?;
+        self.state = 2;
+        Ok(())
+    }
+
+    fn write_background(&mut self, value: &Float32OrString) -> yardl::Result<()> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 3;
+        Ok(())
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 3 {
+            return Err(self.invalid_state(3, false));
+        }
+        self.w.flush()
+    }
+}
+
+/// Reads the FloatImageStream protocol in binary format.
+pub struct BinaryFloatImageStreamReader<R: Read> {
+    r: BinaryReader<R>,
+    state: usize,
+    images_remaining: u64,
+}
+
+impl<R: Read> BinaryFloatImageStreamReader<R> {
+    /// Reads the protocol header and returns an error if the stream is not a binary FloatImageStream stream.
+    pub fn new(r: R) -> yardl::Result<Self> {
+        let mut r = BinaryReader::new(r);
+        r.read_header(Some(FLOAT_IMAGE_STREAM_SCHEMA))?;
+        Ok(Self {
+            r,
+            state: 0,
+            images_remaining: 0,
+        })
+    }
+
+    fn invalid_state(&self, attempted: usize) -> yardl::Error {
+        let method_name = |state: usize| match state {
+            0 => "read_header()",
+            1 => "read_images()",
+            2 => "read_background()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: method_name(self.state).to_string(),
+            received: method_name(attempted).to_string(),
+        }
+    }
+}
+
+impl<R: Read> FloatImageStreamReader for BinaryFloatImageStreamReader<R> {
+    fn read_header(&mut self) -> yardl::Result<GenericRecord<i32, f32>> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 1;
+        Ok(value)
+    }
+
+    fn read_images(&mut self) -> yardl::Result<Option<Image<f32>>> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1));
+        }
+        let value = self.r.read_stream_item(&mut self.images_remaining)?;
+        if value.is_none() {
+            self.state = 2;
+        }
+        Ok(value)
+    }
+
+    fn read_background(&mut self) -> yardl::Result<Float32OrString> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 3;
+        Ok(value)
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 3 {
+            return Err(self.invalid_state(3));
+        }
+        Ok(())
+    }
+}
+
+impl BinaryWrite for Complexfloat32OrString {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        match self {
+            Complexfloat32OrString::Complexfloat32(value) => {
+                w.write_uvarint(0)?;
+                BinaryWrite::write(value, w)
+            }
+            Complexfloat32OrString::String(value) => {
+                w.write_uvarint(1)?;
+                BinaryWrite::write(value, w)
+            }
+        }
+    }
+
+    fn write_some<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        match self {
+            Complexfloat32OrString::Complexfloat32(value) => {
+                w.write_uvarint(1)?;
+                BinaryWrite::write(value, w)
+            }
+            Complexfloat32OrString::String(value) => {
+                w.write_uvarint(2)?;
+                BinaryWrite::write(value, w)
+            }
+        }
+    }
+}
+
+impl BinaryRead for Complexfloat32OrString {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        match r.read_uvarint()? {
+            0 => Ok(Complexfloat32OrString::Complexfloat32(BinaryRead::read(r)?)),
+            1 => Ok(Complexfloat32OrString::String(BinaryRead::read(r)?)),
+            index => Err(yardl::Error::InvalidData(format!("unexpected Complexfloat32OrString index {}", index))),
+        }
+    }
+
+    fn read_optional<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Option<Self>> {
+        match r.read_uvarint()? {
+            0 => Ok(None),
+            1 => Ok(Some(Complexfloat32OrString::Complexfloat32(BinaryRead::read(r)?))),
+            2 => Ok(Some(Complexfloat32OrString::String(BinaryRead::read(r)?))),
+            index => Err(yardl::Error::InvalidData(format!("unexpected Complexfloat32OrString index {}", index))),
+        }
+    }
+}
+
+/// Writes the ComplexImageStream protocol in binary format.
+pub struct BinaryComplexImageStreamWriter<W: Write> {
+    w: BinaryWriter<W>,
+    state: usize,
+}
+
+impl<W: Write> BinaryComplexImageStreamWriter<W> {
+    /// Writes the protocol header to the given writer.
+    pub fn new(w: W) -> yardl::Result<Self> {
+        let mut w = BinaryWriter::new(w);
+        w.write_header(COMPLEX_IMAGE_STREAM_SCHEMA)?;
+        Ok(Self { w, state: 0 })
+    }
+
+    fn invalid_state(&self, attempted: usize, end: bool) -> yardl::Error {
+        let expected = match self.state {
+            0 => "write_header()",
+            1 => "write_images() or end_images()",
+            2 => "write_background()",
+            _ => "close()",
+        };
+        let received = match attempted {
+            0 => "write_header()",
+            1 if end => "end_images()",
+            1 => "write_images()",
+            2 => "write_background()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: expected.to_string(),
+            received: received.to_string(),
+        }
+    }
+}
+
+impl<W: Write> ComplexImageStreamWriter for BinaryComplexImageStreamWriter<W> {
+    fn write_header(&mut self, value: &GenericRecord<i32, yardl::Complex<f32>>) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 1;
+        Ok(())
+    }
+
+    fn write_images(&mut self, values: &[Image<yardl::Complex<f32>>]) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, false));
+        }
+        self.w.write_block(values)
+    }
+
+    fn end_images(&mut self) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, true));
+        }
+        self.w.write_end_of_stream()?;
+        self.state = 2;
+        Ok(())
+    }
+
+    fn write_background(&mut self, value: &Complexfloat32OrString) -> yardl::Result<()> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 3;
+        Ok(())
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 3 {
+            return Err(self.invalid_state(3, false));
+        }
+        self.w.flush()
+    }
+}
+
+/// Reads the ComplexImageStream protocol in binary format.
+pub struct BinaryComplexImageStreamReader<R: Read> {
+    r: BinaryReader<R>,
+    state: usize,
+    images_remaining: u64,
+}
+
+impl<R: Read> BinaryComplexImageStreamReader<R> {
+    /// Reads the protocol header and returns an error if the stream is not a binary ComplexImageStream stream.
+    pub fn new(r: R) -> yardl::Result<Self> {
+        let mut r = BinaryReader::new(r);
+        r.read_header(Some(COMPLEX_IMAGE_STREAM_SCHEMA))?;
+        Ok(Self {
+            r,
+            state: 0,
+            images_remaining: 0,
+        })
+    }
+
+    fn invalid_state(&self, attempted: usize) -> yardl::Error {
+        let method_name = |state: usize| match state {
+            0 => "read_header()",
+            1 => "read_images()",
+            2 => "read_background()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: method_name(self.state).to_string(),
+            received: method_name(attempted).to_string(),
+        }
+    }
+}
+
+impl<R: Read> ComplexImageStreamReader for BinaryComplexImageStreamReader<R> {
+    fn read_header(&mut self) -> yardl::Result<GenericRecord<i32, yardl::Complex<f32>>> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 1;
+        Ok(value)
+    }
+
+    fn read_images(&mut self) -> yardl::Result<Option<Image<yardl::Complex<f32>>>> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1));
+        }
+        let value = self.r.read_stream_item(&mut self.images_remaining)?;
+        if value.is_none() {
+            self.state = 2;
+        }
+        Ok(value)
+    }
+
+    fn read_background(&mut self) -> yardl::Result<Complexfloat32OrString> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 3;
+        Ok(value)
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 3 {
+            return Err(self.invalid_state(3));
+        }
+        Ok(())
+    }
+}
+
+/// Writes the Aliases protocol in binary format.
+pub struct BinaryAliasesWriter<W: Write> {
+    w: BinaryWriter<W>,
+    state: usize,
+}
+
+impl<W: Write> BinaryAliasesWriter<W> {
+    /// Writes the protocol header to the given writer.
+    pub fn new(w: W) -> yardl::Result<Self> {
+        let mut w = BinaryWriter::new(w);
+        w.write_header(ALIASES_SCHEMA)?;
+        Ok(Self { w, state: 0 })
+    }
+
+    fn invalid_state(&self, attempted: usize, end: bool) -> yardl::Error {
+        let expected = match self.state {
+            0 => "write_aliased_string()",
+            1 => "write_aliased_enum()",
+            2 => "write_aliased_open_generic()",
+            3 => "write_aliased_closed_generic()",
+            4 => "write_aliased_optional()",
+            5 => "write_aliased_generic_optional()",
+            6 => "write_aliased_generic_union_2()",
+            7 => "write_aliased_generic_vector()",
+            8 => "write_aliased_generic_fixed_vector()",
+            9 => "write_stream_of_aliased_generic_union_2() or end_stream_of_aliased_generic_union_2()",
+            10 => "write_vectors()",
+            _ => "close()",
+        };
+        let received = match attempted {
+            0 => "write_aliased_string()",
+            1 => "write_aliased_enum()",
+            2 => "write_aliased_open_generic()",
+            3 => "write_aliased_closed_generic()",
+            4 => "write_aliased_optional()",
+            5 => "write_aliased_generic_optional()",
+            6 => "write_aliased_generic_union_2()",
+            7 => "write_aliased_generic_vector()",
+            8 => "write_aliased_generic_fixed_vector()",
+            9 if end => "end_stream_of_aliased_generic_union_2()",
+            9 => "write_stream_of_aliased_generic_union_2()",
+            10 => "write_vectors()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: expected.to_string(),
+            received: received.to_string(),
+        }
+    }
+}
+
+impl<W: Write> AliasesWriter for BinaryAliasesWriter<W> {
+    fn write_aliased_string(&mut self, value: &AliasedString) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 1;
+        Ok(())
+    }
+
+    fn write_aliased_enum(&mut self, value: &AliasedEnum) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 2;
+        Ok(())
+    }
+
+    fn write_aliased_open_generic(&mut self, value: &AliasedOpenGeneric<AliasedString, AliasedEnum>) -> yardl::Result<()> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 3;
+        Ok(())
+    }
+
+    fn write_aliased_closed_generic(&mut self, value: &AliasedClosedGeneric) -> yardl::Result<()> {
+        if self.state != 3 {
+            return Err(self.invalid_state(3, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 4;
+        Ok(())
+    }
+
+    fn write_aliased_optional(&mut self, value: &AliasedOptional) -> yardl::Result<()> {
+        if self.state != 4 {
+            return Err(self.invalid_state(4, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 5;
+        Ok(())
+    }
+
+    fn write_aliased_generic_optional(&mut self, value: &AliasedGenericOptional<f32>) -> yardl::Result<()> {
+        if self.state != 5 {
+            return Err(self.invalid_state(5, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 6;
+        Ok(())
+    }
+
+    fn write_aliased_generic_union_2(&mut self, value: &AliasedGenericUnion2<AliasedString, AliasedEnum>) -> yardl::Result<()> {
+        if self.state != 6 {
+            return Err(self.invalid_state(6, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 7;
+        Ok(())
+    }
+
+    fn write_aliased_generic_vector(&mut self, value: &AliasedGenericVector<f32>) -> yardl::Result<()> {
+        if self.state != 7 {
+            return Err(self.invalid_state(7, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 8;
+        Ok(())
+    }
+
+    fn write_aliased_generic_fixed_vector(&mut self, value: &AliasedGenericFixedVector<f32>) -> yardl::Result<()> {
+        if self.state != 8 {
+            return Err(self.invalid_state(8, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 9;
+        Ok(())
+    }
+
+    fn write_stream_of_aliased_generic_union_2(&mut self, values: &[AliasedGenericUnion2<AliasedString, AliasedEnum>]) -> yardl::Result<()> {
+        if self.state != 9 {
+            return Err(self.invalid_state(9, false));
+        }
+        self.w.write_block(values)
+    }
+
+    fn end_stream_of_aliased_generic_union_2(&mut self) -> yardl::Result<()> {
+        if self.state != 9 {
+            return Err(self.invalid_state(9, true));
+        }
+        self.w.write_end_of_stream()?;
+        self.state = 10;
+        Ok(())
+    }
+
+    fn write_vectors(&mut self, value: &Vec<RecordContainingVectorsOfAliases>) -> yardl::Result<()> {
+        if self.state != 10 {
+            return Err(self.invalid_state(10, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 11;
+        Ok(())
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 11 {
+            return Err(self.invalid_state(11, false));
+        }
+        self.w.flush()
+    }
+}
+
+/// Reads the Aliases protocol in binary format.
+pub struct BinaryAliasesReader<R: Read> {
+    r: BinaryReader<R>,
+    state: usize,
+    stream_of_aliased_generic_union_2_remaining: u64,
+}
+
+impl<R: Read> BinaryAliasesReader<R> {
+    /// Reads the protocol header and returns an error if the stream is not a binary Aliases stream.
+    pub fn new(r: R) -> yardl::Result<Self> {
+        let mut r = BinaryReader::new(r);
+        r.read_header(Some(ALIASES_SCHEMA))?;
+        Ok(Self {
+            r,
+            state: 0,
+            stream_of_aliased_generic_union_2_remaining: 0,
+        })
+    }
+
+    fn invalid_state(&self, attempted: usize) -> yardl::Error {
+        let method_name = |state: usize| match state {
+            0 => "read_aliased_string()",
+            1 => "read_aliased_enum()",
+            2 => "read_aliased_open_generic()",
+            3 => "read_aliased_closed_generic()",
+            4 => "read_aliased_optional()",
+            5 => "read_aliased_generic_optional()",
+            6 => "read_aliased_generic_union_2()",
+            7 => "read_aliased_generic_vector()",
+            8 => "read_aliased_generic_fixed_vector()",
+            9 => "read_stream_of_aliased_generic_union_2()",
+            10 => "read_vectors()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: method_name(self.state).to_string(),
+            received: method_name(attempted).to_string(),
+        }
+    }
+}
+
+impl<R: Read> AliasesReader for BinaryAliasesReader<R> {
+    fn read_aliased_string(&mut self) -> yardl::Result<AliasedString> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 1;
+        Ok(value)
+    }
+
+    fn read_aliased_enum(&mut self) -> yardl::Result<AliasedEnum> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 2;
+        Ok(value)
+    }
+
+    fn read_aliased_open_generic(&mut self) -> yardl::Result<AliasedOpenGeneric<AliasedString, AliasedEnum>> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 3;
+        Ok(value)
+    }
+
+    fn read_aliased_closed_generic(&mut self) -> yardl::Result<AliasedClosedGeneric> {
+        if self.state != 3 {
+            return Err(self.invalid_state(3));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 4;
+        Ok(value)
+    }
+
+    fn read_aliased_optional(&mut self) -> yardl::Result<AliasedOptional> {
+        if self.state != 4 {
+            return Err(self.invalid_state(4));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 5;
+        Ok(value)
+    }
+
+    fn read_aliased_generic_optional(&mut self) -> yardl::Result<AliasedGenericOptional<f32>> {
+        if self.state != 5 {
+            return Err(self.invalid_state(5));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 6;
+        Ok(value)
+    }
+
+    fn read_aliased_generic_union_2(&mut self) -> yardl::Result<AliasedGenericUnion2<AliasedString, AliasedEnum>> {
+        if self.state != 6 {
+            return Err(self.invalid_state(6));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 7;
+        Ok(value)
+    }
+
+    fn read_aliased_generic_vector(&mut self) -> yardl::Result<AliasedGenericVector<f32>> {
+        if self.state != 7 {
+            return Err(self.invalid_state(7));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 8;
+        Ok(value)
+    }
+
+    fn read_aliased_generic_fixed_vector(&mut self) -> yardl::Result<AliasedGenericFixedVector<f32>> {
+        if self.state != 8 {
+            return Err(self.invalid_state(8));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 9;
+        Ok(value)
+    }
+
+    fn read_stream_of_aliased_generic_union_2(&mut self) -> yardl::Result<Option<AliasedGenericUnion2<AliasedString, AliasedEnum>>> {
+        if self.state != 9 {
+            return Err(self.invalid_state(9));
+        }
+        let value = self.r.read_stream_item(&mut self.stream_of_aliased_generic_union_2_remaining)?;
+        if value.is_none() {
+            self.state = 10;
+        }
+        Ok(value)
+    }
+
+    fn read_vectors(&mut self) -> yardl::Result<Vec<RecordContainingVectorsOfAliases>> {
+        if self.state != 10 {
+            return Err(self.invalid_state(10));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 11;
+        Ok(value)
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 11 {
+            return Err(self.invalid_state(11));
+        }
+        Ok(())
+    }
+}
+
+/// Writes the StreamsOfAliasedUnions protocol in binary format.
+pub struct BinaryStreamsOfAliasedUnionsWriter<W: Write> {
+    w: BinaryWriter<W>,
+    state: usize,
+}
+
+impl<W: Write> BinaryStreamsOfAliasedUnionsWriter<W> {
+    /// Writes the protocol header to the given writer.
+    pub fn new(w: W) -> yardl::Result<Self> {
+        let mut w = BinaryWriter::new(w);
+        w.write_header(STREAMS_OF_ALIASED_UNIONS_SCHEMA)?;
+        Ok(Self { w, state: 0 })
+    }
+
+    fn invalid_state(&self, attempted: usize, end: bool) -> yardl::Error {
+        let expected = match self.state {
+            0 => "write_int_or_simple_record() or end_int_or_simple_record()",
+            1 => "write_nullable_int_or_simple_record() or end_nullable_int_or_simple_record()",
+            _ => "close()",
+        };
+        let received = match attempted {
+            0 if end => "end_int_or_simple_record()",
+            0 => "write_int_or_simple_record()",
+            1 if end => "end_nullable_int_or_simple_record()",
+            1 => "write_nullable_int_or_simple_record()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: expected.to_string(),
+            received: received.to_string(),
+        }
+    }
+}
+
+impl<W: Write> StreamsOfAliasedUnionsWriter for BinaryStreamsOfAliasedUnionsWriter<W> {
+    fn write_int_or_simple_record(&mut self, values: &[AliasedIntOrSimpleRecord]) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, false));
+        }
+        self.w.write_block(values)
+    }
+
+    fn end_int_or_simple_record(&mut self) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, true));
+        }
+        self.w.write_end_of_stream()?;
+        self.state = 1;
+        Ok(())
+    }
+
+    fn write_nullable_int_or_simple_record(&mut self, values: &[Option<AliasedNullableIntSimpleRecord>]) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, false));
+        }
+        self.w.write_block(values)
+    }
+
+    fn end_nullable_int_or_simple_record(&mut self) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, true));
+        }
+        self.w.write_end_of_stream()?;
+        self.state = 2;
+        Ok(())
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2, false));
+        }
+        self.w.flush()
+    }
+}
+
+/// Reads the StreamsOfAliasedUnions protocol in binary format.
+pub struct BinaryStreamsOfAliasedUnionsReader<R: Read> {
+    r: BinaryReader<R>,
+    state: usize,
+    int_or_simple_record_remaining: u64,
+    nullable_int_or_simple_record_remaining: u64,
+}
+
+impl<R: Read> BinaryStreamsOfAliasedUnionsReader<R> {
+    /// Reads the protocol header and returns an error if the stream is not a binary StreamsOfAliasedUnions stream.
+    pub fn new(r: R) -> yardl::Result<Self> {
+        let mut r = BinaryReader::new(r);
+        r.read_header(Some(STREAMS_OF_ALIASED_UNIONS_SCHEMA))?;
+        Ok(Self {
+            r,
+            state: 0,
+            int_or_simple_record_remaining: 0,
+            nullable_int_or_simple_record_remaining: 0,
+        })
+    }
+
+    fn invalid_state(&self, attempted: usize) -> yardl::Error {
+        let method_name = |state: usize| match state {
+            0 => "read_int_or_simple_record()",
+            1 => "read_nullable_int_or_simple_record()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: method_name(self.state).to_string(),
+            received: method_name(attempted).to_string(),
+        }
+    }
+}
+
+impl<R: Read> StreamsOfAliasedUnionsReader for BinaryStreamsOfAliasedUnionsReader<R> {
+    fn read_int_or_simple_record(&mut self) -> yardl::Result<Option<AliasedIntOrSimpleRecord>> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0));
+        }
+        let value = self.r.read_stream_item(&mut self.int_or_simple_record_remaining)?;
+        if value.is_none() {
+            self.state = 1;
+        }
+        Ok(value)
+    }
+
+    fn read_nullable_int_or_simple_record(&mut self) -> yardl::Result<Option<Option<AliasedNullableIntSimpleRecord>>> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1));
+        }
+        let value = self.r.read_stream_item(&mut self.nullable_int_or_simple_record_remaining)?;
+        if value.is_none() {
+            self.state = 2;
+        }
+        Ok(value)
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2));
+        }
+        Ok(())
+    }
+}
+
+/// Writes the ProtocolWithComputedFields protocol in binary format.
+pub struct BinaryProtocolWithComputedFieldsWriter<W: Write> {
+    w: BinaryWriter<W>,
+    state: usize,
+}
+
+impl<W: Write> BinaryProtocolWithComputedFieldsWriter<W> {
+    /// Writes the protocol header to the given writer.
+    pub fn new(w: W) -> yardl::Result<Self> {
+        let mut w = BinaryWriter::new(w);
+        w.write_header(PROTOCOL_WITH_COMPUTED_FIELDS_SCHEMA)?;
+        Ok(Self { w, state: 0 })
+    }
+
+    fn invalid_state(&self, attempted: usize, end: bool) -> yardl::Error {
+        let expected = match self.state {
+            0 => "write_record_with_computed_fields()",
+            _ => "close()",
+        };
+        let received = match attempted {
+            0 => "write_record_with_computed_fields()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: expected.to_string(),
+            received: received.to_string(),
+        }
+    }
+}
+
+impl<W: Write> ProtocolWithComputedFieldsWriter for BinaryProtocolWithComputedFieldsWriter<W> {
+    fn write_record_with_computed_fields(&mut self, value: &RecordWithComputedFields) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 1;
+        Ok(())
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, false));
+        }
+        self.w.flush()
+    }
+}
+
+/// Reads the ProtocolWithComputedFields protocol in binary format.
+pub struct BinaryProtocolWithComputedFieldsReader<R: Read> {
+    r: BinaryReader<R>,
+    state: usize,
+}
+
+impl<R: Read> BinaryProtocolWithComputedFieldsReader<R> {
+    /// Reads the protocol header and returns an error if the stream is not a binary ProtocolWithComputedFields stream.
+    pub fn new(r: R) -> yardl::Result<Self> {
+        let mut r = BinaryReader::new(r);
+        r.read_header(Some(PROTOCOL_WITH_COMPUTED_FIELDS_SCHEMA))?;
+        Ok(Self {
+            r,
+            state: 0,
+        })
+    }
+
+    fn invalid_state(&self, attempted: usize) -> yardl::Error {
+        let method_name = |state: usize| match state {
+            0 => "read_record_with_computed_fields()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: method_name(self.state).to_string(),
+            received: method_name(attempted).to_string(),
+        }
+    }
+}
+
+impl<R: Read> ProtocolWithComputedFieldsReader for BinaryProtocolWithComputedFieldsReader<R> {
+    fn read_record_with_computed_fields(&mut self) -> yardl::Result<RecordWithComputedFields> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 1;
+        Ok(value)
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1));
+        }
+        Ok(())
+    }
+}
+
+/// Writes the ProtocolWithConstraints protocol in binary format.
+pub struct BinaryProtocolWithConstraintsWriter<W: Write> {
+    w: BinaryWriter<W>,
+    state: usize,
+}
+
+impl<W: Write> BinaryProtocolWithConstraintsWriter<W> {
+    /// Writes the protocol header to the given writer.
+    pub fn new(w: W) -> yardl::Result<Self> {
+        let mut w = BinaryWriter::new(w);
+        w.write_header(PROTOCOL_WITH_CONSTRAINTS_SCHEMA)?;
+        Ok(Self { w, state: 0 })
+    }
+
+    fn invalid_state(&self, attempted: usize, end: bool) -> yardl::Error {
+        let expected = match self.state {
+            0 => "write_record()",
+            1 => "write_records() or end_records()",
+            _ => "close()",
+        };
+        let received = match attempted {
+            0 => "write_record()",
+            1 if end => "end_records()",
+            1 => "write_records()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: expected.to_string(),
+            received: received.to_string(),
+        }
+    }
+}
+
+impl<W: Write> ProtocolWithConstraintsWriter for BinaryProtocolWithConstraintsWriter<W> {
+    fn write_record(&mut self, value: &RecordWithConstraints) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 1;
+        Ok(())
+    }
+
+    fn write_records(&mut self, values: &[RecordWithConstrainedRecords]) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, false));
+        }
+        self.w.write_block(values)
+    }
+
+    fn end_records(&mut self) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, true));
+        }
+        self.w.write_end_of_stream()?;
+        self.state = 2;
+        Ok(())
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2, false));
+        }
+        self.w.flush()
+    }
+}
+
+/// Reads the ProtocolWithConstraints protocol in binary format.
+pub struct BinaryProtocolWithConstraintsReader<R: Read> {
+    r: BinaryReader<R>,
+    state: usize,
+    records_remaining: u64,
+}
+
+impl<R: Read> BinaryProtocolWithConstraintsReader<R> {
+    /// Reads the protocol header and returns an error if the stream is not a binary ProtocolWithConstraints stream.
+    pub fn new(r: R) -> yardl::Result<Self> {
+        let mut r = BinaryReader::new(r);
+        r.read_header(Some(PROTOCOL_WITH_CONSTRAINTS_SCHEMA))?;
+        Ok(Self {
+            r,
+            state: 0,
+            records_remaining: 0,
+        })
+    }
+
+    fn invalid_state(&self, attempted: usize) -> yardl::Error {
+        let method_name = |state: usize| match state {
+            0 => "read_record()",
+            1 => "read_records()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: method_name(self.state).to_string(),
+            received: method_name(attempted).to_string(),
+        }
+    }
+}
+
+impl<R: Read> ProtocolWithConstraintsReader for BinaryProtocolWithConstraintsReader<R> {
+    fn read_record(&mut self) -> yardl::Result<RecordWithConstraints> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 1;
+        Ok(value)
+    }
+
+    fn read_records(&mut self) -> yardl::Result<Option<RecordWithConstrainedRecords>> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1));
+        }
+        let value = self.r.read_stream_item(&mut self.records_remaining)?;
+        if value.is_none() {
+            self.state = 2;
+        }
+        Ok(value)
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2));
+        }
+        Ok(())
+    }
+}
+
+/// Writes the ProtocolWithKeywordSteps protocol in binary format.
+pub struct BinaryProtocolWithKeywordStepsWriter<W: Write> {
+    w: BinaryWriter<W>,
+    state: usize,
+}
+
+impl<W: Write> BinaryProtocolWithKeywordStepsWriter<W> {
+    /// Writes the protocol header to the given writer.
+    pub fn new(w: W) -> yardl::Result<Self> {
+        let mut w = BinaryWriter::new(w);
+        w.write_header(PROTOCOL_WITH_KEYWORD_STEPS_SCHEMA)?;
+        Ok(Self { w, state: 0 })
+    }
+
+    fn invalid_state(&self, attempted: usize, end: bool) -> yardl::Error {
+        let expected = match self.state {
+            0 => "write_int() or end_int()",
+            1 => "write_float()",
+            _ => "close()",
+        };
+        let received = match attempted {
+            0 if end => "end_int()",
+            0 => "write_int()",
+            1 => "write_float()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: expected.to_string(),
+            received: received.to_string(),
+        }
+    }
+}
+
+impl<W: Write> ProtocolWithKeywordStepsWriter for BinaryProtocolWithKeywordStepsWriter<W> {
+    fn write_int(&mut self, values: &[RecordWithKeywordFields]) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, false));
+        }
+        self.w.write_block(values)
+    }
+
+    fn end_int(&mut self) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, true));
+        }
+        self.w.write_end_of_stream()?;
+        self.state = 1;
+        Ok(())
+    }
+
+    fn write_float(&mut self, value: &EnumWithKeywordSymbols) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 2;
+        Ok(())
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2, false));
+        }
+        self.w.flush()
+    }
+}
+
+/// Reads the ProtocolWithKeywordSteps protocol in binary format.
+pub struct BinaryProtocolWithKeywordStepsReader<R: Read> {
+    r: BinaryReader<R>,
+    state: usize,
+    int_remaining: u64,
+}
+
+impl<R: Read> BinaryProtocolWithKeywordStepsReader<R> {
+    /// Reads the protocol header and returns an error if the stream is not a binary ProtocolWithKeywordSteps stream.
+    pub fn new(r: R) -> yardl::Result<Self> {
+        let mut r = BinaryReader::new(r);
+        r.read_header(Some(PROTOCOL_WITH_KEYWORD_STEPS_SCHEMA))?;
+        Ok(Self {
+            r,
+            state: 0,
+            int_remaining: 0,
+        })
+    }
+
+    fn invalid_state(&self, attempted: usize) -> yardl::Error {
+        let method_name = |state: usize| match state {
+            0 => "read_int()",
+            1 => "read_float()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: method_name(self.state).to_string(),
+            received: method_name(attempted).to_string(),
+        }
+    }
+}
+
+impl<R: Read> ProtocolWithKeywordStepsReader for BinaryProtocolWithKeywordStepsReader<R> {
+    fn read_int(&mut self) -> yardl::Result<Option<RecordWithKeywordFields>> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0));
+        }
+        let value = self.r.read_stream_item(&mut self.int_remaining)?;
+        if value.is_none() {
+            self.state = 1;
+        }
+        Ok(value)
+    }
+
+    fn read_float(&mut self) -> yardl::Result<EnumWithKeywordSymbols> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 2;
+        Ok(value)
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 2 {
+            return Err(self.invalid_state(2));
+        }
+        Ok(())
+    }
+}
+
+/// Writes the ProtocolWithOptionalDate protocol in binary format.
+pub struct BinaryProtocolWithOptionalDateWriter<W: Write> {
+    w: BinaryWriter<W>,
+    state: usize,
+}
+
+impl<W: Write> BinaryProtocolWithOptionalDateWriter<W> {
+    /// Writes the protocol header to the given writer.
+    pub fn new(w: W) -> yardl::Result<Self> {
+        let mut w = BinaryWriter::new(w);
+        w.write_header(PROTOCOL_WITH_OPTIONAL_DATE_SCHEMA)?;
+        Ok(Self { w, state: 0 })
+    }
+
+    fn invalid_state(&self, attempted: usize, end: bool) -> yardl::Error {
+        let expected = match self.state {
+            0 => "write_record()",
+            _ => "close()",
+        };
+        let received = match attempted {
+            0 => "write_record()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: expected.to_string(),
+            received: received.to_string(),
+        }
+    }
+}
+
+impl<W: Write> ProtocolWithOptionalDateWriter for BinaryProtocolWithOptionalDateWriter<W> {
+    fn write_record(&mut self, value: &Option<RecordWithOptionalDate>) -> yardl::Result<()> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0, false));
+        }
+        BinaryWrite::write(value, &mut self.w)?;
+        self.state = 1;
+        Ok(())
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1, false));
+        }
+        self.w.flush()
+    }
+}
+
+/// Reads the ProtocolWithOptionalDate protocol in binary format.
+pub struct BinaryProtocolWithOptionalDateReader<R: Read> {
+    r: BinaryReader<R>,
+    state: usize,
+}
+
+impl<R: Read> BinaryProtocolWithOptionalDateReader<R> {
+    /// Reads the protocol header and returns an error if the stream is not a binary ProtocolWithOptionalDate stream.
+    pub fn new(r: R) -> yardl::Result<Self> {
+        let mut r = BinaryReader::new(r);
+        r.read_header(Some(PROTOCOL_WITH_OPTIONAL_DATE_SCHEMA))?;
+        Ok(Self {
+            r,
+            state: 0,
+        })
+    }
+
+    fn invalid_state(&self, attempted: usize) -> yardl::Error {
+        let method_name = |state: usize| match state {
+            0 => "read_record()",
+            _ => "close()",
+        };
+        yardl::Error::Protocol {
+            expected: method_name(self.state).to_string(),
+            received: method_name(attempted).to_string(),
+        }
+    }
+}
+
+impl<R: Read> ProtocolWithOptionalDateReader for BinaryProtocolWithOptionalDateReader<R> {
+    fn read_record(&mut self) -> yardl::Result<Option<RecordWithOptionalDate>> {
+        if self.state != 0 {
+            return Err(self.invalid_state(0));
+        }
+        let value = BinaryRead::read(&mut self.r)?;
+        self.state = 1;
+        Ok(value)
+    }
+
+    fn close(&mut self) -> yardl::Result<()> {
+        if self.state != 1 {
+            return Err(self.invalid_state(1));
+        }
+        Ok(())
+    }
+}
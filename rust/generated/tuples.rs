@@ -0,0 +1,31 @@
+// Code generated by the "yardl" tool. DO NOT EDIT.
+
+#![allow(dead_code, unused_imports, unused_variables, non_camel_case_types)]
+
+use std::collections::HashMap;
+use std::io::{Read, Write};
+
+use super::yardl::{self, BinaryRead, BinaryReader, BinaryWrite, BinaryWriter};
+
+#[derive(Debug, Clone, PartialEq)]
+pub struct Tuple<T1, T2> {
+    pub v1: T1,
+    pub v2: T2,
+}
+
+impl<T1: BinaryWrite, T2: BinaryWrite> BinaryWrite for Tuple<T1, T2> {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()> {
+        BinaryWrite::write(&self.v1, w)?;
+        BinaryWrite::write(&self.v2, w)?;
+        Ok(())
+    }
+}
+
+impl<T1: BinaryRead, T2: BinaryRead> BinaryRead for Tuple<T1, T2> {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self> {
+        Ok(Tuple {
+            v1: BinaryRead::read(r)?,
+            v2: BinaryRead::read(r)?,
+        })
+    }
+}
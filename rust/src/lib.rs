@@ -0,0 +1,8 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+//! The code generated from the test model, which is exercised by the tests
+//! in the tests directory.
+
+#[path = "../generated/mod.rs"]
+pub mod generated;
@@ -0,0 +1,140 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+//! Checks the bytes written by the generated Rust code against the examples in
+//! docs/reference/binary.md.
+
+use yardl_tests::generated::test_model::{
+    BinaryStreamsWriter, StreamsWriter, StringOrInt32, STREAMS_SCHEMA,
+};
+use yardl_tests::generated::tuples::Tuple;
+use yardl_tests::generated::yardl::{self, BinaryWrite, BinaryWriter, Date, Duration, Uuid};
+
+fn encode<T: BinaryWrite + ?Sized>(value: &T) -> Vec<u8> {
+    let mut buf = Vec::new();
+    let mut w = BinaryWriter::new(&mut buf);
+    value.write(&mut w).unwrap();
+    w.flush().unwrap();
+    drop(w);
+    buf
+}
+
+#[test]
+fn header() -> yardl::Result<()> {
+    let mut buf = Vec::new();
+    let mut w = BinaryStreamsWriter::new(&mut buf)?;
+    w.end_int_data()?;
+    w.end_optional_int_data()?;
+    w.end_record_with_optional_vector_data()?;
+    w.end_fixed_vector()?;
+    w.close()?;
+    drop(w);
+
+    let mut expected = b"yardl".to_vec();
+    expected.extend_from_slice(&[0x01, 0x00, 0x00, 0x00]);
+    expected.extend(encode(STREAMS_SCHEMA));
+    expected.extend_from_slice(&[0x00, 0x00, 0x00, 0x00]);
+    assert_eq!(buf, expected);
+    Ok(())
+}
+
+#[test]
+fn integers() {
+    assert_eq!(encode(&-88i8), [0xa8]);
+    assert_eq!(encode(&200u8), [0xc8]);
+
+    for (value, expected) in [
+        (0u64, &[0x00][..]),
+        (1, &[0x01]),
+        (127, &[0x7f]),
+        (128, &[0x80, 0x01]),
+        (129, &[0x81, 0x01]),
+    ] {
+        assert_eq!(encode(&value), expected, "{}", value);
+        assert_eq!(encode(&(value as u16)), expected, "{}", value);
+        assert_eq!(encode(&(value as u32)), expected, "{}", value);
+    }
+
+    for (value, expected) in [(0i64, 0u8), (-1, 1), (1, 2), (-2, 3), (2, 4)] {
+        assert_eq!(encode(&value), [expected], "{}", value);
+        assert_eq!(encode(&(value as i16)), [expected], "{}", value);
+        assert_eq!(encode(&(value as i32)), [expected], "{}", value);
+    }
+}
+
+#[test]
+fn strings_and_uuids() -> yardl::Result<()> {
+    assert_eq!(encode("hello"), [0x05, 0x68, 0x65, 0x6c, 0x6c, 0x6f]);
+
+    let id: Uuid = "123e4567-e89b-12d3-a456-426614174000".parse()?;
+    assert_eq!(
+        encode(&id),
+        [
+            0x12, 0x3e, 0x45, 0x67, 0xe8, 0x9b, 0x12, 0xd3, 0xa4, 0x56, 0x42, 0x66, 0x14, 0x17,
+            0x40, 0x00
+        ]
+    );
+    Ok(())
+}
+
+#[test]
+fn dates_and_durations() {
+    assert_eq!(encode(&Date(-1)), [0x01]);
+    assert_eq!(encode(&Duration(64)), [0x80, 0x01]);
+}
+
+#[test]
+fn optionals_and_unions() {
+    assert_eq!(encode(&None::<u32>), [0x00]);
+    assert_eq!(encode(&Some(6u32)), [0x01, 0x06]);
+    assert_eq!(encode(&StringOrInt32::Int32(6)), [0x01, 0x0c]);
+    assert_eq!(
+        encode(&StringOrInt32::String("a".to_string())),
+        [0x00, 0x01, 0x61]
+    );
+}
+
+#[test]
+fn vectors_and_maps() {
+    assert_eq!(encode(&vec![1i32, -1]), [0x02, 0x02, 0x01]);
+    assert_eq!(encode(&[1i32, -1]), [0x02, 0x01]);
+    assert_eq!(
+        encode(&vec![("a".to_string(), 1i32)]),
+        [0x01, 0x01, 0x61, 0x02]
+    );
+}
+
+// The example at the end of the reference: a float[2,2] array followed by a
+// stream of records written in blocks of 3 and 2.
+#[test]
+fn example() {
+    let float_array = [[1.2f32, 3.4], [5.6, 7.8]];
+    let points = [
+        Tuple { v1: 1u64, v2: 2i32 },
+        Tuple { v1: 3, v2: 4 },
+        Tuple { v1: 5, v2: 6 },
+        Tuple { v1: 700, v2: 800 },
+        Tuple {
+            v1: 800000,
+            v2: -900000,
+        },
+    ];
+
+    let mut buf = Vec::new();
+    let mut w = BinaryWriter::new(&mut buf);
+    float_array.write(&mut w).unwrap();
+    w.write_block(&points[..3]).unwrap();
+    w.write_block(&points[3..]).unwrap();
+    w.write_end_of_stream().unwrap();
+    w.flush().unwrap();
+    drop(w);
+
+    assert_eq!(
+        buf,
+        [
+            0x9a, 0x99, 0x99, 0x3f, 0x9a, 0x99, 0x59, 0x40, 0x33, 0x33, 0xb3, 0x40, 0x9a, 0x99,
+            0xf9, 0x40, 0x03, 0x01, 0x04, 0x03, 0x08, 0x05, 0x0c, 0x02, 0xbc, 0x05, 0xc0, 0x0c,
+            0x80, 0xea, 0x30, 0xbf, 0xee, 0x6d, 0x00
+        ]
+    );
+}
@@ -0,0 +1,73 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+use std::io::Write;
+use std::path::PathBuf;
+use std::process::{Command, Stdio};
+
+use yardl_tests::generated::yardl;
+
+fn translator_path() -> PathBuf {
+    PathBuf::from(env!("CARGO_MANIFEST_DIR")).join("../cpp/build/translator")
+}
+
+/// Runs the C++ translator, which reads the input in one format and writes it
+/// in another.
+pub fn invoke_translator(input: &[u8], input_format: &str, output_format: &str) -> Vec<u8> {
+    let mut child = Command::new(translator_path())
+        .args([input_format, output_format])
+        .stdin(Stdio::piped())
+        .stdout(Stdio::piped())
+        .stderr(Stdio::piped())
+        .spawn()
+        .expect("failed to start the translator (run `just build-translator` to build it)");
+
+    // Write the input on another thread so that a large output cannot block the translator
+    let mut stdin = child.stdin.take().unwrap();
+    let input = input.to_vec();
+    let writer = std::thread::spawn(move || stdin.write_all(&input));
+
+    let output = child.wait_with_output().unwrap();
+    writer.join().unwrap().unwrap();
+    assert!(
+        output.status.success(),
+        "translator failed: {}",
+        String::from_utf8_lossy(&output.stderr)
+    );
+    output.stdout
+}
+
+/// Calls `read` with the binary data written by Rust, and with the data after
+/// the C++ translator has read it and written it again, both directly and
+/// through NDJSON.
+pub fn verify_round_trip(data: &[u8], read: impl Fn(&[u8]) -> yardl::Result<()>) {
+    let inputs = [
+        ("Rust", data.to_vec()),
+        ("C++", invoke_translator(data, "binary", "binary")),
+        (
+            "C++ NDJSON",
+            invoke_translator(
+                &invoke_translator(data, "binary", "ndjson"),
+                "ndjson",
+                "binary",
+            ),
+        ),
+    ];
+
+    for (name, input) in inputs {
+        // Printed output is shown when the test fails
+        println!("reading the data from {}", name);
+        if let Err(e) = read(&input) {
+            panic!("{}: {}", name, e);
+        }
+    }
+}
+
+/// Reads a protocol stream to its end.
+pub fn read_stream<T>(mut read: impl FnMut() -> yardl::Result<Option<T>>) -> yardl::Result<Vec<T>> {
+    let mut items = Vec::new();
+    while let Some(item) = read()? {
+        items.push(item);
+    }
+    Ok(items)
+}
@@ -0,0 +1,792 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+//! Writes each protocol with the generated Rust code and reads it back, both
+//! directly and after the C++ translator has read and rewritten it.
+
+mod common;
+
+use std::collections::HashMap;
+
+use common::{invoke_translator, read_stream, verify_round_trip};
+use yardl_tests::generated::basic_types::{
+    self, GenericNullableUnion2, Int32OrString, RecordWithString, RecordWithStringOrInt32,
+    RecordWithUnions, TimeOrDatetime,
+};
+use yardl_tests::generated::test_model::*;
+use yardl_tests::generated::tuples::Tuple;
+use yardl_tests::generated::yardl::{
+    self, Complex, Date, DateTime, Duration, DynamicNDArray, NDArray, Time, Uuid,
+};
+
+#[test]
+fn scalars() -> yardl::Result<()> {
+    let rec = RecordWithPrimitives {
+        bool_field: true,
+        int8_field: -88,
+        uint8_field: 88,
+        int16_field: -1616,
+        uint16_field: 1616,
+        int32_field: -3232,
+        uint32_field: 3232,
+        int64_field: -64646464,
+        uint64_field: 64646464,
+        size_field: 64646464,
+        float32_field: 32.0,
+        float64_field: 64.64,
+        complexfloat32_field: Complex::new(32.0, 64.0),
+        complexfloat64_field: Complex::new(64.64, 32.32),
+        date_field: Date(19815),
+        time_field: Time::from_hms_nano(12, 34, 56, 0),
+        datetime_field: DateTime(1_712_061_296_111_222_333),
+    };
+
+    let mut buf = Vec::new();
+    let mut w = BinaryScalarsWriter::new(&mut buf)?;
+    w.write_int32(&42)?;
+    w.write_record(&rec)?;
+    w.close()?;
+    drop(w);
+
+    verify_round_trip(&buf, |data| {
+        let mut r = BinaryScalarsReader::new(data)?;
+        assert_eq!(r.read_int32()?, 42);
+        assert_eq!(r.read_record()?, rec);
+        r.close()
+    });
+    Ok(())
+}
+
+#[test]
+fn scalar_optionals() -> yardl::Result<()> {
+    let mut buf = Vec::new();
+    let mut w = BinaryScalarOptionalsWriter::new(&mut buf)?;
+    w.write_optional_int(&None)?;
+    w.write_optional_record(&None)?;
+    let empty = RecordWithOptionalFields {
+        optional_int: None,
+        optional_int_alternate_syntax: None,
+        optional_time: None,
+    };
+    w.write_record_with_optional_fields(&empty)?;
+    w.write_optional_record_with_optional_fields(&None)?;
+    w.close()?;
+    drop(w);
+
+    verify_round_trip(&buf, |data| {
+        let mut r = BinaryScalarOptionalsReader::new(data)?;
+        assert_eq!(r.read_optional_int()?, None);
+        assert_eq!(r.read_optional_record()?, None);
+        assert_eq!(r.read_record_with_optional_fields()?, empty);
+        assert_eq!(r.read_optional_record_with_optional_fields()?, None);
+        r.close()
+    });
+
+    let rec = RecordWithOptionalFields {
+        optional_int: Some(44),
+        optional_int_alternate_syntax: Some(45),
+        optional_time: Some(Time::from_hms_nano(12, 34, 56, 0)),
+    };
+
+    let mut buf = Vec::new();
+    let mut w = BinaryScalarOptionalsWriter::new(&mut buf)?;
+    w.write_optional_int(&Some(55))?;
+    w.write_optional_record(&Some(SimpleRecord { x: 8, y: 9, z: 10 }))?;
+    w.write_record_with_optional_fields(&rec)?;
+    w.write_optional_record_with_optional_fields(&Some(rec.clone()))?;
+    w.close()?;
+    drop(w);
+
+    verify_round_trip(&buf, |data| {
+        let mut r = BinaryScalarOptionalsReader::new(data)?;
+        assert_eq!(r.read_optional_int()?, Some(55));
+        assert_eq!(
+            r.read_optional_record()?,
+            Some(SimpleRecord { x: 8, y: 9, z: 10 })
+        );
+        assert_eq!(r.read_record_with_optional_fields()?, rec);
+        assert_eq!(
+            r.read_optional_record_with_optional_fields()?,
+            Some(rec.clone())
+        );
+        r.close()
+    });
+    Ok(())
+}
+
+#[test]
+fn variable_length_vectors() -> yardl::Result<()> {
+    let rec = RecordWithVlens {
+        a: vec![
+            SimpleRecord { x: 1, y: 2, z: 3 },
+            SimpleRecord { x: 4, y: 5, z: 6 },
+        ],
+        b: 4,
+        c: 2,
+    };
+    let complexes = vec![Complex::new(1.0, 2.0), Complex::new(3.0, 4.0)];
+
+    let mut buf = Vec::new();
+    let mut w = BinaryVlensWriter::new(&mut buf)?;
+    w.write_int_vector(&vec![1, 2, 3])?;
+    w.write_complex_vector(&complexes)?;
+    w.write_record_with_vlens(&rec)?;
+    w.write_vlen_of_record_with_vlens(&vec![rec.clone(), rec.clone()])?;
+    w.close()?;
+    drop(w);
+
+    verify_round_trip(&buf, |data| {
+        let mut r = BinaryVlensReader::new(data)?;
+        assert_eq!(r.read_int_vector()?, vec![1, 2, 3]);
+        assert_eq!(r.read_complex_vector()?, complexes);
+        assert_eq!(r.read_record_with_vlens()?, rec);
+        assert_eq!(
+            r.read_vlen_of_record_with_vlens()?,
+            vec![rec.clone(), rec.clone()]
+        );
+        r.close()
+    });
+    Ok(())
+}
+
+#[test]
+fn strings() -> yardl::Result<()> {
+    let rec = RecordWithStrings {
+        a: "Montréal".to_string(),
+        b: "臺北市".to_string(),
+    };
+
+    let mut buf = Vec::new();
+    let mut w = BinaryStringsWriter::new(&mut buf)?;
+    w.write_single_string(&"hello".to_string())?;
+    w.write_rec_with_string(&rec)?;
+    w.close()?;
+    drop(w);
+
+    verify_round_trip(&buf, |data| {
+        let mut r = BinaryStringsReader::new(data)?;
+        assert_eq!(r.read_single_string()?, "hello");
+        assert_eq!(r.read_rec_with_string()?, rec);
+        r.close()
+    });
+    Ok(())
+}
+
+#[test]
+fn bytes() -> yardl::Result<()> {
+    let rec = RecordWithBytes {
+        data: vec![0x00, 0xff, 0x10],
+        optional_data: Some(b"abc".to_vec()),
+        chunks: vec![vec![0x01], vec![0x02, 0x03]],
+    };
+
+    let mut buf = Vec::new();
+    let mut w = BinaryProtocolWithBytesWriter::new(&mut buf)?;
+    w.write_single_bytes(&vec![0xde, 0xad, 0xbe, 0xef])?;
+    w.write_rec_with_bytes(&rec)?;
+    w.close()?;
+    drop(w);
+
+    verify_round_trip(&buf, |data| {
+        let mut r = BinaryProtocolWithBytesReader::new(data)?;
+        assert_eq!(r.read_single_bytes()?, vec![0xde, 0xad, 0xbe, 0xef]);
+        assert_eq!(r.read_rec_with_bytes()?, rec);
+        r.close()
+    });
+    Ok(())
+}
+
+#[test]
+fn uuids() -> yardl::Result<()> {
+    let id: Uuid = "123e4567-e89b-12d3-a456-426614174000".parse()?;
+    let other: Uuid = "00000000-0000-0000-0000-0000000000ff".parse()?;
+    let rec = RecordWithUuids {
+        id,
+        optional_id: Some(other),
+        related: vec![other, id],
+        names: HashMap::from([(id, "first".to_string()), (other, "second".to_string())]),
+    };
+
+    let mut buf = Vec::new();
+    let mut w = BinaryProtocolWithUuidsWriter::new(&mut buf)?;
+    w.write_single_uuid(&id)?;
+    w.write_rec_with_uuids(&rec)?;
+    w.close()?;
+    drop(w);
+
+    verify_round_trip(&buf, |data| {
+        let mut r = BinaryProtocolWithUuidsReader::new(data)?;
+        assert_eq!(r.read_single_uuid()?, id);
+        assert_eq!(r.read_rec_with_uuids()?, rec);
+        r.close()
+    });
+    Ok(())
+}
+
+#[test]
+fn durations() -> yardl::Result<()> {
+    let start = 1_700_000_000_000_000_000;
+    let rec = RecordWithDurations {
+        acquisition_start: DateTime(start),
+        acquisition_end: DateTime(start + 2_500_000_000),
+        repetition_time: Duration(3_000_000),
+        timeout: Some(Duration(-3_600_000_000_000)),
+        intervals: vec![Duration(1), Duration(0), Duration(-30_000_000_000)],
+    };
+
+    let mut buf = Vec::new();
+    let mut w = BinaryProtocolWithDurationsWriter::new(&mut buf)?;
+    w.write_single_duration(&Duration(-1_500_000_000))?;
+    w.write_rec_with_durations(&rec)?;
+    w.close()?;
+    drop(w);
+
+    verify_round_trip(&buf, |data| {
+        let mut r = BinaryProtocolWithDurationsReader::new(data)?;
+        assert_eq!(r.read_single_duration()?, Duration(-1_500_000_000));
+        assert_eq!(r.read_rec_with_durations()?, rec);
+        r.close()
+    });
+    Ok(())
+}
+
+#[test]
+fn recursive_records() -> yardl::Result<()> {
+    let leaf = TreeNode {
+        label: "leaf".to_string(),
+        children: vec![],
+    };
+    let tree = TreeNode {
+        label: "root".to_string(),
+        children: vec![
+            leaf.clone(),
+            TreeNode {
+                label: "branch".to_string(),
+                children: vec![leaf],
+            },
+        ],
+    };
+    let list = LinkedListNode {
+        value: 1,
+        next: Some(Box::new(LinkedListNode {
+            value: 2,
+            next: None,
+        })),
+    };
+    let expression = Expression {
+        name: "add".to_string(),
+        operand: Int32OrExpression::Expression(Box::new(Expression {
+            name: "neg".to_string(),
+            operand: Int32OrExpression::Int32(3),
+            arguments: HashMap::new(),
+        })),
+        arguments: HashMap::from([(
+            "x".to_string(),
+            Expression {
+                name: "x".to_string(),
+                operand: Int32OrExpression::Int32(4),
+                arguments: HashMap::new(),
+            },
+        )]),
+    };
+
+    let mut buf = Vec::new();
+    let mut w = BinaryProtocolWithRecursiveRecordsWriter::new(&mut buf)?;
+    w.write_tree(&tree)?;
+    w.write_list(&list)?;
+    w.write_expressions(&[expression.clone()])?;
+    w.end_expressions()?;
+    w.close()?;
+    drop(w);
+
+    verify_round_trip(&buf, |data| {
+        let mut r = BinaryProtocolWithRecursiveRecordsReader::new(data)?;
+        assert_eq!(r.read_tree()?, tree);
+        assert_eq!(r.read_list()?, list);
+        assert_eq!(
+            read_stream(|| r.read_expressions())?,
+            vec![expression.clone()]
+        );
+        r.close()
+    });
+    Ok(())
+}
+
+#[test]
+fn sub_protocols() -> yardl::Result<()> {
+    let mut buf = Vec::new();
+    let mut w = BinaryProtocolWithSubProtocolsWriter::new(&mut buf)?;
+    w.write_header(&"header".to_string())?;
+    {
+        let mut calibration = w.calibration();
+        calibration.write_gain(&1.5)?;
+        calibration.write_samples(&[1.0, 2.0])?;
+        calibration.write_samples(&[3.0])?;
+        calibration.end_samples()?;
+        calibration.close()?;
+    }
+    w.write_data(&[1, 2, 3])?;
+    w.end_data()?;
+    w.recalibration().write_gain(&2.5)?;
+    w.recalibration().end_samples()?;
+    w.close()?;
+    drop(w);
+
+    verify_round_trip(&buf, |data| {
+        let mut r = BinaryProtocolWithSubProtocolsReader::new(data)?;
+        assert_eq!(r.read_header()?, "header");
+        {
+            let mut calibration = r.calibration();
+            assert_eq!(calibration.read_gain()?, 1.5);
+            assert_eq!(
+                read_stream(|| calibration.read_samples())?,
+                vec![1.0, 2.0, 3.0]
+            );
+            calibration.close()?;
+        }
+        assert_eq!(read_stream(|| r.read_data())?, vec![1, 2, 3]);
+        assert_eq!(r.read_recalibration_gain()?, 2.5);
+        assert_eq!(
+            read_stream(|| r.read_recalibration_samples())?,
+            Vec::<f64>::new()
+        );
+        r.close()
+    });
+    Ok(())
+}
+
+#[test]
+fn half_precision() -> yardl::Result<()> {
+    let halves: Vec<_> = [1.5, -0.25, 65504.0, f32::INFINITY]
+        .into_iter()
+        .map(yardl::Float16::from_f32)
+        .collect();
+    let brain_array = [1.0, 2.5, 1024.0, -0.5, 0.0, 7.0]
+        .into_iter()
+        .map(yardl::BFloat16::from_f32)
+        .collect();
+    let rec = RecordWithHalfPrecision {
+        half: yardl::Float16::from_f32(6.103515625e-05),
+        brain: yardl::BFloat16::from_f32(-(2.0f32.powi(100))),
+        half_vector: vec![
+            yardl::Float16::from_f32(0.0),
+            yardl::Float16::from_f32(-2.0),
+        ],
+        brain_array: DynamicNDArray {
+            shape: vec![2, 3],
+            data: brain_array,
+        },
+    };
+
+    let mut buf = Vec::new();
+    let mut w = BinaryProtocolWithHalfPrecisionWriter::new(&mut buf)?;
+    w.write_halves(&halves)?;
+    w.write_rec_with_halves(&rec)?;
+    w.close()?;
+    drop(w);
+
+    // JSON has no representation of infinity, so this does not go through NDJSON
+    for input in [buf.clone(), invoke_translator(&buf, "binary", "binary")] {
+        let mut r = BinaryProtocolWithHalfPrecisionReader::new(&input[..])?;
+        assert_eq!(r.read_halves()?, halves);
+        assert_eq!(r.read_rec_with_halves()?, rec);
+        r.close()?;
+    }
+    Ok(())
+}
+
+#[test]
+fn fixed_vectors() -> yardl::Result<()> {
+    let vlens = RecordWithVlens {
+        a: vec![SimpleRecord { x: 1, y: 2, z: 3 }],
+        b: 5,
+        c: 6,
+    };
+    let rec = RecordWithFixedVectors {
+        fixed_int_vector: [1, 2, 3, 4, 5],
+        fixed_simple_record_vector: [
+            SimpleRecord { x: 1, y: 2, z: 3 },
+            SimpleRecord { x: 4, y: 5, z: 6 },
+            SimpleRecord { x: 7, y: 8, z: 9 },
+        ],
+        fixed_record_with_vlens_vector: [vlens.clone(), vlens],
+    };
+
+    let mut buf = Vec::new();
+    let mut w = BinaryFixedVectorsWriter::new(&mut buf)?;
+    w.write_fixed_int_vector(&rec.fixed_int_vector)?;
+    w.write_fixed_simple_record_vector(&rec.fixed_simple_record_vector)?;
+    w.write_fixed_record_with_vlens_vector(&rec.fixed_record_with_vlens_vector)?;
+    w.write_record_with_fixed_vectors(&rec)?;
+    w.close()?;
+    drop(w);
+
+    verify_round_trip(&buf, |data| {
+        let mut r = BinaryFixedVectorsReader::new(data)?;
+        assert_eq!(r.read_fixed_int_vector()?, rec.fixed_int_vector);
+        assert_eq!(
+            r.read_fixed_simple_record_vector()?,
+            rec.fixed_simple_record_vector
+        );
+        assert_eq!(
+            r.read_fixed_record_with_vlens_vector()?,
+            rec.fixed_record_with_vlens_vector
+        );
+        assert_eq!(r.read_record_with_fixed_vectors()?, rec);
+        r.close()
+    });
+    Ok(())
+}
+
+#[test]
+fn streams() -> yardl::Result<()> {
+    let no_vector = RecordWithOptionalVector {
+        optional_vector: None,
+    };
+    let optional_vector = RecordWithOptionalVector {
+        optional_vector: Some(vec![1, 2, 3]),
+    };
+
+    let mut buf = Vec::new();
+    let mut w = BinaryStreamsWriter::new(&mut buf)?;
+    w.write_int_data(&[1, 2])?;
+    w.write_int_data(&[3, 4, 5])?;
+    w.end_int_data()?;
+    w.write_optional_int_data(&[Some(1), None, Some(3)])?;
+    w.end_optional_int_data()?;
+    w.write_record_with_optional_vector_data(&[no_vector.clone(), optional_vector.clone()])?;
+    w.end_record_with_optional_vector_data()?;
+    w.end_fixed_vector()?;
+    w.close()?;
+    drop(w);
+
+    verify_round_trip(&buf, |data| {
+        let mut r = BinaryStreamsReader::new(data)?;
+        assert_eq!(read_stream(|| r.read_int_data())?, vec![1, 2, 3, 4, 5]);
+        assert_eq!(
+            read_stream(|| r.read_optional_int_data())?,
+            vec![Some(1), None, Some(3)]
+        );
+        assert_eq!(
+            read_stream(|| r.read_record_with_optional_vector_data())?,
+            vec![no_vector.clone(), optional_vector.clone()]
+        );
+        assert_eq!(
+            read_stream(|| r.read_fixed_vector())?,
+            Vec::<[i32; 3]>::new()
+        );
+        r.close()
+    });
+    Ok(())
+}
+
+#[test]
+fn dynamic_nd_arrays() -> yardl::Result<()> {
+    let ints = DynamicNDArray {
+        shape: vec![2, 3],
+        data: vec![1, 2, 3, 4, 5, 6],
+    };
+    let records = DynamicNDArray {
+        shape: vec![1, 2],
+        data: vec![
+            SimpleRecord { x: 1, y: 2, z: 3 },
+            SimpleRecord { x: 4, y: 5, z: 6 },
+        ],
+    };
+    let vlens = DynamicNDArray {
+        shape: vec![1, 1],
+        data: vec![RecordWithVlens {
+            a: vec![SimpleRecord { x: 1, y: 2, z: 3 }],
+            b: 4,
+            c: 5,
+        }],
+    };
+    let rec = RecordWithDynamicNDArrays {
+        ints: ints.clone(),
+        simple_record_array: records.clone(),
+        record_with_vlens_array: vlens.clone(),
+    };
+
+    let mut buf = Vec::new();
+    let mut w = BinaryDynamicNDArraysWriter::new(&mut buf)?;
+    w.write_ints(&ints)?;
+    w.write_simple_record_array(&records)?;
+    w.write_record_with_vlens_array(&vlens)?;
+    w.write_record_with_dynamic_nd_arrays(&rec)?;
+    w.close()?;
+    drop(w);
+
+    verify_round_trip(&buf, |data| {
+        let mut r = BinaryDynamicNDArraysReader::new(data)?;
+        assert_eq!(r.read_ints()?, ints);
+        assert_eq!(r.read_simple_record_array()?, records);
+        assert_eq!(r.read_record_with_vlens_array()?, vlens);
+        assert_eq!(r.read_record_with_dynamic_nd_arrays()?, rec);
+        r.close()
+    });
+    Ok(())
+}
+
+#[test]
+fn maps() -> yardl::Result<()> {
+    let string_to_int = HashMap::from([
+        ("a".to_string(), 1),
+        ("b".to_string(), 2),
+        ("c".to_string(), 3),
+    ]);
+    let int_to_string = HashMap::from([
+        (1, "a".to_string()),
+        (2, "b".to_string()),
+        (3, "c".to_string()),
+    ]);
+    let string_to_union = HashMap::from([
+        ("a".to_string(), StringOrInt32::Int32(1)),
+        ("b".to_string(), StringOrInt32::String("2".to_string())),
+    ]);
+    // Maps with generic keys are vectors of pairs, whose order other languages need not keep
+    let mut aliased_generic: basic_types::AliasedMap<String, i32> =
+        string_to_int.clone().into_iter().collect();
+    aliased_generic.sort();
+    let records = vec![RecordWithMaps {
+        set_1: HashMap::from([(1, 1), (2, 2)]),
+        set_2: HashMap::from([(-1, true), (3, false)]),
+        set_3: HashMap::from([("x".to_string(), StringOrInt32::String("y".to_string()))]),
+    }];
+
+    let mut buf = Vec::new();
+    let mut w = BinaryMapsWriter::new(&mut buf)?;
+    w.write_string_to_int(&string_to_int)?;
+    w.write_int_to_string(&int_to_string)?;
+    w.write_string_to_union(&string_to_union)?;
+    w.write_aliased_generic(&aliased_generic)?;
+    w.write_records(&records)?;
+    w.close()?;
+    drop(w);
+
+    verify_round_trip(&buf, |data| {
+        let mut r = BinaryMapsReader::new(data)?;
+        assert_eq!(r.read_string_to_int()?, string_to_int);
+        assert_eq!(r.read_int_to_string()?, int_to_string);
+        assert_eq!(r.read_string_to_union()?, string_to_union);
+        let mut actual = r.read_aliased_generic()?;
+        actual.sort();
+        assert_eq!(actual, aliased_generic);
+        assert_eq!(r.read_records()?, records);
+        r.close()
+    });
+    Ok(())
+}
+
+#[test]
+fn unions() -> yardl::Result<()> {
+    let record_with_unions = RecordWithUnions {
+        null_or_int_or_string: Some(Int32OrString::String("hello".to_string())),
+        date_or_datetime: TimeOrDatetime::Datetime(DateTime(1_712_061_296_000_000_000)),
+        null_or_fruits_or_days_of_week: Some(GenericNullableUnion2::T2(
+            DaysOfWeek::MONDAY | DaysOfWeek::FRIDAY,
+        )),
+        record_or_int: RecordWithStringOrInt32::RecordWithString(RecordWithString {
+            i: "i".to_string(),
+        }),
+    };
+    let vlens = Int32OrRecordWithVlens::RecordWithVlens(RecordWithVlens {
+        a: vec![],
+        b: 1,
+        c: 2,
+    });
+    let vector_of_unions = vec![
+        StringOrInt32::String("hello".to_string()),
+        StringOrInt32::Int32(3),
+    ];
+
+    let mut buf = Vec::new();
+    let mut w = BinaryUnionsWriter::new(&mut buf)?;
+    w.write_int_or_simple_record(&Int32OrSimpleRecord::Int32(1))?;
+    w.write_int_or_record_with_vlens(&vlens)?;
+    w.write_monosotate_or_int_or_simple_record(&None)?;
+    w.write_vector_of_unions(&vector_of_unions)?;
+    w.write_record_with_unions(&record_with_unions)?;
+    w.close()?;
+    drop(w);
+
+    verify_round_trip(&buf, |data| {
+        let mut r = BinaryUnionsReader::new(data)?;
+        assert_eq!(
+            r.read_int_or_simple_record()?,
+            Int32OrSimpleRecord::Int32(1)
+        );
+        assert_eq!(r.read_int_or_record_with_vlens()?, vlens);
+        assert_eq!(r.read_monosotate_or_int_or_simple_record()?, None);
+        assert_eq!(r.read_vector_of_unions()?, vector_of_unions);
+        assert_eq!(r.read_record_with_unions()?, record_with_unions);
+        r.close()
+    });
+    Ok(())
+}
+
+#[test]
+fn streams_of_unions() -> yardl::Result<()> {
+    let int_or_simple_record = vec![
+        Int32OrSimpleRecord::Int32(1),
+        Int32OrSimpleRecord::SimpleRecord(SimpleRecord { x: 1, y: 2, z: 3 }),
+    ];
+    let nullable = vec![None, Some(Int32OrSimpleRecord::Int32(2)), None];
+
+    let mut buf = Vec::new();
+    let mut w = BinaryStreamsOfUnionsWriter::new(&mut buf)?;
+    w.write_int_or_simple_record(&int_or_simple_record)?;
+    w.end_int_or_simple_record()?;
+    w.write_nullable_int_or_simple_record(&nullable)?;
+    w.end_nullable_int_or_simple_record()?;
+    w.end_many_cases()?;
+    w.close()?;
+    drop(w);
+
+    verify_round_trip(&buf, |data| {
+        let mut r = BinaryStreamsOfUnionsReader::new(data)?;
+        assert_eq!(
+            read_stream(|| r.read_int_or_simple_record())?,
+            int_or_simple_record
+        );
+        assert_eq!(
+            read_stream(|| r.read_nullable_int_or_simple_record())?,
+            nullable
+        );
+        assert!(read_stream(|| r.read_many_cases())?.is_empty());
+        r.close()
+    });
+    Ok(())
+}
+
+#[test]
+fn enums() -> yardl::Result<()> {
+    let rec = RecordWithEnums {
+        enum_: Fruits::Banana,
+        flags: DaysOfWeek::MONDAY | DaysOfWeek::SUNDAY,
+        flags_2: TextFormat::BOLD,
+        rec: RecordWithNoDefaultEnum {
+            enum_: Fruits::Pear,
+        },
+    };
+
+    let mut buf = Vec::new();
+    let mut w = BinaryEnumsWriter::new(&mut buf)?;
+    w.write_single(&Fruits::Apple)?;
+    w.write_vec(&vec![Fruits::Apple, Fruits::Banana, Fruits::Pear])?;
+    w.write_size(&SizeBasedEnum::C)?;
+    w.write_rec(&rec)?;
+    w.close()?;
+    drop(w);
+
+    verify_round_trip(&buf, |data| {
+        let mut r = BinaryEnumsReader::new(data)?;
+        assert_eq!(r.read_single()?, Fruits::Apple);
+        assert_eq!(
+            r.read_vec()?,
+            vec![Fruits::Apple, Fruits::Banana, Fruits::Pear]
+        );
+        assert_eq!(r.read_size()?, SizeBasedEnum::C);
+        assert_eq!(r.read_rec()?, rec);
+        r.close()
+    });
+    Ok(())
+}
+
+#[test]
+fn flags() -> yardl::Result<()> {
+    let days = vec![
+        DaysOfWeek::SUNDAY,
+        DaysOfWeek::MONDAY | DaysOfWeek::WEDNESDAY | DaysOfWeek::FRIDAY,
+        basic_types::DaysOfWeek(0),
+        basic_types::DaysOfWeek(282839),
+        basic_types::DaysOfWeek(234532),
+    ];
+    let formats = vec![
+        TextFormat::BOLD,
+        TextFormat::BOLD | TextFormat::ITALIC,
+        TextFormat::REGULAR,
+        basic_types::TextFormat(232932),
+    ];
+
+    let mut buf = Vec::new();
+    let mut w = BinaryFlagsWriter::new(&mut buf)?;
+    w.write_days(&days)?;
+    w.end_days()?;
+    w.write_formats(&formats)?;
+    w.end_formats()?;
+    w.close()?;
+    drop(w);
+
+    verify_round_trip(&buf, |data| {
+        let mut r = BinaryFlagsReader::new(data)?;
+        assert_eq!(read_stream(|| r.read_days())?, days);
+        assert_eq!(read_stream(|| r.read_formats())?, formats);
+        r.close()
+    });
+    Ok(())
+}
+
+#[test]
+fn simple_generics() -> yardl::Result<()> {
+    let float_image = NDArray {
+        shape: [2, 2],
+        data: vec![1.0f32, 2.0, 3.0, 4.0],
+    };
+    let int_image = NDArray {
+        shape: [1, 2],
+        data: vec![1, 2],
+    };
+    let string_image = NDArray {
+        shape: [1, 2],
+        data: vec!["a".to_string(), "b".to_string()],
+    };
+    let variants = vec![
+        ImageFloatOrImageDouble::ImageFloat(float_image.clone()),
+        ImageFloatOrImageDouble::ImageDouble(NDArray {
+            shape: [1, 1],
+            data: vec![5.0],
+        }),
+    ];
+
+    let mut buf = Vec::new();
+    let mut w = BinarySimpleGenericsWriter::new(&mut buf)?;
+    w.write_float_image(&float_image)?;
+    w.write_int_image(&int_image)?;
+    w.write_int_image_alternate_syntax(&int_image)?;
+    w.write_string_image(&string_image)?;
+    w.write_int_float_tuple(&Tuple { v1: 1, v2: 2.0 })?;
+    w.write_float_float_tuple(&Tuple { v1: 1.0, v2: 2.0 })?;
+    w.write_int_float_tuple_alternate_syntax(&Tuple { v1: 1, v2: 2.0 })?;
+    w.write_int_string_tuple(&Tuple {
+        v1: 1,
+        v2: "2".to_string(),
+    })?;
+    w.write_stream_of_type_variants(&variants)?;
+    w.end_stream_of_type_variants()?;
+    w.close()?;
+    drop(w);
+
+    verify_round_trip(&buf, |data| {
+        let mut r = BinarySimpleGenericsReader::new(data)?;
+        assert_eq!(r.read_float_image()?, float_image);
+        assert_eq!(r.read_int_image()?, int_image);
+        assert_eq!(r.read_int_image_alternate_syntax()?, int_image);
+        assert_eq!(r.read_string_image()?, string_image);
+        assert_eq!(r.read_int_float_tuple()?, Tuple { v1: 1, v2: 2.0 });
+        assert_eq!(r.read_float_float_tuple()?, Tuple { v1: 1.0, v2: 2.0 });
+        assert_eq!(
+            r.read_int_float_tuple_alternate_syntax()?,
+            Tuple { v1: 1, v2: 2.0 }
+        );
+        assert_eq!(
+            r.read_int_string_tuple()?,
+            Tuple {
+                v1: 1,
+                v2: "2".to_string()
+            }
+        );
+        assert_eq!(read_stream(|| r.read_stream_of_type_variants())?, variants);
+        r.close()
+    });
+    Ok(())
+}
@@ -17,9 +17,13 @@ import (
 	"github.com/fsnotify/fsnotify"
 	"github.com/inancgumus/screen"
 	"github.com/microsoft/yardl/tooling/internal/cpp"
+	"github.com/microsoft/yardl/tooling/internal/golang"
 	"github.com/microsoft/yardl/tooling/internal/iocommon"
+	"github.com/microsoft/yardl/tooling/internal/jsonschema"
 	"github.com/microsoft/yardl/tooling/internal/matlab"
+	"github.com/microsoft/yardl/tooling/internal/protobuf"
 	"github.com/microsoft/yardl/tooling/internal/python"
+	"github.com/microsoft/yardl/tooling/internal/rust"
 	"github.com/microsoft/yardl/tooling/pkg/dsl"
 	"github.com/microsoft/yardl/tooling/pkg/packaging"
 	"github.com/spf13/cobra"
@@ -175,6 +179,18 @@ func WriteSuccessfulSummary(packageInfo *packaging.PackageInfo) {
 	if packageInfo.Matlab != nil {
 		fmt.Printf("✅ Wrote Matlab to %s.\n", packageInfo.Matlab.OutputDir)
 	}
+	if packageInfo.Go != nil {
+		fmt.Printf("✅ Wrote Go to %s.\n", packageInfo.Go.OutputDir)
+	}
+	if packageInfo.Rust != nil {
+		fmt.Printf("✅ Wrote Rust to %s.\n", packageInfo.Rust.OutputDir)
+	}
+	if packageInfo.Protobuf != nil {
+		fmt.Printf("✅ Wrote Protocol Buffers to %s.\n", packageInfo.Protobuf.OutputDir)
+	}
+	if packageInfo.JsonSchema != nil {
+		fmt.Printf("✅ Wrote JSON Schema to %s.\n", packageInfo.JsonSchema.OutputDir)
+	}
 }
 
 func generateImpl(configArgs map[string]string) (*packaging.PackageInfo, []string, error) {
@@ -225,6 +241,35 @@ func generateImpl(configArgs map[string]string) (*packaging.PackageInfo, []strin
 		}
 	}
 
+	if packageInfo.Go != nil && !packageInfo.Go.Disabled {
+		err = golang.Generate(env, *packageInfo.Go)
+		if err != nil {
+			return packageInfo, warnings, err
+		}
+	}
+
+	if packageInfo.Rust != nil && !packageInfo.Rust.Disabled {
+		err = rust.Generate(env, *packageInfo.Rust)
+		if err != nil {
+			return packageInfo, warnings, err
+		}
+	}
+
+	if packageInfo.Protobuf != nil && !packageInfo.Protobuf.Disabled {
+		protobufWarnings, err := protobuf.Generate(env, *packageInfo.Protobuf)
+		warnings = append(warnings, protobufWarnings...)
+		if err != nil {
+			return packageInfo, warnings, err
+		}
+	}
+
+	if packageInfo.JsonSchema != nil && !packageInfo.JsonSchema.Disabled {
+		err = jsonschema.Generate(env, *packageInfo.JsonSchema)
+		if err != nil {
+			return packageInfo, warnings, err
+		}
+	}
+
 	return packageInfo, warnings, err
 }
 
@@ -0,0 +1,77 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/microsoft/yardl/tooling/internal/jsonschema"
+	"github.com/microsoft/yardl/tooling/internal/protobuf"
+	"github.com/microsoft/yardl/tooling/pkg/packaging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testModelDir = "../../../models/test"
+
+// The .proto files of the test model are checked in, and compiled by protoc
+// in CI. This makes sure that they are what the generator currently writes.
+func TestProtobufMatchesCheckedInOutput(t *testing.T) {
+	packageInfo, err := packaging.LoadPackage(testModelDir)
+	require.NoError(t, err)
+	require.NotNil(t, packageInfo.Protobuf, "the test model should have protobuf output")
+
+	env, _, err := validatePackage(packageInfo)
+	require.NoError(t, err)
+
+	options := *packageInfo.Protobuf
+	options.OutputDir = t.TempDir()
+	_, err = protobuf.Generate(env, options)
+	require.NoError(t, err)
+
+	assertSameFiles(t, packageInfo.Protobuf.OutputDir, options.OutputDir)
+}
+
+// The NDJSON written by the Python round-trip tests is validated against the
+// checked-in JSON Schema of the test model.
+func TestJsonSchemaMatchesCheckedInOutput(t *testing.T) {
+	packageInfo, err := packaging.LoadPackage(testModelDir)
+	require.NoError(t, err)
+	require.NotNil(t, packageInfo.JsonSchema, "the test model should have JSON Schema output")
+
+	env, _, err := validatePackage(packageInfo)
+	require.NoError(t, err)
+
+	options := *packageInfo.JsonSchema
+	options.OutputDir = t.TempDir()
+	require.NoError(t, jsonschema.Generate(env, options))
+
+	assertSameFiles(t, packageInfo.JsonSchema.OutputDir, options.OutputDir)
+}
+
+func assertSameFiles(t *testing.T, expectedDir, actualDir string) {
+	expectedFiles, err := filepath.Glob(filepath.Join(expectedDir, "*"))
+	require.NoError(t, err)
+	actualFiles, err := filepath.Glob(filepath.Join(actualDir, "*"))
+	require.NoError(t, err)
+
+	var expectedNames, actualNames []string
+	for _, f := range expectedFiles {
+		expectedNames = append(expectedNames, filepath.Base(f))
+	}
+	for _, f := range actualFiles {
+		actualNames = append(actualNames, filepath.Base(f))
+	}
+	require.Equal(t, expectedNames, actualNames, "run `just generate` to update %s", expectedDir)
+
+	for _, name := range actualNames {
+		expected, err := os.ReadFile(filepath.Join(expectedDir, name))
+		require.NoError(t, err)
+		actual, err := os.ReadFile(filepath.Join(actualDir, name))
+		require.NoError(t, err)
+		assert.Equal(t, string(expected), string(actual), "run `just generate` to update %s", filepath.Join(expectedDir, name))
+	}
+}
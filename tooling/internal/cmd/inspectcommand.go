@@ -0,0 +1,290 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/microsoft/yardl/tooling/internal/dynamic"
+	"github.com/microsoft/yardl/tooling/internal/formatting"
+	"github.com/microsoft/yardl/tooling/pkg/binary"
+	"github.com/microsoft/yardl/tooling/pkg/dsl"
+	"github.com/microsoft/yardl/tooling/pkg/evolution"
+	"github.com/spf13/cobra"
+)
+
+// The width of the column holding byte offsets, including the trailing padding
+const offsetColumnWidth = 10
+
+func newInspectCommand() *cobra.Command {
+	var maxItems int
+	var upgrade bool
+	var modelDir string
+
+	cmd := &cobra.Command{
+		Use:   "inspect [--max-items N] [--model DIR] [--upgrade] [FILE]",
+		Short: "Print the contents of a binary protocol file",
+		Long: `Print the protocol definition embedded in a binary protocol file, followed by
+the value of each protocol step and the byte offset at which it starts.
+
+Generated code is not needed. FILE defaults to standard input.
+
+The schema does not record which enums are flags, so when the protocol has enums,
+the package that defines them is needed. It is given with --model and defaults to
+the package in the current directory, if there is one.
+
+With --upgrade, data written with a previous version of the protocol is shown as
+the latest version of the protocol, which is taken from the package.`,
+		Aliases:               []string{"dump"},
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			configOverrides, err := cmd.Flags().GetStringToString("config")
+			if err != nil {
+				log.Fatal().Msgf("error getting config: %v", err)
+			}
+
+			input := "-"
+			if len(args) > 0 {
+				input = args[0]
+			}
+
+			if err := inspectImpl(configOverrides, modelDir, input, maxItems, upgrade, os.Stdout); err != nil {
+				log.Error().Msg(err.Error())
+				os.Exit(1)
+			}
+		},
+	}
+
+	cmd.Flags().IntVar(&maxItems, "max-items", 20, "The maximum number of stream items and elements of vectors, arrays and maps to print (0 for no limit)")
+	cmd.Flags().StringVar(&modelDir, "model", "", "The directory of the package that defines the protocol (default: the current directory, if it contains a package)")
+	cmd.Flags().BoolVar(&upgrade, "upgrade", false, "Convert the data to the latest version of the protocol in the package")
+
+	return cmd
+}
+
+// A binary protocol reader that reports the position of the values it reads
+type offsetReader interface {
+	dynamic.Reader
+	Offset() int64
+	ValueOffset() int64
+}
+
+func inspectImpl(configArgs map[string]string, modelDir, input string, maxItems int, upgrade bool, out io.Writer) error {
+	inputStream := os.Stdin
+	if input != "-" {
+		f, err := os.Open(input)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		inputStream = f
+	}
+
+	model, err := loadModelIfPresent(configArgs, modelDir)
+	if err != nil {
+		return err
+	}
+
+	var reader offsetReader
+	var env *dsl.Environment
+	var schema string
+	var upgraded bool
+	if upgrade {
+		if model == nil {
+			return errors.New("--upgrade requires a package, given with --model or in the current directory")
+		}
+		evolutionReader, err := evolution.NewReader(inputStream, model)
+		if err != nil {
+			return err
+		}
+		if _, env, err = dsl.ParseProtocolSchema([]byte(evolutionReader.Schema())); err != nil {
+			return err
+		}
+		reader = evolutionReader
+		schema = evolutionReader.PreviousSchema()
+		upgraded = evolutionReader.Change() != nil
+	} else {
+		binaryReader, err := binary.NewSchemaReader(inputStream)
+		if err != nil {
+			return err
+		}
+		if err := dynamic.ResolveFlags(binaryReader.Protocol(), model); err != nil {
+			return fmt.Errorf("%w. Use --model to give the package that defines the protocol", err)
+		}
+		reader = binaryReader
+		env = binaryReader.Environment()
+		schema = binaryReader.Schema()
+	}
+
+	protocol := reader.Protocol()
+
+	w := formatting.NewIndentedWriter(out, "  ")
+	fmt.Fprintf(w, "Schema: %d bytes, data starts at offset %d\n", len(schema), reader.Offset())
+	if upgraded {
+		w.WriteStringln("The data was written with a previous version of the protocol and is shown as the latest version")
+	}
+	w.WriteStringln("")
+	writeProtocolDefinition(w, protocol, env)
+	w.WriteStringln("")
+
+	formatter := dynamic.Formatter{MaxItems: maxItems}
+	valueIndent := strings.Repeat(" ", offsetColumnWidth)
+
+	var current *dsl.ProtocolStep
+	var itemCount int
+	nextStepIndex := 0
+
+	// Prints a summary for the current stream and for any streams that
+	// were skipped because they had no items.
+	endSteps := func(upTo int) {
+		if current != nil && current.IsStream() {
+			if maxItems > 0 && itemCount > maxItems {
+				fmt.Fprintf(w, "%s%s: ... (%d more)\n", valueIndent, current.Name, itemCount-maxItems)
+			}
+			fmt.Fprintf(w, "%s%s: %d items\n", valueIndent, current.Name, itemCount)
+		}
+		for ; nextStepIndex < upTo; nextStepIndex++ {
+			if step := protocol.Sequence[nextStepIndex]; step.IsStream() {
+				fmt.Fprintf(w, "%s%s: 0 items\n", valueIndent, step.Name)
+			}
+		}
+		current = nil
+	}
+
+	for {
+		step, value, err := reader.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return fmt.Errorf("at offset %d: %w", reader.Offset(), err)
+		}
+
+		if step != current {
+			endSteps(stepIndex(protocol, step))
+			current = step
+			itemCount = 0
+			nextStepIndex++
+		}
+
+		label := step.Name
+		if step.IsStream() {
+			label = fmt.Sprintf("%s[%d]", step.Name, itemCount)
+			itemCount++
+			if maxItems > 0 && itemCount > maxItems {
+				continue
+			}
+		}
+
+		fmt.Fprintf(w, "%-*s%s:", offsetColumnWidth, fmt.Sprintf("%08x", reader.ValueOffset()), label)
+		text := formatter.Format(value, valueIndent+"  ")
+		if !strings.HasPrefix(text, "\n") {
+			w.WriteString(" ")
+		}
+		w.WriteStringln(text)
+	}
+
+	endSteps(len(protocol.Sequence))
+	fmt.Fprintf(w, "%-*send of data\n", offsetColumnWidth, fmt.Sprintf("%08x", reader.Offset()))
+	return nil
+}
+
+func stepIndex(protocol *dsl.ProtocolDefinition, step *dsl.ProtocolStep) int {
+	for i, s := range protocol.Sequence {
+		if s == step {
+			return i
+		}
+	}
+	return len(protocol.Sequence)
+}
+
+// Writes the protocol and the type definitions from the schema in the model file syntax
+func writeProtocolDefinition(w *formatting.IndentedWriter, protocol *dsl.ProtocolDefinition, env *dsl.Environment) {
+	fmt.Fprintf(w, "%s: !protocol\n", protocol.Name)
+	w.Indented(func() {
+		w.WriteStringln("sequence:")
+		w.Indented(func() {
+			for _, step := range protocol.Sequence {
+				if step.IsStream() {
+					fmt.Fprintf(w, "%s: !stream\n", step.Name)
+					w.Indented(func() {
+						fmt.Fprintf(w, "items: %s\n", dsl.TypeToShortSyntax(step.Type.(*dsl.GeneralizedType).ToScalar(), false))
+					})
+				} else {
+					fmt.Fprintf(w, "%s: %s\n", step.Name, dsl.TypeToShortSyntax(step.Type, false))
+				}
+			}
+		})
+	})
+
+	flags := make(map[string]bool)
+	visited := make(map[dsl.TypeDefinition]bool)
+	dsl.Visit(protocol, func(self dsl.Visitor, node dsl.Node) {
+		switch node := node.(type) {
+		case *dsl.SimpleType:
+			if !visited[node.ResolvedDefinition] {
+				visited[node.ResolvedDefinition] = true
+				self.Visit(node.ResolvedDefinition)
+			}
+			return
+		case *dsl.EnumDefinition:
+			flags[node.GetQualifiedName()] = node.IsFlags
+		}
+		self.VisitChildren(node)
+	})
+
+	for _, ns := range env.Namespaces {
+		for _, td := range ns.TypeDefinitions {
+			w.WriteStringln("")
+			meta := td.GetDefinitionMeta()
+			name := meta.Name
+			if len(meta.TypeParameters) > 0 {
+				params := make([]string, len(meta.TypeParameters))
+				for i, p := range meta.TypeParameters {
+					params[i] = p.Name
+				}
+				name = fmt.Sprintf("%s<%s>", name, strings.Join(params, ", "))
+			}
+
+			switch td := td.(type) {
+			case *dsl.RecordDefinition:
+				fmt.Fprintf(w, "%s: !record\n", name)
+				w.Indented(func() {
+					w.WriteStringln("fields:")
+					w.Indented(func() {
+						for _, field := range td.Fields {
+							fmt.Fprintf(w, "%s: %s\n", field.Name, dsl.TypeToShortSyntax(field.Type, false))
+						}
+					})
+				})
+			case *dsl.EnumDefinition:
+				kind := "enum"
+				if flags[td.GetQualifiedName()] {
+					kind = "flags"
+				}
+				fmt.Fprintf(w, "%s: !%s\n", name, kind)
+				w.Indented(func() {
+					if td.BaseType != nil {
+						fmt.Fprintf(w, "base: %s\n", dsl.TypeToShortSyntax(td.BaseType, false))
+					}
+					w.WriteStringln("values:")
+					w.Indented(func() {
+						for _, v := range td.Values {
+							fmt.Fprintf(w, "%s: %s\n", v.Symbol, v.IntegerValue.String())
+						}
+					})
+				})
+			case *dsl.NamedType:
+				fmt.Fprintf(w, "%s: %s\n", name, dsl.TypeToShortSyntax(td.Type, false))
+			}
+		}
+	}
+}
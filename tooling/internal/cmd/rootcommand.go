@@ -58,6 +58,8 @@ Read more at https://github.com/microsoft/yardl`,
 	cmd.AddCommand(newInitCommand())
 	cmd.AddCommand(newGenerateCommand())
 	cmd.AddCommand(newValidateCommand())
+	cmd.AddCommand(newTranslateCommand())
+	cmd.AddCommand(newInspectCommand())
 
 	return cmd
 }
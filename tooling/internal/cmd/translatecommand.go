@@ -0,0 +1,184 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/microsoft/yardl/tooling/internal/dynamic"
+	"github.com/microsoft/yardl/tooling/pkg/dsl"
+	"github.com/microsoft/yardl/tooling/pkg/evolution"
+	"github.com/microsoft/yardl/tooling/pkg/packaging"
+	"github.com/spf13/cobra"
+)
+
+func newTranslateCommand() *cobra.Command {
+	var protocolName, from, to, modelDir string
+	var upgrade bool
+
+	cmd := &cobra.Command{
+		Use:   "translate [--protocol PROTOCOL] [--model DIR] [--upgrade] --from FORMAT --to FORMAT [INPUT [OUTPUT]]",
+		Short: "Convert protocol data between the binary and NDJSON formats",
+		Long: `Convert protocol data between the binary and NDJSON formats.
+
+The protocol is read from the schema embedded in the input, so generated code is not needed.
+INPUT and OUTPUT default to standard input and standard output, or can be given as "-".
+
+The schema does not record which enums are flags, which are written differently in NDJSON.
+When the protocol has enums, reading or writing NDJSON requires the package that defines them,
+which is given with --model and defaults to the package in the current directory, if there is one.
+
+With --upgrade, binary input written with a previous version of the protocol is converted
+to the latest version of the protocol in the package.`,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.MaximumNArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			configOverrides, err := cmd.Flags().GetStringToString("config")
+			if err != nil {
+				log.Fatal().Msgf("error getting config: %v", err)
+			}
+
+			input, output := "-", "-"
+			if len(args) > 0 {
+				input = args[0]
+			}
+			if len(args) > 1 {
+				output = args[1]
+			}
+
+			err = translateImpl(configOverrides, modelDir, protocolName, dynamic.Format(from), dynamic.Format(to), upgrade, input, output)
+			if err != nil {
+				log.Error().Msg(err.Error())
+				os.Exit(1)
+			}
+		},
+	}
+
+	cmd.Flags().StringVarP(&protocolName, "protocol", "p", "", "The expected name of the protocol")
+	cmd.Flags().StringVar(&from, "from", "", "The format of the input (binary or ndjson)")
+	cmd.Flags().StringVar(&to, "to", "", "The format of the output (binary or ndjson)")
+	cmd.Flags().StringVar(&modelDir, "model", "", "The directory of the package that defines the protocol (default: the current directory, if it contains a package)")
+	cmd.Flags().BoolVar(&upgrade, "upgrade", false, "Convert binary input to the latest version of the protocol in the package")
+	cmd.MarkFlagRequired("from")
+	cmd.MarkFlagRequired("to")
+
+	return cmd
+}
+
+func translateImpl(configArgs map[string]string, modelDir, protocolName string, from, to dynamic.Format, upgrade bool, input, output string) error {
+	for _, format := range []dynamic.Format{from, to} {
+		if format != dynamic.FormatBinary && format != dynamic.FormatNDJson {
+			return fmt.Errorf("unsupported format '%s': expected one of %v", format, dynamic.Formats)
+		}
+	}
+	if upgrade && from != dynamic.FormatBinary {
+		return fmt.Errorf("--upgrade is only supported with --from %s", dynamic.FormatBinary)
+	}
+
+	model, err := loadModelIfPresent(configArgs, modelDir)
+	if err != nil {
+		return err
+	}
+	if upgrade && model == nil {
+		return errors.New("--upgrade requires a package, given with --model or in the current directory")
+	}
+
+	inputStream := os.Stdin
+	if input != "-" {
+		f, err := os.Open(input)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		inputStream = f
+	}
+
+	var reader dynamic.Reader
+	if upgrade {
+		reader, err = evolution.NewReader(inputStream, model)
+	} else {
+		reader, err = dynamic.NewReader(from, inputStream)
+	}
+	if err != nil {
+		return err
+	}
+
+	protocol := reader.Protocol()
+	if protocolName != "" && protocolName != protocol.Name {
+		return fmt.Errorf("the input contains protocol '%s', not '%s'", protocol.Name, protocolName)
+	}
+
+	// Only NDJSON needs to know which enums are flags. The reader needs it too, because
+	// unions of an enum and flags are written without a tag.
+	if !upgrade && (from == dynamic.FormatNDJson || to == dynamic.FormatNDJson) {
+		if err := dynamic.ResolveFlags(protocol, model); err != nil {
+			return fmt.Errorf("%w. Use --model to give the package that defines the protocol", err)
+		}
+	}
+
+	outputStream := os.Stdout
+	if output != "-" {
+		f, err := os.Create(output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		outputStream = f
+	}
+
+	writer, err := dynamic.NewWriter(to, outputStream, protocol, reader.Schema())
+	if err != nil {
+		return err
+	}
+
+	for {
+		step, value, err := reader.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return err
+		}
+
+		if err := writer.Write(step, value); err != nil {
+			return err
+		}
+	}
+
+	return writer.Close()
+}
+
+// Loads and validates the package in modelDir or, if modelDir is empty, in the
+// current directory, returning nil if there is no package there.
+func loadModelIfPresent(configArgs map[string]string, modelDir string) (*dsl.Environment, error) {
+	inputDir := modelDir
+	if inputDir == "" {
+		var err error
+		if inputDir, err = os.Getwd(); err != nil {
+			return nil, err
+		}
+
+		if _, err := os.Stat(filepath.Join(inputDir, packaging.PackageFileName)); err != nil {
+			return nil, nil
+		}
+	}
+
+	packageInfo, err := packaging.LoadPackage(inputDir)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := updatePackageInfoFromArgs(packageInfo, configArgs); err != nil {
+		return nil, err
+	}
+
+	env, _, err := validatePackage(packageInfo)
+	return env, err
+}
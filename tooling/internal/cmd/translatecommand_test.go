@@ -0,0 +1,51 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/microsoft/yardl/tooling/internal/dynamic"
+	"github.com/microsoft/yardl/tooling/pkg/dsl"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// A union of an enum and flags is written to NDJSON without a tag, so reading
+// it back needs to know which of the two is the flags.
+func TestTranslateNDJsonUnionOfEnumAndFlags(t *testing.T) {
+	modelDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(modelDir, "_package.yml"), []byte("namespace: Test\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(modelDir, "model.yml"), []byte(`
+Fruit: !enum
+  values: [apple, banana]
+
+Perms: !flags
+  values: [read, write]
+
+P: !protocol
+  sequence:
+    value: [Fruit, Perms]
+`), 0644))
+
+	env, err := loadModelIfPresent(nil, modelDir)
+	require.NoError(t, err)
+	schema := dsl.GetProtocolSchemaString(env.GetTopLevelNamespace().Protocols[0], env.SymbolTable)
+	ndjson := `{"yardl":{"version":1,"schema":` + schema + "}}\n" + `{"value":["read","write"]}` + "\n"
+
+	dir := t.TempDir()
+	input := filepath.Join(dir, "input.ndjson")
+	binaryPath := filepath.Join(dir, "data.bin")
+	output := filepath.Join(dir, "output.ndjson")
+	require.NoError(t, os.WriteFile(input, []byte(ndjson), 0644))
+
+	require.NoError(t, translateImpl(nil, modelDir, "", dynamic.FormatNDJson, dynamic.FormatBinary, false, input, binaryPath))
+	require.NoError(t, translateImpl(nil, modelDir, "", dynamic.FormatBinary, dynamic.FormatNDJson, false, binaryPath, output))
+
+	actual, err := os.ReadFile(output)
+	require.NoError(t, err)
+	assert.Equal(t, ndjson, string(actual))
+}
@@ -409,6 +409,20 @@ func writeUnionSerializers(w *formatting.IndentedWriter, env *dsl.Environment) {
 func writeNamespaceDefinitions(w *formatting.IndentedWriter, ns *dsl.Namespace) {
 	if len(ns.TypeDefinitions) > 0 {
 		w.WriteStringln("namespace {")
+
+		// Recursive records are referenced before they are defined
+		hasRecursiveRecords := false
+		for _, typeDef := range ns.TypeDefinitions {
+			if rec, ok := typeDef.(*dsl.RecordDefinition); ok && rec.IsRecursive {
+				writeRwFunctionDeclaration(rec, w, true)
+				writeRwFunctionDeclaration(rec, w, false)
+				hasRecursiveRecords = true
+			}
+		}
+		if hasRecursiveRecords {
+			w.WriteStringln("")
+		}
+
 		for _, typeDef := range ns.TypeDefinitions {
 			writeSerializers(w, typeDef)
 		}
@@ -488,6 +502,14 @@ func writeRwFunctionSignature(t dsl.TypeDefinition, w *formatting.IndentedWriter
 	}
 }
 
+func writeRwFunctionDeclaration(t dsl.TypeDefinition, w *formatting.IndentedWriter, write bool) {
+	if write {
+		fmt.Fprintf(w, "[[maybe_unused]] void Write%s(yardl::binary::CodedOutputStream& stream, %s const& value);\n", t.GetDefinitionMeta().Name, common.TypeDefinitionSyntax(t))
+	} else {
+		fmt.Fprintf(w, "[[maybe_unused]] void Read%s(yardl::binary::CodedInputStream& stream, %s& value);\n", t.GetDefinitionMeta().Name, common.TypeDefinitionSyntax(t))
+	}
+}
+
 func writeRwFunctionTemplateDeclaration(t dsl.TypeDefinition, w *formatting.IndentedWriter, write bool) {
 	meta := t.GetDefinitionMeta()
 	if len(meta.TypeParameters) > 0 {
@@ -517,12 +539,18 @@ func writeTypeConversion(w *formatting.IndentedWriter, typeChange dsl.TypeChange
 
 			overflowCheck := ""
 			if dsl.GetPrimitiveKind(oldPrim) == dsl.PrimitiveKindFloatingPoint {
+				// float16 has a smaller range than bfloat16, which has the same width
 				if dsl.GetPrimitiveKind(newPrim) == dsl.PrimitiveKindInteger ||
-					(dsl.GetPrimitiveKind(newPrim) == dsl.PrimitiveKindFloatingPoint && dsl.GetPrimitiveWidth(oldPrim) > dsl.GetPrimitiveWidth(newPrim)) {
+					(dsl.GetPrimitiveKind(newPrim) == dsl.PrimitiveKindFloatingPoint && (dsl.GetPrimitiveWidth(oldPrim) > dsl.GetPrimitiveWidth(newPrim) || newPrim == dsl.Float16)) {
 					overflowCheck = fmt.Sprintf("if (%s > std::numeric_limits<%s>::max() || %s < std::numeric_limits<%s>::lowest()) {\n", rhs, common.TypeSyntax(tc.NewType()), rhs, common.TypeSyntax(tc.NewType()))
 				}
 			}
 
+			if dsl.GetPrimitiveKind(oldPrim) == dsl.PrimitiveKindInteger && newPrim == dsl.Float16 && dsl.GetPrimitiveWidth(oldPrim) >= 16 {
+				// float16 cannot represent integers above 65504
+				overflowCheck = fmt.Sprintf("if (%s > std::numeric_limits<%s>::max() || %s < std::numeric_limits<%s>::lowest()) {\n", rhs, common.TypeSyntax(tc.NewType()), rhs, common.TypeSyntax(tc.NewType()))
+			}
+
 			if dsl.GetPrimitiveKind(oldPrim) == dsl.PrimitiveKindInteger && dsl.GetPrimitiveKind(newPrim) == dsl.PrimitiveKindInteger {
 				if dsl.IsSignedPrimitive(oldPrim) {
 					if dsl.IsSignedPrimitive(newPrim) {
@@ -595,7 +623,7 @@ func writeTypeConversion(w *formatting.IndentedWriter, typeChange dsl.TypeChange
 				rhs = fmt.Sprintf("std::stol(%s)", sourceName)
 			case dsl.PrimitiveUint8, dsl.PrimitiveUint16, dsl.PrimitiveUint32, dsl.PrimitiveUint64:
 				rhs = fmt.Sprintf("std::stoul(%s)", sourceName)
-			case dsl.PrimitiveFloat32:
+			case dsl.PrimitiveFloat16, dsl.PrimitiveBFloat16, dsl.PrimitiveFloat32:
 				rhs = fmt.Sprintf("std::stof(%s)", sourceName)
 			case dsl.PrimitiveFloat64:
 				rhs = fmt.Sprintf("std::stod(%s)", sourceName)
@@ -618,6 +646,33 @@ func writeTypeConversion(w *formatting.IndentedWriter, typeChange dsl.TypeChange
 			fmt.Fprintf(w, "%s = std::to_string(%s);\n", targetName, sourceName)
 		}
 
+	case *dsl.TypeChangeBytesToString:
+		writeTypeConversion(w, tc.Inverse(), sourceName, targetName, !write)
+	case *dsl.TypeChangeStringToBytes:
+		if write {
+			fmt.Fprintf(w, "%s = std::string(reinterpret_cast<char const*>(%s.data()), %s.size());\n", targetName, sourceName, sourceName)
+		} else {
+			fmt.Fprintf(w, "%s = std::vector<std::byte>(reinterpret_cast<std::byte const*>(%s.data()), reinterpret_cast<std::byte const*>(%s.data()) + %s.size());\n", targetName, sourceName, sourceName, sourceName)
+		}
+
+	case *dsl.TypeChangeUuidToString:
+		writeTypeConversion(w, tc.Inverse(), sourceName, targetName, !write)
+	case *dsl.TypeChangeStringToUuid:
+		if write {
+			fmt.Fprintf(w, "%s = %s.ToString();\n", targetName, sourceName)
+		} else {
+			fmt.Fprintf(w, "%s = yardl::Uuid::Parse(%s);\n", targetName, sourceName)
+		}
+
+	case *dsl.TypeChangeBytesToVector:
+		writeTypeConversion(w, tc.Inverse(), sourceName, targetName, !write)
+	case *dsl.TypeChangeVectorToBytes:
+		if write {
+			fmt.Fprintf(w, "%s = std::vector<uint8_t>(reinterpret_cast<uint8_t const*>(%s.data()), reinterpret_cast<uint8_t const*>(%s.data()) + %s.size());\n", targetName, sourceName, sourceName, sourceName)
+		} else {
+			fmt.Fprintf(w, "%s = std::vector<std::byte>(reinterpret_cast<std::byte const*>(%s.data()), reinterpret_cast<std::byte const*>(%s.data()) + %s.size());\n", targetName, sourceName, sourceName, sourceName)
+		}
+
 	case *dsl.TypeChangeOptionalTypeChanged:
 		fmt.Fprintf(w, "if (%s.has_value()) {\n", sourceName)
 		w.Indented(func() {
@@ -1134,16 +1189,22 @@ func typeDefinitionRwFunction(t dsl.TypeDefinition, write bool) string {
 			switch t {
 			case dsl.Bool, dsl.Int8, dsl.Int16, dsl.Int32, dsl.Int64, dsl.Uint8, dsl.Uint16, dsl.Uint32, dsl.Uint64, dsl.Size:
 				return "Integer"
-			case dsl.Float32, dsl.Float64, dsl.ComplexFloat32, dsl.PrimitiveComplexFloat64:
+			case dsl.Float16, dsl.BFloat16, dsl.Float32, dsl.Float64, dsl.ComplexFloat32, dsl.PrimitiveComplexFloat64:
 				return "FloatingPoint"
 			case dsl.String:
 				return "String"
+			case dsl.Bytes:
+				return "Bytes"
+			case dsl.Uuid:
+				return "Uuid"
 			case dsl.Date:
 				return "Date"
 			case dsl.Time:
 				return "Time"
 			case dsl.DateTime:
 				return "DateTime"
+			case dsl.Duration:
+				return "Duration"
 			default:
 				panic(fmt.Sprintf("Unknown primitive type %s", t))
 			}
@@ -1196,13 +1257,13 @@ func typeRwFunction(t dsl.Type, write bool) string {
 				return typeRwFunction(t.Cases[0].Type, write)
 			}
 			if t.Cases.IsOptional() {
-				return fmt.Sprintf("yardl::binary::%sOptional<%s, %s>", verb(write), common.TypeSyntax(t.Cases[1].Type), typeRwFunction(t.Cases[1].Type, write))
+				return fmt.Sprintf("yardl::binary::%sOptional<%s, %s>", verb(write), common.IndirectTypeSyntax(t.Cases[1].Type), indirectRwFunction(t.Cases[1].Type, write))
 			}
 
 			templateArguments := make([]string, 2*len(t.Cases))
 			for i, c := range t.Cases {
-				templateArguments[2*i] = common.TypeSyntax(c.Type)
-				templateArguments[2*i+1] = typeRwFunction(c.Type, write)
+				templateArguments[2*i] = common.IndirectTypeSyntax(c.Type)
+				templateArguments[2*i+1] = indirectRwFunction(c.Type, write)
 			}
 
 			return fmt.Sprintf("%sUnion<%s>", verb(write), strings.Join(templateArguments, ", "))
@@ -1230,7 +1291,7 @@ func typeRwFunction(t dsl.Type, write bool) string {
 
 			return fmt.Sprintf("yardl::binary::%sDynamicNDArray<%s, %s>", verb(write), common.TypeSyntax(scalarType), scalarFunction)
 		case *dsl.Map:
-			return fmt.Sprintf("yardl::binary::%sMap<%s, %s, %s, %s>", verb(write), common.TypeSyntax(td.KeyType), common.TypeSyntax(scalarType), typeRwFunction(td.KeyType, write), scalarFunction)
+			return fmt.Sprintf("yardl::binary::%sMap<%s, %s, %s, %s>", verb(write), common.TypeSyntax(td.KeyType), common.IndirectTypeSyntax(scalarType), typeRwFunction(td.KeyType, write), indirectRwFunction(scalarType, write))
 		default:
 			panic(fmt.Sprintf("Unknown dimensionality type %T", td))
 		}
@@ -1239,6 +1300,16 @@ func typeRwFunction(t dsl.Type, write bool) string {
 	}
 }
 
+// Returns the function for reading or writing an optional or union case or a
+// map value, which is held through a yardl::Indirect if it is a reference to
+// a recursive record that is not yet defined.
+func indirectRwFunction(t dsl.Type, write bool) string {
+	if common.IsRecursiveReference(t) {
+		return fmt.Sprintf("yardl::binary::%sIndirect<%s, %s>", verb(write), common.TypeSyntax(t), typeRwFunction(t, write))
+	}
+	return typeRwFunction(t, write)
+}
+
 func BinaryWriterClassName(p *dsl.ProtocolDefinition) string {
 	return fmt.Sprintf("%sWriter", p.Name)
 }
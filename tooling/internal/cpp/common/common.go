@@ -232,10 +232,13 @@ func TypeSyntax(t dsl.Type) string {
 	case *dsl.GeneralizedType:
 		scalarString := func() string {
 			if t.Cases.IsSingle() {
+				if _, isMap := t.Dimensionality.(*dsl.Map); isMap {
+					return IndirectTypeSyntax(t.Cases[0].Type)
+				}
 				return TypeSyntax(t.Cases[0].Type)
 			}
 			if t.Cases.IsOptional() {
-				return fmt.Sprintf("std::optional<%s>", TypeSyntax(t.Cases[1].Type))
+				return fmt.Sprintf("std::optional<%s>", IndirectTypeSyntax(t.Cases[1].Type))
 			}
 
 			caseStrings := make([]string, len(t.Cases))
@@ -243,7 +246,7 @@ func TypeSyntax(t dsl.Type) string {
 				if typeCase == nil {
 					caseStrings[i] = "std::monostate"
 				} else {
-					caseStrings[i] = TypeSyntax(typeCase.Type)
+					caseStrings[i] = IndirectTypeSyntax(typeCase.Type)
 				}
 			}
 
@@ -284,6 +287,22 @@ func TypeSyntax(t dsl.Type) string {
 	}
 }
 
+// Returns the syntax of a type that is an optional or union case or a map value.
+// A reference to a recursive record that is not yet defined is held through a
+// yardl::Indirect there, since std::optional, std::variant and std::unordered_map
+// require their types to be complete. std::vector does not.
+func IndirectTypeSyntax(t dsl.Type) string {
+	if IsRecursiveReference(t) {
+		return fmt.Sprintf("yardl::Indirect<%s>", TypeSyntax(t))
+	}
+	return TypeSyntax(t)
+}
+
+func IsRecursiveReference(t dsl.Type) bool {
+	st, ok := t.(*dsl.SimpleType)
+	return ok && st.IsRecursiveReference
+}
+
 func TypeDefinitionSyntax(t dsl.TypeDefinition) string {
 	switch t := t.(type) {
 	case dsl.PrimitiveDefinition:
@@ -317,6 +336,10 @@ func PrimitiveSyntax(p dsl.PrimitiveDefinition) string {
 		return string(p) + "_t"
 	case dsl.Size:
 		return "yardl::Size"
+	case dsl.Float16:
+		return "yardl::Float16"
+	case dsl.BFloat16:
+		return "yardl::BFloat16"
 	case dsl.Float32:
 		return "float"
 	case dsl.Float64:
@@ -329,12 +352,18 @@ func PrimitiveSyntax(p dsl.PrimitiveDefinition) string {
 		return "bool"
 	case dsl.String:
 		return "std::string"
+	case dsl.Bytes:
+		return "std::vector<std::byte>"
+	case dsl.Uuid:
+		return "yardl::Uuid"
 	case dsl.Date:
 		return "yardl::Date"
 	case dsl.Time:
 		return "yardl::Time"
 	case dsl.DateTime:
 		return "yardl::DateTime"
+	case dsl.Duration:
+		return "yardl::Duration"
 	default:
 		panic(fmt.Sprintf("primitive '%v' not yet supported", p))
 	}
@@ -386,6 +415,15 @@ func EnumValueIdentifierName(name string) string {
 	return fmt.Sprintf("%s_value", prefixed)
 }
 
+func ConstantIdentifierName(name string) string {
+	prefixed := fmt.Sprintf("k%s", formatting.ToPascalCase(name))
+	if _, reserved := reservedNames[prefixed]; !reserved {
+		return prefixed
+	}
+
+	return fmt.Sprintf("%s_value", prefixed)
+}
+
 func ComputedFieldIdentifierName(name string) string {
 	pascalCased := formatting.ToPascalCase(name)
 	if _, reserved := reservedNames[pascalCased]; !reserved {
@@ -473,3 +511,41 @@ func WriteGeneratedFileHeader(w *formatting.IndentedWriter) {
 	WriteComment(w, "This file was generated by the \"yardl\" tool. DO NOT EDIT.")
 	w.WriteStringln("")
 }
+
+// Writes statements that call Validate() on the records within a value of the
+// given type, for types for which dsl.TypeHasConstraints is true.
+func WriteValidation(w *formatting.IndentedWriter, t dsl.Type, value string) {
+	writeValidation(w, t, value, 0)
+}
+
+func writeValidation(w *formatting.IndentedWriter, t dsl.Type, value string, depth int) {
+	switch t := t.(type) {
+	case *dsl.SimpleType:
+		switch td := t.ResolvedDefinition.(type) {
+		case *dsl.NamedType:
+			writeValidation(w, td.Type, value, depth)
+		case *dsl.RecordDefinition:
+			fmt.Fprintf(w, "%s.Validate();\n", value)
+		}
+	case *dsl.GeneralizedType:
+		switch t.Dimensionality.(type) {
+		case nil:
+			if t.Cases.IsSingle() {
+				writeValidation(w, t.Cases[0].Type, value, depth)
+			} else if t.Cases.IsOptional() {
+				fmt.Fprintf(w, "if (%s.has_value()) {\n", value)
+				w.Indented(func() {
+					writeValidation(w, t.Cases[1].Type, value+".value()", depth)
+				})
+				w.WriteStringln("}")
+			}
+		case *dsl.Vector:
+			item := fmt.Sprintf("item%d", depth)
+			fmt.Fprintf(w, "for (auto const& %s : %s) {\n", item, value)
+			w.Indented(func() {
+				writeValidation(w, t.ToScalar(), item, depth+1)
+			})
+			w.WriteStringln("}")
+		}
+	}
+}
@@ -63,17 +63,22 @@ func writeNamespaceDefinitions(w *formatting.IndentedWriter, ns *dsl.Namespace)
 		}
 	}
 
+	// Recursive records cannot be represented in HDF5
 	for _, t := range ns.TypeDefinitions {
 		switch t := t.(type) {
 		case *dsl.RecordDefinition:
-			writeInnerType(w, t)
+			if !recordContainsRecursiveRecord(t) {
+				writeInnerType(w, t)
+			}
 		}
 	}
 
 	for _, t := range ns.TypeDefinitions {
 		switch t := t.(type) {
 		case *dsl.RecordDefinition:
-			writeRecordDdlFunction(w, t)
+			if !recordContainsRecursiveRecord(t) {
+				writeRecordDdlFunction(w, t)
+			}
 		}
 	}
 
@@ -81,11 +86,17 @@ func writeNamespaceDefinitions(w *formatting.IndentedWriter, ns *dsl.Namespace)
 
 	if ns.IsTopLevel {
 		for _, p := range ns.Protocols {
-			writeProtocolMethods(w, p)
+			if !dsl.ProtocolContainsRecursiveRecord(p) {
+				writeProtocolMethods(w, p)
+			}
 		}
 	}
 }
 
+func recordContainsRecursiveRecord(rec *dsl.RecordDefinition) bool {
+	return dsl.TypeContainsRecursiveRecord(&dsl.SimpleType{Name: rec.Name, ResolvedDefinition: rec})
+}
+
 func getConversionBufferSizeExpression(t dsl.Type) string {
 	if containsVlen(t) {
 		return fmt.Sprintf("std::max(sizeof(%s), sizeof(%s))", innerTypeSyntax(t), common.TypeSyntax(t))
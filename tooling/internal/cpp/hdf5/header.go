@@ -37,6 +37,10 @@ func writeHeaderFile(env *dsl.Environment, options packaging.CppCodegenOptions)
 		}
 		fmt.Fprintf(w, "namespace %s::hdf5 {\n", common.NamespaceIdentifierName(ns.Name))
 		for _, protocol := range ns.Protocols {
+			if dsl.ProtocolContainsRecursiveRecord(protocol) {
+				// Recursive records cannot be represented in HDF5
+				continue
+			}
 
 			common.WriteComment(w, fmt.Sprintf("HDF5 writer for the %s protocol.", protocol.Name))
 			common.WriteComment(w, protocol.Comment)
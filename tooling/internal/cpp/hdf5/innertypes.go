@@ -293,7 +293,7 @@ func needsInnerType(node dsl.Node) bool {
 			}
 			self.VisitChildren(node)
 		case dsl.PrimitiveDefinition:
-			if t == dsl.String {
+			if t == dsl.String || t == dsl.Bytes {
 				result = true
 			}
 		case *dsl.GenericTypeParameter:
@@ -327,7 +327,7 @@ func containsVlen(node dsl.Node) bool {
 			}
 			self.VisitChildren(node)
 		case dsl.PrimitiveDefinition:
-			if t == dsl.String {
+			if t == dsl.String || t == dsl.Bytes {
 				result = true
 			}
 		default:
@@ -412,6 +412,8 @@ func innerTypeDefinitionSyntax(t dsl.TypeDefinition) string {
 		switch string(t) {
 		case "string":
 			return "yardl::hdf5::InnerVlenString"
+		case "bytes":
+			return "yardl::hdf5::InnerVlen<std::byte, std::byte>"
 		default:
 			return common.PrimitiveSyntax(t)
 		}
@@ -594,6 +596,10 @@ func typeDefinitionDdlExpression(t dsl.TypeDefinition) string {
 			return "yardl::hdf5::SizeTypeDdl()"
 		case dsl.Bool:
 			return "H5::PredType::NATIVE_HBOOL"
+		case dsl.Float16:
+			return "yardl::hdf5::Float16TypeDdl()"
+		case dsl.BFloat16:
+			return "yardl::hdf5::BFloat16TypeDdl()"
 		case dsl.Float32:
 			return "H5::PredType::NATIVE_FLOAT"
 		case dsl.Float64:
@@ -604,12 +610,18 @@ func typeDefinitionDdlExpression(t dsl.TypeDefinition) string {
 			return "yardl::hdf5::ComplexTypeDdl<double>()"
 		case dsl.String:
 			return "yardl::hdf5::InnerVlenStringDdl()"
+		case dsl.Bytes:
+			return "yardl::hdf5::InnerVlenBytesDdl()"
+		case dsl.Uuid:
+			return "yardl::hdf5::UuidTypeDdl()"
 		case dsl.Date:
 			return "yardl::hdf5::DateTypeDdl()"
 		case dsl.Time:
 			return "yardl::hdf5::TimeTypeDdl()"
 		case dsl.DateTime:
 			return "yardl::hdf5::DateTimeTypeDdl()"
+		case dsl.Duration:
+			return "yardl::hdf5::DurationTypeDdl()"
 		default:
 			log.Panic().Msgf("primitive '%v' not yet supported", t)
 		}
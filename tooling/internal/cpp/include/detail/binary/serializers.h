@@ -4,6 +4,7 @@
 #pragma once
 
 #include <complex>
+#include <cstddef>
 #include <memory>
 #include <optional>
 #include <unordered_map>
@@ -59,8 +60,20 @@ struct IsTriviallySerializable<T, typename std::enable_if_t<
                                       std::is_same_v<T, std::complex<double>>>>
     : std::true_type {
 };
+
+template <typename T>
+struct IsTriviallySerializable<T, typename std::enable_if_t<
+                                      std::is_same_v<T, yardl::Float16> ||
+                                      std::is_same_v<T, yardl::BFloat16>>>
+    : std::true_type {
+};
 #endif
 
+template <>
+struct IsTriviallySerializable<yardl::Uuid>
+    : std::true_type {
+};
+
 template <typename T, size_t N>
 struct IsTriviallySerializable<std::array<T, N>,
                                typename std::enable_if_t<IsTriviallySerializable<T>::value>>
@@ -138,6 +151,8 @@ inline void ReadInteger(CodedInputStream& stream, T& value) {
 }
 
 template <typename T, std::enable_if_t<std::is_floating_point_v<T> ||
+                                           std::is_same_v<T, yardl::Float16> ||
+                                           std::is_same_v<T, yardl::BFloat16> ||
                                            std::is_same_v<T, std::complex<float>> ||
                                            std::is_same_v<T, std::complex<double>>,
                                        bool> = true>
@@ -146,6 +161,8 @@ inline void WriteFloatingPoint(CodedOutputStream& stream, T const& value) {
 }
 
 template <typename T, std::enable_if_t<std::is_floating_point_v<T> ||
+                                           std::is_same_v<T, yardl::Float16> ||
+                                           std::is_same_v<T, yardl::BFloat16> ||
                                            std::is_same_v<T, std::complex<float>> ||
                                            std::is_same_v<T, std::complex<double>>,
                                        bool> = true>
@@ -165,6 +182,26 @@ inline void ReadString(CodedInputStream& stream, std::string& value) {
   stream.ReadBytes(value.data(), size);
 }
 
+inline void WriteBytes(CodedOutputStream& stream, std::vector<std::byte> const& value) {
+  WriteInteger(stream, value.size());
+  stream.WriteBytes(value.data(), value.size());
+}
+
+inline void ReadBytes(CodedInputStream& stream, std::vector<std::byte>& value) {
+  size_t size;
+  ReadInteger(stream, size);
+  value.resize(size);
+  stream.ReadBytes(value.data(), size);
+}
+
+inline void WriteUuid(CodedOutputStream& stream, yardl::Uuid const& value) {
+  WriteTriviallySerializable(stream, value);
+}
+
+inline void ReadUuid(CodedInputStream& stream, yardl::Uuid& value) {
+  ReadTriviallySerializable(stream, value);
+}
+
 inline void WriteDate(CodedOutputStream& stream, yardl::Date const& value) {
   auto days = value.time_since_epoch().count();
   WriteInteger(stream, days);
@@ -199,6 +236,26 @@ inline void ReadDateTime(CodedInputStream& stream, yardl::DateTime& value) {
                               std::chrono::nanoseconds>(std::chrono::nanoseconds(ns))};
 }
 
+inline void WriteDuration(CodedOutputStream& stream, yardl::Duration const& value) {
+  WriteInteger(stream, value.count());
+}
+
+inline void ReadDuration(CodedInputStream& stream, yardl::Duration& value) {
+  int64_t count;
+  ReadInteger(stream, count);
+  value = yardl::Duration(count);
+}
+
+template <typename T, Writer<T> WriteElement>
+inline void WriteIndirect(CodedOutputStream& stream, Indirect<T> const& value) {
+  WriteElement(stream, *value);
+}
+
+template <typename T, Reader<T> ReadElement>
+inline void ReadIndirect(CodedInputStream& stream, Indirect<T>& value) {
+  ReadElement(stream, *value);
+}
+
 template <typename T, Writer<T> WriteElement>
 inline void WriteOptional(CodedOutputStream& stream, std::optional<T> const& value) {
   stream.WriteByte(value.has_value());
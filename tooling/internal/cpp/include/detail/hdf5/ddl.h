@@ -5,6 +5,7 @@
 
 #include <array>
 #include <complex>
+#include <cstddef>
 #include <cstring>
 #include <memory>
 #include <optional>
@@ -32,6 +33,40 @@ static inline H5::PredType const& SizeTypeDdl() {
   return H5::PredType::NATIVE_HSIZE;
 }
 
+/**
+ * @brief Creates an HDF5 type for yardl::Float16, an IEEE 754
+ * half-precision floating-point number.
+ */
+static inline H5::FloatType Float16TypeDdl() {
+  static_assert(sizeof(yardl::Float16) == 2);
+  H5::FloatType type(H5::PredType::NATIVE_FLOAT);
+  type.setFields(15, 10, 5, 0, 10);
+  type.setSize(2);
+  type.setEbias(15);
+  return type;
+}
+
+/**
+ * @brief Creates an HDF5 type for yardl::BFloat16, which has the sign and
+ * exponent of a float and a 7-bit mantissa.
+ */
+static inline H5::FloatType BFloat16TypeDdl() {
+  static_assert(sizeof(yardl::BFloat16) == 2);
+  H5::FloatType type(H5::PredType::NATIVE_FLOAT);
+  type.setFields(15, 7, 8, 0, 7);
+  type.setSize(2);
+  type.setEbias(127);
+  return type;
+}
+
+/**
+ * @brief Creates an HDF5 type for UUIDs. These are stored as 16-byte opaque values.
+ */
+static inline H5::DataType UuidTypeDdl() {
+  static_assert(sizeof(yardl::Uuid) == 16);
+  return H5::DataType(H5T_OPAQUE, 16);
+}
+
 /**
  * @brief Creates an HDF5 type for dates. These are stored as an integer number
  * of days since the epoch.
@@ -62,6 +97,16 @@ static inline H5::DataType DateTimeTypeDdl() {
   return H5::PredType::NATIVE_INT64;
 }
 
+/**
+ * @brief Creates an HDF5 type for durations. These are stored as an int64
+ * number of nanoseconds.
+ */
+static inline H5::DataType DurationTypeDdl() {
+  static_assert(sizeof(yardl::Duration) == sizeof(int64_t));
+  static_assert(std::is_same_v<yardl::Duration::rep, int64_t>);
+  return H5::PredType::NATIVE_INT64;
+}
+
 /**
  * @brief Creates an HDF5 optional type.
  */
@@ -122,6 +167,14 @@ static inline H5::StrType InnerVlenStringDdl() {
   return type;
 }
 
+/**
+ * @brief Creates a variable-length datatype of opaque bytes.
+ */
+static inline H5::VarLenType InnerVlenBytesDdl() {
+  static_assert(sizeof(std::byte) == 1);
+  return H5::VarLenType(H5::DataType(H5T_OPAQUE, 1));
+}
+
 /**
  * @brief Creates a datatype for a fixed-length vector.
  */
@@ -4,6 +4,7 @@
 #pragma once
 
 #include <complex>
+#include <cstddef>
 #include <memory>
 #include <optional>
 #include <unordered_map>
@@ -67,6 +68,67 @@ inline bool ReadProtocolValue(std::istream& stream, std::string& line, std::stri
   return true;
 }
 
+inline std::string EncodeBase64(std::vector<std::byte> const& value) {
+  static constexpr char alphabet[] = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/";
+  std::string encoded;
+  encoded.reserve((value.size() + 2) / 3 * 4);
+  for (size_t i = 0; i < value.size(); i += 3) {
+    uint32_t n = std::to_integer<uint32_t>(value[i]) << 16;
+    if (i + 1 < value.size()) {
+      n |= std::to_integer<uint32_t>(value[i + 1]) << 8;
+    }
+    if (i + 2 < value.size()) {
+      n |= std::to_integer<uint32_t>(value[i + 2]);
+    }
+    encoded.push_back(alphabet[(n >> 18) & 0x3F]);
+    encoded.push_back(alphabet[(n >> 12) & 0x3F]);
+    encoded.push_back(i + 1 < value.size() ? alphabet[(n >> 6) & 0x3F] : '=');
+    encoded.push_back(i + 2 < value.size() ? alphabet[n & 0x3F] : '=');
+  }
+  return encoded;
+}
+
+inline std::vector<std::byte> DecodeBase64(std::string const& encoded) {
+  auto decode_char = [](char c) -> uint32_t {
+    if (c >= 'A' && c <= 'Z') {
+      return c - 'A';
+    }
+    if (c >= 'a' && c <= 'z') {
+      return c - 'a' + 26;
+    }
+    if (c >= '0' && c <= '9') {
+      return c - '0' + 52;
+    }
+    if (c == '+') {
+      return 62;
+    }
+    if (c == '/') {
+      return 63;
+    }
+    throw std::runtime_error("invalid base64 character");
+  };
+
+  if (encoded.size() % 4 != 0) {
+    throw std::runtime_error("invalid base64 length");
+  }
+
+  std::vector<std::byte> value;
+  value.reserve(encoded.size() / 4 * 3);
+  for (size_t i = 0; i < encoded.size(); i += 4) {
+    uint32_t n = decode_char(encoded[i]) << 18 | decode_char(encoded[i + 1]) << 12;
+    value.push_back(static_cast<std::byte>((n >> 16) & 0xFF));
+    if (encoded[i + 2] != '=') {
+      n |= decode_char(encoded[i + 2]) << 6;
+      value.push_back(static_cast<std::byte>((n >> 8) & 0xFF));
+      if (encoded[i + 3] != '=') {
+        n |= decode_char(encoded[i + 3]);
+        value.push_back(static_cast<std::byte>(n & 0xFF));
+      }
+    }
+  }
+  return value;
+}
+
 template <typename T>
 constexpr bool ShouldSerializeFieldValue(T const&) {
   return true;
@@ -109,6 +171,17 @@ struct adl_serializer<std::optional<T>> {
   }
 };
 
+template <typename T>
+struct adl_serializer<yardl::Indirect<T>> {
+  static void to_json(ordered_json& j, yardl::Indirect<T> const& value) {
+    j = *value;
+  }
+
+  static void from_json(ordered_json const& j, yardl::Indirect<T>& value) {
+    j.get_to(*value);
+  }
+};
+
 template <>
 struct adl_serializer<std::monostate> {
   static void to_json(ordered_json& j, [[maybe_unused]] std::monostate const& value) {
@@ -122,6 +195,50 @@ struct adl_serializer<std::monostate> {
   }
 };
 
+template <>
+struct adl_serializer<std::vector<std::byte>> {
+  static void to_json(ordered_json& j, std::vector<std::byte> const& value) {
+    j = yardl::ndjson::EncodeBase64(value);
+  }
+
+  static void from_json(ordered_json const& j, std::vector<std::byte>& value) {
+    value = yardl::ndjson::DecodeBase64(j.get<std::string>());
+  }
+};
+
+template <>
+struct adl_serializer<yardl::Float16> {
+  static void to_json(ordered_json& j, yardl::Float16 const& value) {
+    j = static_cast<float>(value);
+  }
+
+  static void from_json(ordered_json const& j, yardl::Float16& value) {
+    value = j.get<float>();
+  }
+};
+
+template <>
+struct adl_serializer<yardl::BFloat16> {
+  static void to_json(ordered_json& j, yardl::BFloat16 const& value) {
+    j = static_cast<float>(value);
+  }
+
+  static void from_json(ordered_json const& j, yardl::BFloat16& value) {
+    value = j.get<float>();
+  }
+};
+
+template <>
+struct adl_serializer<yardl::Uuid> {
+  static void to_json(ordered_json& j, yardl::Uuid const& value) {
+    j = value.ToString();
+  }
+
+  static void from_json(ordered_json const& j, yardl::Uuid& value) {
+    value = yardl::Uuid::Parse(j.get<std::string>());
+  }
+};
+
 template <>
 struct adl_serializer<yardl::Date> {
   static void to_json(ordered_json& j, yardl::Date const& value) {
@@ -167,6 +284,17 @@ struct adl_serializer<yardl::DateTime> {
   }
 };
 
+template <>
+struct adl_serializer<yardl::Duration> {
+  static void to_json(ordered_json& j, yardl::Duration const& value) {
+    j = value.count();
+  }
+
+  static void from_json(ordered_json const& j, yardl::Duration& value) {
+    value = yardl::Duration(j.get<yardl::Duration::rep>());
+  }
+};
+
 template <typename T>
 struct adl_serializer<std::complex<T>> {
   static void to_json(ordered_json& j, std::complex<T> const& value) {
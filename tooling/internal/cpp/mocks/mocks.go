@@ -269,7 +269,11 @@ func writeFactories(env *dsl.Environment, options packaging.CppCodegenOptions) e
 				w.WriteStringln("switch (format) {")
 				w.WriteStringln("case Format::kHdf5:")
 				w.Indented(func() {
-					fmt.Fprintf(w, "return std::make_unique<%s>(filename);\n", hdf5.QualifiedHdf5WriterClassName(protocol))
+					if dsl.ProtocolContainsRecursiveRecord(protocol) {
+						w.WriteStringln("throw std::runtime_error(\"Recursive records are not supported in HDF5\");")
+					} else {
+						fmt.Fprintf(w, "return std::make_unique<%s>(filename);\n", hdf5.QualifiedHdf5WriterClassName(protocol))
+					}
 				})
 				w.WriteStringln("case Format::kBinary:")
 				w.Indented(func() {
@@ -294,7 +298,11 @@ func writeFactories(env *dsl.Environment, options packaging.CppCodegenOptions) e
 				w.WriteStringln("switch (format) {")
 				w.WriteStringln("case Format::kHdf5:")
 				w.Indented(func() {
-					fmt.Fprintf(w, "return std::make_unique<%s>(filename);\n", hdf5.QualifiedHdf5ReaderClassName(protocol))
+					if dsl.ProtocolContainsRecursiveRecord(protocol) {
+						w.WriteStringln("throw std::runtime_error(\"Recursive records are not supported in HDF5\");")
+					} else {
+						fmt.Fprintf(w, "return std::make_unique<%s>(filename);\n", hdf5.QualifiedHdf5ReaderClassName(protocol))
+					}
 				})
 				w.WriteStringln("case Format::kBinary:")
 				w.Indented(func() {
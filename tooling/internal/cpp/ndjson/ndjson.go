@@ -91,7 +91,9 @@ func WriteNdJson(env *dsl.Environment, options packaging.CppCodegenOptions) erro
 	dsl.Visit(env, func(self dsl.Visitor, node dsl.Node) {
 		switch t := node.(type) {
 		case *dsl.SimpleType:
-			self.Visit(t.ResolvedDefinition)
+			if !t.IsRecursiveReference {
+				self.Visit(t.ResolvedDefinition)
+			}
 		case *dsl.GeneralizedType:
 			if t.Cases.IsUnion() {
 				// Convert the union cases to their u types so we don't generate
@@ -453,7 +455,9 @@ func writeUnionConverters(w *formatting.IndentedWriter, unionType *dsl.Generaliz
 		case *dsl.NamedType:
 			self.Visit(node.Type)
 		case *dsl.SimpleType:
-			self.Visit(node.ResolvedDefinition)
+			if !node.IsRecursiveReference {
+				self.Visit(node.ResolvedDefinition)
+			}
 		default:
 			self.VisitChildren(node)
 		}
@@ -473,7 +477,7 @@ func writeUnionConverters(w *formatting.IndentedWriter, unionType *dsl.Generaliz
 					for i, c := range unionType.Cases {
 						fmt.Fprintf(w, "case %d:\n", i)
 						w.Indented(func() {
-							fmt.Fprintf(w, "j = ordered_json{ {\"%s\", std::get<%s>(value)} };\n", c.Tag, common.TypeSyntax(c.Type))
+							fmt.Fprintf(w, "j = ordered_json{ {\"%s\", std::get<%s>(value)} };\n", c.Tag, common.IndirectTypeSyntax(c.Type))
 							w.WriteStringln("break;")
 						})
 					}
@@ -495,7 +499,7 @@ func writeUnionConverters(w *formatting.IndentedWriter, unionType *dsl.Generaliz
 					dt := ndjsoncommon.GetJsonDataType(c.Type)
 					fmt.Fprintf(w, "if (%s) {\n", getTypeCheck(dt, "j"))
 					w.Indented(func() {
-						fmt.Fprintf(w, "value = j.get<%s>();\n", common.TypeSyntax(c.Type))
+						fmt.Fprintf(w, "value = j.get<%s>();\n", common.IndirectTypeSyntax(c.Type))
 						w.WriteStringln("return;")
 					})
 					w.WriteStringln("}")
@@ -508,7 +512,7 @@ func writeUnionConverters(w *formatting.IndentedWriter, unionType *dsl.Generaliz
 				for _, v := range unionType.Cases {
 					fmt.Fprintf(w, "if (tag == \"%s\") {\n", v.Tag)
 					w.Indented(func() {
-						fmt.Fprintf(w, "value = it.value().get<%s>();\n", common.TypeSyntax(v.Type))
+						fmt.Fprintf(w, "value = it.value().get<%s>();\n", common.IndirectTypeSyntax(v.Type))
 						w.WriteStringln("return;")
 					})
 					w.WriteStringln("}")
@@ -56,7 +56,7 @@ func writeHeader(env *dsl.Environment, options packaging.CppCodegenOptions) erro
 	for _, ns := range env.Namespaces {
 		if ns.IsTopLevel {
 			fmt.Fprintf(w, "namespace %s {\n", common.NamespaceIdentifierName(ns.Name))
-			writeDeclarations(w, ns)
+			writeDeclarations(w, ns, env.SymbolTable)
 			fmt.Fprintf(w, "} // namespace %s\n", common.NamespaceIdentifierName(ns.Name))
 		}
 	}
@@ -65,7 +65,7 @@ func writeHeader(env *dsl.Environment, options packaging.CppCodegenOptions) erro
 	return iocommon.WriteFileIfNeeded(definitionsPath, b.Bytes(), 0644)
 }
 
-func writeDeclarations(w *formatting.IndentedWriter, ns *dsl.Namespace) {
+func writeDeclarations(w *formatting.IndentedWriter, ns *dsl.Namespace, symbolTable dsl.SymbolTable) {
 	fmt.Fprintf(w, "enum class Version {\n")
 	w.Indented(func() {
 		for _, v := range ns.Versions {
@@ -79,7 +79,7 @@ func writeDeclarations(w *formatting.IndentedWriter, ns *dsl.Namespace) {
 
 		// Writer
 		common.WriteComment(w, fmt.Sprintf("Abstract writer for the %s protocol.", p.Name))
-		common.WriteComment(w, p.Comment)
+		common.WriteComment(w, dsl.AnnotatedComment(p.Comment, p.Annotations))
 		fmt.Fprintf(w, "class %s {\n", common.AbstractWriterName(p))
 		w.Indented(func() {
 			fmt.Fprintln(w, "public:")
@@ -114,6 +114,17 @@ func writeDeclarations(w *formatting.IndentedWriter, ns *dsl.Namespace) {
 			common.WriteComment(w, "Flushes all buffered data.")
 			w.WriteString("virtual void Flush() {}\n\n")
 
+			if protocolHasConstraints(p, symbolTable) {
+				common.WriteComment(w, "Enables checking that values satisfy the constraints of their fields before they are written. Disabled by default.")
+				w.WriteString("void SetValidateConstraints(bool validate) { validate_constraints_ = validate; }\n\n")
+			}
+
+			for _, sub := range p.SubProtocols {
+				common.WriteComment(w, fmt.Sprintf("Returns a writer for the `%s` steps, which are written through this writer.", sub.Name))
+				common.WriteComment(w, sub.Comment)
+				fmt.Fprintf(w, "%s& %s() { return %s; }\n\n", common.AbstractWriterName(sub.Protocol), subProtocolAccessorName(sub), subWriterMemberName(sub))
+			}
+
 			w.WriteStringln("protected:")
 			for _, step := range p.Sequence {
 				fmt.Fprintf(w, "virtual void %s(%s const& value) = 0;\n", common.ProtocolWriteImplMethodName(step), common.TypeSyntax(step.Type))
@@ -131,6 +142,13 @@ func writeDeclarations(w *formatting.IndentedWriter, ns *dsl.Namespace) {
 
 			w.WriteStringln("private:")
 			w.WriteString("uint8_t state_ = 0;\n\n")
+			if protocolHasConstraints(p, symbolTable) {
+				w.WriteString("bool validate_constraints_ = false;\n\n")
+			}
+
+			for _, sub := range p.SubProtocols {
+				writeSubWriterDeclaration(w, p, sub)
+			}
 
 			fmt.Fprintf(w, "friend class %s;\n", common.AbstractReaderName(p))
 		})
@@ -173,6 +191,12 @@ func writeDeclarations(w *formatting.IndentedWriter, ns *dsl.Namespace) {
 
 			fmt.Fprintf(w, "virtual ~%s() = default;\n\n", common.AbstractReaderName(p))
 
+			for _, sub := range p.SubProtocols {
+				common.WriteComment(w, fmt.Sprintf("Returns a reader for the `%s` steps, which are read through this reader.", sub.Name))
+				common.WriteComment(w, sub.Comment)
+				fmt.Fprintf(w, "%s& %s() { return %s; }\n\n", common.AbstractReaderName(sub.Protocol), subProtocolAccessorName(sub), subReaderMemberName(sub))
+			}
+
 			w.WriteStringln("protected:")
 			for _, step := range p.Sequence {
 				returnType := "void"
@@ -194,11 +218,89 @@ func writeDeclarations(w *formatting.IndentedWriter, ns *dsl.Namespace) {
 
 			w.WriteStringln("private:")
 			w.WriteStringln("uint8_t state_ = 0;")
+
+			for _, sub := range p.SubProtocols {
+				w.WriteStringln("")
+				writeSubReaderDeclaration(w, p, sub)
+			}
 		})
 		fmt.Fprint(w, "};\n")
 	})
 }
 
+// Writes a nested class that implements the sub-protocol's writer by
+// forwarding each step to the outer writer's corresponding inlined step.
+func writeSubWriterDeclaration(w *formatting.IndentedWriter, p *dsl.ProtocolDefinition, sub *dsl.SubProtocol) {
+	className := fmt.Sprintf("%sSubWriter", subProtocolAccessorName(sub))
+	fmt.Fprintf(w, "class %s : public %s {\n", className, common.AbstractWriterName(sub.Protocol))
+	w.Indented(func() {
+		fmt.Fprintln(w, "public:")
+		fmt.Fprintf(w, "%s(%s& outer) : outer_(outer) {}\n\n", className, common.AbstractWriterName(p))
+
+		w.WriteStringln("protected:")
+		outerSteps := sub.Steps(p)
+		for i, step := range sub.Protocol.Sequence {
+			outerStep := outerSteps[i]
+			fmt.Fprintf(w, "void %s(%s const& value) override { outer_.%s(value); }\n", common.ProtocolWriteImplMethodName(step), common.TypeSyntax(step.Type), common.ProtocolWriteMethodName(outerStep))
+			if step.IsStream() {
+				fmt.Fprintf(w, "void %s(std::vector<%s> const& values) override { outer_.%s(values); }\n", common.ProtocolWriteImplMethodName(step), common.TypeSyntax(step.Type), common.ProtocolWriteMethodName(outerStep))
+				fmt.Fprintf(w, "void %s() override { outer_.%s(); }\n", common.ProtocolWriteEndImplMethodName(step), common.ProtocolWriteEndMethodName(outerStep))
+			}
+		}
+		w.WriteStringln("")
+
+		w.WriteStringln("private:")
+		fmt.Fprintf(w, "%s& outer_;\n", common.AbstractWriterName(p))
+	})
+	w.WriteString("};\n\n")
+	fmt.Fprintf(w, "%s %s{*this};\n\n", className, subWriterMemberName(sub))
+}
+
+// Writes a nested class that implements the sub-protocol's reader by
+// forwarding each step to the outer reader's corresponding inlined step.
+func writeSubReaderDeclaration(w *formatting.IndentedWriter, p *dsl.ProtocolDefinition, sub *dsl.SubProtocol) {
+	className := fmt.Sprintf("%sSubReader", subProtocolAccessorName(sub))
+	fmt.Fprintf(w, "class %s : public %s {\n", className, common.AbstractReaderName(sub.Protocol))
+	w.Indented(func() {
+		fmt.Fprintln(w, "public:")
+		fmt.Fprintf(w, "%s(%s& outer) : outer_(outer) {}\n\n", className, common.AbstractReaderName(p))
+
+		w.WriteStringln("protected:")
+		outerSteps := sub.Steps(p)
+		for i, step := range sub.Protocol.Sequence {
+			outerStep := outerSteps[i]
+			returnType := "void"
+			returnStatement := ""
+			if step.IsStream() {
+				returnType = "bool"
+				returnStatement = "return "
+			}
+			fmt.Fprintf(w, "%s %s(%s& value) override { %souter_.%s(value); }\n", returnType, common.ProtocolReadImplMethodName(step), common.TypeSyntax(step.Type), returnStatement, common.ProtocolReadMethodName(outerStep))
+			if step.IsStream() {
+				fmt.Fprintf(w, "bool %s(std::vector<%s>& values) override { return outer_.%s(values); }\n", common.ProtocolReadImplMethodName(step), common.TypeSyntax(step.Type), common.ProtocolReadMethodName(outerStep))
+			}
+		}
+		w.WriteStringln("")
+
+		w.WriteStringln("private:")
+		fmt.Fprintf(w, "%s& outer_;\n", common.AbstractReaderName(p))
+	})
+	w.WriteString("};\n\n")
+	fmt.Fprintf(w, "%s %s{*this};\n", className, subReaderMemberName(sub))
+}
+
+func subProtocolAccessorName(sub *dsl.SubProtocol) string {
+	return formatting.ToPascalCase(sub.Name)
+}
+
+func subWriterMemberName(sub *dsl.SubProtocol) string {
+	return fmt.Sprintf("%s_sub_writer_", formatting.ToSnakeCase(sub.Name))
+}
+
+func subReaderMemberName(sub *dsl.SubProtocol) string {
+	return fmt.Sprintf("%s_sub_reader_", formatting.ToSnakeCase(sub.Name))
+}
+
 func writeDefinitions(w *formatting.IndentedWriter, ns *dsl.Namespace, symbolTable dsl.SymbolTable) {
 	formatting.Delimited(w, "\n", ns.Protocols, func(w *formatting.IndentedWriter, i int, p *dsl.ProtocolDefinition) {
 		w.WriteString("namespace {\n")
@@ -246,6 +348,22 @@ func writeDefinitions(w *formatting.IndentedWriter, ns *dsl.Namespace, symbolTab
 					})
 					w.WriteString("}\n\n")
 
+					if stepType := stepValueType(step); dsl.TypeHasConstraints(stepType, symbolTable) {
+						w.WriteStringln("if (validate_constraints_) {")
+						w.Indented(func() {
+							if variableName == "values" {
+								w.WriteStringln("for (auto const& value : values) {")
+								w.Indented(func() {
+									common.WriteValidation(w, stepType, "value")
+								})
+								w.WriteStringln("}")
+							} else {
+								common.WriteValidation(w, stepType, variableName)
+							}
+						})
+						w.WriteString("}\n\n")
+					}
+
 					fmt.Fprintf(w, "%s(%s);\n", common.ProtocolWriteImplMethodName(step), variableName)
 					if !step.IsStream() {
 						fmt.Fprintf(w, "state_ = %d;\n", i+1)
@@ -562,3 +680,22 @@ func writeProtocolCopyToMethod(w *formatting.IndentedWriter, p *dsl.ProtocolDefi
 	})
 	w.WriteString("}\n")
 }
+
+// Returns the type of the values passed to the write method of a step
+func stepValueType(step *dsl.ProtocolStep) dsl.Type {
+	if step.IsStream() {
+		return step.Type.(*dsl.GeneralizedType).ToScalar()
+	}
+
+	return step.Type
+}
+
+func protocolHasConstraints(p *dsl.ProtocolDefinition, symbolTable dsl.SymbolTable) bool {
+	for _, step := range p.Sequence {
+		if dsl.TypeHasConstraints(stepValueType(step), symbolTable) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/microsoft/yardl/tooling/internal/cpp/binary"
 	"github.com/microsoft/yardl/tooling/internal/cpp/common"
+	"github.com/microsoft/yardl/tooling/internal/cpp/hdf5"
 	"github.com/microsoft/yardl/tooling/internal/cpp/ndjson"
 	"github.com/microsoft/yardl/tooling/internal/formatting"
 	"github.com/microsoft/yardl/tooling/internal/iocommon"
@@ -23,6 +24,7 @@ func WriteTranslator(env *dsl.Environment, options packaging.CppCodegenOptions)
 	common.WriteGeneratedFileHeader(w)
 
 	w.WriteStringln(`#include <iostream>
+#include <memory>
 
 #include "../format.h"
 #include "binary/protocols.h"
@@ -31,41 +33,44 @@ func WriteTranslator(env *dsl.Environment, options packaging.CppCodegenOptions)
 `)
 
 	w.WriteStringln("namespace yardl::testing {")
-	w.WriteStringln("void TranslateStream(std::string const& protocol_name, yardl::testing::Format input_format, std::istream& input, yardl::testing::Format output_format, std::ostream& output) {")
+	w.WriteStringln("// HDF5 files cannot be streamed, so they are read from and written to the given paths instead.")
+	w.WriteStringln("void TranslateStream(std::string const& protocol_name, yardl::testing::Format input_format, std::istream& input, std::string const& hdf5_input_path, yardl::testing::Format output_format, std::ostream& output, std::string const& hdf5_output_path) {")
 	w.Indented(func() {
-		w.WriteStringln("switch (input_format) {")
-		w.WriteStringln("case yardl::testing::Format::kBinary:")
-		w.Indented(func() {
-			w.WriteStringln("break;")
-		})
-		w.WriteStringln("case yardl::testing::Format::kNDJson:")
-		w.Indented(func() {
-			w.WriteStringln("break;")
-		})
-		w.WriteStringln("default:")
-		w.Indented(func() {
-			w.WriteStringln("throw std::runtime_error(\"Unsupported input format\");")
-		})
-		w.WriteStringln("}\n")
-
 		for _, ns := range env.Namespaces {
 			if !ns.IsTopLevel {
 				continue
 			}
 			for _, protocol := range ns.Protocols {
+				// HDF5 does not support recursive types
+				supportsHdf5 := !dsl.ProtocolContainsRecursiveRecord(protocol)
+
 				fmt.Fprintf(w, "if (protocol_name == \"%s\") {\n", protocol.Name)
 				w.Indented(func() {
-					w.WriteStringln("auto reader = input_format == yardl::testing::Format::kBinary")
-					w.Indented(func() {
-						fmt.Fprintf(w, "? std::unique_ptr<%s>(new %s(input))\n", common.QualifiedAbstractReaderName(protocol), binary.QualifiedBinaryReaderClassName(protocol))
-						fmt.Fprintf(w, ": std::unique_ptr<%s>(new %s(input));\n", common.QualifiedAbstractReaderName(protocol), ndjson.QualifiedNDJsonReaderClassName(protocol))
+					fmt.Fprintf(w, "std::unique_ptr<%s> reader;\n", common.QualifiedAbstractReaderName(protocol))
+					writeFormatSwitch(w, "input_format", supportsHdf5, func(format string) {
+						switch format {
+						case "kBinary":
+							fmt.Fprintf(w, "reader = std::make_unique<%s>(input);\n", binary.QualifiedBinaryReaderClassName(protocol))
+						case "kHdf5":
+							fmt.Fprintf(w, "reader = std::make_unique<%s>(hdf5_input_path);\n", hdf5.QualifiedHdf5ReaderClassName(protocol))
+						case "kNDJson":
+							fmt.Fprintf(w, "reader = std::make_unique<%s>(input);\n", ndjson.QualifiedNDJsonReaderClassName(protocol))
+						}
 					})
 					w.WriteStringln("")
-					w.WriteStringln("auto writer = output_format == yardl::testing::Format::kBinary")
-					w.Indented(func() {
-						fmt.Fprintf(w, "? std::unique_ptr<%s>(new %s(output))\n", common.QualifiedAbstractWriterName(protocol), binary.QualifiedBinaryWriterClassName(protocol))
-						fmt.Fprintf(w, ": std::unique_ptr<%s>(new %s(output));\n", common.QualifiedAbstractWriterName(protocol), ndjson.QualifiedNDJsonWriterClassName(protocol))
+
+					fmt.Fprintf(w, "std::unique_ptr<%s> writer;\n", common.QualifiedAbstractWriterName(protocol))
+					writeFormatSwitch(w, "output_format", supportsHdf5, func(format string) {
+						switch format {
+						case "kBinary":
+							fmt.Fprintf(w, "writer = std::make_unique<%s>(output);\n", binary.QualifiedBinaryWriterClassName(protocol))
+						case "kHdf5":
+							fmt.Fprintf(w, "writer = std::make_unique<%s>(hdf5_output_path);\n", hdf5.QualifiedHdf5WriterClassName(protocol))
+						case "kNDJson":
+							fmt.Fprintf(w, "writer = std::make_unique<%s>(output);\n", ndjson.QualifiedNDJsonWriterClassName(protocol))
+						}
 					})
+					w.WriteStringln("")
 
 					w.WriteStringln("reader->CopyTo(*writer);")
 					w.WriteStringln("return;")
@@ -82,3 +87,23 @@ func WriteTranslator(env *dsl.Environment, options packaging.CppCodegenOptions)
 	definitionsPath := path.Join(options.SourcesOutputDir, "translator_impl.cc")
 	return iocommon.WriteFileIfNeeded(definitionsPath, b.Bytes(), 0644)
 }
+
+func writeFormatSwitch(w *formatting.IndentedWriter, formatVariable string, supportsHdf5 bool, writeCase func(format string)) {
+	fmt.Fprintf(w, "switch (%s) {\n", formatVariable)
+	formats := []string{"kBinary", "kNDJson"}
+	if supportsHdf5 {
+		formats = []string{"kBinary", "kHdf5", "kNDJson"}
+	}
+	for _, format := range formats {
+		fmt.Fprintf(w, "case yardl::testing::Format::%s:\n", format)
+		w.Indented(func() {
+			writeCase(format)
+			w.WriteStringln("break;")
+		})
+	}
+	w.WriteStringln("default:")
+	w.Indented(func() {
+		w.WriteStringln("throw std::runtime_error(\"Unsupported format\");")
+	})
+	w.WriteStringln("}")
+}
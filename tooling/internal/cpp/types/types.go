@@ -29,11 +29,24 @@ func writeHeaderFile(env *dsl.Environment, options packaging.CppCodegenOptions)
 	w := formatting.NewIndentedWriter(&b, "  ")
 	common.WriteGeneratedFileHeader(w)
 
-	w.WriteStringln(`#pragma once
-#include <array>
-#include <complex>
-#include <optional>
-#include <unordered_map>
+	functions := calledFunctions(env)
+	w.WriteStringln("#pragma once")
+	if functions[dsl.FunctionMin] || functions[dsl.FunctionMax] {
+		w.WriteStringln("#include <algorithm>")
+	}
+	w.WriteStringln("#include <array>")
+	if functions[dsl.FunctionAbs] || functions[dsl.FunctionSqrt] || functions[dsl.FunctionFloor] || functions[dsl.FunctionCeil] {
+		w.WriteStringln("#include <cmath>")
+	}
+	w.WriteStringln(`#include <complex>`)
+	if functions[dsl.FunctionSum] {
+		w.WriteStringln("#include <numeric>")
+	}
+	w.WriteStringln(`#include <optional>`)
+	if hasPatternConstraints(env) {
+		w.WriteStringln("#include <regex>")
+	}
+	w.WriteStringln(`#include <unordered_map>
 #include <variant>
 #include <vector>
 
@@ -42,7 +55,7 @@ func writeHeaderFile(env *dsl.Environment, options packaging.CppCodegenOptions)
 
 	for _, ns := range env.Namespaces {
 		fmt.Fprintf(w, "namespace %s {\n", common.NamespaceIdentifierName(ns.Name))
-		writeNamespaceMembers(w, ns)
+		writeNamespaceMembers(w, ns, env.SymbolTable)
 		fmt.Fprintf(w, "} // namespace %s\n\n", common.NamespaceIdentifierName(ns.Name))
 	}
 
@@ -78,12 +91,34 @@ func writeSourceFile(env *dsl.Environment, options packaging.CppCodegenOptions)
 	return iocommon.WriteFileIfNeeded(definitionsPath, b.Bytes(), 0644)
 }
 
-func writeNamespaceMembers(w *formatting.IndentedWriter, ns *dsl.Namespace) {
+func writeNamespaceMembers(w *formatting.IndentedWriter, ns *dsl.Namespace, symbolTable dsl.SymbolTable) {
+	for _, c := range ns.Constants {
+		common.WriteComment(w, c.Comment)
+		fmt.Fprintf(w, "constexpr %s %s = ", common.TypeSyntax(dsl.GetUnderlyingType(c.Type)), common.ConstantIdentifierName(c.Name))
+		writeComputedFieldExpression(w, c.Value)
+		w.WriteStringln(";")
+	}
+	if len(ns.Constants) > 0 {
+		w.WriteStringln("")
+	}
+
+	// Recursive records are referenced before they are defined
+	hasRecursiveRecords := false
+	for _, td := range ns.TypeDefinitions {
+		if rec, ok := td.(*dsl.RecordDefinition); ok && rec.IsRecursive {
+			fmt.Fprintf(w, "struct %s;\n", common.TypeIdentifierName(rec.Name))
+			hasRecursiveRecords = true
+		}
+	}
+	if hasRecursiveRecords {
+		w.WriteStringln("")
+	}
+
 	for _, td := range ns.TypeDefinitions {
 		switch td := td.(type) {
 		case *dsl.EnumDefinition:
 			if td.IsFlags {
-				common.WriteComment(w, td.Comment)
+				common.WriteComment(w, dsl.AnnotatedComment(td.Comment, td.Annotations))
 
 				typeName := common.TypeIdentifierName(td.Name)
 				var valueTypeSyntax string
@@ -105,7 +140,7 @@ func writeNamespaceMembers(w *formatting.IndentedWriter, ns *dsl.Namespace) {
 				fmt.Fprint(w, "};\n\n")
 
 			} else {
-				common.WriteComment(w, td.Comment)
+				common.WriteComment(w, dsl.AnnotatedComment(td.Comment, td.Annotations))
 				fmt.Fprintf(w, "enum class %s ", common.TypeIdentifierName(td.Name))
 				if td.BaseType != nil {
 					fmt.Fprintf(w, ": %s ", common.TypeSyntax(td.BaseType))
@@ -123,13 +158,17 @@ func writeNamespaceMembers(w *formatting.IndentedWriter, ns *dsl.Namespace) {
 		case *dsl.NamedType:
 			writeNamedTypeDefinition(w, td)
 		case *dsl.RecordDefinition:
-			common.WriteComment(w, td.Comment)
+			common.WriteComment(w, dsl.AnnotatedComment(td.Comment, td.Annotations))
 			common.WriteDefinitionTemplateSpec(w, td)
 			fmt.Fprintf(w, "struct %s {\n", common.TypeIdentifierName(td.Name))
 			w.Indented(func() {
 				for _, field := range td.Fields {
-					common.WriteComment(w, field.Comment)
-					fmt.Fprintf(w, "%s %s{};\n", common.TypeSyntax(field.Type), common.FieldIdentifierName(field.Name))
+					common.WriteComment(w, dsl.AnnotatedComment(field.Comment, field.Annotations))
+					fmt.Fprintf(w, "%s %s{", common.TypeSyntax(field.Type), common.FieldIdentifierName(field.Name))
+					if field.Default != nil {
+						writeComputedFieldExpression(w, field.Default)
+					}
+					w.WriteStringln("};")
 				}
 
 				w.WriteString("\n")
@@ -161,6 +200,10 @@ func writeNamespaceMembers(w *formatting.IndentedWriter, ns *dsl.Namespace) {
 					}
 				}
 
+				if dsl.TypeHasConstraints(&dsl.SimpleType{ResolvedDefinition: td}, symbolTable) {
+					writeValidateMethod(w, td, symbolTable)
+				}
+
 				unused := ""
 				if len(td.Fields) == 0 {
 					unused = "[[maybe_unused]]"
@@ -207,6 +250,109 @@ func writeNamespaceMembers(w *formatting.IndentedWriter, ns *dsl.Namespace) {
 	}
 }
 
+func hasPatternConstraints(env *dsl.Environment) bool {
+	found := false
+	dsl.Visit(env, func(self dsl.Visitor, node dsl.Node) {
+		if constraints, ok := node.(*dsl.FieldConstraints); ok && constraints.Pattern != "" {
+			found = true
+		}
+		self.VisitChildren(node)
+	})
+
+	return found
+}
+
+// Returns the names of the functions that are called in computed fields
+func calledFunctions(env *dsl.Environment) map[string]bool {
+	functions := make(map[string]bool)
+	dsl.Visit(env, func(self dsl.Visitor, node dsl.Node) {
+		if call, ok := node.(*dsl.FunctionCallExpression); ok {
+			functions[call.FunctionName] = true
+		}
+		self.VisitChildren(node)
+	})
+
+	return functions
+}
+
+func writeValidateMethod(w *formatting.IndentedWriter, rec *dsl.RecordDefinition, symbolTable dsl.SymbolTable) {
+	common.WriteComment(w, "Throws std::runtime_error if a field does not satisfy its constraints.")
+	w.WriteStringln("void Validate() const {")
+	w.Indented(func() {
+		for _, field := range rec.Fields {
+			if field.Constraints != nil {
+				writeConstraintChecks(w, rec, field)
+			}
+			if dsl.TypeHasConstraints(field.Type, symbolTable) {
+				common.WriteValidation(w, field.Type, common.FieldIdentifierName(field.Name))
+			}
+		}
+	})
+	w.WriteString("}\n\n")
+}
+
+func writeConstraintChecks(w *formatting.IndentedWriter, rec *dsl.RecordDefinition, field *dsl.Field) {
+	constraints := field.Constraints
+	value := common.FieldIdentifierName(field.Name)
+	guard := ""
+	if _, optional := dsl.GetConstrainedType(field); optional {
+		guard = value + ".has_value() && "
+		value += ".value()"
+	}
+
+	check := func(writeCondition func(), message string) {
+		fmt.Fprintf(w, "if (%s", guard)
+		writeCondition()
+		w.WriteStringln(") {")
+		w.Indented(func() {
+			fmt.Fprintf(w, "throw std::runtime_error(%q);\n", fmt.Sprintf("%s.%s %s", rec.Name, field.Name, message))
+		})
+		w.WriteStringln("}")
+	}
+
+	if constraints.Min != nil {
+		check(func() {
+			fmt.Fprintf(w, "%s < ", value)
+			writeComputedFieldExpression(w, constraints.Min)
+		}, "must be at least "+literalText(constraints.Min))
+	}
+	if constraints.Max != nil {
+		check(func() {
+			fmt.Fprintf(w, "%s > ", value)
+			writeComputedFieldExpression(w, constraints.Max)
+		}, "must be at most "+literalText(constraints.Max))
+	}
+	if constraints.Pattern != "" {
+		patternName := fmt.Sprintf("k%sPattern", formatting.ToPascalCase(field.Name))
+		fmt.Fprintf(w, "static std::regex const %s(%q);\n", patternName, constraints.Pattern)
+		check(func() {
+			fmt.Fprintf(w, "!std::regex_match(%s, %s)", value, patternName)
+		}, fmt.Sprintf("must match the pattern '%s'", constraints.Pattern))
+	}
+	if constraints.MinLength != nil {
+		check(func() {
+			fmt.Fprintf(w, "%s.size() < %d", value, *constraints.MinLength)
+		}, fmt.Sprintf("must have a length of at least %d", *constraints.MinLength))
+	}
+	if constraints.MaxLength != nil {
+		check(func() {
+			fmt.Fprintf(w, "%s.size() > %d", value, *constraints.MaxLength)
+		}, fmt.Sprintf("must have a length of at most %d", *constraints.MaxLength))
+	}
+}
+
+// Returns the text of a numeric literal, for use in messages
+func literalText(expression dsl.Expression) string {
+	switch t := expression.(type) {
+	case *dsl.IntegerLiteralExpression:
+		return t.Value.String()
+	case *dsl.FloatingPointLiteralExpression:
+		return t.Value
+	default:
+		panic(fmt.Sprintf("unexpected expression %T", expression))
+	}
+}
+
 func writeNamedTypeDefinition(w *formatting.IndentedWriter, nt *dsl.NamedType) {
 	common.WriteComment(w, nt.Comment)
 	common.WriteDefinitionTemplateSpec(w, nt)
@@ -231,10 +377,14 @@ func writeComputedFieldExpression(w *formatting.IndentedWriter, expression dsl.E
 	dsl.Visit(expression, func(self dsl.Visitor, node dsl.Node) {
 		switch t := node.(type) {
 		case *dsl.UnaryExpression:
-			if t.Operator != dsl.UnaryOpNegate {
+			switch t.Operator {
+			case dsl.UnaryOpNegate:
+				w.WriteString("-(")
+			case dsl.UnaryOpNot:
+				w.WriteString("!(")
+			default:
 				panic(fmt.Sprintf("unexpected unary operator %d", t.Operator))
 			}
-			w.WriteString("-(")
 			self.Visit(t.Expression)
 			w.WriteString(")")
 		case *dsl.BinaryExpression:
@@ -248,7 +398,7 @@ func writeComputedFieldExpression(w *formatting.IndentedWriter, expression dsl.E
 			}
 
 			requiresParentheses := false
-			if l, ok := t.Left.(*dsl.BinaryExpression); ok && l.Operator.Precedence() < t.Operator.Precedence() {
+			if l, ok := t.Left.(*dsl.BinaryExpression); ok && (l.Operator.Precedence() < t.Operator.Precedence() || l.Operator.IsComparison() && t.Operator.IsComparison()) {
 				requiresParentheses = true
 			}
 
@@ -271,6 +421,22 @@ func writeComputedFieldExpression(w *formatting.IndentedWriter, expression dsl.E
 				w.WriteString("*")
 			case dsl.BinaryOpDiv:
 				w.WriteString("/")
+			case dsl.BinaryOpEq:
+				w.WriteString("==")
+			case dsl.BinaryOpNe:
+				w.WriteString("!=")
+			case dsl.BinaryOpLt:
+				w.WriteString("<")
+			case dsl.BinaryOpLe:
+				w.WriteString("<=")
+			case dsl.BinaryOpGt:
+				w.WriteString(">")
+			case dsl.BinaryOpGe:
+				w.WriteString(">=")
+			case dsl.BinaryOpAnd:
+				w.WriteString("&&")
+			case dsl.BinaryOpOr:
+				w.WriteString("||")
 			default:
 				panic(fmt.Sprintf("unexpected binary operator %d", t.Operator))
 			}
@@ -278,7 +444,7 @@ func writeComputedFieldExpression(w *formatting.IndentedWriter, expression dsl.E
 			w.WriteString(" ")
 
 			requiresParentheses = false
-			if r, ok := t.Right.(*dsl.BinaryExpression); ok && r.Operator.Precedence() < t.Operator.Precedence() {
+			if r, ok := t.Right.(*dsl.BinaryExpression); ok && (r.Operator.Precedence() < t.Operator.Precedence() || r.Operator.IsComparison() && t.Operator.IsComparison()) {
 				requiresParentheses = true
 			}
 
@@ -289,15 +455,30 @@ func writeComputedFieldExpression(w *formatting.IndentedWriter, expression dsl.E
 			if requiresParentheses {
 				w.WriteString(")")
 			}
+		case *dsl.ConditionalExpression:
+			w.WriteString("(")
+			self.Visit(t.Condition)
+			w.WriteString(" ? ")
+			self.Visit(t.Then)
+			w.WriteString(" : ")
+			self.Visit(t.Else)
+			w.WriteString(")")
 		case *dsl.IntegerLiteralExpression:
 			w.Write([]byte(common.IntegerLiteral(t.Value, t.ResolvedType)))
 		case *dsl.FloatingPointLiteralExpression:
 			w.WriteString(t.Value)
-			if t.ResolvedType == dsl.Float32Type {
+			switch primitive, _ := dsl.GetPrimitiveType(t.ResolvedType); primitive {
+			case dsl.Float16, dsl.BFloat16, dsl.Float32:
 				w.WriteString("f")
 			}
 		case *dsl.StringLiteralExpression:
 			fmt.Fprintf(w, "%q", t.Value)
+		case *dsl.BooleanLiteralExpression:
+			fmt.Fprint(w, t.Value)
+		case *dsl.EnumValueExpression:
+			fmt.Fprintf(w, "%s::%s", common.TypeSyntax(t.ResolvedType), common.EnumValueIdentifierName(t.Value.Symbol))
+		case *dsl.ConstantReferenceExpression:
+			fmt.Fprintf(w, "%s::%s", common.NamespaceIdentifierName(t.Definition.Namespace), common.ConstantIdentifierName(t.Definition.Name))
 		case *dsl.MemberAccessExpression:
 			if t.Target != nil {
 				self.Visit(t.Target)
@@ -367,6 +548,49 @@ func writeComputedFieldExpression(w *formatting.IndentedWriter, expression dsl.E
 				fmt.Fprintf(w, "yardl::dimension(")
 				self.Visit(t.Arguments[0])
 				fmt.Fprintf(w, ")")
+
+			case dsl.FunctionMin, dsl.FunctionMax:
+				fmt.Fprintf(w, "std::%s<%s>(", t.FunctionName, common.TypeSyntax(t.ResolvedType))
+				self.Visit(t.Arguments[0])
+				w.WriteString(", ")
+				self.Visit(t.Arguments[1])
+				w.WriteString(")")
+
+			case dsl.FunctionAbs:
+				if primitive, _ := dsl.GetPrimitiveType(t.ResolvedType); dsl.IsIntegralPrimitive(primitive) && !dsl.IsSignedPrimitive(primitive) {
+					// std::abs is ambiguous for unsigned integers
+					self.Visit(t.Arguments[0])
+					return
+				}
+				w.WriteString("std::abs(")
+				self.Visit(t.Arguments[0])
+				w.WriteString(")")
+
+			case dsl.FunctionSqrt, dsl.FunctionFloor, dsl.FunctionCeil:
+				fmt.Fprintf(w, "std::%s(", t.FunctionName)
+				self.Visit(t.Arguments[0])
+				w.WriteString(")")
+
+			case dsl.FunctionSum:
+				fmt.Fprintf(w, "yardl::Sum<%s>(", common.TypeSyntax(t.ResolvedType))
+				self.Visit(t.Arguments[0])
+				w.WriteString(")")
+
+			case dsl.FunctionLength:
+				self.Visit(t.Arguments[0])
+				w.WriteString(".size()")
+
+			case dsl.FunctionContains:
+				w.WriteString("(")
+				self.Visit(t.Arguments[0])
+				w.WriteString(".count(")
+				self.Visit(t.Arguments[1])
+				w.WriteString(") != 0)")
+
+			case dsl.FunctionHasValue:
+				self.Visit(t.Arguments[0])
+				w.WriteString(".has_value()")
+
 			default:
 				panic(fmt.Sprintf("Unknown function '%s'", t.FunctionName))
 			}
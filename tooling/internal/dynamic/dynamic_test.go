@@ -0,0 +1,175 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+package dynamic
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/microsoft/yardl/tooling/pkg/binary"
+	"github.com/microsoft/yardl/tooling/pkg/dsl"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testModel = `
+P: !protocol
+  sequence:
+    header: Header
+    samples: !stream
+      items: [int, string]
+    labels: string->int
+    lookup: int->float
+    image: int[x, y]
+    fixed: float[2]
+    dynamic: double[]
+    choice: [null, int, float]
+    fruit: Fruit
+    perms: Perms
+    when: datetime
+    maybe: int?
+    blob: bytes
+    id: uuid
+    interval: duration
+    empty: !stream
+      items: int
+
+Header: !record
+  fields:
+    name: string
+    day: date
+    time: time
+    value: complexfloat
+    optional: int?
+
+Fruit: !enum
+  values:
+    - apple
+    - banana
+
+Perms: !flags
+  values:
+    - read
+    - write
+    - execute
+`
+
+func TestTranslateRoundTrip(t *testing.T) {
+	schema := getSchema(t, testModel)
+
+	lines := []string{
+		`{"yardl":{"version":1,"schema":` + schema + `}}`,
+		`{"header":{"name":"a \"name\" <with> & symbols","day":"2023-02-01","time":"12:34:56.000000789","value":[1.5,-2]}}`,
+		`{"samples":1}`,
+		`{"samples":"two"}`,
+		`{"samples":3}`,
+		`{"labels":{"b":2,"a":1}}`,
+		`{"lookup":[[2,0.5],[1,0.25]]}`,
+		`{"image":{"shape":[2,3],"data":[1,2,3,4,5,6]}}`,
+		`{"fixed":[1,2]}`,
+		`{"dynamic":{"shape":[2],"data":[1e-7,null]}}`,
+		`{"choice":{"float32":1.5}}`,
+		`{"fruit":"banana"}`,
+		`{"perms":["read","execute"]}`,
+		`{"when":"1969-12-31T23:59:59.500000000"}`,
+		`{"maybe":null}`,
+		`{"blob":"AAEC/w=="}`,
+		`{"id":"123e4567-e89b-12d3-a456-426614174000"}`,
+		`{"interval":-1500000000}`,
+	}
+	original := strings.Join(lines, "\n") + "\n"
+
+	binaryData := translate(t, FormatNDJson, FormatBinary, original)
+	assert.True(t, bytes.HasPrefix([]byte(binaryData), []byte("yardl\x01\x00\x00\x00")))
+	assert.Equal(t, original, translate(t, FormatBinary, FormatNDJson, binaryData))
+	assert.Equal(t, binaryData, translate(t, FormatBinary, FormatBinary, binaryData))
+}
+
+func TestReadIncompleteProtocol(t *testing.T) {
+	schema := getSchema(t, testModel)
+	input := `{"yardl":{"version":1,"schema":` + schema + `}}` + "\n" + `{"samples":1}` + "\n"
+
+	reader, err := NewNDJsonReader(strings.NewReader(input))
+	require.NoError(t, err)
+	_, _, err = reader.Read()
+	assert.ErrorContains(t, err, "unexpected protocol step 'samples'")
+}
+
+func TestResolveFlags(t *testing.T) {
+	schema := getSchema(t, testModel)
+	protocol, _, err := dsl.ParseProtocolSchema([]byte(schema))
+	require.NoError(t, err)
+
+	err = ResolveFlags(protocol, nil)
+	assert.ErrorContains(t, err, "the protocol schema does not record whether the enums 'test.Fruit', 'test.Perms' were declared as !flags")
+
+	// An enum whose values look like flags is still an enum
+	env := getModel(t, strings.Replace(testModel, "Fruit: !enum\n  values:\n    - apple\n    - banana", "Fruit: !enum\n  values:\n    apple: 1\n    banana: 2\n    cherry: 4", 1))
+	protocol, _, err = dsl.ParseProtocolSchema([]byte(dsl.GetProtocolSchemaString(env.GetTopLevelNamespace().Protocols[0], env.SymbolTable)))
+	require.NoError(t, err)
+	require.NoError(t, ResolveFlags(protocol, env))
+
+	fruit := protocol.Sequence[8].Type.(*dsl.SimpleType).ResolvedDefinition.(*dsl.EnumDefinition)
+	perms := protocol.Sequence[9].Type.(*dsl.SimpleType).ResolvedDefinition.(*dsl.EnumDefinition)
+	require.Len(t, fruit.Values, 3)
+	assert.False(t, fruit.IsFlags)
+	assert.True(t, perms.IsFlags)
+}
+
+func getModel(t *testing.T, model string) *dsl.Environment {
+	d := t.TempDir()
+	require.NoError(t, os.WriteFile(path.Join(d, "model.yml"), []byte(model), 0644))
+	ns, err := dsl.ParseYamlInDir(d, "test")
+	require.NoError(t, err)
+	env, err := dsl.Validate([]*dsl.Namespace{ns})
+	require.NoError(t, err)
+	return env
+}
+
+func getSchema(t *testing.T, model string) string {
+	env := getModel(t, model)
+	return dsl.GetProtocolSchemaString(env.GetTopLevelNamespace().Protocols[0], env.SymbolTable)
+}
+
+func translate(t *testing.T, from, to Format, input string) string {
+	reader, err := NewReader(from, strings.NewReader(input))
+	require.NoError(t, err)
+	require.NoError(t, ResolveFlags(reader.Protocol(), getModel(t, testModel)))
+
+	var output bytes.Buffer
+	writer, err := NewWriter(to, &output, reader.Protocol(), reader.Schema())
+	require.NoError(t, err)
+
+	for {
+		step, value, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		require.NoError(t, writer.Write(step, value))
+	}
+
+	require.NoError(t, writer.Close())
+	return output.String()
+}
+
+func TestFormatter(t *testing.T) {
+	schema := getSchema(t, testModel)
+	reader, err := NewNDJsonReader(strings.NewReader(`{"yardl":{"version":1,"schema":` + schema + `}}` + "\n" +
+		`{"header":{"name":"abc","day":"2023-02-01","time":"12:34:56","value":[1.5,-2]}}`))
+	require.NoError(t, err)
+	_, header, err := reader.Read()
+	require.NoError(t, err)
+
+	formatter := Formatter{MaxItems: 2}
+	assert.Equal(t, "\n  name: \"abc\"\n  day: 2023-02-01\n  time: 12:34:56.000000000\n  value: (1.5-2i)\n  optional: null", formatter.Format(header, "  "))
+	assert.Equal(t, "[1, 2, ... (1 more)]", formatter.Format([]binary.Value{int32(1), int32(2), int32(3)}, ""))
+	assert.Equal(t, "bytes[00, ff, ... (1 more)]", formatter.Format([]byte{0, 255, 7}, ""))
+	assert.Equal(t, "array(2x2)[1, 2, ... (2 more)]", formatter.Format(&binary.Array{Shape: []uint64{2, 2}, Data: []binary.Value{1, 2, 3, 4}}, ""))
+}
@@ -0,0 +1,53 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+package dynamic
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/microsoft/yardl/tooling/pkg/dsl"
+)
+
+// ResolveFlags sets IsFlags on the enums referenced by a protocol parsed from a
+// protocol schema, since the schema does not record whether an enum was declared
+// as !flags.
+//
+// Enums are matched by qualified name against the model's definitions. An error
+// listing the enums that cannot be found is returned if model is nil or does not
+// define all of them. These enums are left with IsFlags set to false.
+func ResolveFlags(protocol *dsl.ProtocolDefinition, model *dsl.Environment) error {
+	var unresolved []string
+	visited := make(map[dsl.TypeDefinition]bool)
+	dsl.Visit(protocol, func(self dsl.Visitor, node dsl.Node) {
+		switch node := node.(type) {
+		case *dsl.SimpleType:
+			if !visited[node.ResolvedDefinition] {
+				visited[node.ResolvedDefinition] = true
+				self.Visit(node.ResolvedDefinition)
+			}
+			return
+		case *dsl.EnumDefinition:
+			if model != nil {
+				if enum, ok := model.SymbolTable[node.GetQualifiedName()].(*dsl.EnumDefinition); ok {
+					node.IsFlags = enum.IsFlags
+					return
+				}
+			}
+			unresolved = append(unresolved, fmt.Sprintf("'%s'", node.GetQualifiedName()))
+			return
+		}
+		self.VisitChildren(node)
+	})
+
+	if len(unresolved) > 0 {
+		reason := "the model does not define them"
+		if model == nil {
+			reason = "no model was given"
+		}
+		return fmt.Errorf("the protocol schema does not record whether the enums %s were declared as !flags, and %s", strings.Join(unresolved, ", "), reason)
+	}
+
+	return nil
+}
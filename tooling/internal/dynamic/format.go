@@ -0,0 +1,213 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+package dynamic
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/microsoft/yardl/tooling/pkg/binary"
+)
+
+// Formatter renders values as human-readable text.
+// Records are written over multiple lines, one field per line, as are
+// vectors, arrays and maps that contain records. Other values are written inline.
+type Formatter struct {
+	// MaxItems limits the number of elements of vectors, arrays and maps
+	// that are written. Zero means no limit.
+	MaxItems int
+}
+
+// Format returns the text for a value. Nested lines are indented by
+// two spaces per level relative to indent. Multi-line values start with
+// a newline.
+func (f Formatter) Format(value binary.Value, indent string) string {
+	var b strings.Builder
+	f.writeValue(&b, value, indent)
+	return b.String()
+}
+
+func (f Formatter) writeValue(b *strings.Builder, value binary.Value, indent string) {
+	if !isBlock(value) {
+		f.writeInline(b, value)
+		return
+	}
+
+	switch v := value.(type) {
+	case *binary.Record:
+		for i, field := range v.Definition.Fields {
+			f.writeEntry(b, indent, field.Name, v.Fields[i])
+		}
+	case *binary.Union:
+		f.writeEntry(b, indent, v.Tag, v.Value)
+	case []binary.Value:
+		f.writeItems(b, indent, len(v), func(i int) (string, binary.Value) {
+			return fmt.Sprintf("[%d]", i), v[i]
+		})
+	case *binary.Array:
+		f.writeItems(b, indent, len(v.Data), func(i int) (string, binary.Value) {
+			return fmt.Sprintf("[%s]", formatArrayIndex(v.Shape, i)), v.Data[i]
+		})
+	case *binary.Map:
+		f.writeItems(b, indent, len(v.Entries), func(i int) (string, binary.Value) {
+			var key strings.Builder
+			f.writeInline(&key, v.Entries[i].Key)
+			return key.String(), v.Entries[i].Value
+		})
+	}
+}
+
+func (f Formatter) writeEntry(b *strings.Builder, indent, label string, value binary.Value) {
+	b.WriteString("\n")
+	b.WriteString(indent)
+	b.WriteString(label)
+	b.WriteString(":")
+	if !isBlock(value) {
+		b.WriteString(" ")
+	}
+	f.writeValue(b, value, indent+"  ")
+}
+
+func (f Formatter) writeItems(b *strings.Builder, indent string, count int, item func(int) (string, binary.Value)) {
+	shown := f.shownItems(count)
+	for i := 0; i < shown; i++ {
+		label, value := item(i)
+		f.writeEntry(b, indent, label, value)
+	}
+	if shown < count {
+		fmt.Fprintf(b, "\n%s... (%d more)", indent, count-shown)
+	}
+}
+
+func (f Formatter) writeInline(b *strings.Builder, value binary.Value) {
+	switch v := value.(type) {
+	case nil:
+		b.WriteString("null")
+	case float32:
+		b.WriteString(strconv.FormatFloat(float64(v), 'g', -1, 32))
+	case float64:
+		b.WriteString(strconv.FormatFloat(v, 'g', -1, 64))
+	case complex64:
+		b.WriteString(strconv.FormatComplex(complex128(v), 'g', -1, 64))
+	case complex128:
+		b.WriteString(strconv.FormatComplex(v, 'g', -1, 128))
+	case string:
+		b.WriteString(strconv.Quote(v))
+	case []byte:
+		f.writeInlineItems(b, "bytes[", "]", len(v), func(i int) {
+			fmt.Fprintf(b, "%02x", v[i])
+		})
+	case *binary.Enum:
+		i, err := binary.IntegerToBigInt(v.Value)
+		if err != nil {
+			fmt.Fprint(b, v.Value)
+			return
+		}
+		symbols, ok, _ := v.Symbols()
+		switch {
+		case !ok:
+			fmt.Fprintf(b, "%s(%s)", v.Definition.Name, i)
+		case len(symbols) == 0:
+			b.WriteString("0")
+		default:
+			b.WriteString(strings.Join(symbols, " | "))
+		}
+	case *binary.Record:
+		b.WriteString("{")
+		for i, field := range v.Definition.Fields {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			b.WriteString(field.Name)
+			b.WriteString(": ")
+			f.writeInline(b, v.Fields[i])
+		}
+		b.WriteString("}")
+	case *binary.Union:
+		b.WriteString(v.Tag)
+		b.WriteString("(")
+		f.writeInline(b, v.Value)
+		b.WriteString(")")
+	case []binary.Value:
+		f.writeInlineItems(b, "[", "]", len(v), func(i int) {
+			f.writeInline(b, v[i])
+		})
+	case *binary.Array:
+		shape := make([]string, len(v.Shape))
+		for i, length := range v.Shape {
+			shape[i] = strconv.FormatUint(length, 10)
+		}
+		fmt.Fprintf(b, "array(%s)", strings.Join(shape, "x"))
+		f.writeInlineItems(b, "[", "]", len(v.Data), func(i int) {
+			f.writeInline(b, v.Data[i])
+		})
+	case *binary.Map:
+		f.writeInlineItems(b, "{", "}", len(v.Entries), func(i int) {
+			f.writeInline(b, v.Entries[i].Key)
+			b.WriteString(": ")
+			f.writeInline(b, v.Entries[i].Value)
+		})
+	default:
+		fmt.Fprint(b, v)
+	}
+}
+
+func (f Formatter) writeInlineItems(b *strings.Builder, open, close string, count int, item func(int)) {
+	b.WriteString(open)
+	shown := f.shownItems(count)
+	for i := 0; i < shown; i++ {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		item(i)
+	}
+	if shown < count {
+		if shown > 0 {
+			b.WriteString(", ")
+		}
+		fmt.Fprintf(b, "... (%d more)", count-shown)
+	}
+	b.WriteString(close)
+}
+
+func (f Formatter) shownItems(count int) int {
+	if f.MaxItems > 0 && count > f.MaxItems {
+		return f.MaxItems
+	}
+	return count
+}
+
+// Returns whether a value is written over multiple lines
+func isBlock(value binary.Value) bool {
+	switch v := value.(type) {
+	case *binary.Record:
+		return len(v.Fields) > 0
+	case *binary.Union:
+		return isBlock(v.Value)
+	case []binary.Value:
+		return len(v) > 0 && isBlock(v[0])
+	case *binary.Array:
+		return len(v.Data) > 0 && isBlock(v.Data[0])
+	case *binary.Map:
+		return len(v.Entries) > 0 && isBlock(v.Entries[0].Value)
+	default:
+		return false
+	}
+}
+
+// Returns the row-major multidimensional index of the flat index i as "i,j,..."
+func formatArrayIndex(shape []uint64, i int) string {
+	index := make([]string, len(shape))
+	remaining := uint64(i)
+	for d := len(shape) - 1; d >= 0; d-- {
+		if shape[d] == 0 {
+			index[d] = "0"
+			continue
+		}
+		index[d] = strconv.FormatUint(remaining%shape[d], 10)
+		remaining /= shape[d]
+	}
+	return strings.Join(index, ",")
+}
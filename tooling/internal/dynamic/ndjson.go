@@ -0,0 +1,1027 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+package dynamic
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/microsoft/yardl/tooling/internal/ndjsoncommon"
+	"github.com/microsoft/yardl/tooling/internal/protocolstate"
+	"github.com/microsoft/yardl/tooling/pkg/binary"
+	"github.com/microsoft/yardl/tooling/pkg/dsl"
+)
+
+const currentNDJsonFormatVersion = 1
+
+// NDJsonReader reads a protocol in the NDJSON format, using the protocol schema
+// in the header line.
+type NDJsonReader struct {
+	r        *bufio.Reader
+	schema   string
+	protocol *dsl.ProtocolDefinition
+	index    int
+	line     int
+}
+
+// NewNDJsonReader reads the header line and protocol schema from r.
+func NewNDJsonReader(r io.Reader) (*NDJsonReader, error) {
+	reader := &NDJsonReader{r: bufio.NewReader(r)}
+
+	line, err := reader.readLine()
+	if err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, fmt.Errorf("unable to read the NDJSON header: %w", err)
+	}
+
+	var header struct {
+		Yardl *struct {
+			Version int             `json:"version"`
+			Schema  json.RawMessage `json:"schema"`
+		} `json:"yardl"`
+	}
+	if err := json.Unmarshal(line, &header); err != nil || header.Yardl == nil || header.Yardl.Schema == nil {
+		return nil, errors.New("the data is not in the expected yardl NDJSON format")
+	}
+	if header.Yardl.Version != currentNDJsonFormatVersion {
+		return nil, fmt.Errorf("unsupported NDJSON format version %d", header.Yardl.Version)
+	}
+
+	var schema bytes.Buffer
+	if err := json.Compact(&schema, header.Yardl.Schema); err != nil {
+		return nil, err
+	}
+	reader.schema = schema.String()
+
+	reader.protocol, _, err = dsl.ParseProtocolSchema(schema.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	return reader, nil
+}
+
+// Schema returns the protocol schema JSON from the header, without whitespace.
+func (r *NDJsonReader) Schema() string {
+	return r.schema
+}
+
+func (r *NDJsonReader) Protocol() *dsl.ProtocolDefinition {
+	return r.protocol
+}
+
+// Read returns the next protocol step value. For stream steps, each call
+// returns a single stream item. Returns io.EOF after the last step.
+func (r *NDJsonReader) Read() (*dsl.ProtocolStep, binary.Value, error) {
+	line, err := r.readLine()
+	if err == io.EOF {
+		for ; r.index < len(r.protocol.Sequence); r.index++ {
+			if step := r.protocol.Sequence[r.index]; !step.IsStream() {
+				return nil, nil, fmt.Errorf("missing protocol step '%s'", step.Name)
+			}
+		}
+		return nil, nil, io.EOF
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	node, err := parseOrderedJson(line)
+	if err != nil {
+		return nil, nil, fmt.Errorf("line %d: %w", r.line, err)
+	}
+	obj, ok := node.(jsonObject)
+	if !ok || len(obj) != 1 {
+		return nil, nil, fmt.Errorf("line %d: expected an object with a single protocol step", r.line)
+	}
+	name := obj[0].Key
+
+	for ; r.index < len(r.protocol.Sequence); r.index++ {
+		step := r.protocol.Sequence[r.index]
+		if step.Name != name {
+			if step.IsStream() {
+				continue
+			}
+			break
+		}
+
+		itemType := step.Type
+		if step.IsStream() {
+			itemType = protocolstate.StreamItemType(step)
+		} else {
+			r.index++
+		}
+
+		value, err := fromJson(itemType, obj[0].Value)
+		if err != nil {
+			return nil, nil, fmt.Errorf("line %d: %w", r.line, protocolstate.StepError(step, err))
+		}
+		return step, value, nil
+	}
+
+	return nil, nil, fmt.Errorf("line %d: unexpected protocol step '%s'", r.line, name)
+}
+
+// Returns the next non-empty line
+func (r *NDJsonReader) readLine() ([]byte, error) {
+	for {
+		line, err := r.r.ReadBytes('\n')
+		if len(line) == 0 && err != nil {
+			return nil, err
+		}
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+		r.line++
+		if line = bytes.TrimSpace(line); len(line) > 0 {
+			return line, nil
+		}
+	}
+}
+
+// NDJsonWriter writes a protocol in the NDJSON format.
+type NDJsonWriter struct {
+	w     *bufio.Writer
+	state *protocolstate.State
+	buf   bytes.Buffer
+}
+
+// NewNDJsonWriter writes the header line with the given protocol schema JSON to w.
+func NewNDJsonWriter(w io.Writer, protocol *dsl.ProtocolDefinition, schema string) (*NDJsonWriter, error) {
+	nw := &NDJsonWriter{w: bufio.NewWriter(w), state: protocolstate.New(protocol)}
+	_, err := fmt.Fprintf(nw.w, "{\"yardl\":{\"version\":%d,\"schema\":%s}}\n", currentNDJsonFormatVersion, schema)
+	return nw, err
+}
+
+// Write writes the value of a protocol step. For stream steps, value
+// is a single stream item.
+func (w *NDJsonWriter) Write(step *dsl.ProtocolStep, value binary.Value) error {
+	step, err := w.state.AdvanceTo(step, func(*dsl.ProtocolStep) error { return nil })
+	if err != nil {
+		return err
+	}
+
+	itemType := step.Type
+	if step.IsStream() {
+		itemType = protocolstate.StreamItemType(step)
+	}
+
+	w.buf.Reset()
+	w.buf.WriteByte('{')
+	writeJsonString(&w.buf, step.Name)
+	w.buf.WriteByte(':')
+	if err := toJson(&w.buf, itemType, value); err != nil {
+		return protocolstate.StepError(step, err)
+	}
+	w.buf.WriteString("}\n")
+
+	if !step.IsStream() {
+		w.state.StepCompleted()
+	}
+
+	_, err = w.w.Write(w.buf.Bytes())
+	return err
+}
+
+// Close flushes the output. It returns an error if not all steps were written.
+func (w *NDJsonWriter) Close() error {
+	if err := w.state.Complete(func(*dsl.ProtocolStep) error { return nil }); err != nil {
+		return err
+	}
+	return w.w.Flush()
+}
+
+// jsonObject is a parsed JSON object that preserves the order of its members.
+type jsonObject []jsonMember
+
+type jsonMember struct {
+	Key   string
+	Value any
+}
+
+func (o jsonObject) get(key string) (any, bool) {
+	for _, m := range o {
+		if m.Key == key {
+			return m.Value, true
+		}
+	}
+	return nil, false
+}
+
+// Parses JSON into nil, bool, json.Number, string, []any, or jsonObject values
+func parseOrderedJson(data []byte) (any, error) {
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	node, err := parseOrderedJsonValue(d)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := d.Token(); err != io.EOF {
+		return nil, errors.New("unexpected data after JSON value")
+	}
+	return node, nil
+}
+
+func parseOrderedJsonValue(d *json.Decoder) (any, error) {
+	token, err := d.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch token {
+	case json.Delim('['):
+		arr := []any{}
+		for d.More() {
+			item, err := parseOrderedJsonValue(d)
+			if err != nil {
+				return nil, err
+			}
+			arr = append(arr, item)
+		}
+		_, err := d.Token()
+		return arr, err
+	case json.Delim('{'):
+		obj := jsonObject{}
+		for d.More() {
+			key, err := d.Token()
+			if err != nil {
+				return nil, err
+			}
+			value, err := parseOrderedJsonValue(d)
+			if err != nil {
+				return nil, err
+			}
+			obj = append(obj, jsonMember{Key: key.(string), Value: value})
+		}
+		_, err := d.Token()
+		return obj, err
+	default:
+		return token, nil
+	}
+}
+
+func jsonNodeMatches(node any, dataType ndjsoncommon.JsonDataType) bool {
+	switch node.(type) {
+	case nil:
+		return dataType&ndjsoncommon.JsonNull != 0
+	case bool:
+		return dataType&ndjsoncommon.JsonBoolean != 0
+	case json.Number:
+		return dataType&ndjsoncommon.JsonNumber != 0
+	case string:
+		return dataType&ndjsoncommon.JsonString != 0
+	case []any:
+		return dataType&ndjsoncommon.JsonArray != 0
+	case jsonObject:
+		return dataType&ndjsoncommon.JsonObject != 0
+	default:
+		return false
+	}
+}
+
+func fromJson(t dsl.Type, node any) (binary.Value, error) {
+	switch t := t.(type) {
+	case nil:
+		if node != nil {
+			return nil, errors.New("expected null")
+		}
+		return nil, nil
+	case *dsl.SimpleType:
+		return typeDefinitionFromJson(t.ResolvedDefinition, node)
+	case *dsl.GeneralizedType:
+		switch dim := t.Dimensionality.(type) {
+		case nil:
+			return typeCasesFromJson(t.Cases, node)
+		case *dsl.Vector:
+			items, ok := node.([]any)
+			if !ok {
+				return nil, errors.New("expected a JSON array for a vector")
+			}
+			if dim.Length != nil && uint64(len(items)) != *dim.Length {
+				return nil, fmt.Errorf("expected a vector of length %d, got %d", *dim.Length, len(items))
+			}
+			return itemsFromJson(t.Cases, items)
+		case *dsl.Array:
+			arr := &binary.Array{}
+			var items []any
+			if dim.IsFixed() {
+				var ok bool
+				if items, ok = node.([]any); !ok {
+					return nil, errors.New("expected a JSON array for a fixed array")
+				}
+				for _, dimension := range *dim.Dimensions {
+					arr.Shape = append(arr.Shape, *dimension.Length)
+				}
+			} else {
+				obj, ok := node.(jsonObject)
+				if !ok {
+					return nil, errors.New("expected a JSON object for an array")
+				}
+				shapeNode, _ := obj.get("shape")
+				shape, ok := shapeNode.([]any)
+				if !ok {
+					return nil, errors.New("expected a 'shape' array")
+				}
+				for _, s := range shape {
+					length, err := fromJson(dsl.SizeType, s)
+					if err != nil {
+						return nil, err
+					}
+					arr.Shape = append(arr.Shape, length.(uint64))
+				}
+				dataNode, _ := obj.get("data")
+				if items, ok = dataNode.([]any); !ok {
+					return nil, errors.New("expected a 'data' array")
+				}
+			}
+
+			data, err := itemsFromJson(t.Cases, items)
+			if err != nil {
+				return nil, err
+			}
+			arr.Data = data
+			return arr, arr.CheckShape(dim)
+		case *dsl.Map:
+			m := &binary.Map{}
+			if isStringType(dim.KeyType) {
+				obj, ok := node.(jsonObject)
+				if !ok {
+					return nil, errors.New("expected a JSON object for a map")
+				}
+				for _, member := range obj {
+					value, err := typeCasesFromJson(t.Cases, member.Value)
+					if err != nil {
+						return nil, err
+					}
+					m.Entries = append(m.Entries, binary.MapEntry{Key: member.Key, Value: value})
+				}
+				return m, nil
+			}
+
+			entries, ok := node.([]any)
+			if !ok {
+				return nil, errors.New("expected a JSON array for a map")
+			}
+			for _, entry := range entries {
+				pair, ok := entry.([]any)
+				if !ok || len(pair) != 2 {
+					return nil, errors.New("expected a [key, value] array for a map entry")
+				}
+				key, err := fromJson(dim.KeyType, pair[0])
+				if err != nil {
+					return nil, err
+				}
+				value, err := typeCasesFromJson(t.Cases, pair[1])
+				if err != nil {
+					return nil, err
+				}
+				m.Entries = append(m.Entries, binary.MapEntry{Key: key, Value: value})
+			}
+			return m, nil
+		default:
+			return nil, fmt.Errorf("unexpected dimensionality %T", dim)
+		}
+	default:
+		return nil, fmt.Errorf("unexpected type %T", t)
+	}
+}
+
+func itemsFromJson(cases dsl.TypeCases, items []any) ([]binary.Value, error) {
+	values := make([]binary.Value, len(items))
+	for i, item := range items {
+		value, err := typeCasesFromJson(cases, item)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+	return values, nil
+}
+
+func typeCasesFromJson(cases dsl.TypeCases, node any) (binary.Value, error) {
+	if cases.IsSingle() {
+		return fromJson(cases[0].Type, node)
+	}
+
+	if cases.IsOptional() {
+		if node == nil {
+			return nil, nil
+		}
+		return fromJson(cases[1].Type, node)
+	}
+
+	if ndjsoncommon.IsSimpleUnion(cases) {
+		for i, c := range cases {
+			if jsonNodeMatches(node, ndjsoncommon.GetJsonDataType(c.Type)) {
+				return unionCaseFromJson(cases, i, node)
+			}
+		}
+		return nil, errors.New("invalid union value")
+	}
+
+	obj, ok := node.(jsonObject)
+	if !ok || len(obj) == 0 {
+		return nil, errors.New("expected a JSON object with a union tag")
+	}
+	for i, c := range cases {
+		if c.Tag == obj[0].Key {
+			return unionCaseFromJson(cases, i, obj[0].Value)
+		}
+	}
+	return nil, fmt.Errorf("unrecognized union tag '%s'", obj[0].Key)
+}
+
+func unionCaseFromJson(cases dsl.TypeCases, index int, node any) (binary.Value, error) {
+	c := cases[index]
+	value, err := fromJson(c.Type, node)
+	if err != nil || c.IsNullType() {
+		return value, err
+	}
+	return &binary.Union{Index: index, Tag: c.Tag, Value: value}, nil
+}
+
+func typeDefinitionFromJson(td dsl.TypeDefinition, node any) (binary.Value, error) {
+	switch td := td.(type) {
+	case dsl.PrimitiveDefinition:
+		return primitiveFromJson(td, node)
+	case *dsl.EnumDefinition:
+		return enumFromJson(td, node)
+	case *dsl.RecordDefinition:
+		obj, ok := node.(jsonObject)
+		if !ok {
+			return nil, fmt.Errorf("expected a JSON object for record '%s'", td.Name)
+		}
+		rec := &binary.Record{Definition: td, Fields: make([]binary.Value, len(td.Fields))}
+		for i, field := range td.Fields {
+			fieldNode, ok := obj.get(field.Name)
+			if !ok {
+				if gt, isGeneralized := field.Type.(*dsl.GeneralizedType); !isGeneralized || gt.Dimensionality != nil || !gt.Cases.HasNullOption() {
+					return nil, fmt.Errorf("%s.%s: missing field", td.Name, field.Name)
+				}
+			}
+			value, err := fromJson(field.Type, fieldNode)
+			if err != nil {
+				return nil, fmt.Errorf("%s.%s: %w", td.Name, field.Name, err)
+			}
+			rec.Fields[i] = value
+		}
+		return rec, nil
+	case *dsl.NamedType:
+		return fromJson(td.Type, node)
+	default:
+		return nil, fmt.Errorf("unexpected type definition %T", td)
+	}
+}
+
+func enumFromJson(enum *dsl.EnumDefinition, node any) (binary.Value, error) {
+	lookup := func(symbol any) (*big.Int, error) {
+		if s, ok := symbol.(string); ok {
+			for _, v := range enum.Values {
+				if v.Symbol == s {
+					return &v.IntegerValue, nil
+				}
+			}
+		}
+		return nil, fmt.Errorf("invalid value %v for enum '%s'", symbol, enum.Name)
+	}
+
+	var i *big.Int
+	var err error
+	switch node := node.(type) {
+	case string:
+		if i, err = lookup(node); err != nil {
+			return nil, err
+		}
+	case []any:
+		i = new(big.Int)
+		for _, item := range node {
+			v, err := lookup(item)
+			if err != nil {
+				return nil, err
+			}
+			i.Or(i, v)
+		}
+	case json.Number:
+		var ok bool
+		if i, ok = new(big.Int).SetString(node.String(), 10); !ok {
+			return nil, fmt.Errorf("invalid value %s for enum '%s'", node, enum.Name)
+		}
+	default:
+		return nil, fmt.Errorf("invalid value for enum '%s'", enum.Name)
+	}
+
+	return binary.NewEnum(enum, i)
+}
+
+func primitiveFromJson(p dsl.PrimitiveDefinition, node any) (binary.Value, error) {
+	mismatch := func() error {
+		return fmt.Errorf("invalid JSON value for type '%s'", p)
+	}
+
+	switch p {
+	case dsl.Bool:
+		if b, ok := node.(bool); ok {
+			return b, nil
+		}
+	case dsl.Int8, dsl.Int16, dsl.Int32, dsl.Int64, dsl.Uint8, dsl.Uint16, dsl.Uint32, dsl.Uint64, dsl.Size:
+		if n, ok := node.(json.Number); ok {
+			if i, ok := new(big.Int).SetString(n.String(), 10); ok {
+				return binary.BigIntToInteger(i, p)
+			}
+		}
+	case dsl.Float16, dsl.BFloat16, dsl.Float32:
+		f, err := floatFromJson(node, 32)
+		return float32(f), err
+	case dsl.Float64:
+		return floatFromJson(node, 64)
+	case dsl.ComplexFloat32, dsl.ComplexFloat64:
+		parts, ok := node.([]any)
+		if !ok || len(parts) != 2 {
+			return nil, mismatch()
+		}
+		bitSize := 64
+		if p == dsl.ComplexFloat32 {
+			bitSize = 32
+		}
+		re, err := floatFromJson(parts[0], bitSize)
+		if err != nil {
+			return nil, err
+		}
+		im, err := floatFromJson(parts[1], bitSize)
+		if err != nil {
+			return nil, err
+		}
+		if p == dsl.ComplexFloat32 {
+			return complex(float32(re), float32(im)), nil
+		}
+		return complex(re, im), nil
+	case dsl.String:
+		if s, ok := node.(string); ok {
+			return s, nil
+		}
+	case dsl.Bytes:
+		if s, ok := node.(string); ok {
+			if b, err := base64.StdEncoding.DecodeString(s); err == nil {
+				return b, nil
+			}
+		}
+	case dsl.Uuid:
+		if s, ok := node.(string); ok {
+			if u, err := binary.ParseUuid(s); err == nil {
+				return u, nil
+			}
+		}
+	case dsl.Date:
+		if s, ok := node.(string); ok {
+			if t, err := time.Parse(time.DateOnly, s); err == nil {
+				return binary.Date(t.Unix() / secondsPerDay), nil
+			}
+		}
+	case dsl.Time:
+		if s, ok := node.(string); ok {
+			if t, err := parseTime(s); err == nil {
+				return t, nil
+			}
+		}
+	case dsl.DateTime:
+		if s, ok := node.(string); ok {
+			for _, layout := range []string{dateTimeLayout, time.RFC3339Nano} {
+				if t, err := time.Parse(layout, s); err == nil {
+					return binary.DateTime(t.UnixNano()), nil
+				}
+			}
+		}
+	case dsl.Duration:
+		if n, ok := node.(json.Number); ok {
+			if v, err := strconv.ParseInt(n.String(), 10, 64); err == nil {
+				return binary.Duration(v), nil
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unexpected primitive type '%s'", p)
+	}
+
+	return nil, mismatch()
+}
+
+func floatFromJson(node any, bitSize int) (float64, error) {
+	switch node := node.(type) {
+	case nil:
+		return math.NaN(), nil
+	case json.Number:
+		return strconv.ParseFloat(node.String(), bitSize)
+	default:
+		return 0, errors.New("expected a JSON number")
+	}
+}
+
+const (
+	secondsPerDay  = 24 * 60 * 60
+	dateTimeLayout = "2006-01-02T15:04:05.999999999"
+)
+
+// Parses a time of day in the form HH:MM[:SS[.fraction]]
+func parseTime(s string) (binary.Time, error) {
+	invalid := fmt.Errorf("invalid time '%s'", s)
+
+	whole, fraction, hasFraction := strings.Cut(s, ".")
+	parts := strings.Split(whole, ":")
+	if len(parts) < 2 || len(parts) > 3 || len(fraction) > 9 || (hasFraction && len(parts) != 3) {
+		return 0, invalid
+	}
+
+	var seconds int64
+	for i, limit := range []int64{24, 60, 60} {
+		var v int64
+		if i < len(parts) {
+			var err error
+			v, err = strconv.ParseInt(parts[i], 10, 64)
+			if err != nil || v < 0 || v >= limit {
+				return 0, invalid
+			}
+		}
+		seconds = seconds*60 + v
+	}
+	ns := seconds * int64(time.Second)
+
+	if fraction != "" {
+		f, err := strconv.ParseInt(fraction+strings.Repeat("0", 9-len(fraction)), 10, 64)
+		if err != nil || f < 0 {
+			return 0, invalid
+		}
+		ns += f
+	}
+
+	return binary.Time(ns), nil
+}
+
+func toJson(buf *bytes.Buffer, t dsl.Type, value binary.Value) error {
+	switch t := t.(type) {
+	case nil:
+		if value != nil {
+			return fmt.Errorf("expected null, got %T", value)
+		}
+		buf.WriteString("null")
+	case *dsl.SimpleType:
+		return typeDefinitionToJson(buf, t.ResolvedDefinition, value)
+	case *dsl.GeneralizedType:
+		switch dim := t.Dimensionality.(type) {
+		case nil:
+			return typeCasesToJson(buf, t.Cases, value)
+		case *dsl.Vector:
+			items, ok := value.([]binary.Value)
+			if !ok {
+				return fmt.Errorf("expected a vector, got %T", value)
+			}
+			return itemsToJson(buf, t.Cases, items)
+		case *dsl.Array:
+			arr, ok := value.(*binary.Array)
+			if !ok {
+				return fmt.Errorf("expected an array, got %T", value)
+			}
+			if err := arr.CheckShape(dim); err != nil {
+				return err
+			}
+			if dim.IsFixed() {
+				return itemsToJson(buf, t.Cases, arr.Data)
+			}
+
+			buf.WriteString(`{"shape":[`)
+			for i, length := range arr.Shape {
+				if i > 0 {
+					buf.WriteByte(',')
+				}
+				buf.WriteString(strconv.FormatUint(length, 10))
+			}
+			buf.WriteString(`],"data":`)
+			if err := itemsToJson(buf, t.Cases, arr.Data); err != nil {
+				return err
+			}
+			buf.WriteByte('}')
+		case *dsl.Map:
+			m, ok := value.(*binary.Map)
+			if !ok {
+				return fmt.Errorf("expected a map, got %T", value)
+			}
+			stringKeys := isStringType(dim.KeyType)
+			if stringKeys {
+				buf.WriteByte('{')
+			} else {
+				buf.WriteByte('[')
+			}
+			for i, entry := range m.Entries {
+				if i > 0 {
+					buf.WriteByte(',')
+				}
+				if stringKeys {
+					key, ok := entry.Key.(string)
+					if !ok {
+						return fmt.Errorf("expected a string map key, got %T", entry.Key)
+					}
+					writeJsonString(buf, key)
+					buf.WriteByte(':')
+				} else {
+					buf.WriteByte('[')
+					if err := toJson(buf, dim.KeyType, entry.Key); err != nil {
+						return err
+					}
+					buf.WriteByte(',')
+				}
+				if err := typeCasesToJson(buf, t.Cases, entry.Value); err != nil {
+					return err
+				}
+				if !stringKeys {
+					buf.WriteByte(']')
+				}
+			}
+			if stringKeys {
+				buf.WriteByte('}')
+			} else {
+				buf.WriteByte(']')
+			}
+		default:
+			return fmt.Errorf("unexpected dimensionality %T", dim)
+		}
+	default:
+		return fmt.Errorf("unexpected type %T", t)
+	}
+
+	return nil
+}
+
+func itemsToJson(buf *bytes.Buffer, cases dsl.TypeCases, items []binary.Value) error {
+	buf.WriteByte('[')
+	for i, item := range items {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		if err := typeCasesToJson(buf, cases, item); err != nil {
+			return err
+		}
+	}
+	buf.WriteByte(']')
+	return nil
+}
+
+func typeCasesToJson(buf *bytes.Buffer, cases dsl.TypeCases, value binary.Value) error {
+	if cases.IsSingle() {
+		return toJson(buf, cases[0].Type, value)
+	}
+
+	if value == nil {
+		if !cases.HasNullOption() {
+			return errors.New("null is not a valid value for this type")
+		}
+		if cases.IsOptional() || ndjsoncommon.IsSimpleUnion(cases) {
+			buf.WriteString("null")
+		} else {
+			buf.WriteByte('{')
+			writeJsonString(buf, cases[0].Tag)
+			buf.WriteString(":null}")
+		}
+		return nil
+	}
+
+	if cases.IsOptional() {
+		return toJson(buf, cases[1].Type, value)
+	}
+
+	u, ok := value.(*binary.Union)
+	if !ok {
+		return fmt.Errorf("expected a union value, got %T", value)
+	}
+	if u.Index < 0 || u.Index >= len(cases) || cases[u.Index].IsNullType() {
+		return fmt.Errorf("union case index %d is out of range", u.Index)
+	}
+
+	if ndjsoncommon.IsSimpleUnion(cases) {
+		return toJson(buf, cases[u.Index].Type, u.Value)
+	}
+
+	buf.WriteByte('{')
+	writeJsonString(buf, cases[u.Index].Tag)
+	buf.WriteByte(':')
+	if err := toJson(buf, cases[u.Index].Type, u.Value); err != nil {
+		return err
+	}
+	buf.WriteByte('}')
+	return nil
+}
+
+func typeDefinitionToJson(buf *bytes.Buffer, td dsl.TypeDefinition, value binary.Value) error {
+	switch td := td.(type) {
+	case dsl.PrimitiveDefinition:
+		return primitiveToJson(buf, td, value)
+	case *dsl.EnumDefinition:
+		enum, ok := value.(*binary.Enum)
+		if !ok {
+			return fmt.Errorf("expected a value of enum '%s', got %T", td.Name, value)
+		}
+		return enumToJson(buf, enum)
+	case *dsl.RecordDefinition:
+		rec, ok := value.(*binary.Record)
+		if !ok {
+			return fmt.Errorf("expected a value of record '%s', got %T", td.Name, value)
+		}
+		if len(rec.Fields) != len(td.Fields) {
+			return fmt.Errorf("expected %d fields for record '%s', got %d", len(td.Fields), td.Name, len(rec.Fields))
+		}
+		buf.WriteByte('{')
+		first := true
+		for i, field := range td.Fields {
+			// null values of optionals and unions with a null case are omitted
+			if rec.Fields[i] == nil {
+				if gt, ok := field.Type.(*dsl.GeneralizedType); ok && gt.Dimensionality == nil && gt.Cases.HasNullOption() {
+					continue
+				}
+			}
+			if !first {
+				buf.WriteByte(',')
+			}
+			first = false
+			writeJsonString(buf, field.Name)
+			buf.WriteByte(':')
+			if err := toJson(buf, field.Type, rec.Fields[i]); err != nil {
+				return fmt.Errorf("%s.%s: %w", td.Name, field.Name, err)
+			}
+		}
+		buf.WriteByte('}')
+		return nil
+	case *dsl.NamedType:
+		return toJson(buf, td.Type, value)
+	default:
+		return fmt.Errorf("unexpected type definition %T", td)
+	}
+}
+
+func enumToJson(buf *bytes.Buffer, enum *binary.Enum) error {
+	symbols, ok, err := enum.Symbols()
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		i, _ := binary.IntegerToBigInt(enum.Value)
+		buf.WriteString(i.String())
+		return nil
+	}
+
+	if !enum.Definition.IsFlags {
+		writeJsonString(buf, symbols[0])
+		return nil
+	}
+
+	buf.WriteByte('[')
+	for i, s := range symbols {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		writeJsonString(buf, s)
+	}
+	buf.WriteByte(']')
+	return nil
+}
+
+func primitiveToJson(buf *bytes.Buffer, p dsl.PrimitiveDefinition, value binary.Value) error {
+	mismatch := func() error {
+		return fmt.Errorf("expected a value of type '%s', got %T", p, value)
+	}
+
+	switch p {
+	case dsl.Bool:
+		v, ok := value.(bool)
+		if !ok {
+			return mismatch()
+		}
+		buf.WriteString(strconv.FormatBool(v))
+	case dsl.Int8, dsl.Int16, dsl.Int32, dsl.Int64, dsl.Uint8, dsl.Uint16, dsl.Uint32, dsl.Uint64, dsl.Size:
+		i, err := binary.IntegerToBigInt(value)
+		if err != nil {
+			return mismatch()
+		}
+		if _, err := binary.BigIntToInteger(i, p); err != nil {
+			return err
+		}
+		buf.WriteString(i.String())
+	case dsl.Float16, dsl.BFloat16, dsl.Float32:
+		v, ok := value.(float32)
+		if !ok {
+			return mismatch()
+		}
+		writeJsonFloat(buf, float64(v), 32)
+	case dsl.Float64:
+		v, ok := value.(float64)
+		if !ok {
+			return mismatch()
+		}
+		writeJsonFloat(buf, v, 64)
+	case dsl.ComplexFloat32:
+		v, ok := value.(complex64)
+		if !ok {
+			return mismatch()
+		}
+		buf.WriteByte('[')
+		writeJsonFloat(buf, float64(real(v)), 32)
+		buf.WriteByte(',')
+		writeJsonFloat(buf, float64(imag(v)), 32)
+		buf.WriteByte(']')
+	case dsl.ComplexFloat64:
+		v, ok := value.(complex128)
+		if !ok {
+			return mismatch()
+		}
+		buf.WriteByte('[')
+		writeJsonFloat(buf, real(v), 64)
+		buf.WriteByte(',')
+		writeJsonFloat(buf, imag(v), 64)
+		buf.WriteByte(']')
+	case dsl.String:
+		v, ok := value.(string)
+		if !ok {
+			return mismatch()
+		}
+		writeJsonString(buf, v)
+	case dsl.Bytes:
+		v, ok := value.([]byte)
+		if !ok {
+			return mismatch()
+		}
+		writeJsonString(buf, base64.StdEncoding.EncodeToString(v))
+	case dsl.Uuid:
+		v, ok := value.(binary.Uuid)
+		if !ok {
+			return mismatch()
+		}
+		writeJsonString(buf, v.String())
+	case dsl.Date:
+		v, ok := value.(binary.Date)
+		if !ok {
+			return mismatch()
+		}
+		writeJsonString(buf, v.String())
+	case dsl.Time:
+		v, ok := value.(binary.Time)
+		if !ok {
+			return mismatch()
+		}
+		writeJsonString(buf, v.String())
+	case dsl.DateTime:
+		v, ok := value.(binary.DateTime)
+		if !ok {
+			return mismatch()
+		}
+		writeJsonString(buf, v.String())
+	case dsl.Duration:
+		v, ok := value.(binary.Duration)
+		if !ok {
+			return mismatch()
+		}
+		buf.WriteString(strconv.FormatInt(int64(v), 10))
+	default:
+		return fmt.Errorf("unexpected primitive type '%s'", p)
+	}
+
+	return nil
+}
+
+// Writes a float, writing NaN and infinite values as null
+func writeJsonFloat(buf *bytes.Buffer, v float64, bitSize int) {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		buf.WriteString("null")
+		return
+	}
+
+	var b []byte
+	if bitSize == 32 {
+		b, _ = json.Marshal(float32(v))
+	} else {
+		b, _ = json.Marshal(v)
+	}
+	buf.Write(b)
+}
+
+func writeJsonString(buf *bytes.Buffer, s string) {
+	var b bytes.Buffer
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	enc.Encode(s)
+	buf.Write(bytes.TrimSuffix(b.Bytes(), []byte("\n")))
+}
+
+func isStringType(t dsl.Type) bool {
+	p, ok := dsl.GetPrimitiveType(t)
+	return ok && p == dsl.String
+}
@@ -0,0 +1,64 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+// Package dynamic reads and writes yardl protocol data in the binary and NDJSON
+// formats using type definitions that are loaded at runtime, for example from the
+// protocol schema embedded in a file, rather than through generated code.
+// Values are represented with the types of the binary package.
+package dynamic
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/microsoft/yardl/tooling/pkg/binary"
+	"github.com/microsoft/yardl/tooling/pkg/dsl"
+)
+
+// Reader reads protocol step values from an encoded stream.
+type Reader interface {
+	// Protocol returns the protocol definition parsed from the stream's schema.
+	Protocol() *dsl.ProtocolDefinition
+	// Schema returns the protocol schema JSON embedded in the stream.
+	Schema() string
+	// Read returns the next protocol step value. For stream steps, each call
+	// returns a single stream item. Returns io.EOF after the last step.
+	Read() (*dsl.ProtocolStep, binary.Value, error)
+}
+
+// Writer writes protocol step values to an encoded stream.
+type Writer interface {
+	Write(step *dsl.ProtocolStep, value binary.Value) error
+	Close() error
+}
+
+type Format string
+
+const (
+	FormatBinary Format = "binary"
+	FormatNDJson Format = "ndjson"
+)
+
+var Formats = []Format{FormatBinary, FormatNDJson}
+
+func NewReader(format Format, r io.Reader) (Reader, error) {
+	switch format {
+	case FormatBinary:
+		return binary.NewSchemaReader(r)
+	case FormatNDJson:
+		return NewNDJsonReader(r)
+	default:
+		return nil, fmt.Errorf("unsupported format '%s'", format)
+	}
+}
+
+func NewWriter(format Format, w io.Writer, protocol *dsl.ProtocolDefinition, schema string) (Writer, error) {
+	switch format {
+	case FormatBinary:
+		return binary.NewWriter(w, protocol, schema)
+	case FormatNDJson:
+		return NewNDJsonWriter(w, protocol, schema)
+	default:
+		return nil, fmt.Errorf("unsupported format '%s'", format)
+	}
+}
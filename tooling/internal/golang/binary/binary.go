@@ -0,0 +1,629 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+package binary
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/microsoft/yardl/tooling/internal/formatting"
+	"github.com/microsoft/yardl/tooling/internal/golang/common"
+	"github.com/microsoft/yardl/tooling/pkg/dsl"
+	"github.com/microsoft/yardl/tooling/pkg/packaging"
+)
+
+func WriteBinary(ns *dsl.Namespace, options packaging.GoCodegenOptions, packageDir string) error {
+	f := common.NewBinaryFile(options, ns)
+	w := f.Writer()
+
+	unions := make(map[string]bool)
+	for _, td := range ns.TypeDefinitions {
+		for _, u := range common.GetUnionDeclarations(td) {
+			if name := unionSerializerName(u.Type); u.NamedType == nil && !unions[name] {
+				unions[name] = true
+				writeUnionSerializers(w, f, u)
+			}
+		}
+
+		switch td := td.(type) {
+		case *dsl.EnumDefinition:
+			writeEnumSerializers(w, f, td)
+		case *dsl.RecordDefinition:
+			writeRecordSerializers(w, f, td)
+		case *dsl.NamedType:
+			writeNamedTypeSerializers(w, f, td)
+		default:
+			panic(fmt.Sprintf("unsupported type definition: %T", td))
+		}
+	}
+
+	for _, p := range ns.Protocols {
+		for _, u := range common.GetUnionDeclarations(p) {
+			if name := unionSerializerName(u.Type); !unions[name] {
+				unions[name] = true
+				writeUnionSerializers(w, f, u)
+			}
+		}
+		writeProtocolWriter(w, f, p)
+		writeProtocolReader(w, f, p)
+	}
+
+	return f.Save(path.Join(packageDir, "binary.go"))
+}
+
+// Returns the name of the writer function for a non-generic type definition,
+// or the name of the function that returns the writer for a generic one.
+func writerFunctionName(name string, typeParameters []*dsl.GenericTypeParameter, exported bool) string {
+	if len(typeParameters) > 0 {
+		if exported {
+			return name + "Writer"
+		}
+		return lowerFirst(name) + "Writer"
+	}
+	if exported {
+		return "Write" + name
+	}
+	return "write" + name
+}
+
+func readerFunctionName(name string, typeParameters []*dsl.GenericTypeParameter, exported bool) string {
+	if len(typeParameters) > 0 {
+		if exported {
+			return name + "Reader"
+		}
+		return lowerFirst(name) + "Reader"
+	}
+	if exported {
+		return "Read" + name
+	}
+	return "read" + name
+}
+
+func typeParameterWriterName(p *dsl.GenericTypeParameter) string {
+	return "write" + common.TypeParameterName(p)
+}
+
+func typeParameterReaderName(p *dsl.GenericTypeParameter) string {
+	return "read" + common.TypeParameterName(p)
+}
+
+func writerSignature(f *common.File, typeSyntax string) string {
+	return fmt.Sprintf("func(*%s.BinaryWriter, %s)", f.Yardl(), typeSyntax)
+}
+
+func readerSignature(f *common.File, typeSyntax string) string {
+	return fmt.Sprintf("func(*%s.BinaryReader) %s", f.Yardl(), typeSyntax)
+}
+
+func typeParameterWriterParameters(f *common.File, typeParameters []*dsl.GenericTypeParameter) string {
+	params := make([]string, len(typeParameters))
+	for i, p := range typeParameters {
+		params[i] = fmt.Sprintf("%s %s", typeParameterWriterName(p), writerSignature(f, common.TypeParameterName(p)))
+	}
+	return strings.Join(params, ", ")
+}
+
+func typeParameterReaderParameters(f *common.File, typeParameters []*dsl.GenericTypeParameter) string {
+	params := make([]string, len(typeParameters))
+	for i, p := range typeParameters {
+		params[i] = fmt.Sprintf("%s %s", typeParameterReaderName(p), readerSignature(f, common.TypeParameterName(p)))
+	}
+	return strings.Join(params, ", ")
+}
+
+// Writes the declarations of a writer and reader for a type definition. For
+// generic type definitions, the declared functions take the serializers for the
+// type arguments and return the writer or reader.
+func writeSerializerFunctions(
+	w *formatting.IndentedWriter,
+	f *common.File,
+	name string,
+	typeParameters []*dsl.GenericTypeParameter,
+	exported bool,
+	typeSyntax string,
+	writeWriterBody func(),
+	writeReaderBody func()) {
+
+	typeParametersDeclaration := common.TypeParametersDeclaration(typeParameters)
+
+	if len(typeParameters) == 0 {
+		fmt.Fprintf(w, "func %s(w *%s.BinaryWriter, value %s) {\n", writerFunctionName(name, typeParameters, exported), f.Yardl(), typeSyntax)
+		w.Indented(writeWriterBody)
+		w.WriteString("}\n\n")
+
+		fmt.Fprintf(w, "func %s(r *%s.BinaryReader) (value %s) {\n", readerFunctionName(name, typeParameters, exported), f.Yardl(), typeSyntax)
+		w.Indented(writeReaderBody)
+		w.WriteString("}\n\n")
+		return
+	}
+
+	fmt.Fprintf(w, "func %s%s(%s) %s {\n", writerFunctionName(name, typeParameters, exported), typeParametersDeclaration, typeParameterWriterParameters(f, typeParameters), writerSignature(f, typeSyntax))
+	w.Indented(func() {
+		fmt.Fprintf(w, "return func(w *%s.BinaryWriter, value %s) {\n", f.Yardl(), typeSyntax)
+		w.Indented(writeWriterBody)
+		w.WriteStringln("}")
+	})
+	w.WriteString("}\n\n")
+
+	fmt.Fprintf(w, "func %s%s(%s) %s {\n", readerFunctionName(name, typeParameters, exported), typeParametersDeclaration, typeParameterReaderParameters(f, typeParameters), readerSignature(f, typeSyntax))
+	w.Indented(func() {
+		fmt.Fprintf(w, "return func(r *%s.BinaryReader) (value %s) {\n", f.Yardl(), typeSyntax)
+		w.Indented(writeReaderBody)
+		w.WriteStringln("}")
+	})
+	w.WriteString("}\n\n")
+}
+
+func writeEnumSerializers(w *formatting.IndentedWriter, f *common.File, enum *dsl.EnumDefinition) {
+	baseType := dsl.Type(dsl.Int32Type)
+	if enum.BaseType != nil {
+		baseType = enum.BaseType
+	}
+	primitive, ok := dsl.GetPrimitiveType(baseType)
+	if !ok {
+		panic(fmt.Sprintf("enum base type %s is not a primitive", dsl.TypeToShortSyntax(baseType, true)))
+	}
+
+	typeSyntax := common.TypeSyntax(f, enum)
+	writeSerializerFunctions(w, f, common.TypeIdentifierName(enum.Name), nil, true, typeSyntax,
+		func() {
+			fmt.Fprintf(w, "%s(w, %s(value))\n", typeWriter(f, baseType), common.TypeSyntax(f, primitive))
+		},
+		func() {
+			fmt.Fprintf(w, "return %s(%s(r))\n", typeSyntax, typeReader(f, baseType))
+		})
+}
+
+func writeRecordSerializers(w *formatting.IndentedWriter, f *common.File, rec *dsl.RecordDefinition) {
+	writeSerializerFunctions(w, f, common.TypeIdentifierName(rec.Name), rec.TypeParameters, true, common.TypeSyntax(f, rec),
+		func() {
+			for _, field := range rec.Fields {
+				fmt.Fprintf(w, "%s(w, value.%s)\n", typeWriter(f, field.Type), common.FieldIdentifierName(field.Name))
+			}
+		},
+		func() {
+			for _, field := range rec.Fields {
+				fmt.Fprintf(w, "value.%s = %s(r)\n", common.FieldIdentifierName(field.Name), typeReader(f, field.Type))
+			}
+			w.WriteStringln("return")
+		})
+}
+
+func writeNamedTypeSerializers(w *formatting.IndentedWriter, f *common.File, nt *dsl.NamedType) {
+	if gt, ok := nt.Type.(*dsl.GeneralizedType); ok && gt.Cases.IsUnion() && gt.Dimensionality == nil {
+		writeUnionSerializers(w, f, &common.UnionDeclaration{
+			Name:           common.TypeIdentifierName(nt.Name),
+			TypeParameters: nt.TypeParameters,
+			Type:           gt,
+			NamedType:      nt,
+		})
+		return
+	}
+
+	writeSerializerFunctions(w, f, common.TypeIdentifierName(nt.Name), nt.TypeParameters, true, common.TypeSyntax(f, nt),
+		func() {
+			fmt.Fprintf(w, "%s(w, value)\n", typeWriter(f, nt.Type))
+		},
+		func() {
+			fmt.Fprintf(w, "return %s(r)\n", typeReader(f, nt.Type))
+		})
+}
+
+func writeUnionSerializers(w *formatting.IndentedWriter, f *common.File, u *common.UnionDeclaration) {
+	namespace := f.Namespace()
+	typeSyntax := f.TypesQualifier(namespace) + u.Name + common.TypeParametersReference(u.TypeParameters)
+	exported := u.NamedType != nil
+	serializerName := u.Name
+	if !exported {
+		serializerName = unionSerializerName(u.Type)
+	}
+
+	writeSerializerFunctions(w, f, serializerName, u.TypeParameters, exported, typeSyntax,
+		func() {
+			w.WriteStringln("switch value := value.(type) {")
+			for i, typeCase := range u.Type.Cases {
+				if typeCase.Type == nil {
+					w.WriteStringln("case nil:")
+					w.Indented(func() {
+						fmt.Fprintf(w, "w.WriteUvarint(%d)\n", i)
+					})
+					continue
+				}
+				fmt.Fprintf(w, "case %s:\n", common.UnionCaseTypeSyntax(f, namespace, u.Name, typeCase))
+				w.Indented(func() {
+					fmt.Fprintf(w, "w.WriteUvarint(%d)\n", i)
+					fmt.Fprintf(w, "%s(w, value.Value)\n", typeWriter(f, typeCase.Type))
+				})
+			}
+			w.WriteStringln("default:")
+			w.Indented(func() {
+				fmt.Fprintf(w, "w.Fail(%s.Errorf(\"unexpected union case %%T\", value))\n", f.Import("fmt"))
+			})
+			w.WriteStringln("}")
+		},
+		func() {
+			w.WriteStringln("switch index := r.ReadUvarint(); index {")
+			for i, typeCase := range u.Type.Cases {
+				fmt.Fprintf(w, "case %d:\n", i)
+				w.Indented(func() {
+					if typeCase.Type == nil {
+						w.WriteStringln("return nil")
+					} else {
+						fmt.Fprintf(w, "return %s{Value: %s(r)}\n", common.UnionCaseTypeSyntax(f, namespace, u.Name, typeCase), typeReader(f, typeCase.Type))
+					}
+				})
+			}
+			w.WriteStringln("default:")
+			w.Indented(func() {
+				fmt.Fprintf(w, "r.Fail(%s.Errorf(\"unexpected union index %%d\", index))\n", f.Import("fmt"))
+				w.WriteStringln("return nil")
+			})
+			w.WriteStringln("}")
+		})
+}
+
+func typeDefinitionWriter(f *common.File, td dsl.TypeDefinition) string {
+	switch td := td.(type) {
+	case dsl.PrimitiveDefinition:
+		return fmt.Sprintf("%s.Write%s", f.Yardl(), primitiveSerializerSuffix(f, td))
+	case *dsl.GenericTypeParameter:
+		return typeParameterWriterName(td)
+	default:
+		meta := td.GetDefinitionMeta()
+		name := f.BinaryQualifier(meta.Namespace) + writerFunctionName(common.TypeIdentifierName(meta.Name), meta.TypeParameters, true)
+		if len(meta.TypeParameters) == 0 {
+			return name
+		}
+
+		args := make([]string, len(meta.TypeParameters))
+		for i, p := range meta.TypeParameters {
+			if len(meta.TypeArguments) > 0 {
+				args[i] = typeWriter(f, meta.TypeArguments[i])
+			} else {
+				args[i] = typeParameterWriterName(p)
+			}
+		}
+		return fmt.Sprintf("%s(%s)", name, strings.Join(args, ", "))
+	}
+}
+
+func typeDefinitionReader(f *common.File, td dsl.TypeDefinition) string {
+	switch td := td.(type) {
+	case dsl.PrimitiveDefinition:
+		return fmt.Sprintf("%s.Read%s", f.Yardl(), primitiveSerializerSuffix(f, td))
+	case *dsl.GenericTypeParameter:
+		return typeParameterReaderName(td)
+	default:
+		meta := td.GetDefinitionMeta()
+		name := f.BinaryQualifier(meta.Namespace) + readerFunctionName(common.TypeIdentifierName(meta.Name), meta.TypeParameters, true)
+		if len(meta.TypeParameters) == 0 {
+			return name
+		}
+
+		args := make([]string, len(meta.TypeParameters))
+		for i, p := range meta.TypeParameters {
+			if len(meta.TypeArguments) > 0 {
+				args[i] = typeReader(f, meta.TypeArguments[i])
+			} else {
+				args[i] = typeParameterReaderName(p)
+			}
+		}
+		return fmt.Sprintf("%s(%s)", name, strings.Join(args, ", "))
+	}
+}
+
+// Returns the suffix of the runtime serializer functions for a primitive,
+// e.g. "Int32" for yardl.WriteInt32 and yardl.ReadInt32
+func primitiveSerializerSuffix(f *common.File, p dsl.PrimitiveDefinition) string {
+	switch p {
+	case dsl.Bytes:
+		return "Bytes"
+	case dsl.Float16:
+		return "Float16"
+	case dsl.BFloat16:
+		return "BFloat16"
+	}
+	syntax := common.TypeSyntax(f, p)
+	syntax = strings.TrimPrefix(syntax, f.Yardl()+".")
+	return strings.ToUpper(syntax[:1]) + syntax[1:]
+}
+
+func lowerFirst(s string) string {
+	return strings.ToLower(s[:1]) + s[1:]
+}
+
+// Returns the base name of the serializers of an anonymous union. Unions that
+// differ only in whether they have a null case share a Go type but are
+// encoded differently.
+func unionSerializerName(gt *dsl.GeneralizedType) string {
+	if gt.Cases.HasNullOption() {
+		return "NullOr" + common.UnionTypeName(gt)
+	}
+	return common.UnionTypeName(gt)
+}
+
+func unionWriter(f *common.File, gt *dsl.GeneralizedType) string {
+	typeParameters := common.GetOpenGenericTypeParameters(gt)
+	name := writerFunctionName(unionSerializerName(gt), typeParameters, false)
+	if len(typeParameters) == 0 {
+		return name
+	}
+
+	args := make([]string, len(typeParameters))
+	for i, p := range typeParameters {
+		args[i] = typeParameterWriterName(p)
+	}
+	return fmt.Sprintf("%s(%s)", name, strings.Join(args, ", "))
+}
+
+func unionReader(f *common.File, gt *dsl.GeneralizedType) string {
+	typeParameters := common.GetOpenGenericTypeParameters(gt)
+	name := readerFunctionName(unionSerializerName(gt), typeParameters, false)
+	if len(typeParameters) == 0 {
+		return name
+	}
+
+	args := make([]string, len(typeParameters))
+	for i, p := range typeParameters {
+		args[i] = typeParameterReaderName(p)
+	}
+	return fmt.Sprintf("%s(%s)", name, strings.Join(args, ", "))
+}
+
+// Returns an expression that evaluates to a function that writes a value of the given type.
+func typeWriter(f *common.File, t dsl.Type) string {
+	switch t := t.(type) {
+	case *dsl.SimpleType:
+		return typeDefinitionWriter(f, t.ResolvedDefinition)
+	case *dsl.GeneralizedType:
+		scalarWriter := func() string {
+			if t.Cases.IsSingle() {
+				return typeWriter(f, t.Cases[0].Type)
+			}
+			if t.Cases.IsOptional() {
+				return fmt.Sprintf("%s.OptionalWriter(%s)", f.Yardl(), typeWriter(f, t.Cases[1].Type))
+			}
+			return unionWriter(f, t)
+		}()
+
+		switch d := t.Dimensionality.(type) {
+		case nil, *dsl.Stream:
+			return scalarWriter
+		case *dsl.Vector:
+			if d.Length != nil {
+				return fixedVectorWriter(f, scalarWriter, common.TypeSyntax(f, t.ToScalar()), *d.Length)
+			}
+			return fmt.Sprintf("%s.VectorWriter(%s)", f.Yardl(), scalarWriter)
+		case *dsl.Array:
+			if d.IsFixed() {
+				writer := scalarWriter
+				elementSyntax := common.TypeSyntax(f, t.ToScalar())
+				dims := *d.Dimensions
+				for i := len(dims) - 1; i >= 0; i-- {
+					writer = fixedVectorWriter(f, writer, elementSyntax, *dims[i].Length)
+					elementSyntax = fmt.Sprintf("[%d]%s", *dims[i].Length, elementSyntax)
+				}
+				return writer
+			}
+			if d.HasKnownNumberOfDimensions() {
+				return fmt.Sprintf("%s.NDArrayWriter(%s, %d)", f.Yardl(), scalarWriter, len(*d.Dimensions))
+			}
+			return fmt.Sprintf("%s.DynamicNDArrayWriter(%s)", f.Yardl(), scalarWriter)
+		case *dsl.Map:
+			return fmt.Sprintf("%s.MapWriter(%s, %s)", f.Yardl(), typeWriter(f, d.KeyType), scalarWriter)
+		default:
+			panic(fmt.Sprintf("unexpected dimensionality %T", d))
+		}
+	default:
+		panic(fmt.Sprintf("unexpected type %T", t))
+	}
+}
+
+// Returns an expression that evaluates to a function that reads a value of the given type.
+func typeReader(f *common.File, t dsl.Type) string {
+	switch t := t.(type) {
+	case *dsl.SimpleType:
+		return typeDefinitionReader(f, t.ResolvedDefinition)
+	case *dsl.GeneralizedType:
+		scalarReader := func() string {
+			if t.Cases.IsSingle() {
+				return typeReader(f, t.Cases[0].Type)
+			}
+			if t.Cases.IsOptional() {
+				return fmt.Sprintf("%s.OptionalReader(%s)", f.Yardl(), typeReader(f, t.Cases[1].Type))
+			}
+			return unionReader(f, t)
+		}()
+
+		switch d := t.Dimensionality.(type) {
+		case nil, *dsl.Stream:
+			return scalarReader
+		case *dsl.Vector:
+			if d.Length != nil {
+				return fixedVectorReader(f, scalarReader, common.TypeSyntax(f, t.ToScalar()), *d.Length)
+			}
+			return fmt.Sprintf("%s.VectorReader(%s)", f.Yardl(), scalarReader)
+		case *dsl.Array:
+			if d.IsFixed() {
+				reader := scalarReader
+				elementSyntax := common.TypeSyntax(f, t.ToScalar())
+				dims := *d.Dimensions
+				for i := len(dims) - 1; i >= 0; i-- {
+					reader = fixedVectorReader(f, reader, elementSyntax, *dims[i].Length)
+					elementSyntax = fmt.Sprintf("[%d]%s", *dims[i].Length, elementSyntax)
+				}
+				return reader
+			}
+			if d.HasKnownNumberOfDimensions() {
+				return fmt.Sprintf("%s.NDArrayReader(%s, %d)", f.Yardl(), scalarReader, len(*d.Dimensions))
+			}
+			return fmt.Sprintf("%s.DynamicNDArrayReader(%s)", f.Yardl(), scalarReader)
+		case *dsl.Map:
+			return fmt.Sprintf("%s.MapReader(%s, %s)", f.Yardl(), typeReader(f, d.KeyType), scalarReader)
+		default:
+			panic(fmt.Sprintf("unexpected dimensionality %T", d))
+		}
+	default:
+		panic(fmt.Sprintf("unexpected type %T", t))
+	}
+}
+
+func fixedVectorWriter(f *common.File, elementWriter string, elementSyntax string, length uint64) string {
+	return fmt.Sprintf("func(w *%s.BinaryWriter, value [%d]%s) { %s.WriteFixedVector(w, value[:], %s) }", f.Yardl(), length, elementSyntax, f.Yardl(), elementWriter)
+}
+
+func fixedVectorReader(f *common.File, elementReader string, elementSyntax string, length uint64) string {
+	return fmt.Sprintf("func(r *%s.BinaryReader) (value [%d]%s) { %s.ReadFixedVector(r, value[:], %s); return }", f.Yardl(), length, elementSyntax, f.Yardl(), elementReader)
+}
+
+func binaryWriterName(p *dsl.ProtocolDefinition) string {
+	return common.WriterInterfaceName(p)
+}
+
+func binaryWriterImplName(p *dsl.ProtocolDefinition) string {
+	return lowerFirst(common.WriterImplInterfaceName(p))
+}
+
+func binaryReaderName(p *dsl.ProtocolDefinition) string {
+	return common.ReaderInterfaceName(p)
+}
+
+func binaryReaderImplName(p *dsl.ProtocolDefinition) string {
+	return lowerFirst(common.ReaderImplInterfaceName(p))
+}
+
+func streamReaderFieldName(step *dsl.ProtocolStep) string {
+	return step.Name + "Stream"
+}
+
+func writeProtocolWriter(w *formatting.IndentedWriter, f *common.File, p *dsl.ProtocolDefinition) {
+	typesQualifier := f.TypesQualifier(p.Namespace)
+	writerName := binaryWriterName(p)
+	implName := binaryWriterImplName(p)
+
+	fmt.Fprintf(w, "// %s writes the %s protocol in binary format.\n", writerName, p.Name)
+	fmt.Fprintf(w, "type %s struct {\n", writerName)
+	w.Indented(func() {
+		fmt.Fprintf(w, "*%s%s\n", typesQualifier, common.AbstractWriterName(p))
+	})
+	w.WriteString("}\n\n")
+
+	fmt.Fprintf(w, "func New%s(w %s.Writer) *%s {\n", writerName, f.Import("io"), writerName)
+	w.Indented(func() {
+		fmt.Fprintf(w, "impl := &%s{w: %s.NewBinaryWriter(w)}\n", implName, f.Yardl())
+		fmt.Fprintf(w, "impl.w.WriteHeader(%s%s)\n", typesQualifier, common.SchemaConstantName(p))
+		fmt.Fprintf(w, "return &%s{%sNew%s(impl)}\n", writerName, typesQualifier, common.AbstractWriterName(p))
+	})
+	w.WriteString("}\n\n")
+
+	fmt.Fprintf(w, "type %s struct {\n", implName)
+	w.Indented(func() {
+		fmt.Fprintf(w, "w *%s.BinaryWriter\n", f.Yardl())
+	})
+	w.WriteString("}\n\n")
+
+	for _, step := range p.Sequence {
+		valueSyntax := common.TypeSyntax(f, step.Type)
+		if step.IsStream() {
+			fmt.Fprintf(w, "func (impl *%s) %s(values []%s) error {\n", implName, common.ProtocolWriteImplMethodName(step), valueSyntax)
+			w.Indented(func() {
+				fmt.Fprintf(w, "%s.WriteBlock(impl.w, values, %s)\n", f.Yardl(), typeWriter(f, step.Type))
+				w.WriteStringln("return impl.w.Err()")
+			})
+			w.WriteString("}\n\n")
+
+			fmt.Fprintf(w, "func (impl *%s) %s() error {\n", implName, common.ProtocolWriteEndImplMethodName(step))
+			w.Indented(func() {
+				fmt.Fprintf(w, "%s.WriteEndOfStream(impl.w)\n", f.Yardl())
+				w.WriteStringln("return impl.w.Err()")
+			})
+			w.WriteString("}\n\n")
+			continue
+		}
+
+		fmt.Fprintf(w, "func (impl *%s) %s(value %s) error {\n", implName, common.ProtocolWriteImplMethodName(step), valueSyntax)
+		w.Indented(func() {
+			fmt.Fprintf(w, "%s(impl.w, value)\n", typeWriter(f, step.Type))
+			w.WriteStringln("return impl.w.Err()")
+		})
+		w.WriteString("}\n\n")
+	}
+
+	fmt.Fprintf(w, "func (impl *%s) CloseImpl() error {\n", implName)
+	w.Indented(func() {
+		w.WriteStringln("return impl.w.Flush()")
+	})
+	w.WriteString("}\n\n")
+}
+
+func writeProtocolReader(w *formatting.IndentedWriter, f *common.File, p *dsl.ProtocolDefinition) {
+	typesQualifier := f.TypesQualifier(p.Namespace)
+	readerName := binaryReaderName(p)
+	implName := binaryReaderImplName(p)
+
+	fmt.Fprintf(w, "// %s reads the %s protocol in binary format.\n", readerName, p.Name)
+	fmt.Fprintf(w, "type %s struct {\n", readerName)
+	w.Indented(func() {
+		fmt.Fprintf(w, "*%s%s\n", typesQualifier, common.AbstractReaderName(p))
+	})
+	w.WriteString("}\n\n")
+
+	fmt.Fprintf(w, "// New%s reads the header of the stream and returns an error if it\n", readerName)
+	fmt.Fprintf(w, "// is not a binary %s stream.\n", p.Name)
+	fmt.Fprintf(w, "func New%s(r %s.Reader) (*%s, error) {\n", readerName, f.Import("io"), readerName)
+	w.Indented(func() {
+		fmt.Fprintf(w, "impl := &%s{r: %s.NewBinaryReader(r)}\n", implName, f.Yardl())
+		fmt.Fprintf(w, "impl.r.ReadHeader(%s%s)\n", typesQualifier, common.SchemaConstantName(p))
+		w.WriteStringln("if err := impl.r.Err(); err != nil {")
+		w.Indented(func() {
+			w.WriteStringln("return nil, err")
+		})
+		w.WriteStringln("}")
+		for _, step := range p.Sequence {
+			if step.IsStream() {
+				fmt.Fprintf(w, "impl.%s = %s.NewStreamReader(%s)\n", streamReaderFieldName(step), f.Yardl(), typeReader(f, step.Type))
+			}
+		}
+		fmt.Fprintf(w, "return &%s{%sNew%s(impl)}, nil\n", readerName, typesQualifier, common.AbstractReaderName(p))
+	})
+	w.WriteString("}\n\n")
+
+	fmt.Fprintf(w, "type %s struct {\n", implName)
+	w.Indented(func() {
+		fmt.Fprintf(w, "r *%s.BinaryReader\n", f.Yardl())
+		for _, step := range p.Sequence {
+			if step.IsStream() {
+				fmt.Fprintf(w, "%s *%s.StreamReader[%s]\n", streamReaderFieldName(step), f.Yardl(), common.TypeSyntax(f, step.Type))
+			}
+		}
+	})
+	w.WriteString("}\n\n")
+
+	for _, step := range p.Sequence {
+		valueSyntax := common.TypeSyntax(f, step.Type)
+		if step.IsStream() {
+			fmt.Fprintf(w, "func (impl *%s) %s() (%s, bool, error) {\n", implName, common.ProtocolReadImplMethodName(step), valueSyntax)
+			w.Indented(func() {
+				fmt.Fprintf(w, "value, ok := impl.%s.Read(impl.r)\n", streamReaderFieldName(step))
+				w.WriteStringln("return value, ok, impl.r.Err()")
+			})
+			w.WriteString("}\n\n")
+			continue
+		}
+
+		fmt.Fprintf(w, "func (impl *%s) %s() (%s, error) {\n", implName, common.ProtocolReadImplMethodName(step), valueSyntax)
+		w.Indented(func() {
+			fmt.Fprintf(w, "value := %s(impl.r)\n", typeReader(f, step.Type))
+			w.WriteStringln("return value, impl.r.Err()")
+		})
+		w.WriteString("}\n\n")
+	}
+
+	fmt.Fprintf(w, "func (impl *%s) CloseImpl() error {\n", implName)
+	w.Indented(func() {
+		w.WriteStringln("return impl.r.Err()")
+	})
+	w.WriteString("}\n\n")
+}
@@ -0,0 +1,581 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+package common
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/microsoft/yardl/tooling/internal/formatting"
+	"github.com/microsoft/yardl/tooling/internal/iocommon"
+	"github.com/microsoft/yardl/tooling/pkg/dsl"
+	"github.com/microsoft/yardl/tooling/pkg/packaging"
+)
+
+const (
+	RuntimePackageName = "yardl"
+	BinaryPackageName  = "binary"
+
+	typeParameterConstraintAnnotationKey = "go.constraint"
+)
+
+var reservedNames = map[string]any{
+	"break":       nil,
+	"case":        nil,
+	"chan":        nil,
+	"const":       nil,
+	"continue":    nil,
+	"default":     nil,
+	"defer":       nil,
+	"else":        nil,
+	"fallthrough": nil,
+	"for":         nil,
+	"func":        nil,
+	"go":          nil,
+	"goto":        nil,
+	"if":          nil,
+	"import":      nil,
+	"interface":   nil,
+	"map":         nil,
+	"package":     nil,
+	"range":       nil,
+	"return":      nil,
+	"select":      nil,
+	"struct":      nil,
+	"switch":      nil,
+	"type":        nil,
+	"var":         nil,
+	// packages imported by generated code
+	"binary": nil,
+	"fmt":    nil,
+	"io":     nil,
+	"yardl":  nil,
+}
+
+// File accumulates the body of a generated Go source file and keeps track
+// of the packages that the body refers to, so that only those are imported.
+type File struct {
+	Options packaging.GoCodegenOptions
+
+	// The name in the package clause of the file
+	PackageName string
+
+	// The namespace whose types can be referenced without a package qualifier, if any
+	TypesNamespace string
+
+	// The namespace whose binary serializers can be referenced without a package qualifier, if any
+	BinaryNamespace string
+
+	imports map[string]string
+	body    bytes.Buffer
+}
+
+func NewTypesFile(options packaging.GoCodegenOptions, ns *dsl.Namespace) *File {
+	return &File{
+		Options:        options,
+		PackageName:    PackageName(ns.Name),
+		TypesNamespace: ns.Name,
+		imports:        make(map[string]string),
+	}
+}
+
+func NewBinaryFile(options packaging.GoCodegenOptions, ns *dsl.Namespace) *File {
+	return &File{
+		Options:         options,
+		PackageName:     BinaryPackageName,
+		BinaryNamespace: ns.Name,
+		imports:         make(map[string]string),
+	}
+}
+
+func (f *File) Writer() *formatting.IndentedWriter {
+	return formatting.NewIndentedWriter(&f.body, "\t")
+}
+
+// Namespace returns the namespace that the file's package was generated for.
+func (f *File) Namespace() string {
+	if f.TypesNamespace != "" {
+		return f.TypesNamespace
+	}
+
+	return f.BinaryNamespace
+}
+
+// Import records a dependency on a standard library package and returns its name.
+func (f *File) Import(importPath string) string {
+	return f.ImportAs(importPath, path.Base(importPath))
+}
+
+func (f *File) ImportAs(importPath string, name string) string {
+	f.imports[importPath] = name
+	return name
+}
+
+// Yardl returns the name of the yardl runtime package.
+func (f *File) Yardl() string {
+	return f.ImportAs(path.Join(f.Options.ImportPath, RuntimePackageName), RuntimePackageName)
+}
+
+// TypesQualifier returns the prefix needed to refer to types in the given namespace.
+func (f *File) TypesQualifier(namespace string) string {
+	if namespace == f.TypesNamespace {
+		return ""
+	}
+
+	return f.ImportAs(TypesImportPath(f.Options, namespace), PackageName(namespace)) + "."
+}
+
+// BinaryQualifier returns the prefix needed to refer to the binary serializers in the given namespace.
+func (f *File) BinaryQualifier(namespace string) string {
+	if namespace == f.BinaryNamespace {
+		return ""
+	}
+
+	return f.ImportAs(path.Join(TypesImportPath(f.Options, namespace), BinaryPackageName), PackageName(namespace)+BinaryPackageName) + "."
+}
+
+// Save formats the file and writes it to the given path.
+func (f *File) Save(filename string) error {
+	var b bytes.Buffer
+	w := formatting.NewIndentedWriter(&b, "\t")
+	WriteGeneratedFileHeader(w)
+	fmt.Fprintf(w, "package %s\n\n", f.PackageName)
+
+	// Standard library imports come first, separated from the others by a blank line
+	var stdImports, otherImports []string
+	for importPath := range f.imports {
+		if strings.Contains(strings.Split(importPath, "/")[0], ".") {
+			otherImports = append(otherImports, importPath)
+		} else {
+			stdImports = append(stdImports, importPath)
+		}
+	}
+	sort.Strings(stdImports)
+	sort.Strings(otherImports)
+
+	if len(f.imports) > 0 {
+		w.WriteStringln("import (")
+		w.Indented(func() {
+			for i, group := range [][]string{stdImports, otherImports} {
+				if i > 0 && len(stdImports) > 0 && len(otherImports) > 0 {
+					w.WriteStringln("")
+				}
+				for _, importPath := range group {
+					name := f.imports[importPath]
+					if name == path.Base(importPath) {
+						fmt.Fprintf(w, "%q\n", importPath)
+					} else {
+						fmt.Fprintf(w, "%s %q\n", name, importPath)
+					}
+				}
+			}
+		})
+		w.WriteStringln(")\n")
+	}
+
+	b.Write(f.body.Bytes())
+
+	formatted, err := format.Source(b.Bytes())
+	if err != nil {
+		// Write the unformatted source to help diagnose the problem
+		os.WriteFile(filename, b.Bytes(), 0644)
+		return fmt.Errorf("failed to format generated file '%s': %w", filename, err)
+	}
+
+	return iocommon.WriteFileIfNeeded(filename, formatted, 0644)
+}
+
+func PackageName(namespace string) string {
+	name := strings.ToLower(namespace)
+	if _, reserved := reservedNames[name]; !reserved {
+		return name
+	}
+
+	return name + "_"
+}
+
+func PackageDir(options packaging.GoCodegenOptions, namespace string) string {
+	return path.Join(options.OutputDir, PackageName(namespace))
+}
+
+func TypesImportPath(options packaging.GoCodegenOptions, namespace string) string {
+	return path.Join(options.ImportPath, PackageName(namespace))
+}
+
+func TypeIdentifierName(name string) string {
+	return formatting.ToPascalCase(name)
+}
+
+func FieldIdentifierName(name string) string {
+	return formatting.ToPascalCase(name)
+}
+
+func EnumValueIdentifierName(e *dsl.EnumDefinition, value *dsl.EnumValue) string {
+	return TypeIdentifierName(e.Name) + formatting.ToPascalCase(value.Symbol)
+}
+
+func TypeParameterName(p *dsl.GenericTypeParameter) string {
+	return TypeIdentifierName(p.Name)
+}
+
+func UnionCaseTypeName(unionName string, typeCase *dsl.TypeCase) string {
+	return unionName + formatting.ToPascalCase(typeCase.Tag)
+}
+
+func UnionMarkerMethodName(unionName string) string {
+	return "is" + unionName
+}
+
+// Returns the name given to an anonymous union type
+func UnionTypeName(gt *dsl.GeneralizedType) string {
+	if !gt.Cases.IsUnion() {
+		panic("Not a union")
+	}
+
+	cases := make([]string, 0, len(gt.Cases))
+	for _, typeCase := range gt.Cases {
+		if typeCase.Type == nil {
+			continue
+		}
+		cases = append(cases, formatting.ToPascalCase(typeCase.Tag))
+	}
+
+	return strings.Join(cases, "Or")
+}
+
+// Returns the open generic type parameters used within the node, in order of first use.
+func GetOpenGenericTypeParameters(node dsl.Node) []*dsl.GenericTypeParameter {
+	var res []*dsl.GenericTypeParameter
+	add := func(p *dsl.GenericTypeParameter) {
+		for _, existing := range res {
+			if p == existing {
+				return
+			}
+		}
+		res = append(res, p)
+	}
+
+	dsl.Visit(node, func(self dsl.Visitor, node dsl.Node) {
+		switch t := node.(type) {
+		case *dsl.GenericTypeParameter:
+			add(t)
+		case *dsl.SimpleType:
+			if p, ok := t.ResolvedDefinition.(*dsl.GenericTypeParameter); ok {
+				add(p)
+			}
+		}
+		self.VisitChildren(node)
+	})
+
+	return res
+}
+
+// Returns the type parameter list for a declaration, e.g. "[T1 any, T2 comparable]"
+func TypeParametersDeclaration(params []*dsl.GenericTypeParameter) string {
+	if len(params) == 0 {
+		return ""
+	}
+
+	decls := make([]string, len(params))
+	for i, p := range params {
+		constraint := "any"
+		if c, ok := p.Annotations[typeParameterConstraintAnnotationKey].(string); ok {
+			constraint = c
+		}
+		decls[i] = fmt.Sprintf("%s %s", TypeParameterName(p), constraint)
+	}
+
+	return fmt.Sprintf("[%s]", strings.Join(decls, ", "))
+}
+
+// Returns the type parameter list for a reference, e.g. "[T1, T2]"
+func TypeParametersReference(params []*dsl.GenericTypeParameter) string {
+	if len(params) == 0 {
+		return ""
+	}
+
+	names := make([]string, len(params))
+	for i, p := range params {
+		names[i] = TypeParameterName(p)
+	}
+
+	return fmt.Sprintf("[%s]", strings.Join(names, ", "))
+}
+
+// AnnotateGenerics determines which generic type parameters must be constrained
+// to be comparable because they are (directly or indirectly) used as map keys.
+func AnnotateGenerics(env *dsl.Environment) {
+	mark := func(t dsl.Type) bool {
+		st, ok := dsl.GetUnderlyingType(t).(*dsl.SimpleType)
+		if !ok {
+			return false
+		}
+		p, ok := st.ResolvedDefinition.(*dsl.GenericTypeParameter)
+		if !ok || p.Annotations[typeParameterConstraintAnnotationKey] != nil {
+			return false
+		}
+		if p.Annotations == nil {
+			p.Annotations = make(map[string]any)
+		}
+		p.Annotations[typeParameterConstraintAnnotationKey] = "comparable"
+		return true
+	}
+
+	for changed := true; changed; {
+		changed = false
+		dsl.Visit(env, func(self dsl.Visitor, node dsl.Node) {
+			switch t := node.(type) {
+			case *dsl.Map:
+				if mark(t.KeyType) {
+					changed = true
+				}
+			case *dsl.SimpleType:
+				meta := t.ResolvedDefinition.GetDefinitionMeta()
+				if len(meta.TypeArguments) > 0 {
+					genericDefinition := env.SymbolTable.GetGenericTypeDefinition(t.ResolvedDefinition)
+					for i, param := range genericDefinition.GetDefinitionMeta().TypeParameters {
+						if param.Annotations[typeParameterConstraintAnnotationKey] != nil && mark(meta.TypeArguments[i]) {
+							changed = true
+						}
+					}
+				}
+			}
+			self.VisitChildren(node)
+		})
+	}
+}
+
+func WriteComment(w *formatting.IndentedWriter, comment string) {
+	comment = strings.TrimSpace(comment)
+	if comment != "" {
+		w = formatting.NewIndentedWriter(w, "// ").Indent()
+		w.WriteStringln(comment)
+	}
+}
+
+func WriteGeneratedFileHeader(w *formatting.IndentedWriter) {
+	WriteComment(w, "Code generated by the \"yardl\" tool. DO NOT EDIT.")
+	w.WriteStringln("")
+}
+
+func AbstractWriterName(p *dsl.ProtocolDefinition) string {
+	return fmt.Sprintf("%sWriterBase", formatting.ToPascalCase(p.Name))
+}
+
+func AbstractReaderName(p *dsl.ProtocolDefinition) string {
+	return fmt.Sprintf("%sReaderBase", formatting.ToPascalCase(p.Name))
+}
+
+func WriterInterfaceName(p *dsl.ProtocolDefinition) string {
+	return fmt.Sprintf("%sWriter", formatting.ToPascalCase(p.Name))
+}
+
+func ReaderInterfaceName(p *dsl.ProtocolDefinition) string {
+	return fmt.Sprintf("%sReader", formatting.ToPascalCase(p.Name))
+}
+
+func WriterImplInterfaceName(p *dsl.ProtocolDefinition) string {
+	return fmt.Sprintf("%sWriterImpl", formatting.ToPascalCase(p.Name))
+}
+
+func ReaderImplInterfaceName(p *dsl.ProtocolDefinition) string {
+	return fmt.Sprintf("%sReaderImpl", formatting.ToPascalCase(p.Name))
+}
+
+func SchemaConstantName(p *dsl.ProtocolDefinition) string {
+	return fmt.Sprintf("%sSchema", formatting.ToPascalCase(p.Name))
+}
+
+func ProtocolWriteMethodName(s *dsl.ProtocolStep) string {
+	return fmt.Sprintf("Write%s", formatting.ToPascalCase(s.Name))
+}
+
+func ProtocolWriteImplMethodName(s *dsl.ProtocolStep) string {
+	return fmt.Sprintf("Write%sImpl", formatting.ToPascalCase(s.Name))
+}
+
+func ProtocolWriteEndMethodName(s *dsl.ProtocolStep) string {
+	return fmt.Sprintf("End%s", formatting.ToPascalCase(s.Name))
+}
+
+func ProtocolWriteEndImplMethodName(s *dsl.ProtocolStep) string {
+	return fmt.Sprintf("End%sImpl", formatting.ToPascalCase(s.Name))
+}
+
+func ProtocolReadMethodName(s *dsl.ProtocolStep) string {
+	return fmt.Sprintf("Read%s", formatting.ToPascalCase(s.Name))
+}
+
+func ProtocolReadImplMethodName(s *dsl.ProtocolStep) string {
+	return fmt.Sprintf("Read%sImpl", formatting.ToPascalCase(s.Name))
+}
+
+var TypeSyntaxWriter dsl.TypeSyntaxWriter[*File] = func(self dsl.TypeSyntaxWriter[*File], t dsl.Node, f *File) string {
+	switch t := t.(type) {
+	case dsl.PrimitiveDefinition:
+		switch t {
+		case dsl.Bool:
+			return "bool"
+		case dsl.Int8:
+			return "int8"
+		case dsl.Uint8:
+			return "uint8"
+		case dsl.Int16:
+			return "int16"
+		case dsl.Uint16:
+			return "uint16"
+		case dsl.Int32:
+			return "int32"
+		case dsl.Uint32:
+			return "uint32"
+		case dsl.Int64:
+			return "int64"
+		case dsl.Uint64, dsl.Size:
+			return "uint64"
+		case dsl.Float16, dsl.BFloat16, dsl.Float32:
+			return "float32"
+		case dsl.Float64:
+			return "float64"
+		case dsl.ComplexFloat32:
+			return "complex64"
+		case dsl.ComplexFloat64:
+			return "complex128"
+		case dsl.String:
+			return "string"
+		case dsl.Bytes:
+			return "[]byte"
+		case dsl.Uuid:
+			return f.Yardl() + ".Uuid"
+		case dsl.Date:
+			return f.Yardl() + ".Date"
+		case dsl.Time:
+			return f.Yardl() + ".Time"
+		case dsl.DateTime:
+			return f.Yardl() + ".DateTime"
+		case dsl.Duration:
+			return f.Yardl() + ".Duration"
+		default:
+			panic(fmt.Sprintf("primitive '%v' not recognized", t))
+		}
+	case *dsl.GenericTypeParameter:
+		return TypeParameterName(t)
+	case dsl.TypeDefinition:
+		meta := t.GetDefinitionMeta()
+		typeName := f.TypesQualifier(meta.Namespace) + TypeIdentifierName(meta.Name)
+		if len(meta.TypeParameters) == 0 {
+			return typeName
+		}
+
+		typeArguments := make([]string, len(meta.TypeParameters))
+		for i, typeParameter := range meta.TypeParameters {
+			if len(meta.TypeArguments) > 0 {
+				typeArguments[i] = self.ToSyntax(meta.TypeArguments[i], f)
+			} else {
+				typeArguments[i] = self.ToSyntax(typeParameter, f)
+			}
+		}
+
+		return fmt.Sprintf("%s[%s]", typeName, strings.Join(typeArguments, ", "))
+	case *dsl.SimpleType:
+		return self.ToSyntax(t.ResolvedDefinition, f)
+	case *dsl.GeneralizedType:
+		scalarString := func() string {
+			if t.Cases.IsSingle() {
+				return self.ToSyntax(t.Cases[0].Type, f)
+			}
+			if t.Cases.IsOptional() {
+				return "*" + self.ToSyntax(t.Cases[1].Type, f)
+			}
+
+			return f.TypesQualifier(f.Namespace()) + UnionTypeName(t) + TypeParametersReference(GetOpenGenericTypeParameters(t))
+		}()
+
+		switch d := t.Dimensionality.(type) {
+		case nil, *dsl.Stream:
+			return scalarString
+		case *dsl.Vector:
+			if d.Length != nil {
+				return fmt.Sprintf("[%d]%s", *d.Length, scalarString)
+			}
+			return "[]" + scalarString
+		case *dsl.Array:
+			if d.IsFixed() {
+				var dims strings.Builder
+				for _, dim := range *d.Dimensions {
+					fmt.Fprintf(&dims, "[%d]", *dim.Length)
+				}
+				return dims.String() + scalarString
+			}
+			return fmt.Sprintf("%s.NDArray[%s]", f.Yardl(), scalarString)
+		case *dsl.Map:
+			return fmt.Sprintf("map[%s]%s", self.ToSyntax(d.KeyType, f), scalarString)
+		default:
+			panic(fmt.Sprintf("unexpected type %T", d))
+		}
+	default:
+		panic(fmt.Sprintf("unexpected type %T", t))
+	}
+}
+
+func TypeSyntax(f *File, typeOrTypeDefinition dsl.Node) string {
+	return TypeSyntaxWriter.ToSyntax(typeOrTypeDefinition, f)
+}
+
+// UnionDeclaration describes a union type that is declared in the generated code.
+type UnionDeclaration struct {
+	Name           string
+	TypeParameters []*dsl.GenericTypeParameter
+	Type           *dsl.GeneralizedType
+
+	// Set when the union is the definition of a named type
+	NamedType *dsl.NamedType
+}
+
+// GetUnionDeclarations returns the unions used by a type definition or protocol.
+// Anonymous unions with the same cases may appear more than once.
+func GetUnionDeclarations(td dsl.TypeDefinition) []*UnionDeclaration {
+	var res []*UnionDeclaration
+	visitor := func(self dsl.Visitor, node dsl.Node) {
+		if gt, ok := node.(*dsl.GeneralizedType); ok && gt.Cases.IsUnion() {
+			u := &UnionDeclaration{Type: gt}
+			if nt, ok := td.(*dsl.NamedType); ok && nt.Type == gt && gt.Dimensionality == nil {
+				// This is a named type defining a union, so we use the named type's name
+				u.Name = TypeIdentifierName(nt.Name)
+				u.TypeParameters = nt.TypeParameters
+				u.NamedType = nt
+			} else {
+				u.Name = UnionTypeName(gt)
+				u.TypeParameters = GetOpenGenericTypeParameters(gt)
+			}
+
+			res = append(res, u)
+		}
+		self.VisitChildren(node)
+	}
+
+	switch td := td.(type) {
+	case *dsl.RecordDefinition:
+		for _, field := range td.Fields {
+			dsl.Visit(field.Type, visitor)
+		}
+	case *dsl.NamedType:
+		dsl.Visit(td.Type, visitor)
+	case *dsl.ProtocolDefinition:
+		for _, step := range td.Sequence {
+			dsl.Visit(step.Type, visitor)
+		}
+	}
+
+	return res
+}
+
+// Returns the syntax of the type of the given union case's struct
+func UnionCaseTypeSyntax(f *File, namespace string, unionName string, typeCase *dsl.TypeCase) string {
+	return f.TypesQualifier(namespace) + UnionCaseTypeName(unionName, typeCase) + TypeParametersReference(GetOpenGenericTypeParameters(typeCase.Type))
+}
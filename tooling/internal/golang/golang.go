@@ -0,0 +1,58 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+package golang
+
+import (
+	"embed"
+	"os"
+	"path"
+
+	"github.com/microsoft/yardl/tooling/internal/golang/binary"
+	"github.com/microsoft/yardl/tooling/internal/golang/common"
+	"github.com/microsoft/yardl/tooling/internal/golang/protocols"
+	"github.com/microsoft/yardl/tooling/internal/golang/types"
+	"github.com/microsoft/yardl/tooling/internal/iocommon"
+	"github.com/microsoft/yardl/tooling/pkg/dsl"
+	"github.com/microsoft/yardl/tooling/pkg/packaging"
+)
+
+//go:embed static_files/*.go
+var staticFiles embed.FS
+
+func Generate(env *dsl.Environment, options packaging.GoCodegenOptions) error {
+	common.AnnotateGenerics(env)
+
+	err := os.MkdirAll(options.OutputDir, 0775)
+	if err != nil {
+		return err
+	}
+
+	runtimeDir := path.Join(options.OutputDir, common.RuntimePackageName)
+	if err := iocommon.CopyEmbeddedStaticFiles(runtimeDir, options.InternalSymlinkStaticFiles, staticFiles); err != nil {
+		return err
+	}
+
+	for _, ns := range env.Namespaces {
+		packageDir := common.PackageDir(options, ns.Name)
+		if err := os.MkdirAll(path.Join(packageDir, common.BinaryPackageName), 0775); err != nil {
+			return err
+		}
+
+		if err := types.WriteTypes(ns, env.SymbolTable, options, packageDir); err != nil {
+			return err
+		}
+
+		if len(ns.Protocols) > 0 {
+			if err := protocols.WriteProtocols(ns, env.SymbolTable, options, packageDir); err != nil {
+				return err
+			}
+		}
+
+		if err := binary.WriteBinary(ns, options, path.Join(packageDir, common.BinaryPackageName)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,468 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+package protocols
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/microsoft/yardl/tooling/internal/formatting"
+	"github.com/microsoft/yardl/tooling/internal/golang/common"
+	"github.com/microsoft/yardl/tooling/pkg/dsl"
+	"github.com/microsoft/yardl/tooling/pkg/packaging"
+)
+
+func WriteProtocols(ns *dsl.Namespace, st dsl.SymbolTable, options packaging.GoCodegenOptions, packageDir string) error {
+	f := common.NewTypesFile(options, ns)
+	w := f.Writer()
+
+	for _, p := range ns.Protocols {
+		writeSchema(w, p, st)
+		writeStepNameFunc(w, p)
+		writeWriterInterfaces(w, f, p)
+		writeWriterBase(w, f, p)
+		writeReaderInterfaces(w, f, p)
+		writeReaderBase(w, f, p)
+	}
+
+	return f.Save(path.Join(packageDir, "protocols.go"))
+}
+
+func writeSchema(w *formatting.IndentedWriter, p *dsl.ProtocolDefinition, st dsl.SymbolTable) {
+	schema := dsl.GetProtocolSchemaString(p, st)
+	literal := strconv.Quote(schema)
+	if !strings.Contains(schema, "`") {
+		literal = "`" + schema + "`"
+	}
+
+	fmt.Fprintf(w, "// %s is the schema written to the header of binary %s streams.\n", common.SchemaConstantName(p), p.Name)
+	fmt.Fprintf(w, "const %s = %s\n\n", common.SchemaConstantName(p), literal)
+}
+
+func stepValueSyntax(f *common.File, step *dsl.ProtocolStep) string {
+	return common.TypeSyntax(f, step.Type)
+}
+
+// Writes the function that returns the name of the step at a given state,
+// which the writer and reader use to describe the expected call in errors.
+func writeStepNameFunc(w *formatting.IndentedWriter, p *dsl.ProtocolDefinition) {
+	fmt.Fprintf(w, "// %s returns the name of the step at the given state and whether it is a stream, or \"\" after the last step.\n", stepNameFuncName(p))
+	fmt.Fprintf(w, "func %s(state int) (name string, isStream bool) {\n", stepNameFuncName(p))
+	w.Indented(func() {
+		w.WriteStringln("switch state {")
+		for i, step := range p.Sequence {
+			fmt.Fprintf(w, "case %d:\n", i)
+			w.Indented(func() {
+				fmt.Fprintf(w, "return \"%s\", %t\n", formatting.ToPascalCase(step.Name), step.IsStream())
+			})
+		}
+		w.WriteStringln("default:")
+		w.Indented(func() {
+			w.WriteStringln("return \"\", false")
+		})
+		w.WriteStringln("}")
+	})
+	w.WriteString("}\n\n")
+}
+
+func stepNameFuncName(p *dsl.ProtocolDefinition) string {
+	return strings.ToLower(p.Name[:1]) + p.Name[1:] + "StepName"
+}
+
+func writeWriterInterfaces(w *formatting.IndentedWriter, f *common.File, p *dsl.ProtocolDefinition) {
+	common.WriteComment(w, p.Comment)
+	fmt.Fprintf(w, "type %s interface {\n", common.WriterInterfaceName(p))
+	w.Indented(func() {
+		for _, step := range p.Sequence {
+			common.WriteComment(w, step.Comment)
+			if step.IsStream() {
+				fmt.Fprintf(w, "%s(values ...%s) error\n", common.ProtocolWriteMethodName(step), stepValueSyntax(f, step))
+				fmt.Fprintf(w, "%s() error\n", common.ProtocolWriteEndMethodName(step))
+			} else {
+				fmt.Fprintf(w, "%s(value %s) error\n", common.ProtocolWriteMethodName(step), stepValueSyntax(f, step))
+			}
+		}
+		for _, sub := range p.SubProtocols {
+			common.WriteComment(w, sub.Comment)
+			fmt.Fprintf(w, "%s() %s\n", subProtocolAccessorName(sub), common.WriterInterfaceName(sub.Protocol))
+		}
+		w.WriteStringln("Close() error")
+	})
+	w.WriteString("}\n\n")
+
+	fmt.Fprintf(w, "// %s is implemented by concrete %s writers.\n", common.WriterImplInterfaceName(p), p.Name)
+	fmt.Fprintf(w, "// Its methods are called by %s after it has validated the order of calls.\n", common.AbstractWriterName(p))
+	fmt.Fprintf(w, "type %s interface {\n", common.WriterImplInterfaceName(p))
+	w.Indented(func() {
+		for _, step := range p.Sequence {
+			if step.IsStream() {
+				fmt.Fprintf(w, "%s(values []%s) error\n", common.ProtocolWriteImplMethodName(step), stepValueSyntax(f, step))
+				fmt.Fprintf(w, "%s() error\n", common.ProtocolWriteEndImplMethodName(step))
+			} else {
+				fmt.Fprintf(w, "%s(value %s) error\n", common.ProtocolWriteImplMethodName(step), stepValueSyntax(f, step))
+			}
+		}
+		w.WriteStringln("CloseImpl() error")
+	})
+	w.WriteString("}\n\n")
+}
+
+func writeWriterBase(w *formatting.IndentedWriter, f *common.File, p *dsl.ProtocolDefinition) {
+	baseName := common.AbstractWriterName(p)
+	fmt.Fprintf(w, "// %s implements %s, ensuring that the protocol steps are written in order.\n", baseName, common.WriterInterfaceName(p))
+	fmt.Fprintf(w, "type %s struct {\n", baseName)
+	w.Indented(func() {
+		fmt.Fprintf(w, "impl  %s\n", common.WriterImplInterfaceName(p))
+		w.WriteStringln("state int")
+		for _, sub := range p.SubProtocols {
+			fmt.Fprintf(w, "%s *%s\n", subWriterFieldName(sub), common.AbstractWriterName(sub.Protocol))
+		}
+	})
+	w.WriteString("}\n\n")
+
+	fmt.Fprintf(w, "func New%s(impl %s) *%s {\n", baseName, common.WriterImplInterfaceName(p), baseName)
+	w.Indented(func() {
+		fmt.Fprintf(w, "return &%s{impl: impl}\n", baseName)
+	})
+	w.WriteString("}\n\n")
+
+	for i, step := range p.Sequence {
+		if step.IsStream() {
+			fmt.Fprintf(w, "func (w *%s) %s(values ...%s) error {\n", baseName, common.ProtocolWriteMethodName(step), stepValueSyntax(f, step))
+			w.Indented(func() {
+				fmt.Fprintf(w, "if w.state != %d {\n", i)
+				w.Indented(func() {
+					fmt.Fprintf(w, "return w.invalidState(\"%s()\")\n", common.ProtocolWriteMethodName(step))
+				})
+				w.WriteStringln("}")
+				fmt.Fprintf(w, "return w.impl.%s(values)\n", common.ProtocolWriteImplMethodName(step))
+			})
+			w.WriteString("}\n\n")
+
+			fmt.Fprintf(w, "func (w *%s) %s() error {\n", baseName, common.ProtocolWriteEndMethodName(step))
+			w.Indented(func() {
+				fmt.Fprintf(w, "if w.state != %d {\n", i)
+				w.Indented(func() {
+					fmt.Fprintf(w, "return w.invalidState(\"%s()\")\n", common.ProtocolWriteEndMethodName(step))
+				})
+				w.WriteStringln("}")
+				fmt.Fprintf(w, "if err := w.impl.%s(); err != nil {\n", common.ProtocolWriteEndImplMethodName(step))
+				w.Indented(func() {
+					w.WriteStringln("return err")
+				})
+				w.WriteStringln("}")
+				fmt.Fprintf(w, "w.state = %d\n", i+1)
+				w.WriteStringln("return nil")
+			})
+			w.WriteString("}\n\n")
+			continue
+		}
+
+		fmt.Fprintf(w, "func (w *%s) %s(value %s) error {\n", baseName, common.ProtocolWriteMethodName(step), stepValueSyntax(f, step))
+		w.Indented(func() {
+			fmt.Fprintf(w, "if w.state != %d {\n", i)
+			w.Indented(func() {
+				fmt.Fprintf(w, "return w.invalidState(\"%s()\")\n", common.ProtocolWriteMethodName(step))
+			})
+			w.WriteStringln("}")
+			fmt.Fprintf(w, "if err := w.impl.%s(value); err != nil {\n", common.ProtocolWriteImplMethodName(step))
+			w.Indented(func() {
+				w.WriteStringln("return err")
+			})
+			w.WriteStringln("}")
+			fmt.Fprintf(w, "w.state = %d\n", i+1)
+			w.WriteStringln("return nil")
+		})
+		w.WriteString("}\n\n")
+	}
+
+	fmt.Fprintf(w, "func (w *%s) Close() error {\n", baseName)
+	w.Indented(func() {
+		fmt.Fprintf(w, "if w.state != %d {\n", len(p.Sequence))
+		w.Indented(func() {
+			w.WriteStringln("return w.invalidState(\"Close()\")")
+		})
+		w.WriteStringln("}")
+		w.WriteStringln("return w.impl.CloseImpl()")
+	})
+	w.WriteString("}\n\n")
+
+	for _, sub := range p.SubProtocols {
+		writeSubWriter(w, f, p, sub)
+	}
+
+	writeInvalidWriterStateMethod(w, f, p)
+}
+
+// Writes the accessor for a sub-protocol's writer and the implementation
+// that forwards each of its steps to the outer writer's inlined step.
+func writeSubWriter(w *formatting.IndentedWriter, f *common.File, p *dsl.ProtocolDefinition, sub *dsl.SubProtocol) {
+	baseName := common.AbstractWriterName(p)
+	implName := subWriterImplName(p, sub)
+	fieldName := subWriterFieldName(sub)
+
+	fmt.Fprintf(w, "// %s returns a writer for the %s steps, which are written through w.\n", subProtocolAccessorName(sub), sub.Name)
+	fmt.Fprintf(w, "func (w *%s) %s() %s {\n", baseName, subProtocolAccessorName(sub), common.WriterInterfaceName(sub.Protocol))
+	w.Indented(func() {
+		fmt.Fprintf(w, "if w.%s == nil {\n", fieldName)
+		w.Indented(func() {
+			fmt.Fprintf(w, "w.%s = New%s(&%s{outer: w})\n", fieldName, common.AbstractWriterName(sub.Protocol), implName)
+		})
+		w.WriteStringln("}")
+		fmt.Fprintf(w, "return w.%s\n", fieldName)
+	})
+	w.WriteString("}\n\n")
+
+	fmt.Fprintf(w, "type %s struct {\n", implName)
+	w.Indented(func() {
+		fmt.Fprintf(w, "outer *%s\n", baseName)
+	})
+	w.WriteString("}\n\n")
+
+	outerSteps := sub.Steps(p)
+	for i, step := range sub.Protocol.Sequence {
+		outerStep := outerSteps[i]
+		if step.IsStream() {
+			fmt.Fprintf(w, "func (impl *%s) %s(values []%s) error {\n", implName, common.ProtocolWriteImplMethodName(step), stepValueSyntax(f, step))
+			w.Indented(func() {
+				fmt.Fprintf(w, "return impl.outer.%s(values...)\n", common.ProtocolWriteMethodName(outerStep))
+			})
+			w.WriteString("}\n\n")
+
+			fmt.Fprintf(w, "func (impl *%s) %s() error {\n", implName, common.ProtocolWriteEndImplMethodName(step))
+			w.Indented(func() {
+				fmt.Fprintf(w, "return impl.outer.%s()\n", common.ProtocolWriteEndMethodName(outerStep))
+			})
+			w.WriteString("}\n\n")
+			continue
+		}
+
+		fmt.Fprintf(w, "func (impl *%s) %s(value %s) error {\n", implName, common.ProtocolWriteImplMethodName(step), stepValueSyntax(f, step))
+		w.Indented(func() {
+			fmt.Fprintf(w, "return impl.outer.%s(value)\n", common.ProtocolWriteMethodName(outerStep))
+		})
+		w.WriteString("}\n\n")
+	}
+
+	fmt.Fprintf(w, "func (impl *%s) CloseImpl() error {\n", implName)
+	w.Indented(func() {
+		w.WriteStringln("return nil")
+	})
+	w.WriteString("}\n\n")
+}
+
+func writeInvalidWriterStateMethod(w *formatting.IndentedWriter, f *common.File, p *dsl.ProtocolDefinition) {
+	fmt.Fprintf(w, "func (w *%s) invalidState(attempted string) error {\n", common.AbstractWriterName(p))
+	w.Indented(func() {
+		w.WriteStringln("expected := \"Close()\"")
+		fmt.Fprintf(w, "if name, isStream := %s(w.state); name != \"\" {\n", stepNameFuncName(p))
+		w.Indented(func() {
+			w.WriteStringln("expected = \"Write\" + name + \"()\"")
+			w.WriteStringln("if isStream {")
+			w.Indented(func() {
+				w.WriteStringln("expected += \" or End\" + name + \"()\"")
+			})
+			w.WriteStringln("}")
+		})
+		w.WriteStringln("}")
+		fmt.Fprintf(w, "return &%s.ProtocolError{Expected: expected, Received: attempted}\n", f.Yardl())
+	})
+	w.WriteString("}\n\n")
+}
+
+func writeReaderInterfaces(w *formatting.IndentedWriter, f *common.File, p *dsl.ProtocolDefinition) {
+	common.WriteComment(w, p.Comment)
+	fmt.Fprintf(w, "type %s interface {\n", common.ReaderInterfaceName(p))
+	w.Indented(func() {
+		for _, step := range p.Sequence {
+			common.WriteComment(w, step.Comment)
+			if step.IsStream() {
+				common.WriteComment(w, "Returns false when the stream has no more items.")
+				fmt.Fprintf(w, "%s() (%s, bool, error)\n", common.ProtocolReadMethodName(step), stepValueSyntax(f, step))
+			} else {
+				fmt.Fprintf(w, "%s() (%s, error)\n", common.ProtocolReadMethodName(step), stepValueSyntax(f, step))
+			}
+		}
+		for _, sub := range p.SubProtocols {
+			common.WriteComment(w, sub.Comment)
+			fmt.Fprintf(w, "%s() %s\n", subProtocolAccessorName(sub), common.ReaderInterfaceName(sub.Protocol))
+		}
+		w.WriteStringln("Close() error")
+	})
+	w.WriteString("}\n\n")
+
+	fmt.Fprintf(w, "// %s is implemented by concrete %s readers.\n", common.ReaderImplInterfaceName(p), p.Name)
+	fmt.Fprintf(w, "// Its methods are called by %s after it has validated the order of calls.\n", common.AbstractReaderName(p))
+	fmt.Fprintf(w, "type %s interface {\n", common.ReaderImplInterfaceName(p))
+	w.Indented(func() {
+		for _, step := range p.Sequence {
+			if step.IsStream() {
+				fmt.Fprintf(w, "%s() (%s, bool, error)\n", common.ProtocolReadImplMethodName(step), stepValueSyntax(f, step))
+			} else {
+				fmt.Fprintf(w, "%s() (%s, error)\n", common.ProtocolReadImplMethodName(step), stepValueSyntax(f, step))
+			}
+		}
+		w.WriteStringln("CloseImpl() error")
+	})
+	w.WriteString("}\n\n")
+}
+
+func writeReaderBase(w *formatting.IndentedWriter, f *common.File, p *dsl.ProtocolDefinition) {
+	baseName := common.AbstractReaderName(p)
+	fmt.Fprintf(w, "// %s implements %s, ensuring that the protocol steps are read in order.\n", baseName, common.ReaderInterfaceName(p))
+	fmt.Fprintf(w, "type %s struct {\n", baseName)
+	w.Indented(func() {
+		fmt.Fprintf(w, "impl  %s\n", common.ReaderImplInterfaceName(p))
+		w.WriteStringln("state int")
+		for _, sub := range p.SubProtocols {
+			fmt.Fprintf(w, "%s *%s\n", subReaderFieldName(sub), common.AbstractReaderName(sub.Protocol))
+		}
+	})
+	w.WriteString("}\n\n")
+
+	fmt.Fprintf(w, "func New%s(impl %s) *%s {\n", baseName, common.ReaderImplInterfaceName(p), baseName)
+	w.Indented(func() {
+		fmt.Fprintf(w, "return &%s{impl: impl}\n", baseName)
+	})
+	w.WriteString("}\n\n")
+
+	for i, step := range p.Sequence {
+		if step.IsStream() {
+			fmt.Fprintf(w, "func (r *%s) %s() (value %s, ok bool, err error) {\n", baseName, common.ProtocolReadMethodName(step), stepValueSyntax(f, step))
+			w.Indented(func() {
+				fmt.Fprintf(w, "if r.state != %d {\n", i)
+				w.Indented(func() {
+					fmt.Fprintf(w, "return value, false, r.invalidState(\"%s()\")\n", common.ProtocolReadMethodName(step))
+				})
+				w.WriteStringln("}")
+				fmt.Fprintf(w, "if value, ok, err = r.impl.%s(); err != nil || ok {\n", common.ProtocolReadImplMethodName(step))
+				w.Indented(func() {
+					w.WriteStringln("return")
+				})
+				w.WriteStringln("}")
+				fmt.Fprintf(w, "r.state = %d\n", i+1)
+				w.WriteStringln("return")
+			})
+			w.WriteString("}\n\n")
+			continue
+		}
+
+		fmt.Fprintf(w, "func (r *%s) %s() (value %s, err error) {\n", baseName, common.ProtocolReadMethodName(step), stepValueSyntax(f, step))
+		w.Indented(func() {
+			fmt.Fprintf(w, "if r.state != %d {\n", i)
+			w.Indented(func() {
+				fmt.Fprintf(w, "return value, r.invalidState(\"%s()\")\n", common.ProtocolReadMethodName(step))
+			})
+			w.WriteStringln("}")
+			fmt.Fprintf(w, "if value, err = r.impl.%s(); err != nil {\n", common.ProtocolReadImplMethodName(step))
+			w.Indented(func() {
+				w.WriteStringln("return")
+			})
+			w.WriteStringln("}")
+			fmt.Fprintf(w, "r.state = %d\n", i+1)
+			w.WriteStringln("return")
+		})
+		w.WriteString("}\n\n")
+	}
+
+	fmt.Fprintf(w, "func (r *%s) Close() error {\n", baseName)
+	w.Indented(func() {
+		fmt.Fprintf(w, "if r.state != %d {\n", len(p.Sequence))
+		w.Indented(func() {
+			w.WriteStringln("return r.invalidState(\"Close()\")")
+		})
+		w.WriteStringln("}")
+		w.WriteStringln("return r.impl.CloseImpl()")
+	})
+	w.WriteString("}\n\n")
+
+	for _, sub := range p.SubProtocols {
+		writeSubReader(w, f, p, sub)
+	}
+
+	writeInvalidReaderStateMethod(w, f, p)
+}
+
+// Writes the accessor for a sub-protocol's reader and the implementation
+// that forwards each of its steps to the outer reader's inlined step.
+func writeSubReader(w *formatting.IndentedWriter, f *common.File, p *dsl.ProtocolDefinition, sub *dsl.SubProtocol) {
+	baseName := common.AbstractReaderName(p)
+	implName := subReaderImplName(p, sub)
+	fieldName := subReaderFieldName(sub)
+
+	fmt.Fprintf(w, "// %s returns a reader for the %s steps, which are read through r.\n", subProtocolAccessorName(sub), sub.Name)
+	fmt.Fprintf(w, "func (r *%s) %s() %s {\n", baseName, subProtocolAccessorName(sub), common.ReaderInterfaceName(sub.Protocol))
+	w.Indented(func() {
+		fmt.Fprintf(w, "if r.%s == nil {\n", fieldName)
+		w.Indented(func() {
+			fmt.Fprintf(w, "r.%s = New%s(&%s{outer: r})\n", fieldName, common.AbstractReaderName(sub.Protocol), implName)
+		})
+		w.WriteStringln("}")
+		fmt.Fprintf(w, "return r.%s\n", fieldName)
+	})
+	w.WriteString("}\n\n")
+
+	fmt.Fprintf(w, "type %s struct {\n", implName)
+	w.Indented(func() {
+		fmt.Fprintf(w, "outer *%s\n", baseName)
+	})
+	w.WriteString("}\n\n")
+
+	outerSteps := sub.Steps(p)
+	for i, step := range sub.Protocol.Sequence {
+		if step.IsStream() {
+			fmt.Fprintf(w, "func (impl *%s) %s() (%s, bool, error) {\n", implName, common.ProtocolReadImplMethodName(step), stepValueSyntax(f, step))
+		} else {
+			fmt.Fprintf(w, "func (impl *%s) %s() (%s, error) {\n", implName, common.ProtocolReadImplMethodName(step), stepValueSyntax(f, step))
+		}
+		w.Indented(func() {
+			fmt.Fprintf(w, "return impl.outer.%s()\n", common.ProtocolReadMethodName(outerSteps[i]))
+		})
+		w.WriteString("}\n\n")
+	}
+
+	fmt.Fprintf(w, "func (impl *%s) CloseImpl() error {\n", implName)
+	w.Indented(func() {
+		w.WriteStringln("return nil")
+	})
+	w.WriteString("}\n\n")
+}
+
+func subProtocolAccessorName(sub *dsl.SubProtocol) string {
+	return formatting.ToPascalCase(sub.Name)
+}
+
+func subWriterFieldName(sub *dsl.SubProtocol) string {
+	return sub.Name + "SubWriter"
+}
+
+func subReaderFieldName(sub *dsl.SubProtocol) string {
+	return sub.Name + "SubReader"
+}
+
+func subWriterImplName(p *dsl.ProtocolDefinition, sub *dsl.SubProtocol) string {
+	name := fmt.Sprintf("%s%sSubWriter", p.Name, formatting.ToPascalCase(sub.Name))
+	return strings.ToLower(name[:1]) + name[1:]
+}
+
+func subReaderImplName(p *dsl.ProtocolDefinition, sub *dsl.SubProtocol) string {
+	name := fmt.Sprintf("%s%sSubReader", p.Name, formatting.ToPascalCase(sub.Name))
+	return strings.ToLower(name[:1]) + name[1:]
+}
+
+func writeInvalidReaderStateMethod(w *formatting.IndentedWriter, f *common.File, p *dsl.ProtocolDefinition) {
+	fmt.Fprintf(w, "func (r *%s) invalidState(attempted string) error {\n", common.AbstractReaderName(p))
+	w.Indented(func() {
+		w.WriteStringln("expected := \"Close()\"")
+		fmt.Fprintf(w, "if name, _ := %s(r.state); name != \"\" {\n", stepNameFuncName(p))
+		w.Indented(func() {
+			w.WriteStringln("expected = \"Read\" + name + \"()\"")
+		})
+		w.WriteStringln("}")
+		fmt.Fprintf(w, "return &%s.ProtocolError{Expected: expected, Received: attempted}\n", f.Yardl())
+	})
+	w.WriteString("}\n\n")
+}
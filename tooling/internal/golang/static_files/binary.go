@@ -0,0 +1,689 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+package yardl
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+const (
+	magicBytes                 = "yardl"
+	currentBinaryFormatVersion = 1
+)
+
+// BinaryWriter writes values in the yardl binary format.
+// Errors are sticky: after the first failure, all subsequent
+// writes are no-ops and the error is returned by Err.
+type BinaryWriter struct {
+	w   *bufio.Writer
+	err error
+}
+
+func NewBinaryWriter(w io.Writer) *BinaryWriter {
+	return &BinaryWriter{w: bufio.NewWriter(w)}
+}
+
+// Err returns the first error encountered by the writer.
+func (w *BinaryWriter) Err() error {
+	return w.err
+}
+
+// Fail records an error if one has not already been recorded.
+func (w *BinaryWriter) Fail(err error) {
+	if w.err == nil {
+		w.err = err
+	}
+}
+
+func (w *BinaryWriter) Flush() error {
+	if w.err == nil {
+		w.err = w.w.Flush()
+	}
+	return w.err
+}
+
+func (w *BinaryWriter) WriteBytes(b []byte) {
+	if w.err == nil {
+		_, w.err = w.w.Write(b)
+	}
+}
+
+func (w *BinaryWriter) WriteByte(b byte) error {
+	if w.err == nil {
+		w.err = w.w.WriteByte(b)
+	}
+	return w.err
+}
+
+func (w *BinaryWriter) WriteUvarint(v uint64) {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(buf[:], v)
+	w.WriteBytes(buf[:n])
+}
+
+func (w *BinaryWriter) WriteVarint(v int64) {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutVarint(buf[:], v)
+	w.WriteBytes(buf[:n])
+}
+
+// WriteHeader writes the magic bytes, format version, and protocol schema.
+func (w *BinaryWriter) WriteHeader(schema string) {
+	w.WriteBytes([]byte(magicBytes))
+	var buf [4]byte
+	binary.LittleEndian.PutUint32(buf[:], currentBinaryFormatVersion)
+	w.WriteBytes(buf[:])
+	WriteString(w, schema)
+}
+
+// BinaryReader reads values in the yardl binary format.
+// Errors are sticky: after the first failure, all subsequent
+// reads return zero values and the error is returned by Err.
+type BinaryReader struct {
+	r   *bufio.Reader
+	err error
+}
+
+func NewBinaryReader(r io.Reader) *BinaryReader {
+	return &BinaryReader{r: bufio.NewReader(r)}
+}
+
+// Err returns the first error encountered by the reader.
+func (r *BinaryReader) Err() error {
+	return r.err
+}
+
+// Fail records an error if one has not already been recorded.
+func (r *BinaryReader) Fail(err error) {
+	if r.err == nil {
+		r.err = err
+	}
+}
+
+func (r *BinaryReader) fail(err error) {
+	if errors.Is(err, io.EOF) {
+		err = io.ErrUnexpectedEOF
+	}
+	r.Fail(err)
+}
+
+func (r *BinaryReader) ReadBytes(b []byte) {
+	if r.err != nil {
+		clear(b)
+		return
+	}
+	if _, err := io.ReadFull(r.r, b); err != nil {
+		r.fail(err)
+	}
+}
+
+func (r *BinaryReader) ReadByte() (byte, error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+	b, err := r.r.ReadByte()
+	if err != nil {
+		r.fail(err)
+	}
+	return b, r.err
+}
+
+func (r *BinaryReader) ReadUvarint() uint64 {
+	if r.err != nil {
+		return 0
+	}
+	v, err := binary.ReadUvarint(r.r)
+	if err != nil {
+		r.fail(err)
+	}
+	return v
+}
+
+func (r *BinaryReader) ReadVarint() int64 {
+	if r.err != nil {
+		return 0
+	}
+	v, err := binary.ReadVarint(r.r)
+	if err != nil {
+		r.fail(err)
+	}
+	return v
+}
+
+// ReadHeader reads the magic bytes, format version, and protocol schema.
+// If expectedSchema is not empty, the schema in the stream must match it.
+func (r *BinaryReader) ReadHeader(expectedSchema string) string {
+	magic := make([]byte, len(magicBytes))
+	r.ReadBytes(magic)
+	if r.err != nil {
+		return ""
+	}
+	if string(magic) != magicBytes {
+		r.Fail(errors.New("invalid magic bytes"))
+		return ""
+	}
+
+	var buf [4]byte
+	r.ReadBytes(buf[:])
+	if version := binary.LittleEndian.Uint32(buf[:]); r.err == nil && version != currentBinaryFormatVersion {
+		r.Fail(fmt.Errorf("unsupported binary format version %d", version))
+		return ""
+	}
+
+	schema := ReadString(r)
+	if r.err == nil && expectedSchema != "" && schema != expectedSchema {
+		r.Fail(errors.New("the schema in the stream does not match the expected schema"))
+	}
+
+	return schema
+}
+
+func WriteBool(w *BinaryWriter, value bool) {
+	if value {
+		w.WriteByte(1)
+	} else {
+		w.WriteByte(0)
+	}
+}
+
+func ReadBool(r *BinaryReader) bool {
+	b, _ := r.ReadByte()
+	return b != 0
+}
+
+func WriteInt8(w *BinaryWriter, value int8) {
+	w.WriteByte(byte(value))
+}
+
+func ReadInt8(r *BinaryReader) int8 {
+	b, _ := r.ReadByte()
+	return int8(b)
+}
+
+func WriteUint8(w *BinaryWriter, value uint8) {
+	w.WriteByte(value)
+}
+
+func ReadUint8(r *BinaryReader) uint8 {
+	b, _ := r.ReadByte()
+	return b
+}
+
+func WriteInt16(w *BinaryWriter, value int16) {
+	w.WriteVarint(int64(value))
+}
+
+func ReadInt16(r *BinaryReader) int16 {
+	v := r.ReadVarint()
+	if v < math.MinInt16 || v > math.MaxInt16 {
+		r.Fail(fmt.Errorf("value %d out of range for int16", v))
+	}
+	return int16(v)
+}
+
+func WriteUint16(w *BinaryWriter, value uint16) {
+	w.WriteUvarint(uint64(value))
+}
+
+func ReadUint16(r *BinaryReader) uint16 {
+	v := r.ReadUvarint()
+	if v > math.MaxUint16 {
+		r.Fail(fmt.Errorf("value %d out of range for uint16", v))
+	}
+	return uint16(v)
+}
+
+func WriteInt32(w *BinaryWriter, value int32) {
+	w.WriteVarint(int64(value))
+}
+
+func ReadInt32(r *BinaryReader) int32 {
+	v := r.ReadVarint()
+	if v < math.MinInt32 || v > math.MaxInt32 {
+		r.Fail(fmt.Errorf("value %d out of range for int32", v))
+	}
+	return int32(v)
+}
+
+func WriteUint32(w *BinaryWriter, value uint32) {
+	w.WriteUvarint(uint64(value))
+}
+
+func ReadUint32(r *BinaryReader) uint32 {
+	v := r.ReadUvarint()
+	if v > math.MaxUint32 {
+		r.Fail(fmt.Errorf("value %d out of range for uint32", v))
+	}
+	return uint32(v)
+}
+
+func WriteInt64(w *BinaryWriter, value int64) {
+	w.WriteVarint(value)
+}
+
+func ReadInt64(r *BinaryReader) int64 {
+	return r.ReadVarint()
+}
+
+func WriteUint64(w *BinaryWriter, value uint64) {
+	w.WriteUvarint(value)
+}
+
+func ReadUint64(r *BinaryReader) uint64 {
+	return r.ReadUvarint()
+}
+
+// Go has no half-precision floating-point types, so float16 and bfloat16
+// values are held as float32 and are rounded to the nearest representable
+// value, with ties to even, when they are written.
+
+func WriteFloat16(w *BinaryWriter, value float32) {
+	var buf [2]byte
+	binary.LittleEndian.PutUint16(buf[:], float32ToFloat16(value))
+	w.WriteBytes(buf[:])
+}
+
+func ReadFloat16(r *BinaryReader) float32 {
+	var buf [2]byte
+	r.ReadBytes(buf[:])
+	return float16ToFloat32(binary.LittleEndian.Uint16(buf[:]))
+}
+
+func WriteBFloat16(w *BinaryWriter, value float32) {
+	var buf [2]byte
+	binary.LittleEndian.PutUint16(buf[:], float32ToBFloat16(value))
+	w.WriteBytes(buf[:])
+}
+
+func ReadBFloat16(r *BinaryReader) float32 {
+	var buf [2]byte
+	r.ReadBytes(buf[:])
+	return math.Float32frombits(uint32(binary.LittleEndian.Uint16(buf[:])) << 16)
+}
+
+func float32ToFloat16(f float32) uint16 {
+	bits := math.Float32bits(f)
+	sign := uint16(bits>>16) & 0x8000
+	exponent := int32(bits>>23) & 0xff
+	mantissa := bits & 0x7fffff
+
+	if exponent == 0xff {
+		if mantissa != 0 {
+			return sign | 0x7e00
+		}
+		return sign | 0x7c00
+	}
+
+	e := exponent - 127 + 15
+	if e >= 0x1f {
+		return sign | 0x7c00
+	}
+
+	if e <= 0 {
+		if e < -10 {
+			return sign
+		}
+		return sign | uint16(roundShift(mantissa|0x800000, uint32(14-e)))
+	}
+
+	return sign | uint16(uint32(e)<<10+roundShift(mantissa, 13))
+}
+
+func float16ToFloat32(h uint16) float32 {
+	sign := uint32(h&0x8000) << 16
+	exponent := uint32(h>>10) & 0x1f
+	mantissa := uint32(h & 0x3ff)
+
+	switch exponent {
+	case 0:
+		if mantissa == 0 {
+			return math.Float32frombits(sign)
+		}
+		e := uint32(127 - 15 + 1)
+		for mantissa&0x400 == 0 {
+			mantissa <<= 1
+			e--
+		}
+		return math.Float32frombits(sign | e<<23 | (mantissa&0x3ff)<<13)
+	case 0x1f:
+		return math.Float32frombits(sign | 0xff<<23 | mantissa<<13)
+	default:
+		return math.Float32frombits(sign | (exponent+127-15)<<23 | mantissa<<13)
+	}
+}
+
+func float32ToBFloat16(f float32) uint16 {
+	bits := math.Float32bits(f)
+	if bits&0x7fffffff > 0x7f800000 {
+		return uint16(bits>>16) | 0x40
+	}
+	return uint16(roundShift(bits, 16))
+}
+
+// Shifts v right, rounding to the nearest value with ties to even.
+func roundShift(v uint32, shift uint32) uint32 {
+	result := v >> shift
+	remainder := v & (1<<shift - 1)
+	half := uint32(1) << (shift - 1)
+	if remainder > half || (remainder == half && result&1 == 1) {
+		result++
+	}
+	return result
+}
+
+func WriteFloat32(w *BinaryWriter, value float32) {
+	var buf [4]byte
+	binary.LittleEndian.PutUint32(buf[:], math.Float32bits(value))
+	w.WriteBytes(buf[:])
+}
+
+func ReadFloat32(r *BinaryReader) float32 {
+	var buf [4]byte
+	r.ReadBytes(buf[:])
+	return math.Float32frombits(binary.LittleEndian.Uint32(buf[:]))
+}
+
+func WriteFloat64(w *BinaryWriter, value float64) {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], math.Float64bits(value))
+	w.WriteBytes(buf[:])
+}
+
+func ReadFloat64(r *BinaryReader) float64 {
+	var buf [8]byte
+	r.ReadBytes(buf[:])
+	return math.Float64frombits(binary.LittleEndian.Uint64(buf[:]))
+}
+
+func WriteComplex64(w *BinaryWriter, value complex64) {
+	WriteFloat32(w, real(value))
+	WriteFloat32(w, imag(value))
+}
+
+func ReadComplex64(r *BinaryReader) complex64 {
+	re := ReadFloat32(r)
+	im := ReadFloat32(r)
+	return complex(re, im)
+}
+
+func WriteComplex128(w *BinaryWriter, value complex128) {
+	WriteFloat64(w, real(value))
+	WriteFloat64(w, imag(value))
+}
+
+func ReadComplex128(r *BinaryReader) complex128 {
+	re := ReadFloat64(r)
+	im := ReadFloat64(r)
+	return complex(re, im)
+}
+
+func WriteString(w *BinaryWriter, value string) {
+	w.WriteUvarint(uint64(len(value)))
+	w.WriteBytes([]byte(value))
+}
+
+func ReadString(r *BinaryReader) string {
+	length := r.ReadUvarint()
+	if r.err != nil {
+		return ""
+	}
+	b := make([]byte, length)
+	r.ReadBytes(b)
+	return string(b)
+}
+
+func WriteBytes(w *BinaryWriter, value []byte) {
+	w.WriteUvarint(uint64(len(value)))
+	w.WriteBytes(value)
+}
+
+func ReadBytes(r *BinaryReader) []byte {
+	length := r.ReadUvarint()
+	if r.err != nil {
+		return nil
+	}
+	b := make([]byte, length)
+	r.ReadBytes(b)
+	return b
+}
+
+func WriteUuid(w *BinaryWriter, value Uuid) {
+	w.WriteBytes(value[:])
+}
+
+func ReadUuid(r *BinaryReader) Uuid {
+	var u Uuid
+	r.ReadBytes(u[:])
+	return u
+}
+
+func WriteDate(w *BinaryWriter, value Date) {
+	w.WriteVarint(int64(value))
+}
+
+func ReadDate(r *BinaryReader) Date {
+	return Date(r.ReadVarint())
+}
+
+func WriteTime(w *BinaryWriter, value Time) {
+	w.WriteVarint(int64(value))
+}
+
+func ReadTime(r *BinaryReader) Time {
+	return Time(r.ReadVarint())
+}
+
+func WriteDateTime(w *BinaryWriter, value DateTime) {
+	w.WriteVarint(int64(value))
+}
+
+func ReadDateTime(r *BinaryReader) DateTime {
+	return DateTime(r.ReadVarint())
+}
+
+func WriteDuration(w *BinaryWriter, value Duration) {
+	w.WriteVarint(int64(value))
+}
+
+func ReadDuration(r *BinaryReader) Duration {
+	return Duration(r.ReadVarint())
+}
+
+// OptionalWriter returns a function that writes a nil pointer as the
+// null case of a [null, T] union, and a non-nil pointer as the T case.
+func OptionalWriter[T any](writeElement func(*BinaryWriter, T)) func(*BinaryWriter, *T) {
+	return func(w *BinaryWriter, value *T) {
+		if value == nil {
+			w.WriteUvarint(0)
+			return
+		}
+		w.WriteUvarint(1)
+		writeElement(w, *value)
+	}
+}
+
+func OptionalReader[T any](readElement func(*BinaryReader) T) func(*BinaryReader) *T {
+	return func(r *BinaryReader) *T {
+		switch index := r.ReadUvarint(); index {
+		case 0:
+			return nil
+		case 1:
+			value := readElement(r)
+			return &value
+		default:
+			r.Fail(fmt.Errorf("unexpected optional index %d", index))
+			return nil
+		}
+	}
+}
+
+func VectorWriter[T any](writeElement func(*BinaryWriter, T)) func(*BinaryWriter, []T) {
+	return func(w *BinaryWriter, value []T) {
+		w.WriteUvarint(uint64(len(value)))
+		WriteFixedVector(w, value, writeElement)
+	}
+}
+
+func VectorReader[T any](readElement func(*BinaryReader) T) func(*BinaryReader) []T {
+	return func(r *BinaryReader) []T {
+		length := r.ReadUvarint()
+		if r.err != nil {
+			return nil
+		}
+		value := make([]T, length)
+		ReadFixedVector(r, value, readElement)
+		return value
+	}
+}
+
+// WriteFixedVector writes the elements of value without a length prefix.
+func WriteFixedVector[T any](w *BinaryWriter, value []T, writeElement func(*BinaryWriter, T)) {
+	for _, v := range value {
+		writeElement(w, v)
+	}
+}
+
+// ReadFixedVector reads len(value) elements into value.
+func ReadFixedVector[T any](r *BinaryReader, value []T, readElement func(*BinaryReader) T) {
+	for i := range value {
+		value[i] = readElement(r)
+		if r.err != nil {
+			return
+		}
+	}
+}
+
+func MapWriter[K comparable, V any](writeKey func(*BinaryWriter, K), writeValue func(*BinaryWriter, V)) func(*BinaryWriter, map[K]V) {
+	return func(w *BinaryWriter, value map[K]V) {
+		w.WriteUvarint(uint64(len(value)))
+		for k, v := range value {
+			writeKey(w, k)
+			writeValue(w, v)
+		}
+	}
+}
+
+func MapReader[K comparable, V any](readKey func(*BinaryReader) K, readValue func(*BinaryReader) V) func(*BinaryReader) map[K]V {
+	return func(r *BinaryReader) map[K]V {
+		length := r.ReadUvarint()
+		if r.err != nil {
+			return nil
+		}
+		value := make(map[K]V, length)
+		for i := uint64(0); i < length; i++ {
+			k := readKey(r)
+			v := readValue(r)
+			if r.err != nil {
+				return value
+			}
+			value[k] = v
+		}
+		return value
+	}
+}
+
+// NDArrayWriter returns a function that writes arrays with a fixed number of
+// dimensions, which is not included in the output.
+func NDArrayWriter[T any](writeElement func(*BinaryWriter, T), rank int) func(*BinaryWriter, NDArray[T]) {
+	return func(w *BinaryWriter, value NDArray[T]) {
+		if value.Shape == nil && value.Data == nil {
+			value.Shape = make([]int, rank)
+		}
+		if len(value.Shape) != rank {
+			w.Fail(fmt.Errorf("expected an array with %d dimensions but it has %d", rank, len(value.Shape)))
+			return
+		}
+		writeNDArrayBody(w, value, writeElement)
+	}
+}
+
+func NDArrayReader[T any](readElement func(*BinaryReader) T, rank int) func(*BinaryReader) NDArray[T] {
+	return func(r *BinaryReader) NDArray[T] {
+		return readNDArrayBody(r, rank, readElement)
+	}
+}
+
+// DynamicNDArrayWriter returns a function that writes arrays with any number
+// of dimensions, prefixing the dimensions with their count.
+func DynamicNDArrayWriter[T any](writeElement func(*BinaryWriter, T)) func(*BinaryWriter, NDArray[T]) {
+	return func(w *BinaryWriter, value NDArray[T]) {
+		if value.Shape == nil && value.Data == nil {
+			value.Shape = []int{0}
+		}
+		w.WriteUvarint(uint64(len(value.Shape)))
+		writeNDArrayBody(w, value, writeElement)
+	}
+}
+
+func DynamicNDArrayReader[T any](readElement func(*BinaryReader) T) func(*BinaryReader) NDArray[T] {
+	return func(r *BinaryReader) NDArray[T] {
+		rank := r.ReadUvarint()
+		return readNDArrayBody(r, int(rank), readElement)
+	}
+}
+
+func writeNDArrayBody[T any](w *BinaryWriter, value NDArray[T], writeElement func(*BinaryWriter, T)) {
+	if len(value.Data) != value.Len() {
+		w.Fail(fmt.Errorf("array data length %d does not match its shape %v", len(value.Data), value.Shape))
+		return
+	}
+	for _, dim := range value.Shape {
+		w.WriteUvarint(uint64(dim))
+	}
+	WriteFixedVector(w, value.Data, writeElement)
+}
+
+func readNDArrayBody[T any](r *BinaryReader, rank int, readElement func(*BinaryReader) T) NDArray[T] {
+	shape := make([]int, rank)
+	for i := range shape {
+		shape[i] = int(r.ReadUvarint())
+	}
+	if r.err != nil {
+		return NDArray[T]{}
+	}
+	value := NewNDArray[T](shape...)
+	ReadFixedVector(r, value.Data, readElement)
+	return value
+}
+
+// WriteBlock writes a non-empty block of stream items prefixed with its length.
+func WriteBlock[T any](w *BinaryWriter, values []T, writeElement func(*BinaryWriter, T)) {
+	if len(values) == 0 {
+		return
+	}
+	w.WriteUvarint(uint64(len(values)))
+	WriteFixedVector(w, values, writeElement)
+}
+
+// WriteEndOfStream writes the empty block that terminates a stream.
+func WriteEndOfStream(w *BinaryWriter) {
+	w.WriteUvarint(0)
+}
+
+// StreamReader keeps track of the position within the blocks of a stream.
+type StreamReader[T any] struct {
+	readElement func(*BinaryReader) T
+	remaining   uint64
+}
+
+func NewStreamReader[T any](readElement func(*BinaryReader) T) *StreamReader[T] {
+	return &StreamReader[T]{readElement: readElement}
+}
+
+// Read returns the next item of the stream, or false if the stream has ended.
+func (s *StreamReader[T]) Read(r *BinaryReader) (value T, ok bool) {
+	if s.remaining == 0 {
+		s.remaining = r.ReadUvarint()
+		if s.remaining == 0 || r.err != nil {
+			return value, false
+		}
+	}
+	s.remaining--
+	return s.readElement(r), r.err == nil
+}
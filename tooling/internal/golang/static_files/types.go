@@ -0,0 +1,160 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+package yardl
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// NDArray is a multidimensional array stored in row-major order.
+// The zero value is an empty array.
+type NDArray[T any] struct {
+	Shape []int
+	Data  []T
+}
+
+// NewNDArray returns a zero-filled array with the given shape.
+func NewNDArray[T any](shape ...int) NDArray[T] {
+	a := NDArray[T]{Shape: shape}
+	a.Data = make([]T, a.Len())
+	return a
+}
+
+// Len returns the total number of elements described by the array's shape.
+func (a NDArray[T]) Len() int {
+	n := 1
+	for _, dim := range a.Shape {
+		n *= dim
+	}
+	return n
+}
+
+// At returns the element at the given indices.
+func (a NDArray[T]) At(indices ...int) T {
+	return a.Data[a.offset(indices)]
+}
+
+// Set assigns the element at the given indices.
+func (a NDArray[T]) Set(value T, indices ...int) {
+	a.Data[a.offset(indices)] = value
+}
+
+func (a NDArray[T]) offset(indices []int) int {
+	if len(indices) != len(a.Shape) {
+		panic(fmt.Sprintf("expected %d indices but got %d", len(a.Shape), len(indices)))
+	}
+	offset := 0
+	for i, index := range indices {
+		if index < 0 || index >= a.Shape[i] {
+			panic(fmt.Sprintf("index %d out of range for dimension %d of length %d", index, i, a.Shape[i]))
+		}
+		offset = offset*a.Shape[i] + index
+	}
+	return offset
+}
+
+// Uuid is a UUID, with its bytes in the order of its canonical string form.
+type Uuid [16]byte
+
+// ParseUuid parses a UUID in its canonical form, e.g.
+// "123e4567-e89b-12d3-a456-426614174000".
+func ParseUuid(s string) (Uuid, error) {
+	var u Uuid
+	if len(s) != 36 || s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+		return u, fmt.Errorf("invalid UUID %q", s)
+	}
+	digits := s[0:8] + s[9:13] + s[14:18] + s[19:23] + s[24:36]
+	if _, err := hex.Decode(u[:], []byte(digits)); err != nil {
+		return u, fmt.Errorf("invalid UUID %q", s)
+	}
+	return u, nil
+}
+
+func (u Uuid) String() string {
+	return fmt.Sprintf("%x-%x-%x-%x-%x", u[0:4], u[4:6], u[6:8], u[8:10], u[10:16])
+}
+
+// Date is the number of days since the Unix epoch.
+type Date int64
+
+func DateFromTime(t time.Time) Date {
+	y, m, d := t.Date()
+	return Date(time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Unix() / (24 * 60 * 60))
+}
+
+func (d Date) ToTime() time.Time {
+	return time.Unix(int64(d)*24*60*60, 0).UTC()
+}
+
+func (d Date) String() string {
+	return d.ToTime().Format(time.DateOnly)
+}
+
+// Time is the number of nanoseconds since midnight.
+type Time int64
+
+func NewTime(hour, minute, second, nanosecond int) Time {
+	return Time(((int64(hour)*60+int64(minute))*60+int64(second))*int64(time.Second) + int64(nanosecond))
+}
+
+func (t Time) String() string {
+	return time.Unix(0, int64(t)).UTC().Format("15:04:05.999999999")
+}
+
+// DateTime is the number of nanoseconds since the Unix epoch.
+type DateTime int64
+
+func DateTimeFromTime(t time.Time) DateTime {
+	return DateTime(t.UnixNano())
+}
+
+func (dt DateTime) ToTime() time.Time {
+	return time.Unix(0, int64(dt)).UTC()
+}
+
+func (dt DateTime) String() string {
+	return dt.ToTime().Format(time.RFC3339Nano)
+}
+
+// Duration is a signed number of nanoseconds.
+type Duration = time.Duration
+
+// ProtocolError is returned when the methods of a protocol reader or
+// writer are called in an order that does not match the protocol sequence.
+type ProtocolError struct {
+	Expected string
+	Received string
+}
+
+func (e *ProtocolError) Error() string {
+	return fmt.Sprintf("Expected call to %s but received call to %s instead.", e.Expected, e.Received)
+}
+
+// Integer is the set of types that can be used as the base type of an enum or flags.
+type Integer interface {
+	~int8 | ~int16 | ~int32 | ~int64 | ~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+// FormatFlags returns the names of the flags set in value, separated by " | ".
+// Bits that do not correspond to a named flag are included as a hexadecimal number.
+func FormatFlags[T Integer](value T, values []T, names []string) string {
+	var parts []string
+	remaining := value
+	for i, v := range values {
+		if v == value {
+			return names[i]
+		}
+		if v != 0 && remaining&v == v {
+			parts = append(parts, names[i])
+			remaining &^= v
+		}
+	}
+	if remaining != 0 || len(parts) == 0 {
+		parts = append(parts, fmt.Sprintf("%#x", uint64(remaining)))
+	}
+	return strings.Join(parts, " | ")
+}
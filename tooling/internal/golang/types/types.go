@@ -0,0 +1,273 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+package types
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/microsoft/yardl/tooling/internal/formatting"
+	"github.com/microsoft/yardl/tooling/internal/golang/common"
+	"github.com/microsoft/yardl/tooling/pkg/dsl"
+	"github.com/microsoft/yardl/tooling/pkg/packaging"
+)
+
+func WriteTypes(ns *dsl.Namespace, symbolTable dsl.SymbolTable, options packaging.GoCodegenOptions, packageDir string) error {
+	f := common.NewTypesFile(options, ns)
+	w := f.Writer()
+
+	unions := make(map[string]bool)
+	for _, td := range ns.TypeDefinitions {
+		for _, u := range common.GetUnionDeclarations(td) {
+			if !unions[u.Name] {
+				unions[u.Name] = true
+				writeUnion(w, f, u)
+			}
+		}
+
+		switch td := td.(type) {
+		case *dsl.EnumDefinition:
+			writeEnum(w, f, td)
+		case *dsl.RecordDefinition:
+			writeRecord(w, f, td)
+			if dsl.TypeHasConstraints(&dsl.SimpleType{ResolvedDefinition: td}, symbolTable) {
+				writeValidateMethod(w, f, td, symbolTable)
+			}
+		case *dsl.NamedType:
+			if gt, ok := td.Type.(*dsl.GeneralizedType); !ok || !gt.Cases.IsUnion() || gt.Dimensionality != nil {
+				writeNamedType(w, f, td)
+			}
+		default:
+			panic(fmt.Sprintf("unsupported type definition: %T", td))
+		}
+	}
+
+	for _, p := range ns.Protocols {
+		for _, u := range common.GetUnionDeclarations(p) {
+			if !unions[u.Name] {
+				unions[u.Name] = true
+				writeUnion(w, f, u)
+			}
+		}
+	}
+
+	return f.Save(path.Join(packageDir, "types.go"))
+}
+
+func writeUnion(w *formatting.IndentedWriter, f *common.File, u *common.UnionDeclaration) {
+	if u.NamedType != nil {
+		common.WriteComment(w, u.NamedType.Comment)
+	}
+	fmt.Fprintf(w, "type %s%s interface {\n", u.Name, common.TypeParametersDeclaration(u.TypeParameters))
+	w.Indented(func() {
+		fmt.Fprintf(w, "%s()\n", common.UnionMarkerMethodName(u.Name))
+	})
+	w.WriteString("}\n\n")
+
+	for _, typeCase := range u.Type.Cases {
+		if typeCase.Type == nil {
+			continue
+		}
+
+		caseTypeName := common.UnionCaseTypeName(u.Name, typeCase)
+		caseTypeParameters := common.GetOpenGenericTypeParameters(typeCase.Type)
+		fmt.Fprintf(w, "type %s%s struct {\n", caseTypeName, common.TypeParametersDeclaration(caseTypeParameters))
+		w.Indented(func() {
+			fmt.Fprintf(w, "Value %s\n", common.TypeSyntax(f, typeCase.Type))
+		})
+		w.WriteString("}\n\n")
+
+		fmt.Fprintf(w, "func (%s%s) %s() {}\n\n", caseTypeName, common.TypeParametersReference(caseTypeParameters), common.UnionMarkerMethodName(u.Name))
+	}
+}
+
+func writeEnum(w *formatting.IndentedWriter, f *common.File, enum *dsl.EnumDefinition) {
+	enumTypeName := common.TypeIdentifierName(enum.Name)
+	baseType := dsl.Type(dsl.Int32Type)
+	if enum.BaseType != nil {
+		baseType = enum.BaseType
+	}
+
+	common.WriteComment(w, enum.Comment)
+	fmt.Fprintf(w, "type %s %s\n\n", enumTypeName, common.TypeSyntax(f, baseType))
+
+	if len(enum.Values) > 0 {
+		w.WriteStringln("const (")
+		w.Indented(func() {
+			for _, value := range enum.Values {
+				common.WriteComment(w, value.Comment)
+				fmt.Fprintf(w, "%s %s = %s\n", common.EnumValueIdentifierName(enum, value), enumTypeName, value.IntegerValue.String())
+			}
+		})
+		w.WriteStringln(")\n")
+	}
+
+	receiver := "e"
+	if enum.IsFlags {
+		receiver = "f"
+	}
+
+	fmt.Fprintf(w, "func (%s %s) String() string {\n", receiver, enumTypeName)
+	w.Indented(func() {
+		if enum.IsFlags {
+			fmt.Fprintf(w, "return %s.FormatFlags(%s,\n", f.Yardl(), receiver)
+			w.Indented(func() {
+				fmt.Fprintf(w, "[]%s{", enumTypeName)
+				formatting.Delimited(w, ", ", enum.Values, func(w *formatting.IndentedWriter, i int, value *dsl.EnumValue) {
+					w.WriteString(common.EnumValueIdentifierName(enum, value))
+				})
+				w.WriteStringln("},")
+				w.WriteString("[]string{")
+				formatting.Delimited(w, ", ", enum.Values, func(w *formatting.IndentedWriter, i int, value *dsl.EnumValue) {
+					fmt.Fprintf(w, "%q", value.Symbol)
+				})
+				w.WriteStringln("})")
+			})
+			return
+		}
+
+		fmt.Fprintf(w, "switch %s {\n", receiver)
+		for _, value := range enum.Values {
+			fmt.Fprintf(w, "case %s:\n", common.EnumValueIdentifierName(enum, value))
+			w.Indented(func() {
+				fmt.Fprintf(w, "return %q\n", value.Symbol)
+			})
+		}
+		w.WriteStringln("}")
+		fmt.Fprintf(w, "return %s.Sprintf(\"%s(%%d)\", %s)\n", f.Import("fmt"), enumTypeName, receiver)
+	})
+	w.WriteString("}\n\n")
+}
+
+func writeRecord(w *formatting.IndentedWriter, f *common.File, rec *dsl.RecordDefinition) {
+	common.WriteComment(w, rec.Comment)
+	fmt.Fprintf(w, "type %s%s struct {\n", common.TypeIdentifierName(rec.Name), common.TypeParametersDeclaration(rec.TypeParameters))
+	w.Indented(func() {
+		for _, field := range rec.Fields {
+			common.WriteComment(w, field.Comment)
+			fmt.Fprintf(w, "%s %s\n", common.FieldIdentifierName(field.Name), common.TypeSyntax(f, field.Type))
+		}
+	})
+	w.WriteString("}\n\n")
+}
+
+func writeValidateMethod(w *formatting.IndentedWriter, f *common.File, rec *dsl.RecordDefinition, symbolTable dsl.SymbolTable) {
+	recordName := common.TypeIdentifierName(rec.Name)
+	for _, field := range rec.Fields {
+		if field.Constraints != nil && field.Constraints.Pattern != "" {
+			fmt.Fprintf(w, "var %s = %s.MustCompile(%q)\n\n", patternVariableName(rec, field), f.Import("regexp"), "^(?:"+field.Constraints.Pattern+")$")
+		}
+	}
+
+	fmt.Fprintf(w, "// Validate returns an error if a field does not satisfy its constraints.\n")
+	fmt.Fprintf(w, "func (r *%s%s) Validate() error {\n", recordName, common.TypeParametersReference(rec.TypeParameters))
+	w.Indented(func() {
+		for _, field := range rec.Fields {
+			if field.Constraints != nil {
+				writeConstraintChecks(w, f, rec, field)
+			}
+			if dsl.TypeHasConstraints(field.Type, symbolTable) {
+				writeValidation(w, field.Type, "r."+common.FieldIdentifierName(field.Name), 0)
+			}
+		}
+		w.WriteStringln("return nil")
+	})
+	w.WriteString("}\n\n")
+}
+
+func writeConstraintChecks(w *formatting.IndentedWriter, f *common.File, rec *dsl.RecordDefinition, field *dsl.Field) {
+	constraints := field.Constraints
+	value := "r." + common.FieldIdentifierName(field.Name)
+	guard := ""
+	if _, optional := dsl.GetConstrainedType(field); optional {
+		guard = value + " != nil && "
+		value = "*" + value
+	}
+
+	check := func(condition string, message string) {
+		fmt.Fprintf(w, "if %s%s {\n", guard, condition)
+		w.Indented(func() {
+			fmt.Fprintf(w, "return %s.New(%q)\n", f.Import("errors"), fmt.Sprintf("%s.%s %s", rec.Name, field.Name, message))
+		})
+		w.WriteStringln("}")
+	}
+
+	if constraints.Min != nil {
+		check(fmt.Sprintf("%s < %s", value, literalText(constraints.Min)), "must be at least "+literalText(constraints.Min))
+	}
+	if constraints.Max != nil {
+		check(fmt.Sprintf("%s > %s", value, literalText(constraints.Max)), "must be at most "+literalText(constraints.Max))
+	}
+	if constraints.Pattern != "" {
+		check(fmt.Sprintf("!%s.MatchString(%s)", patternVariableName(rec, field), value), fmt.Sprintf("must match the pattern '%s'", constraints.Pattern))
+	}
+	if constraints.MinLength != nil {
+		check(fmt.Sprintf("len(%s) < %d", value, *constraints.MinLength), fmt.Sprintf("must have a length of at least %d", *constraints.MinLength))
+	}
+	if constraints.MaxLength != nil {
+		check(fmt.Sprintf("len(%s) > %d", value, *constraints.MaxLength), fmt.Sprintf("must have a length of at most %d", *constraints.MaxLength))
+	}
+}
+
+// Writes statements that return the error of Validate() on the records within
+// a value of the given type.
+func writeValidation(w *formatting.IndentedWriter, t dsl.Type, value string, depth int) {
+	switch t := t.(type) {
+	case *dsl.SimpleType:
+		switch td := t.ResolvedDefinition.(type) {
+		case *dsl.NamedType:
+			writeValidation(w, td.Type, value, depth)
+		case *dsl.RecordDefinition:
+			fmt.Fprintf(w, "if err := %s.Validate(); err != nil {\n", value)
+			w.Indented(func() {
+				w.WriteStringln("return err")
+			})
+			w.WriteStringln("}")
+		}
+	case *dsl.GeneralizedType:
+		switch t.Dimensionality.(type) {
+		case nil:
+			if t.Cases.IsSingle() {
+				writeValidation(w, t.Cases[0].Type, value, depth)
+			} else if t.Cases.IsOptional() {
+				fmt.Fprintf(w, "if %s != nil {\n", value)
+				w.Indented(func() {
+					writeValidation(w, t.Cases[1].Type, value, depth)
+				})
+				w.WriteStringln("}")
+			}
+		case *dsl.Vector:
+			// Index the items so that they are addressable
+			index := fmt.Sprintf("i%d", depth)
+			fmt.Fprintf(w, "for %s := range %s {\n", index, value)
+			w.Indented(func() {
+				writeValidation(w, t.ToScalar(), fmt.Sprintf("%s[%s]", value, index), depth+1)
+			})
+			w.WriteStringln("}")
+		}
+	}
+}
+
+func patternVariableName(rec *dsl.RecordDefinition, field *dsl.Field) string {
+	recordName := formatting.ToPascalCase(rec.Name)
+	return fmt.Sprintf("%s%s%sPattern", strings.ToLower(recordName[:1]), recordName[1:], formatting.ToPascalCase(field.Name))
+}
+
+// Returns the text of a numeric literal
+func literalText(expression dsl.Expression) string {
+	switch t := expression.(type) {
+	case *dsl.IntegerLiteralExpression:
+		return t.Value.String()
+	case *dsl.FloatingPointLiteralExpression:
+		return t.Value
+	default:
+		panic(fmt.Sprintf("unexpected expression %T", expression))
+	}
+}
+
+func writeNamedType(w *formatting.IndentedWriter, f *common.File, nt *dsl.NamedType) {
+	common.WriteComment(w, nt.Comment)
+	fmt.Fprintf(w, "type %s%s = %s\n\n", common.TypeIdentifierName(nt.Name), common.TypeParametersDeclaration(nt.TypeParameters), common.TypeSyntax(f, nt.Type))
+}
@@ -0,0 +1,438 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+// Package jsonschema writes JSON Schema (draft 2020-12) documents that describe
+// the NDJSON encoding of a model, so that NDJSON streams can be validated
+// without generated code.
+package jsonschema
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"path"
+	"strconv"
+
+	"github.com/microsoft/yardl/tooling/internal/iocommon"
+	"github.com/microsoft/yardl/tooling/internal/ndjsoncommon"
+	"github.com/microsoft/yardl/tooling/pkg/dsl"
+	"github.com/microsoft/yardl/tooling/pkg/packaging"
+)
+
+const metaSchema = "https://json-schema.org/draft/2020-12/schema"
+
+// Matches the text written for times and datetimes
+const (
+	timePattern     = `^\d{2}:\d{2}:\d{2}(\.\d{1,9})?$`
+	dateTimePattern = `^-?\d{4,}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(\.\d{1,9})?Z?$`
+)
+
+type schema map[string]any
+
+// Generate writes a schema with the definitions of each namespace in env and,
+// for each protocol of the top-level namespace, a schema that each line of
+// the protocol's NDJSON stream is valid against.
+func Generate(env *dsl.Environment, options packaging.JsonSchemaCodegenOptions) error {
+	if err := os.MkdirAll(options.OutputDir, 0775); err != nil {
+		return err
+	}
+
+	for _, ns := range env.Namespaces {
+		g := newGenerator()
+		for _, td := range ns.TypeDefinitions {
+			if isDefinition(td) {
+				g.ref(td)
+			}
+		}
+
+		s := schema{
+			"$schema": metaSchema,
+			"title":   ns.Name,
+			"$defs":   g.defs,
+		}
+		if err := writeSchema(path.Join(options.OutputDir, ns.Name+".schema.json"), s); err != nil {
+			return err
+		}
+
+		if !ns.IsTopLevel {
+			continue
+		}
+
+		for _, p := range ns.Protocols {
+			s, err := protocolSchema(p, env)
+			if err != nil {
+				return err
+			}
+			if err := writeSchema(path.Join(options.OutputDir, fmt.Sprintf("%s.%s.schema.json", ns.Name, p.Name)), s); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Returns a schema for a line of a protocol's NDJSON stream: either the header
+// with the protocol schema, or an object with the value of a single step.
+func protocolSchema(p *dsl.ProtocolDefinition, env *dsl.Environment) (schema, error) {
+	var protocolSchema any
+	if err := json.Unmarshal([]byte(dsl.GetProtocolSchemaString(p, env.SymbolTable)), &protocolSchema); err != nil {
+		return nil, err
+	}
+
+	lines := []any{
+		objectWithProperty("yardl", schema{
+			"type": "object",
+			"properties": schema{
+				"version": schema{"const": 1},
+				"schema":  schema{"const": protocolSchema},
+			},
+			"required":             []string{"version", "schema"},
+			"additionalProperties": false,
+		}),
+	}
+
+	g := newGenerator()
+	for _, step := range p.Sequence {
+		t := step.Type
+		if step.IsStream() {
+			// Each item of a stream is on its own line
+			t = t.(*dsl.GeneralizedType).ToScalar()
+		}
+
+		stepSchema := g.typeSchema(t)
+		if step.Comment != "" {
+			stepSchema = schema{"allOf": []any{stepSchema}, "description": step.Comment}
+		}
+		lines = append(lines, objectWithProperty(step.Name, stepSchema))
+	}
+
+	s := schema{
+		"$schema": metaSchema,
+		"title":   p.Name,
+		"oneOf":   lines,
+	}
+	if p.Comment != "" {
+		s["description"] = p.Comment
+	}
+	if len(g.defs) > 0 {
+		s["$defs"] = g.defs
+	}
+	return s, nil
+}
+
+func writeSchema(filePath string, s schema) error {
+	b, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return iocommon.WriteFileIfNeeded(filePath, append(b, '\n'), 0644)
+}
+
+type generator struct {
+	// The schemas of the type definitions referenced with $ref, by qualified name
+	defs schema
+}
+
+func newGenerator() *generator {
+	return &generator{defs: make(schema)}
+}
+
+// Returns whether a type definition is written to $defs. Generic type
+// definitions are written inline for each instantiation.
+func isDefinition(td dsl.TypeDefinition) bool {
+	switch td.(type) {
+	case *dsl.RecordDefinition, *dsl.EnumDefinition, *dsl.NamedType:
+		return !dsl.IsGeneric(td)
+	default:
+		return false
+	}
+}
+
+// Returns a reference to the schema of a type definition, adding it to $defs
+func (g *generator) ref(td dsl.TypeDefinition) schema {
+	meta := td.GetDefinitionMeta()
+	name := meta.GetQualifiedName()
+	if _, ok := g.defs[name]; !ok {
+		// Reserve the name before writing the definition, which may refer to itself
+		g.defs[name] = nil
+		s := g.typeDefinitionSchema(td)
+		if meta.Comment != "" {
+			s["description"] = meta.Comment
+		}
+		g.defs[name] = s
+	}
+
+	return schema{"$ref": "#/$defs/" + name}
+}
+
+func (g *generator) typeDefinitionSchema(td dsl.TypeDefinition) schema {
+	switch td := td.(type) {
+	case dsl.PrimitiveDefinition:
+		return primitiveSchema(td)
+	case *dsl.EnumDefinition:
+		return enumSchema(td)
+	case *dsl.RecordDefinition:
+		return g.recordSchema(td)
+	case *dsl.NamedType:
+		return schema{"allOf": []any{g.typeSchema(td.Type)}}
+	default:
+		panic(fmt.Sprintf("unexpected type definition %T", td))
+	}
+}
+
+func (g *generator) typeSchema(t dsl.Type) schema {
+	switch t := t.(type) {
+	case nil:
+		return schema{"type": "null"}
+	case *dsl.SimpleType:
+		if isDefinition(t.ResolvedDefinition) {
+			return g.ref(t.ResolvedDefinition)
+		}
+		return g.typeDefinitionSchema(t.ResolvedDefinition)
+	case *dsl.GeneralizedType:
+		switch d := t.Dimensionality.(type) {
+		case nil:
+			return g.typeCasesSchema(t.Cases)
+		case *dsl.Vector:
+			s := schema{"type": "array", "items": g.typeCasesSchema(t.Cases)}
+			if d.IsFixed() {
+				s["minItems"] = *d.Length
+				s["maxItems"] = *d.Length
+			}
+			return s
+		case *dsl.Array:
+			items := g.typeCasesSchema(t.Cases)
+			if d.IsFixed() {
+				// Fixed arrays are written as a flat list of elements
+				length := uint64(1)
+				for _, dim := range *d.Dimensions {
+					length *= *dim.Length
+				}
+				return schema{"type": "array", "items": items, "minItems": length, "maxItems": length}
+			}
+
+			shape := schema{"type": "array", "items": schema{"type": "integer", "minimum": 0}}
+			if d.HasKnownNumberOfDimensions() {
+				shape["minItems"] = len(*d.Dimensions)
+				shape["maxItems"] = len(*d.Dimensions)
+			}
+			return schema{
+				"type": "object",
+				"properties": schema{
+					"shape": shape,
+					"data":  schema{"type": "array", "items": items},
+				},
+				"required":             []string{"shape", "data"},
+				"additionalProperties": false,
+			}
+		case *dsl.Map:
+			values := g.typeCasesSchema(t.Cases)
+			if p, ok := dsl.GetPrimitiveType(d.KeyType); ok && p == dsl.String {
+				return schema{"type": "object", "additionalProperties": values}
+			}
+
+			// Maps with other keys are written as a list of [key, value] pairs
+			return schema{
+				"type": "array",
+				"items": schema{
+					"type":        "array",
+					"prefixItems": []any{g.typeSchema(d.KeyType), values},
+					"items":       false,
+					"minItems":    2,
+				},
+			}
+		default:
+			panic(fmt.Sprintf("unexpected dimensionality %T", d))
+		}
+	default:
+		panic(fmt.Sprintf("unexpected type %T", t))
+	}
+}
+
+func (g *generator) typeCasesSchema(cases dsl.TypeCases) schema {
+	if cases.IsSingle() {
+		return g.typeSchema(cases[0].Type)
+	}
+
+	if cases.IsOptional() {
+		return schema{"anyOf": []any{schema{"type": "null"}, g.typeSchema(cases[1].Type)}}
+	}
+
+	options := make([]any, len(cases))
+	if ndjsoncommon.IsSimpleUnion(cases) {
+		// The JSON data types of the cases are distinct, so values are written without a tag
+		for i, c := range cases {
+			options[i] = g.typeSchema(c.Type)
+		}
+	} else {
+		for i, c := range cases {
+			options[i] = objectWithProperty(c.Tag, g.typeSchema(c.Type))
+		}
+	}
+
+	return schema{"anyOf": options}
+}
+
+func (g *generator) recordSchema(record *dsl.RecordDefinition) schema {
+	properties := make(schema, len(record.Fields))
+	required := make([]string, 0, len(record.Fields))
+	for _, field := range record.Fields {
+		fieldSchema := g.typeSchema(field.Type)
+		if field.Comment != "" || field.Constraints != nil {
+			fieldSchema = schema{"allOf": []any{fieldSchema}}
+			if field.Comment != "" {
+				fieldSchema["description"] = field.Comment
+			}
+			addConstraints(fieldSchema, field.Constraints)
+		}
+		properties[field.Name] = fieldSchema
+
+		// null values of optionals and unions with a null case are omitted
+		if gt, ok := dsl.GetUnderlyingType(field.Type).(*dsl.GeneralizedType); !ok || gt.Dimensionality != nil || !gt.Cases.HasNullOption() {
+			required = append(required, field.Name)
+		}
+	}
+
+	return schema{
+		"type":                 "object",
+		"properties":           properties,
+		"required":             required,
+		"additionalProperties": false,
+	}
+}
+
+// Adds the keywords for the constraints of a field. These only apply to values
+// of the matching JSON type, so the null value of an optional field is valid.
+func addConstraints(s schema, constraints *dsl.FieldConstraints) {
+	if constraints == nil {
+		return
+	}
+
+	if constraints.Min != nil {
+		s["minimum"] = literalValue(constraints.Min)
+	}
+	if constraints.Max != nil {
+		s["maximum"] = literalValue(constraints.Max)
+	}
+	if constraints.Pattern != "" {
+		// Patterns are not anchored in JSON Schema
+		s["pattern"] = "^(?:" + constraints.Pattern + ")$"
+	}
+	if constraints.MinLength != nil {
+		s["minItems"] = *constraints.MinLength
+	}
+	if constraints.MaxLength != nil {
+		s["maxItems"] = *constraints.MaxLength
+	}
+}
+
+func literalValue(expression dsl.Expression) any {
+	switch t := expression.(type) {
+	case *dsl.IntegerLiteralExpression:
+		return &t.Value
+	case *dsl.FloatingPointLiteralExpression:
+		value, err := strconv.ParseFloat(t.Value, 64)
+		if err != nil {
+			panic(fmt.Sprintf("invalid floating-point literal %s", t.Value))
+		}
+		return value
+	default:
+		panic(fmt.Sprintf("unexpected expression %T", expression))
+	}
+}
+
+// Enums are written as their symbol, or as their integer value if it is not
+// one of the defined values. Flags are written as a list of the symbols of the
+// values that are set.
+func enumSchema(enum *dsl.EnumDefinition) schema {
+	symbols := make([]string, len(enum.Values))
+	for i, v := range enum.Values {
+		symbols[i] = v.Symbol
+	}
+
+	baseType := dsl.Type(dsl.Int32Type)
+	if enum.BaseType != nil {
+		baseType = enum.BaseType
+	}
+	base, _ := dsl.GetPrimitiveType(baseType)
+
+	symbolic := schema{"enum": symbols}
+	if enum.IsFlags {
+		symbolic = schema{"type": "array", "items": symbolic, "uniqueItems": true}
+	}
+
+	return schema{"anyOf": []any{symbolic, primitiveSchema(base)}}
+}
+
+func primitiveSchema(p dsl.PrimitiveDefinition) schema {
+	switch p {
+	case dsl.Bool:
+		return schema{"type": "boolean"}
+	case dsl.Int8, dsl.Int16, dsl.Int32, dsl.Int64, dsl.Uint8, dsl.Uint16, dsl.Uint32, dsl.Uint64, dsl.Size:
+		min, max := integerRange(p)
+		return schema{"type": "integer", "minimum": min, "maximum": max}
+	case dsl.Float16, dsl.BFloat16, dsl.Float32, dsl.Float64:
+		return schema{"type": "number"}
+	case dsl.ComplexFloat32, dsl.ComplexFloat64:
+		// The real component followed by the imaginary component
+		component := schema{"type": "number"}
+		return schema{
+			"type":        "array",
+			"prefixItems": []any{component, component},
+			"items":       false,
+			"minItems":    2,
+		}
+	case dsl.String:
+		return schema{"type": "string"}
+	case dsl.Bytes:
+		return schema{"type": "string", "contentEncoding": "base64"}
+	case dsl.Uuid:
+		return schema{"type": "string", "format": "uuid"}
+	case dsl.Date:
+		return schema{"type": "string", "format": "date"}
+	case dsl.Time:
+		return schema{"type": "string", "pattern": timePattern}
+	case dsl.DateTime:
+		return schema{"type": "string", "pattern": dateTimePattern}
+	case dsl.Duration:
+		// A signed number of nanoseconds
+		return primitiveSchema(dsl.Int64)
+	default:
+		panic(fmt.Sprintf("unexpected primitive type %s", p))
+	}
+}
+
+func integerRange(p dsl.PrimitiveDefinition) (min, max *big.Int) {
+	switch p {
+	case dsl.Int8:
+		return dsl.MinInt8, dsl.MaxInt8
+	case dsl.Uint8:
+		return dsl.Zero, dsl.MaxUint8
+	case dsl.Int16:
+		return dsl.MinInt16, dsl.MaxInt16
+	case dsl.Uint16:
+		return dsl.Zero, dsl.MaxUint16
+	case dsl.Int32:
+		return dsl.MinInt32, dsl.MaxInt32
+	case dsl.Uint32:
+		return dsl.Zero, dsl.MaxUint32
+	case dsl.Int64:
+		return dsl.MinInt64, dsl.MaxInt64
+	case dsl.Uint64, dsl.Size:
+		return dsl.Zero, dsl.MaxUint64
+	default:
+		panic(fmt.Sprintf("unexpected integer type %s", p))
+	}
+}
+
+func objectWithProperty(name string, value any) schema {
+	return schema{
+		"type":                 "object",
+		"properties":           schema{name: value},
+		"required":             []string{name},
+		"additionalProperties": false,
+	}
+}
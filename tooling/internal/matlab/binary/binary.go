@@ -269,6 +269,9 @@ func typeSerializer(t dsl.Type, contextNamespace string, namedType *dsl.NamedTyp
 	case nil:
 		return "yardl.binary.NoneSerializer"
 	case *dsl.SimpleType:
+		if t.IsRecursiveReference {
+			return fmt.Sprintf("yardl.binary.RecursiveSerializer('%s', @() %s)", common.TypeSyntax(t, contextNamespace), typeDefinitionSerializer(t.ResolvedDefinition, contextNamespace))
+		}
 		return typeDefinitionSerializer(t.ResolvedDefinition, contextNamespace)
 	case *dsl.GeneralizedType:
 		getScalarSerializer := func() string {
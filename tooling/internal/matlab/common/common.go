@@ -61,18 +61,22 @@ var TypeSyntaxWriter dsl.TypeSyntaxWriter[string] = func(self dsl.TypeSyntaxWrit
 			return "int64"
 		case dsl.Uint64, dsl.Size:
 			return "uint64"
-		case dsl.Float32, dsl.ComplexFloat32:
+		case dsl.Float16, dsl.BFloat16, dsl.Float32, dsl.ComplexFloat32:
 			return "single"
 		case dsl.Float64, dsl.ComplexFloat64:
 			return "double"
-		case dsl.String:
+		case dsl.String, dsl.Uuid:
 			return "string"
+		case dsl.Bytes:
+			return "uint8"
 		case dsl.Date:
 			return "yardl.Date"
 		case dsl.Time:
 			return "yardl.Time"
 		case dsl.DateTime:
 			return "yardl.DateTime"
+		case dsl.Duration:
+			return "duration"
 		default:
 			panic(fmt.Sprintf("primitive '%v' not recognized", t))
 		}
@@ -115,6 +119,18 @@ func TypeSyntax(typeOrTypeDefinition dsl.Node, contextNamespace string) string {
 	return TypeSyntaxWriter.ToSyntax(typeOrTypeDefinition, contextNamespace)
 }
 
+// The name of the class whose Constant properties are the constants of a namespace.
+const ConstantsClassName = "Constants"
+
+func ConstantIdentifierName(name string) string {
+	cased := formatting.ToUpperSnakeCase(name)
+	if !isReservedName[cased] {
+		return cased
+	}
+
+	return cased + "_"
+}
+
 func ComputedFieldIdentifierName(name string) string {
 	cased := formatting.ToSnakeCase(name)
 	if !isReservedName[name] {
@@ -276,3 +292,46 @@ func (fw *MatlabFileWriter) RemoveStaleFiles() error {
 	}
 	return nil
 }
+
+// Writes statements that call validate() on the records within a value of the
+// given type, for types for which dsl.TypeHasConstraints is true.
+func WriteValidation(w *formatting.IndentedWriter, t dsl.Type, value string) {
+	writeValidation(w, t, value, 0)
+}
+
+func writeValidation(w *formatting.IndentedWriter, t dsl.Type, value string, depth int) {
+	switch t := t.(type) {
+	case *dsl.SimpleType:
+		switch td := t.ResolvedDefinition.(type) {
+		case *dsl.NamedType:
+			writeValidation(w, td.Type, value, depth)
+		case *dsl.RecordDefinition:
+			fmt.Fprintf(w, "%s.validate();\n", value)
+		}
+	case *dsl.GeneralizedType:
+		switch t.Dimensionality.(type) {
+		case nil:
+			if t.Cases.IsSingle() {
+				writeValidation(w, t.Cases[0].Type, value, depth)
+			} else if t.Cases.IsOptional() {
+				fmt.Fprintf(w, "if %s ~= yardl.None\n", value)
+				WriteBlockBody(w, func() {
+					writeValidation(w, t.Cases[1].Type, value, depth)
+				})
+			}
+		case *dsl.Vector:
+			// Vectors are either arrays or cell arrays
+			items := fmt.Sprintf("items%d", depth)
+			index := fmt.Sprintf("i%d", depth)
+			fmt.Fprintf(w, "%s = %s;\n", items, value)
+			fmt.Fprintf(w, "if ~iscell(%s)\n", items)
+			WriteBlockBody(w, func() {
+				fmt.Fprintf(w, "%s = num2cell(%s);\n", items, items)
+			})
+			fmt.Fprintf(w, "for %s = 1:numel(%s)\n", index, items)
+			WriteBlockBody(w, func() {
+				writeValidation(w, t.ToScalar(), fmt.Sprintf("%s{%s}", items, index), depth+1)
+			})
+		}
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/microsoft/yardl/tooling/internal/matlab/binary"
 	"github.com/microsoft/yardl/tooling/internal/matlab/common"
 	"github.com/microsoft/yardl/tooling/internal/matlab/mocks"
+	"github.com/microsoft/yardl/tooling/internal/matlab/ndjson"
 	"github.com/microsoft/yardl/tooling/internal/matlab/protocols"
 	"github.com/microsoft/yardl/tooling/internal/matlab/types"
 	"github.com/microsoft/yardl/tooling/pkg/dsl"
@@ -57,6 +58,16 @@ func Generate(env *dsl.Environment, options packaging.MatlabCodegenOptions) erro
 			return err
 		}
 
+		// Write NDJSON converters
+		if options.GenerateNDJson {
+			ndjsonDir := path.Join(packageDir, common.PackageDir("ndjson"))
+			if err := updatePackage(ndjsonDir, func(fw *common.MatlabFileWriter) error {
+				return ndjson.WriteNDJson(fw, ns)
+			}); err != nil {
+				return err
+			}
+		}
+
 		// Write mocks and test support classes
 		if ns.IsTopLevel && options.InternalGenerateMocks {
 			mocksDir := path.Join(packageDir, common.PackageDir("testing"))
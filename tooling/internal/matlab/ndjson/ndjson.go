@@ -0,0 +1,458 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+package ndjson
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/microsoft/yardl/tooling/internal/formatting"
+	"github.com/microsoft/yardl/tooling/internal/matlab/common"
+	"github.com/microsoft/yardl/tooling/internal/ndjsoncommon"
+	"github.com/microsoft/yardl/tooling/pkg/dsl"
+)
+
+func WriteNDJson(fw *common.MatlabFileWriter, ns *dsl.Namespace) error {
+	if ns.IsTopLevel {
+		if err := writeProtocols(fw, ns); err != nil {
+			return err
+		}
+	}
+
+	if err := writeEnumConverters(fw, ns); err != nil {
+		return err
+	}
+
+	return writeRecordConverters(fw, ns)
+}
+
+func writeProtocols(fw *common.MatlabFileWriter, ns *dsl.Namespace) error {
+	for _, p := range ns.Protocols {
+		if err := writeProtocolWriter(fw, p, ns); err != nil {
+			return err
+		}
+
+		if err := writeProtocolReader(fw, p, ns); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeProtocolWriter(fw *common.MatlabFileWriter, p *dsl.ProtocolDefinition, ns *dsl.Namespace) error {
+	return fw.WriteFile(NDJsonWriterName(p), func(w *formatting.IndentedWriter) {
+		abstractWriterName := fmt.Sprintf("%s.%s", common.NamespaceIdentifierName(p.Namespace), common.AbstractWriterName(p))
+		fmt.Fprintf(w, "classdef %s < yardl.ndjson.NDJsonProtocolWriter & %s\n", NDJsonWriterName(p), abstractWriterName)
+		common.WriteBlockBody(w, func() {
+			common.WriteComment(w, fmt.Sprintf("NDJSON writer for the %s protocol", p.Name))
+			common.WriteComment(w, p.Comment)
+
+			w.WriteStringln("properties (Access=protected)")
+			common.WriteBlockBody(w, func() {
+				for _, step := range p.Sequence {
+					w.WriteStringln(converterName(step))
+				}
+			})
+
+			w.WriteStringln("")
+			w.WriteStringln("methods")
+			common.WriteBlockBody(w, func() {
+				fmt.Fprintf(w, "function self = %s(filename)\n", NDJsonWriterName(p))
+				common.WriteBlockBody(w, func() {
+					fmt.Fprintf(w, "self@%s();\n", abstractWriterName)
+					fmt.Fprintf(w, "self@yardl.ndjson.NDJsonProtocolWriter(filename, %s.schema);\n", abstractWriterName)
+					for _, step := range p.Sequence {
+						fmt.Fprintf(w, "self.%s = %s;\n", converterName(step), typeConverter(step.Type, ns.Name, nil))
+					}
+				})
+			})
+			w.WriteStringln("")
+
+			w.WriteStringln("methods (Access=protected)")
+			common.WriteBlockBody(w, func() {
+				for i, step := range p.Sequence {
+					fmt.Fprintf(w, "function %s(self, value)\n", common.ProtocolWriteImplMethodName(step))
+					common.WriteBlockBody(w, func() {
+						if step.IsStream() {
+							fmt.Fprintf(w, "self.write_json_stream_(\"%s\", self.%s, value);\n", step.Name, converterName(step))
+						} else {
+							fmt.Fprintf(w, "self.write_json_line_(\"%s\", self.%s.to_json(value));\n", step.Name, converterName(step))
+						}
+					})
+					if i < len(p.Sequence)-1 {
+						w.WriteStringln("")
+					}
+				}
+			})
+		})
+	})
+}
+
+func writeProtocolReader(fw *common.MatlabFileWriter, p *dsl.ProtocolDefinition, ns *dsl.Namespace) error {
+	return fw.WriteFile(NDJsonReaderName(p), func(w *formatting.IndentedWriter) {
+		abstractReaderName := fmt.Sprintf("%s.%s", common.NamespaceIdentifierName(p.Namespace), common.AbstractReaderName(p))
+		fmt.Fprintf(w, "classdef %s < yardl.ndjson.NDJsonProtocolReader & %s\n", NDJsonReaderName(p), abstractReaderName)
+		common.WriteBlockBody(w, func() {
+			common.WriteComment(w, fmt.Sprintf("NDJSON reader for the %s protocol", p.Name))
+			common.WriteComment(w, p.Comment)
+
+			w.WriteStringln("properties (Access=protected)")
+			common.WriteBlockBody(w, func() {
+				for _, step := range p.Sequence {
+					w.WriteStringln(converterName(step))
+				}
+			})
+			w.WriteStringln("")
+
+			w.WriteStringln("methods")
+			common.WriteBlockBody(w, func() {
+				fmt.Fprintf(w, "function self = %s(filename, options)\n", NDJsonReaderName(p))
+				common.WriteBlockBody(w, func() {
+					w.WriteStringln("arguments")
+					common.WriteBlockBody(w, func() {
+						w.WriteStringln("filename (1,1) string")
+						fmt.Fprintf(w, "options.skip_completed_check (1,1) logical = false\n")
+					})
+					fmt.Fprintf(w, "self@%s(skip_completed_check=options.skip_completed_check);\n", abstractReaderName)
+					fmt.Fprintf(w, "self@yardl.ndjson.NDJsonProtocolReader(filename, %s.schema);\n", abstractReaderName)
+					for _, step := range p.Sequence {
+						fmt.Fprintf(w, "self.%s = %s;\n", converterName(step), typeConverter(step.Type, ns.Name, nil))
+					}
+				})
+			})
+			w.WriteStringln("")
+
+			w.WriteStringln("methods (Access=protected)")
+			common.WriteBlockBody(w, func() {
+				for i, step := range p.Sequence {
+					if step.IsStream() {
+						fmt.Fprintf(w, "function more = %s(self)\n", common.ProtocolHasMoreImplMethodName(step))
+						common.WriteBlockBody(w, func() {
+							fmt.Fprintf(w, "more = self.has_json_line_(\"%s\");\n", step.Name)
+						})
+						w.WriteStringln("")
+					}
+
+					fmt.Fprintf(w, "function value = %s(self)\n", common.ProtocolReadImplMethodName(step))
+					common.WriteBlockBody(w, func() {
+						fmt.Fprintf(w, "json = self.read_json_line_(\"%s\");\n", step.Name)
+						fmt.Fprintf(w, "value = self.%s.from_json(json);\n", converterName(step))
+					})
+					if i < len(p.Sequence)-1 {
+						w.WriteStringln("")
+					}
+				}
+			})
+		})
+	})
+}
+
+func converterName(step *dsl.ProtocolStep) string {
+	return fmt.Sprintf("%s_converter", formatting.ToSnakeCase(step.Name))
+}
+
+func writeEnumConverters(fw *common.MatlabFileWriter, ns *dsl.Namespace) error {
+	for _, td := range ns.TypeDefinitions {
+		switch td := td.(type) {
+		case *dsl.EnumDefinition:
+			if err := writeEnumConverter(fw, td, ns); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func writeEnumConverter(fw *common.MatlabFileWriter, enum *dsl.EnumDefinition, ns *dsl.Namespace) error {
+	return fw.WriteFile(enumConverterClassName(enum), func(w *formatting.IndentedWriter) {
+		baseClass := "yardl.ndjson.EnumConverter"
+		if enum.IsFlags {
+			baseClass = "yardl.ndjson.FlagsConverter"
+		}
+
+		var baseType dsl.Type
+		if enum.BaseType != nil {
+			baseType = enum.BaseType
+		} else {
+			baseType = dsl.Int32Type
+		}
+
+		enumSyntax := common.TypeSyntax(enum, ns.Name)
+		symbols := make([]string, len(enum.Values))
+		values := make([]string, len(enum.Values))
+		for i, v := range enum.Values {
+			symbols[i] = fmt.Sprintf("\"%s\"", v.Symbol)
+			values[i] = fmt.Sprintf("%s.%s", enumSyntax, common.EnumValueIdentifierName(v.Symbol))
+		}
+
+		fmt.Fprintf(w, "classdef %s < %s\n", enumConverterClassName(enum), baseClass)
+		common.WriteBlockBody(w, func() {
+			w.WriteStringln("methods")
+			common.WriteBlockBody(w, func() {
+				fmt.Fprintf(w, "function self = %s()\n", enumConverterClassName(enum))
+				common.WriteBlockBody(w, func() {
+					fmt.Fprintf(w, "symbols = [%s];\n", strings.Join(symbols, ", "))
+					fmt.Fprintf(w, "values = [%s];\n", strings.Join(values, ", "))
+					fmt.Fprintf(w, "self@%s('%s', @%s, %s, symbols, values);\n", baseClass, enumSyntax, enumSyntax, typeConverter(baseType, ns.Name, nil))
+				})
+			})
+		})
+	})
+}
+
+func enumConverterClassName(enum *dsl.EnumDefinition) string {
+	return fmt.Sprintf("%sConverter", formatting.ToPascalCase(enum.Name))
+}
+
+func writeRecordConverters(fw *common.MatlabFileWriter, ns *dsl.Namespace) error {
+	for _, td := range ns.TypeDefinitions {
+		switch td := td.(type) {
+		case *dsl.RecordDefinition:
+			if err := writeRecordConverter(fw, td, ns); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func writeRecordConverter(fw *common.MatlabFileWriter, rec *dsl.RecordDefinition, ns *dsl.Namespace) error {
+	return fw.WriteFile(recordConverterClassName(rec), func(w *formatting.IndentedWriter) {
+
+		typeSyntax := common.TypeSyntax(rec, ns.Name)
+		fmt.Fprintf(w, "classdef %s < yardl.ndjson.RecordConverter\n", recordConverterClassName(rec))
+		common.WriteBlockBody(w, func() {
+
+			w.WriteStringln("methods")
+			common.WriteBlockBody(w, func() {
+				if len(rec.TypeParameters) > 0 {
+					typeParamConverters := make([]string, 0, len(rec.TypeParameters))
+					for _, tp := range rec.TypeParameters {
+						typeParamConverters = append(
+							typeParamConverters,
+							typeDefinitionConverter(tp, ns.Name))
+					}
+
+					fmt.Fprintf(w, "function self = %s(%s)\n", recordConverterClassName(rec), strings.Join(typeParamConverters, ", "))
+				} else {
+					fmt.Fprintf(w, "function self = %s()\n", recordConverterClassName(rec))
+				}
+
+				common.WriteBlockBody(w, func() {
+					fieldNames := make([]string, len(rec.Fields))
+					for i, field := range rec.Fields {
+						fieldNames[i] = fmt.Sprintf("\"%s\"", field.Name)
+					}
+
+					if len(rec.Fields) == 0 {
+						w.WriteStringln("field_converters = {};")
+					}
+					for i, field := range rec.Fields {
+						fmt.Fprintf(w, "field_converters{%d} = %s;\n", i+1, typeConverter(field.Type, ns.Name, nil))
+					}
+					fmt.Fprintf(w, "self@yardl.ndjson.RecordConverter('%s', [%s], field_converters);\n", typeSyntax, strings.Join(fieldNames, ", "))
+				})
+				w.WriteStringln("")
+
+				fmt.Fprintf(w, "function json = to_json(self, value)\n")
+				common.WriteBlockBody(w, func() {
+					w.WriteStringln("arguments")
+					common.WriteBlockBody(w, func() {
+						w.WriteStringln("self")
+						fmt.Fprintf(w, "value (1,1) %s\n", typeSyntax)
+					})
+
+					fieldAccesses := make([]string, len(rec.Fields))
+					for i, field := range rec.Fields {
+						fieldAccesses[i] = fmt.Sprintf("value.%s", common.FieldIdentifierName(field.Name))
+					}
+					fmt.Fprintf(w, "json = self.to_json_(%s);\n", strings.Join(fieldAccesses, ", "))
+				})
+				w.WriteStringln("")
+
+				fmt.Fprintf(w, "function value = from_json(self, json)\n")
+				common.WriteBlockBody(w, func() {
+					w.WriteStringln("fields = self.from_json_(json);")
+					fieldWrites := make([]string, len(rec.Fields))
+					for i, field := range rec.Fields {
+						fieldWrites[i] = fmt.Sprintf("%s=fields{%d}", common.FieldIdentifierName(field.Name), i+1)
+					}
+					fmt.Fprintf(w, "value = %s(%s);\n", typeSyntax, strings.Join(fieldWrites, ", "))
+				})
+			})
+		})
+	})
+}
+
+func recordConverterClassName(record *dsl.RecordDefinition) string {
+	return fmt.Sprintf("%sConverter", formatting.ToPascalCase(record.Name))
+}
+
+func typeDefinitionConverter(td dsl.TypeDefinition, contextNamespace string) string {
+	switch td := td.(type) {
+	case dsl.PrimitiveDefinition:
+		return fmt.Sprintf("yardl.ndjson.%sConverter", formatting.ToPascalCase(string(td)))
+
+	case *dsl.EnumDefinition:
+		return fmt.Sprintf("%s.ndjson.%s()", common.NamespaceIdentifierName(td.Namespace), enumConverterClassName(td))
+
+	case *dsl.RecordDefinition:
+		qualifiedConverterName := fmt.Sprintf("%s.ndjson.%s", common.NamespaceIdentifierName(td.Namespace), recordConverterClassName(td))
+		if len(td.TypeParameters) == 0 {
+			return fmt.Sprintf("%s()", qualifiedConverterName)
+		}
+		if len(td.TypeArguments) == 0 {
+			panic("Expected type arguments")
+		}
+
+		typeArguments := make([]string, 0, len(td.TypeArguments))
+		for _, arg := range td.TypeArguments {
+			typeArguments = append(typeArguments, typeConverter(arg, contextNamespace, nil))
+		}
+
+		return fmt.Sprintf("%s(%s)", qualifiedConverterName, strings.Join(typeArguments, ", "))
+
+	case *dsl.GenericTypeParameter:
+		return fmt.Sprintf("%s_converter", formatting.ToSnakeCase(td.Name))
+
+	case *dsl.NamedType:
+		return typeConverter(td.Type, contextNamespace, td)
+
+	default:
+		panic(fmt.Sprintf("Not implemented %T", td))
+	}
+}
+
+func typeConverter(t dsl.Type, contextNamespace string, namedType *dsl.NamedType) string {
+	switch t := t.(type) {
+	case nil:
+		return "yardl.ndjson.NoneConverter"
+	case *dsl.SimpleType:
+		if t.IsRecursiveReference {
+			return fmt.Sprintf("yardl.ndjson.RecursiveConverter('%s', @() %s)", common.TypeSyntax(t, contextNamespace), typeDefinitionConverter(t.ResolvedDefinition, contextNamespace))
+		}
+		return typeDefinitionConverter(t.ResolvedDefinition, contextNamespace)
+	case *dsl.GeneralizedType:
+		getScalarConverter := func() string {
+			if t.Cases.IsSingle() {
+				return typeConverter(t.Cases[0].Type, contextNamespace, namedType)
+			}
+			if t.Cases.IsOptional() {
+				return fmt.Sprintf("yardl.ndjson.OptionalConverter(%s)", typeConverter(t.Cases[1].Type, contextNamespace, namedType))
+			}
+
+			unionClassName := common.UnionClassName(t)
+			if namedType != nil {
+				unionClassName = fmt.Sprintf("%s.%s", common.NamespaceIdentifierName(namedType.Namespace), namedType.Name)
+			} else {
+				unionClassName = fmt.Sprintf("%s.%s", common.NamespaceIdentifierName(contextNamespace), unionClassName)
+			}
+
+			simple := "true"
+			var possibleTypes ndjsoncommon.JsonDataType
+			converters := make([]string, len(t.Cases))
+			factories := make([]string, len(t.Cases))
+			tags := make([]string, len(t.Cases))
+			jsonTypes := make([]string, len(t.Cases))
+			for i, c := range t.Cases {
+				if c.Type == nil {
+					converters[i] = "yardl.ndjson.NoneConverter"
+					factories[i] = "yardl.None"
+					tags[i] = `""`
+					jsonTypes[i] = `["null"]`
+					continue
+				}
+
+				converters[i] = typeConverter(c.Type, contextNamespace, namedType)
+				factories[i] = fmt.Sprintf("@%s.%s", unionClassName, formatting.ToPascalCase(c.Tag))
+				tags[i] = fmt.Sprintf("\"%s\"", c.Tag)
+
+				caseTypes := ndjsoncommon.GetJsonDataType(c.Type)
+				jsonTypes[i] = fmt.Sprintf("[%s]", strings.Join(jsonDataTypeNames(caseTypes), ", "))
+				if caseTypes&possibleTypes != 0 {
+					simple = "false"
+				}
+				possibleTypes |= caseTypes
+			}
+
+			return fmt.Sprintf(
+				"yardl.ndjson.UnionConverter('%s', {%s}, {%s}, [%s], {%s}, %s)",
+				unionClassName,
+				strings.Join(converters, ", "),
+				strings.Join(factories, ", "),
+				strings.Join(tags, ", "),
+				strings.Join(jsonTypes, ", "),
+				simple)
+		}
+
+		switch td := t.Dimensionality.(type) {
+		case nil, *dsl.Stream:
+			return getScalarConverter()
+		case *dsl.Vector:
+			if td.Length != nil {
+				return fmt.Sprintf("yardl.ndjson.FixedVectorConverter(%s, %d)", getScalarConverter(), *td.Length)
+			}
+			return fmt.Sprintf("yardl.ndjson.VectorConverter(%s)", getScalarConverter())
+		case *dsl.Array:
+			if td.IsFixed() {
+				dims := make([]string, len(*td.Dimensions))
+				for i, d := range *td.Dimensions {
+					dims[len(*td.Dimensions)-i-1] = strconv.FormatUint(*d.Length, 10)
+				}
+
+				return fmt.Sprintf("yardl.ndjson.FixedNDArrayConverter(%s, [%s])", getScalarConverter(), strings.Join(dims, ", "))
+			}
+
+			if td.HasKnownNumberOfDimensions() {
+				return fmt.Sprintf("yardl.ndjson.NDArrayConverter(%s, %d)", getScalarConverter(), len(*td.Dimensions))
+			}
+
+			return fmt.Sprintf("yardl.ndjson.DynamicNDArrayConverter(%s)", getScalarConverter())
+
+		case *dsl.Map:
+			keyConverter := typeConverter(td.KeyType, contextNamespace, namedType)
+			valueConverter := typeConverter(t.ToScalar(), contextNamespace, namedType)
+
+			return fmt.Sprintf("yardl.ndjson.MapConverter(%s, %s)", keyConverter, valueConverter)
+
+		default:
+			panic(fmt.Sprintf("Not implemented %T", t.Dimensionality))
+		}
+	default:
+		panic(fmt.Sprintf("Not implemented %T", t))
+	}
+}
+
+// Returns the names of the JSON data types, as returned by yardl.ndjson.json_data_type
+func jsonDataTypeNames(jsonTypes ndjsoncommon.JsonDataType) []string {
+	names := make([]string, 0, 1)
+	if jsonTypes&ndjsoncommon.JsonNull != 0 {
+		names = append(names, `"null"`)
+	}
+	if jsonTypes&ndjsoncommon.JsonBoolean != 0 {
+		names = append(names, `"boolean"`)
+	}
+	if jsonTypes&ndjsoncommon.JsonNumber != 0 {
+		names = append(names, `"number"`)
+	}
+	if jsonTypes&ndjsoncommon.JsonString != 0 {
+		names = append(names, `"string"`)
+	}
+	if jsonTypes&ndjsoncommon.JsonArray != 0 {
+		names = append(names, `"array"`)
+	}
+	if jsonTypes&ndjsoncommon.JsonObject != 0 {
+		names = append(names, `"object"`)
+	}
+	return names
+}
+
+func NDJsonWriterName(p *dsl.ProtocolDefinition) string {
+	return fmt.Sprintf("%sWriter", formatting.ToPascalCase(p.Name))
+}
+
+func NDJsonReaderName(p *dsl.ProtocolDefinition) string {
+	return fmt.Sprintf("%sReader", formatting.ToPascalCase(p.Name))
+}
@@ -19,6 +19,14 @@ func WriteProtocols(fw *common.MatlabFileWriter, ns *dsl.Namespace, st dsl.Symbo
 		if err := writeAbstractReader(fw, p, ns); err != nil {
 			return err
 		}
+		for _, sub := range p.SubProtocols {
+			if err := writeSubWriter(fw, p, sub, ns); err != nil {
+				return err
+			}
+			if err := writeSubReader(fw, p, sub, ns); err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
@@ -33,16 +41,37 @@ func writeAbstractWriter(fw *common.MatlabFileWriter, p *dsl.ProtocolDefinition,
 		common.WriteBlockBody(w, func() {
 
 			w.WriteStringln("properties (Access=protected)")
-			common.WriteBlockBody(w, func() { w.WriteStringln("state_") })
+			common.WriteBlockBody(w, func() {
+				w.WriteStringln("state_")
+				for _, sub := range p.SubProtocols {
+					fmt.Fprintf(w, "%s_writer_\n", formatting.ToSnakeCase(sub.Name))
+				}
+			})
 			w.WriteStringln("")
 
 			w.WriteStringln("methods")
 			common.WriteBlockBody(w, func() {
 				// Constructor
 				fmt.Fprintf(w, "function self = %s()\n", common.AbstractWriterName(p))
-				common.WriteBlockBody(w, func() { w.WriteStringln("self.state_ = 0;") })
+				common.WriteBlockBody(w, func() {
+					w.WriteStringln("self.state_ = 0;")
+					for _, sub := range p.SubProtocols {
+						fmt.Fprintf(w, "self.%s_writer_ = %s.%s(self);\n", formatting.ToSnakeCase(sub.Name), common.NamespaceIdentifierName(ns.Name), subWriterName(p, sub))
+					}
+				})
 				w.WriteStringln("")
 
+				// Sub-protocol writers
+				for _, sub := range p.SubProtocols {
+					common.WriteComment(w, fmt.Sprintf("Writer for the `%s` steps, which are written through this writer", sub.Name))
+					common.WriteComment(w, sub.Comment)
+					fmt.Fprintf(w, "function writer = %s(self)\n", common.FieldIdentifierName(sub.Name))
+					common.WriteBlockBody(w, func() {
+						fmt.Fprintf(w, "writer = self.%s_writer_;\n", formatting.ToSnakeCase(sub.Name))
+					})
+					w.WriteStringln("")
+				}
+
 				// Close method
 				w.WriteStringln("function close(self)")
 				common.WriteBlockBody(w, func() {
@@ -165,6 +194,9 @@ func writeAbstractReader(fw *common.MatlabFileWriter, p *dsl.ProtocolDefinition,
 			common.WriteBlockBody(w, func() {
 				w.WriteStringln("state_")
 				w.WriteStringln("skip_completed_check_")
+				for _, sub := range p.SubProtocols {
+					fmt.Fprintf(w, "%s_reader_\n", formatting.ToSnakeCase(sub.Name))
+				}
 			})
 			w.WriteStringln("")
 
@@ -179,9 +211,23 @@ func writeAbstractReader(fw *common.MatlabFileWriter, p *dsl.ProtocolDefinition,
 					})
 					w.WriteStringln("self.state_ = 0;")
 					w.WriteStringln("self.skip_completed_check_ = options.skip_completed_check;")
+					for _, sub := range p.SubProtocols {
+						fmt.Fprintf(w, "self.%s_reader_ = %s.%s(self);\n", formatting.ToSnakeCase(sub.Name), common.NamespaceIdentifierName(ns.Name), subReaderName(p, sub))
+					}
 				})
 				w.WriteStringln("")
 
+				// Sub-protocol readers
+				for _, sub := range p.SubProtocols {
+					common.WriteComment(w, fmt.Sprintf("Reader for the `%s` steps, which are read through this reader", sub.Name))
+					common.WriteComment(w, sub.Comment)
+					fmt.Fprintf(w, "function reader = %s(self)\n", common.FieldIdentifierName(sub.Name))
+					common.WriteBlockBody(w, func() {
+						fmt.Fprintf(w, "reader = self.%s_reader_;\n", formatting.ToSnakeCase(sub.Name))
+					})
+					w.WriteStringln("")
+				}
+
 				// Close method
 				w.WriteStringln("function close(self)")
 				common.WriteBlockBody(w, func() {
@@ -304,3 +350,116 @@ func writeAbstractReader(fw *common.MatlabFileWriter, p *dsl.ProtocolDefinition,
 		})
 	})
 }
+
+// Writes a writer for the sub-protocol that forwards each step to the outer
+// writer's corresponding inlined step.
+func writeSubWriter(fw *common.MatlabFileWriter, p *dsl.ProtocolDefinition, sub *dsl.SubProtocol, ns *dsl.Namespace) error {
+	return fw.WriteFile(subWriterName(p, sub), func(w *formatting.IndentedWriter) {
+		common.WriteComment(w, fmt.Sprintf("Writes the `%s` steps of protocol %s", sub.Name, p.Name))
+		fmt.Fprintf(w, "classdef %s < %s.%s\n", subWriterName(p, sub), common.NamespaceIdentifierName(ns.Name), common.AbstractWriterName(sub.Protocol))
+
+		common.WriteBlockBody(w, func() {
+			w.WriteStringln("properties (Access=private)")
+			common.WriteBlockBody(w, func() { w.WriteStringln("outer_") })
+			w.WriteStringln("")
+
+			w.WriteStringln("methods")
+			common.WriteBlockBody(w, func() {
+				fmt.Fprintf(w, "function self = %s(outer)\n", subWriterName(p, sub))
+				common.WriteBlockBody(w, func() { w.WriteStringln("self.outer_ = outer;") })
+			})
+			w.WriteStringln("")
+
+			w.WriteStringln("methods (Access=protected)")
+			common.WriteBlockBody(w, func() {
+				outerSteps := sub.Steps(p)
+				for i, step := range sub.Protocol.Sequence {
+					fmt.Fprintf(w, "function %s(self, value)\n", common.ProtocolWriteImplMethodName(step))
+					common.WriteBlockBody(w, func() {
+						fmt.Fprintf(w, "self.outer_.%s(value);\n", common.ProtocolWriteMethodName(outerSteps[i]))
+					})
+					w.WriteStringln("")
+				}
+
+				// The stream being ended is identified by the current state
+				w.WriteStringln("function end_stream_(self)")
+				common.WriteBlockBody(w, func() {
+					first := true
+					for i, step := range sub.Protocol.Sequence {
+						if !step.IsStream() {
+							continue
+						}
+						if !first {
+							w.WriteString("else")
+						}
+						first = false
+						fmt.Fprintf(w, "if self.state_ == %d\n", i)
+						w.Indented(func() {
+							fmt.Fprintf(w, "self.outer_.%s();\n", common.ProtocolEndMethodName(outerSteps[i]))
+						})
+					}
+					if !first {
+						w.WriteStringln("end")
+					}
+				})
+				w.WriteStringln("")
+
+				w.WriteStringln("function close_(self)")
+				w.WriteStringln("end")
+			})
+		})
+	})
+}
+
+// Writes a reader for the sub-protocol that forwards each step to the outer
+// reader's corresponding inlined step.
+func writeSubReader(fw *common.MatlabFileWriter, p *dsl.ProtocolDefinition, sub *dsl.SubProtocol, ns *dsl.Namespace) error {
+	return fw.WriteFile(subReaderName(p, sub), func(w *formatting.IndentedWriter) {
+		common.WriteComment(w, fmt.Sprintf("Reads the `%s` steps of protocol %s", sub.Name, p.Name))
+		fmt.Fprintf(w, "classdef %s < %s.%s\n", subReaderName(p, sub), common.NamespaceIdentifierName(ns.Name), common.AbstractReaderName(sub.Protocol))
+
+		common.WriteBlockBody(w, func() {
+			w.WriteStringln("properties (Access=private)")
+			common.WriteBlockBody(w, func() { w.WriteStringln("outer_") })
+			w.WriteStringln("")
+
+			w.WriteStringln("methods")
+			common.WriteBlockBody(w, func() {
+				fmt.Fprintf(w, "function self = %s(outer)\n", subReaderName(p, sub))
+				common.WriteBlockBody(w, func() { w.WriteStringln("self.outer_ = outer;") })
+			})
+			w.WriteStringln("")
+
+			w.WriteStringln("methods (Access=protected)")
+			common.WriteBlockBody(w, func() {
+				outerSteps := sub.Steps(p)
+				for i, step := range sub.Protocol.Sequence {
+					if step.IsStream() {
+						fmt.Fprintf(w, "function more = %s(self)\n", common.ProtocolHasMoreImplMethodName(step))
+						common.WriteBlockBody(w, func() {
+							fmt.Fprintf(w, "more = self.outer_.%s();\n", common.ProtocolHasMoreMethodName(outerSteps[i]))
+						})
+						w.WriteStringln("")
+					}
+
+					fmt.Fprintf(w, "function value = %s(self)\n", common.ProtocolReadImplMethodName(step))
+					common.WriteBlockBody(w, func() {
+						fmt.Fprintf(w, "value = self.outer_.%s();\n", common.ProtocolReadMethodName(outerSteps[i]))
+					})
+					w.WriteStringln("")
+				}
+
+				w.WriteStringln("function close_(self)")
+				w.WriteStringln("end")
+			})
+		})
+	})
+}
+
+func subWriterName(p *dsl.ProtocolDefinition, sub *dsl.SubProtocol) string {
+	return fmt.Sprintf("%s%sSubWriter", formatting.ToPascalCase(p.Name), formatting.ToPascalCase(sub.Name))
+}
+
+func subReaderName(p *dsl.ProtocolDefinition, sub *dsl.SubProtocol) string {
+	return fmt.Sprintf("%s%sSubReader", formatting.ToPascalCase(p.Name), formatting.ToPascalCase(sub.Name))
+}
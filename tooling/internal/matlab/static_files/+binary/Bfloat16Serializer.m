@@ -0,0 +1,56 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+classdef Bfloat16Serializer < yardl.binary.TypeSerializer
+    % MATLAB has no bfloat16 type, so values are held as single and are
+    % rounded to the nearest bfloat16 value, with ties to even, when they
+    % are written.
+
+    methods (Static)
+        function write(outstream, value)
+            arguments
+                outstream (1,1) yardl.binary.CodedOutputStream
+                value (1,1) single
+            end
+
+            bits = yardl.binary.Bfloat16Serializer.to_bits(value);
+            outstream.write_bytes(typecast(bits, "uint8"));
+        end
+
+        function res = read(instream)
+            bytes = instream.read_bytes(2);
+            res = yardl.binary.Bfloat16Serializer.from_bits(typecast(bytes, "uint16"));
+        end
+
+        function c = get_class()
+            c = "single";
+        end
+
+        function trivial = is_trivially_serializable()
+            trivial = true;
+        end
+
+        function bits = to_bits(values)
+            u = typecast(single(values(:)), "uint32");
+            result = yardl.binary.Float16Serializer.round_shift(u, 16);
+            nan = bitand(u, 0x7fffffffu32) > 0x7f800000u32;
+            result(nan) = bitor(bitshift(u(nan), -16), 0x40u32);
+            bits = reshape(uint16(result), size(values));
+        end
+
+        function values = from_bits(bits)
+            values = typecast(bitshift(uint32(bits(:)), 16), "single");
+            values = reshape(values, size(bits));
+        end
+    end
+
+    methods
+        function write_trivially(self, stream, values)
+            stream.write_values_directly(self.to_bits(values), "uint16");
+        end
+
+        function res = read_trivially(self, stream, shape)
+            res = self.from_bits(stream.read_values_directly(shape, "uint16"));
+        end
+    end
+end
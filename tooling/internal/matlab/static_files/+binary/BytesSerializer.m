@@ -0,0 +1,28 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+classdef BytesSerializer < yardl.binary.TypeSerializer
+    methods (Static)
+        function write(outstream, value)
+            arguments
+                outstream (1,1) yardl.binary.CodedOutputStream
+                value (1,:) {mustBeA(value, "uint8")}
+            end
+            outstream.write_unsigned_varint(length(value));
+            outstream.write_bytes(value);
+        end
+
+        function res = read(instream)
+            len = instream.read_unsigned_varint();
+            res = instream.read_bytes(len);
+        end
+
+        function c = get_class()
+            c = "uint8";
+        end
+
+        function s = get_shape()
+            s = [];
+        end
+    end
+end
@@ -0,0 +1,32 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+% Durations are represented as MATLAB durations and are written as a
+% signed number of nanoseconds.
+classdef DurationSerializer < yardl.binary.TypeSerializer
+    methods (Static)
+        function write(outstream, value)
+            arguments
+                outstream (1,1) yardl.binary.CodedOutputStream
+                value (1,1) duration
+            end
+            outstream.write_signed_varint(yardl.binary.DurationSerializer.to_nanoseconds(value));
+        end
+
+        function res = read(instream)
+            res = yardl.binary.DurationSerializer.from_nanoseconds(instream.read_signed_varint());
+        end
+
+        function c = get_class()
+            c = "duration";
+        end
+
+        function ns = to_nanoseconds(value)
+            ns = int64(round(milliseconds(value) * 1e6));
+        end
+
+        function value = from_nanoseconds(ns)
+            value = milliseconds(double(ns) / 1e6);
+        end
+    end
+end
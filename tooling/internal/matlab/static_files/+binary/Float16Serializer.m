@@ -0,0 +1,87 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+classdef Float16Serializer < yardl.binary.TypeSerializer
+    % MATLAB's half type requires an additional toolbox, so float16 values
+    % are held as single and are rounded to the nearest float16 value, with
+    % ties to even, when they are written.
+
+    methods (Static)
+        function write(outstream, value)
+            arguments
+                outstream (1,1) yardl.binary.CodedOutputStream
+                value (1,1) single
+            end
+
+            bits = yardl.binary.Float16Serializer.to_bits(value);
+            outstream.write_bytes(typecast(bits, "uint8"));
+        end
+
+        function res = read(instream)
+            bytes = instream.read_bytes(2);
+            res = yardl.binary.Float16Serializer.from_bits(typecast(bytes, "uint16"));
+        end
+
+        function c = get_class()
+            c = "single";
+        end
+
+        function trivial = is_trivially_serializable()
+            trivial = true;
+        end
+
+        function bits = to_bits(values)
+            u = typecast(single(values(:)), "uint32");
+            sign = uint16(bitshift(bitand(u, 0x80000000u32), -16));
+            exponent = bitand(bitshift(u, -23), 0xffu32);
+            mantissa = bitand(u, 0x7fffffu32);
+            e = int32(exponent) - 127 + 15;
+
+            result = zeros(size(u), "uint32");
+            normal = e > 0 & e < 31;
+            result(normal) = bitshift(uint32(e(normal)), 10) + ...
+                yardl.binary.Float16Serializer.round_shift(mantissa(normal), 13);
+            subnormal = e <= 0 & e >= -10;
+            result(subnormal) = yardl.binary.Float16Serializer.round_shift( ...
+                bitor(mantissa(subnormal), 0x800000u32), double(14 - e(subnormal)));
+            result(e >= 31) = 0x7c00;
+            result(exponent == 255 & mantissa ~= 0) = 0x7e00;
+
+            bits = reshape(bitor(uint16(result), sign), size(values));
+        end
+
+        function values = from_bits(bits)
+            sign = 1 - 2 * double(bitshift(bits, -15));
+            exponent = double(bitand(bitshift(bits, -10), 0x1fu16));
+            mantissa = double(bitand(bits, 0x3ffu16));
+
+            values = sign .* pow2(1 + mantissa / 1024, exponent - 15);
+            subnormal = exponent == 0;
+            values(subnormal) = sign(subnormal) .* pow2(mantissa(subnormal), -24);
+            infinite = exponent == 31 & mantissa == 0;
+            values(infinite) = sign(infinite) .* Inf;
+            values(exponent == 31 & mantissa ~= 0) = NaN;
+
+            values = single(values);
+        end
+
+        function r = round_shift(v, shift)
+            % Shifts right, rounding to the nearest value with ties to even.
+            r = bitshift(v, -shift);
+            remainder = v - bitshift(r, shift);
+            half = bitshift(ones(size(remainder), "uint32"), shift - 1);
+            up = remainder > half | (remainder == half & bitand(r, 1u32) == 1);
+            r(up) = r(up) + 1;
+        end
+    end
+
+    methods
+        function write_trivially(self, stream, values)
+            stream.write_values_directly(self.to_bits(values), "uint16");
+        end
+
+        function res = read_trivially(self, stream, shape)
+            res = self.from_bits(stream.read_values_directly(shape, "uint16"));
+        end
+    end
+end
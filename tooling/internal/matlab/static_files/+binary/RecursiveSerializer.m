@@ -0,0 +1,41 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+classdef RecursiveSerializer < yardl.binary.TypeSerializer
+    % Creates the serializer of a recursive record on first use, so that
+    % constructing a record serializer does not recurse indefinitely.
+
+    properties
+        classname_;
+        serializer_factory_;
+        serializer_;
+    end
+
+    methods
+        function self = RecursiveSerializer(classname, serializer_factory)
+            self.classname_ = classname;
+            self.serializer_factory_ = serializer_factory;
+        end
+
+        function write(self, outstream, value)
+            self.get_serializer_().write(outstream, value);
+        end
+
+        function res = read(self, instream)
+            res = self.get_serializer_().read(instream);
+        end
+
+        function c = get_class(self)
+            c = self.classname_;
+        end
+    end
+
+    methods (Access=private)
+        function s = get_serializer_(self)
+            if isempty(self.serializer_)
+                self.serializer_ = self.serializer_factory_();
+            end
+            s = self.serializer_;
+        end
+    end
+end
@@ -0,0 +1,38 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+% UUIDs are represented as strings in their canonical form and are
+% written as their 16 bytes.
+classdef UuidSerializer < yardl.binary.TypeSerializer
+    methods (Static)
+        function write(outstream, value)
+            arguments
+                outstream (1,1) yardl.binary.CodedOutputStream
+                value (1,1) {mustBeTextScalar}
+            end
+            outstream.write_bytes(yardl.binary.UuidSerializer.to_bytes(value));
+        end
+
+        function res = read(instream)
+            res = yardl.binary.UuidSerializer.from_bytes(instream.read_bytes(16));
+        end
+
+        function c = get_class()
+            c = "string";
+        end
+
+        function bytes = to_bytes(value)
+            value = char(value);
+            if isempty(regexp(value, '^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$', 'once'))
+                throw(yardl.ValueError("Invalid UUID '%s'", value));
+            end
+            digits = value(value ~= '-');
+            bytes = uint8(hex2dec(reshape(digits, 2, [])'))';
+        end
+
+        function res = from_bytes(bytes)
+            digits = lower(reshape(dec2hex(bytes, 2)', 1, []));
+            res = string([digits(1:8) '-' digits(9:12) '-' digits(13:16) '-' digits(17:20) '-' digits(21:32)]);
+        end
+    end
+end
@@ -0,0 +1,10 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+classdef Bfloat16Converter < yardl.ndjson.NumberConverter
+    methods
+        function self = Bfloat16Converter()
+            self@yardl.ndjson.NumberConverter("single");
+        end
+    end
+end
@@ -0,0 +1,25 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+classdef BoolConverter < yardl.ndjson.JsonConverter
+    methods
+        function json = to_json(~, value)
+            arguments
+                ~
+                value (1,1) {mustBeInRange(value, 0, 1)}
+            end
+            json = logical(value);
+        end
+
+        function res = from_json(~, json)
+            if ~islogical(json) || ~isscalar(json)
+                throw(yardl.TypeError("Expected a JSON boolean"));
+            end
+            res = json;
+        end
+
+        function c = get_class(~)
+            c = "logical";
+        end
+    end
+end
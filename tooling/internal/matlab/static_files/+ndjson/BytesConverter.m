@@ -0,0 +1,29 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+classdef BytesConverter < yardl.ndjson.JsonConverter
+    methods
+        function json = to_json(~, value)
+            arguments
+                ~
+                value (1,:) {mustBeA(value, "uint8")}
+            end
+            json = string(matlab.net.base64encode(value));
+        end
+
+        function res = from_json(~, json)
+            if ~ischar(json) && ~(isstring(json) && isscalar(json))
+                throw(yardl.TypeError("Expected a JSON string"));
+            end
+            res = matlab.net.base64decode(json);
+        end
+
+        function c = get_class(~)
+            c = "uint8";
+        end
+
+        function s = get_shape(~)
+            s = [];
+        end
+    end
+end
@@ -0,0 +1,6 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+function res = CURRENT_NDJSON_FORMAT_VERSION
+    res = int32(1);
+end
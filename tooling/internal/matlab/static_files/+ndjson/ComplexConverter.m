@@ -0,0 +1,36 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+classdef ComplexConverter < yardl.ndjson.JsonConverter
+    % Complex numbers are written as a JSON array of the real and imaginary parts
+    properties (Access=protected)
+        classname_
+    end
+
+    methods
+        function self = ComplexConverter(classname)
+            self.classname_ = classname;
+        end
+
+        function json = to_json(self, value)
+            arguments
+                self
+                value (1,1) {mustBeNumeric}
+            end
+            value = cast(value, self.classname_);
+            json = {real(value), imag(value)};
+        end
+
+        function res = from_json(self, json)
+            parts = yardl.ndjson.json_array_to_cell(json);
+            if length(parts) ~= 2
+                throw(yardl.ValueError("Expected a JSON array of length 2 for a complex number"));
+            end
+            res = complex(cast(parts{1}, self.classname_), cast(parts{2}, self.classname_));
+        end
+
+        function c = get_class(self)
+            c = self.classname_;
+        end
+    end
+end
@@ -0,0 +1,10 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+classdef Complexfloat32Converter < yardl.ndjson.ComplexConverter
+    methods
+        function self = Complexfloat32Converter()
+            self@yardl.ndjson.ComplexConverter("single");
+        end
+    end
+end
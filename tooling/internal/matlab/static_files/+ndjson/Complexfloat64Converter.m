@@ -0,0 +1,10 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+classdef Complexfloat64Converter < yardl.ndjson.ComplexConverter
+    methods
+        function self = Complexfloat64Converter()
+            self@yardl.ndjson.ComplexConverter("double");
+        end
+    end
+end
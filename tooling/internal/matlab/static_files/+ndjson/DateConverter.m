@@ -0,0 +1,29 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+classdef DateConverter < yardl.ndjson.JsonConverter
+    % Dates are written as strings formatted as YYYY-MM-DD
+    methods
+        function json = to_json(~, value)
+            if isa(value, "datetime")
+                value = yardl.Date.from_datetime(value);
+            elseif ~isa(value, "yardl.Date")
+                throw(yardl.TypeError("Expected datetime or yardl.Date, got %s", class(value)));
+            end
+            dt = datetime(1970, 1, 1) + caldays(value.value);
+            json = string(sprintf("%04d-%02d-%02d", year(dt), month(dt), day(dt)));
+        end
+
+        function res = from_json(~, json)
+            parts = sscanf(json, "%d-%d-%d");
+            if length(parts) ~= 3
+                throw(yardl.ValueError("Invalid date format: '%s'", json));
+            end
+            res = yardl.Date.from_components(parts(1), parts(2), parts(3));
+        end
+
+        function c = get_class(~)
+            c = "yardl.Date";
+        end
+    end
+end
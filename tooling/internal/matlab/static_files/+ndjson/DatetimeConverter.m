@@ -0,0 +1,42 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+classdef DatetimeConverter < yardl.ndjson.JsonConverter
+    % DateTimes are written as UTC strings formatted as YYYY-MM-DDTHH:MM:SS.FFFFFFFFF
+    methods
+        function json = to_json(~, value)
+            if isa(value, "datetime")
+                value = yardl.DateTime.from_datetime(value);
+            elseif ~isa(value, "yardl.DateTime")
+                throw(yardl.TypeError("Expected datetime or yardl.DateTime, got %s", class(value)));
+            end
+
+            ns = int64(value.value);
+            ns_per_day = int64(86400e9);
+            days_since_epoch = idivide(ns, ns_per_day, "floor");
+            dt = datetime(1970, 1, 1) + caldays(double(days_since_epoch));
+            json = sprintf("%04d-%02d-%02dT", year(dt), month(dt), day(dt)) + ...
+                yardl.ndjson.TimeConverter.format_time(ns - days_since_epoch * ns_per_day);
+        end
+
+        function res = from_json(~, json)
+            parts = split(erase(string(json), "Z"), "T");
+            if length(parts) ~= 2
+                throw(yardl.ValueError("Invalid datetime format: '%s'", json));
+            end
+
+            date_parts = sscanf(parts(1), "%d-%d-%d");
+            if length(date_parts) ~= 3
+                throw(yardl.ValueError("Invalid datetime format: '%s'", json));
+            end
+
+            days_since_epoch = days(datetime(date_parts(1), date_parts(2), date_parts(3)) - datetime(1970, 1, 1));
+            res = yardl.DateTime(int64(days_since_epoch) * int64(86400e9) + ...
+                yardl.ndjson.TimeConverter.parse_time(parts(2)));
+        end
+
+        function c = get_class(~)
+            c = "yardl.DateTime";
+        end
+    end
+end
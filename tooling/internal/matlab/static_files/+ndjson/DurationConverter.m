@@ -0,0 +1,26 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+classdef DurationConverter < yardl.ndjson.JsonConverter
+    % Durations are written as an integer number of nanoseconds
+    methods
+        function json = to_json(~, value)
+            arguments
+                ~
+                value (1,1) duration
+            end
+            json = yardl.binary.DurationSerializer.to_nanoseconds(value);
+        end
+
+        function res = from_json(~, json)
+            if ~isnumeric(json) || ~isscalar(json)
+                throw(yardl.TypeError("Expected a JSON number"));
+            end
+            res = yardl.binary.DurationSerializer.from_nanoseconds(json);
+        end
+
+        function c = get_class(~)
+            c = "duration";
+        end
+    end
+end
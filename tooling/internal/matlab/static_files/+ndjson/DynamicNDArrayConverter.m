@@ -0,0 +1,39 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+classdef DynamicNDArrayConverter < yardl.ndjson.NDArrayConverterBase
+
+    methods
+        function self = DynamicNDArrayConverter(item_converter)
+            self@yardl.ndjson.NDArrayConverterBase(item_converter);
+        end
+
+        function json = to_json(self, values)
+            item_shape = self.item_converter_.get_shape();
+            shape = size(values);
+            if isempty(item_shape)
+                % values is an array of variable-length vectors or arrays
+                values = values(:);
+            elseif isscalar(item_shape)
+                % values is an array of scalars
+                values = values(:);
+            else
+                % values is an array of fixed-length vectors or arrays
+                item_shape = item_shape(item_shape > 1);
+                outer_shape = shape(length(item_shape) + 1:end);
+                values = reshape(values, [item_shape prod(outer_shape)]);
+                shape = outer_shape;
+            end
+
+            json = yardl.ndjson.json_object();
+            json('shape') = self.shape_to_json_(shape);
+            json('data') = self.data_to_json_(values);
+        end
+
+        function value = from_json(self, json)
+            self.check_object_(json);
+            shape = self.shape_from_json_(json('shape'));
+            value = self.data_from_json_(json('data'), shape);
+        end
+    end
+end
@@ -0,0 +1,49 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+classdef EnumConverter < yardl.ndjson.JsonConverter
+    % Enums are written as their symbol, or as their integer value if it
+    % is not one of the defined values
+    properties
+        classname_;
+        constructor_;
+        integer_converter_;
+        symbols_;
+        values_;
+    end
+
+    methods
+        function self = EnumConverter(classname, classconstructor, integer_converter, symbols, values)
+            self.classname_ = classname;
+            self.constructor_ = classconstructor;
+            self.integer_converter_ = integer_converter;
+            self.symbols_ = symbols;
+            self.values_ = values;
+        end
+
+        function json = to_json(self, value)
+            idx = find(self.values_ == value, 1);
+            if isempty(idx)
+                json = self.integer_converter_.to_json(value);
+            else
+                json = self.symbols_(idx);
+            end
+        end
+
+        function res = from_json(self, json)
+            if ischar(json) || isstring(json)
+                idx = find(self.symbols_ == json, 1);
+                if isempty(idx)
+                    throw(yardl.ValueError("Unknown symbol '%s' for enum %s", json, self.classname_));
+                end
+                res = self.values_(idx);
+            else
+                res = self.constructor_(self.integer_converter_.from_json(json));
+            end
+        end
+
+        function c = get_class(self)
+            c = self.classname_;
+        end
+    end
+end
@@ -0,0 +1,61 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+classdef FixedNDArrayConverter < yardl.ndjson.NDArrayConverterBase
+    % Fixed arrays are written as a flat JSON array in row-major order
+
+    properties
+        shape_
+    end
+
+    methods
+        function self = FixedNDArrayConverter(item_converter, shape)
+            self@yardl.ndjson.NDArrayConverterBase(item_converter);
+            self.shape_ = shape;
+        end
+
+        function json = to_json(self, values)
+            if numel(values) == prod(self.shape_)
+                % This is an NDArray of scalars
+                json = self.data_to_json_(values(:));
+                return;
+            end
+
+            sz = size(values);
+            if length(sz) < length(self.shape_)
+                expected = sprintf("%d ", self.shape_);
+                actual = sprintf("%d ", sz);
+                throw(yardl.ValueError("Expected shape [%s], got [%s]", expected, actual));
+            end
+
+            fixedSize = sz(end-length(self.shape_)+1:end);
+            if fixedSize ~= self.shape_
+                expected = sprintf("%d ", self.shape_);
+                actual = sprintf("%d ", fixedSize);
+                throw(yardl.ValueError("Expected shape [%s], got [%s]", expected, actual));
+            end
+
+            inner_shape = sz(1:end-length(self.shape_));
+            values = reshape(values, [inner_shape prod(self.shape_)]);
+
+            json = self.data_to_json_(values);
+        end
+
+        function value = from_json(self, json)
+            value = self.data_from_json_(json, self.shape_);
+        end
+
+        function s = get_shape(self)
+            item_shape = self.item_converter_.get_shape();
+            if isempty(item_shape)
+                s = self.shape_;
+            else
+                s = [item_shape self.shape_];
+            end
+
+            if length(s) > 2
+                s = s(s>1);
+            end
+        end
+    end
+end
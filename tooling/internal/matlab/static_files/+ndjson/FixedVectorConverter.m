@@ -0,0 +1,35 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+classdef FixedVectorConverter < yardl.ndjson.VectorConverterBase
+    properties
+        length_;
+    end
+
+    methods (Access=protected)
+        function check_count_(self, count)
+            if count ~= self.length_
+                throw(yardl.ValueError("Expected an array of length %d, got %d", self.length_, count));
+            end
+        end
+    end
+
+    methods
+        function self = FixedVectorConverter(item_converter, length)
+            self@yardl.ndjson.VectorConverterBase(item_converter);
+            self.length_ = length;
+        end
+
+        function s = get_shape(self)
+            item_shape = self.item_converter_.get_shape();
+            if isempty(item_shape)
+                s = [1, self.length_];
+            elseif isscalar(item_shape)
+                s = [item_shape self.length_];
+            else
+                s = [item_shape self.length_];
+                s = s(s>1);
+            end
+        end
+    end
+end
@@ -0,0 +1,74 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+classdef FlagsConverter < yardl.ndjson.JsonConverter
+    % Flags are written as an array of the symbols that are set, or as their
+    % integer value if it cannot be represented by the defined values
+    properties
+        classname_;
+        constructor_;
+        integer_converter_;
+        symbols_;
+        values_;
+    end
+
+    methods
+        function self = FlagsConverter(classname, classconstructor, integer_converter, symbols, values)
+            self.classname_ = classname;
+            self.constructor_ = classconstructor;
+            self.integer_converter_ = integer_converter;
+            self.symbols_ = symbols;
+            self.values_ = cast(values, integer_converter.get_class());
+        end
+
+        function json = to_json(self, value)
+            remaining = cast(value, self.integer_converter_.get_class());
+            if remaining == 0
+                idx = find(self.values_ == 0, 1);
+                json = cellstr(self.symbols_(idx));
+                return
+            end
+
+            json = {};
+            for i = 1:length(self.values_)
+                flag = self.values_(i);
+                if flag == 0
+                    continue
+                end
+                if bitand(remaining, flag) == flag
+                    json{end+1} = self.symbols_(i);
+                    remaining = bitand(remaining, bitcmp(flag));
+                    if remaining == 0
+                        break
+                    end
+                end
+            end
+
+            if remaining ~= 0
+                json = self.integer_converter_.to_json(value);
+            end
+        end
+
+        function res = from_json(self, json)
+            if isnumeric(json) && isscalar(json)
+                res = self.constructor_(self.integer_converter_.from_json(json));
+                return
+            end
+
+            value = cast(0, self.integer_converter_.get_class());
+            names = yardl.ndjson.json_array_to_cell(json);
+            for i = 1:length(names)
+                idx = find(self.symbols_ == names{i}, 1);
+                if isempty(idx)
+                    throw(yardl.ValueError("Unknown symbol '%s' for flags %s", names{i}, self.classname_));
+                end
+                value = bitor(value, self.values_(idx));
+            end
+            res = self.constructor_(value);
+        end
+
+        function c = get_class(self)
+            c = self.classname_;
+        end
+    end
+end
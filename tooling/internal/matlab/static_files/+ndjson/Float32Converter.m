@@ -0,0 +1,10 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+classdef Float32Converter < yardl.ndjson.NumberConverter
+    methods
+        function self = Float32Converter()
+            self@yardl.ndjson.NumberConverter("single");
+        end
+    end
+end
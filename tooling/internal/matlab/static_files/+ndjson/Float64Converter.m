@@ -0,0 +1,10 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+classdef Float64Converter < yardl.ndjson.NumberConverter
+    methods
+        function self = Float64Converter()
+            self@yardl.ndjson.NumberConverter("double");
+        end
+    end
+end
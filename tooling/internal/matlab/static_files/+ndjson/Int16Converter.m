@@ -0,0 +1,10 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+classdef Int16Converter < yardl.ndjson.NumberConverter
+    methods
+        function self = Int16Converter()
+            self@yardl.ndjson.NumberConverter("int16");
+        end
+    end
+end
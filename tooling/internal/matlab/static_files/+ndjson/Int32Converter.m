@@ -0,0 +1,10 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+classdef Int32Converter < yardl.ndjson.NumberConverter
+    methods
+        function self = Int32Converter()
+            self@yardl.ndjson.NumberConverter("int32");
+        end
+    end
+end
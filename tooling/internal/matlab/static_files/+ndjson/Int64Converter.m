@@ -0,0 +1,10 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+classdef Int64Converter < yardl.ndjson.NumberConverter
+    methods
+        function self = Int64Converter()
+            self@yardl.ndjson.NumberConverter("int64");
+        end
+    end
+end
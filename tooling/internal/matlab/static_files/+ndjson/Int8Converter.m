@@ -0,0 +1,10 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+classdef Int8Converter < yardl.ndjson.NumberConverter
+    methods
+        function self = Int8Converter()
+            self@yardl.ndjson.NumberConverter("int8");
+        end
+    end
+end
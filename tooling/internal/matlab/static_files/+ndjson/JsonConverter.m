@@ -0,0 +1,23 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+classdef JsonConverter < handle
+    % Converts between yardl values and the MATLAB values given to
+    % yardl.ndjson.json_encode and returned by yardl.ndjson.json_decode.
+
+    methods (Abstract)
+        json = to_json(self, value)
+        res = from_json(self, json)
+        c = get_class(self)
+    end
+
+    methods
+        function s = get_shape(~)
+            s = 1;
+        end
+
+        function res = supports_none(~)
+            res = false;
+        end
+    end
+end
@@ -0,0 +1,73 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+classdef MapConverter < yardl.ndjson.JsonConverter
+    % Maps with string keys are written as JSON objects, and other maps as
+    % an array of [key, value] arrays.
+    properties
+        key_converter_;
+        value_converter_;
+    end
+
+    methods
+        function self = MapConverter(key_converter, value_converter)
+            self.key_converter_ = key_converter;
+            self.value_converter_ = value_converter;
+        end
+
+        function json = to_json(self, value)
+            arguments
+                self (1,1)
+                value (1,1) yardl.Map
+            end
+
+            count = numEntries(value);
+            if count > 0
+                ks = keys(value);
+                vs = values(value);
+            end
+
+            if isa(self.key_converter_, "yardl.ndjson.StringConverter")
+                json = yardl.ndjson.json_object();
+                for i = 1:count
+                    json(char(self.key_converter_.to_json(ks(i)))) = self.value_converter_.to_json(vs(i));
+                end
+                return
+            end
+
+            json = cell(1, count);
+            for i = 1:count
+                json{i} = {self.key_converter_.to_json(ks(i)), self.value_converter_.to_json(vs(i))};
+            end
+        end
+
+        function res = from_json(self, json)
+            res = yardl.Map();
+
+            if isa(self.key_converter_, "yardl.ndjson.StringConverter")
+                if ~yardl.ndjson.is_json_object(json)
+                    throw(yardl.TypeError("Expected a JSON object for a map with string keys"));
+                end
+                ks = keys(json);
+                vs = values(json);
+                for i = 1:length(ks)
+                    insert(res, string(ks{i}), self.value_converter_.from_json(vs{i}));
+                end
+                return
+            end
+
+            entries = yardl.ndjson.json_array_to_cell(json);
+            for i = 1:length(entries)
+                entry = yardl.ndjson.json_array_to_cell(entries{i});
+                if length(entry) ~= 2
+                    throw(yardl.ValueError("Expected a [key, value] JSON array for a map entry"));
+                end
+                insert(res, self.key_converter_.from_json(entry{1}), self.value_converter_.from_json(entry{2}));
+            end
+        end
+
+        function c = get_class(~)
+            c = "yardl.Map";
+        end
+    end
+end
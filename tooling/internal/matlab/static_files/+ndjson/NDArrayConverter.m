@@ -0,0 +1,48 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+classdef NDArrayConverter < yardl.ndjson.NDArrayConverterBase
+
+    properties
+        ndims_
+    end
+
+    methods
+        function self = NDArrayConverter(item_converter, ndims)
+            self@yardl.ndjson.NDArrayConverterBase(item_converter);
+            self.ndims_ = ndims;
+        end
+
+        function json = to_json(self, values)
+            sz = size(values);
+            if ndims(values) < self.ndims_
+                sz = [sz ones(1, self.ndims_-ndims(values))];
+            end
+
+            json = yardl.ndjson.json_object();
+            if length(sz) == self.ndims_
+                % This is an NDArray of scalars
+                json('shape') = self.shape_to_json_(sz);
+                json('data') = self.data_to_json_(values(:));
+                return
+            end
+
+            % This is an NDArray of vectors/arrays
+            inner_shape = sz(1:end-self.ndims_);
+            outer_shape = sz(end-self.ndims_+1:end);
+            values = reshape(values, [inner_shape prod(outer_shape)]);
+
+            json('shape') = self.shape_to_json_(outer_shape);
+            json('data') = self.data_to_json_(values);
+        end
+
+        function value = from_json(self, json)
+            self.check_object_(json);
+            shape = self.shape_from_json_(json('shape'));
+            if length(shape) ~= self.ndims_
+                throw(yardl.ValueError("Expected %d dimensions, got %d", self.ndims_, length(shape)));
+            end
+            value = self.data_from_json_(json('data'), shape);
+        end
+    end
+end
@@ -0,0 +1,120 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+classdef NDArrayConverterBase < yardl.ndjson.JsonConverter
+    % MATLAB arrays are column-major, so the MATLAB shape of an array is the
+    % reverse of its yardl shape and the data written in row-major order is
+    % the MATLAB data in storage order. The shapes of values accepted and
+    % returned match yardl.binary.NDArraySerializerBase
+
+    properties
+        item_converter_
+    end
+
+    methods (Access=protected)
+        function self = NDArrayConverterBase(item_converter)
+            self.item_converter_ = item_converter;
+        end
+
+        function json = data_to_json_(self, values)
+            % values is an array of shape [A, B, ..., N], where
+            % N is the "flattened" dimension of the NDArray, and
+            % A, B, ... are the dimensions of the inner items.
+
+            sz = size(values);
+
+            if ndims(values) > 2
+                count = sz(end);
+                inner_shape = sz(1:end-1);
+                r = reshape(values, [], count);
+                json = cell(1, count);
+                for i = 1:count
+                    val = reshape(r(:, i), inner_shape);
+                    json{i} = self.item_converter_.to_json(val);
+                end
+            elseif isrow(values) || iscolumn(values)
+                count = prod(sz);
+                json = cell(1, count);
+                if iscell(values)
+                    for i = 1:count
+                        json{i} = self.item_converter_.to_json(values{i});
+                    end
+                else
+                    for i = 1:count
+                        json{i} = self.item_converter_.to_json(values(i));
+                    end
+                end
+            else
+                count = sz(end);
+                json = cell(1, count);
+                for i = 1:count
+                    json{i} = self.item_converter_.to_json(values(:, i));
+                end
+            end
+        end
+
+        function res = data_from_json_(self, json, shape)
+            items = yardl.ndjson.json_array_to_cell(json);
+            flat_length = prod(shape);
+            if length(items) ~= flat_length
+                throw(yardl.ValueError("Expected %d array elements, got %d", flat_length, length(items)));
+            end
+
+            item_shape = self.item_converter_.get_shape();
+
+            if isempty(item_shape)
+                res = cell(shape);
+                for i = 1:flat_length
+                    res{i} = self.item_converter_.from_json(items{i});
+                end
+                return
+            end
+
+            res = yardl.allocate(self.get_class(), [prod(item_shape), flat_length]);
+            for i = 1:flat_length
+                item = self.item_converter_.from_json(items{i});
+                res(:, i) = item(:);
+            end
+
+            % Tricky reshaping to remove unnecessary singleton dimensions in
+            %   subarrays, arrays of fixed vectors, etc.
+            item_shape = item_shape(item_shape > 1);
+            if isempty(item_shape) && isscalar(shape)
+                item_shape = 1;
+            end
+            newshape = [item_shape shape];
+            res = reshape(res, newshape);
+
+            if iscolumn(res)
+                res = transpose(res);
+            end
+        end
+    end
+
+    methods (Static, Access=protected)
+        function json = shape_to_json_(shape)
+            json = num2cell(flip(shape));
+        end
+
+        function shape = shape_from_json_(json)
+            dims = yardl.ndjson.json_array_to_cell(json);
+            shape = flip(cellfun(@double, dims));
+        end
+
+        function check_object_(json)
+            if ~yardl.ndjson.is_json_object(json) || ~all(isKey(json, {'shape', 'data'}))
+                throw(yardl.TypeError("Expected a JSON object with shape and data fields"));
+            end
+        end
+    end
+
+    methods
+        function c = get_class(self)
+            c = self.item_converter_.get_class();
+        end
+
+        function s = get_shape(~)
+            s = [];
+        end
+    end
+end
@@ -0,0 +1,91 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+classdef NDJsonProtocolReader < handle
+
+    properties (Access=protected)
+        fid_
+        owns_stream_
+        unused_
+        has_unused_
+    end
+
+    methods
+        function self = NDJsonProtocolReader(infile, expected_schema)
+            if isa(infile, "string") || isa(infile, "char")
+                [fileId, errMsg] = fopen(infile, "r", "n", "UTF-8");
+                if fileId < 0
+                    throw(yardl.RuntimeError(errMsg));
+                end
+                self.fid_ = fileId;
+                self.owns_stream_ = true;
+            else
+                self.fid_ = infile;
+                self.owns_stream_ = false;
+            end
+
+            self.has_unused_ = false;
+
+            line = fgetl(self.fid_);
+            try
+                header = jsondecode(line);
+            catch
+                throw(yardl.ProtocolError("Data in the stream is not in the expected Yardl NDJSON format."));
+            end
+
+            if ~isstruct(header) || ~isfield(header, "yardl") || ~isstruct(header.yardl)
+                throw(yardl.ProtocolError("Data in the stream is not in the expected Yardl NDJSON format."));
+            end
+
+            if ~isfield(header.yardl, "version") || header.yardl.version ~= yardl.ndjson.CURRENT_NDJSON_FORMAT_VERSION
+                throw(yardl.ProtocolError("Unsupported yardl version."));
+            end
+
+            if ~isempty(expected_schema) && ...
+                    (~isfield(header.yardl, "schema") || ~isequal(header.yardl.schema, jsondecode(expected_schema)))
+                throw(yardl.ProtocolError("The schema of the data to be read is not compatible with the current protocol."));
+            end
+        end
+    end
+
+    methods (Access=protected)
+        function close_(self)
+            if self.owns_stream_ && self.fid_ > 2
+                fclose(self.fid_);
+                self.fid_ = -1;
+            end
+        end
+
+        function more = has_json_line_(self, step_name)
+            % Returns whether the next line holds a value for the given step,
+            % keeping the line for a subsequent call to read_json_line_
+            if ~self.has_unused_
+                line = fgetl(self.fid_);
+                if ~ischar(line)
+                    more = false;
+                    return;
+                end
+                self.unused_ = yardl.ndjson.json_decode(line);
+                if ~yardl.ndjson.is_json_object(self.unused_)
+                    throw(yardl.ProtocolError("Expected a JSON object for a protocol step."));
+                end
+                self.has_unused_ = true;
+            end
+
+            more = isKey(self.unused_, char(step_name));
+        end
+
+        function json = read_json_line_(self, step_name)
+            if ~self.has_json_line_(step_name)
+                if ~self.has_unused_
+                    throw(yardl.ProtocolError("Encountered EOF but expected to find protocol step '%s'.", step_name));
+                end
+                throw(yardl.ProtocolError("Expected protocol step '%s' not found.", step_name));
+            end
+
+            json = self.unused_(char(step_name));
+            self.unused_ = [];
+            self.has_unused_ = false;
+        end
+    end
+end
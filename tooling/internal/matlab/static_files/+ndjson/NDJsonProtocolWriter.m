@@ -0,0 +1,81 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+classdef NDJsonProtocolWriter < handle
+
+    properties (Access=protected)
+        fid_
+        owns_stream_
+    end
+
+    methods
+        function self = NDJsonProtocolWriter(outfile, schema)
+            if isa(outfile, "string") || isa(outfile, "char")
+                [fileId, errMsg] = fopen(outfile, "w", "n", "UTF-8");
+                if fileId < 0
+                    throw(yardl.RuntimeError(errMsg));
+                end
+                self.fid_ = fileId;
+                self.owns_stream_ = true;
+            else
+                self.fid_ = outfile;
+                self.owns_stream_ = false;
+            end
+
+            fprintf(self.fid_, '{"yardl":{"version":%d,"schema":%s}}\n', ...
+                yardl.ndjson.CURRENT_NDJSON_FORMAT_VERSION, schema);
+        end
+    end
+
+    methods (Access=protected)
+        function end_stream_(~)
+        end
+
+        function close_(self)
+            if self.owns_stream_ && self.fid_ > 2
+                fclose(self.fid_);
+                self.fid_ = -1;
+            end
+        end
+
+        function write_json_line_(self, step_name, json)
+            fprintf(self.fid_, '{"%s":%s}\n', step_name, yardl.ndjson.json_encode(json));
+        end
+
+        function write_json_stream_(self, step_name, item_converter, values)
+            % Writes each item of a stream on its own line.
+            % The shapes of values accepted match yardl.binary.StreamSerializer
+            if isempty(values)
+                return;
+            end
+
+            if iscolumn(values)
+                values = transpose(values);
+            end
+            s = size(values);
+            count = s(end);
+
+            if iscell(values)
+                if ~isvector(s)
+                    throw(yardl.ValueError("cell array must be a vector"));
+                end
+                for i = 1:count
+                    self.write_json_line_(step_name, item_converter.to_json(values{i}));
+                end
+            else
+                if ndims(values) > 2
+                    r = reshape(values, [], count);
+                    inner_shape = s(1:end-1);
+                    for i = 1:count
+                        val = reshape(r(:, i), inner_shape);
+                        self.write_json_line_(step_name, item_converter.to_json(val));
+                    end
+                else
+                    for i = 1:count
+                        self.write_json_line_(step_name, item_converter.to_json(transpose(values(:, i))));
+                    end
+                end
+            end
+        end
+    end
+end
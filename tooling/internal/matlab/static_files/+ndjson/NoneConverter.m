@@ -0,0 +1,22 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+classdef NoneConverter < yardl.ndjson.JsonConverter
+    methods
+        function json = to_json(~, ~)
+            json = yardl.ndjson.json_null;
+        end
+
+        function res = from_json(~, ~)
+            res = yardl.None;
+        end
+
+        function c = get_class(~)
+            c = "yardl.Optional";
+        end
+
+        function res = supports_none(~)
+            res = true;
+        end
+    end
+end
@@ -0,0 +1,45 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+classdef NumberConverter < yardl.ndjson.JsonConverter
+    properties (Access=protected)
+        classname_
+    end
+
+    methods
+        function self = NumberConverter(classname)
+            self.classname_ = classname;
+        end
+
+        function json = to_json(self, value)
+            arguments
+                self
+                value (1,1) {mustBeNumeric}
+            end
+            json = cast(value, self.classname_);
+        end
+
+        function res = from_json(self, json)
+            if ~isnumeric(json) || ~isscalar(json)
+                throw(yardl.TypeError("Expected a JSON number"));
+            end
+            res = cast(json, self.classname_);
+            if isinteger(res)
+                if isinteger(json)
+                    exact = cast(res, class(json)) == json;
+                else
+                    exact = json == fix(json) && ...
+                        json >= double(intmin(char(self.classname_))) && ...
+                        json < double(intmax(char(self.classname_))) + 1;
+                end
+                if ~exact
+                    throw(yardl.ValueError("JSON number cannot be represented exactly as %s", self.classname_));
+                end
+            end
+        end
+
+        function c = get_class(self)
+            c = self.classname_;
+        end
+    end
+end
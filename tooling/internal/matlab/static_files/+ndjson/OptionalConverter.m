@@ -0,0 +1,43 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+classdef OptionalConverter < yardl.ndjson.JsonConverter
+    properties
+        item_converter_;
+    end
+
+    methods
+        function self = OptionalConverter(item_converter)
+            self.item_converter_ = item_converter;
+        end
+
+        function json = to_json(self, value)
+            if isa(value, "yardl.Optional")
+                if value.has_value()
+                    json = self.item_converter_.to_json(value.value());
+                else
+                    json = yardl.ndjson.json_null;
+                end
+            else
+                json = self.item_converter_.to_json(value);
+            end
+        end
+
+        function res = from_json(self, json)
+            % Returns either yardl.None or the inner optional value
+            if yardl.ndjson.is_json_null(json)
+                res = yardl.None;
+            else
+                res = self.item_converter_.from_json(json);
+            end
+        end
+
+        function c = get_class(~)
+            c = "yardl.Optional";
+        end
+
+        function res = supports_none(~)
+            res = true;
+        end
+    end
+end
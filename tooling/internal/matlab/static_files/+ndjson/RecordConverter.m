@@ -0,0 +1,57 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+classdef RecordConverter < yardl.ndjson.JsonConverter
+    % Records are written as JSON objects. Fields whose value is None are omitted.
+
+    properties
+        field_names
+        field_converters
+        classname
+    end
+
+    methods
+        function self = RecordConverter(classname, field_names, field_converters)
+            self.classname = classname;
+            self.field_names = field_names;
+            self.field_converters = field_converters;
+        end
+
+        function c = get_class(self)
+            c = self.classname;
+        end
+    end
+
+    methods (Access=protected)
+        function json = to_json_(self, varargin)
+            json = yardl.ndjson.json_object();
+            for i = 1:nargin-1
+                fc = self.field_converters{i};
+                field_value = varargin{i};
+                if fc.supports_none() && isa(field_value, "yardl.Optional") && ~field_value.has_value
+                    continue
+                end
+                json(char(self.field_names(i))) = fc.to_json(field_value);
+            end
+        end
+
+        function res = from_json_(self, json)
+            if ~yardl.ndjson.is_json_object(json)
+                throw(yardl.TypeError("Expected a JSON object for %s", self.classname));
+            end
+
+            res = cell(size(self.field_converters));
+            for i = 1:length(self.field_converters)
+                fc = self.field_converters{i};
+                name = char(self.field_names(i));
+                if isKey(json, name)
+                    res{i} = fc.from_json(json(name));
+                elseif fc.supports_none()
+                    res{i} = yardl.None;
+                else
+                    throw(yardl.ValueError("Missing field '%s' for %s", name, self.classname));
+                end
+            end
+        end
+    end
+end
@@ -0,0 +1,41 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+classdef RecursiveConverter < yardl.ndjson.JsonConverter
+    % Creates the converter of a recursive record on first use, so that
+    % constructing a record converter does not recurse indefinitely.
+
+    properties
+        classname_;
+        converter_factory_;
+        converter_;
+    end
+
+    methods
+        function self = RecursiveConverter(classname, converter_factory)
+            self.classname_ = classname;
+            self.converter_factory_ = converter_factory;
+        end
+
+        function json = to_json(self, value)
+            json = self.get_converter_().to_json(value);
+        end
+
+        function res = from_json(self, json)
+            res = self.get_converter_().from_json(json);
+        end
+
+        function c = get_class(self)
+            c = self.classname_;
+        end
+    end
+
+    methods (Access=private)
+        function c = get_converter_(self)
+            if isempty(self.converter_)
+                self.converter_ = self.converter_factory_();
+            end
+            c = self.converter_;
+        end
+    end
+end
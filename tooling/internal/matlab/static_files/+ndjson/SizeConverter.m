@@ -0,0 +1,10 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+classdef SizeConverter < yardl.ndjson.NumberConverter
+    methods
+        function self = SizeConverter()
+            self@yardl.ndjson.NumberConverter("uint64");
+        end
+    end
+end
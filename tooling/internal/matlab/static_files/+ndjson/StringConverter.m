@@ -0,0 +1,25 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+classdef StringConverter < yardl.ndjson.JsonConverter
+    methods
+        function json = to_json(~, value)
+            arguments
+                ~
+                value (1,1) {mustBeTextScalar}
+            end
+            json = string(value);
+        end
+
+        function res = from_json(~, json)
+            if ~ischar(json) && ~(isstring(json) && isscalar(json))
+                throw(yardl.TypeError("Expected a JSON string"));
+            end
+            res = convertCharsToStrings(json);
+        end
+
+        function c = get_class(~)
+            c = "string";
+        end
+    end
+end
@@ -0,0 +1,57 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+classdef TimeConverter < yardl.ndjson.JsonConverter
+    % Times are written as strings formatted as HH:MM:SS.FFFFFFFFF
+    methods
+        function json = to_json(~, value)
+            if isa(value, "datetime")
+                value = yardl.Time.from_datetime(value);
+            elseif ~isa(value, "yardl.Time")
+                throw(yardl.TypeError("Expected datetime or yardl.Time, got %s", class(value)));
+            end
+            json = yardl.ndjson.TimeConverter.format_time(int64(value.value));
+        end
+
+        function res = from_json(~, json)
+            res = yardl.Time(yardl.ndjson.TimeConverter.parse_time(json));
+        end
+
+        function c = get_class(~)
+            c = "yardl.Time";
+        end
+    end
+
+    methods (Static)
+        function s = format_time(nanoseconds_since_midnight)
+            ns = int64(nanoseconds_since_midnight);
+            hours = idivide(ns, int64(3600e9));
+            ns = ns - hours * int64(3600e9);
+            minutes = idivide(ns, int64(60e9));
+            ns = ns - minutes * int64(60e9);
+            seconds = idivide(ns, int64(1e9));
+            ns = ns - seconds * int64(1e9);
+            s = string(sprintf("%02d:%02d:%02d.%09d", hours, minutes, seconds, ns));
+        end
+
+        function ns = parse_time(s)
+            components = split(string(s), ":");
+            if length(components) < 2 || length(components) > 3
+                throw(yardl.ValueError("Invalid time format: '%s'", s));
+            end
+
+            ns = int64(str2double(components(1))) * int64(3600e9) + ...
+                int64(str2double(components(2))) * int64(60e9);
+
+            if length(components) == 3
+                second_components = split(components(3), ".");
+                ns = ns + int64(str2double(second_components(1))) * int64(1e9);
+                if length(second_components) == 2
+                    fraction = char(second_components(2));
+                    fraction = pad(fraction(1:min(end, 9)), 9, "right", "0");
+                    ns = ns + int64(str2double(fraction));
+                end
+            end
+        end
+    end
+end
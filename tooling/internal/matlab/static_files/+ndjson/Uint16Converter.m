@@ -0,0 +1,10 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+classdef Uint16Converter < yardl.ndjson.NumberConverter
+    methods
+        function self = Uint16Converter()
+            self@yardl.ndjson.NumberConverter("uint16");
+        end
+    end
+end
@@ -0,0 +1,10 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+classdef Uint32Converter < yardl.ndjson.NumberConverter
+    methods
+        function self = Uint32Converter()
+            self@yardl.ndjson.NumberConverter("uint32");
+        end
+    end
+end
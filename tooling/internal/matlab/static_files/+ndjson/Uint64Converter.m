@@ -0,0 +1,10 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+classdef Uint64Converter < yardl.ndjson.NumberConverter
+    methods
+        function self = Uint64Converter()
+            self@yardl.ndjson.NumberConverter("uint64");
+        end
+    end
+end
@@ -0,0 +1,10 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+classdef Uint8Converter < yardl.ndjson.NumberConverter
+    methods
+        function self = Uint8Converter()
+            self@yardl.ndjson.NumberConverter("uint8");
+        end
+    end
+end
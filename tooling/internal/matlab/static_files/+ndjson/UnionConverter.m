@@ -0,0 +1,114 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+classdef UnionConverter < yardl.ndjson.JsonConverter
+    % Unions are written as the value of the case if each case has a distinct
+    % JSON data type (simple), and otherwise as an object with a single field
+    % named after the tag of the case
+
+    properties (Access=protected)
+        classname_
+        case_converters_
+        case_factories_
+        case_tags_
+        case_json_types_
+        simple_
+        offset_
+    end
+
+    methods
+        function self = UnionConverter(union_class, case_converters, case_factories, case_tags, case_json_types, simple)
+            self.classname_ = union_class;
+            self.case_converters_ = case_converters;
+            self.case_factories_ = case_factories;
+            self.case_tags_ = case_tags;
+            self.case_json_types_ = case_json_types;
+            self.simple_ = simple;
+
+            if isa(case_converters{1}, "yardl.ndjson.NoneConverter")
+                self.offset_ = 1;
+            else
+                self.offset_ = 0;
+            end
+        end
+
+        function json = to_json(self, value)
+            if isa(value, "yardl.Optional")
+                if self.offset_ == 0
+                    throw(yardl.TypeError("Optional is not valid for this union type"))
+                end
+
+                if value.has_value()
+                    value = value.value;
+                else
+                    json = yardl.ndjson.json_null;
+                    return;
+                end
+            end
+
+            if ~isa(value, self.classname_)
+                throw(yardl.TypeError("Expected union value of type %s, got %s", self.classname_, class(value)))
+            end
+
+            case_index = value.index + self.offset_;
+            inner_json = self.case_converters_{case_index}.to_json(value.value);
+            if self.simple_
+                json = inner_json;
+            else
+                json = yardl.ndjson.json_object();
+                json(char(self.case_tags_(case_index))) = inner_json;
+            end
+        end
+
+        function res = from_json(self, json)
+            if yardl.ndjson.is_json_null(json) && self.offset_ == 1
+                res = yardl.None;
+                return
+            end
+
+            if self.simple_
+                json_type = yardl.ndjson.json_data_type(json);
+                case_index = 0;
+                for i = 1+self.offset_:length(self.case_json_types_)
+                    if any(self.case_json_types_{i} == json_type)
+                        case_index = i;
+                        break
+                    end
+                end
+                if case_index == 0
+                    throw(yardl.ValueError("JSON %s does not match any case of union %s", json_type, self.classname_));
+                end
+                inner_json = json;
+            else
+                if ~yardl.ndjson.is_json_object(json)
+                    throw(yardl.TypeError("Expected a JSON object for union %s", self.classname_));
+                end
+                tags = keys(json);
+                if length(tags) ~= 1
+                    throw(yardl.ValueError("Expected a JSON object with a single field for union %s", self.classname_));
+                end
+                case_index = find(self.case_tags_ == tags{1}, 1);
+                if isempty(case_index)
+                    throw(yardl.ValueError("Unknown tag '%s' for union %s", tags{1}, self.classname_));
+                end
+                inner_json = json(tags{1});
+            end
+
+            value = self.case_converters_{case_index}.from_json(inner_json);
+            factory = self.case_factories_{case_index};
+            res = factory(value);
+        end
+
+        function c = get_class(self)
+            if self.offset_ == 1
+                c = "yardl.Optional";
+            else
+                c = self.classname_;
+            end
+        end
+
+        function res = supports_none(self)
+            res = self.offset_ == 1;
+        end
+    end
+end
@@ -0,0 +1,25 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+classdef UuidConverter < yardl.ndjson.JsonConverter
+    methods
+        function json = to_json(~, value)
+            arguments
+                ~
+                value (1,1) {mustBeTextScalar}
+            end
+            json = yardl.binary.UuidSerializer.from_bytes(yardl.binary.UuidSerializer.to_bytes(value));
+        end
+
+        function res = from_json(~, json)
+            if ~ischar(json) && ~(isstring(json) && isscalar(json))
+                throw(yardl.TypeError("Expected a JSON string"));
+            end
+            res = yardl.binary.UuidSerializer.from_bytes(yardl.binary.UuidSerializer.to_bytes(json));
+        end
+
+        function c = get_class(~)
+            c = "string";
+        end
+    end
+end
@@ -0,0 +1,20 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+classdef VectorConverter < yardl.ndjson.VectorConverterBase
+
+    methods (Access=protected)
+        function check_count_(~, ~)
+        end
+    end
+
+    methods
+        function self = VectorConverter(item_converter)
+            self@yardl.ndjson.VectorConverterBase(item_converter);
+        end
+
+        function s = get_shape(~)
+            s = [];
+        end
+    end
+end
@@ -0,0 +1,90 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+classdef VectorConverterBase < yardl.ndjson.JsonConverter
+    % Vectors are written as JSON arrays. The shapes of values accepted and
+    % returned match yardl.binary.VectorSerializerBase
+    properties
+        item_converter_;
+    end
+
+    methods (Abstract, Access=protected)
+        check_count_(self, count)
+    end
+
+    methods (Access=protected)
+        function self = VectorConverterBase(item_converter)
+            self.item_converter_ = item_converter;
+        end
+    end
+
+    methods
+        function json = to_json(self, values)
+            if iscolumn(values)
+                values = transpose(values);
+            end
+            s = size(values);
+            count = s(end);
+
+            self.check_count_(count);
+
+            json = cell(1, count);
+            if iscell(values)
+                % values is a cell array, so must be a vector of shape [1, COUNT]
+                if ~isvector(s)
+                    throw(yardl.ValueError("cell array must be a vector"));
+                end
+                for i = 1:count
+                    json{i} = self.item_converter_.to_json(values{i});
+                end
+            else
+                % values is an array, so must have shape [A, B, ..., COUNT]
+                if ndims(values) > 2
+                    r = reshape(values, [], count);
+                    for i = 1:count
+                        val = reshape(r(:, i), s(1:end-1));
+                        json{i} = self.item_converter_.to_json(val);
+                    end
+                else
+                    for i = 1:count
+                        json{i} = self.item_converter_.to_json(transpose(values(:, i)));
+                    end
+                end
+            end
+        end
+
+        function res = from_json(self, json)
+            items = yardl.ndjson.json_array_to_cell(json);
+            count = length(items);
+            self.check_count_(count);
+            if count == 0
+                res = yardl.allocate(self.get_class(), 0);
+                return;
+            end
+
+            item_shape = self.item_converter_.get_shape();
+            if isempty(item_shape)
+                res = cell(1, count);
+                for i = 1:count
+                    res{i} = self.item_converter_.from_json(items{i});
+                end
+                return
+            end
+
+            res = yardl.allocate(self.get_class(), [prod(item_shape), count]);
+            for i = 1:count
+                item = self.item_converter_.from_json(items{i});
+                res(:, i) = item(:);
+            end
+
+            res = squeeze(reshape(res, [item_shape, count]));
+            if iscolumn(res)
+                res = transpose(res);
+            end
+        end
+
+        function c = get_class(self)
+            c = self.item_converter_.get_class();
+        end
+    end
+end
@@ -0,0 +1,7 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+function res = is_json_null(json)
+    % json_decode returns [] for null and a cell array for an empty array
+    res = isnumeric(json) && isempty(json);
+end
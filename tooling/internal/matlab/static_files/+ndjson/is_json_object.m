@@ -0,0 +1,6 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+function res = is_json_object(json)
+    res = isa(json, "containers.Map") && isscalar(json);
+end
@@ -0,0 +1,10 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+function res = json_array_to_cell(json)
+    % Returns the elements of a JSON array returned by json_decode as a 1xN cell array.
+    if ~iscell(json)
+        throw(yardl.TypeError("Expected a JSON array, got a %s", yardl.ndjson.json_data_type(json)));
+    end
+    res = reshape(json, 1, []);
+end
@@ -0,0 +1,19 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+function t = json_data_type(json)
+    % Returns the JSON data type of a value returned by json_decode.
+    if yardl.ndjson.is_json_null(json)
+        t = "null";
+    elseif islogical(json) && isscalar(json)
+        t = "boolean";
+    elseif isnumeric(json) && isscalar(json)
+        t = "number";
+    elseif ischar(json) || (isstring(json) && isscalar(json))
+        t = "string";
+    elseif yardl.ndjson.is_json_object(json)
+        t = "object";
+    else
+        t = "array";
+    end
+end
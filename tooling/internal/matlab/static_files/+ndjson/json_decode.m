@@ -0,0 +1,160 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+function value = json_decode(text)
+    % Decodes JSON text. Unlike jsondecode, objects are returned as
+    % containers.Map so that keys that are not valid MATLAB identifiers are
+    % not modified, arrays are always returned as 1xN cell arrays, null is
+    % returned as [], and integers that cannot be represented exactly as a
+    % double are returned as int64 or uint64.
+    tokens = regexp(text, '"(?:[^"\\]|\\.)*"|-?\d+(?:\.\d+)?(?:[eE][-+]?\d+)?|true|false|null|\S', 'match');
+    [value, pos] = parse_value(tokens, 1);
+    if pos <= length(tokens)
+        throw(yardl.ProtocolError("Unexpected '%s' after the JSON value", tokens{pos}));
+    end
+end
+
+function [tok, pos] = next_token(tokens, pos)
+    if pos > length(tokens)
+        throw(yardl.ProtocolError("Unexpected end of JSON text"));
+    end
+    tok = tokens{pos};
+    pos = pos + 1;
+end
+
+function [value, pos] = parse_value(tokens, pos)
+    [tok, pos] = next_token(tokens, pos);
+    switch tok
+        case '{'
+            [value, pos] = parse_object(tokens, pos);
+        case '['
+            [value, pos] = parse_array(tokens, pos);
+        case 'true'
+            value = true;
+        case 'false'
+            value = false;
+        case 'null'
+            value = [];
+        otherwise
+            if tok(1) == '"'
+                value = parse_string(tok);
+            else
+                value = parse_number(tok);
+            end
+    end
+end
+
+function [value, pos] = parse_object(tokens, pos)
+    value = yardl.ndjson.json_object();
+    if pos <= length(tokens) && strcmp(tokens{pos}, '}')
+        pos = pos + 1;
+        return
+    end
+
+    while true
+        [key, pos] = next_token(tokens, pos);
+        if key(1) ~= '"'
+            throw(yardl.ProtocolError("Expected a JSON object key, got '%s'", key));
+        end
+        [tok, pos] = next_token(tokens, pos);
+        if ~strcmp(tok, ':')
+            throw(yardl.ProtocolError("Expected ':' after a JSON object key, got '%s'", tok));
+        end
+        [item, pos] = parse_value(tokens, pos);
+        value(parse_string(key)) = item;
+
+        [tok, pos] = next_token(tokens, pos);
+        if strcmp(tok, '}')
+            return
+        elseif ~strcmp(tok, ',')
+            throw(yardl.ProtocolError("Expected ',' or '}' in a JSON object, got '%s'", tok));
+        end
+    end
+end
+
+function [value, pos] = parse_array(tokens, pos)
+    value = cell(1, 0);
+    if pos <= length(tokens) && strcmp(tokens{pos}, ']')
+        pos = pos + 1;
+        return
+    end
+
+    while true
+        [item, pos] = parse_value(tokens, pos);
+        value{end+1} = item;
+
+        [tok, pos] = next_token(tokens, pos);
+        if strcmp(tok, ']')
+            return
+        elseif ~strcmp(tok, ',')
+            throw(yardl.ProtocolError("Expected ',' or ']' in a JSON array, got '%s'", tok));
+        end
+    end
+end
+
+function s = parse_string(tok)
+    if length(tok) < 2 || tok(end) ~= '"'
+        throw(yardl.ProtocolError("Unterminated JSON string"));
+    end
+
+    chars = tok(2:end-1);
+    if ~any(chars == '\')
+        s = chars;
+        return
+    end
+
+    s = blanks(length(chars));
+    n = 0;
+    i = 1;
+    while i <= length(chars)
+        c = chars(i);
+        if c == '\'
+            i = i + 1;
+            switch chars(i)
+                case 'b'
+                    c = char(8);
+                case 'f'
+                    c = char(12);
+                case 'n'
+                    c = char(10);
+                case 'r'
+                    c = char(13);
+                case 't'
+                    c = char(9);
+                case 'u'
+                    if i + 4 > length(chars) || ~all(isstrprop(chars(i+1:i+4), 'xdigit'))
+                        throw(yardl.ProtocolError("Invalid unicode escape in JSON string"));
+                    end
+                    % MATLAB chars are UTF-16 code units, so surrogate pairs
+                    % need no special handling
+                    c = char(hex2dec(chars(i+1:i+4)));
+                    i = i + 4;
+                otherwise
+                    c = chars(i);
+            end
+        end
+        n = n + 1;
+        s(n) = c;
+        i = i + 1;
+    end
+    s = s(1:n);
+end
+
+function value = parse_number(tok)
+    value = str2double(tok);
+    if isnan(value)
+        throw(yardl.ProtocolError("Unexpected '%s' in JSON text", tok));
+    end
+
+    if abs(value) >= flintmax && ~any(tok == '.' | tok == 'e' | tok == 'E')
+        if tok(1) == '-'
+            int_value = sscanf(tok, '%ld');
+        else
+            int_value = sscanf(tok, '%lu');
+        end
+        % sscanf saturates values that are out of range
+        if strcmp(sprintf('%d', int_value), tok)
+            value = int_value;
+        end
+    end
+end
@@ -0,0 +1,70 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+function text = json_encode(json)
+    % Encodes a value returned by a converter's to_json as JSON text.
+    % Unlike jsonencode, cell arrays are always written as arrays and
+    % int64 and uint64 values are written without converting them to double.
+    if yardl.ndjson.is_json_object(json)
+        ks = keys(json);
+        vs = values(json);
+        parts = cell(1, length(ks));
+        for i = 1:length(ks)
+            parts{i} = [encode_string(ks{i}) ':' yardl.ndjson.json_encode(vs{i})];
+        end
+        text = ['{' strjoin(parts, ',') '}'];
+    elseif iscell(json)
+        parts = cellfun(@yardl.ndjson.json_encode, reshape(json, 1, []), 'UniformOutput', false);
+        text = ['[' strjoin(parts, ',') ']'];
+    elseif ischar(json) || (isstring(json) && isscalar(json))
+        text = encode_string(char(json));
+    elseif ~isscalar(json)
+        text = yardl.ndjson.json_encode(num2cell(json));
+    elseif islogical(json)
+        if json
+            text = 'true';
+        else
+            text = 'false';
+        end
+    elseif isinteger(json)
+        text = sprintf('%d', json);
+    elseif isnumeric(json)
+        text = encode_float(json);
+    else
+        throw(yardl.TypeError("Cannot encode %s as JSON", class(json)));
+    end
+end
+
+function text = encode_float(value)
+    if ~isfinite(value)
+        % Like jsonencode, write NaN and Inf as null
+        text = 'null';
+        return
+    end
+
+    % Use the shortest representation that reads back as the same value
+    if isa(value, 'single')
+        precisions = 6:9;
+    else
+        precisions = 15:17;
+    end
+    for p = precisions
+        text = sprintf('%.*g', p, value);
+        if cast(str2double(text), class(value)) == value
+            return
+        end
+    end
+end
+
+function text = encode_string(s)
+    s = strrep(s, '\', '\\');
+    s = strrep(s, '"', '\"');
+    if any(s < 32)
+        parts = num2cell(s);
+        for i = find(s < 32)
+            parts{i} = sprintf('\\u%04x', double(s(i)));
+        end
+        s = [parts{:}];
+    end
+    text = ['"' s '"'];
+end
@@ -0,0 +1,7 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+function res = json_null
+    % json_encode writes NaN as null
+    res = NaN;
+end
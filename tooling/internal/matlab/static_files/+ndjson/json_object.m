@@ -0,0 +1,8 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+function res = json_object
+    % JSON objects are containers.Map objects with char keys, so that their
+    % keys do not have to be valid MATLAB identifiers
+    res = containers.Map('KeyType', 'char', 'ValueType', 'any');
+end
@@ -41,6 +41,24 @@ classdef DateTime < handle
         function isequal = isequal(self, other)
             isequal = all(eq(self, other));
         end
+
+        function res = plus(self, other)
+            if isa(self, 'duration')
+                [self, other] = deal(other, self);
+            end
+            res = yardl.DateTime(int64(self.nanoseconds_since_epoch) + ...
+                yardl.binary.DurationSerializer.to_nanoseconds(other));
+        end
+
+        function res = minus(self, other)
+            if isa(other, 'yardl.DateTime')
+                res = yardl.binary.DurationSerializer.from_nanoseconds( ...
+                    int64(self.nanoseconds_since_epoch) - int64(other.nanoseconds_since_epoch));
+            else
+                res = yardl.DateTime(int64(self.nanoseconds_since_epoch) - ...
+                    yardl.binary.DurationSerializer.to_nanoseconds(other));
+            end
+        end
     end
 
     methods (Static)
@@ -36,6 +36,10 @@ classdef Map < handle
             n = numEntries(self.dict);
         end
 
+        function res = isKey(self, key)
+            res = numEntries(self.dict) > 0 && isKey(self.dict, key);
+        end
+
         function res = eq(a, b)
             if isa(b, 'yardl.Map')
                 res = isequal({a.dict}, {b.dict});
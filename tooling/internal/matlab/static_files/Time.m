@@ -45,6 +45,11 @@ classdef Time < handle
         function isequal = isequal(self, other)
             isequal = all(eq(self, other));
         end
+
+        function res = minus(self, other)
+            res = yardl.binary.DurationSerializer.from_nanoseconds( ...
+                int64(self.nanoseconds_since_midnight) - int64(other.nanoseconds_since_midnight));
+        end
     end
 
     methods (Static)
@@ -5,6 +5,10 @@ function res = allocate(classname, varargin)
     % Wrapper around zeros, used to preallocate arrays of yardl objects
     if classname == "string"
         res = strings(varargin{:});
+    elseif classname == "logical"
+        res = false(varargin{:});
+    elseif classname == "duration"
+        res = seconds(zeros(varargin{:}));
     else
         res = zeros(varargin{:}, classname);
     end
@@ -0,0 +1,17 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+function res = checked_sum(items)
+    % Alternative to sum(items, "native") for integer items, which saturates
+    % Throws if an intermediate result does not fit in the class of the items
+    res = zeros(1, 1, class(items));
+    lo = intmin(class(items));
+    hi = intmax(class(items));
+    for i = 1:numel(items)
+        item = items(i);
+        if (item > 0 && res > hi - item) || (item < 0 && res < lo - item)
+            throw(yardl.RuntimeError("sum() overflowed its result type"));
+        end
+        res = res + item;
+    end
+end
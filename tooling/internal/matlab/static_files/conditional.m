@@ -0,0 +1,12 @@
+% Copyright (c) Microsoft Corporation.
+% Licensed under the MIT License.
+
+function res = conditional(condition, then, otherwise)
+    % Alternative to a conditional operator, which Matlab does not have
+    % Only the function handle of the selected branch is evaluated
+    if condition
+        res = then();
+    else
+        res = otherwise();
+    end
+end
@@ -48,9 +48,34 @@ func WriteTypes(fw *common.MatlabFileWriter, ns *dsl.Namespace, st dsl.SymbolTab
 		}
 	}
 
+	if len(ns.Constants) > 0 {
+		return writeConstants(fw, ns)
+	}
+
 	return nil
 }
 
+func writeConstants(fw *common.MatlabFileWriter, ns *dsl.Namespace) error {
+	return fw.WriteFile(common.ConstantsClassName, func(w *formatting.IndentedWriter) {
+		fmt.Fprintf(w, "classdef %s\n", common.ConstantsClassName)
+		common.WriteBlockBody(w, func() {
+			w.WriteStringln("properties (Constant)")
+			common.WriteBlockBody(w, func() {
+				for _, c := range ns.Constants {
+					common.WriteComment(w, c.Comment)
+					fmt.Fprintf(w, "%s = ", common.ConstantIdentifierName(c.Name))
+					// Numeric literals are converted, since they would otherwise be doubles
+					tail := tailWrapper{}.Append(func(next func()) {
+						writeTypeConversion(w, dsl.GetUnderlyingType(c.Type), next)
+					})
+					writeExpression(w, c.Value, ns.Name, tail, nil)
+					w.WriteStringln("")
+				}
+			})
+		})
+	})
+}
+
 func writeUnionClasses(fw *common.MatlabFileWriter, td dsl.TypeDefinition, unionGenerated map[string]bool) error {
 	var writeError error
 	dsl.Visit(td, func(self dsl.Visitor, node dsl.Node) {
@@ -147,7 +172,7 @@ func typeCanBeAliased(t dsl.Type) bool {
 	if st, ok := t.(*dsl.SimpleType); ok {
 		if pd, ok := st.ResolvedDefinition.(dsl.PrimitiveDefinition); ok {
 			switch pd {
-			case dsl.PrimitiveString:
+			case dsl.PrimitiveString, dsl.PrimitiveBytes, dsl.PrimitiveUuid, dsl.PrimitiveDuration:
 				return false
 			}
 		}
@@ -192,7 +217,7 @@ func writeEnum(fw *common.MatlabFileWriter, enum *dsl.EnumDefinition, namedType
 
 		fmt.Fprintf(w, "classdef %s < %s\n", enumName, base)
 		common.WriteBlockBody(w, func() {
-			common.WriteComment(w, enum.Comment)
+			common.WriteComment(w, dsl.AnnotatedComment(enum.Comment, enum.Annotations))
 			w.WriteStringln("methods (Static)")
 			common.WriteBlockBody(w, func() {
 				for _, value := range enum.Values {
@@ -255,24 +280,34 @@ func writeEnum(fw *common.MatlabFileWriter, enum *dsl.EnumDefinition, namedType
 func writeRecord(fw *common.MatlabFileWriter, rec *dsl.RecordDefinition, st dsl.SymbolTable) error {
 	recordName := common.TypeIdentifierName(rec.Name)
 	return fw.WriteFile(recordName, func(w *formatting.IndentedWriter) {
-		fmt.Fprintf(w, "classdef %s < handle\n", recordName)
+		superclasses := []string{"handle"}
+		if len(rec.Extends) > 0 {
+			superclasses = superclasses[:0]
+			for _, base := range dsl.GetExtendedRecords(rec) {
+				superclasses = append(superclasses, common.TypeSyntax(base, rec.Namespace))
+			}
+		}
+		fmt.Fprintf(w, "classdef %s < %s\n", recordName, strings.Join(superclasses, " & "))
 		common.WriteBlockBody(w, func() {
-			common.WriteComment(w, rec.Comment)
+			common.WriteComment(w, dsl.AnnotatedComment(rec.Comment, rec.Annotations))
 
 			w.WriteStringln("properties")
 			var fieldNames []string
 			var zerosMethodArgs []string
 			common.WriteBlockBody(w, func() {
 				for _, field := range rec.Fields {
-					common.WriteComment(w, field.Comment)
 					fieldName := common.FieldIdentifierName(field.Name)
 					fieldNames = append(fieldNames, fieldName)
-					w.WriteStringln(fieldName)
 					_, defaultExpressionKind := typeDefault(field.Type, rec.Namespace, "", st)
-					switch defaultExpressionKind {
-					case defaultValueKindNone:
+					if defaultExpressionKind == defaultValueKindNone && field.Default == nil {
 						zerosMethodArgs = append(zerosMethodArgs, fmt.Sprintf("%s=yardl.None", fieldName))
 					}
+					if dsl.IsInheritedMember(rec, field.Name) {
+						// declared by the superclass
+						continue
+					}
+					common.WriteComment(w, dsl.AnnotatedComment(field.Comment, field.Annotations))
+					w.WriteStringln(fieldName)
 				}
 			})
 			w.WriteStringln("")
@@ -286,6 +321,13 @@ func writeRecord(fw *common.MatlabFileWriter, rec *dsl.RecordDefinition, st dsl.
 					common.WriteBlockBody(w, func() {
 						for _, field := range rec.Fields {
 							fieldName := common.FieldIdentifierName(field.Name)
+							if field.Default != nil {
+								fmt.Fprintf(w, "kwargs.%s = ", fieldName)
+								writeFieldDefault(w, field, rec.Namespace)
+								w.WriteStringln(";")
+								continue
+							}
+
 							defaultExpression, defaultExpressionKind := typeDefault(field.Type, rec.Namespace, "", st)
 							switch defaultExpressionKind {
 							case defaultValueKindNone:
@@ -295,24 +337,49 @@ func writeRecord(fw *common.MatlabFileWriter, rec *dsl.RecordDefinition, st dsl.
 							}
 						}
 					})
-					for _, field := range rec.Fields {
-						fieldName := common.FieldIdentifierName(field.Name)
-						_, defaultExpressionKind := typeDefault(field.Type, rec.Namespace, "", st)
-						switch defaultExpressionKind {
-						case defaultValueKindNone:
+					writeRequiredCheck := func(field *dsl.Field) {
+						if _, defaultExpressionKind := typeDefault(field.Type, rec.Namespace, "", st); defaultExpressionKind == defaultValueKindNone && field.Default == nil {
+							fieldName := common.FieldIdentifierName(field.Name)
 							fmt.Fprintf(w, "if ~isfield(kwargs, \"%s\")\n", fieldName)
 							common.WriteBlockBody(w, func() {
 								fmt.Fprintf(w, "throw(yardl.TypeError(\"Missing required keyword argument '%s'\"))\n", fieldName)
 							})
 						}
+					}
+
+					// Inherited fields are set by the superclass constructors
+					for _, field := range rec.Fields {
+						if dsl.IsInheritedMember(rec, field.Name) {
+							writeRequiredCheck(field)
+						}
+					}
+					for _, base := range dsl.GetExtendedRecords(rec) {
+						args := make([]string, len(base.Fields))
+						for i, field := range base.Fields {
+							fieldName := common.FieldIdentifierName(field.Name)
+							args[i] = fmt.Sprintf("%s=kwargs.%s", fieldName, fieldName)
+						}
+						fmt.Fprintf(w, "self@%s(%s);\n", common.TypeSyntax(base, rec.Namespace), strings.Join(args, ", "))
+					}
+
+					for _, field := range rec.Fields {
+						if dsl.IsInheritedMember(rec, field.Name) {
+							continue
+						}
+						writeRequiredCheck(field)
+						fieldName := common.FieldIdentifierName(field.Name)
 						fmt.Fprintf(w, "self.%s = kwargs.%s;\n", fieldName, fieldName)
 					}
 				})
 				w.WriteStringln("")
 
 				// Computed Fields
-				if len(rec.ComputedFields) > 0 {
-					for _, computedField := range rec.ComputedFields {
+				computedFields := slices.DeleteFunc(slices.Clone(rec.ComputedFields), func(f *dsl.ComputedField) bool {
+					// inherited computed fields are defined by the superclass
+					return dsl.IsInheritedMember(rec, f.Name)
+				})
+				if len(computedFields) > 0 {
+					for _, computedField := range computedFields {
 						fieldName := common.ComputedFieldIdentifierName(computedField.Name)
 
 						fmt.Fprintf(w, "function res = %s(self)\n", fieldName)
@@ -325,6 +392,10 @@ func writeRecord(fw *common.MatlabFileWriter, rec *dsl.RecordDefinition, st dsl.
 					w.WriteStringln("")
 				}
 
+				if dsl.TypeHasConstraints(&dsl.SimpleType{ResolvedDefinition: rec}, st) {
+					writeValidateMethod(w, rec, st)
+				}
+
 				// eq method
 				w.WriteStringln("function res = eq(self, other)")
 				common.WriteBlockBody(w, func() {
@@ -366,6 +437,78 @@ func writeRecord(fw *common.MatlabFileWriter, rec *dsl.RecordDefinition, st dsl.
 	})
 }
 
+func writeValidateMethod(w *formatting.IndentedWriter, rec *dsl.RecordDefinition, st dsl.SymbolTable) {
+	w.WriteStringln("function validate(self)")
+	common.WriteBlockBody(w, func() {
+		common.WriteComment(w, "Throws a yardl.ValueError if a field does not satisfy its constraints.")
+		for _, field := range rec.Fields {
+			if field.Constraints != nil {
+				writeConstraintChecks(w, rec, field)
+			}
+			if dsl.TypeHasConstraints(field.Type, st) {
+				common.WriteValidation(w, field.Type, "self."+common.FieldIdentifierName(field.Name))
+			}
+		}
+	})
+	w.WriteStringln("")
+}
+
+func writeConstraintChecks(w *formatting.IndentedWriter, rec *dsl.RecordDefinition, field *dsl.Field) {
+	constraints := field.Constraints
+	value := "self." + common.FieldIdentifierName(field.Name)
+	guard := ""
+	if _, optional := dsl.GetConstrainedType(field); optional {
+		guard = value + " ~= yardl.None && "
+	}
+
+	// The message is a format string, followed by any arguments
+	check := func(condition string, message string, args ...string) {
+		fmt.Fprintf(w, "if %s%s\n", guard, condition)
+		common.WriteBlockBody(w, func() {
+			fmt.Fprintf(w, "throw(yardl.ValueError(%s", matlabString(fmt.Sprintf("%s.%s %s", rec.Name, field.Name, message)))
+			for _, arg := range args {
+				fmt.Fprintf(w, ", %s", arg)
+			}
+			w.WriteStringln("));")
+		})
+	}
+
+	if constraints.Min != nil {
+		check(fmt.Sprintf("%s < %s", value, literalText(constraints.Min)), "must be at least "+literalText(constraints.Min))
+	}
+	if constraints.Max != nil {
+		check(fmt.Sprintf("%s > %s", value, literalText(constraints.Max)), "must be at most "+literalText(constraints.Max))
+	}
+	if constraints.Pattern != "" {
+		pattern := matlabString("^(?:" + constraints.Pattern + ")$")
+		check(fmt.Sprintf("isempty(regexp(%s, %s, \"once\"))", value, pattern), "must match the pattern '%s'", matlabString(constraints.Pattern))
+	}
+	if constraints.MinLength != nil {
+		check(fmt.Sprintf("length(%s) < %d", value, *constraints.MinLength), fmt.Sprintf("must have a length of at least %d", *constraints.MinLength))
+	}
+	if constraints.MaxLength != nil {
+		check(fmt.Sprintf("length(%s) > %d", value, *constraints.MaxLength), fmt.Sprintf("must have a length of at most %d", *constraints.MaxLength))
+	}
+}
+
+// Returns a MATLAB string literal. Unlike Go, MATLAB strings do not use
+// backslash escapes, and double quotes are escaped by doubling them.
+func matlabString(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+}
+
+// Returns the text of a numeric literal
+func literalText(expression dsl.Expression) string {
+	switch t := expression.(type) {
+	case *dsl.IntegerLiteralExpression:
+		return t.Value.String()
+	case *dsl.FloatingPointLiteralExpression:
+		return t.Value
+	default:
+		panic(fmt.Sprintf("unexpected expression %T", expression))
+	}
+}
+
 func writeZerosStaticMethod(w *formatting.IndentedWriter, typeSyntax string, defaultArgs []string) {
 	// zeros method, only if can be constructed without arguments
 	w.WriteStringln("function z = zeros(varargin)")
@@ -448,12 +591,6 @@ func writeComputedFieldExpression(w *formatting.IndentedWriter, expression dsl.E
 		self.VisitChildren(node)
 	})
 
-	varCounter := 0
-	newVarName := func() string {
-		varCounter++
-		return fmt.Sprintf("var%d", varCounter)
-	}
-
 	tail := tailWrapper{}.Append(func(next func()) {
 		w.WriteString("res = ")
 		next()
@@ -461,14 +598,45 @@ func writeComputedFieldExpression(w *formatting.IndentedWriter, expression dsl.E
 		w.WriteStringln("return")
 	})
 
+	writeExpression(w, expression, contextNamespace, tail, helperFunctionLookup)
+}
+
+// Writes the default value of a field. Numeric literals are converted
+// to the type of the field, since they would otherwise be doubles.
+func writeFieldDefault(w *formatting.IndentedWriter, field *dsl.Field, contextNamespace string) {
+	tail := tailWrapper{}
+	if _, isConversion := field.Default.(*dsl.TypeConversionExpression); !isConversion {
+		if kind, ok := dsl.GetKindIfPrimitive(field.Type); ok && kind != dsl.PrimitiveKindOther {
+			tail = tail.Append(func(next func()) {
+				writeTypeConversion(w, dsl.GetUnderlyingType(field.Type), next)
+			})
+		}
+	}
+
+	writeExpression(w, field.Default, contextNamespace, tail, nil)
+}
+
+// Writes an expression, passing the code that produces its value to the given tail.
+// Expressions that are not constant may write statements before that.
+func writeExpression(w *formatting.IndentedWriter, expression dsl.Expression, contextNamespace string, tail tailWrapper, helperFunctionLookup map[any]string) {
+	varCounter := 0
+	newVarName := func() string {
+		varCounter++
+		return fmt.Sprintf("var%d", varCounter)
+	}
+
 	dsl.VisitWithContext(expression, tail, func(self dsl.VisitorWithContext[tailWrapper], node dsl.Node, tail tailWrapper) {
 		switch t := node.(type) {
 		case *dsl.UnaryExpression:
 			tail.Run(func() {
-				if t.Operator != dsl.UnaryOpNegate {
+				switch t.Operator {
+				case dsl.UnaryOpNegate:
+					w.WriteString("-(")
+				case dsl.UnaryOpNot:
+					w.WriteString("~(")
+				default:
 					panic(fmt.Sprintf("unexpected unary operator %d", t.Operator))
 				}
-				w.WriteString("-(")
 				self.Visit(t.Expression, tailWrapper{})
 				w.WriteString(")")
 			})
@@ -476,7 +644,7 @@ func writeComputedFieldExpression(w *formatting.IndentedWriter, expression dsl.E
 		case *dsl.BinaryExpression:
 			tail.Run(func() {
 				requiresParentheses := false
-				if l, ok := t.Left.(*dsl.BinaryExpression); ok && l.Operator.Precedence() < t.Operator.Precedence() {
+				if l, ok := t.Left.(*dsl.BinaryExpression); ok && (l.Operator.Precedence() < t.Operator.Precedence() || l.Operator.IsComparison() && t.Operator.IsComparison()) {
 					requiresParentheses = true
 				}
 
@@ -501,6 +669,22 @@ func writeComputedFieldExpression(w *formatting.IndentedWriter, expression dsl.E
 					w.WriteString("./")
 				case dsl.BinaryOpPow:
 					w.WriteString("^")
+				case dsl.BinaryOpEq:
+					w.WriteString("==")
+				case dsl.BinaryOpNe:
+					w.WriteString("~=")
+				case dsl.BinaryOpLt:
+					w.WriteString("<")
+				case dsl.BinaryOpLe:
+					w.WriteString("<=")
+				case dsl.BinaryOpGt:
+					w.WriteString(">")
+				case dsl.BinaryOpGe:
+					w.WriteString(">=")
+				case dsl.BinaryOpAnd:
+					w.WriteString("&&")
+				case dsl.BinaryOpOr:
+					w.WriteString("||")
 				default:
 					panic(fmt.Sprintf("unexpected binary operator %d", t.Operator))
 				}
@@ -508,7 +692,7 @@ func writeComputedFieldExpression(w *formatting.IndentedWriter, expression dsl.E
 				w.WriteString(" ")
 
 				requiresParentheses = false
-				if r, ok := t.Right.(*dsl.BinaryExpression); ok && r.Operator.Precedence() < t.Operator.Precedence() {
+				if r, ok := t.Right.(*dsl.BinaryExpression); ok && (r.Operator.Precedence() < t.Operator.Precedence() || r.Operator.IsComparison() && t.Operator.IsComparison()) {
 					requiresParentheses = true
 				}
 
@@ -521,6 +705,18 @@ func writeComputedFieldExpression(w *formatting.IndentedWriter, expression dsl.E
 				}
 			})
 
+		case *dsl.ConditionalExpression:
+			// Matlab has no conditional operator, so the branches are
+			// passed as function handles to evaluate only one of them
+			tail.Run(func() {
+				w.WriteString("yardl.conditional(")
+				self.Visit(t.Condition, tailWrapper{})
+				w.WriteString(", @() ")
+				self.Visit(t.Then, tailWrapper{})
+				w.WriteString(", @() ")
+				self.Visit(t.Else, tailWrapper{})
+				w.WriteString(")")
+			})
 		case *dsl.IntegerLiteralExpression:
 			tail.Run(func() {
 				fmt.Fprintf(w, "%d", &t.Value)
@@ -533,6 +729,18 @@ func writeComputedFieldExpression(w *formatting.IndentedWriter, expression dsl.E
 			tail.Run(func() {
 				fmt.Fprintf(w, "%q", t.Value)
 			})
+		case *dsl.BooleanLiteralExpression:
+			tail.Run(func() {
+				fmt.Fprint(w, t.Value)
+			})
+		case *dsl.EnumValueExpression:
+			tail.Run(func() {
+				fmt.Fprintf(w, "%s.%s", common.TypeSyntax(t.ResolvedType, contextNamespace), common.EnumValueIdentifierName(t.Value.Symbol))
+			})
+		case *dsl.ConstantReferenceExpression:
+			tail.Run(func() {
+				fmt.Fprintf(w, "%s.%s.%s", common.NamespaceIdentifierName(t.Definition.Namespace), common.ConstantsClassName, common.ConstantIdentifierName(t.Definition.Name))
+			})
 		case *dsl.MemberAccessExpression:
 			tail.Run(func() {
 				if t.Target == nil {
@@ -584,7 +792,9 @@ func writeComputedFieldExpression(w *formatting.IndentedWriter, expression dsl.E
 							return
 						}
 					case *dsl.SimpleType:
-						self.Visit(t.ResolvedDefinition)
+						if !t.IsRecursiveReference {
+							self.Visit(t.ResolvedDefinition)
+						}
 					}
 					self.VisitChildren(node)
 				})
@@ -642,6 +852,42 @@ func writeComputedFieldExpression(w *formatting.IndentedWriter, expression dsl.E
 					self.Visit(t.Arguments[0], tailWrapper{})
 					w.WriteString(")")
 
+				case dsl.FunctionMin, dsl.FunctionMax, dsl.FunctionAbs, dsl.FunctionSqrt, dsl.FunctionFloor, dsl.FunctionCeil:
+					fmt.Fprintf(w, "%s(", t.FunctionName)
+					formatting.Delimited(w, ", ", t.Arguments, func(w *formatting.IndentedWriter, i int, arg dsl.Expression) {
+						self.Visit(arg, tailWrapper{})
+					})
+					w.WriteString(")")
+
+				case dsl.FunctionSum:
+					if primitive, _ := dsl.GetPrimitiveType(t.ResolvedType); dsl.IsIntegralPrimitive(primitive) {
+						// sum(x, "native") would saturate on overflow
+						w.WriteString("yardl.checked_sum(")
+						self.Visit(t.Arguments[0], tailWrapper{})
+						w.WriteString(")")
+					} else {
+						w.WriteString("sum(")
+						self.Visit(t.Arguments[0], tailWrapper{})
+						w.WriteString(", \"native\")")
+					}
+
+				case dsl.FunctionLength:
+					// The length in UTF-8 bytes, not in characters as strlength() gives
+					w.WriteString("numel(unicode2native(")
+					self.Visit(t.Arguments[0], tailWrapper{})
+					w.WriteString(", \"UTF-8\"))")
+
+				case dsl.FunctionContains:
+					self.Visit(t.Arguments[0], tailWrapper{})
+					w.WriteString(".isKey(")
+					self.Visit(t.Arguments[1], tailWrapper{})
+					w.WriteString(")")
+
+				case dsl.FunctionHasValue:
+					w.WriteString("(")
+					self.Visit(t.Arguments[0], tailWrapper{})
+					w.WriteString(" ~= yardl.None)")
+
 				default:
 					panic(fmt.Sprintf("Unknown function '%s'", t.FunctionName))
 				}
@@ -668,7 +914,9 @@ func writeComputedFieldExpression(w *formatting.IndentedWriter, expression dsl.E
 				dsl.Visit(t.Target, func(self dsl.Visitor, node dsl.Node) {
 					switch node := node.(type) {
 					case *dsl.SimpleType:
-						self.Visit(node.ResolvedDefinition)
+						if !node.IsRecursiveReference {
+							self.Visit(node.ResolvedDefinition)
+						}
 					case *dsl.RecordDefinition:
 						for _, field := range node.Fields {
 							u := dsl.GetUnderlyingType(field.Type)
@@ -834,7 +1082,7 @@ func writeTypeConversion(w *formatting.IndentedWriter, t dsl.Type, next func())
 					return "int64(", ")"
 				case dsl.Uint64, dsl.Size:
 					return "uint64(", ")"
-				case dsl.Float32:
+				case dsl.Float16, dsl.BFloat16, dsl.Float32:
 					return "single(", ")"
 				case dsl.Float64:
 					return "double(", ")"
@@ -842,8 +1090,10 @@ func writeTypeConversion(w *formatting.IndentedWriter, t dsl.Type, next func())
 					return "complex(single(", "))"
 				case dsl.ComplexFloat64:
 					return "complex(double(", "))"
-				case dsl.String:
+				case dsl.String, dsl.Uuid:
 					return "string(", ")"
+				case dsl.Bytes:
+					return "uint8(", ")"
 				case dsl.Date:
 					return "yardl.Date(", ")"
 				case dsl.Time:
@@ -991,7 +1241,7 @@ func typeDefinitionDefault(t dsl.TypeDefinition, contextNamespace string, st dsl
 			return "int64(0)", defaultValueKindImmutable
 		case dsl.Uint64, dsl.Size:
 			return "uint64(0)", defaultValueKindImmutable
-		case dsl.Float32:
+		case dsl.Float16, dsl.BFloat16, dsl.Float32:
 			return "single(0)", defaultValueKindImmutable
 		case dsl.Float64:
 			return "double(0)", defaultValueKindImmutable
@@ -1001,12 +1251,18 @@ func typeDefinitionDefault(t dsl.TypeDefinition, contextNamespace string, st dsl
 			return "complex(0)", defaultValueKindImmutable
 		case dsl.String:
 			return `""`, defaultValueKindImmutable
+		case dsl.Bytes:
+			return "uint8.empty()", defaultValueKindImmutable
+		case dsl.Uuid:
+			return `"00000000-0000-0000-0000-000000000000"`, defaultValueKindImmutable
 		case dsl.Date:
 			return "yardl.Date()", defaultValueKindImmutable
 		case dsl.Time:
 			return "yardl.Time()", defaultValueKindImmutable
 		case dsl.DateTime:
 			return "yardl.DateTime()", defaultValueKindImmutable
+		case dsl.Duration:
+			return "seconds(0)", defaultValueKindImmutable
 		}
 
 	case *dsl.EnumDefinition:
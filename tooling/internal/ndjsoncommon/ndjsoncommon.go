@@ -48,15 +48,15 @@ func GetJsonDataType(t dsl.Type) JsonDataType {
 	switch td := scalarType.ResolvedDefinition.(type) {
 	case dsl.PrimitiveDefinition:
 		switch td {
-		case dsl.String:
+		case dsl.String, dsl.Bytes, dsl.Uuid:
 			return JsonString
-		case dsl.Int8, dsl.Int16, dsl.Int32, dsl.Int64, dsl.Uint8, dsl.Uint16, dsl.Uint32, dsl.Uint64, dsl.Size, dsl.Float32, dsl.Float64:
+		case dsl.Int8, dsl.Int16, dsl.Int32, dsl.Int64, dsl.Uint8, dsl.Uint16, dsl.Uint32, dsl.Uint64, dsl.Size, dsl.Float16, dsl.BFloat16, dsl.Float32, dsl.Float64:
 			return JsonNumber
 		case dsl.Bool:
 			return JsonBoolean
 		case dsl.ComplexFloat32, dsl.ComplexFloat64:
 			return JsonArray
-		case dsl.Date, dsl.Time, dsl.DateTime:
+		case dsl.Date, dsl.Time, dsl.DateTime, dsl.Duration:
 			return JsonNumber
 		default:
 			panic(fmt.Sprintf("unexpected primitive type %s", td))
@@ -76,3 +76,17 @@ func GetJsonDataType(t dsl.Type) JsonDataType {
 		panic(fmt.Sprintf("unexpected type %T", td))
 	}
 }
+
+// Returns whether the JSON representations of the cases of a union are distinct,
+// in which case union values are written without a tag.
+func IsSimpleUnion(cases dsl.TypeCases) bool {
+	var possibleTypes JsonDataType
+	for _, c := range cases {
+		thisType := GetJsonDataType(c.Type)
+		if thisType&possibleTypes != 0 {
+			return false
+		}
+		possibleTypes |= thisType
+	}
+	return true
+}
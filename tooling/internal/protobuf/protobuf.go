@@ -0,0 +1,588 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+// Package protobuf writes Protocol Buffers (proto3) definitions that are
+// equivalent to a yardl model, one .proto file per namespace.
+package protobuf
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/microsoft/yardl/tooling/internal/formatting"
+	"github.com/microsoft/yardl/tooling/internal/iocommon"
+	"github.com/microsoft/yardl/tooling/internal/validation"
+	"github.com/microsoft/yardl/tooling/pkg/dsl"
+	"github.com/microsoft/yardl/tooling/pkg/packaging"
+)
+
+// Generate writes a .proto file for each namespace in env and returns warnings
+// for the constructs of the model that Protocol Buffers cannot faithfully represent.
+func Generate(env *dsl.Environment, options packaging.ProtobufCodegenOptions) ([]string, error) {
+	err := os.MkdirAll(options.OutputDir, 0775)
+	if err != nil {
+		return nil, err
+	}
+
+	warnings := &warningSink{reported: make(map[string]bool)}
+	for _, ns := range env.Namespaces {
+		if err := writeNamespaceFile(ns, warnings, options); err != nil {
+			return nil, err
+		}
+	}
+
+	return warnings.AsStrings(), nil
+}
+
+// packageName returns the name of the Protocol Buffers package of a namespace.
+func packageName(namespace string) string {
+	return formatting.ToSnakeCase(namespace)
+}
+
+// fileName returns the name of the .proto file written for a namespace.
+func fileName(namespace string) string {
+	return packageName(namespace) + ".proto"
+}
+
+func writeNamespaceFile(ns *dsl.Namespace, warnings *warningSink, options packaging.ProtobufCodegenOptions) error {
+	g := &generator{
+		namespace: ns,
+		warnings:  warnings,
+		imports:   make(map[string]bool),
+		messages:  make(map[string]string),
+	}
+
+	for _, td := range ns.TypeDefinitions {
+		g.messages[td.GetDefinitionMeta().Name] = ""
+	}
+	for _, p := range ns.Protocols {
+		g.messages[p.Name] = ""
+	}
+
+	var definitions []string
+	for _, td := range ns.TypeDefinitions {
+		if definition := g.typeDefinition(td); definition != "" {
+			definitions = append(definitions, definition)
+		}
+	}
+	for _, p := range ns.Protocols {
+		definitions = append(definitions, g.render(func(w *formatting.IndentedWriter) {
+			g.writeProtocol(w, p)
+		}))
+	}
+
+	// Messages generated for anonymous types follow the definitions of the model
+	for _, name := range g.generated {
+		definitions = append(definitions, g.messages[name])
+	}
+
+	b := bytes.Buffer{}
+	w := formatting.NewIndentedWriter(&b, "  ")
+	w.WriteStringln("// Code generated by the \"yardl\" tool. DO NOT EDIT.")
+	w.WriteStringln("")
+	w.WriteStringln(`syntax = "proto3";`)
+	w.WriteStringln("")
+	fmt.Fprintf(w, "package %s;\n", packageName(ns.Name))
+
+	if len(g.imports) > 0 {
+		w.WriteStringln("")
+		imports := make([]string, 0, len(g.imports))
+		for imp := range g.imports {
+			imports = append(imports, imp)
+		}
+		sort.Strings(imports)
+		for _, imp := range imports {
+			fmt.Fprintf(w, "import \"%s\";\n", fileName(imp))
+		}
+	}
+
+	for _, definition := range definitions {
+		w.WriteStringln("")
+		w.WriteString(definition)
+	}
+
+	return iocommon.WriteFileIfNeeded(path.Join(options.OutputDir, fileName(ns.Name)), b.Bytes(), 0644)
+}
+
+type generator struct {
+	namespace *dsl.Namespace
+	warnings  *warningSink
+
+	// The namespaces of the types referenced from other files
+	imports map[string]bool
+
+	// The definitions of the messages generated for anonymous types, by name.
+	// Names of the definitions of the model map to an empty string.
+	messages  map[string]string
+	generated []string
+}
+
+// The syntax of a type as the type of a Protocol Buffers field
+type fieldType struct {
+	Name    string
+	Label   string
+	Comment string
+}
+
+func (g *generator) render(write func(w *formatting.IndentedWriter)) string {
+	b := bytes.Buffer{}
+	write(formatting.NewIndentedWriter(&b, "  "))
+	return b.String()
+}
+
+// Returns the name of a message generated for an anonymous type. Messages
+// with the same base name but different definitions get a numeric suffix.
+func (g *generator) message(baseName string, write func(w *formatting.IndentedWriter, name string)) string {
+	for i := 1; ; i++ {
+		name := baseName
+		if i > 1 {
+			name = fmt.Sprintf("%s%d", baseName, i)
+		}
+
+		definition := g.render(func(w *formatting.IndentedWriter) { write(w, name) })
+		existing, ok := g.messages[name]
+		if !ok {
+			g.messages[name] = definition
+			g.generated = append(g.generated, name)
+			return name
+		}
+		if existing == definition {
+			return name
+		}
+	}
+}
+
+func (g *generator) typeDefinition(td dsl.TypeDefinition) string {
+	switch td := td.(type) {
+	case *dsl.RecordDefinition:
+		if dsl.IsGeneric(td) {
+			g.warnings.add(td, "generic record '%s' cannot be represented in Protocol Buffers, so a message is written for each of its instantiations instead", td.Name)
+			return ""
+		}
+		return g.render(func(w *formatting.IndentedWriter) {
+			g.writeRecord(w, td.Name, td)
+		})
+	case *dsl.EnumDefinition:
+		if td.IsFlags {
+			g.warnings.add(td, "flags '%s' cannot be represented in Protocol Buffers, so its values are written as integer bit masks", td.Name)
+		}
+		return g.render(func(w *formatting.IndentedWriter) {
+			g.writeEnum(w, td)
+		})
+	case *dsl.NamedType:
+		if dsl.IsGeneric(td) || !isUnion(td.Type) {
+			// Aliases are replaced by the types they refer to
+			return ""
+		}
+		return g.render(func(w *formatting.IndentedWriter) {
+			g.writeUnion(w, td.Name, td.Comment, dsl.GetUnderlyingType(td.Type).(*dsl.GeneralizedType))
+		})
+	default:
+		return ""
+	}
+}
+
+func (g *generator) writeRecord(w *formatting.IndentedWriter, name string, record *dsl.RecordDefinition) {
+	writeComment(w, record.Comment)
+	fmt.Fprintf(w, "message %s {\n", name)
+	w.Indented(func() {
+		g.writeFields(w, record.Fields)
+	})
+	w.WriteStringln("}")
+
+	for _, computedField := range record.ComputedFields {
+		g.warnings.add(computedField, "computed field '%s' of record '%s' cannot be represented in Protocol Buffers and is omitted", computedField.Name, record.Name)
+	}
+}
+
+func (g *generator) writeProtocol(w *formatting.IndentedWriter, protocol *dsl.ProtocolDefinition) {
+	fields := make(dsl.Fields, len(protocol.Sequence))
+	for i, step := range protocol.Sequence {
+		fields[i] = (*dsl.Field)(step)
+	}
+
+	writeComment(w, protocol.Comment)
+	fmt.Fprintf(w, "message %s {\n", protocol.Name)
+	w.Indented(func() {
+		g.writeFields(w, fields)
+	})
+	w.WriteStringln("}")
+}
+
+func (g *generator) writeFields(w *formatting.IndentedWriter, fields dsl.Fields) {
+	number := 1
+	for _, field := range fields {
+		writeComment(w, field.Comment)
+		fieldName := formatting.ToSnakeCase(field.Name)
+		if gt, ok := field.Type.(*dsl.GeneralizedType); ok && gt.Dimensionality == nil && gt.Cases.IsUnion() {
+			g.writeOneof(w, fieldName, fieldName+"_", gt, &number)
+			continue
+		}
+
+		g.writeField(w, g.fieldType(field.Type), fieldName, &number)
+	}
+}
+
+func (g *generator) writeField(w *formatting.IndentedWriter, t fieldType, name string, number *int) {
+	if t.Label != "" {
+		fmt.Fprintf(w, "%s ", t.Label)
+	}
+	fmt.Fprintf(w, "%s %s = %d;", t.Name, name, *number)
+	if t.Comment != "" {
+		fmt.Fprintf(w, " // %s", t.Comment)
+	}
+	w.WriteStringln("")
+	*number++
+}
+
+func (g *generator) writeEnum(w *formatting.IndentedWriter, enum *dsl.EnumDefinition) {
+	prefix := formatting.ToUpperSnakeCase(enum.Name) + "_"
+
+	// The first value of a proto3 enum must be zero
+	values := make(dsl.EnumValues, 0, len(enum.Values))
+	zero := enum.GetZeroValue()
+	if zero != nil {
+		values = append(values, zero)
+	}
+	for _, value := range enum.Values {
+		if value != zero {
+			values = append(values, value)
+		}
+	}
+
+	writeComment(w, enum.Comment)
+	fmt.Fprintf(w, "enum %s {\n", enum.Name)
+	w.Indented(func() {
+		if zero == nil {
+			fmt.Fprintf(w, "%sUNSPECIFIED = 0;\n", prefix)
+		}
+		for _, value := range values {
+			if !value.IntegerValue.IsInt64() || value.IntegerValue.Int64() < math.MinInt32 || value.IntegerValue.Int64() > math.MaxInt32 {
+				g.warnings.add(value, "the value of '%s.%s' does not fit in a Protocol Buffers enum and is omitted", enum.Name, value.Symbol)
+				continue
+			}
+			writeComment(w, value.Comment)
+			fmt.Fprintf(w, "%s%s = %s;\n", prefix, formatting.ToUpperSnakeCase(value.Symbol), value.IntegerValue.String())
+		}
+	})
+	w.WriteStringln("}")
+}
+
+// Writes a message with a single oneof for a union
+func (g *generator) writeUnion(w *formatting.IndentedWriter, name string, comment string, union *dsl.GeneralizedType) {
+	writeComment(w, comment)
+	fmt.Fprintf(w, "message %s {\n", name)
+	w.Indented(func() {
+		number := 1
+		g.writeOneof(w, "value", "", union, &number)
+	})
+	w.WriteStringln("}")
+}
+
+// Writes a oneof with a field for each case of a union. A null case is
+// represented by none of the fields being set.
+func (g *generator) writeOneof(w *formatting.IndentedWriter, name string, casePrefix string, union *dsl.GeneralizedType, number *int) {
+	fmt.Fprintf(w, "oneof %s {\n", name)
+	w.Indented(func() {
+		for _, c := range union.Cases {
+			if c.IsNullType() {
+				continue
+			}
+			g.writeField(w, g.scalarType(c.Type), casePrefix+formatting.ToSnakeCase(c.Tag), number)
+		}
+	})
+	w.WriteStringln("}")
+}
+
+func (g *generator) fieldType(t dsl.Type) fieldType {
+	switch t := t.(type) {
+	case *dsl.SimpleType:
+		return g.simpleType(t)
+	case *dsl.GeneralizedType:
+		switch d := t.Dimensionality.(type) {
+		case nil:
+			if t.Cases.IsSingle() {
+				return g.fieldType(t.Cases[0].Type)
+			}
+			if t.Cases.IsOptional() {
+				inner := g.scalarType(t.Cases[1].Type)
+				inner.Label = "optional"
+				return inner
+			}
+			return fieldType{Name: g.unionMessage(t)}
+		case *dsl.Vector:
+			if d.IsFixed() {
+				g.warnings.add(t, "the length of fixed vector '%s' cannot be represented in Protocol Buffers", dsl.TypeToShortSyntax(t, false))
+			}
+			item := g.scalarType(t.ToScalar())
+			item.Label = "repeated"
+			return item
+		case *dsl.Array:
+			if d.IsFixed() {
+				g.warnings.add(t, "the dimensions of fixed array '%s' cannot be represented in Protocol Buffers, so its shape is written with each value", dsl.TypeToShortSyntax(t, false))
+			}
+			return fieldType{Name: g.arrayMessage(t)}
+		case *dsl.Map:
+			return g.mapType(t, d)
+		case *dsl.Stream:
+			item := g.scalarType(t.ToScalar())
+			item.Label = "repeated"
+			return item
+		}
+	}
+
+	panic(fmt.Sprintf("unexpected type %T", t))
+}
+
+// Returns a field type that can be an item of a repeated field, the value of a
+// map, or a case of a oneof, wrapping the type in a message if necessary.
+func (g *generator) scalarType(t dsl.Type) fieldType {
+	inner := g.fieldType(t)
+	if inner.Label == "" && !strings.HasPrefix(inner.Name, "map<") {
+		return inner
+	}
+
+	return fieldType{Name: g.message(typeName(t), func(w *formatting.IndentedWriter, name string) {
+		fmt.Fprintf(w, "message %s {\n", name)
+		w.Indented(func() {
+			number := 1
+			g.writeField(w, inner, "value", &number)
+		})
+		w.WriteStringln("}")
+	})}
+}
+
+func (g *generator) simpleType(t *dsl.SimpleType) fieldType {
+	switch td := t.ResolvedDefinition.(type) {
+	case dsl.PrimitiveDefinition:
+		return g.primitiveType(td)
+	case *dsl.EnumDefinition:
+		if td.IsFlags {
+			baseType := dsl.Type(dsl.Int32Type)
+			if td.BaseType != nil {
+				baseType = td.BaseType
+			}
+			base := g.fieldType(baseType)
+			base.Comment = fmt.Sprintf("bit mask of %s values", g.qualifiedName(td.DefinitionMeta))
+			return base
+		}
+		return fieldType{Name: g.qualifiedName(td.DefinitionMeta)}
+	case *dsl.RecordDefinition:
+		if len(t.TypeArguments) == 0 {
+			return fieldType{Name: g.qualifiedName(td.DefinitionMeta)}
+		}
+		return fieldType{Name: g.message(typeName(t), func(w *formatting.IndentedWriter, name string) {
+			g.writeRecord(w, name, td)
+		})}
+	case *dsl.NamedType:
+		if len(t.TypeArguments) == 0 && isUnion(td.Type) {
+			return fieldType{Name: g.qualifiedName(td.DefinitionMeta)}
+		}
+		return g.fieldType(td.Type)
+	}
+
+	panic(fmt.Sprintf("unexpected type definition %T", t.ResolvedDefinition))
+}
+
+func (g *generator) primitiveType(p dsl.PrimitiveDefinition) fieldType {
+	switch p {
+	case dsl.Bool:
+		return fieldType{Name: "bool"}
+	case dsl.Int8, dsl.Int16, dsl.Int32:
+		return fieldType{Name: "int32"}
+	case dsl.Uint8, dsl.Uint16, dsl.Uint32:
+		return fieldType{Name: "uint32"}
+	case dsl.Int64:
+		return fieldType{Name: "int64"}
+	case dsl.Uint64, dsl.Size:
+		return fieldType{Name: "uint64"}
+	case dsl.Float16, dsl.BFloat16, dsl.Float32:
+		return fieldType{Name: "float"}
+	case dsl.Float64:
+		return fieldType{Name: "double"}
+	case dsl.String:
+		return fieldType{Name: "string"}
+	case dsl.Bytes:
+		return fieldType{Name: "bytes"}
+	case dsl.Uuid:
+		return fieldType{Name: "bytes", Comment: "16-byte UUID"}
+	case dsl.Date:
+		return fieldType{Name: "int64", Comment: "days since the epoch"}
+	case dsl.Time:
+		return fieldType{Name: "int64", Comment: "nanoseconds since midnight"}
+	case dsl.DateTime:
+		return fieldType{Name: "int64", Comment: "nanoseconds since the epoch"}
+	case dsl.Duration:
+		return fieldType{Name: "int64", Comment: "nanoseconds"}
+	case dsl.ComplexFloat32, dsl.ComplexFloat64:
+		component := g.primitiveType(dsl.Float32)
+		if p == dsl.ComplexFloat64 {
+			component = g.primitiveType(dsl.Float64)
+		}
+		return fieldType{Name: g.message(primitiveName(p), func(w *formatting.IndentedWriter, name string) {
+			fmt.Fprintf(w, "message %s {\n", name)
+			w.Indented(func() {
+				number := 1
+				g.writeField(w, component, "real", &number)
+				g.writeField(w, component, "imaginary", &number)
+			})
+			w.WriteStringln("}")
+		})}
+	}
+
+	panic(fmt.Sprintf("unexpected primitive %s", p))
+}
+
+func (g *generator) unionMessage(union *dsl.GeneralizedType) string {
+	return g.message(typeName(union), func(w *formatting.IndentedWriter, name string) {
+		g.writeUnion(w, name, "", union)
+	})
+}
+
+// Returns the name of a message with the shape and the elements of an array
+func (g *generator) arrayMessage(array *dsl.GeneralizedType) string {
+	item := g.scalarType(array.ToScalar())
+	item.Label = "repeated"
+	return g.message(typeName(array), func(w *formatting.IndentedWriter, name string) {
+		fmt.Fprintf(w, "message %s {\n", name)
+		w.Indented(func() {
+			number := 1
+			w.WriteStringln("// The length of each dimension")
+			g.writeField(w, fieldType{Name: "uint64", Label: "repeated"}, "shape", &number)
+			w.WriteStringln("// The elements in row-major order")
+			g.writeField(w, item, "data", &number)
+		})
+		w.WriteStringln("}")
+	})
+}
+
+func (g *generator) mapType(t *dsl.GeneralizedType, m *dsl.Map) fieldType {
+	key := g.fieldType(m.KeyType)
+	value := g.scalarType(t.ToScalar())
+	switch key.Name {
+	case "bool", "int32", "uint32", "int64", "uint64", "string":
+		return fieldType{Name: fmt.Sprintf("map<%s, %s>", key.Name, value.Name)}
+	}
+
+	g.warnings.add(t, "Protocol Buffers maps cannot have keys of type '%s', so map '%s' is written as a repeated field of entries", dsl.TypeToShortSyntax(m.KeyType, false), dsl.TypeToShortSyntax(t, false))
+	entry := g.message(typeName(t)+"Entry", func(w *formatting.IndentedWriter, name string) {
+		fmt.Fprintf(w, "message %s {\n", name)
+		w.Indented(func() {
+			number := 1
+			g.writeField(w, key, "key", &number)
+			g.writeField(w, value, "value", &number)
+		})
+		w.WriteStringln("}")
+	})
+	return fieldType{Name: entry, Label: "repeated"}
+}
+
+// Returns the name of a type definition, qualified with its package if it is
+// defined in another namespace.
+func (g *generator) qualifiedName(meta *dsl.DefinitionMeta) string {
+	if meta.Namespace == "" || meta.Namespace == g.namespace.Name {
+		return meta.Name
+	}
+
+	g.imports[meta.Namespace] = true
+	return fmt.Sprintf("%s.%s", packageName(meta.Namespace), meta.Name)
+}
+
+// Returns the base name of a message generated for an anonymous type
+func typeName(t dsl.Type) string {
+	switch t := t.(type) {
+	case nil:
+		return "Null"
+	case *dsl.SimpleType:
+		if p, ok := t.ResolvedDefinition.(dsl.PrimitiveDefinition); ok {
+			return primitiveName(p)
+		}
+		meta := t.ResolvedDefinition.GetDefinitionMeta()
+		name := meta.Name
+		for _, arg := range meta.TypeArguments {
+			name += typeName(arg)
+		}
+		return name
+	case *dsl.GeneralizedType:
+		switch d := t.Dimensionality.(type) {
+		case nil:
+			if t.Cases.IsSingle() {
+				return typeName(t.Cases[0].Type)
+			}
+			if t.Cases.IsOptional() {
+				return "Optional" + typeName(t.Cases[1].Type)
+			}
+			cases := make([]string, 0, len(t.Cases))
+			for _, c := range t.Cases {
+				if !c.IsNullType() {
+					cases = append(cases, formatting.ToPascalCase(c.Tag))
+				}
+			}
+			return strings.Join(cases, "Or")
+		case *dsl.Vector:
+			return typeName(t.ToScalar()) + "Vector"
+		case *dsl.Array:
+			return typeName(t.ToScalar()) + "Array"
+		case *dsl.Map:
+			return typeName(d.KeyType) + "To" + typeName(t.ToScalar()) + "Map"
+		case *dsl.Stream:
+			return typeName(t.ToScalar()) + "Stream"
+		}
+	}
+
+	panic(fmt.Sprintf("unexpected type %T", t))
+}
+
+func primitiveName(p dsl.PrimitiveDefinition) string {
+	switch p {
+	case dsl.ComplexFloat32:
+		return "ComplexFloat32"
+	case dsl.ComplexFloat64:
+		return "ComplexFloat64"
+	default:
+		return formatting.ToPascalCase(string(p))
+	}
+}
+
+func isUnion(t dsl.Type) bool {
+	gt, ok := dsl.GetUnderlyingType(t).(*dsl.GeneralizedType)
+	return ok && gt.Dimensionality == nil && gt.Cases.IsUnion()
+}
+
+func writeComment(w *formatting.IndentedWriter, comment string) {
+	if comment == "" {
+		return
+	}
+	for _, line := range strings.Split(strings.TrimSpace(comment), "\n") {
+		fmt.Fprintln(w, strings.TrimRight("// "+line, " "))
+	}
+}
+
+// Collects warnings, ignoring duplicates reported when the same type is
+// written more than once
+type warningSink struct {
+	validation.WarningSink
+	reported map[string]bool
+}
+
+func (s *warningSink) add(node dsl.Node, message string, args ...any) {
+	meta := node.GetNodeMeta()
+	message = fmt.Sprintf(message, args...)
+	key := fmt.Sprintf("%s %s", meta, message)
+	if s.reported[key] {
+		return
+	}
+	s.reported[key] = true
+
+	s.Add(validation.ValidationWarning{
+		Message: message,
+		File:    meta.File,
+		Line:    &meta.Line,
+		Column:  &meta.Column,
+	})
+}
@@ -0,0 +1,79 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+// Package protocolstate tracks the progress of protocol writers that
+// are not generated from a model, such as the binary and NDJSON writers
+// that operate on values loaded at runtime.
+package protocolstate
+
+import (
+	"fmt"
+
+	"github.com/microsoft/yardl/tooling/pkg/dsl"
+)
+
+// State tracks the current step of a protocol writer so that steps
+// are written in the order of the protocol's sequence.
+type State struct {
+	protocol *dsl.ProtocolDefinition
+	index    int
+}
+
+func New(protocol *dsl.ProtocolDefinition) *State {
+	return &State{protocol: protocol}
+}
+
+// AdvanceTo moves to the step with the same name as the given step. Streams that
+// are skipped over are ended by calling endStream. It is an error to skip over
+// a step that is not a stream.
+func (s *State) AdvanceTo(step *dsl.ProtocolStep, endStream func(*dsl.ProtocolStep) error) (*dsl.ProtocolStep, error) {
+	for i := s.index; i < len(s.protocol.Sequence); i++ {
+		if s.protocol.Sequence[i].Name != step.Name {
+			continue
+		}
+
+		for ; s.index < i; s.index++ {
+			skipped := s.protocol.Sequence[s.index]
+			if !skipped.IsStream() {
+				return nil, fmt.Errorf("cannot write step '%s' before step '%s'", step.Name, skipped.Name)
+			}
+			if err := endStream(skipped); err != nil {
+				return nil, err
+			}
+		}
+
+		return s.protocol.Sequence[i], nil
+	}
+
+	return nil, fmt.Errorf("unexpected step '%s' in protocol '%s'", step.Name, s.protocol.Name)
+}
+
+// StepCompleted moves past the current step, which must not be a stream.
+func (s *State) StepCompleted() {
+	s.index++
+}
+
+// Complete ends any remaining streams. It is an error if a step that is
+// not a stream has not been written.
+func (s *State) Complete(endStream func(*dsl.ProtocolStep) error) error {
+	for ; s.index < len(s.protocol.Sequence); s.index++ {
+		step := s.protocol.Sequence[s.index]
+		if !step.IsStream() {
+			return fmt.Errorf("protocol '%s' is incomplete: step '%s' was not written", s.protocol.Name, step.Name)
+		}
+		if err := endStream(step); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// StreamItemType returns the type of the items of a stream step
+func StreamItemType(step *dsl.ProtocolStep) dsl.Type {
+	return step.Type.(*dsl.GeneralizedType).ToScalar()
+}
+
+func StepError(step *dsl.ProtocolStep, err error) error {
+	return fmt.Errorf("step '%s': %w", step.Name, err)
+}
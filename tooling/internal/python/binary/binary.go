@@ -257,6 +257,9 @@ func typeSerializer(t dsl.Type, contextNamespace string, namedType *dsl.NamedTyp
 	case nil:
 		return "_binary.none_serializer"
 	case *dsl.SimpleType:
+		if t.IsRecursiveReference {
+			return fmt.Sprintf("_binary.RecursiveSerializer(lambda: %s)", typeDefinitionSerializer(t.ResolvedDefinition, contextNamespace))
+		}
 		return typeDefinitionSerializer(t.ResolvedDefinition, contextNamespace)
 	case *dsl.GeneralizedType:
 		getScalarSerializer := func() string {
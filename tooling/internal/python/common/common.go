@@ -81,6 +81,10 @@ var TypeSyntaxWriter dsl.TypeSyntaxWriter[string] = func(self dsl.TypeSyntaxWrit
 			return "yardl.UInt64"
 		case dsl.Size:
 			return "yardl.Size"
+		case dsl.Float16:
+			return "yardl.Float16"
+		case dsl.BFloat16:
+			return "yardl.BFloat16"
 		case dsl.Float32:
 			return "yardl.Float32"
 		case dsl.Float64:
@@ -91,12 +95,18 @@ var TypeSyntaxWriter dsl.TypeSyntaxWriter[string] = func(self dsl.TypeSyntaxWrit
 			return "yardl.ComplexDouble"
 		case dsl.String:
 			return "str"
+		case dsl.Bytes:
+			return "bytes"
+		case dsl.Uuid:
+			return "uuid.UUID"
 		case dsl.Date:
 			return "datetime.date"
 		case dsl.Time:
 			return "yardl.Time"
 		case dsl.DateTime:
 			return "yardl.DateTime"
+		case dsl.Duration:
+			return "np.timedelta64"
 		default:
 			panic(fmt.Sprintf("primitive '%v' not recognized", t))
 		}
@@ -152,6 +162,10 @@ var TypeSyntaxWriter dsl.TypeSyntaxWriter[string] = func(self dsl.TypeSyntaxWrit
 	case nil:
 		return "None"
 	case *dsl.SimpleType:
+		if t.IsRecursiveReference {
+			// The record is not yet defined at this point, so we use a forward reference
+			return fmt.Sprintf("\"%s\"", self.ToSyntax(t.ResolvedDefinition, contextNamespace))
+		}
 		return self.ToSyntax(t.ResolvedDefinition, contextNamespace)
 	case *dsl.GeneralizedType:
 		scalarString := func() string {
@@ -254,6 +268,10 @@ func TypeDefinitionDTypeSyntax(t dsl.TypeDefinition) string {
 			return fmt.Sprintf("np.%s", strings.ToLower(string(t)))
 		case dsl.Size:
 			return "np.uint64"
+		case dsl.Float16:
+			return "np.float16"
+		case dsl.BFloat16:
+			return "np.float32"
 		case dsl.ComplexFloat32:
 			return "np.complex64"
 		case dsl.ComplexFloat64:
@@ -264,7 +282,9 @@ func TypeDefinitionDTypeSyntax(t dsl.TypeDefinition) string {
 			return "np.timedelta64"
 		case dsl.DateTime:
 			return "np.datetime64"
-		case dsl.String:
+		case dsl.Duration:
+			return "np.timedelta64"
+		case dsl.String, dsl.Bytes, dsl.Uuid:
 			return "np.object_"
 		default:
 			panic(fmt.Sprintf("Not implemented %s", t))
@@ -369,6 +389,15 @@ func EnumValueIdentifierName(name string) string {
 	return cased + "_"
 }
 
+func ConstantIdentifierName(name string) string {
+	cased := formatting.ToUpperSnakeCase(name)
+	if _, reserved := reservedNames[cased]; !reserved {
+		return cased
+	}
+
+	return cased + "_"
+}
+
 func ComputedFieldIdentifierName(name string) string {
 	cased := formatting.ToSnakeCase(name)
 	if _, reserved := reservedNames[cased]; !reserved {
@@ -543,3 +572,39 @@ func WriteGeneratedFileHeader(w *formatting.IndentedWriter) {
 	WriteComment(w, "This file was generated by the \"yardl\" tool. DO NOT EDIT.")
 	w.WriteStringln("")
 }
+
+// Writes statements that call validate() on the records within a value of the
+// given type, for types for which dsl.TypeHasConstraints is true.
+func WriteValidation(w *formatting.IndentedWriter, t dsl.Type, value string) {
+	writeValidation(w, t, value, 0)
+}
+
+func writeValidation(w *formatting.IndentedWriter, t dsl.Type, value string, depth int) {
+	switch t := t.(type) {
+	case *dsl.SimpleType:
+		switch td := t.ResolvedDefinition.(type) {
+		case *dsl.NamedType:
+			writeValidation(w, td.Type, value, depth)
+		case *dsl.RecordDefinition:
+			fmt.Fprintf(w, "%s.validate()\n", value)
+		}
+	case *dsl.GeneralizedType:
+		switch t.Dimensionality.(type) {
+		case nil:
+			if t.Cases.IsSingle() {
+				writeValidation(w, t.Cases[0].Type, value, depth)
+			} else if t.Cases.IsOptional() {
+				fmt.Fprintf(w, "if %s is not None:\n", value)
+				w.Indented(func() {
+					writeValidation(w, t.Cases[1].Type, value, depth)
+				})
+			}
+		case *dsl.Vector:
+			item := fmt.Sprintf("item%d", depth)
+			fmt.Fprintf(w, "for %s in %s:\n", item, value)
+			w.Indented(func() {
+				writeValidation(w, t.ToScalar(), item, depth+1)
+			})
+		}
+	}
+}
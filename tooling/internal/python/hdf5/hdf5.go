@@ -0,0 +1,372 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+package hdf5
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/microsoft/yardl/tooling/internal/formatting"
+	"github.com/microsoft/yardl/tooling/internal/iocommon"
+	"github.com/microsoft/yardl/tooling/internal/python/common"
+	"github.com/microsoft/yardl/tooling/pkg/dsl"
+)
+
+func WriteHdf5(ns *dsl.Namespace, packageDir string) error {
+	b := bytes.Buffer{}
+	w := formatting.NewIndentedWriter(&b, "    ")
+	common.WriteGeneratedFileHeader(w)
+
+	common.WriteComment(w, "pyright: reportUnusedClass=false")
+	common.WriteComment(w, "pyright: reportUnusedImport=false")
+	common.WriteComment(w, "pyright: reportUnknownArgumentType=false")
+	common.WriteComment(w, "pyright: reportUnknownMemberType=false")
+	common.WriteComment(w, "pyright: reportUnknownVariableType=false")
+
+	w.WriteStringln(`
+import collections.abc
+import typing
+
+import h5py
+import numpy as np
+import numpy.typing as npt
+
+from .types import *
+`)
+
+	relativePath := ".."
+	if ns.IsTopLevel {
+		relativePath = "."
+		w.WriteStringln("from .protocols import *")
+	}
+
+	fmt.Fprintf(w, "from %s import _hdf5\n", relativePath)
+	fmt.Fprintf(w, "from %s import yardl_types as yardl\n\n", relativePath)
+
+	writeConverters(w, ns)
+	if ns.IsTopLevel {
+		writeProtocols(w, ns)
+	}
+
+	hdf5Path := path.Join(packageDir, "hdf5.py")
+	return iocommon.WriteFileIfNeeded(hdf5Path, b.Bytes(), 0644)
+}
+
+func writeConverters(w *formatting.IndentedWriter, ns *dsl.Namespace) {
+	for _, t := range ns.TypeDefinitions {
+		switch t := t.(type) {
+		case *dsl.EnumDefinition:
+			if !t.IsFlags {
+				writeEnumMap(t, w, ns)
+			}
+		case *dsl.RecordDefinition:
+			// HDF5 does not support recursive types
+			if !dsl.TypeContainsRecursiveRecord(&dsl.SimpleType{Name: t.Name, ResolvedDefinition: t}) {
+				writeRecordConverter(t, w, ns)
+			}
+		}
+	}
+}
+
+func writeRecordConverter(td *dsl.RecordDefinition, w *formatting.IndentedWriter, ns *dsl.Namespace) {
+	typeSyntax := common.TypeSyntax(td, ns.Name)
+	var genericSpec string
+	if len(td.TypeParameters) > 0 {
+		params := make([]string, 2*len(td.TypeParameters))
+		for i, tp := range td.TypeParameters {
+			params[2*i] = common.TypeParameterSyntax(tp, false)
+			params[2*i+1] = common.TypeParameterSyntax(tp, true)
+		}
+		genericSpec = fmt.Sprintf("typing.Generic[%s], ", strings.Join(params, ", "))
+	}
+
+	fmt.Fprintf(w, "class %s(%s_hdf5.RecordConverter[%s]):\n", recordConverterClassName(td, ns.Name), genericSpec, typeSyntax)
+	w.Indented(func() {
+		if len(td.TypeParameters) > 0 {
+			typeParamConverters := make([]string, 0, len(td.TypeParameters))
+			for _, tp := range td.TypeParameters {
+				typeParamConverters = append(
+					typeParamConverters,
+					fmt.Sprintf("%s: _hdf5.Hdf5Converter[%s, %s]", typeDefinitionConverter(tp, ns.Name), common.TypeParameterSyntax(tp, false), common.TypeParameterSyntax(tp, true)))
+			}
+
+			fmt.Fprintf(w, "def __init__(self, %s) -> None:\n", strings.Join(typeParamConverters, ", "))
+		} else {
+			w.WriteStringln("def __init__(self) -> None:")
+		}
+		w.Indented(func() {
+			w.WriteStringln("super().__init__([")
+			w.Indented(func() {
+				for _, f := range td.Fields {
+					fmt.Fprintf(w, "(\"%s\", \"%s\", %s),\n", f.Name, common.FieldIdentifierName(f.Name), typeConverter(f.Type, ns.Name, nil))
+				}
+			})
+			w.WriteStringln("])")
+		})
+		w.WriteStringln("")
+
+		fmt.Fprintf(w, "def to_hdf5(self, value: %s) -> typing.Any:\n", typeSyntax)
+		w.Indented(func() {
+			w.WriteStringln("if isinstance(value, np.void):")
+			w.Indented(func() {
+				w.WriteStringln("return self.numpy_to_hdf5(value)")
+			})
+			fmt.Fprintf(w, "if not isinstance(value, %s): # pyright: ignore [reportUnnecessaryIsInstance]\n", common.TypeSyntaxWithoutTypeParameters(td, ns.Name))
+			w.Indented(func() {
+				fmt.Fprintf(w, "raise TypeError(\"Expected '%s' instance\")\n", typeSyntax)
+			})
+
+			fieldValues := make([]string, len(td.Fields))
+			for i, f := range td.Fields {
+				fieldValues[i] = fmt.Sprintf("value.%s", common.FieldIdentifierName(f.Name))
+			}
+			fmt.Fprintf(w, "return self._to_hdf5(%s)\n", strings.Join(fieldValues, ", "))
+		})
+		w.WriteStringln("")
+
+		fmt.Fprintf(w, "def from_hdf5(self, value: typing.Any) -> %s:\n", typeSyntax)
+		w.Indented(func() {
+			w.WriteStringln("field_values = self._from_hdf5(value)")
+			fmt.Fprintf(w, "return %s(", typeSyntax)
+			for i, f := range td.Fields {
+				if i > 0 {
+					w.WriteString(", ")
+				}
+				fmt.Fprintf(w, "%s=field_values[%d]", common.FieldIdentifierName(f.Name), i)
+			}
+			w.WriteStringln(")")
+		})
+		w.WriteStringln("")
+	})
+
+	w.WriteStringln("")
+}
+
+// HDF5 enum types are labeled with the symbols from the model, matching the C++ implementation.
+func writeEnumMap(t *dsl.EnumDefinition, w *formatting.IndentedWriter, ns *dsl.Namespace) {
+	fmt.Fprintf(w, "%s = {\n", enumNameToValueMapName(t, ns.Name))
+	w.Indented(func() {
+		for _, v := range t.Values {
+			fmt.Fprintf(w, "\"%s\": %s.%s,\n", v.Symbol, common.TypeSyntax(t, ns.Name), common.EnumValueIdentifierName(v.Symbol))
+		}
+	})
+	fmt.Fprintf(w, "}\n\n")
+}
+
+func enumNameToValueMapName(t *dsl.EnumDefinition, contextNamespace string) string {
+	name := fmt.Sprintf("%s_name_to_value_map", formatting.ToSnakeCase(t.Name))
+	if t.Namespace != contextNamespace {
+		name = fmt.Sprintf("%s.hdf5.%s", common.NamespaceIdentifierName(t.Namespace), name)
+	}
+	return name
+}
+
+func recordConverterClassName(record *dsl.RecordDefinition, contextNamespace string) string {
+	className := fmt.Sprintf("%sConverter", formatting.ToPascalCase(record.Name))
+	if record.Namespace != contextNamespace {
+		className = fmt.Sprintf("%s.hdf5.%s", common.NamespaceIdentifierName(record.Namespace), className)
+	}
+	return className
+}
+
+func writeProtocols(w *formatting.IndentedWriter, ns *dsl.Namespace) {
+	for _, p := range ns.Protocols {
+		if dsl.ProtocolContainsRecursiveRecord(p) {
+			// HDF5 does not support recursive types
+			continue
+		}
+
+		// writer
+		fmt.Fprintf(w, "class %s(_hdf5.Hdf5ProtocolWriter, %s):\n", Hdf5WriterName(p), common.AbstractWriterName(p))
+		w.Indented(func() {
+			common.WriteDocstringWithLeadingLine(w, fmt.Sprintf("HDF5 writer for the %s protocol.", p.Name), p.Comment)
+			w.WriteStringln("")
+
+			w.WriteStringln("def __init__(self, file: typing.Union[str, typing.BinaryIO, h5py.File]) -> None:")
+			w.Indented(func() {
+				fmt.Fprintf(w, "%s.__init__(self)\n", common.AbstractWriterName(p))
+				fmt.Fprintf(w, "_hdf5.Hdf5ProtocolWriter.__init__(self, file, \"%s\", %s.schema)\n", p.Name, common.AbstractWriterName(p))
+			})
+			w.WriteStringln("")
+
+			for _, step := range p.Sequence {
+				valueType := common.TypeSyntax(step.Type, ns.Name)
+				if step.IsStream() {
+					fmt.Fprintf(w, "def %s(self, value: collections.abc.Iterable[%s]) -> None:\n", common.ProtocolWriteImplMethodName(step), valueType)
+					w.Indented(func() {
+						fmt.Fprintf(w, "self._write_stream(\"%s\", %s, value)\n", step.Name, typeConverter(step.Type, ns.Name, nil))
+					})
+				} else {
+					fmt.Fprintf(w, "def %s(self, value: %s) -> None:\n", common.ProtocolWriteImplMethodName(step), valueType)
+					w.Indented(func() {
+						fmt.Fprintf(w, "self._write_scalar(\"%s\", %s, value)\n", step.Name, typeConverter(step.Type, ns.Name, nil))
+					})
+				}
+				w.WriteStringln("")
+			}
+		})
+
+		w.WriteStringln("")
+
+		// reader
+		fmt.Fprintf(w, "class %s(_hdf5.Hdf5ProtocolReader, %s):\n", Hdf5ReaderName(p), common.AbstractReaderName(p))
+		w.Indented(func() {
+			common.WriteDocstringWithLeadingLine(w, fmt.Sprintf("HDF5 reader for the %s protocol.", p.Name), p.Comment)
+			w.WriteStringln("")
+
+			w.WriteStringln("def __init__(self, file: typing.Union[str, typing.BinaryIO, h5py.File], skip_completed_check: bool = False) -> None:")
+			w.Indented(func() {
+				fmt.Fprintf(w, "%s.__init__(self, skip_completed_check)\n", common.AbstractReaderName(p))
+				fmt.Fprintf(w, "_hdf5.Hdf5ProtocolReader.__init__(self, file, \"%s\", %s.schema)\n", p.Name, common.AbstractReaderName(p))
+			})
+			w.WriteStringln("")
+
+			for _, step := range p.Sequence {
+				valueType := common.TypeSyntax(step.Type, ns.Name)
+				if step.IsStream() {
+					fmt.Fprintf(w, "def %s(self) -> collections.abc.Iterable[%s]:\n", common.ProtocolReadImplMethodName(step), valueType)
+					w.Indented(func() {
+						fmt.Fprintf(w, "return self._read_stream(\"%s\", %s)\n", step.Name, typeConverter(step.Type, ns.Name, nil))
+					})
+				} else {
+					fmt.Fprintf(w, "def %s(self) -> %s:\n", common.ProtocolReadImplMethodName(step), valueType)
+					w.Indented(func() {
+						fmt.Fprintf(w, "return self._read_scalar(\"%s\", %s)\n", step.Name, typeConverter(step.Type, ns.Name, nil))
+					})
+				}
+
+				w.WriteStringln("")
+			}
+		})
+	}
+}
+
+func typeDefinitionConverter(t dsl.TypeDefinition, contextNamespace string) string {
+	switch t := t.(type) {
+	case dsl.PrimitiveDefinition:
+		return fmt.Sprintf("_hdf5.%s_converter", strings.ToLower(string(t)))
+	case *dsl.EnumDefinition:
+		var baseType dsl.Type
+		if t.BaseType != nil {
+			baseType = t.BaseType
+		} else {
+			baseType = dsl.Int32Type
+		}
+
+		if t.IsFlags {
+			return fmt.Sprintf("_hdf5.FlagsConverter(%s, %s)", common.TypeSyntax(t, contextNamespace), common.TypeDTypeSyntax(baseType))
+		}
+
+		return fmt.Sprintf("_hdf5.EnumConverter(%s, %s, %s)", common.TypeSyntax(t, contextNamespace), common.TypeDTypeSyntax(baseType), enumNameToValueMapName(t, contextNamespace))
+	case *dsl.RecordDefinition:
+		converterName := recordConverterClassName(t, contextNamespace)
+		if len(t.TypeParameters) == 0 {
+			return fmt.Sprintf("%s()", converterName)
+		}
+		if len(t.TypeArguments) == 0 {
+			panic("Expected type arguments")
+		}
+
+		typeArguments := make([]string, 0, len(t.TypeArguments))
+		for _, arg := range t.TypeArguments {
+			typeArguments = append(typeArguments, typeConverter(arg, contextNamespace, nil))
+		}
+
+		return fmt.Sprintf("%s(%s)", converterName, strings.Join(typeArguments, ", "))
+	case *dsl.GenericTypeParameter:
+		return fmt.Sprintf("%s_converter", formatting.ToSnakeCase(t.Name))
+	case *dsl.NamedType:
+		return typeConverter(t.Type, contextNamespace, t)
+	default:
+		panic(fmt.Sprintf("Not implemented %T", t))
+	}
+}
+
+func typeConverter(t dsl.Type, contextNamespace string, namedType *dsl.NamedType) string {
+	switch t := t.(type) {
+	case *dsl.SimpleType:
+		return typeDefinitionConverter(t.ResolvedDefinition, contextNamespace)
+	case *dsl.GeneralizedType:
+		getScalarConverter := func() string {
+			if t.Cases.IsSingle() {
+				return typeConverter(t.Cases[0].Type, contextNamespace, namedType)
+			}
+			if t.Cases.IsOptional() {
+				return fmt.Sprintf("_hdf5.OptionalConverter(%s)", typeConverter(t.Cases[1].Type, contextNamespace, namedType))
+			}
+
+			unionClassName, typeParameters := common.UnionClassName(t)
+			if namedType != nil {
+				unionClassName = namedType.Name
+				if namedType.Namespace != contextNamespace {
+					unionClassName = fmt.Sprintf("%s.%s", common.NamespaceIdentifierName(namedType.Namespace), unionClassName)
+				}
+			}
+
+			var classSyntax string
+			if len(typeParameters) == 0 {
+				classSyntax = unionClassName
+			} else {
+				classSyntax = fmt.Sprintf("%s[%s]", unionClassName, typeParameters)
+			}
+
+			options := make([]string, len(t.Cases))
+			for i, c := range t.Cases {
+				if c.Type == nil {
+					options[i] = "None"
+				} else {
+					options[i] = fmt.Sprintf("(%s.%s, %s)", classSyntax, formatting.ToPascalCase(c.Tag), typeConverter(c.Type, contextNamespace, namedType))
+				}
+			}
+
+			return fmt.Sprintf("_hdf5.UnionConverter(%s, [%s])", unionClassName, strings.Join(options, ", "))
+		}
+		switch td := t.Dimensionality.(type) {
+		case nil, *dsl.Stream:
+			return getScalarConverter()
+		case *dsl.Vector:
+			if td.Length != nil {
+				return fmt.Sprintf("_hdf5.FixedVectorConverter(%s, %d)", getScalarConverter(), *td.Length)
+			}
+
+			return fmt.Sprintf("_hdf5.VectorConverter(%s)", getScalarConverter())
+		case *dsl.Array:
+			if td.IsFixed() {
+				dims := make([]string, len(*td.Dimensions))
+				for i, d := range *td.Dimensions {
+					dims[i] = strconv.FormatUint(*d.Length, 10)
+				}
+
+				return fmt.Sprintf("_hdf5.FixedNDArrayConverter(%s, (%s,))", getScalarConverter(), strings.Join(dims, ", "))
+			}
+
+			if td.HasKnownNumberOfDimensions() {
+				return fmt.Sprintf("_hdf5.NDArrayConverter(%s, %d)", getScalarConverter(), len(*td.Dimensions))
+			}
+
+			return fmt.Sprintf("_hdf5.DynamicNDArrayConverter(%s)", getScalarConverter())
+
+		case *dsl.Map:
+			keyConverter := typeConverter(td.KeyType, contextNamespace, namedType)
+			valueConverter := typeConverter(t.ToScalar(), contextNamespace, namedType)
+
+			return fmt.Sprintf("_hdf5.MapConverter(%s, %s)", keyConverter, valueConverter)
+		default:
+			panic(fmt.Sprintf("Not implemented %T", t.Dimensionality))
+		}
+	default:
+		panic(fmt.Sprintf("Not implemented %T", t))
+	}
+}
+
+func Hdf5WriterName(p *dsl.ProtocolDefinition) string {
+	return fmt.Sprintf("Hdf5%sWriter", formatting.ToPascalCase(p.Name))
+}
+
+func Hdf5ReaderName(p *dsl.ProtocolDefinition) string {
+	return fmt.Sprintf("Hdf5%sReader", formatting.ToPascalCase(p.Name))
+}
@@ -397,6 +397,9 @@ func typeConverter(t dsl.Type, contextNamespace string, namedType *dsl.NamedType
 	case nil:
 		return "_ndjson.none_converter"
 	case *dsl.SimpleType:
+		if t.IsRecursiveReference {
+			return fmt.Sprintf("_ndjson.RecursiveConverter(lambda: %s)", typeDefinitionConverter(t.ResolvedDefinition, contextNamespace))
+		}
 		return typeDefinitionConverter(t.ResolvedDefinition, contextNamespace)
 	case *dsl.GeneralizedType:
 		getScalarConverter := func() string {
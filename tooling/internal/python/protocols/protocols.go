@@ -26,6 +26,7 @@ import abc
 import collections.abc
 import datetime
 import typing
+import uuid
 
 import numpy as np
 import numpy.typing as npt
@@ -38,6 +39,10 @@ from . import yardl_types as yardl
 	for _, p := range ns.Protocols {
 		writeAbstractWriter(w, p, st, ns)
 		writeAbstractReader(w, p, ns)
+		for _, sub := range p.SubProtocols {
+			writeSubWriter(w, p, sub, ns)
+			writeSubReader(w, p, sub, ns)
+		}
 	}
 
 	definitionsPath := path.Join(packageDir, "protocols.py")
@@ -47,16 +52,35 @@ from . import yardl_types as yardl
 func writeAbstractWriter(w *formatting.IndentedWriter, p *dsl.ProtocolDefinition, st dsl.SymbolTable, ns *dsl.Namespace) {
 	fmt.Fprintf(w, "class %s(abc.ABC):\n", common.AbstractWriterName(p))
 	w.Indented(func() {
-		common.WriteDocstringWithLeadingLine(w, fmt.Sprintf("Abstract writer for the %s protocol.", p.Name), p.Comment)
+		common.WriteDocstringWithLeadingLine(w, fmt.Sprintf("Abstract writer for the %s protocol.", p.Name), dsl.AnnotatedComment(p.Comment, p.Annotations))
 		w.WriteStringln("")
 
 		// __init__
 		w.WriteStringln("def __init__(self) -> None:")
 		w.Indented(func() {
 			w.WriteStringln("self._state = 0")
+			if protocolHasConstraints(p, st) {
+				w.WriteStringln("self._validate_constraints = False")
+			}
+			for _, sub := range p.SubProtocols {
+				fmt.Fprintf(w, "self.%s = %s(self)\n", subWriterAttributeName(sub), subWriterClassName(p, sub))
+			}
 		})
 		w.WriteStringln("")
 
+		for _, sub := range p.SubProtocols {
+			writeSubProtocolProperty(w, sub, common.AbstractWriterName(sub.Protocol), subWriterAttributeName(sub), fmt.Sprintf("Writer for the `%s` steps, which are written through this writer.", sub.Name))
+		}
+
+		if protocolHasConstraints(p, st) {
+			w.WriteStringln("def set_validate_constraints(self, validate: bool) -> None:")
+			w.Indented(func() {
+				common.WriteDocstring(w, "Enables checking that values satisfy the constraints of their fields before they are written. Disabled by default.\nThe items of streams are checked as they are written.")
+				w.WriteStringln("self._validate_constraints = validate")
+			})
+			w.WriteStringln("")
+		}
+
 		// schema field
 		fmt.Fprintf(w, `schema = r"""%s"""`, dsl.GetProtocolSchemaString(p, st))
 		w.WriteStringln("\n")
@@ -140,6 +164,11 @@ func writeAbstractWriter(w *formatting.IndentedWriter, p *dsl.ProtocolDefinition
 					fmt.Fprintf(w, "self._raise_unexpected_state(%d)\n", i*2)
 				})
 				w.WriteStringln("")
+
+				if stepType := stepValueType(step); dsl.TypeHasConstraints(stepType, st) {
+					writeStepValidation(w, step, stepType, ns)
+				}
+
 				fmt.Fprintf(w, "self.%s(value)\n", common.ProtocolWriteImplMethodName(step))
 				if step.IsStream() {
 					fmt.Fprintf(w, "self._state = %d\n", i*2+1)
@@ -207,7 +236,7 @@ func writeAbstractWriter(w *formatting.IndentedWriter, p *dsl.ProtocolDefinition
 func writeAbstractReader(w *formatting.IndentedWriter, p *dsl.ProtocolDefinition, ns *dsl.Namespace) {
 	fmt.Fprintf(w, "class %s(abc.ABC):\n", common.AbstractReaderName(p))
 	w.Indented(func() {
-		common.WriteDocstringWithLeadingLine(w, fmt.Sprintf("Abstract reader for the %s protocol.", p.Name), p.Comment)
+		common.WriteDocstringWithLeadingLine(w, fmt.Sprintf("Abstract reader for the %s protocol.", p.Name), dsl.AnnotatedComment(p.Comment, p.Annotations))
 		w.WriteStringln("")
 
 		// init method
@@ -215,9 +244,16 @@ func writeAbstractReader(w *formatting.IndentedWriter, p *dsl.ProtocolDefinition
 		w.Indented(func() {
 			w.WriteStringln("self._skip_completed_check = skip_completed_check")
 			w.WriteStringln("self._state = 0")
+			for _, sub := range p.SubProtocols {
+				fmt.Fprintf(w, "self.%s = %s(self)\n", subReaderAttributeName(sub), subReaderClassName(p, sub))
+			}
 		})
 		w.WriteStringln("")
 
+		for _, sub := range p.SubProtocols {
+			writeSubProtocolProperty(w, sub, common.AbstractReaderName(sub.Protocol), subReaderAttributeName(sub), fmt.Sprintf("Reader for the `%s` steps, which are read through this reader.", sub.Name))
+		}
+
 		// close method
 		w.WriteStringln("def close(self) -> None:")
 		w.Indented(func() {
@@ -361,3 +397,150 @@ else:
 		w.WriteStringln("")
 	})
 }
+
+func writeSubProtocolProperty(w *formatting.IndentedWriter, sub *dsl.SubProtocol, typeName, attributeName, description string) {
+	w.WriteStringln("@property")
+	fmt.Fprintf(w, "def %s(self) -> %s:\n", common.FieldIdentifierName(sub.Name), typeName)
+	w.Indented(func() {
+		common.WriteDocstringWithLeadingLine(w, description, sub.Comment)
+		fmt.Fprintf(w, "return self.%s\n", attributeName)
+	})
+	w.WriteStringln("")
+}
+
+// Writes a writer for the sub-protocol that forwards each step to the outer
+// writer's corresponding inlined step.
+func writeSubWriter(w *formatting.IndentedWriter, p *dsl.ProtocolDefinition, sub *dsl.SubProtocol, ns *dsl.Namespace) {
+	fmt.Fprintf(w, "class %s(%s):\n", subWriterClassName(p, sub), common.AbstractWriterName(sub.Protocol))
+	w.Indented(func() {
+		common.WriteDocstring(w, fmt.Sprintf("Writes the `%s` steps of the %s protocol.", sub.Name, p.Name))
+
+		fmt.Fprintf(w, "def __init__(self, outer: %s) -> None:\n", common.AbstractWriterName(p))
+		w.Indented(func() {
+			w.WriteStringln("super().__init__()")
+			w.WriteStringln("self._outer = outer")
+		})
+		w.WriteStringln("")
+
+		outerSteps := sub.Steps(p)
+		for i, step := range sub.Protocol.Sequence {
+			valueType := common.TypeSyntax(step.Type, ns.Name)
+			if step.IsStream() {
+				valueType = fmt.Sprintf("collections.abc.Iterable[%s]", valueType)
+			}
+
+			fmt.Fprintf(w, "def %s(self, value: %s) -> None:\n", common.ProtocolWriteImplMethodName(step), valueType)
+			w.Indented(func() {
+				fmt.Fprintf(w, "self._outer.%s(value)\n", common.ProtocolWriteMethodName(outerSteps[i]))
+			})
+			w.WriteStringln("")
+		}
+
+		// The outer writer ends its streams itself
+		w.WriteStringln("def _close(self) -> None:")
+		w.Indented(func() {
+			w.WriteStringln("pass")
+		})
+		w.WriteStringln("")
+
+		w.WriteStringln("def _end_stream(self) -> None:")
+		w.Indented(func() {
+			w.WriteStringln("pass")
+		})
+		w.WriteStringln("")
+	})
+}
+
+// Writes a reader for the sub-protocol that forwards each step to the outer
+// reader's corresponding inlined step.
+func writeSubReader(w *formatting.IndentedWriter, p *dsl.ProtocolDefinition, sub *dsl.SubProtocol, ns *dsl.Namespace) {
+	fmt.Fprintf(w, "class %s(%s):\n", subReaderClassName(p, sub), common.AbstractReaderName(sub.Protocol))
+	w.Indented(func() {
+		common.WriteDocstring(w, fmt.Sprintf("Reads the `%s` steps of the %s protocol.", sub.Name, p.Name))
+
+		fmt.Fprintf(w, "def __init__(self, outer: %s) -> None:\n", common.AbstractReaderName(p))
+		w.Indented(func() {
+			w.WriteStringln("super().__init__()")
+			w.WriteStringln("self._outer = outer")
+		})
+		w.WriteStringln("")
+
+		outerSteps := sub.Steps(p)
+		for i, step := range sub.Protocol.Sequence {
+			valueType := common.TypeSyntax(step.Type, ns.Name)
+			if step.IsStream() {
+				valueType = fmt.Sprintf("collections.abc.Iterable[%s]", valueType)
+			}
+
+			fmt.Fprintf(w, "def %s(self) -> %s:\n", common.ProtocolReadImplMethodName(step), valueType)
+			w.Indented(func() {
+				fmt.Fprintf(w, "return self._outer.%s()\n", common.ProtocolReadMethodName(outerSteps[i]))
+			})
+			w.WriteStringln("")
+		}
+
+		w.WriteStringln("def _close(self) -> None:")
+		w.Indented(func() {
+			w.WriteStringln("pass")
+		})
+		w.WriteStringln("")
+	})
+}
+
+func subWriterClassName(p *dsl.ProtocolDefinition, sub *dsl.SubProtocol) string {
+	return fmt.Sprintf("_%s%sWriter", formatting.ToPascalCase(p.Name), formatting.ToPascalCase(sub.Name))
+}
+
+func subReaderClassName(p *dsl.ProtocolDefinition, sub *dsl.SubProtocol) string {
+	return fmt.Sprintf("_%s%sReader", formatting.ToPascalCase(p.Name), formatting.ToPascalCase(sub.Name))
+}
+
+func subWriterAttributeName(sub *dsl.SubProtocol) string {
+	return fmt.Sprintf("_%s_writer", formatting.ToSnakeCase(sub.Name))
+}
+
+func subReaderAttributeName(sub *dsl.SubProtocol) string {
+	return fmt.Sprintf("_%s_reader", formatting.ToSnakeCase(sub.Name))
+}
+
+func writeStepValidation(w *formatting.IndentedWriter, step *dsl.ProtocolStep, stepType dsl.Type, ns *dsl.Namespace) {
+	w.WriteStringln("if self._validate_constraints:")
+	w.Indented(func() {
+		if !step.IsStream() {
+			common.WriteValidation(w, stepType, "value")
+			return
+		}
+
+		// Validate the items lazily so that iterables are only consumed once
+		itemType := common.TypeSyntax(stepType, ns.Name)
+		fmt.Fprintf(w, "def validated(items: collections.abc.Iterable[%s]) -> collections.abc.Iterable[%s]:\n", itemType, itemType)
+		w.Indented(func() {
+			w.WriteStringln("for item in items:")
+			w.Indented(func() {
+				common.WriteValidation(w, stepType, "item")
+				w.WriteStringln("yield item")
+			})
+		})
+		w.WriteStringln("value = validated(value)")
+	})
+	w.WriteStringln("")
+}
+
+// Returns the type of the values passed to the write method of a step
+func stepValueType(step *dsl.ProtocolStep) dsl.Type {
+	if step.IsStream() {
+		return step.Type.(*dsl.GeneralizedType).ToScalar()
+	}
+
+	return step.Type
+}
+
+func protocolHasConstraints(p *dsl.ProtocolDefinition, st dsl.SymbolTable) bool {
+	for _, step := range p.Sequence {
+		if dsl.TypeHasConstraints(stepValueType(step), st) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/microsoft/yardl/tooling/internal/iocommon"
 	"github.com/microsoft/yardl/tooling/internal/python/binary"
 	"github.com/microsoft/yardl/tooling/internal/python/common"
+	"github.com/microsoft/yardl/tooling/internal/python/hdf5"
 	"github.com/microsoft/yardl/tooling/internal/python/ndjson"
 	"github.com/microsoft/yardl/tooling/internal/python/protocols"
 	"github.com/microsoft/yardl/tooling/internal/python/types"
@@ -28,6 +29,9 @@ var staticFiles embed.FS
 //go:embed static_files/_ndjson.py
 var staticNdJsonFile embed.FS
 
+//go:embed static_files/_hdf5.py
+var staticHdf5File embed.FS
+
 func Generate(env *dsl.Environment, options packaging.PythonCodegenOptions) error {
 	common.AnnotateGenerics(env)
 
@@ -46,13 +50,18 @@ func Generate(env *dsl.Environment, options packaging.PythonCodegenOptions) erro
 			return err
 		}
 	}
+	if options.GenerateHDF5 {
+		if err := iocommon.CopyEmbeddedStaticFiles(topPackageDir, options.InternalSymlinkStaticFiles, staticHdf5File); err != nil {
+			return err
+		}
+	}
 
 	for _, ns := range env.Namespaces {
 		packageDir := topPackageDir
 		if !ns.IsTopLevel {
 			packageDir = path.Join(packageDir, formatting.ToSnakeCase(ns.Name))
 		}
-		err = writeNamespace(ns, env.SymbolTable, packageDir, options.GenerateNDJson)
+		err = writeNamespace(ns, env.SymbolTable, packageDir, options.GenerateNDJson, options.GenerateHDF5)
 		if err != nil {
 			return err
 		}
@@ -61,13 +70,13 @@ func Generate(env *dsl.Environment, options packaging.PythonCodegenOptions) erro
 	return nil
 }
 
-func writeNamespace(ns *dsl.Namespace, st dsl.SymbolTable, packageDir string, generateNDJson bool) error {
+func writeNamespace(ns *dsl.Namespace, st dsl.SymbolTable, packageDir string, generateNDJson bool, generateHDF5 bool) error {
 	if err := os.MkdirAll(packageDir, 0775); err != nil {
 		return err
 	}
 
 	// Write __init__.py
-	if err := writePackageInitFile(ns, packageDir, generateNDJson); err != nil {
+	if err := writePackageInitFile(ns, packageDir, generateNDJson, generateHDF5); err != nil {
 		return err
 	}
 
@@ -91,10 +100,16 @@ func writeNamespace(ns *dsl.Namespace, st dsl.SymbolTable, packageDir string, ge
 		}
 	}
 
+	if generateHDF5 {
+		if err := hdf5.WriteHdf5(ns, packageDir); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
-func writePackageInitFile(ns *dsl.Namespace, packageDir string, generateNDJson bool) error {
+func writePackageInitFile(ns *dsl.Namespace, packageDir string, generateNDJson bool, generateHDF5 bool) error {
 	b := bytes.Buffer{}
 	w := formatting.NewIndentedWriter(&b, "    ")
 	common.WriteGeneratedFileHeader(w)
@@ -137,6 +152,9 @@ if _parse_version(_np.__version__) < _MIN_NUMPY_VERSION:
 	for _, t := range ns.TypeDefinitions {
 		typesMembers = append(typesMembers, common.TypeIdentifierName(t.GetDefinitionMeta().Name))
 	}
+	for _, c := range ns.Constants {
+		typesMembers = append(typesMembers, common.ConstantIdentifierName(c.Name))
+	}
 
 	unions := make(map[string]interface{})
 	dsl.Visit(ns, func(self dsl.Visitor, node dsl.Node) {
@@ -223,11 +241,38 @@ if _parse_version(_np.__version__) < _MIN_NUMPY_VERSION:
 			})
 			fmt.Fprintf(w, ")\n")
 		}
+
+		if generateHDF5 {
+			// Protocols with recursive records have no HDF5 implementation
+			protocolsMembers = protocolsMembers[:0]
+			for _, p := range ns.Protocols {
+				if !dsl.ProtocolContainsRecursiveRecord(p) {
+					protocolsMembers = append(protocolsMembers, hdf5.Hdf5WriterName(p), hdf5.Hdf5ReaderName(p))
+				}
+			}
+
+			sort.Slice(protocolsMembers, func(i, j int) bool {
+				return protocolsMembers[i] < protocolsMembers[j]
+			})
+
+			if len(protocolsMembers) > 0 {
+				fmt.Fprintf(w, "from .hdf5 import (\n")
+				w.Indented(func() {
+					for _, p := range protocolsMembers {
+						fmt.Fprintf(w, "%s,\n", p)
+					}
+				})
+				fmt.Fprintf(w, ")\n")
+			}
+		}
 	} else {
 		w.WriteStringln("from . import binary")
 		if generateNDJson {
 			w.WriteStringln("from . import ndjson")
 		}
+		if generateHDF5 {
+			w.WriteStringln("from . import hdf5")
+		}
 	}
 
 	return iocommon.WriteFileIfNeeded(path.Join(packageDir, "__init__.py"), b.Bytes(), 0644)
@@ -8,6 +8,7 @@ from enum import Enum
 from io import BufferedIOBase, BufferedReader, BytesIO
 from typing import (
     BinaryIO,
+    Callable,
     Iterable,
     Protocol,
     TypeVar,
@@ -20,6 +21,7 @@ from typing import (
 from abc import ABC, abstractmethod
 import struct
 import sys
+import uuid
 import numpy as np
 
 from numpy.lib import recfunctions
@@ -593,6 +595,52 @@ class SizeSerializer(TypeSerializer[Size, np.uint64]):
 size_serializer = SizeSerializer()
 
 
+class Float16Serializer(StructSerializer[Float16, np.float16]):
+    def __init__(self) -> None:
+        super().__init__(np.float16, "<e")
+
+    def read(self, stream: CodedInputStream) -> Float16:
+        return super().read(stream)
+
+    def is_trivially_serializable(self) -> bool:
+        return True
+
+
+float16_serializer = Float16Serializer()
+
+
+class BFloat16Serializer(TypeSerializer[BFloat16, np.float32]):
+    """NumPy has no bfloat16 type, so values are held as float32 and are
+    rounded to the nearest bfloat16 value, with ties to even, when written."""
+
+    _bits_struct = struct.Struct("<H")
+
+    def __init__(self) -> None:
+        super().__init__(np.float32)
+
+    def write(self, stream: CodedOutputStream, value: BFloat16) -> None:
+        self.write_numpy(stream, np.float32(value))
+
+    def write_numpy(self, stream: CodedOutputStream, value: np.float32) -> None:
+        bits = int(np.float32(value).view(np.uint32))
+        if bits & 0x7FFFFFFF > 0x7F800000:
+            # NaN
+            bits = (bits >> 16) | 0x40
+        else:
+            bits = (bits + 0x7FFF + ((bits >> 16) & 1)) >> 16
+        stream.write(self._bits_struct, bits)
+
+    def read(self, stream: CodedInputStream) -> BFloat16:
+        return float(self.read_numpy(stream))
+
+    def read_numpy(self, stream: CodedInputStream) -> np.float32:
+        bits = stream.read(self._bits_struct)[0]
+        return np.uint32(bits << 16).view(np.float32)
+
+
+bfloat16_serializer = BFloat16Serializer()
+
+
 class Float32Serializer(StructSerializer[Float32, np.float32]):
     def __init__(self) -> None:
         super().__init__(np.float32, "<f")
@@ -692,6 +740,50 @@ class StringSerializer(TypeSerializer[str, np.object_]):
 
 string_serializer = StringSerializer()
 
+
+class BytesSerializer(TypeSerializer[bytes, np.object_]):
+    def __init__(self) -> None:
+        super().__init__(np.object_)
+
+    def write(self, stream: CodedOutputStream, value: bytes) -> None:
+        stream.write_unsigned_varint(len(value))
+        stream.write_bytes(value)
+
+    def write_numpy(self, stream: CodedOutputStream, value: np.object_) -> None:
+        self.write(stream, cast(bytes, value))
+
+    def read(self, stream: CodedInputStream) -> bytes:
+        length = stream.read_unsigned_varint()
+        return bytes(stream.read_view(length))
+
+    def read_numpy(self, stream: CodedInputStream) -> np.object_:
+        return np.object_(self.read(stream))  # pyright: ignore [reportReturnType]
+
+
+bytes_serializer = BytesSerializer()
+
+
+class UuidSerializer(TypeSerializer[uuid.UUID, np.object_]):
+    def __init__(self) -> None:
+        super().__init__(np.object_)
+
+    def write(self, stream: CodedOutputStream, value: uuid.UUID) -> None:
+        if not isinstance(value, uuid.UUID):
+            raise ValueError(f"Value in not a UUID: {value}")
+        stream.write_bytes(value.bytes)
+
+    def write_numpy(self, stream: CodedOutputStream, value: np.object_) -> None:
+        self.write(stream, cast(uuid.UUID, value))
+
+    def read(self, stream: CodedInputStream) -> uuid.UUID:
+        return uuid.UUID(bytes=bytes(stream.read_view(16)))
+
+    def read_numpy(self, stream: CodedInputStream) -> np.object_:
+        return np.object_(self.read(stream))  # pyright: ignore [reportReturnType]
+
+
+uuid_serializer = UuidSerializer()
+
 EPOCH_ORDINAL_DAYS = datetime.date(1970, 1, 1).toordinal()
 DATETIME_DAYS_DTYPE = np.dtype("datetime64[D]")
 
@@ -810,6 +902,37 @@ class DateTimeSerializer(TypeSerializer[DateTime, np.datetime64]):
 datetime_serializer = DateTimeSerializer()
 
 
+class DurationSerializer(TypeSerializer[np.timedelta64, np.timedelta64]):
+    def __init__(self) -> None:
+        super().__init__(TIMEDELTA_NANOSECONDS_DTYPE)
+
+    def write(self, stream: CodedOutputStream, value: np.timedelta64) -> None:
+        if isinstance(value, datetime.timedelta):
+            self.write_numpy(stream, np.timedelta64(value))
+        else:
+            if not isinstance(value, np.timedelta64):
+                raise ValueError(
+                    f"Expected datetime.timedelta or numpy.timedelta64, got {type(value)}"
+                )
+
+            self.write_numpy(stream, value)
+
+    def write_numpy(self, stream: CodedOutputStream, value: np.timedelta64) -> None:
+        stream.write_signed_varint(
+            value.astype(TIMEDELTA_NANOSECONDS_DTYPE).astype(np.int64)
+        )
+
+    def read(self, stream: CodedInputStream) -> np.timedelta64:
+        return self.read_numpy(stream)
+
+    def read_numpy(self, stream: CodedInputStream) -> np.timedelta64:
+        nanoseconds = stream.read_signed_varint()
+        return np.timedelta64(nanoseconds, "ns")
+
+
+duration_serializer = DurationSerializer()
+
+
 class NoneSerializer(TypeSerializer[None, Any]):
     def __init__(self) -> None:
         super().__init__(np.object_)
@@ -1328,6 +1451,35 @@ class RecordSerializer(TypeSerializer[T, np.void]):
         return cast(np.void, self._read(stream))
 
 
+class RecursiveSerializer(TypeSerializer[T, np.object_]):
+    """Creates the serializer of a recursive record on first use, so that
+    constructing a record serializer does not recurse indefinitely."""
+
+    def __init__(
+        self, serializer_factory: Callable[[], TypeSerializer[T, Any]]
+    ) -> None:
+        super().__init__(np.object_)
+        self._serializer_factory = serializer_factory
+        self._serializer: Optional[TypeSerializer[T, Any]] = None
+
+    def _get_serializer(self) -> TypeSerializer[T, Any]:
+        if self._serializer is None:
+            self._serializer = self._serializer_factory()
+        return self._serializer
+
+    def write(self, stream: CodedOutputStream, value: T) -> None:
+        self._get_serializer().write(stream, value)
+
+    def write_numpy(self, stream: CodedOutputStream, value: np.object_) -> None:
+        self.write(stream, cast(T, value))
+
+    def read(self, stream: CodedInputStream) -> T:
+        return self._get_serializer().read(stream)
+
+    def read_numpy(self, stream: CodedInputStream) -> np.object_:
+        return self.read(stream)  # type: ignore
+
+
 # Only used in the header
 int32_struct = struct.Struct("<i")
 assert int32_struct.size == 4
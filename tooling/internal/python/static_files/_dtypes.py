@@ -5,6 +5,7 @@ import datetime
 from types import GenericAlias
 from typing import Annotated, Any
 import sys
+import uuid
 
 if sys.version_info >= (3, 10):
     from types import UnionType
@@ -33,6 +34,9 @@ def make_get_dtype_func(
     dtype_map[yardl.Int64] = np.dtype(np.int64)
     dtype_map[yardl.UInt64] = np.dtype(np.uint64)
     dtype_map[yardl.Size] = np.dtype(np.uint64)
+    dtype_map[yardl.Float16] = np.dtype(np.float16)
+    # NumPy has no bfloat16 type, so bfloat16 values are held as float32
+    dtype_map[yardl.BFloat16] = np.dtype(np.float32)
     dtype_map[yardl.Float32] = np.dtype(np.float32)
     dtype_map[yardl.Float64] = np.dtype(np.float64)
     dtype_map[yardl.ComplexFloat] = np.dtype(np.complex64)
@@ -40,7 +44,10 @@ def make_get_dtype_func(
     dtype_map[datetime.date] = np.dtype("datetime64[D]")
     dtype_map[yardl.Time] = np.dtype("timedelta64[ns]")
     dtype_map[yardl.DateTime] = np.dtype("datetime64[ns]")
+    dtype_map[np.timedelta64] = np.dtype("timedelta64[ns]")
     dtype_map[str] = np.dtype(np.object_)
+    dtype_map[bytes] = np.dtype(np.object_)
+    dtype_map[uuid.UUID] = np.dtype(np.object_)
 
     # Add the Python types to the dictionary too, but these may not be
     # correct since they map to several dtypes
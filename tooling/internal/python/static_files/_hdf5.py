@@ -0,0 +1,1296 @@
+# Copyright (c) Microsoft Corporation.
+# Licensed under the MIT License.
+
+# pyright: reportUnnecessaryIsInstance=false
+# pyright: reportUnknownArgumentType=false
+# pyright: reportUnknownMemberType=false
+# pyright: reportUnknownVariableType=false
+
+from abc import ABC, abstractmethod
+import datetime
+from enum import Enum, IntFlag
+from typing import Any, BinaryIO, Generic, Iterable, Optional, TypeVar, Union, cast
+import uuid
+
+import h5py
+import numpy as np
+import numpy.typing as npt
+
+from .yardl_types import *
+
+# To be incremented when we make a structural change to the
+# way we store data in an HDF5 file. Must match the C++ implementation.
+CURRENT_HDF5_FORMAT_VERSION: int = 1
+FORMAT_VERSION_ATTRIBUTE_NAME = "$yardl_format_version"
+SCHEMA_DATASET_NAME = "$yardl_schema"
+UNION_INDEX_DATASET_NAME = "$index"
+
+# Because we do not know the size of a stream up-front, streams are written to
+# chunked datasets. This matches the chunk size used by the C++ implementation.
+CHUNK_SIZE_BYTES = 8 * 1024 * 1024
+
+# The number of rows converted and written (or read) at a time.
+BATCH_SIZE = 8192
+
+HBOOL_DTYPE = np.dtype(np.uint8)
+STRING_DTYPE = h5py.string_dtype("utf-8")
+# Variable-length sequences of HDF5 opaque bytes
+BYTES_DTYPE = h5py.vlen_dtype(np.dtype("V1"))
+SIZE_DTYPE = np.dtype(np.uint64)
+
+File = Union[str, BinaryIO, h5py.File]
+
+T = TypeVar("T")
+T_NP = TypeVar("T_NP", bound=np.generic)
+
+
+class Hdf5Converter(Generic[T, T_NP], ABC):
+    """Converts values between their Python representation and the representation
+    h5py uses for the HDF5 datatype that the C++ implementation uses for the type."""
+
+    def __init__(self, hdf5_dtype: npt.DTypeLike, overall_dtype: npt.DTypeLike) -> None:
+        self._hdf5_dtype: np.dtype[Any] = np.dtype(hdf5_dtype)
+        self._overall_dtype: np.dtype[Any] = np.dtype(overall_dtype)
+
+    def hdf5_dtype(self) -> np.dtype[Any]:
+        return self._hdf5_dtype
+
+    def overall_dtype(self) -> np.dtype[Any]:
+        return self._overall_dtype
+
+    @abstractmethod
+    def to_hdf5(self, value: T) -> Any:
+        raise NotImplementedError
+
+    @abstractmethod
+    def numpy_to_hdf5(self, value: T_NP) -> Any:
+        raise NotImplementedError
+
+    @abstractmethod
+    def from_hdf5(self, value: Any) -> T:
+        raise NotImplementedError
+
+    @abstractmethod
+    def from_hdf5_to_numpy(self, value: Any) -> T_NP:
+        raise NotImplementedError
+
+    def default_hdf5(self) -> Any:
+        """Returns the value written for an absent value, such as an optional
+        without a value or a union case that is not selected."""
+        return np.zeros((), dtype=self._hdf5_dtype)[()]
+
+    def is_trivially_convertible(self) -> bool:
+        """Whether numpy arrays of this type can be written to and read from HDF5 as-is."""
+        return False
+
+
+def to_hdf5_array(converter: Hdf5Converter[Any, Any], values: Iterable[Any]) -> npt.NDArray[Any]:
+    items = values if isinstance(values, list) else list(values)
+    result = np.empty(len(items), dtype=converter.hdf5_dtype())
+    for i, item in enumerate(items):
+        result[i] = converter.to_hdf5(item)
+    return result
+
+
+def numpy_to_hdf5_array(
+    converter: Hdf5Converter[Any, Any], values: npt.NDArray[Any]
+) -> npt.NDArray[Any]:
+    if converter.is_trivially_convertible():
+        return np.ascontiguousarray(values, dtype=converter.hdf5_dtype())
+
+    result = np.empty(len(values), dtype=converter.hdf5_dtype())
+    for i, item in enumerate(values):
+        result[i] = converter.numpy_to_hdf5(item)
+    return result
+
+
+def from_hdf5_to_numpy_array(
+    converter: Hdf5Converter[Any, Any], values: npt.NDArray[Any]
+) -> npt.NDArray[Any]:
+    if converter.is_trivially_convertible():
+        return values.astype(converter.overall_dtype(), copy=False)
+
+    result = np.empty(len(values), dtype=converter.overall_dtype())
+    for i, item in enumerate(values):
+        result[i] = converter.from_hdf5_to_numpy(item)
+    return result
+
+
+class BoolConverter(Hdf5Converter[bool, np.bool_]):
+    def __init__(self) -> None:
+        super().__init__(HBOOL_DTYPE, np.bool_)
+
+    def to_hdf5(self, value: bool) -> Any:
+        return 1 if value else 0
+
+    def numpy_to_hdf5(self, value: np.bool_) -> Any:
+        return 1 if value else 0
+
+    def from_hdf5(self, value: Any) -> bool:
+        return bool(value)
+
+    def from_hdf5_to_numpy(self, value: Any) -> np.bool_:
+        return np.bool_(value)
+
+
+bool_converter = BoolConverter()
+
+
+class NumericConverter(Hdf5Converter[T, T_NP]):
+    def __init__(self, numpy_type: type, python_type: type) -> None:
+        super().__init__(numpy_type, numpy_type)
+        self._numpy_type = numpy_type
+        self._python_type = python_type
+
+    def to_hdf5(self, value: T) -> Any:
+        return value
+
+    def numpy_to_hdf5(self, value: T_NP) -> Any:
+        return value
+
+    def from_hdf5(self, value: Any) -> T:
+        return self._python_type(value)
+
+    def from_hdf5_to_numpy(self, value: Any) -> T_NP:
+        return self._numpy_type(value)
+
+    def is_trivially_convertible(self) -> bool:
+        return True
+
+
+int8_converter = NumericConverter[Int8, np.int8](np.int8, int)
+uint8_converter = NumericConverter[UInt8, np.uint8](np.uint8, int)
+int16_converter = NumericConverter[Int16, np.int16](np.int16, int)
+uint16_converter = NumericConverter[UInt16, np.uint16](np.uint16, int)
+int32_converter = NumericConverter[Int32, np.int32](np.int32, int)
+uint32_converter = NumericConverter[UInt32, np.uint32](np.uint32, int)
+int64_converter = NumericConverter[Int64, np.int64](np.int64, int)
+uint64_converter = NumericConverter[UInt64, np.uint64](np.uint64, int)
+size_converter = NumericConverter[Size, np.uint64](np.uint64, int)
+float16_converter = NumericConverter[Float16, np.float16](np.float16, float)
+# NumPy has no bfloat16 type, so bfloat16 values are stored as float32
+bfloat16_converter = NumericConverter[BFloat16, np.float32](np.float32, float)
+float32_converter = NumericConverter[Float32, np.float32](np.float32, float)
+float64_converter = NumericConverter[Float64, np.float64](np.float64, float)
+
+
+class ComplexConverter(Hdf5Converter[T, T_NP]):
+    def __init__(self, numpy_type: type, component_type: type) -> None:
+        super().__init__(
+            [("real", component_type), ("imaginary", component_type)], numpy_type
+        )
+        self._numpy_type = numpy_type
+
+    def to_hdf5(self, value: T) -> Any:
+        c = complex(cast(complex, value))
+        return (c.real, c.imag)
+
+    def numpy_to_hdf5(self, value: T_NP) -> Any:
+        return (value.real, value.imag)  # type: ignore
+
+    def from_hdf5(self, value: Any) -> T:
+        return cast(T, complex(value["real"], value["imaginary"]))
+
+    def from_hdf5_to_numpy(self, value: Any) -> T_NP:
+        return self._numpy_type(complex(value["real"], value["imaginary"]))
+
+
+complexfloat32_converter = ComplexConverter[ComplexFloat, np.complex64](
+    np.complex64, np.float32
+)
+complexfloat64_converter = ComplexConverter[ComplexDouble, np.complex128](
+    np.complex128, np.float64
+)
+
+
+class StringConverter(Hdf5Converter[str, np.object_]):
+    def __init__(self) -> None:
+        super().__init__(STRING_DTYPE, np.object_)
+
+    def to_hdf5(self, value: str) -> Any:
+        if not isinstance(value, str):
+            raise ValueError(f"Value in not a string: {value}")
+        return value
+
+    def numpy_to_hdf5(self, value: np.object_) -> Any:
+        return self.to_hdf5(cast(str, value))
+
+    def from_hdf5(self, value: Any) -> str:
+        # h5py returns variable-length strings as bytes
+        if isinstance(value, bytes):
+            return value.decode("utf-8")
+        return str(value)
+
+    def from_hdf5_to_numpy(self, value: Any) -> np.object_:
+        return cast(np.object_, self.from_hdf5(value))
+
+    def default_hdf5(self) -> Any:
+        return ""
+
+
+string_converter = StringConverter()
+
+
+class BytesConverter(Hdf5Converter[bytes, np.object_]):
+    def __init__(self) -> None:
+        super().__init__(BYTES_DTYPE, np.object_)
+
+    def to_hdf5(self, value: bytes) -> Any:
+        if not isinstance(value, (bytes, bytearray)):
+            raise ValueError(f"Value in not bytes: {value}")
+        return np.frombuffer(value, dtype=np.dtype("V1"))
+
+    def numpy_to_hdf5(self, value: np.object_) -> Any:
+        return self.to_hdf5(cast(bytes, value))
+
+    def from_hdf5(self, value: Any) -> bytes:
+        return cast(np.ndarray, value).tobytes()
+
+    def from_hdf5_to_numpy(self, value: Any) -> np.object_:
+        return cast(np.object_, self.from_hdf5(value))
+
+    def default_hdf5(self) -> Any:
+        return np.empty(0, dtype=np.dtype("V1"))
+
+
+bytes_converter = BytesConverter()
+
+# UUIDs are stored as 16-byte HDF5 opaque values
+UUID_DTYPE = np.dtype("V16")
+
+
+class UuidConverter(Hdf5Converter[uuid.UUID, np.object_]):
+    def __init__(self) -> None:
+        super().__init__(UUID_DTYPE, np.object_)
+
+    def to_hdf5(self, value: uuid.UUID) -> Any:
+        if not isinstance(value, uuid.UUID):
+            raise ValueError(f"Value in not a UUID: {value}")
+        return np.void(value.bytes)
+
+    def numpy_to_hdf5(self, value: np.object_) -> Any:
+        return self.to_hdf5(cast(uuid.UUID, value))
+
+    def from_hdf5(self, value: Any) -> uuid.UUID:
+        return uuid.UUID(bytes=cast(np.void, value).tobytes())
+
+    def from_hdf5_to_numpy(self, value: Any) -> np.object_:
+        return cast(np.object_, self.from_hdf5(value))
+
+
+uuid_converter = UuidConverter()
+
+EPOCH_ORDINAL_DAYS = datetime.date(1970, 1, 1).toordinal()
+DATETIME_DAYS_DTYPE = np.dtype("datetime64[D]")
+TIMEDELTA_NANOSECONDS_DTYPE = np.dtype("timedelta64[ns]")
+DATETIME_NANOSECONDS_DTYPE = np.dtype("datetime64[ns]")
+
+
+class DateConverter(Hdf5Converter[datetime.date, np.datetime64]):
+    """Dates are stored as an int32 number of days since the epoch."""
+
+    def __init__(self) -> None:
+        super().__init__(np.int32, DATETIME_DAYS_DTYPE)
+
+    def to_hdf5(self, value: datetime.date) -> Any:
+        if isinstance(value, datetime.date):
+            return value.toordinal() - EPOCH_ORDINAL_DAYS
+        if not isinstance(value, np.datetime64):
+            raise ValueError(
+                f"Expected datetime.date or numpy.datetime64, got {type(value)}"
+            )
+        return self.numpy_to_hdf5(value)
+
+    def numpy_to_hdf5(self, value: np.datetime64) -> Any:
+        return value.astype(DATETIME_DAYS_DTYPE).astype(np.int32)
+
+    def from_hdf5(self, value: Any) -> datetime.date:
+        return datetime.date.fromordinal(int(value) + EPOCH_ORDINAL_DAYS)
+
+    def from_hdf5_to_numpy(self, value: Any) -> np.datetime64:
+        return np.datetime64(int(value), "D")
+
+
+date_converter = DateConverter()
+
+
+class TimeConverter(Hdf5Converter[Time, np.timedelta64]):
+    """Times are stored as an int64 number of nanoseconds since midnight."""
+
+    def __init__(self) -> None:
+        super().__init__(np.int64, TIMEDELTA_NANOSECONDS_DTYPE)
+
+    def to_hdf5(self, value: Time) -> Any:
+        if isinstance(value, Time):
+            return self.numpy_to_hdf5(value.numpy_value)
+        if isinstance(value, datetime.time):
+            return self.numpy_to_hdf5(Time.from_time(value).numpy_value)
+        if not isinstance(value, np.timedelta64):
+            raise ValueError(
+                f"Expected a Time, datetime.time or np.timedelta64, got {type(value)}"
+            )
+        return self.numpy_to_hdf5(value)
+
+    def numpy_to_hdf5(self, value: np.timedelta64) -> Any:
+        return value.astype(TIMEDELTA_NANOSECONDS_DTYPE).astype(np.int64)
+
+    def from_hdf5(self, value: Any) -> Time:
+        return Time(int(value))
+
+    def from_hdf5_to_numpy(self, value: Any) -> np.timedelta64:
+        return np.timedelta64(int(value), "ns")
+
+
+time_converter = TimeConverter()
+
+
+class DateTimeConverter(Hdf5Converter[DateTime, np.datetime64]):
+    """Datetimes are stored as an int64 number of nanoseconds since the epoch,
+    ignoring leap seconds."""
+
+    def __init__(self) -> None:
+        super().__init__(np.int64, DATETIME_NANOSECONDS_DTYPE)
+
+    def to_hdf5(self, value: DateTime) -> Any:
+        if isinstance(value, DateTime):
+            return self.numpy_to_hdf5(value.numpy_value)
+        if isinstance(value, datetime.datetime):
+            return self.numpy_to_hdf5(DateTime.from_datetime(value).numpy_value)
+        if not isinstance(value, np.datetime64):
+            raise ValueError(
+                f"Expected datetime.datetime or numpy.datetime64, got {type(value)}"
+            )
+        return self.numpy_to_hdf5(value)
+
+    def numpy_to_hdf5(self, value: np.datetime64) -> Any:
+        return value.astype(DATETIME_NANOSECONDS_DTYPE).astype(np.int64)
+
+    def from_hdf5(self, value: Any) -> DateTime:
+        return DateTime(int(value))
+
+    def from_hdf5_to_numpy(self, value: Any) -> np.datetime64:
+        return np.datetime64(int(value), "ns")
+
+
+datetime_converter = DateTimeConverter()
+
+
+class DurationConverter(Hdf5Converter[np.timedelta64, np.timedelta64]):
+    """Durations are stored as an int64 number of nanoseconds."""
+
+    def __init__(self) -> None:
+        super().__init__(np.int64, TIMEDELTA_NANOSECONDS_DTYPE)
+
+    def to_hdf5(self, value: np.timedelta64) -> Any:
+        if isinstance(value, datetime.timedelta):
+            return self.numpy_to_hdf5(np.timedelta64(value))
+        if not isinstance(value, np.timedelta64):
+            raise ValueError(
+                f"Expected datetime.timedelta or numpy.timedelta64, got {type(value)}"
+            )
+        return self.numpy_to_hdf5(value)
+
+    def numpy_to_hdf5(self, value: np.timedelta64) -> Any:
+        return value.astype(TIMEDELTA_NANOSECONDS_DTYPE).astype(np.int64)
+
+    def from_hdf5(self, value: Any) -> np.timedelta64:
+        return self.from_hdf5_to_numpy(value)
+
+    def from_hdf5_to_numpy(self, value: Any) -> np.timedelta64:
+        return np.timedelta64(int(value), "ns")
+
+
+duration_converter = DurationConverter()
+
+TEnum = TypeVar("TEnum", bound=Enum)
+
+
+class EnumConverter(Generic[TEnum, T_NP], Hdf5Converter[TEnum, T_NP]):
+    """Enums are stored as HDF5 enum types, labeled with the symbols from the model."""
+
+    def __init__(
+        self,
+        enum_type: type[TEnum],
+        numpy_type: type,
+        name_to_value: dict[str, TEnum],
+    ) -> None:
+        super().__init__(
+            h5py.enum_dtype(
+                {name: value.value for name, value in name_to_value.items()},
+                basetype=numpy_type,
+            ),
+            numpy_type,
+        )
+        self._enum_type = enum_type
+
+    def to_hdf5(self, value: TEnum) -> Any:
+        if not isinstance(value, self._enum_type):
+            raise ValueError(f"Value in not an enum or not the right type: {value}")
+        return value.value
+
+    def numpy_to_hdf5(self, value: T_NP) -> Any:
+        return value
+
+    def from_hdf5(self, value: Any) -> TEnum:
+        return self._enum_type(int(value))
+
+    def from_hdf5_to_numpy(self, value: Any) -> T_NP:
+        return self._overall_dtype.type(value)
+
+
+TFlag = TypeVar("TFlag", bound=IntFlag)
+
+
+class FlagsConverter(Generic[TFlag, T_NP], Hdf5Converter[TFlag, T_NP]):
+    """Flags are stored as their underlying integer type."""
+
+    def __init__(self, enum_type: type[TFlag], numpy_type: type) -> None:
+        super().__init__(numpy_type, numpy_type)
+        self._enum_type = enum_type
+
+    def to_hdf5(self, value: TFlag) -> Any:
+        if not isinstance(value, self._enum_type):
+            raise ValueError(f"Value in not an enum or not the right type: {value}")
+        return value.value
+
+    def numpy_to_hdf5(self, value: T_NP) -> Any:
+        return value
+
+    def from_hdf5(self, value: Any) -> TFlag:
+        return self._enum_type(int(value))
+
+    def from_hdf5_to_numpy(self, value: Any) -> T_NP:
+        return self._overall_dtype.type(value)
+
+    def is_trivially_convertible(self) -> bool:
+        return True
+
+
+class OptionalConverter(Generic[T, T_NP], Hdf5Converter[Optional[T], np.void]):
+    def __init__(self, element_converter: Hdf5Converter[T, T_NP]) -> None:
+        super().__init__(
+            [
+                ("has_value", HBOOL_DTYPE),
+                ("value", element_converter.hdf5_dtype()),
+            ],
+            [("has_value", np.bool_), ("value", element_converter.overall_dtype())],
+        )
+        self._element_converter = element_converter
+        self._none = cast(np.void, np.zeros((), dtype=self.overall_dtype())[()])
+
+    def to_hdf5(self, value: Optional[T]) -> Any:
+        if value is None:
+            return self.default_hdf5()
+        return (1, self._element_converter.to_hdf5(value))
+
+    def numpy_to_hdf5(self, value: np.void) -> Any:
+        if not value["has_value"]:
+            return self.default_hdf5()
+        return (1, self._element_converter.numpy_to_hdf5(value["value"]))
+
+    def from_hdf5(self, value: Any) -> Optional[T]:
+        if not value["has_value"]:
+            return None
+        return self._element_converter.from_hdf5(value["value"])
+
+    def from_hdf5_to_numpy(self, value: Any) -> np.void:
+        if not value["has_value"]:
+            return self._none
+        return cast(
+            np.void,
+            (True, self._element_converter.from_hdf5_to_numpy(value["value"])),
+        )
+
+    def default_hdf5(self) -> Any:
+        return (0, self._element_converter.default_hdf5())
+
+
+class UnionConverter(Hdf5Converter[T, np.object_]):
+    """Unions are stored as a compound type with a `$type` enum field holding the
+    index of the case (-1 for null) and one field per case, named after its tag."""
+
+    def __init__(
+        self,
+        union_type: type,
+        cases: list[Optional[tuple[type, Hdf5Converter[Any, Any]]]],
+    ) -> None:
+        self._union_type = union_type
+        self._nullable = cases[0] is None
+        self._cases = cast(
+            list[tuple[type, Hdf5Converter[Any, Any]]],
+            [case for case in cases if case is not None],
+        )
+        self._tags: list[str] = [
+            cast(str, case_type.tag)  # pyright: ignore [reportAttributeAccessIssue]
+            for case_type, _ in self._cases
+        ]
+        self._type_dtype = UnionConverter._type_enum_dtype(self._nullable, self._tags)
+        super().__init__(
+            [("$type", self._type_dtype)]
+            + [
+                (tag, converter.hdf5_dtype())
+                for tag, (_, converter) in zip(self._tags, self._cases)
+            ],
+            np.object_,
+        )
+
+    @staticmethod
+    def _type_enum_dtype(nullable: bool, tags: list[str]) -> np.dtype[Any]:
+        labels: dict[str, int] = {"null": -1} if nullable else {}
+        labels.update({tag: i for i, tag in enumerate(tags)})
+        return h5py.enum_dtype(labels, basetype=np.int8)
+
+    def index_dtype(self) -> np.dtype[Any]:
+        """The element type of the `$index` dataset of a stream of this union."""
+        return np.dtype(
+            [("type", self._type_dtype), ("offset", np.uint64)], align=True
+        )
+
+    def case_tags(self) -> list[str]:
+        return self._tags
+
+    def case_converters(self) -> list[Hdf5Converter[Any, Any]]:
+        return [converter for _, converter in self._cases]
+
+    def case_index(self, value: T) -> int:
+        """Returns the index of the case of the value, or -1 for None."""
+        if value is None:
+            if self._nullable:
+                return -1
+            raise ValueError("None is not a valid for this union type")
+
+        if not isinstance(value, self._union_type):
+            raise ValueError(
+                f"Expected union value of type {self._union_type} but got {type(value)}"
+            )
+
+        return cast(int, value.index)  # pyright: ignore [reportAttributeAccessIssue]
+
+    def case_from_hdf5(self, index: int, value: Any) -> T:
+        if index < 0:
+            if self._nullable:
+                return None  # type: ignore
+            raise ValueError(f"Unexpected union case index {index}")
+
+        case_type, converter = self._cases[index]
+        return case_type(converter.from_hdf5(value))
+
+    def to_hdf5(self, value: T) -> Any:
+        index = self.case_index(value)
+        fields: list[Any] = [index]
+        for i, (_, converter) in enumerate(self._cases):
+            if i == index:
+                fields.append(
+                    converter.to_hdf5(
+                        value.value  # pyright: ignore [reportAttributeAccessIssue]
+                    )
+                )
+            else:
+                fields.append(converter.default_hdf5())
+        return tuple(fields)
+
+    def numpy_to_hdf5(self, value: np.object_) -> Any:
+        return self.to_hdf5(cast(T, value))
+
+    def from_hdf5(self, value: Any) -> T:
+        index = int(value["$type"])
+        if index < 0:
+            return self.case_from_hdf5(index, None)
+        return self.case_from_hdf5(index, value[self._tags[index]])
+
+    def from_hdf5_to_numpy(self, value: Any) -> np.object_:
+        return self.from_hdf5(value)  # type: ignore
+
+    def default_hdf5(self) -> Any:
+        return tuple(
+            [-1 if self._nullable else 0]
+            + [converter.default_hdf5() for _, converter in self._cases]
+        )
+
+
+class VectorConverter(Generic[T, T_NP], Hdf5Converter[list[T], np.object_]):
+    """Vectors are stored as HDF5 variable-length sequences."""
+
+    def __init__(self, element_converter: Hdf5Converter[T, T_NP]) -> None:
+        super().__init__(h5py.vlen_dtype(element_converter.hdf5_dtype()), np.object_)
+        self._element_converter = element_converter
+
+    def to_hdf5(self, value: list[T]) -> Any:
+        if isinstance(value, np.ndarray):
+            return numpy_to_hdf5_array(self._element_converter, value)
+        if not isinstance(value, list):
+            raise ValueError(f"Value in not a list: {value}")
+        return to_hdf5_array(self._element_converter, value)
+
+    def numpy_to_hdf5(self, value: np.object_) -> Any:
+        return self.to_hdf5(cast(list[T], value))
+
+    def from_hdf5(self, value: Any) -> list[T]:
+        return [self._element_converter.from_hdf5(v) for v in value]
+
+    def from_hdf5_to_numpy(self, value: Any) -> np.object_:
+        return cast(np.object_, self.from_hdf5(value))
+
+    def default_hdf5(self) -> Any:
+        return np.empty(0, dtype=self._element_converter.hdf5_dtype())
+
+
+class FixedVectorConverter(Generic[T, T_NP], Hdf5Converter[list[T], np.object_]):
+    """Fixed-length vectors are stored as HDF5 array types."""
+
+    def __init__(self, element_converter: Hdf5Converter[T, T_NP], length: int) -> None:
+        super().__init__(
+            np.dtype((element_converter.hdf5_dtype(), length)),
+            np.dtype((element_converter.overall_dtype(), length)),
+        )
+        self._element_converter = element_converter
+        self._length = length
+
+    def to_hdf5(self, value: list[T]) -> Any:
+        if len(value) != self._length:
+            raise ValueError(
+                f"Expected a list of length {self._length}, got {len(value)}"
+            )
+        if isinstance(value, np.ndarray):
+            return numpy_to_hdf5_array(self._element_converter, value)
+        return to_hdf5_array(self._element_converter, value)
+
+    def numpy_to_hdf5(self, value: np.object_) -> Any:
+        return self.to_hdf5(cast(list[T], value))
+
+    def from_hdf5(self, value: Any) -> list[T]:
+        return [self._element_converter.from_hdf5(v) for v in value]
+
+    def from_hdf5_to_numpy(self, value: Any) -> np.object_:
+        return cast(
+            np.object_, from_hdf5_to_numpy_array(self._element_converter, value)
+        )
+
+    def default_hdf5(self) -> Any:
+        return to_hdf5_array(
+            _DefaultValueConverter(self._element_converter),
+            [None] * self._length,
+        )
+
+    def is_trivially_convertible(self) -> bool:
+        return self._element_converter.is_trivially_convertible()
+
+
+class _DefaultValueConverter(Hdf5Converter[Any, Any]):
+    """Produces the default HDF5 value of another converter for every value."""
+
+    def __init__(self, converter: Hdf5Converter[Any, Any]) -> None:
+        super().__init__(converter.hdf5_dtype(), converter.overall_dtype())
+        self._converter = converter
+
+    def to_hdf5(self, value: Any) -> Any:
+        return self._converter.default_hdf5()
+
+    def numpy_to_hdf5(self, value: Any) -> Any:
+        return self._converter.default_hdf5()
+
+    def from_hdf5(self, value: Any) -> Any:
+        raise NotImplementedError
+
+    def from_hdf5_to_numpy(self, value: Any) -> Any:
+        raise NotImplementedError
+
+
+TKey = TypeVar("TKey")
+TKey_NP = TypeVar("TKey_NP", bound=np.generic)
+TValue = TypeVar("TValue")
+TValue_NP = TypeVar("TValue_NP", bound=np.generic)
+
+
+class MapConverter(
+    Generic[TKey, TKey_NP, TValue, TValue_NP],
+    Hdf5Converter[dict[TKey, TValue], np.object_],
+):
+    """Maps are stored as variable-length sequences of key/value compounds."""
+
+    def __init__(
+        self,
+        key_converter: Hdf5Converter[TKey, TKey_NP],
+        value_converter: Hdf5Converter[TValue, TValue_NP],
+    ) -> None:
+        self._pair_dtype = np.dtype(
+            [
+                ("key", key_converter.hdf5_dtype()),
+                ("value", value_converter.hdf5_dtype()),
+            ]
+        )
+        super().__init__(h5py.vlen_dtype(self._pair_dtype), np.object_)
+        self._key_converter = key_converter
+        self._value_converter = value_converter
+
+    def to_hdf5(self, value: dict[TKey, TValue]) -> Any:
+        if not isinstance(value, dict):
+            raise ValueError(f"Value in not a dict: {value}")
+
+        result = np.empty(len(value), dtype=self._pair_dtype)
+        for i, (k, v) in enumerate(value.items()):
+            result[i] = (
+                self._key_converter.to_hdf5(k),
+                self._value_converter.to_hdf5(v),
+            )
+        return result
+
+    def numpy_to_hdf5(self, value: np.object_) -> Any:
+        return self.to_hdf5(cast(dict[TKey, TValue], value))
+
+    def from_hdf5(self, value: Any) -> dict[TKey, TValue]:
+        return {
+            self._key_converter.from_hdf5(
+                pair["key"]
+            ): self._value_converter.from_hdf5(pair["value"])
+            for pair in value
+        }
+
+    def from_hdf5_to_numpy(self, value: Any) -> np.object_:
+        return cast(np.object_, self.from_hdf5(value))
+
+    def default_hdf5(self) -> Any:
+        return np.empty(0, dtype=self._pair_dtype)
+
+
+class NDArrayConverterBase(
+    Generic[T, T_NP], Hdf5Converter[npt.NDArray[Any], np.object_]
+):
+    def __init__(
+        self,
+        hdf5_dtype: npt.DTypeLike,
+        overall_dtype: npt.DTypeLike,
+        element_converter: Hdf5Converter[T, T_NP],
+    ) -> None:
+        super().__init__(hdf5_dtype, overall_dtype)
+        self._element_converter = element_converter
+        self._array_dtype, self._subarray_shape = (
+            NDArrayConverterBase._get_dtype_and_subarray_shape(
+                element_converter.overall_dtype()
+            )
+        )
+
+    @staticmethod
+    def _get_dtype_and_subarray_shape(
+        dtype: np.dtype[Any],
+    ) -> tuple[np.dtype[Any], tuple[int, ...]]:
+        if dtype.subdtype is None:
+            return dtype, ()
+        subres = NDArrayConverterBase._get_dtype_and_subarray_shape(dtype.subdtype[0])
+        return (subres[0], dtype.subdtype[1] + subres[1])
+
+    def _split_shape(self, value: npt.NDArray[Any]) -> tuple[int, ...]:
+        """Returns the shape of the array, without the trailing subarray dimensions
+        of the element type."""
+        if len(self._subarray_shape) == 0:
+            return value.shape
+
+        if (
+            len(value.shape) < len(self._subarray_shape)
+            or value.shape[-len(self._subarray_shape) :] != self._subarray_shape
+        ):
+            raise ValueError(
+                f"The array is required to have shape (..., {(', '.join((str(i) for i in self._subarray_shape)))})"
+            )
+        return value.shape[: -len(self._subarray_shape)]
+
+    def _data_to_hdf5(self, value: npt.NDArray[Any]) -> npt.NDArray[Any]:
+        if not isinstance(value, np.ndarray):
+            raise ValueError(f"Value in not an ndarray: {value}")
+
+        flat = value.reshape((-1,) + self._subarray_shape)
+        return numpy_to_hdf5_array(self._element_converter, flat)
+
+    def _data_from_hdf5(
+        self, data: npt.NDArray[Any], shape: tuple[int, ...]
+    ) -> npt.NDArray[Any]:
+        result = from_hdf5_to_numpy_array(self._element_converter, data)
+        return result.reshape(shape + self._subarray_shape)
+
+    def numpy_to_hdf5(self, value: np.object_) -> Any:
+        return self.to_hdf5(cast(npt.NDArray[Any], value))
+
+    def from_hdf5_to_numpy(self, value: Any) -> np.object_:
+        return cast(np.object_, self.from_hdf5(value))
+
+
+class NDArrayConverter(Generic[T, T_NP], NDArrayConverterBase[T, T_NP]):
+    """Arrays with a known number of dimensions are stored as a compound of their
+    dimensions and a variable-length sequence of their data. One-dimensional
+    arrays are stored as just the variable-length sequence."""
+
+    def __init__(self, element_converter: Hdf5Converter[T, T_NP], ndims: int) -> None:
+        data_dtype = h5py.vlen_dtype(element_converter.hdf5_dtype())
+        if ndims == 1:
+            hdf5_dtype = data_dtype
+        else:
+            hdf5_dtype = np.dtype(
+                [("dimensions", (SIZE_DTYPE, (ndims,))), ("data", data_dtype)]
+            )
+        super().__init__(hdf5_dtype, np.object_, element_converter)
+        self._ndims = ndims
+
+    def to_hdf5(self, value: npt.NDArray[Any]) -> Any:
+        shape = self._split_shape(value)
+        if len(shape) != self._ndims:
+            raise ValueError(f"Expected {self._ndims} dimensions, got {len(shape)}")
+
+        data = self._data_to_hdf5(value)
+        if self._ndims == 1:
+            return data
+        return (shape, data)
+
+    def from_hdf5(self, value: Any) -> npt.NDArray[Any]:
+        if self._ndims == 1:
+            return self._data_from_hdf5(value, (len(value),))
+
+        shape = tuple(int(d) for d in value["dimensions"])
+        return self._data_from_hdf5(value["data"], shape)
+
+    def default_hdf5(self) -> Any:
+        data = np.empty(0, dtype=self._element_converter.hdf5_dtype())
+        if self._ndims == 1:
+            return data
+        return ((0,) * self._ndims, data)
+
+
+class DynamicNDArrayConverter(NDArrayConverterBase[T, T_NP]):
+    """Arrays with an unknown number of dimensions are stored as a compound of
+    two variable-length sequences: their dimensions and their data."""
+
+    def __init__(self, element_converter: Hdf5Converter[T, T_NP]) -> None:
+        super().__init__(
+            [
+                ("dimensions", h5py.vlen_dtype(SIZE_DTYPE)),
+                ("data", h5py.vlen_dtype(element_converter.hdf5_dtype())),
+            ],
+            np.object_,
+            element_converter,
+        )
+
+    def to_hdf5(self, value: npt.NDArray[Any]) -> Any:
+        shape = self._split_shape(value)
+        return (np.array(shape, dtype=SIZE_DTYPE), self._data_to_hdf5(value))
+
+    def from_hdf5(self, value: Any) -> npt.NDArray[Any]:
+        shape = tuple(int(d) for d in value["dimensions"])
+        return self._data_from_hdf5(value["data"], shape)
+
+    def default_hdf5(self) -> Any:
+        return (
+            np.empty(0, dtype=SIZE_DTYPE),
+            np.empty(0, dtype=self._element_converter.hdf5_dtype()),
+        )
+
+
+class FixedNDArrayConverter(Generic[T, T_NP], NDArrayConverterBase[T, T_NP]):
+    """Fixed-size arrays are stored as HDF5 array types."""
+
+    def __init__(
+        self, element_converter: Hdf5Converter[T, T_NP], shape: tuple[int, ...]
+    ) -> None:
+        super().__init__(
+            np.dtype((element_converter.hdf5_dtype(), shape)),
+            np.dtype((element_converter.overall_dtype(), shape)),
+            element_converter,
+        )
+        self._shape = shape
+
+    def to_hdf5(self, value: npt.NDArray[Any]) -> Any:
+        required_shape = self._shape + self._subarray_shape
+        if value.shape != required_shape:
+            raise ValueError(f"Expected shape {required_shape}, got {value.shape}")
+
+        return self._data_to_hdf5(value).reshape(self._shape + self._hdf5_subarray_shape())
+
+    def from_hdf5(self, value: Any) -> npt.NDArray[Any]:
+        data = np.asarray(value).reshape((-1,) + self._hdf5_subarray_shape())
+        return self._data_from_hdf5(data, self._shape)
+
+    def default_hdf5(self) -> Any:
+        return np.zeros((), dtype=self.hdf5_dtype())
+
+    def is_trivially_convertible(self) -> bool:
+        return self._element_converter.is_trivially_convertible()
+
+    def _hdf5_subarray_shape(self) -> tuple[int, ...]:
+        return NDArrayConverterBase._get_dtype_and_subarray_shape(
+            self._element_converter.hdf5_dtype()
+        )[1]
+
+
+class RecordConverter(Hdf5Converter[T, np.void]):
+    """Records are stored as compound types whose member names are the field
+    names from the model."""
+
+    def __init__(
+        self, field_converters: list[tuple[str, str, Hdf5Converter[Any, Any]]]
+    ) -> None:
+        super().__init__(
+            [
+                (hdf5_name, converter.hdf5_dtype())
+                for hdf5_name, _, converter in field_converters
+            ],
+            np.dtype(
+                [
+                    (python_name, converter.overall_dtype())
+                    for _, python_name, converter in field_converters
+                ],
+                align=True,
+            ),
+        )
+        self._field_converters = field_converters
+
+    def _to_hdf5(self, *values: Any) -> Any:
+        return tuple(
+            converter.to_hdf5(values[i])
+            for i, (_, _, converter) in enumerate(self._field_converters)
+        )
+
+    def numpy_to_hdf5(self, value: np.void) -> Any:
+        return tuple(
+            converter.numpy_to_hdf5(value[python_name])
+            for _, python_name, converter in self._field_converters
+        )
+
+    def _from_hdf5(self, value: Any) -> tuple[Any, ...]:
+        return tuple(
+            converter.from_hdf5(value[hdf5_name])
+            for hdf5_name, _, converter in self._field_converters
+        )
+
+    def from_hdf5_to_numpy(self, value: Any) -> np.void:
+        return cast(
+            np.void,
+            tuple(
+                converter.from_hdf5_to_numpy(value[hdf5_name])
+                for hdf5_name, _, converter in self._field_converters
+            ),
+        )
+
+    def default_hdf5(self) -> Any:
+        return tuple(
+            converter.default_hdf5() for _, _, converter in self._field_converters
+        )
+
+
+def _create_dataset(
+    group: h5py.Group, name: str, dtype: np.dtype[Any], stream: bool
+) -> h5py.Dataset:
+    if name in group:
+        raise ValueError(
+            f"Unable to create dataset '{name}' for protocol because it already exists."
+        )
+
+    if not stream:
+        return group.create_dataset(name, shape=(), dtype=dtype)
+
+    chunk_rows = max(1, CHUNK_SIZE_BYTES // max(1, dtype.itemsize))
+    return group.create_dataset(
+        name, shape=(0,), maxshape=(None,), chunks=(chunk_rows,), dtype=dtype
+    )
+
+
+def write_scalar_dataset(
+    group: h5py.Group, name: str, converter: Hdf5Converter[T, Any], value: T
+) -> None:
+    dtype = converter.hdf5_dtype()
+    dataset = _create_dataset(group, name, dtype, False)
+    data = np.empty(1, dtype=dtype)
+    data[0] = converter.to_hdf5(value)
+    dataset.id.write(
+        h5py.h5s.create(h5py.h5s.SCALAR),
+        dataset.id.get_space(),
+        data,
+        mtype=h5py.h5t.py_create(dtype),
+    )
+
+
+def read_scalar_dataset(
+    group: h5py.Group, name: str, converter: Hdf5Converter[T, Any]
+) -> T:
+    if name not in group:
+        raise ValueError(f"Expected protocol step '{name}' not found.")
+
+    dtype = converter.hdf5_dtype()
+    dataset = cast(h5py.Dataset, group[name])
+    data = np.empty(1, dtype=dtype)
+    dataset.id.read(
+        h5py.h5s.create(h5py.h5s.SCALAR),
+        dataset.id.get_space(),
+        data,
+        mtype=h5py.h5t.py_create(dtype),
+    )
+    return converter.from_hdf5(data[0])
+
+
+class DatasetWriter:
+    """Appends rows to a chunked, one-dimensional dataset."""
+
+    def __init__(self, group: h5py.Group, name: str, dtype: np.dtype[Any]) -> None:
+        self._dataset = _create_dataset(group, name, dtype, True)
+        self._mtype = h5py.h5t.py_create(dtype)
+        self._offset = 0
+
+    def append(self, rows: npt.NDArray[Any]) -> None:
+        count = len(rows)
+        if count == 0:
+            return
+
+        self._dataset.resize((self._offset + count,))
+        file_space = self._dataset.id.get_space()
+        file_space.select_hyperslab((self._offset,), (count,))
+        self._dataset.id.write(
+            h5py.h5s.create_simple((count,)),
+            file_space,
+            np.ascontiguousarray(rows),
+            mtype=self._mtype,
+        )
+        self._offset += count
+
+
+class DatasetReader:
+    """Reads rows from a one-dimensional dataset in batches."""
+
+    def __init__(self, group: h5py.Group, name: str, dtype: np.dtype[Any]) -> None:
+        if name not in group:
+            raise ValueError(f"Expected protocol step '{name}' not found.")
+
+        self._dataset = cast(h5py.Dataset, group[name])
+        self._dtype = dtype
+        self._mtype = h5py.h5t.py_create(dtype)
+        self._total_rows = self._dataset.shape[0]
+        self._offset = 0
+
+    def read_batch(self, max_rows: int = BATCH_SIZE) -> Optional[npt.NDArray[Any]]:
+        count = min(max_rows, self._total_rows - self._offset)
+        if count <= 0:
+            return None
+
+        rows = np.empty(count, dtype=self._dtype)
+        file_space = self._dataset.id.get_space()
+        file_space.select_hyperslab((self._offset,), (count,))
+        self._dataset.id.read(
+            h5py.h5s.create_simple((count,)), file_space, rows, mtype=self._mtype
+        )
+        self._offset += count
+        return rows
+
+    def rows(self) -> Iterable[Any]:
+        while (batch := self.read_batch()) is not None:
+            yield from batch
+
+
+class StreamWriter(Generic[T]):
+    def __init__(
+        self, group: h5py.Group, name: str, converter: Hdf5Converter[T, Any]
+    ) -> None:
+        self._converter = converter
+        self._writer = DatasetWriter(group, name, converter.hdf5_dtype())
+
+    def write(self, values: Iterable[T]) -> None:
+        if isinstance(values, np.ndarray) and values.dtype == self._converter.overall_dtype():
+            self._writer.append(numpy_to_hdf5_array(self._converter, values))
+            return
+
+        batch: list[Any] = []
+        for value in values:
+            batch.append(value)
+            if len(batch) == BATCH_SIZE:
+                self._writer.append(to_hdf5_array(self._converter, batch))
+                batch = []
+
+        self._writer.append(to_hdf5_array(self._converter, batch))
+
+    def flush(self) -> None:
+        pass
+
+
+class UnionStreamWriter(Generic[T]):
+    """Writes a stream of unions to a group with one dataset per union case and
+    an `$index` dataset recording the case and offset of each item."""
+
+    def __init__(
+        self, group: h5py.Group, name: str, converter: UnionConverter[T]
+    ) -> None:
+        if name in group:
+            raise ValueError(
+                f"Unable to create group '{name}' for protocol because it already exists."
+            )
+
+        self._converter = converter
+        union_group = group.create_group(name)
+        self._index_dtype = converter.index_dtype()
+        self._index_writer = DatasetWriter(
+            union_group, UNION_INDEX_DATASET_NAME, self._index_dtype
+        )
+        self._case_converters = converter.case_converters()
+        self._case_writers = [
+            DatasetWriter(union_group, tag, case_converter.hdf5_dtype())
+            for tag, case_converter in zip(
+                converter.case_tags(), self._case_converters
+            )
+        ]
+        self._case_offsets = [0] * len(self._case_writers)
+        self._index_buffer: list[tuple[int, int]] = []
+        self._case_buffers: list[list[Any]] = [[] for _ in self._case_writers]
+
+    def write(self, values: Iterable[T]) -> None:
+        for value in values:
+            index = self._converter.case_index(value)
+            if index < 0:
+                self._index_buffer.append((index, 0))
+            else:
+                self._index_buffer.append((index, self._case_offsets[index]))
+                self._case_offsets[index] += 1
+                self._case_buffers[index].append(
+                    value.value  # pyright: ignore [reportAttributeAccessIssue]
+                )
+
+            if len(self._index_buffer) == BATCH_SIZE:
+                self.flush()
+
+        self.flush()
+
+    def flush(self) -> None:
+        for i, buffer in enumerate(self._case_buffers):
+            if len(buffer) > 0:
+                self._case_writers[i].append(
+                    to_hdf5_array(self._case_converters[i], buffer)
+                )
+                self._case_buffers[i] = []
+
+        if len(self._index_buffer) > 0:
+            self._index_writer.append(
+                np.array(self._index_buffer, dtype=self._index_dtype)
+            )
+            self._index_buffer = []
+
+
+def read_stream(
+    group: h5py.Group, name: str, converter: Hdf5Converter[T, Any]
+) -> Iterable[T]:
+    if isinstance(converter, UnionConverter):
+        yield from _read_union_stream(group, name, cast(UnionConverter[T], converter))
+        return
+
+    reader = DatasetReader(group, name, converter.hdf5_dtype())
+    for row in reader.rows():
+        yield converter.from_hdf5(row)
+
+
+def _read_union_stream(
+    group: h5py.Group, name: str, converter: UnionConverter[T]
+) -> Iterable[T]:
+    if name not in group:
+        raise ValueError(f"Expected protocol step '{name}' not found.")
+
+    union_group = cast(h5py.Group, group[name])
+    index_reader = DatasetReader(
+        union_group, UNION_INDEX_DATASET_NAME, converter.index_dtype()
+    )
+    case_rows = [
+        iter(DatasetReader(union_group, tag, case_converter.hdf5_dtype()).rows())
+        for tag, case_converter in zip(
+            converter.case_tags(), converter.case_converters()
+        )
+    ]
+
+    for entry in index_reader.rows():
+        index = int(entry["type"])
+        if index < 0:
+            yield converter.case_from_hdf5(index, None)
+        else:
+            yield converter.case_from_hdf5(index, next(case_rows[index]))
+
+
+class Hdf5ProtocolWriter(ABC):
+    def __init__(self, file: File, group_name: str, schema: str) -> None:
+        if isinstance(file, h5py.File):
+            self._file = file
+            self._owns_file = False
+        else:
+            self._file = h5py.File(file, "a")
+            self._owns_file = True
+
+        if group_name in self._file:
+            raise ValueError(
+                f"Unable to create group '{group_name}' for protocol because it already exists."
+            )
+
+        self._group = self._file.create_group(group_name)
+        self._group.attrs.create(
+            FORMAT_VERSION_ATTRIBUTE_NAME, CURRENT_HDF5_FORMAT_VERSION, dtype=np.int32
+        )
+        write_scalar_dataset(self._group, SCHEMA_DATASET_NAME, string_converter, schema)
+        self._stream_writer: Optional[Union[StreamWriter[Any], UnionStreamWriter[Any]]] = None
+
+    def _close(self) -> None:
+        self._end_stream()
+        if self._owns_file:
+            self._file.close()
+        else:
+            self._file.flush()
+
+    def _end_stream(self) -> None:
+        if self._stream_writer is not None:
+            self._stream_writer.flush()
+            self._stream_writer = None
+
+    def _write_scalar(
+        self, name: str, converter: Hdf5Converter[T, Any], value: T
+    ) -> None:
+        write_scalar_dataset(self._group, name, converter, value)
+
+    def _write_stream(
+        self, name: str, converter: Hdf5Converter[T, Any], values: Iterable[T]
+    ) -> None:
+        if self._stream_writer is None:
+            if isinstance(converter, UnionConverter):
+                self._stream_writer = UnionStreamWriter(
+                    self._group, name, cast(UnionConverter[T], converter)
+                )
+            else:
+                self._stream_writer = StreamWriter(self._group, name, converter)
+
+        self._stream_writer.write(values)
+
+
+class Hdf5ProtocolReader(ABC):
+    def __init__(self, file: File, group_name: str, schema: str) -> None:
+        if isinstance(file, h5py.File):
+            self._file = file
+            self._owns_file = False
+        else:
+            self._file = h5py.File(file, "r")
+            self._owns_file = True
+
+        if group_name not in self._file:
+            raise ValueError(
+                f"Unable to open group '{group_name}' for protocol because it does not exist."
+            )
+
+        self._group = cast(h5py.Group, self._file[group_name])
+        version = self._group.attrs.get(FORMAT_VERSION_ATTRIBUTE_NAME)
+        if version is None or int(version) != CURRENT_HDF5_FORMAT_VERSION:
+            raise ValueError("The data in the HDF5 file is not in the expected format.")
+
+        actual_schema = read_scalar_dataset(
+            self._group, SCHEMA_DATASET_NAME, string_converter
+        )
+        if actual_schema != schema:
+            raise ValueError(
+                "The schema of the data to be read is not compatible with the current protocol."
+            )
+
+    def _close(self) -> None:
+        if self._owns_file:
+            self._file.close()
+
+    def _read_scalar(self, name: str, converter: Hdf5Converter[T, Any]) -> T:
+        return read_scalar_dataset(self._group, name, converter)
+
+    def _read_stream(
+        self, name: str, converter: Hdf5Converter[T, Any]
+    ) -> Iterable[T]:
+        return read_stream(self._group, name, converter)
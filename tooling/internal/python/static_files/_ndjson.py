@@ -6,11 +6,13 @@
 # pyright: reportUnknownVariableType=false
 
 from abc import ABC, abstractmethod
+import base64
 import datetime
 from enum import IntFlag
 import io
 import json
-from typing import Any, Generic, Optional, TextIO, TypeVar, Union, cast
+import uuid
+from typing import Any, Callable, Generic, Optional, TextIO, TypeVar, Union, cast
 
 import numpy as np
 import numpy.typing as npt
@@ -454,6 +456,52 @@ class SizeConverter(JsonConverter[int, np.uint64]):
 size_converter = SizeConverter()
 
 
+class Float16Converter(JsonConverter[float, np.float16]):
+    def __init__(self) -> None:
+        super().__init__(np.float16)
+
+    def to_json(self, value: float) -> object:
+        if not isinstance(value, float):
+            raise ValueError(f"Value in not a 16-bit float: {value}")
+
+        return value
+
+    def numpy_to_json(self, value: np.float16) -> object:
+        return float(value)
+
+    def from_json(self, json_object: object) -> float:
+        return cast(float, json_object)
+
+    def from_json_to_numpy(self, json_object: object) -> np.float16:
+        return np.float16(cast(float, json_object))
+
+
+float16_converter = Float16Converter()
+
+
+class BFloat16Converter(JsonConverter[float, np.float32]):
+    def __init__(self) -> None:
+        super().__init__(np.float32)
+
+    def to_json(self, value: float) -> object:
+        if not isinstance(value, float):
+            raise ValueError(f"Value in not a bfloat16: {value}")
+
+        return value
+
+    def numpy_to_json(self, value: np.float32) -> object:
+        return float(value)
+
+    def from_json(self, json_object: object) -> float:
+        return cast(float, json_object)
+
+    def from_json_to_numpy(self, json_object: object) -> np.float32:
+        return np.float32(cast(float, json_object))
+
+
+bfloat16_converter = BFloat16Converter()
+
+
 class Float32Converter(JsonConverter[float, np.float32]):
     def __init__(self) -> None:
         super().__init__(np.float32)
@@ -574,6 +622,50 @@ class StringConverter(JsonConverter[str, np.object_]):
 string_converter = StringConverter()
 
 
+class BytesConverter(JsonConverter[bytes, np.object_]):
+    def __init__(self) -> None:
+        super().__init__(np.object_)
+
+    def to_json(self, value: bytes) -> object:
+        if not isinstance(value, (bytes, bytearray)):
+            raise ValueError(f"Value in not bytes: {value}")
+        return base64.b64encode(value).decode("ascii")
+
+    def numpy_to_json(self, value: np.object_) -> object:
+        return self.to_json(cast(bytes, value))
+
+    def from_json(self, json_object: object) -> bytes:
+        return base64.b64decode(cast(str, json_object), validate=True)
+
+    def from_json_to_numpy(self, json_object: object) -> np.object_:
+        return np.object_(self.from_json(json_object))  # pyright: ignore [reportReturnType]
+
+
+bytes_converter = BytesConverter()
+
+
+class UuidConverter(JsonConverter[uuid.UUID, np.object_]):
+    def __init__(self) -> None:
+        super().__init__(np.object_)
+
+    def to_json(self, value: uuid.UUID) -> object:
+        if not isinstance(value, uuid.UUID):
+            raise ValueError(f"Value in not a UUID: {value}")
+        return str(value)
+
+    def numpy_to_json(self, value: np.object_) -> object:
+        return self.to_json(cast(uuid.UUID, value))
+
+    def from_json(self, json_object: object) -> uuid.UUID:
+        return uuid.UUID(cast(str, json_object))
+
+    def from_json_to_numpy(self, json_object: object) -> np.object_:
+        return np.object_(self.from_json(json_object))  # pyright: ignore [reportReturnType]
+
+
+uuid_converter = UuidConverter()
+
+
 class DateConverter(JsonConverter[datetime.date, np.datetime64]):
     def __init__(self) -> None:
         super().__init__(np.datetime64)
@@ -645,6 +737,35 @@ class DateTimeConverter(JsonConverter[DateTime, np.datetime64]):
 
 datetime_converter = DateTimeConverter()
 
+TIMEDELTA_NANOSECONDS_DTYPE = np.dtype("timedelta64[ns]")
+
+
+class DurationConverter(JsonConverter[np.timedelta64, np.timedelta64]):
+    """Durations are written as an integer number of nanoseconds."""
+
+    def __init__(self) -> None:
+        super().__init__(np.timedelta64)
+
+    def to_json(self, value: np.timedelta64) -> object:
+        if isinstance(value, datetime.timedelta):
+            return self.numpy_to_json(np.timedelta64(value))
+        if not isinstance(value, np.timedelta64):
+            raise ValueError(f"Value in not a duration: {value}")
+
+        return self.numpy_to_json(value)
+
+    def numpy_to_json(self, value: np.timedelta64) -> object:
+        return int(value.astype(TIMEDELTA_NANOSECONDS_DTYPE).astype(np.int64))
+
+    def from_json(self, json_object: object) -> np.timedelta64:
+        return self.from_json_to_numpy(json_object)
+
+    def from_json_to_numpy(self, json_object: object) -> np.timedelta64:
+        return np.timedelta64(cast(int, json_object), "ns")
+
+
+duration_converter = DurationConverter()
+
 TEnum = TypeVar("TEnum", bound=OutOfRangeEnum)
 
 
@@ -848,6 +969,33 @@ class UnionConverter(JsonConverter[T, np.object_]):
         return self._cases[0] is None
 
 
+class RecursiveConverter(JsonConverter[T, np.object_]):
+    """Creates the converter of a recursive record on first use, so that
+    constructing a record converter does not recurse indefinitely."""
+
+    def __init__(self, converter_factory: Callable[[], JsonConverter[T, Any]]) -> None:
+        super().__init__(np.object_)
+        self._converter_factory = converter_factory
+        self._converter: Optional[JsonConverter[T, Any]] = None
+
+    def _get_converter(self) -> JsonConverter[T, Any]:
+        if self._converter is None:
+            self._converter = self._converter_factory()
+        return self._converter
+
+    def to_json(self, value: T) -> object:
+        return self._get_converter().to_json(value)
+
+    def numpy_to_json(self, value: np.object_) -> object:
+        return self.to_json(cast(T, value))
+
+    def from_json(self, json_object: object) -> T:
+        return self._get_converter().from_json(json_object)
+
+    def from_json_to_numpy(self, json_object: object) -> np.object_:
+        return self.from_json(json_object)  # type: ignore
+
+
 class VectorConverter(Generic[T, T_NP], JsonConverter[list[T], np.object_]):
     def __init__(self, element_converter: JsonConverter[T, T_NP]) -> None:
         super().__init__(np.object_)
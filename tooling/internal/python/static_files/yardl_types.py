@@ -6,7 +6,7 @@
 
 from abc import ABC
 from enum import Enum
-from typing import Annotated, Generic, TypeVar, Union
+from typing import Annotated, Any, Generic, Iterable, TypeVar, Union, overload
 import numpy as np
 import datetime
 import time
@@ -118,6 +118,24 @@ class DateTime:
     def __hash__(self) -> int:
         return hash(self._value)
 
+    def __add__(self, other: Union[np.timedelta64, datetime.timedelta]) -> "DateTime":
+        return DateTime(self._value + np.timedelta64(other, "ns"))
+
+    @overload
+    def __sub__(self, other: "DateTime") -> np.timedelta64: ...
+
+    @overload
+    def __sub__(
+        self, other: Union[np.timedelta64, datetime.timedelta]
+    ) -> "DateTime": ...
+
+    def __sub__(
+        self, other: Union["DateTime", np.timedelta64, datetime.timedelta]
+    ) -> Union[np.timedelta64, "DateTime"]:
+        if isinstance(other, DateTime):
+            return self._value - other._value
+        return DateTime(self._value - np.timedelta64(other, "ns"))
+
 
 class Time:
     """A basic time of day with nanosecond precision. It is not timezone-aware and is meant
@@ -223,6 +241,9 @@ class Time:
             else (isinstance(other, np.timedelta64) and self._value == other)
         )
 
+    def __sub__(self, other: "Time") -> np.timedelta64:
+        return self._value - other._value
+
 
 Int8 = Annotated[int, "Int8"]
 UInt8 = Annotated[int, "UInt8"]
@@ -233,6 +254,8 @@ UInt32 = Annotated[int, "UInt32"]
 Int64 = Annotated[int, "Int64"]
 UInt64 = Annotated[int, "UInt64"]
 Size = Annotated[int, "Size"]
+Float16 = Annotated[float, "Float16"]
+BFloat16 = Annotated[float, "BFloat16"]
 Float32 = Annotated[float, "Float32"]
 Float64 = Annotated[float, "Float64"]
 ComplexFloat = Annotated[complex, "ComplexFloat"]
@@ -280,6 +303,19 @@ def structural_equal(a: object, b: object) -> bool:
     return a == b
 
 
+def checked_sum(items: Iterable[Any], dtype: "type[np.integer[Any]]") -> int:
+    """Returns the sum of integer items, as computed by the sum() function in
+    computed fields. Raises OverflowError if an intermediate result does not fit
+    in dtype, rather than wrapping or growing without bound."""
+    info = np.iinfo(dtype)
+    res = 0
+    for item in items:
+        res += int(item)
+        if res < info.min or res > info.max:
+            raise OverflowError("sum() overflowed its result type")
+    return res
+
+
 _T = TypeVar("_T")
 
 
@@ -31,11 +31,17 @@ func WriteTypes(ns *dsl.Namespace, st dsl.SymbolTable, packageDir string) error
 		relativePath = "."
 	}
 
+	reImport := ""
+	if hasPatternConstraints(ns) {
+		reImport = "import re\n"
+	}
+
 	fmt.Fprintf(w, `
 import datetime
 import enum
-import types
+%simport types
 import typing
+import uuid
 
 import numpy as np
 import numpy.typing as npt
@@ -43,7 +49,7 @@ import numpy.typing as npt
 from %s import yardl_types as yardl
 from %s import _dtypes
 
-`, relativePath, relativePath)
+`, reImport, relativePath, relativePath)
 
 	for _, ref := range ns.GetAllChildReferences() {
 		fmt.Fprintf(w, "from %s import %s\n", relativePath, common.NamespaceIdentifierName(ref.Name))
@@ -60,6 +66,7 @@ from %s import _dtypes
 
 func writeTypes(w *formatting.IndentedWriter, st dsl.SymbolTable, ns *dsl.Namespace) {
 	writeTypeVars(w, ns)
+	writeConstants(w, ns)
 
 	unions := make(map[string]any)
 
@@ -102,6 +109,18 @@ func writeTypeVars(w *formatting.IndentedWriter, ns *dsl.Namespace) {
 	}
 }
 
+func writeConstants(w *formatting.IndentedWriter, ns *dsl.Namespace) {
+	for _, c := range ns.Constants {
+		common.WriteComment(w, c.Comment)
+		fmt.Fprintf(w, "%s: %s = ", common.ConstantIdentifierName(c.Name), common.TypeSyntax(c.Type, ns.Name))
+		writeExpression(w, c.Value, ns.Name, tailWrapper{}, nil)
+		w.WriteStringln("")
+	}
+	if len(ns.Constants) > 0 {
+		w.WriteStringln("\n")
+	}
+}
+
 func writeUnionClasses(w *formatting.IndentedWriter, td dsl.TypeDefinition, unions map[string]any) {
 	dsl.Visit(td, func(self dsl.Visitor, node dsl.Node) {
 		switch node := node.(type) {
@@ -142,10 +161,13 @@ func writeUnionClass(w *formatting.IndentedWriter, className string, typeParamet
 				continue
 			}
 
+			// The annotation is already a forward reference, so nested forward references are unquoted
+			caseTypeSyntax := strings.ReplaceAll(common.TypeSyntax(tc.Type, contextNamespace), "\"", "")
+
 			if len(typeParameters) > 0 {
-				fmt.Fprintf(w, "%s: typing.ClassVar[type[\"%s[%s, %s]\"]] # type: ignore\n", formatting.ToPascalCase(tc.Tag), unionCaseType, typeParameters, common.TypeSyntax(tc.Type, contextNamespace))
+				fmt.Fprintf(w, "%s: typing.ClassVar[type[\"%s[%s, %s]\"]] # type: ignore\n", formatting.ToPascalCase(tc.Tag), unionCaseType, typeParameters, caseTypeSyntax)
 			} else {
-				fmt.Fprintf(w, "%s: typing.ClassVar[type[\"%s[%s]\"]]\n", formatting.ToPascalCase(tc.Tag), unionCaseType, common.TypeSyntax(tc.Type, contextNamespace))
+				fmt.Fprintf(w, "%s: typing.ClassVar[type[\"%s[%s]\"]]\n", formatting.ToPascalCase(tc.Tag), unionCaseType, caseTypeSyntax)
 			}
 		}
 	})
@@ -189,11 +211,18 @@ func writeNamedType(w *formatting.IndentedWriter, td *dsl.NamedType) {
 }
 
 func writeRecord(w *formatting.IndentedWriter, rec *dsl.RecordDefinition, st dsl.SymbolTable) {
-	fmt.Fprintf(w, "class %s%s:\n", common.TypeSyntaxWithoutTypeParameters(rec, rec.Namespace), GetGenericBase(rec))
+	fmt.Fprintf(w, "class %s%s:\n", common.TypeSyntaxWithoutTypeParameters(rec, rec.Namespace), recordBaseClasses(rec))
 	w.Indented(func() {
-		common.WriteDocstring(w, rec.Comment)
+		common.WriteDocstring(w, dsl.AnnotatedComment(rec.Comment, rec.Annotations))
 		for _, field := range rec.Fields {
-			fmt.Fprintf(w, "%s: %s\n", common.FieldIdentifierName(field.Name), common.TypeSyntax(field.Type, rec.Namespace))
+			if dsl.IsInheritedMember(rec, field.Name) {
+				continue
+			}
+			fieldTypeSyntax := common.TypeSyntax(field.Type, rec.Namespace)
+			if len(field.Annotations) > 0 {
+				fieldTypeSyntax = fmt.Sprintf("typing.Annotated[%s, %s]", fieldTypeSyntax, annotationsLiteral(field.Annotations))
+			}
+			fmt.Fprintf(w, "%s: %s\n", common.FieldIdentifierName(field.Name), fieldTypeSyntax)
 
 			common.WriteDocstring(w, field.Comment)
 		}
@@ -207,6 +236,13 @@ func writeRecord(w *formatting.IndentedWriter, rec *dsl.RecordDefinition, st dsl
 					fieldTypeSyntax := common.TypeSyntax(f.Type, rec.Namespace)
 					fmt.Fprintf(w, "%s: ", fieldName)
 
+					if f.Default != nil {
+						fmt.Fprintf(w, "%s = ", fieldTypeSyntax)
+						writeExpression(w, f.Default, rec.Namespace, tailWrapper{}, nil)
+						w.WriteStringln(",")
+						continue
+					}
+
 					defaultExpression, defaultExpressionKind := typeDefault(f.Type, rec.Namespace, "", st)
 					switch defaultExpressionKind {
 					case defaultValueKindNone:
@@ -224,6 +260,12 @@ func writeRecord(w *formatting.IndentedWriter, rec *dsl.RecordDefinition, st dsl
 			w.Indented(func() {
 				for _, f := range rec.Fields {
 					fieldName := common.FieldIdentifierName(f.Name)
+					if f.Default != nil {
+						// default values are constants of immutable types
+						fmt.Fprintf(w, "self.%s = %s\n", fieldName, fieldName)
+						continue
+					}
+
 					defaultExpression, defaultExpressionKind := typeDefault(f.Type, rec.Namespace, "", st)
 					switch defaultExpressionKind {
 					case defaultValueKindNone, defaultValueKindImmutable:
@@ -237,6 +279,10 @@ func writeRecord(w *formatting.IndentedWriter, rec *dsl.RecordDefinition, st dsl
 		}
 
 		for _, computedField := range rec.ComputedFields {
+			if dsl.IsInheritedMember(rec, computedField.Name) {
+				// defined by the base class
+				continue
+			}
 			expressionTypeSyntax := common.TypeSyntax(computedField.Expression.GetResolvedType(), rec.Namespace)
 			fieldName := common.ComputedFieldIdentifierName(computedField.Name)
 			fmt.Fprintf(w, "def %s(self) -> %s:\n", fieldName, expressionTypeSyntax)
@@ -247,6 +293,10 @@ func writeRecord(w *formatting.IndentedWriter, rec *dsl.RecordDefinition, st dsl
 			})
 		}
 
+		if dsl.TypeHasConstraints(&dsl.SimpleType{ResolvedDefinition: rec}, st) {
+			writeValidateMethod(w, rec, st)
+		}
+
 		writeEqMethod(w, rec)
 
 		w.WriteStringln("def __str__(self) -> str:")
@@ -272,6 +322,107 @@ func writeRecord(w *formatting.IndentedWriter, rec *dsl.RecordDefinition, st dsl
 	w.WriteStringln("")
 }
 
+// Returns a Python dict literal with the given annotations, used as the
+// metadata of a typing.Annotated field type.
+func annotationsLiteral(annotations dsl.Annotations) string {
+	entries := make([]string, 0, len(annotations))
+	for _, name := range annotations.Names() {
+		var value string
+		switch v := annotations[name].(type) {
+		case string:
+			value = strconv.Quote(v)
+		case bool:
+			if v {
+				value = "True"
+			} else {
+				value = "False"
+			}
+		case float64:
+			value = strconv.FormatFloat(v, 'g', -1, 64)
+			if !strings.ContainsAny(value, ".eInN") {
+				value += ".0"
+			}
+		default:
+			value = fmt.Sprintf("%v", v)
+		}
+		entries = append(entries, fmt.Sprintf("%s: %s", strconv.Quote(name), value))
+	}
+	return "{" + strings.Join(entries, ", ") + "}"
+}
+
+func hasPatternConstraints(ns *dsl.Namespace) bool {
+	found := false
+	dsl.Visit(ns, func(self dsl.Visitor, node dsl.Node) {
+		if constraints, ok := node.(*dsl.FieldConstraints); ok && constraints.Pattern != "" {
+			found = true
+		}
+		self.VisitChildren(node)
+	})
+
+	return found
+}
+
+func writeValidateMethod(w *formatting.IndentedWriter, rec *dsl.RecordDefinition, st dsl.SymbolTable) {
+	w.WriteStringln("def validate(self) -> None:")
+	w.Indented(func() {
+		common.WriteDocstring(w, "Raises ValueError if a field does not satisfy its constraints.")
+		for _, field := range rec.Fields {
+			if field.Constraints != nil {
+				writeConstraintChecks(w, rec, field)
+			}
+			if dsl.TypeHasConstraints(field.Type, st) {
+				common.WriteValidation(w, field.Type, "self."+common.FieldIdentifierName(field.Name))
+			}
+		}
+		w.WriteStringln("")
+	})
+}
+
+func writeConstraintChecks(w *formatting.IndentedWriter, rec *dsl.RecordDefinition, field *dsl.Field) {
+	constraints := field.Constraints
+	value := "self." + common.FieldIdentifierName(field.Name)
+	guard := ""
+	if _, optional := dsl.GetConstrainedType(field); optional {
+		guard = value + " is not None and "
+	}
+
+	check := func(condition string, message string) {
+		fmt.Fprintf(w, "if %s%s:\n", guard, condition)
+		w.Indented(func() {
+			fmt.Fprintf(w, "raise ValueError(%q)\n", fmt.Sprintf("%s.%s %s", rec.Name, field.Name, message))
+		})
+	}
+
+	if constraints.Min != nil {
+		check(fmt.Sprintf("%s < %s", value, literalText(constraints.Min)), "must be at least "+literalText(constraints.Min))
+	}
+	if constraints.Max != nil {
+		check(fmt.Sprintf("%s > %s", value, literalText(constraints.Max)), "must be at most "+literalText(constraints.Max))
+	}
+	if constraints.Pattern != "" {
+		// re.ASCII limits \d and \w to ASCII characters, as in the other languages
+		check(fmt.Sprintf("re.fullmatch(%q, %s, re.ASCII) is None", constraints.Pattern, value), fmt.Sprintf("must match the pattern '%s'", constraints.Pattern))
+	}
+	if constraints.MinLength != nil {
+		check(fmt.Sprintf("len(%s) < %d", value, *constraints.MinLength), fmt.Sprintf("must have a length of at least %d", *constraints.MinLength))
+	}
+	if constraints.MaxLength != nil {
+		check(fmt.Sprintf("len(%s) > %d", value, *constraints.MaxLength), fmt.Sprintf("must have a length of at most %d", *constraints.MaxLength))
+	}
+}
+
+// Returns the text of a numeric literal
+func literalText(expression dsl.Expression) string {
+	switch t := expression.(type) {
+	case *dsl.IntegerLiteralExpression:
+		return t.Value.String()
+	case *dsl.FloatingPointLiteralExpression:
+		return t.Value
+	default:
+		panic(fmt.Sprintf("unexpected expression %T", expression))
+	}
+}
+
 func writeEqMethod(w *formatting.IndentedWriter, rec *dsl.RecordDefinition) {
 	w.WriteStringln("def __eq__(self, other: object) -> bool:")
 	w.Indented(func() {
@@ -333,7 +484,9 @@ func hasSimpleEquality(t dsl.Node) bool {
 	dsl.Visit(t, func(self dsl.Visitor, node dsl.Node) {
 		switch t := node.(type) {
 		case *dsl.SimpleType:
-			self.Visit(t.ResolvedDefinition)
+			if !t.IsRecursiveReference {
+				self.Visit(t.ResolvedDefinition)
+			}
 		case *dsl.Array, *dsl.GenericTypeParameter:
 			res = false
 			return
@@ -402,6 +555,33 @@ func writeComputedFieldExpression(w *formatting.IndentedWriter, expression dsl.E
 		self.VisitChildren(node)
 	})
 
+	tail := tailWrapper{}.Append(func(next func()) {
+		w.WriteString("return ")
+		next()
+		w.WriteStringln("")
+	})
+
+	writeExpression(w, expression, contextNamespace, tail, helperFunctionLookup)
+}
+
+// Returns true if the operand of a binary operator needs to be parenthesized.
+// Comparisons are parenthesized when they are operands of comparisons, since Python
+// would otherwise chain them, and so are negations, because `not` has a lower
+// precedence than comparisons in Python.
+func operandRequiresParentheses(operand dsl.Expression, operator dsl.BinaryOperator) bool {
+	switch operand := operand.(type) {
+	case *dsl.BinaryExpression:
+		return operand.Operator.Precedence() < operator.Precedence() || operand.Operator.IsComparison() && operator.IsComparison()
+	case *dsl.UnaryExpression:
+		return operand.Operator == dsl.UnaryOpNot && operator.IsComparison()
+	default:
+		return false
+	}
+}
+
+// Writes an expression, passing the code that produces its value to the given tail.
+// Expressions that are not constant may write statements before that.
+func writeExpression(w *formatting.IndentedWriter, expression dsl.Expression, contextNamespace string, tail tailWrapper, helperFunctionLookup map[any]string) {
 	varCounter := 0
 	newVarName := func() string {
 		varName := fmt.Sprintf("_var%d", varCounter)
@@ -409,29 +589,24 @@ func writeComputedFieldExpression(w *formatting.IndentedWriter, expression dsl.E
 		return varName
 	}
 
-	tail := tailWrapper{}.Append(func(next func()) {
-		w.WriteString("return ")
-		next()
-		w.WriteStringln("")
-	})
-
 	dsl.VisitWithContext(expression, tail, func(self dsl.VisitorWithContext[tailWrapper], node dsl.Node, tail tailWrapper) {
 		switch t := node.(type) {
 		case *dsl.UnaryExpression:
 			tail.Run(func() {
-				if t.Operator != dsl.UnaryOpNegate {
+				switch t.Operator {
+				case dsl.UnaryOpNegate:
+					w.WriteString("-(")
+				case dsl.UnaryOpNot:
+					w.WriteString("not (")
+				default:
 					panic(fmt.Sprintf("unexpected unary operator %d", t.Operator))
 				}
-				w.WriteString("-(")
 				self.Visit(t.Expression, tailWrapper{})
 				w.WriteString(")")
 			})
 		case *dsl.BinaryExpression:
 			tail.Run(func() {
-				requiresParentheses := false
-				if l, ok := t.Left.(*dsl.BinaryExpression); ok && l.Operator.Precedence() < t.Operator.Precedence() {
-					requiresParentheses = true
-				}
+				requiresParentheses := operandRequiresParentheses(t.Left, t.Operator)
 
 				if requiresParentheses {
 					w.WriteString("(")
@@ -454,16 +629,29 @@ func writeComputedFieldExpression(w *formatting.IndentedWriter, expression dsl.E
 					w.WriteString("//")
 				case dsl.BinaryOpPow:
 					w.WriteString("**")
+				case dsl.BinaryOpEq:
+					w.WriteString("==")
+				case dsl.BinaryOpNe:
+					w.WriteString("!=")
+				case dsl.BinaryOpLt:
+					w.WriteString("<")
+				case dsl.BinaryOpLe:
+					w.WriteString("<=")
+				case dsl.BinaryOpGt:
+					w.WriteString(">")
+				case dsl.BinaryOpGe:
+					w.WriteString(">=")
+				case dsl.BinaryOpAnd:
+					w.WriteString("and")
+				case dsl.BinaryOpOr:
+					w.WriteString("or")
 				default:
 					panic(fmt.Sprintf("unexpected binary operator %d", t.Operator))
 				}
 
 				w.WriteString(" ")
 
-				requiresParentheses = false
-				if r, ok := t.Right.(*dsl.BinaryExpression); ok && r.Operator.Precedence() < t.Operator.Precedence() {
-					requiresParentheses = true
-				}
+				requiresParentheses = operandRequiresParentheses(t.Right, t.Operator)
 
 				if requiresParentheses {
 					w.WriteString("(")
@@ -473,6 +661,16 @@ func writeComputedFieldExpression(w *formatting.IndentedWriter, expression dsl.E
 					w.WriteString(")")
 				}
 			})
+		case *dsl.ConditionalExpression:
+			tail.Run(func() {
+				w.WriteString("(")
+				self.Visit(t.Then, tailWrapper{})
+				w.WriteString(" if ")
+				self.Visit(t.Condition, tailWrapper{})
+				w.WriteString(" else ")
+				self.Visit(t.Else, tailWrapper{})
+				w.WriteString(")")
+			})
 		case *dsl.IntegerLiteralExpression:
 			tail.Run(func() {
 				fmt.Fprintf(w, "%d", &t.Value)
@@ -485,6 +683,25 @@ func writeComputedFieldExpression(w *formatting.IndentedWriter, expression dsl.E
 			tail.Run(func() {
 				fmt.Fprintf(w, "%q", t.Value)
 			})
+		case *dsl.BooleanLiteralExpression:
+			tail.Run(func() {
+				if t.Value {
+					w.WriteString("True")
+				} else {
+					w.WriteString("False")
+				}
+			})
+		case *dsl.EnumValueExpression:
+			tail.Run(func() {
+				fmt.Fprintf(w, "%s.%s", common.TypeSyntax(t.ResolvedType, contextNamespace), common.EnumValueIdentifierName(t.Value.Symbol))
+			})
+		case *dsl.ConstantReferenceExpression:
+			tail.Run(func() {
+				if t.Definition.Namespace != contextNamespace {
+					fmt.Fprintf(w, "%s.", common.NamespaceIdentifierName(t.Definition.Namespace))
+				}
+				w.WriteString(common.ConstantIdentifierName(t.Definition.Name))
+			})
 		case *dsl.MemberAccessExpression:
 			tail.Run(func() {
 				if t.Target == nil {
@@ -562,6 +779,48 @@ func writeComputedFieldExpression(w *formatting.IndentedWriter, expression dsl.E
 				case dsl.FunctionDimensionCount:
 					self.Visit(t.Arguments[0], tailWrapper{})
 					fmt.Fprintf(w, ".ndim")
+
+				case dsl.FunctionSum:
+					if primitive, _ := dsl.GetPrimitiveType(t.ResolvedType); dsl.IsIntegralPrimitive(primitive) {
+						w.WriteString("yardl.checked_sum(")
+						self.Visit(t.Arguments[0], tailWrapper{})
+						fmt.Fprintf(w, ", %s)", common.TypeDTypeSyntax(t.ResolvedType))
+					} else {
+						w.WriteString("sum(")
+						self.Visit(t.Arguments[0], tailWrapper{})
+						w.WriteString(")")
+					}
+
+				case dsl.FunctionMin, dsl.FunctionMax, dsl.FunctionAbs:
+					fmt.Fprintf(w, "%s(", t.FunctionName)
+					formatting.Delimited(w, ", ", t.Arguments, func(w *formatting.IndentedWriter, i int, arg dsl.Expression) {
+						self.Visit(arg, tailWrapper{})
+					})
+					w.WriteString(")")
+
+				case dsl.FunctionSqrt, dsl.FunctionFloor, dsl.FunctionCeil:
+					fmt.Fprintf(w, "np.%s(", t.FunctionName)
+					self.Visit(t.Arguments[0], tailWrapper{})
+					w.WriteString(")")
+
+				case dsl.FunctionLength:
+					// The length in UTF-8 bytes, as in the other languages
+					w.WriteString("len(")
+					self.Visit(t.Arguments[0], tailWrapper{})
+					w.WriteString(".encode(\"utf-8\"))")
+
+				case dsl.FunctionContains:
+					w.WriteString("(")
+					self.Visit(t.Arguments[1], tailWrapper{})
+					w.WriteString(" in ")
+					self.Visit(t.Arguments[0], tailWrapper{})
+					w.WriteString(")")
+
+				case dsl.FunctionHasValue:
+					w.WriteString("(")
+					self.Visit(t.Arguments[0], tailWrapper{})
+					w.WriteString(" is not None)")
+
 				default:
 					panic(fmt.Sprintf("Unknown function '%s'", t.FunctionName))
 				}
@@ -588,7 +847,9 @@ func writeComputedFieldExpression(w *formatting.IndentedWriter, expression dsl.E
 				dsl.Visit(t.Target, func(self dsl.Visitor, node dsl.Node) {
 					switch node := node.(type) {
 					case *dsl.SimpleType:
-						self.Visit(node.ResolvedDefinition)
+						if !node.IsRecursiveReference {
+							self.Visit(node.ResolvedDefinition)
+						}
 					case *dsl.RecordDefinition:
 						for _, field := range node.Fields {
 							u := dsl.GetUnderlyingType(field.Type)
@@ -734,12 +995,16 @@ func typeConversionCallable(t dsl.Type) string {
 				return "bool"
 			case dsl.Int8, dsl.Uint8, dsl.Int16, dsl.Uint16, dsl.Int32, dsl.Uint32, dsl.Int64, dsl.Uint64, dsl.Size:
 				return "int"
-			case dsl.Float32, dsl.Float64:
+			case dsl.Float16, dsl.BFloat16, dsl.Float32, dsl.Float64:
 				return "float"
 			case dsl.ComplexFloat32, dsl.ComplexFloat64:
 				return "complex"
 			case dsl.String:
 				return "str"
+			case dsl.Bytes:
+				return "bytes"
+			case dsl.Uuid:
+				return "uuid.UUID"
 			case dsl.Date:
 				return "datetime.date"
 			case dsl.Time:
@@ -752,7 +1017,34 @@ func typeConversionCallable(t dsl.Type) string {
 	panic(fmt.Sprintf("Unsupported type '%s'", t))
 }
 
+// Returns the base classes of a record's class, which are the classes of the
+// records it extends followed by its generic base, if any.
+func recordBaseClasses(rec *dsl.RecordDefinition) string {
+	if len(rec.Extends) == 0 {
+		return GetGenericBase(rec)
+	}
+
+	bases := make([]string, 0, len(rec.Extends)+1)
+	for _, t := range rec.Extends {
+		bases = append(bases, common.TypeSyntax(t, rec.Namespace))
+	}
+	if genericBase := genericBaseClass(rec); genericBase != "" {
+		bases = append(bases, genericBase)
+	}
+
+	return fmt.Sprintf("(%s)", strings.Join(bases, ", "))
+}
+
 func GetGenericBase(t dsl.TypeDefinition) string {
+	genericBase := genericBaseClass(t)
+	if genericBase == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("(%s)", genericBase)
+}
+
+func genericBaseClass(t dsl.TypeDefinition) string {
 	meta := t.GetDefinitionMeta()
 	if len(meta.TypeParameters) == 0 {
 		return ""
@@ -773,7 +1065,7 @@ func GetGenericBase(t dsl.TypeDefinition) string {
 		return ""
 	}
 
-	return fmt.Sprintf("(typing.Generic[%s])", strings.Join(typeParams, ", "))
+	return fmt.Sprintf("typing.Generic[%s]", strings.Join(typeParams, ", "))
 }
 
 func writeEnum(w *formatting.IndentedWriter, enum *dsl.EnumDefinition) {
@@ -788,7 +1080,7 @@ func writeEnum(w *formatting.IndentedWriter, enum *dsl.EnumDefinition) {
 	fmt.Fprintf(w, "class %s(%s):\n", enumTypeSyntax, base)
 
 	w.Indented(func() {
-		common.WriteDocstring(w, enum.Comment)
+		common.WriteDocstring(w, dsl.AnnotatedComment(enum.Comment, enum.Annotations))
 		for _, value := range enum.Values {
 			fmt.Fprintf(w, "%s = %d\n", common.EnumValueIdentifierName(value.Symbol), &value.IntegerValue)
 			common.WriteDocstring(w, value.Comment)
@@ -914,18 +1206,24 @@ func typeDefinitionDefault(t dsl.TypeDefinition, contextNamespace string, st dsl
 			return "False", defaultValueKindImmutable
 		case dsl.Int8, dsl.Uint8, dsl.Int16, dsl.Uint16, dsl.Int32, dsl.Uint32, dsl.Int64, dsl.Uint64, dsl.Size:
 			return "0", defaultValueKindImmutable
-		case dsl.Float32, dsl.Float64:
+		case dsl.Float16, dsl.BFloat16, dsl.Float32, dsl.Float64:
 			return "0.0", defaultValueKindImmutable
 		case dsl.ComplexFloat32, dsl.ComplexFloat64:
 			return "0j", defaultValueKindImmutable
 		case dsl.String:
 			return `""`, defaultValueKindImmutable
+		case dsl.Bytes:
+			return `b""`, defaultValueKindImmutable
+		case dsl.Uuid:
+			return "uuid.UUID(int=0)", defaultValueKindImmutable
 		case dsl.Date:
 			return "datetime.date(1970, 1, 1)", defaultValueKindImmutable
 		case dsl.Time:
 			return "yardl.Time()", defaultValueKindImmutable
 		case dsl.DateTime:
 			return "yardl.DateTime()", defaultValueKindImmutable
+		case dsl.Duration:
+			return `np.timedelta64(0, "ns")`, defaultValueKindImmutable
 		}
 	case *dsl.EnumDefinition:
 		zeroValue := t.GetZeroValue()
@@ -1092,6 +1390,10 @@ func typeDefinitionDTypeExpression(t dsl.TypeDefinition, context dTypeExpression
 				return fmt.Sprintf("np.dtype(np.%s)", strings.ToLower(string(t)))
 			case dsl.Size:
 				return "np.dtype(np.uint64)"
+			case dsl.Float16:
+				return "np.dtype(np.float16)"
+			case dsl.BFloat16:
+				return "np.dtype(np.float32)"
 			case dsl.ComplexFloat32:
 				return "np.dtype(np.complex64)"
 			case dsl.ComplexFloat64:
@@ -1102,7 +1404,9 @@ func typeDefinitionDTypeExpression(t dsl.TypeDefinition, context dTypeExpression
 				return "np.dtype(np.timedelta64)"
 			case dsl.DateTime:
 				return "np.dtype(np.datetime64)"
-			case dsl.String:
+			case dsl.Duration:
+				return "np.dtype(np.timedelta64)"
+			case dsl.String, dsl.Bytes, dsl.Uuid:
 				return "np.dtype(np.object_)"
 			default:
 				panic(fmt.Sprintf("Not implemented %s", t))
@@ -1184,6 +1488,10 @@ func typeDefinitionDTypeExpression(t dsl.TypeDefinition, context dTypeExpression
 func typeDTypeExpression(t dsl.Type, context dTypeExpressionContext) string {
 	switch t := dsl.GetUnderlyingType(t).(type) {
 	case *dsl.SimpleType:
+		if t.IsRecursiveReference {
+			// Recursive records are stored as Python objects
+			return "np.dtype(np.object_)"
+		}
 		context.root = false
 		return typeDefinitionDTypeExpression(t.ResolvedDefinition, context)
 
@@ -0,0 +1,455 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+package binary
+
+import (
+	"fmt"
+
+	"github.com/microsoft/yardl/tooling/internal/formatting"
+	"github.com/microsoft/yardl/tooling/internal/rust/common"
+	"github.com/microsoft/yardl/tooling/internal/rust/types"
+	"github.com/microsoft/yardl/tooling/pkg/dsl"
+)
+
+func WriteBinary(w *formatting.IndentedWriter, ns *dsl.Namespace) {
+	unions := make(map[string]bool)
+	for _, td := range ns.TypeDefinitions {
+		for _, u := range common.GetUnionDeclarations(td) {
+			if !unions[u.Name] {
+				unions[u.Name] = true
+				writeUnionSerializers(w, ns.Name, u)
+			}
+		}
+
+		switch td := td.(type) {
+		case *dsl.EnumDefinition:
+			if td.IsFlags {
+				writeFlagsSerializers(w, ns.Name, td)
+			} else {
+				writeEnumSerializers(w, ns.Name, td)
+			}
+		case *dsl.RecordDefinition:
+			writeRecordSerializers(w, ns.Name, td)
+		case *dsl.NamedType:
+			// Aliases use the serializers of the aliased type and
+			// named unions are handled above
+		default:
+			panic(fmt.Sprintf("unsupported type definition: %T", td))
+		}
+	}
+
+	for _, p := range ns.Protocols {
+		for _, u := range common.GetUnionDeclarations(p) {
+			if !unions[u.Name] {
+				unions[u.Name] = true
+				writeUnionSerializers(w, ns.Name, u)
+			}
+		}
+		writeProtocolWriter(w, ns.Name, p)
+		writeProtocolReader(w, ns.Name, p)
+	}
+}
+
+// Writes the BinaryWrite and BinaryRead impls for a type. The bodies are
+// written by the given functions.
+func writeSerializerImpls(
+	w *formatting.IndentedWriter,
+	typeName string,
+	typeParameters []*dsl.GenericTypeParameter,
+	writeWriterMethods func(),
+	writeReaderMethods func()) {
+
+	fmt.Fprintf(w, "impl%s BinaryWrite for %s%s {\n", common.TypeParameters(typeParameters, "BinaryWrite"), typeName, common.TypeParameters(typeParameters, ""))
+	w.Indented(writeWriterMethods)
+	w.WriteString("}\n\n")
+
+	fmt.Fprintf(w, "impl%s BinaryRead for %s%s {\n", common.TypeParameters(typeParameters, "BinaryRead"), typeName, common.TypeParameters(typeParameters, ""))
+	w.Indented(writeReaderMethods)
+	w.WriteString("}\n\n")
+}
+
+func writeMethod(w *formatting.IndentedWriter, signature string, writeBody func()) {
+	fmt.Fprintf(w, "%s {\n", signature)
+	w.Indented(writeBody)
+	w.WriteStringln("}")
+}
+
+const (
+	writeSignature        = "fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()>"
+	writeSomeSignature    = "fn write_some<W: Write>(&self, w: &mut BinaryWriter<W>) -> yardl::Result<()>"
+	readSignature         = "fn read<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Self>"
+	readOptionalSignature = "fn read_optional<R: Read>(r: &mut BinaryReader<R>) -> yardl::Result<Option<Self>>"
+)
+
+func writeEnumSerializers(w *formatting.IndentedWriter, namespace string, enum *dsl.EnumDefinition) {
+	typeName := common.TypeIdentifierName(enum.Name)
+	baseSyntax := common.TypeSyntax(namespace, types.EnumBaseType(enum))
+	writeSerializerImpls(w, typeName, nil,
+		func() {
+			writeMethod(w, writeSignature, func() {
+				if len(enum.Values) == 0 {
+					w.WriteStringln("match *self {}")
+					return
+				}
+				fmt.Fprintf(w, "BinaryWrite::write(&(*self as %s), w)\n", baseSyntax)
+			})
+		},
+		func() {
+			writeMethod(w, readSignature, func() {
+				fmt.Fprintf(w, "let value = <%s as BinaryRead>::read(r)?;\n", baseSyntax)
+				w.WriteStringln("match value {")
+				w.Indented(func() {
+					for _, value := range enum.Values {
+						fmt.Fprintf(w, "%s => Ok(%s::%s),\n", value.IntegerValue.String(), typeName, common.EnumVariantName(value))
+					}
+					fmt.Fprintf(w, "_ => Err(yardl::Error::InvalidData(format!(\"invalid %s value {}\", value))),\n", typeName)
+				})
+				w.WriteStringln("}")
+			})
+		})
+}
+
+func writeFlagsSerializers(w *formatting.IndentedWriter, namespace string, flags *dsl.EnumDefinition) {
+	typeName := common.TypeIdentifierName(flags.Name)
+	writeSerializerImpls(w, typeName, nil,
+		func() {
+			writeMethod(w, writeSignature, func() {
+				w.WriteStringln("BinaryWrite::write(&self.0, w)")
+			})
+		},
+		func() {
+			writeMethod(w, readSignature, func() {
+				fmt.Fprintf(w, "Ok(%s(BinaryRead::read(r)?))\n", typeName)
+			})
+		})
+}
+
+func writeRecordSerializers(w *formatting.IndentedWriter, namespace string, rec *dsl.RecordDefinition) {
+	typeName := common.TypeIdentifierName(rec.Name)
+	writeSerializerImpls(w, typeName, rec.TypeParameters,
+		func() {
+			writeMethod(w, writeSignature, func() {
+				for _, field := range rec.Fields {
+					fmt.Fprintf(w, "BinaryWrite::write(&self.%s, w)?;\n", common.FieldIdentifierName(field.Name))
+				}
+				w.WriteStringln("Ok(())")
+			})
+		},
+		func() {
+			writeMethod(w, readSignature, func() {
+				if len(rec.Fields) == 0 {
+					fmt.Fprintf(w, "Ok(%s {})\n", typeName)
+					return
+				}
+				fmt.Fprintf(w, "Ok(%s {\n", typeName)
+				w.Indented(func() {
+					for _, field := range rec.Fields {
+						fmt.Fprintf(w, "%s: BinaryRead::read(r)?,\n", common.FieldIdentifierName(field.Name))
+					}
+				})
+				w.WriteStringln("})")
+			})
+		})
+}
+
+// Unions are written as the index of the case followed by the value. Since the
+// null case of a union is represented by the None variant of an Option wrapping
+// the union, the index is offset by one when the union includes null.
+//
+// Anonymous unions with and without a null case share a Rust enum, so they
+// override write_some and read_optional to write the index of a union with null.
+// A named union type that includes null is always wrapped in an Option, so its
+// index always accounts for the null case.
+func writeUnionSerializers(w *formatting.IndentedWriter, namespace string, u *common.UnionDeclaration) {
+	nonNullCases := make([]*dsl.TypeCase, 0, len(u.Type.Cases))
+	for _, typeCase := range u.Type.Cases {
+		if typeCase.Type != nil {
+			nonNullCases = append(nonNullCases, typeCase)
+		}
+	}
+
+	writeCases := func(offset int) {
+		w.WriteStringln("match self {")
+		w.Indented(func() {
+			for i, typeCase := range nonNullCases {
+				fmt.Fprintf(w, "%s::%s(value) => {\n", u.Name, common.UnionVariantName(typeCase))
+				w.Indented(func() {
+					fmt.Fprintf(w, "w.write_uvarint(%d)?;\n", i+offset)
+					w.WriteStringln("BinaryWrite::write(value, w)")
+				})
+				w.WriteStringln("}")
+			}
+		})
+		w.WriteStringln("}")
+	}
+
+	readCases := func(offset int, optional bool) {
+		wrap := func(s string) string {
+			if optional {
+				return fmt.Sprintf("Some(%s)", s)
+			}
+			return s
+		}
+
+		w.WriteStringln("match r.read_uvarint()? {")
+		w.Indented(func() {
+			if optional {
+				w.WriteStringln("0 => Ok(None),")
+			}
+			for i, typeCase := range nonNullCases {
+				fmt.Fprintf(w, "%d => Ok(%s),\n", i+offset, wrap(fmt.Sprintf("%s::%s(BinaryRead::read(r)?)", u.Name, common.UnionVariantName(typeCase))))
+			}
+			fmt.Fprintf(w, "index => Err(yardl::Error::InvalidData(format!(\"unexpected %s index {}\", index))),\n", u.Name)
+		})
+		w.WriteStringln("}")
+	}
+
+	anonymous := u.NamedType == nil
+	nullable := u.Type.Cases.HasNullOption()
+
+	writeSerializerImpls(w, u.Name, u.TypeParameters,
+		func() {
+			switch {
+			case anonymous:
+				writeMethod(w, writeSignature, func() { writeCases(0) })
+				w.WriteStringln("")
+				writeMethod(w, writeSomeSignature, func() { writeCases(1) })
+			case nullable:
+				writeMethod(w, writeSignature, func() { writeCases(1) })
+				w.WriteStringln("")
+				writeMethod(w, writeSomeSignature, func() { w.WriteStringln("BinaryWrite::write(self, w)") })
+			default:
+				writeMethod(w, writeSignature, func() { writeCases(0) })
+			}
+		},
+		func() {
+			switch {
+			case anonymous:
+				writeMethod(w, readSignature, func() { readCases(0, false) })
+				w.WriteStringln("")
+				writeMethod(w, readOptionalSignature, func() { readCases(1, true) })
+			case nullable:
+				writeMethod(w, readSignature, func() { readCases(1, false) })
+				w.WriteStringln("")
+				writeMethod(w, readOptionalSignature, func() { readCases(1, true) })
+			default:
+				writeMethod(w, readSignature, func() { readCases(0, false) })
+			}
+		})
+}
+
+func binaryWriterName(p *dsl.ProtocolDefinition) string {
+	return "Binary" + common.WriterTraitName(p)
+}
+
+func binaryReaderName(p *dsl.ProtocolDefinition) string {
+	return "Binary" + common.ReaderTraitName(p)
+}
+
+func streamRemainingFieldName(step *dsl.ProtocolStep) string {
+	return formatting.ToSnakeCase(step.Name) + "_remaining"
+}
+
+func writeProtocolWriter(w *formatting.IndentedWriter, namespace string, p *dsl.ProtocolDefinition) {
+	writerName := binaryWriterName(p)
+
+	fmt.Fprintf(w, "/// Writes the %s protocol in binary format.\n", p.Name)
+	fmt.Fprintf(w, "pub struct %s<W: Write> {\n", writerName)
+	w.Indented(func() {
+		w.WriteStringln("w: BinaryWriter<W>,")
+		w.WriteStringln("state: usize,")
+	})
+	w.WriteString("}\n\n")
+
+	fmt.Fprintf(w, "impl<W: Write> %s<W> {\n", writerName)
+	w.Indented(func() {
+		w.WriteStringln("/// Writes the protocol header to the given writer.")
+		writeMethod(w, "pub fn new(w: W) -> yardl::Result<Self>", func() {
+			w.WriteStringln("let mut w = BinaryWriter::new(w);")
+			fmt.Fprintf(w, "w.write_header(%s)?;\n", common.SchemaConstantName(p))
+			w.WriteStringln("Ok(Self { w, state: 0 })")
+		})
+		w.WriteStringln("")
+
+		writeMethod(w, "fn invalid_state(&self, attempted: usize, end: bool) -> yardl::Error", func() {
+			w.WriteStringln("let expected = match self.state {")
+			w.Indented(func() {
+				for i, step := range p.Sequence {
+					if step.IsStream() {
+						fmt.Fprintf(w, "%d => \"%s() or %s()\",\n", i, common.ProtocolWriteMethodName(step), common.ProtocolWriteEndMethodName(step))
+					} else {
+						fmt.Fprintf(w, "%d => \"%s()\",\n", i, common.ProtocolWriteMethodName(step))
+					}
+				}
+				w.WriteStringln("_ => \"close()\",")
+			})
+			w.WriteStringln("};")
+
+			w.WriteStringln("let received = match attempted {")
+			w.Indented(func() {
+				for i, step := range p.Sequence {
+					if step.IsStream() {
+						fmt.Fprintf(w, "%d if end => \"%s()\",\n", i, common.ProtocolWriteEndMethodName(step))
+					}
+					fmt.Fprintf(w, "%d => \"%s()\",\n", i, common.ProtocolWriteMethodName(step))
+				}
+				w.WriteStringln("_ => \"close()\",")
+			})
+			w.WriteStringln("};")
+
+			w.WriteStringln("yardl::Error::Protocol {")
+			w.Indented(func() {
+				w.WriteStringln("expected: expected.to_string(),")
+				w.WriteStringln("received: received.to_string(),")
+			})
+			w.WriteStringln("}")
+		})
+	})
+	w.WriteString("}\n\n")
+
+	checkState := func(i int, end bool) {
+		fmt.Fprintf(w, "if self.state != %d {\n", i)
+		w.Indented(func() {
+			fmt.Fprintf(w, "return Err(self.invalid_state(%d, %t));\n", i, end)
+		})
+		w.WriteStringln("}")
+	}
+
+	fmt.Fprintf(w, "impl<W: Write> %s for %s<W> {\n", common.WriterTraitName(p), writerName)
+	w.Indented(func() {
+		for i, step := range p.Sequence {
+			valueSyntax := common.TypeSyntax(namespace, step.Type)
+			if step.IsStream() {
+				writeMethod(w, fmt.Sprintf("fn %s(&mut self, values: &[%s]) -> yardl::Result<()>", common.ProtocolWriteMethodName(step), valueSyntax), func() {
+					checkState(i, false)
+					w.WriteStringln("self.w.write_block(values)")
+				})
+				w.WriteStringln("")
+
+				writeMethod(w, fmt.Sprintf("fn %s(&mut self) -> yardl::Result<()>", common.ProtocolWriteEndMethodName(step)), func() {
+					checkState(i, true)
+					w.WriteStringln("self.w.write_end_of_stream()?;")
+					fmt.Fprintf(w, "self.state = %d;\n", i+1)
+					w.WriteStringln("Ok(())")
+				})
+				w.WriteStringln("")
+				continue
+			}
+
+			writeMethod(w, fmt.Sprintf("fn %s(&mut self, value: &%s) -> yardl::Result<()>", common.ProtocolWriteMethodName(step), valueSyntax), func() {
+				checkState(i, false)
+				w.WriteStringln("BinaryWrite::write(value, &mut self.w)?;")
+				fmt.Fprintf(w, "self.state = %d;\n", i+1)
+				w.WriteStringln("Ok(())")
+			})
+			w.WriteStringln("")
+		}
+
+		writeMethod(w, "fn close(&mut self) -> yardl::Result<()>", func() {
+			checkState(len(p.Sequence), false)
+			w.WriteStringln("self.w.flush()")
+		})
+	})
+	w.WriteString("}\n\n")
+}
+
+func writeProtocolReader(w *formatting.IndentedWriter, namespace string, p *dsl.ProtocolDefinition) {
+	readerName := binaryReaderName(p)
+
+	fmt.Fprintf(w, "/// Reads the %s protocol in binary format.\n", p.Name)
+	fmt.Fprintf(w, "pub struct %s<R: Read> {\n", readerName)
+	w.Indented(func() {
+		w.WriteStringln("r: BinaryReader<R>,")
+		w.WriteStringln("state: usize,")
+		for _, step := range p.Sequence {
+			if step.IsStream() {
+				fmt.Fprintf(w, "%s: u64,\n", streamRemainingFieldName(step))
+			}
+		}
+	})
+	w.WriteString("}\n\n")
+
+	fmt.Fprintf(w, "impl<R: Read> %s<R> {\n", readerName)
+	w.Indented(func() {
+		fmt.Fprintf(w, "/// Reads the protocol header and returns an error if the stream is not a binary %s stream.\n", p.Name)
+		writeMethod(w, "pub fn new(r: R) -> yardl::Result<Self>", func() {
+			w.WriteStringln("let mut r = BinaryReader::new(r);")
+			fmt.Fprintf(w, "r.read_header(Some(%s))?;\n", common.SchemaConstantName(p))
+			w.WriteStringln("Ok(Self {")
+			w.Indented(func() {
+				w.WriteStringln("r,")
+				w.WriteStringln("state: 0,")
+				for _, step := range p.Sequence {
+					if step.IsStream() {
+						fmt.Fprintf(w, "%s: 0,\n", streamRemainingFieldName(step))
+					}
+				}
+			})
+			w.WriteStringln("})")
+		})
+		w.WriteStringln("")
+
+		writeMethod(w, "fn invalid_state(&self, attempted: usize) -> yardl::Error", func() {
+			w.WriteStringln("let method_name = |state: usize| match state {")
+			w.Indented(func() {
+				for i, step := range p.Sequence {
+					fmt.Fprintf(w, "%d => \"%s()\",\n", i, common.ProtocolReadMethodName(step))
+				}
+				w.WriteStringln("_ => \"close()\",")
+			})
+			w.WriteStringln("};")
+
+			w.WriteStringln("yardl::Error::Protocol {")
+			w.Indented(func() {
+				w.WriteStringln("expected: method_name(self.state).to_string(),")
+				w.WriteStringln("received: method_name(attempted).to_string(),")
+			})
+			w.WriteStringln("}")
+		})
+	})
+	w.WriteString("}\n\n")
+
+	checkState := func(i int) {
+		fmt.Fprintf(w, "if self.state != %d {\n", i)
+		w.Indented(func() {
+			fmt.Fprintf(w, "return Err(self.invalid_state(%d));\n", i)
+		})
+		w.WriteStringln("}")
+	}
+
+	fmt.Fprintf(w, "impl<R: Read> %s for %s<R> {\n", common.ReaderTraitName(p), readerName)
+	w.Indented(func() {
+		for i, step := range p.Sequence {
+			valueSyntax := common.TypeSyntax(namespace, step.Type)
+			if step.IsStream() {
+				writeMethod(w, fmt.Sprintf("fn %s(&mut self) -> yardl::Result<Option<%s>>", common.ProtocolReadMethodName(step), valueSyntax), func() {
+					checkState(i)
+					fmt.Fprintf(w, "let value = self.r.read_stream_item(&mut self.%s)?;\n", streamRemainingFieldName(step))
+					w.WriteStringln("if value.is_none() {")
+					w.Indented(func() {
+						fmt.Fprintf(w, "self.state = %d;\n", i+1)
+					})
+					w.WriteStringln("}")
+					w.WriteStringln("Ok(value)")
+				})
+				w.WriteStringln("")
+				continue
+			}
+
+			writeMethod(w, fmt.Sprintf("fn %s(&mut self) -> yardl::Result<%s>", common.ProtocolReadMethodName(step), valueSyntax), func() {
+				checkState(i)
+				w.WriteStringln("let value = BinaryRead::read(&mut self.r)?;")
+				fmt.Fprintf(w, "self.state = %d;\n", i+1)
+				w.WriteStringln("Ok(value)")
+			})
+			w.WriteStringln("")
+		}
+
+		writeMethod(w, "fn close(&mut self) -> yardl::Result<()>", func() {
+			checkState(len(p.Sequence))
+			w.WriteStringln("Ok(())")
+		})
+	})
+	w.WriteString("}\n\n")
+}
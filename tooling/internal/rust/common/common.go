@@ -0,0 +1,474 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+package common
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/microsoft/yardl/tooling/internal/formatting"
+	"github.com/microsoft/yardl/tooling/pkg/dsl"
+	"github.com/microsoft/yardl/tooling/pkg/packaging"
+)
+
+const RuntimeModuleName = "yardl"
+
+var reservedNames = map[string]any{
+	"abstract": nil,
+	"as":       nil,
+	"async":    nil,
+	"await":    nil,
+	"become":   nil,
+	"box":      nil,
+	"break":    nil,
+	"const":    nil,
+	"continue": nil,
+	"crate":    nil,
+	"do":       nil,
+	"dyn":      nil,
+	"else":     nil,
+	"enum":     nil,
+	"extern":   nil,
+	"false":    nil,
+	"final":    nil,
+	"fn":       nil,
+	"for":      nil,
+	"gen":      nil,
+	"if":       nil,
+	"impl":     nil,
+	"in":       nil,
+	"let":      nil,
+	"loop":     nil,
+	"macro":    nil,
+	"match":    nil,
+	"mod":      nil,
+	"move":     nil,
+	"mut":      nil,
+	"override": nil,
+	"priv":     nil,
+	"pub":      nil,
+	"ref":      nil,
+	"return":   nil,
+	"self":     nil,
+	"Self":     nil,
+	"static":   nil,
+	"struct":   nil,
+	"super":    nil,
+	"trait":    nil,
+	"true":     nil,
+	"try":      nil,
+	"type":     nil,
+	"typeof":   nil,
+	"unsafe":   nil,
+	"unsized":  nil,
+	"use":      nil,
+	"virtual":  nil,
+	"where":    nil,
+	"while":    nil,
+	"yield":    nil,
+	// names used by generated code
+	"yardl":        nil,
+	"Box":          nil,
+	"Err":          nil,
+	"HashMap":      nil,
+	"None":         nil,
+	"Ok":           nil,
+	"Option":       nil,
+	"Read":         nil,
+	"Result":       nil,
+	"Some":         nil,
+	"String":       nil,
+	"Vec":          nil,
+	"Write":        nil,
+	"BinaryRead":   nil,
+	"BinaryReader": nil,
+	"BinaryWrite":  nil,
+	"BinaryWriter": nil,
+	// type parameters of the serialization methods
+	"R": nil,
+	"W": nil,
+}
+
+func escapeReserved(name string) string {
+	if _, reserved := reservedNames[name]; !reserved {
+		return name
+	}
+
+	return name + "_"
+}
+
+// ModuleName returns the name of the Rust module generated for a namespace.
+func ModuleName(namespace string) string {
+	return escapeReserved(formatting.ToSnakeCase(namespace))
+}
+
+func ModuleFilePath(options packaging.RustCodegenOptions, namespace string) string {
+	return path.Join(options.OutputDir, ModuleName(namespace)+".rs")
+}
+
+func TypeIdentifierName(name string) string {
+	return escapeReserved(formatting.ToPascalCase(name))
+}
+
+func FieldIdentifierName(name string) string {
+	return escapeReserved(formatting.ToSnakeCase(name))
+}
+
+// Variants are always qualified by the name of their enum, so they can only
+// conflict with the Self keyword.
+func variantName(name string) string {
+	if name == "Self" {
+		return name + "_"
+	}
+
+	return name
+}
+
+func EnumVariantName(value *dsl.EnumValue) string {
+	return variantName(formatting.ToPascalCase(value.Symbol))
+}
+
+func FlagConstantName(value *dsl.EnumValue) string {
+	return escapeReserved(formatting.ToUpperSnakeCase(value.Symbol))
+}
+
+func UnionVariantName(typeCase *dsl.TypeCase) string {
+	return variantName(formatting.ToPascalCase(typeCase.Tag))
+}
+
+func TypeParameterName(p *dsl.GenericTypeParameter) string {
+	return escapeReserved(formatting.ToPascalCase(p.Name))
+}
+
+// Returns the name given to an anonymous union type
+func UnionTypeName(gt *dsl.GeneralizedType) string {
+	if !gt.Cases.IsUnion() {
+		panic("Not a union")
+	}
+
+	cases := make([]string, 0, len(gt.Cases))
+	for _, typeCase := range gt.Cases {
+		if typeCase.Type == nil {
+			continue
+		}
+		cases = append(cases, formatting.ToPascalCase(typeCase.Tag))
+	}
+
+	return strings.Join(cases, "Or")
+}
+
+// Returns the open generic type parameters used within the node, in order of first use.
+func GetOpenGenericTypeParameters(node dsl.Node) []*dsl.GenericTypeParameter {
+	var res []*dsl.GenericTypeParameter
+	add := func(p *dsl.GenericTypeParameter) {
+		for _, existing := range res {
+			if p == existing {
+				return
+			}
+		}
+		res = append(res, p)
+	}
+
+	dsl.Visit(node, func(self dsl.Visitor, node dsl.Node) {
+		switch t := node.(type) {
+		case *dsl.GenericTypeParameter:
+			add(t)
+		case *dsl.SimpleType:
+			if p, ok := t.ResolvedDefinition.(*dsl.GenericTypeParameter); ok {
+				add(p)
+			}
+		}
+		self.VisitChildren(node)
+	})
+
+	return res
+}
+
+// Returns the type parameter list of a declaration or reference, e.g. "<T1, T2>".
+// If bound is not empty, each parameter is constrained by it, e.g. "<T1: BinaryWrite>".
+func TypeParameters(params []*dsl.GenericTypeParameter, bound string) string {
+	if len(params) == 0 {
+		return ""
+	}
+
+	names := make([]string, len(params))
+	for i, p := range params {
+		names[i] = TypeParameterName(p)
+		if bound != "" {
+			names[i] += ": " + bound
+		}
+	}
+
+	return fmt.Sprintf("<%s>", strings.Join(names, ", "))
+}
+
+func WriteComment(w *formatting.IndentedWriter, comment string) {
+	comment = strings.TrimSpace(comment)
+	if comment != "" {
+		w = formatting.NewIndentedWriter(w, "/// ").Indent()
+		w.WriteStringln(comment)
+	}
+}
+
+func WriteGeneratedFileHeader(w *formatting.IndentedWriter) {
+	w.WriteStringln("// Code generated by the \"yardl\" tool. DO NOT EDIT.")
+	w.WriteStringln("")
+}
+
+func SchemaConstantName(p *dsl.ProtocolDefinition) string {
+	return formatting.ToUpperSnakeCase(p.Name) + "_SCHEMA"
+}
+
+func WriterTraitName(p *dsl.ProtocolDefinition) string {
+	return fmt.Sprintf("%sWriter", formatting.ToPascalCase(p.Name))
+}
+
+func ReaderTraitName(p *dsl.ProtocolDefinition) string {
+	return fmt.Sprintf("%sReader", formatting.ToPascalCase(p.Name))
+}
+
+func ProtocolWriteMethodName(s *dsl.ProtocolStep) string {
+	return "write_" + formatting.ToSnakeCase(s.Name)
+}
+
+func ProtocolWriteEndMethodName(s *dsl.ProtocolStep) string {
+	return "end_" + formatting.ToSnakeCase(s.Name)
+}
+
+func ProtocolReadMethodName(s *dsl.ProtocolStep) string {
+	return "read_" + formatting.ToSnakeCase(s.Name)
+}
+
+// IsNullableUnionDefinition returns true if the type definition is a named
+// type whose definition is a union that includes null. Such a named type is
+// declared as a Rust enum of its non-null cases and referenced as an Option.
+func IsNullableUnionDefinition(td dsl.TypeDefinition) bool {
+	nt, ok := td.(*dsl.NamedType)
+	if !ok {
+		return false
+	}
+
+	gt, ok := nt.Type.(*dsl.GeneralizedType)
+	return ok && gt.Dimensionality == nil && gt.Cases.IsUnion() && gt.Cases.HasNullOption()
+}
+
+// IsHashable returns true if values of the type can be keys of a HashMap.
+// Maps with other key types are represented as vectors of key-value pairs.
+func IsHashable(t dsl.Type) bool {
+	st, ok := dsl.GetUnderlyingType(t).(*dsl.SimpleType)
+	if !ok {
+		return false
+	}
+
+	switch d := st.ResolvedDefinition.(type) {
+	case dsl.PrimitiveDefinition:
+		switch d {
+		case dsl.Float16, dsl.BFloat16, dsl.Float32, dsl.Float64, dsl.ComplexFloat32, dsl.ComplexFloat64:
+			return false
+		}
+		return true
+	case *dsl.EnumDefinition:
+		return true
+	default:
+		return false
+	}
+}
+
+// TypeSyntaxWriter renders types as seen from the module of the namespace given as context.
+var TypeSyntaxWriter dsl.TypeSyntaxWriter[string] = func(self dsl.TypeSyntaxWriter[string], t dsl.Node, namespace string) string {
+	switch t := t.(type) {
+	case dsl.PrimitiveDefinition:
+		switch t {
+		case dsl.Bool:
+			return "bool"
+		case dsl.Int8:
+			return "i8"
+		case dsl.Uint8:
+			return "u8"
+		case dsl.Int16:
+			return "i16"
+		case dsl.Uint16:
+			return "u16"
+		case dsl.Int32:
+			return "i32"
+		case dsl.Uint32:
+			return "u32"
+		case dsl.Int64:
+			return "i64"
+		case dsl.Uint64, dsl.Size:
+			return "u64"
+		case dsl.Float16:
+			return "yardl::Float16"
+		case dsl.BFloat16:
+			return "yardl::BFloat16"
+		case dsl.Float32:
+			return "f32"
+		case dsl.Float64:
+			return "f64"
+		case dsl.ComplexFloat32:
+			return "yardl::Complex<f32>"
+		case dsl.ComplexFloat64:
+			return "yardl::Complex<f64>"
+		case dsl.String:
+			return "String"
+		case dsl.Bytes:
+			return "Vec<u8>"
+		case dsl.Uuid:
+			return "yardl::Uuid"
+		case dsl.Date:
+			return "yardl::Date"
+		case dsl.Time:
+			return "yardl::Time"
+		case dsl.DateTime:
+			return "yardl::DateTime"
+		case dsl.Duration:
+			return "yardl::Duration"
+		default:
+			panic(fmt.Sprintf("primitive '%v' not recognized", t))
+		}
+	case *dsl.GenericTypeParameter:
+		return TypeParameterName(t)
+	case dsl.TypeDefinition:
+		meta := t.GetDefinitionMeta()
+		typeName := TypeIdentifierName(meta.Name)
+		if meta.Namespace != namespace {
+			typeName = fmt.Sprintf("super::%s::%s", ModuleName(meta.Namespace), typeName)
+		}
+		if len(meta.TypeParameters) == 0 {
+			return typeName
+		}
+
+		typeArguments := make([]string, len(meta.TypeParameters))
+		for i, typeParameter := range meta.TypeParameters {
+			if len(meta.TypeArguments) > 0 {
+				typeArguments[i] = self.ToSyntax(meta.TypeArguments[i], namespace)
+			} else {
+				typeArguments[i] = self.ToSyntax(typeParameter, namespace)
+			}
+		}
+
+		return fmt.Sprintf("%s<%s>", typeName, strings.Join(typeArguments, ", "))
+	case *dsl.SimpleType:
+		syntax := self.ToSyntax(t.ResolvedDefinition, namespace)
+		if IsNullableUnionDefinition(t.ResolvedDefinition) {
+			return fmt.Sprintf("Option<%s>", syntax)
+		}
+		return syntax
+	case *dsl.GeneralizedType:
+		scalarString := func() string {
+			if t.Cases.IsSingle() {
+				return self.ToSyntax(t.Cases[0].Type, namespace)
+			}
+			if t.Cases.IsOptional() {
+				return fmt.Sprintf("Option<%s>", indirectTypeSyntax(self, t.Cases[1].Type, namespace))
+			}
+
+			union := UnionTypeName(t) + TypeParameters(GetOpenGenericTypeParameters(t), "")
+			if t.Cases.HasNullOption() {
+				return fmt.Sprintf("Option<%s>", union)
+			}
+			return union
+		}()
+
+		switch d := t.Dimensionality.(type) {
+		case nil, *dsl.Stream:
+			return scalarString
+		case *dsl.Vector:
+			if d.Length != nil {
+				return fmt.Sprintf("[%s; %d]", scalarString, *d.Length)
+			}
+			return fmt.Sprintf("Vec<%s>", scalarString)
+		case *dsl.Array:
+			if d.IsFixed() {
+				dims := *d.Dimensions
+				syntax := scalarString
+				for i := len(dims) - 1; i >= 0; i-- {
+					syntax = fmt.Sprintf("[%s; %d]", syntax, *dims[i].Length)
+				}
+				return syntax
+			}
+			if d.HasKnownNumberOfDimensions() {
+				return fmt.Sprintf("yardl::NDArray<%s, %d>", scalarString, len(*d.Dimensions))
+			}
+			return fmt.Sprintf("yardl::DynamicNDArray<%s>", scalarString)
+		case *dsl.Map:
+			keyString := self.ToSyntax(d.KeyType, namespace)
+			if IsHashable(d.KeyType) {
+				return fmt.Sprintf("HashMap<%s, %s>", keyString, scalarString)
+			}
+			return fmt.Sprintf("Vec<(%s, %s)>", keyString, scalarString)
+		default:
+			panic(fmt.Sprintf("unexpected type %T", d))
+		}
+	default:
+		panic(fmt.Sprintf("unexpected type %T", t))
+	}
+}
+
+func TypeSyntax(namespace string, typeOrTypeDefinition dsl.Node) string {
+	return TypeSyntaxWriter.ToSyntax(typeOrTypeDefinition, namespace)
+}
+
+// Returns the syntax of an optional value or union case. A reference to a
+// recursive record is boxed, since the record would otherwise contain itself.
+func IndirectTypeSyntax(namespace string, t dsl.Type) string {
+	return indirectTypeSyntax(TypeSyntaxWriter, t, namespace)
+}
+
+func indirectTypeSyntax(writer dsl.TypeSyntaxWriter[string], t dsl.Type, namespace string) string {
+	syntax := writer.ToSyntax(t, namespace)
+	if st, ok := t.(*dsl.SimpleType); ok && st.IsRecursiveReference {
+		return fmt.Sprintf("Box<%s>", syntax)
+	}
+	return syntax
+}
+
+// UnionDeclaration describes a union type that is declared in the generated code.
+type UnionDeclaration struct {
+	Name           string
+	TypeParameters []*dsl.GenericTypeParameter
+	Type           *dsl.GeneralizedType
+
+	// Set when the union is the definition of a named type
+	NamedType *dsl.NamedType
+}
+
+// GetUnionDeclarations returns the unions used by a type definition or protocol.
+// Anonymous unions with the same cases may appear more than once.
+func GetUnionDeclarations(td dsl.TypeDefinition) []*UnionDeclaration {
+	var res []*UnionDeclaration
+	visitor := func(self dsl.Visitor, node dsl.Node) {
+		if gt, ok := node.(*dsl.GeneralizedType); ok && gt.Cases.IsUnion() {
+			u := &UnionDeclaration{Type: gt}
+			if nt, ok := td.(*dsl.NamedType); ok && nt.Type == gt && gt.Dimensionality == nil {
+				// This is a named type defining a union, so we use the named type's name
+				u.Name = TypeIdentifierName(nt.Name)
+				u.TypeParameters = nt.TypeParameters
+				u.NamedType = nt
+			} else {
+				u.Name = UnionTypeName(gt)
+				u.TypeParameters = GetOpenGenericTypeParameters(gt)
+			}
+
+			res = append(res, u)
+		}
+		self.VisitChildren(node)
+	}
+
+	switch td := td.(type) {
+	case *dsl.RecordDefinition:
+		for _, field := range td.Fields {
+			dsl.Visit(field.Type, visitor)
+		}
+	case *dsl.NamedType:
+		dsl.Visit(td.Type, visitor)
+	case *dsl.ProtocolDefinition:
+		for _, step := range td.Sequence {
+			dsl.Visit(step.Type, visitor)
+		}
+	}
+
+	return res
+}
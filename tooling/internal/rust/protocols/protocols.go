@@ -0,0 +1,173 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+package protocols
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/microsoft/yardl/tooling/internal/formatting"
+	"github.com/microsoft/yardl/tooling/internal/rust/common"
+	"github.com/microsoft/yardl/tooling/pkg/dsl"
+)
+
+func WriteProtocols(w *formatting.IndentedWriter, ns *dsl.Namespace, st dsl.SymbolTable) {
+	for _, p := range ns.Protocols {
+		writeSchema(w, p, st)
+		writeWriterTrait(w, ns.Name, p)
+		writeReaderTrait(w, ns.Name, p)
+		for _, sub := range p.SubProtocols {
+			writeSubWriter(w, ns.Name, p, sub)
+			writeSubReader(w, ns.Name, p, sub)
+		}
+	}
+}
+
+func writeSchema(w *formatting.IndentedWriter, p *dsl.ProtocolDefinition, st dsl.SymbolTable) {
+	schema := dsl.GetProtocolSchemaString(p, st)
+
+	// Use a raw string literal with enough hashes that the schema cannot terminate it
+	hashes := "#"
+	for strings.Contains(schema, "\""+hashes) {
+		hashes += "#"
+	}
+
+	fmt.Fprintf(w, "/// The schema written to the header of binary %s streams.\n", p.Name)
+	fmt.Fprintf(w, "pub const %s: &str = r%s\"%s\"%s;\n\n", common.SchemaConstantName(p), hashes, schema, hashes)
+}
+
+func writeWriterTrait(w *formatting.IndentedWriter, namespace string, p *dsl.ProtocolDefinition) {
+	common.WriteComment(w, p.Comment)
+	fmt.Fprintf(w, "pub trait %s {\n", common.WriterTraitName(p))
+	w.Indented(func() {
+		for _, step := range p.Sequence {
+			common.WriteComment(w, step.Comment)
+			valueSyntax := common.TypeSyntax(namespace, step.Type)
+			if step.IsStream() {
+				fmt.Fprintf(w, "fn %s(&mut self, values: &[%s]) -> yardl::Result<()>;\n", common.ProtocolWriteMethodName(step), valueSyntax)
+				fmt.Fprintf(w, "/// Marks the end of the %s stream.\n", step.Name)
+				fmt.Fprintf(w, "fn %s(&mut self) -> yardl::Result<()>;\n", common.ProtocolWriteEndMethodName(step))
+			} else {
+				fmt.Fprintf(w, "fn %s(&mut self, value: &%s) -> yardl::Result<()>;\n", common.ProtocolWriteMethodName(step), valueSyntax)
+			}
+		}
+		for _, sub := range p.SubProtocols {
+			fmt.Fprintf(w, "/// Returns a writer for the %s steps, which are written through this writer.\n", sub.Name)
+			common.WriteComment(w, sub.Comment)
+			fmt.Fprintf(w, "fn %s(&mut self) -> %s<'_, Self> where Self: Sized {\n", common.FieldIdentifierName(sub.Name), subWriterName(p, sub))
+			w.Indented(func() {
+				fmt.Fprintf(w, "%s { outer: self }\n", subWriterName(p, sub))
+			})
+			w.WriteStringln("}")
+		}
+		w.WriteStringln("/// Verifies that all steps have been written and flushes the output.")
+		w.WriteStringln("fn close(&mut self) -> yardl::Result<()>;")
+	})
+	w.WriteString("}\n\n")
+}
+
+func writeReaderTrait(w *formatting.IndentedWriter, namespace string, p *dsl.ProtocolDefinition) {
+	common.WriteComment(w, p.Comment)
+	fmt.Fprintf(w, "pub trait %s {\n", common.ReaderTraitName(p))
+	w.Indented(func() {
+		for _, step := range p.Sequence {
+			common.WriteComment(w, step.Comment)
+			valueSyntax := common.TypeSyntax(namespace, step.Type)
+			if step.IsStream() {
+				w.WriteStringln("/// Returns `None` when the stream has no more items.")
+				fmt.Fprintf(w, "fn %s(&mut self) -> yardl::Result<Option<%s>>;\n", common.ProtocolReadMethodName(step), valueSyntax)
+			} else {
+				fmt.Fprintf(w, "fn %s(&mut self) -> yardl::Result<%s>;\n", common.ProtocolReadMethodName(step), valueSyntax)
+			}
+		}
+		for _, sub := range p.SubProtocols {
+			fmt.Fprintf(w, "/// Returns a reader for the %s steps, which are read through this reader.\n", sub.Name)
+			common.WriteComment(w, sub.Comment)
+			fmt.Fprintf(w, "fn %s(&mut self) -> %s<'_, Self> where Self: Sized {\n", common.FieldIdentifierName(sub.Name), subReaderName(p, sub))
+			w.Indented(func() {
+				fmt.Fprintf(w, "%s { outer: self }\n", subReaderName(p, sub))
+			})
+			w.WriteStringln("}")
+		}
+		w.WriteStringln("/// Verifies that all steps have been read.")
+		w.WriteStringln("fn close(&mut self) -> yardl::Result<()>;")
+	})
+	w.WriteString("}\n\n")
+}
+
+// Writes a writer for the sub-protocol that forwards each step to the outer
+// writer's inlined step. The outer writer checks the order of the steps, so
+// closing the sub-protocol writer does nothing.
+func writeSubWriter(w *formatting.IndentedWriter, namespace string, p *dsl.ProtocolDefinition, sub *dsl.SubProtocol) {
+	name := subWriterName(p, sub)
+	fmt.Fprintf(w, "/// Writes the %s steps of %s through its writer.\n", sub.Name, p.Name)
+	fmt.Fprintf(w, "pub struct %s<'a, W: %s> {\n", name, common.WriterTraitName(p))
+	w.Indented(func() {
+		w.WriteStringln("outer: &'a mut W,")
+	})
+	w.WriteString("}\n\n")
+
+	fmt.Fprintf(w, "impl<'a, W: %s> %s for %s<'a, W> {\n", common.WriterTraitName(p), common.WriterTraitName(sub.Protocol), name)
+	w.Indented(func() {
+		outerSteps := sub.Steps(p)
+		for i, step := range sub.Protocol.Sequence {
+			outerStep := outerSteps[i]
+			valueSyntax := common.TypeSyntax(namespace, step.Type)
+			if step.IsStream() {
+				fmt.Fprintf(w, "fn %s(&mut self, values: &[%s]) -> yardl::Result<()> {\n", common.ProtocolWriteMethodName(step), valueSyntax)
+				fmt.Fprintf(w.Indent(), "self.outer.%s(values)\n", common.ProtocolWriteMethodName(outerStep))
+				w.WriteStringln("}")
+				fmt.Fprintf(w, "fn %s(&mut self) -> yardl::Result<()> {\n", common.ProtocolWriteEndMethodName(step))
+				fmt.Fprintf(w.Indent(), "self.outer.%s()\n", common.ProtocolWriteEndMethodName(outerStep))
+				w.WriteStringln("}")
+			} else {
+				fmt.Fprintf(w, "fn %s(&mut self, value: &%s) -> yardl::Result<()> {\n", common.ProtocolWriteMethodName(step), valueSyntax)
+				fmt.Fprintf(w.Indent(), "self.outer.%s(value)\n", common.ProtocolWriteMethodName(outerStep))
+				w.WriteStringln("}")
+			}
+		}
+		w.WriteStringln("fn close(&mut self) -> yardl::Result<()> {")
+		w.Indent().WriteStringln("Ok(())")
+		w.WriteStringln("}")
+	})
+	w.WriteString("}\n\n")
+}
+
+// Writes a reader for the sub-protocol that forwards each step to the outer
+// reader's inlined step.
+func writeSubReader(w *formatting.IndentedWriter, namespace string, p *dsl.ProtocolDefinition, sub *dsl.SubProtocol) {
+	name := subReaderName(p, sub)
+	fmt.Fprintf(w, "/// Reads the %s steps of %s through its reader.\n", sub.Name, p.Name)
+	fmt.Fprintf(w, "pub struct %s<'a, R: %s> {\n", name, common.ReaderTraitName(p))
+	w.Indented(func() {
+		w.WriteStringln("outer: &'a mut R,")
+	})
+	w.WriteString("}\n\n")
+
+	fmt.Fprintf(w, "impl<'a, R: %s> %s for %s<'a, R> {\n", common.ReaderTraitName(p), common.ReaderTraitName(sub.Protocol), name)
+	w.Indented(func() {
+		outerSteps := sub.Steps(p)
+		for i, step := range sub.Protocol.Sequence {
+			valueSyntax := common.TypeSyntax(namespace, step.Type)
+			if step.IsStream() {
+				valueSyntax = fmt.Sprintf("Option<%s>", valueSyntax)
+			}
+			fmt.Fprintf(w, "fn %s(&mut self) -> yardl::Result<%s> {\n", common.ProtocolReadMethodName(step), valueSyntax)
+			fmt.Fprintf(w.Indent(), "self.outer.%s()\n", common.ProtocolReadMethodName(outerSteps[i]))
+			w.WriteStringln("}")
+		}
+		w.WriteStringln("fn close(&mut self) -> yardl::Result<()> {")
+		w.Indent().WriteStringln("Ok(())")
+		w.WriteStringln("}")
+	})
+	w.WriteString("}\n\n")
+}
+
+func subWriterName(p *dsl.ProtocolDefinition, sub *dsl.SubProtocol) string {
+	return fmt.Sprintf("%s%sSubWriter", p.Name, formatting.ToPascalCase(sub.Name))
+}
+
+func subReaderName(p *dsl.ProtocolDefinition, sub *dsl.SubProtocol) string {
+	return fmt.Sprintf("%s%sSubReader", p.Name, formatting.ToPascalCase(sub.Name))
+}
@@ -0,0 +1,80 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+package rust
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"os"
+	"path"
+
+	"github.com/microsoft/yardl/tooling/internal/formatting"
+	"github.com/microsoft/yardl/tooling/internal/iocommon"
+	"github.com/microsoft/yardl/tooling/internal/rust/binary"
+	"github.com/microsoft/yardl/tooling/internal/rust/common"
+	"github.com/microsoft/yardl/tooling/internal/rust/protocols"
+	"github.com/microsoft/yardl/tooling/internal/rust/types"
+	"github.com/microsoft/yardl/tooling/pkg/dsl"
+	"github.com/microsoft/yardl/tooling/pkg/packaging"
+)
+
+//go:embed static_files/*.rs
+var staticFiles embed.FS
+
+func Generate(env *dsl.Environment, options packaging.RustCodegenOptions) error {
+	err := os.MkdirAll(options.OutputDir, 0775)
+	if err != nil {
+		return err
+	}
+
+	runtimeDir := path.Join(options.OutputDir, common.RuntimeModuleName)
+	if err := iocommon.CopyEmbeddedStaticFiles(runtimeDir, options.InternalSymlinkStaticFiles, staticFiles); err != nil {
+		return err
+	}
+
+	if err := writeRootModule(env, options); err != nil {
+		return err
+	}
+
+	for _, ns := range env.Namespaces {
+		if err := writeNamespaceModule(ns, env.SymbolTable, options); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func writeRootModule(env *dsl.Environment, options packaging.RustCodegenOptions) error {
+	b := bytes.Buffer{}
+	w := formatting.NewIndentedWriter(&b, "    ")
+	common.WriteGeneratedFileHeader(w)
+
+	fmt.Fprintf(w, "pub mod %s;\n", common.RuntimeModuleName)
+	for _, ns := range env.Namespaces {
+		fmt.Fprintf(w, "pub mod %s;\n", common.ModuleName(ns.Name))
+	}
+
+	return iocommon.WriteFileIfNeeded(path.Join(options.OutputDir, "mod.rs"), b.Bytes(), 0644)
+}
+
+func writeNamespaceModule(ns *dsl.Namespace, st dsl.SymbolTable, options packaging.RustCodegenOptions) error {
+	b := bytes.Buffer{}
+	w := formatting.NewIndentedWriter(&b, "    ")
+	common.WriteGeneratedFileHeader(w)
+
+	w.WriteStringln("#![allow(dead_code, unused_imports, unused_variables, non_camel_case_types)]")
+	w.WriteStringln("")
+	w.WriteStringln("use std::collections::HashMap;")
+	w.WriteStringln("use std::io::{Read, Write};")
+	w.WriteStringln("")
+	fmt.Fprintf(w, "use super::%s::{self, BinaryRead, BinaryReader, BinaryWrite, BinaryWriter};\n\n", common.RuntimeModuleName)
+
+	types.WriteTypes(w, ns, st)
+	protocols.WriteProtocols(w, ns, st)
+	binary.WriteBinary(w, ns)
+
+	return iocommon.WriteFileIfNeeded(common.ModuleFilePath(options, ns.Name), append(bytes.TrimRight(b.Bytes(), "\n"), '\n'), 0644)
+}
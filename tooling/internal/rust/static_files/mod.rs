@@ -0,0 +1,812 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+//! Runtime support for code generated by the yardl tool.
+
+#![allow(dead_code)]
+
+use std::collections::HashMap;
+use std::fmt;
+use std::hash::Hash;
+use std::io::{self, BufReader, BufWriter, Read, Write};
+
+const MAGIC_BYTES: &[u8] = b"yardl";
+const CURRENT_BINARY_FORMAT_VERSION: u32 = 1;
+
+#[derive(Debug)]
+pub enum Error {
+    /// An error from the underlying reader or writer.
+    Io(io::Error),
+    /// The methods of a protocol reader or writer were called out of order.
+    Protocol { expected: String, received: String },
+    /// The data is not valid for the expected type.
+    InvalidData(String),
+    /// A field does not satisfy its constraints.
+    ConstraintViolation(String),
+}
+
+impl fmt::Display for Error {
+    fn fmt(&self, f: &mut fmt::Formatter<'_>) -> fmt::Result {
+        match self {
+            Error::Io(e) => e.fmt(f),
+            Error::Protocol { expected, received } => write!(
+                f,
+                "Expected call to {} but received call to {} instead.",
+                expected, received
+            ),
+            Error::InvalidData(message) | Error::ConstraintViolation(message) => f.write_str(message),
+        }
+    }
+}
+
+impl std::error::Error for Error {
+    fn source(&self) -> Option<&(dyn std::error::Error + 'static)> {
+        match self {
+            Error::Io(e) => Some(e),
+            _ => None,
+        }
+    }
+}
+
+impl From<io::Error> for Error {
+    fn from(e: io::Error) -> Self {
+        Error::Io(e)
+    }
+}
+
+pub type Result<T> = std::result::Result<T, Error>;
+
+/// A complex number with real and imaginary parts.
+#[derive(Debug, Clone, Copy, PartialEq, Default)]
+pub struct Complex<T> {
+    pub re: T,
+    pub im: T,
+}
+
+impl<T> Complex<T> {
+    pub fn new(re: T, im: T) -> Self {
+        Complex { re, im }
+    }
+}
+
+/// An IEEE 754 half-precision floating-point number, stored as its bits.
+#[derive(Debug, Clone, Copy, PartialEq, Eq, Hash, Default)]
+pub struct Float16(pub u16);
+
+impl Float16 {
+    /// Rounds the value to the nearest representable value, with ties to even.
+    pub fn from_f32(value: f32) -> Self {
+        let bits = value.to_bits();
+        let sign = ((bits >> 16) & 0x8000) as u16;
+        let exponent = ((bits >> 23) & 0xff) as i32;
+        let mantissa = bits & 0x7f_ffff;
+
+        if exponent == 0xff {
+            return Float16(sign | if mantissa != 0 { 0x7e00 } else { 0x7c00 });
+        }
+
+        let e = exponent - 127 + 15;
+        if e >= 0x1f {
+            return Float16(sign | 0x7c00);
+        }
+
+        if e <= 0 {
+            if e < -10 {
+                return Float16(sign);
+            }
+            return Float16(sign | round_shift(mantissa | 0x80_0000, (14 - e) as u32) as u16);
+        }
+
+        Float16(sign | (((e as u32) << 10) + round_shift(mantissa, 13)) as u16)
+    }
+
+    pub fn to_f32(self) -> f32 {
+        let sign = ((self.0 & 0x8000) as u32) << 16;
+        let exponent = ((self.0 >> 10) & 0x1f) as u32;
+        let mut mantissa = (self.0 & 0x3ff) as u32;
+
+        match exponent {
+            0 if mantissa == 0 => f32::from_bits(sign),
+            0 => {
+                let mut e = 127 - 15 + 1;
+                while mantissa & 0x400 == 0 {
+                    mantissa <<= 1;
+                    e -= 1;
+                }
+                f32::from_bits(sign | (e << 23) | ((mantissa & 0x3ff) << 13))
+            }
+            0x1f => f32::from_bits(sign | (0xff << 23) | (mantissa << 13)),
+            _ => f32::from_bits(sign | ((exponent + 127 - 15) << 23) | (mantissa << 13)),
+        }
+    }
+}
+
+/// A bfloat16 floating-point number, which has the exponent range of an f32,
+/// stored as its bits.
+#[derive(Debug, Clone, Copy, PartialEq, Eq, Hash, Default)]
+pub struct BFloat16(pub u16);
+
+impl BFloat16 {
+    /// Rounds the value to the nearest representable value, with ties to even.
+    pub fn from_f32(value: f32) -> Self {
+        let bits = value.to_bits();
+        if bits & 0x7fff_ffff > 0x7f80_0000 {
+            return BFloat16((bits >> 16) as u16 | 0x40);
+        }
+        BFloat16(round_shift(bits, 16) as u16)
+    }
+
+    pub fn to_f32(self) -> f32 {
+        f32::from_bits((self.0 as u32) << 16)
+    }
+}
+
+/// Shifts the value right, rounding to the nearest value with ties to even.
+fn round_shift(value: u32, shift: u32) -> u32 {
+    let result = value >> shift;
+    let remainder = value & ((1 << shift) - 1);
+    let half = 1 << (shift - 1);
+    if remainder > half || (remainder == half && result & 1 == 1) {
+        result + 1
+    } else {
+        result
+    }
+}
+
+/// A UUID, with its bytes in the order of its canonical string form.
+#[derive(Debug, Clone, Copy, PartialEq, Eq, PartialOrd, Ord, Hash, Default)]
+pub struct Uuid(pub [u8; 16]);
+
+impl fmt::Display for Uuid {
+    fn fmt(&self, f: &mut fmt::Formatter<'_>) -> fmt::Result {
+        for (i, b) in self.0.iter().enumerate() {
+            if i == 4 || i == 6 || i == 8 || i == 10 {
+                f.write_str("-")?;
+            }
+            write!(f, "{:02x}", b)?;
+        }
+        Ok(())
+    }
+}
+
+impl std::str::FromStr for Uuid {
+    type Err = Error;
+
+    /// Parses a UUID in its canonical form, e.g. "123e4567-e89b-12d3-a456-426614174000".
+    fn from_str(s: &str) -> Result<Self> {
+        let invalid = || Error::InvalidData(format!("invalid UUID '{}'", s));
+        let s_bytes = s.as_bytes();
+        if s_bytes.len() != 36 || [8, 13, 18, 23].iter().any(|&i| s_bytes[i] != b'-') {
+            return Err(invalid());
+        }
+        let mut digits = Vec::with_capacity(32);
+        for (i, &c) in s_bytes.iter().enumerate() {
+            if [8, 13, 18, 23].contains(&i) {
+                continue;
+            }
+            if !c.is_ascii_hexdigit() {
+                return Err(invalid());
+            }
+            digits.push(c);
+        }
+        let mut bytes = [0u8; 16];
+        for (i, pair) in digits.chunks(2).enumerate() {
+            let hex = std::str::from_utf8(pair).map_err(|_| invalid())?;
+            bytes[i] = u8::from_str_radix(hex, 16).map_err(|_| invalid())?;
+        }
+        Ok(Uuid(bytes))
+    }
+}
+
+/// The number of days since the Unix epoch.
+#[derive(Debug, Clone, Copy, PartialEq, Eq, PartialOrd, Ord, Hash, Default)]
+pub struct Date(pub i64);
+
+/// The number of nanoseconds since midnight.
+#[derive(Debug, Clone, Copy, PartialEq, Eq, PartialOrd, Ord, Hash, Default)]
+pub struct Time(pub i64);
+
+impl Time {
+    pub fn from_hms_nano(hour: u32, minute: u32, second: u32, nanosecond: u32) -> Self {
+        Time(
+            ((hour as i64 * 60 + minute as i64) * 60 + second as i64) * 1_000_000_000
+                + nanosecond as i64,
+        )
+    }
+}
+
+/// The number of nanoseconds since the Unix epoch.
+#[derive(Debug, Clone, Copy, PartialEq, Eq, PartialOrd, Ord, Hash, Default)]
+pub struct DateTime(pub i64);
+
+/// A signed number of nanoseconds.
+#[derive(Debug, Clone, Copy, PartialEq, Eq, PartialOrd, Ord, Hash, Default)]
+pub struct Duration(pub i64);
+
+/// A multidimensional array with a fixed number of dimensions, stored in row-major order.
+#[derive(Debug, Clone, PartialEq)]
+pub struct NDArray<T, const N: usize> {
+    pub shape: [usize; N],
+    pub data: Vec<T>,
+}
+
+impl<T: Clone + Default, const N: usize> NDArray<T, N> {
+    /// Creates an array of the given shape filled with default values.
+    pub fn new(shape: [usize; N]) -> Self {
+        let len = shape.iter().product();
+        NDArray {
+            shape,
+            data: vec![T::default(); len],
+        }
+    }
+}
+
+impl<T, const N: usize> Default for NDArray<T, N> {
+    fn default() -> Self {
+        NDArray {
+            shape: [0; N],
+            data: Vec::new(),
+        }
+    }
+}
+
+/// A multidimensional array with any number of dimensions, stored in row-major order.
+#[derive(Debug, Clone, PartialEq, Default)]
+pub struct DynamicNDArray<T> {
+    pub shape: Vec<usize>,
+    pub data: Vec<T>,
+}
+
+impl<T: Clone + Default> DynamicNDArray<T> {
+    /// Creates an array of the given shape filled with default values.
+    pub fn new(shape: Vec<usize>) -> Self {
+        let len = shape.iter().product();
+        DynamicNDArray {
+            shape,
+            data: vec![T::default(); len],
+        }
+    }
+}
+
+/// Writes values in the yardl binary format.
+pub struct BinaryWriter<W: Write> {
+    w: BufWriter<W>,
+}
+
+impl<W: Write> BinaryWriter<W> {
+    pub fn new(w: W) -> Self {
+        BinaryWriter {
+            w: BufWriter::new(w),
+        }
+    }
+
+    pub fn write_bytes(&mut self, bytes: &[u8]) -> Result<()> {
+        self.w.write_all(bytes)?;
+        Ok(())
+    }
+
+    pub fn write_uvarint(&mut self, mut value: u64) -> Result<()> {
+        let mut buf = [0u8; 10];
+        let mut i = 0;
+        while value >= 0x80 {
+            buf[i] = (value as u8) | 0x80;
+            value >>= 7;
+            i += 1;
+        }
+        buf[i] = value as u8;
+        self.write_bytes(&buf[..=i])
+    }
+
+    pub fn write_varint(&mut self, value: i64) -> Result<()> {
+        self.write_uvarint(((value << 1) ^ (value >> 63)) as u64)
+    }
+
+    /// Writes the magic bytes, format version, and protocol schema.
+    pub fn write_header(&mut self, schema: &str) -> Result<()> {
+        self.write_bytes(MAGIC_BYTES)?;
+        self.write_bytes(&CURRENT_BINARY_FORMAT_VERSION.to_le_bytes())?;
+        schema.write(self)
+    }
+
+    /// Writes a non-empty block of stream items prefixed with its length.
+    pub fn write_block<T: BinaryWrite>(&mut self, values: &[T]) -> Result<()> {
+        if values.is_empty() {
+            return Ok(());
+        }
+        self.write_uvarint(values.len() as u64)?;
+        values.iter().try_for_each(|v| v.write(self))
+    }
+
+    /// Writes the empty block that terminates a stream.
+    pub fn write_end_of_stream(&mut self) -> Result<()> {
+        self.write_uvarint(0)
+    }
+
+    pub fn flush(&mut self) -> Result<()> {
+        self.w.flush()?;
+        Ok(())
+    }
+}
+
+/// Reads values in the yardl binary format.
+pub struct BinaryReader<R: Read> {
+    r: BufReader<R>,
+}
+
+impl<R: Read> BinaryReader<R> {
+    pub fn new(r: R) -> Self {
+        BinaryReader {
+            r: BufReader::new(r),
+        }
+    }
+
+    pub fn read_bytes(&mut self, buf: &mut [u8]) -> Result<()> {
+        self.r.read_exact(buf)?;
+        Ok(())
+    }
+
+    pub fn read_byte(&mut self) -> Result<u8> {
+        let mut buf = [0u8; 1];
+        self.read_bytes(&mut buf)?;
+        Ok(buf[0])
+    }
+
+    pub fn read_uvarint(&mut self) -> Result<u64> {
+        let mut value = 0u64;
+        let mut shift = 0;
+        loop {
+            let b = self.read_byte()?;
+            if shift == 63 && b > 1 {
+                return Err(Error::InvalidData(
+                    "varint overflows a 64-bit integer".to_string(),
+                ));
+            }
+            value |= ((b & 0x7f) as u64) << shift;
+            if b & 0x80 == 0 {
+                return Ok(value);
+            }
+            shift += 7;
+        }
+    }
+
+    pub fn read_varint(&mut self) -> Result<i64> {
+        let value = self.read_uvarint()?;
+        Ok(((value >> 1) as i64) ^ -((value & 1) as i64))
+    }
+
+    /// Reads the magic bytes, format version, and protocol schema.
+    /// If `expected_schema` is given, the schema in the stream must match it.
+    pub fn read_header(&mut self, expected_schema: Option<&str>) -> Result<String> {
+        let mut magic = [0u8; 5];
+        self.read_bytes(&mut magic)?;
+        if magic != MAGIC_BYTES {
+            return Err(Error::InvalidData("invalid magic bytes".to_string()));
+        }
+
+        let mut version = [0u8; 4];
+        self.read_bytes(&mut version)?;
+        let version = u32::from_le_bytes(version);
+        if version != CURRENT_BINARY_FORMAT_VERSION {
+            return Err(Error::InvalidData(format!(
+                "unsupported binary format version {}",
+                version
+            )));
+        }
+
+        let schema = String::read(self)?;
+        if let Some(expected) = expected_schema {
+            if schema != expected {
+                return Err(Error::InvalidData(
+                    "the schema in the stream does not match the expected schema".to_string(),
+                ));
+            }
+        }
+
+        Ok(schema)
+    }
+
+    /// Reads the next item of a stream, given the number of items remaining in
+    /// the current block. Returns `None` when the stream has ended.
+    pub fn read_stream_item<T: BinaryRead>(&mut self, remaining: &mut u64) -> Result<Option<T>> {
+        if *remaining == 0 {
+            *remaining = self.read_uvarint()?;
+            if *remaining == 0 {
+                return Ok(None);
+            }
+        }
+        *remaining -= 1;
+        T::read(self).map(Some)
+    }
+}
+
+/// Types that can be written in the yardl binary format.
+pub trait BinaryWrite {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> Result<()>;
+
+    /// Writes the value as the non-null case of an optional.
+    /// Unions that include a null case override this to write a single index.
+    fn write_some<W: Write>(&self, w: &mut BinaryWriter<W>) -> Result<()> {
+        w.write_uvarint(1)?;
+        self.write(w)
+    }
+}
+
+/// Types that can be read from the yardl binary format.
+pub trait BinaryRead: Sized {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> Result<Self>;
+
+    /// Reads an optional value. Unions that include a null case override this
+    /// to read a single index.
+    fn read_optional<R: Read>(r: &mut BinaryReader<R>) -> Result<Option<Self>> {
+        match r.read_uvarint()? {
+            0 => Ok(None),
+            1 => Self::read(r).map(Some),
+            index => Err(Error::InvalidData(format!(
+                "unexpected optional index {}",
+                index
+            ))),
+        }
+    }
+}
+
+impl BinaryWrite for bool {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> Result<()> {
+        w.write_bytes(&[*self as u8])
+    }
+}
+
+impl BinaryRead for bool {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> Result<Self> {
+        Ok(r.read_byte()? != 0)
+    }
+}
+
+impl BinaryWrite for i8 {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> Result<()> {
+        w.write_bytes(&[*self as u8])
+    }
+}
+
+impl BinaryRead for i8 {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> Result<Self> {
+        Ok(r.read_byte()? as i8)
+    }
+}
+
+impl BinaryWrite for u8 {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> Result<()> {
+        w.write_bytes(&[*self])
+    }
+}
+
+impl BinaryRead for u8 {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> Result<Self> {
+        r.read_byte()
+    }
+}
+
+macro_rules! impl_varint {
+    ($($t:ty),*) => {
+        $(
+            impl BinaryWrite for $t {
+                fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> Result<()> {
+                    w.write_varint(*self as i64)
+                }
+            }
+
+            impl BinaryRead for $t {
+                fn read<R: Read>(r: &mut BinaryReader<R>) -> Result<Self> {
+                    let value = r.read_varint()?;
+                    <$t>::try_from(value).map_err(|_| {
+                        Error::InvalidData(format!("value {} out of range for {}", value, stringify!($t)))
+                    })
+                }
+            }
+        )*
+    };
+}
+
+macro_rules! impl_uvarint {
+    ($($t:ty),*) => {
+        $(
+            impl BinaryWrite for $t {
+                fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> Result<()> {
+                    w.write_uvarint(*self as u64)
+                }
+            }
+
+            impl BinaryRead for $t {
+                fn read<R: Read>(r: &mut BinaryReader<R>) -> Result<Self> {
+                    let value = r.read_uvarint()?;
+                    <$t>::try_from(value).map_err(|_| {
+                        Error::InvalidData(format!("value {} out of range for {}", value, stringify!($t)))
+                    })
+                }
+            }
+        )*
+    };
+}
+
+impl_varint!(i16, i32, i64);
+impl_uvarint!(u16, u32, u64);
+
+macro_rules! impl_float {
+    ($($t:ty),*) => {
+        $(
+            impl BinaryWrite for $t {
+                fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> Result<()> {
+                    w.write_bytes(&self.to_le_bytes())
+                }
+            }
+
+            impl BinaryRead for $t {
+                fn read<R: Read>(r: &mut BinaryReader<R>) -> Result<Self> {
+                    let mut buf = [0u8; std::mem::size_of::<$t>()];
+                    r.read_bytes(&mut buf)?;
+                    Ok(<$t>::from_le_bytes(buf))
+                }
+            }
+        )*
+    };
+}
+
+impl_float!(f32, f64);
+
+macro_rules! impl_half {
+    ($($t:ident),*) => {
+        $(
+            impl BinaryWrite for $t {
+                fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> Result<()> {
+                    w.write_bytes(&self.0.to_le_bytes())
+                }
+            }
+
+            impl BinaryRead for $t {
+                fn read<R: Read>(r: &mut BinaryReader<R>) -> Result<Self> {
+                    let mut buf = [0u8; 2];
+                    r.read_bytes(&mut buf)?;
+                    Ok($t(u16::from_le_bytes(buf)))
+                }
+            }
+        )*
+    };
+}
+
+impl_half!(Float16, BFloat16);
+
+impl BinaryWrite for Uuid {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> Result<()> {
+        w.write_bytes(&self.0)
+    }
+}
+
+impl BinaryRead for Uuid {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> Result<Self> {
+        let mut buf = [0u8; 16];
+        r.read_bytes(&mut buf)?;
+        Ok(Uuid(buf))
+    }
+}
+
+impl<T: BinaryWrite> BinaryWrite for Complex<T> {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> Result<()> {
+        self.re.write(w)?;
+        self.im.write(w)
+    }
+}
+
+impl<T: BinaryRead> BinaryRead for Complex<T> {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> Result<Self> {
+        let re = T::read(r)?;
+        let im = T::read(r)?;
+        Ok(Complex { re, im })
+    }
+}
+
+impl BinaryWrite for str {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> Result<()> {
+        w.write_uvarint(self.len() as u64)?;
+        w.write_bytes(self.as_bytes())
+    }
+}
+
+impl BinaryWrite for String {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> Result<()> {
+        self.as_str().write(w)
+    }
+}
+
+impl BinaryRead for String {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> Result<Self> {
+        let len = r.read_uvarint()? as usize;
+        let mut buf = vec![0u8; len];
+        r.read_bytes(&mut buf)?;
+        String::from_utf8(buf).map_err(|e| Error::InvalidData(e.to_string()))
+    }
+}
+
+macro_rules! impl_newtype {
+    ($($t:ident),*) => {
+        $(
+            impl BinaryWrite for $t {
+                fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> Result<()> {
+                    w.write_varint(self.0)
+                }
+            }
+
+            impl BinaryRead for $t {
+                fn read<R: Read>(r: &mut BinaryReader<R>) -> Result<Self> {
+                    Ok($t(r.read_varint()?))
+                }
+            }
+        )*
+    };
+}
+
+impl_newtype!(Date, Time, DateTime, Duration);
+
+impl<T: BinaryWrite> BinaryWrite for Option<T> {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> Result<()> {
+        match self {
+            None => w.write_uvarint(0),
+            Some(value) => value.write_some(w),
+        }
+    }
+}
+
+impl<T: BinaryRead> BinaryRead for Option<T> {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> Result<Self> {
+        T::read_optional(r)
+    }
+}
+
+impl<T: BinaryWrite> BinaryWrite for Box<T> {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> Result<()> {
+        (**self).write(w)
+    }
+
+    fn write_some<W: Write>(&self, w: &mut BinaryWriter<W>) -> Result<()> {
+        (**self).write_some(w)
+    }
+}
+
+impl<T: BinaryRead> BinaryRead for Box<T> {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> Result<Self> {
+        T::read(r).map(Box::new)
+    }
+
+    fn read_optional<R: Read>(r: &mut BinaryReader<R>) -> Result<Option<Self>> {
+        Ok(T::read_optional(r)?.map(Box::new))
+    }
+}
+
+impl<T: BinaryWrite> BinaryWrite for Vec<T> {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> Result<()> {
+        w.write_uvarint(self.len() as u64)?;
+        self.iter().try_for_each(|v| v.write(w))
+    }
+}
+
+impl<T: BinaryRead> BinaryRead for Vec<T> {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> Result<Self> {
+        let len = r.read_uvarint()? as usize;
+        read_elements(r, len)
+    }
+}
+
+fn read_elements<T: BinaryRead, R: Read>(r: &mut BinaryReader<R>, len: usize) -> Result<Vec<T>> {
+    // Avoid preallocating based on untrusted lengths
+    let mut values = Vec::with_capacity(len.min(4096));
+    for _ in 0..len {
+        values.push(T::read(r)?);
+    }
+    Ok(values)
+}
+
+impl<T: BinaryWrite, const N: usize> BinaryWrite for [T; N] {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> Result<()> {
+        self.iter().try_for_each(|v| v.write(w))
+    }
+}
+
+impl<T: BinaryRead, const N: usize> BinaryRead for [T; N] {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> Result<Self> {
+        let values = read_elements(r, N)?;
+        Ok(values.try_into().unwrap_or_else(|_| unreachable!()))
+    }
+}
+
+impl<K: BinaryWrite, V: BinaryWrite> BinaryWrite for HashMap<K, V> {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> Result<()> {
+        w.write_uvarint(self.len() as u64)?;
+        for (k, v) in self {
+            k.write(w)?;
+            v.write(w)?;
+        }
+        Ok(())
+    }
+}
+
+impl<K: BinaryRead + Eq + Hash, V: BinaryRead> BinaryRead for HashMap<K, V> {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> Result<Self> {
+        let len = r.read_uvarint()? as usize;
+        let mut map = HashMap::with_capacity(len.min(4096));
+        for _ in 0..len {
+            let k = K::read(r)?;
+            let v = V::read(r)?;
+            map.insert(k, v);
+        }
+        Ok(map)
+    }
+}
+
+/// Maps whose keys cannot be hashed (e.g. floating-point keys) are represented
+/// as vectors of key-value pairs, which have the same encoding.
+impl<K: BinaryWrite, V: BinaryWrite> BinaryWrite for (K, V) {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> Result<()> {
+        self.0.write(w)?;
+        self.1.write(w)
+    }
+}
+
+impl<K: BinaryRead, V: BinaryRead> BinaryRead for (K, V) {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> Result<Self> {
+        let k = K::read(r)?;
+        let v = V::read(r)?;
+        Ok((k, v))
+    }
+}
+
+fn write_array_data<T: BinaryWrite, W: Write>(
+    w: &mut BinaryWriter<W>,
+    shape: &[usize],
+    data: &[T],
+) -> Result<()> {
+    if shape.iter().product::<usize>() != data.len() {
+        return Err(Error::InvalidData(format!(
+            "array data length {} does not match its shape {:?}",
+            data.len(),
+            shape
+        )));
+    }
+    for dim in shape {
+        w.write_uvarint(*dim as u64)?;
+    }
+    data.iter().try_for_each(|v| v.write(w))
+}
+
+impl<T: BinaryWrite, const N: usize> BinaryWrite for NDArray<T, N> {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> Result<()> {
+        write_array_data(w, &self.shape, &self.data)
+    }
+}
+
+impl<T: BinaryRead, const N: usize> BinaryRead for NDArray<T, N> {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> Result<Self> {
+        let mut shape = [0usize; N];
+        for dim in shape.iter_mut() {
+            *dim = r.read_uvarint()? as usize;
+        }
+        let data = read_elements(r, shape.iter().product())?;
+        Ok(NDArray { shape, data })
+    }
+}
+
+impl<T: BinaryWrite> BinaryWrite for DynamicNDArray<T> {
+    fn write<W: Write>(&self, w: &mut BinaryWriter<W>) -> Result<()> {
+        w.write_uvarint(self.shape.len() as u64)?;
+        write_array_data(w, &self.shape, &self.data)
+    }
+}
+
+impl<T: BinaryRead> BinaryRead for DynamicNDArray<T> {
+    fn read<R: Read>(r: &mut BinaryReader<R>) -> Result<Self> {
+        let rank = r.read_uvarint()? as usize;
+        let mut shape = Vec::with_capacity(rank.min(64));
+        for _ in 0..rank {
+            shape.push(r.read_uvarint()? as usize);
+        }
+        let data = read_elements(r, shape.iter().product())?;
+        Ok(DynamicNDArray { shape, data })
+    }
+}
@@ -0,0 +1,317 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+package types
+
+import (
+	"fmt"
+
+	"github.com/microsoft/yardl/tooling/internal/formatting"
+	"github.com/microsoft/yardl/tooling/internal/rust/common"
+	"github.com/microsoft/yardl/tooling/pkg/dsl"
+)
+
+func WriteTypes(w *formatting.IndentedWriter, ns *dsl.Namespace, st dsl.SymbolTable) {
+	unions := make(map[string]bool)
+	for _, td := range ns.TypeDefinitions {
+		for _, u := range common.GetUnionDeclarations(td) {
+			if !unions[u.Name] {
+				unions[u.Name] = true
+				writeUnion(w, ns.Name, u)
+			}
+		}
+
+		switch td := td.(type) {
+		case *dsl.EnumDefinition:
+			if td.IsFlags {
+				writeFlags(w, ns.Name, td)
+			} else {
+				writeEnum(w, ns.Name, td)
+			}
+		case *dsl.RecordDefinition:
+			writeRecord(w, ns.Name, td)
+			if dsl.TypeHasConstraints(&dsl.SimpleType{ResolvedDefinition: td}, st) {
+				writeValidateMethod(w, td, st)
+			}
+		case *dsl.NamedType:
+			if gt, ok := td.Type.(*dsl.GeneralizedType); !ok || !gt.Cases.IsUnion() || gt.Dimensionality != nil {
+				writeNamedType(w, ns.Name, td)
+			}
+		default:
+			panic(fmt.Sprintf("unsupported type definition: %T", td))
+		}
+	}
+
+	for _, p := range ns.Protocols {
+		for _, u := range common.GetUnionDeclarations(p) {
+			if !unions[u.Name] {
+				unions[u.Name] = true
+				writeUnion(w, ns.Name, u)
+			}
+		}
+	}
+}
+
+func writeUnion(w *formatting.IndentedWriter, namespace string, u *common.UnionDeclaration) {
+	if u.NamedType != nil {
+		common.WriteComment(w, u.NamedType.Comment)
+	}
+	w.WriteStringln("#[derive(Debug, Clone, PartialEq)]")
+	fmt.Fprintf(w, "pub enum %s%s {\n", u.Name, common.TypeParameters(u.TypeParameters, ""))
+	w.Indented(func() {
+		for _, typeCase := range u.Type.Cases {
+			if typeCase.Type != nil {
+				fmt.Fprintf(w, "%s(%s),\n", common.UnionVariantName(typeCase), common.IndirectTypeSyntax(namespace, typeCase.Type))
+			}
+		}
+	})
+	w.WriteString("}\n\n")
+}
+
+// EnumBaseType returns the primitive type that the values of an enum or flags are stored as.
+func EnumBaseType(enum *dsl.EnumDefinition) dsl.PrimitiveDefinition {
+	baseType := dsl.Type(dsl.Int32Type)
+	if enum.BaseType != nil {
+		baseType = enum.BaseType
+	}
+	primitive, ok := dsl.GetPrimitiveType(baseType)
+	if !ok {
+		panic(fmt.Sprintf("enum base type %s is not a primitive", dsl.TypeToShortSyntax(baseType, true)))
+	}
+	return primitive
+}
+
+func writeEnum(w *formatting.IndentedWriter, namespace string, enum *dsl.EnumDefinition) {
+	common.WriteComment(w, enum.Comment)
+	w.WriteStringln("#[derive(Debug, Clone, Copy, PartialEq, Eq, Hash)]")
+	if len(enum.Values) > 0 {
+		fmt.Fprintf(w, "#[repr(%s)]\n", common.TypeSyntax(namespace, EnumBaseType(enum)))
+	}
+	fmt.Fprintf(w, "pub enum %s {\n", common.TypeIdentifierName(enum.Name))
+	w.Indented(func() {
+		for _, value := range enum.Values {
+			common.WriteComment(w, value.Comment)
+			fmt.Fprintf(w, "%s = %s,\n", common.EnumVariantName(value), value.IntegerValue.String())
+		}
+	})
+	w.WriteString("}\n\n")
+}
+
+func writeFlags(w *formatting.IndentedWriter, namespace string, flags *dsl.EnumDefinition) {
+	typeName := common.TypeIdentifierName(flags.Name)
+	baseSyntax := common.TypeSyntax(namespace, EnumBaseType(flags))
+
+	common.WriteComment(w, flags.Comment)
+	w.WriteStringln("#[derive(Debug, Clone, Copy, PartialEq, Eq, Hash, Default)]")
+	fmt.Fprintf(w, "pub struct %s(pub %s);\n\n", typeName, baseSyntax)
+
+	fmt.Fprintf(w, "impl %s {\n", typeName)
+	w.Indented(func() {
+		for _, value := range flags.Values {
+			common.WriteComment(w, value.Comment)
+			fmt.Fprintf(w, "pub const %s: %s = %s(%s);\n", common.FlagConstantName(value), typeName, typeName, value.IntegerValue.String())
+		}
+		if len(flags.Values) > 0 {
+			w.WriteStringln("")
+		}
+
+		w.WriteStringln("/// Returns true if all of the given flags are set.")
+		w.WriteStringln("pub fn has_flags(self, flags: Self) -> bool {")
+		w.Indented(func() {
+			w.WriteStringln("self.0 & flags.0 == flags.0")
+		})
+		w.WriteStringln("}")
+	})
+	w.WriteString("}\n\n")
+
+	for _, op := range []struct{ trait, method, operator string }{
+		{"BitOr", "bitor", "|"},
+		{"BitAnd", "bitand", "&"},
+		{"BitXor", "bitxor", "^"},
+	} {
+		fmt.Fprintf(w, "impl std::ops::%s for %s {\n", op.trait, typeName)
+		w.Indented(func() {
+			w.WriteStringln("type Output = Self;")
+			w.WriteStringln("")
+			fmt.Fprintf(w, "fn %s(self, rhs: Self) -> Self {\n", op.method)
+			w.Indented(func() {
+				fmt.Fprintf(w, "%s(self.0 %s rhs.0)\n", typeName, op.operator)
+			})
+			w.WriteStringln("}")
+		})
+		w.WriteString("}\n\n")
+
+		fmt.Fprintf(w, "impl std::ops::%sAssign for %s {\n", op.trait, typeName)
+		w.Indented(func() {
+			fmt.Fprintf(w, "fn %s_assign(&mut self, rhs: Self) {\n", op.method)
+			w.Indented(func() {
+				fmt.Fprintf(w, "self.0 %s= rhs.0;\n", op.operator)
+			})
+			w.WriteStringln("}")
+		})
+		w.WriteString("}\n\n")
+	}
+}
+
+func writeRecord(w *formatting.IndentedWriter, namespace string, rec *dsl.RecordDefinition) {
+	common.WriteComment(w, rec.Comment)
+	w.WriteStringln("#[derive(Debug, Clone, PartialEq)]")
+	fmt.Fprintf(w, "pub struct %s%s {\n", common.TypeIdentifierName(rec.Name), common.TypeParameters(rec.TypeParameters, ""))
+	w.Indented(func() {
+		for _, field := range rec.Fields {
+			common.WriteComment(w, field.Comment)
+			fmt.Fprintf(w, "pub %s: %s,\n", common.FieldIdentifierName(field.Name), common.TypeSyntax(namespace, field.Type))
+		}
+	})
+	w.WriteString("}\n\n")
+}
+
+func writeValidateMethod(w *formatting.IndentedWriter, rec *dsl.RecordDefinition, st dsl.SymbolTable) {
+	fmt.Fprintf(w, "impl%s %s%s {\n", common.TypeParameters(rec.TypeParameters, ""), common.TypeIdentifierName(rec.Name), common.TypeParameters(rec.TypeParameters, ""))
+	w.Indented(func() {
+		w.WriteStringln("/// Returns an error if a field does not satisfy its constraints.")
+		if hasPatternConstraints(rec) {
+			w.WriteStringln("/// Pattern constraints are not checked, since the standard library has no regular expressions.")
+		}
+		w.WriteStringln("pub fn validate(&self) -> yardl::Result<()> {")
+		w.Indented(func() {
+			for _, field := range rec.Fields {
+				if field.Constraints != nil {
+					writeConstraintChecks(w, rec, field)
+				}
+				if dsl.TypeHasConstraints(field.Type, st) {
+					writeValidation(w, field.Type, "self."+common.FieldIdentifierName(field.Name), 0)
+				}
+			}
+			w.WriteStringln("Ok(())")
+		})
+		w.WriteStringln("}")
+	})
+	w.WriteString("}\n\n")
+}
+
+func hasPatternConstraints(rec *dsl.RecordDefinition) bool {
+	for _, field := range rec.Fields {
+		if field.Constraints != nil && field.Constraints.Pattern != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func writeConstraintChecks(w *formatting.IndentedWriter, rec *dsl.RecordDefinition, field *dsl.Field) {
+	constraints := field.Constraints
+	if constraints.Min == nil && constraints.Max == nil && constraints.MinLength == nil && constraints.MaxLength == nil {
+		return
+	}
+
+	// The value is dereferenced for comparisons, and auto-dereferenced for method calls
+	value := "self." + common.FieldIdentifierName(field.Name)
+	scalar := value
+	constrainedType, optional := dsl.GetConstrainedType(field)
+	if optional {
+		fmt.Fprintf(w, "if let Some(value) = &%s {\n", value)
+		value = "value"
+		scalar = "*value"
+	}
+	if primitive, _ := dsl.GetPrimitiveType(constrainedType); primitive == dsl.Float16 || primitive == dsl.BFloat16 {
+		scalar = value + ".to_f32()"
+	}
+
+	writeChecks := func() {
+		check := func(condition string, message string) {
+			fmt.Fprintf(w, "if %s {\n", condition)
+			w.Indented(func() {
+				fmt.Fprintf(w, "return Err(yardl::Error::ConstraintViolation(%q.to_string()));\n", fmt.Sprintf("%s.%s %s", rec.Name, field.Name, message))
+			})
+			w.WriteStringln("}")
+		}
+
+		if constraints.Min != nil {
+			check(fmt.Sprintf("%s < %s", scalar, literalSyntax(constraints.Min, constrainedType)), "must be at least "+literalText(constraints.Min))
+		}
+		if constraints.Max != nil {
+			check(fmt.Sprintf("%s > %s", scalar, literalSyntax(constraints.Max, constrainedType)), "must be at most "+literalText(constraints.Max))
+		}
+		if constraints.MinLength != nil {
+			check(fmt.Sprintf("%s.len() < %d", value, *constraints.MinLength), fmt.Sprintf("must have a length of at least %d", *constraints.MinLength))
+		}
+		if constraints.MaxLength != nil {
+			check(fmt.Sprintf("%s.len() > %d", value, *constraints.MaxLength), fmt.Sprintf("must have a length of at most %d", *constraints.MaxLength))
+		}
+	}
+
+	if optional {
+		w.Indented(writeChecks)
+		w.WriteStringln("}")
+	} else {
+		writeChecks()
+	}
+}
+
+// Writes statements that return the error of validate() on the records within
+// a value of the given type.
+func writeValidation(w *formatting.IndentedWriter, t dsl.Type, value string, depth int) {
+	switch t := t.(type) {
+	case *dsl.SimpleType:
+		switch td := t.ResolvedDefinition.(type) {
+		case *dsl.NamedType:
+			writeValidation(w, td.Type, value, depth)
+		case *dsl.RecordDefinition:
+			fmt.Fprintf(w, "%s.validate()?;\n", value)
+		}
+	case *dsl.GeneralizedType:
+		item := fmt.Sprintf("item%d", depth)
+		switch t.Dimensionality.(type) {
+		case nil:
+			if t.Cases.IsSingle() {
+				writeValidation(w, t.Cases[0].Type, value, depth)
+			} else if t.Cases.IsOptional() {
+				fmt.Fprintf(w, "if let Some(%s) = &%s {\n", item, value)
+				w.Indented(func() {
+					writeValidation(w, t.Cases[1].Type, item, depth+1)
+				})
+				w.WriteStringln("}")
+			}
+		case *dsl.Vector:
+			fmt.Fprintf(w, "for %s in &%s {\n", item, value)
+			w.Indented(func() {
+				writeValidation(w, t.ToScalar(), item, depth+1)
+			})
+			w.WriteStringln("}")
+		}
+	}
+}
+
+// Returns a numeric literal that has the type of the field, since integer
+// literals cannot be compared to floating-point values
+func literalSyntax(expression dsl.Expression, fieldType dsl.Type) string {
+	if primitive, ok := dsl.GetPrimitiveType(fieldType); ok {
+		switch primitive {
+		case dsl.Float16, dsl.BFloat16, dsl.Float32:
+			return literalText(expression) + "_f32"
+		case dsl.Float64:
+			return literalText(expression) + "_f64"
+		}
+	}
+
+	return literalText(expression)
+}
+
+// Returns the text of a numeric literal
+func literalText(expression dsl.Expression) string {
+	switch t := expression.(type) {
+	case *dsl.IntegerLiteralExpression:
+		return t.Value.String()
+	case *dsl.FloatingPointLiteralExpression:
+		return t.Value
+	default:
+		panic(fmt.Sprintf("unexpected expression %T", expression))
+	}
+}
+
+func writeNamedType(w *formatting.IndentedWriter, namespace string, nt *dsl.NamedType) {
+	common.WriteComment(w, nt.Comment)
+	fmt.Fprintf(w, "pub type %s%s = %s;\n\n", common.TypeIdentifierName(nt.Name), common.TypeParameters(nt.TypeParameters, ""), common.TypeSyntax(namespace, nt.Type))
+}
@@ -0,0 +1,962 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+package binary
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/microsoft/yardl/tooling/internal/protocolstate"
+	"github.com/microsoft/yardl/tooling/pkg/dsl"
+)
+
+const (
+	binaryMagicBytes           = "yardl"
+	currentBinaryFormatVersion = 1
+
+	// The maximum number of stream items written in a single block
+	maxStreamBlockLength = 1024
+)
+
+// Reader reads a protocol in the compact binary format.
+type Reader struct {
+	d        *binaryDecoder
+	schema   string
+	protocol *dsl.ProtocolDefinition
+	env      *dsl.Environment
+
+	index            int
+	remainingInBlock uint64
+	valueOffset      int64
+}
+
+// NewReader reads the header from r and verifies that the protocol schema embedded
+// in the stream is the given schema, which is usually obtained with
+// dsl.GetProtocolSchemaString. Values are read using the definitions of the given
+// protocol, so records and enums refer to the definitions in the caller's model.
+func NewReader(r io.Reader, protocol *dsl.ProtocolDefinition, schema string) (*Reader, error) {
+	d, embeddedSchema, err := readHeader(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if embeddedSchema != schema {
+		return nil, fmt.Errorf("the protocol schema in the stream does not match protocol '%s'", protocol.Name)
+	}
+
+	return &Reader{d: d, schema: schema, protocol: protocol}, nil
+}
+
+// NewSchemaReader reads the header from r and reads the rest of the stream using
+// the protocol schema embedded in the stream. This does not require a model,
+// but the schema does not record whether an enum is a flags type.
+func NewSchemaReader(r io.Reader) (*Reader, error) {
+	d, schema, err := readHeader(r)
+	if err != nil {
+		return nil, err
+	}
+
+	protocol, env, err := dsl.ParseProtocolSchema([]byte(schema))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Reader{d: d, schema: schema, protocol: protocol, env: env}, nil
+}
+
+func readHeader(r io.Reader) (*binaryDecoder, string, error) {
+	d := &binaryDecoder{r: bufio.NewReader(r)}
+
+	magic, err := d.readBytes(len(binaryMagicBytes))
+	if err != nil || string(magic) != binaryMagicBytes {
+		return nil, "", errors.New("the stream does not start with the yardl magic bytes")
+	}
+
+	versionBytes, err := d.readBytes(4)
+	if err != nil {
+		return nil, "", err
+	}
+	if version := binary.LittleEndian.Uint32(versionBytes); version != currentBinaryFormatVersion {
+		return nil, "", fmt.Errorf("unsupported binary format version %d", version)
+	}
+
+	schema, err := d.readString()
+	if err != nil {
+		return nil, "", fmt.Errorf("unable to read the protocol schema: %w", err)
+	}
+
+	return d, schema, nil
+}
+
+// Schema returns the protocol schema JSON embedded in the stream.
+func (r *Reader) Schema() string {
+	return r.schema
+}
+
+// Protocol returns the protocol the stream is read as.
+func (r *Reader) Protocol() *dsl.ProtocolDefinition {
+	return r.protocol
+}
+
+// Environment returns the type definitions parsed from the protocol schema
+// when the reader was created with NewSchemaReader, and nil otherwise.
+func (r *Reader) Environment() *dsl.Environment {
+	return r.env
+}
+
+// Offset returns the number of bytes read from the stream so far.
+func (r *Reader) Offset() int64 {
+	return r.d.offset
+}
+
+// ValueOffset returns the byte offset in the stream at which the value
+// most recently returned by Read begins.
+func (r *Reader) ValueOffset() int64 {
+	return r.valueOffset
+}
+
+// Read returns the next protocol step value. For stream steps, each call
+// returns a single stream item. Returns io.EOF after the last step.
+func (r *Reader) Read() (*dsl.ProtocolStep, Value, error) {
+	for r.index < len(r.protocol.Sequence) {
+		step := r.protocol.Sequence[r.index]
+		if !step.IsStream() {
+			r.valueOffset = r.d.offset
+			value, err := r.d.readValue(step.Type)
+			if err != nil {
+				return nil, nil, protocolstate.StepError(step, err)
+			}
+			r.index++
+			return step, value, nil
+		}
+
+		if r.remainingInBlock == 0 {
+			blockLength, err := r.d.readUvarint()
+			if err != nil {
+				return nil, nil, protocolstate.StepError(step, err)
+			}
+			if blockLength == 0 {
+				r.index++
+				continue
+			}
+			r.remainingInBlock = blockLength
+		}
+
+		r.valueOffset = r.d.offset
+		value, err := r.d.readValue(protocolstate.StreamItemType(step))
+		if err != nil {
+			return nil, nil, protocolstate.StepError(step, err)
+		}
+		r.remainingInBlock--
+		return step, value, nil
+	}
+
+	return nil, nil, io.EOF
+}
+
+// Writer writes a protocol in the compact binary format.
+type Writer struct {
+	w        *countingWriter
+	e        *binaryEncoder
+	state    *protocolstate.State
+	block    bytes.Buffer
+	blockLen uint64
+	// A stream item is encoded here first, so that a value that fails
+	// partway through is not added to the block
+	item bytes.Buffer
+}
+
+// NewWriter writes the header with the given protocol schema JSON to w.
+// The schema is usually obtained with dsl.GetProtocolSchemaString.
+func NewWriter(w io.Writer, protocol *dsl.ProtocolDefinition, schema string) (*Writer, error) {
+	bw := &Writer{w: &countingWriter{Writer: bufio.NewWriter(w)}, state: protocolstate.New(protocol)}
+	bw.e = &binaryEncoder{w: bw.w}
+
+	bw.e.writeBytes([]byte(binaryMagicBytes))
+	var version [4]byte
+	binary.LittleEndian.PutUint32(version[:], currentBinaryFormatVersion)
+	bw.e.writeBytes(version[:])
+	bw.e.writeString(schema)
+	return bw, bw.e.err
+}
+
+// Write writes the value of a protocol step. For stream steps, value
+// is a single stream item.
+func (w *Writer) Write(step *dsl.ProtocolStep, value Value) error {
+	if w.e.err != nil {
+		return w.e.err
+	}
+
+	step, err := w.state.AdvanceTo(step, w.endStream)
+	if err != nil {
+		return err
+	}
+
+	if !step.IsStream() {
+		written := w.w.n
+		if err := w.e.writeValue(step.Type, value); err != nil {
+			err = protocolstate.StepError(step, err)
+			if w.w.n != written {
+				// The value was partly written, so the output cannot be continued
+				w.e.err = err
+			}
+			return err
+		}
+		w.state.StepCompleted()
+		return nil
+	}
+
+	w.item.Reset()
+	itemEncoder := binaryEncoder{w: &w.item}
+	if err := itemEncoder.writeValue(protocolstate.StreamItemType(step), value); err != nil {
+		return protocolstate.StepError(step, err)
+	}
+	w.block.Write(w.item.Bytes())
+	w.blockLen++
+	if w.blockLen == maxStreamBlockLength {
+		return w.flushBlock()
+	}
+	return nil
+}
+
+// Flush writes any buffered data, including stream items that have
+// not yet been written as a block, to the underlying writer.
+func (w *Writer) Flush() error {
+	if err := w.flushBlock(); err != nil {
+		return err
+	}
+	return w.w.Flush()
+}
+
+// Close ends any remaining streams and flushes the output. It returns an error
+// if not all steps were written.
+func (w *Writer) Close() error {
+	if w.e.err != nil {
+		return w.e.err
+	}
+	if err := w.state.Complete(w.endStream); err != nil {
+		return err
+	}
+	if w.e.err != nil {
+		return w.e.err
+	}
+	return w.w.Flush()
+}
+
+func (w *Writer) flushBlock() error {
+	if w.blockLen > 0 {
+		w.e.writeUvarint(w.blockLen)
+		w.e.writeBytes(w.block.Bytes())
+		w.block.Reset()
+		w.blockLen = 0
+	}
+	return w.e.err
+}
+
+func (w *Writer) endStream(*dsl.ProtocolStep) error {
+	if err := w.flushBlock(); err != nil {
+		return err
+	}
+	w.e.writeUvarint(0)
+	return w.e.err
+}
+
+// countingWriter counts the bytes written through it.
+type countingWriter struct {
+	*bufio.Writer
+	n int64
+}
+
+func (w *countingWriter) Write(b []byte) (int, error) {
+	n, err := w.Writer.Write(b)
+	w.n += int64(n)
+	return n, err
+}
+
+func (w *countingWriter) WriteByte(b byte) error {
+	err := w.Writer.WriteByte(b)
+	if err == nil {
+		w.n++
+	}
+	return err
+}
+
+type binaryDecoder struct {
+	r *bufio.Reader
+	// The number of bytes read so far
+	offset int64
+}
+
+func (d *binaryDecoder) readByte() (byte, error) {
+	b, err := d.r.ReadByte()
+	if err != nil {
+		return 0, unexpectedEOF(err)
+	}
+	d.offset++
+	return b, nil
+}
+
+func (d *binaryDecoder) readBytes(n int) ([]byte, error) {
+	buf := make([]byte, n)
+	read, err := io.ReadFull(d.r, buf)
+	d.offset += int64(read)
+	if err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	return buf, nil
+}
+
+// readLengthPrefixedBytes reads a length as an unsigned varint followed by that
+// many bytes. The buffer grows as the bytes are read, so that a corrupt length
+// results in an error rather than a huge allocation.
+func (d *binaryDecoder) readLengthPrefixedBytes() ([]byte, error) {
+	length, err := d.readUvarint()
+	if err != nil {
+		return nil, err
+	}
+	if length > math.MaxInt64 {
+		return nil, fmt.Errorf("length %d is too large", length)
+	}
+
+	buf := bytes.NewBuffer(make([]byte, 0, min(length, 1024)))
+	read, err := io.CopyN(buf, d.r, int64(length))
+	d.offset += read
+	if err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	return buf.Bytes(), nil
+}
+
+func (d *binaryDecoder) readUvarint() (uint64, error) {
+	v, err := binary.ReadUvarint(d)
+	return v, unexpectedEOF(err)
+}
+
+func (d *binaryDecoder) readVarint() (int64, error) {
+	v, err := binary.ReadVarint(d)
+	return v, unexpectedEOF(err)
+}
+
+// ReadByte implements io.ByteReader for binary.ReadUvarint
+func (d *binaryDecoder) ReadByte() (byte, error) {
+	b, err := d.r.ReadByte()
+	if err == nil {
+		d.offset++
+	}
+	return b, err
+}
+
+func (d *binaryDecoder) readString() (string, error) {
+	b, err := d.readLengthPrefixedBytes()
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func (d *binaryDecoder) readUint16() (uint16, error) {
+	b, err := d.readBytes(2)
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint16(b), nil
+}
+
+func (d *binaryDecoder) readFloat32() (float32, error) {
+	b, err := d.readBytes(4)
+	if err != nil {
+		return 0, err
+	}
+	return math.Float32frombits(binary.LittleEndian.Uint32(b)), nil
+}
+
+func (d *binaryDecoder) readFloat64() (float64, error) {
+	b, err := d.readBytes(8)
+	if err != nil {
+		return 0, err
+	}
+	return math.Float64frombits(binary.LittleEndian.Uint64(b)), nil
+}
+
+func (d *binaryDecoder) readValue(t dsl.Type) (Value, error) {
+	switch t := t.(type) {
+	case nil:
+		return nil, nil
+	case *dsl.SimpleType:
+		return d.readTypeDefinition(t.ResolvedDefinition)
+	case *dsl.GeneralizedType:
+		switch dim := t.Dimensionality.(type) {
+		case nil:
+			return d.readTypeCases(t.Cases)
+		case *dsl.Vector:
+			var length uint64
+			if dim.Length != nil {
+				length = *dim.Length
+			} else {
+				var err error
+				if length, err = d.readUvarint(); err != nil {
+					return nil, err
+				}
+			}
+			items := make([]Value, 0, min(length, 1024))
+			for i := uint64(0); i < length; i++ {
+				item, err := d.readTypeCases(t.Cases)
+				if err != nil {
+					return nil, err
+				}
+				items = append(items, item)
+			}
+			return items, nil
+		case *dsl.Array:
+			var shape []uint64
+			if dim.IsFixed() {
+				for _, dimension := range *dim.Dimensions {
+					shape = append(shape, *dimension.Length)
+				}
+			} else {
+				var rank uint64
+				if dim.HasKnownNumberOfDimensions() {
+					rank = uint64(len(*dim.Dimensions))
+				} else {
+					var err error
+					if rank, err = d.readUvarint(); err != nil {
+						return nil, err
+					}
+				}
+				for i := uint64(0); i < rank; i++ {
+					length, err := d.readUvarint()
+					if err != nil {
+						return nil, err
+					}
+					shape = append(shape, length)
+				}
+			}
+
+			count := shapeElementCount(shape)
+			data := make([]Value, 0, min(count, 1024))
+			for i := uint64(0); i < count; i++ {
+				item, err := d.readTypeCases(t.Cases)
+				if err != nil {
+					return nil, err
+				}
+				data = append(data, item)
+			}
+			return &Array{Shape: shape, Data: data}, nil
+		case *dsl.Map:
+			length, err := d.readUvarint()
+			if err != nil {
+				return nil, err
+			}
+			m := &Map{Entries: make([]MapEntry, 0, min(length, 1024))}
+			for i := uint64(0); i < length; i++ {
+				key, err := d.readValue(dim.KeyType)
+				if err != nil {
+					return nil, err
+				}
+				value, err := d.readTypeCases(t.Cases)
+				if err != nil {
+					return nil, err
+				}
+				m.Entries = append(m.Entries, MapEntry{Key: key, Value: value})
+			}
+			return m, nil
+		default:
+			return nil, fmt.Errorf("unexpected dimensionality %T", dim)
+		}
+	default:
+		return nil, fmt.Errorf("unexpected type %T", t)
+	}
+}
+
+func (d *binaryDecoder) readTypeCases(cases dsl.TypeCases) (Value, error) {
+	if cases.IsSingle() {
+		return d.readValue(cases[0].Type)
+	}
+
+	index, err := d.readUvarint()
+	if err != nil {
+		return nil, err
+	}
+	if index >= uint64(len(cases)) {
+		return nil, fmt.Errorf("union case index %d is out of range", index)
+	}
+
+	c := cases[index]
+	value, err := d.readValue(c.Type)
+	if err != nil || c.IsNullType() || cases.IsOptional() {
+		return value, err
+	}
+
+	return &Union{Index: int(index), Tag: c.Tag, Value: value}, nil
+}
+
+func (d *binaryDecoder) readTypeDefinition(td dsl.TypeDefinition) (Value, error) {
+	switch td := td.(type) {
+	case dsl.PrimitiveDefinition:
+		return d.readPrimitive(td)
+	case *dsl.EnumDefinition:
+		value, err := d.readValue(enumBaseType(td))
+		if err != nil {
+			return nil, err
+		}
+		return &Enum{Definition: td, Value: value}, nil
+	case *dsl.RecordDefinition:
+		rec := &Record{Definition: td, Fields: make([]Value, len(td.Fields))}
+		for i, field := range td.Fields {
+			value, err := d.readValue(field.Type)
+			if err != nil {
+				return nil, fmt.Errorf("%s.%s: %w", td.Name, field.Name, err)
+			}
+			rec.Fields[i] = value
+		}
+		return rec, nil
+	case *dsl.NamedType:
+		return d.readValue(td.Type)
+	default:
+		return nil, fmt.Errorf("unexpected type definition %T", td)
+	}
+}
+
+func (d *binaryDecoder) readPrimitive(p dsl.PrimitiveDefinition) (Value, error) {
+	switch p {
+	case dsl.Bool:
+		b, err := d.readByte()
+		return b != 0, err
+	case dsl.Int8:
+		b, err := d.readByte()
+		return int8(b), err
+	case dsl.Int16, dsl.Int32, dsl.Int64:
+		v, err := d.readVarint()
+		if err != nil {
+			return nil, err
+		}
+		switch p {
+		case dsl.Int16:
+			return int16(v), nil
+		case dsl.Int32:
+			return int32(v), nil
+		default:
+			return v, nil
+		}
+	case dsl.Uint8:
+		b, err := d.readByte()
+		return b, err
+	case dsl.Uint16, dsl.Uint32, dsl.Uint64, dsl.Size:
+		v, err := d.readUvarint()
+		if err != nil {
+			return nil, err
+		}
+		switch p {
+		case dsl.Uint16:
+			return uint16(v), nil
+		case dsl.Uint32:
+			return uint32(v), nil
+		default:
+			return v, nil
+		}
+	case dsl.Float16:
+		v, err := d.readUint16()
+		return float16ToFloat32(v), err
+	case dsl.BFloat16:
+		v, err := d.readUint16()
+		return bfloat16ToFloat32(v), err
+	case dsl.Float32:
+		return d.readFloat32()
+	case dsl.Float64:
+		return d.readFloat64()
+	case dsl.ComplexFloat32:
+		re, err := d.readFloat32()
+		if err != nil {
+			return nil, err
+		}
+		im, err := d.readFloat32()
+		return complex(re, im), err
+	case dsl.ComplexFloat64:
+		re, err := d.readFloat64()
+		if err != nil {
+			return nil, err
+		}
+		im, err := d.readFloat64()
+		return complex(re, im), err
+	case dsl.String:
+		return d.readString()
+	case dsl.Bytes:
+		return d.readLengthPrefixedBytes()
+	case dsl.Uuid:
+		b, err := d.readBytes(16)
+		if err != nil {
+			return nil, err
+		}
+		return Uuid(b), nil
+	case dsl.Date:
+		v, err := d.readVarint()
+		return Date(v), err
+	case dsl.Time:
+		v, err := d.readVarint()
+		return Time(v), err
+	case dsl.DateTime:
+		v, err := d.readVarint()
+		return DateTime(v), err
+	case dsl.Duration:
+		v, err := d.readVarint()
+		return Duration(v), err
+	default:
+		return nil, fmt.Errorf("unexpected primitive type '%s'", p)
+	}
+}
+
+// binaryEncoder writes values in the binary format.
+// Write errors are sticky and stored in err.
+type binaryEncoder struct {
+	w interface {
+		io.Writer
+		io.ByteWriter
+	}
+	err error
+}
+
+func (e *binaryEncoder) writeBytes(b []byte) {
+	if e.err == nil {
+		_, e.err = e.w.Write(b)
+	}
+}
+
+func (e *binaryEncoder) writeByte(b byte) {
+	if e.err == nil {
+		e.err = e.w.WriteByte(b)
+	}
+}
+
+func (e *binaryEncoder) writeUvarint(v uint64) {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(buf[:], v)
+	e.writeBytes(buf[:n])
+}
+
+func (e *binaryEncoder) writeVarint(v int64) {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutVarint(buf[:], v)
+	e.writeBytes(buf[:n])
+}
+
+func (e *binaryEncoder) writeString(s string) {
+	e.writeUvarint(uint64(len(s)))
+	e.writeBytes([]byte(s))
+}
+
+func (e *binaryEncoder) writeUint16(v uint16) {
+	var buf [2]byte
+	binary.LittleEndian.PutUint16(buf[:], v)
+	e.writeBytes(buf[:])
+}
+
+func (e *binaryEncoder) writeFloat32(v float32) {
+	var buf [4]byte
+	binary.LittleEndian.PutUint32(buf[:], math.Float32bits(v))
+	e.writeBytes(buf[:])
+}
+
+func (e *binaryEncoder) writeFloat64(v float64) {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], math.Float64bits(v))
+	e.writeBytes(buf[:])
+}
+
+func (e *binaryEncoder) writeValue(t dsl.Type, value Value) error {
+	switch t := t.(type) {
+	case nil:
+		if value != nil {
+			return fmt.Errorf("expected null, got %T", value)
+		}
+	case *dsl.SimpleType:
+		return e.writeTypeDefinition(t.ResolvedDefinition, value)
+	case *dsl.GeneralizedType:
+		switch dim := t.Dimensionality.(type) {
+		case nil:
+			return e.writeTypeCases(t.Cases, value)
+		case *dsl.Vector:
+			items, ok := value.([]Value)
+			if !ok {
+				return fmt.Errorf("expected a vector, got %T", value)
+			}
+			if dim.Length != nil {
+				if uint64(len(items)) != *dim.Length {
+					return fmt.Errorf("expected a vector of length %d, got %d", *dim.Length, len(items))
+				}
+			} else {
+				e.writeUvarint(uint64(len(items)))
+			}
+			for _, item := range items {
+				if err := e.writeTypeCases(t.Cases, item); err != nil {
+					return err
+				}
+			}
+		case *dsl.Array:
+			arr, ok := value.(*Array)
+			if !ok {
+				return fmt.Errorf("expected an array, got %T", value)
+			}
+			if err := arr.CheckShape(dim); err != nil {
+				return err
+			}
+			if !dim.IsFixed() {
+				if !dim.HasKnownNumberOfDimensions() {
+					e.writeUvarint(uint64(len(arr.Shape)))
+				}
+				for _, length := range arr.Shape {
+					e.writeUvarint(length)
+				}
+			}
+			for _, item := range arr.Data {
+				if err := e.writeTypeCases(t.Cases, item); err != nil {
+					return err
+				}
+			}
+		case *dsl.Map:
+			m, ok := value.(*Map)
+			if !ok {
+				return fmt.Errorf("expected a map, got %T", value)
+			}
+			e.writeUvarint(uint64(len(m.Entries)))
+			for _, entry := range m.Entries {
+				if err := e.writeValue(dim.KeyType, entry.Key); err != nil {
+					return err
+				}
+				if err := e.writeTypeCases(t.Cases, entry.Value); err != nil {
+					return err
+				}
+			}
+		default:
+			return fmt.Errorf("unexpected dimensionality %T", dim)
+		}
+	default:
+		return fmt.Errorf("unexpected type %T", t)
+	}
+
+	return e.err
+}
+
+func (e *binaryEncoder) writeTypeCases(cases dsl.TypeCases, value Value) error {
+	if cases.IsSingle() {
+		return e.writeValue(cases[0].Type, value)
+	}
+
+	if value == nil {
+		if !cases.HasNullOption() {
+			return errors.New("null is not a valid value for this type")
+		}
+		e.writeUvarint(0)
+		return e.err
+	}
+
+	if cases.IsOptional() {
+		e.writeUvarint(1)
+		return e.writeValue(cases[1].Type, value)
+	}
+
+	u, ok := value.(*Union)
+	if !ok {
+		return fmt.Errorf("expected a union value, got %T", value)
+	}
+	if u.Index < 0 || u.Index >= len(cases) || cases[u.Index].IsNullType() {
+		return fmt.Errorf("union case index %d is out of range", u.Index)
+	}
+	e.writeUvarint(uint64(u.Index))
+	return e.writeValue(cases[u.Index].Type, u.Value)
+}
+
+func (e *binaryEncoder) writeTypeDefinition(td dsl.TypeDefinition, value Value) error {
+	switch td := td.(type) {
+	case dsl.PrimitiveDefinition:
+		return e.writePrimitive(td, value)
+	case *dsl.EnumDefinition:
+		enum, ok := value.(*Enum)
+		if !ok {
+			return fmt.Errorf("expected a value of enum '%s', got %T", td.Name, value)
+		}
+		return e.writeValue(enumBaseType(td), enum.Value)
+	case *dsl.RecordDefinition:
+		rec, ok := value.(*Record)
+		if !ok {
+			return fmt.Errorf("expected a value of record '%s', got %T", td.Name, value)
+		}
+		if len(rec.Fields) != len(td.Fields) {
+			return fmt.Errorf("expected %d fields for record '%s', got %d", len(td.Fields), td.Name, len(rec.Fields))
+		}
+		for i, field := range td.Fields {
+			if err := e.writeValue(field.Type, rec.Fields[i]); err != nil {
+				return fmt.Errorf("%s.%s: %w", td.Name, field.Name, err)
+			}
+		}
+		return nil
+	case *dsl.NamedType:
+		return e.writeValue(td.Type, value)
+	default:
+		return fmt.Errorf("unexpected type definition %T", td)
+	}
+}
+
+func (e *binaryEncoder) writePrimitive(p dsl.PrimitiveDefinition, value Value) error {
+	mismatch := func() error {
+		return fmt.Errorf("expected a value of type '%s', got %T", p, value)
+	}
+
+	switch p {
+	case dsl.Bool:
+		v, ok := value.(bool)
+		if !ok {
+			return mismatch()
+		}
+		if v {
+			e.writeByte(1)
+		} else {
+			e.writeByte(0)
+		}
+	case dsl.Int8:
+		v, ok := value.(int8)
+		if !ok {
+			return mismatch()
+		}
+		e.writeByte(byte(v))
+	case dsl.Int16:
+		v, ok := value.(int16)
+		if !ok {
+			return mismatch()
+		}
+		e.writeVarint(int64(v))
+	case dsl.Int32:
+		v, ok := value.(int32)
+		if !ok {
+			return mismatch()
+		}
+		e.writeVarint(int64(v))
+	case dsl.Int64:
+		v, ok := value.(int64)
+		if !ok {
+			return mismatch()
+		}
+		e.writeVarint(v)
+	case dsl.Uint8:
+		v, ok := value.(uint8)
+		if !ok {
+			return mismatch()
+		}
+		e.writeByte(v)
+	case dsl.Uint16:
+		v, ok := value.(uint16)
+		if !ok {
+			return mismatch()
+		}
+		e.writeUvarint(uint64(v))
+	case dsl.Uint32:
+		v, ok := value.(uint32)
+		if !ok {
+			return mismatch()
+		}
+		e.writeUvarint(uint64(v))
+	case dsl.Uint64, dsl.Size:
+		v, ok := value.(uint64)
+		if !ok {
+			return mismatch()
+		}
+		e.writeUvarint(v)
+	case dsl.Float16:
+		v, ok := value.(float32)
+		if !ok {
+			return mismatch()
+		}
+		e.writeUint16(float32ToFloat16(v))
+	case dsl.BFloat16:
+		v, ok := value.(float32)
+		if !ok {
+			return mismatch()
+		}
+		e.writeUint16(float32ToBFloat16(v))
+	case dsl.Float32:
+		v, ok := value.(float32)
+		if !ok {
+			return mismatch()
+		}
+		e.writeFloat32(v)
+	case dsl.Float64:
+		v, ok := value.(float64)
+		if !ok {
+			return mismatch()
+		}
+		e.writeFloat64(v)
+	case dsl.ComplexFloat32:
+		v, ok := value.(complex64)
+		if !ok {
+			return mismatch()
+		}
+		e.writeFloat32(real(v))
+		e.writeFloat32(imag(v))
+	case dsl.ComplexFloat64:
+		v, ok := value.(complex128)
+		if !ok {
+			return mismatch()
+		}
+		e.writeFloat64(real(v))
+		e.writeFloat64(imag(v))
+	case dsl.String:
+		v, ok := value.(string)
+		if !ok {
+			return mismatch()
+		}
+		e.writeString(v)
+	case dsl.Bytes:
+		v, ok := value.([]byte)
+		if !ok {
+			return mismatch()
+		}
+		e.writeUvarint(uint64(len(v)))
+		e.writeBytes(v)
+	case dsl.Uuid:
+		v, ok := value.(Uuid)
+		if !ok {
+			return mismatch()
+		}
+		e.writeBytes(v[:])
+	case dsl.Date:
+		v, ok := value.(Date)
+		if !ok {
+			return mismatch()
+		}
+		e.writeVarint(int64(v))
+	case dsl.Time:
+		v, ok := value.(Time)
+		if !ok {
+			return mismatch()
+		}
+		e.writeVarint(int64(v))
+	case dsl.DateTime:
+		v, ok := value.(DateTime)
+		if !ok {
+			return mismatch()
+		}
+		e.writeVarint(int64(v))
+	case dsl.Duration:
+		v, ok := value.(Duration)
+		if !ok {
+			return mismatch()
+		}
+		e.writeVarint(int64(v))
+	default:
+		return fmt.Errorf("unexpected primitive type '%s'", p)
+	}
+
+	return e.err
+}
+
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
@@ -0,0 +1,324 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+package binary
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+	"math/big"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/microsoft/yardl/tooling/pkg/dsl"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testModel = `
+P: !protocol
+  sequence:
+    header: Header
+    samples: !stream
+      items: [int, string]
+    image: int[x, y]
+    lookup: string->Fruit
+    maybe: int?
+    blob: bytes
+    half: float16
+    brain: bfloat16
+    id: uuid
+    interval: duration
+
+Header: !record
+  fields:
+    name: string
+    day: date
+    values: float*
+
+Fruit: !enum
+  values:
+    - apple
+    - banana
+`
+
+func TestRoundTrip(t *testing.T) {
+	protocol, schema := loadProtocol(t, testModel)
+	header := protocol.Sequence[0].Type.(*dsl.SimpleType).ResolvedDefinition.(*dsl.RecordDefinition)
+	fruit := protocol.Sequence[3].Type.(*dsl.GeneralizedType).Cases[0].Type.(*dsl.SimpleType).ResolvedDefinition.(*dsl.EnumDefinition)
+	banana, err := NewEnum(fruit, big.NewInt(1))
+	require.NoError(t, err)
+
+	steps := []struct {
+		name  string
+		value Value
+	}{
+		{"header", &Record{Definition: header, Fields: []Value{"abc", Date(19389), []Value{float32(1.5), float32(-2)}}}},
+		{"samples", &Union{Index: 0, Tag: "int32", Value: int32(1)}},
+		{"samples", &Union{Index: 1, Tag: "string", Value: "two"}},
+		{"image", &Array{Shape: []uint64{2, 3}, Data: []Value{int32(1), int32(2), int32(3), int32(4), int32(5), int32(6)}}},
+		{"lookup", &Map{Entries: []MapEntry{{Key: "b", Value: banana}}}},
+		{"maybe", nil},
+		{"blob", []byte{0, 1, 255}},
+		{"half", float32(1.5)},
+		{"brain", float32(-0.375)},
+		{"id", Uuid{0x12, 0x3e, 0x45, 0x67, 0xe8, 0x9b, 0x12, 0xd3, 0xa4, 0x56, 0x42, 0x66, 0x14, 0x17, 0x40, 0x00}},
+		{"interval", Duration(-1500000000)},
+	}
+
+	var buf bytes.Buffer
+	writer, err := NewWriter(&buf, protocol, schema)
+	require.NoError(t, err)
+	for _, step := range steps {
+		require.NoError(t, writer.Write(&dsl.ProtocolStep{Name: step.name}, step.value))
+	}
+	require.NoError(t, writer.Close())
+
+	reader, err := NewReader(bytes.NewReader(buf.Bytes()), protocol, schema)
+	require.NoError(t, err)
+	for _, expected := range steps {
+		step, value, err := reader.Read()
+		require.NoError(t, err)
+		assert.Equal(t, expected.name, step.Name)
+		assert.Equal(t, expected.value, value)
+	}
+	_, _, err = reader.Read()
+	assert.ErrorIs(t, err, io.EOF)
+}
+
+func TestHalfPrecision(t *testing.T) {
+	float16Cases := []struct {
+		value float32
+		bits  uint16
+	}{
+		{1, 0x3c00},
+		{-2, 0xc000},
+		{65504, 0x7bff},
+		{65520, 0x7c00}, // rounds up to infinity
+		{float32(math.Inf(-1)), 0xfc00},
+		{float32(math.Ldexp(1, -24)), 0x0001},     // smallest subnormal
+		{float32(math.Ldexp(1, -14)), 0x0400},     // smallest normal
+		{float32(math.Ldexp(1, -26)), 0x0000},     // underflows to zero
+		{1 + float32(math.Ldexp(1, -11)), 0x3c00}, // tie rounds to even
+		{1 + float32(math.Ldexp(3, -11)), 0x3c02}, // tie rounds to even
+		{float32(math.Ldexp(1023, -24)), 0x03ff},  // largest subnormal
+		{float32(math.Ldexp(2047, -25)), 0x0400},  // subnormal rounds up to normal
+	}
+	for _, c := range float16Cases {
+		assert.Equal(t, c.bits, float32ToFloat16(c.value), "%g", c.value)
+	}
+
+	for _, bits := range []uint16{0x0001, 0x03ff, 0x0400, 0x3c00, 0x7bff, 0x7c00, 0x8000, 0xc000} {
+		assert.Equal(t, bits, float32ToFloat16(float16ToFloat32(bits)), "%#04x", bits)
+	}
+	assert.True(t, math.IsNaN(float64(float16ToFloat32(float32ToFloat16(float32(math.NaN()))))))
+
+	assert.Equal(t, uint16(0x3fc0), float32ToBFloat16(1.5))
+	assert.Equal(t, uint16(0x3f80), float32ToBFloat16(math.Float32frombits(0x3f808000))) // tie rounds to even
+	assert.Equal(t, uint16(0x3f82), float32ToBFloat16(math.Float32frombits(0x3f818000))) // tie rounds to even
+	assert.Equal(t, uint16(0x7f80), float32ToBFloat16(math.MaxFloat32))
+	assert.Equal(t, float32(1.5), bfloat16ToFloat32(0x3fc0))
+	assert.True(t, math.IsNaN(float64(bfloat16ToFloat32(float32ToBFloat16(float32(math.NaN()))))))
+}
+
+// Like the generated code, 8-bit integers are written as a single byte
+// rather than as varints.
+func TestEightBitIntegers(t *testing.T) {
+	protocol, schema := loadProtocol(t, `
+P: !protocol
+  sequence:
+    signed: int8
+    unsigned: uint8
+`)
+	var buf bytes.Buffer
+	writer, err := NewWriter(&buf, protocol, schema)
+	require.NoError(t, err)
+	require.NoError(t, writer.Write(protocol.Sequence[0], int8(-88)))
+	require.NoError(t, writer.Write(protocol.Sequence[1], uint8(200)))
+	require.NoError(t, writer.Close())
+	assert.Equal(t, []byte{0xa8, 0xc8}, buf.Bytes()[buf.Len()-2:])
+
+	reader, err := NewReader(bytes.NewReader(buf.Bytes()), protocol, schema)
+	require.NoError(t, err)
+	_, value, err := reader.Read()
+	require.NoError(t, err)
+	assert.Equal(t, int8(-88), value)
+	_, value, err = reader.Read()
+	require.NoError(t, err)
+	assert.Equal(t, uint8(200), value)
+}
+
+func TestUuidString(t *testing.T) {
+	u, err := ParseUuid("123E4567-e89b-12d3-a456-426614174000")
+	require.NoError(t, err)
+	assert.Equal(t, Uuid{0x12, 0x3e, 0x45, 0x67, 0xe8, 0x9b, 0x12, 0xd3, 0xa4, 0x56, 0x42, 0x66, 0x14, 0x17, 0x40, 0x00}, u)
+	assert.Equal(t, "123e4567-e89b-12d3-a456-426614174000", u.String())
+
+	for _, invalid := range []string{"", "123e4567e89b12d3a456426614174000", "123e4567-e89b-12d3-a456-42661417400g", "123e4567-e89b-12d3-a456-42-661417400", "{123e4567-e89b-12d3-a456-426614174000}"} {
+		_, err := ParseUuid(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestWriteInvalidValue(t *testing.T) {
+	protocol, schema := loadProtocol(t, testModel)
+	writer, err := NewWriter(io.Discard, protocol, schema)
+	require.NoError(t, err)
+
+	err = writer.Write(protocol.Sequence[0], "not a record")
+	assert.ErrorContains(t, err, "step 'header': expected a value of record 'Header', got string")
+
+	err = writer.Write(protocol.Sequence[2], &Array{Shape: []uint64{2, 2}, Data: []Value{int32(1)}})
+	assert.ErrorContains(t, err, "cannot write step 'image' before step 'header'")
+}
+
+// A value that fails partway through must not leave bytes in the output
+func TestWriteInvalidValueMidway(t *testing.T) {
+	protocol, schema := loadProtocol(t, `
+P: !protocol
+  sequence:
+    items: !stream
+      items: int*
+    values: int*
+`)
+
+	var buf bytes.Buffer
+	writer, err := NewWriter(&buf, protocol, schema)
+	require.NoError(t, err)
+	require.NoError(t, writer.Write(protocol.Sequence[0], []Value{int32(1)}))
+	err = writer.Write(protocol.Sequence[0], []Value{int32(2), "x"})
+	assert.ErrorContains(t, err, "step 'items': expected a value of type 'int32', got string")
+	require.NoError(t, writer.Write(protocol.Sequence[0], []Value{int32(3)}))
+	require.NoError(t, writer.Write(protocol.Sequence[1], []Value{}))
+	require.NoError(t, writer.Close())
+
+	reader, err := NewReader(bytes.NewReader(buf.Bytes()), protocol, schema)
+	require.NoError(t, err)
+	for _, expected := range []Value{[]Value{int32(1)}, []Value{int32(3)}, []Value{}} {
+		_, value, err := reader.Read()
+		require.NoError(t, err)
+		assert.Equal(t, expected, value)
+	}
+
+	// The value of a step that is not a stream is written directly to the
+	// output, so the writer cannot continue after it
+	writer, err = NewWriter(io.Discard, protocol, schema)
+	require.NoError(t, err)
+	require.NoError(t, writer.Write(protocol.Sequence[0], []Value{int32(1)}))
+	err = writer.Write(protocol.Sequence[1], []Value{int32(4), "x"})
+	assert.ErrorContains(t, err, "step 'values': expected a value of type 'int32', got string")
+	assert.Equal(t, err, writer.Write(protocol.Sequence[1], []Value{int32(4)}))
+	assert.Equal(t, err, writer.Close())
+}
+
+func TestReadCorruptLength(t *testing.T) {
+	protocol, schema := loadProtocol(t, `
+P: !protocol
+  sequence:
+    name: string
+    blob: bytes
+`)
+
+	var header bytes.Buffer
+	writer, err := NewWriter(&header, protocol, schema)
+	require.NoError(t, err)
+	require.NoError(t, writer.Flush())
+
+	huge := binary.AppendUvarint(nil, math.MaxUint64)
+	for name, data := range map[string][]byte{
+		"huge string":      huge,
+		"truncated string": {0x05, 'a', 'b'},
+		"huge bytes":       append([]byte{0x00}, huge...),
+		"truncated bytes":  {0x00, 0x80, 0x80, 0x01, 0xff},
+	} {
+		t.Run(name, func(t *testing.T) {
+			reader, err := NewReader(io.MultiReader(bytes.NewReader(header.Bytes()), bytes.NewReader(data)), protocol, schema)
+			require.NoError(t, err)
+			for {
+				_, _, err = reader.Read()
+				if err != nil {
+					break
+				}
+			}
+			assert.NotErrorIs(t, err, io.EOF)
+			assert.Error(t, err)
+		})
+	}
+
+	_, err = NewSchemaReader(bytes.NewReader(append([]byte("yardl\x01\x00\x00\x00"), huge...)))
+	assert.ErrorContains(t, err, "is too large")
+}
+
+func TestReadSchemaMismatch(t *testing.T) {
+	protocol, schema := loadProtocol(t, testModel)
+	var buf bytes.Buffer
+	writer, err := NewWriter(&buf, protocol, schema)
+	require.NoError(t, err)
+	require.NoError(t, writer.Write(protocol.Sequence[0], &Record{Definition: protocol.Sequence[0].Type.(*dsl.SimpleType).ResolvedDefinition.(*dsl.RecordDefinition), Fields: []Value{"", Date(0), []Value{}}}))
+	require.NoError(t, writer.Flush())
+
+	otherProtocol, otherSchema := loadProtocol(t, `
+P: !protocol
+  sequence:
+    header: string
+`)
+	_, err = NewReader(bytes.NewReader(buf.Bytes()), otherProtocol, otherSchema)
+	assert.ErrorContains(t, err, "the protocol schema in the stream does not match protocol 'P'")
+
+	reader, err := NewSchemaReader(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	assert.Equal(t, schema, reader.Schema())
+	assert.Equal(t, "P", reader.Protocol().Name)
+}
+
+func TestReaderOffsets(t *testing.T) {
+	protocol, schema := loadProtocol(t, `
+P: !protocol
+  sequence:
+    a: int
+    b: !stream
+      items: string
+`)
+
+	var buf bytes.Buffer
+	writer, err := NewWriter(&buf, protocol, schema)
+	require.NoError(t, err)
+	require.NoError(t, writer.Write(protocol.Sequence[0], int32(300)))
+	require.NoError(t, writer.Write(protocol.Sequence[1], "x"))
+	require.NoError(t, writer.Write(protocol.Sequence[1], "yz"))
+	require.NoError(t, writer.Close())
+
+	reader, err := NewSchemaReader(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	headerLength := reader.Offset()
+
+	var offsets []int64
+	for {
+		_, _, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		offsets = append(offsets, reader.ValueOffset()-headerLength)
+	}
+
+	// a takes two bytes, then comes the block length of b
+	assert.Equal(t, []int64{0, 3, 5}, offsets)
+	assert.Equal(t, int64(buf.Len()), reader.Offset())
+}
+
+func loadProtocol(t *testing.T, model string) (*dsl.ProtocolDefinition, string) {
+	d := t.TempDir()
+	require.NoError(t, os.WriteFile(path.Join(d, "model.yml"), []byte(model), 0644))
+	ns, err := dsl.ParseYamlInDir(d, "test")
+	require.NoError(t, err)
+	env, err := dsl.Validate([]*dsl.Namespace{ns})
+	require.NoError(t, err)
+
+	protocol := env.GetTopLevelNamespace().Protocols[0]
+	return protocol, dsl.GetProtocolSchemaString(protocol, env.SymbolTable)
+}
@@ -0,0 +1,88 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+package binary
+
+import "math"
+
+// Go has no half-precision floating-point types, so float16 and bfloat16
+// values are held as float32, which can represent all of their values exactly.
+// They are rounded to the nearest representable value, with ties to even,
+// when they are written.
+
+func float32ToFloat16(f float32) uint16 {
+	bits := math.Float32bits(f)
+	sign := uint16(bits>>16) & 0x8000
+	exponent := int32(bits>>23) & 0xff
+	mantissa := bits & 0x7fffff
+
+	if exponent == 0xff {
+		if mantissa != 0 {
+			return sign | 0x7e00
+		}
+		return sign | 0x7c00
+	}
+
+	e := exponent - 127 + 15
+	if e >= 0x1f {
+		return sign | 0x7c00
+	}
+
+	if e <= 0 {
+		if e < -10 {
+			return sign
+		}
+		// subnormal, where a rounded-up result can carry into the exponent
+		return sign | uint16(roundShift(mantissa|0x800000, uint32(14-e)))
+	}
+
+	// a rounded-up mantissa carries into the exponent, possibly up to infinity
+	return sign | uint16(uint32(e)<<10+roundShift(mantissa, 13))
+}
+
+func float16ToFloat32(h uint16) float32 {
+	sign := uint32(h&0x8000) << 16
+	exponent := uint32(h>>10) & 0x1f
+	mantissa := uint32(h & 0x3ff)
+
+	switch exponent {
+	case 0:
+		if mantissa == 0 {
+			return math.Float32frombits(sign)
+		}
+		e := uint32(127 - 15 + 1)
+		for mantissa&0x400 == 0 {
+			mantissa <<= 1
+			e--
+		}
+		return math.Float32frombits(sign | e<<23 | (mantissa&0x3ff)<<13)
+	case 0x1f:
+		return math.Float32frombits(sign | 0xff<<23 | mantissa<<13)
+	default:
+		return math.Float32frombits(sign | (exponent+127-15)<<23 | mantissa<<13)
+	}
+}
+
+func float32ToBFloat16(f float32) uint16 {
+	bits := math.Float32bits(f)
+	if bits&0x7fffffff > 0x7f800000 {
+		// NaN
+		return uint16(bits>>16) | 0x40
+	}
+	return uint16(roundShift(bits, 16))
+}
+
+func bfloat16ToFloat32(b uint16) float32 {
+	return math.Float32frombits(uint32(b) << 16)
+}
+
+// Shifts v right, rounding to the nearest value with ties to even.
+func roundShift(v uint32, shift uint32) uint32 {
+	result := v >> shift
+	remainder := v & (1<<shift - 1)
+	half := uint32(1) << (shift - 1)
+	if remainder > half || (remainder == half && result&1 == 1) {
+		result++
+	}
+	return result
+}
@@ -0,0 +1,288 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+// Package binary reads and writes protocols in the yardl compact binary format
+// without generated code. Values are represented as a tree of Value nodes that
+// are interpreted using the types of a dsl.ProtocolDefinition, which can come
+// from a model loaded with dsl.ParseYamlInDir and dsl.Validate, or from the
+// protocol schema embedded in a stream.
+package binary
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/microsoft/yardl/tooling/pkg/dsl"
+)
+
+// Value holds a yardl value. Its dynamic type depends on the yardl type it is an
+// instance of:
+//
+//	bool, int8, uint8, int16, uint16, int32, uint32, int64, uint64 (also for size),
+//	float32 (also for float16 and bfloat16), float64, complex64, complex128,
+//	string, []byte, Uuid, Date, Time, DateTime, Duration,
+//	*Enum, *Record, *Union, []Value (vectors), *Array, *Map,
+//	and nil for the null case of optionals and unions.
+//
+// The value of an optional that is set is the inner value itself.
+type Value any
+
+// Uuid is a UUID, with its bytes in the order of its canonical string form.
+type Uuid [16]byte
+
+// Date is a number of days since the epoch.
+type Date int64
+
+// Time is a number of nanoseconds since midnight.
+type Time int64
+
+// DateTime is a number of nanoseconds since the epoch.
+type DateTime int64
+
+// Duration is a signed number of nanoseconds.
+type Duration int64
+
+const secondsPerDay = 24 * 60 * 60
+
+func (u Uuid) String() string {
+	return fmt.Sprintf("%x-%x-%x-%x-%x", u[0:4], u[4:6], u[6:8], u[8:10], u[10:16])
+}
+
+// ParseUuid parses a UUID in its canonical form, e.g.
+// "123e4567-e89b-12d3-a456-426614174000". Hex digits can be of either case.
+func ParseUuid(s string) (Uuid, error) {
+	var u Uuid
+	if len(s) != 36 || s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+		return u, fmt.Errorf("invalid UUID '%s'", s)
+	}
+
+	digits := s[0:8] + s[9:13] + s[14:18] + s[19:23] + s[24:36]
+	if _, err := hex.Decode(u[:], []byte(digits)); err != nil {
+		return u, fmt.Errorf("invalid UUID '%s'", s)
+	}
+	return u, nil
+}
+
+func (d Date) String() string {
+	return time.Unix(int64(d)*secondsPerDay, 0).UTC().Format(time.DateOnly)
+}
+
+func (t Time) String() string {
+	ns := int64(t)
+	seconds := ns / int64(time.Second)
+	return fmt.Sprintf("%02d:%02d:%02d.%09d", seconds/3600, seconds/60%60, seconds%60, ns%int64(time.Second))
+}
+
+func (dt DateTime) String() string {
+	return time.Unix(0, int64(dt)).UTC().Format("2006-01-02T15:04:05.000000000")
+}
+
+func (d Duration) String() string {
+	return time.Duration(d).String()
+}
+
+// Enum is a value of an enum or flags type.
+type Enum struct {
+	Definition *dsl.EnumDefinition
+	// Value holds the integer value, using the Go type corresponding
+	// to the enum's base type.
+	Value Value
+}
+
+// Symbols returns the symbols of the enum's values that make up the value.
+// For an enum, this is a single symbol. For flags, these are the symbols of
+// the flags that are set, or the symbol of the zero value, if any, when no
+// flags are set. Returns false if the value cannot be expressed with the
+// defined symbols.
+func (e *Enum) Symbols() ([]string, bool, error) {
+	i, err := IntegerToBigInt(e.Value)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if !e.Definition.IsFlags {
+		for _, v := range e.Definition.Values {
+			if v.IntegerValue.Cmp(i) == 0 {
+				return []string{v.Symbol}, true, nil
+			}
+		}
+		return nil, false, nil
+	}
+
+	zero := e.Definition.GetZeroValue()
+	if i.Sign() == 0 {
+		if zero != nil {
+			return []string{zero.Symbol}, true, nil
+		}
+		return []string{}, true, nil
+	}
+
+	symbols := []string{}
+	remaining := new(big.Int).Set(i)
+	for _, v := range e.Definition.Values {
+		if v == zero {
+			continue
+		}
+		if new(big.Int).And(remaining, &v.IntegerValue).Cmp(&v.IntegerValue) == 0 {
+			remaining.AndNot(remaining, &v.IntegerValue)
+			symbols = append(symbols, v.Symbol)
+			if remaining.Sign() == 0 {
+				return symbols, true, nil
+			}
+		}
+	}
+
+	return nil, false, nil
+}
+
+// NewEnum returns a value of an enum or flags type with the given integer value,
+// which must be in the range of the enum's base type.
+func NewEnum(enum *dsl.EnumDefinition, i *big.Int) (*Enum, error) {
+	primitive, err := integerPrimitive(enumBaseType(enum))
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := BigIntToInteger(i, primitive)
+	if err != nil {
+		return nil, err
+	}
+	return &Enum{Definition: enum, Value: value}, nil
+}
+
+// Record is a value of a record type. Fields are in the order of the
+// record definition's fields.
+type Record struct {
+	Definition *dsl.RecordDefinition
+	Fields     []Value
+}
+
+// Union is a value of a union type other than an optional.
+type Union struct {
+	// Index is the index of the case in the union's TypeCases
+	Index int
+	Tag   string
+	Value Value
+}
+
+// Array is a multidimensional array. Data is stored in row-major order.
+type Array struct {
+	Shape []uint64
+	Data  []Value
+}
+
+// Map is a map with entries in insertion order.
+type Map struct {
+	Entries []MapEntry
+}
+
+type MapEntry struct {
+	Key   Value
+	Value Value
+}
+
+// CheckShape returns an error if the array's shape does not match the
+// dimensions of the array type or its data does not match its shape.
+func (a *Array) CheckShape(dim *dsl.Array) error {
+	if dim.HasKnownNumberOfDimensions() {
+		if len(a.Shape) != len(*dim.Dimensions) {
+			return fmt.Errorf("expected an array with %d dimensions, got %d", len(*dim.Dimensions), len(a.Shape))
+		}
+		if dim.IsFixed() {
+			for i, dimension := range *dim.Dimensions {
+				if a.Shape[i] != *dimension.Length {
+					return fmt.Errorf("expected dimension %d of the array to have length %d, got %d", i, *dimension.Length, a.Shape[i])
+				}
+			}
+		}
+	}
+
+	if count := shapeElementCount(a.Shape); uint64(len(a.Data)) != count {
+		return fmt.Errorf("expected %d array elements for shape %v, got %d", count, a.Shape, len(a.Data))
+	}
+
+	return nil
+}
+
+func shapeElementCount(shape []uint64) uint64 {
+	count := uint64(1)
+	for _, length := range shape {
+		count *= length
+	}
+	return count
+}
+
+func enumBaseType(enum *dsl.EnumDefinition) dsl.Type {
+	if enum.BaseType != nil {
+		return enum.BaseType
+	}
+
+	return dsl.Int32Type
+}
+
+// IntegerToBigInt returns the value of an integer of any of the Go types
+// used for yardl integer types.
+func IntegerToBigInt(v Value) (*big.Int, error) {
+	switch v := v.(type) {
+	case int8:
+		return big.NewInt(int64(v)), nil
+	case int16:
+		return big.NewInt(int64(v)), nil
+	case int32:
+		return big.NewInt(int64(v)), nil
+	case int64:
+		return big.NewInt(v), nil
+	case uint8:
+		return new(big.Int).SetUint64(uint64(v)), nil
+	case uint16:
+		return new(big.Int).SetUint64(uint64(v)), nil
+	case uint32:
+		return new(big.Int).SetUint64(uint64(v)), nil
+	case uint64:
+		return new(big.Int).SetUint64(v), nil
+	default:
+		return nil, fmt.Errorf("expected an integer value, got %T", v)
+	}
+}
+
+// BigIntToInteger returns an integer value of the Go type used for the given
+// primitive type, or an error if i is out of range for the type.
+func BigIntToInteger(i *big.Int, primitive dsl.PrimitiveDefinition) (Value, error) {
+	var v Value
+	var inRange bool
+	switch primitive {
+	case dsl.Int8:
+		v, inRange = int8(i.Int64()), i.IsInt64() && i.Cmp(dsl.MinInt8) >= 0 && i.Cmp(dsl.MaxInt8) <= 0
+	case dsl.Int16:
+		v, inRange = int16(i.Int64()), i.IsInt64() && i.Cmp(dsl.MinInt16) >= 0 && i.Cmp(dsl.MaxInt16) <= 0
+	case dsl.Int32:
+		v, inRange = int32(i.Int64()), i.IsInt64() && i.Cmp(dsl.MinInt32) >= 0 && i.Cmp(dsl.MaxInt32) <= 0
+	case dsl.Int64:
+		v, inRange = i.Int64(), i.IsInt64()
+	case dsl.Uint8:
+		v, inRange = uint8(i.Uint64()), i.IsUint64() && i.Cmp(dsl.MaxUint8) <= 0
+	case dsl.Uint16:
+		v, inRange = uint16(i.Uint64()), i.IsUint64() && i.Cmp(dsl.MaxUint16) <= 0
+	case dsl.Uint32:
+		v, inRange = uint32(i.Uint64()), i.IsUint64() && i.Cmp(dsl.MaxUint32) <= 0
+	case dsl.Uint64, dsl.Size:
+		v, inRange = i.Uint64(), i.IsUint64()
+	default:
+		return nil, fmt.Errorf("'%s' is not an integer type", primitive)
+	}
+
+	if !inRange {
+		return nil, fmt.Errorf("the value %s is out of range for type '%s'", i.String(), primitive)
+	}
+	return v, nil
+}
+
+// Returns the primitive definition underlying an integer type such as an enum base type
+func integerPrimitive(t dsl.Type) (dsl.PrimitiveDefinition, error) {
+	if p, ok := dsl.GetPrimitiveType(t); ok {
+		return p, nil
+	}
+	return "", fmt.Errorf("'%s' is not a primitive type", dsl.TypeToShortSyntax(t, true))
+}
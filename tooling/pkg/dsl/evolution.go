@@ -54,6 +54,49 @@ func ValidateEvolution(latest *Environment, predecessors []*Environment, version
 	return latest, allWarnings, nil
 }
 
+// ProtocolEvolution describes how data written with a previous version of a
+// protocol relates to the latest version of the protocol.
+type ProtocolEvolution struct {
+	// Change is nil if the protocol is unchanged
+	Change  *ProtocolChange
+	context *EvolutionContext
+}
+
+// ResolveProtocolEvolution compares a previous version of a protocol and the
+// types it references with the latest version. Unlike ValidateEvolution, it does
+// not modify either environment, so it can be used at runtime, for example with
+// a previous version parsed from an embedded protocol schema.
+func ResolveProtocolEvolution(latest *ProtocolDefinition, latestEnv *Environment, previous *ProtocolDefinition, previousEnv *Environment) *ProtocolEvolution {
+	context := resolveTypeDefinitionChanges(getAllTypeDefinitions(latestEnv), getAllTypeDefinitions(previousEnv))
+	return &ProtocolEvolution{
+		Change:  compareProtocolDefinitions(latest, previous, context),
+		context: context,
+	}
+}
+
+// CompareTypes returns the change from a type in the previous version to a
+// type in the latest version, or nil if they are equivalent.
+func (e *ProtocolEvolution) CompareTypes(newType, oldType Type) TypeChange {
+	return compareTypes(newType, oldType, e.context)
+}
+
+// Validate returns an error if data written with the previous version of the
+// protocol cannot be read as the latest version.
+func (e *ProtocolEvolution) Validate() error {
+	if e.Change == nil {
+		return nil
+	}
+
+	errorSink := &validation.ErrorSink{}
+	saveError := func(node Node, format string, args ...interface{}) {
+		errorSink.Add(validationError(node, format, args...))
+	}
+	ignoreWarning := func(node Node, format string, args ...interface{}) {}
+
+	validateProtocolChanges(map[string]DefinitionChange{e.Change.LatestDefinition().GetDefinitionMeta().GetQualifiedName(): e.Change}, ignoreWarning, saveError)
+	return errorSink.AsError()
+}
+
 func renameOldTypeDefinitions(env *Environment, changes []DefinitionChange, versionLabel string) {
 	oldNames := make(map[string]bool)
 	for _, ch := range changes {
@@ -75,7 +118,9 @@ func renameOldTypeDefinitions(env *Environment, changes []DefinitionChange, vers
 
 		case *SimpleType:
 			self.VisitChildren(node)
-			self.Visit(node.ResolvedDefinition)
+			if !node.IsRecursiveReference {
+				self.Visit(node.ResolvedDefinition)
+			}
 		default:
 			self.VisitChildren(node)
 		}
@@ -388,6 +433,111 @@ func resolveAllChanges(newEnv, oldEnv *Environment) ([]DefinitionChange, map[str
 	allNewTypeDefs := getAllTypeDefinitions(newEnv)
 	allOldTypeDefs := getAllTypeDefinitions(oldEnv)
 
+	context := resolveTypeDefinitionChanges(allNewTypeDefs, allOldTypeDefs)
+
+	// Now we're finished comparing all TypeDefinitions and we can finally compare Protocols
+	allProtocolChanges := resolveAllProtocolChanges(newEnv, oldEnv, context)
+
+	// Collect all DefinitionChanges in OLD Definition order - the order in which they'll be referenced by codegen
+	// While simultaneously filtering so we only produce one DefinitionChange per each OLD TypeDefinition
+	defChangesByOldName := make(map[string]DefinitionChange)
+	for _, oldTd := range allOldTypeDefs {
+		oldName := oldTd.GetDefinitionMeta().GetQualifiedName()
+
+		// First, look for oldTd's base semantic pair and emit that DefinitionChange
+		for _, newTd := range allNewTypeDefs {
+			newName := newTd.GetDefinitionMeta().GetQualifiedName()
+
+			if _, ok := context.BasePairs[newName][oldName]; ok {
+				change, ok := context.Changes[newName][oldName]
+				if !ok {
+					log.Panic().Msgf("Should have already compared %s <= %s", newName, oldName)
+				}
+				if change == nil {
+					continue
+				}
+				// log.Debug().Msgf("Emitting BASE %T for %s <= %s", change, defWithArgs(change.LatestDefinition()), defWithArgs(change.PreviousDefinition()))
+				defChangesByOldName[oldName] = change
+				break
+			}
+		}
+
+		if _, ok := defChangesByOldName[oldName]; ok {
+			continue
+		}
+
+		// Otherwise, emit any DefinitionChange for NamedTypes that reference oldTd
+		for _, newTd := range allNewTypeDefs {
+			newName := newTd.GetDefinitionMeta().GetQualifiedName()
+
+			if resolvedPair, compared := context.SemanticPairs[newName][oldName]; compared {
+				change, ok := context.Changes[newName][oldName]
+				if !ok {
+					log.Panic().Msgf("Should have already compared %s <= %s", newName, oldName)
+				}
+				if change == nil {
+					change = &CompatibilityChange{*resolvedPair}
+				}
+				// log.Debug().Msgf("Emitting REF  %T for %s <= %s", change, defWithArgs(change.LatestDefinition()), defWithArgs(change.PreviousDefinition()))
+				defChangesByOldName[oldName] = change
+				break
+			}
+		}
+	}
+
+	// Determine which "old" TypeDefinitions we need to emit DefinitionChanges for
+	oldDefsReferenced := make(map[string]bool)
+	for _, ch := range allProtocolChanges {
+		if _, ok := ch.(*ProtocolRemoved); ok {
+			continue
+		}
+		for _, tc := range ch.(*ProtocolChange).StepChanges {
+			if tc == nil {
+				continue
+			}
+			Visit(tc.OldType(), func(self Visitor, node Node) {
+				switch node := node.(type) {
+				case nil, PrimitiveDefinition, *GenericTypeParameter:
+					return
+				case *NamedType:
+					oldDefsReferenced[node.GetDefinitionMeta().GetQualifiedName()] = true
+					self.Visit(node.Type)
+				case TypeDefinition:
+					oldDefsReferenced[node.GetDefinitionMeta().GetQualifiedName()] = true
+					self.VisitChildren(node)
+				case *SimpleType:
+					if !node.IsRecursiveReference {
+						self.Visit(node.ResolvedDefinition)
+					}
+				default:
+					self.VisitChildren(node)
+				}
+			})
+		}
+	}
+
+	// De-deduplicate DefinitionChanges with respect to the "old" TypeDefinition
+	finalDefinitionChanges := make([]DefinitionChange, 0)
+	for _, oldTd := range allOldTypeDefs {
+		oldName := oldTd.GetDefinitionMeta().GetQualifiedName()
+		if !oldDefsReferenced[oldName] {
+			// We don't need any "compatibility" codegen for this old TypeDefinition
+			continue
+		}
+
+		if ch, ok := defChangesByOldName[oldName]; ok {
+			finalDefinitionChanges = append(finalDefinitionChanges, ch)
+		} else if _, ok := oldTd.(*NamedType); ok {
+			// Save any additional NamedTypes that are no longer in the new model
+			finalDefinitionChanges = append(finalDefinitionChanges, &AliasRemoved{DefinitionPair{oldTd, oldTd}})
+		}
+	}
+
+	return finalDefinitionChanges, allProtocolChanges
+}
+
+// Compares all TypeDefinitions across versions, returning the context used to compare Types
+func resolveTypeDefinitionChanges(allNewTypeDefs, allOldTypeDefs []TypeDefinition) *EvolutionContext {
 	context := &EvolutionContext{
 		BasePairs:     make(map[string]map[string]*DefinitionPair),
 		SemanticPairs: make(map[string]map[string]*DefinitionPair),
@@ -455,6 +605,9 @@ func resolveAllChanges(newEnv, oldEnv *Environment) ([]DefinitionChange, map[str
 					log.Panic().Msgf("Should have already resolved %s <= %s", newName, oldName)
 				}
 				baseChange := compareTypeDefinitions(resolvedPair.LatestDefinition(), resolvedPair.PreviousDefinition(), context)
+				if rec, ok := resolvedPair.LatestDefinition().(*RecordDefinition); ok && rec.IsRecursive && baseChange != nil {
+					baseChange = &DefinitionChangeIncompatible{*resolvedPair, IncompatibleRecursiveDefinitions}
+				}
 				context.Changes[newName][oldName] = baseChange
 				// log.Debug().Msgf("Saved %T for %s <= %s", changes[newName][oldName], newName, oldName)
 
@@ -496,103 +649,7 @@ func resolveAllChanges(newEnv, oldEnv *Environment) ([]DefinitionChange, map[str
 		}
 	}
 
-	// Now we're finished comparing all TypeDefinitions and we can finally compare Protocols
-	allProtocolChanges := resolveAllProtocolChanges(newEnv, oldEnv, context)
-
-	// Collect all DefinitionChanges in OLD Definition order - the order in which they'll be referenced by codegen
-	// While simultaneously filtering so we only produce one DefinitionChange per each OLD TypeDefinition
-	defChangesByOldName := make(map[string]DefinitionChange)
-	for _, oldTd := range allOldTypeDefs {
-		oldName := oldTd.GetDefinitionMeta().GetQualifiedName()
-
-		// First, look for oldTd's base semantic pair and emit that DefinitionChange
-		for _, newTd := range allNewTypeDefs {
-			newName := newTd.GetDefinitionMeta().GetQualifiedName()
-
-			if _, ok := context.BasePairs[newName][oldName]; ok {
-				change, ok := context.Changes[newName][oldName]
-				if !ok {
-					log.Panic().Msgf("Should have already compared %s <= %s", newName, oldName)
-				}
-				if change == nil {
-					continue
-				}
-				// log.Debug().Msgf("Emitting BASE %T for %s <= %s", change, defWithArgs(change.LatestDefinition()), defWithArgs(change.PreviousDefinition()))
-				defChangesByOldName[oldName] = change
-				break
-			}
-		}
-
-		if _, ok := defChangesByOldName[oldName]; ok {
-			continue
-		}
-
-		// Otherwise, emit any DefinitionChange for NamedTypes that reference oldTd
-		for _, newTd := range allNewTypeDefs {
-			newName := newTd.GetDefinitionMeta().GetQualifiedName()
-
-			if resolvedPair, compared := context.SemanticPairs[newName][oldName]; compared {
-				change, ok := context.Changes[newName][oldName]
-				if !ok {
-					log.Panic().Msgf("Should have already compared %s <= %s", newName, oldName)
-				}
-				if change == nil {
-					change = &CompatibilityChange{*resolvedPair}
-				}
-				// log.Debug().Msgf("Emitting REF  %T for %s <= %s", change, defWithArgs(change.LatestDefinition()), defWithArgs(change.PreviousDefinition()))
-				defChangesByOldName[oldName] = change
-				break
-			}
-		}
-	}
-
-	// Determine which "old" TypeDefinitions we need to emit DefinitionChanges for
-	oldDefsReferenced := make(map[string]bool)
-	for _, ch := range allProtocolChanges {
-		if _, ok := ch.(*ProtocolRemoved); ok {
-			continue
-		}
-		for _, tc := range ch.(*ProtocolChange).StepChanges {
-			if tc == nil {
-				continue
-			}
-			Visit(tc.OldType(), func(self Visitor, node Node) {
-				switch node := node.(type) {
-				case nil, PrimitiveDefinition, *GenericTypeParameter:
-					return
-				case *NamedType:
-					oldDefsReferenced[node.GetDefinitionMeta().GetQualifiedName()] = true
-					self.Visit(node.Type)
-				case TypeDefinition:
-					oldDefsReferenced[node.GetDefinitionMeta().GetQualifiedName()] = true
-					self.VisitChildren(node)
-				case *SimpleType:
-					self.Visit(node.ResolvedDefinition)
-				default:
-					self.VisitChildren(node)
-				}
-			})
-		}
-	}
-
-	// De-deduplicate DefinitionChanges with respect to the "old" TypeDefinition
-	finalDefinitionChanges := make([]DefinitionChange, 0)
-	for _, oldTd := range allOldTypeDefs {
-		oldName := oldTd.GetDefinitionMeta().GetQualifiedName()
-		if !oldDefsReferenced[oldName] {
-			// We don't need any "compatibility" codegen for this old TypeDefinition
-			continue
-		}
-
-		if ch, ok := defChangesByOldName[oldName]; ok {
-			finalDefinitionChanges = append(finalDefinitionChanges, ch)
-		} else if _, ok := oldTd.(*NamedType); ok {
-			// Save any additional NamedTypes that are no longer in the new model
-			finalDefinitionChanges = append(finalDefinitionChanges, &AliasRemoved{DefinitionPair{oldTd, oldTd}})
-		}
-	}
-
-	return finalDefinitionChanges, allProtocolChanges
+	return context
 }
 
 func resolveAllProtocolChanges(newEnv, oldEnv *Environment, context *EvolutionContext) map[string]DefinitionChange {
@@ -963,6 +1020,10 @@ func compareTypes(newType, oldType Type, context *EvolutionContext) TypeChange {
 				return compareTypes(nt.Type, oldType, context)
 			}
 			if oldType.Dimensionality != nil {
+				if isBytesType(newType) && isByteVectorType(oldType) {
+					// CHANGE: Changed a vector of uint8 to bytes
+					return &TypeChangeVectorToBytes{TypePair{oldType, newType}}
+				}
 				// NOTE: This is where we will add support for changing a scalar SimpleType to a non-scalar Type
 				return &TypeChangeIncompatible{TypePair{oldType, newType}}
 			}
@@ -1008,6 +1069,10 @@ func compareTypes(newType, oldType Type, context *EvolutionContext) TypeChange {
 				return compareTypes(newType, nt.Type, context)
 			}
 			if newType.Dimensionality != nil {
+				if isBytesType(oldType) && isByteVectorType(newType) {
+					// CHANGE: Changed bytes to a vector of uint8
+					return &TypeChangeBytesToVector{TypePair{oldType, newType}}
+				}
 				// NOTE: This is where we will add support for changing from non-scalar Type to a scalar SimpleType
 				return &TypeChangeIncompatible{TypePair{oldType, newType}}
 			}
@@ -1053,6 +1118,11 @@ func compareSemanticallyEquivalentTypes(newType, oldType *SimpleType, context *E
 
 	ch, ok := context.Changes[newName][oldName]
 	if !ok {
+		if newType.IsRecursiveReference {
+			// The record is still being compared. Recursive records cannot change
+			// between versions, so the reference is treated as unchanged.
+			return nil
+		}
 		log.Panic().Msgf("Haven't yet compared %s <= %s", newName, oldName)
 	}
 	switch ch.(type) {
@@ -1223,15 +1293,49 @@ func compareGeneralizedTypes(newType, oldType *GeneralizedType, context *Evoluti
 	}
 }
 
+func isBytesType(t *SimpleType) bool {
+	p, ok := GetPrimitiveType(t)
+	return ok && p == PrimitiveBytes && GetUnit(t) == ""
+}
+
+// Returns true for a variable-length vector of uint8, which has the same
+// binary encoding as bytes.
+func isByteVectorType(t *GeneralizedType) bool {
+	vector, ok := t.Dimensionality.(*Vector)
+	if !ok || vector.Length != nil || !t.Cases.IsSingle() {
+		return false
+	}
+	p, ok := GetPrimitiveType(t.Cases[0].Type)
+	return ok && p == PrimitiveUint8 && GetUnit(t.Cases[0].Type) == ""
+}
+
 func detectPrimitiveTypeChange(newType, oldType *SimpleType) TypeChange {
 	newPrimitive := newType.ResolvedDefinition.(PrimitiveDefinition)
 	oldPrimitive := oldType.ResolvedDefinition.(PrimitiveDefinition)
 
+	// CHANGE: Changed unit of measure
+	if newType.Unit != oldType.Unit {
+		return &TypeChangeIncompatible{TypePair{oldType, newType}}
+	}
+
 	if newPrimitive == oldPrimitive {
 		return nil
 	}
 
 	// CHANGE: Changed Primitive type
+	if oldPrimitive == PrimitiveString && newPrimitive == PrimitiveBytes {
+		return &TypeChangeStringToBytes{TypePair{oldType, newType}}
+	}
+	if oldPrimitive == PrimitiveBytes && newPrimitive == PrimitiveString {
+		return &TypeChangeBytesToString{TypePair{oldType, newType}}
+	}
+	if oldPrimitive == PrimitiveString && newPrimitive == PrimitiveUuid {
+		return &TypeChangeStringToUuid{TypePair{oldType, newType}}
+	}
+	if oldPrimitive == PrimitiveUuid && newPrimitive == PrimitiveString {
+		return &TypeChangeUuidToString{TypePair{oldType, newType}}
+	}
+
 	if oldPrimitive == PrimitiveString {
 		if GetPrimitiveKind(newPrimitive) == PrimitiveKindInteger || GetPrimitiveKind(newPrimitive) == PrimitiveKindFloatingPoint {
 			return &TypeChangeStringToNumber{TypePair{oldType, newType}}
@@ -54,6 +54,43 @@ func (tc *TypeChangeStringToNumber) Inverse() TypeChange {
 	return &TypeChangeNumberToString{tc.Swap()}
 }
 
+type TypeChangeStringToBytes struct{ TypePair }
+
+func (tc *TypeChangeStringToBytes) Inverse() TypeChange {
+	return &TypeChangeBytesToString{tc.Swap()}
+}
+
+type TypeChangeBytesToString struct{ TypePair }
+
+func (tc *TypeChangeBytesToString) Inverse() TypeChange {
+	return &TypeChangeStringToBytes{tc.Swap()}
+}
+
+type TypeChangeStringToUuid struct{ TypePair }
+
+func (tc *TypeChangeStringToUuid) Inverse() TypeChange {
+	return &TypeChangeUuidToString{tc.Swap()}
+}
+
+type TypeChangeUuidToString struct{ TypePair }
+
+func (tc *TypeChangeUuidToString) Inverse() TypeChange {
+	return &TypeChangeStringToUuid{tc.Swap()}
+}
+
+// A change between a vector of uint8 and bytes, which have the same binary encoding.
+type TypeChangeVectorToBytes struct{ TypePair }
+
+func (tc *TypeChangeVectorToBytes) Inverse() TypeChange {
+	return &TypeChangeBytesToVector{tc.Swap()}
+}
+
+type TypeChangeBytesToVector struct{ TypePair }
+
+func (tc *TypeChangeBytesToVector) Inverse() TypeChange {
+	return &TypeChangeVectorToBytes{tc.Swap()}
+}
+
 type TypeChangeScalarToOptional struct{ TypePair }
 
 func (tc *TypeChangeScalarToOptional) Inverse() TypeChange {
@@ -165,6 +202,12 @@ var (
 	_ TypeChange = (*TypeChangeComplexToComplex)(nil)
 	_ TypeChange = (*TypeChangeNumberToString)(nil)
 	_ TypeChange = (*TypeChangeStringToNumber)(nil)
+	_ TypeChange = (*TypeChangeStringToBytes)(nil)
+	_ TypeChange = (*TypeChangeBytesToString)(nil)
+	_ TypeChange = (*TypeChangeStringToUuid)(nil)
+	_ TypeChange = (*TypeChangeUuidToString)(nil)
+	_ TypeChange = (*TypeChangeVectorToBytes)(nil)
+	_ TypeChange = (*TypeChangeBytesToVector)(nil)
 	_ TypeChange = (*TypeChangeScalarToOptional)(nil)
 	_ TypeChange = (*TypeChangeOptionalToScalar)(nil)
 	_ TypeChange = (*TypeChangeScalarToUnion)(nil)
@@ -211,9 +254,10 @@ type DefinitionChangeIncompatible struct {
 }
 
 const (
-	IncompatibleDefinitions     = "definitions are incompatible"
-	IncompatibleTypeParameters  = "type parameters do not match"
-	IncompatibleBaseDefinitions = "base definitions are incompatible"
+	IncompatibleDefinitions          = "definitions are incompatible"
+	IncompatibleTypeParameters       = "type parameters do not match"
+	IncompatibleBaseDefinitions      = "base definitions are incompatible"
+	IncompatibleRecursiveDefinitions = "recursive records cannot change between versions"
 )
 
 type NamedTypeChange struct {
@@ -272,7 +316,17 @@ func typeChangeIsError(tc TypeChange) bool {
 }
 
 func typeChangeToError(tc TypeChange) string {
-	return fmt.Sprintf("'%s' to '%s' is not backward compatible", TypeToShortSyntax(tc.OldType(), true), TypeToShortSyntax(tc.NewType(), true))
+	return fmt.Sprintf("'%s' to '%s' is not backward compatible", typeWithUnitSyntax(tc.OldType()), typeWithUnitSyntax(tc.NewType()))
+}
+
+// Returns the short syntax of a type followed by its unit of measure, if it has one,
+// so that errors about changed units show the difference.
+func typeWithUnitSyntax(t Type) string {
+	syntax := TypeToShortSyntax(t, true)
+	if st, ok := t.(*SimpleType); ok && st.Unit != "" {
+		return fmt.Sprintf("%s @ %s", syntax, st.Unit)
+	}
+	return syntax
 }
 
 func typeChangeWarningReason(tc TypeChange) string {
@@ -283,6 +337,14 @@ func typeChangeWarningReason(tc TypeChange) string {
 		return fmt.Sprintf("will result in a write error if its value cannot be converted to type '%s' at runtime", TypeToShortSyntax(tc.OldType(), true))
 	case *TypeChangeStringToNumber:
 		return fmt.Sprintf("will result in a read error if its value cannot be converted to type '%s' at runtime", TypeToShortSyntax(tc.NewType(), true))
+	case *TypeChangeStringToBytes:
+		return "will result in a write error if its value is not valid UTF-8 at runtime"
+	case *TypeChangeBytesToString:
+		return "will result in a read error if its value is not valid UTF-8 at runtime"
+	case *TypeChangeStringToUuid:
+		return "will result in a read error if its value is not a valid UUID at runtime"
+	case *TypeChangeUuidToString:
+		return "will result in a write error if its value is not a valid UUID at runtime"
 
 	case *TypeChangeScalarToOptional:
 		return fmt.Sprintf("will result in writing the default zero value for '%s' if it does not have a value at runtime", TypeToShortSyntax(tc.OldType(), true))
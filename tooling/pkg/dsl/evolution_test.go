@@ -6,6 +6,7 @@ package dsl
 import (
 	"fmt"
 	"slices"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -313,6 +314,12 @@ P: !protocol
 		{"bool?", "int?"},
 
 		{"int?", "[int, float]"},
+
+		{"float @ mm", "float @ m"},
+		{"float @ mm", "float"},
+		{"float @ mm*", "float @ m*"},
+		{"float @ mm?", "float?"},
+		{"string->float @ s", "string->float @ ms"},
 	}
 
 	for _, tt := range tests {
@@ -905,3 +912,280 @@ GenericRecord<T>: !record
 	_, _, err := ValidateEvolution(latest, previous, labels)
 	assert.Nil(t, err)
 }
+
+func TestInheritedFieldChanges(t *testing.T) {
+	models := []string{`
+P: !protocol
+  sequence:
+    x: R
+
+R: !record
+  fields:
+    version: int
+    data: float
+`, `
+P: !protocol
+  sequence:
+    x: R
+
+Header: !record
+  fields:
+    version: int
+    id: string
+
+R: !record
+  extends: [Header]
+  fields:
+    data: float
+`}
+
+	// Inherited fields are compared like the record's own fields
+	latest, previous, labels := parseVersions(t, models)
+	_, _, err := ValidateEvolution(latest, previous, labels)
+	assert.Nil(t, err)
+
+	changes := latest.GetTopLevelNamespace().DefinitionChanges["v0"]
+	index := slices.IndexFunc(changes, func(c DefinitionChange) bool {
+		return c.LatestDefinition().GetDefinitionMeta().Name == "R"
+	})
+	if assert.GreaterOrEqual(t, index, 0) {
+		recordChange := changes[index].(*RecordChange)
+		if assert.Len(t, recordChange.FieldsAdded, 1) {
+			assert.Equal(t, "id", recordChange.FieldsAdded[0].Name)
+		}
+		assert.Equal(t, []bool{false, false}, recordChange.FieldRemoved)
+	}
+}
+
+func TestUnitChanges(t *testing.T) {
+	models := []string{`
+P: !protocol
+  sequence:
+    x: R
+
+R: !record
+  fields:
+    length: float @ mm
+    duration: Seconds
+
+Seconds: double @ s
+`, `
+P: !protocol
+  sequence:
+    x: R
+
+R: !record
+  fields:
+    length: float @ m
+    duration: Seconds
+
+Seconds: double @ ms
+`}
+
+	latest, previous, labels := parseVersions(t, models)
+	_, _, err := ValidateEvolution(latest, previous, labels)
+	assert.ErrorContains(t, err, "changing field 'length' from 'float32 @ mm' to 'float32 @ m' is not backward compatible")
+	assert.ErrorContains(t, err, "this change to 'Seconds' is not backward compatible")
+
+	// keeping the units is compatible, even when the type changes
+	models[1] = strings.Replace(models[1], "float @ m", "int @ mm", 1)
+	models[1] = strings.Replace(models[1], "double @ ms", "double @ s", 1)
+	latest, previous, labels = parseVersions(t, models)
+	_, _, err = ValidateEvolution(latest, previous, labels)
+	assert.Nil(t, err)
+}
+
+func TestBytesChanges(t *testing.T) {
+	model := `
+R: !record
+  fields:
+    x: %s
+
+P: !protocol
+  sequence:
+    r: R
+`
+
+	tests := []struct {
+		typeA   string
+		typeB   string
+		warning string
+	}{
+		{"string", "bytes", "will result in a write error if its value is not valid UTF-8 at runtime"},
+		{"bytes", "string", "will result in a read error if its value is not valid UTF-8 at runtime"},
+		{"uint8*", "bytes", ""},
+		{"bytes", "uint8*", ""},
+		{"bytes?", "uint8*?", ""},
+	}
+
+	for _, tt := range tests {
+		latest, previous, labels := parseVersions(t, []string{fmt.Sprintf(model, tt.typeA), fmt.Sprintf(model, tt.typeB)})
+		_, warnings, err := ValidateEvolution(latest, previous, labels)
+		assert.Nil(t, err, "typeA: %s, typeB: %s", tt.typeA, tt.typeB)
+		if tt.warning == "" {
+			assert.Empty(t, warnings, "typeA: %s, typeB: %s", tt.typeA, tt.typeB)
+		} else {
+			assert.Len(t, warnings, 1)
+			assert.Contains(t, warnings[0], tt.warning)
+		}
+	}
+
+	invalid := []struct {
+		typeA string
+		typeB string
+	}{
+		{"uint8*4", "bytes"},
+		{"int8*", "bytes"},
+		{"uint8[]", "bytes"},
+		{"bytes", "int"},
+	}
+
+	for _, tt := range invalid {
+		latest, previous, labels := parseVersions(t, []string{fmt.Sprintf(model, tt.typeA), fmt.Sprintf(model, tt.typeB)})
+		_, _, err := ValidateEvolution(latest, previous, labels)
+		assert.NotNil(t, err, "typeA: %s, typeB: %s", tt.typeA, tt.typeB)
+
+		latest, previous, labels = parseVersions(t, []string{fmt.Sprintf(model, tt.typeB), fmt.Sprintf(model, tt.typeA)})
+		_, _, err = ValidateEvolution(latest, previous, labels)
+		assert.NotNil(t, err, "typeA: %s, typeB: %s", tt.typeB, tt.typeA)
+	}
+}
+
+func TestUuidChanges(t *testing.T) {
+	model := `
+R: !record
+  fields:
+    x: %s
+
+P: !protocol
+  sequence:
+    r: R
+`
+
+	tests := []struct {
+		typeA   string
+		typeB   string
+		warning string
+	}{
+		{"string", "uuid", "will result in a read error if its value is not a valid UUID at runtime"},
+		{"uuid", "string", "will result in a write error if its value is not a valid UUID at runtime"},
+		{"uuid?", "string?", "will result in a write error if its value is not a valid UUID at runtime"},
+	}
+
+	for _, tt := range tests {
+		latest, previous, labels := parseVersions(t, []string{fmt.Sprintf(model, tt.typeA), fmt.Sprintf(model, tt.typeB)})
+		_, warnings, err := ValidateEvolution(latest, previous, labels)
+		assert.Nil(t, err, "typeA: %s, typeB: %s", tt.typeA, tt.typeB)
+		assert.Len(t, warnings, 1)
+		assert.Contains(t, warnings[0], tt.warning)
+	}
+
+	for _, other := range []string{"bytes", "uint8*16", "int"} {
+		latest, previous, labels := parseVersions(t, []string{fmt.Sprintf(model, "uuid"), fmt.Sprintf(model, other)})
+		_, _, err := ValidateEvolution(latest, previous, labels)
+		assert.NotNil(t, err, "typeA: uuid, typeB: %s", other)
+
+		latest, previous, labels = parseVersions(t, []string{fmt.Sprintf(model, other), fmt.Sprintf(model, "uuid")})
+		_, _, err = ValidateEvolution(latest, previous, labels)
+		assert.NotNil(t, err, "typeA: %s, typeB: uuid", other)
+	}
+}
+
+func TestHalfPrecisionChanges(t *testing.T) {
+	model := `
+R: !record
+  fields:
+    x: %s
+
+P: !protocol
+  sequence:
+    r: R
+`
+
+	valid := []string{"int", "uint16", "float", "double", "bfloat16", "string"}
+	for _, other := range valid {
+		for _, half := range []string{"float16", "bfloat16"} {
+			if other == half {
+				continue
+			}
+			latest, previous, labels := parseVersions(t, []string{fmt.Sprintf(model, half), fmt.Sprintf(model, other)})
+			_, _, err := ValidateEvolution(latest, previous, labels)
+			assert.Nil(t, err, "typeA: %s, typeB: %s", half, other)
+
+			latest, previous, labels = parseVersions(t, []string{fmt.Sprintf(model, other), fmt.Sprintf(model, half)})
+			_, _, err = ValidateEvolution(latest, previous, labels)
+			assert.Nil(t, err, "typeA: %s, typeB: %s", other, half)
+		}
+	}
+
+	invalid := []string{"complexfloat", "bool", "date", "bytes"}
+	for _, other := range invalid {
+		latest, previous, labels := parseVersions(t, []string{fmt.Sprintf(model, "float16"), fmt.Sprintf(model, other)})
+		_, _, err := ValidateEvolution(latest, previous, labels)
+		assert.NotNil(t, err, "typeA: float16, typeB: %s", other)
+	}
+}
+
+func TestRecursiveRecordChanges(t *testing.T) {
+	model := `
+Node: !record
+  fields:
+    label: string
+    children: Node*
+%s
+
+P: !protocol
+  sequence:
+    root: Node
+`
+
+	latest, previous, labels := parseVersions(t, []string{fmt.Sprintf(model, ""), fmt.Sprintf(model, "")})
+	_, _, err := ValidateEvolution(latest, previous, labels)
+	assert.Nil(t, err)
+
+	latest, previous, labels = parseVersions(t, []string{fmt.Sprintf(model, ""), fmt.Sprintf(model, "    weight: int")})
+	_, _, err = ValidateEvolution(latest, previous, labels)
+	assert.ErrorContains(t, err, IncompatibleRecursiveDefinitions)
+}
+
+func TestSubProtocolChanges(t *testing.T) {
+	model := `
+Calibration: !protocol
+  sequence:
+    gain: %s
+
+P: !protocol
+  sequence:
+    calibration: !protocol Calibration
+    data: int
+`
+
+	latest, previous, labels := parseVersions(t, []string{fmt.Sprintf(model, "float"), fmt.Sprintf(model, "float")})
+	_, _, err := ValidateEvolution(latest, previous, labels)
+	assert.Nil(t, err)
+	for _, p := range latest.Namespaces[0].Protocols {
+		assert.Nil(t, p.Versions["v0"], p.Name)
+	}
+
+	latest, previous, labels = parseVersions(t, []string{fmt.Sprintf(model, "float"), fmt.Sprintf(model, "double")})
+	_, _, err = ValidateEvolution(latest, previous, labels)
+	assert.Nil(t, err)
+	for _, p := range latest.Namespaces[0].Protocols {
+		change := p.Versions["v0"]
+		if assert.NotNil(t, change, p.Name) {
+			assert.NotNil(t, change.StepChanges[0], p.Name)
+		}
+	}
+
+	// Inlining the steps of a protocol is compatible with writing them directly
+	flat := `
+P: !protocol
+  sequence:
+    calibrationGain: float
+    data: int
+`
+	latest, previous, labels = parseVersions(t, []string{flat, fmt.Sprintf(model, "float")})
+	_, _, err = ValidateEvolution(latest, previous, labels)
+	assert.Nil(t, err)
+	assert.Nil(t, latest.Namespaces[0].Protocols[1].Versions["v0"])
+}
@@ -18,6 +18,8 @@ var (
 		{Name: "Float", Pattern: `(((\d+\.\d*)|(\d*\.\d+))(e[-+]?[0-9]+)?)|(\d+(e[-+]?[0-9]+))`},
 		{Name: "Int", Pattern: `((0[xX][0-9A-Fa-f]+)|\d+)`},
 		{Name: "As", Pattern: `as`},
+		{Name: "If", Pattern: `if\b`},
+		{Name: "Else", Pattern: `else\b`},
 		{Name: "Ident", Pattern: `[a-zA-Z_]\w*`},
 		{Name: "OpenParen", Pattern: `\(`},
 		{Name: "CloseParen", Pattern: `\)`},
@@ -31,6 +33,15 @@ var (
 		{Name: "Star", Pattern: `\*`},
 		{Name: "Slash", Pattern: `/`},
 		{Name: "Colon", Pattern: `:`},
+		{Name: "Eq", Pattern: `==`},
+		{Name: "Ne", Pattern: `!=`},
+		{Name: "Le", Pattern: `<=`},
+		{Name: "Ge", Pattern: `>=`},
+		{Name: "Lt", Pattern: `<`},
+		{Name: "Gt", Pattern: `>`},
+		{Name: "And", Pattern: `&&`},
+		{Name: "Or", Pattern: `\|\|`},
+		{Name: "Not", Pattern: `!`},
 		{Name: "UnterminatedString", Pattern: `["']`},
 		{Name: "OtherPunct", Pattern: `[-[!@#$%^&*()+_={}\|:;"'<,>.?/]|]`},
 		{Name: "whitespace", Pattern: `[ \t]+`},
@@ -40,6 +51,8 @@ var (
 	TokenTypeFloat              = expressionLexer.Symbols()["Float"]
 	TokenTypeInt                = expressionLexer.Symbols()["Int"]
 	TokenTypeAs                 = expressionLexer.Symbols()["As"]
+	TokenTypeIf                 = expressionLexer.Symbols()["If"]
+	TokenTypeElse               = expressionLexer.Symbols()["Else"]
 	TokenTypeIdent              = expressionLexer.Symbols()["Ident"]
 	TokenTypeOpenParen          = expressionLexer.Symbols()["OpenParen"]
 	TokenTypeCloseParen         = expressionLexer.Symbols()["CloseParen"]
@@ -53,6 +66,15 @@ var (
 	TokenTypeStar               = expressionLexer.Symbols()["Star"]
 	TokenTypeSlash              = expressionLexer.Symbols()["Slash"]
 	TokenTypeColon              = expressionLexer.Symbols()["Colon"]
+	TokenTypeEq                 = expressionLexer.Symbols()["Eq"]
+	TokenTypeNe                 = expressionLexer.Symbols()["Ne"]
+	TokenTypeLe                 = expressionLexer.Symbols()["Le"]
+	TokenTypeGe                 = expressionLexer.Symbols()["Ge"]
+	TokenTypeLt                 = expressionLexer.Symbols()["Lt"]
+	TokenTypeGt                 = expressionLexer.Symbols()["Gt"]
+	TokenTypeAnd                = expressionLexer.Symbols()["And"]
+	TokenTypeOr                 = expressionLexer.Symbols()["Or"]
+	TokenTypeNot                = expressionLexer.Symbols()["Not"]
 	TokenTypeUnterminatedString = expressionLexer.Symbols()["UnterminatedString"]
 
 	operatorInfo = map[lexer.TokenType]struct {
@@ -60,18 +82,29 @@ var (
 		Precedence         int
 		IsBinary           bool
 	}{
-		TokenTypeOpenBracket: {Precedence: 5, IsBinary: false}, // for subscript
-		TokenTypeOpenParen:   {Precedence: 5, IsBinary: false}, // for function call
-		TokenTypeDot:         {Precedence: 5, IsBinary: true},
-		TokenTypeAs:          {Precedence: 4, IsBinary: true},
-		TokenTypePow:         {Precedence: 3, IsBinary: true, IsRightAssociative: true},
-		TokenTypeStar:        {Precedence: 2, IsBinary: true},
-		TokenTypeSlash:       {Precedence: 2, IsBinary: true},
-		TokenTypePlus:        {Precedence: 1, IsBinary: true},
-		TokenTypeMinus:       {Precedence: 1, IsBinary: true},
+		TokenTypeOpenBracket: {Precedence: postfixPrecedence, IsBinary: false}, // for subscript
+		TokenTypeOpenParen:   {Precedence: postfixPrecedence, IsBinary: false}, // for function call
+		TokenTypeDot:         {Precedence: postfixPrecedence, IsBinary: true},
+		TokenTypeAs:          {Precedence: 8, IsBinary: true},
+		TokenTypePow:         {Precedence: 7, IsBinary: true, IsRightAssociative: true},
+		TokenTypeStar:        {Precedence: 6, IsBinary: true},
+		TokenTypeSlash:       {Precedence: 6, IsBinary: true},
+		TokenTypePlus:        {Precedence: 5, IsBinary: true},
+		TokenTypeMinus:       {Precedence: 5, IsBinary: true},
+		TokenTypeEq:          {Precedence: 4, IsBinary: true},
+		TokenTypeNe:          {Precedence: 4, IsBinary: true},
+		TokenTypeLt:          {Precedence: 4, IsBinary: true},
+		TokenTypeLe:          {Precedence: 4, IsBinary: true},
+		TokenTypeGt:          {Precedence: 4, IsBinary: true},
+		TokenTypeGe:          {Precedence: 4, IsBinary: true},
+		TokenTypeAnd:         {Precedence: 3, IsBinary: true},
+		TokenTypeOr:          {Precedence: 2, IsBinary: true},
+		TokenTypeIf:          {Precedence: 1, IsBinary: false}, // for conditional
 	}
 )
 
+const postfixPrecedence = 9
+
 var expressionParser = participle.MustBuild[Expression](
 	participle.Lexer(expressionLexer),
 	participle.Unquote("String"),
@@ -154,13 +187,18 @@ func parseExprWithPrecedence(lex *lexer.PeekingLexer, minPrec int) (Expression,
 				return nil, err
 			}
 		case tok.Type == TokenTypeOpenBracket:
-			if minPrec >= 5 {
+			if minPrec >= postfixPrecedence {
 				break
 			}
 			lhs, err = parseSubscript(lex, lhs)
 			if err != nil {
 				return nil, err
 			}
+		case tok.Type == TokenTypeIf:
+			lhs, err = parseConditional(lex, lhs)
+			if err != nil {
+				return nil, err
+			}
 		}
 	}
 
@@ -214,6 +252,22 @@ func combineOperands(lhs Expression, tok *lexer.Token, rhs Expression) (Expressi
 		binaryExpression.Operator = BinaryOpDiv
 	case TokenTypePow:
 		binaryExpression.Operator = BinaryOpPow
+	case TokenTypeEq:
+		binaryExpression.Operator = BinaryOpEq
+	case TokenTypeNe:
+		binaryExpression.Operator = BinaryOpNe
+	case TokenTypeLt:
+		binaryExpression.Operator = BinaryOpLt
+	case TokenTypeLe:
+		binaryExpression.Operator = BinaryOpLe
+	case TokenTypeGt:
+		binaryExpression.Operator = BinaryOpGt
+	case TokenTypeGe:
+		binaryExpression.Operator = BinaryOpGe
+	case TokenTypeAnd:
+		binaryExpression.Operator = BinaryOpAnd
+	case TokenTypeOr:
+		binaryExpression.Operator = BinaryOpOr
 	default:
 		panic(fmt.Sprintf("unexpected token type %v", tok.Type))
 	}
@@ -252,6 +306,18 @@ func parseAtom(lex *lexer.PeekingLexer) (Expression, error) {
 				Expression: expr,
 			}, nil
 		}
+	case TokenTypeNot:
+		// logical not, which binds more tightly than binary operators other than `as`
+		expr, err := parseExprWithPrecedence(lex, operatorInfo[TokenTypeAs].Precedence)
+		if err != nil {
+			return nil, err
+		}
+
+		return &UnaryExpression{
+			NodeMeta:   nodeMetaFromPosition(tok.Pos),
+			Operator:   UnaryOpNot,
+			Expression: expr,
+		}, nil
 	case TokenTypeInt:
 		i := IntegerLiteralExpression{
 			NodeMeta: nodeMetaFromPosition(tok.Pos),
@@ -283,8 +349,21 @@ func parseAtom(lex *lexer.PeekingLexer) (Expression, error) {
 			return nil, &participle.UnexpectedTokenError{Unexpected: *tok, Expect: "closing parenthesis"}
 		}
 		return expr, nil
+	case TokenTypeIf, TokenTypeElse:
+		// `if` and `else` are only keywords where an operator is expected,
+		// so they can still name fields
+		return &MemberAccessExpression{
+			NodeMeta: nodeMetaFromPosition(tok.Pos),
+			Member:   tok.Value,
+		}, nil
 	case TokenTypeIdent:
 		identifier := tok.Value
+		if identifier == "true" || identifier == "false" {
+			return &BooleanLiteralExpression{
+				NodeMeta: nodeMetaFromPosition(tok.Pos),
+				Value:    identifier == "true",
+			}, nil
+		}
 		return &MemberAccessExpression{
 			NodeMeta: nodeMetaFromPosition(tok.Pos),
 			Member:   identifier,
@@ -334,6 +413,37 @@ func parseCall(lex *lexer.PeekingLexer, target Expression) (*FunctionCallExpress
 	}, nil
 }
 
+// Parses the remainder of a conditional expression `then if condition else otherwise`.
+// Like in Python, the condition cannot be a conditional expression without parentheses,
+// but the else branch can.
+func parseConditional(lex *lexer.PeekingLexer, then Expression) (*ConditionalExpression, error) {
+	tok := lex.Next()
+	if tok.Type != TokenTypeIf {
+		panic("expected if")
+	}
+
+	condition, err := parseExprWithPrecedence(lex, operatorInfo[TokenTypeIf].Precedence+1)
+	if err != nil {
+		return nil, err
+	}
+
+	if elseTok := lex.Next(); elseTok.EOF() || elseTok.Type != TokenTypeElse {
+		return nil, &participle.UnexpectedTokenError{Unexpected: *elseTok, Expect: "else"}
+	}
+
+	otherwise, err := parseExprWithPrecedence(lex, operatorInfo[TokenTypeIf].Precedence)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ConditionalExpression{
+		NodeMeta:  nodeMetaFromPosition(tok.Pos),
+		Condition: condition,
+		Then:      then,
+		Else:      otherwise,
+	}, nil
+}
+
 func parseSubscript(lex *lexer.PeekingLexer, target Expression) (*SubscriptExpression, error) {
 	if lex.Next().Type != TokenTypeOpenBracket {
 		panic("expected open bracket")
@@ -400,7 +510,7 @@ func parseSubscriptArg(lex *lexer.PeekingLexer) (*SubscriptArgument, error) {
 }
 
 func nodeMetaFromPosition(pos lexer.Position) NodeMeta {
-	return NodeMeta{}
+	return NodeMeta{Line: pos.Line, Column: pos.Column}
 }
 
 func getLexerPosFromNodeMeta(meta NodeMeta) lexer.Position {
@@ -61,6 +61,9 @@ func TestParseExpression(t *testing.T) {
 		{"foo(bar(),1)", "", "(call foo (call bar) 1)"},
 		{"foo(bar(),)", `unexpected token ")" (expected an expression)`, ""},
 		{"foo", "", "foo"},
+		{"true", "", "true"},
+		{"false", "", "false"},
+		{"trueish", "", "trueish"},
 		{"foo.bar", "", "(. foo bar)"},
 		{"foo.bar.baz", "", "(. (. foo bar) baz)"},
 		{"foo.(1+2)", `The right-hand side of a '.' operator must be an identifier`, ""},
@@ -87,6 +90,45 @@ func TestParseExpression(t *testing.T) {
 		{"2 ** 2 ** 3", "", "(** 2 (** 2 3))"},
 		{"1 * 2 ** 3 + 2", "", "(+ (* 1 (** 2 3)) 2)"},
 
+		{"a == b", "", "(== a b)"},
+		{"a != b", "", "(!= a b)"},
+		{"a < b", "", "(< a b)"},
+		{"a <= b", "", "(<= a b)"},
+		{"a > b", "", "(> a b)"},
+		{"a >= b", "", "(>= a b)"},
+		{"size(a) > 1024", "", "(> (call size a) 1024)"},
+		{"a + 1 < b * 2", "", "(< (+ a 1) (* b 2))"},
+		{"a < b == c", "", "(== (< a b) c)"},
+		{"a && b || c", "", "(|| (&& a b) c)"},
+		{"a || b && c", "", "(|| a (&& b c))"},
+		{"a < b && c >= d", "", "(&& (< a b) (>= c d))"},
+		{"!a", "", "(! a)"},
+		{"!a.b[0]", "", "(! (subscript (. a b) 0))"},
+		{"!a && b", "", "(&& (! a) b)"},
+		{"!(a && b)", "", "(! (&& a b))"},
+		{"!a == b", "", "(== (! a) b)"},
+		{"!!a", "", "(! (! a))"},
+		{"b if a else c", "", "(if a b c)"},
+		{"a + 1 if a > 0 else -a", "", "(if (> a 0) (+ a 1) (- a))"},
+		{"b if a else d if c else e", "", "(if a b (if c d e))"},
+		{"(c if b else d) if a else e", "", "(if a (if b c d) e)"},
+		{"b if (c if a else d) else e", "", "(if (if a c d) b e)"},
+		{"(b if a else c) + 1", "", "(+ (if a b c) 1)"},
+		{"c if a || b else d", "", "(if (|| a b) c d)"},
+		{"foo[1 if a else 2]", "", "(subscript foo (if a 1 2))"},
+		{"foo[x: 1 if a else 2]", "", "(subscript foo x:(if a 1 2))"},
+		{"iffy", "", "iffy"},
+		{"elsewhere", "", "elsewhere"},
+		{"if", "", "if"},
+		{"else", "", "else"},
+		{"a.if", "", "(. a if)"},
+		{"a if if else b", "", "(if if a b)"},
+		{"a if b else else", "", "(if b a else)"},
+		{"foo[if:1, else:2]", "", "(subscript foo if:1 else:2)"},
+		{"b if a", `unexpected token "<EOF>" (expected else)`, ""},
+		{"b if a c", `unexpected token "c" (expected else)`, ""},
+		{"a & b", `unexpected token "&"`, ""},
+
 		{"Foo(1 + 2, a[3 * 4])", "", "(call Foo (+ 1 2) (subscript a (* 3 4)))"},
 		{"1 ** x() + 2", "", "(+ (** 1 (call x)) 2)"},
 
@@ -123,6 +165,8 @@ func expressionToString(exp Expression) string {
 		return exp.Value
 	case *StringLiteralExpression:
 		return fmt.Sprintf("«%s»", exp.Value)
+	case *BooleanLiteralExpression:
+		return fmt.Sprint(exp.Value)
 	case *BinaryExpression:
 		var op string
 		switch exp.Operator {
@@ -136,15 +180,37 @@ func expressionToString(exp Expression) string {
 			op = "/"
 		case BinaryOpPow:
 			op = "**"
+		case BinaryOpEq:
+			op = "=="
+		case BinaryOpNe:
+			op = "!="
+		case BinaryOpLt:
+			op = "<"
+		case BinaryOpLe:
+			op = "<="
+		case BinaryOpGt:
+			op = ">"
+		case BinaryOpGe:
+			op = ">="
+		case BinaryOpAnd:
+			op = "&&"
+		case BinaryOpOr:
+			op = "||"
 		default:
 			panic(fmt.Sprintf("unexpected binary operator %d", exp.Operator))
 		}
 		return fmt.Sprintf("(%s %s %s)", op, expressionToString(exp.Left), expressionToString(exp.Right))
 	case *UnaryExpression:
-		if exp.Operator != UnaryOpNegate {
+		switch exp.Operator {
+		case UnaryOpNegate:
+			return fmt.Sprintf("(- %s)", expressionToString(exp.Expression))
+		case UnaryOpNot:
+			return fmt.Sprintf("(! %s)", expressionToString(exp.Expression))
+		default:
 			panic(fmt.Sprintf("unexpected unary operator %d", exp.Operator))
 		}
-		return fmt.Sprintf("(- %s)", expressionToString(exp.Expression))
+	case *ConditionalExpression:
+		return fmt.Sprintf("(if %s %s %s)", expressionToString(exp.Condition), expressionToString(exp.Then), expressionToString(exp.Else))
 	case *TypeConversionExpression:
 		return fmt.Sprintf("(as %s %s)", expressionToString(exp.Expression), TypeToShortSyntax(exp.Type, true))
 	case *MemberAccessExpression:
@@ -0,0 +1,211 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+package dsl
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/microsoft/yardl/tooling/internal/validation"
+)
+
+// The kinds of arguments that built-in functions accept.
+type FunctionParameterKind int
+
+const (
+	// An integer or floating-point number
+	FunctionParameterNumber FunctionParameterKind = iota
+	// A floating-point number
+	FunctionParameterFloatingPoint
+	// A string
+	FunctionParameterString
+	// A vector of integers or floating-point numbers
+	FunctionParameterNumericVector
+	// A map
+	FunctionParameterMap
+	// A key of the map given as the preceding argument
+	FunctionParameterMapKey
+	// An optional value
+	FunctionParameterOptional
+)
+
+func (k FunctionParameterKind) String() string {
+	switch k {
+	case FunctionParameterNumber:
+		return "an integer or floating-point number"
+	case FunctionParameterFloatingPoint:
+		return "a floating-point number"
+	case FunctionParameterString:
+		return "a string"
+	case FunctionParameterNumericVector:
+		return "a !vector of integers or floating-point numbers"
+	case FunctionParameterMap:
+		return "a !map"
+	case FunctionParameterMapKey:
+		return "a key of the !map"
+	case FunctionParameterOptional:
+		return "an optional value"
+	default:
+		panic(fmt.Sprintf("unexpected function parameter kind %d", k))
+	}
+}
+
+// The signature of a built-in function that can be called in computed fields.
+// Number arguments are converted to the result type, and map keys to the
+// key type of the map.
+type FunctionSignature struct {
+	Name       string
+	Parameters []FunctionParameterKind
+	// Returns the type of the result given the types of the arguments,
+	// which have already been checked against the parameters.
+	ResultType func(argumentTypes []Type) (Type, error)
+}
+
+var builtinFunctions = map[string]*FunctionSignature{}
+
+func init() {
+	sameAsArgument := func(argumentTypes []Type) (Type, error) {
+		return argumentTypes[0], nil
+	}
+
+	for _, signature := range []*FunctionSignature{
+		{Name: FunctionMin, Parameters: []FunctionParameterKind{FunctionParameterNumber, FunctionParameterNumber}, ResultType: commonNumberType},
+		{Name: FunctionMax, Parameters: []FunctionParameterKind{FunctionParameterNumber, FunctionParameterNumber}, ResultType: commonNumberType},
+		{Name: FunctionAbs, Parameters: []FunctionParameterKind{FunctionParameterNumber}, ResultType: sameAsArgument},
+		{Name: FunctionSqrt, Parameters: []FunctionParameterKind{FunctionParameterNumber}, ResultType: func(argumentTypes []Type) (Type, error) {
+			if kind, _ := GetKindIfPrimitive(argumentTypes[0]); kind == PrimitiveKindInteger {
+				return Float64Type, nil
+			}
+			return argumentTypes[0], nil
+		}},
+		{Name: FunctionFloor, Parameters: []FunctionParameterKind{FunctionParameterFloatingPoint}, ResultType: sameAsArgument},
+		{Name: FunctionCeil, Parameters: []FunctionParameterKind{FunctionParameterFloatingPoint}, ResultType: sameAsArgument},
+		{Name: FunctionSum, Parameters: []FunctionParameterKind{FunctionParameterNumericVector}, ResultType: func(argumentTypes []Type) (Type, error) {
+			return ToGeneralizedType(GetUnderlyingType(argumentTypes[0])).Cases[0].Type, nil
+		}},
+		{Name: FunctionLength, Parameters: []FunctionParameterKind{FunctionParameterString}, ResultType: func([]Type) (Type, error) {
+			return SizeType, nil
+		}},
+		{Name: FunctionContains, Parameters: []FunctionParameterKind{FunctionParameterMap, FunctionParameterMapKey}, ResultType: func([]Type) (Type, error) {
+			return BoolType, nil
+		}},
+		{Name: FunctionHasValue, Parameters: []FunctionParameterKind{FunctionParameterOptional}, ResultType: func([]Type) (Type, error) {
+			return BoolType, nil
+		}},
+	} {
+		builtinFunctions[signature.Name] = signature
+	}
+}
+
+// Returns the signature of the built-in function with the given name, if there is one.
+// The size, dimensionIndex, and dimensionCount functions are not included,
+// since their arguments cannot be described by a signature.
+func GetBuiltinFunction(name string) (*FunctionSignature, bool) {
+	signature, ok := builtinFunctions[name]
+	return signature, ok
+}
+
+func commonNumberType(argumentTypes []Type) (Type, error) {
+	return GetCommonType(argumentTypes[0], argumentTypes[1])
+}
+
+// Checks the arguments of a call to a function with the given signature, converting them
+// as needed, and sets the type of the call to the function's result type.
+func resolveBuiltinFunctionCall(functionCall *FunctionCallExpression, signature *FunctionSignature, visitor *RewriterWithContext[*ComputedFieldScope], context *ComputedFieldScope, errorSink *validation.ErrorSink) Expression {
+	functionCall = visitor.DefaultRewrite(functionCall, context).(*FunctionCallExpression)
+	functionCall = shallowClone(functionCall)
+
+	if len(functionCall.Arguments) != len(signature.Parameters) {
+		plural := "s"
+		if len(signature.Parameters) == 1 {
+			plural = ""
+		}
+		errorSink.Add(validationError(functionCall, "%s() expects %d argument%s, but called with %d", signature.Name, len(signature.Parameters), plural, len(functionCall.Arguments)))
+		return functionCall
+	}
+
+	argumentTypes := make([]Type, len(functionCall.Arguments))
+	for i, arg := range functionCall.Arguments {
+		argumentTypes[i] = arg.GetResolvedType()
+		if argumentTypes[i] == nil {
+			return functionCall
+		}
+	}
+
+	var keyType Type
+	valid := true
+	for i, parameter := range signature.Parameters {
+		underlyingType := ToGeneralizedType(GetUnderlyingType(argumentTypes[i]))
+		kind, _ := GetKindIfPrimitive(argumentTypes[i])
+		matches := false
+		switch parameter {
+		case FunctionParameterNumber:
+			matches = kind == PrimitiveKindInteger || kind == PrimitiveKindFloatingPoint
+		case FunctionParameterFloatingPoint:
+			matches = kind == PrimitiveKindFloatingPoint
+		case FunctionParameterString:
+			primitive, ok := GetPrimitiveType(argumentTypes[i])
+			matches = ok && primitive == String
+		case FunctionParameterNumericVector:
+			if _, ok := underlyingType.Dimensionality.(*Vector); ok && underlyingType.Cases.IsSingle() {
+				itemKind, _ := GetKindIfPrimitive(underlyingType.Cases[0].Type)
+				matches = itemKind == PrimitiveKindInteger || itemKind == PrimitiveKindFloatingPoint
+			}
+		case FunctionParameterMap:
+			if m, ok := underlyingType.Dimensionality.(*Map); ok {
+				keyType = m.KeyType
+				matches = true
+			}
+		case FunctionParameterMapKey:
+			if keyType == nil {
+				// the map argument is not valid
+				continue
+			}
+			functionCall.Arguments[i] = convertToMapKey(functionCall.Arguments[i], keyType)
+			matches = functionCall.Arguments[i].GetResolvedType() != nil && TypesEqual(GetUnderlyingType(functionCall.Arguments[i].GetResolvedType()), GetUnderlyingType(keyType))
+		case FunctionParameterOptional:
+			matches = underlyingType.Dimensionality == nil && underlyingType.Cases.IsOptional()
+		}
+
+		if !matches {
+			valid = false
+			errorSink.Add(validationError(functionCall.Arguments[i], "argument %d of %s() must be %s, but has type '%s'", i+1, signature.Name, parameter, TypeToShortSyntax(argumentTypes[i], true)))
+		}
+	}
+
+	if !valid || !validateFunctionArgumentUnits(functionCall, errorSink) {
+		return functionCall
+	}
+
+	resultType, err := signature.ResultType(argumentTypes)
+	if err != nil {
+		types := make([]string, len(argumentTypes))
+		for i, t := range argumentTypes {
+			types[i] = fmt.Sprintf("'%s'", TypeToShortSyntax(t, true))
+		}
+		errorSink.Add(validationError(functionCall, "%s() is not defined for arguments with types %s", signature.Name, strings.Join(types, " and ")))
+		return functionCall
+	}
+
+	for i, parameter := range signature.Parameters {
+		if parameter == FunctionParameterNumber {
+			functionCall.Arguments[i] = insertConversion(functionCall.Arguments[i], resultType)
+		}
+	}
+
+	functionCall.ResolvedType = resultType
+	return functionCall
+}
+
+// Converts a numeric key to the key type of a map. Keys of other types
+// are returned as they are.
+func convertToMapKey(key Expression, keyType Type) Expression {
+	keyKind, keyIsPrimitive := GetKindIfPrimitive(key.GetResolvedType())
+	targetKind, targetIsPrimitive := GetKindIfPrimitive(keyType)
+	if keyIsPrimitive && targetIsPrimitive && keyKind == PrimitiveKindInteger && targetKind == PrimitiveKindInteger {
+		return insertConversion(key, keyType)
+	}
+
+	return key
+}
@@ -0,0 +1,128 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+package dsl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuiltinFunctions(t *testing.T) {
+	src := `
+X: !record
+  fields:
+    i: int
+    u: uint8
+    f: float
+    items: int16*
+    name: string
+    lookup: int->string
+    maybe: double?
+  computedFields:
+    minimum: min(i, u)
+    maximum: max(f, 1)
+    absolute: abs(u)
+    root: sqrt(i)
+    floatRoot: sqrt(f)
+    lower: floor(f)
+    upper: ceil(f)
+    total: sum(items)
+    nameLength: length(name)
+    hasKey: contains(lookup, 1)
+    present: hasValue(maybe)`
+	env, err := parseAndValidate(t, src)
+	require.NoError(t, err)
+
+	expected := map[string]Type{
+		"minimum":    Int32Type,
+		"maximum":    Float32Type,
+		"absolute":   Uint8Type,
+		"root":       Float64Type,
+		"floatRoot":  Float32Type,
+		"lower":      Float32Type,
+		"upper":      Float32Type,
+		"total":      Int16Type,
+		"nameLength": SizeType,
+		"hasKey":     BoolType,
+		"present":    BoolType,
+	}
+
+	computedFields := env.SymbolTable["test.X"].(*RecordDefinition).ComputedFields
+	for _, f := range computedFields {
+		assert.True(t, TypesEqual(expected[f.Name], f.Expression.GetResolvedType()), f.Name)
+	}
+
+	// the arguments are converted to the result type
+	minimum := computedFields[0].Expression.(*FunctionCallExpression)
+	assert.True(t, TypesEqual(Int32Type, minimum.Arguments[1].GetResolvedType()))
+
+	// and map keys to the key type
+	hasKey := computedFields[9].Expression.(*FunctionCallExpression)
+	assert.True(t, TypesEqual(Int32Type, hasKey.Arguments[1].GetResolvedType()))
+}
+
+func TestBuiltinFunctionArgumentCount(t *testing.T) {
+	src := `
+X: !record
+  fields:
+    f: float
+  computedFields:
+    c1: min(f)
+    c2: abs(f, f)`
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, "min() expects 2 arguments, but called with 1")
+	assert.ErrorContains(t, err, "abs() expects 1 argument, but called with 2")
+}
+
+func TestBuiltinFunctionArgumentTypes(t *testing.T) {
+	src := `
+X: !record
+  fields:
+    i: int
+    s: string
+    items: string*
+    lookup: string->int
+    notOptional: int
+  computedFields:
+    c1: abs(s)
+    c2: floor(i)
+    c3: sum(items)
+    c4: length(i)
+    c5: contains(items, 1)
+    c6: contains(lookup, 1)
+    c7: hasValue(notOptional)`
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, "argument 1 of abs() must be an integer or floating-point number, but has type 'string'")
+	assert.ErrorContains(t, err, "argument 1 of floor() must be a floating-point number, but has type 'int32'")
+	assert.ErrorContains(t, err, "argument 1 of sum() must be a !vector of integers or floating-point numbers, but has type 'string*'")
+	assert.ErrorContains(t, err, "argument 1 of length() must be a string, but has type 'int32'")
+	assert.ErrorContains(t, err, "argument 1 of contains() must be a !map, but has type 'string*'")
+	assert.ErrorContains(t, err, "argument 2 of contains() must be a key of the !map, but has type 'uint8'")
+	assert.ErrorContains(t, err, "argument 1 of hasValue() must be an optional value, but has type 'int32'")
+}
+
+func TestBuiltinFunctionNoCommonType(t *testing.T) {
+	src := `
+X: !record
+  fields:
+    a: int64
+    b: uint64
+  computedFields:
+    c: max(a, b)`
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, "max() is not defined for arguments with types 'int64' and 'uint64'")
+}
+
+func TestUnknownFunction(t *testing.T) {
+	src := `
+X: !record
+  fields:
+    a: int
+  computedFields:
+    c: round(a)`
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, "unknown function 'round'")
+}
@@ -11,14 +11,15 @@ import (
 // Customize JSON marshaling for DSL types
 
 func (t *SimpleType) MarshalJSON() ([]byte, error) {
-	if len(t.TypeArguments) == 0 {
+	if len(t.TypeArguments) == 0 && t.Unit == "" {
 		return json.Marshal(t.Name)
 	}
 	type expanded struct {
 		Name          string `json:"name"`
 		TypeArguments []Type `json:"typeArguments,omitempty"`
+		Unit          string `json:"unit,omitempty"`
 	}
-	return json.Marshal(expanded{Name: t.Name, TypeArguments: t.TypeArguments})
+	return json.Marshal(expanded{Name: t.Name, TypeArguments: t.TypeArguments, Unit: t.Unit})
 }
 
 func (t *GenericTypeParameter) MarshalJSON() ([]byte, error) {
@@ -163,6 +164,30 @@ func (e *StringLiteralExpression) MarshalJSON() ([]byte, error) {
 	return json.Marshal(fmt.Sprintf("%q", e.Value))
 }
 
+func (e *BooleanLiteralExpression) MarshalJSON() ([]byte, error) {
+	return json.Marshal(e.Value)
+}
+
+func (e *EnumValueExpression) MarshalJSON() ([]byte, error) {
+	type repr struct {
+		Type   Type   `json:"type"`
+		Symbol string `json:"symbol"`
+	}
+	return json.Marshal(struct {
+		EnumValue repr `json:"enumValue"`
+	}{
+		EnumValue: repr{Type: e.ResolvedType, Symbol: e.Value.Symbol},
+	})
+}
+
+func (e *ConstantReferenceExpression) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Constant string `json:"constant"`
+	}{
+		Constant: e.Definition.GetQualifiedName(),
+	})
+}
+
 func (op *BinaryOperator) MarshalJSON() ([]byte, error) {
 	switch *op {
 	case BinaryOpAdd:
@@ -175,6 +200,22 @@ func (op *BinaryOperator) MarshalJSON() ([]byte, error) {
 		return json.Marshal("div")
 	case BinaryOpPow:
 		return json.Marshal("exp")
+	case BinaryOpEq:
+		return json.Marshal("eq")
+	case BinaryOpNe:
+		return json.Marshal("ne")
+	case BinaryOpLt:
+		return json.Marshal("lt")
+	case BinaryOpLe:
+		return json.Marshal("le")
+	case BinaryOpGt:
+		return json.Marshal("gt")
+	case BinaryOpGe:
+		return json.Marshal("ge")
+	case BinaryOpAnd:
+		return json.Marshal("and")
+	case BinaryOpOr:
+		return json.Marshal("or")
 	default:
 		panic(fmt.Sprintf("unexpected binary operator %d", *op))
 	}
@@ -189,18 +230,34 @@ func (e *BinaryExpression) MarshalJSON() ([]byte, error) {
 	})
 }
 
-func (e *UnaryExpression) MarshalJSON() ([]byte, error) {
-	if e.Operator != UnaryOpNegate {
-		panic(fmt.Sprintf("unexpected unary operator %d", e.Operator))
-	}
-
+func (e *ConditionalExpression) MarshalJSON() ([]byte, error) {
+	type Alias ConditionalExpression
 	return json.Marshal(struct {
-		Negate Expression `json:"negate"`
+		Conditional *Alias `json:"conditional"`
 	}{
-		Negate: e.Expression,
+		Conditional: (*Alias)(e),
 	})
 }
 
+func (e *UnaryExpression) MarshalJSON() ([]byte, error) {
+	switch e.Operator {
+	case UnaryOpNegate:
+		return json.Marshal(struct {
+			Negate Expression `json:"negate"`
+		}{
+			Negate: e.Expression,
+		})
+	case UnaryOpNot:
+		return json.Marshal(struct {
+			Not Expression `json:"not"`
+		}{
+			Not: e.Expression,
+		})
+	default:
+		panic(fmt.Sprintf("unexpected unary operator %d", e.Operator))
+	}
+}
+
 func (k *MemberAccessKind) MarshalJSON() ([]byte, error) {
 	switch *k {
 	case MemberAccessUnknown:
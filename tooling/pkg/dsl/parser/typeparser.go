@@ -38,19 +38,27 @@ type TypeName struct {
 	Pos      lexer.Position
 	Name     string  `parser:"@Ident @('.' Ident)*"`
 	TypeArgs []*Type `parser:"('<' @@ (',' @@)* '>')?"`
+	Unit     *string `parser:"('@' @Ident)?"`
 }
 
 func (n TypeName) String() string {
+	var val string
 	if len(n.TypeArgs) == 0 {
-		return fmt.Sprintf("'%s'", n.Name)
+		val = fmt.Sprintf("'%s'", n.Name)
+	} else {
+		var args []string
+		for _, arg := range n.TypeArgs {
+			args = append(args, arg.String())
+		}
+
+		val = fmt.Sprintf("(Generic '%s' %s)", n.Name, strings.Join(args, " "))
 	}
 
-	var args []string
-	for _, arg := range n.TypeArgs {
-		args = append(args, arg.String())
+	if n.Unit != nil {
+		return fmt.Sprintf("(Unit %s %s)", val, *n.Unit)
 	}
 
-	return fmt.Sprintf("(Generic '%s' %s)", n.Name, strings.Join(args, " "))
+	return val
 }
 
 type TypeTail struct {
@@ -86,6 +94,9 @@ func (t TypeTail) String(target string) string {
 			if dim.Length != nil {
 				args = append(args, fmt.Sprintf("%d", *dim.Length))
 			}
+			if dim.LengthConstant != nil {
+				args = append(args, *dim.LengthConstant)
+			}
 			dims = append(dims, fmt.Sprintf("[%s]", strings.Join(args, " ")))
 		}
 
@@ -104,8 +115,9 @@ type Array struct {
 }
 
 type ArrayDimension struct {
-	Name   *string
-	Length *uint64
+	Name           *string
+	Length         *uint64
+	LengthConstant *string
 }
 
 func (a *ArrayDimension) Parse(lex *lexer.PeekingLexer) error {
@@ -121,11 +133,16 @@ func (a *ArrayDimension) Parse(lex *lexer.PeekingLexer) error {
 		if lex.Peek().Type == ':' {
 			lex.Next()
 
-			l, err := parseUint64(lex)
-			if err != nil {
-				return err
+			if lex.Peek().Type == scanner.Ident {
+				constant := parseQualifiedName(lex)
+				a.LengthConstant = &constant
+			} else {
+				l, err := parseUint64(lex)
+				if err != nil {
+					return err
+				}
+				a.Length = &l
 			}
-			a.Length = &l
 		}
 	} else if lex.Peek().Type == scanner.Int {
 		l, err := parseUint64(lex)
@@ -168,6 +185,18 @@ func ParsePattern(input string) (*Pattern, error) {
 	return patternParser.ParseString("", input)
 }
 
+func parseQualifiedName(lex *lexer.PeekingLexer) string {
+	name := lex.Next().Value
+	for lex.Peek().Type == '.' {
+		lex.Next()
+		if lex.Peek().Type != scanner.Ident {
+			break
+		}
+		name += "." + lex.Next().Value
+	}
+	return name
+}
+
 func parseUint64(lex *lexer.PeekingLexer) (uint64, error) {
 	if lex.Peek().Type != scanner.Int {
 		return 0, &participle.UnexpectedTokenError{
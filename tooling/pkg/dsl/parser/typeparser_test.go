@@ -53,6 +53,14 @@ func TestTypeParsing_Valid(t *testing.T) {
 		{input: "Foo[x,y]", expected: `(Array[[x][y]] 'Foo')`},
 		{input: "Foo[2,3]", expected: `(Array[[2][3]] 'Foo')`},
 		{input: "Foo[x:2,y:3]", expected: `(Array[[x 2][y 3]] 'Foo')`},
+		{input: "Foo[x:N,y:ns.M]", expected: `(Array[[x N][y ns.M]] 'Foo')`},
+
+		{input: "float@mm", expected: `(Unit 'float' mm)`},
+		{input: "float32 @ mm", expected: `(Unit 'float32' mm)`},
+		{input: "float @ mm?", expected: `(Optional (Unit 'float' mm))`},
+		{input: "float @ ms*", expected: `(Vector (Unit 'float' ms))`},
+		{input: "Foo<float @ s>", expected: `(Generic 'Foo' (Unit 'float' s))`},
+		{input: "string->int @ mm", expected: `(Map 'string' (Unit 'int' mm))`},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.input, func(t *testing.T) {
@@ -84,6 +92,8 @@ func TestTypeParsing_Invalid(t *testing.T) {
 		{input: "int[((x:2)]", expected: `unexpected token "]" (expected ")")`},
 		{input: "int[x:4987439128739182743918274]", expected: `integer out of range`},
 		{input: "int[4987439128739182743918274]", expected: `integer out of range`},
+		{input: "int@", expected: `unexpected token "<EOF>" (expected <ident>)`},
+		{input: "int@2", expected: `unexpected token "2" (expected <ident>)`},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.input, func(t *testing.T) {
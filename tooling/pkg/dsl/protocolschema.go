@@ -4,8 +4,13 @@
 package dsl
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
 	"sort"
+	"strings"
 )
 
 type ProtocolSchema struct {
@@ -36,7 +41,10 @@ func GetProtocolSchema(protocol *ProtocolDefinition, symbolTable SymbolTable) *P
 			if rec, ok := t.(*RecordDefinition); ok {
 				clone := *rec
 				clone.ComputedFields = nil
+				// Nor the records it extends, since it already has their fields.
+				clone.Extends = nil
 				t = &clone
+				node = t
 			}
 
 			schema.Types = append(schema.Types, removeComments(t))
@@ -62,21 +70,26 @@ func removeComments[T Node](typeDefinition T) T {
 	return Rewrite(typeDefinition, func(self *Rewriter, node Node) Node {
 		switch t := node.(type) {
 		case *DefinitionMeta:
-			if t.Comment == "" {
+			if t.Comment == "" && t.Annotations == nil {
 				return t
 			}
 
 			clone := *t
 			clone.Comment = ""
+			clone.Annotations = nil
 			return &clone
 
 		case *Field:
-			if t.Comment == "" {
+			// Default values, constraints, and annotations do not affect serialization either
+			if t.Comment == "" && t.Default == nil && t.Constraints == nil && t.Annotations == nil {
 				return self.DefaultRewrite(t)
 			}
 
 			clone := *t
 			clone.Comment = ""
+			clone.Default = nil
+			clone.Constraints = nil
+			clone.Annotations = nil
 			return self.DefaultRewrite(&clone)
 		case *ProtocolStep:
 			if t.Comment == "" {
@@ -116,3 +129,394 @@ func GetProtocolSchemaString(protocol *ProtocolDefinition, symbolTable SymbolTab
 	}
 	return string(bytes)
 }
+
+// ParseProtocolSchema reconstructs a protocol definition and the types it
+// references from the JSON protocol schema that is embedded in the binary, NDJSON,
+// and HDF5 encodings. The definitions are validated and resolved so that they can be
+// used to read and write values at runtime without the original model.
+//
+// The schema does not retain comments, computed fields, or whether an enum was
+// declared as !flags, so all enums are returned with IsFlags set to false.
+func ParseProtocolSchema(schema []byte) (*ProtocolDefinition, *Environment, error) {
+	var parsed struct {
+		Protocol struct {
+			Name     string            `json:"name"`
+			Sequence []jsonSchemaField `json:"sequence"`
+		} `json:"protocol"`
+		Types []map[string]json.RawMessage `json:"types"`
+	}
+
+	if err := json.Unmarshal(schema, &parsed); err != nil {
+		return nil, nil, fmt.Errorf("invalid protocol schema: %w", err)
+	}
+
+	if parsed.Protocol.Name == "" {
+		return nil, nil, errors.New("invalid protocol schema: the protocol name is missing")
+	}
+
+	protocol := &ProtocolDefinition{DefinitionMeta: &DefinitionMeta{Name: parsed.Protocol.Name}}
+	for _, step := range parsed.Protocol.Sequence {
+		t, err := parseSchemaType(step.Type)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid protocol schema: step '%s': %w", step.Name, err)
+		}
+		protocol.Sequence = append(protocol.Sequence, &ProtocolStep{Name: step.Name, Type: t})
+	}
+
+	var typeDefinitions []TypeDefinition
+	for _, rawType := range parsed.Types {
+		td, err := parseSchemaTypeDefinition(rawType)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid protocol schema: %w", err)
+		}
+		typeDefinitions = append(typeDefinitions, td)
+	}
+
+	// Type definitions are not qualified with their namespace in the schema,
+	// but references to them are. Definitions sharing the same name appear in the
+	// schema sorted by qualified name.
+	namespacesByTypeName := make(map[string][]string)
+	var protocolNamespace *string
+	collectNamespaces := func(self Visitor, node Node) {
+		if t, ok := node.(*SimpleType); ok {
+			if i := strings.LastIndex(t.Name, "."); i >= 0 {
+				namespace, name := t.Name[:i], t.Name[i+1:]
+				if !slices.Contains(namespacesByTypeName[name], namespace) {
+					namespacesByTypeName[name] = append(namespacesByTypeName[name], namespace)
+				}
+				if protocolNamespace == nil {
+					protocolNamespace = &namespace
+				}
+			}
+		}
+		self.VisitChildren(node)
+	}
+
+	Visit(protocol, collectNamespaces)
+	for _, td := range typeDefinitions {
+		Visit(td, collectNamespaces)
+	}
+	for name, namespaces := range namespacesByTypeName {
+		sort.Slice(namespaces, func(i, j int) bool {
+			return namespaces[i]+"."+name < namespaces[j]+"."+name
+		})
+	}
+
+	namespacesByName := make(map[string]*Namespace)
+	var namespaces []*Namespace
+	getNamespace := func(name string) *Namespace {
+		ns, ok := namespacesByName[name]
+		if !ok {
+			ns = &Namespace{Name: name}
+			namespacesByName[name] = ns
+			namespaces = append(namespaces, ns)
+		}
+		return ns
+	}
+
+	definitionCounts := make(map[string]int)
+	for _, td := range typeDefinitions {
+		name := td.GetDefinitionMeta().Name
+		index := definitionCounts[name]
+		definitionCounts[name]++
+		if index >= len(namespacesByTypeName[name]) {
+			return nil, nil, fmt.Errorf("invalid protocol schema: unable to determine the namespace of type '%s'", name)
+		}
+		ns := getNamespace(namespacesByTypeName[name][index])
+		ns.TypeDefinitions = append(ns.TypeDefinitions, td)
+	}
+
+	// The protocol's namespace is not recorded in the schema.
+	// We place it in the namespace of the first type it references, and make
+	// that namespace the top-level namespace.
+	if protocolNamespace == nil {
+		empty := ""
+		protocolNamespace = &empty
+	}
+	topLevelNamespace := getNamespace(*protocolNamespace)
+	topLevelNamespace.IsTopLevel = true
+	topLevelNamespace.Protocols = append(topLevelNamespace.Protocols, protocol)
+	for i, ns := range namespaces {
+		if ns == topLevelNamespace {
+			namespaces = append(append(namespaces[:i:i], namespaces[i+1:]...), ns)
+			break
+		}
+	}
+	for _, ns := range namespaces {
+		if ns != topLevelNamespace {
+			topLevelNamespace.References = append(topLevelNamespace.References, ns)
+		}
+	}
+
+	env, err := Validate(namespaces)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid protocol schema: %w", err)
+	}
+
+	return protocol, env, nil
+}
+
+type jsonSchemaField struct {
+	Name string          `json:"name"`
+	Type json.RawMessage `json:"type"`
+}
+
+func parseSchemaTypeDefinition(raw map[string]json.RawMessage) (TypeDefinition, error) {
+	meta := &DefinitionMeta{}
+	if err := json.Unmarshal(raw["name"], &meta.Name); err != nil {
+		return nil, errors.New("type definition name is missing")
+	}
+
+	if rawTypeParameters, ok := raw["typeParameters"]; ok {
+		var typeParameters []string
+		if err := json.Unmarshal(rawTypeParameters, &typeParameters); err != nil {
+			return nil, fmt.Errorf("type '%s': invalid type parameters", meta.Name)
+		}
+		for _, tp := range typeParameters {
+			meta.TypeParameters = append(meta.TypeParameters, &GenericTypeParameter{Name: tp})
+		}
+	}
+
+	if rawFields, ok := raw["fields"]; ok {
+		var fields []jsonSchemaField
+		if err := json.Unmarshal(rawFields, &fields); err != nil {
+			return nil, fmt.Errorf("record '%s': invalid fields", meta.Name)
+		}
+
+		rec := &RecordDefinition{DefinitionMeta: meta, Fields: Fields{}}
+		for _, f := range fields {
+			t, err := parseSchemaType(f.Type)
+			if err != nil {
+				return nil, fmt.Errorf("record '%s', field '%s': %w", meta.Name, f.Name, err)
+			}
+			rec.Fields = append(rec.Fields, &Field{Name: f.Name, Type: t})
+		}
+		return rec, nil
+	}
+
+	if rawValues, ok := raw["values"]; ok {
+		var values []struct {
+			Symbol string      `json:"symbol"`
+			Value  json.Number `json:"value"`
+		}
+		if err := json.Unmarshal(rawValues, &values); err != nil {
+			return nil, fmt.Errorf("enum '%s': invalid values", meta.Name)
+		}
+
+		enum := &EnumDefinition{DefinitionMeta: meta, Values: EnumValues{}}
+		for _, v := range values {
+			ev := &EnumValue{Symbol: v.Symbol}
+			if _, ok := ev.IntegerValue.SetString(v.Value.String(), 10); !ok {
+				return nil, fmt.Errorf("enum '%s': invalid value for symbol '%s'", meta.Name, v.Symbol)
+			}
+			enum.Values = append(enum.Values, ev)
+		}
+
+		if rawBase, ok := raw["base"]; ok {
+			baseType, err := parseSchemaType(rawBase)
+			if err != nil {
+				return nil, fmt.Errorf("enum '%s': %w", meta.Name, err)
+			}
+			enum.BaseType = baseType
+		}
+		return enum, nil
+	}
+
+	if rawType, ok := raw["type"]; ok {
+		t, err := parseSchemaType(rawType)
+		if err != nil {
+			return nil, fmt.Errorf("alias '%s': %w", meta.Name, err)
+		}
+		return &NamedType{DefinitionMeta: meta, Type: t}, nil
+	}
+
+	return nil, fmt.Errorf("unrecognized definition of type '%s'", meta.Name)
+}
+
+func parseSchemaType(raw json.RawMessage) (Type, error) {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 {
+		return nil, errors.New("type is missing")
+	}
+
+	switch raw[0] {
+	case '"':
+		var name string
+		if err := json.Unmarshal(raw, &name); err != nil {
+			return nil, err
+		}
+		return &SimpleType{Name: name}, nil
+
+	case '[':
+		cases, err := parseSchemaTypeCases(raw)
+		if err != nil {
+			return nil, err
+		}
+		return &GeneralizedType{Cases: cases}, nil
+
+	case '{':
+		var obj map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &obj); err != nil {
+			return nil, err
+		}
+
+		if rawName, ok := obj["name"]; ok {
+			t := &SimpleType{}
+			if err := json.Unmarshal(rawName, &t.Name); err != nil {
+				return nil, err
+			}
+			if rawUnit, ok := obj["unit"]; ok {
+				if err := json.Unmarshal(rawUnit, &t.Unit); err != nil {
+					return nil, fmt.Errorf("type '%s': invalid unit", t.Name)
+				}
+				if _, hasTypeArguments := obj["typeArguments"]; !hasTypeArguments {
+					return t, nil
+				}
+			}
+			var typeArguments []json.RawMessage
+			if err := json.Unmarshal(obj["typeArguments"], &typeArguments); err != nil {
+				return nil, fmt.Errorf("type '%s': invalid type arguments", t.Name)
+			}
+			for _, rawArg := range typeArguments {
+				arg, err := parseSchemaType(rawArg)
+				if err != nil {
+					return nil, err
+				}
+				t.TypeArguments = append(t.TypeArguments, arg)
+			}
+			return t, nil
+		}
+
+		if rawVector, ok := obj["vector"]; ok {
+			var vector struct {
+				Items  json.RawMessage `json:"items"`
+				Length *uint64         `json:"length"`
+			}
+			if err := json.Unmarshal(rawVector, &vector); err != nil {
+				return nil, fmt.Errorf("invalid vector: %w", err)
+			}
+			cases, err := parseSchemaTypeCases(vector.Items)
+			if err != nil {
+				return nil, err
+			}
+			return &GeneralizedType{Cases: cases, Dimensionality: &Vector{Length: vector.Length}}, nil
+		}
+
+		if rawArray, ok := obj["array"]; ok {
+			var array struct {
+				Items      json.RawMessage `json:"items"`
+				Dimensions json.RawMessage `json:"dimensions"`
+			}
+			if err := json.Unmarshal(rawArray, &array); err != nil {
+				return nil, fmt.Errorf("invalid array: %w", err)
+			}
+			cases, err := parseSchemaTypeCases(array.Items)
+			if err != nil {
+				return nil, err
+			}
+
+			arr := &Array{}
+			if len(array.Dimensions) > 0 {
+				dims := ArrayDimensions{}
+				var count int
+				if err := json.Unmarshal(array.Dimensions, &count); err == nil {
+					for i := 0; i < count; i++ {
+						dims = append(dims, &ArrayDimension{})
+					}
+				} else if err := json.Unmarshal(array.Dimensions, &dims); err != nil {
+					return nil, fmt.Errorf("invalid array dimensions: %w", err)
+				}
+				arr.Dimensions = &dims
+			}
+			return &GeneralizedType{Cases: cases, Dimensionality: arr}, nil
+		}
+
+		if rawMap, ok := obj["map"]; ok {
+			var m struct {
+				Keys   json.RawMessage `json:"keys"`
+				Values json.RawMessage `json:"values"`
+			}
+			if err := json.Unmarshal(rawMap, &m); err != nil {
+				return nil, fmt.Errorf("invalid map: %w", err)
+			}
+			keyType, err := parseSchemaType(m.Keys)
+			if err != nil {
+				return nil, err
+			}
+			cases, err := parseSchemaTypeCases(m.Values)
+			if err != nil {
+				return nil, err
+			}
+			return &GeneralizedType{Cases: cases, Dimensionality: &Map{KeyType: keyType}}, nil
+		}
+
+		if rawStream, ok := obj["stream"]; ok {
+			var stream struct {
+				Items json.RawMessage `json:"items"`
+			}
+			if err := json.Unmarshal(rawStream, &stream); err != nil {
+				return nil, fmt.Errorf("invalid stream: %w", err)
+			}
+			cases, err := parseSchemaTypeCases(stream.Items)
+			if err != nil {
+				return nil, err
+			}
+			return &GeneralizedType{Cases: cases, Dimensionality: &Stream{}}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unrecognized type %s", string(raw))
+}
+
+func parseSchemaTypeCases(raw json.RawMessage) (TypeCases, error) {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 || raw[0] != '[' {
+		t, err := parseSchemaType(raw)
+		if err != nil {
+			return nil, err
+		}
+		return TypeCases{{Type: t}}, nil
+	}
+
+	var rawCases []json.RawMessage
+	if err := json.Unmarshal(raw, &rawCases); err != nil {
+		return nil, err
+	}
+
+	cases := make(TypeCases, 0, len(rawCases))
+	for _, rawCase := range rawCases {
+		rawCase = bytes.TrimSpace(rawCase)
+		if string(rawCase) == "null" {
+			cases = append(cases, &TypeCase{})
+			continue
+		}
+
+		var expanded struct {
+			Tag         string          `json:"tag"`
+			ExplicitTag bool            `json:"explicitTag"`
+			Type        json.RawMessage `json:"type"`
+		}
+		if rawCase[0] == '{' {
+			if err := json.Unmarshal(rawCase, &expanded); err != nil {
+				return nil, err
+			}
+		}
+
+		if expanded.Type == nil {
+			t, err := parseSchemaType(rawCase)
+			if err != nil {
+				return nil, err
+			}
+			cases = append(cases, &TypeCase{Type: t})
+			continue
+		}
+
+		t, err := parseSchemaType(expanded.Type)
+		if err != nil {
+			return nil, err
+		}
+		cases = append(cases, &TypeCase{Tag: expanded.Tag, ExplicitTag: expanded.ExplicitTag, Type: t})
+	}
+
+	return cases, nil
+}
@@ -0,0 +1,104 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+package dsl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseProtocolSchemaRoundTrip(t *testing.T) {
+	src := `
+P: !protocol
+  sequence:
+    header: Header
+    samples: !stream
+      items: Sample<float>
+    labels: string->int
+    image: Image<int>
+    fixed: float[2, 3]
+    dynamic: double[]
+    knownRank: complexfloat[x, y]
+    choice: [null, int, string, DoubleSample]
+    explicit: !union
+      first: int
+      second: string
+    fruit: Fruit
+    flags: Flags
+    optionalVector: int*?
+    distances: float @ mm*
+    timed: Sample<double @ s>
+
+Header: !record
+  fields:
+    name: string
+    created: datetime
+    day: date?
+    time: time
+    meta: [int, Header2]
+
+Header2: !record
+  fields:
+    values: !vector
+      items: size
+      length: 3
+
+Sample<T>: !record
+  fields:
+    value: T
+    values: T*
+
+Image<T>: T[x, y]
+
+DoubleSample: Sample<double>
+
+Fruit: !enum
+  base: uint8
+  values:
+    - apple
+    - banana
+    - pear
+
+Flags: !flags
+  values:
+    - a
+    - b
+`
+
+	env, err := parseAndValidate(t, src)
+	require.NoError(t, err)
+
+	protocol := env.GetTopLevelNamespace().Protocols[0]
+	expected := GetProtocolSchemaString(protocol, env.SymbolTable)
+
+	parsedProtocol, parsedEnv, err := ParseProtocolSchema([]byte(expected))
+	require.NoError(t, err)
+
+	assert.Equal(t, "P", parsedProtocol.Name)
+	assert.Len(t, parsedProtocol.Sequence, len(protocol.Sequence))
+	assert.Equal(t, expected, GetProtocolSchemaString(parsedProtocol, parsedEnv.SymbolTable))
+	assert.Contains(t, expected, `{"name":"float32","unit":"mm"}`)
+
+	header := parsedEnv.SymbolTable["test.Header"]
+	require.IsType(t, &RecordDefinition{}, header)
+	assert.Len(t, header.(*RecordDefinition).Fields, 5)
+
+	fruit := parsedEnv.SymbolTable["test.Fruit"]
+	require.IsType(t, &EnumDefinition{}, fruit)
+	assert.Equal(t, "pear", fruit.(*EnumDefinition).Values[2].Symbol)
+	assert.Equal(t, int64(2), fruit.(*EnumDefinition).Values[2].IntegerValue.Int64())
+}
+
+func TestParseProtocolSchemaInvalid(t *testing.T) {
+	_, _, err := ParseProtocolSchema([]byte(`{"protocol":{"sequence":[]},"types":[]}`))
+	assert.ErrorContains(t, err, "protocol name is missing")
+
+	_, _, err = ParseProtocolSchema([]byte(`{"protocol":{"name":"P","sequence":[{"name":"a","type":"test.Missing"}]},"types":[]}`))
+	assert.ErrorContains(t, err, "not recognized")
+
+	_, _, err = ParseProtocolSchema([]byte(`{"protocol":{"name":"P","sequence":[{"name":"a","type":{"tensor":{}}}]},"types":[]}`))
+	assert.ErrorContains(t, err, "unrecognized type")
+}
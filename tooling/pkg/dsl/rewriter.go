@@ -112,8 +112,9 @@ func defaultRewriteImpl[T any](rewriter *RewriterWithContext[T], node Node, cont
 	case *Namespace:
 		rewrittenTypes := rewriteInterfaceSlice(t.TypeDefinitions, context, rewriter)
 		rewrittenProtocols := rewriteSlice(t.Protocols, context, rewriter)
+		rewrittenConstants := rewriteSlice(t.Constants, context, rewriter)
 
-		if rewrittenTypes == nil && rewrittenProtocols == nil {
+		if rewrittenTypes == nil && rewrittenProtocols == nil && rewrittenConstants == nil {
 			return t
 		}
 
@@ -124,21 +125,28 @@ func defaultRewriteImpl[T any](rewriter *RewriterWithContext[T], node Node, cont
 		if rewrittenProtocols != nil {
 			rewrittenNamespace.Protocols = rewrittenProtocols
 		}
+		if rewrittenConstants != nil {
+			rewrittenNamespace.Constants = rewrittenConstants
+		}
 
 		return &rewrittenNamespace
 	case *DefinitionMeta:
 		return t
 	case *RecordDefinition:
 		rewrittenDimensionMeta := rewriter.Rewrite(t.DefinitionMeta, context)
+		rewrittenExtends := rewriteInterfaceSlice(t.Extends, context, rewriter)
 		rewrittenFields := rewriteSlice(t.Fields, context, rewriter)
 		rewrittenComputedFields := rewriteSlice(t.ComputedFields, context, rewriter)
 
-		if rewrittenDimensionMeta == t.DefinitionMeta && rewrittenFields == nil && rewrittenComputedFields == nil {
+		if rewrittenDimensionMeta == t.DefinitionMeta && rewrittenExtends == nil && rewrittenFields == nil && rewrittenComputedFields == nil {
 			return t
 		}
 
 		rewrittenRecord := *t
 		rewrittenRecord.DefinitionMeta = rewrittenDimensionMeta.(*DefinitionMeta)
+		if rewrittenExtends != nil {
+			rewrittenRecord.Extends = rewrittenExtends
+		}
 		if rewrittenFields != nil {
 			rewrittenRecord.Fields = rewrittenFields
 		}
@@ -219,14 +227,64 @@ func defaultRewriteImpl[T any](rewriter *RewriterWithContext[T], node Node, cont
 			rewrittenProtocol.Sequence = rewrittenSteps
 		}
 		return &rewrittenProtocol
+	case *ConstantDefinition:
+		rewrittenDimensionMeta := rewriter.Rewrite(t.DefinitionMeta, context)
+		var rewrittenType Node
+		if t.Type != nil {
+			rewrittenType = rewriter.Rewrite(t.Type, context)
+		}
+		rewrittenValue := rewriter.Rewrite(t.Value, context)
+
+		if t.DefinitionMeta == rewrittenDimensionMeta && rewrittenType == Node(t.Type) && rewrittenValue == Node(t.Value) {
+			return t
+		}
+
+		rewrittenConstant := *t
+		rewrittenConstant.DefinitionMeta = rewrittenDimensionMeta.(*DefinitionMeta)
+		if rewrittenType != nil {
+			rewrittenConstant.Type = rewrittenType.(Type)
+		}
+		rewrittenConstant.Value = rewrittenValue.(Expression)
+		return &rewrittenConstant
 	case *Field:
 		rewrittenType := rewriter.Rewrite(t.Type, context)
-		if rewrittenType == t.Type {
+		var rewrittenDefault Node
+		if t.Default != nil {
+			rewrittenDefault = rewriter.Rewrite(t.Default, context)
+		}
+		rewrittenConstraints := t.Constraints
+		if t.Constraints != nil {
+			rewrittenConstraints = rewriter.Rewrite(t.Constraints, context).(*FieldConstraints)
+		}
+		if rewrittenType == t.Type && rewrittenDefault == Node(t.Default) && rewrittenConstraints == t.Constraints {
 			return t
 		}
 		rewrittenField := *t
 		rewrittenField.Type = rewrittenType.(Type)
+		if rewrittenDefault != nil {
+			rewrittenField.Default = rewrittenDefault.(Expression)
+		}
+		rewrittenField.Constraints = rewrittenConstraints
 		return &rewrittenField
+	case *FieldConstraints:
+		var rewrittenMin, rewrittenMax Node
+		if t.Min != nil {
+			rewrittenMin = rewriter.Rewrite(t.Min, context)
+		}
+		if t.Max != nil {
+			rewrittenMax = rewriter.Rewrite(t.Max, context)
+		}
+		if rewrittenMin == Node(t.Min) && rewrittenMax == Node(t.Max) {
+			return t
+		}
+		rewrittenConstraints := *t
+		if rewrittenMin != nil {
+			rewrittenConstraints.Min = rewrittenMin.(Expression)
+		}
+		if rewrittenMax != nil {
+			rewrittenConstraints.Max = rewrittenMax.(Expression)
+		}
+		return &rewrittenConstraints
 	case *ProtocolStep:
 		rewrittenType := rewriter.Rewrite(t.Type, context)
 		if rewrittenType == t.Type {
@@ -309,12 +367,32 @@ func defaultRewriteImpl[T any](rewriter *RewriterWithContext[T], node Node, cont
 		rewrittenExpression.Left = rewrittenLeft.(Expression)
 		rewrittenExpression.Right = rewrittenRight.(Expression)
 		return &rewrittenExpression
+	case *ConditionalExpression:
+		rewrittenCondition := rewriter.Rewrite(t.Condition, context)
+		rewrittenThen := rewriter.Rewrite(t.Then, context)
+		rewrittenElse := rewriter.Rewrite(t.Else, context)
+
+		if rewrittenCondition == t.Condition && rewrittenThen == t.Then && rewrittenElse == t.Else {
+			return t
+		}
+
+		rewrittenExpression := *t
+		rewrittenExpression.Condition = rewrittenCondition.(Expression)
+		rewrittenExpression.Then = rewrittenThen.(Expression)
+		rewrittenExpression.Else = rewrittenElse.(Expression)
+		return &rewrittenExpression
 	case *IntegerLiteralExpression:
 		return t
 	case *FloatingPointLiteralExpression:
 		return t
 	case *StringLiteralExpression:
 		return t
+	case *BooleanLiteralExpression:
+		return t
+	case *EnumValueExpression:
+		return t
+	case *ConstantReferenceExpression:
+		return t
 	case *MemberAccessExpression:
 		if t.Target == nil {
 			return t
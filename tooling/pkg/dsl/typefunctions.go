@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -42,7 +43,7 @@ func MakeGenericType(genericTypeDefinition TypeDefinition, typeArguments []Type,
 			newMeta.TypeArguments = typeArguments
 			return &newMeta
 		case *SimpleType:
-			if shallow {
+			if shallow || t.IsRecursiveReference {
 				return t
 			}
 			if targetParam, ok := t.ResolvedDefinition.(*GenericTypeParameter); ok {
@@ -54,12 +55,16 @@ func MakeGenericType(genericTypeDefinition TypeDefinition, typeArguments []Type,
 				errorSink.Add(validationError(t, "internal error: unable to substitute generic type parameter"))
 			}
 
+			rewrittenTypeArgs := rewriteInterfaceSlice[any, Type](t.TypeArguments, nil, &self.rewriterWithContext)
 			rewrittenResolvedType := self.Rewrite(t.ResolvedDefinition)
-			if rewrittenResolvedType == t.ResolvedDefinition {
+			if rewrittenResolvedType == t.ResolvedDefinition && rewrittenTypeArgs == nil {
 				return t
 			}
 			newType := *t
 			newType.ResolvedDefinition = rewrittenResolvedType.(TypeDefinition)
+			if rewrittenTypeArgs != nil {
+				newType.TypeArguments = rewrittenTypeArgs
+			}
 			return &newType
 		case *GenericTypeParameter:
 			return t
@@ -99,6 +104,10 @@ func NormalizeGenericTypeParameters(t Type) Type {
 			rewritten.DefinitionMeta = meta.(*DefinitionMeta)
 			return &rewritten
 		case *SimpleType:
+			if node.IsRecursiveReference {
+				// A recursive record is not generic
+				return node
+			}
 			defaultRewritten := self.DefaultRewrite(node)
 			rewrittenResolved := self.Rewrite(node.ResolvedDefinition)
 			if defaultRewritten == node && rewrittenResolved == node.ResolvedDefinition {
@@ -174,7 +183,7 @@ func TypeDefinitionsEqual(a, b TypeDefinition) bool {
 
 		for i, fa := range ta.Fields {
 			fb := tb.Fields[i]
-			if fa.Name != fb.Name || !TypesEqual(fa.Type, fb.Type) {
+			if fa.Name != fb.Name || !TypesEqual(fa.Type, fb.Type) || !ExpressionsEqual(fa.Default, fb.Default) || !FieldConstraintsEqual(fa.Constraints, fb.Constraints) {
 				return false
 			}
 		}
@@ -381,6 +390,54 @@ func ExpressionsEqual(a, b Expression) bool {
 			return false
 		}
 		return TypesEqual(ta.ResolvedType, tb.ResolvedType) && ta.Value == tb.Value
+	case *FloatingPointLiteralExpression:
+		tb, ok := b.(*FloatingPointLiteralExpression)
+		if !ok {
+			return false
+		}
+		return TypesEqual(ta.ResolvedType, tb.ResolvedType) && ta.Value == tb.Value
+	case *BooleanLiteralExpression:
+		tb, ok := b.(*BooleanLiteralExpression)
+		if !ok {
+			return false
+		}
+		return ta.Value == tb.Value
+	case *EnumValueExpression:
+		tb, ok := b.(*EnumValueExpression)
+		if !ok {
+			return false
+		}
+		return TypesEqual(ta.ResolvedType, tb.ResolvedType) && ta.Value.Symbol == tb.Value.Symbol
+	case *ConstantReferenceExpression:
+		tb, ok := b.(*ConstantReferenceExpression)
+		if !ok {
+			return false
+		}
+		return ta.Definition.GetQualifiedName() == tb.Definition.GetQualifiedName()
+	case *UnaryExpression:
+		tb, ok := b.(*UnaryExpression)
+		if !ok {
+			return false
+		}
+		return ta.Operator == tb.Operator && ExpressionsEqual(ta.Expression, tb.Expression)
+	case *BinaryExpression:
+		tb, ok := b.(*BinaryExpression)
+		if !ok {
+			return false
+		}
+		return ta.Operator == tb.Operator && ExpressionsEqual(ta.Left, tb.Left) && ExpressionsEqual(ta.Right, tb.Right)
+	case *ConditionalExpression:
+		tb, ok := b.(*ConditionalExpression)
+		if !ok {
+			return false
+		}
+		return ExpressionsEqual(ta.Condition, tb.Condition) && ExpressionsEqual(ta.Then, tb.Then) && ExpressionsEqual(ta.Else, tb.Else)
+	case *TypeConversionExpression:
+		tb, ok := b.(*TypeConversionExpression)
+		if !ok {
+			return false
+		}
+		return TypesEqual(ta.Type, tb.Type) && ExpressionsEqual(ta.Expression, tb.Expression)
 	case *MemberAccessExpression:
 		tb, ok := b.(*MemberAccessExpression)
 		if !ok {
@@ -446,6 +503,25 @@ func ExpressionsEqual(a, b Expression) bool {
 	}
 }
 
+func FieldConstraintsEqual(a, b *FieldConstraints) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	lengthsEqual := func(a, b *uint64) bool {
+		if a == nil || b == nil {
+			return a == b
+		}
+		return *a == *b
+	}
+
+	return ExpressionsEqual(a.Min, b.Min) &&
+		ExpressionsEqual(a.Max, b.Max) &&
+		a.Pattern == b.Pattern &&
+		lengthsEqual(a.MinLength, b.MinLength) &&
+		lengthsEqual(a.MaxLength, b.MaxLength)
+}
+
 func PatternsEqual(a, b Pattern) bool {
 	if a == b {
 		return true
@@ -493,6 +569,25 @@ func ToGeneralizedType(t Type) *GeneralizedType {
 	}
 }
 
+// Returns the unit of measure of a type, following type aliases, or an empty
+// string if the type has no unit.
+func GetUnit(t Type) string {
+	st, ok := t.(*SimpleType)
+	if !ok {
+		return ""
+	}
+
+	if st.Unit != "" {
+		return st.Unit
+	}
+
+	if namedType, ok := st.ResolvedDefinition.(*NamedType); ok {
+		return GetUnit(namedType.Type)
+	}
+
+	return ""
+}
+
 func GetUnderlyingType(t Type) Type {
 	underlyingTypeFromTypeDefinition := func(t TypeDefinition) Type {
 		switch t := t.(type) {
@@ -565,7 +660,7 @@ func GetPrimitiveKind(t PrimitiveDefinition) PrimitiveKind {
 	switch t {
 	case Int8, Int16, Int32, Int64, Uint8, Uint16, Uint32, Uint64, Size:
 		return PrimitiveKindInteger
-	case Float32, Float64:
+	case Float16, BFloat16, Float32, Float64:
 		return PrimitiveKindFloatingPoint
 	case ComplexFloat32, ComplexFloat64:
 		return PrimitiveKindComplexFloatingPoint
@@ -578,7 +673,7 @@ func GetPrimitiveWidth(t PrimitiveDefinition) int {
 	switch t {
 	case Int8, Uint8:
 		return 8
-	case Int16, Uint16:
+	case Int16, Uint16, Float16, BFloat16:
 		return 16
 	case Int32, Uint32, Float32, ComplexFloat32:
 		return 32
@@ -667,11 +762,17 @@ var (
 	Uint64Type         = &SimpleType{Name: Uint64, ResolvedDefinition: PrimitiveUint64}
 	SizeType           = &SimpleType{Name: Size, ResolvedDefinition: PrimitiveSize}
 	BoolType           = &SimpleType{Name: Bool, ResolvedDefinition: PrimitiveBool}
+	Float16Type        = &SimpleType{Name: Float16, ResolvedDefinition: PrimitiveFloat16}
+	BFloat16Type       = &SimpleType{Name: BFloat16, ResolvedDefinition: PrimitiveBFloat16}
 	Float32Type        = &SimpleType{Name: Float32, ResolvedDefinition: PrimitiveFloat32}
 	Float64Type        = &SimpleType{Name: Float64, ResolvedDefinition: PrimitiveFloat64}
 	ComplexFloat32Type = &SimpleType{Name: ComplexFloat32, ResolvedDefinition: PrimitiveComplexFloat32}
 	ComplexFloat64Type = &SimpleType{Name: ComplexFloat64, ResolvedDefinition: PrimitiveComplexFloat64}
 	StringType         = &SimpleType{Name: String, ResolvedDefinition: PrimitiveString}
+	BytesType          = &SimpleType{Name: Bytes, ResolvedDefinition: PrimitiveBytes}
+	UuidType           = &SimpleType{Name: Uuid, ResolvedDefinition: PrimitiveUuid}
+	DateTimeType       = &SimpleType{Name: DateTime, ResolvedDefinition: PrimitiveDateTime}
+	DurationType       = &SimpleType{Name: Duration, ResolvedDefinition: PrimitiveDuration}
 )
 
 var commonTypeMap = func() map[primitivePair]PrimitiveDefinition {
@@ -748,6 +849,36 @@ var commonTypeMap = func() map[primitivePair]PrimitiveDefinition {
 
 		{Float32, Float64, Float64},
 		{ComplexFloat32, ComplexFloat64, ComplexFloat64},
+
+		// The half-precision types cannot represent the range of most
+		// integer types, so they are promoted to at least float32.
+		{Int8, Float16, Float32},
+		{Int16, Float16, Float32},
+		{Int32, Float16, Float32},
+		{Uint8, Float16, Float32},
+		{Uint16, Float16, Float32},
+		{Uint32, Float16, Float32},
+		{Uint64, Float16, Float32},
+		{Size, Float16, Float32},
+		{Float16, Float32, Float32},
+		{Float16, Float64, Float64},
+		{Float16, ComplexFloat32, ComplexFloat32},
+		{Float16, ComplexFloat64, ComplexFloat64},
+
+		{Int8, BFloat16, Float32},
+		{Int16, BFloat16, Float32},
+		{Int32, BFloat16, Float32},
+		{Uint8, BFloat16, Float32},
+		{Uint16, BFloat16, Float32},
+		{Uint32, BFloat16, Float32},
+		{Uint64, BFloat16, Float32},
+		{Size, BFloat16, Float32},
+		{BFloat16, Float32, Float32},
+		{BFloat16, Float64, Float64},
+		{BFloat16, ComplexFloat32, ComplexFloat32},
+		{BFloat16, ComplexFloat64, ComplexFloat64},
+
+		{Float16, BFloat16, Float32},
 	}
 
 	m := make(map[primitivePair]PrimitiveDefinition)
@@ -787,6 +918,137 @@ func GetCommonType(a, b Type) (Type, error) {
 	return nil, ErrNoCommonType
 }
 
+// Returns the records that a record extends, with their type arguments applied.
+func GetExtendedRecords(rec *RecordDefinition) []*RecordDefinition {
+	records := make([]*RecordDefinition, len(rec.Extends))
+	for i, t := range rec.Extends {
+		records[i] = t.(*SimpleType).ResolvedDefinition.(*RecordDefinition)
+	}
+	return records
+}
+
+// Returns true if the field or computed field with the given name is inherited
+// from one of the records that a record extends.
+func IsInheritedMember(rec *RecordDefinition, name string) bool {
+	for _, base := range GetExtendedRecords(rec) {
+		for _, f := range base.Fields {
+			if f.Name == name {
+				return true
+			}
+		}
+		for _, f := range base.ComputedFields {
+			if f.Name == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Returns the names of the annotations in sorted order.
+func (a Annotations) Names() []string {
+	names := make([]string, 0, len(a))
+	for name := range a {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Formats the annotations as a comma-separated list of name=value pairs,
+// sorted by name, with string values quoted.
+func (a Annotations) String() string {
+	pairs := make([]string, 0, len(a))
+	for _, name := range a.Names() {
+		var value string
+		switch v := a[name].(type) {
+		case string:
+			value = strconv.Quote(v)
+		case float64:
+			value = strconv.FormatFloat(v, 'g', -1, 64)
+			if !strings.ContainsAny(value, ".eInN") {
+				value += ".0"
+			}
+		default:
+			value = fmt.Sprintf("%v", v)
+		}
+		pairs = append(pairs, fmt.Sprintf("%s=%s", name, value))
+	}
+	return strings.Join(pairs, ", ")
+}
+
+// Returns a comment followed by a line listing the annotations, if there are any,
+// for generators that render annotations as comments.
+func AnnotatedComment(comment string, annotations Annotations) string {
+	if len(annotations) == 0 {
+		return comment
+	}
+
+	annotationsLine := "Annotations: " + annotations.String()
+	comment = strings.TrimSpace(comment)
+	if comment == "" {
+		return annotationsLine
+	}
+	return comment + "\n" + annotationsLine
+}
+
+// Returns the type that the constraints of a field apply to. This is the type
+// of the field, or the type of its value if the field is optional.
+func GetConstrainedType(field *Field) (t Type, optional bool) {
+	if gt, ok := GetUnderlyingType(field.Type).(*GeneralizedType); ok && gt.Dimensionality == nil && gt.Cases.IsOptional() {
+		return gt.Cases[1].Type, true
+	}
+
+	return field.Type, false
+}
+
+// Returns true if values of the type need to be validated, because it is a record
+// with field constraints, or refers to one through record fields, optionals, or
+// vectors. Generic records are considered as declared, without their type
+// arguments.
+func TypeHasConstraints(t Type, symbolTable SymbolTable) bool {
+	return typeHasConstraints(t, symbolTable, make(map[string]bool))
+}
+
+func typeHasConstraints(t Type, symbolTable SymbolTable, visited map[string]bool) bool {
+	switch t := t.(type) {
+	case *SimpleType:
+		switch td := t.ResolvedDefinition.(type) {
+		case *NamedType:
+			return typeHasConstraints(td.Type, symbolTable, visited)
+		case *RecordDefinition:
+			name := td.GetQualifiedName()
+			if hasConstraints, ok := visited[name]; ok {
+				return hasConstraints
+			}
+
+			if declared, ok := symbolTable[name].(*RecordDefinition); ok {
+				td = declared
+			}
+
+			visited[name] = false
+			for _, field := range td.Fields {
+				if field.Constraints != nil || typeHasConstraints(field.Type, symbolTable, visited) {
+					visited[name] = true
+					return true
+				}
+			}
+		}
+	case *GeneralizedType:
+		switch t.Dimensionality.(type) {
+		case nil, *Vector:
+			if t.Cases.IsSingle() {
+				return typeHasConstraints(t.Cases[0].Type, symbolTable, visited)
+			}
+			if t.Cases.IsOptional() {
+				return typeHasConstraints(t.Cases[1].Type, symbolTable, visited)
+			}
+		}
+	}
+
+	return false
+}
+
 // Returns true if the type is Optional
 func TypeHasNullOption(node Type) bool {
 	hasNull := false
@@ -808,6 +1070,35 @@ func TypeHasNullOption(node Type) bool {
 	return hasNull
 }
 
+// Returns true if the type refers to a recursive record, directly or through
+// other types.
+func TypeContainsRecursiveRecord(node Type) bool {
+	contains := false
+	visited := make(map[TypeDefinition]bool)
+	Visit(node, func(self Visitor, node Node) {
+		switch node := node.(type) {
+		case *RecordDefinition:
+			if node.IsRecursive {
+				contains = true
+				return
+			}
+			self.VisitChildren(node)
+		case *NamedType:
+			self.Visit(node.Type)
+		case *SimpleType:
+			if !visited[node.ResolvedDefinition] {
+				visited[node.ResolvedDefinition] = true
+				self.Visit(node.ResolvedDefinition)
+			}
+			self.VisitChildren(node)
+		default:
+			self.VisitChildren(node)
+		}
+	})
+
+	return contains
+}
+
 // Returns true if the type is generic (not concrete)
 func TypeContainsGenericTypeParameter(node Type) bool {
 	contains := false
@@ -819,7 +1110,9 @@ func TypeContainsGenericTypeParameter(node Type) bool {
 		case *NamedType:
 			self.Visit(node.Type)
 		case *SimpleType:
-			self.Visit(node.ResolvedDefinition)
+			if !node.IsRecursiveReference {
+				self.Visit(node.ResolvedDefinition)
+			}
 		default:
 			self.VisitChildren(node)
 		}
@@ -959,3 +1252,15 @@ func TypeToShortSyntax(t Type, qualified bool) string {
 		panic(fmt.Sprintf("unknown type: %T", t))
 	}
 }
+
+// Returns true if a step of the protocol refers to a recursive record.
+// Recursive records cannot be represented in HDF5.
+func ProtocolContainsRecursiveRecord(protocol *ProtocolDefinition) bool {
+	for _, step := range protocol.Sequence {
+		if TypeContainsRecursiveRecord(step.Type) {
+			return true
+		}
+	}
+
+	return false
+}
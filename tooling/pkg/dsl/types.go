@@ -82,6 +82,7 @@ type Namespace struct {
 	Name              string                        `json:"name"`
 	TypeDefinitions   TypeDefinitions               `json:"types,omitempty"`
 	Protocols         []*ProtocolDefinition         `json:"protocols,omitempty"`
+	Constants         []*ConstantDefinition         `json:"constants,omitempty"`
 	Versions          []string                      `json:"-"`
 	DefinitionChanges map[string][]DefinitionChange `json:"-"`
 	References        []*Namespace                  `json:"-"`
@@ -134,14 +135,19 @@ const (
 	Int64          = "int64"
 	Uint64         = "uint64"
 	Size           = "size"
+	Float16        = "float16"
+	BFloat16       = "bfloat16"
 	Float32        = "float32"
 	Float64        = "float64"
 	ComplexFloat32 = "complexfloat32"
 	ComplexFloat64 = "complexfloat64"
 	String         = "string"
+	Bytes          = "bytes"
+	Uuid           = "uuid"
 	Date           = "date"
 	Time           = "time"
 	DateTime       = "datetime"
+	Duration       = "duration"
 )
 
 var (
@@ -155,14 +161,19 @@ var (
 	PrimitiveInt64          = PrimitiveDefinition(Int64)
 	PrimitiveUint64         = PrimitiveDefinition(Uint64)
 	PrimitiveSize           = PrimitiveDefinition(Size)
+	PrimitiveFloat16        = PrimitiveDefinition(Float16)
+	PrimitiveBFloat16       = PrimitiveDefinition(BFloat16)
 	PrimitiveFloat32        = PrimitiveDefinition(Float32)
 	PrimitiveFloat64        = PrimitiveDefinition(Float64)
 	PrimitiveComplexFloat32 = PrimitiveDefinition(ComplexFloat32)
 	PrimitiveComplexFloat64 = PrimitiveDefinition(ComplexFloat64)
 	PrimitiveString         = PrimitiveDefinition(String)
+	PrimitiveBytes          = PrimitiveDefinition(Bytes)
+	PrimitiveUuid           = PrimitiveDefinition(Uuid)
 	PrimitiveDate           = PrimitiveDefinition(Date)
 	PrimitiveTime           = PrimitiveDefinition(Time)
 	PrimitiveDateTime       = PrimitiveDefinition(DateTime)
+	PrimitiveDuration       = PrimitiveDefinition(Duration)
 )
 
 var primitiveTypes = func(names ...string) map[string]PrimitiveDefinition {
@@ -187,14 +198,19 @@ var primitiveTypes = func(names ...string) map[string]PrimitiveDefinition {
 	Int64,
 	Uint64,
 	Size,
+	Float16,
+	BFloat16,
 	Float32,
 	Float64,
 	ComplexFloat32,
 	ComplexFloat64,
 	String,
+	Bytes,
+	Uuid,
 	Date,
 	Time,
 	DateTime,
+	Duration,
 
 	// aliases
 	"byte="+Uint8,
@@ -225,6 +241,7 @@ type DefinitionMeta struct {
 	TypeParameters []*GenericTypeParameter `json:"typeParameters,omitempty"`
 	TypeArguments  []Type                  `json:"typeArguments,omitempty"`
 	Comment        string                  `json:"comment,omitempty"`
+	Annotations    Annotations             `json:"annotations,omitempty"`
 }
 
 func (meta *DefinitionMeta) GetQualifiedName() string {
@@ -254,8 +271,14 @@ func (nt *NamedType) GetDefinitionMeta() *DefinitionMeta {
 // Records
 type RecordDefinition struct {
 	*DefinitionMeta
+	// The records this record extends. Their fields and computed fields are
+	// included in Fields and ComputedFields during validation.
+	Extends        []Type         `json:"-"`
 	Fields         Fields         `json:"fields"`
 	ComputedFields ComputedFields `json:"computedFields,omitempty"`
+	// Set during validation when the record refers to itself, either directly
+	// or through other records.
+	IsRecursive bool `json:"-"`
 }
 
 func (r *RecordDefinition) GetDefinitionMeta() *DefinitionMeta {
@@ -279,9 +302,33 @@ type Fields []*Field
 
 type Field struct {
 	NodeMeta
-	Name    string `json:"name"`
-	Comment string `json:"comment,omitempty"`
-	Type    Type   `json:"type"`
+	Name        string            `json:"name"`
+	Comment     string            `json:"comment,omitempty"`
+	Type        Type              `json:"type"`
+	Default     Expression        `json:"default,omitempty"`
+	Constraints *FieldConstraints `json:"constraints,omitempty"`
+	Annotations Annotations       `json:"annotations,omitempty"`
+}
+
+// User-defined metadata given with `annotations:` on a definition or field.
+// The values are strings, integers, floating-point numbers, or booleans.
+// Annotations do not affect serialization.
+type Annotations map[string]any
+
+// FieldConstraints restrict the values a field can hold. They are checked by
+// the validation code generated for records, not when reading or writing data.
+type FieldConstraints struct {
+	NodeMeta
+	// Inclusive bounds of a numeric field. These are literals of the field's type.
+	Min Expression `json:"min,omitempty"`
+	Max Expression `json:"max,omitempty"`
+
+	// A regular expression that a string field must match in its entirety.
+	Pattern string `json:"pattern,omitempty"`
+
+	// Bounds on the number of elements of a vector field.
+	MinLength *uint64 `json:"minLength,omitempty"`
+	MaxLength *uint64 `json:"maxLength,omitempty"`
 }
 
 // ----------------------------------------------------------------------------
@@ -294,6 +341,9 @@ type ArrayDimension struct {
 	Comment string  `json:"comment,omitempty"`
 	Name    *string `json:"name,omitempty"`
 	Length  *uint64 `json:"length,omitempty"`
+	// The name of the constant the length was given as, if any.
+	// Length is set to the constant's value during validation.
+	LengthConstant *string `json:"-"`
 }
 
 type Dimensionality interface {
@@ -304,6 +354,9 @@ type Dimensionality interface {
 type Vector struct {
 	NodeMeta
 	Length *uint64 `json:"length,omitempty"`
+	// The name of the constant the length was given as, if any.
+	// Length is set to the constant's value during validation.
+	LengthConstant *string `json:"-"`
 }
 
 func (v *Vector) dimensionality() {}
@@ -364,6 +417,14 @@ type SimpleType struct {
 	Name               string
 	TypeArguments      []Type
 	ResolvedDefinition TypeDefinition
+	// The physical unit of measure of a numeric primitive type, given as
+	// `float32 @ mm`. Empty if the type has no unit.
+	Unit string
+	// Set during validation on a reference to a recursive record that appears
+	// before the record is fully defined, such as `Node` in the field
+	// `children: Node*` of the record `Node`. Type definitions are sorted so
+	// that these are the only references to types that are not yet defined.
+	IsRecursiveReference bool
 }
 
 type GeneralizedType struct {
@@ -452,8 +513,9 @@ type EnumValue struct {
 // Protocols
 type ProtocolDefinition struct {
 	*DefinitionMeta
-	Sequence ProtocolSteps              `json:"sequence"`
-	Versions map[string]*ProtocolChange `json:"-"`
+	Sequence     ProtocolSteps              `json:"sequence"`
+	SubProtocols []*SubProtocol             `json:"-"`
+	Versions     map[string]*ProtocolChange `json:"-"`
 }
 
 func (p *ProtocolDefinition) GetDefinitionMeta() *DefinitionMeta {
@@ -462,6 +524,41 @@ func (p *ProtocolDefinition) GetDefinitionMeta() *DefinitionMeta {
 
 type ProtocolSteps []*ProtocolStep
 
+// A protocol step that references another protocol, e.g.
+// `calibration: !protocol CalibrationProtocol`. During validation, the
+// referenced protocol's steps are inlined into the outer protocol's sequence,
+// starting at Offset, with their names prefixed by the step name
+// (e.g. `calibrationGain`).
+type SubProtocol struct {
+	NodeMeta
+	Name         string
+	Comment      string
+	ProtocolName string
+	Protocol     *ProtocolDefinition
+	Offset       int
+}
+
+// Returns the steps of the outer protocol's sequence that were inlined
+// from the sub-protocol, in the order of the sub-protocol's sequence.
+func (s *SubProtocol) Steps(outer *ProtocolDefinition) ProtocolSteps {
+	return outer.Sequence[s.Offset : s.Offset+len(s.Protocol.Sequence)]
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+
+// A named constant, e.g. `MaxChannels: !const 128`. After validation, Value
+// is a literal of the constant's type.
+type ConstantDefinition struct {
+	*DefinitionMeta
+	Type  Type       `json:"type"`
+	Value Expression `json:"value"`
+}
+
+func (c *ConstantDefinition) GetDefinitionMeta() *DefinitionMeta {
+	return c.DefinitionMeta
+}
+
 type ProtocolStep Field
 
 func (s *ProtocolStep) IsStream() bool {
@@ -496,6 +593,7 @@ type UnaryOperator int
 
 const (
 	UnaryOpNegate UnaryOperator = iota
+	UnaryOpNot
 )
 
 type UnaryExpression struct {
@@ -523,21 +621,45 @@ const (
 	BinaryOpMul
 	BinaryOpDiv
 	BinaryOpPow
+	BinaryOpEq
+	BinaryOpNe
+	BinaryOpLt
+	BinaryOpLe
+	BinaryOpGt
+	BinaryOpGe
+	BinaryOpAnd
+	BinaryOpOr
 )
 
 func (o BinaryOperator) Precedence() int {
 	switch o {
-	case BinaryOpAdd, BinaryOpSub:
+	case BinaryOpOr:
 		return 0
-	case BinaryOpMul, BinaryOpDiv:
+	case BinaryOpAnd:
 		return 1
-	case BinaryOpPow:
+	case BinaryOpEq, BinaryOpNe, BinaryOpLt, BinaryOpLe, BinaryOpGt, BinaryOpGe:
 		return 2
+	case BinaryOpAdd, BinaryOpSub:
+		return 3
+	case BinaryOpMul, BinaryOpDiv:
+		return 4
+	case BinaryOpPow:
+		return 5
 	default:
 		panic(fmt.Sprintf("unknown binary operator: %d", o))
 	}
 }
 
+// Returns true for the operators that compare two values.
+func (o BinaryOperator) IsComparison() bool {
+	switch o {
+	case BinaryOpEq, BinaryOpNe, BinaryOpLt, BinaryOpLe, BinaryOpGt, BinaryOpGe:
+		return true
+	default:
+		return false
+	}
+}
+
 type BinaryExpression struct {
 	NodeMeta
 	Left         Expression     `json:"left"`
@@ -554,6 +676,23 @@ func (b *BinaryExpression) IsReference() bool {
 	return false
 }
 
+// A conditional expression, written as `then if condition else otherwise`.
+type ConditionalExpression struct {
+	NodeMeta
+	Condition    Expression `json:"condition"`
+	Then         Expression `json:"then"`
+	Else         Expression `json:"else"`
+	ResolvedType Type       `json:"-"`
+}
+
+func (*ConditionalExpression) _expression() {}
+func (e *ConditionalExpression) GetResolvedType() Type {
+	return e.ResolvedType
+}
+func (e *ConditionalExpression) IsReference() bool {
+	return false
+}
+
 type IntegerLiteralExpression struct {
 	NodeMeta
 	Value        big.Int
@@ -596,6 +735,52 @@ func (e *StringLiteralExpression) IsReference() bool {
 	return false
 }
 
+type BooleanLiteralExpression struct {
+	NodeMeta
+	Value        bool
+	ResolvedType Type
+}
+
+func (e *BooleanLiteralExpression) _expression() {}
+func (e *BooleanLiteralExpression) GetResolvedType() Type {
+	return e.ResolvedType
+}
+func (e *BooleanLiteralExpression) IsReference() bool {
+	return false
+}
+
+// A reference to a constant, e.g. `MaxChannels`.
+type ConstantReferenceExpression struct {
+	NodeMeta
+	Definition   *ConstantDefinition `json:"definition"`
+	ResolvedType Type                `json:"-"`
+}
+
+func (e *ConstantReferenceExpression) _expression() {}
+func (e *ConstantReferenceExpression) GetResolvedType() Type {
+	return e.ResolvedType
+}
+func (e *ConstantReferenceExpression) IsReference() bool {
+	return false
+}
+
+// A reference to a value of an enum or flags, e.g. `Mode.fast`.
+// ResolvedType refers to the enum, or to an alias of it if that
+// is how it was referenced.
+type EnumValueExpression struct {
+	NodeMeta
+	Value        *EnumValue `json:"value"`
+	ResolvedType Type       `json:"type"`
+}
+
+func (e *EnumValueExpression) _expression() {}
+func (e *EnumValueExpression) GetResolvedType() Type {
+	return e.ResolvedType
+}
+func (e *EnumValueExpression) IsReference() bool {
+	return false
+}
+
 type MemberAccessKind int
 
 const (
@@ -661,6 +846,16 @@ const (
 	FunctionSize           = "size"
 	FunctionDimensionIndex = "dimensionIndex"
 	FunctionDimensionCount = "dimensionCount"
+	FunctionMin            = "min"
+	FunctionMax            = "max"
+	FunctionAbs            = "abs"
+	FunctionSqrt           = "sqrt"
+	FunctionFloor          = "floor"
+	FunctionCeil           = "ceil"
+	FunctionSum            = "sum"
+	FunctionLength         = "length"
+	FunctionContains       = "contains"
+	FunctionHasValue       = "hasValue"
 )
 
 type TypeConversionExpression struct {
@@ -731,6 +926,7 @@ var (
 	_ Node = (*NamedType)(nil)
 	_ Node = (*RecordDefinition)(nil)
 	_ Node = (*Field)(nil)
+	_ Node = (*FieldConstraints)(nil)
 	_ Node = (*ArrayDimension)(nil)
 	_ Node = Dimensionality(nil)
 	_ Node = (*Vector)(nil)
@@ -742,6 +938,7 @@ var (
 	_ Node = (*EnumDefinition)(nil)
 	_ Node = (*ProtocolDefinition)(nil)
 	_ Node = (*ProtocolStep)(nil)
+	_ Node = (*ConstantDefinition)(nil)
 	_ Node = (*ComputedField)(nil)
 
 	_ TypeDefinition = (*RecordDefinition)(nil)
@@ -749,6 +946,7 @@ var (
 	_ TypeDefinition = (PrimitiveDefinition)("")
 	_ TypeDefinition = (*NamedType)(nil)
 	_ TypeDefinition = (*ProtocolDefinition)(nil)
+	_ TypeDefinition = (*ConstantDefinition)(nil)
 	_ TypeDefinition = (*GenericTypeParameter)(nil)
 
 	_ Dimensionality = (*Vector)(nil)
@@ -761,9 +959,13 @@ var (
 
 	_ Expression = (*UnaryExpression)(nil)
 	_ Expression = (*BinaryExpression)(nil)
+	_ Expression = (*ConditionalExpression)(nil)
 	_ Expression = (*IntegerLiteralExpression)(nil)
 	_ Expression = (*FloatingPointLiteralExpression)(nil)
 	_ Expression = (*StringLiteralExpression)(nil)
+	_ Expression = (*BooleanLiteralExpression)(nil)
+	_ Expression = (*EnumValueExpression)(nil)
+	_ Expression = (*ConstantReferenceExpression)(nil)
 	_ Expression = (*MemberAccessExpression)(nil)
 	_ Expression = (*SubscriptExpression)(nil)
 	_ Expression = (*FunctionCallExpression)(nil)
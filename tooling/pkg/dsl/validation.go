@@ -35,14 +35,22 @@ func Validate(namespaces []*Namespace) (*Environment, error) {
 		validateStreams,
 		buildSymbolTable,
 		resolveTypes,
+		resolveConstants,
+		resolveRecordExtensions,
 		assignUnionCaseTags,
 		topologicalSortTypes,
 		convertGenericReferences,
+		instantiateGenericProtocols,
 		validateUnionCases,
 		validateEnums,
+		validateUnits,
 		resolveComputedFields,
+		resolveFieldDefaults,
+		validateFieldConstraints,
+		inlineSubProtocols,
 		removeUnusedDeclarationPatterns,
 		validateGenericParametersUsed,
+		removeGenericProtocols,
 	}
 
 	for _, pass := range passes {
@@ -109,6 +117,10 @@ func validateRecordFieldNames(env *Environment, errorSink *validation.ErrorSink)
 				errorSink.Add(validationError(field, "field name '%s' must be camelCased matching the format %s", field.Name, memberNameRegex.String()))
 			}
 
+			if field.Name == "true" || field.Name == "false" {
+				errorSink.Add(validationError(field, "field name '%s' is reserved for boolean literals", field.Name))
+			}
+
 			if _, found := fields[field.Name]; found {
 				errorSink.Add(validationError(field, "a field with the name '%s' is already defined on the record '%s'", field.Name, record.Name))
 			}
@@ -121,6 +133,10 @@ func validateRecordFieldNames(env *Environment, errorSink *validation.ErrorSink)
 				errorSink.Add(validationError(field, "computed field name '%s' must be camelCased matching the format %s", field.Name, memberNameRegex.String()))
 			}
 
+			if field.Name == "true" || field.Name == "false" {
+				errorSink.Add(validationError(field, "computed field name '%s' is reserved for boolean literals", field.Name))
+			}
+
 			if _, found := fields[field.Name]; found {
 				errorSink.Add(validationError(field, "a field or computed field with the name '%s' is already defined on the record '%s'", field.Name, record.Name))
 			}
@@ -153,6 +169,18 @@ func validateProtocolSequenceNames(env *Environment, errorSink *validation.Error
 
 			steps[step.Name] = true
 		}
+
+		for _, subProtocol := range protocol.SubProtocols {
+			if !memberNameRegex.MatchString(subProtocol.Name) {
+				errorSink.Add(validationError(subProtocol, "protocol step name '%s' must be camelCased matching the format %s", subProtocol.Name, memberNameRegex.String()))
+			}
+
+			if _, found := steps[subProtocol.Name]; found {
+				errorSink.Add(validationError(subProtocol, "a sequence step with the name '%s' is already defined on the protocol '%s'", subProtocol.Name, protocol.Name))
+			}
+
+			steps[subProtocol.Name] = true
+		}
 	})
 
 	return env
@@ -14,7 +14,7 @@ func validateArrayAndVectorDimensions(env *Environment, errorSink *validation.Er
 				notNullLengthCount := 0
 				dimensionNames := make(map[string]bool)
 				for _, dim := range *t.Dimensions {
-					if dim.Length == nil {
+					if dim.Length == nil && dim.LengthConstant == nil {
 						nullLengthCount++
 					} else {
 						notNullLengthCount++
@@ -13,7 +13,10 @@ import (
 )
 
 type ComputedFieldScope struct {
+	// The record whose fields can be referenced. Nil when the
+	// expression must be constant, as for field default values.
 	Record          *RecordDefinition
+	Namespace       string
 	RewrittenFields map[*ComputedField]*ComputedField
 	CurrentFields   []*ComputedField
 	Variables       []*DeclarationPattern
@@ -26,6 +29,7 @@ func resolveComputedFields(env *Environment, errorSink *validation.ErrorSink) *E
 		return env
 	}
 
+	resolve := expressionResolver(env, errorSink)
 	return RewriteWithContext(env, &ComputedFieldScope{}, func(node Node, context *ComputedFieldScope, self *RewriterWithContext[*ComputedFieldScope]) Node {
 		switch t := node.(type) {
 		case *RecordDefinition:
@@ -34,10 +38,25 @@ func resolveComputedFields(env *Environment, errorSink *validation.ErrorSink) *E
 			}
 			scope := ComputedFieldScope{
 				Record:          t,
+				Namespace:       t.Namespace,
 				RewrittenFields: make(map[*ComputedField]*ComputedField),
 			}
 
 			return self.DefaultRewrite(node, &scope)
+		case *Field:
+			// default values are resolved in resolveFieldDefaults
+			return rewriteFieldType(t, context, self)
+		default:
+			return resolve(node, context, self)
+		}
+	}).(*Environment)
+}
+
+// Returns a rewriter function that resolves the types of expressions and
+// validates them, adding any errors to the given sink.
+func expressionResolver(env *Environment, errorSink *validation.ErrorSink) RewriterWithContextFunc[*ComputedFieldScope] {
+	return func(node Node, context *ComputedFieldScope, self *RewriterWithContext[*ComputedFieldScope]) Node {
+		switch t := node.(type) {
 		case *ComputedField:
 
 			if rewritten, ok := context.RewrittenFields[t]; ok {
@@ -57,9 +76,12 @@ func resolveComputedFields(env *Environment, errorSink *validation.ErrorSink) *E
 				}
 			}
 
-			rewritten := self.DefaultRewrite(node, &ComputedFieldScope{context.Record, context.RewrittenFields, append(context.CurrentFields, t), context.Variables})
+			rewritten := self.DefaultRewrite(node, &ComputedFieldScope{context.Record, context.Namespace, context.RewrittenFields, append(context.CurrentFields, t), context.Variables})
 			context.RewrittenFields[t] = rewritten.(*ComputedField)
 			return rewritten
+		case *ConstantDefinition:
+			// constants are resolved in resolveConstants
+			return t
 		case *TypeConversionExpression:
 			t = self.DefaultRewrite(t, context).(*TypeConversionExpression)
 			innerType := t.Expression.GetResolvedType()
@@ -99,6 +121,12 @@ func resolveComputedFields(env *Environment, errorSink *validation.ErrorSink) *E
 
 			errorSink.Add(validationError(t, "cannot cast from from '%s' to '%s'", TypeToShortSyntax(innerType, true), TypeToShortSyntax(t.Type, true)))
 			return t
+		case *UnaryExpression:
+			t = self.DefaultRewrite(t, context).(*UnaryExpression)
+			if t.Operator == UnaryOpNot && t.Expression.GetResolvedType() != nil && !isBoolType(t.Expression.GetResolvedType()) {
+				errorSink.Add(validationError(t, "operator '!' not defined for an operand with type '%s'", TypeToShortSyntax(t.Expression.GetResolvedType(), true)))
+			}
+			return t
 		case *BinaryExpression:
 			t = self.DefaultRewrite(t, context).(*BinaryExpression)
 			t = shallowClone(t)
@@ -106,6 +134,26 @@ func resolveComputedFields(env *Environment, errorSink *validation.ErrorSink) *E
 				return t
 			}
 
+			if !validateOperandUnits(t, errorSink) {
+				return t
+			}
+
+			switch {
+			case t.Operator == BinaryOpAnd || t.Operator == BinaryOpOr:
+				if !isBoolType(t.Left.GetResolvedType()) || !isBoolType(t.Right.GetResolvedType()) {
+					lType := TypeToShortSyntax(t.Left.GetResolvedType(), true)
+					rtype := TypeToShortSyntax(t.Right.GetResolvedType(), true)
+					errorSink.Add(validationError(t, "operator not defined between operands with types '%s' and '%s'", lType, rtype))
+					return t
+				}
+				t.ResolvedType = BoolType
+				return t
+			case t.Operator.IsComparison():
+				return resolveComparison(t, errorSink)
+			case isTemporalType(t.Left.GetResolvedType()) || isTemporalType(t.Right.GetResolvedType()):
+				return resolveTemporalArithmetic(t, errorSink)
+			}
+
 			lKind, lIsPrim := GetKindIfPrimitive(t.Left.GetResolvedType())
 			rKind, rIsPrim := GetKindIfPrimitive(t.Right.GetResolvedType())
 			commonType, err := GetCommonType(t.Left.GetResolvedType(), t.Right.GetResolvedType())
@@ -140,6 +188,38 @@ func resolveComputedFields(env *Environment, errorSink *validation.ErrorSink) *E
 			t.Right = insertConversion(t.Right, commonType)
 			t.ResolvedType = commonType
 			return t
+		case *ConditionalExpression:
+			t = self.DefaultRewrite(t, context).(*ConditionalExpression)
+			t = shallowClone(t)
+			if t.Condition.GetResolvedType() == nil || t.Then.GetResolvedType() == nil || t.Else.GetResolvedType() == nil {
+				return t
+			}
+
+			if !isBoolType(t.Condition.GetResolvedType()) {
+				errorSink.Add(validationError(t.Condition, "the condition of a conditional expression must be of type 'bool' and not '%s'", TypeToShortSyntax(t.Condition.GetResolvedType(), true)))
+				return t
+			}
+
+			if mismatch := unitMismatch(t.Then, t.Else); mismatch != "" {
+				errorSink.Add(validationError(t, "the branches of the conditional expression have %s", mismatch))
+				return t
+			}
+
+			commonType := t.Then.GetResolvedType()
+			if !TypesEqual(GetUnderlyingType(t.Then.GetResolvedType()), GetUnderlyingType(t.Else.GetResolvedType())) {
+				var err error
+				if commonType, err = GetCommonType(t.Then.GetResolvedType(), t.Else.GetResolvedType()); err != nil {
+					thenType := TypeToShortSyntax(t.Then.GetResolvedType(), true)
+					elseType := TypeToShortSyntax(t.Else.GetResolvedType(), true)
+					errorSink.Add(validationError(t, "no best type was found for the conditional expression with branches of types '%s' and '%s'", thenType, elseType))
+					return t
+				}
+			}
+
+			t.Then = insertConversion(t.Then, commonType)
+			t.Else = insertConversion(t.Else, commonType)
+			t.ResolvedType = commonType
+			return t
 		case *IntegerLiteralExpression:
 			if t.Value.Sign() >= 0 {
 				if t.Value.Cmp(MaxUint8) <= 0 {
@@ -196,7 +276,18 @@ func resolveComputedFields(env *Environment, errorSink *validation.ErrorSink) *E
 			clone := *t
 			clone.ResolvedType = StringType
 			return &clone
+		case *BooleanLiteralExpression:
+			clone := *t
+			clone.ResolvedType = BoolType
+			return &clone
 		case *MemberAccessExpression:
+			if enumValue := resolveEnumValueReference(t, context, env.SymbolTable, errorSink); enumValue != nil {
+				return enumValue
+			}
+			if constant := resolveConstantReference(t, context, env.SymbolTable); constant != nil {
+				return constant
+			}
+
 			t = self.DefaultRewrite(t, context).(*MemberAccessExpression)
 			t = shallowClone(t)
 			target := context.Record
@@ -227,6 +318,11 @@ func resolveComputedFields(env *Environment, errorSink *validation.ErrorSink) *E
 						return t
 					}
 				}
+
+				if target == nil {
+					errorSink.Add(validationError(t, "'%s' cannot be referenced here because the expression must be constant", t.Member))
+					return t
+				}
 			}
 
 			for _, f := range target.Fields {
@@ -401,6 +497,9 @@ func resolveComputedFields(env *Environment, errorSink *validation.ErrorSink) *E
 			case FunctionDimensionCount:
 				return resolveDimensionCountFunctionCall(t, self, context, errorSink)
 			default:
+				if signature, ok := GetBuiltinFunction(t.FunctionName); ok {
+					return resolveBuiltinFunctionCall(t, signature, self, context, errorSink)
+				}
 				errorSink.Add(validationError(t, "unknown function '%s'", t.FunctionName))
 				return t
 			}
@@ -505,7 +604,188 @@ func resolveComputedFields(env *Environment, errorSink *validation.ErrorSink) *E
 		default:
 			return self.DefaultRewrite(node, context)
 		}
-	}).(*Environment)
+	}
+}
+
+// Resolves a comparison. Numbers are compared after conversion to their common
+// type, durations can be compared with each other, and values of other types
+// can be compared for equality if they are booleans, strings, or values of the
+// same enum.
+func resolveComparison(t *BinaryExpression, errorSink *validation.ErrorSink) Expression {
+	lType := t.Left.GetResolvedType()
+	rType := t.Right.GetResolvedType()
+	lKind, _ := GetKindIfPrimitive(lType)
+	rKind, _ := GetKindIfPrimitive(rType)
+
+	isOrderable := func(kind PrimitiveKind) bool {
+		return kind == PrimitiveKindInteger || kind == PrimitiveKindFloatingPoint
+	}
+	isEquatable := func(kind PrimitiveKind) bool {
+		return isOrderable(kind) || kind == PrimitiveKindComplexFloatingPoint
+	}
+
+	isEquality := t.Operator == BinaryOpEq || t.Operator == BinaryOpNe
+
+	comparable := false
+	switch {
+	case isOrderable(lKind) && isOrderable(rKind), isEquality && isEquatable(lKind) && isEquatable(rKind):
+		if commonType, err := GetCommonType(lType, rType); err == nil {
+			t.Left = insertConversion(t.Left, commonType)
+			t.Right = insertConversion(t.Right, commonType)
+			comparable = true
+		}
+	case isDurationType(lType) && isDurationType(rType):
+		comparable = true
+	case isEquality && TypesEqual(GetUnderlyingType(lType), GetUnderlyingType(rType)):
+		switch underlyingType := GetUnderlyingType(lType).(type) {
+		case *SimpleType:
+			switch definition := underlyingType.ResolvedDefinition.(type) {
+			case PrimitiveDefinition:
+				comparable = definition == Bool || definition == String
+			case *EnumDefinition:
+				comparable = true
+			}
+		}
+	}
+
+	if !comparable {
+		errorSink.Add(validationError(t, "operator not defined between operands with types '%s' and '%s'", TypeToShortSyntax(lType, true), TypeToShortSyntax(rType, true)))
+		return t
+	}
+
+	t.ResolvedType = BoolType
+	return t
+}
+
+// Resolves an addition or subtraction involving dates and times. The difference
+// between two datetimes or two times is a duration, a duration can be added to
+// or subtracted from a datetime, and durations can be added to and subtracted
+// from each other.
+func resolveTemporalArithmetic(t *BinaryExpression, errorSink *validation.ErrorSink) Expression {
+	lPrimitive, _ := GetPrimitiveType(t.Left.GetResolvedType())
+	rPrimitive, _ := GetPrimitiveType(t.Right.GetResolvedType())
+	isAddOrSub := t.Operator == BinaryOpAdd || t.Operator == BinaryOpSub
+
+	switch {
+	case t.Operator == BinaryOpSub && lPrimitive == rPrimitive && (lPrimitive == DateTime || lPrimitive == Time):
+		t.ResolvedType = DurationType
+		return t
+	case isAddOrSub && lPrimitive == DateTime && rPrimitive == Duration:
+		t.ResolvedType = DateTimeType
+		return t
+	case t.Operator == BinaryOpAdd && lPrimitive == Duration && rPrimitive == DateTime:
+		// The operands are swapped so that code generators only
+		// need to handle a datetime on the left.
+		t.Left, t.Right = t.Right, t.Left
+		t.ResolvedType = DateTimeType
+		return t
+	case isAddOrSub && lPrimitive == Duration && rPrimitive == Duration:
+		t.ResolvedType = DurationType
+		return t
+	}
+
+	lType := TypeToShortSyntax(t.Left.GetResolvedType(), true)
+	rtype := TypeToShortSyntax(t.Right.GetResolvedType(), true)
+	errorSink.Add(validationError(t, "operator not defined between operands with types '%s' and '%s'", lType, rtype))
+	return t
+}
+
+func isBoolType(t Type) bool {
+	primitive, ok := GetPrimitiveType(t)
+	return ok && primitive == Bool
+}
+
+func isDurationType(t Type) bool {
+	primitive, ok := GetPrimitiveType(t)
+	return ok && primitive == Duration
+}
+
+func isTemporalType(t Type) bool {
+	primitive, ok := GetPrimitiveType(t)
+	if !ok {
+		return false
+	}
+	switch primitive {
+	case Date, Time, DateTime, Duration:
+		return true
+	}
+	return false
+}
+
+// Returns true if the name refers to a variable, field, or computed field in scope.
+// These take precedence over the names of types and constants.
+func isNameInScope(name string, context *ComputedFieldScope) bool {
+	for _, variable := range context.Variables {
+		if variable.Identifier == name {
+			return true
+		}
+	}
+	if context.Record != nil {
+		for _, f := range context.Record.Fields {
+			if f.Name == name {
+				return true
+			}
+		}
+		for _, f := range context.Record.ComputedFields {
+			if f.Name == name {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// If the given member access refers to a value of an enum, e.g. `Mode.fast` or
+// `OtherNamespace.Mode.fast`, returns an EnumValueExpression for it.
+// Fields and variables in scope take precedence over type names.
+func resolveEnumValueReference(expression *MemberAccessExpression, context *ComputedFieldScope, symbolTable SymbolTable, errorSink *validation.ErrorSink) Expression {
+	var path []string
+	for target := expression.Target; target != nil; {
+		member, ok := target.(*MemberAccessExpression)
+		if !ok {
+			return nil
+		}
+		path = append([]string{member.Member}, path...)
+		target = member.Target
+	}
+
+	if len(path) == 0 {
+		return nil
+	}
+
+	if isNameInScope(path[0], context) {
+		return nil
+	}
+
+	typeName := strings.Join(path, ".")
+	definition, err := resolveTypeByName(typeName, context.Namespace, symbolTable)
+	if err != nil {
+		return nil
+	}
+
+	enumType := &SimpleType{NodeMeta: *expression.Target.GetNodeMeta(), Name: definition.GetDefinitionMeta().GetQualifiedName(), ResolvedDefinition: definition}
+	underlying, ok := GetUnderlyingType(enumType).(*SimpleType)
+	if !ok {
+		return nil
+	}
+	enum, ok := underlying.ResolvedDefinition.(*EnumDefinition)
+	if !ok {
+		return nil
+	}
+
+	for _, v := range enum.Values {
+		if v.Symbol == expression.Member {
+			return &EnumValueExpression{
+				NodeMeta:     expression.NodeMeta,
+				Value:        v,
+				ResolvedType: enumType,
+			}
+		}
+	}
+
+	errorSink.Add(validationError(expression, "'%s' is not a value of '%s'", expression.Member, typeName))
+	return expression
 }
 
 func insertConversion(expression Expression, targetType Type) Expression {
@@ -610,7 +890,7 @@ func resolveSwitchCase(switchCase *SwitchCase, typeCases TypeCases, self *Rewrit
 		}
 
 		updated := *switchCase
-		updated.Expression = self.Rewrite(switchCase.Expression, &ComputedFieldScope{context.Record, context.RewrittenFields, context.CurrentFields, append(context.Variables, t)}).(Expression)
+		updated.Expression = self.Rewrite(switchCase.Expression, &ComputedFieldScope{context.Record, context.Namespace, context.RewrittenFields, context.CurrentFields, append(context.Variables, t)}).(Expression)
 		return &updated
 	default:
 		panic(fmt.Sprintf("unexpected switch case pattern type %T", switchCase.Pattern))
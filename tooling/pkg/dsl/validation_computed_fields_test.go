@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestDuplicateComputedFieldName(t *testing.T) {
@@ -21,6 +22,32 @@ X: !record
 	assert.ErrorContains(t, err, `a field or computed field with the name 'f' is already defined on the record 'X'`)
 }
 
+func TestKeywordFieldReferences(t *testing.T) {
+	src := `
+X: !record
+  fields:
+    if: bool
+    else: int
+  computedFields:
+    a: if
+    b: else
+    c: else if if else 0`
+	_, err := parseAndValidate(t, src)
+	assert.NoError(t, err)
+}
+
+func TestBooleanLiteralFieldNames(t *testing.T) {
+	src := `
+X: !record
+  fields:
+    "true": int
+  computedFields:
+    "false": 1`
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, "field name 'true' is reserved for boolean literals")
+	assert.ErrorContains(t, err, "computed field name 'false' is reserved for boolean literals")
+}
+
 func TestUnboundField(t *testing.T) {
 	src := `
 X: !record
@@ -870,6 +897,198 @@ X: !record
 	assert.ErrorContains(t, err, `operator not defined between operands with types 'int64' and 'uint64'`)
 }
 
+func TestTemporalArithmetic(t *testing.T) {
+	src := `
+X: !record
+  fields:
+    start: datetime
+    end: datetime
+    startTime: time
+    endTime: time
+    repetitionTime: duration
+  computedFields:
+    elapsed: end - start
+    elapsedTime: endTime - startTime
+    next: start + repetitionTime
+    previous: start - repetitionTime
+    nextSwapped: repetitionTime + start
+    twice: repetitionTime + repetitionTime
+    isLong: elapsed > repetitionTime
+`
+	env, err := parseAndValidate(t, src)
+	require.NoError(t, err)
+
+	computedFields := env.SymbolTable["test.X"].(*RecordDefinition).ComputedFields
+	expectedTypes := []Type{DurationType, DurationType, DateTimeType, DateTimeType, DateTimeType, DurationType, BoolType}
+	for i, f := range computedFields {
+		assert.True(t, TypesEqual(expectedTypes[i], f.Expression.GetResolvedType()), f.Name)
+	}
+
+	// the datetime is always the left operand of an addition
+	nextSwapped := computedFields[4].Expression.(*BinaryExpression)
+	assert.True(t, TypesEqual(DateTimeType, nextSwapped.Left.GetResolvedType()))
+}
+
+func TestTemporalArithmeticIncompatibleOperands(t *testing.T) {
+	src := `
+X: !record
+  fields:
+    start: datetime
+    startTime: time
+    day: date
+    repetitionTime: duration
+  computedFields:
+    c1: start + start
+    c2: repetitionTime - start
+    c3: start - startTime
+    c4: startTime + repetitionTime
+    c5: day - day
+    c6: repetitionTime * 2
+    c7: start < start
+`
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, `operator not defined between operands with types 'datetime' and 'datetime'`)
+	assert.ErrorContains(t, err, `operator not defined between operands with types 'duration' and 'datetime'`)
+	assert.ErrorContains(t, err, `operator not defined between operands with types 'datetime' and 'time'`)
+	assert.ErrorContains(t, err, `operator not defined between operands with types 'time' and 'duration'`)
+	assert.ErrorContains(t, err, `operator not defined between operands with types 'date' and 'date'`)
+	assert.ErrorContains(t, err, `operator not defined between operands with types 'duration' and 'uint8'`)
+}
+
+func TestComparisonsAndLogicalOperators(t *testing.T) {
+	src := `
+X: !record
+  fields:
+    data: int*
+    threshold: float
+    name: string
+    mode: Mode
+    flag: bool
+    complex: complexfloat
+  computedFields:
+    isLarge: size(data) > 1024
+    aboveThreshold: size(data) >= threshold
+    named: name == "abc"
+    fast: mode != Mode.fast
+    complexZero: complex == 0
+    both: isLarge && !flag
+    neither: "!isLarge && !flag"
+    either: (isLarge || flag) == false
+
+Mode: !enum
+  values:
+    - slow
+    - fast
+`
+	env, err := parseAndValidate(t, src)
+	require.NoError(t, err)
+
+	computedFields := env.SymbolTable["test.X"].(*RecordDefinition).ComputedFields
+	for _, f := range computedFields {
+		assert.True(t, TypesEqual(BoolType, f.Expression.GetResolvedType()), f.Name)
+	}
+
+	// the size is converted to the type of the threshold before comparison
+	aboveThreshold := computedFields[1].Expression.(*BinaryExpression)
+	assert.True(t, TypesEqual(Float32Type, aboveThreshold.Left.GetResolvedType()))
+}
+
+func TestComparisonIncompatibleOperands(t *testing.T) {
+	src := `
+X: !record
+  fields:
+    name: string
+    complex: complexfloat
+    mode: Mode
+    other: Other
+  computedFields:
+    c1: name < "abc"
+    c2: name == 1
+    c3: complex < 1
+    c4: mode == other
+
+Mode: !enum
+  values:
+    - a
+
+Other: !enum
+  values:
+    - a
+`
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, `operator not defined between operands with types 'string' and 'string'`)
+	assert.ErrorContains(t, err, `operator not defined between operands with types 'string' and 'uint8'`)
+	assert.ErrorContains(t, err, `operator not defined between operands with types 'complexfloat32' and 'uint8'`)
+	assert.ErrorContains(t, err, `operator not defined between operands with types 'test.Mode' and 'test.Other'`)
+}
+
+func TestLogicalOperatorsRequireBool(t *testing.T) {
+	src := `
+X: !record
+  fields:
+    a: int
+    b: bool
+  computedFields:
+    c1: a && b
+    c2: "!a"
+`
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, `operator not defined between operands with types 'int32' and 'bool'`)
+	assert.ErrorContains(t, err, `operator '!' not defined for an operand with type 'int32'`)
+}
+
+func TestConditionalExpression(t *testing.T) {
+	src := `
+X: !record
+  fields:
+    data: int*
+    flag: bool
+    mode: Mode
+  computedFields:
+    first: data[0] if size(data) > 0 else 0
+    scale: (2 if flag else 0.5) * 3
+    selected: Mode.fast if flag else mode
+
+Mode: !enum
+  values:
+    - slow
+    - fast
+`
+	env, err := parseAndValidate(t, src)
+	require.NoError(t, err)
+
+	computedFields := env.SymbolTable["test.X"].(*RecordDefinition).ComputedFields
+	first := computedFields[0].Expression.(*ConditionalExpression)
+	assert.True(t, TypesEqual(Int32Type, first.GetResolvedType()))
+	assert.True(t, TypesEqual(Int32Type, first.Else.GetResolvedType()))
+	assert.True(t, TypesEqual(Float64Type, computedFields[1].Expression.GetResolvedType()))
+	assert.Equal(t, "test.Mode", TypeToShortSyntax(computedFields[2].Expression.GetResolvedType(), true))
+}
+
+func TestConditionalExpressionConditionNotBool(t *testing.T) {
+	src := `
+X: !record
+  fields:
+    a: int
+  computedFields:
+    c: 1 if a else 2
+`
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, `the condition of a conditional expression must be of type 'bool' and not 'int32'`)
+}
+
+func TestConditionalExpressionNoCommonType(t *testing.T) {
+	src := `
+X: !record
+  fields:
+    a: bool
+  computedFields:
+    c: 1 if a else "one"
+`
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, `no best type was found for the conditional expression with branches of types 'uint8' and 'string'`)
+}
+
 func TestCastToUnrecognizedType(t *testing.T) {
 	src := `
 X: !record
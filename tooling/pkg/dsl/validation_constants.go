@@ -0,0 +1,450 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+package dsl
+
+import (
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/microsoft/yardl/tooling/internal/validation"
+)
+
+// Resolves the values of constants, folding each into a literal of the
+// constant's type. The lengths of vectors and array dimensions that are given
+// as constants are then set to the constants' values.
+func resolveConstants(env *Environment, errorSink *validation.ErrorSink) *Environment {
+	if len(errorSink.Errors) > 0 {
+		return env
+	}
+
+	resolve := expressionResolver(env, errorSink)
+	done := make(map[*ConstantDefinition]bool)
+	var current []*ConstantDefinition
+
+	var resolveConstant func(constant *ConstantDefinition)
+	resolveConstant = func(constant *ConstantDefinition) {
+		if done[constant] {
+			return
+		}
+
+		for i, c := range current {
+			if c == constant {
+				chain := make([]string, 0, len(current)-i+1)
+				for _, c := range current[i:] {
+					chain = append(chain, c.Name)
+					done[c] = true
+				}
+				chain = append(chain, constant.Name)
+				errorSink.Add(validationError(constant, "cycle detected in constants: %s", strings.Join(chain, " -> ")))
+				return
+			}
+		}
+
+		// The constants that this one refers to are resolved first,
+		// since their types are needed to resolve this one.
+		current = append(current, constant)
+		for _, dependency := range referencedConstants(constant, env.SymbolTable) {
+			resolveConstant(dependency)
+		}
+		current = current[:len(current)-1]
+
+		if !done[constant] {
+			done[constant] = true
+			foldConstant(constant, resolve, errorSink)
+		}
+	}
+
+	for _, ns := range env.Namespaces {
+		for _, constant := range ns.Constants {
+			resolveConstant(constant)
+		}
+	}
+
+	VisitWithContext(env, "", func(self VisitorWithContext[string], node Node, namespace string) {
+		switch t := node.(type) {
+		case *Namespace:
+			self.VisitChildren(node, t.Name)
+			return
+		case *Vector:
+			if t.LengthConstant != nil && t.Length == nil {
+				t.Length = constantLength(t, *t.LengthConstant, namespace, env.SymbolTable, errorSink)
+			}
+		case *ArrayDimension:
+			if t.LengthConstant != nil && t.Length == nil {
+				t.Length = constantLength(t, *t.LengthConstant, namespace, env.SymbolTable, errorSink)
+			}
+		}
+
+		self.VisitChildren(node, namespace)
+	})
+
+	return env
+}
+
+// Looks up a constant by its name, which can be qualified by its namespace.
+func lookupConstant(name string, currentNamespace string, symbolTable SymbolTable) (*ConstantDefinition, bool) {
+	definition, found := symbolTable[name]
+	if !found {
+		definition, found = symbolTable[currentNamespace+"."+name]
+	}
+	if !found {
+		return nil, false
+	}
+
+	constant, ok := definition.(*ConstantDefinition)
+	return constant, ok
+}
+
+// If the member access refers to a constant, e.g. `MaxChannels` or
+// `OtherNamespace.MaxChannels`, returns a ConstantReferenceExpression for it.
+func resolveConstantReference(expression *MemberAccessExpression, context *ComputedFieldScope, symbolTable SymbolTable) Expression {
+	path, ok := memberAccessPath(expression)
+	if !ok || isNameInScope(path[0], context) {
+		return nil
+	}
+
+	constant, ok := lookupConstant(strings.Join(path, "."), context.Namespace, symbolTable)
+	if !ok {
+		return nil
+	}
+
+	return &ConstantReferenceExpression{
+		NodeMeta:     expression.NodeMeta,
+		Definition:   constant,
+		ResolvedType: constant.Type,
+	}
+}
+
+// Returns the names of a chain of member accesses, e.g. ["a", "b", "c"] for `a.b.c`.
+func memberAccessPath(expression *MemberAccessExpression) ([]string, bool) {
+	path := []string{expression.Member}
+	for target := expression.Target; target != nil; {
+		member, ok := target.(*MemberAccessExpression)
+		if !ok {
+			return nil, false
+		}
+		path = append([]string{member.Member}, path...)
+		target = member.Target
+	}
+
+	return path, true
+}
+
+func referencedConstants(constant *ConstantDefinition, symbolTable SymbolTable) []*ConstantDefinition {
+	var referenced []*ConstantDefinition
+	Visit(constant.Value, func(self Visitor, node Node) {
+		if t, ok := node.(*MemberAccessExpression); ok {
+			if path, ok := memberAccessPath(t); ok {
+				if c, ok := lookupConstant(strings.Join(path, "."), constant.Namespace, symbolTable); ok {
+					referenced = append(referenced, c)
+					return
+				}
+			}
+		}
+
+		self.VisitChildren(node)
+	})
+
+	return referenced
+}
+
+// Resolves the value of a constant and replaces it with a literal of the
+// constant's type. If no type is given, it is inferred from the value.
+func foldConstant(constant *ConstantDefinition, resolve RewriterWithContextFunc[*ComputedFieldScope], errorSink *validation.ErrorSink) {
+	value := RewriteWithContext(constant.Value, &ComputedFieldScope{Namespace: constant.Namespace}, resolve).(Expression)
+	valueType := value.GetResolvedType()
+	if valueType == nil {
+		// there is already an error for this
+		return
+	}
+
+	// The type of an integer constant without a given type
+	// is inferred once its value is known
+	valueKind, _ := GetKindIfPrimitive(valueType)
+	if constant.Type != nil || valueKind != PrimitiveKindInteger {
+		constantType := constant.Type
+		if constantType == nil {
+			constantType = valueType
+		}
+
+		if !isConstantType(constantType) {
+			errorSink.Add(validationError(constant, "constant '%s' must have an integer, floating-point, or bool type, but has type '%s'", constant.Name, TypeToShortSyntax(constantType, true)))
+			return
+		}
+
+		if !isAssignableConstant(valueType, constantType) {
+			errorSink.Add(validationError(value, "a value of type '%s' cannot be assigned to constant '%s' of type '%s'", TypeToShortSyntax(valueType, true), constant.Name, TypeToShortSyntax(constantType, true)))
+			return
+		}
+
+		constant.Type = constantType
+	}
+
+	result, ok := evaluateConstantExpression(value, constant, errorSink)
+	if !ok {
+		return
+	}
+
+	switch result := result.(type) {
+	case *big.Int:
+		if constant.Type == nil {
+			constant.Type = inferIntegerConstantType(result)
+		}
+
+		primitive, _ := GetPrimitiveType(constant.Type)
+		if GetPrimitiveKind(primitive) == PrimitiveKindFloatingPoint {
+			value, _ := new(big.Float).SetInt(result).Float64()
+			constant.Value = floatingPointConstant(constant, value, errorSink)
+			return
+		}
+
+		min, max := integerRange(primitive)
+		if result.Cmp(min) < 0 || result.Cmp(max) > 0 {
+			errorSink.Add(validationError(constant, "the value %s of constant '%s' is out of range for the type '%s'", result.String(), constant.Name, primitive))
+			return
+		}
+
+		constant.Value = &IntegerLiteralExpression{NodeMeta: *value.GetNodeMeta(), Value: *result, ResolvedType: constant.Type}
+	case float64:
+		constant.Value = floatingPointConstant(constant, result, errorSink)
+	case bool:
+		constant.Value = &BooleanLiteralExpression{NodeMeta: *value.GetNodeMeta(), Value: result, ResolvedType: constant.Type}
+	}
+}
+
+func isConstantType(t Type) bool {
+	if isBoolType(t) {
+		return true
+	}
+
+	kind, ok := GetKindIfPrimitive(t)
+	return ok && (kind == PrimitiveKindInteger || kind == PrimitiveKindFloatingPoint)
+}
+
+func isAssignableConstant(valueType, constantType Type) bool {
+	if isBoolType(constantType) {
+		return isBoolType(valueType)
+	}
+
+	valueKind, _ := GetKindIfPrimitive(valueType)
+	constantKind, _ := GetKindIfPrimitive(constantType)
+	switch constantKind {
+	case PrimitiveKindInteger:
+		return valueKind == PrimitiveKindInteger
+	case PrimitiveKindFloatingPoint:
+		return valueKind == PrimitiveKindInteger || valueKind == PrimitiveKindFloatingPoint
+	default:
+		return false
+	}
+}
+
+// Integer constants without a given type are int32 if their value fits,
+// otherwise int64 or uint64.
+func inferIntegerConstantType(value *big.Int) Type {
+	switch {
+	case value.Cmp(MinInt32) >= 0 && value.Cmp(MaxInt32) <= 0:
+		return Int32Type
+	case value.Cmp(MinInt64) >= 0 && value.Cmp(MaxInt64) <= 0:
+		return Int64Type
+	default:
+		return Uint64Type
+	}
+}
+
+func floatingPointConstant(constant *ConstantDefinition, value float64, errorSink *validation.ErrorSink) Expression {
+	if math.IsInf(value, 0) || math.IsNaN(value) {
+		errorSink.Add(validationError(constant, "the value of constant '%s' is not a finite number", constant.Name))
+		return constant.Value
+	}
+
+	formatted := strconv.FormatFloat(value, 'g', -1, 64)
+	if !strings.ContainsAny(formatted, ".e") {
+		formatted += ".0"
+	}
+
+	return &FloatingPointLiteralExpression{NodeMeta: *constant.Value.GetNodeMeta(), Value: formatted, ResolvedType: constant.Type}
+}
+
+// Evaluates a resolved expression that is made up of literals, references to
+// other constants, and operators. The result is a *big.Int, a float64, or a bool.
+func evaluateConstantExpression(expression Expression, constant *ConstantDefinition, errorSink *validation.ErrorSink) (any, bool) {
+	evaluate := func(e Expression) (any, bool) {
+		return evaluateConstantExpression(e, constant, errorSink)
+	}
+
+	switch t := expression.(type) {
+	case *IntegerLiteralExpression:
+		return new(big.Int).Set(&t.Value), true
+	case *FloatingPointLiteralExpression:
+		value, err := strconv.ParseFloat(t.Value, 64)
+		return value, err == nil
+	case *BooleanLiteralExpression:
+		return t.Value, true
+	case *ConstantReferenceExpression:
+		switch value := t.Definition.Value.(type) {
+		case *IntegerLiteralExpression, *FloatingPointLiteralExpression, *BooleanLiteralExpression:
+			return evaluate(value)
+		default:
+			// the referenced constant has an error
+			return nil, false
+		}
+	case *TypeConversionExpression:
+		inner, ok := evaluate(t.Expression)
+		if !ok {
+			return nil, false
+		}
+
+		kind, _ := GetKindIfPrimitive(t.Type)
+		switch inner := inner.(type) {
+		case *big.Int:
+			if kind == PrimitiveKindFloatingPoint {
+				value, _ := new(big.Float).SetInt(inner).Float64()
+				return value, true
+			}
+		case float64:
+			if kind == PrimitiveKindInteger {
+				value, _ := big.NewFloat(inner).Int(nil)
+				return value, true
+			}
+		}
+		return inner, true
+	case *UnaryExpression:
+		inner, ok := evaluate(t.Expression)
+		if !ok {
+			return nil, false
+		}
+
+		switch inner := inner.(type) {
+		case *big.Int:
+			return inner.Neg(inner), true
+		case float64:
+			return -inner, true
+		case bool:
+			return !inner, true
+		}
+	case *BinaryExpression:
+		left, ok := evaluate(t.Left)
+		if !ok {
+			return nil, false
+		}
+		right, ok := evaluate(t.Right)
+		if !ok {
+			return nil, false
+		}
+
+		switch left := left.(type) {
+		case *big.Int:
+			right := right.(*big.Int)
+			switch t.Operator {
+			case BinaryOpAdd:
+				return left.Add(left, right), true
+			case BinaryOpSub:
+				return left.Sub(left, right), true
+			case BinaryOpMul:
+				return left.Mul(left, right), true
+			case BinaryOpDiv:
+				if right.Sign() == 0 {
+					errorSink.Add(validationError(t, "division by zero in the value of constant '%s'", constant.Name))
+					return nil, false
+				}
+				return left.Quo(left, right), true
+			default:
+				return compareConstants(t.Operator, left.Cmp(right)), true
+			}
+		case float64:
+			right := right.(float64)
+			switch t.Operator {
+			case BinaryOpAdd:
+				return left + right, true
+			case BinaryOpSub:
+				return left - right, true
+			case BinaryOpMul:
+				return left * right, true
+			case BinaryOpDiv:
+				return left / right, true
+			case BinaryOpPow:
+				return math.Pow(left, right), true
+			default:
+				cmp := 0
+				if left < right {
+					cmp = -1
+				} else if left > right {
+					cmp = 1
+				}
+				return compareConstants(t.Operator, cmp), true
+			}
+		case bool:
+			right := right.(bool)
+			switch t.Operator {
+			case BinaryOpAnd:
+				return left && right, true
+			case BinaryOpOr:
+				return left || right, true
+			case BinaryOpEq:
+				return left == right, true
+			case BinaryOpNe:
+				return left != right, true
+			}
+		}
+	case *ConditionalExpression:
+		condition, ok := evaluate(t.Condition)
+		if !ok {
+			return nil, false
+		}
+		if condition.(bool) {
+			return evaluate(t.Then)
+		}
+		return evaluate(t.Else)
+	}
+
+	errorSink.Add(validationError(expression, "the value of constant '%s' can only be computed from literals and other constants", constant.Name))
+	return nil, false
+}
+
+func compareConstants(operator BinaryOperator, cmp int) bool {
+	switch operator {
+	case BinaryOpEq:
+		return cmp == 0
+	case BinaryOpNe:
+		return cmp != 0
+	case BinaryOpLt:
+		return cmp < 0
+	case BinaryOpLe:
+		return cmp <= 0
+	case BinaryOpGt:
+		return cmp > 0
+	default:
+		return cmp >= 0
+	}
+}
+
+// Returns the value of a constant that is used as the length of a vector or array dimension.
+func constantLength(node Node, name string, namespace string, symbolTable SymbolTable, errorSink *validation.ErrorSink) *uint64 {
+	constant, ok := lookupConstant(name, namespace, symbolTable)
+	if !ok {
+		errorSink.Add(validationError(node, "the length '%s' is not a known constant", name))
+		return nil
+	}
+
+	if kind, _ := GetKindIfPrimitive(constant.Type); constant.Type != nil && kind != PrimitiveKindInteger {
+		errorSink.Add(validationError(node, "the constant '%s' cannot be used as a length because it is not an integer", name))
+		return nil
+	}
+
+	literal, ok := constant.Value.(*IntegerLiteralExpression)
+	if !ok {
+		// there is already an error for this
+		return nil
+	}
+
+	if literal.Value.Sign() < 0 {
+		errorSink.Add(validationError(node, "the constant '%s' cannot be used as a length because it is negative", name))
+		return nil
+	}
+
+	length := literal.Value.Uint64()
+	return &length
+}
@@ -0,0 +1,197 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+package dsl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConstants(t *testing.T) {
+	src := `
+MaxChannels: !const 128
+Large: !const 5000000000
+Gain: !const 1.5
+Enabled: !const "!false"
+SampleCount: !const
+  type: uint64
+  value: MaxChannels * 4
+HalfGain: !const
+  type: float
+  value: Gain / 2
+Three: !const
+  type: double
+  value: 3
+Limit: !const (MaxChannels if Enabled else 64) - 1`
+	env, err := parseAndValidate(t, src)
+	require.NoError(t, err)
+
+	expected := []struct {
+		name      string
+		valueType Type
+		value     string
+	}{
+		{"MaxChannels", Int32Type, "128"},
+		{"Large", Int64Type, "5000000000"},
+		{"Gain", Float64Type, "1.5"},
+		{"Enabled", BoolType, "true"},
+		{"SampleCount", Uint64Type, "512"},
+		{"HalfGain", Float32Type, "0.75"},
+		{"Three", Float64Type, "3.0"},
+		{"Limit", Int32Type, "127"},
+	}
+
+	constants := env.Namespaces[0].Constants
+	require.Len(t, constants, len(expected))
+	for i, e := range expected {
+		constant := constants[i]
+		assert.Equal(t, e.name, constant.Name)
+		assert.True(t, TypesEqual(e.valueType, constant.Type), e.name)
+		assert.True(t, TypesEqual(e.valueType, constant.Value.GetResolvedType()), e.name)
+
+		var value string
+		switch v := constant.Value.(type) {
+		case *IntegerLiteralExpression:
+			value = v.Value.String()
+		case *FloatingPointLiteralExpression:
+			value = v.Value
+		case *BooleanLiteralExpression:
+			if v.Value {
+				value = "true"
+			} else {
+				value = "false"
+			}
+		}
+		assert.Equal(t, e.value, value, e.name)
+	}
+}
+
+func TestConstantReferences(t *testing.T) {
+	src := `
+MaxChannels: !const 4
+Gain: !const
+  type: float
+  value: 2
+X: !record
+  fields:
+    a: !vector
+      items: int
+      length: MaxChannels
+    b: int[x:MaxChannels, y:2]
+    c: !array
+      items: int
+      dimensions:
+        x: test.MaxChannels
+    d:
+      type: uint8
+      default: MaxChannels
+  computedFields:
+    e: MaxChannels * 2
+    f: Gain`
+	env, err := parseAndValidate(t, src)
+	require.NoError(t, err)
+
+	rec := env.SymbolTable["test.X"].(*RecordDefinition)
+	assert.Equal(t, "int32*4", TypeToShortSyntax(rec.Fields[0].Type, false))
+	assert.Equal(t, "int32[x:4, y:2]", TypeToShortSyntax(rec.Fields[1].Type, false))
+	assert.Equal(t, "int32[x:4]", TypeToShortSyntax(rec.Fields[2].Type, false))
+
+	defaultValue := rec.Fields[3].Default.(*TypeConversionExpression)
+	assert.True(t, TypesEqual(Uint8Type, defaultValue.GetResolvedType()))
+	assert.Equal(t, "MaxChannels", defaultValue.Expression.(*ConstantReferenceExpression).Definition.Name)
+
+	assert.True(t, TypesEqual(Int32Type, rec.ComputedFields[0].Expression.GetResolvedType()))
+	assert.True(t, TypesEqual(Float32Type, rec.ComputedFields[1].Expression.GetResolvedType()))
+}
+
+func TestConstantCycle(t *testing.T) {
+	src := `
+A: !const B + 1
+B: !const A + 1`
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, "cycle detected in constants: A -> B -> A")
+}
+
+func TestConstantMustBeConstant(t *testing.T) {
+	src := `
+A: !const x + 1`
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, "'x' cannot be referenced here because the expression must be constant")
+}
+
+func TestConstantInvalidType(t *testing.T) {
+	src := `
+A: !const '"abc"'
+B: !const
+  type: uint8
+  value: 1.5
+C: !const
+  type: int*
+  value: 1`
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, "constant 'A' must have an integer, floating-point, or bool type, but has type 'string'")
+	assert.ErrorContains(t, err, "a value of type 'float64' cannot be assigned to constant 'B' of type 'uint8'")
+	assert.ErrorContains(t, err, "constant 'C' must have an integer, floating-point, or bool type, but has type 'int32*'")
+}
+
+func TestConstantOutOfRange(t *testing.T) {
+	src := `
+A: !const
+  type: uint8
+  value: 200 + 100`
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, "the value 300 of constant 'A' is out of range for the type 'uint8'")
+}
+
+func TestConstantDivisionByZero(t *testing.T) {
+	src := `
+A: !const 1 / (2 - 2)`
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, "division by zero in the value of constant 'A'")
+}
+
+func TestConstantNotComputable(t *testing.T) {
+	src := `
+A: !const abs(-1)`
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, "the value of constant 'A' can only be computed from literals and other constants")
+}
+
+func TestConstantUsedAsType(t *testing.T) {
+	src := `
+A: !const 1
+X: !record
+  fields:
+    a: A`
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, "'A' is a constant and cannot be used as a type")
+}
+
+func TestConstantLengths(t *testing.T) {
+	src := `
+Negative: !const -1
+Fractional: !const 1.5
+X: !record
+  fields:
+    a: int[x:Negative]
+    b: !vector
+      items: int
+      length: Fractional
+    c: !vector
+      items: int
+      length: Unknown`
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, "the constant 'Negative' cannot be used as a length because it is negative")
+	assert.ErrorContains(t, err, "the constant 'Fractional' cannot be used as a length because it is not an integer")
+	assert.ErrorContains(t, err, "the length 'Unknown' is not a known constant")
+}
+
+func TestConstantTypeParameters(t *testing.T) {
+	src := `
+A<T>: !const 1`
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, "a !const cannot have type parameters")
+}
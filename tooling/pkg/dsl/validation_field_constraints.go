@@ -0,0 +1,243 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+package dsl
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+
+	"github.com/microsoft/yardl/tooling/internal/validation"
+)
+
+// Resolves the constraints of record fields and validates them against the
+// types of the fields. The bounds of numeric fields are resolved like default
+// values, and must be literals.
+func validateFieldConstraints(env *Environment, errorSink *validation.ErrorSink) *Environment {
+	if len(errorSink.Errors) > 0 {
+		return env
+	}
+
+	resolve := expressionResolver(env, errorSink)
+	return RewriteWithContext(env, &ComputedFieldScope{}, func(node Node, context *ComputedFieldScope, self *RewriterWithContext[*ComputedFieldScope]) Node {
+		switch t := node.(type) {
+		case *RecordDefinition:
+			return self.DefaultRewrite(t, &ComputedFieldScope{Namespace: t.Namespace})
+		case *Field:
+			rewrittenField := rewriteFieldType(t, context, self)
+			if t.Constraints == nil {
+				return rewrittenField
+			}
+
+			resolved := self.Rewrite(t.Constraints, context).(*FieldConstraints)
+			if rewrittenField == t {
+				copy := *t
+				rewrittenField = &copy
+			}
+			rewrittenField.Constraints = checkFieldConstraints(t, resolved, errorSink)
+			return rewrittenField
+		case *ComputedField:
+			return t
+		default:
+			return resolve(node, context, self)
+		}
+	}).(*Environment)
+}
+
+func checkFieldConstraints(field *Field, constraints *FieldConstraints, errorSink *validation.ErrorSink) *FieldConstraints {
+	if TypeContainsGenericTypeParameter(field.Type) {
+		errorSink.Add(validationError(constraints, "constraints cannot be given to field '%s' because its type is generic", field.Name))
+		return constraints
+	}
+
+	checked := *constraints
+	constrainedType, _ := GetConstrainedType(field)
+	underlyingType := GetUnderlyingType(constrainedType)
+	primitive, isPrimitive := GetPrimitiveType(underlyingType)
+	typeName := TypeToShortSyntax(constrainedType, true)
+
+	if checked.Min != nil || checked.Max != nil {
+		kind := GetPrimitiveKind(primitive)
+		if !isPrimitive || (kind != PrimitiveKindInteger && kind != PrimitiveKindFloatingPoint) {
+			errorSink.Add(validationError(constraints, "`min` and `max` constraints cannot be given to field '%s' because its type '%s' is not an integer or floating-point type", field.Name, typeName))
+		} else {
+			fieldType := underlyingType.(*SimpleType)
+			checked.Min = constraintBound(field, fieldType, checked.Min, "minimum", errorSink)
+			checked.Max = constraintBound(field, fieldType, checked.Max, "maximum", errorSink)
+
+			min, minOk := literalValue(checked.Min)
+			max, maxOk := literalValue(checked.Max)
+			if minOk && maxOk && min.Cmp(max) > 0 {
+				errorSink.Add(validationError(constraints, "the minimum of field '%s' is greater than its maximum", field.Name))
+			}
+		}
+	}
+
+	if checked.Pattern != "" {
+		if !isPrimitive || primitive != String {
+			errorSink.Add(validationError(constraints, "a `pattern` constraint cannot be given to field '%s' because its type '%s' is not a string", field.Name, typeName))
+		} else if _, err := regexp.Compile(checked.Pattern); err != nil {
+			errorSink.Add(validationError(constraints, "the pattern of field '%s' is not a valid regular expression: %v", field.Name, err))
+		} else if unsupported := unsupportedPatternSyntax(checked.Pattern); unsupported != "" {
+			errorSink.Add(validationError(constraints, "the pattern of field '%s' uses %s, which is not in the regular expression syntax that patterns are restricted to", field.Name, unsupported))
+		}
+	}
+
+	if checked.MinLength != nil || checked.MaxLength != nil {
+		var vector *Vector
+		if t, ok := underlyingType.(*GeneralizedType); ok {
+			vector, _ = t.Dimensionality.(*Vector)
+		}
+
+		switch {
+		case vector == nil:
+			errorSink.Add(validationError(constraints, "`minLength` and `maxLength` constraints cannot be given to field '%s' because its type '%s' is not a vector", field.Name, typeName))
+		case vector.IsFixed():
+			errorSink.Add(validationError(constraints, "`minLength` and `maxLength` constraints cannot be given to field '%s' because it is a vector of fixed length", field.Name))
+		case checked.MinLength != nil && checked.MaxLength != nil && *checked.MinLength > *checked.MaxLength:
+			errorSink.Add(validationError(constraints, "the minimum length of field '%s' is greater than its maximum length", field.Name))
+		}
+	}
+
+	return &checked
+}
+
+// The characters that can be escaped in patterns to match them literally.
+const patternMetacharacters = `\.+*?()[]{}|^$-`
+
+// Patterns are evaluated by the regular expression library of each language,
+// so they are restricted to syntax that all of these interpret the same way:
+// printable ASCII characters, escaped metacharacters, \d and \w, character
+// classes that are not negated, groups, alternations and quantifiers.
+// Constructs that can match non-ASCII characters, like '.' and negated classes,
+// are excluded, since C++ matches strings byte by byte and the other languages
+// character by character.
+// Returns a description of the first construct outside of this syntax, or ""
+// if there is none. The pattern must already be a valid RE2 expression.
+func unsupportedPatternSyntax(pattern string) string {
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		if c < ' ' || c > '~' {
+			return "a character that is not printable ASCII"
+		}
+
+		switch c {
+		case '\\':
+			i++
+			if pattern[i] != 'd' && pattern[i] != 'w' && !strings.ContainsRune(patternMetacharacters, rune(pattern[i])) {
+				return fmt.Sprintf("the escape sequence `%s`", pattern[i-1:i+1])
+			}
+		case '.':
+			return "`.`, which matches different characters in different languages"
+		case '^', '$':
+			return fmt.Sprintf("the anchor `%c`, while patterns always match the whole string", c)
+		case ']', '}':
+			return fmt.Sprintf("an unescaped `%c`", c)
+		case '(':
+			if strings.HasPrefix(pattern[i+1:], "?") && !strings.HasPrefix(pattern[i+1:], "?:") {
+				return "a group starting with `(?` other than `(?:`"
+			}
+		case '{':
+			end := strings.IndexByte(pattern[i:], '}')
+			if end < 0 || !patternRepetition.MatchString(pattern[i:i+end+1]) {
+				return "a `{` that does not start a repetition `{n}`, `{n,}` or `{n,m}`"
+			}
+			i += end
+		case '[':
+			end, unsupported := unsupportedPatternClassSyntax(pattern, i+1)
+			if unsupported != "" {
+				return unsupported
+			}
+			i = end
+		}
+	}
+
+	return ""
+}
+
+var patternRepetition = regexp.MustCompile(`^\{[0-9]+(,[0-9]*)?\}$`)
+
+// Checks the character class that starts at the given index, just after its
+// opening `[`, and returns the index of its closing `]`.
+func unsupportedPatternClassSyntax(pattern string, start int) (int, string) {
+	if start < len(pattern) && (pattern[start] == '^' || pattern[start] == ']') {
+		return 0, fmt.Sprintf("a character class starting with `[%c`", pattern[start])
+	}
+
+	// Whether the previous item in the class is a single character that can
+	// start a range
+	rangeStart := false
+	for i := start; i < len(pattern); i++ {
+		c := pattern[i]
+		switch c {
+		case ']':
+			return i, ""
+		case '[':
+			return 0, "an unescaped `[` in a character class"
+		case '\\':
+			i++
+			if pattern[i] == 'd' || pattern[i] == 'w' {
+				rangeStart = false
+				continue
+			}
+			if !strings.ContainsRune(patternMetacharacters, rune(pattern[i])) {
+				return 0, fmt.Sprintf("the escape sequence `%s`", pattern[i-1:i+1])
+			}
+			rangeStart = true
+		case '-':
+			if !rangeStart || i+1 == len(pattern) || strings.IndexByte(`]\[-`, pattern[i+1]) >= 0 || pattern[i+1] < ' ' || pattern[i+1] > '~' {
+				return 0, "a `-` in a character class that is not between the two ends of a range (escape it as `\\-`)"
+			}
+			i++
+			rangeStart = false
+		default:
+			if c < ' ' || c > '~' {
+				return 0, "a character that is not printable ASCII"
+			}
+			if (c == '&' || c == '|' || c == '~') && i+1 < len(pattern) && pattern[i+1] == c {
+				return 0, fmt.Sprintf("`%c%c` in a character class, which Python reserves for set operations", c, c)
+			}
+			rangeStart = true
+		}
+	}
+
+	// Unreachable for valid expressions
+	return len(pattern), ""
+}
+
+// Validates that the bound of a numeric field is a literal that is assignable to
+// the field's type, returning it as a literal of that type.
+func constraintBound(field *Field, fieldType *SimpleType, bound Expression, description string, errorSink *validation.ErrorSink) Expression {
+	if bound == nil || bound.GetResolvedType() == nil {
+		return bound
+	}
+
+	if _, ok := literalValue(bound); !ok {
+		errorSink.Add(validationError(bound, "the %s of field '%s' must be a numeric literal", description, field.Name))
+		return bound
+	}
+
+	converted := convertToFieldType(field, fieldType, bound, description, errorSink)
+	if conversion, ok := converted.(*TypeConversionExpression); ok {
+		if literal, ok := conversion.Expression.(*IntegerLiteralExpression); ok {
+			// An integer bound of a floating-point field, written like a floating-point literal
+			return &FloatingPointLiteralExpression{NodeMeta: literal.NodeMeta, Value: literal.Value.String() + ".0", ResolvedType: fieldType}
+		}
+	}
+
+	return converted
+}
+
+func literalValue(expression Expression) (*big.Float, bool) {
+	switch t := expression.(type) {
+	case *IntegerLiteralExpression:
+		return new(big.Float).SetInt(&t.Value), true
+	case *FloatingPointLiteralExpression:
+		value, _, err := big.ParseFloat(t.Value, 0, 256, big.ToNearestEven)
+		return value, err == nil
+	default:
+		return nil, false
+	}
+}
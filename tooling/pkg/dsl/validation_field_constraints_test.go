@@ -0,0 +1,343 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+package dsl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFieldConstraints(t *testing.T) {
+	src := `
+X: !record
+  constraints:
+    matrixSize:
+      min: 1
+      max: 4096
+    gain:
+      min: 0
+      max: 2.5
+    offset:
+      min: -0.5
+    name:
+      pattern: "[a-z]+"
+    items:
+      minLength: 1
+      maxLength: 10
+    optionalSize:
+      max: 10
+  fields:
+    matrixSize: uint32
+    gain: float
+    offset: double
+    name: string
+    items: int*
+    optionalSize: Size?
+    unconstrained: int
+
+Size: int`
+	env, err := parseAndValidate(t, src)
+	require.Nil(t, err)
+
+	fields := env.SymbolTable["test.X"].(*RecordDefinition).Fields
+	matrixSize := fields[0].Constraints
+	assert.Equal(t, "1", matrixSize.Min.(*IntegerLiteralExpression).Value.String())
+	assert.True(t, TypesEqual(Uint32Type, matrixSize.Min.GetResolvedType()))
+	assert.Equal(t, "4096", matrixSize.Max.(*IntegerLiteralExpression).Value.String())
+
+	gain := fields[1].Constraints
+	assert.Equal(t, "0.0", gain.Min.(*FloatingPointLiteralExpression).Value)
+	assert.True(t, TypesEqual(Float32Type, gain.Min.GetResolvedType()))
+	assert.True(t, TypesEqual(Float32Type, gain.Max.GetResolvedType()))
+
+	assert.Equal(t, "-0.5", fields[2].Constraints.Min.(*FloatingPointLiteralExpression).Value)
+
+	assert.Equal(t, "[a-z]+", fields[3].Constraints.Pattern)
+	assert.Equal(t, uint64(1), *fields[4].Constraints.MinLength)
+	assert.Equal(t, uint64(10), *fields[4].Constraints.MaxLength)
+	assert.NotNil(t, fields[5].Constraints.Max)
+	assert.Nil(t, fields[6].Constraints)
+}
+
+func TestFieldConstraintsHaveNoEffectOnSchema(t *testing.T) {
+	constrained := `
+X: !record
+  fields:
+    a: int
+  constraints:
+    a:
+      min: 1
+P: !protocol
+  sequence:
+    x: X`
+	unconstrained := `
+X: !record
+  fields:
+    a: int
+P: !protocol
+  sequence:
+    x: X`
+
+	constrainedEnv, err := parseAndValidate(t, constrained)
+	require.Nil(t, err)
+	unconstrainedEnv, err := parseAndValidate(t, unconstrained)
+	require.Nil(t, err)
+
+	assert.Equal(t,
+		GetProtocolSchemaString(unconstrainedEnv.Namespaces[0].Protocols[0], unconstrainedEnv.SymbolTable),
+		GetProtocolSchemaString(constrainedEnv.Namespaces[0].Protocols[0], constrainedEnv.SymbolTable))
+}
+
+func TestFieldConstraintsOnRecordReferencedByField(t *testing.T) {
+	src := `
+X: !record
+  fields:
+    a: int
+  constraints:
+    a:
+      min: 1
+Y: !record
+  fields:
+    x: X
+P: !protocol
+  sequence:
+    x: X
+    y: Y`
+
+	env, err := parseAndValidate(t, src)
+	require.Nil(t, err)
+
+	// The field of Y refers to the same definition of X as the protocol
+	schema := GetProtocolSchema(env.Namespaces[0].Protocols[0], env.SymbolTable)
+	assert.Len(t, schema.Types, 2)
+}
+
+func TestFieldConstraintsUnknownField(t *testing.T) {
+	src := `
+X: !record
+  fields:
+    a: int
+  constraints:
+    b:
+      min: 1`
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, "constraints are given for 'b', which is not a field of the record")
+}
+
+func TestFieldConstraintsInvalidKey(t *testing.T) {
+	src := `
+X: !record
+  fields:
+    a: int
+  constraints:
+    a:
+      minimum: 1`
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, "field 'minimum' is not valid on a field constraints specification")
+}
+
+func TestFieldConstraintsNegativeLength(t *testing.T) {
+	src := `
+X: !record
+  fields:
+    a: int*
+  constraints:
+    a:
+      maxLength: -1`
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, "a length must be a non-negative 64-bit integer")
+}
+
+func TestFieldConstraintsMinOnString(t *testing.T) {
+	src := `
+X: !record
+  fields:
+    a: string
+  constraints:
+    a:
+      min: 1`
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, "`min` and `max` constraints cannot be given to field 'a' because its type 'string' is not an integer or floating-point type")
+}
+
+func TestFieldConstraintsBoundTypeMismatch(t *testing.T) {
+	src := `
+X: !record
+  fields:
+    a: int
+  constraints:
+    a:
+      max: 1.5`
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, "a maximum of type 'float64' cannot be assigned to field 'a' of type 'int32'")
+}
+
+func TestFieldConstraintsBoundOutOfRange(t *testing.T) {
+	src := `
+X: !record
+  fields:
+    a: uint8
+  constraints:
+    a:
+      max: 256`
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, "the maximum 256 of field 'a' is out of range for the type 'uint8'")
+}
+
+func TestFieldConstraintsBoundNotLiteral(t *testing.T) {
+	src := `
+X: !record
+  fields:
+    a: int
+  constraints:
+    a:
+      max: 2 * 3`
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, "the maximum of field 'a' must be a numeric literal")
+}
+
+func TestFieldConstraintsMinGreaterThanMax(t *testing.T) {
+	src := `
+X: !record
+  fields:
+    a: float
+  constraints:
+    a:
+      min: 2
+      max: 1.5`
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, "the minimum of field 'a' is greater than its maximum")
+}
+
+func TestFieldConstraintsInvalidPattern(t *testing.T) {
+	src := `
+X: !record
+  fields:
+    a: string
+  constraints:
+    a:
+      pattern: "[a-z"`
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, "the pattern of field 'a' is not a valid regular expression")
+}
+
+func TestFieldConstraintsPatternSyntax(t *testing.T) {
+	supported := []string{
+		`[a-z][a-z0-9_]*`,
+		`a|ab`,
+		`\w+`,
+		`\d{2,3}(?:\-\d{2})?`,
+		`x{2,}y*?`,
+		`\(\.\)[\-+*]`,
+		`[A-Z\d\w.$^\]]+`,
+		`()`,
+	}
+	for _, pattern := range supported {
+		t.Run(pattern, func(t *testing.T) {
+			assert.Equal(t, "", unsupportedPatternSyntax(pattern))
+		})
+	}
+
+	unsupported := map[string]string{
+		`(?i)abc`:     "a group starting with `(?` other than `(?:`",
+		`(?P<x>a)`:    "a group starting with `(?` other than `(?:`",
+		`(?=a)a`:      "a group starting with `(?` other than `(?:`",
+		`\pL`:         "the escape sequence `\\p`",
+		`\s+`:         "the escape sequence `\\s`",
+		`(a)\1`:       "the escape sequence `\\1`",
+		`\bab`:        "the escape sequence `\\b`",
+		`a.c`:         "`.`, which matches different characters in different languages",
+		`^abc$`:       "the anchor `^`, while patterns always match the whole string",
+		`abc$`:        "the anchor `$`, while patterns always match the whole string",
+		`[^a]`:        "a character class starting with `[^`",
+		`[]a]`:        "a character class starting with `[]`",
+		`[[:alpha:]]`: "an unescaped `[` in a character class",
+		`[\s]`:        "the escape sequence `\\s`",
+		`[-a]`:        "a `-` in a character class that is not between the two ends of a range",
+		`[\d-z]`:      "a `-` in a character class that is not between the two ends of a range",
+		`[a&&b]`:      "`&&` in a character class",
+		`a{,3}`:       "a `{` that does not start a repetition",
+		`a]`:          "an unescaped `]`",
+		"caf\u00e9":   "a character that is not printable ASCII",
+		"[\u00e9]":    "a character that is not printable ASCII",
+		"a\tb":        "a character that is not printable ASCII",
+	}
+	for pattern, expected := range unsupported {
+		t.Run(pattern, func(t *testing.T) {
+			assert.Contains(t, unsupportedPatternSyntax(pattern), expected)
+		})
+	}
+}
+
+func TestFieldConstraintsUnsupportedPattern(t *testing.T) {
+	src := `
+X: !record
+  fields:
+    a: string
+  constraints:
+    a:
+      pattern: "(?i)abc"`
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, "the pattern of field 'a' uses a group starting with `(?` other than `(?:`, which is not in the regular expression syntax that patterns are restricted to")
+}
+
+func TestFieldConstraintsLengthOnFixedVector(t *testing.T) {
+	src := `
+X: !record
+  fields:
+    a: int*3
+  constraints:
+    a:
+      maxLength: 2`
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, "because it is a vector of fixed length")
+}
+
+func TestFieldConstraintsGenericType(t *testing.T) {
+	src := `
+X<T>: !record
+  fields:
+    a: T
+  constraints:
+    a:
+      min: 1`
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, "constraints cannot be given to field 'a' because its type is generic")
+}
+
+func TestTypeHasConstraints(t *testing.T) {
+	src := `
+Constrained: !record
+  fields:
+    a: int
+  constraints:
+    a:
+      min: 0
+
+Outer: !record
+  fields:
+    inner: Constrained?*
+
+Generic<T>: !record
+  fields:
+    t: T
+
+Unconstrained: !record
+  fields:
+    a: int
+    generic: Generic<Constrained>
+    union: [Constrained, int]`
+	env, err := parseAndValidate(t, src)
+	require.Nil(t, err)
+
+	typeOf := func(name string) Type {
+		return &SimpleType{Name: name, ResolvedDefinition: env.SymbolTable[name]}
+	}
+
+	assert.True(t, TypeHasConstraints(typeOf("test.Constrained"), env.SymbolTable))
+	assert.True(t, TypeHasConstraints(typeOf("test.Outer"), env.SymbolTable))
+	assert.False(t, TypeHasConstraints(typeOf("test.Unconstrained"), env.SymbolTable))
+}
@@ -0,0 +1,145 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+package dsl
+
+import (
+	"math/big"
+
+	"github.com/microsoft/yardl/tooling/internal/validation"
+)
+
+// Resolves the default values of record fields. These are constant expressions
+// that are resolved like computed fields, except that they cannot refer to
+// fields, and must be assignable to the type of the field.
+func resolveFieldDefaults(env *Environment, errorSink *validation.ErrorSink) *Environment {
+	if len(errorSink.Errors) > 0 {
+		return env
+	}
+
+	resolve := expressionResolver(env, errorSink)
+	return RewriteWithContext(env, &ComputedFieldScope{}, func(node Node, context *ComputedFieldScope, self *RewriterWithContext[*ComputedFieldScope]) Node {
+		switch t := node.(type) {
+		case *RecordDefinition:
+			return self.DefaultRewrite(t, &ComputedFieldScope{Namespace: t.Namespace})
+		case *Field:
+			rewrittenField := rewriteFieldType(t, context, self)
+			if t.Default == nil {
+				return rewrittenField
+			}
+
+			resolved := self.Rewrite(t.Default, context).(Expression)
+			if rewrittenField == t {
+				copy := *t
+				rewrittenField = &copy
+			}
+			rewrittenField.Default = convertFieldDefault(t, resolved, errorSink)
+			return rewrittenField
+		case *ComputedField:
+			return t
+		default:
+			return resolve(node, context, self)
+		}
+	}).(*Environment)
+}
+
+// Rewrites the type of a field, so that it refers to rewritten type
+// definitions, leaving the rest of the field unchanged.
+func rewriteFieldType[T any](field *Field, context T, rewriter *RewriterWithContext[T]) *Field {
+	rewrittenType := rewriter.Rewrite(field.Type, context).(Type)
+	if rewrittenType == field.Type {
+		return field
+	}
+
+	rewrittenField := *field
+	rewrittenField.Type = rewrittenType
+	return &rewrittenField
+}
+
+// Validates that the resolved default value of a field is assignable to the
+// field's type, returning it converted to that type.
+func convertFieldDefault(field *Field, value Expression, errorSink *validation.ErrorSink) Expression {
+	valueType := value.GetResolvedType()
+	if valueType == nil {
+		// there is already an error for this
+		return value
+	}
+
+	if TypeContainsGenericTypeParameter(field.Type) {
+		errorSink.Add(validationError(value, "a default value cannot be given to field '%s' because its type is generic", field.Name))
+		return value
+	}
+
+	fieldType, ok := GetUnderlyingType(field.Type).(*SimpleType)
+	if !ok {
+		errorSink.Add(validationError(value, "a default value cannot be given to field '%s' because default values are only supported on fields of primitive or enum types", field.Name))
+		return value
+	}
+
+	return convertToFieldType(field, fieldType, value, "default value", errorSink)
+}
+
+// Validates that a constant value is assignable to a field whose underlying
+// type is fieldType, returning it converted to that type. The description of
+// the value is used in error messages.
+func convertToFieldType(field *Field, fieldType *SimpleType, value Expression, description string, errorSink *validation.ErrorSink) Expression {
+	valueType := value.GetResolvedType()
+	if primitive, ok := fieldType.ResolvedDefinition.(PrimitiveDefinition); ok {
+		valueKind, valueIsPrimitive := GetKindIfPrimitive(valueType)
+		assignable := false
+		if valueIsPrimitive {
+			switch GetPrimitiveKind(primitive) {
+			case PrimitiveKindInteger:
+				assignable = valueKind == PrimitiveKindInteger
+			case PrimitiveKindFloatingPoint:
+				assignable = valueKind == PrimitiveKindInteger || valueKind == PrimitiveKindFloatingPoint
+			case PrimitiveKindComplexFloatingPoint:
+				assignable = valueKind != PrimitiveKindOther
+			}
+		}
+
+		if assignable {
+			literal, ok := value.(*IntegerLiteralExpression)
+			if reference, isReference := value.(*ConstantReferenceExpression); isReference {
+				literal, ok = reference.Definition.Value.(*IntegerLiteralExpression)
+			}
+			if ok && GetPrimitiveKind(primitive) == PrimitiveKindInteger {
+				min, max := integerRange(primitive)
+				if literal.Value.Cmp(min) < 0 || literal.Value.Cmp(max) > 0 {
+					errorSink.Add(validationError(value, "the %s %s of field '%s' is out of range for the type '%s'", description, literal.Value.String(), field.Name, primitive))
+					return value
+				}
+			}
+
+			return insertConversion(value, fieldType)
+		}
+	}
+
+	if TypesEqual(GetUnderlyingType(valueType), fieldType) {
+		return value
+	}
+
+	errorSink.Add(validationError(value, "a %s of type '%s' cannot be assigned to field '%s' of type '%s'", description, TypeToShortSyntax(valueType, true), field.Name, TypeToShortSyntax(field.Type, true)))
+	return value
+}
+
+func integerRange(primitive PrimitiveDefinition) (min, max *big.Int) {
+	switch primitive {
+	case Int8:
+		return MinInt8, MaxInt8
+	case Uint8:
+		return Zero, MaxUint8
+	case Int16:
+		return MinInt16, MaxInt16
+	case Uint16:
+		return Zero, MaxUint16
+	case Int32:
+		return MinInt32, MaxInt32
+	case Uint32:
+		return Zero, MaxUint32
+	case Int64:
+		return MinInt64, MaxInt64
+	default:
+		return Zero, MaxUint64
+	}
+}
@@ -0,0 +1,211 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+package dsl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFieldDefaults(t *testing.T) {
+	src := `
+X: !record
+  fields:
+    gain:
+      type: float
+      default: 1.5
+    count:
+      type: uint8
+      default: 3
+    scale:
+      type: double
+      default: 2 * 4
+    name:
+      type: string
+      default: '"abc"'
+    enabled:
+      type: bool
+      default: true
+    mode:
+      type: Mode
+      default: Mode.fast
+    aliasedMode:
+      type: ModeAlias
+      default: ModeAlias.slow
+    noDefault: int
+
+Mode: !enum
+  values:
+    - slow
+    - fast
+
+ModeAlias: Mode`
+	env, err := parseAndValidate(t, src)
+	require.Nil(t, err)
+
+	fields := env.SymbolTable["test.X"].(*RecordDefinition).Fields
+	assert.True(t, TypesEqual(Float32Type, fields[0].Default.GetResolvedType()))
+	assert.IsType(t, &FloatingPointLiteralExpression{}, fields[0].Default)
+	assert.True(t, TypesEqual(Uint8Type, fields[1].Default.GetResolvedType()))
+	assert.IsType(t, &TypeConversionExpression{}, fields[2].Default)
+	assert.True(t, TypesEqual(StringType, fields[3].Default.GetResolvedType()))
+	assert.Equal(t, true, fields[4].Default.(*BooleanLiteralExpression).Value)
+	assert.Equal(t, "fast", fields[5].Default.(*EnumValueExpression).Value.Symbol)
+	assert.Equal(t, "slow", fields[6].Default.(*EnumValueExpression).Value.Symbol)
+	assert.Nil(t, fields[7].Default)
+}
+
+func TestFieldDefaultEnumInOtherNamespace(t *testing.T) {
+	src := `
+X: !record
+  fields:
+    mode:
+      type: Mode
+      default: test.Mode.fast
+
+Mode: !enum
+  values:
+    - slow
+    - fast`
+	_, err := parseAndValidate(t, src)
+	assert.Nil(t, err)
+}
+
+func TestFieldDefaultTypeMismatch(t *testing.T) {
+	src := `
+X: !record
+  fields:
+    count:
+      type: int
+      default: 1.5`
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, "a default value of type 'float64' cannot be assigned to field 'count' of type 'int32'")
+}
+
+func TestFieldDefaultOutOfRange(t *testing.T) {
+	src := `
+X: !record
+  fields:
+    count:
+      type: uint8
+      default: -1`
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, "the default value -1 of field 'count' is out of range for the type 'uint8'")
+}
+
+func TestFieldDefaultWrongEnum(t *testing.T) {
+	src := `
+X: !record
+  fields:
+    mode:
+      type: Mode
+      default: Other.a
+
+Mode: !enum
+  values:
+    - a
+
+Other: !enum
+  values:
+    - a`
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, "a default value of type 'test.Other' cannot be assigned to field 'mode' of type 'test.Mode'")
+}
+
+func TestFieldDefaultUnknownEnumValue(t *testing.T) {
+	src := `
+X: !record
+  fields:
+    mode:
+      type: Mode
+      default: Mode.b
+
+Mode: !enum
+  values:
+    - a`
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, "'b' is not a value of 'Mode'")
+}
+
+func TestFieldDefaultReferencesField(t *testing.T) {
+	src := `
+X: !record
+  fields:
+    a: int
+    b:
+      type: int
+      default: a`
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, "'a' cannot be referenced here because the expression must be constant")
+}
+
+func TestFieldDefaultUnsupportedType(t *testing.T) {
+	src := `
+X: !record
+  fields:
+    a:
+      type: int*
+      default: 1`
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, "default values are only supported on fields of primitive or enum types")
+}
+
+func TestFieldDefaultGenericType(t *testing.T) {
+	src := `
+X<T>: !record
+  fields:
+    a:
+      type: T
+      default: 1
+    b:
+      type: int
+      default: 1`
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, "a default value cannot be given to field 'a' because its type is generic")
+}
+
+func TestFieldDefaultOnProtocolStep(t *testing.T) {
+	src := `
+P: !protocol
+  sequence:
+    a:
+      type: int
+      default: 1`
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, "default values can only be specified on record fields")
+}
+
+func TestFieldDefaultInvalidKey(t *testing.T) {
+	src := `
+X: !record
+  fields:
+    a:
+      type: int
+      value: 1`
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, "field 'value' is not valid on a field specification")
+}
+
+func TestComputedFieldEnumValue(t *testing.T) {
+	src := `
+X: !record
+  fields:
+    mode: Mode
+  computedFields:
+    fast: Mode.fast
+    current: mode
+
+Mode: !enum
+  values:
+    - slow
+    - fast`
+	env, err := parseAndValidate(t, src)
+	require.Nil(t, err)
+
+	computedFields := env.SymbolTable["test.X"].(*RecordDefinition).ComputedFields
+	assert.IsType(t, &EnumValueExpression{}, computedFields[0].Expression)
+	assert.IsType(t, &MemberAccessExpression{}, computedFields[1].Expression)
+}
@@ -0,0 +1,175 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+package dsl
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/microsoft/yardl/tooling/internal/validation"
+)
+
+// Returns the generic protocol that a named type instantiates (e.g.
+// `FloatImageStream: ImageStream<float>`), or nil if the type definition
+// is not a protocol instantiation.
+func getInstantiatedGenericProtocol(t TypeDefinition, symbolTable SymbolTable) *ProtocolDefinition {
+	namedType, ok := t.(*NamedType)
+	if !ok {
+		return nil
+	}
+
+	simpleType, ok := namedType.Type.(*SimpleType)
+	if !ok {
+		return nil
+	}
+
+	if protocol, ok := simpleType.ResolvedDefinition.(*ProtocolDefinition); ok {
+		return protocol
+	}
+
+	resolved, found := lookupTypeDefinition(simpleType.Name, namedType.Namespace, symbolTable)
+	if !found {
+		return nil
+	}
+
+	protocol, ok := resolved.(*ProtocolDefinition)
+	if !ok || len(protocol.TypeParameters) == 0 {
+		return nil
+	}
+
+	return protocol
+}
+
+func resolveGenericProtocolInstantiation(namedType *NamedType, protocol *ProtocolDefinition) error {
+	if len(namedType.TypeParameters) > 0 {
+		return fmt.Errorf("'%s' cannot have generic type parameters because it is an instantiation of the protocol '%s'", namedType.Name, protocol.Name)
+	}
+
+	simpleType := namedType.Type.(*SimpleType)
+	simpleType.Name = protocol.GetQualifiedName()
+	if len(protocol.TypeParameters) != len(simpleType.TypeArguments) {
+		return fmt.Errorf("'%s' was given %d type argument(s) but has %d type parameter(s)", protocol.Name, len(simpleType.TypeArguments), len(protocol.TypeParameters))
+	}
+
+	simpleType.ResolvedDefinition = protocol
+	return nil
+}
+
+// Replaces each named type that instantiates a generic protocol with a
+// closed protocol definition that has the named type's name. The closed
+// protocols follow their generic protocol in the namespace's protocol list.
+// Generic protocols are removed later, in removeGenericProtocols.
+func instantiateGenericProtocols(env *Environment, errorSink *validation.ErrorSink) *Environment {
+	if len(errorSink.Errors) > 0 {
+		return env
+	}
+
+	for _, ns := range env.Namespaces {
+		typeDefinitions := make(TypeDefinitions, 0, len(ns.TypeDefinitions))
+		var closedProtocols []*ProtocolDefinition
+		var genericProtocols []*ProtocolDefinition
+		for _, t := range ns.TypeDefinitions {
+			genericProtocol := getInstantiatedGenericProtocol(t, env.SymbolTable)
+			if genericProtocol == nil {
+				typeDefinitions = append(typeDefinitions, t)
+				continue
+			}
+
+			namedType := t.(*NamedType)
+			closedProtocol, err := instantiateGenericProtocol(namedType, genericProtocol)
+			if err != nil {
+				errorSink.Add(validationError(namedType, "%s", err.Error()))
+				continue
+			}
+
+			env.SymbolTable[namedType.GetQualifiedName()] = closedProtocol
+			closedProtocols = append(closedProtocols, closedProtocol)
+			genericProtocols = append(genericProtocols, genericProtocol)
+		}
+
+		if len(closedProtocols) == 0 {
+			continue
+		}
+
+		ns.TypeDefinitions = typeDefinitions
+
+		protocols := make([]*ProtocolDefinition, 0, len(ns.Protocols)+len(closedProtocols))
+		added := make([]bool, len(closedProtocols))
+		for _, p := range ns.Protocols {
+			protocols = append(protocols, p)
+			for i, closedProtocol := range closedProtocols {
+				if genericProtocols[i] == p {
+					protocols = append(protocols, closedProtocol)
+					added[i] = true
+				}
+			}
+		}
+
+		// Instantiations of protocols from other namespaces
+		for i, closedProtocol := range closedProtocols {
+			if !added[i] {
+				protocols = append(protocols, closedProtocol)
+			}
+		}
+
+		ns.Protocols = protocols
+	}
+
+	return env
+}
+
+func instantiateGenericProtocol(namedType *NamedType, genericProtocol *ProtocolDefinition) (*ProtocolDefinition, error) {
+	closed, err := MakeGenericType(genericProtocol, namedType.Type.(*SimpleType).TypeArguments, false)
+	if err != nil {
+		return nil, err
+	}
+
+	closedProtocol, ok := closed.(*ProtocolDefinition)
+	if !ok {
+		return nil, errors.New("internal error: unable to instantiate generic protocol")
+	}
+
+	meta := *namedType.DefinitionMeta
+	if meta.Comment == "" {
+		meta.Comment = genericProtocol.Comment
+	}
+	if meta.Annotations == nil {
+		meta.Annotations = genericProtocol.Annotations
+	}
+
+	closedProtocol.DefinitionMeta = &meta
+
+	// Implicit union case tags were derived from the generic protocol's
+	// types (e.g. "T"), so we derive them again from the closed types.
+	// This makes the instantiation identical to the equivalent non-generic protocol.
+	Visit(closedProtocol, func(self Visitor, node Node) {
+		if t, ok := node.(*GeneralizedType); ok && t.Cases.IsUnion() {
+			for _, typeCase := range t.Cases {
+				if !typeCase.ExplicitTag && !typeCase.IsNullType() {
+					typeCase.Tag = TypeToShortSyntax(typeCase.Type, false)
+				}
+			}
+		}
+
+		self.VisitChildren(node)
+	})
+
+	return closedProtocol, nil
+}
+
+// Generic protocols are only templates for their instantiations, so there
+// is no code to generate for them.
+func removeGenericProtocols(env *Environment, errorSink *validation.ErrorSink) *Environment {
+	for _, ns := range env.Namespaces {
+		protocols := make([]*ProtocolDefinition, 0, len(ns.Protocols))
+		for _, p := range ns.Protocols {
+			if len(p.TypeParameters) == 0 {
+				protocols = append(protocols, p)
+			}
+		}
+		ns.Protocols = protocols
+	}
+
+	return env
+}
@@ -0,0 +1,112 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+package dsl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenericProtocolInstantiations(t *testing.T) {
+	src := `
+Image<T>: T[]
+
+# A stream of images
+ImageStream<T>: !protocol
+  sequence:
+    images: !stream
+      items: Image<T>
+    value: [T, string]
+
+FloatImageStream: ImageStream<float>
+
+# Complex images
+ComplexImageStream: ImageStream<complexfloat>`
+
+	env, err := parseAndValidate(t, src)
+	require.Nil(t, err)
+
+	ns := env.Namespaces[0]
+	require.Len(t, ns.Protocols, 2)
+	for _, td := range ns.TypeDefinitions {
+		assert.NotContains(t, []string{"FloatImageStream", "ComplexImageStream"}, td.GetDefinitionMeta().Name)
+	}
+
+	floatStream := ns.Protocols[0]
+	assert.Equal(t, "FloatImageStream", floatStream.Name)
+	assert.Equal(t, "A stream of images", floatStream.Comment)
+	assert.Empty(t, floatStream.TypeParameters)
+	assert.Equal(t, "stream<Image<float32>>", TypeToShortSyntax(floatStream.Sequence[0].Type, false))
+	unionCases := floatStream.Sequence[1].Type.(*GeneralizedType).Cases
+	assert.Equal(t, "float32", unionCases[0].Tag)
+	primitive, _ := GetPrimitiveType(unionCases[0].Type)
+	assert.Equal(t, PrimitiveFloat32, primitive)
+
+	complexStream := ns.Protocols[1]
+	assert.Equal(t, "ComplexImageStream", complexStream.Name)
+	assert.Equal(t, "Complex images", complexStream.Comment)
+	assert.Equal(t, "complexfloat32", complexStream.Sequence[1].Type.(*GeneralizedType).Cases[0].Tag)
+
+	assert.IsType(t, &ProtocolDefinition{}, env.SymbolTable["test.FloatImageStream"])
+}
+
+func TestGenericProtocolWithoutInstantiations(t *testing.T) {
+	src := `
+MyProtocol<T>: !protocol
+  sequence:
+    s: T`
+	env, err := parseAndValidate(t, src)
+	require.Nil(t, err)
+	assert.Empty(t, env.Namespaces[0].Protocols)
+}
+
+func TestGenericProtocolInstantiationWrongTypeArgCount(t *testing.T) {
+	src := `
+MyProtocol<T>: !protocol
+  sequence:
+    s: T
+
+Closed: MyProtocol<int, float>`
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, "'MyProtocol' was given 2 type argument(s) but has 1 type parameter(s)")
+}
+
+func TestGenericProtocolInstantiationCannotBeGeneric(t *testing.T) {
+	src := `
+MyProtocol<T>: !protocol
+  sequence:
+    s: T
+
+Open<U>: MyProtocol<U>`
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, "'Open' cannot have generic type parameters because it is an instantiation of the protocol 'MyProtocol'")
+}
+
+func TestCannotReferenceGenericProtocolInstantiation(t *testing.T) {
+	src := `
+MyProtocol<T>: !protocol
+  sequence:
+    s: T
+
+Closed: MyProtocol<int>
+
+Rec: !record
+  fields:
+    f: Closed`
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, "cannot reference a protocol")
+}
+
+func TestGenericProtocolInstantiationUnionCasesValidated(t *testing.T) {
+	src := `
+MyProtocol<T>: !protocol
+  sequence:
+    s: [T, int]
+
+Closed: MyProtocol<int>`
+	_, err := parseAndValidate(t, src)
+	assert.NotNil(t, err)
+}
@@ -17,9 +17,16 @@ func validateMaps(env *Environment, errorSink *validation.ErrorSink) *Environmen
 
 		t := GetUnderlyingType(m.KeyType)
 		if st, ok := t.(*SimpleType); ok {
-			switch st.ResolvedDefinition.(type) {
-			case nil, PrimitiveDefinition:
-				return
+			switch def := st.ResolvedDefinition.(type) {
+			case nil:
+				// bytes cannot be hashed or ordered in every language
+				if primitiveTypes[st.Name] != PrimitiveBytes {
+					return
+				}
+			case PrimitiveDefinition:
+				if def != PrimitiveBytes {
+					return
+				}
 			}
 		}
 
@@ -37,3 +37,10 @@ X: !map
 	_, err := parseAndValidate(t, src)
 	assert.ErrorContains(t, err, "the type 'Missing' is not recognized")
 }
+
+func TestMapBytesKey(t *testing.T) {
+	src := `
+X: bytes->int`
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, "map key type must be a primitive scalar type")
+}
@@ -0,0 +1,128 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+package dsl
+
+import (
+	"github.com/microsoft/yardl/tooling/internal/validation"
+)
+
+// Merges the fields and computed fields of the records that a record extends
+// into the record. Inherited members come first, in the order the records are
+// extended, followed by the record's own members.
+func resolveRecordExtensions(env *Environment, errorSink *validation.ErrorSink) *Environment {
+	if len(errorSink.Errors) > 0 {
+		return env
+	}
+
+	done := make(map[*RecordDefinition]bool)
+	inProgress := make(map[*RecordDefinition]bool)
+
+	var resolveRecord func(rec *RecordDefinition)
+	resolveRecord = func(rec *RecordDefinition) {
+		if done[rec] || inProgress[rec] {
+			// cycles are reported by topologicalSortTypes
+			return
+		}
+
+		inProgress[rec] = true
+		defer func() {
+			delete(inProgress, rec)
+			done[rec] = true
+		}()
+
+		if len(rec.Extends) == 0 {
+			return
+		}
+
+		// the name of the record each inherited member comes from
+		origins := make(map[string]string)
+		var fields Fields
+		var computedFields ComputedFields
+		for _, extendedType := range rec.Extends {
+			base, ok := extendedRecord(extendedType, env.SymbolTable)
+			if !ok {
+				errorSink.Add(validationError(extendedType, "'%s' cannot be extended because it is not a record", TypeToShortSyntax(extendedType, false)))
+				continue
+			}
+
+			resolveRecord(base)
+
+			baseName := base.Name
+			if len(base.TypeParameters) > 0 {
+				instance, err := MakeGenericType(base, extendedType.(*SimpleType).TypeArguments, false)
+				if err != nil {
+					errorSink.Add(validationError(extendedType, "%s", err.Error()))
+					continue
+				}
+				base = instance.(*RecordDefinition)
+			}
+
+			addOrigin := func(name string) bool {
+				if other, found := origins[name]; found {
+					errorSink.Add(validationError(extendedType, "record '%s' inherits a member named '%s' from both '%s' and '%s'", rec.Name, name, other, baseName))
+					return false
+				}
+				origins[name] = baseName
+				return true
+			}
+
+			for _, field := range base.Fields {
+				if addOrigin(field.Name) {
+					clone := *field
+					fields = append(fields, &clone)
+				}
+			}
+
+			for _, computedField := range base.ComputedFields {
+				if addOrigin(computedField.Name) {
+					clone := *computedField
+					computedFields = append(computedFields, &clone)
+				}
+			}
+		}
+
+		for _, field := range rec.Fields {
+			if origin, found := origins[field.Name]; found {
+				errorSink.Add(validationError(field, "field '%s' conflicts with the member of the same name inherited from '%s'", field.Name, origin))
+			}
+		}
+
+		for _, computedField := range rec.ComputedFields {
+			if origin, found := origins[computedField.Name]; found {
+				errorSink.Add(validationError(computedField, "computed field '%s' conflicts with the member of the same name inherited from '%s'", computedField.Name, origin))
+			}
+		}
+
+		rec.Fields = append(fields, rec.Fields...)
+		if len(computedFields) > 0 {
+			rec.ComputedFields = append(computedFields, rec.ComputedFields...)
+		}
+	}
+
+	for _, ns := range env.Namespaces {
+		for _, typeDefinition := range ns.TypeDefinitions {
+			if rec, ok := typeDefinition.(*RecordDefinition); ok {
+				resolveRecord(rec)
+			}
+		}
+	}
+
+	return env
+}
+
+// Returns the generic definition of the record that a type in a record's
+// `extends` list refers to.
+func extendedRecord(t Type, symbolTable SymbolTable) (*RecordDefinition, bool) {
+	simpleType, ok := t.(*SimpleType)
+	if !ok || simpleType.ResolvedDefinition == nil {
+		return nil, false
+	}
+
+	if _, isParameter := simpleType.ResolvedDefinition.(*GenericTypeParameter); isParameter {
+		return nil, false
+	}
+
+	rec, ok := symbolTable.GetGenericTypeDefinition(simpleType.ResolvedDefinition).(*RecordDefinition)
+	return rec, ok
+}
@@ -0,0 +1,203 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+package dsl
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func recordMemberNames(rec *RecordDefinition) ([]string, []string) {
+	var fields, computedFields []string
+	for _, f := range rec.Fields {
+		fields = append(fields, f.Name)
+	}
+	for _, f := range rec.ComputedFields {
+		computedFields = append(computedFields, f.Name)
+	}
+	return fields, computedFields
+}
+
+func TestRecordExtends(t *testing.T) {
+	src := `
+Header: !record
+  fields:
+    version: int
+    flags:
+      type: uint
+      default: 3
+  computedFields:
+    isV2: version == 2
+Timestamped: !record
+  fields:
+    timestamp: long
+Acquisition: !record
+  extends: [Header, Timestamped]
+  fields:
+    data: float*
+  computedFields:
+    count: size(data)
+Empty: !record
+  extends: Header`
+	env, err := parseAndValidate(t, src)
+	require.NoError(t, err)
+
+	acquisition := env.SymbolTable["test.Acquisition"].(*RecordDefinition)
+	fields, computedFields := recordMemberNames(acquisition)
+	assert.Equal(t, []string{"version", "flags", "timestamp", "data"}, fields)
+	assert.Equal(t, []string{"isV2", "count"}, computedFields)
+	assert.NotNil(t, acquisition.Fields[1].Default)
+
+	// inherited fields are copies
+	header := env.SymbolTable["test.Header"].(*RecordDefinition)
+	assert.NotSame(t, header.Fields[0], acquisition.Fields[0])
+
+	empty := env.SymbolTable["test.Empty"].(*RecordDefinition)
+	fields, _ = recordMemberNames(empty)
+	assert.Equal(t, []string{"version", "flags"}, fields)
+
+	// the base record is sorted before the records that extend it
+	names := []string{}
+	for _, td := range env.Namespaces[0].TypeDefinitions {
+		names = append(names, td.GetDefinitionMeta().Name)
+	}
+	assert.Less(t, slices.Index(names, "Header"), slices.Index(names, "Acquisition"))
+	assert.Less(t, slices.Index(names, "Timestamped"), slices.Index(names, "Acquisition"))
+}
+
+func TestRecordExtendsTransitively(t *testing.T) {
+	src := `
+C: !record
+  extends: [B]
+  fields:
+    c: int
+B: !record
+  extends: [A]
+  fields:
+    b: int
+A: !record
+  fields:
+    a: int`
+	env, err := parseAndValidate(t, src)
+	require.NoError(t, err)
+
+	fields, _ := recordMemberNames(env.SymbolTable["test.C"].(*RecordDefinition))
+	assert.Equal(t, []string{"a", "b", "c"}, fields)
+}
+
+func TestRecordExtendsGeneric(t *testing.T) {
+	src := `
+Base<T>: !record
+  fields:
+    value: T
+    values: T*
+Derived<U>: !record
+  extends: [Base<U>]
+  fields:
+    other: U
+Concrete: !record
+  extends: [Base<string>]
+  fields:
+    x: int`
+	env, err := parseAndValidate(t, src)
+	require.NoError(t, err)
+
+	concrete := env.SymbolTable["test.Concrete"].(*RecordDefinition)
+	assert.Equal(t, "string", TypeToShortSyntax(concrete.Fields[0].Type, false))
+	assert.Equal(t, "string*", TypeToShortSyntax(concrete.Fields[1].Type, false))
+
+	derived := env.SymbolTable["test.Derived"].(*RecordDefinition)
+	assert.Equal(t, "U", TypeToShortSyntax(derived.Fields[0].Type, false))
+	assert.Equal(t, "U*", TypeToShortSyntax(derived.Fields[1].Type, false))
+}
+
+func TestRecordExtendsConflicts(t *testing.T) {
+	src := `
+A: !record
+  fields:
+    x: int
+  computedFields:
+    y: x
+B: !record
+  fields:
+    x: int
+C: !record
+  extends: [A, B]
+  fields:
+    z: int
+D: !record
+  extends: [A]
+  fields:
+    x: int
+  computedFields:
+    y: x`
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, "record 'C' inherits a member named 'x' from both 'A' and 'B'")
+	assert.ErrorContains(t, err, "field 'x' conflicts with the member of the same name inherited from 'A'")
+	assert.ErrorContains(t, err, "computed field 'y' conflicts with the member of the same name inherited from 'A'")
+}
+
+func TestRecordExtendsNonRecord(t *testing.T) {
+	src := `
+E: !enum
+  values: [a, b]
+A: !record
+  fields:
+    x: int
+X<T>: !record
+  extends: [T]
+  fields:
+    y: int
+Y: !record
+  extends: [E]
+  fields:
+    y: int
+Z: !record
+  extends:
+    - A?
+  fields:
+    y: int`
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, "'T' cannot be extended because it is not a record")
+	assert.ErrorContains(t, err, "'E' cannot be extended because it is not a record")
+	assert.ErrorContains(t, err, "'A?' cannot be extended because it is not a record")
+}
+
+func TestRecordExtendsCycle(t *testing.T) {
+	src := `
+A: !record
+  extends: [B]
+  fields:
+    a: int
+B: !record
+  extends: [A]
+  fields:
+    b: int`
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, "there is a reference cycle")
+}
+
+func TestRecordExtendsNotInSchema(t *testing.T) {
+	src := `
+Header: !record
+  fields:
+    version: int
+Acquisition: !record
+  extends: [Header]
+  fields:
+    data: float*
+P: !protocol
+  sequence:
+    a: Acquisition`
+	env, err := parseAndValidate(t, src)
+	require.NoError(t, err)
+
+	schema := GetProtocolSchema(env.SymbolTable["test.P"].(*ProtocolDefinition), env.SymbolTable)
+	require.Len(t, schema.Types, 1)
+	fields, _ := recordMemberNames(schema.Types[0].(*RecordDefinition))
+	assert.Equal(t, []string{"version", "data"}, fields)
+}
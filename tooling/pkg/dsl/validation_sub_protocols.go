@@ -0,0 +1,138 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+package dsl
+
+import (
+	"strings"
+
+	"github.com/microsoft/yardl/tooling/internal/validation"
+)
+
+// Inlines the steps of each protocol's sub-protocols into its sequence.
+// Protocols are reordered so that sub-protocols precede the protocols that
+// reference them, which is also the order in which they are inlined.
+func inlineSubProtocols(env *Environment, errorSink *validation.ErrorSink) *Environment {
+	if len(errorSink.Errors) > 0 {
+		return env
+	}
+
+	for _, ns := range env.Namespaces {
+		for _, p := range ns.Protocols {
+			for _, subProtocol := range p.SubProtocols {
+				resolveSubProtocol(p, subProtocol, env.SymbolTable, errorSink)
+			}
+		}
+	}
+
+	if len(errorSink.Errors) > 0 {
+		return env
+	}
+
+	const (
+		visiting = 1
+		visited  = 2
+	)
+
+	for _, ns := range env.Namespaces {
+		protocols := make([]*ProtocolDefinition, 0, len(ns.Protocols))
+		state := make(map[*ProtocolDefinition]int)
+
+		var visit func(p *ProtocolDefinition, referencedBy *SubProtocol)
+		visit = func(p *ProtocolDefinition, referencedBy *SubProtocol) {
+			switch state[p] {
+			case visited:
+				return
+			case visiting:
+				errorSink.Add(validationError(referencedBy, "the protocol '%s' cannot reference itself through its sub-protocols", p.Name))
+				return
+			}
+
+			state[p] = visiting
+			for _, subProtocol := range p.SubProtocols {
+				visit(subProtocol.Protocol, subProtocol)
+			}
+
+			inlineSubProtocolSteps(p, errorSink)
+			state[p] = visited
+			protocols = append(protocols, p)
+		}
+
+		for _, p := range ns.Protocols {
+			visit(p, nil)
+		}
+
+		ns.Protocols = protocols
+	}
+
+	return env
+}
+
+func resolveSubProtocol(p *ProtocolDefinition, subProtocol *SubProtocol, symbolTable SymbolTable, errorSink *validation.ErrorSink) {
+	resolved, found := lookupTypeDefinition(subProtocol.ProtocolName, p.Namespace, symbolTable)
+	protocol, isProtocol := resolved.(*ProtocolDefinition)
+	if !found || !isProtocol {
+		errorSink.Add(validationError(subProtocol, "the protocol '%s' is not recognized", subProtocol.ProtocolName))
+		return
+	}
+
+	if len(protocol.TypeParameters) > 0 {
+		errorSink.Add(validationError(subProtocol, "the generic protocol '%s' cannot be used as a sub-protocol. Declare a named instantiation of it instead", protocol.Name))
+		return
+	}
+
+	if protocol.Namespace != p.Namespace {
+		errorSink.Add(validationError(subProtocol, "the sub-protocol '%s' must be defined in the same namespace as the protocol '%s'", protocol.Name, p.Name))
+		return
+	}
+
+	subProtocol.Protocol = protocol
+}
+
+// Replaces the protocol's sequence with one that includes the steps of its
+// sub-protocols, which must already have been inlined themselves.
+func inlineSubProtocolSteps(p *ProtocolDefinition, errorSink *validation.ErrorSink) {
+	if len(p.SubProtocols) == 0 {
+		return
+	}
+
+	stepNames := make(map[string]bool)
+	for _, step := range p.Sequence {
+		stepNames[step.Name] = true
+	}
+
+	sequence := make(ProtocolSteps, 0, len(p.Sequence))
+	subProtocols := make([]*SubProtocol, len(p.SubProtocols))
+	next := 0
+	for i, subProtocol := range p.SubProtocols {
+		sequence = append(sequence, p.Sequence[next:subProtocol.Offset]...)
+		next = subProtocol.Offset
+
+		// Instantiations of generic protocols share SubProtocols with
+		// the generic protocol, so we don't modify them in place.
+		inlined := *subProtocol
+		inlined.Offset = len(sequence)
+		subProtocols[i] = &inlined
+
+		for _, step := range subProtocol.Protocol.Sequence {
+			inlinedStep := *step
+			inlinedStep.Name = subProtocol.Name + strings.ToUpper(step.Name[:1]) + step.Name[1:]
+
+			if !memberNameRegex.MatchString(inlinedStep.Name) {
+				errorSink.Add(validationError(subProtocol, "the step '%s' of the sub-protocol '%s' must match the format %s", inlinedStep.Name, subProtocol.Protocol.Name, memberNameRegex.String()))
+			}
+
+			if stepNames[inlinedStep.Name] {
+				errorSink.Add(validationError(subProtocol, "the step '%s' of the sub-protocol '%s' conflicts with another step on the protocol '%s'", inlinedStep.Name, subProtocol.Protocol.Name, p.Name))
+			}
+
+			stepNames[inlinedStep.Name] = true
+			sequence = append(sequence, &inlinedStep)
+		}
+	}
+
+	sequence = append(sequence, p.Sequence[next:]...)
+
+	p.Sequence = sequence
+	p.SubProtocols = subProtocols
+}
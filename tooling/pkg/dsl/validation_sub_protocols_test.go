@@ -0,0 +1,198 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+package dsl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubProtocolStepsAreInlined(t *testing.T) {
+	src := `
+Acquisition: !protocol
+  sequence:
+    header: string
+    # The calibration phase
+    calibration: !protocol Calibration
+    data: !stream
+      items: float
+
+Calibration: !protocol
+  sequence:
+    gain: float
+    # Noise samples
+    noise: !stream
+      items: float`
+
+	env, err := parseAndValidate(t, src)
+	require.Nil(t, err)
+
+	ns := env.Namespaces[0]
+	require.Len(t, ns.Protocols, 2)
+	assert.Equal(t, "Calibration", ns.Protocols[0].Name)
+
+	acquisition := ns.Protocols[1]
+	assert.Equal(t, "Acquisition", acquisition.Name)
+
+	var stepNames []string
+	for _, step := range acquisition.Sequence {
+		stepNames = append(stepNames, step.Name)
+	}
+	assert.Equal(t, []string{"header", "calibrationGain", "calibrationNoise", "data"}, stepNames)
+	assert.Equal(t, "Noise samples", acquisition.Sequence[2].Comment)
+	assert.True(t, acquisition.Sequence[2].IsStream())
+
+	require.Len(t, acquisition.SubProtocols, 1)
+	subProtocol := acquisition.SubProtocols[0]
+	assert.Equal(t, "calibration", subProtocol.Name)
+	assert.Equal(t, "The calibration phase", subProtocol.Comment)
+	assert.Same(t, ns.Protocols[0], subProtocol.Protocol)
+	assert.Equal(t, 1, subProtocol.Offset)
+	assert.Equal(t, acquisition.Sequence[1:3], subProtocol.Steps(acquisition))
+}
+
+func TestNestedSubProtocols(t *testing.T) {
+	src := `
+Inner: !protocol
+  sequence:
+    a: int
+
+Middle: !protocol
+  sequence:
+    inner: !protocol Inner
+    b: int
+
+Outer: !protocol
+  sequence:
+    first: !protocol Middle
+    second: !protocol Middle`
+
+	env, err := parseAndValidate(t, src)
+	require.Nil(t, err)
+
+	outer := env.Namespaces[0].Protocols[2]
+	var stepNames []string
+	for _, step := range outer.Sequence {
+		stepNames = append(stepNames, step.Name)
+	}
+	assert.Equal(t, []string{"firstInnerA", "firstB", "secondInnerA", "secondB"}, stepNames)
+	assert.Equal(t, 0, outer.SubProtocols[0].Offset)
+	assert.Equal(t, 2, outer.SubProtocols[1].Offset)
+}
+
+func TestSubProtocolOfGenericProtocolInstantiation(t *testing.T) {
+	src := `
+Values<T>: !protocol
+  sequence:
+    values: !stream
+      items: T
+
+FloatValues: Values<float>
+
+P: !protocol
+  sequence:
+    floats: !protocol FloatValues`
+
+	env, err := parseAndValidate(t, src)
+	require.Nil(t, err)
+
+	p := env.Namespaces[0].Protocols[1]
+	assert.Equal(t, "P", p.Name)
+	assert.Equal(t, "floatsValues", p.Sequence[0].Name)
+	assert.Equal(t, "stream<float32>", TypeToShortSyntax(p.Sequence[0].Type, false))
+}
+
+func TestSubProtocolNotRecognized(t *testing.T) {
+	src := `
+P: !protocol
+  sequence:
+    calibration: !protocol Calibration`
+
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, "the protocol 'Calibration' is not recognized")
+
+	src = `
+Calibration: float
+
+P: !protocol
+  sequence:
+    calibration: !protocol Calibration`
+
+	_, err = parseAndValidate(t, src)
+	assert.ErrorContains(t, err, "the protocol 'Calibration' is not recognized")
+}
+
+func TestSubProtocolCycle(t *testing.T) {
+	src := `
+A: !protocol
+  sequence:
+    b: !protocol B
+
+B: !protocol
+  sequence:
+    x: int
+    a: !protocol A`
+
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, "cannot reference itself through its sub-protocols")
+}
+
+func TestSubProtocolStepNameConflicts(t *testing.T) {
+	src := `
+Calibration: !protocol
+  sequence:
+    gain: float
+
+P: !protocol
+  sequence:
+    calibrationGain: float
+    calibration: !protocol Calibration`
+
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, "the step 'calibrationGain' of the sub-protocol 'Calibration' conflicts with another step on the protocol 'P'")
+
+	src = `
+Calibration: !protocol
+  sequence:
+    gain: float
+
+P: !protocol
+  sequence:
+    calibration: float
+    calibration: !protocol Calibration`
+
+	_, err = parseAndValidate(t, src)
+	assert.ErrorContains(t, err, "a sequence step with the name 'calibration' is already defined on the protocol 'P'")
+}
+
+func TestGenericSubProtocol(t *testing.T) {
+	src := `
+Values<T>: !protocol
+  sequence:
+    values: !stream
+      items: T
+
+P: !protocol
+  sequence:
+    floats: !protocol Values`
+
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, "the generic protocol 'Values' cannot be used as a sub-protocol")
+}
+
+func TestRecordFieldCannotReferenceProtocol(t *testing.T) {
+	src := `
+Calibration: !protocol
+  sequence:
+    gain: float
+
+R: !record
+  fields:
+    calibration: !protocol Calibration`
+
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, "only protocol steps can reference a !protocol")
+}
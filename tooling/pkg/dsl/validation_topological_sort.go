@@ -10,16 +10,47 @@ import (
 	"github.com/microsoft/yardl/tooling/internal/validation"
 )
 
+// Sorts the type definitions of each namespace so that types are defined
+// before they are referenced. Records may refer to themselves, directly or
+// through other records, as long as the reference is the value of an
+// optional, a union case, the element of a variable-length vector, or the
+// value of a map. Such references are marked with IsRecursiveReference
+// and are the only references to types that are not yet defined. All other
+// reference cycles are errors.
 func topologicalSortTypes(env *Environment, errorSink *validation.ErrorSink) *Environment {
 	var rootSentinel Node = &RecordDefinition{}
 	for _, ns := range env.Namespaces {
 		sortedTypes := []TypeDefinition{}
 		predecessors := make(map[Node]Node)
 
+		// The GeneralizedType whose cases are being visited, if the current
+		// node is one of its cases.
+		var enclosingType *GeneralizedType
+
+		// Returns the cycle that is closed by a reference from parent to t.
+		cyclePath := func(t TypeDefinition, parent Node) []Node {
+			path := []Node{t, parent}
+			for pred := predecessors[parent]; pred != rootSentinel; pred = predecessors[pred] {
+				path = append(path, pred)
+				if pred == t {
+					break
+				}
+			}
+
+			for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+				path[i], path[j] = path[j], path[i]
+			}
+
+			return path
+		}
+
 		VisitWithContext(ns, rootSentinel, func(self VisitorWithContext[Node], node Node, parent Node) {
 			switch t := node.(type) {
 			case *ProtocolDefinition:
 				// Cannot be referenced from other types
+			case *ConstantDefinition:
+				// Constants are kept in Namespace.Constants and their
+				// references are checked for cycles in resolveConstants
 			case TypeDefinition:
 				pred, found := predecessors[t]
 				if found {
@@ -39,26 +70,22 @@ func topologicalSortTypes(env *Environment, errorSink *validation.ErrorSink) *En
 							}
 						}
 
-						path := []string{nodeNameFunc(t), nodeNameFunc(parent)}
-						for pred = predecessors[parent]; pred != rootSentinel; pred = predecessors[pred] {
-							path = append(path, nodeNameFunc(pred))
-							if pred == t {
-								break
-							}
-						}
-
-						for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
-							path[i], path[j] = path[j], path[i]
+						path := []string{}
+						for _, n := range cyclePath(t, parent) {
+							path = append(path, nodeNameFunc(n))
 						}
 
-						errorSink.Add(validationError(parent, "there is a reference cycle, which is not supported, within namespace '%s': %s", ns.Name, strings.Join(path, " -> ")))
+						errorSink.Add(validationError(parent, "there is a reference cycle, which is not supported, within namespace '%s': %s. Records can only refer to themselves through an optional, union, vector, or map", ns.Name, strings.Join(path, " -> ")))
 					}
 					return
 				}
 
+				outerType := enclosingType
+				enclosingType = nil
 				predecessors[t] = parent
 				self.VisitChildren(node, node)
 				predecessors[t] = nil
+				enclosingType = outerType
 				sortedTypes = append(sortedTypes, t)
 
 			case *Field:
@@ -66,11 +93,39 @@ func topologicalSortTypes(env *Environment, errorSink *validation.ErrorSink) *En
 				self.VisitChildren(node, node)
 				delete(predecessors, t)
 
+			case *GeneralizedType:
+				outerType := enclosingType
+				enclosingType = t
+				for _, typeCase := range t.Cases {
+					self.Visit(typeCase, parent)
+				}
+				enclosingType = nil
+				if t.Dimensionality != nil {
+					self.Visit(t.Dimensionality, parent)
+				}
+				enclosingType = outerType
+
 			case *SimpleType:
+				outerType := enclosingType
+				enclosingType = nil
 				if t.ResolvedDefinition != nil {
 					definitionMeta := t.ResolvedDefinition.GetDefinitionMeta()
 					if definitionMeta.Namespace == ns.Name {
-						self.Visit(env.SymbolTable.GetGenericTypeDefinition(t.ResolvedDefinition), parent)
+						definition := env.SymbolTable.GetGenericTypeDefinition(t.ResolvedDefinition)
+						if predecessors[definition] != nil && isIndirection(outerType) && isSupportedRecursion(cyclePath(definition, parent)) {
+							t.IsRecursiveReference = true
+							if outerType.Cases.IsUnion() && !outerType.Cases.HasNullOption() && outerType.Cases[0].Type == t {
+								// The default value of a union is the default value of its first case
+								errorSink.Add(validationError(t, "the recursive record '%s' cannot be the first case of a union without a null case, because the union would have no default value", definition.GetDefinitionMeta().Name))
+							}
+							for _, n := range cyclePath(definition, parent) {
+								if rec, ok := n.(*RecordDefinition); ok {
+									rec.IsRecursive = true
+								}
+							}
+						} else {
+							self.Visit(definition, parent)
+						}
 					}
 					for _, typeArg := range t.ResolvedDefinition.GetDefinitionMeta().TypeParameters {
 						if typeArg.GetDefinitionMeta().Namespace == ns.Name {
@@ -80,6 +135,7 @@ func topologicalSortTypes(env *Environment, errorSink *validation.ErrorSink) *En
 				}
 
 				self.VisitChildren(node, parent)
+				enclosingType = outerType
 			default:
 				self.VisitChildren(node, parent)
 			}
@@ -90,3 +146,38 @@ func topologicalSortTypes(env *Environment, errorSink *validation.ErrorSink) *En
 
 	return env
 }
+
+// Returns whether a value of a type with the given cases and dimensionality
+// can hold a reference to a record that is not yet defined.
+func isIndirection(t *GeneralizedType) bool {
+	if t == nil {
+		return false
+	}
+
+	switch d := t.Dimensionality.(type) {
+	case nil:
+		return t.Cases.HasNullOption() || t.Cases.IsUnion()
+	case *Vector:
+		return !d.IsFixed()
+	case *Map:
+		return true
+	default:
+		return false
+	}
+}
+
+// Recursion is only supported between non-generic records.
+func isSupportedRecursion(cycle []Node) bool {
+	for _, n := range cycle {
+		switch t := n.(type) {
+		case *RecordDefinition:
+			if len(t.TypeParameters) > 0 {
+				return false
+			}
+		case TypeDefinition:
+			return false
+		}
+	}
+
+	return true
+}
@@ -4,6 +4,7 @@
 package dsl
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -68,3 +69,92 @@ Image<T>: !array
 	_, err := parseAndValidate(t, src)
 	require.ErrorContains(t, err, "there is a reference cycle, which is not supported, within namespace 'test': Alias 'Image' -> Alias 'Image'")
 }
+
+func TestRecursiveRecords(t *testing.T) {
+	src := `
+Node: !record
+  fields:
+    children: Node*
+    labels: string->Node
+Transform: !record
+  fields:
+    parent: Transform?
+    next: [int, Transform]
+A: !record
+  fields:
+    b: B?
+B: !record
+  fields:
+    a: A*
+C: !record
+  fields:
+    i: int
+`
+	env, err := parseAndValidate(t, src)
+	require.Nil(t, err)
+
+	records := make(map[string]*RecordDefinition)
+	for _, td := range env.Namespaces[0].TypeDefinitions {
+		records[td.GetDefinitionMeta().Name] = td.(*RecordDefinition)
+	}
+
+	require.True(t, records["Node"].IsRecursive)
+	require.True(t, records["Transform"].IsRecursive)
+	require.True(t, records["A"].IsRecursive)
+	require.True(t, records["B"].IsRecursive)
+	require.False(t, records["C"].IsRecursive)
+
+	isRecursiveReference := func(field *Field) bool {
+		return GetUnderlyingType(field.Type).(*GeneralizedType).Cases[0].Type.(*SimpleType).IsRecursiveReference
+	}
+
+	require.True(t, isRecursiveReference(records["Node"].Fields[0]))
+	require.True(t, isRecursiveReference(records["Node"].Fields[1]))
+	require.True(t, GetUnderlyingType(records["Transform"].Fields[1].Type).(*GeneralizedType).Cases[1].Type.(*SimpleType).IsRecursiveReference)
+
+	// B is defined before A, so only the reference to A is recursive
+	require.True(t, isRecursiveReference(records["B"].Fields[0]))
+	require.False(t, GetUnderlyingType(records["A"].Fields[0].Type).(*GeneralizedType).Cases[1].Type.(*SimpleType).IsRecursiveReference)
+}
+
+func TestRecursionWithoutIndirection(t *testing.T) {
+	src := `
+Node: !record
+  fields:
+    child: Node
+Transform: !record
+  fields:
+    parents: Transform*3
+`
+	_, err := parseAndValidate(t, src)
+	require.ErrorContains(t, err, "there is a reference cycle, which is not supported, within namespace 'test': Record 'Node' -> Field 'child' -> Record 'Node'. Records can only refer to themselves through an optional, union, vector, or map")
+	require.ErrorContains(t, err, "Record 'Transform' -> Field 'parents' -> Record 'Transform'")
+}
+
+func TestRecursionThroughAliasOrGenericRecord(t *testing.T) {
+	src := `
+Node: !record
+  fields:
+    children: Nodes
+Nodes: Node*
+Tree<T>: !record
+  fields:
+    value: T
+    children: Tree<T>*
+`
+	_, err := parseAndValidate(t, src)
+	require.ErrorContains(t, err, "Record 'Node' -> Field 'children' -> Alias 'Nodes' -> Record 'Node'")
+	require.ErrorContains(t, err, "Record 'Tree' -> Field 'children' -> Record 'Tree'")
+}
+
+func TestRecursiveRecordAsFirstUnionCase(t *testing.T) {
+	src := `
+Node: !record
+  fields:
+    next: [Node, int]
+    previous: [null, Node, int]
+`
+	_, err := parseAndValidate(t, src)
+	require.ErrorContains(t, err, "the recursive record 'Node' cannot be the first case of a union without a null case")
+	require.Equal(t, 1, strings.Count(err.Error(), "first case"))
+}
@@ -58,6 +58,14 @@ func resolveTypes(env *Environment, errorSink *validation.ErrorSink) *Environmen
 			self.VisitChildren(node, &visitorContext{currentNamespace: t.Name, symbolTable: env.SymbolTable})
 			return
 		case TypeDefinition:
+			if protocol := getInstantiatedGenericProtocol(t, context.symbolTable); protocol != nil {
+				self.VisitChildren(t.(*NamedType).Type, context)
+				if err := resolveGenericProtocolInstantiation(t.(*NamedType), protocol); err != nil {
+					errorSink.Add(validationError(t, "%s", err.Error()))
+				}
+				return
+			}
+
 			definitionMeta := t.GetDefinitionMeta()
 			if len(definitionMeta.TypeParameters) > 0 {
 				scopedSymbolTable := context.symbolTable.Clone()
@@ -100,6 +108,13 @@ func convertGenericReferences(env *Environment, errorSink *validation.ErrorSink)
 			self.VisitChildren(node, visitorContext{context.symbolTable, t.Name})
 			return
 		case TypeDefinition:
+			if getInstantiatedGenericProtocol(t, context.symbolTable) != nil {
+				// Only the type arguments are resolved here. The protocol itself
+				// is instantiated in instantiateGenericProtocols.
+				self.VisitChildren(t.(*NamedType).Type, context)
+				return
+			}
+
 			definitionMeta := t.GetDefinitionMeta()
 			if len(definitionMeta.TypeParameters) > 0 {
 				scopedSymbolTable := context.symbolTable.Clone()
@@ -126,24 +141,33 @@ func convertGenericReferences(env *Environment, errorSink *validation.ErrorSink)
 	return env
 }
 
+func lookupTypeDefinition(typeName string, currentNamespace string, symbolTable SymbolTable) (TypeDefinition, bool) {
+	if resolvedType, found := symbolTable[typeName]; found {
+		return resolvedType, true
+	}
+
+	resolvedType, found := symbolTable[fmt.Sprintf("%s.%s", currentNamespace, typeName)]
+	return resolvedType, found
+}
+
 func resolveTypeByName(typeName string, currentNamespace string, symbolTable SymbolTable) (TypeDefinition, error) {
 	if primitiveType, found := primitiveTypes[typeName]; found {
 		return primitiveType, nil
 	}
 
-	resolvedType, found := symbolTable[typeName]
+	resolvedType, found := lookupTypeDefinition(typeName, currentNamespace, symbolTable)
 	if !found {
-		qualifiedName := fmt.Sprintf("%s.%s", currentNamespace, typeName)
-		resolvedType, found = symbolTable[qualifiedName]
-		if !found {
-			return nil, fmt.Errorf("the type '%s' is not recognized", typeName)
-		}
+		return nil, fmt.Errorf("the type '%s' is not recognized", typeName)
 	}
 
-	if _, isProtocol := resolvedType.(*ProtocolDefinition); isProtocol {
+	if _, isProtocol := resolvedType.(*ProtocolDefinition); isProtocol || getInstantiatedGenericProtocol(resolvedType, symbolTable) != nil {
 		return nil, errors.New("cannot reference a protocol")
 	}
 
+	if _, isConstant := resolvedType.(*ConstantDefinition); isConstant {
+		return nil, fmt.Errorf("'%s' is a constant and cannot be used as a type", typeName)
+	}
+
 	return resolvedType, nil
 }
 
@@ -172,7 +196,7 @@ func resolveType(simpleType *SimpleType, currentNamespace string, symbolTable Sy
 func validateGenericTypeDefinitions(env *Environment, errorSink *validation.ErrorSink) *Environment {
 	Visit(env, func(self Visitor, node Node) {
 		switch node := node.(type) {
-		case *RecordDefinition, *NamedType:
+		case *RecordDefinition, *NamedType, *ProtocolDefinition:
 			return
 		case TypeDefinition:
 			meta := node.GetDefinitionMeta()
@@ -54,15 +54,6 @@ Abc<T>: !enum
 	assert.ErrorContains(t, err, "'Abc' cannot have generic type parameters")
 }
 
-func TestProtocolsCannotBeGeneric(t *testing.T) {
-	src := `
-MyProtocol<T>: !protocol
-  sequence:
-    s: T`
-	_, err := parseAndValidate(t, src)
-	assert.ErrorContains(t, err, "'MyProtocol' cannot have generic type parameters")
-}
-
 func TestNoTypeArgsGiven(t *testing.T) {
 	src := `
 Rec1<T>: !record
@@ -0,0 +1,297 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+package dsl
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/microsoft/yardl/tooling/internal/validation"
+)
+
+// Ensures that units of measure are only given on numeric primitive types.
+func validateUnits(env *Environment, errorSink *validation.ErrorSink) *Environment {
+	Visit(env, func(self Visitor, node Node) {
+		self.VisitChildren(node)
+
+		t, ok := node.(*SimpleType)
+		if !ok || t.Unit == "" {
+			return
+		}
+
+		if primitive, ok := t.ResolvedDefinition.(PrimitiveDefinition); ok {
+			switch GetPrimitiveKind(primitive) {
+			case PrimitiveKindInteger, PrimitiveKindFloatingPoint, PrimitiveKindComplexFloatingPoint:
+				return
+			}
+		}
+
+		errorSink.Add(validationError(t, "a unit can only be given on a numeric primitive type, not '%s'", TypeToShortSyntax(t, false)))
+	})
+
+	return env
+}
+
+// A unit of measure derived from the units of the values in an expression, as
+// the exponent of each unit. For example, mm/s is {"mm": 1, "s": -1}.
+type derivedUnit map[string]int
+
+func (u derivedUnit) String() string {
+	names := make([]string, 0, len(u))
+	for name := range u {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var numerator, denominator []string
+	for _, name := range names {
+		exponent := u[name]
+		term := name
+		if exponent > 1 || exponent < -1 {
+			term = fmt.Sprintf("%s^%d", name, max(exponent, -exponent))
+		}
+		if exponent > 0 {
+			numerator = append(numerator, term)
+		} else {
+			denominator = append(denominator, term)
+		}
+	}
+
+	switch {
+	case len(denominator) == 0:
+		return strings.Join(numerator, "*")
+	case len(numerator) == 0:
+		numerator = []string{"1"}
+	}
+
+	if len(denominator) == 1 {
+		return fmt.Sprintf("%s/%s", strings.Join(numerator, "*"), denominator[0])
+	}
+	return fmt.Sprintf("%s/(%s)", strings.Join(numerator, "*"), strings.Join(denominator, "*"))
+}
+
+// Returns u * v^power.
+func (u derivedUnit) combine(v derivedUnit, power int) derivedUnit {
+	result := derivedUnit{}
+	for name, exponent := range u {
+		result[name] = exponent
+	}
+	for name, exponent := range v {
+		result[name] += exponent * power
+		if result[name] == 0 {
+			delete(result, name)
+		}
+	}
+	return result
+}
+
+// Returns the square root of the unit, or false if an exponent is odd.
+func (u derivedUnit) sqrt() (derivedUnit, bool) {
+	result := derivedUnit{}
+	for name, exponent := range u {
+		if exponent%2 != 0 {
+			return nil, false
+		}
+		result[name] = exponent / 2
+	}
+	return result, true
+}
+
+// Returns the unit of measure of a resolved expression, or an empty string if
+// it has none. See deriveUnit.
+func expressionUnit(expression Expression) string {
+	return deriveUnit(expression).String()
+}
+
+// Returns the unit of measure of a resolved expression. The operands of + and -
+// keep their unit, as does a value that is scaled by a number without a unit.
+// The units of the operands of * and / are multiplied and divided, so the
+// product of two lengths in mm has the unit mm^2, and a value raised to an
+// integer literal power has its unit raised to that power.
+func deriveUnit(expression Expression) derivedUnit {
+	switch e := expression.(type) {
+	case *BinaryExpression:
+		left := deriveUnit(e.Left)
+		switch e.Operator {
+		case BinaryOpAdd, BinaryOpSub:
+			if len(left) > 0 {
+				return left
+			}
+			return deriveUnit(e.Right)
+		case BinaryOpMul:
+			return left.combine(deriveUnit(e.Right), 1)
+		case BinaryOpDiv:
+			return left.combine(deriveUnit(e.Right), -1)
+		case BinaryOpPow:
+			if power, ok := integerLiteralValue(e.Right); ok {
+				return derivedUnit{}.combine(left, power)
+			}
+			// Reported by validateOperandUnits if the base has a unit.
+			return nil
+		default:
+			return nil
+		}
+	case *UnaryExpression:
+		if e.Operator == UnaryOpNegate {
+			return deriveUnit(e.Expression)
+		}
+		return nil
+	case *ConditionalExpression:
+		if then := deriveUnit(e.Then); len(then) > 0 {
+			return then
+		}
+		return deriveUnit(e.Else)
+	case *TypeConversionExpression:
+		// Converting to a type alias that has a unit gives the value that unit.
+		// Other conversions, including those inserted to convert operands to
+		// a common type, keep the unit of the value.
+		if st, ok := e.Type.(*SimpleType); ok {
+			if _, isNamedType := st.ResolvedDefinition.(*NamedType); isNamedType {
+				if unit := GetUnit(st); unit != "" {
+					return derivedUnit{unit: 1}
+				}
+			}
+		}
+		return deriveUnit(e.Expression)
+	case *FunctionCallExpression:
+		switch e.FunctionName {
+		case FunctionMin, FunctionMax, FunctionAbs, FunctionFloor, FunctionCeil:
+			// The arguments of min() and max() are checked to have the same unit.
+			for _, arg := range e.Arguments {
+				if unit := deriveUnit(arg); len(unit) > 0 {
+					return unit
+				}
+			}
+			return nil
+		case FunctionSqrt:
+			// Reported by validateFunctionArgumentUnits if an exponent is odd.
+			unit, _ := deriveUnit(e.Arguments[0]).sqrt()
+			return unit
+		}
+	}
+
+	if unit := GetUnit(expression.GetResolvedType()); unit != "" {
+		return derivedUnit{unit: 1}
+	}
+	return nil
+}
+
+// Returns the value of an integer literal, which can be negated or converted
+// to another type.
+func integerLiteralValue(expression Expression) (int, bool) {
+	switch e := expression.(type) {
+	case *IntegerLiteralExpression:
+		if !e.Value.IsInt64() || e.Value.Int64() > math.MaxInt32 || e.Value.Int64() < math.MinInt32 {
+			return 0, false
+		}
+		return int(e.Value.Int64()), true
+	case *UnaryExpression:
+		if e.Operator == UnaryOpNegate {
+			value, ok := integerLiteralValue(e.Expression)
+			return -value, ok
+		}
+	case *TypeConversionExpression:
+		return integerLiteralValue(e.Expression)
+	}
+	return 0, false
+}
+
+// Returns whether an expression is a number written in the model, such as a
+// literal, a constant, or arithmetic on them, which can be combined with a
+// value of any unit.
+func isNumericLiteral(expression Expression) bool {
+	switch e := expression.(type) {
+	case *IntegerLiteralExpression, *FloatingPointLiteralExpression, *ConstantReferenceExpression:
+		return true
+	case *UnaryExpression:
+		return e.Operator == UnaryOpNegate && isNumericLiteral(e.Expression)
+	case *TypeConversionExpression:
+		return isNumericLiteral(e.Expression)
+	case *BinaryExpression:
+		switch e.Operator {
+		case BinaryOpAdd, BinaryOpSub, BinaryOpMul, BinaryOpDiv, BinaryOpPow:
+			return isNumericLiteral(e.Left) && isNumericLiteral(e.Right)
+		}
+	}
+	return false
+}
+
+// Returns a description of the units of two values that must have the same
+// unit, such as "units 'mm' and 's'", or an empty string if they do. A value
+// without a unit only matches another unit if it is a numeric literal.
+func unitMismatch(left, right Expression) string {
+	leftUnit := expressionUnit(left)
+	rightUnit := expressionUnit(right)
+	switch {
+	case leftUnit == rightUnit:
+		return ""
+	case leftUnit == "":
+		if isNumericLiteral(left) {
+			return ""
+		}
+		return fmt.Sprintf("no unit and the unit '%s'", rightUnit)
+	case rightUnit == "":
+		if isNumericLiteral(right) {
+			return ""
+		}
+		return fmt.Sprintf("the unit '%s' and no unit", leftUnit)
+	default:
+		return fmt.Sprintf("units '%s' and '%s'", leftUnit, rightUnit)
+	}
+}
+
+// Reports an error if the operands of an addition, subtraction, or comparison
+// have different units, or if a value with a unit is raised to a power that is
+// not an integer literal, returning false if there is an error.
+func validateOperandUnits(expression *BinaryExpression, errorSink *validation.ErrorSink) bool {
+	switch expression.Operator {
+	case BinaryOpAdd, BinaryOpSub:
+	case BinaryOpPow:
+		if exponentUnit := expressionUnit(expression.Right); exponentUnit != "" {
+			errorSink.Add(validationError(expression, "the exponent of '**' cannot have a unit, but has the unit '%s'", exponentUnit))
+			return false
+		}
+		if baseUnit := expressionUnit(expression.Left); baseUnit != "" {
+			if _, ok := integerLiteralValue(expression.Right); !ok {
+				errorSink.Add(validationError(expression, "a value with the unit '%s' can only be raised to an integer literal power", baseUnit))
+				return false
+			}
+		}
+		return true
+	default:
+		if !expression.Operator.IsComparison() {
+			return true
+		}
+	}
+
+	if mismatch := unitMismatch(expression.Left, expression.Right); mismatch != "" {
+		errorSink.Add(validationError(expression, "operator not defined between operands with %s", mismatch))
+		return false
+	}
+
+	return true
+}
+
+// Reports an error if the arguments of min() or max() have different units, or
+// if the square root of the argument of sqrt() has no unit, returning false if
+// there is an error.
+func validateFunctionArgumentUnits(functionCall *FunctionCallExpression, errorSink *validation.ErrorSink) bool {
+	switch functionCall.FunctionName {
+	case FunctionMin, FunctionMax:
+		if mismatch := unitMismatch(functionCall.Arguments[0], functionCall.Arguments[1]); mismatch != "" {
+			errorSink.Add(validationError(functionCall, "%s() not defined between arguments with %s", functionCall.FunctionName, mismatch))
+			return false
+		}
+	case FunctionSqrt:
+		unit := deriveUnit(functionCall.Arguments[0])
+		if _, ok := unit.sqrt(); !ok {
+			errorSink.Add(validationError(functionCall, "sqrt() not defined for an argument with the unit '%s'", unit))
+			return false
+		}
+	}
+
+	return true
+}
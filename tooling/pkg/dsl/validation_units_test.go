@@ -0,0 +1,204 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+package dsl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnits(t *testing.T) {
+	src := `
+Millimeters: float @ mm
+Rec: !record
+  fields:
+    length: float @ mm
+    width: Millimeters
+    duration: double @ s
+    durations: double @ s*
+    count: uint @ count
+  computedFields:
+    perimeter: 2 * (length + width)
+    area: length * width
+    speed: length / duration
+    rate: count / duration
+    total: durations[0] + duration
+    longer: length > width
+    scaled: (duration * 1000) as Millimeters + length
+    clamped: length if length > 0 else 0
+    squared: length ** 2 + width * width
+    inverse: length ** -1 * duration
+    diagonal: sqrt(length * length + width * width)
+    smallest: min(length, width * 2)
+    negated: -(length / duration)
+    acceleration: length / duration / duration`
+	env, err := parseAndValidate(t, src)
+	require.NoError(t, err)
+
+	rec := env.SymbolTable["test.Rec"].(*RecordDefinition)
+	assert.Equal(t, "mm", rec.Fields[0].Type.(*SimpleType).Unit)
+	assert.Equal(t, "mm", GetUnit(rec.Fields[1].Type))
+
+	expected := map[string]string{
+		"perimeter":    "mm",
+		"area":         "mm^2",
+		"speed":        "mm/s",
+		"rate":         "count/s",
+		"total":        "s",
+		"longer":       "",
+		"scaled":       "mm",
+		"clamped":      "mm",
+		"squared":      "mm^2",
+		"inverse":      "s/mm",
+		"diagonal":     "mm",
+		"smallest":     "mm",
+		"negated":      "mm/s",
+		"acceleration": "mm/s^2",
+	}
+	for _, cf := range rec.ComputedFields {
+		assert.Equal(t, expected[cf.Name], expressionUnit(cf.Expression), cf.Name)
+	}
+}
+
+func TestUnitMismatches(t *testing.T) {
+	src := `
+Rec: !record
+  fields:
+    length: float @ mm
+    duration: double @ s
+    durations: double @ ms*
+  computedFields:
+    sum: length + duration
+    difference: duration - durations[0]
+    comparison: length < duration
+    nested: (length * 2) + duration
+    conditional: length if length > 0 else duration`
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, "8:17: operator not defined between operands with units 'mm' and 's'")
+	assert.ErrorContains(t, err, "9:26: operator not defined between operands with units 's' and 'ms'")
+	assert.ErrorContains(t, err, "10:24: operator not defined between operands with units 'mm' and 's'")
+	assert.ErrorContains(t, err, "11:26: operator not defined between operands with units 'mm' and 's'")
+	assert.ErrorContains(t, err, "12:25: the branches of the conditional expression have units 'mm' and 's'")
+}
+
+func TestUnitlessOperands(t *testing.T) {
+	src := `
+Scale: !const 2.5
+Rec: !record
+  fields:
+    lengthMm: float @ mm
+    durationMs: double @ ms
+    count: int
+    someUnitlessField: double
+  computedFields:
+    sum: lengthMm + count
+    comparison: durationMs > someUnitlessField
+    reversed: count - lengthMm
+    minimum: min(lengthMm, count)
+    conditional: lengthMm if count > 0 else someUnitlessField
+    literals: lengthMm + 1 - -2.5 + (3 * 4) as float + Scale
+    literalComparison: durationMs > 0 && 10 >= durationMs
+    literalArguments: max(lengthMm, 0) + min(-1, lengthMm)
+    literalBranch: lengthMm if count > 0 else 0
+    scaled: lengthMm * count + lengthMm / someUnitlessField`
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, "10:19: operator not defined between operands with the unit 'mm' and no unit")
+	assert.ErrorContains(t, err, "11:28: operator not defined between operands with the unit 'ms' and no unit")
+	assert.ErrorContains(t, err, "12:21: operator not defined between operands with no unit and the unit 'mm'")
+	assert.ErrorContains(t, err, "13:14: min() not defined between arguments with the unit 'mm' and no unit")
+	assert.ErrorContains(t, err, "the branches of the conditional expression have the unit 'mm' and no unit")
+	assert.NotContains(t, err.Error(), "t.yaml:15:")
+	assert.NotContains(t, err.Error(), "t.yaml:16:")
+	assert.NotContains(t, err.Error(), "t.yaml:17:")
+	assert.NotContains(t, err.Error(), "t.yaml:18:")
+	assert.NotContains(t, err.Error(), "t.yaml:19:")
+}
+
+func TestUnitMismatchesInProductsAndQuotients(t *testing.T) {
+	src := `
+Rec: !record
+  fields:
+    length: float @ mm
+    width: float @ mm
+    duration: double @ s
+    count: uint @ count
+  computedFields:
+    productPlusUnit: length * length + duration
+    quotientPlusUnit: length / duration + length
+    productComparison: length * width > length
+    differentProducts: length * width - duration * duration
+    rates: count / duration == length / duration
+    power: length ** 2 + length
+    unitlessQuotient: length / width + 1`
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, "9:38: operator not defined between operands with units 'mm^2' and 's'")
+	assert.ErrorContains(t, err, "10:41: operator not defined between operands with units 'mm/s' and 'mm'")
+	assert.ErrorContains(t, err, "11:39: operator not defined between operands with units 'mm^2' and 'mm'")
+	assert.ErrorContains(t, err, "12:39: operator not defined between operands with units 'mm^2' and 's^2'")
+	assert.ErrorContains(t, err, "13:29: operator not defined between operands with units 'count/s' and 'mm/s'")
+	assert.ErrorContains(t, err, "14:24: operator not defined between operands with units 'mm^2' and 'mm'")
+	assert.NotContains(t, err.Error(), "t.yaml:15:")
+}
+
+func TestUnitMismatchesInFunctionArguments(t *testing.T) {
+	src := `
+Rec: !record
+  fields:
+    length: float @ mm
+    width: float @ mm
+    duration: double @ s
+  computedFields:
+    minimum: min(length, duration)
+    maximum: max(length * width, length)
+    root: sqrt(length)
+    absolute: abs(length) + duration
+    compatible: min(length, 2) + max(width, length) + sqrt(length * width)`
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, "8:14: min() not defined between arguments with units 'mm' and 's'")
+	assert.ErrorContains(t, err, "9:14: max() not defined between arguments with units 'mm^2' and 'mm'")
+	assert.ErrorContains(t, err, "10:11: sqrt() not defined for an argument with the unit 'mm'")
+	assert.ErrorContains(t, err, "11:27: operator not defined between operands with units 'mm' and 's'")
+	assert.NotContains(t, err.Error(), "t.yaml:12:")
+}
+
+func TestUnitsInPowers(t *testing.T) {
+	src := `
+Rec: !record
+  fields:
+    length: float @ mm
+    exponent: int
+    duration: double @ s
+  computedFields:
+    variable: length ** exponent
+    withUnit: 2 ** duration
+    unitless: 2 ** exponent`
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, "8:22: a value with the unit 'mm' can only be raised to an integer literal power")
+	assert.ErrorContains(t, err, "9:17: the exponent of '**' cannot have a unit, but has the unit 's'")
+	assert.NotContains(t, err.Error(), "t.yaml:10:")
+}
+
+func TestUnitsOnNonNumericTypes(t *testing.T) {
+	src := `
+Other: !record
+  fields:
+    x: int
+Generic<T>: !record
+  fields:
+    value: T @ mm
+Rec: !record
+  fields:
+    name: string @ mm
+    other: Other @ mm
+    flag: bool @ s
+    values: Generic<int @ mm>`
+	_, err := parseAndValidate(t, src)
+	assert.ErrorContains(t, err, "a unit can only be given on a numeric primitive type, not 'T'")
+	assert.ErrorContains(t, err, "a unit can only be given on a numeric primitive type, not 'string'")
+	assert.ErrorContains(t, err, "a unit can only be given on a numeric primitive type, not 'Other'")
+	assert.ErrorContains(t, err, "a unit can only be given on a numeric primitive type, not 'bool'")
+	assert.NotContains(t, err.Error(), "'int'")
+}
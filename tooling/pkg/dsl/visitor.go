@@ -65,10 +65,18 @@ func (visitor VisitorWithContext[T]) VisitChildren(node Node, context T) {
 		for _, p := range t.Protocols {
 			visitor.Visit(p, context)
 		}
+
+		for _, c := range t.Constants {
+			visitor.Visit(c, context)
+		}
 	case *DefinitionMeta:
 		break
 	case *RecordDefinition:
 		visitor.Visit(t.DefinitionMeta, context)
+		for _, e := range t.Extends {
+			visitor.Visit(e, context)
+		}
+
 		for _, f := range t.Fields {
 			visitor.Visit(f, context)
 		}
@@ -108,8 +116,27 @@ func (visitor VisitorWithContext[T]) VisitChildren(node Node, context T) {
 		for _, step := range t.Sequence {
 			visitor.Visit(step, context)
 		}
+	case *ConstantDefinition:
+		visitor.Visit(t.DefinitionMeta, context)
+		if t.Type != nil {
+			visitor.Visit(t.Type, context)
+		}
+		visitor.Visit(t.Value, context)
 	case *Field:
 		visitor.Visit(t.Type, context)
+		if t.Default != nil {
+			visitor.Visit(t.Default, context)
+		}
+		if t.Constraints != nil {
+			visitor.Visit(t.Constraints, context)
+		}
+	case *FieldConstraints:
+		if t.Min != nil {
+			visitor.Visit(t.Min, context)
+		}
+		if t.Max != nil {
+			visitor.Visit(t.Max, context)
+		}
 	case *ProtocolStep:
 		visitor.Visit(t.Type, context)
 	case *GenericTypeParameter:
@@ -140,6 +167,10 @@ func (visitor VisitorWithContext[T]) VisitChildren(node Node, context T) {
 	case *BinaryExpression:
 		visitor.Visit(t.Left, context)
 		visitor.Visit(t.Right, context)
+	case *ConditionalExpression:
+		visitor.Visit(t.Condition, context)
+		visitor.Visit(t.Then, context)
+		visitor.Visit(t.Else, context)
 
 	case *IntegerLiteralExpression:
 		break
@@ -147,6 +178,12 @@ func (visitor VisitorWithContext[T]) VisitChildren(node Node, context T) {
 		break
 	case *StringLiteralExpression:
 		break
+	case *BooleanLiteralExpression:
+		break
+	case *EnumValueExpression:
+		break
+	case *ConstantReferenceExpression:
+		break
 	case *MemberAccessExpression:
 		if t.Target != nil {
 			visitor.Visit(t.Target, context)
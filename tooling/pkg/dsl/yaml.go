@@ -10,6 +10,7 @@ import (
 	"math/big"
 	"os"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strings"
 
@@ -106,6 +107,7 @@ func ParseYamlInDir(path string, namespaceName string) (*Namespace, error) {
 
 		combinedNamespace.TypeDefinitions = append(combinedNamespace.TypeDefinitions, ns.TypeDefinitions...)
 		combinedNamespace.Protocols = append(combinedNamespace.Protocols, ns.Protocols...)
+		combinedNamespace.Constants = append(combinedNamespace.Constants, ns.Constants...)
 	}
 
 	return combinedNamespace, errorSink.AsError()
@@ -152,6 +154,7 @@ func (meta *DefinitionMeta) UnmarshalYAML(value *yaml.Node) error {
 
 func (rec *RecordDefinition) UnmarshalYAML(value *yaml.Node) error {
 	parsedFields := false
+	var constraintsNode *yaml.Node
 	for i := 0; i < len(value.Content); i += 2 {
 		k := value.Content[i]
 		v := value.Content[i+1]
@@ -172,15 +175,130 @@ func (rec *RecordDefinition) UnmarshalYAML(value *yaml.Node) error {
 			if len(rec.ComputedFields) == 0 {
 				return parseError(value, "!record specification computedFields cannot be empty")
 			}
+		case "constraints":
+			constraintsNode = v
+		case "extends":
+			if err := rec.unmarshalExtendsYAML(v); err != nil {
+				return err
+			}
+		case "annotations":
+			annotations, err := unmarshalAnnotationsYAML(v)
+			if err != nil {
+				return err
+			}
+			rec.Annotations = annotations
 		default:
 			return parseError(k, "field '%s' is not valid on a !record specification", k.Value)
 		}
 	}
 
-	if !parsedFields {
+	if !parsedFields && len(rec.Extends) == 0 {
 		return parseError(value, "!record specification must define at least one field")
 	}
 
+	if constraintsNode != nil {
+		// Parsed last, since the constraints can be given before the fields
+		return unmarshalFieldConstraintsYAML(rec.Fields, constraintsNode)
+	}
+
+	return nil
+}
+
+// Parses the records a record extends, given as a single type name or a sequence of them.
+func (rec *RecordDefinition) unmarshalExtendsYAML(value *yaml.Node) error {
+	nodes := []*yaml.Node{value}
+	if value.Kind == yaml.SequenceNode {
+		if len(value.Content) == 0 {
+			return parseError(value, "!record specification extends cannot be empty")
+		}
+		nodes = value.Content
+	}
+
+	for _, node := range nodes {
+		if node.Kind != yaml.ScalarNode {
+			return parseError(node, "expected the name of a record to extend")
+		}
+
+		t, err := UnmarshalTypeYAML(node)
+		if err != nil {
+			return err
+		}
+		if t == nil {
+			return parseError(node, "expected the name of a record to extend")
+		}
+		rec.Extends = append(rec.Extends, t)
+	}
+
+	return nil
+}
+
+func unmarshalFieldConstraintsYAML(fields Fields, value *yaml.Node) error {
+	if value.Tag != "!!map" || len(value.Content) == 0 {
+		return parseError(value, "expected constraints to be a mapping from <field name>: <constraints>")
+	}
+
+	for i := 0; i < len(value.Content); i += 2 {
+		k := value.Content[i]
+		v := value.Content[i+1]
+		index := slices.IndexFunc(fields, func(f *Field) bool { return f.Name == k.Value })
+		if index < 0 {
+			return parseError(k, "constraints are given for '%s', which is not a field of the record", k.Value)
+		}
+
+		constraints := &FieldConstraints{NodeMeta: createNodeMeta(k)}
+		if err := constraints.unmarshalYAML(v); err != nil {
+			return err
+		}
+		fields[index].Constraints = constraints
+	}
+
+	return nil
+}
+
+func (constraints *FieldConstraints) unmarshalYAML(value *yaml.Node) error {
+	if value.Tag != "!!map" || len(value.Content) == 0 {
+		return parseError(value, "field constraints must be specified with one or more of `min`, `max`, `pattern`, `minLength`, and `maxLength`")
+	}
+
+	unmarshalLength := func(node *yaml.Node) (*uint64, error) {
+		var length big.Int
+		if node.Tag != "!!int" || length.UnmarshalText([]byte(node.Value)) != nil {
+			return nil, parseError(node, "expected a length to be an integer")
+		}
+		if length.Sign() < 0 || !length.IsUint64() {
+			return nil, parseError(node, "a length must be a non-negative 64-bit integer")
+		}
+		asUint64 := length.Uint64()
+		return &asUint64, nil
+	}
+
+	var err error
+	for i := 0; i < len(value.Content); i += 2 {
+		k := value.Content[i]
+		v := value.Content[i+1]
+		switch k.Value {
+		case "min":
+			constraints.Min, err = UnmarshalExpression(v)
+		case "max":
+			constraints.Max, err = UnmarshalExpression(v)
+		case "pattern":
+			if v.Tag != "!!str" {
+				return parseError(v, "expected the pattern to be a string")
+			}
+			constraints.Pattern = v.Value
+		case "minLength":
+			constraints.MinLength, err = unmarshalLength(v)
+		case "maxLength":
+			constraints.MaxLength, err = unmarshalLength(v)
+		default:
+			return parseError(k, "field '%s' is not valid on a field constraints specification", k.Value)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -203,9 +321,12 @@ func (ns *Namespace) UnmarshalYAML(value *yaml.Node) error {
 			return err
 		}
 
-		if protocol, ok := typeDef.(*ProtocolDefinition); ok {
-			ns.Protocols = append(ns.Protocols, protocol)
-		} else {
+		switch typeDef := typeDef.(type) {
+		case *ProtocolDefinition:
+			ns.Protocols = append(ns.Protocols, typeDef)
+		case *ConstantDefinition:
+			ns.Constants = append(ns.Constants, typeDef)
+		default:
 			ns.TypeDefinitions = append(ns.TypeDefinitions, typeDef)
 		}
 	}
@@ -256,6 +377,10 @@ func (computedFields *ComputedFields) UnmarshalYAML(value *yaml.Node) error {
 			return parseError(value, "expected computed field name to be a string")
 		}
 
+		if fieldValue.Tag == "!protocol" {
+			return parseError(fieldValue, "only protocol steps can reference a !protocol")
+		}
+
 		fieldName := fieldKey.Value
 
 		expression, err := UnmarshalExpression(fieldValue)
@@ -377,6 +502,9 @@ func convertType(ast *parser.Type, node NodeMeta) Type {
 	var t Type
 	if ast.Named != nil {
 		simpleType := SimpleType{NodeMeta: nodeWithPositionUpdated, Name: ast.Named.Name}
+		if ast.Named.Unit != nil {
+			simpleType.Unit = *ast.Named.Unit
+		}
 		for _, typeArg := range ast.Named.TypeArgs {
 			simpleType.TypeArguments = append(simpleType.TypeArguments, convertType(typeArg, node))
 		}
@@ -420,7 +548,7 @@ func applyTypeTail(inner Type, tail parser.TypeTail) Type {
 		if len(tail.Array.Dimensions) > 0 {
 			dims := ArrayDimensions{}
 			for _, dim := range tail.Array.Dimensions {
-				dims = append(dims, &ArrayDimension{NodeMeta: nodeMeta, Name: dim.Name, Length: dim.Length})
+				dims = append(dims, &ArrayDimension{NodeMeta: nodeMeta, Name: dim.Name, Length: dim.Length, LengthConstant: dim.LengthConstant})
 			}
 
 			a.Dimensions = &dims
@@ -457,13 +585,24 @@ func (protocol *ProtocolDefinition) UnmarshalYAML(value *yaml.Node) error {
 		v := value.Content[i+1]
 		switch k.Value {
 		case "sequence":
-			err := v.DecodeWithOptions(&protocol.Sequence, yaml.DecodeOptions{KnownFields: true})
+			steps, subProtocols, err := unmarshalSubProtocolsYAML(v)
+			if err != nil {
+				return err
+			}
+			err = steps.DecodeWithOptions(&protocol.Sequence, yaml.DecodeOptions{KnownFields: true})
 			if err != nil {
 				return err
 			}
-			if len(protocol.Sequence) > 0 {
+			protocol.SubProtocols = subProtocols
+			if len(protocol.Sequence) > 0 || len(protocol.SubProtocols) > 0 {
 				parsedSequence = true
 			}
+		case "annotations":
+			annotations, err := unmarshalAnnotationsYAML(v)
+			if err != nil {
+				return err
+			}
+			protocol.Annotations = annotations
 		default:
 			return parseError(k, "field '%s' is not valid on a !protocol specification", k.Value)
 		}
@@ -476,6 +615,41 @@ func (protocol *ProtocolDefinition) UnmarshalYAML(value *yaml.Node) error {
 	return nil
 }
 
+// Separates the steps of a protocol sequence that reference another protocol
+// (`calibration: !protocol CalibrationProtocol`) from the regular steps,
+// returning a sequence node containing only the regular steps.
+func unmarshalSubProtocolsYAML(value *yaml.Node) (*yaml.Node, []*SubProtocol, error) {
+	if value.Kind != yaml.MappingNode {
+		return value, nil, nil
+	}
+
+	steps := *value
+	steps.Content = make([]*yaml.Node, 0, len(value.Content))
+	var subProtocols []*SubProtocol
+	for i := 0; i+1 < len(value.Content); i += 2 {
+		k := value.Content[i]
+		v := value.Content[i+1]
+		if v.Tag != "!protocol" {
+			steps.Content = append(steps.Content, k, v)
+			continue
+		}
+
+		if v.Kind != yaml.ScalarNode || v.Value == "" {
+			return nil, nil, parseError(v, "a !protocol step must be given the name of a protocol, e.g. `%s: !protocol MyProtocol`", k.Value)
+		}
+
+		subProtocols = append(subProtocols, &SubProtocol{
+			NodeMeta:     createNodeMeta(k),
+			Name:         k.Value,
+			Comment:      normalizeComment(k.HeadComment),
+			ProtocolName: v.Value,
+			Offset:       len(steps.Content) / 2,
+		})
+	}
+
+	return &steps, subProtocols, nil
+}
+
 func (steps *ProtocolSteps) UnmarshalYAML(value *yaml.Node) error {
 	unpacked := []*ProtocolStep(*steps)
 	err := UnmarshalFieldsOrProtocolStepsYAML(&unpacked, value)
@@ -498,18 +672,38 @@ func UnmarshalFieldsOrProtocolStepsYAML[T fieldOrProtocolStep](elements *[]*T, v
 			return parseError(value, "a field or protocol step cannot be null")
 		}
 
+		if fieldValue.Tag == "!protocol" {
+			return parseError(fieldValue, "only protocol steps can reference a !protocol")
+		}
+
 		fieldName := fieldKey.Value
 
+		var defaultValue Expression
+		var annotations Annotations
+		if fieldValue.Tag == "!!map" {
+			if _, isStep := any((*T)(nil)).(*ProtocolStep); isStep {
+				return parseError(fieldValue, "default values can only be specified on record fields")
+			}
+
+			var err error
+			fieldValue, defaultValue, annotations, err = unmarshalFieldSpecificationYAML(fieldValue)
+			if err != nil {
+				return err
+			}
+		}
+
 		t, err := UnmarshalTypeYAML(fieldValue)
 		if err != nil {
 			return err
 		}
 
 		e := &T{
-			Name:     fieldName,
-			Comment:  normalizeComment(fieldKey.HeadComment),
-			Type:     t,
-			NodeMeta: createNodeMeta(fieldKey),
+			Name:        fieldName,
+			Comment:     normalizeComment(fieldKey.HeadComment),
+			Type:        t,
+			Default:     defaultValue,
+			Annotations: annotations,
+			NodeMeta:    createNodeMeta(fieldKey),
 		}
 		*elements = append(*elements, e)
 	}
@@ -517,6 +711,84 @@ func UnmarshalFieldsOrProtocolStepsYAML[T fieldOrProtocolStep](elements *[]*T, v
 	return nil
 }
 
+// Parses a field given as a map with the keys `type`, `default`, and `annotations`,
+// returning the node of the field's type, the default value expression, and the
+// annotations.
+func unmarshalFieldSpecificationYAML(value *yaml.Node) (*yaml.Node, Expression, Annotations, error) {
+	var typeNode, defaultNode, annotationsNode *yaml.Node
+	for i := 0; i < len(value.Content); i += 2 {
+		k := value.Content[i]
+		v := value.Content[i+1]
+		switch k.Value {
+		case "type":
+			typeNode = v
+		case "default":
+			defaultNode = v
+		case "annotations":
+			annotationsNode = v
+		default:
+			return nil, nil, nil, parseError(k, "field '%s' is not valid on a field specification", k.Value)
+		}
+	}
+
+	if typeNode == nil {
+		return nil, nil, nil, parseError(value, "a field specified as a map must have a `type`")
+	}
+	if defaultNode == nil && annotationsNode == nil {
+		return nil, nil, nil, parseError(value, "a field specified as a map must have a `default` or `annotations`")
+	}
+
+	var defaultValue Expression
+	if defaultNode != nil {
+		var err error
+		defaultValue, err = UnmarshalExpression(defaultNode)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	var annotations Annotations
+	if annotationsNode != nil {
+		var err error
+		annotations, err = unmarshalAnnotationsYAML(annotationsNode)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	return typeNode, defaultValue, annotations, nil
+}
+
+// Parses user-defined annotations, which map names to strings, numbers, or booleans.
+func unmarshalAnnotationsYAML(value *yaml.Node) (Annotations, error) {
+	if value.Kind != yaml.MappingNode || len(value.Content) == 0 {
+		return nil, parseError(value, "annotations must be a map from <name>: <value>")
+	}
+
+	annotations := make(Annotations, len(value.Content)/2)
+	for i := 0; i < len(value.Content); i += 2 {
+		k := value.Content[i]
+		v := value.Content[i+1]
+		if k.Tag != "!!str" || k.Value == "" {
+			return nil, parseError(k, "an annotation name must be a non-empty string")
+		}
+
+		switch v.Tag {
+		case "!!str", "!!int", "!!float", "!!bool":
+		default:
+			return nil, parseError(v, "the value of annotation '%s' must be a string, number, or boolean", k.Value)
+		}
+
+		var annotationValue any
+		if err := v.Decode(&annotationValue); err != nil {
+			return nil, parseError(v, "%s", err.Error())
+		}
+		annotations[k.Value] = annotationValue
+	}
+
+	return annotations, nil
+}
+
 type fieldOrProtocolStep interface {
 	Field | ProtocolStep
 }
@@ -540,6 +812,11 @@ func UnmarshalVectorYAML(value *yaml.Node) (*GeneralizedType, error) {
 			}
 			t.Cases = cases
 		case "length":
+			if v.Tag == "!!str" {
+				vector.LengthConstant = &v.Value
+				break
+			}
+
 			var length big.Int
 			if err := length.UnmarshalText([]byte(v.Value)); err != nil {
 				return nil, err
@@ -611,7 +888,10 @@ func UnmarshalArrayYAML(value *yaml.Node) (*GeneralizedType, error) {
 					k := v.Content[i]
 					v := v.Content[i+1]
 					dim := ArrayDimension{Name: &k.Value, Comment: normalizeComment(k.HeadComment), NodeMeta: createNodeMeta(k)}
-					if err := v.DecodeWithOptions(&dim, yaml.DecodeOptions{KnownFields: true}); err != nil {
+					if v.Tag == "!!str" {
+						// the length is given as the name of a constant
+						dim.LengthConstant = &v.Value
+					} else if err := v.DecodeWithOptions(&dim, yaml.DecodeOptions{KnownFields: true}); err != nil {
 						return nil, err
 					}
 					*array.Dimensions = append(*array.Dimensions, &dim)
@@ -720,6 +1000,10 @@ func UnmarshalTypeDefinition(value *yaml.Node, definitionMeta *DefinitionMeta) (
 		protocol := &ProtocolDefinition{DefinitionMeta: definitionMeta}
 		err := value.DecodeWithOptions(protocol, yaml.DecodeOptions{KnownFields: true})
 		return protocol, err
+	case "!const":
+		constant := &ConstantDefinition{DefinitionMeta: definitionMeta}
+		err := constant.unmarshalYAML(value)
+		return constant, err
 	default:
 		namedType := &NamedType{DefinitionMeta: definitionMeta}
 		underlyingType, err := UnmarshalTypeYAML(value)
@@ -731,6 +1015,51 @@ func UnmarshalTypeDefinition(value *yaml.Node, definitionMeta *DefinitionMeta) (
 	}
 }
 
+// Parses a constant, given either as an expression or as a map with the keys
+// `type` and `value`.
+func (constant *ConstantDefinition) unmarshalYAML(value *yaml.Node) error {
+	if len(constant.TypeParameters) > 0 {
+		return parseError(value, "a !const cannot have type parameters")
+	}
+
+	if value.Kind == yaml.ScalarNode {
+		if value.Value == "" {
+			return parseError(value, "a !const must be given a value")
+		}
+		expression, err := ParseExpression(value.Value, value.Line, value.Column)
+		constant.Value = expression
+		return err
+	}
+
+	if value.Kind != yaml.MappingNode {
+		return parseError(value, "a !const must be specified as a value or with the fields `type` and `value`")
+	}
+
+	for i := 0; i < len(value.Content); i += 2 {
+		k := value.Content[i]
+		v := value.Content[i+1]
+		var err error
+		switch k.Value {
+		case "type":
+			constant.Type, err = UnmarshalTypeYAML(v)
+		case "value":
+			constant.Value, err = UnmarshalExpression(v)
+		default:
+			return parseError(k, "field '%s' is not valid on a !const specification", k.Value)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if constant.Value == nil {
+		return parseError(value, "`value` must be specified on a !const")
+	}
+
+	return nil
+}
+
 func UnmarshalTypeYAML(value *yaml.Node) (Type, error) {
 	switch value.Tag {
 	case "!!null":
@@ -898,6 +1227,12 @@ func (enum *EnumDefinition) UnmarshalYAML(value *yaml.Node) error {
 			}
 
 			enum.Values = *vals
+		case "annotations":
+			annotations, err := unmarshalAnnotationsYAML(v)
+			if err != nil {
+				return err
+			}
+			enum.Annotations = annotations
 		default:
 			return parseError(k, "field '%s' is not valid on an !enum specification", k.Value)
 		}
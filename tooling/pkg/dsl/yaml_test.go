@@ -144,6 +144,113 @@ x: !protocol
 	require.Equal(t, "comment on step", ns.Protocols[0].Sequence[0].Comment)
 }
 
+func TestAnnotations(t *testing.T) {
+	src := `
+x: !record
+  annotations:
+    version: 2
+  fields:
+    f:
+      type: float
+      annotations:
+        units: mm
+        dicomTag: (0018,0050)
+        scale: 0.5
+        deprecated: true
+    g:
+      type: int
+      default: 1
+      annotations:
+        units: s
+y: !enum
+  annotations:
+    deprecated: false
+  values: [a]
+p: !protocol
+  annotations:
+    owner: me
+  sequence:
+    i: int`
+
+	ns, err := parse(t, src)
+	require.Nil(t, err)
+	rec := ns.TypeDefinitions[0].(*RecordDefinition)
+	require.Equal(t, Annotations{"version": 2}, rec.Annotations)
+	require.Equal(t, Annotations{"units": "mm", "dicomTag": "(0018,0050)", "scale": 0.5, "deprecated": true}, rec.Fields[0].Annotations)
+	require.Equal(t, Annotations{"units": "s"}, rec.Fields[1].Annotations)
+	require.NotNil(t, rec.Fields[1].Default)
+	require.Equal(t, Annotations{"deprecated": false}, ns.TypeDefinitions[1].(*EnumDefinition).Annotations)
+	require.Equal(t, Annotations{"owner": "me"}, ns.Protocols[0].Annotations)
+
+	require.Equal(t, `deprecated=true, dicomTag="(0018,0050)", scale=0.5, units="mm"`, rec.Fields[0].Annotations.String())
+	require.Equal(t, "comment\nAnnotations: version=2", AnnotatedComment("comment", rec.Annotations))
+}
+
+func TestAnnotationsErrors(t *testing.T) {
+	_, err := parse(t, `
+x: !record
+  annotations: [a, b]
+  fields:
+    f: int`)
+	require.ErrorContains(t, err, "annotations must be a map from <name>: <value>")
+
+	_, err = parse(t, `
+x: !record
+  fields:
+    f:
+      type: int
+      annotations: {}`)
+	require.ErrorContains(t, err, "annotations must be a map from <name>: <value>")
+
+	_, err = parse(t, `
+x: !record
+  fields:
+    f:
+      type: float
+      annotations:
+        units: [mm]`)
+	require.ErrorContains(t, err, "the value of annotation 'units' must be a string, number, or boolean")
+
+	_, err = parse(t, `
+x: !record
+  fields:
+    f:
+      annotations:
+        units: mm`)
+	require.ErrorContains(t, err, "a field specified as a map must have a `type`")
+}
+
+func TestAnnotationsPreservedInGenericInstances(t *testing.T) {
+	src := `
+X<T>: !record
+  annotations:
+    kind: generic
+  fields:
+    f:
+      type: T
+      annotations:
+        units: mm
+P: !protocol
+  sequence:
+    x: X<int>`
+
+	env, err := parseAndValidate(t, src)
+	require.Nil(t, err)
+
+	generic := env.SymbolTable["test.X"].(*RecordDefinition)
+	instance, err := MakeGenericType(generic, []Type{&SimpleType{Name: "int", ResolvedDefinition: PrimitiveInt32}}, false)
+	require.Nil(t, err)
+	rec := instance.(*RecordDefinition)
+	require.Equal(t, Annotations{"kind": "generic"}, rec.Annotations)
+	require.Equal(t, Annotations{"units": "mm"}, rec.Fields[0].Annotations)
+
+	// annotations do not affect serialization, so they are not part of the schema
+	schema := GetProtocolSchema(env.SymbolTable["test.P"].(*ProtocolDefinition), env.SymbolTable)
+	schemaRec := schema.Types[0].(*RecordDefinition)
+	require.Nil(t, schemaRec.Annotations)
+	require.Nil(t, schemaRec.Fields[0].Annotations)
+}
+
 func TestGenericTypeWithInvalidNestedGenerics(t *testing.T) {
 	src := `
 Foo<X<Y>>: string`
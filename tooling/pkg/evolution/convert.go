@@ -0,0 +1,496 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+package evolution
+
+import (
+	"fmt"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/microsoft/yardl/tooling/pkg/binary"
+	"github.com/microsoft/yardl/tooling/pkg/dsl"
+)
+
+// Converts a value of a type in the previous version to the corresponding type
+// in the latest version. Values of records and enums are always rebuilt so that
+// they refer to the latest definitions.
+func (r *Reader) convert(newType, oldType dsl.Type, value binary.Value) (binary.Value, error) {
+	switch tc := r.evolution.CompareTypes(newType, oldType).(type) {
+	case *dsl.TypeChangeIncompatible:
+		return nil, fmt.Errorf("cannot convert '%s' to '%s'", dsl.TypeToShortSyntax(tc.OldType(), true), dsl.TypeToShortSyntax(tc.NewType(), true))
+
+	case *dsl.TypeChangeNumberToNumber, *dsl.TypeChangeComplexToComplex, *dsl.TypeChangeNumberToString, *dsl.TypeChangeStringToNumber:
+		primitive, _ := dsl.GetPrimitiveType(tc.NewType())
+		return convertPrimitive(value, primitive)
+
+	case *dsl.TypeChangeStringToBytes:
+		return []byte(value.(string)), nil
+
+	case *dsl.TypeChangeBytesToString:
+		b := value.([]byte)
+		if !utf8.Valid(b) {
+			return nil, fmt.Errorf("a value of type 'bytes' cannot be converted to 'string' because it is not valid UTF-8")
+		}
+		return string(b), nil
+
+	case *dsl.TypeChangeStringToUuid:
+		u, err := binary.ParseUuid(value.(string))
+		if err != nil {
+			return nil, fmt.Errorf("a value of type 'string' cannot be converted to 'uuid': %w", err)
+		}
+		return u, nil
+
+	case *dsl.TypeChangeUuidToString:
+		return value.(binary.Uuid).String(), nil
+
+	case *dsl.TypeChangeVectorToBytes:
+		items := value.([]binary.Value)
+		converted := make([]byte, len(items))
+		for i, item := range items {
+			converted[i] = item.(uint8)
+		}
+		return converted, nil
+
+	case *dsl.TypeChangeBytesToVector:
+		b := value.([]byte)
+		converted := make([]binary.Value, len(b))
+		for i, item := range b {
+			converted[i] = item
+		}
+		return converted, nil
+
+	case *dsl.TypeChangeScalarToOptional:
+		return r.convert(tc.NewType().(*dsl.GeneralizedType).Cases[1].Type, tc.OldType(), value)
+
+	case *dsl.TypeChangeOptionalToScalar:
+		if value == nil {
+			return zero(tc.NewType()), nil
+		}
+		return r.convert(tc.NewType(), tc.OldType().(*dsl.GeneralizedType).Cases[1].Type, value)
+
+	case *dsl.TypeChangeScalarToUnion:
+		c := tc.NewType().(*dsl.GeneralizedType).Cases[tc.TypeIndex]
+		converted, err := r.convert(c.Type, tc.OldType(), value)
+		if err != nil {
+			return nil, err
+		}
+		return &binary.Union{Index: tc.TypeIndex, Tag: c.Tag, Value: converted}, nil
+
+	case *dsl.TypeChangeUnionToScalar:
+		u, ok := value.(*binary.Union)
+		if !ok || u.Index != tc.TypeIndex {
+			return zero(tc.NewType()), nil
+		}
+		return r.convert(tc.NewType(), tc.OldType().(*dsl.GeneralizedType).Cases[u.Index].Type, u.Value)
+
+	case *dsl.TypeChangeUnionToOptional:
+		if value == nil {
+			return nil, nil
+		}
+		oldCases := tc.OldType().(*dsl.GeneralizedType).Cases
+		u := value.(*binary.Union)
+		if u.Index != tc.TypeIndex {
+			return nil, fmt.Errorf("a value of type '%s' cannot be converted to '%s'", dsl.TypeToShortSyntax(oldCases[u.Index].Type, true), dsl.TypeToShortSyntax(tc.NewType(), true))
+		}
+		return r.convert(tc.NewType().(*dsl.GeneralizedType).Cases[1].Type, oldCases[u.Index].Type, u.Value)
+
+	case *dsl.TypeChangeOptionalToUnion:
+		if value == nil {
+			return nil, nil
+		}
+		c := tc.NewType().(*dsl.GeneralizedType).Cases[tc.TypeIndex]
+		converted, err := r.convert(c.Type, tc.OldType().(*dsl.GeneralizedType).Cases[1].Type, value)
+		if err != nil {
+			return nil, err
+		}
+		return &binary.Union{Index: tc.TypeIndex, Tag: c.Tag, Value: converted}, nil
+
+	default:
+		// The types have the same structure, but the definitions
+		// or types they contain may have changed
+		return r.convertStructure(dsl.GetUnderlyingType(newType), dsl.GetUnderlyingType(oldType), value)
+	}
+}
+
+func (r *Reader) convertStructure(newType, oldType dsl.Type, value binary.Value) (binary.Value, error) {
+	switch newType := newType.(type) {
+	case nil:
+		return nil, nil
+
+	case *dsl.SimpleType:
+		switch newDef := newType.ResolvedDefinition.(type) {
+		case dsl.PrimitiveDefinition:
+			return value, nil
+		case *dsl.RecordDefinition:
+			return r.convertRecord(newDef, value.(*binary.Record))
+		case *dsl.EnumDefinition:
+			return convertEnum(newDef, value.(*binary.Enum))
+		default:
+			return nil, fmt.Errorf("unexpected type definition %T", newDef)
+		}
+
+	case *dsl.GeneralizedType:
+		oldType := oldType.(*dsl.GeneralizedType)
+		newItemType, oldItemType := newType.ToScalar(), oldType.ToScalar()
+
+		switch dim := newType.Dimensionality.(type) {
+		case nil:
+			return r.convertUnion(newType.Cases, oldType.Cases, value)
+		case *dsl.Vector:
+			items := value.([]binary.Value)
+			converted := make([]binary.Value, len(items))
+			for i, item := range items {
+				var err error
+				if converted[i], err = r.convert(newItemType, oldItemType, item); err != nil {
+					return nil, err
+				}
+			}
+			return converted, nil
+		case *dsl.Array:
+			arr := value.(*binary.Array)
+			converted := &binary.Array{Shape: arr.Shape, Data: make([]binary.Value, len(arr.Data))}
+			for i, item := range arr.Data {
+				var err error
+				if converted.Data[i], err = r.convert(newItemType, oldItemType, item); err != nil {
+					return nil, err
+				}
+			}
+			return converted, nil
+		case *dsl.Map:
+			oldKeyType := oldType.Dimensionality.(*dsl.Map).KeyType
+			m := value.(*binary.Map)
+			converted := &binary.Map{Entries: make([]binary.MapEntry, len(m.Entries))}
+			for i, entry := range m.Entries {
+				key, err := r.convert(dim.KeyType, oldKeyType, entry.Key)
+				if err != nil {
+					return nil, err
+				}
+				v, err := r.convert(newItemType, oldItemType, entry.Value)
+				if err != nil {
+					return nil, err
+				}
+				converted.Entries[i] = binary.MapEntry{Key: key, Value: v}
+			}
+			return converted, nil
+		default:
+			return nil, fmt.Errorf("unexpected dimensionality %T", dim)
+		}
+
+	default:
+		return nil, fmt.Errorf("unexpected type %T", newType)
+	}
+}
+
+// Converts the value of an optional or union whose cases may have been
+// added, removed, reordered or changed.
+func (r *Reader) convertUnion(newCases, oldCases dsl.TypeCases, value binary.Value) (binary.Value, error) {
+	if value == nil {
+		// The null case
+		return nil, nil
+	}
+
+	if newCases.IsOptional() {
+		return r.convert(newCases[1].Type, oldCases[1].Type, value)
+	}
+
+	u := value.(*binary.Union)
+	oldCase := oldCases[u.Index]
+	newIndex := r.matchingUnionCase(newCases, oldCases, u.Index)
+	if newIndex < 0 {
+		return nil, fmt.Errorf("a value of type '%s' cannot be converted to '%s'", dsl.TypeToShortSyntax(oldCase.Type, true), dsl.TypeToShortSyntax(&dsl.GeneralizedType{Cases: newCases}, true))
+	}
+
+	newCase := newCases[newIndex]
+	converted, err := r.convert(newCase.Type, oldCase.Type, u.Value)
+	if err != nil {
+		return nil, err
+	}
+	return &binary.Union{Index: newIndex, Tag: newCase.Tag, Value: converted}, nil
+}
+
+// Returns the index of the case in newCases that the case at oldIndex in
+// oldCases corresponds to, or -1 if there is none. Cases are matched the same
+// way as when union changes are detected.
+func (r *Reader) matchingUnionCase(newCases, oldCases dsl.TypeCases, oldIndex int) int {
+	oldMatches := make([]bool, len(oldCases))
+	for i, newCase := range newCases {
+		for j, oldCase := range oldCases {
+			if oldMatches[j] {
+				continue
+			}
+
+			switch r.evolution.CompareTypes(newCase.Type, oldCase.Type).(type) {
+			case nil, *dsl.TypeChangeDefinitionChanged:
+				if j == oldIndex {
+					return i
+				}
+				oldMatches[j] = true
+			default:
+				continue
+			}
+			break
+		}
+	}
+
+	return -1
+}
+
+func (r *Reader) convertRecord(newDef *dsl.RecordDefinition, rec *binary.Record) (binary.Value, error) {
+	converted := &binary.Record{Definition: newDef, Fields: make([]binary.Value, len(newDef.Fields))}
+	for i, newField := range newDef.Fields {
+		converted.Fields[i] = zero(newField.Type)
+		for j, oldField := range rec.Definition.Fields {
+			if oldField.Name == newField.Name {
+				v, err := r.convert(newField.Type, oldField.Type, rec.Fields[j])
+				if err != nil {
+					return nil, fmt.Errorf("%s.%s: %w", newDef.Name, newField.Name, err)
+				}
+				converted.Fields[i] = v
+				break
+			}
+		}
+	}
+
+	return converted, nil
+}
+
+func convertEnum(newDef *dsl.EnumDefinition, enum *binary.Enum) (binary.Value, error) {
+	primitive, _ := dsl.GetPrimitiveType(enumBaseType(newDef))
+	v, err := convertPrimitive(enum.Value, primitive)
+	if err != nil {
+		return nil, err
+	}
+	return &binary.Enum{Definition: newDef, Value: v}, nil
+}
+
+func enumBaseType(enum *dsl.EnumDefinition) dsl.Type {
+	if enum.BaseType != nil {
+		return enum.BaseType
+	}
+	return dsl.Int32Type
+}
+
+type number interface {
+	~int8 | ~int16 | ~int32 | ~int64 | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~float32 | ~float64
+}
+
+// Converts a number with the semantics of a Go conversion
+func castNumber[T number](value binary.Value) (T, error) {
+	switch v := value.(type) {
+	case int8:
+		return T(v), nil
+	case int16:
+		return T(v), nil
+	case int32:
+		return T(v), nil
+	case int64:
+		return T(v), nil
+	case uint8:
+		return T(v), nil
+	case uint16:
+		return T(v), nil
+	case uint32:
+		return T(v), nil
+	case uint64:
+		return T(v), nil
+	case float32:
+		return T(v), nil
+	case float64:
+		return T(v), nil
+	default:
+		return 0, fmt.Errorf("expected a number, got %T", value)
+	}
+}
+
+// Converts a number, complex number or string to the given primitive type
+func convertPrimitive(value binary.Value, primitive dsl.PrimitiveDefinition) (binary.Value, error) {
+	if s, ok := value.(string); ok && primitive != dsl.String {
+		return parseNumber(s, primitive)
+	}
+
+	switch primitive {
+	case dsl.Int8:
+		return castNumber[int8](value)
+	case dsl.Int16:
+		return castNumber[int16](value)
+	case dsl.Int32:
+		return castNumber[int32](value)
+	case dsl.Int64:
+		return castNumber[int64](value)
+	case dsl.Uint8:
+		return castNumber[uint8](value)
+	case dsl.Uint16:
+		return castNumber[uint16](value)
+	case dsl.Uint32:
+		return castNumber[uint32](value)
+	case dsl.Uint64, dsl.Size:
+		return castNumber[uint64](value)
+	case dsl.Float16, dsl.BFloat16, dsl.Float32:
+		return castNumber[float32](value)
+	case dsl.Float64:
+		return castNumber[float64](value)
+	case dsl.ComplexFloat32:
+		switch v := value.(type) {
+		case complex64:
+			return v, nil
+		case complex128:
+			return complex64(v), nil
+		}
+	case dsl.ComplexFloat64:
+		switch v := value.(type) {
+		case complex64:
+			return complex128(v), nil
+		case complex128:
+			return v, nil
+		}
+	case dsl.String:
+		switch v := value.(type) {
+		case string:
+			return v, nil
+		case float32:
+			return strconv.FormatFloat(float64(v), 'g', -1, 32), nil
+		case float64:
+			return strconv.FormatFloat(v, 'g', -1, 64), nil
+		case int8, int16, int32, int64, uint8, uint16, uint32, uint64:
+			return fmt.Sprint(v), nil
+		}
+	}
+
+	return nil, fmt.Errorf("cannot convert a value of type %T to '%s'", value, primitive)
+}
+
+func parseNumber(s string, primitive dsl.PrimitiveDefinition) (binary.Value, error) {
+	switch dsl.GetPrimitiveKind(primitive) {
+	case dsl.PrimitiveKindInteger:
+		if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return convertPrimitive(i, primitive)
+		}
+		if u, err := strconv.ParseUint(s, 10, 64); err == nil {
+			return convertPrimitive(u, primitive)
+		}
+	case dsl.PrimitiveKindFloatingPoint:
+		if f, err := strconv.ParseFloat(s, 64); err == nil {
+			return convertPrimitive(f, primitive)
+		}
+	}
+
+	return nil, fmt.Errorf("the string %q cannot be converted to '%s'", s, primitive)
+}
+
+// Returns the default value of a type, which is used for record fields
+// and protocol steps that were added in the latest version
+func zero(t dsl.Type) binary.Value {
+	switch t := t.(type) {
+	case nil:
+		return nil
+
+	case *dsl.SimpleType:
+		switch td := t.ResolvedDefinition.(type) {
+		case dsl.PrimitiveDefinition:
+			return zeroPrimitive(td)
+		case *dsl.EnumDefinition:
+			return &binary.Enum{Definition: td, Value: zero(enumBaseType(td))}
+		case *dsl.RecordDefinition:
+			rec := &binary.Record{Definition: td, Fields: make([]binary.Value, len(td.Fields))}
+			for i, field := range td.Fields {
+				rec.Fields[i] = zero(field.Type)
+			}
+			return rec
+		case *dsl.NamedType:
+			return zero(td.Type)
+		default:
+			return nil
+		}
+
+	case *dsl.GeneralizedType:
+		switch dim := t.Dimensionality.(type) {
+		case nil:
+			if t.Cases.IsSingle() {
+				return zero(t.Cases[0].Type)
+			}
+			if t.Cases.HasNullOption() {
+				return nil
+			}
+			return &binary.Union{Index: 0, Tag: t.Cases[0].Tag, Value: zero(t.Cases[0].Type)}
+		case *dsl.Vector:
+			items := []binary.Value{}
+			if dim.Length != nil {
+				for i := uint64(0); i < *dim.Length; i++ {
+					items = append(items, zero(t.ToScalar()))
+				}
+			}
+			return items
+		case *dsl.Array:
+			arr := &binary.Array{Shape: []uint64{0}, Data: []binary.Value{}}
+			if dim.HasKnownNumberOfDimensions() {
+				arr.Shape = make([]uint64, len(*dim.Dimensions))
+				if dim.IsFixed() {
+					count := uint64(1)
+					for i, dimension := range *dim.Dimensions {
+						arr.Shape[i] = *dimension.Length
+						count *= *dimension.Length
+					}
+					for i := uint64(0); i < count; i++ {
+						arr.Data = append(arr.Data, zero(t.ToScalar()))
+					}
+				}
+			}
+			return arr
+		case *dsl.Map:
+			return &binary.Map{Entries: []binary.MapEntry{}}
+		default:
+			return nil
+		}
+
+	default:
+		return nil
+	}
+}
+
+func zeroPrimitive(primitive dsl.PrimitiveDefinition) binary.Value {
+	switch primitive {
+	case dsl.Bool:
+		return false
+	case dsl.Int8:
+		return int8(0)
+	case dsl.Int16:
+		return int16(0)
+	case dsl.Int32:
+		return int32(0)
+	case dsl.Int64:
+		return int64(0)
+	case dsl.Uint8:
+		return uint8(0)
+	case dsl.Uint16:
+		return uint16(0)
+	case dsl.Uint32:
+		return uint32(0)
+	case dsl.Uint64, dsl.Size:
+		return uint64(0)
+	case dsl.Float16, dsl.BFloat16, dsl.Float32:
+		return float32(0)
+	case dsl.Float64:
+		return float64(0)
+	case dsl.ComplexFloat32:
+		return complex64(0)
+	case dsl.ComplexFloat64:
+		return complex128(0)
+	case dsl.String:
+		return ""
+	case dsl.Bytes:
+		return []byte{}
+	case dsl.Uuid:
+		return binary.Uuid{}
+	case dsl.Date:
+		return binary.Date(0)
+	case dsl.Time:
+		return binary.Time(0)
+	case dsl.DateTime:
+		return binary.DateTime(0)
+	case dsl.Duration:
+		return binary.Duration(0)
+	default:
+		return nil
+	}
+}
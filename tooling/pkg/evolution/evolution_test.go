@@ -0,0 +1,257 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+package evolution
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/microsoft/yardl/tooling/internal/dynamic"
+	"github.com/microsoft/yardl/tooling/pkg/binary"
+	"github.com/microsoft/yardl/tooling/pkg/dsl"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const previousModel = `
+P: !protocol
+  sequence:
+    header: Header
+    count: int
+    values: !stream
+      items: int
+    choice: [int, string]
+    maybe: int?
+
+Header: !record
+  fields:
+    id: int
+    name: string
+    kind: Kind
+
+Kind: !enum
+  values:
+    - a
+    - b
+`
+
+const latestModel = `
+P: !protocol
+  sequence:
+    header: Header
+    count: string
+    values: !stream
+      items: double
+    added: int?
+    choice: [string, int, float]
+    maybe: int
+    extra: !stream
+      items: int
+
+Header: !record
+  fields:
+    name: string
+    kind: Kind
+    id: long
+    note: string?
+
+Kind: !enum
+  values:
+    - a
+    - b
+    - c
+`
+
+func TestReadPreviousVersion(t *testing.T) {
+	data := writeBinary(t, previousModel,
+		`{"header":{"id":7,"name":"abc","kind":"b"}}`,
+		`{"count":42}`,
+		`{"values":1}`,
+		`{"values":2}`,
+		`{"choice":"x"}`,
+		`{"maybe":null}`,
+	)
+
+	output := readAsLatest(t, latestModel, data)
+	assert.Equal(t, []string{
+		`{"header":{"name":"abc","kind":"b","id":7}}`,
+		`{"count":"42"}`,
+		`{"values":1}`,
+		`{"values":2}`,
+		`{"added":null}`,
+		`{"choice":{"string":"x"}}`,
+		`{"maybe":0}`,
+	}, output[1:])
+}
+
+func TestReadSameVersion(t *testing.T) {
+	lines := []string{
+		`{"header":{"id":7,"name":"abc","kind":"b"}}`,
+		`{"count":42}`,
+		`{"values":1}`,
+		`{"choice":3}`,
+		`{"maybe":5}`,
+	}
+	output := readAsLatest(t, previousModel, writeBinary(t, previousModel, lines...))
+	assert.Equal(t, lines, output[1:])
+}
+
+func TestReadIncompatibleVersion(t *testing.T) {
+	data := writeBinary(t, previousModel,
+		`{"header":{"id":7,"name":"abc","kind":"b"}}`,
+		`{"count":42}`,
+		`{"choice":3}`,
+		`{"maybe":5}`,
+	)
+
+	latest := strings.Replace(previousModel, "    count: int\n", "", 1)
+	_, err := NewReader(bytes.NewReader(data), loadModel(t, latest))
+	assert.ErrorContains(t, err, "removing step 'count' is not backward compatible")
+}
+
+func TestReadUnconvertibleValue(t *testing.T) {
+	data := writeBinary(t, previousModel,
+		`{"header":{"id":7,"name":"abc","kind":"b"}}`,
+		`{"count":42}`,
+		`{"choice":"x"}`,
+		`{"maybe":5}`,
+	)
+
+	latest := strings.Replace(previousModel, "choice: [int, string]", "choice: [int, float]", 1)
+	reader, err := NewReader(bytes.NewReader(data), loadModel(t, latest))
+	require.NoError(t, err)
+
+	for {
+		_, _, err = reader.Read()
+		if err != nil {
+			break
+		}
+	}
+	assert.ErrorContains(t, err, "step 'choice': a value of type 'string' cannot be converted to 'int32 | float32'")
+}
+
+func TestReadBytesChanges(t *testing.T) {
+	previous := `
+P: !protocol
+  sequence:
+    text: string
+    octets: uint8*
+    raw: bytes
+`
+	data := writeBinary(t, previous,
+		`{"text":"hi"}`,
+		`{"octets":[0,1,255]}`,
+		`{"raw":"aGk="}`,
+	)
+
+	latest := strings.NewReplacer("text: string", "text: bytes", "octets: uint8*", "octets: bytes", "raw: bytes", "raw: string").Replace(previous)
+	output := readAsLatest(t, latest, data)
+	assert.Equal(t, []string{
+		`{"text":"aGk="}`,
+		`{"octets":"AAH/"}`,
+		`{"raw":"hi"}`,
+	}, output[1:])
+
+	data = writeBinary(t, previous,
+		`{"text":"hi"}`,
+		`{"octets":[]}`,
+		`{"raw":"/w=="}`,
+	)
+	reader, err := NewReader(bytes.NewReader(data), loadModel(t, latest))
+	require.NoError(t, err)
+	for {
+		_, _, err = reader.Read()
+		if err != nil {
+			break
+		}
+	}
+	assert.ErrorContains(t, err, "step 'raw': a value of type 'bytes' cannot be converted to 'string' because it is not valid UTF-8")
+}
+
+func TestReadUuidChanges(t *testing.T) {
+	previous := `
+P: !protocol
+  sequence:
+    text: string
+    id: uuid
+`
+	data := writeBinary(t, previous,
+		`{"text":"123E4567-E89B-12D3-A456-426614174000"}`,
+		`{"id":"123e4567-e89b-12d3-a456-426614174000"}`,
+	)
+
+	latest := strings.NewReplacer("text: string", "text: uuid", "id: uuid", "id: string").Replace(previous)
+	output := readAsLatest(t, latest, data)
+	assert.Equal(t, []string{
+		`{"text":"123e4567-e89b-12d3-a456-426614174000"}`,
+		`{"id":"123e4567-e89b-12d3-a456-426614174000"}`,
+	}, output[1:])
+
+	data = writeBinary(t, previous,
+		`{"text":"not a uuid"}`,
+		`{"id":"123e4567-e89b-12d3-a456-426614174000"}`,
+	)
+	reader, err := NewReader(bytes.NewReader(data), loadModel(t, latest))
+	require.NoError(t, err)
+	_, _, err = reader.Read()
+	assert.ErrorContains(t, err, "step 'text': a value of type 'string' cannot be converted to 'uuid': invalid UUID 'not a uuid'")
+}
+
+func loadModel(t *testing.T, model string) *dsl.Environment {
+	d := t.TempDir()
+	require.NoError(t, os.WriteFile(path.Join(d, "model.yml"), []byte(model), 0644))
+	ns, err := dsl.ParseYamlInDir(d, "test")
+	require.NoError(t, err)
+	env, err := dsl.Validate([]*dsl.Namespace{ns})
+	require.NoError(t, err)
+	return env
+}
+
+// Writes the NDJSON lines of the given model's protocol to the binary format
+func writeBinary(t *testing.T, model string, lines ...string) []byte {
+	env := loadModel(t, model)
+	protocol := env.GetTopLevelNamespace().Protocols[0]
+	schema := dsl.GetProtocolSchemaString(protocol, env.SymbolTable)
+
+	header := `{"yardl":{"version":1,"schema":` + schema + `}}`
+	input := header + "\n" + strings.Join(lines, "\n") + "\n"
+	reader, err := dynamic.NewNDJsonReader(strings.NewReader(input))
+	require.NoError(t, err)
+	require.NoError(t, dynamic.ResolveFlags(reader.Protocol(), env))
+
+	var output bytes.Buffer
+	writer, err := binary.NewWriter(&output, reader.Protocol(), reader.Schema())
+	require.NoError(t, err)
+	copySteps(t, reader, writer)
+	return output.Bytes()
+}
+
+// Reads binary data as the given model's protocol and returns it as NDJSON lines
+func readAsLatest(t *testing.T, model string, data []byte) []string {
+	reader, err := NewReader(bytes.NewReader(data), loadModel(t, model))
+	require.NoError(t, err)
+
+	var output bytes.Buffer
+	writer, err := dynamic.NewNDJsonWriter(&output, reader.Protocol(), reader.Schema())
+	require.NoError(t, err)
+	copySteps(t, reader, writer)
+	return strings.Split(strings.TrimSuffix(output.String(), "\n"), "\n")
+}
+
+func copySteps(t *testing.T, reader dynamic.Reader, writer dynamic.Writer) {
+	for {
+		step, value, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		require.NoError(t, writer.Write(step, value))
+	}
+	require.NoError(t, writer.Close())
+}
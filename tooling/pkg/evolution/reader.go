@@ -0,0 +1,194 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+
+// Package evolution reads binary protocol data that was written with a previous
+// version of a model and converts it to the latest version of the model, following
+// the same schema evolution rules as generated code.
+package evolution
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/microsoft/yardl/tooling/internal/dynamic"
+	"github.com/microsoft/yardl/tooling/pkg/binary"
+	"github.com/microsoft/yardl/tooling/pkg/dsl"
+)
+
+// Reader reads a binary protocol stream written with any compatible version
+// of a protocol and returns the steps and values of the latest version of the
+// protocol. It implements dynamic.Reader.
+type Reader struct {
+	reader    *binary.Reader
+	protocol  *dsl.ProtocolDefinition
+	schema    string
+	evolution *dsl.ProtocolEvolution
+
+	// The index of each step of the previous version in the latest version's sequence
+	newStepIndex map[*dsl.ProtocolStep]int
+
+	// The index in the latest version's sequence of the next step that has not been read
+	nextStep int
+
+	pendingStep  *dsl.ProtocolStep
+	pendingIndex int
+	pendingValue binary.Value
+	hasPending   bool
+	eof          bool
+
+	// Whether the value last returned by Read is of a step that is not in the data
+	synthesized bool
+}
+
+// NewReader reads the protocol schema embedded in the binary stream r and
+// resolves how it relates to the protocol with the same name in latest.
+// Returns an error if the data cannot be read as the latest version.
+func NewReader(r io.Reader, latest *dsl.Environment) (*Reader, error) {
+	reader, err := binary.NewSchemaReader(r)
+	if err != nil {
+		return nil, err
+	}
+
+	previous := reader.Protocol()
+	var protocol *dsl.ProtocolDefinition
+	for _, p := range latest.GetTopLevelNamespace().Protocols {
+		if p.Name == previous.Name {
+			protocol = p
+			break
+		}
+	}
+	if protocol == nil {
+		return nil, fmt.Errorf("the input contains protocol '%s', which is not defined in the model", previous.Name)
+	}
+
+	// The schema does not say which enums are flags, so take that from the latest
+	// version. Enums that the latest version no longer defines are not compared
+	// with any of its definitions, so they do not need to be resolved.
+	_ = dynamic.ResolveFlags(previous, latest)
+
+	evolution := dsl.ResolveProtocolEvolution(protocol, latest, previous, reader.Environment())
+	if err := evolution.Validate(); err != nil {
+		return nil, fmt.Errorf("the input cannot be read as the latest version of protocol '%s':\n%w", protocol.Name, err)
+	}
+
+	newStepIndex := make(map[*dsl.ProtocolStep]int)
+	for _, oldStep := range previous.Sequence {
+		for i, newStep := range protocol.Sequence {
+			if newStep.Name == oldStep.Name {
+				newStepIndex[oldStep] = i
+			}
+		}
+	}
+
+	return &Reader{
+		reader:       reader,
+		protocol:     protocol,
+		schema:       dsl.GetProtocolSchemaString(protocol, latest.SymbolTable),
+		evolution:    evolution,
+		newStepIndex: newStepIndex,
+	}, nil
+}
+
+// Protocol returns the latest version of the protocol.
+func (r *Reader) Protocol() *dsl.ProtocolDefinition {
+	return r.protocol
+}
+
+// Schema returns the protocol schema of the latest version of the protocol.
+func (r *Reader) Schema() string {
+	return r.schema
+}
+
+// PreviousProtocol returns the version of the protocol the data was written with.
+func (r *Reader) PreviousProtocol() *dsl.ProtocolDefinition {
+	return r.reader.Protocol()
+}
+
+// PreviousSchema returns the protocol schema embedded in the stream.
+func (r *Reader) PreviousSchema() string {
+	return r.reader.Schema()
+}
+
+// Change returns the changes from the version of the protocol the data was
+// written with to the latest version, or nil if they are the same.
+func (r *Reader) Change() *dsl.ProtocolChange {
+	return r.evolution.Change
+}
+
+// Offset returns the number of bytes of the underlying stream that have been consumed.
+func (r *Reader) Offset() int64 {
+	return r.reader.Offset()
+}
+
+// ValueOffset returns the offset at which the value last returned by Read
+// starts in the underlying stream. Values of steps that were added in the latest
+// version are not in the stream, so the offset of the next value is returned.
+func (r *Reader) ValueOffset() int64 {
+	if r.synthesized && !r.hasPending {
+		return r.reader.Offset()
+	}
+	return r.reader.ValueOffset()
+}
+
+// Read returns the next step of the latest version of the protocol and its value.
+// Steps that were added in the latest version have their default value, or no items
+// if they are streams. Returns io.EOF after the last step.
+func (r *Reader) Read() (*dsl.ProtocolStep, binary.Value, error) {
+	if !r.hasPending && !r.eof {
+		oldStep, value, err := r.reader.Read()
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				return nil, nil, err
+			}
+			r.eof = true
+		} else {
+			r.pendingStep = oldStep
+			r.pendingIndex = r.newStepIndex[oldStep]
+			r.pendingValue = value
+			r.hasPending = true
+		}
+	}
+
+	target := len(r.protocol.Sequence)
+	if r.hasPending {
+		target = r.pendingIndex
+	}
+
+	// Steps before the next one in the data were either added in the
+	// latest version or are streams without items.
+	for r.nextStep < target {
+		step := r.protocol.Sequence[r.nextStep]
+		r.nextStep++
+		if !step.IsStream() {
+			r.synthesized = true
+			return step, zero(step.Type), nil
+		}
+	}
+
+	if !r.hasPending {
+		return nil, nil, io.EOF
+	}
+
+	newStep := r.protocol.Sequence[target]
+	oldStep := r.pendingStep
+	r.hasPending = false
+	r.synthesized = false
+
+	newType, oldType := newStep.Type, oldStep.Type
+	if newStep.IsStream() {
+		newType = newStep.Type.(*dsl.GeneralizedType).ToScalar()
+		oldType = oldStep.Type.(*dsl.GeneralizedType).ToScalar()
+	} else {
+		r.nextStep = target + 1
+	}
+
+	value, err := r.convert(newType, oldType, r.pendingValue)
+	if err != nil {
+		return nil, nil, fmt.Errorf("step '%s': %w", newStep.Name, err)
+	}
+
+	return newStep, value, nil
+}
+
+var _ dynamic.Reader = (*Reader)(nil)
@@ -30,10 +30,14 @@ type PackageInfo struct {
 	Versions Versions `yaml:"versions,omitempty"`
 	Imports  Imports  `yaml:"imports,omitempty"`
 
-	Json   *JsonCodegenOptions   `yaml:"json,omitempty"`
-	Cpp    *CppCodegenOptions    `yaml:"cpp,omitempty"`
-	Python *PythonCodegenOptions `yaml:"python,omitempty"`
-	Matlab *MatlabCodegenOptions `yaml:"matlab,omitempty"`
+	Json       *JsonCodegenOptions       `yaml:"json,omitempty"`
+	Cpp        *CppCodegenOptions        `yaml:"cpp,omitempty"`
+	Python     *PythonCodegenOptions     `yaml:"python,omitempty"`
+	Matlab     *MatlabCodegenOptions     `yaml:"matlab,omitempty"`
+	Go         *GoCodegenOptions         `yaml:"go,omitempty"`
+	Rust       *RustCodegenOptions       `yaml:"rust,omitempty"`
+	Protobuf   *ProtobufCodegenOptions   `yaml:"protobuf,omitempty"`
+	JsonSchema *JsonSchemaCodegenOptions `yaml:"jsonSchema,omitempty"`
 }
 
 func (p *PackageInfo) PackageDir() string {
@@ -122,6 +126,45 @@ func (p *PackageInfo) validate() error {
 		}
 	}
 
+	if p.Go != nil {
+		p.Go.PackageInfo = p
+		if p.Go.OutputDir == "" {
+			errorSink.Add(validation.NewValidationError(errors.New("the 'go.outputDir' field must not be empty"), p.FilePath))
+		} else {
+			p.Go.OutputDir = filepath.Join(p.PackageDir(), p.Go.OutputDir)
+		}
+		if p.Go.ImportPath == "" {
+			errorSink.Add(validation.NewValidationError(errors.New("the 'go.importPath' field must not be empty"), p.FilePath))
+		}
+	}
+
+	if p.Rust != nil {
+		p.Rust.PackageInfo = p
+		if p.Rust.OutputDir == "" {
+			errorSink.Add(validation.NewValidationError(errors.New("the 'rust.outputDir' field must not be empty"), p.FilePath))
+		} else {
+			p.Rust.OutputDir = filepath.Join(p.PackageDir(), p.Rust.OutputDir)
+		}
+	}
+
+	if p.Protobuf != nil {
+		p.Protobuf.PackageInfo = p
+		if p.Protobuf.OutputDir == "" {
+			errorSink.Add(validation.NewValidationError(errors.New("the 'protobuf.outputDir' field must not be empty"), p.FilePath))
+		} else {
+			p.Protobuf.OutputDir = filepath.Join(p.PackageDir(), p.Protobuf.OutputDir)
+		}
+	}
+
+	if p.JsonSchema != nil {
+		p.JsonSchema.PackageInfo = p
+		if p.JsonSchema.OutputDir == "" {
+			errorSink.Add(validation.NewValidationError(errors.New("the 'jsonSchema.outputDir' field must not be empty"), p.FilePath))
+		} else {
+			p.JsonSchema.OutputDir = filepath.Join(p.PackageDir(), p.JsonSchema.OutputDir)
+		}
+	}
+
 	return errorSink.AsError()
 }
 
@@ -222,6 +265,7 @@ type PythonCodegenOptions struct {
 	Disabled                   bool         `yaml:"disabled"`
 	OutputDir                  string       `yaml:"outputDir"`
 	GenerateNDJson             bool         `yaml:"generateNDJson"`
+	GenerateHDF5               bool         `yaml:"generateHDF5"`
 	InternalSymlinkStaticFiles bool         `yaml:"internalSymlinkStaticFiles"`
 }
 
@@ -237,10 +281,38 @@ type MatlabCodegenOptions struct {
 	PackageInfo                *PackageInfo `yaml:"-"`
 	Disabled                   bool         `yaml:"disabled"`
 	OutputDir                  string       `yaml:"outputDir"`
+	GenerateNDJson             bool         `yaml:"generateNDJson"`
 	InternalSymlinkStaticFiles bool         `yaml:"internalSymlinkStaticFiles"`
 	InternalGenerateMocks      bool         `yaml:"internalGenerateMocks"`
 }
 
+type GoCodegenOptions struct {
+	PackageInfo                *PackageInfo `yaml:"-"`
+	Disabled                   bool         `yaml:"disabled"`
+	OutputDir                  string       `yaml:"outputDir"`
+	ImportPath                 string       `yaml:"importPath"`
+	InternalSymlinkStaticFiles bool         `yaml:"internalSymlinkStaticFiles"`
+}
+
+type RustCodegenOptions struct {
+	PackageInfo                *PackageInfo `yaml:"-"`
+	Disabled                   bool         `yaml:"disabled"`
+	OutputDir                  string       `yaml:"outputDir"`
+	InternalSymlinkStaticFiles bool         `yaml:"internalSymlinkStaticFiles"`
+}
+
+type ProtobufCodegenOptions struct {
+	PackageInfo *PackageInfo `yaml:"-"`
+	Disabled    bool         `yaml:"disabled"`
+	OutputDir   string       `yaml:"outputDir"`
+}
+
+type JsonSchemaCodegenOptions struct {
+	PackageInfo *PackageInfo `yaml:"-"`
+	Disabled    bool         `yaml:"disabled"`
+	OutputDir   string       `yaml:"outputDir"`
+}
+
 // Parses PackageInfo in dir then loads all package Imports and Predecessors
 func LoadPackage(dir string) (*PackageInfo, error) {
 	packageInfo, err := loadPackageVersion(dir)